@@ -0,0 +1,113 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// iface is an interface declaration of the TypeScript definitions
+type iface struct {
+	Name  string
+	Base  string
+	Doc   string
+	Props []prop
+}
+
+// prop is a property of an interface. Type is the TypeScript type without
+// array brackets, Enum the values of a string literal union and Fixed the
+// value of a readonly literal such as resourceType.
+type prop struct {
+	Name     string
+	Doc      string
+	Type     string
+	Enum     []string
+	Fixed    string
+	Array    bool
+	Optional bool
+}
+
+var (
+	ifaceRe   = regexp.MustCompile(`^export interface (\w+)(?:<(\w+) = (\w+)>)?(?: extends (\w+))? \{$`)
+	propRe    = regexp.MustCompile(`^\s+(readonly )?(\w+)(\?)?: (.+?)(?: \| undefined)?;$`)
+	literalRe = regexp.MustCompile(`'([^']*)'`)
+)
+
+// parseDTS reads the interfaces of a TypeScript definition file as generated
+// for the @types/fhir package
+func parseDTS(path string) ([]*iface, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ifaces  []*iface
+		current *iface
+		generic map[string]string
+		doc     []string
+		inDoc   bool
+	)
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case inDoc:
+			if text, ok := strings.CutSuffix(trimmed, "*/"); ok {
+				inDoc = false
+				trimmed = text
+			}
+			doc = append(doc, strings.TrimPrefix(trimmed, "*"))
+		case strings.HasPrefix(trimmed, "/**"):
+			text := strings.TrimPrefix(trimmed, "/**")
+			text, closed := strings.CutSuffix(text, "*/")
+			doc = []string{text}
+			inDoc = !closed
+		case current == nil:
+			if m := ifaceRe.FindStringSubmatch(line); m != nil {
+				current = &iface{Name: m[1], Base: m[4], Doc: joinDoc(doc)}
+				generic = map[string]string{}
+				if m[2] != "" {
+					generic[m[2]] = m[3]
+				}
+			}
+			doc = nil
+		case trimmed == "}":
+			ifaces = append(ifaces, current)
+			current = nil
+		default:
+			m := propRe.FindStringSubmatch(line)
+			if m == nil {
+				doc = nil
+				continue
+			}
+			p := prop{Name: m[2], Doc: joinDoc(doc), Optional: m[3] != ""}
+			doc = nil
+			t := m[4]
+			if rest, ok := strings.CutSuffix(t, "[]"); ok {
+				p.Array = true
+				t = rest
+			}
+			if values := literalRe.FindAllStringSubmatch(t, -1); len(values) > 0 {
+				for _, v := range values {
+					p.Enum = append(p.Enum, v[1])
+				}
+				if m[1] != "" {
+					p.Fixed, p.Enum = p.Enum[0], nil
+				}
+			} else {
+				// type arguments are dropped and type parameters replaced by their default
+				t, _, _ = strings.Cut(t, "<")
+				if def, ok := generic[t]; ok {
+					t = def
+				}
+				p.Type = t
+			}
+			current.Props = append(current.Props, p)
+		}
+	}
+	return ifaces, nil
+}
+
+func joinDoc(lines []string) string {
+	return strings.Join(strings.Fields(strings.Join(lines, " ")), " ")
+}
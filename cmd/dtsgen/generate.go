@@ -0,0 +1,720 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// The styles follow the conventions of the fhir4 package, where required
+// complex elements are values and every choice type has its own comment, or
+// of the fhir3 package, where they are pointers and the choice types share the
+// short description of the element.
+const (
+	styleFHIR4 = "fhir4"
+	styleFHIR3 = "fhir3"
+)
+
+// abstractTypes are the base interfaces the structs embed rather than generate
+var abstractTypes = map[string]bool{
+	"Element": true, "BackboneElement": true, "DataType": true, "Resource": true, "DomainResource": true,
+}
+
+// fieldNames are the JSON properties whose Go names are not just capitalized
+var fieldNames = map[string]string{
+	"id":          "ID",
+	"url":         "URL",
+	"uri":         "URI",
+	"fullUrl":     "FullURL",
+	"versionId":   "VersionID",
+	"fhirVersion": "FHIRVersion",
+}
+
+// legacyNumbers are the Go types of the number elements of R2 and R3 that
+// later versions dropped, so neither the reference package nor the profiles
+// know them
+var legacyNumbers = map[string]string{
+	"sequenceLinkId":          "int",
+	"detailSequenceLinkId":    "int",
+	"subdetailSequenceLinkId": "int",
+	"noteNumberLinkId":        "int",
+	"dependent":               "int",
+	"frameNumbers":            "int",
+	"doseSequence":            "int",
+	"doseNumber":              "int",
+	"strand":                  "int",
+	"measureScore":            "common.Decimal",
+	"prism":                   "common.Decimal",
+}
+
+// codeNames are the Go names of codes that are not words
+var codeNames = map[string]string{
+	"=":  "Equals",
+	"!=": "NotEquals",
+	">":  "Greater",
+	"<":  "Less",
+	">=": "GreaterOrEqual",
+	"<=": "LessOrEqual",
+}
+
+// generator writes the missing structs of a version package
+type generator struct {
+	pkgName  string
+	local    *goPackage
+	ref      *goPackage
+	common   *goPackage
+	style    string
+	profiles map[string]string
+	shorts   map[string]string
+
+	// short descriptions of the elements keyed like profiles
+	elementShorts map[string]string
+	ifaces        []*iface
+	byName        map[string]*iface
+
+	// names of the structs generated by this run
+	structs map[string]bool
+
+	// values of the code types of the package, keyed by type name
+	enums map[string][]string
+
+	// missing datatypes referenced by the generated structs
+	datatypes []string
+
+	unresolved []string
+}
+
+func newGenerator(dts, dir, commonDir, ref, profiles, style string) (*generator, error) {
+	if style != styleFHIR4 && style != styleFHIR3 {
+		return nil, fmt.Errorf("dtsgen: unknown style %q", style)
+	}
+	ifaces, err := parseDTS(dts)
+	if err != nil {
+		return nil, err
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{
+		pkgName: filepath.Base(abs),
+		style:   style,
+		ifaces:  ifaces,
+		byName:  map[string]*iface{},
+		structs: map[string]bool{},
+		enums:   map[string][]string{},
+	}
+	for _, it := range ifaces {
+		g.byName[it.Name] = it
+	}
+	if g.local, err = loadGoPackage(dir); err != nil {
+		return nil, err
+	}
+	if g.common, err = loadGoPackage(commonDir); err != nil {
+		return nil, err
+	}
+	if g.ref, err = loadGoPackage(ref); err != nil {
+		return nil, err
+	}
+	if g.profiles, g.shorts, g.elementShorts, err = loadProfiles(profiles); err != nil {
+		return nil, err
+	}
+	for name, consts := range g.local.Consts {
+		for value := range consts {
+			g.enums[name] = append(g.enums[name], value)
+		}
+	}
+	return g, nil
+}
+
+// unit is a resource or datatype with the backbone elements declared in its file
+type unit struct {
+	root  *iface
+	parts []*iface
+}
+
+// generate returns a file for every resource the package lacks and for every
+// datatype those resources need
+func (g *generator) generate() ([]file, error) {
+	var resources []string
+	for _, it := range g.ifaces {
+		if (it.Base == "DomainResource" || it.Base == "Resource") && !abstractTypes[it.Name] {
+			resources = append(resources, it.Name)
+		}
+	}
+	// longest names first so that backbone elements go to the most specific resource
+	sort.Slice(resources, func(i, j int) bool { return len(resources[i]) > len(resources[j]) })
+
+	units := map[string]*unit{}
+	var order []string
+	for _, it := range g.ifaces {
+		if g.local.Types[it.Name] {
+			continue
+		}
+		owner := ""
+		for _, r := range resources {
+			if partOf(it.Name, r) {
+				owner = r
+				break
+			}
+		}
+		if owner == "" || g.local.Types[owner] {
+			continue
+		}
+		if units[owner] == nil {
+			units[owner] = &unit{}
+			order = append(order, owner)
+		}
+		g.structs[it.Name] = true
+		if it.Name == owner {
+			units[owner].root = it
+		} else {
+			units[owner].parts = append(units[owner].parts, it)
+		}
+	}
+
+	var files []file
+	for _, name := range order {
+		src, err := g.unit(units[name])
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file{name: snakeCase(name) + ".go", src: src})
+	}
+	// datatypes may reference further missing datatypes, the elements of a
+	// datatype such as ElementDefinitionBinding go to the file of the datatype
+	units = map[string]*unit{}
+	order = nil
+	bodies := map[string]*strings.Builder{}
+	for i := 0; i < len(g.datatypes); i++ {
+		it := g.byName[g.datatypes[i]]
+		owner := it.Name
+		for _, name := range order {
+			if partOf(it.Name, name) && units[name].references(it.Name) {
+				owner = name
+				break
+			}
+		}
+		if units[owner] == nil {
+			units[owner] = &unit{root: it}
+			bodies[owner] = &strings.Builder{}
+			order = append(order, owner)
+		} else {
+			units[owner].parts = append(units[owner].parts, it)
+		}
+		if err := g.writeStruct(bodies[owner], it); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range order {
+		files = append(files, file{name: snakeCase(name) + ".go", src: g.source(bodies[name].String())})
+	}
+	return files, nil
+}
+
+// partOf reports whether name is the name of owner or of one of its elements,
+// e.g. "CareTeamParticipant" of "CareTeam"
+func partOf(name, owner string) bool {
+	rest, ok := strings.CutPrefix(name, owner)
+	return ok && (rest == "" || unicode.IsUpper(rune(rest[0])))
+}
+
+// references reports whether a struct of the unit has a property of the type
+func (u *unit) references(typ string) bool {
+	for _, it := range append([]*iface{u.root}, u.parts...) {
+		for _, p := range it.Props {
+			if p.Type == typ {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unit writes the source of a file
+func (g *generator) unit(u *unit) (string, error) {
+	var body strings.Builder
+	for _, it := range append([]*iface{u.root}, u.parts...) {
+		if err := g.writeStruct(&body, it); err != nil {
+			return "", err
+		}
+	}
+	return g.source(body.String()), nil
+}
+
+// source adds the package clause and imports to the structs of a file
+func (g *generator) source(body string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "package %s\n\n", g.pkgName)
+	if strings.Contains(body, "common.") {
+		b.WriteString("import (\n\t\"github.com/d4l-data4life/go-fhir/pkg/common\"\n)\n\n")
+	}
+	b.WriteString(body)
+	return b.String()
+}
+
+// writeStruct writes a struct followed by the code types its fields declare
+func (g *generator) writeStruct(b *strings.Builder, it *iface) error {
+	var enums strings.Builder
+	doc := g.ref.Docs[it.Name]
+	if doc == "" || g.style == styleFHIR3 {
+		doc = g.structDoc(it)
+	}
+	fmt.Fprintf(b, "// %s\ntype %s struct {\n", doc, it.Name)
+	switch it.Base {
+	case "DomainResource", "Resource":
+		fmt.Fprintf(b, "\t%s\n", it.Base)
+	case "BackboneElement", "Element":
+		fmt.Fprintf(b, "\tcommon.%s\n", it.Base)
+	default:
+		base, err := g.structType(it.Base)
+		if err != nil {
+			return fmt.Errorf("%s: %w", it.Name, err)
+		}
+		fmt.Fprintf(b, "\t%s\n", base)
+	}
+
+	previous := ""
+	for _, p := range it.Props {
+		if strings.HasPrefix(p.Name, "_") {
+			continue
+		}
+		if p.Fixed != "" {
+			// the resources of fhir3 take the resourceType from Resource
+			if g.style != styleFHIR3 {
+				fmt.Fprintf(b, "\n\t// Resource Type Name (for serialization)\n\t%s string `json:\"%s\"` // Always %q\n", upperFirst(p.Name), p.Name, p.Fixed)
+			}
+			continue
+		}
+		name, doc := g.fieldDoc(it, p)
+
+		typ, primitive, err := g.fieldType(it, p, &enums)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", it.Name, p.Name, err)
+		}
+		tag := p.Name
+		if p.Optional {
+			tag += ",omitempty"
+		}
+		switch {
+		case p.Array:
+			typ = "[]" + typ
+		case typ == "common.Resource":
+		case p.Optional, g.style == styleFHIR3 && !primitive:
+			typ = "*" + typ
+		}
+
+		// fhir3 declares the types of a choice element as one group
+		if g.style != styleFHIR3 || p.Doc != previous {
+			b.WriteString("\n")
+			if doc != "" {
+				fmt.Fprintf(b, "\t// %s\n", doc)
+			}
+		}
+		previous = p.Doc
+		fmt.Fprintf(b, "\t%s %s `json:\"%s\"`\n", name, typ, tag)
+		if primitive {
+			element := "*common.Element"
+			if p.Array {
+				element = "[]*common.Element"
+			}
+			fmt.Fprintf(b, "\t%sElement %s `json:\"_%s,omitempty\"`\n", name, element, p.Name)
+		}
+	}
+	b.WriteString("}\n\n")
+	b.WriteString(enums.String())
+	return nil
+}
+
+// fieldDoc returns the Go name and the comment of a property
+func (g *generator) fieldDoc(it *iface, p prop) (string, string) {
+	name, doc := fieldName(p.Name), summary(p.Doc)
+	refField, hasRef := g.ref.Structs[it.Name][p.Name]
+	if hasRef {
+		name = refField.Name
+	}
+	switch {
+	case g.style == styleFHIR3 && g.elementShorts[it.Name+"."+p.Name] != "":
+		doc = strings.TrimSuffix(g.elementShorts[it.Name+"."+p.Name], ".")
+	case g.style != styleFHIR3 && hasRef:
+		doc = refField.Doc
+	}
+	return name, doc
+}
+
+// fieldType returns the Go type of a property without pointer or slice and
+// whether it is a primitive with an element companion
+func (g *generator) fieldType(it *iface, p prop, enums *strings.Builder) (string, bool, error) {
+	if p.Enum != nil {
+		name, err := g.enumType(it, p, enums)
+		return name, true, err
+	}
+	switch p.Type {
+	case "string", "number", "boolean":
+		typ, err := g.primitiveType(it, p)
+		return typ, true, err
+	case "FhirResource", "Resource":
+		return "common.Resource", false, nil
+	}
+	typ, err := g.structType(p.Type)
+	return typ, false, err
+}
+
+// structType returns the Go type of a complex TypeScript type, preferring the
+// structs of the package over those of the common package
+func (g *generator) structType(name string) (string, error) {
+	if g.local.Types[name] || g.structs[name] {
+		return name, nil
+	}
+	if g.common.Types[name] {
+		return "common." + name, nil
+	}
+	if it, ok := g.byName[name]; ok && !abstractTypes[name] {
+		g.structs[name] = true
+		g.datatypes = append(g.datatypes, it.Name)
+		return name, nil
+	}
+	return "", fmt.Errorf("unknown type %s", name)
+}
+
+// primitiveType resolves the Go type of a TypeScript string, number or boolean
+func (g *generator) primitiveType(it *iface, p prop) (string, error) {
+	if p.Type == "boolean" {
+		return "bool", nil
+	}
+	if code, ok := g.profiles[it.Name+"."+p.Name]; ok && isPrimitiveCode(code) {
+		typ, err := fhirPrimitive(code)
+		if err != nil {
+			return "", err
+		}
+		if compatible(p.Type, typ) {
+			return typ, nil
+		}
+	}
+	if f, ok := g.ref.Structs[it.Name][p.Name]; ok {
+		if typ := refPrimitive(strings.TrimLeft(f.Type, "*[]")); compatible(p.Type, typ) {
+			return typ, nil
+		}
+	}
+	if p.Type == "number" {
+		for _, code := range []string{"Integer", "PositiveInt", "UnsignedInt", "Decimal"} {
+			if strings.HasSuffix(p.Name, code) {
+				return fhirPrimitive(lowerFirst(code))
+			}
+		}
+		if typ, ok := legacyNumbers[p.Name]; ok {
+			return typ, nil
+		}
+		if typ := g.numberType(p.Name); typ != "" {
+			return typ, nil
+		}
+		g.unresolved = append(g.unresolved, it.Name+"."+p.Name)
+		return "common.Decimal", nil
+	}
+	if _, ok := g.profiles[it.Name+"."+p.Name]; !ok {
+		g.unresolved = append(g.unresolved, it.Name+"."+p.Name)
+	}
+	return "string", nil
+}
+
+// numberType returns the Go type the reference package uses for all number
+// properties of the given name, or an empty string if there is none or they differ
+func (g *generator) numberType(property string) string {
+	found := ""
+	for _, fields := range g.ref.Structs {
+		f, ok := fields[property]
+		if !ok {
+			continue
+		}
+		typ := refPrimitive(strings.TrimLeft(f.Type, "*[]"))
+		if !compatible("number", typ) {
+			continue
+		}
+		if found != "" && found != typ {
+			return ""
+		}
+		found = typ
+	}
+	return found
+}
+
+// refPrimitive normalizes the Go type of a primitive field of the reference package
+func refPrimitive(typ string) string {
+	switch typ {
+	case "int", "bool", "common.Decimal", "common.Date", "common.DateTime", "common.Instant", "common.Time":
+		return typ
+	case "float64":
+		return "common.Decimal"
+	}
+	return "string"
+}
+
+// fhirPrimitive returns the Go type of a FHIR primitive type. The types of the
+// FHIRPath system, e.g. http://hl7.org/fhirpath/System.String, are those of the
+// id and value elements of the primitives themselves.
+func fhirPrimitive(code string) (string, error) {
+	switch strings.TrimPrefix(code, fhirPathSystem) {
+	case "boolean", "Boolean":
+		return "bool", nil
+	case "integer", "positiveInt", "unsignedInt", "Integer":
+		return "int", nil
+	case "decimal", "Decimal":
+		return "common.Decimal", nil
+	case "date", "Date":
+		return "common.Date", nil
+	case "dateTime", "DateTime":
+		return "common.DateTime", nil
+	case "instant":
+		return "common.Instant", nil
+	case "time", "Time":
+		return "common.Time", nil
+	case "string", "String", "code", "id", "markdown", "uri", "url", "canonical", "oid", "uuid",
+		"base64Binary", "xhtml", "integer64":
+		// integer64 is a string in JSON
+		return "string", nil
+	}
+	return "", fmt.Errorf("unknown primitive type %s", code)
+}
+
+const fhirPathSystem = "http://hl7.org/fhirpath/System."
+
+// isPrimitiveCode reports whether a type code names a primitive type rather
+// than a complex datatype, which other versions may use for the same element
+func isPrimitiveCode(code string) bool {
+	return strings.HasPrefix(code, fhirPathSystem) || code != "" && code[0] >= 'a' && code[0] <= 'z'
+}
+
+// compatible reports whether a Go type can hold the JSON of a TypeScript primitive
+func compatible(ts, typ string) bool {
+	if ts == "number" {
+		return typ == "int" || typ == "common.Decimal"
+	}
+	return typ != "int" && typ != "common.Decimal" && typ != "bool"
+}
+
+// enumType returns the code type of a string literal union, declaring it
+// unless the package already has a code type with the same values
+func (g *generator) enumType(it *iface, p prop, enums *strings.Builder) (string, error) {
+	var candidates []string
+	if f, ok := g.ref.Structs[it.Name][p.Name]; ok {
+		if typ := strings.TrimLeft(f.Type, "*[]"); g.ref.Consts[typ] != nil {
+			candidates = append(candidates, typ)
+		}
+	}
+	name := it.Name + upperFirst(p.Name)
+	candidates = append(candidates, name, name+"Code")
+
+	for _, c := range candidates {
+		if values, ok := g.enums[c]; ok {
+			if sameValues(values, p.Enum) {
+				return c, nil
+			}
+			continue
+		}
+		if g.local.Types[c] || g.structs[c] || g.byName[c] != nil {
+			continue
+		}
+		g.enums[c] = p.Enum
+		g.writeEnum(enums, c, it, p)
+		return c, nil
+	}
+	return "", fmt.Errorf("no name for the code type of %s.%s", it.Name, p.Name)
+}
+
+func (g *generator) writeEnum(b *strings.Builder, name string, it *iface, p prop) {
+	doc := g.ref.Docs[name]
+	if doc == "" || g.style == styleFHIR3 {
+		doc = fmt.Sprintf("%s represents the %s of %s", name, words(p.Name), article(words(it.Name)))
+	}
+	fmt.Fprintf(b, "// %s\ntype %s string\n\nconst (\n", doc, name)
+	seen := map[string]bool{}
+	for _, value := range p.Enum {
+		constName, ok := g.ref.Consts[name][value]
+		if !ok {
+			constName = name + codeName(value)
+		}
+		for i := 2; seen[constName]; i++ {
+			constName = fmt.Sprintf("%s%s%d", name, codeName(value), i)
+		}
+		seen[constName] = true
+		fmt.Fprintf(b, "\t%s %s = %q\n", constName, name, value)
+	}
+	b.WriteString(")\n\n")
+}
+
+func sameValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := map[string]bool{}
+	for _, v := range a {
+		set[v] = true
+	}
+	for _, v := range b {
+		if !set[v] {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldName returns the Go name of a JSON property
+func fieldName(property string) string {
+	if name, ok := fieldNames[property]; ok {
+		return name
+	}
+	return upperFirst(property)
+}
+
+// codeName returns the Go name of a code, e.g. "InProgress" for "in-progress"
+func codeName(code string) string {
+	if name, ok := codeNames[code]; ok {
+		return name
+	}
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(code, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		b.WriteString(upperFirst(part))
+	}
+	if b.Len() == 0 {
+		return "Value"
+	}
+	return b.String()
+}
+
+var (
+	linkRe      = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	sentenceEnd = regexp.MustCompile(`[^.]\. `)
+)
+
+// maxDoc is the length beyond which a summary is cut at a clause boundary
+const maxDoc = 120
+
+// summary shortens a TypeScript doc comment to the first sentence without its
+// period, long sentences are cut at their last clause boundary
+func summary(doc string) string {
+	doc = linkRe.ReplaceAllString(doc, "$1")
+	for _, loc := range sentenceEnd.FindAllStringIndex(doc, -1) {
+		before := doc[:loc[0]+1]
+		if strings.HasSuffix(before, "e.g") || strings.HasSuffix(before, "i.e") || strings.HasSuffix(before, "etc") {
+			continue
+		}
+		doc = before
+		break
+	}
+	doc = strings.TrimSuffix(strings.TrimSpace(doc), ".")
+	if len(doc) > maxDoc {
+		cut := -1
+		for _, sep := range []string{", ", " (", "; ", " - "} {
+			if i := strings.LastIndex(doc[:maxDoc], sep); i > cut {
+				cut = i
+			}
+		}
+		if cut > maxDoc/4 {
+			doc = doc[:cut]
+		}
+		if open := strings.LastIndex(doc, " ("); open > maxDoc/4 && open > strings.LastIndex(doc, ")") {
+			doc = doc[:open]
+		}
+	}
+	return doc
+}
+
+// structDoc builds the comment of a struct from the short description of its
+// element or the TypeScript comment. Comments that explain a condition or a
+// rule, such as "If messaging is supported", rather than the element itself
+// are replaced by the name of the element that holds the struct.
+func (g *generator) structDoc(it *iface) string {
+	short := g.shorts[it.Name]
+	if short == "" || startsWith(short, "If", "Whether") {
+		short = it.Doc
+	}
+	short = strings.TrimSuffix(summary(short), "?")
+	for _, prefix := range []string{"This resource ", "The " + it.Name + " "} {
+		if rest, ok := strings.CutPrefix(short, prefix); ok && verbs[firstWord(rest)] {
+			short = rest
+		}
+	}
+	if short == "" || startsWith(short, "If", "Whether", "This", "The") {
+		for _, owner := range g.ifaces {
+			for _, p := range owner.Props {
+				if p.Type == it.Name {
+					return fmt.Sprintf("%s represents the %s of %s", it.Name, words(p.Name), article(words(owner.Name)))
+				}
+			}
+		}
+	}
+	if verbs[firstWord(short)] {
+		return it.Name + " " + lowerFirst(short)
+	}
+	if fields := strings.Fields(short); len(fields) > 1 && unicode.IsUpper(rune(fields[1][0])) {
+		// titles such as "Audit Event Reporter" keep their capitals
+		return it.Name + " represents " + short
+	}
+	return it.Name + " represents " + lowerFirst(short)
+}
+
+// verbs start the descriptions that read as a sentence after the struct
+// name, e.g. "Tracks balance, charges, for patient or cost center"
+var verbs = map[string]bool{
+	"contains":   true,
+	"deals":      true,
+	"declares":   true,
+	"defines":    true,
+	"describes":  true,
+	"identifies": true,
+	"indicates":  true,
+	"allows":     true,
+	"provides":   true,
+	"represents": true,
+	"tracks":     true,
+}
+
+func firstWord(s string) string {
+	first, _, _ := strings.Cut(s, " ")
+	return strings.ToLower(first)
+}
+
+func startsWith(s string, words ...string) bool {
+	first, _, _ := strings.Cut(s, " ")
+	for _, w := range words {
+		if first == w {
+			return true
+		}
+	}
+	return false
+}
+
+// lowerFirst lowercases the first letter unless it starts an acronym
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	if len(s) > 1 && unicode.IsUpper(rune(s[1])) {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// words splits a Go or JSON name into lowercase words, e.g. "care team" for "CareTeam"
+func words(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func article(noun string) string {
+	if strings.ContainsRune("aeiou", rune(noun[0])) {
+		return "an " + noun
+	}
+	return "a " + noun
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const generatedSuffix = "_gen.go"
+
+// goPackage describes the declarations of a Go package the generator reuses:
+// names, types and comments of struct fields and the constants of code types
+type goPackage struct {
+	Types   map[string]bool
+	Docs    map[string]string
+	Structs map[string]map[string]goField
+	Consts  map[string]map[string]string
+}
+
+// goField is a struct field keyed by its JSON property
+type goField struct {
+	Name string
+	Type string
+	Doc  string
+}
+
+// loadGoPackage parses the hand-written sources of the package in dir, a
+// missing directory yields an empty package
+func loadGoPackage(dir string) (*goPackage, error) {
+	pkg := &goPackage{
+		Types:   map[string]bool{},
+		Docs:    map[string]string{},
+		Structs: map[string]map[string]goField{},
+		Consts:  map[string]map[string]string{},
+	}
+	if dir == "" {
+		return pkg, nil
+	}
+
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), generatedSuffix)
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pkgs {
+		for _, file := range p.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						pkg.addType(gen, spec)
+					case *ast.ValueSpec:
+						pkg.addConst(gen, spec)
+					}
+				}
+			}
+		}
+	}
+	return pkg, nil
+}
+
+func (pkg *goPackage) addType(gen *ast.GenDecl, spec *ast.TypeSpec) {
+	name := spec.Name.Name
+	pkg.Types[name] = true
+	if doc := spec.Doc; doc != nil {
+		pkg.Docs[name] = comment(doc)
+	} else if gen.Doc != nil {
+		pkg.Docs[name] = comment(gen.Doc)
+	}
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return
+	}
+	fields := map[string]goField{}
+	doc := ""
+	for _, field := range st.Fields.List {
+		if field.Doc != nil {
+			doc = comment(field.Doc)
+		}
+		if len(field.Names) == 0 || field.Tag == nil {
+			continue
+		}
+		tag, _ := strconv.Unquote(field.Tag.Value)
+		property := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+		fields[property] = goField{Name: field.Names[0].Name, Type: exprString(field.Type), Doc: doc}
+	}
+	pkg.Structs[name] = fields
+}
+
+func (pkg *goPackage) addConst(gen *ast.GenDecl, spec *ast.ValueSpec) {
+	if gen.Tok != token.CONST || len(spec.Names) != 1 || len(spec.Values) != 1 {
+		return
+	}
+	typ, ok := spec.Type.(*ast.Ident)
+	lit, isLit := spec.Values[0].(*ast.BasicLit)
+	if !ok || !isLit || lit.Kind != token.STRING {
+		return
+	}
+	value, _ := strconv.Unquote(lit.Value)
+	if pkg.Consts[typ.Name] == nil {
+		pkg.Consts[typ.Name] = map[string]string{}
+	}
+	pkg.Consts[typ.Name][value] = spec.Names[0].Name
+}
+
+// comment returns the text of a comment group on a single line
+func comment(group *ast.CommentGroup) string {
+	return strings.Join(strings.Fields(group.Text()), " ")
+}
+
+func exprString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return exprString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprString(t.X)
+	case *ast.ArrayType:
+		return "[]" + exprString(t.Elt)
+	}
+	return ""
+}
@@ -0,0 +1,85 @@
+// Command dtsgen generates the resource structs of a FHIR version package from
+// the TypeScript definitions in js/. It is run through go:generate from within
+// the package directory and writes a file per resource that the package does
+// not declare yet, together with its backbone elements, code types and the
+// datatypes it needs that neither the package nor the common package provide,
+// each in a file with its own elements. Existing files are never touched, so hand-written changes to the generated
+// structs survive later runs.
+//
+// The TypeScript types do not distinguish integer from decimal numbers or
+// dates from strings. The Go types of those primitives are taken from the
+// element types of the StructureDefinitions given with -profiles, from the same
+// field of the reference package given with -ref or, failing both, default to
+// decimal and string. -profiles lists the definitions of the version first and
+// those of R5, which also provide the comments, last. A type code that is no
+// known FHIR primitive is an error.
+//
+// With -style fhir3 the structs follow the conventions of the R3 and R2
+// packages instead of those of the R4 packages.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	dts := flag.String("dts", "", "TypeScript definitions of the FHIR version")
+	dir := flag.String("dir", ".", "directory of the FHIR version package")
+	commonDir := flag.String("common", "../common", "directory of the common package")
+	ref := flag.String("ref", "", "directory of a version package whose field types and comments are reused")
+	profiles := flag.String("profiles", "", "directories of the StructureDefinitions used for primitive types and comments, separated by commas")
+	style := flag.String("style", styleFHIR4, "conventions of the generated structs, fhir4 or fhir3")
+	flag.Parse()
+
+	if *dts == "" {
+		log.Fatal("dtsgen: -dts is required")
+	}
+	g, err := newGenerator(*dts, *dir, *commonDir, *ref, *profiles, *style)
+	if err != nil {
+		log.Fatal(err)
+	}
+	files, err := g.generate()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, f := range files {
+		path := filepath.Join(*dir, f.name)
+		if _, err := os.Stat(path); err == nil {
+			log.Fatalf("dtsgen: %s already exists", path)
+		}
+		src, err := format.Source([]byte(f.src))
+		if err != nil {
+			log.Fatalf("dtsgen: formatting %s: %v", path, err)
+		}
+		if err := os.WriteFile(path, src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	for _, u := range g.unresolved {
+		fmt.Fprintf(os.Stderr, "dtsgen: no primitive type for %s, using the default\n", u)
+	}
+}
+
+// file is a generated source file
+type file struct {
+	name string
+	src  string
+}
+
+// snakeCase converts a type name into a file name, e.g. "CareTeam" into "care_team"
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToLower(b.String())
+}
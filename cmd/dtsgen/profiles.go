@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// loadProfiles reads the base StructureDefinitions in the directories listed in
+// profiles, separated by commas, either single *.profile.json files or the
+// profiles-*.json bundles of the FHIR definitions. It returns the type codes of
+// every element keyed by struct and property name, e.g. "GoalTarget.detailInteger"
+// for the integer type of Goal.target.detail[x], the short descriptions of the
+// elements with children keyed by struct name and the short descriptions of all
+// elements keyed like their types. An earlier directory takes precedence, so the
+// definitions of the version itself can come first and those of R5, which carry
+// the short descriptions, last.
+func loadProfiles(profiles string) (types, shorts, elementShorts map[string]string, err error) {
+	types, shorts, elementShorts = map[string]string{}, map[string]string{}, map[string]string{}
+	for _, dir := range strings.Split(profiles, ",") {
+		if dir = strings.TrimSpace(dir); dir == "" {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(dir, "*.profile.json"))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		bundles, err := filepath.Glob(filepath.Join(dir, "profiles-*.json"))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		files = append(files, bundles...)
+		sort.Strings(files)
+
+		dirTypes := map[string]string{}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			var resource struct {
+				ResourceType string `json:"resourceType"`
+				Entry        []struct {
+					Resource json.RawMessage `json:"resource"`
+				} `json:"entry"`
+			}
+			if err := json.Unmarshal(data, &resource); err != nil {
+				continue
+			}
+			entries := []json.RawMessage{data}
+			if resource.ResourceType == "Bundle" {
+				entries = entries[:0]
+				for _, entry := range resource.Entry {
+					entries = append(entries, entry.Resource)
+				}
+			}
+			for _, entry := range entries {
+				readProfile(entry, dirTypes, shorts, elementShorts)
+			}
+		}
+		for key, code := range dirTypes {
+			if _, ok := types[key]; !ok {
+				types[key] = code
+			}
+		}
+	}
+	return types, shorts, elementShorts, nil
+}
+
+// readProfile adds the element types and short descriptions of a
+// StructureDefinition, keeping the short descriptions already known
+func readProfile(data []byte, types, shorts, elementShorts map[string]string) {
+	var sd struct {
+		ResourceType string `json:"resourceType"`
+		Derivation   string `json:"derivation"`
+		Snapshot     struct {
+			Element []struct {
+				Path  string `json:"path"`
+				Short string `json:"short"`
+				Type  []struct {
+					Code string `json:"code"`
+				} `json:"type"`
+			} `json:"element"`
+		} `json:"snapshot"`
+	}
+	if err := json.Unmarshal(data, &sd); err != nil || sd.ResourceType != "StructureDefinition" || sd.Derivation == "constraint" {
+		return
+	}
+	short := func(m map[string]string, key, value string) {
+		if m[key] == "" {
+			m[key] = value
+		}
+	}
+	for _, element := range sd.Snapshot.Element {
+		if len(element.Type) == 0 || element.Type[0].Code == "BackboneElement" || element.Type[0].Code == "Element" {
+			short(shorts, structName(element.Path), element.Short)
+		}
+		i := strings.LastIndexByte(element.Path, '.')
+		if i < 0 || len(element.Type) == 0 {
+			continue
+		}
+		parent, name := structName(element.Path[:i]), element.Path[i+1:]
+		if base, ok := strings.CutSuffix(name, "[x]"); ok {
+			for _, t := range element.Type {
+				types[parent+"."+base+upperFirst(t.Code)] = t.Code
+				short(elementShorts, parent+"."+base+upperFirst(t.Code), element.Short)
+			}
+			continue
+		}
+		types[parent+"."+name] = element.Type[0].Code
+		short(elementShorts, parent+"."+name, element.Short)
+	}
+}
+
+// structName converts an element path into the name of the struct modelling it
+func structName(path string) string {
+	var b strings.Builder
+	for _, segment := range strings.Split(path, ".") {
+		b.WriteString(upperFirst(strings.TrimSuffix(segment, "[x]")))
+	}
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
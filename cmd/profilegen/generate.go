@@ -0,0 +1,767 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/profile"
+)
+
+const (
+	commonPackage  = "github.com/d4l-data4life/go-fhir/pkg/common"
+	profilePackage = "github.com/d4l-data4life/go-fhir/pkg/profile"
+)
+
+// versionPackages allocate the resources of the version packages by name
+var versionPackages = map[string]func(resourceType string) (common.Resource, bool){
+	"fhir2":  fhir2.NewResource,
+	"fhir3":  fhir3.NewResource,
+	"fhir4":  fhir4.NewResource,
+	"fhir4b": fhir4b.NewResource,
+	"fhir5":  fhir5.NewResource,
+}
+
+// fhirVersions are the version packages by the major and minor FHIR version
+var fhirVersions = map[string]string{
+	"1.0": "fhir2",
+	"3.0": "fhir3",
+	"4.0": "fhir4",
+	"4.3": "fhir4b",
+	"5.0": "fhir5",
+}
+
+// fileInfo is the data of the file template
+type fileInfo struct {
+	Package    string
+	Imports    []string
+	Profiles   []profileInfo
+	Extensions []extensionInfo
+}
+
+// profileInfo describes the wrapper of a resource profile
+type profileInfo struct {
+	Name  string
+	URL   string
+	Title string
+
+	// Type is the wrapped resource struct, e.g. fhir5.Observation, Field the
+	// name of the embedded field
+	Type  string
+	Field string
+
+	// Fixed is the JSON of a new resource conforming to the profile
+	Fixed string
+
+	Slices     []sliceInfo
+	Extensions []extensionInfo
+}
+
+// sliceInfo describes the accessors of a named slice
+type sliceInfo struct {
+	Method string
+
+	// Element is the sliced element with the name of the slice, e.g. component:SystolicBP
+	Element string
+
+	// Field is the Go field of the sliced element, Type the Go type of its items
+	Field string
+	Type  string
+
+	// Pattern is the JSON the items of the slice match, Fixed the JSON of a new item
+	Pattern string
+	Fixed   string
+	Repeats bool
+}
+
+// extensionInfo describes the accessors of an extension
+type extensionInfo struct {
+	Name  string
+	Title string
+	URL   string
+
+	// Field is the Go field holding the extension in a wrapper, Extension or
+	// ModifierExtension
+	Field string
+
+	// Value is the value[x] field of common.Extension holding the value of a
+	// simple extension, Type its Go type. Complex extensions have no value
+	// field and are accessed as *common.Extension.
+	Value   string
+	Type    string
+	Repeats bool
+}
+
+// generator collects the wrappers of the definitions it is given
+type generator struct {
+	pkg       string
+	fhir      string
+	resolver  profile.Resolver
+	snapshots *profile.Generator
+
+	imports    map[string]bool
+	names      map[string]string
+	profiles   []profileInfo
+	extensions []extensionInfo
+	warnings   []string
+	warned     map[string]bool
+}
+
+func newGenerator(pkg, fhir string, resolver profile.Resolver) *generator {
+	return &generator{
+		pkg:       pkg,
+		fhir:      fhir,
+		resolver:  resolver,
+		snapshots: profile.NewGenerator(resolver),
+		imports:   map[string]bool{profilePackage: true},
+		names:     map[string]string{},
+		warned:    map[string]bool{},
+	}
+}
+
+func (g *generator) warn(format string, args ...interface{}) {
+	w := fmt.Sprintf(format, args...)
+	if !g.warned[w] {
+		g.warned[w] = true
+		g.warnings = append(g.warnings, w)
+	}
+}
+
+// name returns the Go name of a definition, which must be unique in the file
+func (g *generator) name(sd *fhir5.StructureDefinition) (string, error) {
+	name := goName(sd.Name)
+	if other, ok := g.names[name]; ok {
+		return "", fmt.Errorf("profilegen: %s and %s are both named %s", other, sd.Url, name)
+	}
+	g.names[name] = sd.Url
+	return name, nil
+}
+
+// add generates the wrapper of a resource profile or the accessors of an
+// extension definition
+func (g *generator) add(sd *fhir5.StructureDefinition) error {
+	sd, err := g.snapshot(sd)
+	if err != nil {
+		return err
+	}
+	switch {
+	case sd.Type == "Extension":
+		name, err := g.name(sd)
+		if err != nil {
+			return err
+		}
+		info, err := g.extension(sd.Url, name, nil, "")
+		if err != nil {
+			return err
+		}
+		info.Title = title(sd)
+		g.extensions = append(g.extensions, info)
+		return nil
+	case sd.Kind == fhir5.StructureDefinitionKindResource:
+		return g.profile(sd)
+	}
+	g.warn("%s: only resource profiles and extensions are supported", sd.Url)
+	return nil
+}
+
+// snapshot returns a definition with its snapshot
+func (g *generator) snapshot(sd *fhir5.StructureDefinition) (*fhir5.StructureDefinition, error) {
+	if sd.Snapshot != nil && len(sd.Snapshot.Element) > 0 {
+		return sd, nil
+	}
+	withSnapshot, err := g.snapshots.Snapshot(sd)
+	if err != nil {
+		return nil, fmt.Errorf("profilegen: %s: %w", sd.Url, err)
+	}
+	return withSnapshot, nil
+}
+
+// versionPackage returns the version package of a profile
+func (g *generator) versionPackage(sd *fhir5.StructureDefinition) (func(string) (common.Resource, bool), error) {
+	name := g.fhir
+	if name == "" && sd.FhirVersion != nil {
+		parts := strings.SplitN(*sd.FhirVersion, ".", 3)
+		if len(parts) >= 2 {
+			name = fhirVersions[parts[0]+"."+parts[1]]
+		}
+	}
+	newResource, ok := versionPackages[name]
+	if !ok {
+		return nil, fmt.Errorf("profilegen: %s: no version package for its FHIR version, use -fhir", sd.Url)
+	}
+	return newResource, nil
+}
+
+func (g *generator) profile(sd *fhir5.StructureDefinition) error {
+	newResource, err := g.versionPackage(sd)
+	if err != nil {
+		return err
+	}
+	resource, ok := newResource(sd.Type)
+	if !ok {
+		return fmt.Errorf("profilegen: %s: %s is not a resource of the version package", sd.Url, sd.Type)
+	}
+	name, err := g.name(sd)
+	if err != nil {
+		return err
+	}
+	t := reflect.TypeOf(resource).Elem()
+	g.imports[t.PkgPath()] = true
+
+	els := newElements(sd)
+	root := elementID(&sd.Snapshot.Element[0])
+	fixed := els.object(root, true)
+	fixed["resourceType"] = sd.Type
+	meta, _ := fixed["meta"].(map[string]interface{})
+	if meta == nil {
+		meta = map[string]interface{}{}
+	}
+	meta["profile"] = []interface{}{sd.Url}
+	fixed["meta"] = meta
+
+	info := profileInfo{
+		Name:  name,
+		URL:   sd.Url,
+		Title: title(sd),
+		Type:  t.String(),
+		Field: t.Name(),
+		Fixed: jsonLiteral(fixed),
+	}
+	methods := map[string]bool{}
+	for _, c := range els.children[root] {
+		name := lastName(c.base.Path)
+		if len(c.slices) == 0 || c.base.Slicing == nil || strings.HasSuffix(name, "[x]") {
+			continue
+		}
+		field, ok := jsonField(t, name)
+		if !ok || field.Type.Kind() != reflect.Slice || field.Type.Elem().Kind() != reflect.Struct {
+			g.warn("%s: %s has no slice of structs to hold its slices", sd.Url, c.base.Path)
+			continue
+		}
+		for _, s := range c.slices {
+			method := goName(*s.SliceName)
+			if methods[method] {
+				g.warn("%s: the slice %s has the name of another accessor", sd.Url, elementID(s))
+				continue
+			}
+			methods[method] = true
+			if name == "extension" || name == "modifierExtension" {
+				extension, err := g.sliceExtension(sd, els, s, method, field.Name)
+				if err != nil {
+					g.warn("%s", err)
+					continue
+				}
+				info.Extensions = append(info.Extensions, extension)
+				continue
+			}
+			slice, err := els.slice(c.base, s)
+			if err != nil {
+				g.warn("%s: %s: %v", sd.Url, elementID(s), err)
+				continue
+			}
+			slice.Method = method
+			slice.Element = name + ":" + *s.SliceName
+			slice.Field = field.Name
+			slice.Type = field.Type.Elem().String()
+			g.imports[field.Type.Elem().PkgPath()] = true
+			info.Slices = append(info.Slices, slice)
+		}
+	}
+	g.profiles = append(g.profiles, info)
+	return nil
+}
+
+// sliceExtension returns the accessors of an extension slice of a profile
+func (g *generator) sliceExtension(sd *fhir5.StructureDefinition, els *elements, s *fhir5.ElementDefinition, method, field string) (extensionInfo, error) {
+	if len(s.Type) != 1 || len(s.Type[0].Profile) != 1 {
+		return extensionInfo{}, fmt.Errorf("%s: %s has no single extension definition", sd.Url, elementID(s))
+	}
+	info, err := g.extension(s.Type[0].Profile[0], method, els, elementID(s))
+	if err != nil {
+		return extensionInfo{}, err
+	}
+	info.Title = *s.SliceName
+	info.Field = field
+	info.Repeats = s.Max == nil || *s.Max != "1"
+	return info, nil
+}
+
+// extension returns the accessors of an extension by the value[x] of its
+// definition, or of the extension slice of a profile if the definition is not
+// available
+func (g *generator) extension(url, name string, els *elements, sliceID string) (extensionInfo, error) {
+	url, _, _ = strings.Cut(url, "|")
+	info := extensionInfo{Name: name, URL: url, Type: "*common.Extension"}
+	g.imports[commonPackage] = true
+
+	var value *fhir5.ElementDefinition
+	if sd, err := g.resolver.StructureDefinition(url); err == nil {
+		if sd, err = g.snapshot(sd); err != nil {
+			return extensionInfo{}, err
+		}
+		value = newElements(sd).byID["Extension.value[x]"]
+	} else if els != nil && els.byID[sliceID+".value[x]"] != nil {
+		value = els.byID[sliceID+".value[x]"]
+	} else {
+		g.warn("the definition of the extension %s is not available, it is accessed as common.Extension", url)
+		return info, nil
+	}
+	if value == nil || (value.Max != nil && *value.Max == "0") || len(value.Type) != 1 {
+		return info, nil
+	}
+	field, ok := reflect.TypeOf(common.Extension{}).FieldByName("Value" + upperFirst(value.Type[0].Code))
+	if !ok {
+		g.warn("common.Extension has no value of type %s for the extension %s", value.Type[0].Code, url)
+		return info, nil
+	}
+	info.Value = field.Name
+	info.Type = field.Type.String()
+	return info, nil
+}
+
+// file returns the data of the file template
+func (g *generator) file() *fileInfo {
+	info := &fileInfo{Package: g.pkg, Profiles: g.profiles, Extensions: g.extensions}
+	for path := range g.imports {
+		info.Imports = append(info.Imports, path)
+	}
+	sort.Strings(info.Imports)
+	return info
+}
+
+// child is a child element and its slices
+type child struct {
+	base   *fhir5.ElementDefinition
+	slices []*fhir5.ElementDefinition
+}
+
+// elements indexes the snapshot elements of a definition by id and parent
+type elements struct {
+	byID     map[string]*fhir5.ElementDefinition
+	children map[string][]*child
+}
+
+func newElements(sd *fhir5.StructureDefinition) *elements {
+	els := &elements{byID: map[string]*fhir5.ElementDefinition{}, children: map[string][]*child{}}
+	bases := map[string]*child{}
+	for i := range sd.Snapshot.Element {
+		e := &sd.Snapshot.Element[i]
+		id := elementID(e)
+		els.byID[id] = e
+		dot := strings.LastIndex(id, ".")
+		if dot < 0 {
+			continue
+		}
+		parent, name := id[:dot], id[dot+1:]
+		if name, _, sliced := strings.Cut(name, ":"); sliced {
+			if c, ok := bases[parent+"."+name]; ok {
+				c.slices = append(c.slices, e)
+			}
+			continue
+		}
+		c := &child{base: e}
+		bases[id] = c
+		els.children[parent] = append(els.children[parent], c)
+	}
+	return els
+}
+
+// object returns the JSON object of the fixed and pattern values of the
+// descendants of an element, of the required descendants only if required
+func (els *elements) object(id string, required bool) map[string]interface{} {
+	obj := map[string]interface{}{}
+	for _, c := range els.children[id] {
+		name := lastName(c.base.Path)
+		if base, ok := strings.CutSuffix(name, "[x]"); ok {
+			els.choice(obj, base, c, required)
+			continue
+		}
+		var items []interface{}
+		for _, e := range append([]*fhir5.ElementDefinition{c.base}, c.slices...) {
+			if v, ok := els.value(e, required); ok {
+				items = append(items, v)
+			}
+		}
+		switch {
+		case len(items) == 0:
+		case c.base.Max != nil && *c.base.Max == "1":
+			obj[name] = items[0]
+		default:
+			obj[name] = items
+		}
+	}
+	return obj
+}
+
+// choice adds the values of a choice element and its type slices, named by
+// their type, e.g. valueQuantity
+func (els *elements) choice(obj map[string]interface{}, base string, c *child, required bool) {
+	for _, s := range c.slices {
+		if v, ok := els.value(s, required); ok {
+			obj[*s.SliceName] = v
+		}
+	}
+	v, ok := els.value(c.base, required)
+	if !ok {
+		return
+	}
+	if _, typeName := c.base.Fixed(); typeName != "" {
+		obj[base+typeName] = v
+	} else if _, typeName := c.base.Pattern(); typeName != "" {
+		obj[base+typeName] = v
+	} else if len(c.base.Type) == 1 {
+		obj[base+upperFirst(c.base.Type[0].Code)] = v
+	}
+}
+
+// value returns the fixed or pattern value of an element combined with the
+// values of its descendants
+func (els *elements) value(e *fhir5.ElementDefinition, required bool) (interface{}, bool) {
+	if required && (e.Min == nil || *e.Min == 0) {
+		return nil, false
+	}
+	return els.instance(e, required)
+}
+
+// instance is value regardless of whether the element itself is required
+func (els *elements) instance(e *fhir5.ElementDefinition, required bool) (interface{}, bool) {
+	var v interface{}
+	if fixed, _ := e.Fixed(); fixed != nil {
+		v = genericValue(fixed)
+	} else if pattern, _ := e.Pattern(); pattern != nil {
+		v = genericValue(pattern)
+	}
+	if obj := els.object(elementID(e), required); len(obj) > 0 {
+		v = mergeValues(v, obj)
+	}
+	return v, v != nil
+}
+
+// slice returns the pattern the items of a slice match by the discriminators
+// of the slicing and the JSON of a new item
+func (els *elements) slice(sliced, s *fhir5.ElementDefinition) (sliceInfo, error) {
+	all, _ := els.instance(s, false)
+
+	var pattern interface{}
+	for _, d := range sliced.Slicing.Discriminator {
+		switch d.Type {
+		case fhir5.ElementDefinitionSlicingDiscriminatorTypeValue, fhir5.ElementDefinitionSlicingDiscriminatorTypePattern:
+		case fhir5.ElementDefinitionSlicingDiscriminatorTypeExists:
+			continue
+		default:
+			return sliceInfo{}, fmt.Errorf("the %s discriminator is not supported", d.Type)
+		}
+		if strings.Contains(d.Path, "(") {
+			return sliceInfo{}, fmt.Errorf("the discriminator path %s is not supported", d.Path)
+		}
+		var path []string
+		if d.Path != "$this" {
+			path = strings.Split(d.Path, ".")
+		}
+		pattern = mergeValues(pattern, project(all, path))
+	}
+	if pattern == nil {
+		return sliceInfo{}, fmt.Errorf("the slice has no values for the discriminators")
+	}
+	fixed, _ := els.instance(s, true)
+	return sliceInfo{
+		Pattern: jsonLiteral(pattern),
+		Fixed:   jsonLiteral(mergeValues(fixed, pattern)),
+		Repeats: s.Max == nil || *s.Max != "1",
+	}, nil
+}
+
+// project returns the parts of a JSON value along a path, nil if the value has
+// nothing at the path
+func project(v interface{}, path []string) interface{} {
+	if len(path) == 0 {
+		return v
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		p := project(v[path[0]], path[1:])
+		if p == nil {
+			return nil
+		}
+		return map[string]interface{}{path[0]: p}
+	case []interface{}:
+		var items []interface{}
+		for _, item := range v {
+			if p := project(item, path); p != nil {
+				items = append(items, p)
+			}
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		return items
+	}
+	return nil
+}
+
+// mergeValues merges two JSON values, objects by their properties and arrays
+// by the position of their items. Other values of a are kept.
+func mergeValues(a, b interface{}) interface{} {
+	switch x := a.(type) {
+	case nil:
+		return b
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok {
+			return a
+		}
+		merged := map[string]interface{}{}
+		for key, value := range x {
+			merged[key] = value
+		}
+		for key, value := range y {
+			merged[key] = mergeValues(merged[key], value)
+		}
+		return merged
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok {
+			return a
+		}
+		merged := append([]interface{}{}, x...)
+		for i, value := range y {
+			if i < len(merged) {
+				merged[i] = mergeValues(merged[i], value)
+			} else {
+				merged = append(merged, value)
+			}
+		}
+		return merged
+	}
+	return a
+}
+
+// jsonField returns the field of a struct, including promoted fields, by its
+// JSON name
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for _, f := range reflect.VisibleFields(t) {
+		if f.Anonymous || !f.IsExported() {
+			continue
+		}
+		if strings.Split(f.Tag.Get("json"), ",")[0] == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func elementID(e *fhir5.ElementDefinition) string {
+	if e.ID != nil {
+		return *e.ID
+	}
+	return e.Path
+}
+
+func lastName(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+func title(sd *fhir5.StructureDefinition) string {
+	if sd.Title != nil {
+		return *sd.Title
+	}
+	return sd.Name
+}
+
+func genericValue(value interface{}) interface{} {
+	data, _ := json.Marshal(value)
+	var generic interface{}
+	_ = json.Unmarshal(data, &generic)
+	return generic
+}
+
+// jsonLiteral returns the JSON of a value without escaping HTML characters
+func jsonLiteral(value interface{}) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(value)
+	return strings.TrimSpace(buf.String())
+}
+
+// goName turns a name into an exported Go identifier, e.g. us-core-race into UsCoreRace
+func goName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// quote returns a Go string literal, a raw string unless it contains a backtick
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+var fileTemplate = template.Must(template.New("file").Funcs(template.FuncMap{"quote": quote}).Parse(`// Code generated by profilegen; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+)
+{{range .Profiles}}
+{{- $p := .}}
+// {{.Name}}URL is the canonical URL of the profile {{.Title}}
+const {{.Name}}URL = "{{.URL}}"
+
+// {{.Name}} is a {{.Type}} conforming to the profile {{.Title}}
+type {{.Name}} struct {
+	*{{.Type}}
+}
+
+// New{{.Name}} returns a new {{.Field}} with the fixed and pattern values of
+// the required elements of the profile and the profile in meta.profile
+func New{{.Name}}() *{{.Name}} {
+	r := &{{.Type}}{}
+	profile.MustUnmarshal({{quote .Fixed}}, r)
+	return &{{.Name}}{ {{- .Field}}: r}
+}
+{{range .Slices}}
+{{- if .Repeats}}
+// {{.Method}} returns the items of the slice {{.Element}}
+func (p *{{$p.Name}}) {{.Method}}() []*{{.Type}} {
+	var items []*{{.Type}}
+	for i := range p.{{$p.Field}}.{{.Field}} {
+		if profile.Matches(&p.{{$p.Field}}.{{.Field}}[i], {{quote .Pattern}}) {
+			items = append(items, &p.{{$p.Field}}.{{.Field}}[i])
+		}
+	}
+	return items
+}
+{{- else}}
+// {{.Method}} returns the item of the slice {{.Element}}, nil if it is absent
+func (p *{{$p.Name}}) {{.Method}}() *{{.Type}} {
+	for i := range p.{{$p.Field}}.{{.Field}} {
+		if profile.Matches(&p.{{$p.Field}}.{{.Field}}[i], {{quote .Pattern}}) {
+			return &p.{{$p.Field}}.{{.Field}}[i]
+		}
+	}
+	return nil
+}
+{{- end}}
+
+// Add{{.Method}} appends an item of the slice {{.Element}} with its fixed and
+// pattern values and returns it
+func (p *{{$p.Name}}) Add{{.Method}}() *{{.Type}} {
+	var item {{.Type}}
+	profile.MustUnmarshal({{quote .Fixed}}, &item)
+	p.{{$p.Field}}.{{.Field}} = append(p.{{$p.Field}}.{{.Field}}, item)
+	return &p.{{$p.Field}}.{{.Field}}[len(p.{{$p.Field}}.{{.Field}})-1]
+}
+{{end}}
+{{- range .Extensions}}
+{{- $target := printf "p.%s.%s" $p.Field .Field}}
+{{- if .Repeats}}
+{{- if .Value}}
+// {{.Name}} returns the values of the {{.Title}} extensions ({{.URL}})
+func (p *{{$p.Name}}) {{.Name}}() []{{.Type}} {
+	var values []{{.Type}}
+	for _, e := range profile.FindExtensions({{$target}}, "{{.URL}}") {
+		values = append(values, e.{{.Value}})
+	}
+	return values
+}
+{{- else}}
+// {{.Name}} returns the {{.Title}} extensions ({{.URL}})
+func (p *{{$p.Name}}) {{.Name}}() []{{.Type}} {
+	return profile.FindExtensions({{$target}}, "{{.URL}}")
+}
+{{- end}}
+
+// Set{{.Name}} replaces the {{.Title}} extensions, nil values are skipped
+func (p *{{$p.Name}}) Set{{.Name}}(values ...{{.Type}}) {
+	var replacements []common.Extension
+	for _, v := range values {
+		if v != nil {
+			replacements = append(replacements, {{template "extension" .}})
+		}
+	}
+	{{$target}} = profile.ReplaceExtensions({{$target}}, "{{.URL}}", replacements...)
+}
+{{- else}}
+// {{.Name}} returns the {{if .Value}}value of the {{end}}{{.Title}} extension ({{.URL}}), nil if it is absent
+func (p *{{$p.Name}}) {{.Name}}() {{.Type}} {
+	if found := profile.FindExtensions({{$target}}, "{{.URL}}"); len(found) > 0 {
+		return found[0]{{if .Value}}.{{.Value}}{{end}}
+	}
+	return nil
+}
+
+// Set{{.Name}} replaces the {{.Title}} extension, nil removes it
+func (p *{{$p.Name}}) Set{{.Name}}(v {{.Type}}) {
+	var replacements []common.Extension
+	if v != nil {
+		replacements = append(replacements, {{template "extension" .}})
+	}
+	{{$target}} = profile.ReplaceExtensions({{$target}}, "{{.URL}}", replacements...)
+}
+{{- end}}
+{{end}}
+{{- end}}
+{{- range .Extensions}}
+// {{.Name}}URL is the url of the extension {{.Title}}
+const {{.Name}}URL = "{{.URL}}"
+
+// {{.Name}} returns the {{if .Value}}value of the first{{else}}first{{end}} {{.Title}} extension among
+// the extensions, nil if there is none
+func {{.Name}}(extensions []common.Extension) {{.Type}} {
+	if found := profile.FindExtensions(extensions, {{.Name}}URL); len(found) > 0 {
+		return found[0]{{if .Value}}.{{.Value}}{{end}}
+	}
+	return nil
+}
+
+// Set{{.Name}} returns the extensions with the {{.Title}} extension replaced,
+// nil removes it
+func Set{{.Name}}(extensions []common.Extension, v {{.Type}}) []common.Extension {
+	var replacements []common.Extension
+	if v != nil {
+		replacements = append(replacements, {{template "extension" .}})
+	}
+	return profile.ReplaceExtensions(extensions, {{.Name}}URL, replacements...)
+}
+{{end}}
+{{- define "extension"}}{{if .Value}}common.Extension{ {{- .Value}}: v}{{else}}*v{{end}}{{end}}`))
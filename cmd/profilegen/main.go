@@ -0,0 +1,121 @@
+// Command profilegen generates typed Go wrappers for FHIR profiles and
+// extension definitions. It reads StructureDefinitions given as arguments and
+// writes one file with
+//
+//   - a struct per resource profile that embeds the resource struct of the
+//     version package, e.g. *fhir4.Observation, with a constructor that fills in
+//     the fixed and pattern values of the required elements and the profile in
+//     meta.profile,
+//   - accessors for the named slices of the elements of the resource, e.g.
+//     SystolicBP and AddSystolicBP for Observation.component:SystolicBP, that
+//     match elements by the value and pattern discriminators of the slicing,
+//   - typed getters and setters for the extension slices of the profile and for
+//     extension definitions, keyed by Extension.url, which work with the
+//     value[x] type of a simple extension and with the whole Extension of a
+//     complex one.
+//
+// Definitions of every FHIR version are read with the R5 model, whose
+// ElementDefinition covers everything the generator needs. The version package
+// of the wrappers follows from the fhirVersion of a profile unless -fhir is
+// given. Profiles without a snapshot get one from profile.Generator, which
+// resolves their base definitions from -definitions, as do the definitions of
+// the extensions a profile uses. Slices and extensions the generator cannot
+// handle are reported and skipped.
+//
+//	//go:generate go run github.com/d4l-data4life/go-fhir/cmd/profilegen -package vitals -out bp_gen.go bp.profile.json
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/profile"
+)
+
+func main() {
+	out := flag.String("out", "", "file to write the wrappers to")
+	pkg := flag.String("package", "", "package of the generated file, by default the name of the directory of -out")
+	fhir := flag.String("fhir", "", "version package of the wrappers, e.g. fhir4, by default the one matching the fhirVersion of a profile")
+	definitions := flag.String("definitions", "", "pattern of the StructureDefinition files of base definitions and extensions, e.g. 'package/StructureDefinition-*.json'")
+	flag.Parse()
+
+	if *out == "" || flag.NArg() == 0 {
+		log.Fatal("profilegen: -out and at least one StructureDefinition are required")
+	}
+	if *pkg == "" {
+		*pkg = filepath.Base(filepath.Dir(mustAbs(*out)))
+	}
+
+	resolver := profile.NewDefinitions()
+	if *definitions != "" {
+		if err := resolver.Load(*definitions); err != nil {
+			log.Fatal(err)
+		}
+	}
+	var inputs []*fhir5.StructureDefinition
+	for _, file := range flag.Args() {
+		sd, err := readDefinition(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := resolver.Add(sd); err != nil {
+			log.Fatalf("profilegen: %s: %v", file, err)
+		}
+		inputs = append(inputs, sd)
+	}
+
+	g := newGenerator(*pkg, *fhir, resolver)
+	for _, sd := range inputs {
+		if err := g.add(sd); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := writeFile(*out, g.file()); err != nil {
+		log.Fatal(err)
+	}
+	for _, w := range g.warnings {
+		fmt.Fprintf(os.Stderr, "profilegen: %s\n", w)
+	}
+}
+
+func readDefinition(file string) (*fhir5.StructureDefinition, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var sd fhir5.StructureDefinition
+	if err := json.Unmarshal(data, &sd); err != nil {
+		return nil, fmt.Errorf("profilegen: %s: %w", file, err)
+	}
+	if sd.ResourceType != "StructureDefinition" {
+		return nil, fmt.Errorf("profilegen: %s is not a StructureDefinition", file)
+	}
+	return &sd, nil
+}
+
+func mustAbs(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return abs
+}
+
+func writeFile(path string, info *fileInfo) error {
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, info); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, src, 0o644)
+}
@@ -0,0 +1,365 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Choice elements [x] are flattened into one field per type, e.g. valueQuantity
+// and valueString for Observation.value[x]. A group of fields is treated as a
+// choice element if at least two fields share a prefix followed by the name of a
+// FHIR type, or if the profile of the struct declares the element as [x].
+
+// choiceTypes are the FHIR type names that can follow the name of a choice element
+var choiceTypes = []string{
+	"Base64Binary", "Boolean", "Canonical", "Code", "Date", "DateTime", "Decimal", "Id", "Instant",
+	"Integer", "Integer64", "Markdown", "Oid", "PositiveInt", "String", "Time", "UnsignedInt", "Uri",
+	"Url", "Uuid",
+	"Address", "Age", "Annotation", "Attachment", "Availability", "CodeableConcept", "CodeableReference",
+	"Coding", "ContactDetail", "ContactPoint", "Contributor", "Count", "DataRequirement", "Distance",
+	"Dosage", "Duration", "Expression", "ExtendedContactDetail", "HumanName", "Identifier", "Meta",
+	"Money", "ParameterDefinition", "Period", "Quantity", "Range", "Ratio", "RatioRange", "Reference",
+	"RelatedArtifact", "SampledData", "Signature", "Timing", "TriggerDefinition", "UsageContext",
+	"VirtualServiceDetail",
+}
+
+// preferredChoiceTypes decide which type SetXxx picks for Go types shared by several FHIR types
+var preferredChoiceTypes = []string{"String", "Integer", "Decimal", "DateTime", "Quantity"}
+
+// goField is a struct field as declared in the source
+type goField struct {
+	Name     string
+	JSON     string
+	Type     string
+	Tag      string
+	Embedded string
+}
+
+// choiceInfo is the data of the choice accessor template
+type choiceInfo struct {
+	Name      string
+	Qualifier string
+	Types     []choiceStruct
+}
+
+// choiceStruct lists the choice elements of one struct
+type choiceStruct struct {
+	Name string
+
+	// Own are the choice elements declared by the struct itself, which get accessors
+	Own []choiceElement
+
+	// All additionally includes the choice elements of embedded structs
+	All []choiceElement
+}
+
+// choiceElement is a single choice element and its types
+type choiceElement struct {
+	// Method is the accessor name, e.g. "Value"
+	Method string
+
+	// Element is the FHIR name, e.g. "value[x]"
+	Element  string
+	Variants []choiceVariant
+}
+
+// choiceVariant is one type of a choice element
+type choiceVariant struct {
+	Type    string
+	Field   string
+	GoType  string
+	Element string
+
+	// Setter marks the variant SetXxx uses for its Go type
+	Setter bool
+}
+
+// loadFields collects the fields of all structs declared in dir
+func loadFields(dir string) (map[string][]goField, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), generatedSuffix)
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := map[string][]goField{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+				var fields []goField
+				for _, field := range st.Fields.List {
+					if len(field.Names) == 0 {
+						fields = append(fields, goField{Embedded: typeName(field.Type)})
+						continue
+					}
+					if field.Tag == nil || !field.Names[0].IsExported() {
+						continue
+					}
+					tag, _ := strconv.Unquote(field.Tag.Value)
+					fields = append(fields, goField{
+						Name: field.Names[0].Name,
+						JSON: strings.Split(reflect.StructTag(tag).Get("json"), ",")[0],
+						Type: types.ExprString(field.Type),
+						Tag:  tag,
+					})
+				}
+				structs[ts.Name.Name] = fields
+				return false
+			})
+		}
+	}
+	return structs, nil
+}
+
+// findChoices groups the fields of a struct into choice elements
+func findChoices(fields []goField, profile []string) []choiceElement {
+	declared := map[string]bool{}
+	elements := map[string]goField{}
+	for _, field := range fields {
+		declared[field.JSON] = true
+		if strings.HasPrefix(field.JSON, "_") {
+			elements[strings.TrimPrefix(field.JSON, "_")] = field
+		}
+	}
+	isChoice, concrete := map[string]bool{}, map[string]bool{}
+	for _, element := range profile {
+		if base, ok := strings.CutSuffix(element, "[x]"); ok {
+			isChoice[base] = true
+		} else {
+			// e.g. TestScript compareToSourceId, an element of its own
+			concrete[element] = true
+		}
+	}
+
+	byType := append([]string{}, choiceTypes...)
+	sort.Slice(byType, func(i, j int) bool { return len(byType[i]) > len(byType[j]) })
+
+	var order []string
+	groups := map[string][]choiceVariant{}
+	for _, field := range fields {
+		if field.JSON == "" || strings.HasPrefix(field.JSON, "_") || !isOptional(field.Type) {
+			continue
+		}
+		for _, typ := range byType {
+			base, ok := strings.CutSuffix(field.JSON, typ)
+			if !ok || base == "" || declared[base] || concrete[field.JSON] {
+				continue
+			}
+			if _, seen := groups[base]; !seen {
+				order = append(order, base)
+			}
+			variant := choiceVariant{Type: typ, Field: field.Name, GoType: field.Type}
+			if element, ok := elements[field.JSON]; ok {
+				variant.Element = element.Name
+			}
+			groups[base] = append(groups[base], variant)
+			break
+		}
+	}
+
+	var choices []choiceElement
+	for _, base := range order {
+		variants := groups[base]
+		if len(variants) < 2 && !isChoice[base] {
+			continue
+		}
+		markSetters(variants)
+		choices = append(choices, choiceElement{
+			Method:   strings.ToUpper(base[:1]) + base[1:],
+			Element:  base + "[x]",
+			Variants: variants,
+		})
+	}
+	return choices
+}
+
+// isOptional reports whether a field can hold one choice type, choice elements never repeat
+func isOptional(goType string) bool {
+	return strings.HasPrefix(goType, "*") || goType == "interface{}" || goType == "any"
+}
+
+// markSetters picks one variant per Go type for the type-based setter
+func markSetters(variants []choiceVariant) {
+	byGoType := map[string][]int{}
+	for i, variant := range variants {
+		byGoType[variant.GoType] = append(byGoType[variant.GoType], i)
+	}
+	for goType, indexes := range byGoType {
+		if strings.Contains(goType, "interface{}") {
+			continue
+		}
+		chosen := indexes[0]
+	preferred:
+		for _, preferred := range preferredChoiceTypes {
+			for _, i := range indexes {
+				if variants[i].Type == preferred {
+					chosen = i
+					break preferred
+				}
+			}
+		}
+		variants[chosen].Setter = true
+	}
+}
+
+// buildChoices finds the choice elements of every struct in the package
+func buildChoices(name, dir, commonDir, profiles string) (*choiceInfo, error) {
+	local, err := loadFields(dir)
+	if err != nil {
+		return nil, err
+	}
+	shared := local
+	qualifier := ""
+	if name != "common" {
+		if shared, err = loadFields(commonDir); err != nil {
+			return nil, err
+		}
+		qualifier = "common."
+	}
+	profileOrder := map[string][]string{}
+	if profiles != "" {
+		if profileOrder, err = loadProfileOrder(profiles); err != nil {
+			return nil, err
+		}
+	}
+
+	var collect func(name string, local map[string][]goField, depth int) []choiceElement
+	collect = func(name string, local map[string][]goField, depth int) []choiceElement {
+		fields, ok := local[name]
+		if strings.HasPrefix(name, "common.") {
+			fields, ok = shared[strings.TrimPrefix(name, "common.")]
+			local = shared
+		}
+		if !ok || depth > 8 {
+			return nil
+		}
+		choices := findChoices(fields, profileOrder[strings.TrimPrefix(name, "common.")])
+		for _, field := range fields {
+			if field.Embedded != "" {
+				choices = append(choices, collect(field.Embedded, local, depth+1)...)
+			}
+		}
+		return choices
+	}
+
+	info := &choiceInfo{Name: name, Qualifier: qualifier}
+	for structName, fields := range local {
+		own := findChoices(fields, profileOrder[structName])
+		all := collect(structName, local, 0)
+		if len(own) == 0 {
+			// choices of embedded structs are covered by the promoted methods
+			continue
+		}
+		info.Types = append(info.Types, choiceStruct{Name: structName, Own: own, All: all})
+	}
+	sort.Slice(info.Types, func(i, j int) bool {
+		return info.Types[i].Name < info.Types[j].Name
+	})
+	return info, nil
+}
+
+var choiceTemplate = template.Must(template.New("choices").Parse(`// Code generated by resourcegen; DO NOT EDIT.
+
+package {{.Name}}
+
+import (
+	"errors"
+{{- if .Qualifier}}
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+{{- end}}
+)
+{{- $q := .Qualifier}}
+{{range .Types}}
+{{- $s := .Name}}
+var _ {{$q}}ChoiceValidator = (*{{$s}})(nil)
+
+// ValidateChoices reports the choice elements of {{$s}} with more than one populated type
+func (s *{{$s}}) ValidateChoices() error {
+	var errs []error
+{{- range .All}}
+	if err := {{$q}}CheckChoice("{{.Element}}", []string{ {{- range $i, $v := .Variants}}{{if $i}}, {{end}}"{{$v.Type}}"{{end -}} },
+{{- range $i, $v := .Variants}}
+		s.{{$v.Field}} != nil,
+{{- end}}
+	); err != nil {
+		errs = append(errs, err)
+	}
+{{- end}}
+	return errors.Join(errs...)
+}
+{{range .Own}}
+{{- $m := .Method}}{{$e := .Element}}
+// {{$m}} returns the populated type of {{$e}} and its FHIR type name,
+// or nil and "" if no type is set
+func (s *{{$s}}) {{$m}}() (interface{}, string) {
+	switch {
+{{- range .Variants}}
+	case s.{{.Field}} != nil:
+		return s.{{.Field}}, "{{.Type}}"
+{{- end}}
+	}
+	return nil, ""
+}
+
+// Set{{$m}} sets {{$e}} to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with Set{{$m}}As. A nil v clears all types.
+func (s *{{$s}}) Set{{$m}}(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clear{{$m}}()
+		return nil
+{{- range .Variants}}{{if .Setter}}
+	case {{.GoType}}:
+		return s.Set{{$m}}As("{{.Type}}", v)
+{{- end}}{{end}}
+	}
+	return {{$q}}ChoiceTypeError("{{$e}}", v)
+}
+
+// Set{{$m}}As sets {{$e}} to v as the FHIR type typeName, e.g. "{{(index .Variants 0).Type}}",
+// and clears the other types
+func (s *{{$s}}) Set{{$m}}As(typeName string, v interface{}) error {
+	switch typeName {
+{{- range .Variants}}
+	case "{{.Type}}":
+		if x, ok := v.({{.GoType}}); ok {
+			s.clear{{$m}}()
+			s.{{.Field}} = x
+			return nil
+		}
+{{- end}}
+	}
+	return {{$q}}ChoiceTypeNameError("{{$e}}", typeName, v)
+}
+
+func (s *{{$s}}) clear{{$m}}() {
+{{- range .Variants}}
+	s.{{.Field}} = nil
+{{- if .Element}}
+	s.{{.Element}} = nil
+{{- end}}
+{{- end}}
+}
+{{end}}
+{{- end}}`))
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// The -dts flag names the TypeScript definitions of a version whose
+// StructureDefinitions are not available, such as R4B. The definitions mark
+// the optional properties of every interface, so the required elements of the
+// validation rules follow them instead of the definitions of R4 and R5.
+
+// dtsProperty is a property of a TypeScript interface
+type dtsProperty struct {
+	Optional bool
+	Array    bool
+}
+
+var (
+	dtsInterfaceRe = regexp.MustCompile(`^export interface (\w+)\b`)
+	dtsPropertyRe  = regexp.MustCompile(`^  (\w+)(\?)?: (.+?)(?: \| undefined)?;$`)
+)
+
+// loadDTSProperties returns the properties of the interfaces in a TypeScript
+// definition file, keyed by interface and property name
+func loadDTSProperties(path string) (map[string]map[string]dtsProperty, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]map[string]dtsProperty{}
+	var current map[string]dtsProperty
+	for _, line := range strings.Split(string(data), "\n") {
+		if m := dtsInterfaceRe.FindStringSubmatch(line); m != nil {
+			current = map[string]dtsProperty{}
+			result[m[1]] = current
+			continue
+		}
+		if line == "}" {
+			current = nil
+			continue
+		}
+		if m := dtsPropertyRe.FindStringSubmatch(line); m != nil && current != nil {
+			current[m[1]] = dtsProperty{Optional: m[2] != "", Array: strings.HasSuffix(m[3], "[]")}
+		}
+	}
+	return result, nil
+}
+
+// applyDTS returns the profile of a struct with the minimum cardinalities of its
+// interface: elements the interface declares optional are not required, and
+// required properties the profile lacks are added. Choice elements are kept as
+// the TypeScript definitions declare every type of a choice optional.
+func applyDTS(profile []elementCardinality, properties map[string]dtsProperty) []elementCardinality {
+	result := make([]elementCardinality, 0, len(profile))
+	known := map[string]bool{}
+	for _, element := range profile {
+		known[element.Name] = true
+		if property, ok := properties[element.Name]; ok && property.Optional {
+			element.Min = 0
+		}
+		result = append(result, element)
+	}
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		property := properties[name]
+		if property.Optional || known[name] || name == "resourceType" {
+			continue
+		}
+		max := "1"
+		if property.Array {
+			max = "*"
+		}
+		result = append(result, elementCardinality{Name: name, Min: 1, Max: max})
+	}
+	return result
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// The element order of FHIR XML is taken from the StructureDefinitions of the
+// version given with -profiles. Struct fields unknown to the definitions keep
+// their position relative to the preceding struct field.
+
+// baseElements is the order of the elements inherited from Resource,
+// DomainResource and BackboneElement, used for structs without a profile
+var baseElements = []string{"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension"}
+
+// structDef lists the fields of a struct in declaration order
+type structDef struct {
+	fields []structField
+}
+
+// structField is either a JSON property or an embedded struct
+type structField struct {
+	json     string
+	embedded string
+}
+
+// orderInfo is the data of the element order template
+type orderInfo struct {
+	Name      string
+	Qualifier string
+	Types     []orderedType
+}
+
+// orderedType lists the JSON properties of one struct in XML order
+type orderedType struct {
+	Name  string
+	Names []string
+}
+
+// loadStructs collects the JSON properties of all structs declared in dir
+func loadStructs(dir string) (map[string]*structDef, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), generatedSuffix)
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	structs := map[string]*structDef{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+				def := &structDef{}
+				for _, field := range st.Fields.List {
+					if len(field.Names) == 0 {
+						def.fields = append(def.fields, structField{embedded: typeName(field.Type)})
+						continue
+					}
+					if field.Tag == nil || !field.Names[0].IsExported() {
+						continue
+					}
+					tag, _ := strconv.Unquote(field.Tag.Value)
+					name := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+					if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "string" && name == "resourceType" {
+						continue
+					}
+					if name == "" || name == "-" || strings.HasPrefix(name, "_") {
+						continue
+					}
+					def.fields = append(def.fields, structField{json: name})
+				}
+				structs[ts.Name.Name] = def
+				return false
+			})
+		}
+	}
+	return structs, nil
+}
+
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		if pkg, ok := t.X.(*ast.Ident); ok {
+			return pkg.Name + "." + t.Sel.Name
+		}
+	case *ast.StarExpr:
+		return typeName(t.X)
+	}
+	return ""
+}
+
+// loadProfileOrder reads the base StructureDefinitions in profiles and returns
+// the child element names of every element with children, keyed by the Go struct
+// name derived from the element path, e.g. "PatientContact" for Patient.contact
+func loadProfileOrder(profiles string) (map[string][]string, error) {
+	definitions, err := readProfiles(profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	order := map[string][]string{}
+	for _, data := range definitions {
+		var sd struct {
+			ResourceType string `json:"resourceType"`
+			Derivation   string `json:"derivation"`
+			Snapshot     struct {
+				Element []struct {
+					Path string `json:"path"`
+				} `json:"element"`
+			} `json:"snapshot"`
+		}
+		if err := json.Unmarshal(data, &sd); err != nil || sd.ResourceType != "StructureDefinition" || sd.Derivation == "constraint" {
+			continue
+		}
+		for _, element := range sd.Snapshot.Element {
+			i := strings.LastIndexByte(element.Path, '.')
+			if i < 0 {
+				continue
+			}
+			parent := structName(element.Path[:i])
+			order[parent] = append(order[parent], element.Path[i+1:])
+		}
+	}
+	return order, nil
+}
+
+// structName converts an element path into the name of the struct modelling it
+func structName(path string) string {
+	var b strings.Builder
+	for _, segment := range strings.Split(path, ".") {
+		segment = strings.TrimSuffix(segment, "[x]")
+		b.WriteString(strings.ToUpper(segment[:1]) + segment[1:])
+	}
+	return b.String()
+}
+
+// flatten returns the JSON properties of a struct including those of embedded structs
+func flatten(name string, local, shared map[string]*structDef) []string {
+	def, ok := local[name]
+	if strings.HasPrefix(name, "common.") {
+		def, ok = shared[strings.TrimPrefix(name, "common.")]
+		local = shared
+	}
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	seen := map[string]bool{}
+	for _, field := range def.fields {
+		var add []string
+		if field.embedded != "" {
+			add = flatten(field.embedded, local, shared)
+		} else {
+			add = []string{field.json}
+		}
+		for _, n := range add {
+			if !seen[n] {
+				seen[n] = true
+				names = append(names, n)
+			}
+		}
+	}
+	return names
+}
+
+// elementRank returns the position of a JSON property in the element order of a
+// profile, or -1 if the profile does not know it
+func elementRank(name string, profile []string) int {
+	for i, element := range profile {
+		if element == name {
+			return i
+		}
+	}
+	for i, element := range profile {
+		if base, ok := strings.CutSuffix(element, "[x]"); ok && strings.HasPrefix(name, base) &&
+			len(name) > len(base) && name[len(base)] >= 'A' && name[len(base)] <= 'Z' {
+			return i
+		}
+	}
+	return -1
+}
+
+// orderFields sorts the JSON properties of a struct by the element order of the profile.
+// Properties unknown to the profile stay behind the properties that precede them.
+func orderFields(names, profile []string) []string {
+	keys := make([]int, len(names))
+	highest := -1
+	for i, name := range names {
+		keys[i] = elementRank(name, profile)
+		if keys[i] < 0 {
+			keys[i] = highest
+		}
+		highest = max(highest, keys[i])
+	}
+	ordered := append([]string{}, names...)
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return keys[index[ordered[i]]] < keys[index[ordered[j]]]
+	})
+	return ordered
+}
+
+// buildElementOrder computes the XML element order of every struct in the package
+// that corresponds to an element of the profiles
+func buildElementOrder(name, dir, commonDir, profiles string) (*orderInfo, error) {
+	local, err := loadStructs(dir)
+	if err != nil {
+		return nil, err
+	}
+	shared := local
+	qualifier := ""
+	if name != "common" {
+		if shared, err = loadStructs(commonDir); err != nil {
+			return nil, err
+		}
+		qualifier = "common."
+	}
+	var profileOrders []map[string][]string
+	for _, profileDir := range profileDirs(profiles) {
+		order, err := loadProfileOrder(profileDir)
+		if err != nil {
+			return nil, err
+		}
+		profileOrders = append(profileOrders, order)
+	}
+
+	info := &orderInfo{Name: name, Qualifier: qualifier}
+	for structName := range local {
+		names := flatten(structName, local, shared)
+		ordered := orderFields(names, bestProfile(structName, names, profileOrders))
+		if strings.Join(ordered, ",") != strings.Join(names, ",") {
+			info.Types = append(info.Types, orderedType{Name: structName, Names: ordered})
+		}
+	}
+	sort.Slice(info.Types, func(i, j int) bool {
+		return info.Types[i].Name < info.Types[j].Name
+	})
+	return info, nil
+}
+
+// bestProfile returns the element order of the profile that knows most of the
+// JSON properties of a struct, earlier profiles win ties
+func bestProfile(structName string, names []string, profileOrders []map[string][]string) []string {
+	best, known := baseElements, 0
+	for _, order := range profileOrders {
+		profile, ok := order[structName]
+		if !ok {
+			continue
+		}
+		count := 0
+		for _, name := range names {
+			if elementRank(name, profile) >= 0 {
+				count++
+			}
+		}
+		if count > known {
+			best, known = profile, count
+		}
+	}
+	return best
+}
+
+var elementOrderTemplate = template.Must(template.New("order").Parse(`// Code generated by resourcegen; DO NOT EDIT.
+
+package {{.Name}}
+
+import (
+	"reflect"
+{{- if .Qualifier}}
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+{{- end}}
+)
+
+// elementOrder lists the properties of each struct in the element order of FHIR XML
+var elementOrder = {{.Qualifier}}ElementOrder{
+{{- range .Types}}
+	reflect.TypeOf({{.Name}}{}): { {{- range $i, $n := .Names}}{{if $i}}, {{end}}"{{$n}}"{{end -}} },
+{{- end}}
+}
+`))
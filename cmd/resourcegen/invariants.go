@@ -0,0 +1,214 @@
+package main
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// The invariants of a version package are the FHIRPath constraints declared by
+// the StructureDefinitions of its version. A constraint is attached to the struct modelling
+// the element that declares it; constraints on elements modelled by a field,
+// such as CanonicalResource.url, are attached to the struct holding the field.
+// Inherited constraints, e.g. ele-1 on every element, are only attached to the
+// struct of the profile declaring them, which the others embed. Constraints
+// without a key, as in the definitions converted from the FHIR protos, are
+// told apart by their expression.
+
+// profileConstraint is a constraint declared by a profile for an element path
+type profileConstraint struct {
+	Path       string
+	Key        string
+	Severity   string
+	Human      string
+	Expression string
+}
+
+// invariantsInfo is the data of the invariants template
+type invariantsInfo struct {
+	Name    string
+	Version string
+	Structs []invariantStruct
+}
+
+// invariantStruct lists the invariants of one struct
+type invariantStruct struct {
+	Type       string
+	Invariants []invariantInfo
+}
+
+// invariantInfo is a constraint attached to a struct, Element is the JSON name
+// of the field it applies to or empty for the struct itself
+type invariantInfo struct {
+	Key        string
+	Element    string
+	Severity   string
+	Human      string
+	Expression string
+}
+
+// loadProfileConstraints reads the constraints the base StructureDefinitions in
+// profiles declare themselves, in the order of their elements
+func loadProfileConstraints(profiles string) ([]profileConstraint, error) {
+	definitions, err := readProfiles(profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []profileConstraint
+	for _, data := range definitions {
+		var sd struct {
+			ResourceType string `json:"resourceType"`
+			URL          string `json:"url"`
+			Derivation   string `json:"derivation"`
+			Snapshot     struct {
+				Element []struct {
+					Path       string `json:"path"`
+					Constraint []struct {
+						Key        string `json:"key"`
+						Severity   string `json:"severity"`
+						Human      string `json:"human"`
+						Expression string `json:"expression"`
+						Source     string `json:"source"`
+					} `json:"constraint"`
+				} `json:"element"`
+			} `json:"snapshot"`
+		}
+		if err := json.Unmarshal(data, &sd); err != nil || sd.ResourceType != "StructureDefinition" || sd.Derivation == "constraint" {
+			continue
+		}
+		// a constraint repeated on the children of the root, e.g. ele-1 on
+		// Element.extension, is covered by the struct of the child
+		rootKeys := map[string]bool{}
+		for _, element := range sd.Snapshot.Element {
+			for _, c := range element.Constraint {
+				if c.Expression == "" || c.Source != "" && c.Source != sd.URL {
+					continue
+				}
+				id := constraintID(c.Key, c.Expression)
+				if !strings.Contains(element.Path, ".") {
+					rootKeys[id] = true
+				} else if rootKeys[id] {
+					continue
+				}
+				result = append(result, profileConstraint{
+					Path: element.Path, Key: c.Key, Severity: c.Severity, Human: c.Human, Expression: c.Expression,
+				})
+			}
+		}
+	}
+	return result, nil
+}
+
+// buildInvariants collects the invariants of the version package in dir for the
+// package name, which lives outside the version package
+func buildInvariants(name, dir, commonDir, profiles string) (*invariantsInfo, error) {
+	version, err := loadPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	local, err := loadFields(dir)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := loadFields(commonDir)
+	if err != nil {
+		return nil, err
+	}
+	constraints, err := loadProfileConstraints(profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	// qualified returns the qualified Go types of a struct name, the version
+	// package and common may both model a type
+	qualified := func(name string) []string {
+		var types []string
+		if _, ok := local[name]; ok {
+			types = append(types, version.Name+"."+name)
+		}
+		if _, ok := shared[name]; ok {
+			types = append(types, "common."+name)
+		}
+		return types
+	}
+
+	byType := map[string][]invariantInfo{}
+	seen := map[string]bool{}
+	for _, c := range constraints {
+		types, element := qualified(structName(c.Path)), ""
+		if len(types) == 0 {
+			i := strings.LastIndexByte(c.Path, '.')
+			if i < 0 {
+				continue
+			}
+			element = strings.TrimSuffix(c.Path[i+1:], "[x]")
+			for _, typ := range qualified(structName(c.Path[:i])) {
+				if hasElement(flattenFields(strings.TrimPrefix(typ, version.Name+"."), local, shared), element) {
+					types = append(types, typ)
+				}
+			}
+		}
+		for _, typ := range types {
+			id := typ + "|" + element + "|" + constraintID(c.Key, c.Expression)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			byType[typ] = append(byType[typ], invariantInfo{
+				Key: c.Key, Element: element, Severity: c.Severity, Human: c.Human, Expression: c.Expression,
+			})
+		}
+	}
+
+	info := &invariantsInfo{Name: name, Version: version.Name}
+	for typ, invariants := range byType {
+		info.Structs = append(info.Structs, invariantStruct{Type: typ, Invariants: invariants})
+	}
+	sort.Slice(info.Structs, func(i, j int) bool { return info.Structs[i].Type < info.Structs[j].Type })
+	return info, nil
+}
+
+// constraintID identifies a constraint by its key, or by its expression if it has none
+func constraintID(key, expression string) string {
+	if key == "" {
+		return expression
+	}
+	return key
+}
+
+// hasElement reports whether the fields model the element, directly or as a choice
+func hasElement(fields []goField, element string) bool {
+	for _, field := range fields {
+		if field.JSON == element {
+			return true
+		}
+	}
+	return hasChoiceField(fields, element)
+}
+
+var invariantsTemplate = template.Must(template.New("invariants").Parse(`// Code generated by resourcegen; DO NOT EDIT.
+
+package {{.Name}}
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/{{.Version}}"
+)
+
+// {{.Version}}Invariants lists the FHIRPath constraints of each struct of the
+// {{.Version}} package, element is the field a constraint applies to or empty for
+// the struct itself
+var {{.Version}}Invariants = map[reflect.Type][]invariant{
+{{- range .Structs}}
+	reflect.TypeOf({{.Type}}{}): {
+	{{- range .Invariants}}
+		{ {{- printf "%q" .Key}}, {{printf "%q" .Element}}, {{printf "%q" .Severity}}, {{printf "%q" .Human}}, {{printf "%q" .Expression -}} },
+	{{- end}}
+	},
+{{- end}}
+}
+`))
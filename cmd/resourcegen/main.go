@@ -0,0 +1,220 @@
+// Command resourcegen generates the per-version resource registry and the
+// common.Resource implementations for a FHIR version package. It is run
+// through go:generate from within the package directory and scans the package
+// sources for resource structs, i.e. structs that embed Resource or
+// DomainResource. It also writes accessors for the choice elements [x] of the
+// package structs, the JSON encoding of null entries of repeating primitives
+// and, with -profiles, their XML element order. With -rules it only writes the
+// validation rules of the package for the validate package, with -invariants
+// only the FHIRPath invariants of its profiles.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const generatedSuffix = "_gen.go"
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the FHIR version package")
+	commonDir := flag.String("common", "../common", "directory of the common package")
+	profiles := flag.String("profiles", "", "comma-separated directories of the StructureDefinitions of the version, earlier ones take precedence")
+	rules := flag.String("rules", "", "write the validation rules of the package in -dir to this file instead of generating the package")
+	dts := flag.String("dts", "", "TypeScript definitions of the version whose optional properties decide the required elements of -rules")
+	invariants := flag.String("invariants", "", "write the FHIRPath invariants of the package in -dir to this file instead of generating the package")
+	flag.Parse()
+
+	if *invariants != "" {
+		info, err := buildInvariants(filepath.Base(filepath.Dir(mustAbs(*invariants))), *dir, *commonDir, *profiles)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeFile(*invariants, invariantsTemplate, info); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if *rules != "" {
+		info, err := buildRules(filepath.Base(filepath.Dir(mustAbs(*rules))), *dir, *commonDir, *profiles, *dts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeFile(*rules, rulesTemplate, info); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	pkg, err := loadPackage(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(pkg.Resources) > 0 {
+		if err := writeFile(filepath.Join(*dir, "resources"+generatedSuffix), resourcesTemplate, pkg); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	choices, err := buildChoices(pkg.Name, *dir, *commonDir, *profiles)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(choices.Types) > 0 {
+		if err := writeFile(filepath.Join(*dir, "choices"+generatedSuffix), choiceTemplate, choices); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	nulls, err := buildNulls(pkg.Name, *dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(nulls.Types) > 0 {
+		if err := writeFile(filepath.Join(*dir, "nulls"+generatedSuffix), nullsTemplate, nulls); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *profiles != "" {
+		order, err := buildElementOrder(pkg.Name, *dir, *commonDir, *profiles)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := writeFile(filepath.Join(*dir, "elementorder"+generatedSuffix), elementOrderTemplate, order); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// packageInfo describes the parts of a version package the generator cares about
+type packageInfo struct {
+	Name      string
+	Resources []resourceInfo
+}
+
+// resourceInfo describes a single resource struct
+type resourceInfo struct {
+	Name string
+
+	// Whether the struct embeds DomainResource rather than Resource
+	Domain bool
+}
+
+func loadPackage(dir string) (*packageInfo, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && !strings.HasSuffix(name, generatedSuffix)
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected exactly one package in %s, found %d", dir, len(pkgs))
+	}
+
+	info := &packageInfo{}
+	for name, pkg := range pkgs {
+		info.Name = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					ts := spec.(*ast.TypeSpec)
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || ts.Name.Name == "DomainResource" {
+						continue
+					}
+					if base := resourceBase(st); base != "" {
+						info.Resources = append(info.Resources, resourceInfo{
+							Name:   ts.Name.Name,
+							Domain: base == "DomainResource",
+						})
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(info.Resources, func(i, j int) bool {
+		return info.Resources[i].Name < info.Resources[j].Name
+	})
+	return info, nil
+}
+
+// resourceBase returns the resource base type embedded by a struct, or an
+// empty string if the struct is not a resource
+func resourceBase(st *ast.StructType) string {
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 0 {
+			continue
+		}
+		if ident, ok := field.Type.(*ast.Ident); ok && (ident.Name == "Resource" || ident.Name == "DomainResource") {
+			return ident.Name
+		}
+	}
+	return ""
+}
+
+func mustAbs(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return abs
+}
+
+func writeFile(path string, tmpl *template.Template, data interface{}) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+var resourcesTemplate = template.Must(template.New("resources").Parse(`// Code generated by resourcegen; DO NOT EDIT.
+
+package {{.Name}}
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+var resourceFactories = map[string]common.ResourceFactory{
+{{- range .Resources}}
+	"{{.Name}}": func() common.Resource { return new({{.Name}}) },
+{{- end}}
+}
+
+var (
+{{- range .Resources}}
+	_ {{if .Domain}}AnyDomainResource{{else}}AnyResource{{end}} = (*{{.Name}})(nil)
+{{- end}}
+)
+{{range .Resources}}
+// GetResourceType returns "{{.Name}}"
+func (*{{.Name}}) GetResourceType() string {
+	return "{{.Name}}"
+}
+{{end}}`))
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Repeating primitives decode null entries of their value array, e.g. the first
+// given name of {"given":[null,"James"],"_given":[{...},null]}, into the zero
+// value of the slice. Structs with repeating primitives get a MarshalJSON that
+// writes a zero value as null again if the parallel Element slice has an entry
+// at its index. A struct declaring its own MarshalJSON has to do so itself.
+
+// nullsInfo is the data of the null placeholder template
+type nullsInfo struct {
+	Name      string
+	Qualifier string
+	Types     []nullsStruct
+}
+
+// nullsStruct lists the repeating primitives of one struct
+type nullsStruct struct {
+	Name   string
+	Fields []nullsField
+}
+
+// nullsField is a repeating primitive and its parallel Element slice
+type nullsField struct {
+	Name    string
+	Element string
+	Type    string
+	Tag     string
+}
+
+// loadMarshalers returns the structs declared in dir that implement MarshalJSON
+func loadMarshalers(dir string) (map[string]bool, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), generatedSuffix)
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+	marshalers := map[string]bool{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if ok && fn.Recv != nil && fn.Name.Name == "MarshalJSON" {
+					marshalers[typeName(fn.Recv.List[0].Type)] = true
+				}
+			}
+		}
+	}
+	return marshalers, nil
+}
+
+// buildNulls collects the repeating primitives of the structs in dir
+func buildNulls(name, dir string) (*nullsInfo, error) {
+	structs, err := loadFields(dir)
+	if err != nil {
+		return nil, err
+	}
+	marshalers, err := loadMarshalers(dir)
+	if err != nil {
+		return nil, err
+	}
+	embedded := map[string]bool{}
+	for _, fields := range structs {
+		for _, field := range fields {
+			embedded[field.Embedded] = true
+		}
+	}
+
+	info := &nullsInfo{Name: name}
+	if name != "common" {
+		info.Qualifier = "common."
+	}
+	for structName, fields := range structs {
+		elements := map[string]goField{}
+		for _, field := range fields {
+			if strings.HasPrefix(field.JSON, "_") && field.Type == "[]*"+info.Qualifier+"Element" {
+				elements[field.JSON[1:]] = field
+			}
+		}
+		s := nullsStruct{Name: structName}
+		for _, field := range fields {
+			element, ok := elements[field.JSON]
+			if !ok || !strings.HasPrefix(field.Type, "[]") || strings.HasPrefix(field.Type, "[]*") {
+				continue
+			}
+			s.Fields = append(s.Fields, nullsField{Name: field.Name, Element: element.Name, Type: field.Type[2:], Tag: field.Tag})
+		}
+		if len(s.Fields) == 0 || marshalers[structName] {
+			continue
+		}
+		if embedded[structName] {
+			// the method would be promoted to the embedding struct and hide its fields
+			return nil, fmt.Errorf("%s has repeating primitives and is embedded by another struct", structName)
+		}
+		info.Types = append(info.Types, s)
+	}
+	sort.Slice(info.Types, func(i, j int) bool { return info.Types[i].Name < info.Types[j].Name })
+	return info, nil
+}
+
+var nullsTemplate = template.Must(template.New("nulls").Parse(`// Code generated by resourcegen; DO NOT EDIT.
+
+package {{.Name}}
+
+import (
+	"encoding/json"
+{{- if .Qualifier}}
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+{{- end}}
+)
+{{$q := .Qualifier}}
+{{- range .Types}}
+// MarshalJSON writes the repeating primitives of {{.Name}} that only have an id
+// or extensions as null
+func (x {{.Name}}) MarshalJSON() ([]byte, error) {
+	type plain {{.Name}}
+	return json.Marshal(struct {
+		plain
+{{- range .Fields}}
+		{{.Name}} []*{{.Type}} ` + "`{{.Tag}}`" + `
+{{- end}}
+	}{
+		plain(x),
+{{- range .Fields}}
+		{{$q}}PrimitiveValues(x.{{.Name}}, x.{{.Element}}),
+{{- end}}
+	})
+}
+{{end}}`))
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The -profiles flag lists the directories holding the StructureDefinitions of
+// a version, separated by commas. A directory holds single *.profile.json files,
+// like the R5 examples, or the profiles-*.json bundles of the FHIR definitions.
+// A type defined in an earlier directory hides its definition in later ones, so
+// R4B can take the definitions of R4 and fall back to R5 for its new resources.
+// The XML element order instead follows, for every struct, the directory whose
+// definitions know most of its fields, as R4B reworked some R4 resources, e.g.
+// Evidence, along the lines of R5, and a last directory can add the order of
+// the elements that no other directory defines.
+
+// profileDirs splits the -profiles flag into its directories
+func profileDirs(profiles string) []string {
+	var dirs []string
+	for _, dir := range strings.Split(profiles, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+// readProfiles returns the StructureDefinitions found in the profile directories
+func readProfiles(profiles string) ([]json.RawMessage, error) {
+	var result []json.RawMessage
+	defined := map[string]bool{}
+	for _, dir := range profileDirs(profiles) {
+		files, err := filepath.Glob(filepath.Join(dir, "*.profile.json"))
+		if err != nil {
+			return nil, err
+		}
+		bundles, err := filepath.Glob(filepath.Join(dir, "profiles-*.json"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, bundles...)
+		sort.Strings(files)
+
+		types := map[string]bool{}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			var resource struct {
+				ResourceType string `json:"resourceType"`
+				Entry        []struct {
+					Resource json.RawMessage `json:"resource"`
+				} `json:"entry"`
+			}
+			if err := json.Unmarshal(data, &resource); err != nil {
+				continue
+			}
+			entries := []json.RawMessage{data}
+			if resource.ResourceType == "Bundle" {
+				entries = entries[:0]
+				for _, entry := range resource.Entry {
+					entries = append(entries, entry.Resource)
+				}
+			}
+			for _, entry := range entries {
+				var sd struct {
+					ResourceType string `json:"resourceType"`
+					Type         string `json:"type"`
+					Derivation   string `json:"derivation"`
+				}
+				if err := json.Unmarshal(entry, &sd); err != nil || sd.ResourceType != "StructureDefinition" {
+					continue
+				}
+				if sd.Derivation != "constraint" {
+					if defined[sd.Type] {
+						continue
+					}
+					types[sd.Type] = true
+				}
+				result = append(result, entry)
+			}
+		}
+		for typ := range types {
+			defined[typ] = true
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// The validation rules of a version package are the cardinalities of the
+// StructureDefinitions of its version, restricted to the elements the structs
+// model, and the
+// values of the string enum types declared in the package and in common. The
+// values of an enum type are the codes of the value set its fields are bound
+// to, or the declared constants if the value set cannot be expanded.
+
+// elementCardinality is the min and max of an element as declared by a profile,
+// ValueSet is set for elements with a required binding
+type elementCardinality struct {
+	Name     string
+	Min      int
+	Max      string
+	ValueSet string
+}
+
+// rulesInfo is the data of the validation rules template
+type rulesInfo struct {
+	Name    string
+	Version string
+	Structs []ruledStruct
+	Enums   []enumType
+}
+
+// ruledStruct lists the constrained elements of one struct
+type ruledStruct struct {
+	Type     string
+	Elements []ruledElement
+}
+
+// ruledElement is an element that is required or limited in its repetitions
+type ruledElement struct {
+	Name string
+	Min  int
+	Max  int
+}
+
+// enumType lists the declared values of a string enum type
+type enumType struct {
+	Type   string
+	Values []string
+}
+
+// loadProfileCardinality reads the base StructureDefinitions in profiles and
+// returns the cardinality of the child elements keyed like loadProfileOrder
+func loadProfileCardinality(profiles string) (map[string][]elementCardinality, error) {
+	definitions, err := readProfiles(profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string][]elementCardinality{}
+	for _, data := range definitions {
+		var sd struct {
+			ResourceType string `json:"resourceType"`
+			Derivation   string `json:"derivation"`
+			Snapshot     struct {
+				Element []struct {
+					Path    string `json:"path"`
+					Min     int    `json:"min"`
+					Max     string `json:"max"`
+					Binding *struct {
+						Strength string `json:"strength"`
+						ValueSet string `json:"valueSet"`
+					} `json:"binding"`
+				} `json:"element"`
+			} `json:"snapshot"`
+		}
+		if err := json.Unmarshal(data, &sd); err != nil || sd.ResourceType != "StructureDefinition" || sd.Derivation == "constraint" {
+			continue
+		}
+		for _, element := range sd.Snapshot.Element {
+			i := strings.LastIndexByte(element.Path, '.')
+			if i < 0 {
+				continue
+			}
+			c := elementCardinality{Name: element.Path[i+1:], Min: element.Min, Max: element.Max}
+			if element.Binding != nil && element.Binding.Strength == "required" {
+				c.ValueSet, _, _ = strings.Cut(element.Binding.ValueSet, "|")
+			}
+			parent := structName(element.Path[:i])
+			result[parent] = append(result[parent], c)
+		}
+	}
+	return result, nil
+}
+
+// flattenFields returns the fields of a struct including those of embedded structs
+func flattenFields(name string, local, shared map[string][]goField) []goField {
+	fields, ok := local[name]
+	if strings.HasPrefix(name, "common.") {
+		fields, ok = shared[strings.TrimPrefix(name, "common.")]
+		local = shared
+	}
+	if !ok {
+		return nil
+	}
+	var result []goField
+	for _, field := range fields {
+		if field.Embedded != "" {
+			result = append(result, flattenFields(field.Embedded, local, shared)...)
+			continue
+		}
+		result = append(result, field)
+	}
+	return result
+}
+
+// structRules returns the constrained elements of a struct, or nil if the profile
+// does not constrain any element the struct models
+func structRules(fields []goField, profile []elementCardinality) []ruledElement {
+	byName := map[string]goField{}
+	for _, field := range fields {
+		byName[field.JSON] = field
+	}
+
+	var elements []ruledElement
+	for _, element := range profile {
+		max := -1
+		if element.Max != "*" {
+			max, _ = strconv.Atoi(element.Max)
+		}
+		if base, ok := strings.CutSuffix(element.Name, "[x]"); ok {
+			if element.Min == 0 || !hasChoiceField(fields, base) {
+				continue
+			}
+			elements = append(elements, ruledElement{Name: element.Name, Min: element.Min, Max: max})
+			continue
+		}
+		field, ok := byName[element.Name]
+		if !ok {
+			continue
+		}
+		repeating := strings.HasPrefix(field.Type, "[]")
+		if element.Min > 0 || repeating && max >= 0 {
+			elements = append(elements, ruledElement{Name: element.Name, Min: element.Min, Max: max})
+		}
+	}
+	return elements
+}
+
+func hasChoiceField(fields []goField, base string) bool {
+	for _, field := range fields {
+		if name, ok := strings.CutPrefix(field.JSON, base); ok && name != "" && name[0] >= 'A' && name[0] <= 'Z' {
+			return true
+		}
+	}
+	return false
+}
+
+// loadEnums collects the values of the string types declared in dir that have constants
+func loadEnums(dir string) (map[string][]string, error) {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), generatedSuffix)
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	stringTypes := map[string]bool{}
+	values := map[string][]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if ident, ok := spec.Type.(*ast.Ident); ok && ident.Name == "string" && spec.Assign == 0 {
+							stringTypes[spec.Name.Name] = true
+						}
+					case *ast.ValueSpec:
+						ident, ok := spec.Type.(*ast.Ident)
+						if !ok || gen.Tok != token.CONST {
+							continue
+						}
+						for _, value := range spec.Values {
+							if lit, ok := value.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+								s, _ := strconv.Unquote(lit.Value)
+								values[ident.Name] = append(values[ident.Name], s)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	for name := range values {
+		if !stringTypes[name] {
+			delete(values, name)
+		}
+	}
+	return values, nil
+}
+
+// buildRules collects the validation rules of the version package in dir for the
+// package name, which lives outside the version package
+func buildRules(name, dir, commonDir, profiles, dts string) (*rulesInfo, error) {
+	version, err := loadPackage(dir)
+	if err != nil {
+		return nil, err
+	}
+	local, err := loadFields(dir)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := loadFields(commonDir)
+	if err != nil {
+		return nil, err
+	}
+	cardinality, err := loadProfileCardinality(profiles)
+	if err != nil {
+		return nil, err
+	}
+
+	var interfaces map[string]map[string]dtsProperty
+	if dts != "" {
+		if interfaces, err = loadDTSProperties(dts); err != nil {
+			return nil, err
+		}
+	}
+
+	codes, err := loadValueSetCodes(profiles)
+	if err != nil {
+		return nil, err
+	}
+	bound := map[string][]string{}
+
+	info := &rulesInfo{Name: name, Version: version.Name}
+	addStructs := func(qualifier, lookup string, structs map[string][]goField) {
+		for structName := range structs {
+			profile, ok := cardinality[structName]
+			if properties, declared := interfaces[structName]; declared {
+				profile, ok = applyDTS(profile, properties), true
+			}
+			if !ok {
+				continue
+			}
+			fields := flattenFields(lookup+structName, local, shared)
+			bindEnums(structs[structName], profile, codes, bound, qualifier)
+			if elements := structRules(fields, profile); len(elements) > 0 {
+				info.Structs = append(info.Structs, ruledStruct{Type: qualifier + structName, Elements: elements})
+			}
+		}
+	}
+	addStructs(version.Name+".", "", local)
+	addStructs("common.", "common.", shared)
+
+	for qualifier, enumDir := range map[string]string{version.Name + ".": dir, "common.": commonDir} {
+		enums, err := loadEnums(enumDir)
+		if err != nil {
+			return nil, err
+		}
+		for typ, values := range enums {
+			if codes, ok := bound[qualifier+typ]; ok {
+				values = codes
+			}
+			info.Enums = append(info.Enums, enumType{Type: qualifier + typ, Values: values})
+		}
+	}
+
+	sort.Slice(info.Structs, func(i, j int) bool { return info.Structs[i].Type < info.Structs[j].Type })
+	sort.Slice(info.Enums, func(i, j int) bool { return info.Enums[i].Type < info.Enums[j].Type })
+	return info, nil
+}
+
+// bindEnums records the codes of the value sets bound to the fields of a struct
+// under the Go type of the field
+func bindEnums(fields []goField, profile []elementCardinality, codes map[string][]string, bound map[string][]string, qualifier string) {
+	for _, element := range profile {
+		values, ok := codes[element.ValueSet]
+		if !ok {
+			continue
+		}
+		for _, field := range fields {
+			if field.JSON != element.Name {
+				continue
+			}
+			typ := strings.TrimLeft(field.Type, "*[]")
+			if !strings.Contains(typ, ".") {
+				typ = qualifier + typ
+			}
+			for _, value := range values {
+				if !slices.Contains(bound[typ], value) {
+					bound[typ] = append(bound[typ], value)
+				}
+			}
+		}
+	}
+}
+
+// loadValueSetCodes expands the value sets in the profile directories that include
+// whole code systems or enumerated concepts, keyed by the value set url
+func loadValueSetCodes(profiles string) (map[string][]string, error) {
+	var files []string
+	for _, dir := range profileDirs(profiles) {
+		matches, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+
+	type concept struct {
+		Code    string    `json:"code"`
+		Concept []concept `json:"concept"`
+	}
+	type include struct {
+		System   string            `json:"system"`
+		Concept  []concept         `json:"concept"`
+		Filter   []json.RawMessage `json:"filter"`
+		ValueSet []string          `json:"valueSet"`
+	}
+	codeSystems := map[string][]string{}
+	valueSets := map[string][]include{}
+	for _, file := range files {
+		name := filepath.Base(file)
+		if !strings.HasPrefix(name, "codesystem-") && !strings.HasPrefix(name, "valueset-") {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var resource struct {
+			ResourceType string    `json:"resourceType"`
+			URL          string    `json:"url"`
+			Concept      []concept `json:"concept"`
+			Compose      struct {
+				Include []include `json:"include"`
+				Exclude []include `json:"exclude"`
+			} `json:"compose"`
+		}
+		if err := json.Unmarshal(data, &resource); err != nil {
+			continue
+		}
+		switch resource.ResourceType {
+		case "CodeSystem":
+			var codes []string
+			var walk func([]concept)
+			walk = func(concepts []concept) {
+				for _, c := range concepts {
+					codes = append(codes, c.Code)
+					walk(c.Concept)
+				}
+			}
+			walk(resource.Concept)
+			codeSystems[resource.URL] = codes
+		case "ValueSet":
+			if len(resource.Compose.Exclude) == 0 {
+				valueSets[resource.URL] = resource.Compose.Include
+			}
+		}
+	}
+
+	var expand func(url string, depth int) ([]string, bool)
+	expand = func(url string, depth int) ([]string, bool) {
+		includes, ok := valueSets[url]
+		if !ok || depth > 4 {
+			return nil, false
+		}
+		var codes []string
+		for _, inc := range includes {
+			switch {
+			case len(inc.Filter) > 0:
+				return nil, false
+			case len(inc.Concept) > 0:
+				for _, c := range inc.Concept {
+					codes = append(codes, c.Code)
+				}
+			case len(inc.ValueSet) > 0:
+				for _, nested := range inc.ValueSet {
+					nestedCodes, ok := expand(strings.Split(nested, "|")[0], depth+1)
+					if !ok {
+						return nil, false
+					}
+					codes = append(codes, nestedCodes...)
+				}
+			default:
+				systemCodes, ok := codeSystems[inc.System]
+				if !ok || len(systemCodes) == 0 {
+					return nil, false
+				}
+				codes = append(codes, systemCodes...)
+			}
+		}
+		return codes, len(codes) > 0
+	}
+
+	result := map[string][]string{}
+	for url := range valueSets {
+		if codes, ok := expand(url, 0); ok {
+			result[url] = codes
+		}
+	}
+	return result, nil
+}
+
+var rulesTemplate = template.Must(template.New("rules").Parse(`// Code generated by resourcegen; DO NOT EDIT.
+
+package {{.Name}}
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/{{.Version}}"
+)
+
+// {{.Version}}Rules are the validation rules of the {{.Version}} package
+var {{.Version}}Rules = ruleSet{
+	// cardinalities lists the required and limited elements of each struct, a max of -1 is unbounded
+	cardinalities: map[reflect.Type][]cardinality{
+{{- range .Structs}}
+		reflect.TypeOf({{.Type}}{}): { {{- range $i, $e := .Elements}}{{if $i}}, {{end}}{"{{$e.Name}}", {{$e.Min}}, {{$e.Max}}}{{end -}} },
+{{- end}}
+	},
+
+	// enumValues lists the allowed values of the code enum types
+	enumValues: map[reflect.Type][]string{
+{{- range .Enums}}
+		reflect.TypeOf({{.Type}}("")): { {{- range $i, $v := .Values}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end -}} },
+{{- end}}
+	},
+}
+`))
@@ -78,7 +78,7 @@ func createPatientExample() *fhir4.Patient {
 			},
 		},
 		Gender:    administrativeGenderPtr(fhir4.AdministrativeGenderMale),
-		BirthDate: stringPtr("1985-03-15"),
+		BirthDate: common.DatePtr(common.MustParseDate("1985-03-15")),
 		Address: []fhir4.Address{
 			{
 				Use:        addressUsePtr(fhir4.AddressUseHome),
@@ -89,7 +89,7 @@ func createPatientExample() *fhir4.Patient {
 				PostalCode: stringPtr("62701"),
 				Country:    stringPtr("US"),
 				Period: &common.Period{
-					Start: parseFHIRDateTimePtr("2020-01-01T00:00:00Z"),
+					Start: parseDateTimePtr("2020-01-01T00:00:00Z"),
 				},
 			},
 		},
@@ -155,12 +155,12 @@ func boolPtr(b bool) *bool {
 	return &b
 }
 
-func parseFHIRDateTimePtr(s string) *common.FHIRDateTime {
-	fhirDateTime, err := common.ParseDateTime(s)
+func parseDateTimePtr(s string) *common.DateTime {
+	dateTime, err := common.ParseDateTime(s)
 	if err != nil {
 		return nil
 	}
-	return fhirDateTime
+	return &dateTime
 }
 
 func identifierUsePtr(use common.IdentifierUse) *common.IdentifierUse {
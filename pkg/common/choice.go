@@ -0,0 +1,136 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ChoiceValidator is implemented by structs with choice elements [x]. The
+// accessors and ValidateChoices methods are generated by resourcegen.
+type ChoiceValidator interface {
+	// ValidateChoices reports the choice elements of the struct that have
+	// more than one populated type, without looking into nested structs
+	ValidateChoices() error
+}
+
+// ChoiceError reports a choice element with more than one populated type
+type ChoiceError struct {
+	// Path of the choice element, e.g. "Observation.component[0].value[x]"
+	Path string
+
+	// Types are the populated types, e.g. ["Quantity", "String"]
+	Types []string
+}
+
+func (e *ChoiceError) Error() string {
+	return fmt.Sprintf("%s: only one of %s may be set", e.Path, strings.Join(e.Types, ", "))
+}
+
+// CheckChoice returns a *ChoiceError if more than one of the types of the
+// choice element is set. The flags in set correspond to types.
+func CheckChoice(element string, types []string, set ...bool) error {
+	var populated []string
+	for i, ok := range set {
+		if ok {
+			populated = append(populated, types[i])
+		}
+	}
+	if len(populated) < 2 {
+		return nil
+	}
+	return &ChoiceError{Path: element, Types: populated}
+}
+
+// ChoiceTypeError is returned by the generated SetXxx accessors for values
+// that are not one of the types of the choice element
+func ChoiceTypeError(element string, v interface{}) error {
+	return fmt.Errorf("%T is not a type of %s", v, element)
+}
+
+// ChoiceTypeNameError is returned by the generated SetXxxAs accessors for
+// unknown type names or values that do not match the type name
+func ChoiceTypeNameError(element, typeName string, v interface{}) error {
+	return fmt.Errorf("%T is not a valid %s value of %s", v, typeName, element)
+}
+
+// ValidateChoices checks v and every struct reachable from it, including
+// contained and bundled resources, for choice elements with more than one
+// populated type. The returned error joins a *ChoiceError for each violation.
+func ValidateChoices(v interface{}) error {
+	root := ""
+	if r, ok := v.(Resource); ok {
+		root = r.GetResourceType()
+	}
+	var errs []error
+	validateChoices(reflect.ValueOf(v), root, &errs)
+	return errors.Join(errs...)
+}
+
+func validateChoices(v reflect.Value, path string, errs *[]error) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			validateChoices(v.Elem(), path, errs)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			validateChoices(v.Index(i), path+"["+strconv.Itoa(i)+"]", errs)
+		}
+	case reflect.Struct:
+		if v.CanAddr() {
+			if validator, ok := v.Addr().Interface().(ChoiceValidator); ok {
+				collectChoiceErrors(validator.ValidateChoices(), path, errs)
+			}
+		}
+		validateFields(v, path, errs)
+	}
+}
+
+// validateFields walks the fields of a struct, embedded structs are walked
+// without checking their choices a second time
+func validateFields(v reflect.Value, path string, errs *[]error) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			validateFields(v.Field(i), path, errs)
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || strings.HasPrefix(name, "_") {
+			continue
+		}
+		validateChoices(v.Field(i), joinPath(path, name), errs)
+	}
+}
+
+func collectChoiceErrors(err error, path string, errs *[]error) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			collectChoiceErrors(e, path, errs)
+		}
+		return
+	}
+	var choiceErr *ChoiceError
+	if errors.As(err, &choiceErr) {
+		*errs = append(*errs, &ChoiceError{Path: joinPath(path, choiceErr.Path), Types: choiceErr.Types})
+		return
+	}
+	*errs = append(*errs, err)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
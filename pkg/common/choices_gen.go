@@ -0,0 +1,1328 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package common
+
+import (
+	"errors"
+)
+
+var _ ChoiceValidator = (*Annotation)(nil)
+
+// ValidateChoices reports the choice elements of Annotation with more than one populated type
+func (s *Annotation) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("author[x]", []string{"Reference", "String"},
+		s.AuthorReference != nil,
+		s.AuthorString != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Author returns the populated type of author[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *Annotation) Author() (interface{}, string) {
+	switch {
+	case s.AuthorReference != nil:
+		return s.AuthorReference, "Reference"
+	case s.AuthorString != nil:
+		return s.AuthorString, "String"
+	}
+	return nil, ""
+}
+
+// SetAuthor sets author[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetAuthorAs. A nil v clears all types.
+func (s *Annotation) SetAuthor(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearAuthor()
+		return nil
+	case *Reference:
+		return s.SetAuthorAs("Reference", v)
+	case *string:
+		return s.SetAuthorAs("String", v)
+	}
+	return ChoiceTypeError("author[x]", v)
+}
+
+// SetAuthorAs sets author[x] to v as the FHIR type typeName, e.g. "Reference",
+// and clears the other types
+func (s *Annotation) SetAuthorAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "Reference":
+		if x, ok := v.(*Reference); ok {
+			s.clearAuthor()
+			s.AuthorReference = x
+			return nil
+		}
+	case "String":
+		if x, ok := v.(*string); ok {
+			s.clearAuthor()
+			s.AuthorString = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("author[x]", typeName, v)
+}
+
+func (s *Annotation) clearAuthor() {
+	s.AuthorReference = nil
+	s.AuthorString = nil
+	s.AuthorStringElement = nil
+}
+
+var _ ChoiceValidator = (*DataRequirement)(nil)
+
+// ValidateChoices reports the choice elements of DataRequirement with more than one populated type
+func (s *DataRequirement) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("subject[x]", []string{"CodeableConcept", "Reference"},
+		s.SubjectCodeableConcept != nil,
+		s.SubjectReference != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Subject returns the populated type of subject[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *DataRequirement) Subject() (interface{}, string) {
+	switch {
+	case s.SubjectCodeableConcept != nil:
+		return s.SubjectCodeableConcept, "CodeableConcept"
+	case s.SubjectReference != nil:
+		return s.SubjectReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetSubject sets subject[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetSubjectAs. A nil v clears all types.
+func (s *DataRequirement) SetSubject(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearSubject()
+		return nil
+	case *CodeableConcept:
+		return s.SetSubjectAs("CodeableConcept", v)
+	case *Reference:
+		return s.SetSubjectAs("Reference", v)
+	}
+	return ChoiceTypeError("subject[x]", v)
+}
+
+// SetSubjectAs sets subject[x] to v as the FHIR type typeName, e.g. "CodeableConcept",
+// and clears the other types
+func (s *DataRequirement) SetSubjectAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "CodeableConcept":
+		if x, ok := v.(*CodeableConcept); ok {
+			s.clearSubject()
+			s.SubjectCodeableConcept = x
+			return nil
+		}
+	case "Reference":
+		if x, ok := v.(*Reference); ok {
+			s.clearSubject()
+			s.SubjectReference = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("subject[x]", typeName, v)
+}
+
+func (s *DataRequirement) clearSubject() {
+	s.SubjectCodeableConcept = nil
+	s.SubjectReference = nil
+}
+
+var _ ChoiceValidator = (*DataRequirementDateFilter)(nil)
+
+// ValidateChoices reports the choice elements of DataRequirementDateFilter with more than one populated type
+func (s *DataRequirementDateFilter) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("value[x]", []string{"DateTime", "Period", "Duration"},
+		s.ValueDateTime != nil,
+		s.ValuePeriod != nil,
+		s.ValueDuration != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Value returns the populated type of value[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *DataRequirementDateFilter) Value() (interface{}, string) {
+	switch {
+	case s.ValueDateTime != nil:
+		return s.ValueDateTime, "DateTime"
+	case s.ValuePeriod != nil:
+		return s.ValuePeriod, "Period"
+	case s.ValueDuration != nil:
+		return s.ValueDuration, "Duration"
+	}
+	return nil, ""
+}
+
+// SetValue sets value[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetValueAs. A nil v clears all types.
+func (s *DataRequirementDateFilter) SetValue(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearValue()
+		return nil
+	case *DateTime:
+		return s.SetValueAs("DateTime", v)
+	case *Period:
+		return s.SetValueAs("Period", v)
+	case *Duration:
+		return s.SetValueAs("Duration", v)
+	}
+	return ChoiceTypeError("value[x]", v)
+}
+
+// SetValueAs sets value[x] to v as the FHIR type typeName, e.g. "DateTime",
+// and clears the other types
+func (s *DataRequirementDateFilter) SetValueAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "DateTime":
+		if x, ok := v.(*DateTime); ok {
+			s.clearValue()
+			s.ValueDateTime = x
+			return nil
+		}
+	case "Period":
+		if x, ok := v.(*Period); ok {
+			s.clearValue()
+			s.ValuePeriod = x
+			return nil
+		}
+	case "Duration":
+		if x, ok := v.(*Duration); ok {
+			s.clearValue()
+			s.ValueDuration = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("value[x]", typeName, v)
+}
+
+func (s *DataRequirementDateFilter) clearValue() {
+	s.ValueDateTime = nil
+	s.ValueDateTimeElement = nil
+	s.ValuePeriod = nil
+	s.ValueDuration = nil
+}
+
+var _ ChoiceValidator = (*DosageDoseAndRate)(nil)
+
+// ValidateChoices reports the choice elements of DosageDoseAndRate with more than one populated type
+func (s *DosageDoseAndRate) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("dose[x]", []string{"Range", "Quantity"},
+		s.DoseRange != nil,
+		s.DoseQuantity != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if err := CheckChoice("rate[x]", []string{"Ratio", "Range", "Quantity"},
+		s.RateRatio != nil,
+		s.RateRange != nil,
+		s.RateQuantity != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Dose returns the populated type of dose[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *DosageDoseAndRate) Dose() (interface{}, string) {
+	switch {
+	case s.DoseRange != nil:
+		return s.DoseRange, "Range"
+	case s.DoseQuantity != nil:
+		return s.DoseQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetDose sets dose[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetDoseAs. A nil v clears all types.
+func (s *DosageDoseAndRate) SetDose(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearDose()
+		return nil
+	case *Range:
+		return s.SetDoseAs("Range", v)
+	case *Quantity:
+		return s.SetDoseAs("Quantity", v)
+	}
+	return ChoiceTypeError("dose[x]", v)
+}
+
+// SetDoseAs sets dose[x] to v as the FHIR type typeName, e.g. "Range",
+// and clears the other types
+func (s *DosageDoseAndRate) SetDoseAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "Range":
+		if x, ok := v.(*Range); ok {
+			s.clearDose()
+			s.DoseRange = x
+			return nil
+		}
+	case "Quantity":
+		if x, ok := v.(*Quantity); ok {
+			s.clearDose()
+			s.DoseQuantity = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("dose[x]", typeName, v)
+}
+
+func (s *DosageDoseAndRate) clearDose() {
+	s.DoseRange = nil
+	s.DoseQuantity = nil
+}
+
+// Rate returns the populated type of rate[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *DosageDoseAndRate) Rate() (interface{}, string) {
+	switch {
+	case s.RateRatio != nil:
+		return s.RateRatio, "Ratio"
+	case s.RateRange != nil:
+		return s.RateRange, "Range"
+	case s.RateQuantity != nil:
+		return s.RateQuantity, "Quantity"
+	}
+	return nil, ""
+}
+
+// SetRate sets rate[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetRateAs. A nil v clears all types.
+func (s *DosageDoseAndRate) SetRate(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearRate()
+		return nil
+	case *Ratio:
+		return s.SetRateAs("Ratio", v)
+	case *Range:
+		return s.SetRateAs("Range", v)
+	case *Quantity:
+		return s.SetRateAs("Quantity", v)
+	}
+	return ChoiceTypeError("rate[x]", v)
+}
+
+// SetRateAs sets rate[x] to v as the FHIR type typeName, e.g. "Ratio",
+// and clears the other types
+func (s *DosageDoseAndRate) SetRateAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "Ratio":
+		if x, ok := v.(*Ratio); ok {
+			s.clearRate()
+			s.RateRatio = x
+			return nil
+		}
+	case "Range":
+		if x, ok := v.(*Range); ok {
+			s.clearRate()
+			s.RateRange = x
+			return nil
+		}
+	case "Quantity":
+		if x, ok := v.(*Quantity); ok {
+			s.clearRate()
+			s.RateQuantity = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("rate[x]", typeName, v)
+}
+
+func (s *DosageDoseAndRate) clearRate() {
+	s.RateRatio = nil
+	s.RateRange = nil
+	s.RateQuantity = nil
+}
+
+var _ ChoiceValidator = (*Extension)(nil)
+
+// ValidateChoices reports the choice elements of Extension with more than one populated type
+func (s *Extension) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("value[x]", []string{"Base64Binary", "Boolean", "Canonical", "Code", "Date", "DateTime", "Decimal", "Id", "Instant", "Integer", "Integer64", "Markdown", "Oid", "PositiveInt", "String", "Time", "UnsignedInt", "Uri", "Url", "Uuid", "Address", "Age", "Annotation", "Attachment", "CodeableConcept", "Coding", "ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "Reference", "SampledData", "Signature", "Timing", "ContactDetail", "Contributor", "DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Dosage", "Meta", "Availability", "CodeableReference", "ExtendedContactDetail", "RatioRange", "VirtualServiceDetail"},
+		s.ValueBase64Binary != nil,
+		s.ValueBoolean != nil,
+		s.ValueCanonical != nil,
+		s.ValueCode != nil,
+		s.ValueDate != nil,
+		s.ValueDateTime != nil,
+		s.ValueDecimal != nil,
+		s.ValueId != nil,
+		s.ValueInstant != nil,
+		s.ValueInteger != nil,
+		s.ValueInteger64 != nil,
+		s.ValueMarkdown != nil,
+		s.ValueOid != nil,
+		s.ValuePositiveInt != nil,
+		s.ValueString != nil,
+		s.ValueTime != nil,
+		s.ValueUnsignedInt != nil,
+		s.ValueUri != nil,
+		s.ValueUrl != nil,
+		s.ValueUuid != nil,
+		s.ValueAddress != nil,
+		s.ValueAge != nil,
+		s.ValueAnnotation != nil,
+		s.ValueAttachment != nil,
+		s.ValueCodeableConcept != nil,
+		s.ValueCoding != nil,
+		s.ValueContactPoint != nil,
+		s.ValueCount != nil,
+		s.ValueDistance != nil,
+		s.ValueDuration != nil,
+		s.ValueHumanName != nil,
+		s.ValueIdentifier != nil,
+		s.ValueMoney != nil,
+		s.ValuePeriod != nil,
+		s.ValueQuantity != nil,
+		s.ValueRange != nil,
+		s.ValueRatio != nil,
+		s.ValueReference != nil,
+		s.ValueSampledData != nil,
+		s.ValueSignature != nil,
+		s.ValueTiming != nil,
+		s.ValueContactDetail != nil,
+		s.ValueContributor != nil,
+		s.ValueDataRequirement != nil,
+		s.ValueExpression != nil,
+		s.ValueParameterDefinition != nil,
+		s.ValueRelatedArtifact != nil,
+		s.ValueTriggerDefinition != nil,
+		s.ValueUsageContext != nil,
+		s.ValueDosage != nil,
+		s.ValueMeta != nil,
+		s.ValueAvailability != nil,
+		s.ValueCodeableReference != nil,
+		s.ValueExtendedContactDetail != nil,
+		s.ValueRatioRange != nil,
+		s.ValueVirtualServiceDetail != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Value returns the populated type of value[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *Extension) Value() (interface{}, string) {
+	switch {
+	case s.ValueBase64Binary != nil:
+		return s.ValueBase64Binary, "Base64Binary"
+	case s.ValueBoolean != nil:
+		return s.ValueBoolean, "Boolean"
+	case s.ValueCanonical != nil:
+		return s.ValueCanonical, "Canonical"
+	case s.ValueCode != nil:
+		return s.ValueCode, "Code"
+	case s.ValueDate != nil:
+		return s.ValueDate, "Date"
+	case s.ValueDateTime != nil:
+		return s.ValueDateTime, "DateTime"
+	case s.ValueDecimal != nil:
+		return s.ValueDecimal, "Decimal"
+	case s.ValueId != nil:
+		return s.ValueId, "Id"
+	case s.ValueInstant != nil:
+		return s.ValueInstant, "Instant"
+	case s.ValueInteger != nil:
+		return s.ValueInteger, "Integer"
+	case s.ValueInteger64 != nil:
+		return s.ValueInteger64, "Integer64"
+	case s.ValueMarkdown != nil:
+		return s.ValueMarkdown, "Markdown"
+	case s.ValueOid != nil:
+		return s.ValueOid, "Oid"
+	case s.ValuePositiveInt != nil:
+		return s.ValuePositiveInt, "PositiveInt"
+	case s.ValueString != nil:
+		return s.ValueString, "String"
+	case s.ValueTime != nil:
+		return s.ValueTime, "Time"
+	case s.ValueUnsignedInt != nil:
+		return s.ValueUnsignedInt, "UnsignedInt"
+	case s.ValueUri != nil:
+		return s.ValueUri, "Uri"
+	case s.ValueUrl != nil:
+		return s.ValueUrl, "Url"
+	case s.ValueUuid != nil:
+		return s.ValueUuid, "Uuid"
+	case s.ValueAddress != nil:
+		return s.ValueAddress, "Address"
+	case s.ValueAge != nil:
+		return s.ValueAge, "Age"
+	case s.ValueAnnotation != nil:
+		return s.ValueAnnotation, "Annotation"
+	case s.ValueAttachment != nil:
+		return s.ValueAttachment, "Attachment"
+	case s.ValueCodeableConcept != nil:
+		return s.ValueCodeableConcept, "CodeableConcept"
+	case s.ValueCoding != nil:
+		return s.ValueCoding, "Coding"
+	case s.ValueContactPoint != nil:
+		return s.ValueContactPoint, "ContactPoint"
+	case s.ValueCount != nil:
+		return s.ValueCount, "Count"
+	case s.ValueDistance != nil:
+		return s.ValueDistance, "Distance"
+	case s.ValueDuration != nil:
+		return s.ValueDuration, "Duration"
+	case s.ValueHumanName != nil:
+		return s.ValueHumanName, "HumanName"
+	case s.ValueIdentifier != nil:
+		return s.ValueIdentifier, "Identifier"
+	case s.ValueMoney != nil:
+		return s.ValueMoney, "Money"
+	case s.ValuePeriod != nil:
+		return s.ValuePeriod, "Period"
+	case s.ValueQuantity != nil:
+		return s.ValueQuantity, "Quantity"
+	case s.ValueRange != nil:
+		return s.ValueRange, "Range"
+	case s.ValueRatio != nil:
+		return s.ValueRatio, "Ratio"
+	case s.ValueReference != nil:
+		return s.ValueReference, "Reference"
+	case s.ValueSampledData != nil:
+		return s.ValueSampledData, "SampledData"
+	case s.ValueSignature != nil:
+		return s.ValueSignature, "Signature"
+	case s.ValueTiming != nil:
+		return s.ValueTiming, "Timing"
+	case s.ValueContactDetail != nil:
+		return s.ValueContactDetail, "ContactDetail"
+	case s.ValueContributor != nil:
+		return s.ValueContributor, "Contributor"
+	case s.ValueDataRequirement != nil:
+		return s.ValueDataRequirement, "DataRequirement"
+	case s.ValueExpression != nil:
+		return s.ValueExpression, "Expression"
+	case s.ValueParameterDefinition != nil:
+		return s.ValueParameterDefinition, "ParameterDefinition"
+	case s.ValueRelatedArtifact != nil:
+		return s.ValueRelatedArtifact, "RelatedArtifact"
+	case s.ValueTriggerDefinition != nil:
+		return s.ValueTriggerDefinition, "TriggerDefinition"
+	case s.ValueUsageContext != nil:
+		return s.ValueUsageContext, "UsageContext"
+	case s.ValueDosage != nil:
+		return s.ValueDosage, "Dosage"
+	case s.ValueMeta != nil:
+		return s.ValueMeta, "Meta"
+	case s.ValueAvailability != nil:
+		return s.ValueAvailability, "Availability"
+	case s.ValueCodeableReference != nil:
+		return s.ValueCodeableReference, "CodeableReference"
+	case s.ValueExtendedContactDetail != nil:
+		return s.ValueExtendedContactDetail, "ExtendedContactDetail"
+	case s.ValueRatioRange != nil:
+		return s.ValueRatioRange, "RatioRange"
+	case s.ValueVirtualServiceDetail != nil:
+		return s.ValueVirtualServiceDetail, "VirtualServiceDetail"
+	}
+	return nil, ""
+}
+
+// SetValue sets value[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetValueAs. A nil v clears all types.
+func (s *Extension) SetValue(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearValue()
+		return nil
+	case *bool:
+		return s.SetValueAs("Boolean", v)
+	case *Date:
+		return s.SetValueAs("Date", v)
+	case *DateTime:
+		return s.SetValueAs("DateTime", v)
+	case *Decimal:
+		return s.SetValueAs("Decimal", v)
+	case *Instant:
+		return s.SetValueAs("Instant", v)
+	case *int:
+		return s.SetValueAs("Integer", v)
+	case *int64:
+		return s.SetValueAs("Integer64", v)
+	case *string:
+		return s.SetValueAs("String", v)
+	case *Time:
+		return s.SetValueAs("Time", v)
+	case *Address:
+		return s.SetValueAs("Address", v)
+	case *Age:
+		return s.SetValueAs("Age", v)
+	case *Annotation:
+		return s.SetValueAs("Annotation", v)
+	case *Attachment:
+		return s.SetValueAs("Attachment", v)
+	case *CodeableConcept:
+		return s.SetValueAs("CodeableConcept", v)
+	case *Coding:
+		return s.SetValueAs("Coding", v)
+	case *ContactPoint:
+		return s.SetValueAs("ContactPoint", v)
+	case *Count:
+		return s.SetValueAs("Count", v)
+	case *Distance:
+		return s.SetValueAs("Distance", v)
+	case *Duration:
+		return s.SetValueAs("Duration", v)
+	case *HumanName:
+		return s.SetValueAs("HumanName", v)
+	case *Identifier:
+		return s.SetValueAs("Identifier", v)
+	case *Money:
+		return s.SetValueAs("Money", v)
+	case *Period:
+		return s.SetValueAs("Period", v)
+	case *Quantity:
+		return s.SetValueAs("Quantity", v)
+	case *Range:
+		return s.SetValueAs("Range", v)
+	case *Ratio:
+		return s.SetValueAs("Ratio", v)
+	case *Reference:
+		return s.SetValueAs("Reference", v)
+	case *SampledData:
+		return s.SetValueAs("SampledData", v)
+	case *Signature:
+		return s.SetValueAs("Signature", v)
+	case *Timing:
+		return s.SetValueAs("Timing", v)
+	case *ContactDetail:
+		return s.SetValueAs("ContactDetail", v)
+	case *Contributor:
+		return s.SetValueAs("Contributor", v)
+	case *DataRequirement:
+		return s.SetValueAs("DataRequirement", v)
+	case *Expression:
+		return s.SetValueAs("Expression", v)
+	case *ParameterDefinition:
+		return s.SetValueAs("ParameterDefinition", v)
+	case *RelatedArtifact:
+		return s.SetValueAs("RelatedArtifact", v)
+	case *TriggerDefinition:
+		return s.SetValueAs("TriggerDefinition", v)
+	case *UsageContext:
+		return s.SetValueAs("UsageContext", v)
+	case *Dosage:
+		return s.SetValueAs("Dosage", v)
+	case *Meta:
+		return s.SetValueAs("Meta", v)
+	case *CodeableReference:
+		return s.SetValueAs("CodeableReference", v)
+	}
+	return ChoiceTypeError("value[x]", v)
+}
+
+// SetValueAs sets value[x] to v as the FHIR type typeName, e.g. "Base64Binary",
+// and clears the other types
+func (s *Extension) SetValueAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "Base64Binary":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueBase64Binary = x
+			return nil
+		}
+	case "Boolean":
+		if x, ok := v.(*bool); ok {
+			s.clearValue()
+			s.ValueBoolean = x
+			return nil
+		}
+	case "Canonical":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueCanonical = x
+			return nil
+		}
+	case "Code":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueCode = x
+			return nil
+		}
+	case "Date":
+		if x, ok := v.(*Date); ok {
+			s.clearValue()
+			s.ValueDate = x
+			return nil
+		}
+	case "DateTime":
+		if x, ok := v.(*DateTime); ok {
+			s.clearValue()
+			s.ValueDateTime = x
+			return nil
+		}
+	case "Decimal":
+		if x, ok := v.(*Decimal); ok {
+			s.clearValue()
+			s.ValueDecimal = x
+			return nil
+		}
+	case "Id":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueId = x
+			return nil
+		}
+	case "Instant":
+		if x, ok := v.(*Instant); ok {
+			s.clearValue()
+			s.ValueInstant = x
+			return nil
+		}
+	case "Integer":
+		if x, ok := v.(*int); ok {
+			s.clearValue()
+			s.ValueInteger = x
+			return nil
+		}
+	case "Integer64":
+		if x, ok := v.(*int64); ok {
+			s.clearValue()
+			s.ValueInteger64 = x
+			return nil
+		}
+	case "Markdown":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueMarkdown = x
+			return nil
+		}
+	case "Oid":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueOid = x
+			return nil
+		}
+	case "PositiveInt":
+		if x, ok := v.(*int); ok {
+			s.clearValue()
+			s.ValuePositiveInt = x
+			return nil
+		}
+	case "String":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueString = x
+			return nil
+		}
+	case "Time":
+		if x, ok := v.(*Time); ok {
+			s.clearValue()
+			s.ValueTime = x
+			return nil
+		}
+	case "UnsignedInt":
+		if x, ok := v.(*int); ok {
+			s.clearValue()
+			s.ValueUnsignedInt = x
+			return nil
+		}
+	case "Uri":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueUri = x
+			return nil
+		}
+	case "Url":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueUrl = x
+			return nil
+		}
+	case "Uuid":
+		if x, ok := v.(*string); ok {
+			s.clearValue()
+			s.ValueUuid = x
+			return nil
+		}
+	case "Address":
+		if x, ok := v.(*Address); ok {
+			s.clearValue()
+			s.ValueAddress = x
+			return nil
+		}
+	case "Age":
+		if x, ok := v.(*Age); ok {
+			s.clearValue()
+			s.ValueAge = x
+			return nil
+		}
+	case "Annotation":
+		if x, ok := v.(*Annotation); ok {
+			s.clearValue()
+			s.ValueAnnotation = x
+			return nil
+		}
+	case "Attachment":
+		if x, ok := v.(*Attachment); ok {
+			s.clearValue()
+			s.ValueAttachment = x
+			return nil
+		}
+	case "CodeableConcept":
+		if x, ok := v.(*CodeableConcept); ok {
+			s.clearValue()
+			s.ValueCodeableConcept = x
+			return nil
+		}
+	case "Coding":
+		if x, ok := v.(*Coding); ok {
+			s.clearValue()
+			s.ValueCoding = x
+			return nil
+		}
+	case "ContactPoint":
+		if x, ok := v.(*ContactPoint); ok {
+			s.clearValue()
+			s.ValueContactPoint = x
+			return nil
+		}
+	case "Count":
+		if x, ok := v.(*Count); ok {
+			s.clearValue()
+			s.ValueCount = x
+			return nil
+		}
+	case "Distance":
+		if x, ok := v.(*Distance); ok {
+			s.clearValue()
+			s.ValueDistance = x
+			return nil
+		}
+	case "Duration":
+		if x, ok := v.(*Duration); ok {
+			s.clearValue()
+			s.ValueDuration = x
+			return nil
+		}
+	case "HumanName":
+		if x, ok := v.(*HumanName); ok {
+			s.clearValue()
+			s.ValueHumanName = x
+			return nil
+		}
+	case "Identifier":
+		if x, ok := v.(*Identifier); ok {
+			s.clearValue()
+			s.ValueIdentifier = x
+			return nil
+		}
+	case "Money":
+		if x, ok := v.(*Money); ok {
+			s.clearValue()
+			s.ValueMoney = x
+			return nil
+		}
+	case "Period":
+		if x, ok := v.(*Period); ok {
+			s.clearValue()
+			s.ValuePeriod = x
+			return nil
+		}
+	case "Quantity":
+		if x, ok := v.(*Quantity); ok {
+			s.clearValue()
+			s.ValueQuantity = x
+			return nil
+		}
+	case "Range":
+		if x, ok := v.(*Range); ok {
+			s.clearValue()
+			s.ValueRange = x
+			return nil
+		}
+	case "Ratio":
+		if x, ok := v.(*Ratio); ok {
+			s.clearValue()
+			s.ValueRatio = x
+			return nil
+		}
+	case "Reference":
+		if x, ok := v.(*Reference); ok {
+			s.clearValue()
+			s.ValueReference = x
+			return nil
+		}
+	case "SampledData":
+		if x, ok := v.(*SampledData); ok {
+			s.clearValue()
+			s.ValueSampledData = x
+			return nil
+		}
+	case "Signature":
+		if x, ok := v.(*Signature); ok {
+			s.clearValue()
+			s.ValueSignature = x
+			return nil
+		}
+	case "Timing":
+		if x, ok := v.(*Timing); ok {
+			s.clearValue()
+			s.ValueTiming = x
+			return nil
+		}
+	case "ContactDetail":
+		if x, ok := v.(*ContactDetail); ok {
+			s.clearValue()
+			s.ValueContactDetail = x
+			return nil
+		}
+	case "Contributor":
+		if x, ok := v.(*Contributor); ok {
+			s.clearValue()
+			s.ValueContributor = x
+			return nil
+		}
+	case "DataRequirement":
+		if x, ok := v.(*DataRequirement); ok {
+			s.clearValue()
+			s.ValueDataRequirement = x
+			return nil
+		}
+	case "Expression":
+		if x, ok := v.(*Expression); ok {
+			s.clearValue()
+			s.ValueExpression = x
+			return nil
+		}
+	case "ParameterDefinition":
+		if x, ok := v.(*ParameterDefinition); ok {
+			s.clearValue()
+			s.ValueParameterDefinition = x
+			return nil
+		}
+	case "RelatedArtifact":
+		if x, ok := v.(*RelatedArtifact); ok {
+			s.clearValue()
+			s.ValueRelatedArtifact = x
+			return nil
+		}
+	case "TriggerDefinition":
+		if x, ok := v.(*TriggerDefinition); ok {
+			s.clearValue()
+			s.ValueTriggerDefinition = x
+			return nil
+		}
+	case "UsageContext":
+		if x, ok := v.(*UsageContext); ok {
+			s.clearValue()
+			s.ValueUsageContext = x
+			return nil
+		}
+	case "Dosage":
+		if x, ok := v.(*Dosage); ok {
+			s.clearValue()
+			s.ValueDosage = x
+			return nil
+		}
+	case "Meta":
+		if x, ok := v.(*Meta); ok {
+			s.clearValue()
+			s.ValueMeta = x
+			return nil
+		}
+	case "Availability":
+		if x, ok := v.(*interface{}); ok {
+			s.clearValue()
+			s.ValueAvailability = x
+			return nil
+		}
+	case "CodeableReference":
+		if x, ok := v.(*CodeableReference); ok {
+			s.clearValue()
+			s.ValueCodeableReference = x
+			return nil
+		}
+	case "ExtendedContactDetail":
+		if x, ok := v.(*interface{}); ok {
+			s.clearValue()
+			s.ValueExtendedContactDetail = x
+			return nil
+		}
+	case "RatioRange":
+		if x, ok := v.(*interface{}); ok {
+			s.clearValue()
+			s.ValueRatioRange = x
+			return nil
+		}
+	case "VirtualServiceDetail":
+		if x, ok := v.(*interface{}); ok {
+			s.clearValue()
+			s.ValueVirtualServiceDetail = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("value[x]", typeName, v)
+}
+
+func (s *Extension) clearValue() {
+	s.ValueBase64Binary = nil
+	s.ValueBase64BinaryElement = nil
+	s.ValueBoolean = nil
+	s.ValueBooleanElement = nil
+	s.ValueCanonical = nil
+	s.ValueCanonicalElement = nil
+	s.ValueCode = nil
+	s.ValueCodeElement = nil
+	s.ValueDate = nil
+	s.ValueDateElement = nil
+	s.ValueDateTime = nil
+	s.ValueDateTimeElement = nil
+	s.ValueDecimal = nil
+	s.ValueDecimalElement = nil
+	s.ValueId = nil
+	s.ValueIdElement = nil
+	s.ValueInstant = nil
+	s.ValueInstantElement = nil
+	s.ValueInteger = nil
+	s.ValueIntegerElement = nil
+	s.ValueInteger64 = nil
+	s.ValueInteger64Element = nil
+	s.ValueMarkdown = nil
+	s.ValueMarkdownElement = nil
+	s.ValueOid = nil
+	s.ValueOidElement = nil
+	s.ValuePositiveInt = nil
+	s.ValuePositiveIntElement = nil
+	s.ValueString = nil
+	s.ValueStringElement = nil
+	s.ValueTime = nil
+	s.ValueTimeElement = nil
+	s.ValueUnsignedInt = nil
+	s.ValueUnsignedIntElement = nil
+	s.ValueUri = nil
+	s.ValueUriElement = nil
+	s.ValueUrl = nil
+	s.ValueUrlElement = nil
+	s.ValueUuid = nil
+	s.ValueUuidElement = nil
+	s.ValueAddress = nil
+	s.ValueAge = nil
+	s.ValueAnnotation = nil
+	s.ValueAttachment = nil
+	s.ValueCodeableConcept = nil
+	s.ValueCoding = nil
+	s.ValueContactPoint = nil
+	s.ValueCount = nil
+	s.ValueDistance = nil
+	s.ValueDuration = nil
+	s.ValueHumanName = nil
+	s.ValueIdentifier = nil
+	s.ValueMoney = nil
+	s.ValuePeriod = nil
+	s.ValueQuantity = nil
+	s.ValueRange = nil
+	s.ValueRatio = nil
+	s.ValueReference = nil
+	s.ValueSampledData = nil
+	s.ValueSignature = nil
+	s.ValueTiming = nil
+	s.ValueContactDetail = nil
+	s.ValueContributor = nil
+	s.ValueDataRequirement = nil
+	s.ValueExpression = nil
+	s.ValueParameterDefinition = nil
+	s.ValueRelatedArtifact = nil
+	s.ValueTriggerDefinition = nil
+	s.ValueUsageContext = nil
+	s.ValueDosage = nil
+	s.ValueMeta = nil
+	s.ValueAvailability = nil
+	s.ValueCodeableReference = nil
+	s.ValueExtendedContactDetail = nil
+	s.ValueRatioRange = nil
+	s.ValueVirtualServiceDetail = nil
+}
+
+var _ ChoiceValidator = (*TimingRepeat)(nil)
+
+// ValidateChoices reports the choice elements of TimingRepeat with more than one populated type
+func (s *TimingRepeat) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("bounds[x]", []string{"Duration", "Range", "Period"},
+		s.BoundsDuration != nil,
+		s.BoundsRange != nil,
+		s.BoundsPeriod != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Bounds returns the populated type of bounds[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *TimingRepeat) Bounds() (interface{}, string) {
+	switch {
+	case s.BoundsDuration != nil:
+		return s.BoundsDuration, "Duration"
+	case s.BoundsRange != nil:
+		return s.BoundsRange, "Range"
+	case s.BoundsPeriod != nil:
+		return s.BoundsPeriod, "Period"
+	}
+	return nil, ""
+}
+
+// SetBounds sets bounds[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetBoundsAs. A nil v clears all types.
+func (s *TimingRepeat) SetBounds(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearBounds()
+		return nil
+	case *Duration:
+		return s.SetBoundsAs("Duration", v)
+	case *Range:
+		return s.SetBoundsAs("Range", v)
+	case *Period:
+		return s.SetBoundsAs("Period", v)
+	}
+	return ChoiceTypeError("bounds[x]", v)
+}
+
+// SetBoundsAs sets bounds[x] to v as the FHIR type typeName, e.g. "Duration",
+// and clears the other types
+func (s *TimingRepeat) SetBoundsAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "Duration":
+		if x, ok := v.(*Duration); ok {
+			s.clearBounds()
+			s.BoundsDuration = x
+			return nil
+		}
+	case "Range":
+		if x, ok := v.(*Range); ok {
+			s.clearBounds()
+			s.BoundsRange = x
+			return nil
+		}
+	case "Period":
+		if x, ok := v.(*Period); ok {
+			s.clearBounds()
+			s.BoundsPeriod = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("bounds[x]", typeName, v)
+}
+
+func (s *TimingRepeat) clearBounds() {
+	s.BoundsDuration = nil
+	s.BoundsRange = nil
+	s.BoundsPeriod = nil
+}
+
+var _ ChoiceValidator = (*TriggerDefinition)(nil)
+
+// ValidateChoices reports the choice elements of TriggerDefinition with more than one populated type
+func (s *TriggerDefinition) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("timing[x]", []string{"Timing", "Reference", "Date", "DateTime"},
+		s.TimingTiming != nil,
+		s.TimingReference != nil,
+		s.TimingDate != nil,
+		s.TimingDateTime != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Timing returns the populated type of timing[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *TriggerDefinition) Timing() (interface{}, string) {
+	switch {
+	case s.TimingTiming != nil:
+		return s.TimingTiming, "Timing"
+	case s.TimingReference != nil:
+		return s.TimingReference, "Reference"
+	case s.TimingDate != nil:
+		return s.TimingDate, "Date"
+	case s.TimingDateTime != nil:
+		return s.TimingDateTime, "DateTime"
+	}
+	return nil, ""
+}
+
+// SetTiming sets timing[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetTimingAs. A nil v clears all types.
+func (s *TriggerDefinition) SetTiming(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearTiming()
+		return nil
+	case *Timing:
+		return s.SetTimingAs("Timing", v)
+	case *Reference:
+		return s.SetTimingAs("Reference", v)
+	case *Date:
+		return s.SetTimingAs("Date", v)
+	case *DateTime:
+		return s.SetTimingAs("DateTime", v)
+	}
+	return ChoiceTypeError("timing[x]", v)
+}
+
+// SetTimingAs sets timing[x] to v as the FHIR type typeName, e.g. "Timing",
+// and clears the other types
+func (s *TriggerDefinition) SetTimingAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "Timing":
+		if x, ok := v.(*Timing); ok {
+			s.clearTiming()
+			s.TimingTiming = x
+			return nil
+		}
+	case "Reference":
+		if x, ok := v.(*Reference); ok {
+			s.clearTiming()
+			s.TimingReference = x
+			return nil
+		}
+	case "Date":
+		if x, ok := v.(*Date); ok {
+			s.clearTiming()
+			s.TimingDate = x
+			return nil
+		}
+	case "DateTime":
+		if x, ok := v.(*DateTime); ok {
+			s.clearTiming()
+			s.TimingDateTime = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("timing[x]", typeName, v)
+}
+
+func (s *TriggerDefinition) clearTiming() {
+	s.TimingTiming = nil
+	s.TimingReference = nil
+	s.TimingDate = nil
+	s.TimingDateElement = nil
+	s.TimingDateTime = nil
+	s.TimingDateTimeElement = nil
+}
+
+var _ ChoiceValidator = (*UsageContext)(nil)
+
+// ValidateChoices reports the choice elements of UsageContext with more than one populated type
+func (s *UsageContext) ValidateChoices() error {
+	var errs []error
+	if err := CheckChoice("value[x]", []string{"CodeableConcept", "Quantity", "Range", "Reference"},
+		s.ValueCodeableConcept != nil,
+		s.ValueQuantity != nil,
+		s.ValueRange != nil,
+		s.ValueReference != nil,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Value returns the populated type of value[x] and its FHIR type name,
+// or nil and "" if no type is set
+func (s *UsageContext) Value() (interface{}, string) {
+	switch {
+	case s.ValueCodeableConcept != nil:
+		return s.ValueCodeableConcept, "CodeableConcept"
+	case s.ValueQuantity != nil:
+		return s.ValueQuantity, "Quantity"
+	case s.ValueRange != nil:
+		return s.ValueRange, "Range"
+	case s.ValueReference != nil:
+		return s.ValueReference, "Reference"
+	}
+	return nil, ""
+}
+
+// SetValue sets value[x] to v, a pointer to one of its types, and clears the other
+// types. Types sharing a Go type with another type, e.g. code and string, are set
+// with SetValueAs. A nil v clears all types.
+func (s *UsageContext) SetValue(v interface{}) error {
+	switch v.(type) {
+	case nil:
+		s.clearValue()
+		return nil
+	case *CodeableConcept:
+		return s.SetValueAs("CodeableConcept", v)
+	case *Quantity:
+		return s.SetValueAs("Quantity", v)
+	case *Range:
+		return s.SetValueAs("Range", v)
+	case *Reference:
+		return s.SetValueAs("Reference", v)
+	}
+	return ChoiceTypeError("value[x]", v)
+}
+
+// SetValueAs sets value[x] to v as the FHIR type typeName, e.g. "CodeableConcept",
+// and clears the other types
+func (s *UsageContext) SetValueAs(typeName string, v interface{}) error {
+	switch typeName {
+	case "CodeableConcept":
+		if x, ok := v.(*CodeableConcept); ok {
+			s.clearValue()
+			s.ValueCodeableConcept = x
+			return nil
+		}
+	case "Quantity":
+		if x, ok := v.(*Quantity); ok {
+			s.clearValue()
+			s.ValueQuantity = x
+			return nil
+		}
+	case "Range":
+		if x, ok := v.(*Range); ok {
+			s.clearValue()
+			s.ValueRange = x
+			return nil
+		}
+	case "Reference":
+		if x, ok := v.(*Reference); ok {
+			s.clearValue()
+			s.ValueReference = x
+			return nil
+		}
+	}
+	return ChoiceTypeNameError("value[x]", typeName, v)
+}
+
+func (s *UsageContext) clearValue() {
+	s.ValueCodeableConcept = nil
+	s.ValueQuantity = nil
+	s.ValueRange = nil
+	s.ValueReference = nil
+}
@@ -2,69 +2,541 @@ package common
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
 // FHIR is known to use partial dateTime values (e.g., just "2016-01" or "2016") — the spec explicitly allows this.
 // This is not supported by the time.Time type, so we need to handle it manually.
-// We use the FHIRDateTime type to represent partial dateTime values.
-
-type FHIRDateTime struct {
-	Time      time.Time
-	Precision string
-}
-
-// Supported layouts with increasing precision
-var fhirLayouts = []struct {
-	layout    string
-	precision string
-}{
-	{time.RFC3339, "second"},
-	{"2006-01-02", "day"},
-	{"2006-01", "month"},
-	{"2006", "year"},
-}
-
-// ParseDateTime parses a string into a FHIRDateTime, supporting partial dateTime values
-// as specified in the FHIR specification.
-func ParseDateTime(s string) (*FHIRDateTime, error) {
-	s = strings.TrimSpace(s)
-	for _, l := range fhirLayouts {
-		t, err := time.Parse(l.layout, s)
-		if err == nil {
-			return &FHIRDateTime{
-				Time:      t,
-				Precision: l.precision,
-			}, nil
-		}
+// The Date, DateTime, Instant and Time types keep the original lexical form,
+// including the timezone and fractional seconds, and interpret a partial value
+// as the implicit range it covers: "2016" is the whole year 2016.
+
+// Lexical spaces of the FHIR date and time types
+var (
+	datePattern     = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1]))?)?$`)
+	dateTimePattern = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)(-(0[1-9]|1[0-2])(-(0[1-9]|[1-2][0-9]|3[0-1])(T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?)?)?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00)?)?)?$`)
+	instantPattern  = regexp.MustCompile(`^([0-9]([0-9]([0-9][1-9]|[1-9]0)|[1-9]00)|[1-9]000)-(0[1-9]|1[0-2])-(0[1-9]|[1-2][0-9]|3[0-1])T([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?(Z|(\+|-)((0[0-9]|1[0-3]):[0-5][0-9]|14:00))$`)
+	timePattern     = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]{1,9})?$`)
+
+	zonePattern = regexp.MustCompile(`(Z|[+-][0-9]{2}:[0-9]{2})$`)
+)
+
+// Precision is the granularity with which a date or time value is specified
+type Precision int
+
+const (
+	PrecisionYear Precision = iota
+	PrecisionMonth
+	PrecisionDay
+	PrecisionSecond
+	PrecisionFraction
+)
+
+// String returns the name of the precision
+func (p Precision) String() string {
+	switch p {
+	case PrecisionYear:
+		return "year"
+	case PrecisionMonth:
+		return "month"
+	case PrecisionDay:
+		return "day"
+	case PrecisionSecond:
+		return "second"
+	case PrecisionFraction:
+		return "fraction"
 	}
-	return nil, fmt.Errorf("FHIRDateTime: unable to parse %q", s)
+	return "unknown"
 }
 
-func (f *FHIRDateTime) UnmarshalJSON(data []byte) error {
-	s := strings.Trim(string(data), `"`)
-	var err error
-	for _, l := range fhirLayouts {
-		f.Time, err = time.Parse(l.layout, s)
-		if err == nil {
-			f.Precision = l.precision
-			return nil
+// temporal is the parsed form shared by Date, DateTime and Instant
+type temporal struct {
+	year, month, day     int
+	hour, minute, second int
+	nanos                int
+	fractionDigits       int
+	precision            Precision
+
+	// location is nil if the value carries no timezone
+	location *time.Location
+}
+
+// parseTemporal splits a validated lexical value into its components
+func parseTemporal(s string) temporal {
+	t := temporal{month: 1, day: 1}
+
+	zone := zonePattern.FindString(s)
+	s = strings.TrimSuffix(s, zone)
+	datePart, timePart := s, ""
+	if i := strings.IndexByte(s, 'T'); i >= 0 {
+		datePart, timePart = s[:i], s[i+1:]
+	}
+
+	parts := strings.Split(datePart, "-")
+	t.year, _ = strconv.Atoi(parts[0])
+	t.precision = PrecisionYear
+	if len(parts) > 1 {
+		t.month, _ = strconv.Atoi(parts[1])
+		t.precision = PrecisionMonth
+	}
+	if len(parts) > 2 {
+		t.day, _ = strconv.Atoi(parts[2])
+		t.precision = PrecisionDay
+	}
+
+	if timePart != "" {
+		t.hour, t.minute, t.second, t.nanos, t.fractionDigits = parseClock(timePart)
+		t.precision = PrecisionSecond
+		if t.fractionDigits > 0 {
+			t.precision = PrecisionFraction
 		}
 	}
-	return fmt.Errorf("FHIRDateTime: unable to parse %q", s)
+
+	switch {
+	case zone == "Z":
+		t.location = time.UTC
+	case zone != "":
+		hours, _ := strconv.Atoi(zone[1:3])
+		minutes, _ := strconv.Atoi(zone[4:6])
+		offset := hours*3600 + minutes*60
+		if zone[0] == '-' {
+			offset = -offset
+		}
+		t.location = time.FixedZone(zone, offset)
+	}
+	return t
+}
+
+// parseClock parses a validated hh:mm:ss(.fffffffff) value
+func parseClock(s string) (hour, minute, second, nanos, fractionDigits int) {
+	hour, _ = strconv.Atoi(s[0:2])
+	minute, _ = strconv.Atoi(s[3:5])
+	second, _ = strconv.Atoi(s[6:8])
+	if len(s) > 9 {
+		fraction := s[9:]
+		fractionDigits = len(fraction)
+		nanos, _ = strconv.Atoi((fraction + "000000000")[:9])
+	}
+	return hour, minute, second, nanos, fractionDigits
+}
+
+// bounds returns the implicit range [start, end) of the value. Values without
+// a timezone are interpreted in loc.
+func (t temporal) bounds(loc *time.Location) (time.Time, time.Time) {
+	if t.location != nil {
+		loc = t.location
+	}
+	start := time.Date(t.year, time.Month(t.month), t.day, t.hour, t.minute, t.second, t.nanos, loc)
+	switch t.precision {
+	case PrecisionYear:
+		return start, start.AddDate(1, 0, 0)
+	case PrecisionMonth:
+		return start, start.AddDate(0, 1, 0)
+	case PrecisionDay:
+		return start, start.AddDate(0, 0, 1)
+	case PrecisionSecond:
+		return start, start.Add(time.Second)
+	}
+	unit := time.Duration(1)
+	for i := t.fractionDigits; i < 9; i++ {
+		unit *= 10
+	}
+	return start, start.Add(unit)
+}
+
+// compareRanges compares two implicit ranges. The comparison is undetermined
+// (ok is false) if the ranges overlap without being identical, e.g. when
+// comparing "2016" with "2016-05".
+func compareRanges(aStart, aEnd, bStart, bEnd time.Time) (result int, ok bool) {
+	switch {
+	case !aEnd.After(bStart):
+		return -1, true
+	case !bEnd.After(aStart):
+		return 1, true
+	case aStart.Equal(bStart) && aEnd.Equal(bEnd):
+		return 0, true
+	}
+	return 0, false
+}
+
+// Date is a FHIR date: a year, year-month or year-month-day without a timezone
+type Date struct {
+	lexical string
+}
+
+// ParseDate parses the lexical form of a FHIR date
+func ParseDate(s string) (Date, error) {
+	if !datePattern.MatchString(s) {
+		return Date{}, fmt.Errorf("Date: invalid date %q", s)
+	}
+	return Date{lexical: s}, nil
+}
+
+// MustParseDate is like ParseDate but panics if s is not a valid date
+func MustParseDate(s string) Date {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DateFromTime returns the date of t with day precision
+func DateFromTime(t time.Time) Date {
+	return Date{lexical: t.Format("2006-01-02")}
+}
+
+// DatePtr returns a pointer to d
+func DatePtr(d Date) *Date { return &d }
+
+// String returns the lexical form of the date
+func (d Date) String() string { return d.lexical }
+
+// IsZero reports whether the date is unset
+func (d Date) IsZero() bool { return d.lexical == "" }
+
+// Precision returns whether the date is given to the year, month or day
+func (d Date) Precision() Precision { return parseTemporal(d.lexical).precision }
+
+// Time returns the start of the date in loc
+func (d Date) Time(loc *time.Location) time.Time {
+	start, _ := d.Range(loc)
+	return start
+}
+
+// Range returns the implicit range [start, end) covered by the date in loc
+func (d Date) Range(loc *time.Location) (time.Time, time.Time) {
+	return parseTemporal(d.lexical).bounds(loc)
+}
+
+// Compare compares two dates. ok is false if the result cannot be determined
+// because the dates have different precisions and overlap.
+func (d Date) Compare(other Date) (result int, ok bool) {
+	aStart, aEnd := d.Range(time.UTC)
+	bStart, bEnd := other.Range(time.UTC)
+	return compareRanges(aStart, aEnd, bStart, bEnd)
+}
+
+// Overlaps reports whether the implicit ranges of both dates intersect
+func (d Date) Overlaps(other Date) bool {
+	result, ok := d.Compare(other)
+	return !ok || result == 0
+}
+
+// MarshalJSON writes the date as a JSON string
+func (d Date) MarshalJSON() ([]byte, error) { return marshalLexical(d.lexical) }
+
+// UnmarshalJSON reads and validates a JSON string
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return unmarshalLexical(data, func(s string) error {
+		parsed, err := ParseDate(s)
+		*d = parsed
+		return err
+	})
 }
 
-func (f FHIRDateTime) MarshalJSON() ([]byte, error) {
-	var layout string
-	for _, l := range fhirLayouts {
-		if l.precision == f.Precision {
-			layout = l.layout
-			break
+// MarshalText implements encoding.TextMarshaler
+func (d Date) MarshalText() ([]byte, error) { return []byte(d.lexical), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := ParseDate(string(text))
+	*d = parsed
+	return err
+}
+
+// DateTime is a FHIR dateTime: a date, or a date and time with timezone.
+// Partial values such as "2016" or "2016-01" are allowed.
+type DateTime struct {
+	lexical string
+}
+
+// FHIRDateTime is the former name of DateTime.
+//
+// Deprecated: use DateTime.
+type FHIRDateTime = DateTime
+
+// ParseDateTime parses the lexical form of a FHIR dateTime
+func ParseDateTime(s string) (DateTime, error) {
+	if !dateTimePattern.MatchString(s) {
+		return DateTime{}, fmt.Errorf("DateTime: invalid dateTime %q", s)
+	}
+	return DateTime{lexical: s}, nil
+}
+
+// MustParseDateTime is like ParseDateTime but panics if s is not a valid dateTime
+func MustParseDateTime(s string) DateTime {
+	d, err := ParseDateTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DateTimeFromTime returns t as a dateTime with second or fraction precision
+func DateTimeFromTime(t time.Time) DateTime {
+	return DateTime{lexical: t.Format(time.RFC3339Nano)}
+}
+
+// DateTimePtr returns a pointer to d
+func DateTimePtr(d DateTime) *DateTime { return &d }
+
+// String returns the lexical form of the dateTime
+func (d DateTime) String() string { return d.lexical }
+
+// IsZero reports whether the dateTime is unset
+func (d DateTime) IsZero() bool { return d.lexical == "" }
+
+// Precision returns the granularity of the dateTime
+func (d DateTime) Precision() Precision { return parseTemporal(d.lexical).precision }
+
+// HasTimezone reports whether the value carries an explicit timezone
+func (d DateTime) HasTimezone() bool { return parseTemporal(d.lexical).location != nil }
+
+// Time returns the start of the dateTime. Values without a timezone are interpreted in loc.
+func (d DateTime) Time(loc *time.Location) time.Time {
+	start, _ := d.Range(loc)
+	return start
+}
+
+// Range returns the implicit range [start, end) covered by the dateTime.
+// Values without a timezone are interpreted in loc.
+func (d DateTime) Range(loc *time.Location) (time.Time, time.Time) {
+	return parseTemporal(d.lexical).bounds(loc)
+}
+
+// Compare compares two dateTimes. ok is false if the result cannot be
+// determined because the values have different precisions and overlap.
+// Values without a timezone are interpreted as UTC.
+func (d DateTime) Compare(other DateTime) (result int, ok bool) {
+	aStart, aEnd := d.Range(time.UTC)
+	bStart, bEnd := other.Range(time.UTC)
+	return compareRanges(aStart, aEnd, bStart, bEnd)
+}
+
+// Overlaps reports whether the implicit ranges of both values intersect
+func (d DateTime) Overlaps(other DateTime) bool {
+	result, ok := d.Compare(other)
+	return !ok || result == 0
+}
+
+// Contains reports whether t lies within the implicit range of the dateTime
+func (d DateTime) Contains(t time.Time) bool {
+	start, end := d.Range(time.UTC)
+	return !t.Before(start) && t.Before(end)
+}
+
+// MarshalJSON writes the dateTime as a JSON string
+func (d DateTime) MarshalJSON() ([]byte, error) { return marshalLexical(d.lexical) }
+
+// UnmarshalJSON reads and validates a JSON string
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	return unmarshalLexical(data, func(s string) error {
+		parsed, err := ParseDateTime(s)
+		*d = parsed
+		return err
+	})
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d DateTime) MarshalText() ([]byte, error) { return []byte(d.lexical), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *DateTime) UnmarshalText(text []byte) error {
+	parsed, err := ParseDateTime(string(text))
+	*d = parsed
+	return err
+}
+
+// Instant is a FHIR instant: a point in time with at least second precision and a timezone
+type Instant struct {
+	lexical string
+}
+
+// ParseInstant parses the lexical form of a FHIR instant
+func ParseInstant(s string) (Instant, error) {
+	if !instantPattern.MatchString(s) {
+		return Instant{}, fmt.Errorf("Instant: invalid instant %q", s)
+	}
+	return Instant{lexical: s}, nil
+}
+
+// MustParseInstant is like ParseInstant but panics if s is not a valid instant
+func MustParseInstant(s string) Instant {
+	i, err := ParseInstant(s)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// InstantFromTime returns t as an instant
+func InstantFromTime(t time.Time) Instant {
+	return Instant{lexical: t.Format(time.RFC3339Nano)}
+}
+
+// InstantPtr returns a pointer to i
+func InstantPtr(i Instant) *Instant { return &i }
+
+// String returns the lexical form of the instant
+func (i Instant) String() string { return i.lexical }
+
+// IsZero reports whether the instant is unset
+func (i Instant) IsZero() bool { return i.lexical == "" }
+
+// Precision returns PrecisionSecond or PrecisionFraction
+func (i Instant) Precision() Precision { return parseTemporal(i.lexical).precision }
+
+// Time returns the instant as a time.Time in its original timezone
+func (i Instant) Time() time.Time {
+	start, _ := parseTemporal(i.lexical).bounds(time.UTC)
+	return start
+}
+
+// Range returns the implicit range [start, end) given by the fractional digits of the instant
+func (i Instant) Range() (time.Time, time.Time) {
+	return parseTemporal(i.lexical).bounds(time.UTC)
+}
+
+// Compare compares two instants. ok is false if the result cannot be
+// determined because the values have different precisions and overlap.
+func (i Instant) Compare(other Instant) (result int, ok bool) {
+	aStart, aEnd := i.Range()
+	bStart, bEnd := other.Range()
+	return compareRanges(aStart, aEnd, bStart, bEnd)
+}
+
+// MarshalJSON writes the instant as a JSON string
+func (i Instant) MarshalJSON() ([]byte, error) { return marshalLexical(i.lexical) }
+
+// UnmarshalJSON reads and validates a JSON string
+func (i *Instant) UnmarshalJSON(data []byte) error {
+	return unmarshalLexical(data, func(s string) error {
+		parsed, err := ParseInstant(s)
+		*i = parsed
+		return err
+	})
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (i Instant) MarshalText() ([]byte, error) { return []byte(i.lexical), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (i *Instant) UnmarshalText(text []byte) error {
+	parsed, err := ParseInstant(string(text))
+	*i = parsed
+	return err
+}
+
+// Time is a FHIR time: a time of day without date or timezone
+type Time struct {
+	lexical string
+}
+
+// ParseTime parses the lexical form of a FHIR time
+func ParseTime(s string) (Time, error) {
+	if !timePattern.MatchString(s) {
+		return Time{}, fmt.Errorf("Time: invalid time %q", s)
+	}
+	return Time{lexical: s}, nil
+}
+
+// MustParseTime is like ParseTime but panics if s is not a valid time
+func MustParseTime(s string) Time {
+	t, err := ParseTime(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// TimePtr returns a pointer to t
+func TimePtr(t Time) *Time { return &t }
+
+// String returns the lexical form of the time
+func (t Time) String() string { return t.lexical }
+
+// IsZero reports whether the time is unset
+func (t Time) IsZero() bool { return t.lexical == "" }
+
+// Precision returns PrecisionSecond or PrecisionFraction
+func (t Time) Precision() Precision {
+	if strings.Contains(t.lexical, ".") {
+		return PrecisionFraction
+	}
+	return PrecisionSecond
+}
+
+// Duration returns the time elapsed since midnight
+func (t Time) Duration() time.Duration {
+	if t.lexical == "" {
+		return 0
+	}
+	hour, minute, second, nanos, _ := parseClock(t.lexical)
+	return time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute +
+		time.Duration(second)*time.Second + time.Duration(nanos)
+}
+
+// On returns the time of day on the date of day, in the location of day
+func (t Time) On(day time.Time) time.Time {
+	year, month, date := day.Date()
+	return time.Date(year, month, date, 0, 0, 0, 0, day.Location()).Add(t.Duration())
+}
+
+// Compare compares two times of day. ok is false if the result cannot be
+// determined because the values have different precisions and overlap.
+func (t Time) Compare(other Time) (result int, ok bool) {
+	midnight := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	span := func(v Time) (time.Time, time.Time) {
+		_, _, _, _, digits := parseClock(v.lexical)
+		unit := time.Second
+		for i := 0; i < digits; i++ {
+			unit /= 10
 		}
+		start := midnight.Add(v.Duration())
+		return start, start.Add(unit)
+	}
+	aStart, aEnd := span(t)
+	bStart, bEnd := span(other)
+	return compareRanges(aStart, aEnd, bStart, bEnd)
+}
+
+// MarshalJSON writes the time as a JSON string
+func (t Time) MarshalJSON() ([]byte, error) { return marshalLexical(t.lexical) }
+
+// UnmarshalJSON reads and validates a JSON string
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return unmarshalLexical(data, func(s string) error {
+		parsed, err := ParseTime(s)
+		*t = parsed
+		return err
+	})
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (t Time) MarshalText() ([]byte, error) { return []byte(t.lexical), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (t *Time) UnmarshalText(text []byte) error {
+	parsed, err := ParseTime(string(text))
+	*t = parsed
+	return err
+}
+
+func marshalLexical(lexical string) ([]byte, error) {
+	return []byte(strconv.Quote(lexical)), nil
+}
+
+func unmarshalLexical(data []byte, parse func(string) error) error {
+	if string(data) == "null" {
+		return nil
 	}
-	if layout == "" {
-		return nil, fmt.Errorf("FHIRDateTime: unknown precision %q", f.Precision)
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("expected a JSON string, got %s", data)
 	}
-	return []byte(fmt.Sprintf(`"%s"`, f.Time.Format(layout))), nil
+	return parse(s)
 }
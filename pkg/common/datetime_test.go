@@ -0,0 +1,125 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateTime_JSONRoundTrip(t *testing.T) {
+	inputs := []string{"2016", "2016-05", "2016-05-12", "2016-05-12T10:30:00Z", "2016-05-12T10:30:00.1230+02:00", "2016-05-12T10:30:00-05:00"}
+	for _, input := range inputs {
+		var d DateTime
+		if err := json.Unmarshal([]byte(`"`+input+`"`), &d); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", input, err)
+		}
+		out, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", input, err)
+		}
+		if string(out) != `"`+input+`"` {
+			t.Errorf("round trip of %s yielded %s", input, out)
+		}
+	}
+}
+
+func TestDateTime_Invalid(t *testing.T) {
+	for _, input := range []string{`"2016-13"`, `"2016-05-12T10:30"`, `"16-05-12"`, `"2016-05-12 10:30:00Z"`, `2016`} {
+		var d DateTime
+		if err := json.Unmarshal([]byte(input), &d); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+	if _, err := ParseDate("2016-05-12T10:30:00Z"); err == nil {
+		t.Error("expected error for date with time")
+	}
+	if _, err := ParseInstant("2016-05-12T10:30:00"); err == nil {
+		t.Error("expected error for instant without timezone")
+	}
+	if _, err := ParseTime("10:30"); err == nil {
+		t.Error("expected error for time without seconds")
+	}
+}
+
+func TestDateTime_Precision(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision Precision
+		start     string
+		end       string
+	}{
+		{"2016", PrecisionYear, "2016-01-01T00:00:00Z", "2017-01-01T00:00:00Z"},
+		{"2016-02", PrecisionMonth, "2016-02-01T00:00:00Z", "2016-03-01T00:00:00Z"},
+		{"2016-02-29", PrecisionDay, "2016-02-29T00:00:00Z", "2016-03-01T00:00:00Z"},
+		{"2016-02-29T10:00:00+01:00", PrecisionSecond, "2016-02-29T10:00:00+01:00", "2016-02-29T10:00:01+01:00"},
+		{"2016-02-29T10:00:00.25Z", PrecisionFraction, "2016-02-29T10:00:00.25Z", "2016-02-29T10:00:00.26Z"},
+	}
+	for _, tt := range tests {
+		d := MustParseDateTime(tt.input)
+		if got := d.Precision(); got != tt.precision {
+			t.Errorf("%s: precision %s, want %s", tt.input, got, tt.precision)
+		}
+		start, end := d.Range(time.UTC)
+		if got := start.Format(time.RFC3339Nano); got != tt.start {
+			t.Errorf("%s: start %s, want %s", tt.input, got, tt.start)
+		}
+		if got := end.Format(time.RFC3339Nano); got != tt.end {
+			t.Errorf("%s: end %s, want %s", tt.input, got, tt.end)
+		}
+	}
+
+	if !MustParseDateTime("2016").Contains(time.Date(2016, 7, 4, 12, 0, 0, 0, time.UTC)) {
+		t.Error("expected 2016 to contain 2016-07-04")
+	}
+	if MustParseDateTime("2016-05").Contains(time.Date(2016, 7, 4, 12, 0, 0, 0, time.UTC)) {
+		t.Error("expected 2016-05 not to contain 2016-07-04")
+	}
+	if !MustParseDateTime("2016-05-12T10:30:00+02:00").HasTimezone() || MustParseDateTime("2016-05-12").HasTimezone() {
+		t.Error("unexpected timezone detection")
+	}
+}
+
+func TestDateTime_Compare(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		result int
+		ok     bool
+	}{
+		{"2016", "2017", -1, true},
+		{"2016-12", "2016-05-12", 1, true},
+		{"2016", "2016-05", 0, false},
+		{"2016-05-12", "2016-05-12", 0, true},
+		{"2016-05-12T10:00:00Z", "2016-05-12T12:00:00+02:00", 0, true},
+		{"2016-05-12T10:00:00Z", "2016-05-12T10:00:00.5Z", 0, false},
+		{"2016-05-12T10:00:01Z", "2016-05-12T10:00:00.5Z", 1, true},
+	}
+	for _, tt := range tests {
+		result, ok := MustParseDateTime(tt.a).Compare(MustParseDateTime(tt.b))
+		if result != tt.result || ok != tt.ok {
+			t.Errorf("compare(%s, %s) = (%d, %v), want (%d, %v)", tt.a, tt.b, result, ok, tt.result, tt.ok)
+		}
+	}
+
+	if !MustParseDate("2016").Overlaps(MustParseDate("2016-05-12")) {
+		t.Error("expected 2016 to overlap 2016-05-12")
+	}
+	if result, ok := MustParseInstant("2016-05-12T10:00:00Z").Compare(MustParseInstant("2016-05-12T09:00:00-02:00")); result != -1 || !ok {
+		t.Errorf("expected earlier instant, got (%d, %v)", result, ok)
+	}
+	if result, ok := MustParseTime("09:30:00").Compare(MustParseTime("10:00:00")); result != -1 || !ok {
+		t.Errorf("expected earlier time, got (%d, %v)", result, ok)
+	}
+}
+
+func TestInstant_Timezone(t *testing.T) {
+	instant := MustParseInstant("2016-05-12T10:30:00.123+02:00")
+	if got := instant.Time().Format(time.RFC3339Nano); got != "2016-05-12T10:30:00.123+02:00" {
+		t.Errorf("expected original offset to be preserved, got %s", got)
+	}
+	if got := InstantFromTime(instant.Time()).String(); got != "2016-05-12T10:30:00.123+02:00" {
+		t.Errorf("expected instant from time to keep offset, got %s", got)
+	}
+	if got := MustParseTime("10:30:15").On(time.Date(2016, 5, 12, 0, 0, 0, 0, time.UTC)); !got.Equal(time.Date(2016, 5, 12, 10, 30, 15, 0, time.UTC)) {
+		t.Errorf("unexpected time of day %s", got)
+	}
+}
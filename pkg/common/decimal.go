@@ -0,0 +1,265 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FHIR decimals are rational numbers with an implied precision: "1.50" and "1.5"
+// denote the same value but differ in their number of significant digits, which
+// matters for digital signatures and for search range matching. float64 can
+// neither keep the lexical form nor represent large values exactly, so decimals
+// are modelled by the Decimal type which keeps the original representation.
+
+// decimalPattern is the lexical space of the FHIR decimal type
+var decimalPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// Decimal is a FHIR decimal that preserves its lexical form and precision.
+// The zero value represents 0.
+type Decimal struct {
+	lexical string
+}
+
+// ParseDecimal parses the lexical form of a FHIR decimal
+func ParseDecimal(s string) (Decimal, error) {
+	if !decimalPattern.MatchString(s) {
+		return Decimal{}, fmt.Errorf("Decimal: invalid decimal %q", s)
+	}
+	return Decimal{lexical: s}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is not a valid decimal
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalFromInt returns the decimal representation of an integer
+func DecimalFromInt(i int64) Decimal {
+	return Decimal{lexical: strconv.FormatInt(i, 10)}
+}
+
+// DecimalFromFloat64 returns the shortest decimal representation of f.
+// Use ParseDecimal where the precision of the value is known.
+func DecimalFromFloat64(f float64) Decimal {
+	return Decimal{lexical: strconv.FormatFloat(f, 'f', -1, 64)}
+}
+
+// DecimalPtr returns a pointer to d
+func DecimalPtr(d Decimal) *Decimal { return &d }
+
+// String returns the lexical form of the decimal
+func (d Decimal) String() string {
+	if d.lexical == "" {
+		return "0"
+	}
+	return d.lexical
+}
+
+// Rat returns the exact value of the decimal
+func (d Decimal) Rat() *big.Rat {
+	r, ok := new(big.Rat).SetString(d.String())
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+// Float64 returns the nearest float64 value of the decimal
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+// IsZero reports whether the decimal has the value 0
+func (d Decimal) IsZero() bool {
+	return d.Rat().Sign() == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the decimal
+func (d Decimal) Sign() int {
+	return d.Rat().Sign()
+}
+
+// Cmp compares the values of two decimals and returns -1, 0 or +1.
+// The precision is ignored, i.e. 1.5 and 1.50 compare as equal.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Equal reports whether both decimals have the same value
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Identical reports whether both decimals have the same lexical form
+func (d Decimal) Identical(other Decimal) bool {
+	return d.String() == other.String()
+}
+
+// Scale returns the number of digits after the decimal point, taking an
+// exponent into account. "1.50" has scale 2, "1.5e1" has scale 0.
+func (d Decimal) Scale() int {
+	mantissa, exponent := d.split()
+	scale := 0
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		scale = len(mantissa) - i - 1
+	}
+	scale -= exponent
+	if scale < 0 {
+		return 0
+	}
+	return scale
+}
+
+// Precision returns the number of significant digits of the decimal.
+// Trailing zeros are significant, so "1.50" has a precision of 3.
+func (d Decimal) Precision() int {
+	mantissa, _ := d.split()
+	digits := strings.TrimLeft(strings.Replace(strings.TrimPrefix(mantissa, "-"), ".", "", 1), "0")
+	if digits == "" {
+		return 1
+	}
+	return len(digits)
+}
+
+// Bounds returns the implicit range of the decimal: the value plus and minus
+// half a unit of its last significant digit. "100" yields [99.5, 100.5] and
+// "1.50" yields [1.495, 1.505], as used for search parameter matching.
+func (d Decimal) Bounds() (low, high Decimal) {
+	mantissa, exponent := d.split()
+	digitsAfterPoint := 0
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		digitsAfterPoint = len(mantissa) - i - 1
+	}
+	unitExponent := exponent - digitsAfterPoint
+
+	half := new(big.Rat).SetFrac64(1, 2)
+	unit := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(unitExponent))), nil))
+	if unitExponent < 0 {
+		unit.Inv(unit)
+	}
+	half.Mul(half, unit)
+
+	scale := 1 - unitExponent
+	if scale < 0 {
+		scale = 0
+	}
+	value := d.Rat()
+	low = decimalFromRat(new(big.Rat).Sub(value, half), scale)
+	high = decimalFromRat(new(big.Rat).Add(value, half), scale)
+	return low, high
+}
+
+// ImpliedRangeContains reports whether value lies within the implicit range of d
+func (d Decimal) ImpliedRangeContains(value Decimal) bool {
+	low, high := d.Bounds()
+	return low.Cmp(value) <= 0 && value.Cmp(high) <= 0
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	s := d.String()
+	if strings.HasPrefix(s, "-") {
+		return Decimal{lexical: s[1:]}
+	}
+	if d.IsZero() {
+		return d
+	}
+	return Decimal{lexical: "-" + s}
+}
+
+// Abs returns |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{lexical: strings.TrimPrefix(d.String(), "-")}
+}
+
+// Add returns d + other with the larger scale of both operands
+func (d Decimal) Add(other Decimal) Decimal {
+	return decimalFromRat(new(big.Rat).Add(d.Rat(), other.Rat()), max(d.Scale(), other.Scale()))
+}
+
+// Sub returns d - other with the larger scale of both operands
+func (d Decimal) Sub(other Decimal) Decimal {
+	return decimalFromRat(new(big.Rat).Sub(d.Rat(), other.Rat()), max(d.Scale(), other.Scale()))
+}
+
+// Mul returns d * other with the sum of the scales of both operands
+func (d Decimal) Mul(other Decimal) Decimal {
+	return decimalFromRat(new(big.Rat).Mul(d.Rat(), other.Rat()), d.Scale()+other.Scale())
+}
+
+// Div returns d / other rounded to the given scale
+func (d Decimal) Div(other Decimal, scale int) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, fmt.Errorf("Decimal: division by zero")
+	}
+	return decimalFromRat(new(big.Rat).Quo(d.Rat(), other.Rat()), scale), nil
+}
+
+// MarshalJSON writes the decimal as a JSON number in its original lexical form
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON reads a JSON number and keeps its lexical form, a JSON null
+// leaves the decimal unchanged
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	parsed, err := ParseDecimal(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(strings.TrimSpace(string(text)))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// split separates the mantissa from the exponent
+func (d Decimal) split() (string, int) {
+	s := d.String()
+	i := strings.IndexAny(s, "eE")
+	if i < 0 {
+		return s, 0
+	}
+	exponent, _ := strconv.Atoi(s[i+1:])
+	return s[:i], exponent
+}
+
+// decimalFromRat formats r with the given number of digits after the decimal point
+func decimalFromRat(r *big.Rat, scale int) Decimal {
+	s := r.FloatString(scale)
+	if strings.HasPrefix(s, "-") && strings.Trim(s, "-0.") == "" {
+		s = s[1:]
+	}
+	return Decimal{lexical: s}
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,139 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecimal_JSONRoundTrip(t *testing.T) {
+	inputs := []string{"1.50", "0", "-0.0", "100", "3.14159265358979323846", "123456789012345678901234567890.000", "1.5e3", "-2.50E-2"}
+	for _, input := range inputs {
+		var d Decimal
+		if err := json.Unmarshal([]byte(input), &d); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", input, err)
+		}
+		out, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("failed to marshal %s: %v", input, err)
+		}
+		if string(out) != input {
+			t.Errorf("round trip of %s yielded %s", input, out)
+		}
+	}
+}
+
+func TestDecimal_Invalid(t *testing.T) {
+	for _, input := range []string{`"1.5"`, "01", "1.", ".5", "+1", "NaN", "true"} {
+		var d Decimal
+		if err := json.Unmarshal([]byte(input), &d); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+}
+
+func TestDecimal_Null(t *testing.T) {
+	d := MustParseDecimal("1.50")
+	if err := json.Unmarshal([]byte("null"), &d); err != nil {
+		t.Fatalf("failed to unmarshal null: %v", err)
+	}
+	if d.String() != "1.50" {
+		t.Errorf("expected null to leave 1.50 unchanged, got %s", d)
+	}
+
+	var q Quantity
+	if err := json.Unmarshal([]byte(`{"value":null,"unit":"mmol/L"}`), &q); err != nil {
+		t.Fatalf("failed to unmarshal quantity: %v", err)
+	}
+	if q.Value != nil {
+		t.Errorf("expected no value, got %s", q.Value)
+	}
+
+	var values []Decimal
+	if err := json.Unmarshal([]byte(`[null,2.5]`), &values); err != nil {
+		t.Fatalf("failed to unmarshal decimals: %v", err)
+	}
+	if len(values) != 2 || values[0].String() != "0" || values[1].String() != "2.5" {
+		t.Errorf("expected [0 2.5], got %v", values)
+	}
+}
+
+func TestDecimal_Quantity(t *testing.T) {
+	data := []byte(`{"value":1.50,"unit":"mmol/L"}`)
+	var q Quantity
+	if err := json.Unmarshal(data, &q); err != nil {
+		t.Fatalf("failed to unmarshal quantity: %v", err)
+	}
+	out, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("failed to marshal quantity: %v", err)
+	}
+	if string(out) != string(data) {
+		t.Errorf("got %s, want %s", out, data)
+	}
+}
+
+func TestDecimal_Precision(t *testing.T) {
+	tests := []struct {
+		input     string
+		scale     int
+		precision int
+		low, high string
+	}{
+		{"100", 0, 3, "99.5", "100.5"},
+		{"1.50", 2, 3, "1.495", "1.505"},
+		{"0.001", 3, 1, "0.0005", "0.0015"},
+		{"-2.5", 1, 2, "-2.55", "-2.45"},
+		{"1e2", 0, 1, "50", "150"},
+	}
+	for _, tt := range tests {
+		d := MustParseDecimal(tt.input)
+		if got := d.Scale(); got != tt.scale {
+			t.Errorf("%s: scale %d, want %d", tt.input, got, tt.scale)
+		}
+		if got := d.Precision(); got != tt.precision {
+			t.Errorf("%s: precision %d, want %d", tt.input, got, tt.precision)
+		}
+		low, high := d.Bounds()
+		if low.String() != tt.low || high.String() != tt.high {
+			t.Errorf("%s: bounds [%s, %s], want [%s, %s]", tt.input, low, high, tt.low, tt.high)
+		}
+	}
+
+	if !MustParseDecimal("100").ImpliedRangeContains(MustParseDecimal("99.7")) {
+		t.Error("expected 99.7 to match 100")
+	}
+	if MustParseDecimal("100.0").ImpliedRangeContains(MustParseDecimal("99.7")) {
+		t.Error("expected 99.7 not to match 100.0")
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := MustParseDecimal("1.50")
+	b := MustParseDecimal("0.2")
+
+	if got := a.Add(b).String(); got != "1.70" {
+		t.Errorf("1.50 + 0.2 = %s", got)
+	}
+	if got := b.Sub(a).String(); got != "-1.30" {
+		t.Errorf("0.2 - 1.50 = %s", got)
+	}
+	if got := a.Mul(b).String(); got != "0.300" {
+		t.Errorf("1.50 * 0.2 = %s", got)
+	}
+	quotient, err := a.Div(b, 2)
+	if err != nil || quotient.String() != "7.50" {
+		t.Errorf("1.50 / 0.2 = %s (%v)", quotient, err)
+	}
+	if _, err := a.Div(Decimal{}, 2); err == nil {
+		t.Error("expected division by zero error")
+	}
+	if a.Cmp(MustParseDecimal("1.5")) != 0 || !a.Equal(MustParseDecimal("1.5")) || a.Identical(MustParseDecimal("1.5")) {
+		t.Error("expected 1.50 to equal but not be identical to 1.5")
+	}
+	if a.Cmp(b) <= 0 {
+		t.Error("expected 1.50 > 0.2")
+	}
+	if got := a.Neg().String(); got != "-1.50" {
+		t.Errorf("-(1.50) = %s", got)
+	}
+}
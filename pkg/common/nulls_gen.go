@@ -0,0 +1,95 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package common
+
+import (
+	"encoding/json"
+)
+
+// MarshalJSON writes the repeating primitives of Address that only have an id
+// or extensions as null
+func (x Address) MarshalJSON() ([]byte, error) {
+	type plain Address
+	return json.Marshal(struct {
+		plain
+		Line []*string `json:"line,omitempty"`
+	}{
+		plain(x),
+		PrimitiveValues(x.Line, x.LineElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of DataRequirement that only have an id
+// or extensions as null
+func (x DataRequirement) MarshalJSON() ([]byte, error) {
+	type plain DataRequirement
+	return json.Marshal(struct {
+		plain
+		Profile     []*string `json:"profile,omitempty"`
+		MustSupport []*string `json:"mustSupport,omitempty"`
+	}{
+		plain(x),
+		PrimitiveValues(x.Profile, x.ProfileElement),
+		PrimitiveValues(x.MustSupport, x.MustSupportElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HumanName that only have an id
+// or extensions as null
+func (x HumanName) MarshalJSON() ([]byte, error) {
+	type plain HumanName
+	return json.Marshal(struct {
+		plain
+		Given  []*string `json:"given,omitempty"`
+		Prefix []*string `json:"prefix,omitempty"`
+		Suffix []*string `json:"suffix,omitempty"`
+	}{
+		plain(x),
+		PrimitiveValues(x.Given, x.GivenElement),
+		PrimitiveValues(x.Prefix, x.PrefixElement),
+		PrimitiveValues(x.Suffix, x.SuffixElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Meta that only have an id
+// or extensions as null
+func (x Meta) MarshalJSON() ([]byte, error) {
+	type plain Meta
+	return json.Marshal(struct {
+		plain
+		Profile []*string `json:"profile,omitempty"`
+	}{
+		plain(x),
+		PrimitiveValues(x.Profile, x.ProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Timing that only have an id
+// or extensions as null
+func (x Timing) MarshalJSON() ([]byte, error) {
+	type plain Timing
+	return json.Marshal(struct {
+		plain
+		Event []*DateTime `json:"event,omitempty"`
+	}{
+		plain(x),
+		PrimitiveValues(x.Event, x.EventElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TimingRepeat that only have an id
+// or extensions as null
+func (x TimingRepeat) MarshalJSON() ([]byte, error) {
+	type plain TimingRepeat
+	return json.Marshal(struct {
+		plain
+		DayOfWeek []*string `json:"dayOfWeek,omitempty"`
+		TimeOfDay []*Time   `json:"timeOfDay,omitempty"`
+		When      []*string `json:"when,omitempty"`
+	}{
+		plain(x),
+		PrimitiveValues(x.DayOfWeek, x.DayOfWeekElement),
+		PrimitiveValues(x.TimeOfDay, x.TimeOfDayElement),
+		PrimitiveValues(x.When, x.WhenElement),
+	})
+}
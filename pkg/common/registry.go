@@ -0,0 +1,168 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ResourceFactory allocates a new, empty resource struct and returns a pointer to it
+type ResourceFactory func() Resource
+
+// ResourceRegistry maps a FHIR resourceType to the Go struct that models it.
+// Each version package owns one registry, which is used to decode polymorphic
+// resource fields such as Bundle.entry.resource or DomainResource.contained.
+type ResourceRegistry struct {
+	factories map[string]ResourceFactory
+}
+
+// NewResourceRegistry creates a registry from a resourceType to factory map
+func NewResourceRegistry(factories map[string]ResourceFactory) *ResourceRegistry {
+	r := &ResourceRegistry{factories: make(map[string]ResourceFactory, len(factories))}
+	for resourceType, factory := range factories {
+		r.factories[resourceType] = factory
+	}
+	return r
+}
+
+// Register adds or replaces the factory used for resourceType
+func (r *ResourceRegistry) Register(resourceType string, factory ResourceFactory) {
+	r.factories[resourceType] = factory
+}
+
+// New allocates an empty resource of the given type.
+// The second return value reports whether the type is known to the registry.
+func (r *ResourceRegistry) New(resourceType string) (Resource, bool) {
+	factory, ok := r.factories[resourceType]
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// ResourceTypes returns all resource types known to the registry
+func (r *ResourceRegistry) ResourceTypes() []string {
+	types := make([]string, 0, len(r.factories))
+	for resourceType := range r.factories {
+		types = append(types, resourceType)
+	}
+	return types
+}
+
+// Unmarshal decodes a single JSON resource into the struct registered for its
+// resourceType. Resources of an unknown type are returned as *RawResource.
+// A JSON null yields a nil resource.
+func (r *ResourceRegistry) Unmarshal(data []byte) (Resource, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("decoding resource: %w", err)
+	}
+	if header.ResourceType == "" {
+		return nil, fmt.Errorf("decoding resource: missing resourceType")
+	}
+
+	resource, ok := r.New(header.ResourceType)
+	if !ok {
+		raw := &RawResource{}
+		if err := raw.UnmarshalJSON(data); err != nil {
+			return nil, err
+		}
+		return raw, nil
+	}
+	if err := json.Unmarshal(data, resource); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", header.ResourceType, err)
+	}
+	return resource, nil
+}
+
+// UnmarshalList decodes a JSON array of resources, see Unmarshal
+func (r *ResourceRegistry) UnmarshalList(data []byte) ([]Resource, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, err
+	}
+	if items == nil {
+		return nil, nil
+	}
+
+	resources := make([]Resource, 0, len(items))
+	for i, item := range items {
+		resource, err := r.Unmarshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("resource %d: %w", i, err)
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// RawResource holds a resource whose type is not modelled by a version package.
+// The original JSON is kept verbatim so that it survives a round trip.
+type RawResource struct {
+	// Resource Type Name, taken from the JSON
+	ResourceType string
+
+	// The complete JSON object of the resource
+	Data json.RawMessage
+}
+
+// UnmarshalJSON keeps a copy of the JSON and extracts the resourceType
+func (r *RawResource) UnmarshalJSON(data []byte) error {
+	var header struct {
+		ResourceType string `json:"resourceType"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	r.ResourceType = header.ResourceType
+	r.Data = append(r.Data[:0], data...)
+	return nil
+}
+
+// MarshalJSON writes the original JSON back unchanged
+func (r RawResource) MarshalJSON() ([]byte, error) {
+	if len(r.Data) == 0 {
+		return []byte("null"), nil
+	}
+	return r.Data, nil
+}
+
+// GetResourceType returns the resourceType found in the JSON
+func (r *RawResource) GetResourceType() string {
+	return r.ResourceType
+}
+
+// GetID returns the id found in the JSON
+func (r *RawResource) GetID() *string {
+	var header struct {
+		ID *string `json:"id"`
+	}
+	if err := json.Unmarshal(r.Data, &header); err != nil {
+		return nil
+	}
+	return header.ID
+}
+
+// SetID rewrites the id in the JSON. The order of the JSON properties is not preserved.
+func (r *RawResource) SetID(id *string) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(r.Data, &object); err != nil || object == nil {
+		return
+	}
+	if id == nil {
+		delete(object, "id")
+	} else {
+		encoded, _ := json.Marshal(*id)
+		object["id"] = encoded
+	}
+	if data, err := json.Marshal(object); err == nil {
+		r.Data = data
+	}
+}
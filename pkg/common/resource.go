@@ -0,0 +1,39 @@
+package common
+
+// Resource is implemented by every resource struct of the version packages.
+// It allows version-agnostic code to inspect and update the identity of a
+// resource without knowing its concrete type.
+type Resource interface {
+	// GetResourceType returns the FHIR resource type name, e.g. "Patient"
+	GetResourceType() string
+
+	// GetID returns the logical id of the resource
+	GetID() *string
+
+	// SetID replaces the logical id of the resource
+	SetID(id *string)
+}
+
+// DomainResource is implemented by every resource that carries narrative,
+// extensions and contained resources
+type DomainResource interface {
+	Resource
+
+	// GetExtension returns the extensions of the resource
+	GetExtension() []Extension
+
+	// SetExtension replaces the extensions of the resource
+	SetExtension(extension []Extension)
+
+	// GetModifierExtension returns the modifier extensions of the resource
+	GetModifierExtension() []Extension
+
+	// SetModifierExtension replaces the modifier extensions of the resource
+	SetModifierExtension(extension []Extension)
+
+	// GetContained returns the contained, inline resources
+	GetContained() []Resource
+
+	// SetContained replaces the contained, inline resources
+	SetContained(resources []Resource)
+}
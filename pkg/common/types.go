@@ -4,6 +4,12 @@ package common
 // Element is the base definition for all elements contained inside a resource.
 // All elements, whether defined as a data type (like the ones in this specification)
 // or as part of a resource definition, have this base content.
+//
+// Element also carries the id and extensions of primitive values. In JSON these
+// appear as a sibling property prefixed with an underscore (e.g. "_birthDate"),
+// which is modelled by a field with the suffix Element next to the primitive
+// field (e.g. BirthDateElement). For repeating primitives the sibling is a
+// parallel slice in which nil entries stand for values without id or extensions.
 type Element struct {
 	// Unique id for the element within a resource (for internal references)
 	ID *string `json:"id,omitempty"`
@@ -12,6 +18,23 @@ type Element struct {
 	Extension []Extension `json:"extension,omitempty"`
 }
 
+// PrimitiveValues returns the values of a repeating primitive for its JSON
+// encoding. A zero value with an entry in the parallel elements slice stands
+// for a null in the value array and is returned as nil.
+func PrimitiveValues[T comparable](values []T, elements []*Element) []*T {
+	if values == nil {
+		return nil
+	}
+	var zero T
+	result := make([]*T, len(values))
+	for i := range values {
+		if values[i] != zero || i >= len(elements) || elements[i] == nil {
+			result[i] = &values[i]
+		}
+	}
+	return result
+}
+
 // BackboneElement is the base definition for all elements that are defined inside a resource
 // but not those in a data type.
 type BackboneElement struct {
@@ -28,57 +51,84 @@ type Extension struct {
 	// identifies the meaning of the extension
 	URL string `json:"url"`
 
-	ValueBase64Binary        *string            `json:"valueBase64Binary,omitempty"`
-	ValueBoolean             *bool              `json:"valueBoolean,omitempty"`
-	ValueCanonical           *string            `json:"valueCanonical,omitempty"`
-	ValueCode                *string            `json:"valueCode,omitempty"`
-	ValueDate                *string            `json:"valueDate,omitempty"`
-	ValueDateTime            *string            `json:"valueDateTime,omitempty"`
-	ValueDecimal             *float64           `json:"valueDecimal,omitempty"`
-	ValueId                  *string            `json:"valueId,omitempty"`
-	ValueInstant             *string            `json:"valueInstant,omitempty"`
-	ValueInteger             *int               `json:"valueInteger,omitempty"`
-	ValueInteger64           *int64             `json:"valueInteger64,omitempty"`
-	ValueMarkdown            *string            `json:"valueMarkdown,omitempty"`
-	ValueOid                 *string            `json:"valueOid,omitempty"`
-	ValuePositiveInt         *int               `json:"valuePositiveInt,omitempty"`
-	ValueString              *string            `json:"valueString,omitempty"`
-	ValueTime                *string            `json:"valueTime,omitempty"`
-	ValueUnsignedInt         *int               `json:"valueUnsignedInt,omitempty"`
-	ValueUri                 *string            `json:"valueUri,omitempty"`
-	ValueUrl                 *string            `json:"valueUrl,omitempty"`
-	ValueUuid                *string            `json:"valueUuid,omitempty"`
-	ValueAddress             *Address           `json:"valueAddress,omitempty"`
-	ValueAge                 *Age               `json:"valueAge,omitempty"`
-	ValueAnnotation          *Annotation        `json:"valueAnnotation,omitempty"`
-	ValueAttachment          *Attachment        `json:"valueAttachment,omitempty"`
-	ValueCodeableConcept     *CodeableConcept   `json:"valueCodeableConcept,omitempty"`
-	ValueCoding              *Coding            `json:"valueCoding,omitempty"`
-	ValueContactPoint        *ContactPoint      `json:"valueContactPoint,omitempty"`
-	ValueCount               *Count             `json:"valueCount,omitempty"`
-	ValueDistance            *Distance          `json:"valueDistance,omitempty"`
-	ValueDuration            *Duration          `json:"valueDuration,omitempty"`
-	ValueHumanName           *HumanName         `json:"valueHumanName,omitempty"`
-	ValueIdentifier          *Identifier        `json:"valueIdentifier,omitempty"`
-	ValueMoney               *Money             `json:"valueMoney,omitempty"`
-	ValuePeriod              *Period            `json:"valuePeriod,omitempty"`
-	ValueQuantity            *Quantity          `json:"valueQuantity,omitempty"`
-	ValueRange               *Range             `json:"valueRange,omitempty"`
-	ValueRatio               *Ratio             `json:"valueRatio,omitempty"`
-	ValueReference           *Reference         `json:"valueReference,omitempty"`
-	ValueSampledData         *interface{}       `json:"valueSampledData,omitempty"`
-	ValueSignature           *Signature         `json:"valueSignature,omitempty"`
-	ValueTiming              *Timing            `json:"valueTiming,omitempty"`
-	ValueContactDetail       *ContactDetail     `json:"valueContactDetail,omitempty"`
-	ValueContributor         *interface{}       `json:"valueContributor,omitempty"`
-	ValueDataRequirement     *interface{}       `json:"valueDataRequirement,omitempty"`
-	ValueExpression          *Expression        `json:"valueExpression,omitempty"`
-	ValueParameterDefinition *interface{}       `json:"valueParameterDefinition,omitempty"`
-	ValueRelatedArtifact     *RelatedArtifact   `json:"valueRelatedArtifact,omitempty"`
-	ValueTriggerDefinition   *TriggerDefinition `json:"valueTriggerDefinition,omitempty"`
-	ValueUsageContext        *UsageContext      `json:"valueUsageContext,omitempty"`
-	ValueDosage              *Dosage            `json:"valueDosage,omitempty"`
-	ValueMeta                *Meta              `json:"valueMeta,omitempty"`
+	ValueBase64Binary        *string              `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *Element             `json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *Element             `json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string              `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *Element             `json:"_valueCanonical,omitempty"`
+	ValueCode                *string              `json:"valueCode,omitempty"`
+	ValueCodeElement         *Element             `json:"_valueCode,omitempty"`
+	ValueDate                *Date                `json:"valueDate,omitempty"`
+	ValueDateElement         *Element             `json:"_valueDate,omitempty"`
+	ValueDateTime            *DateTime            `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *Element             `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *Decimal             `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *Element             `json:"_valueDecimal,omitempty"`
+	ValueId                  *string              `json:"valueId,omitempty"`
+	ValueIdElement           *Element             `json:"_valueId,omitempty"`
+	ValueInstant             *Instant             `json:"valueInstant,omitempty"`
+	ValueInstantElement      *Element             `json:"_valueInstant,omitempty"`
+	ValueInteger             *int                 `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *Element             `json:"_valueInteger,omitempty"`
+	ValueInteger64           *int64               `json:"valueInteger64,omitempty"`
+	ValueInteger64Element    *Element             `json:"_valueInteger64,omitempty"`
+	ValueMarkdown            *string              `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *Element             `json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string              `json:"valueOid,omitempty"`
+	ValueOidElement          *Element             `json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                 `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *Element             `json:"_valuePositiveInt,omitempty"`
+	ValueString              *string              `json:"valueString,omitempty"`
+	ValueStringElement       *Element             `json:"_valueString,omitempty"`
+	ValueTime                *Time                `json:"valueTime,omitempty"`
+	ValueTimeElement         *Element             `json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                 `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *Element             `json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string              `json:"valueUri,omitempty"`
+	ValueUriElement          *Element             `json:"_valueUri,omitempty"`
+	ValueUrl                 *string              `json:"valueUrl,omitempty"`
+	ValueUrlElement          *Element             `json:"_valueUrl,omitempty"`
+	ValueUuid                *string              `json:"valueUuid,omitempty"`
+	ValueUuidElement         *Element             `json:"_valueUuid,omitempty"`
+	ValueAddress             *Address             `json:"valueAddress,omitempty"`
+	ValueAge                 *Age                 `json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation          `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment          `json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *CodeableConcept     `json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *Coding              `json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint        `json:"valueContactPoint,omitempty"`
+	ValueCount               *Count               `json:"valueCount,omitempty"`
+	ValueDistance            *Distance            `json:"valueDistance,omitempty"`
+	ValueDuration            *Duration            `json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName           `json:"valueHumanName,omitempty"`
+	ValueIdentifier          *Identifier          `json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money               `json:"valueMoney,omitempty"`
+	ValuePeriod              *Period              `json:"valuePeriod,omitempty"`
+	ValueQuantity            *Quantity            `json:"valueQuantity,omitempty"`
+	ValueRange               *Range               `json:"valueRange,omitempty"`
+	ValueRatio               *Ratio               `json:"valueRatio,omitempty"`
+	ValueReference           *Reference           `json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData         `json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature           `json:"valueSignature,omitempty"`
+	ValueTiming              *Timing              `json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail       `json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor         `json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement     `json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression          `json:"valueExpression,omitempty"`
+	ValueParameterDefinition *ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact     `json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition   `json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext        `json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage              `json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                `json:"valueMeta,omitempty"`
+
+	// R5 data types that are not modelled in common
+	ValueAvailability          *interface{}       `json:"valueAvailability,omitempty"`
+	ValueCodeableReference     *CodeableReference `json:"valueCodeableReference,omitempty"`
+	ValueExtendedContactDetail *interface{}       `json:"valueExtendedContactDetail,omitempty"`
+	ValueRatioRange            *interface{}       `json:"valueRatioRange,omitempty"`
+	ValueVirtualServiceDetail  *interface{}       `json:"valueVirtualServiceDetail,omitempty"`
 }
 
 // Reference is a reference from one resource to another.
@@ -86,16 +136,19 @@ type Reference struct {
 	Element
 
 	// Literal reference, Relative, internal or absolute URL
-	Reference *string `json:"reference,omitempty"`
+	Reference        *string  `json:"reference,omitempty"`
+	ReferenceElement *Element `json:"_reference,omitempty"`
 
 	// Type the reference refers to (e.g. "Patient")
-	Type *string `json:"type,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+	TypeElement *Element `json:"_type,omitempty"`
 
 	// Logical reference, when literal reference is not known
 	Identifier *Identifier `json:"identifier,omitempty"`
 
 	// Text alternative for the resource
-	Display *string `json:"display,omitempty"`
+	Display        *string  `json:"display,omitempty"`
+	DisplayElement *Element `json:"_display,omitempty"`
 }
 
 // Identifier represents an identifier intended for computation.
@@ -103,16 +156,19 @@ type Identifier struct {
 	Element
 
 	// The purpose of this identifier
-	Use *IdentifierUse `json:"use,omitempty"`
+	Use        *IdentifierUse `json:"use,omitempty"`
+	UseElement *Element       `json:"_use,omitempty"`
 
 	// Description of identifier
 	Type *CodeableConcept `json:"type,omitempty"`
 
 	// The namespace for the identifier value
-	System *string `json:"system,omitempty"`
+	System        *string  `json:"system,omitempty"`
+	SystemElement *Element `json:"_system,omitempty"`
 
 	// The value that is unique
-	Value *string `json:"value,omitempty"`
+	Value        *string  `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// Time period when id is/was valid for use
 	Period *Period `json:"period,omitempty"`
@@ -137,10 +193,12 @@ type Period struct {
 	Element
 
 	// Start time with inclusive boundary
-	Start *FHIRDateTime `json:"start,omitempty"`
+	Start        *DateTime `json:"start,omitempty"`
+	StartElement *Element  `json:"_start,omitempty"`
 
 	// End time with inclusive boundary, if not ongoing
-	End *FHIRDateTime `json:"end,omitempty"`
+	End        *DateTime `json:"end,omitempty"`
+	EndElement *Element  `json:"_end,omitempty"`
 }
 
 // CodeableConcept represents a concept that may be defined by a formal reference
@@ -152,7 +210,8 @@ type CodeableConcept struct {
 	Coding []Coding `json:"coding,omitempty"`
 
 	// Plain text representation of the concept
-	Text *string `json:"text,omitempty"`
+	Text        *string  `json:"text,omitempty"`
+	TextElement *Element `json:"_text,omitempty"`
 }
 
 // Coding represents a reference to a code defined by a terminology system.
@@ -160,19 +219,24 @@ type Coding struct {
 	Element
 
 	// Identity of the terminology system
-	System *string `json:"system,omitempty"`
+	System        *string  `json:"system,omitempty"`
+	SystemElement *Element `json:"_system,omitempty"`
 
 	// Version of the system - if relevant
-	Version *string `json:"version,omitempty"`
+	Version        *string  `json:"version,omitempty"`
+	VersionElement *Element `json:"_version,omitempty"`
 
 	// Symbol in syntax defined by the system
-	Code *string `json:"code,omitempty"`
+	Code        *string  `json:"code,omitempty"`
+	CodeElement *Element `json:"_code,omitempty"`
 
 	// Representation defined by the system
-	Display *string `json:"display,omitempty"`
+	Display        *string  `json:"display,omitempty"`
+	DisplayElement *Element `json:"_display,omitempty"`
 
 	// If this coding was chosen directly by the user
-	UserSelected *bool `json:"userSelected,omitempty"`
+	UserSelected        *bool    `json:"userSelected,omitempty"`
+	UserSelectedElement *Element `json:"_userSelected,omitempty"`
 }
 
 // Quantity represents a measured amount (or an amount that can potentially be measured).
@@ -180,19 +244,24 @@ type Quantity struct {
 	Element
 
 	// Numerical value (with implicit precision)
-	Value *float64 `json:"value,omitempty"`
+	Value        *Decimal `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// < | <= | >= | > - how to understand the value
-	Comparator *QuantityComparator `json:"comparator,omitempty"`
+	Comparator        *QuantityComparator `json:"comparator,omitempty"`
+	ComparatorElement *Element            `json:"_comparator,omitempty"`
 
 	// Unit representation
-	Unit *string `json:"unit,omitempty"`
+	Unit        *string  `json:"unit,omitempty"`
+	UnitElement *Element `json:"_unit,omitempty"`
 
 	// System that defines coded unit form
-	System *string `json:"system,omitempty"`
+	System        *string  `json:"system,omitempty"`
+	SystemElement *Element `json:"_system,omitempty"`
 
 	// Coded form of the unit
-	Code *string `json:"code,omitempty"`
+	Code        *string  `json:"code,omitempty"`
+	CodeElement *Element `json:"_code,omitempty"`
 }
 
 // QuantityComparator represents how to understand the quantity value
@@ -210,34 +279,43 @@ type SampledData struct {
 	Element
 
 	// The ConceptMap cannot define meanings for the codes 'E', 'U', or 'L'
-	CodeMap *string `json:"codeMap,omitempty"`
+	CodeMap        *string  `json:"codeMap,omitempty"`
+	CodeMapElement *Element `json:"_codeMap,omitempty"`
 
 	// The data may be missing if it is omitted for summarization purposes
-	Data *string `json:"data,omitempty"`
+	Data        *string  `json:"data,omitempty"`
+	DataElement *Element `json:"_data,omitempty"`
 
 	// If there is more than one dimension, the code for the type of data will define the meaning of the dimensions
-	Dimensions int `json:"dimensions"`
+	Dimensions        int      `json:"dimensions"`
+	DimensionsElement *Element `json:"_dimensions,omitempty"`
 
 	// A correction factor that is applied to the sampled data points before they are added to the origin
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *Decimal `json:"factor,omitempty"`
+	FactorElement *Element `json:"_factor,omitempty"`
 
 	// This is usually a whole number
-	Interval *int `json:"interval,omitempty"`
+	Interval        *int     `json:"interval,omitempty"`
+	IntervalElement *Element `json:"_interval,omitempty"`
 
 	// The measurement unit in which the sample interval is expressed
-	IntervalUnit string `json:"intervalUnit"`
+	IntervalUnit        string   `json:"intervalUnit"`
+	IntervalUnitElement *Element `json:"_intervalUnit,omitempty"`
 
 	// The lower limit of detection of the measured points
-	LowerLimit *float64 `json:"lowerLimit,omitempty"`
+	LowerLimit        *Decimal `json:"lowerLimit,omitempty"`
+	LowerLimitElement *Element `json:"_lowerLimit,omitempty"`
 
 	// If offsets is present, the number of data points must be equal to the number of offsets multiplied by the dimensions
-	Offsets *string `json:"offSets,omitempty"`
+	Offsets        *string  `json:"offSets,omitempty"`
+	OffsetsElement *Element `json:"_offSets,omitempty"`
 
 	// The base quantity that a measured value of zero represents
 	Origin Quantity `json:"origin"`
 
 	// The upper limit of detection of the measured points
-	UpperLimit *float64 `json:"upperLimit,omitempty"`
+	UpperLimit        *Decimal `json:"upperLimit,omitempty"`
+	UpperLimitElement *Element `json:"_upperLimit,omitempty"`
 }
 
 // ContactDetail represents contact information for a person or organization
@@ -245,7 +323,8 @@ type ContactDetail struct {
 	Element
 
 	// The name of an individual to contact
-	Name *string `json:"name,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	NameElement *Element `json:"_name,omitempty"`
 
 	// The contact details for the individual (if a name was provided) or the organization
 	Telecom []ContactPoint `json:"telecom,omitempty"`
@@ -256,16 +335,20 @@ type ContactPoint struct {
 	Element
 
 	// Telecommunications form for contact point - what communications system is required to make use of this contact
-	System *ContactPointSystem `json:"system,omitempty"`
+	System        *ContactPointSystem `json:"system,omitempty"`
+	SystemElement *Element            `json:"_system,omitempty"`
 
 	// The actual contact point details, in a form that is meaningful to the designated communication system
-	Value *string `json:"value,omitempty"`
+	Value        *string  `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// Identifies the purpose for the contact point
-	Use *ContactPointUse `json:"use,omitempty"`
+	Use        *ContactPointUse `json:"use,omitempty"`
+	UseElement *Element         `json:"_use,omitempty"`
 
 	// Specifies a preferred order in which to use a set of contacts
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int     `json:"rank,omitempty"`
+	RankElement *Element `json:"_rank,omitempty"`
 
 	// Time period when the contact point was/is in use
 	Period *Period `json:"period,omitempty"`
@@ -325,25 +408,32 @@ type ParameterDefinition struct {
 	Element
 
 	// The name of the parameter used to allow access to the value of the parameter in evaluation contexts
-	Name *string `json:"name,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	NameElement *Element `json:"_name,omitempty"`
 
 	// The type of the parameter
-	Type *string `json:"type,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+	TypeElement *Element `json:"_type,omitempty"`
 
 	// Whether the parameter is input or output for the module
-	Use *ParameterUse `json:"use,omitempty"`
+	Use        *ParameterUse `json:"use,omitempty"`
+	UseElement *Element      `json:"_use,omitempty"`
 
 	// The minimum number of times this parameter SHALL appear in the request or response
-	Min *int `json:"min,omitempty"`
+	Min        *int     `json:"min,omitempty"`
+	MinElement *Element `json:"_min,omitempty"`
 
 	// The maximum number of times this element is permitted to appear in the request or response
-	Max *string `json:"max,omitempty"`
+	Max        *string  `json:"max,omitempty"`
+	MaxElement *Element `json:"_max,omitempty"`
 
 	// A brief discussion of what the parameter is for and how it is used by the module
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string  `json:"documentation,omitempty"`
+	DocumentationElement *Element `json:"_documentation,omitempty"`
 
 	// If specified, this indicates a profile that the input data must conform to, or that the output data will conform to
-	Profile *string `json:"profile,omitempty"`
+	Profile        *string  `json:"profile,omitempty"`
+	ProfileElement *Element `json:"_profile,omitempty"`
 }
 
 // ParameterUse represents whether the parameter is input or output for the module
@@ -359,10 +449,12 @@ type Contributor struct {
 	Element
 
 	// The type of contributor
-	Type ContributorType `json:"type"`
+	Type        ContributorType `json:"type"`
+	TypeElement *Element        `json:"_type,omitempty"`
 
 	// The name of the individual or organization responsible for some aspect of the content being contributed
-	Name string `json:"name"`
+	Name        string   `json:"name"`
+	NameElement *Element `json:"_name,omitempty"`
 
 	// Contact details to assist a user in finding and communicating with the contributor
 	Contact []ContactDetail `json:"contact,omitempty"`
@@ -394,31 +486,40 @@ type Address struct {
 	Element
 
 	// The purpose of this address
-	Use *AddressUse `json:"use,omitempty"`
+	Use        *AddressUse `json:"use,omitempty"`
+	UseElement *Element    `json:"_use,omitempty"`
 
 	// Distinguishes between physical addresses (those you can visit) and mailing addresses (e.g. PO Boxes and care-of addresses)
-	Type *AddressType `json:"type,omitempty"`
+	Type        *AddressType `json:"type,omitempty"`
+	TypeElement *Element     `json:"_type,omitempty"`
 
 	// A full text representation of the address
-	Text *string `json:"text,omitempty"`
+	Text        *string  `json:"text,omitempty"`
+	TextElement *Element `json:"_text,omitempty"`
 
 	// This component contains the house number, apartment number, street name, street direction, P.O. Box number, delivery hints, and similar address information
-	Line []string `json:"line,omitempty"`
+	Line        []string   `json:"line,omitempty"`
+	LineElement []*Element `json:"_line,omitempty"`
 
 	// The name of the city, town, suburb, village or other community or delivery center
-	City *string `json:"city,omitempty"`
+	City        *string  `json:"city,omitempty"`
+	CityElement *Element `json:"_city,omitempty"`
 
 	// Sub-unit of a country with limited sovereignty in a federally organized country
-	District *string `json:"district,omitempty"`
+	District        *string  `json:"district,omitempty"`
+	DistrictElement *Element `json:"_district,omitempty"`
 
 	// Sub-unit of a country with limited sovereignty in a federally organized country
-	State *string `json:"state,omitempty"`
+	State        *string  `json:"state,omitempty"`
+	StateElement *Element `json:"_state,omitempty"`
 
 	// A postal code designating a region defined by the postal service
-	PostalCode *string `json:"postalCode,omitempty"`
+	PostalCode        *string  `json:"postalCode,omitempty"`
+	PostalCodeElement *Element `json:"_postalCode,omitempty"`
 
 	// Country - a nation as commonly understood or generally accepted
-	Country *string `json:"country,omitempty"`
+	Country        *string  `json:"country,omitempty"`
+	CountryElement *Element `json:"_country,omitempty"`
 
 	// Time period when address was/is in use
 	Period *Period `json:"period,omitempty"`
@@ -449,14 +550,17 @@ type Annotation struct {
 	Element
 
 	// The individual responsible for making the annotation
-	AuthorReference *Reference `json:"authorReference,omitempty"`
-	AuthorString    *string    `json:"authorString,omitempty"`
+	AuthorReference     *Reference `json:"authorReference,omitempty"`
+	AuthorString        *string    `json:"authorString,omitempty"`
+	AuthorStringElement *Element   `json:"_authorString,omitempty"`
 
 	// The text of the annotation in markdown format
-	Text string `json:"text"`
+	Text        string   `json:"text"`
+	TextElement *Element `json:"_text,omitempty"`
 
 	// Indicates when this particular annotation was made
-	Time *FHIRDateTime `json:"time,omitempty"`
+	Time        *DateTime `json:"time,omitempty"`
+	TimeElement *Element  `json:"_time,omitempty"`
 }
 
 // Attachment represents content in a base64format
@@ -464,28 +568,36 @@ type Attachment struct {
 	Element
 
 	// Identifies the type of the data in the attachment and allows a method to be chosen to interpret or render the data
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string  `json:"contentType,omitempty"`
+	ContentTypeElement *Element `json:"_contentType,omitempty"`
 
 	// The human language of the content
-	Language *string `json:"language,omitempty"`
+	Language        *string  `json:"language,omitempty"`
+	LanguageElement *Element `json:"_language,omitempty"`
 
 	// The actual data of the attachment - a sequence of bytes, base64 encoded
-	Data *string `json:"data,omitempty"`
+	Data        *string  `json:"data,omitempty"`
+	DataElement *Element `json:"_data,omitempty"`
 
 	// A location where the data can be accessed
-	URL *string `json:"url,omitempty"`
+	URL        *string  `json:"url,omitempty"`
+	URLElement *Element `json:"_url,omitempty"`
 
 	// The number of bytes of data that make up this attachment
-	Size *int64 `json:"size,omitempty"`
+	Size        *int64   `json:"size,omitempty"`
+	SizeElement *Element `json:"_size,omitempty"`
 
 	// The calculated hash of the data using SHA-1
-	Hash *string `json:"hash,omitempty"`
+	Hash        *string  `json:"hash,omitempty"`
+	HashElement *Element `json:"_hash,omitempty"`
 
 	// A label or set of text to display in place of the data
-	Title *string `json:"title,omitempty"`
+	Title        *string  `json:"title,omitempty"`
+	TitleElement *Element `json:"_title,omitempty"`
 
 	// The date that the attachment was first created
-	Creation *FHIRDateTime `json:"creation,omitempty"`
+	Creation        *DateTime `json:"creation,omitempty"`
+	CreationElement *Element  `json:"_creation,omitempty"`
 }
 
 // Count represents a measured amount
@@ -493,19 +605,24 @@ type Count struct {
 	Element
 
 	// The value of the measured amount
-	Value *float64 `json:"value,omitempty"`
+	Value        *Decimal `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// How the value should be understood and represented
-	Comparator *QuantityComparator `json:"comparator,omitempty"`
+	Comparator        *QuantityComparator `json:"comparator,omitempty"`
+	ComparatorElement *Element            `json:"_comparator,omitempty"`
 
 	// A human-readable form of the value
-	Unit *string `json:"unit,omitempty"`
+	Unit        *string  `json:"unit,omitempty"`
+	UnitElement *Element `json:"_unit,omitempty"`
 
 	// The identification of the system that provides the coded form of the unit
-	System *string `json:"system,omitempty"`
+	System        *string  `json:"system,omitempty"`
+	SystemElement *Element `json:"_system,omitempty"`
 
 	// A computer processable form of the unit in some unit representation system
-	Code *string `json:"code,omitempty"`
+	Code        *string  `json:"code,omitempty"`
+	CodeElement *Element `json:"_code,omitempty"`
 }
 
 // Distance represents a length - a value with a unit that is a physical distance
@@ -513,19 +630,24 @@ type Distance struct {
 	Element
 
 	// The value of the measured amount
-	Value *float64 `json:"value,omitempty"`
+	Value        *Decimal `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// How the value should be understood and represented
-	Comparator *QuantityComparator `json:"comparator,omitempty"`
+	Comparator        *QuantityComparator `json:"comparator,omitempty"`
+	ComparatorElement *Element            `json:"_comparator,omitempty"`
 
 	// A human-readable form of the value
-	Unit *string `json:"unit,omitempty"`
+	Unit        *string  `json:"unit,omitempty"`
+	UnitElement *Element `json:"_unit,omitempty"`
 
 	// The identification of the system that provides the coded form of the unit
-	System *string `json:"system,omitempty"`
+	System        *string  `json:"system,omitempty"`
+	SystemElement *Element `json:"_system,omitempty"`
 
 	// A computer processable form of the unit in some unit representation system
-	Code *string `json:"code,omitempty"`
+	Code        *string  `json:"code,omitempty"`
+	CodeElement *Element `json:"_code,omitempty"`
 }
 
 // HumanName represents a human's name with the ability to identify parts and usage
@@ -533,22 +655,28 @@ type HumanName struct {
 	Element
 
 	// Identifies the purpose for this name
-	Use *HumanNameUse `json:"use,omitempty"`
+	Use        *HumanNameUse `json:"use,omitempty"`
+	UseElement *Element      `json:"_use,omitempty"`
 
 	// The full name formatted for display
-	Text *string `json:"text,omitempty"`
+	Text        *string  `json:"text,omitempty"`
+	TextElement *Element `json:"_text,omitempty"`
 
 	// The part of a name that links to the genealogy
-	Family *string `json:"family,omitempty"`
+	Family        *string  `json:"family,omitempty"`
+	FamilyElement *Element `json:"_family,omitempty"`
 
 	// Given name
-	Given []string `json:"given,omitempty"`
+	Given        []string   `json:"given,omitempty"`
+	GivenElement []*Element `json:"_given,omitempty"`
 
 	// Part that comes before the name
-	Prefix []string `json:"prefix,omitempty"`
+	Prefix        []string   `json:"prefix,omitempty"`
+	PrefixElement []*Element `json:"_prefix,omitempty"`
 
 	// Part that comes after the name
-	Suffix []string `json:"suffix,omitempty"`
+	Suffix        []string   `json:"suffix,omitempty"`
+	SuffixElement []*Element `json:"_suffix,omitempty"`
 
 	// Time period when name was/is in use
 	Period *Period `json:"period,omitempty"`
@@ -572,10 +700,12 @@ type Money struct {
 	Element
 
 	// Numerical value (with implicit precision)
-	Value *float64 `json:"value,omitempty"`
+	Value        *Decimal `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// ISO 4217 Currency Code
-	Currency *string `json:"currency,omitempty"`
+	Currency        *string  `json:"currency,omitempty"`
+	CurrencyElement *Element `json:"_currency,omitempty"`
 }
 
 // Ratio represents a relationship of two Quantity values expressed as a numerator and a denominator
@@ -597,7 +727,8 @@ type Signature struct {
 	Type []Coding `json:"type,omitempty"`
 
 	// When the digital signature was signed
-	When *FHIRDateTime `json:"when,omitempty"`
+	When        *Instant `json:"when,omitempty"`
+	WhenElement *Element `json:"_when,omitempty"`
 
 	// A reference to an application-usable description of the identity that signed
 	Who *Reference `json:"who,omitempty"`
@@ -606,13 +737,16 @@ type Signature struct {
 	OnBehalfOf *Reference `json:"onBehalfOf,omitempty"`
 
 	// A mime type that indicates the technical format of the target resources signed by the signature
-	TargetFormat *string `json:"targetFormat,omitempty"`
+	TargetFormat        *string  `json:"targetFormat,omitempty"`
+	TargetFormatElement *Element `json:"_targetFormat,omitempty"`
 
 	// A mime type that indicates the technical format of the signature
-	SigFormat *string `json:"sigFormat,omitempty"`
+	SigFormat        *string  `json:"sigFormat,omitempty"`
+	SigFormatElement *Element `json:"_sigFormat,omitempty"`
 
 	// The base64 encoding of the Signature content
-	Data *string `json:"data,omitempty"`
+	Data        *string  `json:"data,omitempty"`
+	DataElement *Element `json:"_data,omitempty"`
 }
 
 // Timing represents a timing schedule
@@ -620,7 +754,8 @@ type Timing struct {
 	Element
 
 	// Identifies what events are expected to occur
-	Event []FHIRDateTime `json:"event,omitempty"`
+	Event        []DateTime `json:"event,omitempty"`
+	EventElement []*Element `json:"_event,omitempty"`
 
 	// A set of rules that describe when the event should occur
 	Repeat *TimingRepeat `json:"repeat,omitempty"`
@@ -639,46 +774,60 @@ type TimingRepeat struct {
 	BoundsPeriod   *Period   `json:"boundsPeriod,omitempty"`
 
 	// Number of times to repeat
-	Count *int `json:"count,omitempty"`
+	Count        *int     `json:"count,omitempty"`
+	CountElement *Element `json:"_count,omitempty"`
 
 	// Maximum number of times to repeat
-	CountMax *int `json:"countMax,omitempty"`
+	CountMax        *int     `json:"countMax,omitempty"`
+	CountMaxElement *Element `json:"_countMax,omitempty"`
 
 	// How long when it happens
-	Duration *float64 `json:"duration,omitempty"`
+	Duration        *Decimal `json:"duration,omitempty"`
+	DurationElement *Element `json:"_duration,omitempty"`
 
 	// How long when it happens (Max)
-	DurationMax *float64 `json:"durationMax,omitempty"`
+	DurationMax        *Decimal `json:"durationMax,omitempty"`
+	DurationMaxElement *Element `json:"_durationMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	DurationUnit *string `json:"durationUnit,omitempty"`
+	DurationUnit        *string  `json:"durationUnit,omitempty"`
+	DurationUnitElement *Element `json:"_durationUnit,omitempty"`
 
 	// Event occurs frequency times per period
-	Frequency *int `json:"frequency,omitempty"`
+	Frequency        *int     `json:"frequency,omitempty"`
+	FrequencyElement *Element `json:"_frequency,omitempty"`
 
 	// Event occurs up to frequencyMax times per period
-	FrequencyMax *int `json:"frequencyMax,omitempty"`
+	FrequencyMax        *int     `json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *Element `json:"_frequencyMax,omitempty"`
 
 	// Event occurs frequency times per period
-	Period *float64 `json:"period,omitempty"`
+	Period        *Decimal `json:"period,omitempty"`
+	PeriodElement *Element `json:"_period,omitempty"`
 
 	// Upper limit of period (3-4 hours)
-	PeriodMax *float64 `json:"periodMax,omitempty"`
+	PeriodMax        *Decimal `json:"periodMax,omitempty"`
+	PeriodMaxElement *Element `json:"_periodMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	PeriodUnit *string `json:"periodUnit,omitempty"`
+	PeriodUnit        *string  `json:"periodUnit,omitempty"`
+	PeriodUnitElement *Element `json:"_periodUnit,omitempty"`
 
 	// mon | tue | wed | thu | fri | sat | sun
-	DayOfWeek []string `json:"dayOfWeek,omitempty"`
+	DayOfWeek        []string   `json:"dayOfWeek,omitempty"`
+	DayOfWeekElement []*Element `json:"_dayOfWeek,omitempty"`
 
 	// Time of day for action
-	TimeOfDay []string `json:"timeOfDay,omitempty"`
+	TimeOfDay        []Time     `json:"timeOfDay,omitempty"`
+	TimeOfDayElement []*Element `json:"_timeOfDay,omitempty"`
 
 	// Code for time period of occurrence
-	When []string `json:"when,omitempty"`
+	When        []string   `json:"when,omitempty"`
+	WhenElement []*Element `json:"_when,omitempty"`
 
 	// Minutes from event (before or after)
-	Offset *int `json:"offset,omitempty"`
+	Offset        *int     `json:"offset,omitempty"`
+	OffsetElement *Element `json:"_offset,omitempty"`
 }
 
 // Duration represents a length of time
@@ -686,19 +835,24 @@ type Duration struct {
 	Element
 
 	// The value of the measured amount
-	Value *float64 `json:"value,omitempty"`
+	Value        *Decimal `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 
 	// How the value should be understood and represented
-	Comparator *QuantityComparator `json:"comparator,omitempty"`
+	Comparator        *QuantityComparator `json:"comparator,omitempty"`
+	ComparatorElement *Element            `json:"_comparator,omitempty"`
 
 	// A human-readable form of the value
-	Unit *string `json:"unit,omitempty"`
+	Unit        *string  `json:"unit,omitempty"`
+	UnitElement *Element `json:"_unit,omitempty"`
 
 	// The identification of the system that provides the coded form of the unit
-	System *string `json:"system,omitempty"`
+	System        *string  `json:"system,omitempty"`
+	SystemElement *Element `json:"_system,omitempty"`
 
 	// A computer processable form of the unit in some unit representation system
-	Code *string `json:"code,omitempty"`
+	Code        *string  `json:"code,omitempty"`
+	CodeElement *Element `json:"_code,omitempty"`
 }
 
 // DataRequirement represents a required data item for evaluation
@@ -706,17 +860,20 @@ type DataRequirement struct {
 	Element
 
 	// The type of the required data
-	Type string `json:"type"`
+	Type        string   `json:"type"`
+	TypeElement *Element `json:"_type,omitempty"`
 
 	// The profile of the required data
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string   `json:"profile,omitempty"`
+	ProfileElement []*Element `json:"_profile,omitempty"`
 
 	// The subject of a data requirement
 	SubjectCodeableConcept *CodeableConcept `json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *Reference       `json:"subjectReference,omitempty"`
 
 	// Indicates that specific elements of the type are referenced by the knowledge module
-	MustSupport []string `json:"mustSupport,omitempty"`
+	MustSupport        []string   `json:"mustSupport,omitempty"`
+	MustSupportElement []*Element `json:"_mustSupport,omitempty"`
 
 	// Code filters specify additional constraints on the data
 	CodeFilter []DataRequirementCodeFilter `json:"codeFilter,omitempty"`
@@ -731,7 +888,8 @@ type DataRequirement struct {
 	Sort []DataRequirementSort `json:"sort,omitempty"`
 
 	// The maximum number of results to return
-	Limit *int `json:"limit,omitempty"`
+	Limit        *int     `json:"limit,omitempty"`
+	LimitElement *Element `json:"_limit,omitempty"`
 }
 
 // DataRequirementCodeFilter represents a code filter for data requirements
@@ -739,13 +897,16 @@ type DataRequirementCodeFilter struct {
 	Element
 
 	// A code-valued attribute to filter on
-	Path *string `json:"path,omitempty"`
+	Path        *string  `json:"path,omitempty"`
+	PathElement *Element `json:"_path,omitempty"`
 
 	// A token that defines the search parameter
-	SearchParam *string `json:"searchParam,omitempty"`
+	SearchParam        *string  `json:"searchParam,omitempty"`
+	SearchParamElement *Element `json:"_searchParam,omitempty"`
 
 	// Valueset for the filter
-	ValueSet *string `json:"valueSet,omitempty"`
+	ValueSet        *string  `json:"valueSet,omitempty"`
+	ValueSetElement *Element `json:"_valueSet,omitempty"`
 
 	// What code is expected
 	Code []Coding `json:"code,omitempty"`
@@ -756,15 +917,18 @@ type DataRequirementDateFilter struct {
 	Element
 
 	// A date-valued attribute to filter on
-	Path *string `json:"path,omitempty"`
+	Path        *string  `json:"path,omitempty"`
+	PathElement *Element `json:"_path,omitempty"`
 
 	// A date parameter that refers to a search parameter type on this type
-	SearchParam *string `json:"searchParam,omitempty"`
+	SearchParam        *string  `json:"searchParam,omitempty"`
+	SearchParamElement *Element `json:"_searchParam,omitempty"`
 
 	// The value of the filter, as a Period, DateTime, or Duration value
-	ValueDateTime *FHIRDateTime `json:"valueDateTime,omitempty"`
-	ValuePeriod   *Period       `json:"valuePeriod,omitempty"`
-	ValueDuration *Duration     `json:"valueDuration,omitempty"`
+	ValueDateTime        *DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *Element  `json:"_valueDateTime,omitempty"`
+	ValuePeriod          *Period   `json:"valuePeriod,omitempty"`
+	ValueDuration        *Duration `json:"valueDuration,omitempty"`
 }
 
 // DataRequirementValueFilter represents a value filter for data requirements
@@ -772,16 +936,20 @@ type DataRequirementValueFilter struct {
 	Element
 
 	// The attribute of the sort
-	Path *string `json:"path,omitempty"`
+	Path        *string  `json:"path,omitempty"`
+	PathElement *Element `json:"_path,omitempty"`
 
 	// The search parameter for the filter
-	SearchParam *string `json:"searchParam,omitempty"`
+	SearchParam        *string  `json:"searchParam,omitempty"`
+	SearchParamElement *Element `json:"_searchParam,omitempty"`
 
 	// The comparator to be used to determine whether the value is acceptable
-	Comparator *string `json:"comparator,omitempty"`
+	Comparator        *string  `json:"comparator,omitempty"`
+	ComparatorElement *Element `json:"_comparator,omitempty"`
 
 	// The value of the filter
-	Value *string `json:"value,omitempty"`
+	Value        *string  `json:"value,omitempty"`
+	ValueElement *Element `json:"_value,omitempty"`
 }
 
 // DataRequirementSort represents a sort specification for data requirements
@@ -789,10 +957,12 @@ type DataRequirementSort struct {
 	Element
 
 	// The attribute of the sort
-	Path string `json:"path"`
+	Path        string   `json:"path"`
+	PathElement *Element `json:"_path,omitempty"`
 
 	// The direction of the sort
-	Direction DataRequirementSortDirection `json:"direction"`
+	Direction        DataRequirementSortDirection `json:"direction"`
+	DirectionElement *Element                     `json:"_direction,omitempty"`
 }
 
 // DataRequirementSortDirection represents the direction of sorting
@@ -808,19 +978,24 @@ type Expression struct {
 	Element
 
 	// A brief, natural language description of the condition that effectively communicates the intended semantics
-	Description *string `json:"description,omitempty"`
+	Description        *string  `json:"description,omitempty"`
+	DescriptionElement *Element `json:"_description,omitempty"`
 
 	// A short name assigned to the expression to allow for multiple reuse of the expression in the context where it is defined
-	Name *string `json:"name,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	NameElement *Element `json:"_name,omitempty"`
 
 	// The media type of the language for the expression
-	Language *string `json:"language,omitempty"`
+	Language        *string  `json:"language,omitempty"`
+	LanguageElement *Element `json:"_language,omitempty"`
 
 	// An expression in the specified language
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string  `json:"expression,omitempty"`
+	ExpressionElement *Element `json:"_expression,omitempty"`
 
 	// A URI that defines where the expression is found
-	Reference *string `json:"reference,omitempty"`
+	Reference        *string  `json:"reference,omitempty"`
+	ReferenceElement *Element `json:"_reference,omitempty"`
 }
 
 // RelatedArtifact represents related artifacts such as additional documentation, justification, or bibliographic references
@@ -828,25 +1003,31 @@ type RelatedArtifact struct {
 	Element
 
 	// The type of relationship to the related artifact
-	Type RelatedArtifactType `json:"type"`
+	Type        RelatedArtifactType `json:"type"`
+	TypeElement *Element            `json:"_type,omitempty"`
 
 	// A short label that can be used to reference the citation from elsewhere in the containing artifact
-	Label *string `json:"label,omitempty"`
+	Label        *string  `json:"label,omitempty"`
+	LabelElement *Element `json:"_label,omitempty"`
 
 	// A brief description of the document or knowledge resource being referenced
-	Display *string `json:"display,omitempty"`
+	Display        *string  `json:"display,omitempty"`
+	DisplayElement *Element `json:"_display,omitempty"`
 
 	// A bibliographic citation for the related artifact
-	Citation *string `json:"citation,omitempty"`
+	Citation        *string  `json:"citation,omitempty"`
+	CitationElement *Element `json:"_citation,omitempty"`
 
 	// The document being referenced
-	URL *string `json:"url,omitempty"`
+	URL        *string  `json:"url,omitempty"`
+	URLElement *Element `json:"_url,omitempty"`
 
 	// The related artifact, such as a library, value set, profile, or other knowledge resource
 	Document *Attachment `json:"document,omitempty"`
 
 	// The related resource
-	Resource *string `json:"resource,omitempty"`
+	Resource        *string  `json:"resource,omitempty"`
+	ResourceElement *Element `json:"_resource,omitempty"`
 }
 
 // RelatedArtifactType represents the type of relationship to the related artifact
@@ -868,16 +1049,20 @@ type TriggerDefinition struct {
 	Element
 
 	// The type of triggering event
-	Type TriggerDefinitionType `json:"type"`
+	Type        TriggerDefinitionType `json:"type"`
+	TypeElement *Element              `json:"_type,omitempty"`
 
 	// A formal name for the event
-	Name *string `json:"name,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	NameElement *Element `json:"_name,omitempty"`
 
 	// The timing of the event (if this is a periodic trigger)
-	TimingTiming    *Timing       `json:"timingTiming,omitempty"`
-	TimingReference *Reference    `json:"timingReference,omitempty"`
-	TimingDate      *FHIRDateTime `json:"timingDate,omitempty"`
-	TimingDateTime  *FHIRDateTime `json:"timingDateTime,omitempty"`
+	TimingTiming          *Timing    `json:"timingTiming,omitempty"`
+	TimingReference       *Reference `json:"timingReference,omitempty"`
+	TimingDate            *Date      `json:"timingDate,omitempty"`
+	TimingDateElement     *Element   `json:"_timingDate,omitempty"`
+	TimingDateTime        *DateTime  `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *Element   `json:"_timingDateTime,omitempty"`
 
 	// The triggering data of the event (if this is a data trigger)
 	Data []DataRequirement `json:"data,omitempty"`
@@ -905,10 +1090,12 @@ type Dosage struct {
 	Element
 
 	// Free text dosage instructions
-	Text *string `json:"text,omitempty"`
+	Text        *string  `json:"text,omitempty"`
+	TextElement *Element `json:"_text,omitempty"`
 
 	// Patient or consumer oriented instructions
-	PatientInstruction *string `json:"patientInstruction,omitempty"`
+	PatientInstruction        *string  `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *Element `json:"_patientInstruction,omitempty"`
 
 	// Timing for the dosage
 	Timing *Timing `json:"timing,omitempty"`
@@ -948,16 +1135,20 @@ type Meta struct {
 	Element
 
 	// Version specific identifier
-	VersionID *string `json:"versionId,omitempty"`
+	VersionID        *string  `json:"versionId,omitempty"`
+	VersionIDElement *Element `json:"_versionId,omitempty"`
 
 	// When the resource version last changed
-	LastUpdated *FHIRDateTime `json:"lastUpdated,omitempty"`
+	LastUpdated        *Instant `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *Element `json:"_lastUpdated,omitempty"`
 
 	// Identifies where the resource comes from
-	Source *string `json:"source,omitempty"`
+	Source        *string  `json:"source,omitempty"`
+	SourceElement *Element `json:"_source,omitempty"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string   `json:"profile,omitempty"`
+	ProfileElement []*Element `json:"_profile,omitempty"`
 
 	// Security Labels applied to this resource
 	Security []Coding `json:"security,omitempty"`
@@ -968,168 +1159,3 @@ type Meta struct {
 
 // Age represents a duration of time during which an organism (or a process) has existed
 type Age Quantity
-
-// ElementDefinition represents the formal definition of an element in a resource or data type
-type ElementDefinition struct {
-	BackboneElement
-
-	// The path of the element (see the ElementDefinition.path property)
-	Path string `json:"path"`
-
-	// How many times this element is permitted to appear in the instance
-	Min *int `json:"min,omitempty"`
-
-	// The maximum number of times this element is permitted to appear in the instance
-	Max *string `json:"max,omitempty"`
-
-	// The data type or resource that the value of this element is permitted to be
-	Type []ElementDefinitionType `json:"type,omitempty"`
-
-	// A concise description of what this element means (used for alternate purpose, etc.)
-	Short *string `json:"short,omitempty"`
-
-	// Provides a complete explanation of the meaning of the data element for human readability
-	Definition *string `json:"definition,omitempty"`
-
-	// Explanatory notes and implementation guidance about the data element
-	Comment *string `json:"comment,omitempty"`
-
-	// The name of this element definition slice, when slicing is working
-	SliceName *string `json:"sliceName,omitempty"`
-
-	// Whether this element definition is constraining a slicer
-	SliceIsConstraining *bool `json:"sliceIsConstraining,omitempty"`
-
-	// A sample value for this element demonstrating the type of information that would typically be captured
-	Example []ElementDefinitionExample `json:"example,omitempty"`
-
-	// Formal constraints such as co-occurrence and other constraints that can be computationally evaluated
-	Constraint []ElementDefinitionConstraint `json:"constraint,omitempty"`
-
-	// Binds to a value set if this element is coded (code, Coding, CodeableConcept, Quantity), or the data types (string, uri)
-	Binding *ElementDefinitionBinding `json:"binding,omitempty"`
-
-	// Identifies a concept from an external specification that roughly corresponds to this element
-	Mapping []ElementDefinitionMapping `json:"mapping,omitempty"`
-}
-
-// ElementDefinitionType represents the data type or resource that the value of this element is permitted to be
-type ElementDefinitionType struct {
-	Element
-
-	// URL of the type
-	Code string `json:"code"`
-
-	// Profile (StructureDefinition) to apply to this element
-	Profile []string `json:"profile,omitempty"`
-
-	// Profile (StructureDefinition) to apply to the target resource (for a reference)
-	TargetProfile []string `json:"targetProfile,omitempty"`
-
-	// If the type is a reference to another resource, how the resource is or can be aggregated
-	Aggregation []ElementDefinitionTypeAggregation `json:"aggregation,omitempty"`
-
-	// Whether this reference needs to be version specific or version independent, or whether either can be used
-	Versioning *ElementDefinitionTypeVersioning `json:"versioning,omitempty"`
-}
-
-// ElementDefinitionTypeAggregation represents if the type is a reference to another resource, how the resource is or can be aggregated
-type ElementDefinitionTypeAggregation string
-
-const (
-	ElementDefinitionTypeAggregationContained  ElementDefinitionTypeAggregation = "contained"
-	ElementDefinitionTypeAggregationReferenced ElementDefinitionTypeAggregation = "referenced"
-	ElementDefinitionTypeAggregationBundled    ElementDefinitionTypeAggregation = "bundled"
-)
-
-// ElementDefinitionTypeVersioning represents whether this reference needs to be version specific or version independent
-type ElementDefinitionTypeVersioning string
-
-const (
-	ElementDefinitionTypeVersioningEither      ElementDefinitionTypeVersioning = "either"
-	ElementDefinitionTypeVersioningIndependent ElementDefinitionTypeVersioning = "independent"
-	ElementDefinitionTypeVersioningSpecific    ElementDefinitionTypeVersioning = "specific"
-)
-
-// ElementDefinitionExample represents a sample value for this element demonstrating the type of information that would typically be captured
-type ElementDefinitionExample struct {
-	Element
-
-	// Describes the purpose of this example
-	Label string `json:"label"`
-
-	// The actual value for the element (must be one of the allowed types)
-	Value interface{} `json:"value"`
-}
-
-// ElementDefinitionConstraint represents formal constraints such as co-occurrence and other constraints that can be computationally evaluated
-type ElementDefinitionConstraint struct {
-	Element
-
-	// Allows identification of which elements have their cardinalities impacted by the constraint
-	Key string `json:"key"`
-
-	// Description of why this constraint is necessary or appropriate
-	Requirements *string `json:"requirements,omitempty"`
-
-	// Identifies the impact constraint violation has on the conformance of the instance
-	Severity ElementDefinitionConstraintSeverity `json:"severity"`
-
-	// Text that can be used to describe the constraint in messages identifying that the constraint has been violated
-	Human string `json:"human"`
-
-	// A FHIRPath expression of constraint that can be executed to see if this constraint is met
-	Expression *string `json:"expression,omitempty"`
-
-	// An XPath expression of constraint that can be executed to see if this constraint is met
-	Xpath *string `json:"xpath,omitempty"`
-}
-
-// ElementDefinitionConstraintSeverity represents identifies the impact constraint violation has on the conformance of the instance
-type ElementDefinitionConstraintSeverity string
-
-const (
-	ElementDefinitionConstraintSeverityError   ElementDefinitionConstraintSeverity = "error"
-	ElementDefinitionConstraintSeverityWarning ElementDefinitionConstraintSeverity = "warning"
-)
-
-// ElementDefinitionBinding represents binds to a value set if this element is coded
-type ElementDefinitionBinding struct {
-	Element
-
-	// Indicates the degree of conformance expectations associated with this binding
-	Strength ElementDefinitionBindingStrength `json:"strength"`
-
-	// Describes the intended use of this binding
-	Description *string `json:"description,omitempty"`
-
-	// Refers to the value set that identifies the set of codes the binding refers to
-	ValueSet *string `json:"valueSet,omitempty"`
-}
-
-// ElementDefinitionBindingStrength represents indicates the degree of conformance expectations associated with this binding
-type ElementDefinitionBindingStrength string
-
-const (
-	ElementDefinitionBindingStrengthRequired   ElementDefinitionBindingStrength = "required"
-	ElementDefinitionBindingStrengthExtensible ElementDefinitionBindingStrength = "extensible"
-	ElementDefinitionBindingStrengthPreferred  ElementDefinitionBindingStrength = "preferred"
-	ElementDefinitionBindingStrengthExample    ElementDefinitionBindingStrength = "example"
-)
-
-// ElementDefinitionMapping represents identifies a concept from an external specification that roughly corresponds to this element
-type ElementDefinitionMapping struct {
-	Element
-
-	// An internal reference to the definition of a mapping
-	Identity string `json:"identity"`
-
-	// Identifies the computable language in which mapping.map is expressed
-	Language *string `json:"language,omitempty"`
-
-	// Expresses what part of the target specification corresponds to this element
-	Map string `json:"map"`
-
-	// Comments that provide information about the mapping or its use
-	Comment *string `json:"comment,omitempty"`
-}
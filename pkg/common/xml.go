@@ -0,0 +1,1117 @@
+package common
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ../../cmd/resourcegen -profiles ../fhir5/testdata/fhir5-json
+
+// FHIR XML represents every JSON property as an element of the same name in
+// the FHIR namespace. Primitive values are held in a value attribute, element
+// ids and extension urls are attributes, the narrative div is inline XHTML and
+// resources nested in other resources are wrapped in an element named after the
+// property. Unlike JSON, the order of the elements is significant; it is taken
+// from the ElementOrder tables generated for each package.
+
+const (
+	// FHIRNamespace is the XML namespace of all FHIR elements
+	FHIRNamespace = "http://hl7.org/fhir"
+
+	// XHTMLNamespace is the XML namespace of the narrative div
+	XHTMLNamespace = "http://www.w3.org/1999/xhtml"
+)
+
+// ElementOrder lists the JSON property names of a struct in the order required by FHIR XML.
+// Structs without an entry are written in the order of their fields.
+type ElementOrder map[reflect.Type][]string
+
+// GenericTypes maps the JSON property name of an untyped (interface{}) field to
+// the struct that models its content, e.g. "valueAvailability" to the R5
+// Availability type. Such fields are converted through that struct, everything
+// else held in interface{} fields is mapped by its JSON structure.
+type GenericTypes map[string]reflect.Type
+
+// XMLCodec reads and writes FHIR XML for the resources of one FHIR version
+type XMLCodec struct {
+	registry *ResourceRegistry
+	order    ElementOrder
+	generic  GenericTypes
+	plans    sync.Map
+}
+
+// NewXMLCodec creates a codec that decodes resources with the given registry and
+// writes elements in the given order. The order of the shared types of this package
+// is always included.
+func NewXMLCodec(registry *ResourceRegistry, order ElementOrder, generic GenericTypes) *XMLCodec {
+	merged := make(ElementOrder, len(elementOrder)+len(order))
+	for t, names := range elementOrder {
+		merged[t] = names
+	}
+	for t, names := range order {
+		merged[t] = names
+	}
+	return &XMLCodec{registry: registry, order: merged, generic: generic}
+}
+
+// Marshal writes the resource as a FHIR XML document
+func (c *XMLCodec) Marshal(resource Resource) ([]byte, error) {
+	return c.MarshalIndent(resource, "", "")
+}
+
+// MarshalIndent is like Marshal but starts each element on a new line that begins
+// with prefix followed by one copy of indent per nesting level
+func (c *XMLCodec) MarshalIndent(resource Resource, prefix, indent string) ([]byte, error) {
+	if resource == nil {
+		return nil, fmt.Errorf("encoding XML: nil resource")
+	}
+	node, err := c.encodeResource(resource)
+	if err != nil {
+		return nil, fmt.Errorf("encoding XML: %w", err)
+	}
+	node.attrs = append([]xmlAttr{{name: "xmlns", value: FHIRNamespace}}, node.attrs...)
+
+	var buf bytes.Buffer
+	buf.WriteString(strings.TrimSuffix(xml.Header, "\n"))
+	node.write(&buf, prefix, indent, 0)
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes a FHIR XML document into the struct registered for its root element.
+// Resources of an unknown type are returned as *RawResource holding their JSON form.
+func (c *XMLCodec) Unmarshal(data []byte) (Resource, error) {
+	root, err := parseXML(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding XML: %w", err)
+	}
+	resource, err := c.decodeResource(root)
+	if err != nil {
+		return nil, fmt.Errorf("decoding XML: %w", err)
+	}
+	return resource, nil
+}
+
+// UnmarshalInto decodes a FHIR XML document into the given resource struct.
+// The root element must match the type of the resource.
+func (c *XMLCodec) UnmarshalInto(data []byte, resource Resource) error {
+	root, err := parseXML(data)
+	if err != nil {
+		return fmt.Errorf("decoding XML: %w", err)
+	}
+	if root.name != resource.GetResourceType() {
+		return fmt.Errorf("decoding XML: expected %s, got %s", resource.GetResourceType(), root.name)
+	}
+	v := reflect.ValueOf(resource)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decoding XML: %T is not a pointer to a struct", resource)
+	}
+	if err := c.decodeStruct(root, v.Elem()); err != nil {
+		return fmt.Errorf("decoding XML: %w", err)
+	}
+	setResourceType(v.Elem(), root.name)
+	return nil
+}
+
+// setResourceType fills the resourceType fields of a resource struct, which have no XML counterpart
+func setResourceType(v reflect.Value, resourceType string) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		switch {
+		case field.Anonymous && field.Type.Kind() == reflect.Struct:
+			setResourceType(v.Field(i), resourceType)
+		case field.Type.Kind() == reflect.String && strings.Split(field.Tag.Get("json"), ",")[0] == "resourceType":
+			v.Field(i).SetString(resourceType)
+		}
+	}
+}
+
+// xmlNode is an element of a FHIR XML document
+type xmlNode struct {
+	name     string
+	space    string
+	attrs    []xmlAttr
+	children []*xmlNode
+
+	// xhtml holds the verbatim markup of an XHTML element
+	xhtml string
+}
+
+type xmlAttr struct {
+	name, value string
+}
+
+func (n *xmlNode) attr(name string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.name == name {
+			return a.value, true
+		}
+	}
+	return "", false
+}
+
+func (n *xmlNode) write(buf *bytes.Buffer, prefix, indent string, depth int) {
+	if prefix != "" || indent != "" {
+		buf.WriteByte('\n')
+		buf.WriteString(prefix)
+		buf.WriteString(strings.Repeat(indent, depth))
+	}
+	if n.xhtml != "" {
+		buf.WriteString(n.xhtml)
+		return
+	}
+	buf.WriteByte('<')
+	buf.WriteString(n.name)
+	for _, a := range n.attrs {
+		buf.WriteByte(' ')
+		buf.WriteString(a.name)
+		buf.WriteString(`="`)
+		xml.EscapeText(buf, []byte(a.value))
+		buf.WriteByte('"')
+	}
+	if len(n.children) == 0 {
+		buf.WriteString("/>")
+		return
+	}
+	buf.WriteByte('>')
+	for _, child := range n.children {
+		child.write(buf, prefix, indent, depth+1)
+	}
+	if prefix != "" || indent != "" {
+		buf.WriteByte('\n')
+		buf.WriteString(prefix)
+		buf.WriteString(strings.Repeat(indent, depth))
+	}
+	buf.WriteString("</")
+	buf.WriteString(n.name)
+	buf.WriteByte('>')
+}
+
+// parseXML reads a document into a tree of nodes. XHTML elements are kept verbatim.
+func parseXML(data []byte) (*xmlNode, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Entity = xml.HTMLEntity
+
+	var root *xmlNode
+	var stack []*xmlNode
+	for {
+		offset := d.InputOffset()
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: t.Name.Local, space: t.Name.Space}
+			if t.Name.Space == XHTMLNamespace {
+				if err := d.Skip(); err != nil {
+					return nil, err
+				}
+				node.xhtml = string(data[offset:d.InputOffset()])
+			} else {
+				for _, a := range t.Attr {
+					if a.Name.Space == "" && a.Name.Local != "xmlns" {
+						node.attrs = append(node.attrs, xmlAttr{name: a.Name.Local, value: a.Value})
+					}
+				}
+			}
+
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, fmt.Errorf("multiple root elements")
+				}
+				root = node
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, node)
+			}
+			if node.xhtml == "" {
+				stack = append(stack, node)
+			}
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no root element")
+	}
+	if root.space != FHIRNamespace {
+		return nil, fmt.Errorf("root element %s is not in the FHIR namespace", root.name)
+	}
+	return root, nil
+}
+
+type xmlKind int
+
+const (
+	xmlPrimitive xmlKind = iota
+	xmlComplex
+	xmlResource
+	xmlGeneric
+	xmlXHTML
+	xmlAttribute
+)
+
+// xmlField describes how a struct field is represented in XML
+type xmlField struct {
+	name      string
+	index     []int
+	kind      xmlKind
+	repeated  bool
+	omitEmpty bool
+
+	// element is the index of the companion Element field holding the id and
+	// extensions of a primitive, nil if there is none
+	element []int
+}
+
+// xmlPlan is the XML layout of a struct type
+type xmlPlan struct {
+	fields []*xmlField
+	byName map[string]*xmlField
+}
+
+var (
+	resourceInterface    = reflect.TypeOf((*Resource)(nil)).Elem()
+	textMarshalerType    = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType  = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	emptyInterfaceType   = reflect.TypeOf((*interface{})(nil)).Elem()
+	genericArrayElements = map[string]bool{
+		"extension": true, "modifierExtension": true, "coding": true, "given": true, "prefix": true,
+		"suffix": true, "line": true, "telecom": true, "identifier": true, "contained": true,
+		"contact": true, "useContext": true, "note": true, "jurisdiction": true, "parameter": true,
+		"part": true, "profile": true, "tag": true, "security": true, "type": true, "element": true,
+	}
+)
+
+// plan returns the XML layout of a struct type, computing it on first use
+func (c *XMLCodec) plan(t reflect.Type) *xmlPlan {
+	if cached, ok := c.plans.Load(t); ok {
+		return cached.(*xmlPlan)
+	}
+
+	type candidate struct {
+		field reflect.StructField
+		index []int
+		depth int
+	}
+	candidates := map[string]candidate{}
+	var names []string
+	var walk func(t reflect.Type, index []int, depth int)
+	walk = func(t reflect.Type, index []int, depth int) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			fieldIndex := append(append([]int{}, index...), i)
+			tag := field.Tag.Get("json")
+			name := strings.Split(tag, ",")[0]
+			if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+				walk(field.Type, fieldIndex, depth+1)
+				continue
+			}
+			if !field.IsExported() || name == "" || name == "-" || (name == "resourceType" && field.Type.Kind() == reflect.String) {
+				continue
+			}
+			if existing, ok := candidates[name]; ok && existing.depth <= depth {
+				continue
+			} else if !ok {
+				names = append(names, name)
+			}
+			candidates[name] = candidate{field: field, index: fieldIndex, depth: depth}
+		}
+	}
+	walk(t, nil, 0)
+
+	if order, ok := c.order[t]; ok {
+		rank := make(map[string]int, len(order))
+		for i, name := range order {
+			rank[name] = i
+		}
+		sort.SliceStable(names, func(i, j int) bool {
+			ri, oki := rank[names[i]]
+			rj, okj := rank[names[j]]
+			if oki && okj {
+				return ri < rj
+			}
+			return oki && !okj
+		})
+	}
+
+	isResource := reflect.PointerTo(t).Implements(resourceInterface)
+	plan := &xmlPlan{byName: map[string]*xmlField{}}
+	for _, name := range names {
+		if strings.HasPrefix(name, "_") {
+			continue
+		}
+		cand := candidates[name]
+		f := &xmlField{
+			name:      name,
+			index:     cand.index,
+			omitEmpty: strings.Contains(cand.field.Tag.Get("json"), ",omitempty"),
+		}
+		if companion, ok := candidates["_"+name]; ok {
+			f.element = companion.index
+		}
+
+		base := cand.field.Type
+		if base.Kind() == reflect.Pointer {
+			base = base.Elem()
+		}
+		if base.Kind() == reflect.Slice && base.Elem().Kind() != reflect.Uint8 {
+			f.repeated = true
+			base = base.Elem()
+			if base.Kind() == reflect.Pointer {
+				base = base.Elem()
+			}
+		}
+
+		switch {
+		case base == resourceInterface:
+			f.kind = xmlResource
+		case base == emptyInterfaceType:
+			f.kind = xmlGeneric
+		case name == "div" && t.Name() == "Narrative":
+			f.kind = xmlXHTML
+		case (name == "id" && !isResource) || (name == "url" && t.Name() == "Extension"):
+			f.kind = xmlAttribute
+		case isPrimitiveType(base):
+			f.kind = xmlPrimitive
+		default:
+			f.kind = xmlComplex
+		}
+		plan.fields = append(plan.fields, f)
+		plan.byName[name] = f
+	}
+
+	cached, _ := c.plans.LoadOrStore(t, plan)
+	return cached.(*xmlPlan)
+}
+
+// isPrimitiveType reports whether values of t are written as a value attribute
+func isPrimitiveType(t reflect.Type) bool {
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return true
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// encodeResource writes a resource as an element named after its type
+func (c *XMLCodec) encodeResource(resource Resource) (*xmlNode, error) {
+	if raw, ok := resource.(*RawResource); ok {
+		var value interface{}
+		if err := json.Unmarshal(raw.Data, &value); err != nil {
+			return nil, fmt.Errorf("%s: %w", raw.ResourceType, err)
+		}
+		nodes, err := c.encodeGeneric("", value, nil)
+		if err != nil || len(nodes) != 1 {
+			return nil, fmt.Errorf("%s: cannot encode resource: %v", raw.ResourceType, err)
+		}
+		return nodes[0].children[0], nil
+	}
+
+	v := reflect.ValueOf(resource)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%T is not a pointer to a resource struct", resource)
+	}
+	node := &xmlNode{name: resource.GetResourceType()}
+	if err := c.encodeStruct(node, v.Elem()); err != nil {
+		return nil, fmt.Errorf("%s.%w", node.name, err)
+	}
+	return node, nil
+}
+
+// encodeStruct adds the attributes and child elements of a struct to node
+func (c *XMLCodec) encodeStruct(node *xmlNode, v reflect.Value) error {
+	plan := c.plan(v.Type())
+	for _, f := range plan.fields {
+		fv := v.FieldByIndex(f.index)
+		switch f.kind {
+		case xmlAttribute:
+			if text, ok := primitiveText(fv, true); ok {
+				node.attrs = append(node.attrs, xmlAttr{name: f.name, value: text})
+			}
+		case xmlXHTML:
+			if fv.String() == "" {
+				continue
+			}
+			div, err := normalizeXHTML(fv.String())
+			if err != nil {
+				return fmt.Errorf("%s: %w", f.name, err)
+			}
+			node.children = append(node.children, &xmlNode{name: f.name, xhtml: div})
+		case xmlPrimitive:
+			var ev reflect.Value
+			if f.element != nil {
+				ev = v.FieldByIndex(f.element)
+			}
+			if err := c.encodePrimitive(node, f, fv, ev); err != nil {
+				return fmt.Errorf("%s: %w", f.name, err)
+			}
+		case xmlComplex:
+			if err := c.encodeComplex(node, f, fv); err != nil {
+				return fmt.Errorf("%s.%w", f.name, err)
+			}
+		case xmlResource:
+			if err := c.encodeResourceField(node, f, fv); err != nil {
+				return fmt.Errorf("%s.%w", f.name, err)
+			}
+		case xmlGeneric:
+			var values []interface{}
+			switch {
+			case fv.Kind() == reflect.Slice:
+				for i := 0; i < fv.Len(); i++ {
+					values = append(values, fv.Index(i).Interface())
+				}
+			case fv.Kind() == reflect.Pointer && !fv.IsNil():
+				values = append(values, fv.Elem().Interface())
+			case fv.Kind() == reflect.Interface && !fv.IsNil():
+				values = append(values, fv.Interface())
+			}
+			for _, value := range values {
+				if t, ok := c.generic[f.name]; ok {
+					typed := reflect.New(t)
+					if err := convertJSON(value, typed.Interface()); err != nil {
+						return fmt.Errorf("%s: %w", f.name, err)
+					}
+					value = typed.Interface()
+				}
+				children, err := c.encodeGeneric(f.name, value, nil)
+				if err != nil {
+					return fmt.Errorf("%s: %w", f.name, err)
+				}
+				node.children = append(node.children, children...)
+			}
+		}
+	}
+	return nil
+}
+
+// encodePrimitive writes a primitive or a list of primitives together with their companion elements
+func (c *XMLCodec) encodePrimitive(node *xmlNode, f *xmlField, fv, ev reflect.Value) error {
+	if !f.repeated {
+		child := &xmlNode{name: f.name}
+		if ev.IsValid() && !ev.IsNil() {
+			if err := c.encodeStruct(child, ev.Elem()); err != nil {
+				return err
+			}
+		}
+		if text, ok := primitiveText(fv, f.omitEmpty); ok {
+			child.attrs = append(child.attrs, xmlAttr{name: "value", value: text})
+		}
+		if len(child.attrs) > 0 || len(child.children) > 0 {
+			node.children = append(node.children, child)
+		}
+		return nil
+	}
+
+	n := fv.Len()
+	if ev.IsValid() && ev.Len() > n {
+		n = ev.Len()
+	}
+	for i := 0; i < n; i++ {
+		child := &xmlNode{name: f.name}
+		if ev.IsValid() && i < ev.Len() && !ev.Index(i).IsNil() {
+			if err := c.encodeStruct(child, ev.Index(i).Elem()); err != nil {
+				return err
+			}
+		}
+		if i < fv.Len() {
+			if text, ok := primitiveText(fv.Index(i), false); ok {
+				child.attrs = append(child.attrs, xmlAttr{name: "value", value: text})
+			}
+		}
+		node.children = append(node.children, child)
+	}
+	return nil
+}
+
+// primitiveText returns the lexical form of a primitive value.
+// ok is false if the value is absent.
+func primitiveText(v reflect.Value, omitEmpty bool) (string, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+		omitEmpty = false
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err == nil && len(text) > 0
+	}
+	if omitEmpty && v.IsZero() {
+		return "", false
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), v.Len() > 0
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// encodeComplex writes a struct or a list of structs
+func (c *XMLCodec) encodeComplex(node *xmlNode, f *xmlField, fv reflect.Value) error {
+	var values []reflect.Value
+	switch fv.Kind() {
+	case reflect.Slice:
+		for i := 0; i < fv.Len(); i++ {
+			values = append(values, fv.Index(i))
+		}
+	case reflect.Pointer:
+		if fv.IsNil() {
+			return nil
+		}
+		values = append(values, fv)
+	default:
+		if fv.IsZero() {
+			return nil
+		}
+		values = append(values, fv)
+	}
+
+	for _, value := range values {
+		if value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+		child := &xmlNode{name: f.name}
+		if err := c.encodeStruct(child, value); err != nil {
+			return err
+		}
+		node.children = append(node.children, child)
+	}
+	return nil
+}
+
+// encodeResourceField wraps each resource in an element named after the field
+func (c *XMLCodec) encodeResourceField(node *xmlNode, f *xmlField, fv reflect.Value) error {
+	var resources []Resource
+	if fv.Kind() == reflect.Slice {
+		for i := 0; i < fv.Len(); i++ {
+			if r, ok := fv.Index(i).Interface().(Resource); ok && r != nil {
+				resources = append(resources, r)
+			}
+		}
+	} else if r, ok := fv.Interface().(Resource); ok && r != nil {
+		resources = append(resources, r)
+	}
+
+	for _, resource := range resources {
+		child, err := c.encodeResource(resource)
+		if err != nil {
+			return err
+		}
+		node.children = append(node.children, &xmlNode{name: f.name, children: []*xmlNode{child}})
+	}
+	return nil
+}
+
+// encodeGeneric writes a value held in an interface{} field. JSON values are
+// mapped by their structure, other values by reflection.
+func (c *XMLCodec) encodeGeneric(name string, value, element interface{}) ([]*xmlNode, error) {
+	switch v := value.(type) {
+	case nil:
+		if element == nil {
+			return nil, nil
+		}
+		child := &xmlNode{name: name}
+		if err := c.encodeGenericObject(child, element.(map[string]interface{}), false); err != nil {
+			return nil, err
+		}
+		return []*xmlNode{child}, nil
+	case []interface{}:
+		elements, _ := element.([]interface{})
+		var nodes []*xmlNode
+		for i, item := range v {
+			var itemElement interface{}
+			if i < len(elements) {
+				itemElement = elements[i]
+			}
+			children, err := c.encodeGeneric(name, item, itemElement)
+			if err != nil {
+				return nil, err
+			}
+			if len(children) == 0 {
+				children = []*xmlNode{{name: name}}
+			}
+			nodes = append(nodes, children...)
+		}
+		return nodes, nil
+	case map[string]interface{}:
+		child := &xmlNode{name: name}
+		if resourceType, ok := v["resourceType"].(string); ok {
+			resource := &xmlNode{name: resourceType}
+			if err := c.encodeGenericObject(resource, v, true); err != nil {
+				return nil, err
+			}
+			child.children = append(child.children, resource)
+		} else if err := c.encodeGenericObject(child, v, false); err != nil {
+			return nil, err
+		}
+		return []*xmlNode{child}, nil
+	case string, bool, float64, json.Number:
+		child := &xmlNode{name: name}
+		if object, ok := element.(map[string]interface{}); ok {
+			if err := c.encodeGenericObject(child, object, false); err != nil {
+				return nil, err
+			}
+		}
+		child.attrs = append(child.attrs, xmlAttr{name: "value", value: fmt.Sprint(v)})
+		return []*xmlNode{child}, nil
+	case Resource:
+		child, err := c.encodeResource(v)
+		if err != nil {
+			return nil, err
+		}
+		return []*xmlNode{{name: name, children: []*xmlNode{child}}}, nil
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	switch {
+	case rv.Kind() == reflect.Struct && !isPrimitiveType(rv.Type()):
+		child := &xmlNode{name: name}
+		if err := c.encodeStruct(child, rv); err != nil {
+			return nil, err
+		}
+		return []*xmlNode{child}, nil
+	case rv.IsValid() && isPrimitiveType(rv.Type()):
+		text, _ := primitiveText(rv, false)
+		return []*xmlNode{{name: name, attrs: []xmlAttr{{name: "value", value: text}}}}, nil
+	}
+	return nil, fmt.Errorf("cannot encode %T", value)
+}
+
+// encodeGenericObject adds the properties of a JSON object to node. Without type
+// information the elements are written with extensions first, followed by the
+// remaining properties in alphabetical order.
+func (c *XMLCodec) encodeGenericObject(node *xmlNode, object map[string]interface{}, isResource bool) error {
+	var names []string
+	for name := range object {
+		if !strings.HasPrefix(name, "_") && name != "resourceType" {
+			names = append(names, name)
+		}
+		if strings.HasPrefix(name, "_") {
+			if _, ok := object[name[1:]]; !ok {
+				names = append(names, name[1:])
+			}
+		}
+	}
+	first := map[string]int{"id": 0, "meta": 1, "implicitRules": 2, "language": 3, "text": 4, "contained": 5, "extension": 6, "modifierExtension": 7}
+	sort.Slice(names, func(i, j int) bool {
+		ri, oki := first[names[i]]
+		rj, okj := first[names[j]]
+		switch {
+		case oki && okj:
+			return ri < rj
+		case oki != okj:
+			return oki
+		}
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		value := object[name]
+		if s, ok := value.(string); ok && !isResource && (name == "id" || (name == "url" && (node.name == "extension" || node.name == "modifierExtension"))) {
+			node.attrs = append(node.attrs, xmlAttr{name: name, value: s})
+			continue
+		}
+		if s, ok := value.(string); ok && name == "div" {
+			div, err := normalizeXHTML(s)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			node.children = append(node.children, &xmlNode{name: name, xhtml: div})
+			continue
+		}
+		children, err := c.encodeGeneric(name, value, object["_"+name])
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		node.children = append(node.children, children...)
+	}
+	return nil
+}
+
+// normalizeXHTML checks that the narrative is well-formed and declares the XHTML namespace
+func normalizeXHTML(div string) (string, error) {
+	div = strings.TrimSpace(div)
+	if end := strings.IndexByte(div, '>'); strings.HasPrefix(div, "<div") && end > 0 && !strings.Contains(div[:end], "xmlns=") {
+		div = `<div xmlns="` + XHTMLNamespace + `"` + div[len("<div"):]
+	}
+	d := xml.NewDecoder(strings.NewReader(div))
+	d.Entity = xml.HTMLEntity
+	for {
+		if _, err := d.Token(); err == io.EOF {
+			return div, nil
+		} else if err != nil {
+			return "", fmt.Errorf("invalid XHTML: %w", err)
+		}
+	}
+}
+
+// decodeResource decodes an element named after a resource type
+func (c *XMLCodec) decodeResource(node *xmlNode) (Resource, error) {
+	resource, ok := c.registry.New(node.name)
+	if !ok {
+		object, err := decodeGenericObject(node, true)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%w", node.name, err)
+		}
+		object["resourceType"] = node.name
+		data, err := json.Marshal(object)
+		if err != nil {
+			return nil, err
+		}
+		return &RawResource{ResourceType: node.name, Data: data}, nil
+	}
+	v := reflect.ValueOf(resource).Elem()
+	if err := c.decodeStruct(node, v); err != nil {
+		return nil, fmt.Errorf("%s.%w", node.name, err)
+	}
+	setResourceType(v, node.name)
+	return resource, nil
+}
+
+// decodeStruct fills an addressable struct from the attributes and children of node
+func (c *XMLCodec) decodeStruct(node *xmlNode, v reflect.Value) error {
+	plan := c.plan(v.Type())
+	for _, a := range node.attrs {
+		if f, ok := plan.byName[a.name]; ok && f.kind == xmlAttribute {
+			if err := setPrimitive(allocate(v.FieldByIndex(f.index)), a.value); err != nil {
+				return fmt.Errorf("%s: %w", a.name, err)
+			}
+		}
+	}
+
+	counts := map[string]int{}
+	for _, child := range node.children {
+		f, ok := plan.byName[child.name]
+		if !ok {
+			continue
+		}
+		index := counts[child.name]
+		counts[child.name]++
+		fv := v.FieldByIndex(f.index)
+		switch f.kind {
+		case xmlXHTML:
+			fv.SetString(child.xhtml)
+		case xmlPrimitive:
+			if err := c.decodePrimitive(child, f, index, v, fv); err != nil {
+				return fmt.Errorf("%s: %w", f.name, err)
+			}
+		case xmlComplex:
+			target := appendTarget(fv, f.repeated)
+			if err := c.decodeStruct(child, allocate(target)); err != nil {
+				return fmt.Errorf("%s.%w", f.name, err)
+			}
+		case xmlResource:
+			if len(child.children) != 1 {
+				return fmt.Errorf("%s: expected exactly one resource", f.name)
+			}
+			resource, err := c.decodeResource(child.children[0])
+			if err != nil {
+				return fmt.Errorf("%s.%w", f.name, err)
+			}
+			appendTarget(fv, f.repeated).Set(reflect.ValueOf(resource))
+		case xmlGeneric:
+			value, err := c.decodeGenericField(child, f.name)
+			if err != nil {
+				return fmt.Errorf("%s: %w", f.name, err)
+			}
+			target := appendTarget(fv, f.repeated)
+			if target.Kind() == reflect.Pointer {
+				target.Set(reflect.New(target.Type().Elem()))
+				target = target.Elem()
+			}
+			if value != nil {
+				target.Set(reflect.ValueOf(value))
+			}
+		}
+	}
+
+	// JSON requires the values and their companion elements to be parallel lists of equal length
+	for _, f := range plan.fields {
+		if f.kind == xmlPrimitive && f.repeated && f.element != nil {
+			fv, ev := v.FieldByIndex(f.index), v.FieldByIndex(f.element)
+			if fv.Len() > 0 && ev.Len() > 0 {
+				padSlice(fv, ev.Len())
+				padSlice(ev, fv.Len())
+			}
+		}
+	}
+	return nil
+}
+
+// decodePrimitive reads the value attribute of the index-th child of a primitive
+// field and its companion element
+func (c *XMLCodec) decodePrimitive(child *xmlNode, f *xmlField, index int, parent, fv reflect.Value) error {
+	hasElement := len(child.children) > 0
+	if _, ok := child.attr("id"); ok {
+		hasElement = true
+	}
+
+	if value, ok := child.attr("value"); ok {
+		target := fv
+		if f.repeated {
+			padSlice(fv, index)
+			target = appendTarget(fv, true)
+		}
+		if err := setPrimitive(allocate(target), value); err != nil {
+			return err
+		}
+	}
+	if f.element == nil || !hasElement {
+		return nil
+	}
+
+	ev := parent.FieldByIndex(f.element)
+	if f.repeated {
+		padSlice(ev, index)
+		ev = appendTarget(ev, true)
+	}
+	return c.decodeStruct(child, allocate(ev))
+}
+
+// padSlice appends zero values until the slice has length n
+func padSlice(v reflect.Value, n int) {
+	for v.Len() < n {
+		v.Set(reflect.Append(v, reflect.Zero(v.Type().Elem())))
+	}
+}
+
+// appendTarget returns the value to decode into: a new slice element for repeated fields, the field itself otherwise
+func appendTarget(fv reflect.Value, repeated bool) reflect.Value {
+	if !repeated {
+		return fv
+	}
+	fv.Set(reflect.Append(fv, reflect.Zero(fv.Type().Elem())))
+	return fv.Index(fv.Len() - 1)
+}
+
+// allocate follows pointers, allocating them where nil, and returns the addressable value
+func allocate(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// setPrimitive parses the lexical form of a primitive into v
+func setPrimitive(v reflect.Value, text string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		switch text {
+		case "true":
+			v.SetBool(true)
+		case "false":
+			v.SetBool(false)
+		default:
+			return fmt.Errorf("invalid boolean %q", text)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", text)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", text)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid decimal %q", text)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("cannot decode %s from a value attribute", v.Type())
+	}
+	return nil
+}
+
+// decodeGenericField decodes the content of an interface{} field, through its
+// struct if the field is listed in the generic types
+func (c *XMLCodec) decodeGenericField(node *xmlNode, name string) (interface{}, error) {
+	t, ok := c.generic[name]
+	if !ok {
+		return decodeGeneric(node)
+	}
+	typed := reflect.New(t)
+	if err := c.decodeStruct(node, typed.Elem()); err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := convertJSON(typed.Interface(), &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// convertJSON converts between two representations of the same JSON value
+func convertJSON(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
+
+// decodeGeneric converts an element into the JSON value it represents. Without
+// type information, numbers and booleans are recognized by their lexical form and
+// a property is only decoded as an array if it repeats or is known to repeat.
+func decodeGeneric(node *xmlNode) (interface{}, error) {
+	if node.xhtml != "" {
+		return node.xhtml, nil
+	}
+	if len(node.children) == 1 && node.children[0].space == FHIRNamespace && isResourceName(node.children[0].name) {
+		object, err := decodeGenericObject(node.children[0], true)
+		if err != nil {
+			return nil, err
+		}
+		object["resourceType"] = node.children[0].name
+		return object, nil
+	}
+	if value, ok := node.attr("value"); ok {
+		return genericPrimitive(value), nil
+	}
+	return decodeGenericObject(node, false)
+}
+
+// decodeGenericObject converts the attributes and children of node into a JSON object
+func decodeGenericObject(node *xmlNode, isResource bool) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	for _, a := range node.attrs {
+		if a.name == "id" || a.name == "url" {
+			object[a.name] = a.value
+		}
+	}
+
+	var names []string
+	groups := map[string][]*xmlNode{}
+	for _, child := range node.children {
+		if _, ok := groups[child.name]; !ok {
+			names = append(names, child.name)
+		}
+		groups[child.name] = append(groups[child.name], child)
+	}
+
+	for _, name := range names {
+		children := groups[name]
+		values := make([]interface{}, len(children))
+		elements := make([]interface{}, len(children))
+		hasValue, hasElement := false, false
+		for i, child := range children {
+			value, isPrimitive := child.attr("value")
+			if isPrimitive || (child.xhtml == "" && len(child.children) > 0 && allExtensions(child)) {
+				if isPrimitive {
+					values[i] = genericPrimitive(value)
+					if name == "id" {
+						values[i] = value
+					}
+					hasValue = true
+				}
+				if element, err := decodeGenericObject(child, false); err != nil {
+					return nil, err
+				} else if len(element) > 0 {
+					elements[i] = element
+					hasElement = true
+				}
+				continue
+			}
+			value2, err := decodeGeneric(child)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value2
+			hasValue = true
+		}
+
+		if len(children) > 1 || genericArrayElements[name] || (isResource && name == "contained") {
+			if hasValue {
+				object[name] = values
+			}
+			if hasElement {
+				object["_"+name] = elements
+			}
+		} else {
+			if hasValue {
+				object[name] = values[0]
+			}
+			if hasElement {
+				object["_"+name] = elements[0]
+			}
+		}
+	}
+	return object, nil
+}
+
+// allExtensions reports whether all children of node are extensions, i.e. node is a primitive without value
+func allExtensions(node *xmlNode) bool {
+	for _, child := range node.children {
+		if child.name != "extension" {
+			return false
+		}
+	}
+	return true
+}
+
+// genericPrimitive infers the JSON type of a value attribute
+func genericPrimitive(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if decimalPattern.MatchString(value) {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return value
+}
+
+// isResourceName reports whether name looks like a resource type, which start with an upper case letter
+func isResourceName(name string) bool {
+	return name != "" && name[0] >= 'A' && name[0] <= 'Z'
+}
@@ -0,0 +1,809 @@
+// Package convert translates resources between FHIR versions.
+//
+// A resource is converted element by element: elements that exist in both
+// versions are copied, renamed and restructured elements are translated, and
+// elements without an equivalent in the target version are preserved as the
+// cross-version extensions of the specification, e.g.
+// http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.classHistory.
+// Converting a resource back restores the elements preserved this way.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Loss describes an element of the source resource that has no exact
+// equivalent in the target version
+type Loss struct {
+	// Path is the location of the element in the source resource, e.g. Encounter.classHistory
+	Path string
+
+	// Extension is the url of the cross-version extension preserving the
+	// element, empty if the element was dropped
+	Extension string
+
+	// Default is the value assigned to an element the target version requires
+	// and the source resource lacks, Path is then the location of the element
+	// in the converted resource
+	Default string
+}
+
+// object is a JSON object of a resource being converted
+type object = map[string]interface{}
+
+// version describes a FHIR version a conversion reads or writes
+type version struct {
+	// name is the release name used in messages, e.g. R4
+	name string
+
+	// code is the version used in cross-version extension urls, e.g. 4.0
+	code string
+
+	newResource func(resourceType string) (common.Resource, bool)
+	unmarshal   func(data []byte) (common.Resource, error)
+
+	// datatypes are the complex datatypes allowed as extension values
+	datatypes map[string]bool
+}
+
+// extensionURL returns the url of the cross-version extension for an element path
+func (v *version) extensionURL(path string) string {
+	return "http://hl7.org/fhir/" + v.code + "/StructureDefinition/extension-" + path
+}
+
+// transform translates the elements of a struct the generic conversion cannot
+// map, before the generic conversion of the remaining elements
+type transform func(s *scope)
+
+// scope is the JSON object of a struct of the source version being converted
+type scope struct {
+	c   *conversion
+	m   object
+	src reflect.Type
+
+	// element is the path of the struct in its definition, path its location
+	// in the resource
+	element, path string
+
+	// converted are the elements the transform stored in the structure of
+	// the target version
+	converted map[string]bool
+}
+
+// conversion converts resources from one version to another
+type conversion struct {
+	from, to   *version
+	transforms map[string]transform
+	losses     []Loss
+
+	// resourceTypes maps the resource types the target version renamed
+	resourceTypes map[string]string
+}
+
+// run converts r, which must be a resource of the source version
+func (c *conversion) run(r common.Resource) (common.Resource, []Loss, error) {
+	if r == nil {
+		return nil, nil, fmt.Errorf("convert: nil resource")
+	}
+	resourceType := r.GetResourceType()
+	if proto, ok := c.from.newResource(resourceType); !ok || reflect.TypeOf(proto) != reflect.TypeOf(r) {
+		return nil, nil, fmt.Errorf("convert: %T is not an %s resource", r, c.from.name)
+	}
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert: encoding %s: %w", resourceType, err)
+	}
+	m, err := decode(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert: decoding %s: %w", resourceType, err)
+	}
+	m["resourceType"] = resourceType
+	if !c.resource(m, resourceType) {
+		return nil, nil, fmt.Errorf("convert: %s does not exist in %s", resourceType, c.to.name)
+	}
+	if data, err = json.Marshal(m); err != nil {
+		return nil, nil, fmt.Errorf("convert: encoding %s: %w", resourceType, err)
+	}
+	result, err := c.to.unmarshal(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("convert: %w", err)
+	}
+	return result, c.losses, nil
+}
+
+// decode reads a JSON object keeping numbers as written
+func decode(data []byte) (object, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var m object
+	err := decoder.Decode(&m)
+	return m, err
+}
+
+// resource converts the JSON object of a resource in place, it reports false
+// if the target version does not know the resource type
+func (c *conversion) resource(m object, path string) bool {
+	resourceType, _ := m["resourceType"].(string)
+	src, ok := c.from.newResource(resourceType)
+	if !ok {
+		return false
+	}
+	target := resourceType
+	if renamed, ok := c.resourceTypes[resourceType]; ok {
+		target = renamed
+	}
+	dst, ok := c.to.newResource(target)
+	if !ok {
+		return false
+	}
+	m["resourceType"] = target
+	c.object(m, structType(reflect.TypeOf(src)), structType(reflect.TypeOf(dst)), resourceType, path)
+	return true
+}
+
+// object converts the JSON object of a struct from src to dst. element is the
+// path of the struct in its definition, used for extension urls, and path its
+// location in the resource.
+func (c *conversion) object(m object, src, dst reflect.Type, element, path string) {
+	s := &scope{c: c, m: m, src: src, element: element, path: path}
+	if fn, ok := c.transforms[src.Name()]; ok {
+		fn(s)
+	}
+	restored := c.restore(m, dst, element)
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key == "resourceType" || key == "extension" || key == "modifierExtension" || strings.HasPrefix(key, "_") {
+			continue
+		}
+		if isEmpty(m[key]) {
+			delete(m, key)
+			continue
+		}
+		dstField, ok := field(dst, key)
+		srcField, known := field(src, key)
+		if !known || restored[key] || s.converted[key] {
+			srcField = dstField
+		}
+		if !ok {
+			if known {
+				c.preserve(m, dst, key, m[key], srcField.Type, element+"."+key, path+"."+key)
+			} else {
+				c.losses = append(c.losses, Loss{Path: path + "." + key})
+			}
+			delete(m, key)
+			delete(m, "_"+key)
+			continue
+		}
+		original := m[key]
+		value, lossy := c.value(original, srcField.Type, dstField.Type, element+"."+key, path+"."+key)
+		if lossy {
+			c.preserve(m, dst, key, original, srcField.Type, element+"."+key, path+"."+key)
+		}
+		if value == nil || nulls(value) && m["_"+key] == nil {
+			delete(m, key)
+			delete(m, "_"+key)
+			continue
+		}
+		m[key] = value
+		if primitive, ok := m["_"+key]; ok {
+			m["_"+key], _ = cardinality(primitive, dstField.Type)
+		}
+	}
+}
+
+// value converts the JSON value of a field, lossy reports whether it could
+// not be converted completely
+func (c *conversion) value(v interface{}, src, dst reflect.Type, element, path string) (result interface{}, lossy bool) {
+	v, lossy = cardinality(v, dst)
+	srcElem, dstElem := elemType(src), elemType(dst)
+	if list, ok := v.([]interface{}); ok {
+		result := make([]interface{}, 0, len(list))
+		for i, item := range list {
+			converted, itemLossy := c.item(item, srcElem, dstElem, element, path+"["+strconv.Itoa(i)+"]")
+			lossy = lossy || itemLossy
+			// null items of a primitive stay in place, their extensions are
+			// in the _ list at the same index
+			if converted != nil || isPrimitive(dstElem) {
+				result = append(result, converted)
+			}
+		}
+		if len(result) == 0 {
+			return nil, lossy
+		}
+		return result, lossy
+	}
+	converted, itemLossy := c.item(v, srcElem, dstElem, element, path)
+	return converted, lossy || itemLossy
+}
+
+// cardinality makes a value a list or a single value as dst requires, keeping
+// only the first value of a list
+func cardinality(v interface{}, dst reflect.Type) (interface{}, bool) {
+	list, isList := v.([]interface{})
+	switch {
+	case isList && !isSlice(dst):
+		if len(list) == 0 {
+			return nil, false
+		}
+		return list[0], len(list) > 1
+	case !isList && v != nil && isSlice(dst):
+		return []interface{}{v}, false
+	}
+	return v, false
+}
+
+// item converts a single value from the struct or primitive type src to dst
+func (c *conversion) item(v interface{}, src, dst reflect.Type, element, path string) (interface{}, bool) {
+	if v == nil {
+		return nil, false
+	}
+	if isResource(dst) {
+		m, ok := v.(object)
+		if !ok {
+			return nil, true
+		}
+		resourceType, _ := m["resourceType"].(string)
+		if !c.resource(m, resourceType) {
+			c.losses = append(c.losses, Loss{Path: path})
+			return nil, false
+		}
+		return m, false
+	}
+	if isPrimitive(src) || isPrimitive(dst) {
+		return primitive(v, dst)
+	}
+	m, ok := v.(object)
+	if !ok {
+		return nil, true
+	}
+	m, lossy := datatype(m, src.Name(), dst.Name())
+	if m == nil {
+		return nil, true
+	}
+	if src.Name() != dst.Name() && c.isDatatype(src.Name()) {
+		// the datatype conversion yields the structure of dst
+		src = dst
+	}
+	if c.isDatatype(src.Name()) {
+		element = src.Name()
+	}
+	c.object(m, src, dst, element, path)
+	return m, lossy
+}
+
+// primitive converts a primitive value to the JSON type of dst, e.g. the integer
+// size of an R4 Attachment to the string of an R5 integer64
+func primitive(v interface{}, dst reflect.Type) (interface{}, bool) {
+	if !isPrimitive(dst) {
+		return nil, true
+	}
+	switch x := v.(type) {
+	case bool:
+		if dst.Kind() == reflect.Bool {
+			return x, false
+		}
+	case json.Number:
+		switch dst.Kind() {
+		case reflect.String:
+			return x.String(), false
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			if _, err := strconv.ParseInt(x.String(), 10, 64); err == nil {
+				return x, false
+			}
+		case reflect.Float32, reflect.Float64, reflect.Struct:
+			if dst == reflect.TypeOf(common.Decimal{}) || dst.Kind() != reflect.Struct {
+				return x, false
+			}
+		}
+	case string:
+		switch dst.Kind() {
+		case reflect.String:
+			return x, false
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			if _, err := strconv.ParseInt(x, 10, 64); err == nil {
+				return json.Number(x), false
+			}
+		case reflect.Struct:
+			if dst != reflect.TypeOf(common.Decimal{}) {
+				return x, false
+			}
+		}
+	}
+	return nil, true
+}
+
+// datatype converts between the datatypes that replace each other across
+// versions, such as Coding and CodeableConcept or CodeableConcept and
+// CodeableReference. A nil result means the value cannot be represented.
+func datatype(m object, src, dst string) (object, bool) {
+	switch {
+	case src == dst:
+		return m, false
+	case src == "Coding" && dst == "CodeableConcept":
+		return object{"coding": []interface{}{m}}, false
+	case src == "CodeableConcept" && dst == "Coding":
+		codings, _ := m["coding"].([]interface{})
+		if len(codings) == 0 {
+			return nil, true
+		}
+		coding, _ := codings[0].(object)
+		return coding, len(codings) > 1 || len(m) > 1
+	case src == "CodeableConcept" && dst == "CodeableReference":
+		return object{"concept": m}, false
+	case src == "Reference" && dst == "CodeableReference":
+		return object{"reference": m}, false
+	case src == "CodeableReference" && (dst == "CodeableConcept" || dst == "Reference"):
+		key := "concept"
+		if dst == "Reference" {
+			key = "reference"
+		}
+		value, _ := m[key].(object)
+		return value, len(m) > 1 || value == nil
+	}
+	return m, false
+}
+
+// preserve stores a value that cannot be converted as cross-version extensions
+// of the struct holding it. The struct type dst decides whether it can carry
+// extensions at all, nil means it can.
+func (c *conversion) preserve(m object, dst reflect.Type, key string, v interface{}, src reflect.Type, element, path string) {
+	if dst != nil {
+		if _, ok := field(dst, "extension"); !ok {
+			c.losses = append(c.losses, Loss{Path: path})
+			return
+		}
+	}
+	url := c.from.extensionURL(element)
+	var primitives []interface{}
+	switch p := m["_"+key].(type) {
+	case []interface{}:
+		primitives = p
+	case object:
+		primitives = []interface{}{p}
+	}
+	values, ok := v.([]interface{})
+	if !ok {
+		values = []interface{}{v}
+	}
+	extensions, _ := m["extension"].([]interface{})
+	for i, value := range values {
+		var primitive interface{}
+		if i < len(primitives) {
+			primitive = primitives[i]
+		}
+		if ext := c.extension(url, value, primitive, elemType(src), element); ext != nil {
+			extensions = append(extensions, ext)
+		}
+	}
+	if len(extensions) > 0 {
+		m["extension"] = extensions
+	}
+	c.losses = append(c.losses, Loss{Path: path, Extension: url})
+}
+
+// extension builds the extension carrying a value of type t. Primitives and
+// datatypes known to the target version become value[x], other structs
+// complex extensions with an extension per child element.
+func (c *conversion) extension(url string, v, primitiveElement interface{}, t reflect.Type, element string) object {
+	ext := object{"url": url}
+	if isPrimitive(t) {
+		if v == nil {
+			return nil
+		}
+		name := primitiveType(t)
+		ext["value"+name] = v
+		if primitiveElement != nil {
+			ext["_value"+name] = primitiveElement
+		}
+		return ext
+	}
+	m, ok := v.(object)
+	if !ok || isResource(t) || isEmpty(m) {
+		return nil
+	}
+	if name := t.Name(); c.to.datatypes[name] {
+		if valueField, ok := field(reflect.TypeOf(common.Extension{}), "value"+name); ok {
+			c.object(m, t, elemType(valueField.Type), name, element)
+			if isEmpty(m) {
+				return nil
+			}
+			ext["value"+name] = m
+			return ext
+		}
+	}
+	if c.isDatatype(t.Name()) {
+		element = t.Name()
+	}
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var children []interface{}
+	for _, key := range keys {
+		if key == "id" || key == "extension" || key == "modifierExtension" || strings.HasPrefix(key, "_") {
+			continue
+		}
+		f, ok := field(t, key)
+		if !ok {
+			continue
+		}
+		values, ok := m[key].([]interface{})
+		if !ok {
+			values = []interface{}{m[key]}
+		}
+		primitives, _ := m["_"+key].([]interface{})
+		for i, value := range values {
+			var primitive interface{}
+			if i < len(primitives) {
+				primitive = primitives[i]
+			} else if p, ok := m["_"+key].(object); ok {
+				primitive = p
+			}
+			if child := c.extension(key, value, primitive, elemType(f.Type), element+"."+key); child != nil {
+				children = append(children, child)
+			}
+		}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	ext["extension"] = children
+	return ext
+}
+
+// restore moves the elements a previous conversion preserved as cross-version
+// extensions of the target version back into the JSON object of the struct
+// dst. A restored element replaces the value the conversion derived, the
+// restored elements are returned.
+func (c *conversion) restore(m object, dst reflect.Type, element string) map[string]bool {
+	extensions, _ := m["extension"].([]interface{})
+	if len(extensions) == 0 {
+		return nil
+	}
+	prefix := c.to.extensionURL(element) + "."
+	restored := map[string]bool{}
+	kept := extensions[:0:0]
+	for _, e := range extensions {
+		ext, _ := e.(object)
+		url, _ := ext["url"].(string)
+		name := strings.TrimPrefix(url, prefix)
+		if ext == nil || name == url || strings.Contains(name, ".") {
+			kept = append(kept, e)
+			continue
+		}
+		key, f, ok := restoreField(dst, name, ext)
+		if !ok {
+			kept = append(kept, e)
+			continue
+		}
+		value, primitiveElement := restoreValue(ext, f.Type)
+		if !restored[key] {
+			restored[key] = true
+			delete(m, key)
+			delete(m, "_"+key)
+		}
+		if !isSlice(f.Type) {
+			m[key] = value
+			if primitiveElement != nil {
+				m["_"+key] = primitiveElement
+			}
+			continue
+		}
+		list, _ := m[key].([]interface{})
+		m[key] = append(list, value)
+		if primitiveElement != nil {
+			primitives, _ := m["_"+key].([]interface{})
+			for len(primitives) < len(list) {
+				primitives = append(primitives, nil)
+			}
+			m["_"+key] = append(primitives, primitiveElement)
+		}
+	}
+	if len(kept) == 0 {
+		delete(m, "extension")
+	} else {
+		m["extension"] = kept
+	}
+	return restored
+}
+
+// restoreField finds the field of t an extension restores, choice elements by
+// the type of the extension value
+func restoreField(t reflect.Type, name string, ext object) (string, reflect.StructField, bool) {
+	if f, ok := field(t, name); ok {
+		return name, f, true
+	}
+	for key := range ext {
+		if strings.HasPrefix(key, "value") {
+			choice := name + strings.TrimPrefix(key, "value")
+			if f, ok := field(t, choice); ok {
+				return choice, f, true
+			}
+		}
+	}
+	return "", reflect.StructField{}, false
+}
+
+// restoreValue returns the value an extension carries for a field of type t
+// and the primitive element of a primitive value
+func restoreValue(ext object, t reflect.Type) (interface{}, interface{}) {
+	for key, value := range ext {
+		if strings.HasPrefix(key, "value") {
+			return value, ext["_"+key]
+		}
+	}
+	t = elemType(t)
+	m := object{}
+	children, _ := ext["extension"].([]interface{})
+	for _, child := range children {
+		childExt, _ := child.(object)
+		name, _ := childExt["url"].(string)
+		key, f, ok := restoreField(t, name, childExt)
+		if !ok {
+			continue
+		}
+		value, primitiveElement := restoreValue(childExt, f.Type)
+		if !isSlice(f.Type) {
+			m[key] = value
+			if primitiveElement != nil {
+				m["_"+key] = primitiveElement
+			}
+			continue
+		}
+		list, _ := m[key].([]interface{})
+		m[key] = append(list, value)
+	}
+	return m, nil
+}
+
+// rename moves an element and its primitive element to a new name
+func (s *scope) rename(from, to string) {
+	for _, prefix := range []string{"", "_"} {
+		if v, ok := s.m[prefix+from]; ok {
+			s.m[prefix+to] = v
+			delete(s.m, prefix+from)
+		}
+	}
+}
+
+// take removes an element and returns its values as a list
+func (s *scope) take(key string) []interface{} {
+	v := s.m[key]
+	delete(s.m, key)
+	delete(s.m, "_"+key)
+	return list(v)
+}
+
+// put stores a value the transform converted to the structure of the target
+// version
+func (s *scope) put(key string, v interface{}) {
+	if s.converted == nil {
+		s.converted = map[string]bool{}
+	}
+	s.converted[key] = true
+	s.m[key] = v
+}
+
+// require sets an element the target version requires to a default value if
+// the source lacks it
+func (s *scope) require(key string, v interface{}) {
+	if !isEmpty(s.m[key]) {
+		return
+	}
+	s.put(key, v)
+	value, ok := v.(string)
+	if !ok {
+		data, _ := json.Marshal(v)
+		value = string(data)
+	}
+	s.c.losses = append(s.c.losses, Loss{Path: s.path + "." + key, Default: value})
+}
+
+// keep preserves the current value of an element the transform cannot convert
+// completely as cross-version extension
+func (s *scope) keep(key string) {
+	if v, ok := s.m[key]; ok {
+		f, _ := field(s.src, key)
+		s.c.preserve(s.m, nil, key, v, f.Type, s.element+"."+key, s.path+"."+key)
+	}
+}
+
+// code translates a code with a table, codes missing from the table are kept.
+// The original code is preserved unless reverse translates it back.
+func (s *scope) code(key string, codes, reverse map[string]string) {
+	from, _ := s.m[key].(string)
+	to, ok := codes[from]
+	if !ok {
+		return
+	}
+	if back, ok := reverse[to]; ok && back != from || !ok && to != from {
+		s.keep(key)
+	}
+	s.m[key] = to
+}
+
+// isEmpty reports whether a JSON value carries no data, such as the zero values
+// of required structs
+func isEmpty(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case object:
+		return len(v) == 0
+	}
+	return false
+}
+
+// nulls reports whether v is a list of null items only
+func nulls(v interface{}) bool {
+	items, ok := v.([]interface{})
+	if !ok {
+		return false
+	}
+	for _, item := range items {
+		if item != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// list returns a JSON value as a list of values
+func list(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return v
+	}
+	return []interface{}{v}
+}
+
+// set returns a set of strings
+func set(values ...string) map[string]bool {
+	result := make(map[string]bool, len(values))
+	for _, v := range values {
+		result[v] = true
+	}
+	return result
+}
+
+// isDatatype reports whether a struct name is a complex datatype, whose
+// elements are identified by the datatype rather than the element using it
+func (c *conversion) isDatatype(name string) bool {
+	return c.from.datatypes[name] || c.to.datatypes[name]
+}
+
+// primitiveType returns the FHIR type of a primitive Go type, named string
+// types are codes
+func primitiveType(t reflect.Type) string {
+	switch t {
+	case reflect.TypeOf(common.Decimal{}):
+		return "Decimal"
+	case reflect.TypeOf(common.Date{}):
+		return "Date"
+	case reflect.TypeOf(common.DateTime{}):
+		return "DateTime"
+	case reflect.TypeOf(common.Instant{}):
+		return "Instant"
+	case reflect.TypeOf(common.Time{}):
+		return "Time"
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "Boolean"
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return "Integer"
+	case reflect.Float32, reflect.Float64:
+		return "Decimal"
+	case reflect.String:
+		if t.Name() != "string" {
+			return "Code"
+		}
+	}
+	return "String"
+}
+
+var resourceType = reflect.TypeOf((*common.Resource)(nil)).Elem()
+
+func isResource(t reflect.Type) bool {
+	return t.Kind() == reflect.Interface && t.Implements(resourceType)
+}
+
+// isPrimitive reports whether values of t are JSON primitives
+func isPrimitive(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeOf(common.Decimal{}), reflect.TypeOf(common.Date{}), reflect.TypeOf(common.DateTime{}),
+		reflect.TypeOf(common.Instant{}), reflect.TypeOf(common.Time{}):
+		return true
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Interface, reflect.Map, reflect.Slice:
+		return false
+	}
+	return true
+}
+
+func isSlice(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Slice
+}
+
+// elemType strips pointers and slices from a field type
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
+
+func structType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// fieldsCache maps a struct type to its fields by JSON name
+var fieldsCache sync.Map
+
+// field returns the field of struct t with the JSON name, including the fields
+// of embedded structs
+func field(t reflect.Type, name string) (reflect.StructField, bool) {
+	if t.Kind() != reflect.Struct {
+		return reflect.StructField{}, false
+	}
+	if fields, ok := fieldsCache.Load(t); ok {
+		f, ok := fields.(map[string]reflect.StructField)[name]
+		return f, ok
+	}
+	fields := map[string]reflect.StructField{}
+	collectFields(t, fields)
+	fieldsCache.Store(t, fields)
+	f, ok := fields[name]
+	return f, ok
+}
+
+// collectFields adds the fields of t before those of its embedded structs,
+// which they shadow
+func collectFields(t reflect.Type, fields map[string]reflect.StructField) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, f.Type)
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" || !f.IsExported() {
+			continue
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = f
+		}
+	}
+	for _, e := range embedded {
+		collectFields(e, fields)
+	}
+}
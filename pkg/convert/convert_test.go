@@ -0,0 +1,264 @@
+package convert
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// jsonValue returns the generic JSON form of a resource for comparisons
+func jsonValue(t *testing.T, r common.Resource) interface{} {
+	t.Helper()
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("failed to marshal %s: %v", r.GetResourceType(), err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", r.GetResourceType(), err)
+	}
+	return v
+}
+
+func unmarshalR4(t *testing.T, data string) common.Resource {
+	t.Helper()
+	r, err := fhir4.UnmarshalResource([]byte(data))
+	if err != nil {
+		t.Fatalf("failed to unmarshal R4 resource: %v", err)
+	}
+	return r
+}
+
+func TestR4ToR5_Encounter(t *testing.T) {
+	r4Encounter := unmarshalR4(t, `{
+		"resourceType": "Encounter",
+		"status": "arrived",
+		"class": {"system": "http://terminology.hl7.org/CodeSystem/v3-ActCode", "code": "AMB"},
+		"classHistory": [{"class": {"code": "EMER"}, "period": {"start": "2024-01-01"}}],
+		"participant": [{"individual": {"reference": "Practitioner/1"}}],
+		"period": {"start": "2024-01-01T10:00:00Z"},
+		"reasonCode": [{"text": "checkup"}],
+		"reasonReference": [{"reference": "Condition/1"}],
+		"diagnosis": [{"condition": {"reference": "Condition/2"}, "rank": 1}],
+		"hospitalization": {"dietPreference": [{"text": "vegetarian"}], "admitSource": {"text": "gp"}}
+	}`)
+
+	converted, losses, err := R4ToR5(r4Encounter)
+	if err != nil {
+		t.Fatalf("R4ToR5 failed: %v", err)
+	}
+	encounter, ok := converted.(*fhir5.Encounter)
+	if !ok {
+		t.Fatalf("expected *fhir5.Encounter, got %T", converted)
+	}
+	if encounter.Status != fhir5.EncounterStatusInProgress {
+		t.Errorf("expected status in-progress, got %s", encounter.Status)
+	}
+	if len(encounter.Class) != 1 || *encounter.Class[0].Coding[0].Code != "AMB" {
+		t.Errorf("expected class AMB, got %+v", encounter.Class)
+	}
+	if encounter.ActualPeriod == nil || len(encounter.Participant) != 1 || *encounter.Participant[0].Actor.Reference != "Practitioner/1" {
+		t.Errorf("expected period and participant to be renamed, got %+v %+v", encounter.ActualPeriod, encounter.Participant)
+	}
+	if len(encounter.Reason) != 1 || len(encounter.Reason[0].Value) != 2 || *encounter.Reason[0].Value[1].Reference.Reference != "Condition/1" {
+		t.Errorf("expected reasons as CodeableReferences, got %+v", encounter.Reason)
+	}
+	if len(encounter.Diagnosis) != 1 || *encounter.Diagnosis[0].Condition[0].Reference.Reference != "Condition/2" {
+		t.Errorf("expected diagnosis condition as CodeableReference, got %+v", encounter.Diagnosis)
+	}
+	if len(encounter.DietPreference) != 1 || encounter.Admission == nil || encounter.Admission.AdmitSource == nil {
+		t.Errorf("expected hospitalization to become admission, got %+v %+v", encounter.DietPreference, encounter.Admission)
+	}
+
+	got := map[string]string{}
+	for _, loss := range losses {
+		got[loss.Path] = loss.Extension
+	}
+	want := map[string]string{
+		"Encounter.status":            "http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.status",
+		"Encounter.classHistory":      "http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.classHistory",
+		"Encounter.diagnosis[0].rank": "http://hl7.org/fhir/4.0/StructureDefinition/extension-Encounter.diagnosis.rank",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got losses %v, want %v", got, want)
+	}
+
+	back, _, err := R5ToR4(encounter)
+	if err != nil {
+		t.Fatalf("R5ToR4 failed: %v", err)
+	}
+	if !reflect.DeepEqual(jsonValue(t, back), jsonValue(t, r4Encounter)) {
+		t.Errorf("round trip changed the encounter:\n got %v\nwant %v", jsonValue(t, back), jsonValue(t, r4Encounter))
+	}
+}
+
+func TestR4ToR5_MedicationRequest(t *testing.T) {
+	r4Request := unmarshalR4(t, `{
+		"resourceType": "MedicationRequest",
+		"status": "active",
+		"intent": "order",
+		"subject": {"reference": "Patient/1"},
+		"medicationCodeableConcept": {"text": "aspirin"},
+		"reportedReference": {"reference": "Practitioner/1"},
+		"dosageInstruction": [{"asNeededCodeableConcept": {"text": "pain"}}],
+		"dispenseRequest": {"performer": {"reference": "Organization/1"}}
+	}`)
+
+	converted, losses, err := R4ToR5(r4Request)
+	if err != nil {
+		t.Fatalf("R4ToR5 failed: %v", err)
+	}
+	request := converted.(*fhir5.MedicationRequest)
+	if request.Medication.Concept == nil || *request.Medication.Concept.Text != "aspirin" {
+		t.Errorf("expected medication concept, got %+v", request.Medication)
+	}
+	if request.Reported == nil || !*request.Reported || len(request.InformationSource) != 1 {
+		t.Errorf("expected reported source, got %v %+v", request.Reported, request.InformationSource)
+	}
+	if len(request.DosageInstruction) != 1 || len(request.DosageInstruction[0].AsNeededFor) != 1 {
+		t.Errorf("expected asNeededFor, got %+v", request.DosageInstruction)
+	}
+	if request.DispenseRequest == nil || request.DispenseRequest.Dispenser == nil {
+		t.Errorf("expected dispenser, got %+v", request.DispenseRequest)
+	}
+	if len(losses) != 0 {
+		t.Errorf("expected a lossless conversion, got %v", losses)
+	}
+
+	back, _, err := R5ToR4(request)
+	if err != nil {
+		t.Fatalf("R5ToR4 failed: %v", err)
+	}
+	if !reflect.DeepEqual(jsonValue(t, back), jsonValue(t, r4Request)) {
+		t.Errorf("round trip changed the request:\n got %v\nwant %v", jsonValue(t, back), jsonValue(t, r4Request))
+	}
+}
+
+func TestR5ToR4_Examples(t *testing.T) {
+	prefixes := []string{"patient-", "observation-", "encounter-", "condition-", "medicationrequest", "bundle-", "capabilitystatement-"}
+	files, err := filepath.Glob("../fhir5/testdata/fhir5-json/*.json")
+	if err != nil {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+	for _, file := range files {
+		name := filepath.Base(file)
+		if strings.HasSuffix(name, ".profile.json") || !hasAnyPrefix(name, prefixes) {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		resource, err := fhir5.UnmarshalResource(data)
+		if err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", name, err)
+		}
+		r4Resource, losses, err := R5ToR4(resource)
+		if err != nil {
+			t.Errorf("%s: R5ToR4 failed: %v", name, err)
+			continue
+		}
+		back, _, err := R4ToR5(r4Resource)
+		if err != nil {
+			t.Errorf("%s: R4ToR5 failed: %v", name, err)
+			continue
+		}
+		if !dropped(losses) && !reflect.DeepEqual(jsonValue(t, back), jsonValue(t, resource)) {
+			t.Errorf("%s: round trip changed the resource", name)
+		}
+	}
+}
+
+func TestR5ToR4_Losses(t *testing.T) {
+	observation := &fhir5.Observation{
+		ResourceType:  "Observation",
+		Status:        "final",
+		Code:          common.CodeableConcept{Text: fhir5.StringPtr("weight")},
+		BodyStructure: &common.Reference{Reference: fhir5.StringPtr("BodyStructure/1")},
+	}
+	converted, losses, err := R5ToR4(observation)
+	if err != nil {
+		t.Fatalf("R5ToR4 failed: %v", err)
+	}
+	want := []Loss{{Path: "Observation.bodyStructure", Extension: "http://hl7.org/fhir/5.0/StructureDefinition/extension-Observation.bodyStructure"}}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+	extensions := converted.(*fhir4.Observation).Extension
+	if len(extensions) != 1 || extensions[0].URL != want[0].Extension || *extensions[0].ValueReference.Reference != "BodyStructure/1" {
+		t.Errorf("expected the body structure as extension, got %+v", extensions)
+	}
+}
+
+func TestR4ToR5_PrimitiveNulls(t *testing.T) {
+	converted, _, err := R4ToR5(unmarshalR4(t, `{
+		"resourceType": "Patient",
+		"name": [{
+			"given": [null, "x"],
+			"_given": [{"extension": [{"url": "https://example.org/initial", "valueBoolean": true}]}, null]
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("R4ToR5 failed: %v", err)
+	}
+	got := jsonValue(t, converted).(map[string]interface{})["name"]
+	want := []interface{}{map[string]interface{}{
+		"given": []interface{}{nil, "x"},
+		"_given": []interface{}{
+			map[string]interface{}{"extension": []interface{}{
+				map[string]interface{}{"url": "https://example.org/initial", "valueBoolean": true},
+			}},
+			nil,
+		},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got name %v, want %v", got, want)
+	}
+}
+
+func TestR4ToR5_EmptyExtension(t *testing.T) {
+	converted, _, err := R4ToR5(unmarshalR4(t, `{
+		"resourceType": "Encounter",
+		"status": "finished",
+		"class": {"code": "AMB"},
+		"classHistory": [{"id": "1"}]
+	}`))
+	if err != nil {
+		t.Fatalf("R4ToR5 failed: %v", err)
+	}
+	if extensions := converted.(*fhir5.Encounter).Extension; len(extensions) != 0 {
+		t.Errorf("expected no extension for an element without values, got %+v", extensions)
+	}
+}
+
+func TestR4ToR5_WrongVersion(t *testing.T) {
+	if _, _, err := R4ToR5(&fhir5.Patient{}); err == nil {
+		t.Error("expected an error for an R5 resource")
+	}
+}
+
+// dropped reports whether a conversion lost elements it could not preserve
+func dropped(losses []Loss) bool {
+	for _, loss := range losses {
+		if loss.Extension == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
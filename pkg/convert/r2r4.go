@@ -0,0 +1,183 @@
+package convert
+
+import (
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+)
+
+var r2 = &version{
+	name:        "R2",
+	code:        "1.0",
+	newResource: fhir2.NewResource,
+	unmarshal:   fhir2.UnmarshalResource,
+	datatypes: set("Address", "Age", "Annotation", "Attachment", "CodeableConcept", "Coding", "ContactPoint", "Count",
+		"Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "Reference",
+		"SampledData", "Signature", "Timing", "Meta"),
+}
+
+// R2ToR4 converts a DSTU2 resource to R4. Elements without an R4 equivalent are
+// preserved as R2 cross-version extensions, elements R4 requires and R2 lacks
+// are set to defaults, both are reported as losses.
+func R2ToR4(r common.Resource) (common.Resource, []Loss, error) {
+	c := &conversion{from: r2, to: r4, transforms: r2ToR4, resourceTypes: r2ResourceTypes}
+	return c.run(r)
+}
+
+// r2ResourceTypes are the R2 resource types R4 renamed
+var r2ResourceTypes = map[string]string{
+	"MedicationOrder": "MedicationRequest",
+}
+
+var r2ToR4 = map[string]transform{
+	"Reference": func(s *scope) {
+		renamedReference(s, "reference")
+	},
+	"BundleEntry": func(s *scope) {
+		renamedReference(s, "fullUrl")
+	},
+	"Patient": func(s *scope) {
+		s.rename("careProvider", "generalPractitioner")
+	},
+	"PatientLink": func(s *scope) {
+		s.code("type", patientLinkTypeR2ToR4, patientLinkTypeR4ToR2)
+	},
+	"Encounter": func(s *scope) {
+		s.rename("patient", "subject")
+		s.rename("reason", "reasonCode")
+		s.rename("incomingReferral", "basedOn")
+		s.rename("indication", "reasonReference")
+		if class, ok := s.m["class"].(string); ok {
+			code, known := encounterClassR2ToR4[class]
+			if !known || s.m["_class"] != nil {
+				s.keep("class")
+			}
+			s.take("class")
+			if known {
+				s.put("class", object{"system": actCodeSystem, "code": code})
+			}
+		}
+		s.require("class", object{"system": nullFlavorSystem, "code": "UNK"})
+	},
+	"Condition": func(s *scope) {
+		s.rename("patient", "subject")
+		s.rename("onsetQuantity", "onsetAge")
+		s.rename("abatementQuantity", "abatementAge")
+		s.rename("dateRecorded", "recordedDate")
+		annotation(s, "notes", "note")
+		codeableStatus(s, "clinicalStatus", conditionClinicalSystem, conditionClinicalStatus)
+		codeableStatus(s, "verificationStatus", conditionVerificationSystem, conditionVerificationStatus)
+	},
+	"Observation": func(s *scope) {
+		annotation(s, "comments", "note")
+		observationRelatedToR4(s)
+	},
+	"ObservationReferenceRange": func(s *scope) {
+		if meaning := list(s.m["meaning"]); len(meaning) > 0 {
+			if len(meaning) > 1 {
+				s.keep("meaning")
+			}
+			s.take("meaning")
+			s.m["type"] = meaning[0]
+		}
+	},
+	"MedicationOrder": func(s *scope) {
+		s.rename("patient", "subject")
+		s.rename("dateWritten", "authoredOn")
+		s.rename("prescriber", "requester")
+		s.rename("reasonCodeableConcept", "reasonCode")
+		annotation(s, "note", "note")
+		s.require("status", "unknown")
+		s.require("intent", "order")
+	},
+	"Procedure": func(s *scope) {
+		s.rename("request", "basedOn")
+		s.rename("notes", "note")
+		notDone(s, "notPerformed", "not-done", "")
+		if reasons := list(s.m["reasonNotPerformed"]); len(reasons) > 0 {
+			if len(reasons) > 1 {
+				s.keep("reasonNotPerformed")
+			}
+			s.take("reasonNotPerformed")
+			s.m["statusReason"] = reasons[0]
+		}
+	},
+	"DiagnosticReport": func(s *scope) {
+		s.rename("request", "basedOn")
+		s.rename("codedDiagnosis", "conclusionCode")
+	},
+	"MedicationOrderSubstitution": func(s *scope) {
+		s.rename("type", "allowedCodeableConcept")
+	},
+	"DosageInstruction": func(s *scope) {
+		s.rename("additionalInstructions", "additionalInstruction")
+		s.rename("siteCodeableConcept", "site")
+		s.rename("rate", "rateRatio")
+		doseAndRate := object{}
+		for _, key := range dosageDoseAndRate {
+			if v, ok := s.m[key]; ok {
+				doseAndRate[key] = v
+				delete(s.m, key)
+			}
+		}
+		if len(doseAndRate) > 0 {
+			s.m["doseAndRate"] = []interface{}{doseAndRate}
+		}
+	},
+	"TimingRepeat": func(s *scope) {
+		s.rename("boundsQuantity", "boundsDuration")
+		s.rename("durationUnits", "durationUnit")
+		s.rename("periodUnits", "periodUnit")
+	},
+	"Signature": signatureToR4,
+}
+
+const (
+	actCodeSystem    = "http://terminology.hl7.org/CodeSystem/v3-ActCode"
+	nullFlavorSystem = "http://terminology.hl7.org/CodeSystem/v3-NullFlavor"
+)
+
+var patientLinkTypeR2ToR4 = map[string]string{
+	"replace": "replaced-by",
+}
+
+var patientLinkTypeR4ToR2 = map[string]string{
+	"replaced-by": "replace",
+}
+
+// encounterClassR2ToR4 maps the R2 encounter class codes to the v3 ActCode
+// codes of R4
+var encounterClassR2ToR4 = map[string]string{
+	"inpatient":  "IMP",
+	"outpatient": "AMB",
+	"ambulatory": "AMB",
+	"emergency":  "EMER",
+	"home":       "HH",
+	"field":      "FLD",
+	"daytime":    "SS",
+	"virtual":    "VR",
+}
+
+// renamedReference rewrites the resource type of a reference or url to the
+// name R4 gave it, e.g. MedicationOrder/1 to MedicationRequest/1
+func renamedReference(s *scope, key string) {
+	reference, _ := s.m[key].(string)
+	for from, to := range r2ResourceTypes {
+		if rest, ok := strings.CutPrefix(reference, from+"/"); ok {
+			s.m[key] = to + "/" + rest
+		} else if before, rest, ok := strings.Cut(reference, "/"+from+"/"); ok {
+			s.m[key] = before + "/" + to + "/" + rest
+		}
+	}
+}
+
+// annotation replaces a string element by an Annotation list with its text
+func annotation(s *scope, from, to string) {
+	if s.m["_"+from] != nil {
+		s.keep(from)
+	}
+	for _, text := range s.take(from) {
+		s.put(to, []interface{}{object{"text": text}})
+	}
+}
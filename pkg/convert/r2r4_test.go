@@ -0,0 +1,241 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+)
+
+func unmarshalR2(t *testing.T, data string) common.Resource {
+	t.Helper()
+	r, err := fhir2.UnmarshalResource([]byte(data))
+	if err != nil {
+		t.Fatalf("failed to unmarshal R2 resource: %v", err)
+	}
+	return r
+}
+
+func TestR2ToR4_MedicationOrder(t *testing.T) {
+	converted, losses, err := R2ToR4(unmarshalR2(t, `{
+		"resourceType": "MedicationOrder",
+		"dateWritten": "2015-01-15",
+		"patient": {"reference": "Patient/1"},
+		"prescriber": {"reference": "Practitioner/1"},
+		"medicationCodeableConcept": {"text": "aspirin"},
+		"reasonCodeableConcept": {"text": "headache"},
+		"note": "take with food",
+		"dosageInstruction": [{
+			"text": "100mg daily",
+			"siteReference": {"reference": "BodySite/1"},
+			"doseQuantity": {"value": 100, "unit": "mg"},
+			"timing": {"repeat": {"frequency": 1, "period": 1, "periodUnits": "d"}}
+		}],
+		"substitution": {"type": {"text": "generic"}}
+	}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	request, ok := converted.(*fhir4.MedicationRequest)
+	if !ok {
+		t.Fatalf("expected *fhir4.MedicationRequest, got %T", converted)
+	}
+	if request.Status != fhir4.MedicationRequestStatusUnknown || request.Intent != fhir4.MedicationRequestIntentOrder {
+		t.Errorf("expected default status and intent, got %s %s", request.Status, request.Intent)
+	}
+	if *request.Subject.Reference != "Patient/1" || request.Requester == nil || *request.Requester.Reference != "Practitioner/1" {
+		t.Errorf("expected subject and requester, got %+v %+v", request.Subject, request.Requester)
+	}
+	if request.AuthoredOn == nil || len(request.ReasonCode) != 1 || len(request.Note) != 1 || request.Note[0].Text != "take with food" {
+		t.Errorf("expected authoredOn, reasonCode and note, got %v %+v %+v", request.AuthoredOn, request.ReasonCode, request.Note)
+	}
+	if len(request.DosageInstruction) != 1 {
+		t.Fatalf("expected one dosage, got %d", len(request.DosageInstruction))
+	}
+	dosage := request.DosageInstruction[0]
+	if len(dosage.DoseAndRate) != 1 || dosage.DoseAndRate[0].DoseQuantity == nil {
+		t.Errorf("expected doseAndRate, got %+v", dosage.DoseAndRate)
+	}
+	if dosage.Timing == nil || dosage.Timing.Repeat == nil || dosage.Timing.Repeat.PeriodUnit == nil || *dosage.Timing.Repeat.PeriodUnit != "d" {
+		t.Errorf("expected timing periodUnit, got %+v", dosage.Timing)
+	}
+	if request.Substitution == nil || request.Substitution.AllowedCodeableConcept == nil {
+		t.Errorf("expected allowedCodeableConcept, got %+v", request.Substitution)
+	}
+
+	want := []Loss{
+		{Path: "MedicationOrder.status", Default: "unknown"},
+		{Path: "MedicationOrder.intent", Default: "order"},
+		{Path: "MedicationOrder.dosageInstruction[0].siteReference", Extension: "http://hl7.org/fhir/1.0/StructureDefinition/extension-MedicationOrder.dosageInstruction.siteReference"},
+	}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+}
+
+func TestR2ToR4_Encounter(t *testing.T) {
+	converted, losses, err := R2ToR4(unmarshalR2(t, `{
+		"resourceType": "Encounter",
+		"status": "finished",
+		"class": "outpatient",
+		"patient": {"reference": "Patient/1"},
+		"appointment": {"reference": "Appointment/1"},
+		"reason": [{"text": "checkup"}]
+	}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	encounter := converted.(*fhir4.Encounter)
+	if encounter.Class.Code == nil || *encounter.Class.Code != "AMB" || *encounter.Class.System != actCodeSystem {
+		t.Errorf("expected class AMB, got %+v", encounter.Class)
+	}
+	if encounter.Subject == nil || len(encounter.Appointment) != 1 || len(encounter.ReasonCode) != 1 {
+		t.Errorf("expected subject, appointment and reasonCode, got %+v", encounter)
+	}
+	if len(losses) != 0 {
+		t.Errorf("expected no losses, got %v", losses)
+	}
+
+	converted, losses, err = R2ToR4(unmarshalR2(t, `{"resourceType": "Encounter", "status": "planned"}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	if code := converted.(*fhir4.Encounter).Class.Code; code == nil || *code != "UNK" {
+		t.Errorf("expected default class UNK, got %v", code)
+	}
+	want := []Loss{{Path: "Encounter.class", Default: `{"code":"UNK","system":"` + nullFlavorSystem + `"}`}}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+}
+
+func TestR2ToR4_Condition(t *testing.T) {
+	converted, losses, err := R2ToR4(unmarshalR2(t, `{
+		"resourceType": "Condition",
+		"patient": {"reference": "Patient/1"},
+		"code": {"text": "asthma"},
+		"category": {"text": "diagnosis"},
+		"clinicalStatus": "relapse",
+		"verificationStatus": "confirmed",
+		"onsetQuantity": {"value": 12, "unit": "a"},
+		"dateRecorded": "2015-02-01",
+		"notes": "since childhood"
+	}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	condition := converted.(*fhir4.Condition)
+	if condition.Subject.Reference == nil || len(condition.Category) != 1 || condition.OnsetAge == nil {
+		t.Errorf("expected subject, category and onsetAge, got %+v", condition)
+	}
+	if condition.ClinicalStatus == nil || *condition.ClinicalStatus.Coding[0].Code != "relapse" ||
+		condition.VerificationStatus == nil || *condition.VerificationStatus.Coding[0].Code != "confirmed" {
+		t.Errorf("expected status CodeableConcepts, got %+v %+v", condition.ClinicalStatus, condition.VerificationStatus)
+	}
+	if len(condition.Note) != 1 || condition.Note[0].Text != "since childhood" {
+		t.Errorf("expected note, got %+v", condition.Note)
+	}
+	if condition.RecordedDate == nil || condition.RecordedDate.String() != "2015-02-01" {
+		t.Errorf("expected recordedDate, got %v", condition.RecordedDate)
+	}
+	if len(losses) != 0 {
+		t.Errorf("expected no losses, got %v", losses)
+	}
+}
+
+func TestR2ToR4_Bundle(t *testing.T) {
+	converted, losses, err := R2ToR4(unmarshalR2(t, `{
+		"resourceType": "Bundle",
+		"type": "collection",
+		"entry": [
+			{"resource": {"resourceType": "Patient", "careProvider": [{"reference": "Practitioner/1"}],
+				"link": [{"other": {"reference": "Patient/2"}, "type": "replace"}]}},
+			{"resource": {"resourceType": "Practitioner", "name": {"family": ["Smith"]},
+				"practitionerRole": [{"role": {"text": "doctor"}}]}},
+			{"resource": {"resourceType": "Observation", "status": "final", "code": {"text": "dose"},
+				"comments": "after breakfast", "related": [{"type": "derived-from", "target": {"reference": "MedicationOrder/1"}}]}}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	bundle := converted.(*fhir4.Bundle)
+	if len(bundle.Entry) != 3 {
+		t.Fatalf("expected three entries, got %d", len(bundle.Entry))
+	}
+	patient := bundle.Entry[0].Resource.(*fhir4.Patient)
+	if len(patient.GeneralPractitioner) != 1 || len(patient.Link) != 1 || patient.Link[0].Type != "replaced-by" {
+		t.Errorf("expected generalPractitioner and replaced-by link, got %+v %+v", patient.GeneralPractitioner, patient.Link)
+	}
+	practitioner := bundle.Entry[1].Resource.(*fhir4.Practitioner)
+	if len(practitioner.Name) != 1 || len(practitioner.Extension) != 1 {
+		t.Errorf("expected name list and preserved role, got %+v %+v", practitioner.Name, practitioner.Extension)
+	}
+	observation := bundle.Entry[2].Resource.(*fhir4.Observation)
+	if len(observation.DerivedFrom) != 1 || *observation.DerivedFrom[0].Reference != "MedicationRequest/1" || len(observation.Note) != 1 {
+		t.Errorf("expected derivedFrom MedicationRequest and note, got %+v %+v", observation.DerivedFrom, observation.Note)
+	}
+	want := []Loss{{Path: "Practitioner.practitionerRole", Extension: "http://hl7.org/fhir/1.0/StructureDefinition/extension-Practitioner.practitionerRole"}}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+}
+
+func TestR2ToR4_Procedure(t *testing.T) {
+	converted, losses, err := R2ToR4(unmarshalR2(t, `{
+		"resourceType": "Procedure",
+		"status": "aborted",
+		"subject": {"reference": "Patient/1"},
+		"code": {"text": "appendectomy"},
+		"notPerformed": true,
+		"reasonNotPerformed": [{"text": "patient refused"}],
+		"request": {"reference": "ProcedureRequest/1"},
+		"focalDevice": [{"manipulated": {"reference": "Device/1"}}]
+	}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	procedure := converted.(*fhir4.Procedure)
+	if procedure.Status != fhir4.ProcedureStatusNotDone || procedure.StatusReason == nil {
+		t.Errorf("expected status not-done with a reason, got %s %+v", procedure.Status, procedure.StatusReason)
+	}
+	if len(procedure.BasedOn) != 1 || len(procedure.FocalDevice) != 1 {
+		t.Errorf("expected basedOn and focalDevice, got %+v %+v", procedure.BasedOn, procedure.FocalDevice)
+	}
+	want := []Loss{{Path: "Procedure.status", Extension: "http://hl7.org/fhir/1.0/StructureDefinition/extension-Procedure.status"}}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+}
+
+func TestR2ToR4_BundleFullURL(t *testing.T) {
+	converted, losses, err := R2ToR4(unmarshalR2(t, `{
+		"resourceType": "Bundle",
+		"type": "collection",
+		"entry": [
+			{"fullUrl": "http://example.org/fhir/MedicationOrder/1", "resource": {"resourceType": "MedicationOrder", "id": "1",
+				"status": "stopped", "patient": {"reference": "Patient/1"}, "medicationCodeableConcept": {"text": "aspirin"},
+				"dateEnded": "2015-02-01", "reasonEnded": {"text": "side effects"}}}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("R2ToR4 failed: %v", err)
+	}
+	entry := converted.(*fhir4.Bundle).Entry[0]
+	if entry.FullURL == nil || *entry.FullURL != "http://example.org/fhir/MedicationRequest/1" {
+		t.Errorf("expected the MedicationRequest fullUrl, got %v", entry.FullURL)
+	}
+	if _, ok := entry.Resource.(*fhir4.MedicationRequest); !ok {
+		t.Errorf("expected *fhir4.MedicationRequest, got %T", entry.Resource)
+	}
+	want := []Loss{
+		{Path: "MedicationOrder.intent", Default: "order"},
+		{Path: "MedicationOrder.dateEnded", Extension: "http://hl7.org/fhir/1.0/StructureDefinition/extension-MedicationOrder.dateEnded"},
+		{Path: "MedicationOrder.reasonEnded", Extension: "http://hl7.org/fhir/1.0/StructureDefinition/extension-MedicationOrder.reasonEnded"},
+	}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+}
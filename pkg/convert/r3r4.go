@@ -0,0 +1,366 @@
+package convert
+
+import (
+	"slices"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+)
+
+var r3 = &version{
+	name:        "R3",
+	code:        "3.0",
+	newResource: fhir3.NewResource,
+	unmarshal:   fhir3.UnmarshalResource,
+	datatypes: set("Address", "Age", "Annotation", "Attachment", "CodeableConcept", "Coding", "ContactPoint", "Count",
+		"Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "Reference",
+		"SampledData", "Signature", "Timing", "ContactDetail", "Contributor", "DataRequirement", "ParameterDefinition",
+		"RelatedArtifact", "TriggerDefinition", "UsageContext", "Dosage", "Meta"),
+}
+
+// R3ToR4 converts an R3 resource to R4. Elements without an R4 equivalent are
+// preserved as R3 cross-version extensions and reported as losses.
+func R3ToR4(r common.Resource) (common.Resource, []Loss, error) {
+	c := &conversion{from: r3, to: r4, transforms: r3ToR4}
+	return c.run(r)
+}
+
+// R4ToR3 converts an R4 resource to R3. Elements without an R3 equivalent are
+// preserved as R4 cross-version extensions and reported as losses.
+func R4ToR3(r common.Resource) (common.Resource, []Loss, error) {
+	c := &conversion{from: r4, to: r3, transforms: r4ToR3}
+	return c.run(r)
+}
+
+var r3ToR4 = map[string]transform{
+	"Encounter": func(s *scope) {
+		s.rename("reason", "reasonCode")
+		s.rename("incomingReferral", "basedOn")
+	},
+	"EncounterDiagnosis": func(s *scope) {
+		s.rename("role", "use")
+	},
+	"Condition": func(s *scope) {
+		s.rename("context", "encounter")
+		codeableStatus(s, "clinicalStatus", conditionClinicalSystem, conditionClinicalStatus)
+		codeableStatus(s, "verificationStatus", conditionVerificationSystem, conditionVerificationStatus)
+	},
+	"Procedure": func(s *scope) {
+		s.rename("context", "encounter")
+		notDone(s, "notDone", "not-done", "aborted")
+		s.code("status", procedureStatusR3ToR4, procedureStatusR4ToR3)
+		s.rename("notDoneReason", "statusReason")
+	},
+	"ProcedurePerformer": func(s *scope) {
+		s.rename("role", "function")
+	},
+	"Observation": func(s *scope) {
+		s.rename("context", "encounter")
+		observationRelatedToR4(s)
+		if s.m["_comment"] != nil {
+			s.keep("comment")
+		}
+		for _, text := range s.take("comment") {
+			s.put("note", append(list(s.m["note"]), object{"text": text}))
+		}
+	},
+	"MedicationRequest": func(s *scope) {
+		s.rename("context", "encounter")
+		if requester, ok := s.m["requester"].(object); ok {
+			if len(requester) > 1 {
+				s.keep("requester")
+			}
+			s.put("requester", requester["agent"])
+		}
+	},
+	"MedicationRequestSubstitution": func(s *scope) {
+		s.rename("allowed", "allowedBoolean")
+	},
+	"Dosage": func(s *scope) {
+		doseAndRate := object{}
+		for _, key := range dosageDoseAndRate {
+			if v, ok := s.m[key]; ok {
+				doseAndRate[key] = v
+				delete(s.m, key)
+			}
+		}
+		if len(doseAndRate) > 0 {
+			s.m["doseAndRate"] = []interface{}{doseAndRate}
+		}
+	},
+	"DiagnosticReport": func(s *scope) {
+		s.rename("context", "encounter")
+		s.rename("image", "media")
+		s.rename("codedDiagnosis", "conclusionCode")
+		var performers []interface{}
+		lossy := false
+		for _, p := range list(s.m["performer"]) {
+			p, _ := p.(object)
+			lossy = lossy || len(p) != 1 || p["actor"] == nil
+			if actor, ok := p["actor"]; ok {
+				performers = append(performers, actor)
+			}
+		}
+		if lossy {
+			s.keep("performer")
+		}
+		delete(s.m, "performer")
+		if len(performers) > 0 {
+			s.put("performer", performers)
+		}
+	},
+	"Signature": signatureToR4,
+}
+
+var r4ToR3 = map[string]transform{
+	"Encounter": func(s *scope) {
+		s.rename("reasonCode", "reason")
+		s.rename("basedOn", "incomingReferral")
+	},
+	"EncounterDiagnosis": func(s *scope) {
+		s.rename("use", "role")
+	},
+	"Condition": func(s *scope) {
+		s.rename("encounter", "context")
+		statusCode(s, "clinicalStatus", conditionClinicalSystem, conditionClinicalStatus)
+		statusCode(s, "verificationStatus", conditionVerificationSystem, conditionVerificationStatus)
+	},
+	"Procedure": func(s *scope) {
+		s.rename("encounter", "context")
+		if s.m["status"] == "not-done" {
+			s.m["notDone"] = true
+			s.m["status"] = "aborted"
+		}
+		s.code("status", procedureStatusR4ToR3, procedureStatusR3ToR4)
+		s.rename("statusReason", "notDoneReason")
+	},
+	"ProcedurePerformer": func(s *scope) {
+		s.rename("function", "role")
+	},
+	"Observation": func(s *scope) {
+		s.rename("encounter", "context")
+		// R3 has a single comment, further notes stay in the note the R3 struct models
+		if notes := list(s.m["note"]); len(notes) == 1 {
+			if note, _ := notes[0].(object); len(note) == 1 && note["text"] != nil {
+				s.take("note")
+				s.m["comment"] = note["text"]
+			}
+		}
+		var related []interface{}
+		for _, t := range []string{"has-member", "derived-from"} {
+			for _, target := range s.take(observationRelated[t]) {
+				related = append(related, object{"type": t, "target": target})
+			}
+		}
+		if len(related) > 0 {
+			s.m["related"] = related
+		}
+	},
+	"MedicationRequest": func(s *scope) {
+		s.rename("encounter", "context")
+		s.code("intent", medicationRequestIntentR4ToR3, nil)
+		for _, requester := range s.take("requester") {
+			s.put("requester", object{"agent": requester})
+		}
+	},
+	"MedicationRequestSubstitution": func(s *scope) {
+		s.rename("allowedBoolean", "allowed")
+	},
+	"Dosage": func(s *scope) {
+		doseAndRate := list(s.m["doseAndRate"])
+		if len(doseAndRate) == 0 {
+			return
+		}
+		first, _ := doseAndRate[0].(object)
+		lossy := len(doseAndRate) > 1
+		for key, v := range first {
+			if slices.Contains(dosageDoseAndRate, key) {
+				s.m[key] = v
+			} else {
+				lossy = true
+			}
+		}
+		if lossy {
+			s.keep("doseAndRate")
+		}
+		delete(s.m, "doseAndRate")
+	},
+	"DiagnosticReport": func(s *scope) {
+		s.rename("encounter", "context")
+		s.rename("media", "image")
+		s.rename("conclusionCode", "codedDiagnosis")
+		var performers []interface{}
+		for _, actor := range s.take("performer") {
+			performers = append(performers, object{"actor": actor})
+		}
+		if len(performers) > 0 {
+			s.put("performer", performers)
+		}
+	},
+	"Signature": func(s *scope) {
+		who, _ := s.m["who"].(object)
+		identifier, _ := who["identifier"].(object)
+		if len(who) == 1 && len(identifier) == 2 && identifier["system"] == uriSystem {
+			delete(s.m, "who")
+			s.m["whoUri"] = identifier["value"]
+		}
+		s.rename("who", "whoReference")
+		s.rename("sigFormat", "contentType")
+		s.rename("data", "blob")
+	},
+}
+
+const (
+	conditionClinicalSystem     = "http://terminology.hl7.org/CodeSystem/condition-clinical"
+	conditionVerificationSystem = "http://terminology.hl7.org/CodeSystem/condition-ver-status"
+
+	// uriSystem identifies an identifier that is a URI, such as the R3
+	// Signature.whoUri
+	uriSystem = "urn:ietf:rfc:3986"
+)
+
+// conditionClinicalStatus maps the R4 condition clinical status codes to R3
+var conditionClinicalStatus = map[string]string{
+	"active":     "active",
+	"recurrence": "recurrence",
+	"relapse":    "relapse",
+	"inactive":   "inactive",
+	"remission":  "remission",
+	"resolved":   "resolved",
+}
+
+// conditionVerificationStatus maps the R4 condition verification status codes to
+// R3, which has no unconfirmed parent of provisional and differential
+var conditionVerificationStatus = map[string]string{
+	"unconfirmed":      "provisional",
+	"provisional":      "provisional",
+	"differential":     "differential",
+	"confirmed":        "confirmed",
+	"refuted":          "refuted",
+	"entered-in-error": "entered-in-error",
+}
+
+var procedureStatusR3ToR4 = map[string]string{
+	"suspended": "on-hold",
+	"aborted":   "stopped",
+}
+
+// procedureStatusR4ToR3 maps the R4 procedure status codes to R3, not-done
+// becomes the R3 notDone flag
+var procedureStatusR4ToR3 = map[string]string{
+	"on-hold": "suspended",
+	"stopped": "aborted",
+}
+
+var medicationRequestIntentR4ToR3 = map[string]string{
+	"original-order": "order",
+	"reflex-order":   "order",
+	"filler-order":   "order",
+	"option":         "proposal",
+}
+
+// observationRelated maps the R3 Observation.related types to the R4 elements
+// replacing them
+var observationRelated = map[string]string{
+	"has-member":   "hasMember",
+	"derived-from": "derivedFrom",
+}
+
+// dosageDoseAndRate are the elements of R3 Dosage R4 moved to Dosage.doseAndRate
+var dosageDoseAndRate = []string{"doseQuantity", "doseRange", "rateQuantity", "rateRange", "rateRatio"}
+
+// codeableStatus replaces a status code by a CodeableConcept of the code
+// system. codes maps the codes of the target version, others are dropped.
+func codeableStatus(s *scope, key, system string, codes map[string]string) {
+	code, ok := s.m[key].(string)
+	if !ok {
+		return
+	}
+	if _, known := codes[code]; !known || s.m["_"+key] != nil {
+		s.keep(key)
+	}
+	s.take(key)
+	if _, known := codes[code]; known {
+		s.put(key, object{"coding": []interface{}{object{"system": system, "code": code}}})
+	}
+}
+
+// statusCode replaces a CodeableConcept by its code of the code system, which
+// codes translates to the target version
+func statusCode(s *scope, key, system string, codes map[string]string) {
+	concept, ok := s.m[key].(object)
+	if !ok {
+		return
+	}
+	codings := list(concept["coding"])
+	lossy := len(concept) > 1 || len(codings) != 1
+	var code string
+	for _, c := range codings {
+		if coding, _ := c.(object); coding["system"] == system && code == "" {
+			original, _ := coding["code"].(string)
+			code = codes[original]
+			lossy = lossy || code != original || len(coding) > 2
+		}
+	}
+	if lossy || code == "" {
+		s.keep(key)
+	}
+	delete(s.m, key)
+	if code != "" {
+		s.m[key] = code
+	}
+}
+
+// notDone replaces the R3 flag of an activity that did not happen by the status
+// code of R4. The R3 status is preserved unless it is the one the flag maps back to.
+func notDone(s *scope, key, code, reverse string) {
+	if s.m["_"+key] != nil {
+		s.keep(key)
+	}
+	for _, flag := range s.take(key) {
+		if flag != true {
+			continue
+		}
+		if status, _ := s.m["status"].(string); status != reverse {
+			s.keep("status")
+		}
+		s.m["status"] = code
+	}
+}
+
+// observationRelatedToR4 replaces the R2 and R3 Observation.related by the R4
+// hasMember and derivedFrom, other relations are preserved
+func observationRelatedToR4(s *scope) {
+	lossy := false
+	for _, r := range list(s.m["related"]) {
+		r, _ := r.(object)
+		t, _ := r["type"].(string)
+		key, ok := observationRelated[t]
+		for k := range r {
+			lossy = lossy || k != "type" && k != "target"
+		}
+		if !ok {
+			lossy = true
+			continue
+		}
+		s.m[key] = append(list(s.m[key]), r["target"])
+	}
+	if lossy {
+		s.keep("related")
+	}
+	delete(s.m, "related")
+	delete(s.m, "_related")
+}
+
+// signatureToR4 converts an R2 or R3 Signature to R4, a whoUri becomes an
+// identifier of who
+func signatureToR4(s *scope) {
+	s.rename("whoReference", "who")
+	for _, uri := range s.take("whoUri") {
+		if s.m["who"] == nil {
+			s.m["who"] = object{"identifier": object{"system": uriSystem, "value": uri}}
+		}
+	}
+	s.rename("contentType", "sigFormat")
+	s.rename("blob", "data")
+}
@@ -0,0 +1,230 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+)
+
+func unmarshalR3(t *testing.T, data string) common.Resource {
+	t.Helper()
+	r, err := fhir3.UnmarshalResource([]byte(data))
+	if err != nil {
+		t.Fatalf("failed to unmarshal R3 resource: %v", err)
+	}
+	return r
+}
+
+// roundTripR3 converts an R3 resource to R4 and back and checks the result
+// equals the original
+func roundTripR3(t *testing.T, r3Resource common.Resource) common.Resource {
+	t.Helper()
+	converted, _, err := R3ToR4(r3Resource)
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	back, _, err := R4ToR3(converted)
+	if err != nil {
+		t.Fatalf("R4ToR3 failed: %v", err)
+	}
+	if !reflect.DeepEqual(jsonValue(t, back), jsonValue(t, r3Resource)) {
+		t.Errorf("round trip changed the %s:\n got %v\nwant %v", r3Resource.GetResourceType(), jsonValue(t, back), jsonValue(t, r3Resource))
+	}
+	return converted
+}
+
+func TestR3ToR4_MedicationRequest(t *testing.T) {
+	r3Request := unmarshalR3(t, `{
+		"resourceType": "MedicationRequest",
+		"status": "active",
+		"intent": "order",
+		"subject": {"reference": "Patient/1"},
+		"context": {"reference": "Encounter/1"},
+		"category": {"text": "outpatient"},
+		"medicationCodeableConcept": {"text": "aspirin"},
+		"requester": {"agent": {"reference": "Practitioner/1"}, "onBehalfOf": {"reference": "Organization/1"}},
+		"dosageInstruction": [{"text": "daily", "doseQuantity": {"value": 100, "unit": "mg"}}],
+		"substitution": {"allowed": false}
+	}`)
+
+	converted, losses, err := R3ToR4(r3Request)
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	request, ok := converted.(*fhir4.MedicationRequest)
+	if !ok {
+		t.Fatalf("expected *fhir4.MedicationRequest, got %T", converted)
+	}
+	if request.Requester == nil || *request.Requester.Reference != "Practitioner/1" {
+		t.Errorf("expected the requester agent, got %+v", request.Requester)
+	}
+	if request.Encounter == nil || len(request.Category) != 1 {
+		t.Errorf("expected encounter and category, got %+v %+v", request.Encounter, request.Category)
+	}
+	if len(request.DosageInstruction) != 1 || len(request.DosageInstruction[0].DoseAndRate) != 1 ||
+		request.DosageInstruction[0].DoseAndRate[0].DoseQuantity == nil {
+		t.Errorf("expected doseAndRate, got %+v", request.DosageInstruction)
+	}
+	if request.Substitution == nil || request.Substitution.AllowedBoolean == nil || *request.Substitution.AllowedBoolean {
+		t.Errorf("expected allowedBoolean false, got %+v", request.Substitution)
+	}
+	want := []Loss{{Path: "MedicationRequest.requester", Extension: "http://hl7.org/fhir/3.0/StructureDefinition/extension-MedicationRequest.requester"}}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+
+	roundTripR3(t, r3Request)
+}
+
+func TestR3ToR4_Condition(t *testing.T) {
+	r3Condition := unmarshalR3(t, `{
+		"resourceType": "Condition",
+		"clinicalStatus": "active",
+		"verificationStatus": "unknown",
+		"code": {"text": "asthma"},
+		"subject": {"reference": "Patient/1"},
+		"context": {"reference": "Encounter/1"}
+	}`)
+
+	converted, losses, err := R3ToR4(r3Condition)
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	condition := converted.(*fhir4.Condition)
+	if condition.ClinicalStatus == nil || len(condition.ClinicalStatus.Coding) != 1 ||
+		*condition.ClinicalStatus.Coding[0].System != conditionClinicalSystem || *condition.ClinicalStatus.Coding[0].Code != "active" {
+		t.Errorf("expected clinical status CodeableConcept, got %+v", condition.ClinicalStatus)
+	}
+	if condition.VerificationStatus != nil {
+		t.Errorf("expected no verification status, got %+v", condition.VerificationStatus)
+	}
+	if condition.Encounter == nil || *condition.Encounter.Reference != "Encounter/1" {
+		t.Errorf("expected encounter, got %+v", condition.Encounter)
+	}
+	want := []Loss{{Path: "Condition.verificationStatus", Extension: "http://hl7.org/fhir/3.0/StructureDefinition/extension-Condition.verificationStatus"}}
+	if !reflect.DeepEqual(losses, want) {
+		t.Errorf("got losses %v, want %v", losses, want)
+	}
+
+	roundTripR3(t, r3Condition)
+}
+
+func TestR3ToR4_NativeElements(t *testing.T) {
+	r3Procedure := unmarshalR3(t, `{
+		"resourceType": "Procedure",
+		"status": "aborted",
+		"notDone": true,
+		"notDoneReason": {"text": "patient refused"},
+		"subject": {"reference": "Patient/1"}
+	}`)
+	converted, losses, err := R3ToR4(r3Procedure)
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	procedure := converted.(*fhir4.Procedure)
+	if procedure.Status != fhir4.ProcedureStatusNotDone {
+		t.Errorf("expected status not-done, got %s", procedure.Status)
+	}
+	if procedure.StatusReason == nil || *procedure.StatusReason.Text != "patient refused" {
+		t.Errorf("expected the status reason, got %+v", procedure.StatusReason)
+	}
+	if len(losses) != 0 {
+		t.Errorf("expected no losses, got %v", losses)
+	}
+	roundTripR3(t, r3Procedure)
+
+	r3Observation := unmarshalR3(t, `{
+		"resourceType": "Observation",
+		"status": "final",
+		"code": {"text": "weight"},
+		"comment": "after lunch"
+	}`)
+	converted, losses, err = R3ToR4(r3Observation)
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	observation := converted.(*fhir4.Observation)
+	if len(observation.Note) != 1 || observation.Note[0].Text != "after lunch" {
+		t.Errorf("expected the comment as note, got %+v", observation.Note)
+	}
+	if len(losses) != 0 {
+		t.Errorf("expected no losses, got %v", losses)
+	}
+	roundTripR3(t, r3Observation)
+
+	r3Report := unmarshalR3(t, `{
+		"resourceType": "DiagnosticReport",
+		"status": "final",
+		"code": {"text": "CBC"},
+		"conclusion": "anemia",
+		"codedDiagnosis": [{"text": "anemia"}]
+	}`)
+	converted, losses, err = R3ToR4(r3Report)
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	report := converted.(*fhir4.DiagnosticReport)
+	if report.Conclusion == nil || *report.Conclusion != "anemia" || len(report.ConclusionCode) != 1 {
+		t.Errorf("expected conclusion and conclusionCode, got %v %+v", report.Conclusion, report.ConclusionCode)
+	}
+	if len(losses) != 0 {
+		t.Errorf("expected no losses, got %v", losses)
+	}
+	roundTripR3(t, r3Report)
+}
+
+func TestR3ToR4_RoundTrip(t *testing.T) {
+	resources := []string{
+		`{"resourceType": "Encounter", "status": "finished", "class": {"code": "AMB"},
+			"reason": [{"text": "checkup"}], "incomingReferral": [{"reference": "ReferralRequest/1"}],
+			"diagnosis": [{"condition": {"reference": "Condition/1"}, "role": {"text": "AD"}}]}`,
+		`{"resourceType": "Procedure", "status": "suspended", "subject": {"reference": "Patient/1"},
+			"context": {"reference": "Encounter/1"},
+			"performer": [{"actor": {"reference": "Practitioner/1"}, "role": {"text": "surgeon"}}]}`,
+		`{"resourceType": "Observation", "status": "final", "code": {"text": "panel"},
+			"related": [{"type": "has-member", "target": {"reference": "Observation/1"}},
+				{"type": "sequel-to", "target": {"reference": "Observation/2"}}]}`,
+		`{"resourceType": "DiagnosticReport", "status": "final", "code": {"text": "CBC"},
+			"performer": [{"actor": {"reference": "Organization/1"}, "role": {"text": "lab"}}],
+			"image": [{"comment": "chest", "link": {"reference": "Media/1"}}]}`,
+		`{"resourceType": "Bundle", "type": "document",
+			"signature": {"type": [{"code": "1.2.840.10065.1.12.1.1"}], "when": "2024-01-01T10:00:00Z",
+				"whoUri": "https://example.org/signer", "contentType": "application/jose", "blob": "c2lnbmF0dXJl"}}`,
+	}
+	for _, data := range resources {
+		roundTripR3(t, unmarshalR3(t, data))
+	}
+}
+
+func TestR3ToR4_Bundle(t *testing.T) {
+	converted, _, err := R3ToR4(unmarshalR3(t, `{
+		"resourceType": "Bundle",
+		"type": "collection",
+		"entry": [{"resource": {"resourceType": "Patient", "gender": "female"}}],
+		"signature": {"type": [{"code": "1.2.840.10065.1.12.1.1"}], "when": "2024-01-01T10:00:00Z",
+			"whoReference": {"reference": "Practitioner/1"}, "contentType": "application/jose", "blob": "c2lnbmF0dXJl"}
+	}`))
+	if err != nil {
+		t.Fatalf("R3ToR4 failed: %v", err)
+	}
+	bundle := converted.(*fhir4.Bundle)
+	if len(bundle.Entry) != 1 {
+		t.Fatalf("expected one entry, got %d", len(bundle.Entry))
+	}
+	if _, ok := bundle.Entry[0].Resource.(*fhir4.Patient); !ok {
+		t.Errorf("expected *fhir4.Patient entry, got %T", bundle.Entry[0].Resource)
+	}
+	signature := bundle.Signature
+	if signature == nil || *signature.Who.Reference != "Practitioner/1" || *signature.SigFormat != "application/jose" || *signature.Data != "c2lnbmF0dXJl" {
+		t.Errorf("expected converted signature, got %+v", signature)
+	}
+}
+
+func TestR4ToR3_WrongVersion(t *testing.T) {
+	if _, _, err := R4ToR3(unmarshalR3(t, `{"resourceType": "Patient"}`)); err == nil {
+		t.Error("expected an error converting an R3 resource with R4ToR3")
+	}
+}
@@ -0,0 +1,296 @@
+package convert
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+var r4 = &version{
+	name:        "R4",
+	code:        "4.0",
+	newResource: fhir4.NewResource,
+	unmarshal:   fhir4.UnmarshalResource,
+	datatypes: set("Address", "Age", "Annotation", "Attachment", "CodeableConcept", "Coding", "ContactPoint", "Count",
+		"Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity", "Range", "Ratio", "Reference",
+		"SampledData", "Signature", "Timing", "ContactDetail", "Contributor", "DataRequirement", "Expression",
+		"ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext", "Dosage", "Meta"),
+}
+
+var r5 = &version{
+	name:        "R5",
+	code:        "5.0",
+	newResource: fhir5.NewResource,
+	unmarshal:   fhir5.UnmarshalResource,
+	datatypes: set("Address", "Age", "Annotation", "Attachment", "CodeableConcept", "CodeableReference", "Coding",
+		"ContactPoint", "Count", "Distance", "Duration", "HumanName", "Identifier", "Money", "Period", "Quantity",
+		"Range", "Ratio", "RatioRange", "Reference", "SampledData", "Signature", "Timing", "ContactDetail",
+		"DataRequirement", "Expression", "ParameterDefinition", "RelatedArtifact", "TriggerDefinition", "UsageContext",
+		"Availability", "ExtendedContactDetail", "VirtualServiceDetail", "Dosage", "Meta"),
+}
+
+// R4ToR5 converts an R4 resource to R5. Elements without an R5 equivalent are
+// preserved as R4 cross-version extensions and reported as losses.
+func R4ToR5(r common.Resource) (common.Resource, []Loss, error) {
+	c := &conversion{from: r4, to: r5, transforms: r4ToR5}
+	return c.run(r)
+}
+
+// R5ToR4 converts an R5 resource to R4. Elements without an R4 equivalent are
+// preserved as R5 cross-version extensions and reported as losses.
+func R5ToR4(r common.Resource) (common.Resource, []Loss, error) {
+	c := &conversion{from: r5, to: r4, transforms: r5ToR4}
+	return c.run(r)
+}
+
+var r4ToR5 = map[string]transform{
+	"Encounter": func(s *scope) {
+		s.code("status", encounterStatusR4ToR5, encounterStatusR5ToR4)
+		s.rename("period", "actualPeriod")
+		if reasons := codeableReferences(s.take("reasonCode"), s.take("reasonReference")); len(reasons) > 0 {
+			s.m["reason"] = []interface{}{object{"value": reasons}}
+		}
+		if hospitalization, ok := s.m["hospitalization"].(object); ok {
+			for _, key := range encounterAdmissionMoved {
+				if v, ok := hospitalization[key]; ok {
+					s.m[key] = v
+					delete(hospitalization, key)
+				}
+			}
+		}
+		s.rename("hospitalization", "admission")
+	},
+	"EncounterParticipant": func(s *scope) {
+		s.rename("individual", "actor")
+	},
+	"EncounterLocation": func(s *scope) {
+		s.rename("physicalType", "form")
+	},
+	"Condition": func(s *scope) {
+		var evidence []interface{}
+		lossy := false
+		for _, e := range list(s.m["evidence"]) {
+			e, _ := e.(object)
+			codes, details := list(e["code"]), list(e["detail"])
+			if len(codes) == 1 && len(details) == 1 {
+				evidence = append(evidence, object{"concept": codes[0], "reference": details[0]})
+			} else {
+				evidence = append(evidence, codeableReferences(codes, details)...)
+			}
+			lossy = lossy || e["id"] != nil || e["extension"] != nil || e["modifierExtension"] != nil
+		}
+		if lossy {
+			s.keep("evidence")
+		}
+		delete(s.m, "evidence")
+		if len(evidence) > 0 {
+			s.m["evidence"] = evidence
+		}
+		for _, recorder := range s.take("recorder") {
+			s.m["participant"] = []interface{}{object{"function": participantFunction("author", "Author"), "actor": recorder}}
+		}
+	},
+	"MedicationRequest": func(s *scope) {
+		if medication := codeableReferences(s.take("medicationCodeableConcept"), s.take("medicationReference")); len(medication) > 0 {
+			s.m["medication"] = medication[0]
+		}
+		if reasons := codeableReferences(s.take("reasonCode"), s.take("reasonReference")); len(reasons) > 0 {
+			s.m["reason"] = reasons
+		}
+		s.rename("reportedBoolean", "reported")
+		if source := s.take("reportedReference"); len(source) > 0 {
+			s.m["reported"] = true
+			s.m["informationSource"] = source
+		}
+	},
+	"MedicationRequestDispenseRequest": func(s *scope) {
+		s.rename("performer", "dispenser")
+	},
+	"Dosage": func(s *scope) {
+		s.rename("asNeededBoolean", "asNeeded")
+		if reasons := s.take("asNeededCodeableConcept"); len(reasons) > 0 {
+			s.m["asNeededFor"] = reasons
+		}
+	},
+	"SampledData": func(s *scope) {
+		period, ok := s.m["period"].(json.Number)
+		if _, err := strconv.ParseInt(period.String(), 10, 64); ok && err == nil {
+			s.rename("period", "interval")
+			s.m["intervalUnit"] = "ms"
+		}
+	},
+}
+
+var r5ToR4 = map[string]transform{
+	"Encounter": func(s *scope) {
+		s.code("status", encounterStatusR5ToR4, encounterStatusR4ToR5)
+		s.rename("actualPeriod", "period")
+		var values []interface{}
+		lossy := false
+		for _, reason := range list(s.m["reason"]) {
+			reason, _ := reason.(object)
+			lossy = lossy || len(reason) != 1 || reason["value"] == nil
+			values = append(values, list(reason["value"])...)
+		}
+		if lossy {
+			s.keep("reason")
+		}
+		delete(s.m, "reason")
+		splitCodeableReferences(s.m, values, "reasonCode", "reasonReference")
+		hospitalization, _ := s.m["admission"].(object)
+		for _, key := range encounterAdmissionMoved {
+			if v, ok := s.m[key]; ok {
+				if hospitalization == nil {
+					hospitalization = object{}
+				}
+				hospitalization[key] = v
+				delete(s.m, key)
+			}
+		}
+		delete(s.m, "admission")
+		if hospitalization != nil {
+			s.m["hospitalization"] = hospitalization
+		}
+	},
+	"EncounterParticipant": func(s *scope) {
+		s.rename("actor", "individual")
+	},
+	"EncounterLocation": func(s *scope) {
+		s.rename("form", "physicalType")
+	},
+	"Condition": func(s *scope) {
+		var evidence []interface{}
+		for _, e := range s.take("evidence") {
+			e, _ := e.(object)
+			converted := object{}
+			if concept, ok := e["concept"]; ok {
+				converted["code"] = []interface{}{concept}
+			}
+			if reference, ok := e["reference"]; ok {
+				converted["detail"] = []interface{}{reference}
+			}
+			evidence = append(evidence, converted)
+		}
+		if len(evidence) > 0 {
+			s.m["evidence"] = evidence
+		}
+		participants := list(s.m["participant"])
+		for _, p := range participants {
+			p, _ := p.(object)
+			if isParticipantFunction(p["function"], "author") && s.m["recorder"] == nil {
+				s.m["recorder"] = p["actor"]
+			}
+		}
+		if len(participants) > 1 || len(participants) == 1 && s.m["recorder"] == nil {
+			s.keep("participant")
+		}
+		delete(s.m, "participant")
+	},
+	"MedicationRequest": func(s *scope) {
+		s.code("status", medicationRequestStatusR5ToR4, nil)
+		splitCodeableReferences(s.m, s.take("medication"), "medicationCodeableConcept", "medicationReference")
+		splitCodeableReferences(s.m, s.take("reason"), "reasonCode", "reasonReference")
+		s.rename("reported", "reportedBoolean")
+		if source := list(s.m["informationSource"]); len(source) > 0 {
+			if len(source) > 1 {
+				s.keep("informationSource")
+			}
+			delete(s.m, "reportedBoolean")
+			s.m["reportedReference"] = source[0]
+		}
+		delete(s.m, "informationSource")
+	},
+	"MedicationRequestDispenseRequest": func(s *scope) {
+		s.rename("dispenser", "performer")
+	},
+	"Dosage": func(s *scope) {
+		s.rename("asNeeded", "asNeededBoolean")
+		if reasons := list(s.m["asNeededFor"]); len(reasons) > 0 {
+			if len(reasons) > 1 {
+				s.keep("asNeededFor")
+			}
+			delete(s.m, "asNeededBoolean")
+			s.m["asNeededCodeableConcept"] = reasons[0]
+		}
+		delete(s.m, "asNeededFor")
+	},
+	"SampledData": func(s *scope) {
+		if unit, _ := s.m["intervalUnit"].(string); unit == "ms" {
+			delete(s.m, "intervalUnit")
+			s.rename("interval", "period")
+		}
+	},
+}
+
+// encounterStatusR4ToR5 translates the R4 encounter states R5 merged or renamed
+var encounterStatusR4ToR5 = map[string]string{
+	"arrived":  "in-progress",
+	"triaged":  "in-progress",
+	"onleave":  "on-hold",
+	"finished": "completed",
+}
+
+var encounterStatusR5ToR4 = map[string]string{
+	"on-hold":      "onleave",
+	"discharged":   "finished",
+	"completed":    "finished",
+	"discontinued": "cancelled",
+}
+
+var medicationRequestStatusR5ToR4 = map[string]string{
+	"ended": "completed",
+}
+
+// encounterAdmissionMoved are the elements of R4 Encounter.hospitalization R5
+// moved to the Encounter
+var encounterAdmissionMoved = []string{"dietPreference", "specialArrangement", "specialCourtesy"}
+
+// participantFunction returns the provenance participant type as CodeableConcept
+func participantFunction(code, display string) object {
+	return object{"coding": []interface{}{object{
+		"system":  "http://terminology.hl7.org/CodeSystem/provenance-participant-type",
+		"code":    code,
+		"display": display,
+	}}}
+}
+
+// isParticipantFunction reports whether a CodeableConcept is the provenance participant type code
+func isParticipantFunction(v interface{}, code string) bool {
+	concept, _ := v.(object)
+	for _, c := range list(concept["coding"]) {
+		if coding, _ := c.(object); coding["code"] == code {
+			return true
+		}
+	}
+	return false
+}
+
+// codeableReferences combines lists of CodeableConcepts and References into
+// CodeableReferences
+func codeableReferences(concepts, references []interface{}) []interface{} {
+	var result []interface{}
+	for _, concept := range concepts {
+		result = append(result, object{"concept": concept})
+	}
+	for _, reference := range references {
+		result = append(result, object{"reference": reference})
+	}
+	return result
+}
+
+// splitCodeableReferences stores the concepts and references of CodeableReferences
+// under separate keys
+func splitCodeableReferences(m object, values []interface{}, concepts, references string) {
+	for _, v := range values {
+		v, _ := v.(object)
+		if concept, ok := v["concept"]; ok {
+			m[concepts] = append(list(m[concepts]), concept)
+		}
+		if reference, ok := v["reference"]; ok {
+			m[references] = append(list(m[references]), reference)
+		}
+	}
+}
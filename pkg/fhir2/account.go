@@ -0,0 +1,46 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Account tracks balance, charges, for patient or cost center
+type Account struct {
+	DomainResource
+
+	// Indicates the period of time over which the account is allowed
+	ActivePeriod *common.Period `json:"activePeriod,omitempty"`
+
+	// Calculated account balance(s)
+	Balance *common.Quantity `json:"balance,omitempty"`
+
+	// Identifies the period of time the account applies to; e.g. accounts created per fiscal year, quarter, etc
+	CoveragePeriod *common.Period `json:"coveragePeriod,omitempty"`
+
+	// The base or default currency
+	Currency *common.Coding `json:"currency,omitempty"`
+
+	// Explanation of purpose/use
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Account number
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Human-readable label
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Entity managing the Account
+	Owner *common.Reference `json:"owner,omitempty"`
+
+	// active | inactive | entered-in-error | on-hold | unknown
+	Status        *string         `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// The entity that caused the expenses
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// E.g. patient, expense, depreciation
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
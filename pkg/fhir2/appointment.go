@@ -0,0 +1,104 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Appointment represents a booking of a healthcare event among patient(s), practitioner(s)
+type Appointment struct {
+	DomainResource
+
+	// Additional comments about the appointment
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Shown on a subject line in a meeting request, or appointment list
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// When appointment is to conclude
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Can be less than start/end (e.g. estimate)
+	MinutesDuration        *int            `json:"minutesDuration,omitempty"`
+	MinutesDurationElement *common.Element `json:"_minutesDuration,omitempty"`
+
+	// Participants involved in appointment
+	Participant []AppointmentParticipant `json:"participant"`
+
+	// Used to make informed decisions if needing to re-prioritize
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// Reason this appointment is scheduled
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// The slots that this appointment is filling
+	Slot []common.Reference `json:"slot,omitempty"`
+
+	// When appointment is to take place
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// proposed | pending | booked | arrived | fulfilled | cancelled | noshow | entered-in-error | checked-in | waitlist
+	Status        AppointmentStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// The type of appointment that is being booked
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// AppointmentStatus represents the status of an appointment
+type AppointmentStatus string
+
+const (
+	AppointmentStatusProposed  AppointmentStatus = "proposed"
+	AppointmentStatusPending   AppointmentStatus = "pending"
+	AppointmentStatusBooked    AppointmentStatus = "booked"
+	AppointmentStatusArrived   AppointmentStatus = "arrived"
+	AppointmentStatusFulfilled AppointmentStatus = "fulfilled"
+	AppointmentStatusCancelled AppointmentStatus = "cancelled"
+	AppointmentStatusNoshow    AppointmentStatus = "noshow"
+)
+
+// AppointmentParticipant represents participants involved in appointment
+type AppointmentParticipant struct {
+	common.BackboneElement
+
+	// The individual, device, location, or service participating in the appointment
+	Actor *common.Reference `json:"actor,omitempty"`
+
+	// The participant is required to attend (optional when false)
+	Required        *AppointmentParticipantRequired `json:"required,omitempty"`
+	RequiredElement *common.Element                 `json:"_required,omitempty"`
+
+	// accepted | declined | tentative | needs-action
+	Status        AppointmentParticipantStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
+
+	// Role of participant in the appointment
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// AppointmentParticipantRequired represents the required of an appointment participant
+type AppointmentParticipantRequired string
+
+const (
+	AppointmentParticipantRequiredRequired        AppointmentParticipantRequired = "required"
+	AppointmentParticipantRequiredOptional        AppointmentParticipantRequired = "optional"
+	AppointmentParticipantRequiredInformationOnly AppointmentParticipantRequired = "information-only"
+)
+
+// AppointmentParticipantStatus represents the status of an appointment participant
+type AppointmentParticipantStatus string
+
+const (
+	AppointmentParticipantStatusAccepted    AppointmentParticipantStatus = "accepted"
+	AppointmentParticipantStatusDeclined    AppointmentParticipantStatus = "declined"
+	AppointmentParticipantStatusTentative   AppointmentParticipantStatus = "tentative"
+	AppointmentParticipantStatusNeedsAction AppointmentParticipantStatus = "needs-action"
+)
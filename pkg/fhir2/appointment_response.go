@@ -0,0 +1,50 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AppointmentResponse represents a reply to an appointment request for a patient and/or practitioner(s), such as a confirmation or rejection
+type AppointmentResponse struct {
+	DomainResource
+
+	// Person(s), Location, HealthcareService, or Device
+	Actor *common.Reference `json:"actor,omitempty"`
+
+	// Appointment this response relates to
+	Appointment *common.Reference `json:"appointment"`
+
+	// Additional comments
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Time from appointment, or requested new end time
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// accepted | declined | tentative | needs-action | entered-in-error
+	ParticipantStatus        AppointmentResponseParticipantStatus `json:"participantStatus"`
+	ParticipantStatusElement *common.Element                      `json:"_participantStatus,omitempty"`
+
+	// Role of participant in the appointment
+	ParticipantType []common.CodeableConcept `json:"participantType,omitempty"`
+
+	// Time from appointment, or requested new start time
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+}
+
+// AppointmentResponseParticipantStatus represents the participant status of an appointment response
+type AppointmentResponseParticipantStatus string
+
+const (
+	AppointmentResponseParticipantStatusAccepted    AppointmentResponseParticipantStatus = "accepted"
+	AppointmentResponseParticipantStatusDeclined    AppointmentResponseParticipantStatus = "declined"
+	AppointmentResponseParticipantStatusTentative   AppointmentResponseParticipantStatus = "tentative"
+	AppointmentResponseParticipantStatusInProcess   AppointmentResponseParticipantStatus = "in-process"
+	AppointmentResponseParticipantStatusCompleted   AppointmentResponseParticipantStatus = "completed"
+	AppointmentResponseParticipantStatusNeedsAction AppointmentResponseParticipantStatus = "needs-action"
+)
@@ -0,0 +1,173 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AuditEvent represents record of an event
+type AuditEvent struct {
+	DomainResource
+
+	// The event must be identified
+	Event *AuditEventEvent `json:"event"`
+
+	// The event may have other objects involved
+	Object []AuditEventObject `json:"object,omitempty"`
+
+	// The event has one or more active participants
+	Participant []AuditEventParticipant `json:"participant"`
+
+	// Audit Event Reporter
+	Source *AuditEventSource `json:"source"`
+}
+
+// AuditEventEvent represents the event of an audit event
+type AuditEventEvent struct {
+	common.BackboneElement
+
+	// This broadly indicates what kind of action was done on the Participant Object
+	Action        *string         `json:"action,omitempty"`
+	ActionElement *common.Element `json:"_action,omitempty"`
+
+	// This ties an event to a specific date and time
+	DateTime        common.Instant  `json:"dateTime"`
+	DateTimeElement *common.Element `json:"_dateTime,omitempty"`
+
+	// Indicates whether the event succeeded or failed
+	Outcome        *string         `json:"outcome,omitempty"`
+	OutcomeElement *common.Element `json:"_outcome,omitempty"`
+
+	// A free text description of the outcome of the event
+	OutcomeDesc        *string         `json:"outcomeDesc,omitempty"`
+	OutcomeDescElement *common.Element `json:"_outcomeDesc,omitempty"`
+
+	// The purposeOfUse (reason) that was used during the event being recorded
+	PurposeOfEvent []common.Coding `json:"purposeOfEvent,omitempty"`
+
+	// This field enables queries of messages by implementation-defined event categories
+	Subtype []common.Coding `json:"subtype,omitempty"`
+
+	// This identifies the performed function
+	Type *common.Coding `json:"type"`
+}
+
+// AuditEventParticipantNetwork represents logical network location for application activity, if the activity has a network location
+type AuditEventParticipantNetwork struct {
+	common.BackboneElement
+
+	// This datum identifies the user's network access point, which may be distinct from the server that performed the action
+	Address        *string         `json:"address,omitempty"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	// This datum identifies the type of network access point identifier of the user device for the audit event
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// AuditEventParticipant represents the participant of an audit event
+type AuditEventParticipant struct {
+	common.BackboneElement
+
+	// In some situations a human user may authenticate with one identity but, to access a specific application system
+	AltId        *string         `json:"altId,omitempty"`
+	AltIdElement *common.Element `json:"_altId,omitempty"`
+
+	// Where the event occurred
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Usually, this is used instead of specifying a network address
+	Media *common.Coding `json:"media,omitempty"`
+
+	// The User ID and Authorization User ID may be internal or otherwise obscure values
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Logical network location for application activity, if the activity has a network location
+	Network *AuditEventParticipantNetwork `json:"network,omitempty"`
+
+	// This value is used retrospectively to determine the authorization policies
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
+
+	// The reason (purpose of use), specific to this participant, that was used during the event being recorded
+	PurposeOfUse []common.Coding `json:"purposeOfUse,omitempty"`
+
+	// This field ties an audit event to a specific resource
+	Reference *common.Reference `json:"reference,omitempty"`
+
+	// This value is used to distinguish between requestor-users and recipient-users
+	Requestor        bool            `json:"requestor"`
+	RequestorElement *common.Element `json:"_requestor,omitempty"`
+
+	// This value ties an audited event to a user's role(s)
+	Role []common.CodeableConcept `json:"role,omitempty"`
+
+	// This field ties an audit event to a specific user
+	UserId *common.Identifier `json:"userId,omitempty"`
+}
+
+// AuditEventSource represents Audit Event Reporter
+type AuditEventSource struct {
+	common.BackboneElement
+
+	// This field ties the event to a specific source system
+	Identifier *common.Identifier `json:"identifier"`
+
+	// Logical source location within the enterprise
+	Site        *string         `json:"site,omitempty"`
+	SiteElement *common.Element `json:"_site,omitempty"`
+
+	// The type of source where event originated
+	Type []common.Coding `json:"type,omitempty"`
+}
+
+// AuditEventObjectDetail represents Additional Information about the Object
+type AuditEventObjectDetail struct {
+	common.BackboneElement
+
+	// Name of the property
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// Property value
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// AuditEventObject represents the object of an audit event
+type AuditEventObject struct {
+	common.BackboneElement
+
+	// Text that describes the object in more detail
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Additional Information about the Object
+	Detail []AuditEventObjectDetail `json:"detail,omitempty"`
+
+	// Identifies a specific instance of the participant object
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Institutional policies for privacy and security may optionally fall under different accountability rules based on data life cycle
+	Lifecycle *common.Coding `json:"lifecycle,omitempty"`
+
+	// An instance-specific descriptor of the Participant Object ID audited, such as a person's name
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// For query events it may be necessary to capture the actual query input to the query process in order to identify the specific event
+	Query        *string         `json:"query,omitempty"`
+	QueryElement *common.Element `json:"_query,omitempty"`
+
+	// Identifies a specific instance of the participant object
+	Reference *common.Reference `json:"reference,omitempty"`
+
+	// For some detailed audit analysis it may be necessary to indicate a more granular type of participant
+	Role *common.Coding `json:"role,omitempty"`
+
+	// This field identifies the security labels for a specific instance of an object, such as a patient
+	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
+
+	// To describe the object being acted upon
+	Type *common.Coding `json:"type,omitempty"`
+}
@@ -0,0 +1,26 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Basic represents resource for non-supported content
+type Basic struct {
+	DomainResource
+
+	// Who created
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Kind of Resource
+	Code *common.CodeableConcept `json:"code"`
+
+	// When created
+	Created        *common.Date    `json:"created,omitempty"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
+
+	// Business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Identifies the focus of this resource
+	Subject *common.Reference `json:"subject,omitempty"`
+}
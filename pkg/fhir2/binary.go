@@ -0,0 +1,18 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Binary represents pure binary content defined by a format other than FHIR
+type Binary struct {
+	Resource
+
+	// The actual content, base64 encoded
+	Content        string          `json:"content"`
+	ContentElement *common.Element `json:"_content,omitempty"`
+
+	// MimeType of the binary content
+	ContentType        string          `json:"contentType"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
+}
@@ -0,0 +1,29 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// BodySite represents record details about the anatomical location of a specimen or body part
+type BodySite struct {
+	DomainResource
+
+	// Named anatomical location - ideally coded where possible
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Description of anatomical location
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Identifier for this instance of the anatomical location
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Image or images used to identify a location
+	Image []Attachment `json:"image,omitempty"`
+
+	// Modifier to refine the anatomical location
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// The person to which the body site belongs
+	Patient *common.Reference `json:"patient"`
+}
@@ -0,0 +1,336 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Claim represents Claim, Pre-determination or Pre-authorization
+type Claim struct {
+	DomainResource
+
+	// Details of the event
+	Accident        *common.Date    `json:"accident,omitempty"`
+	AccidentElement *common.Element `json:"_accident,omitempty"`
+
+	// Coverage may be dependent on the type of accident
+	AccidentType *common.Coding `json:"accidentType,omitempty"`
+
+	// Code to indicate that Xrays, images, emails, documents
+	AdditionalMaterials []common.Coding `json:"additionalMaterials,omitempty"`
+
+	// List of patient conditions for which care is sought
+	Condition []common.Coding `json:"condition,omitempty"`
+
+	// Health care programs and insurers are significant payors of health service costs
+	Coverage []ClaimCoverage `json:"coverage,omitempty"`
+
+	// Resource creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Pertinent diagnosis information
+	Diagnosis []ClaimDiagnosis `json:"diagnosis,omitempty"`
+
+	// Author of the claim
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// To determine extenuating circumstances for coverage
+	Exception []common.Coding `json:"exception,omitempty"`
+
+	// Servicing facility
+	Facility *common.Reference `json:"facility,omitempty"`
+
+	// For whom to reserve funds
+	FundsReserve *common.Coding `json:"fundsReserve,omitempty"`
+
+	// Business Identifier for claim
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Coverage may be modified based on exception information provided
+	InterventionException []common.Coding `json:"interventionException,omitempty"`
+
+	// Product or service provided
+	Item []ClaimItem `json:"item,omitempty"`
+
+	// The list of missing teeth may influence the adjudication of services for example with Bridges
+	MissingTeeth []ClaimMissingTeeth `json:"missingTeeth,omitempty"`
+
+	// The organization which is responsible for the bill, claim pre-determination, pre-authorization
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Original prescription if superseded by fulfiller
+	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
+
+	// The version of the specification from which the original instance was created
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// The recipient of the products and services
+	Patient *common.Reference `json:"patient"`
+
+	// Recipient of benefits payable
+	Payee *ClaimPayee `json:"payee,omitempty"`
+
+	// Prescription authorizing services and products
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// Desired processing urgency
+	Priority *common.Coding `json:"priority,omitempty"`
+
+	// Party responsible for the claim
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// Treatment referral
+	Referral *common.Reference `json:"referral,omitempty"`
+
+	// The version of the specification on which this instance relies
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// Often required for over-age dependents
+	School        *string         `json:"school,omitempty"`
+	SchoolElement *common.Element `json:"_school,omitempty"`
+
+	// Insurer Identifier, typical BIN number (6 digit)
+	Target *common.Reference `json:"target,omitempty"`
+
+	// Category or discipline
+	Type        ClaimType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// claim | preauthorization | predetermination
+	Use        *ClaimUse       `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
+}
+
+// ClaimType represents the type of a claim
+type ClaimType string
+
+const (
+	ClaimTypeInstitutional ClaimType = "institutional"
+	ClaimTypeOral          ClaimType = "oral"
+	ClaimTypePharmacy      ClaimType = "pharmacy"
+	ClaimTypeProfessional  ClaimType = "professional"
+	ClaimTypeVision        ClaimType = "vision"
+)
+
+// ClaimUse represents the use of a claim
+type ClaimUse string
+
+const (
+	ClaimUseComplete    ClaimUse = "complete"
+	ClaimUseProposed    ClaimUse = "proposed"
+	ClaimUseExploratory ClaimUse = "exploratory"
+	ClaimUseOther       ClaimUse = "other"
+)
+
+// ClaimPayee represents recipient of benefits payable
+type ClaimPayee struct {
+	common.BackboneElement
+
+	// The organization who is to be reimbursed for the claim (the party to whom any benefit is assigned)
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// The person other than the subscriber who is to be reimbursed for the claim (the party to whom any benefit is assigned)
+	Person *common.Reference `json:"person,omitempty"`
+
+	// The provider who is to be reimbursed for the claim (the party to whom any benefit is assigned)
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// Category of recipient
+	Type *common.Coding `json:"type,omitempty"`
+}
+
+// ClaimDiagnosis represents pertinent diagnosis information
+type ClaimDiagnosis struct {
+	common.BackboneElement
+
+	// Required to adjudicate services rendered to condition presented
+	Diagnosis *common.Coding `json:"diagnosis"`
+
+	// Diagnosis instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimCoverage represents health care programs and insurers are significant payors of health service costs
+type ClaimCoverage struct {
+	common.BackboneElement
+
+	// The contract number of a business agreement which describes the terms and conditions
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
+
+	// Used by downstream payers to determine what balance remains and the net payable
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	// Need to identify the issuer to target for processing and for coordination of benefit processing
+	Coverage *common.Reference `json:"coverage"`
+
+	// To identify which coverage is being adjudicated
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// To provide any pre=determination or prior authorization reference
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+
+	// To determine the relationship between the patient and the subscriber
+	Relationship *common.Coding `json:"relationship"`
+
+	// To maintain order of the coverages
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimItemDetailSubDetail represents product or service provided
+type ClaimItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// Price scaling factor
+	Factor        *common.Decimal  `json:"factor,omitempty"`
+	FactorElement *common.Element  `json:"_factor,omitempty"`
+	Net           *common.Quantity `json:"net,omitempty"`
+	Points        *common.Decimal  `json:"points,omitempty"`
+	PointsElement *common.Element  `json:"_points,omitempty"`
+
+	// Count of products or services
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Item instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The fee for an additional service or product or charge
+	Service *common.Coding `json:"service"`
+
+	// The type of product or service
+	Type *common.Coding `json:"type"`
+
+	// Unique device identifier
+	Udi *common.Coding `json:"udi,omitempty"`
+
+	// Fee, charge or cost per item
+	UnitPrice *common.Quantity `json:"unitPrice,omitempty"`
+}
+
+// ClaimItemDetail represents product or service provided
+type ClaimItemDetail struct {
+	common.BackboneElement
+
+	// Price scaling factor
+	Factor        *common.Decimal  `json:"factor,omitempty"`
+	FactorElement *common.Element  `json:"_factor,omitempty"`
+	Net           *common.Quantity `json:"net,omitempty"`
+	Points        *common.Decimal  `json:"points,omitempty"`
+	PointsElement *common.Element  `json:"_points,omitempty"`
+
+	// Count of products or services
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Item instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// If a grouping item then 'GROUP' otherwise it is a node therefore a code to indicate the Professional Service or Product supplied
+	Service *common.Coding `json:"service"`
+
+	// Product or service provided
+	SubDetail []ClaimItemDetailSubDetail `json:"subDetail,omitempty"`
+
+	// The type of product or service
+	Type *common.Coding `json:"type"`
+
+	// Unique device identifier
+	Udi *common.Coding `json:"udi,omitempty"`
+
+	// Fee, charge or cost per item
+	UnitPrice *common.Quantity `json:"unitPrice,omitempty"`
+}
+
+// ClaimItemProsthesis represents the prosthesis of a claim item
+type ClaimItemProsthesis struct {
+	common.BackboneElement
+
+	// May impact on adjudication
+	Initial          *bool           `json:"initial,omitempty"`
+	InitialElement   *common.Element `json:"_initial,omitempty"`
+	PriorDate        *common.Date    `json:"priorDate,omitempty"`
+	PriorDateElement *common.Element `json:"_priorDate,omitempty"`
+	PriorMaterial    *common.Coding  `json:"priorMaterial,omitempty"`
+}
+
+// ClaimItem represents product or service provided
+type ClaimItem struct {
+	common.BackboneElement
+
+	// Anatomical location
+	BodySite *common.Coding `json:"bodySite,omitempty"`
+
+	// Product or service provided
+	Detail []ClaimItemDetail `json:"detail,omitempty"`
+
+	// Diagnosis applicable for this service or product line
+	DiagnosisLinkId        []int             `json:"diagnosisLinkId,omitempty"`
+	DiagnosisLinkIdElement []*common.Element `json:"_diagnosisLinkId,omitempty"`
+
+	// Price scaling factor
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Product or service billing modifiers
+	Modifier []common.Coding `json:"modifier,omitempty"`
+
+	// Total item cost
+	Net           *common.Quantity `json:"net,omitempty"`
+	Points        *common.Decimal  `json:"points,omitempty"`
+	PointsElement *common.Element  `json:"_points,omitempty"`
+
+	// The materials and placement date of prior fixed prosthesis
+	Prosthesis *ClaimItemProsthesis `json:"prosthesis,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// Count of products or services
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Item instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// If a grouping item then 'GROUP' otherwise it is a node therefore a code to indicate the Professional Service or Product supplied
+	Service *common.Coding `json:"service"`
+
+	// The date when the enclosed suite of services were performed or completed
+	ServiceDate        *common.Date    `json:"serviceDate,omitempty"`
+	ServiceDateElement *common.Element `json:"_serviceDate,omitempty"`
+
+	// A region or surface of the site, e.g. limb region or tooth surface(s)
+	SubSite []common.Coding `json:"subSite,omitempty"`
+
+	// The type of product or service
+	Type *common.Coding `json:"type"`
+
+	// Unique device identifier
+	Udi *common.Coding `json:"udi,omitempty"`
+
+	// Fee, charge or cost per item
+	UnitPrice *common.Quantity `json:"unitPrice,omitempty"`
+}
+
+// ClaimMissingTeeth represents the missing teeth of a claim
+type ClaimMissingTeeth struct {
+	common.BackboneElement
+
+	// Some services and adjudications require this information
+	ExtractionDate        *common.Date    `json:"extractionDate,omitempty"`
+	ExtractionDateElement *common.Element `json:"_extractionDate,omitempty"`
+
+	// Provides the reason for the missing tooth
+	Reason *common.Coding `json:"reason,omitempty"`
+
+	// Provides the tooth number of the missing tooth
+	Tooth *common.Coding `json:"tooth"`
+}
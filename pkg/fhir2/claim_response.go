@@ -0,0 +1,329 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ClaimResponse represents response to a claim predetermination or preauthorization
+type ClaimResponse struct {
+	DomainResource
+
+	// Insurer added line items
+	AddItem []ClaimResponseAddItem `json:"addItem,omitempty"`
+
+	// Health care programs and insurers are significant payors of health service costs
+	Coverage []ClaimResponseCoverage `json:"coverage,omitempty"`
+
+	// Response creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Disposition Message
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Processing errors
+	Error []ClaimResponseError `json:"error,omitempty"`
+
+	// Printed reference or actual form
+	Form *common.Coding `json:"form,omitempty"`
+
+	// Business Identifier for a claim response
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Adjudication for claim line items
+	Item []ClaimResponseItem `json:"item,omitempty"`
+
+	// Note text
+	Note []ClaimResponseNote `json:"note,omitempty"`
+
+	// The Insurer who produced this adjudicated response
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// queued | complete | error | partial
+	Outcome        *ClaimResponseOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element       `json:"_outcome,omitempty"`
+
+	// Party to be paid any benefits payable
+	PayeeType *common.Coding `json:"payeeType,omitempty"`
+
+	// Adjustment to the payment of this transaction which is not related to adjudication of this transaction
+	PaymentAdjustment *common.Quantity `json:"paymentAdjustment,omitempty"`
+
+	// Reason for the payment adjustment
+	PaymentAdjustmentReason *common.Coding `json:"paymentAdjustmentReason,omitempty"`
+
+	// Payable less any payment adjustment
+	PaymentAmount *common.Quantity `json:"paymentAmount,omitempty"`
+
+	// Estimated payment data
+	PaymentDate        *common.Date    `json:"paymentDate,omitempty"`
+	PaymentDateElement *common.Element `json:"_paymentDate,omitempty"`
+
+	// Payment identifier
+	PaymentRef *common.Identifier `json:"paymentRef,omitempty"`
+
+	// Id of resource triggering adjudication
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// Status of funds reservation (For provider, for Patient, None)
+	Reserved *common.Coding `json:"reserved,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// Total amount of benefit payable
+	TotalBenefit *common.Quantity `json:"totalBenefit,omitempty"`
+
+	// This is a check value that the receiver calculates and returns
+	TotalCost *common.Quantity `json:"totalCost,omitempty"`
+
+	// The amount of deductible applied which was not allocated to any particular service line
+	UnallocDeductable *common.Quantity `json:"unallocDeductable,omitempty"`
+}
+
+// ClaimResponseOutcome represents the outcome of a claim response
+type ClaimResponseOutcome string
+
+const (
+	ClaimResponseOutcomeComplete ClaimResponseOutcome = "complete"
+	ClaimResponseOutcomeError    ClaimResponseOutcome = "error"
+)
+
+// ClaimResponseItemAdjudication represents adjudication details
+type ClaimResponseItemAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// Code indicating: Co-Pay, deductible, eligible, benefit, tax, etc
+	Code *common.Coding `json:"code"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ClaimResponseItemDetailAdjudication represents detail level adjudication details
+type ClaimResponseItemDetailAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount associated with the code
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// Code indicating: Co-Pay, deductible, eligible, benefit, tax, etc
+	Code *common.Coding `json:"code"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ClaimResponseItemDetailSubDetailAdjudication represents subdetail level adjudication details
+type ClaimResponseItemDetailSubDetailAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount associated with the code
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// Code indicating: Co-Pay, deductible, eligible, benefit, tax, etc
+	Code *common.Coding `json:"code"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ClaimResponseItemDetailSubDetail represents adjudication for claim sub-details
+type ClaimResponseItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseItemDetailSubDetailAdjudication `json:"adjudication,omitempty"`
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+}
+
+// ClaimResponseItemDetail represents adjudication for claim details
+type ClaimResponseItemDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseItemDetailAdjudication `json:"adjudication,omitempty"`
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// Adjudication for claim sub-details
+	SubDetail []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty"`
+}
+
+// ClaimResponseItem represents adjudication for claim line items
+type ClaimResponseItem struct {
+	common.BackboneElement
+
+	// Adjudication details
+	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
+
+	// Adjudication for claim details
+	Detail []ClaimResponseItemDetail `json:"detail,omitempty"`
+
+	// Applicable note numbers
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+}
+
+// ClaimResponseAddItemAdjudication represents added items adjudication
+type ClaimResponseAddItemAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount associated with the code
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// Code indicating: Co-Pay, deductible, eligible, benefit, tax, etc
+	Code *common.Coding `json:"code"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ClaimResponseAddItemDetailAdjudication represents added items detail adjudication
+type ClaimResponseAddItemDetailAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount associated with the code
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// Code indicating: Co-Pay, deductible, eligible, benefit, tax, etc
+	Code *common.Coding `json:"code"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ClaimResponseAddItemDetail represents insurer added line details
+type ClaimResponseAddItemDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseAddItemDetailAdjudication `json:"adjudication,omitempty"`
+
+	// The fee charged for the professional service or product.
+	Fee *common.Quantity `json:"fee,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied
+	Service *common.Coding `json:"service"`
+}
+
+// ClaimResponseAddItem represents insurer added line items
+type ClaimResponseAddItem struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseAddItemAdjudication `json:"adjudication,omitempty"`
+
+	// Insurer added line details
+	Detail []ClaimResponseAddItemDetail `json:"detail,omitempty"`
+
+	// The fee charged for the professional service or product.
+	Fee *common.Quantity `json:"fee,omitempty"`
+
+	// A list of note references to the notes provided below
+	NoteNumberLinkId        []int             `json:"noteNumberLinkId,omitempty"`
+	NoteNumberLinkIdElement []*common.Element `json:"_noteNumberLinkId,omitempty"`
+
+	// List of input service items which this service line is intended to replace
+	SequenceLinkId        []int             `json:"sequenceLinkId,omitempty"`
+	SequenceLinkIdElement []*common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied
+	Service *common.Coding `json:"service"`
+}
+
+// ClaimResponseError represents processing errors
+type ClaimResponseError struct {
+	common.BackboneElement
+
+	// Error code detailing processing issues
+	Code *common.Coding `json:"code"`
+
+	// The sequence number of the addition within the line item submitted which contains the error
+	DetailSequenceLinkId        *int            `json:"detailSequenceLinkId,omitempty"`
+	DetailSequenceLinkIdElement *common.Element `json:"_detailSequenceLinkId,omitempty"`
+
+	// The sequence number of the line item submitted which contains the error
+	SequenceLinkId        *int            `json:"sequenceLinkId,omitempty"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// The sequence number of the addition within the line item submitted which contains the error
+	SubdetailSequenceLinkId        *int            `json:"subdetailSequenceLinkId,omitempty"`
+	SubdetailSequenceLinkIdElement *common.Element `json:"_subdetailSequenceLinkId,omitempty"`
+}
+
+// ClaimResponseNote represents note text
+type ClaimResponseNote struct {
+	common.BackboneElement
+
+	// An integer associated with each note which may be referred to from each service line item
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// The note text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The note purpose: Print/Display
+	Type *common.Coding `json:"type,omitempty"`
+}
+
+// ClaimResponseCoverage represents health care programs and insurers are significant payors of health service costs
+type ClaimResponseCoverage struct {
+	common.BackboneElement
+
+	// The contract number of a business agreement which describes the terms and conditions
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
+
+	// Used by downstream payers to determine what balance remains and the net payable
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	// Need to identify the issuer to target for processing and for coordination of benefit processing
+	Coverage *common.Reference `json:"coverage"`
+
+	// To identify which coverage is being adjudicated
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// To provide any pre-determination or prior authorization reference
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+
+	// To determine the relationship between the patient and the subscriber
+	Relationship *common.Coding `json:"relationship"`
+
+	// To maintain order of the coverages
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
@@ -0,0 +1,112 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ClinicalImpression represents a clinical assessment performed when planning treatments and management strategies for a patient
+type ClinicalImpression struct {
+	DomainResource
+
+	// Actions taken during assessment
+	Action []common.Reference `json:"action,omitempty"`
+
+	// The clinician performing the assessment
+	Assessor *common.Reference `json:"assessor,omitempty"`
+
+	// When the assessment was documented
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Why/how the assessment was performed
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Possible or likely findings and diagnoses
+	Finding []ClinicalImpressionFinding `json:"finding,omitempty"`
+
+	// One or more sets of investigations (signs, symptions, etc.)
+	Investigations []ClinicalImpressionInvestigations `json:"investigations,omitempty"`
+
+	// The patient being assessed
+	Patient *common.Reference `json:"patient"`
+
+	// Plan of action after assessment
+	Plan []common.Reference `json:"plan,omitempty"`
+
+	// Reference to last assessment
+	Previous *common.Reference `json:"previous,omitempty"`
+
+	// Relevant impressions of patient state
+	Problem []common.Reference `json:"problem,omitempty"`
+
+	// Estimate of likely outcome
+	Prognosis        *string         `json:"prognosis,omitempty"`
+	PrognosisElement *common.Element `json:"_prognosis,omitempty"`
+
+	// Clinical Protocol followed
+	Protocol        *string         `json:"protocol,omitempty"`
+	ProtocolElement *common.Element `json:"_protocol,omitempty"`
+
+	// Diagnoses/conditions resolved since the last assessment
+	Resolved []common.CodeableConcept `json:"resolved,omitempty"`
+
+	// Diagnosis considered not possible
+	RuledOut []ClinicalImpressionRuledOut `json:"ruledOut,omitempty"`
+
+	// preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
+	Status        ClinicalImpressionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// Summary of the assessment
+	Summary        *string         `json:"summary,omitempty"`
+	SummaryElement *common.Element `json:"_summary,omitempty"`
+
+	// The request or event that necessitated this assessment
+	TriggerCodeableConcept *common.CodeableConcept `json:"triggerCodeableConcept,omitempty"`
+	TriggerReference       *common.Reference       `json:"triggerReference,omitempty"`
+}
+
+// ClinicalImpressionStatus represents the status of a clinical impression
+type ClinicalImpressionStatus string
+
+const (
+	ClinicalImpressionStatusInProgress     ClinicalImpressionStatus = "in-progress"
+	ClinicalImpressionStatusCompleted      ClinicalImpressionStatus = "completed"
+	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
+)
+
+// ClinicalImpressionInvestigations represents one or more sets of investigations (signs, symptions, etc.)
+type ClinicalImpressionInvestigations struct {
+	common.BackboneElement
+
+	// A name/code for the group ("set") of investigations
+	Code *common.CodeableConcept `json:"code"`
+
+	// A record of a specific investigation that was undertaken
+	Item []common.Reference `json:"item,omitempty"`
+}
+
+// ClinicalImpressionFinding represents possible or likely findings and diagnoses
+type ClinicalImpressionFinding struct {
+	common.BackboneElement
+
+	// Which investigations support finding or diagnosis
+	Cause        *string         `json:"cause,omitempty"`
+	CauseElement *common.Element `json:"_cause,omitempty"`
+
+	// What was found
+	Item *common.CodeableConcept `json:"item"`
+}
+
+// ClinicalImpressionRuledOut represents diagnosis considered not possible
+type ClinicalImpressionRuledOut struct {
+	common.BackboneElement
+
+	// Specific text of code for diagnosis
+	Item *common.CodeableConcept `json:"item"`
+
+	// Grounds for elimination
+	Reason        *string         `json:"reason,omitempty"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
+}
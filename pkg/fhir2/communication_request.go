@@ -0,0 +1,83 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CommunicationRequest represents a request for information to be sent to a receiver
+type CommunicationRequest struct {
+	DomainResource
+
+	// Message category
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The Encounter during which this CommunicationRequest was created
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Unique identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// A channel of communication
+	Medium []common.CodeableConcept `json:"medium,omitempty"`
+
+	// Message payload
+	Payload []CommunicationRequestPayload `json:"payload,omitempty"`
+
+	// routine | urgent | asap | stat
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// Why is communication needed?
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Who to share the information with
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// The time when the request was made
+	RequestedOn        *common.DateTime `json:"requestedOn,omitempty"`
+	RequestedOnElement *common.Element  `json:"_requestedOn,omitempty"`
+
+	// Who asks for the information to be shared
+	Requester *common.Reference `json:"requester,omitempty"`
+
+	// The time when this communication is to occur
+	ScheduledDateTime        *common.DateTime `json:"scheduledDateTime,omitempty"`
+	ScheduledDateTimeElement *common.Element  `json:"_scheduledDateTime,omitempty"`
+	ScheduledPeriod          *common.Period   `json:"scheduledPeriod,omitempty"`
+
+	// The entity (e.g. person, organization, clinical information system
+	Sender *common.Reference `json:"sender,omitempty"`
+
+	// draft | active | on-hold | revoked | completed | entered-in-error | unknown
+	Status        *CommunicationRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
+
+	// Focus of message
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// CommunicationRequestStatus represents the status of a communication request
+type CommunicationRequestStatus string
+
+const (
+	CommunicationRequestStatusProposed   CommunicationRequestStatus = "proposed"
+	CommunicationRequestStatusPlanned    CommunicationRequestStatus = "planned"
+	CommunicationRequestStatusRequested  CommunicationRequestStatus = "requested"
+	CommunicationRequestStatusReceived   CommunicationRequestStatus = "received"
+	CommunicationRequestStatusAccepted   CommunicationRequestStatus = "accepted"
+	CommunicationRequestStatusInProgress CommunicationRequestStatus = "in-progress"
+	CommunicationRequestStatusCompleted  CommunicationRequestStatus = "completed"
+	CommunicationRequestStatusSuspended  CommunicationRequestStatus = "suspended"
+	CommunicationRequestStatusRejected   CommunicationRequestStatus = "rejected"
+	CommunicationRequestStatusFailed     CommunicationRequestStatus = "failed"
+)
+
+// CommunicationRequestPayload represents message payload
+type CommunicationRequestPayload struct {
+	common.BackboneElement
+
+	// The communicated content (or for multi-part communications, one portion of the communication)
+	ContentString        *string           `json:"contentString,omitempty"`
+	ContentStringElement *common.Element   `json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference     *common.Reference `json:"contentReference,omitempty"`
+}
@@ -0,0 +1,168 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ConceptMap represents a map from one set of concepts to one or more other concepts
+type ConceptMap struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []ConceptMapContact `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the concept map
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Mappings for an individual concept in the source to one or more concepts in the target
+	Element []ConceptMapElement `json:"element,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Additional identifier for the concept map
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Name for this concept map (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Explains why this concept map is needed and why it has been constrained as it has
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// The source value set that specifies the concepts that are being mapped
+	SourceUri        *string           `json:"sourceUri,omitempty"`
+	SourceUriElement *common.Element   `json:"_sourceUri,omitempty"`
+	SourceReference  *common.Reference `json:"sourceReference,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ConceptMapStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// The target value set provides context to the mappings
+	TargetUri        *string           `json:"targetUri,omitempty"`
+	TargetUriElement *common.Element   `json:"_targetUri,omitempty"`
+	TargetReference  *common.Reference `json:"targetReference,omitempty"`
+
+	// Canonical identifier for this concept map, represented as a URI (globally unique)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.CodeableConcept `json:"useContext,omitempty"`
+
+	// Business version of the concept map
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ConceptMapStatus represents the status of a concept map
+type ConceptMapStatus string
+
+const (
+	ConceptMapStatusDraft   ConceptMapStatus = "draft"
+	ConceptMapStatusActive  ConceptMapStatus = "active"
+	ConceptMapStatusRetired ConceptMapStatus = "retired"
+)
+
+// ConceptMapContact represents contacts to assist a user in finding and communicating with the publisher
+type ConceptMapContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the concept map
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// ConceptMapElementTargetDependsOn represents a set of additional dependencies for this mapping to hold
+type ConceptMapElementTargetDependsOn struct {
+	common.BackboneElement
+
+	// Identity (code or path) or the element/item/ValueSet that the map depends on / refers to
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// An absolute URI that identifies the code system of the dependency code
+	CodeSystem        string          `json:"codeSystem"`
+	CodeSystemElement *common.Element `json:"_codeSystem,omitempty"`
+
+	// A reference to a specific concept that holds a coded value
+	Element        string          `json:"element"`
+	ElementElement *common.Element `json:"_element,omitempty"`
+}
+
+// ConceptMapElementTarget represents a concept from the target value set that this concept maps to
+type ConceptMapElementTarget struct {
+	common.BackboneElement
+
+	// Identity (code or path) or the element/item that the map refers to
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// An absolute URI that identifies the code system of the target code
+	CodeSystem        *string         `json:"codeSystem,omitempty"`
+	CodeSystemElement *common.Element `json:"_codeSystem,omitempty"`
+
+	// A description of status/issues in mapping that conveys additional information not represented in the structured data
+	Comments        *string         `json:"comments,omitempty"`
+	CommentsElement *common.Element `json:"_comments,omitempty"`
+
+	// A set of additional dependencies for this mapping to hold
+	DependsOn []ConceptMapElementTargetDependsOn `json:"dependsOn,omitempty"`
+
+	// The equivalence between the source and target concepts (counting for the dependencies and products)
+	Equivalence        ConceptMapElementTargetEquivalence `json:"equivalence"`
+	EquivalenceElement *common.Element                    `json:"_equivalence,omitempty"`
+
+	// A set of additional outcomes from this mapping to other elements
+	Product []ConceptMapElementTargetDependsOn `json:"product,omitempty"`
+}
+
+// ConceptMapElementTargetEquivalence represents the equivalence of a concept map element target
+type ConceptMapElementTargetEquivalence string
+
+const (
+	ConceptMapElementTargetEquivalenceEquivalent  ConceptMapElementTargetEquivalence = "equivalent"
+	ConceptMapElementTargetEquivalenceEqual       ConceptMapElementTargetEquivalence = "equal"
+	ConceptMapElementTargetEquivalenceWider       ConceptMapElementTargetEquivalence = "wider"
+	ConceptMapElementTargetEquivalenceSubsumes    ConceptMapElementTargetEquivalence = "subsumes"
+	ConceptMapElementTargetEquivalenceNarrower    ConceptMapElementTargetEquivalence = "narrower"
+	ConceptMapElementTargetEquivalenceSpecializes ConceptMapElementTargetEquivalence = "specializes"
+	ConceptMapElementTargetEquivalenceInexact     ConceptMapElementTargetEquivalence = "inexact"
+	ConceptMapElementTargetEquivalenceUnmatched   ConceptMapElementTargetEquivalence = "unmatched"
+	ConceptMapElementTargetEquivalenceDisjoint    ConceptMapElementTargetEquivalence = "disjoint"
+)
+
+// ConceptMapElement represents mappings for an individual concept in the source to one or more concepts in the target
+type ConceptMapElement struct {
+	common.BackboneElement
+
+	// Identity (code or path) or the element/item being mapped
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// An absolute URI that identifies the Code System (if the source is a value set that crosses more than one code system)
+	CodeSystem        *string         `json:"codeSystem,omitempty"`
+	CodeSystemElement *common.Element `json:"_codeSystem,omitempty"`
+
+	// A concept from the target value set that this concept maps to
+	Target []ConceptMapElementTarget `json:"target,omitempty"`
+}
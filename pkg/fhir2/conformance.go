@@ -0,0 +1,538 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Conformance represents a conformance statement is a set of capabilities of a FHIR Server that may be used as a statement of actual server functionality or a statement of required or desired server implementation
+type Conformance struct {
+	DomainResource
+
+	// A code that indicates whether the application accepts unknown elements or extensions when reading resources
+	AcceptUnknown        ConformanceAcceptUnknown `json:"acceptUnknown"`
+	AcceptUnknownElement *common.Element          `json:"_acceptUnknown,omitempty"`
+
+	// Contacts to assist a user in finding and communicating with the publisher
+	Contact []ConformanceContact `json:"contact,omitempty"`
+
+	// A copyright statement relating to the conformance statement and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// The date (and optionally time) when the conformance statement was published
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// A free text natural language description of the conformance statement and its use
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A document definition
+	Document []ConformanceDocument `json:"document,omitempty"`
+
+	// A flag to indicate that this conformance statement is authored for testing purposes
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// The version of the FHIR specification on which this conformance statement is based
+	FHIRVersion        string          `json:"fhirVersion"`
+	FHIRVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// A list of the formats supported by this implementation using their content types
+	Format        []string          `json:"format"`
+	FormatElement []*common.Element `json:"_format,omitempty"`
+
+	// Identifies a specific implementation instance that is described by the conformance statement
+	Implementation *ConformanceImplementation `json:"implementation,omitempty"`
+
+	// Allow searching the 3 modes
+	Kind        ConformanceKind `json:"kind"`
+	KindElement *common.Element `json:"_kind,omitempty"`
+
+	// A description of the messaging capabilities of the solution
+	Messaging []ConformanceMessaging `json:"messaging,omitempty"`
+
+	// A free text natural language name identifying the conformance statement
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A list of profiles that represent different use cases supported by the system
+	Profile []common.Reference `json:"profile,omitempty"`
+
+	// Helps establish the "authority/credibility" of the conformance
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Explains why this conformance statement is needed and why it's been constrained as it has
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// A definition of the restful capabilities of the solution, if any
+	Rest []ConformanceRest `json:"rest,omitempty"`
+
+	// Software that is covered by this conformance statement
+	Software *ConformanceSoftware `json:"software,omitempty"`
+
+	// The status of this conformance statement
+	Status        *ConformanceStatus `json:"status,omitempty"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// An absolute URL that is used to identify this conformance statement when it is referenced in a specification, model
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The identifier that is used to identify this version of the conformance statement when it is referenced in a specification, model, design or instance
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ConformanceAcceptUnknown represents the accept unknown of a conformance
+type ConformanceAcceptUnknown string
+
+const (
+	ConformanceAcceptUnknownNo         ConformanceAcceptUnknown = "no"
+	ConformanceAcceptUnknownExtensions ConformanceAcceptUnknown = "extensions"
+	ConformanceAcceptUnknownElements   ConformanceAcceptUnknown = "elements"
+	ConformanceAcceptUnknownBoth       ConformanceAcceptUnknown = "both"
+)
+
+// ConformanceKind represents the kind of a conformance
+type ConformanceKind string
+
+const (
+	ConformanceKindInstance     ConformanceKind = "instance"
+	ConformanceKindCapability   ConformanceKind = "capability"
+	ConformanceKindRequirements ConformanceKind = "requirements"
+)
+
+// ConformanceStatus represents the status of a conformance
+type ConformanceStatus string
+
+const (
+	ConformanceStatusDraft   ConformanceStatus = "draft"
+	ConformanceStatusActive  ConformanceStatus = "active"
+	ConformanceStatusRetired ConformanceStatus = "retired"
+)
+
+// ConformanceContact represents contacts to assist a user in finding and communicating with the publisher
+type ConformanceContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the conformance
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// ConformanceSoftware represents software that is covered by this conformance statement
+type ConformanceSoftware struct {
+	common.BackboneElement
+
+	// Name software is known by
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Date this version of the software released
+	ReleaseDate        *common.DateTime `json:"releaseDate,omitempty"`
+	ReleaseDateElement *common.Element  `json:"_releaseDate,omitempty"`
+
+	// The version identifier for the software covered by this statement
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ConformanceImplementation identifies a specific implementation instance that is described by the conformance statement
+type ConformanceImplementation struct {
+	common.BackboneElement
+
+	// Information about the specific installation that this conformance statement relates to
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An absolute base URL for the implementation
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ConformanceRestSecurityCertificate represents certificates associated with security profiles
+type ConformanceRestSecurityCertificate struct {
+	common.BackboneElement
+
+	// Actual certificate
+	Blob        *string         `json:"blob,omitempty"`
+	BlobElement *common.Element `json:"_blob,omitempty"`
+
+	// Mime type for certificate
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// ConformanceRestSecurity represents information about security implementation from an interface perspective - what a client needs to know
+type ConformanceRestSecurity struct {
+	common.BackboneElement
+
+	// Certificates associated with security profiles
+	Certificate []ConformanceRestSecurityCertificate `json:"certificate,omitempty"`
+
+	// Server adds CORS headers when responding to requests - this enables javascript applications to use the server
+	Cors        *bool           `json:"cors,omitempty"`
+	CorsElement *common.Element `json:"_cors,omitempty"`
+
+	// General description of how security works
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Types of security services are supported/required by the system
+	Service []common.CodeableConcept `json:"service,omitempty"`
+}
+
+// ConformanceRestResourceInteraction identifies a restful operation supported by the solution
+type ConformanceRestResourceInteraction struct {
+	common.BackboneElement
+
+	// Coded identifier of the operation, supported by the system resource
+	Code        ConformanceRestResourceInteractionCode `json:"code"`
+	CodeElement *common.Element                        `json:"_code,omitempty"`
+
+	// REST allows a degree of variability in the implementation of RESTful solutions that is useful for exchange partners to be aware of
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+}
+
+// ConformanceRestResourceInteractionCode represents the code of a conformance rest resource interaction
+type ConformanceRestResourceInteractionCode string
+
+const (
+	ConformanceRestResourceInteractionCodeRead            ConformanceRestResourceInteractionCode = "read"
+	ConformanceRestResourceInteractionCodeVread           ConformanceRestResourceInteractionCode = "vread"
+	ConformanceRestResourceInteractionCodeUpdate          ConformanceRestResourceInteractionCode = "update"
+	ConformanceRestResourceInteractionCodeDelete          ConformanceRestResourceInteractionCode = "delete"
+	ConformanceRestResourceInteractionCodeHistoryInstance ConformanceRestResourceInteractionCode = "history-instance"
+	ConformanceRestResourceInteractionCodeValidate        ConformanceRestResourceInteractionCode = "validate"
+	ConformanceRestResourceInteractionCodeHistoryType     ConformanceRestResourceInteractionCode = "history-type"
+	ConformanceRestResourceInteractionCodeCreate          ConformanceRestResourceInteractionCode = "create"
+	ConformanceRestResourceInteractionCodeSearchType      ConformanceRestResourceInteractionCode = "search-type"
+)
+
+// ConformanceRestResourceSearchParam represents search parameters for implementations to support and/or make use of
+type ConformanceRestResourceSearchParam struct {
+	common.BackboneElement
+
+	// Contains the names of any search parameters which may be chained to the containing search parameter
+	Chain        []string          `json:"chain,omitempty"`
+	ChainElement []*common.Element `json:"_chain,omitempty"`
+
+	// An absolute URI that is a formal reference to where this parameter was first defined
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// This allows documentation of any distinct behaviors about how the search parameter is used
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// A modifier supported for the search parameter
+	Modifier        []ConformanceRestResourceSearchParamModifier `json:"modifier,omitempty"`
+	ModifierElement []*common.Element                            `json:"_modifier,omitempty"`
+
+	// The name of the search parameter used in the interface
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Types of resource (if a resource is referenced)
+	Target        []string          `json:"target,omitempty"`
+	TargetElement []*common.Element `json:"_target,omitempty"`
+
+	// The type of value a search parameter refers to, and how the content is interpreted
+	Type        ConformanceRestResourceSearchParamType `json:"type"`
+	TypeElement *common.Element                        `json:"_type,omitempty"`
+}
+
+// ConformanceRestResourceSearchParamModifier represents the modifier of a conformance rest resource search param
+type ConformanceRestResourceSearchParamModifier string
+
+const (
+	ConformanceRestResourceSearchParamModifierMissing  ConformanceRestResourceSearchParamModifier = "missing"
+	ConformanceRestResourceSearchParamModifierExact    ConformanceRestResourceSearchParamModifier = "exact"
+	ConformanceRestResourceSearchParamModifierContains ConformanceRestResourceSearchParamModifier = "contains"
+	ConformanceRestResourceSearchParamModifierNot      ConformanceRestResourceSearchParamModifier = "not"
+	ConformanceRestResourceSearchParamModifierText     ConformanceRestResourceSearchParamModifier = "text"
+	ConformanceRestResourceSearchParamModifierIn       ConformanceRestResourceSearchParamModifier = "in"
+	ConformanceRestResourceSearchParamModifierNotIn    ConformanceRestResourceSearchParamModifier = "not-in"
+	ConformanceRestResourceSearchParamModifierBelow    ConformanceRestResourceSearchParamModifier = "below"
+	ConformanceRestResourceSearchParamModifierAbove    ConformanceRestResourceSearchParamModifier = "above"
+	ConformanceRestResourceSearchParamModifierType     ConformanceRestResourceSearchParamModifier = "type"
+)
+
+// ConformanceRestResourceSearchParamType represents the type of a conformance rest resource search param
+type ConformanceRestResourceSearchParamType string
+
+const (
+	ConformanceRestResourceSearchParamTypeNumber    ConformanceRestResourceSearchParamType = "number"
+	ConformanceRestResourceSearchParamTypeDate      ConformanceRestResourceSearchParamType = "date"
+	ConformanceRestResourceSearchParamTypeString    ConformanceRestResourceSearchParamType = "string"
+	ConformanceRestResourceSearchParamTypeToken     ConformanceRestResourceSearchParamType = "token"
+	ConformanceRestResourceSearchParamTypeReference ConformanceRestResourceSearchParamType = "reference"
+	ConformanceRestResourceSearchParamTypeComposite ConformanceRestResourceSearchParamType = "composite"
+	ConformanceRestResourceSearchParamTypeQuantity  ConformanceRestResourceSearchParamType = "quantity"
+	ConformanceRestResourceSearchParamTypeUri       ConformanceRestResourceSearchParamType = "uri"
+)
+
+// ConformanceRestResource represents a specification of the restful capabilities of the solution for a specific resource type
+type ConformanceRestResource struct {
+	common.BackboneElement
+
+	// A flag that indicates that the server supports conditional create
+	ConditionalCreate        *bool           `json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *common.Element `json:"_conditionalCreate,omitempty"`
+
+	// A code that indicates how the server supports conditional delete
+	ConditionalDelete        *ConformanceRestResourceConditionalDelete `json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *common.Element                           `json:"_conditionalDelete,omitempty"`
+
+	// A flag that indicates that the server supports conditional update
+	ConditionalUpdate        *bool           `json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *common.Element `json:"_conditionalUpdate,omitempty"`
+
+	// Identifies a restful operation supported by the solution
+	Interaction []ConformanceRestResourceInteraction `json:"interaction"`
+
+	// A specification of the profile that describes the solution's overall support for the resource
+	Profile *common.Reference `json:"profile,omitempty"`
+
+	// A flag for whether the server is able to return past versions as part of the vRead operation
+	ReadHistory        *bool           `json:"readHistory,omitempty"`
+	ReadHistoryElement *common.Element `json:"_readHistory,omitempty"`
+
+	// A list of _include values supported by the server
+	SearchInclude        []string          `json:"searchInclude,omitempty"`
+	SearchIncludeElement []*common.Element `json:"_searchInclude,omitempty"`
+
+	// Search parameters for implementations to support and/or make use of
+	SearchParam []ConformanceRestResourceSearchParam `json:"searchParam,omitempty"`
+
+	// A list of _revinclude (reverse include) values supported by the server
+	SearchRevInclude        []string          `json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement []*common.Element `json:"_searchRevInclude,omitempty"`
+
+	// A type of resource exposed via the restful interface
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// A flag to indicate that the server allows or needs to allow the client to create new identities on the server
+	UpdateCreate        *bool           `json:"updateCreate,omitempty"`
+	UpdateCreateElement *common.Element `json:"_updateCreate,omitempty"`
+
+	// This field is set to no-version to specify that the system does not support (server) or use
+	Versioning        *ConformanceRestResourceVersioning `json:"versioning,omitempty"`
+	VersioningElement *common.Element                    `json:"_versioning,omitempty"`
+}
+
+// ConformanceRestResourceConditionalDelete represents the conditional delete of a conformance rest resource
+type ConformanceRestResourceConditionalDelete string
+
+const (
+	ConformanceRestResourceConditionalDeleteNotSupported ConformanceRestResourceConditionalDelete = "not-supported"
+	ConformanceRestResourceConditionalDeleteSingle       ConformanceRestResourceConditionalDelete = "single"
+	ConformanceRestResourceConditionalDeleteMultiple     ConformanceRestResourceConditionalDelete = "multiple"
+)
+
+// ConformanceRestResourceVersioning represents the versioning of a conformance rest resource
+type ConformanceRestResourceVersioning string
+
+const (
+	ConformanceRestResourceVersioningNoVersion       ConformanceRestResourceVersioning = "no-version"
+	ConformanceRestResourceVersioningVersioned       ConformanceRestResourceVersioning = "versioned"
+	ConformanceRestResourceVersioningVersionedUpdate ConformanceRestResourceVersioning = "versioned-update"
+)
+
+// ConformanceRestInteraction represents a specification of restful operations supported by the system
+type ConformanceRestInteraction struct {
+	common.BackboneElement
+
+	// A coded identifier of the operation, supported by the system
+	Code        ConformanceRestInteractionCode `json:"code"`
+	CodeElement *common.Element                `json:"_code,omitempty"`
+
+	// Guidance specific to the implementation of this operation, such as limitations on the kind of transactions allowed
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+}
+
+// ConformanceRestInteractionCode represents the code of a conformance rest interaction
+type ConformanceRestInteractionCode string
+
+const (
+	ConformanceRestInteractionCodeTransaction   ConformanceRestInteractionCode = "transaction"
+	ConformanceRestInteractionCodeSearchSystem  ConformanceRestInteractionCode = "search-system"
+	ConformanceRestInteractionCodeHistorySystem ConformanceRestInteractionCode = "history-system"
+)
+
+// ConformanceRestOperation represents definition of an operation or a named query and with its parameters and their meaning and type
+type ConformanceRestOperation struct {
+	common.BackboneElement
+
+	// Where the formal definition can be found
+	Definition *common.Reference `json:"definition"`
+
+	// The name of a query, which is used in the _query parameter when the query is called
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// ConformanceRest represents a definition of the restful capabilities of the solution, if any
+type ConformanceRest struct {
+	common.BackboneElement
+
+	// An absolute URI which is a reference to the definition of a compartment hosted by the system
+	Compartment        []string          `json:"compartment,omitempty"`
+	CompartmentElement []*common.Element `json:"_compartment,omitempty"`
+
+	// Information about the system's restful capabilities that apply across all applications, such as security
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// A specification of restful operations supported by the system
+	Interaction []ConformanceRestInteraction `json:"interaction,omitempty"`
+
+	// Identifies whether this portion of the statement is describing ability to initiate or receive restful operations
+	Mode        ConformanceRestMode `json:"mode"`
+	ModeElement *common.Element     `json:"_mode,omitempty"`
+
+	// Definition of an operation or a named query and with its parameters and their meaning and type
+	Operation []ConformanceRestOperation `json:"operation,omitempty"`
+
+	// A specification of the restful capabilities of the solution for a specific resource type
+	Resource []ConformanceRestResource `json:"resource"`
+
+	// Search parameters that are supported for searching all resources for implementations to support and/or make use of
+	SearchParam []ConformanceRestResourceSearchParam `json:"searchParam,omitempty"`
+
+	// Information about security implementation from an interface perspective - what a client needs to know
+	Security *ConformanceRestSecurity `json:"security,omitempty"`
+
+	// A code that indicates how transactions are supported
+	TransactionMode        *ConformanceRestTransactionMode `json:"transactionMode,omitempty"`
+	TransactionModeElement *common.Element                 `json:"_transactionMode,omitempty"`
+}
+
+// ConformanceRestMode represents the mode of a conformance rest
+type ConformanceRestMode string
+
+const (
+	ConformanceRestModeClient ConformanceRestMode = "client"
+	ConformanceRestModeServer ConformanceRestMode = "server"
+)
+
+// ConformanceRestTransactionMode represents the transaction mode of a conformance rest
+type ConformanceRestTransactionMode string
+
+const (
+	ConformanceRestTransactionModeNotSupported ConformanceRestTransactionMode = "not-supported"
+	ConformanceRestTransactionModeBatch        ConformanceRestTransactionMode = "batch"
+	ConformanceRestTransactionModeTransaction  ConformanceRestTransactionMode = "transaction"
+	ConformanceRestTransactionModeBoth         ConformanceRestTransactionMode = "both"
+)
+
+// ConformanceMessagingEndpoint represents an endpoint (network accessible address) to which messages and/or replies are to be sent
+type ConformanceMessagingEndpoint struct {
+	common.BackboneElement
+
+	// The network address of the end-point
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	// A list of the messaging transport protocol(s) identifiers, supported by this endpoint
+	Protocol *common.Coding `json:"protocol"`
+}
+
+// ConformanceMessagingEvent represents a description of the solution's support for an event at this end-point
+type ConformanceMessagingEvent struct {
+	common.BackboneElement
+
+	// The impact of the content of the message
+	Category        *ConformanceMessagingEventCategory `json:"category,omitempty"`
+	CategoryElement *common.Element                    `json:"_category,omitempty"`
+
+	// A coded identifier of a supported messaging event
+	Code *common.Coding `json:"code"`
+
+	// Guidance on how this event is handled, such as internal system trigger points, business rules, etc
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// A resource associated with the event
+	Focus        string          `json:"focus"`
+	FocusElement *common.Element `json:"_focus,omitempty"`
+
+	// The mode of this event declaration - whether application is sender or receiver
+	Mode        ConformanceMessagingEventMode `json:"mode"`
+	ModeElement *common.Element               `json:"_mode,omitempty"`
+
+	// Information about the request for this event
+	Request *common.Reference `json:"request"`
+
+	// Information about the response for this event
+	Response *common.Reference `json:"response"`
+}
+
+// ConformanceMessagingEventCategory represents the category of a conformance messaging event
+type ConformanceMessagingEventCategory string
+
+const (
+	ConformanceMessagingEventCategoryConsequence  ConformanceMessagingEventCategory = "Consequence"
+	ConformanceMessagingEventCategoryCurrency     ConformanceMessagingEventCategory = "Currency"
+	ConformanceMessagingEventCategoryNotification ConformanceMessagingEventCategory = "Notification"
+)
+
+// ConformanceMessagingEventMode represents the mode of a conformance messaging event
+type ConformanceMessagingEventMode string
+
+const (
+	ConformanceMessagingEventModeSender   ConformanceMessagingEventMode = "sender"
+	ConformanceMessagingEventModeReceiver ConformanceMessagingEventMode = "receiver"
+)
+
+// ConformanceMessaging represents a description of the messaging capabilities of the solution
+type ConformanceMessaging struct {
+	common.BackboneElement
+
+	// Documentation about the system's messaging capabilities for this endpoint not otherwise documented by the conformance statement
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// An endpoint (network accessible address) to which messages and/or replies are to be sent
+	Endpoint []ConformanceMessagingEndpoint `json:"endpoint,omitempty"`
+
+	// A description of the solution's support for an event at this end-point
+	Event []ConformanceMessagingEvent `json:"event"`
+
+	// Length if the receiver's reliable messaging cache in minutes
+	ReliableCache        *int            `json:"reliableCache,omitempty"`
+	ReliableCacheElement *common.Element `json:"_reliableCache,omitempty"`
+}
+
+// ConformanceDocument represents a document definition
+type ConformanceDocument struct {
+	common.BackboneElement
+
+	// A description of how the application supports or uses the specified document profile
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Mode of this document declaration - whether application is producer or consumer
+	Mode        ConformanceDocumentMode `json:"mode"`
+	ModeElement *common.Element         `json:"_mode,omitempty"`
+
+	// A constraint on a resource used in the document
+	Profile *common.Reference `json:"profile"`
+}
+
+// ConformanceDocumentMode represents the mode of a conformance document
+type ConformanceDocumentMode string
+
+const (
+	ConformanceDocumentModeProducer ConformanceDocumentMode = "producer"
+	ConformanceDocumentModeConsumer ConformanceDocumentMode = "consumer"
+)
@@ -0,0 +1,239 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Contract represents a formal agreement between parties regarding the conduct of business, exchange of information or other matters
+type Contract struct {
+	DomainResource
+
+	// Action stipulated by this Contract
+	Action []common.CodeableConcept `json:"action,omitempty"`
+
+	// Reason for action stipulated by this Contract
+	ActionReason []common.CodeableConcept `json:"actionReason,omitempty"`
+
+	// List of Contract actors
+	Actor []ContractActor `json:"actor,omitempty"`
+
+	// Relevant time or time-period when this Contract is applicable
+	Applies *common.Period `json:"applies,omitempty"`
+
+	// A formally or informally recognized grouping of people, principals, organizations
+	Authority []common.Reference `json:"authority,omitempty"`
+
+	// Legally binding Contract: This is the signed and legally recognized representation of the Contract
+	BindingAttachment *Attachment       `json:"bindingAttachment,omitempty"`
+	BindingReference  *common.Reference `json:"bindingReference,omitempty"`
+
+	// Recognized governance framework or system operating with a circumscribed scope in accordance with specified principles
+	Domain []common.Reference `json:"domain,omitempty"`
+
+	// The "patient friendly language" versionof the Contract in whole or in parts
+	Friendly []ContractFriendly `json:"friendly,omitempty"`
+
+	// Unique identifier for this Contract
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// When this Contract was issued
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
+
+	// List of Legal expressions or representations of this Contract
+	Legal []ContractLegal `json:"legal,omitempty"`
+
+	// List of Computable Policy Rule Language Representations of this Contract
+	Rule []ContractRule `json:"rule,omitempty"`
+
+	// Party signing this Contract
+	Signer []ContractSigner `json:"signer,omitempty"`
+
+	// Who and/or what this Contract is about: typically a Patient, Organization, or valued items such as goods and services
+	Subject []common.Reference `json:"subject,omitempty"`
+
+	// More specific type or specialization of an overarching or more general contract such as auto insurance
+	SubType []common.CodeableConcept `json:"subType,omitempty"`
+
+	// One or more Contract Provisions, which may be related and conveyed as a group, and may contain nested groups
+	Term []ContractTerm `json:"term,omitempty"`
+
+	// Type of Contract such as an insurance policy, real estate contract, a will, power of attorny
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Contract Valued Item List
+	ValuedItem []ContractValuedItem `json:"valuedItem,omitempty"`
+}
+
+// ContractActor represents list of Contract actors
+type ContractActor struct {
+	common.BackboneElement
+
+	// Who or what actors are assigned roles in this Contract
+	Entity *common.Reference `json:"entity"`
+
+	// Role type of actors assigned roles in this Contract
+	Role []common.CodeableConcept `json:"role,omitempty"`
+}
+
+// ContractValuedItem represents Contract Valued Item List
+type ContractValuedItem struct {
+	common.BackboneElement
+
+	// Indicates the time during which this Contract ValuedItem information is effective
+	EffectiveTime        *common.DateTime `json:"effectiveTime,omitempty"`
+	EffectiveTimeElement *common.Element  `json:"_effectiveTime,omitempty"`
+
+	// Specific type of Contract Valued Item that may be priced
+	EntityCodeableConcept *common.CodeableConcept `json:"entityCodeableConcept,omitempty"`
+	EntityReference       *common.Reference       `json:"entityReference,omitempty"`
+
+	// A real number that represents a multiplier used in determining the overall value of the Contract Valued Item delivered
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Identifies a Contract Valued Item instance
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Expresses the product of the Contract Valued Item unitQuantity and the unitPriceAmt
+	Net *common.Quantity `json:"net,omitempty"`
+
+	// An amount that expresses the weighting
+	Points        *common.Decimal `json:"points,omitempty"`
+	PointsElement *common.Element `json:"_points,omitempty"`
+
+	// Specifies the units by which the Contract Valued Item is measured or counted
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// A Contract Valued Item unit valuation measure
+	UnitPrice *common.Quantity `json:"unitPrice,omitempty"`
+}
+
+// ContractSigner represents party signing this Contract
+type ContractSigner struct {
+	common.BackboneElement
+
+	// Party which is a signator to this Contract
+	Party *common.Reference `json:"party"`
+
+	// Legally binding Contract DSIG signature contents in Base64
+	Signature        string          `json:"signature"`
+	SignatureElement *common.Element `json:"_signature,omitempty"`
+
+	// Role of this Contract signer, e.g. notary, grantee
+	Type *common.Coding `json:"type"`
+}
+
+// ContractTermActor represents list of actors participating in this Contract Provision
+type ContractTermActor struct {
+	common.BackboneElement
+
+	// The actor assigned a role in this Contract Provision
+	Entity *common.Reference `json:"entity"`
+
+	// Role played by the actor assigned this role in this Contract Provision
+	Role []common.CodeableConcept `json:"role,omitempty"`
+}
+
+// ContractTermValuedItem represents Contract Provision Valued Item List
+type ContractTermValuedItem struct {
+	common.BackboneElement
+
+	// Indicates the time during which this Contract Term ValuedItem information is effective
+	EffectiveTime        *common.DateTime `json:"effectiveTime,omitempty"`
+	EffectiveTimeElement *common.Element  `json:"_effectiveTime,omitempty"`
+
+	// Specific type of Contract Provision Valued Item that may be priced
+	EntityCodeableConcept *common.CodeableConcept `json:"entityCodeableConcept,omitempty"`
+	EntityReference       *common.Reference       `json:"entityReference,omitempty"`
+
+	// A real number that represents a multiplier used in determining the overall value of the Contract Provision Valued Item delivered
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Identifies a Contract Provision Valued Item instance
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Expresses the product of the Contract Provision Valued Item unitQuantity and the unitPriceAmt
+	Net *common.Quantity `json:"net,omitempty"`
+
+	// An amount that expresses the weighting
+	Points        *common.Decimal `json:"points,omitempty"`
+	PointsElement *common.Element `json:"_points,omitempty"`
+
+	// Specifies the units by which the Contract Provision Valued Item is measured or counted
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// A Contract Provision Valued Item unit valuation measure
+	UnitPrice *common.Quantity `json:"unitPrice,omitempty"`
+}
+
+// ContractTerm represents one or more Contract Provisions, which may be related and conveyed as a group, and may contain nested groups
+type ContractTerm struct {
+	common.BackboneElement
+
+	// Action stipulated by this Contract Provision
+	Action []common.CodeableConcept `json:"action,omitempty"`
+
+	// Reason or purpose for the action stipulated by this Contract Provision
+	ActionReason []common.CodeableConcept `json:"actionReason,omitempty"`
+
+	// List of actors participating in this Contract Provision
+	Actor []ContractTermActor `json:"actor,omitempty"`
+
+	// Relevant time or time-period when this Contract Provision is applicable
+	Applies *common.Period `json:"applies,omitempty"`
+
+	// Nested group of Contract Provisions
+	Group []ContractTerm `json:"group,omitempty"`
+
+	// Unique identifier for this particular Contract Provision
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// When this Contract Provision was issued
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
+
+	// Who or what this Contract Provision is about
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Subtype of this Contract Provision, e.g. life time maximum payment for a contract term for specific valued item
+	SubType *common.CodeableConcept `json:"subType,omitempty"`
+
+	// Human readable form of this Contract Provision
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Type of Contract Provision such as specific requirements, purposes for actions, obligations, prohibitions
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Contract Provision Valued Item List
+	ValuedItem []ContractTermValuedItem `json:"valuedItem,omitempty"`
+}
+
+// ContractFriendly represents the friendly of a contract
+type ContractFriendly struct {
+	common.BackboneElement
+
+	// Human readable rendering of this Contract in a format and representation intended to enhance comprehension and ensure understandability
+	ContentAttachment *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference  *common.Reference `json:"contentReference,omitempty"`
+}
+
+// ContractLegal represents list of Legal expressions or representations of this Contract
+type ContractLegal struct {
+	common.BackboneElement
+
+	// Contract legal text in human renderable form
+	ContentAttachment *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference  *common.Reference `json:"contentReference,omitempty"`
+}
+
+// ContractRule represents list of Computable Policy Rule Language Representations of this Contract
+type ContractRule struct {
+	common.BackboneElement
+
+	// Computable Contract conveyed using a policy rule language (e.g. XACML, DKAL, SecPal)
+	ContentAttachment *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference  *common.Reference `json:"contentReference,omitempty"`
+}
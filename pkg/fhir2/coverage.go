@@ -0,0 +1,57 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Coverage represents insurance or medical plan or a payment agreement
+type Coverage struct {
+	DomainResource
+
+	// Business Identification Number (BIN number) used to identify the routing of eclaims if the insurer themselves don't have a BIN number for all of their business
+	Bin *common.Identifier `json:"bin,omitempty"`
+
+	// Contract details
+	Contract []common.Reference `json:"contract,omitempty"`
+
+	// Dependent number
+	Dependent        *int            `json:"dependent,omitempty"`
+	DependentElement *common.Element `json:"_dependent,omitempty"`
+
+	// Identifies a style or collective of coverage issues by the underwriter
+	Group        *string         `json:"group,omitempty"`
+	GroupElement *common.Element `json:"_group,omitempty"`
+
+	// Business identifier(s) for this coverage
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Need to identify the issuer to target for processing and for coordination of benefit processing
+	Issuer *common.Reference `json:"issuer,omitempty"`
+
+	// Insurer network
+	Network *common.Identifier `json:"network,omitempty"`
+
+	// Coverage start and end dates
+	Period *common.Period `json:"period,omitempty"`
+
+	// Identifies a style or collective of coverage issues by the underwriter
+	Plan        *string         `json:"plan,omitempty"`
+	PlanElement *common.Element `json:"_plan,omitempty"`
+
+	// Some coverage, for example social plans, may be offered in short time increments
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// Identifies a sub-style or sub-collective of coverage issues by the underwriter
+	SubPlan        *string         `json:"subPlan,omitempty"`
+	SubPlanElement *common.Element `json:"_subPlan,omitempty"`
+
+	// Subscriber to the policy
+	Subscriber *common.Reference `json:"subscriber,omitempty"`
+
+	// ID assigned to the subscriber
+	SubscriberId *common.Identifier `json:"subscriberId,omitempty"`
+
+	// Coverage category such as medical or accident
+	Type *common.Coding `json:"type,omitempty"`
+}
@@ -0,0 +1,115 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DataElement represents the formal description of a single piece of information that can be gathered and reported
+type DataElement struct {
+	DomainResource
+
+	// Contacts to assist a user in finding and communicating with the publisher
+	Contact []DataElementContact `json:"contact,omitempty"`
+
+	// A copyright statement relating to the definition of the data element
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// The date this version of the data element was published
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// 1
+	Element []ElementDefinition `json:"element"`
+
+	// A flag to indicate that this search data element definition is authored for testing purposes
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Formal identifier that is used to identify this data element when it is represented in other formats
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Identifies a specification (other than a terminology) that the elements which make up the DataElement have some correspondence with
+	Mapping []DataElementMapping `json:"mapping,omitempty"`
+
+	// The term used by humans to refer to the data element
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Helps establish the "authority/credibility" of the data element
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Allows filtering of data elements that are appropriate for use
+	Status        DataElementStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// Allows determining the degree of comparability of data element instances
+	Stringency        *DataElementStringency `json:"stringency,omitempty"`
+	StringencyElement *common.Element        `json:"_stringency,omitempty"`
+
+	// An absolute URL that is used to identify this data element when it is referenced in a specification, model
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The content was developed with a focus and intent of supporting the contexts that are listed
+	UseContext []common.CodeableConcept `json:"useContext,omitempty"`
+
+	// There may be multiple resource versions of the data element that have this same identifier
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// DataElementStatus represents the status of a data element
+type DataElementStatus string
+
+const (
+	DataElementStatusDraft   DataElementStatus = "draft"
+	DataElementStatusActive  DataElementStatus = "active"
+	DataElementStatusRetired DataElementStatus = "retired"
+)
+
+// DataElementStringency represents the stringency of a data element
+type DataElementStringency string
+
+const (
+	DataElementStringencyComparable     DataElementStringency = "comparable"
+	DataElementStringencyFullySpecified DataElementStringency = "fully-specified"
+	DataElementStringencyEquivalent     DataElementStringency = "equivalent"
+	DataElementStringencyConvertable    DataElementStringency = "convertable"
+	DataElementStringencyScaleable      DataElementStringency = "scaleable"
+	DataElementStringencyFlexible       DataElementStringency = "flexible"
+)
+
+// DataElementContact represents contacts to assist a user in finding and communicating with the publisher
+type DataElementContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the data element
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// DataElementMapping identifies a specification (other than a terminology) that the elements which make up the DataElement have some correspondence with
+type DataElementMapping struct {
+	common.BackboneElement
+
+	// Comments about this mapping, including version notes, issues, scope limitations, and other important notes for usage
+	Comments        *string         `json:"comments,omitempty"`
+	CommentsElement *common.Element `json:"_comments,omitempty"`
+
+	// An internal id that is used to identify this mapping set when specific mappings are made on a per-element basis
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
+
+	// A name for the specification that is being mapped to
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// An absolute URI that identifies the specification that this mapping is expressed to
+	URI        *string         `json:"uri,omitempty"`
+	URIElement *common.Element `json:"_uri,omitempty"`
+}
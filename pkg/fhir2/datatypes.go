@@ -2,8 +2,6 @@
 package fhir2
 
 import (
-	"time"
-
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
@@ -13,34 +11,43 @@ type Address struct {
 	common.Element
 
 	// The name of the city, town, village or other community or delivery center
-	City *string `json:"city,omitempty"`
+	City        *string         `json:"city,omitempty"`
+	CityElement *common.Element `json:"_city,omitempty"`
 
 	// Country - a nation as commonly understood or generally accepted
-	Country *string `json:"country,omitempty"`
+	Country        *string         `json:"country,omitempty"`
+	CountryElement *common.Element `json:"_country,omitempty"`
 
 	// The name of the administrative area (county)
-	District *string `json:"district,omitempty"`
+	District        *string         `json:"district,omitempty"`
+	DistrictElement *common.Element `json:"_district,omitempty"`
 
 	// This component contains the house number, apartment number, street name, street direction
-	Line []string `json:"line,omitempty"`
+	Line        []string          `json:"line,omitempty"`
+	LineElement []*common.Element `json:"_line,omitempty"`
 
 	// Allows addresses to be placed in historical context
 	Period *common.Period `json:"period,omitempty"`
 
 	// A postal code designating a region defined by the postal service
-	PostalCode *string `json:"postalCode,omitempty"`
+	PostalCode        *string         `json:"postalCode,omitempty"`
+	PostalCodeElement *common.Element `json:"_postalCode,omitempty"`
 
 	// Sub-unit of a country with limited sovereignty in a federally organized country
-	State *string `json:"state,omitempty"`
+	State        *string         `json:"state,omitempty"`
+	StateElement *common.Element `json:"_state,omitempty"`
 
 	// A renderable, unencoded form
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// postal | physical | both - Distinguishes between physical and mailing addresses
-	Type *AddressType `json:"type,omitempty"`
+	Type        *AddressType    `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// home | work | temp | old - purpose of this address
-	Use *AddressUse `json:"use,omitempty"`
+	Use        *AddressUse     `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 }
 
 // AddressType represents the type of address (R2 version)
@@ -72,14 +79,17 @@ type Annotation struct {
 	common.Element
 
 	// The individual responsible for making the annotation
-	AuthorReference *common.Reference `json:"authorReference,omitempty"`
-	AuthorString    *string           `json:"authorString,omitempty"`
+	AuthorReference     *common.Reference `json:"authorReference,omitempty"`
+	AuthorString        *string           `json:"authorString,omitempty"`
+	AuthorStringElement *common.Element   `json:"_authorString,omitempty"`
 
 	// The text of the annotation
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Indicates when this particular annotation was made
-	Time *time.Time `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 }
 
 // Attachment represents data content defined in other formats
@@ -87,28 +97,36 @@ type Attachment struct {
 	common.Element
 
 	// Processors of the data need to be able to know how to interpret the data
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// This is often tracked as an integrity issue for use of the attachment
-	Creation *time.Time `json:"creation,omitempty"`
+	Creation        *common.DateTime `json:"creation,omitempty"`
+	CreationElement *common.Element  `json:"_creation,omitempty"`
 
 	// The data needs to able to be transmitted inline
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// Included so that applications can verify that the contents have not changed
-	Hash *string `json:"hash,omitempty"`
+	Hash        *string         `json:"hash,omitempty"`
+	HashElement *common.Element `json:"_hash,omitempty"`
 
 	// Users need to be able to choose between the languages in a set of attachments
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 
 	// Representing the size allows applications to determine whether they should fetch the content
-	Size *int `json:"size,omitempty"`
+	Size        *int            `json:"size,omitempty"`
+	SizeElement *common.Element `json:"_size,omitempty"`
 
 	// Applications need a label to display to a human user in place of the actual data
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The data needs to be transmitted by reference
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // ContactPoint represents details for technology mediated contact points
@@ -119,16 +137,20 @@ type ContactPoint struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// Specifies a preferred order in which to use a set of contacts
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// phone | fax | email | pager | other - Telecommunications form for contact point
-	System *ContactPointSystem `json:"system,omitempty"`
+	System        *ContactPointSystem `json:"system,omitempty"`
+	SystemElement *common.Element     `json:"_system,omitempty"`
 
 	// home | work | temp | old | mobile - The use of a contact point
-	Use *ContactPointUse `json:"use,omitempty"`
+	Use        *ContactPointUse `json:"use,omitempty"`
+	UseElement *common.Element  `json:"_use,omitempty"`
 
 	// The actual contact point details
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ContactPointSystem represents telecommunications form for contact point (R2 version)
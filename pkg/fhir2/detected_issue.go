@@ -0,0 +1,68 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DetectedIssue represents clinical issue with action
+type DetectedIssue struct {
+	DomainResource
+
+	// The provider or device that identified the issue
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Type of detected issue, e.g. drug-drug, duplicate therapy, etc
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// No-one can be responsible for mitigation prior to the issue being identified
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Description and context
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
+
+	// Unique id for the detected issue
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Problem resource
+	Implicated []common.Reference `json:"implicated,omitempty"`
+
+	// Step taken to address
+	Mitigation []DetectedIssueMitigation `json:"mitigation,omitempty"`
+
+	// While the subject could be inferred by tracing the subject of the implicated resources
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Authority for issue
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+
+	// high | moderate | low
+	Severity        *DetectedIssueSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element        `json:"_severity,omitempty"`
+}
+
+// DetectedIssueSeverity represents the severity of a detected issue
+type DetectedIssueSeverity string
+
+const (
+	DetectedIssueSeverityHigh     DetectedIssueSeverity = "high"
+	DetectedIssueSeverityModerate DetectedIssueSeverity = "moderate"
+	DetectedIssueSeverityLow      DetectedIssueSeverity = "low"
+)
+
+// DetectedIssueMitigation represents step taken to address
+type DetectedIssueMitigation struct {
+	common.BackboneElement
+
+	// What mitigation?
+	Action *common.CodeableConcept `json:"action"`
+
+	// Who is committing?
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Date committed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+}
@@ -0,0 +1,74 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceComponent describes the characteristics, operational status and capabilities of a medical-related component of a medical device
+type DeviceComponent struct {
+	DomainResource
+
+	// Describes the local assigned unique identification by the software
+	Identifier *common.Identifier `json:"identifier"`
+
+	// Describes the language code for the human-readable text string produced by the device
+	LanguageCode *common.CodeableConcept `json:"languageCode,omitempty"`
+
+	// Describes the timestamp for the most recent system change which includes device configuration or setting change
+	LastSystemChange        common.Instant  `json:"lastSystemChange"`
+	LastSystemChangeElement *common.Element `json:"_lastSystemChange,omitempty"`
+
+	// Describes the physical principle of the measurement
+	MeasurementPrinciple        *DeviceComponentMeasurementPrinciple `json:"measurementPrinciple,omitempty"`
+	MeasurementPrincipleElement *common.Element                      `json:"_measurementPrinciple,omitempty"`
+
+	// Indicates current operational status of the device
+	OperationalStatus []common.CodeableConcept `json:"operationalStatus,omitempty"`
+
+	// Describes the parameter group supported by the current device component that is based on some nomenclature
+	ParameterGroup *common.CodeableConcept `json:"parameterGroup,omitempty"`
+
+	// Describes the link to the parent resource
+	Parent *common.Reference `json:"parent,omitempty"`
+
+	// Describes the production specification such as component revision, serial number, etc
+	ProductionSpecification []DeviceComponentProductionSpecification `json:"productionSpecification,omitempty"`
+
+	// Describes the link to the source Device that contains administrative device information such as manufacture
+	Source *common.Reference `json:"source,omitempty"`
+
+	// Describes the specific component type as defined in the object-oriented or metric nomenclature partition
+	Type *common.CodeableConcept `json:"type"`
+}
+
+// DeviceComponentMeasurementPrinciple represents the measurement principle of a device component
+type DeviceComponentMeasurementPrinciple string
+
+const (
+	DeviceComponentMeasurementPrincipleOther      DeviceComponentMeasurementPrinciple = "other"
+	DeviceComponentMeasurementPrincipleChemical   DeviceComponentMeasurementPrinciple = "chemical"
+	DeviceComponentMeasurementPrincipleElectrical DeviceComponentMeasurementPrinciple = "electrical"
+	DeviceComponentMeasurementPrincipleImpedance  DeviceComponentMeasurementPrinciple = "impedance"
+	DeviceComponentMeasurementPrincipleNuclear    DeviceComponentMeasurementPrinciple = "nuclear"
+	DeviceComponentMeasurementPrincipleOptical    DeviceComponentMeasurementPrinciple = "optical"
+	DeviceComponentMeasurementPrincipleThermal    DeviceComponentMeasurementPrinciple = "thermal"
+	DeviceComponentMeasurementPrincipleBiological DeviceComponentMeasurementPrinciple = "biological"
+	DeviceComponentMeasurementPrincipleMechanical DeviceComponentMeasurementPrinciple = "mechanical"
+	DeviceComponentMeasurementPrincipleAcoustical DeviceComponentMeasurementPrinciple = "acoustical"
+	DeviceComponentMeasurementPrincipleManual     DeviceComponentMeasurementPrinciple = "manual"
+)
+
+// DeviceComponentProductionSpecification describes the production specification such as component revision, serial number, etc
+type DeviceComponentProductionSpecification struct {
+	common.BackboneElement
+
+	// Describes the internal component unique identification
+	ComponentId *common.Identifier `json:"componentId,omitempty"`
+
+	// Describes the printable string defining the component
+	ProductionSpec        *string         `json:"productionSpec,omitempty"`
+	ProductionSpecElement *common.Element `json:"_productionSpec,omitempty"`
+
+	// Describes the specification type, such as, serial number, part number, hardware revision, software revision, etc
+	SpecType *common.CodeableConcept `json:"specType,omitempty"`
+}
@@ -0,0 +1,113 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceMetric represents measurement, calculation or setting capability of a medical device
+type DeviceMetric struct {
+	DomainResource
+
+	// Describes the calibrations that have been performed or that are required to be performed
+	Calibration []DeviceMetricCalibration `json:"calibration,omitempty"`
+
+	// measurement | setting | calculation | unspecified
+	Category        DeviceMetricCategory `json:"category"`
+	CategoryElement *common.Element      `json:"_category,omitempty"`
+
+	// Color name (from CSS4) or #RRGGBB code
+	Color        *DeviceMetricColor `json:"color,omitempty"`
+	ColorElement *common.Element    `json:"_color,omitempty"`
+
+	// Instance identifier
+	Identifier *common.Identifier `json:"identifier"`
+
+	// Describes the measurement repetition time
+	MeasurementPeriod *Timing `json:"measurementPeriod,omitempty"`
+
+	// on | off | standby | entered-in-error
+	OperationalStatus        *DeviceMetricOperationalStatus `json:"operationalStatus,omitempty"`
+	OperationalStatusElement *common.Element                `json:"_operationalStatus,omitempty"`
+
+	// Describes the link to the DeviceComponent that this DeviceMetric belongs to and that provide information about the location of this DeviceMetric in the containment structure of the parent Device
+	Parent *common.Reference `json:"parent,omitempty"`
+
+	// Describes the link to the Device that this DeviceMetric belongs to and that contains administrative device information such as manufacture, serial number, etc
+	Source *common.Reference `json:"source,omitempty"`
+
+	// Identity of metric, for example Heart Rate or PEEP Setting
+	Type *common.CodeableConcept `json:"type"`
+
+	// Unit of Measure for the Metric
+	Unit *common.CodeableConcept `json:"unit,omitempty"`
+}
+
+// DeviceMetricCategory represents the category of a device metric
+type DeviceMetricCategory string
+
+const (
+	DeviceMetricCategoryMeasurement DeviceMetricCategory = "measurement"
+	DeviceMetricCategorySetting     DeviceMetricCategory = "setting"
+	DeviceMetricCategoryCalculation DeviceMetricCategory = "calculation"
+	DeviceMetricCategoryUnspecified DeviceMetricCategory = "unspecified"
+)
+
+// DeviceMetricColor represents the color of a device metric
+type DeviceMetricColor string
+
+const (
+	DeviceMetricColorBlack   DeviceMetricColor = "black"
+	DeviceMetricColorRed     DeviceMetricColor = "red"
+	DeviceMetricColorGreen   DeviceMetricColor = "green"
+	DeviceMetricColorYellow  DeviceMetricColor = "yellow"
+	DeviceMetricColorBlue    DeviceMetricColor = "blue"
+	DeviceMetricColorMagenta DeviceMetricColor = "magenta"
+	DeviceMetricColorCyan    DeviceMetricColor = "cyan"
+	DeviceMetricColorWhite   DeviceMetricColor = "white"
+)
+
+// DeviceMetricOperationalStatus represents the operational status of a device metric
+type DeviceMetricOperationalStatus string
+
+const (
+	DeviceMetricOperationalStatusOn      DeviceMetricOperationalStatus = "on"
+	DeviceMetricOperationalStatusOff     DeviceMetricOperationalStatus = "off"
+	DeviceMetricOperationalStatusStandby DeviceMetricOperationalStatus = "standby"
+)
+
+// DeviceMetricCalibration describes the calibrations that have been performed or that are required to be performed
+type DeviceMetricCalibration struct {
+	common.BackboneElement
+
+	// not-calibrated | calibration-required | calibrated | unspecified
+	State        *DeviceMetricCalibrationState `json:"state,omitempty"`
+	StateElement *common.Element               `json:"_state,omitempty"`
+
+	// Describes the time last calibration has been performed
+	Time        *common.Instant `json:"time,omitempty"`
+	TimeElement *common.Element `json:"_time,omitempty"`
+
+	// unspecified | offset | gain | two-point
+	Type        *DeviceMetricCalibrationType `json:"type,omitempty"`
+	TypeElement *common.Element              `json:"_type,omitempty"`
+}
+
+// DeviceMetricCalibrationState represents the state of a device metric calibration
+type DeviceMetricCalibrationState string
+
+const (
+	DeviceMetricCalibrationStateNotCalibrated       DeviceMetricCalibrationState = "not-calibrated"
+	DeviceMetricCalibrationStateCalibrationRequired DeviceMetricCalibrationState = "calibration-required"
+	DeviceMetricCalibrationStateCalibrated          DeviceMetricCalibrationState = "calibrated"
+	DeviceMetricCalibrationStateUnspecified         DeviceMetricCalibrationState = "unspecified"
+)
+
+// DeviceMetricCalibrationType represents the type of a device metric calibration
+type DeviceMetricCalibrationType string
+
+const (
+	DeviceMetricCalibrationTypeUnspecified DeviceMetricCalibrationType = "unspecified"
+	DeviceMetricCalibrationTypeOffset      DeviceMetricCalibrationType = "offset"
+	DeviceMetricCalibrationTypeGain        DeviceMetricCalibrationType = "gain"
+	DeviceMetricCalibrationTypeTwoPoint    DeviceMetricCalibrationType = "two-point"
+)
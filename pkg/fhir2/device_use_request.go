@@ -0,0 +1,84 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceUseRequest represents a request for a patient to employ a medical device
+type DeviceUseRequest struct {
+	DomainResource
+
+	// Knowing where the device is targeted is important for tracking if multiple sites are possible
+	BodySiteCodeableConcept *common.CodeableConcept `json:"bodySiteCodeableConcept,omitempty"`
+	BodySiteReference       *common.Reference       `json:"bodySiteReference,omitempty"`
+
+	// The details of the device to be used
+	Device *common.Reference `json:"device"`
+
+	// An encounter that provides additional context in which this request is made
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Identifiers assigned to this order by the orderer or by the receiver
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Reason or justification for the use of this device
+	Indication []common.CodeableConcept `json:"indication,omitempty"`
+
+	// Details about this request that were not represented at all or sufficiently in one of the attributes provided in a class
+	Notes        []string          `json:"notes,omitempty"`
+	NotesElement []*common.Element `json:"_notes,omitempty"`
+
+	// The time when the request was made
+	OrderedOn        *common.DateTime `json:"orderedOn,omitempty"`
+	OrderedOnElement *common.Element  `json:"_orderedOn,omitempty"`
+
+	// Characterizes how quickly the use of device must be initiated
+	Priority        *DeviceUseRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element           `json:"_priority,omitempty"`
+
+	// The proposed act must be performed if the indicated conditions occur, e.g.., shortness of breath, SpO2 less than x%
+	PrnReason []common.CodeableConcept `json:"prnReason,omitempty"`
+
+	// The time at which the request was made/recorded
+	RecordedOn        *common.DateTime `json:"recordedOn,omitempty"`
+	RecordedOnElement *common.Element  `json:"_recordedOn,omitempty"`
+
+	// The status of the request
+	Status        *DeviceUseRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
+
+	// The patient who will use the device
+	Subject *common.Reference `json:"subject"`
+
+	// The timing schedule for the use of the device The Schedule data type allows many different expressions, for example
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+}
+
+// DeviceUseRequestPriority represents the priority of a device use request
+type DeviceUseRequestPriority string
+
+const (
+	DeviceUseRequestPriorityRoutine DeviceUseRequestPriority = "routine"
+	DeviceUseRequestPriorityUrgent  DeviceUseRequestPriority = "urgent"
+	DeviceUseRequestPriorityStat    DeviceUseRequestPriority = "stat"
+	DeviceUseRequestPriorityAsap    DeviceUseRequestPriority = "asap"
+)
+
+// DeviceUseRequestStatus represents the status of a device use request
+type DeviceUseRequestStatus string
+
+const (
+	DeviceUseRequestStatusProposed   DeviceUseRequestStatus = "proposed"
+	DeviceUseRequestStatusPlanned    DeviceUseRequestStatus = "planned"
+	DeviceUseRequestStatusRequested  DeviceUseRequestStatus = "requested"
+	DeviceUseRequestStatusReceived   DeviceUseRequestStatus = "received"
+	DeviceUseRequestStatusAccepted   DeviceUseRequestStatus = "accepted"
+	DeviceUseRequestStatusInProgress DeviceUseRequestStatus = "in-progress"
+	DeviceUseRequestStatusCompleted  DeviceUseRequestStatus = "completed"
+	DeviceUseRequestStatusSuspended  DeviceUseRequestStatus = "suspended"
+	DeviceUseRequestStatusRejected   DeviceUseRequestStatus = "rejected"
+	DeviceUseRequestStatusAborted    DeviceUseRequestStatus = "aborted"
+)
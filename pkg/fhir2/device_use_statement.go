@@ -0,0 +1,43 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceUseStatement represents a record of a device being used by a patient where the record is the result of a report from the patient or another clinician
+type DeviceUseStatement struct {
+	DomainResource
+
+	// Knowing where the device is targeted is important for tracking if multiple sites are possible
+	BodySiteCodeableConcept *common.CodeableConcept `json:"bodySiteCodeableConcept,omitempty"`
+	BodySiteReference       *common.Reference       `json:"bodySiteReference,omitempty"`
+
+	// The details of the device used
+	Device *common.Reference `json:"device"`
+
+	// An external identifier for this statement such as an IRI
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Reason or justification for the use of the device
+	Indication []common.CodeableConcept `json:"indication,omitempty"`
+
+	// Details about the device statement that were not represented at all or sufficiently in one of the attributes provided in a class
+	Notes        []string          `json:"notes,omitempty"`
+	NotesElement []*common.Element `json:"_notes,omitempty"`
+
+	// The time at which the statement was made/recorded
+	RecordedOn        *common.DateTime `json:"recordedOn,omitempty"`
+	RecordedOnElement *common.Element  `json:"_recordedOn,omitempty"`
+
+	// The patient who used the device
+	Subject *common.Reference `json:"subject"`
+
+	// How often the device was used
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+
+	// The time period over which the device was used
+	WhenUsed *common.Period `json:"whenUsed,omitempty"`
+}
@@ -0,0 +1,155 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DiagnosticOrder represents a record of a request for a diagnostic investigation service to be performed
+type DiagnosticOrder struct {
+	DomainResource
+
+	// An encounter that provides additional information about the healthcare context in which this request is made
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// A summary of the events of interest that have occurred as the request is processed; e.g. when the order was made
+	Event []DiagnosticOrderEvent `json:"event,omitempty"`
+
+	// Identifiers assigned to this order instance by the orderer and/or the receiver and/or order fulfiller
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The specific diagnostic investigations that are requested as part of this request
+	Item []DiagnosticOrderItem `json:"item,omitempty"`
+
+	// Any other notes associated with this patient, specimen or order (e.g. "patient hates needles")
+	Note []Annotation `json:"note,omitempty"`
+
+	// The practitioner that holds legal responsibility for ordering the investigation
+	Orderer *common.Reference `json:"orderer,omitempty"`
+
+	// The clinical priority associated with this order
+	Priority        *DiagnosticOrderPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element          `json:"_priority,omitempty"`
+
+	// An explanation or justification for why this diagnostic investigation is being requested
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// One or more specimens that the diagnostic investigation is about
+	Specimen []common.Reference `json:"specimen,omitempty"`
+
+	// The status of the order
+	Status        *DiagnosticOrderStatus `json:"status,omitempty"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// Who or what the investigation is to be performed on
+	Subject *common.Reference `json:"subject"`
+
+	// Additional clinical information about the patient or specimen that may influence test interpretations
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+}
+
+// DiagnosticOrderPriority represents the priority of a diagnostic order
+type DiagnosticOrderPriority string
+
+const (
+	DiagnosticOrderPriorityRoutine DiagnosticOrderPriority = "routine"
+	DiagnosticOrderPriorityUrgent  DiagnosticOrderPriority = "urgent"
+	DiagnosticOrderPriorityStat    DiagnosticOrderPriority = "stat"
+	DiagnosticOrderPriorityAsap    DiagnosticOrderPriority = "asap"
+)
+
+// DiagnosticOrderStatus represents the status of a diagnostic order
+type DiagnosticOrderStatus string
+
+const (
+	DiagnosticOrderStatusProposed   DiagnosticOrderStatus = "proposed"
+	DiagnosticOrderStatusDraft      DiagnosticOrderStatus = "draft"
+	DiagnosticOrderStatusPlanned    DiagnosticOrderStatus = "planned"
+	DiagnosticOrderStatusRequested  DiagnosticOrderStatus = "requested"
+	DiagnosticOrderStatusReceived   DiagnosticOrderStatus = "received"
+	DiagnosticOrderStatusAccepted   DiagnosticOrderStatus = "accepted"
+	DiagnosticOrderStatusInProgress DiagnosticOrderStatus = "in-progress"
+	DiagnosticOrderStatusReview     DiagnosticOrderStatus = "review"
+	DiagnosticOrderStatusCompleted  DiagnosticOrderStatus = "completed"
+	DiagnosticOrderStatusCancelled  DiagnosticOrderStatus = "cancelled"
+	DiagnosticOrderStatusSuspended  DiagnosticOrderStatus = "suspended"
+	DiagnosticOrderStatusRejected   DiagnosticOrderStatus = "rejected"
+	DiagnosticOrderStatusFailed     DiagnosticOrderStatus = "failed"
+)
+
+// DiagnosticOrderEvent represents a summary of the events of interest that have occurred as the request is processed; e.g. when the order was made
+type DiagnosticOrderEvent struct {
+	common.BackboneElement
+
+	// The person responsible for performing or recording the action
+	Actor *common.Reference `json:"actor,omitempty"`
+
+	// The date/time at which the event occurred
+	DateTime        common.DateTime `json:"dateTime"`
+	DateTimeElement *common.Element `json:"_dateTime,omitempty"`
+
+	// Additional information about the event that occurred - e.g. if the status remained unchanged
+	Description *common.CodeableConcept `json:"description,omitempty"`
+
+	// The status for the event
+	Status        DiagnosticOrderEventStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
+}
+
+// DiagnosticOrderEventStatus represents the status of a diagnostic order event
+type DiagnosticOrderEventStatus string
+
+const (
+	DiagnosticOrderEventStatusProposed   DiagnosticOrderEventStatus = "proposed"
+	DiagnosticOrderEventStatusDraft      DiagnosticOrderEventStatus = "draft"
+	DiagnosticOrderEventStatusPlanned    DiagnosticOrderEventStatus = "planned"
+	DiagnosticOrderEventStatusRequested  DiagnosticOrderEventStatus = "requested"
+	DiagnosticOrderEventStatusReceived   DiagnosticOrderEventStatus = "received"
+	DiagnosticOrderEventStatusAccepted   DiagnosticOrderEventStatus = "accepted"
+	DiagnosticOrderEventStatusInProgress DiagnosticOrderEventStatus = "in-progress"
+	DiagnosticOrderEventStatusReview     DiagnosticOrderEventStatus = "review"
+	DiagnosticOrderEventStatusCompleted  DiagnosticOrderEventStatus = "completed"
+	DiagnosticOrderEventStatusCancelled  DiagnosticOrderEventStatus = "cancelled"
+	DiagnosticOrderEventStatusSuspended  DiagnosticOrderEventStatus = "suspended"
+	DiagnosticOrderEventStatusRejected   DiagnosticOrderEventStatus = "rejected"
+	DiagnosticOrderEventStatusFailed     DiagnosticOrderEventStatus = "failed"
+)
+
+// DiagnosticOrderItem represents the item of a diagnostic order
+type DiagnosticOrderItem struct {
+	common.BackboneElement
+
+	// Anatomical location where the request test should be performed
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// A code that identifies a particular diagnostic investigation, or panel of investigations, that have been requested
+	Code *common.CodeableConcept `json:"code"`
+
+	// A summary of the events of interest that have occurred as this item of the request is processed
+	Event []DiagnosticOrderEvent `json:"event,omitempty"`
+
+	// If the item is related to a specific specimen
+	Specimen []common.Reference `json:"specimen,omitempty"`
+
+	// The status of this individual item within the order
+	Status        *DiagnosticOrderItemStatus `json:"status,omitempty"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
+}
+
+// DiagnosticOrderItemStatus represents the status of a diagnostic order item
+type DiagnosticOrderItemStatus string
+
+const (
+	DiagnosticOrderItemStatusProposed   DiagnosticOrderItemStatus = "proposed"
+	DiagnosticOrderItemStatusDraft      DiagnosticOrderItemStatus = "draft"
+	DiagnosticOrderItemStatusPlanned    DiagnosticOrderItemStatus = "planned"
+	DiagnosticOrderItemStatusRequested  DiagnosticOrderItemStatus = "requested"
+	DiagnosticOrderItemStatusReceived   DiagnosticOrderItemStatus = "received"
+	DiagnosticOrderItemStatusAccepted   DiagnosticOrderItemStatus = "accepted"
+	DiagnosticOrderItemStatusInProgress DiagnosticOrderItemStatus = "in-progress"
+	DiagnosticOrderItemStatusReview     DiagnosticOrderItemStatus = "review"
+	DiagnosticOrderItemStatusCompleted  DiagnosticOrderItemStatus = "completed"
+	DiagnosticOrderItemStatusCancelled  DiagnosticOrderItemStatus = "cancelled"
+	DiagnosticOrderItemStatusSuspended  DiagnosticOrderItemStatus = "suspended"
+	DiagnosticOrderItemStatusRejected   DiagnosticOrderItemStatus = "rejected"
+	DiagnosticOrderItemStatusFailed     DiagnosticOrderItemStatus = "failed"
+)
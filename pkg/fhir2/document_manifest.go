@@ -0,0 +1,79 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DocumentManifest represents a manifest that defines a set of documents
+type DocumentManifest struct {
+	DomainResource
+
+	// Identifies who is responsible for creating the manifest, and adding documents to it
+	Author []common.Reference `json:"author,omitempty"`
+
+	// The list of Documents included in the manifest
+	Content []DocumentManifestContent `json:"content"`
+
+	// When the document manifest was created for submission to the server
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Helps humans to assess whether the document is of interest
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Other identifiers associated with the document manifest, including version independent identifiers
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// A single identifier that uniquely identifies this manifest
+	MasterIdentifier *common.Identifier `json:"masterIdentifier,omitempty"`
+
+	// A patient, practitioner, or organization for which this set of documents is intended
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// Related identifiers or resources associated with the DocumentManifest
+	Related []DocumentManifestRelated `json:"related,omitempty"`
+
+	// Identifies the source system, application, or software that produced the document manifest
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// The status of this document manifest
+	Status        DocumentManifestStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// Who or what the set of documents is about
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Specifies the kind of this set of documents (e.g. Patient Summary, Discharge Summary, Prescription, etc.)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// DocumentManifestStatus represents the status of a document manifest
+type DocumentManifestStatus string
+
+const (
+	DocumentManifestStatusCurrent        DocumentManifestStatus = "current"
+	DocumentManifestStatusSuperseded     DocumentManifestStatus = "superseded"
+	DocumentManifestStatusEnteredInError DocumentManifestStatus = "entered-in-error"
+)
+
+// DocumentManifestContent represents the content of a document manifest
+type DocumentManifestContent struct {
+	common.BackboneElement
+
+	// The list of references to document content, or Attachment that consist of the parts of this document manifest
+	PAttachment *Attachment       `json:"pAttachment,omitempty"`
+	PReference  *common.Reference `json:"pReference,omitempty"`
+}
+
+// DocumentManifestRelated represents related identifiers or resources associated with the DocumentManifest
+type DocumentManifestRelated struct {
+	common.BackboneElement
+
+	// Related identifier to this DocumentManifest
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Related Resource to this DocumentManifest
+	Ref *common.Reference `json:"ref,omitempty"`
+}
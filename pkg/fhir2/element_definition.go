@@ -0,0 +1,560 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ElementDefinition represents definition of an element in a resource or extension
+type ElementDefinition struct {
+	common.Element
+
+	// Other names
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
+
+	// Base definition information for tools
+	Base *ElementDefinitionBase `json:"base,omitempty"`
+
+	// ValueSet details if this is coded
+	Binding *ElementDefinitionBinding `json:"binding,omitempty"`
+
+	// Corresponding codes in terminologies
+	Code []common.Coding `json:"code,omitempty"`
+
+	// Explanatory notes and implementation guidance about the data element
+	Comments        *string         `json:"comments,omitempty"`
+	CommentsElement *common.Element `json:"_comments,omitempty"`
+
+	// Reference to invariant about presence
+	Condition        []string          `json:"condition,omitempty"`
+	ConditionElement []*common.Element `json:"_condition,omitempty"`
+
+	// Condition that must evaluate to true
+	Constraint []ElementDefinitionConstraint `json:"constraint,omitempty"`
+
+	// Specified value if missing from instance
+	DefaultValueBoolean             *bool                   `json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *common.Element         `json:"_defaultValueBoolean,omitempty"`
+	DefaultValueInteger             *int                    `json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *common.Element         `json:"_defaultValueInteger,omitempty"`
+	DefaultValueDecimal             *common.Decimal         `json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *common.Element         `json:"_defaultValueDecimal,omitempty"`
+	DefaultValueBase64Binary        *string                 `json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *common.Element         `json:"_defaultValueBase64Binary,omitempty"`
+	DefaultValueInstant             *common.Instant         `json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *common.Element         `json:"_defaultValueInstant,omitempty"`
+	DefaultValueString              *string                 `json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *common.Element         `json:"_defaultValueString,omitempty"`
+	DefaultValueUri                 *string                 `json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *common.Element         `json:"_defaultValueUri,omitempty"`
+	DefaultValueDate                *common.Date            `json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *common.Element         `json:"_defaultValueDate,omitempty"`
+	DefaultValueDateTime            *common.DateTime        `json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *common.Element         `json:"_defaultValueDateTime,omitempty"`
+	DefaultValueTime                *common.Time            `json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *common.Element         `json:"_defaultValueTime,omitempty"`
+	DefaultValueCode                *string                 `json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *common.Element         `json:"_defaultValueCode,omitempty"`
+	DefaultValueOid                 *string                 `json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *common.Element         `json:"_defaultValueOid,omitempty"`
+	DefaultValueId                  *string                 `json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *common.Element         `json:"_defaultValueId,omitempty"`
+	DefaultValueUnsignedInt         *int                    `json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *common.Element         `json:"_defaultValueUnsignedInt,omitempty"`
+	DefaultValuePositiveInt         *int                    `json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *common.Element         `json:"_defaultValuePositiveInt,omitempty"`
+	DefaultValueMarkdown            *string                 `json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *common.Element         `json:"_defaultValueMarkdown,omitempty"`
+	DefaultValueAnnotation          *Annotation             `json:"defaultValueAnnotation,omitempty"`
+	DefaultValueAttachment          *Attachment             `json:"defaultValueAttachment,omitempty"`
+	DefaultValueIdentifier          *common.Identifier      `json:"defaultValueIdentifier,omitempty"`
+	DefaultValueCodeableConcept     *common.CodeableConcept `json:"defaultValueCodeableConcept,omitempty"`
+	DefaultValueCoding              *common.Coding          `json:"defaultValueCoding,omitempty"`
+	DefaultValueQuantity            *common.Quantity        `json:"defaultValueQuantity,omitempty"`
+	DefaultValueRange               *Range                  `json:"defaultValueRange,omitempty"`
+	DefaultValuePeriod              *common.Period          `json:"defaultValuePeriod,omitempty"`
+	DefaultValueRatio               *Ratio                  `json:"defaultValueRatio,omitempty"`
+	DefaultValueSampledData         *SampledData            `json:"defaultValueSampledData,omitempty"`
+	DefaultValueSignature           *Signature              `json:"defaultValueSignature,omitempty"`
+	DefaultValueHumanName           *HumanName              `json:"defaultValueHumanName,omitempty"`
+	DefaultValueAddress             *Address                `json:"defaultValueAddress,omitempty"`
+	DefaultValueContactPoint        *ContactPoint           `json:"defaultValueContactPoint,omitempty"`
+	DefaultValueTiming              *Timing                 `json:"defaultValueTiming,omitempty"`
+	DefaultValueReference           *common.Reference       `json:"defaultValueReference,omitempty"`
+	DefaultValueMeta                *Meta                   `json:"defaultValueMeta,omitempty"`
+
+	// Full formal definition as narrative text
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// A sample value for this element demonstrating the type of information that would typically be captured
+	ExampleBoolean             *bool                   `json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement      *common.Element         `json:"_exampleBoolean,omitempty"`
+	ExampleInteger             *int                    `json:"exampleInteger,omitempty"`
+	ExampleIntegerElement      *common.Element         `json:"_exampleInteger,omitempty"`
+	ExampleDecimal             *common.Decimal         `json:"exampleDecimal,omitempty"`
+	ExampleDecimalElement      *common.Element         `json:"_exampleDecimal,omitempty"`
+	ExampleBase64Binary        *string                 `json:"exampleBase64Binary,omitempty"`
+	ExampleBase64BinaryElement *common.Element         `json:"_exampleBase64Binary,omitempty"`
+	ExampleInstant             *common.Instant         `json:"exampleInstant,omitempty"`
+	ExampleInstantElement      *common.Element         `json:"_exampleInstant,omitempty"`
+	ExampleString              *string                 `json:"exampleString,omitempty"`
+	ExampleStringElement       *common.Element         `json:"_exampleString,omitempty"`
+	ExampleUri                 *string                 `json:"exampleUri,omitempty"`
+	ExampleUriElement          *common.Element         `json:"_exampleUri,omitempty"`
+	ExampleDate                *common.Date            `json:"exampleDate,omitempty"`
+	ExampleDateElement         *common.Element         `json:"_exampleDate,omitempty"`
+	ExampleDateTime            *common.DateTime        `json:"exampleDateTime,omitempty"`
+	ExampleDateTimeElement     *common.Element         `json:"_exampleDateTime,omitempty"`
+	ExampleTime                *common.Time            `json:"exampleTime,omitempty"`
+	ExampleTimeElement         *common.Element         `json:"_exampleTime,omitempty"`
+	ExampleCode                *string                 `json:"exampleCode,omitempty"`
+	ExampleCodeElement         *common.Element         `json:"_exampleCode,omitempty"`
+	ExampleOid                 *string                 `json:"exampleOid,omitempty"`
+	ExampleOidElement          *common.Element         `json:"_exampleOid,omitempty"`
+	ExampleId                  *string                 `json:"exampleId,omitempty"`
+	ExampleIdElement           *common.Element         `json:"_exampleId,omitempty"`
+	ExampleUnsignedInt         *int                    `json:"exampleUnsignedInt,omitempty"`
+	ExampleUnsignedIntElement  *common.Element         `json:"_exampleUnsignedInt,omitempty"`
+	ExamplePositiveInt         *int                    `json:"examplePositiveInt,omitempty"`
+	ExamplePositiveIntElement  *common.Element         `json:"_examplePositiveInt,omitempty"`
+	ExampleMarkdown            *string                 `json:"exampleMarkdown,omitempty"`
+	ExampleMarkdownElement     *common.Element         `json:"_exampleMarkdown,omitempty"`
+	ExampleAnnotation          *Annotation             `json:"exampleAnnotation,omitempty"`
+	ExampleAttachment          *Attachment             `json:"exampleAttachment,omitempty"`
+	ExampleIdentifier          *common.Identifier      `json:"exampleIdentifier,omitempty"`
+	ExampleCodeableConcept     *common.CodeableConcept `json:"exampleCodeableConcept,omitempty"`
+	ExampleCoding              *common.Coding          `json:"exampleCoding,omitempty"`
+	ExampleQuantity            *common.Quantity        `json:"exampleQuantity,omitempty"`
+	ExampleRange               *Range                  `json:"exampleRange,omitempty"`
+	ExamplePeriod              *common.Period          `json:"examplePeriod,omitempty"`
+	ExampleRatio               *Ratio                  `json:"exampleRatio,omitempty"`
+	ExampleSampledData         *SampledData            `json:"exampleSampledData,omitempty"`
+	ExampleSignature           *Signature              `json:"exampleSignature,omitempty"`
+	ExampleHumanName           *HumanName              `json:"exampleHumanName,omitempty"`
+	ExampleAddress             *Address                `json:"exampleAddress,omitempty"`
+	ExampleContactPoint        *ContactPoint           `json:"exampleContactPoint,omitempty"`
+	ExampleTiming              *Timing                 `json:"exampleTiming,omitempty"`
+	ExampleReference           *common.Reference       `json:"exampleReference,omitempty"`
+	ExampleMeta                *Meta                   `json:"exampleMeta,omitempty"`
+
+	// Value must be exactly this
+	FixedBoolean             *bool                   `json:"fixedBoolean,omitempty"`
+	FixedBooleanElement      *common.Element         `json:"_fixedBoolean,omitempty"`
+	FixedInteger             *int                    `json:"fixedInteger,omitempty"`
+	FixedIntegerElement      *common.Element         `json:"_fixedInteger,omitempty"`
+	FixedDecimal             *common.Decimal         `json:"fixedDecimal,omitempty"`
+	FixedDecimalElement      *common.Element         `json:"_fixedDecimal,omitempty"`
+	FixedBase64Binary        *string                 `json:"fixedBase64Binary,omitempty"`
+	FixedBase64BinaryElement *common.Element         `json:"_fixedBase64Binary,omitempty"`
+	FixedInstant             *common.Instant         `json:"fixedInstant,omitempty"`
+	FixedInstantElement      *common.Element         `json:"_fixedInstant,omitempty"`
+	FixedString              *string                 `json:"fixedString,omitempty"`
+	FixedStringElement       *common.Element         `json:"_fixedString,omitempty"`
+	FixedUri                 *string                 `json:"fixedUri,omitempty"`
+	FixedUriElement          *common.Element         `json:"_fixedUri,omitempty"`
+	FixedDate                *common.Date            `json:"fixedDate,omitempty"`
+	FixedDateElement         *common.Element         `json:"_fixedDate,omitempty"`
+	FixedDateTime            *common.DateTime        `json:"fixedDateTime,omitempty"`
+	FixedDateTimeElement     *common.Element         `json:"_fixedDateTime,omitempty"`
+	FixedTime                *common.Time            `json:"fixedTime,omitempty"`
+	FixedTimeElement         *common.Element         `json:"_fixedTime,omitempty"`
+	FixedCode                *string                 `json:"fixedCode,omitempty"`
+	FixedCodeElement         *common.Element         `json:"_fixedCode,omitempty"`
+	FixedOid                 *string                 `json:"fixedOid,omitempty"`
+	FixedOidElement          *common.Element         `json:"_fixedOid,omitempty"`
+	FixedId                  *string                 `json:"fixedId,omitempty"`
+	FixedIdElement           *common.Element         `json:"_fixedId,omitempty"`
+	FixedUnsignedInt         *int                    `json:"fixedUnsignedInt,omitempty"`
+	FixedUnsignedIntElement  *common.Element         `json:"_fixedUnsignedInt,omitempty"`
+	FixedPositiveInt         *int                    `json:"fixedPositiveInt,omitempty"`
+	FixedPositiveIntElement  *common.Element         `json:"_fixedPositiveInt,omitempty"`
+	FixedMarkdown            *string                 `json:"fixedMarkdown,omitempty"`
+	FixedMarkdownElement     *common.Element         `json:"_fixedMarkdown,omitempty"`
+	FixedAnnotation          *Annotation             `json:"fixedAnnotation,omitempty"`
+	FixedAttachment          *Attachment             `json:"fixedAttachment,omitempty"`
+	FixedIdentifier          *common.Identifier      `json:"fixedIdentifier,omitempty"`
+	FixedCodeableConcept     *common.CodeableConcept `json:"fixedCodeableConcept,omitempty"`
+	FixedCoding              *common.Coding          `json:"fixedCoding,omitempty"`
+	FixedQuantity            *common.Quantity        `json:"fixedQuantity,omitempty"`
+	FixedRange               *Range                  `json:"fixedRange,omitempty"`
+	FixedPeriod              *common.Period          `json:"fixedPeriod,omitempty"`
+	FixedRatio               *Ratio                  `json:"fixedRatio,omitempty"`
+	FixedSampledData         *SampledData            `json:"fixedSampledData,omitempty"`
+	FixedSignature           *Signature              `json:"fixedSignature,omitempty"`
+	FixedHumanName           *HumanName              `json:"fixedHumanName,omitempty"`
+	FixedAddress             *Address                `json:"fixedAddress,omitempty"`
+	FixedContactPoint        *ContactPoint           `json:"fixedContactPoint,omitempty"`
+	FixedTiming              *Timing                 `json:"fixedTiming,omitempty"`
+	FixedReference           *common.Reference       `json:"fixedReference,omitempty"`
+	FixedMeta                *Meta                   `json:"fixedMeta,omitempty"`
+
+	// If this modifies the meaning of other elements
+	IsModifier        *bool           `json:"isModifier,omitempty"`
+	IsModifierElement *common.Element `json:"_isModifier,omitempty"`
+
+	// Include when _summary = true?
+	IsSummary        *bool           `json:"isSummary,omitempty"`
+	IsSummaryElement *common.Element `json:"_isSummary,omitempty"`
+
+	// Name for element to display with or prompt for element
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Map element to another set of definitions
+	Mapping []ElementDefinitionMapping `json:"mapping,omitempty"`
+
+	// Maximum Cardinality (a number or *)
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Max length for string type data
+	MaxLength        *int            `json:"maxLength,omitempty"`
+	MaxLengthElement *common.Element `json:"_maxLength,omitempty"`
+
+	// The maximum allowed value for the element
+	MaxValueBoolean             *bool                   `json:"maxValueBoolean,omitempty"`
+	MaxValueBooleanElement      *common.Element         `json:"_maxValueBoolean,omitempty"`
+	MaxValueInteger             *int                    `json:"maxValueInteger,omitempty"`
+	MaxValueIntegerElement      *common.Element         `json:"_maxValueInteger,omitempty"`
+	MaxValueDecimal             *common.Decimal         `json:"maxValueDecimal,omitempty"`
+	MaxValueDecimalElement      *common.Element         `json:"_maxValueDecimal,omitempty"`
+	MaxValueBase64Binary        *string                 `json:"maxValueBase64Binary,omitempty"`
+	MaxValueBase64BinaryElement *common.Element         `json:"_maxValueBase64Binary,omitempty"`
+	MaxValueInstant             *common.Instant         `json:"maxValueInstant,omitempty"`
+	MaxValueInstantElement      *common.Element         `json:"_maxValueInstant,omitempty"`
+	MaxValueString              *string                 `json:"maxValueString,omitempty"`
+	MaxValueStringElement       *common.Element         `json:"_maxValueString,omitempty"`
+	MaxValueUri                 *string                 `json:"maxValueUri,omitempty"`
+	MaxValueUriElement          *common.Element         `json:"_maxValueUri,omitempty"`
+	MaxValueDate                *common.Date            `json:"maxValueDate,omitempty"`
+	MaxValueDateElement         *common.Element         `json:"_maxValueDate,omitempty"`
+	MaxValueDateTime            *common.DateTime        `json:"maxValueDateTime,omitempty"`
+	MaxValueDateTimeElement     *common.Element         `json:"_maxValueDateTime,omitempty"`
+	MaxValueTime                *common.Time            `json:"maxValueTime,omitempty"`
+	MaxValueTimeElement         *common.Element         `json:"_maxValueTime,omitempty"`
+	MaxValueCode                *string                 `json:"maxValueCode,omitempty"`
+	MaxValueCodeElement         *common.Element         `json:"_maxValueCode,omitempty"`
+	MaxValueOid                 *string                 `json:"maxValueOid,omitempty"`
+	MaxValueOidElement          *common.Element         `json:"_maxValueOid,omitempty"`
+	MaxValueId                  *string                 `json:"maxValueId,omitempty"`
+	MaxValueIdElement           *common.Element         `json:"_maxValueId,omitempty"`
+	MaxValueUnsignedInt         *int                    `json:"maxValueUnsignedInt,omitempty"`
+	MaxValueUnsignedIntElement  *common.Element         `json:"_maxValueUnsignedInt,omitempty"`
+	MaxValuePositiveInt         *int                    `json:"maxValuePositiveInt,omitempty"`
+	MaxValuePositiveIntElement  *common.Element         `json:"_maxValuePositiveInt,omitempty"`
+	MaxValueMarkdown            *string                 `json:"maxValueMarkdown,omitempty"`
+	MaxValueMarkdownElement     *common.Element         `json:"_maxValueMarkdown,omitempty"`
+	MaxValueAnnotation          *Annotation             `json:"maxValueAnnotation,omitempty"`
+	MaxValueAttachment          *Attachment             `json:"maxValueAttachment,omitempty"`
+	MaxValueIdentifier          *common.Identifier      `json:"maxValueIdentifier,omitempty"`
+	MaxValueCodeableConcept     *common.CodeableConcept `json:"maxValueCodeableConcept,omitempty"`
+	MaxValueCoding              *common.Coding          `json:"maxValueCoding,omitempty"`
+	MaxValueQuantity            *common.Quantity        `json:"maxValueQuantity,omitempty"`
+	MaxValueRange               *Range                  `json:"maxValueRange,omitempty"`
+	MaxValuePeriod              *common.Period          `json:"maxValuePeriod,omitempty"`
+	MaxValueRatio               *Ratio                  `json:"maxValueRatio,omitempty"`
+	MaxValueSampledData         *SampledData            `json:"maxValueSampledData,omitempty"`
+	MaxValueSignature           *Signature              `json:"maxValueSignature,omitempty"`
+	MaxValueHumanName           *HumanName              `json:"maxValueHumanName,omitempty"`
+	MaxValueAddress             *Address                `json:"maxValueAddress,omitempty"`
+	MaxValueContactPoint        *ContactPoint           `json:"maxValueContactPoint,omitempty"`
+	MaxValueTiming              *Timing                 `json:"maxValueTiming,omitempty"`
+	MaxValueReference           *common.Reference       `json:"maxValueReference,omitempty"`
+	MaxValueMeta                *Meta                   `json:"maxValueMeta,omitempty"`
+
+	// Implicit meaning when this element is missing
+	MeaningWhenMissing        *string         `json:"meaningWhenMissing,omitempty"`
+	MeaningWhenMissingElement *common.Element `json:"_meaningWhenMissing,omitempty"`
+
+	// Minimum Cardinality
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// The minimum allowed value for the element
+	MinValueBoolean             *bool                   `json:"minValueBoolean,omitempty"`
+	MinValueBooleanElement      *common.Element         `json:"_minValueBoolean,omitempty"`
+	MinValueInteger             *int                    `json:"minValueInteger,omitempty"`
+	MinValueIntegerElement      *common.Element         `json:"_minValueInteger,omitempty"`
+	MinValueDecimal             *common.Decimal         `json:"minValueDecimal,omitempty"`
+	MinValueDecimalElement      *common.Element         `json:"_minValueDecimal,omitempty"`
+	MinValueBase64Binary        *string                 `json:"minValueBase64Binary,omitempty"`
+	MinValueBase64BinaryElement *common.Element         `json:"_minValueBase64Binary,omitempty"`
+	MinValueInstant             *common.Instant         `json:"minValueInstant,omitempty"`
+	MinValueInstantElement      *common.Element         `json:"_minValueInstant,omitempty"`
+	MinValueString              *string                 `json:"minValueString,omitempty"`
+	MinValueStringElement       *common.Element         `json:"_minValueString,omitempty"`
+	MinValueUri                 *string                 `json:"minValueUri,omitempty"`
+	MinValueUriElement          *common.Element         `json:"_minValueUri,omitempty"`
+	MinValueDate                *common.Date            `json:"minValueDate,omitempty"`
+	MinValueDateElement         *common.Element         `json:"_minValueDate,omitempty"`
+	MinValueDateTime            *common.DateTime        `json:"minValueDateTime,omitempty"`
+	MinValueDateTimeElement     *common.Element         `json:"_minValueDateTime,omitempty"`
+	MinValueTime                *common.Time            `json:"minValueTime,omitempty"`
+	MinValueTimeElement         *common.Element         `json:"_minValueTime,omitempty"`
+	MinValueCode                *string                 `json:"minValueCode,omitempty"`
+	MinValueCodeElement         *common.Element         `json:"_minValueCode,omitempty"`
+	MinValueOid                 *string                 `json:"minValueOid,omitempty"`
+	MinValueOidElement          *common.Element         `json:"_minValueOid,omitempty"`
+	MinValueId                  *string                 `json:"minValueId,omitempty"`
+	MinValueIdElement           *common.Element         `json:"_minValueId,omitempty"`
+	MinValueUnsignedInt         *int                    `json:"minValueUnsignedInt,omitempty"`
+	MinValueUnsignedIntElement  *common.Element         `json:"_minValueUnsignedInt,omitempty"`
+	MinValuePositiveInt         *int                    `json:"minValuePositiveInt,omitempty"`
+	MinValuePositiveIntElement  *common.Element         `json:"_minValuePositiveInt,omitempty"`
+	MinValueMarkdown            *string                 `json:"minValueMarkdown,omitempty"`
+	MinValueMarkdownElement     *common.Element         `json:"_minValueMarkdown,omitempty"`
+	MinValueAnnotation          *Annotation             `json:"minValueAnnotation,omitempty"`
+	MinValueAttachment          *Attachment             `json:"minValueAttachment,omitempty"`
+	MinValueIdentifier          *common.Identifier      `json:"minValueIdentifier,omitempty"`
+	MinValueCodeableConcept     *common.CodeableConcept `json:"minValueCodeableConcept,omitempty"`
+	MinValueCoding              *common.Coding          `json:"minValueCoding,omitempty"`
+	MinValueQuantity            *common.Quantity        `json:"minValueQuantity,omitempty"`
+	MinValueRange               *Range                  `json:"minValueRange,omitempty"`
+	MinValuePeriod              *common.Period          `json:"minValuePeriod,omitempty"`
+	MinValueRatio               *Ratio                  `json:"minValueRatio,omitempty"`
+	MinValueSampledData         *SampledData            `json:"minValueSampledData,omitempty"`
+	MinValueSignature           *Signature              `json:"minValueSignature,omitempty"`
+	MinValueHumanName           *HumanName              `json:"minValueHumanName,omitempty"`
+	MinValueAddress             *Address                `json:"minValueAddress,omitempty"`
+	MinValueContactPoint        *ContactPoint           `json:"minValueContactPoint,omitempty"`
+	MinValueTiming              *Timing                 `json:"minValueTiming,omitempty"`
+	MinValueReference           *common.Reference       `json:"minValueReference,omitempty"`
+	MinValueMeta                *Meta                   `json:"minValueMeta,omitempty"`
+
+	// If the element must be supported (discouraged - see obligations)
+	MustSupport        *bool           `json:"mustSupport,omitempty"`
+	MustSupportElement *common.Element `json:"_mustSupport,omitempty"`
+
+	// Allows referencing a defined element
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Identifies the name of a slice defined elsewhere in the profile whose constraints should be applied to the current element
+	NameReference        *string         `json:"nameReference,omitempty"`
+	NameReferenceElement *common.Element `json:"_nameReference,omitempty"`
+
+	// Path of the element in the hierarchy of elements
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// Value must have at least these property values
+	PatternBoolean             *bool                   `json:"patternBoolean,omitempty"`
+	PatternBooleanElement      *common.Element         `json:"_patternBoolean,omitempty"`
+	PatternInteger             *int                    `json:"patternInteger,omitempty"`
+	PatternIntegerElement      *common.Element         `json:"_patternInteger,omitempty"`
+	PatternDecimal             *common.Decimal         `json:"patternDecimal,omitempty"`
+	PatternDecimalElement      *common.Element         `json:"_patternDecimal,omitempty"`
+	PatternBase64Binary        *string                 `json:"patternBase64Binary,omitempty"`
+	PatternBase64BinaryElement *common.Element         `json:"_patternBase64Binary,omitempty"`
+	PatternInstant             *common.Instant         `json:"patternInstant,omitempty"`
+	PatternInstantElement      *common.Element         `json:"_patternInstant,omitempty"`
+	PatternString              *string                 `json:"patternString,omitempty"`
+	PatternStringElement       *common.Element         `json:"_patternString,omitempty"`
+	PatternUri                 *string                 `json:"patternUri,omitempty"`
+	PatternUriElement          *common.Element         `json:"_patternUri,omitempty"`
+	PatternDate                *common.Date            `json:"patternDate,omitempty"`
+	PatternDateElement         *common.Element         `json:"_patternDate,omitempty"`
+	PatternDateTime            *common.DateTime        `json:"patternDateTime,omitempty"`
+	PatternDateTimeElement     *common.Element         `json:"_patternDateTime,omitempty"`
+	PatternTime                *common.Time            `json:"patternTime,omitempty"`
+	PatternTimeElement         *common.Element         `json:"_patternTime,omitempty"`
+	PatternCode                *string                 `json:"patternCode,omitempty"`
+	PatternCodeElement         *common.Element         `json:"_patternCode,omitempty"`
+	PatternOid                 *string                 `json:"patternOid,omitempty"`
+	PatternOidElement          *common.Element         `json:"_patternOid,omitempty"`
+	PatternId                  *string                 `json:"patternId,omitempty"`
+	PatternIdElement           *common.Element         `json:"_patternId,omitempty"`
+	PatternUnsignedInt         *int                    `json:"patternUnsignedInt,omitempty"`
+	PatternUnsignedIntElement  *common.Element         `json:"_patternUnsignedInt,omitempty"`
+	PatternPositiveInt         *int                    `json:"patternPositiveInt,omitempty"`
+	PatternPositiveIntElement  *common.Element         `json:"_patternPositiveInt,omitempty"`
+	PatternMarkdown            *string                 `json:"patternMarkdown,omitempty"`
+	PatternMarkdownElement     *common.Element         `json:"_patternMarkdown,omitempty"`
+	PatternAnnotation          *Annotation             `json:"patternAnnotation,omitempty"`
+	PatternAttachment          *Attachment             `json:"patternAttachment,omitempty"`
+	PatternIdentifier          *common.Identifier      `json:"patternIdentifier,omitempty"`
+	PatternCodeableConcept     *common.CodeableConcept `json:"patternCodeableConcept,omitempty"`
+	PatternCoding              *common.Coding          `json:"patternCoding,omitempty"`
+	PatternQuantity            *common.Quantity        `json:"patternQuantity,omitempty"`
+	PatternRange               *Range                  `json:"patternRange,omitempty"`
+	PatternPeriod              *common.Period          `json:"patternPeriod,omitempty"`
+	PatternRatio               *Ratio                  `json:"patternRatio,omitempty"`
+	PatternSampledData         *SampledData            `json:"patternSampledData,omitempty"`
+	PatternSignature           *Signature              `json:"patternSignature,omitempty"`
+	PatternHumanName           *HumanName              `json:"patternHumanName,omitempty"`
+	PatternAddress             *Address                `json:"patternAddress,omitempty"`
+	PatternContactPoint        *ContactPoint           `json:"patternContactPoint,omitempty"`
+	PatternTiming              *Timing                 `json:"patternTiming,omitempty"`
+	PatternReference           *common.Reference       `json:"patternReference,omitempty"`
+	PatternMeta                *Meta                   `json:"patternMeta,omitempty"`
+
+	// xmlAttr | xmlText | typeAttr | cdaText | xhtml
+	Representation        []string          `json:"representation,omitempty"`
+	RepresentationElement []*common.Element `json:"_representation,omitempty"`
+
+	// Why this resource has been created
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// Concise definition for space-constrained presentation
+	Short        *string         `json:"short,omitempty"`
+	ShortElement *common.Element `json:"_short,omitempty"`
+
+	// This element is sliced - slices follow
+	Slicing *ElementDefinitionSlicing `json:"slicing,omitempty"`
+
+	// Data type and Profile for this element
+	Type []ElementDefinitionType `json:"type,omitempty"`
+}
+
+// ElementDefinitionBase represents base definition information for tools
+type ElementDefinitionBase struct {
+	common.Element
+
+	// Max cardinality of the base element
+	Max        string          `json:"max"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Min cardinality of the base element
+	Min        int             `json:"min"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Path that identifies the base element
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+}
+
+// ElementDefinitionBinding represents valueSet details if this is coded
+type ElementDefinitionBinding struct {
+	common.Element
+
+	// Intended use of codes in the bound value set
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// required | extensible | preferred | example
+	Strength        ElementDefinitionBindingStrength `json:"strength"`
+	StrengthElement *common.Element                  `json:"_strength,omitempty"`
+
+	// Points to the value set or external definition (e.g. implicit value set) that identifies the set of codes to be used
+	ValueSetUri        *string           `json:"valueSetUri,omitempty"`
+	ValueSetUriElement *common.Element   `json:"_valueSetUri,omitempty"`
+	ValueSetReference  *common.Reference `json:"valueSetReference,omitempty"`
+}
+
+// ElementDefinitionBindingStrength represents the strength of an element definition binding
+type ElementDefinitionBindingStrength string
+
+const (
+	ElementDefinitionBindingStrengthRequired   ElementDefinitionBindingStrength = "required"
+	ElementDefinitionBindingStrengthExtensible ElementDefinitionBindingStrength = "extensible"
+	ElementDefinitionBindingStrengthPreferred  ElementDefinitionBindingStrength = "preferred"
+	ElementDefinitionBindingStrengthExample    ElementDefinitionBindingStrength = "example"
+)
+
+// ElementDefinitionConstraint represents condition that must evaluate to true
+type ElementDefinitionConstraint struct {
+	common.Element
+
+	// Human description of constraint
+	Human        string          `json:"human"`
+	HumanElement *common.Element `json:"_human,omitempty"`
+
+	// Target of 'condition' reference above
+	Key        string          `json:"key"`
+	KeyElement *common.Element `json:"_key,omitempty"`
+
+	// Why this constraint is necessary or appropriate
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// error | warning
+	Severity        ElementDefinitionConstraintSeverity `json:"severity"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
+
+	// Used in Schematron tests of the validity of the resource
+	Xpath        string          `json:"xpath"`
+	XpathElement *common.Element `json:"_xpath,omitempty"`
+}
+
+// ElementDefinitionConstraintSeverity represents the severity of an element definition constraint
+type ElementDefinitionConstraintSeverity string
+
+const (
+	ElementDefinitionConstraintSeverityError   ElementDefinitionConstraintSeverity = "error"
+	ElementDefinitionConstraintSeverityWarning ElementDefinitionConstraintSeverity = "warning"
+)
+
+// ElementDefinitionMapping represents map element to another set of definitions
+type ElementDefinitionMapping struct {
+	common.Element
+
+	// Reference to mapping declaration
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
+
+	// Computable language of mapping
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// Details of the mapping
+	Map        string          `json:"map"`
+	MapElement *common.Element `json:"_map,omitempty"`
+}
+
+// ElementDefinitionSlicing represents the slicing of an element definition
+type ElementDefinitionSlicing struct {
+	common.Element
+
+	// Text description of how slicing works (or not)
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Element values that are used to distinguish the slices
+	Discriminator        []string          `json:"discriminator,omitempty"`
+	DiscriminatorElement []*common.Element `json:"_discriminator,omitempty"`
+
+	// If elements must be in same order as slices
+	Ordered        *bool           `json:"ordered,omitempty"`
+	OrderedElement *common.Element `json:"_ordered,omitempty"`
+
+	// closed | open | openAtEnd
+	Rules        ElementDefinitionSlicingRules `json:"rules"`
+	RulesElement *common.Element               `json:"_rules,omitempty"`
+}
+
+// ElementDefinitionSlicingRules represents the rules of an element definition slicing
+type ElementDefinitionSlicingRules string
+
+const (
+	ElementDefinitionSlicingRulesClosed    ElementDefinitionSlicingRules = "closed"
+	ElementDefinitionSlicingRulesOpen      ElementDefinitionSlicingRules = "open"
+	ElementDefinitionSlicingRulesOpenAtEnd ElementDefinitionSlicingRules = "openAtEnd"
+)
+
+// ElementDefinitionType represents data type and Profile for this element
+type ElementDefinitionType struct {
+	common.Element
+
+	// contained | referenced | bundled - how aggregated
+	Aggregation        []ElementDefinitionTypeAggregation `json:"aggregation,omitempty"`
+	AggregationElement []*common.Element                  `json:"_aggregation,omitempty"`
+
+	// Data type or Resource (reference to definition)
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Profiles (StructureDefinition or IG) - one must apply
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
+}
+
+// ElementDefinitionTypeAggregation represents the aggregation of an element definition type
+type ElementDefinitionTypeAggregation string
+
+const (
+	ElementDefinitionTypeAggregationContained  ElementDefinitionTypeAggregation = "contained"
+	ElementDefinitionTypeAggregationReferenced ElementDefinitionTypeAggregation = "referenced"
+	ElementDefinitionTypeAggregationBundled    ElementDefinitionTypeAggregation = "bundled"
+)
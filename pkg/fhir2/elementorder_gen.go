@@ -0,0 +1,256 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir2
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// elementOrder lists the properties of each struct in the element order of FHIR XML
+var elementOrder = common.ElementOrder{
+	reflect.TypeOf(Account{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "type", "status", "activePeriod", "currency", "balance", "coveragePeriod", "subject", "owner", "description"},
+	reflect.TypeOf(Address{}):                                          {"id", "extension", "use", "type", "text", "line", "city", "district", "state", "postalCode", "country", "period"},
+	reflect.TypeOf(Annotation{}):                                       {"id", "extension", "authorReference", "authorString", "time", "text"},
+	reflect.TypeOf(Appointment{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "reason", "priority", "description", "start", "end", "minutesDuration", "slot", "comment", "participant"},
+	reflect.TypeOf(AppointmentParticipant{}):                           {"id", "extension", "modifierExtension", "type", "actor", "required", "status"},
+	reflect.TypeOf(AppointmentResponse{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "appointment", "start", "end", "participantType", "actor", "participantStatus", "comment"},
+	reflect.TypeOf(Attachment{}):                                       {"id", "extension", "contentType", "language", "data", "url", "size", "hash", "title", "creation"},
+	reflect.TypeOf(AuditEvent{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "event", "participant", "source", "object"},
+	reflect.TypeOf(AuditEventEvent{}):                                  {"id", "extension", "modifierExtension", "type", "subtype", "action", "dateTime", "outcome", "outcomeDesc", "purposeOfEvent"},
+	reflect.TypeOf(AuditEventObject{}):                                 {"id", "extension", "modifierExtension", "identifier", "reference", "type", "role", "lifecycle", "securityLabel", "name", "description", "query", "detail"},
+	reflect.TypeOf(AuditEventParticipant{}):                            {"id", "extension", "modifierExtension", "role", "reference", "userId", "altId", "name", "requestor", "location", "policy", "media", "network", "purposeOfUse"},
+	reflect.TypeOf(AuditEventSource{}):                                 {"id", "extension", "modifierExtension", "site", "identifier", "type"},
+	reflect.TypeOf(Basic{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "subject", "author", "created"},
+	reflect.TypeOf(Binary{}):                                           {"id", "meta", "implicitRules", "language", "contentType", "content"},
+	reflect.TypeOf(BodySite{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "patient", "identifier", "code", "modifier", "description", "image"},
+	reflect.TypeOf(Bundle{}):                                           {"id", "meta", "implicitRules", "language", "type", "total", "link", "entry", "signature"},
+	reflect.TypeOf(BundleEntry{}):                                      {"id", "extension", "modifierExtension", "link", "fullUrl", "resource", "search", "request", "response"},
+	reflect.TypeOf(BundleEntryRequest{}):                               {"id", "extension", "modifierExtension", "method", "url", "ifNoneMatch", "ifModifiedSince", "ifMatch", "ifNoneExist"},
+	reflect.TypeOf(BundleEntryResponse{}):                              {"id", "extension", "modifierExtension", "status", "location", "etag", "lastModified"},
+	reflect.TypeOf(CarePlan{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "status", "period", "author", "modified", "participant", "goal", "activity", "note"},
+	reflect.TypeOf(Claim{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "type", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "use", "priority", "fundsReserve", "enterer", "facility", "prescription", "originalPrescription", "payee", "referral", "diagnosis", "condition", "patient", "coverage", "exception", "school", "accident", "accidentType", "interventionException", "item", "additionalMaterials", "missingTeeth"},
+	reflect.TypeOf(ClaimCoverage{}):                                    {"id", "extension", "modifierExtension", "sequence", "focal", "coverage", "businessArrangement", "relationship", "preAuthRef", "claimResponse", "originalRuleset"},
+	reflect.TypeOf(ClaimDiagnosis{}):                                   {"id", "extension", "modifierExtension", "sequence", "diagnosis"},
+	reflect.TypeOf(ClaimItem{}):                                        {"id", "extension", "modifierExtension", "sequence", "type", "provider", "diagnosisLinkId", "service", "serviceDate", "quantity", "unitPrice", "factor", "points", "net", "udi", "bodySite", "subSite", "modifier", "detail", "prosthesis"},
+	reflect.TypeOf(ClaimItemDetail{}):                                  {"id", "extension", "modifierExtension", "sequence", "type", "service", "quantity", "unitPrice", "factor", "points", "net", "udi", "subDetail"},
+	reflect.TypeOf(ClaimItemDetailSubDetail{}):                         {"id", "extension", "modifierExtension", "sequence", "type", "service", "quantity", "unitPrice", "factor", "points", "net", "udi"},
+	reflect.TypeOf(ClaimMissingTeeth{}):                                {"id", "extension", "modifierExtension", "tooth", "reason", "extractionDate"},
+	reflect.TypeOf(ClaimPayee{}):                                       {"id", "extension", "modifierExtension", "type", "provider", "organization", "person"},
+	reflect.TypeOf(ClaimResponse{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization", "outcome", "disposition", "payeeType", "item", "addItem", "error", "totalCost", "unallocDeductable", "totalBenefit", "paymentAdjustment", "paymentAdjustmentReason", "paymentDate", "paymentAmount", "paymentRef", "reserved", "form", "note", "coverage"},
+	reflect.TypeOf(ClaimResponseAddItem{}):                             {"id", "extension", "modifierExtension", "sequenceLinkId", "service", "fee", "noteNumberLinkId", "adjudication", "detail"},
+	reflect.TypeOf(ClaimResponseAddItemAdjudication{}):                 {"id", "extension", "modifierExtension", "code", "amount", "value"},
+	reflect.TypeOf(ClaimResponseAddItemDetail{}):                       {"id", "extension", "modifierExtension", "service", "fee", "adjudication"},
+	reflect.TypeOf(ClaimResponseAddItemDetailAdjudication{}):           {"id", "extension", "modifierExtension", "code", "amount", "value"},
+	reflect.TypeOf(ClaimResponseCoverage{}):                            {"id", "extension", "modifierExtension", "sequence", "focal", "coverage", "businessArrangement", "relationship", "preAuthRef", "claimResponse", "originalRuleset"},
+	reflect.TypeOf(ClaimResponseError{}):                               {"id", "extension", "modifierExtension", "sequenceLinkId", "detailSequenceLinkId", "subdetailSequenceLinkId", "code"},
+	reflect.TypeOf(ClaimResponseItem{}):                                {"id", "extension", "modifierExtension", "sequenceLinkId", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ClaimResponseItemAdjudication{}):                    {"id", "extension", "modifierExtension", "code", "amount", "value"},
+	reflect.TypeOf(ClaimResponseItemDetail{}):                          {"id", "extension", "modifierExtension", "sequenceLinkId", "adjudication", "subDetail"},
+	reflect.TypeOf(ClaimResponseItemDetailAdjudication{}):              {"id", "extension", "modifierExtension", "code", "amount", "value"},
+	reflect.TypeOf(ClaimResponseItemDetailSubDetail{}):                 {"id", "extension", "modifierExtension", "sequenceLinkId", "adjudication"},
+	reflect.TypeOf(ClaimResponseItemDetailSubDetailAdjudication{}):     {"id", "extension", "modifierExtension", "code", "amount", "value"},
+	reflect.TypeOf(ClaimResponseNote{}):                                {"id", "extension", "modifierExtension", "number", "type", "text"},
+	reflect.TypeOf(ClinicalImpression{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "patient", "assessor", "status", "date", "description", "previous", "problem", "triggerCodeableConcept", "triggerReference", "investigations", "protocol", "summary", "finding", "resolved", "ruledOut", "prognosis", "plan", "action"},
+	reflect.TypeOf(ClinicalImpressionFinding{}):                        {"id", "extension", "modifierExtension", "item", "cause"},
+	reflect.TypeOf(Communication{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "category", "sender", "recipient", "payload", "medium", "status", "encounter", "sent", "received", "reason", "subject", "requestDetail"},
+	reflect.TypeOf(CommunicationRequest{}):                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "category", "sender", "recipient", "payload", "medium", "requester", "status", "encounter", "scheduledDateTime", "scheduledPeriod", "reason", "requestedOn", "subject", "priority"},
+	reflect.TypeOf(Composition{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "date", "type", "class", "title", "status", "confidentiality", "subject", "author", "attester", "custodian", "event", "encounter", "section"},
+	reflect.TypeOf(CompositionAttester{}):                              {"id", "extension", "modifierExtension", "mode", "time", "party"},
+	reflect.TypeOf(CompositionEvent{}):                                 {"id", "extension", "modifierExtension", "code", "period", "detail"},
+	reflect.TypeOf(CompositionSection{}):                               {"id", "extension", "modifierExtension", "title", "code", "text", "mode", "orderedBy", "entry", "emptyReason", "section"},
+	reflect.TypeOf(ConceptMap{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "status", "experimental", "publisher", "contact", "date", "description", "useContext", "requirements", "copyright", "sourceUri", "sourceReference", "targetUri", "targetReference", "element"},
+	reflect.TypeOf(ConceptMapElement{}):                                {"id", "extension", "modifierExtension", "codeSystem", "code", "target"},
+	reflect.TypeOf(ConceptMapElementTarget{}):                          {"id", "extension", "modifierExtension", "codeSystem", "code", "equivalence", "comments", "dependsOn", "product"},
+	reflect.TypeOf(ConceptMapElementTargetDependsOn{}):                 {"id", "extension", "modifierExtension", "element", "codeSystem", "code"},
+	reflect.TypeOf(Condition{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "encounter", "asserter", "dateRecorded", "code", "category", "clinicalStatus", "verificationStatus", "severity", "onsetDateTime", "onsetQuantity", "onsetPeriod", "onsetRange", "onsetString", "abatementDateTime", "abatementQuantity", "abatementBoolean", "abatementPeriod", "abatementRange", "abatementString", "stage", "evidence", "bodySite", "notes"},
+	reflect.TypeOf(ConditionStage{}):                                   {"id", "extension", "modifierExtension", "summary", "assessment"},
+	reflect.TypeOf(Conformance{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "publisher", "contact", "date", "description", "requirements", "copyright", "kind", "software", "implementation", "fhirVersion", "acceptUnknown", "format", "profile", "rest", "messaging", "document"},
+	reflect.TypeOf(ConformanceDocument{}):                              {"id", "extension", "modifierExtension", "mode", "documentation", "profile"},
+	reflect.TypeOf(ConformanceMessaging{}):                             {"id", "extension", "modifierExtension", "endpoint", "reliableCache", "documentation", "event"},
+	reflect.TypeOf(ConformanceMessagingEndpoint{}):                     {"id", "extension", "modifierExtension", "protocol", "address"},
+	reflect.TypeOf(ConformanceMessagingEvent{}):                        {"id", "extension", "modifierExtension", "code", "category", "mode", "focus", "request", "response", "documentation"},
+	reflect.TypeOf(ConformanceRest{}):                                  {"id", "extension", "modifierExtension", "mode", "documentation", "security", "resource", "interaction", "transactionMode", "searchParam", "operation", "compartment"},
+	reflect.TypeOf(ConformanceRestOperation{}):                         {"id", "extension", "modifierExtension", "name", "definition"},
+	reflect.TypeOf(ConformanceRestResource{}):                          {"id", "extension", "modifierExtension", "type", "profile", "interaction", "versioning", "readHistory", "updateCreate", "conditionalCreate", "conditionalUpdate", "conditionalDelete", "searchInclude", "searchRevInclude", "searchParam"},
+	reflect.TypeOf(ConformanceRestResourceSearchParam{}):               {"id", "extension", "modifierExtension", "name", "definition", "type", "documentation", "target", "modifier", "chain"},
+	reflect.TypeOf(ConformanceRestSecurity{}):                          {"id", "extension", "modifierExtension", "cors", "service", "description", "certificate"},
+	reflect.TypeOf(ConformanceRestSecurityCertificate{}):               {"id", "extension", "modifierExtension", "type", "blob"},
+	reflect.TypeOf(ConformanceSoftware{}):                              {"id", "extension", "modifierExtension", "name", "version", "releaseDate"},
+	reflect.TypeOf(ContactPoint{}):                                     {"id", "extension", "system", "value", "use", "rank", "period"},
+	reflect.TypeOf(Contract{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "issued", "applies", "subject", "authority", "domain", "type", "subType", "action", "actionReason", "actor", "valuedItem", "signer", "term", "bindingAttachment", "bindingReference", "friendly", "legal", "rule"},
+	reflect.TypeOf(ContractSigner{}):                                   {"id", "extension", "modifierExtension", "type", "party", "signature"},
+	reflect.TypeOf(ContractTerm{}):                                     {"id", "extension", "modifierExtension", "identifier", "issued", "applies", "type", "subType", "subject", "action", "actionReason", "actor", "text", "valuedItem", "group"},
+	reflect.TypeOf(ContractTermValuedItem{}):                           {"id", "extension", "modifierExtension", "entityCodeableConcept", "entityReference", "identifier", "effectiveTime", "quantity", "unitPrice", "factor", "points", "net"},
+	reflect.TypeOf(ContractValuedItem{}):                               {"id", "extension", "modifierExtension", "entityCodeableConcept", "entityReference", "identifier", "effectiveTime", "quantity", "unitPrice", "factor", "points", "net"},
+	reflect.TypeOf(Coverage{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "issuer", "bin", "period", "type", "subscriberId", "identifier", "group", "plan", "subPlan", "dependent", "sequence", "subscriber", "network", "contract"},
+	reflect.TypeOf(DataElement{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "status", "experimental", "publisher", "contact", "date", "useContext", "copyright", "stringency", "mapping", "element"},
+	reflect.TypeOf(DataElementMapping{}):                               {"id", "extension", "modifierExtension", "identity", "uri", "name", "comments"},
+	reflect.TypeOf(DetectedIssue{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "patient", "category", "severity", "implicated", "detail", "date", "author", "identifier", "reference", "mitigation"},
+	reflect.TypeOf(DetectedIssueMitigation{}):                          {"id", "extension", "modifierExtension", "action", "date", "author"},
+	reflect.TypeOf(Device{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "note", "status", "manufacturer", "model", "version", "manufactureDate", "expiry", "udi", "lotNumber", "owner", "location", "patient", "contact", "url"},
+	reflect.TypeOf(DeviceComponent{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "type", "identifier", "lastSystemChange", "source", "parent", "operationalStatus", "parameterGroup", "measurementPrinciple", "productionSpecification", "languageCode"},
+	reflect.TypeOf(DeviceComponentProductionSpecification{}):           {"id", "extension", "modifierExtension", "specType", "componentId", "productionSpec"},
+	reflect.TypeOf(DeviceMetric{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "type", "identifier", "unit", "source", "parent", "operationalStatus", "color", "category", "measurementPeriod", "calibration"},
+	reflect.TypeOf(DeviceMetricCalibration{}):                          {"id", "extension", "modifierExtension", "type", "state", "time"},
+	reflect.TypeOf(DeviceUseRequest{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "bodySiteCodeableConcept", "bodySiteReference", "status", "device", "encounter", "identifier", "indication", "notes", "prnReason", "orderedOn", "recordedOn", "subject", "timingTiming", "timingPeriod", "timingDateTime", "priority"},
+	reflect.TypeOf(DeviceUseStatement{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "bodySiteCodeableConcept", "bodySiteReference", "whenUsed", "device", "identifier", "indication", "notes", "recordedOn", "subject", "timingTiming", "timingPeriod", "timingDateTime"},
+	reflect.TypeOf(DiagnosticOrder{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "subject", "orderer", "identifier", "encounter", "reason", "supportingInformation", "specimen", "status", "priority", "event", "item", "note"},
+	reflect.TypeOf(DiagnosticOrderEvent{}):                             {"id", "extension", "modifierExtension", "status", "description", "dateTime", "actor"},
+	reflect.TypeOf(DiagnosticOrderItem{}):                              {"id", "extension", "modifierExtension", "code", "specimen", "bodySite", "status", "event"},
+	reflect.TypeOf(DiagnosticReport{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "issued", "performer", "request", "specimen", "result", "imagingStudy", "image", "conclusion", "codedDiagnosis", "presentedForm"},
+	reflect.TypeOf(DocumentManifest{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "masterIdentifier", "identifier", "subject", "recipient", "type", "author", "created", "source", "status", "description", "content", "related"},
+	reflect.TypeOf(DocumentReference{}):                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "type", "class", "author", "custodian", "authenticator", "created", "indexed", "status", "docStatus", "description", "securityLabel", "content", "context"},
+	reflect.TypeOf(DocumentReferenceContext{}):                         {"id", "extension", "modifierExtension", "encounter", "event", "period", "facilityType", "practiceSetting", "sourcePatientInfo", "related"},
+	reflect.TypeOf(DosageInstruction{}):                                {"id", "text", "extension", "modifierExtension", "additionalInstructions", "asNeededBoolean", "asNeededCodeableConcept", "doseQuantity", "doseRange", "maxDosePerPeriod", "method", "rate", "route", "siteCodeableConcept", "siteReference", "timing"},
+	reflect.TypeOf(ElementDefinition{}):                                {"id", "extension", "path", "representation", "name", "label", "code", "slicing", "short", "definition", "comments", "requirements", "alias", "min", "max", "base", "type", "nameReference", "defaultValueBoolean", "defaultValueInteger", "defaultValueDecimal", "defaultValueBase64Binary", "defaultValueInstant", "defaultValueString", "defaultValueUri", "defaultValueDate", "defaultValueDateTime", "defaultValueTime", "defaultValueCode", "defaultValueOid", "defaultValueId", "defaultValueUnsignedInt", "defaultValuePositiveInt", "defaultValueMarkdown", "defaultValueAnnotation", "defaultValueAttachment", "defaultValueIdentifier", "defaultValueCodeableConcept", "defaultValueCoding", "defaultValueQuantity", "defaultValueRange", "defaultValuePeriod", "defaultValueRatio", "defaultValueSampledData", "defaultValueSignature", "defaultValueHumanName", "defaultValueAddress", "defaultValueContactPoint", "defaultValueTiming", "defaultValueReference", "defaultValueMeta", "meaningWhenMissing", "fixedBoolean", "fixedInteger", "fixedDecimal", "fixedBase64Binary", "fixedInstant", "fixedString", "fixedUri", "fixedDate", "fixedDateTime", "fixedTime", "fixedCode", "fixedOid", "fixedId", "fixedUnsignedInt", "fixedPositiveInt", "fixedMarkdown", "fixedAnnotation", "fixedAttachment", "fixedIdentifier", "fixedCodeableConcept", "fixedCoding", "fixedQuantity", "fixedRange", "fixedPeriod", "fixedRatio", "fixedSampledData", "fixedSignature", "fixedHumanName", "fixedAddress", "fixedContactPoint", "fixedTiming", "fixedReference", "fixedMeta", "patternBoolean", "patternInteger", "patternDecimal", "patternBase64Binary", "patternInstant", "patternString", "patternUri", "patternDate", "patternDateTime", "patternTime", "patternCode", "patternOid", "patternId", "patternUnsignedInt", "patternPositiveInt", "patternMarkdown", "patternAnnotation", "patternAttachment", "patternIdentifier", "patternCodeableConcept", "patternCoding", "patternQuantity", "patternRange", "patternPeriod", "patternRatio", "patternSampledData", "patternSignature", "patternHumanName", "patternAddress", "patternContactPoint", "patternTiming", "patternReference", "patternMeta", "exampleBoolean", "exampleInteger", "exampleDecimal", "exampleBase64Binary", "exampleInstant", "exampleString", "exampleUri", "exampleDate", "exampleDateTime", "exampleTime", "exampleCode", "exampleOid", "exampleId", "exampleUnsignedInt", "examplePositiveInt", "exampleMarkdown", "exampleAnnotation", "exampleAttachment", "exampleIdentifier", "exampleCodeableConcept", "exampleCoding", "exampleQuantity", "exampleRange", "examplePeriod", "exampleRatio", "exampleSampledData", "exampleSignature", "exampleHumanName", "exampleAddress", "exampleContactPoint", "exampleTiming", "exampleReference", "exampleMeta", "minValueBoolean", "minValueInteger", "minValueDecimal", "minValueBase64Binary", "minValueInstant", "minValueString", "minValueUri", "minValueDate", "minValueDateTime", "minValueTime", "minValueCode", "minValueOid", "minValueId", "minValueUnsignedInt", "minValuePositiveInt", "minValueMarkdown", "minValueAnnotation", "minValueAttachment", "minValueIdentifier", "minValueCodeableConcept", "minValueCoding", "minValueQuantity", "minValueRange", "minValuePeriod", "minValueRatio", "minValueSampledData", "minValueSignature", "minValueHumanName", "minValueAddress", "minValueContactPoint", "minValueTiming", "minValueReference", "minValueMeta", "maxValueBoolean", "maxValueInteger", "maxValueDecimal", "maxValueBase64Binary", "maxValueInstant", "maxValueString", "maxValueUri", "maxValueDate", "maxValueDateTime", "maxValueTime", "maxValueCode", "maxValueOid", "maxValueId", "maxValueUnsignedInt", "maxValuePositiveInt", "maxValueMarkdown", "maxValueAnnotation", "maxValueAttachment", "maxValueIdentifier", "maxValueCodeableConcept", "maxValueCoding", "maxValueQuantity", "maxValueRange", "maxValuePeriod", "maxValueRatio", "maxValueSampledData", "maxValueSignature", "maxValueHumanName", "maxValueAddress", "maxValueContactPoint", "maxValueTiming", "maxValueReference", "maxValueMeta", "maxLength", "condition", "constraint", "mustSupport", "isModifier", "isSummary", "binding", "mapping"},
+	reflect.TypeOf(ElementDefinitionBase{}):                            {"id", "extension", "path", "min", "max"},
+	reflect.TypeOf(ElementDefinitionBinding{}):                         {"id", "extension", "strength", "description", "valueSetUri", "valueSetReference"},
+	reflect.TypeOf(ElementDefinitionConstraint{}):                      {"id", "extension", "key", "requirements", "severity", "human", "xpath"},
+	reflect.TypeOf(ElementDefinitionSlicing{}):                         {"id", "extension", "discriminator", "description", "ordered", "rules"},
+	reflect.TypeOf(ElementDefinitionType{}):                            {"id", "extension", "code", "profile", "aggregation"},
+	reflect.TypeOf(EligibilityRequest{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization"},
+	reflect.TypeOf(EligibilityResponse{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization"},
+	reflect.TypeOf(Encounter{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "class", "type", "priority", "patient", "episodeOfCare", "incomingReferral", "participant", "appointment", "period", "length", "reason", "indication", "hospitalization", "location", "serviceProvider", "partOf"},
+	reflect.TypeOf(EncounterHospitalization{}):                         {"id", "extension", "modifierExtension", "preAdmissionIdentifier", "origin", "admitSource", "reAdmission", "dietPreference", "specialCourtesy", "specialArrangement", "destination", "dischargeDisposition"},
+	reflect.TypeOf(EncounterLocation{}):                                {"id", "extension", "modifierExtension", "location", "status", "period"},
+	reflect.TypeOf(EncounterParticipant{}):                             {"id", "extension", "modifierExtension", "type", "period", "individual"},
+	reflect.TypeOf(EncounterStatusHistory{}):                           {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(EnrollmentRequest{}):                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "subject", "coverage", "relationship"},
+	reflect.TypeOf(EnrollmentResponse{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization"},
+	reflect.TypeOf(EpisodeOfCare{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "type", "condition", "patient", "managingOrganization", "period", "referralRequest", "careManager", "careTeam"},
+	reflect.TypeOf(EpisodeOfCareCareTeam{}):                            {"id", "extension", "modifierExtension", "role", "period", "member"},
+	reflect.TypeOf(EpisodeOfCareStatusHistory{}):                       {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(ExplanationOfBenefit{}):                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization"},
+	reflect.TypeOf(FamilyMemberHistory{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "date", "status", "name", "relationship", "gender", "bornPeriod", "bornDate", "bornString", "ageQuantity", "ageRange", "ageString", "deceasedBoolean", "deceasedQuantity", "deceasedRange", "deceasedDate", "deceasedString", "note", "condition"},
+	reflect.TypeOf(FamilyMemberHistoryCondition{}):                     {"id", "extension", "modifierExtension", "code", "outcome", "onsetQuantity", "onsetRange", "onsetPeriod", "onsetString", "note"},
+	reflect.TypeOf(Flag{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "category", "status", "period", "subject", "encounter", "author", "code"},
+	reflect.TypeOf(Goal{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "startDate", "startCodeableConcept", "targetDate", "targetQuantity", "category", "description", "status", "statusDate", "statusReason", "author", "priority", "addresses", "note", "outcome"},
+	reflect.TypeOf(Group{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "actual", "code", "name", "quantity", "characteristic", "member"},
+	reflect.TypeOf(GroupCharacteristic{}):                              {"id", "extension", "modifierExtension", "code", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "exclude", "period"},
+	reflect.TypeOf(GroupMember{}):                                      {"id", "extension", "modifierExtension", "entity", "period", "inactive"},
+	reflect.TypeOf(HealthcareService{}):                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "providedBy", "serviceCategory", "serviceType", "location", "serviceName", "comment", "extraDetails", "photo", "telecom", "coverageArea", "serviceProvisionCode", "eligibility", "eligibilityNote", "programName", "characteristic", "referralMethod", "publicKey", "appointmentRequired", "availableTime", "notAvailable", "availabilityExceptions"},
+	reflect.TypeOf(HealthcareServiceAvailableTime{}):                   {"id", "extension", "modifierExtension", "daysOfWeek", "allDay", "availableStartTime", "availableEndTime"},
+	reflect.TypeOf(HealthcareServiceServiceType{}):                     {"id", "extension", "modifierExtension", "type", "specialty"},
+	reflect.TypeOf(ImagingObjectSelection{}):                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "uid", "patient", "title", "description", "author", "authoringTime", "study"},
+	reflect.TypeOf(ImagingObjectSelectionStudy{}):                      {"id", "extension", "modifierExtension", "uid", "url", "imagingStudy", "series"},
+	reflect.TypeOf(ImagingObjectSelectionStudySeries{}):                {"id", "extension", "modifierExtension", "uid", "url", "instance"},
+	reflect.TypeOf(ImagingObjectSelectionStudySeriesInstance{}):        {"id", "extension", "modifierExtension", "sopClass", "uid", "url", "frames"},
+	reflect.TypeOf(ImagingStudy{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "started", "patient", "uid", "accession", "identifier", "order", "modalityList", "referrer", "availability", "url", "numberOfSeries", "numberOfInstances", "procedure", "interpreter", "description", "series"},
+	reflect.TypeOf(ImagingStudySeries{}):                               {"id", "extension", "modifierExtension", "number", "modality", "uid", "description", "numberOfInstances", "availability", "url", "bodySite", "laterality", "started", "instance"},
+	reflect.TypeOf(ImagingStudySeriesInstance{}):                       {"id", "extension", "modifierExtension", "number", "uid", "sopClass", "type", "title", "content"},
+	reflect.TypeOf(ImmunizationRecommendationRecommendation{}):         {"id", "extension", "modifierExtension", "date", "vaccineCode", "doseNumber", "forecastStatus", "dateCriterion", "protocol", "supportingImmunization", "supportingPatientInformation"},
+	reflect.TypeOf(ImmunizationRecommendationRecommendationProtocol{}): {"id", "extension", "modifierExtension", "doseSequence", "description", "authority", "series"},
+	reflect.TypeOf(ImplementationGuide{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "publisher", "contact", "date", "description", "useContext", "copyright", "fhirVersion", "dependency", "package", "global", "binary", "page"},
+	reflect.TypeOf(ImplementationGuideGlobal{}):                        {"id", "extension", "modifierExtension", "type", "profile"},
+	reflect.TypeOf(ImplementationGuidePackage{}):                       {"id", "extension", "modifierExtension", "name", "description", "resource"},
+	reflect.TypeOf(ImplementationGuidePackageResource{}):               {"id", "extension", "modifierExtension", "purpose", "name", "description", "acronym", "sourceUri", "sourceReference", "exampleFor"},
+	reflect.TypeOf(ImplementationGuidePage{}):                          {"id", "extension", "modifierExtension", "source", "name", "kind", "type", "package", "format", "page"},
+	reflect.TypeOf(List{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "title", "code", "subject", "source", "encounter", "status", "date", "orderedBy", "mode", "note", "entry", "emptyReason"},
+	reflect.TypeOf(ListEntry{}):                                        {"id", "extension", "modifierExtension", "flag", "deleted", "date", "item"},
+	reflect.TypeOf(Location{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "name", "description", "mode", "type", "telecom", "address", "physicalType", "position", "managingOrganization", "partOf"},
+	reflect.TypeOf(LocationPosition{}):                                 {"id", "extension", "modifierExtension", "longitude", "latitude", "altitude"},
+	reflect.TypeOf(Media{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "type", "subtype", "identifier", "subject", "operator", "view", "deviceName", "height", "width", "frames", "duration", "content"},
+	reflect.TypeOf(MedicationAdministration{}):                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "practitioner", "encounter", "prescription", "wasNotGiven", "reasonNotGiven", "reasonGiven", "effectiveTimeDateTime", "effectiveTimePeriod", "medicationCodeableConcept", "medicationReference", "device", "note", "dosage"},
+	reflect.TypeOf(MedicationAdministrationDosage{}):                   {"id", "extension", "modifierExtension", "text", "siteCodeableConcept", "siteReference", "route", "method", "quantity", "rateRatio", "rateRange"},
+	reflect.TypeOf(MedicationDispense{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "dispenser", "authorizingPrescription", "type", "quantity", "daysSupply", "medicationCodeableConcept", "medicationReference", "whenPrepared", "whenHandedOver", "destination", "receiver", "note", "dosageInstruction", "substitution"},
+	reflect.TypeOf(MedicationDispenseDosageInstruction{}):              {"id", "extension", "modifierExtension", "text", "additionalInstructions", "timing", "asNeededBoolean", "asNeededCodeableConcept", "siteCodeableConcept", "siteReference", "route", "method", "doseRange", "doseQuantity", "rateRatio", "rateRange", "maxDosePerPeriod"},
+	reflect.TypeOf(MedicationDispenseSubstitution{}):                   {"id", "extension", "modifierExtension", "type", "reason", "responsibleParty"},
+	reflect.TypeOf(MedicationOrder{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "dateWritten", "status", "dateEnded", "reasonEnded", "patient", "prescriber", "encounter", "reasonCodeableConcept", "reasonReference", "note", "medicationCodeableConcept", "medicationReference", "dosageInstruction", "dispenseRequest", "substitution", "priorPrescription", "priority"},
+	reflect.TypeOf(MedicationOrderDispenseRequest{}):                   {"id", "extension", "modifierExtension", "medicationCodeableConcept", "medicationReference", "validityPeriod", "numberOfRepeatsAllowed", "quantity", "expectedSupplyDuration"},
+	reflect.TypeOf(MedicationProductBatch{}):                           {"id", "extension", "modifierExtension", "lotNumber", "expirationDate"},
+	reflect.TypeOf(MedicationStatement{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "informationSource", "dateAsserted", "status", "wasNotTaken", "reasonNotTaken", "reasonForUseCodeableConcept", "reasonForUseReference", "effectiveDateTime", "effectivePeriod", "note", "supportingInformation", "medicationCodeableConcept", "medicationReference", "dosage"},
+	reflect.TypeOf(MedicationStatementDosage{}):                        {"id", "extension", "modifierExtension", "text", "timing", "asNeededBoolean", "asNeededCodeableConcept", "siteCodeableConcept", "siteReference", "route", "method", "quantityQuantity", "quantityRange", "rateRatio", "rateRange", "maxDosePerPeriod"},
+	reflect.TypeOf(MessageHeader{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "timestamp", "event", "response", "source", "destination", "enterer", "author", "receiver", "responsible", "reason", "data"},
+	reflect.TypeOf(MessageHeaderDestination{}):                         {"id", "extension", "modifierExtension", "name", "target", "endpoint"},
+	reflect.TypeOf(MessageHeaderResponse{}):                            {"id", "extension", "modifierExtension", "identifier", "code", "details"},
+	reflect.TypeOf(MessageHeaderSource{}):                              {"id", "extension", "modifierExtension", "name", "software", "version", "contact", "endpoint"},
+	reflect.TypeOf(NamingSystem{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "name", "status", "kind", "publisher", "contact", "responsible", "date", "type", "description", "useContext", "usage", "uniqueId", "replacedBy"},
+	reflect.TypeOf(NamingSystemUniqueId{}):                             {"id", "extension", "modifierExtension", "type", "value", "preferred", "period"},
+	reflect.TypeOf(NutritionOrder{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "patient", "orderer", "identifier", "encounter", "dateTime", "status", "allergyIntolerance", "foodPreferenceModifier", "excludeFoodModifier", "oralDiet", "supplement", "enteralFormula"},
+	reflect.TypeOf(NutritionOrderEnteralFormula{}):                     {"id", "extension", "modifierExtension", "baseFormulaType", "baseFormulaProductName", "additiveType", "additiveProductName", "caloricDensity", "routeofAdministration", "administration", "maxVolumeToDeliver", "administrationInstruction"},
+	reflect.TypeOf(NutritionOrderEnteralFormulaAdministration{}):       {"id", "extension", "modifierExtension", "schedule", "quantity", "rateQuantity", "rateRatio"},
+	reflect.TypeOf(NutritionOrderOralDiet{}):                           {"id", "extension", "modifierExtension", "type", "schedule", "nutrient", "texture", "fluidConsistencyType", "instruction"},
+	reflect.TypeOf(NutritionOrderOralDietNutrient{}):                   {"id", "extension", "modifierExtension", "modifier", "amount"},
+	reflect.TypeOf(NutritionOrderOralDietTexture{}):                    {"id", "extension", "modifierExtension", "modifier", "foodType"},
+	reflect.TypeOf(NutritionOrderSupplement{}):                         {"id", "extension", "modifierExtension", "type", "productName", "schedule", "quantity", "instruction"},
+	reflect.TypeOf(Observation{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "issued", "performer", "valueQuantity", "valueCodeableConcept", "valueString", "valueRange", "valueRatio", "valueSampledData", "valueAttachment", "valueTime", "valueDateTime", "valuePeriod", "dataAbsentReason", "interpretation", "comments", "bodySite", "method", "specimen", "device", "referenceRange", "related", "component"},
+	reflect.TypeOf(ObservationComponent{}):                             {"id", "extension", "modifierExtension", "code", "valueQuantity", "valueCodeableConcept", "valueString", "valueRange", "valueRatio", "valueSampledData", "valueAttachment", "valueTime", "valueDateTime", "valuePeriod", "dataAbsentReason", "referenceRange"},
+	reflect.TypeOf(ObservationReferenceRange{}):                        {"id", "extension", "modifierExtension", "low", "high", "meaning", "age", "text"},
+	reflect.TypeOf(ObservationRelated{}):                               {"id", "extension", "modifierExtension", "type", "target"},
+	reflect.TypeOf(OperationDefinition{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "kind", "experimental", "publisher", "contact", "date", "description", "requirements", "idempotent", "code", "notes", "base", "system", "type", "instance", "parameter"},
+	reflect.TypeOf(OperationDefinitionParameter{}):                     {"id", "extension", "modifierExtension", "name", "use", "min", "max", "documentation", "type", "profile", "binding", "part"},
+	reflect.TypeOf(OperationOutcomeIssue{}):                            {"id", "extension", "modifierExtension", "severity", "code", "details", "diagnostics", "location"},
+	reflect.TypeOf(Order{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "date", "subject", "source", "target", "reasonCodeableConcept", "reasonReference", "when", "detail"},
+	reflect.TypeOf(OrderResponse{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "date", "who", "orderStatus", "description", "fulfillment"},
+	reflect.TypeOf(Organization{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "name", "telecom", "address", "partOf", "contact"},
+	reflect.TypeOf(OrganizationContact{}):                              {"id", "extension", "modifierExtension", "purpose", "name", "telecom", "address"},
+	reflect.TypeOf(ParametersParameter{}):                              {"id", "extension", "modifierExtension", "name", "valueBoolean", "valueInteger", "valueDecimal", "valueBase64Binary", "valueInstant", "valueString", "valueUri", "valueDate", "valueDateTime", "valueTime", "valueCode", "valueOid", "valueId", "valueUnsignedInt", "valuePositiveInt", "valueMarkdown", "valueAnnotation", "valueAttachment", "valueIdentifier", "valueCodeableConcept", "valueCoding", "valueQuantity", "valueRange", "valuePeriod", "valueRatio", "valueSampledData", "valueSignature", "valueHumanName", "valueAddress", "valueContactPoint", "valueTiming", "valueReference", "valueMeta", "resource", "part"},
+	reflect.TypeOf(Patient{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "address", "maritalStatus", "multipleBirthBoolean", "multipleBirthInteger", "photo", "contact", "animal", "communication", "careProvider", "managingOrganization", "link"},
+	reflect.TypeOf(PatientContact{}):                                   {"id", "extension", "modifierExtension", "relationship", "name", "telecom", "address", "gender", "period"},
+	reflect.TypeOf(PaymentNotice{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "request", "response", "paymentStatus"},
+	reflect.TypeOf(PaymentReconciliation{}):                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "period", "organization", "requestProvider", "requestOrganization", "detail", "form", "total", "note"},
+	reflect.TypeOf(PaymentReconciliationDetail{}):                      {"id", "extension", "modifierExtension", "type", "request", "responce", "submitter", "payee", "date", "amount"},
+	reflect.TypeOf(PaymentReconciliationNote{}):                        {"id", "extension", "modifierExtension", "type", "text"},
+	reflect.TypeOf(Person{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "telecom", "gender", "birthDate", "address", "photo", "managingOrganization", "active", "link"},
+	reflect.TypeOf(PersonLink{}):                                       {"id", "extension", "modifierExtension", "target", "assurance"},
+	reflect.TypeOf(Practitioner{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "address", "gender", "birthDate", "photo", "practitionerRole", "qualification", "communication"},
+	reflect.TypeOf(PractitionerQualification{}):                        {"id", "extension", "modifierExtension", "identifier", "code", "period", "issuer"},
+	reflect.TypeOf(Procedure{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "status", "category", "code", "notPerformed", "reasonNotPerformed", "bodySite", "reasonCodeableConcept", "reasonReference", "performer", "performedDateTime", "performedPeriod", "encounter", "location", "outcome", "report", "complication", "device", "followUp", "request", "notes", "focalDevice", "used"},
+	reflect.TypeOf(ProcedureRequest{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "code", "bodySite", "reasonCodeableConcept", "reasonReference", "scheduledDateTime", "scheduledPeriod", "scheduledTiming", "encounter", "performer", "status", "notes", "asNeededBoolean", "asNeededCodeableConcept", "orderedOn", "orderer", "priority"},
+	reflect.TypeOf(ProcessRequest{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "action", "identifier", "ruleset", "originalRuleset", "created", "target", "provider", "organization", "request", "response", "nullify", "reference", "item", "include", "exclude", "period"},
+	reflect.TypeOf(ProcessResponse{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "request", "outcome", "disposition", "ruleset", "originalRuleset", "created", "organization", "requestProvider", "requestOrganization", "form", "notes", "error"},
+	reflect.TypeOf(ProcessResponseNotes{}):                             {"id", "extension", "modifierExtension", "type", "text"},
+	reflect.TypeOf(Provenance{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "target", "period", "recorded", "reason", "activity", "location", "policy", "agent", "entity", "signature"},
+	reflect.TypeOf(ProvenanceAgent{}):                                  {"id", "extension", "modifierExtension", "role", "actor", "userId", "relatedAgent"},
+	reflect.TypeOf(ProvenanceAgentRelatedAgent{}):                      {"id", "extension", "modifierExtension", "type", "target"},
+	reflect.TypeOf(ProvenanceEntity{}):                                 {"id", "extension", "modifierExtension", "role", "type", "reference", "display", "agent"},
+	reflect.TypeOf(Questionnaire{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "version", "status", "date", "publisher", "telecom", "subjectType", "group"},
+	reflect.TypeOf(QuestionnaireGroup{}):                               {"id", "extension", "modifierExtension", "linkId", "title", "concept", "text", "required", "repeats", "group", "question"},
+	reflect.TypeOf(QuestionnaireGroupQuestion{}):                       {"id", "extension", "modifierExtension", "linkId", "concept", "text", "type", "required", "repeats", "options", "option", "group"},
+	reflect.TypeOf(QuestionnaireResponse{}):                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "questionnaire", "status", "subject", "author", "authored", "source", "encounter", "group"},
+	reflect.TypeOf(QuestionnaireResponseGroup{}):                       {"id", "extension", "modifierExtension", "linkId", "title", "text", "subject", "group", "question"},
+	reflect.TypeOf(QuestionnaireResponseGroupQuestion{}):               {"id", "extension", "modifierExtension", "linkId", "text", "answer"},
+	reflect.TypeOf(QuestionnaireResponseGroupQuestionAnswer{}):         {"id", "extension", "modifierExtension", "valueBoolean", "valueDecimal", "valueInteger", "valueDate", "valueDateTime", "valueInstant", "valueTime", "valueString", "valueUri", "valueAttachment", "valueCoding", "valueQuantity", "valueReference", "group"},
+	reflect.TypeOf(ReferralRequest{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "status", "identifier", "date", "type", "specialty", "priority", "patient", "requester", "recipient", "encounter", "dateSent", "reason", "description", "serviceRequested", "supportingInformation", "fulfillmentTime"},
+	reflect.TypeOf(RelatedPerson{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "relationship", "name", "telecom", "gender", "birthDate", "address", "photo", "period"},
+	reflect.TypeOf(RiskAssessment{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "subject", "date", "condition", "encounter", "performer", "identifier", "method", "basis", "prediction", "mitigation"},
+	reflect.TypeOf(RiskAssessmentPrediction{}):                         {"id", "extension", "modifierExtension", "outcome", "probabilityDecimal", "probabilityRange", "probabilityCodeableConcept", "relativeRisk", "whenPeriod", "whenRange", "rationale"},
+	reflect.TypeOf(SampledData{}):                                      {"id", "extension", "origin", "period", "factor", "lowerLimit", "upperLimit", "dimensions", "data"},
+	reflect.TypeOf(Schedule{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "actor", "planningHorizon", "comment"},
+	reflect.TypeOf(SearchParameter{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "name", "status", "experimental", "publisher", "contact", "date", "requirements", "code", "base", "type", "description", "xpath", "xpathUsage", "target"},
+	reflect.TypeOf(Signature{}):                                        {"id", "extension", "type", "when", "whoUri", "whoReference", "contentType", "blob"},
+	reflect.TypeOf(Slot{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "schedule", "freeBusyType", "start", "end", "overbooked", "comment"},
+	reflect.TypeOf(StructureDefinition{}):                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "display", "status", "experimental", "publisher", "contact", "date", "description", "useContext", "requirements", "copyright", "code", "fhirVersion", "mapping", "kind", "constrainedType", "abstract", "contextType", "context", "base", "snapshot", "differential"},
+	reflect.TypeOf(StructureDefinitionMapping{}):                       {"id", "extension", "modifierExtension", "identity", "uri", "name", "comments"},
+	reflect.TypeOf(Subscription{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "criteria", "contact", "reason", "status", "error", "channel", "end", "tag"},
+	reflect.TypeOf(SubscriptionChannel{}):                              {"id", "extension", "modifierExtension", "type", "endpoint", "payload", "header"},
+	reflect.TypeOf(Substance{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "category", "code", "description", "instance", "ingredient"},
+	reflect.TypeOf(SubstanceInstance{}):                                {"id", "extension", "modifierExtension", "identifier", "expiry", "quantity"},
+	reflect.TypeOf(SupplyDelivery{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "type", "quantity", "suppliedItem", "supplier", "whenPrepared", "time", "destination", "receiver"},
+	reflect.TypeOf(SupplyRequest{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "patient", "source", "date", "identifier", "status", "kind", "orderedItem", "supplier", "reasonCodeableConcept", "reasonReference", "when"},
+	reflect.TypeOf(TestScript{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "identifier", "experimental", "publisher", "contact", "date", "description", "useContext", "requirements", "copyright", "metadata", "multiserver", "fixture", "profile", "variable", "setup", "test", "teardown"},
+	reflect.TypeOf(TestScriptMetadata{}):                               {"id", "extension", "modifierExtension", "link", "capability"},
+	reflect.TypeOf(TestScriptMetadataCapability{}):                     {"id", "extension", "modifierExtension", "required", "validated", "description", "destination", "link", "conformance"},
+	reflect.TypeOf(TestScriptMetadataLink{}):                           {"id", "extension", "modifierExtension", "url", "description"},
+	reflect.TypeOf(TestScriptSetup{}):                                  {"id", "extension", "modifierExtension", "metadata", "action"},
+	reflect.TypeOf(TestScriptSetupAction{}):                            {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScriptSetupActionAssert{}):                      {"id", "extension", "modifierExtension", "label", "description", "direction", "compareToSourceId", "compareToSourcePath", "contentType", "headerField", "minimumId", "navigationLinks", "operator", "path", "resource", "response", "responseCode", "sourceId", "validateProfileId", "value", "warningOnly"},
+	reflect.TypeOf(TestScriptSetupActionOperation{}):                   {"id", "extension", "modifierExtension", "type", "resource", "label", "description", "accept", "contentType", "destination", "encodeRequestUrl", "params", "requestHeader", "responseId", "sourceId", "targetId", "url"},
+	reflect.TypeOf(TestScriptTest{}):                                   {"id", "extension", "modifierExtension", "name", "description", "metadata", "action"},
+	reflect.TypeOf(TestScriptTestAction{}):                             {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScriptVariable{}):                               {"id", "extension", "modifierExtension", "name", "headerField", "path", "sourceId"},
+	reflect.TypeOf(Timing{}):                                           {"id", "extension", "event", "repeat", "code"},
+	reflect.TypeOf(ValueSet{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "status", "experimental", "publisher", "contact", "date", "lockedDate", "description", "useContext", "immutable", "requirements", "copyright", "extensible", "codeSystem", "compose", "expansion"},
+	reflect.TypeOf(ValueSetCodeSystem{}):                               {"id", "extension", "modifierExtension", "system", "version", "caseSensitive", "concept"},
+	reflect.TypeOf(ValueSetCodeSystemConcept{}):                        {"id", "extension", "modifierExtension", "code", "abstract", "display", "definition", "designation", "concept"},
+	reflect.TypeOf(ValueSetCompose{}):                                  {"id", "extension", "modifierExtension", "import", "include", "exclude"},
+	reflect.TypeOf(ValueSetComposeInclude{}):                           {"id", "extension", "modifierExtension", "system", "version", "concept", "filter"},
+	reflect.TypeOf(ValueSetComposeIncludeConcept{}):                    {"id", "extension", "modifierExtension", "code", "display", "designation"},
+	reflect.TypeOf(ValueSetComposeIncludeFilter{}):                     {"id", "extension", "modifierExtension", "property", "op", "value"},
+	reflect.TypeOf(ValueSetExpansion{}):                                {"id", "extension", "modifierExtension", "identifier", "timestamp", "total", "offset", "parameter", "contains"},
+	reflect.TypeOf(ValueSetExpansionContains{}):                        {"id", "extension", "modifierExtension", "system", "abstract", "version", "code", "display", "contains"},
+	reflect.TypeOf(VisionPrescription{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "dateWritten", "patient", "prescriber", "encounter", "reasonCodeableConcept", "reasonReference", "dispense"},
+	reflect.TypeOf(VisionPrescriptionDispense{}):                       {"id", "extension", "modifierExtension", "product", "eye", "sphere", "cylinder", "axis", "prism", "base", "add", "power", "backCurve", "diameter", "duration", "color", "brand", "notes"},
+}
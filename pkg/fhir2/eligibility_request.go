@@ -0,0 +1,32 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EligibilityRequest provides the insurance eligibility details from the insurer regarding a specified coverage and optionally some class of service
+type EligibilityRequest struct {
+	DomainResource
+
+	// The date when this resource was created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// The Insurer who is target of the request
+	Target *common.Reference `json:"target,omitempty"`
+}
@@ -0,0 +1,41 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EnrollmentRequest represents enroll in coverage
+type EnrollmentRequest struct {
+	DomainResource
+
+	// Insurance information
+	Coverage *common.Reference `json:"coverage"`
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Business Identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// Responsible practitioner
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// To determine the relationship between the patient and the subscriber
+	Relationship *common.Coding `json:"relationship"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// Patient Resource
+	Subject *common.Reference `json:"subject"`
+
+	// The Insurer who is target of the request
+	Target *common.Reference `json:"target,omitempty"`
+}
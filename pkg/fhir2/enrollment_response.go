@@ -0,0 +1,51 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EnrollmentResponse represents enrollmentResponse resource
+type EnrollmentResponse struct {
+	DomainResource
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Disposition Message
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Business Identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Insurer
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// queued | complete | error | partial
+	Outcome        *EnrollmentResponseOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element            `json:"_outcome,omitempty"`
+
+	// Claim reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// Responsible practitioner
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+}
+
+// EnrollmentResponseOutcome represents the outcome of an enrollment response
+type EnrollmentResponseOutcome string
+
+const (
+	EnrollmentResponseOutcomeComplete EnrollmentResponseOutcome = "complete"
+	EnrollmentResponseOutcomeError    EnrollmentResponseOutcome = "error"
+)
@@ -0,0 +1,82 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EpisodeOfCare represents an association of a Patient with an Organization and  Healthcare Provider(s) for a period of time that the Organization assumes some level of responsibility
+type EpisodeOfCare struct {
+	DomainResource
+
+	// Care manager/care coordinator for the patient
+	CareManager *common.Reference `json:"careManager,omitempty"`
+
+	// Other practitioners facilitating this episode of care
+	CareTeam []EpisodeOfCareCareTeam `json:"careTeam,omitempty"`
+
+	// A list of conditions/problems/diagnoses that this episode of care is intended to be providing care for
+	Condition []common.Reference `json:"condition,omitempty"`
+
+	// Business Identifier(s) relevant for this EpisodeOfCare
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Organization that assumes responsibility for care coordination
+	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
+
+	// The patient who is the focus of this episode of care
+	Patient *common.Reference `json:"patient"`
+
+	// Interval during responsibility is assumed
+	Period *common.Period `json:"period,omitempty"`
+
+	// Originating Referral Request(s)
+	ReferralRequest []common.Reference `json:"referralRequest,omitempty"`
+
+	// planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Past list of status codes (the current status may be included to cover the start date of the status)
+	StatusHistory []EpisodeOfCareStatusHistory `json:"statusHistory,omitempty"`
+
+	// Type/class  - e.g. specialist referral, disease management
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// EpisodeOfCareStatus represents the status of an episode of care
+type EpisodeOfCareStatus string
+
+const (
+	EpisodeOfCareStatusPlanned   EpisodeOfCareStatus = "planned"
+	EpisodeOfCareStatusWaitlist  EpisodeOfCareStatus = "waitlist"
+	EpisodeOfCareStatusActive    EpisodeOfCareStatus = "active"
+	EpisodeOfCareStatusOnhold    EpisodeOfCareStatus = "onhold"
+	EpisodeOfCareStatusFinished  EpisodeOfCareStatus = "finished"
+	EpisodeOfCareStatusCancelled EpisodeOfCareStatus = "cancelled"
+)
+
+// EpisodeOfCareStatusHistory represents past list of status codes (the current status may be included to cover the start date of the status)
+type EpisodeOfCareStatusHistory struct {
+	common.BackboneElement
+
+	// Duration the EpisodeOfCare was in the specified status
+	Period *common.Period `json:"period"`
+
+	// planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+}
+
+// EpisodeOfCareCareTeam represents the care team of an episode of care
+type EpisodeOfCareCareTeam struct {
+	common.BackboneElement
+
+	// The practitioner (or Organization) within the team
+	Member *common.Reference `json:"member,omitempty"`
+
+	// The period of time this practitioner is performing some role within the episode of care
+	Period *common.Period `json:"period,omitempty"`
+
+	// The role this team member is taking within this episode of care
+	Role []common.CodeableConcept `json:"role,omitempty"`
+}
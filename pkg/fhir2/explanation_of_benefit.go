@@ -0,0 +1,51 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ExplanationOfBenefit represents this resource provides: the claim details; adjudication details from the processing of a Claim
+type ExplanationOfBenefit struct {
+	DomainResource
+
+	// The date when the enclosed suite of services were performed or completed
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// A description of the status of the adjudication
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The Insurer who produced this adjudicated response
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// Transaction status: error, complete
+	Outcome        *ExplanationOfBenefitOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element              `json:"_outcome,omitempty"`
+
+	// Original request resource reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+}
+
+// ExplanationOfBenefitOutcome represents the outcome of an explanation of benefit
+type ExplanationOfBenefitOutcome string
+
+const (
+	ExplanationOfBenefitOutcomeComplete ExplanationOfBenefitOutcome = "complete"
+	ExplanationOfBenefitOutcomeError    ExplanationOfBenefitOutcome = "error"
+)
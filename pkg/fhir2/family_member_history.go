@@ -0,0 +1,105 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// FamilyMemberHistory represents information about patient's relatives, relevant for patient
+type FamilyMemberHistory struct {
+	DomainResource
+
+	// While age can be calculated from date of birth, sometimes recording age directly is more natureal for clinicians
+	AgeQuantity      *common.Quantity `json:"ageQuantity,omitempty"`
+	AgeRange         *Range           `json:"ageRange,omitempty"`
+	AgeString        *string          `json:"ageString,omitempty"`
+	AgeStringElement *common.Element  `json:"_ageString,omitempty"`
+
+	// (approximate) date of birth
+	BornPeriod        *common.Period  `json:"bornPeriod,omitempty"`
+	BornDate          *common.Date    `json:"bornDate,omitempty"`
+	BornDateElement   *common.Element `json:"_bornDate,omitempty"`
+	BornString        *string         `json:"bornString,omitempty"`
+	BornStringElement *common.Element `json:"_bornString,omitempty"`
+
+	// Condition that the related person had
+	Condition []FamilyMemberHistoryCondition `json:"condition,omitempty"`
+
+	// When history was recorded or last updated
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Dead? How old/when?
+	DeceasedBoolean        *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement *common.Element  `json:"_deceasedBoolean,omitempty"`
+	DeceasedQuantity       *common.Quantity `json:"deceasedQuantity,omitempty"`
+	DeceasedRange          *Range           `json:"deceasedRange,omitempty"`
+	DeceasedDate           *common.Date     `json:"deceasedDate,omitempty"`
+	DeceasedDateElement    *common.Element  `json:"_deceasedDate,omitempty"`
+	DeceasedString         *string          `json:"deceasedString,omitempty"`
+	DeceasedStringElement  *common.Element  `json:"_deceasedString,omitempty"`
+
+	// Not all relationship codes imply gender and the relative's gender can be relevant for risk assessments
+	Gender        *FamilyMemberHistoryGender `json:"gender,omitempty"`
+	GenderElement *common.Element            `json:"_gender,omitempty"`
+
+	// External Id(s) for this record
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The family member described
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// General note about related person
+	Note *Annotation `json:"note,omitempty"`
+
+	// Patient history is about
+	Patient *common.Reference `json:"patient"`
+
+	// Relationship to the subject
+	Relationship *common.CodeableConcept `json:"relationship"`
+
+	// partial | completed | entered-in-error | health-unknown
+	Status        FamilyMemberHistoryStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+}
+
+// FamilyMemberHistoryGender represents the gender of a family member history
+type FamilyMemberHistoryGender string
+
+const (
+	FamilyMemberHistoryGenderMale    FamilyMemberHistoryGender = "male"
+	FamilyMemberHistoryGenderFemale  FamilyMemberHistoryGender = "female"
+	FamilyMemberHistoryGenderOther   FamilyMemberHistoryGender = "other"
+	FamilyMemberHistoryGenderUnknown FamilyMemberHistoryGender = "unknown"
+)
+
+// FamilyMemberHistoryStatus represents the status of a family member history
+type FamilyMemberHistoryStatus string
+
+const (
+	FamilyMemberHistoryStatusPartial        FamilyMemberHistoryStatus = "partial"
+	FamilyMemberHistoryStatusCompleted      FamilyMemberHistoryStatus = "completed"
+	FamilyMemberHistoryStatusEnteredInError FamilyMemberHistoryStatus = "entered-in-error"
+	FamilyMemberHistoryStatusHealthUnknown  FamilyMemberHistoryStatus = "health-unknown"
+)
+
+// FamilyMemberHistoryCondition represents condition that the related person had
+type FamilyMemberHistoryCondition struct {
+	common.BackboneElement
+
+	// Condition suffered by relation
+	Code *common.CodeableConcept `json:"code"`
+
+	// Extra information about condition
+	Note *Annotation `json:"note,omitempty"`
+
+	// Age of onset of a condition in relatives is predictive of risk for the patient
+	OnsetQuantity      *common.Quantity `json:"onsetQuantity,omitempty"`
+	OnsetRange         *Range           `json:"onsetRange,omitempty"`
+	OnsetPeriod        *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetString        *string          `json:"onsetString,omitempty"`
+	OnsetStringElement *common.Element  `json:"_onsetString,omitempty"`
+
+	// deceased | permanent disability | etc
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+}
@@ -0,0 +1,44 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Flag represents key information to flag to healthcare providers
+type Flag struct {
+	DomainResource
+
+	// Flag creator
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Clinical, administrative, etc
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Coded or textual message to display to user
+	Code *common.CodeableConcept `json:"code"`
+
+	// Alert relevant during encounter
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Time period when flag is active
+	Period *common.Period `json:"period,omitempty"`
+
+	// active | inactive | entered-in-error
+	Status        FlagStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Who/What is flag about?
+	Subject *common.Reference `json:"subject"`
+}
+
+// FlagStatus represents the status of a flag
+type FlagStatus string
+
+const (
+	FlagStatusActive         FlagStatus = "active"
+	FlagStatusInactive       FlagStatus = "inactive"
+	FlagStatusEnteredInError FlagStatus = "entered-in-error"
+)
@@ -0,0 +1,83 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Goal describes the intended objective(s) for a patient, group or organization
+type Goal struct {
+	DomainResource
+
+	// Issues addressed by this goal
+	Addresses []common.Reference `json:"addresses,omitempty"`
+
+	// Indicates whose goal this is - patient goal, practitioner goal, etc
+	Author *common.Reference `json:"author,omitempty"`
+
+	// E.g. Treatment, dietary, behavioral, etc
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Code or text describing goal
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// External Ids for this goal
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Comments about the goal
+	Note []Annotation `json:"note,omitempty"`
+
+	// What result was achieved regarding the goal?
+	Outcome []GoalOutcome `json:"outcome,omitempty"`
+
+	// high-priority | medium-priority | low-priority
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// When goal pursuit begins
+	StartDate            *common.Date            `json:"startDate,omitempty"`
+	StartDateElement     *common.Element         `json:"_startDate,omitempty"`
+	StartCodeableConcept *common.CodeableConcept `json:"startCodeableConcept,omitempty"`
+
+	// Allows measuring outcome and whether goal needs to be further tracked
+	Status        GoalStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// When goal status took effect
+	StatusDate        *common.Date    `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element `json:"_statusDate,omitempty"`
+
+	// Reason for current status
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+
+	// Who this goal is intended for
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Identifies when the goal should be evaluated
+	TargetDate        *common.Date     `json:"targetDate,omitempty"`
+	TargetDateElement *common.Element  `json:"_targetDate,omitempty"`
+	TargetQuantity    *common.Quantity `json:"targetQuantity,omitempty"`
+}
+
+// GoalStatus represents the status of a goal
+type GoalStatus string
+
+const (
+	GoalStatusProposed   GoalStatus = "proposed"
+	GoalStatusPlanned    GoalStatus = "planned"
+	GoalStatusAccepted   GoalStatus = "accepted"
+	GoalStatusRejected   GoalStatus = "rejected"
+	GoalStatusInProgress GoalStatus = "in-progress"
+	GoalStatusAchieved   GoalStatus = "achieved"
+	GoalStatusSustaining GoalStatus = "sustaining"
+	GoalStatusOnHold     GoalStatus = "on-hold"
+	GoalStatusCancelled  GoalStatus = "cancelled"
+)
+
+// GoalOutcome represents outcome tracking is a key aspect of care planning
+type GoalOutcome struct {
+	common.BackboneElement
+
+	// Details of what's changed (or not changed)
+	ResultCodeableConcept *common.CodeableConcept `json:"resultCodeableConcept,omitempty"`
+	ResultReference       *common.Reference       `json:"resultReference,omitempty"`
+}
@@ -0,0 +1,87 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Group represents group of multiple entities
+type Group struct {
+	DomainResource
+
+	// There are use-cases for groups that define specific collections of individuals
+	Actual        bool            `json:"actual"`
+	ActualElement *common.Element `json:"_actual,omitempty"`
+
+	// Include / Exclude group members by Trait
+	Characteristic []GroupCharacteristic `json:"characteristic,omitempty"`
+
+	// Kind of Group members
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Business Identifier for this Group
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Who or what is in group
+	Member []GroupMember `json:"member,omitempty"`
+
+	// Label for Group
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Number of members
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
+
+	// person | animal | practitioner | device | careteam | healthcareservice | location | organization | relatedperson | specimen
+	Type        GroupType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// GroupType represents the type of a group
+type GroupType string
+
+const (
+	GroupTypePerson       GroupType = "person"
+	GroupTypeAnimal       GroupType = "animal"
+	GroupTypePractitioner GroupType = "practitioner"
+	GroupTypeDevice       GroupType = "device"
+	GroupTypeMedication   GroupType = "medication"
+	GroupTypeSubstance    GroupType = "substance"
+)
+
+// GroupCharacteristic represents include / Exclude group members by Trait
+type GroupCharacteristic struct {
+	common.BackboneElement
+
+	// Kind of characteristic
+	Code *common.CodeableConcept `json:"code"`
+
+	// Group includes or excludes
+	Exclude        bool            `json:"exclude"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
+
+	// Period over which characteristic is tested
+	Period *common.Period `json:"period,omitempty"`
+
+	// Value held by characteristic
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
+	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange           *Range                  `json:"valueRange,omitempty"`
+}
+
+// GroupMember represents who or what is in group
+type GroupMember struct {
+	common.BackboneElement
+
+	// Reference to the group member
+	Entity *common.Reference `json:"entity"`
+
+	// If member is no longer in group
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
+
+	// Period member belonged to the group
+	Period *common.Period `json:"period,omitempty"`
+}
@@ -0,0 +1,141 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// HealthcareService represents the details of a healthcare service available at a location
+type HealthcareService struct {
+	DomainResource
+
+	// If an appointment is required for access to this service
+	AppointmentRequired        *bool           `json:"appointmentRequired,omitempty"`
+	AppointmentRequiredElement *common.Element `json:"_appointmentRequired,omitempty"`
+
+	// A description of site availability exceptions, e.g. public holiday availability
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
+
+	// A collection of times that the Service Site is available
+	AvailableTime []HealthcareServiceAvailableTime `json:"availableTime,omitempty"`
+
+	// Collection of characteristics (attributes)
+	Characteristic []common.CodeableConcept `json:"characteristic,omitempty"`
+
+	// Additional description and/or any specific issues not covered elsewhere
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Location(s) service is intended for/available to
+	CoverageArea []common.Reference `json:"coverageArea,omitempty"`
+
+	// Specific eligibility requirements required to use the service
+	Eligibility *common.CodeableConcept `json:"eligibility,omitempty"`
+
+	// Describes the eligibility conditions for the service
+	EligibilityNote        *string         `json:"eligibilityNote,omitempty"`
+	EligibilityNoteElement *common.Element `json:"_eligibilityNote,omitempty"`
+
+	// Extra details about the service that can't be placed in the other fields
+	ExtraDetails        *string         `json:"extraDetails,omitempty"`
+	ExtraDetailsElement *common.Element `json:"_extraDetails,omitempty"`
+
+	// External identifiers for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Location(s) where service may be provided
+	Location *common.Reference `json:"location"`
+
+	// The HealthcareService is not available during this period of time due to the provided reason
+	NotAvailable []HealthcareServiceNotAvailable `json:"notAvailable,omitempty"`
+
+	// Facilitates quick identification of the service
+	Photo *Attachment `json:"photo,omitempty"`
+
+	// Program Names that can be used to categorize the service
+	ProgramName        []string          `json:"programName,omitempty"`
+	ProgramNameElement []*common.Element `json:"_programName,omitempty"`
+
+	// Organization that provides this service
+	ProvidedBy *common.Reference `json:"providedBy,omitempty"`
+
+	// The public part of the 'keys' allocated to an Organization by an accredited body to support secure exchange of data over the internet
+	PublicKey        *string         `json:"publicKey,omitempty"`
+	PublicKeyElement *common.Element `json:"_publicKey,omitempty"`
+
+	// Ways that the service accepts referrals
+	ReferralMethod []common.CodeableConcept `json:"referralMethod,omitempty"`
+
+	// Identifies the broad category of service being performed or delivered
+	ServiceCategory *common.CodeableConcept `json:"serviceCategory,omitempty"`
+
+	// Further description of the service as it would be presented to a consumer while searching
+	ServiceName        *string         `json:"serviceName,omitempty"`
+	ServiceNameElement *common.Element `json:"_serviceName,omitempty"`
+
+	// Conditions under which service is available/offered
+	ServiceProvisionCode []common.CodeableConcept `json:"serviceProvisionCode,omitempty"`
+
+	// A specific type of service that may be delivered or performed
+	ServiceType []HealthcareServiceServiceType `json:"serviceType,omitempty"`
+
+	// List of contacts related to this specific healthcare service
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// HealthcareServiceServiceType represents a specific type of service that may be delivered or performed
+type HealthcareServiceServiceType struct {
+	common.BackboneElement
+
+	// Collection of specialties handled by the service site
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+
+	// The specific type of service being delivered or performed
+	Type *common.CodeableConcept `json:"type"`
+}
+
+// HealthcareServiceAvailableTime represents a collection of times that the Service Site is available
+type HealthcareServiceAvailableTime struct {
+	common.BackboneElement
+
+	// Is this always available? (hence times are irrelevant) e.g. 24 hour service
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
+
+	// The closing time of day
+	AvailableEndTime        *common.Time    `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement *common.Element `json:"_availableEndTime,omitempty"`
+
+	// The opening time of day
+	AvailableStartTime        *common.Time    `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element `json:"_availableStartTime,omitempty"`
+
+	// Indicates which days of the week are available between the start and end Times
+	DaysOfWeek        []HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element                          `json:"_daysOfWeek,omitempty"`
+}
+
+// HealthcareServiceAvailableTimeDaysOfWeek represents the days of week of a healthcare service available time
+type HealthcareServiceAvailableTimeDaysOfWeek string
+
+const (
+	HealthcareServiceAvailableTimeDaysOfWeekMon HealthcareServiceAvailableTimeDaysOfWeek = "mon"
+	HealthcareServiceAvailableTimeDaysOfWeekTue HealthcareServiceAvailableTimeDaysOfWeek = "tue"
+	HealthcareServiceAvailableTimeDaysOfWeekWed HealthcareServiceAvailableTimeDaysOfWeek = "wed"
+	HealthcareServiceAvailableTimeDaysOfWeekThu HealthcareServiceAvailableTimeDaysOfWeek = "thu"
+	HealthcareServiceAvailableTimeDaysOfWeekFri HealthcareServiceAvailableTimeDaysOfWeek = "fri"
+	HealthcareServiceAvailableTimeDaysOfWeekSat HealthcareServiceAvailableTimeDaysOfWeek = "sat"
+	HealthcareServiceAvailableTimeDaysOfWeekSun HealthcareServiceAvailableTimeDaysOfWeek = "sun"
+)
+
+// HealthcareServiceNotAvailable represents the not available of a healthcare service
+type HealthcareServiceNotAvailable struct {
+	common.BackboneElement
+
+	// The reason that can be presented to the user as to why this time is not available
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Service is not available (seasonally or for a public holiday) from this date
+	During *common.Period `json:"during,omitempty"`
+}
@@ -0,0 +1,102 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImagingObjectSelection represents a manifest of a set of DICOM Service-Object Pair Instances (SOP Instances)
+type ImagingObjectSelection struct {
+	DomainResource
+
+	// Track the selection decision maker
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Date and time when the selection was made can be important to understand the content of selection
+	AuthoringTime        *common.DateTime `json:"authoringTime,omitempty"`
+	AuthoringTimeElement *common.Element  `json:"_authoringTime,omitempty"`
+
+	// Need to provide a narrative description of the SOP instances in the selection
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// SOP Instances in ImagingObjectSelection must be from the same patient
+	Patient *common.Reference `json:"patient"`
+
+	// DICOM SOP instances are identified through the UIDs of the study - series - instance hierarchy
+	Study []ImagingObjectSelectionStudy `json:"study"`
+
+	// Need to represent the application purpose that the SOP instances in ImagingObjectSelection are selected for
+	Title *common.CodeableConcept `json:"title"`
+
+	// DICOM SOP Instance is always assigned with a unique identifier (UID)
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingObjectSelectionStudySeriesInstanceFrames represents the frames of an imaging object selection study series instance
+type ImagingObjectSelectionStudySeriesInstanceFrames struct {
+	common.BackboneElement
+
+	// One or more non-duplicate frame numbers in the selected instance
+	FrameNumbers        []int             `json:"frameNumbers"`
+	FrameNumbersElement []*common.Element `json:"_frameNumbers,omitempty"`
+
+	// WADO-RS URL enables retrieval of the frames using DICOM WADO-RS API
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ImagingObjectSelectionStudySeriesInstance represents DICOM SOP instances are identified through the UIDs of the study - series - instance hierarchy
+type ImagingObjectSelectionStudySeriesInstance struct {
+	common.BackboneElement
+
+	// The specific set of frames referenced in this imaging object selection
+	Frames []ImagingObjectSelectionStudySeriesInstanceFrames `json:"frames,omitempty"`
+
+	// DICOM SOP instances can be an image or other data object
+	SopClass        string          `json:"sopClass"`
+	SopClassElement *common.Element `json:"_sopClass,omitempty"`
+
+	// SOP instance UID is required to fully identify the DICOM SOP instance in the selection
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+
+	// WADO-RS URL enables retrieval of the SOP instance using DICOM WADO-RS API
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ImagingObjectSelectionStudySeries represents DICOM SOP instances are identified through the UIDs of the study - series - instance hierarchy
+type ImagingObjectSelectionStudySeries struct {
+	common.BackboneElement
+
+	// DICOM SOP instances are identified through the UIDs of the study - series - instance hierarchy
+	Instance []ImagingObjectSelectionStudySeriesInstance `json:"instance"`
+
+	// Series instance UID is required to fully identify the DICOM SOP instances in the selection
+	Uid        *string         `json:"uid,omitempty"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+
+	// WADO-RS URL enables retrieval of the entire series using DICOM WADO-RS API
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ImagingObjectSelectionStudy represents DICOM SOP instances are identified through the UIDs of the study - series - instance hierarchy
+type ImagingObjectSelectionStudy struct {
+	common.BackboneElement
+
+	// Used to retrieve the ImagingStudy that contain the images referenced in the Imaging ObjectSelection.study
+	ImagingStudy *common.Reference `json:"imagingStudy,omitempty"`
+
+	// DICOM SOP instances are identified through the UIDs of the study - series - instance hierarchy
+	Series []ImagingObjectSelectionStudySeries `json:"series"`
+
+	// Study instance UID is required to fully identify the DICOM SOP instances in the selection
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+
+	// WADO-RS URL enables retrieval of the entire study using DICOM WADO-RS API
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
@@ -0,0 +1,158 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImagingStudy represents a set of images produced in single study (one or more series of references images)
+type ImagingStudy struct {
+	DomainResource
+
+	// Accession Number is an identifier related to some aspect of imaging workflow and data management
+	Accession *common.Identifier `json:"accession,omitempty"`
+
+	// Availability of study (online, offline or nearline)
+	Availability        *ImagingStudyAvailability `json:"availability,omitempty"`
+	AvailabilityElement *common.Element           `json:"_availability,omitempty"`
+
+	// Institution-generated description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Identifiers for the whole study
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Who read the study and interpreted the images or other content
+	Interpreter *common.Reference `json:"interpreter,omitempty"`
+
+	// A list of all the Series.ImageModality values that are actual acquisition modalities
+	ModalityList []common.Coding `json:"modalityList,omitempty"`
+
+	// Number of Study Related Instances
+	NumberOfInstances        int             `json:"numberOfInstances"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
+
+	// Number of Study Related Series
+	NumberOfSeries        int             `json:"numberOfSeries"`
+	NumberOfSeriesElement *common.Element `json:"_numberOfSeries,omitempty"`
+
+	// To support grouped procedures (one imaging study supporting multiple ordered procedures, e.g. chest/abdomen/pelvis CT)
+	Order []common.Reference `json:"order,omitempty"`
+
+	// The patient imaged in the study
+	Patient *common.Reference `json:"patient"`
+
+	// The performed procedure or code
+	Procedure []common.Reference `json:"procedure,omitempty"`
+
+	// Referring physician
+	Referrer *common.Reference `json:"referrer,omitempty"`
+
+	// Each study has one or more series of instances
+	Series []ImagingStudySeries `json:"series,omitempty"`
+
+	// When the study was started
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
+
+	// Formal identifier for the study
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+
+	// WADO-RS resource where Study is available
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ImagingStudyAvailability represents the availability of an imaging study
+type ImagingStudyAvailability string
+
+const (
+	ImagingStudyAvailabilityONLINE      ImagingStudyAvailability = "ONLINE"
+	ImagingStudyAvailabilityOFFLINE     ImagingStudyAvailability = "OFFLINE"
+	ImagingStudyAvailabilityNEARLINE    ImagingStudyAvailability = "NEARLINE"
+	ImagingStudyAvailabilityUNAVAILABLE ImagingStudyAvailability = "UNAVAILABLE"
+)
+
+// ImagingStudySeriesInstance represents a single SOP instance from the series
+type ImagingStudySeriesInstance struct {
+	common.BackboneElement
+
+	// Content of the instance or a rendering thereof (e.g. a JPEG of an image, or an XML of a structured report)
+	Content []Attachment `json:"content,omitempty"`
+
+	// The number of this instance in the series
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// DICOM class type
+	SopClass        string          `json:"sopClass"`
+	SopClassElement *common.Element `json:"_sopClass,omitempty"`
+
+	// Description of instance
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// A human-friendly SOP Class name
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// DICOM SOP Instance UID
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingStudySeries represents each study has one or more series of instances
+type ImagingStudySeries struct {
+	common.BackboneElement
+
+	// Availability of series (online, offline or nearline)
+	Availability        *ImagingStudySeriesAvailability `json:"availability,omitempty"`
+	AvailabilityElement *common.Element                 `json:"_availability,omitempty"`
+
+	// Body part examined
+	BodySite *common.Coding `json:"bodySite,omitempty"`
+
+	// A short human readable summary of the series
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A single SOP instance from the series
+	Instance []ImagingStudySeriesInstance `json:"instance,omitempty"`
+
+	// Body part laterality
+	Laterality *common.Coding `json:"laterality,omitempty"`
+
+	// The modality used for this series
+	Modality *common.Coding `json:"modality"`
+
+	// Numeric identifier of this series
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// Number of Series Related Instances
+	NumberOfInstances        int             `json:"numberOfInstances"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
+
+	// When the series started
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
+
+	// DICOM Series Instance UID for the series
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+
+	// URI/URL specifying the location of the referenced series using WADO-RS
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ImagingStudySeriesAvailability represents the availability of an imaging study series
+type ImagingStudySeriesAvailability string
+
+const (
+	ImagingStudySeriesAvailabilityONLINE      ImagingStudySeriesAvailability = "ONLINE"
+	ImagingStudySeriesAvailabilityOFFLINE     ImagingStudySeriesAvailability = "OFFLINE"
+	ImagingStudySeriesAvailabilityNEARLINE    ImagingStudySeriesAvailability = "NEARLINE"
+	ImagingStudySeriesAvailabilityUNAVAILABLE ImagingStudySeriesAvailability = "UNAVAILABLE"
+)
@@ -0,0 +1,229 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImplementationGuide represents a set of rules about how FHIR is used
+type ImplementationGuide struct {
+	DomainResource
+
+	// A binary file that is included in the implementation guide when it is published
+	Binary        []string          `json:"binary,omitempty"`
+	BinaryElement []*common.Element `json:"_binary,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ImplementationGuideContact `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Another implementation guide that this implementation depends on
+	Dependency []ImplementationGuideDependency `json:"dependency,omitempty"`
+
+	// Natural language description of the implementation guide
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// FHIR Version(s) this Implementation Guide targets
+	FhirVersion        *string         `json:"fhirVersion,omitempty"`
+	FhirVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// Profiles that apply globally
+	Global []ImplementationGuideGlobal `json:"global,omitempty"`
+
+	// Name for this implementation guide (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A logical group of resources
+	Package []ImplementationGuidePackage `json:"package"`
+
+	// A page / section in the implementation guide
+	Page *ImplementationGuidePage `json:"page"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ImplementationGuideStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Canonical identifier for this implementation guide, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.CodeableConcept `json:"useContext,omitempty"`
+
+	// Business version of the implementation guide
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ImplementationGuideStatus represents the status of an implementation guide
+type ImplementationGuideStatus string
+
+const (
+	ImplementationGuideStatusDraft   ImplementationGuideStatus = "draft"
+	ImplementationGuideStatusActive  ImplementationGuideStatus = "active"
+	ImplementationGuideStatusRetired ImplementationGuideStatus = "retired"
+)
+
+// ImplementationGuideContact represents contacts to assist a user in finding and communicating with the publisher
+type ImplementationGuideContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the implementation guide
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// ImplementationGuideDependency represents another implementation guide that this implementation depends on
+type ImplementationGuideDependency struct {
+	common.BackboneElement
+
+	// How the dependency is represented when the guide is published
+	Type        ImplementationGuideDependencyType `json:"type"`
+	TypeElement *common.Element                   `json:"_type,omitempty"`
+
+	// Where the dependency is located
+	URI        string          `json:"uri"`
+	URIElement *common.Element `json:"_uri,omitempty"`
+}
+
+// ImplementationGuideDependencyType represents the type of an implementation guide dependency
+type ImplementationGuideDependencyType string
+
+const (
+	ImplementationGuideDependencyTypeReference ImplementationGuideDependencyType = "reference"
+	ImplementationGuideDependencyTypeInclusion ImplementationGuideDependencyType = "inclusion"
+)
+
+// ImplementationGuidePackageResource represents a resource that is part of the implementation guide
+type ImplementationGuidePackageResource struct {
+	common.BackboneElement
+
+	// A short code that may be used to identify the resource throughout the implementation guide
+	Acronym        *string         `json:"acronym,omitempty"`
+	AcronymElement *common.Element `json:"_acronym,omitempty"`
+
+	// A description of the reason that a resource has been included in the implementation guide
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Another resource that this resource is an example for
+	ExampleFor *common.Reference `json:"exampleFor,omitempty"`
+
+	// A human assigned name for the resource
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// This element is provided so that implementation guide tooling does not have to guess the purpose of including a resource in the implementation guide based on the type or context
+	Purpose        ImplementationGuidePackageResourcePurpose `json:"purpose"`
+	PurposeElement *common.Element                           `json:"_purpose,omitempty"`
+
+	// Where this resource is found
+	SourceUri        *string           `json:"sourceUri,omitempty"`
+	SourceUriElement *common.Element   `json:"_sourceUri,omitempty"`
+	SourceReference  *common.Reference `json:"sourceReference,omitempty"`
+}
+
+// ImplementationGuidePackageResourcePurpose represents the purpose of an implementation guide package resource
+type ImplementationGuidePackageResourcePurpose string
+
+const (
+	ImplementationGuidePackageResourcePurposeExample     ImplementationGuidePackageResourcePurpose = "example"
+	ImplementationGuidePackageResourcePurposeTerminology ImplementationGuidePackageResourcePurpose = "terminology"
+	ImplementationGuidePackageResourcePurposeProfile     ImplementationGuidePackageResourcePurpose = "profile"
+	ImplementationGuidePackageResourcePurposeExtension   ImplementationGuidePackageResourcePurpose = "extension"
+	ImplementationGuidePackageResourcePurposeDictionary  ImplementationGuidePackageResourcePurpose = "dictionary"
+	ImplementationGuidePackageResourcePurposeLogical     ImplementationGuidePackageResourcePurpose = "logical"
+)
+
+// ImplementationGuidePackage represents a logical group of resources
+type ImplementationGuidePackage struct {
+	common.BackboneElement
+
+	// Human readable text describing the package
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The name for the group, as used in page.package
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A resource that is part of the implementation guide
+	Resource []ImplementationGuidePackageResource `json:"resource"`
+}
+
+// ImplementationGuideGlobal represents profiles that apply globally
+type ImplementationGuideGlobal struct {
+	common.BackboneElement
+
+	// Profile that all resources must conform to
+	Profile *common.Reference `json:"profile"`
+
+	// Type this profile applies to
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// ImplementationGuidePage represents a page / section in the implementation guide
+type ImplementationGuidePage struct {
+	common.BackboneElement
+
+	// The format of the page
+	Format        *string         `json:"format,omitempty"`
+	FormatElement *common.Element `json:"_format,omitempty"`
+
+	// The kind of page that this is
+	Kind        ImplementationGuidePageKind `json:"kind"`
+	KindElement *common.Element             `json:"_kind,omitempty"`
+
+	// A short name used to represent this page in navigational structures such as table of contents, bread crumbs, etc
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// For constructed pages, a list of packages to include in the page (or else empty for everything)
+	Package        []string          `json:"package,omitempty"`
+	PackageElement []*common.Element `json:"_package,omitempty"`
+
+	// Nested Pages/Sections under this page
+	Page []ImplementationGuidePage `json:"page,omitempty"`
+
+	// The source address for the page
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// For constructed pages, what kind of resources to include in the list
+	Type        []string          `json:"type,omitempty"`
+	TypeElement []*common.Element `json:"_type,omitempty"`
+}
+
+// ImplementationGuidePageKind represents the kind of an implementation guide page
+type ImplementationGuidePageKind string
+
+const (
+	ImplementationGuidePageKindPage       ImplementationGuidePageKind = "page"
+	ImplementationGuidePageKindExample    ImplementationGuidePageKind = "example"
+	ImplementationGuidePageKindList       ImplementationGuidePageKind = "list"
+	ImplementationGuidePageKindInclude    ImplementationGuidePageKind = "include"
+	ImplementationGuidePageKindDirectory  ImplementationGuidePageKind = "directory"
+	ImplementationGuidePageKindDictionary ImplementationGuidePageKind = "dictionary"
+	ImplementationGuidePageKindToc        ImplementationGuidePageKind = "toc"
+	ImplementationGuidePageKindResource   ImplementationGuidePageKind = "resource"
+)
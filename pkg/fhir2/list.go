@@ -0,0 +1,91 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// List represents a list is a curated collection of resources
+type List struct {
+	DomainResource
+
+	// What the purpose of this list is
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// When the list was prepared
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Why list is empty
+	EmptyReason *common.CodeableConcept `json:"emptyReason,omitempty"`
+
+	// Context in which list created
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Entries in the list
+	Entry []ListEntry `json:"entry,omitempty"`
+
+	// Business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// working | snapshot | changes
+	Mode        ListMode        `json:"mode"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
+
+	// Comments about the list
+	Note        *string         `json:"note,omitempty"`
+	NoteElement *common.Element `json:"_note,omitempty"`
+
+	// What order the list has
+	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
+
+	// Who and/or what defined the list contents (aka Author)
+	Source *common.Reference `json:"source,omitempty"`
+
+	// current | retired | entered-in-error
+	Status        ListStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// If all resources have the same subject(s)
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Descriptive name for the list
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// ListMode represents the mode of a list
+type ListMode string
+
+const (
+	ListModeWorking  ListMode = "working"
+	ListModeSnapshot ListMode = "snapshot"
+	ListModeChanges  ListMode = "changes"
+)
+
+// ListStatus represents the status of a list
+type ListStatus string
+
+const (
+	ListStatusCurrent        ListStatus = "current"
+	ListStatusRetired        ListStatus = "retired"
+	ListStatusEnteredInError ListStatus = "entered-in-error"
+)
+
+// ListEntry represents entries in the list
+type ListEntry struct {
+	common.BackboneElement
+
+	// When item added to list
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// If this item is actually marked as deleted
+	Deleted        *bool           `json:"deleted,omitempty"`
+	DeletedElement *common.Element `json:"_deleted,omitempty"`
+
+	// Status/Workflow information about this item
+	Flag *common.CodeableConcept `json:"flag,omitempty"`
+
+	// Actual entry
+	Item *common.Reference `json:"item"`
+}
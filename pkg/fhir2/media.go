@@ -0,0 +1,61 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Media represents a photo, video, or audio recording acquired or used in healthcare
+type Media struct {
+	DomainResource
+
+	// The actual content of the media - inline or by direct reference to the media source file
+	Content *Attachment `json:"content"`
+
+	// The name of the device / manufacturer of the device that was used to make the recording
+	DeviceName        *string         `json:"deviceName,omitempty"`
+	DeviceNameElement *common.Element `json:"_deviceName,omitempty"`
+
+	// The duration of the recording in seconds - for audio and video
+	Duration        *int            `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
+
+	// The number of frames in a photo
+	Frames        *int            `json:"frames,omitempty"`
+	FramesElement *common.Element `json:"_frames,omitempty"`
+
+	// Height of the image in pixels (photo/video)
+	Height        *int            `json:"height,omitempty"`
+	HeightElement *common.Element `json:"_height,omitempty"`
+
+	// Identifiers associated with the image - these may include identifiers for the image itself
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The person who administered the collection of the image
+	Operator *common.Reference `json:"operator,omitempty"`
+
+	// Who/What this Media is a record of
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Details of the type of the media - usually, how it was acquired (what type of device)
+	Subtype *common.CodeableConcept `json:"subtype,omitempty"`
+
+	// Whether the media is a photo (still image), an audio recording, or a video recording
+	Type        MediaType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// The name of the imaging view e.g. Lateral or Antero-posterior (AP)
+	View *common.CodeableConcept `json:"view,omitempty"`
+
+	// Width of the image in pixels (photo/video)
+	Width        *int            `json:"width,omitempty"`
+	WidthElement *common.Element `json:"_width,omitempty"`
+}
+
+// MediaType represents the type of a media
+type MediaType string
+
+const (
+	MediaTypePhoto MediaType = "photo"
+	MediaTypeVideo MediaType = "video"
+	MediaTypeAudio MediaType = "audio"
+)
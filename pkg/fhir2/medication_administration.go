@@ -0,0 +1,95 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicationAdministration represents administration of medication to a patient
+type MedicationAdministration struct {
+	DomainResource
+
+	// Device used to administer
+	Device []common.Reference `json:"device,omitempty"`
+
+	// Details of how medication was taken
+	Dosage *MedicationAdministrationDosage `json:"dosage,omitempty"`
+
+	// A specific date/time or interval of time during which the administration took place
+	EffectiveTimeDateTime        *common.DateTime `json:"effectiveTimeDateTime,omitempty"`
+	EffectiveTimeDateTimeElement *common.Element  `json:"_effectiveTimeDateTime,omitempty"`
+	EffectiveTimePeriod          *common.Period   `json:"effectiveTimePeriod,omitempty"`
+
+	// Encounter administered as part of
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// External identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Identifies the medication that was administered
+	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
+
+	// Information about the administration
+	Note        *string         `json:"note,omitempty"`
+	NoteElement *common.Element `json:"_note,omitempty"`
+
+	// The person or animal receiving the medication
+	Patient *common.Reference `json:"patient"`
+
+	// The individual who was responsible for giving the medication to the patient
+	Practitioner *common.Reference `json:"practitioner,omitempty"`
+
+	// The original request, instruction or authority to perform the administration
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// A code indicating why the medication was given
+	ReasonGiven []common.CodeableConcept `json:"reasonGiven,omitempty"`
+
+	// A code indicating why the administration was not performed
+	ReasonNotGiven []common.CodeableConcept `json:"reasonNotGiven,omitempty"`
+
+	// in-progress | not-done | on-hold | completed | entered-in-error | stopped | unknown
+	Status        MedicationAdministrationStatus `json:"status"`
+	StatusElement *common.Element                `json:"_status,omitempty"`
+
+	// Set this to true if the record is saying that the medication was NOT administered
+	WasNotGiven        *bool           `json:"wasNotGiven,omitempty"`
+	WasNotGivenElement *common.Element `json:"_wasNotGiven,omitempty"`
+}
+
+// MedicationAdministrationStatus represents the status of a medication administration
+type MedicationAdministrationStatus string
+
+const (
+	MedicationAdministrationStatusInProgress     MedicationAdministrationStatus = "in-progress"
+	MedicationAdministrationStatusOnHold         MedicationAdministrationStatus = "on-hold"
+	MedicationAdministrationStatusCompleted      MedicationAdministrationStatus = "completed"
+	MedicationAdministrationStatusEnteredInError MedicationAdministrationStatus = "entered-in-error"
+	MedicationAdministrationStatusStopped        MedicationAdministrationStatus = "stopped"
+)
+
+// MedicationAdministrationDosage represents details of how medication was taken
+type MedicationAdministrationDosage struct {
+	common.BackboneElement
+
+	// How drug was administered
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// The amount of the medication given at one administration event
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Dose quantity per unit of time
+	RateRatio *Ratio `json:"rateRatio,omitempty"`
+	RateRange *Range `json:"rateRange,omitempty"`
+
+	// Path of substance into body
+	Route *common.CodeableConcept `json:"route,omitempty"`
+
+	// A coded specification of the anatomic site where the medication first entered the body
+	SiteCodeableConcept *common.CodeableConcept `json:"siteCodeableConcept,omitempty"`
+	SiteReference       *common.Reference       `json:"siteReference,omitempty"`
+
+	// Free text dosage instructions e.g. SIG
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+}
@@ -0,0 +1,129 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicationDispense represents dispensing a medication to a named patient
+type MedicationDispense struct {
+	DomainResource
+
+	// Medication order that authorizes the dispense
+	AuthorizingPrescription []common.Reference `json:"authorizingPrescription,omitempty"`
+
+	// Amount of medication expressed as a timing amount
+	DaysSupply *common.Quantity `json:"daysSupply,omitempty"`
+
+	// Where the medication was/will be sent
+	Destination *common.Reference `json:"destination,omitempty"`
+
+	// The individual responsible for dispensing the medication
+	Dispenser *common.Reference `json:"dispenser,omitempty"`
+
+	// How the medication is to be used by the patient or administered by the caregiver
+	DosageInstruction []MedicationDispenseDosageInstruction `json:"dosageInstruction,omitempty"`
+
+	// External identifier
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Identifies the medication being administered
+	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
+
+	// Information about the dispense
+	Note        *string         `json:"note,omitempty"`
+	NoteElement *common.Element `json:"_note,omitempty"`
+
+	// A link to a resource representing the person to whom the medication will be given
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Amount dispensed
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Who collected the medication or where the medication was delivered
+	Receiver []common.Reference `json:"receiver,omitempty"`
+
+	// preparation | in-progress | cancelled | on-hold | completed | entered-in-error | stopped | declined | unknown
+	Status        *MedicationDispenseStatus `json:"status,omitempty"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Whether a substitution was performed on the dispense
+	Substitution *MedicationDispenseSubstitution `json:"substitution,omitempty"`
+
+	// Trial fill, partial fill, emergency fill, etc
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// When product was given out
+	WhenHandedOver        *common.DateTime `json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement *common.Element  `json:"_whenHandedOver,omitempty"`
+
+	// When product was packaged and reviewed
+	WhenPrepared        *common.DateTime `json:"whenPrepared,omitempty"`
+	WhenPreparedElement *common.Element  `json:"_whenPrepared,omitempty"`
+}
+
+// MedicationDispenseStatus represents the status of a medication dispense
+type MedicationDispenseStatus string
+
+const (
+	MedicationDispenseStatusInProgress     MedicationDispenseStatus = "in-progress"
+	MedicationDispenseStatusOnHold         MedicationDispenseStatus = "on-hold"
+	MedicationDispenseStatusCompleted      MedicationDispenseStatus = "completed"
+	MedicationDispenseStatusEnteredInError MedicationDispenseStatus = "entered-in-error"
+	MedicationDispenseStatusStopped        MedicationDispenseStatus = "stopped"
+)
+
+// MedicationDispenseDosageInstruction indicates how the medication is to be used by the patient
+type MedicationDispenseDosageInstruction struct {
+	common.BackboneElement
+
+	// Additional instructions such as "Swallow with plenty of water" which may or may not be coded
+	AdditionalInstructions *common.CodeableConcept `json:"additionalInstructions,omitempty"`
+
+	// Indicates whether the Medication is only taken when needed within a specific dosing schedule (Boolean option)
+	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
+
+	// The amount of therapeutic or other substance given at one administration event
+	DoseRange    *Range           `json:"doseRange,omitempty"`
+	DoseQuantity *common.Quantity `json:"doseQuantity,omitempty"`
+
+	// The maximum total quantity of a therapeutic substance that may be administered to a subject over the period of time
+	MaxDosePerPeriod *Ratio `json:"maxDosePerPeriod,omitempty"`
+
+	// A coded value indicating the method by which the medication is intended to be or was introduced into or on the body
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// Identifies the speed with which the medication was or will be introduced into the patient
+	RateRatio *Ratio `json:"rateRatio,omitempty"`
+	RateRange *Range `json:"rateRange,omitempty"`
+
+	// A code specifying the route or physiological path of administration of a therapeutic agent into or onto a subject
+	Route *common.CodeableConcept `json:"route,omitempty"`
+
+	// A coded specification of the anatomic site where the medication first enters the body
+	SiteCodeableConcept *common.CodeableConcept `json:"siteCodeableConcept,omitempty"`
+	SiteReference       *common.Reference       `json:"siteReference,omitempty"`
+
+	// Free text dosage instructions can be used for cases where the instructions are too complex to code
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The timing schedule for giving the medication to the patient
+	Timing *Timing `json:"timing,omitempty"`
+}
+
+// MedicationDispenseSubstitution indicates whether or not substitution was made as part of the dispense
+type MedicationDispenseSubstitution struct {
+	common.BackboneElement
+
+	// Why was substitution made
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Who is responsible for the substitution
+	ResponsibleParty []common.Reference `json:"responsibleParty,omitempty"`
+
+	// Code signifying whether a different drug was dispensed from what was prescribed
+	Type *common.CodeableConcept `json:"type"`
+}
@@ -0,0 +1,105 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicationStatement represents record of medication being taken by a patient
+type MedicationStatement struct {
+	DomainResource
+
+	// When the usage was asserted?
+	DateAsserted        *common.DateTime `json:"dateAsserted,omitempty"`
+	DateAssertedElement *common.Element  `json:"_dateAsserted,omitempty"`
+
+	// Details of how medication is/was taken or should be taken
+	Dosage []MedicationStatementDosage `json:"dosage,omitempty"`
+
+	// The date/time or interval when the medication is/was/will be taken
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+
+	// External identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Person or organization that provided the information about the taking of this medication
+	InformationSource *common.Reference `json:"informationSource,omitempty"`
+
+	// Identifies the medication being administered
+	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
+
+	// Further information about the usage
+	Note        *string         `json:"note,omitempty"`
+	NoteElement *common.Element `json:"_note,omitempty"`
+
+	// The person or animal who is/was taking the medication
+	Patient *common.Reference `json:"patient"`
+
+	// A reason for why the medication is being/was taken
+	ReasonForUseCodeableConcept *common.CodeableConcept `json:"reasonForUseCodeableConcept,omitempty"`
+	ReasonForUseReference       *common.Reference       `json:"reasonForUseReference,omitempty"`
+
+	// A code indicating why the medication was not taken
+	ReasonNotTaken []common.CodeableConcept `json:"reasonNotTaken,omitempty"`
+
+	// recorded | entered-in-error | draft
+	Status        MedicationStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Allows linking the MedicationStatement to the underlying MedicationOrder
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+
+	// Set this to true if the record is saying that the medication was NOT taken
+	WasNotTaken        *bool           `json:"wasNotTaken,omitempty"`
+	WasNotTakenElement *common.Element `json:"_wasNotTaken,omitempty"`
+}
+
+// MedicationStatementStatus represents the status of a medication statement
+type MedicationStatementStatus string
+
+const (
+	MedicationStatementStatusActive         MedicationStatementStatus = "active"
+	MedicationStatementStatusCompleted      MedicationStatementStatus = "completed"
+	MedicationStatementStatusEnteredInError MedicationStatementStatus = "entered-in-error"
+	MedicationStatementStatusIntended       MedicationStatementStatus = "intended"
+)
+
+// MedicationStatementDosage indicates how the medication is/was used by the patient
+type MedicationStatementDosage struct {
+	common.BackboneElement
+
+	// Indicates whether the Medication is only taken when needed within a specific dosing schedule (Boolean option)
+	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
+
+	// The maximum total quantity of a therapeutic substance that may be administered to a subject over the period of time
+	MaxDosePerPeriod *Ratio `json:"maxDosePerPeriod,omitempty"`
+
+	// A coded value indicating the method by which the medication is intended to be or was introduced into or on the body
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// The amount of therapeutic or other substance given at one administration event
+	QuantityQuantity *common.Quantity `json:"quantityQuantity,omitempty"`
+	QuantityRange    *Range           `json:"quantityRange,omitempty"`
+
+	// Identifies the speed with which the medication was or will be introduced into the patient
+	RateRatio *Ratio `json:"rateRatio,omitempty"`
+	RateRange *Range `json:"rateRange,omitempty"`
+
+	// A code specifying the route or physiological path of administration of a therapeutic agent into or onto a subject
+	Route *common.CodeableConcept `json:"route,omitempty"`
+
+	// A coded specification of or a reference to the anatomic site where the medication first enters the body
+	SiteCodeableConcept *common.CodeableConcept `json:"siteCodeableConcept,omitempty"`
+	SiteReference       *common.Reference       `json:"siteReference,omitempty"`
+
+	// Free text dosage information as reported about a patient's medication use
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The timing schedule for giving the medication to the patient
+	Timing *Timing `json:"timing,omitempty"`
+}
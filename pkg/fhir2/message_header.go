@@ -0,0 +1,107 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MessageHeader represents a resource that describes a message that is exchanged between systems
+type MessageHeader struct {
+	DomainResource
+
+	// The source of the decision
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Every message event is about actual data, a single resource, that is identified in the definition of the event
+	Data []common.Reference `json:"data,omitempty"`
+
+	// Message destination application(s)
+	Destination []MessageHeaderDestination `json:"destination,omitempty"`
+
+	// Need to know for audit/traceback requirements and possibly for authorization
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Drives the behavior associated with this message
+	Event *common.Coding `json:"event"`
+
+	// Cause of event
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// Allows routing beyond just the application level
+	Receiver *common.Reference `json:"receiver,omitempty"`
+
+	// If this is a reply to prior message
+	Response *MessageHeaderResponse `json:"response,omitempty"`
+
+	// Final responsibility for event
+	Responsible *common.Reference `json:"responsible,omitempty"`
+
+	// Message source application
+	Source *MessageHeaderSource `json:"source"`
+
+	// Allows limited detection of out-of-order and delayed transmission
+	Timestamp        common.Instant  `json:"timestamp"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+}
+
+// MessageHeaderResponse represents information about the message that this message is a response to
+type MessageHeaderResponse struct {
+	common.BackboneElement
+
+	// ok | transient-error | fatal-error
+	Code        MessageHeaderResponseCode `json:"code"`
+	CodeElement *common.Element           `json:"_code,omitempty"`
+
+	// Specific list of hints/warnings/errors
+	Details *common.Reference `json:"details,omitempty"`
+
+	// Bundle.identifier of original message
+	Identifier        string          `json:"identifier"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
+}
+
+// MessageHeaderResponseCode represents the code of a message header response
+type MessageHeaderResponseCode string
+
+const (
+	MessageHeaderResponseCodeOk             MessageHeaderResponseCode = "ok"
+	MessageHeaderResponseCodeTransientError MessageHeaderResponseCode = "transient-error"
+	MessageHeaderResponseCodeFatalError     MessageHeaderResponseCode = "fatal-error"
+)
+
+// MessageHeaderSource represents message source application
+type MessageHeaderSource struct {
+	common.BackboneElement
+
+	// Human contact for problems
+	Contact *ContactPoint `json:"contact,omitempty"`
+
+	// Identifies where to send responses, may influence security permissions
+	Endpoint        string          `json:"endpoint"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// Name of system
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of software running the system
+	Software        *string         `json:"software,omitempty"`
+	SoftwareElement *common.Element `json:"_software,omitempty"`
+	Version         *string         `json:"version,omitempty"`
+	VersionElement  *common.Element `json:"_version,omitempty"`
+}
+
+// MessageHeaderDestination represents message destination application(s)
+type MessageHeaderDestination struct {
+	common.BackboneElement
+
+	// Identifies where to route the message
+	Endpoint        string          `json:"endpoint"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// Name of system
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Particular delivery destination within the destination
+	Target *common.Reference `json:"target,omitempty"`
+}
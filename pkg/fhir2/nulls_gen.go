@@ -0,0 +1,501 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir2
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MarshalJSON writes the repeating primitives of Address that only have an id
+// or extensions as null
+func (x Address) MarshalJSON() ([]byte, error) {
+	type plain Address
+	return json.Marshal(struct {
+		plain
+		Line []*string `json:"line,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Line, x.LineElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of AuditEventParticipant that only have an id
+// or extensions as null
+func (x AuditEventParticipant) MarshalJSON() ([]byte, error) {
+	type plain AuditEventParticipant
+	return json.Marshal(struct {
+		plain
+		Policy []*string `json:"policy,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Policy, x.PolicyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CarePlanActivity that only have an id
+// or extensions as null
+func (x CarePlanActivity) MarshalJSON() ([]byte, error) {
+	type plain CarePlanActivity
+	return json.Marshal(struct {
+		plain
+		Goal []*string `json:"goal,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Goal, x.GoalElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimCoverage that only have an id
+// or extensions as null
+func (x ClaimCoverage) MarshalJSON() ([]byte, error) {
+	type plain ClaimCoverage
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimItem that only have an id
+// or extensions as null
+func (x ClaimItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimItem
+	return json.Marshal(struct {
+		plain
+		DiagnosisLinkId []*int `json:"diagnosisLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DiagnosisLinkId, x.DiagnosisLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseAddItem that only have an id
+// or extensions as null
+func (x ClaimResponseAddItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseAddItem
+	return json.Marshal(struct {
+		plain
+		NoteNumberLinkId []*int `json:"noteNumberLinkId,omitempty"`
+		SequenceLinkId   []*int `json:"sequenceLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumberLinkId, x.NoteNumberLinkIdElement),
+		common.PrimitiveValues(x.SequenceLinkId, x.SequenceLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseCoverage that only have an id
+// or extensions as null
+func (x ClaimResponseCoverage) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseCoverage
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItem that only have an id
+// or extensions as null
+func (x ClaimResponseItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItem
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CompositionAttester that only have an id
+// or extensions as null
+func (x CompositionAttester) MarshalJSON() ([]byte, error) {
+	type plain CompositionAttester
+	return json.Marshal(struct {
+		plain
+		Mode []*CompositionAttesterMode `json:"mode"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Mode, x.ModeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Conformance that only have an id
+// or extensions as null
+func (x Conformance) MarshalJSON() ([]byte, error) {
+	type plain Conformance
+	return json.Marshal(struct {
+		plain
+		Format []*string `json:"format"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Format, x.FormatElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ConformanceRest that only have an id
+// or extensions as null
+func (x ConformanceRest) MarshalJSON() ([]byte, error) {
+	type plain ConformanceRest
+	return json.Marshal(struct {
+		plain
+		Compartment []*string `json:"compartment,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Compartment, x.CompartmentElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ConformanceRestResource that only have an id
+// or extensions as null
+func (x ConformanceRestResource) MarshalJSON() ([]byte, error) {
+	type plain ConformanceRestResource
+	return json.Marshal(struct {
+		plain
+		SearchInclude    []*string `json:"searchInclude,omitempty"`
+		SearchRevInclude []*string `json:"searchRevInclude,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.SearchInclude, x.SearchIncludeElement),
+		common.PrimitiveValues(x.SearchRevInclude, x.SearchRevIncludeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ConformanceRestResourceSearchParam that only have an id
+// or extensions as null
+func (x ConformanceRestResourceSearchParam) MarshalJSON() ([]byte, error) {
+	type plain ConformanceRestResourceSearchParam
+	return json.Marshal(struct {
+		plain
+		Chain    []*string                                     `json:"chain,omitempty"`
+		Modifier []*ConformanceRestResourceSearchParamModifier `json:"modifier,omitempty"`
+		Target   []*string                                     `json:"target,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Chain, x.ChainElement),
+		common.PrimitiveValues(x.Modifier, x.ModifierElement),
+		common.PrimitiveValues(x.Target, x.TargetElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of DeviceUseRequest that only have an id
+// or extensions as null
+func (x DeviceUseRequest) MarshalJSON() ([]byte, error) {
+	type plain DeviceUseRequest
+	return json.Marshal(struct {
+		plain
+		Notes []*string `json:"notes,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Notes, x.NotesElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of DeviceUseStatement that only have an id
+// or extensions as null
+func (x DeviceUseStatement) MarshalJSON() ([]byte, error) {
+	type plain DeviceUseStatement
+	return json.Marshal(struct {
+		plain
+		Notes []*string `json:"notes,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Notes, x.NotesElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinition that only have an id
+// or extensions as null
+func (x ElementDefinition) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinition
+	return json.Marshal(struct {
+		plain
+		Alias          []*string `json:"alias,omitempty"`
+		Condition      []*string `json:"condition,omitempty"`
+		Representation []*string `json:"representation,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+		common.PrimitiveValues(x.Condition, x.ConditionElement),
+		common.PrimitiveValues(x.Representation, x.RepresentationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinitionSlicing that only have an id
+// or extensions as null
+func (x ElementDefinitionSlicing) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinitionSlicing
+	return json.Marshal(struct {
+		plain
+		Discriminator []*string `json:"discriminator,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Discriminator, x.DiscriminatorElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinitionType that only have an id
+// or extensions as null
+func (x ElementDefinitionType) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinitionType
+	return json.Marshal(struct {
+		plain
+		Aggregation []*ElementDefinitionTypeAggregation `json:"aggregation,omitempty"`
+		Profile     []*string                           `json:"profile,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Aggregation, x.AggregationElement),
+		common.PrimitiveValues(x.Profile, x.ProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HealthcareService that only have an id
+// or extensions as null
+func (x HealthcareService) MarshalJSON() ([]byte, error) {
+	type plain HealthcareService
+	return json.Marshal(struct {
+		plain
+		ProgramName []*string `json:"programName,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ProgramName, x.ProgramNameElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HealthcareServiceAvailableTime that only have an id
+// or extensions as null
+func (x HealthcareServiceAvailableTime) MarshalJSON() ([]byte, error) {
+	type plain HealthcareServiceAvailableTime
+	return json.Marshal(struct {
+		plain
+		DaysOfWeek []*HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DaysOfWeek, x.DaysOfWeekElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HumanName that only have an id
+// or extensions as null
+func (x HumanName) MarshalJSON() ([]byte, error) {
+	type plain HumanName
+	return json.Marshal(struct {
+		plain
+		Family []*string `json:"family,omitempty"`
+		Given  []*string `json:"given,omitempty"`
+		Prefix []*string `json:"prefix,omitempty"`
+		Suffix []*string `json:"suffix,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Family, x.FamilyElement),
+		common.PrimitiveValues(x.Given, x.GivenElement),
+		common.PrimitiveValues(x.Prefix, x.PrefixElement),
+		common.PrimitiveValues(x.Suffix, x.SuffixElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImagingObjectSelectionStudySeriesInstanceFrames that only have an id
+// or extensions as null
+func (x ImagingObjectSelectionStudySeriesInstanceFrames) MarshalJSON() ([]byte, error) {
+	type plain ImagingObjectSelectionStudySeriesInstanceFrames
+	return json.Marshal(struct {
+		plain
+		FrameNumbers []*int `json:"frameNumbers"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.FrameNumbers, x.FrameNumbersElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuide that only have an id
+// or extensions as null
+func (x ImplementationGuide) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuide
+	return json.Marshal(struct {
+		plain
+		Binary []*string `json:"binary,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Binary, x.BinaryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuidePage that only have an id
+// or extensions as null
+func (x ImplementationGuidePage) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuidePage
+	return json.Marshal(struct {
+		plain
+		Package []*string `json:"package,omitempty"`
+		Type    []*string `json:"type,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Package, x.PackageElement),
+		common.PrimitiveValues(x.Type, x.TypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Meta that only have an id
+// or extensions as null
+func (x Meta) MarshalJSON() ([]byte, error) {
+	type plain Meta
+	return json.Marshal(struct {
+		plain
+		Profile []*string `json:"profile,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Profile, x.ProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationDefinition that only have an id
+// or extensions as null
+func (x OperationDefinition) MarshalJSON() ([]byte, error) {
+	type plain OperationDefinition
+	return json.Marshal(struct {
+		plain
+		Type []*string `json:"type,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Type, x.TypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationOutcomeIssue that only have an id
+// or extensions as null
+func (x OperationOutcomeIssue) MarshalJSON() ([]byte, error) {
+	type plain OperationOutcomeIssue
+	return json.Marshal(struct {
+		plain
+		Location []*string `json:"location,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Location, x.LocationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ProcessRequest that only have an id
+// or extensions as null
+func (x ProcessRequest) MarshalJSON() ([]byte, error) {
+	type plain ProcessRequest
+	return json.Marshal(struct {
+		plain
+		Exclude []*string `json:"exclude,omitempty"`
+		Include []*string `json:"include,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Exclude, x.ExcludeElement),
+		common.PrimitiveValues(x.Include, x.IncludeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Provenance that only have an id
+// or extensions as null
+func (x Provenance) MarshalJSON() ([]byte, error) {
+	type plain Provenance
+	return json.Marshal(struct {
+		plain
+		Policy []*string `json:"policy,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Policy, x.PolicyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Questionnaire that only have an id
+// or extensions as null
+func (x Questionnaire) MarshalJSON() ([]byte, error) {
+	type plain Questionnaire
+	return json.Marshal(struct {
+		plain
+		SubjectType []*string `json:"subjectType,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.SubjectType, x.SubjectTypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SearchParameter that only have an id
+// or extensions as null
+func (x SearchParameter) MarshalJSON() ([]byte, error) {
+	type plain SearchParameter
+	return json.Marshal(struct {
+		plain
+		Target []*string `json:"target,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Target, x.TargetElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SpecimenCollection that only have an id
+// or extensions as null
+func (x SpecimenCollection) MarshalJSON() ([]byte, error) {
+	type plain SpecimenCollection
+	return json.Marshal(struct {
+		plain
+		Comment []*string `json:"comment,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Comment, x.CommentElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureDefinition that only have an id
+// or extensions as null
+func (x StructureDefinition) MarshalJSON() ([]byte, error) {
+	type plain StructureDefinition
+	return json.Marshal(struct {
+		plain
+		Context []*string `json:"context,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Context, x.ContextElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TestScriptMetadataCapability that only have an id
+// or extensions as null
+func (x TestScriptMetadataCapability) MarshalJSON() ([]byte, error) {
+	type plain TestScriptMetadataCapability
+	return json.Marshal(struct {
+		plain
+		Link []*string `json:"link,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Link, x.LinkElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Timing that only have an id
+// or extensions as null
+func (x Timing) MarshalJSON() ([]byte, error) {
+	type plain Timing
+	return json.Marshal(struct {
+		plain
+		Event []*common.DateTime `json:"event,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Event, x.EventElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ValueSetCompose that only have an id
+// or extensions as null
+func (x ValueSetCompose) MarshalJSON() ([]byte, error) {
+	type plain ValueSetCompose
+	return json.Marshal(struct {
+		plain
+		Import []*string `json:"import,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Import, x.ImportElement),
+	})
+}
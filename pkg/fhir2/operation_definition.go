@@ -0,0 +1,182 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// OperationDefinition represents definition of an operation or a named query
+type OperationDefinition struct {
+	DomainResource
+
+	// Marks this as a profile of the base
+	Base *common.Reference `json:"base,omitempty"`
+
+	// Recommended name for operation in search url
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Contact details for the publisher
+	Contact []OperationDefinitionContact `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the operation definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Operations that are idempotent (see HTTP specification definition of idempotent) may be invoked by performing an HTTP GET operation instead of a POST
+	Idempotent        *bool           `json:"idempotent,omitempty"`
+	IdempotentElement *common.Element `json:"_idempotent,omitempty"`
+
+	// Invoke on an instance?
+	Instance        bool            `json:"instance"`
+	InstanceElement *common.Element `json:"_instance,omitempty"`
+
+	// operation | query
+	Kind        OperationDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
+
+	// Name for this operation definition (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Additional information about how to use this operation or named query
+	Notes        *string         `json:"notes,omitempty"`
+	NotesElement *common.Element `json:"_notes,omitempty"`
+
+	// Parameters for the operation/query
+	Parameter []OperationDefinitionParameter `json:"parameter,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Explains why this operation definition is needed and why it's been constrained as it has
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        OperationDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Invoke at the system level?
+	System        bool            `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// Invoke at the type level?
+	Type        []string          `json:"type,omitempty"`
+	TypeElement []*common.Element `json:"_type,omitempty"`
+
+	// Canonical identifier for this operation definition, represented as an absolute URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// Business version of the operation definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// OperationDefinitionKind represents the kind of an operation definition
+type OperationDefinitionKind string
+
+const (
+	OperationDefinitionKindOperation OperationDefinitionKind = "operation"
+	OperationDefinitionKindQuery     OperationDefinitionKind = "query"
+)
+
+// OperationDefinitionStatus represents the status of an operation definition
+type OperationDefinitionStatus string
+
+const (
+	OperationDefinitionStatusDraft   OperationDefinitionStatus = "draft"
+	OperationDefinitionStatusActive  OperationDefinitionStatus = "active"
+	OperationDefinitionStatusRetired OperationDefinitionStatus = "retired"
+)
+
+// OperationDefinitionContact represents contacts to assist a user in finding and communicating with the publisher
+type OperationDefinitionContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the operation definition
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// OperationDefinitionParameterBinding represents valueSet details if this is coded
+type OperationDefinitionParameterBinding struct {
+	common.BackboneElement
+
+	// required | extensible | preferred | example
+	Strength        OperationDefinitionParameterBindingStrength `json:"strength"`
+	StrengthElement *common.Element                             `json:"_strength,omitempty"`
+
+	// Points to the value set or external definition (e.g. implicit value set) that identifies the set of codes to be used
+	ValueSetUri        *string           `json:"valueSetUri,omitempty"`
+	ValueSetUriElement *common.Element   `json:"_valueSetUri,omitempty"`
+	ValueSetReference  *common.Reference `json:"valueSetReference,omitempty"`
+}
+
+// OperationDefinitionParameterBindingStrength represents the strength of an operation definition parameter binding
+type OperationDefinitionParameterBindingStrength string
+
+const (
+	OperationDefinitionParameterBindingStrengthRequired   OperationDefinitionParameterBindingStrength = "required"
+	OperationDefinitionParameterBindingStrengthExtensible OperationDefinitionParameterBindingStrength = "extensible"
+	OperationDefinitionParameterBindingStrengthPreferred  OperationDefinitionParameterBindingStrength = "preferred"
+	OperationDefinitionParameterBindingStrengthExample    OperationDefinitionParameterBindingStrength = "example"
+)
+
+// OperationDefinitionParameter represents parameters for the operation/query
+type OperationDefinitionParameter struct {
+	common.BackboneElement
+
+	// ValueSet details if this is coded
+	Binding *OperationDefinitionParameterBinding `json:"binding,omitempty"`
+
+	// Description of meaning/use
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Maximum Cardinality (a number or *)
+	Max        string          `json:"max"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Minimum Cardinality
+	Min        int             `json:"min"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Name in Parameters.parameter.name or in URL
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The parts of a Tuple Parameter
+	Part []OperationDefinitionParameter `json:"part,omitempty"`
+
+	// A profile the specifies the rules that this parameter must conform to
+	Profile *common.Reference `json:"profile,omitempty"`
+
+	// What type this parameter has
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// in | out
+	Use        OperationDefinitionParameterUse `json:"use"`
+	UseElement *common.Element                 `json:"_use,omitempty"`
+}
+
+// OperationDefinitionParameterUse represents the use of an operation definition parameter
+type OperationDefinitionParameterUse string
+
+const (
+	OperationDefinitionParameterUseIn  OperationDefinitionParameterUse = "in"
+	OperationDefinitionParameterUseOut OperationDefinitionParameterUse = "out"
+)
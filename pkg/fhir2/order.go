@@ -0,0 +1,47 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Order represents a request to perform an action
+type Order struct {
+	DomainResource
+
+	// When the order was made
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// What action is being ordered
+	Detail []common.Reference `json:"detail"`
+
+	// Identifiers assigned to this order by the orderer or by the receiver
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Text - why the order was made
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// Who initiated the order
+	Source *common.Reference `json:"source,omitempty"`
+
+	// Patient this order is about
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Who is intended to fulfill the order
+	Target *common.Reference `json:"target,omitempty"`
+
+	// When order should be fulfilled
+	When *OrderWhen `json:"when,omitempty"`
+}
+
+// OrderWhen represents when order should be fulfilled
+type OrderWhen struct {
+	common.BackboneElement
+
+	// Code specifies when request should be done
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// A formal schedule
+	Schedule *Timing `json:"schedule,omitempty"`
+}
@@ -0,0 +1,49 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// OrderResponse represents a response to an order
+type OrderResponse struct {
+	DomainResource
+
+	// The date and time at which this order response was made (created/posted)
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Additional description about the response
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Links to resources that provide details of the outcome of performing the order
+	Fulfillment []common.Reference `json:"fulfillment,omitempty"`
+
+	// Identifiers assigned to this order
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// What this response says about the status of the original order
+	OrderStatus        OrderResponseOrderStatus `json:"orderStatus"`
+	OrderStatusElement *common.Element          `json:"_orderStatus,omitempty"`
+
+	// A reference to the order that this is in response to
+	Request *common.Reference `json:"request"`
+
+	// The person, organization, or device credited with making the response
+	Who *common.Reference `json:"who,omitempty"`
+}
+
+// OrderResponseOrderStatus represents the order status of an order response
+type OrderResponseOrderStatus string
+
+const (
+	OrderResponseOrderStatusPending   OrderResponseOrderStatus = "pending"
+	OrderResponseOrderStatusReview    OrderResponseOrderStatus = "review"
+	OrderResponseOrderStatusRejected  OrderResponseOrderStatus = "rejected"
+	OrderResponseOrderStatusError     OrderResponseOrderStatus = "error"
+	OrderResponseOrderStatusAccepted  OrderResponseOrderStatus = "accepted"
+	OrderResponseOrderStatusCancelled OrderResponseOrderStatus = "cancelled"
+	OrderResponseOrderStatusReplaced  OrderResponseOrderStatus = "replaced"
+	OrderResponseOrderStatusAborted   OrderResponseOrderStatus = "aborted"
+	OrderResponseOrderStatusCompleted OrderResponseOrderStatus = "completed"
+)
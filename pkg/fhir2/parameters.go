@@ -0,0 +1,101 @@
+package fhir2
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Parameters represents Operation Request or Response
+type Parameters struct {
+	Resource
+
+	// Operation Parameter
+	Parameter []ParametersParameter `json:"parameter,omitempty"`
+}
+
+// ParametersParameter represents Operation Parameter
+type ParametersParameter struct {
+	common.BackboneElement
+
+	// Name from the definition
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A named part of a parameter
+	Part []ParametersParameter `json:"part,omitempty"`
+
+	// If parameter is a whole resource
+	Resource common.Resource `json:"resource,omitempty"`
+
+	// If parameter is a data type
+	ValueBoolean             *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element         `json:"_valueBoolean,omitempty"`
+	ValueInteger             *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element         `json:"_valueInteger,omitempty"`
+	ValueDecimal             *common.Decimal         `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element         `json:"_valueDecimal,omitempty"`
+	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element         `json:"_valueBase64Binary,omitempty"`
+	ValueInstant             *common.Instant         `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element         `json:"_valueInstant,omitempty"`
+	ValueString              *string                 `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element         `json:"_valueString,omitempty"`
+	ValueUri                 *string                 `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element         `json:"_valueUri,omitempty"`
+	ValueDate                *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element         `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element         `json:"_valueDateTime,omitempty"`
+	ValueTime                *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element         `json:"_valueTime,omitempty"`
+	ValueCode                *string                 `json:"valueCode,omitempty"`
+	ValueCodeElement         *common.Element         `json:"_valueCode,omitempty"`
+	ValueOid                 *string                 `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element         `json:"_valueOid,omitempty"`
+	ValueId                  *string                 `json:"valueId,omitempty"`
+	ValueIdElement           *common.Element         `json:"_valueId,omitempty"`
+	ValueUnsignedInt         *int                    `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element         `json:"_valueUnsignedInt,omitempty"`
+	ValuePositiveInt         *int                    `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element         `json:"_valuePositiveInt,omitempty"`
+	ValueMarkdown            *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element         `json:"_valueMarkdown,omitempty"`
+	ValueAnnotation          *Annotation             `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
+	ValueIdentifier          *common.Identifier      `json:"valueIdentifier,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *common.Coding          `json:"valueCoding,omitempty"`
+	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange               *Range                  `json:"valueRange,omitempty"`
+	ValuePeriod              *common.Period          `json:"valuePeriod,omitempty"`
+	ValueRatio               *Ratio                  `json:"valueRatio,omitempty"`
+	ValueSampledData         *SampledData            `json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature              `json:"valueSignature,omitempty"`
+	ValueHumanName           *HumanName              `json:"valueHumanName,omitempty"`
+	ValueAddress             *Address                `json:"valueAddress,omitempty"`
+	ValueContactPoint        *ContactPoint           `json:"valueContactPoint,omitempty"`
+	ValueTiming              *Timing                 `json:"valueTiming,omitempty"`
+	ValueReference           *common.Reference       `json:"valueReference,omitempty"`
+	ValueMeta                *Meta                   `json:"valueMeta,omitempty"`
+}
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (p *ParametersParameter) UnmarshalJSON(data []byte) error {
+	type Alias ParametersParameter
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	p.Resource = resource
+	return nil
+}
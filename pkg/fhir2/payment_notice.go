@@ -0,0 +1,41 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PaymentNotice represents paymentNotice request
+type PaymentNotice struct {
+	DomainResource
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Business Identifier for the payment notice
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// Issued or cleared Status of the payment
+	PaymentStatus *common.Coding `json:"paymentStatus"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// Request reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// Response reference
+	Response *common.Reference `json:"response,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// The Insurer who is target of the request
+	Target *common.Reference `json:"target,omitempty"`
+}
@@ -0,0 +1,105 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PaymentReconciliation represents paymentReconciliation resource
+type PaymentReconciliation struct {
+	DomainResource
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// List of individual settlement amounts and the corresponding transaction
+	Detail []PaymentReconciliationDetail `json:"detail,omitempty"`
+
+	// Disposition message
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// The form to be used for printing the content
+	Form *common.Coding `json:"form,omitempty"`
+
+	// Business Identifier for a payment reconciliation
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Suite of notes
+	Note []PaymentReconciliationNote `json:"note,omitempty"`
+
+	// The Insurer who produced this adjudicated response
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// queued | complete | error | partial
+	Outcome        *PaymentReconciliationOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element               `json:"_outcome,omitempty"`
+
+	// Period covered
+	Period *common.Period `json:"period,omitempty"`
+
+	// Reference to requesting resource
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// Total payment amount
+	Total *common.Quantity `json:"total"`
+}
+
+// PaymentReconciliationOutcome represents the outcome of a payment reconciliation
+type PaymentReconciliationOutcome string
+
+const (
+	PaymentReconciliationOutcomeComplete PaymentReconciliationOutcome = "complete"
+	PaymentReconciliationOutcomeError    PaymentReconciliationOutcome = "error"
+)
+
+// PaymentReconciliationDetail represents list of individual settlement amounts and the corresponding transaction
+type PaymentReconciliationDetail struct {
+	common.BackboneElement
+
+	// Amount paid for this detail
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// The date of the invoice or financial resource
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// The organization which is receiving the payment
+	Payee *common.Reference `json:"payee,omitempty"`
+
+	// The claim or financial resource
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The claim response resource
+	Responce *common.Reference `json:"responce,omitempty"`
+
+	// The Organization which submitted the invoice or financial transaction
+	Submitter *common.Reference `json:"submitter,omitempty"`
+
+	// Code to indicate the nature of the payment, adjustment, funds advance, etc
+	Type *common.Coding `json:"type"`
+}
+
+// PaymentReconciliationNote represents suite of notes
+type PaymentReconciliationNote struct {
+	common.BackboneElement
+
+	// The note text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The note purpose: Print/Display
+	Type *common.Coding `json:"type,omitempty"`
+}
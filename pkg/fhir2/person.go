@@ -0,0 +1,75 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Person represents a generic person record
+type Person struct {
+	DomainResource
+
+	// This person's record is in active use
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// One or more addresses for the person
+	Address []Address `json:"address,omitempty"`
+
+	// The date on which the person was born
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
+
+	// male | female | other | unknown
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
+
+	// A human identifier for this person
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Link to a resource that concerns the same actual person
+	Link []PersonLink `json:"link,omitempty"`
+
+	// The organization that is the custodian of the person record
+	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
+
+	// A name associated with the person
+	Name []HumanName `json:"name,omitempty"`
+
+	// Image of the person
+	Photo *Attachment `json:"photo,omitempty"`
+
+	// A contact detail for the person
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// AdministrativeGender represents the gender of a person
+type AdministrativeGender string
+
+const (
+	AdministrativeGenderMale    AdministrativeGender = "male"
+	AdministrativeGenderFemale  AdministrativeGender = "female"
+	AdministrativeGenderOther   AdministrativeGender = "other"
+	AdministrativeGenderUnknown AdministrativeGender = "unknown"
+)
+
+// PersonLink represents link to a resource that concerns the same actual person
+type PersonLink struct {
+	common.BackboneElement
+
+	// level1 | level2 | level3 | level4
+	Assurance        *PersonLinkAssurance `json:"assurance,omitempty"`
+	AssuranceElement *common.Element      `json:"_assurance,omitempty"`
+
+	// The resource to which this actual person is associated
+	Target *common.Reference `json:"target"`
+}
+
+// PersonLinkAssurance represents the assurance of a person link
+type PersonLinkAssurance string
+
+const (
+	PersonLinkAssuranceLevel1 PersonLinkAssurance = "level1"
+	PersonLinkAssuranceLevel2 PersonLinkAssurance = "level2"
+	PersonLinkAssuranceLevel3 PersonLinkAssurance = "level3"
+	PersonLinkAssuranceLevel4 PersonLinkAssurance = "level4"
+)
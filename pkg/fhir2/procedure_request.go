@@ -0,0 +1,87 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProcedureRequest represents a request for a procedure to be performed
+type ProcedureRequest struct {
+	DomainResource
+
+	// If a CodeableConcept is present, it indicates the pre-condition for performing the procedure
+	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
+
+	// Knowing where the procedure is made is important for tracking if multiple sites are possible
+	BodySite []common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// The specific procedure that is ordered
+	Code *common.CodeableConcept `json:"code"`
+
+	// The encounter within which the procedure proposal or request was created
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Identifiers assigned to this order by the order or by the receiver
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Any other notes associated with this proposal or order - e.g. provider instructions
+	Notes []Annotation `json:"notes,omitempty"`
+
+	// The time when the request was made
+	OrderedOn        *common.DateTime `json:"orderedOn,omitempty"`
+	OrderedOnElement *common.Element  `json:"_orderedOn,omitempty"`
+
+	// The healthcare professional responsible for proposing or ordering the procedure
+	Orderer *common.Reference `json:"orderer,omitempty"`
+
+	// For example, the surgeon, anaethetist, endoscopist, etc
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// The clinical priority associated with this order
+	Priority        *ProcedureRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element           `json:"_priority,omitempty"`
+
+	// The reason why the procedure is being proposed or ordered
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// The timing schedule for the proposed or ordered procedure
+	ScheduledDateTime        *common.DateTime `json:"scheduledDateTime,omitempty"`
+	ScheduledDateTimeElement *common.Element  `json:"_scheduledDateTime,omitempty"`
+	ScheduledPeriod          *common.Period   `json:"scheduledPeriod,omitempty"`
+	ScheduledTiming          *Timing          `json:"scheduledTiming,omitempty"`
+
+	// The status of the order
+	Status        *ProcedureRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
+
+	// The person, animal or group that should receive the procedure
+	Subject *common.Reference `json:"subject"`
+}
+
+// ProcedureRequestPriority represents the priority of a procedure request
+type ProcedureRequestPriority string
+
+const (
+	ProcedureRequestPriorityRoutine ProcedureRequestPriority = "routine"
+	ProcedureRequestPriorityUrgent  ProcedureRequestPriority = "urgent"
+	ProcedureRequestPriorityStat    ProcedureRequestPriority = "stat"
+	ProcedureRequestPriorityAsap    ProcedureRequestPriority = "asap"
+)
+
+// ProcedureRequestStatus represents the status of a procedure request
+type ProcedureRequestStatus string
+
+const (
+	ProcedureRequestStatusProposed   ProcedureRequestStatus = "proposed"
+	ProcedureRequestStatusDraft      ProcedureRequestStatus = "draft"
+	ProcedureRequestStatusRequested  ProcedureRequestStatus = "requested"
+	ProcedureRequestStatusReceived   ProcedureRequestStatus = "received"
+	ProcedureRequestStatusAccepted   ProcedureRequestStatus = "accepted"
+	ProcedureRequestStatusInProgress ProcedureRequestStatus = "in-progress"
+	ProcedureRequestStatusCompleted  ProcedureRequestStatus = "completed"
+	ProcedureRequestStatusSuspended  ProcedureRequestStatus = "suspended"
+	ProcedureRequestStatusRejected   ProcedureRequestStatus = "rejected"
+	ProcedureRequestStatusAborted    ProcedureRequestStatus = "aborted"
+)
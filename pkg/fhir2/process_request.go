@@ -0,0 +1,83 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProcessRequest provides the target, request and response
+type ProcessRequest struct {
+	DomainResource
+
+	// The type of processing action being requested, for example Reversal, Readjudication, StatusRequest,PendedRequest
+	Action        ProcessRequestAction `json:"action"`
+	ActionElement *common.Element      `json:"_action,omitempty"`
+
+	// The date when this resource was created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Names of resource types to exclude
+	Exclude        []string          `json:"exclude,omitempty"`
+	ExcludeElement []*common.Element `json:"_exclude,omitempty"`
+
+	// The ProcessRequest business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Names of resource types to include
+	Include        []string          `json:"include,omitempty"`
+	IncludeElement []*common.Element `json:"_include,omitempty"`
+
+	// List of top level items to be re-adjudicated, if none specified then the entire submission is re-adjudicated
+	Item []ProcessRequestItem `json:"item,omitempty"`
+
+	// Some resources must not simply be reversed in a processing or accounting sense but rather must have all history removed, such as the accidental submission of sensitive and/or wrong information
+	Nullify        *bool           `json:"nullify,omitempty"`
+	NullifyElement *common.Element `json:"_nullify,omitempty"`
+
+	// The organization which is responsible for the action speccified in thise request
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// A period of time during which the fulfilling resources would have been created
+	Period *common.Period `json:"period,omitempty"`
+
+	// The practitioner who is responsible for the action specified in thise request
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// A reference to supply which authenticates the process
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+
+	// Reference of resource which is the target or subject of this action
+	Request *common.Reference `json:"request,omitempty"`
+
+	// Reference of a prior response to resource which is the target or subject of this action
+	Response *common.Reference `json:"response,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+
+	// The organization which is the target of the request
+	Target *common.Reference `json:"target,omitempty"`
+}
+
+// ProcessRequestAction represents the action of a process request
+type ProcessRequestAction string
+
+const (
+	ProcessRequestActionCancel    ProcessRequestAction = "cancel"
+	ProcessRequestActionPoll      ProcessRequestAction = "poll"
+	ProcessRequestActionReprocess ProcessRequestAction = "reprocess"
+	ProcessRequestActionStatus    ProcessRequestAction = "status"
+)
+
+// ProcessRequestItem represents list of top level items to be re-adjudicated, if none specified then the entire submission is re-adjudicated
+type ProcessRequestItem struct {
+	common.BackboneElement
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+}
@@ -0,0 +1,63 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProcessResponse provides processing status, errors and notes from the processing of a resource
+type ProcessResponse struct {
+	DomainResource
+
+	// The date when the enclosed suite of services were performed or completed
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// A description of the status of the adjudication or processing
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Processing errors
+	Error []common.Coding `json:"error,omitempty"`
+
+	// The form to be used for printing the content
+	Form *common.Coding `json:"form,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Suite of processing note or additional requirements is the processing has been held
+	Notes []ProcessResponseNotes `json:"notes,omitempty"`
+
+	// The organization who produced this adjudicated response
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Knowledge of the original version can inform the processing of this instance so that information which is processable by the originating system may be generated
+	OriginalRuleset *common.Coding `json:"originalRuleset,omitempty"`
+
+	// Transaction status: error, complete, held
+	Outcome *common.Coding `json:"outcome,omitempty"`
+
+	// Original request resource reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// The version of the style of resource contents
+	Ruleset *common.Coding `json:"ruleset,omitempty"`
+}
+
+// ProcessResponseNotes represents suite of processing note or additional requirements is the processing has been held
+type ProcessResponseNotes struct {
+	common.BackboneElement
+
+	// The note text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The note purpose: Print/Display
+	Type *common.Coding `json:"type,omitempty"`
+}
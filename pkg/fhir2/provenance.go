@@ -0,0 +1,104 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Provenance represents Who, What, When for a set of resources
+type Provenance struct {
+	DomainResource
+
+	// Activity that occurred
+	Activity *common.CodeableConcept `json:"activity,omitempty"`
+
+	// Actor involved
+	Agent []ProvenanceAgent `json:"agent,omitempty"`
+
+	// An entity used in this activity
+	Entity []ProvenanceEntity `json:"entity,omitempty"`
+
+	// Where the activity occurred, if relevant
+	Location *common.Reference `json:"location,omitempty"`
+
+	// The period during which the activity occurred
+	Period *common.Period `json:"period,omitempty"`
+
+	// Policy or plan the activity was defined by
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
+
+	// The reason that the activity was taking place
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// When the activity was recorded / updated
+	Recorded        common.Instant  `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
+
+	// Signature on target
+	Signature []Signature `json:"signature,omitempty"`
+
+	// Target Reference(s) (usually version specific)
+	Target []common.Reference `json:"target"`
+}
+
+// ProvenanceAgentRelatedAgent represents a relationship between two the agents referenced in this resource
+type ProvenanceAgentRelatedAgent struct {
+	common.BackboneElement
+
+	// An internal reference to another agent listed in this provenance by its identifier
+	Target        string          `json:"target"`
+	TargetElement *common.Element `json:"_target,omitempty"`
+
+	// The type of relationship between agents
+	Type *common.CodeableConcept `json:"type"`
+}
+
+// ProvenanceAgent represents actor involved
+type ProvenanceAgent struct {
+	common.BackboneElement
+
+	// The individual, device or organization that participated in the event
+	Actor *common.Reference `json:"actor,omitempty"`
+
+	// A relationship between two the agents referenced in this resource
+	RelatedAgent []ProvenanceAgentRelatedAgent `json:"relatedAgent,omitempty"`
+
+	// What the agents role was
+	Role *common.Coding `json:"role"`
+
+	// The identity of the agent as known by the authorization system
+	UserId *common.Identifier `json:"userId,omitempty"`
+}
+
+// ProvenanceEntity represents an entity used in this activity
+type ProvenanceEntity struct {
+	common.BackboneElement
+
+	// The entity is attributed to an agent to express the agent's responsibility for that entity
+	Agent *ProvenanceAgent `json:"agent,omitempty"`
+
+	// Human-readable description of the entity
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// Identity of the Entity used
+	Reference        string          `json:"reference"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+
+	// revision | quotation | source | instantiates | removal
+	Role        ProvenanceEntityRole `json:"role"`
+	RoleElement *common.Element      `json:"_role,omitempty"`
+
+	// If the type is "resource" then the resource itself was the participant
+	Type *common.Coding `json:"type"`
+}
+
+// ProvenanceEntityRole represents the role of a provenance entity
+type ProvenanceEntityRole string
+
+const (
+	ProvenanceEntityRoleDerivation ProvenanceEntityRole = "derivation"
+	ProvenanceEntityRoleRevision   ProvenanceEntityRole = "revision"
+	ProvenanceEntityRoleQuotation  ProvenanceEntityRole = "quotation"
+	ProvenanceEntityRoleSource     ProvenanceEntityRole = "source"
+)
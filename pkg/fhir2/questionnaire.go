@@ -0,0 +1,140 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Questionnaire represents a structured set of questions
+type Questionnaire struct {
+	DomainResource
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Need to be able to logically group answers to grouped questions
+	Group *QuestionnaireGroup `json:"group"`
+
+	// Business identifier for questionnaire
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        QuestionnaireStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Resource that can be subject of QuestionnaireResponse
+	SubjectType        []string          `json:"subjectType,omitempty"`
+	SubjectTypeElement []*common.Element `json:"_subjectType,omitempty"`
+
+	// Contact details to assist a user in finding and communicating with the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+
+	// Business version of the questionnaire
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// QuestionnaireStatus represents the status of a questionnaire
+type QuestionnaireStatus string
+
+const (
+	QuestionnaireStatusDraft     QuestionnaireStatus = "draft"
+	QuestionnaireStatusPublished QuestionnaireStatus = "published"
+	QuestionnaireStatusRetired   QuestionnaireStatus = "retired"
+)
+
+// QuestionnaireGroupQuestion represents must register answers to questions
+type QuestionnaireGroupQuestion struct {
+	common.BackboneElement
+
+	// Allows linking of questions (and their answers) to formal terminologies
+	Concept []common.Coding `json:"concept,omitempty"`
+
+	// It is useful to have "sub-questions"
+	Group []QuestionnaireGroup `json:"group,omitempty"`
+
+	// [[[QuestionnaireResponse]]] does not require omitted groups to be included and may have some groups that repeat
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
+
+	// For a "choice" question, identifies one of the permitted answers for the question
+	Option []common.Coding `json:"option,omitempty"`
+
+	// Reference to a value set containing a list of codes representing permitted answers for the question
+	Options *common.Reference `json:"options,omitempty"`
+
+	// Used for "check all that apply" types of questions or "list your top 3", etc
+	Repeats        *bool           `json:"repeats,omitempty"`
+	RepeatsElement *common.Element `json:"_repeats,omitempty"`
+
+	// If true, indicates that the question must be answered and have required groups within it also present
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
+
+	// When including text for the questionnaire, each question may contain its actual question display text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Defines the format in which the user is to be prompted for the answer
+	Type        *QuestionnaireGroupQuestionType `json:"type,omitempty"`
+	TypeElement *common.Element                 `json:"_type,omitempty"`
+}
+
+// QuestionnaireGroupQuestionType represents the type of a questionnaire group question
+type QuestionnaireGroupQuestionType string
+
+const (
+	QuestionnaireGroupQuestionTypeBoolean    QuestionnaireGroupQuestionType = "boolean"
+	QuestionnaireGroupQuestionTypeDecimal    QuestionnaireGroupQuestionType = "decimal"
+	QuestionnaireGroupQuestionTypeInteger    QuestionnaireGroupQuestionType = "integer"
+	QuestionnaireGroupQuestionTypeDate       QuestionnaireGroupQuestionType = "date"
+	QuestionnaireGroupQuestionTypeDateTime   QuestionnaireGroupQuestionType = "dateTime"
+	QuestionnaireGroupQuestionTypeInstant    QuestionnaireGroupQuestionType = "instant"
+	QuestionnaireGroupQuestionTypeTime       QuestionnaireGroupQuestionType = "time"
+	QuestionnaireGroupQuestionTypeString     QuestionnaireGroupQuestionType = "string"
+	QuestionnaireGroupQuestionTypeText       QuestionnaireGroupQuestionType = "text"
+	QuestionnaireGroupQuestionTypeUrl        QuestionnaireGroupQuestionType = "url"
+	QuestionnaireGroupQuestionTypeChoice     QuestionnaireGroupQuestionType = "choice"
+	QuestionnaireGroupQuestionTypeOpenChoice QuestionnaireGroupQuestionType = "open-choice"
+	QuestionnaireGroupQuestionTypeAttachment QuestionnaireGroupQuestionType = "attachment"
+	QuestionnaireGroupQuestionTypeReference  QuestionnaireGroupQuestionType = "reference"
+	QuestionnaireGroupQuestionTypeQuantity   QuestionnaireGroupQuestionType = "quantity"
+)
+
+// QuestionnaireGroup represents need to be able to logically group answers to grouped questions
+type QuestionnaireGroup struct {
+	common.BackboneElement
+
+	// Allows linking of groups of questions (and complete Questionnaire resources) to formal terminologies
+	Concept []common.Coding `json:"concept,omitempty"`
+
+	// Reports can consist of complex nested groups
+	Group []QuestionnaireGroup `json:"group,omitempty"`
+
+	// [[[QuestionnaireResponse]]] does not require omitted groups to be included and may have some groups that repeat
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
+
+	// Must register answers to questions
+	Question []QuestionnaireGroupQuestion `json:"question,omitempty"`
+
+	// Groups may be used to create set of (related) questions that can be repeated to give multiple answers to such a set
+	Repeats        *bool           `json:"repeats,omitempty"`
+	RepeatsElement *common.Element `json:"_repeats,omitempty"`
+
+	// If true, indicates that the group must be present and have required questions within it answered
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
+
+	// When including text for the questionnaire, each section may contain additional explanations are background text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// When including text for the questionnaire with the answers, sections may have a section header
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
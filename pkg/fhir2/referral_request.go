@@ -0,0 +1,75 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ReferralRequest represents used to record and send details about a request for referral service or transfer of a patient to the care of another provider or provider organization
+type ReferralRequest struct {
+	DomainResource
+
+	// Date/DateTime of creation for draft requests and date of activation for active requests
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Date/DateTime the request for referral or transfer of care is sent by the author
+	DateSent        *common.DateTime `json:"dateSent,omitempty"`
+	DateSentElement *common.Element  `json:"_dateSent,omitempty"`
+
+	// The reason element gives a short description of why the referral is being made
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The encounter at which the request for referral or transfer of care is initiated
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Use cases: (1) to indicate that the requested service is not to happen before a specified date
+	FulfillmentTime *common.Period `json:"fulfillmentTime,omitempty"`
+
+	// Business identifier that uniquely identifies the referral/care transfer request instance
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The patient who is the subject of a referral or transfer of care request
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// An indication of the urgency of referral (or where applicable the type of transfer of care) request
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// Description of clinical condition indicating why referral/transfer of care is requested
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// The healthcare provider(s) or provider organization(s) who/which is to receive the referral/transfer of care request
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// The healthcare provider or provider organization who/which initiated the referral/transfer of care request
+	Requester *common.Reference `json:"requester,omitempty"`
+
+	// The service(s) that is/are requested to be provided to the patient
+	ServiceRequested []common.CodeableConcept `json:"serviceRequested,omitempty"`
+
+	// Indication of the clinical domain or discipline to which the referral or transfer of care request is sent
+	Specialty *common.CodeableConcept `json:"specialty,omitempty"`
+
+	// The workflow status of the referral or transfer of care request
+	Status        ReferralRequestStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// Any additional (administrative, financial or clinical) information required to support request for referral or transfer of care
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+
+	// An indication of the type of referral (or where applicable the type of transfer of care) request
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ReferralRequestStatus represents the status of a referral request
+type ReferralRequestStatus string
+
+const (
+	ReferralRequestStatusDraft     ReferralRequestStatus = "draft"
+	ReferralRequestStatusRequested ReferralRequestStatus = "requested"
+	ReferralRequestStatusActive    ReferralRequestStatus = "active"
+	ReferralRequestStatusCancelled ReferralRequestStatus = "cancelled"
+	ReferralRequestStatusAccepted  ReferralRequestStatus = "accepted"
+	ReferralRequestStatusRejected  ReferralRequestStatus = "rejected"
+	ReferralRequestStatusCompleted ReferralRequestStatus = "completed"
+)
@@ -0,0 +1,42 @@
+// Package fhir2 contains FHIR R2 (version 1.0.2) resource definitions
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+//go:generate go run ../../cmd/dtsgen -dts ../../js/r2.d.ts -ref ../fhir4 -profiles testdata/fhir2-definitions,../fhir5/testdata/fhir5-json -style fhir3
+//go:generate go run ../../cmd/resourcegen -profiles testdata/fhir2-definitions
+
+// registry knows every resource type modelled by this package
+var registry = common.NewResourceRegistry(resourceFactories)
+
+// NewResource allocates an empty resource struct for the given resourceType.
+// The second return value reports whether the resource type is known.
+func NewResource(resourceType string) (common.Resource, bool) {
+	return registry.New(resourceType)
+}
+
+// RegisterResource makes a custom struct available for decoding resources of the given type
+func RegisterResource(resourceType string, factory common.ResourceFactory) {
+	registry.Register(resourceType, factory)
+}
+
+// UnmarshalResource decodes a JSON resource into the matching struct of this package,
+// e.g. a Patient into *Patient. Unknown resource types are returned as *common.RawResource.
+func UnmarshalResource(data []byte) (common.Resource, error) {
+	return registry.Unmarshal(data)
+}
+
+// ResourceList is a list of inline resources that are decoded by their resourceType
+type ResourceList []common.Resource
+
+// UnmarshalJSON decodes every entry into the matching resource struct
+func (l *ResourceList) UnmarshalJSON(data []byte) error {
+	resources, err := registry.UnmarshalList(data)
+	if err != nil {
+		return err
+	}
+	*l = resources
+	return nil
+}
@@ -0,0 +1,90 @@
+// Package fhir2 contains FHIR R2 (version 1.0.2) resource definitions
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AnyResource is implemented by every resource of this package
+type AnyResource interface {
+	common.Resource
+
+	// GetMeta returns the metadata about the resource
+	GetMeta() *Meta
+
+	// SetMeta replaces the metadata about the resource
+	SetMeta(meta *Meta)
+}
+
+// AnyDomainResource is implemented by every resource of this package that
+// includes narrative, extensions and contained resources
+type AnyDomainResource interface {
+	AnyResource
+	common.DomainResource
+
+	// GetText returns the text summary of the resource
+	GetText() *Narrative
+
+	// SetText replaces the text summary of the resource
+	SetText(text *Narrative)
+}
+
+// GetID returns the logical id of the resource
+func (r *Resource) GetID() *string {
+	return r.ID
+}
+
+// SetID replaces the logical id of the resource
+func (r *Resource) SetID(id *string) {
+	r.ID = id
+}
+
+// GetMeta returns the metadata about the resource
+func (r *Resource) GetMeta() *Meta {
+	return r.Meta
+}
+
+// SetMeta replaces the metadata about the resource
+func (r *Resource) SetMeta(meta *Meta) {
+	r.Meta = meta
+}
+
+// GetText returns the text summary of the resource
+func (d *DomainResource) GetText() *Narrative {
+	return d.Text
+}
+
+// SetText replaces the text summary of the resource
+func (d *DomainResource) SetText(text *Narrative) {
+	d.Text = text
+}
+
+// GetContained returns the contained, inline resources
+func (d *DomainResource) GetContained() []common.Resource {
+	return d.Contained
+}
+
+// SetContained replaces the contained, inline resources
+func (d *DomainResource) SetContained(resources []common.Resource) {
+	d.Contained = resources
+}
+
+// GetExtension returns the extensions of the resource
+func (d *DomainResource) GetExtension() []common.Extension {
+	return d.Extension
+}
+
+// SetExtension replaces the extensions of the resource
+func (d *DomainResource) SetExtension(extension []common.Extension) {
+	d.Extension = extension
+}
+
+// GetModifierExtension returns the modifier extensions of the resource
+func (d *DomainResource) GetModifierExtension() []common.Extension {
+	return d.ModifierExtension
+}
+
+// SetModifierExtension replaces the modifier extensions of the resource
+func (d *DomainResource) SetModifierExtension(extension []common.Extension) {
+	d.ModifierExtension = extension
+}
@@ -2,6 +2,7 @@
 package fhir2
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/d4l-data4life/go-fhir/pkg/common"
@@ -21,16 +22,19 @@ type Resource struct {
 	ResourceType string `json:"resourceType"`
 
 	// Logical id of this artifact
-	ID *string `json:"id,omitempty"`
+	ID        *string         `json:"id,omitempty"`
+	IDElement *common.Element `json:"_id,omitempty"`
 
 	// Metadata about the resource
 	Meta *Meta `json:"meta,omitempty"`
 
 	// A set of rules under which this content was created
-	ImplicitRules *string `json:"implicitRules,omitempty"`
+	ImplicitRules        *string         `json:"implicitRules,omitempty"`
+	ImplicitRulesElement *common.Element `json:"_implicitRules,omitempty"`
 
 	// Language of the resource content
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 }
 
 // DomainResource represents a resource that includes narrative, extensions, and contained resources (R2 version)
@@ -41,7 +45,7 @@ type DomainResource struct {
 	Text *Narrative `json:"text,omitempty"`
 
 	// Contained, inline Resources
-	Contained []interface{} `json:"contained,omitempty"`
+	Contained ResourceList `json:"contained,omitempty"`
 
 	// Additional content defined by implementations
 	Extension []common.Extension `json:"extension,omitempty"`
@@ -55,13 +59,16 @@ type Meta struct {
 	common.Element
 
 	// Version specific identifier
-	VersionId *string `json:"versionId,omitempty"`
+	VersionId        *string         `json:"versionId,omitempty"`
+	VersionIdElement *common.Element `json:"_versionId,omitempty"`
 
 	// When the resource version last changed
-	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+	LastUpdated        *common.Instant `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *common.Element `json:"_lastUpdated,omitempty"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Security Labels applied to this resource
 	Security []common.Coding `json:"security,omitempty"`
@@ -75,22 +82,28 @@ type HumanName struct {
 	common.Element
 
 	// usual | official | temp | nickname | anonymous | old | maiden
-	Use *HumanNameUse `json:"use,omitempty"`
+	Use        *HumanNameUse   `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 
 	// Text representation of the full name
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Family name (often called 'Surname')
-	Family []string `json:"family,omitempty"` // R2 uses array, not single string
+	Family        []string          `json:"family,omitempty"` // R2 uses array, not single string
+	FamilyElement []*common.Element `json:"_family,omitempty"`
 
 	// Given names (not always 'first'). Includes middle names
-	Given []string `json:"given,omitempty"`
+	Given        []string          `json:"given,omitempty"`
+	GivenElement []*common.Element `json:"_given,omitempty"`
 
 	// Parts that come before the name
-	Prefix []string `json:"prefix,omitempty"`
+	Prefix        []string          `json:"prefix,omitempty"`
+	PrefixElement []*common.Element `json:"_prefix,omitempty"`
 
 	// Parts that come after the name
-	Suffix []string `json:"suffix,omitempty"`
+	Suffix        []string          `json:"suffix,omitempty"`
+	SuffixElement []*common.Element `json:"_suffix,omitempty"`
 
 	// Time period when name was/is in use
 	Period *common.Period `json:"period,omitempty"`
@@ -114,7 +127,8 @@ type Patient struct {
 	DomainResource
 
 	// Whether this patient's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses for the individual
 	Address []Address `json:"address,omitempty"`
@@ -123,7 +137,8 @@ type Patient struct {
 	Animal *PatientAnimal `json:"animal,omitempty"`
 
 	// The date of birth for the individual
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// Patient's nominated care provider
 	CareProvider []common.Reference `json:"careProvider,omitempty"`
@@ -135,11 +150,14 @@ type Patient struct {
 	Contact []PatientContact `json:"contact,omitempty"`
 
 	// Indicates if the individual is deceased or not
-	DeceasedBoolean  *bool   `json:"deceasedBoolean,omitempty"`
-	DeceasedDateTime *string `json:"deceasedDateTime,omitempty"` // R2 uses string, not time.Time
+	DeceasedBoolean         *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement  *common.Element  `json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime        *common.DateTime `json:"deceasedDateTime,omitempty"` // R2 uses string, not time.Time
+	DeceasedDateTimeElement *common.Element  `json:"_deceasedDateTime,omitempty"`
 
 	// male | female | other | unknown
-	Gender *PatientGender `json:"gender,omitempty"`
+	Gender        *PatientGender  `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// Identifier for this individual
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -154,8 +172,10 @@ type Patient struct {
 	MaritalStatus *common.CodeableConcept `json:"maritalStatus,omitempty"`
 
 	// Whether patient is part of a multiple birth
-	MultipleBirthBoolean *bool `json:"multipleBirthBoolean,omitempty"`
-	MultipleBirthInteger *int  `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthBoolean        *bool           `json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *common.Element `json:"_multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger        *int            `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *common.Element `json:"_multipleBirthInteger,omitempty"`
 
 	// A name associated with the patient
 	Name []HumanName `json:"name,omitempty"`
@@ -189,7 +209,8 @@ type PatientCommunication struct {
 	Language *common.CodeableConcept `json:"language"`
 
 	// Language preference indicator
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 }
 
 // PatientContact represents a contact party for the patient (R2 version)
@@ -200,7 +221,8 @@ type PatientContact struct {
 	Address *Address `json:"address,omitempty"`
 
 	// male | female | other | unknown
-	Gender *PatientGender `json:"gender,omitempty"`
+	Gender        *PatientGender  `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// A name associated with the contact person
 	Name *HumanName `json:"name,omitempty"`
@@ -223,7 +245,8 @@ type PatientLink struct {
 	Other *common.Reference `json:"other"`
 
 	// replace | replaces | refer | seealso
-	Type PatientLinkType `json:"type"`
+	Type        PatientLinkType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // PatientGender represents the gender of a patient (R2 version)
@@ -260,10 +283,12 @@ type Bundle struct {
 	Signature *Signature `json:"signature,omitempty"`
 
 	// Only used if the bundle is a search result set
-	Total *int `json:"total,omitempty"`
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
 
 	// document | message | transaction | transaction-response | batch | batch-response | history | searchset | collection
-	Type BundleType `json:"type"`
+	Type        BundleType      `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // BundleEntry represents an entry in a bundle resource (R2 version)
@@ -271,7 +296,8 @@ type BundleEntry struct {
 	common.BackboneElement
 
 	// URI for resource (Absolute or relative)
-	FullURL *string `json:"fullUrl,omitempty"`
+	FullURL        *string         `json:"fullUrl,omitempty"`
+	FullURLElement *common.Element `json:"_fullUrl,omitempty"`
 
 	// Links related to this entry
 	Link []BundleLink `json:"link,omitempty"`
@@ -280,7 +306,7 @@ type BundleEntry struct {
 	Request *BundleEntryRequest `json:"request,omitempty"`
 
 	// The Resource for the entry
-	Resource interface{} `json:"resource,omitempty"`
+	Resource common.Resource `json:"resource,omitempty"`
 
 	// Transaction Related Information
 	Response *BundleEntryResponse `json:"response,omitempty"`
@@ -294,10 +320,12 @@ type BundleLink struct {
 	common.BackboneElement
 
 	// See http://www.iana.org/assignments/link-relations/link-relations.xhtml#link-relations-1
-	Relation string `json:"relation"`
+	Relation        string          `json:"relation"`
+	RelationElement *common.Element `json:"_relation,omitempty"`
 
 	// Reference details for the link
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntryRequest represents additional execution information (R2 version)
@@ -305,22 +333,28 @@ type BundleEntryRequest struct {
 	common.BackboneElement
 
 	// For managing update contention
-	IfMatch *string `json:"ifMatch,omitempty"`
+	IfMatch        *string         `json:"ifMatch,omitempty"`
+	IfMatchElement *common.Element `json:"_ifMatch,omitempty"`
 
 	// For managing update contention
-	IfModifiedSince *string `json:"ifModifiedSince,omitempty"` // R2 uses string, not time
+	IfModifiedSince        *common.Instant `json:"ifModifiedSince,omitempty"` // R2 uses string, not time
+	IfModifiedSinceElement *common.Element `json:"_ifModifiedSince,omitempty"`
 
 	// For conditional creates
-	IfNoneExist *string `json:"ifNoneExist,omitempty"`
+	IfNoneExist        *string         `json:"ifNoneExist,omitempty"`
+	IfNoneExistElement *common.Element `json:"_ifNoneExist,omitempty"`
 
 	// For conditional read
-	IfNoneMatch *string `json:"ifNoneMatch,omitempty"`
+	IfNoneMatch        *string         `json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement *common.Element `json:"_ifNoneMatch,omitempty"`
 
 	// GET | POST | PUT | DELETE
-	Method BundleEntryRequestMethod `json:"method"`
+	Method        BundleEntryRequestMethod `json:"method"`
+	MethodElement *common.Element          `json:"_method,omitempty"`
 
 	// URL for HTTP equivalent of this entry
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntryResponse represents transaction related information (R2 version)
@@ -328,16 +362,20 @@ type BundleEntryResponse struct {
 	common.BackboneElement
 
 	// The Etag for the resource
-	Etag *string `json:"etag,omitempty"`
+	Etag        *string         `json:"etag,omitempty"`
+	EtagElement *common.Element `json:"_etag,omitempty"`
 
 	// Server's date time modified
-	LastModified *string `json:"lastModified,omitempty"` // R2 uses string, not time
+	LastModified        *common.Instant `json:"lastModified,omitempty"` // R2 uses string, not time
+	LastModifiedElement *common.Element `json:"_lastModified,omitempty"`
 
 	// The location (if the operation returns a location)
-	Location *string `json:"location,omitempty"`
+	Location        *string         `json:"location,omitempty"`
+	LocationElement *common.Element `json:"_location,omitempty"`
 
 	// Status response code
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // BundleEntrySearch represents search related information (R2 version)
@@ -345,10 +383,12 @@ type BundleEntrySearch struct {
 	common.BackboneElement
 
 	// match | include | outcome - why this is in the result set
-	Mode *BundleEntrySearchMode `json:"mode,omitempty"`
+	Mode        *BundleEntrySearchMode `json:"mode,omitempty"`
+	ModeElement *common.Element        `json:"_mode,omitempty"`
 
 	// Search ranking
-	Score *float64 `json:"score,omitempty"`
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
 }
 
 // Bundle-related enums (R2 version)
@@ -388,7 +428,8 @@ type Organization struct {
 	DomainResource
 
 	// Whether the organization's record is still in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses for the organization
 	Address []Address `json:"address,omitempty"`
@@ -400,7 +441,8 @@ type Organization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Name used for the organization
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The organization of which this organization forms a part
 	PartOf *common.Reference `json:"partOf,omitempty"`
@@ -434,19 +476,22 @@ type Practitioner struct {
 	DomainResource
 
 	// Whether this practitioner's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses where the practitioner can be found or visited or to which mail can be delivered
 	Address []Address `json:"address,omitempty"`
 
 	// The date of birth for the practitioner
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// A language the practitioner can use in patient communication
 	Communication []common.CodeableConcept `json:"communication,omitempty"`
 
 	// male | female | other | unknown
-	Gender *PractitionerGender `json:"gender,omitempty"`
+	Gender        *PractitionerGender `json:"gender,omitempty"`
+	GenderElement *common.Element     `json:"_gender,omitempty"`
 
 	// An identifier for this practitioner
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -519,7 +564,11 @@ type Encounter struct {
 	Appointment *common.Reference `json:"appointment,omitempty"` // R2 uses single reference, not array
 
 	// inpatient | outpatient | ambulatory | emergency +
-	Class *EncounterClass `json:"class,omitempty"` // R2 uses enum, not Coding
+	Class        *EncounterClass `json:"class,omitempty"` // R2 uses enum, not Coding
+	ClassElement *common.Element `json:"_class,omitempty"`
+
+	// Episode(s) of care that this encounter should be recorded against
+	EpisodeOfCare []common.Reference `json:"episodeOfCare,omitempty"`
 
 	// Where the encounter took place
 	Hospitalization *EncounterHospitalization `json:"hospitalization,omitempty"`
@@ -530,6 +579,9 @@ type Encounter struct {
 	// Incoming Referral Request
 	IncomingReferral []common.Reference `json:"incomingReferral,omitempty"`
 
+	// Reason the encounter takes place (resource)
+	Indication []common.Reference `json:"indication,omitempty"`
+
 	// Quantity of time the encounter lasted
 	Length *Duration `json:"length,omitempty"`
 
@@ -555,7 +607,8 @@ type Encounter struct {
 	ServiceProvider *common.Reference `json:"serviceProvider,omitempty"`
 
 	// planned | arrived | in-progress | onleave | finished | cancelled
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// List of past encounter statuses
 	StatusHistory []EncounterStatusHistory `json:"statusHistory,omitempty"`
@@ -610,7 +663,8 @@ type EncounterLocation struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// planned | active | reserved | completed
-	Status *EncounterLocationStatus `json:"status,omitempty"`
+	Status        *EncounterLocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
 
 // EncounterParticipant represents list of participants involved in the encounter (R2 version)
@@ -635,7 +689,8 @@ type EncounterStatusHistory struct {
 	Period *common.Period `json:"period"`
 
 	// planned | arrived | in-progress | onleave | finished | cancelled
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // Encounter-related enums (R2 version)
@@ -677,12 +732,15 @@ type Condition struct {
 	DomainResource
 
 	// Estimated or actual date or date-time the condition was resolved
-	AbatementDateTime *string          `json:"abatementDateTime,omitempty"` // R2 uses string
-	AbatementQuantity *common.Quantity `json:"abatementQuantity,omitempty"` // R2 uses Quantity, not Age
-	AbatementBoolean  *bool            `json:"abatementBoolean,omitempty"`
-	AbatementPeriod   *common.Period   `json:"abatementPeriod,omitempty"`
-	AbatementRange    *Range           `json:"abatementRange,omitempty"`
-	AbatementString   *string          `json:"abatementString,omitempty"`
+	AbatementDateTime        *common.DateTime `json:"abatementDateTime,omitempty"` // R2 uses string
+	AbatementDateTimeElement *common.Element  `json:"_abatementDateTime,omitempty"`
+	AbatementQuantity        *common.Quantity `json:"abatementQuantity,omitempty"` // R2 uses Quantity, not Age
+	AbatementBoolean         *bool            `json:"abatementBoolean,omitempty"`
+	AbatementBooleanElement  *common.Element  `json:"_abatementBoolean,omitempty"`
+	AbatementPeriod          *common.Period   `json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range           `json:"abatementRange,omitempty"`
+	AbatementString          *string          `json:"abatementString,omitempty"`
+	AbatementStringElement   *common.Element  `json:"_abatementString,omitempty"`
 
 	// Person who asserts this condition
 	Asserter *common.Reference `json:"asserter,omitempty"`
@@ -694,11 +752,16 @@ type Condition struct {
 	Category *common.CodeableConcept `json:"category,omitempty"` // R2 uses single, not array
 
 	// active | relapse | remission | resolved
-	ClinicalStatus *ConditionClinicalStatus `json:"clinicalStatus,omitempty"` // R2 has different values
+	ClinicalStatus        *ConditionClinicalStatus `json:"clinicalStatus,omitempty"` // R2 has different values
+	ClinicalStatusElement *common.Element          `json:"_clinicalStatus,omitempty"`
 
 	// Identification of the condition, problem or diagnosis
 	Code *common.CodeableConcept `json:"code"`
 
+	// When first entered
+	DateRecorded        *common.Date    `json:"dateRecorded,omitempty"`
+	DateRecordedElement *common.Element `json:"_dateRecorded,omitempty"`
+
 	// Encounter when condition first asserted
 	Encounter *common.Reference `json:"encounter,omitempty"` // R2 uses "encounter" not "context"
 
@@ -709,14 +772,17 @@ type Condition struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Additional information about the Condition
-	Notes *string `json:"notes,omitempty"` // R2 uses single string, not array
+	Notes        *string         `json:"notes,omitempty"` // R2 uses single string, not array
+	NotesElement *common.Element `json:"_notes,omitempty"`
 
 	// Date record was believed accurate
-	OnsetDateTime *string          `json:"onsetDateTime,omitempty"` // R2 uses string
-	OnsetQuantity *common.Quantity `json:"onsetQuantity,omitempty"` // R2 uses Quantity, not Age
-	OnsetPeriod   *common.Period   `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range           `json:"onsetRange,omitempty"`
-	OnsetString   *string          `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"` // R2 uses string
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetQuantity        *common.Quantity `json:"onsetQuantity,omitempty"` // R2 uses Quantity, not Age
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// Who has the condition?
 	Patient *common.Reference `json:"patient"` // R2 uses "patient" not "subject"
@@ -728,7 +794,8 @@ type Condition struct {
 	Stage *ConditionStage `json:"stage,omitempty"`
 
 	// provisional | differential | confirmed | refuted | entered-in-error | unknown
-	VerificationStatus ConditionVerificationStatus `json:"verificationStatus"` // R2 required field
+	VerificationStatus        ConditionVerificationStatus `json:"verificationStatus"` // R2 required field
+	VerificationStatusElement *common.Element             `json:"_verificationStatus,omitempty"`
 }
 
 // ConditionEvidence represents supporting evidence (R2 version)
@@ -796,6 +863,9 @@ type Procedure struct {
 	// Encounter associated with the procedure
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
+	// Device changed in procedure
+	FocalDevice []ProcedureDevice `json:"focalDevice,omitempty"`
+
 	// Instructions for follow up
 	FollowUp []common.CodeableConcept `json:"followUp,omitempty"`
 
@@ -805,6 +875,10 @@ type Procedure struct {
 	// Where the procedure happened
 	Location *common.Reference `json:"location,omitempty"`
 
+	// True if procedure was not performed as scheduled
+	NotPerformed        *bool           `json:"notPerformed,omitempty"`
+	NotPerformedElement *common.Element `json:"_notPerformed,omitempty"`
+
 	// Additional information about the procedure
 	Notes []Annotation `json:"notes,omitempty"` // R2 uses array of Annotation
 
@@ -812,8 +886,9 @@ type Procedure struct {
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
 
 	// Date/Period the procedure was performed
-	PerformedDateTime *string        `json:"performedDateTime,omitempty"` // R2 uses string
-	PerformedPeriod   *common.Period `json:"performedPeriod,omitempty"`
+	PerformedDateTime        *common.DateTime `json:"performedDateTime,omitempty"` // R2 uses string
+	PerformedDateTimeElement *common.Element  `json:"_performedDateTime,omitempty"`
+	PerformedPeriod          *common.Period   `json:"performedPeriod,omitempty"`
 
 	// The people who performed the procedure
 	Performer []ProcedurePerformer `json:"performer,omitempty"`
@@ -821,6 +896,9 @@ type Procedure struct {
 	// Coded reason procedure performed
 	ReasonCodeableConcept []common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
 
+	// Reason procedure was not performed
+	ReasonNotPerformed []common.CodeableConcept `json:"reasonNotPerformed,omitempty"`
+
 	// Condition that justifies procedure
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
@@ -831,7 +909,8 @@ type Procedure struct {
 	Request *common.Reference `json:"request,omitempty"` // R2 uses single reference
 
 	// in-progress | aborted | completed | entered-in-error
-	Status ProcedureStatus `json:"status"`
+	Status        ProcedureStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Who the procedure was performed on
 	Subject *common.Reference `json:"subject"` // R2 uses "subject"
@@ -880,7 +959,8 @@ type Location struct {
 	Address *Address `json:"address,omitempty"`
 
 	// Description of the Location
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Unique code or number identifying the location to its users
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -889,10 +969,12 @@ type Location struct {
 	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
 
 	// instance | kind
-	Mode *LocationMode `json:"mode,omitempty"`
+	Mode        *LocationMode   `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
 
 	// Name of the location as used by humans
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Another Location this one is physically part of
 	PartOf *common.Reference `json:"partOf,omitempty"`
@@ -904,7 +986,8 @@ type Location struct {
 	Position *LocationPosition `json:"position,omitempty"`
 
 	// active | suspended | inactive
-	Status *LocationStatus `json:"status,omitempty"`
+	Status        *LocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Contact details of the location
 	Telecom []ContactPoint `json:"telecom,omitempty"`
@@ -918,13 +1001,16 @@ type LocationPosition struct {
 	common.BackboneElement
 
 	// Altitude with WGS84 datum
-	Altitude *float64 `json:"altitude,omitempty"`
+	Altitude        *common.Decimal `json:"altitude,omitempty"`
+	AltitudeElement *common.Element `json:"_altitude,omitempty"`
 
 	// Latitude with WGS84 datum
-	Latitude float64 `json:"latitude"`
+	Latitude        common.Decimal  `json:"latitude"`
+	LatitudeElement *common.Element `json:"_latitude,omitempty"`
 
 	// Longitude with WGS84 datum
-	Longitude float64 `json:"longitude"`
+	Longitude        common.Decimal  `json:"longitude"`
+	LongitudeElement *common.Element `json:"_longitude,omitempty"`
 }
 
 // Location-related enums (R2 version)
@@ -957,11 +1043,13 @@ type DiagnosticReport struct {
 	CodedDiagnosis []common.CodeableConcept `json:"codedDiagnosis,omitempty"`
 
 	// Clinical Interpretation of test results
-	Conclusion *string `json:"conclusion,omitempty"`
+	Conclusion        *string         `json:"conclusion,omitempty"`
+	ConclusionElement *common.Element `json:"_conclusion,omitempty"`
 
 	// Clinically relevant time/time-period for report
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"` // R2 uses string
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"` // R2 uses string
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
 
 	// Health care event when test ordered
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -972,8 +1060,12 @@ type DiagnosticReport struct {
 	// Key images associated with this report
 	Image []DiagnosticReportImage `json:"image,omitempty"`
 
+	// Reference to full details of imaging associated with the diagnostic report
+	ImagingStudy []common.Reference `json:"imagingStudy,omitempty"`
+
 	// DateTime this version was released
-	Issued *string `json:"issued,omitempty"` // R2 uses string
+	Issued        *common.Instant `json:"issued,omitempty"` // R2 uses string
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// Responsible Diagnostic Service
 	Performer *common.Reference `json:"performer"`
@@ -981,6 +1073,9 @@ type DiagnosticReport struct {
 	// Entire report as issued
 	PresentedForm []Attachment `json:"presentedForm,omitempty"`
 
+	// What was requested
+	Request []common.Reference `json:"request,omitempty"`
+
 	// Observations - simple observations
 	Result []common.Reference `json:"result,omitempty"`
 
@@ -988,7 +1083,8 @@ type DiagnosticReport struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error
-	Status DiagnosticReportStatus `json:"status"`
+	Status        DiagnosticReportStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// The subject of the report - usually, but not always, the patient
 	Subject *common.Reference `json:"subject"`
@@ -999,7 +1095,8 @@ type DiagnosticReportImage struct {
 	common.BackboneElement
 
 	// Comment about the image
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Reference to the image source
 	Link *common.Reference `json:"link"`
@@ -1024,6 +1121,9 @@ const (
 type Observation struct {
 	DomainResource
 
+	// Observed body part
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
 	// Classification of type of observation
 	Category *common.CodeableConcept `json:"category,omitempty"` // R2 uses single, not array
 
@@ -1031,7 +1131,11 @@ type Observation struct {
 	Code *common.CodeableConcept `json:"code"`
 
 	// Comments about result
-	Comments *string `json:"comments,omitempty"` // R2 uses single string
+	Comments        *string         `json:"comments,omitempty"` // R2 uses single string
+	CommentsElement *common.Element `json:"_comments,omitempty"`
+
+	// Component results
+	Component []ObservationComponent `json:"component,omitempty"`
 
 	// Why the result is missing
 	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
@@ -1040,8 +1144,9 @@ type Observation struct {
 	Device *common.Reference `json:"device,omitempty"`
 
 	// Clinically relevant time/time-period for observation
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"` // R2 uses string
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"` // R2 uses string
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
 
 	// Healthcare event during which this observation is made
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -1053,7 +1158,8 @@ type Observation struct {
 	Interpretation *common.CodeableConcept `json:"interpretation,omitempty"`
 
 	// Date/Time this was made available
-	Issued *string `json:"issued,omitempty"` // R2 uses string
+	Issued        *common.Instant `json:"issued,omitempty"` // R2 uses string
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// The observation method
 	Method *common.CodeableConcept `json:"method,omitempty"`
@@ -1071,7 +1177,8 @@ type Observation struct {
 	Specimen *common.Reference `json:"specimen,omitempty"`
 
 	// registered | preliminary | final | amended | cancelled | entered-in-error | unknown
-	Status ObservationStatus `json:"status"`
+	Status        ObservationStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Who and/or what this is about
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1080,12 +1187,44 @@ type Observation struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
 	ValueSampledData     *SampledData            `json:"valueSampledData,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
-	ValueTime            *string                 `json:"valueTime,omitempty"`
-	ValueDateTime        *string                 `json:"valueDateTime,omitempty"` // R2 uses string
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"` // R2 uses string
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
+	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
+}
+
+// ObservationComponent represents a component result of an observation (R2 version)
+type ObservationComponent struct {
+	common.BackboneElement
+
+	// Type of component observation (code / type)
+	Code *common.CodeableConcept `json:"code"`
+
+	// Why the component result is missing
+	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
+
+	// Provides guide for interpretation of component result
+	ReferenceRange []ObservationReferenceRange `json:"referenceRange,omitempty"`
+
+	// Actual component result
+	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
+	ValueRange           *Range                  `json:"valueRange,omitempty"`
+	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
+	ValueSampledData     *SampledData            `json:"valueSampledData,omitempty"`
+	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
 }
 
@@ -1106,7 +1245,8 @@ type ObservationReferenceRange struct {
 	Meaning []common.CodeableConcept `json:"meaning,omitempty"`
 
 	// Text based reference range in an observation
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // ObservationRelated represents observations related to this observation (R2 version)
@@ -1117,7 +1257,8 @@ type ObservationRelated struct {
 	Target *common.Reference `json:"target"`
 
 	// has-member | derived-from | sequel-to | replaces | qualified-by | interfered-by
-	Type *ObservationRelatedType `json:"type,omitempty"`
+	Type        *ObservationRelatedType `json:"type,omitempty"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
 }
 
 // Observation-related enums (R2 version)
@@ -1152,7 +1293,8 @@ type Medication struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// True if a brand
-	IsBrand *bool `json:"isBrand,omitempty"`
+	IsBrand        *bool           `json:"isBrand,omitempty"`
+	IsBrandElement *common.Element `json:"_isBrand,omitempty"`
 
 	// Manufacturer of the item
 	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
@@ -1194,10 +1336,12 @@ type MedicationProductBatch struct {
 	common.BackboneElement
 
 	// When batch will expire
-	ExpirationDate *string `json:"expirationDate,omitempty"` // R2 uses string
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"` // R2 uses string
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// The assigned lot number of a batch of the specified product
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 }
 
 // MedicationPackage represents details about packaged medications (R2 version)
@@ -1226,8 +1370,13 @@ type MedicationPackageContent struct {
 type MedicationOrder struct {
 	DomainResource
 
+	// When prescription was stopped
+	DateEnded        *common.DateTime `json:"dateEnded,omitempty"`
+	DateEndedElement *common.Element  `json:"_dateEnded,omitempty"`
+
 	// When prescription was initially authorized
-	DateWritten *string `json:"dateWritten,omitempty"` // R2 uses string
+	DateWritten        *common.DateTime `json:"dateWritten,omitempty"` // R2 uses string
+	DateWrittenElement *common.Element  `json:"_dateWritten,omitempty"`
 
 	// Medication supply authorization
 	DispenseRequest *MedicationOrderDispenseRequest `json:"dispenseRequest,omitempty"`
@@ -1246,7 +1395,8 @@ type MedicationOrder struct {
 	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
 
 	// Information about the prescription
-	Note *string `json:"note,omitempty"` // R2 uses single string
+	Note        *string         `json:"note,omitempty"` // R2 uses single string
+	NoteElement *common.Element `json:"_note,omitempty"`
 
 	// Who ordered the medication(s)
 	Prescriber *common.Reference `json:"prescriber,omitempty"`
@@ -1255,14 +1405,19 @@ type MedicationOrder struct {
 	PriorPrescription *common.Reference `json:"priorPrescription,omitempty"`
 
 	// routine | urgent | stat | asap
-	Priority *MedicationOrderPriority `json:"priority,omitempty"`
+	Priority        *MedicationOrderPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element          `json:"_priority,omitempty"`
 
 	// Reason or indication for writing the prescription
 	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
 	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
 
+	// Why prescription was stopped
+	ReasonEnded *common.CodeableConcept `json:"reasonEnded,omitempty"`
+
 	// active | on-hold | completed | entered-in-error | stopped | draft
-	Status *MedicationOrderStatus `json:"status,omitempty"`
+	Status        *MedicationOrderStatus `json:"status,omitempty"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// Who prescription is for
 	Patient *common.Reference `json:"patient"` // R2 uses "patient" not "subject"
@@ -1283,7 +1438,8 @@ type MedicationOrderDispenseRequest struct {
 	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
 
 	// Number of refills authorized
-	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed        *int            `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *common.Element `json:"_numberOfRepeatsAllowed,omitempty"`
 
 	// Amount of medication to supply per dispense
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -1332,7 +1488,8 @@ type QuestionnaireResponse struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// Date the answers were gathered
-	Authored *string `json:"authored,omitempty"` // R2 uses string
+	Authored        *common.DateTime `json:"authored,omitempty"` // R2 uses string
+	AuthoredElement *common.Element  `json:"_authored,omitempty"`
 
 	// Primary encounter during which questionnaire was completed
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -1350,7 +1507,8 @@ type QuestionnaireResponse struct {
 	Source *common.Reference `json:"source,omitempty"`
 
 	// in-progress | completed | amended
-	Status QuestionnaireResponseStatus `json:"status"`
+	Status        QuestionnaireResponseStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 
 	// The subject of the questions
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1364,7 +1522,8 @@ type QuestionnaireResponseGroup struct {
 	Group []QuestionnaireResponseGroup `json:"group,omitempty"`
 
 	// Groups can repeat in the answers, so a direct 1..1 correspondence may not exist
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// Questions in this group
 	Question []QuestionnaireResponseGroupQuestion `json:"question,omitempty"`
@@ -1373,10 +1532,12 @@ type QuestionnaireResponseGroup struct {
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// Additional text for the group
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Name for the group
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // QuestionnaireResponseGroupQuestion represents questions in a group (R2 version)
@@ -1387,10 +1548,12 @@ type QuestionnaireResponseGroupQuestion struct {
 	Answer []QuestionnaireResponseGroupQuestionAnswer `json:"answer,omitempty"`
 
 	// Groups can repeat in the answers, so a direct 1..1 correspondence may not exist
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// Text of the question as it is shown to the user
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // QuestionnaireResponseGroupQuestionAnswer represents the response(s) to the question (R2 version)
@@ -1401,19 +1564,28 @@ type QuestionnaireResponseGroupQuestionAnswer struct {
 	Group []QuestionnaireResponseGroup `json:"group,omitempty"`
 
 	// Single-valued answer to the question
-	ValueBoolean    *bool             `json:"valueBoolean,omitempty"`
-	ValueDecimal    *float64          `json:"valueDecimal,omitempty"`
-	ValueInteger    *int              `json:"valueInteger,omitempty"`
-	ValueDate       *string           `json:"valueDate,omitempty"`
-	ValueDateTime   *string           `json:"valueDateTime,omitempty"` // R2 uses string
-	ValueInstant    *string           `json:"valueInstant,omitempty"`  // R2 has instant type
-	ValueTime       *string           `json:"valueTime,omitempty"`
-	ValueString     *string           `json:"valueString,omitempty"`
-	ValueUri        *string           `json:"valueUri,omitempty"`
-	ValueAttachment *Attachment       `json:"valueAttachment,omitempty"`
-	ValueCoding     *common.Coding    `json:"valueCoding,omitempty"`
-	ValueQuantity   *common.Quantity  `json:"valueQuantity,omitempty"`
-	ValueReference  *common.Reference `json:"valueReference,omitempty"`
+	ValueBoolean         *bool             `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element   `json:"_valueBoolean,omitempty"`
+	ValueDecimal         *common.Decimal   `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element   `json:"_valueDecimal,omitempty"`
+	ValueInteger         *int              `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element   `json:"_valueInteger,omitempty"`
+	ValueDate            *common.Date      `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element   `json:"_valueDate,omitempty"`
+	ValueDateTime        *common.DateTime  `json:"valueDateTime,omitempty"` // R2 uses string
+	ValueDateTimeElement *common.Element   `json:"_valueDateTime,omitempty"`
+	ValueInstant         *common.Instant   `json:"valueInstant,omitempty"` // R2 has instant type
+	ValueInstantElement  *common.Element   `json:"_valueInstant,omitempty"`
+	ValueTime            *common.Time      `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element   `json:"_valueTime,omitempty"`
+	ValueString          *string           `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element   `json:"_valueString,omitempty"`
+	ValueUri             *string           `json:"valueUri,omitempty"`
+	ValueUriElement      *common.Element   `json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment       `json:"valueAttachment,omitempty"`
+	ValueCoding          *common.Coding    `json:"valueCoding,omitempty"`
+	ValueQuantity        *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueReference       *common.Reference `json:"valueReference,omitempty"`
 }
 
 // QuestionnaireResponse-related enums (R2 version)
@@ -1448,7 +1620,8 @@ type Communication struct {
 	Reason []common.CodeableConcept `json:"reason,omitempty"`
 
 	// When received
-	Received *string `json:"received,omitempty"` // R2 uses string
+	Received        *common.DateTime `json:"received,omitempty"` // R2 uses string
+	ReceivedElement *common.Element  `json:"_received,omitempty"`
 
 	// Message recipient
 	Recipient []common.Reference `json:"recipient,omitempty"`
@@ -1460,10 +1633,12 @@ type Communication struct {
 	Sender *common.Reference `json:"sender,omitempty"`
 
 	// When sent
-	Sent *string `json:"sent,omitempty"` // R2 uses string
+	Sent        *common.DateTime `json:"sent,omitempty"` // R2 uses string
+	SentElement *common.Element  `json:"_sent,omitempty"`
 
 	// in-progress | completed | suspended | rejected | failed
-	Status CommunicationStatus `json:"status"`
+	Status        CommunicationStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// Focus of message
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1474,9 +1649,10 @@ type CommunicationPayload struct {
 	common.BackboneElement
 
 	// Message part content
-	ContentString     *string           `json:"contentString,omitempty"`
-	ContentAttachment *Attachment       `json:"contentAttachment,omitempty"`
-	ContentReference  *common.Reference `json:"contentReference,omitempty"`
+	ContentString        *string           `json:"contentString,omitempty"`
+	ContentStringElement *common.Element   `json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference     *common.Reference `json:"contentReference,omitempty"`
 }
 
 // Communication-related enums (R2 version)
@@ -1504,13 +1680,15 @@ type Composition struct {
 	Class *common.CodeableConcept `json:"class,omitempty"`
 
 	// As defined by affinity domain
-	Confidentiality *string `json:"confidentiality,omitempty"`
+	Confidentiality        *string         `json:"confidentiality,omitempty"`
+	ConfidentialityElement *common.Element `json:"_confidentiality,omitempty"`
 
 	// Organization which maintains the composition
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// Composition editing time
-	Date string `json:"date"` // R2 uses string
+	Date        common.DateTime `json:"date"` // R2 uses string
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Context of the Composition
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -1525,13 +1703,15 @@ type Composition struct {
 	Section []CompositionSection `json:"section,omitempty"`
 
 	// preliminary | final | amended | entered-in-error
-	Status CompositionStatus `json:"status"`
+	Status        CompositionStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Who and/or what the composition is about
 	Subject *common.Reference `json:"subject"`
 
 	// Human Readable name/title
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Kind of composition (LOINC if possible)
 	Type *common.CodeableConcept `json:"type"`
@@ -1542,13 +1722,15 @@ type CompositionAttester struct {
 	common.BackboneElement
 
 	// personal | professional | legal | official
-	Mode []CompositionAttesterMode `json:"mode"`
+	Mode        []CompositionAttesterMode `json:"mode"`
+	ModeElement []*common.Element         `json:"_mode,omitempty"`
 
 	// Who attested the composition
 	Party *common.Reference `json:"party,omitempty"`
 
 	// When composition attested
-	Time *string `json:"time,omitempty"` // R2 uses string
+	Time        *common.DateTime `json:"time,omitempty"` // R2 uses string
+	TimeElement *common.Element  `json:"_time,omitempty"`
 }
 
 // CompositionEvent represents the clinical service being documented (R2 version)
@@ -1579,7 +1761,8 @@ type CompositionSection struct {
 	Entry []common.Reference `json:"entry,omitempty"`
 
 	// working | snapshot | changes
-	Mode *CompositionSectionMode `json:"mode,omitempty"`
+	Mode        *CompositionSectionMode `json:"mode,omitempty"`
+	ModeElement *common.Element         `json:"_mode,omitempty"`
 
 	// Order of section entries
 	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
@@ -1591,7 +1774,8 @@ type CompositionSection struct {
 	Text *Narrative `json:"text,omitempty"`
 
 	// Label for section (e.g. for ToC)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // Composition-related enums (R2 version)
@@ -1641,13 +1825,15 @@ type DocumentReference struct {
 	Context *DocumentReferenceContext `json:"context,omitempty"`
 
 	// When this document reference was created
-	Created *string `json:"created,omitempty"` // R2 uses string
+	Created        *common.DateTime `json:"created,omitempty"` // R2 uses string
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// Organization which maintains the document
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// Human-readable description (title)
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Document identifier and version
 	DocStatus *common.CodeableConcept `json:"docStatus,omitempty"` // R2 has this field
@@ -1656,13 +1842,15 @@ type DocumentReference struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// When this document reference was indexed
-	Indexed string `json:"indexed"` // R2 uses string
+	Indexed        common.Instant  `json:"indexed"` // R2 uses string
+	IndexedElement *common.Element `json:"_indexed,omitempty"`
 
 	// Document security-tags
 	SecurityLabel []common.CodeableConcept `json:"securityLabel,omitempty"`
 
 	// current | superseded | entered-in-error
-	Status DocumentReferenceStatus `json:"status"`
+	Status        DocumentReferenceStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Who/what is the subject of the document
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1736,7 +1924,8 @@ type Device struct {
 	Contact []ContactPoint `json:"contact,omitempty"`
 
 	// Date and time of expiry of this device (if applicable)
-	Expiry *string `json:"expiry,omitempty"` // R2 uses string
+	Expiry        *common.DateTime `json:"expiry,omitempty"` // R2 uses string
+	ExpiryElement *common.Element  `json:"_expiry,omitempty"`
 
 	// Instance identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -1745,16 +1934,20 @@ type Device struct {
 	Location *common.Reference `json:"location,omitempty"`
 
 	// Lot number of manufacture
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// Date when the device was made
-	ManufactureDate *string `json:"manufactureDate,omitempty"` // R2 uses string
+	ManufactureDate        *common.DateTime `json:"manufactureDate,omitempty"` // R2 uses string
+	ManufactureDateElement *common.Element  `json:"_manufactureDate,omitempty"`
 
 	// Name of device manufacturer
-	Manufacturer *string `json:"manufacturer,omitempty"`
+	Manufacturer        *string         `json:"manufacturer,omitempty"`
+	ManufacturerElement *common.Element `json:"_manufacturer,omitempty"`
 
 	// Model id assigned by the manufacturer
-	Model *string `json:"model,omitempty"`
+	Model        *string         `json:"model,omitempty"`
+	ModelElement *common.Element `json:"_model,omitempty"`
 
 	// Device notes and comments
 	Note []Annotation `json:"note,omitempty"`
@@ -1766,19 +1959,23 @@ type Device struct {
 	Patient *common.Reference `json:"patient,omitempty"`
 
 	// available | not-available | entered-in-error
-	Status *DeviceStatus `json:"status,omitempty"`
+	Status        *DeviceStatus   `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The kind or type of device
 	Type *common.CodeableConcept `json:"type"`
 
 	// FDA mandated Unique Device Identifier
-	Udi *string `json:"udi,omitempty"` // R2 uses simple string
+	Udi        *string         `json:"udi,omitempty"` // R2 uses simple string
+	UdiElement *common.Element `json:"_udi,omitempty"`
 
 	// Network address to contact device
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// Version number (i.e. software)
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // Device-related enums (R2 version)
@@ -1799,6 +1996,7 @@ type DosageInstruction struct {
 
 	// Take "as needed" (for x)
 	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
 	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
 
 	// Amount of medication per dose
@@ -1822,7 +2020,8 @@ type DosageInstruction struct {
 	SiteReference       *common.Reference       `json:"siteReference,omitempty"`
 
 	// Free text dosage instructions e.g. SIG
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// When medication should be administered
 	Timing *Timing `json:"timing,omitempty"`
@@ -1836,7 +2035,8 @@ type Timing struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// When the event is to occur
-	Event []string `json:"event,omitempty"` // R2 uses string array
+	Event        []common.DateTime `json:"event,omitempty"` // R2 uses string array
+	EventElement []*common.Element `json:"_event,omitempty"`
 
 	// When the event is to occur
 	Repeat *TimingRepeat `json:"repeat,omitempty"`
@@ -1851,28 +2051,36 @@ type TimingRepeat struct {
 	BoundsQuantity *common.Quantity `json:"boundsQuantity,omitempty"` // R2 uses Quantity for duration
 
 	// Number of times to repeat
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// How long when it happens
-	Duration *float64 `json:"duration,omitempty"`
+	Duration        *common.Decimal `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	DurationUnits *TimingRepeatDurationUnits `json:"durationUnits,omitempty"`
+	DurationUnits        *TimingRepeatDurationUnits `json:"durationUnits,omitempty"`
+	DurationUnitsElement *common.Element            `json:"_durationUnits,omitempty"`
 
 	// Event occurs frequency times per period
-	Frequency *int `json:"frequency,omitempty"`
+	Frequency        *int            `json:"frequency,omitempty"`
+	FrequencyElement *common.Element `json:"_frequency,omitempty"`
 
 	// Event occurs up to frequencyMax times per period
-	FrequencyMax *int `json:"frequencyMax,omitempty"`
+	FrequencyMax        *int            `json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *common.Element `json:"_frequencyMax,omitempty"`
 
 	// Event occurs frequency times per period
-	Period *float64 `json:"period,omitempty"`
+	Period        *common.Decimal `json:"period,omitempty"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	PeriodUnits *TimingRepeatPeriodUnits `json:"periodUnits,omitempty"`
+	PeriodUnits        *TimingRepeatPeriodUnits `json:"periodUnits,omitempty"`
+	PeriodUnitsElement *common.Element          `json:"_periodUnits,omitempty"`
 
 	// Regular life events the event is tied to
-	When *TimingRepeatWhen `json:"when,omitempty"` // R2 uses single value
+	When        *TimingRepeatWhen `json:"when,omitempty"` // R2 uses single value
+	WhenElement *common.Element   `json:"_when,omitempty"`
 }
 
 // Timing-related enums (R2 version)
@@ -1924,25 +2132,31 @@ type SampledData struct {
 	common.Element
 
 	// Decimal values with spaces, or "E" | "U" | "L"
-	Data string `json:"data"`
+	Data        string          `json:"data"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// Number of sample points at each time point
-	Dimensions int `json:"dimensions"`
+	Dimensions        int             `json:"dimensions"`
+	DimensionsElement *common.Element `json:"_dimensions,omitempty"`
 
 	// Multiply data by this before adding to origin
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Lower limit of detection
-	LowerLimit *float64 `json:"lowerLimit,omitempty"`
+	LowerLimit        *common.Decimal `json:"lowerLimit,omitempty"`
+	LowerLimitElement *common.Element `json:"_lowerLimit,omitempty"`
 
 	// Zero value and units
 	Origin *common.Quantity `json:"origin"`
 
 	// Number of milliseconds between samples
-	Period float64 `json:"period"`
+	Period        common.Decimal  `json:"period"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// Upper limit of detection
-	UpperLimit *float64 `json:"upperLimit,omitempty"`
+	UpperLimit        *common.Decimal `json:"upperLimit,omitempty"`
+	UpperLimitElement *common.Element `json:"_upperLimit,omitempty"`
 }
 
 // Narrative represents a human-readable formatted text, including images (R2 version)
@@ -1950,7 +2164,8 @@ type Narrative struct {
 	common.Element
 
 	// generated | extensions | additional | empty
-	Status NarrativeStatus `json:"status"`
+	Status        NarrativeStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Limited xhtml content
 	Div string `json:"div"`
@@ -1971,20 +2186,24 @@ type Signature struct {
 	common.Element
 
 	// The actual signature content (XML DigSig, JWT, picture, etc.)
-	Blob *string `json:"blob,omitempty"`
+	Blob        *string         `json:"blob,omitempty"`
+	BlobElement *common.Element `json:"_blob,omitempty"`
 
 	// The technical format of the signature
-	ContentType string `json:"contentType"`
+	ContentType        string          `json:"contentType"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// Indication of the reason the entity signed the object(s)
 	Type []common.Coding `json:"type"`
 
 	// Who signed
-	WhoURI       *string           `json:"whoUri,omitempty"`
-	WhoReference *common.Reference `json:"whoReference,omitempty"`
+	WhoURI        *string           `json:"whoUri,omitempty"`
+	WhoURIElement *common.Element   `json:"_whoUri,omitempty"`
+	WhoReference  *common.Reference `json:"whoReference,omitempty"`
 
 	// When the signature was created
-	When string `json:"when"` // R2 uses string
+	When        common.Instant  `json:"when"` // R2 uses string
+	WhenElement *common.Element `json:"_when,omitempty"`
 }
 
 // AllergyIntolerance represents risk of harmful or undesirable, physiological response to a substance (R2)
@@ -1998,7 +2217,8 @@ type AllergyIntolerance struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Date record was believed accurate
-	RecordedDate *string `json:"recordedDate,omitempty"` // R2 uses string
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"` // R2 uses string
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Who recorded the sensitivity
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -2010,19 +2230,24 @@ type AllergyIntolerance struct {
 	Substance *common.CodeableConcept `json:"substance"`
 
 	// active | unconfirmed | confirmed | inactive | resolved | refuted | entered-in-error
-	Status AllergyIntoleranceStatus `json:"status"`
+	Status        AllergyIntoleranceStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 
 	// CRITL | CRITH | CRITU (R2 has simpler criticality)
-	Criticality *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	Criticality        *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	CriticalityElement *common.Element                `json:"_criticality,omitempty"`
 
 	// allergy | intolerance | adverse reaction (R2 categories)
-	Type AllergyIntoleranceType `json:"type"`
+	Type        AllergyIntoleranceType `json:"type"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
 
 	// food | medication | environment | other (R2 simpler categories)
-	Category AllergyIntoleranceCategory `json:"category"`
+	Category        AllergyIntoleranceCategory `json:"category"`
+	CategoryElement *common.Element            `json:"_category,omitempty"`
 
 	// Date(/time) of last known occurrence of a reaction
-	LastOccurenceDate *string `json:"lastOccurenceDate,omitempty"` // R2 uses string
+	LastOccurenceDate        *common.DateTime `json:"lastOccurenceDate,omitempty"` // R2 uses string
+	LastOccurenceDateElement *common.Element  `json:"_lastOccurenceDate,omitempty"`
 
 	// Additional information about the Allergy or Intolerance
 	Note *Annotation `json:"note,omitempty"` // R2 uses single Annotation
@@ -2083,13 +2308,16 @@ type AllergyIntoleranceReaction struct {
 	Manifestation []common.CodeableConcept `json:"manifestation"`
 
 	// Description of the event as a whole
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Date(/time) when manifestations showed
-	Onset *string `json:"onset,omitempty"` // R2 uses string
+	Onset        *common.DateTime `json:"onset,omitempty"` // R2 uses string
+	OnsetElement *common.Element  `json:"_onset,omitempty"`
 
 	// mild | moderate | severe (of event as a whole)
-	Severity *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	Severity        *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
 
 	// How the subject was exposed to the substance
 	ExposureRoute *common.CodeableConcept `json:"exposureRoute,omitempty"`
@@ -2118,7 +2346,8 @@ type Immunization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Vaccine administration date
-	Date string `json:"date"` // R2 uses string and is required
+	Date        common.DateTime `json:"date"` // R2 uses string and is required
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Vaccine that was administered or was to be administered
 	VaccineCode common.CodeableConcept `json:"vaccineCode"`
@@ -2127,10 +2356,12 @@ type Immunization struct {
 	Patient common.Reference `json:"patient"`
 
 	// Flag for whether immunization was given
-	WasNotGiven bool `json:"wasNotGiven"`
+	WasNotGiven        bool            `json:"wasNotGiven"`
+	WasNotGivenElement *common.Element `json:"_wasNotGiven,omitempty"`
 
 	// True if this administration was reported rather than directly administered
-	Reported bool `json:"reported"`
+	Reported        bool            `json:"reported"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
 
 	// Who performed the vaccination
 	Performer *common.Reference `json:"performer,omitempty"`
@@ -2148,10 +2379,12 @@ type Immunization struct {
 	Location *common.Reference `json:"location,omitempty"`
 
 	// Vaccine lot number
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// Vaccine expiration date
-	ExpirationDate *string `json:"expirationDate,omitempty"` // R2 uses string
+	ExpirationDate        *common.Date    `json:"expirationDate,omitempty"` // R2 uses string
+	ExpirationDateElement *common.Element `json:"_expirationDate,omitempty"`
 
 	// Body site vaccine was administered
 	Site *common.CodeableConcept `json:"site,omitempty"`
@@ -2191,13 +2424,15 @@ type ImmunizationReaction struct {
 	common.BackboneElement
 
 	// When reaction started
-	Date *string `json:"date,omitempty"` // R2 uses string
+	Date        *common.DateTime `json:"date,omitempty"` // R2 uses string
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Additional information on reaction
 	Detail *common.Reference `json:"detail,omitempty"`
 
 	// Indicates self-reported reaction
-	Reported *bool `json:"reported,omitempty"`
+	Reported        *bool           `json:"reported,omitempty"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
 }
 
 // ImmunizationVaccinationProtocol represents protocol followed by the provider (R2)
@@ -2205,19 +2440,23 @@ type ImmunizationVaccinationProtocol struct {
 	common.BackboneElement
 
 	// Dose number within series
-	DoseSequence int `json:"doseSequence"`
+	DoseSequence        int             `json:"doseSequence"`
+	DoseSequenceElement *common.Element `json:"_doseSequence,omitempty"`
 
 	// Details of the series
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Who is responsible for publishing the recommendations
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// Name of vaccine series
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// Recommended number of doses for immunity
-	SeriesDoses *int `json:"seriesDoses,omitempty"`
+	SeriesDoses        *int            `json:"seriesDoses,omitempty"`
+	SeriesDosesElement *common.Element `json:"_seriesDoses,omitempty"`
 
 	// Disease immunized against
 	DoseTarget []common.CodeableConcept `json:"doseTarget"` // R2 uses doseTarget instead
@@ -2243,13 +2482,15 @@ type CarePlan struct {
 	Patient *common.Reference `json:"patient,omitempty"` // R2 uses patient
 
 	// planned | active | completed | cancelled
-	Status CarePlanStatus `json:"status"`
+	Status        CarePlanStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Time period plan covers
 	Period *common.Period `json:"period,omitempty"`
 
 	// When last updated
-	Modified *string `json:"modified,omitempty"` // R2 uses string
+	Modified        *common.DateTime `json:"modified,omitempty"` // R2 uses string
+	ModifiedElement *common.Element  `json:"_modified,omitempty"`
 
 	// Who is responsible for contents of the care plan
 	Author []common.Reference `json:"author,omitempty"`
@@ -2296,10 +2537,12 @@ type CarePlanGoal struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Human-readable description of a specific desired objective
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// in-progress | achieved | sustaining | cancelled | accepted | rejected
-	Status *CarePlanGoalStatus `json:"status,omitempty"`
+	Status        *CarePlanGoalStatus `json:"status,omitempty"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// Comments about the goal
 	Note []Annotation `json:"note,omitempty"`
@@ -2328,13 +2571,16 @@ type CarePlanActivity struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Goals that this activity relates to
-	Goal []string `json:"goal,omitempty"` // R2 uses string array, not references
+	Goal        []string          `json:"goal,omitempty"` // R2 uses string array, not references
+	GoalElement []*common.Element `json:"_goal,omitempty"`
 
 	// in-progress | completed | aborted | cancelled
-	Status *CarePlanActivityStatus `json:"status,omitempty"`
+	Status        *CarePlanActivityStatus `json:"status,omitempty"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Do NOT do
-	Prohibited bool `json:"prohibited"`
+	Prohibited        bool            `json:"prohibited"`
+	ProhibitedElement *common.Element `json:"_prohibited,omitempty"`
 
 	// Appointments, orders, etc.
 	ActionResulting []common.Reference `json:"actionResulting,omitempty"`
@@ -2366,7 +2612,8 @@ type CarePlanActivityDetail struct {
 	common.BackboneElement
 
 	// diet | drug | encounter | observation | procedure | supply | other
-	Category CarePlanActivityCategory `json:"category"`
+	Category        CarePlanActivityCategory `json:"category"`
+	CategoryElement *common.Element          `json:"_category,omitempty"`
 
 	// Detail type of activity
 	Code *common.CodeableConcept `json:"code,omitempty"`
@@ -2381,18 +2628,21 @@ type CarePlanActivityDetail struct {
 	Goal []common.Reference `json:"goal,omitempty"`
 
 	// not-started | scheduled | in-progress | on-hold | completed | cancelled | stopped
-	Status *CarePlanActivityStatus `json:"status,omitempty"`
+	Status        *CarePlanActivityStatus `json:"status,omitempty"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
 
 	// Do NOT do
-	Prohibited bool `json:"prohibited"`
+	Prohibited        bool            `json:"prohibited"`
+	ProhibitedElement *common.Element `json:"_prohibited,omitempty"`
 
 	// When activity is to occur
-	ScheduledTiming *Timing        `json:"scheduledTiming,omitempty"`
-	ScheduledPeriod *common.Period `json:"scheduledPeriod,omitempty"`
-	ScheduledString *string        `json:"scheduledString,omitempty"`
+	ScheduledTiming        *Timing         `json:"scheduledTiming,omitempty"`
+	ScheduledPeriod        *common.Period  `json:"scheduledPeriod,omitempty"`
+	ScheduledString        *string         `json:"scheduledString,omitempty"`
+	ScheduledStringElement *common.Element `json:"_scheduledString,omitempty"`
 
 	// Where it should happen
 	Location *common.Reference `json:"location,omitempty"`
@@ -2410,7 +2660,8 @@ type CarePlanActivityDetail struct {
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// Extra info describing activity to perform
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 }
 
 // CarePlanActivityCategory represents the category (R2)
@@ -2449,7 +2700,8 @@ type Specimen struct {
 	AccessionIdentifier []common.Identifier `json:"accessionIdentifier,omitempty"` // R2 uses array
 
 	// Time when specimen was received for processing
-	ReceivedTime *string `json:"receivedTime,omitempty"` // R2 uses string
+	ReceivedTime        *common.DateTime `json:"receivedTime,omitempty"` // R2 uses string
+	ReceivedTimeElement *common.Element  `json:"_receivedTime,omitempty"`
 
 	// Collection details
 	Collection *SpecimenCollection `json:"collection,omitempty"`
@@ -2469,11 +2721,13 @@ type SpecimenCollection struct {
 	Collector *common.Reference `json:"collector,omitempty"`
 
 	// Collector comments
-	Comment []string `json:"comment,omitempty"` // R2 uses string array
+	Comment        []string          `json:"comment,omitempty"` // R2 uses string array
+	CommentElement []*common.Element `json:"_comment,omitempty"`
 
 	// Collection time
-	CollectedDateTime *string        `json:"collectedDateTime,omitempty"` // R2 uses string
-	CollectedPeriod   *common.Period `json:"collectedPeriod,omitempty"`
+	CollectedDateTime        *common.DateTime `json:"collectedDateTime,omitempty"` // R2 uses string
+	CollectedDateTimeElement *common.Element  `json:"_collectedDateTime,omitempty"`
+	CollectedPeriod          *common.Period   `json:"collectedPeriod,omitempty"`
 
 	// How much was collected
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -2491,7 +2745,8 @@ type SpecimenTreatment struct {
 	common.BackboneElement
 
 	// Textual description of procedure
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Indicates the treatment or processing step applied to the specimen
 	Procedure *common.CodeableConcept `json:"procedure,omitempty"`
@@ -2508,7 +2763,8 @@ type SpecimenContainer struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Textual description of the container
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Kind of container directly associated with specimen
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -2522,3 +2778,23 @@ type SpecimenContainer struct {
 	// Additive associated with container
 	Additive *common.Reference `json:"additive,omitempty"` // R2 uses single reference
 }
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (e *BundleEntry) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntry
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	e.Resource = resource
+	return nil
+}
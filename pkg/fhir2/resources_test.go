@@ -0,0 +1,68 @@
+package fhir2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+func TestExamples_RoundTripSerialization(t *testing.T) {
+	files, err := filepath.Glob("testdata/fhir2-json/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		resource, err := UnmarshalResource(data)
+		if err != nil {
+			t.Errorf("failed to unmarshal %s: %v", file, err)
+			continue
+		}
+		if _, ok := resource.(*common.RawResource); ok {
+			t.Errorf("expected a typed resource for %s, got %T", file, resource)
+			continue
+		}
+		empty, _ := NewResource(resource.GetResourceType())
+		test_utils.CompareRoundTripSerialization(t, data, empty, file)
+	}
+}
+
+func TestUnmarshalResource_Parameters(t *testing.T) {
+	data, err := os.ReadFile("testdata/fhir2-json/parameters-example.json")
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+	resource, err := UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("failed to unmarshal parameters: %v", err)
+	}
+	parameters, ok := resource.(*Parameters)
+	if !ok {
+		t.Fatalf("expected *Parameters, got %T", resource)
+	}
+	last := parameters.Parameter[len(parameters.Parameter)-1]
+	if _, ok := last.Resource.(*Order); !ok {
+		t.Errorf("expected the last parameter to hold a *Order, got %T", last.Resource)
+	}
+}
+
+func TestNewResource_GeneratedResources(t *testing.T) {
+	for _, resourceType := range []string{
+		"Flag", "Order", "DiagnosticOrder", "Appointment", "Conformance", "ImagingObjectSelection", "DeviceUseRequest", "OrderResponse",
+	} {
+		resource, ok := NewResource(resourceType)
+		if !ok {
+			t.Errorf("expected %s to be known", resourceType)
+			continue
+		}
+		if resource.GetResourceType() != resourceType {
+			t.Errorf("expected resource type %s, got %s", resourceType, resource.GetResourceType())
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RiskAssessment represents potential outcomes for a subject with likelihood
+type RiskAssessment struct {
+	DomainResource
+
+	// Information used in assessment
+	Basis []common.Reference `json:"basis,omitempty"`
+
+	// Condition assessed
+	Condition *common.Reference `json:"condition,omitempty"`
+
+	// The assessment results lose validity the more time elapses from when they are first made
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Where was assessment performed?
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Unique identifier for the assessment
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Evaluation mechanism
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// How to reduce risk
+	Mitigation        *string         `json:"mitigation,omitempty"`
+	MitigationElement *common.Element `json:"_mitigation,omitempty"`
+
+	// Who did assessment?
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Outcome predicted
+	Prediction []RiskAssessmentPrediction `json:"prediction,omitempty"`
+
+	// Who/what does assessment apply to?
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// RiskAssessmentPrediction represents outcome predicted
+type RiskAssessmentPrediction struct {
+	common.BackboneElement
+
+	// Possible outcome for the subject
+	Outcome *common.CodeableConcept `json:"outcome"`
+
+	// Likelihood of specified outcome
+	ProbabilityDecimal         *common.Decimal         `json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement  *common.Element         `json:"_probabilityDecimal,omitempty"`
+	ProbabilityRange           *Range                  `json:"probabilityRange,omitempty"`
+	ProbabilityCodeableConcept *common.CodeableConcept `json:"probabilityCodeableConcept,omitempty"`
+
+	// Explanation of prediction
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
+
+	// Relative likelihood
+	RelativeRisk        *common.Decimal `json:"relativeRisk,omitempty"`
+	RelativeRiskElement *common.Element `json:"_relativeRisk,omitempty"`
+
+	// Timeframe or age range
+	WhenPeriod *common.Period `json:"whenPeriod,omitempty"`
+	WhenRange  *Range         `json:"whenRange,omitempty"`
+}
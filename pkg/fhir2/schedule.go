@@ -0,0 +1,26 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Schedule represents a container for slots of time that may be available for booking appointments
+type Schedule struct {
+	DomainResource
+
+	// Resource(s) that availability information is being provided for
+	Actor *common.Reference `json:"actor"`
+
+	// Comments on availability
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Period of time covered by schedule
+	PlanningHorizon *common.Period `json:"planningHorizon,omitempty"`
+
+	// The schedule type can be used for the categorization of healthcare services or other appointment types
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
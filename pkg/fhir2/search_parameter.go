@@ -0,0 +1,115 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SearchParameter represents search parameter for a resource
+type SearchParameter struct {
+	DomainResource
+
+	// The resource type(s) this search parameter applies to
+	Base        string          `json:"base"`
+	BaseElement *common.Element `json:"_base,omitempty"`
+
+	// Recommended name for parameter in search url
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Contact details for the publisher
+	Contact []SearchParameterContact `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the search parameter
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Name for this search parameter (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// The Scope and Usage that this search parameter was created to meet
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        *SearchParameterStatus `json:"status,omitempty"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// Types of resource (if a resource reference)
+	Target        []string          `json:"target,omitempty"`
+	TargetElement []*common.Element `json:"_target,omitempty"`
+
+	// number | date | string | token | reference | composite | quantity | uri | special
+	Type        SearchParameterType `json:"type"`
+	TypeElement *common.Element     `json:"_type,omitempty"`
+
+	// Canonical identifier for this search parameter, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// An XPath expression that returns a set of elements for the search parameter
+	Xpath        *string         `json:"xpath,omitempty"`
+	XpathElement *common.Element `json:"_xpath,omitempty"`
+
+	// How the search parameter relates to the set of elements returned by evaluating the xpath query
+	XpathUsage        *SearchParameterXpathUsage `json:"xpathUsage,omitempty"`
+	XpathUsageElement *common.Element            `json:"_xpathUsage,omitempty"`
+}
+
+// SearchParameterStatus represents the status of a search parameter
+type SearchParameterStatus string
+
+const (
+	SearchParameterStatusDraft   SearchParameterStatus = "draft"
+	SearchParameterStatusActive  SearchParameterStatus = "active"
+	SearchParameterStatusRetired SearchParameterStatus = "retired"
+)
+
+// SearchParameterType represents the type of a search parameter
+type SearchParameterType string
+
+const (
+	SearchParameterTypeNumber    SearchParameterType = "number"
+	SearchParameterTypeDate      SearchParameterType = "date"
+	SearchParameterTypeString    SearchParameterType = "string"
+	SearchParameterTypeToken     SearchParameterType = "token"
+	SearchParameterTypeReference SearchParameterType = "reference"
+	SearchParameterTypeComposite SearchParameterType = "composite"
+	SearchParameterTypeQuantity  SearchParameterType = "quantity"
+	SearchParameterTypeUri       SearchParameterType = "uri"
+)
+
+// SearchParameterXpathUsage represents the xpath usage of a search parameter
+type SearchParameterXpathUsage string
+
+const (
+	SearchParameterXpathUsageNormal   SearchParameterXpathUsage = "normal"
+	SearchParameterXpathUsagePhonetic SearchParameterXpathUsage = "phonetic"
+	SearchParameterXpathUsageNearby   SearchParameterXpathUsage = "nearby"
+	SearchParameterXpathUsageDistance SearchParameterXpathUsage = "distance"
+	SearchParameterXpathUsageOther    SearchParameterXpathUsage = "other"
+)
+
+// SearchParameterContact represents contacts to assist a user in finding and communicating with the publisher
+type SearchParameterContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the search parameter
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
@@ -0,0 +1,49 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Slot represents a slot of time on a schedule that may be available for booking appointments
+type Slot struct {
+	DomainResource
+
+	// Comments on the slot to describe any extended information. Such as custom constraints on the slot
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Date/Time that the slot is to conclude
+	End        common.Instant  `json:"end"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// busy | free | busy-unavailable | busy-tentative
+	FreeBusyType        SlotFreeBusyType `json:"freeBusyType"`
+	FreeBusyTypeElement *common.Element  `json:"_freeBusyType,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// This slot has already been overbooked, appointments are unlikely to be accepted for this time
+	Overbooked        *bool           `json:"overbooked,omitempty"`
+	OverbookedElement *common.Element `json:"_overbooked,omitempty"`
+
+	// The schedule resource that this slot defines an interval of status information
+	Schedule *common.Reference `json:"schedule"`
+
+	// Date/Time that the slot is to begin
+	Start        common.Instant  `json:"start"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// The type of appointments that can be booked into this slot
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SlotFreeBusyType represents the free busy type of a slot
+type SlotFreeBusyType string
+
+const (
+	SlotFreeBusyTypeBusy            SlotFreeBusyType = "busy"
+	SlotFreeBusyTypeFree            SlotFreeBusyType = "free"
+	SlotFreeBusyTypeBusyUnavailable SlotFreeBusyType = "busy-unavailable"
+	SlotFreeBusyTypeBusyTentative   SlotFreeBusyType = "busy-tentative"
+)
@@ -0,0 +1,180 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// StructureDefinition represents Structural Definition
+type StructureDefinition struct {
+	DomainResource
+
+	// Whether the structure is abstract
+	Abstract        bool            `json:"abstract"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
+
+	// An absolute URI that is the base structure from which this set of constraints is derived
+	Base        *string         `json:"base,omitempty"`
+	BaseElement *common.Element `json:"_base,omitempty"`
+
+	// Assist in searching for appropriate StructureDefinitions
+	Code []common.Coding `json:"code,omitempty"`
+
+	// The type of type that is being constrained - a data type, an extension, a resource, including abstract ones
+	ConstrainedType        *string         `json:"constrainedType,omitempty"`
+	ConstrainedTypeElement *common.Element `json:"_constrainedType,omitempty"`
+
+	// Contact details for the publisher
+	Contact []StructureDefinitionContact `json:"contact,omitempty"`
+
+	// If an extension, where it can be used in instances
+	Context        []string          `json:"context,omitempty"`
+	ContextElement []*common.Element `json:"_context,omitempty"`
+
+	// If this is an extension, Identifies the context within FHIR resources where the extension can be used
+	ContextType        *StructureDefinitionContextType `json:"contextType,omitempty"`
+	ContextTypeElement *common.Element                 `json:"_contextType,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the structure definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Differential view of the structure
+	Differential *StructureDefinitionDifferential `json:"differential,omitempty"`
+
+	// Defined so that applications can use this name when displaying the value of the extension to the user
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// FHIR Version this StructureDefinition targets
+	FhirVersion        *string         `json:"fhirVersion,omitempty"`
+	FhirVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// Additional identifier for the structure definition
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// primitive-type | complex-type | resource | logical
+	Kind        StructureDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
+
+	// External specification that the content is mapped to
+	Mapping []StructureDefinitionMapping `json:"mapping,omitempty"`
+
+	// Name for this structure definition (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Explains why this structure definition is needed and why it's been constrained as it has
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// Snapshot view of the structure
+	Snapshot *StructureDefinitionSnapshot `json:"snapshot,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        StructureDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Canonical identifier for this structure definition, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.CodeableConcept `json:"useContext,omitempty"`
+
+	// Business version of the structure definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// StructureDefinitionContextType represents the context type of a structure definition
+type StructureDefinitionContextType string
+
+const (
+	StructureDefinitionContextTypeResource  StructureDefinitionContextType = "resource"
+	StructureDefinitionContextTypeDatatype  StructureDefinitionContextType = "datatype"
+	StructureDefinitionContextTypeMapping   StructureDefinitionContextType = "mapping"
+	StructureDefinitionContextTypeExtension StructureDefinitionContextType = "extension"
+)
+
+// StructureDefinitionKind represents the kind of a structure definition
+type StructureDefinitionKind string
+
+const (
+	StructureDefinitionKindDatatype StructureDefinitionKind = "datatype"
+	StructureDefinitionKindResource StructureDefinitionKind = "resource"
+	StructureDefinitionKindLogical  StructureDefinitionKind = "logical"
+)
+
+// StructureDefinitionStatus represents the status of a structure definition
+type StructureDefinitionStatus string
+
+const (
+	StructureDefinitionStatusDraft   StructureDefinitionStatus = "draft"
+	StructureDefinitionStatusActive  StructureDefinitionStatus = "active"
+	StructureDefinitionStatusRetired StructureDefinitionStatus = "retired"
+)
+
+// StructureDefinitionContact represents contacts to assist a user in finding and communicating with the publisher
+type StructureDefinitionContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the structure definition
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// StructureDefinitionMapping represents external specification that the content is mapped to
+type StructureDefinitionMapping struct {
+	common.BackboneElement
+
+	// Comments about this mapping, including version notes, issues, scope limitations, and other important notes for usage
+	Comments        *string         `json:"comments,omitempty"`
+	CommentsElement *common.Element `json:"_comments,omitempty"`
+
+	// Internal id when this mapping is used
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
+
+	// Names what this mapping refers to
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Identifies what this mapping refers to
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
+}
+
+// StructureDefinitionSnapshot represents snapshot view of the structure
+type StructureDefinitionSnapshot struct {
+	common.BackboneElement
+
+	// Definition of elements in the resource (if no StructureDefinition)
+	Element []ElementDefinition `json:"element"`
+}
+
+// StructureDefinitionDifferential represents differential view of the structure
+type StructureDefinitionDifferential struct {
+	common.BackboneElement
+
+	// Definition of elements in the resource (if no StructureDefinition)
+	Element []ElementDefinition `json:"element"`
+}
@@ -0,0 +1,54 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SupplyDelivery represents delivery of bulk Supplies
+type SupplyDelivery struct {
+	DomainResource
+
+	// Where the delivery was sent
+	Destination *common.Reference `json:"destination,omitempty"`
+
+	// External identifier
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Patient for whom the item is supplied
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// The amount of supply that has been dispensed
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Who received the delivery
+	Receiver []common.Reference `json:"receiver,omitempty"`
+
+	// in-progress | completed | abandoned | entered-in-error
+	Status        *SupplyDeliveryStatus `json:"status,omitempty"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// The item that is delivered or supplied
+	SuppliedItem *common.Reference `json:"suppliedItem,omitempty"`
+
+	// The item supplier
+	Supplier *common.Reference `json:"supplier,omitempty"`
+
+	// The time the dispensed item was sent or handed to the patient (or agent)
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
+
+	// Category of supply event
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// The time the dispense event occurred
+	WhenPrepared *common.Period `json:"whenPrepared,omitempty"`
+}
+
+// SupplyDeliveryStatus represents the status of a supply delivery
+type SupplyDeliveryStatus string
+
+const (
+	SupplyDeliveryStatusInProgress SupplyDeliveryStatus = "in-progress"
+	SupplyDeliveryStatusCompleted  SupplyDeliveryStatus = "completed"
+	SupplyDeliveryStatusAbandoned  SupplyDeliveryStatus = "abandoned"
+)
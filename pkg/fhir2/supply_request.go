@@ -0,0 +1,64 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SupplyRequest represents request for a medication, substance or device
+type SupplyRequest struct {
+	DomainResource
+
+	// When the request was made
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Business Identifier for SupplyRequest
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Category of supply, e.g. central, non-stock, etc. This is used to support work flows associated with the supply process
+	Kind *common.CodeableConcept `json:"kind,omitempty"`
+
+	// The item that is requested to be supplied
+	OrderedItem *common.Reference `json:"orderedItem,omitempty"`
+
+	// A link to a resource representing the person whom the ordered item is for
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Why the supply item was requested
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// The Practitioner , Organization or Patient who initiated this order for the supply
+	Source *common.Reference `json:"source,omitempty"`
+
+	// draft | active | suspended +
+	Status        *SupplyRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Who is intended to fulfill the request
+	Supplier []common.Reference `json:"supplier,omitempty"`
+
+	// When the request should be fulfilled
+	When *SupplyRequestWhen `json:"when,omitempty"`
+}
+
+// SupplyRequestStatus represents the status of a supply request
+type SupplyRequestStatus string
+
+const (
+	SupplyRequestStatusRequested SupplyRequestStatus = "requested"
+	SupplyRequestStatusCompleted SupplyRequestStatus = "completed"
+	SupplyRequestStatusFailed    SupplyRequestStatus = "failed"
+	SupplyRequestStatusCancelled SupplyRequestStatus = "cancelled"
+)
+
+// SupplyRequestWhen represents when the request should be fulfilled
+type SupplyRequestWhen struct {
+	common.BackboneElement
+
+	// Code indicating when the request should be fulfilled
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Formal fulfillment schedule
+	Schedule *Timing `json:"schedule,omitempty"`
+}
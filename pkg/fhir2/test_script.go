@@ -0,0 +1,477 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// TestScript describes a set of tests
+type TestScript struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []TestScriptContact `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the test script
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Fixture in the test script - by reference (uri)
+	Fixture []TestScriptFixture `json:"fixture,omitempty"`
+
+	// Additional identifier for the test script
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Required capability that is assumed to function correctly on the FHIR server being tested
+	Metadata *TestScriptMetadata `json:"metadata,omitempty"`
+
+	// If the tests apply to more than one FHIR server (e.g. cross-server interoperability tests) then multiserver=true
+	Multiserver        *bool           `json:"multiserver,omitempty"`
+	MultiserverElement *common.Element `json:"_multiserver,omitempty"`
+
+	// Name for this test script (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Reference of the validation profile
+	Profile []common.Reference `json:"profile,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Explains why this Test Script is needed and why it's been constrained as it has
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// A series of required setup operations before tests are executed
+	Setup *TestScriptSetup `json:"setup,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        TestScriptStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// A series of required clean up steps
+	Teardown *TestScriptTeardown `json:"teardown,omitempty"`
+
+	// A test in this script
+	Test []TestScriptTest `json:"test,omitempty"`
+
+	// Canonical identifier for this test script, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.CodeableConcept `json:"useContext,omitempty"`
+
+	// Placeholder for evaluated elements
+	Variable []TestScriptVariable `json:"variable,omitempty"`
+
+	// Business version of the test script
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// TestScriptStatus represents the status of a test script
+type TestScriptStatus string
+
+const (
+	TestScriptStatusDraft   TestScriptStatus = "draft"
+	TestScriptStatusActive  TestScriptStatus = "active"
+	TestScriptStatusRetired TestScriptStatus = "retired"
+)
+
+// TestScriptContact represents contacts to assist a user in finding and communicating with the publisher
+type TestScriptContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the Test Script
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// TestScriptMetadataLink represents links to the FHIR specification
+type TestScriptMetadataLink struct {
+	common.BackboneElement
+
+	// Short description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// URL to the specification
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+}
+
+// TestScriptMetadataCapability represents capabilities  that are assumed to function correctly on the FHIR server being tested
+type TestScriptMetadataCapability struct {
+	common.BackboneElement
+
+	// Minimum conformance required of server for test script to execute successfully
+	Conformance *common.Reference `json:"conformance"`
+
+	// The expected capabilities of the server
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Which server these requirements apply to
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
+
+	// Links to the FHIR specification
+	Link        []string          `json:"link,omitempty"`
+	LinkElement []*common.Element `json:"_link,omitempty"`
+
+	// Are the capabilities required?
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
+
+	// Are the capabilities validated?
+	Validated        *bool           `json:"validated,omitempty"`
+	ValidatedElement *common.Element `json:"_validated,omitempty"`
+}
+
+// TestScriptMetadata represents required capability that is assumed to function correctly on the FHIR server being tested
+type TestScriptMetadata struct {
+	common.BackboneElement
+
+	// Capabilities  that are assumed to function correctly on the FHIR server being tested
+	Capability []TestScriptMetadataCapability `json:"capability"`
+
+	// Links to the FHIR specification
+	Link []TestScriptMetadataLink `json:"link,omitempty"`
+}
+
+// TestScriptFixture represents fixture in the test script - by reference (uri)
+type TestScriptFixture struct {
+	common.BackboneElement
+
+	// Whether or not to implicitly create the fixture during setup
+	Autocreate        *bool           `json:"autocreate,omitempty"`
+	AutocreateElement *common.Element `json:"_autocreate,omitempty"`
+
+	// Whether or not to implicitly delete the fixture during teardown
+	Autodelete        *bool           `json:"autodelete,omitempty"`
+	AutodeleteElement *common.Element `json:"_autodelete,omitempty"`
+
+	// Reference of the resource
+	Resource *common.Reference `json:"resource,omitempty"`
+}
+
+// TestScriptVariable represents placeholder for evaluated elements
+type TestScriptVariable struct {
+	common.BackboneElement
+
+	// HTTP header field name for source
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
+
+	// Descriptive name for this variable
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// XPath or JSONPath against the fixture body
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// Fixture Id of source expression or headerField within this variable
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+}
+
+// TestScriptSetupActionOperationRequestHeader represents each operation can have one or more header elements
+type TestScriptSetupActionOperationRequestHeader struct {
+	common.BackboneElement
+
+	// HTTP header field name
+	Field        string          `json:"field"`
+	FieldElement *common.Element `json:"_field,omitempty"`
+
+	// HTTP headerfield value
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// TestScriptSetupActionOperation represents the operation of a test script setup action
+type TestScriptSetupActionOperation struct {
+	common.BackboneElement
+
+	// Mime type to accept in the payload of the response, with charset etc
+	Accept        *TestScriptSetupActionOperationAccept `json:"accept,omitempty"`
+	AcceptElement *common.Element                       `json:"_accept,omitempty"`
+
+	// Mime type of the request payload contents, with charset etc
+	ContentType        *TestScriptSetupActionOperationContentType `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element                            `json:"_contentType,omitempty"`
+
+	// Tracking/reporting operation description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Server responding to the request
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
+
+	// Whether or not to send the request url in encoded format
+	EncodeRequestUrl        *bool           `json:"encodeRequestUrl,omitempty"`
+	EncodeRequestUrlElement *common.Element `json:"_encodeRequestUrl,omitempty"`
+
+	// Tracking/logging operation label
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Explicitly defined path parameters
+	Params        *string         `json:"params,omitempty"`
+	ParamsElement *common.Element `json:"_params,omitempty"`
+
+	// Each operation can have one or more header elements
+	RequestHeader []TestScriptSetupActionOperationRequestHeader `json:"requestHeader,omitempty"`
+
+	// Resource type
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+
+	// Fixture Id of mapped response
+	ResponseId        *string         `json:"responseId,omitempty"`
+	ResponseIdElement *common.Element `json:"_responseId,omitempty"`
+
+	// Fixture Id of body for PUT and POST requests
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+
+	// Id of fixture used for extracting the [id],  [type], and [vid] for GET requests
+	TargetId        *string         `json:"targetId,omitempty"`
+	TargetIdElement *common.Element `json:"_targetId,omitempty"`
+
+	// The operation code type that will be executed
+	Type *common.Coding `json:"type,omitempty"`
+
+	// Request URL
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+}
+
+// TestScriptSetupActionOperationAccept represents the accept of a test script setup action operation
+type TestScriptSetupActionOperationAccept string
+
+const (
+	TestScriptSetupActionOperationAcceptXml  TestScriptSetupActionOperationAccept = "xml"
+	TestScriptSetupActionOperationAcceptJson TestScriptSetupActionOperationAccept = "json"
+)
+
+// TestScriptSetupActionOperationContentType represents the content type of a test script setup action operation
+type TestScriptSetupActionOperationContentType string
+
+const (
+	TestScriptSetupActionOperationContentTypeXml  TestScriptSetupActionOperationContentType = "xml"
+	TestScriptSetupActionOperationContentTypeJson TestScriptSetupActionOperationContentType = "json"
+)
+
+// TestScriptSetupActionAssert represents the assert of a test script setup action
+type TestScriptSetupActionAssert struct {
+	common.BackboneElement
+
+	// Id of the source fixture to be evaluated
+	CompareToSourceId        *string         `json:"compareToSourceId,omitempty"`
+	CompareToSourceIdElement *common.Element `json:"_compareToSourceId,omitempty"`
+
+	// XPath or JSONPath expression to evaluate against the source fixture
+	CompareToSourcePath        *string         `json:"compareToSourcePath,omitempty"`
+	CompareToSourcePathElement *common.Element `json:"_compareToSourcePath,omitempty"`
+
+	// Mime type to compare against the 'Content-Type' header
+	ContentType        *TestScriptSetupActionAssertContentType `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element                         `json:"_contentType,omitempty"`
+
+	// Tracking/reporting assertion description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// response | request
+	Direction        *TestScriptSetupActionAssertDirection `json:"direction,omitempty"`
+	DirectionElement *common.Element                       `json:"_direction,omitempty"`
+
+	// HTTP header field name
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
+
+	// Tracking/logging assertion label
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Fixture Id of minimum content resource
+	MinimumId        *string         `json:"minimumId,omitempty"`
+	MinimumIdElement *common.Element `json:"_minimumId,omitempty"`
+
+	// Perform validation on navigation links?
+	NavigationLinks        *bool           `json:"navigationLinks,omitempty"`
+	NavigationLinksElement *common.Element `json:"_navigationLinks,omitempty"`
+
+	// equals | notEquals | in | notIn | greaterThan | lessThan | empty | notEmpty | contains | notContains | eval | manualEval
+	Operator        *TestScriptSetupActionAssertOperator `json:"operator,omitempty"`
+	OperatorElement *common.Element                      `json:"_operator,omitempty"`
+
+	// XPath or JSONPath expression
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// Resource type
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+
+	// continue | switchingProtocols | okay | created | accepted | nonAuthoritativeInformation | noContent | resetContent | partialContent | multipleChoices | movedPermanently | found | seeOther | notModified | useProxy | temporaryRedirect | permanentRedirect | badRequest | unauthorized | paymentRequired | forbidden | notFound | methodNotAllowed | notAcceptable | proxyAuthenticationRequired | requestTimeout | conflict | gone | lengthRequired | preconditionFailed | contentTooLarge | uriTooLong | unsupportedMediaType | rangeNotSatisfiable | expectationFailed | misdirectedRequest | unprocessableContent | upgradeRequired | internalServerError | notImplemented | badGateway | serviceUnavailable | gatewayTimeout | httpVersionNotSupported
+	Response        *TestScriptSetupActionAssertResponse `json:"response,omitempty"`
+	ResponseElement *common.Element                      `json:"_response,omitempty"`
+
+	// HTTP response code to test
+	ResponseCode        *string         `json:"responseCode,omitempty"`
+	ResponseCodeElement *common.Element `json:"_responseCode,omitempty"`
+
+	// Fixture Id of source expression or headerField
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+
+	// Profile Id of validation profile reference
+	ValidateProfileId        *string         `json:"validateProfileId,omitempty"`
+	ValidateProfileIdElement *common.Element `json:"_validateProfileId,omitempty"`
+
+	// The value to compare to
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+
+	// Will this assert produce a warning only on error?
+	WarningOnly        *bool           `json:"warningOnly,omitempty"`
+	WarningOnlyElement *common.Element `json:"_warningOnly,omitempty"`
+}
+
+// TestScriptSetupActionAssertContentType represents the content type of a test script setup action assert
+type TestScriptSetupActionAssertContentType string
+
+const (
+	TestScriptSetupActionAssertContentTypeXml  TestScriptSetupActionAssertContentType = "xml"
+	TestScriptSetupActionAssertContentTypeJson TestScriptSetupActionAssertContentType = "json"
+)
+
+// TestScriptSetupActionAssertDirection represents the direction of a test script setup action assert
+type TestScriptSetupActionAssertDirection string
+
+const (
+	TestScriptSetupActionAssertDirectionResponse TestScriptSetupActionAssertDirection = "response"
+	TestScriptSetupActionAssertDirectionRequest  TestScriptSetupActionAssertDirection = "request"
+)
+
+// TestScriptSetupActionAssertOperator represents the operator of a test script setup action assert
+type TestScriptSetupActionAssertOperator string
+
+const (
+	TestScriptSetupActionAssertOperatorEquals      TestScriptSetupActionAssertOperator = "equals"
+	TestScriptSetupActionAssertOperatorNotEquals   TestScriptSetupActionAssertOperator = "notEquals"
+	TestScriptSetupActionAssertOperatorIn          TestScriptSetupActionAssertOperator = "in"
+	TestScriptSetupActionAssertOperatorNotIn       TestScriptSetupActionAssertOperator = "notIn"
+	TestScriptSetupActionAssertOperatorGreaterThan TestScriptSetupActionAssertOperator = "greaterThan"
+	TestScriptSetupActionAssertOperatorLessThan    TestScriptSetupActionAssertOperator = "lessThan"
+	TestScriptSetupActionAssertOperatorEmpty       TestScriptSetupActionAssertOperator = "empty"
+	TestScriptSetupActionAssertOperatorNotEmpty    TestScriptSetupActionAssertOperator = "notEmpty"
+	TestScriptSetupActionAssertOperatorContains    TestScriptSetupActionAssertOperator = "contains"
+	TestScriptSetupActionAssertOperatorNotContains TestScriptSetupActionAssertOperator = "notContains"
+)
+
+// TestScriptSetupActionAssertResponse represents the response of a test script setup action assert
+type TestScriptSetupActionAssertResponse string
+
+const (
+	TestScriptSetupActionAssertResponseOkay               TestScriptSetupActionAssertResponse = "okay"
+	TestScriptSetupActionAssertResponseCreated            TestScriptSetupActionAssertResponse = "created"
+	TestScriptSetupActionAssertResponseNoContent          TestScriptSetupActionAssertResponse = "noContent"
+	TestScriptSetupActionAssertResponseNotModified        TestScriptSetupActionAssertResponse = "notModified"
+	TestScriptSetupActionAssertResponseBad                TestScriptSetupActionAssertResponse = "bad"
+	TestScriptSetupActionAssertResponseForbidden          TestScriptSetupActionAssertResponse = "forbidden"
+	TestScriptSetupActionAssertResponseNotFound           TestScriptSetupActionAssertResponse = "notFound"
+	TestScriptSetupActionAssertResponseMethodNotAllowed   TestScriptSetupActionAssertResponse = "methodNotAllowed"
+	TestScriptSetupActionAssertResponseConflict           TestScriptSetupActionAssertResponse = "conflict"
+	TestScriptSetupActionAssertResponseGone               TestScriptSetupActionAssertResponse = "gone"
+	TestScriptSetupActionAssertResponsePreconditionFailed TestScriptSetupActionAssertResponse = "preconditionFailed"
+	TestScriptSetupActionAssertResponseUnprocessable      TestScriptSetupActionAssertResponse = "unprocessable"
+)
+
+// TestScriptSetupAction represents a setup operation or assert to perform
+type TestScriptSetupAction struct {
+	common.BackboneElement
+
+	// The assertion to perform
+	Assert *TestScriptSetupActionAssert `json:"assert,omitempty"`
+
+	// The setup operation to perform
+	Operation *TestScriptSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestScriptSetup represents a series of required setup operations before tests are executed
+type TestScriptSetup struct {
+	common.BackboneElement
+
+	// A setup operation or assert to perform
+	Action []TestScriptSetupAction `json:"action"`
+
+	// Capabilities that must exist and are assumed to function correctly on the FHIR server being tested
+	Metadata *TestScriptMetadata `json:"metadata,omitempty"`
+}
+
+// TestScriptTestAction represents a test operation or assert to perform
+type TestScriptTestAction struct {
+	common.BackboneElement
+
+	// Evaluates the results of previous operations to determine if the server under test behaves appropriately
+	Assert *TestScriptSetupActionAssert `json:"assert,omitempty"`
+
+	// An operation would involve a REST request to a server
+	Operation *TestScriptSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestScriptTest represents a test in this script
+type TestScriptTest struct {
+	common.BackboneElement
+
+	// A test operation or assert to perform
+	Action []TestScriptTestAction `json:"action"`
+
+	// Tracking/reporting short description of the test
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Capabilities that must exist and are assumed to function correctly on the FHIR server being tested
+	Metadata *TestScriptMetadata `json:"metadata,omitempty"`
+
+	// Tracking/logging name of this test
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// TestScriptTeardownAction represents one or more teardown operations to perform
+type TestScriptTeardownAction struct {
+	common.BackboneElement
+
+	// An operation would involve a REST request to a server
+	Operation *TestScriptSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestScriptTeardown represents a series of required clean up steps
+type TestScriptTeardown struct {
+	common.BackboneElement
+
+	// One or more teardown operations to perform
+	Action []TestScriptTeardownAction `json:"action"`
+}
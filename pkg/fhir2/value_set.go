@@ -0,0 +1,321 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ValueSet represents a set of codes drawn from one or more code systems
+type ValueSet struct {
+	DomainResource
+
+	// A definition of a code system, inlined into the value set (as a packaging convenience)
+	CodeSystem *ValueSetCodeSystem `json:"codeSystem,omitempty"`
+
+	// Content logical definition of the value set (CLD)
+	Compose *ValueSetCompose `json:"compose,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ValueSetContact `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the value set
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Used when the value set is "expanded"
+	Expansion *ValueSetExpansion `json:"expansion,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// It is not required to say whether this intent applies
+	Extensible        *bool           `json:"extensible,omitempty"`
+	ExtensibleElement *common.Element `json:"_extensible,omitempty"`
+
+	// Additional identifier for the value set (business identifier)
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Indicates whether or not any change to the content logical definition may occur
+	Immutable        *bool           `json:"immutable,omitempty"`
+	ImmutableElement *common.Element `json:"_immutable,omitempty"`
+
+	// If a locked date is defined, then the Content Logical Definition must be evaluated using the current version of all referenced code system(s) and value set instances as of the locked date
+	LockedDate        *common.Date    `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element `json:"_lockedDate,omitempty"`
+
+	// Name for this value set (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Explains why this value set is needed and why it has been constrained as it has
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ValueSetStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Canonical identifier for this value set, represented as a URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.CodeableConcept `json:"useContext,omitempty"`
+
+	// Business version of the value set
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetStatus represents the status of a value set
+type ValueSetStatus string
+
+const (
+	ValueSetStatusDraft   ValueSetStatus = "draft"
+	ValueSetStatusActive  ValueSetStatus = "active"
+	ValueSetStatusRetired ValueSetStatus = "retired"
+)
+
+// ValueSetContact represents contacts to assist a user in finding and communicating with the publisher
+type ValueSetContact struct {
+	common.BackboneElement
+
+	// The name of an individual to contact regarding the value set
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Contact details for individual (if a name was provided) or the publisher
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// ValueSetCodeSystemConceptDesignation represents many concept definition systems support multiple representations, in multiple languages, and for multiple purposes
+type ValueSetCodeSystemConceptDesignation struct {
+	common.BackboneElement
+
+	// The language this designation is defined for
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// A code that details how this designation would be used
+	Use *common.Coding `json:"use,omitempty"`
+
+	// The text value for this designation
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ValueSetCodeSystemConcept represents concepts that are in the code system
+type ValueSetCodeSystemConcept struct {
+	common.BackboneElement
+
+	// If this code is not for use as a real concept
+	Abstract        *bool           `json:"abstract,omitempty"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
+
+	// A code - a text symbol - that uniquely identifies the concept within the code system
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Defines children of a concept to produce a hierarchy of concepts
+	Concept []ValueSetCodeSystemConcept `json:"concept,omitempty"`
+
+	// The formal definition of the concept
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// Many concept definition systems support multiple representations, in multiple languages, and for multiple purposes
+	Designation []ValueSetCodeSystemConceptDesignation `json:"designation,omitempty"`
+
+	// A human readable string that is the recommended default way to present this concept to a user
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+}
+
+// ValueSetCodeSystem represents a definition of a code system, inlined into the value set (as a packaging convenience)
+type ValueSetCodeSystem struct {
+	common.BackboneElement
+
+	// If code comparison is case sensitive when codes within this system are compared to each other
+	CaseSensitive        *bool           `json:"caseSensitive,omitempty"`
+	CaseSensitiveElement *common.Element `json:"_caseSensitive,omitempty"`
+
+	// Concepts that are in the code system
+	Concept []ValueSetCodeSystemConcept `json:"concept"`
+
+	// An absolute URI that is used to reference this code system, including in [Coding]{datatypes.html#Coding}.system
+	System        string          `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// The version of this code system that defines the codes
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetComposeIncludeConcept represents a concept defined in the system
+type ValueSetComposeIncludeConcept struct {
+	common.BackboneElement
+
+	// Code or expression from system
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Additional representations for this concept
+	Designation []ValueSetCodeSystemConceptDesignation `json:"designation,omitempty"`
+
+	// Text to display for this code for this value set in this valueset
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+}
+
+// ValueSetComposeIncludeFilter represents select codes/concepts by their properties (including relationships)
+type ValueSetComposeIncludeFilter struct {
+	common.BackboneElement
+
+	// = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
+	Op        ValueSetComposeIncludeFilterOp `json:"op"`
+	OpElement *common.Element                `json:"_op,omitempty"`
+
+	// A property/filter defined by the code system
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+
+	// Code from the system, or regex criteria, or boolean value for exists
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ValueSetComposeIncludeFilterOp represents the op of a value set compose include filter
+type ValueSetComposeIncludeFilterOp string
+
+const (
+	ValueSetComposeIncludeFilterOpEquals ValueSetComposeIncludeFilterOp = "="
+	ValueSetComposeIncludeFilterOpIsA    ValueSetComposeIncludeFilterOp = "is-a"
+	ValueSetComposeIncludeFilterOpIsNotA ValueSetComposeIncludeFilterOp = "is-not-a"
+	ValueSetComposeIncludeFilterOpRegex  ValueSetComposeIncludeFilterOp = "regex"
+	ValueSetComposeIncludeFilterOpIn     ValueSetComposeIncludeFilterOp = "in"
+	ValueSetComposeIncludeFilterOpNotIn  ValueSetComposeIncludeFilterOp = "not-in"
+)
+
+// ValueSetComposeInclude represents include one or more codes from a code system or other value set(s)
+type ValueSetComposeInclude struct {
+	common.BackboneElement
+
+	// A concept defined in the system
+	Concept []ValueSetComposeIncludeConcept `json:"concept,omitempty"`
+
+	// Select codes/concepts by their properties (including relationships)
+	Filter []ValueSetComposeIncludeFilter `json:"filter,omitempty"`
+
+	// The system the codes come from
+	System        string          `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// Specific version of the code system referred to
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetCompose represents content logical definition of the value set (CLD)
+type ValueSetCompose struct {
+	common.BackboneElement
+
+	// Exclude one or more codes from the value set
+	Exclude []ValueSetComposeInclude `json:"exclude,omitempty"`
+
+	// Includes the contents of the referenced value set as a part of the contents of this value set
+	Import        []string          `json:"import,omitempty"`
+	ImportElement []*common.Element `json:"_import,omitempty"`
+
+	// Include one or more codes from a code system or other value set(s)
+	Include []ValueSetComposeInclude `json:"include,omitempty"`
+}
+
+// ValueSetExpansionParameter represents parameter that controlled the expansion process
+type ValueSetExpansionParameter struct {
+	common.BackboneElement
+
+	// Name as assigned by the client or server
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Value of the named parameter
+	ValueString         *string         `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element `json:"_valueString,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+	ValueUri            *string         `json:"valueUri,omitempty"`
+	ValueUriElement     *common.Element `json:"_valueUri,omitempty"`
+	ValueCode           *string         `json:"valueCode,omitempty"`
+	ValueCodeElement    *common.Element `json:"_valueCode,omitempty"`
+}
+
+// ValueSetExpansionContains represents codes in the value set
+type ValueSetExpansionContains struct {
+	common.BackboneElement
+
+	// If user cannot select this entry
+	Abstract        *bool           `json:"abstract,omitempty"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
+
+	// Code - if blank, this is not a selectable code
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Other codes and entries contained under this entry in the hierarchy
+	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
+
+	// User display for the concept
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// System value for the code
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// Version in which this code/display is defined
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetExpansion represents used when the value set is "expanded"
+type ValueSetExpansion struct {
+	common.BackboneElement
+
+	// Codes in the value set
+	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
+
+	// Identifies the value set expansion (business identifier)
+	Identifier        string          `json:"identifier"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
+
+	// Offset at which this resource starts
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
+
+	// Parameter that controlled the expansion process
+	Parameter []ValueSetExpansionParameter `json:"parameter,omitempty"`
+
+	// Time ValueSet expansion happened
+	Timestamp        common.DateTime `json:"timestamp"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+
+	// Total number of codes in the expansion
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
+}
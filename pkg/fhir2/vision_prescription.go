@@ -0,0 +1,114 @@
+package fhir2
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// VisionPrescription represents prescription for vision correction products for a patient
+type VisionPrescription struct {
+	DomainResource
+
+	// When prescription was authorized
+	DateWritten        *common.DateTime `json:"dateWritten,omitempty"`
+	DateWrittenElement *common.Element  `json:"_dateWritten,omitempty"`
+
+	// Deals with details of the dispense part of the supply specification
+	Dispense []VisionPrescriptionDispense `json:"dispense,omitempty"`
+
+	// Created during encounter / admission / stay
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Business Identifier for vision prescription
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Who prescription is for
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Who authorized the vision prescription
+	Prescriber *common.Reference `json:"prescriber,omitempty"`
+
+	// Can be the reason or the indication for writing the prescription
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+}
+
+// VisionPrescriptionDispense deals with details of the dispense part of the supply specification
+type VisionPrescriptionDispense struct {
+	common.BackboneElement
+
+	// Power adjustment for multifocal lenses measured in diopters (0.25 units)
+	Add        *common.Decimal `json:"add,omitempty"`
+	AddElement *common.Element `json:"_add,omitempty"`
+
+	// Adjustment for astigmatism measured in integer degrees
+	Axis        *int            `json:"axis,omitempty"`
+	AxisElement *common.Element `json:"_axis,omitempty"`
+
+	// Back curvature measured in millimeters
+	BackCurve        *common.Decimal `json:"backCurve,omitempty"`
+	BackCurveElement *common.Element `json:"_backCurve,omitempty"`
+
+	// The relative base, or reference lens edge, for the prism
+	Base        *VisionPrescriptionDispenseBase `json:"base,omitempty"`
+	BaseElement *common.Element                 `json:"_base,omitempty"`
+
+	// Brand recommendations or restrictions
+	Brand        *string         `json:"brand,omitempty"`
+	BrandElement *common.Element `json:"_brand,omitempty"`
+
+	// Special color or pattern
+	Color        *string         `json:"color,omitempty"`
+	ColorElement *common.Element `json:"_color,omitempty"`
+
+	// Power adjustment for astigmatism measured in diopters (0.25 units)
+	Cylinder        *common.Decimal `json:"cylinder,omitempty"`
+	CylinderElement *common.Element `json:"_cylinder,omitempty"`
+
+	// Contact lens diameter measured in millimeters
+	Diameter        *common.Decimal `json:"diameter,omitempty"`
+	DiameterElement *common.Element `json:"_diameter,omitempty"`
+
+	// The recommended maximum wear period for the lens
+	Duration *common.Quantity `json:"duration,omitempty"`
+
+	// The eye for which the lens applies
+	Eye        *VisionPrescriptionDispenseEye `json:"eye,omitempty"`
+	EyeElement *common.Element                `json:"_eye,omitempty"`
+
+	// Notes for special requirements such as coatings and lens materials
+	Notes        *string         `json:"notes,omitempty"`
+	NotesElement *common.Element `json:"_notes,omitempty"`
+
+	// Contact lens power measured in diopters (0.25 units)
+	Power        *common.Decimal `json:"power,omitempty"`
+	PowerElement *common.Element `json:"_power,omitempty"`
+
+	// Amount of prism to compensate for eye alignment in fractional units
+	Prism        *common.Decimal `json:"prism,omitempty"`
+	PrismElement *common.Element `json:"_prism,omitempty"`
+
+	// Identifies the type of vision correction product which is required for the patient
+	Product *common.Coding `json:"product"`
+
+	// Lens power measured in diopters (0.25 units)
+	Sphere        *common.Decimal `json:"sphere,omitempty"`
+	SphereElement *common.Element `json:"_sphere,omitempty"`
+}
+
+// VisionPrescriptionDispenseBase represents the base of a vision prescription dispense
+type VisionPrescriptionDispenseBase string
+
+const (
+	VisionPrescriptionDispenseBaseUp   VisionPrescriptionDispenseBase = "up"
+	VisionPrescriptionDispenseBaseDown VisionPrescriptionDispenseBase = "down"
+	VisionPrescriptionDispenseBaseIn   VisionPrescriptionDispenseBase = "in"
+	VisionPrescriptionDispenseBaseOut  VisionPrescriptionDispenseBase = "out"
+)
+
+// VisionPrescriptionDispenseEye represents the eye of a vision prescription dispense
+type VisionPrescriptionDispenseEye string
+
+const (
+	VisionPrescriptionDispenseEyeRight VisionPrescriptionDispenseEye = "right"
+	VisionPrescriptionDispenseEyeLeft  VisionPrescriptionDispenseEye = "left"
+)
@@ -0,0 +1,85 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Account tracks balance, charges, for patient or cost center
+type Account struct {
+	DomainResource
+
+	// Indicates the period of time over which the account is allowed to have transactions posted to it
+	Active *common.Period `json:"active,omitempty"`
+
+	// Calculated account balance(s)
+	Balance *common.Money `json:"balance,omitempty"`
+
+	// The party(s) that are responsible for covering the payment of this account, and what order should they be applied to the account
+	Coverage []AccountCoverage `json:"coverage,omitempty"`
+
+	// Explanation of purpose/use
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The parties ultimately responsible for balancing the Account
+	Guarantor []AccountGuarantor `json:"guarantor,omitempty"`
+
+	// Account number
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Human-readable label
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Entity managing the Account
+	Owner *common.Reference `json:"owner,omitempty"`
+
+	// It is possible for transactions relevant to a coverage period to be posted to the account before or after the coverage period, however the services that are being charged for must be within this period
+	Period *common.Period `json:"period,omitempty"`
+
+	// active | inactive | entered-in-error | on-hold | unknown
+	Status        *AccountStatus  `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// The entity that caused the expenses
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// E.g. patient, expense, depreciation
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// AccountStatus represents the status of an account
+type AccountStatus string
+
+const (
+	AccountStatusActive         AccountStatus = "active"
+	AccountStatusInactive       AccountStatus = "inactive"
+	AccountStatusEnteredInError AccountStatus = "entered-in-error"
+)
+
+// AccountCoverage represents the coverage of an account
+type AccountCoverage struct {
+	common.BackboneElement
+
+	// The party(s), such as insurances, that may contribute to the payment of this account
+	Coverage *common.Reference `json:"coverage"`
+
+	// The priority of the coverage in the context of this account
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+}
+
+// AccountGuarantor represents the guarantor of an account
+type AccountGuarantor struct {
+	common.BackboneElement
+
+	// Credit or other hold applied
+	OnHold        *bool           `json:"onHold,omitempty"`
+	OnHoldElement *common.Element `json:"_onHold,omitempty"`
+
+	// Responsible entity
+	Party *common.Reference `json:"party"`
+
+	// Guarantee account during
+	Period *common.Period `json:"period,omitempty"`
+}
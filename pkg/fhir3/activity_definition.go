@@ -0,0 +1,184 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ActivityDefinition represents the definition of a specific activity to be taken, independent of any particular patient or context
+type ActivityDefinition struct {
+	DomainResource
+
+	// When the activity definition was approved by publisher
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// What part of body to perform on
+	BodySite []common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// Detail type of activity
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A contributor to the content of the asset, including authors, editors, reviewers, and endorsers
+	Contributor []Contributor `json:"contributor,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the activity definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Detailed dosage instructions
+	Dosage []Dosage `json:"dosage,omitempty"`
+
+	// Dynamic aspects of the definition
+	DynamicValue []ActivityDefinitionDynamicValue `json:"dynamicValue,omitempty"`
+
+	// When the activity definition is expected to be used
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Additional identifier for the activity definition
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for activity definition (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Kind of resource
+	Kind        *string         `json:"kind,omitempty"`
+	KindElement *common.Element `json:"_kind,omitempty"`
+
+	// When the activity definition was last reviewed by the publisher
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// Logic used by the activity definition
+	Library []common.Reference `json:"library,omitempty"`
+
+	// Where it should happen
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Name for this activity definition (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Who should participate in the action
+	Participant []ActivityDefinitionParticipant `json:"participant,omitempty"`
+
+	// What's administered/supplied
+	ProductReference       *common.Reference       `json:"productReference,omitempty"`
+	ProductCodeableConcept *common.CodeableConcept `json:"productCodeableConcept,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this activity definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// How much is administered/consumed/supplied
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Additional documentation, citations, etc
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ActivityDefinitionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// When activity is to occur
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingRange           *Range           `json:"timingRange,omitempty"`
+
+	// Name for this activity definition (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// E.g. Education, Treatment, Assessment, etc
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// Transform to apply the template
+	Transform *common.Reference `json:"transform,omitempty"`
+
+	// Canonical identifier for this activity definition, represented as a URI (globally unique)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// Describes the clinical usage of the activity definition
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the activity definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ActivityDefinitionStatus represents the status of an activity definition
+type ActivityDefinitionStatus string
+
+const (
+	ActivityDefinitionStatusDraft   ActivityDefinitionStatus = "draft"
+	ActivityDefinitionStatusActive  ActivityDefinitionStatus = "active"
+	ActivityDefinitionStatusRetired ActivityDefinitionStatus = "retired"
+	ActivityDefinitionStatusUnknown ActivityDefinitionStatus = "unknown"
+)
+
+// ActivityDefinitionParticipant represents who should participate in the action
+type ActivityDefinitionParticipant struct {
+	common.BackboneElement
+
+	// E.g. Nurse, Surgeon, Parent, etc
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
+	Type        ActivityDefinitionParticipantType `json:"type"`
+	TypeElement *common.Element                   `json:"_type,omitempty"`
+}
+
+// ActivityDefinitionParticipantType represents the type of an activity definition participant
+type ActivityDefinitionParticipantType string
+
+const (
+	ActivityDefinitionParticipantTypePatient       ActivityDefinitionParticipantType = "patient"
+	ActivityDefinitionParticipantTypePractitioner  ActivityDefinitionParticipantType = "practitioner"
+	ActivityDefinitionParticipantTypeRelatedPerson ActivityDefinitionParticipantType = "related-person"
+)
+
+// ActivityDefinitionDynamicValue represents dynamic aspects of the definition
+type ActivityDefinitionDynamicValue struct {
+	common.BackboneElement
+
+	// A brief, natural language description of the intended semantics of the dynamic value
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An expression that provides the dynamic value for the customization
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// The media type of the language for the expression
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// The path to the element to be set dynamically
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+}
@@ -0,0 +1,105 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AdverseEvent represents an event that may be related to unintended effects on a patient or research participant
+type AdverseEvent struct {
+	DomainResource
+
+	// wrong-patient | procedure-mishap | medication-mishap | device | unsafe-physical-environment | hospital-aquired-infection | wrong-body-site
+	Category        *AdverseEventCategory `json:"category,omitempty"`
+	CategoryElement *common.Element       `json:"_category,omitempty"`
+
+	// The date (and perhaps time) when the adverse event occurred
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Describes the adverse event in text
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Parties that may or should contribute or have contributed information to the Act
+	EventParticipant *common.Reference `json:"eventParticipant,omitempty"`
+
+	// Business identifier for the event
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Location where adverse event occurred
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Type of outcome from the adverse event
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// Includes information about the reaction that occurred as a result of exposure to a substance
+	Reaction []common.Reference `json:"reaction,omitempty"`
+
+	// Who recorded the adverse event
+	Recorder *common.Reference `json:"recorder,omitempty"`
+
+	// AdverseEvent.referenceDocument
+	ReferenceDocument []common.Reference `json:"referenceDocument,omitempty"`
+
+	// Seriousness or gravity of the event
+	Seriousness *common.CodeableConcept `json:"seriousness,omitempty"`
+
+	// Research study that the subject is enrolled in
+	Study []common.Reference `json:"study,omitempty"`
+
+	// Subject impacted by event
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// AdverseEvent.subjectMedicalHistory
+	SubjectMedicalHistory []common.Reference `json:"subjectMedicalHistory,omitempty"`
+
+	// The suspected agent causing the adverse event
+	SuspectEntity []AdverseEventSuspectEntity `json:"suspectEntity,omitempty"`
+
+	// This element defines the specific type of event that occurred or that was prevented from occurring
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// AdverseEventCategory represents the category of an adverse event
+type AdverseEventCategory string
+
+const (
+	AdverseEventCategoryAE  AdverseEventCategory = "AE"
+	AdverseEventCategoryPAE AdverseEventCategory = "PAE"
+)
+
+// AdverseEventSuspectEntity represents the suspect entity of an adverse event
+type AdverseEventSuspectEntity struct {
+	common.BackboneElement
+
+	// Information on the possible cause of the event
+	Causality        *AdverseEventSuspectEntityCausality `json:"causality,omitempty"`
+	CausalityElement *common.Element                     `json:"_causality,omitempty"`
+
+	// assess1 | assess2
+	CausalityAssessment *common.CodeableConcept `json:"causalityAssessment,omitempty"`
+
+	// AdverseEvent.suspectEntity.causalityAuthor
+	CausalityAuthor *common.Reference `json:"causalityAuthor,omitempty"`
+
+	// method1 | method2
+	CausalityMethod *common.CodeableConcept `json:"causalityMethod,omitempty"`
+
+	// AdverseEvent.suspectEntity.causalityProductRelatedness
+	CausalityProductRelatedness        *string         `json:"causalityProductRelatedness,omitempty"`
+	CausalityProductRelatednessElement *common.Element `json:"_causalityProductRelatedness,omitempty"`
+
+	// result1 | result2
+	CausalityResult *common.CodeableConcept `json:"causalityResult,omitempty"`
+
+	// Identifies the actual instance of what caused the adverse event
+	Instance *common.Reference `json:"instance"`
+}
+
+// AdverseEventSuspectEntityCausality represents the causality of an adverse event suspect entity
+type AdverseEventSuspectEntityCausality string
+
+const (
+	AdverseEventSuspectEntityCausalityCausality1 AdverseEventSuspectEntityCausality = "causality1"
+	AdverseEventSuspectEntityCausalityCausality2 AdverseEventSuspectEntityCausality = "causality2"
+)
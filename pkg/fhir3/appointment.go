@@ -0,0 +1,130 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Appointment represents a booking of a healthcare event among patient(s), practitioner(s)
+type Appointment struct {
+	DomainResource
+
+	// The style of appointment or patient that has been booked in the slot (not service type)
+	AppointmentType *common.CodeableConcept `json:"appointmentType,omitempty"`
+
+	// Additional text to aid in facilitating the appointment
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// The date that this appointment was initially created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Shown on a subject line in a meeting request, or appointment list
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// When appointment is to conclude
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The referral request this appointment is allocated to assess (incoming referral)
+	IncomingReferral []common.Reference `json:"incomingReferral,omitempty"`
+
+	// Reason the appointment has been scheduled to take place, as specified using information from another resource
+	Indication []common.Reference `json:"indication,omitempty"`
+
+	// Can be less than start/end (e.g. estimate)
+	MinutesDuration        *int            `json:"minutesDuration,omitempty"`
+	MinutesDurationElement *common.Element `json:"_minutesDuration,omitempty"`
+
+	// Participants involved in appointment
+	Participant []AppointmentParticipant `json:"participant"`
+
+	// Used to make informed decisions if needing to re-prioritize
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// Reason this appointment is scheduled
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Potential date/time interval(s) requested to allocate the appointment within
+	RequestedPeriod []common.Period `json:"requestedPeriod,omitempty"`
+
+	// A broad categorization of the service that is to be performed during this appointment
+	ServiceCategory *common.CodeableConcept `json:"serviceCategory,omitempty"`
+
+	// The specific service that is to be performed during this appointment
+	ServiceType []common.CodeableConcept `json:"serviceType,omitempty"`
+
+	// The slots that this appointment is filling
+	Slot []common.Reference `json:"slot,omitempty"`
+
+	// The specialty of a practitioner that would be required to perform the service requested in this appointment
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+
+	// When appointment is to take place
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// proposed | pending | booked | arrived | fulfilled | cancelled | noshow | entered-in-error | checked-in | waitlist
+	Status        AppointmentStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// Additional information to support the appointment
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+}
+
+// AppointmentStatus represents the status of an appointment
+type AppointmentStatus string
+
+const (
+	AppointmentStatusProposed       AppointmentStatus = "proposed"
+	AppointmentStatusPending        AppointmentStatus = "pending"
+	AppointmentStatusBooked         AppointmentStatus = "booked"
+	AppointmentStatusArrived        AppointmentStatus = "arrived"
+	AppointmentStatusFulfilled      AppointmentStatus = "fulfilled"
+	AppointmentStatusCancelled      AppointmentStatus = "cancelled"
+	AppointmentStatusNoshow         AppointmentStatus = "noshow"
+	AppointmentStatusEnteredInError AppointmentStatus = "entered-in-error"
+)
+
+// AppointmentParticipant represents participants involved in appointment
+type AppointmentParticipant struct {
+	common.BackboneElement
+
+	// The individual, device, location, or service participating in the appointment
+	Actor *common.Reference `json:"actor,omitempty"`
+
+	// The participant is required to attend (optional when false)
+	Required        *AppointmentParticipantRequired `json:"required,omitempty"`
+	RequiredElement *common.Element                 `json:"_required,omitempty"`
+
+	// accepted | declined | tentative | needs-action
+	Status        AppointmentParticipantStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
+
+	// Role of participant in the appointment
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// AppointmentParticipantRequired represents the required of an appointment participant
+type AppointmentParticipantRequired string
+
+const (
+	AppointmentParticipantRequiredRequired        AppointmentParticipantRequired = "required"
+	AppointmentParticipantRequiredOptional        AppointmentParticipantRequired = "optional"
+	AppointmentParticipantRequiredInformationOnly AppointmentParticipantRequired = "information-only"
+)
+
+// AppointmentParticipantStatus represents the status of an appointment participant
+type AppointmentParticipantStatus string
+
+const (
+	AppointmentParticipantStatusAccepted    AppointmentParticipantStatus = "accepted"
+	AppointmentParticipantStatusDeclined    AppointmentParticipantStatus = "declined"
+	AppointmentParticipantStatusTentative   AppointmentParticipantStatus = "tentative"
+	AppointmentParticipantStatusNeedsAction AppointmentParticipantStatus = "needs-action"
+)
@@ -0,0 +1,165 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AuditEvent represents record of an event
+type AuditEvent struct {
+	DomainResource
+
+	// Type of action performed during the event
+	Action        *string         `json:"action,omitempty"`
+	ActionElement *common.Element `json:"_action,omitempty"`
+
+	// Actor involved in the event
+	Agent []AuditEventAgent `json:"agent"`
+
+	// Data or objects used
+	Entity []AuditEventEntity `json:"entity,omitempty"`
+
+	// Whether the event succeeded or failed
+	Outcome        *string         `json:"outcome,omitempty"`
+	OutcomeElement *common.Element `json:"_outcome,omitempty"`
+
+	// A free text description of the outcome of the event
+	OutcomeDesc        *string         `json:"outcomeDesc,omitempty"`
+	OutcomeDescElement *common.Element `json:"_outcomeDesc,omitempty"`
+
+	// Use AuditEvent.agent.purposeOfUse when you know that it is specific to the agent
+	PurposeOfEvent []common.CodeableConcept `json:"purposeOfEvent,omitempty"`
+
+	// Time when the event was recorded
+	Recorded        common.Instant  `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
+
+	// Audit Event Reporter
+	Source *AuditEventSource `json:"source"`
+
+	// Identifier for the category of event
+	Subtype []common.Coding `json:"subtype,omitempty"`
+
+	// Identifier for a family of the event
+	Type *common.Coding `json:"type"`
+}
+
+// AuditEventAgentNetwork represents logical network location for application activity, if the activity has a network location
+type AuditEventAgentNetwork struct {
+	common.BackboneElement
+
+	// This could be a device id, IP address or some other identifier associated with a device
+	Address        *string         `json:"address,omitempty"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	// An identifier for the type of network access point that originated the audit event
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// AuditEventAgent represents actor involved in the event
+type AuditEventAgent struct {
+	common.BackboneElement
+
+	// Alternative agent Identifier
+	AltID        *string         `json:"altId,omitempty"`
+	AltIDElement *common.Element `json:"_altId,omitempty"`
+
+	// The agent location when the event occurred
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Type of media involved
+	Media *common.Coding `json:"media,omitempty"`
+
+	// Human-meaningful name for the agent
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Logical network location for application activity, if the activity has a network location
+	Network *AuditEventAgentNetwork `json:"network,omitempty"`
+
+	// Policy that authorized the agent participation in the event
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
+
+	// Use AuditEvent.agent.purposeOfUse when you know that is specific to the agent, otherwise use AuditEvent.purposeOfEvent
+	PurposeOfUse []common.CodeableConcept `json:"purposeOfUse,omitempty"`
+
+	// Direct reference to a resource that identifies the agent
+	Reference *common.Reference `json:"reference,omitempty"`
+
+	// Whether user is initiator
+	Requestor        bool            `json:"requestor"`
+	RequestorElement *common.Element `json:"_requestor,omitempty"`
+
+	// Agent role in the event
+	Role []common.CodeableConcept `json:"role,omitempty"`
+
+	// A unique value within the Audit Source ID
+	UserId *common.Identifier `json:"userId,omitempty"`
+}
+
+// AuditEventSource represents Audit Event Reporter
+type AuditEventSource struct {
+	common.BackboneElement
+
+	// Identifier of the source where the event was detected
+	Identifier *common.Identifier `json:"identifier"`
+
+	// Logical source location within the enterprise
+	Site        *string         `json:"site,omitempty"`
+	SiteElement *common.Element `json:"_site,omitempty"`
+
+	// The type of source where event originated
+	Type []common.Coding `json:"type,omitempty"`
+}
+
+// AuditEventEntityDetail represents Additional Information about the entity
+type AuditEventEntityDetail struct {
+	common.BackboneElement
+
+	// Name of the property
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// The value is base64 encoded to enable various encodings or binary content
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// AuditEventEntity represents data or objects used
+type AuditEventEntity struct {
+	common.BackboneElement
+
+	// Text that describes the entity in more detail
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Additional Information about the entity
+	Detail []AuditEventEntityDetail `json:"detail,omitempty"`
+
+	// Identifier detail depends on entity type
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// This can be used to provide an audit trail for data, over time, as it passes through the system
+	Lifecycle *common.Coding `json:"lifecycle,omitempty"`
+
+	// This field may be used in a query/report to identify audit events for a specific person
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Query parameters
+	Query        *string         `json:"query,omitempty"`
+	QueryElement *common.Element `json:"_query,omitempty"`
+
+	// Identifies a specific instance of the entity
+	Reference *common.Reference `json:"reference,omitempty"`
+
+	// What role the entity played
+	Role *common.Coding `json:"role,omitempty"`
+
+	// Security labels on the entity
+	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
+
+	// This value is distinct from the user's role or any user relationship to the entity
+	Type *common.Coding `json:"type,omitempty"`
+}
@@ -0,0 +1,21 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Binary represents pure binary content defined by a format other than FHIR
+type Binary struct {
+	Resource
+
+	// If the content type is itself base64 encoding, then this will be base64 encoded twice
+	Content        string          `json:"content"`
+	ContentElement *common.Element `json:"_content,omitempty"`
+
+	// MimeType of the binary content
+	ContentType        string          `json:"contentType"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
+
+	// Identifies another resource to use as proxy when enforcing access control
+	SecurityContext *common.Reference `json:"securityContext,omitempty"`
+}
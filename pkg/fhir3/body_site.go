@@ -0,0 +1,33 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// BodySite represents record details about the anatomical location of a specimen or body part
+type BodySite struct {
+	DomainResource
+
+	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// Named anatomical location - ideally coded where possible
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// This description could include any visual markings used to orientate the viewer e.g. external reference points
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Identifier for this instance of the anatomical location
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Image or images used to identify a location
+	Image []Attachment `json:"image,omitempty"`
+
+	// The person to which the body site belongs
+	Patient *common.Reference `json:"patient"`
+
+	// Qualifier to refine the anatomical location
+	Qualifier []common.CodeableConcept `json:"qualifier,omitempty"`
+}
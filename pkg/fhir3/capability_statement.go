@@ -0,0 +1,564 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CapabilityStatement represents a statement of system capabilities
+type CapabilityStatement struct {
+	DomainResource
+
+	// Unknown elements in a resource can only arise as later versions of the specification are published
+	AcceptUnknown        CapabilityStatementAcceptUnknown `json:"acceptUnknown"`
+	AcceptUnknownElement *common.Element                  `json:"_acceptUnknown,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Natural language description of the capability statement
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Document definition
+	Document []CapabilityStatementDocument `json:"document,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// FHIR Version the system supports
+	FHIRVersion        string          `json:"fhirVersion"`
+	FHIRVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// formats supported (xml | json | ttl | mime type)
+	Format        []string          `json:"format"`
+	FormatElement []*common.Element `json:"_format,omitempty"`
+
+	// If this describes a specific instance
+	Implementation *CapabilityStatementImplementation `json:"implementation,omitempty"`
+
+	// Implementation guides supported
+	ImplementationGuide        []string          `json:"implementationGuide,omitempty"`
+	ImplementationGuideElement []*common.Element `json:"_implementationGuide,omitempty"`
+
+	// Canonical URL of another capability statement this implements
+	Instantiates        []string          `json:"instantiates,omitempty"`
+	InstantiatesElement []*common.Element `json:"_instantiates,omitempty"`
+
+	// Intended jurisdiction for capability statement (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// instance | capability | requirements
+	Kind        CapabilityStatementKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
+
+	// If messaging is supported
+	Messaging []CapabilityStatementMessaging `json:"messaging,omitempty"`
+
+	// Name for this capability statement (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Patch formats supported
+	PatchFormat        []string          `json:"patchFormat,omitempty"`
+	PatchFormatElement []*common.Element `json:"_patchFormat,omitempty"`
+
+	// Supported profiles are different than the profiles that apply to a particular resource in rest.resource.profile
+	Profile []common.Reference `json:"profile,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this capability statement is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// If the endpoint is a RESTful one
+	Rest []CapabilityStatementRest `json:"rest,omitempty"`
+
+	// Software that is covered by this capability statement
+	Software *CapabilityStatementSoftware `json:"software,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        CapabilityStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Name for this capability statement (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this capability statement, represented as a URI (globally unique)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the capability statement
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// CapabilityStatementAcceptUnknown represents the accept unknown of a capability statement
+type CapabilityStatementAcceptUnknown string
+
+const (
+	CapabilityStatementAcceptUnknownNo         CapabilityStatementAcceptUnknown = "no"
+	CapabilityStatementAcceptUnknownExtensions CapabilityStatementAcceptUnknown = "extensions"
+	CapabilityStatementAcceptUnknownElements   CapabilityStatementAcceptUnknown = "elements"
+	CapabilityStatementAcceptUnknownBoth       CapabilityStatementAcceptUnknown = "both"
+)
+
+// CapabilityStatementKind represents the kind of a capability statement
+type CapabilityStatementKind string
+
+const (
+	CapabilityStatementKindInstance     CapabilityStatementKind = "instance"
+	CapabilityStatementKindCapability   CapabilityStatementKind = "capability"
+	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
+)
+
+// CapabilityStatementStatus represents the status of a capability statement
+type CapabilityStatementStatus string
+
+const (
+	CapabilityStatementStatusDraft   CapabilityStatementStatus = "draft"
+	CapabilityStatementStatusActive  CapabilityStatementStatus = "active"
+	CapabilityStatementStatusRetired CapabilityStatementStatus = "retired"
+	CapabilityStatementStatusUnknown CapabilityStatementStatus = "unknown"
+)
+
+// CapabilityStatementSoftware represents software that is covered by this capability statement
+type CapabilityStatementSoftware struct {
+	common.BackboneElement
+
+	// A name the software is known by
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Date this version was released
+	ReleaseDate        *common.DateTime `json:"releaseDate,omitempty"`
+	ReleaseDateElement *common.Element  `json:"_releaseDate,omitempty"`
+
+	// Version covered by this statement
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// CapabilityStatementImplementation identifies a specific implementation instance that is described by the capability statement
+type CapabilityStatementImplementation struct {
+	common.BackboneElement
+
+	// Describes this specific instance
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Base URL for the installation
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// CapabilityStatementRestSecurityCertificate represents certificates associated with security profiles
+type CapabilityStatementRestSecurityCertificate struct {
+	common.BackboneElement
+
+	// Actual certificate
+	Blob        *string         `json:"blob,omitempty"`
+	BlobElement *common.Element `json:"_blob,omitempty"`
+
+	// Mime type for a certificate
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// CapabilityStatementRestSecurity represents information about security of implementation
+type CapabilityStatementRestSecurity struct {
+	common.BackboneElement
+
+	// Certificates associated with security profiles
+	Certificate []CapabilityStatementRestSecurityCertificate `json:"certificate,omitempty"`
+
+	// Adds CORS Headers (http://enable-cors.org/)
+	Cors        *bool           `json:"cors,omitempty"`
+	CorsElement *common.Element `json:"_cors,omitempty"`
+
+	// General description of how security works
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// OAuth | SMART-on-FHIR | NTLM | Basic | Kerberos | Certificates
+	Service []common.CodeableConcept `json:"service,omitempty"`
+}
+
+// CapabilityStatementRestResourceInteraction represents what operations are supported
+type CapabilityStatementRestResourceInteraction struct {
+	common.BackboneElement
+
+	// read | vread | update | patch | delete | history-instance | history-type | create | search-type
+	Code        CapabilityStatementRestResourceInteractionCode `json:"code"`
+	CodeElement *common.Element                                `json:"_code,omitempty"`
+
+	// Anything special about operation behavior
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+}
+
+// CapabilityStatementRestResourceInteractionCode represents the code of a capability statement rest resource interaction
+type CapabilityStatementRestResourceInteractionCode string
+
+const (
+	CapabilityStatementRestResourceInteractionCodeRead            CapabilityStatementRestResourceInteractionCode = "read"
+	CapabilityStatementRestResourceInteractionCodeVread           CapabilityStatementRestResourceInteractionCode = "vread"
+	CapabilityStatementRestResourceInteractionCodeUpdate          CapabilityStatementRestResourceInteractionCode = "update"
+	CapabilityStatementRestResourceInteractionCodePatch           CapabilityStatementRestResourceInteractionCode = "patch"
+	CapabilityStatementRestResourceInteractionCodeDelete          CapabilityStatementRestResourceInteractionCode = "delete"
+	CapabilityStatementRestResourceInteractionCodeHistoryInstance CapabilityStatementRestResourceInteractionCode = "history-instance"
+	CapabilityStatementRestResourceInteractionCodeHistoryType     CapabilityStatementRestResourceInteractionCode = "history-type"
+	CapabilityStatementRestResourceInteractionCodeCreate          CapabilityStatementRestResourceInteractionCode = "create"
+	CapabilityStatementRestResourceInteractionCodeSearchType      CapabilityStatementRestResourceInteractionCode = "search-type"
+)
+
+// CapabilityStatementRestResourceSearchParam represents search parameters supported by implementation
+type CapabilityStatementRestResourceSearchParam struct {
+	common.BackboneElement
+
+	// Source of definition for parameter
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// Server-specific usage
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Name for parameter in search url
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// number | date | string | token | reference | composite | quantity | uri | special
+	Type        CapabilityStatementRestResourceSearchParamType `json:"type"`
+	TypeElement *common.Element                                `json:"_type,omitempty"`
+}
+
+// CapabilityStatementRestResourceSearchParamType represents the type of a capability statement rest resource search param
+type CapabilityStatementRestResourceSearchParamType string
+
+const (
+	CapabilityStatementRestResourceSearchParamTypeNumber    CapabilityStatementRestResourceSearchParamType = "number"
+	CapabilityStatementRestResourceSearchParamTypeDate      CapabilityStatementRestResourceSearchParamType = "date"
+	CapabilityStatementRestResourceSearchParamTypeString    CapabilityStatementRestResourceSearchParamType = "string"
+	CapabilityStatementRestResourceSearchParamTypeToken     CapabilityStatementRestResourceSearchParamType = "token"
+	CapabilityStatementRestResourceSearchParamTypeReference CapabilityStatementRestResourceSearchParamType = "reference"
+	CapabilityStatementRestResourceSearchParamTypeComposite CapabilityStatementRestResourceSearchParamType = "composite"
+	CapabilityStatementRestResourceSearchParamTypeQuantity  CapabilityStatementRestResourceSearchParamType = "quantity"
+	CapabilityStatementRestResourceSearchParamTypeUri       CapabilityStatementRestResourceSearchParamType = "uri"
+)
+
+// CapabilityStatementRestResource represents resource served on the REST interface
+type CapabilityStatementRestResource struct {
+	common.BackboneElement
+
+	// If allows/uses conditional create
+	ConditionalCreate        *bool           `json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *common.Element `json:"_conditionalCreate,omitempty"`
+
+	// not-supported | single | multiple - how conditional delete is supported
+	ConditionalDelete        *CapabilityStatementRestResourceConditionalDelete `json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *common.Element                                   `json:"_conditionalDelete,omitempty"`
+
+	// not-supported | modified-since | not-match | full-support
+	ConditionalRead        *CapabilityStatementRestResourceConditionalRead `json:"conditionalRead,omitempty"`
+	ConditionalReadElement *common.Element                                 `json:"_conditionalRead,omitempty"`
+
+	// If allows/uses conditional update
+	ConditionalUpdate        *bool           `json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *common.Element `json:"_conditionalUpdate,omitempty"`
+
+	// Additional information about the use of the resource type
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// What operations are supported?
+	Interaction []CapabilityStatementRestResourceInteraction `json:"interaction"`
+
+	// System-wide profile
+	Profile *common.Reference `json:"profile,omitempty"`
+
+	// Whether vRead can return past versions
+	ReadHistory        *bool           `json:"readHistory,omitempty"`
+	ReadHistoryElement *common.Element `json:"_readHistory,omitempty"`
+
+	// literal | logical | resolves | enforced | local
+	ReferencePolicy        []CapabilityStatementRestResourceReferencePolicy `json:"referencePolicy,omitempty"`
+	ReferencePolicyElement []*common.Element                                `json:"_referencePolicy,omitempty"`
+
+	// _include values supported by the server
+	SearchInclude        []string          `json:"searchInclude,omitempty"`
+	SearchIncludeElement []*common.Element `json:"_searchInclude,omitempty"`
+
+	// Search parameters supported by implementation
+	SearchParam []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
+
+	// _revinclude values supported by the server
+	SearchRevInclude        []string          `json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement []*common.Element `json:"_searchRevInclude,omitempty"`
+
+	// A resource type that is supported
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// If update can commit to a new identity
+	UpdateCreate        *bool           `json:"updateCreate,omitempty"`
+	UpdateCreateElement *common.Element `json:"_updateCreate,omitempty"`
+
+	// no-version | versioned | versioned-update
+	Versioning        *CapabilityStatementRestResourceVersioning `json:"versioning,omitempty"`
+	VersioningElement *common.Element                            `json:"_versioning,omitempty"`
+}
+
+// CapabilityStatementRestResourceConditionalDelete represents the conditional delete of a capability statement rest resource
+type CapabilityStatementRestResourceConditionalDelete string
+
+const (
+	CapabilityStatementRestResourceConditionalDeleteNotSupported CapabilityStatementRestResourceConditionalDelete = "not-supported"
+	CapabilityStatementRestResourceConditionalDeleteSingle       CapabilityStatementRestResourceConditionalDelete = "single"
+	CapabilityStatementRestResourceConditionalDeleteMultiple     CapabilityStatementRestResourceConditionalDelete = "multiple"
+)
+
+// CapabilityStatementRestResourceConditionalRead represents the conditional read of a capability statement rest resource
+type CapabilityStatementRestResourceConditionalRead string
+
+const (
+	CapabilityStatementRestResourceConditionalReadNotSupported  CapabilityStatementRestResourceConditionalRead = "not-supported"
+	CapabilityStatementRestResourceConditionalReadModifiedSince CapabilityStatementRestResourceConditionalRead = "modified-since"
+	CapabilityStatementRestResourceConditionalReadNotMatch      CapabilityStatementRestResourceConditionalRead = "not-match"
+	CapabilityStatementRestResourceConditionalReadFullSupport   CapabilityStatementRestResourceConditionalRead = "full-support"
+)
+
+// CapabilityStatementRestResourceReferencePolicy represents the reference policy of a capability statement rest resource
+type CapabilityStatementRestResourceReferencePolicy string
+
+const (
+	CapabilityStatementRestResourceReferencePolicyLiteral  CapabilityStatementRestResourceReferencePolicy = "literal"
+	CapabilityStatementRestResourceReferencePolicyLogical  CapabilityStatementRestResourceReferencePolicy = "logical"
+	CapabilityStatementRestResourceReferencePolicyResolves CapabilityStatementRestResourceReferencePolicy = "resolves"
+	CapabilityStatementRestResourceReferencePolicyEnforced CapabilityStatementRestResourceReferencePolicy = "enforced"
+	CapabilityStatementRestResourceReferencePolicyLocal    CapabilityStatementRestResourceReferencePolicy = "local"
+)
+
+// CapabilityStatementRestResourceVersioning represents the versioning of a capability statement rest resource
+type CapabilityStatementRestResourceVersioning string
+
+const (
+	CapabilityStatementRestResourceVersioningNoVersion       CapabilityStatementRestResourceVersioning = "no-version"
+	CapabilityStatementRestResourceVersioningVersioned       CapabilityStatementRestResourceVersioning = "versioned"
+	CapabilityStatementRestResourceVersioningVersionedUpdate CapabilityStatementRestResourceVersioning = "versioned-update"
+)
+
+// CapabilityStatementRestInteraction represents what operations are supported
+type CapabilityStatementRestInteraction struct {
+	common.BackboneElement
+
+	// transaction | batch | search-system | history-system
+	Code        CapabilityStatementRestInteractionCode `json:"code"`
+	CodeElement *common.Element                        `json:"_code,omitempty"`
+
+	// Anything special about operation behavior
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+}
+
+// CapabilityStatementRestInteractionCode represents the code of a capability statement rest interaction
+type CapabilityStatementRestInteractionCode string
+
+const (
+	CapabilityStatementRestInteractionCodeTransaction   CapabilityStatementRestInteractionCode = "transaction"
+	CapabilityStatementRestInteractionCodeBatch         CapabilityStatementRestInteractionCode = "batch"
+	CapabilityStatementRestInteractionCodeSearchSystem  CapabilityStatementRestInteractionCode = "search-system"
+	CapabilityStatementRestInteractionCodeHistorySystem CapabilityStatementRestInteractionCode = "history-system"
+)
+
+// CapabilityStatementRestOperation represents definition of a system level operation
+type CapabilityStatementRestOperation struct {
+	common.BackboneElement
+
+	// This can be used to build an HTML form to invoke the operation, for instance
+	Definition *common.Reference `json:"definition"`
+
+	// The name here SHOULD be the same as the name in the definition, unless there is a name clash and the name cannot be used
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// CapabilityStatementRest represents multiple repetitions allow definition of both client and/or server behaviors or possibly behaviors under different configuration settings (for software or requirements statements)
+type CapabilityStatementRest struct {
+	common.BackboneElement
+
+	// Compartments served/used by system
+	Compartment        []string          `json:"compartment,omitempty"`
+	CompartmentElement []*common.Element `json:"_compartment,omitempty"`
+
+	// General description of implementation
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// What operations are supported?
+	Interaction []CapabilityStatementRestInteraction `json:"interaction,omitempty"`
+
+	// client | server
+	Mode        CapabilityStatementRestMode `json:"mode"`
+	ModeElement *common.Element             `json:"_mode,omitempty"`
+
+	// Definition of an operation or a named query together with its parameters and their meaning and type
+	Operation []CapabilityStatementRestOperation `json:"operation,omitempty"`
+
+	// Resource served on the REST interface
+	Resource []CapabilityStatementRestResource `json:"resource,omitempty"`
+
+	// Typically, the only search parameters supported for all searchse are those that apply to all resources - tags
+	SearchParam []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
+
+	// Information about security of implementation
+	Security *CapabilityStatementRestSecurity `json:"security,omitempty"`
+}
+
+// CapabilityStatementRestMode represents the mode of a capability statement rest
+type CapabilityStatementRestMode string
+
+const (
+	CapabilityStatementRestModeClient CapabilityStatementRestMode = "client"
+	CapabilityStatementRestModeServer CapabilityStatementRestMode = "server"
+)
+
+// CapabilityStatementMessagingEndpoint represents where messages should be sent
+type CapabilityStatementMessagingEndpoint struct {
+	common.BackboneElement
+
+	// Network address or identifier of the end-point
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	// http | ftp | mllp +
+	Protocol *common.Coding `json:"protocol"`
+}
+
+// CapabilityStatementMessagingSupportedMessage represents messages supported by this system
+type CapabilityStatementMessagingSupportedMessage struct {
+	common.BackboneElement
+
+	// Message supported by this system
+	Definition *common.Reference `json:"definition"`
+
+	// sender | receiver
+	Mode        CapabilityStatementMessagingSupportedMessageMode `json:"mode"`
+	ModeElement *common.Element                                  `json:"_mode,omitempty"`
+}
+
+// CapabilityStatementMessagingSupportedMessageMode represents the mode of a capability statement messaging supported message
+type CapabilityStatementMessagingSupportedMessageMode string
+
+const (
+	CapabilityStatementMessagingSupportedMessageModeSender   CapabilityStatementMessagingSupportedMessageMode = "sender"
+	CapabilityStatementMessagingSupportedMessageModeReceiver CapabilityStatementMessagingSupportedMessageMode = "receiver"
+)
+
+// CapabilityStatementMessagingEvent represents the event of a capability statement messaging
+type CapabilityStatementMessagingEvent struct {
+	common.BackboneElement
+
+	// The impact of the content of the message
+	Category        *CapabilityStatementMessagingEventCategory `json:"category,omitempty"`
+	CategoryElement *common.Element                            `json:"_category,omitempty"`
+
+	// A coded identifier of a supported messaging event
+	Code *common.Coding `json:"code"`
+
+	// Guidance on how this event is handled, such as internal system trigger points, business rules, etc
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// This SHALL be provided if the event type supports multiple different resource types
+	Focus        string          `json:"focus"`
+	FocusElement *common.Element `json:"_focus,omitempty"`
+
+	// The mode of this event declaration - whether an application is a sender or receiver
+	Mode        CapabilityStatementMessagingEventMode `json:"mode"`
+	ModeElement *common.Element                       `json:"_mode,omitempty"`
+
+	// Information about the request for this event
+	Request *common.Reference `json:"request"`
+
+	// Information about the response for this event
+	Response *common.Reference `json:"response"`
+}
+
+// CapabilityStatementMessagingEventCategory represents the category of a capability statement messaging event
+type CapabilityStatementMessagingEventCategory string
+
+const (
+	CapabilityStatementMessagingEventCategoryConsequence  CapabilityStatementMessagingEventCategory = "Consequence"
+	CapabilityStatementMessagingEventCategoryCurrency     CapabilityStatementMessagingEventCategory = "Currency"
+	CapabilityStatementMessagingEventCategoryNotification CapabilityStatementMessagingEventCategory = "Notification"
+)
+
+// CapabilityStatementMessagingEventMode represents the mode of a capability statement messaging event
+type CapabilityStatementMessagingEventMode string
+
+const (
+	CapabilityStatementMessagingEventModeSender   CapabilityStatementMessagingEventMode = "sender"
+	CapabilityStatementMessagingEventModeReceiver CapabilityStatementMessagingEventMode = "receiver"
+)
+
+// CapabilityStatementMessaging represents multiple repetitions allow the documentation of multiple endpoints per solution
+type CapabilityStatementMessaging struct {
+	common.BackboneElement
+
+	// Messaging interface behavior details
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Where messages should be sent
+	Endpoint []CapabilityStatementMessagingEndpoint `json:"endpoint,omitempty"`
+
+	// The same event may be listed up to two times - once as sender and once as receiver
+	Event []CapabilityStatementMessagingEvent `json:"event,omitempty"`
+
+	// Reliable Message Cache Length (min)
+	ReliableCache        *int            `json:"reliableCache,omitempty"`
+	ReliableCacheElement *common.Element `json:"_reliableCache,omitempty"`
+
+	// Messages supported by this system
+	SupportedMessage []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty"`
+}
+
+// CapabilityStatementDocument represents document definition
+type CapabilityStatementDocument struct {
+	common.BackboneElement
+
+	// Description of document support
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// producer | consumer
+	Mode        CapabilityStatementDocumentMode `json:"mode"`
+	ModeElement *common.Element                 `json:"_mode,omitempty"`
+
+	// Constraint on the resources used in the document
+	Profile *common.Reference `json:"profile"`
+}
+
+// CapabilityStatementDocumentMode represents the mode of a capability statement document
+type CapabilityStatementDocumentMode string
+
+const (
+	CapabilityStatementDocumentModeProducer CapabilityStatementDocumentMode = "producer"
+	CapabilityStatementDocumentModeConsumer CapabilityStatementDocumentMode = "consumer"
+)
@@ -0,0 +1,76 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CareTeam represents planned participants in the coordination and delivery of care
+type CareTeam struct {
+	DomainResource
+
+	// Type of team
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// The encounter or episode of care that establishes the context for this care team
+	Context *common.Reference `json:"context,omitempty"`
+
+	// External Ids for this team
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Organization responsible for the care team
+	ManagingOrganization []common.Reference `json:"managingOrganization,omitempty"`
+
+	// Name of the team, such as crisis assessment team
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Comments made about the CareTeam
+	Note []Annotation `json:"note,omitempty"`
+
+	// Members of the team
+	Participant []CareTeamParticipant `json:"participant,omitempty"`
+
+	// Time period team covers
+	Period *common.Period `json:"period,omitempty"`
+
+	// Describes why the care team exists
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Condition(s) that this care team addresses
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// proposed | active | suspended | inactive | entered-in-error
+	Status        *CareTeamStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Who care team is for
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// CareTeamStatus represents the status of a care team
+type CareTeamStatus string
+
+const (
+	CareTeamStatusProposed       CareTeamStatus = "proposed"
+	CareTeamStatusActive         CareTeamStatus = "active"
+	CareTeamStatusSuspended      CareTeamStatus = "suspended"
+	CareTeamStatusInactive       CareTeamStatus = "inactive"
+	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
+)
+
+// CareTeamParticipant represents members of the team
+type CareTeamParticipant struct {
+	common.BackboneElement
+
+	// Who is involved
+	Member *common.Reference `json:"member,omitempty"`
+
+	// Organization of the practitioner
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+
+	// Indicates when the specific member or organization did (or is intended to) come into effect and end
+	Period *common.Period `json:"period,omitempty"`
+
+	// Type of involvement
+	Role *common.CodeableConcept `json:"role,omitempty"`
+}
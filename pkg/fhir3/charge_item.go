@@ -0,0 +1,111 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ChargeItem represents item containing charge code(s) associated with the provision of healthcare provider products
+type ChargeItem struct {
+	DomainResource
+
+	// Account to place this charge
+	Account []common.Reference `json:"account,omitempty"`
+
+	// Anatomical location, if relevant
+	Bodysite []common.CodeableConcept `json:"bodysite,omitempty"`
+
+	// A code that identifies the charge, like a billing code
+	Code *common.CodeableConcept `json:"code"`
+
+	// The encounter or episode of care that establishes the context for this event
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Target Resource is yet to be defined as a container that holds information about list prices and rules applicable to the charge item code
+	Definition        []string          `json:"definition,omitempty"`
+	DefinitionElement []*common.Element `json:"_definition,omitempty"`
+
+	// Date the charge item was entered
+	EnteredDate        *common.DateTime `json:"enteredDate,omitempty"`
+	EnteredDateElement *common.Element  `json:"_enteredDate,omitempty"`
+
+	// Individual who was entering
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// There is no reason to carry the factor in the instance of a ChargeItem unless special circumstances require a manual override
+	FactorOverride        *common.Decimal `json:"factorOverride,omitempty"`
+	FactorOverrideElement *common.Element `json:"_factorOverride,omitempty"`
+
+	// Business Identifier for item
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Comments made about the ChargeItem
+	Note []Annotation `json:"note,omitempty"`
+
+	// When the charged service was applied
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
+
+	// Reason for overriding the list price/factor
+	OverrideReason        *string         `json:"overrideReason,omitempty"`
+	OverrideReasonElement *common.Element `json:"_overrideReason,omitempty"`
+
+	// Indicates who or what performed or participated in the charged service
+	Participant []ChargeItemParticipant `json:"participant,omitempty"`
+
+	// Part of referenced ChargeItem
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Organization providing the charged service
+	PerformingOrganization *common.Reference `json:"performingOrganization,omitempty"`
+
+	// There is no reason to carry the price in the instance of a ChargeItem unless circumstances require a manual override
+	PriceOverride *common.Money `json:"priceOverride,omitempty"`
+
+	// Quantity of which the charge item has been serviced
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Why was the charged  service rendered?
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Organization requesting the charged service
+	RequestingOrganization *common.Reference `json:"requestingOrganization,omitempty"`
+
+	// Which rendered service is being charged?
+	Service []common.Reference `json:"service,omitempty"`
+
+	// planned | billable | not-billable | aborted | billed | entered-in-error | unknown
+	Status        ChargeItemStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// Individual service was done for/to
+	Subject *common.Reference `json:"subject"`
+
+	// Further information supporting this charge
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+}
+
+// ChargeItemStatus represents the status of a charge item
+type ChargeItemStatus string
+
+const (
+	ChargeItemStatusPlanned        ChargeItemStatus = "planned"
+	ChargeItemStatusBillable       ChargeItemStatus = "billable"
+	ChargeItemStatusNotBillable    ChargeItemStatus = "not-billable"
+	ChargeItemStatusAborted        ChargeItemStatus = "aborted"
+	ChargeItemStatusBilled         ChargeItemStatus = "billed"
+	ChargeItemStatusEnteredInError ChargeItemStatus = "entered-in-error"
+	ChargeItemStatusUnknown        ChargeItemStatus = "unknown"
+)
+
+// ChargeItemParticipant indicates who or what performed or participated in the charged service
+type ChargeItemParticipant struct {
+	common.BackboneElement
+
+	// The device, practitioner, etc. who performed or participated in the service
+	Actor *common.Reference `json:"actor"`
+
+	// Describes the type of performance or participation(e.g. primary surgeon, anaesthesiologiest, etc.)
+	Role *common.CodeableConcept `json:"role,omitempty"`
+}
@@ -0,0 +1,441 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Claim represents Claim, Pre-determination or Pre-authorization
+type Claim struct {
+	DomainResource
+
+	// Details of the event
+	Accident *ClaimAccident `json:"accident,omitempty"`
+
+	// Relevant time frame for the claim
+	BillablePeriod *common.Period `json:"billablePeriod,omitempty"`
+
+	// Members of the care team
+	CareTeam []ClaimCareTeam `json:"careTeam,omitempty"`
+
+	// Resource creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Pertinent diagnosis information
+	Diagnosis []ClaimDiagnosis `json:"diagnosis,omitempty"`
+
+	// The start and optional end dates of when the patient was precluded from working due to the treatable condition(s)
+	EmploymentImpacted *common.Period `json:"employmentImpacted,omitempty"`
+
+	// Author of the claim
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Servicing facility
+	Facility *common.Reference `json:"facility,omitempty"`
+
+	// For whom to reserve funds
+	FundsReserve *common.CodeableConcept `json:"fundsReserve,omitempty"`
+
+	// The start and optional end dates of when the patient was confined to a treatment center
+	Hospitalization *common.Period `json:"hospitalization,omitempty"`
+
+	// Business Identifier for claim
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Additional information codes regarding exceptions, special considerations, the condition, situation
+	Information []ClaimInformation `json:"information,omitempty"`
+
+	// Patient insurance information
+	Insurance []ClaimInsurance `json:"insurance,omitempty"`
+
+	// Target
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// Product or service provided
+	Item []ClaimItem `json:"item,omitempty"`
+
+	// The organization which is responsible for the bill, claim pre-determination, pre-authorization
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Original prescription if superseded by fulfiller
+	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
+
+	// The recipient of the products and services
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Recipient of benefits payable
+	Payee *ClaimPayee `json:"payee,omitempty"`
+
+	// Prescription authorizing services and products
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// Desired processing urgency
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// Clinical procedures performed
+	Procedure []ClaimProcedure `json:"procedure,omitempty"`
+
+	// Party responsible for the claim
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// Treatment referral
+	Referral *common.Reference `json:"referral,omitempty"`
+
+	// Prior or corollary claims
+	Related []ClaimRelated `json:"related,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *ClaimStatus    `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// More granular claim type
+	SubType []common.CodeableConcept `json:"subType,omitempty"`
+
+	// Total claim cost
+	Total *common.Money `json:"total,omitempty"`
+
+	// Category or discipline
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// claim | preauthorization | predetermination
+	Use        *ClaimUse       `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
+}
+
+// ClaimStatus represents the status of a claim
+type ClaimStatus string
+
+const (
+	ClaimStatusActive         ClaimStatus = "active"
+	ClaimStatusCancelled      ClaimStatus = "cancelled"
+	ClaimStatusDraft          ClaimStatus = "draft"
+	ClaimStatusEnteredInError ClaimStatus = "entered-in-error"
+)
+
+// ClaimUse represents the use of a claim
+type ClaimUse string
+
+const (
+	ClaimUseComplete    ClaimUse = "complete"
+	ClaimUseProposed    ClaimUse = "proposed"
+	ClaimUseExploratory ClaimUse = "exploratory"
+	ClaimUseOther       ClaimUse = "other"
+)
+
+// ClaimRelated represents prior or corollary claims
+type ClaimRelated struct {
+	common.BackboneElement
+
+	// Reference to the related claim
+	Claim *common.Reference `json:"claim,omitempty"`
+
+	// File or case reference
+	Reference *common.Identifier `json:"reference,omitempty"`
+
+	// How the reference claim is related
+	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
+}
+
+// ClaimPayee represents recipient of benefits payable
+type ClaimPayee struct {
+	common.BackboneElement
+
+	// Recipient reference
+	Party *common.Reference `json:"party,omitempty"`
+
+	// organization | patient | practitioner | relatedperson
+	ResourceType *common.Coding `json:"resourceType,omitempty"`
+
+	// Category of recipient
+	Type *common.CodeableConcept `json:"type"`
+}
+
+// ClaimCareTeam represents members of the care team
+type ClaimCareTeam struct {
+	common.BackboneElement
+
+	// Practitioner or organization
+	Provider *common.Reference `json:"provider"`
+
+	// The qualification which is applicable for this service
+	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
+
+	// Indicator of the lead practitioner
+	Responsible        *bool           `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
+
+	// Function within the team
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// Order of care team
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimInformation represents additional information codes regarding exceptions, special considerations, the condition, situation
+type ClaimInformation struct {
+	common.BackboneElement
+
+	// This may contain the local bill type codes such as the US UB-04 bill type code
+	Category *common.CodeableConcept `json:"category"`
+	Code     *common.CodeableConcept `json:"code,omitempty"`
+
+	// For example, provides the reason for: the additional stay
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// Sequence of the information element which serves to provide a link
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The date when or period to which this information refers
+	TimingDate        *common.Date    `json:"timingDate,omitempty"`
+	TimingDateElement *common.Element `json:"_timingDate,omitempty"`
+	TimingPeriod      *common.Period  `json:"timingPeriod,omitempty"`
+
+	// Additional data or information such as resources, documents
+	ValueString        *string           `json:"valueString,omitempty"`
+	ValueStringElement *common.Element   `json:"_valueString,omitempty"`
+	ValueQuantity      *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueAttachment    *Attachment       `json:"valueAttachment,omitempty"`
+	ValueReference     *common.Reference `json:"valueReference,omitempty"`
+}
+
+// ClaimDiagnosis represents pertinent diagnosis information
+type ClaimDiagnosis struct {
+	common.BackboneElement
+
+	// Nature of illness or problem
+	DiagnosisCodeableConcept *common.CodeableConcept `json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *common.Reference       `json:"diagnosisReference,omitempty"`
+
+	// The package billing code, for example DRG, based on the assigned grouping code system
+	PackageCode *common.CodeableConcept `json:"packageCode,omitempty"`
+
+	// Diagnosis instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// Timing or nature of the diagnosis
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClaimProcedure represents clinical procedures performed
+type ClaimProcedure struct {
+	common.BackboneElement
+
+	// When the procedure was performed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Specific clinical procedure
+	ProcedureCodeableConcept *common.CodeableConcept `json:"procedureCodeableConcept,omitempty"`
+	ProcedureReference       *common.Reference       `json:"procedureReference,omitempty"`
+
+	// Procedure instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimInsurance represents patient insurance information
+type ClaimInsurance struct {
+	common.BackboneElement
+
+	// Additional provider contract number
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
+
+	// Adjudication results
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	// Insurance information
+	Coverage *common.Reference `json:"coverage"`
+
+	// Coverage to be used for adjudication
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
+
+	// Prior authorization reference number
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+
+	// Insurance instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimAccident represents details of the event
+type ClaimAccident struct {
+	common.BackboneElement
+
+	// When the incident occurred
+	Date        common.Date     `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Where the event occurred
+	LocationAddress   *Address          `json:"locationAddress,omitempty"`
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	// The nature of the accident
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClaimItemDetailSubDetail represents product or service provided
+type ClaimItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// Benefit classification
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Price scaling factor
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Service/Product billing modifiers
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Total item cost
+	Net *common.Money `json:"net,omitempty"`
+
+	// Program the product or service is provided under
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// Count of products or services
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Revenue or cost center code
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// Item instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied (eg
+	Service *common.CodeableConcept `json:"service,omitempty"`
+
+	// Unique device identifier
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// Fee, charge or cost per item
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ClaimItemDetail represents product or service provided
+type ClaimItemDetail struct {
+	common.BackboneElement
+
+	// Benefit classification
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Price scaling factor
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Service/Product billing modifiers
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Total item cost
+	Net *common.Money `json:"net,omitempty"`
+
+	// Program the product or service is provided under
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// Count of products or services
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Revenue or cost center code
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// Item instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// If this is an actual service or product line, ie
+	Service *common.CodeableConcept `json:"service,omitempty"`
+
+	// Product or service provided
+	SubDetail []ClaimItemDetailSubDetail `json:"subDetail,omitempty"`
+
+	// Unique device identifier
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// Fee, charge or cost per item
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ClaimItem represents product or service provided
+type ClaimItem struct {
+	common.BackboneElement
+
+	// Anatomical location
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// CareTeam applicable for this service or product line
+	CareTeamLinkId        []int             `json:"careTeamLinkId,omitempty"`
+	CareTeamLinkIdElement []*common.Element `json:"_careTeamLinkId,omitempty"`
+
+	// Benefit classification
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Product or service provided
+	Detail []ClaimItemDetail `json:"detail,omitempty"`
+
+	// Diagnosis applicable for this service or product line
+	DiagnosisLinkId        []int             `json:"diagnosisLinkId,omitempty"`
+	DiagnosisLinkIdElement []*common.Element `json:"_diagnosisLinkId,omitempty"`
+
+	// Encounters associated with the listed treatments
+	Encounter []common.Reference `json:"encounter,omitempty"`
+
+	// Price scaling factor
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Exceptions, special conditions and supporting information pplicable for this service or product line
+	InformationLinkId        []int             `json:"informationLinkId,omitempty"`
+	InformationLinkIdElement []*common.Element `json:"_informationLinkId,omitempty"`
+
+	// Place of service or where product was supplied
+	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
+	LocationAddress         *Address                `json:"locationAddress,omitempty"`
+	LocationReference       *common.Reference       `json:"locationReference,omitempty"`
+
+	// Product or service billing modifiers
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Total item cost
+	Net *common.Money `json:"net,omitempty"`
+
+	// Procedures applicable for this service or product line
+	ProcedureLinkId        []int             `json:"procedureLinkId,omitempty"`
+	ProcedureLinkIdElement []*common.Element `json:"_procedureLinkId,omitempty"`
+
+	// Program the product or service is provided under
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// Count of products or services
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Revenue or cost center code
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// Item instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// If this is an actual service or product line, ie
+	Service *common.CodeableConcept `json:"service,omitempty"`
+
+	// Date or dates of service or product delivery
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period  `json:"servicedPeriod,omitempty"`
+
+	// A region or surface of the site, eg
+	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
+
+	// Unique device identifier
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// Fee, charge or cost per item
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
@@ -0,0 +1,315 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ClaimResponse represents response to a claim predetermination or preauthorization
+type ClaimResponse struct {
+	DomainResource
+
+	// Insurer added line items
+	AddItem []ClaimResponseAddItem `json:"addItem,omitempty"`
+
+	// Request for additional information
+	CommunicationRequest []common.Reference `json:"communicationRequest,omitempty"`
+
+	// Response creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Disposition Message
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Processing errors
+	Error []ClaimResponseError `json:"error,omitempty"`
+
+	// Printed reference or actual form
+	Form *common.CodeableConcept `json:"form,omitempty"`
+
+	// Business Identifier for a claim response
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Patient insurance information
+	Insurance []ClaimResponseInsurance `json:"insurance,omitempty"`
+
+	// Party responsible for reimbursement
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// Adjudication for claim line items
+	Item []ClaimResponseItem `json:"item,omitempty"`
+
+	// queued | complete | error | partial
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// The recipient of the products and services
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Party to be paid any benefits payable
+	PayeeType *common.CodeableConcept `json:"payeeType,omitempty"`
+
+	// Payment Details
+	Payment *ClaimResponsePayment `json:"payment,omitempty"`
+
+	// Note concerning adjudication
+	ProcessNote []ClaimResponseProcessNote `json:"processNote,omitempty"`
+
+	// Id of resource triggering adjudication
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// Status of funds reservation (For provider, for Patient, None)
+	Reserved *common.Coding `json:"reserved,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *ClaimResponseStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Total amount of benefit payable
+	TotalBenefit *common.Money `json:"totalBenefit,omitempty"`
+
+	// The total cost of the services reported
+	TotalCost *common.Money `json:"totalCost,omitempty"`
+
+	// The amount of deductible applied which was not allocated to any particular service line
+	UnallocDeductable *common.Money `json:"unallocDeductable,omitempty"`
+}
+
+// ClaimResponseStatus represents the status of a claim response
+type ClaimResponseStatus string
+
+const (
+	ClaimResponseStatusActive         ClaimResponseStatus = "active"
+	ClaimResponseStatusCancelled      ClaimResponseStatus = "cancelled"
+	ClaimResponseStatusDraft          ClaimResponseStatus = "draft"
+	ClaimResponseStatusEnteredInError ClaimResponseStatus = "entered-in-error"
+)
+
+// ClaimResponseItemAdjudication represents adjudication details
+type ClaimResponseItemAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// Type of adjudication information
+	Category *common.CodeableConcept `json:"category"`
+
+	// Explanation of adjudication outcome
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ClaimResponseItemDetailSubDetail represents adjudication for claim sub-details
+type ClaimResponseItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
+
+	// Applicable note numbers
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+}
+
+// ClaimResponseItemDetail represents adjudication for claim details
+type ClaimResponseItemDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
+
+	// Applicable note numbers
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// Adjudication for claim sub-details
+	SubDetail []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty"`
+}
+
+// ClaimResponseItem represents adjudication for claim line items
+type ClaimResponseItem struct {
+	common.BackboneElement
+
+	// Adjudication details
+	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
+
+	// Adjudication for claim details
+	Detail []ClaimResponseItemDetail `json:"detail,omitempty"`
+
+	// Applicable note numbers
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+}
+
+// ClaimResponseAddItemDetail represents insurer added line details
+type ClaimResponseAddItemDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The fee charged for the professional service or product.
+	Fee *common.Money `json:"fee,omitempty"`
+
+	// Service/Product billing modifiers
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Applicable note numbers
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// Revenue or cost center code
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied
+	Service *common.CodeableConcept `json:"service,omitempty"`
+}
+
+// ClaimResponseAddItem represents insurer added line items
+type ClaimResponseAddItem struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Insurer added line details
+	Detail []ClaimResponseAddItemDetail `json:"detail,omitempty"`
+
+	// The fee charged for the professional service or product.
+	Fee *common.Money `json:"fee,omitempty"`
+
+	// Service/Product billing modifiers
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Applicable note numbers
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// Revenue or cost center code
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// List of input service items which this service line is intended to replace
+	SequenceLinkId        []int             `json:"sequenceLinkId,omitempty"`
+	SequenceLinkIdElement []*common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied
+	Service *common.CodeableConcept `json:"service,omitempty"`
+}
+
+// ClaimResponseError represents processing errors
+type ClaimResponseError struct {
+	common.BackboneElement
+
+	// Error code detailing processing issues
+	Code *common.CodeableConcept `json:"code"`
+
+	// The sequence number of the addition within the line item submitted which contains the error
+	DetailSequenceLinkId        *int            `json:"detailSequenceLinkId,omitempty"`
+	DetailSequenceLinkIdElement *common.Element `json:"_detailSequenceLinkId,omitempty"`
+
+	// The sequence number of the line item submitted which contains the error
+	SequenceLinkId        *int            `json:"sequenceLinkId,omitempty"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// The sequence number of the addition within the line item submitted which contains the error
+	SubdetailSequenceLinkId        *int            `json:"subdetailSequenceLinkId,omitempty"`
+	SubdetailSequenceLinkIdElement *common.Element `json:"_subdetailSequenceLinkId,omitempty"`
+}
+
+// ClaimResponsePayment represents Payment Details
+type ClaimResponsePayment struct {
+	common.BackboneElement
+
+	// Payment adjustment for non-claim issues
+	Adjustment *common.Money `json:"adjustment,omitempty"`
+
+	// Explanation for the adjustment
+	AdjustmentReason *common.CodeableConcept `json:"adjustmentReason,omitempty"`
+
+	// Payable amount after adjustment
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// Expected date of payment
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Business identifier for the payment
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Partial or complete payment
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClaimResponseProcessNote represents note concerning adjudication
+type ClaimResponseProcessNote struct {
+	common.BackboneElement
+
+	// Language of the text
+	Language *common.CodeableConcept `json:"language,omitempty"`
+
+	// Note instance identifier
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// Note explanatory text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Note purpose
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClaimResponseInsurance represents patient insurance information
+type ClaimResponseInsurance struct {
+	common.BackboneElement
+
+	// Additional provider contract number
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
+
+	// Adjudication results
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	// Insurance information
+	Coverage *common.Reference `json:"coverage"`
+
+	// Coverage to be used for adjudication
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
+
+	// A list of references from the Insurer to which these services pertain
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+
+	// Insurance instance identifier
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
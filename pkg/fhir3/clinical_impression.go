@@ -0,0 +1,107 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ClinicalImpression represents a clinical assessment performed when planning treatments and management strategies for a patient
+type ClinicalImpression struct {
+	DomainResource
+
+	// Actions recommended as a result of the impression should not be captured using the 'action' element
+	Action []common.Reference `json:"action,omitempty"`
+
+	// The clinician performing the assessment
+	Assessor *common.Reference `json:"assessor,omitempty"`
+
+	// This is present as a place-holder only and may be removed based on feedback/work group opinion
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The encounter or episode of care this impression was created as part of
+	Context *common.Reference `json:"context,omitempty"`
+
+	// When the assessment was documented
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Why/how the assessment was performed
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Time of assessment
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+
+	// Possible or likely findings and diagnoses
+	Finding []ClinicalImpressionFinding `json:"finding,omitempty"`
+
+	// Business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// One or more sets of investigations (signs, symptions, etc.)
+	Investigation []ClinicalImpressionInvestigation `json:"investigation,omitempty"`
+
+	// Comments made about the ClinicalImpression
+	Note []Annotation `json:"note,omitempty"`
+
+	// Reference to last assessment
+	Previous *common.Reference `json:"previous,omitempty"`
+
+	// Relevant impressions of patient state
+	Problem []common.Reference `json:"problem,omitempty"`
+
+	// Estimate of likely outcome
+	PrognosisCodeableConcept []common.CodeableConcept `json:"prognosisCodeableConcept,omitempty"`
+
+	// RiskAssessment expressing likely outcome
+	PrognosisReference []common.Reference `json:"prognosisReference,omitempty"`
+
+	// Clinical Protocol followed
+	Protocol        []string          `json:"protocol,omitempty"`
+	ProtocolElement []*common.Element `json:"_protocol,omitempty"`
+
+	// preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
+	Status        ClinicalImpressionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// Patient or group assessed
+	Subject *common.Reference `json:"subject"`
+
+	// Summary of the assessment
+	Summary        *string         `json:"summary,omitempty"`
+	SummaryElement *common.Element `json:"_summary,omitempty"`
+}
+
+// ClinicalImpressionStatus represents the status of a clinical impression
+type ClinicalImpressionStatus string
+
+const (
+	ClinicalImpressionStatusDraft          ClinicalImpressionStatus = "draft"
+	ClinicalImpressionStatusCompleted      ClinicalImpressionStatus = "completed"
+	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
+)
+
+// ClinicalImpressionInvestigation represents one or more sets of investigations (signs, symptions, etc.)
+type ClinicalImpressionInvestigation struct {
+	common.BackboneElement
+
+	// A name/code for the group ("set") of investigations
+	Code *common.CodeableConcept `json:"code"`
+
+	// Most investigations are observations of one kind of or another but some other specific types of data collection resources can also be used
+	Item []common.Reference `json:"item,omitempty"`
+}
+
+// ClinicalImpressionFinding represents possible or likely findings and diagnoses
+type ClinicalImpressionFinding struct {
+	common.BackboneElement
+
+	// Which investigations support finding
+	Basis        *string         `json:"basis,omitempty"`
+	BasisElement *common.Element `json:"_basis,omitempty"`
+
+	// Specific text, code or reference for finding or diagnosis, which may include ruled-out or resolved conditions
+	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
+	ItemReference       *common.Reference       `json:"itemReference,omitempty"`
+}
@@ -0,0 +1,251 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CodeSystem declares the existence of and describes a code system or code system supplement
+type CodeSystem struct {
+	DomainResource
+
+	// If code comparison is case sensitive
+	CaseSensitive        *bool           `json:"caseSensitive,omitempty"`
+	CaseSensitiveElement *common.Element `json:"_caseSensitive,omitempty"`
+
+	// If code system defines a compositional grammar
+	Compositional        *bool           `json:"compositional,omitempty"`
+	CompositionalElement *common.Element `json:"_compositional,omitempty"`
+
+	// Concepts in the code system
+	Concept []CodeSystemConcept `json:"concept,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// not-present | example | fragment | complete | supplement
+	Content        CodeSystemContent `json:"content"`
+	ContentElement *common.Element   `json:"_content,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Total concepts in the code system
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the code system
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Filter that can be used in a value set
+	Filter []CodeSystemFilter `json:"filter,omitempty"`
+
+	// grouped-by | is-a | part-of | classified-with
+	HierarchyMeaning        *CodeSystemHierarchyMeaning `json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaningElement *common.Element             `json:"_hierarchyMeaning,omitempty"`
+
+	// Additional identifier for the code system (business identifier)
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for code system (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this code system (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Additional information supplied about each concept
+	Property []CodeSystemProperty `json:"property,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this code system is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        CodeSystemStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// Name for this code system (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this code system, represented as a URI (globally unique) (Coding.system)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Canonical reference to the value set with entire code system
+	ValueSet        *string         `json:"valueSet,omitempty"`
+	ValueSetElement *common.Element `json:"_valueSet,omitempty"`
+
+	// Business version of the code system (Coding.version)
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+
+	// If definitions are not stable
+	VersionNeeded        *bool           `json:"versionNeeded,omitempty"`
+	VersionNeededElement *common.Element `json:"_versionNeeded,omitempty"`
+}
+
+// CodeSystemContent represents the content of a code system
+type CodeSystemContent string
+
+const (
+	CodeSystemContentNotPresent CodeSystemContent = "not-present"
+	CodeSystemContentExample    CodeSystemContent = "example"
+	CodeSystemContentFragment   CodeSystemContent = "fragment"
+	CodeSystemContentComplete   CodeSystemContent = "complete"
+)
+
+// CodeSystemHierarchyMeaning represents the hierarchy meaning of a code system
+type CodeSystemHierarchyMeaning string
+
+const (
+	CodeSystemHierarchyMeaningGroupedBy      CodeSystemHierarchyMeaning = "grouped-by"
+	CodeSystemHierarchyMeaningIsA            CodeSystemHierarchyMeaning = "is-a"
+	CodeSystemHierarchyMeaningPartOf         CodeSystemHierarchyMeaning = "part-of"
+	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
+)
+
+// CodeSystemStatus represents the status of a code system
+type CodeSystemStatus string
+
+const (
+	CodeSystemStatusDraft   CodeSystemStatus = "draft"
+	CodeSystemStatusActive  CodeSystemStatus = "active"
+	CodeSystemStatusRetired CodeSystemStatus = "retired"
+	CodeSystemStatusUnknown CodeSystemStatus = "unknown"
+)
+
+// CodeSystemFilter represents filter that can be used in a value set
+type CodeSystemFilter struct {
+	common.BackboneElement
+
+	// Code that identifies the filter
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// How or why the filter is used
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
+	Operator        []string          `json:"operator"`
+	OperatorElement []*common.Element `json:"_operator,omitempty"`
+
+	// What to use for the value
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CodeSystemProperty represents additional information supplied about each concept
+type CodeSystemProperty struct {
+	common.BackboneElement
+
+	// Identifies the property on the concepts, and when referred to in operations
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Why the property is defined, and/or what it conveys
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// code | Coding | string | integer | boolean | dateTime | decimal
+	Type        CodeSystemPropertyType `json:"type"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
+
+	// Formal identifier for the property
+	URI        *string         `json:"uri,omitempty"`
+	URIElement *common.Element `json:"_uri,omitempty"`
+}
+
+// CodeSystemPropertyType represents the type of a code system property
+type CodeSystemPropertyType string
+
+const (
+	CodeSystemPropertyTypeCode     CodeSystemPropertyType = "code"
+	CodeSystemPropertyTypeCoding   CodeSystemPropertyType = "Coding"
+	CodeSystemPropertyTypeString   CodeSystemPropertyType = "string"
+	CodeSystemPropertyTypeInteger  CodeSystemPropertyType = "integer"
+	CodeSystemPropertyTypeBoolean  CodeSystemPropertyType = "boolean"
+	CodeSystemPropertyTypeDateTime CodeSystemPropertyType = "dateTime"
+)
+
+// CodeSystemConceptDesignation represents additional representations for the concept
+type CodeSystemConceptDesignation struct {
+	common.BackboneElement
+
+	// Human language of the designation
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// Details how this designation would be used
+	Use *common.Coding `json:"use,omitempty"`
+
+	// The text value for this designation
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CodeSystemConceptProperty represents property value for the concept
+type CodeSystemConceptProperty struct {
+	common.BackboneElement
+
+	// Reference to CodeSystem.property.code
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Value of the property for this concept
+	ValueCode            *string          `json:"valueCode,omitempty"`
+	ValueCodeElement     *common.Element  `json:"_valueCode,omitempty"`
+	ValueCoding          *common.Coding   `json:"valueCoding,omitempty"`
+	ValueString          *string          `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element  `json:"_valueString,omitempty"`
+	ValueInteger         *int             `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element  `json:"_valueInteger,omitempty"`
+	ValueBoolean         *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+}
+
+// CodeSystemConcept represents concepts in the code system
+type CodeSystemConcept struct {
+	common.BackboneElement
+
+	// Code that identifies concept
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Defines children of a concept to produce a hierarchy of concepts
+	Concept []CodeSystemConcept `json:"concept,omitempty"`
+
+	// Formal definition
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// Additional representations for the concept
+	Designation []CodeSystemConceptDesignation `json:"designation,omitempty"`
+
+	// Text to display to the user
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// Property value for the concept
+	Property []CodeSystemConceptProperty `json:"property,omitempty"`
+}
@@ -0,0 +1,110 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CommunicationRequest represents a request for information to be sent to a receiver
+type CommunicationRequest struct {
+	DomainResource
+
+	// When request transitioned to being actionable
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// Fulfills plan or proposal
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Message category
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// The encounter or episode of care within which the communication request was created
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Composite request this is part of
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	// Unique identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// A channel of communication
+	Medium []common.CodeableConcept `json:"medium,omitempty"`
+
+	// Comments made about communication request
+	Note []Annotation `json:"note,omitempty"`
+
+	// When scheduled
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+
+	// Message payload
+	Payload []CommunicationRequestPayload `json:"payload,omitempty"`
+
+	// routine | urgent | asap | stat
+	Priority        *string         `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// Textual reasons can be caprued using reasonCode.text
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Indicates another resource whose existence justifies this request
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// Who to share the information with
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// Request(s) replaced by this request
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	// Who asks for the information to be shared
+	Requester *CommunicationRequestRequester `json:"requester,omitempty"`
+
+	// The entity (e.g. person, organization, clinical information system
+	Sender *common.Reference `json:"sender,omitempty"`
+
+	// draft | active | on-hold | revoked | completed | entered-in-error | unknown
+	Status        CommunicationRequestStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
+
+	// Focus of message
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// The resources which were related to producing this communication request
+	Topic []common.Reference `json:"topic,omitempty"`
+}
+
+// CommunicationRequestStatus represents the status of a communication request
+type CommunicationRequestStatus string
+
+const (
+	CommunicationRequestStatusDraft          CommunicationRequestStatus = "draft"
+	CommunicationRequestStatusActive         CommunicationRequestStatus = "active"
+	CommunicationRequestStatusSuspended      CommunicationRequestStatus = "suspended"
+	CommunicationRequestStatusCancelled      CommunicationRequestStatus = "cancelled"
+	CommunicationRequestStatusCompleted      CommunicationRequestStatus = "completed"
+	CommunicationRequestStatusEnteredInError CommunicationRequestStatus = "entered-in-error"
+	CommunicationRequestStatusUnknown        CommunicationRequestStatus = "unknown"
+)
+
+// CommunicationRequestPayload represents message payload
+type CommunicationRequestPayload struct {
+	common.BackboneElement
+
+	// The communicated content (or for multi-part communications, one portion of the communication)
+	ContentString        *string           `json:"contentString,omitempty"`
+	ContentStringElement *common.Element   `json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference     *common.Reference `json:"contentReference,omitempty"`
+}
+
+// CommunicationRequestRequester represents the requester of a communication request
+type CommunicationRequestRequester struct {
+	common.BackboneElement
+
+	// The device, practitioner, etc. who initiated the request
+	Agent *common.Reference `json:"agent"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
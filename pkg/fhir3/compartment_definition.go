@@ -0,0 +1,104 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CompartmentDefinition represents Compartment Definition for a resource
+type CompartmentDefinition struct {
+	DomainResource
+
+	// Patient | Encounter | RelatedPerson | Practitioner | Device | EpisodeOfCare
+	Code        CompartmentDefinitionCode `json:"code"`
+	CodeElement *common.Element           `json:"_code,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the compartment definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// It may be possible for the compartment definition to be used in jurisdictions other than those for which it was originally designed or intended
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this compartment definition (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this compartment definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// How a resource is related to the compartment
+	Resource []CompartmentDefinitionResource `json:"resource,omitempty"`
+
+	// Whether the search syntax is supported
+	Search        bool            `json:"search"`
+	SearchElement *common.Element `json:"_search,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        CompartmentDefinitionStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
+
+	// Name for this compartment definition (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this compartment definition, represented as a URI (globally unique)
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+}
+
+// CompartmentDefinitionCode represents the code of a compartment definition
+type CompartmentDefinitionCode string
+
+const (
+	CompartmentDefinitionCodePatient       CompartmentDefinitionCode = "Patient"
+	CompartmentDefinitionCodeEncounter     CompartmentDefinitionCode = "Encounter"
+	CompartmentDefinitionCodeRelatedPerson CompartmentDefinitionCode = "RelatedPerson"
+	CompartmentDefinitionCodePractitioner  CompartmentDefinitionCode = "Practitioner"
+	CompartmentDefinitionCodeDevice        CompartmentDefinitionCode = "Device"
+)
+
+// CompartmentDefinitionStatus represents the status of a compartment definition
+type CompartmentDefinitionStatus string
+
+const (
+	CompartmentDefinitionStatusDraft   CompartmentDefinitionStatus = "draft"
+	CompartmentDefinitionStatusActive  CompartmentDefinitionStatus = "active"
+	CompartmentDefinitionStatusRetired CompartmentDefinitionStatus = "retired"
+	CompartmentDefinitionStatusUnknown CompartmentDefinitionStatus = "unknown"
+)
+
+// CompartmentDefinitionResource represents how a resource is related to the compartment
+type CompartmentDefinitionResource struct {
+	common.BackboneElement
+
+	// Name of resource type
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Additional documentation about the resource and compartment
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Search Parameter Name, or chained parameters
+	Param        []string          `json:"param,omitempty"`
+	ParamElement []*common.Element `json:"_param,omitempty"`
+}
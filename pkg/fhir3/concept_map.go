@@ -0,0 +1,226 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ConceptMap represents a map from one set of concepts to one or more other concepts
+type ConceptMap struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the concept map
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Same source and target systems
+	Group []ConceptMapGroup `json:"group,omitempty"`
+
+	// Additional identifier for the concept map
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for concept map (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this concept map (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this concept map is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Should be a version specific reference
+	SourceUri        *string           `json:"sourceUri,omitempty"`
+	SourceUriElement *common.Element   `json:"_sourceUri,omitempty"`
+	SourceReference  *common.Reference `json:"sourceReference,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ConceptMapStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// Should be a version specific reference
+	TargetUri        *string           `json:"targetUri,omitempty"`
+	TargetUriElement *common.Element   `json:"_targetUri,omitempty"`
+	TargetReference  *common.Reference `json:"targetReference,omitempty"`
+
+	// Name for this concept map (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this concept map, represented as a URI (globally unique)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the concept map
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ConceptMapStatus represents the status of a concept map
+type ConceptMapStatus string
+
+const (
+	ConceptMapStatusDraft   ConceptMapStatus = "draft"
+	ConceptMapStatusActive  ConceptMapStatus = "active"
+	ConceptMapStatusRetired ConceptMapStatus = "retired"
+	ConceptMapStatusUnknown ConceptMapStatus = "unknown"
+)
+
+// ConceptMapGroupElementTargetDependsOn represents other properties required for this mapping
+type ConceptMapGroupElementTargetDependsOn struct {
+	common.BackboneElement
+
+	// Identity (code or path) or the element/item/ValueSet that the map depends on / refers to
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// The display is ignored when processing the map
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// A reference to an element that holds a coded value that corresponds to a code system property
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+
+	// An absolute URI that identifies the code system of the dependency code
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+}
+
+// ConceptMapGroupElementTarget represents concept in target system for element
+type ConceptMapGroupElementTarget struct {
+	common.BackboneElement
+
+	// Code that identifies the target element
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Description of status/issues in mapping
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Other properties required for this mapping
+	DependsOn []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty"`
+
+	// Display for the code
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// This element is labeled as a modifier because it may indicate that a target does not apply
+	Equivalence        *ConceptMapGroupElementTargetEquivalence `json:"equivalence,omitempty"`
+	EquivalenceElement *common.Element                          `json:"_equivalence,omitempty"`
+
+	// A set of additional outcomes from this mapping to other elements
+	Product []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty"`
+}
+
+// ConceptMapGroupElementTargetEquivalence represents the equivalence of a concept map group element target
+type ConceptMapGroupElementTargetEquivalence string
+
+const (
+	ConceptMapGroupElementTargetEquivalenceRelatedto   ConceptMapGroupElementTargetEquivalence = "relatedto"
+	ConceptMapGroupElementTargetEquivalenceEquivalent  ConceptMapGroupElementTargetEquivalence = "equivalent"
+	ConceptMapGroupElementTargetEquivalenceEqual       ConceptMapGroupElementTargetEquivalence = "equal"
+	ConceptMapGroupElementTargetEquivalenceWider       ConceptMapGroupElementTargetEquivalence = "wider"
+	ConceptMapGroupElementTargetEquivalenceSubsumes    ConceptMapGroupElementTargetEquivalence = "subsumes"
+	ConceptMapGroupElementTargetEquivalenceNarrower    ConceptMapGroupElementTargetEquivalence = "narrower"
+	ConceptMapGroupElementTargetEquivalenceSpecializes ConceptMapGroupElementTargetEquivalence = "specializes"
+	ConceptMapGroupElementTargetEquivalenceInexact     ConceptMapGroupElementTargetEquivalence = "inexact"
+	ConceptMapGroupElementTargetEquivalenceUnmatched   ConceptMapGroupElementTargetEquivalence = "unmatched"
+	ConceptMapGroupElementTargetEquivalenceDisjoint    ConceptMapGroupElementTargetEquivalence = "disjoint"
+)
+
+// ConceptMapGroupElement represents mappings for a concept from the source set
+type ConceptMapGroupElement struct {
+	common.BackboneElement
+
+	// Identifies element being mapped
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Display for the code
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// Concept in target system for element
+	Target []ConceptMapGroupElementTarget `json:"target,omitempty"`
+}
+
+// ConceptMapGroupUnmapped represents what to do when there is no mapping target for the source concept and ConceptMap.group.element.noMap is not true
+type ConceptMapGroupUnmapped struct {
+	common.BackboneElement
+
+	// Fixed code when mode = fixed
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Display for the code
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// use-source-code | fixed | other-map
+	Mode        ConceptMapGroupUnmappedMode `json:"mode"`
+	ModeElement *common.Element             `json:"_mode,omitempty"`
+
+	// The canonical URL of the map to use if this map contains no mapping
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ConceptMapGroupUnmappedMode represents the mode of a concept map group unmapped
+type ConceptMapGroupUnmappedMode string
+
+const (
+	ConceptMapGroupUnmappedModeProvided ConceptMapGroupUnmappedMode = "provided"
+	ConceptMapGroupUnmappedModeFixed    ConceptMapGroupUnmappedMode = "fixed"
+	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
+)
+
+// ConceptMapGroup represents same source and target systems
+type ConceptMapGroup struct {
+	common.BackboneElement
+
+	// Mappings for a concept from the source set
+	Element []ConceptMapGroupElement `json:"element"`
+
+	// Source system where concepts to be mapped are defined
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// The specification of a particular code system version may be required for code systems which lack concept permanence
+	SourceVersion        *string         `json:"sourceVersion,omitempty"`
+	SourceVersionElement *common.Element `json:"_sourceVersion,omitempty"`
+
+	// Target system that the concepts are to be mapped to
+	Target        *string         `json:"target,omitempty"`
+	TargetElement *common.Element `json:"_target,omitempty"`
+
+	// The specification of a particular code system version may be required for code systems which lack concept permanence
+	TargetVersion        *string         `json:"targetVersion,omitempty"`
+	TargetVersionElement *common.Element `json:"_targetVersion,omitempty"`
+
+	// What to do when there is no mapping target for the source concept and ConceptMap.group.element.noMap is not true
+	Unmapped *ConceptMapGroupUnmapped `json:"unmapped,omitempty"`
+}
@@ -0,0 +1,127 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Coverage represents insurance or medical plan or a payment agreement
+type Coverage struct {
+	DomainResource
+
+	// Plan beneficiary
+	Beneficiary *common.Reference `json:"beneficiary,omitempty"`
+
+	// Contract details
+	Contract []common.Reference `json:"contract,omitempty"`
+
+	// Dependent number
+	Dependent        *string         `json:"dependent,omitempty"`
+	DependentElement *common.Element `json:"_dependent,omitempty"`
+
+	// A suite of underwrite specific classifiers, for example may be used to identify a class of coverage or employer group
+	Grouping *CoverageGrouping `json:"grouping,omitempty"`
+
+	// Business identifier(s) for this coverage
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Insurer network
+	Network        *string         `json:"network,omitempty"`
+	NetworkElement *common.Element `json:"_network,omitempty"`
+
+	// Relative order of the coverage
+	Order        *int            `json:"order,omitempty"`
+	OrderElement *common.Element `json:"_order,omitempty"`
+
+	// The program or plan underwriter or payor including both insurance and non-insurance agreements
+	Payor []common.Reference `json:"payor,omitempty"`
+
+	// Coverage start and end dates
+	Period *common.Period `json:"period,omitempty"`
+
+	// Owner of the policy
+	PolicyHolder *common.Reference `json:"policyHolder,omitempty"`
+
+	// Beneficiary relationship to the subscriber
+	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
+
+	// An optional counter for a particular instance of the identified coverage which increments upon each renewal
+	Sequence        *string         `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *CoverageStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Subscriber to the policy
+	Subscriber *common.Reference `json:"subscriber,omitempty"`
+
+	// ID assigned to the subscriber
+	SubscriberId        *string         `json:"subscriberId,omitempty"`
+	SubscriberIdElement *common.Element `json:"_subscriberId,omitempty"`
+
+	// Coverage category such as medical or accident
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// CoverageStatus represents the status of a coverage
+type CoverageStatus string
+
+const (
+	CoverageStatusActive         CoverageStatus = "active"
+	CoverageStatusCancelled      CoverageStatus = "cancelled"
+	CoverageStatusDraft          CoverageStatus = "draft"
+	CoverageStatusEnteredInError CoverageStatus = "entered-in-error"
+)
+
+// CoverageGrouping represents a suite of underwrite specific classifiers, for example may be used to identify a class of coverage or employer group
+type CoverageGrouping struct {
+	common.BackboneElement
+
+	// Identifies a style or collective of coverage issues by the underwriter
+	Class        *string         `json:"class,omitempty"`
+	ClassElement *common.Element `json:"_class,omitempty"`
+
+	// A short description for the class
+	ClassDisplay        *string         `json:"classDisplay,omitempty"`
+	ClassDisplayElement *common.Element `json:"_classDisplay,omitempty"`
+
+	// Identifies a style or collective of coverage issued by the underwriter
+	Group        *string         `json:"group,omitempty"`
+	GroupElement *common.Element `json:"_group,omitempty"`
+
+	// A short description for the group
+	GroupDisplay        *string         `json:"groupDisplay,omitempty"`
+	GroupDisplayElement *common.Element `json:"_groupDisplay,omitempty"`
+
+	// Identifies a style or collective of coverage issued by the underwriter
+	Plan        *string         `json:"plan,omitempty"`
+	PlanElement *common.Element `json:"_plan,omitempty"`
+
+	// A short description for the plan
+	PlanDisplay        *string         `json:"planDisplay,omitempty"`
+	PlanDisplayElement *common.Element `json:"_planDisplay,omitempty"`
+
+	// Identifies a sub-style or sub-collective of coverage issues by the underwriter
+	SubClass        *string         `json:"subClass,omitempty"`
+	SubClassElement *common.Element `json:"_subClass,omitempty"`
+
+	// A short description for the subclass
+	SubClassDisplay        *string         `json:"subClassDisplay,omitempty"`
+	SubClassDisplayElement *common.Element `json:"_subClassDisplay,omitempty"`
+
+	// Identifies a style or collective of coverage issued by the underwriter
+	SubGroup        *string         `json:"subGroup,omitempty"`
+	SubGroupElement *common.Element `json:"_subGroup,omitempty"`
+
+	// A short description for the subgroup
+	SubGroupDisplay        *string         `json:"subGroupDisplay,omitempty"`
+	SubGroupDisplayElement *common.Element `json:"_subGroupDisplay,omitempty"`
+
+	// Identifies a sub-style or sub-collective of coverage issued by the underwriter
+	SubPlan        *string         `json:"subPlan,omitempty"`
+	SubPlanElement *common.Element `json:"_subPlan,omitempty"`
+
+	// A short description for the subplan
+	SubPlanDisplay        *string         `json:"subPlanDisplay,omitempty"`
+	SubPlanDisplayElement *common.Element `json:"_subPlanDisplay,omitempty"`
+}
@@ -0,0 +1,111 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DataElement represents the formal description of a single piece of information that can be gathered and reported
+type DataElement struct {
+	DomainResource
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// null The copyright statement does not apply to values for the data element, only to its definition
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// For simple data types there will only be one repetition
+	Element []ElementDefinition `json:"element"`
+
+	// Allows filtering of data element that are appropriate for use vs
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type, e.g.
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// It may be possible for the data element to be used in jurisdictions other than those for which it was originally designed or intended
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Identifies a specification (other than a terminology) that the elements which make up the DataElement have some correspondence with
+	Mapping []DataElementMapping `json:"mapping,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Usually an organization, but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Allows filtering of data elements that are appropriate for use vs
+	Status        DataElementStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// Identifies how precise the data element is in its definition
+	Stringency        *DataElementStringency `json:"stringency,omitempty"`
+	StringencyElement *common.Element        `json:"_stringency,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid:, but real http: addresses are preferred
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation whether all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different data element instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// DataElementStatus represents the status of a data element
+type DataElementStatus string
+
+const (
+	DataElementStatusDraft   DataElementStatus = "draft"
+	DataElementStatusActive  DataElementStatus = "active"
+	DataElementStatusRetired DataElementStatus = "retired"
+	DataElementStatusUnknown DataElementStatus = "unknown"
+)
+
+// DataElementStringency represents the stringency of a data element
+type DataElementStringency string
+
+const (
+	DataElementStringencyComparable     DataElementStringency = "comparable"
+	DataElementStringencyFullySpecified DataElementStringency = "fully-specified"
+	DataElementStringencyEquivalent     DataElementStringency = "equivalent"
+	DataElementStringencyConvertable    DataElementStringency = "convertable"
+	DataElementStringencyScaleable      DataElementStringency = "scaleable"
+	DataElementStringencyFlexible       DataElementStringency = "flexible"
+)
+
+// DataElementMapping identifies a specification (other than a terminology) that the elements which make up the DataElement have some correspondence with
+type DataElementMapping struct {
+	common.BackboneElement
+
+	// Comments about this mapping, including version notes, issues, scope limitations, and other important notes for usage
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// The specification is described once, with general comments
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
+
+	// A name for the specification that is being mapped to
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A formal identity for the specification being mapped to helps with identifying maps consistently
+	URI        *string         `json:"uri,omitempty"`
+	URIElement *common.Element `json:"_uri,omitempty"`
+}
@@ -0,0 +1,86 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DetectedIssue represents clinical issue with action
+type DetectedIssue struct {
+	DomainResource
+
+	// The provider or device that identified the issue
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Type of detected issue, e.g. drug-drug, duplicate therapy, etc
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The date or date-time when the detected issue was initially identified
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Description and context
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
+
+	// Unique id for the detected issue
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Problem resource
+	Implicated []common.Reference `json:"implicated,omitempty"`
+
+	// Step taken to address
+	Mitigation []DetectedIssueMitigation `json:"mitigation,omitempty"`
+
+	// Indicates the patient whose record the detected issue is associated with
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Authority for issue
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+
+	// high | moderate | low
+	Severity        *DetectedIssueSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element        `json:"_severity,omitempty"`
+
+	// preliminary | final | entered-in-error | mitigated
+	Status        DetectedIssueStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+}
+
+// DetectedIssueSeverity represents the severity of a detected issue
+type DetectedIssueSeverity string
+
+const (
+	DetectedIssueSeverityHigh     DetectedIssueSeverity = "high"
+	DetectedIssueSeverityModerate DetectedIssueSeverity = "moderate"
+	DetectedIssueSeverityLow      DetectedIssueSeverity = "low"
+)
+
+// DetectedIssueStatus represents the status of a detected issue
+type DetectedIssueStatus string
+
+const (
+	DetectedIssueStatusRegistered     DetectedIssueStatus = "registered"
+	DetectedIssueStatusPreliminary    DetectedIssueStatus = "preliminary"
+	DetectedIssueStatusFinal          DetectedIssueStatus = "final"
+	DetectedIssueStatusAmended        DetectedIssueStatus = "amended"
+	DetectedIssueStatusCorrected      DetectedIssueStatus = "corrected"
+	DetectedIssueStatusCancelled      DetectedIssueStatus = "cancelled"
+	DetectedIssueStatusEnteredInError DetectedIssueStatus = "entered-in-error"
+	DetectedIssueStatusUnknown        DetectedIssueStatus = "unknown"
+)
+
+// DetectedIssueMitigation represents step taken to address
+type DetectedIssueMitigation struct {
+	common.BackboneElement
+
+	// What mitigation?
+	Action *common.CodeableConcept `json:"action"`
+
+	// Who is committing?
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Date committed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+}
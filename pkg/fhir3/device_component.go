@@ -0,0 +1,74 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceComponent represents the characteristics, operational status and capabilities of a medical-related component of a medical device
+type DeviceComponent struct {
+	DomainResource
+
+	// The locally assigned unique identification by the software
+	Identifier *common.Identifier `json:"identifier"`
+
+	// The language code for the human-readable text string produced by the device
+	LanguageCode *common.CodeableConcept `json:"languageCode,omitempty"`
+
+	// The timestamp for the most recent system change which includes device configuration or setting change
+	LastSystemChange        *common.Instant `json:"lastSystemChange,omitempty"`
+	LastSystemChangeElement *common.Element `json:"_lastSystemChange,omitempty"`
+
+	// The physical principle of the measurement
+	MeasurementPrinciple        *DeviceComponentMeasurementPrinciple `json:"measurementPrinciple,omitempty"`
+	MeasurementPrincipleElement *common.Element                      `json:"_measurementPrinciple,omitempty"`
+
+	// OperationalStatus for the MDS, VMD, or Channel will be bound to a specific ValueSet that is defined in its profile
+	OperationalStatus []common.CodeableConcept `json:"operationalStatus,omitempty"`
+
+	// The parameter group supported by the current device component that is based on some nomenclature, e.g. cardiovascular
+	ParameterGroup *common.CodeableConcept `json:"parameterGroup,omitempty"`
+
+	// The link to the parent resource
+	Parent *common.Reference `json:"parent,omitempty"`
+
+	// The production specification such as component revision, serial number, etc
+	ProductionSpecification []DeviceComponentProductionSpecification `json:"productionSpecification,omitempty"`
+
+	// The link to the source Device that contains administrative device information such as manufacture, serial number, etc
+	Source *common.Reference `json:"source,omitempty"`
+
+	// DeviceComponent.type can be referred to either GDMN
+	Type *common.CodeableConcept `json:"type"`
+}
+
+// DeviceComponentMeasurementPrinciple represents the measurement principle of a device component
+type DeviceComponentMeasurementPrinciple string
+
+const (
+	DeviceComponentMeasurementPrincipleOther      DeviceComponentMeasurementPrinciple = "other"
+	DeviceComponentMeasurementPrincipleChemical   DeviceComponentMeasurementPrinciple = "chemical"
+	DeviceComponentMeasurementPrincipleElectrical DeviceComponentMeasurementPrinciple = "electrical"
+	DeviceComponentMeasurementPrincipleImpedance  DeviceComponentMeasurementPrinciple = "impedance"
+	DeviceComponentMeasurementPrincipleNuclear    DeviceComponentMeasurementPrinciple = "nuclear"
+	DeviceComponentMeasurementPrincipleOptical    DeviceComponentMeasurementPrinciple = "optical"
+	DeviceComponentMeasurementPrincipleThermal    DeviceComponentMeasurementPrinciple = "thermal"
+	DeviceComponentMeasurementPrincipleBiological DeviceComponentMeasurementPrinciple = "biological"
+	DeviceComponentMeasurementPrincipleMechanical DeviceComponentMeasurementPrinciple = "mechanical"
+	DeviceComponentMeasurementPrincipleAcoustical DeviceComponentMeasurementPrinciple = "acoustical"
+	DeviceComponentMeasurementPrincipleManual     DeviceComponentMeasurementPrinciple = "manual"
+)
+
+// DeviceComponentProductionSpecification represents the production specification of a device component
+type DeviceComponentProductionSpecification struct {
+	common.BackboneElement
+
+	// The internal component unique identification
+	ComponentId *common.Identifier `json:"componentId,omitempty"`
+
+	// The printable string defining the component
+	ProductionSpec        *string         `json:"productionSpec,omitempty"`
+	ProductionSpecElement *common.Element `json:"_productionSpec,omitempty"`
+
+	// The specification type, such as, serial number, part number, hardware revision, software revision, etc
+	SpecType *common.CodeableConcept `json:"specType,omitempty"`
+}
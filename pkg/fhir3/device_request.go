@@ -0,0 +1,102 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceRequest represents medical device request
+type DeviceRequest struct {
+	DomainResource
+
+	// When recorded
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// What request fulfills
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// The details of the device to be used
+	CodeReference       *common.Reference       `json:"codeReference,omitempty"`
+	CodeCodeableConcept *common.CodeableConcept `json:"codeCodeableConcept,omitempty"`
+
+	// An encounter that provides additional context in which this request is made
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Protocol or definition followed by this request
+	Definition []common.Reference `json:"definition,omitempty"`
+
+	// Identifier of composite request
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	// External Request identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// proposal | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
+	Intent *common.CodeableConcept `json:"intent"`
+
+	// Notes or comments
+	Note []Annotation `json:"note,omitempty"`
+
+	// Desired time or schedule for use
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
+
+	// Requested Filler
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Desired type of performer for doing the diagnostic testing
+	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
+
+	// routine | urgent | asap | stat
+	Priority        *string         `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// The request takes the place of the referenced completed or terminated request(s)
+	PriorRequest []common.Reference `json:"priorRequest,omitempty"`
+
+	// Reason or justification for the use of this device
+	ReasonCode      []common.CodeableConcept `json:"reasonCode,omitempty"`
+	ReasonReference []common.Reference       `json:"reasonReference,omitempty"`
+
+	// Request provenance
+	RelevantHistory []common.Reference `json:"relevantHistory,omitempty"`
+
+	// Who/what submitted the device request
+	Requester *DeviceRequestRequester `json:"requester,omitempty"`
+
+	// draft | active | on-hold | revoked | completed | entered-in-error | unknown
+	Status        *DeviceRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Focus of request
+	Subject *common.Reference `json:"subject"`
+
+	// Additional clinical information
+	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
+}
+
+// DeviceRequestStatus represents the status of a device request
+type DeviceRequestStatus string
+
+const (
+	DeviceRequestStatusDraft          DeviceRequestStatus = "draft"
+	DeviceRequestStatusActive         DeviceRequestStatus = "active"
+	DeviceRequestStatusSuspended      DeviceRequestStatus = "suspended"
+	DeviceRequestStatusCancelled      DeviceRequestStatus = "cancelled"
+	DeviceRequestStatusCompleted      DeviceRequestStatus = "completed"
+	DeviceRequestStatusEnteredInError DeviceRequestStatus = "entered-in-error"
+	DeviceRequestStatusUnknown        DeviceRequestStatus = "unknown"
+)
+
+// DeviceRequestRequester represents the requester of a device request
+type DeviceRequestRequester struct {
+	common.BackboneElement
+
+	// The device, practitioner, etc. who initiated the request
+	Agent *common.Reference `json:"agent"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
@@ -0,0 +1,60 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceUseStatement represents a record of a device being used by a patient where the record is the result of a report from the patient or another clinician
+type DeviceUseStatement struct {
+	DomainResource
+
+	// Indicates the site on the subject's body where the device was used ( i.e. the target site)
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// The details of the device used
+	Device *common.Reference `json:"device"`
+
+	// An external identifier for this statement such as an IRI
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Reason or justification for the use of the device
+	Indication []common.CodeableConcept `json:"indication,omitempty"`
+
+	// Details about the device statement that were not represented at all or sufficiently in one of the attributes provided in a class
+	Note []Annotation `json:"note,omitempty"`
+
+	// The time at which the statement was made/recorded
+	RecordedOn        *common.DateTime `json:"recordedOn,omitempty"`
+	RecordedOnElement *common.Element  `json:"_recordedOn,omitempty"`
+
+	// Who reported the device was being used by the patient
+	Source *common.Reference `json:"source,omitempty"`
+
+	// DeviceUseStatment is a statement at a point in time
+	Status        DeviceUseStatementStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// The patient who used the device
+	Subject *common.Reference `json:"subject"`
+
+	// How often the device was used
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+
+	// The time period over which the device was used
+	WhenUsed *common.Period `json:"whenUsed,omitempty"`
+}
+
+// DeviceUseStatementStatus represents the status of a device use statement
+type DeviceUseStatementStatus string
+
+const (
+	DeviceUseStatementStatusActive         DeviceUseStatementStatus = "active"
+	DeviceUseStatementStatusCompleted      DeviceUseStatementStatus = "completed"
+	DeviceUseStatementStatusEnteredInError DeviceUseStatementStatus = "entered-in-error"
+	DeviceUseStatementStatusIntended       DeviceUseStatementStatus = "intended"
+	DeviceUseStatementStatusStopped        DeviceUseStatementStatus = "stopped"
+	DeviceUseStatementStatusOnHold         DeviceUseStatementStatus = "on-hold"
+)
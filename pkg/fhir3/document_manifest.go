@@ -0,0 +1,77 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DocumentManifest represents a collection of documents compiled for a purpose together with metadata that applies to the collection
+type DocumentManifest struct {
+	DomainResource
+
+	// Not necessarily who did the actual data entry (i.e. typist) or who was the source (informant)
+	Author []common.Reference `json:"author,omitempty"`
+
+	// The list of Documents included in the manifest
+	Content []DocumentManifestContent `json:"content"`
+
+	// Creation time is used for tracking, organizing versions and searching
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// What the document is about, rather than a terse summary of the document
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Other identifiers associated with the document manifest, including version independent identifiers
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// A single identifier that uniquely identifies this manifest
+	MasterIdentifier *common.Identifier `json:"masterIdentifier,omitempty"`
+
+	// How the recipient receives the document set or is notified of it is up to the implementation
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// May be identifiers or resources that caused the DocumentManifest to be created
+	Related []DocumentManifestRelated `json:"related,omitempty"`
+
+	// Identifies the source system, application, or software that produced the document manifest
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// This element is labeled as a modifier because the status contains the codes that mark the manifest as not currently valid
+	Status        DocumentManifestStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// Who or what the set of documents is about
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Specifies the kind of this set of documents (e.g. Patient Summary, Discharge Summary, Prescription, etc.)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// DocumentManifestStatus represents the status of a document manifest
+type DocumentManifestStatus string
+
+const (
+	DocumentManifestStatusCurrent        DocumentManifestStatus = "current"
+	DocumentManifestStatusSuperseded     DocumentManifestStatus = "superseded"
+	DocumentManifestStatusEnteredInError DocumentManifestStatus = "entered-in-error"
+)
+
+// DocumentManifestContent represents the content of a document manifest
+type DocumentManifestContent struct {
+	common.BackboneElement
+
+	// The intended focus of the DocumentManifest is for the reference to target either DocumentReference or Media Resources
+	PAttachment *Attachment       `json:"pAttachment,omitempty"`
+	PReference  *common.Reference `json:"pReference,omitempty"`
+}
+
+// DocumentManifestRelated represents may be identifiers or resources that caused the DocumentManifest to be created
+type DocumentManifestRelated struct {
+	common.BackboneElement
+
+	// If both identifier and ref elements are present they shall refer to the same thing
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+	Ref        *common.Reference  `json:"ref,omitempty"`
+}
@@ -0,0 +1,364 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir3
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// elementOrder lists the properties of each struct in the element order of FHIR XML
+var elementOrder = common.ElementOrder{
+	reflect.TypeOf(Account{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "name", "subject", "period", "active", "balance", "coverage", "owner", "description", "guarantor"},
+	reflect.TypeOf(AccountGuarantor{}):                                    {"id", "extension", "modifierExtension", "party", "onHold", "period"},
+	reflect.TypeOf(ActivityDefinition{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "description", "purpose", "usage", "approvalDate", "lastReviewDate", "effectivePeriod", "useContext", "jurisdiction", "topic", "contributor", "contact", "copyright", "relatedArtifact", "library", "kind", "code", "timingTiming", "timingDateTime", "timingPeriod", "timingRange", "location", "participant", "productReference", "productCodeableConcept", "quantity", "dosage", "bodySite", "transform", "dynamicValue"},
+	reflect.TypeOf(ActivityDefinitionDynamicValue{}):                      {"id", "extension", "modifierExtension", "description", "path", "language", "expression"},
+	reflect.TypeOf(ActivityDefinitionParticipant{}):                       {"id", "extension", "modifierExtension", "type", "role"},
+	reflect.TypeOf(Address{}):                                             {"id", "extension", "use", "type", "text", "line", "city", "district", "state", "postalCode", "country", "period"},
+	reflect.TypeOf(AdverseEvent{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "category", "type", "subject", "date", "reaction", "location", "seriousness", "outcome", "recorder", "eventParticipant", "description", "suspectEntity", "subjectMedicalHistory", "referenceDocument", "study"},
+	reflect.TypeOf(AdverseEventSuspectEntity{}):                           {"id", "extension", "modifierExtension", "instance", "causality", "causalityAssessment", "causalityProductRelatedness", "causalityMethod", "causalityAuthor", "causalityResult"},
+	reflect.TypeOf(Annotation{}):                                          {"id", "extension", "authorReference", "authorString", "time", "text"},
+	reflect.TypeOf(Appointment{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "serviceCategory", "serviceType", "specialty", "appointmentType", "reason", "indication", "priority", "description", "supportingInformation", "start", "end", "minutesDuration", "slot", "created", "comment", "incomingReferral", "participant", "requestedPeriod"},
+	reflect.TypeOf(AppointmentParticipant{}):                              {"id", "extension", "modifierExtension", "type", "actor", "required", "status"},
+	reflect.TypeOf(AppointmentResponse{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "appointment", "start", "end", "participantType", "actor", "participantStatus", "comment"},
+	reflect.TypeOf(Attachment{}):                                          {"id", "extension", "contentType", "language", "data", "url", "size", "hash", "title", "creation"},
+	reflect.TypeOf(AuditEvent{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "type", "subtype", "action", "recorded", "outcome", "outcomeDesc", "purposeOfEvent", "agent", "source", "entity"},
+	reflect.TypeOf(AuditEventAgent{}):                                     {"id", "extension", "modifierExtension", "role", "reference", "userId", "altId", "name", "requestor", "location", "policy", "media", "network", "purposeOfUse"},
+	reflect.TypeOf(AuditEventEntity{}):                                    {"id", "extension", "modifierExtension", "identifier", "reference", "type", "role", "lifecycle", "securityLabel", "name", "description", "query", "detail"},
+	reflect.TypeOf(AuditEventSource{}):                                    {"id", "extension", "modifierExtension", "site", "identifier", "type"},
+	reflect.TypeOf(Basic{}):                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "subject", "created", "author"},
+	reflect.TypeOf(Binary{}):                                              {"id", "meta", "implicitRules", "language", "contentType", "securityContext", "content"},
+	reflect.TypeOf(BodySite{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "code", "qualifier", "description", "image", "patient"},
+	reflect.TypeOf(Bundle{}):                                              {"id", "meta", "implicitRules", "language", "identifier", "type", "total", "link", "entry", "signature"},
+	reflect.TypeOf(BundleEntry{}):                                         {"id", "extension", "modifierExtension", "link", "fullUrl", "resource", "search", "request", "response"},
+	reflect.TypeOf(BundleEntryRequest{}):                                  {"id", "extension", "modifierExtension", "method", "url", "ifNoneMatch", "ifModifiedSince", "ifMatch", "ifNoneExist"},
+	reflect.TypeOf(BundleEntryResponse{}):                                 {"id", "extension", "modifierExtension", "status", "location", "etag", "lastModified", "outcome"},
+	reflect.TypeOf(CapabilityStatement{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "kind", "instantiates", "software", "implementation", "fhirVersion", "acceptUnknown", "format", "patchFormat", "implementationGuide", "profile", "rest", "messaging", "document"},
+	reflect.TypeOf(CapabilityStatementDocument{}):                         {"id", "extension", "modifierExtension", "mode", "documentation", "profile"},
+	reflect.TypeOf(CapabilityStatementMessaging{}):                        {"id", "extension", "modifierExtension", "endpoint", "reliableCache", "documentation", "supportedMessage", "event"},
+	reflect.TypeOf(CapabilityStatementMessagingEndpoint{}):                {"id", "extension", "modifierExtension", "protocol", "address"},
+	reflect.TypeOf(CapabilityStatementMessagingEvent{}):                   {"id", "extension", "modifierExtension", "code", "category", "mode", "focus", "request", "response", "documentation"},
+	reflect.TypeOf(CapabilityStatementMessagingSupportedMessage{}):        {"id", "extension", "modifierExtension", "mode", "definition"},
+	reflect.TypeOf(CapabilityStatementRest{}):                             {"id", "extension", "modifierExtension", "mode", "documentation", "security", "resource", "interaction", "searchParam", "operation", "compartment"},
+	reflect.TypeOf(CapabilityStatementRestOperation{}):                    {"id", "extension", "modifierExtension", "name", "definition"},
+	reflect.TypeOf(CapabilityStatementRestResource{}):                     {"id", "extension", "modifierExtension", "type", "profile", "documentation", "interaction", "versioning", "readHistory", "updateCreate", "conditionalCreate", "conditionalRead", "conditionalUpdate", "conditionalDelete", "referencePolicy", "searchInclude", "searchRevInclude", "searchParam"},
+	reflect.TypeOf(CapabilityStatementRestResourceSearchParam{}):          {"id", "extension", "modifierExtension", "name", "definition", "type", "documentation"},
+	reflect.TypeOf(CapabilityStatementRestSecurity{}):                     {"id", "extension", "modifierExtension", "cors", "service", "description", "certificate"},
+	reflect.TypeOf(CapabilityStatementRestSecurityCertificate{}):          {"id", "extension", "modifierExtension", "type", "blob"},
+	reflect.TypeOf(CapabilityStatementSoftware{}):                         {"id", "extension", "modifierExtension", "name", "version", "releaseDate"},
+	reflect.TypeOf(CareTeam{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "name", "subject", "context", "period", "participant", "reasonCode", "reasonReference", "managingOrganization", "note"},
+	reflect.TypeOf(CareTeamParticipant{}):                                 {"id", "extension", "modifierExtension", "role", "member", "onBehalfOf", "period"},
+	reflect.TypeOf(ChargeItem{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "status", "partOf", "code", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "participant", "performingOrganization", "requestingOrganization", "quantity", "bodysite", "factorOverride", "priceOverride", "overrideReason", "enterer", "enteredDate", "reason", "service", "account", "note", "supportingInformation"},
+	reflect.TypeOf(ChargeItemParticipant{}):                               {"id", "extension", "modifierExtension", "role", "actor"},
+	reflect.TypeOf(Claim{}):                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subType", "use", "patient", "billablePeriod", "created", "enterer", "insurer", "provider", "organization", "priority", "fundsReserve", "related", "prescription", "originalPrescription", "payee", "referral", "facility", "careTeam", "information", "diagnosis", "procedure", "insurance", "accident", "employmentImpacted", "hospitalization", "item", "total"},
+	reflect.TypeOf(ClaimAccident{}):                                       {"id", "extension", "modifierExtension", "date", "type", "locationAddress", "locationReference"},
+	reflect.TypeOf(ClaimCareTeam{}):                                       {"id", "extension", "modifierExtension", "sequence", "provider", "responsible", "role", "qualification"},
+	reflect.TypeOf(ClaimDiagnosis{}):                                      {"id", "extension", "modifierExtension", "sequence", "diagnosisCodeableConcept", "diagnosisReference", "type", "packageCode"},
+	reflect.TypeOf(ClaimInformation{}):                                    {"id", "extension", "modifierExtension", "sequence", "category", "code", "timingDate", "timingPeriod", "valueString", "valueQuantity", "valueAttachment", "valueReference", "reason"},
+	reflect.TypeOf(ClaimInsurance{}):                                      {"id", "extension", "modifierExtension", "sequence", "focal", "coverage", "businessArrangement", "preAuthRef", "claimResponse"},
+	reflect.TypeOf(ClaimItem{}):                                           {"id", "extension", "modifierExtension", "sequence", "careTeamLinkId", "diagnosisLinkId", "procedureLinkId", "informationLinkId", "revenue", "category", "service", "modifier", "programCode", "servicedDate", "servicedPeriod", "locationCodeableConcept", "locationAddress", "locationReference", "quantity", "unitPrice", "factor", "net", "udi", "bodySite", "subSite", "encounter", "detail"},
+	reflect.TypeOf(ClaimItemDetail{}):                                     {"id", "extension", "modifierExtension", "sequence", "revenue", "category", "service", "modifier", "programCode", "quantity", "unitPrice", "factor", "net", "udi", "subDetail"},
+	reflect.TypeOf(ClaimItemDetailSubDetail{}):                            {"id", "extension", "modifierExtension", "sequence", "revenue", "category", "service", "modifier", "programCode", "quantity", "unitPrice", "factor", "net", "udi"},
+	reflect.TypeOf(ClaimPayee{}):                                          {"id", "extension", "modifierExtension", "type", "resourceType", "party"},
+	reflect.TypeOf(ClaimProcedure{}):                                      {"id", "extension", "modifierExtension", "sequence", "date", "procedureCodeableConcept", "procedureReference"},
+	reflect.TypeOf(ClaimRelated{}):                                        {"id", "extension", "modifierExtension", "claim", "relationship", "reference"},
+	reflect.TypeOf(ClaimResponse{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "created", "insurer", "requestProvider", "requestOrganization", "request", "outcome", "disposition", "payeeType", "item", "addItem", "error", "totalCost", "unallocDeductable", "totalBenefit", "payment", "reserved", "form", "processNote", "communicationRequest", "insurance"},
+	reflect.TypeOf(ClaimResponseAddItem{}):                                {"id", "extension", "modifierExtension", "sequenceLinkId", "revenue", "category", "service", "modifier", "fee", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ClaimResponseAddItemDetail{}):                          {"id", "extension", "modifierExtension", "revenue", "category", "service", "modifier", "fee", "noteNumber", "adjudication"},
+	reflect.TypeOf(ClaimResponseError{}):                                  {"id", "extension", "modifierExtension", "sequenceLinkId", "detailSequenceLinkId", "subdetailSequenceLinkId", "code"},
+	reflect.TypeOf(ClaimResponseInsurance{}):                              {"id", "extension", "modifierExtension", "sequence", "focal", "coverage", "businessArrangement", "preAuthRef", "claimResponse"},
+	reflect.TypeOf(ClaimResponseItem{}):                                   {"id", "extension", "modifierExtension", "sequenceLinkId", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ClaimResponseItemAdjudication{}):                       {"id", "extension", "modifierExtension", "category", "reason", "amount", "value"},
+	reflect.TypeOf(ClaimResponseItemDetail{}):                             {"id", "extension", "modifierExtension", "sequenceLinkId", "noteNumber", "adjudication", "subDetail"},
+	reflect.TypeOf(ClaimResponseItemDetailSubDetail{}):                    {"id", "extension", "modifierExtension", "sequenceLinkId", "noteNumber", "adjudication"},
+	reflect.TypeOf(ClaimResponsePayment{}):                                {"id", "extension", "modifierExtension", "type", "adjustment", "adjustmentReason", "date", "amount", "identifier"},
+	reflect.TypeOf(ClaimResponseProcessNote{}):                            {"id", "extension", "modifierExtension", "number", "type", "text", "language"},
+	reflect.TypeOf(ClinicalImpression{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "code", "description", "subject", "context", "effectiveDateTime", "effectivePeriod", "date", "assessor", "previous", "problem", "investigation", "protocol", "summary", "finding", "prognosisCodeableConcept", "prognosisReference", "action", "note"},
+	reflect.TypeOf(ClinicalImpressionFinding{}):                           {"id", "extension", "modifierExtension", "itemCodeableConcept", "itemReference", "basis"},
+	reflect.TypeOf(CodeSystem{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "caseSensitive", "valueSet", "hierarchyMeaning", "compositional", "versionNeeded", "content", "count", "filter", "property", "concept"},
+	reflect.TypeOf(CodeSystemConcept{}):                                   {"id", "extension", "modifierExtension", "code", "display", "definition", "designation", "property", "concept"},
+	reflect.TypeOf(CodeSystemProperty{}):                                  {"id", "extension", "modifierExtension", "code", "uri", "description", "type"},
+	reflect.TypeOf(Communication{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "partOf", "status", "notDone", "notDoneReason", "category", "medium", "subject", "recipient", "context", "sent", "received", "sender", "reasonCode", "reasonReference", "payload", "note"},
+	reflect.TypeOf(CommunicationRequest{}):                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "replaces", "groupIdentifier", "status", "category", "priority", "medium", "subject", "recipient", "topic", "context", "payload", "occurrenceDateTime", "occurrencePeriod", "authoredOn", "sender", "requester", "reasonCode", "reasonReference", "note"},
+	reflect.TypeOf(CompartmentDefinition{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "purpose", "useContext", "jurisdiction", "code", "search", "resource"},
+	reflect.TypeOf(CompartmentDefinitionResource{}):                       {"id", "extension", "modifierExtension", "code", "param", "documentation"},
+	reflect.TypeOf(Composition{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "class", "subject", "encounter", "date", "author", "title", "confidentiality", "attester", "custodian", "section"},
+	reflect.TypeOf(CompositionAttester{}):                                 {"id", "extension", "modifierExtension", "mode", "time", "party"},
+	reflect.TypeOf(CompositionSection{}):                                  {"id", "extension", "modifierExtension", "title", "code", "text", "mode", "orderedBy", "entry", "emptyReason", "section"},
+	reflect.TypeOf(ConceptMap{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "sourceUri", "sourceReference", "targetUri", "targetReference", "group"},
+	reflect.TypeOf(ConceptMapGroup{}):                                     {"id", "extension", "modifierExtension", "source", "sourceVersion", "target", "targetVersion", "element", "unmapped"},
+	reflect.TypeOf(ConceptMapGroupElementTarget{}):                        {"id", "extension", "modifierExtension", "code", "display", "equivalence", "comment", "dependsOn", "product"},
+	reflect.TypeOf(ConceptMapGroupElementTargetDependsOn{}):               {"id", "extension", "modifierExtension", "property", "system", "code", "display"},
+	reflect.TypeOf(ConceptMapGroupUnmapped{}):                             {"id", "extension", "modifierExtension", "mode", "code", "display", "url"},
+	reflect.TypeOf(Condition{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "clinicalStatus", "verificationStatus", "category", "severity", "code", "bodySite", "subject", "context", "onsetDateTime", "onsetAge", "onsetPeriod", "onsetRange", "onsetString", "abatementDateTime", "abatementAge", "abatementPeriod", "abatementRange", "abatementString", "asserter", "stage", "evidence", "note"},
+	reflect.TypeOf(ConditionStage{}):                                      {"id", "extension", "modifierExtension", "summary", "assessment"},
+	reflect.TypeOf(Consent{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "patient", "period", "dateTime", "consentingParty", "actor", "action", "organization", "sourceAttachment", "sourceIdentifier", "sourceReference", "policy", "policyRule", "securityLabel", "purpose", "dataPeriod", "data", "except"},
+	reflect.TypeOf(ConsentActor{}):                                        {"id", "extension", "modifierExtension", "role", "reference"},
+	reflect.TypeOf(ConsentExcept{}):                                       {"id", "extension", "modifierExtension", "type", "period", "actor", "action", "securityLabel", "purpose", "class", "code", "dataPeriod", "data"},
+	reflect.TypeOf(ConsentExceptActor{}):                                  {"id", "extension", "modifierExtension", "role", "reference"},
+	reflect.TypeOf(ContactPoint{}):                                        {"id", "extension", "system", "value", "use", "rank", "period"},
+	reflect.TypeOf(Contract{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "issued", "applies", "subject", "topic", "authority", "domain", "type", "subType", "action", "actionReason", "decisionType", "contentDerivative", "securityLabel", "agent", "signer", "valuedItem", "term", "bindingAttachment", "bindingReference", "friendly", "legal", "rule"},
+	reflect.TypeOf(ContractSigner{}):                                      {"id", "extension", "modifierExtension", "type", "party", "signature"},
+	reflect.TypeOf(ContractTerm{}):                                        {"id", "extension", "modifierExtension", "identifier", "issued", "applies", "type", "subType", "topic", "action", "actionReason", "securityLabel", "agent", "text", "valuedItem", "group"},
+	reflect.TypeOf(ContractTermValuedItem{}):                              {"id", "extension", "modifierExtension", "entityCodeableConcept", "entityReference", "identifier", "effectiveTime", "quantity", "unitPrice", "factor", "points", "net"},
+	reflect.TypeOf(ContractValuedItem{}):                                  {"id", "extension", "modifierExtension", "entityCodeableConcept", "entityReference", "identifier", "effectiveTime", "quantity", "unitPrice", "factor", "points", "net"},
+	reflect.TypeOf(Contributor{}):                                         {"id", "extension", "type", "name", "contact"},
+	reflect.TypeOf(Coverage{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "policyHolder", "subscriber", "subscriberId", "beneficiary", "relationship", "period", "payor", "grouping", "dependent", "sequence", "order", "network", "contract"},
+	reflect.TypeOf(CoverageGrouping{}):                                    {"id", "extension", "modifierExtension", "group", "groupDisplay", "subGroup", "subGroupDisplay", "plan", "planDisplay", "subPlan", "subPlanDisplay", "class", "classDisplay", "subClass", "subClassDisplay"},
+	reflect.TypeOf(DataElement{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "status", "experimental", "date", "publisher", "name", "title", "contact", "useContext", "jurisdiction", "copyright", "stringency", "mapping", "element"},
+	reflect.TypeOf(DataElementMapping{}):                                  {"id", "extension", "modifierExtension", "identity", "uri", "name", "comment"},
+	reflect.TypeOf(DetectedIssue{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "severity", "patient", "date", "author", "implicated", "detail", "reference", "mitigation"},
+	reflect.TypeOf(DetectedIssueMitigation{}):                             {"id", "extension", "modifierExtension", "action", "date", "author"},
+	reflect.TypeOf(Device{}):                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "udi", "status", "type", "lotNumber", "manufacturer", "manufactureDate", "expirationDate", "model", "version", "patient", "owner", "contact", "location", "url", "note", "safety"},
+	reflect.TypeOf(DeviceComponent{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "lastSystemChange", "source", "parent", "operationalStatus", "parameterGroup", "measurementPrinciple", "productionSpecification", "languageCode"},
+	reflect.TypeOf(DeviceComponentProductionSpecification{}):              {"id", "extension", "modifierExtension", "specType", "componentId", "productionSpec"},
+	reflect.TypeOf(DeviceMetric{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "unit", "source", "parent", "operationalStatus", "color", "category", "measurementPeriod", "calibration"},
+	reflect.TypeOf(DeviceMetricCalibration{}):                             {"id", "extension", "modifierExtension", "type", "state", "time"},
+	reflect.TypeOf(DeviceRequest{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "priorRequest", "groupIdentifier", "status", "intent", "priority", "codeReference", "codeCodeableConcept", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "authoredOn", "requester", "performerType", "performer", "reasonCode", "reasonReference", "supportingInfo", "note", "relevantHistory"},
+	reflect.TypeOf(DeviceUdi{}):                                           {"id", "extension", "modifierExtension", "deviceIdentifier", "name", "jurisdiction", "carrierHRF", "carrierAIDC", "issuer"},
+	reflect.TypeOf(DeviceUseStatement{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "subject", "whenUsed", "timingTiming", "timingPeriod", "timingDateTime", "recordedOn", "source", "device", "indication", "bodySite", "note"},
+	reflect.TypeOf(DiagnosticReport{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "category", "code", "subject", "context", "effectiveDateTime", "effectivePeriod", "issued", "performer", "specimen", "result", "imagingStudy", "image", "conclusion", "codedDiagnosis", "presentedForm"},
+	reflect.TypeOf(DiagnosticReportPerformer{}):                           {"id", "extension", "modifierExtension", "role", "actor"},
+	reflect.TypeOf(DocumentManifest{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "masterIdentifier", "identifier", "status", "type", "subject", "created", "author", "recipient", "source", "description", "content", "related"},
+	reflect.TypeOf(DocumentReference{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "class", "subject", "created", "indexed", "author", "authenticator", "custodian", "description", "securityLabel", "content", "context"},
+	reflect.TypeOf(DocumentReferenceContext{}):                            {"id", "extension", "modifierExtension", "encounter", "event", "period", "facilityType", "practiceSetting", "sourcePatientInfo", "related"},
+	reflect.TypeOf(Dosage{}):                                              {"id", "extension", "modifierExtension", "sequence", "text", "additionalInstruction", "patientInstruction", "timing", "asNeededBoolean", "asNeededCodeableConcept", "site", "route", "method", "doseQuantity", "doseRange", "maxDosePerPeriod", "maxDosePerAdministration", "maxDosePerLifetime", "rateQuantity", "rateRange", "rateRatio"},
+	reflect.TypeOf(ElementDefinition{}):                                   {"id", "extension", "path", "representation", "sliceName", "label", "code", "slicing", "short", "definition", "comment", "requirements", "alias", "min", "max", "base", "contentReference", "type", "defaultValueBase64Binary", "defaultValueBoolean", "defaultValueCode", "defaultValueDate", "defaultValueDateTime", "defaultValueDecimal", "defaultValueId", "defaultValueInstant", "defaultValueInteger", "defaultValueMarkdown", "defaultValueOid", "defaultValuePositiveInt", "defaultValueString", "defaultValueTime", "defaultValueUnsignedInt", "defaultValueUri", "defaultValueAddress", "defaultValueAge", "defaultValueAnnotation", "defaultValueAttachment", "defaultValueCodeableConcept", "defaultValueCoding", "defaultValueContactPoint", "defaultValueCount", "defaultValueDistance", "defaultValueDuration", "defaultValueHumanName", "defaultValueIdentifier", "defaultValueMoney", "defaultValuePeriod", "defaultValueQuantity", "defaultValueRange", "defaultValueRatio", "defaultValueReference", "defaultValueSampledData", "defaultValueSignature", "defaultValueTiming", "defaultValueMeta", "meaningWhenMissing", "orderMeaning", "fixedBase64Binary", "fixedBoolean", "fixedCode", "fixedDate", "fixedDateTime", "fixedDecimal", "fixedId", "fixedInstant", "fixedInteger", "fixedMarkdown", "fixedOid", "fixedPositiveInt", "fixedString", "fixedTime", "fixedUnsignedInt", "fixedUri", "fixedAddress", "fixedAge", "fixedAnnotation", "fixedAttachment", "fixedCodeableConcept", "fixedCoding", "fixedContactPoint", "fixedCount", "fixedDistance", "fixedDuration", "fixedHumanName", "fixedIdentifier", "fixedMoney", "fixedPeriod", "fixedQuantity", "fixedRange", "fixedRatio", "fixedReference", "fixedSampledData", "fixedSignature", "fixedTiming", "fixedMeta", "patternBase64Binary", "patternBoolean", "patternCode", "patternDate", "patternDateTime", "patternDecimal", "patternId", "patternInstant", "patternInteger", "patternMarkdown", "patternOid", "patternPositiveInt", "patternString", "patternTime", "patternUnsignedInt", "patternUri", "patternAddress", "patternAge", "patternAnnotation", "patternAttachment", "patternCodeableConcept", "patternCoding", "patternContactPoint", "patternCount", "patternDistance", "patternDuration", "patternHumanName", "patternIdentifier", "patternMoney", "patternPeriod", "patternQuantity", "patternRange", "patternRatio", "patternReference", "patternSampledData", "patternSignature", "patternTiming", "patternMeta", "example", "minValueDate", "minValueDateTime", "minValueInstant", "minValueTime", "minValueDecimal", "minValueInteger", "minValuePositiveInt", "minValueUnsignedInt", "minValueQuantity", "maxValueDate", "maxValueDateTime", "maxValueInstant", "maxValueTime", "maxValueDecimal", "maxValueInteger", "maxValuePositiveInt", "maxValueUnsignedInt", "maxValueQuantity", "maxLength", "condition", "constraint", "mustSupport", "isModifier", "isSummary", "binding", "mapping"},
+	reflect.TypeOf(ElementDefinitionBase{}):                               {"id", "extension", "path", "min", "max"},
+	reflect.TypeOf(ElementDefinitionBinding{}):                            {"id", "extension", "strength", "description", "valueSetUri", "valueSetReference"},
+	reflect.TypeOf(ElementDefinitionConstraint{}):                         {"id", "extension", "key", "requirements", "severity", "human", "expression", "xpath", "source"},
+	reflect.TypeOf(ElementDefinitionMapping{}):                            {"id", "extension", "identity", "language", "map", "comment"},
+	reflect.TypeOf(ElementDefinitionSlicing{}):                            {"id", "extension", "discriminator", "description", "ordered", "rules"},
+	reflect.TypeOf(ElementDefinitionSlicingDiscriminator{}):               {"id", "extension", "type", "path"},
+	reflect.TypeOf(ElementDefinitionType{}):                               {"id", "extension", "code", "profile", "targetProfile", "aggregation", "versioning"},
+	reflect.TypeOf(EligibilityRequest{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "priority", "patient", "servicedDate", "servicedPeriod", "created", "enterer", "provider", "organization", "insurer", "facility", "coverage", "businessArrangement", "benefitCategory", "benefitSubCategory"},
+	reflect.TypeOf(EligibilityResponse{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "requestProvider", "requestOrganization", "request", "outcome", "disposition", "insurer", "inforce", "insurance", "form", "error"},
+	reflect.TypeOf(EligibilityResponseInsurance{}):                        {"id", "extension", "modifierExtension", "coverage", "contract", "benefitBalance"},
+	reflect.TypeOf(EligibilityResponseInsuranceBenefitBalance{}):          {"id", "extension", "modifierExtension", "category", "subCategory", "excluded", "name", "description", "network", "unit", "term", "financial"},
+	reflect.TypeOf(EligibilityResponseInsuranceBenefitBalanceFinancial{}): {"id", "extension", "modifierExtension", "type", "allowedUnsignedInt", "allowedString", "allowedMoney", "usedUnsignedInt", "usedMoney"},
+	reflect.TypeOf(Encounter{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "class", "classHistory", "type", "priority", "subject", "episodeOfCare", "incomingReferral", "participant", "appointment", "period", "length", "reason", "diagnosis", "account", "hospitalization", "location", "serviceProvider", "partOf"},
+	reflect.TypeOf(EncounterDiagnosis{}):                                  {"id", "extension", "modifierExtension", "condition", "role", "rank"},
+	reflect.TypeOf(EncounterHospitalization{}):                            {"id", "extension", "modifierExtension", "preAdmissionIdentifier", "origin", "admitSource", "reAdmission", "dietPreference", "specialCourtesy", "specialArrangement", "destination", "dischargeDisposition"},
+	reflect.TypeOf(EncounterLocation{}):                                   {"id", "extension", "modifierExtension", "location", "status", "period"},
+	reflect.TypeOf(EncounterParticipant{}):                                {"id", "extension", "modifierExtension", "type", "period", "individual"},
+	reflect.TypeOf(EncounterStatusHistory{}):                              {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(Endpoint{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "connectionType", "name", "managingOrganization", "contact", "period", "payloadType", "payloadMimeType", "address", "header"},
+	reflect.TypeOf(EnrollmentRequest{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "insurer", "provider", "organization", "subject", "coverage"},
+	reflect.TypeOf(EnrollmentResponse{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "request", "outcome", "disposition", "created", "organization", "requestProvider", "requestOrganization"},
+	reflect.TypeOf(EpisodeOfCare{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "type", "diagnosis", "patient", "managingOrganization", "period", "referralRequest", "careManager", "team", "account"},
+	reflect.TypeOf(EpisodeOfCareDiagnosis{}):                              {"id", "extension", "modifierExtension", "condition", "role", "rank"},
+	reflect.TypeOf(EpisodeOfCareStatusHistory{}):                          {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(ExpansionProfile{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "fixedVersion", "excludedSystem", "includeDesignations", "designation", "includeDefinition", "activeOnly", "excludeNested", "excludeNotForUI", "excludePostCoordinated", "displayLanguage", "limitedExpansion"},
+	reflect.TypeOf(ExpansionProfileDesignation{}):                         {"id", "extension", "modifierExtension", "include", "exclude"},
+	reflect.TypeOf(ExpansionProfileFixedVersion{}):                        {"id", "extension", "modifierExtension", "system", "version", "mode"},
+	reflect.TypeOf(ExplanationOfBenefit{}):                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subType", "patient", "billablePeriod", "created", "enterer", "insurer", "provider", "organization", "referral", "facility", "claim", "claimResponse", "outcome", "disposition", "related", "prescription", "originalPrescription", "payee", "information", "careTeam", "diagnosis", "procedure", "precedence", "insurance", "accident", "employmentImpacted", "hospitalization", "item", "addItem", "totalCost", "unallocDeductable", "totalBenefit", "payment", "form", "processNote", "benefitBalance"},
+	reflect.TypeOf(ExplanationOfBenefitAccident{}):                        {"id", "extension", "modifierExtension", "date", "type", "locationAddress", "locationReference"},
+	reflect.TypeOf(ExplanationOfBenefitAddItem{}):                         {"id", "extension", "modifierExtension", "sequenceLinkId", "revenue", "category", "service", "modifier", "fee", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ExplanationOfBenefitAddItemDetail{}):                   {"id", "extension", "modifierExtension", "revenue", "category", "service", "modifier", "fee", "noteNumber", "adjudication"},
+	reflect.TypeOf(ExplanationOfBenefitBenefitBalance{}):                  {"id", "extension", "modifierExtension", "category", "subCategory", "excluded", "name", "description", "network", "unit", "term", "financial"},
+	reflect.TypeOf(ExplanationOfBenefitBenefitBalanceFinancial{}):         {"id", "extension", "modifierExtension", "type", "allowedUnsignedInt", "allowedString", "allowedMoney", "usedUnsignedInt", "usedMoney"},
+	reflect.TypeOf(ExplanationOfBenefitCareTeam{}):                        {"id", "extension", "modifierExtension", "sequence", "provider", "responsible", "role", "qualification"},
+	reflect.TypeOf(ExplanationOfBenefitDiagnosis{}):                       {"id", "extension", "modifierExtension", "sequence", "diagnosisCodeableConcept", "diagnosisReference", "type", "packageCode"},
+	reflect.TypeOf(ExplanationOfBenefitInformation{}):                     {"id", "extension", "modifierExtension", "sequence", "category", "code", "timingDate", "timingPeriod", "valueString", "valueQuantity", "valueAttachment", "valueReference", "reason"},
+	reflect.TypeOf(ExplanationOfBenefitItem{}):                            {"id", "extension", "modifierExtension", "sequence", "careTeamLinkId", "diagnosisLinkId", "procedureLinkId", "informationLinkId", "revenue", "category", "service", "modifier", "programCode", "servicedDate", "servicedPeriod", "locationCodeableConcept", "locationAddress", "locationReference", "quantity", "unitPrice", "factor", "net", "udi", "bodySite", "subSite", "encounter", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ExplanationOfBenefitItemAdjudication{}):                {"id", "extension", "modifierExtension", "category", "reason", "amount", "value"},
+	reflect.TypeOf(ExplanationOfBenefitItemDetail{}):                      {"id", "extension", "modifierExtension", "sequence", "type", "revenue", "category", "service", "modifier", "programCode", "quantity", "unitPrice", "factor", "net", "udi", "noteNumber", "adjudication", "subDetail"},
+	reflect.TypeOf(ExplanationOfBenefitItemDetailSubDetail{}):             {"id", "extension", "modifierExtension", "sequence", "type", "revenue", "category", "service", "modifier", "programCode", "quantity", "unitPrice", "factor", "net", "udi", "noteNumber", "adjudication"},
+	reflect.TypeOf(ExplanationOfBenefitPayee{}):                           {"id", "extension", "modifierExtension", "type", "resourceType", "party"},
+	reflect.TypeOf(ExplanationOfBenefitPayment{}):                         {"id", "extension", "modifierExtension", "type", "adjustment", "adjustmentReason", "date", "amount", "identifier"},
+	reflect.TypeOf(ExplanationOfBenefitProcedure{}):                       {"id", "extension", "modifierExtension", "sequence", "date", "procedureCodeableConcept", "procedureReference"},
+	reflect.TypeOf(ExplanationOfBenefitProcessNote{}):                     {"id", "extension", "modifierExtension", "number", "type", "text", "language"},
+	reflect.TypeOf(ExplanationOfBenefitRelated{}):                         {"id", "extension", "modifierExtension", "claim", "relationship", "reference"},
+	reflect.TypeOf(FamilyMemberHistory{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "status", "notDone", "notDoneReason", "patient", "date", "name", "relationship", "gender", "bornPeriod", "bornDate", "bornString", "ageAge", "ageRange", "ageString", "estimatedAge", "deceasedBoolean", "deceasedAge", "deceasedRange", "deceasedDate", "deceasedString", "reasonCode", "reasonReference", "note", "condition"},
+	reflect.TypeOf(FamilyMemberHistoryCondition{}):                        {"id", "extension", "modifierExtension", "code", "outcome", "onsetAge", "onsetRange", "onsetPeriod", "onsetString", "note"},
+	reflect.TypeOf(Flag{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "subject", "period", "encounter", "author"},
+	reflect.TypeOf(Goal{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "priority", "description", "subject", "startDate", "startCodeableConcept", "target", "statusDate", "statusReason", "expressedBy", "addresses", "note", "outcomeCode", "outcomeReference"},
+	reflect.TypeOf(GoalTarget{}):                                          {"id", "extension", "modifierExtension", "measure", "detailQuantity", "detailRange", "detailCodeableConcept", "dueDate", "dueDuration"},
+	reflect.TypeOf(GraphDefinition{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "start", "profile", "link"},
+	reflect.TypeOf(GraphDefinitionLink{}):                                 {"id", "extension", "modifierExtension", "path", "sliceName", "min", "max", "description", "target"},
+	reflect.TypeOf(GraphDefinitionLinkTarget{}):                           {"id", "extension", "modifierExtension", "type", "profile", "compartment", "link"},
+	reflect.TypeOf(GraphDefinitionLinkTargetCompartment{}):                {"id", "extension", "modifierExtension", "code", "rule", "expression", "description"},
+	reflect.TypeOf(Group{}):                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "actual", "code", "name", "quantity", "characteristic", "member"},
+	reflect.TypeOf(GroupCharacteristic{}):                                 {"id", "extension", "modifierExtension", "code", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "exclude", "period"},
+	reflect.TypeOf(GroupMember{}):                                         {"id", "extension", "modifierExtension", "entity", "period", "inactive"},
+	reflect.TypeOf(GuidanceResponse{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "requestId", "identifier", "module", "status", "subject", "context", "occurrenceDateTime", "performer", "reasonCodeableConcept", "reasonReference", "note", "evaluationMessage", "outputParameters", "result", "dataRequirement"},
+	reflect.TypeOf(HealthcareService{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "providedBy", "category", "type", "specialty", "location", "name", "comment", "extraDetails", "photo", "telecom", "coverageArea", "serviceProvisionCode", "eligibility", "eligibilityNote", "programName", "characteristic", "referralMethod", "appointmentRequired", "availableTime", "notAvailable", "availabilityExceptions", "endpoint"},
+	reflect.TypeOf(HealthcareServiceAvailableTime{}):                      {"id", "extension", "modifierExtension", "daysOfWeek", "allDay", "availableStartTime", "availableEndTime"},
+	reflect.TypeOf(ImagingManifest{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "authoringTime", "author", "description", "study"},
+	reflect.TypeOf(ImagingManifestStudy{}):                                {"id", "extension", "modifierExtension", "uid", "imagingStudy", "endpoint", "series"},
+	reflect.TypeOf(ImagingManifestStudySeries{}):                          {"id", "extension", "modifierExtension", "uid", "endpoint", "instance"},
+	reflect.TypeOf(ImagingStudy{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "uid", "accession", "identifier", "availability", "modalityList", "patient", "context", "started", "basedOn", "referrer", "interpreter", "endpoint", "numberOfSeries", "numberOfInstances", "procedureReference", "procedureCode", "reason", "description", "series"},
+	reflect.TypeOf(ImagingStudySeries{}):                                  {"id", "extension", "modifierExtension", "uid", "number", "modality", "description", "numberOfInstances", "availability", "endpoint", "bodySite", "laterality", "started", "performer", "instance"},
+	reflect.TypeOf(ImagingStudySeriesInstance{}):                          {"id", "extension", "modifierExtension", "uid", "number", "sopClass", "title"},
+	reflect.TypeOf(ImmunizationRecommendationRecommendation{}):            {"id", "extension", "modifierExtension", "date", "vaccineCode", "targetDisease", "doseNumber", "forecastStatus", "dateCriterion", "protocol", "supportingImmunization", "supportingPatientInformation"},
+	reflect.TypeOf(ImmunizationRecommendationRecommendationProtocol{}):    {"id", "extension", "modifierExtension", "doseSequence", "description", "authority", "series"},
+	reflect.TypeOf(ImplementationGuide{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "copyright", "fhirVersion", "dependency", "package", "global", "binary", "page"},
+	reflect.TypeOf(ImplementationGuideGlobal{}):                           {"id", "extension", "modifierExtension", "type", "profile"},
+	reflect.TypeOf(ImplementationGuidePackage{}):                          {"id", "extension", "modifierExtension", "name", "description", "resource"},
+	reflect.TypeOf(ImplementationGuidePackageResource{}):                  {"id", "extension", "modifierExtension", "example", "name", "description", "acronym", "sourceUri", "sourceReference", "exampleFor"},
+	reflect.TypeOf(ImplementationGuidePage{}):                             {"id", "extension", "modifierExtension", "source", "title", "kind", "type", "package", "format", "page"},
+	reflect.TypeOf(Library{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "type", "date", "publisher", "description", "purpose", "usage", "approvalDate", "lastReviewDate", "effectivePeriod", "useContext", "jurisdiction", "topic", "contributor", "contact", "copyright", "relatedArtifact", "parameter", "dataRequirement", "content"},
+	reflect.TypeOf(LinkageItem{}):                                         {"id", "extension", "modifierExtension", "type", "resource"},
+	reflect.TypeOf(List{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "mode", "title", "code", "subject", "encounter", "date", "source", "orderedBy", "note", "entry", "emptyReason"},
+	reflect.TypeOf(ListEntry{}):                                           {"id", "extension", "modifierExtension", "flag", "deleted", "date", "item"},
+	reflect.TypeOf(Location{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "operationalStatus", "name", "description", "mode", "type", "telecom", "address", "physicalType", "position", "managingOrganization", "partOf", "endpoint"},
+	reflect.TypeOf(LocationPosition{}):                                    {"id", "extension", "modifierExtension", "longitude", "latitude", "altitude"},
+	reflect.TypeOf(Measure{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "description", "purpose", "usage", "approvalDate", "lastReviewDate", "effectivePeriod", "useContext", "jurisdiction", "topic", "contributor", "contact", "copyright", "relatedArtifact", "library", "disclaimer", "scoring", "compositeScoring", "type", "riskAdjustment", "rateAggregation", "rationale", "clinicalRecommendationStatement", "improvementNotation", "definition", "guidance", "set", "group", "supplementalData"},
+	reflect.TypeOf(MeasureGroup{}):                                        {"id", "extension", "modifierExtension", "identifier", "name", "description", "population", "stratifier"},
+	reflect.TypeOf(MeasureGroupPopulation{}):                              {"id", "extension", "modifierExtension", "identifier", "code", "name", "description", "criteria"},
+	reflect.TypeOf(MeasureGroupStratifier{}):                              {"id", "extension", "modifierExtension", "identifier", "criteria", "path"},
+	reflect.TypeOf(MeasureReport{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "measure", "patient", "date", "reportingOrganization", "period", "group", "evaluatedResources"},
+	reflect.TypeOf(MeasureReportGroup{}):                                  {"id", "extension", "modifierExtension", "identifier", "population", "measureScore", "stratifier"},
+	reflect.TypeOf(MeasureReportGroupPopulation{}):                        {"id", "extension", "modifierExtension", "identifier", "code", "count", "patients"},
+	reflect.TypeOf(MeasureReportGroupStratifierStratum{}):                 {"id", "extension", "modifierExtension", "value", "population", "measureScore"},
+	reflect.TypeOf(MeasureReportGroupStratifierStratumPopulation{}):       {"id", "extension", "modifierExtension", "identifier", "code", "count", "patients"},
+	reflect.TypeOf(MeasureSupplementalData{}):                             {"id", "extension", "modifierExtension", "identifier", "usage", "criteria", "path"},
+	reflect.TypeOf(Media{}):                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "type", "subtype", "view", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "operator", "reasonCode", "bodySite", "device", "height", "width", "frames", "duration", "content", "note"},
+	reflect.TypeOf(Medication{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "code", "status", "isBrand", "isOverTheCounter", "manufacturer", "form", "identifier", "ingredient", "package"},
+	reflect.TypeOf(MedicationAdministration{}):                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "partOf", "status", "category", "medicationCodeableConcept", "medicationReference", "subject", "context", "supportingInformation", "effectiveDateTime", "effectivePeriod", "performer", "notGiven", "reasonNotGiven", "reasonCode", "reasonReference", "prescription", "device", "note", "dosage", "eventHistory"},
+	reflect.TypeOf(MedicationAdministrationDosage{}):                      {"id", "extension", "modifierExtension", "text", "site", "route", "method", "dose", "rateRatio", "rateQuantity"},
+	reflect.TypeOf(MedicationDispense{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "partOf", "status", "category", "medicationCodeableConcept", "medicationReference", "subject", "context", "supportingInformation", "performer", "authorizingPrescription", "type", "quantity", "daysSupply", "whenPrepared", "whenHandedOver", "destination", "receiver", "note", "dosageInstruction", "substitution", "detectedIssue", "notDone", "notDoneReasonCodeableConcept", "notDoneReasonReference", "eventHistory"},
+	reflect.TypeOf(MedicationDispenseSubstitution{}):                      {"id", "extension", "modifierExtension", "wasSubstituted", "type", "reason", "responsibleParty"},
+	reflect.TypeOf(MedicationIngredient{}):                                {"id", "extension", "modifierExtension", "itemCodeableConcept", "itemReference", "isActive", "amount"},
+	reflect.TypeOf(MedicationPackage{}):                                   {"id", "extension", "modifierExtension", "container", "content", "batch"},
+	reflect.TypeOf(MedicationPackageBatch{}):                              {"id", "extension", "modifierExtension", "lotNumber", "expirationDate"},
+	reflect.TypeOf(MedicationPackageContent{}):                            {"id", "extension", "modifierExtension", "itemCodeableConcept", "itemReference", "amount"},
+	reflect.TypeOf(MedicationRequest{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "status", "intent", "category", "priority", "medicationCodeableConcept", "medicationReference", "subject", "context", "supportingInformation", "authoredOn", "requester", "recorder", "reasonCode", "reasonReference", "note", "dosageInstruction", "dispenseRequest", "substitution", "priorPrescription"},
+	reflect.TypeOf(MedicationRequestDispenseRequest{}):                    {"id", "extension", "modifierExtension", "validityPeriod", "numberOfRepeatsAllowed", "quantity", "expectedSupplyDuration", "performer"},
+	reflect.TypeOf(MedicationStatement{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "context", "status", "category", "medicationCodeableConcept", "medicationReference", "effectiveDateTime", "effectivePeriod", "dateAsserted", "informationSource", "subject", "derivedFrom", "taken", "reasonNotTaken", "reasonCode", "reasonReference", "note", "dosage"},
+	reflect.TypeOf(MessageDefinition{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "base", "parent", "replaces", "event", "category", "focus", "responseRequired", "allowedResponse"},
+	reflect.TypeOf(MessageDefinitionFocus{}):                              {"id", "extension", "modifierExtension", "code", "profile", "min", "max"},
+	reflect.TypeOf(MessageHeader{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "event", "destination", "receiver", "sender", "timestamp", "enterer", "author", "source", "responsible", "reason", "response", "focus"},
+	reflect.TypeOf(MessageHeaderDestination{}):                            {"id", "extension", "modifierExtension", "name", "target", "endpoint"},
+	reflect.TypeOf(MessageHeaderResponse{}):                               {"id", "extension", "modifierExtension", "identifier", "code", "details"},
+	reflect.TypeOf(MessageHeaderSource{}):                                 {"id", "extension", "modifierExtension", "name", "software", "version", "contact", "endpoint"},
+	reflect.TypeOf(NamingSystem{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "name", "status", "kind", "date", "publisher", "contact", "responsible", "type", "description", "useContext", "jurisdiction", "usage", "uniqueId", "replacedBy"},
+	reflect.TypeOf(NamingSystemUniqueId{}):                                {"id", "extension", "modifierExtension", "type", "value", "preferred", "comment", "period"},
+	reflect.TypeOf(NutritionOrder{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "encounter", "dateTime", "orderer", "allergyIntolerance", "foodPreferenceModifier", "excludeFoodModifier", "oralDiet", "supplement", "enteralFormula"},
+	reflect.TypeOf(NutritionOrderEnteralFormula{}):                        {"id", "extension", "modifierExtension", "baseFormulaType", "baseFormulaProductName", "additiveType", "additiveProductName", "caloricDensity", "routeofAdministration", "administration", "maxVolumeToDeliver", "administrationInstruction"},
+	reflect.TypeOf(NutritionOrderEnteralFormulaAdministration{}):          {"id", "extension", "modifierExtension", "schedule", "quantity", "rateQuantity", "rateRatio"},
+	reflect.TypeOf(NutritionOrderOralDiet{}):                              {"id", "extension", "modifierExtension", "type", "schedule", "nutrient", "texture", "fluidConsistencyType", "instruction"},
+	reflect.TypeOf(NutritionOrderOralDietNutrient{}):                      {"id", "extension", "modifierExtension", "modifier", "amount"},
+	reflect.TypeOf(NutritionOrderOralDietTexture{}):                       {"id", "extension", "modifierExtension", "modifier", "foodType"},
+	reflect.TypeOf(NutritionOrderSupplement{}):                            {"id", "extension", "modifierExtension", "type", "productName", "schedule", "quantity", "instruction"},
+	reflect.TypeOf(Observation{}):                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "category", "code", "subject", "context", "effectiveDateTime", "effectivePeriod", "issued", "performer", "valueQuantity", "valueCodeableConcept", "valueString", "valueBoolean", "valueRange", "valueRatio", "valueSampledData", "valueAttachment", "valueTime", "valueDateTime", "valuePeriod", "dataAbsentReason", "interpretation", "comment", "bodySite", "method", "specimen", "device", "note", "referenceRange", "related"},
+	reflect.TypeOf(ObservationReferenceRange{}):                           {"id", "extension", "modifierExtension", "low", "high", "type", "appliesTo", "age", "text"},
+	reflect.TypeOf(ObservationRelated{}):                                  {"id", "extension", "modifierExtension", "type", "target"},
+	reflect.TypeOf(OperationDefinition{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "kind", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "idempotent", "code", "comment", "base", "resource", "system", "type", "instance", "parameter", "overload"},
+	reflect.TypeOf(OperationDefinitionOverload{}):                         {"id", "extension", "modifierExtension", "parameterName", "comment"},
+	reflect.TypeOf(OperationDefinitionParameter{}):                        {"id", "extension", "modifierExtension", "name", "use", "min", "max", "documentation", "type", "searchType", "profile", "binding", "part"},
+	reflect.TypeOf(OperationOutcomeIssue{}):                               {"id", "extension", "modifierExtension", "severity", "code", "details", "diagnostics", "location", "expression"},
+	reflect.TypeOf(Organization{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "name", "telecom", "address", "partOf", "contact", "endpoint"},
+	reflect.TypeOf(OrganizationContact{}):                                 {"id", "extension", "modifierExtension", "purpose", "name", "telecom", "address"},
+	reflect.TypeOf(ParametersParameter{}):                                 {"id", "extension", "modifierExtension", "name", "valueBase64Binary", "valueBoolean", "valueCode", "valueDate", "valueDateTime", "valueDecimal", "valueId", "valueInstant", "valueInteger", "valueMarkdown", "valueOid", "valuePositiveInt", "valueString", "valueTime", "valueUnsignedInt", "valueUri", "valueAddress", "valueAge", "valueAnnotation", "valueAttachment", "valueCodeableConcept", "valueCoding", "valueContactPoint", "valueCount", "valueDistance", "valueDuration", "valueHumanName", "valueIdentifier", "valueMoney", "valuePeriod", "valueQuantity", "valueRange", "valueRatio", "valueReference", "valueSampledData", "valueSignature", "valueTiming", "valueMeta", "resource", "part"},
+	reflect.TypeOf(Patient{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "address", "maritalStatus", "multipleBirthBoolean", "multipleBirthInteger", "photo", "contact", "managingOrganization", "link"},
+	reflect.TypeOf(PatientContact{}):                                      {"id", "extension", "modifierExtension", "relationship", "name", "telecom", "address", "gender"},
+	reflect.TypeOf(PaymentNotice{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "request", "response", "statusDate", "created", "target", "provider", "organization", "paymentStatus"},
+	reflect.TypeOf(PaymentReconciliation{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "period", "created", "organization", "request", "outcome", "disposition", "requestProvider", "requestOrganization", "detail", "form", "total", "processNote"},
+	reflect.TypeOf(PaymentReconciliationDetail{}):                         {"id", "extension", "modifierExtension", "type", "request", "response", "submitter", "payee", "date", "amount"},
+	reflect.TypeOf(PaymentReconciliationProcessNote{}):                    {"id", "extension", "modifierExtension", "type", "text"},
+	reflect.TypeOf(Person{}):                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "telecom", "gender", "birthDate", "address", "photo", "managingOrganization", "active", "link"},
+	reflect.TypeOf(PersonLink{}):                                          {"id", "extension", "modifierExtension", "target", "assurance"},
+	reflect.TypeOf(PlanDefinition{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "type", "status", "experimental", "date", "publisher", "description", "purpose", "usage", "approvalDate", "lastReviewDate", "effectivePeriod", "useContext", "jurisdiction", "topic", "contributor", "contact", "copyright", "relatedArtifact", "library", "goal", "action"},
+	reflect.TypeOf(PlanDefinitionAction{}):                                {"id", "extension", "modifierExtension", "label", "title", "description", "textEquivalent", "code", "reason", "documentation", "goalId", "triggerDefinition", "condition", "input", "output", "relatedAction", "timingDateTime", "timingPeriod", "timingDuration", "timingRange", "timingTiming", "participant", "type", "groupingBehavior", "selectionBehavior", "requiredBehavior", "precheckBehavior", "cardinalityBehavior", "definition", "transform", "dynamicValue", "action"},
+	reflect.TypeOf(PlanDefinitionActionCondition{}):                       {"id", "extension", "modifierExtension", "kind", "description", "language", "expression"},
+	reflect.TypeOf(PlanDefinitionActionDynamicValue{}):                    {"id", "extension", "modifierExtension", "description", "path", "language", "expression"},
+	reflect.TypeOf(PlanDefinitionActionParticipant{}):                     {"id", "extension", "modifierExtension", "type", "role"},
+	reflect.TypeOf(PlanDefinitionActionRelatedAction{}):                   {"id", "extension", "modifierExtension", "actionId", "relationship", "offsetDuration", "offsetRange"},
+	reflect.TypeOf(PlanDefinitionGoal{}):                                  {"id", "extension", "modifierExtension", "category", "description", "priority", "start", "addresses", "documentation", "target"},
+	reflect.TypeOf(PlanDefinitionGoalTarget{}):                            {"id", "extension", "modifierExtension", "measure", "detailQuantity", "detailRange", "detailCodeableConcept", "due"},
+	reflect.TypeOf(Practitioner{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "address", "gender", "birthDate", "photo", "qualification", "communication"},
+	reflect.TypeOf(PractitionerQualification{}):                           {"id", "extension", "modifierExtension", "identifier", "code", "period", "issuer"},
+	reflect.TypeOf(PractitionerRole{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "period", "practitioner", "organization", "code", "specialty", "location", "healthcareService", "telecom", "availableTime", "notAvailable", "availabilityExceptions", "endpoint"},
+	reflect.TypeOf(PractitionerRoleAvailableTime{}):                       {"id", "extension", "modifierExtension", "daysOfWeek", "allDay", "availableStartTime", "availableEndTime"},
+	reflect.TypeOf(Procedure{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "partOf", "status", "notDone", "notDoneReason", "category", "code", "subject", "context", "performedDateTime", "performedPeriod", "performer", "location", "reasonCode", "reasonReference", "bodySite", "outcome", "report", "complication", "complicationDetail", "followUp", "note", "focalDevice", "usedReference", "usedCode"},
+	reflect.TypeOf(ProcedurePerformer{}):                                  {"id", "extension", "modifierExtension", "role", "actor", "onBehalfOf"},
+	reflect.TypeOf(ProcedureRequest{}):                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "replaces", "requisition", "status", "intent", "priority", "doNotPerform", "category", "code", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "asNeededBoolean", "asNeededCodeableConcept", "authoredOn", "requester", "performerType", "performer", "reasonCode", "reasonReference", "supportingInfo", "specimen", "bodySite", "note", "relevantHistory"},
+	reflect.TypeOf(ProcessRequest{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "action", "target", "created", "provider", "organization", "request", "response", "nullify", "reference", "item", "include", "exclude", "period"},
+	reflect.TypeOf(ProcessResponse{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "organization", "request", "outcome", "disposition", "requestProvider", "requestOrganization", "form", "processNote", "error", "communicationRequest"},
+	reflect.TypeOf(ProcessResponseProcessNote{}):                          {"id", "extension", "modifierExtension", "type", "text"},
+	reflect.TypeOf(Provenance{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "target", "period", "recorded", "policy", "location", "reason", "activity", "agent", "entity", "signature"},
+	reflect.TypeOf(ProvenanceAgent{}):                                     {"id", "extension", "modifierExtension", "role", "whoUri", "whoReference", "onBehalfOfUri", "onBehalfOfReference", "relatedAgentType"},
+	reflect.TypeOf(ProvenanceEntity{}):                                    {"id", "extension", "modifierExtension", "role", "whatUri", "whatReference", "whatIdentifier", "agent"},
+	reflect.TypeOf(Questionnaire{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "description", "purpose", "approvalDate", "lastReviewDate", "effectivePeriod", "useContext", "jurisdiction", "contact", "copyright", "code", "subjectType", "item"},
+	reflect.TypeOf(QuestionnaireItem{}):                                   {"id", "extension", "modifierExtension", "linkId", "definition", "code", "prefix", "text", "type", "enableWhen", "required", "repeats", "readOnly", "maxLength", "options", "option", "initialBoolean", "initialDecimal", "initialInteger", "initialDate", "initialDateTime", "initialTime", "initialString", "initialUri", "initialAttachment", "initialCoding", "initialQuantity", "initialReference", "item"},
+	reflect.TypeOf(QuestionnaireItemEnableWhen{}):                         {"id", "extension", "modifierExtension", "question", "hasAnswer", "answerBoolean", "answerDecimal", "answerInteger", "answerDate", "answerDateTime", "answerTime", "answerString", "answerUri", "answerAttachment", "answerCoding", "answerQuantity", "answerReference"},
+	reflect.TypeOf(QuestionnaireResponse{}):                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "parent", "questionnaire", "status", "subject", "context", "authored", "author", "source", "item"},
+	reflect.TypeOf(QuestionnaireResponseItem{}):                           {"id", "extension", "modifierExtension", "linkId", "definition", "text", "subject", "answer", "item"},
+	reflect.TypeOf(QuestionnaireResponseItemAnswer{}):                     {"id", "extension", "modifierExtension", "valueBoolean", "valueDecimal", "valueInteger", "valueDate", "valueDateTime", "valueTime", "valueString", "valueUri", "valueAttachment", "valueCoding", "valueQuantity", "valueReference", "item"},
+	reflect.TypeOf(ReferralRequest{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "replaces", "groupIdentifier", "status", "intent", "type", "priority", "serviceRequested", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "authoredOn", "requester", "specialty", "recipient", "reasonCode", "reasonReference", "description", "supportingInfo", "note", "relevantHistory"},
+	reflect.TypeOf(RelatedPerson{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "patient", "relationship", "name", "telecom", "gender", "birthDate", "address", "photo", "period"},
+	reflect.TypeOf(RequestGroup{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "basedOn", "replaces", "groupIdentifier", "status", "intent", "priority", "subject", "context", "authoredOn", "author", "reasonCodeableConcept", "reasonReference", "note", "action"},
+	reflect.TypeOf(RequestGroupAction{}):                                  {"id", "extension", "modifierExtension", "label", "title", "description", "textEquivalent", "code", "documentation", "condition", "relatedAction", "timingDateTime", "timingPeriod", "timingDuration", "timingRange", "timingTiming", "participant", "type", "groupingBehavior", "selectionBehavior", "requiredBehavior", "precheckBehavior", "cardinalityBehavior", "resource", "action"},
+	reflect.TypeOf(RequestGroupActionCondition{}):                         {"id", "extension", "modifierExtension", "kind", "description", "language", "expression"},
+	reflect.TypeOf(RequestGroupActionRelatedAction{}):                     {"id", "extension", "modifierExtension", "actionId", "relationship", "offsetDuration", "offsetRange"},
+	reflect.TypeOf(ResearchStudy{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "title", "protocol", "partOf", "status", "category", "focus", "contact", "relatedArtifact", "keyword", "jurisdiction", "description", "enrollment", "period", "sponsor", "principalInvestigator", "site", "reasonStopped", "note", "arm"},
+	reflect.TypeOf(ResearchStudyArm{}):                                    {"id", "extension", "modifierExtension", "name", "code", "description"},
+	reflect.TypeOf(ResearchSubject{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "period", "study", "individual", "assignedArm", "actualArm", "consent"},
+	reflect.TypeOf(RiskAssessment{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "parent", "status", "method", "code", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "condition", "performer", "reasonCodeableConcept", "reasonReference", "basis", "prediction", "mitigation", "comment"},
+	reflect.TypeOf(RiskAssessmentPrediction{}):                            {"id", "extension", "modifierExtension", "outcome", "probabilityDecimal", "probabilityRange", "qualitativeRisk", "relativeRisk", "whenPeriod", "whenRange", "rationale"},
+	reflect.TypeOf(SampledData{}):                                         {"id", "extension", "origin", "period", "factor", "lowerLimit", "upperLimit", "dimensions", "data"},
+	reflect.TypeOf(Schedule{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "serviceCategory", "serviceType", "specialty", "actor", "planningHorizon", "comment"},
+	reflect.TypeOf(SearchParameter{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "date", "publisher", "contact", "useContext", "jurisdiction", "purpose", "code", "base", "type", "derivedFrom", "description", "expression", "xpath", "xpathUsage", "target", "comparator", "modifier", "chain", "component"},
+	reflect.TypeOf(Sequence{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "coordinateSystem", "patient", "specimen", "device", "performer", "quantity", "referenceSeq", "variant", "observedSeq", "quality", "readCoverage", "repository", "pointer"},
+	reflect.TypeOf(SequenceQuality{}):                                     {"id", "extension", "modifierExtension", "type", "standardSequence", "start", "end", "score", "method", "truthTP", "queryTP", "truthFN", "queryFP", "gtFP", "precision", "recall", "fScore"},
+	reflect.TypeOf(SequenceReferenceSeq{}):                                {"id", "extension", "modifierExtension", "chromosome", "genomeBuild", "referenceSeqId", "referenceSeqPointer", "referenceSeqString", "strand", "windowStart", "windowEnd"},
+	reflect.TypeOf(SequenceRepository{}):                                  {"id", "extension", "modifierExtension", "type", "url", "name", "datasetId", "variantsetId", "readsetId"},
+	reflect.TypeOf(SequenceVariant{}):                                     {"id", "extension", "modifierExtension", "start", "end", "observedAllele", "referenceAllele", "cigar", "variantPointer"},
+	reflect.TypeOf(ServiceDefinition{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "description", "purpose", "usage", "approvalDate", "lastReviewDate", "effectivePeriod", "useContext", "jurisdiction", "topic", "contributor", "contact", "copyright", "relatedArtifact", "trigger", "dataRequirement", "operationDefinition"},
+	reflect.TypeOf(Signature{}):                                           {"id", "extension", "type", "when", "whoUri", "whoReference", "contentType", "blob"},
+	reflect.TypeOf(Slot{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "serviceCategory", "serviceType", "specialty", "appointmentType", "schedule", "status", "start", "end", "overbooked", "comment"},
+	reflect.TypeOf(StructureDefinition{}):                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "keyword", "fhirVersion", "mapping", "kind", "abstract", "contextType", "context", "contextInvariant", "type", "baseDefinition", "derivation", "snapshot", "differential"},
+	reflect.TypeOf(StructureDefinitionMapping{}):                          {"id", "extension", "modifierExtension", "identity", "uri", "name", "comment"},
+	reflect.TypeOf(StructureMap{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "structure", "import", "group"},
+	reflect.TypeOf(StructureMapGroup{}):                                   {"id", "extension", "modifierExtension", "name", "extends", "typeMode", "documentation", "input", "rule"},
+	reflect.TypeOf(StructureMapGroupInput{}):                              {"id", "extension", "modifierExtension", "name", "type", "mode", "documentation"},
+	reflect.TypeOf(StructureMapGroupRule{}):                               {"id", "extension", "modifierExtension", "name", "source", "target", "rule", "dependent", "documentation"},
+	reflect.TypeOf(StructureMapGroupRuleSource{}):                         {"id", "extension", "modifierExtension", "context", "min", "max", "type", "defaultValueBase64Binary", "defaultValueBoolean", "defaultValueCode", "defaultValueDate", "defaultValueDateTime", "defaultValueDecimal", "defaultValueId", "defaultValueInstant", "defaultValueInteger", "defaultValueMarkdown", "defaultValueOid", "defaultValuePositiveInt", "defaultValueString", "defaultValueTime", "defaultValueUnsignedInt", "defaultValueUri", "defaultValueAddress", "defaultValueAge", "defaultValueAnnotation", "defaultValueAttachment", "defaultValueCodeableConcept", "defaultValueCoding", "defaultValueContactPoint", "defaultValueCount", "defaultValueDistance", "defaultValueDuration", "defaultValueHumanName", "defaultValueIdentifier", "defaultValueMoney", "defaultValuePeriod", "defaultValueQuantity", "defaultValueRange", "defaultValueRatio", "defaultValueReference", "defaultValueSampledData", "defaultValueSignature", "defaultValueTiming", "defaultValueMeta", "element", "listMode", "variable", "condition", "check"},
+	reflect.TypeOf(StructureMapGroupRuleTarget{}):                         {"id", "extension", "modifierExtension", "context", "contextType", "element", "variable", "listMode", "listRuleId", "transform", "parameter"},
+	reflect.TypeOf(StructureMapStructure{}):                               {"id", "extension", "modifierExtension", "url", "mode", "alias", "documentation"},
+	reflect.TypeOf(Subscription{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "status", "contact", "end", "reason", "criteria", "error", "channel", "tag"},
+	reflect.TypeOf(SubscriptionChannel{}):                                 {"id", "extension", "modifierExtension", "type", "endpoint", "payload", "header"},
+	reflect.TypeOf(Substance{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "description", "instance", "ingredient"},
+	reflect.TypeOf(SubstanceInstance{}):                                   {"id", "extension", "modifierExtension", "identifier", "expiry", "quantity"},
+	reflect.TypeOf(SupplyDelivery{}):                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "patient", "type", "suppliedItem", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "supplier", "destination", "receiver"},
+	reflect.TypeOf(SupplyDeliverySuppliedItem{}):                          {"id", "extension", "modifierExtension", "quantity", "itemCodeableConcept", "itemReference"},
+	reflect.TypeOf(SupplyRequest{}):                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "priority", "orderedItem", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "authoredOn", "requester", "supplier", "reasonCodeableConcept", "reasonReference", "deliverFrom", "deliverTo"},
+	reflect.TypeOf(SupplyRequestOrderedItem{}):                            {"id", "extension", "modifierExtension", "quantity", "itemCodeableConcept", "itemReference"},
+	reflect.TypeOf(Task{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definitionUri", "definitionReference", "basedOn", "groupIdentifier", "partOf", "status", "statusReason", "businessStatus", "intent", "priority", "code", "description", "focus", "for", "context", "executionPeriod", "authoredOn", "lastModified", "requester", "performerType", "owner", "reason", "note", "relevantHistory", "restriction", "input", "output"},
+	reflect.TypeOf(TaskRestriction{}):                                     {"id", "extension", "modifierExtension", "repetitions", "period", "recipient"},
+	reflect.TypeOf(TestReport{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "status", "testScript", "result", "score", "tester", "issued", "participant", "setup", "test", "teardown"},
+	reflect.TypeOf(TestReportParticipant{}):                               {"id", "extension", "modifierExtension", "type", "uri", "display"},
+	reflect.TypeOf(TestReportSetupAction{}):                               {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestReportSetupActionAssert{}):                         {"id", "extension", "modifierExtension", "result", "message", "detail"},
+	reflect.TypeOf(TestReportSetupActionOperation{}):                      {"id", "extension", "modifierExtension", "result", "message", "detail"},
+	reflect.TypeOf(TestReportTest{}):                                      {"id", "extension", "modifierExtension", "name", "description", "action"},
+	reflect.TypeOf(TestReportTestAction{}):                                {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScript{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "origin", "destination", "metadata", "fixture", "profile", "variable", "rule", "ruleset", "setup", "test", "teardown"},
+	reflect.TypeOf(TestScriptMetadata{}):                                  {"id", "extension", "modifierExtension", "link", "capability"},
+	reflect.TypeOf(TestScriptMetadataCapability{}):                        {"id", "extension", "modifierExtension", "required", "validated", "description", "origin", "destination", "link", "capabilities"},
+	reflect.TypeOf(TestScriptMetadataLink{}):                              {"id", "extension", "modifierExtension", "url", "description"},
+	reflect.TypeOf(TestScriptRule{}):                                      {"id", "extension", "modifierExtension", "resource", "param"},
+	reflect.TypeOf(TestScriptRulesetRule{}):                               {"id", "extension", "modifierExtension", "ruleId", "param"},
+	reflect.TypeOf(TestScriptSetupAction{}):                               {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScriptSetupActionAssert{}):                         {"id", "extension", "modifierExtension", "label", "description", "direction", "compareToSourceId", "compareToSourceExpression", "compareToSourcePath", "contentType", "expression", "headerField", "minimumId", "navigationLinks", "operator", "path", "requestMethod", "requestURL", "resource", "response", "responseCode", "rule", "ruleset", "sourceId", "validateProfileId", "value", "warningOnly"},
+	reflect.TypeOf(TestScriptSetupActionAssertRule{}):                     {"id", "extension", "modifierExtension", "ruleId", "param"},
+	reflect.TypeOf(TestScriptSetupActionAssertRuleset{}):                  {"id", "extension", "modifierExtension", "rulesetId", "rule"},
+	reflect.TypeOf(TestScriptSetupActionAssertRulesetRule{}):              {"id", "extension", "modifierExtension", "ruleId", "param"},
+	reflect.TypeOf(TestScriptSetupActionOperation{}):                      {"id", "extension", "modifierExtension", "type", "resource", "label", "description", "accept", "contentType", "destination", "encodeRequestUrl", "origin", "params", "requestHeader", "requestId", "responseId", "sourceId", "targetId", "url"},
+	reflect.TypeOf(TestScriptTest{}):                                      {"id", "extension", "modifierExtension", "name", "description", "action"},
+	reflect.TypeOf(TestScriptTestAction{}):                                {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScriptVariable{}):                                  {"id", "extension", "modifierExtension", "name", "defaultValue", "description", "expression", "headerField", "hint", "path", "sourceId"},
+	reflect.TypeOf(Timing{}):                                              {"id", "extension", "modifierExtension", "event", "repeat", "code"},
+	reflect.TypeOf(TimingRepeat{}):                                        {"id", "extension", "modifierExtension", "boundsDuration", "boundsRange", "boundsPeriod", "count", "countMax", "duration", "durationMax", "durationUnit", "frequency", "frequencyMax", "period", "periodMax", "periodUnit", "dayOfWeek", "timeOfDay", "when", "offset"},
+	reflect.TypeOf(ValueSet{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "immutable", "purpose", "copyright", "extensible", "compose", "expansion"},
+	reflect.TypeOf(ValueSetCompose{}):                                     {"id", "extension", "modifierExtension", "lockedDate", "inactive", "include", "exclude"},
+	reflect.TypeOf(ValueSetComposeInclude{}):                              {"id", "extension", "modifierExtension", "system", "version", "concept", "filter", "valueSet"},
+	reflect.TypeOf(ValueSetComposeIncludeConcept{}):                       {"id", "extension", "modifierExtension", "code", "display", "designation"},
+	reflect.TypeOf(ValueSetComposeIncludeFilter{}):                        {"id", "extension", "modifierExtension", "property", "op", "value"},
+	reflect.TypeOf(ValueSetExpansion{}):                                   {"id", "extension", "modifierExtension", "identifier", "timestamp", "total", "offset", "parameter", "contains"},
+	reflect.TypeOf(ValueSetExpansionContains{}):                           {"id", "extension", "modifierExtension", "system", "abstract", "inactive", "version", "code", "display", "designation", "contains"},
+	reflect.TypeOf(VisionPrescription{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "encounter", "dateWritten", "prescriber", "reasonCodeableConcept", "reasonReference", "dispense"},
+	reflect.TypeOf(VisionPrescriptionDispense{}):                          {"id", "extension", "modifierExtension", "product", "eye", "sphere", "cylinder", "axis", "prism", "base", "add", "power", "backCurve", "diameter", "duration", "color", "brand", "note"},
+}
@@ -0,0 +1,70 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EligibilityRequest provides patient and insurance coverage information to an insurer for them to respond
+type EligibilityRequest struct {
+	DomainResource
+
+	// Dental, Vision, Medical, Pharmacy, Rehab etc
+	BenefitCategory *common.CodeableConcept `json:"benefitCategory,omitempty"`
+
+	// Dental: basic, major, ortho; Vision exam, glasses, contacts; etc
+	BenefitSubCategory *common.CodeableConcept `json:"benefitSubCategory,omitempty"`
+
+	// The contract number of a business agreement which describes the terms and conditions
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
+
+	// 1..1
+	Coverage *common.Reference `json:"coverage,omitempty"`
+
+	// The date when this resource was created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Person who created the invoice/claim/pre-determination or pre-authorization
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Facility where the services were provided
+	Facility *common.Reference `json:"facility,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The Insurer who is target of the request
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// 1..1
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Immediate (STAT), best effort (NORMAL), deferred (DEFER)
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// The date or dates when the enclosed suite of services were performed or completed
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period  `json:"servicedPeriod,omitempty"`
+
+	// The status of the resource instance
+	Status        *EligibilityRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+}
+
+// EligibilityRequestStatus represents the status of an eligibility request
+type EligibilityRequestStatus string
+
+const (
+	EligibilityRequestStatusActive         EligibilityRequestStatus = "active"
+	EligibilityRequestStatusCancelled      EligibilityRequestStatus = "cancelled"
+	EligibilityRequestStatusDraft          EligibilityRequestStatus = "draft"
+	EligibilityRequestStatusEnteredInError EligibilityRequestStatus = "entered-in-error"
+)
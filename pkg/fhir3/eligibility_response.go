@@ -0,0 +1,140 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EligibilityResponse provides eligibility and plan details from the processing of an Eligibility resource
+type EligibilityResponse struct {
+	DomainResource
+
+	// The date when the enclosed suite of services were performed or completed
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// A description of the status of the adjudication
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Mutually exclusive with Services Provided (Item)
+	Error []EligibilityResponseError `json:"error,omitempty"`
+
+	// The form to be used for printing the content
+	Form *common.CodeableConcept `json:"form,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Flag indicating if the coverage provided is inforce currently if no service date(s) specified or for the whole duration of the service dates
+	Inforce        *bool           `json:"inforce,omitempty"`
+	InforceElement *common.Element `json:"_inforce,omitempty"`
+
+	// The insurer may provide both the details for the requested coverage as well as details for additional coverages known to the insurer
+	Insurance []EligibilityResponseInsurance `json:"insurance,omitempty"`
+
+	// The Insurer who produced this adjudicated response
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// Transaction status: error, complete
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// Original request resource reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the response as not currently valid
+	Status        *EligibilityResponseStatus `json:"status,omitempty"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
+}
+
+// EligibilityResponseStatus represents the status of an eligibility response
+type EligibilityResponseStatus string
+
+const (
+	EligibilityResponseStatusActive         EligibilityResponseStatus = "active"
+	EligibilityResponseStatusCancelled      EligibilityResponseStatus = "cancelled"
+	EligibilityResponseStatusDraft          EligibilityResponseStatus = "draft"
+	EligibilityResponseStatusEnteredInError EligibilityResponseStatus = "entered-in-error"
+)
+
+// EligibilityResponseInsuranceBenefitBalanceFinancial represents Benefits Used to date
+type EligibilityResponseInsuranceBenefitBalanceFinancial struct {
+	common.BackboneElement
+
+	// Benefits allowed
+	AllowedUnsignedInt        *int            `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *common.Element `json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string         `json:"allowedString,omitempty"`
+	AllowedStringElement      *common.Element `json:"_allowedString,omitempty"`
+	AllowedMoney              *common.Money   `json:"allowedMoney,omitempty"`
+
+	// Deductable, visits, benefit amount
+	Type *common.CodeableConcept `json:"type"`
+
+	// Benefits used
+	UsedUnsignedInt        *int            `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement *common.Element `json:"_usedUnsignedInt,omitempty"`
+	UsedMoney              *common.Money   `json:"usedMoney,omitempty"`
+}
+
+// EligibilityResponseInsuranceBenefitBalance represents benefits and optionally current balances by Category
+type EligibilityResponseInsuranceBenefitBalance struct {
+	common.BackboneElement
+
+	// Dental, Vision, Medical, Pharmacy, Rehab etc
+	Category *common.CodeableConcept `json:"category"`
+
+	// A richer description of the benefit, for example 'DENT2 covers 100% of basic, 50% of major but exclused Ortho
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// True if the indicated class of service is excluded from the plan
+	Excluded        *bool           `json:"excluded,omitempty"`
+	ExcludedElement *common.Element `json:"_excluded,omitempty"`
+
+	// Benefits Used to date
+	Financial []EligibilityResponseInsuranceBenefitBalanceFinancial `json:"financial,omitempty"`
+
+	// A short name or tag for the benefit, for example MED01, or DENT2
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Network designation
+	Network *common.CodeableConcept `json:"network,omitempty"`
+
+	// Dental: basic, major, ortho; Vision exam, glasses, contacts; etc
+	SubCategory *common.CodeableConcept `json:"subCategory,omitempty"`
+
+	// The term or period of the values such as 'maximum lifetime benefit' or 'maximum annual vistis'
+	Term *common.CodeableConcept `json:"term,omitempty"`
+
+	// Unit designation: individual or family
+	Unit *common.CodeableConcept `json:"unit,omitempty"`
+}
+
+// EligibilityResponseInsurance represents the insurance of an eligibility response
+type EligibilityResponseInsurance struct {
+	common.BackboneElement
+
+	// Benefits and optionally current balances by Category
+	BenefitBalance []EligibilityResponseInsuranceBenefitBalance `json:"benefitBalance,omitempty"`
+
+	// The contract resource which may provide more detailed information
+	Contract *common.Reference `json:"contract,omitempty"`
+
+	// A suite of updated or additional Coverages from the Insurer
+	Coverage *common.Reference `json:"coverage,omitempty"`
+}
+
+// EligibilityResponseError represents mutually exclusive with Services Provided (Item)
+type EligibilityResponseError struct {
+	common.BackboneElement
+
+	// An error code,from a specified code system, which details why the eligibility check could not be performed
+	Code *common.CodeableConcept `json:"code"`
+}
@@ -0,0 +1,60 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Endpoint represents the technical details of an endpoint that can be used for electronic services
+type Endpoint struct {
+	DomainResource
+
+	// The technical base address for connecting to this endpoint
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	// Protocol/Profile/Standard to be used with this endpoint connection
+	ConnectionType *common.Coding `json:"connectionType"`
+
+	// Contact details for source (e.g. troubleshooting)
+	Contact []ContactPoint `json:"contact,omitempty"`
+
+	// Usage depends on the channel type
+	Header        []string          `json:"header,omitempty"`
+	HeaderElement []*common.Element `json:"_header,omitempty"`
+
+	// Identifies this endpoint across multiple systems
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Organization that manages this endpoint (might not be the organization that exposes the endpoint)
+	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
+
+	// A name that this endpoint can be identified by
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Sending the payload has obvious security consequences
+	PayloadMimeType        []string          `json:"payloadMimeType,omitempty"`
+	PayloadMimeTypeElement []*common.Element `json:"_payloadMimeType,omitempty"`
+
+	// The payloadFormat describes the serialization format of the data
+	PayloadType []common.CodeableConcept `json:"payloadType"`
+
+	// Interval the endpoint is expected to be operational
+	Period *common.Period `json:"period,omitempty"`
+
+	// active | suspended | error | off | entered-in-error | test
+	Status        EndpointStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+}
+
+// EndpointStatus represents the status of an endpoint
+type EndpointStatus string
+
+const (
+	EndpointStatusActive         EndpointStatus = "active"
+	EndpointStatusSuspended      EndpointStatus = "suspended"
+	EndpointStatusError          EndpointStatus = "error"
+	EndpointStatusOff            EndpointStatus = "off"
+	EndpointStatusEnteredInError EndpointStatus = "entered-in-error"
+	EndpointStatusTest           EndpointStatus = "test"
+)
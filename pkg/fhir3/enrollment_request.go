@@ -0,0 +1,46 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EnrollmentRequest represents enroll in coverage
+type EnrollmentRequest struct {
+	DomainResource
+
+	// Insurance information
+	Coverage *common.Reference `json:"coverage,omitempty"`
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Business Identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Target
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Responsible practitioner
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *EnrollmentRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// Patient Resource
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// EnrollmentRequestStatus represents the status of an enrollment request
+type EnrollmentRequestStatus string
+
+const (
+	EnrollmentRequestStatusActive         EnrollmentRequestStatus = "active"
+	EnrollmentRequestStatusCancelled      EnrollmentRequestStatus = "cancelled"
+	EnrollmentRequestStatusDraft          EnrollmentRequestStatus = "draft"
+	EnrollmentRequestStatusEnteredInError EnrollmentRequestStatus = "entered-in-error"
+)
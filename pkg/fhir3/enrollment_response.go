@@ -0,0 +1,50 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EnrollmentResponse represents enrollmentResponse resource
+type EnrollmentResponse struct {
+	DomainResource
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Disposition Message
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Business Identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Insurer
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// queued | complete | error | partial
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// Claim reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// Responsible practitioner
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *EnrollmentResponseStatus `json:"status,omitempty"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+}
+
+// EnrollmentResponseStatus represents the status of an enrollment response
+type EnrollmentResponseStatus string
+
+const (
+	EnrollmentResponseStatusActive         EnrollmentResponseStatus = "active"
+	EnrollmentResponseStatusCancelled      EnrollmentResponseStatus = "cancelled"
+	EnrollmentResponseStatusDraft          EnrollmentResponseStatus = "draft"
+	EnrollmentResponseStatusEnteredInError EnrollmentResponseStatus = "entered-in-error"
+)
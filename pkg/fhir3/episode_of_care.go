@@ -0,0 +1,87 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EpisodeOfCare represents an association of a Patient with an Organization and  Healthcare Provider(s) for a period of time that the Organization assumes some level of responsibility
+type EpisodeOfCare struct {
+	DomainResource
+
+	// The set of accounts that may be used for billing for this EpisodeOfCare
+	Account []common.Reference `json:"account,omitempty"`
+
+	// Care manager/care coordinator for the patient
+	CareManager *common.Reference `json:"careManager,omitempty"`
+
+	// The list of medical conditions that were addressed during the episode of care
+	Diagnosis []EpisodeOfCareDiagnosis `json:"diagnosis,omitempty"`
+
+	// Business Identifier(s) relevant for this EpisodeOfCare
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Organization that assumes responsibility for care coordination
+	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
+
+	// The patient who is the focus of this episode of care
+	Patient *common.Reference `json:"patient"`
+
+	// Interval during responsibility is assumed
+	Period *common.Period `json:"period,omitempty"`
+
+	// Originating Referral Request(s)
+	ReferralRequest []common.Reference `json:"referralRequest,omitempty"`
+
+	// planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Past list of status codes (the current status may be included to cover the start date of the status)
+	StatusHistory []EpisodeOfCareStatusHistory `json:"statusHistory,omitempty"`
+
+	// The list of practitioners that may be facilitating this episode of care for specific purposes
+	Team []common.Reference `json:"team,omitempty"`
+
+	// Type/class  - e.g. specialist referral, disease management
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// EpisodeOfCareStatus represents the status of an episode of care
+type EpisodeOfCareStatus string
+
+const (
+	EpisodeOfCareStatusPlanned        EpisodeOfCareStatus = "planned"
+	EpisodeOfCareStatusWaitlist       EpisodeOfCareStatus = "waitlist"
+	EpisodeOfCareStatusActive         EpisodeOfCareStatus = "active"
+	EpisodeOfCareStatusOnhold         EpisodeOfCareStatus = "onhold"
+	EpisodeOfCareStatusFinished       EpisodeOfCareStatus = "finished"
+	EpisodeOfCareStatusCancelled      EpisodeOfCareStatus = "cancelled"
+	EpisodeOfCareStatusEnteredInError EpisodeOfCareStatus = "entered-in-error"
+)
+
+// EpisodeOfCareStatusHistory represents past list of status codes (the current status may be included to cover the start date of the status)
+type EpisodeOfCareStatusHistory struct {
+	common.BackboneElement
+
+	// Duration the EpisodeOfCare was in the specified status
+	Period *common.Period `json:"period"`
+
+	// planned | waitlist | active | onhold | finished | cancelled | entered-in-error
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+}
+
+// EpisodeOfCareDiagnosis represents the diagnosis of an episode of care
+type EpisodeOfCareDiagnosis struct {
+	common.BackboneElement
+
+	// The medical condition that was addressed during the episode of care
+	Condition *common.Reference `json:"condition"`
+
+	// Ranking of the diagnosis (for each role type)
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
+
+	// Role that this diagnosis has within the episode of care (e.g. admission, billing, discharge …)
+	Role *common.CodeableConcept `json:"role,omitempty"`
+}
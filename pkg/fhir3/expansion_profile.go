@@ -0,0 +1,195 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ExpansionProfile represents resource to define constraints on the Expansion of a FHIR ValueSet
+type ExpansionProfile struct {
+	DomainResource
+
+	// Inactive codes are included if activeOnly is present and has a value of "true"
+	ActiveOnly        *bool           `json:"activeOnly,omitempty"`
+	ActiveOnlyElement *common.Element `json:"_activeOnly,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the expansion profile was built, comments about misuse
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A set of criteria that provide the constraints imposed on the value set expansion by including or excluding designations
+	Designation *ExpansionProfileDesignation `json:"designation,omitempty"`
+
+	// Specifies the language to be used for description in the expansions i.e. the language to be used for ValueSet.expansion.contains.display
+	DisplayLanguage        *string         `json:"displayLanguage,omitempty"`
+	DisplayLanguageElement *common.Element `json:"_displayLanguage,omitempty"`
+
+	// When a system is excluded, and this makes a material difference to the expansion
+	ExcludedSystem *ExpansionProfileExcludedSystem `json:"excludedSystem,omitempty"`
+
+	// Nesting of codes is excluded if excludeNested is present and has a value of "true"
+	ExcludeNested        *bool           `json:"excludeNested,omitempty"`
+	ExcludeNestedElement *common.Element `json:"_excludeNested,omitempty"`
+
+	// Codes which cannot be used in user interfaces will be excluded if excludeNotForUI is present and has a value of "true"
+	ExcludeNotForUI        *bool           `json:"excludeNotForUI,omitempty"`
+	ExcludeNotForUIElement *common.Element `json:"_excludeNotForUI,omitempty"`
+
+	// Post coordinated codes are excluded if excludePostCoordinated is present and has a value of "true"
+	ExcludePostCoordinated        *bool           `json:"excludePostCoordinated,omitempty"`
+	ExcludePostCoordinatedElement *common.Element `json:"_excludePostCoordinated,omitempty"`
+
+	// Allows filtering of expansion profile that are appropriate for use vs
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Fix use of a particular code system to a particular version
+	FixedVersion []ExpansionProfileFixedVersion `json:"fixedVersion,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type, e.g.
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The ValueSet defintion refers to all fields in the ValueSet definition apart from ValueSet.contains
+	IncludeDefinition        *bool           `json:"includeDefinition,omitempty"`
+	IncludeDefinitionElement *common.Element `json:"_includeDefinition,omitempty"`
+
+	// ExpansionProfile.designation.include and ExpansionProfile.designation.exclude are only evaluated if includeDesignations is present and set to "true"
+	IncludeDesignations        *bool           `json:"includeDesignations,omitempty"`
+	IncludeDesignationsElement *common.Element `json:"_includeDesignations,omitempty"`
+
+	// It may be possible for the expansion profile to be used in jurisdictions other than those for which it was originally designed or intended
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// This field overides the default behaviour (which is to return an error)
+	LimitedExpansion        *bool           `json:"limitedExpansion,omitempty"`
+	LimitedExpansionElement *common.Element `json:"_limitedExpansion,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Usually an organization, but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Allows filtering of expansion profiles that are appropriate for use vs
+	Status        ExpansionProfileStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid:, but real http: addresses are preferred
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation whether all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different expansion profile instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ExpansionProfileStatus represents the status of an expansion profile
+type ExpansionProfileStatus string
+
+const (
+	ExpansionProfileStatusDraft   ExpansionProfileStatus = "draft"
+	ExpansionProfileStatusActive  ExpansionProfileStatus = "active"
+	ExpansionProfileStatusRetired ExpansionProfileStatus = "retired"
+	ExpansionProfileStatusUnknown ExpansionProfileStatus = "unknown"
+)
+
+// ExpansionProfileFixedVersion represents fix use of a particular code system to a particular version
+type ExpansionProfileFixedVersion struct {
+	common.BackboneElement
+
+	// How to manage the intersection between a fixed version in a value set
+	Mode        ExpansionProfileFixedVersionMode `json:"mode"`
+	ModeElement *common.Element                  `json:"_mode,omitempty"`
+
+	// See ''Coding.system'' for further documentation
+	System        string          `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// The version of the code system from which codes in the expansion should be included
+	Version        string          `json:"version"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ExpansionProfileFixedVersionMode represents the mode of an expansion profile fixed version
+type ExpansionProfileFixedVersionMode string
+
+const (
+	ExpansionProfileFixedVersionModeDefault  ExpansionProfileFixedVersionMode = "default"
+	ExpansionProfileFixedVersionModeCheck    ExpansionProfileFixedVersionMode = "check"
+	ExpansionProfileFixedVersionModeOverride ExpansionProfileFixedVersionMode = "override"
+)
+
+// ExpansionProfileExcludedSystem represents when a system is excluded, and this makes a material difference to the expansion
+type ExpansionProfileExcludedSystem struct {
+	common.BackboneElement
+
+	// See ''Coding.system'' for further documentation
+	System        string          `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// This is used to apply version specifc exclusion contraints
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ExpansionProfileDesignationIncludeDesignation represents at least one of designation.language or designation.use must be provided for each include.designation element
+type ExpansionProfileDesignationIncludeDesignation struct {
+	common.BackboneElement
+
+	// In the absence of a language, all designations are retained which match the designation.use parameter for this include.designation
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// In the absence of a use, all designations are retained which match the designation.language parameter for this include.designation element
+	Use *common.Coding `json:"use,omitempty"`
+}
+
+// ExpansionProfileDesignationInclude represents designations to be included
+type ExpansionProfileDesignationInclude struct {
+	common.BackboneElement
+
+	// At least one of designation.language or designation.use must be provided for each include.designation element
+	Designation []ExpansionProfileDesignationIncludeDesignation `json:"designation,omitempty"`
+}
+
+// ExpansionProfileDesignationExcludeDesignation represents at least one of designation.language or designation.use must be provided for each exclude.designation element
+type ExpansionProfileDesignationExcludeDesignation struct {
+	common.BackboneElement
+
+	// In the absence of a language, all designations are excluded which match the designation.use parameter for this exclude.designation element
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// In the absence of a use, all designations are retained which match the designation.language parameter for this exclude.designation element
+	Use *common.Coding `json:"use,omitempty"`
+}
+
+// ExpansionProfileDesignationExclude represents designations to be excluded
+type ExpansionProfileDesignationExclude struct {
+	common.BackboneElement
+
+	// At least one of designation.language or designation.use must be provided for each exclude.designation element
+	Designation []ExpansionProfileDesignationExcludeDesignation `json:"designation,omitempty"`
+}
+
+// ExpansionProfileDesignation represents a set of criteria that provide the constraints imposed on the value set expansion by including or excluding designations
+type ExpansionProfileDesignation struct {
+	common.BackboneElement
+
+	// Designations to be excluded
+	Exclude *ExpansionProfileDesignationExclude `json:"exclude,omitempty"`
+
+	// Designations to be included
+	Include *ExpansionProfileDesignationInclude `json:"include,omitempty"`
+}
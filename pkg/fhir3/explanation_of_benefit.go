@@ -0,0 +1,646 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ExplanationOfBenefit represents this resource provides: the claim details; adjudication details from the processing of a Claim
+type ExplanationOfBenefit struct {
+	DomainResource
+
+	// An accident which resulted in the need for healthcare services
+	Accident *ExplanationOfBenefitAccident `json:"accident,omitempty"`
+
+	// The first tier service adjudications for payor added services
+	AddItem []ExplanationOfBenefitAddItem `json:"addItem,omitempty"`
+
+	// Balance by Benefit Category
+	BenefitBalance []ExplanationOfBenefitBenefitBalance `json:"benefitBalance,omitempty"`
+
+	// The billable period for which charges are being submitted
+	BillablePeriod *common.Period `json:"billablePeriod,omitempty"`
+
+	// The members of the team who provided the overall service as well as their role and whether responsible and qualifications
+	CareTeam []ExplanationOfBenefitCareTeam `json:"careTeam,omitempty"`
+
+	// The business identifier for the instance: invoice number, claim number, pre-determination or pre-authorization number
+	Claim         *common.Reference `json:"claim,omitempty"`
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	// The date when the EOB was created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Ordered list of patient diagnosis for which care is sought
+	Diagnosis []ExplanationOfBenefitDiagnosis `json:"diagnosis,omitempty"`
+
+	// Do we need a disposition code?
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// The start and optional end dates of when the patient was precluded from working due to the treatable condition(s)
+	EmploymentImpacted *common.Period `json:"employmentImpacted,omitempty"`
+
+	// The person who created the explanation of benefit
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Facility where the services were provided
+	Facility *common.Reference `json:"facility,omitempty"`
+
+	// The form to be used for printing the content
+	Form *common.CodeableConcept `json:"form,omitempty"`
+
+	// The start and optional end dates of when the patient was confined to a treatment center
+	Hospitalization *common.Period `json:"hospitalization,omitempty"`
+
+	// The EOB Business Identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Additional information codes regarding exceptions, special considerations, the condition, situation
+	Information []ExplanationOfBenefitInformation `json:"information,omitempty"`
+
+	// Financial instrument by which payment information for health care
+	Insurance *ExplanationOfBenefitInsurance `json:"insurance,omitempty"`
+
+	// The insurer which is responsible for the explanation of benefit
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// First tier of goods and services
+	Item []ExplanationOfBenefitItem `json:"item,omitempty"`
+
+	// The provider which is responsible for the claim
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Original prescription which has been superceded by this prescription to support the dispensing of pharmacy services
+	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
+
+	// Processing outcome errror, partial or complete processing
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// Patient Resource
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// The party to be reimbursed for the services
+	Payee *ExplanationOfBenefitPayee `json:"payee,omitempty"`
+
+	// Payment details for the claim if the claim has been paid
+	Payment *ExplanationOfBenefitPayment `json:"payment,omitempty"`
+
+	// Precedence (primary, secondary, etc.)
+	Precedence        *int            `json:"precedence,omitempty"`
+	PrecedenceElement *common.Element `json:"_precedence,omitempty"`
+
+	// Prescription to support the dispensing of Pharmacy or Vision products
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// Ordered list of patient procedures performed to support the adjudication
+	Procedure []ExplanationOfBenefitProcedure `json:"procedure,omitempty"`
+
+	// Note text
+	ProcessNote []ExplanationOfBenefitProcessNote `json:"processNote,omitempty"`
+
+	// The provider which is responsible for the claim
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// The referral resource which lists the date, practitioner, reason and other supporting information
+	Referral *common.Reference `json:"referral,omitempty"`
+
+	// Other claims which are related to this claim such as prior claim versions or for related services
+	Related []ExplanationOfBenefitRelated `json:"related,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        *ExplanationOfBenefitStatus `json:"status,omitempty"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
+
+	// This may contain the local bill type codes such as the US UB-04 bill type code
+	SubType []common.CodeableConcept `json:"subType,omitempty"`
+
+	// Total amount of benefit payable
+	TotalBenefit *common.Money `json:"totalBenefit,omitempty"`
+
+	// The total cost of the services reported
+	TotalCost *common.Money `json:"totalCost,omitempty"`
+
+	// Affects which fields and value sets are used
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// The amount of deductable applied which was not allocated to any particular service line
+	UnallocDeductable *common.Money `json:"unallocDeductable,omitempty"`
+}
+
+// ExplanationOfBenefitStatus represents the status of an explanation of benefit
+type ExplanationOfBenefitStatus string
+
+const (
+	ExplanationOfBenefitStatusActive         ExplanationOfBenefitStatus = "active"
+	ExplanationOfBenefitStatusCancelled      ExplanationOfBenefitStatus = "cancelled"
+	ExplanationOfBenefitStatusDraft          ExplanationOfBenefitStatus = "draft"
+	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
+)
+
+// ExplanationOfBenefitRelated represents other claims which are related to this claim such as prior claim versions or for related services
+type ExplanationOfBenefitRelated struct {
+	common.BackboneElement
+
+	// Do we need a relationship code?
+	Claim *common.Reference `json:"claim,omitempty"`
+
+	// An alternate organizational reference to the case or file to which this particular claim pertains
+	Reference *common.Identifier `json:"reference,omitempty"`
+
+	// For example prior or umbrella
+	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
+}
+
+// ExplanationOfBenefitPayee represents the payee of an explanation of benefit
+type ExplanationOfBenefitPayee struct {
+	common.BackboneElement
+
+	// Party to be reimbursed: Subscriber, provider, other
+	Party *common.Reference `json:"party,omitempty"`
+
+	// organization | patient | practitioner | relatedperson
+	ResourceType *common.CodeableConcept `json:"resourceType,omitempty"`
+
+	// Type of Party to be reimbursed: Subscriber, provider, other
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitInformation represents additional information codes regarding exceptions, special considerations, the condition, situation
+type ExplanationOfBenefitInformation struct {
+	common.BackboneElement
+
+	// This may contain the local bill type codes such as the US UB-04 bill type code
+	Category *common.CodeableConcept `json:"category"`
+	Code     *common.CodeableConcept `json:"code,omitempty"`
+
+	// For example, provides the reason for: the additional stay
+	Reason *common.Coding `json:"reason,omitempty"`
+
+	// Sequence of the information element which serves to provide a link
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The date when or period to which this information refers
+	TimingDate        *common.Date    `json:"timingDate,omitempty"`
+	TimingDateElement *common.Element `json:"_timingDate,omitempty"`
+	TimingPeriod      *common.Period  `json:"timingPeriod,omitempty"`
+
+	// Additional data or information such as resources, documents
+	ValueString        *string           `json:"valueString,omitempty"`
+	ValueStringElement *common.Element   `json:"_valueString,omitempty"`
+	ValueQuantity      *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueAttachment    *Attachment       `json:"valueAttachment,omitempty"`
+	ValueReference     *common.Reference `json:"valueReference,omitempty"`
+}
+
+// ExplanationOfBenefitCareTeam represents the care team of an explanation of benefit
+type ExplanationOfBenefitCareTeam struct {
+	common.BackboneElement
+
+	// The members of the team who provided the overall service
+	Provider *common.Reference `json:"provider"`
+
+	// The qualification which is applicable for this service
+	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
+
+	// The practitioner who is billing and responsible for the claimed services rendered to the patient
+	Responsible        *bool           `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
+
+	// The lead, assisting or supervising practitioner and their discipline if a multidisiplinary team
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// Sequence of careteam which serves to order and provide a link
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ExplanationOfBenefitDiagnosis represents ordered list of patient diagnosis for which care is sought
+type ExplanationOfBenefitDiagnosis struct {
+	common.BackboneElement
+
+	// The diagnosis
+	DiagnosisCodeableConcept *common.CodeableConcept `json:"diagnosisCodeableConcept,omitempty"`
+	DiagnosisReference       *common.Reference       `json:"diagnosisReference,omitempty"`
+
+	// The package billing code, for example DRG, based on the assigned grouping code system
+	PackageCode *common.CodeableConcept `json:"packageCode,omitempty"`
+
+	// Sequence of diagnosis which serves to provide a link
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// Diagnosis are presented in list order to their expected importance: primary, secondary, etc
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitProcedure represents ordered list of patient procedures performed to support the adjudication
+type ExplanationOfBenefitProcedure struct {
+	common.BackboneElement
+
+	// SB DateTime??
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// The procedure code
+	ProcedureCodeableConcept *common.CodeableConcept `json:"procedureCodeableConcept,omitempty"`
+	ProcedureReference       *common.Reference       `json:"procedureReference,omitempty"`
+
+	// Sequence of procedures which serves to order and provide a link
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ExplanationOfBenefitInsurance represents financial instrument by which payment information for health care
+type ExplanationOfBenefitInsurance struct {
+	common.BackboneElement
+
+	// Reference to the program or plan identification, underwriter or payor
+	Coverage *common.Reference `json:"coverage,omitempty"`
+
+	// A list of references from the Insurer to which these services pertain
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+}
+
+// ExplanationOfBenefitAccident represents an accident which resulted in the need for healthcare services
+type ExplanationOfBenefitAccident struct {
+	common.BackboneElement
+
+	// Date of an accident which these services are addressing
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Where the accident occurred
+	LocationAddress   *Address          `json:"locationAddress,omitempty"`
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	// Type of accident: work, auto, etc
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitItemAdjudication represents the adjudication of an explanation of benefit item detail sub detail
+type ExplanationOfBenefitItemAdjudication struct {
+	common.BackboneElement
+
+	// Monitory amount associated with the code
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// Code indicating: Co-Pay, deductable, elegible, benefit, tax, etc
+	Category *common.CodeableConcept `json:"category"`
+
+	// Adjudication reason such as limit reached
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// A non-monetary value for example a percentage
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ExplanationOfBenefitItemDetailSubDetail represents third tier of goods and services
+type ExplanationOfBenefitItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// A real number that represents a multiplier used in determining the overall value of services delivered and/or goods received
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Item typification or modifiers codes, eg for Oral whether the treatment is cosmetic or associated with TMJ
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// The quantity times the unit price for an addittional service or product or charge
+	Net *common.Money `json:"net,omitempty"`
+
+	// A list of note references to the notes provided below
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// For programs which require reson codes for the inclusion, covering, of this billed item under the program or sub-program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The type of reveneu or cost center providing the product and/or service
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A service line number
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied (eg
+	Service *common.CodeableConcept `json:"service,omitempty"`
+
+	// The type of product or service
+	Type *common.CodeableConcept `json:"type"`
+
+	// List of Unique Device Identifiers associated with this line item
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// The fee for an addittional service or product or charge
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitItemDetail represents second tier of goods and services
+type ExplanationOfBenefitItemDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// A real number that represents a multiplier used in determining the overall value of services delivered and/or goods received
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Item typification or modifiers codes, eg for Oral whether the treatment is cosmetic or associated with TMJ
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// The quantity times the unit price for an addittional service or product or charge
+	Net *common.Money `json:"net,omitempty"`
+
+	// A list of note references to the notes provided below
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// For programs which require reson codes for the inclusion, covering, of this billed item under the program or sub-program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The type of reveneu or cost center providing the product and/or service
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A service line number
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// If this is an actual service or product line, ie
+	Service *common.CodeableConcept `json:"service,omitempty"`
+
+	// Third tier of goods and services
+	SubDetail []ExplanationOfBenefitItemDetailSubDetail `json:"subDetail,omitempty"`
+
+	// ItemType
+	Type *common.CodeableConcept `json:"type"`
+
+	// List of Unique Device Identifiers associated with this line item
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// If the item is a node then this is the fee for the product or service
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitItem represents first tier of goods and services
+type ExplanationOfBenefitItem struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Physical service site on the patient (limb, tooth, etc)
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// Careteam applicable for this service or product line
+	CareTeamLinkId        []int             `json:"careTeamLinkId,omitempty"`
+	CareTeamLinkIdElement []*common.Element `json:"_careTeamLinkId,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Second tier of goods and services
+	Detail []ExplanationOfBenefitItemDetail `json:"detail,omitempty"`
+
+	// Diagnosis applicable for this service or product line
+	DiagnosisLinkId        []int             `json:"diagnosisLinkId,omitempty"`
+	DiagnosisLinkIdElement []*common.Element `json:"_diagnosisLinkId,omitempty"`
+
+	// A billed item may include goods or services provided in multiple encounters
+	Encounter []common.Reference `json:"encounter,omitempty"`
+
+	// A real number that represents a multiplier used in determining the overall value of services delivered and/or goods received
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Exceptions, special conditions and supporting information pplicable for this service or product line
+	InformationLinkId        []int             `json:"informationLinkId,omitempty"`
+	InformationLinkIdElement []*common.Element `json:"_informationLinkId,omitempty"`
+
+	// Where the service was provided
+	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
+	LocationAddress         *Address                `json:"locationAddress,omitempty"`
+	LocationReference       *common.Reference       `json:"locationReference,omitempty"`
+
+	// Item typification or modifiers codes, eg for Oral whether the treatment is cosmetic or associated with TMJ
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// The quantity times the unit price for an addittional service or product or charge
+	Net *common.Money `json:"net,omitempty"`
+
+	// A list of note references to the notes provided below
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// Procedures applicable for this service or product line
+	ProcedureLinkId        []int             `json:"procedureLinkId,omitempty"`
+	ProcedureLinkIdElement []*common.Element `json:"_procedureLinkId,omitempty"`
+
+	// For programs which require reson codes for the inclusion, covering, of this billed item under the program or sub-program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The type of reveneu or cost center providing the product and/or service
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A service line number
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// If this is an actual service or product line, ie
+	Service *common.CodeableConcept `json:"service,omitempty"`
+
+	// The date or dates when the enclosed suite of services were performed or completed
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period  `json:"servicedPeriod,omitempty"`
+
+	// A region or surface of the site, eg
+	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
+
+	// List of Unique Device Identifiers associated with this line item
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// If the item is a node then this is the fee for the product or service
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitAddItemDetail represents the detail of an explanation of benefit add item
+type ExplanationOfBenefitAddItemDetail struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The fee charged for the professional service or product
+	Fee *common.Money `json:"fee,omitempty"`
+
+	// Item typification or modifiers codes, eg for Oral whether the treatment is cosmetic or associated with TMJ
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// A list of note references to the notes provided below
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// The type of reveneu or cost center providing the product and/or service
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A code to indicate the Professional Service or Product supplied (eg
+	Service *common.CodeableConcept `json:"service,omitempty"`
+}
+
+// ExplanationOfBenefitAddItem represents the add item of an explanation of benefit
+type ExplanationOfBenefitAddItem struct {
+	common.BackboneElement
+
+	// The adjudications results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Health Care Service Type Codes to identify the classification of service or benefits
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The second tier service adjudications for payor added services
+	Detail []ExplanationOfBenefitAddItemDetail `json:"detail,omitempty"`
+
+	// The fee charged for the professional service or product
+	Fee *common.Money `json:"fee,omitempty"`
+
+	// Item typification or modifiers codes, eg for Oral whether the treatment is cosmetic or associated with TMJ
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// A list of note references to the notes provided below
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// The type of reveneu or cost center providing the product and/or service
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// List of input service items which this service line is intended to replace
+	SequenceLinkId        []int             `json:"sequenceLinkId,omitempty"`
+	SequenceLinkIdElement []*common.Element `json:"_sequenceLinkId,omitempty"`
+
+	// If this is an actual service or product line, ie
+	Service *common.CodeableConcept `json:"service,omitempty"`
+}
+
+// ExplanationOfBenefitPayment represents payment details for the claim if the claim has been paid
+type ExplanationOfBenefitPayment struct {
+	common.BackboneElement
+
+	// Adjustment to the payment of this transaction which is not related to adjudication of this transaction
+	Adjustment *common.Money `json:"adjustment,omitempty"`
+
+	// Reason for the payment adjustment
+	AdjustmentReason *common.CodeableConcept `json:"adjustmentReason,omitempty"`
+
+	// Payable less any payment adjustment
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// Estimated payment date
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Payment identifer
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Whether this represents partial or complete payment of the claim
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitProcessNote represents note text
+type ExplanationOfBenefitProcessNote struct {
+	common.BackboneElement
+
+	// The ISO-639-1 alpha 2 code in lower case for the language
+	Language *common.CodeableConcept `json:"language,omitempty"`
+
+	// An integer associated with each note which may be referred to from each service line item
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// The note text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The note purpose: Print/Display
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitBenefitBalanceFinancial represents Benefits Used to date
+type ExplanationOfBenefitBenefitBalanceFinancial struct {
+	common.BackboneElement
+
+	// Benefits allowed
+	AllowedUnsignedInt        *int            `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *common.Element `json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string         `json:"allowedString,omitempty"`
+	AllowedStringElement      *common.Element `json:"_allowedString,omitempty"`
+	AllowedMoney              *common.Money   `json:"allowedMoney,omitempty"`
+
+	// Deductable, visits, benefit amount
+	Type *common.CodeableConcept `json:"type"`
+
+	// Benefits used
+	UsedUnsignedInt        *int            `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement *common.Element `json:"_usedUnsignedInt,omitempty"`
+	UsedMoney              *common.Money   `json:"usedMoney,omitempty"`
+}
+
+// ExplanationOfBenefitBenefitBalance represents balance by Benefit Category
+type ExplanationOfBenefitBenefitBalance struct {
+	common.BackboneElement
+
+	// Dental, Vision, Medical, Pharmacy, Rehab etc
+	Category *common.CodeableConcept `json:"category"`
+
+	// A richer description of the benefit, for example 'DENT2 covers 100% of basic, 50% of major but exclused Ortho
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// True if the indicated class of service is excluded from the plan
+	Excluded        *bool           `json:"excluded,omitempty"`
+	ExcludedElement *common.Element `json:"_excluded,omitempty"`
+
+	// Benefits Used to date
+	Financial []ExplanationOfBenefitBenefitBalanceFinancial `json:"financial,omitempty"`
+
+	// A short name or tag for the benefit, for example MED01, or DENT2
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Network designation
+	Network *common.CodeableConcept `json:"network,omitempty"`
+
+	// Dental: basic, major, ortho; Vision exam, glasses, contacts; etc
+	SubCategory *common.CodeableConcept `json:"subCategory,omitempty"`
+
+	// The term or period of the values such as 'maximum lifetime benefit' or 'maximum annual vistis'
+	Term *common.CodeableConcept `json:"term,omitempty"`
+
+	// Unit designation: individual or family
+	Unit *common.CodeableConcept `json:"unit,omitempty"`
+}
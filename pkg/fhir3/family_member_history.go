@@ -0,0 +1,125 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// FamilyMemberHistory represents information about patient's relatives, relevant for patient
+type FamilyMemberHistory struct {
+	DomainResource
+
+	// (approximate) age
+	AgeAge           *Age            `json:"ageAge,omitempty"`
+	AgeRange         *Range          `json:"ageRange,omitempty"`
+	AgeString        *string         `json:"ageString,omitempty"`
+	AgeStringElement *common.Element `json:"_ageString,omitempty"`
+
+	// (approximate) date of birth
+	BornPeriod        *common.Period  `json:"bornPeriod,omitempty"`
+	BornDate          *common.Date    `json:"bornDate,omitempty"`
+	BornDateElement   *common.Element `json:"_bornDate,omitempty"`
+	BornString        *string         `json:"bornString,omitempty"`
+	BornStringElement *common.Element `json:"_bornString,omitempty"`
+
+	// Condition that the related person had
+	Condition []FamilyMemberHistoryCondition `json:"condition,omitempty"`
+
+	// When history was recorded or last updated
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Dead? How old/when?
+	DeceasedBoolean        *bool           `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement *common.Element `json:"_deceasedBoolean,omitempty"`
+	DeceasedAge            *Age            `json:"deceasedAge,omitempty"`
+	DeceasedRange          *Range          `json:"deceasedRange,omitempty"`
+	DeceasedDate           *common.Date    `json:"deceasedDate,omitempty"`
+	DeceasedDateElement    *common.Element `json:"_deceasedDate,omitempty"`
+	DeceasedString         *string         `json:"deceasedString,omitempty"`
+	DeceasedStringElement  *common.Element `json:"_deceasedString,omitempty"`
+
+	// A protocol or questionnaire that was adhered to in whole or in part by this event
+	Definition []common.Reference `json:"definition,omitempty"`
+
+	// Age is estimated?
+	EstimatedAge        *bool           `json:"estimatedAge,omitempty"`
+	EstimatedAgeElement *common.Element `json:"_estimatedAge,omitempty"`
+
+	// Administrative Gender - the gender that the relative is considered to have for administration and record keeping purposes
+	Gender        *FamilyMemberHistoryGender `json:"gender,omitempty"`
+	GenderElement *common.Element            `json:"_gender,omitempty"`
+
+	// External Id(s) for this record
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The family member described
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// This element is labeled as a modifier because it marks the family member history as a family member history that did not occur
+	NotDone        *bool           `json:"notDone,omitempty"`
+	NotDoneElement *common.Element `json:"_notDone,omitempty"`
+
+	// This notDoneReason element is being evaluated and will likely be replaced in a subsequent release
+	NotDoneReason *common.CodeableConcept `json:"notDoneReason,omitempty"`
+
+	// General note about related person
+	Note []Annotation `json:"note,omitempty"`
+
+	// Patient history is about
+	Patient *common.Reference `json:"patient"`
+
+	// Textual reasons can be caprued using reasonCode.text
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Indicates a Condition, Observation, AllergyIntolerance
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// Relationship to the subject
+	Relationship *common.CodeableConcept `json:"relationship"`
+
+	// partial | completed | entered-in-error | health-unknown
+	Status        FamilyMemberHistoryStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+}
+
+// FamilyMemberHistoryGender represents the gender of a family member history
+type FamilyMemberHistoryGender string
+
+const (
+	FamilyMemberHistoryGenderMale    FamilyMemberHistoryGender = "male"
+	FamilyMemberHistoryGenderFemale  FamilyMemberHistoryGender = "female"
+	FamilyMemberHistoryGenderOther   FamilyMemberHistoryGender = "other"
+	FamilyMemberHistoryGenderUnknown FamilyMemberHistoryGender = "unknown"
+)
+
+// FamilyMemberHistoryStatus represents the status of a family member history
+type FamilyMemberHistoryStatus string
+
+const (
+	FamilyMemberHistoryStatusPartial        FamilyMemberHistoryStatus = "partial"
+	FamilyMemberHistoryStatusCompleted      FamilyMemberHistoryStatus = "completed"
+	FamilyMemberHistoryStatusEnteredInError FamilyMemberHistoryStatus = "entered-in-error"
+	FamilyMemberHistoryStatusHealthUnknown  FamilyMemberHistoryStatus = "health-unknown"
+)
+
+// FamilyMemberHistoryCondition represents condition that the related person had
+type FamilyMemberHistoryCondition struct {
+	common.BackboneElement
+
+	// Condition suffered by relation
+	Code *common.CodeableConcept `json:"code"`
+
+	// Extra information about condition
+	Note []Annotation `json:"note,omitempty"`
+
+	// When condition first manifested
+	OnsetAge           *Age            `json:"onsetAge,omitempty"`
+	OnsetRange         *Range          `json:"onsetRange,omitempty"`
+	OnsetPeriod        *common.Period  `json:"onsetPeriod,omitempty"`
+	OnsetString        *string         `json:"onsetString,omitempty"`
+	OnsetStringElement *common.Element `json:"_onsetString,omitempty"`
+
+	// deceased | permanent disability | etc
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+}
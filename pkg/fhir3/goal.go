@@ -0,0 +1,97 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Goal describes the intended objective(s) for a patient, group or organization
+type Goal struct {
+	DomainResource
+
+	// Issues addressed by this goal
+	Addresses []common.Reference `json:"addresses,omitempty"`
+
+	// E.g. Treatment, dietary, behavioral, etc
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Code or text describing goal
+	Description *common.CodeableConcept `json:"description"`
+
+	// This is the individual reponsible for establishing the goal, not necessarily who recorded it
+	ExpressedBy *common.Reference `json:"expressedBy,omitempty"`
+
+	// External Ids for this goal
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Comments about the goal
+	Note []Annotation `json:"note,omitempty"`
+
+	// Note that this should not duplicate the goal status
+	OutcomeCode []common.CodeableConcept `json:"outcomeCode,omitempty"`
+
+	// The goal outcome is independent of the outcome of the related activities
+	OutcomeReference []common.Reference `json:"outcomeReference,omitempty"`
+
+	// high-priority | medium-priority | low-priority
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// When goal pursuit begins
+	StartDate            *common.Date            `json:"startDate,omitempty"`
+	StartDateElement     *common.Element         `json:"_startDate,omitempty"`
+	StartCodeableConcept *common.CodeableConcept `json:"startCodeableConcept,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        GoalStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// When goal status took effect
+	StatusDate        *common.Date    `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element `json:"_statusDate,omitempty"`
+
+	// Reason for current status
+	StatusReason        *string         `json:"statusReason,omitempty"`
+	StatusReasonElement *common.Element `json:"_statusReason,omitempty"`
+
+	// Who this goal is intended for
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Target outcome for the goal
+	Target *GoalTarget `json:"target,omitempty"`
+}
+
+// GoalStatus represents the status of a goal
+type GoalStatus string
+
+const (
+	GoalStatusProposed       GoalStatus = "proposed"
+	GoalStatusAccepted       GoalStatus = "accepted"
+	GoalStatusPlanned        GoalStatus = "planned"
+	GoalStatusInProgress     GoalStatus = "in-progress"
+	GoalStatusOnTarget       GoalStatus = "on-target"
+	GoalStatusAheadOfTarget  GoalStatus = "ahead-of-target"
+	GoalStatusBehindTarget   GoalStatus = "behind-target"
+	GoalStatusSustaining     GoalStatus = "sustaining"
+	GoalStatusAchieved       GoalStatus = "achieved"
+	GoalStatusOnHold         GoalStatus = "on-hold"
+	GoalStatusCancelled      GoalStatus = "cancelled"
+	GoalStatusEnteredInError GoalStatus = "entered-in-error"
+	GoalStatusRejected       GoalStatus = "rejected"
+)
+
+// GoalTarget represents target outcome for the goal
+type GoalTarget struct {
+	common.BackboneElement
+
+	// The target value to be achieved
+	DetailQuantity        *common.Quantity        `json:"detailQuantity,omitempty"`
+	DetailRange           *Range                  `json:"detailRange,omitempty"`
+	DetailCodeableConcept *common.CodeableConcept `json:"detailCodeableConcept,omitempty"`
+
+	// Reach goal on or before
+	DueDate        *common.Date    `json:"dueDate,omitempty"`
+	DueDateElement *common.Element `json:"_dueDate,omitempty"`
+	DueDuration    *Duration       `json:"dueDuration,omitempty"`
+
+	// The parameter whose value is being tracked
+	Measure *common.CodeableConcept `json:"measure,omitempty"`
+}
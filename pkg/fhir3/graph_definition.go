@@ -0,0 +1,154 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// GraphDefinition represents definition of a graph of resources
+type GraphDefinition struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the graph definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Intended jurisdiction for graph definition (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Links this graph makes rules about
+	Link []GraphDefinitionLink `json:"link,omitempty"`
+
+	// Name for this graph definition (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The code does not include the '$' prefix that is always included in the URL when the operation is invoked
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this graph definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Starting Node
+	Start        string          `json:"start"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        GraphDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// Canonical identifier for this graph definition, represented as a URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the graph definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// GraphDefinitionStatus represents the status of a graph definition
+type GraphDefinitionStatus string
+
+const (
+	GraphDefinitionStatusDraft   GraphDefinitionStatus = "draft"
+	GraphDefinitionStatusActive  GraphDefinitionStatus = "active"
+	GraphDefinitionStatusRetired GraphDefinitionStatus = "retired"
+	GraphDefinitionStatusUnknown GraphDefinitionStatus = "unknown"
+)
+
+// GraphDefinitionLinkTargetCompartment represents Compartment Consistency Rules
+type GraphDefinitionLinkTargetCompartment struct {
+	common.BackboneElement
+
+	// Identifies the compartment
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Documentation for FHIRPath expression
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Custom rule, as a FHIRPath expression
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// identical | matching | different | no-rule | custom
+	Rule        GraphDefinitionLinkTargetCompartmentRule `json:"rule"`
+	RuleElement *common.Element                          `json:"_rule,omitempty"`
+}
+
+// GraphDefinitionLinkTargetCompartmentRule represents the rule of a graph definition link target compartment
+type GraphDefinitionLinkTargetCompartmentRule string
+
+const (
+	GraphDefinitionLinkTargetCompartmentRuleIdentical GraphDefinitionLinkTargetCompartmentRule = "identical"
+	GraphDefinitionLinkTargetCompartmentRuleMatching  GraphDefinitionLinkTargetCompartmentRule = "matching"
+	GraphDefinitionLinkTargetCompartmentRuleDifferent GraphDefinitionLinkTargetCompartmentRule = "different"
+	GraphDefinitionLinkTargetCompartmentRuleCustom    GraphDefinitionLinkTargetCompartmentRule = "custom"
+)
+
+// GraphDefinitionLinkTarget represents potential target for the link
+type GraphDefinitionLinkTarget struct {
+	common.BackboneElement
+
+	// Compartment Consistency Rules
+	Compartment []GraphDefinitionLinkTargetCompartment `json:"compartment,omitempty"`
+
+	// Additional links from target resource
+	Link []GraphDefinitionLink `json:"link,omitempty"`
+
+	// Profile for the target resource
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
+
+	// Type of resource this link refers to
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// GraphDefinitionLink represents links this graph makes rules about
+type GraphDefinitionLink struct {
+	common.BackboneElement
+
+	// Why this link is specified
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Maximum occurrences for this link
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Minimum occurrences for this link
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Path in the resource that contains the link
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// Which slice (if profiled)
+	SliceName        *string         `json:"sliceName,omitempty"`
+	SliceNameElement *common.Element `json:"_sliceName,omitempty"`
+
+	// Potential target for the link
+	Target []GraphDefinitionLinkTarget `json:"target"`
+}
@@ -0,0 +1,68 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// GuidanceResponse represents the formal response to a guidance request
+type GuidanceResponse struct {
+	DomainResource
+
+	// Allows the context of the guidance response to be provided if available
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Additional required data
+	DataRequirement []common.DataRequirement `json:"dataRequirement,omitempty"`
+
+	// Messages resulting from the evaluation of the artifact or artifacts
+	EvaluationMessage []common.Reference `json:"evaluationMessage,omitempty"`
+
+	// Business identifier
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// A reference to the knowledge module that was invoked
+	Module *common.Reference `json:"module"`
+
+	// Additional notes about the response
+	Note []Annotation `json:"note,omitempty"`
+
+	// When the guidance response was processed
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+
+	// The output parameters of the evaluation, if any
+	OutputParameters *common.Reference `json:"outputParameters,omitempty"`
+
+	// Device returning the guidance
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Indicates the reason the request was initiated
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// The id of the request associated with this response
+	RequestId        *string         `json:"requestId,omitempty"`
+	RequestIdElement *common.Element `json:"_requestId,omitempty"`
+
+	// Proposed actions, if any
+	Result *common.Reference `json:"result,omitempty"`
+
+	// success | data-requested | data-required | in-progress | failure | entered-in-error
+	Status        GuidanceResponseStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// Patient the request was performed for
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// GuidanceResponseStatus represents the status of a guidance response
+type GuidanceResponseStatus string
+
+const (
+	GuidanceResponseStatusSuccess        GuidanceResponseStatus = "success"
+	GuidanceResponseStatusDataRequested  GuidanceResponseStatus = "data-requested"
+	GuidanceResponseStatusDataRequired   GuidanceResponseStatus = "data-required"
+	GuidanceResponseStatusInProgress     GuidanceResponseStatus = "in-progress"
+	GuidanceResponseStatusFailure        GuidanceResponseStatus = "failure"
+	GuidanceResponseStatusEnteredInError GuidanceResponseStatus = "entered-in-error"
+)
@@ -0,0 +1,134 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// HealthcareService represents the details of a healthcare service available at a location
+type HealthcareService struct {
+	DomainResource
+
+	// Whether this HealthcareService record is in active use
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// If an appointment is required for access to this service
+	AppointmentRequired        *bool           `json:"appointmentRequired,omitempty"`
+	AppointmentRequiredElement *common.Element `json:"_appointmentRequired,omitempty"`
+
+	// A description of site availability exceptions, e.g. public holiday availability
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
+
+	// More detailed availability information may be provided in associated Schedule/Slot resources
+	AvailableTime []HealthcareServiceAvailableTime `json:"availableTime,omitempty"`
+
+	// Broad category of service being performed or delivered
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Collection of characteristics (attributes)
+	Characteristic []common.CodeableConcept `json:"characteristic,omitempty"`
+
+	// Additional description and/or any specific issues not covered elsewhere
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Location(s) service is intended for/available to
+	CoverageArea []common.Reference `json:"coverageArea,omitempty"`
+
+	// Specific eligibility requirements required to use the service
+	Eligibility *common.CodeableConcept `json:"eligibility,omitempty"`
+
+	// The description of service eligibility should, in general, not exceed one or two paragraphs
+	EligibilityNote        *string         `json:"eligibilityNote,omitempty"`
+	EligibilityNoteElement *common.Element `json:"_eligibilityNote,omitempty"`
+
+	// Technical endpoints providing access to electronic services operated for the healthcare service
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// Extra details about the service that can't be placed in the other fields
+	ExtraDetails        *string         `json:"extraDetails,omitempty"`
+	ExtraDetailsElement *common.Element `json:"_extraDetails,omitempty"`
+
+	// External identifiers for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Location(s) where service may be provided
+	Location []common.Reference `json:"location,omitempty"`
+
+	// Description of service as presented to a consumer while searching
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The HealthcareService is not available during this period of time due to the provided reason
+	NotAvailable []HealthcareServiceNotAvailable `json:"notAvailable,omitempty"`
+
+	// Facilitates quick identification of the service
+	Photo *Attachment `json:"photo,omitempty"`
+
+	// Programs are often defined externally to an Organization, commonly by governments
+	ProgramName        []string          `json:"programName,omitempty"`
+	ProgramNameElement []*common.Element `json:"_programName,omitempty"`
+
+	// Organization that provides this service
+	ProvidedBy *common.Reference `json:"providedBy,omitempty"`
+
+	// Ways that the service accepts referrals
+	ReferralMethod []common.CodeableConcept `json:"referralMethod,omitempty"`
+
+	// Conditions under which service is available/offered
+	ServiceProvisionCode []common.CodeableConcept `json:"serviceProvisionCode,omitempty"`
+
+	// Specialties handled by the HealthcareService
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+
+	// If this is empty, then refer to the location's contacts
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+
+	// Type of service that may be delivered or performed
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// HealthcareServiceAvailableTime represents more detailed availability information may be provided in associated Schedule/Slot resources
+type HealthcareServiceAvailableTime struct {
+	common.BackboneElement
+
+	// Is this always available? (hence times are irrelevant) e.g. 24 hour service
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
+
+	// The timezone is expected to be for where this HealthcareService is provided at
+	AvailableEndTime          *common.Time    `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *common.Element `json:"_availableEndTime,omitempty"`
+	AvailableStartTime        *common.Time    `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element `json:"_availableStartTime,omitempty"`
+
+	// Indicates which days of the week are available between the start and end Times
+	DaysOfWeek        []HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element                          `json:"_daysOfWeek,omitempty"`
+}
+
+// HealthcareServiceAvailableTimeDaysOfWeek represents the days of week of a healthcare service available time
+type HealthcareServiceAvailableTimeDaysOfWeek string
+
+const (
+	HealthcareServiceAvailableTimeDaysOfWeekMon HealthcareServiceAvailableTimeDaysOfWeek = "mon"
+	HealthcareServiceAvailableTimeDaysOfWeekTue HealthcareServiceAvailableTimeDaysOfWeek = "tue"
+	HealthcareServiceAvailableTimeDaysOfWeekWed HealthcareServiceAvailableTimeDaysOfWeek = "wed"
+	HealthcareServiceAvailableTimeDaysOfWeekThu HealthcareServiceAvailableTimeDaysOfWeek = "thu"
+	HealthcareServiceAvailableTimeDaysOfWeekFri HealthcareServiceAvailableTimeDaysOfWeek = "fri"
+	HealthcareServiceAvailableTimeDaysOfWeekSat HealthcareServiceAvailableTimeDaysOfWeek = "sat"
+	HealthcareServiceAvailableTimeDaysOfWeekSun HealthcareServiceAvailableTimeDaysOfWeek = "sun"
+)
+
+// HealthcareServiceNotAvailable represents the not available of a healthcare service
+type HealthcareServiceNotAvailable struct {
+	common.BackboneElement
+
+	// The reason that can be presented to the user as to why this time is not available
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Service is not available (seasonally or for a public holiday) from this date
+	During *common.Period `json:"during,omitempty"`
+}
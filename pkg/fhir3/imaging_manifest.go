@@ -0,0 +1,76 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImagingManifest represents a text description of the DICOM SOP instances selected in the ImagingManifest; or the reason for, or significance of
+type ImagingManifest struct {
+	DomainResource
+
+	// Author of ImagingManifest
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Date and time when the selection of the referenced instances were made
+	AuthoringTime        *common.DateTime `json:"authoringTime,omitempty"`
+	AuthoringTimeElement *common.Element  `json:"_authoringTime,omitempty"`
+
+	// Free text narrative description of the ImagingManifest
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Unique identifier of the DICOM Key Object Selection (KOS) that this resource represents
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// SOP instances selected in the ImagingManifest can be from different studies, but must be of the same patient
+	Patient *common.Reference `json:"patient"`
+
+	// Study component represents the study level identity and locator information of the DICOM SOP instances in the selection
+	Study []ImagingManifestStudy `json:"study"`
+}
+
+// ImagingManifestStudySeriesInstance represents SOP Instance component identify the instance selected
+type ImagingManifestStudySeriesInstance struct {
+	common.BackboneElement
+
+	// SOP class UID identifies the type of the selected instances, e.g. CT image, Gray scale softcopy presentation state
+	SopClass        string          `json:"sopClass"`
+	SopClassElement *common.Element `json:"_sopClass,omitempty"`
+
+	// SOP instance UID identifies the instance
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingManifestStudySeries represents series component represents the series level identity and locator information of the DICOM SOP instances in the selection
+type ImagingManifestStudySeries struct {
+	common.BackboneElement
+
+	// The network service providing access (e.g., query, view, or retrieval) for this series
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// SOP Instance component identify the instance selected
+	Instance []ImagingManifestStudySeriesInstance `json:"instance"`
+
+	// Series instance UID of the SOP instances in the selection
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingManifestStudy represents study component represents the study level identity and locator information of the DICOM SOP instances in the selection
+type ImagingManifestStudy struct {
+	common.BackboneElement
+
+	// The network service providing access (e.g., query, view, or retrieval) for the study
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// Reference to the Imaging Study in FHIR form
+	ImagingStudy *common.Reference `json:"imagingStudy,omitempty"`
+
+	// Series component represents the series level identity and locator information of the DICOM SOP instances in the selection
+	Series []ImagingManifestStudySeries `json:"series"`
+
+	// Study instance UID of the SOP instances in the selection
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
@@ -0,0 +1,161 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImagingStudy represents a set of images produced in single study (one or more series of references images)
+type ImagingStudy struct {
+	DomainResource
+
+	// Accession Number is an identifier related to some aspect of imaging workflow and data management
+	Accession *common.Identifier `json:"accession,omitempty"`
+
+	// Availability of study (online, offline, or nearline)
+	Availability        *ImagingStudyAvailability `json:"availability,omitempty"`
+	AvailabilityElement *common.Element           `json:"_availability,omitempty"`
+
+	// Request fulfilled
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// The encounter or episode at which the request is initiated
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Institution-generated description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Study access endpoint
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// Identifiers for the whole study
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Who read the study and interpreted the images or other content
+	Interpreter []common.Reference `json:"interpreter,omitempty"`
+
+	// A list of all the Series.ImageModality values that are actual acquisition modalities
+	ModalityList []common.Coding `json:"modalityList,omitempty"`
+
+	// Number of Study Related Instances
+	NumberOfInstances        *int            `json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
+
+	// Number of Study Related Series
+	NumberOfSeries        *int            `json:"numberOfSeries,omitempty"`
+	NumberOfSeriesElement *common.Element `json:"_numberOfSeries,omitempty"`
+
+	// The patient imaged in the study
+	Patient *common.Reference `json:"patient"`
+
+	// The code for the performed procedure type
+	ProcedureCode []common.CodeableConcept `json:"procedureCode,omitempty"`
+
+	// A reference to the performed Procedure
+	ProcedureReference []common.Reference `json:"procedureReference,omitempty"`
+
+	// Why the study was requested / performed
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// Referring physician
+	Referrer *common.Reference `json:"referrer,omitempty"`
+
+	// Each study has one or more series of instances
+	Series []ImagingStudySeries `json:"series,omitempty"`
+
+	// When the study was started
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
+
+	// Formal identifier for the study
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingStudyAvailability represents the availability of an imaging study
+type ImagingStudyAvailability string
+
+const (
+	ImagingStudyAvailabilityONLINE      ImagingStudyAvailability = "ONLINE"
+	ImagingStudyAvailabilityOFFLINE     ImagingStudyAvailability = "OFFLINE"
+	ImagingStudyAvailabilityNEARLINE    ImagingStudyAvailability = "NEARLINE"
+	ImagingStudyAvailabilityUNAVAILABLE ImagingStudyAvailability = "UNAVAILABLE"
+)
+
+// ImagingStudySeriesInstance represents a single SOP instance from the series
+type ImagingStudySeriesInstance struct {
+	common.BackboneElement
+
+	// The number of this instance in the series
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// DICOM class type
+	SopClass        string          `json:"sopClass"`
+	SopClassElement *common.Element `json:"_sopClass,omitempty"`
+
+	// Description of instance
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// DICOM SOP Instance UID
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingStudySeries represents each study has one or more series of instances
+type ImagingStudySeries struct {
+	common.BackboneElement
+
+	// Availability of series (online, offline or nearline)
+	Availability        *ImagingStudySeriesAvailability `json:"availability,omitempty"`
+	AvailabilityElement *common.Element                 `json:"_availability,omitempty"`
+
+	// Body part examined
+	BodySite *common.Coding `json:"bodySite,omitempty"`
+
+	// A short human readable summary of the series
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Series access endpoint
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// A single SOP instance from the series
+	Instance []ImagingStudySeriesInstance `json:"instance,omitempty"`
+
+	// Body part laterality
+	Laterality *common.Coding `json:"laterality,omitempty"`
+
+	// The modality used for this series
+	Modality *common.Coding `json:"modality"`
+
+	// Numeric identifier of this series
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// Number of Series Related Instances
+	NumberOfInstances        *int            `json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
+
+	// Who performed the series
+	Performer []common.Reference `json:"performer,omitempty"`
+
+	// When the series started
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
+
+	// DICOM Series Instance UID for the series
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
+}
+
+// ImagingStudySeriesAvailability represents the availability of an imaging study series
+type ImagingStudySeriesAvailability string
+
+const (
+	ImagingStudySeriesAvailabilityONLINE      ImagingStudySeriesAvailability = "ONLINE"
+	ImagingStudySeriesAvailabilityOFFLINE     ImagingStudySeriesAvailability = "OFFLINE"
+	ImagingStudySeriesAvailabilityNEARLINE    ImagingStudySeriesAvailability = "NEARLINE"
+	ImagingStudySeriesAvailabilityUNAVAILABLE ImagingStudySeriesAvailability = "UNAVAILABLE"
+)
@@ -0,0 +1,85 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImmunizationRecommendation represents guidance or advice relating to an immunization
+type ImmunizationRecommendation struct {
+	DomainResource
+
+	// Business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Who this profile is for
+	Patient *common.Reference `json:"patient"`
+
+	// Vaccine administration recommendations
+	Recommendation []ImmunizationRecommendationRecommendation `json:"recommendation"`
+}
+
+// ImmunizationRecommendationRecommendationDateCriterion represents dates governing proposed immunization
+type ImmunizationRecommendationRecommendationDateCriterion struct {
+	common.BackboneElement
+
+	// Type of date
+	Code *common.CodeableConcept `json:"code"`
+
+	// Recommended date
+	Value        common.DateTime `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ImmunizationRecommendationRecommendationProtocol contains information about the protocol under which the vaccine was administered
+type ImmunizationRecommendationRecommendationProtocol struct {
+	common.BackboneElement
+
+	// Indicates the authority who published the protocol
+	Authority *common.Reference `json:"authority,omitempty"`
+
+	// Contains the description about the protocol under which the vaccine was administered
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Indicates the nominal position in a series of the next dose
+	DoseSequence        *int            `json:"doseSequence,omitempty"`
+	DoseSequenceElement *common.Element `json:"_doseSequence,omitempty"`
+
+	// One possible path to achieve presumed immunity against a disease - within the context of an authority
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
+}
+
+// ImmunizationRecommendationRecommendation represents vaccine administration recommendations
+type ImmunizationRecommendationRecommendation struct {
+	common.BackboneElement
+
+	// The date the immunization recommendation was created
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Dates governing proposed immunization
+	DateCriterion []ImmunizationRecommendationRecommendationDateCriterion `json:"dateCriterion,omitempty"`
+
+	// Recommended dose number within series
+	DoseNumber        *int            `json:"doseNumber,omitempty"`
+	DoseNumberElement *common.Element `json:"_doseNumber,omitempty"`
+
+	// Vaccine recommendation status
+	ForecastStatus *common.CodeableConcept `json:"forecastStatus"`
+
+	// Contains information about the protocol under which the vaccine was administered
+	Protocol *ImmunizationRecommendationRecommendationProtocol `json:"protocol,omitempty"`
+
+	// Past immunizations supporting recommendation
+	SupportingImmunization []common.Reference `json:"supportingImmunization,omitempty"`
+
+	// Patient observations supporting recommendation
+	SupportingPatientInformation []common.Reference `json:"supportingPatientInformation,omitempty"`
+
+	// Disease to be immunized against
+	TargetDisease *common.CodeableConcept `json:"targetDisease,omitempty"`
+
+	// Vaccine  or vaccine group recommendation applies to
+	VaccineCode *common.CodeableConcept `json:"vaccineCode,omitempty"`
+}
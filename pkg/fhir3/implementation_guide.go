@@ -0,0 +1,209 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImplementationGuide represents a set of rules about how FHIR is used
+type ImplementationGuide struct {
+	DomainResource
+
+	// This is principally included for the FHIR publishing tool
+	Binary        []string          `json:"binary,omitempty"`
+	BinaryElement []*common.Element `json:"_binary,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Another implementation guide that this implementation depends on
+	Dependency []ImplementationGuideDependency `json:"dependency,omitempty"`
+
+	// Natural language description of the implementation guide
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// FHIR Version(s) this Implementation Guide targets
+	FhirVersion        *string         `json:"fhirVersion,omitempty"`
+	FhirVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// Profiles that apply globally
+	Global []ImplementationGuideGlobal `json:"global,omitempty"`
+
+	// Intended jurisdiction for implementation guide (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this implementation guide (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A logical group of resources
+	Package []ImplementationGuidePackage `json:"package,omitempty"`
+
+	// Pages automatically become sections if they have sub-pages
+	Page *ImplementationGuidePage `json:"page,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ImplementationGuideStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Canonical identifier for this implementation guide, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the implementation guide
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ImplementationGuideStatus represents the status of an implementation guide
+type ImplementationGuideStatus string
+
+const (
+	ImplementationGuideStatusDraft   ImplementationGuideStatus = "draft"
+	ImplementationGuideStatusActive  ImplementationGuideStatus = "active"
+	ImplementationGuideStatusRetired ImplementationGuideStatus = "retired"
+	ImplementationGuideStatusUnknown ImplementationGuideStatus = "unknown"
+)
+
+// ImplementationGuideDependency represents another implementation guide that this implementation depends on
+type ImplementationGuideDependency struct {
+	common.BackboneElement
+
+	// For information about processing included guides, see [Including Guides]{implementationguide.html#include}
+	Type        ImplementationGuideDependencyType `json:"type"`
+	TypeElement *common.Element                   `json:"_type,omitempty"`
+
+	// When a guide is published, and the type is 'include', this will be a relative reference within the guide
+	URI        string          `json:"uri"`
+	URIElement *common.Element `json:"_uri,omitempty"`
+}
+
+// ImplementationGuideDependencyType represents the type of an implementation guide dependency
+type ImplementationGuideDependencyType string
+
+const (
+	ImplementationGuideDependencyTypeReference ImplementationGuideDependencyType = "reference"
+	ImplementationGuideDependencyTypeInclusion ImplementationGuideDependencyType = "inclusion"
+)
+
+// ImplementationGuidePackageResource represents a resource that is part of the implementation guide
+type ImplementationGuidePackageResource struct {
+	common.BackboneElement
+
+	// This is used when generating schematrons from profiles, for example, but has other uses
+	Acronym        *string         `json:"acronym,omitempty"`
+	AcronymElement *common.Element `json:"_acronym,omitempty"`
+
+	// This is mostly used with examples to explain why it is present (though they can have extensive comments in the examples)
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Typically, conformance resources and knowledge resources are directly part of the implementation guide
+	Example        bool            `json:"example"`
+	ExampleElement *common.Element `json:"_example,omitempty"`
+
+	// Examples: * StructureDefinition -> Any * ValueSet -> expansion * OperationDefinition -> Parameters * Questionnaire -> QuestionnaireResponse
+	ExampleFor *common.Reference `json:"exampleFor,omitempty"`
+
+	// A human assigned name for the resource
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Usually this is a relative URL that locates the resource within the implementation guide
+	SourceUri        *string           `json:"sourceUri,omitempty"`
+	SourceUriElement *common.Element   `json:"_sourceUri,omitempty"`
+	SourceReference  *common.Reference `json:"sourceReference,omitempty"`
+}
+
+// ImplementationGuidePackage represents a logical group of resources
+type ImplementationGuidePackage struct {
+	common.BackboneElement
+
+	// Human readable text describing the package
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The name for the group, as used in page.package
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A resource that is part of the implementation guide
+	Resource []ImplementationGuidePackageResource `json:"resource"`
+}
+
+// ImplementationGuideGlobal represents profiles that apply globally
+type ImplementationGuideGlobal struct {
+	common.BackboneElement
+
+	// Profile that all resources must conform to
+	Profile *common.Reference `json:"profile"`
+
+	// Type this profile applies to
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// ImplementationGuidePage represents pages automatically become sections if they have sub-pages
+type ImplementationGuidePage struct {
+	common.BackboneElement
+
+	// This is primarily for the publishing tool, that will convert all pages to html format
+	Format        *string         `json:"format,omitempty"`
+	FormatElement *common.Element `json:"_format,omitempty"`
+
+	// The kind of page that this is
+	Kind        ImplementationGuidePageKind `json:"kind"`
+	KindElement *common.Element             `json:"_kind,omitempty"`
+
+	// For constructed pages, a list of packages to include in the page (or else empty for everything)
+	Package        []string          `json:"package,omitempty"`
+	PackageElement []*common.Element `json:"_package,omitempty"`
+
+	// The implementation guide breadcrumbs are generated from this structure
+	Page []ImplementationGuidePage `json:"page,omitempty"`
+
+	// The publishing tool will autogenerate source for list (source = n/a), and inject included implementations for include
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// A short title used to represent this page in navigational structures such as table of contents, bread crumbs, etc
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// For constructed pages, what kind of resources to include in the list
+	Type        []string          `json:"type,omitempty"`
+	TypeElement []*common.Element `json:"_type,omitempty"`
+}
+
+// ImplementationGuidePageKind represents the kind of an implementation guide page
+type ImplementationGuidePageKind string
+
+const (
+	ImplementationGuidePageKindPage       ImplementationGuidePageKind = "page"
+	ImplementationGuidePageKindExample    ImplementationGuidePageKind = "example"
+	ImplementationGuidePageKindList       ImplementationGuidePageKind = "list"
+	ImplementationGuidePageKindInclude    ImplementationGuidePageKind = "include"
+	ImplementationGuidePageKindDirectory  ImplementationGuidePageKind = "directory"
+	ImplementationGuidePageKindDictionary ImplementationGuidePageKind = "dictionary"
+	ImplementationGuidePageKindToc        ImplementationGuidePageKind = "toc"
+	ImplementationGuidePageKindResource   ImplementationGuidePageKind = "resource"
+)
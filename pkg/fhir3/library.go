@@ -0,0 +1,112 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Library represents a library of quality improvement components
+type Library struct {
+	DomainResource
+
+	// When the library was approved by publisher
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Contents of the library, either embedded or referenced
+	Content []Attachment `json:"content,omitempty"`
+
+	// A contributor to the content of the library, including authors, editors, reviewers, and endorsers
+	Contributor []Contributor `json:"contributor,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// What data is referenced by this library
+	DataRequirement []common.DataRequirement `json:"dataRequirement,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the library
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// When the library is expected to be used
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Additional identifier for the library
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for library (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// When the library was last reviewed by the publisher
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// Name for this library (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Parameters defined by the library
+	Parameter []common.ParameterDefinition `json:"parameter,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this library is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Additional documentation, citations, etc
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        LibraryStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Name for this library (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// E.g. Education, Treatment, Assessment, etc
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// logic-library | model-definition | asset-collection | module-definition
+	Type *common.CodeableConcept `json:"type"`
+
+	// Canonical identifier for this library, represented as a URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// Describes the clinical usage of the library
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the library
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// LibraryStatus represents the status of a library
+type LibraryStatus string
+
+const (
+	LibraryStatusDraft   LibraryStatus = "draft"
+	LibraryStatusActive  LibraryStatus = "active"
+	LibraryStatusRetired LibraryStatus = "retired"
+	LibraryStatusUnknown LibraryStatus = "unknown"
+)
@@ -0,0 +1,41 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Linkage represents links records for 'same' item
+type Linkage struct {
+	DomainResource
+
+	// Whether this linkage assertion is active or not
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// Who is responsible for linkages
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Item to be linked
+	Item []LinkageItem `json:"item"`
+}
+
+// LinkageItem represents item to be linked
+type LinkageItem struct {
+	common.BackboneElement
+
+	// Resource being linked
+	Resource *common.Reference `json:"resource"`
+
+	// source | alternate | historical
+	Type        LinkageItemType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// LinkageItemType represents the type of a linkage item
+type LinkageItemType string
+
+const (
+	LinkageItemTypeSource     LinkageItemType = "source"
+	LinkageItemTypeAlternate  LinkageItemType = "alternate"
+	LinkageItemTypeHistorical LinkageItemType = "historical"
+)
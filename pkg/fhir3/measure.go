@@ -0,0 +1,234 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Measure represents a quality measure definition
+type Measure struct {
+	DomainResource
+
+	// When the measure was approved by publisher
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// Summary of clinical guidelines
+	ClinicalRecommendationStatement        *string         `json:"clinicalRecommendationStatement,omitempty"`
+	ClinicalRecommendationStatementElement *common.Element `json:"_clinicalRecommendationStatement,omitempty"`
+
+	// opportunity | all-or-nothing | linear | weighted
+	CompositeScoring *common.CodeableConcept `json:"compositeScoring,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A contributor to the content of the measure, including authors, editors, reviewers, and endorsers
+	Contributor []Contributor `json:"contributor,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Provides a description of an individual term used within the measure
+	Definition        []string          `json:"definition,omitempty"`
+	DefinitionElement []*common.Element `json:"_definition,omitempty"`
+
+	// Natural language description of the measure
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Disclaimer for use of the measure or its referenced content
+	Disclaimer        *string         `json:"disclaimer,omitempty"`
+	DisclaimerElement *common.Element `json:"_disclaimer,omitempty"`
+
+	// When the measure is expected to be used
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Population criteria group
+	Group []MeasureGroup `json:"group,omitempty"`
+
+	// Additional guidance for implementers (deprecated)
+	Guidance        *string         `json:"guidance,omitempty"`
+	GuidanceElement *common.Element `json:"_guidance,omitempty"`
+
+	// Additional identifier for the measure
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// increase | decrease
+	ImprovementNotation        *string         `json:"improvementNotation,omitempty"`
+	ImprovementNotationElement *common.Element `json:"_improvementNotation,omitempty"`
+
+	// Intended jurisdiction for measure (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// When the measure was last reviewed by the publisher
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// Logic used by the measure
+	Library []common.Reference `json:"library,omitempty"`
+
+	// Name for this measure (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this measure is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// How is rate aggregation performed for this measure
+	RateAggregation        *string         `json:"rateAggregation,omitempty"`
+	RateAggregationElement *common.Element `json:"_rateAggregation,omitempty"`
+
+	// Detailed description of why the measure exists
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
+
+	// Additional documentation, citations, etc
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// How risk adjustment is applied for this measure
+	RiskAdjustment        *string         `json:"riskAdjustment,omitempty"`
+	RiskAdjustmentElement *common.Element `json:"_riskAdjustment,omitempty"`
+
+	// proportion | ratio | continuous-variable | cohort
+	Scoring *common.CodeableConcept `json:"scoring,omitempty"`
+
+	// The measure set, e.g. Preventive Care and Screening
+	Set        *string         `json:"set,omitempty"`
+	SetElement *common.Element `json:"_set,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        MeasureStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// What other data should be reported with the measure
+	SupplementalData []MeasureSupplementalData `json:"supplementalData,omitempty"`
+
+	// Name for this measure (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// The category of the measure, such as Education, Treatment, Assessment, etc
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// process | outcome | structure | patient-reported-outcome | composite
+	Type []common.CodeableConcept `json:"type,omitempty"`
+
+	// Canonical identifier for this measure, represented as a URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// Describes the clinical usage of the measure
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the measure
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// MeasureStatus represents the status of a measure
+type MeasureStatus string
+
+const (
+	MeasureStatusDraft   MeasureStatus = "draft"
+	MeasureStatusActive  MeasureStatus = "active"
+	MeasureStatusRetired MeasureStatus = "retired"
+	MeasureStatusUnknown MeasureStatus = "unknown"
+)
+
+// MeasureGroupPopulation represents population criteria
+type MeasureGroupPopulation struct {
+	common.BackboneElement
+
+	// initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The criteria that defines this population
+	Criteria        string          `json:"criteria"`
+	CriteriaElement *common.Element `json:"_criteria,omitempty"`
+
+	// The human readable description of this population criteria
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A unique identifier for the population criteria
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Optional name or short description of this population
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// MeasureGroupStratifier represents stratifier criteria for the measure
+type MeasureGroupStratifier struct {
+	common.BackboneElement
+
+	// How the measure should be stratified
+	Criteria        *string         `json:"criteria,omitempty"`
+	CriteriaElement *common.Element `json:"_criteria,omitempty"`
+
+	// The identifier for the stratifier used to coordinate the reported data back to this stratifier
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The path to an element that defines the stratifier, specified as a valid FHIR resource path
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+}
+
+// MeasureGroup represents population criteria group
+type MeasureGroup struct {
+	common.BackboneElement
+
+	// Summary description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A unique identifier for the group
+	Identifier *common.Identifier `json:"identifier"`
+
+	// Optional name or short description of this group
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Population criteria
+	Population []MeasureGroupPopulation `json:"population,omitempty"`
+
+	// Stratifier criteria for the measure
+	Stratifier []MeasureGroupStratifier `json:"stratifier,omitempty"`
+}
+
+// MeasureSupplementalData represents what other data should be reported with the measure
+type MeasureSupplementalData struct {
+	common.BackboneElement
+
+	// Expression describing additional data to be reported
+	Criteria        *string         `json:"criteria,omitempty"`
+	CriteriaElement *common.Element `json:"_criteria,omitempty"`
+
+	// An identifier for the supplemental data
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The supplemental data to be supplied as part of the measure response, specified as a valid FHIR Resource Path
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// supplemental-data | risk-adjustment-factor
+	Usage []common.CodeableConcept `json:"usage,omitempty"`
+}
@@ -0,0 +1,142 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MeasureReport represents results of a measure evaluation
+type MeasureReport struct {
+	DomainResource
+
+	// When the measure was calculated
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// A reference to a Bundle containing the Resources that were used in the evaluation of this report
+	EvaluatedResources *common.Reference `json:"evaluatedResources,omitempty"`
+
+	// Measure results for each group
+	Group []MeasureReportGroup `json:"group,omitempty"`
+
+	// Additional identifier for the MeasureReport
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// What measure was calculated
+	Measure *common.Reference `json:"measure"`
+
+	// Optional Patient if the report was requested for a single patient
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// What period the report covers
+	Period *common.Period `json:"period"`
+
+	// Reporting Organization
+	ReportingOrganization *common.Reference `json:"reportingOrganization,omitempty"`
+
+	// complete | pending | error
+	Status        MeasureReportStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// individual | subject-list | summary | data-exchange
+	Type        MeasureReportType `json:"type"`
+	TypeElement *common.Element   `json:"_type,omitempty"`
+}
+
+// MeasureReportStatus represents the status of a measure report
+type MeasureReportStatus string
+
+const (
+	MeasureReportStatusComplete MeasureReportStatus = "complete"
+	MeasureReportStatusPending  MeasureReportStatus = "pending"
+	MeasureReportStatusError    MeasureReportStatus = "error"
+)
+
+// MeasureReportType represents the type of a measure report
+type MeasureReportType string
+
+const (
+	MeasureReportTypeIndividual  MeasureReportType = "individual"
+	MeasureReportTypePatientList MeasureReportType = "patient-list"
+	MeasureReportTypeSummary     MeasureReportType = "summary"
+)
+
+// MeasureReportGroupPopulation represents the population of a measure report group
+type MeasureReportGroupPopulation struct {
+	common.BackboneElement
+
+	// initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Size of the population
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
+
+	// The identifier of the population being reported, as defined by the population element of the measure
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// This element refers to a List of patient level MeasureReport resources, one for each patient in this population
+	Patients *common.Reference `json:"patients,omitempty"`
+}
+
+// MeasureReportGroupStratifierStratumPopulation represents population results in this stratum
+type MeasureReportGroupStratifierStratumPopulation struct {
+	common.BackboneElement
+
+	// initial-population | numerator | numerator-exclusion | denominator | denominator-exclusion | denominator-exception | measure-population | measure-population-exclusion | measure-observation
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Size of the population
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
+
+	// The identifier of the population being reported, as defined by the population element of the measure
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// This element refers to a List of patient level MeasureReport resources
+	Patients *common.Reference `json:"patients,omitempty"`
+}
+
+// MeasureReportGroupStratifierStratum represents stratum results, one for each unique value, or set of values, in the stratifier, or stratifier components
+type MeasureReportGroupStratifierStratum struct {
+	common.BackboneElement
+
+	// The measure score for this stratum, calculated as appropriate for the measure type and scoring method
+	MeasureScore        *common.Decimal `json:"measureScore,omitempty"`
+	MeasureScoreElement *common.Element `json:"_measureScore,omitempty"`
+
+	// Population results in this stratum
+	Population []MeasureReportGroupStratifierStratumPopulation `json:"population,omitempty"`
+
+	// The value for this stratum, expressed as a string
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// MeasureReportGroupStratifier represents stratification results
+type MeasureReportGroupStratifier struct {
+	common.BackboneElement
+
+	// The identifier of this stratifier, as defined in the measure definition
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Stratum results, one for each unique value, or set of values, in the stratifier, or stratifier components
+	Stratum []MeasureReportGroupStratifierStratum `json:"stratum,omitempty"`
+}
+
+// MeasureReportGroup represents measure results for each group
+type MeasureReportGroup struct {
+	common.BackboneElement
+
+	// The identifier of the population group as defined in the measure definition
+	Identifier *common.Identifier `json:"identifier"`
+
+	// The measure score for this population group, calculated as appropriate for the measure type and scoring method
+	MeasureScore        *common.Decimal `json:"measureScore,omitempty"`
+	MeasureScoreElement *common.Element `json:"_measureScore,omitempty"`
+
+	// The populations in the group
+	Population []MeasureReportGroupPopulation `json:"population,omitempty"`
+
+	// Stratification results
+	Stratifier []MeasureReportGroupStratifier `json:"stratifier,omitempty"`
+}
@@ -0,0 +1,80 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Media represents a photo, video, or audio recording acquired or used in healthcare
+type Media struct {
+	DomainResource
+
+	// A procedure that is fulfilled in whole or in part by the creation of this media
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// If the use case requires BodySite to be handled as a separate resource
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// Recommended content types: image/jpeg, image/png, image/tiff, video/mpeg, audio/mp4, application/dicom
+	Content *Attachment `json:"content"`
+
+	// This will typically be the encounter the media occurred within
+	Context *common.Reference `json:"context,omitempty"`
+
+	// An extension should be used if further typing of the device is needed
+	Device *common.Reference `json:"device,omitempty"`
+
+	// The duration might differ from occurrencePeriod if recording was paused
+	Duration        *int            `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
+
+	// The number of frames in a photo
+	Frames        *int            `json:"frames,omitempty"`
+	FramesElement *common.Element `json:"_frames,omitempty"`
+
+	// Height of the image in pixels (photo/video)
+	Height        *int            `json:"height,omitempty"`
+	HeightElement *common.Element `json:"_height,omitempty"`
+
+	// The identifier label and use can be used to determine what kind of identifier it is
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Comments made about the media by the performer, subject or other participants
+	Note []Annotation `json:"note,omitempty"`
+
+	// The date and time(s) at which the media was collected
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+
+	// The person who administered the collection of the image
+	Operator *common.Reference `json:"operator,omitempty"`
+
+	// Textual reasons can be caprued using reasonCode.text
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Who/What this Media is a record of
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Details of the type of the media - usually, how it was acquired (what type of device)
+	Subtype *common.CodeableConcept `json:"subtype,omitempty"`
+
+	// Whether the media is a photo (still image), an audio recording, or a video recording
+	Type        MediaType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// The name of the imaging view e.g. Lateral or Antero-posterior (AP)
+	View *common.CodeableConcept `json:"view,omitempty"`
+
+	// Width of the image in pixels (photo/video)
+	Width        *int            `json:"width,omitempty"`
+	WidthElement *common.Element `json:"_width,omitempty"`
+}
+
+// MediaType represents the type of a media
+type MediaType string
+
+const (
+	MediaTypePhoto MediaType = "photo"
+	MediaTypeVideo MediaType = "video"
+	MediaTypeAudio MediaType = "audio"
+)
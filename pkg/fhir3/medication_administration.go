@@ -0,0 +1,123 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicationAdministration represents administration of medication to a patient
+type MedicationAdministration struct {
+	DomainResource
+
+	// Type of medication administration
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The visit, admission or other contact between patient and health care provider the medication administration was performed as part of
+	Context *common.Reference `json:"context,omitempty"`
+
+	// A protocol, guideline, orderset or other definition that was adhered to in whole or in part by this event
+	Definition []common.Reference `json:"definition,omitempty"`
+
+	// Device used to administer
+	Device []common.Reference `json:"device,omitempty"`
+
+	// Details of how medication was taken
+	Dosage *MedicationAdministrationDosage `json:"dosage,omitempty"`
+
+	// A specific date/time or interval of time during which the administration took place
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+
+	// A list of events of interest in the lifecycle
+	EventHistory []common.Reference `json:"eventHistory,omitempty"`
+
+	// External identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// If only a code is specified, then it needs to be a code for a specific product
+	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
+
+	// Information about the administration
+	Note []Annotation `json:"note,omitempty"`
+
+	// This element is labeled as a modifier because it indicates that a administration didn't happen
+	NotGiven        *bool           `json:"notGiven,omitempty"`
+	NotGivenElement *common.Element `json:"_notGiven,omitempty"`
+
+	// Part of referenced event
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Who or what performed the medication administration and what type of performance they did
+	Performer []MedicationAdministrationPerformer `json:"performer,omitempty"`
+
+	// The original request, instruction or authority to perform the administration
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// A code indicating why the medication was given
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// A code indicating why the administration was not performed
+	ReasonNotGiven []common.CodeableConcept `json:"reasonNotGiven,omitempty"`
+
+	// This is a reference to a condition that is the reason for the medication request
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// in-progress | not-done | on-hold | completed | entered-in-error | stopped | unknown
+	Status        MedicationAdministrationStatus `json:"status"`
+	StatusElement *common.Element                `json:"_status,omitempty"`
+
+	// Who received medication
+	Subject *common.Reference `json:"subject"`
+
+	// Additional information to support administration
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+}
+
+// MedicationAdministrationStatus represents the status of a medication administration
+type MedicationAdministrationStatus string
+
+const (
+	MedicationAdministrationStatusInProgress     MedicationAdministrationStatus = "in-progress"
+	MedicationAdministrationStatusOnHold         MedicationAdministrationStatus = "on-hold"
+	MedicationAdministrationStatusCompleted      MedicationAdministrationStatus = "completed"
+	MedicationAdministrationStatusEnteredInError MedicationAdministrationStatus = "entered-in-error"
+	MedicationAdministrationStatusStopped        MedicationAdministrationStatus = "stopped"
+	MedicationAdministrationStatusUnknown        MedicationAdministrationStatus = "unknown"
+)
+
+// MedicationAdministrationPerformer represents who or what performed the medication administration and what type of performance they did
+type MedicationAdministrationPerformer struct {
+	common.BackboneElement
+
+	// Who or what performed the medication administration
+	Actor *common.Reference `json:"actor"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
+
+// MedicationAdministrationDosage represents details of how medication was taken
+type MedicationAdministrationDosage struct {
+	common.BackboneElement
+
+	// Amount of medication per dose
+	Dose *common.Quantity `json:"dose,omitempty"`
+
+	// How drug was administered
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// Dose quantity per unit of time
+	RateRatio    *Ratio           `json:"rateRatio,omitempty"`
+	RateQuantity *common.Quantity `json:"rateQuantity,omitempty"`
+
+	// Path of substance into body
+	Route *common.CodeableConcept `json:"route,omitempty"`
+
+	// Body site administered to
+	Site *common.CodeableConcept `json:"site,omitempty"`
+
+	// Free text dosage instructions e.g. SIG
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+}
@@ -0,0 +1,129 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicationDispense represents dispensing a medication to a named patient
+type MedicationDispense struct {
+	DomainResource
+
+	// Medication order that authorizes the dispense
+	AuthorizingPrescription []common.Reference `json:"authorizingPrescription,omitempty"`
+
+	// Type of medication dispense
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The encounter or episode of care that establishes the context for this event
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Amount of medication expressed as a timing amount
+	DaysSupply *common.Quantity `json:"daysSupply,omitempty"`
+
+	// Where the medication was/will be sent
+	Destination *common.Reference `json:"destination,omitempty"`
+
+	// Indicates an actual or potential clinical issue with or between one or more active or proposed clinical actions for a patient; e.g. Drug-drug interaction, duplicate therapy, dosage alert etc
+	DetectedIssue []common.Reference `json:"detectedIssue,omitempty"`
+
+	// How the medication is to be used by the patient or administered by the caregiver
+	DosageInstruction []Dosage `json:"dosageInstruction,omitempty"`
+
+	// A list of relevant lifecycle events
+	EventHistory []common.Reference `json:"eventHistory,omitempty"`
+
+	// External identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// If only a code is specified, then it needs to be a code for a specific product
+	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
+
+	// True if the dispense was not performed for some reason
+	NotDone        *bool           `json:"notDone,omitempty"`
+	NotDoneElement *common.Element `json:"_notDone,omitempty"`
+
+	// Indicates the reason why a dispense was not performed
+	NotDoneReasonCodeableConcept *common.CodeableConcept `json:"notDoneReasonCodeableConcept,omitempty"`
+	NotDoneReasonReference       *common.Reference       `json:"notDoneReasonReference,omitempty"`
+
+	// Information about the dispense
+	Note []Annotation `json:"note,omitempty"`
+
+	// Event that dispense is part of
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Who performed event
+	Performer []MedicationDispensePerformer `json:"performer,omitempty"`
+
+	// Amount dispensed
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Who collected the medication or where the medication was delivered
+	Receiver []common.Reference `json:"receiver,omitempty"`
+
+	// preparation | in-progress | cancelled | on-hold | completed | entered-in-error | stopped | declined | unknown
+	Status        *MedicationDispenseStatus `json:"status,omitempty"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Who the dispense is for
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Whether a substitution was performed on the dispense
+	Substitution *MedicationDispenseSubstitution `json:"substitution,omitempty"`
+
+	// Information that supports the dispensing of the medication
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+
+	// Trial fill, partial fill, emergency fill, etc
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// When product was given out
+	WhenHandedOver        *common.DateTime `json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement *common.Element  `json:"_whenHandedOver,omitempty"`
+
+	// When product was packaged and reviewed
+	WhenPrepared        *common.DateTime `json:"whenPrepared,omitempty"`
+	WhenPreparedElement *common.Element  `json:"_whenPrepared,omitempty"`
+}
+
+// MedicationDispenseStatus represents the status of a medication dispense
+type MedicationDispenseStatus string
+
+const (
+	MedicationDispenseStatusPreparation    MedicationDispenseStatus = "preparation"
+	MedicationDispenseStatusInProgress     MedicationDispenseStatus = "in-progress"
+	MedicationDispenseStatusOnHold         MedicationDispenseStatus = "on-hold"
+	MedicationDispenseStatusCompleted      MedicationDispenseStatus = "completed"
+	MedicationDispenseStatusEnteredInError MedicationDispenseStatus = "entered-in-error"
+	MedicationDispenseStatusStopped        MedicationDispenseStatus = "stopped"
+)
+
+// MedicationDispensePerformer represents who performed event
+type MedicationDispensePerformer struct {
+	common.BackboneElement
+
+	// Individual who was performing
+	Actor *common.Reference `json:"actor"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
+
+// MedicationDispenseSubstitution indicates whether or not substitution was made as part of the dispense
+type MedicationDispenseSubstitution struct {
+	common.BackboneElement
+
+	// Why was substitution made
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Who is responsible for the substitution
+	ResponsibleParty []common.Reference `json:"responsibleParty,omitempty"`
+
+	// Code signifying whether a different drug was dispensed from what was prescribed
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Whether a substitution was or was not performed on the dispense
+	WasSubstituted        bool            `json:"wasSubstituted"`
+	WasSubstitutedElement *common.Element `json:"_wasSubstituted,omitempty"`
+}
@@ -0,0 +1,92 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicationStatement represents record of medication being taken by a patient
+type MedicationStatement struct {
+	DomainResource
+
+	// A plan, proposal or order that is fulfilled in whole or in part by this event
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Type of medication statement
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The encounter or episode of care that establishes the context for this MedicationStatement
+	Context *common.Reference `json:"context,omitempty"`
+
+	// When the usage was asserted?
+	DateAsserted        *common.DateTime `json:"dateAsserted,omitempty"`
+	DateAssertedElement *common.Element  `json:"_dateAsserted,omitempty"`
+
+	// Link to information used to derive the MedicationStatement
+	DerivedFrom []common.Reference `json:"derivedFrom,omitempty"`
+
+	// Details of how medication is/was taken or should be taken
+	Dosage []Dosage `json:"dosage,omitempty"`
+
+	// The date/time or interval when the medication is/was/will be taken
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+
+	// External identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Person or organization that provided the information about the taking of this medication
+	InformationSource *common.Reference `json:"informationSource,omitempty"`
+
+	// If only a code is specified, then it needs to be a code for a specific product
+	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	MedicationReference       *common.Reference       `json:"medicationReference,omitempty"`
+
+	// Further information about the usage
+	Note []Annotation `json:"note,omitempty"`
+
+	// Part of referenced event
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// This could be a diagnosis code
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// A code indicating why the medication was not taken
+	ReasonNotTaken []common.CodeableConcept `json:"reasonNotTaken,omitempty"`
+
+	// This is a reference to a condition that is the reason why the medication is being/was taken
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// recorded | entered-in-error | draft
+	Status        MedicationStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Who is/was taking  the medication
+	Subject *common.Reference `json:"subject"`
+
+	// This element is labeled as a modifier because it indicates that the medication was not taken
+	Taken        MedicationStatementTaken `json:"taken"`
+	TakenElement *common.Element          `json:"_taken,omitempty"`
+}
+
+// MedicationStatementStatus represents the status of a medication statement
+type MedicationStatementStatus string
+
+const (
+	MedicationStatementStatusActive         MedicationStatementStatus = "active"
+	MedicationStatementStatusCompleted      MedicationStatementStatus = "completed"
+	MedicationStatementStatusEnteredInError MedicationStatementStatus = "entered-in-error"
+	MedicationStatementStatusIntended       MedicationStatementStatus = "intended"
+	MedicationStatementStatusStopped        MedicationStatementStatus = "stopped"
+	MedicationStatementStatusOnHold         MedicationStatementStatus = "on-hold"
+)
+
+// MedicationStatementTaken represents the taken of a medication statement
+type MedicationStatementTaken string
+
+const (
+	MedicationStatementTakenY   MedicationStatementTaken = "y"
+	MedicationStatementTakenN   MedicationStatementTaken = "n"
+	MedicationStatementTakenUnk MedicationStatementTaken = "unk"
+	MedicationStatementTakenNa  MedicationStatementTaken = "na"
+)
@@ -0,0 +1,143 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MessageDefinition represents a resource that defines a type of message that can be exchanged between systems
+type MessageDefinition struct {
+	DomainResource
+
+	// Responses to this message
+	AllowedResponse []MessageDefinitionAllowedResponse `json:"allowedResponse,omitempty"`
+
+	// Definition this one is based on
+	Base *common.Reference `json:"base,omitempty"`
+
+	// consequence | currency | notification
+	Category        *MessageDefinitionCategory `json:"category,omitempty"`
+	CategoryElement *common.Element            `json:"_category,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Natural language description of the message definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A coded identifier of a supported messaging event
+	Event *common.Coding `json:"event"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Resource(s) that are the subject of the event
+	Focus []MessageDefinitionFocus `json:"focus,omitempty"`
+
+	// Business Identifier for a given MessageDefinition
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for message definition (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this message definition (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Protocol/workflow this is part of
+	Parent []common.Reference `json:"parent,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this message definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Takes the place of
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	// always | on-error | never | on-success
+	ResponseRequired        *bool           `json:"responseRequired,omitempty"`
+	ResponseRequiredElement *common.Element `json:"_responseRequired,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        MessageDefinitionStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
+
+	// Name for this message definition (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// The cannonical URL for a given MessageDefinition
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the message definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// MessageDefinitionCategory represents the category of a message definition
+type MessageDefinitionCategory string
+
+const (
+	MessageDefinitionCategoryConsequence  MessageDefinitionCategory = "Consequence"
+	MessageDefinitionCategoryCurrency     MessageDefinitionCategory = "Currency"
+	MessageDefinitionCategoryNotification MessageDefinitionCategory = "Notification"
+)
+
+// MessageDefinitionStatus represents the status of a message definition
+type MessageDefinitionStatus string
+
+const (
+	MessageDefinitionStatusDraft   MessageDefinitionStatus = "draft"
+	MessageDefinitionStatusActive  MessageDefinitionStatus = "active"
+	MessageDefinitionStatusRetired MessageDefinitionStatus = "retired"
+	MessageDefinitionStatusUnknown MessageDefinitionStatus = "unknown"
+)
+
+// MessageDefinitionFocus represents resource(s) that are the subject of the event
+type MessageDefinitionFocus struct {
+	common.BackboneElement
+
+	// Type of resource
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Maximum number of focuses of this type
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Minimum number of focuses of this type
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Profile that must be adhered to by focus
+	Profile *common.Reference `json:"profile,omitempty"`
+}
+
+// MessageDefinitionAllowedResponse represents responses to this message
+type MessageDefinitionAllowedResponse struct {
+	common.BackboneElement
+
+	// Reference to allowed message definition response
+	Message *common.Reference `json:"message"`
+
+	// When should this response be used
+	Situation        *string         `json:"situation,omitempty"`
+	SituationElement *common.Element `json:"_situation,omitempty"`
+}
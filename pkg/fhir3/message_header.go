@@ -0,0 +1,112 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MessageHeader represents a resource that describes a message that is exchanged between systems
+type MessageHeader struct {
+	DomainResource
+
+	// The source of the decision
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Message destination application(s)
+	Destination []MessageHeaderDestination `json:"destination,omitempty"`
+
+	// Usually only for the request, but can be used in a response
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Code that identifies the event this message represents and connects it with its definition
+	Event *common.Coding `json:"event"`
+
+	// The actual content of the message
+	Focus []common.Reference `json:"focus,omitempty"`
+
+	// Cause of event
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// Allows data conveyed by a message to be addressed to a particular person or department when routing to a specific application isn't sufficient
+	Receiver *common.Reference `json:"receiver,omitempty"`
+
+	// If this is a reply to prior message
+	Response *MessageHeaderResponse `json:"response,omitempty"`
+
+	// Final responsibility for event
+	Responsible *common.Reference `json:"responsible,omitempty"`
+
+	// Real world sender of the message
+	Sender *common.Reference `json:"sender,omitempty"`
+
+	// Message source application
+	Source *MessageHeaderSource `json:"source"`
+
+	// The time that the message was sent
+	Timestamp        common.Instant  `json:"timestamp"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+}
+
+// MessageHeaderDestination represents message destination application(s)
+type MessageHeaderDestination struct {
+	common.BackboneElement
+
+	// The id may be a non-resolvable URI for systems that do not use standard network-based addresses
+	Endpoint        string          `json:"endpoint"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// Name of system
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Particular delivery destination within the destination
+	Target *common.Reference `json:"target,omitempty"`
+}
+
+// MessageHeaderSource represents message source application
+type MessageHeaderSource struct {
+	common.BackboneElement
+
+	// Human contact for problems
+	Contact *ContactPoint `json:"contact,omitempty"`
+
+	// The id may be a non-resolvable URI for systems that do not use standard network-based addresses
+	Endpoint        string          `json:"endpoint"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// Name of system
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of software running the system
+	Software        *string         `json:"software,omitempty"`
+	SoftwareElement *common.Element `json:"_software,omitempty"`
+
+	// Version of software running
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// MessageHeaderResponse represents information about the message that this message is a response to
+type MessageHeaderResponse struct {
+	common.BackboneElement
+
+	// ok | transient-error | fatal-error
+	Code        MessageHeaderResponseCode `json:"code"`
+	CodeElement *common.Element           `json:"_code,omitempty"`
+
+	// Specific list of hints/warnings/errors
+	Details *common.Reference `json:"details,omitempty"`
+
+	// Bundle.identifier of original message
+	Identifier        string          `json:"identifier"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
+}
+
+// MessageHeaderResponseCode represents the code of a message header response
+type MessageHeaderResponseCode string
+
+const (
+	MessageHeaderResponseCodeOk             MessageHeaderResponseCode = "ok"
+	MessageHeaderResponseCodeTransientError MessageHeaderResponseCode = "transient-error"
+	MessageHeaderResponseCodeFatalError     MessageHeaderResponseCode = "fatal-error"
+)
@@ -0,0 +1,113 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// NamingSystem represents system of unique identification
+type NamingSystem struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Natural language description of the naming system
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Intended jurisdiction for naming system (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// codesystem | identifier | root
+	Kind        NamingSystemKind `json:"kind"`
+	KindElement *common.Element  `json:"_kind,omitempty"`
+
+	// Name for this naming system (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// For naming systems that are retired, indicates the naming system that should be used in their place (if any)
+	ReplacedBy *common.Reference `json:"replacedBy,omitempty"`
+
+	// Who maintains system namespace?
+	Responsible        *string         `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        NamingSystemStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// e.g. driver,  provider,  patient, bank etc
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Unique identifiers used for system
+	UniqueId []NamingSystemUniqueId `json:"uniqueId"`
+
+	// How/where is it used
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+}
+
+// NamingSystemKind represents the kind of a naming system
+type NamingSystemKind string
+
+const (
+	NamingSystemKindCodesystem NamingSystemKind = "codesystem"
+	NamingSystemKindIdentifier NamingSystemKind = "identifier"
+	NamingSystemKindRoot       NamingSystemKind = "root"
+)
+
+// NamingSystemStatus represents the status of a naming system
+type NamingSystemStatus string
+
+const (
+	NamingSystemStatusDraft   NamingSystemStatus = "draft"
+	NamingSystemStatusActive  NamingSystemStatus = "active"
+	NamingSystemStatusRetired NamingSystemStatus = "retired"
+	NamingSystemStatusUnknown NamingSystemStatus = "unknown"
+)
+
+// NamingSystemUniqueId represents unique identifiers used for system
+type NamingSystemUniqueId struct {
+	common.BackboneElement
+
+	// Notes about identifier usage
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// When is identifier valid?
+	Period *common.Period `json:"period,omitempty"`
+
+	// Is this the id that should be used for this type
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
+
+	// oid | uuid | uri | iri-stem | v2csmnemonic | other
+	Type        NamingSystemUniqueIdType `json:"type"`
+	TypeElement *common.Element          `json:"_type,omitempty"`
+
+	// The unique identifier
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// NamingSystemUniqueIdType represents the type of a naming system unique id
+type NamingSystemUniqueIdType string
+
+const (
+	NamingSystemUniqueIdTypeOid   NamingSystemUniqueIdType = "oid"
+	NamingSystemUniqueIdTypeUuid  NamingSystemUniqueIdType = "uuid"
+	NamingSystemUniqueIdTypeUri   NamingSystemUniqueIdType = "uri"
+	NamingSystemUniqueIdTypeOther NamingSystemUniqueIdType = "other"
+)
@@ -0,0 +1,758 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir3
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MarshalJSON writes the repeating primitives of Address that only have an id
+// or extensions as null
+func (x Address) MarshalJSON() ([]byte, error) {
+	type plain Address
+	return json.Marshal(struct {
+		plain
+		Line []*string `json:"line,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Line, x.LineElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of AllergyIntolerance that only have an id
+// or extensions as null
+func (x AllergyIntolerance) MarshalJSON() ([]byte, error) {
+	type plain AllergyIntolerance
+	return json.Marshal(struct {
+		plain
+		Category []*AllergyIntoleranceCategory `json:"category,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Category, x.CategoryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of AuditEventAgent that only have an id
+// or extensions as null
+func (x AuditEventAgent) MarshalJSON() ([]byte, error) {
+	type plain AuditEventAgent
+	return json.Marshal(struct {
+		plain
+		Policy []*string `json:"policy,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Policy, x.PolicyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CapabilityStatement that only have an id
+// or extensions as null
+func (x CapabilityStatement) MarshalJSON() ([]byte, error) {
+	type plain CapabilityStatement
+	return json.Marshal(struct {
+		plain
+		Format              []*string `json:"format"`
+		ImplementationGuide []*string `json:"implementationGuide,omitempty"`
+		Instantiates        []*string `json:"instantiates,omitempty"`
+		PatchFormat         []*string `json:"patchFormat,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Format, x.FormatElement),
+		common.PrimitiveValues(x.ImplementationGuide, x.ImplementationGuideElement),
+		common.PrimitiveValues(x.Instantiates, x.InstantiatesElement),
+		common.PrimitiveValues(x.PatchFormat, x.PatchFormatElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CapabilityStatementRest that only have an id
+// or extensions as null
+func (x CapabilityStatementRest) MarshalJSON() ([]byte, error) {
+	type plain CapabilityStatementRest
+	return json.Marshal(struct {
+		plain
+		Compartment []*string `json:"compartment,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Compartment, x.CompartmentElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CapabilityStatementRestResource that only have an id
+// or extensions as null
+func (x CapabilityStatementRestResource) MarshalJSON() ([]byte, error) {
+	type plain CapabilityStatementRestResource
+	return json.Marshal(struct {
+		plain
+		ReferencePolicy  []*CapabilityStatementRestResourceReferencePolicy `json:"referencePolicy,omitempty"`
+		SearchInclude    []*string                                         `json:"searchInclude,omitempty"`
+		SearchRevInclude []*string                                         `json:"searchRevInclude,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ReferencePolicy, x.ReferencePolicyElement),
+		common.PrimitiveValues(x.SearchInclude, x.SearchIncludeElement),
+		common.PrimitiveValues(x.SearchRevInclude, x.SearchRevIncludeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ChargeItem that only have an id
+// or extensions as null
+func (x ChargeItem) MarshalJSON() ([]byte, error) {
+	type plain ChargeItem
+	return json.Marshal(struct {
+		plain
+		Definition []*string `json:"definition,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Definition, x.DefinitionElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimInsurance that only have an id
+// or extensions as null
+func (x ClaimInsurance) MarshalJSON() ([]byte, error) {
+	type plain ClaimInsurance
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimItem that only have an id
+// or extensions as null
+func (x ClaimItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimItem
+	return json.Marshal(struct {
+		plain
+		CareTeamLinkId    []*int `json:"careTeamLinkId,omitempty"`
+		DiagnosisLinkId   []*int `json:"diagnosisLinkId,omitempty"`
+		InformationLinkId []*int `json:"informationLinkId,omitempty"`
+		ProcedureLinkId   []*int `json:"procedureLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.CareTeamLinkId, x.CareTeamLinkIdElement),
+		common.PrimitiveValues(x.DiagnosisLinkId, x.DiagnosisLinkIdElement),
+		common.PrimitiveValues(x.InformationLinkId, x.InformationLinkIdElement),
+		common.PrimitiveValues(x.ProcedureLinkId, x.ProcedureLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseAddItem that only have an id
+// or extensions as null
+func (x ClaimResponseAddItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseAddItem
+	return json.Marshal(struct {
+		plain
+		NoteNumber     []*int `json:"noteNumber,omitempty"`
+		SequenceLinkId []*int `json:"sequenceLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+		common.PrimitiveValues(x.SequenceLinkId, x.SequenceLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseAddItemDetail that only have an id
+// or extensions as null
+func (x ClaimResponseAddItemDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseAddItemDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseInsurance that only have an id
+// or extensions as null
+func (x ClaimResponseInsurance) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseInsurance
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItem that only have an id
+// or extensions as null
+func (x ClaimResponseItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItem
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItemDetail that only have an id
+// or extensions as null
+func (x ClaimResponseItemDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItemDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItemDetailSubDetail that only have an id
+// or extensions as null
+func (x ClaimResponseItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItemDetailSubDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClinicalImpression that only have an id
+// or extensions as null
+func (x ClinicalImpression) MarshalJSON() ([]byte, error) {
+	type plain ClinicalImpression
+	return json.Marshal(struct {
+		plain
+		Protocol []*string `json:"protocol,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Protocol, x.ProtocolElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CodeSystemFilter that only have an id
+// or extensions as null
+func (x CodeSystemFilter) MarshalJSON() ([]byte, error) {
+	type plain CodeSystemFilter
+	return json.Marshal(struct {
+		plain
+		Operator []*string `json:"operator"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Operator, x.OperatorElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CompartmentDefinitionResource that only have an id
+// or extensions as null
+func (x CompartmentDefinitionResource) MarshalJSON() ([]byte, error) {
+	type plain CompartmentDefinitionResource
+	return json.Marshal(struct {
+		plain
+		Param []*string `json:"param,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Param, x.ParamElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CompositionAttester that only have an id
+// or extensions as null
+func (x CompositionAttester) MarshalJSON() ([]byte, error) {
+	type plain CompositionAttester
+	return json.Marshal(struct {
+		plain
+		Mode []*CompositionAttesterMode `json:"mode"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Mode, x.ModeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinition that only have an id
+// or extensions as null
+func (x ElementDefinition) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinition
+	return json.Marshal(struct {
+		plain
+		Alias          []*string                          `json:"alias,omitempty"`
+		Condition      []*string                          `json:"condition,omitempty"`
+		Representation []*ElementDefinitionRepresentation `json:"representation,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+		common.PrimitiveValues(x.Condition, x.ConditionElement),
+		common.PrimitiveValues(x.Representation, x.RepresentationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinitionType that only have an id
+// or extensions as null
+func (x ElementDefinitionType) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinitionType
+	return json.Marshal(struct {
+		plain
+		Aggregation []*ElementDefinitionTypeAggregation `json:"aggregation,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Aggregation, x.AggregationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Endpoint that only have an id
+// or extensions as null
+func (x Endpoint) MarshalJSON() ([]byte, error) {
+	type plain Endpoint
+	return json.Marshal(struct {
+		plain
+		Header          []*string `json:"header,omitempty"`
+		PayloadMimeType []*string `json:"payloadMimeType,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Header, x.HeaderElement),
+		common.PrimitiveValues(x.PayloadMimeType, x.PayloadMimeTypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitAddItem that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitAddItem) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitAddItem
+	return json.Marshal(struct {
+		plain
+		NoteNumber     []*int `json:"noteNumber,omitempty"`
+		SequenceLinkId []*int `json:"sequenceLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+		common.PrimitiveValues(x.SequenceLinkId, x.SequenceLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitAddItemDetail that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitAddItemDetail) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitAddItemDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitInsurance that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitInsurance) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitInsurance
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitItem that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitItem) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitItem
+	return json.Marshal(struct {
+		plain
+		CareTeamLinkId    []*int `json:"careTeamLinkId,omitempty"`
+		DiagnosisLinkId   []*int `json:"diagnosisLinkId,omitempty"`
+		InformationLinkId []*int `json:"informationLinkId,omitempty"`
+		NoteNumber        []*int `json:"noteNumber,omitempty"`
+		ProcedureLinkId   []*int `json:"procedureLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.CareTeamLinkId, x.CareTeamLinkIdElement),
+		common.PrimitiveValues(x.DiagnosisLinkId, x.DiagnosisLinkIdElement),
+		common.PrimitiveValues(x.InformationLinkId, x.InformationLinkIdElement),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+		common.PrimitiveValues(x.ProcedureLinkId, x.ProcedureLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitItemDetail that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitItemDetail) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitItemDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitItemDetailSubDetail that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitItemDetailSubDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HealthcareService that only have an id
+// or extensions as null
+func (x HealthcareService) MarshalJSON() ([]byte, error) {
+	type plain HealthcareService
+	return json.Marshal(struct {
+		plain
+		ProgramName []*string `json:"programName,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ProgramName, x.ProgramNameElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HealthcareServiceAvailableTime that only have an id
+// or extensions as null
+func (x HealthcareServiceAvailableTime) MarshalJSON() ([]byte, error) {
+	type plain HealthcareServiceAvailableTime
+	return json.Marshal(struct {
+		plain
+		DaysOfWeek []*HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DaysOfWeek, x.DaysOfWeekElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HumanName that only have an id
+// or extensions as null
+func (x HumanName) MarshalJSON() ([]byte, error) {
+	type plain HumanName
+	return json.Marshal(struct {
+		plain
+		Given  []*string `json:"given,omitempty"`
+		Prefix []*string `json:"prefix,omitempty"`
+		Suffix []*string `json:"suffix,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Given, x.GivenElement),
+		common.PrimitiveValues(x.Prefix, x.PrefixElement),
+		common.PrimitiveValues(x.Suffix, x.SuffixElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuide that only have an id
+// or extensions as null
+func (x ImplementationGuide) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuide
+	return json.Marshal(struct {
+		plain
+		Binary []*string `json:"binary,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Binary, x.BinaryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuidePage that only have an id
+// or extensions as null
+func (x ImplementationGuidePage) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuidePage
+	return json.Marshal(struct {
+		plain
+		Package []*string `json:"package,omitempty"`
+		Type    []*string `json:"type,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Package, x.PackageElement),
+		common.PrimitiveValues(x.Type, x.TypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Measure that only have an id
+// or extensions as null
+func (x Measure) MarshalJSON() ([]byte, error) {
+	type plain Measure
+	return json.Marshal(struct {
+		plain
+		Definition []*string `json:"definition,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Definition, x.DefinitionElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Meta that only have an id
+// or extensions as null
+func (x Meta) MarshalJSON() ([]byte, error) {
+	type plain Meta
+	return json.Marshal(struct {
+		plain
+		Profile []*string `json:"profile,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Profile, x.ProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationDefinition that only have an id
+// or extensions as null
+func (x OperationDefinition) MarshalJSON() ([]byte, error) {
+	type plain OperationDefinition
+	return json.Marshal(struct {
+		plain
+		Resource []*string `json:"resource,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Resource, x.ResourceElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationDefinitionOverload that only have an id
+// or extensions as null
+func (x OperationDefinitionOverload) MarshalJSON() ([]byte, error) {
+	type plain OperationDefinitionOverload
+	return json.Marshal(struct {
+		plain
+		ParameterName []*string `json:"parameterName,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ParameterName, x.ParameterNameElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationOutcomeIssue that only have an id
+// or extensions as null
+func (x OperationOutcomeIssue) MarshalJSON() ([]byte, error) {
+	type plain OperationOutcomeIssue
+	return json.Marshal(struct {
+		plain
+		Expression []*string `json:"expression,omitempty"`
+		Location   []*string `json:"location,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Expression, x.ExpressionElement),
+		common.PrimitiveValues(x.Location, x.LocationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of PlanDefinitionAction that only have an id
+// or extensions as null
+func (x PlanDefinitionAction) MarshalJSON() ([]byte, error) {
+	type plain PlanDefinitionAction
+	return json.Marshal(struct {
+		plain
+		GoalId []*string `json:"goalId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.GoalId, x.GoalIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of PractitionerRoleAvailableTime that only have an id
+// or extensions as null
+func (x PractitionerRoleAvailableTime) MarshalJSON() ([]byte, error) {
+	type plain PractitionerRoleAvailableTime
+	return json.Marshal(struct {
+		plain
+		DaysOfWeek []*PractitionerRoleAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DaysOfWeek, x.DaysOfWeekElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ProcessRequest that only have an id
+// or extensions as null
+func (x ProcessRequest) MarshalJSON() ([]byte, error) {
+	type plain ProcessRequest
+	return json.Marshal(struct {
+		plain
+		Exclude []*string `json:"exclude,omitempty"`
+		Include []*string `json:"include,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Exclude, x.ExcludeElement),
+		common.PrimitiveValues(x.Include, x.IncludeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Provenance that only have an id
+// or extensions as null
+func (x Provenance) MarshalJSON() ([]byte, error) {
+	type plain Provenance
+	return json.Marshal(struct {
+		plain
+		Policy []*string `json:"policy,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Policy, x.PolicyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Questionnaire that only have an id
+// or extensions as null
+func (x Questionnaire) MarshalJSON() ([]byte, error) {
+	type plain Questionnaire
+	return json.Marshal(struct {
+		plain
+		SubjectType []*string `json:"subjectType,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.SubjectType, x.SubjectTypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SearchParameter that only have an id
+// or extensions as null
+func (x SearchParameter) MarshalJSON() ([]byte, error) {
+	type plain SearchParameter
+	return json.Marshal(struct {
+		plain
+		Base       []*string                    `json:"base"`
+		Chain      []*string                    `json:"chain,omitempty"`
+		Comparator []*SearchParameterComparator `json:"comparator,omitempty"`
+		Modifier   []*SearchParameterModifier   `json:"modifier,omitempty"`
+		Target     []*string                    `json:"target,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Base, x.BaseElement),
+		common.PrimitiveValues(x.Chain, x.ChainElement),
+		common.PrimitiveValues(x.Comparator, x.ComparatorElement),
+		common.PrimitiveValues(x.Modifier, x.ModifierElement),
+		common.PrimitiveValues(x.Target, x.TargetElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureDefinition that only have an id
+// or extensions as null
+func (x StructureDefinition) MarshalJSON() ([]byte, error) {
+	type plain StructureDefinition
+	return json.Marshal(struct {
+		plain
+		Context          []*string `json:"context,omitempty"`
+		ContextInvariant []*string `json:"contextInvariant,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Context, x.ContextElement),
+		common.PrimitiveValues(x.ContextInvariant, x.ContextInvariantElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureMap that only have an id
+// or extensions as null
+func (x StructureMap) MarshalJSON() ([]byte, error) {
+	type plain StructureMap
+	return json.Marshal(struct {
+		plain
+		Import []*string `json:"import,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Import, x.ImportElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureMapGroupRuleDependent that only have an id
+// or extensions as null
+func (x StructureMapGroupRuleDependent) MarshalJSON() ([]byte, error) {
+	type plain StructureMapGroupRuleDependent
+	return json.Marshal(struct {
+		plain
+		Variable []*string `json:"variable"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Variable, x.VariableElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureMapGroupRuleTarget that only have an id
+// or extensions as null
+func (x StructureMapGroupRuleTarget) MarshalJSON() ([]byte, error) {
+	type plain StructureMapGroupRuleTarget
+	return json.Marshal(struct {
+		plain
+		ListMode []*StructureMapGroupRuleTargetListMode `json:"listMode,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ListMode, x.ListModeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SubscriptionChannel that only have an id
+// or extensions as null
+func (x SubscriptionChannel) MarshalJSON() ([]byte, error) {
+	type plain SubscriptionChannel
+	return json.Marshal(struct {
+		plain
+		Header []*string `json:"header,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Header, x.HeaderElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TestScriptMetadataCapability that only have an id
+// or extensions as null
+func (x TestScriptMetadataCapability) MarshalJSON() ([]byte, error) {
+	type plain TestScriptMetadataCapability
+	return json.Marshal(struct {
+		plain
+		Link   []*string `json:"link,omitempty"`
+		Origin []*int    `json:"origin,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Link, x.LinkElement),
+		common.PrimitiveValues(x.Origin, x.OriginElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Timing that only have an id
+// or extensions as null
+func (x Timing) MarshalJSON() ([]byte, error) {
+	type plain Timing
+	return json.Marshal(struct {
+		plain
+		Event []*common.DateTime `json:"event,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Event, x.EventElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TimingRepeat that only have an id
+// or extensions as null
+func (x TimingRepeat) MarshalJSON() ([]byte, error) {
+	type plain TimingRepeat
+	return json.Marshal(struct {
+		plain
+		TimeOfDay []*common.Time           `json:"timeOfDay,omitempty"`
+		DayOfWeek []*TimingRepeatDayOfWeek `json:"dayOfWeek,omitempty"`
+		When      []*TimingRepeatWhen      `json:"when,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.TimeOfDay, x.TimeOfDayElement),
+		common.PrimitiveValues(x.DayOfWeek, x.DayOfWeekElement),
+		common.PrimitiveValues(x.When, x.WhenElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ValueSetComposeInclude that only have an id
+// or extensions as null
+func (x ValueSetComposeInclude) MarshalJSON() ([]byte, error) {
+	type plain ValueSetComposeInclude
+	return json.Marshal(struct {
+		plain
+		ValueSet []*string `json:"valueSet,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ValueSet, x.ValueSetElement),
+	})
+}
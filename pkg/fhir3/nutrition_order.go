@@ -0,0 +1,181 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// NutritionOrder represents diet, formula or nutritional supplement request
+type NutritionOrder struct {
+	DomainResource
+
+	// List of the patient's food and nutrition-related allergies and intolerances
+	AllergyIntolerance []common.Reference `json:"allergyIntolerance,omitempty"`
+
+	// Date and time the nutrition order was requested
+	DateTime        common.DateTime `json:"dateTime"`
+	DateTimeElement *common.Element `json:"_dateTime,omitempty"`
+
+	// The encounter associated with this nutrition order
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Enteral formula components
+	EnteralFormula *NutritionOrderEnteralFormula `json:"enteralFormula,omitempty"`
+
+	// Order-specific modifier about the type of food that should not be given
+	ExcludeFoodModifier []common.CodeableConcept `json:"excludeFoodModifier,omitempty"`
+
+	// Order-specific modifier about the type of food that should be given
+	FoodPreferenceModifier []common.CodeableConcept `json:"foodPreferenceModifier,omitempty"`
+
+	// Identifiers assigned to this order
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Oral diet components
+	OralDiet *NutritionOrderOralDiet `json:"oralDiet,omitempty"`
+
+	// Who ordered the diet, formula or nutritional supplement
+	Orderer *common.Reference `json:"orderer,omitempty"`
+
+	// The person (patient) who needs the nutrition order for an oral diet
+	Patient *common.Reference `json:"patient"`
+
+	// draft | active | on-hold | revoked | completed | entered-in-error | unknown
+	Status        *NutritionOrderStatus `json:"status,omitempty"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// Supplement components
+	Supplement []NutritionOrderSupplement `json:"supplement,omitempty"`
+}
+
+// NutritionOrderStatus represents the status of a nutrition order
+type NutritionOrderStatus string
+
+const (
+	NutritionOrderStatusProposed       NutritionOrderStatus = "proposed"
+	NutritionOrderStatusDraft          NutritionOrderStatus = "draft"
+	NutritionOrderStatusPlanned        NutritionOrderStatus = "planned"
+	NutritionOrderStatusRequested      NutritionOrderStatus = "requested"
+	NutritionOrderStatusActive         NutritionOrderStatus = "active"
+	NutritionOrderStatusOnHold         NutritionOrderStatus = "on-hold"
+	NutritionOrderStatusCompleted      NutritionOrderStatus = "completed"
+	NutritionOrderStatusCancelled      NutritionOrderStatus = "cancelled"
+	NutritionOrderStatusEnteredInError NutritionOrderStatus = "entered-in-error"
+)
+
+// NutritionOrderOralDietNutrient represents required  nutrient modifications
+type NutritionOrderOralDietNutrient struct {
+	common.BackboneElement
+
+	// Quantity of the specified nutrient
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// Type of nutrient that is being modified
+	Modifier *common.CodeableConcept `json:"modifier,omitempty"`
+}
+
+// NutritionOrderOralDietTexture represents required  texture modifications
+type NutritionOrderOralDietTexture struct {
+	common.BackboneElement
+
+	// Concepts that are used to identify an entity that is ingested for nutritional purposes
+	FoodType *common.CodeableConcept `json:"foodType,omitempty"`
+
+	// Code to indicate how to alter the texture of the foods, e.g. pureed
+	Modifier *common.CodeableConcept `json:"modifier,omitempty"`
+}
+
+// NutritionOrderOralDiet represents oral diet components
+type NutritionOrderOralDiet struct {
+	common.BackboneElement
+
+	// The required consistency of fluids and liquids provided to the patient
+	FluidConsistencyType []common.CodeableConcept `json:"fluidConsistencyType,omitempty"`
+
+	// Instructions or additional information about the oral diet
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
+
+	// Required  nutrient modifications
+	Nutrient []NutritionOrderOralDietNutrient `json:"nutrient,omitempty"`
+
+	// Scheduling information for oral diets
+	Schedule []Timing `json:"schedule,omitempty"`
+
+	// Required  texture modifications
+	Texture []NutritionOrderOralDietTexture `json:"texture,omitempty"`
+
+	// Type of oral diet or diet restrictions that describe what can be consumed orally
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// NutritionOrderSupplement represents supplement components
+type NutritionOrderSupplement struct {
+	common.BackboneElement
+
+	// Instructions or additional information about the oral supplement
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
+
+	// Product or brand name of the nutritional supplement
+	ProductName        *string         `json:"productName,omitempty"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
+
+	// Amount of the nutritional supplement
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Scheduling information for supplements
+	Schedule []Timing `json:"schedule,omitempty"`
+
+	// Type of supplement product requested
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// NutritionOrderEnteralFormulaAdministration represents formula feeding instruction as structured data
+type NutritionOrderEnteralFormulaAdministration struct {
+	common.BackboneElement
+
+	// The volume of formula to provide
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Speed with which the formula is provided per period of time
+	RateQuantity *common.Quantity `json:"rateQuantity,omitempty"`
+	RateRatio    *Ratio           `json:"rateRatio,omitempty"`
+
+	// Scheduling information for enteral formula products
+	Schedule *Timing `json:"schedule,omitempty"`
+}
+
+// NutritionOrderEnteralFormula represents enteral formula components
+type NutritionOrderEnteralFormula struct {
+	common.BackboneElement
+
+	// The product or brand name of the type of modular component to be added to the formula
+	AdditiveProductName        *string         `json:"additiveProductName,omitempty"`
+	AdditiveProductNameElement *common.Element `json:"_additiveProductName,omitempty"`
+
+	// Indicates the type of modular component such as protein, carbohydrate
+	AdditiveType *common.CodeableConcept `json:"additiveType,omitempty"`
+
+	// Formula feeding instruction as structured data
+	Administration []NutritionOrderEnteralFormulaAdministration `json:"administration,omitempty"`
+
+	// Formula feeding instructions expressed as text
+	AdministrationInstruction        *string         `json:"administrationInstruction,omitempty"`
+	AdministrationInstructionElement *common.Element `json:"_administrationInstruction,omitempty"`
+
+	// Product or brand name of the enteral or infant formula
+	BaseFormulaProductName        *string         `json:"baseFormulaProductName,omitempty"`
+	BaseFormulaProductNameElement *common.Element `json:"_baseFormulaProductName,omitempty"`
+
+	// Type of enteral or infant formula
+	BaseFormulaType *common.CodeableConcept `json:"baseFormulaType,omitempty"`
+
+	// Amount of energy per specified volume that is required
+	CaloricDensity *common.Quantity `json:"caloricDensity,omitempty"`
+
+	// Upper limit on formula volume per unit of time
+	MaxVolumeToDeliver *common.Quantity `json:"maxVolumeToDeliver,omitempty"`
+
+	// The route or physiological path of administration into the patient's gastrointestinal tract for purposes of providing the formula feeding, e.g. nasogastric tube
+	RouteofAdministration *common.CodeableConcept `json:"routeofAdministration,omitempty"`
+}
@@ -0,0 +1,215 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// OperationDefinition represents definition of an operation or a named query
+type OperationDefinition struct {
+	DomainResource
+
+	// Marks this as a profile of the base
+	Base *common.Reference `json:"base,omitempty"`
+
+	// Recommended name for operation in search url
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Additional information about use
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the operation definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Operations that are idempotent (see HTTP specification definition of idempotent) may be invoked by performing an HTTP GET operation instead of a POST
+	Idempotent        *bool           `json:"idempotent,omitempty"`
+	IdempotentElement *common.Element `json:"_idempotent,omitempty"`
+
+	// Invoke on an instance?
+	Instance        bool            `json:"instance"`
+	InstanceElement *common.Element `json:"_instance,omitempty"`
+
+	// Intended jurisdiction for operation definition (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// operation | query
+	Kind        OperationDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
+
+	// Name for this operation definition (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Define overloaded variants for when  generating code
+	Overload []OperationDefinitionOverload `json:"overload,omitempty"`
+
+	// Parameters for the operation/query
+	Parameter []OperationDefinitionParameter `json:"parameter,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this operation definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Types this operation applies to
+	Resource        []string          `json:"resource,omitempty"`
+	ResourceElement []*common.Element `json:"_resource,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        OperationDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Invoke at the system level?
+	System        bool            `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// Invoke at the type level?
+	Type        bool            `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// Canonical identifier for this operation definition, represented as an absolute URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the operation definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// OperationDefinitionKind represents the kind of an operation definition
+type OperationDefinitionKind string
+
+const (
+	OperationDefinitionKindOperation OperationDefinitionKind = "operation"
+	OperationDefinitionKindQuery     OperationDefinitionKind = "query"
+)
+
+// OperationDefinitionStatus represents the status of an operation definition
+type OperationDefinitionStatus string
+
+const (
+	OperationDefinitionStatusDraft   OperationDefinitionStatus = "draft"
+	OperationDefinitionStatusActive  OperationDefinitionStatus = "active"
+	OperationDefinitionStatusRetired OperationDefinitionStatus = "retired"
+	OperationDefinitionStatusUnknown OperationDefinitionStatus = "unknown"
+)
+
+// OperationDefinitionParameterBinding represents valueSet details if this is coded
+type OperationDefinitionParameterBinding struct {
+	common.BackboneElement
+
+	// required | extensible | preferred | example
+	Strength        OperationDefinitionParameterBindingStrength `json:"strength"`
+	StrengthElement *common.Element                             `json:"_strength,omitempty"`
+
+	// For value sets with a referenceResource, the display can contain the value set description
+	ValueSetUri        *string           `json:"valueSetUri,omitempty"`
+	ValueSetUriElement *common.Element   `json:"_valueSetUri,omitempty"`
+	ValueSetReference  *common.Reference `json:"valueSetReference,omitempty"`
+}
+
+// OperationDefinitionParameterBindingStrength represents the strength of an operation definition parameter binding
+type OperationDefinitionParameterBindingStrength string
+
+const (
+	OperationDefinitionParameterBindingStrengthRequired   OperationDefinitionParameterBindingStrength = "required"
+	OperationDefinitionParameterBindingStrengthExtensible OperationDefinitionParameterBindingStrength = "extensible"
+	OperationDefinitionParameterBindingStrengthPreferred  OperationDefinitionParameterBindingStrength = "preferred"
+	OperationDefinitionParameterBindingStrengthExample    OperationDefinitionParameterBindingStrength = "example"
+)
+
+// OperationDefinitionParameter represents parameters for the operation/query
+type OperationDefinitionParameter struct {
+	common.BackboneElement
+
+	// ValueSet details if this is coded
+	Binding *OperationDefinitionParameterBinding `json:"binding,omitempty"`
+
+	// Description of meaning/use
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Maximum Cardinality (a number or *)
+	Max        string          `json:"max"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Minimum Cardinality
+	Min        int             `json:"min"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Name in Parameters.parameter.name or in URL
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Query Definitions only have one output parameter, named "result"
+	Part []OperationDefinitionParameter `json:"part,omitempty"`
+
+	// A profile the specifies the rules that this parameter must conform to
+	Profile *common.Reference `json:"profile,omitempty"`
+
+	// number | date | string | token | reference | composite | quantity | uri | special
+	SearchType        *OperationDefinitionParameterSearchType `json:"searchType,omitempty"`
+	SearchTypeElement *common.Element                         `json:"_searchType,omitempty"`
+
+	// What type this parameter has
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// in | out
+	Use        OperationDefinitionParameterUse `json:"use"`
+	UseElement *common.Element                 `json:"_use,omitempty"`
+}
+
+// OperationDefinitionParameterSearchType represents the search type of an operation definition parameter
+type OperationDefinitionParameterSearchType string
+
+const (
+	OperationDefinitionParameterSearchTypeNumber    OperationDefinitionParameterSearchType = "number"
+	OperationDefinitionParameterSearchTypeDate      OperationDefinitionParameterSearchType = "date"
+	OperationDefinitionParameterSearchTypeString    OperationDefinitionParameterSearchType = "string"
+	OperationDefinitionParameterSearchTypeToken     OperationDefinitionParameterSearchType = "token"
+	OperationDefinitionParameterSearchTypeReference OperationDefinitionParameterSearchType = "reference"
+	OperationDefinitionParameterSearchTypeComposite OperationDefinitionParameterSearchType = "composite"
+	OperationDefinitionParameterSearchTypeQuantity  OperationDefinitionParameterSearchType = "quantity"
+	OperationDefinitionParameterSearchTypeUri       OperationDefinitionParameterSearchType = "uri"
+)
+
+// OperationDefinitionParameterUse represents the use of an operation definition parameter
+type OperationDefinitionParameterUse string
+
+const (
+	OperationDefinitionParameterUseIn  OperationDefinitionParameterUse = "in"
+	OperationDefinitionParameterUseOut OperationDefinitionParameterUse = "out"
+)
+
+// OperationDefinitionOverload represents define overloaded variants for when  generating code
+type OperationDefinitionOverload struct {
+	common.BackboneElement
+
+	// Comments to go on overload
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Name of parameter to include in overload
+	ParameterName        []string          `json:"parameterName,omitempty"`
+	ParameterNameElement []*common.Element `json:"_parameterName,omitempty"`
+}
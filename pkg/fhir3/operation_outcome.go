@@ -0,0 +1,51 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// OperationOutcome represents information about the success/failure of an action
+type OperationOutcome struct {
+	DomainResource
+
+	// A single issue associated with the action
+	Issue []OperationOutcomeIssue `json:"issue"`
+}
+
+// OperationOutcomeIssue represents a single issue associated with the action
+type OperationOutcomeIssue struct {
+	common.BackboneElement
+
+	// Error or warning code
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Additional details about the error
+	Details *common.CodeableConcept `json:"details,omitempty"`
+
+	// Additional diagnostic information about the issue
+	Diagnostics        *string         `json:"diagnostics,omitempty"`
+	DiagnosticsElement *common.Element `json:"_diagnostics,omitempty"`
+
+	// FHIRPath of element(s) related to issue
+	Expression        []string          `json:"expression,omitempty"`
+	ExpressionElement []*common.Element `json:"_expression,omitempty"`
+
+	// Deprecated: Path of element(s) related to issue
+	Location        []string          `json:"location,omitempty"`
+	LocationElement []*common.Element `json:"_location,omitempty"`
+
+	// fatal | error | warning | information | success
+	Severity        OperationOutcomeIssueSeverity `json:"severity"`
+	SeverityElement *common.Element               `json:"_severity,omitempty"`
+}
+
+// OperationOutcomeIssueSeverity represents the severity of an operation outcome issue
+type OperationOutcomeIssueSeverity string
+
+const (
+	OperationOutcomeIssueSeverityFatal       OperationOutcomeIssueSeverity = "fatal"
+	OperationOutcomeIssueSeverityError       OperationOutcomeIssueSeverity = "error"
+	OperationOutcomeIssueSeverityWarning     OperationOutcomeIssueSeverity = "warning"
+	OperationOutcomeIssueSeverityInformation OperationOutcomeIssueSeverity = "information"
+)
@@ -0,0 +1,106 @@
+package fhir3
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Parameters represents Operation Request or Response
+type Parameters struct {
+	Resource
+
+	// Operation Parameter
+	Parameter []ParametersParameter `json:"parameter,omitempty"`
+}
+
+// ParametersParameter represents Operation Parameter
+type ParametersParameter struct {
+	common.BackboneElement
+
+	// Name from the definition
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Only one level of nested parameters is allowed
+	Part []ParametersParameter `json:"part,omitempty"`
+
+	// If parameter is a whole resource
+	Resource common.Resource `json:"resource,omitempty"`
+
+	// If parameter is a data type
+	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element         `json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element         `json:"_valueBoolean,omitempty"`
+	ValueCode                *string                 `json:"valueCode,omitempty"`
+	ValueCodeElement         *common.Element         `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element         `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element         `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal         `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element         `json:"_valueDecimal,omitempty"`
+	ValueId                  *string                 `json:"valueId,omitempty"`
+	ValueIdElement           *common.Element         `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant         `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element         `json:"_valueInstant,omitempty"`
+	ValueInteger             *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element         `json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element         `json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                 `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element         `json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                    `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element         `json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                 `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element         `json:"_valueString,omitempty"`
+	ValueTime                *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element         `json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                    `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element         `json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                 `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element         `json:"_valueUri,omitempty"`
+	ValueAddress             *Address                `json:"valueAddress,omitempty"`
+	ValueAge                 *Age                    `json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation             `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *common.Coding          `json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint           `json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                  `json:"valueCount,omitempty"`
+	ValueDistance            *Distance               `json:"valueDistance,omitempty"`
+	ValueDuration            *Duration               `json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName              `json:"valueHumanName,omitempty"`
+	ValueIdentifier          *common.Identifier      `json:"valueIdentifier,omitempty"`
+	ValueMoney               *common.Money           `json:"valueMoney,omitempty"`
+	ValuePeriod              *common.Period          `json:"valuePeriod,omitempty"`
+	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange               *Range                  `json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                  `json:"valueRatio,omitempty"`
+	ValueReference           *common.Reference       `json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData            `json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature              `json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                 `json:"valueTiming,omitempty"`
+	ValueMeta                *Meta                   `json:"valueMeta,omitempty"`
+}
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (p *ParametersParameter) UnmarshalJSON(data []byte) error {
+	type Alias ParametersParameter
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	p.Resource = resource
+	return nil
+}
@@ -0,0 +1,53 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PaymentNotice represents paymentNotice request
+type PaymentNotice struct {
+	DomainResource
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Business Identifier for the payment notice
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Issued or cleared Status of the payment
+	PaymentStatus *common.CodeableConcept `json:"paymentStatus,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// Request reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// Response reference
+	Response *common.Reference `json:"response,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *PaymentNoticeStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// The date when the above payment action occurrred
+	StatusDate        *common.Date    `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element `json:"_statusDate,omitempty"`
+
+	// The Insurer who is target of the request
+	Target *common.Reference `json:"target,omitempty"`
+}
+
+// PaymentNoticeStatus represents the status of a payment notice
+type PaymentNoticeStatus string
+
+const (
+	PaymentNoticeStatusActive         PaymentNoticeStatus = "active"
+	PaymentNoticeStatusCancelled      PaymentNoticeStatus = "cancelled"
+	PaymentNoticeStatusDraft          PaymentNoticeStatus = "draft"
+	PaymentNoticeStatusEnteredInError PaymentNoticeStatus = "entered-in-error"
+)
@@ -0,0 +1,104 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PaymentReconciliation represents paymentReconciliation resource
+type PaymentReconciliation struct {
+	DomainResource
+
+	// Creation date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// List of individual settlement amounts and the corresponding transaction
+	Detail []PaymentReconciliationDetail `json:"detail,omitempty"`
+
+	// Disposition message
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// The form to be used for printing the content
+	Form *common.CodeableConcept `json:"form,omitempty"`
+
+	// Business Identifier for a payment reconciliation
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The Insurer who produced this adjudicated response
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// queued | complete | error | partial
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// Period covered
+	Period *common.Period `json:"period,omitempty"`
+
+	// Note concerning processing
+	ProcessNote []PaymentReconciliationProcessNote `json:"processNote,omitempty"`
+
+	// Reference to requesting resource
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// active | cancelled | draft | entered-in-error
+	Status        *PaymentReconciliationStatus `json:"status,omitempty"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
+
+	// Total payment amount
+	Total *common.Money `json:"total,omitempty"`
+}
+
+// PaymentReconciliationStatus represents the status of a payment reconciliation
+type PaymentReconciliationStatus string
+
+const (
+	PaymentReconciliationStatusActive         PaymentReconciliationStatus = "active"
+	PaymentReconciliationStatusCancelled      PaymentReconciliationStatus = "cancelled"
+	PaymentReconciliationStatusDraft          PaymentReconciliationStatus = "draft"
+	PaymentReconciliationStatusEnteredInError PaymentReconciliationStatus = "entered-in-error"
+)
+
+// PaymentReconciliationDetail represents list of individual settlement amounts and the corresponding transaction
+type PaymentReconciliationDetail struct {
+	common.BackboneElement
+
+	// Amount paid for this detail
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// The date of the invoice or financial resource
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// The organization which is receiving the payment
+	Payee *common.Reference `json:"payee,omitempty"`
+
+	// The claim or financial resource
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The claim response resource
+	Response *common.Reference `json:"response,omitempty"`
+
+	// The Organization which submitted the claim or financial transaction
+	Submitter *common.Reference `json:"submitter,omitempty"`
+
+	// Code to indicate the nature of the payment, adjustment, funds advance, etc
+	Type *common.CodeableConcept `json:"type"`
+}
+
+// PaymentReconciliationProcessNote represents note concerning processing
+type PaymentReconciliationProcessNote struct {
+	common.BackboneElement
+
+	// Note explanatory text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// display | print | printoper
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
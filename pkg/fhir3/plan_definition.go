@@ -0,0 +1,399 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PlanDefinition represents the definition of a plan for a series of actions, independent of any specific patient or context
+type PlanDefinition struct {
+	DomainResource
+
+	// Action defined by the plan
+	Action []PlanDefinitionAction `json:"action,omitempty"`
+
+	// When the plan definition was approved by publisher
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A contributor to the content of the asset, including authors, editors, reviewers, and endorsers
+	Contributor []Contributor `json:"contributor,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the plan definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// When the plan definition is expected to be used
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// What the plan is trying to accomplish
+	Goal []PlanDefinitionGoal `json:"goal,omitempty"`
+
+	// Additional identifier for the plan definition
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for plan definition (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// When the plan definition was last reviewed by the publisher
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// Logic used by the plan definition
+	Library []common.Reference `json:"library,omitempty"`
+
+	// Name for this plan definition (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this plan definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Additional documentation, citations
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        PlanDefinitionStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Name for this plan definition (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// E.g. Education, Treatment, Assessment
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// order-set | clinical-protocol | eca-rule | workflow-definition
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Canonical identifier for this plan definition, represented as a URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// Describes the clinical usage of the plan
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the plan definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// PlanDefinitionStatus represents the status of a plan definition
+type PlanDefinitionStatus string
+
+const (
+	PlanDefinitionStatusDraft   PlanDefinitionStatus = "draft"
+	PlanDefinitionStatusActive  PlanDefinitionStatus = "active"
+	PlanDefinitionStatusRetired PlanDefinitionStatus = "retired"
+	PlanDefinitionStatusUnknown PlanDefinitionStatus = "unknown"
+)
+
+// PlanDefinitionGoalTarget represents target outcome for the goal
+type PlanDefinitionGoalTarget struct {
+	common.BackboneElement
+
+	// The target value to be achieved
+	DetailQuantity        *common.Quantity        `json:"detailQuantity,omitempty"`
+	DetailRange           *Range                  `json:"detailRange,omitempty"`
+	DetailCodeableConcept *common.CodeableConcept `json:"detailCodeableConcept,omitempty"`
+
+	// Reach goal within
+	Due *Duration `json:"due,omitempty"`
+
+	// The parameter whose value is to be tracked
+	Measure *common.CodeableConcept `json:"measure,omitempty"`
+}
+
+// PlanDefinitionGoal represents what the plan is trying to accomplish
+type PlanDefinitionGoal struct {
+	common.BackboneElement
+
+	// What does the goal address
+	Addresses []common.CodeableConcept `json:"addresses,omitempty"`
+
+	// E.g. Treatment, dietary, behavioral
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Code or text describing the goal
+	Description *common.CodeableConcept `json:"description"`
+
+	// Supporting documentation for the goal
+	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
+
+	// high-priority | medium-priority | low-priority
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// When goal pursuit begins
+	Start *common.CodeableConcept `json:"start,omitempty"`
+
+	// Target outcome for the goal
+	Target []PlanDefinitionGoalTarget `json:"target,omitempty"`
+}
+
+// PlanDefinitionActionCondition represents an expression that describes applicability criteria, or start/stop conditions for the action
+type PlanDefinitionActionCondition struct {
+	common.BackboneElement
+
+	// A brief, natural language description of the condition that effectively communicates the intended semantics
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Boolean-valued expression
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// applicability | start | stop
+	Kind        PlanDefinitionActionConditionKind `json:"kind"`
+	KindElement *common.Element                   `json:"_kind,omitempty"`
+
+	// The media type of the language for the expression
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+}
+
+// PlanDefinitionActionConditionKind represents the kind of a plan definition action condition
+type PlanDefinitionActionConditionKind string
+
+const (
+	PlanDefinitionActionConditionKindApplicability PlanDefinitionActionConditionKind = "applicability"
+	PlanDefinitionActionConditionKindStart         PlanDefinitionActionConditionKind = "start"
+	PlanDefinitionActionConditionKindStop          PlanDefinitionActionConditionKind = "stop"
+)
+
+// PlanDefinitionActionRelatedAction represents relationship to another action
+type PlanDefinitionActionRelatedAction struct {
+	common.BackboneElement
+
+	// The element id of the related action
+	ActionId        string          `json:"actionId"`
+	ActionIdElement *common.Element `json:"_actionId,omitempty"`
+
+	// Time offset for the relationship
+	OffsetDuration *Duration `json:"offsetDuration,omitempty"`
+	OffsetRange    *Range    `json:"offsetRange,omitempty"`
+
+	// before | before-start | before-end | concurrent | concurrent-with-start | concurrent-with-end | after | after-start | after-end
+	Relationship        PlanDefinitionActionRelatedActionRelationship `json:"relationship"`
+	RelationshipElement *common.Element                               `json:"_relationship,omitempty"`
+}
+
+// PlanDefinitionActionRelatedActionRelationship represents the relationship of a plan definition action related action
+type PlanDefinitionActionRelatedActionRelationship string
+
+const (
+	PlanDefinitionActionRelatedActionRelationshipBeforeStart         PlanDefinitionActionRelatedActionRelationship = "before-start"
+	PlanDefinitionActionRelatedActionRelationshipBefore              PlanDefinitionActionRelatedActionRelationship = "before"
+	PlanDefinitionActionRelatedActionRelationshipBeforeEnd           PlanDefinitionActionRelatedActionRelationship = "before-end"
+	PlanDefinitionActionRelatedActionRelationshipConcurrentWithStart PlanDefinitionActionRelatedActionRelationship = "concurrent-with-start"
+	PlanDefinitionActionRelatedActionRelationshipConcurrent          PlanDefinitionActionRelatedActionRelationship = "concurrent"
+	PlanDefinitionActionRelatedActionRelationshipConcurrentWithEnd   PlanDefinitionActionRelatedActionRelationship = "concurrent-with-end"
+	PlanDefinitionActionRelatedActionRelationshipAfterStart          PlanDefinitionActionRelatedActionRelationship = "after-start"
+	PlanDefinitionActionRelatedActionRelationshipAfter               PlanDefinitionActionRelatedActionRelationship = "after"
+	PlanDefinitionActionRelatedActionRelationshipAfterEnd            PlanDefinitionActionRelatedActionRelationship = "after-end"
+)
+
+// PlanDefinitionActionParticipant represents who should participate in the action
+type PlanDefinitionActionParticipant struct {
+	common.BackboneElement
+
+	// E.g. Nurse, Surgeon, Parent
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// careteam | device | group | healthcareservice | location | organization | patient | practitioner | practitionerrole | relatedperson
+	Type        PlanDefinitionActionParticipantType `json:"type"`
+	TypeElement *common.Element                     `json:"_type,omitempty"`
+}
+
+// PlanDefinitionActionParticipantType represents the type of a plan definition action participant
+type PlanDefinitionActionParticipantType string
+
+const (
+	PlanDefinitionActionParticipantTypePatient       PlanDefinitionActionParticipantType = "patient"
+	PlanDefinitionActionParticipantTypePractitioner  PlanDefinitionActionParticipantType = "practitioner"
+	PlanDefinitionActionParticipantTypeRelatedPerson PlanDefinitionActionParticipantType = "related-person"
+)
+
+// PlanDefinitionActionDynamicValue represents dynamic aspects of the definition
+type PlanDefinitionActionDynamicValue struct {
+	common.BackboneElement
+
+	// A brief, natural language description of the intended semantics of the dynamic value
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An expression that provides the dynamic value for the customization
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// The media type of the language for the expression
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// The path to the element to be set dynamically
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+}
+
+// PlanDefinitionAction represents action defined by the plan
+type PlanDefinitionAction struct {
+	common.BackboneElement
+
+	// Sub actions that are contained within the action
+	Action []PlanDefinitionAction `json:"action,omitempty"`
+
+	// single | multiple
+	CardinalityBehavior        *PlanDefinitionActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                          `json:"_cardinalityBehavior,omitempty"`
+
+	// Code representing the meaning of the action or sub-actions
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// Whether or not the action is applicable
+	Condition []PlanDefinitionActionCondition `json:"condition,omitempty"`
+
+	// Note that the definition is optional, and if no definition is specified, a dynamicValue with a root
+	Definition *common.Reference `json:"definition,omitempty"`
+
+	// Brief description of the action
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Supporting documentation for the intended performer of the action
+	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
+
+	// Dynamic aspects of the definition
+	DynamicValue []PlanDefinitionActionDynamicValue `json:"dynamicValue,omitempty"`
+
+	// What goals this action supports
+	GoalId        []string          `json:"goalId,omitempty"`
+	GoalIdElement []*common.Element `json:"_goalId,omitempty"`
+
+	// visual-group | logical-group | sentence-group
+	GroupingBehavior        *PlanDefinitionActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement *common.Element                       `json:"_groupingBehavior,omitempty"`
+
+	// Input data requirements
+	Input []common.DataRequirement `json:"input,omitempty"`
+
+	// A user-visible label for the action
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Output data definition
+	Output []common.DataRequirement `json:"output,omitempty"`
+
+	// Who should participate in the action
+	Participant []PlanDefinitionActionParticipant `json:"participant,omitempty"`
+
+	// yes | no
+	PrecheckBehavior        *PlanDefinitionActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement *common.Element                       `json:"_precheckBehavior,omitempty"`
+
+	// Why the action should be performed
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Relationship to another action
+	RelatedAction []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty"`
+
+	// must | could | must-unless-documented
+	RequiredBehavior        *PlanDefinitionActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement *common.Element                       `json:"_requiredBehavior,omitempty"`
+
+	// any | all | all-or-none | exactly-one | at-most-one | one-or-more
+	SelectionBehavior        *PlanDefinitionActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement *common.Element                        `json:"_selectionBehavior,omitempty"`
+
+	// Static text equivalent of the action, used if the dynamic aspects cannot be interpreted by the receiving system
+	TextEquivalent        *string         `json:"textEquivalent,omitempty"`
+	TextEquivalentElement *common.Element `json:"_textEquivalent,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingDuration        *Duration        `json:"timingDuration,omitempty"`
+	TimingRange           *Range           `json:"timingRange,omitempty"`
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+
+	// User-visible title
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Transform to apply the template
+	Transform *common.Reference `json:"transform,omitempty"`
+
+	// A description of when the action should be triggered
+	TriggerDefinition []common.TriggerDefinition `json:"triggerDefinition,omitempty"`
+
+	// create | update | remove | fire-event
+	Type *common.Coding `json:"type,omitempty"`
+}
+
+// PlanDefinitionActionCardinalityBehavior represents the cardinality behavior of a plan definition action
+type PlanDefinitionActionCardinalityBehavior string
+
+const (
+	PlanDefinitionActionCardinalityBehaviorSingle   PlanDefinitionActionCardinalityBehavior = "single"
+	PlanDefinitionActionCardinalityBehaviorMultiple PlanDefinitionActionCardinalityBehavior = "multiple"
+)
+
+// PlanDefinitionActionGroupingBehavior represents the grouping behavior of a plan definition action
+type PlanDefinitionActionGroupingBehavior string
+
+const (
+	PlanDefinitionActionGroupingBehaviorVisualGroup   PlanDefinitionActionGroupingBehavior = "visual-group"
+	PlanDefinitionActionGroupingBehaviorLogicalGroup  PlanDefinitionActionGroupingBehavior = "logical-group"
+	PlanDefinitionActionGroupingBehaviorSentenceGroup PlanDefinitionActionGroupingBehavior = "sentence-group"
+)
+
+// PlanDefinitionActionPrecheckBehavior represents the precheck behavior of a plan definition action
+type PlanDefinitionActionPrecheckBehavior string
+
+const (
+	PlanDefinitionActionPrecheckBehaviorYes PlanDefinitionActionPrecheckBehavior = "yes"
+	PlanDefinitionActionPrecheckBehaviorNo  PlanDefinitionActionPrecheckBehavior = "no"
+)
+
+// PlanDefinitionActionRequiredBehavior represents the required behavior of a plan definition action
+type PlanDefinitionActionRequiredBehavior string
+
+const (
+	PlanDefinitionActionRequiredBehaviorMust                 PlanDefinitionActionRequiredBehavior = "must"
+	PlanDefinitionActionRequiredBehaviorCould                PlanDefinitionActionRequiredBehavior = "could"
+	PlanDefinitionActionRequiredBehaviorMustUnlessDocumented PlanDefinitionActionRequiredBehavior = "must-unless-documented"
+)
+
+// PlanDefinitionActionSelectionBehavior represents the selection behavior of a plan definition action
+type PlanDefinitionActionSelectionBehavior string
+
+const (
+	PlanDefinitionActionSelectionBehaviorAny        PlanDefinitionActionSelectionBehavior = "any"
+	PlanDefinitionActionSelectionBehaviorAll        PlanDefinitionActionSelectionBehavior = "all"
+	PlanDefinitionActionSelectionBehaviorAllOrNone  PlanDefinitionActionSelectionBehavior = "all-or-none"
+	PlanDefinitionActionSelectionBehaviorExactlyOne PlanDefinitionActionSelectionBehavior = "exactly-one"
+	PlanDefinitionActionSelectionBehaviorAtMostOne  PlanDefinitionActionSelectionBehavior = "at-most-one"
+	PlanDefinitionActionSelectionBehaviorOneOrMore  PlanDefinitionActionSelectionBehavior = "one-or-more"
+)
@@ -0,0 +1,98 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PractitionerRole represents roles/organizations the practitioner is associated with
+type PractitionerRole struct {
+	DomainResource
+
+	// Whether this practitioner role record is in active use
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// A description of site availability exceptions, e.g. public holiday availability
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
+
+	// More detailed availability information may be provided in associated Schedule/Slot resources
+	AvailableTime []PractitionerRoleAvailableTime `json:"availableTime,omitempty"`
+
+	// Roles which this practitioner may perform
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// Endpoints for interacting with the practitioner in this role
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// Healthcare services provided for this role's Organization/Location(s)
+	HealthcareService []common.Reference `json:"healthcareService,omitempty"`
+
+	// Identifiers for a role/location
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Location(s) where the practitioner provides care
+	Location []common.Reference `json:"location,omitempty"`
+
+	// The HealthcareService is not available during this period of time due to the provided reason
+	NotAvailable []PractitionerRoleNotAvailable `json:"notAvailable,omitempty"`
+
+	// Organization where the roles are available
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// The period during which the practitioner is authorized to perform in these role(s)
+	Period *common.Period `json:"period,omitempty"`
+
+	// Practitioner that provides services for the organization
+	Practitioner *common.Reference `json:"practitioner,omitempty"`
+
+	// Specific specialty of the practitioner
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+
+	// Contact details that are specific to the role/location/service
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// PractitionerRoleAvailableTime represents more detailed availability information may be provided in associated Schedule/Slot resources
+type PractitionerRoleAvailableTime struct {
+	common.BackboneElement
+
+	// Is this always available? (hence times are irrelevant) e.g. 24 hour service
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
+
+	// The timezone is expected to be for where this HealthcareService is provided at
+	AvailableEndTime          *common.Time    `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *common.Element `json:"_availableEndTime,omitempty"`
+	AvailableStartTime        *common.Time    `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element `json:"_availableStartTime,omitempty"`
+
+	// Indicates which days of the week are available between the start and end Times
+	DaysOfWeek        []PractitionerRoleAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element                         `json:"_daysOfWeek,omitempty"`
+}
+
+// PractitionerRoleAvailableTimeDaysOfWeek represents the days of week of a practitioner role available time
+type PractitionerRoleAvailableTimeDaysOfWeek string
+
+const (
+	PractitionerRoleAvailableTimeDaysOfWeekMon PractitionerRoleAvailableTimeDaysOfWeek = "mon"
+	PractitionerRoleAvailableTimeDaysOfWeekTue PractitionerRoleAvailableTimeDaysOfWeek = "tue"
+	PractitionerRoleAvailableTimeDaysOfWeekWed PractitionerRoleAvailableTimeDaysOfWeek = "wed"
+	PractitionerRoleAvailableTimeDaysOfWeekThu PractitionerRoleAvailableTimeDaysOfWeek = "thu"
+	PractitionerRoleAvailableTimeDaysOfWeekFri PractitionerRoleAvailableTimeDaysOfWeek = "fri"
+	PractitionerRoleAvailableTimeDaysOfWeekSat PractitionerRoleAvailableTimeDaysOfWeek = "sat"
+	PractitionerRoleAvailableTimeDaysOfWeekSun PractitionerRoleAvailableTimeDaysOfWeek = "sun"
+)
+
+// PractitionerRoleNotAvailable represents the not available of a practitioner role
+type PractitionerRoleNotAvailable struct {
+	common.BackboneElement
+
+	// The reason that can be presented to the user as to why this time is not available
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Service is not available (seasonally or for a public holiday) from this date
+	During *common.Period `json:"during,omitempty"`
+}
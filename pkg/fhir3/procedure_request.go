@@ -0,0 +1,144 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProcedureRequest represents a record of a request for diagnostic investigations, treatments, or operations to be performed
+type ProcedureRequest struct {
+	DomainResource
+
+	// If a CodeableConcept is present, it indicates the pre-condition for performing the procedure
+	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
+	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
+
+	// When the request transitioned to being actionable
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// Plan/proposal/order fulfilled by this request
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Only used if not implicit in the code found in ProcedureRequest.type
+	BodySite []common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// There may be multiple axis of categorization depending on the context or use case for retrieving or displaying the resource
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Many laboratory and radiology procedure codes embed the specimen/organ system in the test ordeer name, for example
+	Code *common.CodeableConcept `json:"code"`
+
+	// An encounter or episode of care that provides additional information about the healthcare context in which this request is made
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Protocol or definition followed by this request
+	Definition []common.Reference `json:"definition,omitempty"`
+
+	// This element is labeled as a modifier because it indicates that a procedure shouldn't happen
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
+
+	// The identifier.type element is used to distinguish between the identifiers assigned by the orderer
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// This element is labeled as a modifier because the intent alters when and how the resource is actually applicable
+	Intent        ProcedureRequestIntent `json:"intent"`
+	IntentElement *common.Element        `json:"_intent,omitempty"`
+
+	// Any other notes and comments made about the service request
+	Note []Annotation `json:"note,omitempty"`
+
+	// The date/time at which the diagnostic testing should occur
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
+
+	// If needed, use an extension for listing alternative performers and/or roles and/or preference
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// this is a role, not a participation type
+	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
+
+	// Indicates how quickly the ProcedureRequest should be addressed with respect to other requests
+	Priority        *ProcedureRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element           `json:"_priority,omitempty"`
+
+	// This may be used to decide how the diagnostic investigation will be performed, or even if it will be performed at all
+	ReasonCode      []common.CodeableConcept `json:"reasonCode,omitempty"`
+	ReasonReference []common.Reference       `json:"reasonReference,omitempty"`
+
+	// This may not include provenances for all versions of the request – only those deemed “relevant” or important
+	RelevantHistory []common.Reference `json:"relevantHistory,omitempty"`
+
+	// The request takes the place of the referenced completed or terminated request(s)
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	// This not the dispatcher, but rather who is the authorizer
+	Requester *ProcedureRequestRequester `json:"requester,omitempty"`
+
+	// Requests are linked either by a "basedOn" relationship
+	Requisition *common.Identifier `json:"requisition,omitempty"`
+
+	// Many diagnostic procedures need a specimen, but the request itself is not actually about the specimen
+	Specimen []common.Reference `json:"specimen,omitempty"`
+
+	// The status is generally fully in the control of the requester
+	Status        ProcedureRequestStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// On whom or what the procedure or diagnostic is to be performed
+	Subject *common.Reference `json:"subject"`
+
+	// Additional clinical information about the patient or specimen that may influence the procedure or diagnostics or their interpretations
+	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
+}
+
+// ProcedureRequestIntent represents the intent of a procedure request
+type ProcedureRequestIntent string
+
+const (
+	ProcedureRequestIntentProposal      ProcedureRequestIntent = "proposal"
+	ProcedureRequestIntentPlan          ProcedureRequestIntent = "plan"
+	ProcedureRequestIntentOrder         ProcedureRequestIntent = "order"
+	ProcedureRequestIntentOriginalOrder ProcedureRequestIntent = "original-order"
+	ProcedureRequestIntentReflexOrder   ProcedureRequestIntent = "reflex-order"
+	ProcedureRequestIntentFillerOrder   ProcedureRequestIntent = "filler-order"
+	ProcedureRequestIntentInstanceOrder ProcedureRequestIntent = "instance-order"
+	ProcedureRequestIntentOption        ProcedureRequestIntent = "option"
+)
+
+// ProcedureRequestPriority represents the priority of a procedure request
+type ProcedureRequestPriority string
+
+const (
+	ProcedureRequestPriorityRoutine ProcedureRequestPriority = "routine"
+	ProcedureRequestPriorityUrgent  ProcedureRequestPriority = "urgent"
+	ProcedureRequestPriorityAsap    ProcedureRequestPriority = "asap"
+	ProcedureRequestPriorityStat    ProcedureRequestPriority = "stat"
+)
+
+// ProcedureRequestStatus represents the status of a procedure request
+type ProcedureRequestStatus string
+
+const (
+	ProcedureRequestStatusDraft          ProcedureRequestStatus = "draft"
+	ProcedureRequestStatusActive         ProcedureRequestStatus = "active"
+	ProcedureRequestStatusSuspended      ProcedureRequestStatus = "suspended"
+	ProcedureRequestStatusCancelled      ProcedureRequestStatus = "cancelled"
+	ProcedureRequestStatusCompleted      ProcedureRequestStatus = "completed"
+	ProcedureRequestStatusEnteredInError ProcedureRequestStatus = "entered-in-error"
+	ProcedureRequestStatusUnknown        ProcedureRequestStatus = "unknown"
+)
+
+// ProcedureRequestRequester represents the requester of a procedure request
+type ProcedureRequestRequester struct {
+	common.BackboneElement
+
+	// The device, practitioner or organization who initiated the request
+	Agent *common.Reference `json:"agent"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
@@ -0,0 +1,91 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProcessRequest provides the target, request and response
+type ProcessRequest struct {
+	DomainResource
+
+	// The type of processing action being requested, for example Reversal, Readjudication, StatusRequest,PendedRequest
+	Action        *ProcessRequestAction `json:"action,omitempty"`
+	ActionElement *common.Element       `json:"_action,omitempty"`
+
+	// The date when this resource was created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// Names of resource types to exclude
+	Exclude        []string          `json:"exclude,omitempty"`
+	ExcludeElement []*common.Element `json:"_exclude,omitempty"`
+
+	// The ProcessRequest business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Names of resource types to include
+	Include        []string          `json:"include,omitempty"`
+	IncludeElement []*common.Element `json:"_include,omitempty"`
+
+	// List of top level items to be re-adjudicated, if none specified then the entire submission is re-adjudicated
+	Item []ProcessRequestItem `json:"item,omitempty"`
+
+	// If true remove all history excluding audit
+	Nullify        *bool           `json:"nullify,omitempty"`
+	NullifyElement *common.Element `json:"_nullify,omitempty"`
+
+	// The organization which is responsible for the action speccified in this request
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// A period of time during which the fulfilling resources would have been created
+	Period *common.Period `json:"period,omitempty"`
+
+	// The practitioner who is responsible for the action specified in this request
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// A reference to supply which authenticates the process
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+
+	// Reference of resource which is the target or subject of this action
+	Request *common.Reference `json:"request,omitempty"`
+
+	// Reference of a prior response to resource which is the target or subject of this action
+	Response *common.Reference `json:"response,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        *ProcessRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// The organization which is the target of the request
+	Target *common.Reference `json:"target,omitempty"`
+}
+
+// ProcessRequestAction represents the action of a process request
+type ProcessRequestAction string
+
+const (
+	ProcessRequestActionCancel    ProcessRequestAction = "cancel"
+	ProcessRequestActionPoll      ProcessRequestAction = "poll"
+	ProcessRequestActionReprocess ProcessRequestAction = "reprocess"
+	ProcessRequestActionStatus    ProcessRequestAction = "status"
+)
+
+// ProcessRequestStatus represents the status of a process request
+type ProcessRequestStatus string
+
+const (
+	ProcessRequestStatusActive         ProcessRequestStatus = "active"
+	ProcessRequestStatusCancelled      ProcessRequestStatus = "cancelled"
+	ProcessRequestStatusDraft          ProcessRequestStatus = "draft"
+	ProcessRequestStatusEnteredInError ProcessRequestStatus = "entered-in-error"
+)
+
+// ProcessRequestItem represents list of top level items to be re-adjudicated, if none specified then the entire submission is re-adjudicated
+type ProcessRequestItem struct {
+	common.BackboneElement
+
+	// A service line number
+	SequenceLinkId        int             `json:"sequenceLinkId"`
+	SequenceLinkIdElement *common.Element `json:"_sequenceLinkId,omitempty"`
+}
@@ -0,0 +1,74 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProcessResponse provides processing status, errors and notes from the processing of a resource
+type ProcessResponse struct {
+	DomainResource
+
+	// Request for additional supporting or authorizing information, such as: documents, images or resources
+	CommunicationRequest []common.Reference `json:"communicationRequest,omitempty"`
+
+	// The date when the enclosed suite of services were performed or completed
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// A description of the status of the adjudication or processing
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Processing errors
+	Error []common.CodeableConcept `json:"error,omitempty"`
+
+	// The form to be used for printing the content
+	Form *common.CodeableConcept `json:"form,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The organization who produced this adjudicated response
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Transaction status: error, complete, held
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// Suite of processing notes or additional requirements if the processing has been held
+	ProcessNote []ProcessResponseProcessNote `json:"processNote,omitempty"`
+
+	// Original request resource reference
+	Request *common.Reference `json:"request,omitempty"`
+
+	// The organization which is responsible for the services rendered to the patient
+	RequestOrganization *common.Reference `json:"requestOrganization,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        *ProcessResponseStatus `json:"status,omitempty"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+}
+
+// ProcessResponseStatus represents the status of a process response
+type ProcessResponseStatus string
+
+const (
+	ProcessResponseStatusActive         ProcessResponseStatus = "active"
+	ProcessResponseStatusCancelled      ProcessResponseStatus = "cancelled"
+	ProcessResponseStatusDraft          ProcessResponseStatus = "draft"
+	ProcessResponseStatusEnteredInError ProcessResponseStatus = "entered-in-error"
+)
+
+// ProcessResponseProcessNote represents suite of processing notes or additional requirements if the processing has been held
+type ProcessResponseProcessNote struct {
+	common.BackboneElement
+
+	// The note text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The note purpose: Print/Display
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
@@ -0,0 +1,92 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Provenance represents Who, What, When for a set of resources
+type Provenance struct {
+	DomainResource
+
+	// Activity that occurred
+	Activity *common.Coding `json:"activity,omitempty"`
+
+	// Actor involved
+	Agent []ProvenanceAgent `json:"agent"`
+
+	// An entity used in this activity
+	Entity []ProvenanceEntity `json:"entity,omitempty"`
+
+	// Where the activity occurred, if relevant
+	Location *common.Reference `json:"location,omitempty"`
+
+	// The period can be a little arbitrary; where possible
+	Period *common.Period `json:"period,omitempty"`
+
+	// Policy or plan the activity was defined by
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
+
+	// The reason that the activity was taking place
+	Reason []common.Coding `json:"reason,omitempty"`
+
+	// When the activity was recorded / updated
+	Recorded        common.Instant  `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
+
+	// Signature on target
+	Signature []Signature `json:"signature,omitempty"`
+
+	// Target Reference(s) (usually version specific)
+	Target []common.Reference `json:"target"`
+}
+
+// ProvenanceAgent represents actor involved
+type ProvenanceAgent struct {
+	common.BackboneElement
+
+	// The individual, device, or organization for whom the change was made
+	OnBehalfOfUri        *string           `json:"onBehalfOfUri,omitempty"`
+	OnBehalfOfUriElement *common.Element   `json:"_onBehalfOfUri,omitempty"`
+	OnBehalfOfReference  *common.Reference `json:"onBehalfOfReference,omitempty"`
+
+	// The type of relationship between agents
+	RelatedAgentType *common.CodeableConcept `json:"relatedAgentType,omitempty"`
+
+	// What the agents role was
+	Role []common.CodeableConcept `json:"role,omitempty"`
+
+	// The individual, device or organization that participated in the event
+	WhoUri        *string           `json:"whoUri,omitempty"`
+	WhoUriElement *common.Element   `json:"_whoUri,omitempty"`
+	WhoReference  *common.Reference `json:"whoReference,omitempty"`
+}
+
+// ProvenanceEntity represents an entity used in this activity
+type ProvenanceEntity struct {
+	common.BackboneElement
+
+	// A usecase where one Provenance.entity.agent is used where the Entity that was used in the creation/updating of the Target, is not in the context of the same custodianship as the Target, and thus the meaning of Provenance.entity.agent is to say that the entity referenced is managed elsewhere and that this Agent provided access to it
+	Agent []ProvenanceAgent `json:"agent,omitempty"`
+
+	// revision | quotation | source | instantiates | removal
+	Role        ProvenanceEntityRole `json:"role"`
+	RoleElement *common.Element      `json:"_role,omitempty"`
+
+	// Identity may be a reference to a resource or to something else, depending on the type
+	WhatUri        *string            `json:"whatUri,omitempty"`
+	WhatUriElement *common.Element    `json:"_whatUri,omitempty"`
+	WhatReference  *common.Reference  `json:"whatReference,omitempty"`
+	WhatIdentifier *common.Identifier `json:"whatIdentifier,omitempty"`
+}
+
+// ProvenanceEntityRole represents the role of a provenance entity
+type ProvenanceEntityRole string
+
+const (
+	ProvenanceEntityRoleDerivation ProvenanceEntityRole = "derivation"
+	ProvenanceEntityRoleRevision   ProvenanceEntityRole = "revision"
+	ProvenanceEntityRoleQuotation  ProvenanceEntityRole = "quotation"
+	ProvenanceEntityRoleSource     ProvenanceEntityRole = "source"
+	ProvenanceEntityRoleRemoval    ProvenanceEntityRole = "removal"
+)
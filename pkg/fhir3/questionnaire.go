@@ -0,0 +1,249 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Questionnaire represents a structured set of questions
+type Questionnaire struct {
+	DomainResource
+
+	// When the questionnaire was approved by publisher
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// Concept that represents the overall questionnaire
+	Code []common.Coding `json:"code,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the questionnaire
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// When the questionnaire is expected to be used
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Business identifier for questionnaire
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Questions and sections within the Questionnaire
+	Item []QuestionnaireItem `json:"item,omitempty"`
+
+	// Intended jurisdiction for questionnaire (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// When the questionnaire was last reviewed by the publisher
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// Name for this questionnaire (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this questionnaire is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        QuestionnaireStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Resource that can be subject of QuestionnaireResponse
+	SubjectType        []string          `json:"subjectType,omitempty"`
+	SubjectTypeElement []*common.Element `json:"_subjectType,omitempty"`
+
+	// Name for this questionnaire (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this questionnaire, represented as an absolute URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the questionnaire
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// QuestionnaireStatus represents the status of a questionnaire
+type QuestionnaireStatus string
+
+const (
+	QuestionnaireStatusDraft   QuestionnaireStatus = "draft"
+	QuestionnaireStatusActive  QuestionnaireStatus = "active"
+	QuestionnaireStatusRetired QuestionnaireStatus = "retired"
+	QuestionnaireStatusUnknown QuestionnaireStatus = "unknown"
+)
+
+// QuestionnaireItemEnableWhen represents only allow data when
+type QuestionnaireItemEnableWhen struct {
+	common.BackboneElement
+
+	// Value for question comparison based on operator
+	AnswerBoolean         *bool             `json:"answerBoolean,omitempty"`
+	AnswerBooleanElement  *common.Element   `json:"_answerBoolean,omitempty"`
+	AnswerDecimal         *common.Decimal   `json:"answerDecimal,omitempty"`
+	AnswerDecimalElement  *common.Element   `json:"_answerDecimal,omitempty"`
+	AnswerInteger         *int              `json:"answerInteger,omitempty"`
+	AnswerIntegerElement  *common.Element   `json:"_answerInteger,omitempty"`
+	AnswerDate            *common.Date      `json:"answerDate,omitempty"`
+	AnswerDateElement     *common.Element   `json:"_answerDate,omitempty"`
+	AnswerDateTime        *common.DateTime  `json:"answerDateTime,omitempty"`
+	AnswerDateTimeElement *common.Element   `json:"_answerDateTime,omitempty"`
+	AnswerTime            *common.Time      `json:"answerTime,omitempty"`
+	AnswerTimeElement     *common.Element   `json:"_answerTime,omitempty"`
+	AnswerString          *string           `json:"answerString,omitempty"`
+	AnswerStringElement   *common.Element   `json:"_answerString,omitempty"`
+	AnswerUri             *string           `json:"answerUri,omitempty"`
+	AnswerUriElement      *common.Element   `json:"_answerUri,omitempty"`
+	AnswerAttachment      *Attachment       `json:"answerAttachment,omitempty"`
+	AnswerCoding          *common.Coding    `json:"answerCoding,omitempty"`
+	AnswerQuantity        *common.Quantity  `json:"answerQuantity,omitempty"`
+	AnswerReference       *common.Reference `json:"answerReference,omitempty"`
+
+	// If answered=false and answer values are also provided, then they are treated as "or"
+	HasAnswer        *bool           `json:"hasAnswer,omitempty"`
+	HasAnswerElement *common.Element `json:"_hasAnswer,omitempty"`
+
+	// The linkId of question that determines whether item is enabled/disabled
+	Question        string          `json:"question"`
+	QuestionElement *common.Element `json:"_question,omitempty"`
+}
+
+// QuestionnaireItemOption represents the option of a questionnaire item
+type QuestionnaireItemOption struct {
+	common.BackboneElement
+
+	// The data type of the value must agree with the item.type
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+	ValueDate           *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement    *common.Element `json:"_valueDate,omitempty"`
+	ValueTime           *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement    *common.Element `json:"_valueTime,omitempty"`
+	ValueString         *string         `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element `json:"_valueString,omitempty"`
+	ValueCoding         *common.Coding  `json:"valueCoding,omitempty"`
+}
+
+// QuestionnaireItem represents questions and sections within the Questionnaire
+type QuestionnaireItem struct {
+	common.BackboneElement
+
+	// Corresponding concept for this item in a terminology
+	Code []common.Coding `json:"code,omitempty"`
+
+	// ElementDefinition - details for the item
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// Only allow data when
+	EnableWhen []QuestionnaireItemEnableWhen `json:"enableWhen,omitempty"`
+
+	// The user is allowed to change the value and override the default (unless marked as read-only)
+	InitialBoolean         *bool             `json:"initialBoolean,omitempty"`
+	InitialBooleanElement  *common.Element   `json:"_initialBoolean,omitempty"`
+	InitialDecimal         *common.Decimal   `json:"initialDecimal,omitempty"`
+	InitialDecimalElement  *common.Element   `json:"_initialDecimal,omitempty"`
+	InitialInteger         *int              `json:"initialInteger,omitempty"`
+	InitialIntegerElement  *common.Element   `json:"_initialInteger,omitempty"`
+	InitialDate            *common.Date      `json:"initialDate,omitempty"`
+	InitialDateElement     *common.Element   `json:"_initialDate,omitempty"`
+	InitialDateTime        *common.DateTime  `json:"initialDateTime,omitempty"`
+	InitialDateTimeElement *common.Element   `json:"_initialDateTime,omitempty"`
+	InitialTime            *common.Time      `json:"initialTime,omitempty"`
+	InitialTimeElement     *common.Element   `json:"_initialTime,omitempty"`
+	InitialString          *string           `json:"initialString,omitempty"`
+	InitialStringElement   *common.Element   `json:"_initialString,omitempty"`
+	InitialUri             *string           `json:"initialUri,omitempty"`
+	InitialUriElement      *common.Element   `json:"_initialUri,omitempty"`
+	InitialAttachment      *Attachment       `json:"initialAttachment,omitempty"`
+	InitialCoding          *common.Coding    `json:"initialCoding,omitempty"`
+	InitialQuantity        *common.Quantity  `json:"initialQuantity,omitempty"`
+	InitialReference       *common.Reference `json:"initialReference,omitempty"`
+
+	// There is no specified limit to the depth of nesting
+	Item []QuestionnaireItem `json:"item,omitempty"`
+
+	// Unique id for item in questionnaire
+	LinkId        string          `json:"linkId"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
+
+	// No more than these many characters
+	MaxLength        *int            `json:"maxLength,omitempty"`
+	MaxLengthElement *common.Element `json:"_maxLength,omitempty"`
+
+	// This element can be used when the value set machinery of options is deemed too cumbersome or when there's a need to capture options that are not codes
+	Option []QuestionnaireItemOption `json:"option,omitempty"`
+
+	// LOINC defines many useful value sets for questionnaire responses
+	Options *common.Reference `json:"options,omitempty"`
+
+	// E.g. "1(a)", "2.5.3"
+	Prefix        *string         `json:"prefix,omitempty"`
+	PrefixElement *common.Element `json:"_prefix,omitempty"`
+
+	// Don't allow human editing
+	ReadOnly        *bool           `json:"readOnly,omitempty"`
+	ReadOnlyElement *common.Element `json:"_readOnly,omitempty"`
+
+	// Whether the item may repeat
+	Repeats        *bool           `json:"repeats,omitempty"`
+	RepeatsElement *common.Element `json:"_repeats,omitempty"`
+
+	// Whether the item must be included in data results
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
+
+	// Primary text for the item
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// group | display | boolean | decimal | integer | date | dateTime +
+	Type        QuestionnaireItemType `json:"type"`
+	TypeElement *common.Element       `json:"_type,omitempty"`
+}
+
+// QuestionnaireItemType represents the type of a questionnaire item
+type QuestionnaireItemType string
+
+const (
+	QuestionnaireItemTypeGroup      QuestionnaireItemType = "group"
+	QuestionnaireItemTypeDisplay    QuestionnaireItemType = "display"
+	QuestionnaireItemTypeQuestion   QuestionnaireItemType = "question"
+	QuestionnaireItemTypeBoolean    QuestionnaireItemType = "boolean"
+	QuestionnaireItemTypeDecimal    QuestionnaireItemType = "decimal"
+	QuestionnaireItemTypeInteger    QuestionnaireItemType = "integer"
+	QuestionnaireItemTypeDate       QuestionnaireItemType = "date"
+	QuestionnaireItemTypeDateTime   QuestionnaireItemType = "dateTime"
+	QuestionnaireItemTypeTime       QuestionnaireItemType = "time"
+	QuestionnaireItemTypeString     QuestionnaireItemType = "string"
+	QuestionnaireItemTypeText       QuestionnaireItemType = "text"
+	QuestionnaireItemTypeUrl        QuestionnaireItemType = "url"
+	QuestionnaireItemTypeChoice     QuestionnaireItemType = "choice"
+	QuestionnaireItemTypeOpenChoice QuestionnaireItemType = "open-choice"
+	QuestionnaireItemTypeAttachment QuestionnaireItemType = "attachment"
+	QuestionnaireItemTypeReference  QuestionnaireItemType = "reference"
+	QuestionnaireItemTypeQuantity   QuestionnaireItemType = "quantity"
+)
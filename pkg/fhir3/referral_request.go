@@ -0,0 +1,124 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ReferralRequest represents used to record and send details about a request for referral service or transfer of a patient to the care of another provider or provider organization
+type ReferralRequest struct {
+	DomainResource
+
+	// Date/DateTime of creation for draft requests and date of activation for active requests
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// Indicates any plans, proposals or orders that this request is intended to satisfy - in whole or in part
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// The encounter at which the request for referral or transfer of care is initiated
+	Context *common.Reference `json:"context,omitempty"`
+
+	// A protocol, guideline, orderset or other definition that is adhered to in whole or in part by this request
+	Definition []common.Reference `json:"definition,omitempty"`
+
+	// The reason element gives a short description of why the referral is being made
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The business identifier of the logical "grouping" request/order that this referral is a part of
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	// Business identifier that uniquely identifies the referral/care transfer request instance
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Distinguishes the "level" of authorization/demand implicit in this request
+	Intent        ReferralRequestIntent `json:"intent"`
+	IntentElement *common.Element       `json:"_intent,omitempty"`
+
+	// Comments made about the referral request by any of the participants
+	Note []Annotation `json:"note,omitempty"`
+
+	// When the occurrenceDateTime is used
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+
+	// An indication of the urgency of referral (or where applicable the type of transfer of care) request
+	Priority        *string         `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// Description of clinical condition indicating why referral/transfer of care is requested
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Indicates another resource whose existence justifies this request
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// There will be a primary receiver
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// This element does not point to the Provenance associated with the *current* version of the resource
+	RelevantHistory []common.Reference `json:"relevantHistory,omitempty"`
+
+	// The replacement could be because the initial request was immediately rejected
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	// The individual who initiated the request and has responsibility for its activation
+	Requester *ReferralRequestRequester `json:"requester,omitempty"`
+
+	// The service(s) that is/are requested to be provided to the patient
+	ServiceRequested []common.CodeableConcept `json:"serviceRequested,omitempty"`
+
+	// Indication of the clinical domain or discipline to which the referral or transfer of care request is sent
+	Specialty *common.CodeableConcept `json:"specialty,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        ReferralRequestStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// Referral of family, group or community is to be catered for by profiles
+	Subject *common.Reference `json:"subject"`
+
+	// Any additional (administrative, financial or clinical) information required to support request for referral or transfer of care
+	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
+
+	// An indication of the type of referral (or where applicable the type of transfer of care) request
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ReferralRequestIntent represents the intent of a referral request
+type ReferralRequestIntent string
+
+const (
+	ReferralRequestIntentProposal      ReferralRequestIntent = "proposal"
+	ReferralRequestIntentPlan          ReferralRequestIntent = "plan"
+	ReferralRequestIntentOrder         ReferralRequestIntent = "order"
+	ReferralRequestIntentOriginalOrder ReferralRequestIntent = "original-order"
+	ReferralRequestIntentReflexOrder   ReferralRequestIntent = "reflex-order"
+	ReferralRequestIntentFillerOrder   ReferralRequestIntent = "filler-order"
+	ReferralRequestIntentInstanceOrder ReferralRequestIntent = "instance-order"
+	ReferralRequestIntentOption        ReferralRequestIntent = "option"
+)
+
+// ReferralRequestStatus represents the status of a referral request
+type ReferralRequestStatus string
+
+const (
+	ReferralRequestStatusDraft          ReferralRequestStatus = "draft"
+	ReferralRequestStatusActive         ReferralRequestStatus = "active"
+	ReferralRequestStatusSuspended      ReferralRequestStatus = "suspended"
+	ReferralRequestStatusCancelled      ReferralRequestStatus = "cancelled"
+	ReferralRequestStatusCompleted      ReferralRequestStatus = "completed"
+	ReferralRequestStatusEnteredInError ReferralRequestStatus = "entered-in-error"
+	ReferralRequestStatusUnknown        ReferralRequestStatus = "unknown"
+)
+
+// ReferralRequestRequester represents the requester of a referral request
+type ReferralRequestRequester struct {
+	common.BackboneElement
+
+	// The device, practitioner, etc. who initiated the request
+	Agent *common.Reference `json:"agent"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
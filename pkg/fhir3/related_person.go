@@ -0,0 +1,46 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RelatedPerson represents a person that is related to a patient, but who is not a direct target of care
+type RelatedPerson struct {
+	DomainResource
+
+	// Whether this related person's record is in active use
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// Address where the related person can be contacted or visited
+	Address []Address `json:"address,omitempty"`
+
+	// The date on which the related person was born
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
+
+	// male | female | other | unknown
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
+
+	// A human identifier for this person
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// A name associated with the person
+	Name []HumanName `json:"name,omitempty"`
+
+	// The patient this person is related to
+	Patient *common.Reference `json:"patient"`
+
+	// Period of time that this relationship is considered valid
+	Period *common.Period `json:"period,omitempty"`
+
+	// Image of the person
+	Photo []Attachment `json:"photo,omitempty"`
+
+	// The relationship of the related person to the patient
+	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
+
+	// A contact detail for the person
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
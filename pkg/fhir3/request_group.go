@@ -0,0 +1,278 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RequestGroup represents a group of related requests that can be used to capture intended activities that have inter-dependencies such as "give this medication after that one"
+type RequestGroup struct {
+	DomainResource
+
+	// The actions, if any, produced by the evaluation of the artifact
+	Action []RequestGroupAction `json:"action,omitempty"`
+
+	// Provides a reference to the author of the request group
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Indicates when the request group was created
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// A plan, proposal or order that is fulfilled in whole or in part by this request
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Describes the context of the request group, if any
+	Context *common.Reference `json:"context,omitempty"`
+
+	// A protocol, guideline, orderset or other definition that is adhered to in whole or in part by this request
+	Definition []common.Reference `json:"definition,omitempty"`
+
+	// Requests are linked either by a "basedOn" relationship
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	// Allows a service to provide a unique, business identifier for the request
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// This element is labeled as a modifier because the intent alters when and how the resource is actually applicable
+	Intent        RequestGroupIntent `json:"intent"`
+	IntentElement *common.Element    `json:"_intent,omitempty"`
+
+	// Provides a mechanism to communicate additional information about the response
+	Note []Annotation `json:"note,omitempty"`
+
+	// Indicates how quickly the request should be addressed with respect to other requests
+	Priority        *RequestGroupPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element       `json:"_priority,omitempty"`
+
+	// Indicates the reason the request group was created
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// The replacement could be because the initial request was immediately rejected
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        RequestGroupStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// The subject for which the request group was created
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// RequestGroupIntent represents the intent of a request group
+type RequestGroupIntent string
+
+const (
+	RequestGroupIntentProposal      RequestGroupIntent = "proposal"
+	RequestGroupIntentPlan          RequestGroupIntent = "plan"
+	RequestGroupIntentOrder         RequestGroupIntent = "order"
+	RequestGroupIntentOriginalOrder RequestGroupIntent = "original-order"
+	RequestGroupIntentReflexOrder   RequestGroupIntent = "reflex-order"
+	RequestGroupIntentFillerOrder   RequestGroupIntent = "filler-order"
+	RequestGroupIntentInstanceOrder RequestGroupIntent = "instance-order"
+	RequestGroupIntentOption        RequestGroupIntent = "option"
+)
+
+// RequestGroupPriority represents the priority of a request group
+type RequestGroupPriority string
+
+const (
+	RequestGroupPriorityRoutine RequestGroupPriority = "routine"
+	RequestGroupPriorityUrgent  RequestGroupPriority = "urgent"
+	RequestGroupPriorityAsap    RequestGroupPriority = "asap"
+	RequestGroupPriorityStat    RequestGroupPriority = "stat"
+)
+
+// RequestGroupStatus represents the status of a request group
+type RequestGroupStatus string
+
+const (
+	RequestGroupStatusDraft          RequestGroupStatus = "draft"
+	RequestGroupStatusActive         RequestGroupStatus = "active"
+	RequestGroupStatusSuspended      RequestGroupStatus = "suspended"
+	RequestGroupStatusCancelled      RequestGroupStatus = "cancelled"
+	RequestGroupStatusCompleted      RequestGroupStatus = "completed"
+	RequestGroupStatusEnteredInError RequestGroupStatus = "entered-in-error"
+	RequestGroupStatusUnknown        RequestGroupStatus = "unknown"
+)
+
+// RequestGroupActionCondition represents an expression that describes applicability criteria, or start/stop conditions for the action
+type RequestGroupActionCondition struct {
+	common.BackboneElement
+
+	// A brief, natural language description of the condition that effectively communicates the intended semantics
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The expression may be inlined, or may be a reference to a named expression within a logic library referenced by the library element
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// Applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
+	Kind        RequestGroupActionConditionKind `json:"kind"`
+	KindElement *common.Element                 `json:"_kind,omitempty"`
+
+	// The media type of the language for the expression
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+}
+
+// RequestGroupActionConditionKind represents the kind of a request group action condition
+type RequestGroupActionConditionKind string
+
+const (
+	RequestGroupActionConditionKindApplicability RequestGroupActionConditionKind = "applicability"
+	RequestGroupActionConditionKindStart         RequestGroupActionConditionKind = "start"
+	RequestGroupActionConditionKindStop          RequestGroupActionConditionKind = "stop"
+)
+
+// RequestGroupActionRelatedAction represents a relationship to another action such as "before" or "30-60 minutes after start of"
+type RequestGroupActionRelatedAction struct {
+	common.BackboneElement
+
+	// The element id of the action this is related to
+	ActionId        string          `json:"actionId"`
+	ActionIdElement *common.Element `json:"_actionId,omitempty"`
+
+	// A duration or range of durations to apply to the relationship
+	OffsetDuration *Duration `json:"offsetDuration,omitempty"`
+	OffsetRange    *Range    `json:"offsetRange,omitempty"`
+
+	// The relationship of this action to the related action
+	Relationship        RequestGroupActionRelatedActionRelationship `json:"relationship"`
+	RelationshipElement *common.Element                             `json:"_relationship,omitempty"`
+}
+
+// RequestGroupActionRelatedActionRelationship represents the relationship of a request group action related action
+type RequestGroupActionRelatedActionRelationship string
+
+const (
+	RequestGroupActionRelatedActionRelationshipBeforeStart         RequestGroupActionRelatedActionRelationship = "before-start"
+	RequestGroupActionRelatedActionRelationshipBefore              RequestGroupActionRelatedActionRelationship = "before"
+	RequestGroupActionRelatedActionRelationshipBeforeEnd           RequestGroupActionRelatedActionRelationship = "before-end"
+	RequestGroupActionRelatedActionRelationshipConcurrentWithStart RequestGroupActionRelatedActionRelationship = "concurrent-with-start"
+	RequestGroupActionRelatedActionRelationshipConcurrent          RequestGroupActionRelatedActionRelationship = "concurrent"
+	RequestGroupActionRelatedActionRelationshipConcurrentWithEnd   RequestGroupActionRelatedActionRelationship = "concurrent-with-end"
+	RequestGroupActionRelatedActionRelationshipAfterStart          RequestGroupActionRelatedActionRelationship = "after-start"
+	RequestGroupActionRelatedActionRelationshipAfter               RequestGroupActionRelatedActionRelationship = "after"
+	RequestGroupActionRelatedActionRelationshipAfterEnd            RequestGroupActionRelatedActionRelationship = "after-end"
+)
+
+// RequestGroupAction represents the action of a request group action
+type RequestGroupAction struct {
+	common.BackboneElement
+
+	// Sub actions
+	Action []RequestGroupAction `json:"action,omitempty"`
+
+	// Defines whether the action can be selected multiple times
+	CardinalityBehavior        *RequestGroupActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                        `json:"_cardinalityBehavior,omitempty"`
+
+	// A code that provides meaning for the action or action group
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// An expression that describes applicability criteria, or start/stop conditions for the action
+	Condition []RequestGroupActionCondition `json:"condition,omitempty"`
+
+	// A short description of the action used to provide a summary to display to the user
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Didactic or other informational resources associated with the action that can be provided to the CDS recipient
+	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
+
+	// Defines the grouping behavior for the action and its children
+	GroupingBehavior        *RequestGroupActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement *common.Element                     `json:"_groupingBehavior,omitempty"`
+
+	// A user-visible label for the action
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// The participant that should perform or be responsible for this action
+	Participant []common.Reference `json:"participant,omitempty"`
+
+	// Defines whether the action should usually be preselected
+	PrecheckBehavior        *RequestGroupActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement *common.Element                     `json:"_precheckBehavior,omitempty"`
+
+	// A relationship to another action such as "before" or "30-60 minutes after start of"
+	RelatedAction []RequestGroupActionRelatedAction `json:"relatedAction,omitempty"`
+
+	// Defines the requiredness behavior for the action
+	RequiredBehavior        *RequestGroupActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement *common.Element                     `json:"_requiredBehavior,omitempty"`
+
+	// The target resource SHALL be a Request resource with a Request.intent set to "option"
+	Resource *common.Reference `json:"resource,omitempty"`
+
+	// Defines the selection behavior for the action and its children
+	SelectionBehavior        *RequestGroupActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement *common.Element                      `json:"_selectionBehavior,omitempty"`
+
+	// A text equivalent of the action to be performed
+	TextEquivalent        *string         `json:"textEquivalent,omitempty"`
+	TextEquivalentElement *common.Element `json:"_textEquivalent,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingDuration        *Duration        `json:"timingDuration,omitempty"`
+	TimingRange           *Range           `json:"timingRange,omitempty"`
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+
+	// The title of the action displayed to a user
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// The type of action to perform (create, update, remove)
+	Type *common.Coding `json:"type,omitempty"`
+}
+
+// RequestGroupActionCardinalityBehavior represents the cardinality behavior of a request group action
+type RequestGroupActionCardinalityBehavior string
+
+const (
+	RequestGroupActionCardinalityBehaviorSingle   RequestGroupActionCardinalityBehavior = "single"
+	RequestGroupActionCardinalityBehaviorMultiple RequestGroupActionCardinalityBehavior = "multiple"
+)
+
+// RequestGroupActionGroupingBehavior represents the grouping behavior of a request group action
+type RequestGroupActionGroupingBehavior string
+
+const (
+	RequestGroupActionGroupingBehaviorVisualGroup   RequestGroupActionGroupingBehavior = "visual-group"
+	RequestGroupActionGroupingBehaviorLogicalGroup  RequestGroupActionGroupingBehavior = "logical-group"
+	RequestGroupActionGroupingBehaviorSentenceGroup RequestGroupActionGroupingBehavior = "sentence-group"
+)
+
+// RequestGroupActionPrecheckBehavior represents the precheck behavior of a request group action
+type RequestGroupActionPrecheckBehavior string
+
+const (
+	RequestGroupActionPrecheckBehaviorYes RequestGroupActionPrecheckBehavior = "yes"
+	RequestGroupActionPrecheckBehaviorNo  RequestGroupActionPrecheckBehavior = "no"
+)
+
+// RequestGroupActionRequiredBehavior represents the required behavior of a request group action
+type RequestGroupActionRequiredBehavior string
+
+const (
+	RequestGroupActionRequiredBehaviorMust                 RequestGroupActionRequiredBehavior = "must"
+	RequestGroupActionRequiredBehaviorCould                RequestGroupActionRequiredBehavior = "could"
+	RequestGroupActionRequiredBehaviorMustUnlessDocumented RequestGroupActionRequiredBehavior = "must-unless-documented"
+)
+
+// RequestGroupActionSelectionBehavior represents the selection behavior of a request group action
+type RequestGroupActionSelectionBehavior string
+
+const (
+	RequestGroupActionSelectionBehaviorAny        RequestGroupActionSelectionBehavior = "any"
+	RequestGroupActionSelectionBehaviorAll        RequestGroupActionSelectionBehavior = "all"
+	RequestGroupActionSelectionBehaviorAllOrNone  RequestGroupActionSelectionBehavior = "all-or-none"
+	RequestGroupActionSelectionBehaviorExactlyOne RequestGroupActionSelectionBehavior = "exactly-one"
+	RequestGroupActionSelectionBehaviorAtMostOne  RequestGroupActionSelectionBehavior = "at-most-one"
+	RequestGroupActionSelectionBehaviorOneOrMore  RequestGroupActionSelectionBehavior = "one-or-more"
+)
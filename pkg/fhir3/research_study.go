@@ -0,0 +1,101 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ResearchStudy represents investigation to increase healthcare-related patient-independent knowledge
+type ResearchStudy struct {
+	DomainResource
+
+	// Describes an expected sequence of events for one of the participants of a study
+	Arm []ResearchStudyArm `json:"arm,omitempty"`
+
+	// Codes categorizing the type of study such as investigational vs
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Contact details to assist a user in learning more about or engaging with the study
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Detailed narrative of the study
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The Group referenced should not generally enumerate specific subjects
+	Enrollment []common.Reference `json:"enrollment,omitempty"`
+
+	// Drugs, devices, etc. under study
+	Focus []common.CodeableConcept `json:"focus,omitempty"`
+
+	// Business Identifier for study
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Indicates a country, state or other region where the study is taking place
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Used to search for the study
+	Keyword []common.CodeableConcept `json:"keyword,omitempty"`
+
+	// Comments made about the study
+	Note []Annotation `json:"note,omitempty"`
+
+	// Part of larger study
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// When the study began and ended
+	Period *common.Period `json:"period,omitempty"`
+
+	// Indicates the individual who has primary oversite of the execution of the study
+	PrincipalInvestigator *common.Reference `json:"principalInvestigator,omitempty"`
+
+	// Steps followed in executing study
+	Protocol []common.Reference `json:"protocol,omitempty"`
+
+	// A description and/or code explaining the premature termination of the study
+	ReasonStopped *common.CodeableConcept `json:"reasonStopped,omitempty"`
+
+	// References, URLs, and attachments
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// Facility where study activities are conducted
+	Site []common.Reference `json:"site,omitempty"`
+
+	// The organization responsible for the execution of the study
+	Sponsor *common.Reference `json:"sponsor,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ResearchStudyStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Human readable name of the study
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// ResearchStudyStatus represents the status of a research study
+type ResearchStudyStatus string
+
+const (
+	ResearchStudyStatusDraft          ResearchStudyStatus = "draft"
+	ResearchStudyStatusInProgress     ResearchStudyStatus = "in-progress"
+	ResearchStudyStatusSuspended      ResearchStudyStatus = "suspended"
+	ResearchStudyStatusStopped        ResearchStudyStatus = "stopped"
+	ResearchStudyStatusCompleted      ResearchStudyStatus = "completed"
+	ResearchStudyStatusEnteredInError ResearchStudyStatus = "entered-in-error"
+)
+
+// ResearchStudyArm describes an expected sequence of events for one of the participants of a study
+type ResearchStudyArm struct {
+	common.BackboneElement
+
+	// Categorization of study arm, e.g. experimental, active comparator, placebo comparater
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// A succinct description of the path through the study that would be followed by a subject adhering to this arm
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Unique, human-readable label for this arm of the study
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
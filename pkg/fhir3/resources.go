@@ -2,6 +2,7 @@
 package fhir3
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/d4l-data4life/go-fhir/pkg/common"
@@ -21,16 +22,19 @@ type Resource struct {
 	ResourceType string `json:"resourceType"`
 
 	// Logical id of this artifact
-	ID *string `json:"id,omitempty"`
+	ID        *string         `json:"id,omitempty"`
+	IDElement *common.Element `json:"_id,omitempty"`
 
 	// Metadata about the resource
 	Meta *Meta `json:"meta,omitempty"`
 
 	// A set of rules under which this content was created
-	ImplicitRules *string `json:"implicitRules,omitempty"`
+	ImplicitRules        *string         `json:"implicitRules,omitempty"`
+	ImplicitRulesElement *common.Element `json:"_implicitRules,omitempty"`
 
 	// Language of the resource content
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 }
 
 // DomainResource represents a resource that includes narrative, extensions, and contained resources
@@ -41,7 +45,7 @@ type DomainResource struct {
 	Text *Narrative `json:"text,omitempty"`
 
 	// Contained, inline Resources
-	Contained []interface{} `json:"contained,omitempty"`
+	Contained ResourceList `json:"contained,omitempty"`
 
 	// Additional content defined by implementations
 	Extension []common.Extension `json:"extension,omitempty"`
@@ -55,13 +59,16 @@ type Meta struct {
 	common.Element
 
 	// Version specific identifier
-	VersionId *string `json:"versionId,omitempty"`
+	VersionId        *string         `json:"versionId,omitempty"`
+	VersionIdElement *common.Element `json:"_versionId,omitempty"`
 
 	// When the resource version last changed
-	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+	LastUpdated        *common.Instant `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *common.Element `json:"_lastUpdated,omitempty"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Security Labels applied to this resource
 	Security []common.Coding `json:"security,omitempty"`
@@ -75,22 +82,28 @@ type HumanName struct {
 	common.Element
 
 	// usual | official | temp | nickname | anonymous | old | maiden
-	Use *HumanNameUse `json:"use,omitempty"`
+	Use        *HumanNameUse   `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 
 	// Text representation of the full name
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Family name (often called 'Surname')
-	Family *string `json:"family,omitempty"`
+	Family        *string         `json:"family,omitempty"`
+	FamilyElement *common.Element `json:"_family,omitempty"`
 
 	// Given names (not always 'first'). Includes middle names
-	Given []string `json:"given,omitempty"`
+	Given        []string          `json:"given,omitempty"`
+	GivenElement []*common.Element `json:"_given,omitempty"`
 
 	// Parts that come before the name
-	Prefix []string `json:"prefix,omitempty"`
+	Prefix        []string          `json:"prefix,omitempty"`
+	PrefixElement []*common.Element `json:"_prefix,omitempty"`
 
 	// Parts that come after the name
-	Suffix []string `json:"suffix,omitempty"`
+	Suffix        []string          `json:"suffix,omitempty"`
+	SuffixElement []*common.Element `json:"_suffix,omitempty"`
 
 	// Time period when name was/is in use
 	Period *common.Period `json:"period,omitempty"`
@@ -114,23 +127,28 @@ type Patient struct {
 	DomainResource
 
 	// Whether this patient's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses for the individual
 	Address []Address `json:"address,omitempty"`
 
 	// The date of birth for the individual
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// A contact party (e.g. guardian, partner, friend) for the patient
 	Contact []PatientContact `json:"contact,omitempty"`
 
 	// Indicates if the individual is deceased or not
-	DeceasedBoolean  *bool      `json:"deceasedBoolean,omitempty"`
-	DeceasedDateTime *time.Time `json:"deceasedDateTime,omitempty"`
+	DeceasedBoolean         *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement  *common.Element  `json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime        *common.DateTime `json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement *common.Element  `json:"_deceasedDateTime,omitempty"`
 
 	// male | female | other | unknown
-	Gender *PatientGender `json:"gender,omitempty"`
+	Gender        *PatientGender  `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// Identifier for this individual
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -145,8 +163,10 @@ type Patient struct {
 	MaritalStatus *common.CodeableConcept `json:"maritalStatus,omitempty"`
 
 	// Whether patient is part of a multiple birth
-	MultipleBirthBoolean *bool `json:"multipleBirthBoolean,omitempty"`
-	MultipleBirthInteger *int  `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthBoolean        *bool           `json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *common.Element `json:"_multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger        *int            `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *common.Element `json:"_multipleBirthInteger,omitempty"`
 
 	// A name associated with the patient
 	Name []HumanName `json:"name,omitempty"`
@@ -166,7 +186,8 @@ type PatientContact struct {
 	Address *Address `json:"address,omitempty"`
 
 	// male | female | other | unknown
-	Gender *PatientGender `json:"gender,omitempty"`
+	Gender        *PatientGender  `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// A name associated with the contact person
 	Name *HumanName `json:"name,omitempty"`
@@ -186,7 +207,8 @@ type PatientLink struct {
 	Other *common.Reference `json:"other"`
 
 	// replaced-by | replaces | refer | seealso
-	Type PatientLinkType `json:"type"`
+	Type        PatientLinkType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // PatientGender represents the gender of a patient
@@ -226,10 +248,12 @@ type Bundle struct {
 	Signature *Signature `json:"signature,omitempty"`
 
 	// Only used if the bundle is a search result set
-	Total *int `json:"total,omitempty"`
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
 
 	// document | message | transaction | transaction-response | batch | batch-response | history | searchset | collection
-	Type BundleType `json:"type"`
+	Type        BundleType      `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // BundleEntry represents an entry in a bundle resource (R3 version)
@@ -237,7 +261,8 @@ type BundleEntry struct {
 	common.BackboneElement
 
 	// URI for resource (Absolute or relative)
-	FullURL *string `json:"fullUrl,omitempty"`
+	FullURL        *string         `json:"fullUrl,omitempty"`
+	FullURLElement *common.Element `json:"_fullUrl,omitempty"`
 
 	// Links related to this entry
 	Link []BundleLink `json:"link,omitempty"`
@@ -246,7 +271,7 @@ type BundleEntry struct {
 	Request *BundleEntryRequest `json:"request,omitempty"`
 
 	// The Resource for the entry
-	Resource interface{} `json:"resource,omitempty"`
+	Resource common.Resource `json:"resource,omitempty"`
 
 	// Transaction Related Information
 	Response *BundleEntryResponse `json:"response,omitempty"`
@@ -260,10 +285,12 @@ type BundleLink struct {
 	common.BackboneElement
 
 	// See http://www.iana.org/assignments/link-relations/link-relations.xhtml#link-relations-1
-	Relation string `json:"relation"`
+	Relation        string          `json:"relation"`
+	RelationElement *common.Element `json:"_relation,omitempty"`
 
 	// Reference details for the link
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntryRequest represents additional execution information
@@ -271,22 +298,28 @@ type BundleEntryRequest struct {
 	common.BackboneElement
 
 	// For managing update contention
-	IfMatch *string `json:"ifMatch,omitempty"`
+	IfMatch        *string         `json:"ifMatch,omitempty"`
+	IfMatchElement *common.Element `json:"_ifMatch,omitempty"`
 
 	// For managing update contention
-	IfModifiedSince *time.Time `json:"ifModifiedSince,omitempty"`
+	IfModifiedSince        *common.Instant `json:"ifModifiedSince,omitempty"`
+	IfModifiedSinceElement *common.Element `json:"_ifModifiedSince,omitempty"`
 
 	// For conditional creates
-	IfNoneExist *string `json:"ifNoneExist,omitempty"`
+	IfNoneExist        *string         `json:"ifNoneExist,omitempty"`
+	IfNoneExistElement *common.Element `json:"_ifNoneExist,omitempty"`
 
 	// For conditional read
-	IfNoneMatch *string `json:"ifNoneMatch,omitempty"`
+	IfNoneMatch        *string         `json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement *common.Element `json:"_ifNoneMatch,omitempty"`
 
 	// GET | POST | PUT | DELETE
-	Method BundleEntryRequestMethod `json:"method"`
+	Method        BundleEntryRequestMethod `json:"method"`
+	MethodElement *common.Element          `json:"_method,omitempty"`
 
 	// URL for HTTP equivalent of this entry
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntryResponse represents transaction related information
@@ -294,19 +327,23 @@ type BundleEntryResponse struct {
 	common.BackboneElement
 
 	// The Etag for the resource
-	Etag *string `json:"etag,omitempty"`
+	Etag        *string         `json:"etag,omitempty"`
+	EtagElement *common.Element `json:"_etag,omitempty"`
 
 	// Server's date time modified
-	LastModified *time.Time `json:"lastModified,omitempty"`
+	LastModified        *common.Instant `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element `json:"_lastModified,omitempty"`
 
 	// The location (if the operation returns a location)
-	Location *string `json:"location,omitempty"`
+	Location        *string         `json:"location,omitempty"`
+	LocationElement *common.Element `json:"_location,omitempty"`
 
 	// OperationOutcome with hints and warnings
-	Outcome interface{} `json:"outcome,omitempty"`
+	Outcome common.Resource `json:"outcome,omitempty"`
 
 	// Status response code
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // BundleEntrySearch represents search related information
@@ -314,10 +351,12 @@ type BundleEntrySearch struct {
 	common.BackboneElement
 
 	// match | include | outcome - why this is in the result set
-	Mode *BundleEntrySearchMode `json:"mode,omitempty"`
+	Mode        *BundleEntrySearchMode `json:"mode,omitempty"`
+	ModeElement *common.Element        `json:"_mode,omitempty"`
 
 	// Search ranking
-	Score *float64 `json:"score,omitempty"`
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
 }
 
 // Bundle-related enums
@@ -357,7 +396,8 @@ type Organization struct {
 	DomainResource
 
 	// Whether the organization's record is still in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses for the organization
 	Address []Address `json:"address,omitempty"`
@@ -372,7 +412,8 @@ type Organization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Name used for the organization
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The organization of which this organization forms a part
 	PartOf *common.Reference `json:"partOf,omitempty"`
@@ -406,19 +447,22 @@ type Practitioner struct {
 	DomainResource
 
 	// Whether this practitioner's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses where the practitioner can be found or visited or to which mail can be delivered
 	Address []Address `json:"address,omitempty"`
 
 	// The date of birth for the practitioner
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// A language the practitioner can use in patient communication
 	Communication []common.CodeableConcept `json:"communication,omitempty"`
 
 	// male | female | other | unknown
-	Gender *PractitionerGender `json:"gender,omitempty"`
+	Gender        *PractitionerGender `json:"gender,omitempty"`
+	GenderElement *common.Element     `json:"_gender,omitempty"`
 
 	// An identifier for this practitioner
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -522,7 +566,8 @@ type Encounter struct {
 	ServiceProvider *common.Reference `json:"serviceProvider,omitempty"`
 
 	// Note that internal business rules will determine the appropriate transitions
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The current status is always found in the current version of the resource
 	StatusHistory []EncounterStatusHistory `json:"statusHistory,omitempty"`
@@ -553,7 +598,8 @@ type EncounterDiagnosis struct {
 	Condition common.Reference `json:"condition"`
 
 	// Ranking of the diagnosis (for each role type)
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// Role that this diagnosis has within the encounter (e.g. admission, billing, discharge) - Note: "role" in R3, "use" in R4+
 	Role *common.CodeableConcept `json:"role,omitempty"`
@@ -602,7 +648,8 @@ type EncounterLocation struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// When the patient is no longer active at a location
-	Status *EncounterLocationStatus `json:"status,omitempty"`
+	Status        *EncounterLocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
 
 // Duration represents a length of time (R3 version)
@@ -654,7 +701,8 @@ type EncounterStatusHistory struct {
 	Period common.Period `json:"period"`
 
 	// planned | arrived | triaged | in-progress | onleave | finished | cancelled | entered-in-error | unknown
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // Condition represents a clinical condition, problem, diagnosis, or other event, situation, issue, or clinical concept
@@ -662,11 +710,13 @@ type Condition struct {
 	DomainResource
 
 	// Estimated or actual date or date-time the condition was resolved
-	AbatementDateTime *time.Time     `json:"abatementDateTime,omitempty"`
-	AbatementAge      *Age           `json:"abatementAge,omitempty"`
-	AbatementPeriod   *common.Period `json:"abatementPeriod,omitempty"`
-	AbatementRange    *Range         `json:"abatementRange,omitempty"`
-	AbatementString   *string        `json:"abatementString,omitempty"`
+	AbatementDateTime        *common.DateTime `json:"abatementDateTime,omitempty"`
+	AbatementDateTimeElement *common.Element  `json:"_abatementDateTime,omitempty"`
+	AbatementAge             *Age             `json:"abatementAge,omitempty"`
+	AbatementPeriod          *common.Period   `json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range           `json:"abatementRange,omitempty"`
+	AbatementString          *string          `json:"abatementString,omitempty"`
+	AbatementStringElement   *common.Element  `json:"_abatementString,omitempty"`
 
 	// Person who asserts this condition
 	Asserter *common.Reference `json:"asserter,omitempty"`
@@ -678,7 +728,8 @@ type Condition struct {
 	Category []common.CodeableConcept `json:"category,omitempty"`
 
 	// active | recurrence | relapse | inactive | remission | resolved
-	ClinicalStatus *ConditionClinicalStatus `json:"clinicalStatus,omitempty"`
+	ClinicalStatus        *ConditionClinicalStatus `json:"clinicalStatus,omitempty"`
+	ClinicalStatusElement *common.Element          `json:"_clinicalStatus,omitempty"`
 
 	// Identification of the condition, problem or diagnosis
 	Code *common.CodeableConcept `json:"code,omitempty"`
@@ -696,11 +747,13 @@ type Condition struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Date record was believed accurate
-	OnsetDateTime *time.Time     `json:"onsetDateTime,omitempty"`
-	OnsetAge      *Age           `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *Age             `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// A subjective assessment of the severity of the condition
 	Severity *common.CodeableConcept `json:"severity,omitempty"`
@@ -712,7 +765,8 @@ type Condition struct {
 	Subject *common.Reference `json:"subject"`
 
 	// provisional | differential | confirmed | refuted | entered-in-error | unknown
-	VerificationStatus *ConditionVerificationStatus `json:"verificationStatus,omitempty"`
+	VerificationStatus        *ConditionVerificationStatus `json:"verificationStatus,omitempty"`
+	VerificationStatusElement *common.Element              `json:"_verificationStatus,omitempty"`
 }
 
 // ConditionEvidence represents supporting evidence
@@ -800,6 +854,13 @@ type Procedure struct {
 	// Where the procedure happened
 	Location *common.Reference `json:"location,omitempty"`
 
+	// True if procedure was not performed as scheduled
+	NotDone        *bool           `json:"notDone,omitempty"`
+	NotDoneElement *common.Element `json:"_notDone,omitempty"`
+
+	// Reason procedure was not performed
+	NotDoneReason *common.CodeableConcept `json:"notDoneReason,omitempty"`
+
 	// Additional information about the procedure
 	Note []Annotation `json:"note,omitempty"`
 
@@ -810,8 +871,9 @@ type Procedure struct {
 	PartOf []common.Reference `json:"partOf,omitempty"`
 
 	// Date/Period the procedure was performed
-	PerformedDateTime *time.Time     `json:"performedDateTime,omitempty"`
-	PerformedPeriod   *common.Period `json:"performedPeriod,omitempty"`
+	PerformedDateTime        *common.DateTime `json:"performedDateTime,omitempty"`
+	PerformedDateTimeElement *common.Element  `json:"_performedDateTime,omitempty"`
+	PerformedPeriod          *common.Period   `json:"performedPeriod,omitempty"`
 
 	// The people who performed the procedure
 	Performer []ProcedurePerformer `json:"performer,omitempty"`
@@ -826,7 +888,8 @@ type Procedure struct {
 	Report []common.Reference `json:"report,omitempty"`
 
 	// preparation | in-progress | suspended | aborted | completed | entered-in-error | unknown
-	Status ProcedureStatus `json:"status"`
+	Status        ProcedureStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Who the procedure was performed on
 	Subject *common.Reference `json:"subject"`
@@ -884,7 +947,8 @@ type Location struct {
 	Address *Address `json:"address,omitempty"`
 
 	// Description of the Location
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Technical endpoints providing access to services operated for the location
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
@@ -896,10 +960,12 @@ type Location struct {
 	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
 
 	// instance | kind
-	Mode *LocationMode `json:"mode,omitempty"`
+	Mode        *LocationMode   `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
 
 	// Name of the location as used by humans
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// What days/times during a week is this location usually open
 	OperationalStatus *common.Coding `json:"operationalStatus,omitempty"`
@@ -914,7 +980,8 @@ type Location struct {
 	Position *LocationPosition `json:"position,omitempty"`
 
 	// active | suspended | inactive
-	Status *LocationStatus `json:"status,omitempty"`
+	Status        *LocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Contact details of the location
 	Telecom []ContactPoint `json:"telecom,omitempty"`
@@ -928,13 +995,16 @@ type LocationPosition struct {
 	common.BackboneElement
 
 	// Altitude with WGS84 datum
-	Altitude *float64 `json:"altitude,omitempty"`
+	Altitude        *common.Decimal `json:"altitude,omitempty"`
+	AltitudeElement *common.Element `json:"_altitude,omitempty"`
 
 	// Latitude with WGS84 datum
-	Latitude float64 `json:"latitude"`
+	Latitude        common.Decimal  `json:"latitude"`
+	LatitudeElement *common.Element `json:"_latitude,omitempty"`
 
 	// Longitude with WGS84 datum
-	Longitude float64 `json:"longitude"`
+	Longitude        common.Decimal  `json:"longitude"`
+	LongitudeElement *common.Element `json:"_longitude,omitempty"`
 }
 
 // Location-related enums
@@ -966,12 +1036,20 @@ type DiagnosticReport struct {
 	// Name/Code for this diagnostic report
 	Code *common.CodeableConcept `json:"code"`
 
+	// Codes for the conclusion
+	CodedDiagnosis []common.CodeableConcept `json:"codedDiagnosis,omitempty"`
+
+	// Clinical Interpretation of test results
+	Conclusion        *string         `json:"conclusion,omitempty"`
+	ConclusionElement *common.Element `json:"_conclusion,omitempty"`
+
 	// Health care event when test ordered
 	Context *common.Reference `json:"context,omitempty"`
 
 	// Clinically relevant time/time-period for report
-	EffectiveDateTime *time.Time     `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
 
 	// Business identifier for report
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -983,7 +1061,8 @@ type DiagnosticReport struct {
 	ImagingStudy []common.Reference `json:"imagingStudy,omitempty"`
 
 	// DateTime this version was released
-	Issued *time.Time `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// Responsible Diagnostic Service
 	Performer []DiagnosticReportPerformer `json:"performer,omitempty"`
@@ -998,7 +1077,8 @@ type DiagnosticReport struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown
-	Status DiagnosticReportStatus `json:"status"`
+	Status        DiagnosticReportStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// The subject of the report - usually, but not always, the patient
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1009,7 +1089,8 @@ type DiagnosticReportImage struct {
 	common.BackboneElement
 
 	// Comment about the image
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Reference to the image source
 	Link *common.Reference `json:"link"`
@@ -1058,6 +1139,10 @@ type Observation struct {
 	// Type of observation (code / type)
 	Code *common.CodeableConcept `json:"code"`
 
+	// Comments about result
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
 	// Why the result is missing
 	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
 
@@ -1065,8 +1150,9 @@ type Observation struct {
 	Device *common.Reference `json:"device,omitempty"`
 
 	// Clinically relevant time/time-period for observation
-	EffectiveDateTime *time.Time     `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
 
 	// Encounter or episode related to observation
 	Context *common.Reference `json:"context,omitempty"`
@@ -1078,7 +1164,8 @@ type Observation struct {
 	Interpretation *common.CodeableConcept `json:"interpretation,omitempty"`
 
 	// Date/Time this was made available
-	Issued *time.Time `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// The observation method
 	Method *common.CodeableConcept `json:"method,omitempty"`
@@ -1099,7 +1186,8 @@ type Observation struct {
 	Specimen *common.Reference `json:"specimen,omitempty"`
 
 	// registered | preliminary | final | amended | corrected | cancelled | entered-in-error | unknown
-	Status ObservationStatus `json:"status"`
+	Status        ObservationStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Who and/or what this is about
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1108,13 +1196,17 @@ type Observation struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
 	ValueSampledData     *SampledData            `json:"valueSampledData,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
-	ValueTime            *string                 `json:"valueTime,omitempty"`
-	ValueDateTime        *time.Time              `json:"valueDateTime,omitempty"`
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
 }
 
@@ -1135,7 +1227,8 @@ type ObservationReferenceRange struct {
 	Low *common.Quantity `json:"low,omitempty"`
 
 	// Text based reference range in an observation
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Reference range qualifier
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -1149,7 +1242,8 @@ type ObservationRelated struct {
 	Target *common.Reference `json:"target"`
 
 	// has-member | derived-from | sequel-to | replaces | qualified-by | interfered-by
-	Type *ObservationRelatedType `json:"type,omitempty"`
+	Type        *ObservationRelatedType `json:"type,omitempty"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
 }
 
 // Observation-related enums
@@ -1194,10 +1288,12 @@ type Medication struct {
 	Ingredient []MedicationIngredient `json:"ingredient,omitempty"`
 
 	// True if a brand
-	IsBrand *bool `json:"isBrand,omitempty"`
+	IsBrand        *bool           `json:"isBrand,omitempty"`
+	IsBrandElement *common.Element `json:"_isBrand,omitempty"`
 
 	// True if medication does not require a prescription
-	IsOverTheCounter *bool `json:"isOverTheCounter,omitempty"`
+	IsOverTheCounter        *bool           `json:"isOverTheCounter,omitempty"`
+	IsOverTheCounterElement *common.Element `json:"_isOverTheCounter,omitempty"`
 
 	// Manufacturer of the item
 	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
@@ -1206,7 +1302,8 @@ type Medication struct {
 	Package *MedicationPackage `json:"package,omitempty"`
 
 	// active | inactive | entered-in-error
-	Status *MedicationStatus `json:"status,omitempty"`
+	Status        *MedicationStatus `json:"status,omitempty"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 }
 
 // MedicationIngredient represents active or inactive ingredient
@@ -1221,7 +1318,8 @@ type MedicationIngredient struct {
 	Amount *Ratio `json:"amount,omitempty"`
 
 	// Active ingredient indicator
-	IsActive *bool `json:"isActive,omitempty"`
+	IsActive        *bool           `json:"isActive,omitempty"`
+	IsActiveElement *common.Element `json:"_isActive,omitempty"`
 }
 
 // MedicationPackage represents details about packaged medications
@@ -1243,10 +1341,12 @@ type MedicationPackageBatch struct {
 	common.BackboneElement
 
 	// When batch will expire
-	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// Identifier assigned to batch
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 }
 
 // MedicationPackageContent represents what is in the package
@@ -1275,7 +1375,8 @@ type MedicationRequest struct {
 	DomainResource
 
 	// When request was initially authored
-	AuthoredOn *time.Time `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Request fulfilled by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -1299,7 +1400,8 @@ type MedicationRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// active | on-hold | cancelled | completed | entered-in-error | stopped | draft | unknown
-	Intent MedicationRequestIntent `json:"intent"`
+	Intent        MedicationRequestIntent `json:"intent"`
+	IntentElement *common.Element         `json:"_intent,omitempty"`
 
 	// Medication to be taken
 	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
@@ -1312,7 +1414,8 @@ type MedicationRequest struct {
 	PriorPrescription *common.Reference `json:"priorPrescription,omitempty"`
 
 	// routine | urgent | stat | asap
-	Priority *MedicationRequestPriority `json:"priority,omitempty"`
+	Priority        *MedicationRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element            `json:"_priority,omitempty"`
 
 	// Reason or indication for writing the prescription
 	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
@@ -1327,7 +1430,8 @@ type MedicationRequest struct {
 	Requester *MedicationRequestRequester `json:"requester,omitempty"`
 
 	// active | on-hold | cancelled | completed | entered-in-error | stopped | draft | unknown
-	Status MedicationRequestStatus `json:"status"`
+	Status        MedicationRequestStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Who treatment is for
 	Subject *common.Reference `json:"subject"`
@@ -1347,7 +1451,8 @@ type MedicationRequestDispenseRequest struct {
 	ExpectedSupplyDuration *Duration `json:"expectedSupplyDuration,omitempty"`
 
 	// Number of refills authorized
-	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed        *int            `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *common.Element `json:"_numberOfRepeatsAllowed,omitempty"`
 
 	// Intended dispenser
 	Performer *common.Reference `json:"performer,omitempty"`
@@ -1375,7 +1480,8 @@ type MedicationRequestSubstitution struct {
 	common.BackboneElement
 
 	// Whether substitution is allowed or not
-	Allowed bool `json:"allowed"`
+	Allowed        bool            `json:"allowed"`
+	AllowedElement *common.Element `json:"_allowed,omitempty"`
 
 	// Why should (not) substitution be made
 	Reason *common.CodeableConcept `json:"reason,omitempty"`
@@ -1421,7 +1527,8 @@ type QuestionnaireResponse struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// Date the answers were gathered
-	Authored *time.Time `json:"authored,omitempty"`
+	Authored        *common.DateTime `json:"authored,omitempty"`
+	AuthoredElement *common.Element  `json:"_authored,omitempty"`
 
 	// Request fulfilled by this QuestionnaireResponse
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -1445,7 +1552,8 @@ type QuestionnaireResponse struct {
 	Source *common.Reference `json:"source,omitempty"`
 
 	// in-progress | completed | amended | entered-in-error | stopped
-	Status QuestionnaireResponseStatus `json:"status"`
+	Status        QuestionnaireResponseStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 
 	// The subject of the questions
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -1459,19 +1567,22 @@ type QuestionnaireResponseItem struct {
 	Answer []QuestionnaireResponseItemAnswer `json:"answer,omitempty"`
 
 	// ElementDefinition - details for the item
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// Nested questionnaire response items
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
 
 	// Pointer to specific item from Questionnaire
-	LinkId string `json:"linkId"`
+	LinkId        string          `json:"linkId"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// The subject this group's answers are about
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// Name for group or question text
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // QuestionnaireResponseItemAnswer represents the response(s) to the question
@@ -1482,18 +1593,26 @@ type QuestionnaireResponseItemAnswer struct {
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
 
 	// Single-valued answer to the question
-	ValueBoolean    *bool             `json:"valueBoolean,omitempty"`
-	ValueDecimal    *float64          `json:"valueDecimal,omitempty"`
-	ValueInteger    *int              `json:"valueInteger,omitempty"`
-	ValueDate       *string           `json:"valueDate,omitempty"`
-	ValueDateTime   *time.Time        `json:"valueDateTime,omitempty"`
-	ValueTime       *string           `json:"valueTime,omitempty"`
-	ValueString     *string           `json:"valueString,omitempty"`
-	ValueUri        *string           `json:"valueUri,omitempty"`
-	ValueAttachment *Attachment       `json:"valueAttachment,omitempty"`
-	ValueCoding     *common.Coding    `json:"valueCoding,omitempty"`
-	ValueQuantity   *common.Quantity  `json:"valueQuantity,omitempty"`
-	ValueReference  *common.Reference `json:"valueReference,omitempty"`
+	ValueBoolean         *bool             `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element   `json:"_valueBoolean,omitempty"`
+	ValueDecimal         *common.Decimal   `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element   `json:"_valueDecimal,omitempty"`
+	ValueInteger         *int              `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element   `json:"_valueInteger,omitempty"`
+	ValueDate            *common.Date      `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element   `json:"_valueDate,omitempty"`
+	ValueDateTime        *common.DateTime  `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element   `json:"_valueDateTime,omitempty"`
+	ValueTime            *common.Time      `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element   `json:"_valueTime,omitempty"`
+	ValueString          *string           `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element   `json:"_valueString,omitempty"`
+	ValueUri             *string           `json:"valueUri,omitempty"`
+	ValueUriElement      *common.Element   `json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment       `json:"valueAttachment,omitempty"`
+	ValueCoding          *common.Coding    `json:"valueCoding,omitempty"`
+	ValueQuantity        *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueReference       *common.Reference `json:"valueReference,omitempty"`
 }
 
 // QuestionnaireResponse-related enums
@@ -1512,10 +1631,12 @@ type ResearchSubject struct {
 	DomainResource
 
 	// The actual group assignment for this subject
-	ActualArm *string `json:"actualArm,omitempty"`
+	ActualArm        *string         `json:"actualArm,omitempty"`
+	ActualArmElement *common.Element `json:"_actualArm,omitempty"`
 
 	// What path should be followed by the subject during the study
-	AssignedArm *string `json:"assignedArm,omitempty"`
+	AssignedArm        *string         `json:"assignedArm,omitempty"`
+	AssignedArmElement *common.Element `json:"_assignedArm,omitempty"`
 
 	// Agreement to participate in study
 	Consent *common.Reference `json:"consent,omitempty"`
@@ -1530,7 +1651,8 @@ type ResearchSubject struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// candidate | eligible | follow-up | ineligible | not-registered | off-study | on-study | on-study-intervention | on-study-observation | pending-on-study | potential-candidate | screening | withdrawn
-	Status ResearchSubjectStatus `json:"status"`
+	Status        ResearchSubjectStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// Study subject is part of
 	Study *common.Reference `json:"study"`
@@ -1578,7 +1700,8 @@ type Consent struct {
 	DataPeriod *common.Period `json:"dataPeriod,omitempty"`
 
 	// When this Consent was created or indexed
-	DateTime *time.Time `json:"dateTime,omitempty"`
+	DateTime        *common.DateTime `json:"dateTime,omitempty"`
+	DateTimeElement *common.Element  `json:"_dateTime,omitempty"`
 
 	// Additional rule - addition or removal of permissions
 	Except []ConsentExcept `json:"except,omitempty"`
@@ -1599,7 +1722,8 @@ type Consent struct {
 	Policy []ConsentPolicy `json:"policy,omitempty"`
 
 	// Policy that this consents to
-	PolicyRule *string `json:"policyRule,omitempty"`
+	PolicyRule        *string         `json:"policyRule,omitempty"`
+	PolicyRuleElement *common.Element `json:"_policyRule,omitempty"`
 
 	// Context of activities for which the agreement is made
 	Purpose []common.Coding `json:"purpose,omitempty"`
@@ -1613,7 +1737,8 @@ type Consent struct {
 	SourceReference  *common.Reference  `json:"sourceReference,omitempty"`
 
 	// draft | proposed | active | rejected | inactive | entered-in-error
-	Status ConsentStatus `json:"status"`
+	Status        ConsentStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // ConsentActor represents who|what controlled by this consent
@@ -1632,10 +1757,12 @@ type ConsentPolicy struct {
 	common.BackboneElement
 
 	// Enforcement source for policy
-	Authority *string `json:"authority,omitempty"`
+	Authority        *string         `json:"authority,omitempty"`
+	AuthorityElement *common.Element `json:"_authority,omitempty"`
 
 	// Specific policy covered by this consent
-	URI *string `json:"uri,omitempty"`
+	URI        *string         `json:"uri,omitempty"`
+	URIElement *common.Element `json:"_uri,omitempty"`
 }
 
 // ConsentData represents data controlled by this consent
@@ -1643,7 +1770,8 @@ type ConsentData struct {
 	common.BackboneElement
 
 	// How the resource reference is interpreted when testing consent restrictions
-	Meaning ConsentDataMeaning `json:"meaning"`
+	Meaning        ConsentDataMeaning `json:"meaning"`
+	MeaningElement *common.Element    `json:"_meaning,omitempty"`
 
 	// The actual data reference
 	Reference *common.Reference `json:"reference"`
@@ -1681,7 +1809,8 @@ type ConsentExcept struct {
 	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
 
 	// deny | permit
-	Type ConsentExceptType `json:"type"`
+	Type        ConsentExceptType `json:"type"`
+	TypeElement *common.Element   `json:"_type,omitempty"`
 }
 
 // ConsentExceptActor represents who|what controlled by this exception
@@ -1700,7 +1829,8 @@ type ConsentExceptData struct {
 	common.BackboneElement
 
 	// How the resource reference is interpreted when testing consent restrictions
-	Meaning ConsentDataMeaning `json:"meaning"`
+	Meaning        ConsentDataMeaning `json:"meaning"`
+	MeaningElement *common.Element    `json:"_meaning,omitempty"`
 
 	// The actual data reference
 	Reference *common.Reference `json:"reference"`
@@ -1742,7 +1872,8 @@ type Device struct {
 	Contact []ContactPoint `json:"contact,omitempty"`
 
 	// Date and time of expiry of this device (if applicable)
-	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// Instance identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -1751,16 +1882,20 @@ type Device struct {
 	Location *common.Reference `json:"location,omitempty"`
 
 	// Lot number of manufacture
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// Date when the device was made
-	ManufactureDate *time.Time `json:"manufactureDate,omitempty"`
+	ManufactureDate        *common.DateTime `json:"manufactureDate,omitempty"`
+	ManufactureDateElement *common.Element  `json:"_manufactureDate,omitempty"`
 
 	// Name of device manufacturer
-	Manufacturer *string `json:"manufacturer,omitempty"`
+	Manufacturer        *string         `json:"manufacturer,omitempty"`
+	ManufacturerElement *common.Element `json:"_manufacturer,omitempty"`
 
 	// Model id assigned by the manufacturer
-	Model *string `json:"model,omitempty"`
+	Model        *string         `json:"model,omitempty"`
+	ModelElement *common.Element `json:"_model,omitempty"`
 
 	// Device notes and comments
 	Note []Annotation `json:"note,omitempty"`
@@ -1775,7 +1910,8 @@ type Device struct {
 	Safety []common.CodeableConcept `json:"safety,omitempty"`
 
 	// active | inactive | entered-in-error | unknown
-	Status *DeviceStatus `json:"status,omitempty"`
+	Status        *DeviceStatus   `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The kind or type of device
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -1784,10 +1920,12 @@ type Device struct {
 	Udi *DeviceUdi `json:"udi,omitempty"`
 
 	// Network address to contact device
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// Version number (i.e. software)
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // DeviceUdi represents unique Device Identifier (UDI) Barcode string (R3 version)
@@ -1795,22 +1933,28 @@ type DeviceUdi struct {
 	common.BackboneElement
 
 	// UDI Machine Readable Barcode String
-	CarrierAIDC *string `json:"carrierAIDC,omitempty"`
+	CarrierAIDC        *string         `json:"carrierAIDC,omitempty"`
+	CarrierAIDCElement *common.Element `json:"_carrierAIDC,omitempty"`
 
 	// UDI Human Readable Barcode String
-	CarrierHRF *string `json:"carrierHRF,omitempty"`
+	CarrierHRF        *string         `json:"carrierHRF,omitempty"`
+	CarrierHRFElement *common.Element `json:"_carrierHRF,omitempty"`
 
 	// A fixed portion of the UDI that identifies the labeler and the specific version or model of a device
-	DeviceIdentifier *string `json:"deviceIdentifier,omitempty"`
+	DeviceIdentifier        *string         `json:"deviceIdentifier,omitempty"`
+	DeviceIdentifierElement *common.Element `json:"_deviceIdentifier,omitempty"`
 
 	// UDI Issuing Organization
-	Issuer *string `json:"issuer,omitempty"`
+	Issuer        *string         `json:"issuer,omitempty"`
+	IssuerElement *common.Element `json:"_issuer,omitempty"`
 
 	// Regional UDI authority
-	Jurisdiction *string `json:"jurisdiction,omitempty"`
+	Jurisdiction        *string         `json:"jurisdiction,omitempty"`
+	JurisdictionElement *common.Element `json:"_jurisdiction,omitempty"`
 
 	// Device Name as appears on UDI label
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 }
 
 // Device-related enums
@@ -1837,13 +1981,15 @@ type Composition struct {
 	Class *common.CodeableConcept `json:"class,omitempty"`
 
 	// As defined by affinity domain
-	Confidentiality *string `json:"confidentiality,omitempty"`
+	Confidentiality        *string         `json:"confidentiality,omitempty"`
+	ConfidentialityElement *common.Element `json:"_confidentiality,omitempty"`
 
 	// Organization which maintains the composition
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// Composition editing time
-	Date time.Time `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Context of the Composition
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -1855,13 +2001,15 @@ type Composition struct {
 	Section []CompositionSection `json:"section,omitempty"`
 
 	// preliminary | final | amended | entered-in-error
-	Status CompositionStatus `json:"status"`
+	Status        CompositionStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Who and/or what the composition is about
 	Subject *common.Reference `json:"subject"`
 
 	// Human Readable name/title
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Kind of composition (LOINC if possible)
 	Type *common.CodeableConcept `json:"type"`
@@ -1872,13 +2020,15 @@ type CompositionAttester struct {
 	common.BackboneElement
 
 	// individual | organization
-	Mode []CompositionAttesterMode `json:"mode"`
+	Mode        []CompositionAttesterMode `json:"mode"`
+	ModeElement []*common.Element         `json:"_mode,omitempty"`
 
 	// Who attested the composition
 	Party *common.Reference `json:"party,omitempty"`
 
 	// When composition attested
-	Time *time.Time `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 }
 
 // CompositionSection represents composition is broken into sections
@@ -1895,7 +2045,8 @@ type CompositionSection struct {
 	Entry []common.Reference `json:"entry,omitempty"`
 
 	// working | snapshot | changes
-	Mode *CompositionSectionMode `json:"mode,omitempty"`
+	Mode        *CompositionSectionMode `json:"mode,omitempty"`
+	ModeElement *common.Element         `json:"_mode,omitempty"`
 
 	// Order of section entries
 	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
@@ -1907,7 +2058,8 @@ type CompositionSection struct {
 	Text *Narrative `json:"text,omitempty"`
 
 	// Label for section (e.g. for ToC)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // Composition-related enums
@@ -1957,25 +2109,29 @@ type DocumentReference struct {
 	Context *DocumentReferenceContext `json:"context,omitempty"`
 
 	// When this document reference was created
-	Created *time.Time `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// Organization which maintains the document
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// Human-readable description (title)
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Unique identifier for the document
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// When this document reference was indexed
-	Indexed time.Time `json:"indexed"`
+	Indexed        common.Instant  `json:"indexed"`
+	IndexedElement *common.Element `json:"_indexed,omitempty"`
 
 	// Document security-tags
 	SecurityLabel []common.CodeableConcept `json:"securityLabel,omitempty"`
 
 	// current | superseded | entered-in-error
-	Status DocumentReferenceStatus `json:"status"`
+	Status        DocumentReferenceStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Who/what is the subject of the document
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -2079,7 +2235,8 @@ type Communication struct {
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
 	// When received
-	Received *time.Time `json:"received,omitempty"`
+	Received        *common.DateTime `json:"received,omitempty"`
+	ReceivedElement *common.Element  `json:"_received,omitempty"`
 
 	// Message recipient
 	Recipient []common.Reference `json:"recipient,omitempty"`
@@ -2088,16 +2245,19 @@ type Communication struct {
 	Sender *common.Reference `json:"sender,omitempty"`
 
 	// When sent
-	Sent *time.Time `json:"sent,omitempty"`
+	Sent        *common.DateTime `json:"sent,omitempty"`
+	SentElement *common.Element  `json:"_sent,omitempty"`
 
 	// preparation | in-progress | suspended | aborted | completed | entered-in-error
-	Status CommunicationStatus `json:"status"`
+	Status        CommunicationStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// Focus of message
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// Communication did not occur
-	NotDone *bool `json:"notDone,omitempty"`
+	NotDone        *bool           `json:"notDone,omitempty"`
+	NotDoneElement *common.Element `json:"_notDone,omitempty"`
 
 	// Why communication did not occur
 	NotDoneReason *common.CodeableConcept `json:"notDoneReason,omitempty"`
@@ -2108,9 +2268,10 @@ type CommunicationPayload struct {
 	common.BackboneElement
 
 	// Message part content
-	ContentString     *string           `json:"contentString,omitempty"`
-	ContentAttachment *Attachment       `json:"contentAttachment,omitempty"`
-	ContentReference  *common.Reference `json:"contentReference,omitempty"`
+	ContentString        *string           `json:"contentString,omitempty"`
+	ContentStringElement *common.Element   `json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference     *common.Reference `json:"contentReference,omitempty"`
 }
 
 // Communication-related enums
@@ -2134,6 +2295,7 @@ type Dosage struct {
 
 	// Take "as needed" (for x)
 	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
 	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
 
 	// Amount of medication per dose
@@ -2149,7 +2311,8 @@ type Dosage struct {
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// Patient or consumer oriented instructions
-	PatientInstruction *string `json:"patientInstruction,omitempty"`
+	PatientInstruction        *string         `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *common.Element `json:"_patientInstruction,omitempty"`
 
 	// Amount of medication per unit of time
 	RateQuantity *common.Quantity `json:"rateQuantity,omitempty"`
@@ -2160,13 +2323,15 @@ type Dosage struct {
 	Route *common.CodeableConcept `json:"route,omitempty"`
 
 	// The order of the dosage instructions
-	Sequence *int `json:"sequence,omitempty"`
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// Body site to administer to
 	Site *common.CodeableConcept `json:"site,omitempty"`
 
 	// Free text dosage instructions e.g. SIG
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// When medication should be administered
 	Timing *Timing `json:"timing,omitempty"`
@@ -2180,7 +2345,8 @@ type Timing struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// When the event is to occur
-	Event []time.Time `json:"event,omitempty"`
+	Event        []common.DateTime `json:"event,omitempty"`
+	EventElement []*common.Element `json:"_event,omitempty"`
 
 	// When the event is to occur
 	Repeat *TimingRepeat `json:"repeat,omitempty"`
@@ -2196,46 +2362,60 @@ type TimingRepeat struct {
 	BoundsPeriod   *common.Period `json:"boundsPeriod,omitempty"`
 
 	// Number of times to repeat
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// Maximum number of times to repeat
-	CountMax *int `json:"countMax,omitempty"`
+	CountMax        *int            `json:"countMax,omitempty"`
+	CountMaxElement *common.Element `json:"_countMax,omitempty"`
 
 	// How long when it happens
-	Duration *float64 `json:"duration,omitempty"`
+	Duration        *common.Decimal `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
 
 	// How long when it happens (Max)
-	DurationMax *float64 `json:"durationMax,omitempty"`
+	DurationMax        *common.Decimal `json:"durationMax,omitempty"`
+	DurationMaxElement *common.Element `json:"_durationMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	DurationUnit *TimingRepeatDurationUnit `json:"durationUnit,omitempty"`
+	DurationUnit        *TimingRepeatDurationUnit `json:"durationUnit,omitempty"`
+	DurationUnitElement *common.Element           `json:"_durationUnit,omitempty"`
 
 	// Event occurs frequency times per period
-	Frequency *int `json:"frequency,omitempty"`
+	Frequency        *int            `json:"frequency,omitempty"`
+	FrequencyElement *common.Element `json:"_frequency,omitempty"`
 
 	// Event occurs up to frequencyMax times per period
-	FrequencyMax *int `json:"frequencyMax,omitempty"`
+	FrequencyMax        *int            `json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *common.Element `json:"_frequencyMax,omitempty"`
 
 	// Minutes from event (before or after)
-	Offset *int `json:"offset,omitempty"`
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
 
 	// Event occurs frequency times per period
-	Period *float64 `json:"period,omitempty"`
+	Period        *common.Decimal `json:"period,omitempty"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// Upper limit of period (3-4 hours)
-	PeriodMax *float64 `json:"periodMax,omitempty"`
+	PeriodMax        *common.Decimal `json:"periodMax,omitempty"`
+	PeriodMaxElement *common.Element `json:"_periodMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	PeriodUnit *TimingRepeatPeriodUnit `json:"periodUnit,omitempty"`
+	PeriodUnit        *TimingRepeatPeriodUnit `json:"periodUnit,omitempty"`
+	PeriodUnitElement *common.Element         `json:"_periodUnit,omitempty"`
 
 	// Time of day for action
-	TimeOfDay []string `json:"timeOfDay,omitempty"`
+	TimeOfDay        []common.Time     `json:"timeOfDay,omitempty"`
+	TimeOfDayElement []*common.Element `json:"_timeOfDay,omitempty"`
 
 	// mon | tue | wed | thu | fri | sat | sun
-	DayOfWeek []TimingRepeatDayOfWeek `json:"dayOfWeek,omitempty"`
+	DayOfWeek        []TimingRepeatDayOfWeek `json:"dayOfWeek,omitempty"`
+	DayOfWeekElement []*common.Element       `json:"_dayOfWeek,omitempty"`
 
 	// Regular life events the event is tied to
-	When []TimingRepeatWhen `json:"when,omitempty"`
+	When        []TimingRepeatWhen `json:"when,omitempty"`
+	WhenElement []*common.Element  `json:"_when,omitempty"`
 }
 
 // Timing-related enums
@@ -2299,25 +2479,31 @@ type SampledData struct {
 	common.Element
 
 	// Decimal values with spaces, or "E" | "U" | "L"
-	Data string `json:"data"`
+	Data        string          `json:"data"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// Number of sample points at each time point
-	Dimensions int `json:"dimensions"`
+	Dimensions        int             `json:"dimensions"`
+	DimensionsElement *common.Element `json:"_dimensions,omitempty"`
 
 	// Multiply data by this before adding to origin
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Lower limit of detection
-	LowerLimit *float64 `json:"lowerLimit,omitempty"`
+	LowerLimit        *common.Decimal `json:"lowerLimit,omitempty"`
+	LowerLimitElement *common.Element `json:"_lowerLimit,omitempty"`
 
 	// Zero value and units
 	Origin *common.Quantity `json:"origin"`
 
 	// Number of milliseconds between samples
-	Period float64 `json:"period"`
+	Period        common.Decimal  `json:"period"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// Upper limit of detection
-	UpperLimit *float64 `json:"upperLimit,omitempty"`
+	UpperLimit        *common.Decimal `json:"upperLimit,omitempty"`
+	UpperLimitElement *common.Element `json:"_upperLimit,omitempty"`
 }
 
 // Narrative represents a human-readable formatted text, including images (R3 version)
@@ -2325,7 +2511,8 @@ type Narrative struct {
 	common.Element
 
 	// generated | extensions | additional | empty
-	Status NarrativeStatus `json:"status"`
+	Status        NarrativeStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Limited xhtml content
 	Div string `json:"div"`
@@ -2346,20 +2533,24 @@ type Signature struct {
 	common.Element
 
 	// The actual signature content (XML DigSig, JWT, picture, etc.)
-	Blob *string `json:"blob,omitempty"`
+	Blob        *string         `json:"blob,omitempty"`
+	BlobElement *common.Element `json:"_blob,omitempty"`
 
 	// The technical format of the signature
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// Indication of the reason the entity signed the object(s)
 	Type []common.Coding `json:"type"`
 
 	// Who signed
-	WhoURI       *string           `json:"whoUri,omitempty"`
-	WhoReference *common.Reference `json:"whoReference,omitempty"`
+	WhoURI        *string           `json:"whoUri,omitempty"`
+	WhoURIElement *common.Element   `json:"_whoUri,omitempty"`
+	WhoReference  *common.Reference `json:"whoReference,omitempty"`
 
 	// When the signature was created
-	When time.Time `json:"when"`
+	When        common.Instant  `json:"when"`
+	WhenElement *common.Element `json:"_when,omitempty"`
 }
 
 // AllergyIntolerance represents risk of harmful or undesirable, physiological response to a substance (R3)
@@ -2373,19 +2564,24 @@ type AllergyIntolerance struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// active | inactive | resolved (simplified in R3)
-	ClinicalStatus *AllergyIntoleranceClinicalStatus `json:"clinicalStatus,omitempty"`
+	ClinicalStatus        *AllergyIntoleranceClinicalStatus `json:"clinicalStatus,omitempty"`
+	ClinicalStatusElement *common.Element                   `json:"_clinicalStatus,omitempty"`
 
 	// unconfirmed | confirmed | refuted | entered-in-error (required in R3)
-	VerificationStatus AllergyIntoleranceVerificationStatus `json:"verificationStatus"`
+	VerificationStatus        AllergyIntoleranceVerificationStatus `json:"verificationStatus"`
+	VerificationStatusElement *common.Element                      `json:"_verificationStatus,omitempty"`
 
 	// allergy | intolerance - Underlying mechanism (if known)
-	Type *AllergyIntoleranceType `json:"type,omitempty"`
+	Type        *AllergyIntoleranceType `json:"type,omitempty"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
 
 	// food | medication | environment | biologic
-	Category []AllergyIntoleranceCategory `json:"category,omitempty"`
+	Category        []AllergyIntoleranceCategory `json:"category,omitempty"`
+	CategoryElement []*common.Element            `json:"_category,omitempty"`
 
 	// low | high | unable-to-assess
-	Criticality *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	Criticality        *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	CriticalityElement *common.Element                `json:"_criticality,omitempty"`
 
 	// Code that identifies the allergy or intolerance
 	Code *common.CodeableConcept `json:"code,omitempty"`
@@ -2394,14 +2590,17 @@ type AllergyIntolerance struct {
 	Patient common.Reference `json:"patient"`
 
 	// Date first version of the resource instance was recorded
-	OnsetDateTime *time.Time     `json:"onsetDateTime,omitempty"`
-	OnsetAge      *Age           `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *Age             `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// When allergy or intolerance was identified
-	AssertedDate *time.Time `json:"assertedDate,omitempty"`
+	AssertedDate        *common.DateTime `json:"assertedDate,omitempty"`
+	AssertedDateElement *common.Element  `json:"_assertedDate,omitempty"`
 
 	// Who recorded the sensitivity
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -2410,7 +2609,8 @@ type AllergyIntolerance struct {
 	Asserter *common.Reference `json:"asserter,omitempty"`
 
 	// Date(/time) of last known occurrence of a reaction
-	LastOccurrence *time.Time `json:"lastOccurrence,omitempty"`
+	LastOccurrence        *common.DateTime `json:"lastOccurrence,omitempty"`
+	LastOccurrenceElement *common.Element  `json:"_lastOccurrence,omitempty"`
 
 	// Additional text not captured in other fields
 	Note []Annotation `json:"note,omitempty"`
@@ -2476,13 +2676,16 @@ type AllergyIntoleranceReaction struct {
 	Manifestation []common.CodeableConcept `json:"manifestation"`
 
 	// Description of the event as a whole
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Date(/time) when manifestations showed
-	Onset *time.Time `json:"onset,omitempty"`
+	Onset        *common.DateTime `json:"onset,omitempty"`
+	OnsetElement *common.Element  `json:"_onset,omitempty"`
 
 	// mild | moderate | severe (of event as a whole)
-	Severity *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	Severity        *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
 
 	// How the subject was exposed to the substance
 	ExposureRoute *common.CodeableConcept `json:"exposureRoute,omitempty"`
@@ -2511,10 +2714,12 @@ type Immunization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// completed | entered-in-error (R3 has different status values)
-	Status ImmunizationStatus `json:"status"`
+	Status        ImmunizationStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// Indicates if the immunization was given (R3 uses notGiven)
-	NotGiven bool `json:"notGiven"`
+	NotGiven        bool            `json:"notGiven"`
+	NotGivenElement *common.Element `json:"_notGiven,omitempty"`
 
 	// Vaccine product administered
 	VaccineCode common.CodeableConcept `json:"vaccineCode"`
@@ -2526,10 +2731,12 @@ type Immunization struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Vaccine administration date
-	Date *time.Time `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Indicates context the data was recorded in (R3)
-	PrimarySource bool `json:"primarySource"`
+	PrimarySource        bool            `json:"primarySource"`
+	PrimarySourceElement *common.Element `json:"_primarySource,omitempty"`
 
 	// Indicates the source of the reported record
 	ReportOrigin *common.CodeableConcept `json:"reportOrigin,omitempty"`
@@ -2541,10 +2748,12 @@ type Immunization struct {
 	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
 
 	// Vaccine lot number
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// Vaccine expiration date
-	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.Date    `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element `json:"_expirationDate,omitempty"`
 
 	// Body site vaccine was administered
 	Site *common.CodeableConcept `json:"site,omitempty"`
@@ -2606,13 +2815,15 @@ type ImmunizationReaction struct {
 	common.BackboneElement
 
 	// When reaction started
-	Date *time.Time `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Additional information on reaction
 	Detail *common.Reference `json:"detail,omitempty"`
 
 	// Indicates self-reported reaction
-	Reported *bool `json:"reported,omitempty"`
+	Reported        *bool           `json:"reported,omitempty"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
 }
 
 // ImmunizationVaccinationProtocol represents protocol followed by the provider (R3)
@@ -2620,19 +2831,23 @@ type ImmunizationVaccinationProtocol struct {
 	common.BackboneElement
 
 	// Dose number within series
-	DoseSequence int `json:"doseSequence"`
+	DoseSequence        int             `json:"doseSequence"`
+	DoseSequenceElement *common.Element `json:"_doseSequence,omitempty"`
 
 	// Details of the series
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Who is responsible for publishing the recommendations
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// Name of vaccine series
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// Recommended number of doses for immunity
-	SeriesDoses *int `json:"seriesDoses,omitempty"`
+	SeriesDoses        *int            `json:"seriesDoses,omitempty"`
+	SeriesDosesElement *common.Element `json:"_seriesDoses,omitempty"`
 
 	// Vaccine preventable disease being targeted
 	TargetDisease []common.CodeableConcept `json:"targetDisease"`
@@ -2667,19 +2882,23 @@ type CarePlan struct {
 	PartOf []common.Reference `json:"partOf,omitempty"`
 
 	// draft | active | suspended | completed | entered-in-error | cancelled | unknown
-	Status CarePlanStatus `json:"status"`
+	Status        CarePlanStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// proposal | plan | order | option
-	Intent CarePlanIntent `json:"intent"`
+	Intent        CarePlanIntent  `json:"intent"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
 
 	// Type of plan
 	Category []common.CodeableConcept `json:"category,omitempty"`
 
 	// Human-friendly name for the care plan
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Summary of nature of plan
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Who the care plan is for
 	Subject common.Reference `json:"subject"`
@@ -2778,15 +2997,18 @@ type CarePlanActivityDetail struct {
 	Goal []common.Reference `json:"goal,omitempty"`
 
 	// not-started | scheduled | in-progress | on-hold | completed | cancelled | stopped | unknown | entered-in-error
-	Status CarePlanActivityStatus `json:"status"`
+	Status        CarePlanActivityStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// Do NOT do
-	Prohibited bool `json:"prohibited"`
+	Prohibited        bool            `json:"prohibited"`
+	ProhibitedElement *common.Element `json:"_prohibited,omitempty"`
 
 	// When activity is to occur
-	ScheduledTiming *Timing        `json:"scheduledTiming,omitempty"`
-	ScheduledPeriod *common.Period `json:"scheduledPeriod,omitempty"`
-	ScheduledString *string        `json:"scheduledString,omitempty"`
+	ScheduledTiming        *Timing         `json:"scheduledTiming,omitempty"`
+	ScheduledPeriod        *common.Period  `json:"scheduledPeriod,omitempty"`
+	ScheduledString        *string         `json:"scheduledString,omitempty"`
+	ScheduledStringElement *common.Element `json:"_scheduledString,omitempty"`
 
 	// Where it should happen
 	Location *common.Reference `json:"location,omitempty"`
@@ -2805,7 +3027,8 @@ type CarePlanActivityDetail struct {
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// Extra info describing activity to perform
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 }
 
 // CarePlanActivityStatus represents the current state of the activity (R3)
@@ -2837,7 +3060,8 @@ type Specimen struct {
 	AccessionIdentifier *common.Identifier `json:"accessionIdentifier,omitempty"`
 
 	// available | unavailable | unsatisfactory | entered-in-error
-	Status *SpecimenStatus `json:"status,omitempty"`
+	Status        *SpecimenStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Kind of material that forms the specimen
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -2846,7 +3070,8 @@ type Specimen struct {
 	Subject common.Reference `json:"subject"`
 
 	// Time when specimen was received for processing
-	ReceivedTime *time.Time `json:"receivedTime,omitempty"`
+	ReceivedTime        *common.DateTime `json:"receivedTime,omitempty"`
+	ReceivedTimeElement *common.Element  `json:"_receivedTime,omitempty"`
 
 	// Specimen from which this specimen originated
 	Parent []common.Reference `json:"parent,omitempty"`
@@ -2885,8 +3110,9 @@ type SpecimenCollection struct {
 	Collector *common.Reference `json:"collector,omitempty"`
 
 	// Collection time
-	CollectedDateTime *time.Time     `json:"collectedDateTime,omitempty"`
-	CollectedPeriod   *common.Period `json:"collectedPeriod,omitempty"`
+	CollectedDateTime        *common.DateTime `json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *common.Element  `json:"_collectedDateTime,omitempty"`
+	CollectedPeriod          *common.Period   `json:"collectedPeriod,omitempty"`
 
 	// How much was collected
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -2903,7 +3129,8 @@ type SpecimenProcessing struct {
 	common.BackboneElement
 
 	// Textual description of procedure
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Indicates the treatment step applied to the specimen
 	Procedure *common.CodeableConcept `json:"procedure,omitempty"`
@@ -2912,8 +3139,9 @@ type SpecimenProcessing struct {
 	Additive []common.Reference `json:"additive,omitempty"`
 
 	// Date and time of specimen processing
-	TimeDateTime *time.Time     `json:"timeDateTime,omitempty"`
-	TimePeriod   *common.Period `json:"timePeriod,omitempty"`
+	TimeDateTime        *common.DateTime `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element  `json:"_timeDateTime,omitempty"`
+	TimePeriod          *common.Period   `json:"timePeriod,omitempty"`
 }
 
 // SpecimenContainer represents the container holding the specimen
@@ -2924,7 +3152,8 @@ type SpecimenContainer struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Textual description of the container
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Kind of container directly associated with specimen
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -2939,3 +3168,43 @@ type SpecimenContainer struct {
 	AdditiveCodeableConcept *common.CodeableConcept `json:"additiveCodeableConcept,omitempty"`
 	AdditiveReference       *common.Reference       `json:"additiveReference,omitempty"`
 }
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (e *BundleEntry) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntry
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	e.Resource = resource
+	return nil
+}
+
+// UnmarshalJSON decodes the inline OperationOutcome into the struct matching its resourceType
+func (r *BundleEntryResponse) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntryResponse
+	aux := &struct {
+		*Alias
+		Outcome json.RawMessage `json:"outcome,omitempty"`
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Outcome)
+	if err != nil {
+		return err
+	}
+	r.Outcome = resource
+	return nil
+}
@@ -0,0 +1,832 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+var resourceFactories = map[string]common.ResourceFactory{
+	"Account":                    func() common.Resource { return new(Account) },
+	"ActivityDefinition":         func() common.Resource { return new(ActivityDefinition) },
+	"AdverseEvent":               func() common.Resource { return new(AdverseEvent) },
+	"AllergyIntolerance":         func() common.Resource { return new(AllergyIntolerance) },
+	"Appointment":                func() common.Resource { return new(Appointment) },
+	"AppointmentResponse":        func() common.Resource { return new(AppointmentResponse) },
+	"AuditEvent":                 func() common.Resource { return new(AuditEvent) },
+	"Basic":                      func() common.Resource { return new(Basic) },
+	"Binary":                     func() common.Resource { return new(Binary) },
+	"BodySite":                   func() common.Resource { return new(BodySite) },
+	"Bundle":                     func() common.Resource { return new(Bundle) },
+	"CapabilityStatement":        func() common.Resource { return new(CapabilityStatement) },
+	"CarePlan":                   func() common.Resource { return new(CarePlan) },
+	"CareTeam":                   func() common.Resource { return new(CareTeam) },
+	"ChargeItem":                 func() common.Resource { return new(ChargeItem) },
+	"Claim":                      func() common.Resource { return new(Claim) },
+	"ClaimResponse":              func() common.Resource { return new(ClaimResponse) },
+	"ClinicalImpression":         func() common.Resource { return new(ClinicalImpression) },
+	"CodeSystem":                 func() common.Resource { return new(CodeSystem) },
+	"Communication":              func() common.Resource { return new(Communication) },
+	"CommunicationRequest":       func() common.Resource { return new(CommunicationRequest) },
+	"CompartmentDefinition":      func() common.Resource { return new(CompartmentDefinition) },
+	"Composition":                func() common.Resource { return new(Composition) },
+	"ConceptMap":                 func() common.Resource { return new(ConceptMap) },
+	"Condition":                  func() common.Resource { return new(Condition) },
+	"Consent":                    func() common.Resource { return new(Consent) },
+	"Contract":                   func() common.Resource { return new(Contract) },
+	"Coverage":                   func() common.Resource { return new(Coverage) },
+	"DataElement":                func() common.Resource { return new(DataElement) },
+	"DetectedIssue":              func() common.Resource { return new(DetectedIssue) },
+	"Device":                     func() common.Resource { return new(Device) },
+	"DeviceComponent":            func() common.Resource { return new(DeviceComponent) },
+	"DeviceMetric":               func() common.Resource { return new(DeviceMetric) },
+	"DeviceRequest":              func() common.Resource { return new(DeviceRequest) },
+	"DeviceUseStatement":         func() common.Resource { return new(DeviceUseStatement) },
+	"DiagnosticReport":           func() common.Resource { return new(DiagnosticReport) },
+	"DocumentManifest":           func() common.Resource { return new(DocumentManifest) },
+	"DocumentReference":          func() common.Resource { return new(DocumentReference) },
+	"EligibilityRequest":         func() common.Resource { return new(EligibilityRequest) },
+	"EligibilityResponse":        func() common.Resource { return new(EligibilityResponse) },
+	"Encounter":                  func() common.Resource { return new(Encounter) },
+	"Endpoint":                   func() common.Resource { return new(Endpoint) },
+	"EnrollmentRequest":          func() common.Resource { return new(EnrollmentRequest) },
+	"EnrollmentResponse":         func() common.Resource { return new(EnrollmentResponse) },
+	"EpisodeOfCare":              func() common.Resource { return new(EpisodeOfCare) },
+	"ExpansionProfile":           func() common.Resource { return new(ExpansionProfile) },
+	"ExplanationOfBenefit":       func() common.Resource { return new(ExplanationOfBenefit) },
+	"FamilyMemberHistory":        func() common.Resource { return new(FamilyMemberHistory) },
+	"Flag":                       func() common.Resource { return new(Flag) },
+	"Goal":                       func() common.Resource { return new(Goal) },
+	"GraphDefinition":            func() common.Resource { return new(GraphDefinition) },
+	"Group":                      func() common.Resource { return new(Group) },
+	"GuidanceResponse":           func() common.Resource { return new(GuidanceResponse) },
+	"HealthcareService":          func() common.Resource { return new(HealthcareService) },
+	"ImagingManifest":            func() common.Resource { return new(ImagingManifest) },
+	"ImagingStudy":               func() common.Resource { return new(ImagingStudy) },
+	"Immunization":               func() common.Resource { return new(Immunization) },
+	"ImmunizationRecommendation": func() common.Resource { return new(ImmunizationRecommendation) },
+	"ImplementationGuide":        func() common.Resource { return new(ImplementationGuide) },
+	"Library":                    func() common.Resource { return new(Library) },
+	"Linkage":                    func() common.Resource { return new(Linkage) },
+	"List":                       func() common.Resource { return new(List) },
+	"Location":                   func() common.Resource { return new(Location) },
+	"Measure":                    func() common.Resource { return new(Measure) },
+	"MeasureReport":              func() common.Resource { return new(MeasureReport) },
+	"Media":                      func() common.Resource { return new(Media) },
+	"Medication":                 func() common.Resource { return new(Medication) },
+	"MedicationAdministration":   func() common.Resource { return new(MedicationAdministration) },
+	"MedicationDispense":         func() common.Resource { return new(MedicationDispense) },
+	"MedicationRequest":          func() common.Resource { return new(MedicationRequest) },
+	"MedicationStatement":        func() common.Resource { return new(MedicationStatement) },
+	"MessageDefinition":          func() common.Resource { return new(MessageDefinition) },
+	"MessageHeader":              func() common.Resource { return new(MessageHeader) },
+	"NamingSystem":               func() common.Resource { return new(NamingSystem) },
+	"NutritionOrder":             func() common.Resource { return new(NutritionOrder) },
+	"Observation":                func() common.Resource { return new(Observation) },
+	"OperationDefinition":        func() common.Resource { return new(OperationDefinition) },
+	"OperationOutcome":           func() common.Resource { return new(OperationOutcome) },
+	"Organization":               func() common.Resource { return new(Organization) },
+	"Parameters":                 func() common.Resource { return new(Parameters) },
+	"Patient":                    func() common.Resource { return new(Patient) },
+	"PaymentNotice":              func() common.Resource { return new(PaymentNotice) },
+	"PaymentReconciliation":      func() common.Resource { return new(PaymentReconciliation) },
+	"Person":                     func() common.Resource { return new(Person) },
+	"PlanDefinition":             func() common.Resource { return new(PlanDefinition) },
+	"Practitioner":               func() common.Resource { return new(Practitioner) },
+	"PractitionerRole":           func() common.Resource { return new(PractitionerRole) },
+	"Procedure":                  func() common.Resource { return new(Procedure) },
+	"ProcedureRequest":           func() common.Resource { return new(ProcedureRequest) },
+	"ProcessRequest":             func() common.Resource { return new(ProcessRequest) },
+	"ProcessResponse":            func() common.Resource { return new(ProcessResponse) },
+	"Provenance":                 func() common.Resource { return new(Provenance) },
+	"Questionnaire":              func() common.Resource { return new(Questionnaire) },
+	"QuestionnaireResponse":      func() common.Resource { return new(QuestionnaireResponse) },
+	"ReferralRequest":            func() common.Resource { return new(ReferralRequest) },
+	"RelatedPerson":              func() common.Resource { return new(RelatedPerson) },
+	"RequestGroup":               func() common.Resource { return new(RequestGroup) },
+	"ResearchStudy":              func() common.Resource { return new(ResearchStudy) },
+	"ResearchSubject":            func() common.Resource { return new(ResearchSubject) },
+	"RiskAssessment":             func() common.Resource { return new(RiskAssessment) },
+	"Schedule":                   func() common.Resource { return new(Schedule) },
+	"SearchParameter":            func() common.Resource { return new(SearchParameter) },
+	"Sequence":                   func() common.Resource { return new(Sequence) },
+	"ServiceDefinition":          func() common.Resource { return new(ServiceDefinition) },
+	"Slot":                       func() common.Resource { return new(Slot) },
+	"Specimen":                   func() common.Resource { return new(Specimen) },
+	"StructureDefinition":        func() common.Resource { return new(StructureDefinition) },
+	"StructureMap":               func() common.Resource { return new(StructureMap) },
+	"Subscription":               func() common.Resource { return new(Subscription) },
+	"Substance":                  func() common.Resource { return new(Substance) },
+	"SupplyDelivery":             func() common.Resource { return new(SupplyDelivery) },
+	"SupplyRequest":              func() common.Resource { return new(SupplyRequest) },
+	"Task":                       func() common.Resource { return new(Task) },
+	"TestReport":                 func() common.Resource { return new(TestReport) },
+	"TestScript":                 func() common.Resource { return new(TestScript) },
+	"ValueSet":                   func() common.Resource { return new(ValueSet) },
+	"VisionPrescription":         func() common.Resource { return new(VisionPrescription) },
+}
+
+var (
+	_ AnyDomainResource = (*Account)(nil)
+	_ AnyDomainResource = (*ActivityDefinition)(nil)
+	_ AnyDomainResource = (*AdverseEvent)(nil)
+	_ AnyDomainResource = (*AllergyIntolerance)(nil)
+	_ AnyDomainResource = (*Appointment)(nil)
+	_ AnyDomainResource = (*AppointmentResponse)(nil)
+	_ AnyDomainResource = (*AuditEvent)(nil)
+	_ AnyDomainResource = (*Basic)(nil)
+	_ AnyResource       = (*Binary)(nil)
+	_ AnyDomainResource = (*BodySite)(nil)
+	_ AnyResource       = (*Bundle)(nil)
+	_ AnyDomainResource = (*CapabilityStatement)(nil)
+	_ AnyDomainResource = (*CarePlan)(nil)
+	_ AnyDomainResource = (*CareTeam)(nil)
+	_ AnyDomainResource = (*ChargeItem)(nil)
+	_ AnyDomainResource = (*Claim)(nil)
+	_ AnyDomainResource = (*ClaimResponse)(nil)
+	_ AnyDomainResource = (*ClinicalImpression)(nil)
+	_ AnyDomainResource = (*CodeSystem)(nil)
+	_ AnyDomainResource = (*Communication)(nil)
+	_ AnyDomainResource = (*CommunicationRequest)(nil)
+	_ AnyDomainResource = (*CompartmentDefinition)(nil)
+	_ AnyDomainResource = (*Composition)(nil)
+	_ AnyDomainResource = (*ConceptMap)(nil)
+	_ AnyDomainResource = (*Condition)(nil)
+	_ AnyDomainResource = (*Consent)(nil)
+	_ AnyDomainResource = (*Contract)(nil)
+	_ AnyDomainResource = (*Coverage)(nil)
+	_ AnyDomainResource = (*DataElement)(nil)
+	_ AnyDomainResource = (*DetectedIssue)(nil)
+	_ AnyDomainResource = (*Device)(nil)
+	_ AnyDomainResource = (*DeviceComponent)(nil)
+	_ AnyDomainResource = (*DeviceMetric)(nil)
+	_ AnyDomainResource = (*DeviceRequest)(nil)
+	_ AnyDomainResource = (*DeviceUseStatement)(nil)
+	_ AnyDomainResource = (*DiagnosticReport)(nil)
+	_ AnyDomainResource = (*DocumentManifest)(nil)
+	_ AnyDomainResource = (*DocumentReference)(nil)
+	_ AnyDomainResource = (*EligibilityRequest)(nil)
+	_ AnyDomainResource = (*EligibilityResponse)(nil)
+	_ AnyDomainResource = (*Encounter)(nil)
+	_ AnyDomainResource = (*Endpoint)(nil)
+	_ AnyDomainResource = (*EnrollmentRequest)(nil)
+	_ AnyDomainResource = (*EnrollmentResponse)(nil)
+	_ AnyDomainResource = (*EpisodeOfCare)(nil)
+	_ AnyDomainResource = (*ExpansionProfile)(nil)
+	_ AnyDomainResource = (*ExplanationOfBenefit)(nil)
+	_ AnyDomainResource = (*FamilyMemberHistory)(nil)
+	_ AnyDomainResource = (*Flag)(nil)
+	_ AnyDomainResource = (*Goal)(nil)
+	_ AnyDomainResource = (*GraphDefinition)(nil)
+	_ AnyDomainResource = (*Group)(nil)
+	_ AnyDomainResource = (*GuidanceResponse)(nil)
+	_ AnyDomainResource = (*HealthcareService)(nil)
+	_ AnyDomainResource = (*ImagingManifest)(nil)
+	_ AnyDomainResource = (*ImagingStudy)(nil)
+	_ AnyDomainResource = (*Immunization)(nil)
+	_ AnyDomainResource = (*ImmunizationRecommendation)(nil)
+	_ AnyDomainResource = (*ImplementationGuide)(nil)
+	_ AnyDomainResource = (*Library)(nil)
+	_ AnyDomainResource = (*Linkage)(nil)
+	_ AnyDomainResource = (*List)(nil)
+	_ AnyDomainResource = (*Location)(nil)
+	_ AnyDomainResource = (*Measure)(nil)
+	_ AnyDomainResource = (*MeasureReport)(nil)
+	_ AnyDomainResource = (*Media)(nil)
+	_ AnyDomainResource = (*Medication)(nil)
+	_ AnyDomainResource = (*MedicationAdministration)(nil)
+	_ AnyDomainResource = (*MedicationDispense)(nil)
+	_ AnyDomainResource = (*MedicationRequest)(nil)
+	_ AnyDomainResource = (*MedicationStatement)(nil)
+	_ AnyDomainResource = (*MessageDefinition)(nil)
+	_ AnyDomainResource = (*MessageHeader)(nil)
+	_ AnyDomainResource = (*NamingSystem)(nil)
+	_ AnyDomainResource = (*NutritionOrder)(nil)
+	_ AnyDomainResource = (*Observation)(nil)
+	_ AnyDomainResource = (*OperationDefinition)(nil)
+	_ AnyDomainResource = (*OperationOutcome)(nil)
+	_ AnyDomainResource = (*Organization)(nil)
+	_ AnyResource       = (*Parameters)(nil)
+	_ AnyDomainResource = (*Patient)(nil)
+	_ AnyDomainResource = (*PaymentNotice)(nil)
+	_ AnyDomainResource = (*PaymentReconciliation)(nil)
+	_ AnyDomainResource = (*Person)(nil)
+	_ AnyDomainResource = (*PlanDefinition)(nil)
+	_ AnyDomainResource = (*Practitioner)(nil)
+	_ AnyDomainResource = (*PractitionerRole)(nil)
+	_ AnyDomainResource = (*Procedure)(nil)
+	_ AnyDomainResource = (*ProcedureRequest)(nil)
+	_ AnyDomainResource = (*ProcessRequest)(nil)
+	_ AnyDomainResource = (*ProcessResponse)(nil)
+	_ AnyDomainResource = (*Provenance)(nil)
+	_ AnyDomainResource = (*Questionnaire)(nil)
+	_ AnyDomainResource = (*QuestionnaireResponse)(nil)
+	_ AnyDomainResource = (*ReferralRequest)(nil)
+	_ AnyDomainResource = (*RelatedPerson)(nil)
+	_ AnyDomainResource = (*RequestGroup)(nil)
+	_ AnyDomainResource = (*ResearchStudy)(nil)
+	_ AnyDomainResource = (*ResearchSubject)(nil)
+	_ AnyDomainResource = (*RiskAssessment)(nil)
+	_ AnyDomainResource = (*Schedule)(nil)
+	_ AnyDomainResource = (*SearchParameter)(nil)
+	_ AnyDomainResource = (*Sequence)(nil)
+	_ AnyDomainResource = (*ServiceDefinition)(nil)
+	_ AnyDomainResource = (*Slot)(nil)
+	_ AnyDomainResource = (*Specimen)(nil)
+	_ AnyDomainResource = (*StructureDefinition)(nil)
+	_ AnyDomainResource = (*StructureMap)(nil)
+	_ AnyDomainResource = (*Subscription)(nil)
+	_ AnyDomainResource = (*Substance)(nil)
+	_ AnyDomainResource = (*SupplyDelivery)(nil)
+	_ AnyDomainResource = (*SupplyRequest)(nil)
+	_ AnyDomainResource = (*Task)(nil)
+	_ AnyDomainResource = (*TestReport)(nil)
+	_ AnyDomainResource = (*TestScript)(nil)
+	_ AnyDomainResource = (*ValueSet)(nil)
+	_ AnyDomainResource = (*VisionPrescription)(nil)
+)
+
+// GetResourceType returns "Account"
+func (*Account) GetResourceType() string {
+	return "Account"
+}
+
+// GetResourceType returns "ActivityDefinition"
+func (*ActivityDefinition) GetResourceType() string {
+	return "ActivityDefinition"
+}
+
+// GetResourceType returns "AdverseEvent"
+func (*AdverseEvent) GetResourceType() string {
+	return "AdverseEvent"
+}
+
+// GetResourceType returns "AllergyIntolerance"
+func (*AllergyIntolerance) GetResourceType() string {
+	return "AllergyIntolerance"
+}
+
+// GetResourceType returns "Appointment"
+func (*Appointment) GetResourceType() string {
+	return "Appointment"
+}
+
+// GetResourceType returns "AppointmentResponse"
+func (*AppointmentResponse) GetResourceType() string {
+	return "AppointmentResponse"
+}
+
+// GetResourceType returns "AuditEvent"
+func (*AuditEvent) GetResourceType() string {
+	return "AuditEvent"
+}
+
+// GetResourceType returns "Basic"
+func (*Basic) GetResourceType() string {
+	return "Basic"
+}
+
+// GetResourceType returns "Binary"
+func (*Binary) GetResourceType() string {
+	return "Binary"
+}
+
+// GetResourceType returns "BodySite"
+func (*BodySite) GetResourceType() string {
+	return "BodySite"
+}
+
+// GetResourceType returns "Bundle"
+func (*Bundle) GetResourceType() string {
+	return "Bundle"
+}
+
+// GetResourceType returns "CapabilityStatement"
+func (*CapabilityStatement) GetResourceType() string {
+	return "CapabilityStatement"
+}
+
+// GetResourceType returns "CarePlan"
+func (*CarePlan) GetResourceType() string {
+	return "CarePlan"
+}
+
+// GetResourceType returns "CareTeam"
+func (*CareTeam) GetResourceType() string {
+	return "CareTeam"
+}
+
+// GetResourceType returns "ChargeItem"
+func (*ChargeItem) GetResourceType() string {
+	return "ChargeItem"
+}
+
+// GetResourceType returns "Claim"
+func (*Claim) GetResourceType() string {
+	return "Claim"
+}
+
+// GetResourceType returns "ClaimResponse"
+func (*ClaimResponse) GetResourceType() string {
+	return "ClaimResponse"
+}
+
+// GetResourceType returns "ClinicalImpression"
+func (*ClinicalImpression) GetResourceType() string {
+	return "ClinicalImpression"
+}
+
+// GetResourceType returns "CodeSystem"
+func (*CodeSystem) GetResourceType() string {
+	return "CodeSystem"
+}
+
+// GetResourceType returns "Communication"
+func (*Communication) GetResourceType() string {
+	return "Communication"
+}
+
+// GetResourceType returns "CommunicationRequest"
+func (*CommunicationRequest) GetResourceType() string {
+	return "CommunicationRequest"
+}
+
+// GetResourceType returns "CompartmentDefinition"
+func (*CompartmentDefinition) GetResourceType() string {
+	return "CompartmentDefinition"
+}
+
+// GetResourceType returns "Composition"
+func (*Composition) GetResourceType() string {
+	return "Composition"
+}
+
+// GetResourceType returns "ConceptMap"
+func (*ConceptMap) GetResourceType() string {
+	return "ConceptMap"
+}
+
+// GetResourceType returns "Condition"
+func (*Condition) GetResourceType() string {
+	return "Condition"
+}
+
+// GetResourceType returns "Consent"
+func (*Consent) GetResourceType() string {
+	return "Consent"
+}
+
+// GetResourceType returns "Contract"
+func (*Contract) GetResourceType() string {
+	return "Contract"
+}
+
+// GetResourceType returns "Coverage"
+func (*Coverage) GetResourceType() string {
+	return "Coverage"
+}
+
+// GetResourceType returns "DataElement"
+func (*DataElement) GetResourceType() string {
+	return "DataElement"
+}
+
+// GetResourceType returns "DetectedIssue"
+func (*DetectedIssue) GetResourceType() string {
+	return "DetectedIssue"
+}
+
+// GetResourceType returns "Device"
+func (*Device) GetResourceType() string {
+	return "Device"
+}
+
+// GetResourceType returns "DeviceComponent"
+func (*DeviceComponent) GetResourceType() string {
+	return "DeviceComponent"
+}
+
+// GetResourceType returns "DeviceMetric"
+func (*DeviceMetric) GetResourceType() string {
+	return "DeviceMetric"
+}
+
+// GetResourceType returns "DeviceRequest"
+func (*DeviceRequest) GetResourceType() string {
+	return "DeviceRequest"
+}
+
+// GetResourceType returns "DeviceUseStatement"
+func (*DeviceUseStatement) GetResourceType() string {
+	return "DeviceUseStatement"
+}
+
+// GetResourceType returns "DiagnosticReport"
+func (*DiagnosticReport) GetResourceType() string {
+	return "DiagnosticReport"
+}
+
+// GetResourceType returns "DocumentManifest"
+func (*DocumentManifest) GetResourceType() string {
+	return "DocumentManifest"
+}
+
+// GetResourceType returns "DocumentReference"
+func (*DocumentReference) GetResourceType() string {
+	return "DocumentReference"
+}
+
+// GetResourceType returns "EligibilityRequest"
+func (*EligibilityRequest) GetResourceType() string {
+	return "EligibilityRequest"
+}
+
+// GetResourceType returns "EligibilityResponse"
+func (*EligibilityResponse) GetResourceType() string {
+	return "EligibilityResponse"
+}
+
+// GetResourceType returns "Encounter"
+func (*Encounter) GetResourceType() string {
+	return "Encounter"
+}
+
+// GetResourceType returns "Endpoint"
+func (*Endpoint) GetResourceType() string {
+	return "Endpoint"
+}
+
+// GetResourceType returns "EnrollmentRequest"
+func (*EnrollmentRequest) GetResourceType() string {
+	return "EnrollmentRequest"
+}
+
+// GetResourceType returns "EnrollmentResponse"
+func (*EnrollmentResponse) GetResourceType() string {
+	return "EnrollmentResponse"
+}
+
+// GetResourceType returns "EpisodeOfCare"
+func (*EpisodeOfCare) GetResourceType() string {
+	return "EpisodeOfCare"
+}
+
+// GetResourceType returns "ExpansionProfile"
+func (*ExpansionProfile) GetResourceType() string {
+	return "ExpansionProfile"
+}
+
+// GetResourceType returns "ExplanationOfBenefit"
+func (*ExplanationOfBenefit) GetResourceType() string {
+	return "ExplanationOfBenefit"
+}
+
+// GetResourceType returns "FamilyMemberHistory"
+func (*FamilyMemberHistory) GetResourceType() string {
+	return "FamilyMemberHistory"
+}
+
+// GetResourceType returns "Flag"
+func (*Flag) GetResourceType() string {
+	return "Flag"
+}
+
+// GetResourceType returns "Goal"
+func (*Goal) GetResourceType() string {
+	return "Goal"
+}
+
+// GetResourceType returns "GraphDefinition"
+func (*GraphDefinition) GetResourceType() string {
+	return "GraphDefinition"
+}
+
+// GetResourceType returns "Group"
+func (*Group) GetResourceType() string {
+	return "Group"
+}
+
+// GetResourceType returns "GuidanceResponse"
+func (*GuidanceResponse) GetResourceType() string {
+	return "GuidanceResponse"
+}
+
+// GetResourceType returns "HealthcareService"
+func (*HealthcareService) GetResourceType() string {
+	return "HealthcareService"
+}
+
+// GetResourceType returns "ImagingManifest"
+func (*ImagingManifest) GetResourceType() string {
+	return "ImagingManifest"
+}
+
+// GetResourceType returns "ImagingStudy"
+func (*ImagingStudy) GetResourceType() string {
+	return "ImagingStudy"
+}
+
+// GetResourceType returns "Immunization"
+func (*Immunization) GetResourceType() string {
+	return "Immunization"
+}
+
+// GetResourceType returns "ImmunizationRecommendation"
+func (*ImmunizationRecommendation) GetResourceType() string {
+	return "ImmunizationRecommendation"
+}
+
+// GetResourceType returns "ImplementationGuide"
+func (*ImplementationGuide) GetResourceType() string {
+	return "ImplementationGuide"
+}
+
+// GetResourceType returns "Library"
+func (*Library) GetResourceType() string {
+	return "Library"
+}
+
+// GetResourceType returns "Linkage"
+func (*Linkage) GetResourceType() string {
+	return "Linkage"
+}
+
+// GetResourceType returns "List"
+func (*List) GetResourceType() string {
+	return "List"
+}
+
+// GetResourceType returns "Location"
+func (*Location) GetResourceType() string {
+	return "Location"
+}
+
+// GetResourceType returns "Measure"
+func (*Measure) GetResourceType() string {
+	return "Measure"
+}
+
+// GetResourceType returns "MeasureReport"
+func (*MeasureReport) GetResourceType() string {
+	return "MeasureReport"
+}
+
+// GetResourceType returns "Media"
+func (*Media) GetResourceType() string {
+	return "Media"
+}
+
+// GetResourceType returns "Medication"
+func (*Medication) GetResourceType() string {
+	return "Medication"
+}
+
+// GetResourceType returns "MedicationAdministration"
+func (*MedicationAdministration) GetResourceType() string {
+	return "MedicationAdministration"
+}
+
+// GetResourceType returns "MedicationDispense"
+func (*MedicationDispense) GetResourceType() string {
+	return "MedicationDispense"
+}
+
+// GetResourceType returns "MedicationRequest"
+func (*MedicationRequest) GetResourceType() string {
+	return "MedicationRequest"
+}
+
+// GetResourceType returns "MedicationStatement"
+func (*MedicationStatement) GetResourceType() string {
+	return "MedicationStatement"
+}
+
+// GetResourceType returns "MessageDefinition"
+func (*MessageDefinition) GetResourceType() string {
+	return "MessageDefinition"
+}
+
+// GetResourceType returns "MessageHeader"
+func (*MessageHeader) GetResourceType() string {
+	return "MessageHeader"
+}
+
+// GetResourceType returns "NamingSystem"
+func (*NamingSystem) GetResourceType() string {
+	return "NamingSystem"
+}
+
+// GetResourceType returns "NutritionOrder"
+func (*NutritionOrder) GetResourceType() string {
+	return "NutritionOrder"
+}
+
+// GetResourceType returns "Observation"
+func (*Observation) GetResourceType() string {
+	return "Observation"
+}
+
+// GetResourceType returns "OperationDefinition"
+func (*OperationDefinition) GetResourceType() string {
+	return "OperationDefinition"
+}
+
+// GetResourceType returns "OperationOutcome"
+func (*OperationOutcome) GetResourceType() string {
+	return "OperationOutcome"
+}
+
+// GetResourceType returns "Organization"
+func (*Organization) GetResourceType() string {
+	return "Organization"
+}
+
+// GetResourceType returns "Parameters"
+func (*Parameters) GetResourceType() string {
+	return "Parameters"
+}
+
+// GetResourceType returns "Patient"
+func (*Patient) GetResourceType() string {
+	return "Patient"
+}
+
+// GetResourceType returns "PaymentNotice"
+func (*PaymentNotice) GetResourceType() string {
+	return "PaymentNotice"
+}
+
+// GetResourceType returns "PaymentReconciliation"
+func (*PaymentReconciliation) GetResourceType() string {
+	return "PaymentReconciliation"
+}
+
+// GetResourceType returns "Person"
+func (*Person) GetResourceType() string {
+	return "Person"
+}
+
+// GetResourceType returns "PlanDefinition"
+func (*PlanDefinition) GetResourceType() string {
+	return "PlanDefinition"
+}
+
+// GetResourceType returns "Practitioner"
+func (*Practitioner) GetResourceType() string {
+	return "Practitioner"
+}
+
+// GetResourceType returns "PractitionerRole"
+func (*PractitionerRole) GetResourceType() string {
+	return "PractitionerRole"
+}
+
+// GetResourceType returns "Procedure"
+func (*Procedure) GetResourceType() string {
+	return "Procedure"
+}
+
+// GetResourceType returns "ProcedureRequest"
+func (*ProcedureRequest) GetResourceType() string {
+	return "ProcedureRequest"
+}
+
+// GetResourceType returns "ProcessRequest"
+func (*ProcessRequest) GetResourceType() string {
+	return "ProcessRequest"
+}
+
+// GetResourceType returns "ProcessResponse"
+func (*ProcessResponse) GetResourceType() string {
+	return "ProcessResponse"
+}
+
+// GetResourceType returns "Provenance"
+func (*Provenance) GetResourceType() string {
+	return "Provenance"
+}
+
+// GetResourceType returns "Questionnaire"
+func (*Questionnaire) GetResourceType() string {
+	return "Questionnaire"
+}
+
+// GetResourceType returns "QuestionnaireResponse"
+func (*QuestionnaireResponse) GetResourceType() string {
+	return "QuestionnaireResponse"
+}
+
+// GetResourceType returns "ReferralRequest"
+func (*ReferralRequest) GetResourceType() string {
+	return "ReferralRequest"
+}
+
+// GetResourceType returns "RelatedPerson"
+func (*RelatedPerson) GetResourceType() string {
+	return "RelatedPerson"
+}
+
+// GetResourceType returns "RequestGroup"
+func (*RequestGroup) GetResourceType() string {
+	return "RequestGroup"
+}
+
+// GetResourceType returns "ResearchStudy"
+func (*ResearchStudy) GetResourceType() string {
+	return "ResearchStudy"
+}
+
+// GetResourceType returns "ResearchSubject"
+func (*ResearchSubject) GetResourceType() string {
+	return "ResearchSubject"
+}
+
+// GetResourceType returns "RiskAssessment"
+func (*RiskAssessment) GetResourceType() string {
+	return "RiskAssessment"
+}
+
+// GetResourceType returns "Schedule"
+func (*Schedule) GetResourceType() string {
+	return "Schedule"
+}
+
+// GetResourceType returns "SearchParameter"
+func (*SearchParameter) GetResourceType() string {
+	return "SearchParameter"
+}
+
+// GetResourceType returns "Sequence"
+func (*Sequence) GetResourceType() string {
+	return "Sequence"
+}
+
+// GetResourceType returns "ServiceDefinition"
+func (*ServiceDefinition) GetResourceType() string {
+	return "ServiceDefinition"
+}
+
+// GetResourceType returns "Slot"
+func (*Slot) GetResourceType() string {
+	return "Slot"
+}
+
+// GetResourceType returns "Specimen"
+func (*Specimen) GetResourceType() string {
+	return "Specimen"
+}
+
+// GetResourceType returns "StructureDefinition"
+func (*StructureDefinition) GetResourceType() string {
+	return "StructureDefinition"
+}
+
+// GetResourceType returns "StructureMap"
+func (*StructureMap) GetResourceType() string {
+	return "StructureMap"
+}
+
+// GetResourceType returns "Subscription"
+func (*Subscription) GetResourceType() string {
+	return "Subscription"
+}
+
+// GetResourceType returns "Substance"
+func (*Substance) GetResourceType() string {
+	return "Substance"
+}
+
+// GetResourceType returns "SupplyDelivery"
+func (*SupplyDelivery) GetResourceType() string {
+	return "SupplyDelivery"
+}
+
+// GetResourceType returns "SupplyRequest"
+func (*SupplyRequest) GetResourceType() string {
+	return "SupplyRequest"
+}
+
+// GetResourceType returns "Task"
+func (*Task) GetResourceType() string {
+	return "Task"
+}
+
+// GetResourceType returns "TestReport"
+func (*TestReport) GetResourceType() string {
+	return "TestReport"
+}
+
+// GetResourceType returns "TestScript"
+func (*TestScript) GetResourceType() string {
+	return "TestScript"
+}
+
+// GetResourceType returns "ValueSet"
+func (*ValueSet) GetResourceType() string {
+	return "ValueSet"
+}
+
+// GetResourceType returns "VisionPrescription"
+func (*VisionPrescription) GetResourceType() string {
+	return "VisionPrescription"
+}
@@ -0,0 +1,106 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RiskAssessment represents potential outcomes for a subject with likelihood
+type RiskAssessment struct {
+	DomainResource
+
+	// Request fulfilled by this assessment
+	BasedOn *common.Reference `json:"basedOn,omitempty"`
+
+	// Information used in assessment
+	Basis []common.Reference `json:"basis,omitempty"`
+
+	// Type of assessment
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Additional comments about the risk assessment
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Condition assessed
+	Condition *common.Reference `json:"condition,omitempty"`
+
+	// The encounter where the assessment was performed
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Unique identifier for the assessment
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Evaluation mechanism
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// How to reduce risk
+	Mitigation        *string         `json:"mitigation,omitempty"`
+	MitigationElement *common.Element `json:"_mitigation,omitempty"`
+
+	// When was assessment made?
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+
+	// Part of this occurrence
+	Parent *common.Reference `json:"parent,omitempty"`
+
+	// Who did assessment?
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Outcome predicted
+	Prediction []RiskAssessmentPrediction `json:"prediction,omitempty"`
+
+	// The reason the risk assessment was performed
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// registered | preliminary | final | amended +
+	Status        RiskAssessmentStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Who/what does assessment apply to?
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// RiskAssessmentStatus represents the status of a risk assessment
+type RiskAssessmentStatus string
+
+const (
+	RiskAssessmentStatusRegistered     RiskAssessmentStatus = "registered"
+	RiskAssessmentStatusPreliminary    RiskAssessmentStatus = "preliminary"
+	RiskAssessmentStatusFinal          RiskAssessmentStatus = "final"
+	RiskAssessmentStatusAmended        RiskAssessmentStatus = "amended"
+	RiskAssessmentStatusCorrected      RiskAssessmentStatus = "corrected"
+	RiskAssessmentStatusCancelled      RiskAssessmentStatus = "cancelled"
+	RiskAssessmentStatusEnteredInError RiskAssessmentStatus = "entered-in-error"
+	RiskAssessmentStatusUnknown        RiskAssessmentStatus = "unknown"
+)
+
+// RiskAssessmentPrediction represents outcome predicted
+type RiskAssessmentPrediction struct {
+	common.BackboneElement
+
+	// Possible outcome for the subject
+	Outcome *common.CodeableConcept `json:"outcome"`
+
+	// Likelihood of specified outcome
+	ProbabilityDecimal        *common.Decimal `json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement *common.Element `json:"_probabilityDecimal,omitempty"`
+	ProbabilityRange          *Range          `json:"probabilityRange,omitempty"`
+
+	// Likelihood of specified outcome as a qualitative value
+	QualitativeRisk *common.CodeableConcept `json:"qualitativeRisk,omitempty"`
+
+	// Explanation of prediction
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
+
+	// Relative likelihood
+	RelativeRisk        *common.Decimal `json:"relativeRisk,omitempty"`
+	RelativeRiskElement *common.Element `json:"_relativeRisk,omitempty"`
+
+	// Timeframe or age range
+	WhenPeriod *common.Period `json:"whenPeriod,omitempty"`
+	WhenRange  *Range         `json:"whenRange,omitempty"`
+}
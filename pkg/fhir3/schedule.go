@@ -0,0 +1,36 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Schedule represents a container for slots of time that may be available for booking appointments
+type Schedule struct {
+	DomainResource
+
+	// Whether this schedule is in active use
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// Resource(s) that availability information is being provided for
+	Actor []common.Reference `json:"actor"`
+
+	// Comments on availability
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Period of time covered by schedule
+	PlanningHorizon *common.Period `json:"planningHorizon,omitempty"`
+
+	// High-level category
+	ServiceCategory *common.CodeableConcept `json:"serviceCategory,omitempty"`
+
+	// Specific service
+	ServiceType []common.CodeableConcept `json:"serviceType,omitempty"`
+
+	// Type of specialty needed
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+}
@@ -0,0 +1,180 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SearchParameter represents search parameter for a resource
+type SearchParameter struct {
+	DomainResource
+
+	// The resource type(s) this search parameter applies to
+	Base        []string          `json:"base"`
+	BaseElement []*common.Element `json:"_base,omitempty"`
+
+	// Chained names supported
+	Chain        []string          `json:"chain,omitempty"`
+	ChainElement []*common.Element `json:"_chain,omitempty"`
+
+	// Recommended name for parameter in search url
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// eq | ne | gt | lt | ge | le | sa | eb | ap
+	Comparator        []SearchParameterComparator `json:"comparator,omitempty"`
+	ComparatorElement []*common.Element           `json:"_comparator,omitempty"`
+
+	// For Composite resources to define the parts
+	Component []SearchParameterComponent `json:"component,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Original definition for the search parameter
+	DerivedFrom        *string         `json:"derivedFrom,omitempty"`
+	DerivedFromElement *common.Element `json:"_derivedFrom,omitempty"`
+
+	// Natural language description of the search parameter
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// FHIRPath expression that extracts the values
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// Intended jurisdiction for search parameter (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// missing | exact | contains | not | text | in | not-in | below | above | type | identifier | of-type | code-text | text-advanced | iterate
+	Modifier        []SearchParameterModifier `json:"modifier,omitempty"`
+	ModifierElement []*common.Element         `json:"_modifier,omitempty"`
+
+	// Name for this search parameter (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this search parameter is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        SearchParameterStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// Types of resource (if a resource reference)
+	Target        []string          `json:"target,omitempty"`
+	TargetElement []*common.Element `json:"_target,omitempty"`
+
+	// number | date | string | token | reference | composite | quantity | uri | special
+	Type        SearchParameterType `json:"type"`
+	TypeElement *common.Element     `json:"_type,omitempty"`
+
+	// Canonical identifier for this search parameter, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the search parameter
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+
+	// Note that the elements returned by the XPath are sometimes complex elements where logic is required to determine quite how to handle them; e.g. CodeableConcepts may contain text and/or multiple codings, where the codings themselves contain a code and a system
+	Xpath        *string         `json:"xpath,omitempty"`
+	XpathElement *common.Element `json:"_xpath,omitempty"`
+
+	// How the search parameter relates to the set of elements returned by evaluating the xpath query
+	XpathUsage        *SearchParameterXpathUsage `json:"xpathUsage,omitempty"`
+	XpathUsageElement *common.Element            `json:"_xpathUsage,omitempty"`
+}
+
+// SearchParameterComparator represents the comparator of a search parameter
+type SearchParameterComparator string
+
+const (
+	SearchParameterComparatorEq SearchParameterComparator = "eq"
+	SearchParameterComparatorNe SearchParameterComparator = "ne"
+	SearchParameterComparatorGt SearchParameterComparator = "gt"
+	SearchParameterComparatorLt SearchParameterComparator = "lt"
+	SearchParameterComparatorGe SearchParameterComparator = "ge"
+	SearchParameterComparatorLe SearchParameterComparator = "le"
+	SearchParameterComparatorSa SearchParameterComparator = "sa"
+	SearchParameterComparatorEb SearchParameterComparator = "eb"
+	SearchParameterComparatorAp SearchParameterComparator = "ap"
+)
+
+// SearchParameterModifier represents the modifier of a search parameter
+type SearchParameterModifier string
+
+const (
+	SearchParameterModifierMissing  SearchParameterModifier = "missing"
+	SearchParameterModifierExact    SearchParameterModifier = "exact"
+	SearchParameterModifierContains SearchParameterModifier = "contains"
+	SearchParameterModifierNot      SearchParameterModifier = "not"
+	SearchParameterModifierText     SearchParameterModifier = "text"
+	SearchParameterModifierIn       SearchParameterModifier = "in"
+	SearchParameterModifierNotIn    SearchParameterModifier = "not-in"
+	SearchParameterModifierBelow    SearchParameterModifier = "below"
+	SearchParameterModifierAbove    SearchParameterModifier = "above"
+	SearchParameterModifierType     SearchParameterModifier = "type"
+)
+
+// SearchParameterStatus represents the status of a search parameter
+type SearchParameterStatus string
+
+const (
+	SearchParameterStatusDraft   SearchParameterStatus = "draft"
+	SearchParameterStatusActive  SearchParameterStatus = "active"
+	SearchParameterStatusRetired SearchParameterStatus = "retired"
+	SearchParameterStatusUnknown SearchParameterStatus = "unknown"
+)
+
+// SearchParameterType represents the type of a search parameter
+type SearchParameterType string
+
+const (
+	SearchParameterTypeNumber    SearchParameterType = "number"
+	SearchParameterTypeDate      SearchParameterType = "date"
+	SearchParameterTypeString    SearchParameterType = "string"
+	SearchParameterTypeToken     SearchParameterType = "token"
+	SearchParameterTypeReference SearchParameterType = "reference"
+	SearchParameterTypeComposite SearchParameterType = "composite"
+	SearchParameterTypeQuantity  SearchParameterType = "quantity"
+	SearchParameterTypeUri       SearchParameterType = "uri"
+)
+
+// SearchParameterXpathUsage represents the xpath usage of a search parameter
+type SearchParameterXpathUsage string
+
+const (
+	SearchParameterXpathUsageNormal   SearchParameterXpathUsage = "normal"
+	SearchParameterXpathUsagePhonetic SearchParameterXpathUsage = "phonetic"
+	SearchParameterXpathUsageNearby   SearchParameterXpathUsage = "nearby"
+	SearchParameterXpathUsageDistance SearchParameterXpathUsage = "distance"
+	SearchParameterXpathUsageOther    SearchParameterXpathUsage = "other"
+)
+
+// SearchParameterComponent represents For Composite resources to define the parts
+type SearchParameterComponent struct {
+	common.BackboneElement
+
+	// Defines how the part works
+	Definition *common.Reference `json:"definition"`
+
+	// Subexpression relative to main expression
+	Expression        string          `json:"expression"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+}
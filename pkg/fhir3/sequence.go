@@ -0,0 +1,228 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Sequence represents raw data describing a biological sequence
+type Sequence struct {
+	DomainResource
+
+	// Whether the sequence is numbered starting at 0
+	CoordinateSystem        int             `json:"coordinateSystem"`
+	CoordinateSystemElement *common.Element `json:"_coordinateSystem,omitempty"`
+
+	// The method for sequencing, for example, chip information
+	Device *common.Reference `json:"device,omitempty"`
+
+	// A unique identifier for this particular sequence instance
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Sequence that was observed
+	ObservedSeq        *string         `json:"observedSeq,omitempty"`
+	ObservedSeqElement *common.Element `json:"_observedSeq,omitempty"`
+
+	// The patient whose sequencing results are described by this resource
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// The organization or lab that should be responsible for this result
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Pointer to next atomic sequence which at most contains one variant
+	Pointer []common.Reference `json:"pointer,omitempty"`
+
+	// An experimental feature attribute that defines the quality of the feature in a quantitative way
+	Quality []SequenceQuality `json:"quality,omitempty"`
+
+	// The number of copies of the seqeunce of interest
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Coverage (read depth or depth) is the average number of reads representing a given nucleotide in the reconstructed sequence
+	ReadCoverage        *int            `json:"readCoverage,omitempty"`
+	ReadCoverageElement *common.Element `json:"_readCoverage,omitempty"`
+
+	// A sequence that is used as a reference to describe variants that are present in a sequence analyzed
+	ReferenceSeq *SequenceReferenceSeq `json:"referenceSeq,omitempty"`
+
+	// Configurations of the external repository
+	Repository []SequenceRepository `json:"repository,omitempty"`
+
+	// Specimen used for sequencing
+	Specimen *common.Reference `json:"specimen,omitempty"`
+
+	// Amino Acid Sequence/ DNA Sequence / RNA Sequence
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// The definition of variant here originates from Sequence ontology (variant_of)
+	Variant []SequenceVariant `json:"variant,omitempty"`
+}
+
+// SequenceReferenceSeq represents a sequence that is used as a reference to describe variants that are present in a sequence analyzed
+type SequenceReferenceSeq struct {
+	common.BackboneElement
+
+	// Structural unit composed of a nucleic acid molecule which controls its own replication through the interaction of specific proteins at one or more origins of replication (SO:0000340)
+	Chromosome *common.CodeableConcept `json:"chromosome,omitempty"`
+
+	// The Genome Build used for reference, following GRCh build versions e.g. 'GRCh 37'
+	GenomeBuild        *string         `json:"genomeBuild,omitempty"`
+	GenomeBuildElement *common.Element `json:"_genomeBuild,omitempty"`
+
+	// Reference identifier of reference sequence submitted to NCBI
+	ReferenceSeqId *common.CodeableConcept `json:"referenceSeqId,omitempty"`
+
+	// A Pointer to another Sequence entity as reference sequence
+	ReferenceSeqPointer *common.Reference `json:"referenceSeqPointer,omitempty"`
+
+	// A string like "ACGT"
+	ReferenceSeqString        *string         `json:"referenceSeqString,omitempty"`
+	ReferenceSeqStringElement *common.Element `json:"_referenceSeqString,omitempty"`
+
+	// Directionality of DNA sequence
+	Strand        *int            `json:"strand,omitempty"`
+	StrandElement *common.Element `json:"_strand,omitempty"`
+
+	// End position of the window on the reference sequence
+	WindowEnd        int             `json:"windowEnd"`
+	WindowEndElement *common.Element `json:"_windowEnd,omitempty"`
+
+	// Start position of the window on the reference sequence
+	WindowStart        int             `json:"windowStart"`
+	WindowStartElement *common.Element `json:"_windowStart,omitempty"`
+}
+
+// SequenceVariant represents the variant of a sequence
+type SequenceVariant struct {
+	common.BackboneElement
+
+	// Extended CIGAR string for aligning the sequence with reference bases
+	Cigar        *string         `json:"cigar,omitempty"`
+	CigarElement *common.Element `json:"_cigar,omitempty"`
+
+	// End position of the variant on the reference sequence.If the coordinate system is 0-based then end is is exclusive and does not include the last position
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// An allele is one of a set of coexisting sequence variants of a gene (SO:0001023)
+	ObservedAllele        *string         `json:"observedAllele,omitempty"`
+	ObservedAlleleElement *common.Element `json:"_observedAllele,omitempty"`
+
+	// An allele is one of a set of coexisting sequence variants of a gene (SO:0001023)
+	ReferenceAllele        *string         `json:"referenceAllele,omitempty"`
+	ReferenceAlleleElement *common.Element `json:"_referenceAllele,omitempty"`
+
+	// Start position of the variant on the reference sequence.If the coordinate system is either 0-based or 1-based
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// A pointer to an Observation containing variant information
+	VariantPointer *common.Reference `json:"variantPointer,omitempty"`
+}
+
+// SequenceQuality represents an experimental feature attribute that defines the quality of the feature in a quantitative way
+type SequenceQuality struct {
+	common.BackboneElement
+
+	// End position of the sequence.If the coordinate system is 0-based then end is is exclusive and does not include the last position
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// Harmonic mean of Recall and Precision, computed as: 2 * precision * recall / (precision + recall)
+	FScore        *common.Decimal `json:"fScore,omitempty"`
+	FScoreElement *common.Element `json:"_fScore,omitempty"`
+
+	// The number of false positives where the non-REF alleles in the Truth and Query Call Sets match
+	GtFP        *common.Decimal `json:"gtFP,omitempty"`
+	GtFPElement *common.Element `json:"_gtFP,omitempty"`
+
+	// Which method is used to get sequence quality
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// QUERY.TP / (QUERY.TP + QUERY.FP)
+	Precision        *common.Decimal `json:"precision,omitempty"`
+	PrecisionElement *common.Element `json:"_precision,omitempty"`
+
+	// False positives, i.e. the number of sites in the Query Call Set for which there is no path through the Truth Call Set that is consistent with this site
+	QueryFP        *common.Decimal `json:"queryFP,omitempty"`
+	QueryFPElement *common.Element `json:"_queryFP,omitempty"`
+
+	// True positives, from the perspective of the query data
+	QueryTP        *common.Decimal `json:"queryTP,omitempty"`
+	QueryTPElement *common.Element `json:"_queryTP,omitempty"`
+
+	// TRUTH.TP / (TRUTH.TP + TRUTH.FN)
+	Recall        *common.Decimal `json:"recall,omitempty"`
+	RecallElement *common.Element `json:"_recall,omitempty"`
+
+	// The score of an experimentally derived feature such as a p-value (SO:0001685)
+	Score *common.Quantity `json:"score,omitempty"`
+
+	// Gold standard sequence used for comparing against
+	StandardSequence *common.CodeableConcept `json:"standardSequence,omitempty"`
+
+	// Start position of the sequence
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// False negatives, i.e. the number of sites in the Truth Call Set for which there is no path through the Query Call Set that is consistent with all of the alleles at this site, or sites for which there is an inaccurate genotype call for the event
+	TruthFN        *common.Decimal `json:"truthFN,omitempty"`
+	TruthFNElement *common.Element `json:"_truthFN,omitempty"`
+
+	// True positives, from the perspective of the truth data
+	TruthTP        *common.Decimal `json:"truthTP,omitempty"`
+	TruthTPElement *common.Element `json:"_truthTP,omitempty"`
+
+	// INDEL / SNP / Undefined variant
+	Type        SequenceQualityType `json:"type"`
+	TypeElement *common.Element     `json:"_type,omitempty"`
+}
+
+// SequenceQualityType represents the type of a sequence quality
+type SequenceQualityType string
+
+const (
+	SequenceQualityTypeIndel   SequenceQualityType = "indel"
+	SequenceQualityTypeSnp     SequenceQualityType = "snp"
+	SequenceQualityTypeUnknown SequenceQualityType = "unknown"
+)
+
+// SequenceRepository represents configurations of the external repository
+type SequenceRepository struct {
+	common.BackboneElement
+
+	// Id of the variant in this external repository
+	DatasetId        *string         `json:"datasetId,omitempty"`
+	DatasetIdElement *common.Element `json:"_datasetId,omitempty"`
+
+	// URI of an external repository which contains further details about the genetics data
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Id of the read in this external repository
+	ReadsetId        *string         `json:"readsetId,omitempty"`
+	ReadsetIdElement *common.Element `json:"_readsetId,omitempty"`
+
+	// Click and see / RESTful API / Need login to see / RESTful API with authentication / Other ways to see resource
+	Type        SequenceRepositoryType `json:"type"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
+
+	// URI of an external repository which contains further details about the genetics data
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// Id of the variantset in this external repository
+	VariantsetId        *string         `json:"variantsetId,omitempty"`
+	VariantsetIdElement *common.Element `json:"_variantsetId,omitempty"`
+}
+
+// SequenceRepositoryType represents the type of a sequence repository
+type SequenceRepositoryType string
+
+const (
+	SequenceRepositoryTypeDirectlink SequenceRepositoryType = "directlink"
+	SequenceRepositoryTypeOpenapi    SequenceRepositoryType = "openapi"
+	SequenceRepositoryTypeLogin      SequenceRepositoryType = "login"
+	SequenceRepositoryTypeOauth      SequenceRepositoryType = "oauth"
+	SequenceRepositoryTypeOther      SequenceRepositoryType = "other"
+)
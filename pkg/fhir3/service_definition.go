@@ -0,0 +1,109 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ServiceDefinition describes a unit of decision support functionality that is made available as a service
+type ServiceDefinition struct {
+	DomainResource
+
+	// The 'date' element may be more recent than the approval date because of minor changes / editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A contributor to the content of the module, including authors, editors, reviewers, and endorsers
+	Contributor []Contributor `json:"contributor,omitempty"`
+
+	// A copyright statement relating to the service definition and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Data requirements are a machine processable description of the data required by the module in order to perform a successful evaluation
+	DataRequirement []common.DataRequirement `json:"dataRequirement,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the service definition was built, comments about misuse
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The effective period for a service definition determines when the content is applicable for usage and is independent of publication and review dates
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// Allows filtering of service definition that are appropriate for use vs
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type, e.g.
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// It may be possible for the service definition to be used in jurisdictions other than those for which it was originally designed or intended
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// If specified, this is usually after the approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A reference to the operation that is used to invoke this service
+	OperationDefinition *common.Reference `json:"operationDefinition,omitempty"`
+
+	// Usually an organization, but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the service definition Instead it provides traceability of ''why'' the resource is either needed or ''why'' it is defined as it is
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Each related resource is either an attachment, or a reference to another resource, but not both
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// Allows filtering of service definitions that are appropriate for use vs
+	Status        ServiceDefinitionStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Descriptive topics related to the module
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// The trigger element defines when the rule should be invoked
+	Trigger []common.TriggerDefinition `json:"trigger,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid:, but real http: addresses are preferred
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// A detailed description of how the module is used from a clinical perspective
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation whether all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different service definition instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ServiceDefinitionStatus represents the status of a service definition
+type ServiceDefinitionStatus string
+
+const (
+	ServiceDefinitionStatusDraft   ServiceDefinitionStatus = "draft"
+	ServiceDefinitionStatusActive  ServiceDefinitionStatus = "active"
+	ServiceDefinitionStatusRetired ServiceDefinitionStatus = "retired"
+	ServiceDefinitionStatusUnknown ServiceDefinitionStatus = "unknown"
+)
@@ -0,0 +1,188 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// StructureDefinition represents Structural Definition
+type StructureDefinition struct {
+	DomainResource
+
+	// Whether the structure is abstract
+	Abstract        bool            `json:"abstract"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
+
+	// Definition that this type is constrained/specialized from
+	BaseDefinition        *string         `json:"baseDefinition,omitempty"`
+	BaseDefinitionElement *common.Element `json:"_baseDefinition,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// If an extension, where it can be used in instances
+	Context        []string          `json:"context,omitempty"`
+	ContextElement []*common.Element `json:"_context,omitempty"`
+
+	// FHIRPath invariants - when the extension can be used
+	ContextInvariant        []string          `json:"contextInvariant,omitempty"`
+	ContextInvariantElement []*common.Element `json:"_contextInvariant,omitempty"`
+
+	// If this is an extension, Identifies the context within FHIR resources where the extension can be used
+	ContextType        *StructureDefinitionContextType `json:"contextType,omitempty"`
+	ContextTypeElement *common.Element                 `json:"_contextType,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// specialization | constraint - How relates to base definition
+	Derivation        *StructureDefinitionDerivation `json:"derivation,omitempty"`
+	DerivationElement *common.Element                `json:"_derivation,omitempty"`
+
+	// Natural language description of the structure definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Differential view of the structure
+	Differential *StructureDefinitionDifferential `json:"differential,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// FHIR Version this StructureDefinition targets
+	FhirVersion        *string         `json:"fhirVersion,omitempty"`
+	FhirVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// Additional identifier for the structure definition
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for structure definition (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Assist with indexing and finding
+	Keyword []common.Coding `json:"keyword,omitempty"`
+
+	// primitive-type | complex-type | resource | logical
+	Kind        StructureDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
+
+	// External specification that the content is mapped to
+	Mapping []StructureDefinitionMapping `json:"mapping,omitempty"`
+
+	// Name for this structure definition (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this structure definition is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Snapshot view of the structure
+	Snapshot *StructureDefinitionSnapshot `json:"snapshot,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        StructureDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Name for this structure definition (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Type defined or constrained by this structure
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// Canonical identifier for this structure definition, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the structure definition
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// StructureDefinitionContextType represents the context type of a structure definition
+type StructureDefinitionContextType string
+
+const (
+	StructureDefinitionContextTypeResource  StructureDefinitionContextType = "resource"
+	StructureDefinitionContextTypeDatatype  StructureDefinitionContextType = "datatype"
+	StructureDefinitionContextTypeExtension StructureDefinitionContextType = "extension"
+)
+
+// StructureDefinitionDerivation represents the derivation of a structure definition
+type StructureDefinitionDerivation string
+
+const (
+	StructureDefinitionDerivationSpecialization StructureDefinitionDerivation = "specialization"
+	StructureDefinitionDerivationConstraint     StructureDefinitionDerivation = "constraint"
+)
+
+// StructureDefinitionKind represents the kind of a structure definition
+type StructureDefinitionKind string
+
+const (
+	StructureDefinitionKindPrimitiveType StructureDefinitionKind = "primitive-type"
+	StructureDefinitionKindComplexType   StructureDefinitionKind = "complex-type"
+	StructureDefinitionKindResource      StructureDefinitionKind = "resource"
+	StructureDefinitionKindLogical       StructureDefinitionKind = "logical"
+)
+
+// StructureDefinitionStatus represents the status of a structure definition
+type StructureDefinitionStatus string
+
+const (
+	StructureDefinitionStatusDraft   StructureDefinitionStatus = "draft"
+	StructureDefinitionStatusActive  StructureDefinitionStatus = "active"
+	StructureDefinitionStatusRetired StructureDefinitionStatus = "retired"
+	StructureDefinitionStatusUnknown StructureDefinitionStatus = "unknown"
+)
+
+// StructureDefinitionMapping represents external specification that the content is mapped to
+type StructureDefinitionMapping struct {
+	common.BackboneElement
+
+	// Versions, Issues, Scope limitations etc
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Internal id when this mapping is used
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
+
+	// Names what this mapping refers to
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Identifies what this mapping refers to
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
+}
+
+// StructureDefinitionSnapshot represents snapshot view of the structure
+type StructureDefinitionSnapshot struct {
+	common.BackboneElement
+
+	// Definition of elements in the resource (if no StructureDefinition)
+	Element []ElementDefinition `json:"element"`
+}
+
+// StructureDefinitionDifferential represents differential view of the structure
+type StructureDefinitionDifferential struct {
+	common.BackboneElement
+
+	// Definition of elements in the resource (if no StructureDefinition)
+	Element []ElementDefinition `json:"element"`
+}
@@ -0,0 +1,422 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// StructureMap represents A Map of relationships between 2 structures that can be used to transform data
+type StructureMap struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the structure map
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Named sections for reader convenience
+	Group []StructureMapGroup `json:"group"`
+
+	// Additional identifier for the structure map
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Other maps used by this map (canonical URLs)
+	Import        []string          `json:"import,omitempty"`
+	ImportElement []*common.Element `json:"_import,omitempty"`
+
+	// Intended jurisdiction for structure map (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this structure map (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this structure map is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        StructureMapStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// Structure Definition used by this map
+	Structure []StructureMapStructure `json:"structure,omitempty"`
+
+	// Name for this structure map (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this structure map, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the structure map
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// StructureMapStatus represents the status of a structure map
+type StructureMapStatus string
+
+const (
+	StructureMapStatusDraft   StructureMapStatus = "draft"
+	StructureMapStatusActive  StructureMapStatus = "active"
+	StructureMapStatusRetired StructureMapStatus = "retired"
+	StructureMapStatusUnknown StructureMapStatus = "unknown"
+)
+
+// StructureMapStructure represents Structure Definition used by this map
+type StructureMapStructure struct {
+	common.BackboneElement
+
+	// Name for type in this map
+	Alias        *string         `json:"alias,omitempty"`
+	AliasElement *common.Element `json:"_alias,omitempty"`
+
+	// Documentation on use of structure
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// source | queried | target | produced
+	Mode        StructureMapStructureMode `json:"mode"`
+	ModeElement *common.Element           `json:"_mode,omitempty"`
+
+	// Canonical reference to structure definition
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+}
+
+// StructureMapStructureMode represents the mode of a structure map structure
+type StructureMapStructureMode string
+
+const (
+	StructureMapStructureModeSource   StructureMapStructureMode = "source"
+	StructureMapStructureModeQueried  StructureMapStructureMode = "queried"
+	StructureMapStructureModeTarget   StructureMapStructureMode = "target"
+	StructureMapStructureModeProduced StructureMapStructureMode = "produced"
+)
+
+// StructureMapGroupInput represents named instance provided when invoking the map
+type StructureMapGroupInput struct {
+	common.BackboneElement
+
+	// Documentation for this instance of data
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// source | target
+	Mode        StructureMapGroupInputMode `json:"mode"`
+	ModeElement *common.Element            `json:"_mode,omitempty"`
+
+	// Name for this instance of data
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Type for this instance of data
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// StructureMapGroupInputMode represents the mode of a structure map group input
+type StructureMapGroupInputMode string
+
+const (
+	StructureMapGroupInputModeSource StructureMapGroupInputMode = "source"
+	StructureMapGroupInputModeTarget StructureMapGroupInputMode = "target"
+)
+
+// StructureMapGroupRuleSource represents source inputs to the mapping
+type StructureMapGroupRuleSource struct {
+	common.BackboneElement
+
+	// FHIRPath expression  - must be true or the mapping engine throws an error instead of completing
+	Check        *string         `json:"check,omitempty"`
+	CheckElement *common.Element `json:"_check,omitempty"`
+
+	// FHIRPath expression  - must be true or the rule does not apply
+	Condition        *string         `json:"condition,omitempty"`
+	ConditionElement *common.Element `json:"_condition,omitempty"`
+
+	// Type or variable this rule applies to
+	Context        string          `json:"context"`
+	ContextElement *common.Element `json:"_context,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueBase64Binary        *string                 `json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *common.Element         `json:"_defaultValueBase64Binary,omitempty"`
+	DefaultValueBoolean             *bool                   `json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *common.Element         `json:"_defaultValueBoolean,omitempty"`
+	DefaultValueCode                *string                 `json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *common.Element         `json:"_defaultValueCode,omitempty"`
+	DefaultValueDate                *common.Date            `json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *common.Element         `json:"_defaultValueDate,omitempty"`
+	DefaultValueDateTime            *common.DateTime        `json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *common.Element         `json:"_defaultValueDateTime,omitempty"`
+	DefaultValueDecimal             *common.Decimal         `json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *common.Element         `json:"_defaultValueDecimal,omitempty"`
+	DefaultValueId                  *string                 `json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *common.Element         `json:"_defaultValueId,omitempty"`
+	DefaultValueInstant             *common.Instant         `json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *common.Element         `json:"_defaultValueInstant,omitempty"`
+	DefaultValueInteger             *int                    `json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *common.Element         `json:"_defaultValueInteger,omitempty"`
+	DefaultValueMarkdown            *string                 `json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *common.Element         `json:"_defaultValueMarkdown,omitempty"`
+	DefaultValueOid                 *string                 `json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *common.Element         `json:"_defaultValueOid,omitempty"`
+	DefaultValuePositiveInt         *int                    `json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *common.Element         `json:"_defaultValuePositiveInt,omitempty"`
+	DefaultValueString              *string                 `json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *common.Element         `json:"_defaultValueString,omitempty"`
+	DefaultValueTime                *common.Time            `json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *common.Element         `json:"_defaultValueTime,omitempty"`
+	DefaultValueUnsignedInt         *int                    `json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *common.Element         `json:"_defaultValueUnsignedInt,omitempty"`
+	DefaultValueUri                 *string                 `json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *common.Element         `json:"_defaultValueUri,omitempty"`
+	DefaultValueAddress             *Address                `json:"defaultValueAddress,omitempty"`
+	DefaultValueAge                 *Age                    `json:"defaultValueAge,omitempty"`
+	DefaultValueAnnotation          *Annotation             `json:"defaultValueAnnotation,omitempty"`
+	DefaultValueAttachment          *Attachment             `json:"defaultValueAttachment,omitempty"`
+	DefaultValueCodeableConcept     *common.CodeableConcept `json:"defaultValueCodeableConcept,omitempty"`
+	DefaultValueCoding              *common.Coding          `json:"defaultValueCoding,omitempty"`
+	DefaultValueContactPoint        *ContactPoint           `json:"defaultValueContactPoint,omitempty"`
+	DefaultValueCount               *Count                  `json:"defaultValueCount,omitempty"`
+	DefaultValueDistance            *Distance               `json:"defaultValueDistance,omitempty"`
+	DefaultValueDuration            *Duration               `json:"defaultValueDuration,omitempty"`
+	DefaultValueHumanName           *HumanName              `json:"defaultValueHumanName,omitempty"`
+	DefaultValueIdentifier          *common.Identifier      `json:"defaultValueIdentifier,omitempty"`
+	DefaultValueMoney               *common.Money           `json:"defaultValueMoney,omitempty"`
+	DefaultValuePeriod              *common.Period          `json:"defaultValuePeriod,omitempty"`
+	DefaultValueQuantity            *common.Quantity        `json:"defaultValueQuantity,omitempty"`
+	DefaultValueRange               *Range                  `json:"defaultValueRange,omitempty"`
+	DefaultValueRatio               *Ratio                  `json:"defaultValueRatio,omitempty"`
+	DefaultValueReference           *common.Reference       `json:"defaultValueReference,omitempty"`
+	DefaultValueSampledData         *SampledData            `json:"defaultValueSampledData,omitempty"`
+	DefaultValueSignature           *Signature              `json:"defaultValueSignature,omitempty"`
+	DefaultValueTiming              *Timing                 `json:"defaultValueTiming,omitempty"`
+	DefaultValueMeta                *Meta                   `json:"defaultValueMeta,omitempty"`
+
+	// Optional field for this source
+	Element        *string         `json:"element,omitempty"`
+	ElementElement *common.Element `json:"_element,omitempty"`
+
+	// first | not_first | last | not_last | only_one
+	ListMode        *StructureMapGroupRuleSourceListMode `json:"listMode,omitempty"`
+	ListModeElement *common.Element                      `json:"_listMode,omitempty"`
+
+	// Specified maximum cardinality (number or *)
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Specified minimum cardinality
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Rule only applies if source has this type
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// Named context for field, if a field is specified
+	Variable        *string         `json:"variable,omitempty"`
+	VariableElement *common.Element `json:"_variable,omitempty"`
+}
+
+// StructureMapGroupRuleSourceListMode represents the list mode of a structure map group rule source
+type StructureMapGroupRuleSourceListMode string
+
+const (
+	StructureMapGroupRuleSourceListModeFirst    StructureMapGroupRuleSourceListMode = "first"
+	StructureMapGroupRuleSourceListModeNotFirst StructureMapGroupRuleSourceListMode = "not_first"
+	StructureMapGroupRuleSourceListModeLast     StructureMapGroupRuleSourceListMode = "last"
+	StructureMapGroupRuleSourceListModeNotLast  StructureMapGroupRuleSourceListMode = "not_last"
+	StructureMapGroupRuleSourceListModeOnlyOne  StructureMapGroupRuleSourceListMode = "only_one"
+)
+
+// StructureMapGroupRuleTargetParameter represents parameters to the transform
+type StructureMapGroupRuleTargetParameter struct {
+	common.BackboneElement
+
+	// Parameter value - variable or literal
+	ValueId             *string         `json:"valueId,omitempty"`
+	ValueIdElement      *common.Element `json:"_valueId,omitempty"`
+	ValueString         *string         `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element `json:"_valueString,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+}
+
+// StructureMapGroupRuleTarget represents content to create because of this mapping rule
+type StructureMapGroupRuleTarget struct {
+	common.BackboneElement
+
+	// Variable this rule applies to
+	Context        *string         `json:"context,omitempty"`
+	ContextElement *common.Element `json:"_context,omitempty"`
+
+	// How to interpret the context
+	ContextType        *StructureMapGroupRuleTargetContextType `json:"contextType,omitempty"`
+	ContextTypeElement *common.Element                         `json:"_contextType,omitempty"`
+
+	// Field to create in the context
+	Element        *string         `json:"element,omitempty"`
+	ElementElement *common.Element `json:"_element,omitempty"`
+
+	// first | share | last | single
+	ListMode        []StructureMapGroupRuleTargetListMode `json:"listMode,omitempty"`
+	ListModeElement []*common.Element                     `json:"_listMode,omitempty"`
+
+	// Internal rule reference for shared list items
+	ListRuleId        *string         `json:"listRuleId,omitempty"`
+	ListRuleIdElement *common.Element `json:"_listRuleId,omitempty"`
+
+	// Parameters to the transform
+	Parameter []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty"`
+
+	// create | copy +
+	Transform        *StructureMapGroupRuleTargetTransform `json:"transform,omitempty"`
+	TransformElement *common.Element                       `json:"_transform,omitempty"`
+
+	// Named context for field, if desired, and a field is specified
+	Variable        *string         `json:"variable,omitempty"`
+	VariableElement *common.Element `json:"_variable,omitempty"`
+}
+
+// StructureMapGroupRuleTargetContextType represents the context type of a structure map group rule target
+type StructureMapGroupRuleTargetContextType string
+
+const (
+	StructureMapGroupRuleTargetContextTypeType     StructureMapGroupRuleTargetContextType = "type"
+	StructureMapGroupRuleTargetContextTypeVariable StructureMapGroupRuleTargetContextType = "variable"
+)
+
+// StructureMapGroupRuleTargetListMode represents the list mode of a structure map group rule target
+type StructureMapGroupRuleTargetListMode string
+
+const (
+	StructureMapGroupRuleTargetListModeFirst   StructureMapGroupRuleTargetListMode = "first"
+	StructureMapGroupRuleTargetListModeShare   StructureMapGroupRuleTargetListMode = "share"
+	StructureMapGroupRuleTargetListModeLast    StructureMapGroupRuleTargetListMode = "last"
+	StructureMapGroupRuleTargetListModeCollate StructureMapGroupRuleTargetListMode = "collate"
+)
+
+// StructureMapGroupRuleTargetTransform represents the transform of a structure map group rule target
+type StructureMapGroupRuleTargetTransform string
+
+const (
+	StructureMapGroupRuleTargetTransformCreate    StructureMapGroupRuleTargetTransform = "create"
+	StructureMapGroupRuleTargetTransformCopy      StructureMapGroupRuleTargetTransform = "copy"
+	StructureMapGroupRuleTargetTransformTruncate  StructureMapGroupRuleTargetTransform = "truncate"
+	StructureMapGroupRuleTargetTransformEscape    StructureMapGroupRuleTargetTransform = "escape"
+	StructureMapGroupRuleTargetTransformCast      StructureMapGroupRuleTargetTransform = "cast"
+	StructureMapGroupRuleTargetTransformAppend    StructureMapGroupRuleTargetTransform = "append"
+	StructureMapGroupRuleTargetTransformTranslate StructureMapGroupRuleTargetTransform = "translate"
+	StructureMapGroupRuleTargetTransformReference StructureMapGroupRuleTargetTransform = "reference"
+	StructureMapGroupRuleTargetTransformDateOp    StructureMapGroupRuleTargetTransform = "dateOp"
+	StructureMapGroupRuleTargetTransformUuid      StructureMapGroupRuleTargetTransform = "uuid"
+	StructureMapGroupRuleTargetTransformPointer   StructureMapGroupRuleTargetTransform = "pointer"
+	StructureMapGroupRuleTargetTransformEvaluate  StructureMapGroupRuleTargetTransform = "evaluate"
+	StructureMapGroupRuleTargetTransformCc        StructureMapGroupRuleTargetTransform = "cc"
+	StructureMapGroupRuleTargetTransformC         StructureMapGroupRuleTargetTransform = "c"
+	StructureMapGroupRuleTargetTransformQty       StructureMapGroupRuleTargetTransform = "qty"
+	StructureMapGroupRuleTargetTransformId        StructureMapGroupRuleTargetTransform = "id"
+	StructureMapGroupRuleTargetTransformCp        StructureMapGroupRuleTargetTransform = "cp"
+)
+
+// StructureMapGroupRuleDependent represents which other rules to apply in the context of this rule
+type StructureMapGroupRuleDependent struct {
+	common.BackboneElement
+
+	// Name of a rule or group to apply
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Variable to pass to the rule or group
+	Variable        []string          `json:"variable"`
+	VariableElement []*common.Element `json:"_variable,omitempty"`
+}
+
+// StructureMapGroupRule represents Transform Rule from source to target
+type StructureMapGroupRule struct {
+	common.BackboneElement
+
+	// Which other rules to apply in the context of this rule
+	Dependent []StructureMapGroupRuleDependent `json:"dependent,omitempty"`
+
+	// Documentation for this instance of data
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Name of the rule for internal references
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Rules contained in this rule
+	Rule []StructureMapGroupRule `json:"rule,omitempty"`
+
+	// Source inputs to the mapping
+	Source []StructureMapGroupRuleSource `json:"source"`
+
+	// Content to create because of this mapping rule
+	Target []StructureMapGroupRuleTarget `json:"target,omitempty"`
+}
+
+// StructureMapGroup represents named sections for reader convenience
+type StructureMapGroup struct {
+	common.BackboneElement
+
+	// Additional description/explanation for group
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Another group that this group adds rules to
+	Extends        *string         `json:"extends,omitempty"`
+	ExtendsElement *common.Element `json:"_extends,omitempty"`
+
+	// Named instance provided when invoking the map
+	Input []StructureMapGroupInput `json:"input"`
+
+	// Human-readable label
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Transform Rule from source to target
+	Rule []StructureMapGroupRule `json:"rule"`
+
+	// types | type-and-types
+	TypeMode        StructureMapGroupTypeMode `json:"typeMode"`
+	TypeModeElement *common.Element           `json:"_typeMode,omitempty"`
+}
+
+// StructureMapGroupTypeMode represents the type mode of a structure map group
+type StructureMapGroupTypeMode string
+
+const (
+	StructureMapGroupTypeModeNone         StructureMapGroupTypeMode = "none"
+	StructureMapGroupTypeModeTypes        StructureMapGroupTypeMode = "types"
+	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
+)
@@ -0,0 +1,81 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Subscription represents notification about a SubscriptionTopic
+type Subscription struct {
+	DomainResource
+
+	// Details where to send notifications when resources are received that meet the criteria
+	Channel *SubscriptionChannel `json:"channel"`
+
+	// Contact details for source (e.g. troubleshooting)
+	Contact []ContactPoint `json:"contact,omitempty"`
+
+	// The rules are a search criteria (without the [base] part)
+	Criteria        string          `json:"criteria"`
+	CriteriaElement *common.Element `json:"_criteria,omitempty"`
+
+	// When to automatically delete the subscription
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// A record of the last error that occurred when the server processed a notification
+	Error        *string         `json:"error,omitempty"`
+	ErrorElement *common.Element `json:"_error,omitempty"`
+
+	// Description of why this subscription was created
+	Reason        string          `json:"reason"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
+
+	// requested | active | error | off | entered-in-error
+	Status        SubscriptionStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// So that other systems can tell which resources have been the subject of a notification
+	Tag []common.Coding `json:"tag,omitempty"`
+}
+
+// SubscriptionStatus represents the status of a subscription
+type SubscriptionStatus string
+
+const (
+	SubscriptionStatusRequested SubscriptionStatus = "requested"
+	SubscriptionStatusActive    SubscriptionStatus = "active"
+	SubscriptionStatusError     SubscriptionStatus = "error"
+	SubscriptionStatusOff       SubscriptionStatus = "off"
+)
+
+// SubscriptionChannel represents details where to send notifications when resources are received that meet the criteria
+type SubscriptionChannel struct {
+	common.BackboneElement
+
+	// For rest-hook, and websocket, the end-point must be an http: or https: URL; for email, a mailto: url, for sms
+	Endpoint        *string         `json:"endpoint,omitempty"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// Exactly what these mean depend on the channel type
+	Header        []string          `json:"header,omitempty"`
+	HeaderElement []*common.Element `json:"_header,omitempty"`
+
+	// Sending the payload has obvious security implications
+	Payload        *string         `json:"payload,omitempty"`
+	PayloadElement *common.Element `json:"_payload,omitempty"`
+
+	// The type of channel to send notifications on
+	Type        SubscriptionChannelType `json:"type"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
+}
+
+// SubscriptionChannelType represents the type of a subscription channel
+type SubscriptionChannelType string
+
+const (
+	SubscriptionChannelTypeRestHook  SubscriptionChannelType = "rest-hook"
+	SubscriptionChannelTypeWebsocket SubscriptionChannelType = "websocket"
+	SubscriptionChannelTypeEmail     SubscriptionChannelType = "email"
+	SubscriptionChannelTypeSms       SubscriptionChannelType = "sms"
+	SubscriptionChannelTypeMessage   SubscriptionChannelType = "message"
+)
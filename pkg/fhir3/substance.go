@@ -0,0 +1,69 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Substance represents a homogeneous material with a definite composition
+type Substance struct {
+	DomainResource
+
+	// What class/type of substance this is
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// What substance this is
+	Code *common.CodeableConcept `json:"code"`
+
+	// Textual description of the substance, comments
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Unique identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Composition information about the substance
+	Ingredient []SubstanceIngredient `json:"ingredient,omitempty"`
+
+	// Is this an instance of a substance or a kind of one
+	Instance []SubstanceInstance `json:"instance,omitempty"`
+
+	// active | inactive | entered-in-error
+	Status        *SubstanceStatus `json:"status,omitempty"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+}
+
+// SubstanceStatus represents the status of a substance
+type SubstanceStatus string
+
+const (
+	SubstanceStatusActive         SubstanceStatus = "active"
+	SubstanceStatusInactive       SubstanceStatus = "inactive"
+	SubstanceStatusEnteredInError SubstanceStatus = "entered-in-error"
+)
+
+// SubstanceInstance represents substance may be used to describe a kind of substance, or a specific package/container of the substance: an instance
+type SubstanceInstance struct {
+	common.BackboneElement
+
+	// When the substance is no longer valid to use
+	Expiry        *common.DateTime `json:"expiry,omitempty"`
+	ExpiryElement *common.Element  `json:"_expiry,omitempty"`
+
+	// Identifier associated with the package/container (usually a label affixed directly)
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The amount of the substance
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+}
+
+// SubstanceIngredient represents composition information about the substance
+type SubstanceIngredient struct {
+	common.BackboneElement
+
+	// Optional amount (concentration)
+	Quantity *Ratio `json:"quantity,omitempty"`
+
+	// A component of the substance
+	SubstanceCodeableConcept *common.CodeableConcept `json:"substanceCodeableConcept,omitempty"`
+	SubstanceReference       *common.Reference       `json:"substanceReference,omitempty"`
+}
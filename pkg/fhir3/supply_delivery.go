@@ -0,0 +1,69 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SupplyDelivery represents delivery of bulk Supplies
+type SupplyDelivery struct {
+	DomainResource
+
+	// Fulfills plan, proposal or order
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Where the delivery was sent
+	Destination *common.Reference `json:"destination,omitempty"`
+
+	// External identifier
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// When event occurred
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
+
+	// Part of referenced event
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Patient for whom the item is supplied
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Who received the delivery
+	Receiver []common.Reference `json:"receiver,omitempty"`
+
+	// in-progress | completed | abandoned | entered-in-error
+	Status        *SupplyDeliveryStatus `json:"status,omitempty"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// The item that is delivered or supplied
+	SuppliedItem *SupplyDeliverySuppliedItem `json:"suppliedItem,omitempty"`
+
+	// The item supplier
+	Supplier *common.Reference `json:"supplier,omitempty"`
+
+	// Category of supply event
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SupplyDeliveryStatus represents the status of a supply delivery
+type SupplyDeliveryStatus string
+
+const (
+	SupplyDeliveryStatusInProgress     SupplyDeliveryStatus = "in-progress"
+	SupplyDeliveryStatusCompleted      SupplyDeliveryStatus = "completed"
+	SupplyDeliveryStatusAbandoned      SupplyDeliveryStatus = "abandoned"
+	SupplyDeliveryStatusEnteredInError SupplyDeliveryStatus = "entered-in-error"
+)
+
+// SupplyDeliverySuppliedItem represents the supplied item of a supply delivery
+type SupplyDeliverySuppliedItem struct {
+	common.BackboneElement
+
+	// Medication, Substance, Device or Biologically Derived Product supplied
+	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
+	ItemReference       *common.Reference       `json:"itemReference,omitempty"`
+
+	// Amount supplied
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+}
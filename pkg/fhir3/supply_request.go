@@ -0,0 +1,99 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SupplyRequest represents request for a medication, substance or device
+type SupplyRequest struct {
+	DomainResource
+
+	// When the request was made
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// The kind of supply (central, non-stock, etc.)
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// The origin of the supply
+	DeliverFrom *common.Reference `json:"deliverFrom,omitempty"`
+
+	// The destination of the supply
+	DeliverTo *common.Reference `json:"deliverTo,omitempty"`
+
+	// Business Identifier for SupplyRequest
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// When the request should be fulfilled
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
+
+	// The item being requested
+	OrderedItem *SupplyRequestOrderedItem `json:"orderedItem,omitempty"`
+
+	// routine | urgent | asap | stat
+	Priority        *SupplyRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
+
+	// Why the supply item was requested
+	ReasonCodeableConcept *common.CodeableConcept `json:"reasonCodeableConcept,omitempty"`
+	ReasonReference       *common.Reference       `json:"reasonReference,omitempty"`
+
+	// Individual making the request
+	Requester *SupplyRequestRequester `json:"requester,omitempty"`
+
+	// draft | active | suspended +
+	Status        *SupplyRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Who is intended to fulfill the request
+	Supplier []common.Reference `json:"supplier,omitempty"`
+}
+
+// SupplyRequestPriority represents the priority of a supply request
+type SupplyRequestPriority string
+
+const (
+	SupplyRequestPriorityRoutine SupplyRequestPriority = "routine"
+	SupplyRequestPriorityUrgent  SupplyRequestPriority = "urgent"
+	SupplyRequestPriorityAsap    SupplyRequestPriority = "asap"
+	SupplyRequestPriorityStat    SupplyRequestPriority = "stat"
+)
+
+// SupplyRequestStatus represents the status of a supply request
+type SupplyRequestStatus string
+
+const (
+	SupplyRequestStatusDraft          SupplyRequestStatus = "draft"
+	SupplyRequestStatusActive         SupplyRequestStatus = "active"
+	SupplyRequestStatusSuspended      SupplyRequestStatus = "suspended"
+	SupplyRequestStatusCancelled      SupplyRequestStatus = "cancelled"
+	SupplyRequestStatusCompleted      SupplyRequestStatus = "completed"
+	SupplyRequestStatusEnteredInError SupplyRequestStatus = "entered-in-error"
+	SupplyRequestStatusUnknown        SupplyRequestStatus = "unknown"
+)
+
+// SupplyRequestOrderedItem represents the ordered item of a supply request
+type SupplyRequestOrderedItem struct {
+	common.BackboneElement
+
+	// Note that there's a difference between a prescription - an instruction to take a medication, along with a
+	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
+	ItemReference       *common.Reference       `json:"itemReference,omitempty"`
+
+	// The amount that is being ordered of the indicated item
+	Quantity *common.Quantity `json:"quantity"`
+}
+
+// SupplyRequestRequester represents the requester of a supply request
+type SupplyRequestRequester struct {
+	common.BackboneElement
+
+	// The device, practitioner, etc. who initiated the request
+	Agent *common.Reference `json:"agent"`
+
+	// The organization the device or practitioner was acting on behalf of
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
@@ -0,0 +1,295 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Task represents a task to be performed
+type Task struct {
+	DomainResource
+
+	// Task Creation Date
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// Request fulfilled by this task
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// E.g. "Specimen collected", "IV prepped"
+	BusinessStatus *common.CodeableConcept `json:"businessStatus,omitempty"`
+
+	// Task Type
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The healthcare event (e.g. a patient and healthcare provider interaction) during which this task was created
+	Context *common.Reference `json:"context,omitempty"`
+
+	// A reference to a formal or informal definition of the task
+	DefinitionUri        *string           `json:"definitionUri,omitempty"`
+	DefinitionUriElement *common.Element   `json:"_definitionUri,omitempty"`
+	DefinitionReference  *common.Reference `json:"definitionReference,omitempty"`
+
+	// Human-readable explanation of task
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Start and end time of execution
+	ExecutionPeriod *common.Period `json:"executionPeriod,omitempty"`
+
+	// What task is acting on
+	Focus *common.Reference `json:"focus,omitempty"`
+
+	// Beneficiary of the Task
+	For *common.Reference `json:"for,omitempty"`
+
+	// Requisition or grouper id
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	// Task Instance Identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Information used to perform task
+	Input []TaskInput `json:"input,omitempty"`
+
+	// unknown | proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option
+	Intent        TaskIntent      `json:"intent"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
+
+	// Task Last Modified Date
+	LastModified        *common.DateTime `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element  `json:"_lastModified,omitempty"`
+
+	// Comments made about the task
+	Note []Annotation `json:"note,omitempty"`
+
+	// Information produced as part of task
+	Output []TaskOutput `json:"output,omitempty"`
+
+	// Responsible individual
+	Owner *common.Reference `json:"owner,omitempty"`
+
+	// Composite task
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// The type of participant that can execute the task
+	PerformerType []common.CodeableConcept `json:"performerType,omitempty"`
+
+	// routine | urgent | asap | stat
+	Priority        *TaskPriority   `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// Why task is needed
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// Key events in history of the Task
+	RelevantHistory []common.Reference `json:"relevantHistory,omitempty"`
+
+	// Who is asking for task to be done
+	Requester *TaskRequester `json:"requester,omitempty"`
+
+	// Constraints on fulfillment tasks
+	Restriction *TaskRestriction `json:"restriction,omitempty"`
+
+	// draft | requested | received | accepted | +
+	Status        TaskStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Reason for current status
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+}
+
+// TaskIntent represents the intent of a task
+type TaskIntent string
+
+const (
+	TaskIntentProposal      TaskIntent = "proposal"
+	TaskIntentPlan          TaskIntent = "plan"
+	TaskIntentOrder         TaskIntent = "order"
+	TaskIntentOriginalOrder TaskIntent = "original-order"
+	TaskIntentReflexOrder   TaskIntent = "reflex-order"
+	TaskIntentFillerOrder   TaskIntent = "filler-order"
+	TaskIntentInstanceOrder TaskIntent = "instance-order"
+	TaskIntentOption        TaskIntent = "option"
+)
+
+// TaskPriority represents the priority of a task
+type TaskPriority string
+
+const (
+	TaskPriorityRoutine TaskPriority = "routine"
+	TaskPriorityUrgent  TaskPriority = "urgent"
+	TaskPriorityAsap    TaskPriority = "asap"
+	TaskPriorityStat    TaskPriority = "stat"
+)
+
+// TaskStatus represents the status of a task
+type TaskStatus string
+
+const (
+	TaskStatusDraft          TaskStatus = "draft"
+	TaskStatusRequested      TaskStatus = "requested"
+	TaskStatusReceived       TaskStatus = "received"
+	TaskStatusAccepted       TaskStatus = "accepted"
+	TaskStatusRejected       TaskStatus = "rejected"
+	TaskStatusReady          TaskStatus = "ready"
+	TaskStatusCancelled      TaskStatus = "cancelled"
+	TaskStatusInProgress     TaskStatus = "in-progress"
+	TaskStatusOnHold         TaskStatus = "on-hold"
+	TaskStatusFailed         TaskStatus = "failed"
+	TaskStatusCompleted      TaskStatus = "completed"
+	TaskStatusEnteredInError TaskStatus = "entered-in-error"
+)
+
+// TaskRequester represents the requester of a task
+type TaskRequester struct {
+	common.BackboneElement
+
+	// The device, practitioner, etc. who initiated the task
+	Agent *common.Reference `json:"agent"`
+
+	// The organization the device or practitioner was acting on behalf of when they initiated the task
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+}
+
+// TaskRestriction represents constraints on fulfillment tasks
+type TaskRestriction struct {
+	common.BackboneElement
+
+	// When fulfillment is sought
+	Period *common.Period `json:"period,omitempty"`
+
+	// For whom is fulfillment sought?
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// How many times to repeat
+	Repetitions        *int            `json:"repetitions,omitempty"`
+	RepetitionsElement *common.Element `json:"_repetitions,omitempty"`
+}
+
+// TaskInput represents information used to perform task
+type TaskInput struct {
+	common.BackboneElement
+
+	// Label for the input
+	Type *common.CodeableConcept `json:"type"`
+
+	// Content to use in performing the task
+	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element         `json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element         `json:"_valueBoolean,omitempty"`
+	ValueCode                *string                 `json:"valueCode,omitempty"`
+	ValueCodeElement         *common.Element         `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element         `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element         `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal         `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element         `json:"_valueDecimal,omitempty"`
+	ValueId                  *string                 `json:"valueId,omitempty"`
+	ValueIdElement           *common.Element         `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant         `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element         `json:"_valueInstant,omitempty"`
+	ValueInteger             *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element         `json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element         `json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                 `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element         `json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                    `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element         `json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                 `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element         `json:"_valueString,omitempty"`
+	ValueTime                *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element         `json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                    `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element         `json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                 `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element         `json:"_valueUri,omitempty"`
+	ValueAddress             *Address                `json:"valueAddress,omitempty"`
+	ValueAge                 *Age                    `json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation             `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *common.Coding          `json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint           `json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                  `json:"valueCount,omitempty"`
+	ValueDistance            *Distance               `json:"valueDistance,omitempty"`
+	ValueDuration            *Duration               `json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName              `json:"valueHumanName,omitempty"`
+	ValueIdentifier          *common.Identifier      `json:"valueIdentifier,omitempty"`
+	ValueMoney               *common.Money           `json:"valueMoney,omitempty"`
+	ValuePeriod              *common.Period          `json:"valuePeriod,omitempty"`
+	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange               *Range                  `json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                  `json:"valueRatio,omitempty"`
+	ValueReference           *common.Reference       `json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData            `json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature              `json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                 `json:"valueTiming,omitempty"`
+	ValueMeta                *Meta                   `json:"valueMeta,omitempty"`
+}
+
+// TaskOutput represents information produced as part of task
+type TaskOutput struct {
+	common.BackboneElement
+
+	// Label for output
+	Type *common.CodeableConcept `json:"type"`
+
+	// Result of output
+	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element         `json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element         `json:"_valueBoolean,omitempty"`
+	ValueCode                *string                 `json:"valueCode,omitempty"`
+	ValueCodeElement         *common.Element         `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element         `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element         `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal         `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element         `json:"_valueDecimal,omitempty"`
+	ValueId                  *string                 `json:"valueId,omitempty"`
+	ValueIdElement           *common.Element         `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant         `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element         `json:"_valueInstant,omitempty"`
+	ValueInteger             *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element         `json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element         `json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                 `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element         `json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                    `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element         `json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                 `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element         `json:"_valueString,omitempty"`
+	ValueTime                *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element         `json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                    `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element         `json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                 `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element         `json:"_valueUri,omitempty"`
+	ValueAddress             *Address                `json:"valueAddress,omitempty"`
+	ValueAge                 *Age                    `json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation             `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *common.Coding          `json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint           `json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                  `json:"valueCount,omitempty"`
+	ValueDistance            *Distance               `json:"valueDistance,omitempty"`
+	ValueDuration            *Duration               `json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName              `json:"valueHumanName,omitempty"`
+	ValueIdentifier          *common.Identifier      `json:"valueIdentifier,omitempty"`
+	ValueMoney               *common.Money           `json:"valueMoney,omitempty"`
+	ValuePeriod              *common.Period          `json:"valuePeriod,omitempty"`
+	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange               *Range                  `json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                  `json:"valueRatio,omitempty"`
+	ValueReference           *common.Reference       `json:"valueReference,omitempty"`
+	ValueSampledData         *SampledData            `json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature              `json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                 `json:"valueTiming,omitempty"`
+	ValueMeta                *Meta                   `json:"valueMeta,omitempty"`
+}
@@ -0,0 +1,216 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// TestReport describes the results of a TestScript execution
+type TestReport struct {
+	DomainResource
+
+	// External identifier
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// When the TestScript was executed and this TestReport was generated
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
+
+	// Informal name of the executed TestReport
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A participant in the test execution, either the execution engine, a client, or a server
+	Participant []TestReportParticipant `json:"participant,omitempty"`
+
+	// pass | fail | pending
+	Result        TestReportResult `json:"result"`
+	ResultElement *common.Element  `json:"_result,omitempty"`
+
+	// The final score (percentage of tests passed) resulting from the execution of the TestScript
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
+
+	// The results of the series of required setup operations before the tests were executed
+	Setup *TestReportSetup `json:"setup,omitempty"`
+
+	// completed | in-progress | waiting | stopped | entered-in-error
+	Status        TestReportStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// The results of running the series of required clean up steps
+	Teardown *TestReportTeardown `json:"teardown,omitempty"`
+
+	// A test executed from the test script
+	Test []TestReportTest `json:"test,omitempty"`
+
+	// Name of the tester producing this report (Organization or individual)
+	Tester        *string         `json:"tester,omitempty"`
+	TesterElement *common.Element `json:"_tester,omitempty"`
+
+	// Canonical URL to the  version-specific TestScript that was executed to produce this TestReport
+	TestScript *common.Reference `json:"testScript"`
+}
+
+// TestReportResult represents the result of a test report
+type TestReportResult string
+
+const (
+	TestReportResultPass    TestReportResult = "pass"
+	TestReportResultFail    TestReportResult = "fail"
+	TestReportResultPending TestReportResult = "pending"
+)
+
+// TestReportStatus represents the status of a test report
+type TestReportStatus string
+
+const (
+	TestReportStatusCompleted      TestReportStatus = "completed"
+	TestReportStatusInProgress     TestReportStatus = "in-progress"
+	TestReportStatusWaiting        TestReportStatus = "waiting"
+	TestReportStatusStopped        TestReportStatus = "stopped"
+	TestReportStatusEnteredInError TestReportStatus = "entered-in-error"
+)
+
+// TestReportParticipant represents a participant in the test execution, either the execution engine, a client, or a server
+type TestReportParticipant struct {
+	common.BackboneElement
+
+	// The display name of the participant
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// test-engine | client | server
+	Type        TestReportParticipantType `json:"type"`
+	TypeElement *common.Element           `json:"_type,omitempty"`
+
+	// The uri of the participant. An absolute URL is preferred
+	Uri        string          `json:"uri"`
+	UriElement *common.Element `json:"_uri,omitempty"`
+}
+
+// TestReportParticipantType represents the type of a test report participant
+type TestReportParticipantType string
+
+const (
+	TestReportParticipantTypeTestEngine TestReportParticipantType = "test-engine"
+	TestReportParticipantTypeClient     TestReportParticipantType = "client"
+	TestReportParticipantTypeServer     TestReportParticipantType = "server"
+)
+
+// TestReportSetupActionOperation represents the operation of a test report setup action
+type TestReportSetupActionOperation struct {
+	common.BackboneElement
+
+	// A link to further details on the result
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
+
+	// A message associated with the result
+	Message        *string         `json:"message,omitempty"`
+	MessageElement *common.Element `json:"_message,omitempty"`
+
+	// pass | skip | fail | warning | error
+	Result        TestReportSetupActionOperationResult `json:"result"`
+	ResultElement *common.Element                      `json:"_result,omitempty"`
+}
+
+// TestReportSetupActionOperationResult represents the result of a test report setup action operation
+type TestReportSetupActionOperationResult string
+
+const (
+	TestReportSetupActionOperationResultPass    TestReportSetupActionOperationResult = "pass"
+	TestReportSetupActionOperationResultSkip    TestReportSetupActionOperationResult = "skip"
+	TestReportSetupActionOperationResultFail    TestReportSetupActionOperationResult = "fail"
+	TestReportSetupActionOperationResultWarning TestReportSetupActionOperationResult = "warning"
+	TestReportSetupActionOperationResultError   TestReportSetupActionOperationResult = "error"
+)
+
+// TestReportSetupActionAssert represents the assert of a test report setup action
+type TestReportSetupActionAssert struct {
+	common.BackboneElement
+
+	// A link to further details on the result
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
+
+	// A message associated with the result
+	Message        *string         `json:"message,omitempty"`
+	MessageElement *common.Element `json:"_message,omitempty"`
+
+	// pass | skip | fail | warning | error
+	Result        TestReportSetupActionAssertResult `json:"result"`
+	ResultElement *common.Element                   `json:"_result,omitempty"`
+}
+
+// TestReportSetupActionAssertResult represents the result of a test report setup action assert
+type TestReportSetupActionAssertResult string
+
+const (
+	TestReportSetupActionAssertResultPass    TestReportSetupActionAssertResult = "pass"
+	TestReportSetupActionAssertResultSkip    TestReportSetupActionAssertResult = "skip"
+	TestReportSetupActionAssertResultFail    TestReportSetupActionAssertResult = "fail"
+	TestReportSetupActionAssertResultWarning TestReportSetupActionAssertResult = "warning"
+	TestReportSetupActionAssertResultError   TestReportSetupActionAssertResult = "error"
+)
+
+// TestReportSetupAction represents a setup operation or assert that was executed
+type TestReportSetupAction struct {
+	common.BackboneElement
+
+	// The assertion to perform
+	Assert *TestReportSetupActionAssert `json:"assert,omitempty"`
+
+	// The operation to perform
+	Operation *TestReportSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestReportSetup represents the setup of a test report
+type TestReportSetup struct {
+	common.BackboneElement
+
+	// A setup operation or assert that was executed
+	Action []TestReportSetupAction `json:"action"`
+}
+
+// TestReportTestAction represents a test operation or assert that was performed
+type TestReportTestAction struct {
+	common.BackboneElement
+
+	// The results of the assertion performed on the previous operations
+	Assert *TestReportSetupActionAssert `json:"assert,omitempty"`
+
+	// An operation would involve a REST request to a server
+	Operation *TestReportSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestReportTest represents a test executed from the test script
+type TestReportTest struct {
+	common.BackboneElement
+
+	// A test operation or assert that was performed
+	Action []TestReportTestAction `json:"action"`
+
+	// Tracking/reporting short description of the test
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Tracking/logging name of this test
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// TestReportTeardownAction represents one or more teardown operations performed
+type TestReportTeardownAction struct {
+	common.BackboneElement
+
+	// An operation would involve a REST request to a server
+	Operation *TestReportSetupActionOperation `json:"operation"`
+}
+
+// TestReportTeardown represents the teardown of a test report
+type TestReportTeardown struct {
+	common.BackboneElement
+
+	// One or more teardown operations performed
+	Action []TestReportTeardownAction `json:"action"`
+}
@@ -0,0 +1,690 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// TestScript describes a set of tests
+type TestScript struct {
+	DomainResource
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the test script
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An abstract server representing a destination or receiver in a message exchange
+	Destination []TestScriptDestination `json:"destination,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Fixture in the test script - by reference (uri)
+	Fixture []TestScriptFixture `json:"fixture,omitempty"`
+
+	// Additional identifier for the test script
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Intended jurisdiction for test script (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Required capability that is assumed to function correctly on the FHIR server being tested
+	Metadata *TestScriptMetadata `json:"metadata,omitempty"`
+
+	// Name for this test script (computer friendly)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// An abstract server representing a client or sender in a message exchange
+	Origin []TestScriptOrigin `json:"origin,omitempty"`
+
+	// Reference of the validation profile
+	Profile []common.Reference `json:"profile,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this test script is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Each rule should be treated by test engines as one assertion regardless of how many assertions are contained within the external rule template
+	Rule []TestScriptRule `json:"rule,omitempty"`
+
+	// Each rule within a ruleset should be treated by test engines as one assertion regardless of how many assertions are contained within the external rule template
+	Ruleset []TestScriptRuleset `json:"ruleset,omitempty"`
+
+	// A series of required setup operations before tests are executed
+	Setup *TestScriptSetup `json:"setup,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        TestScriptStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// A series of required clean up steps
+	Teardown *TestScriptTeardown `json:"teardown,omitempty"`
+
+	// A test in this script
+	Test []TestScriptTest `json:"test,omitempty"`
+
+	// Name for this test script (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this test script, represented as a URI (globally unique)
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Placeholder for evaluated elements
+	Variable []TestScriptVariable `json:"variable,omitempty"`
+
+	// Business version of the test script
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// TestScriptStatus represents the status of a test script
+type TestScriptStatus string
+
+const (
+	TestScriptStatusDraft   TestScriptStatus = "draft"
+	TestScriptStatusActive  TestScriptStatus = "active"
+	TestScriptStatusRetired TestScriptStatus = "retired"
+	TestScriptStatusUnknown TestScriptStatus = "unknown"
+)
+
+// TestScriptOrigin represents an abstract server representing a client or sender in a message exchange
+type TestScriptOrigin struct {
+	common.BackboneElement
+
+	// The index of the abstract origin server starting at 1
+	Index        int             `json:"index"`
+	IndexElement *common.Element `json:"_index,omitempty"`
+
+	// FHIR-Client | FHIR-SDC-FormFiller
+	Profile *common.Coding `json:"profile"`
+}
+
+// TestScriptDestination represents an abstract server representing a destination or receiver in a message exchange
+type TestScriptDestination struct {
+	common.BackboneElement
+
+	// The index of the abstract destination server starting at 1
+	Index        int             `json:"index"`
+	IndexElement *common.Element `json:"_index,omitempty"`
+
+	// FHIR-Server | FHIR-SDC-FormManager | FHIR-SDC-FormReceiver | FHIR-SDC-FormProcessor
+	Profile *common.Coding `json:"profile"`
+}
+
+// TestScriptMetadataLink represents links to the FHIR specification
+type TestScriptMetadataLink struct {
+	common.BackboneElement
+
+	// Short description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// URL to the specification
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+}
+
+// TestScriptMetadataCapability represents capabilities  that are assumed to function correctly on the FHIR server being tested
+type TestScriptMetadataCapability struct {
+	common.BackboneElement
+
+	// Required Capability Statement
+	Capabilities *common.Reference `json:"capabilities"`
+
+	// The expected capabilities of the server
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Which server these requirements apply to
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
+
+	// Links to the FHIR specification
+	Link        []string          `json:"link,omitempty"`
+	LinkElement []*common.Element `json:"_link,omitempty"`
+
+	// Which origin server these requirements apply to
+	Origin        []int             `json:"origin,omitempty"`
+	OriginElement []*common.Element `json:"_origin,omitempty"`
+
+	// Are the capabilities required?
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
+
+	// Are the capabilities validated?
+	Validated        *bool           `json:"validated,omitempty"`
+	ValidatedElement *common.Element `json:"_validated,omitempty"`
+}
+
+// TestScriptMetadata represents required capability that is assumed to function correctly on the FHIR server being tested
+type TestScriptMetadata struct {
+	common.BackboneElement
+
+	// Capabilities  that are assumed to function correctly on the FHIR server being tested
+	Capability []TestScriptMetadataCapability `json:"capability"`
+
+	// Links to the FHIR specification
+	Link []TestScriptMetadataLink `json:"link,omitempty"`
+}
+
+// TestScriptFixture represents fixture in the test script - by reference (uri)
+type TestScriptFixture struct {
+	common.BackboneElement
+
+	// Whether or not to implicitly create the fixture during setup
+	Autocreate        *bool           `json:"autocreate,omitempty"`
+	AutocreateElement *common.Element `json:"_autocreate,omitempty"`
+
+	// Whether or not to implicitly delete the fixture during teardown
+	Autodelete        *bool           `json:"autodelete,omitempty"`
+	AutodeleteElement *common.Element `json:"_autodelete,omitempty"`
+
+	// Reference of the resource
+	Resource *common.Reference `json:"resource,omitempty"`
+}
+
+// TestScriptVariable represents placeholder for evaluated elements
+type TestScriptVariable struct {
+	common.BackboneElement
+
+	// Default, hard-coded, or user-defined value for this variable
+	DefaultValue        *string         `json:"defaultValue,omitempty"`
+	DefaultValueElement *common.Element `json:"_defaultValue,omitempty"`
+
+	// Natural language description of the variable
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The FHIRPath expression against the fixture body
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// HTTP header field name for source
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
+
+	// Hint help text for default value to enter
+	Hint        *string         `json:"hint,omitempty"`
+	HintElement *common.Element `json:"_hint,omitempty"`
+
+	// Descriptive name for this variable
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// XPath or JSONPath against the fixture body
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// Fixture Id of source expression or headerField within this variable
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+}
+
+// TestScriptRuleParam represents the param of a test script rule
+type TestScriptRuleParam struct {
+	common.BackboneElement
+
+	// The external rule template would be looking for the parameter by this name
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// This value can be overwritten by the assert.rule.param.value i.e. TestScript.rule.param.value will be used if assert.rule.param.value is not specified
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// TestScriptRule represents each rule should be treated by test engines as one assertion regardless of how many assertions are contained within the external rule template
+type TestScriptRule struct {
+	common.BackboneElement
+
+	// The parameter value can be dynamic at runtime
+	Param []TestScriptRuleParam `json:"param,omitempty"`
+
+	// Reference to the resource (containing the contents of the rule needed for assertions)
+	Resource *common.Reference `json:"resource"`
+}
+
+// TestScriptRulesetRuleParam represents the param of a test script ruleset rule
+type TestScriptRulesetRuleParam struct {
+	common.BackboneElement
+
+	// The external rule template would be looking for the parameter by this name
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// This value can be overwritten by the assert.ruleset.rule.param.value i.e. TestScript.ruleset.rule.param.value will be used if assert.ruleset.rule.param.value is not specified
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// TestScriptRulesetRule represents the rule of a test script ruleset
+type TestScriptRulesetRule struct {
+	common.BackboneElement
+
+	// The parameter value can be dynamic at runtime
+	Param []TestScriptRulesetRuleParam `json:"param,omitempty"`
+
+	// Id of the referenced rule within the external ruleset template
+	RuleId        string          `json:"ruleId"`
+	RuleIdElement *common.Element `json:"_ruleId,omitempty"`
+}
+
+// TestScriptRuleset represents each rule within a ruleset should be treated by test engines as one assertion regardless of how many assertions are contained within the external rule template
+type TestScriptRuleset struct {
+	common.BackboneElement
+
+	// Reference to the resource (containing the contents of the ruleset needed for assertions)
+	Resource *common.Reference `json:"resource"`
+
+	// This qualifies each param name so that a parameter with the same name can be used differently by the different rules with the ruleset
+	Rule []TestScriptRulesetRule `json:"rule"`
+}
+
+// TestScriptSetupActionOperationRequestHeader represents each operation can have one or more header elements
+type TestScriptSetupActionOperationRequestHeader struct {
+	common.BackboneElement
+
+	// HTTP header field name
+	Field        string          `json:"field"`
+	FieldElement *common.Element `json:"_field,omitempty"`
+
+	// HTTP headerfield value
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// TestScriptSetupActionOperation represents the operation of a test script setup action
+type TestScriptSetupActionOperation struct {
+	common.BackboneElement
+
+	// Mime type to accept in the payload of the response, with charset etc
+	Accept        *TestScriptSetupActionOperationAccept `json:"accept,omitempty"`
+	AcceptElement *common.Element                       `json:"_accept,omitempty"`
+
+	// Mime type of the request payload contents, with charset etc
+	ContentType        *TestScriptSetupActionOperationContentType `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element                            `json:"_contentType,omitempty"`
+
+	// Tracking/reporting operation description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Server responding to the request
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
+
+	// Whether or not to send the request url in encoded format
+	EncodeRequestUrl        *bool           `json:"encodeRequestUrl,omitempty"`
+	EncodeRequestUrlElement *common.Element `json:"_encodeRequestUrl,omitempty"`
+
+	// Tracking/logging operation label
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Server initiating the request
+	Origin        *int            `json:"origin,omitempty"`
+	OriginElement *common.Element `json:"_origin,omitempty"`
+
+	// Explicitly defined path parameters
+	Params        *string         `json:"params,omitempty"`
+	ParamsElement *common.Element `json:"_params,omitempty"`
+
+	// Each operation can have one or more header elements
+	RequestHeader []TestScriptSetupActionOperationRequestHeader `json:"requestHeader,omitempty"`
+
+	// Fixture Id of mapped request
+	RequestId        *string         `json:"requestId,omitempty"`
+	RequestIdElement *common.Element `json:"_requestId,omitempty"`
+
+	// Resource type
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+
+	// Fixture Id of mapped response
+	ResponseId        *string         `json:"responseId,omitempty"`
+	ResponseIdElement *common.Element `json:"_responseId,omitempty"`
+
+	// Fixture Id of body for PUT and POST requests
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+
+	// Id of fixture used for extracting the [id],  [type], and [vid] for GET requests
+	TargetId        *string         `json:"targetId,omitempty"`
+	TargetIdElement *common.Element `json:"_targetId,omitempty"`
+
+	// The operation code type that will be executed
+	Type *common.Coding `json:"type,omitempty"`
+
+	// Request URL
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+}
+
+// TestScriptSetupActionOperationAccept represents the accept of a test script setup action operation
+type TestScriptSetupActionOperationAccept string
+
+const (
+	TestScriptSetupActionOperationAcceptXml  TestScriptSetupActionOperationAccept = "xml"
+	TestScriptSetupActionOperationAcceptJson TestScriptSetupActionOperationAccept = "json"
+	TestScriptSetupActionOperationAcceptTtl  TestScriptSetupActionOperationAccept = "ttl"
+	TestScriptSetupActionOperationAcceptNone TestScriptSetupActionOperationAccept = "none"
+)
+
+// TestScriptSetupActionOperationContentType represents the content type of a test script setup action operation
+type TestScriptSetupActionOperationContentType string
+
+const (
+	TestScriptSetupActionOperationContentTypeXml  TestScriptSetupActionOperationContentType = "xml"
+	TestScriptSetupActionOperationContentTypeJson TestScriptSetupActionOperationContentType = "json"
+	TestScriptSetupActionOperationContentTypeTtl  TestScriptSetupActionOperationContentType = "ttl"
+	TestScriptSetupActionOperationContentTypeNone TestScriptSetupActionOperationContentType = "none"
+)
+
+// TestScriptSetupActionAssertRuleParam represents the param of a test script setup action assert rule
+type TestScriptSetupActionAssertRuleParam struct {
+	common.BackboneElement
+
+	// The external rule template would be looking for the parameter by this name
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// This value overwrites the value (if any) specified in TestScript.rule.param.value
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// TestScriptSetupActionAssertRule represents each rule should get evaluated by test engines as one assertion regardless of how many assertions are contained within the external rule template
+type TestScriptSetupActionAssertRule struct {
+	common.BackboneElement
+
+	// The parameter value can be dynamic at runtime
+	Param []TestScriptSetupActionAssertRuleParam `json:"param,omitempty"`
+
+	// The TestScript.rule id value this assert will evaluate
+	RuleId        string          `json:"ruleId"`
+	RuleIdElement *common.Element `json:"_ruleId,omitempty"`
+}
+
+// TestScriptSetupActionAssertRulesetRuleParam represents the param of a test script setup action assert ruleset rule
+type TestScriptSetupActionAssertRulesetRuleParam struct {
+	common.BackboneElement
+
+	// The external rule template would be looking for the parameter by this name
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// This value overwrites the value (if any) specified in TestScript.ruleset.rule.param.value
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// TestScriptSetupActionAssertRulesetRule represents the rule of a test script setup action assert ruleset
+type TestScriptSetupActionAssertRulesetRule struct {
+	common.BackboneElement
+
+	// The parameter value can be dynamic at runtime
+	Param []TestScriptSetupActionAssertRulesetRuleParam `json:"param,omitempty"`
+
+	// Id of the referenced rule within the external ruleset template
+	RuleId        string          `json:"ruleId"`
+	RuleIdElement *common.Element `json:"_ruleId,omitempty"`
+}
+
+// TestScriptSetupActionAssertRuleset represents each rule within a ruleset should get evaluated by test engines as a separate assertion
+type TestScriptSetupActionAssertRuleset struct {
+	common.BackboneElement
+
+	// This qualifies each param name so that a parameter with the same name can be used differently by the different rules with the ruleset
+	Rule []TestScriptSetupActionAssertRulesetRule `json:"rule,omitempty"`
+
+	// The TestScript.ruleset id value this assert will evaluate
+	RulesetId        string          `json:"rulesetId"`
+	RulesetIdElement *common.Element `json:"_rulesetId,omitempty"`
+}
+
+// TestScriptSetupActionAssert represents the assert of a test script setup action
+type TestScriptSetupActionAssert struct {
+	common.BackboneElement
+
+	// The FHIRPath expression to evaluate against the source fixture
+	CompareToSourceExpression        *string         `json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpressionElement *common.Element `json:"_compareToSourceExpression,omitempty"`
+
+	// Id of the source fixture to be evaluated
+	CompareToSourceId        *string         `json:"compareToSourceId,omitempty"`
+	CompareToSourceIdElement *common.Element `json:"_compareToSourceId,omitempty"`
+
+	// XPath or JSONPath expression to evaluate against the source fixture
+	CompareToSourcePath        *string         `json:"compareToSourcePath,omitempty"`
+	CompareToSourcePathElement *common.Element `json:"_compareToSourcePath,omitempty"`
+
+	// Mime type to compare against the 'Content-Type' header
+	ContentType        *TestScriptSetupActionAssertContentType `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element                         `json:"_contentType,omitempty"`
+
+	// Tracking/reporting assertion description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// response | request
+	Direction        *TestScriptSetupActionAssertDirection `json:"direction,omitempty"`
+	DirectionElement *common.Element                       `json:"_direction,omitempty"`
+
+	// The FHIRPath expression to be evaluated
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// HTTP header field name
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
+
+	// Tracking/logging assertion label
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Fixture Id of minimum content resource
+	MinimumId        *string         `json:"minimumId,omitempty"`
+	MinimumIdElement *common.Element `json:"_minimumId,omitempty"`
+
+	// Perform validation on navigation links?
+	NavigationLinks        *bool           `json:"navigationLinks,omitempty"`
+	NavigationLinksElement *common.Element `json:"_navigationLinks,omitempty"`
+
+	// equals | notEquals | in | notIn | greaterThan | lessThan | empty | notEmpty | contains | notContains | eval | manualEval
+	Operator        *TestScriptSetupActionAssertOperator `json:"operator,omitempty"`
+	OperatorElement *common.Element                      `json:"_operator,omitempty"`
+
+	// XPath or JSONPath expression
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// delete | get | options | patch | post | put | head
+	RequestMethod        *TestScriptSetupActionAssertRequestMethod `json:"requestMethod,omitempty"`
+	RequestMethodElement *common.Element                           `json:"_requestMethod,omitempty"`
+
+	// Request URL comparison value
+	RequestURL        *string         `json:"requestURL,omitempty"`
+	RequestURLElement *common.Element `json:"_requestURL,omitempty"`
+
+	// Resource type
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+
+	// continue | switchingProtocols | okay | created | accepted | nonAuthoritativeInformation | noContent | resetContent | partialContent | multipleChoices | movedPermanently | found | seeOther | notModified | useProxy | temporaryRedirect | permanentRedirect | badRequest | unauthorized | paymentRequired | forbidden | notFound | methodNotAllowed | notAcceptable | proxyAuthenticationRequired | requestTimeout | conflict | gone | lengthRequired | preconditionFailed | contentTooLarge | uriTooLong | unsupportedMediaType | rangeNotSatisfiable | expectationFailed | misdirectedRequest | unprocessableContent | upgradeRequired | internalServerError | notImplemented | badGateway | serviceUnavailable | gatewayTimeout | httpVersionNotSupported
+	Response        *TestScriptSetupActionAssertResponse `json:"response,omitempty"`
+	ResponseElement *common.Element                      `json:"_response,omitempty"`
+
+	// HTTP response code to test
+	ResponseCode        *string         `json:"responseCode,omitempty"`
+	ResponseCodeElement *common.Element `json:"_responseCode,omitempty"`
+
+	// Each rule should get evaluated by test engines as one assertion regardless of how many assertions are contained within the external rule template
+	Rule *TestScriptSetupActionAssertRule `json:"rule,omitempty"`
+
+	// Each rule within a ruleset should get evaluated by test engines as a separate assertion
+	Ruleset *TestScriptSetupActionAssertRuleset `json:"ruleset,omitempty"`
+
+	// Fixture Id of source expression or headerField
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+
+	// Profile Id of validation profile reference
+	ValidateProfileId        *string         `json:"validateProfileId,omitempty"`
+	ValidateProfileIdElement *common.Element `json:"_validateProfileId,omitempty"`
+
+	// The value to compare to
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+
+	// Will this assert produce a warning only on error?
+	WarningOnly        *bool           `json:"warningOnly,omitempty"`
+	WarningOnlyElement *common.Element `json:"_warningOnly,omitempty"`
+}
+
+// TestScriptSetupActionAssertContentType represents the content type of a test script setup action assert
+type TestScriptSetupActionAssertContentType string
+
+const (
+	TestScriptSetupActionAssertContentTypeXml  TestScriptSetupActionAssertContentType = "xml"
+	TestScriptSetupActionAssertContentTypeJson TestScriptSetupActionAssertContentType = "json"
+	TestScriptSetupActionAssertContentTypeTtl  TestScriptSetupActionAssertContentType = "ttl"
+	TestScriptSetupActionAssertContentTypeNone TestScriptSetupActionAssertContentType = "none"
+)
+
+// TestScriptSetupActionAssertDirection represents the direction of a test script setup action assert
+type TestScriptSetupActionAssertDirection string
+
+const (
+	TestScriptSetupActionAssertDirectionResponse TestScriptSetupActionAssertDirection = "response"
+	TestScriptSetupActionAssertDirectionRequest  TestScriptSetupActionAssertDirection = "request"
+)
+
+// TestScriptSetupActionAssertOperator represents the operator of a test script setup action assert
+type TestScriptSetupActionAssertOperator string
+
+const (
+	TestScriptSetupActionAssertOperatorEquals      TestScriptSetupActionAssertOperator = "equals"
+	TestScriptSetupActionAssertOperatorNotEquals   TestScriptSetupActionAssertOperator = "notEquals"
+	TestScriptSetupActionAssertOperatorIn          TestScriptSetupActionAssertOperator = "in"
+	TestScriptSetupActionAssertOperatorNotIn       TestScriptSetupActionAssertOperator = "notIn"
+	TestScriptSetupActionAssertOperatorGreaterThan TestScriptSetupActionAssertOperator = "greaterThan"
+	TestScriptSetupActionAssertOperatorLessThan    TestScriptSetupActionAssertOperator = "lessThan"
+	TestScriptSetupActionAssertOperatorEmpty       TestScriptSetupActionAssertOperator = "empty"
+	TestScriptSetupActionAssertOperatorNotEmpty    TestScriptSetupActionAssertOperator = "notEmpty"
+	TestScriptSetupActionAssertOperatorContains    TestScriptSetupActionAssertOperator = "contains"
+	TestScriptSetupActionAssertOperatorNotContains TestScriptSetupActionAssertOperator = "notContains"
+	TestScriptSetupActionAssertOperatorEval        TestScriptSetupActionAssertOperator = "eval"
+)
+
+// TestScriptSetupActionAssertRequestMethod represents the request method of a test script setup action assert
+type TestScriptSetupActionAssertRequestMethod string
+
+const (
+	TestScriptSetupActionAssertRequestMethodDelete  TestScriptSetupActionAssertRequestMethod = "delete"
+	TestScriptSetupActionAssertRequestMethodGet     TestScriptSetupActionAssertRequestMethod = "get"
+	TestScriptSetupActionAssertRequestMethodOptions TestScriptSetupActionAssertRequestMethod = "options"
+	TestScriptSetupActionAssertRequestMethodPatch   TestScriptSetupActionAssertRequestMethod = "patch"
+	TestScriptSetupActionAssertRequestMethodPost    TestScriptSetupActionAssertRequestMethod = "post"
+	TestScriptSetupActionAssertRequestMethodPut     TestScriptSetupActionAssertRequestMethod = "put"
+)
+
+// TestScriptSetupActionAssertResponse represents the response of a test script setup action assert
+type TestScriptSetupActionAssertResponse string
+
+const (
+	TestScriptSetupActionAssertResponseOkay               TestScriptSetupActionAssertResponse = "okay"
+	TestScriptSetupActionAssertResponseCreated            TestScriptSetupActionAssertResponse = "created"
+	TestScriptSetupActionAssertResponseNoContent          TestScriptSetupActionAssertResponse = "noContent"
+	TestScriptSetupActionAssertResponseNotModified        TestScriptSetupActionAssertResponse = "notModified"
+	TestScriptSetupActionAssertResponseBad                TestScriptSetupActionAssertResponse = "bad"
+	TestScriptSetupActionAssertResponseForbidden          TestScriptSetupActionAssertResponse = "forbidden"
+	TestScriptSetupActionAssertResponseNotFound           TestScriptSetupActionAssertResponse = "notFound"
+	TestScriptSetupActionAssertResponseMethodNotAllowed   TestScriptSetupActionAssertResponse = "methodNotAllowed"
+	TestScriptSetupActionAssertResponseConflict           TestScriptSetupActionAssertResponse = "conflict"
+	TestScriptSetupActionAssertResponseGone               TestScriptSetupActionAssertResponse = "gone"
+	TestScriptSetupActionAssertResponsePreconditionFailed TestScriptSetupActionAssertResponse = "preconditionFailed"
+	TestScriptSetupActionAssertResponseUnprocessable      TestScriptSetupActionAssertResponse = "unprocessable"
+)
+
+// TestScriptSetupAction represents a setup operation or assert to perform
+type TestScriptSetupAction struct {
+	common.BackboneElement
+
+	// The assertion to perform
+	Assert *TestScriptSetupActionAssert `json:"assert,omitempty"`
+
+	// The setup operation to perform
+	Operation *TestScriptSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestScriptSetup represents a series of required setup operations before tests are executed
+type TestScriptSetup struct {
+	common.BackboneElement
+
+	// A setup operation or assert to perform
+	Action []TestScriptSetupAction `json:"action"`
+}
+
+// TestScriptTestAction represents a test operation or assert to perform
+type TestScriptTestAction struct {
+	common.BackboneElement
+
+	// In order to evaluate an assertion, the request, response
+	Assert *TestScriptSetupActionAssert `json:"assert,omitempty"`
+
+	// An operation would involve a REST request to a server
+	Operation *TestScriptSetupActionOperation `json:"operation,omitempty"`
+}
+
+// TestScriptTest represents a test in this script
+type TestScriptTest struct {
+	common.BackboneElement
+
+	// A test operation or assert to perform
+	Action []TestScriptTestAction `json:"action"`
+
+	// Tracking/reporting short description of the test
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Tracking/logging name of this test
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// TestScriptTeardownAction represents one or more teardown operations to perform
+type TestScriptTeardownAction struct {
+	common.BackboneElement
+
+	// An operation would involve a REST request to a server
+	Operation *TestScriptSetupActionOperation `json:"operation"`
+}
+
+// TestScriptTeardown represents a series of required clean up steps
+type TestScriptTeardown struct {
+	common.BackboneElement
+
+	// One or more teardown operations to perform
+	Action []TestScriptTeardownAction `json:"action"`
+}
@@ -0,0 +1,281 @@
+package fhir3
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ValueSet represents a set of codes drawn from one or more code systems
+type ValueSet struct {
+	DomainResource
+
+	// Content logical definition of the value set (CLD)
+	Compose *ValueSetCompose `json:"compose,omitempty"`
+
+	// Contact details for the publisher
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Date last changed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Natural language description of the value set
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Used when the value set is "expanded"
+	Expansion *ValueSetExpansion `json:"expansion,omitempty"`
+
+	// For testing purposes, not real usage
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Whether this is intended to be used with an extensible binding or not
+	Extensible        *bool           `json:"extensible,omitempty"`
+	ExtensibleElement *common.Element `json:"_extensible,omitempty"`
+
+	// Additional identifier for the value set (business identifier)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Indicates whether or not any change to the content logical definition may occur
+	Immutable        *bool           `json:"immutable,omitempty"`
+	ImmutableElement *common.Element `json:"_immutable,omitempty"`
+
+	// Intended jurisdiction for value set (if applicable)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Name for this value set (computer friendly)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Name of the publisher/steward (organization or individual)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Why this value set is defined
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// draft | active | retired | unknown
+	Status        ValueSetStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Name for this value set (human friendly)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Canonical identifier for this value set, represented as a URI (globally unique)
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The context that the content is intended to support
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Business version of the value set
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetStatus represents the status of a value set
+type ValueSetStatus string
+
+const (
+	ValueSetStatusDraft   ValueSetStatus = "draft"
+	ValueSetStatusActive  ValueSetStatus = "active"
+	ValueSetStatusRetired ValueSetStatus = "retired"
+	ValueSetStatusUnknown ValueSetStatus = "unknown"
+)
+
+// ValueSetComposeIncludeConceptDesignation represents additional representations for this concept
+type ValueSetComposeIncludeConceptDesignation struct {
+	common.BackboneElement
+
+	// Human language of the designation
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// Types of uses of designations
+	Use *common.Coding `json:"use,omitempty"`
+
+	// The text value for this designation
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ValueSetComposeIncludeConcept represents a concept defined in the system
+type ValueSetComposeIncludeConcept struct {
+	common.BackboneElement
+
+	// Code or expression from system
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Additional representations for this concept
+	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
+
+	// Text to display for this code for this value set in this valueset
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+}
+
+// ValueSetComposeIncludeFilter represents select codes/concepts by their properties (including relationships)
+type ValueSetComposeIncludeFilter struct {
+	common.BackboneElement
+
+	// = | is-a | descendent-of | is-not-a | regex | in | not-in | generalizes | child-of | descendent-leaf | exists
+	Op        ValueSetComposeIncludeFilterOp `json:"op"`
+	OpElement *common.Element                `json:"_op,omitempty"`
+
+	// A property/filter defined by the code system
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+
+	// Code from the system, or regex criteria, or boolean value for exists
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ValueSetComposeIncludeFilterOp represents the op of a value set compose include filter
+type ValueSetComposeIncludeFilterOp string
+
+const (
+	ValueSetComposeIncludeFilterOpEquals       ValueSetComposeIncludeFilterOp = "="
+	ValueSetComposeIncludeFilterOpIsA          ValueSetComposeIncludeFilterOp = "is-a"
+	ValueSetComposeIncludeFilterOpDescendentOf ValueSetComposeIncludeFilterOp = "descendent-of"
+	ValueSetComposeIncludeFilterOpIsNotA       ValueSetComposeIncludeFilterOp = "is-not-a"
+	ValueSetComposeIncludeFilterOpRegex        ValueSetComposeIncludeFilterOp = "regex"
+	ValueSetComposeIncludeFilterOpIn           ValueSetComposeIncludeFilterOp = "in"
+	ValueSetComposeIncludeFilterOpNotIn        ValueSetComposeIncludeFilterOp = "not-in"
+	ValueSetComposeIncludeFilterOpGeneralizes  ValueSetComposeIncludeFilterOp = "generalizes"
+	ValueSetComposeIncludeFilterOpExists       ValueSetComposeIncludeFilterOp = "exists"
+)
+
+// ValueSetComposeInclude represents include one or more codes from a code system or other value set(s)
+type ValueSetComposeInclude struct {
+	common.BackboneElement
+
+	// A concept defined in the system
+	Concept []ValueSetComposeIncludeConcept `json:"concept,omitempty"`
+
+	// Select codes/concepts by their properties (including relationships)
+	Filter []ValueSetComposeIncludeFilter `json:"filter,omitempty"`
+
+	// The system the codes come from
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// Select the contents included in this value set
+	ValueSet        []string          `json:"valueSet,omitempty"`
+	ValueSetElement []*common.Element `json:"_valueSet,omitempty"`
+
+	// Specific version of the code system referred to
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetCompose represents content logical definition of the value set (CLD)
+type ValueSetCompose struct {
+	common.BackboneElement
+
+	// Usually this is used to selectively exclude codes that were included by subsumption in the inclusions
+	Exclude []ValueSetComposeInclude `json:"exclude,omitempty"`
+
+	// Whether inactive codes are in the value set
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
+
+	// Include one or more codes from a code system or other value set(s)
+	Include []ValueSetComposeInclude `json:"include"`
+
+	// Fixed date for references with no specified version (transitive)
+	LockedDate        *common.Date    `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element `json:"_lockedDate,omitempty"`
+}
+
+// ValueSetExpansionParameter represents parameter that controlled the expansion process
+type ValueSetExpansionParameter struct {
+	common.BackboneElement
+
+	// Name as assigned by the client or server
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Value of the named parameter
+	ValueString         *string         `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element `json:"_valueString,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+	ValueUri            *string         `json:"valueUri,omitempty"`
+	ValueUriElement     *common.Element `json:"_valueUri,omitempty"`
+	ValueCode           *string         `json:"valueCode,omitempty"`
+	ValueCodeElement    *common.Element `json:"_valueCode,omitempty"`
+}
+
+// ValueSetExpansionContains represents codes in the value set
+type ValueSetExpansionContains struct {
+	common.BackboneElement
+
+	// If user cannot select this entry
+	Abstract        *bool           `json:"abstract,omitempty"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
+
+	// Code - if blank, this is not a selectable code
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// If the expansion uses this element, there is no implication about the logical relationship between them
+	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
+
+	// The designations provided must be based on the value set and code system definitions
+	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
+
+	// User display for the concept
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	// If concept is inactive in the code system
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
+
+	// System value for the code
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	// Version in which this code/display is defined
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetExpansion represents used when the value set is "expanded"
+type ValueSetExpansion struct {
+	common.BackboneElement
+
+	// Codes in the value set
+	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
+
+	// Identifies the value set expansion (business identifier)
+	Identifier        string          `json:"identifier"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
+
+	// Offset at which this resource starts
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
+
+	// Parameter that controlled the expansion process
+	Parameter []ValueSetExpansionParameter `json:"parameter,omitempty"`
+
+	// Time ValueSet expansion happened
+	Timestamp        common.DateTime `json:"timestamp"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+
+	// Total number of codes in the expansion
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
+}
@@ -0,0 +1,50 @@
+package fhir3
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+func TestXML_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("testdata/fhir3-json/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+
+	definitions := test_utils.LoadElementDefinitions(t, "testdata/fhir3-definitions")
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("failed to read example file: %v", err)
+			}
+			resource, err := UnmarshalResource(data)
+			if err != nil {
+				t.Fatalf("failed to unmarshal JSON: %v", err)
+			}
+			expected, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			xmlData, err := MarshalXML(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal XML: %v", err)
+			}
+			test_utils.CheckXMLElementOrder(t, xmlData, definitions)
+
+			decoded, err := UnmarshalResourceXML(xmlData)
+			if err != nil {
+				t.Fatalf("failed to unmarshal XML: %v\n%s", err, xmlData)
+			}
+			actual, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			test_utils.CompareXMLRoundTrip(t, expected, actual, xmlData)
+		})
+	}
+}
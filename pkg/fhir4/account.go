@@ -23,7 +23,8 @@ type AccountCoverage struct {
 	Coverage common.Reference `json:"coverage"`
 
 	// The priority of the coverage in the context of this account
-	Priority *int `json:"priority,omitempty"`
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
 }
 
 // AccountGuarantor represents guarantor information for an account
@@ -34,7 +35,8 @@ type AccountGuarantor struct {
 	Party common.Reference `json:"party"`
 
 	// A guarantor may be placed on credit hold or otherwise have their role temporarily suspended
-	OnHold *bool `json:"onHold,omitempty"`
+	OnHold        *bool           `json:"onHold,omitempty"`
+	OnHoldElement *common.Element `json:"_onHold,omitempty"`
 
 	// The timeframe during which the guarantor accepts responsibility for the account
 	Period *common.Period `json:"period,omitempty"`
@@ -51,7 +53,8 @@ type Account struct {
 	Coverage []AccountCoverage `json:"coverage,omitempty"`
 
 	// Provides additional information about what the account tracks and how it is used
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The parties responsible for balancing the account if other payment options fall short
 	Guarantor []AccountGuarantor `json:"guarantor,omitempty"`
@@ -60,7 +63,8 @@ type Account struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Name used for the account when displaying it to humans in reports, etc
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Indicates the service area, hospital, department, etc. with responsibility for managing the Account
 	Owner *common.Reference `json:"owner,omitempty"`
@@ -72,7 +76,8 @@ type Account struct {
 	ServicePeriod *common.Period `json:"servicePeriod,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the codes inactive and entered-in-error that mark the Account as not currently valid
-	Status AccountStatus `json:"status"`
+	Status        AccountStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Accounts can be applied to non-patients for tracking other non-patient related activities, such as group services (patients not tracked, and costs charged to another body), or might not be allocated
 	Subject []common.Reference `json:"subject,omitempty"`
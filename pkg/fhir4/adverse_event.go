@@ -26,7 +26,8 @@ type AdverseEventSuspectEntityCausality struct {
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// AdverseEvent.suspectEntity.causalityProductRelatedness
-	ProductRelatedness *string `json:"productRelatedness,omitempty"`
+	ProductRelatedness        *string         `json:"productRelatedness,omitempty"`
+	ProductRelatednessElement *common.Element `json:"_productRelatedness,omitempty"`
 }
 
 // AdverseEventSuspectEntity represents the entity that is suspected to have caused the adverse event
@@ -48,7 +49,8 @@ type AdverseEvent struct {
 	ResourceType string `json:"resourceType"` // Always "AdverseEvent"
 
 	// Whether the event actually happened, or just had the potential to. Note that this is independent of whether anyone was affected or harmed or how severely
-	Actuality AdverseEventActuality `json:"actuality"`
+	Actuality        AdverseEventActuality `json:"actuality"`
+	ActualityElement *common.Element       `json:"_actuality,omitempty"`
 
 	// The overall type of event, intended for search and filtering purposes
 	Category []common.CodeableConcept `json:"category,omitempty"`
@@ -57,10 +59,12 @@ type AdverseEvent struct {
 	Contributor []common.Reference `json:"contributor,omitempty"`
 
 	// The date (and perhaps time) when the adverse event occurred
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Estimated or actual date the AdverseEvent began, in the opinion of the reporter
-	Detected *string `json:"detected,omitempty"`
+	Detected        *common.DateTime `json:"detected,omitempty"`
+	DetectedElement *common.Element  `json:"_detected,omitempty"`
 
 	// This will typically be the encounter the event occurred within, but some activities may be initiated prior to or after the official completion of an encounter but still be tied to the context of the encounter
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -78,7 +82,8 @@ type AdverseEvent struct {
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
 
 	// The recordedDate represents the date when this particular AdverseEvent record was created in the system, not the date of the most recent update
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Information on who recorded the adverse event. May be the patient or a practitioner
 	Recorder *common.Reference `json:"recorder,omitempty"`
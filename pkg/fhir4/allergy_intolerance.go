@@ -22,13 +22,16 @@ type AllergyIntolerance struct {
 	VerificationStatus *common.CodeableConcept `json:"verificationStatus,omitempty"`
 
 	// allergy | intolerance - Underlying mechanism (if known)
-	Type *AllergyIntoleranceType `json:"type,omitempty"`
+	Type        *AllergyIntoleranceType `json:"type,omitempty"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
 
 	// food | medication | environment | biologic
-	Category []AllergyIntoleranceCategory `json:"category,omitempty"`
+	Category        []AllergyIntoleranceCategory `json:"category,omitempty"`
+	CategoryElement []*common.Element            `json:"_category,omitempty"`
 
 	// low | high | unable-to-assess
-	Criticality *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	Criticality        *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	CriticalityElement *common.Element                `json:"_criticality,omitempty"`
 
 	// Code that identifies the allergy or intolerance
 	Code *common.CodeableConcept `json:"code,omitempty"`
@@ -40,14 +43,17 @@ type AllergyIntolerance struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Date first version of the resource instance was recorded
-	OnsetDateTime *string        `json:"onsetDateTime,omitempty"`
-	OnsetAge      *Age           `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *Age             `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// Date(/time) of last known occurrence of a reaction
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Who recorded the sensitivity
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -56,7 +62,8 @@ type AllergyIntolerance struct {
 	Asserter *common.Reference `json:"asserter,omitempty"`
 
 	// Date(/time) of last known occurrence of a reaction
-	LastOccurrence *string `json:"lastOccurrence,omitempty"`
+	LastOccurrence        *common.DateTime `json:"lastOccurrence,omitempty"`
+	LastOccurrenceElement *common.Element  `json:"_lastOccurrence,omitempty"`
 
 	// Additional text not captured in other fields
 	Note []Annotation `json:"note,omitempty"`
@@ -103,13 +110,16 @@ type AllergyIntoleranceReaction struct {
 	Manifestation []common.CodeableConcept `json:"manifestation"`
 
 	// Description of the event as a whole
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Date(/time) when manifestations showed
-	Onset *string `json:"onset,omitempty"`
+	Onset        *common.DateTime `json:"onset,omitempty"`
+	OnsetElement *common.Element  `json:"_onset,omitempty"`
 
 	// mild | moderate | severe (of event as a whole)
-	Severity *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	Severity        *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
 
 	// How the subject was exposed to the substance
 	ExposureRoute *common.CodeableConcept `json:"exposureRoute,omitempty"`
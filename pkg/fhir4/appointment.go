@@ -41,10 +41,12 @@ type AppointmentParticipant struct {
 	Actor *common.Reference `json:"actor,omitempty"`
 
 	// Whether this participant is required to be present at the meeting
-	Required *string `json:"required,omitempty"`
+	Required        *string         `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
 
 	// Participation status of the actor
-	Status AppointmentParticipantStatus `json:"status"`
+	Status        AppointmentParticipantStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
 
 	// Period of participation during the appointment
 	Period *common.Period `json:"period,omitempty"`
@@ -61,7 +63,8 @@ type Appointment struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The overall status of the Appointment. Each of the participants has their own participation status which indicates their involvement in the process, however this status indicates the shared status
-	Status AppointmentStatus `json:"status"`
+	Status        AppointmentStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// A broad categorization of the service that is to be performed during this appointment
 	ServiceCategory []common.CodeableConcept `json:"serviceCategory,omitempty"`
@@ -82,34 +85,42 @@ type Appointment struct {
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
 	// The priority of the appointment. Can be used to make informed decisions if needing to re-prioritize appointments
-	Priority *int `json:"priority,omitempty"`
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
 
 	// The brief description of the appointment as would be shown on a subject line in a meeting request, or appointment list
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Additional information to support the appointment
 	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
 
 	// Date/Time that the appointment is to take place
-	Start *string `json:"start,omitempty"`
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// Date/Time that the appointment is to conclude
-	End *string `json:"end,omitempty"`
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// Number of minutes that the appointment is to take
-	MinutesDuration *int `json:"minutesDuration,omitempty"`
+	MinutesDuration        *int            `json:"minutesDuration,omitempty"`
+	MinutesDurationElement *common.Element `json:"_minutesDuration,omitempty"`
 
 	// The slots that this appointment is filling
 	Slot []common.Reference `json:"slot,omitempty"`
 
 	// The date that this appointment was initially created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// Additional comments about the appointment
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// While appointments can be made for a specific patient, they are often made for a group of patients
-	PatientInstruction *string `json:"patientInstruction,omitempty"`
+	PatientInstruction        *string         `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *common.Element `json:"_patientInstruction,omitempty"`
 
 	// The service request this appointment is allocated to assess
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -28,10 +28,12 @@ type AppointmentResponse struct {
 	Appointment common.Reference `json:"appointment"`
 
 	// Date/Time that the appointment is to take place, or requested new start time
-	Start *string `json:"start,omitempty"`
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// This may be either the same as the appointment request to confirm the details of the appointment, or alternately a new time to request a re-negotiation of the end time
-	End *string `json:"end,omitempty"`
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// Role of participant in the appointment
 	ParticipantType []common.CodeableConcept `json:"participantType,omitempty"`
@@ -40,8 +42,10 @@ type AppointmentResponse struct {
 	Actor *common.Reference `json:"actor,omitempty"`
 
 	// Participation status of the participant
-	ParticipantStatus AppointmentResponseParticipantStatus `json:"participantStatus"`
+	ParticipantStatus        AppointmentResponseParticipantStatus `json:"participantStatus"`
+	ParticipantStatusElement *common.Element                      `json:"_participantStatus,omitempty"`
 
 	// Additional comments about the appointment
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 }
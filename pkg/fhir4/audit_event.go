@@ -50,19 +50,23 @@ type AuditEventAgent struct {
 	Who *common.Reference `json:"who,omitempty"`
 
 	// Alternative agent Identifier. For a human, this should be the user ID
-	AltID *string `json:"altId,omitempty"`
+	AltID        *string         `json:"altId,omitempty"`
+	AltIDElement *common.Element `json:"_altId,omitempty"`
 
 	// Human-meaningful name for the agent
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Indicator that the user is or is not the requestor, or initiator, for the event being audited
-	Requestor bool `json:"requestor"`
+	Requestor        bool            `json:"requestor"`
+	RequestorElement *common.Element `json:"_requestor,omitempty"`
 
 	// Where the event occurred
 	Location *common.Reference `json:"location,omitempty"`
 
 	// The policy or plan that authorized the activity being recorded
-	Policy []string `json:"policy,omitempty"`
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
 
 	// Type of media involved
 	Media *common.Coding `json:"media,omitempty"`
@@ -79,10 +83,12 @@ type AuditEventAgentNetwork struct {
 	common.BackboneElement
 
 	// An identifier for the network access point of the user device for the audit event
-	Address *string `json:"address,omitempty"`
+	Address        *string         `json:"address,omitempty"`
+	AddressElement *common.Element `json:"_address,omitempty"`
 
 	// An identifier for the type of network access point that originated the audit event
-	Type *AuditEventAgentNetworkType `json:"type,omitempty"`
+	Type        *AuditEventAgentNetworkType `json:"type,omitempty"`
+	TypeElement *common.Element             `json:"_type,omitempty"`
 }
 
 // AuditEventSource represents the source of the event
@@ -90,7 +96,8 @@ type AuditEventSource struct {
 	common.BackboneElement
 
 	// Logical source location within the healthcare enterprise network
-	Site *string `json:"site,omitempty"`
+	Site        *string         `json:"site,omitempty"`
+	SiteElement *common.Element `json:"_site,omitempty"`
 
 	// Identifier of the source where the event originated
 	Observer common.Reference `json:"observer"`
@@ -116,13 +123,16 @@ type AuditEventEntity struct {
 	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
 
 	// A value that describes the entity
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Text that describes the entity in more detail
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The query parameters for a query-type entities
-	Query *string `json:"query,omitempty"`
+	Query        *string         `json:"query,omitempty"`
+	QueryElement *common.Element `json:"_query,omitempty"`
 
 	// Tagged value pairs for conveying additional information about the entity
 	Detail []AuditEventEntityDetail `json:"detail,omitempty"`
@@ -133,11 +143,14 @@ type AuditEventEntityDetail struct {
 	common.BackboneElement
 
 	// The type of extra detail provided in the value
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// The value of the extra detail
-	ValueString       *string `json:"valueString,omitempty"`
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueString              *string         `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element `json:"_valueString,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 }
 
 // AuditEvent represents a record of an event made for purposes of maintaining a security log
@@ -154,19 +167,23 @@ type AuditEvent struct {
 	Subtype []common.Coding `json:"subtype,omitempty"`
 
 	// Indicator for type of action performed during the event that generated the audit
-	Action *AuditEventAction `json:"action,omitempty"`
+	Action        *AuditEventAction `json:"action,omitempty"`
+	ActionElement *common.Element   `json:"_action,omitempty"`
 
 	// The period during which the recorded activity occurred
 	Period *common.Period `json:"period,omitempty"`
 
 	// The time when the event was recorded
-	Recorded *string `json:"recorded"`
+	Recorded        *common.Instant `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
 
 	// Indicates whether the event succeeded or failed
-	Outcome *AuditEventOutcome `json:"outcome,omitempty"`
+	Outcome        *AuditEventOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element    `json:"_outcome,omitempty"`
 
 	// A free text description of the outcome of the event
-	OutcomeDesc *string `json:"outcomeDesc,omitempty"`
+	OutcomeDesc        *string         `json:"outcomeDesc,omitempty"`
+	OutcomeDescElement *common.Element `json:"_outcomeDesc,omitempty"`
 
 	// The purposeOfUse (reason) that was used during the event being recorded
 	PurposeOfEvent []common.CodeableConcept `json:"purposeOfEvent,omitempty"`
@@ -21,7 +21,8 @@ type Basic struct {
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// Identifies when the resource was first created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// Indicates who was responsible for creating the resource
 	Author *common.Reference `json:"author,omitempty"`
@@ -1,5 +1,9 @@
 package fhir4
 
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
 // Binary represents a binary resource that contains content
 type Binary struct {
 	Resource
@@ -8,23 +12,30 @@ type Binary struct {
 	ResourceType string `json:"resourceType"` // Always "Binary"
 
 	// MimeType of the binary content represented as a standard MimeType (BCP 13)
-	ContentType string `json:"contentType"`
+	ContentType        string          `json:"contentType"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The actual content, base64 encoded
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// A location where the data can be accessed
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// The number of bytes of data that make up this attachment
-	Size *int64 `json:"size,omitempty"`
+	Size        *int64          `json:"size,omitempty"`
+	SizeElement *common.Element `json:"_size,omitempty"`
 
 	// The calculated hash of the data using SHA-1
-	Hash *string `json:"hash,omitempty"`
+	Hash        *string         `json:"hash,omitempty"`
+	HashElement *common.Element `json:"_hash,omitempty"`
 
 	// A label or set of text to display in place of the data
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The date that the attachment was first created
-	Creation *string `json:"creation,omitempty"`
+	Creation        *string         `json:"creation,omitempty"`
+	CreationElement *common.Element `json:"_creation,omitempty"`
 }
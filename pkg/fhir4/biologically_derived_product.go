@@ -34,8 +34,9 @@ type BiologicallyDerivedProductCollection struct {
 	Source *common.Reference `json:"source,omitempty"`
 
 	// Time of product collection
-	CollectedDateTime *string        `json:"collectedDateTime,omitempty"`
-	CollectedPeriod   *common.Period `json:"collectedPeriod,omitempty"`
+	CollectedDateTime        *common.DateTime `json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *common.Element  `json:"_collectedDateTime,omitempty"`
+	CollectedPeriod          *common.Period   `json:"collectedPeriod,omitempty"`
 }
 
 // BiologicallyDerivedProductProcessing represents processing information
@@ -43,7 +44,8 @@ type BiologicallyDerivedProductProcessing struct {
 	common.BackboneElement
 
 	// Description of of processing
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Procesing code
 	Procedure *common.CodeableConcept `json:"procedure,omitempty"`
@@ -52,8 +54,9 @@ type BiologicallyDerivedProductProcessing struct {
 	Additive *common.Reference `json:"additive,omitempty"`
 
 	// Time of processing
-	TimeDateTime *string        `json:"timeDateTime,omitempty"`
-	TimePeriod   *common.Period `json:"timePeriod,omitempty"`
+	TimeDateTime        *common.DateTime `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element  `json:"_timeDateTime,omitempty"`
+	TimePeriod          *common.Period   `json:"timePeriod,omitempty"`
 }
 
 // BiologicallyDerivedProductManipulation represents manipulation information
@@ -61,11 +64,13 @@ type BiologicallyDerivedProductManipulation struct {
 	common.BackboneElement
 
 	// Description of manipulation
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Time of manipulation
-	TimeDateTime *string        `json:"timeDateTime,omitempty"`
-	TimePeriod   *common.Period `json:"timePeriod,omitempty"`
+	TimeDateTime        *common.DateTime `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element  `json:"_timeDateTime,omitempty"`
+	TimePeriod          *common.Period   `json:"timePeriod,omitempty"`
 }
 
 // BiologicallyDerivedProductStorage represents storage information
@@ -73,13 +78,16 @@ type BiologicallyDerivedProductStorage struct {
 	common.BackboneElement
 
 	// Description of storage
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Storage temperature
-	Temperature *float64 `json:"temperature,omitempty"`
+	Temperature        *common.Decimal `json:"temperature,omitempty"`
+	TemperatureElement *common.Element `json:"_temperature,omitempty"`
 
 	// Temperature scale used
-	Scale *string `json:"scale,omitempty"`
+	Scale        *string         `json:"scale,omitempty"`
+	ScaleElement *common.Element `json:"_scale,omitempty"`
 
 	// Storage timeperiod
 	Duration *common.Period `json:"duration,omitempty"`
@@ -96,19 +104,22 @@ type BiologicallyDerivedProduct struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Broad category of this product
-	ProductCategory *BiologicallyDerivedProductCategory `json:"productCategory,omitempty"`
+	ProductCategory        *BiologicallyDerivedProductCategory `json:"productCategory,omitempty"`
+	ProductCategoryElement *common.Element                     `json:"_productCategory,omitempty"`
 
 	// A code that identifies the kind of this biologically derived product (SNOMED Ctcode)
 	ProductCode *common.CodeableConcept `json:"productCode,omitempty"`
 
 	// Whether the product is currently available
-	Status *BiologicallyDerivedProductStatus `json:"status,omitempty"`
+	Status        *BiologicallyDerivedProductStatus `json:"status,omitempty"`
+	StatusElement *common.Element                   `json:"_status,omitempty"`
 
 	// Procedure request to obtain this biologically derived product
 	Request []common.Reference `json:"request,omitempty"`
 
 	// Number of discrete units within this product
-	Quantity *int `json:"quantity,omitempty"`
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
 
 	// Parent product
 	Parent []common.Reference `json:"parent,omitempty"`
@@ -15,7 +15,8 @@ type BodyStructure struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Whether this record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// The kind of structure being represented by the resource at BodyStructure.location
 	Morphology *common.CodeableConcept `json:"morphology,omitempty"`
@@ -27,7 +28,8 @@ type BodyStructure struct {
 	LocationQualifier []common.CodeableConcept `json:"locationQualifier,omitempty"`
 
 	// A summary, characterization or explanation of the body structure
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Image or images used to identify a location
 	Image []common.Attachment `json:"image,omitempty"`
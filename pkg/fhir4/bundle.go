@@ -2,6 +2,8 @@
 package fhir4
 
 import (
+	"encoding/json"
+
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
@@ -13,13 +15,16 @@ type Bundle struct {
 	ResourceType string `json:"resourceType"` // Always "Bundle"
 
 	// Identifies the purpose of this bundle
-	Type BundleType `json:"type"`
+	Type        BundleType      `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Optional timestamp when this bundle was assembled
-	Timestamp *string `json:"timestamp,omitempty"`
+	Timestamp        *common.Instant `json:"timestamp,omitempty"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
 
 	// If a set of search matches, this is the total number of matches for the search
-	Total *int `json:"total,omitempty"`
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
 
 	// A series of links that provide context to this bundle
 	Link []BundleLink `json:"link,omitempty"`
@@ -54,10 +59,12 @@ type BundleLink struct {
 	common.BackboneElement
 
 	// A name which details the functional use for this link
-	Relation string `json:"relation"`
+	Relation        string          `json:"relation"`
+	RelationElement *common.Element `json:"_relation,omitempty"`
 
 	// The reference details for the link
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntry represents an entry in a bundle
@@ -65,13 +72,14 @@ type BundleEntry struct {
 	common.BackboneElement
 
 	// URI for resource (Absolute URL or relative URL)
-	FullURL *string `json:"fullUrl,omitempty"`
+	FullURL        *string         `json:"fullUrl,omitempty"`
+	FullURLElement *common.Element `json:"_fullUrl,omitempty"`
 
 	// A series of links that provide context to this entry
 	Link []BundleLink `json:"link,omitempty"`
 
 	// The Resource for the entry
-	Resource interface{} `json:"resource,omitempty"`
+	Resource common.Resource `json:"resource,omitempty"`
 
 	// Information about the search process that lead to the creation of this entry
 	Search *BundleEntrySearch `json:"search,omitempty"`
@@ -88,10 +96,12 @@ type BundleEntrySearch struct {
 	common.BackboneElement
 
 	// search | match | include | outcome - why this entry is in the result set
-	Mode *BundleEntrySearchMode `json:"mode,omitempty"`
+	Mode        *BundleEntrySearchMode `json:"mode,omitempty"`
+	ModeElement *common.Element        `json:"_mode,omitempty"`
 
 	// Search ranking (between 0..1)
-	Score *float64 `json:"score,omitempty"`
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
 }
 
 // BundleEntrySearchMode represents the mode of a bundle entry search
@@ -109,22 +119,28 @@ type BundleEntryRequest struct {
 	common.BackboneElement
 
 	// In a transaction or batch, this is the HTTP action to be executed for this entry
-	Method BundleEntryRequestMethod `json:"method"`
+	Method        BundleEntryRequestMethod `json:"method"`
+	MethodElement *common.Element          `json:"_method,omitempty"`
 
 	// URL for HTTP equivalent of this entry
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// For managing cache currency
-	IfNoneMatch *string `json:"ifNoneMatch,omitempty"`
+	IfNoneMatch        *string         `json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement *common.Element `json:"_ifNoneMatch,omitempty"`
 
 	// For managing cache currency
-	IfModifiedSince *string `json:"ifModifiedSince,omitempty"`
+	IfModifiedSince        *common.Instant `json:"ifModifiedSince,omitempty"`
+	IfModifiedSinceElement *common.Element `json:"_ifModifiedSince,omitempty"`
 
 	// For managing update contention
-	IfMatch *string `json:"ifMatch,omitempty"`
+	IfMatch        *string         `json:"ifMatch,omitempty"`
+	IfMatchElement *common.Element `json:"_ifMatch,omitempty"`
 
 	// For conditional creates
-	IfNoneExist *string `json:"ifNoneExist,omitempty"`
+	IfNoneExist        *string         `json:"ifNoneExist,omitempty"`
+	IfNoneExistElement *common.Element `json:"_ifNoneExist,omitempty"`
 }
 
 // BundleEntryRequestMethod represents the HTTP method for a bundle entry request
@@ -143,17 +159,61 @@ type BundleEntryResponse struct {
 	common.BackboneElement
 
 	// Status response code (text optional)
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The location header created by processing this operation
-	Location *string `json:"location,omitempty"`
+	Location        *string         `json:"location,omitempty"`
+	LocationElement *common.Element `json:"_location,omitempty"`
 
 	// The etag for the resource (if relevant)
-	Etag *string `json:"etag,omitempty"`
+	Etag        *string         `json:"etag,omitempty"`
+	EtagElement *common.Element `json:"_etag,omitempty"`
 
 	// Server's date time modified
-	LastModified *string `json:"lastModified,omitempty"`
+	LastModified        *common.Instant `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element `json:"_lastModified,omitempty"`
 
 	// OperationOutcome with hints and warnings (for batch/transaction)
-	Outcome interface{} `json:"outcome,omitempty"`
+	Outcome common.Resource `json:"outcome,omitempty"`
+}
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (e *BundleEntry) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntry
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	e.Resource = resource
+	return nil
+}
+
+// UnmarshalJSON decodes the inline OperationOutcome into the struct matching its resourceType
+func (r *BundleEntryResponse) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntryResponse
+	aux := &struct {
+		*Alias
+		Outcome json.RawMessage `json:"outcome,omitempty"`
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Outcome)
+	if err != nil {
+		return err
+	}
+	r.Outcome = resource
+	return nil
 }
@@ -13,166 +13,227 @@ type CapabilityStatement struct {
 	// Resource Type Name (for serialization)
 	ResourceType string `json:"resourceType"`
 
-	URL                 *string                            `json:"url,omitempty"`
-	Version             *string                            `json:"version,omitempty"`
-	Name                *string                            `json:"name,omitempty"`
-	Title               *string                            `json:"title,omitempty"`
-	Status              string                             `json:"status"`
-	Experimental        *bool                              `json:"experimental,omitempty"`
-	Date                string                             `json:"date"`
-	Publisher           *string                            `json:"publisher,omitempty"`
-	Contact             []common.ContactDetail             `json:"contact,omitempty"`
-	Description         *string                            `json:"description,omitempty"`
-	UseContext          []common.UsageContext              `json:"useContext,omitempty"`
-	Jurisdiction        []common.CodeableConcept           `json:"jurisdiction,omitempty"`
-	Purpose             *string                            `json:"purpose,omitempty"`
-	Copyright           *string                            `json:"copyright,omitempty"`
-	Kind                string                             `json:"kind"`
-	Instantiates        []string                           `json:"instantiates,omitempty"`
-	Imports             []string                           `json:"imports,omitempty"`
-	Software            *CapabilityStatementSoftware       `json:"software,omitempty"`
-	Implementation      *CapabilityStatementImplementation `json:"implementation,omitempty"`
-	FHIRVersion         string                             `json:"fhirVersion"`
-	Format              []string                           `json:"format"`
-	PatchFormat         []string                           `json:"patchFormat,omitempty"`
-	ImplementationGuide []string                           `json:"implementationGuide,omitempty"`
-	Rest                []CapabilityStatementRest          `json:"rest,omitempty"`
-	Messaging           []CapabilityStatementMessaging     `json:"messaging,omitempty"`
-	Document            []CapabilityStatementDocument      `json:"document,omitempty"`
+	URL                        *string                            `json:"url,omitempty"`
+	URLElement                 *common.Element                    `json:"_url,omitempty"`
+	Version                    *string                            `json:"version,omitempty"`
+	VersionElement             *common.Element                    `json:"_version,omitempty"`
+	Name                       *string                            `json:"name,omitempty"`
+	NameElement                *common.Element                    `json:"_name,omitempty"`
+	Title                      *string                            `json:"title,omitempty"`
+	TitleElement               *common.Element                    `json:"_title,omitempty"`
+	Status                     string                             `json:"status"`
+	StatusElement              *common.Element                    `json:"_status,omitempty"`
+	Experimental               *bool                              `json:"experimental,omitempty"`
+	ExperimentalElement        *common.Element                    `json:"_experimental,omitempty"`
+	Date                       common.DateTime                    `json:"date"`
+	DateElement                *common.Element                    `json:"_date,omitempty"`
+	Publisher                  *string                            `json:"publisher,omitempty"`
+	PublisherElement           *common.Element                    `json:"_publisher,omitempty"`
+	Contact                    []common.ContactDetail             `json:"contact,omitempty"`
+	Description                *string                            `json:"description,omitempty"`
+	DescriptionElement         *common.Element                    `json:"_description,omitempty"`
+	UseContext                 []common.UsageContext              `json:"useContext,omitempty"`
+	Jurisdiction               []common.CodeableConcept           `json:"jurisdiction,omitempty"`
+	Purpose                    *string                            `json:"purpose,omitempty"`
+	PurposeElement             *common.Element                    `json:"_purpose,omitempty"`
+	Copyright                  *string                            `json:"copyright,omitempty"`
+	CopyrightElement           *common.Element                    `json:"_copyright,omitempty"`
+	Kind                       string                             `json:"kind"`
+	KindElement                *common.Element                    `json:"_kind,omitempty"`
+	Instantiates               []string                           `json:"instantiates,omitempty"`
+	InstantiatesElement        []*common.Element                  `json:"_instantiates,omitempty"`
+	Imports                    []string                           `json:"imports,omitempty"`
+	ImportsElement             []*common.Element                  `json:"_imports,omitempty"`
+	Software                   *CapabilityStatementSoftware       `json:"software,omitempty"`
+	Implementation             *CapabilityStatementImplementation `json:"implementation,omitempty"`
+	FHIRVersion                string                             `json:"fhirVersion"`
+	FHIRVersionElement         *common.Element                    `json:"_fhirVersion,omitempty"`
+	Format                     []string                           `json:"format"`
+	FormatElement              []*common.Element                  `json:"_format,omitempty"`
+	PatchFormat                []string                           `json:"patchFormat,omitempty"`
+	PatchFormatElement         []*common.Element                  `json:"_patchFormat,omitempty"`
+	ImplementationGuide        []string                           `json:"implementationGuide,omitempty"`
+	ImplementationGuideElement []*common.Element                  `json:"_implementationGuide,omitempty"`
+	Rest                       []CapabilityStatementRest          `json:"rest,omitempty"`
+	Messaging                  []CapabilityStatementMessaging     `json:"messaging,omitempty"`
+	Document                   []CapabilityStatementDocument      `json:"document,omitempty"`
 }
 
 // CapabilityStatementSoftware represents software information
 type CapabilityStatementSoftware struct {
 	common.BackboneElement
 
-	Name        string  `json:"name"`
-	Version     *string `json:"version,omitempty"`
-	ReleaseDate *string `json:"releaseDate,omitempty"`
+	Name               string           `json:"name"`
+	NameElement        *common.Element  `json:"_name,omitempty"`
+	Version            *string          `json:"version,omitempty"`
+	VersionElement     *common.Element  `json:"_version,omitempty"`
+	ReleaseDate        *common.DateTime `json:"releaseDate,omitempty"`
+	ReleaseDateElement *common.Element  `json:"_releaseDate,omitempty"`
 }
 
 // CapabilityStatementImplementation represents implementation details
 type CapabilityStatementImplementation struct {
 	common.BackboneElement
 
-	Description string            `json:"description"`
-	URL         *string           `json:"url,omitempty"`
-	Custodian   *common.Reference `json:"custodian,omitempty"`
+	Description        string            `json:"description"`
+	DescriptionElement *common.Element   `json:"_description,omitempty"`
+	URL                *string           `json:"url,omitempty"`
+	URLElement         *common.Element   `json:"_url,omitempty"`
+	Custodian          *common.Reference `json:"custodian,omitempty"`
 }
 
 // CapabilityStatementRest represents REST capabilities
 type CapabilityStatementRest struct {
 	common.BackboneElement
 
-	Mode          string                                       `json:"mode"`
-	Documentation *string                                      `json:"documentation,omitempty"`
-	Security      *CapabilityStatementRestSecurity             `json:"security,omitempty"`
-	Resource      []CapabilityStatementRestResource            `json:"resource,omitempty"`
-	Interaction   []CapabilityStatementRestInteraction         `json:"interaction,omitempty"`
-	SearchParam   []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
-	Operation     []CapabilityStatementRestResourceOperation   `json:"operation,omitempty"`
-	Compartment   []string                                     `json:"compartment,omitempty"`
+	Mode                 string                                       `json:"mode"`
+	ModeElement          *common.Element                              `json:"_mode,omitempty"`
+	Documentation        *string                                      `json:"documentation,omitempty"`
+	DocumentationElement *common.Element                              `json:"_documentation,omitempty"`
+	Security             *CapabilityStatementRestSecurity             `json:"security,omitempty"`
+	Resource             []CapabilityStatementRestResource            `json:"resource,omitempty"`
+	Interaction          []CapabilityStatementRestInteraction         `json:"interaction,omitempty"`
+	SearchParam          []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
+	Operation            []CapabilityStatementRestResourceOperation   `json:"operation,omitempty"`
+	Compartment          []string                                     `json:"compartment,omitempty"`
+	CompartmentElement   []*common.Element                            `json:"_compartment,omitempty"`
 }
 
 // CapabilityStatementRestSecurity represents security information
 type CapabilityStatementRestSecurity struct {
 	common.BackboneElement
 
-	Cors        *bool                    `json:"cors,omitempty"`
-	Service     []common.CodeableConcept `json:"service,omitempty"`
-	Description *string                  `json:"description,omitempty"`
+	Cors               *bool                    `json:"cors,omitempty"`
+	CorsElement        *common.Element          `json:"_cors,omitempty"`
+	Service            []common.CodeableConcept `json:"service,omitempty"`
+	Description        *string                  `json:"description,omitempty"`
+	DescriptionElement *common.Element          `json:"_description,omitempty"`
 }
 
 // CapabilityStatementRestResource represents resource capabilities
 type CapabilityStatementRestResource struct {
 	common.BackboneElement
 
-	Type              string                                       `json:"type"`
-	Profile           *string                                      `json:"profile,omitempty"`
-	SupportedProfile  []string                                     `json:"supportedProfile,omitempty"`
-	Documentation     *string                                      `json:"documentation,omitempty"`
-	Interaction       []CapabilityStatementRestResourceInteraction `json:"interaction,omitempty"`
-	Versioning        *string                                      `json:"versioning,omitempty"`
-	ReadHistory       *bool                                        `json:"readHistory,omitempty"`
-	UpdateCreate      *bool                                        `json:"updateCreate,omitempty"`
-	ConditionalCreate *bool                                        `json:"conditionalCreate,omitempty"`
-	ConditionalRead   *string                                      `json:"conditionalRead,omitempty"`
-	ConditionalUpdate *bool                                        `json:"conditionalUpdate,omitempty"`
-	ConditionalDelete *string                                      `json:"conditionalDelete,omitempty"`
-	ReferencePolicy   []string                                     `json:"referencePolicy,omitempty"`
-	SearchInclude     []string                                     `json:"searchInclude,omitempty"`
-	SearchRevInclude  []string                                     `json:"searchRevInclude,omitempty"`
-	SearchParam       []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
-	Operation         []CapabilityStatementRestResourceOperation   `json:"operation,omitempty"`
+	Type                     string                                       `json:"type"`
+	TypeElement              *common.Element                              `json:"_type,omitempty"`
+	Profile                  *string                                      `json:"profile,omitempty"`
+	ProfileElement           *common.Element                              `json:"_profile,omitempty"`
+	SupportedProfile         []string                                     `json:"supportedProfile,omitempty"`
+	SupportedProfileElement  []*common.Element                            `json:"_supportedProfile,omitempty"`
+	Documentation            *string                                      `json:"documentation,omitempty"`
+	DocumentationElement     *common.Element                              `json:"_documentation,omitempty"`
+	Interaction              []CapabilityStatementRestResourceInteraction `json:"interaction,omitempty"`
+	Versioning               *string                                      `json:"versioning,omitempty"`
+	VersioningElement        *common.Element                              `json:"_versioning,omitempty"`
+	ReadHistory              *bool                                        `json:"readHistory,omitempty"`
+	ReadHistoryElement       *common.Element                              `json:"_readHistory,omitempty"`
+	UpdateCreate             *bool                                        `json:"updateCreate,omitempty"`
+	UpdateCreateElement      *common.Element                              `json:"_updateCreate,omitempty"`
+	ConditionalCreate        *bool                                        `json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *common.Element                              `json:"_conditionalCreate,omitempty"`
+	ConditionalRead          *string                                      `json:"conditionalRead,omitempty"`
+	ConditionalReadElement   *common.Element                              `json:"_conditionalRead,omitempty"`
+	ConditionalUpdate        *bool                                        `json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *common.Element                              `json:"_conditionalUpdate,omitempty"`
+	ConditionalDelete        *string                                      `json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *common.Element                              `json:"_conditionalDelete,omitempty"`
+	ReferencePolicy          []string                                     `json:"referencePolicy,omitempty"`
+	ReferencePolicyElement   []*common.Element                            `json:"_referencePolicy,omitempty"`
+	SearchInclude            []string                                     `json:"searchInclude,omitempty"`
+	SearchIncludeElement     []*common.Element                            `json:"_searchInclude,omitempty"`
+	SearchRevInclude         []string                                     `json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement  []*common.Element                            `json:"_searchRevInclude,omitempty"`
+	SearchParam              []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
+	Operation                []CapabilityStatementRestResourceOperation   `json:"operation,omitempty"`
 }
 
 // CapabilityStatementRestResourceInteraction represents resource interactions
 type CapabilityStatementRestResourceInteraction struct {
 	common.BackboneElement
 
-	Code          string  `json:"code"`
-	Documentation *string `json:"documentation,omitempty"`
+	Code                 string          `json:"code"`
+	CodeElement          *common.Element `json:"_code,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // CapabilityStatementRestResourceSearchParam represents search parameters
 type CapabilityStatementRestResourceSearchParam struct {
 	common.BackboneElement
 
-	Name          string  `json:"name"`
-	Definition    *string `json:"definition,omitempty"`
-	Type          string  `json:"type"`
-	Documentation *string `json:"documentation,omitempty"`
+	Name                 string          `json:"name"`
+	NameElement          *common.Element `json:"_name,omitempty"`
+	Definition           *string         `json:"definition,omitempty"`
+	DefinitionElement    *common.Element `json:"_definition,omitempty"`
+	Type                 string          `json:"type"`
+	TypeElement          *common.Element `json:"_type,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // CapabilityStatementRestResourceOperation represents operations
 type CapabilityStatementRestResourceOperation struct {
 	common.BackboneElement
 
-	Name          string  `json:"name"`
-	Definition    string  `json:"definition"`
-	Documentation *string `json:"documentation,omitempty"`
+	Name                 string          `json:"name"`
+	NameElement          *common.Element `json:"_name,omitempty"`
+	Definition           string          `json:"definition"`
+	DefinitionElement    *common.Element `json:"_definition,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // CapabilityStatementRestInteraction represents REST interactions
 type CapabilityStatementRestInteraction struct {
 	common.BackboneElement
 
-	Code          string  `json:"code"`
-	Documentation *string `json:"documentation,omitempty"`
+	Code                 string          `json:"code"`
+	CodeElement          *common.Element `json:"_code,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // CapabilityStatementMessaging represents messaging capabilities
 type CapabilityStatementMessaging struct {
 	common.BackboneElement
 
-	Endpoint         []CapabilityStatementMessagingEndpoint         `json:"endpoint,omitempty"`
-	ReliableCache    *int                                           `json:"reliableCache,omitempty"`
-	Documentation    *string                                        `json:"documentation,omitempty"`
-	SupportedMessage []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty"`
+	Endpoint             []CapabilityStatementMessagingEndpoint         `json:"endpoint,omitempty"`
+	ReliableCache        *int                                           `json:"reliableCache,omitempty"`
+	ReliableCacheElement *common.Element                                `json:"_reliableCache,omitempty"`
+	Documentation        *string                                        `json:"documentation,omitempty"`
+	DocumentationElement *common.Element                                `json:"_documentation,omitempty"`
+	SupportedMessage     []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty"`
 }
 
 // CapabilityStatementMessagingEndpoint represents messaging endpoints
 type CapabilityStatementMessagingEndpoint struct {
 	common.BackboneElement
 
-	Protocol common.Coding `json:"protocol"`
-	Address  string        `json:"address"`
+	Protocol       common.Coding   `json:"protocol"`
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
 }
 
 // CapabilityStatementMessagingSupportedMessage represents supported messages
 type CapabilityStatementMessagingSupportedMessage struct {
 	common.BackboneElement
 
-	Mode       string `json:"mode"`
-	Definition string `json:"definition"`
+	Mode              string          `json:"mode"`
+	ModeElement       *common.Element `json:"_mode,omitempty"`
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 }
 
 // CapabilityStatementDocument represents document capabilities
 type CapabilityStatementDocument struct {
 	common.BackboneElement
 
-	Mode          string  `json:"mode"`
-	Documentation *string `json:"documentation,omitempty"`
-	Profile       string  `json:"profile"`
+	Mode                 string          `json:"mode"`
+	ModeElement          *common.Element `json:"_mode,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+	Profile              string          `json:"profile"`
+	ProfileElement       *common.Element `json:"_profile,omitempty"`
 }
 
 // MarshalJSON marshals the CapabilityStatement to JSON
@@ -180,9 +241,15 @@ func (c *CapabilityStatement) MarshalJSON() ([]byte, error) {
 	type Alias CapabilityStatement
 	return json.Marshal(&struct {
 		*Alias
-		ResourceType string `json:"resourceType"`
+		ResourceType        string    `json:"resourceType"`
+		Format              []*string `json:"format"`
+		PatchFormat         []*string `json:"patchFormat,omitempty"`
+		ImplementationGuide []*string `json:"implementationGuide,omitempty"`
 	}{
-		Alias:        (*Alias)(c),
-		ResourceType: "CapabilityStatement",
+		Alias:               (*Alias)(c),
+		ResourceType:        "CapabilityStatement",
+		Format:              common.PrimitiveValues(c.Format, c.FormatElement),
+		PatchFormat:         common.PrimitiveValues(c.PatchFormat, c.PatchFormatElement),
+		ImplementationGuide: common.PrimitiveValues(c.ImplementationGuide, c.ImplementationGuideElement),
 	})
 }
@@ -15,8 +15,10 @@ type CareTeam struct {
 
 	Identifier           []common.Identifier      `json:"identifier,omitempty"`
 	Status               *string                  `json:"status,omitempty"`
+	StatusElement        *common.Element          `json:"_status,omitempty"`
 	Category             []common.CodeableConcept `json:"category,omitempty"`
 	Name                 *string                  `json:"name,omitempty"`
+	NameElement          *common.Element          `json:"_name,omitempty"`
 	Subject              *common.Reference        `json:"subject,omitempty"`
 	Encounter            *common.Reference        `json:"encounter,omitempty"`
 	Period               *common.Period           `json:"period,omitempty"`
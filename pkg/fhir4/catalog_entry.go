@@ -16,13 +16,18 @@ type CatalogEntry struct {
 	Identifier               []common.Identifier        `json:"identifier,omitempty"`
 	Type                     *common.CodeableConcept    `json:"type,omitempty"`
 	Orderable                bool                       `json:"orderable"`
+	OrderableElement         *common.Element            `json:"_orderable,omitempty"`
 	ReferencedItem           common.Reference           `json:"referencedItem"`
 	AdditionalIdentifier     []common.Identifier        `json:"additionalIdentifier,omitempty"`
 	Classification           []common.CodeableConcept   `json:"classification,omitempty"`
 	Status                   *string                    `json:"status,omitempty"`
+	StatusElement            *common.Element            `json:"_status,omitempty"`
 	ValidFrom                *string                    `json:"validFrom,omitempty"`
+	ValidFromElement         *common.Element            `json:"_validFrom,omitempty"`
 	ValidTo                  *string                    `json:"validTo,omitempty"`
-	LastUpdated              *string                    `json:"lastUpdated,omitempty"`
+	ValidToElement           *common.Element            `json:"_validTo,omitempty"`
+	LastUpdated              *common.DateTime           `json:"lastUpdated,omitempty"`
+	LastUpdatedElement       *common.Element            `json:"_lastUpdated,omitempty"`
 	AdditionalCharacteristic []common.CodeableConcept   `json:"additionalCharacteristic,omitempty"`
 	AdditionalClassification []common.CodeableConcept   `json:"additionalClassification,omitempty"`
 	RelatedEntry             []CatalogEntryRelatedEntry `json:"relatedEntry,omitempty"`
@@ -32,8 +37,9 @@ type CatalogEntry struct {
 type CatalogEntryRelatedEntry struct {
 	common.BackboneElement
 
-	RelationType string           `json:"relationtype"`
-	Item         common.Reference `json:"item"`
+	RelationType        string           `json:"relationtype"`
+	RelationTypeElement *common.Element  `json:"_relationtype,omitempty"`
+	Item                common.Reference `json:"item"`
 }
 
 // MarshalJSON marshals the CatalogEntry to JSON
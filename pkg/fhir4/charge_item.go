@@ -13,35 +13,42 @@ type ChargeItem struct {
 	// Resource Type Name (for serialization)
 	ResourceType string `json:"resourceType"`
 
-	Identifier             []common.Identifier      `json:"identifier,omitempty"`
-	DefinitionUri          []string                 `json:"definitionUri,omitempty"`
-	DefinitionCanonical    []string                 `json:"definitionCanonical,omitempty"`
-	Status                 string                   `json:"status"`
-	PartOf                 []common.Reference       `json:"partOf,omitempty"`
-	Code                   common.CodeableConcept   `json:"code"`
-	Subject                common.Reference         `json:"subject"`
-	Context                *common.Reference        `json:"context,omitempty"`
-	OccurrenceDateTime     *string                  `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod       *common.Period           `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming       *Timing                  `json:"occurrenceTiming,omitempty"`
-	Performer              []ChargeItemPerformer    `json:"performer,omitempty"`
-	PerformingOrganization *common.Reference        `json:"performingOrganization,omitempty"`
-	RequestingOrganization *common.Reference        `json:"requestingOrganization,omitempty"`
-	CostCenter             *common.Reference        `json:"costCenter,omitempty"`
-	Quantity               *common.Quantity         `json:"quantity,omitempty"`
-	Bodysite               []common.CodeableConcept `json:"bodysite,omitempty"`
-	FactorOverride         *float64                 `json:"factorOverride,omitempty"`
-	PriceOverride          *common.Money            `json:"priceOverride,omitempty"`
-	OverrideReason         *string                  `json:"overrideReason,omitempty"`
-	Enterer                *common.Reference        `json:"enterer,omitempty"`
-	EnteredDate            *string                  `json:"enteredDate,omitempty"`
-	Reason                 []common.CodeableConcept `json:"reason,omitempty"`
-	Service                []common.Reference       `json:"service,omitempty"`
-	ProductReference       *common.Reference        `json:"productReference,omitempty"`
-	ProductCodeableConcept *common.CodeableConcept  `json:"productCodeableConcept,omitempty"`
-	Account                []common.Reference       `json:"account,omitempty"`
-	Note                   []common.Annotation      `json:"note,omitempty"`
-	SupportingInformation  []common.Reference       `json:"supportingInformation,omitempty"`
+	Identifier                 []common.Identifier      `json:"identifier,omitempty"`
+	DefinitionUri              []string                 `json:"definitionUri,omitempty"`
+	DefinitionUriElement       []*common.Element        `json:"_definitionUri,omitempty"`
+	DefinitionCanonical        []string                 `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement []*common.Element        `json:"_definitionCanonical,omitempty"`
+	Status                     string                   `json:"status"`
+	StatusElement              *common.Element          `json:"_status,omitempty"`
+	PartOf                     []common.Reference       `json:"partOf,omitempty"`
+	Code                       common.CodeableConcept   `json:"code"`
+	Subject                    common.Reference         `json:"subject"`
+	Context                    *common.Reference        `json:"context,omitempty"`
+	OccurrenceDateTime         *common.DateTime         `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement  *common.Element          `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod           *common.Period           `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming           *Timing                  `json:"occurrenceTiming,omitempty"`
+	Performer                  []ChargeItemPerformer    `json:"performer,omitempty"`
+	PerformingOrganization     *common.Reference        `json:"performingOrganization,omitempty"`
+	RequestingOrganization     *common.Reference        `json:"requestingOrganization,omitempty"`
+	CostCenter                 *common.Reference        `json:"costCenter,omitempty"`
+	Quantity                   *common.Quantity         `json:"quantity,omitempty"`
+	Bodysite                   []common.CodeableConcept `json:"bodysite,omitempty"`
+	FactorOverride             *common.Decimal          `json:"factorOverride,omitempty"`
+	FactorOverrideElement      *common.Element          `json:"_factorOverride,omitempty"`
+	PriceOverride              *common.Money            `json:"priceOverride,omitempty"`
+	OverrideReason             *string                  `json:"overrideReason,omitempty"`
+	OverrideReasonElement      *common.Element          `json:"_overrideReason,omitempty"`
+	Enterer                    *common.Reference        `json:"enterer,omitempty"`
+	EnteredDate                *common.DateTime         `json:"enteredDate,omitempty"`
+	EnteredDateElement         *common.Element          `json:"_enteredDate,omitempty"`
+	Reason                     []common.CodeableConcept `json:"reason,omitempty"`
+	Service                    []common.Reference       `json:"service,omitempty"`
+	ProductReference           *common.Reference        `json:"productReference,omitempty"`
+	ProductCodeableConcept     *common.CodeableConcept  `json:"productCodeableConcept,omitempty"`
+	Account                    []common.Reference       `json:"account,omitempty"`
+	Note                       []common.Annotation      `json:"note,omitempty"`
+	SupportingInformation      []common.Reference       `json:"supportingInformation,omitempty"`
 }
 
 // ChargeItemPerformer represents a performer of a charge item
@@ -57,9 +64,13 @@ func (c *ChargeItem) MarshalJSON() ([]byte, error) {
 	type Alias ChargeItem
 	return json.Marshal(&struct {
 		*Alias
-		ResourceType string `json:"resourceType"`
+		ResourceType        string    `json:"resourceType"`
+		DefinitionUri       []*string `json:"definitionUri,omitempty"`
+		DefinitionCanonical []*string `json:"definitionCanonical,omitempty"`
 	}{
-		Alias:        (*Alias)(c),
-		ResourceType: "ChargeItem",
+		Alias:               (*Alias)(c),
+		ResourceType:        "ChargeItem",
+		DefinitionUri:       common.PrimitiveValues(c.DefinitionUri, c.DefinitionUriElement),
+		DefinitionCanonical: common.PrimitiveValues(c.DefinitionCanonical, c.DefinitionCanonicalElement),
 	})
 }
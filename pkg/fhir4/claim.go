@@ -15,12 +15,15 @@ type Claim struct {
 
 	Identifier           []common.Identifier     `json:"identifier,omitempty"`
 	Status               string                  `json:"status"`
+	StatusElement        *common.Element         `json:"_status,omitempty"`
 	Type                 common.CodeableConcept  `json:"type"`
 	SubType              *common.CodeableConcept `json:"subType,omitempty"`
 	Use                  string                  `json:"use"`
+	UseElement           *common.Element         `json:"_use,omitempty"`
 	Patient              common.Reference        `json:"patient"`
 	BillablePeriod       *common.Period          `json:"billablePeriod,omitempty"`
-	Created              string                  `json:"created"`
+	Created              common.DateTime         `json:"created"`
+	CreatedElement       *common.Element         `json:"_created,omitempty"`
 	Enterer              *common.Reference       `json:"enterer,omitempty"`
 	Insurer              *common.Reference       `json:"insurer,omitempty"`
 	Provider             common.Reference        `json:"provider"`
@@ -60,31 +63,38 @@ type ClaimPayee struct {
 type ClaimCareTeam struct {
 	common.BackboneElement
 
-	Sequence      int                     `json:"sequence"`
-	Provider      common.Reference        `json:"provider"`
-	Responsible   *bool                   `json:"responsible,omitempty"`
-	Role          *common.CodeableConcept `json:"role,omitempty"`
-	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
+	Sequence           int                     `json:"sequence"`
+	SequenceElement    *common.Element         `json:"_sequence,omitempty"`
+	Provider           common.Reference        `json:"provider"`
+	Responsible        *bool                   `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element         `json:"_responsible,omitempty"`
+	Role               *common.CodeableConcept `json:"role,omitempty"`
+	Qualification      *common.CodeableConcept `json:"qualification,omitempty"`
 }
 
 // ClaimInsurance represents insurance information
 type ClaimInsurance struct {
 	common.BackboneElement
 
-	Sequence            int                `json:"sequence"`
-	Focal               bool               `json:"focal"`
-	Identifier          *common.Identifier `json:"identifier,omitempty"`
-	Coverage            common.Reference   `json:"coverage"`
-	BusinessArrangement *string            `json:"businessArrangement,omitempty"`
-	PreAuthRef          []string           `json:"preAuthRef,omitempty"`
-	ClaimResponse       *common.Reference  `json:"claimResponse,omitempty"`
+	Sequence                   int                `json:"sequence"`
+	SequenceElement            *common.Element    `json:"_sequence,omitempty"`
+	Focal                      bool               `json:"focal"`
+	FocalElement               *common.Element    `json:"_focal,omitempty"`
+	Identifier                 *common.Identifier `json:"identifier,omitempty"`
+	Coverage                   common.Reference   `json:"coverage"`
+	BusinessArrangement        *string            `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element    `json:"_businessArrangement,omitempty"`
+	PreAuthRef                 []string           `json:"preAuthRef,omitempty"`
+	PreAuthRefElement          []*common.Element  `json:"_preAuthRef,omitempty"`
+	ClaimResponse              *common.Reference  `json:"claimResponse,omitempty"`
 }
 
 // ClaimAccident represents accident information
 type ClaimAccident struct {
 	common.BackboneElement
 
-	Date              string                  `json:"date"`
+	Date              common.Date             `json:"date"`
+	DateElement       *common.Element         `json:"_date,omitempty"`
 	Type              *common.CodeableConcept `json:"type,omitempty"`
 	LocationAddress   *common.Address         `json:"locationAddress,omitempty"`
 	LocationReference *common.Reference       `json:"locationReference,omitempty"`
@@ -94,65 +104,76 @@ type ClaimAccident struct {
 type ClaimItem struct {
 	common.BackboneElement
 
-	Sequence                int                      `json:"sequence"`
-	CareTeamLinkId          []int                    `json:"careTeamLinkId,omitempty"`
-	DiagnosisLinkId         []int                    `json:"diagnosisLinkId,omitempty"`
-	ProcedureLinkId         []int                    `json:"procedureLinkId,omitempty"`
-	InformationLinkId       []int                    `json:"informationLinkId,omitempty"`
-	Revenue                 *common.CodeableConcept  `json:"revenue,omitempty"`
-	Category                *common.CodeableConcept  `json:"category,omitempty"`
-	Service                 *common.CodeableConcept  `json:"service,omitempty"`
-	Modifier                []common.CodeableConcept `json:"modifier,omitempty"`
-	ProgramCode             []common.CodeableConcept `json:"programCode,omitempty"`
-	ServicedDate            *string                  `json:"servicedDate,omitempty"`
-	ServicedPeriod          *common.Period           `json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *common.CodeableConcept  `json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *common.Address          `json:"locationAddress,omitempty"`
-	LocationReference       *common.Reference        `json:"locationReference,omitempty"`
-	Quantity                *common.Quantity         `json:"quantity,omitempty"`
-	UnitPrice               *common.Money            `json:"unitPrice,omitempty"`
-	Factor                  *float64                 `json:"factor,omitempty"`
-	Net                     *common.Money            `json:"net,omitempty"`
-	Udi                     []common.Reference       `json:"udi,omitempty"`
-	BodySite                *common.CodeableConcept  `json:"bodySite,omitempty"`
-	SubSite                 []common.CodeableConcept `json:"subSite,omitempty"`
-	Encounter               []common.Reference       `json:"encounter,omitempty"`
-	Detail                  []ClaimItemDetail        `json:"detail,omitempty"`
+	Sequence                 int                      `json:"sequence"`
+	SequenceElement          *common.Element          `json:"_sequence,omitempty"`
+	CareTeamLinkId           []int                    `json:"careTeamLinkId,omitempty"`
+	CareTeamLinkIdElement    []*common.Element        `json:"_careTeamLinkId,omitempty"`
+	DiagnosisLinkId          []int                    `json:"diagnosisLinkId,omitempty"`
+	DiagnosisLinkIdElement   []*common.Element        `json:"_diagnosisLinkId,omitempty"`
+	ProcedureLinkId          []int                    `json:"procedureLinkId,omitempty"`
+	ProcedureLinkIdElement   []*common.Element        `json:"_procedureLinkId,omitempty"`
+	InformationLinkId        []int                    `json:"informationLinkId,omitempty"`
+	InformationLinkIdElement []*common.Element        `json:"_informationLinkId,omitempty"`
+	Revenue                  *common.CodeableConcept  `json:"revenue,omitempty"`
+	Category                 *common.CodeableConcept  `json:"category,omitempty"`
+	Service                  *common.CodeableConcept  `json:"service,omitempty"`
+	Modifier                 []common.CodeableConcept `json:"modifier,omitempty"`
+	ProgramCode              []common.CodeableConcept `json:"programCode,omitempty"`
+	ServicedDate             *common.Date             `json:"servicedDate,omitempty"`
+	ServicedDateElement      *common.Element          `json:"_servicedDate,omitempty"`
+	ServicedPeriod           *common.Period           `json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept  *common.CodeableConcept  `json:"locationCodeableConcept,omitempty"`
+	LocationAddress          *common.Address          `json:"locationAddress,omitempty"`
+	LocationReference        *common.Reference        `json:"locationReference,omitempty"`
+	Quantity                 *common.Quantity         `json:"quantity,omitempty"`
+	UnitPrice                *common.Money            `json:"unitPrice,omitempty"`
+	Factor                   *common.Decimal          `json:"factor,omitempty"`
+	FactorElement            *common.Element          `json:"_factor,omitempty"`
+	Net                      *common.Money            `json:"net,omitempty"`
+	Udi                      []common.Reference       `json:"udi,omitempty"`
+	BodySite                 *common.CodeableConcept  `json:"bodySite,omitempty"`
+	SubSite                  []common.CodeableConcept `json:"subSite,omitempty"`
+	Encounter                []common.Reference       `json:"encounter,omitempty"`
+	Detail                   []ClaimItemDetail        `json:"detail,omitempty"`
 }
 
 // ClaimItemDetail represents claim item details
 type ClaimItemDetail struct {
 	common.BackboneElement
 
-	Sequence    int                        `json:"sequence"`
-	Revenue     *common.CodeableConcept    `json:"revenue,omitempty"`
-	Category    *common.CodeableConcept    `json:"category,omitempty"`
-	Service     *common.CodeableConcept    `json:"service,omitempty"`
-	Modifier    []common.CodeableConcept   `json:"modifier,omitempty"`
-	ProgramCode []common.CodeableConcept   `json:"programCode,omitempty"`
-	Quantity    *common.Quantity           `json:"quantity,omitempty"`
-	UnitPrice   *common.Money              `json:"unitPrice,omitempty"`
-	Factor      *float64                   `json:"factor,omitempty"`
-	Net         *common.Money              `json:"net,omitempty"`
-	Udi         []common.Reference         `json:"udi,omitempty"`
-	SubDetail   []ClaimItemDetailSubDetail `json:"subDetail,omitempty"`
+	Sequence        int                        `json:"sequence"`
+	SequenceElement *common.Element            `json:"_sequence,omitempty"`
+	Revenue         *common.CodeableConcept    `json:"revenue,omitempty"`
+	Category        *common.CodeableConcept    `json:"category,omitempty"`
+	Service         *common.CodeableConcept    `json:"service,omitempty"`
+	Modifier        []common.CodeableConcept   `json:"modifier,omitempty"`
+	ProgramCode     []common.CodeableConcept   `json:"programCode,omitempty"`
+	Quantity        *common.Quantity           `json:"quantity,omitempty"`
+	UnitPrice       *common.Money              `json:"unitPrice,omitempty"`
+	Factor          *common.Decimal            `json:"factor,omitempty"`
+	FactorElement   *common.Element            `json:"_factor,omitempty"`
+	Net             *common.Money              `json:"net,omitempty"`
+	Udi             []common.Reference         `json:"udi,omitempty"`
+	SubDetail       []ClaimItemDetailSubDetail `json:"subDetail,omitempty"`
 }
 
 // ClaimItemDetailSubDetail represents claim item sub-details
 type ClaimItemDetailSubDetail struct {
 	common.BackboneElement
 
-	Sequence    int                      `json:"sequence"`
-	Revenue     *common.CodeableConcept  `json:"revenue,omitempty"`
-	Category    *common.CodeableConcept  `json:"category,omitempty"`
-	Service     *common.CodeableConcept  `json:"service,omitempty"`
-	Modifier    []common.CodeableConcept `json:"modifier,omitempty"`
-	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
-	Quantity    *common.Quantity         `json:"quantity,omitempty"`
-	UnitPrice   *common.Money            `json:"unitPrice,omitempty"`
-	Factor      *float64                 `json:"factor,omitempty"`
-	Net         *common.Money            `json:"net,omitempty"`
-	Udi         []common.Reference       `json:"udi,omitempty"`
+	Sequence        int                      `json:"sequence"`
+	SequenceElement *common.Element          `json:"_sequence,omitempty"`
+	Revenue         *common.CodeableConcept  `json:"revenue,omitempty"`
+	Category        *common.CodeableConcept  `json:"category,omitempty"`
+	Service         *common.CodeableConcept  `json:"service,omitempty"`
+	Modifier        []common.CodeableConcept `json:"modifier,omitempty"`
+	ProgramCode     []common.CodeableConcept `json:"programCode,omitempty"`
+	Quantity        *common.Quantity         `json:"quantity,omitempty"`
+	UnitPrice       *common.Money            `json:"unitPrice,omitempty"`
+	Factor          *common.Decimal          `json:"factor,omitempty"`
+	FactorElement   *common.Element          `json:"_factor,omitempty"`
+	Net             *common.Money            `json:"net,omitempty"`
+	Udi             []common.Reference       `json:"udi,omitempty"`
 }
 
 // MarshalJSON marshals the Claim to JSON
@@ -21,10 +21,12 @@ type ClaimResponse struct {
 	CommunicationRequest []common.Reference `json:"communicationRequest,omitempty"`
 
 	// The date this resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// A human readable description of the status of the adjudication
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// If the request contains errors then an error element should be provided
 	Error []ClaimResponseError `json:"error,omitempty"`
@@ -51,7 +53,8 @@ type ClaimResponse struct {
 	Item []ClaimResponseItem `json:"item,omitempty"`
 
 	// The resource may be used to indicate that: the request has been held (queued) for processing
-	Outcome ClaimResponseOutcome `json:"outcome"`
+	Outcome        ClaimResponseOutcome `json:"outcome"`
+	OutcomeElement *common.Element      `json:"_outcome,omitempty"`
 
 	// The party to whom the professional services and/or products have been supplied
 	Patient common.Reference `json:"patient"`
@@ -66,7 +69,8 @@ type ClaimResponse struct {
 	PreAuthPeriod *common.Period `json:"preAuthPeriod,omitempty"`
 
 	// This value is only present on preauthorization adjudications
-	PreAuthRef *string `json:"preAuthRef,omitempty"`
+	PreAuthRef        *string         `json:"preAuthRef,omitempty"`
+	PreAuthRefElement *common.Element `json:"_preAuthRef,omitempty"`
 
 	// A note that describes or explains adjudication results in a human readable form
 	ProcessNote []ClaimResponseProcessNote `json:"processNote,omitempty"`
@@ -84,7 +88,8 @@ type ClaimResponse struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// A code, used only on a response to a preauthorization, to indicate whether the benefits payable have been reserved and for whom
-	Use ClaimUse `json:"use"`
+	Use        ClaimUse        `json:"use"`
+	UseElement *common.Element `json:"_use,omitempty"`
 }
 
 // ClaimUse represents the use of a claim
@@ -120,7 +125,8 @@ type ClaimResponseItemAdjudication struct {
 	Reason *common.CodeableConcept `json:"reason,omitempty"`
 
 	// For example: eligible percentage or co-payment percentage
-	Value *float64 `json:"value,omitempty"`
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ClaimResponseItemDetailSubDetail represents a sub-detail adjudication
@@ -131,10 +137,12 @@ type ClaimResponseItemDetailSubDetail struct {
 	Adjudication []ClaimResponseItemAdjudication `json:"adjudication,omitempty"`
 
 	// The numbers associated with notes below which apply to the adjudication of this item
-	NoteNumber []int `json:"noteNumber,omitempty"`
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
 
 	// A number to uniquely reference the claim sub-detail entry
-	SubDetailSequence int `json:"subDetailSequence"`
+	SubDetailSequence        int             `json:"subDetailSequence"`
+	SubDetailSequenceElement *common.Element `json:"_subDetailSequence,omitempty"`
 }
 
 // ClaimResponseItemDetail represents a claim detail
@@ -145,10 +153,12 @@ type ClaimResponseItemDetail struct {
 	Adjudication []ClaimResponseItemAdjudication `json:"adjudication"`
 
 	// A number to uniquely reference the claim detail entry
-	DetailSequence int `json:"detailSequence"`
+	DetailSequence        int             `json:"detailSequence"`
+	DetailSequenceElement *common.Element `json:"_detailSequence,omitempty"`
 
 	// The numbers associated with notes below which apply to the adjudication of this item
-	NoteNumber []int `json:"noteNumber,omitempty"`
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
 
 	// A sub-detail adjudication of a simple product or service
 	SubDetail []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty"`
@@ -165,10 +175,12 @@ type ClaimResponseItem struct {
 	Detail []ClaimResponseItemDetail `json:"detail,omitempty"`
 
 	// A number to uniquely reference the claim item entries
-	ItemSequence int `json:"itemSequence"`
+	ItemSequence        int             `json:"itemSequence"`
+	ItemSequenceElement *common.Element `json:"_itemSequence,omitempty"`
 
 	// The numbers associated with notes below which apply to the adjudication of this item
-	NoteNumber []int `json:"noteNumber,omitempty"`
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
 }
 
 // ClaimResponseAddItemDetailSubDetail represents the third-tier service adjudications
@@ -179,7 +191,8 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	Adjudication []ClaimResponseItemAdjudication `json:"adjudication"`
 
 	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// For example in Oral whether the treatment is cosmetic or associated with TMJ
 	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
@@ -188,7 +201,8 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	Net *common.Money `json:"net,omitempty"`
 
 	// The numbers associated with notes below which apply to the adjudication of this item
-	NoteNumber []int `json:"noteNumber,omitempty"`
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
 
 	// If this is an actual service or product line, i.e. not a Group
 	ProductOrService common.CodeableConcept `json:"productOrService"`
@@ -208,7 +222,8 @@ type ClaimResponseAddItemDetail struct {
 	Adjudication []ClaimResponseItemAdjudication `json:"adjudication"`
 
 	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// For example in Oral whether the treatment is cosmetic or associated with TMJ
 	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
@@ -217,7 +232,8 @@ type ClaimResponseAddItemDetail struct {
 	Net *common.Money `json:"net,omitempty"`
 
 	// The numbers associated with notes below which apply to the adjudication of this item
-	NoteNumber []int `json:"noteNumber,omitempty"`
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
 
 	// If this is an actual service or product line, i.e. not a Group
 	ProductOrService common.CodeableConcept `json:"productOrService"`
@@ -246,13 +262,16 @@ type ClaimResponseAddItem struct {
 	Detail []ClaimResponseAddItemDetail `json:"detail,omitempty"`
 
 	// The sequence number of the details within the claim item which this line is intended to replace
-	DetailSequence []int `json:"detailSequence,omitempty"`
+	DetailSequence        []int             `json:"detailSequence,omitempty"`
+	DetailSequenceElement []*common.Element `json:"_detailSequence,omitempty"`
 
 	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Claim items which this service line is intended to replace
-	ItemSequence []int `json:"itemSequence,omitempty"`
+	ItemSequence        []int             `json:"itemSequence,omitempty"`
+	ItemSequenceElement []*common.Element `json:"_itemSequence,omitempty"`
 
 	// Where the product or service was provided
 	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
@@ -270,7 +289,8 @@ type ClaimResponseAddItem struct {
 	Net *common.Money `json:"net,omitempty"`
 
 	// The numbers associated with notes below which apply to the adjudication of this item
-	NoteNumber []int `json:"noteNumber,omitempty"`
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
 
 	// If this is an actual service or product line, i.e. not a Group
 	ProductOrService common.CodeableConcept `json:"productOrService"`
@@ -285,13 +305,15 @@ type ClaimResponseAddItem struct {
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// The date or dates when the service or product was supplied, performed or completed
-	ServicedDate *string `json:"servicedDate,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
 
 	// The date or dates when the service or product was supplied, performed or completed
 	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
 
 	// The sequence number of the sub-details within the details within the claim item which this line is intended to replace
-	SubdetailSequence []int `json:"subdetailSequence,omitempty"`
+	SubdetailSequence        []int             `json:"subdetailSequence,omitempty"`
+	SubdetailSequenceElement []*common.Element `json:"_subdetailSequence,omitempty"`
 
 	// A region or surface of the bodySite, e.g. limb region or tooth surface(s)
 	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
@@ -325,7 +347,8 @@ type ClaimResponsePayment struct {
 	Amount common.Money `json:"amount"`
 
 	// Estimated date the payment will be issued or the actual issue date of payment
-	Date *string `json:"date,omitempty"`
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// For example: EFT number or check number
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -342,13 +365,16 @@ type ClaimResponseProcessNote struct {
 	Language *common.CodeableConcept `json:"language,omitempty"`
 
 	// A number to uniquely identify a note entry
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// The explanation or description associated with the processing
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The business purpose of the note text
-	Type *ClaimResponseProcessNoteType `json:"type,omitempty"`
+	Type        *ClaimResponseProcessNoteType `json:"type,omitempty"`
+	TypeElement *common.Element               `json:"_type,omitempty"`
 }
 
 // ClaimResponseProcessNoteType represents the business purpose of the note text
@@ -365,7 +391,8 @@ type ClaimResponseInsurance struct {
 	common.BackboneElement
 
 	// A business agreement number established between the provider and the insurer
-	BusinessArrangement *string `json:"businessArrangement,omitempty"`
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
 
 	// Must not be specified when 'focal=true' for this insurance
 	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
@@ -374,10 +401,12 @@ type ClaimResponseInsurance struct {
 	Coverage common.Reference `json:"coverage"`
 
 	// A patient may (will) have multiple insurance policies which provide reimbursement
-	Focal bool `json:"focal"`
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
 
 	// A number to uniquely identify insurance entries and provide a sequence of coverages
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 }
 
 // ClaimResponseError represents an error in the claim response
@@ -388,11 +417,14 @@ type ClaimResponseError struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// The sequence number of the detail within the line item submitted which contains the error
-	DetailSequence *int `json:"detailSequence,omitempty"`
+	DetailSequence        *int            `json:"detailSequence,omitempty"`
+	DetailSequenceElement *common.Element `json:"_detailSequence,omitempty"`
 
 	// The sequence number of the line item submitted which contains the error
-	ItemSequence *int `json:"itemSequence,omitempty"`
+	ItemSequence        *int            `json:"itemSequence,omitempty"`
+	ItemSequenceElement *common.Element `json:"_itemSequence,omitempty"`
 
 	// The sequence number of the sub-detail within the detail within the line item submitted which contains the error
-	SubDetailSequence *int `json:"subDetailSequence,omitempty"`
+	SubDetailSequence        *int            `json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement *common.Element `json:"_subDetailSequence,omitempty"`
 }
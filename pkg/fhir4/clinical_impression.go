@@ -15,19 +15,24 @@ type ClinicalImpression struct {
 
 	Identifier               []common.Identifier               `json:"identifier,omitempty"`
 	Status                   string                            `json:"status"`
+	StatusElement            *common.Element                   `json:"_status,omitempty"`
 	StatusReason             *common.CodeableConcept           `json:"statusReason,omitempty"`
 	Code                     *common.CodeableConcept           `json:"code,omitempty"`
 	Description              *string                           `json:"description,omitempty"`
+	DescriptionElement       *common.Element                   `json:"_description,omitempty"`
 	Subject                  common.Reference                  `json:"subject"`
 	Encounter                *common.Reference                 `json:"encounter,omitempty"`
-	EffectiveDateTime        *string                           `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTime        *common.DateTime                  `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element                   `json:"_effectiveDateTime,omitempty"`
 	EffectivePeriod          *common.Period                    `json:"effectivePeriod,omitempty"`
-	Date                     *string                           `json:"date,omitempty"`
+	Date                     *common.DateTime                  `json:"date,omitempty"`
+	DateElement              *common.Element                   `json:"_date,omitempty"`
 	Assessor                 *common.Reference                 `json:"assessor,omitempty"`
 	Previous                 *common.Reference                 `json:"previous,omitempty"`
 	Problem                  []common.Reference                `json:"problem,omitempty"`
 	Investigation            []ClinicalImpressionInvestigation `json:"investigation,omitempty"`
 	Protocol                 []string                          `json:"protocol,omitempty"`
+	ProtocolElement          []*common.Element                 `json:"_protocol,omitempty"`
 	Finding                  []ClinicalImpressionFinding       `json:"finding,omitempty"`
 	PrognosisCodeableConcept []common.CodeableConcept          `json:"prognosisCodeableConcept,omitempty"`
 	PrognosisReference       []common.Reference                `json:"prognosisReference,omitempty"`
@@ -50,6 +55,7 @@ type ClinicalImpressionFinding struct {
 	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
 	ItemReference       *common.Reference       `json:"itemReference,omitempty"`
 	Basis               *string                 `json:"basis,omitempty"`
+	BasisElement        *common.Element         `json:"_basis,omitempty"`
 }
 
 // MarshalJSON marshals the ClinicalImpression to JSON
@@ -57,9 +63,11 @@ func (c *ClinicalImpression) MarshalJSON() ([]byte, error) {
 	type Alias ClinicalImpression
 	return json.Marshal(&struct {
 		*Alias
-		ResourceType string `json:"resourceType"`
+		ResourceType string    `json:"resourceType"`
+		Protocol     []*string `json:"protocol,omitempty"`
 	}{
 		Alias:        (*Alias)(c),
 		ResourceType: "ClinicalImpression",
+		Protocol:     common.PrimitiveValues(c.Protocol, c.ProtocolElement),
 	})
 }
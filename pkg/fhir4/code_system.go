@@ -13,87 +13,126 @@ type CodeSystem struct {
 	// Resource Type Name (for serialization)
 	ResourceType string `json:"resourceType"`
 
-	URL              *string                  `json:"url,omitempty"`
-	Identifier       []common.Identifier      `json:"identifier,omitempty"`
-	Version          *string                  `json:"version,omitempty"`
-	Name             *string                  `json:"name,omitempty"`
-	Title            *string                  `json:"title,omitempty"`
-	Status           string                   `json:"status"`
-	Experimental     *bool                    `json:"experimental,omitempty"`
-	Date             *string                  `json:"date,omitempty"`
-	Publisher        *string                  `json:"publisher,omitempty"`
-	Contact          []common.ContactDetail   `json:"contact,omitempty"`
-	Description      *string                  `json:"description,omitempty"`
-	UseContext       []common.UsageContext    `json:"useContext,omitempty"`
-	Jurisdiction     []common.CodeableConcept `json:"jurisdiction,omitempty"`
-	Purpose          *string                  `json:"purpose,omitempty"`
-	Copyright        *string                  `json:"copyright,omitempty"`
-	CaseSensitive    *bool                    `json:"caseSensitive,omitempty"`
-	ValueSet         *string                  `json:"valueSet,omitempty"`
-	HierarchyMeaning *string                  `json:"hierarchyMeaning,omitempty"`
-	Compositional    *bool                    `json:"compositional,omitempty"`
-	VersionNeeded    *bool                    `json:"versionNeeded,omitempty"`
-	Content          string                   `json:"content"`
-	Supplements      *string                  `json:"supplements,omitempty"`
-	Count            *int                     `json:"count,omitempty"`
-	Filter           []CodeSystemFilter       `json:"filter,omitempty"`
-	Property         []CodeSystemProperty     `json:"property,omitempty"`
-	Concept          []CodeSystemConcept      `json:"concept,omitempty"`
+	URL                     *string                  `json:"url,omitempty"`
+	URLElement              *common.Element          `json:"_url,omitempty"`
+	Identifier              []common.Identifier      `json:"identifier,omitempty"`
+	Version                 *string                  `json:"version,omitempty"`
+	VersionElement          *common.Element          `json:"_version,omitempty"`
+	Name                    *string                  `json:"name,omitempty"`
+	NameElement             *common.Element          `json:"_name,omitempty"`
+	Title                   *string                  `json:"title,omitempty"`
+	TitleElement            *common.Element          `json:"_title,omitempty"`
+	Status                  string                   `json:"status"`
+	StatusElement           *common.Element          `json:"_status,omitempty"`
+	Experimental            *bool                    `json:"experimental,omitempty"`
+	ExperimentalElement     *common.Element          `json:"_experimental,omitempty"`
+	Date                    *common.DateTime         `json:"date,omitempty"`
+	DateElement             *common.Element          `json:"_date,omitempty"`
+	Publisher               *string                  `json:"publisher,omitempty"`
+	PublisherElement        *common.Element          `json:"_publisher,omitempty"`
+	Contact                 []common.ContactDetail   `json:"contact,omitempty"`
+	Description             *string                  `json:"description,omitempty"`
+	DescriptionElement      *common.Element          `json:"_description,omitempty"`
+	UseContext              []common.UsageContext    `json:"useContext,omitempty"`
+	Jurisdiction            []common.CodeableConcept `json:"jurisdiction,omitempty"`
+	Purpose                 *string                  `json:"purpose,omitempty"`
+	PurposeElement          *common.Element          `json:"_purpose,omitempty"`
+	Copyright               *string                  `json:"copyright,omitempty"`
+	CopyrightElement        *common.Element          `json:"_copyright,omitempty"`
+	CaseSensitive           *bool                    `json:"caseSensitive,omitempty"`
+	CaseSensitiveElement    *common.Element          `json:"_caseSensitive,omitempty"`
+	ValueSet                *string                  `json:"valueSet,omitempty"`
+	ValueSetElement         *common.Element          `json:"_valueSet,omitempty"`
+	HierarchyMeaning        *string                  `json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaningElement *common.Element          `json:"_hierarchyMeaning,omitempty"`
+	Compositional           *bool                    `json:"compositional,omitempty"`
+	CompositionalElement    *common.Element          `json:"_compositional,omitempty"`
+	VersionNeeded           *bool                    `json:"versionNeeded,omitempty"`
+	VersionNeededElement    *common.Element          `json:"_versionNeeded,omitempty"`
+	Content                 string                   `json:"content"`
+	ContentElement          *common.Element          `json:"_content,omitempty"`
+	Supplements             *string                  `json:"supplements,omitempty"`
+	SupplementsElement      *common.Element          `json:"_supplements,omitempty"`
+	Count                   *int                     `json:"count,omitempty"`
+	CountElement            *common.Element          `json:"_count,omitempty"`
+	Filter                  []CodeSystemFilter       `json:"filter,omitempty"`
+	Property                []CodeSystemProperty     `json:"property,omitempty"`
+	Concept                 []CodeSystemConcept      `json:"concept,omitempty"`
 }
 
 // CodeSystemFilter represents filters
 type CodeSystemFilter struct {
 	common.BackboneElement
 
-	Code        string   `json:"code"`
-	Description *string  `json:"description,omitempty"`
-	Operator    []string `json:"operator"`
-	Value       string   `json:"value"`
+	Code               string            `json:"code"`
+	CodeElement        *common.Element   `json:"_code,omitempty"`
+	Description        *string           `json:"description,omitempty"`
+	DescriptionElement *common.Element   `json:"_description,omitempty"`
+	Operator           []string          `json:"operator"`
+	OperatorElement    []*common.Element `json:"_operator,omitempty"`
+	Value              string            `json:"value"`
+	ValueElement       *common.Element   `json:"_value,omitempty"`
 }
 
 // CodeSystemProperty represents properties
 type CodeSystemProperty struct {
 	common.BackboneElement
 
-	Code        string  `json:"code"`
-	URI         *string `json:"uri,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Type        string  `json:"type"`
+	Code               string          `json:"code"`
+	CodeElement        *common.Element `json:"_code,omitempty"`
+	URI                *string         `json:"uri,omitempty"`
+	URIElement         *common.Element `json:"_uri,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+	Type               string          `json:"type"`
+	TypeElement        *common.Element `json:"_type,omitempty"`
 }
 
 // CodeSystemConcept represents concepts
 type CodeSystemConcept struct {
 	common.BackboneElement
 
-	Code        string                         `json:"code"`
-	Display     *string                        `json:"display,omitempty"`
-	Definition  *string                        `json:"definition,omitempty"`
-	Designation []CodeSystemConceptDesignation `json:"designation,omitempty"`
-	Property    []CodeSystemConceptProperty    `json:"property,omitempty"`
-	Concept     []CodeSystemConcept            `json:"concept,omitempty"`
+	Code              string                         `json:"code"`
+	CodeElement       *common.Element                `json:"_code,omitempty"`
+	Display           *string                        `json:"display,omitempty"`
+	DisplayElement    *common.Element                `json:"_display,omitempty"`
+	Definition        *string                        `json:"definition,omitempty"`
+	DefinitionElement *common.Element                `json:"_definition,omitempty"`
+	Designation       []CodeSystemConceptDesignation `json:"designation,omitempty"`
+	Property          []CodeSystemConceptProperty    `json:"property,omitempty"`
+	Concept           []CodeSystemConcept            `json:"concept,omitempty"`
 }
 
 // CodeSystemConceptDesignation represents concept designations
 type CodeSystemConceptDesignation struct {
 	common.BackboneElement
 
-	Language *string        `json:"language,omitempty"`
-	Use      *common.Coding `json:"use,omitempty"`
-	Value    string         `json:"value"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+	Use             *common.Coding  `json:"use,omitempty"`
+	Value           string          `json:"value"`
+	ValueElement    *common.Element `json:"_value,omitempty"`
 }
 
 // CodeSystemConceptProperty represents concept properties
 type CodeSystemConceptProperty struct {
 	common.BackboneElement
 
-	Code          string         `json:"code"`
-	ValueCode     *string        `json:"valueCode,omitempty"`
-	ValueCoding   *common.Coding `json:"valueCoding,omitempty"`
-	ValueString   *string        `json:"valueString,omitempty"`
-	ValueInteger  *int           `json:"valueInteger,omitempty"`
-	ValueBoolean  *bool          `json:"valueBoolean,omitempty"`
-	ValueDateTime *string        `json:"valueDateTime,omitempty"`
-	ValueDecimal  *float64       `json:"valueDecimal,omitempty"`
+	Code                 string           `json:"code"`
+	CodeElement          *common.Element  `json:"_code,omitempty"`
+	ValueCode            *string          `json:"valueCode,omitempty"`
+	ValueCodeElement     *common.Element  `json:"_valueCode,omitempty"`
+	ValueCoding          *common.Coding   `json:"valueCoding,omitempty"`
+	ValueString          *string          `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element  `json:"_valueString,omitempty"`
+	ValueInteger         *int             `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element  `json:"_valueInteger,omitempty"`
+	ValueBoolean         *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+	ValueDecimal         *common.Decimal  `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element  `json:"_valueDecimal,omitempty"`
 }
 
 // MarshalJSON marshals the CodeSystem to JSON
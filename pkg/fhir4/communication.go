@@ -16,10 +16,12 @@ type Communication struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// Instantiates external protocol or definition
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Request fulfilled by this communication
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -31,7 +33,8 @@ type Communication struct {
 	InResponseTo []common.Reference `json:"inResponseTo,omitempty"`
 
 	// preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
-	Status CommunicationStatus `json:"status"`
+	Status        CommunicationStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -40,7 +43,8 @@ type Communication struct {
 	Category []common.CodeableConcept `json:"category,omitempty"`
 
 	// routine | urgent | asap | stat
-	Priority *CommunicationPriority `json:"priority,omitempty"`
+	Priority        *CommunicationPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
 
 	// A channel of communication
 	Medium []common.CodeableConcept `json:"medium,omitempty"`
@@ -58,10 +62,12 @@ type Communication struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// When sent
-	Sent *string `json:"sent,omitempty"`
+	Sent        *common.DateTime `json:"sent,omitempty"`
+	SentElement *common.Element  `json:"_sent,omitempty"`
 
 	// When received
-	Received *string `json:"received,omitempty"`
+	Received        *common.DateTime `json:"received,omitempty"`
+	ReceivedElement *common.Element  `json:"_received,omitempty"`
 
 	// Message recipient
 	Recipient []common.Reference `json:"recipient,omitempty"`
@@ -111,7 +117,8 @@ type CommunicationPayload struct {
 	common.BackboneElement
 
 	// Message part content
-	ContentString     *string           `json:"contentString,omitempty"`
-	ContentAttachment *Attachment       `json:"contentAttachment,omitempty"`
-	ContentReference  *common.Reference `json:"contentReference,omitempty"`
+	ContentString        *string           `json:"contentString,omitempty"`
+	ContentStringElement *common.Element   `json:"_contentString,omitempty"`
+	ContentAttachment    *Attachment       `json:"contentAttachment,omitempty"`
+	ContentReference     *common.Reference `json:"contentReference,omitempty"`
 }
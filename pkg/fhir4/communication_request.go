@@ -10,37 +10,43 @@ import (
 type CommunicationRequest struct {
 	DomainResource
 
-	ResourceType       string                        `json:"resourceType"`
-	Identifier         []common.Identifier           `json:"identifier,omitempty"`
-	BasedOn            []common.Reference            `json:"basedOn,omitempty"`
-	Replaces           []common.Reference            `json:"replaces,omitempty"`
-	GroupIdentifier    *common.Identifier            `json:"groupIdentifier,omitempty"`
-	Status             string                        `json:"status"`
-	StatusReason       *common.CodeableConcept       `json:"statusReason,omitempty"`
-	Category           []common.CodeableConcept      `json:"category,omitempty"`
-	Priority           *string                       `json:"priority,omitempty"`
-	DoNotPerform       *bool                         `json:"doNotPerform,omitempty"`
-	Medium             []common.CodeableConcept      `json:"medium,omitempty"`
-	Subject            *common.Reference             `json:"subject,omitempty"`
-	About              []common.Reference            `json:"about,omitempty"`
-	Encounter          *common.Reference             `json:"encounter,omitempty"`
-	Payload            []CommunicationRequestPayload `json:"payload,omitempty"`
-	OccurrenceDateTime *string                       `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period                `json:"occurrencePeriod,omitempty"`
-	AuthoredOn         *string                       `json:"authoredOn,omitempty"`
-	Requester          *common.Reference             `json:"requester,omitempty"`
-	Recipient          []common.Reference            `json:"recipient,omitempty"`
-	Sender             *common.Reference             `json:"sender,omitempty"`
-	ReasonCode         []common.CodeableConcept      `json:"reasonCode,omitempty"`
-	ReasonReference    []common.Reference            `json:"reasonReference,omitempty"`
-	Note               []common.Annotation           `json:"note,omitempty"`
+	ResourceType              string                        `json:"resourceType"`
+	Identifier                []common.Identifier           `json:"identifier,omitempty"`
+	BasedOn                   []common.Reference            `json:"basedOn,omitempty"`
+	Replaces                  []common.Reference            `json:"replaces,omitempty"`
+	GroupIdentifier           *common.Identifier            `json:"groupIdentifier,omitempty"`
+	Status                    string                        `json:"status"`
+	StatusElement             *common.Element               `json:"_status,omitempty"`
+	StatusReason              *common.CodeableConcept       `json:"statusReason,omitempty"`
+	Category                  []common.CodeableConcept      `json:"category,omitempty"`
+	Priority                  *string                       `json:"priority,omitempty"`
+	PriorityElement           *common.Element               `json:"_priority,omitempty"`
+	DoNotPerform              *bool                         `json:"doNotPerform,omitempty"`
+	DoNotPerformElement       *common.Element               `json:"_doNotPerform,omitempty"`
+	Medium                    []common.CodeableConcept      `json:"medium,omitempty"`
+	Subject                   *common.Reference             `json:"subject,omitempty"`
+	About                     []common.Reference            `json:"about,omitempty"`
+	Encounter                 *common.Reference             `json:"encounter,omitempty"`
+	Payload                   []CommunicationRequestPayload `json:"payload,omitempty"`
+	OccurrenceDateTime        *common.DateTime              `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element               `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period                `json:"occurrencePeriod,omitempty"`
+	AuthoredOn                *common.DateTime              `json:"authoredOn,omitempty"`
+	AuthoredOnElement         *common.Element               `json:"_authoredOn,omitempty"`
+	Requester                 *common.Reference             `json:"requester,omitempty"`
+	Recipient                 []common.Reference            `json:"recipient,omitempty"`
+	Sender                    *common.Reference             `json:"sender,omitempty"`
+	ReasonCode                []common.CodeableConcept      `json:"reasonCode,omitempty"`
+	ReasonReference           []common.Reference            `json:"reasonReference,omitempty"`
+	Note                      []common.Annotation           `json:"note,omitempty"`
 }
 
 type CommunicationRequestPayload struct {
 	common.BackboneElement
-	ContentString     *string            `json:"contentString,omitempty"`
-	ContentAttachment *common.Attachment `json:"contentAttachment,omitempty"`
-	ContentReference  *common.Reference  `json:"contentReference,omitempty"`
+	ContentString        *string            `json:"contentString,omitempty"`
+	ContentStringElement *common.Element    `json:"_contentString,omitempty"`
+	ContentAttachment    *common.Attachment `json:"contentAttachment,omitempty"`
+	ContentReference     *common.Reference  `json:"contentReference,omitempty"`
 }
 
 func (c *CommunicationRequest) MarshalJSON() ([]byte, error) {
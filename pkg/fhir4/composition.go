@@ -16,7 +16,8 @@ type Composition struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// The workflow/clinical status of the composition
-	Status CompositionStatus `json:"status"`
+	Status        CompositionStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Kind of composition (LOINC if possible)
 	Type common.CodeableConcept `json:"type"`
@@ -31,12 +32,14 @@ type Composition struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Composition editing time
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Who and/or what authored the composition
 	Author []common.Reference `json:"author"`
 
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Who attested the composition
 	Attester []CompositionAttester `json:"attester,omitempty"`
@@ -69,10 +72,12 @@ type CompositionAttester struct {
 	common.BackboneElement
 
 	// personal | professional | legal | official
-	Mode []CompositionAttesterMode `json:"mode"`
+	Mode        []CompositionAttesterMode `json:"mode"`
+	ModeElement []*common.Element         `json:"_mode,omitempty"`
 
 	// When the composition was attested
-	Time *string `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 
 	// Who attested the composition
 	Party *common.Reference `json:"party,omitempty"`
@@ -93,7 +98,8 @@ type CompositionRelatesTo struct {
 	common.BackboneElement
 
 	// replaces | transforms | signs | appends
-	Code CompositionRelatesToCode `json:"code"`
+	Code        CompositionRelatesToCode `json:"code"`
+	CodeElement *common.Element          `json:"_code,omitempty"`
 
 	// Target of the relationship
 	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
@@ -129,7 +135,8 @@ type CompositionSection struct {
 	common.BackboneElement
 
 	// Label for section (e.g. for ToC)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Classification of section (recommended)
 	Code *common.CodeableConcept `json:"code,omitempty"`
@@ -144,7 +151,8 @@ type CompositionSection struct {
 	Text *Narrative `json:"text,omitempty"`
 
 	// working | snapshot | changes
-	Mode *SectionMode `json:"mode,omitempty"`
+	Mode        *SectionMode    `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
 
 	// Order of section entries
 	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
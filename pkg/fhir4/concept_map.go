@@ -10,70 +10,103 @@ import (
 type ConceptMap struct {
 	DomainResource
 
-	ResourceType    string                   `json:"resourceType"`
-	URL             *string                  `json:"url,omitempty"`
-	Identifier      *common.Identifier       `json:"identifier,omitempty"`
-	Version         *string                  `json:"version,omitempty"`
-	Name            *string                  `json:"name,omitempty"`
-	Title           *string                  `json:"title,omitempty"`
-	Status          string                   `json:"status"`
-	Experimental    *bool                    `json:"experimental,omitempty"`
-	Date            *string                  `json:"date,omitempty"`
-	Publisher       *string                  `json:"publisher,omitempty"`
-	Contact         []common.ContactDetail   `json:"contact,omitempty"`
-	Description     *string                  `json:"description,omitempty"`
-	UseContext      []common.UsageContext    `json:"useContext,omitempty"`
-	Jurisdiction    []common.CodeableConcept `json:"jurisdiction,omitempty"`
-	Purpose         *string                  `json:"purpose,omitempty"`
-	Copyright       *string                  `json:"copyright,omitempty"`
-	SourceUri       *string                  `json:"sourceUri,omitempty"`
-	SourceCanonical *string                  `json:"sourceCanonical,omitempty"`
-	TargetUri       *string                  `json:"targetUri,omitempty"`
-	TargetCanonical *string                  `json:"targetCanonical,omitempty"`
-	Group           []ConceptMapGroup        `json:"group,omitempty"`
+	ResourceType           string                   `json:"resourceType"`
+	URL                    *string                  `json:"url,omitempty"`
+	URLElement             *common.Element          `json:"_url,omitempty"`
+	Identifier             *common.Identifier       `json:"identifier,omitempty"`
+	Version                *string                  `json:"version,omitempty"`
+	VersionElement         *common.Element          `json:"_version,omitempty"`
+	Name                   *string                  `json:"name,omitempty"`
+	NameElement            *common.Element          `json:"_name,omitempty"`
+	Title                  *string                  `json:"title,omitempty"`
+	TitleElement           *common.Element          `json:"_title,omitempty"`
+	Status                 string                   `json:"status"`
+	StatusElement          *common.Element          `json:"_status,omitempty"`
+	Experimental           *bool                    `json:"experimental,omitempty"`
+	ExperimentalElement    *common.Element          `json:"_experimental,omitempty"`
+	Date                   *common.DateTime         `json:"date,omitempty"`
+	DateElement            *common.Element          `json:"_date,omitempty"`
+	Publisher              *string                  `json:"publisher,omitempty"`
+	PublisherElement       *common.Element          `json:"_publisher,omitempty"`
+	Contact                []common.ContactDetail   `json:"contact,omitempty"`
+	Description            *string                  `json:"description,omitempty"`
+	DescriptionElement     *common.Element          `json:"_description,omitempty"`
+	UseContext             []common.UsageContext    `json:"useContext,omitempty"`
+	Jurisdiction           []common.CodeableConcept `json:"jurisdiction,omitempty"`
+	Purpose                *string                  `json:"purpose,omitempty"`
+	PurposeElement         *common.Element          `json:"_purpose,omitempty"`
+	Copyright              *string                  `json:"copyright,omitempty"`
+	CopyrightElement       *common.Element          `json:"_copyright,omitempty"`
+	SourceUri              *string                  `json:"sourceUri,omitempty"`
+	SourceUriElement       *common.Element          `json:"_sourceUri,omitempty"`
+	SourceCanonical        *string                  `json:"sourceCanonical,omitempty"`
+	SourceCanonicalElement *common.Element          `json:"_sourceCanonical,omitempty"`
+	TargetUri              *string                  `json:"targetUri,omitempty"`
+	TargetUriElement       *common.Element          `json:"_targetUri,omitempty"`
+	TargetCanonical        *string                  `json:"targetCanonical,omitempty"`
+	TargetCanonicalElement *common.Element          `json:"_targetCanonical,omitempty"`
+	Group                  []ConceptMapGroup        `json:"group,omitempty"`
 }
 
 type ConceptMapGroup struct {
 	common.BackboneElement
-	Source        *string                  `json:"source,omitempty"`
-	SourceVersion *string                  `json:"sourceVersion,omitempty"`
-	Target        *string                  `json:"target,omitempty"`
-	TargetVersion *string                  `json:"targetVersion,omitempty"`
-	Element       []ConceptMapGroupElement `json:"element,omitempty"`
-	Unmapped      *ConceptMapGroupUnmapped `json:"unmapped,omitempty"`
+	Source               *string                  `json:"source,omitempty"`
+	SourceElement        *common.Element          `json:"_source,omitempty"`
+	SourceVersion        *string                  `json:"sourceVersion,omitempty"`
+	SourceVersionElement *common.Element          `json:"_sourceVersion,omitempty"`
+	Target               *string                  `json:"target,omitempty"`
+	TargetElement        *common.Element          `json:"_target,omitempty"`
+	TargetVersion        *string                  `json:"targetVersion,omitempty"`
+	TargetVersionElement *common.Element          `json:"_targetVersion,omitempty"`
+	Element              []ConceptMapGroupElement `json:"element,omitempty"`
+	Unmapped             *ConceptMapGroupUnmapped `json:"unmapped,omitempty"`
 }
 
 type ConceptMapGroupElement struct {
 	common.BackboneElement
-	Code    *string                        `json:"code,omitempty"`
-	Display *string                        `json:"display,omitempty"`
-	Target  []ConceptMapGroupElementTarget `json:"target,omitempty"`
+	Code           *string                        `json:"code,omitempty"`
+	CodeElement    *common.Element                `json:"_code,omitempty"`
+	Display        *string                        `json:"display,omitempty"`
+	DisplayElement *common.Element                `json:"_display,omitempty"`
+	Target         []ConceptMapGroupElementTarget `json:"target,omitempty"`
 }
 
 type ConceptMapGroupElementTarget struct {
 	common.BackboneElement
-	Code        *string                                 `json:"code,omitempty"`
-	Display     *string                                 `json:"display,omitempty"`
-	Equivalence string                                  `json:"equivalence"`
-	Comment     *string                                 `json:"comment,omitempty"`
-	DependsOn   []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty"`
-	Product     []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty"`
+	Code               *string                                 `json:"code,omitempty"`
+	CodeElement        *common.Element                         `json:"_code,omitempty"`
+	Display            *string                                 `json:"display,omitempty"`
+	DisplayElement     *common.Element                         `json:"_display,omitempty"`
+	Equivalence        string                                  `json:"equivalence"`
+	EquivalenceElement *common.Element                         `json:"_equivalence,omitempty"`
+	Comment            *string                                 `json:"comment,omitempty"`
+	CommentElement     *common.Element                         `json:"_comment,omitempty"`
+	DependsOn          []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty"`
+	Product            []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty"`
 }
 
 type ConceptMapGroupElementTargetDependsOn struct {
 	common.BackboneElement
-	Property string  `json:"property"`
-	System   *string `json:"system,omitempty"`
-	Value    string  `json:"value"`
-	Display  *string `json:"display,omitempty"`
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+	System          *string         `json:"system,omitempty"`
+	SystemElement   *common.Element `json:"_system,omitempty"`
+	Value           string          `json:"value"`
+	ValueElement    *common.Element `json:"_value,omitempty"`
+	Display         *string         `json:"display,omitempty"`
+	DisplayElement  *common.Element `json:"_display,omitempty"`
 }
 
 type ConceptMapGroupUnmapped struct {
 	common.BackboneElement
-	Mode    string  `json:"mode"`
-	Code    *string `json:"code,omitempty"`
-	Display *string `json:"display,omitempty"`
-	URL     *string `json:"url,omitempty"`
+	Mode           string          `json:"mode"`
+	ModeElement    *common.Element `json:"_mode,omitempty"`
+	Code           *string         `json:"code,omitempty"`
+	CodeElement    *common.Element `json:"_code,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+	URL            *string         `json:"url,omitempty"`
+	URLElement     *common.Element `json:"_url,omitempty"`
 }
 
 func (c *ConceptMap) MarshalJSON() ([]byte, error) {
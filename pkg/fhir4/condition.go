@@ -40,22 +40,28 @@ type Condition struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Estimated or actual date, date-time, or age when condition began
-	OnsetDateTime *string        `json:"onsetDateTime,omitempty"`
-	OnsetAge      *Age           `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *Age             `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// When in resolution/remission
-	AbatementDateTime *string        `json:"abatementDateTime,omitempty"`
-	AbatementAge      *Age           `json:"abatementAge,omitempty"`
-	AbatementPeriod   *common.Period `json:"abatementPeriod,omitempty"`
-	AbatementRange    *Range         `json:"abatementRange,omitempty"`
-	AbatementString   *string        `json:"abatementString,omitempty"`
-	AbatementBoolean  *bool          `json:"abatementBoolean,omitempty"`
+	AbatementDateTime        *common.DateTime `json:"abatementDateTime,omitempty"`
+	AbatementDateTimeElement *common.Element  `json:"_abatementDateTime,omitempty"`
+	AbatementAge             *Age             `json:"abatementAge,omitempty"`
+	AbatementPeriod          *common.Period   `json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range           `json:"abatementRange,omitempty"`
+	AbatementString          *string          `json:"abatementString,omitempty"`
+	AbatementStringElement   *common.Element  `json:"_abatementString,omitempty"`
+	AbatementBoolean         *bool            `json:"abatementBoolean,omitempty"`
+	AbatementBooleanElement  *common.Element  `json:"_abatementBoolean,omitempty"`
 
 	// Date record was first recorded
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Who recorded the record and takes responsibility for its content
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -12,7 +12,8 @@ type Contract struct {
 	ResourceType string `json:"resourceType"` // Always "Contract"
 
 	// Alternative representation of the title for this Contract definition
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Relevant time or time-period when this Contract is applicable
 	Applies *common.Period `json:"applies,omitempty"`
@@ -45,10 +46,12 @@ type Contract struct {
 	InstantiatesCanonical *common.Reference `json:"instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained definition that is adhered to in whole or in part by this Contract
-	InstantiatesUri *string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
 
 	// When this Contract was issued
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
 
 	// List of Legal expressions or representations of this Contract
 	Legal []ContractLegal `json:"legal,omitempty"`
@@ -63,7 +66,8 @@ type Contract struct {
 	LegalState *common.CodeableConcept `json:"legalState,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Links to Provenance records for past versions of this Contract definition
 	RelevantHistory []common.Reference `json:"relevantHistory,omitempty"`
@@ -81,13 +85,15 @@ type Contract struct {
 	Site []common.Reference `json:"site,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the contract as not currently valid or active
-	Status *ContractStatus `json:"status,omitempty"`
+	Status        *ContractStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The Contract.subject is an entity that has some role with respect to the Contract.topic
 	Subject []common.Reference `json:"subject,omitempty"`
 
 	// An explanatory or alternate user-friendly title for this Contract definition
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// Sub-category for the Contract that distinguishes the kinds of systems that would be interested in the Contract
 	SubType []common.CodeableConcept `json:"subType,omitempty"`
@@ -99,7 +105,8 @@ type Contract struct {
 	Term []ContractTerm `json:"term,omitempty"`
 
 	// A short, descriptive, user-friendly title for this Contract definition
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Narrows the range of legal concerns to focus on or achieve one or more Contract.topic to one or more Contract.topic.term
 	TopicCodeableConcept *common.CodeableConcept `json:"topicCodeableConcept,omitempty"`
@@ -140,13 +147,16 @@ type ContractContentDefinition struct {
 	common.BackboneElement
 
 	// A copyright statement relating to Contract precursor content
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The date (and optionally time) when the contract was published
-	PublicationDate *string `json:"publicationDate,omitempty"`
+	PublicationDate        *common.Date    `json:"publicationDate,omitempty"`
+	PublicationDateElement *common.Element `json:"_publicationDate,omitempty"`
 
 	// amended | appended | cancelled | disputed | entered-in-error | executable | executed | negotiable | offered | policy | rejected | renewed | revoked | resolved | terminated
-	PublicationStatus ContractContentDefinitionPublicationStatus `json:"publicationStatus"`
+	PublicationStatus        ContractContentDefinitionPublicationStatus `json:"publicationStatus"`
+	PublicationStatusElement *common.Element                            `json:"_publicationStatus,omitempty"`
 
 	// The individual or organization that published the Contract precursor content
 	Publisher *common.Reference `json:"publisher,omitempty"`
@@ -231,7 +241,8 @@ type ContractValuedItem struct {
 	common.BackboneElement
 
 	// Indicates the time during which this Contract ValuedItem information is effective
-	EffectiveTime *string `json:"effectiveTime,omitempty"`
+	EffectiveTime        *common.DateTime `json:"effectiveTime,omitempty"`
+	EffectiveTimeElement *common.Element  `json:"_effectiveTime,omitempty"`
 
 	// Specific type of Contract Valued Item that may be priced
 	EntityCodeableConcept *common.CodeableConcept `json:"entityCodeableConcept,omitempty"`
@@ -240,25 +251,30 @@ type ContractValuedItem struct {
 	EntityReference *common.Reference `json:"entityReference,omitempty"`
 
 	// A real number that represents a multiplier used in determining the overall value
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Identifies a Contract Valued Item instance
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Id of the clause or question text related to the context of this valuedItem
-	LinkId []string `json:"linkId,omitempty"`
+	LinkId        []string          `json:"linkId,omitempty"`
+	LinkIdElement []*common.Element `json:"_linkId,omitempty"`
 
 	// Expresses the product of the Contract Valued Item unitQuantity and the unitPriceAmt
 	Net *common.Money `json:"net,omitempty"`
 
 	// Terms of valuation
-	Payment *string `json:"payment,omitempty"`
+	Payment        *string         `json:"payment,omitempty"`
+	PaymentElement *common.Element `json:"_payment,omitempty"`
 
 	// When payment is due
-	PaymentDate *string `json:"paymentDate,omitempty"`
+	PaymentDate        *common.DateTime `json:"paymentDate,omitempty"`
+	PaymentDateElement *common.Element  `json:"_paymentDate,omitempty"`
 
 	// An amount that expresses the weighting associated with the Contract Valued Item delivered
-	Points *float64 `json:"points,omitempty"`
+	Points        *common.Decimal `json:"points,omitempty"`
+	PointsElement *common.Element `json:"_points,omitempty"`
 
 	// Specifies the units by which the Contract Valued Item is measured or counted
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -270,7 +286,8 @@ type ContractValuedItem struct {
 	Responsible *common.Reference `json:"responsible,omitempty"`
 
 	// A set of security labels that define which terms are controlled by this condition
-	SecurityLabelNumber []int `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumber        []int             `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*common.Element `json:"_securityLabelNumber,omitempty"`
 
 	// A Contract Valued Item unit valuation measure
 	UnitPrice *common.Money `json:"unitPrice,omitempty"`
@@ -296,7 +313,8 @@ type ContractTerm struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// When this Contract Provision was issued
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
 
 	// The matter of concern in the context of this provision of the agreement
 	Offer ContractTermOffer `json:"offer"`
@@ -308,7 +326,8 @@ type ContractTerm struct {
 	SubType *common.CodeableConcept `json:"subType,omitempty"`
 
 	// Statement of a provision in a policy or a contract
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The entity that the term applies to
 	TopicCodeableConcept *common.CodeableConcept `json:"topicCodeableConcept,omitempty"`
@@ -328,22 +347,26 @@ type ContractTermAction struct {
 	Context *common.Reference `json:"context,omitempty"`
 
 	// Id of the clause or question text related to the requester of this action
-	ContextLinkId []string `json:"contextLinkId,omitempty"`
+	ContextLinkId        []string          `json:"contextLinkId,omitempty"`
+	ContextLinkIdElement []*common.Element `json:"_contextLinkId,omitempty"`
 
 	// True if the term prohibits the action
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// Reason or purpose for the action stipulated by this Contract Provision
 	Intent common.CodeableConcept `json:"intent"`
 
 	// Id of the clause or question text related to this action
-	LinkId []string `json:"linkId,omitempty"`
+	LinkId        []string          `json:"linkId,omitempty"`
+	LinkIdElement []*common.Element `json:"_linkId,omitempty"`
 
 	// Comments made about the term action made by the requester, performer, subject or other participants
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// When action happens
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// When action happens
 	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
@@ -355,7 +378,8 @@ type ContractTermAction struct {
 	Performer *common.Reference `json:"performer,omitempty"`
 
 	// Id of the clause or question text related to the reason type or reference of this action
-	PerformerLinkId []string `json:"performerLinkId,omitempty"`
+	PerformerLinkId        []string          `json:"performerLinkId,omitempty"`
+	PerformerLinkIdElement []*common.Element `json:"_performerLinkId,omitempty"`
 
 	// The type of role or competency of an individual desired or required to perform or not perform the action
 	PerformerRole *common.CodeableConcept `json:"performerRole,omitempty"`
@@ -364,13 +388,15 @@ type ContractTermAction struct {
 	PerformerType []common.CodeableConcept `json:"performerType,omitempty"`
 
 	// Describes why the action is to be performed or not performed in textual form
-	Reason []string `json:"reason,omitempty"`
+	Reason        []string          `json:"reason,omitempty"`
+	ReasonElement []*common.Element `json:"_reason,omitempty"`
 
 	// Rationale for the action to be performed or not performed
 	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
 
 	// Id of the clause or question text related to the reason type or reference of this action
-	ReasonLinkId []string `json:"reasonLinkId,omitempty"`
+	ReasonLinkId        []string          `json:"reasonLinkId,omitempty"`
+	ReasonLinkIdElement []*common.Element `json:"_reasonLinkId,omitempty"`
 
 	// Indicates another resource whose existence justifies permitting or not permitting this action
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
@@ -379,10 +405,12 @@ type ContractTermAction struct {
 	Requester []common.Reference `json:"requester,omitempty"`
 
 	// Id of the clause or question text related to the requester of this action
-	RequesterLinkId []string `json:"requesterLinkId,omitempty"`
+	RequesterLinkId        []string          `json:"requesterLinkId,omitempty"`
+	RequesterLinkIdElement []*common.Element `json:"_requesterLinkId,omitempty"`
 
 	// Security labels that protects the action
-	SecurityLabelNumber []int `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumber        []int             `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*common.Element `json:"_securityLabelNumber,omitempty"`
 
 	// Current state of the term action
 	Status common.CodeableConcept `json:"status"`
@@ -413,13 +441,15 @@ type ContractTermAsset struct {
 	Answer []ContractTermOfferAnswer `json:"answer,omitempty"`
 
 	// Description of the quality and completeness of the asset that may be a factor in its valuation
-	Condition *string `json:"condition,omitempty"`
+	Condition        *string         `json:"condition,omitempty"`
+	ConditionElement *common.Element `json:"_condition,omitempty"`
 
 	// Circumstance of the asset
 	Context []ContractTermAssetContext `json:"context,omitempty"`
 
 	// Id of the clause or question text about the asset in the referenced form or QuestionnaireResponse
-	LinkId []string `json:"linkId,omitempty"`
+	LinkId        []string          `json:"linkId,omitempty"`
+	LinkIdElement []*common.Element `json:"_linkId,omitempty"`
 
 	// Asset relevant contractual time period
 	Period []common.Period `json:"period,omitempty"`
@@ -434,13 +464,15 @@ type ContractTermAsset struct {
 	Scope *common.CodeableConcept `json:"scope,omitempty"`
 
 	// Security labels that protects the asset
-	SecurityLabelNumber []int `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumber        []int             `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*common.Element `json:"_securityLabelNumber,omitempty"`
 
 	// May be a subtype or part of an offered asset
 	Subtype []common.CodeableConcept `json:"subtype,omitempty"`
 
 	// Clause or question text concerning the asset in a linked form
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Target entity type about which the term may be concerned
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -466,7 +498,8 @@ type ContractTermAssetContext struct {
 	Reference *common.Reference `json:"reference,omitempty"`
 
 	// Context description
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // ContractTermAssetValuedItem represents Contract Valued Item List
@@ -474,7 +507,8 @@ type ContractTermAssetValuedItem struct {
 	common.BackboneElement
 
 	// Indicates the time during which this Contract ValuedItem information is effective
-	EffectiveTime *string `json:"effectiveTime,omitempty"`
+	EffectiveTime        *common.DateTime `json:"effectiveTime,omitempty"`
+	EffectiveTimeElement *common.Element  `json:"_effectiveTime,omitempty"`
 
 	// Specific type of Contract Valued Item that may be priced
 	EntityCodeableConcept *common.CodeableConcept `json:"entityCodeableConcept,omitempty"`
@@ -483,25 +517,30 @@ type ContractTermAssetValuedItem struct {
 	EntityReference *common.Reference `json:"entityReference,omitempty"`
 
 	// A real number that represents a multiplier used in determining the overall value
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Identifies a Contract Valued Item instance
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Id of the clause or question text related to the context of this valuedItem
-	LinkId []string `json:"linkId,omitempty"`
+	LinkId        []string          `json:"linkId,omitempty"`
+	LinkIdElement []*common.Element `json:"_linkId,omitempty"`
 
 	// Expresses the product of the Contract Valued Item unitQuantity and the unitPriceAmt
 	Net *common.Money `json:"net,omitempty"`
 
 	// Terms of valuation
-	Payment *string `json:"payment,omitempty"`
+	Payment        *string         `json:"payment,omitempty"`
+	PaymentElement *common.Element `json:"_payment,omitempty"`
 
 	// When payment is due
-	PaymentDate *string `json:"paymentDate,omitempty"`
+	PaymentDate        *common.DateTime `json:"paymentDate,omitempty"`
+	PaymentDateElement *common.Element  `json:"_paymentDate,omitempty"`
 
 	// An amount that expresses the weighting associated with the Contract Valued Item delivered
-	Points *float64 `json:"points,omitempty"`
+	Points        *common.Decimal `json:"points,omitempty"`
+	PointsElement *common.Element `json:"_points,omitempty"`
 
 	// Specifies the units by which the Contract Valued Item is measured or counted
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -513,7 +552,8 @@ type ContractTermAssetValuedItem struct {
 	Responsible *common.Reference `json:"responsible,omitempty"`
 
 	// A set of security labels that define which terms are controlled by this condition
-	SecurityLabelNumber []int `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumber        []int             `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*common.Element `json:"_securityLabelNumber,omitempty"`
 
 	// A Contract Valued Item unit valuation measure
 	UnitPrice *common.Money `json:"unitPrice,omitempty"`
@@ -536,16 +576,19 @@ type ContractTermOffer struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The id of the clause or question text of the offer in the referenced questionnaire/response
-	LinkId []string `json:"linkId,omitempty"`
+	LinkId        []string          `json:"linkId,omitempty"`
+	LinkIdElement []*common.Element `json:"_linkId,omitempty"`
 
 	// Offer Recipient
 	Party []ContractTermOfferParty `json:"party,omitempty"`
 
 	// Security labels that protects the offer
-	SecurityLabelNumber []int `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumber        []int             `json:"securityLabelNumber,omitempty"`
+	SecurityLabelNumberElement []*common.Element `json:"_securityLabelNumber,omitempty"`
 
 	// Human readable form of this Contract Offer
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The Contract.topic may be an application for or offer of a policy or service
 	Topic *common.Reference `json:"topic,omitempty"`
@@ -559,28 +602,36 @@ type ContractTermOfferAnswer struct {
 	common.BackboneElement
 
 	// Response to an offer clause or question text
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// Response to an offer clause or question text
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// Response to an offer clause or question text
 	ValueAttachment *common.Attachment `json:"valueAttachment,omitempty"`
@@ -620,5 +671,6 @@ type ContractTermSecurityLabel struct {
 	Control []common.Coding `json:"control,omitempty"`
 
 	// Number used to link this term or term element to the applicable Security Label
-	Number []int `json:"number,omitempty"`
+	Number        []int             `json:"number,omitempty"`
+	NumberElement []*common.Element `json:"_number,omitempty"`
 }
@@ -24,16 +24,19 @@ type Coverage struct {
 	CostToBeneficiary []CoverageCostToBeneficiary `json:"costToBeneficiary,omitempty"`
 
 	// Periodically the member number is constructed from the subscriberId and the dependant number
-	Dependent *string `json:"dependent,omitempty"`
+	Dependent        *string         `json:"dependent,omitempty"`
+	DependentElement *common.Element `json:"_dependent,omitempty"`
 
 	// The main (and possibly only) identifier for the coverage - often referred to as a Member Id, Certificate number, Personal Health Number or Case ID
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The insurer-specific identifier for the insurer-defined network of providers to which the beneficiary may seek treatment
-	Network *string `json:"network,omitempty"`
+	Network        *string         `json:"network,omitempty"`
+	NetworkElement *common.Element `json:"_network,omitempty"`
 
 	// The order of applicability of this coverage relative to other coverages which are currently in force
-	Order *int `json:"order,omitempty"`
+	Order        *int            `json:"order,omitempty"`
+	OrderElement *common.Element `json:"_order,omitempty"`
 
 	// May provide multiple identifiers such as insurance company identifier or business identifier (BIN number)
 	Payor []common.Reference `json:"payor"`
@@ -48,16 +51,19 @@ type Coverage struct {
 	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the code entered-in-error that marks the coverage as not currently valid
-	Status CoverageStatus `json:"status"`
+	Status        CoverageStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Typically, automotive and worker's compensation policies would be flagged with 'subrogation=true' to enable healthcare payors to collect against accident claims
-	Subrogation *bool `json:"subrogation,omitempty"`
+	Subrogation        *bool           `json:"subrogation,omitempty"`
+	SubrogationElement *common.Element `json:"_subrogation,omitempty"`
 
 	// May be self or a parent in the case of dependants
 	Subscriber *common.Reference `json:"subscriber,omitempty"`
 
 	// The insurer assigned ID for the Subscriber
-	SubscriberId *string `json:"subscriberId,omitempty"`
+	SubscriberId        *string         `json:"subscriberId,omitempty"`
+	SubscriberIdElement *common.Element `json:"_subscriberId,omitempty"`
 
 	// The type of coverage: social program, medical plan, accident coverage (workers compensation, auto), group health or payment by an individual or organization
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -78,13 +84,15 @@ type CoverageClass struct {
 	common.BackboneElement
 
 	// A short description for the class
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of classification for which an insurer-specific class label or number and optional name is provided
 	Type common.CodeableConcept `json:"type"`
 
 	// For example, the Group or Plan number
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // CoverageCostToBeneficiaryException represents exceptions or reductions to patient costs
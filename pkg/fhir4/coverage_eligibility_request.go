@@ -12,7 +12,8 @@ type CoverageEligibilityRequest struct {
 	ResourceType string `json:"resourceType"` // Always "CoverageEligibilityRequest"
 
 	// The date when this resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// Person who created the request
 	Enterer *common.Reference `json:"enterer,omitempty"`
@@ -42,16 +43,19 @@ type CoverageEligibilityRequest struct {
 	Provider *common.Reference `json:"provider,omitempty"`
 
 	// Code to specify whether requesting: prior authorization requirements for some service categories or billing codes
-	Purpose []CoverageEligibilityRequestPurpose `json:"purpose"`
+	Purpose        []CoverageEligibilityRequestPurpose `json:"purpose"`
+	PurposeElement []*common.Element                   `json:"_purpose,omitempty"`
 
 	// The date or dates when the enclosed suite of services were performed or completed
-	ServicedDate *string `json:"servicedDate,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
 
 	// The date or dates when the enclosed suite of services were performed or completed
 	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status CoverageEligibilityRequestStatus `json:"status"`
+	Status        CoverageEligibilityRequestStatus `json:"status"`
+	StatusElement *common.Element                  `json:"_status,omitempty"`
 
 	// Often there are multiple jurisdiction specific valuesets which are required
 	SupportingInfo []CoverageEligibilityRequestSupportingInfo `json:"supportingInfo,omitempty"`
@@ -82,13 +86,15 @@ type CoverageEligibilityRequestSupportingInfo struct {
 	common.BackboneElement
 
 	// The supporting materials are applicable for all detail items, product/service categories and specific billing codes
-	AppliesToAll *bool `json:"appliesToAll,omitempty"`
+	AppliesToAll        *bool           `json:"appliesToAll,omitempty"`
+	AppliesToAllElement *common.Element `json:"_appliesToAll,omitempty"`
 
 	// Could be used to provide references to other resources, document
 	Information common.Reference `json:"information"`
 
 	// A number to uniquely identify supporting information entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 }
 
 // CoverageEligibilityRequestInsurance represents insurance coverages for the patient
@@ -96,13 +102,15 @@ type CoverageEligibilityRequestInsurance struct {
 	common.BackboneElement
 
 	// A business agreement number established between the provider and the insurer for special business processing purposes
-	BusinessArrangement *string `json:"businessArrangement,omitempty"`
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
 
 	// Reference to the insurance card level information contained in the Coverage resource
 	Coverage common.Reference `json:"coverage"`
 
 	// A patient may (will) have multiple insurance policies which provide reimbursement for healthcare services and products
-	Focal *bool `json:"focal,omitempty"`
+	Focal        *bool           `json:"focal,omitempty"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
 }
 
 // CoverageEligibilityRequestItemDiagnosis represents patient diagnosis for which care is sought
@@ -145,7 +153,8 @@ type CoverageEligibilityRequestItem struct {
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// Exceptions, special conditions and supporting information applicable for this service or product line
-	SupportingInfoSequence []int `json:"supportingInfoSequence,omitempty"`
+	SupportingInfoSequence        []int             `json:"supportingInfoSequence,omitempty"`
+	SupportingInfoSequenceElement []*common.Element `json:"_supportingInfoSequence,omitempty"`
 
 	// The amount charged to the patient by the provider for a single unit
 	UnitPrice *common.Money `json:"unitPrice,omitempty"`
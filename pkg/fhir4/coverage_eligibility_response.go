@@ -12,10 +12,12 @@ type CoverageEligibilityResponse struct {
 	ResourceType string `json:"resourceType"` // Always "CoverageEligibilityResponse"
 
 	// The date this resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// A human readable description of the status of the adjudication
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// Errors encountered during the processing of the request
 	Error []CoverageEligibilityResponseError `json:"error,omitempty"`
@@ -33,16 +35,19 @@ type CoverageEligibilityResponse struct {
 	Insurer common.Reference `json:"insurer"`
 
 	// The resource may be used to indicate that: the request has been held (queued) for processing
-	Outcome CoverageEligibilityResponseOutcome `json:"outcome"`
+	Outcome        CoverageEligibilityResponseOutcome `json:"outcome"`
+	OutcomeElement *common.Element                    `json:"_outcome,omitempty"`
 
 	// The party who is the beneficiary of the supplied coverage and for whom eligibility is sought
 	Patient common.Reference `json:"patient"`
 
 	// A reference from the Insurer to which these services pertain to be used on further communication
-	PreAuthRef *string `json:"preAuthRef,omitempty"`
+	PreAuthRef        *string         `json:"preAuthRef,omitempty"`
+	PreAuthRefElement *common.Element `json:"_preAuthRef,omitempty"`
 
 	// Code to specify whether requesting: prior authorization requirements for some service categories or billing codes
-	Purpose []CoverageEligibilityResponsePurpose `json:"purpose"`
+	Purpose        []CoverageEligibilityResponsePurpose `json:"purpose"`
+	PurposeElement []*common.Element                    `json:"_purpose,omitempty"`
 
 	// Reference to the original request resource
 	Request common.Reference `json:"request"`
@@ -51,13 +56,15 @@ type CoverageEligibilityResponse struct {
 	Requestor *common.Reference `json:"requestor,omitempty"`
 
 	// The date or dates when the enclosed suite of services were performed or completed
-	ServicedDate *string `json:"servicedDate,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
 
 	// The date or dates when the enclosed suite of services were performed or completed
 	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status CoverageEligibilityResponseStatus `json:"status"`
+	Status        CoverageEligibilityResponseStatus `json:"status"`
+	StatusElement *common.Element                   `json:"_status,omitempty"`
 }
 
 // CoverageEligibilityResponseOutcome represents the outcome of the response
@@ -95,10 +102,12 @@ type CoverageEligibilityResponseInsuranceItemBenefit struct {
 	common.BackboneElement
 
 	// The quantity of the benefit which is permitted under the coverage
-	AllowedUnsignedInt *int `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedInt        *int            `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *common.Element `json:"_allowedUnsignedInt,omitempty"`
 
 	// The quantity of the benefit which is permitted under the coverage
-	AllowedString *string `json:"allowedString,omitempty"`
+	AllowedString        *string         `json:"allowedString,omitempty"`
+	AllowedStringElement *common.Element `json:"_allowedString,omitempty"`
 
 	// The quantity of the benefit which is permitted under the coverage
 	AllowedMoney *common.Money `json:"allowedMoney,omitempty"`
@@ -107,10 +116,12 @@ type CoverageEligibilityResponseInsuranceItemBenefit struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The quantity of the benefit which have been consumed to date
-	UsedUnsignedInt *int `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedInt        *int            `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement *common.Element `json:"_usedUnsignedInt,omitempty"`
 
 	// The quantity of the benefit which have been consumed to date
-	UsedString *string `json:"usedString,omitempty"`
+	UsedString        *string         `json:"usedString,omitempty"`
+	UsedStringElement *common.Element `json:"_usedString,omitempty"`
 
 	// The quantity of the benefit which have been consumed to date
 	UsedMoney *common.Money `json:"usedMoney,omitempty"`
@@ -121,13 +132,15 @@ type CoverageEligibilityResponseInsuranceItem struct {
 	common.BackboneElement
 
 	// A boolean flag indicating whether a preauthorization is required prior to actual service delivery
-	AuthorizationRequired *bool `json:"authorizationRequired,omitempty"`
+	AuthorizationRequired        *bool           `json:"authorizationRequired,omitempty"`
+	AuthorizationRequiredElement *common.Element `json:"_authorizationRequired,omitempty"`
 
 	// Codes or comments regarding information or actions associated with the preauthorization
 	AuthorizationSupporting []common.CodeableConcept `json:"authorizationSupporting,omitempty"`
 
 	// A web location for obtaining requirements or descriptive information regarding the preauthorization
-	AuthorizationUrl *string `json:"authorizationUrl,omitempty"`
+	AuthorizationUrl        *string         `json:"authorizationUrl,omitempty"`
+	AuthorizationUrlElement *common.Element `json:"_authorizationUrl,omitempty"`
 
 	// Benefits used to date
 	Benefit []CoverageEligibilityResponseInsuranceItemBenefit `json:"benefit,omitempty"`
@@ -136,16 +149,19 @@ type CoverageEligibilityResponseInsuranceItem struct {
 	Category *common.CodeableConcept `json:"category,omitempty"`
 
 	// For example 'DENT2 covers 100% of basic, 50% of major but excludes Ortho, Implants and Cosmetic services'
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// True if the indicated class of service is excluded from the plan
-	Excluded *bool `json:"excluded,omitempty"`
+	Excluded        *bool           `json:"excluded,omitempty"`
+	ExcludedElement *common.Element `json:"_excluded,omitempty"`
 
 	// For example in Oral whether the treatment is cosmetic or associated with TMJ
 	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
 
 	// For example: MED01, or DENT2
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Is a flag to indicate whether the benefits refer to in-network providers or out-of-network providers
 	Network *common.CodeableConcept `json:"network,omitempty"`
@@ -174,7 +190,8 @@ type CoverageEligibilityResponseInsurance struct {
 	Coverage common.Reference `json:"coverage"`
 
 	// Flag indicating if the coverage provided is inforce currently
-	Inforce *bool `json:"inforce,omitempty"`
+	Inforce        *bool           `json:"inforce,omitempty"`
+	InforceElement *common.Element `json:"_inforce,omitempty"`
 
 	// Benefits and optionally current balances, and authorization details by category or service
 	Item []CoverageEligibilityResponseInsuranceItem `json:"item,omitempty"`
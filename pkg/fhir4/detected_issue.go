@@ -18,13 +18,15 @@ type DetectedIssue struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Should focus on information not covered elsewhere as discrete data
-	Detail *string `json:"detail,omitempty"`
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
 
 	// Supporting evidence or manifestations that provide the basis for identifying the detected issue
 	Evidence []DetectedIssueEvidence `json:"evidence,omitempty"`
 
 	// The date or period when the detected issue was initially identified
-	IdentifiedDateTime *string `json:"identifiedDateTime,omitempty"`
+	IdentifiedDateTime        *common.DateTime `json:"identifiedDateTime,omitempty"`
+	IdentifiedDateTimeElement *common.Element  `json:"_identifiedDateTime,omitempty"`
 
 	// The date or period when the detected issue was initially identified
 	IdentifiedPeriod *common.Period `json:"identifiedPeriod,omitempty"`
@@ -42,13 +44,16 @@ type DetectedIssue struct {
 	Patient *common.Reference `json:"patient,omitempty"`
 
 	// The literature, knowledge-base or similar reference that describes the propensity for the detected issue identified
-	Reference *string `json:"reference,omitempty"`
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
 
 	// Indicates the degree of importance associated with the identified issue based on the potential impact on the patient
-	Severity *DetectedIssueSeverity `json:"severity,omitempty"`
+	Severity        *DetectedIssueSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element        `json:"_severity,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the codes cancelled and entered-in-error that mark the issue as not currently valid
-	Status DetectedIssueStatus `json:"status"`
+	Status        DetectedIssueStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 }
 
 // DetectedIssueSeverity represents the severity of the detected issue
@@ -96,5 +101,6 @@ type DetectedIssueMitigation struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// This might not be the same as when the mitigating step was actually taken
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 }
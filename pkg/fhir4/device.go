@@ -21,37 +21,46 @@ type Device struct {
 	UdiCarrier []DeviceUdiCarrier `json:"udiCarrier,omitempty"`
 
 	// active | inactive | entered-in-error | unknown
-	Status *DeviceStatus `json:"status,omitempty"`
+	Status        *DeviceStatus   `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Reason for the device's status
 	StatusReason []common.CodeableConcept `json:"statusReason,omitempty"`
 
 	// For example, this applies to devices in the United States regulated under Code of Federal Regulation 21CFR§1271.290(c)
-	DistinctIdentifier *string `json:"distinctIdentifier,omitempty"`
+	DistinctIdentifier        *string         `json:"distinctIdentifier,omitempty"`
+	DistinctIdentifierElement *common.Element `json:"_distinctIdentifier,omitempty"`
 
 	// Name of device manufacturer
-	Manufacturer *string `json:"manufacturer,omitempty"`
+	Manufacturer        *string         `json:"manufacturer,omitempty"`
+	ManufacturerElement *common.Element `json:"_manufacturer,omitempty"`
 
 	// Date when the device was made
-	ManufactureDate *string `json:"manufactureDate,omitempty"`
+	ManufactureDate        *common.DateTime `json:"manufactureDate,omitempty"`
+	ManufactureDateElement *common.Element  `json:"_manufactureDate,omitempty"`
 
 	// Date and time of expiry of this device (if applicable)
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// Lot number assigned by the manufacturer
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// The serial number assigned by the organization when the device was manufactured
-	SerialNumber *string `json:"serialNumber,omitempty"`
+	SerialNumber        *string         `json:"serialNumber,omitempty"`
+	SerialNumberElement *common.Element `json:"_serialNumber,omitempty"`
 
 	// The names of the device as given by the manufacturer
 	DeviceName []DeviceDeviceName `json:"deviceName,omitempty"`
 
 	// The model number for the device
-	ModelNumber *string `json:"modelNumber,omitempty"`
+	ModelNumber        *string         `json:"modelNumber,omitempty"`
+	ModelNumberElement *common.Element `json:"_modelNumber,omitempty"`
 
 	// The part number of the device
-	PartNumber *string `json:"partNumber,omitempty"`
+	PartNumber        *string         `json:"partNumber,omitempty"`
+	PartNumberElement *common.Element `json:"_partNumber,omitempty"`
 
 	// The kind or type of device
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -75,7 +84,8 @@ type Device struct {
 	Location *common.Reference `json:"location,omitempty"`
 
 	// Network address to contact device
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // DeviceStatus represents the status of the device
@@ -93,22 +103,28 @@ type DeviceUdiCarrier struct {
 	common.BackboneElement
 
 	// The AIDC form of UDIs should be scanned or otherwise used for the identification of the device
-	CarrierAIDC *string `json:"carrierAIDC,omitempty"`
+	CarrierAIDC        *string         `json:"carrierAIDC,omitempty"`
+	CarrierAIDCElement *common.Element `json:"_carrierAIDC,omitempty"`
 
 	// If separate barcodes for DI and PI are present, concatenate the string with DI first
-	CarrierHRF *string `json:"carrierHRF,omitempty"`
+	CarrierHRF        *string         `json:"carrierHRF,omitempty"`
+	CarrierHRFElement *common.Element `json:"_carrierHRF,omitempty"`
 
 	// The device identifier (DI) is a mandatory, fixed portion of a UDI
-	DeviceIdentifier *string `json:"deviceIdentifier,omitempty"`
+	DeviceIdentifier        *string         `json:"deviceIdentifier,omitempty"`
+	DeviceIdentifierElement *common.Element `json:"_deviceIdentifier,omitempty"`
 
 	// A coded entry to indicate how the data was entered
-	EntryType *DeviceUdiCarrierEntryType `json:"entryType,omitempty"`
+	EntryType        *DeviceUdiCarrierEntryType `json:"entryType,omitempty"`
+	EntryTypeElement *common.Element            `json:"_entryType,omitempty"`
 
 	// Organization that is charged with issuing UDIs for devices
-	Issuer *string `json:"issuer,omitempty"`
+	Issuer        *string         `json:"issuer,omitempty"`
+	IssuerElement *common.Element `json:"_issuer,omitempty"`
 
 	// The identity of the authoritative source for UDI generation within a jurisdiction
-	Jurisdiction *string `json:"jurisdiction,omitempty"`
+	Jurisdiction        *string         `json:"jurisdiction,omitempty"`
+	JurisdictionElement *common.Element `json:"_jurisdiction,omitempty"`
 }
 
 // DeviceUdiCarrierEntryType represents a coded entry to indicate how the data was entered
@@ -128,10 +144,12 @@ type DeviceDeviceName struct {
 	common.BackboneElement
 
 	// The name of the device
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of deviceName
-	Type DeviceDeviceNameType `json:"type"`
+	Type        DeviceDeviceNameType `json:"type"`
+	TypeElement *common.Element      `json:"_type,omitempty"`
 }
 
 // DeviceDeviceNameType represents the type of device name
@@ -154,7 +172,8 @@ type DeviceSpecialization struct {
 	SystemType common.CodeableConcept `json:"systemType"`
 
 	// The version of the standard that is used to operate and communicate
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // DeviceProperty represents the actual configuration settings of a device
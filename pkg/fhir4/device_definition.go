@@ -27,7 +27,8 @@ type DeviceDefinition struct {
 	LanguageCode []common.CodeableConcept `json:"languageCode,omitempty"`
 
 	// A name of the manufacturer
-	ManufacturerString *string `json:"manufacturerString,omitempty"`
+	ManufacturerString        *string         `json:"manufacturerString,omitempty"`
+	ManufacturerStringElement *common.Element `json:"_manufacturerString,omitempty"`
 
 	// A name of the manufacturer
 	ManufacturerReference *common.Reference `json:"manufacturerReference,omitempty"`
@@ -36,13 +37,15 @@ type DeviceDefinition struct {
 	Material []DeviceDefinitionMaterial `json:"material,omitempty"`
 
 	// The model number for the device
-	ModelNumber *string `json:"modelNumber,omitempty"`
+	ModelNumber        *string         `json:"modelNumber,omitempty"`
+	ModelNumberElement *common.Element `json:"_modelNumber,omitempty"`
 
 	// Descriptive information, usage information or implantation information that is not captured in an existing element
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// Access to on-line information about the device
-	OnlineInformation *string `json:"onlineInformation,omitempty"`
+	OnlineInformation        *string         `json:"onlineInformation,omitempty"`
+	OnlineInformationElement *common.Element `json:"_onlineInformation,omitempty"`
 
 	// An organization that is responsible for the provision and ongoing maintenance of the device
 	Owner *common.Reference `json:"owner,omitempty"`
@@ -75,10 +78,12 @@ type DeviceDefinition struct {
 	UdiDeviceIdentifier []DeviceDefinitionUdiDeviceIdentifier `json:"udiDeviceIdentifier,omitempty"`
 
 	// If the device is running a FHIR server, the network address should be the Base URL from which a conformance statement may be retrieved
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// The available versions of the device, e.g., software versions
-	Version []string `json:"version,omitempty"`
+	Version        []string          `json:"version,omitempty"`
+	VersionElement []*common.Element `json:"_version,omitempty"`
 }
 
 // DeviceDefinitionUdiDeviceIdentifier represents UDI device identifier
@@ -86,13 +91,16 @@ type DeviceDefinitionUdiDeviceIdentifier struct {
 	common.BackboneElement
 
 	// The identifier that is to be associated with every Device that references this DeviceDefinition
-	DeviceIdentifier string `json:"deviceIdentifier"`
+	DeviceIdentifier        string          `json:"deviceIdentifier"`
+	DeviceIdentifierElement *common.Element `json:"_deviceIdentifier,omitempty"`
 
 	// The organization that assigns the identifier algorithm
-	Issuer string `json:"issuer"`
+	Issuer        string          `json:"issuer"`
+	IssuerElement *common.Element `json:"_issuer,omitempty"`
 
 	// The jurisdiction to which the deviceIdentifier applies
-	Jurisdiction string `json:"jurisdiction"`
+	Jurisdiction        string          `json:"jurisdiction"`
+	JurisdictionElement *common.Element `json:"_jurisdiction,omitempty"`
 }
 
 // DeviceDefinitionDeviceName represents a name given to the device to identify it
@@ -100,10 +108,12 @@ type DeviceDefinitionDeviceName struct {
 	common.BackboneElement
 
 	// The name of the device
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of deviceName
-	Type DeviceDefinitionDeviceNameType `json:"type"`
+	Type        DeviceDefinitionDeviceNameType `json:"type"`
+	TypeElement *common.Element                `json:"_type,omitempty"`
 }
 
 // DeviceDefinitionDeviceNameType represents the type of device name
@@ -123,10 +133,12 @@ type DeviceDefinitionSpecialization struct {
 	common.BackboneElement
 
 	// The standard that is used to operate and communicate
-	SystemType string `json:"systemType"`
+	SystemType        string          `json:"systemType"`
+	SystemTypeElement *common.Element `json:"_systemType,omitempty"`
 
 	// The version of the standard that is used to operate and communicate
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // DeviceDefinitionCapability represents device capabilities
@@ -159,10 +171,12 @@ type DeviceDefinitionMaterial struct {
 	common.BackboneElement
 
 	// Whether the substance is a known or suspected allergen
-	AllergenicIndicator *bool `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicator        *bool           `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *common.Element `json:"_allergenicIndicator,omitempty"`
 
 	// Indicates an alternative material of the device
-	Alternate *bool `json:"alternate,omitempty"`
+	Alternate        *bool           `json:"alternate,omitempty"`
+	AlternateElement *common.Element `json:"_alternate,omitempty"`
 
 	// The substance
 	Substance common.CodeableConcept `json:"substance"`
@@ -182,10 +196,12 @@ type ProdCharacteristic struct {
 	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
 
 	// The value of the characteristic
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value of the characteristic
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value of the characteristic
 	ValueAttachment *common.Attachment `json:"valueAttachment,omitempty"`
@@ -15,10 +15,12 @@ type DeviceMetric struct {
 	Calibration []DeviceMetricCalibration `json:"calibration,omitempty"`
 
 	// Indicates the category of the observation generation process
-	Category DeviceMetricCategory `json:"category"`
+	Category        DeviceMetricCategory `json:"category"`
+	CategoryElement *common.Element      `json:"_category,omitempty"`
 
 	// Describes the color representation for the metric
-	Color *DeviceMetricColor `json:"color,omitempty"`
+	Color        *DeviceMetricColor `json:"color,omitempty"`
+	ColorElement *common.Element    `json:"_color,omitempty"`
 
 	// For identifiers assigned to a device by the device or gateway software
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -27,7 +29,8 @@ type DeviceMetric struct {
 	MeasurementPeriod *common.Timing `json:"measurementPeriod,omitempty"`
 
 	// Indicates current operational state of the device
-	OperationalStatus *DeviceMetricOperationalStatus `json:"operationalStatus,omitempty"`
+	OperationalStatus        *DeviceMetricOperationalStatus `json:"operationalStatus,omitempty"`
+	OperationalStatusElement *common.Element                `json:"_operationalStatus,omitempty"`
 
 	// Describes the link to the Device that this DeviceMetric belongs to
 	Parent *common.Reference `json:"parent,omitempty"`
@@ -81,13 +84,16 @@ type DeviceMetricCalibration struct {
 	common.BackboneElement
 
 	// Describes the state of the calibration
-	State *DeviceMetricCalibrationState `json:"state,omitempty"`
+	State        *DeviceMetricCalibrationState `json:"state,omitempty"`
+	StateElement *common.Element               `json:"_state,omitempty"`
 
 	// Describes the time last calibration has been performed
-	Time *string `json:"time,omitempty"`
+	Time        *common.Instant `json:"time,omitempty"`
+	TimeElement *common.Element `json:"_time,omitempty"`
 
 	// Describes the type of the calibration method
-	Type *DeviceMetricCalibrationType `json:"type,omitempty"`
+	Type        *DeviceMetricCalibrationType `json:"type,omitempty"`
+	TypeElement *common.Element              `json:"_type,omitempty"`
 }
 
 // DeviceMetricCalibrationState represents the state of the calibration
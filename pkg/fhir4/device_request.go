@@ -12,7 +12,8 @@ type DeviceRequest struct {
 	ResourceType string `json:"resourceType"` // Always "DeviceRequest"
 
 	// When the request transitioned to being actionable
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Plan/proposal/order fulfilled by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -33,22 +34,26 @@ type DeviceRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Note: This is a business identifier, not a resource identifier
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations that may be required for delivering the requested service
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// Whether the request is a proposal, plan, an original order or a reflex order
-	Intent DeviceRequestIntent `json:"intent"`
+	Intent        DeviceRequestIntent `json:"intent"`
+	IntentElement *common.Element     `json:"_intent,omitempty"`
 
 	// Details about this request that were not represented at all or sufficiently in one of the attributes provided in a class
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// The timing schedule for the use of the device
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// The timing schedule for the use of the device
 	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
@@ -66,7 +71,8 @@ type DeviceRequest struct {
 	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
 
 	// Indicates how quickly the request should be addressed with respect to other requests
-	Priority *DeviceRequestPriority `json:"priority,omitempty"`
+	Priority        *DeviceRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
 
 	// The request takes the place of the referenced completed or terminated request(s)
 	PriorRequest []common.Reference `json:"priorRequest,omitempty"`
@@ -84,7 +90,8 @@ type DeviceRequest struct {
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the codes cancelled and entered-in-error that mark the request as not currently valid
-	Status *DeviceRequestStatus `json:"status,omitempty"`
+	Status        *DeviceRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// The patient who will use the device
 	Subject common.Reference `json:"subject"`
@@ -148,5 +155,6 @@ type DeviceRequestParameter struct {
 	ValueRange *common.Range `json:"valueRange,omitempty"`
 
 	// Range means device should have a value that falls somewhere within the specified range
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 }
@@ -36,13 +36,15 @@ type DeviceUseStatement struct {
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
 	// The time at which the statement was made/recorded
-	RecordedOn *string `json:"recordedOn,omitempty"`
+	RecordedOn        *common.DateTime `json:"recordedOn,omitempty"`
+	RecordedOnElement *common.Element  `json:"_recordedOn,omitempty"`
 
 	// Who reported the device was being used by the patient
 	Source *common.Reference `json:"source,omitempty"`
 
 	// DeviceUseStatement is a statement at a point in time
-	Status DeviceUseStatementStatus `json:"status"`
+	Status        DeviceUseStatementStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 
 	// The patient who used the device
 	Subject common.Reference `json:"subject"`
@@ -54,7 +56,8 @@ type DeviceUseStatement struct {
 	TimingPeriod *common.Period `json:"timingPeriod,omitempty"`
 
 	// How often the device was used
-	TimingDateTime *string `json:"timingDateTime,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
 }
 
 // DeviceUseStatementStatus represents the status of the device use statement
@@ -18,7 +18,8 @@ type DiagnosticReport struct {
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
 
 	// registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown
-	Status DiagnosticReportStatus `json:"status"`
+	Status        DiagnosticReportStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// Service category
 	Category []common.CodeableConcept `json:"category,omitempty"`
@@ -33,11 +34,13 @@ type DiagnosticReport struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Clinically relevant time/time-period for report
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
 
 	// DateTime this version was made
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// Responsible Diagnostic Service
 	Performer []common.Reference `json:"performer,omitempty"`
@@ -55,7 +58,8 @@ type DiagnosticReport struct {
 	Media []DiagnosticReportMedia `json:"media,omitempty"`
 
 	// Clinical conclusion (interpretation) of test results
-	Conclusion *string `json:"conclusion,omitempty"`
+	Conclusion        *string         `json:"conclusion,omitempty"`
+	ConclusionElement *common.Element `json:"_conclusion,omitempty"`
 
 	// Codes for the clinical conclusion of test results
 	ConclusionCode []common.CodeableConcept `json:"conclusionCode,omitempty"`
@@ -85,7 +89,8 @@ type DiagnosticReportMedia struct {
 	common.BackboneElement
 
 	// Comment about the image (e.g. explanation)
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Reference to the image source
 	Link common.Reference `json:"link"`
@@ -18,10 +18,12 @@ type DocumentManifest struct {
 	Content []common.Reference `json:"content"`
 
 	// Creation time is used for tracking, organizing versions and searching
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// What the document is about, rather than a terse summary of the document
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Other identifiers associated with the document manifest, including version independent identifiers
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -36,10 +38,12 @@ type DocumentManifest struct {
 	Related []DocumentManifestRelated `json:"related,omitempty"`
 
 	// Identifies the source system, application, or software that produced the document manifest
-	Source *string `json:"source,omitempty"`
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the codes that mark the manifest as not currently valid
-	Status DocumentManifestStatus `json:"status"`
+	Status        DocumentManifestStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// Who or what the set of documents is about
 	Subject *common.Reference `json:"subject,omitempty"`
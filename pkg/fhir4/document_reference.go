@@ -19,10 +19,12 @@ type DocumentReference struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// current | superseded | entered-in-error
-	Status DocumentReferenceStatus `json:"status"`
+	Status        DocumentReferenceStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// preliminary | final | amended | entered-in-error
-	DocStatus *DocumentReferenceDocStatus `json:"docStatus,omitempty"`
+	DocStatus        *DocumentReferenceDocStatus `json:"docStatus,omitempty"`
+	DocStatusElement *common.Element             `json:"_docStatus,omitempty"`
 
 	// Kind of document (LOINC if possible)
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -34,7 +36,8 @@ type DocumentReference struct {
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// When this document reference was created
-	Date *string `json:"date,omitempty"`
+	Date        *common.Instant `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Who and/or what authored the document
 	Author []common.Reference `json:"author,omitempty"`
@@ -49,7 +52,8 @@ type DocumentReference struct {
 	RelatesTo []DocumentReferenceRelatesTo `json:"relatesTo,omitempty"`
 
 	// Human-readable description
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Document security-tags
 	SecurityLabel []common.CodeableConcept `json:"securityLabel,omitempty"`
@@ -85,7 +89,8 @@ type DocumentReferenceRelatesTo struct {
 	common.BackboneElement
 
 	// replaces | transforms | signs | appends
-	Code DocumentReferenceRelatesToCode `json:"code"`
+	Code        DocumentReferenceRelatesToCode `json:"code"`
+	CodeElement *common.Element                `json:"_code,omitempty"`
 
 	// Target of the relationship
 	Target common.Reference `json:"target"`
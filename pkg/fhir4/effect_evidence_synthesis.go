@@ -12,7 +12,8 @@ type EffectEvidenceSynthesis struct {
 	ResourceType string `json:"resourceType"` // Always "EffectEvidenceSynthesis"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.DateTime `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element  `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -24,13 +25,16 @@ type EffectEvidenceSynthesis struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the effect evidence synthesis and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the effect evidence synthesis was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -57,10 +61,12 @@ type EffectEvidenceSynthesis struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.DateTime `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element  `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A human-readable string to clarify or explain concepts about the resource
 	Note []common.Annotation `json:"note,omitempty"`
@@ -72,7 +78,8 @@ type EffectEvidenceSynthesis struct {
 	Population common.Reference `json:"population"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -87,7 +94,8 @@ type EffectEvidenceSynthesis struct {
 	SampleSize *EffectEvidenceSynthesisSampleSize `json:"sampleSize,omitempty"`
 
 	// Allows filtering of effect evidence synthesiss that are appropriate for use versus not
-	Status EffectEvidenceSynthesisStatus `json:"status"`
+	Status        EffectEvidenceSynthesisStatus `json:"status"`
+	StatusElement *common.Element               `json:"_status,omitempty"`
 
 	// Type of study eg randomized trial
 	StudyType *common.CodeableConcept `json:"studyType,omitempty"`
@@ -96,19 +104,22 @@ type EffectEvidenceSynthesis struct {
 	SynthesisType *common.CodeableConcept `json:"synthesisType,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the EffectEvidenceSynthesis
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different effect evidence synthesis instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // EffectEvidenceSynthesisStatus represents the status of the effect evidence synthesis
@@ -126,16 +137,20 @@ type EffectEvidenceSynthesisSampleSize struct {
 	common.BackboneElement
 
 	// Human-readable summary of population estimate
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Number of participants in the group
-	NumberOfParticipants *int `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipants        *int            `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *common.Element `json:"_numberOfParticipants,omitempty"`
 
 	// Number of studies included in this evidence synthesis
-	NumberOfStudies *int `json:"numberOfStudies,omitempty"`
+	NumberOfStudies        *int            `json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement *common.Element `json:"_numberOfStudies,omitempty"`
 
 	// Known or expected number of participants known to have the exposure
-	KnownDataCount *int `json:"knownDataCount,omitempty"`
+	KnownDataCount        *int            `json:"knownDataCount,omitempty"`
+	KnownDataCountElement *common.Element `json:"_knownDataCount,omitempty"`
 }
 
 // EffectEvidenceSynthesisResultsByExposure represents a description of the results for each exposure considered in the effect estimate
@@ -143,10 +158,12 @@ type EffectEvidenceSynthesisResultsByExposure struct {
 	common.BackboneElement
 
 	// Human-readable summary of results by exposure
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Whether these results are for the exposure state or alternative exposure state
-	ExposureState *EffectEvidenceSynthesisResultsByExposureExposureState `json:"exposureState,omitempty"`
+	ExposureState        *EffectEvidenceSynthesisResultsByExposureExposureState `json:"exposureState,omitempty"`
+	ExposureStateElement *common.Element                                        `json:"_exposureState,omitempty"`
 
 	// Variant exposure states for the population
 	VariantState *common.CodeableConcept `json:"variantState,omitempty"`
@@ -168,7 +185,8 @@ type EffectEvidenceSynthesisEffectEstimate struct {
 	common.BackboneElement
 
 	// Human-readable summary of effect estimate
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Type of efffect estimate
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -177,7 +195,8 @@ type EffectEvidenceSynthesisEffectEstimate struct {
 	VariantState *common.CodeableConcept `json:"variantState,omitempty"`
 
 	// Point estimate
-	Value *float64 `json:"value,omitempty"`
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 
 	// What unit is the outcome described in
 	UnitOfMeasure *common.CodeableConcept `json:"unitOfMeasure,omitempty"`
@@ -194,13 +213,16 @@ type EffectEvidenceSynthesisEffectEstimatePrecisionEstimate struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// Level of confidence interval
-	Level *float64 `json:"level,omitempty"`
+	Level        *common.Decimal `json:"level,omitempty"`
+	LevelElement *common.Element `json:"_level,omitempty"`
 
 	// Lower bound
-	From *float64 `json:"from,omitempty"`
+	From        *common.Decimal `json:"from,omitempty"`
+	FromElement *common.Element `json:"_from,omitempty"`
 
 	// Upper bound
-	To *float64 `json:"to,omitempty"`
+	To        *common.Decimal `json:"to,omitempty"`
+	ToElement *common.Element `json:"_to,omitempty"`
 }
 
 // EffectEvidenceSynthesisCertainty represents a description of the certainty of the effect estimate
@@ -0,0 +1,424 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir4
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// elementOrder lists the properties of each struct in the element order of FHIR XML
+var elementOrder = common.ElementOrder{
+	reflect.TypeOf(Account{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "name", "subject", "servicePeriod", "coverage", "owner", "description", "guarantor", "partOf"},
+	reflect.TypeOf(ActivityDefinition{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "kind", "profile", "code", "intent", "priority", "doNotPerform", "timingTiming", "timingDateTime", "timingAge", "timingPeriod", "timingRange", "timingDuration", "location", "participant", "productReference", "productCodeableConcept", "quantity", "dosage", "bodySite", "specimenRequirement", "observationRequirement", "observationResultRequirement", "transform", "dynamicValue"},
+	reflect.TypeOf(ActivityDefinitionDynamicValue{}):                                                   {"id", "extension", "modifierExtension", "path", "expression"},
+	reflect.TypeOf(ActivityDefinitionParticipant{}):                                                    {"id", "extension", "modifierExtension", "type", "role"},
+	reflect.TypeOf(Address{}):                                                                          {"id", "extension", "use", "type", "text", "line", "city", "district", "state", "postalCode", "country", "period"},
+	reflect.TypeOf(AdverseEvent{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "actuality", "category", "event", "subject", "encounter", "date", "detected", "recordedDate", "resultingCondition", "location", "seriousness", "severity", "outcome", "recorder", "contributor", "suspectEntity", "subjectMedicalHistory", "referenceDocument", "study"},
+	reflect.TypeOf(AdverseEventSuspectEntity{}):                                                        {"id", "extension", "modifierExtension", "instance", "causality"},
+	reflect.TypeOf(AdverseEventSuspectEntityCausality{}):                                               {"id", "extension", "modifierExtension", "assessment", "productRelatedness", "author", "method"},
+	reflect.TypeOf(AllergyIntolerance{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "clinicalStatus", "verificationStatus", "type", "category", "criticality", "code", "patient", "encounter", "onsetDateTime", "onsetAge", "onsetPeriod", "onsetRange", "onsetString", "recordedDate", "recorder", "asserter", "lastOccurrence", "note", "reaction"},
+	reflect.TypeOf(Annotation{}):                                                                       {"id", "extension", "authorReference", "authorString", "time", "text"},
+	reflect.TypeOf(Appointment{}):                                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "serviceCategory", "serviceType", "specialty", "appointmentType", "reasonCode", "reasonReference", "priority", "description", "supportingInformation", "start", "end", "minutesDuration", "slot", "created", "comment", "patientInstruction", "basedOn", "participant", "requestedPeriod"},
+	reflect.TypeOf(AppointmentResponse{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "appointment", "start", "end", "participantType", "actor", "participantStatus", "comment"},
+	reflect.TypeOf(Attachment{}):                                                                       {"id", "extension", "contentType", "language", "data", "url", "size", "hash", "title", "creation"},
+	reflect.TypeOf(AuditEvent{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "type", "subtype", "action", "period", "recorded", "outcome", "outcomeDesc", "purposeOfEvent", "agent", "source", "entity"},
+	reflect.TypeOf(Basic{}):                                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "subject", "created", "author"},
+	reflect.TypeOf(BiologicallyDerivedProduct{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "productCategory", "productCode", "status", "request", "quantity", "parent", "collection", "processing", "manipulation", "storage"},
+	reflect.TypeOf(BodyStructure{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "morphology", "location", "locationQualifier", "description", "image", "patient"},
+	reflect.TypeOf(Bundle{}):                                                                           {"id", "meta", "implicitRules", "language", "identifier", "type", "timestamp", "total", "link", "entry", "signature"},
+	reflect.TypeOf(BundleEntry{}):                                                                      {"id", "extension", "modifierExtension", "link", "fullUrl", "resource", "search", "request", "response"},
+	reflect.TypeOf(CapabilityStatement{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "kind", "instantiates", "imports", "software", "implementation", "fhirVersion", "format", "patchFormat", "implementationGuide", "rest", "messaging", "document"},
+	reflect.TypeOf(CareTeam{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "name", "subject", "encounter", "period", "participant", "reasonCode", "reasonReference", "managingOrganization", "telecom", "note"},
+	reflect.TypeOf(CatalogEntry{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "orderable", "referencedItem", "additionalIdentifier", "classification", "status", "validFrom", "validTo", "lastUpdated", "additionalCharacteristic", "additionalClassification", "relatedEntry"},
+	reflect.TypeOf(ChargeItem{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definitionUri", "definitionCanonical", "status", "partOf", "code", "subject", "context", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "performer", "performingOrganization", "requestingOrganization", "costCenter", "quantity", "bodysite", "factorOverride", "priceOverride", "overrideReason", "enterer", "enteredDate", "reason", "service", "productReference", "productCodeableConcept", "account", "note", "supportingInformation"},
+	reflect.TypeOf(ChargeItemDefinition{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "title", "derivedFromUri", "partOf", "replaces", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "code", "instance", "applicability", "propertyGroup"},
+	reflect.TypeOf(ChargeItemDefinitionApplicability{}):                                                {"id", "extension", "modifierExtension", "description", "language", "expression"},
+	reflect.TypeOf(ChargeItemDefinitionPropertyGroupPriceComponent{}):                                  {"id", "extension", "modifierExtension", "type", "code", "factor", "amount"},
+	reflect.TypeOf(Claim{}):                                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subType", "use", "patient", "billablePeriod", "created", "enterer", "insurer", "provider", "priority", "fundsReserve", "related", "prescription", "originalPrescription", "payee", "referral", "facility", "careTeam", "insurance", "accident", "item", "total"},
+	reflect.TypeOf(ClaimResponse{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "use", "patient", "created", "insurer", "requestor", "request", "outcome", "disposition", "preAuthRef", "preAuthPeriod", "payeeType", "item", "addItem", "adjudication", "total", "payment", "fundsReserve", "formCode", "form", "processNote", "communicationRequest", "insurance", "error"},
+	reflect.TypeOf(ClaimResponseAddItem{}):                                                             {"id", "extension", "modifierExtension", "itemSequence", "detailSequence", "subdetailSequence", "provider", "productOrService", "modifier", "programCode", "servicedDate", "servicedPeriod", "locationCodeableConcept", "locationAddress", "locationReference", "quantity", "unitPrice", "factor", "net", "bodySite", "subSite", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ClaimResponseAddItemDetail{}):                                                       {"id", "extension", "modifierExtension", "productOrService", "modifier", "quantity", "unitPrice", "factor", "net", "noteNumber", "adjudication", "subDetail"},
+	reflect.TypeOf(ClaimResponseAddItemDetailSubDetail{}):                                              {"id", "extension", "modifierExtension", "productOrService", "modifier", "quantity", "unitPrice", "factor", "net", "noteNumber", "adjudication"},
+	reflect.TypeOf(ClaimResponseError{}):                                                               {"id", "extension", "modifierExtension", "itemSequence", "detailSequence", "subDetailSequence", "code"},
+	reflect.TypeOf(ClaimResponseInsurance{}):                                                           {"id", "extension", "modifierExtension", "sequence", "focal", "coverage", "businessArrangement", "claimResponse"},
+	reflect.TypeOf(ClaimResponseItem{}):                                                                {"id", "extension", "modifierExtension", "itemSequence", "noteNumber", "adjudication", "detail"},
+	reflect.TypeOf(ClaimResponseItemAdjudication{}):                                                    {"id", "extension", "modifierExtension", "category", "reason", "amount", "value"},
+	reflect.TypeOf(ClaimResponseItemDetail{}):                                                          {"id", "extension", "modifierExtension", "detailSequence", "noteNumber", "adjudication", "subDetail"},
+	reflect.TypeOf(ClaimResponseItemDetailSubDetail{}):                                                 {"id", "extension", "modifierExtension", "subDetailSequence", "noteNumber", "adjudication"},
+	reflect.TypeOf(ClaimResponsePayment{}):                                                             {"id", "extension", "modifierExtension", "type", "adjustment", "adjustmentReason", "date", "amount", "identifier"},
+	reflect.TypeOf(ClaimResponseProcessNote{}):                                                         {"id", "extension", "modifierExtension", "number", "type", "text", "language"},
+	reflect.TypeOf(ClaimResponseTotal{}):                                                               {"id", "extension", "modifierExtension", "category", "amount"},
+	reflect.TypeOf(ClinicalImpression{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusReason", "code", "description", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "date", "assessor", "previous", "problem", "investigation", "protocol", "finding", "prognosisCodeableConcept", "prognosisReference", "supportingInfo", "note"},
+	reflect.TypeOf(CodeSystem{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "caseSensitive", "valueSet", "hierarchyMeaning", "compositional", "versionNeeded", "content", "supplements", "count", "filter", "property", "concept"},
+	reflect.TypeOf(Communication{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "partOf", "inResponseTo", "status", "statusReason", "category", "priority", "medium", "subject", "topic", "about", "encounter", "sent", "received", "recipient", "sender", "reasonCode", "reasonReference", "payload", "note"},
+	reflect.TypeOf(CommunicationRequest{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "replaces", "groupIdentifier", "status", "statusReason", "category", "priority", "doNotPerform", "medium", "subject", "about", "encounter", "payload", "occurrenceDateTime", "occurrencePeriod", "authoredOn", "requester", "recipient", "sender", "reasonCode", "reasonReference", "note"},
+	reflect.TypeOf(CompartmentDefinition{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "purpose", "code", "search", "resource"},
+	reflect.TypeOf(Composition{}):                                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "category", "subject", "encounter", "date", "author", "title", "attester", "custodian", "relatesTo", "event", "section"},
+	reflect.TypeOf(ConceptMap{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "sourceUri", "sourceCanonical", "targetUri", "targetCanonical", "group"},
+	reflect.TypeOf(Condition{}):                                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "clinicalStatus", "verificationStatus", "category", "severity", "code", "bodySite", "subject", "encounter", "onsetDateTime", "onsetAge", "onsetPeriod", "onsetRange", "onsetString", "abatementDateTime", "abatementAge", "abatementPeriod", "abatementRange", "abatementString", "abatementBoolean", "recordedDate", "recorder", "stage", "evidence", "note"},
+	reflect.TypeOf(Consent{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "scope", "category", "patient", "dateTime", "performer", "organization", "sourceAttachment", "sourceReference", "policy", "policyRule", "verification", "provision"},
+	reflect.TypeOf(ContactPoint{}):                                                                     {"id", "extension", "system", "value", "use", "rank", "period"},
+	reflect.TypeOf(Contract{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "legalState", "instantiatesCanonical", "instantiatesUri", "contentDerivative", "issued", "applies", "expirationType", "subject", "authority", "domain", "site", "name", "title", "subtitle", "alias", "author", "scope", "topicCodeableConcept", "topicReference", "type", "subType", "contentDefinition", "term", "supportingInfo", "relevantHistory", "signer", "friendly", "legal", "rule", "legallyBindingAttachment", "legallyBindingReference", "valuedItem"},
+	reflect.TypeOf(ContractContentDefinition{}):                                                        {"id", "extension", "modifierExtension", "type", "subType", "publisher", "publicationDate", "publicationStatus", "copyright"},
+	reflect.TypeOf(ContractSigner{}):                                                                   {"id", "extension", "modifierExtension", "type", "party", "signature"},
+	reflect.TypeOf(ContractTerm{}):                                                                     {"id", "extension", "modifierExtension", "identifier", "issued", "applies", "topicCodeableConcept", "topicReference", "type", "subType", "text", "securityLabel", "offer", "asset", "action", "group"},
+	reflect.TypeOf(ContractTermAction{}):                                                               {"id", "extension", "modifierExtension", "doNotPerform", "type", "subject", "intent", "linkId", "status", "context", "contextLinkId", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "requester", "requesterLinkId", "performerType", "performerRole", "performer", "performerLinkId", "reasonCode", "reasonReference", "reason", "reasonLinkId", "note", "securityLabelNumber"},
+	reflect.TypeOf(ContractTermAsset{}):                                                                {"id", "extension", "modifierExtension", "scope", "type", "typeReference", "subtype", "relationship", "context", "condition", "periodType", "period", "usePeriod", "text", "linkId", "answer", "securityLabelNumber", "valuedItem"},
+	reflect.TypeOf(ContractTermAssetContext{}):                                                         {"id", "extension", "modifierExtension", "reference", "code", "text"},
+	reflect.TypeOf(ContractTermAssetValuedItem{}):                                                      {"id", "extension", "modifierExtension", "entityCodeableConcept", "entityReference", "identifier", "effectiveTime", "quantity", "unitPrice", "factor", "points", "net", "payment", "paymentDate", "responsible", "recipient", "linkId", "securityLabelNumber"},
+	reflect.TypeOf(ContractTermOffer{}):                                                                {"id", "extension", "modifierExtension", "identifier", "party", "topic", "type", "decision", "decisionMode", "answer", "text", "linkId", "securityLabelNumber"},
+	reflect.TypeOf(ContractTermSecurityLabel{}):                                                        {"id", "extension", "modifierExtension", "number", "classification", "category", "control"},
+	reflect.TypeOf(Contributor{}):                                                                      {"id", "extension", "type", "name", "contact"},
+	reflect.TypeOf(Coverage{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "policyHolder", "subscriber", "subscriberId", "beneficiary", "dependent", "relationship", "period", "payor", "class", "order", "network", "costToBeneficiary", "subrogation", "contract"},
+	reflect.TypeOf(CoverageClass{}):                                                                    {"id", "extension", "modifierExtension", "type", "value", "name"},
+	reflect.TypeOf(CoverageCostToBeneficiary{}):                                                        {"id", "extension", "modifierExtension", "type", "valueQuantity", "valueMoney", "exception"},
+	reflect.TypeOf(CoverageCostToBeneficiaryException{}):                                               {"id", "extension", "modifierExtension", "type", "period"},
+	reflect.TypeOf(CoverageEligibilityRequest{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "priority", "purpose", "patient", "servicedDate", "servicedPeriod", "created", "enterer", "provider", "insurer", "facility", "supportingInfo", "insurance", "item"},
+	reflect.TypeOf(CoverageEligibilityRequestInsurance{}):                                              {"id", "extension", "modifierExtension", "focal", "coverage", "businessArrangement"},
+	reflect.TypeOf(CoverageEligibilityRequestItem{}):                                                   {"id", "extension", "modifierExtension", "supportingInfoSequence", "category", "productOrService", "modifier", "provider", "quantity", "unitPrice", "facility", "diagnosis", "detail"},
+	reflect.TypeOf(CoverageEligibilityRequestSupportingInfo{}):                                         {"id", "extension", "modifierExtension", "sequence", "information", "appliesToAll"},
+	reflect.TypeOf(CoverageEligibilityResponse{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "purpose", "patient", "servicedDate", "servicedPeriod", "created", "requestor", "request", "outcome", "disposition", "insurer", "insurance", "preAuthRef", "form", "error"},
+	reflect.TypeOf(CoverageEligibilityResponseInsurance{}):                                             {"id", "extension", "modifierExtension", "coverage", "inforce", "benefitPeriod", "item"},
+	reflect.TypeOf(CoverageEligibilityResponseInsuranceItem{}):                                         {"id", "extension", "modifierExtension", "category", "productOrService", "modifier", "provider", "excluded", "name", "description", "network", "unit", "term", "benefit", "authorizationRequired", "authorizationSupporting", "authorizationUrl"},
+	reflect.TypeOf(CoverageEligibilityResponseInsuranceItemBenefit{}):                                  {"id", "extension", "modifierExtension", "type", "allowedUnsignedInt", "allowedString", "allowedMoney", "usedUnsignedInt", "usedString", "usedMoney"},
+	reflect.TypeOf(DetectedIssue{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "code", "severity", "patient", "identifiedDateTime", "identifiedPeriod", "author", "implicated", "evidence", "detail", "reference", "mitigation"},
+	reflect.TypeOf(DetectedIssueMitigation{}):                                                          {"id", "extension", "modifierExtension", "action", "date", "author"},
+	reflect.TypeOf(Device{}):                                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definition", "udiCarrier", "status", "statusReason", "distinctIdentifier", "manufacturer", "manufactureDate", "expirationDate", "lotNumber", "serialNumber", "deviceName", "modelNumber", "partNumber", "type", "specialization", "property", "patient", "owner", "contact", "location", "url"},
+	reflect.TypeOf(DeviceDefinition{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "udiDeviceIdentifier", "manufacturerString", "manufacturerReference", "deviceName", "modelNumber", "type", "specialization", "version", "safety", "shelfLifeStorage", "physicalCharacteristics", "languageCode", "capability", "property", "owner", "contact", "url", "onlineInformation", "note", "quantity", "parentDevice", "material"},
+	reflect.TypeOf(DeviceDefinitionCapability{}):                                                       {"id", "extension", "modifierExtension", "type", "description"},
+	reflect.TypeOf(DeviceDefinitionMaterial{}):                                                         {"id", "extension", "modifierExtension", "substance", "alternate", "allergenicIndicator"},
+	reflect.TypeOf(DeviceDefinitionProperty{}):                                                         {"id", "extension", "modifierExtension", "type", "valueQuantity", "valueCode"},
+	reflect.TypeOf(DeviceMetric{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "unit", "source", "parent", "operationalStatus", "color", "category", "measurementPeriod", "calibration"},
+	reflect.TypeOf(DeviceMetricCalibration{}):                                                          {"id", "extension", "modifierExtension", "type", "state", "time"},
+	reflect.TypeOf(DeviceProperty{}):                                                                   {"id", "extension", "modifierExtension", "type", "valueQuantity", "valueCode"},
+	reflect.TypeOf(DeviceRequest{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "priorRequest", "groupIdentifier", "status", "intent", "priority", "codeReference", "codeCodeableConcept", "parameter", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "authoredOn", "requester", "performerType", "performer", "reasonCode", "reasonReference", "insurance", "supportingInfo", "note", "relevantHistory"},
+	reflect.TypeOf(DeviceUdiCarrier{}):                                                                 {"id", "extension", "modifierExtension", "deviceIdentifier", "issuer", "jurisdiction", "carrierAIDC", "carrierHRF", "entryType"},
+	reflect.TypeOf(DeviceUseStatement{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "subject", "derivedFrom", "timingTiming", "timingPeriod", "timingDateTime", "recordedOn", "source", "device", "reasonCode", "reasonReference", "bodySite", "note"},
+	reflect.TypeOf(DiagnosticReport{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "category", "code", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "issued", "performer", "resultsInterpreter", "specimen", "result", "media", "conclusion", "conclusionCode", "presentedForm"},
+	reflect.TypeOf(DocumentManifest{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "masterIdentifier", "identifier", "status", "type", "subject", "created", "author", "recipient", "source", "description", "content", "related"},
+	reflect.TypeOf(DocumentReference{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "masterIdentifier", "identifier", "status", "docStatus", "type", "category", "subject", "date", "author", "authenticator", "custodian", "relatesTo", "description", "securityLabel", "content", "context"},
+	reflect.TypeOf(DomainResource{}):                                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension"},
+	reflect.TypeOf(Dosage{}):                                                                           {"id", "extension", "modifierExtension", "sequence", "text", "additionalInstruction", "patientInstruction", "timing", "asNeededBoolean", "asNeededCodeableConcept", "site", "route", "method", "doseAndRate", "maxDosePerPeriod", "maxDosePerAdministration", "maxDosePerLifetime"},
+	reflect.TypeOf(EffectEvidenceSynthesis{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "date", "publisher", "contact", "description", "note", "useContext", "jurisdiction", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "synthesisType", "studyType", "population", "exposure", "exposureAlternative", "outcome", "sampleSize", "resultsByExposure", "effectEstimate", "certainty"},
+	reflect.TypeOf(EffectEvidenceSynthesisSampleSize{}):                                                {"id", "extension", "modifierExtension", "description", "numberOfStudies", "numberOfParticipants", "knownDataCount"},
+	reflect.TypeOf(ElementDefinition{}):                                                                {"id", "extension", "modifierExtension", "path", "representation", "sliceName", "sliceIsConstraining", "label", "code", "slicing", "short", "definition", "comment", "requirements", "alias", "min", "max", "base", "contentReference", "type", "defaultValueBase64Binary", "defaultValueBoolean", "defaultValueCanonical", "defaultValueCode", "defaultValueDate", "defaultValueDateTime", "defaultValueDecimal", "defaultValueId", "defaultValueInstant", "defaultValueInteger", "defaultValueMarkdown", "defaultValueOid", "defaultValuePositiveInt", "defaultValueString", "defaultValueTime", "defaultValueUnsignedInt", "defaultValueUri", "defaultValueUrl", "defaultValueUuid", "defaultValueAddress", "defaultValueAge", "defaultValueAnnotation", "defaultValueAttachment", "defaultValueCodeableConcept", "defaultValueCoding", "defaultValueContactPoint", "defaultValueCount", "defaultValueDistance", "defaultValueDuration", "defaultValueHumanName", "defaultValueIdentifier", "defaultValueMoney", "defaultValuePeriod", "defaultValueQuantity", "defaultValueRange", "defaultValueRatio", "defaultValueReference", "defaultValueSampledData", "defaultValueSignature", "defaultValueTiming", "defaultValueContactDetail", "defaultValueContributor", "defaultValueDataRequirement", "defaultValueExpression", "defaultValueParameterDefinition", "defaultValueRelatedArtifact", "defaultValueTriggerDefinition", "defaultValueUsageContext", "defaultValueDosage", "defaultValueMeta", "meaningWhenMissing", "orderMeaning", "fixedBase64Binary", "fixedBoolean", "fixedCanonical", "fixedCode", "fixedDate", "fixedDateTime", "fixedDecimal", "fixedId", "fixedInstant", "fixedInteger", "fixedMarkdown", "fixedOid", "fixedPositiveInt", "fixedString", "fixedTime", "fixedUnsignedInt", "fixedUri", "fixedUrl", "fixedUuid", "fixedAddress", "fixedAge", "fixedAnnotation", "fixedAttachment", "fixedCodeableConcept", "fixedCoding", "fixedContactPoint", "fixedCount", "fixedDistance", "fixedDuration", "fixedHumanName", "fixedIdentifier", "fixedMoney", "fixedPeriod", "fixedQuantity", "fixedRange", "fixedRatio", "fixedReference", "fixedSampledData", "fixedSignature", "fixedTiming", "fixedContactDetail", "fixedContributor", "fixedDataRequirement", "fixedExpression", "fixedParameterDefinition", "fixedRelatedArtifact", "fixedTriggerDefinition", "fixedUsageContext", "fixedDosage", "fixedMeta", "patternBase64Binary", "patternBoolean", "patternCanonical", "patternCode", "patternDate", "patternDateTime", "patternDecimal", "patternId", "patternInstant", "patternInteger", "patternMarkdown", "patternOid", "patternPositiveInt", "patternString", "patternTime", "patternUnsignedInt", "patternUri", "patternUrl", "patternUuid", "patternAddress", "patternAge", "patternAnnotation", "patternAttachment", "patternCodeableConcept", "patternCoding", "patternContactPoint", "patternCount", "patternDistance", "patternDuration", "patternHumanName", "patternIdentifier", "patternMoney", "patternPeriod", "patternQuantity", "patternRange", "patternRatio", "patternReference", "patternSampledData", "patternSignature", "patternTiming", "patternContactDetail", "patternContributor", "patternDataRequirement", "patternExpression", "patternParameterDefinition", "patternRelatedArtifact", "patternTriggerDefinition", "patternUsageContext", "patternDosage", "patternMeta", "example", "minValueDate", "minValueDateTime", "minValueInstant", "minValueTime", "minValueDecimal", "minValueInteger", "minValuePositiveInt", "minValueUnsignedInt", "minValueQuantity", "maxValueDate", "maxValueDateTime", "maxValueInstant", "maxValueTime", "maxValueDecimal", "maxValueInteger", "maxValuePositiveInt", "maxValueUnsignedInt", "maxValueQuantity", "maxLength", "condition", "constraint", "mustSupport", "isModifier", "isModifierReason", "isSummary", "binding", "mapping"},
+	reflect.TypeOf(ElementDefinitionBase{}):                                                            {"id", "extension", "path", "min", "max"},
+	reflect.TypeOf(ElementDefinitionBinding{}):                                                         {"id", "extension", "strength", "description", "valueSet"},
+	reflect.TypeOf(ElementDefinitionConstraint{}):                                                      {"id", "extension", "key", "requirements", "severity", "human", "expression", "xpath", "source"},
+	reflect.TypeOf(ElementDefinitionMapping{}):                                                         {"id", "extension", "identity", "language", "map", "comment"},
+	reflect.TypeOf(ElementDefinitionSlicing{}):                                                         {"id", "extension", "discriminator", "description", "ordered", "rules"},
+	reflect.TypeOf(ElementDefinitionSlicingDiscriminator{}):                                            {"id", "extension", "type", "path"},
+	reflect.TypeOf(ElementDefinitionType{}):                                                            {"id", "extension", "code", "profile", "targetProfile", "aggregation", "versioning"},
+	reflect.TypeOf(Encounter{}):                                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "class", "classHistory", "type", "serviceType", "priority", "subject", "episodeOfCare", "basedOn", "participant", "appointment", "period", "length", "reasonCode", "reasonReference", "diagnosis", "account", "hospitalization", "location", "serviceProvider", "partOf"},
+	reflect.TypeOf(EncounterDiagnosis{}):                                                               {"id", "extension", "modifierExtension", "condition", "use", "rank"},
+	reflect.TypeOf(EncounterHospitalization{}):                                                         {"id", "extension", "modifierExtension", "preAdmissionIdentifier", "origin", "admitSource", "reAdmission", "dietPreference", "specialCourtesy", "specialArrangement", "destination", "dischargeDisposition"},
+	reflect.TypeOf(EncounterLocation{}):                                                                {"id", "extension", "modifierExtension", "location", "status", "physicalType", "period"},
+	reflect.TypeOf(EncounterParticipant{}):                                                             {"id", "extension", "modifierExtension", "type", "period", "individual"},
+	reflect.TypeOf(EncounterStatusHistory{}):                                                           {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(Endpoint{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "connectionType", "name", "managingOrganization", "contact", "period", "payloadType", "payloadMimeType", "address", "header"},
+	reflect.TypeOf(EnrollmentRequest{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "insurer", "provider", "candidate", "coverage"},
+	reflect.TypeOf(EnrollmentResponse{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "request", "outcome", "disposition", "created", "organization", "requestProvider"},
+	reflect.TypeOf(EpisodeOfCare{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "type", "diagnosis", "patient", "managingOrganization", "period", "referralRequest", "careManager", "account"},
+	reflect.TypeOf(EpisodeOfCareDiagnosis{}):                                                           {"id", "extension", "modifierExtension", "condition", "role", "rank"},
+	reflect.TypeOf(EpisodeOfCareStatusHistory{}):                                                       {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(EventDefinition{}):                                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "trigger"},
+	reflect.TypeOf(Evidence{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "subtitle", "status", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "exposureBackground", "exposureVariant", "outcome", "purpose", "usage"},
+	reflect.TypeOf(EvidenceVariable{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "shortTitle", "subtitle", "status", "date", "publisher", "contact", "description", "note", "useContext", "jurisdiction", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "type", "characteristic"},
+	reflect.TypeOf(EvidenceVariableCharacteristic{}):                                                   {"id", "extension", "modifierExtension", "description", "definitionReference", "definitionCanonical", "definitionCodeableConcept", "definitionExpression", "definitionDataRequirement", "definitionTriggerDefinition", "usageContext", "exclude", "participantEffectiveDateTime", "participantEffectivePeriod", "participantEffectiveDuration", "participantEffectiveTiming", "timeFromStart", "groupMeasure"},
+	reflect.TypeOf(ExampleScenario{}):                                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "status", "experimental", "date", "publisher", "contact", "useContext", "jurisdiction", "copyright", "purpose", "actor", "instance", "process", "workflow"},
+	reflect.TypeOf(ExampleScenarioActor{}):                                                             {"id", "extension", "modifierExtension", "actorId", "type", "name", "description"},
+	reflect.TypeOf(ExampleScenarioInstance{}):                                                          {"id", "extension", "modifierExtension", "resourceId", "name", "description", "version", "containedInstance"},
+	reflect.TypeOf(ExampleScenarioInstanceVersion{}):                                                   {"id", "extension", "modifierExtension", "versionId", "description"},
+	reflect.TypeOf(ExampleScenarioProcess{}):                                                           {"id", "extension", "modifierExtension", "title", "description", "preConditions", "postConditions", "step"},
+	reflect.TypeOf(ExampleScenarioProcessStep{}):                                                       {"id", "extension", "modifierExtension", "process", "pause", "operation", "alternative"},
+	reflect.TypeOf(ExampleScenarioProcessStepAlternative{}):                                            {"id", "extension", "modifierExtension", "title", "description", "step"},
+	reflect.TypeOf(ExampleScenarioProcessStepOperation{}):                                              {"id", "extension", "modifierExtension", "number", "type", "name", "initiator", "receiver", "description", "initiatorActive", "receiverActive", "request", "response"},
+	reflect.TypeOf(ExplanationOfBenefit{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subType", "use", "patient", "billablePeriod", "created", "enterer", "insurer", "provider", "priority", "fundsReserveRequested", "fundsReserve", "related", "prescription", "originalPrescription", "payee", "referral", "facility", "claim", "claimResponse", "outcome", "disposition", "preAuthRef", "preAuthRefPeriod", "careTeam", "supportingInfo", "diagnosis", "procedure", "precedence", "insurance", "accident", "item", "addItem", "adjudication", "total", "payment", "formCode", "form", "processNote", "benefitPeriod", "benefitBalance"},
+	reflect.TypeOf(ExplanationOfBenefitAccident{}):                                                     {"id", "extension", "modifierExtension", "date", "type", "locationAddress", "locationReference"},
+	reflect.TypeOf(ExplanationOfBenefitAddItem{}):                                                      {"id", "extension", "modifierExtension", "modifier", "servicedDate", "servicedPeriod", "quantity", "unitPrice", "bodySite", "adjudication", "careTeamSequence", "category", "detail", "diagnosisSequence", "encounter", "revenue", "service", "udi"},
+	reflect.TypeOf(ExplanationOfBenefitAddItemDetail{}):                                                {"id", "extension", "modifierExtension", "modifier", "quantity", "unitPrice", "adjudication", "category", "revenue", "service", "subDetail", "udi"},
+	reflect.TypeOf(ExplanationOfBenefitAddItemDetailSubDetail{}):                                       {"id", "extension", "modifierExtension", "modifier", "quantity", "unitPrice", "adjudication", "category", "revenue", "service", "udi"},
+	reflect.TypeOf(ExplanationOfBenefitBenefitBalance{}):                                               {"id", "extension", "modifierExtension", "category", "excluded", "name", "description", "network", "unit", "term", "financial"},
+	reflect.TypeOf(ExplanationOfBenefitBenefitBalanceFinancial{}):                                      {"id", "extension", "modifierExtension", "type", "allowedMoney", "allowedUnsignedInt", "allowedString", "usedMoney", "usedUnsignedInt"},
+	reflect.TypeOf(ExplanationOfBenefitCareTeam{}):                                                     {"id", "extension", "modifierExtension", "sequence", "provider", "responsible", "role", "qualification"},
+	reflect.TypeOf(ExplanationOfBenefitDiagnosis{}):                                                    {"id", "extension", "modifierExtension", "sequence", "diagnosisCodeableConcept", "diagnosisReference", "type", "packageCode"},
+	reflect.TypeOf(ExplanationOfBenefitInsurance{}):                                                    {"id", "extension", "modifierExtension", "focal", "coverage", "preAuthRef", "preAuthRefPeriod", "sequence"},
+	reflect.TypeOf(ExplanationOfBenefitItem{}):                                                         {"id", "extension", "modifierExtension", "sequence", "careTeamSequence", "diagnosisSequence", "revenue", "category", "modifier", "servicedDate", "servicedPeriod", "quantity", "unitPrice", "udi", "bodySite", "encounter", "adjudication", "detail", "service"},
+	reflect.TypeOf(ExplanationOfBenefitItemAdjudication{}):                                             {"id", "extension", "modifierExtension", "category", "reason", "amount", "value"},
+	reflect.TypeOf(ExplanationOfBenefitItemDetail{}):                                                   {"id", "extension", "modifierExtension", "sequence", "revenue", "category", "modifier", "quantity", "unitPrice", "udi", "adjudication", "service", "subDetail"},
+	reflect.TypeOf(ExplanationOfBenefitItemDetailSubDetail{}):                                          {"id", "extension", "modifierExtension", "sequence", "revenue", "category", "modifier", "quantity", "unitPrice", "udi", "adjudication", "service"},
+	reflect.TypeOf(ExplanationOfBenefitPayee{}):                                                        {"id", "extension", "modifierExtension", "type", "party"},
+	reflect.TypeOf(ExplanationOfBenefitPayment{}):                                                      {"id", "extension", "modifierExtension", "type", "adjustment", "adjustmentReason", "date", "amount", "identifier"},
+	reflect.TypeOf(ExplanationOfBenefitProcedure{}):                                                    {"id", "extension", "modifierExtension", "sequence", "type", "date", "procedureCodeableConcept", "procedureReference", "udi"},
+	reflect.TypeOf(ExplanationOfBenefitProcessNote{}):                                                  {"id", "extension", "modifierExtension", "type", "text", "language"},
+	reflect.TypeOf(ExplanationOfBenefitRelated{}):                                                      {"id", "extension", "modifierExtension", "claim", "relationship", "reference"},
+	reflect.TypeOf(ExplanationOfBenefitSupportingInfo{}):                                               {"id", "extension", "modifierExtension", "sequence", "category", "code", "timingDate", "timingPeriod", "valueBoolean", "valueString", "valueQuantity", "valueAttachment", "valueReference", "reason"},
+	reflect.TypeOf(ExplanationOfBenefitTotal{}):                                                        {"id", "extension", "modifierExtension", "category", "amount"},
+	reflect.TypeOf(FamilyMemberHistory{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "status", "dataAbsentReason", "patient", "date", "name", "relationship", "sex", "bornPeriod", "bornDate", "bornString", "ageAge", "ageRange", "ageString", "estimatedAge", "deceasedBoolean", "deceasedAge", "deceasedRange", "deceasedDate", "deceasedString", "reasonCode", "reasonReference", "note", "condition"},
+	reflect.TypeOf(FamilyMemberHistoryCondition{}):                                                     {"id", "extension", "modifierExtension", "code", "outcome", "contributedToDeath", "onsetAge", "onsetRange", "onsetPeriod", "onsetString", "note"},
+	reflect.TypeOf(Flag{}):                                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "subject", "period", "encounter", "author"},
+	reflect.TypeOf(Goal{}):                                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "lifecycleStatus", "achievementStatus", "category", "priority", "description", "subject", "startDate", "startCodeableConcept", "target", "statusDate", "statusReason", "expressedBy", "addresses", "note", "outcomeCode", "outcomeReference"},
+	reflect.TypeOf(GoalTarget{}):                                                                       {"id", "extension", "modifierExtension", "measure", "detailQuantity", "detailRange", "detailCodeableConcept", "detailString", "detailBoolean", "detailInteger", "detailRatio", "dueDate", "dueDuration"},
+	reflect.TypeOf(GraphDefinition{}):                                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "start", "profile", "link"},
+	reflect.TypeOf(GraphDefinitionLink{}):                                                              {"id", "extension", "modifierExtension", "path", "sliceName", "min", "max", "description", "target"},
+	reflect.TypeOf(GraphDefinitionLinkTarget{}):                                                        {"id", "extension", "modifierExtension", "type", "params", "profile", "compartment", "link"},
+	reflect.TypeOf(GraphDefinitionLinkTargetCompartment{}):                                             {"id", "extension", "modifierExtension", "use", "code", "rule", "expression", "description"},
+	reflect.TypeOf(Group{}):                                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "actual", "code", "name", "quantity", "managingEntity", "characteristic", "member"},
+	reflect.TypeOf(GroupCharacteristic{}):                                                              {"id", "extension", "modifierExtension", "code", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "valueReference", "exclude", "period"},
+	reflect.TypeOf(GroupMember{}):                                                                      {"id", "extension", "modifierExtension", "entity", "period", "inactive"},
+	reflect.TypeOf(GuidanceResponse{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "requestIdentifier", "identifier", "moduleUri", "moduleCanonical", "moduleCodeableConcept", "status", "subject", "encounter", "occurrenceDateTime", "performer", "reasonCode", "reasonReference", "note", "evaluationMessage", "outputParameters", "result", "dataRequirement"},
+	reflect.TypeOf(HealthcareService{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "providedBy", "category", "type", "specialty", "location", "name", "comment", "extraDetails", "photo", "telecom", "coverageArea", "serviceProvisionCode", "eligibility", "program", "characteristic", "communication", "referralMethod", "appointmentRequired", "availableTime", "notAvailable", "availabilityExceptions", "endpoint"},
+	reflect.TypeOf(HealthcareServiceAvailableTime{}):                                                   {"id", "extension", "modifierExtension", "daysOfWeek", "allDay", "availableStartTime", "availableEndTime"},
+	reflect.TypeOf(ImagingStudy{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "modality", "subject", "encounter", "started", "basedOn", "referrer", "interpreter", "endpoint", "numberOfSeries", "numberOfInstances", "procedureReference", "procedureCode", "location", "reasonCode", "reasonReference", "note", "description", "series"},
+	reflect.TypeOf(ImagingStudySeries{}):                                                               {"id", "extension", "modifierExtension", "uid", "number", "modality", "description", "numberOfInstances", "endpoint", "bodySite", "laterality", "specimen", "started", "performer", "instance"},
+	reflect.TypeOf(ImagingStudySeriesInstance{}):                                                       {"id", "extension", "modifierExtension", "uid", "sopClass", "number", "title"},
+	reflect.TypeOf(ImagingStudySeriesPerformer{}):                                                      {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(Immunization{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusReason", "vaccineCode", "patient", "encounter", "occurrenceDateTime", "occurrenceString", "recorded", "primarySource", "reportOrigin", "location", "manufacturer", "lotNumber", "expirationDate", "site", "route", "doseQuantity", "performer", "note", "reasonCode", "reasonReference", "isSubpotent", "subpotentReason", "education", "programEligibility", "fundingSource", "reaction", "protocolApplied"},
+	reflect.TypeOf(ImmunizationEvaluation{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "date", "authority", "targetDisease", "immunizationEvent", "doseStatus", "doseStatusReason", "description", "series", "doseNumberPositiveInt", "doseNumberString", "seriesDosesPositiveInt", "seriesDosesString"},
+	reflect.TypeOf(ImmunizationRecommendation{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "date", "authority", "recommendation"},
+	reflect.TypeOf(ImmunizationRecommendationRecommendation{}):                                         {"id", "extension", "modifierExtension", "vaccineCode", "targetDisease", "contraindicatedVaccineCode", "forecastStatus", "forecastReason", "dateCriterion", "description", "series", "doseNumberPositiveInt", "doseNumberString", "seriesDosesPositiveInt", "seriesDosesString", "supportingImmunization", "supportingPatientInformation"},
+	reflect.TypeOf(ImplementationGuide{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "copyright", "packageId", "license", "fhirVersion", "dependsOn", "global", "definition", "manifest"},
+	reflect.TypeOf(ImplementationGuideDefinition{}):                                                    {"id", "extension", "modifierExtension", "grouping", "resource", "page", "parameter", "template"},
+	reflect.TypeOf(ImplementationGuideDefinitionGrouping{}):                                            {"id", "extension", "modifierExtension", "name", "description"},
+	reflect.TypeOf(ImplementationGuideDefinitionPage{}):                                                {"id", "extension", "modifierExtension", "title", "generation", "name", "page", "sourceUrl"},
+	reflect.TypeOf(ImplementationGuideDefinitionResource{}):                                            {"id", "extension", "modifierExtension", "reference", "name", "description", "exampleBoolean", "exampleCanonical"},
+	reflect.TypeOf(ImplementationGuideDependsOn{}):                                                     {"id", "extension", "modifierExtension", "uri", "packageId", "version"},
+	reflect.TypeOf(ImplementationGuideGlobal{}):                                                        {"id", "extension", "modifierExtension", "type", "profile"},
+	reflect.TypeOf(ImplementationGuideManifest{}):                                                      {"id", "extension", "modifierExtension", "rendering", "resource", "page", "image", "other"},
+	reflect.TypeOf(ImplementationGuideManifestPage{}):                                                  {"id", "extension", "modifierExtension", "name", "title", "anchor"},
+	reflect.TypeOf(ImplementationGuideManifestResource{}):                                              {"id", "extension", "modifierExtension", "reference", "exampleBoolean", "exampleCanonical", "relativePath"},
+	reflect.TypeOf(InsurancePlan{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "name", "alias", "period", "ownedBy", "administeredBy", "coverageArea", "contact", "endpoint", "network", "coverage", "plan"},
+	reflect.TypeOf(InsurancePlanContact{}):                                                             {"id", "extension", "modifierExtension", "purpose", "name", "telecom", "address"},
+	reflect.TypeOf(InsurancePlanCoverage{}):                                                            {"id", "extension", "modifierExtension", "type", "network", "benefit"},
+	reflect.TypeOf(InsurancePlanCoverageBenefit{}):                                                     {"id", "extension", "modifierExtension", "type", "requirement", "limit"},
+	reflect.TypeOf(InsurancePlanCoverageBenefitLimit{}):                                                {"id", "extension", "modifierExtension", "value", "code"},
+	reflect.TypeOf(InsurancePlanPlan{}):                                                                {"id", "extension", "modifierExtension", "identifier", "type", "coverageArea", "network", "generalCost", "specificCost"},
+	reflect.TypeOf(InsurancePlanPlanGeneralCost{}):                                                     {"id", "extension", "modifierExtension", "type", "groupSize", "cost", "comment"},
+	reflect.TypeOf(InsurancePlanPlanSpecificCost{}):                                                    {"id", "extension", "modifierExtension", "category", "benefit"},
+	reflect.TypeOf(InsurancePlanPlanSpecificCostBenefit{}):                                             {"id", "extension", "modifierExtension", "type", "cost"},
+	reflect.TypeOf(InsurancePlanPlanSpecificCostBenefitCost{}):                                         {"id", "extension", "modifierExtension", "type", "applicability", "qualifiers", "value"},
+	reflect.TypeOf(Invoice{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "cancelledReason", "type", "subject", "recipient", "date", "participant", "issuer", "account", "lineItem", "totalPriceComponent", "totalNet", "totalGross", "paymentTerms", "note"},
+	reflect.TypeOf(InvoiceLineItem{}):                                                                  {"id", "extension", "modifierExtension", "sequence", "chargeItemReference", "chargeItemCodeableConcept", "priceComponent"},
+	reflect.TypeOf(InvoiceLineItemPriceComponent{}):                                                    {"id", "extension", "modifierExtension", "type", "code", "factor", "amount"},
+	reflect.TypeOf(InvoiceParticipant{}):                                                               {"id", "extension", "modifierExtension", "role", "actor"},
+	reflect.TypeOf(Library{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "subtitle", "status", "experimental", "type", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "parameter", "dataRequirement", "content"},
+	reflect.TypeOf(Linkage{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "active", "author", "item"},
+	reflect.TypeOf(LinkageItem{}):                                                                      {"id", "extension", "modifierExtension", "type", "resource"},
+	reflect.TypeOf(List{}):                                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "mode", "title", "code", "subject", "encounter", "date", "source", "orderedBy", "note", "entry", "emptyReason"},
+	reflect.TypeOf(ListEntry{}):                                                                        {"id", "extension", "modifierExtension", "flag", "deleted", "date", "item"},
+	reflect.TypeOf(Location{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "operationalStatus", "name", "alias", "description", "mode", "type", "telecom", "address", "physicalType", "position", "managingOrganization", "partOf", "hoursOfOperation", "availabilityExceptions", "endpoint"},
+	reflect.TypeOf(Measure{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "disclaimer", "scoring", "compositeScoring", "type", "riskAdjustment", "rateAggregation", "rationale", "clinicalRecommendationStatement", "improvementNotation", "definition", "guidance", "group", "supplementalData"},
+	reflect.TypeOf(MeasureGroupPopulation{}):                                                           {"id", "extension", "modifierExtension", "code", "description", "criteria"},
+	reflect.TypeOf(MeasureGroupStratifier{}):                                                           {"id", "extension", "modifierExtension", "description", "criteria", "identifier", "path"},
+	reflect.TypeOf(MeasureReport{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "measure", "date", "reporter", "period", "group", "evaluatedResource"},
+	reflect.TypeOf(MeasureReportGroupStratifierStratum{}):                                              {"id", "extension", "modifierExtension", "score", "value", "component", "population"},
+	reflect.TypeOf(MeasureSupplementalData{}):                                                          {"id", "extension", "modifierExtension", "usage", "description", "criteria", "identifier"},
+	reflect.TypeOf(Media{}):                                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "type", "modality", "view", "subject", "encounter", "createdDateTime", "createdPeriod", "issued", "operator", "reasonCode", "bodySite", "deviceName", "device", "height", "width", "frames", "duration", "content", "note"},
+	reflect.TypeOf(Medication{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "status", "manufacturer", "form", "amount", "ingredient", "batch"},
+	reflect.TypeOf(MedicationAdministration{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiates", "partOf", "status", "statusReason", "category", "medicationCodeableConcept", "medicationReference", "subject", "context", "supportingInformation", "effectiveDateTime", "effectivePeriod", "performer", "reasonCode", "reasonReference", "request", "device", "note", "dosage", "eventHistory"},
+	reflect.TypeOf(MedicationAdministrationDosage{}):                                                   {"id", "extension", "modifierExtension", "text", "site", "route", "method", "dose", "rateQuantity", "rateRatio"},
+	reflect.TypeOf(MedicationAdministrationPerformer{}):                                                {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(MedicationDispense{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "partOf", "status", "statusReasonCodeableConcept", "statusReasonReference", "category", "medicationCodeableConcept", "medicationReference", "subject", "context", "supportingInformation", "performer", "location", "authorizingPrescription", "type", "quantity", "daysSupply", "whenPrepared", "whenHandedOver", "destination", "receiver", "note", "dosageInstruction", "substitution", "detectedIssue", "eventHistory"},
+	reflect.TypeOf(MedicationDispensePerformer{}):                                                      {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(MedicationDispenseSubstitution{}):                                                   {"id", "extension", "modifierExtension", "wasSubstituted", "type", "reason", "responsibleParty"},
+	reflect.TypeOf(MedicationKnowledge{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "code", "status", "manufacturer", "doseForm", "amount", "synonym", "relatedMedicationKnowledge", "associatedMedication", "productType", "monograph", "ingredient", "preparationInstruction", "intendedRoute", "cost", "monitoringProgram", "administrationGuidelines", "medicineClassification", "packaging", "drugCharacteristic", "contraindication", "regulatory", "kinetics"},
+	reflect.TypeOf(MedicationKnowledgeAdministrationGuidelinesDosage{}):                                {"id", "extension", "modifierExtension", "type", "dosage"},
+	reflect.TypeOf(MedicationKnowledgeCost{}):                                                          {"id", "extension", "modifierExtension", "type", "source", "cost"},
+	reflect.TypeOf(MedicationKnowledgeIngredient{}):                                                    {"id", "extension", "modifierExtension", "itemCodeableConcept", "itemReference", "isActive", "strength"},
+	reflect.TypeOf(MedicationKnowledgeKinetics{}):                                                      {"id", "extension", "modifierExtension", "areaUnderCurve", "lethalDose50", "halfLifePeriod"},
+	reflect.TypeOf(MedicationKnowledgeMedicineClassification{}):                                        {"id", "extension", "modifierExtension", "type", "classification"},
+	reflect.TypeOf(MedicationKnowledgeMonitoringProgram{}):                                             {"id", "extension", "modifierExtension", "type", "name"},
+	reflect.TypeOf(MedicationKnowledgeMonograph{}):                                                     {"id", "extension", "modifierExtension", "type", "source"},
+	reflect.TypeOf(MedicationKnowledgeRegulatory{}):                                                    {"id", "extension", "modifierExtension", "regulatoryAuthority", "substitution", "schedule", "maxDispense"},
+	reflect.TypeOf(MedicationKnowledgeRegulatorySubstitution{}):                                        {"id", "extension", "modifierExtension", "type", "allowed"},
+	reflect.TypeOf(MedicationKnowledgeRelatedMedicationKnowledge{}):                                    {"id", "extension", "modifierExtension", "type", "reference"},
+	reflect.TypeOf(MedicationRequest{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusReason", "intent", "category", "priority", "doNotPerform", "reportedBoolean", "reportedReference", "medicationCodeableConcept", "medicationReference", "subject", "encounter", "supportingInformation", "authoredOn", "requester", "recorder", "reasonCode", "reasonReference", "instantiatesCanonical", "instantiatesUri", "basedOn", "groupIdentifier", "courseOfTherapyType", "insurance", "note", "dosageInstruction", "dispenseRequest", "substitution", "priorPrescription", "detectedIssue", "eventHistory"},
+	reflect.TypeOf(MedicationStatement{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "statusReason", "category", "medicationCodeableConcept", "medicationReference", "subject", "context", "effectiveDateTime", "effectivePeriod", "dateAsserted", "informationSource", "derivedFrom", "reasonCode", "reasonReference", "note", "dosage"},
+	reflect.TypeOf(MedicinalProduct{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "domain", "combinedPharmaceuticalDoseForm", "legalStatusOfSupply", "additionalMonitoringIndicator", "specialMeasures", "paediatricUseIndicator", "productClassification", "marketingStatus", "pharmaceuticalProduct", "packagedMedicinalProduct", "attachedDocument", "masterFile", "contact", "clinicalTrial", "name", "crossReference", "manufacturingBusinessOperation", "specialDesignation"},
+	reflect.TypeOf(MedicinalProductAuthorization{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "country", "jurisdiction", "status", "statusDate", "restoreDate", "validityPeriod", "dataExclusivityPeriod", "dateOfFirstAuthorization", "internationalBirthDate", "legalBasis", "jurisdictionalAuthorization", "holder", "regulator", "procedure"},
+	reflect.TypeOf(MedicinalProductAuthorizationJurisdictionalAuthorization{}):                         {"id", "extension", "modifierExtension", "identifier", "country", "jurisdiction", "legalStatusOfSupply", "validityPeriod"},
+	reflect.TypeOf(MedicinalProductAuthorizationProcedure{}):                                           {"id", "extension", "modifierExtension", "identifier", "type", "datePeriod", "dateDateTime", "application"},
+	reflect.TypeOf(MedicinalProductContraindication{}):                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "subject", "disease", "diseaseStatus", "comorbidity", "therapeuticIndication", "otherTherapy", "population"},
+	reflect.TypeOf(MedicinalProductContraindicationOtherTherapy{}):                                     {"id", "extension", "modifierExtension", "therapyRelationshipType", "medicationCodeableConcept", "medicationReference"},
+	reflect.TypeOf(MedicinalProductIndication{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "subject", "diseaseSymptomProcedure", "diseaseStatus", "comorbidity", "intendedEffect", "duration", "otherTherapy", "undesirableEffect", "population"},
+	reflect.TypeOf(MedicinalProductIndicationOtherTherapy{}):                                           {"id", "extension", "modifierExtension", "therapyRelationshipType", "medicationCodeableConcept", "medicationReference"},
+	reflect.TypeOf(MedicinalProductIngredient{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "role", "allergenicIndicator", "manufacturer", "specifiedSubstance", "substance"},
+	reflect.TypeOf(MedicinalProductInteraction{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "subject", "description", "interactant", "type", "effect", "incidence", "management"},
+	reflect.TypeOf(MedicinalProductManufactured{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "manufacturedDoseForm", "unitOfPresentation", "quantity", "manufacturer", "ingredient", "physicalCharacteristics", "otherCharacteristics"},
+	reflect.TypeOf(MedicinalProductManufacturingBusinessOperation{}):                                   {"id", "extension", "modifierExtension", "operationType", "authorisationReferenceNumber", "effectiveDate", "confidentialityIndicator", "manufacturer", "regulator"},
+	reflect.TypeOf(MedicinalProductName{}):                                                             {"id", "extension", "modifierExtension", "productName", "namePart", "countryLanguage"},
+	reflect.TypeOf(MedicinalProductPackaged{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "description", "legalStatusOfSupply", "marketingStatus", "marketingAuthorization", "manufacturer", "batchIdentifier", "packageItem"},
+	reflect.TypeOf(MedicinalProductPackagedBatchIdentifier{}):                                          {"id", "extension", "modifierExtension", "outerPackaging", "immediatePackaging"},
+	reflect.TypeOf(MedicinalProductPackagedPackageItem{}):                                              {"id", "extension", "modifierExtension", "identifier", "type", "quantity", "material", "alternateMaterial", "device", "manufacturedItem", "packageItem", "physicalCharacteristics", "otherCharacteristics", "shelfLifeStorage", "manufacturer"},
+	reflect.TypeOf(MedicinalProductPharmaceutical{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "administrableDoseForm", "unitOfPresentation", "ingredient", "device", "characteristics", "routeOfAdministration"},
+	reflect.TypeOf(MedicinalProductPharmaceuticalRouteOfAdministration{}):                              {"id", "extension", "modifierExtension", "code", "firstDose", "maxSingleDose", "maxDosePerDay", "maxDosePerTreatmentPeriod", "maxTreatmentPeriod", "targetSpecies"},
+	reflect.TypeOf(MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}): {"id", "extension", "modifierExtension", "tissue", "value", "supportingInformation"},
+	reflect.TypeOf(MedicinalProductSpecialDesignation{}):                                               {"id", "extension", "modifierExtension", "identifier", "type", "intendedUse", "indicationCodeableConcept", "indicationReference", "status", "date", "species"},
+	reflect.TypeOf(MedicinalProductUndesirableEffect{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "subject", "symptomConditionEffect", "classification", "frequencyOfOccurrence", "population"},
+	reflect.TypeOf(MessageDefinition{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "replaces", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "base", "parent", "eventCoding", "eventUri", "category", "focus", "responseRequired", "allowedResponse", "graph"},
+	reflect.TypeOf(MessageDefinitionFocus{}):                                                           {"id", "extension", "modifierExtension", "code", "profile", "min", "max"},
+	reflect.TypeOf(MessageHeader{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "eventCoding", "eventUri", "destination", "sender", "enterer", "author", "source", "responsible", "reason", "response", "focus", "definition"},
+	reflect.TypeOf(MessageHeaderDestination{}):                                                         {"id", "extension", "modifierExtension", "name", "target", "endpoint", "receiver"},
+	reflect.TypeOf(MessageHeaderResponse{}):                                                            {"id", "extension", "modifierExtension", "identifier", "code", "details"},
+	reflect.TypeOf(MessageHeaderSource{}):                                                              {"id", "extension", "modifierExtension", "name", "software", "version", "contact", "endpoint"},
+	reflect.TypeOf(MolecularSequence{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "coordinateSystem", "patient", "specimen", "device", "performer", "quantity", "referenceSeq", "variant", "observedSeq", "quality", "readCoverage", "repository", "pointer", "structureVariant"},
+	reflect.TypeOf(MolecularSequenceQuality{}):                                                         {"id", "extension", "modifierExtension", "type", "standardSequence", "start", "end", "score", "method", "truthTP", "queryTP", "truthFN", "queryFP", "gtFP", "precision", "recall", "fScore", "roc"},
+	reflect.TypeOf(MolecularSequenceQualityRoc{}):                                                      {"id", "extension", "modifierExtension", "score", "numTP", "numFP", "numFN", "precision", "sensitivity", "fMeasure"},
+	reflect.TypeOf(MolecularSequenceReferenceSeq{}):                                                    {"id", "extension", "modifierExtension", "chromosome", "genomeBuild", "orientation", "referenceSeqId", "referenceSeqPointer", "referenceSeqString", "strand", "windowStart", "windowEnd"},
+	reflect.TypeOf(MolecularSequenceRepository{}):                                                      {"id", "extension", "modifierExtension", "type", "url", "name", "datasetId", "variantsetId", "readsetId"},
+	reflect.TypeOf(MolecularSequenceStructureVariant{}):                                                {"id", "extension", "modifierExtension", "variantType", "exact", "length", "outer", "inner"},
+	reflect.TypeOf(MolecularSequenceStructureVariantInner{}):                                           {"id", "extension", "modifierExtension", "start", "end"},
+	reflect.TypeOf(MolecularSequenceStructureVariantOuter{}):                                           {"id", "extension", "modifierExtension", "start", "end"},
+	reflect.TypeOf(MolecularSequenceVariant{}):                                                         {"id", "extension", "modifierExtension", "start", "end", "observedAllele", "referenceAllele", "cigar", "variantPointer"},
+	reflect.TypeOf(NamingSystem{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "name", "status", "kind", "date", "publisher", "contact", "responsible", "type", "description", "useContext", "jurisdiction", "usage", "uniqueId"},
+	reflect.TypeOf(NamingSystemUniqueId{}):                                                             {"id", "extension", "modifierExtension", "type", "value", "preferred", "comment", "period"},
+	reflect.TypeOf(NutritionOrder{}):                                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "instantiates", "status", "intent", "patient", "encounter", "dateTime", "orderer", "allergyIntolerance", "foodPreferenceModifier", "excludeFoodModifier", "oralDiet", "supplement", "enteralFormula", "note"},
+	reflect.TypeOf(NutritionOrderEnteralFormula{}):                                                     {"id", "extension", "modifierExtension", "baseFormulaType", "baseFormulaProductName", "additiveType", "additiveProductName", "caloricDensity", "routeofAdministration", "administration", "maxVolumeToDeliver", "administrationInstruction"},
+	reflect.TypeOf(NutritionOrderEnteralFormulaAdministration{}):                                       {"id", "extension", "modifierExtension", "schedule", "quantity", "rateQuantity", "rateRatio"},
+	reflect.TypeOf(NutritionOrderOralDiet{}):                                                           {"id", "extension", "modifierExtension", "type", "schedule", "nutrient", "texture", "fluidConsistencyType", "instruction"},
+	reflect.TypeOf(NutritionOrderOralDietNutrient{}):                                                   {"id", "extension", "modifierExtension", "modifier", "amount"},
+	reflect.TypeOf(NutritionOrderOralDietTexture{}):                                                    {"id", "extension", "modifierExtension", "modifier", "foodType"},
+	reflect.TypeOf(NutritionOrderSupplement{}):                                                         {"id", "extension", "modifierExtension", "type", "productName", "schedule", "quantity", "instruction"},
+	reflect.TypeOf(Observation{}):                                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "category", "code", "subject", "focus", "encounter", "effectiveDateTime", "effectivePeriod", "effectiveTiming", "effectiveInstant", "issued", "performer", "valueQuantity", "valueCodeableConcept", "valueString", "valueBoolean", "valueInteger", "valueRange", "valueRatio", "valueSampledData", "valueTime", "valueDateTime", "valuePeriod", "dataAbsentReason", "interpretation", "note", "bodySite", "method", "specimen", "device", "referenceRange", "hasMember", "derivedFrom", "component"},
+	reflect.TypeOf(ObservationDefinition{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "category", "code", "identifier", "permittedDataType", "multipleResultsAllowed", "method", "preferredReportName", "quantitativeDetails", "qualifiedInterval", "validCodedValueSet", "normalCodedValueSet", "abnormalCodedValueSet", "criticalCodedValueSet"},
+	reflect.TypeOf(ObservationDefinitionQualifiedInterval{}):                                           {"id", "extension", "modifierExtension", "category", "range", "appliesTo", "gender", "age", "gestationalAge", "condition", "text", "type"},
+	reflect.TypeOf(ObservationDefinitionQuantitativeDetails{}):                                         {"id", "extension", "modifierExtension", "customaryUnit", "unit", "conversionFactor", "decimalPrecision"},
+	reflect.TypeOf(OperationDefinition{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "title", "status", "kind", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "affectsState", "code", "comment", "base", "resource", "system", "type", "instance", "inputProfile", "outputProfile", "parameter", "overload"},
+	reflect.TypeOf(OperationDefinitionOverload{}):                                                      {"id", "extension", "modifierExtension", "parameterName", "comment"},
+	reflect.TypeOf(OperationDefinitionParameter{}):                                                     {"id", "extension", "modifierExtension", "name", "use", "min", "max", "documentation", "type", "targetProfile", "searchType", "binding", "referencedFrom", "part"},
+	reflect.TypeOf(OperationOutcome{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "issue"},
+	reflect.TypeOf(OperationOutcomeIssue{}):                                                            {"id", "extension", "modifierExtension", "severity", "code", "details", "diagnostics", "location", "expression"},
+	reflect.TypeOf(Organization{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "name", "alias", "telecom", "address", "partOf", "contact", "endpoint"},
+	reflect.TypeOf(OrganizationAffiliation{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "period", "organization", "participatingOrganization", "network", "code", "specialty", "location", "healthcareService", "telecom", "endpoint"},
+	reflect.TypeOf(ParametersParameter{}):                                                              {"id", "extension", "modifierExtension", "name", "valueBase64Binary", "valueBoolean", "valueCanonical", "valueCode", "valueDate", "valueDateTime", "valueDecimal", "valueId", "valueInstant", "valueInteger", "valueMarkdown", "valueOid", "valuePositiveInt", "valueString", "valueTime", "valueUnsignedInt", "valueUri", "valueUrl", "valueUuid", "valueAddress", "valueAge", "valueAnnotation", "valueAttachment", "valueCodeableConcept", "valueCoding", "valueContactPoint", "valueCount", "valueDistance", "valueDuration", "valueHumanName", "valueIdentifier", "valueMoney", "valuePeriod", "valueQuantity", "valueRange", "valueRatio", "valueReference", "valueSampledData", "valueSignature", "valueTiming", "valueDosage", "valueMeta", "resource", "part"},
+	reflect.TypeOf(Patient{}):                                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "address", "maritalStatus", "multipleBirthBoolean", "multipleBirthInteger", "photo", "contact", "communication", "generalPractitioner", "managingOrganization", "link"},
+	reflect.TypeOf(PaymentNotice{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "request", "response", "created", "provider", "payment", "paymentDate", "payee", "recipient", "amount", "paymentStatus"},
+	reflect.TypeOf(PaymentReconciliation{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "period", "created", "paymentIssuer", "request", "requestor", "outcome", "disposition", "paymentDate", "paymentAmount", "paymentIdentifier", "detail", "formCode", "processNote"},
+	reflect.TypeOf(PaymentReconciliationDetail{}):                                                      {"id", "extension", "modifierExtension", "identifier", "predecessor", "type", "request", "submitter", "response", "date", "responsible", "payee", "amount"},
+	reflect.TypeOf(PaymentReconciliationProcessNote{}):                                                 {"id", "extension", "modifierExtension", "type", "text"},
+	reflect.TypeOf(Person{}):                                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "telecom", "gender", "birthDate", "address", "photo", "managingOrganization", "active", "link"},
+	reflect.TypeOf(PersonLink{}):                                                                       {"id", "extension", "modifierExtension", "target", "assurance"},
+	reflect.TypeOf(PlanDefinition{}):                                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "endorser", "relatedArtifact", "goal", "action"},
+	reflect.TypeOf(PlanDefinitionAction{}):                                                             {"id", "extension", "modifierExtension", "prefix", "title", "description", "textEquivalent", "priority", "code", "reason", "documentation", "goalId", "subjectCodeableConcept", "subjectReference", "trigger", "condition", "input", "output", "relatedAction", "timingDateTime", "timingAge", "timingPeriod", "timingDuration", "timingRange", "timingTiming", "participant", "type", "groupingBehavior", "selectionBehavior", "requiredBehavior", "precheckBehavior", "cardinalityBehavior", "definitionCanonical", "definitionUri", "transform", "dynamicValue", "action"},
+	reflect.TypeOf(PlanDefinitionActionCondition{}):                                                    {"id", "extension", "modifierExtension", "kind", "expression"},
+	reflect.TypeOf(PlanDefinitionActionDynamicValue{}):                                                 {"id", "extension", "modifierExtension", "path", "expression"},
+	reflect.TypeOf(PlanDefinitionActionParticipant{}):                                                  {"id", "extension", "modifierExtension", "type", "role"},
+	reflect.TypeOf(PlanDefinitionActionRelatedAction{}):                                                {"id", "extension", "modifierExtension", "actionId", "relationship", "offsetDuration", "offsetRange"},
+	reflect.TypeOf(PlanDefinitionGoal{}):                                                               {"id", "extension", "modifierExtension", "category", "description", "priority", "start", "addresses", "documentation", "target"},
+	reflect.TypeOf(PlanDefinitionGoalTarget{}):                                                         {"id", "extension", "modifierExtension", "measure", "detailQuantity", "detailRange", "detailCodeableConcept", "due"},
+	reflect.TypeOf(Practitioner{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "address", "gender", "birthDate", "photo", "qualification", "communication"},
+	reflect.TypeOf(PractitionerRole{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "period", "practitioner", "organization", "code", "specialty", "location", "healthcareService", "telecom", "availableTime", "notAvailable", "availabilityExceptions", "endpoint"},
+	reflect.TypeOf(PractitionerRoleAvailableTime{}):                                                    {"id", "extension", "modifierExtension", "daysOfWeek", "availableStartTime", "availableEndTime"},
+	reflect.TypeOf(Procedure{}):                                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "statusReason", "category", "code", "subject", "encounter", "performedDateTime", "performedPeriod", "performedString", "performedAge", "performedRange", "recorder", "asserter", "performer", "location", "reasonCode", "reasonReference", "bodySite", "outcome", "report", "complication", "complicationDetail", "followUp", "note", "focalDevice", "usedReference", "usedCode"},
+	reflect.TypeOf(ProductShelfLife{}):                                                                 {"id", "extension", "modifierExtension", "type", "period", "specialPrecautionsForStorage"},
+	reflect.TypeOf(Provenance{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "target", "occurredPeriod", "occurredDateTime", "recorded", "policy", "location", "reason", "activity", "agent", "entity", "signature"},
+	reflect.TypeOf(Questionnaire{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "derivedFrom", "status", "experimental", "subjectType", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "code", "item"},
+	reflect.TypeOf(QuestionnaireItem{}):                                                                {"id", "extension", "modifierExtension", "linkId", "definition", "code", "prefix", "text", "type", "enableWhen", "enableBehavior", "required", "repeats", "readOnly", "maxLength", "answerValueSet", "answerOption", "initial", "item"},
+	reflect.TypeOf(QuestionnaireItemAnswerOption{}):                                                    {"id", "extension", "modifierExtension", "valueInteger", "valueDate", "valueTime", "valueString", "valueCoding", "valueReference", "initialSelected"},
+	reflect.TypeOf(QuestionnaireItemEnableWhen{}):                                                      {"id", "extension", "modifierExtension", "question", "operator", "answerBoolean", "answerDecimal", "answerInteger", "answerDate", "answerDateTime", "answerTime", "answerString", "answerCoding", "answerQuantity", "answerReference"},
+	reflect.TypeOf(QuestionnaireResponse{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "questionnaire", "status", "subject", "encounter", "authored", "author", "source", "item"},
+	reflect.TypeOf(RelatedPerson{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "patient", "relationship", "name", "telecom", "gender", "birthDate", "address", "photo", "period", "communication"},
+	reflect.TypeOf(RequestGroup{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "replaces", "groupIdentifier", "status", "intent", "priority", "code", "subject", "encounter", "authoredOn", "author", "reasonCode", "reasonReference", "note", "action"},
+	reflect.TypeOf(RequestGroupAction{}):                                                               {"id", "extension", "modifierExtension", "prefix", "title", "description", "textEquivalent", "priority", "code", "documentation", "condition", "relatedAction", "timingDateTime", "timingAge", "timingPeriod", "timingDuration", "timingRange", "timingTiming", "participant", "type", "groupingBehavior", "selectionBehavior", "requiredBehavior", "precheckBehavior", "cardinalityBehavior", "resource", "action"},
+	reflect.TypeOf(RequestGroupActionCondition{}):                                                      {"id", "extension", "modifierExtension", "kind", "expression"},
+	reflect.TypeOf(RequestGroupActionRelatedAction{}):                                                  {"id", "extension", "modifierExtension", "actionId", "relationship", "offsetDuration", "offsetRange"},
+	reflect.TypeOf(ResearchDefinition{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "shortTitle", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "comment", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "population", "exposure", "exposureAlternative", "outcome"},
+	reflect.TypeOf(ResearchElementDefinition{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "shortTitle", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "comment", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "type", "variableType", "characteristic"},
+	reflect.TypeOf(ResearchElementDefinitionCharacteristic{}):                                          {"id", "extension", "modifierExtension", "definitionCodeableConcept", "definitionCanonical", "definitionExpression", "definitionDataRequirement", "usageContext", "exclude", "unitOfMeasure", "studyEffectiveDescription", "studyEffectiveDateTime", "studyEffectivePeriod", "studyEffectiveDuration", "studyEffectiveTiming", "studyEffectiveTimeFromStart", "studyEffectiveGroupMeasure", "participantEffectiveDescription", "participantEffectiveDateTime", "participantEffectivePeriod", "participantEffectiveDuration", "participantEffectiveTiming", "participantEffectiveTimeFromStart", "participantEffectiveGroupMeasure"},
+	reflect.TypeOf(ResearchStudy{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "title", "protocol", "partOf", "status", "primaryPurposeType", "phase", "category", "focus", "condition", "contact", "relatedArtifact", "keyword", "location", "description", "enrollment", "period", "sponsor", "principalInvestigator", "site", "reasonStopped", "note", "arm", "objective"},
+	reflect.TypeOf(ResearchStudyArm{}):                                                                 {"id", "extension", "modifierExtension", "name", "type", "description"},
+	reflect.TypeOf(ResearchSubject{}):                                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "period", "study", "individual", "assignedArm", "actualArm", "consent"},
+	reflect.TypeOf(RiskAssessment{}):                                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "parent", "status", "method", "code", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "condition", "performer", "reasonCode", "reasonReference", "basis", "prediction", "mitigation", "note"},
+	reflect.TypeOf(RiskAssessmentPrediction{}):                                                         {"id", "extension", "modifierExtension", "outcome", "probabilityDecimal", "probabilityRange", "qualitativeRisk", "relativeRisk", "whenPeriod", "whenRange", "rationale"},
+	reflect.TypeOf(RiskEvidenceSynthesis{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "date", "publisher", "contact", "description", "note", "useContext", "jurisdiction", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "synthesisType", "studyType", "population", "exposure", "outcome", "sampleSize", "riskEstimate", "certainty"},
+	reflect.TypeOf(RiskEvidenceSynthesisCertainty{}):                                                   {"id", "extension", "modifierExtension", "rating", "note", "certaintySubcomponent"},
+	reflect.TypeOf(RiskEvidenceSynthesisCertaintyCertaintySubcomponent{}):                              {"id", "extension", "modifierExtension", "type", "rating", "note"},
+	reflect.TypeOf(RiskEvidenceSynthesisRiskEstimate{}):                                                {"id", "extension", "modifierExtension", "description", "type", "value", "unitOfMeasure", "denominatorCount", "numeratorCount", "precisionEstimate"},
+	reflect.TypeOf(RiskEvidenceSynthesisRiskEstimatePrecisionEstimate{}):                               {"id", "extension", "modifierExtension", "type", "level", "from", "to"},
+	reflect.TypeOf(RiskEvidenceSynthesisSampleSize{}):                                                  {"id", "extension", "modifierExtension", "description", "numberOfStudies", "numberOfParticipants"},
+	reflect.TypeOf(Schedule{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "serviceCategory", "serviceType", "specialty", "actor", "planningHorizon", "comment"},
+	reflect.TypeOf(SearchParameter{}):                                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "derivedFrom", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "code", "base", "type", "expression", "xpath", "xpathUsage", "target", "multipleOr", "multipleAnd", "comparator", "modifier", "chain", "component"},
+	reflect.TypeOf(ServiceRequest{}):                                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "replaces", "requisition", "status", "intent", "category", "priority", "doNotPerform", "code", "orderDetail", "quantityQuantity", "quantityRatio", "quantityRange", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "asNeededBoolean", "asNeededCodeableConcept", "authoredOn", "requester", "performerType", "performer", "locationCode", "locationReference", "reasonCode", "reasonReference", "insurance", "supportingInfo", "specimen", "bodySite", "note", "patientInstruction", "relevantHistory"},
+	reflect.TypeOf(Slot{}):                                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "serviceCategory", "serviceType", "specialty", "appointmentType", "schedule", "status", "start", "end", "overbooked", "comment"},
+	reflect.TypeOf(SpecimenDefinition{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "typeCollected", "patientPreparation", "timeAspect", "collection", "typeTested"},
+	reflect.TypeOf(SpecimenDefinitionTypeTested{}):                                                     {"id", "extension", "modifierExtension", "isDerived", "type", "container", "handling", "patientPreparation", "timeAspect"},
+	reflect.TypeOf(SpecimenDefinitionTypeTestedContainer{}):                                            {"id", "extension", "modifierExtension", "material", "type", "cap", "description", "capacity", "minimumVolumeQuantity", "minimumVolumeString", "additive", "preparation"},
+	reflect.TypeOf(SpecimenDefinitionTypeTestedHandling{}):                                             {"id", "extension", "modifierExtension", "temperatureQualifier", "temperatureRange", "maxDuration", "instruction"},
+	reflect.TypeOf(StructureDefinition{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "keyword", "fhirVersion", "mapping", "kind", "abstract", "context", "contextInvariant", "type", "baseDefinition", "derivation", "snapshot", "differential"},
+	reflect.TypeOf(StructureDefinitionContext{}):                                                       {"id", "extension", "modifierExtension", "type", "expression"},
+	reflect.TypeOf(StructureDefinitionMapping{}):                                                       {"id", "extension", "modifierExtension", "identity", "uri", "name", "comment"},
+	reflect.TypeOf(StructureMap{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "structure", "import", "group"},
+	reflect.TypeOf(StructureMapGroup{}):                                                                {"id", "extension", "modifierExtension", "name", "extends", "typeMode", "documentation", "input", "rule"},
+	reflect.TypeOf(StructureMapGroupInput{}):                                                           {"id", "extension", "modifierExtension", "name", "type", "mode", "documentation"},
+	reflect.TypeOf(StructureMapGroupRuleSource{}):                                                      {"id", "extension", "modifierExtension", "context", "defaultValueBase64Binary", "defaultValueBoolean", "defaultValueCanonical", "defaultValueCode", "defaultValueDate", "defaultValueDateTime", "defaultValueDecimal", "defaultValueId", "defaultValueInstant", "defaultValueInteger", "defaultValueMarkdown", "defaultValueOid", "defaultValuePositiveInt", "defaultValueString", "defaultValueTime", "defaultValueUnsignedInt", "defaultValueUri", "defaultValueUrl", "defaultValueUuid", "defaultValueAddress", "defaultValueAge", "defaultValueAnnotation", "defaultValueAttachment", "defaultValueCodeableConcept", "defaultValueCoding", "defaultValueContactPoint", "defaultValueCount", "defaultValueDistance", "defaultValueDuration", "defaultValueHumanName", "defaultValueIdentifier", "defaultValueMoney", "defaultValuePeriod", "defaultValueQuantity", "defaultValueRange", "defaultValueRatio", "defaultValueReference", "defaultValueSampledData", "defaultValueSignature", "defaultValueTiming", "defaultValueMeta", "element", "listMode", "variable", "condition", "check", "first", "last", "logMessage"},
+	reflect.TypeOf(StructureMapStructure{}):                                                            {"id", "extension", "modifierExtension", "url", "mode", "alias", "documentation"},
+	reflect.TypeOf(Subscription{}):                                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "status", "contact", "end", "reason", "criteria", "error", "channel"},
+	reflect.TypeOf(SubscriptionChannel{}):                                                              {"id", "extension", "modifierExtension", "type", "endpoint", "payload", "header"},
+	reflect.TypeOf(Substance{}):                                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "description", "instance", "ingredient"},
+	reflect.TypeOf(SubstanceInstance{}):                                                                {"id", "extension", "modifierExtension", "identifier", "expiry", "quantity"},
+	reflect.TypeOf(SubstanceNucleicAcid{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "sequenceType", "numberOfSubunits", "areaOfHybridisation", "oligoNucleotideType", "subunit"},
+	reflect.TypeOf(SubstanceNucleicAcidSubunit{}):                                                      {"id", "extension", "modifierExtension", "subunit", "sequence", "length", "sequenceAttachment", "fivePrime", "threePrime", "linkage", "sugar"},
+	reflect.TypeOf(SubstancePolymer{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "class", "geometry", "copolymerConnectivity", "modification", "monomerSet", "repeat"},
+	reflect.TypeOf(SubstancePolymerMonomerSetStartingMaterial{}):                                       {"id", "extension", "modifierExtension", "material", "type", "isDefining", "amount"},
+	reflect.TypeOf(SubstancePolymerRepeat{}):                                                           {"id", "extension", "modifierExtension", "numberOfUnits", "averageMolecularFormula", "repeatUnitAmountType", "repeatUnit"},
+	reflect.TypeOf(SubstancePolymerRepeatRepeatUnit{}):                                                 {"id", "extension", "modifierExtension", "orientationOfPolymerisation", "repeatUnit", "amount", "degreeOfPolymerisation", "structuralRepresentation"},
+	reflect.TypeOf(SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation{}):                           {"id", "extension", "modifierExtension", "degree", "amount"},
+	reflect.TypeOf(SubstancePolymerRepeatRepeatUnitStructuralRepresentation{}):                         {"id", "extension", "modifierExtension", "type", "representation", "attachment"},
+	reflect.TypeOf(SubstanceProtein{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "sequenceType", "numberOfSubunits", "disulfideLinkage", "subunit"},
+	reflect.TypeOf(SubstanceProteinSubunit{}):                                                          {"id", "extension", "modifierExtension", "subunit", "sequence", "length", "sequenceAttachment", "nTerminalModificationId", "nTerminalModification", "cTerminalModificationId", "cTerminalModification"},
+	reflect.TypeOf(SubstanceReferenceInformation{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "comment", "gene", "geneElement", "classification", "target"},
+	reflect.TypeOf(SubstanceReferenceInformationClassification{}):                                      {"id", "extension", "modifierExtension", "domain", "classification", "subtype", "source"},
+	reflect.TypeOf(SubstanceReferenceInformationGene{}):                                                {"id", "extension", "modifierExtension", "geneSequenceOrigin", "gene", "source"},
+	reflect.TypeOf(SubstanceReferenceInformationGeneElement{}):                                         {"id", "extension", "modifierExtension", "type", "element", "source"},
+	reflect.TypeOf(SubstanceReferenceInformationTarget{}):                                              {"id", "extension", "modifierExtension", "target", "type", "interaction", "organism", "organismType", "amountQuantity", "amountRange", "amountString", "amountType", "source"},
+	reflect.TypeOf(SubstanceSourceMaterial{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "sourceMaterialClass", "sourceMaterialType", "sourceMaterialState", "organismId", "organismName", "parentSubstanceId", "parentSubstanceName", "countryOfOrigin", "geographicalLocation", "developmentStage", "fractionDescription", "organism", "partDescription"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganism{}):                                                  {"id", "extension", "modifierExtension", "family", "genus", "species", "intraspecificType", "intraspecificDescription", "author", "hybrid", "organismGeneral"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganismAuthor{}):                                            {"id", "extension", "modifierExtension", "authorType", "authorDescription"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganismHybrid{}):                                            {"id", "extension", "modifierExtension", "maternalOrganismId", "maternalOrganismName", "paternalOrganismId", "paternalOrganismName", "hybridType"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganismOrganismGeneral{}):                                   {"id", "extension", "modifierExtension", "kingdom", "phylum", "class", "order"},
+	reflect.TypeOf(SubstanceSpecification{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "status", "domain", "description", "source", "comment", "moiety", "property", "referenceInformation", "structure", "code", "name", "molecularWeight", "relationship", "nucleicAcid", "polymer", "protein", "sourceMaterial"},
+	reflect.TypeOf(SubstanceSpecificationCode{}):                                                       {"id", "extension", "modifierExtension", "code", "status", "statusDate", "comment", "source"},
+	reflect.TypeOf(SubstanceSpecificationMoiety{}):                                                     {"id", "extension", "modifierExtension", "role", "identifier", "name", "stereochemistry", "opticalActivity", "molecularFormula", "amountQuantity", "amountString"},
+	reflect.TypeOf(SubstanceSpecificationName{}):                                                       {"id", "extension", "modifierExtension", "name", "type", "status", "preferred", "language", "domain", "jurisdiction", "official", "source"},
+	reflect.TypeOf(SubstanceSpecificationNameOfficial{}):                                               {"id", "extension", "modifierExtension", "authority", "status", "date"},
+	reflect.TypeOf(SubstanceSpecificationProperty{}):                                                   {"id", "extension", "modifierExtension", "category", "code", "parameters", "definingSubstanceReference", "definingSubstanceCodeableConcept", "amountQuantity", "amountString"},
+	reflect.TypeOf(SubstanceSpecificationStructure{}):                                                  {"id", "extension", "modifierExtension", "stereochemistry", "opticalActivity", "molecularFormula", "molecularFormulaByMoiety", "isotope", "molecularWeight", "source", "representation"},
+	reflect.TypeOf(SubstanceSpecificationStructureIsotope{}):                                           {"id", "extension", "modifierExtension", "identifier", "name", "substitution", "halfLife", "molecularWeight"},
+	reflect.TypeOf(SubstanceSpecificationStructureIsotopeMolecularWeight{}):                            {"id", "extension", "modifierExtension", "method", "type", "amount"},
+	reflect.TypeOf(SubstanceSpecificationStructureRepresentation{}):                                    {"id", "extension", "modifierExtension", "type", "representation", "attachment"},
+	reflect.TypeOf(SupplyDelivery{}):                                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "patient", "type", "suppliedItem", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "supplier", "destination", "receiver"},
+	reflect.TypeOf(SupplyDeliverySuppliedItem{}):                                                       {"id", "extension", "modifierExtension", "quantity", "itemCodeableConcept", "itemReference"},
+	reflect.TypeOf(SupplyRequest{}):                                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "priority", "itemCodeableConcept", "itemReference", "quantity", "parameter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "authoredOn", "requester", "supplier", "reasonCode", "reasonReference", "deliverFrom", "deliverTo"},
+	reflect.TypeOf(Task{}):                                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "groupIdentifier", "partOf", "status", "statusReason", "businessStatus", "intent", "priority", "code", "description", "focus", "for", "encounter", "executionPeriod", "authoredOn", "lastModified", "requester", "performerType", "owner", "location", "reasonCode", "reasonReference", "insurance", "note", "relevantHistory", "restriction", "input", "output"},
+	reflect.TypeOf(TaskRestriction{}):                                                                  {"id", "extension", "modifierExtension", "repetitions", "period", "recipient"},
+	reflect.TypeOf(TerminologyCapabilities{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "kind", "software", "implementation", "lockedDate", "codeSystem", "expansion", "codeSearch", "validateCode", "translation", "closure"},
+	reflect.TypeOf(TerminologyCapabilitiesCodeSystemVersion{}):                                         {"id", "extension", "modifierExtension", "code", "isDefault", "compositional", "language", "filter", "property"},
+	reflect.TypeOf(TestReport{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "status", "testScript", "result", "score", "tester", "issued", "participant", "setup", "test", "teardown"},
+	reflect.TypeOf(TestReportParticipant{}):                                                            {"id", "extension", "modifierExtension", "type", "uri", "display"},
+	reflect.TypeOf(TestReportSetupAction{}):                                                            {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestReportSetupActionAssert{}):                                                      {"id", "extension", "modifierExtension", "result", "message", "detail"},
+	reflect.TypeOf(TestReportSetupActionOperation{}):                                                   {"id", "extension", "modifierExtension", "result", "message", "detail"},
+	reflect.TypeOf(TestReportTest{}):                                                                   {"id", "extension", "modifierExtension", "name", "description", "action"},
+	reflect.TypeOf(TestReportTestAction{}):                                                             {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScript{}):                                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "origin", "destination", "metadata", "fixture", "profile", "variable", "setup", "test", "teardown"},
+	reflect.TypeOf(TestScriptMetadata{}):                                                               {"id", "extension", "modifierExtension", "link", "capability"},
+	reflect.TypeOf(TestScriptMetadataCapability{}):                                                     {"id", "extension", "modifierExtension", "required", "validated", "description", "origin", "destination", "link", "capabilities"},
+	reflect.TypeOf(TestScriptMetadataLink{}):                                                           {"id", "extension", "modifierExtension", "url", "description"},
+	reflect.TypeOf(TestScriptSetupAction{}):                                                            {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(TestScriptTestAction{}):                                                             {"id", "extension", "modifierExtension", "operation", "assert"},
+	reflect.TypeOf(ValueSet{}):                                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "immutable", "purpose", "copyright", "compose", "expansion"},
+	reflect.TypeOf(ValueSetCompose{}):                                                                  {"id", "extension", "modifierExtension", "lockedDate", "inactive", "include", "exclude"},
+	reflect.TypeOf(ValueSetComposeInclude{}):                                                           {"id", "extension", "modifierExtension", "system", "version", "concept", "filter", "valueSet"},
+	reflect.TypeOf(ValueSetComposeIncludeConcept{}):                                                    {"id", "extension", "modifierExtension", "code", "display", "designation"},
+	reflect.TypeOf(ValueSetComposeIncludeFilter{}):                                                     {"id", "extension", "modifierExtension", "property", "op", "value"},
+	reflect.TypeOf(ValueSetExpansion{}):                                                                {"id", "extension", "modifierExtension", "identifier", "timestamp", "total", "offset", "parameter", "contains"},
+	reflect.TypeOf(ValueSetExpansionContains{}):                                                        {"id", "extension", "modifierExtension", "system", "abstract", "inactive", "version", "code", "display", "contains"},
+	reflect.TypeOf(VerificationResult{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "target", "targetLocation", "need", "status", "statusDate", "validationType", "validationProcess", "frequency", "lastPerformed", "nextScheduled", "failureAction", "primarySource", "attestation", "validator"},
+	reflect.TypeOf(VerificationResultAttestation{}):                                                    {"id", "extension", "modifierExtension", "who", "onBehalfOf", "communicationMethod", "date", "sourceIdentityCertificate", "proxyIdentityCertificate", "proxySignature", "sourceSignature"},
+	reflect.TypeOf(VerificationResultPrimarySource{}):                                                  {"id", "extension", "modifierExtension", "who", "type", "communicationMethod", "validationStatus", "validationDate", "canPushUpdates", "pushTypeAvailable"},
+	reflect.TypeOf(VerificationResultValidator{}):                                                      {"id", "extension", "modifierExtension", "organization", "identityCertificate", "attestationSignature"},
+	reflect.TypeOf(VisionPrescription{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "patient", "encounter", "dateWritten", "prescriber", "lensSpecification"},
+	reflect.TypeOf(VisionPrescriptionLensSpecification{}):                                              {"id", "extension", "modifierExtension", "product", "eye", "sphere", "cylinder", "axis", "prism", "add", "power", "backCurve", "diameter", "duration", "color", "brand", "note"},
+}
@@ -70,7 +70,8 @@ type Encounter struct {
 	ServiceType *common.CodeableConcept `json:"serviceType,omitempty"`
 
 	// Note that internal business rules will determine the appropriate transitions
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The current status is always found in the current version of the resource
 	StatusHistory []EncounterStatusHistory `json:"statusHistory,omitempty"`
@@ -115,7 +116,8 @@ type EncounterStatusHistory struct {
 	Period common.Period `json:"period"`
 
 	// planned | arrived | triaged | in-progress | onleave | finished | cancelled | entered-in-error | unknown
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // EncounterClassHistory represents the class history of the encounter
@@ -151,7 +153,8 @@ type EncounterDiagnosis struct {
 	Condition common.Reference `json:"condition"`
 
 	// Ranking of the diagnosis (for each role type)
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// Role that this diagnosis has within the encounter (e.g. admission, billing, discharge)
 	Use *common.CodeableConcept `json:"use,omitempty"`
@@ -203,5 +206,6 @@ type EncounterLocation struct {
 	PhysicalType *common.CodeableConcept `json:"physicalType,omitempty"`
 
 	// When the patient is no longer active at a location
-	Status *EncounterLocationStatus `json:"status,omitempty"`
+	Status        *EncounterLocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
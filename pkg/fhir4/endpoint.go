@@ -12,7 +12,8 @@ type Endpoint struct {
 	ResourceType string `json:"resourceType"` // Always "Endpoint"
 
 	// For rest-hook, and websocket, the end-point must be an http: or https: URL
-	Address string `json:"address"`
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
 
 	// For additional connectivity details for the protocol, extensions will be used at this point
 	ConnectionType common.Coding `json:"connectionType"`
@@ -21,7 +22,8 @@ type Endpoint struct {
 	Contact []common.ContactPoint `json:"contact,omitempty"`
 
 	// Exactly what these mean depends on the channel type
-	Header []string `json:"header,omitempty"`
+	Header        []string          `json:"header,omitempty"`
+	HeaderElement []*common.Element `json:"_header,omitempty"`
 
 	// Identifier for the organization that is used to identify the endpoint across multiple disparate systems
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -30,10 +32,12 @@ type Endpoint struct {
 	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
 
 	// A friendly name that this endpoint can be referred to with
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Sending the payload has obvious security consequences
-	PayloadMimeType []string `json:"payloadMimeType,omitempty"`
+	PayloadMimeType        []string          `json:"payloadMimeType,omitempty"`
+	PayloadMimeTypeElement []*common.Element `json:"_payloadMimeType,omitempty"`
 
 	// The payloadFormat describes the serialization format of the data
 	PayloadType []common.CodeableConcept `json:"payloadType"`
@@ -42,7 +46,8 @@ type Endpoint struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the endpoint as not currently valid
-	Status EndpointStatus `json:"status"`
+	Status        EndpointStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // EndpointStatus represents the status of the endpoint
@@ -39,7 +39,8 @@ type EpisodeOfCare struct {
 	StatusHistory []EpisodeOfCareStatusHistory `json:"statusHistory,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the code entered-in-error that marks the episode as not currently valid
-	Status EpisodeOfCareStatus `json:"status"`
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// A classification of the type of episode of care; e.g. specialist referral, disease management, type of funded care
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -66,7 +67,8 @@ type EpisodeOfCareStatusHistory struct {
 	Period common.Period `json:"period"`
 
 	// planned | waitlist | active | onhold | finished | cancelled
-	Status EpisodeOfCareStatus `json:"status"`
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 }
 
 // EpisodeOfCareDiagnosis represents the list of diagnosis relevant to this episode of care
@@ -77,7 +79,8 @@ type EpisodeOfCareDiagnosis struct {
 	Condition common.Reference `json:"condition"`
 
 	// Ranking of the diagnosis (for each role type)
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// Role that this diagnosis has within the episode of care (e.g. admission, billing, discharge …)
 	Role *common.CodeableConcept `json:"role,omitempty"`
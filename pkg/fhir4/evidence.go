@@ -12,7 +12,8 @@ type Evidence struct {
 	ResourceType string `json:"resourceType"` // Always "Evidence"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -21,13 +22,16 @@ type Evidence struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the evidence and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the evidence was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -51,19 +55,23 @@ type Evidence struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A reference to a EvidenceVariable resource that defines the outcome for the research
 	Outcome []common.Reference `json:"outcome,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the evidence
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Each related resource is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -72,28 +80,34 @@ type Evidence struct {
 	Reviewer []common.ContactDetail `json:"reviewer,omitempty"`
 
 	// Allows filtering of evidence that are appropriate for use versus not
-	Status EvidenceStatus `json:"status"`
+	Status        EvidenceStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// An explanatory or alternate title for the evidence giving additional information about its content
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the module
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description of how the evidence is used from a clinical perspective
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different evidence instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // EvidenceStatus represents the status of the evidence
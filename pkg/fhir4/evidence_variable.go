@@ -12,7 +12,8 @@ type EvidenceVariable struct {
 	ResourceType string `json:"resourceType"` // Always "EvidenceVariable"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -24,13 +25,16 @@ type EvidenceVariable struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the evidence variable and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the evidence variable was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -48,16 +52,19 @@ type EvidenceVariable struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A human-readable string to clarify or explain concepts about the resource
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -66,31 +73,38 @@ type EvidenceVariable struct {
 	Reviewer []common.ContactDetail `json:"reviewer,omitempty"`
 
 	// The short title provides an alternate title for use in informal descriptive contexts
-	ShortTitle *string `json:"shortTitle,omitempty"`
+	ShortTitle        *string         `json:"shortTitle,omitempty"`
+	ShortTitleElement *common.Element `json:"_shortTitle,omitempty"`
 
 	// Allows filtering of evidence variables that are appropriate for use versus not
-	Status EvidenceVariableStatus `json:"status"`
+	Status        EvidenceVariableStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// An explanatory or alternate title for the EvidenceVariable giving additional information about its content
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the EvidenceVariable
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// The type of evidence element, a population, an exposure, or an outcome
-	Type *EvidenceVariableType `json:"type,omitempty"`
+	Type        *EvidenceVariableType `json:"type,omitempty"`
+	TypeElement *common.Element       `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different evidence variable instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // EvidenceVariableStatus represents the status of the evidence variable
@@ -120,7 +134,8 @@ type EvidenceVariableCharacteristic struct {
 	DefinitionReference *common.Reference `json:"definitionReference,omitempty"`
 
 	// Define members of the evidence element using Codes
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty"`
+	DefinitionCanonical        *string         `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element `json:"_definitionCanonical,omitempty"`
 
 	// Define members of the evidence element using Codes
 	DefinitionCodeableConcept *common.CodeableConcept `json:"definitionCodeableConcept,omitempty"`
@@ -135,16 +150,20 @@ type EvidenceVariableCharacteristic struct {
 	DefinitionTriggerDefinition *common.TriggerDefinition `json:"definitionTriggerDefinition,omitempty"`
 
 	// A short, natural language description of the characteristic
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// When true, members with this characteristic are excluded from the element
-	Exclude *bool `json:"exclude,omitempty"`
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// Indicates how elements are aggregated within the study effective period
-	GroupMeasure *EvidenceVariableCharacteristicGroupMeasure `json:"groupMeasure,omitempty"`
+	GroupMeasure        *EvidenceVariableCharacteristicGroupMeasure `json:"groupMeasure,omitempty"`
+	GroupMeasureElement *common.Element                             `json:"_groupMeasure,omitempty"`
 
 	// Indicates what effective period the study covers
-	ParticipantEffectiveDateTime *string `json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTime        *common.DateTime `json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTimeElement *common.Element  `json:"_participantEffectiveDateTime,omitempty"`
 
 	// Indicates what effective period the study covers
 	ParticipantEffectivePeriod *common.Period `json:"participantEffectivePeriod,omitempty"`
@@ -18,13 +18,16 @@ type ExampleScenario struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// Frequently, the copyright differs between the value set and the codes that are included
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Allows filtering of example scenarios that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -36,31 +39,38 @@ type ExampleScenario struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Each major process - a group of operations
 	Process []ExampleScenarioProcess `json:"process,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the example scenario
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Allows filtering of example scenarios that are appropriate for use versus not
-	Status ExampleScenarioStatus `json:"status"`
+	Status        ExampleScenarioStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different example scenario instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// Another nested workflow
-	Workflow []string `json:"workflow,omitempty"`
+	Workflow        []string          `json:"workflow,omitempty"`
+	WorkflowElement []*common.Element `json:"_workflow,omitempty"`
 }
 
 // ExampleScenarioStatus represents the status of the example scenario
@@ -78,16 +88,20 @@ type ExampleScenarioActor struct {
 	common.BackboneElement
 
 	// Should this be called ID or acronym?
-	ActorId string `json:"actorId"`
+	ActorId        string          `json:"actorId"`
+	ActorIdElement *common.Element `json:"_actorId,omitempty"`
 
 	// Cardinality: is name and description 1..1?
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Cardinality: is name and description 1..1?
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of actor - person or system
-	Type ExampleScenarioActorType `json:"type"`
+	Type        ExampleScenarioActorType `json:"type"`
+	TypeElement *common.Element          `json:"_type,omitempty"`
 }
 
 // ExampleScenarioActorType represents the type of actor
@@ -106,13 +120,16 @@ type ExampleScenarioInstance struct {
 	ContainedInstance []ExampleScenarioInstanceContainedInstance `json:"containedInstance,omitempty"`
 
 	// Human-friendly description of the resource instance
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A short name for the resource instance
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The id of the resource for referencing
-	ResourceId string `json:"resourceId"`
+	ResourceId        string          `json:"resourceId"`
+	ResourceIdElement *common.Element `json:"_resourceId,omitempty"`
 
 	// The type of the resource
 	ResourceType string `json:"resourceType"`
@@ -126,10 +143,12 @@ type ExampleScenarioInstanceVersion struct {
 	common.BackboneElement
 
 	// The description of the resource version
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The identifier of a specific version of a resource
-	VersionId string `json:"versionId"`
+	VersionId        string          `json:"versionId"`
+	VersionIdElement *common.Element `json:"_versionId,omitempty"`
 }
 
 // ExampleScenarioInstanceContainedInstance represents resources contained in the instance
@@ -137,10 +156,12 @@ type ExampleScenarioInstanceContainedInstance struct {
 	common.BackboneElement
 
 	// Each resource contained in the instance
-	ResourceId string `json:"resourceId"`
+	ResourceId        string          `json:"resourceId"`
+	ResourceIdElement *common.Element `json:"_resourceId,omitempty"`
 
 	// A specific version of a resource contained in the instance
-	VersionId *string `json:"versionId,omitempty"`
+	VersionId        *string         `json:"versionId,omitempty"`
+	VersionIdElement *common.Element `json:"_versionId,omitempty"`
 }
 
 // ExampleScenarioProcess represents each major process - a group of operations
@@ -148,19 +169,23 @@ type ExampleScenarioProcess struct {
 	common.BackboneElement
 
 	// A longer description of the group of operations
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Description of final status after the process ends
-	PostConditions *string `json:"postConditions,omitempty"`
+	PostConditions        *string         `json:"postConditions,omitempty"`
+	PostConditionsElement *common.Element `json:"_postConditions,omitempty"`
 
 	// Description of initial status before the process starts
-	PreConditions *string `json:"preConditions,omitempty"`
+	PreConditions        *string         `json:"preConditions,omitempty"`
+	PreConditionsElement *common.Element `json:"_preConditions,omitempty"`
 
 	// Each step of the process
 	Step []ExampleScenarioProcessStep `json:"step,omitempty"`
 
 	// The diagram title of the group of operations
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ExampleScenarioProcessStep represents each step of the process
@@ -174,7 +199,8 @@ type ExampleScenarioProcessStep struct {
 	Operation *ExampleScenarioProcessStepOperation `json:"operation,omitempty"`
 
 	// If there is a pause in the flow
-	Pause *bool `json:"pause,omitempty"`
+	Pause        *bool           `json:"pause,omitempty"`
+	PauseElement *common.Element `json:"_pause,omitempty"`
 
 	// Nested process
 	Process []ExampleScenarioProcess `json:"process,omitempty"`
@@ -185,13 +211,15 @@ type ExampleScenarioProcessStepAlternative struct {
 	common.BackboneElement
 
 	// A human-readable description of the alternative explaining when the alternative should occur
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// What happens in each alternative option
 	Step []ExampleScenarioProcessStep `json:"step,omitempty"`
 
 	// The label to display for the alternative that gives a sense of the circumstance
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ExampleScenarioProcessStepOperation represents each interaction or action
@@ -199,25 +227,32 @@ type ExampleScenarioProcessStepOperation struct {
 	common.BackboneElement
 
 	// A comment to be inserted in the diagram
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Who starts the transaction
-	Initiator *string `json:"initiator,omitempty"`
+	Initiator        *string         `json:"initiator,omitempty"`
+	InitiatorElement *common.Element `json:"_initiator,omitempty"`
 
 	// Whether the initiator is deactivated right after the transaction
-	InitiatorActive *bool `json:"initiatorActive,omitempty"`
+	InitiatorActive        *bool           `json:"initiatorActive,omitempty"`
+	InitiatorActiveElement *common.Element `json:"_initiatorActive,omitempty"`
 
 	// The human-friendly name of the interaction
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The sequential number of the interaction, e.g. 1.2.5
-	Number string `json:"number"`
+	Number        string          `json:"number"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// Who receives the transaction
-	Receiver *string `json:"receiver,omitempty"`
+	Receiver        *string         `json:"receiver,omitempty"`
+	ReceiverElement *common.Element `json:"_receiver,omitempty"`
 
 	// Whether the receiver is deactivated right after the transaction
-	ReceiverActive *bool `json:"receiverActive,omitempty"`
+	ReceiverActive        *bool           `json:"receiverActive,omitempty"`
+	ReceiverActiveElement *common.Element `json:"_receiverActive,omitempty"`
 
 	// Each resource instance used by the initiator
 	Request *ExampleScenarioInstanceContainedInstance `json:"request,omitempty"`
@@ -226,5 +261,6 @@ type ExampleScenarioProcessStepOperation struct {
 	Response *ExampleScenarioInstanceContainedInstance `json:"response,omitempty"`
 
 	// The type of operation - CRUD
-	Type *string `json:"type,omitempty"`
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
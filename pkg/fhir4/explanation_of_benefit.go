@@ -39,13 +39,15 @@ type ExplanationOfBenefit struct {
 	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
 
 	// This field is independent of the date of creation of the resource as it may reflect the creation date of a source document prior to digitization
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// Information about diagnoses relevant to the claim items
 	Diagnosis []ExplanationOfBenefitDiagnosis `json:"diagnosis,omitempty"`
 
 	// A human readable description of the status of the adjudication
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// Individual who created the claim, predetermination or preauthorization
 	Enterer *common.Reference `json:"enterer,omitempty"`
@@ -81,7 +83,8 @@ type ExplanationOfBenefit struct {
 	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
 
 	// The resource may be used to indicate that: the request has been held (queued) for processing
-	Outcome ExplanationOfBenefitOutcome `json:"outcome"`
+	Outcome        ExplanationOfBenefitOutcome `json:"outcome"`
+	OutcomeElement *common.Element             `json:"_outcome,omitempty"`
 
 	// The party to whom the professional services and/or products have been supplied or are being considered
 	Patient common.Reference `json:"patient"`
@@ -93,13 +96,15 @@ type ExplanationOfBenefit struct {
 	Payment *ExplanationOfBenefitPayment `json:"payment,omitempty"`
 
 	// This value is only present on preauthorization adjudications
-	PreAuthRef []string `json:"preAuthRef,omitempty"`
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
 
 	// This value is only present on preauthorization adjudications
 	PreAuthRefPeriod []common.Period `json:"preAuthRefPeriod,omitempty"`
 
 	// This indicates the relative order of a series of EOBs related to different coverages for the same suite of services
-	Precedence *int `json:"precedence,omitempty"`
+	Precedence        *int            `json:"precedence,omitempty"`
+	PrecedenceElement *common.Element `json:"_precedence,omitempty"`
 
 	// Prescription to support the dispensing of pharmacy, device or vision products
 	Prescription *common.Reference `json:"prescription,omitempty"`
@@ -123,7 +128,8 @@ type ExplanationOfBenefit struct {
 	Related []ExplanationOfBenefitRelated `json:"related,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status ExplanationOfBenefitStatus `json:"status"`
+	Status        ExplanationOfBenefitStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
 
 	// This may contain the local bill type codes such as the US UB-04 bill type code
 	SubType *common.CodeableConcept `json:"subType,omitempty"`
@@ -138,7 +144,8 @@ type ExplanationOfBenefit struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// A code to indicate whether the nature of the request is: to request adjudication of products and services previously rendered
-	Use ExplanationOfBenefitUse `json:"use"`
+	Use        ExplanationOfBenefitUse `json:"use"`
+	UseElement *common.Element         `json:"_use,omitempty"`
 }
 
 // ExplanationOfBenefitOutcome represents the outcome of the explanation of benefit
@@ -206,13 +213,15 @@ type ExplanationOfBenefitCareTeam struct {
 	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
 
 	// Responsible might not be required when there is only a single provider listed
-	Responsible *bool `json:"responsible,omitempty"`
+	Responsible        *bool           `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
 
 	// Role might not be required when there is only a single provider listed
 	Role *common.CodeableConcept `json:"role,omitempty"`
 
 	// A number to uniquely identify care team entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 }
 
 // ExplanationOfBenefitSupportingInfo represents supporting information
@@ -229,19 +238,23 @@ type ExplanationOfBenefitSupportingInfo struct {
 	Reason *common.Coding `json:"reason,omitempty"`
 
 	// A number to uniquely identify supporting information entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// The date when or period to which this information refers
-	TimingDate *string `json:"timingDate,omitempty"`
+	TimingDate        *common.Date    `json:"timingDate,omitempty"`
+	TimingDateElement *common.Element `json:"_timingDate,omitempty"`
 
 	// The date when or period to which this information refers
 	TimingPeriod *common.Period `json:"timingPeriod,omitempty"`
 
 	// Could be used to provide references to other resources, document
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// Could be used to provide references to other resources, document
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// Could be used to provide references to other resources, document
 	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
@@ -267,7 +280,8 @@ type ExplanationOfBenefitDiagnosis struct {
 	PackageCode *common.CodeableConcept `json:"packageCode,omitempty"`
 
 	// A number to uniquely identify diagnosis entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// The type of diagnosis (for example, admitting, primary, secondary, discharge)
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -278,7 +292,8 @@ type ExplanationOfBenefitProcedure struct {
 	common.BackboneElement
 
 	// Date and optionally time the procedure was performed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The code or reference to a Procedure resource which identifies the clinical intervention performed
 	ProcedureCodeableConcept *common.CodeableConcept `json:"procedureCodeableConcept,omitempty"`
@@ -287,7 +302,8 @@ type ExplanationOfBenefitProcedure struct {
 	ProcedureReference *common.Reference `json:"procedureReference,omitempty"`
 
 	// Sequence of procedures which serves to order and provide a link
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// When the condition was observed or the relative ranking
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -304,16 +320,19 @@ type ExplanationOfBenefitInsurance struct {
 	Coverage common.Reference `json:"coverage"`
 
 	// A flag to indicate that this Coverage is to be used for adjudication of this claim when set to true
-	Focal *bool `json:"focal,omitempty"`
+	Focal        *bool           `json:"focal,omitempty"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
 
 	// Reference to the original request resource
-	PreAuthRef []string `json:"preAuthRef,omitempty"`
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
 
 	// Reference to the original request resource
 	PreAuthRefPeriod []common.Period `json:"preAuthRefPeriod,omitempty"`
 
 	// Sequence of the referenced insurance card level information contained in the Coverage resource
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 }
 
 // ExplanationOfBenefitAccident represents details of an accident
@@ -321,7 +340,8 @@ type ExplanationOfBenefitAccident struct {
 	common.BackboneElement
 
 	// Date of an accident event related to the products and services contained in the claim
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The physical location of the accident event
 	LocationAddress *common.Address `json:"locationAddress,omitempty"`
@@ -347,7 +367,8 @@ type ExplanationOfBenefitItemAdjudication struct {
 	Reason *common.CodeableConcept `json:"reason,omitempty"`
 
 	// A non-monetary value associated with the category
-	Value *float64 `json:"value,omitempty"`
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ExplanationOfBenefitItemDetailSubDetail represents sub-details of an item detail
@@ -370,7 +391,8 @@ type ExplanationOfBenefitItemDetailSubDetail struct {
 	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
 
 	// A number to uniquely identify sub-detail entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// A code to identify the general type of benefits under which products and services are provided
 	Service *common.CodeableConcept `json:"service,omitempty"`
@@ -402,7 +424,8 @@ type ExplanationOfBenefitItemDetail struct {
 	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
 
 	// A number to uniquely identify detail entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// A code to identify the general type of benefits under which products and services are provided
 	Service *common.CodeableConcept `json:"service,omitempty"`
@@ -428,7 +451,8 @@ type ExplanationOfBenefitItem struct {
 	BodySite []common.CodeableConcept `json:"bodySite,omitempty"`
 
 	// CareTeam members related to this service or product
-	CareTeamSequence []int `json:"careTeamSequence,omitempty"`
+	CareTeamSequence        []int             `json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement []*common.Element `json:"_careTeamSequence,omitempty"`
 
 	// The type of revenue or cost center providing the product and/or service
 	Category *common.CodeableConcept `json:"category,omitempty"`
@@ -437,7 +461,8 @@ type ExplanationOfBenefitItem struct {
 	Detail []ExplanationOfBenefitItemDetail `json:"detail,omitempty"`
 
 	// Diagnosis applicable for this service or product
-	DiagnosisSequence []int `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequence        []int             `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement []*common.Element `json:"_diagnosisSequence,omitempty"`
 
 	// Encounters related to this billed item
 	Encounter []common.Reference `json:"encounter,omitempty"`
@@ -452,13 +477,15 @@ type ExplanationOfBenefitItem struct {
 	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
 
 	// A number to uniquely identify item entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// A code to identify the general type of benefits under which products and services are provided
 	Service *common.CodeableConcept `json:"service,omitempty"`
 
 	// The date or dates when the service or product was supplied, performed or completed
-	ServicedDate *string `json:"servicedDate,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
 
 	// The date or dates when the service or product was supplied, performed or completed
 	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
@@ -542,7 +569,8 @@ type ExplanationOfBenefitAddItem struct {
 	BodySite []common.CodeableConcept `json:"bodySite,omitempty"`
 
 	// CareTeam members related to this service or product
-	CareTeamSequence []int `json:"careTeamSequence,omitempty"`
+	CareTeamSequence        []int             `json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement []*common.Element `json:"_careTeamSequence,omitempty"`
 
 	// The type of revenue or cost center providing the product and/or service
 	Category *common.CodeableConcept `json:"category,omitempty"`
@@ -551,7 +579,8 @@ type ExplanationOfBenefitAddItem struct {
 	Detail []ExplanationOfBenefitAddItemDetail `json:"detail,omitempty"`
 
 	// Diagnosis applicable for this service or product
-	DiagnosisSequence []int `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequence        []int             `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement []*common.Element `json:"_diagnosisSequence,omitempty"`
 
 	// Encounters related to this billed item
 	Encounter []common.Reference `json:"encounter,omitempty"`
@@ -569,7 +598,8 @@ type ExplanationOfBenefitAddItem struct {
 	Service *common.CodeableConcept `json:"service,omitempty"`
 
 	// The date or dates when the service or product was supplied, performed or completed
-	ServicedDate *string `json:"servicedDate,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
 
 	// The date or dates when the service or product was supplied, performed or completed
 	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
@@ -603,7 +633,8 @@ type ExplanationOfBenefitPayment struct {
 	AdjustmentReason *common.CodeableConcept `json:"adjustmentReason,omitempty"`
 
 	// Estimated date, based on the payment date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Benefits payable less any payment adjustment
 	Amount common.Money `json:"amount"`
@@ -620,10 +651,12 @@ type ExplanationOfBenefitProcessNote struct {
 	common.BackboneElement
 
 	// The explanation or description associated with the processing
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The business purpose of the note text
-	Type *ExplanationOfBenefitProcessNoteType `json:"type,omitempty"`
+	Type        *ExplanationOfBenefitProcessNoteType `json:"type,omitempty"`
+	TypeElement *common.Element                      `json:"_type,omitempty"`
 
 	// A code to define the language used in the text of the note
 	Language *common.CodeableConcept `json:"language,omitempty"`
@@ -646,16 +679,19 @@ type ExplanationOfBenefitBenefitBalanceFinancial struct {
 	AllowedMoney *common.Money `json:"allowedMoney,omitempty"`
 
 	// Benefits allowed
-	AllowedUnsignedInt *int `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedInt        *int            `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *common.Element `json:"_allowedUnsignedInt,omitempty"`
 
 	// Benefits allowed
-	AllowedString *string `json:"allowedString,omitempty"`
+	AllowedString        *string         `json:"allowedString,omitempty"`
+	AllowedStringElement *common.Element `json:"_allowedString,omitempty"`
 
 	// Benefits used
 	UsedMoney *common.Money `json:"usedMoney,omitempty"`
 
 	// Benefits used
-	UsedUnsignedInt *int `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedInt        *int            `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement *common.Element `json:"_usedUnsignedInt,omitempty"`
 
 	// Type of benefit (primary care; speciality care; inpatient; outpatient)
 	Type common.CodeableConcept `json:"type"`
@@ -672,13 +708,16 @@ type ExplanationOfBenefitBenefitBalance struct {
 	Category common.CodeableConcept `json:"category"`
 
 	// True if the indicated class of service is excluded from the plan, missing or False indicates the product or service is included in the coverage
-	Excluded *bool `json:"excluded,omitempty"`
+	Excluded        *bool           `json:"excluded,omitempty"`
+	ExcludedElement *common.Element `json:"_excluded,omitempty"`
 
 	// A short name or tag for the benefit
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A richer description of the benefit or services covered
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Is a flag to indicate whether the benefits refer to in-network providers or out-of-network providers
 	Network *common.CodeableConcept `json:"network,omitempty"`
@@ -18,16 +18,19 @@ type FamilyMemberHistory struct {
 	AgeRange *common.Range `json:"ageRange,omitempty"`
 
 	// Use estimatedAge to indicate whether the age is actual or not
-	AgeString *string `json:"ageString,omitempty"`
+	AgeString        *string         `json:"ageString,omitempty"`
+	AgeStringElement *common.Element `json:"_ageString,omitempty"`
 
 	// The actual or approximate date of birth of the relative
 	BornPeriod *common.Period `json:"bornPeriod,omitempty"`
 
 	// The actual or approximate date of birth of the relative
-	BornDate *string `json:"bornDate,omitempty"`
+	BornDate        *common.Date    `json:"bornDate,omitempty"`
+	BornDateElement *common.Element `json:"_bornDate,omitempty"`
 
 	// The actual or approximate date of birth of the relative
-	BornString *string `json:"bornString,omitempty"`
+	BornString        *string         `json:"bornString,omitempty"`
+	BornStringElement *common.Element `json:"_bornString,omitempty"`
 
 	// The significant Conditions (or condition) that the family member had
 	Condition []FamilyMemberHistoryCondition `json:"condition,omitempty"`
@@ -36,10 +39,12 @@ type FamilyMemberHistory struct {
 	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
 
 	// This should be captured even if the same as the date on the List aggregating the full family history
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Deceased flag or the actual or approximate age of the relative at the time of death
-	DeceasedBoolean *bool `json:"deceasedBoolean,omitempty"`
+	DeceasedBoolean        *bool           `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement *common.Element `json:"_deceasedBoolean,omitempty"`
 
 	// Deceased flag or the actual or approximate age of the relative at the time of death
 	DeceasedAge *common.Age `json:"deceasedAge,omitempty"`
@@ -48,25 +53,31 @@ type FamilyMemberHistory struct {
 	DeceasedRange *common.Range `json:"deceasedRange,omitempty"`
 
 	// Deceased flag or the actual or approximate age of the relative at the time of death
-	DeceasedDate *string `json:"deceasedDate,omitempty"`
+	DeceasedDate        *common.Date    `json:"deceasedDate,omitempty"`
+	DeceasedDateElement *common.Element `json:"_deceasedDate,omitempty"`
 
 	// Deceased flag or the actual or approximate age of the relative at the time of death
-	DeceasedString *string `json:"deceasedString,omitempty"`
+	DeceasedString        *string         `json:"deceasedString,omitempty"`
+	DeceasedStringElement *common.Element `json:"_deceasedString,omitempty"`
 
 	// This element is labeled as a modifier because the fact that age is estimated can/should change the results
-	EstimatedAge *bool `json:"estimatedAge,omitempty"`
+	EstimatedAge        *bool           `json:"estimatedAge,omitempty"`
+	EstimatedAgeElement *common.Element `json:"_estimatedAge,omitempty"`
 
 	// This is a business identifier, not a resource identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, orderset or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// This will either be a name or a description; e.g. "Aunt Susan", "my cousin with the red hair"
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// This property allows a non condition-specific note to the made about the related person
 	Note []common.Annotation `json:"note,omitempty"`
@@ -87,7 +98,8 @@ type FamilyMemberHistory struct {
 	Sex *common.CodeableConcept `json:"sex,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status FamilyMemberHistoryStatus `json:"status"`
+	Status        FamilyMemberHistoryStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 }
 
 // FamilyMemberHistoryStatus represents the status of the family member history
@@ -108,7 +120,8 @@ type FamilyMemberHistoryCondition struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// This condition contributed to the cause of death of the related person
-	ContributedToDeath *bool `json:"contributedToDeath,omitempty"`
+	ContributedToDeath        *bool           `json:"contributedToDeath,omitempty"`
+	ContributedToDeathElement *common.Element `json:"_contributedToDeath,omitempty"`
 
 	// An area where general notes can be placed about this specific condition
 	Note []common.Annotation `json:"note,omitempty"`
@@ -123,7 +136,8 @@ type FamilyMemberHistoryCondition struct {
 	OnsetPeriod *common.Period `json:"onsetPeriod,omitempty"`
 
 	// Either the age of onset, range of approximate age or descriptive string can be recorded
-	OnsetString *string `json:"onsetString,omitempty"`
+	OnsetString        *string         `json:"onsetString,omitempty"`
+	OnsetStringElement *common.Element `json:"_onsetString,omitempty"`
 
 	// Indicates what happened following the condition
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
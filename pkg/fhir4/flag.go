@@ -30,7 +30,8 @@ type Flag struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status FlagStatus `json:"status"`
+	Status        FlagStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The patient, location, group, organization, or practitioner etc. this is about record this flag is associated with
 	Subject common.Reference `json:"subject"`
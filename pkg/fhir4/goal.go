@@ -30,7 +30,8 @@ type Goal struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This element is labeled as a modifier because the lifecycleStatus contains codes that mark the resource as not currently valid
-	LifecycleStatus GoalLifecycleStatus `json:"lifecycleStatus"`
+	LifecycleStatus        GoalLifecycleStatus `json:"lifecycleStatus"`
+	LifecycleStatusElement *common.Element     `json:"_lifecycleStatus,omitempty"`
 
 	// May be used for progress notes, concerns or other related information that doesn't actually describe the goal itself
 	Note []common.Annotation `json:"note,omitempty"`
@@ -45,16 +46,19 @@ type Goal struct {
 	Priority *common.CodeableConcept `json:"priority,omitempty"`
 
 	// The date or event after which the goal should begin being pursued
-	StartDate *string `json:"startDate,omitempty"`
+	StartDate        *common.Date    `json:"startDate,omitempty"`
+	StartDateElement *common.Element `json:"_startDate,omitempty"`
 
 	// The date or event after which the goal should begin being pursued
 	StartCodeableConcept *common.CodeableConcept `json:"startCodeableConcept,omitempty"`
 
 	// To see the date for past statuses, query history
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.Date    `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element `json:"_statusDate,omitempty"`
 
 	// This will typically be captured for statuses such as rejected, on-hold or cancelled, but could be present for others
-	StatusReason *string `json:"statusReason,omitempty"`
+	StatusReason        *string         `json:"statusReason,omitempty"`
+	StatusReasonElement *common.Element `json:"_statusReason,omitempty"`
 
 	// Identifies the patient, group or organization for whom the goal is being established
 	Subject common.Reference `json:"subject"`
@@ -92,19 +96,23 @@ type GoalTarget struct {
 	DetailCodeableConcept *common.CodeableConcept `json:"detailCodeableConcept,omitempty"`
 
 	// A CodeableConcept with just a text would be used instead of a string if the field was usually coded
-	DetailString *string `json:"detailString,omitempty"`
+	DetailString        *string         `json:"detailString,omitempty"`
+	DetailStringElement *common.Element `json:"_detailString,omitempty"`
 
 	// A CodeableConcept with just a text would be used instead of a string if the field was usually coded
-	DetailBoolean *bool `json:"detailBoolean,omitempty"`
+	DetailBoolean        *bool           `json:"detailBoolean,omitempty"`
+	DetailBooleanElement *common.Element `json:"_detailBoolean,omitempty"`
 
 	// A CodeableConcept with just a text would be used instead of a string if the field was usually coded
-	DetailInteger *int `json:"detailInteger,omitempty"`
+	DetailInteger        *int            `json:"detailInteger,omitempty"`
+	DetailIntegerElement *common.Element `json:"_detailInteger,omitempty"`
 
 	// A CodeableConcept with just a text would be used instead of a string if the field was usually coded
 	DetailRatio *common.Ratio `json:"detailRatio,omitempty"`
 
 	// Indicates either the date or the duration after start by which the goal should be met
-	DueDate *string `json:"dueDate,omitempty"`
+	DueDate        *common.Date    `json:"dueDate,omitempty"`
+	DueDateElement *common.Element `json:"_dueDate,omitempty"`
 
 	// Indicates either the date or the duration after start by which the goal should be met
 	DueDuration *common.Duration `json:"dueDuration,omitempty"`
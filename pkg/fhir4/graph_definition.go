@@ -15,13 +15,16 @@ type GraphDefinition struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the graph definition was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of graph definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// It may be possible for the graph definition to be used in jurisdictions other than those for which it was originally designed or intended
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
@@ -30,31 +33,39 @@ type GraphDefinition struct {
 	Link []GraphDefinitionLink `json:"link,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The code does not include the '$' prefix that is always included in the URL when the operation is invoked
-	Profile *string `json:"profile,omitempty"`
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the graph definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The type of FHIR resource at which instances of this graph start
-	Start string `json:"start"`
+	Start        string          `json:"start"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// Allows filtering of graph definitions that are appropriate for use versus not
-	Status GraphDefinitionStatus `json:"status"`
+	Status        GraphDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different graph definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // GraphDefinitionStatus represents the status of the graph definition
@@ -72,19 +83,24 @@ type GraphDefinitionLink struct {
 	common.BackboneElement
 
 	// Information about why this link is of interest in this graph definition
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Maximum occurrences for this link
-	Max *string `json:"max,omitempty"`
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
 
 	// Minimum occurrences for this link
-	Min *int `json:"min,omitempty"`
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
 
 	// The path expression cannot contain a resolve() function
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 
 	// Which slice (if profiled)
-	SliceName *string `json:"sliceName,omitempty"`
+	SliceName        *string         `json:"sliceName,omitempty"`
+	SliceNameElement *common.Element `json:"_sliceName,omitempty"`
 
 	// Potential target for the link
 	Target []GraphDefinitionLinkTarget `json:"target,omitempty"`
@@ -101,13 +117,16 @@ type GraphDefinitionLinkTarget struct {
 	Link []GraphDefinitionLink `json:"link,omitempty"`
 
 	// At least one of the parameters must have the value {ref} which identifies the focus resource
-	Params *string `json:"params,omitempty"`
+	Params        *string         `json:"params,omitempty"`
+	ParamsElement *common.Element `json:"_params,omitempty"`
 
 	// Profile for the target resource
-	Profile *string `json:"profile,omitempty"`
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// Type of resource this link refers to
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // GraphDefinitionLinkTargetCompartment represents compartment consistency rules
@@ -115,19 +134,24 @@ type GraphDefinitionLinkTargetCompartment struct {
 	common.BackboneElement
 
 	// Identifies the compartment
-	Code GraphDefinitionLinkTargetCompartmentCode `json:"code"`
+	Code        GraphDefinitionLinkTargetCompartmentCode `json:"code"`
+	CodeElement *common.Element                          `json:"_code,omitempty"`
 
 	// Documentation for FHIRPath expression
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Custom rule, as a FHIRPath expression
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// identical | matching | different | no-rule | custom
-	Rule GraphDefinitionLinkTargetCompartmentRule `json:"rule"`
+	Rule        GraphDefinitionLinkTargetCompartmentRule `json:"rule"`
+	RuleElement *common.Element                          `json:"_rule,omitempty"`
 
 	// All conditional rules are evaluated; if they are true, then the rules are evaluated
-	Use GraphDefinitionLinkTargetCompartmentUse `json:"use"`
+	Use        GraphDefinitionLinkTargetCompartmentUse `json:"use"`
+	UseElement *common.Element                         `json:"_use,omitempty"`
 }
 
 // GraphDefinitionLinkTargetCompartmentCode represents the compartment code
@@ -12,10 +12,12 @@ type Group struct {
 	ResourceType string `json:"resourceType"` // Always "Group"
 
 	// Indicates whether the record for the group is available for use or is merely being retained for historical purposes
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// If true, indicates that the resource refers to a specific group of real individuals
-	Actual bool `json:"actual"`
+	Actual        bool            `json:"actual"`
+	ActualElement *common.Element `json:"_actual,omitempty"`
 
 	// All the identified characteristics must be true for an entity to a member of the group
 	Characteristic []GroupCharacteristic `json:"characteristic,omitempty"`
@@ -33,13 +35,16 @@ type Group struct {
 	Member []GroupMember `json:"member,omitempty"`
 
 	// A label assigned to the group for human identification and communication
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Note that the quantity may be less than the number of members if some of the members are not active
-	Quantity *int `json:"quantity,omitempty"`
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
 
 	// Group members SHALL be of the appropriate resource type
-	Type GroupType `json:"type"`
+	Type        GroupType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // GroupType represents the type of group
@@ -62,7 +67,8 @@ type GroupCharacteristic struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// This is labeled as "Is Modifier" because applications cannot wrongly include excluded members as included or vice versa
-	Exclude bool `json:"exclude"`
+	Exclude        bool            `json:"exclude"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// The period over which the characteristic is tested; e.g. the patient had an operation during the month of June
 	Period *common.Period `json:"period,omitempty"`
@@ -71,7 +77,8 @@ type GroupCharacteristic struct {
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 
 	// For Range, it means members of the group have a value that falls somewhere within the specified range
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// For Range, it means members of the group have a value that falls somewhere within the specified range
 	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
@@ -91,7 +98,8 @@ type GroupMember struct {
 	Entity common.Reference `json:"entity"`
 
 	// A flag to indicate that the member is no longer in the group, but previously may have been a member
-	Inactive *bool `json:"inactive,omitempty"`
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
 
 	// The period that the member was in the group, if known
 	Period *common.Period `json:"period,omitempty"`
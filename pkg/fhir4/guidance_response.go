@@ -24,10 +24,12 @@ type GuidanceResponse struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// An identifier, CodeableConcept or canonical reference to the guidance that was requested
-	ModuleUri *string `json:"moduleUri,omitempty"`
+	ModuleUri        *string         `json:"moduleUri,omitempty"`
+	ModuleUriElement *common.Element `json:"_moduleUri,omitempty"`
 
 	// An identifier, CodeableConcept or canonical reference to the guidance that was requested
-	ModuleCanonical *string `json:"moduleCanonical,omitempty"`
+	ModuleCanonical        *string         `json:"moduleCanonical,omitempty"`
+	ModuleCanonicalElement *common.Element `json:"_moduleCanonical,omitempty"`
 
 	// An identifier, CodeableConcept or canonical reference to the guidance that was requested
 	ModuleCodeableConcept *common.CodeableConcept `json:"moduleCodeableConcept,omitempty"`
@@ -36,7 +38,8 @@ type GuidanceResponse struct {
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// Indicates when the guidance response was processed
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// The output parameters of the evaluation, if any
 	OutputParameters *common.Reference `json:"outputParameters,omitempty"`
@@ -57,7 +60,8 @@ type GuidanceResponse struct {
 	Result *common.Reference `json:"result,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status GuidanceResponseStatus `json:"status"`
+	Status        GuidanceResponseStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// The patient for which the request was processed
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -12,13 +12,16 @@ type HealthcareService struct {
 	ResourceType string `json:"resourceType"` // Always "HealthcareService"
 
 	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Indicates whether or not a prospective consumer will require an appointment for a particular service at a site
-	AppointmentRequired *bool `json:"appointmentRequired,omitempty"`
+	AppointmentRequired        *bool           `json:"appointmentRequired,omitempty"`
+	AppointmentRequiredElement *common.Element `json:"_appointmentRequired,omitempty"`
 
 	// A description of site availability exceptions, e.g. public holiday availability
-	AvailabilityExceptions *string `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
 
 	// More detailed availability information may be provided in associated Schedule/Slot resources
 	AvailableTime []HealthcareServiceAvailableTime `json:"availableTime,omitempty"`
@@ -30,7 +33,8 @@ type HealthcareService struct {
 	Characteristic []common.CodeableConcept `json:"characteristic,omitempty"`
 
 	// Would expect that a user would not see this information on a search results, and it would only be available when viewing the complete details of the service
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// When using this property it indicates that the service is available with this language
 	Communication []common.CodeableConcept `json:"communication,omitempty"`
@@ -45,7 +49,8 @@ type HealthcareService struct {
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
 
 	// Extra details about the service that can't be placed in the other fields
-	ExtraDetails *string `json:"extraDetails,omitempty"`
+	ExtraDetails        *string         `json:"extraDetails,omitempty"`
+	ExtraDetailsElement *common.Element `json:"_extraDetails,omitempty"`
 
 	// External identifiers for this item
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -54,7 +59,8 @@ type HealthcareService struct {
 	Location []common.Reference `json:"location,omitempty"`
 
 	// Further description of the service as it would be presented to a consumer while searching
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The HealthcareService is not available during this period of time due to the provided reason
 	NotAvailable []HealthcareServiceNotAvailable `json:"notAvailable,omitempty"`
@@ -92,7 +98,8 @@ type HealthcareServiceEligibility struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The description of service eligibility should, in general, not exceed one or two paragraphs
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 }
 
 // HealthcareServiceAvailableTime represents more detailed availability information
@@ -100,16 +107,20 @@ type HealthcareServiceAvailableTime struct {
 	common.BackboneElement
 
 	// Is this always available? (hence times are irrelevant) e.g. 24 hour service
-	AllDay *bool `json:"allDay,omitempty"`
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
 
 	// The time zone is expected to be for where this HealthcareService is provided at
-	AvailableEndTime *string `json:"availableEndTime,omitempty"`
+	AvailableEndTime        *common.Time    `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement *common.Element `json:"_availableEndTime,omitempty"`
 
 	// The time zone is expected to be for where this HealthcareService is provided at
-	AvailableStartTime *string `json:"availableStartTime,omitempty"`
+	AvailableStartTime        *common.Time    `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element `json:"_availableStartTime,omitempty"`
 
 	// Indicates which days of the week are available between the start and end Times
-	DaysOfWeek []HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeek        []HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element                          `json:"_daysOfWeek,omitempty"`
 }
 
 // HealthcareServiceAvailableTimeDaysOfWeek represents the days of the week
@@ -130,7 +141,8 @@ type HealthcareServiceNotAvailable struct {
 	common.BackboneElement
 
 	// The reason that can be presented to the user as to why this time is not available
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Service is not available (seasonally or for a public holiday) from this date
 	During *common.Period `json:"during,omitempty"`
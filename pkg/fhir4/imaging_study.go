@@ -15,7 +15,8 @@ type ImagingStudy struct {
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
 
 	// The Imaging Manager description of the study
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This will typically be the encounter the event occurred within, but some events may be initiated prior to or after the official completion of an encounter
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -39,10 +40,12 @@ type ImagingStudy struct {
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// Number of SOP Instances in Study
-	NumberOfInstances *int `json:"numberOfInstances,omitempty"`
+	NumberOfInstances        *int            `json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
 
 	// Number of Series in the Study
-	NumberOfSeries *int `json:"numberOfSeries,omitempty"`
+	NumberOfSeries        *int            `json:"numberOfSeries,omitempty"`
+	NumberOfSeriesElement *common.Element `json:"_numberOfSeries,omitempty"`
 
 	// The code for the performed procedure type
 	ProcedureCode []common.CodeableConcept `json:"procedureCode,omitempty"`
@@ -63,10 +66,12 @@ type ImagingStudy struct {
 	Series []ImagingStudySeries `json:"series,omitempty"`
 
 	// Date and time the study started
-	Started *string `json:"started,omitempty"`
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
 
 	// Unknown does not represent "other" - one of the defined statuses must apply
-	Status ImagingStudyStatus `json:"status"`
+	Status        ImagingStudyStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// QA phantoms can be recorded with a Device; multiple subjects (such as mice) can be recorded with a Group
 	Subject common.Reference `json:"subject"`
@@ -91,7 +96,8 @@ type ImagingStudySeries struct {
 	BodySite *common.Coding `json:"bodySite,omitempty"`
 
 	// A description of the series
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Typical endpoint types include DICOM WADO-RS, which is used to retrieve DICOM instances in native or rendered formats
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
@@ -106,10 +112,12 @@ type ImagingStudySeries struct {
 	Modality common.Coding `json:"modality"`
 
 	// The numeric identifier of this series in the study
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// Number of SOP Instances in the Study
-	NumberOfInstances *int `json:"numberOfInstances,omitempty"`
+	NumberOfInstances        *int            `json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
 
 	// If the person who performed the series is not known, their Organization may be recorded
 	Performer []ImagingStudySeriesPerformer `json:"performer,omitempty"`
@@ -118,10 +126,12 @@ type ImagingStudySeries struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// The date and time the series was started
-	Started *string `json:"started,omitempty"`
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
 
 	// See DICOM PS3.3 C.7.3
-	Uid string `json:"uid"`
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
 }
 
 // ImagingStudySeriesInstance represents a single SOP instance within the series
@@ -129,16 +139,19 @@ type ImagingStudySeriesInstance struct {
 	common.BackboneElement
 
 	// The number of instance in the series
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// DICOM instance type
 	SopClass common.Coding `json:"sopClass"`
 
 	// Particularly for post-acquisition analytic objects, such as SR, presentation states, value mapping, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// See DICOM PS3.3 C.12.1
-	Uid string `json:"uid"`
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
 }
 
 // ImagingStudySeriesPerformer represents if the person who performed the series is not known, their Organization may be recorded
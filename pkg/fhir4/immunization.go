@@ -16,7 +16,8 @@ type Immunization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// completed | entered-in-error | not-done
-	Status ImmunizationStatus `json:"status"`
+	Status        ImmunizationStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// Reason not done
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -31,14 +32,18 @@ type Immunization struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Vaccine administration date
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
-	OccurrenceString   *string `json:"occurrenceString,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrenceString          *string          `json:"occurrenceString,omitempty"`
+	OccurrenceStringElement   *common.Element  `json:"_occurrenceString,omitempty"`
 
 	// When the immunization was first captured in the subject's record
-	Recorded *string `json:"recorded,omitempty"`
+	Recorded        *common.DateTime `json:"recorded,omitempty"`
+	RecordedElement *common.Element  `json:"_recorded,omitempty"`
 
 	// Indicates if record is from primary source
-	PrimarySource *bool `json:"primarySource,omitempty"`
+	PrimarySource        *bool           `json:"primarySource,omitempty"`
+	PrimarySourceElement *common.Element `json:"_primarySource,omitempty"`
 
 	// Indicates the source of the vaccine actually administered
 	ReportOrigin *common.CodeableConcept `json:"reportOrigin,omitempty"`
@@ -50,10 +55,12 @@ type Immunization struct {
 	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
 
 	// Vaccine lot number
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// Vaccine expiration date
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.Date    `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element `json:"_expirationDate,omitempty"`
 
 	// Body site vaccine was administered
 	Site *common.CodeableConcept `json:"site,omitempty"`
@@ -77,7 +84,8 @@ type Immunization struct {
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
 	// Dose potency
-	IsSubpotent *bool `json:"isSubpotent,omitempty"`
+	IsSubpotent        *bool           `json:"isSubpotent,omitempty"`
+	IsSubpotentElement *common.Element `json:"_isSubpotent,omitempty"`
 
 	// Reason for being subpotent
 	SubpotentReason []common.CodeableConcept `json:"subpotentReason,omitempty"`
@@ -123,16 +131,20 @@ type ImmunizationEducation struct {
 	common.BackboneElement
 
 	// Educational material document identifier
-	DocumentType *string `json:"documentType,omitempty"`
+	DocumentType        *string         `json:"documentType,omitempty"`
+	DocumentTypeElement *common.Element `json:"_documentType,omitempty"`
 
 	// Educational material reference pointer
-	Reference *string `json:"reference,omitempty"`
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
 
 	// Educational material publication date
-	PublicationDate *string `json:"publicationDate,omitempty"`
+	PublicationDate        *common.DateTime `json:"publicationDate,omitempty"`
+	PublicationDateElement *common.Element  `json:"_publicationDate,omitempty"`
 
 	// Educational material presentation date
-	PresentationDate *string `json:"presentationDate,omitempty"`
+	PresentationDate        *common.DateTime `json:"presentationDate,omitempty"`
+	PresentationDateElement *common.Element  `json:"_presentationDate,omitempty"`
 }
 
 // ImmunizationReaction represents a reaction that follows immunization
@@ -140,13 +152,15 @@ type ImmunizationReaction struct {
 	common.BackboneElement
 
 	// When reaction started
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Additional information on reaction
 	Detail *common.Reference `json:"detail,omitempty"`
 
 	// Indicates self-reported reaction
-	Reported *bool `json:"reported,omitempty"`
+	Reported        *bool           `json:"reported,omitempty"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
 }
 
 // ImmunizationProtocolApplied represents protocol followed by the provider
@@ -154,7 +168,8 @@ type ImmunizationProtocolApplied struct {
 	common.BackboneElement
 
 	// Name of vaccine series
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// Who is responsible for publishing the recommendations
 	Authority *common.Reference `json:"authority,omitempty"`
@@ -163,10 +178,14 @@ type ImmunizationProtocolApplied struct {
 	TargetDisease []common.CodeableConcept `json:"targetDisease,omitempty"`
 
 	// Dose number within series
-	DoseNumberPositiveInt *int    `json:"doseNumberPositiveInt,omitempty"`
-	DoseNumberString      *string `json:"doseNumberString,omitempty"`
+	DoseNumberPositiveInt        *int            `json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement *common.Element `json:"_doseNumberPositiveInt,omitempty"`
+	DoseNumberString             *string         `json:"doseNumberString,omitempty"`
+	DoseNumberStringElement      *common.Element `json:"_doseNumberString,omitempty"`
 
 	// Recommended number of doses for immunity
-	SeriesDosesPositiveInt *int    `json:"seriesDosesPositiveInt,omitempty"`
-	SeriesDosesString      *string `json:"seriesDosesString,omitempty"`
+	SeriesDosesPositiveInt        *int            `json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *common.Element `json:"_seriesDosesPositiveInt,omitempty"`
+	SeriesDosesString             *string         `json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement      *common.Element `json:"_seriesDosesString,omitempty"`
 }
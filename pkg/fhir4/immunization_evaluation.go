@@ -15,16 +15,20 @@ type ImmunizationEvaluation struct {
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// The date the evaluation of the vaccine administration event was performed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Additional information about the evaluation
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumberPositiveInt *int `json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveInt        *int            `json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement *common.Element `json:"_doseNumberPositiveInt,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumberString *string `json:"doseNumberString,omitempty"`
+	DoseNumberString        *string         `json:"doseNumberString,omitempty"`
+	DoseNumberStringElement *common.Element `json:"_doseNumberString,omitempty"`
 
 	// Indicates if the dose is valid or not valid with respect to the published recommendations
 	DoseStatus common.CodeableConcept `json:"doseStatus"`
@@ -42,16 +46,20 @@ type ImmunizationEvaluation struct {
 	Patient common.Reference `json:"patient"`
 
 	// One possible path to achieve presumed immunity against a disease - within the context of an authority
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDosesPositiveInt *int `json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveInt        *int            `json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *common.Element `json:"_seriesDosesPositiveInt,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDosesString *string `json:"seriesDosesString,omitempty"`
+	SeriesDosesString        *string         `json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement *common.Element `json:"_seriesDosesString,omitempty"`
 
 	// Indicates the current status of the evaluation of the vaccination administration event
-	Status ImmunizationEvaluationStatus `json:"status"`
+	Status        ImmunizationEvaluationStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
 
 	// The vaccine preventable disease the dose is being evaluated against
 	TargetDisease common.CodeableConcept `json:"targetDisease"`
@@ -15,7 +15,8 @@ type ImmunizationRecommendation struct {
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// The date the immunization recommendation(s) were created
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// A unique identifier assigned to this particular recommendation record
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -38,13 +39,16 @@ type ImmunizationRecommendationRecommendation struct {
 	DateCriterion []ImmunizationRecommendationRecommendationDateCriterion `json:"dateCriterion,omitempty"`
 
 	// Contains the description about the protocol under which the vaccine was administered
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumberPositiveInt *int `json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveInt        *int            `json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement *common.Element `json:"_doseNumberPositiveInt,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumberString *string `json:"doseNumberString,omitempty"`
+	DoseNumberString        *string         `json:"doseNumberString,omitempty"`
+	DoseNumberStringElement *common.Element `json:"_doseNumberString,omitempty"`
 
 	// The reason for the assigned forecast status
 	ForecastReason []common.CodeableConcept `json:"forecastReason,omitempty"`
@@ -53,13 +57,16 @@ type ImmunizationRecommendationRecommendation struct {
 	ForecastStatus common.CodeableConcept `json:"forecastStatus"`
 
 	// One possible path to achieve presumed immunity against a disease - within the context of an authority
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDosesPositiveInt *int `json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveInt        *int            `json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *common.Element `json:"_seriesDosesPositiveInt,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDosesString *string `json:"seriesDosesString,omitempty"`
+	SeriesDosesString        *string         `json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement *common.Element `json:"_seriesDosesString,omitempty"`
 
 	// Immunization event history and/or evaluation that supports the status and recommendation
 	SupportingImmunization []common.Reference `json:"supportingImmunization,omitempty"`
@@ -82,5 +89,6 @@ type ImmunizationRecommendationRecommendationDateCriterion struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// The date whose meaning is specified by dateCriterion.code
-	Value string `json:"value"`
+	Value        common.DateTime `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
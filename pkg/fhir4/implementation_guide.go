@@ -15,10 +15,12 @@ type ImplementationGuide struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the implementation guide and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Principally, this consists of information about source resource and file locations, and build parameters and templates
 	Definition *ImplementationGuideDefinition `json:"definition,omitempty"`
@@ -27,13 +29,16 @@ type ImplementationGuide struct {
 	DependsOn []ImplementationGuideDependsOn `json:"dependsOn,omitempty"`
 
 	// This description can be used to capture details such as why the implementation guide was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of implementation guides that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Most implementation guides target a single version
-	FhirVersion []string `json:"fhirVersion"`
+	FhirVersion        []string          `json:"fhirVersion"`
+	FhirVersionElement []*common.Element `json:"_fhirVersion,omitempty"`
 
 	// See Default Profiles for a discussion of which resources are 'covered' by an implementation guide
 	Global []ImplementationGuideGlobal `json:"global,omitempty"`
@@ -42,34 +47,42 @@ type ImplementationGuide struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The license that applies to this Implementation Guide, using an SPDX license code, or 'not-open-source'
-	License *string `json:"license,omitempty"`
+	License        *string         `json:"license,omitempty"`
+	LicenseElement *common.Element `json:"_license,omitempty"`
 
 	// Information about an assembled implementation guide, created by the publication tooling
 	Manifest *ImplementationGuideManifest `json:"manifest,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Many (if not all) IG publishing tools will require that this element be present
-	PackageId string `json:"packageId"`
+	PackageId        string          `json:"packageId"`
+	PackageIdElement *common.Element `json:"_packageId,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Allows filtering of implementation guides that are appropriate for use versus not
-	Status ImplementationGuideStatus `json:"status"`
+	Status        ImplementationGuideStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different implementation guide instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // ImplementationGuideStatus represents the status of the implementation guide
@@ -87,13 +100,16 @@ type ImplementationGuideDependsOn struct {
 	common.BackboneElement
 
 	// The NPM package name for the Implementation Guide that this IG depends on
-	PackageId *string `json:"packageId,omitempty"`
+	PackageId        *string         `json:"packageId,omitempty"`
+	PackageIdElement *common.Element `json:"_packageId,omitempty"`
 
 	// Usually, A canonical reference to the implementation guide is the same as the master location at which the implementation guide is published
-	Uri string `json:"uri"`
+	Uri        string          `json:"uri"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 
 	// This follows the syntax of the NPM packaging version field
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // ImplementationGuideGlobal represents see Default Profiles for a discussion of which resources are 'covered' by an implementation guide
@@ -101,10 +117,12 @@ type ImplementationGuideGlobal struct {
 	common.BackboneElement
 
 	// The profile allows the implementation guide to specify the types and structure of profiles that are allowed to be referenced in this implementation guide when using inline resources
-	Profile string `json:"profile"`
+	Profile        string          `json:"profile"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// The type of resource this definition applies to
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // ImplementationGuideDefinition represents principally, this consists of information about source resource and file locations, and build parameters and templates
@@ -132,10 +150,12 @@ type ImplementationGuideDefinitionGrouping struct {
 	common.BackboneElement
 
 	// Human readable text describing the package
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The human-readable title to display for the package of resources when rendering the implementation guide
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 }
 
 // ImplementationGuideDefinitionResource represents a resource that is part of the implementation guide
@@ -143,16 +163,20 @@ type ImplementationGuideDefinitionResource struct {
 	common.BackboneElement
 
 	// A description of the reason that a resource has been included in the implementation guide
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Examples, if present, represent resources that conform to this resource, and cannot be represented by inline resources
-	ExampleBoolean *bool `json:"exampleBoolean,omitempty"`
+	ExampleBoolean        *bool           `json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement *common.Element `json:"_exampleBoolean,omitempty"`
 
 	// Examples, if present, represent resources that conform to this resource, and cannot be represented by inline resources
-	ExampleCanonical *string `json:"exampleCanonical,omitempty"`
+	ExampleCanonical        *string         `json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *common.Element `json:"_exampleCanonical,omitempty"`
 
 	// A human assigned name for the resource. All resources SHOULD have a name, but the name may be extracted from the resource (e.g. ValueSet.name)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Reference of the resource
 	Reference common.Reference `json:"reference"`
@@ -163,19 +187,23 @@ type ImplementationGuideDefinitionPage struct {
 	common.BackboneElement
 
 	// The format of the page
-	Generation ImplementationGuideDefinitionPageGeneration `json:"generation"`
+	Generation        ImplementationGuideDefinitionPageGeneration `json:"generation"`
+	GenerationElement *common.Element                             `json:"_generation,omitempty"`
 
 	// The name of the page to be generated
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Nested Pages/Sections under this page
 	Page []ImplementationGuideDefinitionPage `json:"page,omitempty"`
 
 	// The source address for the page
-	SourceUrl *string `json:"sourceUrl,omitempty"`
+	SourceUrl        *string         `json:"sourceUrl,omitempty"`
+	SourceUrlElement *common.Element `json:"_sourceUrl,omitempty"`
 
 	// The title of the page to be generated
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ImplementationGuideDefinitionPageGeneration represents the format of the page
@@ -193,10 +221,12 @@ type ImplementationGuideDefinitionParameter struct {
 	common.BackboneElement
 
 	// apply | path-resource | path-pages | path-tx-cache | expansion-parameter | rule-broken-links | generate-xml | generate-json | generate-turtle | html-template
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Value for named type, or id, path, or url for a resource
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ImplementationGuideDefinitionTemplate represents a template for building resources
@@ -204,13 +234,16 @@ type ImplementationGuideDefinitionTemplate struct {
 	common.BackboneElement
 
 	// The code specifies the type of template
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// The source location for the template
-	Source string `json:"source"`
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// The scope in which the template applies
-	Scope *string `json:"scope,omitempty"`
+	Scope        *string         `json:"scope,omitempty"`
+	ScopeElement *common.Element `json:"_scope,omitempty"`
 }
 
 // ImplementationGuideManifest represents information about an assembled implementation guide, created by the publication tooling
@@ -218,16 +251,19 @@ type ImplementationGuideManifest struct {
 	common.BackboneElement
 
 	// A pointer to other implementation guides that this implementation depends on
-	Image []string `json:"image,omitempty"`
+	Image        []string          `json:"image,omitempty"`
+	ImageElement []*common.Element `json:"_image,omitempty"`
 
 	// A pointer to other implementation guides that this implementation depends on
-	Other []string `json:"other,omitempty"`
+	Other        []string          `json:"other,omitempty"`
+	OtherElement []*common.Element `json:"_other,omitempty"`
 
 	// Information about a page within the IG
 	Page []ImplementationGuideManifestPage `json:"page,omitempty"`
 
 	// A pointer to other implementation guides that this implementation depends on
-	Rendering *string `json:"rendering,omitempty"`
+	Rendering        *string         `json:"rendering,omitempty"`
+	RenderingElement *common.Element `json:"_rendering,omitempty"`
 
 	// A resource that is part of the implementation guide
 	Resource []ImplementationGuideManifestResource `json:"resource"`
@@ -238,16 +274,19 @@ type ImplementationGuideManifestResource struct {
 	common.BackboneElement
 
 	// If true or a reference, indicates the resource is an example instance
-	ExampleBoolean *bool `json:"exampleBoolean,omitempty"`
+	ExampleBoolean        *bool           `json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement *common.Element `json:"_exampleBoolean,omitempty"`
 
 	// If true or a reference, indicates the resource is an example instance
-	ExampleCanonical *string `json:"exampleCanonical,omitempty"`
+	ExampleCanonical        *string         `json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *common.Element `json:"_exampleCanonical,omitempty"`
 
 	// Relative path for page in IG
 	Reference common.Reference `json:"reference"`
 
 	// Relative path for page in IG
-	RelativePath *string `json:"relativePath,omitempty"`
+	RelativePath        *string         `json:"relativePath,omitempty"`
+	RelativePathElement *common.Element `json:"_relativePath,omitempty"`
 }
 
 // ImplementationGuideManifestPage represents information about a page within the IG
@@ -255,11 +294,14 @@ type ImplementationGuideManifestPage struct {
 	common.BackboneElement
 
 	// Relative path for page in IG
-	Anchor []string `json:"anchor,omitempty"`
+	Anchor        []string          `json:"anchor,omitempty"`
+	AnchorElement []*common.Element `json:"_anchor,omitempty"`
 
 	// Relative path for page in IG
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Relative path for page in IG
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
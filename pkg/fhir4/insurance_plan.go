@@ -15,7 +15,8 @@ type InsurancePlan struct {
 	AdministeredBy *common.Reference `json:"administeredBy,omitempty"`
 
 	// There are no dates associated with the alias/historic names
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Where multiple contacts for the same purpose are provided there is a standard extension
 	Contact []InsurancePlanContact `json:"contact,omitempty"`
@@ -33,7 +34,8 @@ type InsurancePlan struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// If the name of the product/plan changes, consider putting the old name in the alias column
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Networks are represented as a hierarchy of organization resources
 	Network []common.Reference `json:"network,omitempty"`
@@ -48,7 +50,8 @@ type InsurancePlan struct {
 	Plan []InsurancePlanPlan `json:"plan,omitempty"`
 
 	// The current state of the health insurance product
-	Status *InsurancePlanStatus `json:"status,omitempty"`
+	Status        *InsurancePlanStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// The kind of health insurance product
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -103,7 +106,8 @@ type InsurancePlanCoverageBenefit struct {
 	Limit []InsurancePlanCoverageBenefitLimit `json:"limit,omitempty"`
 
 	// The referral requirements to have access/coverage for this benefit
-	Requirement *string `json:"requirement,omitempty"`
+	Requirement        *string         `json:"requirement,omitempty"`
+	RequirementElement *common.Element `json:"_requirement,omitempty"`
 
 	// Type of benefit (primary care; speciality care; inpatient; outpatient)
 	Type common.CodeableConcept `json:"type"`
@@ -148,13 +152,15 @@ type InsurancePlanPlanGeneralCost struct {
 	common.BackboneElement
 
 	// Additional information about the general costs associated with this plan
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Value of the cost
 	Cost *common.Money `json:"cost,omitempty"`
 
 	// Number of participants enrolled in the plan
-	GroupSize *int `json:"groupSize,omitempty"`
+	GroupSize        *int            `json:"groupSize,omitempty"`
+	GroupSizeElement *common.Element `json:"_groupSize,omitempty"`
 
 	// Type of cost
 	Type *common.CodeableConcept `json:"type,omitempty"`
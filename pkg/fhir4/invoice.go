@@ -15,10 +15,12 @@ type Invoice struct {
 	Account *common.Reference `json:"account,omitempty"`
 
 	// Derived Profiles may choose to add invariants requiring this field to be populated
-	CancelledReason *string `json:"cancelledReason,omitempty"`
+	CancelledReason        *string         `json:"cancelledReason,omitempty"`
+	CancelledReasonElement *common.Element `json:"_cancelledReason,omitempty"`
 
 	// The list of types may be constrained as appropriate for the type of charge item
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Identifier of this Invoice, often used for reference in correspondence about this invoice
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -36,13 +38,15 @@ type Invoice struct {
 	Participant []InvoiceParticipant `json:"participant,omitempty"`
 
 	// Derived Profiles may chose to add invariants requiring this field to be populated
-	PaymentTerms *string `json:"paymentTerms,omitempty"`
+	PaymentTerms        *string         `json:"paymentTerms,omitempty"`
+	PaymentTermsElement *common.Element `json:"_paymentTerms,omitempty"`
 
 	// The individual or Organization responsible for balancing of this invoice
 	Recipient *common.Reference `json:"recipient,omitempty"`
 
 	// The current state of the Invoice
-	Status InvoiceStatus `json:"status"`
+	Status        InvoiceStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The individual or set of individuals receiving the goods and services billed in this invoice
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -96,7 +100,8 @@ type InvoiceLineItem struct {
 	PriceComponent []InvoiceLineItemPriceComponent `json:"priceComponent,omitempty"`
 
 	// Sequence in which the items appear on the invoice
-	Sequence *int `json:"sequence,omitempty"`
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 }
 
 // InvoiceLineItemPriceComponent represents the price for a ChargeItem may be calculated as a base price with surcharges/deductions
@@ -110,10 +115,12 @@ type InvoiceLineItemPriceComponent struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// There is no reason to carry the price in the instance of a ChargeItem unless circumstances require a manual override
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// This code identifies the type of the component
-	Type InvoiceLineItemPriceComponentType `json:"type"`
+	Type        InvoiceLineItemPriceComponentType `json:"type"`
+	TypeElement *common.Element                   `json:"_type,omitempty"`
 }
 
 // InvoiceLineItemPriceComponentType represents the type of price component
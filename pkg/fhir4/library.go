@@ -12,7 +12,8 @@ type Library struct {
 	ResourceType string `json:"resourceType"` // Always "Library"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -24,16 +25,19 @@ type Library struct {
 	Content []common.Attachment `json:"content,omitempty"`
 
 	// A copyright statement relating to the library and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Describes a set of data that must be provided in order to be able to successfully perform the computations
 	DataRequirement []common.DataRequirement `json:"dataRequirement,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the library was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -45,7 +49,8 @@ type Library struct {
 	Endorser []common.ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of libraries that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -54,19 +59,23 @@ type Library struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The parameter element defines parameters used by the library
 	Parameter []common.ParameterDefinition `json:"parameter,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the library
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -75,7 +84,8 @@ type Library struct {
 	Reviewer []common.ContactDetail `json:"reviewer,omitempty"`
 
 	// Allows filtering of libraries that are appropriate for use vs. not
-	Status LibraryStatus `json:"status"`
+	Status        LibraryStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// A code or group definition that describes the intended subject of the contents of the library
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
@@ -84,10 +94,12 @@ type Library struct {
 	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
 
 	// An explanatory or alternate title for the library giving additional information about its content
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the library
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -96,16 +108,19 @@ type Library struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description of how the library is used from a clinical perspective
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different library instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // LibraryStatus represents the status of the library
@@ -15,7 +15,8 @@ type List struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The actual important date is the date of currency of the resources that were summarized
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The various reasons for an empty list make a significant interpretation to its interpretation
 	EmptyReason *common.CodeableConcept `json:"emptyReason,omitempty"`
@@ -30,7 +31,8 @@ type List struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This element is labeled as a modifier because a change list must not be misunderstood as a complete list
-	Mode ListMode `json:"mode"`
+	Mode        ListMode        `json:"mode"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
 
 	// Comments that apply to the overall list
 	Note []common.Annotation `json:"note,omitempty"`
@@ -42,13 +44,15 @@ type List struct {
 	Source *common.Reference `json:"source,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status ListStatus `json:"status"`
+	Status        ListStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Some purely arbitrary lists do not have a common subject, so this is optional
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// A label for the list assigned by the author
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ListMode represents the mode of the list
@@ -74,10 +78,12 @@ type ListEntry struct {
 	common.BackboneElement
 
 	// When this item was added to the list
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// If the flag means that the entry has actually been deleted from the list, the deleted element SHALL be true
-	Deleted *bool `json:"deleted,omitempty"`
+	Deleted        *bool           `json:"deleted,omitempty"`
+	DeletedElement *common.Element `json:"_deleted,omitempty"`
 
 	// The flag can only be understood in the context of the List.code
 	Flag *common.CodeableConcept `json:"flag,omitempty"`
@@ -15,19 +15,24 @@ type Location struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// active | suspended | inactive
-	Status *LocationStatus `json:"status,omitempty"`
+	Status        *LocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Name of the location as used by humans
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A list of alternate names that the location is known as, or was known as in the past
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Additional details about the location that could be displayed as further information
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// instance | kind - Indicates whether a resource instance represents a specific location or a class of locations
-	Mode *LocationMode `json:"mode,omitempty"`
+	Mode        *LocationMode   `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
 
 	// Indicates the type of function performed at the location
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -54,7 +59,8 @@ type Location struct {
 	HoursOfOperation []LocationHoursOfOperation `json:"hoursOfOperation,omitempty"`
 
 	// Description of availability exceptions
-	AvailabilityExceptions *string `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
 
 	// Technical endpoints providing access to services operated for the location
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
@@ -85,13 +91,16 @@ type LocationPosition struct {
 	common.BackboneElement
 
 	// Longitude with WGS84 datum
-	Longitude float64 `json:"longitude"`
+	Longitude        common.Decimal  `json:"longitude"`
+	LongitudeElement *common.Element `json:"_longitude,omitempty"`
 
 	// Latitude with WGS84 datum
-	Latitude float64 `json:"latitude"`
+	Latitude        common.Decimal  `json:"latitude"`
+	LatitudeElement *common.Element `json:"_latitude,omitempty"`
 
 	// Altitude with WGS84 datum
-	Altitude *float64 `json:"altitude,omitempty"`
+	Altitude        *common.Decimal `json:"altitude,omitempty"`
+	AltitudeElement *common.Element `json:"_altitude,omitempty"`
 }
 
 // LocationHoursOfOperation represents the hours of operation for the location
@@ -99,14 +108,18 @@ type LocationHoursOfOperation struct {
 	common.BackboneElement
 
 	// Indicates which days of the week are available between the start and end Times
-	DaysOfWeek []DaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeek        []DaysOfWeek      `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element `json:"_daysOfWeek,omitempty"`
 
 	// The Location is open all day
-	AllDay *bool `json:"allDay,omitempty"`
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
 
 	// Time that the Location opens
-	OpeningTime *string `json:"openingTime,omitempty"`
+	OpeningTime        *common.Time    `json:"openingTime,omitempty"`
+	OpeningTimeElement *common.Element `json:"_openingTime,omitempty"`
 
 	// Time that the Location closes
-	ClosingTime *string `json:"closingTime,omitempty"`
+	ClosingTime        *common.Time    `json:"closingTime,omitempty"`
+	ClosingTimeElement *common.Element `json:"_closingTime,omitempty"`
 }
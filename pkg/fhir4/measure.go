@@ -12,13 +12,15 @@ type Measure struct {
 	ResourceType string `json:"resourceType"` // Always "Measure"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
 
 	// Provides a summary of relevant clinical guidelines or other clinical recommendations supporting the measure
-	ClinicalRecommendationStatement *string `json:"clinicalRecommendationStatement,omitempty"`
+	ClinicalRecommendationStatement        *string         `json:"clinicalRecommendationStatement,omitempty"`
+	ClinicalRecommendationStatementElement *common.Element `json:"_clinicalRecommendationStatement,omitempty"`
 
 	// If this is a composite measure, the scoring method used to combine the component measures
 	CompositeScoring *common.CodeableConcept `json:"compositeScoring,omitempty"`
@@ -27,19 +29,24 @@ type Measure struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the measure and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Provides a description of an individual term used within the measure
-	Definition []string `json:"definition,omitempty"`
+	Definition        []string          `json:"definition,omitempty"`
+	DefinitionElement []*common.Element `json:"_definition,omitempty"`
 
 	// This description can be used to capture details such as why the measure was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Notices and disclaimers regarding the use of the measure or related to intellectual property
-	Disclaimer *string `json:"disclaimer,omitempty"`
+	Disclaimer        *string         `json:"disclaimer,omitempty"`
+	DisclaimerElement *common.Element `json:"_disclaimer,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -51,13 +58,15 @@ type Measure struct {
 	Endorser []common.ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of measures that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// A group of population criteria for the measure
 	Group []MeasureGroup `json:"group,omitempty"`
 
 	// Additional guidance for the measure including how it can be used in a clinical context
-	Guidance *string `json:"guidance,omitempty"`
+	Guidance        *string         `json:"guidance,omitempty"`
+	GuidanceElement *common.Element `json:"_guidance,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -69,25 +78,32 @@ type Measure struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// A reference to a Library resource containing the formal logic used by the measure
-	Library []string `json:"library,omitempty"`
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the measure
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The measure rate for an organization or clinician is based upon the entities' aggregate data
-	RateAggregation *string `json:"rateAggregation,omitempty"`
+	RateAggregation        *string         `json:"rateAggregation,omitempty"`
+	RateAggregationElement *common.Element `json:"_rateAggregation,omitempty"`
 
 	// Provides a succinct statement of the need for the measure
-	Rationale *string `json:"rationale,omitempty"`
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -96,13 +112,15 @@ type Measure struct {
 	Reviewer []common.ContactDetail `json:"reviewer,omitempty"`
 
 	// Describes the method of adjusting for clinical severity and conditions present at the start of care
-	RiskAdjustment *string `json:"riskAdjustment,omitempty"`
+	RiskAdjustment        *string         `json:"riskAdjustment,omitempty"`
+	RiskAdjustmentElement *common.Element `json:"_riskAdjustment,omitempty"`
 
 	// Indicates how the calculation is performed for the measure
 	Scoring *common.CodeableConcept `json:"scoring,omitempty"`
 
 	// Allows filtering of measures that are appropriate for use versus not
-	Status MeasureStatus `json:"status"`
+	Status        MeasureStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The subject of the measure is critical in interpreting the criteria definitions
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
@@ -111,13 +129,15 @@ type Measure struct {
 	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
 
 	// An explanatory or alternate title for the measure giving additional information about its content
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// Note that supplemental data are reported as observations for each patient
 	SupplementalData []MeasureSupplementalData `json:"supplementalData,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the measure
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -126,16 +146,19 @@ type Measure struct {
 	Type []common.CodeableConcept `json:"type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description, from a clinical perspective, of how the measure is used
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different measure instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // MeasureStatus represents the status of the measure
@@ -156,7 +179,8 @@ type MeasureGroup struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The human readable description of this population group
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The populations that make up the population group, one for each type of population appropriate for the measure
 	Population []MeasureGroupPopulation `json:"population,omitempty"`
@@ -176,7 +200,8 @@ type MeasureGroupPopulation struct {
 	Criteria common.Expression `json:"criteria"`
 
 	// The human readable description of this population criteria
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 }
 
 // MeasureGroupStratifier represents the stratifier criteria for the measure report
@@ -187,13 +212,15 @@ type MeasureGroupStratifier struct {
 	Criteria *common.Expression `json:"criteria,omitempty"`
 
 	// The human readable description of this stratifier
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The identifier for the stratifier used to coordinate the reported data back to this stratifier
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// The path to an element that defines the stratifier, specified as a valid FHIR resource path
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 }
 
 // MeasureSupplementalData represents the supplemental data criteria for the measure report
@@ -204,7 +231,8 @@ type MeasureSupplementalData struct {
 	Criteria common.Expression `json:"criteria"`
 
 	// The human readable description of this supplemental data
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An identifier for the supplemental data
 	Identifier *common.Identifier `json:"identifier,omitempty"`
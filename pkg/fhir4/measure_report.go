@@ -12,7 +12,8 @@ type MeasureReport struct {
 	ResourceType string `json:"resourceType"` // Always "MeasureReport"
 
 	// The date this measure report was generated
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// A reference to a Bundle containing the Resources that were used in the calculation of this measure
 	EvaluatedResource []common.Reference `json:"evaluatedResource,omitempty"`
@@ -24,7 +25,8 @@ type MeasureReport struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// A reference to the Measure that was calculated to produce this report
-	Measure string `json:"measure"`
+	Measure        string          `json:"measure"`
+	MeasureElement *common.Element `json:"_measure,omitempty"`
 
 	// The reporting period for which the report was calculated
 	Period common.Period `json:"period"`
@@ -33,10 +35,12 @@ type MeasureReport struct {
 	Reporter *common.Reference `json:"reporter,omitempty"`
 
 	// The status of the measure report
-	Status MeasureReportStatus `json:"status"`
+	Status        MeasureReportStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// The type of measure report
-	Type MeasureReportType `json:"type"`
+	Type        MeasureReportType `json:"type"`
+	TypeElement *common.Element   `json:"_type,omitempty"`
 }
 
 // MeasureReportStatus represents the status of the measure report
@@ -86,7 +90,8 @@ type MeasureReportGroupPopulation struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The number of members of the population
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// The identifier of the population being reported, as defined by the population element of the measure
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -131,7 +136,8 @@ type MeasureReportGroupStratifierStratumPopulation struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The number of members of the population in this stratum
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// The identifier of the population being reported, as defined by the population element of the measure
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -21,7 +21,8 @@ type Media struct {
 	Content common.Attachment `json:"content"`
 
 	// The date and time(s) at which the media was collected
-	CreatedDateTime *string `json:"createdDateTime,omitempty"`
+	CreatedDateTime        *common.DateTime `json:"createdDateTime,omitempty"`
+	CreatedDateTimeElement *common.Element  `json:"_createdDateTime,omitempty"`
 
 	// The date and time(s) at which the media was collected
 	CreatedPeriod *common.Period `json:"createdPeriod,omitempty"`
@@ -30,25 +31,30 @@ type Media struct {
 	Device *common.Reference `json:"device,omitempty"`
 
 	// The name of the device / manufacturer of the device that was used to make the recording
-	DeviceName *string `json:"deviceName,omitempty"`
+	DeviceName        *string         `json:"deviceName,omitempty"`
+	DeviceNameElement *common.Element `json:"_deviceName,omitempty"`
 
 	// The duration might differ from occurrencePeriod if recording was paused
-	Duration *float64 `json:"duration,omitempty"`
+	Duration        *common.Decimal `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
 
 	// This will typically be the encounter the media occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// If the number of frames is not supplied, the value may be unknown
-	Frames *int `json:"frames,omitempty"`
+	Frames        *int            `json:"frames,omitempty"`
+	FramesElement *common.Element `json:"_frames,omitempty"`
 
 	// Height of the image in pixels (photo/video)
-	Height *int `json:"height,omitempty"`
+	Height        *int            `json:"height,omitempty"`
+	HeightElement *common.Element `json:"_height,omitempty"`
 
 	// The identifier label and use can be used to determine what kind of identifier it is
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// It may be the same as the lastUpdated time of the resource itself
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
 
 	// Details of the type of the media - usually, how it was acquired (what type of device)
 	Modality *common.CodeableConcept `json:"modality,omitempty"`
@@ -66,7 +72,8 @@ type Media struct {
 	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
 
 	// A nominal state-transition diagram can be found in the Event pattern documentation
-	Status MediaStatus `json:"status"`
+	Status        MediaStatus     `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Who/What this Media is a record of
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -78,7 +85,8 @@ type Media struct {
 	View *common.CodeableConcept `json:"view,omitempty"`
 
 	// Width of the image in pixels (photo/video)
-	Width *int `json:"width,omitempty"`
+	Width        *int            `json:"width,omitempty"`
+	WidthElement *common.Element `json:"_width,omitempty"`
 }
 
 // MediaStatus represents the status of the media
@@ -17,7 +17,8 @@ type Medication struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// active | inactive | entered-in-error
-	Status *MedicationStatus `json:"status,omitempty"`
+	Status        *MedicationStatus `json:"status,omitempty"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Manufacturer of the medication
 	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
@@ -51,7 +52,8 @@ type MedicationIngredient struct {
 	ItemReference       *common.Reference       `json:"itemReference,omitempty"`
 
 	// Indication of whether this ingredient affects the therapeutic action of the drug
-	IsActive *bool `json:"isActive,omitempty"`
+	IsActive        *bool           `json:"isActive,omitempty"`
+	IsActiveElement *common.Element `json:"_isActive,omitempty"`
 
 	// Quantity of ingredient present
 	Strength *Ratio `json:"strength,omitempty"`
@@ -61,8 +63,10 @@ type MedicationBatch struct {
 	common.BackboneElement
 
 	// Identifier assigned to batch
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// When batch will expire
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 }
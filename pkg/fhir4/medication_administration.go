@@ -24,7 +24,8 @@ type MedicationAdministration struct {
 	Dosage *MedicationAdministrationDosage `json:"dosage,omitempty"`
 
 	// A specific date/time or interval of time during which the administration took place
-	EffectiveDateTime *string `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
 
 	// A specific date/time or interval of time during which the administration took place
 	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
@@ -36,7 +37,8 @@ type MedicationAdministration struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// A protocol, guideline, orderset, or other definition that was adhered to in whole or in part by this event
-	Instantiates []string `json:"instantiates,omitempty"`
+	Instantiates        []string          `json:"instantiates,omitempty"`
+	InstantiatesElement []*common.Element `json:"_instantiates,omitempty"`
 
 	// If only a code is specified, then it needs to be a code for a specific product
 	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
@@ -63,7 +65,8 @@ type MedicationAdministration struct {
 	Request *common.Reference `json:"request,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status MedicationAdministrationStatus `json:"status"`
+	Status        MedicationAdministrationStatus `json:"status"`
+	StatusElement *common.Element                `json:"_status,omitempty"`
 
 	// A code indicating why the administration was not performed
 	StatusReason []common.CodeableConcept `json:"statusReason,omitempty"`
@@ -122,5 +125,6 @@ type MedicationAdministrationDosage struct {
 	Site *common.CodeableConcept `json:"site,omitempty"`
 
 	// Free text dosage instructions e.g. SIG
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
@@ -30,7 +30,7 @@ type MedicationDispense struct {
 	DetectedIssue []common.Reference `json:"detectedIssue,omitempty"`
 
 	// When the dose or rate is intended to change over the entire administration period
-	DosageInstruction []common.Dosage `json:"dosageInstruction,omitempty"`
+	DosageInstruction []Dosage `json:"dosageInstruction,omitempty"`
 
 	// This might not include provenances for all versions of the request
 	EventHistory []common.Reference `json:"eventHistory,omitempty"`
@@ -63,7 +63,8 @@ type MedicationDispense struct {
 	Receiver []common.Reference `json:"receiver,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status MedicationDispenseStatus `json:"status"`
+	Status        MedicationDispenseStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 
 	// Indicates the reason why a dispense was not performed
 	StatusReasonCodeableConcept *common.CodeableConcept `json:"statusReasonCodeableConcept,omitempty"`
@@ -84,10 +85,12 @@ type MedicationDispense struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// The time the dispensed product was provided to the patient or their representative
-	WhenHandedOver *string `json:"whenHandedOver,omitempty"`
+	WhenHandedOver        *common.DateTime `json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement *common.Element  `json:"_whenHandedOver,omitempty"`
 
 	// The time when the dispensed product was packaged and reviewed
-	WhenPrepared *string `json:"whenPrepared,omitempty"`
+	WhenPrepared        *common.DateTime `json:"whenPrepared,omitempty"`
+	WhenPreparedElement *common.Element  `json:"_whenPrepared,omitempty"`
 }
 
 // MedicationDispenseStatus represents the status of the medication dispense
@@ -130,5 +133,6 @@ type MedicationDispenseSubstitution struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// True if the dispenser dispensed a different drug or product from what was prescribed
-	WasSubstituted bool `json:"wasSubstituted"`
+	WasSubstituted        bool            `json:"wasSubstituted"`
+	WasSubstitutedElement *common.Element `json:"_wasSubstituted,omitempty"`
 }
@@ -60,7 +60,8 @@ type MedicationKnowledge struct {
 	Packaging *MedicationKnowledgePackaging `json:"packaging,omitempty"`
 
 	// The instructions for preparing the medication
-	PreparationInstruction *string `json:"preparationInstruction,omitempty"`
+	PreparationInstruction        *string         `json:"preparationInstruction,omitempty"`
+	PreparationInstructionElement *common.Element `json:"_preparationInstruction,omitempty"`
 
 	// Category of the medication or product (e.g. branded product, therapeutic moeity, generic product, innovator product, etc.)
 	ProductType []common.CodeableConcept `json:"productType,omitempty"`
@@ -72,10 +73,12 @@ type MedicationKnowledge struct {
 	RelatedMedicationKnowledge []MedicationKnowledgeRelatedMedicationKnowledge `json:"relatedMedicationKnowledge,omitempty"`
 
 	// This status is intended to identify if the medication in a local system is in active use within a drug database or inventory
-	Status *MedicationKnowledgeStatus `json:"status,omitempty"`
+	Status        *MedicationKnowledgeStatus `json:"status,omitempty"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
 
 	// Additional names for a medication, for example, the name(s) given to a medication in different countries
-	Synonym []string `json:"synonym,omitempty"`
+	Synonym        []string          `json:"synonym,omitempty"`
+	SynonymElement []*common.Element `json:"_synonym,omitempty"`
 }
 
 // MedicationKnowledgeStatus represents the status of the medication knowledge
@@ -114,7 +117,8 @@ type MedicationKnowledgeIngredient struct {
 	common.BackboneElement
 
 	// Indication of whether this ingredient affects the therapeutic action of the drug
-	IsActive *bool `json:"isActive,omitempty"`
+	IsActive        *bool           `json:"isActive,omitempty"`
+	IsActiveElement *common.Element `json:"_isActive,omitempty"`
 
 	// The actual ingredient - either a substance (simple ingredient) or another medication
 	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
@@ -134,7 +138,8 @@ type MedicationKnowledgeCost struct {
 	Cost common.Money `json:"cost"`
 
 	// The source or owner that assigns the price to the medication
-	Source *string `json:"source,omitempty"`
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// The category of the cost information
 	Type common.CodeableConcept `json:"type"`
@@ -145,7 +150,8 @@ type MedicationKnowledgeMonitoringProgram struct {
 	common.BackboneElement
 
 	// Name of the reviewing program
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Type of program under which the medication is monitored
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -173,7 +179,7 @@ type MedicationKnowledgeAdministrationGuidelinesDosage struct {
 	common.BackboneElement
 
 	// Dosage for the medication for the specific guidelines
-	Dosage []common.Dosage `json:"dosage"`
+	Dosage []Dosage `json:"dosage"`
 
 	// The type of dosage (for example, prophylaxis, maintenance, therapeutic, etc.)
 	Type common.CodeableConcept `json:"type"`
@@ -226,7 +232,8 @@ type MedicationKnowledgeDrugCharacteristic struct {
 	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
 
 	// Description of the characteristic
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 }
 
 // MedicationKnowledgeRegulatory represents regulatory information about a medication
@@ -251,7 +258,8 @@ type MedicationKnowledgeRegulatorySubstitution struct {
 	common.BackboneElement
 
 	// Specifies the type of substitution allowed
-	Allowed bool `json:"allowed"`
+	Allowed        bool            `json:"allowed"`
+	AllowedElement *common.Element `json:"_allowed,omitempty"`
 
 	// Specifies the type of substitution allowed
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -14,26 +14,31 @@ type MedicationRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// active | on-hold | cancelled | completed | entered-in-error | stopped | draft | unknown
-	Status MedicationRequestStatus `json:"status"`
+	Status        MedicationRequestStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
 
 	// proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option
-	Intent MedicationRequestIntent `json:"intent"`
+	Intent        MedicationRequestIntent `json:"intent"`
+	IntentElement *common.Element         `json:"_intent,omitempty"`
 
 	// Type of medication usage
 	Category []common.CodeableConcept `json:"category,omitempty"`
 
 	// routine | urgent | asap | stat
-	Priority *MedicationRequestPriority `json:"priority,omitempty"`
+	Priority        *MedicationRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element            `json:"_priority,omitempty"`
 
 	// True if request is prohibiting action
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// Reported rather than primary record
-	ReportedBoolean   *bool             `json:"reportedBoolean,omitempty"`
-	ReportedReference *common.Reference `json:"reportedReference,omitempty"`
+	ReportedBoolean        *bool             `json:"reportedBoolean,omitempty"`
+	ReportedBooleanElement *common.Element   `json:"_reportedBoolean,omitempty"`
+	ReportedReference      *common.Reference `json:"reportedReference,omitempty"`
 
 	// Medication to be taken
 	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
@@ -49,7 +54,8 @@ type MedicationRequest struct {
 	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
 
 	// When request was initially authored
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Who/What requested the Request
 	Requester *common.Reference `json:"requester,omitempty"`
@@ -62,8 +68,10 @@ type MedicationRequest struct {
 	ReasonReference []common.Reference       `json:"reasonReference,omitempty"`
 
 	// Instantiates FHIR protocol or definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string `json:"instantiatesUri,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// What request fulfills
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -147,7 +155,8 @@ type MedicationRequestDispenseRequest struct {
 	ValidityPeriod *common.Period `json:"validityPeriod,omitempty"`
 
 	// Number of refills authorized
-	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed        *int            `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *common.Element `json:"_numberOfRepeatsAllowed,omitempty"`
 
 	// Amount of medication to supply per dispense
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -174,6 +183,7 @@ type MedicationRequestSubstitution struct {
 
 	// Whether substitution is allowed or not
 	AllowedBoolean         *bool                   `json:"allowedBoolean,omitempty"`
+	AllowedBooleanElement  *common.Element         `json:"_allowedBoolean,omitempty"`
 	AllowedCodeableConcept *common.CodeableConcept `json:"allowedCodeableConcept,omitempty"`
 
 	// Why should (not) substitution be made
@@ -21,16 +21,18 @@ type MedicationStatement struct {
 	Context *common.Reference `json:"context,omitempty"`
 
 	// The date when the medication statement was asserted by the information source
-	DateAsserted *string `json:"dateAsserted,omitempty"`
+	DateAsserted        *common.DateTime `json:"dateAsserted,omitempty"`
+	DateAssertedElement *common.Element  `json:"_dateAsserted,omitempty"`
 
 	// Likely references would be to MedicationRequest, MedicationDispense, Claim, Observation or QuestionnaireAnswers
 	DerivedFrom []common.Reference `json:"derivedFrom,omitempty"`
 
 	// The dates included in the dosage on a Medication Statement reflect the dates for a given dose
-	Dosage []common.Dosage `json:"dosage,omitempty"`
+	Dosage []Dosage `json:"dosage,omitempty"`
 
 	// This attribute reflects the period over which the patient consumed the medication
-	EffectiveDateTime *string `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
 
 	// This attribute reflects the period over which the patient consumed the medication
 	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
@@ -60,7 +62,8 @@ type MedicationStatement struct {
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
 	// MedicationStatement is a statement at a point in time
-	Status MedicationStatementStatus `json:"status"`
+	Status        MedicationStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// This is generally only used for "exception" statuses such as "not-taken", "on-hold", "cancelled" or "entered-in-error"
 	StatusReason []common.CodeableConcept `json:"statusReason,omitempty"`
@@ -66,7 +66,8 @@ type MedicinalProduct struct {
 	SpecialDesignation []MedicinalProductSpecialDesignation `json:"specialDesignation,omitempty"`
 
 	// Whether the Medicinal Product is subject to special measures for regulatory reasons
-	SpecialMeasures []string `json:"specialMeasures,omitempty"`
+	SpecialMeasures        []string          `json:"specialMeasures,omitempty"`
+	SpecialMeasuresElement []*common.Element `json:"_specialMeasures,omitempty"`
 
 	// Regulatory type, e.g. Investigational or Authorized
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -83,7 +84,8 @@ type MedicinalProductName struct {
 	NamePart []MedicinalProductNameNamePart `json:"namePart,omitempty"`
 
 	// The full product name
-	ProductName string `json:"productName"`
+	ProductName        string          `json:"productName"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
 }
 
 // MedicinalProductNameNamePart represents coding words or phrases of the name
@@ -91,7 +93,8 @@ type MedicinalProductNameNamePart struct {
 	common.BackboneElement
 
 	// A fragment of a product name
-	Part string `json:"part"`
+	Part        string          `json:"part"`
+	PartElement *common.Element `json:"_part,omitempty"`
 
 	// Identifying type for this part of the name (e.g. strength part)
 	Type common.Coding `json:"type"`
@@ -122,7 +125,8 @@ type MedicinalProductManufacturingBusinessOperation struct {
 	ConfidentialityIndicator *common.CodeableConcept `json:"confidentialityIndicator,omitempty"`
 
 	// Regulatory authorization date
-	EffectiveDate *string `json:"effectiveDate,omitempty"`
+	EffectiveDate        *common.DateTime `json:"effectiveDate,omitempty"`
+	EffectiveDateElement *common.Element  `json:"_effectiveDate,omitempty"`
 
 	// The manufacturer or establishment associated with the process
 	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
@@ -139,7 +143,8 @@ type MedicinalProductSpecialDesignation struct {
 	common.BackboneElement
 
 	// Date when the designation was granted
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Identifier for the designation, or procedure number
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -177,7 +182,8 @@ type MedicinalProductMarketingStatus struct {
 	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The date when the Medicinal Product is placed on the market
-	RestoreDate *string `json:"restoreDate,omitempty"`
+	RestoreDate        *common.DateTime `json:"restoreDate,omitempty"`
+	RestoreDateElement *common.Element  `json:"_restoreDate,omitempty"`
 
 	// This attribute provides information on the status of the marketing of the medicinal product
 	Status common.CodeableConcept `json:"status"`
@@ -18,7 +18,8 @@ type MedicinalProductAuthorization struct {
 	DataExclusivityPeriod *common.Period `json:"dataExclusivityPeriod,omitempty"`
 
 	// The date when the first authorization was granted by a Medicines Regulatory Agency
-	DateOfFirstAuthorization *string `json:"dateOfFirstAuthorization,omitempty"`
+	DateOfFirstAuthorization        *string         `json:"dateOfFirstAuthorization,omitempty"`
+	DateOfFirstAuthorizationElement *common.Element `json:"_dateOfFirstAuthorization,omitempty"`
 
 	// Marketing Authorization Holder
 	Holder *common.Reference `json:"holder,omitempty"`
@@ -27,7 +28,8 @@ type MedicinalProductAuthorization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Date of first marketing authorization for a company's new medicinal product in any country in the World
-	InternationalBirthDate *string `json:"internationalBirthDate,omitempty"`
+	InternationalBirthDate        *common.DateTime `json:"internationalBirthDate,omitempty"`
+	InternationalBirthDateElement *common.Element  `json:"_internationalBirthDate,omitempty"`
 
 	// Jurisdiction within a country
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
@@ -45,13 +47,15 @@ type MedicinalProductAuthorization struct {
 	Regulator *common.Reference `json:"regulator,omitempty"`
 
 	// The date when a suspended the marketing or the marketing authorization of the product is anticipated to be restored
-	RestoreDate *string `json:"restoreDate,omitempty"`
+	RestoreDate        *common.DateTime `json:"restoreDate,omitempty"`
+	RestoreDateElement *common.Element  `json:"_restoreDate,omitempty"`
 
 	// The status of the marketing authorization
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// The date at which the given status has become applicable
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
 
 	// The medicinal product that is being authorized
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -91,7 +95,8 @@ type MedicinalProductAuthorizationProcedure struct {
 	DatePeriod *common.Period `json:"datePeriod,omitempty"`
 
 	// Date of procedure
-	DateDateTime *string `json:"dateDateTime,omitempty"`
+	DateDateTime        *common.DateTime `json:"dateDateTime,omitempty"`
+	DateDateTimeElement *common.Element  `json:"_dateDateTime,omitempty"`
 
 	// Identifier for this procedure
 	Identifier *common.Identifier `json:"identifier,omitempty"`
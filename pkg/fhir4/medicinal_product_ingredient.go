@@ -12,7 +12,8 @@ type MedicinalProductIngredient struct {
 	ResourceType string `json:"resourceType"` // Always "MedicinalProductIngredient"
 
 	// If the ingredient is a known or suspected allergen
-	AllergenicIndicator *bool `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicator        *bool           `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *common.Element `json:"_allergenicIndicator,omitempty"`
 
 	// The identifier(s) of this Ingredient that are assigned by business processes
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -64,7 +65,8 @@ type MedicinalProductIngredientSpecifiedSubstanceStrength struct {
 	ConcentrationLowLimit *common.Ratio `json:"concentrationLowLimit,omitempty"`
 
 	// For when strength is measured at a particular point or distance
-	MeasurementPoint *string `json:"measurementPoint,omitempty"`
+	MeasurementPoint        *string         `json:"measurementPoint,omitempty"`
+	MeasurementPointElement *common.Element `json:"_measurementPoint,omitempty"`
 
 	// The country or countries for which the strength range applies
 	Country []common.CodeableConcept `json:"country,omitempty"`
@@ -87,7 +89,8 @@ type MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength struc
 	StrengthLowLimit *common.Ratio `json:"strengthLowLimit,omitempty"`
 
 	// For when strength is measured at a particular point or distance
-	MeasurementPoint *string `json:"measurementPoint,omitempty"`
+	MeasurementPoint        *string         `json:"measurementPoint,omitempty"`
+	MeasurementPointElement *common.Element `json:"_measurementPoint,omitempty"`
 
 	// The country or countries for which the strength range applies
 	Country []common.CodeableConcept `json:"country,omitempty"`
@@ -12,7 +12,8 @@ type MedicinalProductInteraction struct {
 	ResourceType string `json:"resourceType"` // Always "MedicinalProductInteraction"
 
 	// The interaction described
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The effect of the interaction, for example "reduced gastric absorption of primary medication"
 	Effect *common.CodeableConcept `json:"effect,omitempty"`
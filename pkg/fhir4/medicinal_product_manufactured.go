@@ -38,7 +38,8 @@ type MedicinalProductManufacturedPhysicalCharacteristics struct {
 	common.BackboneElement
 
 	// Where applicable, the color can be specified
-	Color []string `json:"color,omitempty"`
+	Color        []string          `json:"color,omitempty"`
+	ColorElement []*common.Element `json:"_color,omitempty"`
 
 	// Where applicable, the depth can be specified using a numerical value and its unit of measurement
 	Depth *common.Quantity `json:"depth,omitempty"`
@@ -53,7 +54,8 @@ type MedicinalProductManufacturedPhysicalCharacteristics struct {
 	Image []common.Attachment `json:"image,omitempty"`
 
 	// Where applicable, the imprint can be specified as text
-	Imprint []string `json:"imprint,omitempty"`
+	Imprint        []string          `json:"imprint,omitempty"`
+	ImprintElement []*common.Element `json:"_imprint,omitempty"`
 
 	// Where applicable, the nominal volume can be specified using a numerical value and its unit of measurement
 	NominalVolume *common.Quantity `json:"nominalVolume,omitempty"`
@@ -62,7 +64,8 @@ type MedicinalProductManufacturedPhysicalCharacteristics struct {
 	Scoring *common.CodeableConcept `json:"scoring,omitempty"`
 
 	// Where applicable, the shape can be specified
-	Shape *string `json:"shape,omitempty"`
+	Shape        *string         `json:"shape,omitempty"`
+	ShapeElement *common.Element `json:"_shape,omitempty"`
 
 	// Where applicable, the weight can be specified using a numerical value and its unit of measurement
 	Weight *common.Quantity `json:"weight,omitempty"`
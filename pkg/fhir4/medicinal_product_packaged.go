@@ -15,7 +15,8 @@ type MedicinalProductPackaged struct {
 	BatchIdentifier []MedicinalProductPackagedBatchIdentifier `json:"batchIdentifier,omitempty"`
 
 	// Textual description
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Unique identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -96,7 +97,8 @@ type MedicinalProductPackagedPhysicalCharacteristics struct {
 	common.BackboneElement
 
 	// Where applicable, the color can be specified
-	Color []string `json:"color,omitempty"`
+	Color        []string          `json:"color,omitempty"`
+	ColorElement []*common.Element `json:"_color,omitempty"`
 
 	// Where applicable, the depth can be specified using a numerical value and its unit of measurement
 	Depth *common.Quantity `json:"depth,omitempty"`
@@ -111,7 +113,8 @@ type MedicinalProductPackagedPhysicalCharacteristics struct {
 	Image []common.Attachment `json:"image,omitempty"`
 
 	// Where applicable, the imprint can be specified as text
-	Imprint []string `json:"imprint,omitempty"`
+	Imprint        []string          `json:"imprint,omitempty"`
+	ImprintElement []*common.Element `json:"_imprint,omitempty"`
 
 	// Where applicable, the nominal volume can be specified using a numerical value and its unit of measurement
 	NominalVolume *common.Quantity `json:"nominalVolume,omitempty"`
@@ -120,7 +123,8 @@ type MedicinalProductPackagedPhysicalCharacteristics struct {
 	Scoring *common.CodeableConcept `json:"scoring,omitempty"`
 
 	// Where applicable, the shape can be specified
-	Shape *string `json:"shape,omitempty"`
+	Shape        *string         `json:"shape,omitempty"`
+	ShapeElement *common.Element `json:"_shape,omitempty"`
 
 	// Where applicable, the weight can be specified using a numerical value and its unit of measurement
 	Weight *common.Quantity `json:"weight,omitempty"`
@@ -160,7 +164,8 @@ type MedicinalProductPackagedMarketingStatus struct {
 	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The date when the Medicinal Product is placed on the market
-	RestoreDate *string `json:"restoreDate,omitempty"`
+	RestoreDate        *common.DateTime `json:"restoreDate,omitempty"`
+	RestoreDateElement *common.Element  `json:"_restoreDate,omitempty"`
 
 	// This attribute provides information on the status of the marketing of the medicinal product
 	Status common.CodeableConcept `json:"status"`
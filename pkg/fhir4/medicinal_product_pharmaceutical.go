@@ -86,7 +86,8 @@ type MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalP
 	common.BackboneElement
 
 	// Extra information about the withdrawal period
-	SupportingInformation *string `json:"supportingInformation,omitempty"`
+	SupportingInformation        *string         `json:"supportingInformation,omitempty"`
+	SupportingInformationElement *common.Element `json:"_supportingInformation,omitempty"`
 
 	// Coded expression for the type of tissue for which the withdrawal period applies, e.g. meat, milk
 	Tissue common.CodeableConcept `json:"tissue"`
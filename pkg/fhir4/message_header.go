@@ -15,7 +15,8 @@ type MessageHeader struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// Permanent link to the MessageDefinition for this message
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// There SHOULD be at least one destination, but in some circumstances, the source system is unaware of any particular destination system
 	Destination []MessageHeaderDestination `json:"destination,omitempty"`
@@ -27,7 +28,8 @@ type MessageHeader struct {
 	EventCoding *common.Coding `json:"eventCoding,omitempty"`
 
 	// The time of the event will be found in the focus resource
-	EventUri *string `json:"eventUri,omitempty"`
+	EventUri        *string         `json:"eventUri,omitempty"`
+	EventUriElement *common.Element `json:"_eventUri,omitempty"`
 
 	// The data is defined where the transaction type is defined
 	Focus []common.Reference `json:"focus,omitempty"`
@@ -53,10 +55,12 @@ type MessageHeaderDestination struct {
 	common.BackboneElement
 
 	// The id may be a non-resolvable URI for systems that do not use standard network-based addresses
-	Endpoint string `json:"endpoint"`
+	Endpoint        string          `json:"endpoint"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
 
 	// Human-readable name for the target system
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Allows data conveyed by a message to be addressed to a particular person or department
 	Receiver *common.Reference `json:"receiver,omitempty"`
@@ -73,16 +77,20 @@ type MessageHeaderSource struct {
 	Contact *common.ContactPoint `json:"contact,omitempty"`
 
 	// The id may be a non-resolvable URI for systems that do not use standard network-based addresses
-	Endpoint string `json:"endpoint"`
+	Endpoint        string          `json:"endpoint"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
 
 	// Human-readable name for the source system
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// May include configuration or other information useful in debugging
-	Software *string `json:"software,omitempty"`
+	Software        *string         `json:"software,omitempty"`
+	SoftwareElement *common.Element `json:"_software,omitempty"`
 
 	// Can convey versions of multiple systems in situations where a message passes through multiple hands
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // MessageHeaderResponse represents information about the message that this message is a response to
@@ -90,13 +98,15 @@ type MessageHeaderResponse struct {
 	common.BackboneElement
 
 	// This is a generic response to the request message
-	Code MessageHeaderResponseCode `json:"code"`
+	Code        MessageHeaderResponseCode `json:"code"`
+	CodeElement *common.Element           `json:"_code,omitempty"`
 
 	// This SHALL be contained in the bundle
 	Details *common.Reference `json:"details,omitempty"`
 
 	// The MessageHeader.id of the message to which this message is a response
-	Identifier string `json:"identifier"`
+	Identifier        string          `json:"identifier"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
 }
 
 // MessageHeaderResponseCode represents the response code
@@ -12,7 +12,8 @@ type MolecularSequence struct {
 	ResourceType string `json:"resourceType"` // Always "MolecularSequence"
 
 	// Whether the sequence is numbered starting at 0 (0-based numbering or coordinates, inclusive start, exclusive end) or starting at 1 (1-based numbering, inclusive start and inclusive end)
-	CoordinateSystem int `json:"coordinateSystem"`
+	CoordinateSystem        int             `json:"coordinateSystem"`
+	CoordinateSystemElement *common.Element `json:"_coordinateSystem,omitempty"`
 
 	// The method for sequencing, for example, chip information
 	Device *common.Reference `json:"device,omitempty"`
@@ -21,7 +22,8 @@ type MolecularSequence struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Sequence that was observed
-	ObservedSeq *string `json:"observedSeq,omitempty"`
+	ObservedSeq        *string         `json:"observedSeq,omitempty"`
+	ObservedSeqElement *common.Element `json:"_observedSeq,omitempty"`
 
 	// The patient whose sequencing results are described by this resource
 	Patient *common.Reference `json:"patient,omitempty"`
@@ -39,7 +41,8 @@ type MolecularSequence struct {
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// Coverage (read depth or depth) is the average number of reads representing a given nucleotide in the reconstructed sequence
-	ReadCoverage *int `json:"readCoverage,omitempty"`
+	ReadCoverage        *int            `json:"readCoverage,omitempty"`
+	ReadCoverageElement *common.Element `json:"_readCoverage,omitempty"`
 
 	// A sequence that is used as a reference to describe variants that are present in a sequence analyzed
 	ReferenceSeq *MolecularSequenceReferenceSeq `json:"referenceSeq,omitempty"`
@@ -54,7 +57,8 @@ type MolecularSequence struct {
 	StructureVariant []MolecularSequenceStructureVariant `json:"structureVariant,omitempty"`
 
 	// Amino Acid Sequence/ DNA Sequence / RNA Sequence
-	Type *MolecularSequenceType `json:"type,omitempty"`
+	Type        *MolecularSequenceType `json:"type,omitempty"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
 
 	// The definition of variant here originates from Sequence ontology
 	Variant []MolecularSequenceVariant `json:"variant,omitempty"`
@@ -77,10 +81,12 @@ type MolecularSequenceReferenceSeq struct {
 	Chromosome *common.CodeableConcept `json:"chromosome,omitempty"`
 
 	// The Genome Build used for reference, following GRCh build versions e.g. 'GRCh 37'
-	GenomeBuild *string `json:"genomeBuild,omitempty"`
+	GenomeBuild        *string         `json:"genomeBuild,omitempty"`
+	GenomeBuildElement *common.Element `json:"_genomeBuild,omitempty"`
 
 	// A relative reference to a DNA strand based on gene orientation
-	Orientation *MolecularSequenceReferenceSeqOrientation `json:"orientation,omitempty"`
+	Orientation        *MolecularSequenceReferenceSeqOrientation `json:"orientation,omitempty"`
+	OrientationElement *common.Element                           `json:"_orientation,omitempty"`
 
 	// Reference identifier of reference sequence submitted to NCBI
 	ReferenceSeqId *common.CodeableConcept `json:"referenceSeqId,omitempty"`
@@ -89,16 +95,20 @@ type MolecularSequenceReferenceSeq struct {
 	ReferenceSeqPointer *common.Reference `json:"referenceSeqPointer,omitempty"`
 
 	// A string like "ACGT"
-	ReferenceSeqString *string `json:"referenceSeqString,omitempty"`
+	ReferenceSeqString        *string         `json:"referenceSeqString,omitempty"`
+	ReferenceSeqStringElement *common.Element `json:"_referenceSeqString,omitempty"`
 
 	// An absolute reference to a strand
-	Strand *MolecularSequenceReferenceSeqStrand `json:"strand,omitempty"`
+	Strand        *MolecularSequenceReferenceSeqStrand `json:"strand,omitempty"`
+	StrandElement *common.Element                      `json:"_strand,omitempty"`
 
 	// End position of the window on the reference sequence
-	WindowEnd *int `json:"windowEnd,omitempty"`
+	WindowEnd        *int            `json:"windowEnd,omitempty"`
+	WindowEndElement *common.Element `json:"_windowEnd,omitempty"`
 
 	// Start position of the window on the reference sequence
-	WindowStart *int `json:"windowStart,omitempty"`
+	WindowStart        *int            `json:"windowStart,omitempty"`
+	WindowStartElement *common.Element `json:"_windowStart,omitempty"`
 }
 
 // MolecularSequenceReferenceSeqOrientation represents a relative reference to a DNA strand
@@ -122,19 +132,24 @@ type MolecularSequenceVariant struct {
 	common.BackboneElement
 
 	// Extended CIGAR string for aligning the sequence with reference bases
-	Cigar *string `json:"cigar,omitempty"`
+	Cigar        *string         `json:"cigar,omitempty"`
+	CigarElement *common.Element `json:"_cigar,omitempty"`
 
 	// End position of the variant on the reference sequence
-	End *int `json:"end,omitempty"`
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// An allele is one of a set of coexisting sequence variants of a gene
-	ObservedAllele *string `json:"observedAllele,omitempty"`
+	ObservedAllele        *string         `json:"observedAllele,omitempty"`
+	ObservedAlleleElement *common.Element `json:"_observedAllele,omitempty"`
 
 	// An allele is one of a set of coexisting sequence variants of a gene
-	ReferenceAllele *string `json:"referenceAllele,omitempty"`
+	ReferenceAllele        *string         `json:"referenceAllele,omitempty"`
+	ReferenceAlleleElement *common.Element `json:"_referenceAllele,omitempty"`
 
 	// Start position of the variant on the reference sequence
-	Start *int `json:"start,omitempty"`
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// A pointer to an Observation containing variant information
 	VariantPointer *common.Reference `json:"variantPointer,omitempty"`
@@ -145,28 +160,35 @@ type MolecularSequenceQuality struct {
 	common.BackboneElement
 
 	// End position of the sequence
-	End *int `json:"end,omitempty"`
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// Harmonic mean of Recall and Precision
-	FScore *float64 `json:"fScore,omitempty"`
+	FScore        *common.Decimal `json:"fScore,omitempty"`
+	FScoreElement *common.Element `json:"_fScore,omitempty"`
 
 	// The number of false positives where the non-REF alleles in the Truth and Query Call Sets match
-	GtFP *int `json:"gtFP,omitempty"`
+	GtFP        *int            `json:"gtFP,omitempty"`
+	GtFPElement *common.Element `json:"_gtFP,omitempty"`
 
 	// Which method is used to get sequence quality
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// QUERY.TP / (QUERY.TP + QUERY.FP)
-	Precision *float64 `json:"precision,omitempty"`
+	Precision        *common.Decimal `json:"precision,omitempty"`
+	PrecisionElement *common.Element `json:"_precision,omitempty"`
 
 	// False positives, i.e. the number of sites in the Query Call Set for which there is no path through the Truth Call Set
-	QueryFP *int `json:"queryFP,omitempty"`
+	QueryFP        *int            `json:"queryFP,omitempty"`
+	QueryFPElement *common.Element `json:"_queryFP,omitempty"`
 
 	// True positives, from the perspective of the query data
-	QueryTP *int `json:"queryTP,omitempty"`
+	QueryTP        *int            `json:"queryTP,omitempty"`
+	QueryTPElement *common.Element `json:"_queryTP,omitempty"`
 
 	// TRUTH.TP / (TRUTH.TP + TRUTH.FN)
-	Recall *float64 `json:"recall,omitempty"`
+	Recall        *common.Decimal `json:"recall,omitempty"`
+	RecallElement *common.Element `json:"_recall,omitempty"`
 
 	// Receiver Operator Characteristic (ROC) Curve to give sensitivity/specificity tradeoff
 	Roc *MolecularSequenceQualityRoc `json:"roc,omitempty"`
@@ -178,16 +200,20 @@ type MolecularSequenceQuality struct {
 	StandardSequence *common.CodeableConcept `json:"standardSequence,omitempty"`
 
 	// Start position of the sequence
-	Start *int `json:"start,omitempty"`
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// False negatives, i.e. the number of sites in the Truth Call Set for which there is no path through the Query Call Set
-	TruthFN *int `json:"truthFN,omitempty"`
+	TruthFN        *int            `json:"truthFN,omitempty"`
+	TruthFNElement *common.Element `json:"_truthFN,omitempty"`
 
 	// True positives, from the perspective of the truth data
-	TruthTP *int `json:"truthTP,omitempty"`
+	TruthTP        *int            `json:"truthTP,omitempty"`
+	TruthTPElement *common.Element `json:"_truthTP,omitempty"`
 
 	// INDEL / SNP / Undefined variant
-	Type MolecularSequenceQualityType `json:"type"`
+	Type        MolecularSequenceQualityType `json:"type"`
+	TypeElement *common.Element              `json:"_type,omitempty"`
 }
 
 // MolecularSequenceQualityType represents the type of quality
@@ -204,25 +230,32 @@ type MolecularSequenceQualityRoc struct {
 	common.BackboneElement
 
 	// Calculated fScore if the GQ score threshold was set to "score" field value
-	FMeasure []float64 `json:"fMeasure,omitempty"`
+	FMeasure        []common.Decimal  `json:"fMeasure,omitempty"`
+	FMeasureElement []*common.Element `json:"_fMeasure,omitempty"`
 
 	// The number of false negatives if the GQ score threshold was set to "score" field value
-	NumFN []int `json:"numFN,omitempty"`
+	NumFN        []int             `json:"numFN,omitempty"`
+	NumFNElement []*common.Element `json:"_numFN,omitempty"`
 
 	// The number of false positives if the GQ score threshold was set to "score" field value
-	NumFP []int `json:"numFP,omitempty"`
+	NumFP        []int             `json:"numFP,omitempty"`
+	NumFPElement []*common.Element `json:"_numFP,omitempty"`
 
 	// The number of true positives if the GQ score threshold was set to "score" field value
-	NumTP []int `json:"numTP,omitempty"`
+	NumTP        []int             `json:"numTP,omitempty"`
+	NumTPElement []*common.Element `json:"_numTP,omitempty"`
 
 	// Calculated precision if the GQ score threshold was set to "score" field value
-	Precision []float64 `json:"precision,omitempty"`
+	Precision        []common.Decimal  `json:"precision,omitempty"`
+	PrecisionElement []*common.Element `json:"_precision,omitempty"`
 
 	// Individual data point representing the GQ (genotype quality) score threshold
-	Score []int `json:"score,omitempty"`
+	Score        []int             `json:"score,omitempty"`
+	ScoreElement []*common.Element `json:"_score,omitempty"`
 
 	// Calculated sensitivity if the GQ score threshold was set to "score" field value
-	Sensitivity []float64 `json:"sensitivity,omitempty"`
+	Sensitivity        []common.Decimal  `json:"sensitivity,omitempty"`
+	SensitivityElement []*common.Element `json:"_sensitivity,omitempty"`
 }
 
 // MolecularSequenceRepository represents configurations of the external repository
@@ -230,22 +263,28 @@ type MolecularSequenceRepository struct {
 	common.BackboneElement
 
 	// Id of the variant in this external repository
-	DatasetId *string `json:"datasetId,omitempty"`
+	DatasetId        *string         `json:"datasetId,omitempty"`
+	DatasetIdElement *common.Element `json:"_datasetId,omitempty"`
 
 	// URI of an external repository which contains further details about the genetics data
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Id of the read in this external repository
-	ReadsetId *string `json:"readsetId,omitempty"`
+	ReadsetId        *string         `json:"readsetId,omitempty"`
+	ReadsetIdElement *common.Element `json:"_readsetId,omitempty"`
 
 	// Click and see / RESTful API / Need login to see / RESTful API with authentication / Other ways to see resource
-	Type MolecularSequenceRepositoryType `json:"type"`
+	Type        MolecularSequenceRepositoryType `json:"type"`
+	TypeElement *common.Element                 `json:"_type,omitempty"`
 
 	// URI of an external repository which contains further details about the genetics data
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// Id of the variantset in this external repository
-	VariantsetId *string `json:"variantsetId,omitempty"`
+	VariantsetId        *string         `json:"variantsetId,omitempty"`
+	VariantsetIdElement *common.Element `json:"_variantsetId,omitempty"`
 }
 
 // MolecularSequenceRepositoryType represents the type of repository
@@ -264,13 +303,15 @@ type MolecularSequenceStructureVariant struct {
 	common.BackboneElement
 
 	// Used to indicate if the outer and inner start-end values have the same meaning
-	Exact *bool `json:"exact,omitempty"`
+	Exact        *bool           `json:"exact,omitempty"`
+	ExactElement *common.Element `json:"_exact,omitempty"`
 
 	// Structural variant inner
 	Inner *MolecularSequenceStructureVariantInner `json:"inner,omitempty"`
 
 	// Length of the variant chromosome
-	Length *int `json:"length,omitempty"`
+	Length        *int            `json:"length,omitempty"`
+	LengthElement *common.Element `json:"_length,omitempty"`
 
 	// Structural variant outer
 	Outer *MolecularSequenceStructureVariantOuter `json:"outer,omitempty"`
@@ -284,10 +325,12 @@ type MolecularSequenceStructureVariantOuter struct {
 	common.BackboneElement
 
 	// Structural variant outer end
-	End *int `json:"end,omitempty"`
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// Structural variant outer start
-	Start *int `json:"start,omitempty"`
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 }
 
 // MolecularSequenceStructureVariantInner represents structural variant inner
@@ -295,8 +338,10 @@ type MolecularSequenceStructureVariantInner struct {
 	common.BackboneElement
 
 	// Structural variant inner end
-	End *int `json:"end,omitempty"`
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// Structural variant inner start
-	Start *int `json:"start,omitempty"`
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 }
@@ -15,28 +15,35 @@ type NamingSystem struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the naming system was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// It may be possible for the naming system to be used in jurisdictions other than those for which it was originally designed
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// Indicates the purpose for the naming system - what kinds of things does it make unique?
-	Kind NamingSystemKind `json:"kind"`
+	Kind        NamingSystemKind `json:"kind"`
+	KindElement *common.Element  `json:"_kind,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This is the primary organization
-	Responsible *string `json:"responsible,omitempty"`
+	Responsible        *string         `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
 
 	// Allows filtering of naming systems that are appropriate for use versus not
-	Status NamingSystemStatus `json:"status"`
+	Status        NamingSystemStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// This will most commonly be used for identifier namespaces
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -45,7 +52,8 @@ type NamingSystem struct {
 	UniqueId []NamingSystemUniqueId `json:"uniqueId"`
 
 	// Provides guidance on the use of the namespace
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
@@ -75,19 +83,23 @@ type NamingSystemUniqueId struct {
 	common.BackboneElement
 
 	// e.g. "must be used in Germany" or "was initially published in error with this value"
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Within a registry, a given identifier should only be "active" for a single namespace at a time
 	Period *common.Period `json:"period,omitempty"`
 
 	// Indicates whether this identifier is the "preferred" identifier of this type
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 
 	// Different identifier types may be used in different types of communications
-	Type NamingSystemUniqueIdType `json:"type"`
+	Type        NamingSystemUniqueIdType `json:"type"`
+	TypeElement *common.Element          `json:"_type,omitempty"`
 
 	// If the value is a URI intended for use as FHIR system identifier
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // NamingSystemUniqueIdType represents different identifier types
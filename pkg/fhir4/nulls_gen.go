@@ -0,0 +1,1292 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir4
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MarshalJSON writes the repeating primitives of ActivityDefinition that only have an id
+// or extensions as null
+func (x ActivityDefinition) MarshalJSON() ([]byte, error) {
+	type plain ActivityDefinition
+	return json.Marshal(struct {
+		plain
+		Library []*string `json:"library,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Library, x.LibraryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Address that only have an id
+// or extensions as null
+func (x Address) MarshalJSON() ([]byte, error) {
+	type plain Address
+	return json.Marshal(struct {
+		plain
+		Line []*string `json:"line,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Line, x.LineElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of AllergyIntolerance that only have an id
+// or extensions as null
+func (x AllergyIntolerance) MarshalJSON() ([]byte, error) {
+	type plain AllergyIntolerance
+	return json.Marshal(struct {
+		plain
+		Category []*AllergyIntoleranceCategory `json:"category,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Category, x.CategoryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of AuditEventAgent that only have an id
+// or extensions as null
+func (x AuditEventAgent) MarshalJSON() ([]byte, error) {
+	type plain AuditEventAgent
+	return json.Marshal(struct {
+		plain
+		Policy []*string `json:"policy,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Policy, x.PolicyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CapabilityStatementRest that only have an id
+// or extensions as null
+func (x CapabilityStatementRest) MarshalJSON() ([]byte, error) {
+	type plain CapabilityStatementRest
+	return json.Marshal(struct {
+		plain
+		Compartment []*string `json:"compartment,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Compartment, x.CompartmentElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CapabilityStatementRestResource that only have an id
+// or extensions as null
+func (x CapabilityStatementRestResource) MarshalJSON() ([]byte, error) {
+	type plain CapabilityStatementRestResource
+	return json.Marshal(struct {
+		plain
+		SupportedProfile []*string `json:"supportedProfile,omitempty"`
+		ReferencePolicy  []*string `json:"referencePolicy,omitempty"`
+		SearchInclude    []*string `json:"searchInclude,omitempty"`
+		SearchRevInclude []*string `json:"searchRevInclude,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.SupportedProfile, x.SupportedProfileElement),
+		common.PrimitiveValues(x.ReferencePolicy, x.ReferencePolicyElement),
+		common.PrimitiveValues(x.SearchInclude, x.SearchIncludeElement),
+		common.PrimitiveValues(x.SearchRevInclude, x.SearchRevIncludeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ChargeItemDefinition that only have an id
+// or extensions as null
+func (x ChargeItemDefinition) MarshalJSON() ([]byte, error) {
+	type plain ChargeItemDefinition
+	return json.Marshal(struct {
+		plain
+		DerivedFromUri []*string `json:"derivedFromUri,omitempty"`
+		PartOf         []*string `json:"partOf,omitempty"`
+		Replaces       []*string `json:"replaces,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DerivedFromUri, x.DerivedFromUriElement),
+		common.PrimitiveValues(x.PartOf, x.PartOfElement),
+		common.PrimitiveValues(x.Replaces, x.ReplacesElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimInsurance that only have an id
+// or extensions as null
+func (x ClaimInsurance) MarshalJSON() ([]byte, error) {
+	type plain ClaimInsurance
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimItem that only have an id
+// or extensions as null
+func (x ClaimItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimItem
+	return json.Marshal(struct {
+		plain
+		CareTeamLinkId    []*int `json:"careTeamLinkId,omitempty"`
+		DiagnosisLinkId   []*int `json:"diagnosisLinkId,omitempty"`
+		ProcedureLinkId   []*int `json:"procedureLinkId,omitempty"`
+		InformationLinkId []*int `json:"informationLinkId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.CareTeamLinkId, x.CareTeamLinkIdElement),
+		common.PrimitiveValues(x.DiagnosisLinkId, x.DiagnosisLinkIdElement),
+		common.PrimitiveValues(x.ProcedureLinkId, x.ProcedureLinkIdElement),
+		common.PrimitiveValues(x.InformationLinkId, x.InformationLinkIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseAddItem that only have an id
+// or extensions as null
+func (x ClaimResponseAddItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseAddItem
+	return json.Marshal(struct {
+		plain
+		DetailSequence    []*int `json:"detailSequence,omitempty"`
+		ItemSequence      []*int `json:"itemSequence,omitempty"`
+		NoteNumber        []*int `json:"noteNumber,omitempty"`
+		SubdetailSequence []*int `json:"subdetailSequence,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DetailSequence, x.DetailSequenceElement),
+		common.PrimitiveValues(x.ItemSequence, x.ItemSequenceElement),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+		common.PrimitiveValues(x.SubdetailSequence, x.SubdetailSequenceElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseAddItemDetail that only have an id
+// or extensions as null
+func (x ClaimResponseAddItemDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseAddItemDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseAddItemDetailSubDetail that only have an id
+// or extensions as null
+func (x ClaimResponseAddItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseAddItemDetailSubDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItem that only have an id
+// or extensions as null
+func (x ClaimResponseItem) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItem
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItemDetail that only have an id
+// or extensions as null
+func (x ClaimResponseItemDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItemDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ClaimResponseItemDetailSubDetail that only have an id
+// or extensions as null
+func (x ClaimResponseItemDetailSubDetail) MarshalJSON() ([]byte, error) {
+	type plain ClaimResponseItemDetailSubDetail
+	return json.Marshal(struct {
+		plain
+		NoteNumber []*int `json:"noteNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.NoteNumber, x.NoteNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CodeSystemFilter that only have an id
+// or extensions as null
+func (x CodeSystemFilter) MarshalJSON() ([]byte, error) {
+	type plain CodeSystemFilter
+	return json.Marshal(struct {
+		plain
+		Operator []*string `json:"operator"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Operator, x.OperatorElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Communication that only have an id
+// or extensions as null
+func (x Communication) MarshalJSON() ([]byte, error) {
+	type plain Communication
+	return json.Marshal(struct {
+		plain
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CompartmentDefinitionResource that only have an id
+// or extensions as null
+func (x CompartmentDefinitionResource) MarshalJSON() ([]byte, error) {
+	type plain CompartmentDefinitionResource
+	return json.Marshal(struct {
+		plain
+		Param []*string `json:"param,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Param, x.ParamElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CompositionAttester that only have an id
+// or extensions as null
+func (x CompositionAttester) MarshalJSON() ([]byte, error) {
+	type plain CompositionAttester
+	return json.Marshal(struct {
+		plain
+		Mode []*CompositionAttesterMode `json:"mode"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Mode, x.ModeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Contract that only have an id
+// or extensions as null
+func (x Contract) MarshalJSON() ([]byte, error) {
+	type plain Contract
+	return json.Marshal(struct {
+		plain
+		Alias []*string `json:"alias,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ContractTermAction that only have an id
+// or extensions as null
+func (x ContractTermAction) MarshalJSON() ([]byte, error) {
+	type plain ContractTermAction
+	return json.Marshal(struct {
+		plain
+		ContextLinkId       []*string `json:"contextLinkId,omitempty"`
+		LinkId              []*string `json:"linkId,omitempty"`
+		PerformerLinkId     []*string `json:"performerLinkId,omitempty"`
+		Reason              []*string `json:"reason,omitempty"`
+		ReasonLinkId        []*string `json:"reasonLinkId,omitempty"`
+		RequesterLinkId     []*string `json:"requesterLinkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ContextLinkId, x.ContextLinkIdElement),
+		common.PrimitiveValues(x.LinkId, x.LinkIdElement),
+		common.PrimitiveValues(x.PerformerLinkId, x.PerformerLinkIdElement),
+		common.PrimitiveValues(x.Reason, x.ReasonElement),
+		common.PrimitiveValues(x.ReasonLinkId, x.ReasonLinkIdElement),
+		common.PrimitiveValues(x.RequesterLinkId, x.RequesterLinkIdElement),
+		common.PrimitiveValues(x.SecurityLabelNumber, x.SecurityLabelNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ContractTermAsset that only have an id
+// or extensions as null
+func (x ContractTermAsset) MarshalJSON() ([]byte, error) {
+	type plain ContractTermAsset
+	return json.Marshal(struct {
+		plain
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.LinkId, x.LinkIdElement),
+		common.PrimitiveValues(x.SecurityLabelNumber, x.SecurityLabelNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ContractTermAssetValuedItem that only have an id
+// or extensions as null
+func (x ContractTermAssetValuedItem) MarshalJSON() ([]byte, error) {
+	type plain ContractTermAssetValuedItem
+	return json.Marshal(struct {
+		plain
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.LinkId, x.LinkIdElement),
+		common.PrimitiveValues(x.SecurityLabelNumber, x.SecurityLabelNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ContractTermOffer that only have an id
+// or extensions as null
+func (x ContractTermOffer) MarshalJSON() ([]byte, error) {
+	type plain ContractTermOffer
+	return json.Marshal(struct {
+		plain
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.LinkId, x.LinkIdElement),
+		common.PrimitiveValues(x.SecurityLabelNumber, x.SecurityLabelNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ContractTermSecurityLabel that only have an id
+// or extensions as null
+func (x ContractTermSecurityLabel) MarshalJSON() ([]byte, error) {
+	type plain ContractTermSecurityLabel
+	return json.Marshal(struct {
+		plain
+		Number []*int `json:"number,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Number, x.NumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ContractValuedItem that only have an id
+// or extensions as null
+func (x ContractValuedItem) MarshalJSON() ([]byte, error) {
+	type plain ContractValuedItem
+	return json.Marshal(struct {
+		plain
+		LinkId              []*string `json:"linkId,omitempty"`
+		SecurityLabelNumber []*int    `json:"securityLabelNumber,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.LinkId, x.LinkIdElement),
+		common.PrimitiveValues(x.SecurityLabelNumber, x.SecurityLabelNumberElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CoverageEligibilityRequest that only have an id
+// or extensions as null
+func (x CoverageEligibilityRequest) MarshalJSON() ([]byte, error) {
+	type plain CoverageEligibilityRequest
+	return json.Marshal(struct {
+		plain
+		Purpose []*CoverageEligibilityRequestPurpose `json:"purpose"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Purpose, x.PurposeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CoverageEligibilityRequestItem that only have an id
+// or extensions as null
+func (x CoverageEligibilityRequestItem) MarshalJSON() ([]byte, error) {
+	type plain CoverageEligibilityRequestItem
+	return json.Marshal(struct {
+		plain
+		SupportingInfoSequence []*int `json:"supportingInfoSequence,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.SupportingInfoSequence, x.SupportingInfoSequenceElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of CoverageEligibilityResponse that only have an id
+// or extensions as null
+func (x CoverageEligibilityResponse) MarshalJSON() ([]byte, error) {
+	type plain CoverageEligibilityResponse
+	return json.Marshal(struct {
+		plain
+		Purpose []*CoverageEligibilityResponsePurpose `json:"purpose"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Purpose, x.PurposeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of DeviceDefinition that only have an id
+// or extensions as null
+func (x DeviceDefinition) MarshalJSON() ([]byte, error) {
+	type plain DeviceDefinition
+	return json.Marshal(struct {
+		plain
+		Version []*string `json:"version,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Version, x.VersionElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of DeviceRequest that only have an id
+// or extensions as null
+func (x DeviceRequest) MarshalJSON() ([]byte, error) {
+	type plain DeviceRequest
+	return json.Marshal(struct {
+		plain
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinition that only have an id
+// or extensions as null
+func (x ElementDefinition) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinition
+	return json.Marshal(struct {
+		plain
+		Alias          []*string                          `json:"alias,omitempty"`
+		Condition      []*string                          `json:"condition,omitempty"`
+		Representation []*ElementDefinitionRepresentation `json:"representation,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+		common.PrimitiveValues(x.Condition, x.ConditionElement),
+		common.PrimitiveValues(x.Representation, x.RepresentationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ElementDefinitionType that only have an id
+// or extensions as null
+func (x ElementDefinitionType) MarshalJSON() ([]byte, error) {
+	type plain ElementDefinitionType
+	return json.Marshal(struct {
+		plain
+		Aggregation   []*ElementDefinitionTypeAggregation `json:"aggregation,omitempty"`
+		Profile       []*string                           `json:"profile,omitempty"`
+		TargetProfile []*string                           `json:"targetProfile,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Aggregation, x.AggregationElement),
+		common.PrimitiveValues(x.Profile, x.ProfileElement),
+		common.PrimitiveValues(x.TargetProfile, x.TargetProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Endpoint that only have an id
+// or extensions as null
+func (x Endpoint) MarshalJSON() ([]byte, error) {
+	type plain Endpoint
+	return json.Marshal(struct {
+		plain
+		Header          []*string `json:"header,omitempty"`
+		PayloadMimeType []*string `json:"payloadMimeType,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Header, x.HeaderElement),
+		common.PrimitiveValues(x.PayloadMimeType, x.PayloadMimeTypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExampleScenario that only have an id
+// or extensions as null
+func (x ExampleScenario) MarshalJSON() ([]byte, error) {
+	type plain ExampleScenario
+	return json.Marshal(struct {
+		plain
+		Workflow []*string `json:"workflow,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Workflow, x.WorkflowElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefit that only have an id
+// or extensions as null
+func (x ExplanationOfBenefit) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefit
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitAddItem that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitAddItem) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitAddItem
+	return json.Marshal(struct {
+		plain
+		CareTeamSequence  []*int `json:"careTeamSequence,omitempty"`
+		DiagnosisSequence []*int `json:"diagnosisSequence,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.CareTeamSequence, x.CareTeamSequenceElement),
+		common.PrimitiveValues(x.DiagnosisSequence, x.DiagnosisSequenceElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitInsurance that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitInsurance) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitInsurance
+	return json.Marshal(struct {
+		plain
+		PreAuthRef []*string `json:"preAuthRef,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PreAuthRef, x.PreAuthRefElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ExplanationOfBenefitItem that only have an id
+// or extensions as null
+func (x ExplanationOfBenefitItem) MarshalJSON() ([]byte, error) {
+	type plain ExplanationOfBenefitItem
+	return json.Marshal(struct {
+		plain
+		CareTeamSequence  []*int `json:"careTeamSequence,omitempty"`
+		DiagnosisSequence []*int `json:"diagnosisSequence,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.CareTeamSequence, x.CareTeamSequenceElement),
+		common.PrimitiveValues(x.DiagnosisSequence, x.DiagnosisSequenceElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of FamilyMemberHistory that only have an id
+// or extensions as null
+func (x FamilyMemberHistory) MarshalJSON() ([]byte, error) {
+	type plain FamilyMemberHistory
+	return json.Marshal(struct {
+		plain
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HealthcareServiceAvailableTime that only have an id
+// or extensions as null
+func (x HealthcareServiceAvailableTime) MarshalJSON() ([]byte, error) {
+	type plain HealthcareServiceAvailableTime
+	return json.Marshal(struct {
+		plain
+		DaysOfWeek []*HealthcareServiceAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DaysOfWeek, x.DaysOfWeekElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of HumanName that only have an id
+// or extensions as null
+func (x HumanName) MarshalJSON() ([]byte, error) {
+	type plain HumanName
+	return json.Marshal(struct {
+		plain
+		Given  []*string `json:"given,omitempty"`
+		Prefix []*string `json:"prefix,omitempty"`
+		Suffix []*string `json:"suffix,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Given, x.GivenElement),
+		common.PrimitiveValues(x.Prefix, x.PrefixElement),
+		common.PrimitiveValues(x.Suffix, x.SuffixElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuide that only have an id
+// or extensions as null
+func (x ImplementationGuide) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuide
+	return json.Marshal(struct {
+		plain
+		FhirVersion []*string `json:"fhirVersion"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.FhirVersion, x.FhirVersionElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuideManifest that only have an id
+// or extensions as null
+func (x ImplementationGuideManifest) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuideManifest
+	return json.Marshal(struct {
+		plain
+		Image []*string `json:"image,omitempty"`
+		Other []*string `json:"other,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Image, x.ImageElement),
+		common.PrimitiveValues(x.Other, x.OtherElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ImplementationGuideManifestPage that only have an id
+// or extensions as null
+func (x ImplementationGuideManifestPage) MarshalJSON() ([]byte, error) {
+	type plain ImplementationGuideManifestPage
+	return json.Marshal(struct {
+		plain
+		Anchor []*string `json:"anchor,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Anchor, x.AnchorElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of InsurancePlan that only have an id
+// or extensions as null
+func (x InsurancePlan) MarshalJSON() ([]byte, error) {
+	type plain InsurancePlan
+	return json.Marshal(struct {
+		plain
+		Alias []*string `json:"alias,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Location that only have an id
+// or extensions as null
+func (x Location) MarshalJSON() ([]byte, error) {
+	type plain Location
+	return json.Marshal(struct {
+		plain
+		Alias []*string `json:"alias,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of LocationHoursOfOperation that only have an id
+// or extensions as null
+func (x LocationHoursOfOperation) MarshalJSON() ([]byte, error) {
+	type plain LocationHoursOfOperation
+	return json.Marshal(struct {
+		plain
+		DaysOfWeek []*DaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DaysOfWeek, x.DaysOfWeekElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Measure that only have an id
+// or extensions as null
+func (x Measure) MarshalJSON() ([]byte, error) {
+	type plain Measure
+	return json.Marshal(struct {
+		plain
+		Definition []*string `json:"definition,omitempty"`
+		Library    []*string `json:"library,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Definition, x.DefinitionElement),
+		common.PrimitiveValues(x.Library, x.LibraryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MedicationAdministration that only have an id
+// or extensions as null
+func (x MedicationAdministration) MarshalJSON() ([]byte, error) {
+	type plain MedicationAdministration
+	return json.Marshal(struct {
+		plain
+		Instantiates []*string `json:"instantiates,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Instantiates, x.InstantiatesElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MedicationKnowledge that only have an id
+// or extensions as null
+func (x MedicationKnowledge) MarshalJSON() ([]byte, error) {
+	type plain MedicationKnowledge
+	return json.Marshal(struct {
+		plain
+		Synonym []*string `json:"synonym,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Synonym, x.SynonymElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MedicationRequest that only have an id
+// or extensions as null
+func (x MedicationRequest) MarshalJSON() ([]byte, error) {
+	type plain MedicationRequest
+	return json.Marshal(struct {
+		plain
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MedicinalProduct that only have an id
+// or extensions as null
+func (x MedicinalProduct) MarshalJSON() ([]byte, error) {
+	type plain MedicinalProduct
+	return json.Marshal(struct {
+		plain
+		SpecialMeasures []*string `json:"specialMeasures,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.SpecialMeasures, x.SpecialMeasuresElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MedicinalProductManufacturedPhysicalCharacteristics that only have an id
+// or extensions as null
+func (x MedicinalProductManufacturedPhysicalCharacteristics) MarshalJSON() ([]byte, error) {
+	type plain MedicinalProductManufacturedPhysicalCharacteristics
+	return json.Marshal(struct {
+		plain
+		Color   []*string `json:"color,omitempty"`
+		Imprint []*string `json:"imprint,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Color, x.ColorElement),
+		common.PrimitiveValues(x.Imprint, x.ImprintElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MedicinalProductPackagedPhysicalCharacteristics that only have an id
+// or extensions as null
+func (x MedicinalProductPackagedPhysicalCharacteristics) MarshalJSON() ([]byte, error) {
+	type plain MedicinalProductPackagedPhysicalCharacteristics
+	return json.Marshal(struct {
+		plain
+		Color   []*string `json:"color,omitempty"`
+		Imprint []*string `json:"imprint,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Color, x.ColorElement),
+		common.PrimitiveValues(x.Imprint, x.ImprintElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MessageDefinition that only have an id
+// or extensions as null
+func (x MessageDefinition) MarshalJSON() ([]byte, error) {
+	type plain MessageDefinition
+	return json.Marshal(struct {
+		plain
+		Graph    []*string `json:"graph,omitempty"`
+		Parent   []*string `json:"parent,omitempty"`
+		Replaces []*string `json:"replaces,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Graph, x.GraphElement),
+		common.PrimitiveValues(x.Parent, x.ParentElement),
+		common.PrimitiveValues(x.Replaces, x.ReplacesElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Meta that only have an id
+// or extensions as null
+func (x Meta) MarshalJSON() ([]byte, error) {
+	type plain Meta
+	return json.Marshal(struct {
+		plain
+		Profile []*string `json:"profile,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Profile, x.ProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of MolecularSequenceQualityRoc that only have an id
+// or extensions as null
+func (x MolecularSequenceQualityRoc) MarshalJSON() ([]byte, error) {
+	type plain MolecularSequenceQualityRoc
+	return json.Marshal(struct {
+		plain
+		FMeasure    []*common.Decimal `json:"fMeasure,omitempty"`
+		NumFN       []*int            `json:"numFN,omitempty"`
+		NumFP       []*int            `json:"numFP,omitempty"`
+		NumTP       []*int            `json:"numTP,omitempty"`
+		Precision   []*common.Decimal `json:"precision,omitempty"`
+		Score       []*int            `json:"score,omitempty"`
+		Sensitivity []*common.Decimal `json:"sensitivity,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.FMeasure, x.FMeasureElement),
+		common.PrimitiveValues(x.NumFN, x.NumFNElement),
+		common.PrimitiveValues(x.NumFP, x.NumFPElement),
+		common.PrimitiveValues(x.NumTP, x.NumTPElement),
+		common.PrimitiveValues(x.Precision, x.PrecisionElement),
+		common.PrimitiveValues(x.Score, x.ScoreElement),
+		common.PrimitiveValues(x.Sensitivity, x.SensitivityElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of NutritionOrder that only have an id
+// or extensions as null
+func (x NutritionOrder) MarshalJSON() ([]byte, error) {
+	type plain NutritionOrder
+	return json.Marshal(struct {
+		plain
+		Instantiates          []*string `json:"instantiates,omitempty"`
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Instantiates, x.InstantiatesElement),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ObservationDefinition that only have an id
+// or extensions as null
+func (x ObservationDefinition) MarshalJSON() ([]byte, error) {
+	type plain ObservationDefinition
+	return json.Marshal(struct {
+		plain
+		PermittedDataType []*ObservationDefinitionPermittedDataType `json:"permittedDataType,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.PermittedDataType, x.PermittedDataTypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationDefinition that only have an id
+// or extensions as null
+func (x OperationDefinition) MarshalJSON() ([]byte, error) {
+	type plain OperationDefinition
+	return json.Marshal(struct {
+		plain
+		Resource []*string `json:"resource,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Resource, x.ResourceElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationDefinitionOverload that only have an id
+// or extensions as null
+func (x OperationDefinitionOverload) MarshalJSON() ([]byte, error) {
+	type plain OperationDefinitionOverload
+	return json.Marshal(struct {
+		plain
+		ParameterName []*string `json:"parameterName,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ParameterName, x.ParameterNameElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationDefinitionParameter that only have an id
+// or extensions as null
+func (x OperationDefinitionParameter) MarshalJSON() ([]byte, error) {
+	type plain OperationDefinitionParameter
+	return json.Marshal(struct {
+		plain
+		TargetProfile []*string `json:"targetProfile,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.TargetProfile, x.TargetProfileElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of OperationOutcomeIssue that only have an id
+// or extensions as null
+func (x OperationOutcomeIssue) MarshalJSON() ([]byte, error) {
+	type plain OperationOutcomeIssue
+	return json.Marshal(struct {
+		plain
+		Expression []*string `json:"expression,omitempty"`
+		Location   []*string `json:"location,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Expression, x.ExpressionElement),
+		common.PrimitiveValues(x.Location, x.LocationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Organization that only have an id
+// or extensions as null
+func (x Organization) MarshalJSON() ([]byte, error) {
+	type plain Organization
+	return json.Marshal(struct {
+		plain
+		Alias []*string `json:"alias,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Alias, x.AliasElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of PlanDefinitionAction that only have an id
+// or extensions as null
+func (x PlanDefinitionAction) MarshalJSON() ([]byte, error) {
+	type plain PlanDefinitionAction
+	return json.Marshal(struct {
+		plain
+		GoalId []*string `json:"goalId,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.GoalId, x.GoalIdElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of PractitionerRoleAvailableTime that only have an id
+// or extensions as null
+func (x PractitionerRoleAvailableTime) MarshalJSON() ([]byte, error) {
+	type plain PractitionerRoleAvailableTime
+	return json.Marshal(struct {
+		plain
+		DaysOfWeek []*PractitionerRoleAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DaysOfWeek, x.DaysOfWeekElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Provenance that only have an id
+// or extensions as null
+func (x Provenance) MarshalJSON() ([]byte, error) {
+	type plain Provenance
+	return json.Marshal(struct {
+		plain
+		Policy []*string `json:"policy,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Policy, x.PolicyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Questionnaire that only have an id
+// or extensions as null
+func (x Questionnaire) MarshalJSON() ([]byte, error) {
+	type plain Questionnaire
+	return json.Marshal(struct {
+		plain
+		DerivedFrom []*string `json:"derivedFrom,omitempty"`
+		SubjectType []*string `json:"subjectType,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DerivedFrom, x.DerivedFromElement),
+		common.PrimitiveValues(x.SubjectType, x.SubjectTypeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of RequestGroup that only have an id
+// or extensions as null
+func (x RequestGroup) MarshalJSON() ([]byte, error) {
+	type plain RequestGroup
+	return json.Marshal(struct {
+		plain
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ResearchDefinition that only have an id
+// or extensions as null
+func (x ResearchDefinition) MarshalJSON() ([]byte, error) {
+	type plain ResearchDefinition
+	return json.Marshal(struct {
+		plain
+		Comment []*string `json:"comment,omitempty"`
+		Library []*string `json:"library,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Comment, x.CommentElement),
+		common.PrimitiveValues(x.Library, x.LibraryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ResearchElementDefinition that only have an id
+// or extensions as null
+func (x ResearchElementDefinition) MarshalJSON() ([]byte, error) {
+	type plain ResearchElementDefinition
+	return json.Marshal(struct {
+		plain
+		Comment []*string `json:"comment,omitempty"`
+		Library []*string `json:"library,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Comment, x.CommentElement),
+		common.PrimitiveValues(x.Library, x.LibraryElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SearchParameter that only have an id
+// or extensions as null
+func (x SearchParameter) MarshalJSON() ([]byte, error) {
+	type plain SearchParameter
+	return json.Marshal(struct {
+		plain
+		Base       []*string                    `json:"base"`
+		Chain      []*string                    `json:"chain,omitempty"`
+		Comparator []*SearchParameterComparator `json:"comparator,omitempty"`
+		Modifier   []*SearchParameterModifier   `json:"modifier,omitempty"`
+		Target     []*string                    `json:"target,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Base, x.BaseElement),
+		common.PrimitiveValues(x.Chain, x.ChainElement),
+		common.PrimitiveValues(x.Comparator, x.ComparatorElement),
+		common.PrimitiveValues(x.Modifier, x.ModifierElement),
+		common.PrimitiveValues(x.Target, x.TargetElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ServiceRequest that only have an id
+// or extensions as null
+func (x ServiceRequest) MarshalJSON() ([]byte, error) {
+	type plain ServiceRequest
+	return json.Marshal(struct {
+		plain
+		InstantiatesCanonical []*string `json:"instantiatesCanonical,omitempty"`
+		InstantiatesUri       []*string `json:"instantiatesUri,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.InstantiatesCanonical, x.InstantiatesCanonicalElement),
+		common.PrimitiveValues(x.InstantiatesUri, x.InstantiatesUriElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureDefinition that only have an id
+// or extensions as null
+func (x StructureDefinition) MarshalJSON() ([]byte, error) {
+	type plain StructureDefinition
+	return json.Marshal(struct {
+		plain
+		ContextInvariant []*string `json:"contextInvariant,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ContextInvariant, x.ContextInvariantElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureMap that only have an id
+// or extensions as null
+func (x StructureMap) MarshalJSON() ([]byte, error) {
+	type plain StructureMap
+	return json.Marshal(struct {
+		plain
+		Import []*string `json:"import,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Import, x.ImportElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureMapGroupRuleDependent that only have an id
+// or extensions as null
+func (x StructureMapGroupRuleDependent) MarshalJSON() ([]byte, error) {
+	type plain StructureMapGroupRuleDependent
+	return json.Marshal(struct {
+		plain
+		Variable []*string `json:"variable"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Variable, x.VariableElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of StructureMapGroupRuleTarget that only have an id
+// or extensions as null
+func (x StructureMapGroupRuleTarget) MarshalJSON() ([]byte, error) {
+	type plain StructureMapGroupRuleTarget
+	return json.Marshal(struct {
+		plain
+		ListMode []*StructureMapGroupRuleTargetListMode `json:"listMode,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ListMode, x.ListModeElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SubscriptionChannel that only have an id
+// or extensions as null
+func (x SubscriptionChannel) MarshalJSON() ([]byte, error) {
+	type plain SubscriptionChannel
+	return json.Marshal(struct {
+		plain
+		Header []*string `json:"header,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Header, x.HeaderElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SubstancePolymer that only have an id
+// or extensions as null
+func (x SubstancePolymer) MarshalJSON() ([]byte, error) {
+	type plain SubstancePolymer
+	return json.Marshal(struct {
+		plain
+		Modification []*string `json:"modification,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Modification, x.ModificationElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SubstanceProtein that only have an id
+// or extensions as null
+func (x SubstanceProtein) MarshalJSON() ([]byte, error) {
+	type plain SubstanceProtein
+	return json.Marshal(struct {
+		plain
+		DisulfideLinkage []*string `json:"disulfideLinkage,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DisulfideLinkage, x.DisulfideLinkageElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of SubstanceSourceMaterial that only have an id
+// or extensions as null
+func (x SubstanceSourceMaterial) MarshalJSON() ([]byte, error) {
+	type plain SubstanceSourceMaterial
+	return json.Marshal(struct {
+		plain
+		GeographicalLocation []*string `json:"geographicalLocation,omitempty"`
+		ParentSubstanceName  []*string `json:"parentSubstanceName,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.GeographicalLocation, x.GeographicalLocationElement),
+		common.PrimitiveValues(x.ParentSubstanceName, x.ParentSubstanceNameElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TerminologyCapabilitiesCodeSystemVersion that only have an id
+// or extensions as null
+func (x TerminologyCapabilitiesCodeSystemVersion) MarshalJSON() ([]byte, error) {
+	type plain TerminologyCapabilitiesCodeSystemVersion
+	return json.Marshal(struct {
+		plain
+		Language []*string `json:"language,omitempty"`
+		Property []*string `json:"property,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Language, x.LanguageElement),
+		common.PrimitiveValues(x.Property, x.PropertyElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TerminologyCapabilitiesCodeSystemVersionFilter that only have an id
+// or extensions as null
+func (x TerminologyCapabilitiesCodeSystemVersionFilter) MarshalJSON() ([]byte, error) {
+	type plain TerminologyCapabilitiesCodeSystemVersionFilter
+	return json.Marshal(struct {
+		plain
+		Op []*string `json:"op"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Op, x.OpElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TestScriptMetadataCapability that only have an id
+// or extensions as null
+func (x TestScriptMetadataCapability) MarshalJSON() ([]byte, error) {
+	type plain TestScriptMetadataCapability
+	return json.Marshal(struct {
+		plain
+		Link   []*string `json:"link,omitempty"`
+		Origin []*int    `json:"origin,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Link, x.LinkElement),
+		common.PrimitiveValues(x.Origin, x.OriginElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of Timing that only have an id
+// or extensions as null
+func (x Timing) MarshalJSON() ([]byte, error) {
+	type plain Timing
+	return json.Marshal(struct {
+		plain
+		Event []*common.DateTime `json:"event,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.Event, x.EventElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of TimingRepeat that only have an id
+// or extensions as null
+func (x TimingRepeat) MarshalJSON() ([]byte, error) {
+	type plain TimingRepeat
+	return json.Marshal(struct {
+		plain
+		DayOfWeek []*DaysOfWeek  `json:"dayOfWeek,omitempty"`
+		TimeOfDay []*common.Time `json:"timeOfDay,omitempty"`
+		When      []*EventTiming `json:"when,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.DayOfWeek, x.DayOfWeekElement),
+		common.PrimitiveValues(x.TimeOfDay, x.TimeOfDayElement),
+		common.PrimitiveValues(x.When, x.WhenElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of ValueSetComposeInclude that only have an id
+// or extensions as null
+func (x ValueSetComposeInclude) MarshalJSON() ([]byte, error) {
+	type plain ValueSetComposeInclude
+	return json.Marshal(struct {
+		plain
+		ValueSet []*string `json:"valueSet,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.ValueSet, x.ValueSetElement),
+	})
+}
+
+// MarshalJSON writes the repeating primitives of VerificationResult that only have an id
+// or extensions as null
+func (x VerificationResult) MarshalJSON() ([]byte, error) {
+	type plain VerificationResult
+	return json.Marshal(struct {
+		plain
+		TargetLocation []*string `json:"targetLocation,omitempty"`
+	}{
+		plain(x),
+		common.PrimitiveValues(x.TargetLocation, x.TargetLocationElement),
+	})
+}
@@ -15,7 +15,8 @@ type NutritionOrder struct {
 	AllergyIntolerance []common.Reference `json:"allergyIntolerance,omitempty"`
 
 	// The date and time that this nutrition order was requested
-	DateTime string `json:"dateTime"`
+	DateTime        common.DateTime `json:"dateTime"`
+	DateTimeElement *common.Element `json:"_dateTime,omitempty"`
 
 	// An encounter that provides additional information about the healthcare context
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -33,16 +34,20 @@ type NutritionOrder struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a protocol, guideline, orderset or other definition
-	Instantiates []string `json:"instantiates,omitempty"`
+	Instantiates        []string          `json:"instantiates,omitempty"`
+	InstantiatesElement []*common.Element `json:"_instantiates,omitempty"`
 
 	// Note: This is a business identifier, not a resource identifier
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// When resources map to this element, they are free to define as many codes as necessary
-	Intent NutritionOrderIntent `json:"intent"`
+	Intent        NutritionOrderIntent `json:"intent"`
+	IntentElement *common.Element      `json:"_intent,omitempty"`
 
 	// This element SHALL NOT be used to supply free text instructions for the diet
 	Note []common.Annotation `json:"note,omitempty"`
@@ -57,7 +62,8 @@ type NutritionOrder struct {
 	Patient common.Reference `json:"patient"`
 
 	// Typically the system placing the order sets the status to "requested"
-	Status NutritionOrderStatus `json:"status"`
+	Status        NutritionOrderStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// Oral nutritional products given in order to add further nutritional value
 	Supplement []NutritionOrderSupplement `json:"supplement,omitempty"`
@@ -99,7 +105,8 @@ type NutritionOrderOralDiet struct {
 	FluidConsistencyType []common.CodeableConcept `json:"fluidConsistencyType,omitempty"`
 
 	// Free text dosage instructions can be used for cases where the instructions are too complex to code
-	Instruction *string `json:"instruction,omitempty"`
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
 
 	// Class that defines the quantity and type of nutrient modifications
 	Nutrient []NutritionOrderOralDietNutrient `json:"nutrient,omitempty"`
@@ -141,10 +148,12 @@ type NutritionOrderSupplement struct {
 	common.BackboneElement
 
 	// Free text dosage instructions can be used for cases where the instructions are too complex to code
-	Instruction *string `json:"instruction,omitempty"`
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
 
 	// The product or brand name of the nutritional supplement
-	ProductName *string `json:"productName,omitempty"`
+	ProductName        *string         `json:"productName,omitempty"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
 
 	// The amount of the nutritional supplement to be given
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -161,7 +170,8 @@ type NutritionOrderEnteralFormula struct {
 	common.BackboneElement
 
 	// The product or brand name of the type of modular component to be added to the formula
-	AdditiveProductName *string `json:"additiveProductName,omitempty"`
+	AdditiveProductName        *string         `json:"additiveProductName,omitempty"`
+	AdditiveProductNameElement *common.Element `json:"_additiveProductName,omitempty"`
 
 	// Indicates the type of modular component such as protein, carbohydrate, fat or fiber
 	AdditiveType *common.CodeableConcept `json:"additiveType,omitempty"`
@@ -170,10 +180,12 @@ type NutritionOrderEnteralFormula struct {
 	Administration []NutritionOrderEnteralFormulaAdministration `json:"administration,omitempty"`
 
 	// Free text dosage instructions can be used for cases where the instructions are too complex to code
-	AdministrationInstruction *string `json:"administrationInstruction,omitempty"`
+	AdministrationInstruction        *string         `json:"administrationInstruction,omitempty"`
+	AdministrationInstructionElement *common.Element `json:"_administrationInstruction,omitempty"`
 
 	// The product or brand name of the enteral or infant formula product
-	BaseFormulaProductName *string `json:"baseFormulaProductName,omitempty"`
+	BaseFormulaProductName        *string         `json:"baseFormulaProductName,omitempty"`
+	BaseFormulaProductNameElement *common.Element `json:"_baseFormulaProductName,omitempty"`
 
 	// The type of enteral or infant formula such as an adult standard formula with fiber
 	BaseFormulaType *common.CodeableConcept `json:"baseFormulaType,omitempty"`
@@ -22,7 +22,8 @@ type Observation struct {
 	PartOf []common.Reference `json:"partOf,omitempty"`
 
 	// registered | preliminary | final | amended | corrected | cancelled | entered-in-error | unknown
-	Status ObservationStatus `json:"status"`
+	Status        ObservationStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// Classification of type of observation
 	Category []common.CodeableConcept `json:"category,omitempty"`
@@ -40,13 +41,16 @@ type Observation struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Clinically relevant time/time-period for observation
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
-	EffectiveTiming   *Timing        `json:"effectiveTiming,omitempty"`
-	EffectiveInstant  *string        `json:"effectiveInstant,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+	EffectiveTiming          *Timing          `json:"effectiveTiming,omitempty"`
+	EffectiveInstant         *common.Instant  `json:"effectiveInstant,omitempty"`
+	EffectiveInstantElement  *common.Element  `json:"_effectiveInstant,omitempty"`
 
 	// Date/Time this version was made available
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// Who is responsible for the observation
 	Performer []common.Reference `json:"performer,omitempty"`
@@ -55,13 +59,18 @@ type Observation struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
 	ValueSampledData     *SampledData            `json:"valueSampledData,omitempty"`
-	ValueTime            *string                 `json:"valueTime,omitempty"`
-	ValueDateTime        *string                 `json:"valueDateTime,omitempty"`
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
 
 	// Why the result is missing
@@ -132,7 +141,8 @@ type ObservationReferenceRange struct {
 	Age *Range `json:"age,omitempty"`
 
 	// Text based reference range in an observation
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // ObservationComponent represents a component observation
@@ -146,13 +156,18 @@ type ObservationComponent struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
 	ValueSampledData     *SampledData            `json:"valueSampledData,omitempty"`
-	ValueTime            *string                 `json:"valueTime,omitempty"`
-	ValueDateTime        *string                 `json:"valueDateTime,omitempty"`
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
 
 	// Why the component result is missing
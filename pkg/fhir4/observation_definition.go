@@ -30,16 +30,19 @@ type ObservationDefinition struct {
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// An example of observation allowing multiple results is "bacteria identified by culture"
-	MultipleResultsAllowed *bool `json:"multipleResultsAllowed,omitempty"`
+	MultipleResultsAllowed        *bool           `json:"multipleResultsAllowed,omitempty"`
+	MultipleResultsAllowedElement *common.Element `json:"_multipleResultsAllowed,omitempty"`
 
 	// The set of normal coded results for the observations conforming to this ObservationDefinition
 	NormalCodedValueSet *common.Reference `json:"normalCodedValueSet,omitempty"`
 
 	// The data types allowed for the value element of the instance observations conforming to this ObservationDefinition
-	PermittedDataType []ObservationDefinitionPermittedDataType `json:"permittedDataType,omitempty"`
+	PermittedDataType        []ObservationDefinitionPermittedDataType `json:"permittedDataType,omitempty"`
+	PermittedDataTypeElement []*common.Element                        `json:"_permittedDataType,omitempty"`
 
 	// The preferred name to be used when reporting the results of observations conforming to this ObservationDefinition
-	PreferredReportName *string `json:"preferredReportName,omitempty"`
+	PreferredReportName        *string         `json:"preferredReportName,omitempty"`
+	PreferredReportNameElement *common.Element `json:"_preferredReportName,omitempty"`
 
 	// Multiple ranges of results qualified by different contexts for ordinal or continuous observations
 	QualifiedInterval []ObservationDefinitionQualifiedInterval `json:"qualifiedInterval,omitempty"`
@@ -79,10 +82,12 @@ type ObservationDefinitionQualifiedInterval struct {
 	AppliesTo []common.CodeableConcept `json:"appliesTo,omitempty"`
 
 	// The category of interval of values for continuous or ordinal observations
-	Category *ObservationDefinitionQualifiedIntervalCategory `json:"category,omitempty"`
+	Category        *ObservationDefinitionQualifiedIntervalCategory `json:"category,omitempty"`
+	CategoryElement *common.Element                                 `json:"_category,omitempty"`
 
 	// The condition that is associated with the reference range
-	Condition *string `json:"condition,omitempty"`
+	Condition        *string         `json:"condition,omitempty"`
+	ConditionElement *common.Element `json:"_condition,omitempty"`
 
 	// Sex of the population the range applies to
 	Gender *common.CodeableConcept `json:"gender,omitempty"`
@@ -94,10 +99,12 @@ type ObservationDefinitionQualifiedInterval struct {
 	Range *common.Range `json:"range,omitempty"`
 
 	// Text based reference range in an observation
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The type of qualified interval
-	Type *ObservationDefinitionQualifiedIntervalType `json:"type,omitempty"`
+	Type        *ObservationDefinitionQualifiedIntervalType `json:"type,omitempty"`
+	TypeElement *common.Element                             `json:"_type,omitempty"`
 }
 
 // ObservationDefinitionQualifiedIntervalCategory represents the category of interval of values
@@ -125,10 +132,12 @@ type ObservationDefinitionQuantitativeDetails struct {
 	CustomaryUnit *common.CodeableConcept `json:"customaryUnit,omitempty"`
 
 	// Factor for converting value expressed with SI unit to value expressed with customary unit
-	ConversionFactor *float64 `json:"conversionFactor,omitempty"`
+	ConversionFactor        *common.Decimal `json:"conversionFactor,omitempty"`
+	ConversionFactorElement *common.Element `json:"_conversionFactor,omitempty"`
 
 	// Number of digits after decimal separator when the results of such observations are of type Quantity
-	DecimalPrecision *int `json:"decimalPrecision,omitempty"`
+	DecimalPrecision        *int            `json:"decimalPrecision,omitempty"`
+	DecimalPrecisionElement *common.Element `json:"_decimalPrecision,omitempty"`
 
 	// SI unit used to report quantitative results of observations conforming to this ObservationDefinition
 	Unit *common.CodeableConcept `json:"unit,omitempty"`
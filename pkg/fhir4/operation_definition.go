@@ -12,46 +12,58 @@ type OperationDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "OperationDefinition"
 
 	// What http methods can be used for the operation depends on the .affectsState value
-	AffectsState *bool `json:"affectsState,omitempty"`
+	AffectsState        *bool           `json:"affectsState,omitempty"`
+	AffectsStateElement *common.Element `json:"_affectsState,omitempty"`
 
 	// A constrained profile can make optional parameters required or not used and clarify documentation
-	Base *string `json:"base,omitempty"`
+	Base        *string         `json:"base,omitempty"`
+	BaseElement *common.Element `json:"_base,omitempty"`
 
 	// The name used to invoke the operation
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Additional information about how to use this operation or named query
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the operation definition was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of operation definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// If present the profile shall not conflict with what is specified in the parameters
-	InputProfile *string `json:"inputProfile,omitempty"`
+	InputProfile        *string         `json:"inputProfile,omitempty"`
+	InputProfileElement *common.Element `json:"_inputProfile,omitempty"`
 
 	// Indicates whether this operation can be invoked on a particular instance of one of the given types
-	Instance bool `json:"instance"`
+	Instance        bool            `json:"instance"`
+	InstanceElement *common.Element `json:"_instance,omitempty"`
 
 	// It may be possible for the operation definition to be used in jurisdictions other than those for which it was originally designed
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// Named queries are invoked differently, and have different capabilities
-	Kind OperationDefinitionKind `json:"kind"`
+	Kind        OperationDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// If present the profile shall not conflict with what is specified in the parameters
-	OutputProfile *string `json:"outputProfile,omitempty"`
+	OutputProfile        *string         `json:"outputProfile,omitempty"`
+	OutputProfileElement *common.Element `json:"_outputProfile,omitempty"`
 
 	// The combinations are suggestions as to which sets of parameters to use together
 	Overload []OperationDefinitionOverload `json:"overload,omitempty"`
@@ -60,34 +72,43 @@ type OperationDefinition struct {
 	Parameter []OperationDefinitionParameter `json:"parameter,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the operation definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// If the type is an abstract resource ("Resource" or "DomainResource") then the operation can be invoked on any concrete specialization
-	Resource []string `json:"resource,omitempty"`
+	Resource        []string          `json:"resource,omitempty"`
+	ResourceElement []*common.Element `json:"_resource,omitempty"`
 
 	// Allows filtering of operation definitions that are appropriate for use versus not
-	Status OperationDefinitionStatus `json:"status"`
+	Status        OperationDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// Indicates whether this operation or named query can be invoked at the system level
-	System bool `json:"system"`
+	System        bool            `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Indicates whether this operation or named query can be invoked at the resource type level
-	Type bool `json:"type"`
+	Type        bool            `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different operation definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // OperationDefinitionKind represents named queries are invoked differently
@@ -116,16 +137,20 @@ type OperationDefinitionParameter struct {
 	Binding *OperationDefinitionParameterBinding `json:"binding,omitempty"`
 
 	// Describes the meaning or use of this parameter
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// The maximum number of times this element is permitted to appear in the request or response
-	Max string `json:"max"`
+	Max        string          `json:"max"`
+	MaxElement *common.Element `json:"_max,omitempty"`
 
 	// The minimum number of times this parameter SHALL appear in the request or response
-	Min int `json:"min"`
+	Min        int             `json:"min"`
+	MinElement *common.Element `json:"_min,omitempty"`
 
 	// This name must be a token (start with a letter in a..z, and only contain letters, numerals, and underscore
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Query Definitions only have one output parameter, named "result"
 	Part []OperationDefinitionParameter `json:"part,omitempty"`
@@ -134,16 +159,20 @@ type OperationDefinitionParameter struct {
 	ReferencedFrom []OperationDefinitionParameterReferencedFrom `json:"referencedFrom,omitempty"`
 
 	// How the parameter is understood as a search parameter
-	SearchType *OperationDefinitionParameterSearchType `json:"searchType,omitempty"`
+	SearchType        *OperationDefinitionParameterSearchType `json:"searchType,omitempty"`
+	SearchTypeElement *common.Element                         `json:"_searchType,omitempty"`
 
 	// Often, these profiles are the base definitions from the spec
-	TargetProfile []string `json:"targetProfile,omitempty"`
+	TargetProfile        []string          `json:"targetProfile,omitempty"`
+	TargetProfileElement []*common.Element `json:"_targetProfile,omitempty"`
 
 	// if there is no stated parameter, then the parameter is a multi-part parameter
-	Type *string `json:"type,omitempty"`
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// If a parameter name is used for both an input and an output parameter, the parameter should be defined twice
-	Use OperationDefinitionParameterUse `json:"use"`
+	Use        OperationDefinitionParameterUse `json:"use"`
+	UseElement *common.Element                 `json:"_use,omitempty"`
 }
 
 // OperationDefinitionParameterUse represents if a parameter name is used for both an input and an output parameter
@@ -174,10 +203,12 @@ type OperationDefinitionParameterBinding struct {
 	common.BackboneElement
 
 	// For further discussion, see Using Terminologies
-	Strength OperationDefinitionParameterBindingStrength `json:"strength"`
+	Strength        OperationDefinitionParameterBindingStrength `json:"strength"`
+	StrengthElement *common.Element                             `json:"_strength,omitempty"`
 
 	// For value sets with a referenceResource, the display can contain the value set description
-	ValueSet string `json:"valueSet"`
+	ValueSet        string          `json:"valueSet"`
+	ValueSetElement *common.Element `json:"_valueSet,omitempty"`
 }
 
 // OperationDefinitionParameterBindingStrength represents for further discussion, see Using Terminologies
@@ -195,10 +226,12 @@ type OperationDefinitionParameterReferencedFrom struct {
 	common.BackboneElement
 
 	// The name of the parameter or dot-separated path of parameter names pointing to the resource parameter
-	Source string `json:"source"`
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// The id of the element in the referencing resource that is expected to resolve to this resource
-	SourceId *string `json:"sourceId,omitempty"`
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
 }
 
 // OperationDefinitionOverload represents the combinations are suggestions as to which sets of parameters to use together
@@ -206,8 +239,10 @@ type OperationDefinitionOverload struct {
 	common.BackboneElement
 
 	// Comments to go on overload
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Name of parameter to include in overload
-	ParameterName []string `json:"parameterName,omitempty"`
+	ParameterName        []string          `json:"parameterName,omitempty"`
+	ParameterNameElement []*common.Element `json:"_parameterName,omitempty"`
 }
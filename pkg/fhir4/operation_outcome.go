@@ -20,22 +20,27 @@ type OperationOutcomeIssue struct {
 	common.BackboneElement
 
 	// Describes the type of the issue
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// A human readable description of the error issue SHOULD be placed in details.text
 	Details *common.CodeableConcept `json:"details,omitempty"`
 
 	// This may be a description of how a value is erroneous, a stack dump to help trace the issue or other troubleshooting information
-	Diagnostics *string `json:"diagnostics,omitempty"`
+	Diagnostics        *string         `json:"diagnostics,omitempty"`
+	DiagnosticsElement *common.Element `json:"_diagnostics,omitempty"`
 
 	// The root of the FHIRPath is the resource or bundle that generated OperationOutcome
-	Expression []string `json:"expression,omitempty"`
+	Expression        []string          `json:"expression,omitempty"`
+	ExpressionElement []*common.Element `json:"_expression,omitempty"`
 
 	// The root of the XPath is the resource or bundle that generated OperationOutcome
-	Location []string `json:"location,omitempty"`
+	Location        []string          `json:"location,omitempty"`
+	LocationElement []*common.Element `json:"_location,omitempty"`
 
 	// This is labeled as "Is Modifier" because applications should not confuse hints and warnings with errors
-	Severity OperationOutcomeIssueSeverity `json:"severity"`
+	Severity        OperationOutcomeIssueSeverity `json:"severity"`
+	SeverityElement *common.Element               `json:"_severity,omitempty"`
 }
 
 // OperationOutcomeIssueSeverity represents this is labeled as "Is Modifier" because applications should not confuse hints and warnings with errors
@@ -13,16 +13,19 @@ type Organization struct {
 	ResourceType string `json:"resourceType"` // Always "Organization"
 
 	// Whether the organization's record is still in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Identifies this organization across multiple systems
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Name used for the organization
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A list of alternate names that the organization is known as, or was known as in the past
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Kind of organization
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -1,6 +1,8 @@
 package fhir4
 
 import (
+	"encoding/json"
+
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
@@ -20,70 +22,90 @@ type ParametersParameter struct {
 	common.BackboneElement
 
 	// The name of the parameter (reference to the operation definition)
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Only one level of nested parameters is allowed
 	Part []ParametersParameter `json:"part,omitempty"`
 
 	// When resolving references in resources, the operation definition may specify how references may be resolved between parameters
-	Resource interface{} `json:"resource,omitempty"`
+	Resource common.Resource `json:"resource,omitempty"`
 
 	// If the parameter is a data type
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 
 	// If the parameter is a data type
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// If the parameter is a data type
-	ValueCanonical *string `json:"valueCanonical,omitempty"`
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
 
 	// If the parameter is a data type
-	ValueCode *string `json:"valueCode,omitempty"`
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
 
 	// If the parameter is a data type
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// If the parameter is a data type
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// If the parameter is a data type
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// If the parameter is a data type
-	ValueId *string `json:"valueId,omitempty"`
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
 
 	// If the parameter is a data type
-	ValueInstant *string `json:"valueInstant,omitempty"`
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
 
 	// If the parameter is a data type
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// If the parameter is a data type
-	ValueMarkdown *string `json:"valueMarkdown,omitempty"`
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
 
 	// If the parameter is a data type
-	ValueOid *string `json:"valueOid,omitempty"`
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
 
 	// If the parameter is a data type
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty"`
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
 
 	// If the parameter is a data type
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// If the parameter is a data type
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// If the parameter is a data type
-	ValueUnsignedInt *int `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
 
 	// If the parameter is a data type
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// If the parameter is a data type
-	ValueUrl *string `json:"valueUrl,omitempty"`
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
 
 	// If the parameter is a data type
-	ValueUuid *string `json:"valueUuid,omitempty"`
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
 
 	// If the parameter is a data type
 	ValueAddress *common.Address `json:"valueAddress,omitempty"`
@@ -149,8 +171,28 @@ type ParametersParameter struct {
 	ValueTiming *common.Timing `json:"valueTiming,omitempty"`
 
 	// If the parameter is a data type
-	ValueDosage *common.Dosage `json:"valueDosage,omitempty"`
+	ValueDosage *Dosage `json:"valueDosage,omitempty"`
 
 	// If the parameter is a data type
 	ValueMeta *common.Meta `json:"valueMeta,omitempty"`
 }
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (p *ParametersParameter) UnmarshalJSON(data []byte) error {
+	type Alias ParametersParameter
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	p.Resource = resource
+	return nil
+}
@@ -13,7 +13,8 @@ type Patient struct {
 	ResourceType string `json:"resourceType"` // Always "Patient"
 
 	// Whether this patient record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// An identifier for this patient
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -25,14 +26,18 @@ type Patient struct {
 	Telecom []ContactPoint `json:"telecom,omitempty"`
 
 	// male | female | other | unknown
-	Gender *AdministrativeGender `json:"gender,omitempty"`
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
 
 	// The date of birth for the individual
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// Indicates if the individual is deceased or not
-	DeceasedBoolean  *bool   `json:"deceasedBoolean,omitempty"`
-	DeceasedDateTime *string `json:"deceasedDateTime,omitempty"`
+	DeceasedBoolean         *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement  *common.Element  `json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime        *common.DateTime `json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement *common.Element  `json:"_deceasedDateTime,omitempty"`
 
 	// An address for the individual
 	Address []Address `json:"address,omitempty"`
@@ -41,8 +46,10 @@ type Patient struct {
 	MaritalStatus *common.CodeableConcept `json:"maritalStatus,omitempty"`
 
 	// Whether patient is part of a multiple birth
-	MultipleBirthBoolean *bool `json:"multipleBirthBoolean,omitempty"`
-	MultipleBirthInteger *int  `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthBoolean        *bool           `json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *common.Element `json:"_multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger        *int            `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *common.Element `json:"_multipleBirthInteger,omitempty"`
 
 	// Image of the patient
 	Photo []Attachment `json:"photo,omitempty"`
@@ -68,22 +75,28 @@ type HumanName struct {
 	common.Element
 
 	// usual | official | temp | nickname | anonymous | old | maiden
-	Use *NameUse `json:"use,omitempty"`
+	Use        *NameUse        `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 
 	// Text representation of the full name
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Family name (often called 'Surname')
-	Family *string `json:"family,omitempty"`
+	Family        *string         `json:"family,omitempty"`
+	FamilyElement *common.Element `json:"_family,omitempty"`
 
 	// Given names (not always 'first'). Includes middle names
-	Given []string `json:"given,omitempty"`
+	Given        []string          `json:"given,omitempty"`
+	GivenElement []*common.Element `json:"_given,omitempty"`
 
 	// Parts that come before the name
-	Prefix []string `json:"prefix,omitempty"`
+	Prefix        []string          `json:"prefix,omitempty"`
+	PrefixElement []*common.Element `json:"_prefix,omitempty"`
 
 	// Parts that come after the name
-	Suffix []string `json:"suffix,omitempty"`
+	Suffix        []string          `json:"suffix,omitempty"`
+	SuffixElement []*common.Element `json:"_suffix,omitempty"`
 
 	// Time period when name was/is in use
 	Period *common.Period `json:"period,omitempty"`
@@ -129,7 +142,8 @@ type PatientContact struct {
 	Address *Address `json:"address,omitempty"`
 
 	// male | female | other | unknown
-	Gender *AdministrativeGender `json:"gender,omitempty"`
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
 
 	// Organization that is associated with the contact
 	Organization *common.Reference `json:"organization,omitempty"`
@@ -146,7 +160,8 @@ type PatientCommunication struct {
 	Language common.CodeableConcept `json:"language"`
 
 	// Language preference indicator
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 }
 
 // PatientLink represents a link to another patient resource that concerns the same actual person
@@ -157,7 +172,8 @@ type PatientLink struct {
 	Other common.Reference `json:"other"`
 
 	// replaced-by | replaces | refer | seealso
-	Type PatientLinkType `json:"type"`
+	Type        PatientLinkType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // PatientLinkType represents the type of link between this patient resource and another patient resource
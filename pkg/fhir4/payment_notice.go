@@ -15,7 +15,8 @@ type PaymentNotice struct {
 	Amount common.Money `json:"amount"`
 
 	// The date when this resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// A unique identifier assigned to this payment notice
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -27,7 +28,8 @@ type PaymentNotice struct {
 	Payment common.Reference `json:"payment"`
 
 	// The date when the above payment action occurred
-	PaymentDate *string `json:"paymentDate,omitempty"`
+	PaymentDate        *common.Date    `json:"paymentDate,omitempty"`
+	PaymentDateElement *common.Element `json:"_paymentDate,omitempty"`
 
 	// Typically paid: payment sent, cleared: payment received
 	PaymentStatus *common.CodeableConcept `json:"paymentStatus,omitempty"`
@@ -45,7 +47,8 @@ type PaymentNotice struct {
 	Response *common.Reference `json:"response,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status PaymentNoticeStatus `json:"status"`
+	Status        PaymentNoticeStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 }
 
 // PaymentNoticeStatus represents this element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
@@ -12,13 +12,15 @@ type PaymentReconciliation struct {
 	ResourceType string `json:"resourceType"` // Always "PaymentReconciliation"
 
 	// The date when the resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// Distribution of the payment amount for a previously acknowledged payable
 	Detail []PaymentReconciliationDetail `json:"detail,omitempty"`
 
 	// A human readable description of the status of the request for the reconciliation
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// May be needed to identify specific jurisdictional forms
 	FormCode *common.CodeableConcept `json:"formCode,omitempty"`
@@ -27,13 +29,15 @@ type PaymentReconciliation struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The resource may be used to indicate that: the request has been held (queued) for processing
-	Outcome *PaymentReconciliationOutcome `json:"outcome,omitempty"`
+	Outcome        *PaymentReconciliationOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element               `json:"_outcome,omitempty"`
 
 	// Total payment amount as indicated on the financial instrument
 	PaymentAmount common.Money `json:"paymentAmount"`
 
 	// The date of payment as indicated on the financial instrument
-	PaymentDate string `json:"paymentDate"`
+	PaymentDate        common.Date     `json:"paymentDate"`
+	PaymentDateElement *common.Element `json:"_paymentDate,omitempty"`
 
 	// For example: EFT number or check number
 	PaymentIdentifier *common.Identifier `json:"paymentIdentifier,omitempty"`
@@ -54,7 +58,8 @@ type PaymentReconciliation struct {
 	Requestor *common.Reference `json:"requestor,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status PaymentReconciliationStatus `json:"status"`
+	Status        PaymentReconciliationStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 }
 
 // PaymentReconciliationStatus represents this element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
@@ -85,7 +90,8 @@ type PaymentReconciliationDetail struct {
 	Amount *common.Money `json:"amount,omitempty"`
 
 	// The date from the response resource containing a commitment to pay
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Unique identifier for the current payment item for the referenced payable
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -117,10 +123,12 @@ type PaymentReconciliationProcessNote struct {
 	common.BackboneElement
 
 	// The explanation or description associated with the processing
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The business purpose of the note text
-	Type *PaymentReconciliationProcessNoteType `json:"type,omitempty"`
+	Type        *PaymentReconciliationProcessNoteType `json:"type,omitempty"`
+	TypeElement *common.Element                       `json:"_type,omitempty"`
 }
 
 // PaymentReconciliationProcessNoteType represents the business purpose of the note text
@@ -12,16 +12,19 @@ type Person struct {
 	ResourceType string `json:"resourceType"` // Always "Person"
 
 	// Whether this person's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Person may have multiple addresses with different uses or applicable periods
 	Address []common.Address `json:"address,omitempty"`
 
 	// At least an estimated year should be provided as a guess if the real DOB is unknown
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// The gender might not match the biological sex as determined by genetics
-	Gender *AdministrativeGender `json:"gender,omitempty"`
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
 
 	// Identifier for a person within a particular scope
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -47,7 +50,8 @@ type PersonLink struct {
 	common.BackboneElement
 
 	// Level of assurance that this link is associated with the target resource
-	Assurance *PersonLinkAssurance `json:"assurance,omitempty"`
+	Assurance        *PersonLinkAssurance `json:"assurance,omitempty"`
+	AssuranceElement *common.Element      `json:"_assurance,omitempty"`
 
 	// The resource to which this actual person is associated
 	Target common.Reference `json:"target"`
@@ -15,7 +15,8 @@ type PlanDefinition struct {
 	Action []PlanDefinitionAction `json:"action,omitempty"`
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individiual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -24,13 +25,16 @@ type PlanDefinition struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the plan definition and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the plan definition was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -42,7 +46,8 @@ type PlanDefinition struct {
 	Endorser []common.ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of plan definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Goals that describe what the activities within the plan are intended to achieve
 	Goal []PlanDefinitionGoal `json:"goal,omitempty"`
@@ -54,37 +59,45 @@ type PlanDefinition struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// A natural language name identifying the plan definition
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the plan definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Related artifacts such as additional documentation, justification, or bibliographic references
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// Allows filtering of plan definitions that are appropriate for use versus not
-	Status PlanDefinitionStatus `json:"status"`
+	Status        PlanDefinitionStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// A short, descriptive, user-friendly title for the plan definition
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the plan definition
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different plan definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // PlanDefinitionStatus represents allows filtering of plan definitions that are appropriate for use versus not
@@ -151,7 +164,8 @@ type PlanDefinitionAction struct {
 	Action []PlanDefinitionAction `json:"action,omitempty"`
 
 	// Defines whether the action can be selected multiple times
-	CardinalityBehavior *PlanDefinitionActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehavior        *PlanDefinitionActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                          `json:"_cardinalityBehavior,omitempty"`
 
 	// A code that provides meaning for the action or action group
 	Code []common.CodeableConcept `json:"code,omitempty"`
@@ -160,13 +174,16 @@ type PlanDefinitionAction struct {
 	Condition []PlanDefinitionActionCondition `json:"condition,omitempty"`
 
 	// Note that the definition is optional, and if no definition is specified, a dynamicValue with a root ($this) path can be used to define the entire resource dynamically
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty"`
+	DefinitionCanonical        *string         `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element `json:"_definitionCanonical,omitempty"`
 
 	// Note that the definition is optional, and if no definition is specified, a dynamicValue with a root ($this) path can be used to define the entire resource dynamically
-	DefinitionUri *string `json:"definitionUri,omitempty"`
+	DefinitionUri        *string         `json:"definitionUri,omitempty"`
+	DefinitionUriElement *common.Element `json:"_definitionUri,omitempty"`
 
 	// A brief description of the action used to provide a summary to display to the user
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Didactic or other informational resources associated with the action that can be provided to the CDS recipient
 	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
@@ -175,10 +192,12 @@ type PlanDefinitionAction struct {
 	DynamicValue []PlanDefinitionActionDynamicValue `json:"dynamicValue,omitempty"`
 
 	// Identifies goals that this action supports
-	GoalId []string `json:"goalId,omitempty"`
+	GoalId        []string          `json:"goalId,omitempty"`
+	GoalIdElement []*common.Element `json:"_goalId,omitempty"`
 
 	// Defines the grouping behavior for the action and its children
-	GroupingBehavior *PlanDefinitionActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehavior        *PlanDefinitionActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement *common.Element                       `json:"_groupingBehavior,omitempty"`
 
 	// Defines input data requirements for the action
 	Input []common.DataRequirement `json:"input,omitempty"`
@@ -190,13 +209,16 @@ type PlanDefinitionAction struct {
 	Participant []PlanDefinitionActionParticipant `json:"participant,omitempty"`
 
 	// Defines whether the action should usually be preselected
-	PrecheckBehavior *PlanDefinitionActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehavior        *PlanDefinitionActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement *common.Element                       `json:"_precheckBehavior,omitempty"`
 
 	// A user-visible prefix for the action
-	Prefix *string `json:"prefix,omitempty"`
+	Prefix        *string         `json:"prefix,omitempty"`
+	PrefixElement *common.Element `json:"_prefix,omitempty"`
 
 	// Indicates how quickly the action should be addressed with respect to other actions
-	Priority *PlanDefinitionActionPriority `json:"priority,omitempty"`
+	Priority        *PlanDefinitionActionPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element               `json:"_priority,omitempty"`
 
 	// This is different than the clinical evidence documentation, it's an actual business description of the reason for performing the action
 	Reason []common.CodeableConcept `json:"reason,omitempty"`
@@ -205,10 +227,12 @@ type PlanDefinitionAction struct {
 	RelatedAction []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty"`
 
 	// Defines the required behavior for the action
-	RequiredBehavior *PlanDefinitionActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehavior        *PlanDefinitionActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement *common.Element                       `json:"_requiredBehavior,omitempty"`
 
 	// Defines the selection behavior for the action and its children
-	SelectionBehavior *PlanDefinitionActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehavior        *PlanDefinitionActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement *common.Element                        `json:"_selectionBehavior,omitempty"`
 
 	// The subject of an action overrides the subject at a parent action or on the root of the PlanDefinition if specified
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
@@ -217,10 +241,12 @@ type PlanDefinitionAction struct {
 	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
 
 	// A text equivalent of the action to be performed
-	TextEquivalent *string `json:"textEquivalent,omitempty"`
+	TextEquivalent        *string         `json:"textEquivalent,omitempty"`
+	TextEquivalentElement *common.Element `json:"_textEquivalent,omitempty"`
 
 	// An optional value describing when the action should be performed
-	TimingDateTime *string `json:"timingDateTime,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
 
 	// An optional value describing when the action should be performed
 	TimingAge *common.Age `json:"timingAge,omitempty"`
@@ -238,10 +264,12 @@ type PlanDefinitionAction struct {
 	TimingTiming *common.Timing `json:"timingTiming,omitempty"`
 
 	// The title of the action displayed to a user
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Note that when a referenced ActivityDefinition also defines a transform, the transform specified here generally takes precedence
-	Transform *string `json:"transform,omitempty"`
+	Transform        *string         `json:"transform,omitempty"`
+	TransformElement *common.Element `json:"_transform,omitempty"`
 
 	// A description of when the action should be triggered
 	Trigger []common.TriggerDefinition `json:"trigger,omitempty"`
@@ -314,7 +342,8 @@ type PlanDefinitionActionCondition struct {
 	Expression *common.Expression `json:"expression,omitempty"`
 
 	// Applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
-	Kind PlanDefinitionActionConditionKind `json:"kind"`
+	Kind        PlanDefinitionActionConditionKind `json:"kind"`
+	KindElement *common.Element                   `json:"_kind,omitempty"`
 }
 
 // PlanDefinitionActionConditionKind represents applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
@@ -331,7 +360,8 @@ type PlanDefinitionActionRelatedAction struct {
 	common.BackboneElement
 
 	// The element id of the related action
-	ActionId string `json:"actionId"`
+	ActionId        string          `json:"actionId"`
+	ActionIdElement *common.Element `json:"_actionId,omitempty"`
 
 	// A duration or range of durations to apply to the relationship
 	OffsetDuration *common.Duration `json:"offsetDuration,omitempty"`
@@ -340,7 +370,8 @@ type PlanDefinitionActionRelatedAction struct {
 	OffsetRange *common.Range `json:"offsetRange,omitempty"`
 
 	// The relationship of this action to the related action
-	Relationship PlanDefinitionActionRelatedActionRelationship `json:"relationship"`
+	Relationship        PlanDefinitionActionRelatedActionRelationship `json:"relationship"`
+	RelationshipElement *common.Element                               `json:"_relationship,omitempty"`
 }
 
 // PlanDefinitionActionRelatedActionRelationship represents the relationship of this action to the related action
@@ -366,7 +397,8 @@ type PlanDefinitionActionParticipant struct {
 	Role *common.CodeableConcept `json:"role,omitempty"`
 
 	// The type of participant in the action
-	Type PlanDefinitionActionParticipantType `json:"type"`
+	Type        PlanDefinitionActionParticipantType `json:"type"`
+	TypeElement *common.Element                     `json:"_type,omitempty"`
 }
 
 // PlanDefinitionActionParticipantType represents the type of participant in the action
@@ -387,5 +419,6 @@ type PlanDefinitionActionDynamicValue struct {
 	Expression *common.Expression `json:"expression,omitempty"`
 
 	// To specify the path to the current action being realized, the %action environment variable is available in this path
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 }
@@ -13,7 +13,8 @@ type Practitioner struct {
 	ResourceType string `json:"resourceType"` // Always "Practitioner"
 
 	// Whether this practitioner's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// An identifier that applies to this person
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -28,10 +29,12 @@ type Practitioner struct {
 	Address []Address `json:"address,omitempty"`
 
 	// male | female | other | unknown
-	Gender *AdministrativeGender `json:"gender,omitempty"`
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
 
 	// The date of birth for the practitioner
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// Image of the person
 	Photo []Attachment `json:"photo,omitempty"`
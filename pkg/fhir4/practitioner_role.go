@@ -12,10 +12,12 @@ type PractitionerRole struct {
 	ResourceType string `json:"resourceType"` // Always "PractitionerRole"
 
 	// If this value is false, you may refer to the period to see when the role was in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// A description of site availability exceptions, e.g. public holiday availability
-	AvailabilityExceptions *string `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
 
 	// More detailed availability information may be provided in associated Schedule/Slot resources
 	AvailableTime []PractitionerRoleAvailableTime `json:"availableTime,omitempty"`
@@ -59,13 +61,16 @@ type PractitionerRoleAvailableTime struct {
 	common.BackboneElement
 
 	// Indicates which days of the week are available between the start and end Times
-	AvailableEndTime *string `json:"availableEndTime,omitempty"`
+	AvailableEndTime        *common.Time    `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement *common.Element `json:"_availableEndTime,omitempty"`
 
 	// Indicates which days of the week are available between the start and end Times
-	AvailableStartTime *string `json:"availableStartTime,omitempty"`
+	AvailableStartTime        *common.Time    `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element `json:"_availableStartTime,omitempty"`
 
 	// Indicates which days of the week are available between the start and end Times
-	DaysOfWeek []PractitionerRoleAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeek        []PractitionerRoleAvailableTimeDaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element                         `json:"_daysOfWeek,omitempty"`
 }
 
 // PractitionerRoleAvailableTimeDaysOfWeek represents indicates which days of the week are available between the start and end Times
@@ -86,7 +91,8 @@ type PractitionerRoleNotAvailable struct {
 	common.BackboneElement
 
 	// The reason that can be presented to the user as to why this time is not available
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Service is not available (seasonally or for a public holiday) from this date
 	During *common.Period `json:"during,omitempty"`
@@ -15,7 +15,8 @@ type Procedure struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// A code specifying the state of the procedure
-	Status ProcedureStatus `json:"status"`
+	Status        ProcedureStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -33,11 +34,13 @@ type Procedure struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// When the procedure was performed
-	PerformedDateTime *string        `json:"performedDateTime,omitempty"`
-	PerformedPeriod   *common.Period `json:"performedPeriod,omitempty"`
-	PerformedString   *string        `json:"performedString,omitempty"`
-	PerformedAge      *Age           `json:"performedAge,omitempty"`
-	PerformedRange    *Range         `json:"performedRange,omitempty"`
+	PerformedDateTime        *common.DateTime `json:"performedDateTime,omitempty"`
+	PerformedDateTimeElement *common.Element  `json:"_performedDateTime,omitempty"`
+	PerformedPeriod          *common.Period   `json:"performedPeriod,omitempty"`
+	PerformedString          *string          `json:"performedString,omitempty"`
+	PerformedStringElement   *common.Element  `json:"_performedString,omitempty"`
+	PerformedAge             *Age             `json:"performedAge,omitempty"`
+	PerformedRange           *Range           `json:"performedRange,omitempty"`
 
 	// Who recorded the record and takes responsibility for its content
 	Recorder *common.Reference `json:"recorder,omitempty"`
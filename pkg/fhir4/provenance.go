@@ -27,16 +27,19 @@ type Provenance struct {
 	OccurredPeriod *common.Period `json:"occurredPeriod,omitempty"`
 
 	// The period can be a little arbitrary; where possible, the time should correspond to human assessment of the activity time
-	OccurredDateTime *string `json:"occurredDateTime,omitempty"`
+	OccurredDateTime        *common.DateTime `json:"occurredDateTime,omitempty"`
+	OccurredDateTimeElement *common.Element  `json:"_occurredDateTime,omitempty"`
 
 	// For example: Where an OAuth token authorizes, the unique identifier from the OAuth token is placed into the policy element
-	Policy []string `json:"policy,omitempty"`
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
 
 	// The reason that the activity was taking place
 	Reason []common.CodeableConcept `json:"reason,omitempty"`
 
 	// This can be a little different from the time stamp on the resource if there is a delay between recording the event and updating the provenance and target resource
-	Recorded string `json:"recorded"`
+	Recorded        common.Instant  `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
 
 	// A digital signature on the target Reference(s)
 	Signature []common.Signature `json:"signature,omitempty"`
@@ -64,7 +67,8 @@ type ProvenanceEntity struct {
 	common.BackboneElement
 
 	// How the entity was used during the activity
-	Role ProvenanceEntityRole `json:"role"`
+	Role        ProvenanceEntityRole `json:"role"`
+	RoleElement *common.Element      `json:"_role,omitempty"`
 
 	// Identity of the Entity used. May be a logical or physical uri and maybe absolute or relative
 	What common.Reference `json:"what"`
@@ -12,7 +12,8 @@ type Questionnaire struct {
 	ResourceType string `json:"resourceType"` // Always "Questionnaire"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An identifier for this question or group of questions in a particular terminology such as LOINC
 	Code []common.Coding `json:"code,omitempty"`
@@ -21,22 +22,27 @@ type Questionnaire struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the questionnaire and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The URL of a Questionnaire that this Questionnaire is based on
-	DerivedFrom []string `json:"derivedFrom,omitempty"`
+	DerivedFrom        []string          `json:"derivedFrom,omitempty"`
+	DerivedFromElement []*common.Element `json:"_derivedFrom,omitempty"`
 
 	// This description can be used to capture details such as why the questionnaire was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The effective period for a questionnaire determines when the content is applicable for usage
 	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
 
 	// Allows filtering of questionnaires that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -48,34 +54,43 @@ type Questionnaire struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the questionnaire
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Allows filtering of questionnaires that are appropriate for use versus not
-	Status QuestionnaireStatus `json:"status"`
+	Status        QuestionnaireStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// If none are specified, then the subject is unlimited
-	SubjectType []string `json:"subjectType,omitempty"`
+	SubjectType        []string          `json:"subjectType,omitempty"`
+	SubjectTypeElement []*common.Element `json:"_subjectType,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The name of the referenced questionnaire can be conveyed using the http://hl7.org/fhir/StructureDefinition/display extension
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different questionnaire instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // QuestionnaireStatus represents allows filtering of questionnaires that are appropriate for use versus not
@@ -96,16 +111,19 @@ type QuestionnaireItem struct {
 	AnswerOption []QuestionnaireItemAnswerOption `json:"answerOption,omitempty"`
 
 	// LOINC defines many useful value sets for questionnaire responses
-	AnswerValueSet *string `json:"answerValueSet,omitempty"`
+	AnswerValueSet        *string         `json:"answerValueSet,omitempty"`
+	AnswerValueSetElement *common.Element `json:"_answerValueSet,omitempty"`
 
 	// The value may come from the ElementDefinition referred to by .definition
 	Code []common.Coding `json:"code,omitempty"`
 
 	// The uri refers to an ElementDefinition in a StructureDefinition
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// This element must be specified if more than one enableWhen value is provided
-	EnableBehavior *QuestionnaireItemEnableBehavior `json:"enableBehavior,omitempty"`
+	EnableBehavior        *QuestionnaireItemEnableBehavior `json:"enableBehavior,omitempty"`
+	EnableBehaviorElement *common.Element                  `json:"_enableBehavior,omitempty"`
 
 	// If multiple repetitions of this extension are present, the item should be enabled when the condition for *any* of the repetitions is true
 	EnableWhen []QuestionnaireItemEnableWhen `json:"enableWhen,omitempty"`
@@ -117,28 +135,36 @@ type QuestionnaireItem struct {
 	Item []QuestionnaireItem `json:"item,omitempty"`
 
 	// This ''can'' be a meaningful identifier (e.g. a LOINC code) but is not intended to have any meaning
-	LinkId string `json:"linkId"`
+	LinkId        string          `json:"linkId"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// For base64binary, reflects the number of characters representing the encoded data, not the number of bytes of the binary data
-	MaxLength *int `json:"maxLength,omitempty"`
+	MaxLength        *int            `json:"maxLength,omitempty"`
+	MaxLengthElement *common.Element `json:"_maxLength,omitempty"`
 
 	// These are generally unique within a questionnaire, though this is not guaranteed
-	Prefix *string `json:"prefix,omitempty"`
+	Prefix        *string         `json:"prefix,omitempty"`
+	PrefixElement *common.Element `json:"_prefix,omitempty"`
 
 	// The value of readOnly elements can be established by asserting extensions for defaultValues
-	ReadOnly *bool `json:"readOnly,omitempty"`
+	ReadOnly        *bool           `json:"readOnly,omitempty"`
+	ReadOnlyElement *common.Element `json:"_readOnly,omitempty"`
 
 	// If a question is marked as repeats=true, then multiple answers can be provided for the question
-	Repeats *bool `json:"repeats,omitempty"`
+	Repeats        *bool           `json:"repeats,omitempty"`
+	RepeatsElement *common.Element `json:"_repeats,omitempty"`
 
 	// Questionnaire.item.required only has meaning for elements that are conditionally enabled with enableWhen
-	Required *bool `json:"required,omitempty"`
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
 
 	// When using this element to represent the name of a section, use group type item
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Additional constraints on the type of answer can be conveyed by extensions
-	Type QuestionnaireItemType `json:"type"`
+	Type        QuestionnaireItemType `json:"type"`
+	TypeElement *common.Element       `json:"_type,omitempty"`
 }
 
 // QuestionnaireItemType represents additional constraints on the type of answer can be conveyed by extensions
@@ -177,25 +203,32 @@ type QuestionnaireItemEnableWhen struct {
 	common.BackboneElement
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerBoolean *bool `json:"answerBoolean,omitempty"`
+	AnswerBoolean        *bool           `json:"answerBoolean,omitempty"`
+	AnswerBooleanElement *common.Element `json:"_answerBoolean,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerDecimal *float64 `json:"answerDecimal,omitempty"`
+	AnswerDecimal        *common.Decimal `json:"answerDecimal,omitempty"`
+	AnswerDecimalElement *common.Element `json:"_answerDecimal,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerInteger *int `json:"answerInteger,omitempty"`
+	AnswerInteger        *int            `json:"answerInteger,omitempty"`
+	AnswerIntegerElement *common.Element `json:"_answerInteger,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerDate *string `json:"answerDate,omitempty"`
+	AnswerDate        *common.Date    `json:"answerDate,omitempty"`
+	AnswerDateElement *common.Element `json:"_answerDate,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerDateTime *string `json:"answerDateTime,omitempty"`
+	AnswerDateTime        *common.DateTime `json:"answerDateTime,omitempty"`
+	AnswerDateTimeElement *common.Element  `json:"_answerDateTime,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerTime *string `json:"answerTime,omitempty"`
+	AnswerTime        *common.Time    `json:"answerTime,omitempty"`
+	AnswerTimeElement *common.Element `json:"_answerTime,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
-	AnswerString *string `json:"answerString,omitempty"`
+	AnswerString        *string         `json:"answerString,omitempty"`
+	AnswerStringElement *common.Element `json:"_answerString,omitempty"`
 
 	// A value that the referenced question is tested using the specified operator in order for the item to be enabled
 	AnswerCoding *common.Coding `json:"answerCoding,omitempty"`
@@ -207,10 +240,12 @@ type QuestionnaireItemEnableWhen struct {
 	AnswerReference *common.Reference `json:"answerReference,omitempty"`
 
 	// Specifies the criteria by which the question is enabled
-	Operator QuestionnaireItemEnableWhenOperator `json:"operator"`
+	Operator        QuestionnaireItemEnableWhenOperator `json:"operator"`
+	OperatorElement *common.Element                     `json:"_operator,omitempty"`
 
 	// If multiple question occurrences are present for the same question (same linkId), then this refers to the nearest question occurrence
-	Question string `json:"question"`
+	Question        string          `json:"question"`
+	QuestionElement *common.Element `json:"_question,omitempty"`
 }
 
 // QuestionnaireItemEnableWhenOperator represents specifies the criteria by which the question is enabled
@@ -231,19 +266,24 @@ type QuestionnaireItemAnswerOption struct {
 	common.BackboneElement
 
 	// Use this instead of initial[v] if answerValueSet is present
-	InitialSelected *bool `json:"initialSelected,omitempty"`
+	InitialSelected        *bool           `json:"initialSelected,omitempty"`
+	InitialSelectedElement *common.Element `json:"_initialSelected,omitempty"`
 
 	// The data type of the value must agree with the item.type
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The data type of the value must agree with the item.type
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// The data type of the value must agree with the item.type
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// The data type of the value must agree with the item.type
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The data type of the value must agree with the item.type
 	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
@@ -257,28 +297,36 @@ type QuestionnaireItemInitial struct {
 	common.BackboneElement
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// The type of the initial value must be consistent with the type of the item
 	ValueAttachment *common.Attachment `json:"valueAttachment,omitempty"`
@@ -20,10 +20,12 @@ type QuestionnaireResponse struct {
 	PartOf []common.Reference `json:"partOf,omitempty"`
 
 	// Form being answered
-	Questionnaire *string `json:"questionnaire,omitempty"`
+	Questionnaire        *string         `json:"questionnaire,omitempty"`
+	QuestionnaireElement *common.Element `json:"_questionnaire,omitempty"`
 
 	// in-progress | completed | amended | entered-in-error | stopped
-	Status QuestionnaireResponseStatus `json:"status"`
+	Status        QuestionnaireResponseStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 
 	// The subject of the questionnaire response
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -32,7 +34,8 @@ type QuestionnaireResponse struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Date the answers were gathered
-	Authored *string `json:"authored,omitempty"`
+	Authored        *common.DateTime `json:"authored,omitempty"`
+	AuthoredElement *common.Element  `json:"_authored,omitempty"`
 
 	// Person who received and recorded the answers
 	Author *common.Reference `json:"author,omitempty"`
@@ -58,13 +61,16 @@ type QuestionnaireResponseItem struct {
 	common.BackboneElement
 
 	// Pointer to specific item from Questionnaire
-	LinkId string `json:"linkId"`
+	LinkId        string          `json:"linkId"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// ElementDefinition - details for the item
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// Name for group or question text
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The response(s) to the question
 	Answer []QuestionnaireResponseItemAnswer `json:"answer,omitempty"`
@@ -77,18 +83,26 @@ type QuestionnaireResponseItemAnswer struct {
 	common.BackboneElement
 
 	// Single-valued answer to the question
-	ValueBoolean    *bool             `json:"valueBoolean,omitempty"`
-	ValueDecimal    *float64          `json:"valueDecimal,omitempty"`
-	ValueInteger    *int              `json:"valueInteger,omitempty"`
-	ValueDate       *string           `json:"valueDate,omitempty"`
-	ValueDateTime   *string           `json:"valueDateTime,omitempty"`
-	ValueTime       *string           `json:"valueTime,omitempty"`
-	ValueString     *string           `json:"valueString,omitempty"`
-	ValueUri        *string           `json:"valueUri,omitempty"`
-	ValueAttachment *Attachment       `json:"valueAttachment,omitempty"`
-	ValueCoding     *common.Coding    `json:"valueCoding,omitempty"`
-	ValueQuantity   *common.Quantity  `json:"valueQuantity,omitempty"`
-	ValueReference  *common.Reference `json:"valueReference,omitempty"`
+	ValueBoolean         *bool             `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element   `json:"_valueBoolean,omitempty"`
+	ValueDecimal         *common.Decimal   `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element   `json:"_valueDecimal,omitempty"`
+	ValueInteger         *int              `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element   `json:"_valueInteger,omitempty"`
+	ValueDate            *common.Date      `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element   `json:"_valueDate,omitempty"`
+	ValueDateTime        *common.DateTime  `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element   `json:"_valueDateTime,omitempty"`
+	ValueTime            *common.Time      `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element   `json:"_valueTime,omitempty"`
+	ValueString          *string           `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element   `json:"_valueString,omitempty"`
+	ValueUri             *string           `json:"valueUri,omitempty"`
+	ValueUriElement      *common.Element   `json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment       `json:"valueAttachment,omitempty"`
+	ValueCoding          *common.Coding    `json:"valueCoding,omitempty"`
+	ValueQuantity        *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueReference       *common.Reference `json:"valueReference,omitempty"`
 
 	// Nested items inside this answer
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
@@ -12,19 +12,22 @@ type RelatedPerson struct {
 	ResourceType string `json:"resourceType"` // Always "RelatedPerson"
 
 	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Address where the related person can be contacted or visited
 	Address []common.Address `json:"address,omitempty"`
 
 	// The date on which the related person was born
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// If no language is specified, this *implies* that the default local language is spoken
 	Communication []RelatedPersonCommunication `json:"communication,omitempty"`
 
 	// Administrative Gender - the gender that the person is considered to have for administration and record keeping purposes
-	Gender *AdministrativeGender `json:"gender,omitempty"`
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
 
 	// Identifier for a person within a particular scope
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -56,5 +59,6 @@ type RelatedPersonCommunication struct {
 	Language common.CodeableConcept `json:"language"`
 
 	// This language is specifically identified for communicating healthcare information
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 }
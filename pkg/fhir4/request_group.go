@@ -18,7 +18,8 @@ type RequestGroup struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// Indicates when the request group was created
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// A plan, proposal or order that is fulfilled in whole or in part by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -36,19 +37,23 @@ type RequestGroup struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// A canonical URL referencing a FHIR-defined protocol, guideline, orderset or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// A URL referencing an externally defined protocol, guideline, orderset or other definition
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Indicates the level of authority/intentionality associated with the request and where the request fits into the workflow chain
-	Intent RequestGroupIntent `json:"intent"`
+	Intent        RequestGroupIntent `json:"intent"`
+	IntentElement *common.Element    `json:"_intent,omitempty"`
 
 	// Provides a mechanism to communicate additional information about the response
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// Indicates how quickly the request should be addressed with respect to other requests
-	Priority *RequestGroupPriority `json:"priority,omitempty"`
+	Priority        *RequestGroupPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element       `json:"_priority,omitempty"`
 
 	// Describes the reason for the request group in coded or textual form
 	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
@@ -60,7 +65,8 @@ type RequestGroup struct {
 	Replaces []common.Reference `json:"replaces,omitempty"`
 
 	// The current state of the request. For request groups, the status reflects the status of all the requests in the group
-	Status RequestGroupStatus `json:"status"`
+	Status        RequestGroupStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// The subject for which the request group was created
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -112,7 +118,8 @@ type RequestGroupAction struct {
 	Action []RequestGroupAction `json:"action,omitempty"`
 
 	// Defines whether the action can be selected multiple times
-	CardinalityBehavior *RequestGroupActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehavior        *RequestGroupActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                        `json:"_cardinalityBehavior,omitempty"`
 
 	// A code that provides meaning for the action or action group
 	Code []common.CodeableConcept `json:"code,omitempty"`
@@ -121,43 +128,52 @@ type RequestGroupAction struct {
 	Condition []RequestGroupActionCondition `json:"condition,omitempty"`
 
 	// A short description of the action used to provide a summary to display to the user
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Didactic or other informational resources associated with the action that can be provided to the CDS recipient
 	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
 
 	// Defines the grouping behavior for the action and its children
-	GroupingBehavior *RequestGroupActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehavior        *RequestGroupActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement *common.Element                     `json:"_groupingBehavior,omitempty"`
 
 	// The participant that should perform or be responsible for this action
 	Participant []common.Reference `json:"participant,omitempty"`
 
 	// Defines whether the action should usually be preselected
-	PrecheckBehavior *RequestGroupActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehavior        *RequestGroupActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement *common.Element                     `json:"_precheckBehavior,omitempty"`
 
 	// A user-visible prefix for the action
-	Prefix *string `json:"prefix,omitempty"`
+	Prefix        *string         `json:"prefix,omitempty"`
+	PrefixElement *common.Element `json:"_prefix,omitempty"`
 
 	// Indicates how quickly the action should be addressed with respect to other actions
-	Priority *RequestGroupActionPriority `json:"priority,omitempty"`
+	Priority        *RequestGroupActionPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element             `json:"_priority,omitempty"`
 
 	// A relationship to another action such as "before" or "30-60 minutes after start of"
 	RelatedAction []RequestGroupActionRelatedAction `json:"relatedAction,omitempty"`
 
 	// Defines expectations around whether an action is required
-	RequiredBehavior *RequestGroupActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehavior        *RequestGroupActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement *common.Element                     `json:"_requiredBehavior,omitempty"`
 
 	// The target resource SHALL be a Request resource with a Request.intent set to "option"
 	Resource *common.Reference `json:"resource,omitempty"`
 
 	// Defines the selection behavior for the action and its children
-	SelectionBehavior *RequestGroupActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehavior        *RequestGroupActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement *common.Element                      `json:"_selectionBehavior,omitempty"`
 
 	// A text equivalent of the action to be performed
-	TextEquivalent *string `json:"textEquivalent,omitempty"`
+	TextEquivalent        *string         `json:"textEquivalent,omitempty"`
+	TextEquivalentElement *common.Element `json:"_textEquivalent,omitempty"`
 
 	// An optional value describing when the action should be performed
-	TimingDateTime *string `json:"timingDateTime,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
 
 	// An optional value describing when the action should be performed
 	TimingAge *common.Age `json:"timingAge,omitempty"`
@@ -175,7 +191,8 @@ type RequestGroupAction struct {
 	TimingTiming *common.Timing `json:"timingTiming,omitempty"`
 
 	// The title of the action displayed to a user
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The type of action to perform (create, update, remove)
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -245,7 +262,8 @@ type RequestGroupActionCondition struct {
 	Expression *common.Expression `json:"expression,omitempty"`
 
 	// Applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
-	Kind RequestGroupActionConditionKind `json:"kind"`
+	Kind        RequestGroupActionConditionKind `json:"kind"`
+	KindElement *common.Element                 `json:"_kind,omitempty"`
 }
 
 // RequestGroupActionConditionKind represents applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
@@ -262,7 +280,8 @@ type RequestGroupActionRelatedAction struct {
 	common.BackboneElement
 
 	// The element id of the action this is related to
-	ActionId string `json:"actionId"`
+	ActionId        string          `json:"actionId"`
+	ActionIdElement *common.Element `json:"_actionId,omitempty"`
 
 	// A duration or range of durations to apply to the relationship
 	OffsetDuration *common.Duration `json:"offsetDuration,omitempty"`
@@ -271,7 +290,8 @@ type RequestGroupActionRelatedAction struct {
 	OffsetRange *common.Range `json:"offsetRange,omitempty"`
 
 	// The relationship of this action to the related action
-	Relationship RequestGroupActionRelatedActionRelationship `json:"relationship"`
+	Relationship        RequestGroupActionRelatedActionRelationship `json:"relationship"`
+	RelationshipElement *common.Element                             `json:"_relationship,omitempty"`
 }
 
 // RequestGroupActionRelatedActionRelationship represents the relationship of this action to the related action
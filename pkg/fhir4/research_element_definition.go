@@ -12,7 +12,8 @@ type ResearchElementDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "ResearchElementDefinition"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.DateTime `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element  `json:"_approvalDate,omitempty"`
 
 	// An individiual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -21,19 +22,23 @@ type ResearchElementDefinition struct {
 	Characteristic []ResearchElementDefinitionCharacteristic `json:"characteristic"`
 
 	// A human-readable string to clarify or explain concepts about the resource
-	Comment []string `json:"comment,omitempty"`
+	Comment        []string          `json:"comment,omitempty"`
+	CommentElement []*common.Element `json:"_comment,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the research element definition and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the research element definition was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -45,7 +50,8 @@ type ResearchElementDefinition struct {
 	Endorser []common.ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of research element definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -54,19 +60,24 @@ type ResearchElementDefinition struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.DateTime `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element  `json:"_lastReviewDate,omitempty"`
 
 	// A reference to a Library resource containing the formal logic used by the ResearchElementDefinition
-	Library []string `json:"library,omitempty"`
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the research element definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -75,10 +86,12 @@ type ResearchElementDefinition struct {
 	Reviewer []common.ContactDetail `json:"reviewer,omitempty"`
 
 	// The short title provides an alternate title for use in informal descriptive contexts
-	ShortTitle *string `json:"shortTitle,omitempty"`
+	ShortTitle        *string         `json:"shortTitle,omitempty"`
+	ShortTitleElement *common.Element `json:"_shortTitle,omitempty"`
 
 	// Allows filtering of research element definitions that are appropriate for use versus not
-	Status ResearchElementDefinitionStatus `json:"status"`
+	Status        ResearchElementDefinitionStatus `json:"status"`
+	StatusElement *common.Element                 `json:"_status,omitempty"`
 
 	// The subject of the ResearchElementDefinition is critical in interpreting the criteria definitions
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
@@ -87,31 +100,38 @@ type ResearchElementDefinition struct {
 	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
 
 	// An explanatory or alternate title for the ResearchElementDefinition giving additional information about its content
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the ResearchElementDefinition
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// The type of research element, a population, an exposure, or an outcome
-	Type ResearchElementDefinitionType `json:"type"`
+	Type        ResearchElementDefinitionType `json:"type"`
+	TypeElement *common.Element               `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description, from a clinical perspective, of how the ResearchElementDefinition is used
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// The type of the outcome (e.g. Dichotomous, Continuous, or Descriptive)
-	VariableType *ResearchElementDefinitionVariableType `json:"variableType,omitempty"`
+	VariableType        *ResearchElementDefinitionVariableType `json:"variableType,omitempty"`
+	VariableTypeElement *common.Element                        `json:"_variableType,omitempty"`
 
 	// There may be different research element definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // ResearchElementDefinitionStatus represents allows filtering of research element definitions that are appropriate for use versus not
@@ -150,7 +170,8 @@ type ResearchElementDefinitionCharacteristic struct {
 	DefinitionCodeableConcept *common.CodeableConcept `json:"definitionCodeableConcept,omitempty"`
 
 	// Define members of the research element using Codes (such as condition, medication, or observation)
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty"`
+	DefinitionCanonical        *string         `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element `json:"_definitionCanonical,omitempty"`
 
 	// Define members of the research element using Codes (such as condition, medication, or observation)
 	DefinitionExpression *common.Expression `json:"definitionExpression,omitempty"`
@@ -159,10 +180,12 @@ type ResearchElementDefinitionCharacteristic struct {
 	DefinitionDataRequirement *common.DataRequirement `json:"definitionDataRequirement,omitempty"`
 
 	// When true, members with this characteristic are excluded from the element
-	Exclude *bool `json:"exclude,omitempty"`
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// Indicates what effective period the study covers
-	ParticipantEffectiveDateTime *string `json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTime        *common.DateTime `json:"participantEffectiveDateTime,omitempty"`
+	ParticipantEffectiveDateTimeElement *common.Element  `json:"_participantEffectiveDateTime,omitempty"`
 
 	// Indicates what effective period the study covers
 	ParticipantEffectivePeriod *common.Period `json:"participantEffectivePeriod,omitempty"`
@@ -174,16 +197,19 @@ type ResearchElementDefinitionCharacteristic struct {
 	ParticipantEffectiveTiming *common.Timing `json:"participantEffectiveTiming,omitempty"`
 
 	// A narrative description of the time period the study covers
-	ParticipantEffectiveDescription *string `json:"participantEffectiveDescription,omitempty"`
+	ParticipantEffectiveDescription        *string         `json:"participantEffectiveDescription,omitempty"`
+	ParticipantEffectiveDescriptionElement *common.Element `json:"_participantEffectiveDescription,omitempty"`
 
 	// Indicates how elements are aggregated within the study effective period
-	ParticipantEffectiveGroupMeasure *ResearchElementDefinitionCharacteristicGroupMeasure `json:"participantEffectiveGroupMeasure,omitempty"`
+	ParticipantEffectiveGroupMeasure        *ResearchElementDefinitionCharacteristicGroupMeasure `json:"participantEffectiveGroupMeasure,omitempty"`
+	ParticipantEffectiveGroupMeasureElement *common.Element                                      `json:"_participantEffectiveGroupMeasure,omitempty"`
 
 	// Indicates duration from the participant's study entry
 	ParticipantEffectiveTimeFromStart *common.Duration `json:"participantEffectiveTimeFromStart,omitempty"`
 
 	// Indicates what effective period the study covers
-	StudyEffectiveDateTime *string `json:"studyEffectiveDateTime,omitempty"`
+	StudyEffectiveDateTime        *common.DateTime `json:"studyEffectiveDateTime,omitempty"`
+	StudyEffectiveDateTimeElement *common.Element  `json:"_studyEffectiveDateTime,omitempty"`
 
 	// Indicates what effective period the study covers
 	StudyEffectivePeriod *common.Period `json:"studyEffectivePeriod,omitempty"`
@@ -195,10 +221,12 @@ type ResearchElementDefinitionCharacteristic struct {
 	StudyEffectiveTiming *common.Timing `json:"studyEffectiveTiming,omitempty"`
 
 	// A narrative description of the time period the study covers
-	StudyEffectiveDescription *string `json:"studyEffectiveDescription,omitempty"`
+	StudyEffectiveDescription        *string         `json:"studyEffectiveDescription,omitempty"`
+	StudyEffectiveDescriptionElement *common.Element `json:"_studyEffectiveDescription,omitempty"`
 
 	// Indicates how elements are aggregated within the study effective period
-	StudyEffectiveGroupMeasure *ResearchElementDefinitionCharacteristicGroupMeasure `json:"studyEffectiveGroupMeasure,omitempty"`
+	StudyEffectiveGroupMeasure        *ResearchElementDefinitionCharacteristicGroupMeasure `json:"studyEffectiveGroupMeasure,omitempty"`
+	StudyEffectiveGroupMeasureElement *common.Element                                      `json:"_studyEffectiveGroupMeasure,omitempty"`
 
 	// Indicates duration from the study initiation
 	StudyEffectiveTimeFromStart *common.Duration `json:"studyEffectiveTimeFromStart,omitempty"`
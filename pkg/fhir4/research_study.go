@@ -24,7 +24,8 @@ type ResearchStudy struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A full description of how the study is being conducted
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The Group referenced should not generally enumerate specific subjects
 	Enrollment []common.Reference `json:"enrollment,omitempty"`
@@ -78,10 +79,12 @@ type ResearchStudy struct {
 	Sponsor *common.Reference `json:"sponsor,omitempty"`
 
 	// The current state of the study
-	Status ResearchStudyStatus `json:"status"`
+	Status        ResearchStudyStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// A short, descriptive user-friendly label for the study
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ResearchStudyStatus represents the current state of the study
@@ -106,10 +109,12 @@ type ResearchStudyArm struct {
 	common.BackboneElement
 
 	// A succinct description of the path through the study that would be followed by a subject adhering to this arm
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Unique, human-readable label for this arm of the study
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Categorization of study arm, e.g. experimental, active comparator, placebo comparater
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -120,7 +125,8 @@ type ResearchStudyObjective struct {
 	common.BackboneElement
 
 	// Unique, human-readable label for this objective of the study
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The kind of study objective
 	Type *common.CodeableConcept `json:"type,omitempty"`
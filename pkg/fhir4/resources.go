@@ -11,16 +11,19 @@ type Resource struct {
 	ResourceType string `json:"resourceType"`
 
 	// Logical id of this artifact
-	ID *string `json:"id,omitempty"`
+	ID        *string         `json:"id,omitempty"`
+	IDElement *common.Element `json:"_id,omitempty"`
 
 	// Metadata about the resource
 	Meta *Meta `json:"meta,omitempty"`
 
 	// A set of rules under which this content was created
-	ImplicitRules *string `json:"implicitRules,omitempty"`
+	ImplicitRules        *string         `json:"implicitRules,omitempty"`
+	ImplicitRulesElement *common.Element `json:"_implicitRules,omitempty"`
 
 	// Language of the resource content
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 }
 
 // DomainResource is a resource that includes narrative, extensions, and contained resources
@@ -28,7 +31,7 @@ type DomainResource struct {
 	Resource
 
 	// Contained, inline Resources
-	Contained []interface{} `json:"contained,omitempty"`
+	Contained ResourceList `json:"contained,omitempty"`
 
 	// Additional content defined by implementations
 	Extension []common.Extension `json:"extension,omitempty"`
@@ -45,16 +48,20 @@ type Meta struct {
 	common.Element
 
 	// Version specific identifier
-	VersionID *string `json:"versionId,omitempty"`
+	VersionID        *string         `json:"versionId,omitempty"`
+	VersionIDElement *common.Element `json:"_versionId,omitempty"`
 
 	// When the resource version last changed
-	LastUpdated *string `json:"lastUpdated,omitempty"`
+	LastUpdated        *common.Instant `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *common.Element `json:"_lastUpdated,omitempty"`
 
 	// Identifies where the resource comes from
-	Source *string `json:"source,omitempty"`
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Security Labels applied to this resource
 	Security []common.Coding `json:"security,omitempty"`
@@ -68,7 +75,8 @@ type Narrative struct {
 	common.Element
 
 	// generated | extensions | additional | empty
-	Status NarrativeStatus `json:"status"`
+	Status        NarrativeStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Limited xhtml content
 	Div string `json:"div"`
@@ -89,7 +97,8 @@ type Timing struct {
 	common.BackboneElement
 
 	// When the event occurs
-	Event []string `json:"event,omitempty"`
+	Event        []common.DateTime `json:"event,omitempty"`
+	EventElement []*common.Element `json:"_event,omitempty"`
 
 	// When the event is to occur
 	Repeat *TimingRepeat `json:"repeat,omitempty"`
@@ -108,34 +117,48 @@ type TimingRepeat struct {
 	BoundsPeriod   *common.Period `json:"boundsPeriod,omitempty"`
 
 	// Number of times to repeat
-	Count    *int `json:"count,omitempty"`
-	CountMax *int `json:"countMax,omitempty"`
+	Count           *int            `json:"count,omitempty"`
+	CountElement    *common.Element `json:"_count,omitempty"`
+	CountMax        *int            `json:"countMax,omitempty"`
+	CountMaxElement *common.Element `json:"_countMax,omitempty"`
 
 	// How long when it happens
-	Duration     *float64     `json:"duration,omitempty"`
-	DurationMax  *float64     `json:"durationMax,omitempty"`
-	DurationUnit *UnitsOfTime `json:"durationUnit,omitempty"`
+	Duration            *common.Decimal `json:"duration,omitempty"`
+	DurationElement     *common.Element `json:"_duration,omitempty"`
+	DurationMax         *common.Decimal `json:"durationMax,omitempty"`
+	DurationMaxElement  *common.Element `json:"_durationMax,omitempty"`
+	DurationUnit        *UnitsOfTime    `json:"durationUnit,omitempty"`
+	DurationUnitElement *common.Element `json:"_durationUnit,omitempty"`
 
 	// Event occurs frequency times per period
-	Frequency    *int `json:"frequency,omitempty"`
-	FrequencyMax *int `json:"frequencyMax,omitempty"`
+	Frequency           *int            `json:"frequency,omitempty"`
+	FrequencyElement    *common.Element `json:"_frequency,omitempty"`
+	FrequencyMax        *int            `json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *common.Element `json:"_frequencyMax,omitempty"`
 
 	// Event occurs frequency times per period
-	Period     *float64     `json:"period,omitempty"`
-	PeriodMax  *float64     `json:"periodMax,omitempty"`
-	PeriodUnit *UnitsOfTime `json:"periodUnit,omitempty"`
+	Period            *common.Decimal `json:"period,omitempty"`
+	PeriodElement     *common.Element `json:"_period,omitempty"`
+	PeriodMax         *common.Decimal `json:"periodMax,omitempty"`
+	PeriodMaxElement  *common.Element `json:"_periodMax,omitempty"`
+	PeriodUnit        *UnitsOfTime    `json:"periodUnit,omitempty"`
+	PeriodUnitElement *common.Element `json:"_periodUnit,omitempty"`
 
 	// If one or more days of week is provided, then the action happens only on the specified day(s)
-	DayOfWeek []DaysOfWeek `json:"dayOfWeek,omitempty"`
+	DayOfWeek        []DaysOfWeek      `json:"dayOfWeek,omitempty"`
+	DayOfWeekElement []*common.Element `json:"_dayOfWeek,omitempty"`
 
 	// Time of day for action
-	TimeOfDay []string `json:"timeOfDay,omitempty"`
+	TimeOfDay        []common.Time     `json:"timeOfDay,omitempty"`
+	TimeOfDayElement []*common.Element `json:"_timeOfDay,omitempty"`
 
 	// Code for time period of occurrence
-	When []EventTiming `json:"when,omitempty"`
+	When        []EventTiming     `json:"when,omitempty"`
+	WhenElement []*common.Element `json:"_when,omitempty"`
 
 	// Minutes from event (before or after)
-	Offset *int `json:"offset,omitempty"`
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
 }
 
 // UnitsOfTime represents units of time
@@ -183,22 +206,28 @@ type SampledData struct {
 	Origin common.Quantity `json:"origin"`
 
 	// Number of milliseconds between samples
-	Period float64 `json:"period"`
+	Period        common.Decimal  `json:"period"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// Multiply data by this before adding to origin
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Lower limit of detection
-	LowerLimit *float64 `json:"lowerLimit,omitempty"`
+	LowerLimit        *common.Decimal `json:"lowerLimit,omitempty"`
+	LowerLimitElement *common.Element `json:"_lowerLimit,omitempty"`
 
 	// Upper limit of detection
-	UpperLimit *float64 `json:"upperLimit,omitempty"`
+	UpperLimit        *common.Decimal `json:"upperLimit,omitempty"`
+	UpperLimitElement *common.Element `json:"_upperLimit,omitempty"`
 
 	// Number of sample points at each time point
-	Dimensions int `json:"dimensions"`
+	Dimensions        int             `json:"dimensions"`
+	DimensionsElement *common.Element `json:"_dimensions,omitempty"`
 
 	// Decimal values with spaces, or "E" | "U" | "L"
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 }
 
 // Signature represents a signature along with supporting context
@@ -209,7 +238,8 @@ type Signature struct {
 	Type []common.Coding `json:"type"`
 
 	// When the signature was created
-	When string `json:"when"`
+	When        common.Instant  `json:"when"`
+	WhenElement *common.Element `json:"_when,omitempty"`
 
 	// Who signed
 	Who common.Reference `json:"who"`
@@ -218,13 +248,16 @@ type Signature struct {
 	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
 
 	// The technical format of the signed resources
-	TargetFormat *string `json:"targetFormat,omitempty"`
+	TargetFormat        *string         `json:"targetFormat,omitempty"`
+	TargetFormatElement *common.Element `json:"_targetFormat,omitempty"`
 
 	// The technical format of the signature
-	SigFormat *string `json:"sigFormat,omitempty"`
+	SigFormat        *string         `json:"sigFormat,omitempty"`
+	SigFormatElement *common.Element `json:"_sigFormat,omitempty"`
 
 	// The base64 encoding of the Signature content
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 }
 
 // ResearchSubject represents a physical entity which is the primary unit of operational and/or administrative interest in a study
@@ -238,7 +271,8 @@ type ResearchSubject struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// candidate | eligible | follow-up | ineligible | not-registered | off-study | on-study | on-study-intervention | on-study-observation | pending-on-study | potential-candidate | screening | withdrawn
-	Status ResearchSubjectStatus `json:"status"`
+	Status        ResearchSubjectStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// Dates the subject began and ended their participation in the study
 	Period *common.Period `json:"period,omitempty"`
@@ -250,10 +284,12 @@ type ResearchSubject struct {
 	Individual common.Reference `json:"individual"`
 
 	// What path should be followed
-	AssignedArm *string `json:"assignedArm,omitempty"`
+	AssignedArm        *string         `json:"assignedArm,omitempty"`
+	AssignedArmElement *common.Element `json:"_assignedArm,omitempty"`
 
 	// What path was followed
-	ActualArm *string `json:"actualArm,omitempty"`
+	ActualArm        *string         `json:"actualArm,omitempty"`
+	ActualArmElement *common.Element `json:"_actualArm,omitempty"`
 
 	// Agreement to participate in study
 	Consent *common.Reference `json:"consent,omitempty"`
@@ -289,7 +325,8 @@ type Consent struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// draft | proposed | active | rejected | inactive | entered-in-error
-	Status ConsentStatus `json:"status"`
+	Status        ConsentStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Which of the four areas this resource covers (extensible)
 	Scope common.CodeableConcept `json:"scope"`
@@ -301,7 +338,8 @@ type Consent struct {
 	Patient *common.Reference `json:"patient,omitempty"`
 
 	// When consent was agreed to
-	DateTime *string `json:"dateTime,omitempty"`
+	DateTime        *string         `json:"dateTime,omitempty"`
+	DateTimeElement *common.Element `json:"_dateTime,omitempty"`
 
 	// Who is agreeing to the policy and rules
 	Performer []common.Reference `json:"performer,omitempty"`
@@ -343,10 +381,12 @@ type ConsentPolicy struct {
 	common.BackboneElement
 
 	// Entity or Organization having regulatory jurisdiction
-	Authority *string `json:"authority,omitempty"`
+	Authority        *string         `json:"authority,omitempty"`
+	AuthorityElement *common.Element `json:"_authority,omitempty"`
 
 	// Specific policy covered by this consent
-	Uri *string `json:"uri,omitempty"`
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 }
 
 // ConsentProvision represents constraints to the base Consent.policyRule
@@ -354,7 +394,8 @@ type ConsentProvision struct {
 	common.BackboneElement
 
 	// deny | permit
-	Type *ConsentProvisionType `json:"type,omitempty"`
+	Type        *ConsentProvisionType `json:"type,omitempty"`
+	TypeElement *common.Element       `json:"_type,omitempty"`
 
 	// Timeframe for this rule
 	Period *common.Period `json:"period,omitempty"`
@@ -411,7 +452,8 @@ type ConsentProvisionData struct {
 	common.BackboneElement
 
 	// instance | related | dependents | authoredby
-	Meaning ConsentProvisionDataMeaning `json:"meaning"`
+	Meaning        ConsentProvisionDataMeaning `json:"meaning"`
+	MeaningElement *common.Element             `json:"_meaning,omitempty"`
 
 	// The actual data reference
 	Reference common.Reference `json:"reference"`
@@ -432,11 +474,13 @@ type ConsentVerification struct {
 	common.BackboneElement
 
 	// Has been verified
-	Verified bool `json:"verified"`
+	Verified        bool            `json:"verified"`
+	VerifiedElement *common.Element `json:"_verified,omitempty"`
 
 	// Person who verified
 	VerifiedWith *common.Reference `json:"verifiedWith,omitempty"`
 
 	// When consent verified
-	VerificationDate *string `json:"verificationDate,omitempty"`
+	VerificationDate        *common.DateTime `json:"verificationDate,omitempty"`
+	VerificationDateElement *common.Element  `json:"_verificationDate,omitempty"`
 }
@@ -0,0 +1,1021 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir4
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+var resourceFactories = map[string]common.ResourceFactory{
+	"Account":                           func() common.Resource { return new(Account) },
+	"ActivityDefinition":                func() common.Resource { return new(ActivityDefinition) },
+	"AdverseEvent":                      func() common.Resource { return new(AdverseEvent) },
+	"AllergyIntolerance":                func() common.Resource { return new(AllergyIntolerance) },
+	"Appointment":                       func() common.Resource { return new(Appointment) },
+	"AppointmentResponse":               func() common.Resource { return new(AppointmentResponse) },
+	"AuditEvent":                        func() common.Resource { return new(AuditEvent) },
+	"Basic":                             func() common.Resource { return new(Basic) },
+	"Binary":                            func() common.Resource { return new(Binary) },
+	"BiologicallyDerivedProduct":        func() common.Resource { return new(BiologicallyDerivedProduct) },
+	"BodyStructure":                     func() common.Resource { return new(BodyStructure) },
+	"Bundle":                            func() common.Resource { return new(Bundle) },
+	"CapabilityStatement":               func() common.Resource { return new(CapabilityStatement) },
+	"CareTeam":                          func() common.Resource { return new(CareTeam) },
+	"CatalogEntry":                      func() common.Resource { return new(CatalogEntry) },
+	"ChargeItem":                        func() common.Resource { return new(ChargeItem) },
+	"ChargeItemDefinition":              func() common.Resource { return new(ChargeItemDefinition) },
+	"Claim":                             func() common.Resource { return new(Claim) },
+	"ClaimResponse":                     func() common.Resource { return new(ClaimResponse) },
+	"ClinicalImpression":                func() common.Resource { return new(ClinicalImpression) },
+	"CodeSystem":                        func() common.Resource { return new(CodeSystem) },
+	"Communication":                     func() common.Resource { return new(Communication) },
+	"CommunicationRequest":              func() common.Resource { return new(CommunicationRequest) },
+	"CompartmentDefinition":             func() common.Resource { return new(CompartmentDefinition) },
+	"Composition":                       func() common.Resource { return new(Composition) },
+	"ConceptMap":                        func() common.Resource { return new(ConceptMap) },
+	"Condition":                         func() common.Resource { return new(Condition) },
+	"Consent":                           func() common.Resource { return new(Consent) },
+	"Contract":                          func() common.Resource { return new(Contract) },
+	"Coverage":                          func() common.Resource { return new(Coverage) },
+	"CoverageEligibilityRequest":        func() common.Resource { return new(CoverageEligibilityRequest) },
+	"CoverageEligibilityResponse":       func() common.Resource { return new(CoverageEligibilityResponse) },
+	"DetectedIssue":                     func() common.Resource { return new(DetectedIssue) },
+	"Device":                            func() common.Resource { return new(Device) },
+	"DeviceDefinition":                  func() common.Resource { return new(DeviceDefinition) },
+	"DeviceMetric":                      func() common.Resource { return new(DeviceMetric) },
+	"DeviceRequest":                     func() common.Resource { return new(DeviceRequest) },
+	"DeviceUseStatement":                func() common.Resource { return new(DeviceUseStatement) },
+	"DiagnosticReport":                  func() common.Resource { return new(DiagnosticReport) },
+	"DocumentManifest":                  func() common.Resource { return new(DocumentManifest) },
+	"DocumentReference":                 func() common.Resource { return new(DocumentReference) },
+	"EffectEvidenceSynthesis":           func() common.Resource { return new(EffectEvidenceSynthesis) },
+	"Encounter":                         func() common.Resource { return new(Encounter) },
+	"Endpoint":                          func() common.Resource { return new(Endpoint) },
+	"EnrollmentRequest":                 func() common.Resource { return new(EnrollmentRequest) },
+	"EnrollmentResponse":                func() common.Resource { return new(EnrollmentResponse) },
+	"EpisodeOfCare":                     func() common.Resource { return new(EpisodeOfCare) },
+	"EventDefinition":                   func() common.Resource { return new(EventDefinition) },
+	"Evidence":                          func() common.Resource { return new(Evidence) },
+	"EvidenceVariable":                  func() common.Resource { return new(EvidenceVariable) },
+	"ExampleScenario":                   func() common.Resource { return new(ExampleScenario) },
+	"ExplanationOfBenefit":              func() common.Resource { return new(ExplanationOfBenefit) },
+	"FamilyMemberHistory":               func() common.Resource { return new(FamilyMemberHistory) },
+	"Flag":                              func() common.Resource { return new(Flag) },
+	"Goal":                              func() common.Resource { return new(Goal) },
+	"GraphDefinition":                   func() common.Resource { return new(GraphDefinition) },
+	"Group":                             func() common.Resource { return new(Group) },
+	"GuidanceResponse":                  func() common.Resource { return new(GuidanceResponse) },
+	"HealthcareService":                 func() common.Resource { return new(HealthcareService) },
+	"ImagingStudy":                      func() common.Resource { return new(ImagingStudy) },
+	"Immunization":                      func() common.Resource { return new(Immunization) },
+	"ImmunizationEvaluation":            func() common.Resource { return new(ImmunizationEvaluation) },
+	"ImmunizationRecommendation":        func() common.Resource { return new(ImmunizationRecommendation) },
+	"ImplementationGuide":               func() common.Resource { return new(ImplementationGuide) },
+	"InsurancePlan":                     func() common.Resource { return new(InsurancePlan) },
+	"Invoice":                           func() common.Resource { return new(Invoice) },
+	"Library":                           func() common.Resource { return new(Library) },
+	"Linkage":                           func() common.Resource { return new(Linkage) },
+	"List":                              func() common.Resource { return new(List) },
+	"Location":                          func() common.Resource { return new(Location) },
+	"Measure":                           func() common.Resource { return new(Measure) },
+	"MeasureReport":                     func() common.Resource { return new(MeasureReport) },
+	"Media":                             func() common.Resource { return new(Media) },
+	"Medication":                        func() common.Resource { return new(Medication) },
+	"MedicationAdministration":          func() common.Resource { return new(MedicationAdministration) },
+	"MedicationDispense":                func() common.Resource { return new(MedicationDispense) },
+	"MedicationKnowledge":               func() common.Resource { return new(MedicationKnowledge) },
+	"MedicationRequest":                 func() common.Resource { return new(MedicationRequest) },
+	"MedicationStatement":               func() common.Resource { return new(MedicationStatement) },
+	"MedicinalProduct":                  func() common.Resource { return new(MedicinalProduct) },
+	"MedicinalProductAuthorization":     func() common.Resource { return new(MedicinalProductAuthorization) },
+	"MedicinalProductContraindication":  func() common.Resource { return new(MedicinalProductContraindication) },
+	"MedicinalProductIndication":        func() common.Resource { return new(MedicinalProductIndication) },
+	"MedicinalProductIngredient":        func() common.Resource { return new(MedicinalProductIngredient) },
+	"MedicinalProductInteraction":       func() common.Resource { return new(MedicinalProductInteraction) },
+	"MedicinalProductManufactured":      func() common.Resource { return new(MedicinalProductManufactured) },
+	"MedicinalProductPackaged":          func() common.Resource { return new(MedicinalProductPackaged) },
+	"MedicinalProductPharmaceutical":    func() common.Resource { return new(MedicinalProductPharmaceutical) },
+	"MedicinalProductUndesirableEffect": func() common.Resource { return new(MedicinalProductUndesirableEffect) },
+	"MessageDefinition":                 func() common.Resource { return new(MessageDefinition) },
+	"MessageHeader":                     func() common.Resource { return new(MessageHeader) },
+	"MolecularSequence":                 func() common.Resource { return new(MolecularSequence) },
+	"NamingSystem":                      func() common.Resource { return new(NamingSystem) },
+	"NutritionOrder":                    func() common.Resource { return new(NutritionOrder) },
+	"Observation":                       func() common.Resource { return new(Observation) },
+	"ObservationDefinition":             func() common.Resource { return new(ObservationDefinition) },
+	"OperationDefinition":               func() common.Resource { return new(OperationDefinition) },
+	"OperationOutcome":                  func() common.Resource { return new(OperationOutcome) },
+	"Organization":                      func() common.Resource { return new(Organization) },
+	"OrganizationAffiliation":           func() common.Resource { return new(OrganizationAffiliation) },
+	"Parameters":                        func() common.Resource { return new(Parameters) },
+	"Patient":                           func() common.Resource { return new(Patient) },
+	"PaymentNotice":                     func() common.Resource { return new(PaymentNotice) },
+	"PaymentReconciliation":             func() common.Resource { return new(PaymentReconciliation) },
+	"Person":                            func() common.Resource { return new(Person) },
+	"PlanDefinition":                    func() common.Resource { return new(PlanDefinition) },
+	"Practitioner":                      func() common.Resource { return new(Practitioner) },
+	"PractitionerRole":                  func() common.Resource { return new(PractitionerRole) },
+	"Procedure":                         func() common.Resource { return new(Procedure) },
+	"Provenance":                        func() common.Resource { return new(Provenance) },
+	"Questionnaire":                     func() common.Resource { return new(Questionnaire) },
+	"QuestionnaireResponse":             func() common.Resource { return new(QuestionnaireResponse) },
+	"RelatedPerson":                     func() common.Resource { return new(RelatedPerson) },
+	"RequestGroup":                      func() common.Resource { return new(RequestGroup) },
+	"ResearchDefinition":                func() common.Resource { return new(ResearchDefinition) },
+	"ResearchElementDefinition":         func() common.Resource { return new(ResearchElementDefinition) },
+	"ResearchStudy":                     func() common.Resource { return new(ResearchStudy) },
+	"ResearchSubject":                   func() common.Resource { return new(ResearchSubject) },
+	"RiskAssessment":                    func() common.Resource { return new(RiskAssessment) },
+	"RiskEvidenceSynthesis":             func() common.Resource { return new(RiskEvidenceSynthesis) },
+	"Schedule":                          func() common.Resource { return new(Schedule) },
+	"SearchParameter":                   func() common.Resource { return new(SearchParameter) },
+	"ServiceRequest":                    func() common.Resource { return new(ServiceRequest) },
+	"Slot":                              func() common.Resource { return new(Slot) },
+	"SpecimenDefinition":                func() common.Resource { return new(SpecimenDefinition) },
+	"StructureDefinition":               func() common.Resource { return new(StructureDefinition) },
+	"StructureMap":                      func() common.Resource { return new(StructureMap) },
+	"Subscription":                      func() common.Resource { return new(Subscription) },
+	"Substance":                         func() common.Resource { return new(Substance) },
+	"SubstanceNucleicAcid":              func() common.Resource { return new(SubstanceNucleicAcid) },
+	"SubstancePolymer":                  func() common.Resource { return new(SubstancePolymer) },
+	"SubstanceProtein":                  func() common.Resource { return new(SubstanceProtein) },
+	"SubstanceReferenceInformation":     func() common.Resource { return new(SubstanceReferenceInformation) },
+	"SubstanceSourceMaterial":           func() common.Resource { return new(SubstanceSourceMaterial) },
+	"SubstanceSpecification":            func() common.Resource { return new(SubstanceSpecification) },
+	"SupplyDelivery":                    func() common.Resource { return new(SupplyDelivery) },
+	"SupplyRequest":                     func() common.Resource { return new(SupplyRequest) },
+	"Task":                              func() common.Resource { return new(Task) },
+	"TerminologyCapabilities":           func() common.Resource { return new(TerminologyCapabilities) },
+	"TestReport":                        func() common.Resource { return new(TestReport) },
+	"TestScript":                        func() common.Resource { return new(TestScript) },
+	"ValueSet":                          func() common.Resource { return new(ValueSet) },
+	"VerificationResult":                func() common.Resource { return new(VerificationResult) },
+	"VisionPrescription":                func() common.Resource { return new(VisionPrescription) },
+}
+
+var (
+	_ AnyDomainResource = (*Account)(nil)
+	_ AnyDomainResource = (*ActivityDefinition)(nil)
+	_ AnyDomainResource = (*AdverseEvent)(nil)
+	_ AnyDomainResource = (*AllergyIntolerance)(nil)
+	_ AnyDomainResource = (*Appointment)(nil)
+	_ AnyDomainResource = (*AppointmentResponse)(nil)
+	_ AnyDomainResource = (*AuditEvent)(nil)
+	_ AnyDomainResource = (*Basic)(nil)
+	_ AnyResource       = (*Binary)(nil)
+	_ AnyDomainResource = (*BiologicallyDerivedProduct)(nil)
+	_ AnyDomainResource = (*BodyStructure)(nil)
+	_ AnyResource       = (*Bundle)(nil)
+	_ AnyDomainResource = (*CapabilityStatement)(nil)
+	_ AnyDomainResource = (*CareTeam)(nil)
+	_ AnyDomainResource = (*CatalogEntry)(nil)
+	_ AnyDomainResource = (*ChargeItem)(nil)
+	_ AnyDomainResource = (*ChargeItemDefinition)(nil)
+	_ AnyDomainResource = (*Claim)(nil)
+	_ AnyDomainResource = (*ClaimResponse)(nil)
+	_ AnyDomainResource = (*ClinicalImpression)(nil)
+	_ AnyDomainResource = (*CodeSystem)(nil)
+	_ AnyDomainResource = (*Communication)(nil)
+	_ AnyDomainResource = (*CommunicationRequest)(nil)
+	_ AnyDomainResource = (*CompartmentDefinition)(nil)
+	_ AnyDomainResource = (*Composition)(nil)
+	_ AnyDomainResource = (*ConceptMap)(nil)
+	_ AnyDomainResource = (*Condition)(nil)
+	_ AnyDomainResource = (*Consent)(nil)
+	_ AnyDomainResource = (*Contract)(nil)
+	_ AnyDomainResource = (*Coverage)(nil)
+	_ AnyDomainResource = (*CoverageEligibilityRequest)(nil)
+	_ AnyDomainResource = (*CoverageEligibilityResponse)(nil)
+	_ AnyDomainResource = (*DetectedIssue)(nil)
+	_ AnyDomainResource = (*Device)(nil)
+	_ AnyDomainResource = (*DeviceDefinition)(nil)
+	_ AnyDomainResource = (*DeviceMetric)(nil)
+	_ AnyDomainResource = (*DeviceRequest)(nil)
+	_ AnyDomainResource = (*DeviceUseStatement)(nil)
+	_ AnyDomainResource = (*DiagnosticReport)(nil)
+	_ AnyDomainResource = (*DocumentManifest)(nil)
+	_ AnyDomainResource = (*DocumentReference)(nil)
+	_ AnyDomainResource = (*EffectEvidenceSynthesis)(nil)
+	_ AnyDomainResource = (*Encounter)(nil)
+	_ AnyDomainResource = (*Endpoint)(nil)
+	_ AnyDomainResource = (*EnrollmentRequest)(nil)
+	_ AnyDomainResource = (*EnrollmentResponse)(nil)
+	_ AnyDomainResource = (*EpisodeOfCare)(nil)
+	_ AnyDomainResource = (*EventDefinition)(nil)
+	_ AnyDomainResource = (*Evidence)(nil)
+	_ AnyDomainResource = (*EvidenceVariable)(nil)
+	_ AnyDomainResource = (*ExampleScenario)(nil)
+	_ AnyDomainResource = (*ExplanationOfBenefit)(nil)
+	_ AnyDomainResource = (*FamilyMemberHistory)(nil)
+	_ AnyDomainResource = (*Flag)(nil)
+	_ AnyDomainResource = (*Goal)(nil)
+	_ AnyDomainResource = (*GraphDefinition)(nil)
+	_ AnyDomainResource = (*Group)(nil)
+	_ AnyDomainResource = (*GuidanceResponse)(nil)
+	_ AnyDomainResource = (*HealthcareService)(nil)
+	_ AnyDomainResource = (*ImagingStudy)(nil)
+	_ AnyDomainResource = (*Immunization)(nil)
+	_ AnyDomainResource = (*ImmunizationEvaluation)(nil)
+	_ AnyDomainResource = (*ImmunizationRecommendation)(nil)
+	_ AnyDomainResource = (*ImplementationGuide)(nil)
+	_ AnyDomainResource = (*InsurancePlan)(nil)
+	_ AnyDomainResource = (*Invoice)(nil)
+	_ AnyDomainResource = (*Library)(nil)
+	_ AnyDomainResource = (*Linkage)(nil)
+	_ AnyDomainResource = (*List)(nil)
+	_ AnyDomainResource = (*Location)(nil)
+	_ AnyDomainResource = (*Measure)(nil)
+	_ AnyDomainResource = (*MeasureReport)(nil)
+	_ AnyDomainResource = (*Media)(nil)
+	_ AnyDomainResource = (*Medication)(nil)
+	_ AnyDomainResource = (*MedicationAdministration)(nil)
+	_ AnyDomainResource = (*MedicationDispense)(nil)
+	_ AnyDomainResource = (*MedicationKnowledge)(nil)
+	_ AnyDomainResource = (*MedicationRequest)(nil)
+	_ AnyDomainResource = (*MedicationStatement)(nil)
+	_ AnyDomainResource = (*MedicinalProduct)(nil)
+	_ AnyDomainResource = (*MedicinalProductAuthorization)(nil)
+	_ AnyDomainResource = (*MedicinalProductContraindication)(nil)
+	_ AnyDomainResource = (*MedicinalProductIndication)(nil)
+	_ AnyDomainResource = (*MedicinalProductIngredient)(nil)
+	_ AnyDomainResource = (*MedicinalProductInteraction)(nil)
+	_ AnyDomainResource = (*MedicinalProductManufactured)(nil)
+	_ AnyDomainResource = (*MedicinalProductPackaged)(nil)
+	_ AnyDomainResource = (*MedicinalProductPharmaceutical)(nil)
+	_ AnyDomainResource = (*MedicinalProductUndesirableEffect)(nil)
+	_ AnyDomainResource = (*MessageDefinition)(nil)
+	_ AnyDomainResource = (*MessageHeader)(nil)
+	_ AnyDomainResource = (*MolecularSequence)(nil)
+	_ AnyDomainResource = (*NamingSystem)(nil)
+	_ AnyDomainResource = (*NutritionOrder)(nil)
+	_ AnyDomainResource = (*Observation)(nil)
+	_ AnyDomainResource = (*ObservationDefinition)(nil)
+	_ AnyDomainResource = (*OperationDefinition)(nil)
+	_ AnyDomainResource = (*OperationOutcome)(nil)
+	_ AnyDomainResource = (*Organization)(nil)
+	_ AnyDomainResource = (*OrganizationAffiliation)(nil)
+	_ AnyResource       = (*Parameters)(nil)
+	_ AnyDomainResource = (*Patient)(nil)
+	_ AnyDomainResource = (*PaymentNotice)(nil)
+	_ AnyDomainResource = (*PaymentReconciliation)(nil)
+	_ AnyDomainResource = (*Person)(nil)
+	_ AnyDomainResource = (*PlanDefinition)(nil)
+	_ AnyDomainResource = (*Practitioner)(nil)
+	_ AnyDomainResource = (*PractitionerRole)(nil)
+	_ AnyDomainResource = (*Procedure)(nil)
+	_ AnyDomainResource = (*Provenance)(nil)
+	_ AnyDomainResource = (*Questionnaire)(nil)
+	_ AnyDomainResource = (*QuestionnaireResponse)(nil)
+	_ AnyDomainResource = (*RelatedPerson)(nil)
+	_ AnyDomainResource = (*RequestGroup)(nil)
+	_ AnyDomainResource = (*ResearchDefinition)(nil)
+	_ AnyDomainResource = (*ResearchElementDefinition)(nil)
+	_ AnyDomainResource = (*ResearchStudy)(nil)
+	_ AnyDomainResource = (*ResearchSubject)(nil)
+	_ AnyDomainResource = (*RiskAssessment)(nil)
+	_ AnyDomainResource = (*RiskEvidenceSynthesis)(nil)
+	_ AnyDomainResource = (*Schedule)(nil)
+	_ AnyDomainResource = (*SearchParameter)(nil)
+	_ AnyDomainResource = (*ServiceRequest)(nil)
+	_ AnyDomainResource = (*Slot)(nil)
+	_ AnyDomainResource = (*SpecimenDefinition)(nil)
+	_ AnyDomainResource = (*StructureDefinition)(nil)
+	_ AnyDomainResource = (*StructureMap)(nil)
+	_ AnyDomainResource = (*Subscription)(nil)
+	_ AnyDomainResource = (*Substance)(nil)
+	_ AnyDomainResource = (*SubstanceNucleicAcid)(nil)
+	_ AnyDomainResource = (*SubstancePolymer)(nil)
+	_ AnyDomainResource = (*SubstanceProtein)(nil)
+	_ AnyDomainResource = (*SubstanceReferenceInformation)(nil)
+	_ AnyDomainResource = (*SubstanceSourceMaterial)(nil)
+	_ AnyDomainResource = (*SubstanceSpecification)(nil)
+	_ AnyDomainResource = (*SupplyDelivery)(nil)
+	_ AnyDomainResource = (*SupplyRequest)(nil)
+	_ AnyDomainResource = (*Task)(nil)
+	_ AnyDomainResource = (*TerminologyCapabilities)(nil)
+	_ AnyDomainResource = (*TestReport)(nil)
+	_ AnyDomainResource = (*TestScript)(nil)
+	_ AnyDomainResource = (*ValueSet)(nil)
+	_ AnyDomainResource = (*VerificationResult)(nil)
+	_ AnyDomainResource = (*VisionPrescription)(nil)
+)
+
+// GetResourceType returns "Account"
+func (*Account) GetResourceType() string {
+	return "Account"
+}
+
+// GetResourceType returns "ActivityDefinition"
+func (*ActivityDefinition) GetResourceType() string {
+	return "ActivityDefinition"
+}
+
+// GetResourceType returns "AdverseEvent"
+func (*AdverseEvent) GetResourceType() string {
+	return "AdverseEvent"
+}
+
+// GetResourceType returns "AllergyIntolerance"
+func (*AllergyIntolerance) GetResourceType() string {
+	return "AllergyIntolerance"
+}
+
+// GetResourceType returns "Appointment"
+func (*Appointment) GetResourceType() string {
+	return "Appointment"
+}
+
+// GetResourceType returns "AppointmentResponse"
+func (*AppointmentResponse) GetResourceType() string {
+	return "AppointmentResponse"
+}
+
+// GetResourceType returns "AuditEvent"
+func (*AuditEvent) GetResourceType() string {
+	return "AuditEvent"
+}
+
+// GetResourceType returns "Basic"
+func (*Basic) GetResourceType() string {
+	return "Basic"
+}
+
+// GetResourceType returns "Binary"
+func (*Binary) GetResourceType() string {
+	return "Binary"
+}
+
+// GetResourceType returns "BiologicallyDerivedProduct"
+func (*BiologicallyDerivedProduct) GetResourceType() string {
+	return "BiologicallyDerivedProduct"
+}
+
+// GetResourceType returns "BodyStructure"
+func (*BodyStructure) GetResourceType() string {
+	return "BodyStructure"
+}
+
+// GetResourceType returns "Bundle"
+func (*Bundle) GetResourceType() string {
+	return "Bundle"
+}
+
+// GetResourceType returns "CapabilityStatement"
+func (*CapabilityStatement) GetResourceType() string {
+	return "CapabilityStatement"
+}
+
+// GetResourceType returns "CareTeam"
+func (*CareTeam) GetResourceType() string {
+	return "CareTeam"
+}
+
+// GetResourceType returns "CatalogEntry"
+func (*CatalogEntry) GetResourceType() string {
+	return "CatalogEntry"
+}
+
+// GetResourceType returns "ChargeItem"
+func (*ChargeItem) GetResourceType() string {
+	return "ChargeItem"
+}
+
+// GetResourceType returns "ChargeItemDefinition"
+func (*ChargeItemDefinition) GetResourceType() string {
+	return "ChargeItemDefinition"
+}
+
+// GetResourceType returns "Claim"
+func (*Claim) GetResourceType() string {
+	return "Claim"
+}
+
+// GetResourceType returns "ClaimResponse"
+func (*ClaimResponse) GetResourceType() string {
+	return "ClaimResponse"
+}
+
+// GetResourceType returns "ClinicalImpression"
+func (*ClinicalImpression) GetResourceType() string {
+	return "ClinicalImpression"
+}
+
+// GetResourceType returns "CodeSystem"
+func (*CodeSystem) GetResourceType() string {
+	return "CodeSystem"
+}
+
+// GetResourceType returns "Communication"
+func (*Communication) GetResourceType() string {
+	return "Communication"
+}
+
+// GetResourceType returns "CommunicationRequest"
+func (*CommunicationRequest) GetResourceType() string {
+	return "CommunicationRequest"
+}
+
+// GetResourceType returns "CompartmentDefinition"
+func (*CompartmentDefinition) GetResourceType() string {
+	return "CompartmentDefinition"
+}
+
+// GetResourceType returns "Composition"
+func (*Composition) GetResourceType() string {
+	return "Composition"
+}
+
+// GetResourceType returns "ConceptMap"
+func (*ConceptMap) GetResourceType() string {
+	return "ConceptMap"
+}
+
+// GetResourceType returns "Condition"
+func (*Condition) GetResourceType() string {
+	return "Condition"
+}
+
+// GetResourceType returns "Consent"
+func (*Consent) GetResourceType() string {
+	return "Consent"
+}
+
+// GetResourceType returns "Contract"
+func (*Contract) GetResourceType() string {
+	return "Contract"
+}
+
+// GetResourceType returns "Coverage"
+func (*Coverage) GetResourceType() string {
+	return "Coverage"
+}
+
+// GetResourceType returns "CoverageEligibilityRequest"
+func (*CoverageEligibilityRequest) GetResourceType() string {
+	return "CoverageEligibilityRequest"
+}
+
+// GetResourceType returns "CoverageEligibilityResponse"
+func (*CoverageEligibilityResponse) GetResourceType() string {
+	return "CoverageEligibilityResponse"
+}
+
+// GetResourceType returns "DetectedIssue"
+func (*DetectedIssue) GetResourceType() string {
+	return "DetectedIssue"
+}
+
+// GetResourceType returns "Device"
+func (*Device) GetResourceType() string {
+	return "Device"
+}
+
+// GetResourceType returns "DeviceDefinition"
+func (*DeviceDefinition) GetResourceType() string {
+	return "DeviceDefinition"
+}
+
+// GetResourceType returns "DeviceMetric"
+func (*DeviceMetric) GetResourceType() string {
+	return "DeviceMetric"
+}
+
+// GetResourceType returns "DeviceRequest"
+func (*DeviceRequest) GetResourceType() string {
+	return "DeviceRequest"
+}
+
+// GetResourceType returns "DeviceUseStatement"
+func (*DeviceUseStatement) GetResourceType() string {
+	return "DeviceUseStatement"
+}
+
+// GetResourceType returns "DiagnosticReport"
+func (*DiagnosticReport) GetResourceType() string {
+	return "DiagnosticReport"
+}
+
+// GetResourceType returns "DocumentManifest"
+func (*DocumentManifest) GetResourceType() string {
+	return "DocumentManifest"
+}
+
+// GetResourceType returns "DocumentReference"
+func (*DocumentReference) GetResourceType() string {
+	return "DocumentReference"
+}
+
+// GetResourceType returns "EffectEvidenceSynthesis"
+func (*EffectEvidenceSynthesis) GetResourceType() string {
+	return "EffectEvidenceSynthesis"
+}
+
+// GetResourceType returns "Encounter"
+func (*Encounter) GetResourceType() string {
+	return "Encounter"
+}
+
+// GetResourceType returns "Endpoint"
+func (*Endpoint) GetResourceType() string {
+	return "Endpoint"
+}
+
+// GetResourceType returns "EnrollmentRequest"
+func (*EnrollmentRequest) GetResourceType() string {
+	return "EnrollmentRequest"
+}
+
+// GetResourceType returns "EnrollmentResponse"
+func (*EnrollmentResponse) GetResourceType() string {
+	return "EnrollmentResponse"
+}
+
+// GetResourceType returns "EpisodeOfCare"
+func (*EpisodeOfCare) GetResourceType() string {
+	return "EpisodeOfCare"
+}
+
+// GetResourceType returns "EventDefinition"
+func (*EventDefinition) GetResourceType() string {
+	return "EventDefinition"
+}
+
+// GetResourceType returns "Evidence"
+func (*Evidence) GetResourceType() string {
+	return "Evidence"
+}
+
+// GetResourceType returns "EvidenceVariable"
+func (*EvidenceVariable) GetResourceType() string {
+	return "EvidenceVariable"
+}
+
+// GetResourceType returns "ExampleScenario"
+func (*ExampleScenario) GetResourceType() string {
+	return "ExampleScenario"
+}
+
+// GetResourceType returns "ExplanationOfBenefit"
+func (*ExplanationOfBenefit) GetResourceType() string {
+	return "ExplanationOfBenefit"
+}
+
+// GetResourceType returns "FamilyMemberHistory"
+func (*FamilyMemberHistory) GetResourceType() string {
+	return "FamilyMemberHistory"
+}
+
+// GetResourceType returns "Flag"
+func (*Flag) GetResourceType() string {
+	return "Flag"
+}
+
+// GetResourceType returns "Goal"
+func (*Goal) GetResourceType() string {
+	return "Goal"
+}
+
+// GetResourceType returns "GraphDefinition"
+func (*GraphDefinition) GetResourceType() string {
+	return "GraphDefinition"
+}
+
+// GetResourceType returns "Group"
+func (*Group) GetResourceType() string {
+	return "Group"
+}
+
+// GetResourceType returns "GuidanceResponse"
+func (*GuidanceResponse) GetResourceType() string {
+	return "GuidanceResponse"
+}
+
+// GetResourceType returns "HealthcareService"
+func (*HealthcareService) GetResourceType() string {
+	return "HealthcareService"
+}
+
+// GetResourceType returns "ImagingStudy"
+func (*ImagingStudy) GetResourceType() string {
+	return "ImagingStudy"
+}
+
+// GetResourceType returns "Immunization"
+func (*Immunization) GetResourceType() string {
+	return "Immunization"
+}
+
+// GetResourceType returns "ImmunizationEvaluation"
+func (*ImmunizationEvaluation) GetResourceType() string {
+	return "ImmunizationEvaluation"
+}
+
+// GetResourceType returns "ImmunizationRecommendation"
+func (*ImmunizationRecommendation) GetResourceType() string {
+	return "ImmunizationRecommendation"
+}
+
+// GetResourceType returns "ImplementationGuide"
+func (*ImplementationGuide) GetResourceType() string {
+	return "ImplementationGuide"
+}
+
+// GetResourceType returns "InsurancePlan"
+func (*InsurancePlan) GetResourceType() string {
+	return "InsurancePlan"
+}
+
+// GetResourceType returns "Invoice"
+func (*Invoice) GetResourceType() string {
+	return "Invoice"
+}
+
+// GetResourceType returns "Library"
+func (*Library) GetResourceType() string {
+	return "Library"
+}
+
+// GetResourceType returns "Linkage"
+func (*Linkage) GetResourceType() string {
+	return "Linkage"
+}
+
+// GetResourceType returns "List"
+func (*List) GetResourceType() string {
+	return "List"
+}
+
+// GetResourceType returns "Location"
+func (*Location) GetResourceType() string {
+	return "Location"
+}
+
+// GetResourceType returns "Measure"
+func (*Measure) GetResourceType() string {
+	return "Measure"
+}
+
+// GetResourceType returns "MeasureReport"
+func (*MeasureReport) GetResourceType() string {
+	return "MeasureReport"
+}
+
+// GetResourceType returns "Media"
+func (*Media) GetResourceType() string {
+	return "Media"
+}
+
+// GetResourceType returns "Medication"
+func (*Medication) GetResourceType() string {
+	return "Medication"
+}
+
+// GetResourceType returns "MedicationAdministration"
+func (*MedicationAdministration) GetResourceType() string {
+	return "MedicationAdministration"
+}
+
+// GetResourceType returns "MedicationDispense"
+func (*MedicationDispense) GetResourceType() string {
+	return "MedicationDispense"
+}
+
+// GetResourceType returns "MedicationKnowledge"
+func (*MedicationKnowledge) GetResourceType() string {
+	return "MedicationKnowledge"
+}
+
+// GetResourceType returns "MedicationRequest"
+func (*MedicationRequest) GetResourceType() string {
+	return "MedicationRequest"
+}
+
+// GetResourceType returns "MedicationStatement"
+func (*MedicationStatement) GetResourceType() string {
+	return "MedicationStatement"
+}
+
+// GetResourceType returns "MedicinalProduct"
+func (*MedicinalProduct) GetResourceType() string {
+	return "MedicinalProduct"
+}
+
+// GetResourceType returns "MedicinalProductAuthorization"
+func (*MedicinalProductAuthorization) GetResourceType() string {
+	return "MedicinalProductAuthorization"
+}
+
+// GetResourceType returns "MedicinalProductContraindication"
+func (*MedicinalProductContraindication) GetResourceType() string {
+	return "MedicinalProductContraindication"
+}
+
+// GetResourceType returns "MedicinalProductIndication"
+func (*MedicinalProductIndication) GetResourceType() string {
+	return "MedicinalProductIndication"
+}
+
+// GetResourceType returns "MedicinalProductIngredient"
+func (*MedicinalProductIngredient) GetResourceType() string {
+	return "MedicinalProductIngredient"
+}
+
+// GetResourceType returns "MedicinalProductInteraction"
+func (*MedicinalProductInteraction) GetResourceType() string {
+	return "MedicinalProductInteraction"
+}
+
+// GetResourceType returns "MedicinalProductManufactured"
+func (*MedicinalProductManufactured) GetResourceType() string {
+	return "MedicinalProductManufactured"
+}
+
+// GetResourceType returns "MedicinalProductPackaged"
+func (*MedicinalProductPackaged) GetResourceType() string {
+	return "MedicinalProductPackaged"
+}
+
+// GetResourceType returns "MedicinalProductPharmaceutical"
+func (*MedicinalProductPharmaceutical) GetResourceType() string {
+	return "MedicinalProductPharmaceutical"
+}
+
+// GetResourceType returns "MedicinalProductUndesirableEffect"
+func (*MedicinalProductUndesirableEffect) GetResourceType() string {
+	return "MedicinalProductUndesirableEffect"
+}
+
+// GetResourceType returns "MessageDefinition"
+func (*MessageDefinition) GetResourceType() string {
+	return "MessageDefinition"
+}
+
+// GetResourceType returns "MessageHeader"
+func (*MessageHeader) GetResourceType() string {
+	return "MessageHeader"
+}
+
+// GetResourceType returns "MolecularSequence"
+func (*MolecularSequence) GetResourceType() string {
+	return "MolecularSequence"
+}
+
+// GetResourceType returns "NamingSystem"
+func (*NamingSystem) GetResourceType() string {
+	return "NamingSystem"
+}
+
+// GetResourceType returns "NutritionOrder"
+func (*NutritionOrder) GetResourceType() string {
+	return "NutritionOrder"
+}
+
+// GetResourceType returns "Observation"
+func (*Observation) GetResourceType() string {
+	return "Observation"
+}
+
+// GetResourceType returns "ObservationDefinition"
+func (*ObservationDefinition) GetResourceType() string {
+	return "ObservationDefinition"
+}
+
+// GetResourceType returns "OperationDefinition"
+func (*OperationDefinition) GetResourceType() string {
+	return "OperationDefinition"
+}
+
+// GetResourceType returns "OperationOutcome"
+func (*OperationOutcome) GetResourceType() string {
+	return "OperationOutcome"
+}
+
+// GetResourceType returns "Organization"
+func (*Organization) GetResourceType() string {
+	return "Organization"
+}
+
+// GetResourceType returns "OrganizationAffiliation"
+func (*OrganizationAffiliation) GetResourceType() string {
+	return "OrganizationAffiliation"
+}
+
+// GetResourceType returns "Parameters"
+func (*Parameters) GetResourceType() string {
+	return "Parameters"
+}
+
+// GetResourceType returns "Patient"
+func (*Patient) GetResourceType() string {
+	return "Patient"
+}
+
+// GetResourceType returns "PaymentNotice"
+func (*PaymentNotice) GetResourceType() string {
+	return "PaymentNotice"
+}
+
+// GetResourceType returns "PaymentReconciliation"
+func (*PaymentReconciliation) GetResourceType() string {
+	return "PaymentReconciliation"
+}
+
+// GetResourceType returns "Person"
+func (*Person) GetResourceType() string {
+	return "Person"
+}
+
+// GetResourceType returns "PlanDefinition"
+func (*PlanDefinition) GetResourceType() string {
+	return "PlanDefinition"
+}
+
+// GetResourceType returns "Practitioner"
+func (*Practitioner) GetResourceType() string {
+	return "Practitioner"
+}
+
+// GetResourceType returns "PractitionerRole"
+func (*PractitionerRole) GetResourceType() string {
+	return "PractitionerRole"
+}
+
+// GetResourceType returns "Procedure"
+func (*Procedure) GetResourceType() string {
+	return "Procedure"
+}
+
+// GetResourceType returns "Provenance"
+func (*Provenance) GetResourceType() string {
+	return "Provenance"
+}
+
+// GetResourceType returns "Questionnaire"
+func (*Questionnaire) GetResourceType() string {
+	return "Questionnaire"
+}
+
+// GetResourceType returns "QuestionnaireResponse"
+func (*QuestionnaireResponse) GetResourceType() string {
+	return "QuestionnaireResponse"
+}
+
+// GetResourceType returns "RelatedPerson"
+func (*RelatedPerson) GetResourceType() string {
+	return "RelatedPerson"
+}
+
+// GetResourceType returns "RequestGroup"
+func (*RequestGroup) GetResourceType() string {
+	return "RequestGroup"
+}
+
+// GetResourceType returns "ResearchDefinition"
+func (*ResearchDefinition) GetResourceType() string {
+	return "ResearchDefinition"
+}
+
+// GetResourceType returns "ResearchElementDefinition"
+func (*ResearchElementDefinition) GetResourceType() string {
+	return "ResearchElementDefinition"
+}
+
+// GetResourceType returns "ResearchStudy"
+func (*ResearchStudy) GetResourceType() string {
+	return "ResearchStudy"
+}
+
+// GetResourceType returns "ResearchSubject"
+func (*ResearchSubject) GetResourceType() string {
+	return "ResearchSubject"
+}
+
+// GetResourceType returns "RiskAssessment"
+func (*RiskAssessment) GetResourceType() string {
+	return "RiskAssessment"
+}
+
+// GetResourceType returns "RiskEvidenceSynthesis"
+func (*RiskEvidenceSynthesis) GetResourceType() string {
+	return "RiskEvidenceSynthesis"
+}
+
+// GetResourceType returns "Schedule"
+func (*Schedule) GetResourceType() string {
+	return "Schedule"
+}
+
+// GetResourceType returns "SearchParameter"
+func (*SearchParameter) GetResourceType() string {
+	return "SearchParameter"
+}
+
+// GetResourceType returns "ServiceRequest"
+func (*ServiceRequest) GetResourceType() string {
+	return "ServiceRequest"
+}
+
+// GetResourceType returns "Slot"
+func (*Slot) GetResourceType() string {
+	return "Slot"
+}
+
+// GetResourceType returns "SpecimenDefinition"
+func (*SpecimenDefinition) GetResourceType() string {
+	return "SpecimenDefinition"
+}
+
+// GetResourceType returns "StructureDefinition"
+func (*StructureDefinition) GetResourceType() string {
+	return "StructureDefinition"
+}
+
+// GetResourceType returns "StructureMap"
+func (*StructureMap) GetResourceType() string {
+	return "StructureMap"
+}
+
+// GetResourceType returns "Subscription"
+func (*Subscription) GetResourceType() string {
+	return "Subscription"
+}
+
+// GetResourceType returns "Substance"
+func (*Substance) GetResourceType() string {
+	return "Substance"
+}
+
+// GetResourceType returns "SubstanceNucleicAcid"
+func (*SubstanceNucleicAcid) GetResourceType() string {
+	return "SubstanceNucleicAcid"
+}
+
+// GetResourceType returns "SubstancePolymer"
+func (*SubstancePolymer) GetResourceType() string {
+	return "SubstancePolymer"
+}
+
+// GetResourceType returns "SubstanceProtein"
+func (*SubstanceProtein) GetResourceType() string {
+	return "SubstanceProtein"
+}
+
+// GetResourceType returns "SubstanceReferenceInformation"
+func (*SubstanceReferenceInformation) GetResourceType() string {
+	return "SubstanceReferenceInformation"
+}
+
+// GetResourceType returns "SubstanceSourceMaterial"
+func (*SubstanceSourceMaterial) GetResourceType() string {
+	return "SubstanceSourceMaterial"
+}
+
+// GetResourceType returns "SubstanceSpecification"
+func (*SubstanceSpecification) GetResourceType() string {
+	return "SubstanceSpecification"
+}
+
+// GetResourceType returns "SupplyDelivery"
+func (*SupplyDelivery) GetResourceType() string {
+	return "SupplyDelivery"
+}
+
+// GetResourceType returns "SupplyRequest"
+func (*SupplyRequest) GetResourceType() string {
+	return "SupplyRequest"
+}
+
+// GetResourceType returns "Task"
+func (*Task) GetResourceType() string {
+	return "Task"
+}
+
+// GetResourceType returns "TerminologyCapabilities"
+func (*TerminologyCapabilities) GetResourceType() string {
+	return "TerminologyCapabilities"
+}
+
+// GetResourceType returns "TestReport"
+func (*TestReport) GetResourceType() string {
+	return "TestReport"
+}
+
+// GetResourceType returns "TestScript"
+func (*TestScript) GetResourceType() string {
+	return "TestScript"
+}
+
+// GetResourceType returns "ValueSet"
+func (*ValueSet) GetResourceType() string {
+	return "ValueSet"
+}
+
+// GetResourceType returns "VerificationResult"
+func (*VerificationResult) GetResourceType() string {
+	return "VerificationResult"
+}
+
+// GetResourceType returns "VisionPrescription"
+func (*VisionPrescription) GetResourceType() string {
+	return "VisionPrescription"
+}
@@ -33,13 +33,15 @@ type RiskAssessment struct {
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// A description of the steps that might be taken to reduce the identified risk(s)
-	Mitigation *string `json:"mitigation,omitempty"`
+	Mitigation        *string         `json:"mitigation,omitempty"`
+	MitigationElement *common.Element `json:"_mitigation,omitempty"`
 
 	// Additional comments about the risk assessment
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// The date (and possibly time) the risk assessment was performed
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// The date (and possibly time) the risk assessment was performed
 	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
@@ -60,7 +62,8 @@ type RiskAssessment struct {
 	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
 
 	// The status of the RiskAssessment, using the same statuses as an Observation
-	Status RiskAssessmentStatus `json:"status"`
+	Status        RiskAssessmentStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// The patient or group the risk assessment applies to
 	Subject common.Reference `json:"subject"`
@@ -88,7 +91,8 @@ type RiskAssessmentPrediction struct {
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
 
 	// If range is used, it represents the lower and upper bounds of certainty; e.g. 40-60%
-	ProbabilityDecimal *float64 `json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimal        *common.Decimal `json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement *common.Element `json:"_probabilityDecimal,omitempty"`
 
 	// If range is used, it represents the lower and upper bounds of certainty; e.g. 40-60%
 	ProbabilityRange *common.Range `json:"probabilityRange,omitempty"`
@@ -97,10 +101,12 @@ type RiskAssessmentPrediction struct {
 	QualitativeRisk *common.CodeableConcept `json:"qualitativeRisk,omitempty"`
 
 	// Additional information explaining the basis for the prediction
-	Rationale *string `json:"rationale,omitempty"`
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
 
 	// Indicates the risk for this particular subject (with their specific characteristics) divided by the risk of the population in general
-	RelativeRisk *float64 `json:"relativeRisk,omitempty"`
+	RelativeRisk        *common.Decimal `json:"relativeRisk,omitempty"`
+	RelativeRiskElement *common.Element `json:"_relativeRisk,omitempty"`
 
 	// If not specified, the risk applies "over the subject's lifespan"
 	WhenPeriod *common.Period `json:"whenPeriod,omitempty"`
@@ -12,7 +12,8 @@ type RiskEvidenceSynthesis struct {
 	ResourceType string `json:"resourceType"` // Always "RiskEvidenceSynthesis"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.DateTime `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element  `json:"_approvalDate,omitempty"`
 
 	// An individiual or organization primarily involved in the creation and maintenance of the content
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -24,13 +25,16 @@ type RiskEvidenceSynthesis struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the risk evidence synthesis and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the risk evidence synthesis was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -51,10 +55,12 @@ type RiskEvidenceSynthesis struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.DateTime `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element  `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A human-readable string to clarify or explain concepts about the resource
 	Note []common.Annotation `json:"note,omitempty"`
@@ -66,7 +72,8 @@ type RiskEvidenceSynthesis struct {
 	Population common.Reference `json:"population"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -81,7 +88,8 @@ type RiskEvidenceSynthesis struct {
 	SampleSize *RiskEvidenceSynthesisSampleSize `json:"sampleSize,omitempty"`
 
 	// Allows filtering of risk evidence synthesiss that are appropriate for use versus not
-	Status RiskEvidenceSynthesisStatus `json:"status"`
+	Status        RiskEvidenceSynthesisStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 
 	// Type of study eg randomized trial
 	StudyType *common.CodeableConcept `json:"studyType,omitempty"`
@@ -90,19 +98,22 @@ type RiskEvidenceSynthesis struct {
 	SynthesisType *common.CodeableConcept `json:"synthesisType,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the RiskEvidenceSynthesis
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different risk evidence synthesis instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // RiskEvidenceSynthesisStatus represents allows filtering of risk evidence synthesiss that are appropriate for use versus not
@@ -120,13 +131,16 @@ type RiskEvidenceSynthesisSampleSize struct {
 	common.BackboneElement
 
 	// Human-readable summary of sample size
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Number of participants included in this evidence synthesis
-	NumberOfParticipants *int `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipants        *int            `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *common.Element `json:"_numberOfParticipants,omitempty"`
 
 	// Number of studies included in this evidence synthesis
-	NumberOfStudies *int `json:"numberOfStudies,omitempty"`
+	NumberOfStudies        *int            `json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement *common.Element `json:"_numberOfStudies,omitempty"`
 }
 
 // RiskEvidenceSynthesisRiskEstimate represents the estimated risk of the outcome
@@ -134,13 +148,16 @@ type RiskEvidenceSynthesisRiskEstimate struct {
 	common.BackboneElement
 
 	// The sample size for the group that was measured for this risk estimate
-	DenominatorCount *int `json:"denominatorCount,omitempty"`
+	DenominatorCount        *int            `json:"denominatorCount,omitempty"`
+	DenominatorCountElement *common.Element `json:"_denominatorCount,omitempty"`
 
 	// Human-readable summary of risk estimate
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The number of group members with the outcome of interest
-	NumeratorCount *int `json:"numeratorCount,omitempty"`
+	NumeratorCount        *int            `json:"numeratorCount,omitempty"`
+	NumeratorCountElement *common.Element `json:"_numeratorCount,omitempty"`
 
 	// A description of the precision of the estimate for the effect
 	PrecisionEstimate []RiskEvidenceSynthesisRiskEstimatePrecisionEstimate `json:"precisionEstimate,omitempty"`
@@ -152,7 +169,8 @@ type RiskEvidenceSynthesisRiskEstimate struct {
 	UnitOfMeasure *common.CodeableConcept `json:"unitOfMeasure,omitempty"`
 
 	// The point estimate of the risk estimate
-	Value *float64 `json:"value,omitempty"`
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // RiskEvidenceSynthesisRiskEstimatePrecisionEstimate represents a description of the precision of the estimate for the effect
@@ -160,13 +178,16 @@ type RiskEvidenceSynthesisRiskEstimatePrecisionEstimate struct {
 	common.BackboneElement
 
 	// Lower bound of confidence interval
-	From *float64 `json:"from,omitempty"`
+	From        *common.Decimal `json:"from,omitempty"`
+	FromElement *common.Element `json:"_from,omitempty"`
 
 	// Use 95 for a 95% confidence interval
-	Level *float64 `json:"level,omitempty"`
+	Level        *common.Decimal `json:"level,omitempty"`
+	LevelElement *common.Element `json:"_level,omitempty"`
 
 	// Upper bound of confidence interval
-	To *float64 `json:"to,omitempty"`
+	To        *common.Decimal `json:"to,omitempty"`
+	ToElement *common.Element `json:"_to,omitempty"`
 
 	// Examples include confidence interval and interquartile range
 	Type *common.CodeableConcept `json:"type,omitempty"`
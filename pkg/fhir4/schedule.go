@@ -12,13 +12,15 @@ type Schedule struct {
 	ResourceType string `json:"resourceType"` // Always "Schedule"
 
 	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// The capacity to support multiple referenced resource types should be used in cases where the specific resources themselves cannot be scheduled without the other
 	Actor []common.Reference `json:"actor"`
 
 	// Comments on the availability to describe any extended information
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// External Ids for this item
 	Identifier []common.Identifier `json:"identifier,omitempty"`
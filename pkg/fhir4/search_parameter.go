@@ -12,16 +12,20 @@ type SearchParameter struct {
 	ResourceType string `json:"resourceType"` // Always "SearchParameter"
 
 	// A search parameter must always apply to at least one resource type
-	Base []string `json:"base"`
+	Base        []string          `json:"base"`
+	BaseElement []*common.Element `json:"_base,omitempty"`
 
 	// Systems are not required to list all the chain names they support
-	Chain []string `json:"chain,omitempty"`
+	Chain        []string          `json:"chain,omitempty"`
+	ChainElement []*common.Element `json:"_chain,omitempty"`
 
 	// For maximum compatibility, use only lowercase ASCII characters
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// If no comparators are listed, clients should not expect servers to support any comparators
-	Comparator []SearchParameterComparator `json:"comparator,omitempty"`
+	Comparator        []SearchParameterComparator `json:"comparator,omitempty"`
+	ComparatorElement []*common.Element           `json:"_comparator,omitempty"`
 
 	// Used to define the parts of a composite search parameter
 	Component []SearchParameterComponent `json:"component,omitempty"`
@@ -30,64 +34,82 @@ type SearchParameter struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The intent of this is that a server can designate that it provides support for a search parameter defined in the specification itself
-	DerivedFrom *string `json:"derivedFrom,omitempty"`
+	DerivedFrom        *string         `json:"derivedFrom,omitempty"`
+	DerivedFromElement *common.Element `json:"_derivedFrom,omitempty"`
 
 	// This description can be used to capture details such as why the search parameter was built
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of search parameters that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Note that the elements returned by the expression are sometimes complex elements where logic is required to determine quite how to handle them
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// It may be possible for the search parameter to be used in jurisdictions other than those for which it was originally designed
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// A modifier supported for the search parameter
-	Modifier []SearchParameterModifier `json:"modifier,omitempty"`
+	Modifier        []SearchParameterModifier `json:"modifier,omitempty"`
+	ModifierElement []*common.Element         `json:"_modifier,omitempty"`
 
 	// Whether multiple parameters are allowed - e.g. more than one parameter with the same name
-	MultipleAnd *bool `json:"multipleAnd,omitempty"`
+	MultipleAnd        *bool           `json:"multipleAnd,omitempty"`
+	MultipleAndElement *common.Element `json:"_multipleAnd,omitempty"`
 
 	// Whether multiple values are allowed for each time the parameter exists
-	MultipleOr *bool `json:"multipleOr,omitempty"`
+	MultipleOr        *bool           `json:"multipleOr,omitempty"`
+	MultipleOrElement *common.Element `json:"_multipleOr,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the search parameter
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Allows filtering of search parameters that are appropriate for use versus not
-	Status SearchParameterStatus `json:"status"`
+	Status        SearchParameterStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// Types of resource (if a resource is referenced)
-	Target []string `json:"target,omitempty"`
+	Target        []string          `json:"target,omitempty"`
+	TargetElement []*common.Element `json:"_target,omitempty"`
 
 	// The type of value that a search parameter may contain, and how the content is interpreted
-	Type SearchParameterType `json:"type"`
+	Type        SearchParameterType `json:"type"`
+	TypeElement *common.Element     `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different search parameter instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// Note that the elements returned by the XPath are sometimes complex elements where logic is required to determine quite how to handle them
-	Xpath *string `json:"xpath,omitempty"`
+	Xpath        *string         `json:"xpath,omitempty"`
+	XpathElement *common.Element `json:"_xpath,omitempty"`
 
 	// How the search parameter relates to the set of elements returned by evaluating the xpath query
-	XpathUsage *SearchParameterXpathUsage `json:"xpathUsage,omitempty"`
+	XpathUsage        *SearchParameterXpathUsage `json:"xpathUsage,omitempty"`
+	XpathUsageElement *common.Element            `json:"_xpathUsage,omitempty"`
 }
 
 // SearchParameterStatus represents allows filtering of search parameters that are appropriate for use versus not
@@ -164,8 +186,10 @@ type SearchParameterComponent struct {
 	common.BackboneElement
 
 	// The definition of the search parameter that describes this part
-	Definition string `json:"definition"`
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// This expression overrides the expression in the definition and extracts the index values from the outcome of the composite expression
-	Expression string `json:"expression"`
+	Expression        string          `json:"expression"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 }
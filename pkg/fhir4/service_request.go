@@ -12,13 +12,15 @@ type ServiceRequest struct {
 	ResourceType string `json:"resourceType"` // Always "ServiceRequest"
 
 	// If a CodeableConcept is present, it indicates the pre-condition for performing the service
-	AsNeededBoolean *bool `json:"asNeededBoolean,omitempty"`
+	AsNeededBoolean        *bool           `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement *common.Element `json:"_asNeededBoolean,omitempty"`
 
 	// If a CodeableConcept is present, it indicates the pre-condition for performing the service
 	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
 
 	// When the request transitioned to being actionable
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Plan/proposal/order fulfilled by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -33,7 +35,8 @@ type ServiceRequest struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// In general, only the code and timeframe will be present, though occasional additional qualifiers such as body site or even performer could be included
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// An encounter that provides additional information about the healthcare context in which this request is made
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -42,16 +45,19 @@ type ServiceRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Note: This is a business identifier, not a resource identifier
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations that may be needed for delivering the requested service
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// This element is labeled as a modifier because the intent alters when and how the resource is actually applicable
-	Intent ServiceRequestIntent `json:"intent"`
+	Intent        ServiceRequestIntent `json:"intent"`
+	IntentElement *common.Element      `json:"_intent,omitempty"`
 
 	// The preferred location(s) where the procedure should actually happen in coded or free text form
 	LocationCode []common.CodeableConcept `json:"locationCode,omitempty"`
@@ -63,7 +69,8 @@ type ServiceRequest struct {
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// The date/time at which the requested service should occur
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// The date/time at which the requested service should occur
 	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
@@ -75,7 +82,8 @@ type ServiceRequest struct {
 	OrderDetail []common.CodeableConcept `json:"orderDetail,omitempty"`
 
 	// Instructions in terms that are understood by the patient or consumer
-	PatientInstruction *string `json:"patientInstruction,omitempty"`
+	PatientInstruction        *string         `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *common.Element `json:"_patientInstruction,omitempty"`
 
 	// If multiple performers are present, it is interpreted as a list of *alternative* performers without any preference regardless of order
 	Performer []common.Reference `json:"performer,omitempty"`
@@ -84,7 +92,8 @@ type ServiceRequest struct {
 	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
 
 	// Indicates how quickly the ServiceRequest should be addressed with respect to other requests
-	Priority *ServiceRequestPriority `json:"priority,omitempty"`
+	Priority        *ServiceRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element         `json:"_priority,omitempty"`
 
 	// An amount of service being requested which can be a quantity
 	QuantityQuantity *common.Quantity `json:"quantityQuantity,omitempty"`
@@ -117,7 +126,8 @@ type ServiceRequest struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// The status is generally fully in the control of the requester
-	Status ServiceRequestStatus `json:"status"`
+	Status        ServiceRequestStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// On whom or what the service is to be performed
 	Subject common.Reference `json:"subject"`
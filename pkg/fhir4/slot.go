@@ -15,16 +15,19 @@ type Slot struct {
 	AppointmentType *common.CodeableConcept `json:"appointmentType,omitempty"`
 
 	// Comments on the slot to describe any extended information
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Date/Time that the slot is to conclude
-	End string `json:"end"`
+	End        common.Instant  `json:"end"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// External Ids for this item
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This slot has already been overbooked, appointments are unlikely to be accepted for this time
-	Overbooked *bool `json:"overbooked,omitempty"`
+	Overbooked        *bool           `json:"overbooked,omitempty"`
+	OverbookedElement *common.Element `json:"_overbooked,omitempty"`
 
 	// The schedule resource that this slot defines an interval of status information
 	Schedule common.Reference `json:"schedule"`
@@ -39,10 +42,12 @@ type Slot struct {
 	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
 
 	// Date/Time that the slot is to begin
-	Start string `json:"start"`
+	Start        common.Instant  `json:"start"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// busy | free | busy-unavailable | busy-tentative | entered-in-error
-	Status SlotStatus `json:"status"`
+	Status        SlotStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // SlotStatus represents busy | free | busy-unavailable | busy-tentative | entered-in-error
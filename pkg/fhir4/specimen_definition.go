@@ -21,7 +21,8 @@ type SpecimenDefinition struct {
 	PatientPreparation []common.CodeableConcept `json:"patientPreparation,omitempty"`
 
 	// Time aspect of specimen collection (duration or offset)
-	TimeAspect *string `json:"timeAspect,omitempty"`
+	TimeAspect        *string         `json:"timeAspect,omitempty"`
+	TimeAspectElement *common.Element `json:"_timeAspect,omitempty"`
 
 	// The kind of material to be collected
 	TypeCollected *common.CodeableConcept `json:"typeCollected,omitempty"`
@@ -41,13 +42,15 @@ type SpecimenDefinitionTypeTested struct {
 	Handling []SpecimenDefinitionTypeTestedHandling `json:"handling,omitempty"`
 
 	// Primary of secondary specimen
-	IsDerived *bool `json:"isDerived,omitempty"`
+	IsDerived        *bool           `json:"isDerived,omitempty"`
+	IsDerivedElement *common.Element `json:"_isDerived,omitempty"`
 
 	// The preferred patient preparation for this type of specimen
 	PatientPreparation []common.CodeableConcept `json:"patientPreparation,omitempty"`
 
 	// The time aspect of specimen collection (duration or offset)
-	TimeAspect *string `json:"timeAspect,omitempty"`
+	TimeAspect        *string         `json:"timeAspect,omitempty"`
+	TimeAspectElement *common.Element `json:"_timeAspect,omitempty"`
 
 	// The kind of specimen conditioned for testing expected by lab
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -67,7 +70,8 @@ type SpecimenDefinitionTypeTestedContainer struct {
 	Capacity *common.Quantity `json:"capacity,omitempty"`
 
 	// The textual description of the kind of container
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The type of material of the container
 	Material *common.CodeableConcept `json:"material,omitempty"`
@@ -76,10 +80,12 @@ type SpecimenDefinitionTypeTestedContainer struct {
 	MinimumVolumeQuantity *common.Quantity `json:"minimumVolumeQuantity,omitempty"`
 
 	// The minimum volume to be conditioned in the container
-	MinimumVolumeString *string `json:"minimumVolumeString,omitempty"`
+	MinimumVolumeString        *string         `json:"minimumVolumeString,omitempty"`
+	MinimumVolumeStringElement *common.Element `json:"_minimumVolumeString,omitempty"`
 
 	// Special processing that should be applied to the container for this kind of specimen
-	Preparation *string `json:"preparation,omitempty"`
+	Preparation        *string         `json:"preparation,omitempty"`
+	PreparationElement *common.Element `json:"_preparation,omitempty"`
 
 	// The type of container used to contain this kind of specimen
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -101,7 +107,8 @@ type SpecimenDefinitionTypeTestedHandling struct {
 	common.BackboneElement
 
 	// Additional textual instructions for the preservation or transport of the specimen
-	Instruction *string `json:"instruction,omitempty"`
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
 
 	// The maximum time interval of preservation of the specimen with these conditions
 	MaxDuration *common.Duration `json:"maxDuration,omitempty"`
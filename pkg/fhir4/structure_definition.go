@@ -12,10 +12,12 @@ type StructureDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "StructureDefinition"
 
 	// Abstract Resources cannot be instantiated - a concrete sub-type must be used
-	Abstract bool `json:"abstract"`
+	Abstract        bool            `json:"abstract"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
 
 	// If differential constraints are specified in this structure, they are applied to the base in a "differential" fashion
-	BaseDefinition *string `json:"baseDefinition,omitempty"`
+	BaseDefinition        *string         `json:"baseDefinition,omitempty"`
+	BaseDefinitionElement *common.Element `json:"_baseDefinition,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc
 	Contact []common.ContactDetail `json:"contact,omitempty"`
@@ -24,28 +26,35 @@ type StructureDefinition struct {
 	Context []StructureDefinitionContext `json:"context,omitempty"`
 
 	// The rules are only evaluated when the extension is present
-	ContextInvariant []string `json:"contextInvariant,omitempty"`
+	ContextInvariant        []string          `json:"contextInvariant,omitempty"`
+	ContextInvariantElement []*common.Element `json:"_contextInvariant,omitempty"`
 
 	// A copyright statement relating to the structure definition and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// If the definition is a specialization, then it adds new elements in the differential
-	Derivation *StructureDefinitionDerivation `json:"derivation,omitempty"`
+	Derivation        *StructureDefinitionDerivation `json:"derivation,omitempty"`
+	DerivationElement *common.Element                `json:"_derivation,omitempty"`
 
 	// This description can be used to capture details such as why the structure definition was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A differential view is expressed relative to the base StructureDefinition
 	Differential *StructureDefinitionDifferential `json:"differential,omitempty"`
 
 	// Allows filtering of structure definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// A StructureDefinition does not need to specify the target it applies to
-	FhirVersion *string `json:"fhirVersion,omitempty"`
+	FhirVersion        *string         `json:"fhirVersion,omitempty"`
+	FhirVersionElement *common.Element `json:"_fhirVersion,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -57,40 +66,49 @@ type StructureDefinition struct {
 	Keyword []common.Coding `json:"keyword,omitempty"`
 
 	// Defines the kind of structure that this definition is describing
-	Kind StructureDefinitionKind `json:"kind"`
+	Kind        StructureDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
 
 	// An external specification that the content is mapped to
 	Mapping []StructureDefinitionMapping `json:"mapping,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the structure definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// A snapshot view is expressed in a standalone form that can be used and interpreted without considering the base StructureDefinition
 	Snapshot *StructureDefinitionSnapshot `json:"snapshot,omitempty"`
 
 	// Allows filtering of structure definitions that are appropriate for use versus not
-	Status StructureDefinitionStatus `json:"status"`
+	Status        StructureDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The type must match the elements defined in the differential and the snapshot
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different structure definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // StructureDefinitionStatus represents allows filtering of structure definitions that are appropriate for use versus not
@@ -126,16 +144,20 @@ type StructureDefinitionMapping struct {
 	common.BackboneElement
 
 	// Comments about this mapping, including version notes, issues, scope limitations, and other important notes for usage
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// The specification is described once, with general comments, and then specific mappings are made that reference this declaration
-	Identity string `json:"identity"`
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
 
 	// A name for the specification that is being mapped to
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A formal identity for the specification being mapped to helps with identifying maps consistently
-	Uri *string `json:"uri,omitempty"`
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 }
 
 // StructureDefinitionContext represents identifies the types of resource or data type elements to which the extension can be applied
@@ -143,10 +165,12 @@ type StructureDefinitionContext struct {
 	common.BackboneElement
 
 	// An expression that defines where an extension can be used in resources
-	Expression string `json:"expression"`
+	Expression        string          `json:"expression"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// Defines how to interpret the expression that defines what the context of the extension is
-	Type StructureDefinitionContextType `json:"type"`
+	Type        StructureDefinitionContextType `json:"type"`
+	TypeElement *common.Element                `json:"_type,omitempty"`
 }
 
 // StructureDefinitionContextType represents defines how to interpret the expression that defines what the context of the extension is
@@ -163,7 +187,7 @@ type StructureDefinitionSnapshot struct {
 	common.BackboneElement
 
 	// Captures constraints on each element within the resource
-	Element []common.ElementDefinition `json:"element"`
+	Element []ElementDefinition `json:"element"`
 }
 
 // StructureDefinitionDifferential represents a differential view is expressed relative to the base StructureDefinition
@@ -171,5 +195,5 @@ type StructureDefinitionDifferential struct {
 	common.BackboneElement
 
 	// Captures constraints on each element within the resource
-	Element []common.ElementDefinition `json:"element"`
+	Element []ElementDefinition `json:"element"`
 }
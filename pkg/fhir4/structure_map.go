@@ -15,16 +15,20 @@ type StructureMap struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the structure map and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the structure map was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of structure maps that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Organizes the mapping into manageable chunks for human review/ease of maintenance
 	Group []StructureMapGroup `json:"group"`
@@ -33,37 +37,45 @@ type StructureMap struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Other maps used by this map (canonical URLs)
-	Import []string `json:"import,omitempty"`
+	Import        []string          `json:"import,omitempty"`
+	ImportElement []*common.Element `json:"_import,omitempty"`
 
 	// It may be possible for the structure map to be used in jurisdictions other than those for which it was originally designed
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the structure map
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Allows filtering of structure maps that are appropriate for use versus not
-	Status StructureMapStatus `json:"status"`
+	Status        StructureMapStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// It is not necessary for a structure map to identify any dependent structures
 	Structure []StructureMapStructure `json:"structure,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different structure map instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // StructureMapStatus represents allows filtering of structure maps that are appropriate for use versus not
@@ -81,16 +93,20 @@ type StructureMapStructure struct {
 	common.BackboneElement
 
 	// This is needed if both types have the same name (e.g. version conversion)
-	Alias *string `json:"alias,omitempty"`
+	Alias        *string         `json:"alias,omitempty"`
+	AliasElement *common.Element `json:"_alias,omitempty"`
 
 	// Documentation that describes how the structure is used in the mapping
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// How the referenced structure is used in this mapping
-	Mode StructureMapStructureMode `json:"mode"`
+	Mode        StructureMapStructureMode `json:"mode"`
+	ModeElement *common.Element           `json:"_mode,omitempty"`
 
 	// The canonical reference to the structure
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // StructureMapStructureMode represents how the referenced structure is used in this mapping
@@ -111,16 +127,20 @@ type StructureMapGroup struct {
 	Input []StructureMapGroupInput `json:"input"`
 
 	// A unique name for the group for the convenience of human readers
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Another group that this group adds rules to
-	Extends *string `json:"extends,omitempty"`
+	Extends        *string         `json:"extends,omitempty"`
+	ExtendsElement *common.Element `json:"_extends,omitempty"`
 
 	// If this is the default rule set to apply for the source type or this combination of types
-	TypeMode *StructureMapGroupTypeMode `json:"typeMode,omitempty"`
+	TypeMode        *StructureMapGroupTypeMode `json:"typeMode,omitempty"`
+	TypeModeElement *common.Element            `json:"_typeMode,omitempty"`
 
 	// Additional supporting documentation that explains the purpose of the group and the types of mappings within it
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// Transform Rule from source to target
 	Rule []StructureMapGroupRule `json:"rule"`
@@ -140,16 +160,20 @@ type StructureMapGroupInput struct {
 	common.BackboneElement
 
 	// Documentation for this instance of data
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// Mode for this instance of data
-	Mode StructureMapGroupInputMode `json:"mode"`
+	Mode        StructureMapGroupInputMode `json:"mode"`
+	ModeElement *common.Element            `json:"_mode,omitempty"`
 
 	// Name for this instance of data
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Type for this instance of data
-	Type *string `json:"type,omitempty"`
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // StructureMapGroupInputMode represents mode for this instance of data
@@ -165,7 +189,8 @@ type StructureMapGroupRule struct {
 	common.BackboneElement
 
 	// Name of the rule for internal references
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Source inputs to the mapping
 	Source []StructureMapGroupRuleSource `json:"source"`
@@ -180,7 +205,8 @@ type StructureMapGroupRule struct {
 	Dependent []StructureMapGroupRuleDependent `json:"dependent,omitempty"`
 
 	// Documentation for this instance of data
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // StructureMapGroupRuleSource represents source inputs to the mapping
@@ -188,74 +214,102 @@ type StructureMapGroupRuleSource struct {
 	common.BackboneElement
 
 	// FHIRPath expression - must be true or the mapping engine throws an error instead of completing
-	Check *string `json:"check,omitempty"`
+	Check        *string         `json:"check,omitempty"`
+	CheckElement *common.Element `json:"_check,omitempty"`
 
 	// FHIRPath expression - must be true or the rule does not apply
-	Condition *string `json:"condition,omitempty"`
+	Condition        *string         `json:"condition,omitempty"`
+	ConditionElement *common.Element `json:"_condition,omitempty"`
 
 	// Type or variable this rule applies to
-	Context string `json:"context"`
+	Context        string          `json:"context"`
+	ContextElement *common.Element `json:"_context,omitempty"`
 
 	// If there's a default value on an item that can repeat, it will only be used once
-	DefaultValueBase64Binary    *string                 `json:"defaultValueBase64Binary,omitempty"`
-	DefaultValueBoolean         *bool                   `json:"defaultValueBoolean,omitempty"`
-	DefaultValueCanonical       *string                 `json:"defaultValueCanonical,omitempty"`
-	DefaultValueCode            *string                 `json:"defaultValueCode,omitempty"`
-	DefaultValueDate            *string                 `json:"defaultValueDate,omitempty"`
-	DefaultValueDateTime        *string                 `json:"defaultValueDateTime,omitempty"`
-	DefaultValueDecimal         *float64                `json:"defaultValueDecimal,omitempty"`
-	DefaultValueId              *string                 `json:"defaultValueId,omitempty"`
-	DefaultValueInstant         *string                 `json:"defaultValueInstant,omitempty"`
-	DefaultValueInteger         *int                    `json:"defaultValueInteger,omitempty"`
-	DefaultValueMarkdown        *string                 `json:"defaultValueMarkdown,omitempty"`
-	DefaultValueOid             *string                 `json:"defaultValueOid,omitempty"`
-	DefaultValuePositiveInt     *int                    `json:"defaultValuePositiveInt,omitempty"`
-	DefaultValueString          *string                 `json:"defaultValueString,omitempty"`
-	DefaultValueTime            *string                 `json:"defaultValueTime,omitempty"`
-	DefaultValueUnsignedInt     *int                    `json:"defaultValueUnsignedInt,omitempty"`
-	DefaultValueUri             *string                 `json:"defaultValueUri,omitempty"`
-	DefaultValueUrl             *string                 `json:"defaultValueUrl,omitempty"`
-	DefaultValueUuid            *string                 `json:"defaultValueUuid,omitempty"`
-	DefaultValueAddress         *common.Address         `json:"defaultValueAddress,omitempty"`
-	DefaultValueAge             *common.Age             `json:"defaultValueAge,omitempty"`
-	DefaultValueAnnotation      *common.Annotation      `json:"defaultValueAnnotation,omitempty"`
-	DefaultValueAttachment      *common.Attachment      `json:"defaultValueAttachment,omitempty"`
-	DefaultValueCodeableConcept *common.CodeableConcept `json:"defaultValueCodeableConcept,omitempty"`
-	DefaultValueCoding          *common.Coding          `json:"defaultValueCoding,omitempty"`
-	DefaultValueContactPoint    *common.ContactPoint    `json:"defaultValueContactPoint,omitempty"`
-	DefaultValueCount           *common.Count           `json:"defaultValueCount,omitempty"`
-	DefaultValueDistance        *common.Distance        `json:"defaultValueDistance,omitempty"`
-	DefaultValueDuration        *common.Duration        `json:"defaultValueDuration,omitempty"`
-	DefaultValueHumanName       *common.HumanName       `json:"defaultValueHumanName,omitempty"`
-	DefaultValueIdentifier      *common.Identifier      `json:"defaultValueIdentifier,omitempty"`
-	DefaultValueMoney           *common.Money           `json:"defaultValueMoney,omitempty"`
-	DefaultValuePeriod          *common.Period          `json:"defaultValuePeriod,omitempty"`
-	DefaultValueQuantity        *common.Quantity        `json:"defaultValueQuantity,omitempty"`
-	DefaultValueRange           *common.Range           `json:"defaultValueRange,omitempty"`
-	DefaultValueRatio           *common.Ratio           `json:"defaultValueRatio,omitempty"`
-	DefaultValueReference       *common.Reference       `json:"defaultValueReference,omitempty"`
-	DefaultValueSampledData     *common.SampledData     `json:"defaultValueSampledData,omitempty"`
-	DefaultValueSignature       *common.Signature       `json:"defaultValueSignature,omitempty"`
-	DefaultValueTiming          *common.Timing          `json:"defaultValueTiming,omitempty"`
-	DefaultValueMeta            *common.Meta            `json:"defaultValueMeta,omitempty"`
+	DefaultValueBase64Binary        *string                 `json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *common.Element         `json:"_defaultValueBase64Binary,omitempty"`
+	DefaultValueBoolean             *bool                   `json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *common.Element         `json:"_defaultValueBoolean,omitempty"`
+	DefaultValueCanonical           *string                 `json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement    *common.Element         `json:"_defaultValueCanonical,omitempty"`
+	DefaultValueCode                *string                 `json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *common.Element         `json:"_defaultValueCode,omitempty"`
+	DefaultValueDate                *common.Date            `json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *common.Element         `json:"_defaultValueDate,omitempty"`
+	DefaultValueDateTime            *common.DateTime        `json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *common.Element         `json:"_defaultValueDateTime,omitempty"`
+	DefaultValueDecimal             *common.Decimal         `json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *common.Element         `json:"_defaultValueDecimal,omitempty"`
+	DefaultValueId                  *string                 `json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *common.Element         `json:"_defaultValueId,omitempty"`
+	DefaultValueInstant             *common.Instant         `json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *common.Element         `json:"_defaultValueInstant,omitempty"`
+	DefaultValueInteger             *int                    `json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *common.Element         `json:"_defaultValueInteger,omitempty"`
+	DefaultValueMarkdown            *string                 `json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *common.Element         `json:"_defaultValueMarkdown,omitempty"`
+	DefaultValueOid                 *string                 `json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *common.Element         `json:"_defaultValueOid,omitempty"`
+	DefaultValuePositiveInt         *int                    `json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *common.Element         `json:"_defaultValuePositiveInt,omitempty"`
+	DefaultValueString              *string                 `json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *common.Element         `json:"_defaultValueString,omitempty"`
+	DefaultValueTime                *common.Time            `json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *common.Element         `json:"_defaultValueTime,omitempty"`
+	DefaultValueUnsignedInt         *int                    `json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *common.Element         `json:"_defaultValueUnsignedInt,omitempty"`
+	DefaultValueUri                 *string                 `json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *common.Element         `json:"_defaultValueUri,omitempty"`
+	DefaultValueUrl                 *string                 `json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement          *common.Element         `json:"_defaultValueUrl,omitempty"`
+	DefaultValueUuid                *string                 `json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement         *common.Element         `json:"_defaultValueUuid,omitempty"`
+	DefaultValueAddress             *common.Address         `json:"defaultValueAddress,omitempty"`
+	DefaultValueAge                 *common.Age             `json:"defaultValueAge,omitempty"`
+	DefaultValueAnnotation          *common.Annotation      `json:"defaultValueAnnotation,omitempty"`
+	DefaultValueAttachment          *common.Attachment      `json:"defaultValueAttachment,omitempty"`
+	DefaultValueCodeableConcept     *common.CodeableConcept `json:"defaultValueCodeableConcept,omitempty"`
+	DefaultValueCoding              *common.Coding          `json:"defaultValueCoding,omitempty"`
+	DefaultValueContactPoint        *common.ContactPoint    `json:"defaultValueContactPoint,omitempty"`
+	DefaultValueCount               *common.Count           `json:"defaultValueCount,omitempty"`
+	DefaultValueDistance            *common.Distance        `json:"defaultValueDistance,omitempty"`
+	DefaultValueDuration            *common.Duration        `json:"defaultValueDuration,omitempty"`
+	DefaultValueHumanName           *common.HumanName       `json:"defaultValueHumanName,omitempty"`
+	DefaultValueIdentifier          *common.Identifier      `json:"defaultValueIdentifier,omitempty"`
+	DefaultValueMoney               *common.Money           `json:"defaultValueMoney,omitempty"`
+	DefaultValuePeriod              *common.Period          `json:"defaultValuePeriod,omitempty"`
+	DefaultValueQuantity            *common.Quantity        `json:"defaultValueQuantity,omitempty"`
+	DefaultValueRange               *common.Range           `json:"defaultValueRange,omitempty"`
+	DefaultValueRatio               *common.Ratio           `json:"defaultValueRatio,omitempty"`
+	DefaultValueReference           *common.Reference       `json:"defaultValueReference,omitempty"`
+	DefaultValueSampledData         *common.SampledData     `json:"defaultValueSampledData,omitempty"`
+	DefaultValueSignature           *common.Signature       `json:"defaultValueSignature,omitempty"`
+	DefaultValueTiming              *common.Timing          `json:"defaultValueTiming,omitempty"`
+	DefaultValueMeta                *common.Meta            `json:"defaultValueMeta,omitempty"`
 
 	// Specified type for the element
-	Element *string `json:"element,omitempty"`
+	Element        *string         `json:"element,omitempty"`
+	ElementElement *common.Element `json:"_element,omitempty"`
 
 	// How to handle the list mode for this element
-	ListMode *StructureMapGroupRuleSourceListMode `json:"listMode,omitempty"`
+	ListMode        *StructureMapGroupRuleSourceListMode `json:"listMode,omitempty"`
+	ListModeElement *common.Element                      `json:"_listMode,omitempty"`
 
 	// Named context for field, if a field is specified
-	Variable *string `json:"variable,omitempty"`
+	Variable        *string         `json:"variable,omitempty"`
+	VariableElement *common.Element `json:"_variable,omitempty"`
 
 	// FHIRPath expression to specify the first element
-	First *string `json:"first,omitempty"`
+	First        *string         `json:"first,omitempty"`
+	FirstElement *common.Element `json:"_first,omitempty"`
 
 	// FHIRPath expression to specify the last element
-	Last *string `json:"last,omitempty"`
+	Last        *string         `json:"last,omitempty"`
+	LastElement *common.Element `json:"_last,omitempty"`
 
 	// FHIRPath expression to specify the last element
-	LogMessage *string `json:"logMessage,omitempty"`
+	LogMessage        *string         `json:"logMessage,omitempty"`
+	LogMessageElement *common.Element `json:"_logMessage,omitempty"`
 }
 
 // StructureMapGroupRuleSourceListMode represents how to handle the list mode for this element
@@ -274,25 +328,32 @@ type StructureMapGroupRuleTarget struct {
 	common.BackboneElement
 
 	// Type or variable this rule applies to
-	Context *string `json:"context,omitempty"`
+	Context        *string         `json:"context,omitempty"`
+	ContextElement *common.Element `json:"_context,omitempty"`
 
 	// How to interpret the context
-	ContextType *StructureMapGroupRuleTargetContextType `json:"contextType,omitempty"`
+	ContextType        *StructureMapGroupRuleTargetContextType `json:"contextType,omitempty"`
+	ContextTypeElement *common.Element                         `json:"_contextType,omitempty"`
 
 	// Field to create in the context
-	Element *string `json:"element,omitempty"`
+	Element        *string         `json:"element,omitempty"`
+	ElementElement *common.Element `json:"_element,omitempty"`
 
 	// Named context for field, if desired, and a field is specified
-	Variable *string `json:"variable,omitempty"`
+	Variable        *string         `json:"variable,omitempty"`
+	VariableElement *common.Element `json:"_variable,omitempty"`
 
 	// If field is a list, how to manage the list
-	ListMode []StructureMapGroupRuleTargetListMode `json:"listMode,omitempty"`
+	ListMode        []StructureMapGroupRuleTargetListMode `json:"listMode,omitempty"`
+	ListModeElement []*common.Element                     `json:"_listMode,omitempty"`
 
 	// Internal rule reference for shared list items
-	ListRuleId *string `json:"listRuleId,omitempty"`
+	ListRuleId        *string         `json:"listRuleId,omitempty"`
+	ListRuleIdElement *common.Element `json:"_listRuleId,omitempty"`
 
 	// Parameters to the transform
-	Transform *StructureMapGroupRuleTargetTransform `json:"transform,omitempty"`
+	Transform        *StructureMapGroupRuleTargetTransform `json:"transform,omitempty"`
+	TransformElement *common.Element                       `json:"_transform,omitempty"`
 
 	// Parameters to the transform
 	Parameter []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty"`
@@ -342,11 +403,16 @@ type StructureMapGroupRuleTargetParameter struct {
 	common.BackboneElement
 
 	// Parameter value - variable or literal
-	ValueId      *string  `json:"valueId,omitempty"`
-	ValueString  *string  `json:"valueString,omitempty"`
-	ValueBoolean *bool    `json:"valueBoolean,omitempty"`
-	ValueInteger *int     `json:"valueInteger,omitempty"`
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueId             *string         `json:"valueId,omitempty"`
+	ValueIdElement      *common.Element `json:"_valueId,omitempty"`
+	ValueString         *string         `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element `json:"_valueString,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 }
 
 // StructureMapGroupRuleDependent represents which other rules to apply in the context of this rule
@@ -354,8 +420,10 @@ type StructureMapGroupRuleDependent struct {
 	common.BackboneElement
 
 	// Name of a rule or group to apply
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Variable to pass to the rule or group
-	Variable []string `json:"variable"`
+	Variable        []string          `json:"variable"`
+	VariableElement []*common.Element `json:"_variable,omitempty"`
 }
@@ -18,19 +18,24 @@ type Subscription struct {
 	Contact []common.ContactPoint `json:"contact,omitempty"`
 
 	// The rules are search criteria (without the [base] part)
-	Criteria string `json:"criteria"`
+	Criteria        string          `json:"criteria"`
+	CriteriaElement *common.Element `json:"_criteria,omitempty"`
 
 	// The server is permitted to deviate from this time but should observe it
-	End *string `json:"end,omitempty"`
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// A record of the last error that occurred when the server processed a notification
-	Error *string `json:"error,omitempty"`
+	Error        *string         `json:"error,omitempty"`
+	ErrorElement *common.Element `json:"_error,omitempty"`
 
 	// A description of why this subscription is defined
-	Reason string `json:"reason"`
+	Reason        string          `json:"reason"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
 
 	// A client can only submit subscription resources in the requested or off state
-	Status SubscriptionStatus `json:"status"`
+	Status        SubscriptionStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 }
 
 // SubscriptionStatus represents a client can only submit subscription resources in the requested or off state
@@ -48,16 +53,20 @@ type SubscriptionChannel struct {
 	common.BackboneElement
 
 	// For rest-hook, and websocket, the end-point must be an http: or https: URL
-	Endpoint *string `json:"endpoint,omitempty"`
+	Endpoint        *string         `json:"endpoint,omitempty"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
 
 	// Exactly what these mean depend on the channel type
-	Header []string `json:"header,omitempty"`
+	Header        []string          `json:"header,omitempty"`
+	HeaderElement []*common.Element `json:"_header,omitempty"`
 
 	// Sending the payload has obvious security implications
-	Payload *string `json:"payload,omitempty"`
+	Payload        *string         `json:"payload,omitempty"`
+	PayloadElement *common.Element `json:"_payload,omitempty"`
 
 	// The type of channel to send notifications on
-	Type SubscriptionChannelType `json:"type"`
+	Type        SubscriptionChannelType `json:"type"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
 }
 
 // SubscriptionChannelType represents the type of channel to send notifications on
@@ -18,7 +18,8 @@ type Substance struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// A description of the substance - its appearance, handling requirements, and other usage notes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This identifier is associated with the kind of substance in contrast to the Substance.instance.identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -30,7 +31,8 @@ type Substance struct {
 	Instance []SubstanceInstance `json:"instance,omitempty"`
 
 	// A code to indicate if the substance is actively used
-	Status *SubstanceStatus `json:"status,omitempty"`
+	Status        *SubstanceStatus `json:"status,omitempty"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 }
 
 // SubstanceStatus represents a code to indicate if the substance is actively used
@@ -47,7 +49,8 @@ type SubstanceInstance struct {
 	common.BackboneElement
 
 	// When the substance is no longer valid to use
-	Expiry *string `json:"expiry,omitempty"`
+	Expiry        *string         `json:"expiry,omitempty"`
+	ExpiryElement *common.Element `json:"_expiry,omitempty"`
 
 	// Identifier associated with the package/container (usually a label affixed directly)
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -12,10 +12,12 @@ type SubstanceNucleicAcid struct {
 	ResourceType string `json:"resourceType"` // Always "SubstanceNucleicAcid"
 
 	// The area of hybridisation shall be described if applicable for double stranded RNA or DNA
-	AreaOfHybridisation *string `json:"areaOfHybridisation,omitempty"`
+	AreaOfHybridisation        *string         `json:"areaOfHybridisation,omitempty"`
+	AreaOfHybridisationElement *common.Element `json:"_areaOfHybridisation,omitempty"`
 
 	// The number of linear sequences of nucleotides linked through phosphodiester bonds shall be described
-	NumberOfSubunits *int `json:"numberOfSubunits,omitempty"`
+	NumberOfSubunits        *int            `json:"numberOfSubunits,omitempty"`
+	NumberOfSubunitsElement *common.Element `json:"_numberOfSubunits,omitempty"`
 
 	// (TBC)
 	OligoNucleotideType *common.CodeableConcept `json:"oligoNucleotideType,omitempty"`
@@ -35,19 +37,22 @@ type SubstanceNucleicAcidSubunit struct {
 	FivePrime *common.CodeableConcept `json:"fivePrime,omitempty"`
 
 	// The length of the sequence shall be captured
-	Length *int `json:"length,omitempty"`
+	Length        *int            `json:"length,omitempty"`
+	LengthElement *common.Element `json:"_length,omitempty"`
 
 	// The linkages between sugar residues will also be captured
 	Linkage []SubstanceNucleicAcidSubunitLinkage `json:"linkage,omitempty"`
 
 	// Actual nucleotide sequence notation from 5' to 3' end using standard single letter codes
-	Sequence *string `json:"sequence,omitempty"`
+	Sequence        *string         `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// (TBC)
 	SequenceAttachment *common.Attachment `json:"sequenceAttachment,omitempty"`
 
 	// Index of linear sequences of nucleic acids in order of decreasing length
-	Subunit *int `json:"subunit,omitempty"`
+	Subunit        *int            `json:"subunit,omitempty"`
+	SubunitElement *common.Element `json:"_subunit,omitempty"`
 
 	// 5.3.6.8.1 Sugar ID (Mandatory)
 	Sugar []SubstanceNucleicAcidSubunitSugar `json:"sugar,omitempty"`
@@ -61,16 +66,19 @@ type SubstanceNucleicAcidSubunitLinkage struct {
 	common.BackboneElement
 
 	// The entity that links the sugar residues together should also be captured
-	Connectivity *string `json:"connectivity,omitempty"`
+	Connectivity        *string         `json:"connectivity,omitempty"`
+	ConnectivityElement *common.Element `json:"_connectivity,omitempty"`
 
 	// Each linkage will be registered as a fragment and have an ID
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Each linkage will be registered as a fragment and have at least one name
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Residues shall be captured as described in 5.3.6.8.3
-	ResidueSite *string `json:"residueSite,omitempty"`
+	ResidueSite        *string         `json:"residueSite,omitempty"`
+	ResidueSiteElement *common.Element `json:"_residueSite,omitempty"`
 }
 
 // SubstanceNucleicAcidSubunitSugar represents 5.3.6.8.1 Sugar ID (Mandatory)
@@ -81,8 +89,10 @@ type SubstanceNucleicAcidSubunitSugar struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// The name of the sugar or sugar-like component that make up the nucleotide
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The residues that contain a given sugar will be captured
-	ResidueSite *string `json:"residueSite,omitempty"`
+	ResidueSite        *string         `json:"residueSite,omitempty"`
+	ResidueSiteElement *common.Element `json:"_residueSite,omitempty"`
 }
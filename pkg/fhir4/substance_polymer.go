@@ -21,7 +21,8 @@ type SubstancePolymer struct {
 	Geometry *common.CodeableConcept `json:"geometry,omitempty"`
 
 	// Todo
-	Modification []string `json:"modification,omitempty"`
+	Modification        []string          `json:"modification,omitempty"`
+	ModificationElement []*common.Element `json:"_modification,omitempty"`
 
 	// Todo
 	MonomerSet []SubstancePolymerMonomerSet `json:"monomerSet,omitempty"`
@@ -49,7 +50,8 @@ type SubstancePolymerMonomerSetStartingMaterial struct {
 	Amount *SubstanceAmount `json:"amount,omitempty"`
 
 	// Todo
-	IsDefining *bool `json:"isDefining,omitempty"`
+	IsDefining        *bool           `json:"isDefining,omitempty"`
+	IsDefiningElement *common.Element `json:"_isDefining,omitempty"`
 
 	// Todo
 	Material *common.CodeableConcept `json:"material,omitempty"`
@@ -63,10 +65,12 @@ type SubstancePolymerRepeat struct {
 	common.BackboneElement
 
 	// Todo
-	AverageMolecularFormula *string `json:"averageMolecularFormula,omitempty"`
+	AverageMolecularFormula        *string         `json:"averageMolecularFormula,omitempty"`
+	AverageMolecularFormulaElement *common.Element `json:"_averageMolecularFormula,omitempty"`
 
 	// Todo
-	NumberOfUnits *int `json:"numberOfUnits,omitempty"`
+	NumberOfUnits        *int            `json:"numberOfUnits,omitempty"`
+	NumberOfUnitsElement *common.Element `json:"_numberOfUnits,omitempty"`
 
 	// Todo
 	RepeatUnit []SubstancePolymerRepeatRepeatUnit `json:"repeatUnit,omitempty"`
@@ -89,7 +93,8 @@ type SubstancePolymerRepeatRepeatUnit struct {
 	OrientationOfPolymerisation *common.CodeableConcept `json:"orientationOfPolymerisation,omitempty"`
 
 	// Todo
-	RepeatUnit *string `json:"repeatUnit,omitempty"`
+	RepeatUnit        *string         `json:"repeatUnit,omitempty"`
+	RepeatUnitElement *common.Element `json:"_repeatUnit,omitempty"`
 
 	// Todo
 	StructuralRepresentation []SubstancePolymerRepeatRepeatUnitStructuralRepresentation `json:"structuralRepresentation,omitempty"`
@@ -114,7 +119,8 @@ type SubstancePolymerRepeatRepeatUnitStructuralRepresentation struct {
 	Attachment *common.Attachment `json:"attachment,omitempty"`
 
 	// Todo
-	Representation *string `json:"representation,omitempty"`
+	Representation        *string         `json:"representation,omitempty"`
+	RepresentationElement *common.Element `json:"_representation,omitempty"`
 
 	// Todo
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -128,11 +134,13 @@ type SubstanceAmount struct {
 	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
 
 	// Todo
-	AmountString *string `json:"amountString,omitempty"`
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
 
 	// Todo
 	AmountType *common.CodeableConcept `json:"amountType,omitempty"`
 
 	// Todo
-	AmountText *string `json:"amountText,omitempty"`
+	AmountText        *string         `json:"amountText,omitempty"`
+	AmountTextElement *common.Element `json:"_amountText,omitempty"`
 }
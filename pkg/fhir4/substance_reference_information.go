@@ -15,7 +15,8 @@ type SubstanceReferenceInformation struct {
 	Classification []SubstanceReferenceInformationClassification `json:"classification,omitempty"`
 
 	// Todo
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Todo
 	Gene []SubstanceReferenceInformationGene `json:"gene,omitempty"`
@@ -83,7 +84,8 @@ type SubstanceReferenceInformationTarget struct {
 	AmountRange *common.Range `json:"amountRange,omitempty"`
 
 	// Todo
-	AmountString *string `json:"amountString,omitempty"`
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
 
 	// Todo
 	AmountType *common.CodeableConcept `json:"amountType,omitempty"`
@@ -21,7 +21,8 @@ type SubstanceSourceMaterial struct {
 	FractionDescription []SubstanceSourceMaterialFractionDescription `json:"fractionDescription,omitempty"`
 
 	// The place/region where the plant is harvested or the places/regions where the animal source material has its habitat
-	GeographicalLocation []string `json:"geographicalLocation,omitempty"`
+	GeographicalLocation        []string          `json:"geographicalLocation,omitempty"`
+	GeographicalLocationElement []*common.Element `json:"_geographicalLocation,omitempty"`
 
 	// This subclause describes the organism which the substance is derived from
 	Organism *SubstanceSourceMaterialOrganism `json:"organism,omitempty"`
@@ -30,13 +31,15 @@ type SubstanceSourceMaterial struct {
 	OrganismId *common.Identifier `json:"organismId,omitempty"`
 
 	// The organism accepted Scientific name shall be provided based on the organism taxonomy
-	OrganismName *string `json:"organismName,omitempty"`
+	OrganismName        *string         `json:"organismName,omitempty"`
+	OrganismNameElement *common.Element `json:"_organismName,omitempty"`
 
 	// The parent of the herbal drug Ginkgo biloba, Leaf is the substance ID of the substance
 	ParentSubstanceId []common.Identifier `json:"parentSubstanceId,omitempty"`
 
 	// The parent substance of the Herbal Drug, or Herbal preparation
-	ParentSubstanceName []string `json:"parentSubstanceName,omitempty"`
+	ParentSubstanceName        []string          `json:"parentSubstanceName,omitempty"`
+	ParentSubstanceNameElement []*common.Element `json:"_parentSubstanceName,omitempty"`
 
 	// To do
 	PartDescription []SubstanceSourceMaterialPartDescription `json:"partDescription,omitempty"`
@@ -56,7 +59,8 @@ type SubstanceSourceMaterialFractionDescription struct {
 	common.BackboneElement
 
 	// This element is capturing information about the fraction of a plant part, or human plasma for fractionation
-	Fraction *string `json:"fraction,omitempty"`
+	Fraction        *string         `json:"fraction,omitempty"`
+	FractionElement *common.Element `json:"_fraction,omitempty"`
 
 	// The specific type of the material constituting the component
 	MaterialType *common.CodeableConcept `json:"materialType,omitempty"`
@@ -79,7 +83,8 @@ type SubstanceSourceMaterialOrganism struct {
 	Hybrid *SubstanceSourceMaterialOrganismHybrid `json:"hybrid,omitempty"`
 
 	// The intraspecific description of an organism shall be specified based on a controlled vocabulary
-	IntraspecificDescription *string `json:"intraspecificDescription,omitempty"`
+	IntraspecificDescription        *string         `json:"intraspecificDescription,omitempty"`
+	IntraspecificDescriptionElement *common.Element `json:"_intraspecificDescription,omitempty"`
 
 	// The Intraspecific type of an organism shall be specified
 	IntraspecificType *common.CodeableConcept `json:"intraspecificType,omitempty"`
@@ -96,7 +101,8 @@ type SubstanceSourceMaterialOrganismAuthor struct {
 	common.BackboneElement
 
 	// The author of an organism species shall be specified
-	AuthorDescription *string `json:"authorDescription,omitempty"`
+	AuthorDescription        *string         `json:"authorDescription,omitempty"`
+	AuthorDescriptionElement *common.Element `json:"_authorDescription,omitempty"`
 
 	// The type of author of an organism species shall be specified
 	AuthorType *common.CodeableConcept `json:"authorType,omitempty"`
@@ -110,16 +116,20 @@ type SubstanceSourceMaterialOrganismHybrid struct {
 	HybridType *common.CodeableConcept `json:"hybridType,omitempty"`
 
 	// The identifier of the maternal species constituting the hybrid organism shall be specified
-	MaternalOrganismId *string `json:"maternalOrganismId,omitempty"`
+	MaternalOrganismId        *string         `json:"maternalOrganismId,omitempty"`
+	MaternalOrganismIdElement *common.Element `json:"_maternalOrganismId,omitempty"`
 
 	// The name of the maternal species constituting the hybrid organism shall be specified
-	MaternalOrganismName *string `json:"maternalOrganismName,omitempty"`
+	MaternalOrganismName        *string         `json:"maternalOrganismName,omitempty"`
+	MaternalOrganismNameElement *common.Element `json:"_maternalOrganismName,omitempty"`
 
 	// The identifier of the paternal species constituting the hybrid organism shall be specified
-	PaternalOrganismId *string `json:"paternalOrganismId,omitempty"`
+	PaternalOrganismId        *string         `json:"paternalOrganismId,omitempty"`
+	PaternalOrganismIdElement *common.Element `json:"_paternalOrganismId,omitempty"`
 
 	// The name of the paternal species constituting the hybrid organism shall be specified
-	PaternalOrganismName *string `json:"paternalOrganismName,omitempty"`
+	PaternalOrganismName        *string         `json:"paternalOrganismName,omitempty"`
+	PaternalOrganismNameElement *common.Element `json:"_paternalOrganismName,omitempty"`
 }
 
 // SubstanceSourceMaterialOrganismOrganismGeneral represents 4.9.13.7.1 Kingdom (Conditional)
@@ -15,10 +15,12 @@ type SubstanceSpecification struct {
 	Code []SubstanceSpecificationCode `json:"code,omitempty"`
 
 	// Textual comment about this record of a substance
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Textual description of the substance
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// If the substance applies to only human or veterinary use
 	Domain *common.CodeableConcept `json:"domain,omitempty"`
@@ -77,16 +79,19 @@ type SubstanceSpecificationMoiety struct {
 	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
 
 	// Quantitative value for this moiety
-	AmountString *string `json:"amountString,omitempty"`
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
 
 	// Identifier by which this moiety substance is known
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Molecular formula
-	MolecularFormula *string `json:"molecularFormula,omitempty"`
+	MolecularFormula        *string         `json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *common.Element `json:"_molecularFormula,omitempty"`
 
 	// Textual name for this moiety substance
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Optical activity type
 	OpticalActivity *common.CodeableConcept `json:"opticalActivity,omitempty"`
@@ -106,7 +111,8 @@ type SubstanceSpecificationProperty struct {
 	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
 
 	// Quantitative value for this property
-	AmountString *string `json:"amountString,omitempty"`
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
 
 	// A category for this property, e.g. Physical, Chemical, Enzymatic
 	Category *common.CodeableConcept `json:"category,omitempty"`
@@ -121,7 +127,8 @@ type SubstanceSpecificationProperty struct {
 	DefiningSubstanceCodeableConcept *common.CodeableConcept `json:"definingSubstanceCodeableConcept,omitempty"`
 
 	// Parameters that were used in the measurement of a property (e.g. for viscosity: measured at 20C with a pH of 7.1)
-	Parameters *string `json:"parameters,omitempty"`
+	Parameters        *string         `json:"parameters,omitempty"`
+	ParametersElement *common.Element `json:"_parameters,omitempty"`
 }
 
 // SubstanceSpecificationStructureIsotopeMolecularWeight represents the molecular weight or weight range (for proteins, polymers or nucleic acids)
@@ -166,7 +173,8 @@ type SubstanceSpecificationStructureRepresentation struct {
 	Attachment *common.Attachment `json:"attachment,omitempty"`
 
 	// The structural representation as text string in a format e.g. InChI, SMILES, MOLFILE, CDX
-	Representation *string `json:"representation,omitempty"`
+	Representation        *string         `json:"representation,omitempty"`
+	RepresentationElement *common.Element `json:"_representation,omitempty"`
 
 	// The type of structure (e.g. Full, Partial, Representative)
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -180,10 +188,12 @@ type SubstanceSpecificationStructure struct {
 	Isotope []SubstanceSpecificationStructureIsotope `json:"isotope,omitempty"`
 
 	// Molecular formula
-	MolecularFormula *string `json:"molecularFormula,omitempty"`
+	MolecularFormula        *string         `json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *common.Element `json:"_molecularFormula,omitempty"`
 
 	// Specified per moiety according to the Hill system, i.e. first C, then H, then alphabetical, each moiety separated by a dot
-	MolecularFormulaByMoiety *string `json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoiety        *string         `json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoietyElement *common.Element `json:"_molecularFormulaByMoiety,omitempty"`
 
 	// The molecular weight or weight range (for proteins, polymers or nucleic acids)
 	MolecularWeight *SubstanceSpecificationStructureIsotopeMolecularWeight `json:"molecularWeight,omitempty"`
@@ -209,7 +219,8 @@ type SubstanceSpecificationCode struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Any comment can be provided in this field, if necessary
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Supporting literature
 	Source []common.Reference `json:"source,omitempty"`
@@ -218,7 +229,8 @@ type SubstanceSpecificationCode struct {
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// The date at which the code status is changed as part of the terminology maintenance
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
 }
 
 // SubstanceSpecificationNameOfficial represents details of the official nature of this name
@@ -229,7 +241,8 @@ type SubstanceSpecificationNameOfficial struct {
 	Authority *common.CodeableConcept `json:"authority,omitempty"`
 
 	// Date of official name change
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The status of the official name
 	Status *common.CodeableConcept `json:"status,omitempty"`
@@ -249,13 +262,15 @@ type SubstanceSpecificationName struct {
 	Language []common.CodeableConcept `json:"language,omitempty"`
 
 	// The actual name
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Details of the official nature of this name
 	Official []SubstanceSpecificationNameOfficial `json:"official,omitempty"`
 
 	// If this is the preferred name for this substance
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 
 	// Supporting literature
 	Source []common.Reference `json:"source,omitempty"`
@@ -281,7 +296,8 @@ type SubstanceSpecificationRelationship struct {
 	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
 
 	// For example where an enzyme strongly bonds to a particular substance, this is a defining relationship for that enzyme, out of several possible substance relationships
-	IsDefining *bool `json:"isDefining,omitempty"`
+	IsDefining        *bool           `json:"isDefining,omitempty"`
+	IsDefiningElement *common.Element `json:"_isDefining,omitempty"`
 
 	// A numeric factor for the relationship, for instance to express that the salt of a substance has some percentage of the active moiety
 	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
@@ -290,7 +306,8 @@ type SubstanceSpecificationRelationship struct {
 	AmountRatio *common.Ratio `json:"amountRatio,omitempty"`
 
 	// A numeric factor for the relationship, for instance to express that the salt of a substance has some percentage of the active moiety
-	AmountString *string `json:"amountString,omitempty"`
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
 
 	// For use when the numeric
 	AmountRatioLowLimit *common.Ratio `json:"amountRatioLowLimit,omitempty"`
@@ -21,7 +21,8 @@ type SupplyDelivery struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// [The list of types may be constrained as appropriate for the type of event]
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// [The list of types may be constrained as appropriate for the type of event]
 	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
@@ -39,7 +40,8 @@ type SupplyDelivery struct {
 	Receiver []common.Reference `json:"receiver,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status *string `json:"status,omitempty"` // "in-progress" | "completed" | "abandoned" | "entered-in-error"
+	Status        *string         `json:"status,omitempty"` // "in-progress" | "completed" | "abandoned" | "entered-in-error"
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The item that is being delivered or has been supplied
 	SuppliedItem *SupplyDeliverySuppliedItem `json:"suppliedItem,omitempty"`
@@ -12,7 +12,8 @@ type SupplyRequest struct {
 	ResourceType string `json:"resourceType"` // Always "SupplyRequest"
 
 	// When the request was made
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Category of supply, e.g. central, non-stock, etc. This is used to support work flows associated with the supply process
 	Category *common.CodeableConcept `json:"category,omitempty"`
@@ -33,7 +34,8 @@ type SupplyRequest struct {
 	ItemReference *common.Reference `json:"itemReference,omitempty"`
 
 	// When the request should be fulfilled
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// When the request should be fulfilled
 	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
@@ -45,7 +47,8 @@ type SupplyRequest struct {
 	Parameter []SupplyRequestParameter `json:"parameter,omitempty"`
 
 	// Indicates how quickly this SupplyRequest should be addressed with respect to other requests
-	Priority *string `json:"priority,omitempty"` // "routine" | "urgent" | "asap" | "stat"
+	Priority        *string         `json:"priority,omitempty"` // "routine" | "urgent" | "asap" | "stat"
+	PriorityElement *common.Element `json:"_priority,omitempty"`
 
 	// The amount that is being ordered of the indicated item
 	Quantity common.Quantity `json:"quantity"`
@@ -60,7 +63,8 @@ type SupplyRequest struct {
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// Status of the supply request
-	Status *string `json:"status,omitempty"` // "draft" | "active" | "suspended" | "cancelled" | "completed" | "entered-in-error" | "unknown"
+	Status        *string         `json:"status,omitempty"` // "draft" | "active" | "suspended" | "cancelled" | "completed" | "entered-in-error" | "unknown"
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Who is intended to fulfill the request
 	Supplier []common.Reference `json:"supplier,omitempty"`
@@ -83,5 +87,6 @@ type SupplyRequestParameter struct {
 	ValueRange *common.Range `json:"valueRange,omitempty"`
 
 	// Range means device should have a value that falls somewhere within the specified range
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 }
@@ -12,7 +12,8 @@ type Task struct {
 	ResourceType string `json:"resourceType"` // Always "Task"
 
 	// The date and time this task was created
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// BasedOn refers to a higher-level authorization that triggered the creation of the task
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -24,7 +25,8 @@ type Task struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// A free-text description of what is to be performed
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The healthcare event (e.g. a patient and healthcare provider interaction) during which this task was created
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -48,19 +50,23 @@ type Task struct {
 	Input []TaskInput `json:"input,omitempty"`
 
 	// The URL pointing to a *FHIR*-defined protocol, guideline, orderset or other definition that is adhered to in whole or in part by this Task
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an *externally* maintained protocol, guideline, orderset or other definition that is adhered to in whole or in part by this Task
-	InstantiatesUri *string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations that may be relevant to the Task
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// This element is immutable. Proposed tasks, planned tasks, etc. must be distinct instances
-	Intent string `json:"intent"` // "unknown" | "proposal" | "plan" | "order" | "original-order" | "reflex-order" | "filler-order" | "instance-order" | "option"
+	Intent        string          `json:"intent"` // "unknown" | "proposal" | "plan" | "order" | "original-order" | "reflex-order" | "filler-order" | "instance-order" | "option"
+	IntentElement *common.Element `json:"_intent,omitempty"`
 
 	// The date and time of last modification to this task
-	LastModified *string `json:"lastModified,omitempty"`
+	LastModified        *common.DateTime `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element  `json:"_lastModified,omitempty"`
 
 	// Principal physical location where the this task is performed
 	Location *common.Reference `json:"location,omitempty"`
@@ -81,7 +87,8 @@ type Task struct {
 	PerformerType []common.CodeableConcept `json:"performerType,omitempty"`
 
 	// Indicates how quickly the Task should be addressed with respect to other requests
-	Priority *string `json:"priority,omitempty"` // "routine" | "urgent" | "asap" | "stat"
+	Priority        *string         `json:"priority,omitempty"` // "routine" | "urgent" | "asap" | "stat"
+	PriorityElement *common.Element `json:"_priority,omitempty"`
 
 	// This should only be included if there is no focus or if it differs from the reason indicated on the focus
 	ReasonCode *common.CodeableConcept `json:"reasonCode,omitempty"`
@@ -99,7 +106,8 @@ type Task struct {
 	Restriction *TaskRestriction `json:"restriction,omitempty"`
 
 	// The current status of the task
-	Status string `json:"status"` // "draft" | "requested" | "received" | "accepted" | "rejected" | "ready" | "cancelled" | "in-progress" | "on-hold" | "failed" | "completed" | "entered-in-error"
+	Status        string          `json:"status"` // "draft" | "requested" | "received" | "accepted" | "rejected" | "ready" | "cancelled" | "in-progress" | "on-hold" | "failed" | "completed" | "entered-in-error"
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// This applies to the current status. Look at the history of the task to see reasons for past statuses
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -116,7 +124,8 @@ type TaskRestriction struct {
 	Recipient []common.Reference `json:"recipient,omitempty"`
 
 	// Indicates the number of times the requested action should occur
-	Repetitions *int `json:"repetitions,omitempty"`
+	Repetitions        *int            `json:"repetitions,omitempty"`
+	RepetitionsElement *common.Element `json:"_repetitions,omitempty"`
 }
 
 // TaskInput represents additional information that may be needed in the execution of the task
@@ -127,61 +136,80 @@ type TaskInput struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The value of the input parameter as a basic type
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueCanonical *string `json:"valueCanonical,omitempty"`
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueCode *string `json:"valueCode,omitempty"`
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueId *string `json:"valueId,omitempty"`
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueInstant *string `json:"valueInstant,omitempty"`
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueMarkdown *string `json:"valueMarkdown,omitempty"`
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueOid *string `json:"valueOid,omitempty"`
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty"`
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUnsignedInt *int `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUrl *string `json:"valueUrl,omitempty"`
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUuid *string `json:"valueUuid,omitempty"`
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
 
 	// The value of the input parameter as a basic type
 	ValueAddress *common.Address `json:"valueAddress,omitempty"`
@@ -271,7 +299,7 @@ type TaskInput struct {
 	ValueUsageContext *common.UsageContext `json:"valueUsageContext,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDosage *common.Dosage `json:"valueDosage,omitempty"`
+	ValueDosage *Dosage `json:"valueDosage,omitempty"`
 
 	// The value of the input parameter as a basic type
 	ValueMeta *common.Meta `json:"valueMeta,omitempty"`
@@ -285,61 +313,80 @@ type TaskOutput struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The value of the Output parameter as a basic type
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueCanonical *string `json:"valueCanonical,omitempty"`
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueCode *string `json:"valueCode,omitempty"`
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueId *string `json:"valueId,omitempty"`
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueInstant *string `json:"valueInstant,omitempty"`
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueMarkdown *string `json:"valueMarkdown,omitempty"`
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueOid *string `json:"valueOid,omitempty"`
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty"`
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueUnsignedInt *int `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueUrl *string `json:"valueUrl,omitempty"`
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueUuid *string `json:"valueUuid,omitempty"`
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
 
 	// The value of the Output parameter as a basic type
 	ValueAddress *common.Address `json:"valueAddress,omitempty"`
@@ -429,7 +476,7 @@ type TaskOutput struct {
 	ValueUsageContext *common.UsageContext `json:"valueUsageContext,omitempty"`
 
 	// The value of the Output parameter as a basic type
-	ValueDosage *common.Dosage `json:"valueDosage,omitempty"`
+	ValueDosage *Dosage `json:"valueDosage,omitempty"`
 
 	// The value of the Output parameter as a basic type
 	ValueMeta *common.Meta `json:"valueMeta,omitempty"`
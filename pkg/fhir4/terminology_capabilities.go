@@ -15,7 +15,8 @@ type TerminologyCapabilities struct {
 	Closure *TerminologyCapabilitiesClosure `json:"closure,omitempty"`
 
 	// See notes on the ValueSet resource
-	CodeSearch *string `json:"codeSearch,omitempty"` // "explicit" | "all"
+	CodeSearch        *string         `json:"codeSearch,omitempty"` // "explicit" | "all"
+	CodeSearchElement *common.Element `json:"_codeSearch,omitempty"`
 
 	// The code system - identified by its system URL - may also be declared explicitly as a Code System Resource at /CodeSystem, but it might not be
 	CodeSystem []TerminologyCapabilitiesCodeSystem `json:"codeSystem,omitempty"`
@@ -24,19 +25,23 @@ type TerminologyCapabilities struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the terminology capabilities and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date, since the resource may be a secondary representation of the terminology capabilities
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the terminology capabilities was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Information about the ValueSet/$expand operation
 	Expansion *TerminologyCapabilitiesExpansion `json:"expansion,omitempty"`
 
 	// Allows filtering of terminology capabilitiess that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Identifies a specific implementation instance that is described by the terminology capability statement
 	Implementation *TerminologyCapabilitiesImplementation `json:"implementation,omitempty"`
@@ -45,34 +50,42 @@ type TerminologyCapabilities struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The way that this statement is intended to be used, to describe an actual running instance of software, a particular product or a class of implementation
-	Kind string `json:"kind"` // "instance" | "capability" | "requirements"
+	Kind        string          `json:"kind"` // "instance" | "capability" | "requirements"
+	KindElement *common.Element `json:"_kind,omitempty"`
 
 	// Whether the server supports lockedDate
-	LockedDate *bool `json:"lockedDate,omitempty"`
+	LockedDate        *bool           `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element `json:"_lockedDate,omitempty"`
 
 	// The name is not expected to be globally unique. The name should be a simple alphanumeric type name to ensure that it is machine-processing friendly
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual. The publisher (or steward) of the terminology capabilities is the organization or individual primarily responsible for the maintenance and upkeep of the terminology capabilities
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the terminology capabilities. Instead, it provides traceability of 'why' the resource is either needed or 'why' it is defined as it is
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Software that is covered by this terminology capability statement
 	Software *TerminologyCapabilitiesSoftware `json:"software,omitempty"`
 
 	// Allows filtering of terminology capabilitiess that are appropriate for use versus not
-	Status string `json:"status"` // "draft" | "active" | "retired" | "unknown"
+	Status        string          `json:"status"` // "draft" | "active" | "retired" | "unknown"
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Information about the ConceptMap/$translate operation
 	Translation *TerminologyCapabilitiesTranslation `json:"translation,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
@@ -81,7 +94,8 @@ type TerminologyCapabilities struct {
 	ValidateCode *TerminologyCapabilitiesValidateCode `json:"validateCode,omitempty"`
 
 	// There may be different terminology capabilities instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // TerminologyCapabilitiesSoftware represents software that is covered by this terminology capability statement
@@ -89,10 +103,12 @@ type TerminologyCapabilitiesSoftware struct {
 	common.BackboneElement
 
 	// Name the software is known by
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// If possible, a version should be specified, as statements are likely to be different for different versions of software
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // TerminologyCapabilitiesImplementation represents identifies a specific implementation instance that is described by the terminology capability statement
@@ -100,10 +116,12 @@ type TerminologyCapabilitiesImplementation struct {
 	common.BackboneElement
 
 	// Information about the specific installation that this terminology capability statement relates to
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An absolute base URL for the implementation
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TerminologyCapabilitiesCodeSystemVersionFilter represents filter Properties supported
@@ -111,10 +129,12 @@ type TerminologyCapabilitiesCodeSystemVersionFilter struct {
 	common.BackboneElement
 
 	// Code of the property supported
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Operations supported for the property
-	Op []string `json:"op"`
+	Op        []string          `json:"op"`
+	OpElement []*common.Element `json:"_op,omitempty"`
 }
 
 // TerminologyCapabilitiesCodeSystemVersion represents language translations might not be available for all codes
@@ -122,22 +142,27 @@ type TerminologyCapabilitiesCodeSystemVersion struct {
 	common.BackboneElement
 
 	// For version-less code systems, there should be a single version with no identifier
-	Code *string `json:"code,omitempty"`
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// If the compositional grammar defined by the code system is supported
-	Compositional *bool `json:"compositional,omitempty"`
+	Compositional        *bool           `json:"compositional,omitempty"`
+	CompositionalElement *common.Element `json:"_compositional,omitempty"`
 
 	// Filter Properties supported
 	Filter []TerminologyCapabilitiesCodeSystemVersionFilter `json:"filter,omitempty"`
 
 	// If this is the default version for this code system
-	IsDefault *bool `json:"isDefault,omitempty"`
+	IsDefault        *bool           `json:"isDefault,omitempty"`
+	IsDefaultElement *common.Element `json:"_isDefault,omitempty"`
 
 	// Language Displays supported
-	Language []string `json:"language,omitempty"`
+	Language        []string          `json:"language,omitempty"`
+	LanguageElement []*common.Element `json:"_language,omitempty"`
 
 	// Properties supported for $lookup
-	Property []string `json:"property,omitempty"`
+	Property        []string          `json:"property,omitempty"`
+	PropertyElement []*common.Element `json:"_property,omitempty"`
 }
 
 // TerminologyCapabilitiesCodeSystem represents the code system - identified by its system URL
@@ -145,13 +170,15 @@ type TerminologyCapabilitiesCodeSystem struct {
 	common.BackboneElement
 
 	// URI for the Code System
-	Uri *string `json:"uri,omitempty"`
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 
 	// For the code system, a list of versions that are supported by the server
 	Version []TerminologyCapabilitiesCodeSystemVersion `json:"version,omitempty"`
 
 	// True if subsumption is supported for this version of the code system
-	Subsumption *bool `json:"subsumption,omitempty"`
+	Subsumption        *bool           `json:"subsumption,omitempty"`
+	SubsumptionElement *common.Element `json:"_subsumption,omitempty"`
 }
 
 // TerminologyCapabilitiesExpansion represents information about the ValueSet/$expand operation
@@ -159,19 +186,23 @@ type TerminologyCapabilitiesExpansion struct {
 	common.BackboneElement
 
 	// Whether the server can return nested value sets
-	Hierarchical *bool `json:"hierarchical,omitempty"`
+	Hierarchical        *bool           `json:"hierarchical,omitempty"`
+	HierarchicalElement *common.Element `json:"_hierarchical,omitempty"`
 
 	// Whether the server supports paging on expansion
-	Paging *bool `json:"paging,omitempty"`
+	Paging        *bool           `json:"paging,omitempty"`
+	PagingElement *common.Element `json:"_paging,omitempty"`
 
 	// Allow request for incomplete expansions?
-	Incomplete *bool `json:"incomplete,omitempty"`
+	Incomplete        *bool           `json:"incomplete,omitempty"`
+	IncompleteElement *common.Element `json:"_incomplete,omitempty"`
 
 	// Supported expansion parameter
 	Parameter []TerminologyCapabilitiesExpansionParameter `json:"parameter,omitempty"`
 
 	// Documentation about text searching works
-	TextFilter *string `json:"textFilter,omitempty"`
+	TextFilter        *string         `json:"textFilter,omitempty"`
+	TextFilterElement *common.Element `json:"_textFilter,omitempty"`
 }
 
 // TerminologyCapabilitiesExpansionParameter represents supported expansion parameter
@@ -179,10 +210,12 @@ type TerminologyCapabilitiesExpansionParameter struct {
 	common.BackboneElement
 
 	// Expansion Parameter name
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Description of support for parameter
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // TerminologyCapabilitiesTranslation represents information about the ConceptMap/$translate operation
@@ -190,7 +223,8 @@ type TerminologyCapabilitiesTranslation struct {
 	common.BackboneElement
 
 	// Whether the client must identify the map
-	NeedsMap bool `json:"needsMap"`
+	NeedsMap        bool            `json:"needsMap"`
+	NeedsMapElement *common.Element `json:"_needsMap,omitempty"`
 }
 
 // TerminologyCapabilitiesValidateCode represents information about the ValueSet/$validate-code operation
@@ -198,7 +232,8 @@ type TerminologyCapabilitiesValidateCode struct {
 	common.BackboneElement
 
 	// Whether translations are validated
-	Translations bool `json:"translations"`
+	Translations        bool            `json:"translations"`
+	TranslationsElement *common.Element `json:"_translations,omitempty"`
 }
 
 // TerminologyCapabilitiesClosure represents whether the $closure operation is supported
@@ -206,5 +241,6 @@ type TerminologyCapabilitiesClosure struct {
 	common.BackboneElement
 
 	// If cross-system closure is supported
-	Translation *bool `json:"translation,omitempty"`
+	Translation        *bool           `json:"translation,omitempty"`
+	TranslationElement *common.Element `json:"_translation,omitempty"`
 }
@@ -15,25 +15,30 @@ type TestReport struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Additional specific dates may be added as extensions
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
 
 	// Not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A participant in the test execution, either the execution engine, a client, or a server
 	Participant []TestReportParticipant `json:"participant,omitempty"`
 
 	// The pass and fail result represents a completed test script execution. The pending result represents a test script execution that has not yet started or is currently in progress
-	Result string `json:"result"` // "pass" | "fail" | "pending"
+	Result        string          `json:"result"` // "pass" | "fail" | "pending"
+	ResultElement *common.Element `json:"_result,omitempty"`
 
 	// The final score (percentage of tests passed) resulting from the execution of the TestScript
-	Score *float64 `json:"score,omitempty"`
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
 
 	// The results of the series of required setup operations before the tests were executed
 	Setup *TestReportSetup `json:"setup,omitempty"`
 
 	// The status represents where the execution is currently within the test script execution life cycle
-	Status string `json:"status"` // "completed" | "in-progress" | "waiting" | "stopped" | "entered-in-error"
+	Status        string          `json:"status"` // "completed" | "in-progress" | "waiting" | "stopped" | "entered-in-error"
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The results of the series of operations required to clean up after all the tests were executed (successfully or otherwise)
 	Teardown *TestReportTeardown `json:"teardown,omitempty"`
@@ -42,7 +47,8 @@ type TestReport struct {
 	Test []TestReportTest `json:"test,omitempty"`
 
 	// Usually an organization, but may be an individual. This item SHOULD be populated unless the information is available from context
-	Tester *string `json:"tester,omitempty"`
+	Tester        *string         `json:"tester,omitempty"`
+	TesterElement *common.Element `json:"_tester,omitempty"`
 
 	// Ideally this is an absolute URL that is used to identify the version-specific TestScript that was executed, matching the TestScript.url
 	TestScript common.Reference `json:"testScript"`
@@ -53,13 +59,16 @@ type TestReportParticipant struct {
 	common.BackboneElement
 
 	// The display name of the participant
-	Display *string `json:"display,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
 
 	// The type of participant
-	Type string `json:"type"` // "test-engine" | "client" | "server"
+	Type        string          `json:"type"` // "test-engine" | "client" | "server"
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// The uri of the participant. An absolute URL is preferred
-	Uri string `json:"uri"`
+	Uri        string          `json:"uri"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 }
 
 // TestReportSetupActionOperation represents the operation performed
@@ -67,13 +76,16 @@ type TestReportSetupActionOperation struct {
 	common.BackboneElement
 
 	// A link to further details on the result
-	Detail *string `json:"detail,omitempty"`
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
 
 	// An explanatory message associated with the result
-	Message *string `json:"message,omitempty"`
+	Message        *string         `json:"message,omitempty"`
+	MessageElement *common.Element `json:"_message,omitempty"`
 
 	// The result of this operation
-	Result string `json:"result"` // "pass" | "skip" | "fail" | "warning" | "error"
+	Result        string          `json:"result"` // "pass" | "skip" | "fail" | "warning" | "error"
+	ResultElement *common.Element `json:"_result,omitempty"`
 }
 
 // TestReportSetupActionAssert represents the results of the assertion performed on the previous operations
@@ -81,13 +93,16 @@ type TestReportSetupActionAssert struct {
 	common.BackboneElement
 
 	// A link to further details on the result
-	Detail *string `json:"detail,omitempty"`
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
 
 	// An explanatory message associated with the result
-	Message *string `json:"message,omitempty"`
+	Message        *string         `json:"message,omitempty"`
+	MessageElement *common.Element `json:"_message,omitempty"`
 
 	// The result of this assertion
-	Result string `json:"result"` // "pass" | "skip" | "fail" | "warning" | "error"
+	Result        string          `json:"result"` // "pass" | "skip" | "fail" | "warning" | "error"
+	ResultElement *common.Element `json:"_result,omitempty"`
 }
 
 // TestReportSetupAction represents an action should contain either an operation or an assertion but not both
@@ -117,10 +132,12 @@ type TestReportTest struct {
 	Action []TestReportTestAction `json:"action"`
 
 	// A description of the test's purpose
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The name of this test used for tracking/logging purposes by test engines
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 }
 
 // TestReportTestAction represents a test operation or assert that was performed
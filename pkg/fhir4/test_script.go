@@ -15,19 +15,23 @@ type TestScript struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the test script and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date, since the resource may be a secondary representation of the test script
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the test script was built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The purpose of this element is to define the profile of a destination element used elsewhere in the script
 	Destination []TestScriptDestination `json:"destination,omitempty"`
 
 	// Allows filtering of test scripts that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Fixture in the test script - by reference (uri). All fixtures are required for the test script to execute
 	Fixture []TestScriptFixture `json:"fixture,omitempty"`
@@ -42,7 +46,8 @@ type TestScript struct {
 	Metadata *TestScriptMetadata `json:"metadata,omitempty"`
 
 	// The name is not expected to be globally unique. The name should be a simple alphanumeric type name to ensure that it is machine-processing friendly
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The purpose of this element is to define the profile of an origin element used elsewhere in the script
 	Origin []TestScriptOrigin `json:"origin,omitempty"`
@@ -51,16 +56,19 @@ type TestScript struct {
 	Profile []common.Reference `json:"profile,omitempty"`
 
 	// Usually an organization but may be an individual. The publisher (or steward) of the test script is the organization or individual primarily responsible for the maintenance and upkeep of the test script
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the test script. Instead, it provides traceability of 'why' the resource is either needed or 'why' it is defined as it is
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// A series of required setup operations before tests are executed
 	Setup *TestScriptSetup `json:"setup,omitempty"`
 
 	// Allows filtering of test scripts that are appropriate for use versus not
-	Status string `json:"status"` // "draft" | "active" | "retired" | "unknown"
+	Status        string          `json:"status"` // "draft" | "active" | "retired" | "unknown"
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// A series of operations required to clean up after all the tests are executed (successfully or otherwise)
 	Teardown *TestScriptTeardown `json:"teardown,omitempty"`
@@ -69,10 +77,12 @@ type TestScript struct {
 	Test []TestScriptTest `json:"test,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
@@ -81,7 +91,8 @@ type TestScript struct {
 	Variable []TestScriptVariable `json:"variable,omitempty"`
 
 	// There may be different test script instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // TestScriptOrigin represents the purpose of this element is to define the profile of an origin element used elsewhere in the script
@@ -89,7 +100,8 @@ type TestScriptOrigin struct {
 	common.BackboneElement
 
 	// A given origin index (e.g. 1) can appear only once in the list
-	Index int `json:"index"`
+	Index        int             `json:"index"`
+	IndexElement *common.Element `json:"_index,omitempty"`
 
 	// Must be a "sender"/"client" profile
 	Profile common.Coding `json:"profile"`
@@ -100,7 +112,8 @@ type TestScriptDestination struct {
 	common.BackboneElement
 
 	// A given destination index (e.g. 1) can appear only once in the list
-	Index int `json:"index"`
+	Index        int             `json:"index"`
+	IndexElement *common.Element `json:"_index,omitempty"`
 
 	// Must be a "receiver"/"server" profile
 	Profile common.Coding `json:"profile"`
@@ -111,10 +124,12 @@ type TestScriptMetadataLink struct {
 	common.BackboneElement
 
 	// Short description of the link
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// URL to a particular requirement or feature within the FHIR specification
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TestScriptMetadataCapability represents when the metadata capabilities section is defined at TestScript.metadata
@@ -122,25 +137,32 @@ type TestScriptMetadataCapability struct {
 	common.BackboneElement
 
 	// The conformance statement of the server has to contain at a minimum the contents of the reference pointed to by this element
-	Capabilities string `json:"capabilities"`
+	Capabilities        string          `json:"capabilities"`
+	CapabilitiesElement *common.Element `json:"_capabilities,omitempty"`
 
 	// Description of the capabilities that this test script is requiring the server to support
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Which server these requirements apply to
-	Destination *int `json:"destination,omitempty"`
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
 
 	// Links to the FHIR specification that describes this interaction and the resources involved in more detail
-	Link []string `json:"link,omitempty"`
+	Link        []string          `json:"link,omitempty"`
+	LinkElement []*common.Element `json:"_link,omitempty"`
 
 	// Which origin server these requirements apply to
-	Origin []int `json:"origin,omitempty"`
+	Origin        []int             `json:"origin,omitempty"`
+	OriginElement []*common.Element `json:"_origin,omitempty"`
 
 	// Whether or not the test execution will require the given capabilities of the server in order for this test script to execute
-	Required bool `json:"required"`
+	Required        bool            `json:"required"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
 
 	// Whether or not the test execution will validate the given capabilities of the server in order for this test script to execute
-	Validated bool `json:"validated"`
+	Validated        bool            `json:"validated"`
+	ValidatedElement *common.Element `json:"_validated,omitempty"`
 }
 
 // TestScriptMetadata represents the required capability must exist and are assumed to function correctly on the FHIR server being tested
@@ -159,10 +181,12 @@ type TestScriptFixture struct {
 	common.BackboneElement
 
 	// Whether or not to implicitly create the fixture during setup
-	Autocreate bool `json:"autocreate"`
+	Autocreate        bool            `json:"autocreate"`
+	AutocreateElement *common.Element `json:"_autocreate,omitempty"`
 
 	// Whether or not to implicitly delete the fixture during teardown
-	Autodelete bool `json:"autodelete"`
+	Autodelete        bool            `json:"autodelete"`
+	AutodeleteElement *common.Element `json:"_autodelete,omitempty"`
 
 	// See http://build.fhir.org/resourcelist.html for complete list of resource types
 	Resource *common.Reference `json:"resource,omitempty"`
@@ -173,25 +197,32 @@ type TestScriptVariable struct {
 	common.BackboneElement
 
 	// The purpose of this element is to allow for a pre-defined value that can be used as a default or as an override value
-	DefaultValue *string `json:"defaultValue,omitempty"`
+	DefaultValue        *string         `json:"defaultValue,omitempty"`
+	DefaultValueElement *common.Element `json:"_defaultValue,omitempty"`
 
 	// A free text natural language description of the variable and its purpose
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// If headerField is defined, then the variable will be evaluated against the headers that sourceId is pointing to
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// If headerField is defined, then the variable will be evaluated against the headers that sourceId is pointing to
-	HeaderField *string `json:"headerField,omitempty"`
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
 
 	// If headerField is defined, then the variable will be evaluated against the headers that sourceId is pointing to
-	Hint *string `json:"hint,omitempty"`
+	Hint        *string         `json:"hint,omitempty"`
+	HintElement *common.Element `json:"_hint,omitempty"`
 
 	// If headerField is defined, then the variable will be evaluated against the headers that sourceId is pointing to
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 
 	// If headerField is defined, then the variable will be evaluated against the headers that sourceId is pointing to
-	SourceId *string `json:"sourceId,omitempty"`
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
 }
 
 // TestScriptSetup represents a series of required setup operations before tests are executed
@@ -224,49 +255,63 @@ type TestScriptSetupActionOperation struct {
 	Type *common.Coding `json:"type,omitempty"`
 
 	// The resource type
-	Resource *string `json:"resource,omitempty"`
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
 
 	// The label would be used for tracking/logging purposes by test engines
-	Label *string `json:"label,omitempty"`
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
 
 	// The description would be used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The mime-type to use for RESTful operations in the 'Accept' header
-	Accept *string `json:"accept,omitempty"`
+	Accept        *string         `json:"accept,omitempty"`
+	AcceptElement *common.Element `json:"_accept,omitempty"`
 
 	// The mime-type to use for RESTful operations in the 'Content-Type' header
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The server where the request message is destined for
-	Destination *int `json:"destination,omitempty"`
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
 
 	// Whether or not to implicitly send the request url in encoded format
-	EncodeRequestUrl *bool `json:"encodeRequestUrl,omitempty"`
+	EncodeRequestUrl        *bool           `json:"encodeRequestUrl,omitempty"`
+	EncodeRequestUrlElement *common.Element `json:"_encodeRequestUrl,omitempty"`
 
 	// The server where the request message originates from
-	Origin *int `json:"origin,omitempty"`
+	Origin        *int            `json:"origin,omitempty"`
+	OriginElement *common.Element `json:"_origin,omitempty"`
 
 	// Path plus parameters after [type]
-	Params *string `json:"params,omitempty"`
+	Params        *string         `json:"params,omitempty"`
+	ParamsElement *common.Element `json:"_params,omitempty"`
 
 	// Header elements would be used to set HTTP headers
 	RequestHeader []TestScriptSetupActionOperationRequestHeader `json:"requestHeader,omitempty"`
 
 	// The fixture id (maybe new) to map to the request
-	RequestId *string `json:"requestId,omitempty"`
+	RequestId        *string         `json:"requestId,omitempty"`
+	RequestIdElement *common.Element `json:"_requestId,omitempty"`
 
 	// The type of the resource
-	ResponseId *string `json:"responseId,omitempty"`
+	ResponseId        *string         `json:"responseId,omitempty"`
+	ResponseIdElement *common.Element `json:"_responseId,omitempty"`
 
 	// The id of the fixture used as the body of a PUT or POST request
-	SourceId *string `json:"sourceId,omitempty"`
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
 
 	// Complete request URL
-	TargetId *string `json:"targetId,omitempty"`
+	TargetId        *string         `json:"targetId,omitempty"`
+	TargetIdElement *common.Element `json:"_targetId,omitempty"`
 
 	// The URL for the request
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TestScriptSetupActionOperationRequestHeader represents header elements would be used to set HTTP headers
@@ -274,10 +319,12 @@ type TestScriptSetupActionOperationRequestHeader struct {
 	common.BackboneElement
 
 	// The HTTP header field e.g. "Accept"
-	Field string `json:"field"`
+	Field        string          `json:"field"`
+	FieldElement *common.Element `json:"_field,omitempty"`
 
 	// The value of the header e.g. "application/fhir+xml"
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // TestScriptSetupActionAssert represents the results of the assertion performed on the previous operations
@@ -285,58 +332,76 @@ type TestScriptSetupActionAssert struct {
 	common.BackboneElement
 
 	// The label would be used for tracking/logging purposes by test engines
-	Label *string `json:"label,omitempty"`
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
 
 	// The description would be used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The direction to use for the assertion
-	Direction *string `json:"direction,omitempty"` // "response" | "request"
+	Direction        *string         `json:"direction,omitempty"` // "response" | "request"
+	DirectionElement *common.Element `json:"_direction,omitempty"`
 
 	// Id of the source fixture to be evaluated
-	CompareToSourceId *string `json:"compareToSourceId,omitempty"`
+	CompareToSourceId        *string         `json:"compareToSourceId,omitempty"`
+	CompareToSourceIdElement *common.Element `json:"_compareToSourceId,omitempty"`
 
 	// The fluentpath expression to evaluate against the source fixture
-	CompareToSourceExpression *string `json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpression        *string         `json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpressionElement *common.Element `json:"_compareToSourceExpression,omitempty"`
 
 	// XPath or JSONPath expression to evaluate against the source fixture
-	CompareToSourcePath *string `json:"compareToSourcePath,omitempty"`
+	CompareToSourcePath        *string         `json:"compareToSourcePath,omitempty"`
+	CompareToSourcePathElement *common.Element `json:"_compareToSourcePath,omitempty"`
 
 	// The mime-type to use for RESTful operations in the 'Accept' header
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The fluentpath expression to be evaluated
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// The HTTP header field name e.g. 'Location'
-	HeaderField *string `json:"headerField,omitempty"`
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
 
 	// The ID of a fixture
-	MinimumId *string `json:"minimumId,omitempty"`
+	MinimumId        *string         `json:"minimumId,omitempty"`
+	MinimumIdElement *common.Element `json:"_minimumId,omitempty"`
 
 	// Whether or not the test execution will perform the validation
-	NavigationLinks *bool `json:"navigationLinks,omitempty"`
+	NavigationLinks        *bool           `json:"navigationLinks,omitempty"`
+	NavigationLinksElement *common.Element `json:"_navigationLinks,omitempty"`
 
 	// The operator type defines the conditional behavior of the assert
-	Operator *string `json:"operator,omitempty"` // "equals" | "notEquals" | "in" | "notIn" | "greaterThan" | "lessThan" | "empty" | "notEmpty" | "contains" | "notContains" | "eval"
+	Operator        *string         `json:"operator,omitempty"` // "equals" | "notEquals" | "in" | "notIn" | "greaterThan" | "lessThan" | "empty" | "notEmpty" | "contains" | "notContains" | "eval"
+	OperatorElement *common.Element `json:"_operator,omitempty"`
 
 	// The XPath or JSONPath expression to evaluate against the fixture
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 
 	// The request method or HTTP operation code to compare against that used by the client
-	RequestMethod *string `json:"requestMethod,omitempty"` // "delete" | "get" | "options" | "patch" | "post" | "put" | "head"
+	RequestMethod        *string         `json:"requestMethod,omitempty"` // "delete" | "get" | "options" | "patch" | "post" | "put" | "head"
+	RequestMethodElement *common.Element `json:"_requestMethod,omitempty"`
 
 	// The value to compare to
-	RequestURL *string `json:"requestURL,omitempty"`
+	RequestURL        *string         `json:"requestURL,omitempty"`
+	RequestURLElement *common.Element `json:"_requestURL,omitempty"`
 
 	// The type of the resource
-	Resource *string `json:"resource,omitempty"`
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
 
 	// okay | created | noContent | notModified | bad | forbidden | notFound | methodNotAllowed | conflict | gone | preconditionFailed | unprocessable
-	Response *string `json:"response,omitempty"` // "okay" | "created" | "noContent" | "notModified" | "bad" | "forbidden" | "notFound" | "methodNotAllowed" | "conflict" | "gone" | "preconditionFailed" | "unprocessable"
+	Response        *string         `json:"response,omitempty"` // "okay" | "created" | "noContent" | "notModified" | "bad" | "forbidden" | "notFound" | "methodNotAllowed" | "conflict" | "gone" | "preconditionFailed" | "unprocessable"
+	ResponseElement *common.Element `json:"_response,omitempty"`
 
 	// The value of the HTTP response code to be tested
-	ResponseCode *string `json:"responseCode,omitempty"`
+	ResponseCode        *string         `json:"responseCode,omitempty"`
+	ResponseCodeElement *common.Element `json:"_responseCode,omitempty"`
 
 	// The TestScript.rule this assert will evaluate
 	Rule *TestScriptSetupActionAssertRule `json:"rule,omitempty"`
@@ -345,16 +410,20 @@ type TestScriptSetupActionAssert struct {
 	Ruleset *TestScriptSetupActionAssertRuleset `json:"ruleset,omitempty"`
 
 	// Fixture to evaluate the XPath/JSONPath expression or the headerField against
-	SourceId *string `json:"sourceId,omitempty"`
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
 
 	// The ID of the Profile to validate against
-	ValidateProfileId *string `json:"validateProfileId,omitempty"`
+	ValidateProfileId        *string         `json:"validateProfileId,omitempty"`
+	ValidateProfileIdElement *common.Element `json:"_validateProfileId,omitempty"`
 
 	// The value to compare to
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 
 	// Will this assert produce a warning only on error
-	WarningOnly *bool `json:"warningOnly,omitempty"`
+	WarningOnly        *bool           `json:"warningOnly,omitempty"`
+	WarningOnlyElement *common.Element `json:"_warningOnly,omitempty"`
 }
 
 // TestScriptSetupActionAssertRule represents the TestScript.rule this assert will evaluate
@@ -362,7 +431,8 @@ type TestScriptSetupActionAssertRule struct {
 	common.BackboneElement
 
 	// The TestScript.rule id value this assert will evaluate
-	RuleId string `json:"ruleId"`
+	RuleId        string          `json:"ruleId"`
+	RuleIdElement *common.Element `json:"_ruleId,omitempty"`
 
 	// The referenced rule param
 	Param []TestScriptSetupActionAssertRuleParam `json:"param,omitempty"`
@@ -373,10 +443,12 @@ type TestScriptSetupActionAssertRuleParam struct {
 	common.BackboneElement
 
 	// Parameter name matching external assert rule parameter
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Parameter value defined either explicitly or dynamically
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // TestScriptSetupActionAssertRuleset represents the TestScript.ruleset this assert will evaluate
@@ -384,7 +456,8 @@ type TestScriptSetupActionAssertRuleset struct {
 	common.BackboneElement
 
 	// The TestScript.ruleset id value this assert will evaluate
-	RulesetId string `json:"rulesetId"`
+	RulesetId        string          `json:"rulesetId"`
+	RulesetIdElement *common.Element `json:"_rulesetId,omitempty"`
 
 	// The referenced rule param
 	Rule []TestScriptSetupActionAssertRulesetRule `json:"rule,omitempty"`
@@ -395,7 +468,8 @@ type TestScriptSetupActionAssertRulesetRule struct {
 	common.BackboneElement
 
 	// The TestScript.rule id value this assert will evaluate
-	RuleId string `json:"ruleId"`
+	RuleId        string          `json:"ruleId"`
+	RuleIdElement *common.Element `json:"_ruleId,omitempty"`
 
 	// The referenced rule param
 	Param []TestScriptSetupActionAssertRuleParam `json:"param,omitempty"`
@@ -406,10 +480,12 @@ type TestScriptTest struct {
 	common.BackboneElement
 
 	// The name of this test used for tracking/logging purposes by test engines
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A short description of the test used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Action would contain either an operation or an assertion but not both
 	Action []TestScriptTestAction `json:"action"`
@@ -10,102 +10,143 @@ type ValueSet struct {
 
 	ResourceType string `json:"resourceType"` // Always "ValueSet"
 
-	Compose      *ValueSetCompose         `json:"compose,omitempty"`
-	Contact      []common.ContactDetail   `json:"contact,omitempty"`
-	Copyright    *string                  `json:"copyright,omitempty"`
-	Date         *string                  `json:"date,omitempty"`
-	Description  *string                  `json:"description,omitempty"`
-	Expansion    *ValueSetExpansion       `json:"expansion,omitempty"`
-	Experimental *bool                    `json:"experimental,omitempty"`
-	Identifier   []common.Identifier      `json:"identifier,omitempty"`
-	Immutable    *bool                    `json:"immutable,omitempty"`
-	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
-	Name         *string                  `json:"name,omitempty"`
-	Publisher    *string                  `json:"publisher,omitempty"`
-	Purpose      *string                  `json:"purpose,omitempty"`
-	Status       string                   `json:"status"` // "draft" | "active" | "retired" | "unknown"
-	Title        *string                  `json:"title,omitempty"`
-	Url          *string                  `json:"url,omitempty"`
-	UseContext   []common.UsageContext    `json:"useContext,omitempty"`
-	Version      *string                  `json:"version,omitempty"`
+	Compose             *ValueSetCompose         `json:"compose,omitempty"`
+	Contact             []common.ContactDetail   `json:"contact,omitempty"`
+	Copyright           *string                  `json:"copyright,omitempty"`
+	CopyrightElement    *common.Element          `json:"_copyright,omitempty"`
+	Date                *common.DateTime         `json:"date,omitempty"`
+	DateElement         *common.Element          `json:"_date,omitempty"`
+	Description         *string                  `json:"description,omitempty"`
+	DescriptionElement  *common.Element          `json:"_description,omitempty"`
+	Expansion           *ValueSetExpansion       `json:"expansion,omitempty"`
+	Experimental        *bool                    `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element          `json:"_experimental,omitempty"`
+	Identifier          []common.Identifier      `json:"identifier,omitempty"`
+	Immutable           *bool                    `json:"immutable,omitempty"`
+	ImmutableElement    *common.Element          `json:"_immutable,omitempty"`
+	Jurisdiction        []common.CodeableConcept `json:"jurisdiction,omitempty"`
+	Name                *string                  `json:"name,omitempty"`
+	NameElement         *common.Element          `json:"_name,omitempty"`
+	Publisher           *string                  `json:"publisher,omitempty"`
+	PublisherElement    *common.Element          `json:"_publisher,omitempty"`
+	Purpose             *string                  `json:"purpose,omitempty"`
+	PurposeElement      *common.Element          `json:"_purpose,omitempty"`
+	Status              string                   `json:"status"` // "draft" | "active" | "retired" | "unknown"
+	StatusElement       *common.Element          `json:"_status,omitempty"`
+	Title               *string                  `json:"title,omitempty"`
+	TitleElement        *common.Element          `json:"_title,omitempty"`
+	Url                 *string                  `json:"url,omitempty"`
+	UrlElement          *common.Element          `json:"_url,omitempty"`
+	UseContext          []common.UsageContext    `json:"useContext,omitempty"`
+	Version             *string                  `json:"version,omitempty"`
+	VersionElement      *common.Element          `json:"_version,omitempty"`
 }
 
 // ValueSetCompose represents the Content Logical Definition (CLD) for a ValueSet
 type ValueSetCompose struct {
 	common.BackboneElement
 
-	Exclude    []ValueSetComposeInclude `json:"exclude,omitempty"`
-	Include    []ValueSetComposeInclude `json:"include"`
-	Inactive   *bool                    `json:"inactive,omitempty"`
-	LockedDate *string                  `json:"lockedDate,omitempty"`
+	Exclude           []ValueSetComposeInclude `json:"exclude,omitempty"`
+	Include           []ValueSetComposeInclude `json:"include"`
+	Inactive          *bool                    `json:"inactive,omitempty"`
+	InactiveElement   *common.Element          `json:"_inactive,omitempty"`
+	LockedDate        *common.Date             `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element          `json:"_lockedDate,omitempty"`
 }
 
 type ValueSetComposeInclude struct {
 	common.BackboneElement
 
-	Concept  []ValueSetComposeIncludeConcept `json:"concept,omitempty"`
-	Filter   []ValueSetComposeIncludeFilter  `json:"filter,omitempty"`
-	System   *string                         `json:"system,omitempty"`
-	ValueSet []string                        `json:"valueSet,omitempty"`
-	Version  *string                         `json:"version,omitempty"`
+	Concept         []ValueSetComposeIncludeConcept `json:"concept,omitempty"`
+	Filter          []ValueSetComposeIncludeFilter  `json:"filter,omitempty"`
+	System          *string                         `json:"system,omitempty"`
+	SystemElement   *common.Element                 `json:"_system,omitempty"`
+	ValueSet        []string                        `json:"valueSet,omitempty"`
+	ValueSetElement []*common.Element               `json:"_valueSet,omitempty"`
+	Version         *string                         `json:"version,omitempty"`
+	VersionElement  *common.Element                 `json:"_version,omitempty"`
 }
 
 type ValueSetComposeIncludeConcept struct {
 	common.BackboneElement
 
-	Code        string                                     `json:"code"`
-	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
-	Display     *string                                    `json:"display,omitempty"`
+	Code           string                                     `json:"code"`
+	CodeElement    *common.Element                            `json:"_code,omitempty"`
+	Designation    []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
+	Display        *string                                    `json:"display,omitempty"`
+	DisplayElement *common.Element                            `json:"_display,omitempty"`
 }
 
 type ValueSetComposeIncludeConceptDesignation struct {
 	common.BackboneElement
 
-	Language *string        `json:"language,omitempty"`
-	Use      *common.Coding `json:"use,omitempty"`
-	Value    string         `json:"value"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+	Use             *common.Coding  `json:"use,omitempty"`
+	Value           string          `json:"value"`
+	ValueElement    *common.Element `json:"_value,omitempty"`
 }
 
 type ValueSetComposeIncludeFilter struct {
 	common.BackboneElement
 
-	Op       string `json:"op"` // "=" | "is-a" | "descendent-of" | "is-not-a" | "regex" | "in" | "not-in" | "generalizes" | "exists"
-	Property string `json:"property"`
-	Value    string `json:"value"`
+	Op              string          `json:"op"` // "=" | "is-a" | "descendent-of" | "is-not-a" | "regex" | "in" | "not-in" | "generalizes" | "exists"
+	OpElement       *common.Element `json:"_op,omitempty"`
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+	Value           string          `json:"value"`
+	ValueElement    *common.Element `json:"_value,omitempty"`
 }
 
 // ValueSetExpansion represents the expansion of a ValueSet
 type ValueSetExpansion struct {
 	common.BackboneElement
 
-	Contains   []ValueSetExpansionContains  `json:"contains,omitempty"`
-	Identifier *string                      `json:"identifier,omitempty"`
-	Parameter  []ValueSetExpansionParameter `json:"parameter,omitempty"`
-	Timestamp  string                       `json:"timestamp"`
-	Total      *int                         `json:"total,omitempty"`
-	Offset     *int                         `json:"offset,omitempty"`
+	Contains          []ValueSetExpansionContains  `json:"contains,omitempty"`
+	Identifier        *string                      `json:"identifier,omitempty"`
+	IdentifierElement *common.Element              `json:"_identifier,omitempty"`
+	Parameter         []ValueSetExpansionParameter `json:"parameter,omitempty"`
+	Timestamp         common.DateTime              `json:"timestamp"`
+	TimestampElement  *common.Element              `json:"_timestamp,omitempty"`
+	Total             *int                         `json:"total,omitempty"`
+	TotalElement      *common.Element              `json:"_total,omitempty"`
+	Offset            *int                         `json:"offset,omitempty"`
+	OffsetElement     *common.Element              `json:"_offset,omitempty"`
 }
 
 type ValueSetExpansionParameter struct {
 	common.BackboneElement
 
-	Name         string   `json:"name"`
-	ValueString  *string  `json:"valueString,omitempty"`
-	ValueBoolean *bool    `json:"valueBoolean,omitempty"`
-	ValueInteger *int     `json:"valueInteger,omitempty"`
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
-	ValueUri     *string  `json:"valueUri,omitempty"`
-	ValueCode    *string  `json:"valueCode,omitempty"`
+	Name                string          `json:"name"`
+	NameElement         *common.Element `json:"_name,omitempty"`
+	ValueString         *string         `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element `json:"_valueString,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+	ValueUri            *string         `json:"valueUri,omitempty"`
+	ValueUriElement     *common.Element `json:"_valueUri,omitempty"`
+	ValueCode           *string         `json:"valueCode,omitempty"`
+	ValueCodeElement    *common.Element `json:"_valueCode,omitempty"`
 }
 
 type ValueSetExpansionContains struct {
 	common.BackboneElement
 
-	Abstract *bool                       `json:"abstract,omitempty"`
-	Code     *string                     `json:"code,omitempty"`
-	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
-	Display  *string                     `json:"display,omitempty"`
-	Inactive *bool                       `json:"inactive,omitempty"`
-	System   *string                     `json:"system,omitempty"`
-	Version  *string                     `json:"version,omitempty"`
+	Abstract        *bool                       `json:"abstract,omitempty"`
+	AbstractElement *common.Element             `json:"_abstract,omitempty"`
+	Code            *string                     `json:"code,omitempty"`
+	CodeElement     *common.Element             `json:"_code,omitempty"`
+	Contains        []ValueSetExpansionContains `json:"contains,omitempty"`
+	Display         *string                     `json:"display,omitempty"`
+	DisplayElement  *common.Element             `json:"_display,omitempty"`
+	Inactive        *bool                       `json:"inactive,omitempty"`
+	InactiveElement *common.Element             `json:"_inactive,omitempty"`
+	System          *string                     `json:"system,omitempty"`
+	SystemElement   *common.Element             `json:"_system,omitempty"`
+	Version         *string                     `json:"version,omitempty"`
+	VersionElement  *common.Element             `json:"_version,omitempty"`
 }
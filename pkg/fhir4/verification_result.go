@@ -10,51 +10,60 @@ type VerificationResult struct {
 
 	ResourceType string `json:"resourceType"` // Always "VerificationResult"
 
-	Attestation       *VerificationResultAttestation    `json:"attestation,omitempty"`
-	FailureAction     *common.CodeableConcept           `json:"failureAction,omitempty"`
-	Frequency         *common.Timing                    `json:"frequency,omitempty"`
-	LastPerformed     *string                           `json:"lastPerformed,omitempty"`
-	Need              *common.CodeableConcept           `json:"need,omitempty"`
-	NextScheduled     *string                           `json:"nextScheduled,omitempty"`
-	PrimarySource     []VerificationResultPrimarySource `json:"primarySource,omitempty"`
-	Status            *common.CodeableConcept           `json:"status,omitempty"`
-	StatusDate        *string                           `json:"statusDate,omitempty"`
-	Target            []common.Reference                `json:"target,omitempty"`
-	TargetLocation    []string                          `json:"targetLocation,omitempty"`
-	Validator         []VerificationResultValidator     `json:"validator,omitempty"`
-	ValidationProcess []common.CodeableConcept          `json:"validationProcess,omitempty"`
-	ValidationType    *common.CodeableConcept           `json:"validationType,omitempty"`
+	Attestation           *VerificationResultAttestation    `json:"attestation,omitempty"`
+	FailureAction         *common.CodeableConcept           `json:"failureAction,omitempty"`
+	Frequency             *common.Timing                    `json:"frequency,omitempty"`
+	LastPerformed         *common.DateTime                  `json:"lastPerformed,omitempty"`
+	LastPerformedElement  *common.Element                   `json:"_lastPerformed,omitempty"`
+	Need                  *common.CodeableConcept           `json:"need,omitempty"`
+	NextScheduled         *common.Date                      `json:"nextScheduled,omitempty"`
+	NextScheduledElement  *common.Element                   `json:"_nextScheduled,omitempty"`
+	PrimarySource         []VerificationResultPrimarySource `json:"primarySource,omitempty"`
+	Status                *common.CodeableConcept           `json:"status,omitempty"`
+	StatusDate            *common.DateTime                  `json:"statusDate,omitempty"`
+	StatusDateElement     *common.Element                   `json:"_statusDate,omitempty"`
+	Target                []common.Reference                `json:"target,omitempty"`
+	TargetLocation        []string                          `json:"targetLocation,omitempty"`
+	TargetLocationElement []*common.Element                 `json:"_targetLocation,omitempty"`
+	Validator             []VerificationResultValidator     `json:"validator,omitempty"`
+	ValidationProcess     []common.CodeableConcept          `json:"validationProcess,omitempty"`
+	ValidationType        *common.CodeableConcept           `json:"validationType,omitempty"`
 }
 
 type VerificationResultPrimarySource struct {
 	common.BackboneElement
 
-	CanPushUpdates      *common.CodeableConcept  `json:"canPushUpdates,omitempty"`
-	CommunicationMethod []common.CodeableConcept `json:"communicationMethod,omitempty"`
-	PushTypeAvailable   []common.CodeableConcept `json:"pushTypeAvailable,omitempty"`
-	Type                []common.CodeableConcept `json:"type,omitempty"`
-	ValidationDate      *string                  `json:"validationDate,omitempty"`
-	ValidationStatus    *common.CodeableConcept  `json:"validationStatus,omitempty"`
-	Who                 *common.Reference        `json:"who,omitempty"`
+	CanPushUpdates        *common.CodeableConcept  `json:"canPushUpdates,omitempty"`
+	CommunicationMethod   []common.CodeableConcept `json:"communicationMethod,omitempty"`
+	PushTypeAvailable     []common.CodeableConcept `json:"pushTypeAvailable,omitempty"`
+	Type                  []common.CodeableConcept `json:"type,omitempty"`
+	ValidationDate        *common.DateTime         `json:"validationDate,omitempty"`
+	ValidationDateElement *common.Element          `json:"_validationDate,omitempty"`
+	ValidationStatus      *common.CodeableConcept  `json:"validationStatus,omitempty"`
+	Who                   *common.Reference        `json:"who,omitempty"`
 }
 
 type VerificationResultAttestation struct {
 	common.BackboneElement
 
-	CommunicationMethod       *common.CodeableConcept `json:"communicationMethod,omitempty"`
-	Date                      *string                 `json:"date,omitempty"`
-	OnBehalfOf                *common.Reference       `json:"onBehalfOf,omitempty"`
-	ProxyIdentityCertificate  *string                 `json:"proxyIdentityCertificate,omitempty"`
-	ProxySignature            *common.Signature       `json:"proxySignature,omitempty"`
-	SourceIdentityCertificate *string                 `json:"sourceIdentityCertificate,omitempty"`
-	SourceSignature           *common.Signature       `json:"sourceSignature,omitempty"`
-	Who                       *common.Reference       `json:"who,omitempty"`
+	CommunicationMethod              *common.CodeableConcept `json:"communicationMethod,omitempty"`
+	Date                             *common.Date            `json:"date,omitempty"`
+	DateElement                      *common.Element         `json:"_date,omitempty"`
+	OnBehalfOf                       *common.Reference       `json:"onBehalfOf,omitempty"`
+	ProxyIdentityCertificate         *string                 `json:"proxyIdentityCertificate,omitempty"`
+	ProxyIdentityCertificateElement  *common.Element         `json:"_proxyIdentityCertificate,omitempty"`
+	ProxySignature                   *common.Signature       `json:"proxySignature,omitempty"`
+	SourceIdentityCertificate        *string                 `json:"sourceIdentityCertificate,omitempty"`
+	SourceIdentityCertificateElement *common.Element         `json:"_sourceIdentityCertificate,omitempty"`
+	SourceSignature                  *common.Signature       `json:"sourceSignature,omitempty"`
+	Who                              *common.Reference       `json:"who,omitempty"`
 }
 
 type VerificationResultValidator struct {
 	common.BackboneElement
 
-	AttestationSignature *common.Signature `json:"attestationSignature,omitempty"`
-	IdentityCertificate  *string           `json:"identityCertificate,omitempty"`
-	Organization         common.Reference  `json:"organization"`
+	AttestationSignature       *common.Signature `json:"attestationSignature,omitempty"`
+	IdentityCertificate        *string           `json:"identityCertificate,omitempty"`
+	IdentityCertificateElement *common.Element   `json:"_identityCertificate,omitempty"`
+	Organization               common.Reference  `json:"organization"`
 }
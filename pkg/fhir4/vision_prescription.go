@@ -10,38 +10,53 @@ type VisionPrescription struct {
 
 	ResourceType string `json:"resourceType"` // Always "VisionPrescription"
 
-	Created           string                                `json:"created"`
-	DateWritten       string                                `json:"dateWritten"`
-	Encounter         *common.Reference                     `json:"encounter,omitempty"`
-	Identifier        []common.Identifier                   `json:"identifier,omitempty"`
-	LensSpecification []VisionPrescriptionLensSpecification `json:"lensSpecification"`
-	Patient           common.Reference                      `json:"patient"`
-	Prescriber        common.Reference                      `json:"prescriber"`
-	Status            string                                `json:"status"` // "active" | "cancelled" | "draft" | "entered-in-error"
+	Created            common.DateTime                       `json:"created"`
+	CreatedElement     *common.Element                       `json:"_created,omitempty"`
+	DateWritten        common.DateTime                       `json:"dateWritten"`
+	DateWrittenElement *common.Element                       `json:"_dateWritten,omitempty"`
+	Encounter          *common.Reference                     `json:"encounter,omitempty"`
+	Identifier         []common.Identifier                   `json:"identifier,omitempty"`
+	LensSpecification  []VisionPrescriptionLensSpecification `json:"lensSpecification"`
+	Patient            common.Reference                      `json:"patient"`
+	Prescriber         common.Reference                      `json:"prescriber"`
+	Status             string                                `json:"status"` // "active" | "cancelled" | "draft" | "entered-in-error"
+	StatusElement      *common.Element                       `json:"_status,omitempty"`
 }
 
 type VisionPrescriptionLensSpecification struct {
 	common.BackboneElement
 
-	Add       *float64                                   `json:"add,omitempty"`
-	Axis      *int                                       `json:"axis,omitempty"`
-	BackCurve *float64                                   `json:"backCurve,omitempty"`
-	Brand     *string                                    `json:"brand,omitempty"`
-	Color     *string                                    `json:"color,omitempty"`
-	Cylinder  *float64                                   `json:"cylinder,omitempty"`
-	Diameter  *float64                                   `json:"diameter,omitempty"`
-	Duration  *common.Quantity                           `json:"duration,omitempty"`
-	Eye       string                                     `json:"eye"` // "right" | "left"
-	Note      []common.Annotation                        `json:"note,omitempty"`
-	Power     *float64                                   `json:"power,omitempty"`
-	Prism     []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty"`
-	Product   common.CodeableConcept                     `json:"product"`
-	Sphere    *float64                                   `json:"sphere,omitempty"`
+	Add              *common.Decimal                            `json:"add,omitempty"`
+	AddElement       *common.Element                            `json:"_add,omitempty"`
+	Axis             *int                                       `json:"axis,omitempty"`
+	AxisElement      *common.Element                            `json:"_axis,omitempty"`
+	BackCurve        *common.Decimal                            `json:"backCurve,omitempty"`
+	BackCurveElement *common.Element                            `json:"_backCurve,omitempty"`
+	Brand            *string                                    `json:"brand,omitempty"`
+	BrandElement     *common.Element                            `json:"_brand,omitempty"`
+	Color            *string                                    `json:"color,omitempty"`
+	ColorElement     *common.Element                            `json:"_color,omitempty"`
+	Cylinder         *common.Decimal                            `json:"cylinder,omitempty"`
+	CylinderElement  *common.Element                            `json:"_cylinder,omitempty"`
+	Diameter         *common.Decimal                            `json:"diameter,omitempty"`
+	DiameterElement  *common.Element                            `json:"_diameter,omitempty"`
+	Duration         *common.Quantity                           `json:"duration,omitempty"`
+	Eye              string                                     `json:"eye"` // "right" | "left"
+	EyeElement       *common.Element                            `json:"_eye,omitempty"`
+	Note             []common.Annotation                        `json:"note,omitempty"`
+	Power            *common.Decimal                            `json:"power,omitempty"`
+	PowerElement     *common.Element                            `json:"_power,omitempty"`
+	Prism            []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty"`
+	Product          common.CodeableConcept                     `json:"product"`
+	Sphere           *common.Decimal                            `json:"sphere,omitempty"`
+	SphereElement    *common.Element                            `json:"_sphere,omitempty"`
 }
 
 type VisionPrescriptionLensSpecificationPrism struct {
 	common.BackboneElement
 
-	Amount float64 `json:"amount"`
-	Base   string  `json:"base"` // "up" | "down" | "in" | "out"
+	Amount        common.Decimal  `json:"amount"`
+	AmountElement *common.Element `json:"_amount,omitempty"`
+	Base          string          `json:"base"` // "up" | "down" | "in" | "out"
+	BaseElement   *common.Element `json:"_base,omitempty"`
 }
@@ -0,0 +1,78 @@
+package fhir4_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/convert"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+// knownDifferences are the converted examples the R4 structs cannot represent yet
+var knownDifferences = map[string]string{
+	"explanationofbenefit-example.json":   "ExplanationOfBenefit.insurance has a sequence element that R4 does not define",
+	"explanationofbenefit-example-2.json": "ExplanationOfBenefit.insurance has a sequence element that R4 does not define",
+	"implementationguide-example.json":    "ImplementationGuide.definition.page has name instead of name[x]",
+	"organization-example.json":           "the R4 XML codec does not know the R5 datatype valueAvailability",
+}
+
+// TestXML_RoundTrip converts the official R5 examples to R4, as there are no R4
+// examples in the repository, and round-trips them through XML
+func TestXML_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("../fhir5/testdata/fhir5-json/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+
+	definitions := test_utils.LoadElementDefinitions(t, "testdata/fhir4-definitions")
+	tested := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		r5, err := fhir5.UnmarshalResource(data)
+		if err != nil {
+			continue
+		}
+		resource, _, err := convert.R5ToR4(r5)
+		if err != nil {
+			// R5 resources without an R4 equivalent
+			continue
+		}
+		if reason, ok := knownDifferences[filepath.Base(file)]; ok {
+			t.Logf("skipping %s: %s", file, reason)
+			continue
+		}
+		tested++
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			expected, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			xmlData, err := fhir4.MarshalXML(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal XML: %v", err)
+			}
+			test_utils.CheckXMLElementOrder(t, xmlData, definitions)
+
+			decoded, err := fhir4.UnmarshalResourceXML(xmlData)
+			if err != nil {
+				t.Fatalf("failed to unmarshal XML: %v\n%s", err, xmlData)
+			}
+			actual, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			test_utils.CompareXMLRoundTrip(t, expected, actual, xmlData)
+		})
+	}
+	if tested == 0 {
+		t.Fatal("no example files were tested")
+	}
+}
@@ -0,0 +1,134 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AdministrableProductDefinition represents a medicinal product in the final form, suitable for administration - after any mixing of multiple components
+type AdministrableProductDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "AdministrableProductDefinition"
+
+	// The dose form of the final product after necessary reconstitution or processing
+	AdministrableDoseForm *common.CodeableConcept `json:"administrableDoseForm,omitempty"`
+
+	// A device that is integral to the medicinal product
+	Device *common.Reference `json:"device,omitempty"`
+
+	// References a product from which one or more of the constituent parts of that product can be prepared and used as described by this administrable product
+	FormOf []common.Reference `json:"formOf,omitempty"`
+
+	// An identifier for the administrable product
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The ingredients of this administrable medicinal product
+	Ingredient []common.CodeableConcept `json:"ingredient,omitempty"`
+
+	// Indicates the specific manufactured items that are part of the 'formOf' product that are used in the preparation of this specific administrable form
+	ProducedFrom []common.Reference `json:"producedFrom,omitempty"`
+
+	// Characteristics e.g. a product's onset of action
+	Property []AdministrableProductDefinitionProperty `json:"property,omitempty"`
+
+	// The path by which the product is taken into or makes contact with the body
+	RouteOfAdministration []AdministrableProductDefinitionRouteOfAdministration `json:"routeOfAdministration"`
+
+	// Allows filtering of administrable products that are appropriate for use versus not
+	Status        AdministrableProductDefinitionStatus `json:"status"`
+	StatusElement *common.Element                      `json:"_status,omitempty"`
+
+	// The presentation type in which this item is given to a patient
+	UnitOfPresentation *common.CodeableConcept `json:"unitOfPresentation,omitempty"`
+}
+
+// AdministrableProductDefinitionStatus represents the status of an administrable product definition
+type AdministrableProductDefinitionStatus string
+
+const (
+	AdministrableProductDefinitionStatusDraft   AdministrableProductDefinitionStatus = "draft"
+	AdministrableProductDefinitionStatusActive  AdministrableProductDefinitionStatus = "active"
+	AdministrableProductDefinitionStatusRetired AdministrableProductDefinitionStatus = "retired"
+	AdministrableProductDefinitionStatusUnknown AdministrableProductDefinitionStatus = "unknown"
+)
+
+// AdministrableProductDefinitionProperty represents characteristics e.g. a product's onset of action
+type AdministrableProductDefinitionProperty struct {
+	common.BackboneElement
+
+	// The status of characteristic e.g. assigned or pending
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// A code expressing the type of characteristic
+	Type common.CodeableConcept `json:"type"`
+
+	// A value for the characteristic
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// A value for the characteristic
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// A value for the characteristic
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// A value for the characteristic
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// A value for the characteristic
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+}
+
+// AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod represents a species specific time during which consumption of animal product is not appropriate
+type AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod struct {
+	common.BackboneElement
+
+	// Extra information about the withdrawal period
+	SupportingInformation        *string         `json:"supportingInformation,omitempty"`
+	SupportingInformationElement *common.Element `json:"_supportingInformation,omitempty"`
+
+	// Coded expression for the type of tissue for which the withdrawal period applies, e.g. meat, milk
+	Tissue common.CodeableConcept `json:"tissue"`
+
+	// A value for the time
+	Value common.Quantity `json:"value"`
+}
+
+// AdministrableProductDefinitionRouteOfAdministrationTargetSpecies represents a species for which this route applies
+type AdministrableProductDefinitionRouteOfAdministrationTargetSpecies struct {
+	common.BackboneElement
+
+	// Coded expression for the species
+	Code common.CodeableConcept `json:"code"`
+
+	// A species specific time during which consumption of animal product is not appropriate
+	WithdrawalPeriod []AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod `json:"withdrawalPeriod,omitempty"`
+}
+
+// AdministrableProductDefinitionRouteOfAdministration represents the path by which the product is taken into or makes contact with the body
+type AdministrableProductDefinitionRouteOfAdministration struct {
+	common.BackboneElement
+
+	// Coded expression for the route
+	Code common.CodeableConcept `json:"code"`
+
+	// The first dose (dose quantity) administered can be specified for the product
+	FirstDose *common.Quantity `json:"firstDose,omitempty"`
+
+	// The maximum dose per day (maximum dose quantity to be administered in any one 24-h period) that can be administered
+	MaxDosePerDay *common.Quantity `json:"maxDosePerDay,omitempty"`
+
+	// The maximum dose per treatment period that can be administered
+	MaxDosePerTreatmentPeriod *Ratio `json:"maxDosePerTreatmentPeriod,omitempty"`
+
+	// The maximum single dose that can be administered, specified using a numerical value and its unit of measurement
+	MaxSingleDose *common.Quantity `json:"maxSingleDose,omitempty"`
+
+	// The maximum treatment period during which the product can be administered
+	MaxTreatmentPeriod *Duration `json:"maxTreatmentPeriod,omitempty"`
+
+	// A species for which this route applies
+	TargetSpecies []AdministrableProductDefinitionRouteOfAdministrationTargetSpecies `json:"targetSpecies,omitempty"`
+}
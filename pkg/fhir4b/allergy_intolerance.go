@@ -0,0 +1,145 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AllergyIntolerance represents risk of harmful or undesirable, physiological response which is unique to an individual and associated with exposure to a substance
+type AllergyIntolerance struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "AllergyIntolerance"
+
+	// Source of the information about the allergy
+	Asserter *common.Reference `json:"asserter,omitempty"`
+
+	// food | medication | environment | biologic
+	Category        []AllergyIntoleranceCategory `json:"category,omitempty"`
+	CategoryElement []*common.Element            `json:"_category,omitempty"`
+
+	// active | inactive | resolved
+	ClinicalStatus *common.CodeableConcept `json:"clinicalStatus,omitempty"`
+
+	// Code that identifies the allergy or intolerance
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// low | high | unable-to-assess
+	Criticality        *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	CriticalityElement *common.Element                `json:"_criticality,omitempty"`
+
+	// Encounter when the allergy or intolerance was asserted
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// External ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Date(/time) of last known occurrence of a reaction
+	LastOccurrence        *common.DateTime `json:"lastOccurrence,omitempty"`
+	LastOccurrenceElement *common.Element  `json:"_lastOccurrence,omitempty"`
+
+	// Additional text not captured in other fields
+	Note []Annotation `json:"note,omitempty"`
+
+	// Date first version of the resource instance was recorded
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+
+	// Date first version of the resource instance was recorded
+	OnsetAge *Age `json:"onsetAge,omitempty"`
+
+	// Date first version of the resource instance was recorded
+	OnsetPeriod *common.Period `json:"onsetPeriod,omitempty"`
+
+	// Date first version of the resource instance was recorded
+	OnsetRange *Range `json:"onsetRange,omitempty"`
+
+	// Date first version of the resource instance was recorded
+	OnsetString        *string         `json:"onsetString,omitempty"`
+	OnsetStringElement *common.Element `json:"_onsetString,omitempty"`
+
+	// Who the sensitivity is for
+	Patient common.Reference `json:"patient"`
+
+	// Adverse Reaction Events linked to exposure to substance
+	Reaction []AllergyIntoleranceReaction `json:"reaction,omitempty"`
+
+	// Date(/time) of last known occurrence of a reaction
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
+
+	// Who recorded the sensitivity
+	Recorder *common.Reference `json:"recorder,omitempty"`
+
+	// allergy | intolerance - Underlying mechanism (if known)
+	Type        *AllergyIntoleranceType `json:"type,omitempty"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
+
+	// unconfirmed | confirmed | refuted | entered-in-error
+	VerificationStatus *common.CodeableConcept `json:"verificationStatus,omitempty"`
+}
+
+// AllergyIntoleranceCategory represents the category of allergy intolerance
+type AllergyIntoleranceCategory string
+
+const (
+	AllergyIntoleranceCategoryFood        AllergyIntoleranceCategory = "food"
+	AllergyIntoleranceCategoryMedication  AllergyIntoleranceCategory = "medication"
+	AllergyIntoleranceCategoryEnvironment AllergyIntoleranceCategory = "environment"
+	AllergyIntoleranceCategoryBiologic    AllergyIntoleranceCategory = "biologic"
+)
+
+// AllergyIntoleranceCriticality represents the criticality of allergy intolerance
+type AllergyIntoleranceCriticality string
+
+const (
+	AllergyIntoleranceCriticalityLow            AllergyIntoleranceCriticality = "low"
+	AllergyIntoleranceCriticalityHigh           AllergyIntoleranceCriticality = "high"
+	AllergyIntoleranceCriticalityUnableToAssess AllergyIntoleranceCriticality = "unable-to-assess"
+)
+
+// AllergyIntoleranceType represents the type of allergy intolerance
+type AllergyIntoleranceType string
+
+const (
+	AllergyIntoleranceTypeAllergy     AllergyIntoleranceType = "allergy"
+	AllergyIntoleranceTypeIntolerance AllergyIntoleranceType = "intolerance"
+)
+
+// AllergyIntoleranceReaction represents a reaction event linked to exposure to substance
+type AllergyIntoleranceReaction struct {
+	common.BackboneElement
+
+	// Description of the event as a whole
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// How the subject was exposed to the substance
+	ExposureRoute *common.CodeableConcept `json:"exposureRoute,omitempty"`
+
+	// Clinical symptoms/signs associated with the Event
+	Manifestation []common.CodeableConcept `json:"manifestation"`
+
+	// Text about event not captured in other fields
+	Note []Annotation `json:"note,omitempty"`
+
+	// Date(/time) when manifestations showed
+	Onset        *common.DateTime `json:"onset,omitempty"`
+	OnsetElement *common.Element  `json:"_onset,omitempty"`
+
+	// mild | moderate | severe (of event as a whole)
+	Severity        *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
+
+	// Specific substance or pharmaceutical product considered to be responsible
+	Substance *common.CodeableConcept `json:"substance,omitempty"`
+}
+
+// AllergyIntoleranceReactionSeverity represents the severity of a reaction event
+type AllergyIntoleranceReactionSeverity string
+
+const (
+	AllergyIntoleranceReactionSeverityMild     AllergyIntoleranceReactionSeverity = "mild"
+	AllergyIntoleranceReactionSeverityModerate AllergyIntoleranceReactionSeverity = "moderate"
+	AllergyIntoleranceReactionSeveritySevere   AllergyIntoleranceReactionSeverity = "severe"
+)
@@ -0,0 +1,145 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Appointment represents a booking of a healthcare event among patient(s), practitioner(s), related person(s) and/or device(s) for a specific date/time
+type Appointment struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Appointment"
+
+	// The style of appointment or patient that has been booked in the slot (not service type)
+	AppointmentType *common.CodeableConcept `json:"appointmentType,omitempty"`
+
+	// The service request this appointment is allocated to assess
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// The coded reason for the appointment being cancelled
+	CancelationReason *common.CodeableConcept `json:"cancelationReason,omitempty"`
+
+	// Additional comments about the appointment
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// The date that this appointment was initially created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// The brief description of the appointment as would be shown on a subject line in a meeting request, or appointment list
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Date/Time that the appointment is to conclude
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// This records identifiers associated with this appointment concern that are defined by business processes and/or used to refer to it when a direct URL reference to the resource itself is not appropriate
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Number of minutes that the appointment is to take
+	MinutesDuration        *int            `json:"minutesDuration,omitempty"`
+	MinutesDurationElement *common.Element `json:"_minutesDuration,omitempty"`
+
+	// List of participants involved in the appointment
+	Participant []AppointmentParticipant `json:"participant"`
+
+	// While appointments can be made for a specific patient, they are often made for a group of patients
+	PatientInstruction        *string         `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *common.Element `json:"_patientInstruction,omitempty"`
+
+	// The priority of the appointment. Can be used to make informed decisions if needing to re-prioritize appointments
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// The coded reason that this appointment is being scheduled
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Reason the appointment has been scheduled, as specified using information from another resource
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// A set of date ranges (potentially including times) that the appointment is preferred to be scheduled within
+	RequestedPeriod []common.Period `json:"requestedPeriod,omitempty"`
+
+	// A broad categorization of the service that is to be performed during this appointment
+	ServiceCategory []common.CodeableConcept `json:"serviceCategory,omitempty"`
+
+	// The specific service that is to be performed during this appointment
+	ServiceType []common.CodeableConcept `json:"serviceType,omitempty"`
+
+	// The slots that this appointment is filling
+	Slot []common.Reference `json:"slot,omitempty"`
+
+	// The specialty of a practitioner that would be required to perform the service requested in this appointment
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+
+	// Date/Time that the appointment is to take place
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// The overall status of the Appointment. Each of the participants has their own participation status which indicates their involvement in the process, however this status indicates the shared status
+	Status        AppointmentStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// Additional information to support the appointment
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+}
+
+// AppointmentStatus represents the status of an appointment
+type AppointmentStatus string
+
+const (
+	AppointmentStatusProposed       AppointmentStatus = "proposed"
+	AppointmentStatusPending        AppointmentStatus = "pending"
+	AppointmentStatusBooked         AppointmentStatus = "booked"
+	AppointmentStatusArrived        AppointmentStatus = "arrived"
+	AppointmentStatusFulfilled      AppointmentStatus = "fulfilled"
+	AppointmentStatusCancelled      AppointmentStatus = "cancelled"
+	AppointmentStatusNoshow         AppointmentStatus = "noshow"
+	AppointmentStatusEnteredInError AppointmentStatus = "entered-in-error"
+	AppointmentStatusCheckedIn      AppointmentStatus = "checked-in"
+	AppointmentStatusWaitlist       AppointmentStatus = "waitlist"
+)
+
+// AppointmentParticipant represents participants involved in the appointment
+type AppointmentParticipant struct {
+	common.BackboneElement
+
+	// A Person, Location/HealthcareService or Device that is participating in the appointment
+	Actor *common.Reference `json:"actor,omitempty"`
+
+	// Period of participation during the appointment
+	Period *common.Period `json:"period,omitempty"`
+
+	// Whether this participant is required to be present at the meeting
+	Required        *AppointmentParticipantRequired `json:"required,omitempty"`
+	RequiredElement *common.Element                 `json:"_required,omitempty"`
+
+	// Participation status of the actor
+	Status        AppointmentParticipantStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
+
+	// Role of participant in the appointment
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// AppointmentParticipantRequired represents the required of an appointment participant
+type AppointmentParticipantRequired string
+
+const (
+	AppointmentParticipantRequiredRequired        AppointmentParticipantRequired = "required"
+	AppointmentParticipantRequiredOptional        AppointmentParticipantRequired = "optional"
+	AppointmentParticipantRequiredInformationOnly AppointmentParticipantRequired = "information-only"
+)
+
+// AppointmentParticipantStatus represents the participation status of a participant
+type AppointmentParticipantStatus string
+
+const (
+	AppointmentParticipantStatusAccepted    AppointmentParticipantStatus = "accepted"
+	AppointmentParticipantStatusDeclined    AppointmentParticipantStatus = "declined"
+	AppointmentParticipantStatusTentative   AppointmentParticipantStatus = "tentative"
+	AppointmentParticipantStatusNeedsAction AppointmentParticipantStatus = "needs-action"
+)
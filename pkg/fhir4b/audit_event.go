@@ -0,0 +1,172 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// AuditEvent represents a record of an event made for purposes of maintaining a security log
+type AuditEvent struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "AuditEvent"
+
+	// Indicator for type of action performed during the event that generated the audit
+	Action        *string         `json:"action,omitempty"`
+	ActionElement *common.Element `json:"_action,omitempty"`
+
+	// An actor taking an active role in the event or activity that is logged
+	Agent []AuditEventAgent `json:"agent"`
+
+	// Specific instances of data or objects that have been accessed
+	Entity []AuditEventEntity `json:"entity,omitempty"`
+
+	// Indicates whether the event succeeded or failed
+	Outcome        *string         `json:"outcome,omitempty"`
+	OutcomeElement *common.Element `json:"_outcome,omitempty"`
+
+	// A free text description of the outcome of the event
+	OutcomeDesc        *string         `json:"outcomeDesc,omitempty"`
+	OutcomeDescElement *common.Element `json:"_outcomeDesc,omitempty"`
+
+	// The period during which the recorded activity occurred
+	Period *common.Period `json:"period,omitempty"`
+
+	// The purposeOfUse (reason) that was used during the event being recorded
+	PurposeOfEvent []common.CodeableConcept `json:"purposeOfEvent,omitempty"`
+
+	// The time when the event was recorded
+	Recorded        common.Instant  `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
+
+	// The system that is reporting the event
+	Source AuditEventSource `json:"source"`
+
+	// Identifier for the category of event
+	Subtype []common.Coding `json:"subtype,omitempty"`
+
+	// Identifier for a family of the event
+	Type common.Coding `json:"type"`
+}
+
+// AuditEventAgentNetwork represents logical network location for application activity
+type AuditEventAgentNetwork struct {
+	common.BackboneElement
+
+	// An identifier for the network access point of the user device for the audit event
+	Address        *string         `json:"address,omitempty"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	// An identifier for the type of network access point that originated the audit event
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// AuditEventAgent represents an agent involved in the event
+type AuditEventAgent struct {
+	common.BackboneElement
+
+	// Alternative agent Identifier. For a human, this should be the user ID
+	AltID        *string         `json:"altId,omitempty"`
+	AltIDElement *common.Element `json:"_altId,omitempty"`
+
+	// Where the event occurred
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Type of media involved
+	Media *common.Coding `json:"media,omitempty"`
+
+	// Human-meaningful name for the agent
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Logical network location for application activity, if the activity has a network location
+	Network *AuditEventAgentNetwork `json:"network,omitempty"`
+
+	// The policy or plan that authorized the activity being recorded
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
+
+	// The reason (purpose of use) that was used during the event being recorded
+	PurposeOfUse []common.CodeableConcept `json:"purposeOfUse,omitempty"`
+
+	// Indicator that the user is or is not the requestor, or initiator, for the event being audited
+	Requestor        bool            `json:"requestor"`
+	RequestorElement *common.Element `json:"_requestor,omitempty"`
+
+	// The security role that the user was acting under, that come from local codes defined by the access control security system (e.g. RBAC, ABAC) used in the local context
+	Role []common.CodeableConcept `json:"role,omitempty"`
+
+	// Specification of the participation type the user plays when performing the event
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Direct reference to a resource that identifies the agent
+	Who *common.Reference `json:"who,omitempty"`
+}
+
+// AuditEventSource represents the source of the event
+type AuditEventSource struct {
+	common.BackboneElement
+
+	// Identifier of the source where the event originated
+	Observer common.Reference `json:"observer"`
+
+	// Logical source location within the healthcare enterprise network
+	Site        *string         `json:"site,omitempty"`
+	SiteElement *common.Element `json:"_site,omitempty"`
+
+	// Code specifying the type of source where event originated
+	Type []common.Coding `json:"type,omitempty"`
+}
+
+// AuditEventEntityDetail represents additional information about the entity
+type AuditEventEntityDetail struct {
+	common.BackboneElement
+
+	// The type of extra detail provided in the value
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// The value of the extra detail
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// The value of the extra detail
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
+}
+
+// AuditEventEntity represents an entity involved in the event
+type AuditEventEntity struct {
+	common.BackboneElement
+
+	// Text that describes the entity in more detail
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Tagged value pairs for conveying additional information about the entity
+	Detail []AuditEventEntityDetail `json:"detail,omitempty"`
+
+	// This can be used to provide an audit trail for data, over time, as it passes through the system
+	Lifecycle *common.Coding `json:"lifecycle,omitempty"`
+
+	// A value that describes the entity
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The query parameters for a query-type entities
+	Query        *string         `json:"query,omitempty"`
+	QueryElement *common.Element `json:"_query,omitempty"`
+
+	// Code representing the role the entity played in the event being audited
+	Role *common.Coding `json:"role,omitempty"`
+
+	// Security labels on the entity
+	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
+
+	// Code representing the role the entity played in the event being audited
+	Type *common.Coding `json:"type,omitempty"`
+
+	// Identifies a specific instance of the entity
+	What *common.Reference `json:"what,omitempty"`
+}
@@ -0,0 +1,24 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Binary represents a binary resource that contains content
+type Binary struct {
+	Resource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Binary"
+
+	// MimeType of the binary content represented as a standard MimeType (BCP 13)
+	ContentType        string          `json:"contentType"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
+
+	// The actual content, base64 encoded
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
+
+	// Very often, a server will also know of a resource that references the binary
+	SecurityContext *common.Reference `json:"securityContext,omitempty"`
+}
@@ -0,0 +1,153 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// BiologicallyDerivedProduct represents a material substance originating from a biological entity intended to be transplanted or infused into another (possibly the same) biological entity
+type BiologicallyDerivedProduct struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "BiologicallyDerivedProduct"
+
+	// How this product was collected
+	Collection *BiologicallyDerivedProductCollection `json:"collection,omitempty"`
+
+	// This records identifiers associated with this biologically derived product that are defined by business processes and/or used to refer to it when a direct URL reference to the resource itself is not appropriate
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Any manipulation of product post-collection
+	Manipulation *BiologicallyDerivedProductManipulation `json:"manipulation,omitempty"`
+
+	// Parent product
+	Parent []common.Reference `json:"parent,omitempty"`
+
+	// Any processing of the product during collection
+	Processing []BiologicallyDerivedProductProcessing `json:"processing,omitempty"`
+
+	// Broad category of this product
+	ProductCategory        *BiologicallyDerivedProductCategory `json:"productCategory,omitempty"`
+	ProductCategoryElement *common.Element                     `json:"_productCategory,omitempty"`
+
+	// A code that identifies the kind of this biologically derived product (SNOMED Ctcode)
+	ProductCode *common.CodeableConcept `json:"productCode,omitempty"`
+
+	// Number of discrete units within this product
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
+
+	// Procedure request to obtain this biologically derived product
+	Request []common.Reference `json:"request,omitempty"`
+
+	// Whether the product is currently available
+	Status        *BiologicallyDerivedProductStatus `json:"status,omitempty"`
+	StatusElement *common.Element                   `json:"_status,omitempty"`
+
+	// Product storage
+	Storage []BiologicallyDerivedProductStorage `json:"storage,omitempty"`
+}
+
+// BiologicallyDerivedProductCategory represents the category of the biologically derived product
+type BiologicallyDerivedProductCategory string
+
+const (
+	BiologicallyDerivedProductCategoryOrgan           BiologicallyDerivedProductCategory = "organ"
+	BiologicallyDerivedProductCategoryTissue          BiologicallyDerivedProductCategory = "tissue"
+	BiologicallyDerivedProductCategoryFluid           BiologicallyDerivedProductCategory = "fluid"
+	BiologicallyDerivedProductCategoryCells           BiologicallyDerivedProductCategory = "cells"
+	BiologicallyDerivedProductCategoryBiologicalAgent BiologicallyDerivedProductCategory = "biologicalAgent"
+)
+
+// BiologicallyDerivedProductStatus represents the status of the biologically derived product
+type BiologicallyDerivedProductStatus string
+
+const (
+	BiologicallyDerivedProductStatusAvailable   BiologicallyDerivedProductStatus = "available"
+	BiologicallyDerivedProductStatusUnavailable BiologicallyDerivedProductStatus = "unavailable"
+)
+
+// BiologicallyDerivedProductCollection represents collection information
+type BiologicallyDerivedProductCollection struct {
+	common.BackboneElement
+
+	// Time of product collection
+	CollectedDateTime        *common.DateTime `json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *common.Element  `json:"_collectedDateTime,omitempty"`
+
+	// Time of product collection
+	CollectedPeriod *common.Period `json:"collectedPeriod,omitempty"`
+
+	// Healthcare professional who is performing the collection
+	Collector *common.Reference `json:"collector,omitempty"`
+
+	// The patient or entity, such as a hospital or vendor in the case of a processed/manipulated/manufactured product, providing the product
+	Source *common.Reference `json:"source,omitempty"`
+}
+
+// BiologicallyDerivedProductProcessing represents processing information
+type BiologicallyDerivedProductProcessing struct {
+	common.BackboneElement
+
+	// Substance added during processing
+	Additive *common.Reference `json:"additive,omitempty"`
+
+	// Description of of processing
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Procesing code
+	Procedure *common.CodeableConcept `json:"procedure,omitempty"`
+
+	// Time of processing
+	TimeDateTime        *common.DateTime `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element  `json:"_timeDateTime,omitempty"`
+
+	// Time of processing
+	TimePeriod *common.Period `json:"timePeriod,omitempty"`
+}
+
+// BiologicallyDerivedProductManipulation represents manipulation information
+type BiologicallyDerivedProductManipulation struct {
+	common.BackboneElement
+
+	// Description of manipulation
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Time of manipulation
+	TimeDateTime        *common.DateTime `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element  `json:"_timeDateTime,omitempty"`
+
+	// Time of manipulation
+	TimePeriod *common.Period `json:"timePeriod,omitempty"`
+}
+
+// BiologicallyDerivedProductStorage represents storage information
+type BiologicallyDerivedProductStorage struct {
+	common.BackboneElement
+
+	// Description of storage
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Storage timeperiod
+	Duration *common.Period `json:"duration,omitempty"`
+
+	// Temperature scale used
+	Scale        *BiologicallyDerivedProductStorageScale `json:"scale,omitempty"`
+	ScaleElement *common.Element                         `json:"_scale,omitempty"`
+
+	// Storage temperature
+	Temperature        *common.Decimal `json:"temperature,omitempty"`
+	TemperatureElement *common.Element `json:"_temperature,omitempty"`
+}
+
+// BiologicallyDerivedProductStorageScale represents the scale of a biologically derived product storage
+type BiologicallyDerivedProductStorageScale string
+
+const (
+	BiologicallyDerivedProductStorageScaleFarenheit BiologicallyDerivedProductStorageScale = "farenheit"
+	BiologicallyDerivedProductStorageScaleCelsius   BiologicallyDerivedProductStorageScale = "celsius"
+	BiologicallyDerivedProductStorageScaleKelvin    BiologicallyDerivedProductStorageScale = "kelvin"
+)
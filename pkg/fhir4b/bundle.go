@@ -0,0 +1,218 @@
+package fhir4b
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Bundle represents a container for a collection of resources
+type Bundle struct {
+	Resource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Bundle"
+
+	// An entry in a bundle resource
+	Entry []BundleEntry `json:"entry,omitempty"`
+
+	// Persistent identity generally only matters for batches of type Document, Message, and Collection
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// A series of links that provide context to this bundle
+	Link []BundleLink `json:"link,omitempty"`
+
+	// Digital Signature
+	Signature *common.Signature `json:"signature,omitempty"`
+
+	// Optional timestamp when this bundle was assembled
+	Timestamp        *common.Instant `json:"timestamp,omitempty"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+
+	// If a set of search matches, this is the total number of matches for the search
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
+
+	// Identifies the purpose of this bundle
+	Type        BundleType      `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// BundleType represents the type of bundle
+type BundleType string
+
+const (
+	BundleTypeDocument            BundleType = "document"
+	BundleTypeMessage             BundleType = "message"
+	BundleTypeTransaction         BundleType = "transaction"
+	BundleTypeTransactionResponse BundleType = "transaction-response"
+	BundleTypeBatch               BundleType = "batch"
+	BundleTypeBatchResponse       BundleType = "batch-response"
+	BundleTypeHistory             BundleType = "history"
+	BundleTypeSearchset           BundleType = "searchset"
+	BundleTypeCollection          BundleType = "collection"
+)
+
+// BundleLink represents a link in a bundle
+type BundleLink struct {
+	common.BackboneElement
+
+	// A name which details the functional use for this link
+	Relation        string          `json:"relation"`
+	RelationElement *common.Element `json:"_relation,omitempty"`
+
+	// The reference details for the link
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// BundleEntrySearch represents search information for a bundle entry
+type BundleEntrySearch struct {
+	common.BackboneElement
+
+	// search | match | include | outcome - why this entry is in the result set
+	Mode        *BundleEntrySearchMode `json:"mode,omitempty"`
+	ModeElement *common.Element        `json:"_mode,omitempty"`
+
+	// Search ranking (between 0..1)
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
+}
+
+// BundleEntrySearchMode represents the mode of a bundle entry search
+type BundleEntrySearchMode string
+
+const (
+	BundleEntrySearchModeMatch   BundleEntrySearchMode = "match"
+	BundleEntrySearchModeInclude BundleEntrySearchMode = "include"
+	BundleEntrySearchModeOutcome BundleEntrySearchMode = "outcome"
+)
+
+// BundleEntryRequest represents request information for a bundle entry
+type BundleEntryRequest struct {
+	common.BackboneElement
+
+	// For managing update contention
+	IfMatch        *string         `json:"ifMatch,omitempty"`
+	IfMatchElement *common.Element `json:"_ifMatch,omitempty"`
+
+	// For managing cache currency
+	IfModifiedSince        *common.Instant `json:"ifModifiedSince,omitempty"`
+	IfModifiedSinceElement *common.Element `json:"_ifModifiedSince,omitempty"`
+
+	// For conditional creates
+	IfNoneExist        *string         `json:"ifNoneExist,omitempty"`
+	IfNoneExistElement *common.Element `json:"_ifNoneExist,omitempty"`
+
+	// For managing cache currency
+	IfNoneMatch        *string         `json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement *common.Element `json:"_ifNoneMatch,omitempty"`
+
+	// In a transaction or batch, this is the HTTP action to be executed for this entry
+	Method        BundleEntryRequestMethod `json:"method"`
+	MethodElement *common.Element          `json:"_method,omitempty"`
+
+	// URL for HTTP equivalent of this entry
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// BundleEntryRequestMethod represents the HTTP method for a bundle entry request
+type BundleEntryRequestMethod string
+
+const (
+	BundleEntryRequestMethodGET    BundleEntryRequestMethod = "GET"
+	BundleEntryRequestMethodHEAD   BundleEntryRequestMethod = "HEAD"
+	BundleEntryRequestMethodPOST   BundleEntryRequestMethod = "POST"
+	BundleEntryRequestMethodPUT    BundleEntryRequestMethod = "PUT"
+	BundleEntryRequestMethodDELETE BundleEntryRequestMethod = "DELETE"
+	BundleEntryRequestMethodPATCH  BundleEntryRequestMethod = "PATCH"
+)
+
+// BundleEntryResponse represents response information for a bundle entry
+type BundleEntryResponse struct {
+	common.BackboneElement
+
+	// The etag for the resource (if relevant)
+	Etag        *string         `json:"etag,omitempty"`
+	EtagElement *common.Element `json:"_etag,omitempty"`
+
+	// Server's date time modified
+	LastModified        *common.Instant `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element `json:"_lastModified,omitempty"`
+
+	// The location header created by processing this operation
+	Location        *string         `json:"location,omitempty"`
+	LocationElement *common.Element `json:"_location,omitempty"`
+
+	// OperationOutcome with hints and warnings (for batch/transaction)
+	Outcome common.Resource `json:"outcome,omitempty"`
+
+	// Status response code (text optional)
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+}
+
+// BundleEntry represents an entry in a bundle
+type BundleEntry struct {
+	common.BackboneElement
+
+	// URI for resource (Absolute URL or relative URL)
+	FullURL        *string         `json:"fullUrl,omitempty"`
+	FullURLElement *common.Element `json:"_fullUrl,omitempty"`
+
+	// A series of links that provide context to this entry
+	Link []BundleLink `json:"link,omitempty"`
+
+	// Additional information about how this entry should be processed as part of a transaction or batch
+	Request *BundleEntryRequest `json:"request,omitempty"`
+
+	// The Resource for the entry
+	Resource common.Resource `json:"resource,omitempty"`
+
+	// Indicates the results of processing the corresponding 'request' entry
+	Response *BundleEntryResponse `json:"response,omitempty"`
+
+	// Information about the search process that lead to the creation of this entry
+	Search *BundleEntrySearch `json:"search,omitempty"`
+}
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (e *BundleEntry) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntry
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	e.Resource = resource
+	return nil
+}
+
+// UnmarshalJSON decodes the inline OperationOutcome into the struct matching its resourceType
+func (r *BundleEntryResponse) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntryResponse
+	aux := &struct {
+		*Alias
+		Outcome json.RawMessage `json:"outcome,omitempty"`
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Outcome)
+	if err != nil {
+		return err
+	}
+	r.Outcome = resource
+	return nil
+}
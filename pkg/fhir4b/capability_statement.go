@@ -0,0 +1,451 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CapabilityStatement represents a FHIR R4 CapabilityStatement resource
+type CapabilityStatement struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CapabilityStatement"
+
+	// Resource Type Name (for serialization)
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Document []CapabilityStatementDocument `json:"document,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Resource Type Name (for serialization)
+	FHIRVersion        string          `json:"fhirVersion"`
+	FHIRVersionElement *common.Element `json:"_fhirVersion,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Format        []string          `json:"format"`
+	FormatElement []*common.Element `json:"_format,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Implementation *CapabilityStatementImplementation `json:"implementation,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ImplementationGuide        []string          `json:"implementationGuide,omitempty"`
+	ImplementationGuideElement []*common.Element `json:"_implementationGuide,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Imports        []string          `json:"imports,omitempty"`
+	ImportsElement []*common.Element `json:"_imports,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Instantiates        []string          `json:"instantiates,omitempty"`
+	InstantiatesElement []*common.Element `json:"_instantiates,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Kind        CapabilityStatementKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Messaging []CapabilityStatementMessaging `json:"messaging,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Resource Type Name (for serialization)
+	PatchFormat        []string          `json:"patchFormat,omitempty"`
+	PatchFormatElement []*common.Element `json:"_patchFormat,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Rest []CapabilityStatementRest `json:"rest,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Software *CapabilityStatementSoftware `json:"software,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        CapabilityStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Resource Type Name (for serialization)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// Resource Type Name (for serialization)
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// CapabilityStatementKind represents the kind of a capability statement
+type CapabilityStatementKind string
+
+const (
+	CapabilityStatementKindInstance     CapabilityStatementKind = "instance"
+	CapabilityStatementKindCapability   CapabilityStatementKind = "capability"
+	CapabilityStatementKindRequirements CapabilityStatementKind = "requirements"
+)
+
+// CapabilityStatementStatus represents the status of a capability statement
+type CapabilityStatementStatus string
+
+const (
+	CapabilityStatementStatusDraft   CapabilityStatementStatus = "draft"
+	CapabilityStatementStatusActive  CapabilityStatementStatus = "active"
+	CapabilityStatementStatusRetired CapabilityStatementStatus = "retired"
+	CapabilityStatementStatusUnknown CapabilityStatementStatus = "unknown"
+)
+
+// CapabilityStatementSoftware represents software information
+type CapabilityStatementSoftware struct {
+	common.BackboneElement
+
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	ReleaseDate        *common.DateTime `json:"releaseDate,omitempty"`
+	ReleaseDateElement *common.Element  `json:"_releaseDate,omitempty"`
+
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// CapabilityStatementImplementation represents implementation details
+type CapabilityStatementImplementation struct {
+	common.BackboneElement
+
+	Custodian *common.Reference `json:"custodian,omitempty"`
+
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// CapabilityStatementRestSecurity represents security information
+type CapabilityStatementRestSecurity struct {
+	common.BackboneElement
+
+	Cors        *bool           `json:"cors,omitempty"`
+	CorsElement *common.Element `json:"_cors,omitempty"`
+
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	Service []common.CodeableConcept `json:"service,omitempty"`
+}
+
+// CapabilityStatementRestResourceInteraction represents resource interactions
+type CapabilityStatementRestResourceInteraction struct {
+	common.BackboneElement
+
+	Code        CapabilityStatementRestResourceInteractionCode `json:"code"`
+	CodeElement *common.Element                                `json:"_code,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+}
+
+// CapabilityStatementRestResourceInteractionCode represents the code of a capability statement rest resource interaction
+type CapabilityStatementRestResourceInteractionCode string
+
+const (
+	CapabilityStatementRestResourceInteractionCodeRead            CapabilityStatementRestResourceInteractionCode = "read"
+	CapabilityStatementRestResourceInteractionCodeVread           CapabilityStatementRestResourceInteractionCode = "vread"
+	CapabilityStatementRestResourceInteractionCodeUpdate          CapabilityStatementRestResourceInteractionCode = "update"
+	CapabilityStatementRestResourceInteractionCodePatch           CapabilityStatementRestResourceInteractionCode = "patch"
+	CapabilityStatementRestResourceInteractionCodeDelete          CapabilityStatementRestResourceInteractionCode = "delete"
+	CapabilityStatementRestResourceInteractionCodeHistoryInstance CapabilityStatementRestResourceInteractionCode = "history-instance"
+	CapabilityStatementRestResourceInteractionCodeHistoryType     CapabilityStatementRestResourceInteractionCode = "history-type"
+	CapabilityStatementRestResourceInteractionCodeCreate          CapabilityStatementRestResourceInteractionCode = "create"
+	CapabilityStatementRestResourceInteractionCodeSearchType      CapabilityStatementRestResourceInteractionCode = "search-type"
+)
+
+// CapabilityStatementRestResourceSearchParam represents search parameters
+type CapabilityStatementRestResourceSearchParam struct {
+	common.BackboneElement
+
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	Type        CapabilityStatementRestResourceSearchParamType `json:"type"`
+	TypeElement *common.Element                                `json:"_type,omitempty"`
+}
+
+// CapabilityStatementRestResourceSearchParamType represents the type of a capability statement rest resource search param
+type CapabilityStatementRestResourceSearchParamType string
+
+const (
+	CapabilityStatementRestResourceSearchParamTypeNumber    CapabilityStatementRestResourceSearchParamType = "number"
+	CapabilityStatementRestResourceSearchParamTypeDate      CapabilityStatementRestResourceSearchParamType = "date"
+	CapabilityStatementRestResourceSearchParamTypeString    CapabilityStatementRestResourceSearchParamType = "string"
+	CapabilityStatementRestResourceSearchParamTypeToken     CapabilityStatementRestResourceSearchParamType = "token"
+	CapabilityStatementRestResourceSearchParamTypeReference CapabilityStatementRestResourceSearchParamType = "reference"
+	CapabilityStatementRestResourceSearchParamTypeComposite CapabilityStatementRestResourceSearchParamType = "composite"
+	CapabilityStatementRestResourceSearchParamTypeQuantity  CapabilityStatementRestResourceSearchParamType = "quantity"
+	CapabilityStatementRestResourceSearchParamTypeUri       CapabilityStatementRestResourceSearchParamType = "uri"
+	CapabilityStatementRestResourceSearchParamTypeSpecial   CapabilityStatementRestResourceSearchParamType = "special"
+)
+
+// CapabilityStatementRestResourceOperation represents operations
+type CapabilityStatementRestResourceOperation struct {
+	common.BackboneElement
+
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// CapabilityStatementRestResource represents resource capabilities
+type CapabilityStatementRestResource struct {
+	common.BackboneElement
+
+	ConditionalCreate        *bool           `json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *common.Element `json:"_conditionalCreate,omitempty"`
+
+	ConditionalDelete        *CapabilityStatementRestResourceConditionalDelete `json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *common.Element                                   `json:"_conditionalDelete,omitempty"`
+
+	ConditionalRead        *CapabilityStatementRestResourceConditionalRead `json:"conditionalRead,omitempty"`
+	ConditionalReadElement *common.Element                                 `json:"_conditionalRead,omitempty"`
+
+	ConditionalUpdate        *bool           `json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *common.Element `json:"_conditionalUpdate,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Interaction []CapabilityStatementRestResourceInteraction `json:"interaction,omitempty"`
+
+	Operation []CapabilityStatementRestResourceOperation `json:"operation,omitempty"`
+
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
+
+	ReadHistory        *bool           `json:"readHistory,omitempty"`
+	ReadHistoryElement *common.Element `json:"_readHistory,omitempty"`
+
+	ReferencePolicy        []CapabilityStatementRestResourceReferencePolicy `json:"referencePolicy,omitempty"`
+	ReferencePolicyElement []*common.Element                                `json:"_referencePolicy,omitempty"`
+
+	SearchInclude        []string          `json:"searchInclude,omitempty"`
+	SearchIncludeElement []*common.Element `json:"_searchInclude,omitempty"`
+
+	SearchParam []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
+
+	SearchRevInclude        []string          `json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement []*common.Element `json:"_searchRevInclude,omitempty"`
+
+	SupportedProfile        []string          `json:"supportedProfile,omitempty"`
+	SupportedProfileElement []*common.Element `json:"_supportedProfile,omitempty"`
+
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	UpdateCreate        *bool           `json:"updateCreate,omitempty"`
+	UpdateCreateElement *common.Element `json:"_updateCreate,omitempty"`
+
+	Versioning        *CapabilityStatementRestResourceVersioning `json:"versioning,omitempty"`
+	VersioningElement *common.Element                            `json:"_versioning,omitempty"`
+}
+
+// CapabilityStatementRestResourceConditionalDelete represents the conditional delete of a capability statement rest resource
+type CapabilityStatementRestResourceConditionalDelete string
+
+const (
+	CapabilityStatementRestResourceConditionalDeleteNotSupported CapabilityStatementRestResourceConditionalDelete = "not-supported"
+	CapabilityStatementRestResourceConditionalDeleteSingle       CapabilityStatementRestResourceConditionalDelete = "single"
+	CapabilityStatementRestResourceConditionalDeleteMultiple     CapabilityStatementRestResourceConditionalDelete = "multiple"
+)
+
+// CapabilityStatementRestResourceConditionalRead represents the conditional read of a capability statement rest resource
+type CapabilityStatementRestResourceConditionalRead string
+
+const (
+	CapabilityStatementRestResourceConditionalReadNotSupported  CapabilityStatementRestResourceConditionalRead = "not-supported"
+	CapabilityStatementRestResourceConditionalReadModifiedSince CapabilityStatementRestResourceConditionalRead = "modified-since"
+	CapabilityStatementRestResourceConditionalReadNotMatch      CapabilityStatementRestResourceConditionalRead = "not-match"
+	CapabilityStatementRestResourceConditionalReadFullSupport   CapabilityStatementRestResourceConditionalRead = "full-support"
+)
+
+// CapabilityStatementRestResourceReferencePolicy represents the reference policy of a capability statement rest resource
+type CapabilityStatementRestResourceReferencePolicy string
+
+const (
+	CapabilityStatementRestResourceReferencePolicyLiteral  CapabilityStatementRestResourceReferencePolicy = "literal"
+	CapabilityStatementRestResourceReferencePolicyLogical  CapabilityStatementRestResourceReferencePolicy = "logical"
+	CapabilityStatementRestResourceReferencePolicyResolves CapabilityStatementRestResourceReferencePolicy = "resolves"
+	CapabilityStatementRestResourceReferencePolicyEnforced CapabilityStatementRestResourceReferencePolicy = "enforced"
+	CapabilityStatementRestResourceReferencePolicyLocal    CapabilityStatementRestResourceReferencePolicy = "local"
+)
+
+// CapabilityStatementRestResourceVersioning represents the versioning of a capability statement rest resource
+type CapabilityStatementRestResourceVersioning string
+
+const (
+	CapabilityStatementRestResourceVersioningNoVersion       CapabilityStatementRestResourceVersioning = "no-version"
+	CapabilityStatementRestResourceVersioningVersioned       CapabilityStatementRestResourceVersioning = "versioned"
+	CapabilityStatementRestResourceVersioningVersionedUpdate CapabilityStatementRestResourceVersioning = "versioned-update"
+)
+
+// CapabilityStatementRestInteraction represents REST interactions
+type CapabilityStatementRestInteraction struct {
+	common.BackboneElement
+
+	Code        CapabilityStatementRestInteractionCode `json:"code"`
+	CodeElement *common.Element                        `json:"_code,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+}
+
+// CapabilityStatementRestInteractionCode represents the code of a capability statement rest interaction
+type CapabilityStatementRestInteractionCode string
+
+const (
+	CapabilityStatementRestInteractionCodeTransaction   CapabilityStatementRestInteractionCode = "transaction"
+	CapabilityStatementRestInteractionCodeBatch         CapabilityStatementRestInteractionCode = "batch"
+	CapabilityStatementRestInteractionCodeSearchSystem  CapabilityStatementRestInteractionCode = "search-system"
+	CapabilityStatementRestInteractionCodeHistorySystem CapabilityStatementRestInteractionCode = "history-system"
+)
+
+// CapabilityStatementRest represents REST capabilities
+type CapabilityStatementRest struct {
+	common.BackboneElement
+
+	Compartment        []string          `json:"compartment,omitempty"`
+	CompartmentElement []*common.Element `json:"_compartment,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Interaction []CapabilityStatementRestInteraction `json:"interaction,omitempty"`
+
+	Mode        CapabilityStatementRestMode `json:"mode"`
+	ModeElement *common.Element             `json:"_mode,omitempty"`
+
+	Operation []CapabilityStatementRestResourceOperation `json:"operation,omitempty"`
+
+	Resource []CapabilityStatementRestResource `json:"resource,omitempty"`
+
+	SearchParam []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
+
+	Security *CapabilityStatementRestSecurity `json:"security,omitempty"`
+}
+
+// CapabilityStatementRestMode represents the mode of a capability statement rest
+type CapabilityStatementRestMode string
+
+const (
+	CapabilityStatementRestModeClient CapabilityStatementRestMode = "client"
+	CapabilityStatementRestModeServer CapabilityStatementRestMode = "server"
+)
+
+// CapabilityStatementMessagingEndpoint represents messaging endpoints
+type CapabilityStatementMessagingEndpoint struct {
+	common.BackboneElement
+
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+
+	Protocol common.Coding `json:"protocol"`
+}
+
+// CapabilityStatementMessagingSupportedMessage represents supported messages
+type CapabilityStatementMessagingSupportedMessage struct {
+	common.BackboneElement
+
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	Mode        CapabilityStatementMessagingSupportedMessageMode `json:"mode"`
+	ModeElement *common.Element                                  `json:"_mode,omitempty"`
+}
+
+// CapabilityStatementMessagingSupportedMessageMode represents the mode of a capability statement messaging supported message
+type CapabilityStatementMessagingSupportedMessageMode string
+
+const (
+	CapabilityStatementMessagingSupportedMessageModeSender   CapabilityStatementMessagingSupportedMessageMode = "sender"
+	CapabilityStatementMessagingSupportedMessageModeReceiver CapabilityStatementMessagingSupportedMessageMode = "receiver"
+)
+
+// CapabilityStatementMessaging represents messaging capabilities
+type CapabilityStatementMessaging struct {
+	common.BackboneElement
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Endpoint []CapabilityStatementMessagingEndpoint `json:"endpoint,omitempty"`
+
+	ReliableCache        *int            `json:"reliableCache,omitempty"`
+	ReliableCacheElement *common.Element `json:"_reliableCache,omitempty"`
+
+	SupportedMessage []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty"`
+}
+
+// CapabilityStatementDocument represents document capabilities
+type CapabilityStatementDocument struct {
+	common.BackboneElement
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Mode        CapabilityStatementDocumentMode `json:"mode"`
+	ModeElement *common.Element                 `json:"_mode,omitempty"`
+
+	Profile        string          `json:"profile"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
+}
+
+// CapabilityStatementDocumentMode represents the mode of a capability statement document
+type CapabilityStatementDocumentMode string
+
+const (
+	CapabilityStatementDocumentModeProducer CapabilityStatementDocumentMode = "producer"
+	CapabilityStatementDocumentModeConsumer CapabilityStatementDocumentMode = "consumer"
+)
@@ -0,0 +1,233 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CarePlan represents healthcare plan for patient or group
+type CarePlan struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CarePlan"
+
+	// Identifies a planned action to occur as part of the plan
+	Activity []CarePlanActivity `json:"activity,omitempty"`
+
+	// When the diagnosis is related to an allergy or intolerance
+	Addresses []common.Reference `json:"addresses,omitempty"`
+
+	// The author may also be a contributor
+	Author *common.Reference `json:"author,omitempty"`
+
+	// A care plan that is fulfilled in whole or in part by this care plan
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Identifies all people and organizations who are expected to be involved in the care envisioned by this plan
+	CareTeam []common.Reference `json:"careTeam,omitempty"`
+
+	// There may be multiple axes of categorization and one plan may serve multiple purposes
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Collaborative care plans may have multiple contributors
+	Contributor []common.Reference `json:"contributor,omitempty"`
+
+	// Represents when this particular CarePlan record was created in the system, which is often a system-generated date
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// A description of the scope and nature of the plan
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// This will typically be the encounter the event occurred within
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Goal can be achieving a particular change or merely maintaining a current state or even slowing a decline
+	Goal []common.Reference `json:"goal,omitempty"`
+
+	// This is a business identifier, not a resource identifier (see discussion)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The URL pointing to a FHIR-defined protocol, guideline
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
+
+	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
+
+	// This element is labeled as a modifier because the intent alters when and how the resource is actually applicable
+	Intent        CarePlanIntent  `json:"intent"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
+
+	// General notes about the care plan not covered elsewhere
+	Note []Annotation `json:"note,omitempty"`
+
+	// Each care plan is an independent request
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Any activities scheduled as part of the plan should be constrained to the specified period regardless of whether the activities are planned within a single encounter/episode or across multiple encounters/episodes (e.g. the longitudinal management of a chronic condition)
+	Period *common.Period `json:"period,omitempty"`
+
+	// The replacement could be because the initial care plan was immediately rejected
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	// The unknown code is not to be used to convey other statuses
+	Status        CarePlanStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Identifies the patient or group whose intended care is described by the plan
+	Subject common.Reference `json:"subject"`
+
+	// Use "concern" to identify specific conditions addressed by the care plan
+	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
+
+	// Human-friendly name for the care plan
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// CarePlanIntent represents the intent of a care plan
+type CarePlanIntent string
+
+const (
+	CarePlanIntentProposal CarePlanIntent = "proposal"
+	CarePlanIntentPlan     CarePlanIntent = "plan"
+	CarePlanIntentOrder    CarePlanIntent = "order"
+	CarePlanIntentOption   CarePlanIntent = "option"
+)
+
+// CarePlanStatus represents the status of a care plan
+type CarePlanStatus string
+
+const (
+	CarePlanStatusDraft          CarePlanStatus = "draft"
+	CarePlanStatusActive         CarePlanStatus = "active"
+	CarePlanStatusOnHold         CarePlanStatus = "on-hold"
+	CarePlanStatusRevoked        CarePlanStatus = "revoked"
+	CarePlanStatusCompleted      CarePlanStatus = "completed"
+	CarePlanStatusEnteredInError CarePlanStatus = "entered-in-error"
+	CarePlanStatusUnknown        CarePlanStatus = "unknown"
+)
+
+// CarePlanActivityDetail represents a simple summary of a planned activity suitable for a general care plan system
+type CarePlanActivityDetail struct {
+	common.BackboneElement
+
+	// Tends to be less relevant for activities involving particular products
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Identifies the quantity expected to be consumed in a given day
+	DailyAmount *common.Quantity `json:"dailyAmount,omitempty"`
+
+	// This provides a textual description of constraints on the intended activity occurrence
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// This element is labeled as a modifier because it marks an activity as an activity that is not to be performed
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
+
+	// Internal reference that identifies the goals that this activity is intended to contribute towards meeting
+	Goal []common.Reference `json:"goal,omitempty"`
+
+	// The URL pointing to a FHIR-defined protocol, guideline
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
+
+	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
+
+	// A description of the kind of resource the in-line definition of a care plan activity is representing
+	Kind        *CarePlanActivityDetailKind `json:"kind,omitempty"`
+	KindElement *common.Element             `json:"_kind,omitempty"`
+
+	// May reference a specific clinical location or may identify a type of location
+	Location *common.Reference `json:"location,omitempty"`
+
+	// A performer MAY also be a participant in the care plan
+	Performer []common.Reference `json:"performer,omitempty"`
+
+	// Identifies the food, drug or other product to be consumed or supplied in the activity
+	ProductCodeableConcept *common.CodeableConcept `json:"productCodeableConcept,omitempty"`
+
+	// Identifies the food, drug or other product to be consumed or supplied in the activity
+	ProductReference *common.Reference `json:"productReference,omitempty"`
+
+	// Identifies the quantity expected to be supplied, administered or consumed by the subject
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// This could be a diagnosis code
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Conditions can be identified at the activity level that are not identified as reasons for the overall plan
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// The period, timing or frequency upon which the described activity is to occur
+	ScheduledTiming *Timing `json:"scheduledTiming,omitempty"`
+
+	// The period, timing or frequency upon which the described activity is to occur
+	ScheduledPeriod *common.Period `json:"scheduledPeriod,omitempty"`
+
+	// The period, timing or frequency upon which the described activity is to occur
+	ScheduledString        *string         `json:"scheduledString,omitempty"`
+	ScheduledStringElement *common.Element `json:"_scheduledString,omitempty"`
+
+	// Some aspects of status can be inferred based on the resources linked in actionTaken
+	Status        CarePlanActivityDetailStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
+
+	// Will generally not be present if status is "complete"
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+}
+
+// CarePlanActivityDetailKind represents the kind of a care plan activity detail
+type CarePlanActivityDetailKind string
+
+const (
+	CarePlanActivityDetailKindAppointment          CarePlanActivityDetailKind = "Appointment"
+	CarePlanActivityDetailKindCommunicationRequest CarePlanActivityDetailKind = "CommunicationRequest"
+	CarePlanActivityDetailKindDeviceRequest        CarePlanActivityDetailKind = "DeviceRequest"
+	CarePlanActivityDetailKindMedicationRequest    CarePlanActivityDetailKind = "MedicationRequest"
+	CarePlanActivityDetailKindNutritionOrder       CarePlanActivityDetailKind = "NutritionOrder"
+	CarePlanActivityDetailKindTask                 CarePlanActivityDetailKind = "Task"
+	CarePlanActivityDetailKindServiceRequest       CarePlanActivityDetailKind = "ServiceRequest"
+	CarePlanActivityDetailKindVisionPrescription   CarePlanActivityDetailKind = "VisionPrescription"
+)
+
+// CarePlanActivityDetailStatus represents the status of a care plan activity detail
+type CarePlanActivityDetailStatus string
+
+const (
+	CarePlanActivityDetailStatusNotStarted     CarePlanActivityDetailStatus = "not-started"
+	CarePlanActivityDetailStatusScheduled      CarePlanActivityDetailStatus = "scheduled"
+	CarePlanActivityDetailStatusInProgress     CarePlanActivityDetailStatus = "in-progress"
+	CarePlanActivityDetailStatusOnHold         CarePlanActivityDetailStatus = "on-hold"
+	CarePlanActivityDetailStatusCompleted      CarePlanActivityDetailStatus = "completed"
+	CarePlanActivityDetailStatusCancelled      CarePlanActivityDetailStatus = "cancelled"
+	CarePlanActivityDetailStatusStopped        CarePlanActivityDetailStatus = "stopped"
+	CarePlanActivityDetailStatusUnknown        CarePlanActivityDetailStatus = "unknown"
+	CarePlanActivityDetailStatusEnteredInError CarePlanActivityDetailStatus = "entered-in-error"
+)
+
+// CarePlanActivity represents action to occur or has occurred as part of plan
+type CarePlanActivity struct {
+	common.BackboneElement
+
+	// A simple summary of a planned activity suitable for a general care plan system
+	Detail *CarePlanActivityDetail `json:"detail,omitempty"`
+
+	// Note that this should not duplicate the activity status (e.g. completed or in progress)
+	OutcomeCodeableConcept []common.CodeableConcept `json:"outcomeCodeableConcept,omitempty"`
+
+	// The activity outcome is independent of the outcome of the related goal(s)
+	OutcomeReference []common.Reference `json:"outcomeReference,omitempty"`
+
+	// This element should NOT be used to describe the activity to be performed
+	Progress []Annotation `json:"progress,omitempty"`
+
+	// Standard extension exists (resource-pertainsToGoal) that allows goals to be referenced from any of the referenced resources in CarePlan.activity.reference
+	Reference *common.Reference `json:"reference,omitempty"`
+}
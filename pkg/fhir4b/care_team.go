@@ -0,0 +1,78 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CareTeam represents a FHIR R4 CareTeam resource
+type CareTeam struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CareTeam"
+
+	// Resource Type Name (for serialization)
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ManagingOrganization []common.Reference `json:"managingOrganization,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Note []Annotation `json:"note,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Participant []CareTeamParticipant `json:"participant,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Period *common.Period `json:"period,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        *CareTeamStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// CareTeamStatus represents the status of a care team
+type CareTeamStatus string
+
+const (
+	CareTeamStatusProposed       CareTeamStatus = "proposed"
+	CareTeamStatusActive         CareTeamStatus = "active"
+	CareTeamStatusSuspended      CareTeamStatus = "suspended"
+	CareTeamStatusInactive       CareTeamStatus = "inactive"
+	CareTeamStatusEnteredInError CareTeamStatus = "entered-in-error"
+)
+
+// CareTeamParticipant represents a participant in a care team
+type CareTeamParticipant struct {
+	common.BackboneElement
+
+	Member *common.Reference `json:"member,omitempty"`
+
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+
+	Period *common.Period `json:"period,omitempty"`
+
+	Role []common.CodeableConcept `json:"role,omitempty"`
+}
@@ -0,0 +1,84 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CatalogEntry represents a FHIR R4 CatalogEntry resource
+type CatalogEntry struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CatalogEntry"
+
+	// Resource Type Name (for serialization)
+	AdditionalCharacteristic []common.CodeableConcept `json:"additionalCharacteristic,omitempty"`
+
+	// Resource Type Name (for serialization)
+	AdditionalClassification []common.CodeableConcept `json:"additionalClassification,omitempty"`
+
+	// Resource Type Name (for serialization)
+	AdditionalIdentifier []common.Identifier `json:"additionalIdentifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Classification []common.CodeableConcept `json:"classification,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	LastUpdated        *common.DateTime `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *common.Element  `json:"_lastUpdated,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Orderable        bool            `json:"orderable"`
+	OrderableElement *common.Element `json:"_orderable,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ReferencedItem common.Reference `json:"referencedItem"`
+
+	// Resource Type Name (for serialization)
+	RelatedEntry []CatalogEntryRelatedEntry `json:"relatedEntry,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        *CatalogEntryStatus `json:"status,omitempty"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// The time period in which this catalog entry is expected to be active
+	ValidityPeriod *common.Period `json:"validityPeriod,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ValidTo        *common.DateTime `json:"validTo,omitempty"`
+	ValidToElement *common.Element  `json:"_validTo,omitempty"`
+}
+
+// CatalogEntryStatus represents the status of a catalog entry
+type CatalogEntryStatus string
+
+const (
+	CatalogEntryStatusDraft   CatalogEntryStatus = "draft"
+	CatalogEntryStatusActive  CatalogEntryStatus = "active"
+	CatalogEntryStatusRetired CatalogEntryStatus = "retired"
+	CatalogEntryStatusUnknown CatalogEntryStatus = "unknown"
+)
+
+// CatalogEntryRelatedEntry represents related catalog entries
+type CatalogEntryRelatedEntry struct {
+	common.BackboneElement
+
+	Item common.Reference `json:"item"`
+
+	RelationType        CatalogEntryRelatedEntryRelationtype `json:"relationtype"`
+	RelationTypeElement *common.Element                      `json:"_relationtype,omitempty"`
+}
+
+// CatalogEntryRelatedEntryRelationtype represents the relationtype of a catalog entry related entry
+type CatalogEntryRelatedEntryRelationtype string
+
+const (
+	CatalogEntryRelatedEntryRelationtypeTriggers     CatalogEntryRelatedEntryRelationtype = "triggers"
+	CatalogEntryRelatedEntryRelationtypeIsReplacedBy CatalogEntryRelatedEntryRelationtype = "is-replaced-by"
+)
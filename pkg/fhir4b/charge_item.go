@@ -0,0 +1,129 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ChargeItem represents a FHIR R4 ChargeItem resource
+type ChargeItem struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ChargeItem"
+
+	// Resource Type Name (for serialization)
+	Account []common.Reference `json:"account,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Bodysite []common.CodeableConcept `json:"bodysite,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Code common.CodeableConcept `json:"code"`
+
+	// Resource Type Name (for serialization)
+	Context *common.Reference `json:"context,omitempty"`
+
+	// Resource Type Name (for serialization)
+	CostCenter *common.Reference `json:"costCenter,omitempty"`
+
+	// Resource Type Name (for serialization)
+	DefinitionCanonical        []string          `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement []*common.Element `json:"_definitionCanonical,omitempty"`
+
+	// Resource Type Name (for serialization)
+	DefinitionUri        []string          `json:"definitionUri,omitempty"`
+	DefinitionUriElement []*common.Element `json:"_definitionUri,omitempty"`
+
+	// Resource Type Name (for serialization)
+	EnteredDate        *common.DateTime `json:"enteredDate,omitempty"`
+	EnteredDateElement *common.Element  `json:"_enteredDate,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Resource Type Name (for serialization)
+	FactorOverride        *common.Decimal `json:"factorOverride,omitempty"`
+	FactorOverrideElement *common.Element `json:"_factorOverride,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Note []Annotation `json:"note,omitempty"`
+
+	// Resource Type Name (for serialization)
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+
+	// Resource Type Name (for serialization)
+	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
+
+	// Resource Type Name (for serialization)
+	OccurrenceTiming *Timing `json:"occurrenceTiming,omitempty"`
+
+	// Resource Type Name (for serialization)
+	OverrideReason        *string         `json:"overrideReason,omitempty"`
+	OverrideReasonElement *common.Element `json:"_overrideReason,omitempty"`
+
+	// Resource Type Name (for serialization)
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Performer []ChargeItemPerformer `json:"performer,omitempty"`
+
+	// Resource Type Name (for serialization)
+	PerformingOrganization *common.Reference `json:"performingOrganization,omitempty"`
+
+	// Resource Type Name (for serialization)
+	PriceOverride *common.Money `json:"priceOverride,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ProductReference *common.Reference `json:"productReference,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ProductCodeableConcept *common.CodeableConcept `json:"productCodeableConcept,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// Resource Type Name (for serialization)
+	RequestingOrganization *common.Reference `json:"requestingOrganization,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Service []common.Reference `json:"service,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        ChargeItemStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Subject common.Reference `json:"subject"`
+
+	// Resource Type Name (for serialization)
+	SupportingInformation []common.Reference `json:"supportingInformation,omitempty"`
+}
+
+// ChargeItemStatus represents the status of a charge item
+type ChargeItemStatus string
+
+const (
+	ChargeItemStatusPlanned        ChargeItemStatus = "planned"
+	ChargeItemStatusBillable       ChargeItemStatus = "billable"
+	ChargeItemStatusNotBillable    ChargeItemStatus = "not-billable"
+	ChargeItemStatusAborted        ChargeItemStatus = "aborted"
+	ChargeItemStatusBilled         ChargeItemStatus = "billed"
+	ChargeItemStatusEnteredInError ChargeItemStatus = "entered-in-error"
+	ChargeItemStatusUnknown        ChargeItemStatus = "unknown"
+)
+
+// ChargeItemPerformer represents a performer of a charge item
+type ChargeItemPerformer struct {
+	common.BackboneElement
+
+	Actor common.Reference `json:"actor"`
+
+	Function *common.CodeableConcept `json:"function,omitempty"`
+}
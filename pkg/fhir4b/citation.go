@@ -0,0 +1,606 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Citation represents a description of identification, location, or contributorship of a publication (article or artifact)
+type Citation struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Citation"
+
+	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// Who authored the Citation
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// The article or artifact being described
+	CitedArtifact *CitationCitedArtifact `json:"citedArtifact,omitempty"`
+
+	// The assignment to an organizing scheme
+	Classification []CitationClassification `json:"classification,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Use and/or publishing restrictions for the Citation, not for the cited artifact
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// The status of the citation
+	CurrentState []common.CodeableConcept `json:"currentState,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the citation was built, comments about misuse
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Who edited the Citation
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// The effective period for a citation determines when the content is applicable for usage and is independent of publication and review dates
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// Who endorsed the Citation
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// Allows filtering of citations that are appropriate for use versus not
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// May include DOI, PMID, PMCID, etc. This element will contain unique identifiers that support de-duplication of citations
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// It may be possible for the citation to be used in jurisdictions other than those for which it was originally designed or intended
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// If specified, this date follows the original approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Used for general notes and annotations not coded elsewhere
+	Note []Annotation `json:"note,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the citation
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Artifact related to the Citation Resource
+	RelatesTo []CitationRelatesTo `json:"relatesTo,omitempty"`
+
+	// Who reviewed the Citation
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// Allows filtering of summarys s that are appropriate for use versus not
+	Status        CitationStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// An effective date or period for a status of the citation
+	StatusDate []CitationStatusDate `json:"statusDate,omitempty"`
+
+	// A human-readable display of the citation
+	Summary []CitationSummary `json:"summary,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different citation instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// CitationStatus represents the status of a citation
+type CitationStatus string
+
+const (
+	CitationStatusDraft   CitationStatus = "draft"
+	CitationStatusActive  CitationStatus = "active"
+	CitationStatusRetired CitationStatus = "retired"
+	CitationStatusUnknown CitationStatus = "unknown"
+)
+
+// CitationSummary represents a human-readable display of key concepts to represent the citation
+type CitationSummary struct {
+	common.BackboneElement
+
+	// Format for display of the citation
+	Style *common.CodeableConcept `json:"style,omitempty"`
+
+	// The human-readable display of the citation
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
+}
+
+// CitationClassification represents the assignment to an organizing scheme
+type CitationClassification struct {
+	common.BackboneElement
+
+	// The specific classification value
+	Classifier []common.CodeableConcept `json:"classifier,omitempty"`
+
+	// The kind of classifier (e.g. publication type, keyword)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// CitationStatusDate represents an effective date or period for a status of the citation record
+type CitationStatusDate struct {
+	common.BackboneElement
+
+	// Classification of the status
+	Activity common.CodeableConcept `json:"activity"`
+
+	// Either occurred or expected
+	Actual        *bool           `json:"actual,omitempty"`
+	ActualElement *common.Element `json:"_actual,omitempty"`
+
+	// For an instance, place the same value in both start and end elements
+	Period common.Period `json:"period"`
+}
+
+// CitationRelatesTo represents artifact related to the Citation Resource
+type CitationRelatesTo struct {
+	common.BackboneElement
+
+	// How the Citation resource relates to the target artifact
+	RelationshipType common.CodeableConcept `json:"relationshipType"`
+
+	// The article or artifact that the Citation Resource is related to
+	TargetUri        *string         `json:"targetUri,omitempty"`
+	TargetUriElement *common.Element `json:"_targetUri,omitempty"`
+
+	// The article or artifact that the Citation Resource is related to
+	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
+
+	// The article or artifact that the Citation Resource is related to
+	TargetReference *common.Reference `json:"targetReference,omitempty"`
+
+	// The article or artifact that the Citation Resource is related to
+	TargetAttachment *Attachment `json:"targetAttachment,omitempty"`
+
+	// The clasification of the related artifact
+	TargetClassifier []common.CodeableConcept `json:"targetClassifier,omitempty"`
+}
+
+// CitationCitedArtifactVersion represents the defined version of the cited artifact
+type CitationCitedArtifactVersion struct {
+	common.BackboneElement
+
+	// Citation for the main version of the cited artifact
+	BaseCitation *common.Reference `json:"baseCitation,omitempty"`
+
+	// The version number or other version identifier
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CitationCitedArtifactStatusDate represents an effective date or period for a status of the cited artifact
+type CitationCitedArtifactStatusDate struct {
+	common.BackboneElement
+
+	// Classification of the status
+	Activity common.CodeableConcept `json:"activity"`
+
+	// Either occurred or expected
+	Actual        *bool           `json:"actual,omitempty"`
+	ActualElement *common.Element `json:"_actual,omitempty"`
+
+	// For an instance, place the same value in both start and end elements
+	Period common.Period `json:"period"`
+}
+
+// CitationCitedArtifactTitle represents the title details of the article or artifact
+type CitationCitedArtifactTitle struct {
+	common.BackboneElement
+
+	// Used to express the specific language
+	Language *common.CodeableConcept `json:"language,omitempty"`
+
+	// The title of the article or artifact
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Used to express the reason or specific aspect for the title
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// CitationCitedArtifactAbstract represents summary of the article or artifact
+type CitationCitedArtifactAbstract struct {
+	common.BackboneElement
+
+	// Copyright notice for the abstract
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Used to express the specific language
+	Language *common.CodeableConcept `json:"language,omitempty"`
+
+	// Abstract content
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Used to express the reason or specific aspect for the abstract
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// CitationCitedArtifactPart represents the component of the article or artifact
+type CitationCitedArtifactPart struct {
+	common.BackboneElement
+
+	// The citation for the full article or artifact
+	BaseCitation *common.Reference `json:"baseCitation,omitempty"`
+
+	// The kind of component
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// The specification of the component
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CitationCitedArtifactRelatesTo represents the artifact related to the cited artifact
+type CitationCitedArtifactRelatesTo struct {
+	common.BackboneElement
+
+	// How the cited artifact relates to the target artifact
+	RelationshipType common.CodeableConcept `json:"relationshipType"`
+
+	// The article or artifact that the cited artifact is related to
+	TargetUri        *string         `json:"targetUri,omitempty"`
+	TargetUriElement *common.Element `json:"_targetUri,omitempty"`
+
+	// The article or artifact that the cited artifact is related to
+	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
+
+	// The article or artifact that the cited artifact is related to
+	TargetReference *common.Reference `json:"targetReference,omitempty"`
+
+	// The article or artifact that the cited artifact is related to
+	TargetAttachment *Attachment `json:"targetAttachment,omitempty"`
+
+	// The clasification of the related artifact
+	TargetClassifier []common.CodeableConcept `json:"targetClassifier,omitempty"`
+}
+
+// CitationCitedArtifactPublicationFormPublishedIn represents the collection the cited article or artifact is published in
+type CitationCitedArtifactPublicationFormPublishedIn struct {
+	common.BackboneElement
+
+	// Journal identifiers include ISSN, ISO Abbreviation and NLMuniqueID; Book identifiers include ISBN
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Name of the publisher
+	Publisher *common.Reference `json:"publisher,omitempty"`
+
+	// Geographic location of the publisher
+	PublisherLocation        *string         `json:"publisherLocation,omitempty"`
+	PublisherLocationElement *common.Element `json:"_publisherLocation,omitempty"`
+
+	// ClinicalTrials.gov is a name of a database
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Journals and newspapers are coded as Periodical
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// CitationCitedArtifactPublicationFormPeriodicReleaseDateOfPublication represents defining the date on which the issue of the journal was published
+type CitationCitedArtifactPublicationFormPeriodicReleaseDateOfPublication struct {
+	common.BackboneElement
+
+	// Date on which the issue of the journal was published
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Day on which the issue of the journal was published
+	Day        *string         `json:"day,omitempty"`
+	DayElement *common.Element `json:"_day,omitempty"`
+
+	// Month on which the issue of the journal was published
+	Month        *string         `json:"month,omitempty"`
+	MonthElement *common.Element `json:"_month,omitempty"`
+
+	// Spring, Summer, Fall/Autumn, Winter
+	Season        *string         `json:"season,omitempty"`
+	SeasonElement *common.Element `json:"_season,omitempty"`
+
+	// Text representation of the date of which the issue of the journal was published
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Year on which the issue of the journal was published
+	Year        *string         `json:"year,omitempty"`
+	YearElement *common.Element `json:"_year,omitempty"`
+}
+
+// CitationCitedArtifactPublicationFormPeriodicRelease represents the specific issue in which the cited article resides
+type CitationCitedArtifactPublicationFormPeriodicRelease struct {
+	common.BackboneElement
+
+	// National Library of Medicine uses the codes "Internet" or "Print"
+	CitedMedium *common.CodeableConcept `json:"citedMedium,omitempty"`
+
+	// Defining the date on which the issue of the journal was published
+	DateOfPublication *CitationCitedArtifactPublicationFormPeriodicReleaseDateOfPublication `json:"dateOfPublication,omitempty"`
+
+	// Issue, part or supplement of journal in which the article is published
+	Issue        *string         `json:"issue,omitempty"`
+	IssueElement *common.Element `json:"_issue,omitempty"`
+
+	// Volume number of journal in which the article is published
+	Volume        *string         `json:"volume,omitempty"`
+	VolumeElement *common.Element `json:"_volume,omitempty"`
+}
+
+// CitationCitedArtifactPublicationForm represents if multiple, used to represent alternative forms of the article that are not separate citations
+type CitationCitedArtifactPublicationForm struct {
+	common.BackboneElement
+
+	// Entry number or identifier for inclusion in a database
+	AccessionNumber        *string         `json:"accessionNumber,omitempty"`
+	AccessionNumberElement *common.Element `json:"_accessionNumber,omitempty"`
+
+	// The date the article was added to the database, or the date the article was released
+	ArticleDate        *common.DateTime `json:"articleDate,omitempty"`
+	ArticleDateElement *common.Element  `json:"_articleDate,omitempty"`
+
+	// Copyright notice for the full article or artifact
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Used for isolated representation of first page
+	FirstPage        *string         `json:"firstPage,omitempty"`
+	FirstPageElement *common.Element `json:"_firstPage,omitempty"`
+
+	// Language is provided to support indexing and accessibility
+	Language []common.CodeableConcept `json:"language,omitempty"`
+
+	// Used for isolated representation of last page
+	LastPage        *string         `json:"lastPage,omitempty"`
+	LastPageElement *common.Element `json:"_lastPage,omitempty"`
+
+	// The date the article was last revised or updated in the database
+	LastRevisionDate        *common.DateTime `json:"lastRevisionDate,omitempty"`
+	LastRevisionDateElement *common.Element  `json:"_lastRevisionDate,omitempty"`
+
+	// Actual or approximate number of pages or screens
+	PageCount        *string         `json:"pageCount,omitempty"`
+	PageCountElement *common.Element `json:"_pageCount,omitempty"`
+
+	// Used for full display of pagination
+	PageString        *string         `json:"pageString,omitempty"`
+	PageStringElement *common.Element `json:"_pageString,omitempty"`
+
+	// The specific issue in which the cited article resides
+	PeriodicRelease *CitationCitedArtifactPublicationFormPeriodicRelease `json:"periodicRelease,omitempty"`
+
+	// The collection the cited article or artifact is published in
+	PublishedIn *CitationCitedArtifactPublicationFormPublishedIn `json:"publishedIn,omitempty"`
+}
+
+// CitationCitedArtifactWebLocation represents used for any URL for the article or artifact cited
+type CitationCitedArtifactWebLocation struct {
+	common.BackboneElement
+
+	// Code the reason for different URLs, e.g. abstract and full-text
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Persistent URLs, like doi, are preferred
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// CitationCitedArtifactClassificationWhoClassified represents provenance and copyright of classification
+type CitationCitedArtifactClassificationWhoClassified struct {
+	common.BackboneElement
+
+	// Rights management statement for the classification
+	ClassifierCopyright        *string         `json:"classifierCopyright,omitempty"`
+	ClassifierCopyrightElement *common.Element `json:"_classifierCopyright,omitempty"`
+
+	// Acceptable to re-use the classification
+	FreeToShare        *bool           `json:"freeToShare,omitempty"`
+	FreeToShareElement *common.Element `json:"_freeToShare,omitempty"`
+
+	// Organization who created the classification
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// Person who created the classification
+	Person *common.Reference `json:"person,omitempty"`
+
+	// The publisher of the classification, not the publisher of the article or artifact being cited
+	Publisher *common.Reference `json:"publisher,omitempty"`
+}
+
+// CitationCitedArtifactClassification represents the assignment to an organizing scheme
+type CitationCitedArtifactClassification struct {
+	common.BackboneElement
+
+	// The specific classification value
+	Classifier []common.CodeableConcept `json:"classifier,omitempty"`
+
+	// The kind of classifier (e.g. publication type, keyword)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Provenance and copyright of classification
+	WhoClassified *CitationCitedArtifactClassificationWhoClassified `json:"whoClassified,omitempty"`
+}
+
+// CitationCitedArtifactContributorshipEntryAffiliationInfo represents organization affiliated with the entity
+type CitationCitedArtifactContributorshipEntryAffiliationInfo struct {
+	common.BackboneElement
+
+	// Display for the organization
+	Affiliation        *string         `json:"affiliation,omitempty"`
+	AffiliationElement *common.Element `json:"_affiliation,omitempty"`
+
+	// Identifier for the organization
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Role within the organization, such as professional title
+	Role        *string         `json:"role,omitempty"`
+	RoleElement *common.Element `json:"_role,omitempty"`
+}
+
+// CitationCitedArtifactContributorshipEntryContributionInstance represents contributions with accounting for time or number
+type CitationCitedArtifactContributorshipEntryContributionInstance struct {
+	common.BackboneElement
+
+	// The time that the contribution was made
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
+
+	// The specific contribution
+	Type common.CodeableConcept `json:"type"`
+}
+
+// CitationCitedArtifactContributorshipEntry represents an individual entity named as a contributor
+type CitationCitedArtifactContributorshipEntry struct {
+	common.BackboneElement
+
+	// Physical mailing address for the author or contributor
+	Address []Address `json:"address,omitempty"`
+
+	// Organization affiliated with the entity
+	AffiliationInfo []CitationCitedArtifactContributorshipEntryAffiliationInfo `json:"affiliationInfo,omitempty"`
+
+	// This element is used in Medline based citations, but not supported fully by FHIR HumanName datatype
+	CollectiveName        *string         `json:"collectiveName,omitempty"`
+	CollectiveNameElement *common.Element `json:"_collectiveName,omitempty"`
+
+	// Contributions with accounting for time or number
+	ContributionInstance []CitationCitedArtifactContributorshipEntryContributionInstance `json:"contributionInstance,omitempty"`
+
+	// The CreDiT taxonomy provides a way to encode contribution information inside article XML files
+	ContributionType []common.CodeableConcept `json:"contributionType,omitempty"`
+
+	// Indication of which contributor is the corresponding contributor for the role
+	CorrespondingContact        *bool           `json:"correspondingContact,omitempty"`
+	CorrespondingContactElement *common.Element `json:"_correspondingContact,omitempty"`
+
+	// Avoids needing to disambiguate common last names or changes of name
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// This element is used in Medline based citations, but not supported fully by FHIR HumanName datatype
+	Initials        *string         `json:"initials,omitempty"`
+	InitialsElement *common.Element `json:"_initials,omitempty"`
+
+	// Used to code order of authors
+	ListOrder        *int            `json:"listOrder,omitempty"`
+	ListOrderElement *common.Element `json:"_listOrder,omitempty"`
+
+	// humanName.family can match MEDLINE-based lastName (used for surname or single name)
+	Name *HumanName `json:"name,omitempty"`
+
+	// The role of the contributor (e.g. author, editor, reviewer)
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// Email or telephone contact methods for the author or contributor
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// CitationCitedArtifactContributorshipSummary represents used to record a display of the author/contributor list without separate data element for each list member
+type CitationCitedArtifactContributorshipSummary struct {
+	common.BackboneElement
+
+	// Used to code the producer or rule for creating the display string
+	Source *common.CodeableConcept `json:"source,omitempty"`
+
+	// The format for the display string
+	Style *common.CodeableConcept `json:"style,omitempty"`
+
+	// Used most commonly to express an author list or a contributorship statement
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// The display string for the author list, contributor list, or contributorship statement
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CitationCitedArtifactContributorship represents attribution of authors and other contributors
+type CitationCitedArtifactContributorship struct {
+	common.BackboneElement
+
+	// If list is incomplete "et al" can be appended to Contributorship.summary.value for display purposes
+	Complete        *bool           `json:"complete,omitempty"`
+	CompleteElement *common.Element `json:"_complete,omitempty"`
+
+	// Used to report contributorship in individualized ways
+	Entry []CitationCitedArtifactContributorshipEntry `json:"entry,omitempty"`
+
+	// Used to record a display of the author/contributor list without separate coding for each list member
+	Summary []CitationCitedArtifactContributorshipSummary `json:"summary,omitempty"`
+}
+
+// CitationCitedArtifact represents the article or artifact being described
+type CitationCitedArtifact struct {
+	common.BackboneElement
+
+	// Summary of the article or artifact
+	Abstract []CitationCitedArtifactAbstract `json:"abstract,omitempty"`
+
+	// The assignment to an organizing scheme
+	Classification []CitationCitedArtifactClassification `json:"classification,omitempty"`
+
+	// This element is used to list authors and other contributors, their contact information, specific contributions
+	Contributorship *CitationCitedArtifactContributorship `json:"contributorship,omitempty"`
+
+	// The status of the cited artifact
+	CurrentState []common.CodeableConcept `json:"currentState,omitempty"`
+
+	// When the cited artifact was accessed
+	DateAccessed        *common.DateTime `json:"dateAccessed,omitempty"`
+	DateAccessedElement *common.Element  `json:"_dateAccessed,omitempty"`
+
+	// May include DOI, PMID, PMCID, etc. This element will contain unique identifiers that support de-duplication of citations
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Any additional information or content for the article or artifact
+	Note []Annotation `json:"note,omitempty"`
+
+	// The component of the article or artifact
+	Part *CitationCitedArtifactPart `json:"part,omitempty"`
+
+	// A common use is a journal article with a publication date and pagination for a print version and a different publication date for the online version of the same article
+	PublicationForm []CitationCitedArtifactPublicationForm `json:"publicationForm,omitempty"`
+
+	// May include trial registry identifiers, e.g. NCT04372602 from clinicaltrials.gov
+	RelatedIdentifier []common.Identifier `json:"relatedIdentifier,omitempty"`
+
+	// The artifact related to the cited artifact
+	RelatesTo []CitationCitedArtifactRelatesTo `json:"relatesTo,omitempty"`
+
+	// An effective date or period for a status of the cited artifact
+	StatusDate []CitationCitedArtifactStatusDate `json:"statusDate,omitempty"`
+
+	// The title details of the article or artifact
+	Title []CitationCitedArtifactTitle `json:"title,omitempty"`
+
+	// The defined version of the cited artifact
+	Version *CitationCitedArtifactVersion `json:"version,omitempty"`
+
+	// Used for any URL for the article or artifact cited
+	WebLocation []CitationCitedArtifactWebLocation `json:"webLocation,omitempty"`
+}
@@ -0,0 +1,408 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Claim represents a FHIR R4 Claim resource
+type Claim struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Claim"
+
+	// Resource Type Name (for serialization)
+	Accident *ClaimAccident `json:"accident,omitempty"`
+
+	// Resource Type Name (for serialization)
+	BillablePeriod *common.Period `json:"billablePeriod,omitempty"`
+
+	// Resource Type Name (for serialization)
+	CareTeam []ClaimCareTeam `json:"careTeam,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
+
+	// Information about diagnoses relevant to the claim items
+	Diagnosis []ClaimDiagnosis `json:"diagnosis,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Facility *common.Reference `json:"facility,omitempty"`
+
+	// Resource Type Name (for serialization)
+	FundsReserve *common.CodeableConcept `json:"fundsReserve,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Insurance []ClaimInsurance `json:"insurance"`
+
+	// Resource Type Name (for serialization)
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Item []ClaimItem `json:"item,omitempty"`
+
+	// Resource Type Name (for serialization)
+	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Patient common.Reference `json:"patient"`
+
+	// Resource Type Name (for serialization)
+	Payee *ClaimPayee `json:"payee,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Priority common.CodeableConcept `json:"priority"`
+
+	// Procedures performed on the patient relevant to the billing items with the claim
+	Procedure []ClaimProcedure `json:"procedure,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Provider common.Reference `json:"provider"`
+
+	// Resource Type Name (for serialization)
+	Referral *common.Reference `json:"referral,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Related []ClaimRelated `json:"related,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        ClaimStatus     `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	SubType *common.CodeableConcept `json:"subType,omitempty"`
+
+	// Often there are multiple jurisdiction specific valuesets which are required
+	SupportingInfo []ClaimSupportingInfo `json:"supportingInfo,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Total *common.Money `json:"total,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Type common.CodeableConcept `json:"type"`
+
+	// Resource Type Name (for serialization)
+	Use        ClaimUse        `json:"use"`
+	UseElement *common.Element `json:"_use,omitempty"`
+}
+
+// ClaimStatus represents the status of a claim
+type ClaimStatus string
+
+const (
+	ClaimStatusActive         ClaimStatus = "active"
+	ClaimStatusCancelled      ClaimStatus = "cancelled"
+	ClaimStatusDraft          ClaimStatus = "draft"
+	ClaimStatusEnteredInError ClaimStatus = "entered-in-error"
+)
+
+// ClaimUse represents the use of a claim
+type ClaimUse string
+
+const (
+	ClaimUseClaim   ClaimUse = "claim"
+	ClaimUsePreauth ClaimUse = "preauthorization"
+	ClaimUsePredet  ClaimUse = "predetermination"
+)
+
+// ClaimRelated represents related claims
+type ClaimRelated struct {
+	common.BackboneElement
+
+	Claim *common.Reference `json:"claim,omitempty"`
+
+	Reference *common.Identifier `json:"reference,omitempty"`
+
+	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
+}
+
+// ClaimPayee represents payee information
+type ClaimPayee struct {
+	common.BackboneElement
+
+	Party *common.Reference `json:"party,omitempty"`
+
+	Type common.CodeableConcept `json:"type"`
+}
+
+// ClaimCareTeam represents care team members
+type ClaimCareTeam struct {
+	common.BackboneElement
+
+	Provider common.Reference `json:"provider"`
+
+	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
+
+	Responsible        *bool           `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
+
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimSupportingInfo represents supporting information
+type ClaimSupportingInfo struct {
+	common.BackboneElement
+
+	// This may contain a category for the local bill type codes
+	Category common.CodeableConcept `json:"category"`
+
+	// System and code pertaining to the specific information regarding special conditions relating to the setting
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// For example: the reason for the additional stay, or why a tooth is missing
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// A number to uniquely identify supporting information entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The date when or period to which this information refers
+	TimingDate        *common.Date    `json:"timingDate,omitempty"`
+	TimingDateElement *common.Element `json:"_timingDate,omitempty"`
+
+	// The date when or period to which this information refers
+	TimingPeriod *common.Period `json:"timingPeriod,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+}
+
+// ClaimDiagnosis represents pertinent diagnosis information
+type ClaimDiagnosis struct {
+	common.BackboneElement
+
+	// The nature of illness or problem in a coded form or as a reference to an external defined Condition
+	DiagnosisCodeableConcept *common.CodeableConcept `json:"diagnosisCodeableConcept,omitempty"`
+
+	// The nature of illness or problem in a coded form or as a reference to an external defined Condition
+	DiagnosisReference *common.Reference `json:"diagnosisReference,omitempty"`
+
+	// Indication of whether the diagnosis was present on admission to a facility
+	OnAdmission *common.CodeableConcept `json:"onAdmission,omitempty"`
+
+	// For example DRG (Diagnosis Related Group) or a bundled billing code
+	PackageCode *common.CodeableConcept `json:"packageCode,omitempty"`
+
+	// Diagnosis are presented in list order to their expected importance: primary, secondary, etc
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// For example: admitting, primary, secondary, discharge
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClaimProcedure represents clinical procedures performed
+type ClaimProcedure struct {
+	common.BackboneElement
+
+	// Date and optionally time the procedure was performed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// The code or reference to a Procedure resource which identifies the clinical intervention performed
+	ProcedureCodeableConcept *common.CodeableConcept `json:"procedureCodeableConcept,omitempty"`
+
+	// The code or reference to a Procedure resource which identifies the clinical intervention performed
+	ProcedureReference *common.Reference `json:"procedureReference,omitempty"`
+
+	// A number to uniquely identify procedure entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// For example: primary, secondary
+	Type []common.CodeableConcept `json:"type,omitempty"`
+
+	// Unique Device Identifiers associated with this line item
+	Udi []common.Reference `json:"udi,omitempty"`
+}
+
+// ClaimInsurance represents insurance information
+type ClaimInsurance struct {
+	common.BackboneElement
+
+	BusinessArrangement        *string         `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element `json:"_businessArrangement,omitempty"`
+
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	Coverage common.Reference `json:"coverage"`
+
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
+
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ClaimAccident represents accident information
+type ClaimAccident struct {
+	common.BackboneElement
+
+	Date        common.Date     `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	LocationAddress *Address `json:"locationAddress,omitempty"`
+
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClaimItemDetailSubDetail represents claim item sub-details
+type ClaimItemDetailSubDetail struct {
+	common.BackboneElement
+
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	Net *common.Money `json:"net,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ClaimItemDetail represents claim item details
+type ClaimItemDetail struct {
+	common.BackboneElement
+
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	Net *common.Money `json:"net,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	SubDetail []ClaimItemDetailSubDetail `json:"subDetail,omitempty"`
+
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ClaimItem represents claim items
+type ClaimItem struct {
+	common.BackboneElement
+
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// CareTeam members related to this service or product
+	CareTeamSequence        []int             `json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement []*common.Element `json:"_careTeamSequence,omitempty"`
+
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	Detail []ClaimItemDetail `json:"detail,omitempty"`
+
+	// Diagnosis applicable for this service or product
+	DiagnosisSequence        []int             `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement []*common.Element `json:"_diagnosisSequence,omitempty"`
+
+	Encounter []common.Reference `json:"encounter,omitempty"`
+
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Exceptions, special conditions and supporting information applicable for this service or product
+	InformationSequence        []int             `json:"informationSequence,omitempty"`
+	InformationSequenceElement []*common.Element `json:"_informationSequence,omitempty"`
+
+	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
+
+	LocationAddress *Address `json:"locationAddress,omitempty"`
+
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	Net *common.Money `json:"net,omitempty"`
+
+	// Procedures applicable for this service or product
+	ProcedureSequence        []int             `json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement []*common.Element `json:"_procedureSequence,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+
+	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
+
+	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
+
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
@@ -0,0 +1,112 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ClinicalImpression represents a FHIR R4 ClinicalImpression resource
+type ClinicalImpression struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ClinicalImpression"
+
+	// Resource Type Name (for serialization)
+	Assessor *common.Reference `json:"assessor,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Resource Type Name (for serialization)
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+
+	// Resource Type Name (for serialization)
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Finding []ClinicalImpressionFinding `json:"finding,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Investigation []ClinicalImpressionInvestigation `json:"investigation,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Note []Annotation `json:"note,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Previous *common.Reference `json:"previous,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Problem []common.Reference `json:"problem,omitempty"`
+
+	// Resource Type Name (for serialization)
+	PrognosisCodeableConcept []common.CodeableConcept `json:"prognosisCodeableConcept,omitempty"`
+
+	// Resource Type Name (for serialization)
+	PrognosisReference []common.Reference `json:"prognosisReference,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Protocol        []string          `json:"protocol,omitempty"`
+	ProtocolElement []*common.Element `json:"_protocol,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        ClinicalImpressionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Subject common.Reference `json:"subject"`
+
+	// A text summary of the investigations and the diagnosis
+	Summary        *string         `json:"summary,omitempty"`
+	SummaryElement *common.Element `json:"_summary,omitempty"`
+
+	// Resource Type Name (for serialization)
+	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
+}
+
+// ClinicalImpressionStatus represents the status of a clinical impression
+type ClinicalImpressionStatus string
+
+const (
+	ClinicalImpressionStatusInProgress     ClinicalImpressionStatus = "in-progress"
+	ClinicalImpressionStatusCompleted      ClinicalImpressionStatus = "completed"
+	ClinicalImpressionStatusEnteredInError ClinicalImpressionStatus = "entered-in-error"
+)
+
+// ClinicalImpressionInvestigation represents investigations
+type ClinicalImpressionInvestigation struct {
+	common.BackboneElement
+
+	Code common.CodeableConcept `json:"code"`
+
+	Item []common.Reference `json:"item,omitempty"`
+}
+
+// ClinicalImpressionFinding represents findings
+type ClinicalImpressionFinding struct {
+	common.BackboneElement
+
+	Basis        *string         `json:"basis,omitempty"`
+	BasisElement *common.Element `json:"_basis,omitempty"`
+
+	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
+
+	ItemReference *common.Reference `json:"itemReference,omitempty"`
+}
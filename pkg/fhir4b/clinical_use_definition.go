@@ -0,0 +1,176 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ClinicalUseDefinition represents a single issue - either an indication, contraindication, interaction or an undesirable effect for a medicinal product
+type ClinicalUseDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ClinicalUseDefinition"
+
+	// A categorisation of the issue, primarily for dividing warnings into subject heading areas such as "Pregnancy and Lactation", "Overdose", "Effects on Ability to Drive and Use Machines"
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Specifics for when this is a contraindication
+	Contraindication *ClinicalUseDefinitionContraindication `json:"contraindication,omitempty"`
+
+	// Business identifier for this issue
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Specifics for when this is an indication
+	Indication *ClinicalUseDefinitionIndication `json:"indication,omitempty"`
+
+	// Specifics for when this is an interaction
+	Interaction *ClinicalUseDefinitionInteraction `json:"interaction,omitempty"`
+
+	// The population group to which this applies
+	Population []common.Reference `json:"population,omitempty"`
+
+	// Whether this is a current issue or one that has been retired etc
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// The medication or procedure for which this is an indication
+	Subject []common.Reference `json:"subject,omitempty"`
+
+	// indication | contraindication | interaction | undesirable-effect | warning
+	Type        ClinicalUseDefinitionType `json:"type"`
+	TypeElement *common.Element           `json:"_type,omitempty"`
+
+	// Describe the possible undesirable effects (negative outcomes) from the use of the medicinal product as treatment
+	UndesirableEffect *ClinicalUseDefinitionUndesirableEffect `json:"undesirableEffect,omitempty"`
+
+	// A critical piece of information about environmental
+	Warning *ClinicalUseDefinitionWarning `json:"warning,omitempty"`
+}
+
+// ClinicalUseDefinitionType represents the type of a clinical use definition
+type ClinicalUseDefinitionType string
+
+const (
+	ClinicalUseDefinitionTypeIndication        ClinicalUseDefinitionType = "indication"
+	ClinicalUseDefinitionTypeContraindication  ClinicalUseDefinitionType = "contraindication"
+	ClinicalUseDefinitionTypeInteraction       ClinicalUseDefinitionType = "interaction"
+	ClinicalUseDefinitionTypeUndesirableEffect ClinicalUseDefinitionType = "undesirable-effect"
+	ClinicalUseDefinitionTypeWarning           ClinicalUseDefinitionType = "warning"
+)
+
+// ClinicalUseDefinitionContraindicationOtherTherapy represents information about use of the product in relation to other therapies described as part of the contraindication
+type ClinicalUseDefinitionContraindicationOtherTherapy struct {
+	common.BackboneElement
+
+	// The type of relationship between the medicinal product indication or contraindication and another therapy
+	RelationshipType common.CodeableConcept `json:"relationshipType"`
+
+	// Reference to a specific medication
+	Therapy common.CodeableReference `json:"therapy"`
+}
+
+// ClinicalUseDefinitionContraindication represents specifics for when this is a contraindication
+type ClinicalUseDefinitionContraindication struct {
+	common.BackboneElement
+
+	// A comorbidity (concurrent condition) or coinfection
+	Comorbidity []common.CodeableReference `json:"comorbidity,omitempty"`
+
+	// The status of the disease or symptom for the contraindication, for example "chronic" or "metastatic"
+	DiseaseStatus *common.CodeableReference `json:"diseaseStatus,omitempty"`
+
+	// The situation that is being documented as contraindicating against this item
+	DiseaseSymptomProcedure *common.CodeableReference `json:"diseaseSymptomProcedure,omitempty"`
+
+	// The indication which this is a contraidication for
+	Indication []common.Reference `json:"indication,omitempty"`
+
+	// Information about the use of the medicinal product in relation to other therapies described as part of the contraindication
+	OtherTherapy []ClinicalUseDefinitionContraindicationOtherTherapy `json:"otherTherapy,omitempty"`
+}
+
+// ClinicalUseDefinitionIndication represents specifics for when this is an indication
+type ClinicalUseDefinitionIndication struct {
+	common.BackboneElement
+
+	// A comorbidity (concurrent condition) or coinfection as part of the indication
+	Comorbidity []common.CodeableReference `json:"comorbidity,omitempty"`
+
+	// The status of the disease or symptom for the indication, for example "chronic" or "metastatic"
+	DiseaseStatus *common.CodeableReference `json:"diseaseStatus,omitempty"`
+
+	// The situation that is being documented as an indicaton for this item
+	DiseaseSymptomProcedure *common.CodeableReference `json:"diseaseSymptomProcedure,omitempty"`
+
+	// Timing or duration information, that may be associated with use with the indicated condition e.g. Adult patients suffering from myocardial infarction (from a few days until less than 35 days), ischaemic stroke (from 7 days until less than 6 months)
+	DurationRange *Range `json:"durationRange,omitempty"`
+
+	// Timing or duration information, that may be associated with use with the indicated condition e.g. Adult patients suffering from myocardial infarction (from a few days until less than 35 days), ischaemic stroke (from 7 days until less than 6 months)
+	DurationString        *string         `json:"durationString,omitempty"`
+	DurationStringElement *common.Element `json:"_durationString,omitempty"`
+
+	// The intended effect, aim or strategy to be achieved
+	IntendedEffect *common.CodeableReference `json:"intendedEffect,omitempty"`
+
+	// Information about the use of the medicinal product in relation to other therapies described as part of the indication
+	OtherTherapy []ClinicalUseDefinitionContraindicationOtherTherapy `json:"otherTherapy,omitempty"`
+
+	// An unwanted side effect or negative outcome that may happen if you use the drug
+	UndesirableEffect []common.Reference `json:"undesirableEffect,omitempty"`
+}
+
+// ClinicalUseDefinitionInteractionInteractant represents the specific medication, product, food etc. or laboratory test that interacts
+type ClinicalUseDefinitionInteractionInteractant struct {
+	common.BackboneElement
+
+	// The specific medication, food or laboratory test that interacts
+	ItemReference *common.Reference `json:"itemReference,omitempty"`
+
+	// The specific medication, food or laboratory test that interacts
+	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
+}
+
+// ClinicalUseDefinitionInteraction represents specifics for when this is an interaction
+type ClinicalUseDefinitionInteraction struct {
+	common.BackboneElement
+
+	// The effect of the interaction, for example "reduced gastric absorption of primary medication"
+	Effect *common.CodeableReference `json:"effect,omitempty"`
+
+	// The incidence of the interaction, e.g. theoretical, observed
+	Incidence *common.CodeableConcept `json:"incidence,omitempty"`
+
+	// The specific medication, food, substance or laboratory test that interacts
+	Interactant []ClinicalUseDefinitionInteractionInteractant `json:"interactant,omitempty"`
+
+	// Actions for managing the interaction
+	Management []common.CodeableConcept `json:"management,omitempty"`
+
+	// The type of the interaction e.g. drug-drug interaction, drug-food interaction, drug-lab test interaction
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ClinicalUseDefinitionUndesirableEffect represents a possible negative outcome from the use of this treatment
+type ClinicalUseDefinitionUndesirableEffect struct {
+	common.BackboneElement
+
+	// High level classification of the effect
+	Classification *common.CodeableConcept `json:"classification,omitempty"`
+
+	// How often the effect is seen
+	FrequencyOfOccurrence *common.CodeableConcept `json:"frequencyOfOccurrence,omitempty"`
+
+	// The situation in which the undesirable effect may manifest
+	SymptomConditionEffect *common.CodeableReference `json:"symptomConditionEffect,omitempty"`
+}
+
+// ClinicalUseDefinitionWarning represents critical environmental, health or physical risks or hazards
+type ClinicalUseDefinitionWarning struct {
+	common.BackboneElement
+
+	// A coded or unformatted textual definition of this warning
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// A textual definition of this warning, with formatting
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+}
@@ -0,0 +1,264 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CodeSystem represents a FHIR R4 CodeSystem resource
+type CodeSystem struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CodeSystem"
+
+	// Resource Type Name (for serialization)
+	CaseSensitive        *bool           `json:"caseSensitive,omitempty"`
+	CaseSensitiveElement *common.Element `json:"_caseSensitive,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Compositional        *bool           `json:"compositional,omitempty"`
+	CompositionalElement *common.Element `json:"_compositional,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Concept []CodeSystemConcept `json:"concept,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Content        CodeSystemContent `json:"content"`
+	ContentElement *common.Element   `json:"_content,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Filter []CodeSystemFilter `json:"filter,omitempty"`
+
+	// Resource Type Name (for serialization)
+	HierarchyMeaning        *CodeSystemHierarchyMeaning `json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaningElement *common.Element             `json:"_hierarchyMeaning,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Property []CodeSystemProperty `json:"property,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Status        CodeSystemStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Supplements        *string         `json:"supplements,omitempty"`
+	SupplementsElement *common.Element `json:"_supplements,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Resource Type Name (for serialization)
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// Resource Type Name (for serialization)
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Resource Type Name (for serialization)
+	ValueSet        *string         `json:"valueSet,omitempty"`
+	ValueSetElement *common.Element `json:"_valueSet,omitempty"`
+
+	// Resource Type Name (for serialization)
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+
+	// Resource Type Name (for serialization)
+	VersionNeeded        *bool           `json:"versionNeeded,omitempty"`
+	VersionNeededElement *common.Element `json:"_versionNeeded,omitempty"`
+}
+
+// CodeSystemContent represents the content of a code system
+type CodeSystemContent string
+
+const (
+	CodeSystemContentNotPresent CodeSystemContent = "not-present"
+	CodeSystemContentExample    CodeSystemContent = "example"
+	CodeSystemContentFragment   CodeSystemContent = "fragment"
+	CodeSystemContentComplete   CodeSystemContent = "complete"
+	CodeSystemContentSupplement CodeSystemContent = "supplement"
+)
+
+// CodeSystemHierarchyMeaning represents the hierarchy meaning of a code system
+type CodeSystemHierarchyMeaning string
+
+const (
+	CodeSystemHierarchyMeaningGroupedBy      CodeSystemHierarchyMeaning = "grouped-by"
+	CodeSystemHierarchyMeaningIsA            CodeSystemHierarchyMeaning = "is-a"
+	CodeSystemHierarchyMeaningPartOf         CodeSystemHierarchyMeaning = "part-of"
+	CodeSystemHierarchyMeaningClassifiedWith CodeSystemHierarchyMeaning = "classified-with"
+)
+
+// CodeSystemStatus represents the status of a code system
+type CodeSystemStatus string
+
+const (
+	CodeSystemStatusDraft   CodeSystemStatus = "draft"
+	CodeSystemStatusActive  CodeSystemStatus = "active"
+	CodeSystemStatusRetired CodeSystemStatus = "retired"
+	CodeSystemStatusUnknown CodeSystemStatus = "unknown"
+)
+
+// CodeSystemFilter represents filters
+type CodeSystemFilter struct {
+	common.BackboneElement
+
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	Operator        []CodeSystemFilterOperator `json:"operator"`
+	OperatorElement []*common.Element          `json:"_operator,omitempty"`
+
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CodeSystemFilterOperator represents the operator of a code system filter
+type CodeSystemFilterOperator string
+
+const (
+	CodeSystemFilterOperatorEquals       CodeSystemFilterOperator = "="
+	CodeSystemFilterOperatorIsA          CodeSystemFilterOperator = "is-a"
+	CodeSystemFilterOperatorDescendentOf CodeSystemFilterOperator = "descendent-of"
+	CodeSystemFilterOperatorIsNotA       CodeSystemFilterOperator = "is-not-a"
+	CodeSystemFilterOperatorRegex        CodeSystemFilterOperator = "regex"
+	CodeSystemFilterOperatorIn           CodeSystemFilterOperator = "in"
+	CodeSystemFilterOperatorNotIn        CodeSystemFilterOperator = "not-in"
+	CodeSystemFilterOperatorGeneralizes  CodeSystemFilterOperator = "generalizes"
+	CodeSystemFilterOperatorExists       CodeSystemFilterOperator = "exists"
+)
+
+// CodeSystemProperty represents properties
+type CodeSystemProperty struct {
+	common.BackboneElement
+
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	Type        CodeSystemPropertyType `json:"type"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
+
+	URI        *string         `json:"uri,omitempty"`
+	URIElement *common.Element `json:"_uri,omitempty"`
+}
+
+// CodeSystemPropertyType represents the type of a code system property
+type CodeSystemPropertyType string
+
+const (
+	CodeSystemPropertyTypeCode     CodeSystemPropertyType = "code"
+	CodeSystemPropertyTypeCoding   CodeSystemPropertyType = "Coding"
+	CodeSystemPropertyTypeString   CodeSystemPropertyType = "string"
+	CodeSystemPropertyTypeInteger  CodeSystemPropertyType = "integer"
+	CodeSystemPropertyTypeBoolean  CodeSystemPropertyType = "boolean"
+	CodeSystemPropertyTypeDateTime CodeSystemPropertyType = "dateTime"
+	CodeSystemPropertyTypeDecimal  CodeSystemPropertyType = "decimal"
+)
+
+// CodeSystemConceptDesignation represents concept designations
+type CodeSystemConceptDesignation struct {
+	common.BackboneElement
+
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	Use *common.Coding `json:"use,omitempty"`
+
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// CodeSystemConceptProperty represents concept properties
+type CodeSystemConceptProperty struct {
+	common.BackboneElement
+
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
+
+	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
+
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+}
+
+// CodeSystemConcept represents concepts
+type CodeSystemConcept struct {
+	common.BackboneElement
+
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Concept []CodeSystemConcept `json:"concept,omitempty"`
+
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	Designation []CodeSystemConceptDesignation `json:"designation,omitempty"`
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	Property []CodeSystemConceptProperty `json:"property,omitempty"`
+}
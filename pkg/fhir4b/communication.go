@@ -0,0 +1,127 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Communication represents an instance of information being transmitted
+type Communication struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Communication"
+
+	// Resources that pertain to this communication
+	About []common.Reference `json:"about,omitempty"`
+
+	// Request fulfilled by this communication
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Message category
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Encounter created as part of
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Unique identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Reply to
+	InResponseTo []common.Reference `json:"inResponseTo,omitempty"`
+
+	// Instantiates FHIR protocol or definition
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
+
+	// Instantiates external protocol or definition
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
+
+	// A channel of communication
+	Medium []common.CodeableConcept `json:"medium,omitempty"`
+
+	// Comments made about the communication
+	Note []Annotation `json:"note,omitempty"`
+
+	// Part of this action
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Message payload
+	Payload []CommunicationPayload `json:"payload,omitempty"`
+
+	// routine | urgent | asap | stat
+	Priority        *CommunicationPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
+
+	// Indication for message
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Why was communication done?
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// When received
+	Received        *common.DateTime `json:"received,omitempty"`
+	ReceivedElement *common.Element  `json:"_received,omitempty"`
+
+	// Message recipient
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// Message sender
+	Sender *common.Reference `json:"sender,omitempty"`
+
+	// When sent
+	Sent        *common.DateTime `json:"sent,omitempty"`
+	SentElement *common.Element  `json:"_sent,omitempty"`
+
+	// preparation | in-progress | not-done | on-hold | stopped | completed | entered-in-error | unknown
+	Status        CommunicationStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Reason for current status
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+
+	// Focus of message
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Description of the purpose/content
+	Topic *common.CodeableConcept `json:"topic,omitempty"`
+}
+
+// CommunicationPriority represents the priority of the communication
+type CommunicationPriority string
+
+const (
+	CommunicationPriorityRoutine CommunicationPriority = "routine"
+	CommunicationPriorityUrgent  CommunicationPriority = "urgent"
+	CommunicationPriorityASAP    CommunicationPriority = "asap"
+	CommunicationPriorityStat    CommunicationPriority = "stat"
+)
+
+// CommunicationStatus represents the status of the communication
+type CommunicationStatus string
+
+const (
+	CommunicationStatusPreparation    CommunicationStatus = "preparation"
+	CommunicationStatusInProgress     CommunicationStatus = "in-progress"
+	CommunicationStatusNotDone        CommunicationStatus = "not-done"
+	CommunicationStatusOnHold         CommunicationStatus = "on-hold"
+	CommunicationStatusStopped        CommunicationStatus = "stopped"
+	CommunicationStatusCompleted      CommunicationStatus = "completed"
+	CommunicationStatusEnteredInError CommunicationStatus = "entered-in-error"
+	CommunicationStatusUnknown        CommunicationStatus = "unknown"
+)
+
+// CommunicationPayload represents a message part content
+type CommunicationPayload struct {
+	common.BackboneElement
+
+	// Message part content
+	ContentString        *string         `json:"contentString,omitempty"`
+	ContentStringElement *common.Element `json:"_contentString,omitempty"`
+
+	// Message part content
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty"`
+
+	// Message part content
+	ContentReference *common.Reference `json:"contentReference,omitempty"`
+}
@@ -0,0 +1,99 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CommunicationRequest represents a FHIR R4 CommunicationRequest resource
+type CommunicationRequest struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CommunicationRequest"
+
+	About []common.Reference `json:"about,omitempty"`
+
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
+
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	Medium []common.CodeableConcept `json:"medium,omitempty"`
+
+	Note []Annotation `json:"note,omitempty"`
+
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+
+	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
+
+	Payload []CommunicationRequestPayload `json:"payload,omitempty"`
+
+	Priority        *CommunicationRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element               `json:"_priority,omitempty"`
+
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	Replaces []common.Reference `json:"replaces,omitempty"`
+
+	Requester *common.Reference `json:"requester,omitempty"`
+
+	Sender *common.Reference `json:"sender,omitempty"`
+
+	Status        CommunicationRequestStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
+
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// CommunicationRequestPriority represents the priority of a communication request
+type CommunicationRequestPriority string
+
+const (
+	CommunicationRequestPriorityRoutine CommunicationRequestPriority = "routine"
+	CommunicationRequestPriorityUrgent  CommunicationRequestPriority = "urgent"
+	CommunicationRequestPriorityAsap    CommunicationRequestPriority = "asap"
+	CommunicationRequestPriorityStat    CommunicationRequestPriority = "stat"
+)
+
+// CommunicationRequestStatus represents the status of a communication request
+type CommunicationRequestStatus string
+
+const (
+	CommunicationRequestStatusDraft          CommunicationRequestStatus = "draft"
+	CommunicationRequestStatusActive         CommunicationRequestStatus = "active"
+	CommunicationRequestStatusOnHold         CommunicationRequestStatus = "on-hold"
+	CommunicationRequestStatusRevoked        CommunicationRequestStatus = "revoked"
+	CommunicationRequestStatusCompleted      CommunicationRequestStatus = "completed"
+	CommunicationRequestStatusEnteredInError CommunicationRequestStatus = "entered-in-error"
+	CommunicationRequestStatusUnknown        CommunicationRequestStatus = "unknown"
+)
+
+// CommunicationRequestPayload represents message payload
+type CommunicationRequestPayload struct {
+	common.BackboneElement
+
+	ContentString        *string         `json:"contentString,omitempty"`
+	ContentStringElement *common.Element `json:"_contentString,omitempty"`
+
+	ContentAttachment *Attachment `json:"contentAttachment,omitempty"`
+
+	ContentReference *common.Reference `json:"contentReference,omitempty"`
+}
@@ -0,0 +1,87 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// CompartmentDefinition represents a FHIR R4 CompartmentDefinition resource
+type CompartmentDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "CompartmentDefinition"
+
+	Code        CompartmentDefinitionCode `json:"code"`
+	CodeElement *common.Element           `json:"_code,omitempty"`
+
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	Resource []CompartmentDefinitionResource `json:"resource,omitempty"`
+
+	Search        bool            `json:"search"`
+	SearchElement *common.Element `json:"_search,omitempty"`
+
+	Status        CompartmentDefinitionStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
+
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// CompartmentDefinitionCode represents the code of a compartment definition
+type CompartmentDefinitionCode string
+
+const (
+	CompartmentDefinitionCodePatient       CompartmentDefinitionCode = "Patient"
+	CompartmentDefinitionCodeEncounter     CompartmentDefinitionCode = "Encounter"
+	CompartmentDefinitionCodeRelatedPerson CompartmentDefinitionCode = "RelatedPerson"
+	CompartmentDefinitionCodePractitioner  CompartmentDefinitionCode = "Practitioner"
+	CompartmentDefinitionCodeDevice        CompartmentDefinitionCode = "Device"
+)
+
+// CompartmentDefinitionStatus represents the status of a compartment definition
+type CompartmentDefinitionStatus string
+
+const (
+	CompartmentDefinitionStatusDraft   CompartmentDefinitionStatus = "draft"
+	CompartmentDefinitionStatusActive  CompartmentDefinitionStatus = "active"
+	CompartmentDefinitionStatusRetired CompartmentDefinitionStatus = "retired"
+	CompartmentDefinitionStatusUnknown CompartmentDefinitionStatus = "unknown"
+)
+
+// CompartmentDefinitionResource represents how a resource is related to the compartment
+type CompartmentDefinitionResource struct {
+	common.BackboneElement
+
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	Param        []string          `json:"param,omitempty"`
+	ParamElement []*common.Element `json:"_param,omitempty"`
+}
@@ -0,0 +1,183 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Composition represents a set of healthcare-related information that is assembled together into a single logical package
+type Composition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Composition"
+
+	// Who attested the composition
+	Attester []CompositionAttester `json:"attester,omitempty"`
+
+	// Who and/or what authored the composition
+	Author []common.Reference `json:"author"`
+
+	// Categorization of Composition
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// The exact use of this element, and enforcement and issues related to highly sensitive documents are out of scope for the base specification, and delegated to implementation profiles (see security section)
+	Confidentiality        *string         `json:"confidentiality,omitempty"`
+	ConfidentialityElement *common.Element `json:"_confidentiality,omitempty"`
+
+	// Organization which maintains the composition
+	Custodian *common.Reference `json:"custodian,omitempty"`
+
+	// Composition editing time
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Context of the Composition
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// The clinical service(s) being documented
+	Event []CompositionEvent `json:"event,omitempty"`
+
+	// Logical identifier for the composition
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Relationships to other compositions/documents
+	RelatesTo []CompositionRelatesTo `json:"relatesTo,omitempty"`
+
+	// The root of the sections that make up the composition
+	Section []CompositionSection `json:"section,omitempty"`
+
+	// The workflow/clinical status of the composition
+	Status        CompositionStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// Who and/or what the composition is about
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Who and/or what authored the composition
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Kind of composition (LOINC if possible)
+	Type common.CodeableConcept `json:"type"`
+}
+
+// CompositionStatus represents the workflow/clinical status of the composition
+type CompositionStatus string
+
+const (
+	CompositionStatusPreliminary    CompositionStatus = "preliminary"
+	CompositionStatusFinal          CompositionStatus = "final"
+	CompositionStatusAmended        CompositionStatus = "amended"
+	CompositionStatusEnteredInError CompositionStatus = "entered-in-error"
+)
+
+// CompositionAttester represents an attester of the composition
+type CompositionAttester struct {
+	common.BackboneElement
+
+	// personal | professional | legal | official
+	Mode        CompositionAttesterMode `json:"mode"`
+	ModeElement *common.Element         `json:"_mode,omitempty"`
+
+	// Who attested the composition
+	Party *common.Reference `json:"party,omitempty"`
+
+	// When the composition was attested
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
+}
+
+// CompositionAttesterMode represents the mode of attestation
+type CompositionAttesterMode string
+
+const (
+	CompositionAttesterModePersonal     CompositionAttesterMode = "personal"
+	CompositionAttesterModeProfessional CompositionAttesterMode = "professional"
+	CompositionAttesterModeLegal        CompositionAttesterMode = "legal"
+	CompositionAttesterModeOfficial     CompositionAttesterMode = "official"
+)
+
+// CompositionRelatesTo represents relationships to other compositions/documents
+type CompositionRelatesTo struct {
+	common.BackboneElement
+
+	// replaces | transforms | signs | appends
+	Code        CompositionRelatesToCode `json:"code"`
+	CodeElement *common.Element          `json:"_code,omitempty"`
+
+	// Target of the relationship
+	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
+
+	// Target of the relationship
+	TargetReference *common.Reference `json:"targetReference,omitempty"`
+}
+
+// CompositionRelatesToCode represents the code for relationships to other compositions/documents
+type CompositionRelatesToCode string
+
+const (
+	CompositionRelatesToCodeReplaces   CompositionRelatesToCode = "replaces"
+	CompositionRelatesToCodeTransforms CompositionRelatesToCode = "transforms"
+	CompositionRelatesToCodeSigns      CompositionRelatesToCode = "signs"
+	CompositionRelatesToCodeAppends    CompositionRelatesToCode = "appends"
+)
+
+// CompositionEvent represents a clinical service being documented
+type CompositionEvent struct {
+	common.BackboneElement
+
+	// Code(s) that apply to the event being documented
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// The event(s) being documented
+	Detail []common.Reference `json:"detail,omitempty"`
+
+	// The period covered by the documentation
+	Period *common.Period `json:"period,omitempty"`
+}
+
+// CompositionSection represents a section in the composition
+type CompositionSection struct {
+	common.BackboneElement
+
+	// Who and/or what authored the section
+	Author []common.Reference `json:"author,omitempty"`
+
+	// Classification of section (recommended)
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Why the section is empty
+	EmptyReason *common.CodeableConcept `json:"emptyReason,omitempty"`
+
+	// A reference to data that supports this section
+	Entry []common.Reference `json:"entry,omitempty"`
+
+	// Who/what the section is about, when it is not about the subject of composition
+	Focus *common.Reference `json:"focus,omitempty"`
+
+	// working | snapshot | changes
+	Mode        *SectionMode    `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
+
+	// Order of section entries
+	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
+
+	// Nested Section
+	Section []CompositionSection `json:"section,omitempty"`
+
+	// Text summary of the section, for human interpretation
+	Text *Narrative `json:"text,omitempty"`
+
+	// Label for section (e.g. for ToC)
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// SectionMode represents the mode of a section
+type SectionMode string
+
+const (
+	SectionModeWorking  SectionMode = "working"
+	SectionModeSnapshot SectionMode = "snapshot"
+	SectionModeChanges  SectionMode = "changes"
+)
@@ -0,0 +1,192 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ConceptMap represents a FHIR R4 ConceptMap resource
+type ConceptMap struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ConceptMap"
+
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	Group []ConceptMapGroup `json:"group,omitempty"`
+
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	SourceUri        *string         `json:"sourceUri,omitempty"`
+	SourceUriElement *common.Element `json:"_sourceUri,omitempty"`
+
+	SourceCanonical        *string         `json:"sourceCanonical,omitempty"`
+	SourceCanonicalElement *common.Element `json:"_sourceCanonical,omitempty"`
+
+	Status        ConceptMapStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	TargetUri        *string         `json:"targetUri,omitempty"`
+	TargetUriElement *common.Element `json:"_targetUri,omitempty"`
+
+	TargetCanonical        *string         `json:"targetCanonical,omitempty"`
+	TargetCanonicalElement *common.Element `json:"_targetCanonical,omitempty"`
+
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ConceptMapStatus represents the status of a concept map
+type ConceptMapStatus string
+
+const (
+	ConceptMapStatusDraft   ConceptMapStatus = "draft"
+	ConceptMapStatusActive  ConceptMapStatus = "active"
+	ConceptMapStatusRetired ConceptMapStatus = "retired"
+	ConceptMapStatusUnknown ConceptMapStatus = "unknown"
+)
+
+// ConceptMapGroupElementTargetDependsOn represents other properties required for this mapping
+type ConceptMapGroupElementTargetDependsOn struct {
+	common.BackboneElement
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ConceptMapGroupElementTarget represents concept in target system for element
+type ConceptMapGroupElementTarget struct {
+	common.BackboneElement
+
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	DependsOn []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty"`
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	Equivalence        ConceptMapGroupElementTargetEquivalence `json:"equivalence"`
+	EquivalenceElement *common.Element                         `json:"_equivalence,omitempty"`
+
+	Product []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty"`
+}
+
+// ConceptMapGroupElementTargetEquivalence represents the equivalence of a concept map group element target
+type ConceptMapGroupElementTargetEquivalence string
+
+const (
+	ConceptMapGroupElementTargetEquivalenceRelatedto   ConceptMapGroupElementTargetEquivalence = "relatedto"
+	ConceptMapGroupElementTargetEquivalenceEquivalent  ConceptMapGroupElementTargetEquivalence = "equivalent"
+	ConceptMapGroupElementTargetEquivalenceEqual       ConceptMapGroupElementTargetEquivalence = "equal"
+	ConceptMapGroupElementTargetEquivalenceWider       ConceptMapGroupElementTargetEquivalence = "wider"
+	ConceptMapGroupElementTargetEquivalenceSubsumes    ConceptMapGroupElementTargetEquivalence = "subsumes"
+	ConceptMapGroupElementTargetEquivalenceNarrower    ConceptMapGroupElementTargetEquivalence = "narrower"
+	ConceptMapGroupElementTargetEquivalenceSpecializes ConceptMapGroupElementTargetEquivalence = "specializes"
+	ConceptMapGroupElementTargetEquivalenceInexact     ConceptMapGroupElementTargetEquivalence = "inexact"
+	ConceptMapGroupElementTargetEquivalenceUnmatched   ConceptMapGroupElementTargetEquivalence = "unmatched"
+	ConceptMapGroupElementTargetEquivalenceDisjoint    ConceptMapGroupElementTargetEquivalence = "disjoint"
+)
+
+// ConceptMapGroupElement represents mappings for a concept from the source set
+type ConceptMapGroupElement struct {
+	common.BackboneElement
+
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	Target []ConceptMapGroupElementTarget `json:"target,omitempty"`
+}
+
+// ConceptMapGroupUnmapped represents what to do when there is no mapping target for the source concept and ConceptMap.group.element.noMap is not true
+type ConceptMapGroupUnmapped struct {
+	common.BackboneElement
+
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	Mode        ConceptMapGroupUnmappedMode `json:"mode"`
+	ModeElement *common.Element             `json:"_mode,omitempty"`
+
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+}
+
+// ConceptMapGroupUnmappedMode represents the mode of a concept map group unmapped
+type ConceptMapGroupUnmappedMode string
+
+const (
+	ConceptMapGroupUnmappedModeProvided ConceptMapGroupUnmappedMode = "provided"
+	ConceptMapGroupUnmappedModeFixed    ConceptMapGroupUnmappedMode = "fixed"
+	ConceptMapGroupUnmappedModeOtherMap ConceptMapGroupUnmappedMode = "other-map"
+)
+
+// ConceptMapGroup represents same source and target systems
+type ConceptMapGroup struct {
+	common.BackboneElement
+
+	Element []ConceptMapGroupElement `json:"element"`
+
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	SourceVersion        *string         `json:"sourceVersion,omitempty"`
+	SourceVersionElement *common.Element `json:"_sourceVersion,omitempty"`
+
+	Target        *string         `json:"target,omitempty"`
+	TargetElement *common.Element `json:"_target,omitempty"`
+
+	TargetVersion        *string         `json:"targetVersion,omitempty"`
+	TargetVersionElement *common.Element `json:"_targetVersion,omitempty"`
+
+	Unmapped *ConceptMapGroupUnmapped `json:"unmapped,omitempty"`
+}
@@ -0,0 +1,178 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Consent represents a record of a healthcare consumer's choices
+type Consent struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Consent"
+
+	// Classification of the consent statement - for indexing/retrieval
+	Category []common.CodeableConcept `json:"category"`
+
+	// When consent was agreed to
+	DateTime        *common.DateTime `json:"dateTime,omitempty"`
+	DateTimeElement *common.Element  `json:"_dateTime,omitempty"`
+
+	// Identifier for this copy of the consent
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Custodian of the consent
+	Organization []common.Reference `json:"organization,omitempty"`
+
+	// Who the consent applies to
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// Who is agreeing to the policy and rules
+	Performer []common.Reference `json:"performer,omitempty"`
+
+	// Policies covered by this consent
+	Policy []ConsentPolicy `json:"policy,omitempty"`
+
+	// Regulation that this consents to
+	PolicyRule *common.CodeableConcept `json:"policyRule,omitempty"`
+
+	// Consent provision(s)
+	Provision *ConsentProvision `json:"provision,omitempty"`
+
+	// Which of the four areas this resource covers (extensible)
+	Scope common.CodeableConcept `json:"scope"`
+
+	// Source from which this consent is taken
+	SourceAttachment *Attachment `json:"sourceAttachment,omitempty"`
+
+	// Source from which this consent is taken
+	SourceReference *common.Reference `json:"sourceReference,omitempty"`
+
+	// draft | proposed | active | rejected | inactive | entered-in-error
+	Status        ConsentStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Contract verification
+	Verification []ConsentVerification `json:"verification,omitempty"`
+}
+
+// ConsentStatus represents the status of the consent
+type ConsentStatus string
+
+const (
+	ConsentStatusDraft          ConsentStatus = "draft"
+	ConsentStatusProposed       ConsentStatus = "proposed"
+	ConsentStatusActive         ConsentStatus = "active"
+	ConsentStatusRejected       ConsentStatus = "rejected"
+	ConsentStatusInactive       ConsentStatus = "inactive"
+	ConsentStatusEnteredInError ConsentStatus = "entered-in-error"
+)
+
+// ConsentPolicy represents policies covered by this consent
+type ConsentPolicy struct {
+	common.BackboneElement
+
+	// Entity or Organization having regulatory jurisdiction
+	Authority        *string         `json:"authority,omitempty"`
+	AuthorityElement *common.Element `json:"_authority,omitempty"`
+
+	// Specific policy covered by this consent
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
+}
+
+// ConsentVerification represents contract verification
+type ConsentVerification struct {
+	common.BackboneElement
+
+	// When consent verified
+	VerificationDate        *common.DateTime `json:"verificationDate,omitempty"`
+	VerificationDateElement *common.Element  `json:"_verificationDate,omitempty"`
+
+	// Has been verified
+	Verified        bool            `json:"verified"`
+	VerifiedElement *common.Element `json:"_verified,omitempty"`
+
+	// Person who verified
+	VerifiedWith *common.Reference `json:"verifiedWith,omitempty"`
+}
+
+// ConsentProvisionActor represents who|what controlled by this rule
+type ConsentProvisionActor struct {
+	common.BackboneElement
+
+	// Resource for the actor (or group, by role)
+	Reference common.Reference `json:"reference"`
+
+	// How the actor is involved
+	Role common.CodeableConcept `json:"role"`
+}
+
+// ConsentProvisionData represents data controlled by this rule
+type ConsentProvisionData struct {
+	common.BackboneElement
+
+	// instance | related | dependents | authoredby
+	Meaning        ConsentProvisionDataMeaning `json:"meaning"`
+	MeaningElement *common.Element             `json:"_meaning,omitempty"`
+
+	// The actual data reference
+	Reference common.Reference `json:"reference"`
+}
+
+// ConsentProvisionDataMeaning represents how the resource reference is interpreted
+type ConsentProvisionDataMeaning string
+
+const (
+	ConsentProvisionDataMeaningInstance   ConsentProvisionDataMeaning = "instance"
+	ConsentProvisionDataMeaningRelated    ConsentProvisionDataMeaning = "related"
+	ConsentProvisionDataMeaningDependents ConsentProvisionDataMeaning = "dependents"
+	ConsentProvisionDataMeaningAuthoredby ConsentProvisionDataMeaning = "authoredby"
+)
+
+// ConsentProvision represents constraints to the base Consent.policyRule
+type ConsentProvision struct {
+	common.BackboneElement
+
+	// Actions controlled by this rule
+	Action []common.CodeableConcept `json:"action,omitempty"`
+
+	// Who|what controlled by this rule
+	Actor []ConsentProvisionActor `json:"actor,omitempty"`
+
+	// e.g. Resource Type, Profile, CDA, etc.
+	Class []common.Coding `json:"class,omitempty"`
+
+	// e.g. LOINC or SNOMED CT code, etc. in the content
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// Data controlled by this rule
+	Data []ConsentProvisionData `json:"data,omitempty"`
+
+	// Timeframe for data controlled by this rule
+	DataPeriod *common.Period `json:"dataPeriod,omitempty"`
+
+	// Timeframe for this rule
+	Period *common.Period `json:"period,omitempty"`
+
+	// Nested Exception Rules
+	Provision []ConsentProvision `json:"provision,omitempty"`
+
+	// Context of activities covered by this rule
+	Purpose []common.Coding `json:"purpose,omitempty"`
+
+	// Security Labels that define affected resources
+	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
+
+	// deny | permit
+	Type        *ConsentProvisionType `json:"type,omitempty"`
+	TypeElement *common.Element       `json:"_type,omitempty"`
+}
+
+// ConsentProvisionType represents deny | permit
+type ConsentProvisionType string
+
+const (
+	ConsentProvisionTypeDeny   ConsentProvisionType = "deny"
+	ConsentProvisionTypePermit ConsentProvisionType = "permit"
+)
@@ -2,8 +2,6 @@
 package fhir4b
 
 import (
-	"time"
-
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
@@ -13,34 +11,43 @@ type Address struct {
 	common.Element
 
 	// The name of the city, town, suburb, village or other community or delivery center
-	City *string `json:"city,omitempty"`
+	City        *string         `json:"city,omitempty"`
+	CityElement *common.Element `json:"_city,omitempty"`
 
 	// ISO 3166 3 letter codes can be used in place of a human readable country name
-	Country *string `json:"country,omitempty"`
+	Country        *string         `json:"country,omitempty"`
+	CountryElement *common.Element `json:"_country,omitempty"`
 
 	// District is sometimes known as county
-	District *string `json:"district,omitempty"`
+	District        *string         `json:"district,omitempty"`
+	DistrictElement *common.Element `json:"_district,omitempty"`
 
 	// House number, apartment number, street name, street direction, P.O. Box number, delivery hints
-	Line []string `json:"line,omitempty"`
+	Line        []string          `json:"line,omitempty"`
+	LineElement []*common.Element `json:"_line,omitempty"`
 
 	// Time period when address was/is in use
 	Period *common.Period `json:"period,omitempty"`
 
 	// A postal code designating a region defined by the postal service
-	PostalCode *string `json:"postalCode,omitempty"`
+	PostalCode        *string         `json:"postalCode,omitempty"`
+	PostalCodeElement *common.Element `json:"_postalCode,omitempty"`
 
 	// Sub-unit of a country with limited sovereignty in a federally organized country
-	State *string `json:"state,omitempty"`
+	State        *string         `json:"state,omitempty"`
+	StateElement *common.Element `json:"_state,omitempty"`
 
 	// Text representation of the address
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// postal | physical | both - The definition of Address
-	Type *AddressType `json:"type,omitempty"`
+	Type        *AddressType    `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// home | work | temp | old | billing - purpose of this address
-	Use *AddressUse `json:"use,omitempty"`
+	Use        *AddressUse     `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 }
 
 // AddressType represents the type of address
@@ -73,14 +80,17 @@ type Annotation struct {
 	common.Element
 
 	// Organization is used when there's no need for specific attribution
-	AuthorReference *common.Reference `json:"authorReference,omitempty"`
-	AuthorString    *string           `json:"authorString,omitempty"`
+	AuthorReference     *common.Reference `json:"authorReference,omitempty"`
+	AuthorString        *string           `json:"authorString,omitempty"`
+	AuthorStringElement *common.Element   `json:"_authorString,omitempty"`
 
 	// The text of the annotation in markdown format
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Indicates when this particular annotation was made
-	Time *time.Time `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 }
 
 // Attachment represents data content defined in other formats
@@ -88,28 +98,36 @@ type Attachment struct {
 	common.Element
 
 	// Identifies the type of the data in the attachment
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The date that the attachment was first created
-	Creation *time.Time `json:"creation,omitempty"`
+	Creation        *common.DateTime `json:"creation,omitempty"`
+	CreationElement *common.Element  `json:"_creation,omitempty"`
 
 	// The base64-encoded data
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// Hash of the data (sha-1, base64ed)
-	Hash *string `json:"hash,omitempty"`
+	Hash        *string         `json:"hash,omitempty"`
+	HashElement *common.Element `json:"_hash,omitempty"`
 
 	// Human language of the content (BCP-47)
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 
 	// Number of bytes of content (if url provided)
-	Size *int `json:"size,omitempty"`
+	Size        *int            `json:"size,omitempty"`
+	SizeElement *common.Element `json:"_size,omitempty"`
 
 	// Label to display in place of the data
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Uri where the data can be found
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // ContactDetail specifies contact information for a person or organization
@@ -117,7 +135,8 @@ type ContactDetail struct {
 	common.Element
 
 	// Name of an individual to contact
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Contact details for individual or organization
 	Telecom []ContactPoint `json:"telecom,omitempty"`
@@ -131,16 +150,20 @@ type ContactPoint struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// Rank for determining order of preference
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// phone | fax | email | pager | url | sms | other
-	System *ContactPointSystem `json:"system,omitempty"`
+	System        *ContactPointSystem `json:"system,omitempty"`
+	SystemElement *common.Element     `json:"_system,omitempty"`
 
 	// home | work | temp | old | mobile
-	Use *ContactPointUse `json:"use,omitempty"`
+	Use        *ContactPointUse `json:"use,omitempty"`
+	UseElement *common.Element  `json:"_use,omitempty"`
 
 	// The actual contact point details
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ContactPointSystem represents telecommunications form for contact point
@@ -172,22 +195,28 @@ type HumanName struct {
 	common.Element
 
 	// usual | official | temp | nickname | anonymous | old | maiden
-	Use *HumanNameUse `json:"use,omitempty"`
+	Use        *HumanNameUse   `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 
 	// Text representation of the full name
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Family name (often called 'Surname')
-	Family *string `json:"family,omitempty"`
+	Family        *string         `json:"family,omitempty"`
+	FamilyElement *common.Element `json:"_family,omitempty"`
 
 	// Given names (not always 'first'). Includes middle names
-	Given []string `json:"given,omitempty"`
+	Given        []string          `json:"given,omitempty"`
+	GivenElement []*common.Element `json:"_given,omitempty"`
 
 	// Parts that come before the name
-	Prefix []string `json:"prefix,omitempty"`
+	Prefix        []string          `json:"prefix,omitempty"`
+	PrefixElement []*common.Element `json:"_prefix,omitempty"`
 
 	// Parts that come after the name
-	Suffix []string `json:"suffix,omitempty"`
+	Suffix        []string          `json:"suffix,omitempty"`
+	SuffixElement []*common.Element `json:"_suffix,omitempty"`
 
 	// Time period when name was/is in use
 	Period *common.Period `json:"period,omitempty"`
@@ -214,10 +243,12 @@ type Contributor struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// The name of the individual or organization responsible for the contribution
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// author | editor | reviewer | endorser
-	Type ContributorType `json:"type"`
+	Type        ContributorType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // ContributorType represents the type of contributor
@@ -0,0 +1,183 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DeviceDefinition represents the DeviceDefinition resource
+type DeviceDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "DeviceDefinition"
+
+	// Device capabilities
+	Capability []DeviceDefinitionCapability `json:"capability,omitempty"`
+
+	// Used for troubleshooting etc
+	Contact []ContactPoint `json:"contact,omitempty"`
+
+	// A name given to the device to identify it
+	DeviceName []DeviceDefinitionDeviceName `json:"deviceName,omitempty"`
+
+	// Unique instance identifiers assigned to a device by the software, manufacturers, other organizations or owners
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Language code for the human-readable text strings produced by the device (all supported)
+	LanguageCode []common.CodeableConcept `json:"languageCode,omitempty"`
+
+	// A name of the manufacturer
+	ManufacturerString        *string         `json:"manufacturerString,omitempty"`
+	ManufacturerStringElement *common.Element `json:"_manufacturerString,omitempty"`
+
+	// A name of the manufacturer
+	ManufacturerReference *common.Reference `json:"manufacturerReference,omitempty"`
+
+	// A substance used to create the material(s) of which the device is made
+	Material []DeviceDefinitionMaterial `json:"material,omitempty"`
+
+	// The model number for the device
+	ModelNumber        *string         `json:"modelNumber,omitempty"`
+	ModelNumberElement *common.Element `json:"_modelNumber,omitempty"`
+
+	// Descriptive information, usage information or implantation information that is not captured in an existing element
+	Note []Annotation `json:"note,omitempty"`
+
+	// Access to on-line information about the device
+	OnlineInformation        *string         `json:"onlineInformation,omitempty"`
+	OnlineInformationElement *common.Element `json:"_onlineInformation,omitempty"`
+
+	// An organization that is responsible for the provision and ongoing maintenance of the device
+	Owner *common.Reference `json:"owner,omitempty"`
+
+	// The parent device it can be part of
+	ParentDevice *common.Reference `json:"parentDevice,omitempty"`
+
+	// Dimensions, color etc
+	PhysicalCharacteristics *ProdCharacteristic `json:"physicalCharacteristics,omitempty"`
+
+	// The actual configuration settings of a device as it actually operates, e.g., regulation status, time properties
+	Property []DeviceDefinitionProperty `json:"property,omitempty"`
+
+	// The quantity of the device present in the packaging (e.g. the number of devices present in a pack, or the number of devices in the same package of the medicinal product)
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Safety characteristics of the device
+	Safety []common.CodeableConcept `json:"safety,omitempty"`
+
+	// Shelf Life and storage information
+	ShelfLifeStorage []ProductShelfLife `json:"shelfLifeStorage,omitempty"`
+
+	// The capabilities supported on a device, the standards to which the device conforms for a particular purpose, and used for the communication
+	Specialization []DeviceDefinitionSpecialization `json:"specialization,omitempty"`
+
+	// What kind of device or device system this is
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Unique device identifier (UDI) assigned to device label or package
+	UdiDeviceIdentifier []DeviceDefinitionUdiDeviceIdentifier `json:"udiDeviceIdentifier,omitempty"`
+
+	// If the device is running a FHIR server, the network address should be the Base URL from which a conformance statement may be retrieved
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// The available versions of the device, e.g., software versions
+	Version        []string          `json:"version,omitempty"`
+	VersionElement []*common.Element `json:"_version,omitempty"`
+}
+
+// DeviceDefinitionUdiDeviceIdentifier represents UDI device identifier
+type DeviceDefinitionUdiDeviceIdentifier struct {
+	common.BackboneElement
+
+	// The identifier that is to be associated with every Device that references this DeviceDefinition
+	DeviceIdentifier        string          `json:"deviceIdentifier"`
+	DeviceIdentifierElement *common.Element `json:"_deviceIdentifier,omitempty"`
+
+	// The organization that assigns the identifier algorithm
+	Issuer        string          `json:"issuer"`
+	IssuerElement *common.Element `json:"_issuer,omitempty"`
+
+	// The jurisdiction to which the deviceIdentifier applies
+	Jurisdiction        string          `json:"jurisdiction"`
+	JurisdictionElement *common.Element `json:"_jurisdiction,omitempty"`
+}
+
+// DeviceDefinitionDeviceName represents a name given to the device to identify it
+type DeviceDefinitionDeviceName struct {
+	common.BackboneElement
+
+	// The name of the device
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The type of deviceName
+	Type        DeviceDefinitionDeviceNameType `json:"type"`
+	TypeElement *common.Element                `json:"_type,omitempty"`
+}
+
+// DeviceDefinitionDeviceNameType represents the type of device name
+type DeviceDefinitionDeviceNameType string
+
+const (
+	DeviceDefinitionDeviceNameTypeUdiLabelName        DeviceDefinitionDeviceNameType = "udi-label-name"
+	DeviceDefinitionDeviceNameTypeUserFriendlyName    DeviceDefinitionDeviceNameType = "user-friendly-name"
+	DeviceDefinitionDeviceNameTypePatientReportedName DeviceDefinitionDeviceNameType = "patient-reported-name"
+	DeviceDefinitionDeviceNameTypeManufacturerName    DeviceDefinitionDeviceNameType = "manufacturer-name"
+	DeviceDefinitionDeviceNameTypeModelName           DeviceDefinitionDeviceNameType = "model-name"
+	DeviceDefinitionDeviceNameTypeOther               DeviceDefinitionDeviceNameType = "other"
+)
+
+// DeviceDefinitionSpecialization represents the capabilities supported on a device
+type DeviceDefinitionSpecialization struct {
+	common.BackboneElement
+
+	// The standard that is used to operate and communicate
+	SystemType        string          `json:"systemType"`
+	SystemTypeElement *common.Element `json:"_systemType,omitempty"`
+
+	// The version of the standard that is used to operate and communicate
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// DeviceDefinitionCapability represents device capabilities
+type DeviceDefinitionCapability struct {
+	common.BackboneElement
+
+	// Description of capability
+	Description []common.CodeableConcept `json:"description,omitempty"`
+
+	// Type of capability
+	Type common.CodeableConcept `json:"type"`
+}
+
+// DeviceDefinitionProperty represents the actual configuration settings of a device
+type DeviceDefinitionProperty struct {
+	common.BackboneElement
+
+	// Code that specifies the property DeviceDefinitionPropertyCode (Extensible)
+	Type common.CodeableConcept `json:"type"`
+
+	// Property value as a code, e.g., NTP4 (synced to NTP)
+	ValueCode []common.CodeableConcept `json:"valueCode,omitempty"`
+
+	// Property value as a quantity
+	ValueQuantity []common.Quantity `json:"valueQuantity,omitempty"`
+}
+
+// DeviceDefinitionMaterial represents a substance used to create the material(s) of which the device is made
+type DeviceDefinitionMaterial struct {
+	common.BackboneElement
+
+	// Whether the substance is a known or suspected allergen
+	AllergenicIndicator        *bool           `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *common.Element `json:"_allergenicIndicator,omitempty"`
+
+	// Indicates an alternative material of the device
+	Alternate        *bool           `json:"alternate,omitempty"`
+	AlternateElement *common.Element `json:"_alternate,omitempty"`
+
+	// The substance
+	Substance common.CodeableConcept `json:"substance"`
+}
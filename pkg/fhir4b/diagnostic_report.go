@@ -0,0 +1,102 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DiagnosticReport represents the findings and interpretation of diagnostic tests
+type DiagnosticReport struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "DiagnosticReport"
+
+	// Details concerning a service requested
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Service category
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Name/Code for this diagnostic report
+	Code common.CodeableConcept `json:"code"`
+
+	// Clinical conclusion (interpretation) of test results
+	Conclusion        *string         `json:"conclusion,omitempty"`
+	ConclusionElement *common.Element `json:"_conclusion,omitempty"`
+
+	// Codes for the clinical conclusion of test results
+	ConclusionCode []common.CodeableConcept `json:"conclusionCode,omitempty"`
+
+	// Clinically relevant time/time-period for report
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+
+	// Clinically relevant time/time-period for report
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// Health care event when test ordered
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Business identifier for report
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// ImagingStudy and the image element are somewhat overlapping - typically
+	ImagingStudy []common.Reference `json:"imagingStudy,omitempty"`
+
+	// DateTime this version was made
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
+
+	// Key images associated with this report
+	Media []DiagnosticReportMedia `json:"media,omitempty"`
+
+	// Responsible Diagnostic Service
+	Performer []common.Reference `json:"performer,omitempty"`
+
+	// Entire report as attachment
+	PresentedForm []Attachment `json:"presentedForm,omitempty"`
+
+	// Observations
+	Result []common.Reference `json:"result,omitempty"`
+
+	// Primary result interpreter
+	ResultsInterpreter []common.Reference `json:"resultsInterpreter,omitempty"`
+
+	// Specimens this report is based on
+	Specimen []common.Reference `json:"specimen,omitempty"`
+
+	// registered | partial | preliminary | final | amended | corrected | appended | cancelled | entered-in-error | unknown
+	Status        DiagnosticReportStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// The subject of the report - usually, but not always, this is a patient
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// DiagnosticReportStatus represents the status of the diagnostic report
+type DiagnosticReportStatus string
+
+const (
+	DiagnosticReportStatusRegistered     DiagnosticReportStatus = "registered"
+	DiagnosticReportStatusPartial        DiagnosticReportStatus = "partial"
+	DiagnosticReportStatusPreliminary    DiagnosticReportStatus = "preliminary"
+	DiagnosticReportStatusFinal          DiagnosticReportStatus = "final"
+	DiagnosticReportStatusAmended        DiagnosticReportStatus = "amended"
+	DiagnosticReportStatusCorrected      DiagnosticReportStatus = "corrected"
+	DiagnosticReportStatusAppended       DiagnosticReportStatus = "appended"
+	DiagnosticReportStatusCancelled      DiagnosticReportStatus = "cancelled"
+	DiagnosticReportStatusEnteredInError DiagnosticReportStatus = "entered-in-error"
+	DiagnosticReportStatusUnknown        DiagnosticReportStatus = "unknown"
+)
+
+// DiagnosticReportMedia represents key images associated with the report
+type DiagnosticReportMedia struct {
+	common.BackboneElement
+
+	// Comment about the image (e.g. explanation)
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Reference to the image source
+	Link common.Reference `json:"link"`
+}
@@ -0,0 +1,143 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// DocumentReference represents a reference to a document
+type DocumentReference struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "DocumentReference"
+
+	// Who/what authenticated the document
+	Authenticator *common.Reference `json:"authenticator,omitempty"`
+
+	// Who and/or what authored the document
+	Author []common.Reference `json:"author,omitempty"`
+
+	// Categorization of document
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Document referenced
+	Content []DocumentReferenceContent `json:"content"`
+
+	// Clinical context of document
+	Context *DocumentReferenceContext `json:"context,omitempty"`
+
+	// Organization which maintains the document
+	Custodian *common.Reference `json:"custodian,omitempty"`
+
+	// When this document reference was created
+	Date        *common.Instant `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// Human-readable description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// preliminary | final | amended | entered-in-error
+	DocStatus        *DocumentReferenceDocStatus `json:"docStatus,omitempty"`
+	DocStatusElement *common.Element             `json:"_docStatus,omitempty"`
+
+	// Other identifiers for the document
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Master Version Specific Identifier
+	MasterIdentifier *common.Identifier `json:"masterIdentifier,omitempty"`
+
+	// Relationships to other documents
+	RelatesTo []DocumentReferenceRelatesTo `json:"relatesTo,omitempty"`
+
+	// Document security-tags
+	SecurityLabel []common.CodeableConcept `json:"securityLabel,omitempty"`
+
+	// current | superseded | entered-in-error
+	Status        DocumentReferenceStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
+
+	// Who/what is the subject of the document
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Kind of document (LOINC if possible)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// DocumentReferenceDocStatus represents the document status
+type DocumentReferenceDocStatus string
+
+const (
+	DocumentReferenceDocStatusPreliminary    DocumentReferenceDocStatus = "preliminary"
+	DocumentReferenceDocStatusFinal          DocumentReferenceDocStatus = "final"
+	DocumentReferenceDocStatusAmended        DocumentReferenceDocStatus = "amended"
+	DocumentReferenceDocStatusEnteredInError DocumentReferenceDocStatus = "entered-in-error"
+)
+
+// DocumentReferenceStatus represents the status of the document reference
+type DocumentReferenceStatus string
+
+const (
+	DocumentReferenceStatusCurrent        DocumentReferenceStatus = "current"
+	DocumentReferenceStatusSuperseded     DocumentReferenceStatus = "superseded"
+	DocumentReferenceStatusEnteredInError DocumentReferenceStatus = "entered-in-error"
+)
+
+// DocumentReferenceRelatesTo represents relationships to other documents
+type DocumentReferenceRelatesTo struct {
+	common.BackboneElement
+
+	// replaces | transforms | signs | appends
+	Code        DocumentReferenceRelatesToCode `json:"code"`
+	CodeElement *common.Element                `json:"_code,omitempty"`
+
+	// Target of the relationship
+	Target common.Reference `json:"target"`
+}
+
+// DocumentReferenceRelatesToCode represents the code for relationships to other documents
+type DocumentReferenceRelatesToCode string
+
+const (
+	DocumentReferenceRelatesToCodeReplaces   DocumentReferenceRelatesToCode = "replaces"
+	DocumentReferenceRelatesToCodeTransforms DocumentReferenceRelatesToCode = "transforms"
+	DocumentReferenceRelatesToCodeSigns      DocumentReferenceRelatesToCode = "signs"
+	DocumentReferenceRelatesToCodeAppends    DocumentReferenceRelatesToCode = "appends"
+)
+
+// DocumentReferenceContent represents content of the document reference
+type DocumentReferenceContent struct {
+	common.BackboneElement
+
+	// Where to access the document
+	Attachment Attachment `json:"attachment"`
+
+	// Format/content rules for the document
+	Format *common.Coding `json:"format,omitempty"`
+}
+
+// DocumentReferenceContext represents clinical context of the document
+type DocumentReferenceContext struct {
+	common.BackboneElement
+
+	// Context of the document content
+	Encounter []common.Reference `json:"encounter,omitempty"`
+
+	// Main clinical acts documented
+	Event []common.CodeableConcept `json:"event,omitempty"`
+
+	// Kind of facility where patient was seen
+	FacilityType *common.CodeableConcept `json:"facilityType,omitempty"`
+
+	// Time of service that is being documented
+	Period *common.Period `json:"period,omitempty"`
+
+	// Additional details about where the content was created (e.g. clinical specialty)
+	PracticeSetting *common.CodeableConcept `json:"practiceSetting,omitempty"`
+
+	// Related identifiers or resources
+	Related []common.Reference `json:"related,omitempty"`
+
+	// Patient demographics from source
+	SourcePatientInfo *common.Reference `json:"sourcePatientInfo,omitempty"`
+}
@@ -0,0 +1,666 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ElementDefinition represents definition of an element in a resource or extension
+type ElementDefinition struct {
+	common.BackboneElement
+
+	// Other names
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
+
+	// Base definition information for tools
+	Base *ElementDefinitionBase `json:"base,omitempty"`
+
+	// ValueSet details if this is coded
+	Binding *ElementDefinitionBinding `json:"binding,omitempty"`
+
+	// Corresponding codes in terminologies
+	Code []common.Coding `json:"code,omitempty"`
+
+	// Comments about the use of this element
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Reference to invariant about presence
+	Condition        []string          `json:"condition,omitempty"`
+	ConditionElement []*common.Element `json:"_condition,omitempty"`
+
+	// Condition that must evaluate to true
+	Constraint []ElementDefinitionConstraint `json:"constraint,omitempty"`
+
+	// Reference to definition of content for the element
+	ContentReference        *string         `json:"contentReference,omitempty"`
+	ContentReferenceElement *common.Element `json:"_contentReference,omitempty"`
+
+	// Specified value if missing from instance
+	DefaultValueBase64Binary        *string                     `json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *common.Element             `json:"_defaultValueBase64Binary,omitempty"`
+	DefaultValueBoolean             *bool                       `json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement      *common.Element             `json:"_defaultValueBoolean,omitempty"`
+	DefaultValueCanonical           *string                     `json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement    *common.Element             `json:"_defaultValueCanonical,omitempty"`
+	DefaultValueCode                *string                     `json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement         *common.Element             `json:"_defaultValueCode,omitempty"`
+	DefaultValueDate                *common.Date                `json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement         *common.Element             `json:"_defaultValueDate,omitempty"`
+	DefaultValueDateTime            *common.DateTime            `json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement     *common.Element             `json:"_defaultValueDateTime,omitempty"`
+	DefaultValueDecimal             *common.Decimal             `json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement      *common.Element             `json:"_defaultValueDecimal,omitempty"`
+	DefaultValueId                  *string                     `json:"defaultValueId,omitempty"`
+	DefaultValueIdElement           *common.Element             `json:"_defaultValueId,omitempty"`
+	DefaultValueInstant             *common.Instant             `json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement      *common.Element             `json:"_defaultValueInstant,omitempty"`
+	DefaultValueInteger             *int                        `json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement      *common.Element             `json:"_defaultValueInteger,omitempty"`
+	DefaultValueMarkdown            *string                     `json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement     *common.Element             `json:"_defaultValueMarkdown,omitempty"`
+	DefaultValueOid                 *string                     `json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement          *common.Element             `json:"_defaultValueOid,omitempty"`
+	DefaultValuePositiveInt         *int                        `json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement  *common.Element             `json:"_defaultValuePositiveInt,omitempty"`
+	DefaultValueString              *string                     `json:"defaultValueString,omitempty"`
+	DefaultValueStringElement       *common.Element             `json:"_defaultValueString,omitempty"`
+	DefaultValueTime                *common.Time                `json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement         *common.Element             `json:"_defaultValueTime,omitempty"`
+	DefaultValueUnsignedInt         *int                        `json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement  *common.Element             `json:"_defaultValueUnsignedInt,omitempty"`
+	DefaultValueUri                 *string                     `json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement          *common.Element             `json:"_defaultValueUri,omitempty"`
+	DefaultValueUrl                 *string                     `json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement          *common.Element             `json:"_defaultValueUrl,omitempty"`
+	DefaultValueUuid                *string                     `json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement         *common.Element             `json:"_defaultValueUuid,omitempty"`
+	DefaultValueAddress             *Address                    `json:"defaultValueAddress,omitempty"`
+	DefaultValueAge                 *Age                        `json:"defaultValueAge,omitempty"`
+	DefaultValueAnnotation          *Annotation                 `json:"defaultValueAnnotation,omitempty"`
+	DefaultValueAttachment          *Attachment                 `json:"defaultValueAttachment,omitempty"`
+	DefaultValueCodeableConcept     *common.CodeableConcept     `json:"defaultValueCodeableConcept,omitempty"`
+	DefaultValueCodeableReference   *common.CodeableReference   `json:"defaultValueCodeableReference,omitempty"`
+	DefaultValueCoding              *common.Coding              `json:"defaultValueCoding,omitempty"`
+	DefaultValueContactPoint        *ContactPoint               `json:"defaultValueContactPoint,omitempty"`
+	DefaultValueCount               *Count                      `json:"defaultValueCount,omitempty"`
+	DefaultValueDistance            *Distance                   `json:"defaultValueDistance,omitempty"`
+	DefaultValueDuration            *Duration                   `json:"defaultValueDuration,omitempty"`
+	DefaultValueHumanName           *HumanName                  `json:"defaultValueHumanName,omitempty"`
+	DefaultValueIdentifier          *common.Identifier          `json:"defaultValueIdentifier,omitempty"`
+	DefaultValueMoney               *common.Money               `json:"defaultValueMoney,omitempty"`
+	DefaultValuePeriod              *common.Period              `json:"defaultValuePeriod,omitempty"`
+	DefaultValueQuantity            *common.Quantity            `json:"defaultValueQuantity,omitempty"`
+	DefaultValueRange               *Range                      `json:"defaultValueRange,omitempty"`
+	DefaultValueRatio               *Ratio                      `json:"defaultValueRatio,omitempty"`
+	DefaultValueRatioRange          *RatioRange                 `json:"defaultValueRatioRange,omitempty"`
+	DefaultValueReference           *common.Reference           `json:"defaultValueReference,omitempty"`
+	DefaultValueSampledData         *common.SampledData         `json:"defaultValueSampledData,omitempty"`
+	DefaultValueSignature           *common.Signature           `json:"defaultValueSignature,omitempty"`
+	DefaultValueTiming              *Timing                     `json:"defaultValueTiming,omitempty"`
+	DefaultValueContactDetail       *ContactDetail              `json:"defaultValueContactDetail,omitempty"`
+	DefaultValueContributor         *Contributor                `json:"defaultValueContributor,omitempty"`
+	DefaultValueDataRequirement     *common.DataRequirement     `json:"defaultValueDataRequirement,omitempty"`
+	DefaultValueExpression          *common.Expression          `json:"defaultValueExpression,omitempty"`
+	DefaultValueParameterDefinition *common.ParameterDefinition `json:"defaultValueParameterDefinition,omitempty"`
+	DefaultValueRelatedArtifact     *common.RelatedArtifact     `json:"defaultValueRelatedArtifact,omitempty"`
+	DefaultValueTriggerDefinition   *common.TriggerDefinition   `json:"defaultValueTriggerDefinition,omitempty"`
+	DefaultValueUsageContext        *common.UsageContext        `json:"defaultValueUsageContext,omitempty"`
+	DefaultValueDosage              *Dosage                     `json:"defaultValueDosage,omitempty"`
+
+	// Full formal definition as narrative text
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// Example value (as defined for type)
+	Example []ElementDefinitionExample `json:"example,omitempty"`
+
+	// Value must be exactly this
+	FixedBase64Binary        *string                     `json:"fixedBase64Binary,omitempty"`
+	FixedBase64BinaryElement *common.Element             `json:"_fixedBase64Binary,omitempty"`
+	FixedBoolean             *bool                       `json:"fixedBoolean,omitempty"`
+	FixedBooleanElement      *common.Element             `json:"_fixedBoolean,omitempty"`
+	FixedCanonical           *string                     `json:"fixedCanonical,omitempty"`
+	FixedCanonicalElement    *common.Element             `json:"_fixedCanonical,omitempty"`
+	FixedCode                *string                     `json:"fixedCode,omitempty"`
+	FixedCodeElement         *common.Element             `json:"_fixedCode,omitempty"`
+	FixedDate                *common.Date                `json:"fixedDate,omitempty"`
+	FixedDateElement         *common.Element             `json:"_fixedDate,omitempty"`
+	FixedDateTime            *common.DateTime            `json:"fixedDateTime,omitempty"`
+	FixedDateTimeElement     *common.Element             `json:"_fixedDateTime,omitempty"`
+	FixedDecimal             *common.Decimal             `json:"fixedDecimal,omitempty"`
+	FixedDecimalElement      *common.Element             `json:"_fixedDecimal,omitempty"`
+	FixedId                  *string                     `json:"fixedId,omitempty"`
+	FixedIdElement           *common.Element             `json:"_fixedId,omitempty"`
+	FixedInstant             *common.Instant             `json:"fixedInstant,omitempty"`
+	FixedInstantElement      *common.Element             `json:"_fixedInstant,omitempty"`
+	FixedInteger             *int                        `json:"fixedInteger,omitempty"`
+	FixedIntegerElement      *common.Element             `json:"_fixedInteger,omitempty"`
+	FixedMarkdown            *string                     `json:"fixedMarkdown,omitempty"`
+	FixedMarkdownElement     *common.Element             `json:"_fixedMarkdown,omitempty"`
+	FixedOid                 *string                     `json:"fixedOid,omitempty"`
+	FixedOidElement          *common.Element             `json:"_fixedOid,omitempty"`
+	FixedPositiveInt         *int                        `json:"fixedPositiveInt,omitempty"`
+	FixedPositiveIntElement  *common.Element             `json:"_fixedPositiveInt,omitempty"`
+	FixedString              *string                     `json:"fixedString,omitempty"`
+	FixedStringElement       *common.Element             `json:"_fixedString,omitempty"`
+	FixedTime                *common.Time                `json:"fixedTime,omitempty"`
+	FixedTimeElement         *common.Element             `json:"_fixedTime,omitempty"`
+	FixedUnsignedInt         *int                        `json:"fixedUnsignedInt,omitempty"`
+	FixedUnsignedIntElement  *common.Element             `json:"_fixedUnsignedInt,omitempty"`
+	FixedUri                 *string                     `json:"fixedUri,omitempty"`
+	FixedUriElement          *common.Element             `json:"_fixedUri,omitempty"`
+	FixedUrl                 *string                     `json:"fixedUrl,omitempty"`
+	FixedUrlElement          *common.Element             `json:"_fixedUrl,omitempty"`
+	FixedUuid                *string                     `json:"fixedUuid,omitempty"`
+	FixedUuidElement         *common.Element             `json:"_fixedUuid,omitempty"`
+	FixedAddress             *Address                    `json:"fixedAddress,omitempty"`
+	FixedAge                 *Age                        `json:"fixedAge,omitempty"`
+	FixedAnnotation          *Annotation                 `json:"fixedAnnotation,omitempty"`
+	FixedAttachment          *Attachment                 `json:"fixedAttachment,omitempty"`
+	FixedCodeableConcept     *common.CodeableConcept     `json:"fixedCodeableConcept,omitempty"`
+	FixedCodeableReference   *common.CodeableReference   `json:"fixedCodeableReference,omitempty"`
+	FixedCoding              *common.Coding              `json:"fixedCoding,omitempty"`
+	FixedContactPoint        *ContactPoint               `json:"fixedContactPoint,omitempty"`
+	FixedCount               *Count                      `json:"fixedCount,omitempty"`
+	FixedDistance            *Distance                   `json:"fixedDistance,omitempty"`
+	FixedDuration            *Duration                   `json:"fixedDuration,omitempty"`
+	FixedHumanName           *HumanName                  `json:"fixedHumanName,omitempty"`
+	FixedIdentifier          *common.Identifier          `json:"fixedIdentifier,omitempty"`
+	FixedMoney               *common.Money               `json:"fixedMoney,omitempty"`
+	FixedPeriod              *common.Period              `json:"fixedPeriod,omitempty"`
+	FixedQuantity            *common.Quantity            `json:"fixedQuantity,omitempty"`
+	FixedRange               *Range                      `json:"fixedRange,omitempty"`
+	FixedRatio               *Ratio                      `json:"fixedRatio,omitempty"`
+	FixedRatioRange          *RatioRange                 `json:"fixedRatioRange,omitempty"`
+	FixedReference           *common.Reference           `json:"fixedReference,omitempty"`
+	FixedSampledData         *common.SampledData         `json:"fixedSampledData,omitempty"`
+	FixedSignature           *common.Signature           `json:"fixedSignature,omitempty"`
+	FixedTiming              *Timing                     `json:"fixedTiming,omitempty"`
+	FixedContactDetail       *ContactDetail              `json:"fixedContactDetail,omitempty"`
+	FixedContributor         *Contributor                `json:"fixedContributor,omitempty"`
+	FixedDataRequirement     *common.DataRequirement     `json:"fixedDataRequirement,omitempty"`
+	FixedExpression          *common.Expression          `json:"fixedExpression,omitempty"`
+	FixedParameterDefinition *common.ParameterDefinition `json:"fixedParameterDefinition,omitempty"`
+	FixedRelatedArtifact     *common.RelatedArtifact     `json:"fixedRelatedArtifact,omitempty"`
+	FixedTriggerDefinition   *common.TriggerDefinition   `json:"fixedTriggerDefinition,omitempty"`
+	FixedUsageContext        *common.UsageContext        `json:"fixedUsageContext,omitempty"`
+	FixedDosage              *Dosage                     `json:"fixedDosage,omitempty"`
+
+	// If this modifies the meaning of other elements
+	IsModifier        *bool           `json:"isModifier,omitempty"`
+	IsModifierElement *common.Element `json:"_isModifier,omitempty"`
+
+	// Reason that this element is marked as a modifier
+	IsModifierReason        *string         `json:"isModifierReason,omitempty"`
+	IsModifierReasonElement *common.Element `json:"_isModifierReason,omitempty"`
+
+	// Include when _summary = true?
+	IsSummary        *bool           `json:"isSummary,omitempty"`
+	IsSummaryElement *common.Element `json:"_isSummary,omitempty"`
+
+	// Name for element to display with or prompt for element
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Map element to another set of definitions
+	Mapping []ElementDefinitionMapping `json:"mapping,omitempty"`
+
+	// Maximum Cardinality (a number or *)
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Max length for string type data
+	MaxLength        *int            `json:"maxLength,omitempty"`
+	MaxLengthElement *common.Element `json:"_maxLength,omitempty"`
+
+	// Maximum Allowed Value (for some types)
+	MaxValueDate               *common.Date     `json:"maxValueDate,omitempty"`
+	MaxValueDateElement        *common.Element  `json:"_maxValueDate,omitempty"`
+	MaxValueDateTime           *common.DateTime `json:"maxValueDateTime,omitempty"`
+	MaxValueDateTimeElement    *common.Element  `json:"_maxValueDateTime,omitempty"`
+	MaxValueInstant            *common.Instant  `json:"maxValueInstant,omitempty"`
+	MaxValueInstantElement     *common.Element  `json:"_maxValueInstant,omitempty"`
+	MaxValueTime               *common.Time     `json:"maxValueTime,omitempty"`
+	MaxValueTimeElement        *common.Element  `json:"_maxValueTime,omitempty"`
+	MaxValueDecimal            *common.Decimal  `json:"maxValueDecimal,omitempty"`
+	MaxValueDecimalElement     *common.Element  `json:"_maxValueDecimal,omitempty"`
+	MaxValueInteger            *int             `json:"maxValueInteger,omitempty"`
+	MaxValueIntegerElement     *common.Element  `json:"_maxValueInteger,omitempty"`
+	MaxValuePositiveInt        *int             `json:"maxValuePositiveInt,omitempty"`
+	MaxValuePositiveIntElement *common.Element  `json:"_maxValuePositiveInt,omitempty"`
+	MaxValueUnsignedInt        *int             `json:"maxValueUnsignedInt,omitempty"`
+	MaxValueUnsignedIntElement *common.Element  `json:"_maxValueUnsignedInt,omitempty"`
+	MaxValueQuantity           *common.Quantity `json:"maxValueQuantity,omitempty"`
+
+	// Implicit meaning when this element is missing
+	MeaningWhenMissing        *string         `json:"meaningWhenMissing,omitempty"`
+	MeaningWhenMissingElement *common.Element `json:"_meaningWhenMissing,omitempty"`
+
+	// Minimum Cardinality
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Minimum Allowed Value (for some types)
+	MinValueDate               *common.Date     `json:"minValueDate,omitempty"`
+	MinValueDateElement        *common.Element  `json:"_minValueDate,omitempty"`
+	MinValueDateTime           *common.DateTime `json:"minValueDateTime,omitempty"`
+	MinValueDateTimeElement    *common.Element  `json:"_minValueDateTime,omitempty"`
+	MinValueInstant            *common.Instant  `json:"minValueInstant,omitempty"`
+	MinValueInstantElement     *common.Element  `json:"_minValueInstant,omitempty"`
+	MinValueTime               *common.Time     `json:"minValueTime,omitempty"`
+	MinValueTimeElement        *common.Element  `json:"_minValueTime,omitempty"`
+	MinValueDecimal            *common.Decimal  `json:"minValueDecimal,omitempty"`
+	MinValueDecimalElement     *common.Element  `json:"_minValueDecimal,omitempty"`
+	MinValueInteger            *int             `json:"minValueInteger,omitempty"`
+	MinValueIntegerElement     *common.Element  `json:"_minValueInteger,omitempty"`
+	MinValuePositiveInt        *int             `json:"minValuePositiveInt,omitempty"`
+	MinValuePositiveIntElement *common.Element  `json:"_minValuePositiveInt,omitempty"`
+	MinValueUnsignedInt        *int             `json:"minValueUnsignedInt,omitempty"`
+	MinValueUnsignedIntElement *common.Element  `json:"_minValueUnsignedInt,omitempty"`
+	MinValueQuantity           *common.Quantity `json:"minValueQuantity,omitempty"`
+
+	// If the element must be supported (discouraged - see obligations)
+	MustSupport        *bool           `json:"mustSupport,omitempty"`
+	MustSupportElement *common.Element `json:"_mustSupport,omitempty"`
+
+	// What the order of the elements means
+	OrderMeaning        *string         `json:"orderMeaning,omitempty"`
+	OrderMeaningElement *common.Element `json:"_orderMeaning,omitempty"`
+
+	// Path of the element in the hierarchy of elements
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// Value must have at least these property values
+	PatternBase64Binary        *string                     `json:"patternBase64Binary,omitempty"`
+	PatternBase64BinaryElement *common.Element             `json:"_patternBase64Binary,omitempty"`
+	PatternBoolean             *bool                       `json:"patternBoolean,omitempty"`
+	PatternBooleanElement      *common.Element             `json:"_patternBoolean,omitempty"`
+	PatternCanonical           *string                     `json:"patternCanonical,omitempty"`
+	PatternCanonicalElement    *common.Element             `json:"_patternCanonical,omitempty"`
+	PatternCode                *string                     `json:"patternCode,omitempty"`
+	PatternCodeElement         *common.Element             `json:"_patternCode,omitempty"`
+	PatternDate                *common.Date                `json:"patternDate,omitempty"`
+	PatternDateElement         *common.Element             `json:"_patternDate,omitempty"`
+	PatternDateTime            *common.DateTime            `json:"patternDateTime,omitempty"`
+	PatternDateTimeElement     *common.Element             `json:"_patternDateTime,omitempty"`
+	PatternDecimal             *common.Decimal             `json:"patternDecimal,omitempty"`
+	PatternDecimalElement      *common.Element             `json:"_patternDecimal,omitempty"`
+	PatternId                  *string                     `json:"patternId,omitempty"`
+	PatternIdElement           *common.Element             `json:"_patternId,omitempty"`
+	PatternInstant             *common.Instant             `json:"patternInstant,omitempty"`
+	PatternInstantElement      *common.Element             `json:"_patternInstant,omitempty"`
+	PatternInteger             *int                        `json:"patternInteger,omitempty"`
+	PatternIntegerElement      *common.Element             `json:"_patternInteger,omitempty"`
+	PatternMarkdown            *string                     `json:"patternMarkdown,omitempty"`
+	PatternMarkdownElement     *common.Element             `json:"_patternMarkdown,omitempty"`
+	PatternOid                 *string                     `json:"patternOid,omitempty"`
+	PatternOidElement          *common.Element             `json:"_patternOid,omitempty"`
+	PatternPositiveInt         *int                        `json:"patternPositiveInt,omitempty"`
+	PatternPositiveIntElement  *common.Element             `json:"_patternPositiveInt,omitempty"`
+	PatternString              *string                     `json:"patternString,omitempty"`
+	PatternStringElement       *common.Element             `json:"_patternString,omitempty"`
+	PatternTime                *common.Time                `json:"patternTime,omitempty"`
+	PatternTimeElement         *common.Element             `json:"_patternTime,omitempty"`
+	PatternUnsignedInt         *int                        `json:"patternUnsignedInt,omitempty"`
+	PatternUnsignedIntElement  *common.Element             `json:"_patternUnsignedInt,omitempty"`
+	PatternUri                 *string                     `json:"patternUri,omitempty"`
+	PatternUriElement          *common.Element             `json:"_patternUri,omitempty"`
+	PatternUrl                 *string                     `json:"patternUrl,omitempty"`
+	PatternUrlElement          *common.Element             `json:"_patternUrl,omitempty"`
+	PatternUuid                *string                     `json:"patternUuid,omitempty"`
+	PatternUuidElement         *common.Element             `json:"_patternUuid,omitempty"`
+	PatternAddress             *Address                    `json:"patternAddress,omitempty"`
+	PatternAge                 *Age                        `json:"patternAge,omitempty"`
+	PatternAnnotation          *Annotation                 `json:"patternAnnotation,omitempty"`
+	PatternAttachment          *Attachment                 `json:"patternAttachment,omitempty"`
+	PatternCodeableConcept     *common.CodeableConcept     `json:"patternCodeableConcept,omitempty"`
+	PatternCodeableReference   *common.CodeableReference   `json:"patternCodeableReference,omitempty"`
+	PatternCoding              *common.Coding              `json:"patternCoding,omitempty"`
+	PatternContactPoint        *ContactPoint               `json:"patternContactPoint,omitempty"`
+	PatternCount               *Count                      `json:"patternCount,omitempty"`
+	PatternDistance            *Distance                   `json:"patternDistance,omitempty"`
+	PatternDuration            *Duration                   `json:"patternDuration,omitempty"`
+	PatternHumanName           *HumanName                  `json:"patternHumanName,omitempty"`
+	PatternIdentifier          *common.Identifier          `json:"patternIdentifier,omitempty"`
+	PatternMoney               *common.Money               `json:"patternMoney,omitempty"`
+	PatternPeriod              *common.Period              `json:"patternPeriod,omitempty"`
+	PatternQuantity            *common.Quantity            `json:"patternQuantity,omitempty"`
+	PatternRange               *Range                      `json:"patternRange,omitempty"`
+	PatternRatio               *Ratio                      `json:"patternRatio,omitempty"`
+	PatternRatioRange          *RatioRange                 `json:"patternRatioRange,omitempty"`
+	PatternReference           *common.Reference           `json:"patternReference,omitempty"`
+	PatternSampledData         *common.SampledData         `json:"patternSampledData,omitempty"`
+	PatternSignature           *common.Signature           `json:"patternSignature,omitempty"`
+	PatternTiming              *Timing                     `json:"patternTiming,omitempty"`
+	PatternContactDetail       *ContactDetail              `json:"patternContactDetail,omitempty"`
+	PatternContributor         *Contributor                `json:"patternContributor,omitempty"`
+	PatternDataRequirement     *common.DataRequirement     `json:"patternDataRequirement,omitempty"`
+	PatternExpression          *common.Expression          `json:"patternExpression,omitempty"`
+	PatternParameterDefinition *common.ParameterDefinition `json:"patternParameterDefinition,omitempty"`
+	PatternRelatedArtifact     *common.RelatedArtifact     `json:"patternRelatedArtifact,omitempty"`
+	PatternTriggerDefinition   *common.TriggerDefinition   `json:"patternTriggerDefinition,omitempty"`
+	PatternUsageContext        *common.UsageContext        `json:"patternUsageContext,omitempty"`
+	PatternDosage              *Dosage                     `json:"patternDosage,omitempty"`
+
+	// xmlAttr | xmlText | typeAttr | cdaText | xhtml
+	Representation        []ElementDefinitionRepresentation `json:"representation,omitempty"`
+	RepresentationElement []*common.Element                 `json:"_representation,omitempty"`
+
+	// Why this resource has been created
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// Concise definition for space-constrained presentation
+	Short        *string         `json:"short,omitempty"`
+	ShortElement *common.Element `json:"_short,omitempty"`
+
+	// If this slice definition constrains an inherited slice definition (or not)
+	SliceIsConstraining        *bool           `json:"sliceIsConstraining,omitempty"`
+	SliceIsConstrainingElement *common.Element `json:"_sliceIsConstraining,omitempty"`
+
+	// Name for this particular element (in a set of slices)
+	SliceName        *string         `json:"sliceName,omitempty"`
+	SliceNameElement *common.Element `json:"_sliceName,omitempty"`
+
+	// This element is sliced - slices follow
+	Slicing *ElementDefinitionSlicing `json:"slicing,omitempty"`
+
+	// Data type and Profile for this element
+	Type []ElementDefinitionType `json:"type,omitempty"`
+}
+
+// ElementDefinitionRepresentation represents the representation of an element definition
+type ElementDefinitionRepresentation string
+
+const (
+	ElementDefinitionRepresentationXmlAttr  ElementDefinitionRepresentation = "xmlAttr"
+	ElementDefinitionRepresentationXmlText  ElementDefinitionRepresentation = "xmlText"
+	ElementDefinitionRepresentationTypeAttr ElementDefinitionRepresentation = "typeAttr"
+	ElementDefinitionRepresentationCdaText  ElementDefinitionRepresentation = "cdaText"
+	ElementDefinitionRepresentationXhtml    ElementDefinitionRepresentation = "xhtml"
+)
+
+// ElementDefinitionBase represents base definition information for tools
+type ElementDefinitionBase struct {
+	common.Element
+
+	// Max cardinality of the base element
+	Max        string          `json:"max"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Min cardinality of the base element
+	Min        int             `json:"min"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Path that identifies the base element
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+}
+
+// ElementDefinitionBinding represents valueSet details if this is coded
+type ElementDefinitionBinding struct {
+	common.Element
+
+	// Intended use of codes in the bound value set
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// required | extensible | preferred | example
+	Strength        ElementDefinitionBindingStrength `json:"strength"`
+	StrengthElement *common.Element                  `json:"_strength,omitempty"`
+
+	// Source of value set
+	ValueSet        *string         `json:"valueSet,omitempty"`
+	ValueSetElement *common.Element `json:"_valueSet,omitempty"`
+}
+
+// ElementDefinitionBindingStrength represents the strength of an element definition binding
+type ElementDefinitionBindingStrength string
+
+const (
+	ElementDefinitionBindingStrengthRequired   ElementDefinitionBindingStrength = "required"
+	ElementDefinitionBindingStrengthExtensible ElementDefinitionBindingStrength = "extensible"
+	ElementDefinitionBindingStrengthPreferred  ElementDefinitionBindingStrength = "preferred"
+	ElementDefinitionBindingStrengthExample    ElementDefinitionBindingStrength = "example"
+)
+
+// ElementDefinitionConstraint represents condition that must evaluate to true
+type ElementDefinitionConstraint struct {
+	common.Element
+
+	// FHIRPath expression of constraint
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
+
+	// Human description of constraint
+	Human        string          `json:"human"`
+	HumanElement *common.Element `json:"_human,omitempty"`
+
+	// Target of 'condition' reference above
+	Key        string          `json:"key"`
+	KeyElement *common.Element `json:"_key,omitempty"`
+
+	// Why this constraint is necessary or appropriate
+	Requirements        *string         `json:"requirements,omitempty"`
+	RequirementsElement *common.Element `json:"_requirements,omitempty"`
+
+	// error | warning
+	Severity        ElementDefinitionConstraintSeverity `json:"severity"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
+
+	// Reference to original source of constraint
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// Elements SHALL use "f" as the namespace prefix for the FHIR namespace, and "x" for the xhtml namespace
+	Xpath        *string         `json:"xpath,omitempty"`
+	XpathElement *common.Element `json:"_xpath,omitempty"`
+}
+
+// ElementDefinitionConstraintSeverity represents the severity of an element definition constraint
+type ElementDefinitionConstraintSeverity string
+
+const (
+	ElementDefinitionConstraintSeverityError   ElementDefinitionConstraintSeverity = "error"
+	ElementDefinitionConstraintSeverityWarning ElementDefinitionConstraintSeverity = "warning"
+)
+
+// ElementDefinitionExample represents example value (as defined for type)
+type ElementDefinitionExample struct {
+	common.Element
+
+	// Describes the purpose of this example
+	Label        string          `json:"label"`
+	LabelElement *common.Element `json:"_label,omitempty"`
+
+	// Value of Example (one of allowed types)
+	ValueBase64Binary        *string                     `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element             `json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                       `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element             `json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string                     `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *common.Element             `json:"_valueCanonical,omitempty"`
+	ValueCode                *string                     `json:"valueCode,omitempty"`
+	ValueCodeElement         *common.Element             `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date                `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element             `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime            `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element             `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal             `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element             `json:"_valueDecimal,omitempty"`
+	ValueId                  *string                     `json:"valueId,omitempty"`
+	ValueIdElement           *common.Element             `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant             `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element             `json:"_valueInstant,omitempty"`
+	ValueInteger             *int                        `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element             `json:"_valueInteger,omitempty"`
+	ValueMarkdown            *string                     `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element             `json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                     `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element             `json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                        `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element             `json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                     `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element             `json:"_valueString,omitempty"`
+	ValueTime                *common.Time                `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element             `json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                        `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element             `json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                     `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element             `json:"_valueUri,omitempty"`
+	ValueUrl                 *string                     `json:"valueUrl,omitempty"`
+	ValueUrlElement          *common.Element             `json:"_valueUrl,omitempty"`
+	ValueUuid                *string                     `json:"valueUuid,omitempty"`
+	ValueUuidElement         *common.Element             `json:"_valueUuid,omitempty"`
+	ValueAddress             *Address                    `json:"valueAddress,omitempty"`
+	ValueAge                 *Age                        `json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation                 `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment                 `json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept     `json:"valueCodeableConcept,omitempty"`
+	ValueCodeableReference   *common.CodeableReference   `json:"valueCodeableReference,omitempty"`
+	ValueCoding              *common.Coding              `json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint               `json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                      `json:"valueCount,omitempty"`
+	ValueDistance            *Distance                   `json:"valueDistance,omitempty"`
+	ValueDuration            *Duration                   `json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName                  `json:"valueHumanName,omitempty"`
+	ValueIdentifier          *common.Identifier          `json:"valueIdentifier,omitempty"`
+	ValueMoney               *common.Money               `json:"valueMoney,omitempty"`
+	ValuePeriod              *common.Period              `json:"valuePeriod,omitempty"`
+	ValueQuantity            *common.Quantity            `json:"valueQuantity,omitempty"`
+	ValueRange               *Range                      `json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                      `json:"valueRatio,omitempty"`
+	ValueRatioRange          *RatioRange                 `json:"valueRatioRange,omitempty"`
+	ValueReference           *common.Reference           `json:"valueReference,omitempty"`
+	ValueSampledData         *common.SampledData         `json:"valueSampledData,omitempty"`
+	ValueSignature           *common.Signature           `json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                     `json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail              `json:"valueContactDetail,omitempty"`
+	ValueContributor         *Contributor                `json:"valueContributor,omitempty"`
+	ValueDataRequirement     *common.DataRequirement     `json:"valueDataRequirement,omitempty"`
+	ValueExpression          *common.Expression          `json:"valueExpression,omitempty"`
+	ValueParameterDefinition *common.ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *common.RelatedArtifact     `json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *common.TriggerDefinition   `json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *common.UsageContext        `json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage                     `json:"valueDosage,omitempty"`
+}
+
+// ElementDefinitionMapping represents map element to another set of definitions
+type ElementDefinitionMapping struct {
+	common.Element
+
+	// Comments about the mapping or its use
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Reference to mapping declaration
+	Identity        string          `json:"identity"`
+	IdentityElement *common.Element `json:"_identity,omitempty"`
+
+	// Computable language of mapping
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	// Details of the mapping
+	Map        string          `json:"map"`
+	MapElement *common.Element `json:"_map,omitempty"`
+}
+
+// ElementDefinitionSlicing represents the slicing of an element definition
+type ElementDefinitionSlicing struct {
+	common.Element
+
+	// Text description of how slicing works (or not)
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Element values that are used to distinguish the slices
+	Discriminator []ElementDefinitionSlicingDiscriminator `json:"discriminator,omitempty"`
+
+	// If elements must be in same order as slices
+	Ordered        *bool           `json:"ordered,omitempty"`
+	OrderedElement *common.Element `json:"_ordered,omitempty"`
+
+	// closed | open | openAtEnd
+	Rules        ElementDefinitionSlicingRules `json:"rules"`
+	RulesElement *common.Element               `json:"_rules,omitempty"`
+}
+
+// ElementDefinitionSlicingRules represents the rules of an element definition slicing
+type ElementDefinitionSlicingRules string
+
+const (
+	ElementDefinitionSlicingRulesClosed    ElementDefinitionSlicingRules = "closed"
+	ElementDefinitionSlicingRulesOpen      ElementDefinitionSlicingRules = "open"
+	ElementDefinitionSlicingRulesOpenAtEnd ElementDefinitionSlicingRules = "openAtEnd"
+)
+
+// ElementDefinitionType represents data type and Profile for this element
+type ElementDefinitionType struct {
+	common.Element
+
+	// contained | referenced | bundled - how aggregated
+	Aggregation        []ElementDefinitionTypeAggregation `json:"aggregation,omitempty"`
+	AggregationElement []*common.Element                  `json:"_aggregation,omitempty"`
+
+	// Data type or Resource (reference to definition)
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// Profiles (StructureDefinition or IG) - one must apply
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
+
+	// Profile (StructureDefinition or IG) on the Reference/canonical target - one must apply
+	TargetProfile        []string          `json:"targetProfile,omitempty"`
+	TargetProfileElement []*common.Element `json:"_targetProfile,omitempty"`
+
+	// either | independent | specific
+	Versioning        *ElementDefinitionTypeVersioning `json:"versioning,omitempty"`
+	VersioningElement *common.Element                  `json:"_versioning,omitempty"`
+}
+
+// ElementDefinitionTypeAggregation represents the aggregation of an element definition type
+type ElementDefinitionTypeAggregation string
+
+const (
+	ElementDefinitionTypeAggregationContained  ElementDefinitionTypeAggregation = "contained"
+	ElementDefinitionTypeAggregationReferenced ElementDefinitionTypeAggregation = "referenced"
+	ElementDefinitionTypeAggregationBundled    ElementDefinitionTypeAggregation = "bundled"
+)
+
+// ElementDefinitionTypeVersioning represents the versioning of an element definition type
+type ElementDefinitionTypeVersioning string
+
+const (
+	ElementDefinitionTypeVersioningEither      ElementDefinitionTypeVersioning = "either"
+	ElementDefinitionTypeVersioningIndependent ElementDefinitionTypeVersioning = "independent"
+	ElementDefinitionTypeVersioningSpecific    ElementDefinitionTypeVersioning = "specific"
+)
+
+// ElementDefinitionSlicingDiscriminator represents element values that are used to distinguish the slices
+type ElementDefinitionSlicingDiscriminator struct {
+	common.Element
+
+	// Path to element value
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+
+	// value | exists | type | profile | position
+	Type        ElementDefinitionSlicingDiscriminatorType `json:"type"`
+	TypeElement *common.Element                           `json:"_type,omitempty"`
+}
+
+// ElementDefinitionSlicingDiscriminatorType represents the type of an element definition slicing discriminator
+type ElementDefinitionSlicingDiscriminatorType string
+
+const (
+	ElementDefinitionSlicingDiscriminatorTypeValue   ElementDefinitionSlicingDiscriminatorType = "value"
+	ElementDefinitionSlicingDiscriminatorTypeExists  ElementDefinitionSlicingDiscriminatorType = "exists"
+	ElementDefinitionSlicingDiscriminatorTypePattern ElementDefinitionSlicingDiscriminatorType = "pattern"
+	ElementDefinitionSlicingDiscriminatorTypeType    ElementDefinitionSlicingDiscriminatorType = "type"
+	ElementDefinitionSlicingDiscriminatorTypeProfile ElementDefinitionSlicingDiscriminatorType = "profile"
+)
@@ -0,0 +1,46 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EnrollmentRequest represents the insurance enrollment details to the insurer
+type EnrollmentRequest struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "EnrollmentRequest"
+
+	// Patient Resource
+	Candidate *common.Reference `json:"candidate,omitempty"`
+
+	// Reference to the program or plan identification, underwriter or payor
+	Coverage *common.Reference `json:"coverage,omitempty"`
+
+	// The date when this resource was created
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
+
+	// The Response business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The Insurer who is target of the request
+	Insurer *common.Reference `json:"insurer,omitempty"`
+
+	// The practitioner who is responsible for the services rendered to the patient
+	Provider *common.Reference `json:"provider,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the request as not currently valid
+	Status        *EnrollmentRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+}
+
+// EnrollmentRequestStatus represents the status of the enrollment request
+type EnrollmentRequestStatus string
+
+const (
+	EnrollmentRequestStatusActive         EnrollmentRequestStatus = "active"
+	EnrollmentRequestStatusCancelled      EnrollmentRequestStatus = "cancelled"
+	EnrollmentRequestStatusDraft          EnrollmentRequestStatus = "draft"
+	EnrollmentRequestStatusEnteredInError EnrollmentRequestStatus = "entered-in-error"
+)
@@ -0,0 +1,275 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Evidence represents the Evidence resource
+type Evidence struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Evidence"
+
+	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// Declarative description of the Evidence
+	Assertion        *string         `json:"assertion,omitempty"`
+	AssertionElement *common.Element `json:"_assertion,omitempty"`
+
+	// An individual or organization primarily involved in the creation and maintenance of the content
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// Assessment of certainty, confidence in the estimates, or quality of the evidence
+	Certainty []EvidenceCertainty `json:"certainty,omitempty"`
+
+	// Citation Resource or display of suggested citation for this evidence
+	CiteAsReference *common.Reference `json:"citeAsReference,omitempty"`
+
+	// Citation Resource or display of suggested citation for this evidence
+	CiteAsMarkdown        *string         `json:"citeAsMarkdown,omitempty"`
+	CiteAsMarkdownElement *common.Element `json:"_citeAsMarkdown,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the evidence was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An individual or organization primarily responsible for internal coherence of the content
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// An individual or organization responsible for officially endorsing the content for use in some setting
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// If specified, this date follows the original approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// Footnotes and/or explanatory notes
+	Note []Annotation `json:"note,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Each related resource is either an attachment, or a reference to another resource, but not both
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// An individual or organization primarily responsible for review of some aspect of the content
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// Values and parameters for a single statistic
+	Statistic []EvidenceStatistic `json:"statistic,omitempty"`
+
+	// Allows filtering of evidence that are appropriate for use versus not
+	Status        EvidenceStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// The type of study that produced this evidence
+	StudyType *common.CodeableConcept `json:"studyType,omitempty"`
+
+	// The method to combine studies
+	SynthesisType *common.CodeableConcept `json:"synthesisType,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// Evidence variable such as population, exposure, or outcome
+	VariableDefinition []EvidenceVariableDefinition `json:"variableDefinition"`
+
+	// There may be different evidence instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// EvidenceStatus represents the status of the evidence
+type EvidenceStatus string
+
+const (
+	EvidenceStatusDraft   EvidenceStatus = "draft"
+	EvidenceStatusActive  EvidenceStatus = "active"
+	EvidenceStatusRetired EvidenceStatus = "retired"
+	EvidenceStatusUnknown EvidenceStatus = "unknown"
+)
+
+// EvidenceStatisticSampleSize represents number of samples in the statistic
+type EvidenceStatisticSampleSize struct {
+	common.BackboneElement
+
+	// Human-readable summary of population sample size
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Number of participants with known results for measured variables
+	KnownDataCount        *int            `json:"knownDataCount,omitempty"`
+	KnownDataCountElement *common.Element `json:"_knownDataCount,omitempty"`
+
+	// Footnote or explanatory note about the sample size
+	Note []Annotation `json:"note,omitempty"`
+
+	// A human-readable string to clarify or explain concepts about the sample size
+	NumberOfParticipants        *int            `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *common.Element `json:"_numberOfParticipants,omitempty"`
+
+	// Number of participants in the population
+	NumberOfStudies        *int            `json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement *common.Element `json:"_numberOfStudies,omitempty"`
+}
+
+// EvidenceStatisticAttributeEstimate represents an attribute of the Statistic
+type EvidenceStatisticAttributeEstimate struct {
+	common.BackboneElement
+
+	// A nested attribute estimate; which is the attribute estimate of an attribute estimate
+	AttributeEstimate []EvidenceStatisticAttributeEstimate `json:"attributeEstimate,omitempty"`
+
+	// Human-readable summary of the estimate
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Use 95 for a 95% confidence interval
+	Level        *common.Decimal `json:"level,omitempty"`
+	LevelElement *common.Element `json:"_level,omitempty"`
+
+	// Footnote or explanatory note about the estimate
+	Note []Annotation `json:"note,omitempty"`
+
+	// Often the p value
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Lower bound of confidence interval
+	Range *Range `json:"range,omitempty"`
+
+	// The type of attribute estimate, eg confidence interval or p value
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// EvidenceStatisticModelCharacteristicVariable represents a variable adjusted for in the adjusted analysis
+type EvidenceStatisticModelCharacteristicVariable struct {
+	common.BackboneElement
+
+	// How the variable is classified for use in adjusted analysis
+	Handling        *EvidenceStatisticModelCharacteristicVariableHandling `json:"handling,omitempty"`
+	HandlingElement *common.Element                                       `json:"_handling,omitempty"`
+
+	// Description for grouping of ordinal or polychotomous variables
+	ValueCategory []common.CodeableConcept `json:"valueCategory,omitempty"`
+
+	// Discrete value for grouping of ordinal or polychotomous variables
+	ValueQuantity []common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Range of values for grouping of ordinal or polychotomous variables
+	ValueRange []Range `json:"valueRange,omitempty"`
+
+	// Description of the variable
+	VariableDefinition common.Reference `json:"variableDefinition"`
+}
+
+// EvidenceStatisticModelCharacteristicVariableHandling represents the handling of an evidence statistic model characteristic variable
+type EvidenceStatisticModelCharacteristicVariableHandling string
+
+const (
+	EvidenceStatisticModelCharacteristicVariableHandlingContinuous    EvidenceStatisticModelCharacteristicVariableHandling = "continuous"
+	EvidenceStatisticModelCharacteristicVariableHandlingDichotomous   EvidenceStatisticModelCharacteristicVariableHandling = "dichotomous"
+	EvidenceStatisticModelCharacteristicVariableHandlingOrdinal       EvidenceStatisticModelCharacteristicVariableHandling = "ordinal"
+	EvidenceStatisticModelCharacteristicVariableHandlingPolychotomous EvidenceStatisticModelCharacteristicVariableHandling = "polychotomous"
+)
+
+// EvidenceStatisticModelCharacteristic represents an aspect of the statistical model
+type EvidenceStatisticModelCharacteristic struct {
+	common.BackboneElement
+
+	// An attribute of the statistic used as a model characteristic
+	AttributeEstimate []EvidenceStatisticAttributeEstimate `json:"attributeEstimate,omitempty"`
+
+	// Description of a component of the method to generate the statistic
+	Code common.CodeableConcept `json:"code"`
+
+	// Further specification of the quantified value of the component of the method to generate the statistic
+	Value *common.Quantity `json:"value,omitempty"`
+
+	// A variable adjusted for in the adjusted analysis
+	Variable []EvidenceStatisticModelCharacteristicVariable `json:"variable,omitempty"`
+}
+
+// EvidenceStatistic represents values and parameters for a single statistic
+type EvidenceStatistic struct {
+	common.BackboneElement
+
+	// A statistical attribute of the statistic such as a measure of heterogeneity
+	AttributeEstimate []EvidenceStatisticAttributeEstimate `json:"attributeEstimate,omitempty"`
+
+	// Simple strings can be used for descriptive purposes
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// A description of the content value of the statistic
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A component of the method to generate the statistic
+	ModelCharacteristic []EvidenceStatisticModelCharacteristic `json:"modelCharacteristic,omitempty"`
+
+	// Footnotes and/or explanatory notes
+	Note []Annotation `json:"note,omitempty"`
+
+	// When the number affected is the statistic
+	NumberAffected        *int            `json:"numberAffected,omitempty"`
+	NumberAffectedElement *common.Element `json:"_numberAffected,omitempty"`
+
+	// When the number of events is the statistic
+	NumberOfEvents        *int            `json:"numberOfEvents,omitempty"`
+	NumberOfEventsElement *common.Element `json:"_numberOfEvents,omitempty"`
+
+	// Statistic value
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Number of samples in the statistic
+	SampleSize *EvidenceStatisticSampleSize `json:"sampleSize,omitempty"`
+
+	// Type of statistic, eg relative risk
+	StatisticType *common.CodeableConcept `json:"statisticType,omitempty"`
+}
+
+// EvidenceCertainty represents certainty or quality of the evidence
+type EvidenceCertainty struct {
+	common.BackboneElement
+
+	// Textual description of certainty
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Footnotes and/or explanatory notes
+	Note []Annotation `json:"note,omitempty"`
+
+	// Individual or group who did the rating
+	Rater        *string         `json:"rater,omitempty"`
+	RaterElement *common.Element `json:"_rater,omitempty"`
+
+	// Assessment or judgement of the aspect
+	Rating *common.CodeableConcept `json:"rating,omitempty"`
+
+	// A domain or subdomain of certainty
+	Subcomponent []EvidenceCertainty `json:"subcomponent,omitempty"`
+
+	// Aspect of certainty being rated
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
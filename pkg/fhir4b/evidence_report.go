@@ -0,0 +1,207 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EvidenceReport represents a report of evidence, a document with sections for the parts of the evidence
+type EvidenceReport struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "EvidenceReport"
+
+	// Extensions to ContactDetail include: contactReference, contactAddress, and contributionTime
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// used for reports for which external citation is expected, such as use in support of scholarly publications
+	CiteAsReference *common.Reference `json:"citeAsReference,omitempty"`
+
+	// used for reports for which external citation is expected, such as use in support of scholarly publications
+	CiteAsMarkdown        *string         `json:"citeAsMarkdown,omitempty"`
+	CiteAsMarkdownElement *common.Element `json:"_citeAsMarkdown,omitempty"`
+
+	// Extensions to ContactDetail include: contactReference, contactAddress, and contributionTime
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Extensions to ContactDetail include: contactReference, contactAddress, and contributionTime
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// Extensions to ContactDetail include: contactReference, contactAddress, and contributionTime
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// This element will contain unique identifiers that support de-duplication of EvidenceReports
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Used for footnotes and annotations
+	Note []Annotation `json:"note,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Link, description or reference to artifact associated with the report
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// May include trial registry identifiers, e.g. NCT04372602 from clinicaltrials.gov
+	RelatedIdentifier []common.Identifier `json:"relatedIdentifier,omitempty"`
+
+	// A document is a version specific composition
+	RelatesTo []EvidenceReportRelatesTo `json:"relatesTo,omitempty"`
+
+	// Extensions to ContactDetail include: contactReference, contactAddress, and contributionTime
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// The root of the sections that make up the composition
+	Section []EvidenceReportSection `json:"section,omitempty"`
+
+	// Allows filtering of summaries that are appropriate for use versus not
+	Status        EvidenceReportStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// May be used as an expression for search queries and search results
+	Subject EvidenceReportSubject `json:"subject"`
+
+	// Specifies the kind of report, such as grouping of classifiers, search results, or human-compiled expression
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+}
+
+// EvidenceReportStatus represents the status of an evidence report
+type EvidenceReportStatus string
+
+const (
+	EvidenceReportStatusDraft   EvidenceReportStatus = "draft"
+	EvidenceReportStatusActive  EvidenceReportStatus = "active"
+	EvidenceReportStatusRetired EvidenceReportStatus = "retired"
+	EvidenceReportStatusUnknown EvidenceReportStatus = "unknown"
+)
+
+// EvidenceReportSubjectCharacteristic represents a characteristic that defines the focus of the report
+type EvidenceReportSubjectCharacteristic struct {
+	common.BackboneElement
+
+	// Example 1 is a Citation
+	Code common.CodeableConcept `json:"code"`
+
+	// Is used to express not the characteristic
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
+
+	// Timeframe for the characteristic
+	Period *common.Period `json:"period,omitempty"`
+
+	// Example 1 is Citation #37
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+
+	// Example 1 is Citation #37
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// Example 1 is Citation #37
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Example 1 is Citation #37
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Example 1 is Citation #37
+	ValueRange *Range `json:"valueRange,omitempty"`
+}
+
+// EvidenceReportSubject represents focus of the report
+type EvidenceReportSubject struct {
+	common.BackboneElement
+
+	// Characteristic
+	Characteristic []EvidenceReportSubjectCharacteristic `json:"characteristic,omitempty"`
+
+	// Used for general notes and annotations not coded elsewhere
+	Note []Annotation `json:"note,omitempty"`
+}
+
+// EvidenceReportRelatesTo represents relationships to other compositions/documents
+type EvidenceReportRelatesTo struct {
+	common.BackboneElement
+
+	// If this document appends another document
+	Code        EvidenceReportRelatesToCode `json:"code"`
+	CodeElement *common.Element             `json:"_code,omitempty"`
+
+	// The target composition/document of this relationship
+	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
+
+	// The target composition/document of this relationship
+	TargetReference *common.Reference `json:"targetReference,omitempty"`
+}
+
+// EvidenceReportRelatesToCode represents the code of an evidence report relates to
+type EvidenceReportRelatesToCode string
+
+const (
+	EvidenceReportRelatesToCodeReplaces        EvidenceReportRelatesToCode = "replaces"
+	EvidenceReportRelatesToCodeAmends          EvidenceReportRelatesToCode = "amends"
+	EvidenceReportRelatesToCodeAppends         EvidenceReportRelatesToCode = "appends"
+	EvidenceReportRelatesToCodeTransforms      EvidenceReportRelatesToCode = "transforms"
+	EvidenceReportRelatesToCodeReplacedWith    EvidenceReportRelatesToCode = "replacedWith"
+	EvidenceReportRelatesToCodeAmendedWith     EvidenceReportRelatesToCode = "amendedWith"
+	EvidenceReportRelatesToCodeAppendedWith    EvidenceReportRelatesToCode = "appendedWith"
+	EvidenceReportRelatesToCodeTransformedWith EvidenceReportRelatesToCode = "transformedWith"
+)
+
+// EvidenceReportSection represents a section of the report
+type EvidenceReportSection struct {
+	common.BackboneElement
+
+	// Identifies who is responsible for the information in this section, not necessarily who typed it in
+	Author []common.Reference `json:"author,omitempty"`
+
+	// The various reasons for an empty section make a significant interpretation to its interpretation
+	EmptyReason *common.CodeableConcept `json:"emptyReason,omitempty"`
+
+	// Specifies any type of classification of the evidence report
+	EntryClassifier []common.CodeableConcept `json:"entryClassifier,omitempty"`
+
+	// Quantity as content
+	EntryQuantity []common.Quantity `json:"entryQuantity,omitempty"`
+
+	// If there are no entries in the list, an emptyReason SHOULD be provided
+	EntryReference []common.Reference `json:"entryReference,omitempty"`
+
+	// The code identifies the section for an automated processor of the document
+	Focus *common.CodeableConcept `json:"focus,omitempty"`
+
+	// A definitional Resource identifying the kind of content contained within the section
+	FocusReference *common.Reference `json:"focusReference,omitempty"`
+
+	// This element is labeled as a modifier because a change list must not be misunderstood as a complete list
+	Mode        *EvidenceReportSectionMode `json:"mode,omitempty"`
+	ModeElement *common.Element            `json:"_mode,omitempty"`
+
+	// Applications SHOULD render ordered lists in the order provided
+	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
+
+	// Nested sections are primarily used to help human readers navigate to particular portions of the document
+	Section []EvidenceReportSection `json:"section,omitempty"`
+
+	// Document profiles may define what content should be represented in the narrative
+	Text *Narrative `json:"text,omitempty"`
+
+	// The title identifies the section for a human reader
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// EvidenceReportSectionMode represents the mode of an evidence report section
+type EvidenceReportSectionMode string
+
+const (
+	EvidenceReportSectionModeWorking  EvidenceReportSectionMode = "working"
+	EvidenceReportSectionModeSnapshot EvidenceReportSectionMode = "snapshot"
+	EvidenceReportSectionModeChanges  EvidenceReportSectionMode = "changes"
+)
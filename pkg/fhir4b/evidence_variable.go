@@ -0,0 +1,237 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// EvidenceVariable represents the EvidenceVariable resource
+type EvidenceVariable struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "EvidenceVariable"
+
+	// True if the actual variable measured, false if a conceptual representation of the intended variable
+	Actual        *bool           `json:"actual,omitempty"`
+	ActualElement *common.Element `json:"_actual,omitempty"`
+
+	// An individual or organization primarily involved in the creation and maintenance of the content
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// A grouping (or set of values) described along with other groupings to specify the set of groupings allowed for the variable
+	Category []EvidenceVariableCategory `json:"category,omitempty"`
+
+	// Characteristics can be defined flexibly to accommodate different use cases for membership criteria
+	Characteristic []EvidenceVariableCharacteristic `json:"characteristic,omitempty"`
+
+	// Used to specify if two or more characteristics are combined with OR or AND
+	CharacteristicCombination        *EvidenceVariableCharacteristicCombination `json:"characteristicCombination,omitempty"`
+	CharacteristicCombinationElement *common.Element                            `json:"_characteristicCombination,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the evidence variable was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An individual or organization primarily responsible for internal coherence of the content
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// An individual or organization responsible for officially endorsing the content for use in some setting
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// Used for an outcome to classify
+	Handling        *EvidenceVariableHandling `json:"handling,omitempty"`
+	HandlingElement *common.Element           `json:"_handling,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A human-readable string to clarify or explain concepts about the resource
+	Note []Annotation `json:"note,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Each related artifact is either an attachment, or a reference to another resource, but not both
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// An individual or organization primarily responsible for review of some aspect of the content
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// The short title provides an alternate title for use in informal descriptive contexts
+	ShortTitle        *string         `json:"shortTitle,omitempty"`
+	ShortTitleElement *common.Element `json:"_shortTitle,omitempty"`
+
+	// Allows filtering of evidence variables that are appropriate for use versus not
+	Status        EvidenceVariableStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// An explanatory or alternate title for the EvidenceVariable giving additional information about its content
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different evidence variable instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// EvidenceVariableCharacteristicCombination represents the characteristic combination of an evidence variable
+type EvidenceVariableCharacteristicCombination string
+
+const (
+	EvidenceVariableCharacteristicCombinationIntersection EvidenceVariableCharacteristicCombination = "intersection"
+	EvidenceVariableCharacteristicCombinationUnion        EvidenceVariableCharacteristicCombination = "union"
+)
+
+// EvidenceVariableHandling represents the handling of an evidence variable
+type EvidenceVariableHandling string
+
+const (
+	EvidenceVariableHandlingContinuous    EvidenceVariableHandling = "continuous"
+	EvidenceVariableHandlingDichotomous   EvidenceVariableHandling = "dichotomous"
+	EvidenceVariableHandlingOrdinal       EvidenceVariableHandling = "ordinal"
+	EvidenceVariableHandlingPolychotomous EvidenceVariableHandling = "polychotomous"
+)
+
+// EvidenceVariableStatus represents the status of the evidence variable
+type EvidenceVariableStatus string
+
+const (
+	EvidenceVariableStatusDraft   EvidenceVariableStatus = "draft"
+	EvidenceVariableStatusActive  EvidenceVariableStatus = "active"
+	EvidenceVariableStatusRetired EvidenceVariableStatus = "retired"
+	EvidenceVariableStatusUnknown EvidenceVariableStatus = "unknown"
+)
+
+// EvidenceVariableDefinition represents evidence variable such as population, exposure, or outcome
+type EvidenceVariableDefinition struct {
+	common.BackboneElement
+
+	// A text description or summary of the variable
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Indication of quality of match between intended variable to actual variable
+	DirectnessMatch *common.CodeableConcept `json:"directnessMatch,omitempty"`
+
+	// Definition of the intended variable related to the Evidence
+	Intended *common.Reference `json:"intended,omitempty"`
+
+	// Footnotes and/or explanatory notes
+	Note []Annotation `json:"note,omitempty"`
+
+	// Definition of the actual variable related to the statistic(s)
+	Observed *common.Reference `json:"observed,omitempty"`
+
+	// population | subpopulation | exposure | referenceExposure | measuredVariable | confounder
+	VariableRole common.CodeableConcept `json:"variableRole"`
+}
+
+// EvidenceVariableCharacteristicTimeFromStart represents indicates duration, period, or point of observation from the participant's study entry
+type EvidenceVariableCharacteristicTimeFromStart struct {
+	common.BackboneElement
+
+	// A short, natural language description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A human-readable string to clarify or explain concepts about the resource
+	Note []Annotation `json:"note,omitempty"`
+
+	// Used to express the observation at a defined amount of time after the study start
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Used to express the observation within a period after the study start
+	Range *Range `json:"range,omitempty"`
+}
+
+// EvidenceVariableCharacteristic represents characteristics that can be defined flexibly
+type EvidenceVariableCharacteristic struct {
+	common.BackboneElement
+
+	// Define members of the evidence element using Codes
+	DefinitionReference *common.Reference `json:"definitionReference,omitempty"`
+
+	// Define members of the evidence element using Codes
+	DefinitionCanonical        *string         `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element `json:"_definitionCanonical,omitempty"`
+
+	// Define members of the evidence element using Codes
+	DefinitionCodeableConcept *common.CodeableConcept `json:"definitionCodeableConcept,omitempty"`
+
+	// Define members of the evidence element using Expressions
+	DefinitionExpression *common.Expression `json:"definitionExpression,omitempty"`
+
+	// A short, natural language description of the characteristic
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Device used for determining characteristic
+	Device *common.Reference `json:"device,omitempty"`
+
+	// When true, members with this characteristic are excluded from the element
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
+
+	// Indicates how elements are aggregated within the study effective period
+	GroupMeasure        *EvidenceVariableCharacteristicGroupMeasure `json:"groupMeasure,omitempty"`
+	GroupMeasureElement *common.Element                             `json:"_groupMeasure,omitempty"`
+
+	// Method used for describing characteristic
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// Indicates duration from the participant's study entry
+	TimeFromStart *EvidenceVariableCharacteristicTimeFromStart `json:"timeFromStart,omitempty"`
+}
+
+// EvidenceVariableCharacteristicGroupMeasure represents how elements are aggregated
+type EvidenceVariableCharacteristicGroupMeasure string
+
+const (
+	EvidenceVariableCharacteristicGroupMeasureMean           EvidenceVariableCharacteristicGroupMeasure = "mean"
+	EvidenceVariableCharacteristicGroupMeasureMedian         EvidenceVariableCharacteristicGroupMeasure = "median"
+	EvidenceVariableCharacteristicGroupMeasureMeanOfMean     EvidenceVariableCharacteristicGroupMeasure = "mean-of-mean"
+	EvidenceVariableCharacteristicGroupMeasureMeanOfMedian   EvidenceVariableCharacteristicGroupMeasure = "mean-of-median"
+	EvidenceVariableCharacteristicGroupMeasureMedianOfMean   EvidenceVariableCharacteristicGroupMeasure = "median-of-mean"
+	EvidenceVariableCharacteristicGroupMeasureMedianOfMedian EvidenceVariableCharacteristicGroupMeasure = "median-of-median"
+)
+
+// EvidenceVariableCategory represents a grouping for ordinal or polychotomous variables
+type EvidenceVariableCategory struct {
+	common.BackboneElement
+
+	// A human-readable title or representation of the grouping
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Value or set of values that define the grouping
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// Value or set of values that define the grouping
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Value or set of values that define the grouping
+	ValueRange *Range `json:"valueRange,omitempty"`
+}
@@ -0,0 +1,817 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ExplanationOfBenefit represents the ExplanationOfBenefit resource
+type ExplanationOfBenefit struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ExplanationOfBenefit"
+
+	// Details of a accident which resulted in injuries which required the products and services listed in the claim
+	Accident *ExplanationOfBenefitAccident `json:"accident,omitempty"`
+
+	// The first-tier service adjudications for payor added product or service lines
+	AddItem []ExplanationOfBenefitAddItem `json:"addItem,omitempty"`
+
+	// The adjudication results which are presented at the header level rather than at the line-item or add-item levels
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Balance by Benefit Category
+	BenefitBalance []ExplanationOfBenefitBenefitBalance `json:"benefitBalance,omitempty"`
+
+	// Not applicable when use=claim
+	BenefitPeriod *common.Period `json:"benefitPeriod,omitempty"`
+
+	// Typically this would be today or in the past for a claim, and today or in the future for preauthorizations and prodeterminations
+	BillablePeriod *common.Period `json:"billablePeriod,omitempty"`
+
+	// The members of the team who provided the products and services
+	CareTeam []ExplanationOfBenefitCareTeam `json:"careTeam,omitempty"`
+
+	// The business identifier for the instance of the adjudication request: claim predetermination or preauthorization
+	Claim *common.Reference `json:"claim,omitempty"`
+
+	// The business identifier for the instance of the adjudication response: claim, predetermination or preauthorization response
+	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
+
+	// This field is independent of the date of creation of the resource as it may reflect the creation date of a source document prior to digitization
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
+
+	// Information about diagnoses relevant to the claim items
+	Diagnosis []ExplanationOfBenefitDiagnosis `json:"diagnosis,omitempty"`
+
+	// A human readable description of the status of the adjudication
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
+
+	// Individual who created the claim, predetermination or preauthorization
+	Enterer *common.Reference `json:"enterer,omitempty"`
+
+	// Facility where the services were provided
+	Facility *common.Reference `json:"facility,omitempty"`
+
+	// Needed to permit insurers to include the actual form
+	Form *Attachment `json:"form,omitempty"`
+
+	// May be needed to identify specific jurisdictional forms
+	FormCode *common.CodeableConcept `json:"formCode,omitempty"`
+
+	// Fund would be release by a future claim quoting the preAuthRef of this response
+	FundsReserve *common.CodeableConcept `json:"fundsReserve,omitempty"`
+
+	// This field is only used for preauthorizations
+	FundsReserveRequested *common.CodeableConcept `json:"fundsReserveRequested,omitempty"`
+
+	// A unique identifier assigned to this explanation of benefit
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// All insurance coverages for the patient which may be applicable for reimbursement
+	Insurance []ExplanationOfBenefitInsurance `json:"insurance"`
+
+	// The party responsible for authorization, adjudication and reimbursement
+	Insurer common.Reference `json:"insurer"`
+
+	// A claim line. Either a simple (a product or service) or a 'group' of details which can also be a simple items or groups of sub-details
+	Item []ExplanationOfBenefitItem `json:"item,omitempty"`
+
+	// For example, a physician may prescribe a medication which the pharmacy determines is contraindicated
+	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
+
+	// The resource may be used to indicate that: the request has been held (queued) for processing
+	Outcome        ExplanationOfBenefitOutcome `json:"outcome"`
+	OutcomeElement *common.Element             `json:"_outcome,omitempty"`
+
+	// The party to whom the professional services and/or products have been supplied or are being considered
+	Patient common.Reference `json:"patient"`
+
+	// Often providers agree to receive the benefits payable to reduce the near-term costs to the patient
+	Payee *ExplanationOfBenefitPayee `json:"payee,omitempty"`
+
+	// Payment details for the adjudication of the claim
+	Payment *ExplanationOfBenefitPayment `json:"payment,omitempty"`
+
+	// This value is only present on preauthorization adjudications
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+
+	// This value is only present on preauthorization adjudications
+	PreAuthRefPeriod []common.Period `json:"preAuthRefPeriod,omitempty"`
+
+	// This indicates the relative order of a series of EOBs related to different coverages for the same suite of services
+	Precedence        *int            `json:"precedence,omitempty"`
+	PrecedenceElement *common.Element `json:"_precedence,omitempty"`
+
+	// Prescription to support the dispensing of pharmacy, device or vision products
+	Prescription *common.Reference `json:"prescription,omitempty"`
+
+	// If a claim processor is unable to complete the processing as per the priority then they should generate and error and not process the request
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// Procedures performed on the patient relevant to the billing items with the claim
+	Procedure []ExplanationOfBenefitProcedure `json:"procedure,omitempty"`
+
+	// A note that describes or explains adjudication results in a human readable form
+	ProcessNote []ExplanationOfBenefitProcessNote `json:"processNote,omitempty"`
+
+	// Typically this field would be 1..1 where this party is responsible for the claim but not necessarily professionally responsible
+	Provider common.Reference `json:"provider"`
+
+	// The referral resource which lists the date, practitioner, reason and other supporting information
+	Referral *common.Reference `json:"referral,omitempty"`
+
+	// For example, for the original treatment and follow-up exams
+	Related []ExplanationOfBenefitRelated `json:"related,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        ExplanationOfBenefitStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
+
+	// This may contain the local bill type codes such as the US UB-04 bill type code
+	SubType *common.CodeableConcept `json:"subType,omitempty"`
+
+	// Often there are multiple jurisdiction specific valuesets which are required
+	SupportingInfo []ExplanationOfBenefitSupportingInfo `json:"supportingInfo,omitempty"`
+
+	// Totals for amounts submitted, co-pays, benefits payable etc
+	Total []ExplanationOfBenefitTotal `json:"total,omitempty"`
+
+	// The majority of jurisdictions use: oral, pharmacy, vision, professional and institutional, or variants on those terms
+	Type common.CodeableConcept `json:"type"`
+
+	// A code to indicate whether the nature of the request is: to request adjudication of products and services previously rendered
+	Use        ExplanationOfBenefitUse `json:"use"`
+	UseElement *common.Element         `json:"_use,omitempty"`
+}
+
+// ExplanationOfBenefitOutcome represents the outcome of the explanation of benefit
+type ExplanationOfBenefitOutcome string
+
+const (
+	ExplanationOfBenefitOutcomeQueued   ExplanationOfBenefitOutcome = "queued"
+	ExplanationOfBenefitOutcomeComplete ExplanationOfBenefitOutcome = "complete"
+	ExplanationOfBenefitOutcomeError    ExplanationOfBenefitOutcome = "error"
+	ExplanationOfBenefitOutcomePartial  ExplanationOfBenefitOutcome = "partial"
+)
+
+// ExplanationOfBenefitStatus represents the status of the explanation of benefit
+type ExplanationOfBenefitStatus string
+
+const (
+	ExplanationOfBenefitStatusActive         ExplanationOfBenefitStatus = "active"
+	ExplanationOfBenefitStatusCancelled      ExplanationOfBenefitStatus = "cancelled"
+	ExplanationOfBenefitStatusDraft          ExplanationOfBenefitStatus = "draft"
+	ExplanationOfBenefitStatusEnteredInError ExplanationOfBenefitStatus = "entered-in-error"
+)
+
+// ExplanationOfBenefitUse represents the use of the explanation of benefit
+type ExplanationOfBenefitUse string
+
+const (
+	ExplanationOfBenefitUseClaim            ExplanationOfBenefitUse = "claim"
+	ExplanationOfBenefitUsePreauthorization ExplanationOfBenefitUse = "preauthorization"
+	ExplanationOfBenefitUsePredetermination ExplanationOfBenefitUse = "predetermination"
+)
+
+// ExplanationOfBenefitRelated represents related claims
+type ExplanationOfBenefitRelated struct {
+	common.BackboneElement
+
+	// Reference to a related claim
+	Claim *common.Reference `json:"claim,omitempty"`
+
+	// For example, Property/Casualty insurer claim number or Workers Compensation case number
+	Reference *common.Identifier `json:"reference,omitempty"`
+
+	// For example, prior claim or umbrella
+	Relationship *common.CodeableConcept `json:"relationship,omitempty"`
+}
+
+// ExplanationOfBenefitPayee represents the payee information
+type ExplanationOfBenefitPayee struct {
+	common.BackboneElement
+
+	// Not required if the payee is 'subscriber' or 'provider'
+	Party *common.Reference `json:"party,omitempty"`
+
+	// Type of Party to be reimbursed: Subscriber, provider, other
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitCareTeam represents the members of the team who provided the products and services
+type ExplanationOfBenefitCareTeam struct {
+	common.BackboneElement
+
+	// Member of the team who provided the product or service
+	Provider common.Reference `json:"provider"`
+
+	// The qualification of the practitioner which is applicable for this service
+	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
+
+	// Responsible might not be required when there is only a single provider listed
+	Responsible        *bool           `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
+
+	// Role might not be required when there is only a single provider listed
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// A number to uniquely identify care team entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+}
+
+// ExplanationOfBenefitSupportingInfo represents supporting information
+type ExplanationOfBenefitSupportingInfo struct {
+	common.BackboneElement
+
+	// This may contain a category for the local bill type codes
+	Category common.CodeableConcept `json:"category"`
+
+	// This may contain the local bill type codes such as the US UB-04 bill type code
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// For example: the reason for the additional stay, or why a tooth is missing
+	Reason *common.Coding `json:"reason,omitempty"`
+
+	// A number to uniquely identify supporting information entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The date when or period to which this information refers
+	TimingDate        *common.Date    `json:"timingDate,omitempty"`
+	TimingDateElement *common.Element `json:"_timingDate,omitempty"`
+
+	// The date when or period to which this information refers
+	TimingPeriod *common.Period `json:"timingPeriod,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// Could be used to provide references to other resources, document
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+}
+
+// ExplanationOfBenefitDiagnosis represents information about diagnoses relevant to the claim items
+type ExplanationOfBenefitDiagnosis struct {
+	common.BackboneElement
+
+	// The nature of illness or problem in a coded form or as a reference to an external defined Condition
+	DiagnosisCodeableConcept *common.CodeableConcept `json:"diagnosisCodeableConcept,omitempty"`
+
+	// The nature of illness or problem in a coded form or as a reference to an external defined Condition
+	DiagnosisReference *common.Reference `json:"diagnosisReference,omitempty"`
+
+	// Indication of whether the diagnosis was present on admission to a facility
+	OnAdmission *common.CodeableConcept `json:"onAdmission,omitempty"`
+
+	// For example, admitting, primary, secondary, discharge
+	PackageCode *common.CodeableConcept `json:"packageCode,omitempty"`
+
+	// A number to uniquely identify diagnosis entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The type of diagnosis (for example, admitting, primary, secondary, discharge)
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitProcedure represents procedures performed on the patient relevant to the billing items
+type ExplanationOfBenefitProcedure struct {
+	common.BackboneElement
+
+	// Date and optionally time the procedure was performed
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// The code or reference to a Procedure resource which identifies the clinical intervention performed
+	ProcedureCodeableConcept *common.CodeableConcept `json:"procedureCodeableConcept,omitempty"`
+
+	// The code or reference to a Procedure resource which identifies the clinical intervention performed
+	ProcedureReference *common.Reference `json:"procedureReference,omitempty"`
+
+	// Sequence of procedures which serves to order and provide a link
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// When the condition was observed or the relative ranking
+	Type []common.CodeableConcept `json:"type,omitempty"`
+
+	// Unique Device Identifiers associated with this line item or detail item
+	Udi []common.Reference `json:"udi,omitempty"`
+}
+
+// ExplanationOfBenefitInsurance represents insurance coverage information
+type ExplanationOfBenefitInsurance struct {
+	common.BackboneElement
+
+	// Reference to the insurance card level information contained in the Coverage resource
+	Coverage common.Reference `json:"coverage"`
+
+	// A flag to indicate that this Coverage is to be used for adjudication of this claim when set to true
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
+
+	// Reference to the original request resource
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
+}
+
+// ExplanationOfBenefitAccident represents details of an accident
+type ExplanationOfBenefitAccident struct {
+	common.BackboneElement
+
+	// Date of an accident event related to the products and services contained in the claim
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// The physical location of the accident event
+	LocationAddress *Address `json:"locationAddress,omitempty"`
+
+	// The physical location of the accident event
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	// The type or context of the accident event for the purposes of selection of potential insurance coverages and determination of coordination between insurers
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitItemAdjudication represents adjudication results
+type ExplanationOfBenefitItemAdjudication struct {
+	common.BackboneElement
+
+	// Monetary amount associated with the category
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// A code to indicate the information type of this adjudication record
+	Category common.CodeableConcept `json:"category"`
+
+	// A code supporting the understanding of the adjudication result and explaining variance from expected amount
+	Reason *common.CodeableConcept `json:"reason,omitempty"`
+
+	// A non-monetary value associated with the category
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ExplanationOfBenefitItemDetailSubDetail represents sub-details of an item detail
+type ExplanationOfBenefitItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// The adjudication results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// The type of revenue or cost center providing the product and/or service
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// A real world service or product
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// For example, the formula: quantity * unitPrice * factor = net
+	Net *common.Money `json:"net,omitempty"`
+
+	// The numbers associated with notes below which apply to the adjudication of this item
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	// For example: Neonatal program, child dental program or drug users recovery program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The fee charged for the professional service or product
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A number to uniquely identify sub-detail entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// Unique Device Identifiers associated with this line item or detail item
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// The charge amount for the service or product
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitItemDetail represents details of an item
+type ExplanationOfBenefitItemDetail struct {
+	common.BackboneElement
+
+	// The adjudication results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// The type of revenue or cost center providing the product and/or service
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// A real world service or product
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// For example, the formula: quantity * unitPrice * factor = net
+	Net *common.Money `json:"net,omitempty"`
+
+	// The numbers associated with notes below which apply to the adjudication of this item
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	// For example: Neonatal program, child dental program or drug users recovery program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The fee charged for the professional service or product
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A number to uniquely identify detail entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// A claim detail line. Either a simple (a product or service) or a 'group' of sub-details which are simple items
+	SubDetail []ExplanationOfBenefitItemDetailSubDetail `json:"subDetail,omitempty"`
+
+	// Unique Device Identifiers associated with this line item or detail item
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// The charge amount for the service or product
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitItem represents a claim line
+type ExplanationOfBenefitItem struct {
+	common.BackboneElement
+
+	// The adjudication results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Physical location where the service is performed or applies
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// CareTeam members related to this service or product
+	CareTeamSequence        []int             `json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement []*common.Element `json:"_careTeamSequence,omitempty"`
+
+	// The type of revenue or cost center providing the product and/or service
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// A claim detail line. Either a simple (a product or service) or a 'group' of sub-details which are simple items
+	Detail []ExplanationOfBenefitItemDetail `json:"detail,omitempty"`
+
+	// Diagnosis applicable for this service or product
+	DiagnosisSequence        []int             `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement []*common.Element `json:"_diagnosisSequence,omitempty"`
+
+	// Encounters related to this billed item
+	Encounter []common.Reference `json:"encounter,omitempty"`
+
+	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Exceptions, special conditions and supporting information applicable for this service or product
+	InformationSequence        []int             `json:"informationSequence,omitempty"`
+	InformationSequenceElement []*common.Element `json:"_informationSequence,omitempty"`
+
+	// Where the product or service was provided
+	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
+
+	// Where the product or service was provided
+	LocationAddress *Address `json:"locationAddress,omitempty"`
+
+	// Where the product or service was provided
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	// A real world service or product
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// For example, the formula: quantity * unitPrice * factor = net
+	Net *common.Money `json:"net,omitempty"`
+
+	// The numbers associated with notes below which apply to the adjudication of this item
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// Procedures applicable for this service or product
+	ProcedureSequence        []int             `json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement []*common.Element `json:"_procedureSequence,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	// For example: Neonatal program, child dental program or drug users recovery program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The fee charged for the professional service or product
+	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
+
+	// A number to uniquely identify item entries
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
+
+	// The date or dates when the service or product was supplied, performed or completed
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+
+	// The date or dates when the service or product was supplied, performed or completed
+	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
+
+	// A region or surface of the bodySite, e.g. limb region or tooth surface(s)
+	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
+
+	// Unique Device Identifiers associated with this line item or detail item
+	Udi []common.Reference `json:"udi,omitempty"`
+
+	// The charge amount for the service or product
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitAddItemDetailSubDetail represents sub-details of an add item detail
+type ExplanationOfBenefitAddItemDetailSubDetail struct {
+	common.BackboneElement
+
+	// The adjudication results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// A real world service or product
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// For example, the formula: quantity * unitPrice * factor = net
+	Net *common.Money `json:"net,omitempty"`
+
+	// The numbers associated with notes below which apply to the adjudication of this item
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The charge amount for the service or product
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitAddItemDetail represents details of an add item
+type ExplanationOfBenefitAddItemDetail struct {
+	common.BackboneElement
+
+	// The adjudication results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// A real world service or product
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// For example, the formula: quantity * unitPrice * factor = net
+	Net *common.Money `json:"net,omitempty"`
+
+	// The numbers associated with notes below which apply to the adjudication of this item
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// A claim detail line. Either a simple (a product or service) or a 'group' of sub-details which are simple items
+	SubDetail []ExplanationOfBenefitAddItemDetailSubDetail `json:"subDetail,omitempty"`
+
+	// The charge amount for the service or product
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitAddItem represents the first-tier service adjudications for payor added product or service lines
+type ExplanationOfBenefitAddItem struct {
+	common.BackboneElement
+
+	// The adjudication results
+	Adjudication []ExplanationOfBenefitItemAdjudication `json:"adjudication,omitempty"`
+
+	// Physical location where the service is performed or applies
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// A claim detail line. Either a simple (a product or service) or a 'group' of sub-details which are simple items
+	Detail []ExplanationOfBenefitAddItemDetail `json:"detail,omitempty"`
+
+	// The sequence number of the details within the claim item which this line is intended to replace
+	DetailSequence        []int             `json:"detailSequence,omitempty"`
+	DetailSequenceElement []*common.Element `json:"_detailSequence,omitempty"`
+
+	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
+
+	// Claim items which this service line is intended to replace
+	ItemSequence        []int             `json:"itemSequence,omitempty"`
+	ItemSequenceElement []*common.Element `json:"_itemSequence,omitempty"`
+
+	// Where the product or service was provided
+	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
+
+	// Where the product or service was provided
+	LocationAddress *Address `json:"locationAddress,omitempty"`
+
+	// Where the product or service was provided
+	LocationReference *common.Reference `json:"locationReference,omitempty"`
+
+	// A real world service or product
+	Modifier []common.CodeableConcept `json:"modifier,omitempty"`
+
+	// For example, the formula: quantity * unitPrice * factor = net
+	Net *common.Money `json:"net,omitempty"`
+
+	// The numbers associated with notes below which apply to the adjudication of this item
+	NoteNumber        []int             `json:"noteNumber,omitempty"`
+	NoteNumberElement []*common.Element `json:"_noteNumber,omitempty"`
+
+	// If this is an actual service or product line, i.e. not a Group
+	ProductOrService common.CodeableConcept `json:"productOrService"`
+
+	// For example: Neonatal program, child dental program or drug users recovery program
+	ProgramCode []common.CodeableConcept `json:"programCode,omitempty"`
+
+	// The providers who are authorized for the services rendered to the patient
+	Provider []common.Reference `json:"provider,omitempty"`
+
+	// The number of repetitions of a service or product
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The date or dates when the service or product was supplied, performed or completed
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+
+	// The date or dates when the service or product was supplied, performed or completed
+	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
+
+	// The sequence number of the sub-details woithin the details within the claim item which this line is intended to replace
+	SubDetailSequence        []int             `json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement []*common.Element `json:"_subDetailSequence,omitempty"`
+
+	// A region or surface of the bodySite, e.g. limb region or tooth surface(s)
+	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
+
+	// The charge amount for the service or product
+	UnitPrice *common.Money `json:"unitPrice,omitempty"`
+}
+
+// ExplanationOfBenefitTotal represents totals for amounts submitted, co-pays, benefits payable etc
+type ExplanationOfBenefitTotal struct {
+	common.BackboneElement
+
+	// Monetary amount associated with the category
+	Amount common.Money `json:"amount"`
+
+	// A code to indicate the information type of this adjudication record
+	Category common.CodeableConcept `json:"category"`
+}
+
+// ExplanationOfBenefitPayment represents payment details for the adjudication of the claim
+type ExplanationOfBenefitPayment struct {
+	common.BackboneElement
+
+	// Total amount of all adjustments to this payment
+	Adjustment *common.Money `json:"adjustment,omitempty"`
+
+	// Reason for the payment adjustment
+	AdjustmentReason *common.CodeableConcept `json:"adjustmentReason,omitempty"`
+
+	// Benefits payable less any payment adjustment
+	Amount *common.Money `json:"amount,omitempty"`
+
+	// Estimated date, based on the payment date
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	// For example: EOB number, preauthorization number
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Whether this represents partial or complete payment of the claim
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ExplanationOfBenefitProcessNote represents a note that describes or explains adjudication results
+type ExplanationOfBenefitProcessNote struct {
+	common.BackboneElement
+
+	// A code to define the language used in the text of the note
+	Language *common.CodeableConcept `json:"language,omitempty"`
+
+	// A number to uniquely identify a note entry
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
+
+	// The explanation or description associated with the processing
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// The business purpose of the note text
+	Type        *ExplanationOfBenefitProcessNoteType `json:"type,omitempty"`
+	TypeElement *common.Element                      `json:"_type,omitempty"`
+}
+
+// ExplanationOfBenefitProcessNoteType represents the business purpose of the note text
+type ExplanationOfBenefitProcessNoteType string
+
+const (
+	ExplanationOfBenefitProcessNoteTypeDisplay   ExplanationOfBenefitProcessNoteType = "display"
+	ExplanationOfBenefitProcessNoteTypePrint     ExplanationOfBenefitProcessNoteType = "print"
+	ExplanationOfBenefitProcessNoteTypePrintoper ExplanationOfBenefitProcessNoteType = "printoper"
+)
+
+// ExplanationOfBenefitBenefitBalanceFinancial represents financial information for benefit balance
+type ExplanationOfBenefitBenefitBalanceFinancial struct {
+	common.BackboneElement
+
+	// Benefits allowed
+	AllowedUnsignedInt        *int            `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *common.Element `json:"_allowedUnsignedInt,omitempty"`
+
+	// Benefits allowed
+	AllowedString        *string         `json:"allowedString,omitempty"`
+	AllowedStringElement *common.Element `json:"_allowedString,omitempty"`
+
+	// Benefits allowed
+	AllowedMoney *common.Money `json:"allowedMoney,omitempty"`
+
+	// Type of benefit (primary care; speciality care; inpatient; outpatient)
+	Type common.CodeableConcept `json:"type"`
+
+	// Benefits used
+	UsedUnsignedInt        *int            `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement *common.Element `json:"_usedUnsignedInt,omitempty"`
+
+	// Benefits used
+	UsedMoney *common.Money `json:"usedMoney,omitempty"`
+}
+
+// ExplanationOfBenefitBenefitBalance represents balance by Benefit Category
+type ExplanationOfBenefitBenefitBalance struct {
+	common.BackboneElement
+
+	// A code to indicate the Information Class of this adjudication record
+	Category common.CodeableConcept `json:"category"`
+
+	// A richer description of the benefit or services covered
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// True if the indicated class of service is excluded from the plan, missing or False indicates the product or service is included in the coverage
+	Excluded        *bool           `json:"excluded,omitempty"`
+	ExcludedElement *common.Element `json:"_excluded,omitempty"`
+
+	// Benefits Used to date
+	Financial []ExplanationOfBenefitBenefitBalanceFinancial `json:"financial,omitempty"`
+
+	// A short name or tag for the benefit
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Is a flag to indicate whether the benefits refer to in-network providers or out-of-network providers
+	Network *common.CodeableConcept `json:"network,omitempty"`
+
+	// The term or period of the values such as 'maximum lifetime benefit' or 'maximum annual visits'
+	Term *common.CodeableConcept `json:"term,omitempty"`
+
+	// Indicates if the benefits apply to the indicated network location
+	Unit *common.CodeableConcept `json:"unit,omitempty"`
+}
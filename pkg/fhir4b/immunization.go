@@ -0,0 +1,196 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Immunization represents the event of a patient being administered a vaccine or a record of an immunization as reported by a patient, a clinician or another party
+type Immunization struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Immunization"
+
+	// Amount of vaccine administered
+	DoseQuantity *common.Quantity `json:"doseQuantity,omitempty"`
+
+	// Educational material presented to patient
+	Education []ImmunizationEducation `json:"education,omitempty"`
+
+	// Encounter immunization was part of
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Vaccine expiration date
+	ExpirationDate        *common.Date    `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element `json:"_expirationDate,omitempty"`
+
+	// Funding source for the vaccine
+	FundingSource *common.CodeableConcept `json:"fundingSource,omitempty"`
+
+	// Business identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Dose potency
+	IsSubpotent        *bool           `json:"isSubpotent,omitempty"`
+	IsSubpotentElement *common.Element `json:"_isSubpotent,omitempty"`
+
+	// Where immunization occurred
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Vaccine lot number
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
+
+	// Vaccine manufacturer
+	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
+
+	// Additional immunization notes
+	Note []Annotation `json:"note,omitempty"`
+
+	// Vaccine administration date
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+
+	// Vaccine administration date
+	OccurrenceString        *string         `json:"occurrenceString,omitempty"`
+	OccurrenceStringElement *common.Element `json:"_occurrenceString,omitempty"`
+
+	// Who was immunized
+	Patient common.Reference `json:"patient"`
+
+	// Who performed event
+	Performer []ImmunizationPerformer `json:"performer,omitempty"`
+
+	// Indicates if record is from primary source
+	PrimarySource        *bool           `json:"primarySource,omitempty"`
+	PrimarySourceElement *common.Element `json:"_primarySource,omitempty"`
+
+	// Patient eligibility for a vaccination program
+	ProgramEligibility []common.CodeableConcept `json:"programEligibility,omitempty"`
+
+	// Protocol followed by the provider
+	ProtocolApplied []ImmunizationProtocolApplied `json:"protocolApplied,omitempty"`
+
+	// Details of a reaction that follows immunization
+	Reaction []ImmunizationReaction `json:"reaction,omitempty"`
+
+	// Why immunization occurred
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Why immunization occurred
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// When the immunization was first captured in the subject's record
+	Recorded        *common.DateTime `json:"recorded,omitempty"`
+	RecordedElement *common.Element  `json:"_recorded,omitempty"`
+
+	// Indicates the source of the vaccine actually administered
+	ReportOrigin *common.CodeableConcept `json:"reportOrigin,omitempty"`
+
+	// How vaccine entered body
+	Route *common.CodeableConcept `json:"route,omitempty"`
+
+	// Body site vaccine was administered
+	Site *common.CodeableConcept `json:"site,omitempty"`
+
+	// completed | entered-in-error | not-done
+	Status        ImmunizationStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// Reason not done
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+
+	// Reason for being subpotent
+	SubpotentReason []common.CodeableConcept `json:"subpotentReason,omitempty"`
+
+	// Vaccine product administered
+	VaccineCode common.CodeableConcept `json:"vaccineCode"`
+}
+
+// ImmunizationStatus represents the status of the immunization
+type ImmunizationStatus string
+
+const (
+	ImmunizationStatusCompleted      ImmunizationStatus = "completed"
+	ImmunizationStatusEnteredInError ImmunizationStatus = "entered-in-error"
+	ImmunizationStatusNotDone        ImmunizationStatus = "not-done"
+)
+
+// ImmunizationPerformer represents who performed the immunization
+type ImmunizationPerformer struct {
+	common.BackboneElement
+
+	// Individual or organization who was performing
+	Actor common.Reference `json:"actor"`
+
+	// What type of performance was done
+	Function *common.CodeableConcept `json:"function,omitempty"`
+}
+
+// ImmunizationEducation represents educational material presented to patient
+type ImmunizationEducation struct {
+	common.BackboneElement
+
+	// Educational material document identifier
+	DocumentType        *string         `json:"documentType,omitempty"`
+	DocumentTypeElement *common.Element `json:"_documentType,omitempty"`
+
+	// Educational material presentation date
+	PresentationDate        *common.DateTime `json:"presentationDate,omitempty"`
+	PresentationDateElement *common.Element  `json:"_presentationDate,omitempty"`
+
+	// Educational material publication date
+	PublicationDate        *common.DateTime `json:"publicationDate,omitempty"`
+	PublicationDateElement *common.Element  `json:"_publicationDate,omitempty"`
+
+	// Educational material reference pointer
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+}
+
+// ImmunizationReaction represents a reaction that follows immunization
+type ImmunizationReaction struct {
+	common.BackboneElement
+
+	// When reaction started
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Additional information on reaction
+	Detail *common.Reference `json:"detail,omitempty"`
+
+	// Indicates self-reported reaction
+	Reported        *bool           `json:"reported,omitempty"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
+}
+
+// ImmunizationProtocolApplied represents protocol followed by the provider
+type ImmunizationProtocolApplied struct {
+	common.BackboneElement
+
+	// Who is responsible for publishing the recommendations
+	Authority *common.Reference `json:"authority,omitempty"`
+
+	// Dose number within series
+	DoseNumberPositiveInt        *int            `json:"doseNumberPositiveInt,omitempty"`
+	DoseNumberPositiveIntElement *common.Element `json:"_doseNumberPositiveInt,omitempty"`
+
+	// Dose number within series
+	DoseNumberString        *string         `json:"doseNumberString,omitempty"`
+	DoseNumberStringElement *common.Element `json:"_doseNumberString,omitempty"`
+
+	// Name of vaccine series
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
+
+	// Recommended number of doses for immunity
+	SeriesDosesPositiveInt        *int            `json:"seriesDosesPositiveInt,omitempty"`
+	SeriesDosesPositiveIntElement *common.Element `json:"_seriesDosesPositiveInt,omitempty"`
+
+	// Recommended number of doses for immunity
+	SeriesDosesString        *string         `json:"seriesDosesString,omitempty"`
+	SeriesDosesStringElement *common.Element `json:"_seriesDosesString,omitempty"`
+
+	// Vaccine preventatable disease being targeted
+	TargetDisease []common.CodeableConcept `json:"targetDisease,omitempty"`
+}
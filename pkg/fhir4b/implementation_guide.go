@@ -0,0 +1,330 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ImplementationGuide represents a set of rules about how FHIR resources are used
+type ImplementationGuide struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ImplementationGuide"
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A copyright statement relating to the implementation guide and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// Principally, this consists of information about source resource and file locations, and build parameters and templates
+	Definition *ImplementationGuideDefinition `json:"definition,omitempty"`
+
+	// Another implementation guide that this implementation depends on
+	DependsOn []ImplementationGuideDependsOn `json:"dependsOn,omitempty"`
+
+	// This description can be used to capture details such as why the implementation guide was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Allows filtering of implementation guides that are appropriate for use versus not
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Most implementation guides target a single version
+	FhirVersion        []string          `json:"fhirVersion"`
+	FhirVersionElement []*common.Element `json:"_fhirVersion,omitempty"`
+
+	// See Default Profiles for a discussion of which resources are 'covered' by an implementation guide
+	Global []ImplementationGuideGlobal `json:"global,omitempty"`
+
+	// It may be possible for the implementation guide to be used in jurisdictions other than those for which it was originally designed or intended
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// The license that applies to this Implementation Guide, using an SPDX license code, or 'not-open-source'
+	License        *string         `json:"license,omitempty"`
+	LicenseElement *common.Element `json:"_license,omitempty"`
+
+	// Information about an assembled implementation guide, created by the publication tooling
+	Manifest *ImplementationGuideManifest `json:"manifest,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Many (if not all) IG publishing tools will require that this element be present
+	PackageId        string          `json:"packageId"`
+	PackageIdElement *common.Element `json:"_packageId,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// Allows filtering of implementation guides that are appropriate for use versus not
+	Status        ImplementationGuideStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different implementation guide instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ImplementationGuideStatus represents the status of the implementation guide
+type ImplementationGuideStatus string
+
+const (
+	ImplementationGuideStatusDraft   ImplementationGuideStatus = "draft"
+	ImplementationGuideStatusActive  ImplementationGuideStatus = "active"
+	ImplementationGuideStatusRetired ImplementationGuideStatus = "retired"
+	ImplementationGuideStatusUnknown ImplementationGuideStatus = "unknown"
+)
+
+// ImplementationGuideDependsOn represents another implementation guide that this implementation depends on
+type ImplementationGuideDependsOn struct {
+	common.BackboneElement
+
+	// The NPM package name for the Implementation Guide that this IG depends on
+	PackageId        *string         `json:"packageId,omitempty"`
+	PackageIdElement *common.Element `json:"_packageId,omitempty"`
+
+	// Usually, A canonical reference to the implementation guide is the same as the master location at which the implementation guide is published
+	Uri        string          `json:"uri"`
+	UriElement *common.Element `json:"_uri,omitempty"`
+
+	// This follows the syntax of the NPM packaging version field
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ImplementationGuideGlobal represents see Default Profiles for a discussion of which resources are 'covered' by an implementation guide
+type ImplementationGuideGlobal struct {
+	common.BackboneElement
+
+	// The profile allows the implementation guide to specify the types and structure of profiles that are allowed to be referenced in this implementation guide when using inline resources
+	Profile        string          `json:"profile"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
+
+	// The type of resource this definition applies to
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// ImplementationGuideDefinitionGrouping represents grouping used to present related resources in the IG
+type ImplementationGuideDefinitionGrouping struct {
+	common.BackboneElement
+
+	// Human readable text describing the package
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The human-readable title to display for the package of resources when rendering the implementation guide
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// ImplementationGuideDefinitionResource represents a resource that is part of the implementation guide
+type ImplementationGuideDefinitionResource struct {
+	common.BackboneElement
+
+	// A description of the reason that a resource has been included in the implementation guide
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Examples, if present, represent resources that conform to this resource, and cannot be represented by inline resources
+	ExampleBoolean        *bool           `json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement *common.Element `json:"_exampleBoolean,omitempty"`
+
+	// Examples, if present, represent resources that conform to this resource, and cannot be represented by inline resources
+	ExampleCanonical        *string         `json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *common.Element `json:"_exampleCanonical,omitempty"`
+
+	// The resource SHALL be valid against all the versions it is specified to apply to
+	FHIRVersion        []string          `json:"fhirVersion,omitempty"`
+	FHIRVersionElement []*common.Element `json:"_fhirVersion,omitempty"`
+
+	// This must correspond to a package.id element within this implementation guide
+	GroupingId        *string         `json:"groupingId,omitempty"`
+	GroupingIdElement *common.Element `json:"_groupingId,omitempty"`
+
+	// A human assigned name for the resource. All resources SHOULD have a name, but the name may be extracted from the resource (e.g. ValueSet.name)
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Reference of the resource
+	Reference common.Reference `json:"reference"`
+}
+
+// ImplementationGuideDefinitionPage represents a page/Section in the implementation guide
+type ImplementationGuideDefinitionPage struct {
+	common.BackboneElement
+
+	// The format of the page
+	Generation        ImplementationGuideDefinitionPageGeneration `json:"generation"`
+	GenerationElement *common.Element                             `json:"_generation,omitempty"`
+
+	// The publishing tool will autogenerate source for list (source = n/a) and inject included implementations for include
+	NameUrl        *string         `json:"nameUrl,omitempty"`
+	NameUrlElement *common.Element `json:"_nameUrl,omitempty"`
+
+	// The publishing tool will autogenerate source for list (source = n/a) and inject included implementations for include
+	NameReference *common.Reference `json:"nameReference,omitempty"`
+
+	// Nested Pages/Sections under this page
+	Page []ImplementationGuideDefinitionPage `json:"page,omitempty"`
+
+	// The title of the page to be generated
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// ImplementationGuideDefinitionPageGeneration represents the format of the page
+type ImplementationGuideDefinitionPageGeneration string
+
+const (
+	ImplementationGuideDefinitionPageGenerationHtml      ImplementationGuideDefinitionPageGeneration = "html"
+	ImplementationGuideDefinitionPageGenerationMarkdown  ImplementationGuideDefinitionPageGeneration = "markdown"
+	ImplementationGuideDefinitionPageGenerationXml       ImplementationGuideDefinitionPageGeneration = "xml"
+	ImplementationGuideDefinitionPageGenerationGenerated ImplementationGuideDefinitionPageGeneration = "generated"
+)
+
+// ImplementationGuideDefinitionParameter represents defines how IG is built by tools
+type ImplementationGuideDefinitionParameter struct {
+	common.BackboneElement
+
+	// apply | path-resource | path-pages | path-tx-cache | expansion-parameter | rule-broken-links | generate-xml | generate-json | generate-turtle | html-template
+	Code        ImplementationGuideDefinitionParameterCode `json:"code"`
+	CodeElement *common.Element                            `json:"_code,omitempty"`
+
+	// Value for named type, or id, path, or url for a resource
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ImplementationGuideDefinitionParameterCode represents the code of an implementation guide definition parameter
+type ImplementationGuideDefinitionParameterCode string
+
+const (
+	ImplementationGuideDefinitionParameterCodeApply              ImplementationGuideDefinitionParameterCode = "apply"
+	ImplementationGuideDefinitionParameterCodePathResource       ImplementationGuideDefinitionParameterCode = "path-resource"
+	ImplementationGuideDefinitionParameterCodePathPages          ImplementationGuideDefinitionParameterCode = "path-pages"
+	ImplementationGuideDefinitionParameterCodePathTxCache        ImplementationGuideDefinitionParameterCode = "path-tx-cache"
+	ImplementationGuideDefinitionParameterCodeExpansionParameter ImplementationGuideDefinitionParameterCode = "expansion-parameter"
+	ImplementationGuideDefinitionParameterCodeRuleBrokenLinks    ImplementationGuideDefinitionParameterCode = "rule-broken-links"
+	ImplementationGuideDefinitionParameterCodeGenerateXml        ImplementationGuideDefinitionParameterCode = "generate-xml"
+	ImplementationGuideDefinitionParameterCodeGenerateJson       ImplementationGuideDefinitionParameterCode = "generate-json"
+	ImplementationGuideDefinitionParameterCodeGenerateTurtle     ImplementationGuideDefinitionParameterCode = "generate-turtle"
+	ImplementationGuideDefinitionParameterCodeHtmlTemplate       ImplementationGuideDefinitionParameterCode = "html-template"
+)
+
+// ImplementationGuideDefinitionTemplate represents a template for building resources
+type ImplementationGuideDefinitionTemplate struct {
+	common.BackboneElement
+
+	// The code specifies the type of template
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	// The scope in which the template applies
+	Scope        *string         `json:"scope,omitempty"`
+	ScopeElement *common.Element `json:"_scope,omitempty"`
+
+	// The source location for the template
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+}
+
+// ImplementationGuideDefinition represents principally, this consists of information about source resource and file locations, and build parameters and templates
+type ImplementationGuideDefinition struct {
+	common.BackboneElement
+
+	// Grouping used to present related resources in the IG
+	Grouping []ImplementationGuideDefinitionGrouping `json:"grouping,omitempty"`
+
+	// A page/Section in the implementation guide
+	Page *ImplementationGuideDefinitionPage `json:"page,omitempty"`
+
+	// Defines how IG is built by tools
+	Parameter []ImplementationGuideDefinitionParameter `json:"parameter,omitempty"`
+
+	// A resource that is part of the implementation guide
+	Resource []ImplementationGuideDefinitionResource `json:"resource"`
+
+	// A template for building resources
+	Template []ImplementationGuideDefinitionTemplate `json:"template,omitempty"`
+}
+
+// ImplementationGuideManifestResource represents a resource that is part of the implementation guide
+type ImplementationGuideManifestResource struct {
+	common.BackboneElement
+
+	// If true or a reference, indicates the resource is an example instance
+	ExampleBoolean        *bool           `json:"exampleBoolean,omitempty"`
+	ExampleBooleanElement *common.Element `json:"_exampleBoolean,omitempty"`
+
+	// If true or a reference, indicates the resource is an example instance
+	ExampleCanonical        *string         `json:"exampleCanonical,omitempty"`
+	ExampleCanonicalElement *common.Element `json:"_exampleCanonical,omitempty"`
+
+	// Relative path for page in IG
+	Reference common.Reference `json:"reference"`
+
+	// Relative path for page in IG
+	RelativePath        *string         `json:"relativePath,omitempty"`
+	RelativePathElement *common.Element `json:"_relativePath,omitempty"`
+}
+
+// ImplementationGuideManifestPage represents information about a page within the IG
+type ImplementationGuideManifestPage struct {
+	common.BackboneElement
+
+	// Relative path for page in IG
+	Anchor        []string          `json:"anchor,omitempty"`
+	AnchorElement []*common.Element `json:"_anchor,omitempty"`
+
+	// Relative path for page in IG
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Relative path for page in IG
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+}
+
+// ImplementationGuideManifest represents information about an assembled implementation guide, created by the publication tooling
+type ImplementationGuideManifest struct {
+	common.BackboneElement
+
+	// A pointer to other implementation guides that this implementation depends on
+	Image        []string          `json:"image,omitempty"`
+	ImageElement []*common.Element `json:"_image,omitempty"`
+
+	// A pointer to other implementation guides that this implementation depends on
+	Other        []string          `json:"other,omitempty"`
+	OtherElement []*common.Element `json:"_other,omitempty"`
+
+	// Information about a page within the IG
+	Page []ImplementationGuideManifestPage `json:"page,omitempty"`
+
+	// A pointer to other implementation guides that this implementation depends on
+	Rendering        *string         `json:"rendering,omitempty"`
+	RenderingElement *common.Element `json:"_rendering,omitempty"`
+
+	// A resource that is part of the implementation guide
+	Resource []ImplementationGuideManifestResource `json:"resource"`
+}
@@ -0,0 +1,137 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Ingredient represents an ingredient of a manufactured item or pharmaceutical product
+type Ingredient struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Ingredient"
+
+	// If the ingredient is a known or suspected allergen
+	AllergenicIndicator        *bool           `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *common.Element `json:"_allergenicIndicator,omitempty"`
+
+	// The product which this ingredient is a constituent part of
+	For []common.Reference `json:"for,omitempty"`
+
+	// A classification of the ingredient identifying its precise purpose(s) in the drug product
+	Function []common.CodeableConcept `json:"function,omitempty"`
+
+	// The identifier(s) of this Ingredient that are assigned by business processes and/or used to refer to it when a direct URL reference to the resource itself is not appropriate
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The organization(s) that manufacture this ingredient
+	Manufacturer []IngredientManufacturer `json:"manufacturer,omitempty"`
+
+	// A classification of the ingredient identifying its purpose within the product, e.g. active, inactive
+	Role common.CodeableConcept `json:"role"`
+
+	// Allows filtering of ingredient that are appropriate for use versus not
+	Status        IngredientStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
+
+	// The substance that comprises this ingredient
+	Substance IngredientSubstance `json:"substance"`
+}
+
+// IngredientStatus represents the status of an ingredient
+type IngredientStatus string
+
+const (
+	IngredientStatusDraft   IngredientStatus = "draft"
+	IngredientStatusActive  IngredientStatus = "active"
+	IngredientStatusRetired IngredientStatus = "retired"
+	IngredientStatusUnknown IngredientStatus = "unknown"
+)
+
+// IngredientManufacturer represents an organization that manufactures this ingredient
+type IngredientManufacturer struct {
+	common.BackboneElement
+
+	// An organization that manufactures this ingredient
+	Manufacturer common.Reference `json:"manufacturer"`
+
+	// The way in which this manufacturer is associated with the ingredient
+	Role        *IngredientManufacturerRole `json:"role,omitempty"`
+	RoleElement *common.Element             `json:"_role,omitempty"`
+}
+
+// IngredientManufacturerRole represents the role of an ingredient manufacturer
+type IngredientManufacturerRole string
+
+const (
+	IngredientManufacturerRoleAllowed  IngredientManufacturerRole = "allowed"
+	IngredientManufacturerRolePossible IngredientManufacturerRole = "possible"
+	IngredientManufacturerRoleActual   IngredientManufacturerRole = "actual"
+)
+
+// IngredientSubstanceStrengthReferenceStrength represents strength expressed in terms of a reference substance
+type IngredientSubstanceStrengthReferenceStrength struct {
+	common.BackboneElement
+
+	// The country or countries for which the strength range applies
+	Country []common.CodeableConcept `json:"country,omitempty"`
+
+	// For when strength is measured at a particular point or distance
+	MeasurementPoint        *string         `json:"measurementPoint,omitempty"`
+	MeasurementPointElement *common.Element `json:"_measurementPoint,omitempty"`
+
+	// Strength expressed in terms of a reference substance
+	StrengthRatio *Ratio `json:"strengthRatio,omitempty"`
+
+	// Strength expressed in terms of a reference substance
+	StrengthRatioRange *RatioRange `json:"strengthRatioRange,omitempty"`
+
+	// Relevant reference substance
+	Substance *common.CodeableReference `json:"substance,omitempty"`
+}
+
+// IngredientSubstanceStrength represents the quantity of substance, per presentation, or per volume or mass, and type of quantity
+type IngredientSubstanceStrength struct {
+	common.BackboneElement
+
+	// The strength per unitary volume (or mass)
+	ConcentrationRatio *Ratio `json:"concentrationRatio,omitempty"`
+
+	// The strength per unitary volume (or mass)
+	ConcentrationRatioRange *RatioRange `json:"concentrationRatioRange,omitempty"`
+
+	// The country or countries for which the strength range applies
+	Country []common.CodeableConcept `json:"country,omitempty"`
+
+	// For when strength is measured at a particular point or distance
+	MeasurementPoint        *string         `json:"measurementPoint,omitempty"`
+	MeasurementPointElement *common.Element `json:"_measurementPoint,omitempty"`
+
+	// The quantity of substance in the unit of presentation, or in the volume
+	PresentationRatio *Ratio `json:"presentationRatio,omitempty"`
+
+	// The quantity of substance in the unit of presentation, or in the volume
+	PresentationRatioRange *RatioRange `json:"presentationRatioRange,omitempty"`
+
+	// Strength expressed in terms of a reference substance
+	ReferenceStrength []IngredientSubstanceStrengthReferenceStrength `json:"referenceStrength,omitempty"`
+
+	// A textual represention of either the whole of the concentration strength or a part of it
+	TextConcentration        *string         `json:"textConcentration,omitempty"`
+	TextConcentrationElement *common.Element `json:"_textConcentration,omitempty"`
+
+	// A textual represention of either the whole of the presentation strength or a part of it
+	TextPresentation        *string         `json:"textPresentation,omitempty"`
+	TextPresentationElement *common.Element `json:"_textPresentation,omitempty"`
+}
+
+// IngredientSubstance represents the substance that comprises this ingredient
+type IngredientSubstance struct {
+	common.BackboneElement
+
+	// A code or full resource that represents the ingredient's substance
+	Code common.CodeableReference `json:"code"`
+
+	// The quantity of substance in the unit of presentation, or in the volume
+	Strength []IngredientSubstanceStrength `json:"strength,omitempty"`
+}
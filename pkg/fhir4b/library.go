@@ -0,0 +1,134 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Library represents the Library resource is a general-purpose container for knowledge asset definitions
+type Library struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Library"
+
+	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// An individual or organization primarily involved in the creation and maintenance of the content
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// The content of the library as an Attachment
+	Content []Attachment `json:"content,omitempty"`
+
+	// A copyright statement relating to the library and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Describes a set of data that must be provided in order to be able to successfully perform the computations
+	DataRequirement []common.DataRequirement `json:"dataRequirement,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the library was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An individual or organization primarily responsible for internal coherence of the content
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// The effective period for a library determines when the content is applicable for usage
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// An individual or organization responsible for officially endorsing the content for use in some setting
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// Allows filtering of libraries that are appropriate for use versus not
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// It may be possible for the library to be used in jurisdictions other than those for which it was originally designed
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// If specified, this date follows the original approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The parameter element defines parameters used by the library
+	Parameter []common.ParameterDefinition `json:"parameter,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the library
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Each related artifact is either an attachment, or a reference to another resource, but not both
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// An individual or organization primarily responsible for review of some aspect of the content
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// Allows filtering of libraries that are appropriate for use vs. not
+	Status        LibraryStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// A code or group definition that describes the intended subject of the contents of the library
+	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
+
+	// A code or group definition that describes the intended subject of the contents of the library
+	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
+
+	// An explanatory or alternate title for the library giving additional information about its content
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Descriptive topics related to the content of the library
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// Identifies the type of library such as a Logic Library, Model Definition, Asset Collection, or Module Definition
+	Type common.CodeableConcept `json:"type"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// A detailed description of how the library is used from a clinical perspective
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different library instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// LibraryStatus represents the status of the library
+type LibraryStatus string
+
+const (
+	LibraryStatusDraft   LibraryStatus = "draft"
+	LibraryStatusActive  LibraryStatus = "active"
+	LibraryStatusRetired LibraryStatus = "retired"
+	LibraryStatusUnknown LibraryStatus = "unknown"
+)
@@ -0,0 +1,138 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Location represents details and position information for a physical place
+type Location struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Location"
+
+	// Physical location
+	Address *Address `json:"address,omitempty"`
+
+	// A list of alternate names that the location is known as, or was known as in the past
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
+
+	// Description of availability exceptions
+	AvailabilityExceptions        *string         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element `json:"_availabilityExceptions,omitempty"`
+
+	// Additional details about the location that could be displayed as further information
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Technical endpoints providing access to services operated for the location
+	Endpoint []common.Reference `json:"endpoint,omitempty"`
+
+	// What days/times during a week is this location usually open
+	HoursOfOperation []LocationHoursOfOperation `json:"hoursOfOperation,omitempty"`
+
+	// Unique code or number identifying the location to its users
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Organization responsible for provisioning and upkeep
+	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
+
+	// instance | kind - Indicates whether a resource instance represents a specific location or a class of locations
+	Mode        *LocationMode   `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
+
+	// Name of the location as used by humans
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// The operational status covers operation values most relevant to beds
+	OperationalStatus *common.Coding `json:"operationalStatus,omitempty"`
+
+	// Another Location of which this Location is physically a part of
+	PartOf *common.Reference `json:"partOf,omitempty"`
+
+	// Physical form of the location
+	PhysicalType *common.CodeableConcept `json:"physicalType,omitempty"`
+
+	// The absolute geographic location
+	Position *LocationPosition `json:"position,omitempty"`
+
+	// active | suspended | inactive
+	Status        *LocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Contact details of the location
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+
+	// Indicates the type of function performed at the location
+	Type []common.CodeableConcept `json:"type,omitempty"`
+}
+
+// LocationMode represents the mode of the location
+type LocationMode string
+
+const (
+	LocationModeInstance LocationMode = "instance"
+	LocationModeKind     LocationMode = "kind"
+)
+
+// LocationStatus represents the status of the location
+type LocationStatus string
+
+const (
+	LocationStatusActive    LocationStatus = "active"
+	LocationStatusSuspended LocationStatus = "suspended"
+	LocationStatusInactive  LocationStatus = "inactive"
+)
+
+// LocationPosition represents the absolute geographic location
+type LocationPosition struct {
+	common.BackboneElement
+
+	// Altitude with WGS84 datum
+	Altitude        *common.Decimal `json:"altitude,omitempty"`
+	AltitudeElement *common.Element `json:"_altitude,omitempty"`
+
+	// Latitude with WGS84 datum
+	Latitude        common.Decimal  `json:"latitude"`
+	LatitudeElement *common.Element `json:"_latitude,omitempty"`
+
+	// Longitude with WGS84 datum
+	Longitude        common.Decimal  `json:"longitude"`
+	LongitudeElement *common.Element `json:"_longitude,omitempty"`
+}
+
+// LocationHoursOfOperation represents the hours of operation for the location
+type LocationHoursOfOperation struct {
+	common.BackboneElement
+
+	// The Location is open all day
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
+
+	// Time that the Location closes
+	ClosingTime        *common.Time    `json:"closingTime,omitempty"`
+	ClosingTimeElement *common.Element `json:"_closingTime,omitempty"`
+
+	// Indicates which days of the week are available between the start and end Times
+	DaysOfWeek        []DaysOfWeek      `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element `json:"_daysOfWeek,omitempty"`
+
+	// Time that the Location opens
+	OpeningTime        *common.Time    `json:"openingTime,omitempty"`
+	OpeningTimeElement *common.Element `json:"_openingTime,omitempty"`
+}
+
+// DaysOfWeek represents days of the week
+type DaysOfWeek string
+
+const (
+	DaysOfWeekMon DaysOfWeek = "mon"
+	DaysOfWeekTue DaysOfWeek = "tue"
+	DaysOfWeekWed DaysOfWeek = "wed"
+	DaysOfWeekThu DaysOfWeek = "thu"
+	DaysOfWeekFri DaysOfWeek = "fri"
+	DaysOfWeekSat DaysOfWeek = "sat"
+	DaysOfWeekSun DaysOfWeek = "sun"
+)
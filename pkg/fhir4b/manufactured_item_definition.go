@@ -0,0 +1,70 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ManufacturedItemDefinition represents the definition and characteristics of a medicinal manufactured item, such as a tablet or capsule
+type ManufacturedItemDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ManufacturedItemDefinition"
+
+	// Unique identifier
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The ingredients of this manufactured item
+	Ingredient []common.CodeableConcept `json:"ingredient,omitempty"`
+
+	// Dose form as manufactured and before any transformation into the pharmaceutical product
+	ManufacturedDoseForm common.CodeableConcept `json:"manufacturedDoseForm"`
+
+	// Manufacturer of the item (Note that this should be named "manufacturer" but it currently causes technical issues)
+	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
+
+	// General characteristics of this item
+	Property []ManufacturedItemDefinitionProperty `json:"property,omitempty"`
+
+	// Allows filtering of manufactured items that are appropriate for use versus not
+	Status        ManufacturedItemDefinitionStatus `json:"status"`
+	StatusElement *common.Element                  `json:"_status,omitempty"`
+
+	// The “real world” units in which the quantity of the manufactured item is described
+	UnitOfPresentation *common.CodeableConcept `json:"unitOfPresentation,omitempty"`
+}
+
+// ManufacturedItemDefinitionStatus represents the status of a manufactured item definition
+type ManufacturedItemDefinitionStatus string
+
+const (
+	ManufacturedItemDefinitionStatusDraft   ManufacturedItemDefinitionStatus = "draft"
+	ManufacturedItemDefinitionStatusActive  ManufacturedItemDefinitionStatus = "active"
+	ManufacturedItemDefinitionStatusRetired ManufacturedItemDefinitionStatus = "retired"
+	ManufacturedItemDefinitionStatusUnknown ManufacturedItemDefinitionStatus = "unknown"
+)
+
+// ManufacturedItemDefinitionProperty represents general characteristics of this item
+type ManufacturedItemDefinitionProperty struct {
+	common.BackboneElement
+
+	// A code expressing the type of characteristic
+	Type common.CodeableConcept `json:"type"`
+
+	// A value for the characteristic
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// A value for the characteristic
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// A value for the characteristic
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// A value for the characteristic
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// A value for the characteristic
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+}
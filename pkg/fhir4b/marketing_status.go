@@ -0,0 +1,26 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MarketingStatus represents the date when a medicinal product is put on the market or is no longer available
+type MarketingStatus struct {
+	common.BackboneElement
+
+	// The country in which the marketing authorisation has been granted shall be specified It should be specified using the ISO 3166 ‑ 1 alpha-2 code elements
+	Country *common.CodeableConcept `json:"country,omitempty"`
+
+	// The date when the Medicinal Product is placed on the market by the Marketing Authorisation Holder
+	DateRange *common.Period `json:"dateRange,omitempty"`
+
+	// Where a Medicines Regulatory Agency has granted a marketing authorisation for which specific provisions within a jurisdiction apply, the jurisdiction can be specified using an appropriate controlled terminology The controlled term and the controlled term identifier shall be specified
+	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// The date when the Medicinal Product is placed on the market by the Marketing Authorisation Holder
+	RestoreDate        *common.DateTime `json:"restoreDate,omitempty"`
+	RestoreDateElement *common.Element  `json:"_restoreDate,omitempty"`
+
+	// This attribute provides information on the status of the marketing of the medicinal product See ISO/TS 20443 for more information and examples
+	Status common.CodeableConcept `json:"status"`
+}
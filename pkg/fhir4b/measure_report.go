@@ -0,0 +1,155 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MeasureReport represents the MeasureReport resource contains the results of the calculation of a measure
+type MeasureReport struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "MeasureReport"
+
+	// The date this measure report was generated
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// A reference to a Bundle containing the Resources that were used in the calculation of this measure
+	EvaluatedResource []common.Reference `json:"evaluatedResource,omitempty"`
+
+	// The results of the calculation, one for each population group in the measure
+	Group []MeasureReportGroup `json:"group,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// This element is typically defined by the measure, but reproduced here to ensure the measure score can be interpreted
+	ImprovementNotation *common.CodeableConcept `json:"improvementNotation,omitempty"`
+
+	// A reference to the Measure that was calculated to produce this report
+	Measure        string          `json:"measure"`
+	MeasureElement *common.Element `json:"_measure,omitempty"`
+
+	// The reporting period for which the report was calculated
+	Period common.Period `json:"period"`
+
+	// The individual, location, or organization that is reporting the data
+	Reporter *common.Reference `json:"reporter,omitempty"`
+
+	// The status of the measure report
+	Status        MeasureReportStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
+
+	// Optional subject identifying the individual or individuals the report is for
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// The type of measure report
+	Type        MeasureReportType `json:"type"`
+	TypeElement *common.Element   `json:"_type,omitempty"`
+}
+
+// MeasureReportStatus represents the status of the measure report
+type MeasureReportStatus string
+
+const (
+	MeasureReportStatusComplete MeasureReportStatus = "complete"
+	MeasureReportStatusPending  MeasureReportStatus = "pending"
+	MeasureReportStatusError    MeasureReportStatus = "error"
+)
+
+// MeasureReportType represents the type of measure report
+type MeasureReportType string
+
+const (
+	MeasureReportTypeIndividual     MeasureReportType = "individual"
+	MeasureReportTypeSubjectList    MeasureReportType = "subject-list"
+	MeasureReportTypeSummary        MeasureReportType = "summary"
+	MeasureReportTypeDataCollection MeasureReportType = "data-collection"
+)
+
+// MeasureReportGroupPopulation represents the populations that make up the population group
+type MeasureReportGroupPopulation struct {
+	common.BackboneElement
+
+	// The type of the population
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The number of members of the population
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
+
+	// This element refers to a List of subject level MeasureReport resources, one for each subject in this population
+	SubjectResults *common.Reference `json:"subjectResults,omitempty"`
+}
+
+// MeasureReportGroupStratifierStratumComponent represents a component of the stratifier value for this stratum
+type MeasureReportGroupStratifierStratumComponent struct {
+	common.BackboneElement
+
+	// The code for the stratum component value
+	Code common.CodeableConcept `json:"code"`
+
+	// The stratum component value
+	Value common.CodeableConcept `json:"value"`
+}
+
+// MeasureReportGroupStratifierStratumPopulation represents the populations that make up the stratum
+type MeasureReportGroupStratifierStratumPopulation struct {
+	common.BackboneElement
+
+	// The type of the population
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The number of members of the population in this stratum
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
+
+	// This element refers to a List of subject level MeasureReport resources
+	SubjectResults *common.Reference `json:"subjectResults,omitempty"`
+}
+
+// MeasureReportGroupStratifierStratum represents this element contains the results for a single stratum within the stratifier
+type MeasureReportGroupStratifierStratum struct {
+	common.BackboneElement
+
+	// A component of the stratifier value for this stratum
+	Component []MeasureReportGroupStratifierStratumComponent `json:"component,omitempty"`
+
+	// The measure score for this stratum, calculated as appropriate for the measure type and scoring method
+	MeasureScore *common.Quantity `json:"measureScore,omitempty"`
+
+	// The populations that make up the stratum, one for each type of population appropriate to the measure
+	Population []MeasureReportGroupStratifierStratumPopulation `json:"population,omitempty"`
+
+	// The value for this stratum, expressed as a CodeableConcept
+	Value *common.CodeableConcept `json:"value,omitempty"`
+}
+
+// MeasureReportGroupStratifier represents when a measure includes multiple stratifiers
+type MeasureReportGroupStratifier struct {
+	common.BackboneElement
+
+	// The identifier of the stratifier, as defined by the stratifier element of the measure
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// This element contains the results for a single stratum within the stratifier
+	Stratum []MeasureReportGroupStratifierStratum `json:"stratum,omitempty"`
+}
+
+// MeasureReportGroup represents the results of the calculation, one for each population group in the measure
+type MeasureReportGroup struct {
+	common.BackboneElement
+
+	// The results of the calculation, one for each population group in the measure
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The measure score for this population group, calculated as appropriate for the measure type and scoring method
+	MeasureScore *common.Quantity `json:"measureScore,omitempty"`
+
+	// The populations that make up the population group, one for each type of population appropriate for the measure
+	Population []MeasureReportGroupPopulation `json:"population,omitempty"`
+
+	// When a measure includes multiple stratifiers, there will be a stratifier group for each stratifier defined by the measure
+	Stratifier []MeasureReportGroupStratifier `json:"stratifier,omitempty"`
+}
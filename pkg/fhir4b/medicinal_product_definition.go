@@ -0,0 +1,209 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MedicinalProductDefinition represents detailed definition of a medicinal product
+type MedicinalProductDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "MedicinalProductDefinition"
+
+	// Whether the Medicinal Product is subject to additional monitoring for regulatory reasons
+	AdditionalMonitoringIndicator *common.CodeableConcept `json:"additionalMonitoringIndicator,omitempty"`
+
+	// Additional information or supporting documentation about the medicinal product
+	AttachedDocument []common.Reference `json:"attachedDocument,omitempty"`
+
+	// Allows the key product features to be recorded, such as "sugar free", "modified release", "parallel import"
+	Characteristic []MedicinalProductDefinitionCharacteristic `json:"characteristic,omitempty"`
+
+	// Allows the product to be classified by various systems, commonly WHO ATC
+	Classification []common.CodeableConcept `json:"classification,omitempty"`
+
+	// Clinical trials or studies that this product is involved in
+	ClinicalTrial []common.Reference `json:"clinicalTrial,omitempty"`
+
+	// A code that this product is known by, usually within some formal terminology, perhaps assigned by a third party
+	Code []common.Coding `json:"code,omitempty"`
+
+	// The dose form for a single part product, or combined form of a multiple part product
+	CombinedPharmaceuticalDoseForm *common.CodeableConcept `json:"combinedPharmaceuticalDoseForm,omitempty"`
+
+	// A product specific contact, person (in a role), or an organization
+	Contact []MedicinalProductDefinitionContact `json:"contact,omitempty"`
+
+	// Reference to another product, e.g. for linking authorised to investigational product, or a virtual product
+	CrossReference []MedicinalProductDefinitionCrossReference `json:"crossReference,omitempty"`
+
+	// General description of this product
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// If this medicine applies to human or veterinary uses
+	Domain *common.CodeableConcept `json:"domain,omitempty"`
+
+	// Business identifier for this product
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Any component of the drug product which is not the chemical entity defined as the drug substance
+	Impurity []common.CodeableReference `json:"impurity,omitempty"`
+
+	// Description of indication(s) for this product, used when structured indications are not required
+	Indication        *string         `json:"indication,omitempty"`
+	IndicationElement *common.Element `json:"_indication,omitempty"`
+
+	// The ingredients of this medicinal product - when not detailed in other resources
+	Ingredient []common.CodeableConcept `json:"ingredient,omitempty"`
+
+	// The legal status of supply of the medicinal product as classified by the regulator
+	LegalStatusOfSupply *common.CodeableConcept `json:"legalStatusOfSupply,omitempty"`
+
+	// Marketing status of the medicinal product, in contrast to marketing authorization
+	MarketingStatus []MarketingStatus `json:"marketingStatus,omitempty"`
+
+	// A master file for the medicinal product (e.g. Pharmacovigilance System Master File)
+	MasterFile []common.Reference `json:"masterFile,omitempty"`
+
+	// The product's name, including full name and possibly coded parts
+	Name []MedicinalProductDefinitionName `json:"name"`
+
+	// A manufacturing or administrative process or step associated with (or performed on) the medicinal product
+	Operation []MedicinalProductDefinitionOperation `json:"operation,omitempty"`
+
+	// Package type for the product
+	PackagedMedicinalProduct []common.CodeableConcept `json:"packagedMedicinalProduct,omitempty"`
+
+	// If authorised for use in children, or infants, neonates etc
+	PediatricUseIndicator *common.CodeableConcept `json:"pediatricUseIndicator,omitempty"`
+
+	// The path by which the product is taken into or makes contact with the body
+	Route []common.CodeableConcept `json:"route,omitempty"`
+
+	// Whether the Medicinal Product is subject to special measures for regulatory reasons
+	SpecialMeasures []common.CodeableConcept `json:"specialMeasures,omitempty"`
+
+	// The status within the lifecycle of this product record
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// The date at which the given status became applicable
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
+
+	// Regulatory type, e.g. Investigational or Authorized
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// A business identifier relating to a specific version of the product
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// MedicinalProductDefinitionContact represents a product specific contact, person (in a role), or an organization
+type MedicinalProductDefinitionContact struct {
+	common.BackboneElement
+
+	// A product specific contact, person (in a role), or an organization
+	Contact common.Reference `json:"contact"`
+
+	// Allows the contact to be classified, for example QPPV, Pharmacovigilance Enquiry Information
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// MedicinalProductDefinitionNameNamePart represents coding words or phrases of the name
+type MedicinalProductDefinitionNameNamePart struct {
+	common.BackboneElement
+
+	// A fragment of a product name
+	Part        string          `json:"part"`
+	PartElement *common.Element `json:"_part,omitempty"`
+
+	// Identifying type for this part of the name (e.g. strength part)
+	Type common.CodeableConcept `json:"type"`
+}
+
+// MedicinalProductDefinitionNameCountryLanguage represents country and jurisdiction where the name applies, and associated language
+type MedicinalProductDefinitionNameCountryLanguage struct {
+	common.BackboneElement
+
+	// Country code for where this name applies
+	Country common.CodeableConcept `json:"country"`
+
+	// Jurisdiction code for where this name applies
+	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Language code for this name
+	Language common.CodeableConcept `json:"language"`
+}
+
+// MedicinalProductDefinitionName represents the product's name, including full name and possibly coded parts
+type MedicinalProductDefinitionName struct {
+	common.BackboneElement
+
+	// Country and jurisdiction where the name applies, and associated language
+	CountryLanguage []MedicinalProductDefinitionNameCountryLanguage `json:"countryLanguage,omitempty"`
+
+	// Coding words or phrases of the name
+	NamePart []MedicinalProductDefinitionNameNamePart `json:"namePart,omitempty"`
+
+	// The full product name
+	ProductName        string          `json:"productName"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
+
+	// Type of product name, such as rINN, BAN, Proprietary, Non-Proprietary
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// MedicinalProductDefinitionCrossReference represents reference to another product, e.g. for linking authorised to investigational product
+type MedicinalProductDefinitionCrossReference struct {
+	common.BackboneElement
+
+	// Reference to another product, e.g. for linking authorised to investigational product
+	Product common.CodeableReference `json:"product"`
+
+	// The type of relationship, for instance branded to generic, virtual to actual product, product to development product
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// MedicinalProductDefinitionOperation represents a manufacturing or administrative process for the medicinal product
+type MedicinalProductDefinitionOperation struct {
+	common.BackboneElement
+
+	// Specifies whether this particular business or manufacturing process is considered proprietary or confidential
+	ConfidentialityIndicator *common.CodeableConcept `json:"confidentialityIndicator,omitempty"`
+
+	// Date range of applicability
+	EffectiveDate *common.Period `json:"effectiveDate,omitempty"`
+
+	// The organization or establishment responsible for (or associated with) the particular process or step
+	Organization []common.Reference `json:"organization,omitempty"`
+
+	// The type of manufacturing operation e.g. manufacturing itself, re-packaging
+	Type *common.CodeableReference `json:"type,omitempty"`
+}
+
+// MedicinalProductDefinitionCharacteristic represents key product features such as "sugar free", "modified release"
+type MedicinalProductDefinitionCharacteristic struct {
+	common.BackboneElement
+
+	// A code expressing the type of characteristic
+	Type common.CodeableConcept `json:"type"`
+
+	// A value for the characteristic
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// A value for the characteristic
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// A value for the characteristic
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// A value for the characteristic
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// A value for the characteristic
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+}
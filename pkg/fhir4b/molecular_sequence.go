@@ -0,0 +1,347 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// MolecularSequence represents raw data describing a biological sequence
+type MolecularSequence struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "MolecularSequence"
+
+	// Whether the sequence is numbered starting at 0 (0-based numbering or coordinates, inclusive start, exclusive end) or starting at 1 (1-based numbering, inclusive start and inclusive end)
+	CoordinateSystem        int             `json:"coordinateSystem"`
+	CoordinateSystemElement *common.Element `json:"_coordinateSystem,omitempty"`
+
+	// The method for sequencing, for example, chip information
+	Device *common.Reference `json:"device,omitempty"`
+
+	// A unique identifier for this particular sequence instance
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Sequence that was observed
+	ObservedSeq        *string         `json:"observedSeq,omitempty"`
+	ObservedSeqElement *common.Element `json:"_observedSeq,omitempty"`
+
+	// The patient whose sequencing results are described by this resource
+	Patient *common.Reference `json:"patient,omitempty"`
+
+	// The organization or lab that should be responsible for this result
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Pointer to next atomic sequence which at most contains one variant
+	Pointer []common.Reference `json:"pointer,omitempty"`
+
+	// An experimental feature attribute that defines the quality of the feature in a quantitative way
+	Quality []MolecularSequenceQuality `json:"quality,omitempty"`
+
+	// The number of copies of the sequence of interest (RNASeq)
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Coverage (read depth or depth) is the average number of reads representing a given nucleotide in the reconstructed sequence
+	ReadCoverage        *int            `json:"readCoverage,omitempty"`
+	ReadCoverageElement *common.Element `json:"_readCoverage,omitempty"`
+
+	// A sequence that is used as a reference to describe variants that are present in a sequence analyzed
+	ReferenceSeq *MolecularSequenceReferenceSeq `json:"referenceSeq,omitempty"`
+
+	// Configurations of the external repository
+	Repository []MolecularSequenceRepository `json:"repository,omitempty"`
+
+	// Specimen used for sequencing
+	Specimen *common.Reference `json:"specimen,omitempty"`
+
+	// Information about chromosome structure variation
+	StructureVariant []MolecularSequenceStructureVariant `json:"structureVariant,omitempty"`
+
+	// Amino Acid Sequence/ DNA Sequence / RNA Sequence
+	Type        *MolecularSequenceType `json:"type,omitempty"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
+
+	// The definition of variant here originates from Sequence ontology
+	Variant []MolecularSequenceVariant `json:"variant,omitempty"`
+}
+
+// MolecularSequenceType represents the type of sequence
+type MolecularSequenceType string
+
+const (
+	MolecularSequenceTypeAA  MolecularSequenceType = "aa"
+	MolecularSequenceTypeDNA MolecularSequenceType = "dna"
+	MolecularSequenceTypeRNA MolecularSequenceType = "rna"
+)
+
+// MolecularSequenceReferenceSeq represents a sequence that is used as a reference to describe variants
+type MolecularSequenceReferenceSeq struct {
+	common.BackboneElement
+
+	// Structural unit composed of a nucleic acid molecule which controls its own replication
+	Chromosome *common.CodeableConcept `json:"chromosome,omitempty"`
+
+	// The Genome Build used for reference, following GRCh build versions e.g. 'GRCh 37'
+	GenomeBuild        *string         `json:"genomeBuild,omitempty"`
+	GenomeBuildElement *common.Element `json:"_genomeBuild,omitempty"`
+
+	// A relative reference to a DNA strand based on gene orientation
+	Orientation        *MolecularSequenceReferenceSeqOrientation `json:"orientation,omitempty"`
+	OrientationElement *common.Element                           `json:"_orientation,omitempty"`
+
+	// Reference identifier of reference sequence submitted to NCBI
+	ReferenceSeqId *common.CodeableConcept `json:"referenceSeqId,omitempty"`
+
+	// A pointer to another MolecularSequence entity as reference sequence
+	ReferenceSeqPointer *common.Reference `json:"referenceSeqPointer,omitempty"`
+
+	// A string like "ACGT"
+	ReferenceSeqString        *string         `json:"referenceSeqString,omitempty"`
+	ReferenceSeqStringElement *common.Element `json:"_referenceSeqString,omitempty"`
+
+	// An absolute reference to a strand
+	Strand        *MolecularSequenceReferenceSeqStrand `json:"strand,omitempty"`
+	StrandElement *common.Element                      `json:"_strand,omitempty"`
+
+	// End position of the window on the reference sequence
+	WindowEnd        *int            `json:"windowEnd,omitempty"`
+	WindowEndElement *common.Element `json:"_windowEnd,omitempty"`
+
+	// Start position of the window on the reference sequence
+	WindowStart        *int            `json:"windowStart,omitempty"`
+	WindowStartElement *common.Element `json:"_windowStart,omitempty"`
+}
+
+// MolecularSequenceReferenceSeqOrientation represents a relative reference to a DNA strand
+type MolecularSequenceReferenceSeqOrientation string
+
+const (
+	MolecularSequenceReferenceSeqOrientationSense     MolecularSequenceReferenceSeqOrientation = "sense"
+	MolecularSequenceReferenceSeqOrientationAntisense MolecularSequenceReferenceSeqOrientation = "antisense"
+)
+
+// MolecularSequenceReferenceSeqStrand represents an absolute reference to a strand
+type MolecularSequenceReferenceSeqStrand string
+
+const (
+	MolecularSequenceReferenceSeqStrandWatson MolecularSequenceReferenceSeqStrand = "watson"
+	MolecularSequenceReferenceSeqStrandCrick  MolecularSequenceReferenceSeqStrand = "crick"
+)
+
+// MolecularSequenceVariant represents the definition of variant here originates from Sequence ontology
+type MolecularSequenceVariant struct {
+	common.BackboneElement
+
+	// Extended CIGAR string for aligning the sequence with reference bases
+	Cigar        *string         `json:"cigar,omitempty"`
+	CigarElement *common.Element `json:"_cigar,omitempty"`
+
+	// End position of the variant on the reference sequence
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// An allele is one of a set of coexisting sequence variants of a gene
+	ObservedAllele        *string         `json:"observedAllele,omitempty"`
+	ObservedAlleleElement *common.Element `json:"_observedAllele,omitempty"`
+
+	// An allele is one of a set of coexisting sequence variants of a gene
+	ReferenceAllele        *string         `json:"referenceAllele,omitempty"`
+	ReferenceAlleleElement *common.Element `json:"_referenceAllele,omitempty"`
+
+	// Start position of the variant on the reference sequence
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// A pointer to an Observation containing variant information
+	VariantPointer *common.Reference `json:"variantPointer,omitempty"`
+}
+
+// MolecularSequenceQualityRoc represents Receiver Operator Characteristic (ROC) Curve
+type MolecularSequenceQualityRoc struct {
+	common.BackboneElement
+
+	// Calculated fScore if the GQ score threshold was set to "score" field value
+	FMeasure        []common.Decimal  `json:"fMeasure,omitempty"`
+	FMeasureElement []*common.Element `json:"_fMeasure,omitempty"`
+
+	// The number of false negatives if the GQ score threshold was set to "score" field value
+	NumFN        []int             `json:"numFN,omitempty"`
+	NumFNElement []*common.Element `json:"_numFN,omitempty"`
+
+	// The number of false positives if the GQ score threshold was set to "score" field value
+	NumFP        []int             `json:"numFP,omitempty"`
+	NumFPElement []*common.Element `json:"_numFP,omitempty"`
+
+	// The number of true positives if the GQ score threshold was set to "score" field value
+	NumTP        []int             `json:"numTP,omitempty"`
+	NumTPElement []*common.Element `json:"_numTP,omitempty"`
+
+	// Calculated precision if the GQ score threshold was set to "score" field value
+	Precision        []common.Decimal  `json:"precision,omitempty"`
+	PrecisionElement []*common.Element `json:"_precision,omitempty"`
+
+	// Individual data point representing the GQ (genotype quality) score threshold
+	Score        []int             `json:"score,omitempty"`
+	ScoreElement []*common.Element `json:"_score,omitempty"`
+
+	// Calculated sensitivity if the GQ score threshold was set to "score" field value
+	Sensitivity        []common.Decimal  `json:"sensitivity,omitempty"`
+	SensitivityElement []*common.Element `json:"_sensitivity,omitempty"`
+}
+
+// MolecularSequenceQuality represents an experimental feature attribute that defines the quality of the feature
+type MolecularSequenceQuality struct {
+	common.BackboneElement
+
+	// End position of the sequence
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// Harmonic mean of Recall and Precision
+	FScore        *common.Decimal `json:"fScore,omitempty"`
+	FScoreElement *common.Element `json:"_fScore,omitempty"`
+
+	// The number of false positives where the non-REF alleles in the Truth and Query Call Sets match
+	GtFP        *common.Decimal `json:"gtFP,omitempty"`
+	GtFPElement *common.Element `json:"_gtFP,omitempty"`
+
+	// Which method is used to get sequence quality
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// QUERY.TP / (QUERY.TP + QUERY.FP)
+	Precision        *common.Decimal `json:"precision,omitempty"`
+	PrecisionElement *common.Element `json:"_precision,omitempty"`
+
+	// False positives, i.e. the number of sites in the Query Call Set for which there is no path through the Truth Call Set
+	QueryFP        *common.Decimal `json:"queryFP,omitempty"`
+	QueryFPElement *common.Element `json:"_queryFP,omitempty"`
+
+	// True positives, from the perspective of the query data
+	QueryTP        *common.Decimal `json:"queryTP,omitempty"`
+	QueryTPElement *common.Element `json:"_queryTP,omitempty"`
+
+	// TRUTH.TP / (TRUTH.TP + TRUTH.FN)
+	Recall        *common.Decimal `json:"recall,omitempty"`
+	RecallElement *common.Element `json:"_recall,omitempty"`
+
+	// Receiver Operator Characteristic (ROC) Curve to give sensitivity/specificity tradeoff
+	Roc *MolecularSequenceQualityRoc `json:"roc,omitempty"`
+
+	// The score of an experimentally derived feature such as a p-value
+	Score *common.Quantity `json:"score,omitempty"`
+
+	// Gold standard sequence used for comparing against
+	StandardSequence *common.CodeableConcept `json:"standardSequence,omitempty"`
+
+	// Start position of the sequence
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// False negatives, i.e. the number of sites in the Truth Call Set for which there is no path through the Query Call Set
+	TruthFN        *common.Decimal `json:"truthFN,omitempty"`
+	TruthFNElement *common.Element `json:"_truthFN,omitempty"`
+
+	// True positives, from the perspective of the truth data
+	TruthTP        *common.Decimal `json:"truthTP,omitempty"`
+	TruthTPElement *common.Element `json:"_truthTP,omitempty"`
+
+	// INDEL / SNP / Undefined variant
+	Type        MolecularSequenceQualityType `json:"type"`
+	TypeElement *common.Element              `json:"_type,omitempty"`
+}
+
+// MolecularSequenceQualityType represents the type of quality
+type MolecularSequenceQualityType string
+
+const (
+	MolecularSequenceQualityTypeIndel   MolecularSequenceQualityType = "indel"
+	MolecularSequenceQualityTypeSNP     MolecularSequenceQualityType = "snp"
+	MolecularSequenceQualityTypeUnknown MolecularSequenceQualityType = "unknown"
+)
+
+// MolecularSequenceRepository represents configurations of the external repository
+type MolecularSequenceRepository struct {
+	common.BackboneElement
+
+	// Id of the variant in this external repository
+	DatasetId        *string         `json:"datasetId,omitempty"`
+	DatasetIdElement *common.Element `json:"_datasetId,omitempty"`
+
+	// URI of an external repository which contains further details about the genetics data
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Id of the read in this external repository
+	ReadsetId        *string         `json:"readsetId,omitempty"`
+	ReadsetIdElement *common.Element `json:"_readsetId,omitempty"`
+
+	// Click and see / RESTful API / Need login to see / RESTful API with authentication / Other ways to see resource
+	Type        MolecularSequenceRepositoryType `json:"type"`
+	TypeElement *common.Element                 `json:"_type,omitempty"`
+
+	// URI of an external repository which contains further details about the genetics data
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// Id of the variantset in this external repository
+	VariantsetId        *string         `json:"variantsetId,omitempty"`
+	VariantsetIdElement *common.Element `json:"_variantsetId,omitempty"`
+}
+
+// MolecularSequenceRepositoryType represents the type of repository
+type MolecularSequenceRepositoryType string
+
+const (
+	MolecularSequenceRepositoryTypeDirectlink MolecularSequenceRepositoryType = "directlink"
+	MolecularSequenceRepositoryTypeOpenapi    MolecularSequenceRepositoryType = "openapi"
+	MolecularSequenceRepositoryTypeLogin      MolecularSequenceRepositoryType = "login"
+	MolecularSequenceRepositoryTypeOauth      MolecularSequenceRepositoryType = "oauth"
+	MolecularSequenceRepositoryTypeOther      MolecularSequenceRepositoryType = "other"
+)
+
+// MolecularSequenceStructureVariantOuter represents structural variant outer
+type MolecularSequenceStructureVariantOuter struct {
+	common.BackboneElement
+
+	// Structural variant outer end
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// Structural variant outer start
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+}
+
+// MolecularSequenceStructureVariantInner represents structural variant inner
+type MolecularSequenceStructureVariantInner struct {
+	common.BackboneElement
+
+	// Structural variant inner end
+	End        *int            `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// Structural variant inner start
+	Start        *int            `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
+}
+
+// MolecularSequenceStructureVariant represents information about chromosome structure variation
+type MolecularSequenceStructureVariant struct {
+	common.BackboneElement
+
+	// Used to indicate if the outer and inner start-end values have the same meaning
+	Exact        *bool           `json:"exact,omitempty"`
+	ExactElement *common.Element `json:"_exact,omitempty"`
+
+	// Structural variant inner
+	Inner *MolecularSequenceStructureVariantInner `json:"inner,omitempty"`
+
+	// Length of the variant chromosome
+	Length        *int            `json:"length,omitempty"`
+	LengthElement *common.Element `json:"_length,omitempty"`
+
+	// Structural variant outer
+	Outer *MolecularSequenceStructureVariantOuter `json:"outer,omitempty"`
+
+	// Information about chromosome structure variation
+	VariantType *common.CodeableConcept `json:"variantType,omitempty"`
+}
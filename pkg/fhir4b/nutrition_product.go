@@ -0,0 +1,127 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// NutritionProduct represents a product used for nutritional purposes (i.e. food or supplement)
+type NutritionProduct struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "NutritionProduct"
+
+	// Nutrition products can have different classifications - according to its nutritional properties, preparation methods
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// The code assigned to the product, for example a manufacturer number or other terminology
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Ingredients contained in this product
+	Ingredient []NutritionProductIngredient `json:"ingredient,omitempty"`
+
+	// Conveys instance-level information about this product item
+	Instance *NutritionProductInstance `json:"instance,omitempty"`
+
+	// Allergens that are known or suspected to be a part of this nutrition product
+	KnownAllergen []common.CodeableReference `json:"knownAllergen,omitempty"`
+
+	// The organisation (manufacturer, representative or legal authorisation holder) that is responsible for the device
+	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
+
+	// Comments made about the product
+	Note []Annotation `json:"note,omitempty"`
+
+	// Note: This is a business identifier, not a resource identifier (see discussion)
+	Nutrient []NutritionProductNutrient `json:"nutrient,omitempty"`
+
+	// Specifies descriptive properties of the nutrition product
+	ProductCharacteristic []NutritionProductProductCharacteristic `json:"productCharacteristic,omitempty"`
+
+	// Unknown does not represent "other" - one of the defined statuses must apply
+	Status        NutritionProductStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+}
+
+// NutritionProductStatus represents the status of a nutrition product
+type NutritionProductStatus string
+
+const (
+	NutritionProductStatusActive         NutritionProductStatus = "active"
+	NutritionProductStatusInactive       NutritionProductStatus = "inactive"
+	NutritionProductStatusEnteredInError NutritionProductStatus = "entered-in-error"
+)
+
+// NutritionProductNutrient represents the product's nutritional information expressed by the nutrients
+type NutritionProductNutrient struct {
+	common.BackboneElement
+
+	// The amount of nutrient expressed in one or more units: X per pack / per serving / per dose
+	Amount []Ratio `json:"amount,omitempty"`
+
+	// The (relevant) nutrients in the product
+	Item *common.CodeableReference `json:"item,omitempty"`
+}
+
+// NutritionProductIngredient represents ingredients contained in this product
+type NutritionProductIngredient struct {
+	common.BackboneElement
+
+	// The amount of ingredient that is in the product
+	Amount []Ratio `json:"amount,omitempty"`
+
+	// The ingredient contained in the product
+	Item common.CodeableReference `json:"item"`
+}
+
+// NutritionProductProductCharacteristic represents specifies descriptive properties of the nutrition product
+type NutritionProductProductCharacteristic struct {
+	common.BackboneElement
+
+	// A code specifying which characteristic of the product is being described (for example, colour, shape)
+	Type common.CodeableConcept `json:"type"`
+
+	// The description should be provided as a CodeableConcept, SimpleQuantity or an image
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// The description should be provided as a CodeableConcept, SimpleQuantity or an image
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// The description should be provided as a CodeableConcept, SimpleQuantity or an image
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// The description should be provided as a CodeableConcept, SimpleQuantity or an image
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
+
+	// The description should be provided as a CodeableConcept, SimpleQuantity or an image
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// The description should be provided as a CodeableConcept, SimpleQuantity or an image
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+}
+
+// NutritionProductInstance represents one or several physical instances or occurrences of the nutrition product
+type NutritionProductInstance struct {
+	common.BackboneElement
+
+	// The time after which the product is no longer expected to be in proper condition
+	Expiry        *common.DateTime `json:"expiry,omitempty"`
+	ExpiryElement *common.Element  `json:"_expiry,omitempty"`
+
+	// The identifier for the physical instance, typically a serial number
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The identification of the batch or lot of the product
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
+
+	// The amount of items or instances that the resource considers, for instance when referring to 2 identical units together
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// The time after which the product is no longer expected to be in proper condition
+	UseBy        *common.DateTime `json:"useBy,omitempty"`
+	UseByElement *common.Element  `json:"_useBy,omitempty"`
+}
@@ -0,0 +1,226 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Observation represents measurements and simple assertions made about a patient
+type Observation struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Observation"
+
+	// Fulfills plan, proposal or order
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Observed body part
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// Classification of type of observation
+	Category []common.CodeableConcept `json:"category,omitempty"`
+
+	// Type of observation (code / type)
+	Code common.CodeableConcept `json:"code"`
+
+	// Component results
+	Component []ObservationComponent `json:"component,omitempty"`
+
+	// Why the result is missing
+	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
+
+	// Related measurements the observation is made from
+	DerivedFrom []common.Reference `json:"derivedFrom,omitempty"`
+
+	// (Measurement) Device
+	Device *common.Reference `json:"device,omitempty"`
+
+	// Clinically relevant time/time-period for observation
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+
+	// Clinically relevant time/time-period for observation
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// Clinically relevant time/time-period for observation
+	EffectiveTiming *Timing `json:"effectiveTiming,omitempty"`
+
+	// Clinically relevant time/time-period for observation
+	EffectiveInstant        *common.Instant `json:"effectiveInstant,omitempty"`
+	EffectiveInstantElement *common.Element `json:"_effectiveInstant,omitempty"`
+
+	// Healthcare event during which this observation is made
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// What the observation is about, when it is not about the subject of record
+	Focus []common.Reference `json:"focus,omitempty"`
+
+	// Related resource that belongs to the Observation group
+	HasMember []common.Reference `json:"hasMember,omitempty"`
+
+	// Business Identifier for observation
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// High, low, normal, etc.
+	Interpretation []common.CodeableConcept `json:"interpretation,omitempty"`
+
+	// Date/Time this version was made available
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
+
+	// How it was done
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// Comments about the observation
+	Note []Annotation `json:"note,omitempty"`
+
+	// Part of referenced event
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Who is responsible for the observation
+	Performer []common.Reference `json:"performer,omitempty"`
+
+	// Provides guide for interpretation
+	ReferenceRange []ObservationReferenceRange `json:"referenceRange,omitempty"`
+
+	// Specimen used for this observation
+	Specimen *common.Reference `json:"specimen,omitempty"`
+
+	// registered | preliminary | final | amended | corrected | cancelled | entered-in-error | unknown
+	Status        ObservationStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
+
+	// Who and/or what the observation is about
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// Actual result
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Actual result
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// Actual result
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// Actual result
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Actual result
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// Actual result
+	ValueRange *Range `json:"valueRange,omitempty"`
+
+	// Actual result
+	ValueRatio *Ratio `json:"valueRatio,omitempty"`
+
+	// Actual result
+	ValueSampledData *common.SampledData `json:"valueSampledData,omitempty"`
+
+	// Actual result
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
+
+	// Actual result
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	// Actual result
+	ValuePeriod *common.Period `json:"valuePeriod,omitempty"`
+}
+
+// ObservationStatus represents the status of an observation
+type ObservationStatus string
+
+const (
+	ObservationStatusRegistered     ObservationStatus = "registered"
+	ObservationStatusPreliminary    ObservationStatus = "preliminary"
+	ObservationStatusFinal          ObservationStatus = "final"
+	ObservationStatusAmended        ObservationStatus = "amended"
+	ObservationStatusCorrected      ObservationStatus = "corrected"
+	ObservationStatusCancelled      ObservationStatus = "cancelled"
+	ObservationStatusEnteredInError ObservationStatus = "entered-in-error"
+	ObservationStatusUnknown        ObservationStatus = "unknown"
+)
+
+// ObservationReferenceRange represents reference range for an observation
+type ObservationReferenceRange struct {
+	common.BackboneElement
+
+	// Applicable age range, if relevant
+	Age *Range `json:"age,omitempty"`
+
+	// Reference range population
+	AppliesTo []common.CodeableConcept `json:"appliesTo,omitempty"`
+
+	// High Range, if relevant
+	High *common.Quantity `json:"high,omitempty"`
+
+	// Low Range, if relevant
+	Low *common.Quantity `json:"low,omitempty"`
+
+	// Text based reference range in an observation
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+
+	// Reference range qualifier
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// ObservationComponent represents a component observation
+type ObservationComponent struct {
+	common.BackboneElement
+
+	// Type of component observation (code / type)
+	Code common.CodeableConcept `json:"code"`
+
+	// Why the component result is missing
+	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
+
+	// High, low, normal, etc.
+	Interpretation []common.CodeableConcept `json:"interpretation,omitempty"`
+
+	// Provides guide for interpretation of component result
+	ReferenceRange []ObservationReferenceRange `json:"referenceRange,omitempty"`
+
+	// Actual component result
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Actual component result
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// Actual component result
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// Actual component result
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Actual component result
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// Actual component result
+	ValueRange *Range `json:"valueRange,omitempty"`
+
+	// Actual component result
+	ValueRatio *Ratio `json:"valueRatio,omitempty"`
+
+	// Actual component result
+	ValueSampledData *common.SampledData `json:"valueSampledData,omitempty"`
+
+	// Actual component result
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
+
+	// Actual component result
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	// Actual component result
+	ValuePeriod *common.Period `json:"valuePeriod,omitempty"`
+}
@@ -0,0 +1,160 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PackagedProductDefinition represents a medically related item or items, in a container or package
+type PackagedProductDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "PackagedProductDefinition"
+
+	// Allows the key features to be recorded, such as "hospital pack", "nurse prescribable", "calendar pack"
+	Characteristic []common.CodeableConcept `json:"characteristic,omitempty"`
+
+	// A total of the complete count of contained items of a particular type/form, independent of sub-packaging or organization
+	ContainedItemQuantity []common.Quantity `json:"containedItemQuantity,omitempty"`
+
+	// States whether a drug product is supplied with another item such as a diluent or adjuvant
+	CopackagedIndicator        *bool           `json:"copackagedIndicator,omitempty"`
+	CopackagedIndicatorElement *common.Element `json:"_copackagedIndicator,omitempty"`
+
+	// Textual description
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A unique identifier for this package as whole
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The legal status of supply of the packaged item as classified by the regulator
+	LegalStatusOfSupply []PackagedProductDefinitionLegalStatusOfSupply `json:"legalStatusOfSupply,omitempty"`
+
+	// Manufacturer of this package type
+	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
+
+	// Allows specifying that an item is on the market for sale, or that it is not available
+	MarketingStatus []MarketingStatus `json:"marketingStatus,omitempty"`
+
+	// A name for this package
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A packaging item, as a container for medically related items, possibly with other packaging items within
+	Package *PackagedProductDefinitionPackage `json:"package,omitempty"`
+
+	// The product that this is a pack for
+	PackageFor []common.Reference `json:"packageFor,omitempty"`
+
+	// The status within the lifecycle of this item
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// The date at which the given status became applicable
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
+
+	// A high level category e.g. medicinal product, raw material, shipping/transport container, etc
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// PackagedProductDefinitionLegalStatusOfSupply represents the legal status of supply of the packaged item as classified by the regulator
+type PackagedProductDefinitionLegalStatusOfSupply struct {
+	common.BackboneElement
+
+	// The actual status of supply
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The place where the legal status of supply applies
+	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
+}
+
+// PackagedProductDefinitionPackageShelfLifeStorage represents shelf Life and storage information
+type PackagedProductDefinitionPackageShelfLifeStorage struct {
+	common.BackboneElement
+
+	// The shelf life time period can be specified using a numerical value for the period of time and its unit of time measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	PeriodDuration *Duration `json:"periodDuration,omitempty"`
+
+	// The shelf life time period can be specified using a numerical value for the period of time and its unit of time measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	PeriodString        *string         `json:"periodString,omitempty"`
+	PeriodStringElement *common.Element `json:"_periodString,omitempty"`
+
+	// Special precautions for storage, if any, can be specified using an appropriate controlled vocabulary
+	SpecialPrecautionsForStorage []common.CodeableConcept `json:"specialPrecautionsForStorage,omitempty"`
+
+	// This describes the shelf life, taking into account various scenarios such as shelf life of the packaged Medicinal Product itself, shelf life after transformation where necessary and shelf life after the first opening of a bottle, etc. The shelf life type shall be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// PackagedProductDefinitionPackageProperty represents general characteristics of this item
+type PackagedProductDefinitionPackageProperty struct {
+	common.BackboneElement
+
+	// A code expressing the type of characteristic
+	Type common.CodeableConcept `json:"type"`
+
+	// A value for the characteristic
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// A value for the characteristic
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// A value for the characteristic
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// A value for the characteristic
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// A value for the characteristic
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+}
+
+// PackagedProductDefinitionPackageContainedItem represents the item(s) within the packaging
+type PackagedProductDefinitionPackageContainedItem struct {
+	common.BackboneElement
+
+	// The number of this type of item within this packaging
+	Amount *common.Quantity `json:"amount,omitempty"`
+
+	// The actual item(s) of medication, as manufactured, or a device (typically, but not necessarily, a co-packaged one)
+	Item common.CodeableReference `json:"item"`
+}
+
+// PackagedProductDefinitionPackage represents a packaging item, as a container for medically related items, possibly with other packaging items within
+type PackagedProductDefinitionPackage struct {
+	common.BackboneElement
+
+	// A possible alternate material for this part of the packaging, that is allowed to be used instead of the usual material
+	AlternateMaterial []common.CodeableConcept `json:"alternateMaterial,omitempty"`
+
+	// The item(s) within the packaging
+	ContainedItem []PackagedProductDefinitionPackageContainedItem `json:"containedItem,omitempty"`
+
+	// An identifier that is specific to this particular part of the packaging
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Manufacturer of this package Item
+	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
+
+	// Material type of the package item
+	Material []common.CodeableConcept `json:"material,omitempty"`
+
+	// Allows containers (and parts of containers) parwithin containers, still a single packaged product
+	Package []PackagedProductDefinitionPackage `json:"package,omitempty"`
+
+	// General characteristics of this item
+	Property []PackagedProductDefinitionPackageProperty `json:"property,omitempty"`
+
+	// The quantity of this level of packaging in the package that contains it
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
+
+	// Shelf Life and storage information
+	ShelfLifeStorage []PackagedProductDefinitionPackageShelfLifeStorage `json:"shelfLifeStorage,omitempty"`
+
+	// The physical type of the container of the items
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
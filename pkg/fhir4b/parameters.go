@@ -0,0 +1,222 @@
+package fhir4b
+
+import (
+	"encoding/json"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Parameters represents a parameter passed to or received from the operation
+type Parameters struct {
+	Resource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Parameters"
+
+	// A parameter passed to or received from the operation
+	Parameter []ParametersParameter `json:"parameter,omitempty"`
+}
+
+// ParametersParameter represents a parameter passed to or received from the operation
+type ParametersParameter struct {
+	common.BackboneElement
+
+	// The name of the parameter (reference to the operation definition)
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Only one level of nested parameters is allowed
+	Part []ParametersParameter `json:"part,omitempty"`
+
+	// When resolving references in resources, the operation definition may specify how references may be resolved between parameters
+	Resource common.Resource `json:"resource,omitempty"`
+
+	// If the parameter is a data type
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
+
+	// If the parameter is a data type
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// If the parameter is a data type
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
+
+	// If the parameter is a data type
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
+
+	// If the parameter is a data type
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// If the parameter is a data type
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	// If the parameter is a data type
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+
+	// If the parameter is a data type
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
+
+	// If the parameter is a data type
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
+
+	// If the parameter is a data type
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// If the parameter is a data type
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
+
+	// If the parameter is a data type
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
+
+	// If the parameter is a data type
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
+
+	// If the parameter is a data type
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// If the parameter is a data type
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
+
+	// If the parameter is a data type
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
+
+	// If the parameter is a data type
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
+
+	// If the parameter is a data type
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
+
+	// If the parameter is a data type
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
+
+	// If the parameter is a data type
+	ValueAddress *Address `json:"valueAddress,omitempty"`
+
+	// If the parameter is a data type
+	ValueAge *Age `json:"valueAge,omitempty"`
+
+	// If the parameter is a data type
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty"`
+
+	// If the parameter is a data type
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// If the parameter is a data type
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// If the parameter is a data type
+	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
+
+	// If the parameter is a data type
+	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty"`
+
+	// If the parameter is a data type
+	ValueCount *Count `json:"valueCount,omitempty"`
+
+	// If the parameter is a data type
+	ValueDistance *Distance `json:"valueDistance,omitempty"`
+
+	// If the parameter is a data type
+	ValueDuration *Duration `json:"valueDuration,omitempty"`
+
+	// If the parameter is a data type
+	ValueHumanName *HumanName `json:"valueHumanName,omitempty"`
+
+	// If the parameter is a data type
+	ValueIdentifier *common.Identifier `json:"valueIdentifier,omitempty"`
+
+	// If the parameter is a data type
+	ValueMoney *common.Money `json:"valueMoney,omitempty"`
+
+	// If the parameter is a data type
+	ValuePeriod *common.Period `json:"valuePeriod,omitempty"`
+
+	// If the parameter is a data type
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// If the parameter is a data type
+	ValueRange *Range `json:"valueRange,omitempty"`
+
+	// If the parameter is a data type
+	ValueRatio *Ratio `json:"valueRatio,omitempty"`
+
+	// If the parameter is a data type
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+
+	// If the parameter is a data type
+	ValueSampledData *common.SampledData `json:"valueSampledData,omitempty"`
+
+	// If the parameter is a data type
+	ValueSignature *common.Signature `json:"valueSignature,omitempty"`
+
+	// If the parameter is a data type
+	ValueTiming *Timing `json:"valueTiming,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueContributor *Contributor `json:"valueContributor,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueDataRequirement *common.DataRequirement `json:"valueDataRequirement,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueExpression *common.Expression `json:"valueExpression,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueParameterDefinition *common.ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueRelatedArtifact *common.RelatedArtifact `json:"valueRelatedArtifact,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueTriggerDefinition *common.TriggerDefinition `json:"valueTriggerDefinition,omitempty"`
+
+	// Conveys the content if the parameter is a data type
+	ValueUsageContext *common.UsageContext `json:"valueUsageContext,omitempty"`
+
+	// If the parameter is a data type
+	ValueDosage *Dosage `json:"valueDosage,omitempty"`
+
+	// If the parameter is a data type
+	ValueMeta *Meta `json:"valueMeta,omitempty"`
+}
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (p *ParametersParameter) UnmarshalJSON(data []byte) error {
+	type Alias ParametersParameter
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	p.Resource = resource
+	return nil
+}
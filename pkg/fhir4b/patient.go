@@ -0,0 +1,145 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Patient represents information about an individual or animal receiving care
+type Patient struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Patient"
+
+	// Whether this patient record is in active use
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
+
+	// An address for the individual
+	Address []Address `json:"address,omitempty"`
+
+	// The date of birth for the individual
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
+
+	// A language which may be used to communicate with the patient
+	Communication []PatientCommunication `json:"communication,omitempty"`
+
+	// A contact party (e.g. guardian, partner, friend) for the patient
+	Contact []PatientContact `json:"contact,omitempty"`
+
+	// Indicates if the individual is deceased or not
+	DeceasedBoolean        *bool           `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement *common.Element `json:"_deceasedBoolean,omitempty"`
+
+	// Indicates if the individual is deceased or not
+	DeceasedDateTime        *common.DateTime `json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement *common.Element  `json:"_deceasedDateTime,omitempty"`
+
+	// male | female | other | unknown
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
+
+	// Patient's nominated primary care provider
+	GeneralPractitioner []common.Reference `json:"generalPractitioner,omitempty"`
+
+	// An identifier for this patient
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Link to another patient resource that concerns the same actual person
+	Link []PatientLink `json:"link,omitempty"`
+
+	// Organization that is the custodian of the patient record
+	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
+
+	// Marital (civil) status of a patient
+	MaritalStatus *common.CodeableConcept `json:"maritalStatus,omitempty"`
+
+	// Whether patient is part of a multiple birth
+	MultipleBirthBoolean        *bool           `json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *common.Element `json:"_multipleBirthBoolean,omitempty"`
+
+	// Whether patient is part of a multiple birth
+	MultipleBirthInteger        *int            `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *common.Element `json:"_multipleBirthInteger,omitempty"`
+
+	// A name associated with the patient
+	Name []HumanName `json:"name,omitempty"`
+
+	// Image of the patient
+	Photo []Attachment `json:"photo,omitempty"`
+
+	// A contact detail for the individual
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// AdministrativeGender represents administrative gender codes
+type AdministrativeGender string
+
+const (
+	AdministrativeGenderMale    AdministrativeGender = "male"
+	AdministrativeGenderFemale  AdministrativeGender = "female"
+	AdministrativeGenderOther   AdministrativeGender = "other"
+	AdministrativeGenderUnknown AdministrativeGender = "unknown"
+)
+
+// PatientContact represents a contact party for the patient
+type PatientContact struct {
+	common.BackboneElement
+
+	// Address for the contact person
+	Address *Address `json:"address,omitempty"`
+
+	// male | female | other | unknown
+	Gender        *AdministrativeGender `json:"gender,omitempty"`
+	GenderElement *common.Element       `json:"_gender,omitempty"`
+
+	// A name associated with the contact person
+	Name *HumanName `json:"name,omitempty"`
+
+	// Organization that is associated with the contact
+	Organization *common.Reference `json:"organization,omitempty"`
+
+	// The period during which this contact person or organization is valid
+	Period *common.Period `json:"period,omitempty"`
+
+	// The kind of relationship
+	Relationship []common.CodeableConcept `json:"relationship,omitempty"`
+
+	// A contact detail for the person
+	Telecom []ContactPoint `json:"telecom,omitempty"`
+}
+
+// PatientCommunication represents a language which may be used to communicate with the patient
+type PatientCommunication struct {
+	common.BackboneElement
+
+	// The language which can be used to communicate with the patient
+	Language common.CodeableConcept `json:"language"`
+
+	// Language preference indicator
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
+}
+
+// PatientLink represents a link to another patient resource that concerns the same actual person
+type PatientLink struct {
+	common.BackboneElement
+
+	// The other patient resource that the link refers to
+	Other common.Reference `json:"other"`
+
+	// replaced-by | replaces | refer | seealso
+	Type        PatientLinkType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// PatientLinkType represents the type of link between this patient resource and another patient resource
+type PatientLinkType string
+
+const (
+	PatientLinkTypeReplacedBy PatientLinkType = "replaced-by"
+	PatientLinkTypeReplaces   PatientLinkType = "replaces"
+	PatientLinkTypeRefer      PatientLinkType = "refer"
+	PatientLinkTypeSeeAlso    PatientLinkType = "seealso"
+)
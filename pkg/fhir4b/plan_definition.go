@@ -0,0 +1,456 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// PlanDefinition represents the definition of various types of plans as a sharable, consumable, and executable artifact
+type PlanDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "PlanDefinition"
+
+	// Note that there is overlap between many of the elements defined here and the ActivityDefinition resource
+	Action []PlanDefinitionAction `json:"action,omitempty"`
+
+	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// An individiual or organization primarily involved in the creation and maintenance of the content
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A copyright statement relating to the plan definition and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the plan definition was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An individual or organization primarily responsible for internal coherence of the content
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// The effective period for a plan definition determines when the content is applicable for usage
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// An individual or organization responsible for officially endorsing the content for use in some setting
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// Allows filtering of plan definitions that are appropriate for use versus not
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Goals that describe what the activities within the plan are intended to achieve
+	Goal []PlanDefinitionGoal `json:"goal,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// It may be possible for the plan definition to be used in jurisdictions other than those for which it was originally designed
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// If specified, this date follows the original approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// A reference to a Library resource containing any formal logic used by the plan definition
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
+
+	// A natural language name identifying the plan definition
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the plan definition
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Related artifacts such as additional documentation, justification, or bibliographic references
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// An individual or organization primarily responsible for review of some aspect of the content
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// Allows filtering of plan definitions that are appropriate for use versus not
+	Status        PlanDefinitionStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Note that the choice of canonical for the subject element was introduced in R4B to support pharmaceutical quality use cases
+	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
+
+	// Note that the choice of canonical for the subject element was introduced in R4B to support pharmaceutical quality use cases
+	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
+
+	// Note that the choice of canonical for the subject element was introduced in R4B to support pharmaceutical quality use cases
+	SubjectCanonical        *string         `json:"subjectCanonical,omitempty"`
+	SubjectCanonicalElement *common.Element `json:"_subjectCanonical,omitempty"`
+
+	// An explanatory or alternate title for the plan definition giving additional information about its content
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
+
+	// A short, descriptive, user-friendly title for the plan definition
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Descriptive topics related to the content of the plan definition
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// A high-level category for the plan definition that distinguishes the kinds of systems that would be interested in the plan definition
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// A detailed description of how the plan definition is used from a clinical perspective
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different plan definition instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// PlanDefinitionStatus represents allows filtering of plan definitions that are appropriate for use versus not
+type PlanDefinitionStatus string
+
+const (
+	PlanDefinitionStatusDraft   PlanDefinitionStatus = "draft"
+	PlanDefinitionStatusActive  PlanDefinitionStatus = "active"
+	PlanDefinitionStatusRetired PlanDefinitionStatus = "retired"
+	PlanDefinitionStatusUnknown PlanDefinitionStatus = "unknown"
+)
+
+// PlanDefinitionGoalTarget represents indicates what should be done and within what timeframe
+type PlanDefinitionGoalTarget struct {
+	common.BackboneElement
+
+	// The target value of the measure to be achieved to signify fulfillment of the goal
+	DetailQuantity *common.Quantity `json:"detailQuantity,omitempty"`
+
+	// The target value of the measure to be achieved to signify fulfillment of the goal
+	DetailRange *Range `json:"detailRange,omitempty"`
+
+	// The target value of the measure to be achieved to signify fulfillment of the goal
+	DetailCodeableConcept *common.CodeableConcept `json:"detailCodeableConcept,omitempty"`
+
+	// Indicates the timeframe after the start of the goal in which the goal should be met
+	Due *Duration `json:"due,omitempty"`
+
+	// The parameter whose value is to be tracked, e.g. body weight, blood pressure, or hemoglobin A1c level
+	Measure *common.CodeableConcept `json:"measure,omitempty"`
+}
+
+// PlanDefinitionGoal represents goals that describe what the activities within the plan are intended to achieve
+type PlanDefinitionGoal struct {
+	common.BackboneElement
+
+	// Identifies problems, conditions, issues, or concerns the goal is intended to address
+	Addresses []common.CodeableConcept `json:"addresses,omitempty"`
+
+	// Indicates a category the goal falls within
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// If no code is available, use CodeableConcept.text
+	Description common.CodeableConcept `json:"description"`
+
+	// Didactic or other informational resources associated with the goal that provide further supporting information about the goal
+	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
+
+	// Identifies the expected level of importance associated with reaching/sustaining the defined goal
+	Priority *common.CodeableConcept `json:"priority,omitempty"`
+
+	// The event after which the goal should begin being pursued
+	Start *common.CodeableConcept `json:"start,omitempty"`
+
+	// Indicates what should be done and within what timeframe
+	Target []PlanDefinitionGoalTarget `json:"target,omitempty"`
+}
+
+// PlanDefinitionActionCondition represents when multiple conditions of the same kind are present, the effects are combined using AND semantics
+type PlanDefinitionActionCondition struct {
+	common.BackboneElement
+
+	// The expression may be inlined or may be a reference to a named expression within a logic library referenced by the library element
+	Expression *common.Expression `json:"expression,omitempty"`
+
+	// Applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
+	Kind        PlanDefinitionActionConditionKind `json:"kind"`
+	KindElement *common.Element                   `json:"_kind,omitempty"`
+}
+
+// PlanDefinitionActionConditionKind represents applicability criteria are used to determine immediate applicability when a plan definition is applied to a given context
+type PlanDefinitionActionConditionKind string
+
+const (
+	PlanDefinitionActionConditionKindApplicability PlanDefinitionActionConditionKind = "applicability"
+	PlanDefinitionActionConditionKindStart         PlanDefinitionActionConditionKind = "start"
+	PlanDefinitionActionConditionKindStop          PlanDefinitionActionConditionKind = "stop"
+)
+
+// PlanDefinitionActionRelatedAction represents when an action depends on multiple actions, the meaning is that all actions are dependencies
+type PlanDefinitionActionRelatedAction struct {
+	common.BackboneElement
+
+	// The element id of the related action
+	ActionId        string          `json:"actionId"`
+	ActionIdElement *common.Element `json:"_actionId,omitempty"`
+
+	// A duration or range of durations to apply to the relationship
+	OffsetDuration *Duration `json:"offsetDuration,omitempty"`
+
+	// A duration or range of durations to apply to the relationship
+	OffsetRange *Range `json:"offsetRange,omitempty"`
+
+	// The relationship of this action to the related action
+	Relationship        PlanDefinitionActionRelatedActionRelationship `json:"relationship"`
+	RelationshipElement *common.Element                               `json:"_relationship,omitempty"`
+}
+
+// PlanDefinitionActionRelatedActionRelationship represents the relationship of this action to the related action
+type PlanDefinitionActionRelatedActionRelationship string
+
+const (
+	PlanDefinitionActionRelatedActionRelationshipBeforeStart         PlanDefinitionActionRelatedActionRelationship = "before-start"
+	PlanDefinitionActionRelatedActionRelationshipBefore              PlanDefinitionActionRelatedActionRelationship = "before"
+	PlanDefinitionActionRelatedActionRelationshipBeforeEnd           PlanDefinitionActionRelatedActionRelationship = "before-end"
+	PlanDefinitionActionRelatedActionRelationshipConcurrentWithStart PlanDefinitionActionRelatedActionRelationship = "concurrent-with-start"
+	PlanDefinitionActionRelatedActionRelationshipConcurrent          PlanDefinitionActionRelatedActionRelationship = "concurrent"
+	PlanDefinitionActionRelatedActionRelationshipConcurrentWithEnd   PlanDefinitionActionRelatedActionRelationship = "concurrent-with-end"
+	PlanDefinitionActionRelatedActionRelationshipAfterStart          PlanDefinitionActionRelatedActionRelationship = "after-start"
+	PlanDefinitionActionRelatedActionRelationshipAfter               PlanDefinitionActionRelatedActionRelationship = "after"
+	PlanDefinitionActionRelatedActionRelationshipAfterEnd            PlanDefinitionActionRelatedActionRelationship = "after-end"
+)
+
+// PlanDefinitionActionParticipant represents indicates who should participate in performing the action described
+type PlanDefinitionActionParticipant struct {
+	common.BackboneElement
+
+	// The role the participant should play in performing the described action
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// The type of participant in the action
+	Type        PlanDefinitionActionParticipantType `json:"type"`
+	TypeElement *common.Element                     `json:"_type,omitempty"`
+}
+
+// PlanDefinitionActionParticipantType represents the type of participant in the action
+type PlanDefinitionActionParticipantType string
+
+const (
+	PlanDefinitionActionParticipantTypePatient       PlanDefinitionActionParticipantType = "patient"
+	PlanDefinitionActionParticipantTypePractitioner  PlanDefinitionActionParticipantType = "practitioner"
+	PlanDefinitionActionParticipantTypeRelatedPerson PlanDefinitionActionParticipantType = "related-person"
+	PlanDefinitionActionParticipantTypeDevice        PlanDefinitionActionParticipantType = "device"
+)
+
+// PlanDefinitionActionDynamicValue represents dynamic values are applied in the order in which they are defined in the PlanDefinition resource
+type PlanDefinitionActionDynamicValue struct {
+	common.BackboneElement
+
+	// The expression may be inlined or may be a reference to a named expression within a logic library referenced by the library element
+	Expression *common.Expression `json:"expression,omitempty"`
+
+	// To specify the path to the current action being realized, the %action environment variable is available in this path
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
+}
+
+// PlanDefinitionAction represents note that there is overlap between many of the elements defined here and the ActivityDefinition resource
+type PlanDefinitionAction struct {
+	common.BackboneElement
+
+	// Sub actions that are contained within the action
+	Action []PlanDefinitionAction `json:"action,omitempty"`
+
+	// Defines whether the action can be selected multiple times
+	CardinalityBehavior        *PlanDefinitionActionCardinalityBehavior `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                          `json:"_cardinalityBehavior,omitempty"`
+
+	// A code that provides meaning for the action or action group
+	Code []common.CodeableConcept `json:"code,omitempty"`
+
+	// When multiple conditions of the same kind are present, the effects are combined using AND semantics
+	Condition []PlanDefinitionActionCondition `json:"condition,omitempty"`
+
+	// Note that the definition is optional, and if no definition is specified, a dynamicValue with a root ($this) path can be used to define the entire resource dynamically
+	DefinitionCanonical        *string         `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element `json:"_definitionCanonical,omitempty"`
+
+	// Note that the definition is optional, and if no definition is specified, a dynamicValue with a root ($this) path can be used to define the entire resource dynamically
+	DefinitionUri        *string         `json:"definitionUri,omitempty"`
+	DefinitionUriElement *common.Element `json:"_definitionUri,omitempty"`
+
+	// A brief description of the action used to provide a summary to display to the user
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Didactic or other informational resources associated with the action that can be provided to the CDS recipient
+	Documentation []common.RelatedArtifact `json:"documentation,omitempty"`
+
+	// Dynamic values are applied in the order in which they are defined in the PlanDefinition resource
+	DynamicValue []PlanDefinitionActionDynamicValue `json:"dynamicValue,omitempty"`
+
+	// Identifies goals that this action supports
+	GoalId        []string          `json:"goalId,omitempty"`
+	GoalIdElement []*common.Element `json:"_goalId,omitempty"`
+
+	// Defines the grouping behavior for the action and its children
+	GroupingBehavior        *PlanDefinitionActionGroupingBehavior `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement *common.Element                       `json:"_groupingBehavior,omitempty"`
+
+	// Defines input data requirements for the action
+	Input []common.DataRequirement `json:"input,omitempty"`
+
+	// Defines the outputs of the action, if any
+	Output []common.DataRequirement `json:"output,omitempty"`
+
+	// Indicates who should participate in performing the action described
+	Participant []PlanDefinitionActionParticipant `json:"participant,omitempty"`
+
+	// Defines whether the action should usually be preselected
+	PrecheckBehavior        *PlanDefinitionActionPrecheckBehavior `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement *common.Element                       `json:"_precheckBehavior,omitempty"`
+
+	// A user-visible prefix for the action
+	Prefix        *string         `json:"prefix,omitempty"`
+	PrefixElement *common.Element `json:"_prefix,omitempty"`
+
+	// Indicates how quickly the action should be addressed with respect to other actions
+	Priority        *PlanDefinitionActionPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element               `json:"_priority,omitempty"`
+
+	// This is different than the clinical evidence documentation, it's an actual business description of the reason for performing the action
+	Reason []common.CodeableConcept `json:"reason,omitempty"`
+
+	// When an action depends on multiple actions, the meaning is that all actions are dependencies
+	RelatedAction []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty"`
+
+	// Defines the required behavior for the action
+	RequiredBehavior        *PlanDefinitionActionRequiredBehavior `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement *common.Element                       `json:"_requiredBehavior,omitempty"`
+
+	// Defines the selection behavior for the action and its children
+	SelectionBehavior        *PlanDefinitionActionSelectionBehavior `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement *common.Element                        `json:"_selectionBehavior,omitempty"`
+
+	// The subject of an action overrides the subject at a parent action or on the root of the PlanDefinition if specified
+	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
+
+	// The subject of an action overrides the subject at a parent action or on the root of the PlanDefinition if specified
+	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
+
+	// The subject of an action overrides the subject at a parent action or on the root of the PlanDefinition if specified
+	SubjectCanonical        *string         `json:"subjectCanonical,omitempty"`
+	SubjectCanonicalElement *common.Element `json:"_subjectCanonical,omitempty"`
+
+	// A text equivalent of the action to be performed
+	TextEquivalent        *string         `json:"textEquivalent,omitempty"`
+	TextEquivalentElement *common.Element `json:"_textEquivalent,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingAge *Age `json:"timingAge,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingPeriod *common.Period `json:"timingPeriod,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingDuration *Duration `json:"timingDuration,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingRange *Range `json:"timingRange,omitempty"`
+
+	// An optional value describing when the action should be performed
+	TimingTiming *Timing `json:"timingTiming,omitempty"`
+
+	// The title of the action displayed to a user
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Note that when a referenced ActivityDefinition also defines a transform, the transform specified here generally takes precedence
+	Transform        *string         `json:"transform,omitempty"`
+	TransformElement *common.Element `json:"_transform,omitempty"`
+
+	// A description of when the action should be triggered
+	Trigger []common.TriggerDefinition `json:"trigger,omitempty"`
+
+	// The type of action to perform (create, update, remove)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// PlanDefinitionActionCardinalityBehavior represents defines whether the action can be selected multiple times
+type PlanDefinitionActionCardinalityBehavior string
+
+const (
+	PlanDefinitionActionCardinalityBehaviorSingle   PlanDefinitionActionCardinalityBehavior = "single"
+	PlanDefinitionActionCardinalityBehaviorMultiple PlanDefinitionActionCardinalityBehavior = "multiple"
+)
+
+// PlanDefinitionActionGroupingBehavior represents defines the grouping behavior for the action and its children
+type PlanDefinitionActionGroupingBehavior string
+
+const (
+	PlanDefinitionActionGroupingBehaviorVisualGroup   PlanDefinitionActionGroupingBehavior = "visual-group"
+	PlanDefinitionActionGroupingBehaviorLogicalGroup  PlanDefinitionActionGroupingBehavior = "logical-group"
+	PlanDefinitionActionGroupingBehaviorSentenceGroup PlanDefinitionActionGroupingBehavior = "sentence-group"
+)
+
+// PlanDefinitionActionPrecheckBehavior represents defines whether the action should usually be preselected
+type PlanDefinitionActionPrecheckBehavior string
+
+const (
+	PlanDefinitionActionPrecheckBehaviorYes PlanDefinitionActionPrecheckBehavior = "yes"
+	PlanDefinitionActionPrecheckBehaviorNo  PlanDefinitionActionPrecheckBehavior = "no"
+)
+
+// PlanDefinitionActionPriority represents indicates how quickly the action should be addressed with respect to other actions
+type PlanDefinitionActionPriority string
+
+const (
+	PlanDefinitionActionPriorityRoutine PlanDefinitionActionPriority = "routine"
+	PlanDefinitionActionPriorityUrgent  PlanDefinitionActionPriority = "urgent"
+	PlanDefinitionActionPriorityAsap    PlanDefinitionActionPriority = "asap"
+	PlanDefinitionActionPriorityStat    PlanDefinitionActionPriority = "stat"
+)
+
+// PlanDefinitionActionRequiredBehavior represents defines the required behavior for the action
+type PlanDefinitionActionRequiredBehavior string
+
+const (
+	PlanDefinitionActionRequiredBehaviorMust                 PlanDefinitionActionRequiredBehavior = "must"
+	PlanDefinitionActionRequiredBehaviorCould                PlanDefinitionActionRequiredBehavior = "could"
+	PlanDefinitionActionRequiredBehaviorMustUnlessDocumented PlanDefinitionActionRequiredBehavior = "must-unless-documented"
+)
+
+// PlanDefinitionActionSelectionBehavior represents defines the selection behavior for the action and its children
+type PlanDefinitionActionSelectionBehavior string
+
+const (
+	PlanDefinitionActionSelectionBehaviorAny        PlanDefinitionActionSelectionBehavior = "any"
+	PlanDefinitionActionSelectionBehaviorAll        PlanDefinitionActionSelectionBehavior = "all"
+	PlanDefinitionActionSelectionBehaviorAllOrNone  PlanDefinitionActionSelectionBehavior = "all-or-none"
+	PlanDefinitionActionSelectionBehaviorExactlyOne PlanDefinitionActionSelectionBehavior = "exactly-one"
+	PlanDefinitionActionSelectionBehaviorAtMostOne  PlanDefinitionActionSelectionBehavior = "at-most-one"
+	PlanDefinitionActionSelectionBehaviorOneOrMore  PlanDefinitionActionSelectionBehavior = "one-or-more"
+)
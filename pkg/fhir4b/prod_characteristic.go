@@ -0,0 +1,46 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProdCharacteristic represents product characteristics
+type ProdCharacteristic struct {
+	common.BackboneElement
+
+	// Where applicable, the color can be specified An appropriate controlled vocabulary shall be used The term and the term identifier shall be used
+	Color        []string          `json:"color,omitempty"`
+	ColorElement []*common.Element `json:"_color,omitempty"`
+
+	// Where applicable, the depth can be specified using a numerical value and its unit of measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	Depth *common.Quantity `json:"depth,omitempty"`
+
+	// Where applicable, the external diameter can be specified using a numerical value and its unit of measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	ExternalDiameter *common.Quantity `json:"externalDiameter,omitempty"`
+
+	// Where applicable, the height can be specified using a numerical value and its unit of measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	Height *common.Quantity `json:"height,omitempty"`
+
+	// Where applicable, the image can be provided The format of the image attachment shall be specified by regional implementations
+	Image []Attachment `json:"image,omitempty"`
+
+	// Where applicable, the imprint can be specified as text
+	Imprint        []string          `json:"imprint,omitempty"`
+	ImprintElement []*common.Element `json:"_imprint,omitempty"`
+
+	// Where applicable, the nominal volume can be specified using a numerical value and its unit of measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	NominalVolume *common.Quantity `json:"nominalVolume,omitempty"`
+
+	// Where applicable, the scoring can be specified An appropriate controlled vocabulary shall be used The term and the term identifier shall be used
+	Scoring *common.CodeableConcept `json:"scoring,omitempty"`
+
+	// Where applicable, the shape can be specified An appropriate controlled vocabulary shall be used The term and the term identifier shall be used
+	Shape        *string         `json:"shape,omitempty"`
+	ShapeElement *common.Element `json:"_shape,omitempty"`
+
+	// Where applicable, the weight can be specified using a numerical value and its unit of measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	Weight *common.Quantity `json:"weight,omitempty"`
+
+	// Where applicable, the width can be specified using a numerical value and its unit of measurement The unit of measurement shall be specified in accordance with ISO 11240 and the resulting terminology The symbol and the symbol identifier shall be used
+	Width *common.Quantity `json:"width,omitempty"`
+}
@@ -0,0 +1,22 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ProductShelfLife represents shelf life and storage information
+type ProductShelfLife struct {
+	common.BackboneElement
+
+	// Unique identifier for the packaged Medicinal Product
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// This describes the shelf life, taking into account various scenarios such as shelf life of the packaged Medicinal Product itself, shelf life after transformation where necessary and shelf life after the first opening of a bottle, etc
+	Period common.Quantity `json:"period"`
+
+	// Special precautions for storage, if any, can be specified using an appropriate controlled vocabulary The controlled term and the controlled term identifier shall be specified
+	SpecialPrecautionsForStorage []common.CodeableConcept `json:"specialPrecautionsForStorage,omitempty"`
+
+	// Shelf life time period can be specified using a controlled vocabulary The controlled term and the controlled term identifier shall be specified
+	Type common.CodeableConcept `json:"type"`
+}
@@ -0,0 +1,135 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// QuestionnaireResponse represents a structured set of questions and their answers
+type QuestionnaireResponse struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "QuestionnaireResponse"
+
+	// Person who received and recorded the answers
+	Author *common.Reference `json:"author,omitempty"`
+
+	// Date the answers were gathered
+	Authored        *common.DateTime `json:"authored,omitempty"`
+	AuthoredElement *common.Element  `json:"_authored,omitempty"`
+
+	// Plan/proposal/order fulfilled by this QuestionnaireResponse
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Encounter created as part of
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Business identifier assigned to a particular completed questionnaire
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Groups and questions
+	Item []QuestionnaireResponseItem `json:"item,omitempty"`
+
+	// Composition this QuestionnaireResponse is part of
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// Form being answered
+	Questionnaire        *string         `json:"questionnaire,omitempty"`
+	QuestionnaireElement *common.Element `json:"_questionnaire,omitempty"`
+
+	// The person who answered the questions
+	Source *common.Reference `json:"source,omitempty"`
+
+	// in-progress | completed | amended | entered-in-error | stopped
+	Status        QuestionnaireResponseStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
+
+	// The subject of the questionnaire response
+	Subject *common.Reference `json:"subject,omitempty"`
+}
+
+// QuestionnaireResponseStatus represents the status of a questionnaire response
+type QuestionnaireResponseStatus string
+
+const (
+	QuestionnaireResponseStatusInProgress     QuestionnaireResponseStatus = "in-progress"
+	QuestionnaireResponseStatusCompleted      QuestionnaireResponseStatus = "completed"
+	QuestionnaireResponseStatusAmended        QuestionnaireResponseStatus = "amended"
+	QuestionnaireResponseStatusEnteredInError QuestionnaireResponseStatus = "entered-in-error"
+	QuestionnaireResponseStatusStopped        QuestionnaireResponseStatus = "stopped"
+)
+
+// QuestionnaireResponseItemAnswer represents the response(s) to the question
+type QuestionnaireResponseItemAnswer struct {
+	common.BackboneElement
+
+	// Nested items inside this answer
+	Item []QuestionnaireResponseItem `json:"item,omitempty"`
+
+	// Single-valued answer to the question
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Single-valued answer to the question
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+
+	// Single-valued answer to the question
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// Single-valued answer to the question
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// Single-valued answer to the question
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	// Single-valued answer to the question
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
+
+	// Single-valued answer to the question
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// Single-valued answer to the question
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
+
+	// Single-valued answer to the question
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// Single-valued answer to the question
+	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
+
+	// Single-valued answer to the question
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Single-valued answer to the question
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+}
+
+// QuestionnaireResponseItem represents groups and questions
+type QuestionnaireResponseItem struct {
+	common.BackboneElement
+
+	// The response(s) to the question
+	Answer []QuestionnaireResponseItemAnswer `json:"answer,omitempty"`
+
+	// ElementDefinition - details for the item
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+
+	// Nested questionnaire response items
+	Item []QuestionnaireResponseItem `json:"item,omitempty"`
+
+	// Pointer to specific item from Questionnaire
+	LinkId        string          `json:"linkId"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
+
+	// Name for group or question text
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
+}
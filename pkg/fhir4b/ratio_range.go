@@ -0,0 +1,19 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RatioRange represents range of ratio values
+type RatioRange struct {
+	common.Element
+
+	// The value of the denominator
+	Denominator *common.Quantity `json:"denominator,omitempty"`
+
+	// If the high element is missing, the high boundary is not known
+	HighNumerator *common.Quantity `json:"highNumerator,omitempty"`
+
+	// If the low element is missing, the low boundary is not known
+	LowNumerator *common.Quantity `json:"lowNumerator,omitempty"`
+}
@@ -0,0 +1,81 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RegulatedAuthorization represents regulatory approval, clearance or licencing related to a regulated product, treatment
+type RegulatedAuthorization struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "RegulatedAuthorization"
+
+	// The legal or regulatory framework against which this authorization is granted, or other reasons for it
+	Basis []common.CodeableConcept `json:"basis,omitempty"`
+
+	// The case or regulatory procedure for granting or amending a regulated authorization
+	Case *RegulatedAuthorizationCase `json:"case,omitempty"`
+
+	// General textual supporting information
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The organization that has been granted this authorization, by some authoritative body (the 'regulator')
+	Holder *common.Reference `json:"holder,omitempty"`
+
+	// Business identifier for the authorization, typically assigned by the authorizing body
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Condition for which the use of the regulated product applies
+	Indication *common.CodeableReference `json:"indication,omitempty"`
+
+	// The intended use of the product, e.g. prevention, treatment, diagnosis
+	IntendedUse *common.CodeableConcept `json:"intendedUse,omitempty"`
+
+	// The territory (e.g., country, jurisdiction etc.) in which the authorization has been granted
+	Region []common.CodeableConcept `json:"region,omitempty"`
+
+	// The regulatory authority or authorizing body granting the authorization
+	Regulator *common.Reference `json:"regulator,omitempty"`
+
+	// The status that is authorised e.g. approved
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// The date at which the current status was assigned
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
+
+	// The product type, treatment, facility or activity that is being authorized
+	Subject []common.Reference `json:"subject,omitempty"`
+
+	// Overall type of this authorization, for example drug marketing approval, orphan drug designation
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// The time period in which the regulatory approval, clearance or licencing is in effect
+	ValidityPeriod *common.Period `json:"validityPeriod,omitempty"`
+}
+
+// RegulatedAuthorizationCase represents the case or regulatory procedure for granting or amending a regulated authorization
+type RegulatedAuthorizationCase struct {
+	common.BackboneElement
+
+	// A regulatory submission from an organization to a regulator, as part of an assessing case
+	Application []RegulatedAuthorizationCase `json:"application,omitempty"`
+
+	// Relevant date for this case
+	DatePeriod *common.Period `json:"datePeriod,omitempty"`
+
+	// Relevant date for this case
+	DateDateTime        *common.DateTime `json:"dateDateTime,omitempty"`
+	DateDateTimeElement *common.Element  `json:"_dateDateTime,omitempty"`
+
+	// Identifier by which this case can be referenced
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The status associated with the case
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// The defining type of case
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
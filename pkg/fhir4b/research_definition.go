@@ -0,0 +1,146 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ResearchDefinition represents the ResearchDefinition resource describes the conditional state and outcome that the knowledge is about
+type ResearchDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ResearchDefinition"
+
+	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// An individiual or organization primarily involved in the creation and maintenance of the content
+	Author []ContactDetail `json:"author,omitempty"`
+
+	// A human-readable string to clarify or explain concepts about the resource
+	Comment        []string          `json:"comment,omitempty"`
+	CommentElement []*common.Element `json:"_comment,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A copyright statement relating to the research definition and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the research definition was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// An individual or organization primarily responsible for internal coherence of the content
+	Editor []ContactDetail `json:"editor,omitempty"`
+
+	// The effective period for a research definition determines when the content is applicable for usage
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// An individual or organization responsible for officially endorsing the content for use in some setting
+	Endorser []ContactDetail `json:"endorser,omitempty"`
+
+	// Allows filtering of research definitions that are appropriate for use versus not
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// A reference to a ResearchElementDefinition resource that defines the exposure for the research
+	Exposure *common.Reference `json:"exposure,omitempty"`
+
+	// A reference to a ResearchElementDefinition resource that defines the exposureAlternative for the research
+	ExposureAlternative *common.Reference `json:"exposureAlternative,omitempty"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// It may be possible for the research definition to be used in jurisdictions other than those for which it was originally designed
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// If specified, this date follows the original approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// A reference to a Library resource containing the formal logic used by the ResearchDefinition
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// A reference to a ResearchElementDefinition resomece that defines the outcome for the research
+	Outcome *common.Reference `json:"outcome,omitempty"`
+
+	// A reference to a ResearchElementDefinition resource that defines the population for the research
+	Population common.Reference `json:"population"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the research definition
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Each related artifact is either an attachment, or a reference to another resource, but not both
+	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
+
+	// An individual or organization primarily responsible for review of some aspect of the content
+	Reviewer []ContactDetail `json:"reviewer,omitempty"`
+
+	// The short title provides an alternate title for use in informal descriptive contexts
+	ShortTitle        *string         `json:"shortTitle,omitempty"`
+	ShortTitleElement *common.Element `json:"_shortTitle,omitempty"`
+
+	// Allows filtering of research definitions that are appropriate for use versus not
+	Status        ResearchDefinitionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	// The subject of the ResearchDefinition is critical in interpreting the criteria definitions
+	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
+
+	// The subject of the ResearchDefinition is critical in interpreting the criteria definitions
+	SubjectReference *common.Reference `json:"subjectReference,omitempty"`
+
+	// An explanatory or alternate title for the ResearchDefinition giving additional information about its content
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Descriptive topics related to the content of the ResearchDefinition
+	Topic []common.CodeableConcept `json:"topic,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// A detailed description, from a clinical perspective, of how the ResearchDefinition is used
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different research definition instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ResearchDefinitionStatus represents allows filtering of research definitions that are appropriate for use versus not
+type ResearchDefinitionStatus string
+
+const (
+	ResearchDefinitionStatusDraft   ResearchDefinitionStatus = "draft"
+	ResearchDefinitionStatusActive  ResearchDefinitionStatus = "active"
+	ResearchDefinitionStatusRetired ResearchDefinitionStatus = "retired"
+	ResearchDefinitionStatusUnknown ResearchDefinitionStatus = "unknown"
+)
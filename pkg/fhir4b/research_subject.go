@@ -0,0 +1,59 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ResearchSubject represents a physical entity which is the primary unit of operational and/or administrative interest in a study
+type ResearchSubject struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ResearchSubject"
+
+	// What path was followed
+	ActualArm        *string         `json:"actualArm,omitempty"`
+	ActualArmElement *common.Element `json:"_actualArm,omitempty"`
+
+	// What path should be followed
+	AssignedArm        *string         `json:"assignedArm,omitempty"`
+	AssignedArmElement *common.Element `json:"_assignedArm,omitempty"`
+
+	// Agreement to participate in study
+	Consent *common.Reference `json:"consent,omitempty"`
+
+	// Business identifier for research subject in a study
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Who or what is part of study
+	Individual common.Reference `json:"individual"`
+
+	// Dates the subject began and ended their participation in the study
+	Period *common.Period `json:"period,omitempty"`
+
+	// candidate | eligible | follow-up | ineligible | not-registered | off-study | on-study | on-study-intervention | on-study-observation | pending-on-study | potential-candidate | screening | withdrawn
+	Status        ResearchSubjectStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
+
+	// Study subject is part of
+	Study common.Reference `json:"study"`
+}
+
+// ResearchSubjectStatus represents the lifecycle status of the research subject
+type ResearchSubjectStatus string
+
+const (
+	ResearchSubjectStatusCandidate           ResearchSubjectStatus = "candidate"
+	ResearchSubjectStatusEligible            ResearchSubjectStatus = "eligible"
+	ResearchSubjectStatusFollowUp            ResearchSubjectStatus = "follow-up"
+	ResearchSubjectStatusIneligible          ResearchSubjectStatus = "ineligible"
+	ResearchSubjectStatusNotRegistered       ResearchSubjectStatus = "not-registered"
+	ResearchSubjectStatusOffStudy            ResearchSubjectStatus = "off-study"
+	ResearchSubjectStatusOnStudy             ResearchSubjectStatus = "on-study"
+	ResearchSubjectStatusOnStudyIntervention ResearchSubjectStatus = "on-study-intervention"
+	ResearchSubjectStatusOnStudyObservation  ResearchSubjectStatus = "on-study-observation"
+	ResearchSubjectStatusPendingOnStudy      ResearchSubjectStatus = "pending-on-study"
+	ResearchSubjectStatusPotentialCandidate  ResearchSubjectStatus = "potential-candidate"
+	ResearchSubjectStatusScreening           ResearchSubjectStatus = "screening"
+	ResearchSubjectStatusWithdrawn           ResearchSubjectStatus = "withdrawn"
+)
@@ -38,7 +38,8 @@ type EncounterStatusHistory struct {
 	Period common.Period `json:"period"`
 
 	// planned | arrived | triaged | in-progress | onleave | finished | cancelled | entered-in-error | unknown
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // EncounterClassHistory represents the class history permitting tracking of encounter transitions
@@ -74,7 +75,8 @@ type EncounterDiagnosis struct {
 	Condition common.Reference `json:"condition"`
 
 	// Ranking of the diagnosis (for each role type)
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// Role that this diagnosis has within the encounter (e.g. admission, billing, discharge)
 	Use *common.CodeableConcept `json:"use,omitempty"`
@@ -126,7 +128,8 @@ type EncounterLocation struct {
 	PhysicalType *common.CodeableConcept `json:"physicalType,omitempty"`
 
 	// When the patient is no longer active at a location
-	Status *EncounterLocationStatus `json:"status,omitempty"`
+	Status        *EncounterLocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
 
 // Encounter represents an interaction between a patient and healthcare provider(s)
@@ -194,7 +197,8 @@ type Encounter struct {
 	ServiceType *common.CodeableConcept `json:"serviceType,omitempty"`
 
 	// Note that internal business rules will determine the appropriate transitions
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The current status is always found in the current version of the resource
 	StatusHistory []EncounterStatusHistory `json:"statusHistory,omitempty"`
@@ -231,19 +235,22 @@ type Practitioner struct {
 	ResourceType string `json:"resourceType"` // Always "Practitioner"
 
 	// Whether this practitioner's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses where the practitioner can be found or visited or to which mail can be delivered
 	Address []Address `json:"address,omitempty"`
 
 	// The date of birth for the practitioner
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// A language the practitioner can use in patient communication
 	Communication []common.CodeableConcept `json:"communication,omitempty"`
 
 	// Administrative Gender - the gender that the person is considered to have for administration and record keeping purposes
-	Gender *PractitionerGender `json:"gender,omitempty"`
+	Gender        *PractitionerGender `json:"gender,omitempty"`
+	GenderElement *common.Element     `json:"_gender,omitempty"`
 
 	// An identifier that applies to this person in this role
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -277,16 +284,19 @@ type Resource struct {
 	ResourceType string `json:"resourceType"`
 
 	// Logical id of this artifact
-	ID *string `json:"id,omitempty"`
+	ID        *string         `json:"id,omitempty"`
+	IDElement *common.Element `json:"_id,omitempty"`
 
 	// Metadata about the resource
 	Meta *Meta `json:"meta,omitempty"`
 
 	// A set of rules under which this content was created
-	ImplicitRules *string `json:"implicitRules,omitempty"`
+	ImplicitRules        *string         `json:"implicitRules,omitempty"`
+	ImplicitRulesElement *common.Element `json:"_implicitRules,omitempty"`
 
 	// Language of the resource content
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 }
 
 // DomainResource is a resource that includes narrative, extensions, and contained resources (R4B version)
@@ -294,7 +304,7 @@ type DomainResource struct {
 	Resource
 
 	// Contained, inline Resources
-	Contained []interface{} `json:"contained,omitempty"`
+	Contained ResourceList `json:"contained,omitempty"`
 
 	// Additional content defined by implementations
 	Extension []common.Extension `json:"extension,omitempty"`
@@ -311,16 +321,20 @@ type Meta struct {
 	common.Element
 
 	// Version specific identifier
-	VersionID *string `json:"versionId,omitempty"`
+	VersionID        *string         `json:"versionId,omitempty"`
+	VersionIDElement *common.Element `json:"_versionId,omitempty"`
 
 	// When the resource version last changed
-	LastUpdated *string `json:"lastUpdated,omitempty"`
+	LastUpdated        *common.Instant `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *common.Element `json:"_lastUpdated,omitempty"`
 
 	// Identifies where the resource comes from
-	Source *string `json:"source,omitempty"`
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Security Labels applied to this resource
 	Security []common.Coding `json:"security,omitempty"`
@@ -334,7 +348,8 @@ type Narrative struct {
 	common.Element
 
 	// generated | extensions | additional | empty
-	Status NarrativeStatus `json:"status"`
+	Status        NarrativeStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Limited xhtml content
 	Div string `json:"div"`
@@ -356,6 +371,12 @@ func BoolPtr(b bool) *bool          { return &b }
 func IntPtr(i int) *int             { return &i }
 func Float64Ptr(f float64) *float64 { return &f }
 
+// DecimalPtr parses s as a FHIR decimal and returns a pointer to it. It panics if s is not a valid decimal.
+func DecimalPtr(s string) *common.Decimal {
+	d := common.MustParseDecimal(s)
+	return &d
+}
+
 // OrganizationContact represents contact information for an organization
 type OrganizationContact struct {
 	common.BackboneElement
@@ -381,13 +402,15 @@ type Organization struct {
 	ResourceType string `json:"resourceType"` // Always "Organization"
 
 	// Whether the organization record is still in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Organization may have multiple addresses with different uses or applicable periods
 	Address []Address `json:"address,omitempty"`
 
 	// A list of alternate names that the organization is known as, or was known as in the past
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Where multiple contacts for the same purpose are provided there is a standard extension that can be used
 	Contact []OrganizationContact `json:"contact,omitempty"`
@@ -399,7 +422,8 @@ type Organization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Name used for the organization
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The organization of which this organization forms a part
 	PartOf *common.Reference `json:"partOf,omitempty"`
@@ -444,11 +468,13 @@ type Condition struct {
 	ResourceType string `json:"resourceType"` // Always "Condition"
 
 	// The date or estimated date that the condition resolved or went into remission
-	AbatementDateTime *string        `json:"abatementDateTime,omitempty"`
-	AbatementAge      *Age           `json:"abatementAge,omitempty"`
-	AbatementPeriod   *common.Period `json:"abatementPeriod,omitempty"`
-	AbatementRange    *Range         `json:"abatementRange,omitempty"`
-	AbatementString   *string        `json:"abatementString,omitempty"`
+	AbatementDateTime        *common.DateTime `json:"abatementDateTime,omitempty"`
+	AbatementDateTimeElement *common.Element  `json:"_abatementDateTime,omitempty"`
+	AbatementAge             *Age             `json:"abatementAge,omitempty"`
+	AbatementPeriod          *common.Period   `json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range           `json:"abatementRange,omitempty"`
+	AbatementString          *string          `json:"abatementString,omitempty"`
+	AbatementStringElement   *common.Element  `json:"_abatementString,omitempty"`
 
 	// Individual who is making the condition statement
 	Asserter *common.Reference `json:"asserter,omitempty"`
@@ -478,14 +504,17 @@ type Condition struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Estimated or actual date or date-time the condition began
-	OnsetDateTime *string        `json:"onsetDateTime,omitempty"`
-	OnsetAge      *Age           `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *Age             `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// The recordedDate represents when this particular Condition record was created in the system
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Individual who recorded the record and takes responsibility for its content
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -508,7 +537,8 @@ type MedicationIngredient struct {
 	common.BackboneElement
 
 	// Indication of whether this ingredient affects the therapeutic action of the drug
-	IsActive *bool `json:"isActive,omitempty"`
+	IsActive        *bool           `json:"isActive,omitempty"`
+	IsActiveElement *common.Element `json:"_isActive,omitempty"`
 
 	// The actual ingredient - either a substance (simple ingredient) or another medication
 	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
@@ -523,10 +553,12 @@ type MedicationBatch struct {
 	common.BackboneElement
 
 	// When this specific batch of product will expire
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// The assigned lot number of a batch of the specified product
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 }
 
 // Medication represents a medication definition (R4B)
@@ -558,7 +590,8 @@ type Medication struct {
 	Manufacturer *common.Reference `json:"manufacturer,omitempty"`
 
 	// Active | inactive | entered-in-error
-	Status *MedicationStatus `json:"status,omitempty"`
+	Status        *MedicationStatus `json:"status,omitempty"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 }
 
 // MedicationStatus represents the status of a medication
@@ -595,7 +628,8 @@ type MedicationRequestDispenseRequest struct {
 	InitialFill *MedicationRequestDispenseRequestInitialFill `json:"initialFill,omitempty"`
 
 	// If displaying "number of authorized fills", add 1 to this number
-	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed        *int            `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *common.Element `json:"_numberOfRepeatsAllowed,omitempty"`
 
 	// Indicates the intended dispensing Organization specified by the prescriber
 	Performer *common.Reference `json:"performer,omitempty"`
@@ -613,6 +647,7 @@ type MedicationRequestSubstitution struct {
 
 	// Whether substitution is allowed
 	AllowedBoolean         *bool                   `json:"allowedBoolean,omitempty"`
+	AllowedBooleanElement  *common.Element         `json:"_allowedBoolean,omitempty"`
 	AllowedCodeableConcept *common.CodeableConcept `json:"allowedCodeableConcept,omitempty"`
 
 	// Indicates the reason for the substitution
@@ -627,7 +662,8 @@ type MedicationRequest struct {
 	ResourceType string `json:"resourceType"` // Always "MedicationRequest"
 
 	// The date when the prescription was initially written or authored on
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// A plan or request that is fulfilled in whole or in part by this medication request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -645,7 +681,8 @@ type MedicationRequest struct {
 	DispenseRequest *MedicationRequestDispenseRequest `json:"dispenseRequest,omitempty"`
 
 	// If do not perform is not specified, the request is a positive request
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// How to take the medication
 	DosageInstruction []Dosage `json:"dosageInstruction,omitempty"`
@@ -663,16 +700,19 @@ type MedicationRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a protocol, guideline, orderset, or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained protocol, guideline, orderset
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Associated insurance coverage
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option
-	Intent MedicationRequestIntent `json:"intent"`
+	Intent        MedicationRequestIntent `json:"intent"`
+	IntentElement *common.Element         `json:"_intent,omitempty"`
 
 	// Medication to be taken (R4B uses choice type)
 	MedicationCodeableConcept *common.CodeableConcept `json:"medicationCodeableConcept,omitempty"`
@@ -688,7 +728,8 @@ type MedicationRequest struct {
 	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
 
 	// routine | urgent | asap | stat
-	Priority *MedicationRequestPriority `json:"priority,omitempty"`
+	Priority        *MedicationRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element            `json:"_priority,omitempty"`
 
 	// Reference to an order/prescription that is being replaced
 	PriorPrescription *common.Reference `json:"priorPrescription,omitempty"`
@@ -701,14 +742,16 @@ type MedicationRequest struct {
 	Recorder *common.Reference `json:"recorder,omitempty"`
 
 	// Indicates if this record was captured as a secondary 'reported' record
-	ReportedBoolean   *bool             `json:"reportedBoolean,omitempty"`
-	ReportedReference *common.Reference `json:"reportedReference,omitempty"`
+	ReportedBoolean        *bool             `json:"reportedBoolean,omitempty"`
+	ReportedBooleanElement *common.Element   `json:"_reportedBoolean,omitempty"`
+	ReportedReference      *common.Reference `json:"reportedReference,omitempty"`
 
 	// The individual, organization, or device that initiated the request
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// active | on-hold | cancelled | completed | entered-in-error | stopped | draft | unknown
-	Status MedicationRequestStatus `json:"status"`
+	Status        MedicationRequestStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -766,10 +809,12 @@ type Dosage struct {
 	common.Element
 
 	// Free text dosage instructions
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Patient or consumer oriented instructions
-	PatientInstruction *string `json:"patientInstruction,omitempty"`
+	PatientInstruction        *string         `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *common.Element `json:"_patientInstruction,omitempty"`
 
 	// Timing for the dosage
 	Timing *Timing `json:"timing,omitempty"`
@@ -809,7 +854,8 @@ type Timing struct {
 	common.Element
 
 	// When the event occurs
-	Event []*string `json:"event,omitempty"`
+	Event        []*common.DateTime `json:"event,omitempty"`
+	EventElement []*common.Element  `json:"_event,omitempty"`
 
 	// When the event is to occur
 	Repeat *TimingRepeat `json:"repeat,omitempty"`
@@ -828,44 +874,58 @@ type TimingRepeat struct {
 	BoundsPeriod   *common.Period `json:"boundsPeriod,omitempty"`
 
 	// Number of times to repeat
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// Maximum number of times to repeat
-	CountMax *int `json:"countMax,omitempty"`
+	CountMax        *int            `json:"countMax,omitempty"`
+	CountMaxElement *common.Element `json:"_countMax,omitempty"`
 
 	// How long when it happens
-	Duration *float64 `json:"duration,omitempty"`
+	Duration        *common.Decimal `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
 
 	// How long when it happens (Max)
-	DurationMax *float64 `json:"durationMax,omitempty"`
+	DurationMax        *common.Decimal `json:"durationMax,omitempty"`
+	DurationMaxElement *common.Element `json:"_durationMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	DurationUnit *string `json:"durationUnit,omitempty"`
+	DurationUnit        *string         `json:"durationUnit,omitempty"`
+	DurationUnitElement *common.Element `json:"_durationUnit,omitempty"`
 
 	// Event occurs frequency times per period
-	Frequency *int `json:"frequency,omitempty"`
+	Frequency        *int            `json:"frequency,omitempty"`
+	FrequencyElement *common.Element `json:"_frequency,omitempty"`
 
 	// Event occurs up to frequencyMax times per period
-	FrequencyMax *int `json:"frequencyMax,omitempty"`
+	FrequencyMax        *int            `json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *common.Element `json:"_frequencyMax,omitempty"`
 
 	// Event occurs frequency times per period
-	Period *float64 `json:"period,omitempty"`
+	Period        *common.Decimal `json:"period,omitempty"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// Upper limit of period (3-4 hours)
-	PeriodMax *float64 `json:"periodMax,omitempty"`
+	PeriodMax        *common.Decimal `json:"periodMax,omitempty"`
+	PeriodMaxElement *common.Element `json:"_periodMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	PeriodUnit *string `json:"periodUnit,omitempty"`
+	PeriodUnit        *string         `json:"periodUnit,omitempty"`
+	PeriodUnitElement *common.Element `json:"_periodUnit,omitempty"`
 
 	// mon | tue | wed | thu | fri | sat | sun
-	DayOfWeek []string `json:"dayOfWeek,omitempty"`
+	DayOfWeek        []string          `json:"dayOfWeek,omitempty"`
+	DayOfWeekElement []*common.Element `json:"_dayOfWeek,omitempty"`
 
 	// Time of day for action
-	TimeOfDay []*string `json:"timeOfDay,omitempty"`
+	TimeOfDay        []*common.Time    `json:"timeOfDay,omitempty"`
+	TimeOfDayElement []*common.Element `json:"_timeOfDay,omitempty"`
 
 	// Code for time period of occurrence
-	When []string `json:"when,omitempty"`
+	When        []string          `json:"when,omitempty"`
+	WhenElement []*common.Element `json:"_when,omitempty"`
 
 	// Minutes from event (before or after)
-	Offset *int `json:"offset,omitempty"`
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
 }
@@ -0,0 +1,179 @@
+package fhir4b_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/convert"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+	"github.com/d4l-data4life/go-fhir/pkg/validate"
+)
+
+// example is an official example in the JSON of R4B
+type example struct {
+	name string
+	data []byte
+}
+
+// officialExamples returns the official R5 examples of the resource types R4B
+// models, converted to R4 where R4 defines the type, as there are no R4B
+// examples in the repository. Examples that are no valid R4B resources, e.g.
+// because R4 requires an element the R5 example lacks, are left out.
+var officialExamples = sync.OnceValues(func() ([]example, error) {
+	files, err := filepath.Glob("../fhir5/testdata/fhir5-json/*.json")
+	if err != nil {
+		return nil, err
+	}
+	var examples []example
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		r5, err := fhir5.UnmarshalResource(data)
+		if err != nil {
+			continue
+		}
+		if _, known := fhir4b.NewResource(r5.GetResourceType()); !known {
+			continue
+		}
+		if r4, _, err := convert.R5ToR4(r5); err == nil {
+			if data, err = json.Marshal(r4); err != nil {
+				return nil, err
+			}
+		}
+		resource, err := fhir4b.UnmarshalResource(data)
+		if err != nil {
+			continue
+		}
+		if outcome, err := validate.Resource(resource); err != nil || validate.HasErrors(outcome) {
+			continue
+		}
+		examples = append(examples, example{name: filepath.Base(file), data: data})
+	}
+	return examples, nil
+})
+
+func loadExamples(t *testing.T) []example {
+	t.Helper()
+	examples, err := officialExamples()
+	if err != nil {
+		t.Fatalf("failed to load the examples: %v", err)
+	}
+	if len(examples) == 0 {
+		t.Fatal("no example files were found")
+	}
+	return examples
+}
+
+// dropsElements reports whether decoded lacks elements of original, i.e. the
+// example has elements the R4B structs do not model. Values must not change.
+func dropsElements(original, decoded interface{}) bool {
+	switch o := original.(type) {
+	case map[string]interface{}:
+		d, ok := decoded.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range o {
+			if child, ok := d[key]; !ok || dropsElements(value, child) {
+				return true
+			}
+		}
+	case []interface{}:
+		d, ok := decoded.([]interface{})
+		if !ok || len(d) != len(o) {
+			return false
+		}
+		for i := range o {
+			if dropsElements(o[i], d[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestExamples_RoundTripSerialization(t *testing.T) {
+	tested := 0
+	for _, ex := range loadExamples(t) {
+		resource, err := fhir4b.UnmarshalResource(ex.data)
+		if err != nil {
+			t.Errorf("failed to unmarshal %s: %v", ex.name, err)
+			continue
+		}
+		if _, ok := resource.(*common.RawResource); ok {
+			t.Errorf("expected a typed resource for %s, got %T", ex.name, resource)
+			continue
+		}
+		serialized, err := json.Marshal(resource)
+		if err != nil {
+			t.Errorf("failed to marshal %s: %v", ex.name, err)
+			continue
+		}
+		var original, decoded interface{}
+		_ = json.Unmarshal(ex.data, &original)
+		_ = json.Unmarshal(serialized, &decoded)
+		if dropsElements(original, decoded) {
+			t.Logf("skipping %s: contains elements R4B does not define", ex.name)
+			continue
+		}
+		tested++
+		empty, _ := fhir4b.NewResource(resource.GetResourceType())
+		test_utils.CompareRoundTripSerialization(t, ex.data, empty, ex.name)
+	}
+	if tested == 0 {
+		t.Fatal("no example files were tested")
+	}
+}
+
+func TestUnmarshalResource_Bundle(t *testing.T) {
+	var data []byte
+	for _, ex := range loadExamples(t) {
+		if ex.name == "bundle-example.json" {
+			data = ex.data
+		}
+	}
+	if data == nil {
+		t.Fatal("bundle-example.json is not an R4B example")
+	}
+	resource, err := fhir4b.UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("failed to unmarshal bundle: %v", err)
+	}
+	bundle, ok := resource.(*fhir4b.Bundle)
+	if !ok {
+		t.Fatalf("expected *Bundle, got %T", resource)
+	}
+	if len(bundle.Entry) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(bundle.Entry))
+	}
+	if _, ok := bundle.Entry[0].Resource.(*fhir4b.MedicationRequest); !ok {
+		t.Errorf("expected entry 0 to be *MedicationRequest, got %T", bundle.Entry[0].Resource)
+	}
+	if _, ok := bundle.Entry[1].Resource.(*fhir4b.Medication); !ok {
+		t.Errorf("expected entry 1 to be *Medication, got %T", bundle.Entry[1].Resource)
+	}
+}
+
+func TestNewResource_R4BResources(t *testing.T) {
+	for _, resourceType := range []string{
+		"SubscriptionTopic", "SubscriptionStatus", "NutritionProduct", "ClinicalUseDefinition",
+		"AdministrableProductDefinition", "Citation", "Ingredient", "RegulatedAuthorization",
+	} {
+		resource, ok := fhir4b.NewResource(resourceType)
+		if !ok {
+			t.Errorf("expected %s to be known", resourceType)
+			continue
+		}
+		if resource.GetResourceType() != resourceType {
+			t.Errorf("expected resource type %s, got %s", resourceType, resource.GetResourceType())
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// RiskAssessment represents an assessment of the likely outcome(s) for a patient or other subject as well as the likelihood of each outcome
+type RiskAssessment struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "RiskAssessment"
+
+	// A reference to the request that is fulfilled by this risk assessment
+	BasedOn *common.Reference `json:"basedOn,omitempty"`
+
+	// Indicates the source data considered as part of the assessment
+	Basis []common.Reference `json:"basis,omitempty"`
+
+	// The type of the risk assessment performed
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// For assessments or prognosis specific to a particular condition, indicates the condition being assessed
+	Condition *common.Reference `json:"condition,omitempty"`
+
+	// The encounter where the assessment was performed
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Business identifier assigned to the risk assessment
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The algorithm, process or mechanism used to evaluate the risk
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// A description of the steps that might be taken to reduce the identified risk(s)
+	Mitigation        *string         `json:"mitigation,omitempty"`
+	MitigationElement *common.Element `json:"_mitigation,omitempty"`
+
+	// Additional comments about the risk assessment
+	Note []Annotation `json:"note,omitempty"`
+
+	// The date (and possibly time) the risk assessment was performed
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+
+	// The date (and possibly time) the risk assessment was performed
+	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
+
+	// A reference to a resource that this risk assessment is part of, such as a Procedure
+	Parent *common.Reference `json:"parent,omitempty"`
+
+	// The provider or software application that performed the assessment
+	Performer *common.Reference `json:"performer,omitempty"`
+
+	// Multiple repetitions can be used to identify the same type of outcome in different timeframes as well as different types of outcomes
+	Prediction []RiskAssessmentPrediction `json:"prediction,omitempty"`
+
+	// The reason the risk assessment was performed
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Resources supporting the reason the risk assessment was performed
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// The status of the RiskAssessment, using the same statuses as an Observation
+	Status        RiskAssessmentStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// The patient or group the risk assessment applies to
+	Subject common.Reference `json:"subject"`
+}
+
+// RiskAssessmentStatus represents the status of the RiskAssessment, using the same statuses as an Observation
+type RiskAssessmentStatus string
+
+const (
+	RiskAssessmentStatusRegistered     RiskAssessmentStatus = "registered"
+	RiskAssessmentStatusPreliminary    RiskAssessmentStatus = "preliminary"
+	RiskAssessmentStatusFinal          RiskAssessmentStatus = "final"
+	RiskAssessmentStatusAmended        RiskAssessmentStatus = "amended"
+	RiskAssessmentStatusCorrected      RiskAssessmentStatus = "corrected"
+	RiskAssessmentStatusCancelled      RiskAssessmentStatus = "cancelled"
+	RiskAssessmentStatusEnteredInError RiskAssessmentStatus = "entered-in-error"
+	RiskAssessmentStatusUnknown        RiskAssessmentStatus = "unknown"
+)
+
+// RiskAssessmentPrediction represents multiple repetitions can be used to identify the same type of outcome in different timeframes as well as different types of outcomes
+type RiskAssessmentPrediction struct {
+	common.BackboneElement
+
+	// One of the potential outcomes for the patient (e.g. remission, death, a particular condition)
+	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
+
+	// If range is used, it represents the lower and upper bounds of certainty; e.g. 40-60%
+	ProbabilityDecimal        *common.Decimal `json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement *common.Element `json:"_probabilityDecimal,omitempty"`
+
+	// If range is used, it represents the lower and upper bounds of certainty; e.g. 40-60%
+	ProbabilityRange *Range `json:"probabilityRange,omitempty"`
+
+	// Indicates how likely the outcome is (in the specified timeframe), expressed as a qualitative value (e.g. low, medium, or high)
+	QualitativeRisk *common.CodeableConcept `json:"qualitativeRisk,omitempty"`
+
+	// Additional information explaining the basis for the prediction
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
+
+	// Indicates the risk for this particular subject (with their specific characteristics) divided by the risk of the population in general
+	RelativeRisk        *common.Decimal `json:"relativeRisk,omitempty"`
+	RelativeRiskElement *common.Element `json:"_relativeRisk,omitempty"`
+
+	// If not specified, the risk applies "over the subject's lifespan"
+	WhenPeriod *common.Period `json:"whenPeriod,omitempty"`
+
+	// If not specified, the risk applies "over the subject's lifespan"
+	WhenRange *Range `json:"whenRange,omitempty"`
+}
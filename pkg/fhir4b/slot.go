@@ -0,0 +1,62 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Slot represents a slot of time on a schedule that may be available for booking appointments
+type Slot struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Slot"
+
+	// The style of appointment or patient that may be booked in the slot (not service type)
+	AppointmentType *common.CodeableConcept `json:"appointmentType,omitempty"`
+
+	// Comments on the slot to describe any extended information
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
+
+	// Date/Time that the slot is to conclude
+	End        common.Instant  `json:"end"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// External Ids for this item
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// This slot has already been overbooked, appointments are unlikely to be accepted for this time
+	Overbooked        *bool           `json:"overbooked,omitempty"`
+	OverbookedElement *common.Element `json:"_overbooked,omitempty"`
+
+	// The schedule resource that this slot defines an interval of status information
+	Schedule common.Reference `json:"schedule"`
+
+	// A broad categorization of the service that is to be performed during this appointment
+	ServiceCategory []common.CodeableConcept `json:"serviceCategory,omitempty"`
+
+	// The type of appointments that can be booked into this slot
+	ServiceType []common.CodeableConcept `json:"serviceType,omitempty"`
+
+	// The specialty of a practitioner that would be required to perform the service requested in this appointment
+	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
+
+	// Date/Time that the slot is to begin
+	Start        common.Instant  `json:"start"`
+	StartElement *common.Element `json:"_start,omitempty"`
+
+	// busy | free | busy-unavailable | busy-tentative | entered-in-error
+	Status        SlotStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+}
+
+// SlotStatus represents busy | free | busy-unavailable | busy-tentative | entered-in-error
+type SlotStatus string
+
+const (
+	SlotStatusBusy            SlotStatus = "busy"
+	SlotStatusFree            SlotStatus = "free"
+	SlotStatusBusyUnavailable SlotStatus = "busy-unavailable"
+	SlotStatusBusyTentative   SlotStatus = "busy-tentative"
+	SlotStatusEnteredInError  SlotStatus = "entered-in-error"
+)
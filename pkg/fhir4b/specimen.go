@@ -0,0 +1,146 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Specimen represents sample for analysis
+type Specimen struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Specimen"
+
+	// The identifier assigned by the lab when accessioning specimen(s)
+	AccessionIdentifier *common.Identifier `json:"accessionIdentifier,omitempty"`
+
+	// Details concerning the specimen collection
+	Collection *SpecimenCollection `json:"collection,omitempty"`
+
+	// Specimen condition is an observation made about the specimen
+	Condition []common.CodeableConcept `json:"condition,omitempty"`
+
+	// The container holding the specimen
+	Container []SpecimenContainer `json:"container,omitempty"`
+
+	// Id for specimen
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// To communicate any details or issues about the specimen or during the specimen collection
+	Note []Annotation `json:"note,omitempty"`
+
+	// The parent specimen could be the source from which the current specimen is derived by some processing step
+	Parent []common.Reference `json:"parent,omitempty"`
+
+	// Details concerning processing and processing steps for the specimen
+	Processing []SpecimenProcessing `json:"processing,omitempty"`
+
+	// Time when specimen was received for processing or testing
+	ReceivedTime        *common.DateTime `json:"receivedTime,omitempty"`
+	ReceivedTimeElement *common.Element  `json:"_receivedTime,omitempty"`
+
+	// The request may be explicit or implied such with a ServiceRequest that requires a blood draw
+	Request []common.Reference `json:"request,omitempty"`
+
+	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
+	Status        *SpecimenStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// Where the specimen came from
+	Subject *common.Reference `json:"subject,omitempty"`
+
+	// The type can change the way that a specimen is handled and drives what kind of analyses can properly be performed on the specimen
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SpecimenStatus represents the status of a specimen
+type SpecimenStatus string
+
+const (
+	SpecimenStatusAvailable      SpecimenStatus = "available"
+	SpecimenStatusUnavailable    SpecimenStatus = "unavailable"
+	SpecimenStatusUnsatisfactory SpecimenStatus = "unsatisfactory"
+	SpecimenStatusEnteredInError SpecimenStatus = "entered-in-error"
+)
+
+// SpecimenCollection represents collection details
+type SpecimenCollection struct {
+	common.BackboneElement
+
+	// If the use case requires BodySite to be handled as a separate resource instead of an inline coded element
+	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
+
+	// Time when specimen was collected from subject - the physiologically relevant time
+	CollectedDateTime        *common.DateTime `json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *common.Element  `json:"_collectedDateTime,omitempty"`
+
+	// Time when specimen was collected from subject - the physiologically relevant time
+	CollectedPeriod *common.Period `json:"collectedPeriod,omitempty"`
+
+	// Person who collected the specimen
+	Collector *common.Reference `json:"collector,omitempty"`
+
+	// The span of time over which the collection of a specimen occurred
+	Duration *Duration `json:"duration,omitempty"`
+
+	// Representing fasting status using this element is preferred to representing it with an observation using a 'pre-coordinated code' such as LOINC 2005-7 (Calcium [Moles/​time] in 2 hour Urine --12 hours fasting), or using a component observation ` such as `Observation.component code` = LOINC 49541-6 (Fasting status - Reported)
+	FastingStatusCodeableConcept *common.CodeableConcept `json:"fastingStatusCodeableConcept,omitempty"`
+
+	// Representing fasting status using this element is preferred to representing it with an observation using a 'pre-coordinated code' such as LOINC 2005-7 (Calcium [Moles/​time] in 2 hour Urine --12 hours fasting), or using a component observation ` such as `Observation.component code` = LOINC 49541-6 (Fasting status - Reported)
+	FastingStatusDuration *Duration `json:"fastingStatusDuration,omitempty"`
+
+	// A coded value specifying the technique that is used to perform the procedure
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// The quantity of specimen collected; for instance the volume of a blood sample
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+}
+
+// SpecimenProcessing represents processing and processing step details
+type SpecimenProcessing struct {
+	common.BackboneElement
+
+	// Material used in the processing step
+	Additive []common.Reference `json:"additive,omitempty"`
+
+	// Textual description of procedure
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A coded value specifying the procedure used to process the specimen
+	Procedure *common.CodeableConcept `json:"procedure,omitempty"`
+
+	// A record of the time or period when the specimen processing occurred
+	TimeDateTime        *common.DateTime `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element  `json:"_timeDateTime,omitempty"`
+
+	// A record of the time or period when the specimen processing occurred
+	TimePeriod *common.Period `json:"timePeriod,omitempty"`
+}
+
+// SpecimenContainer represents direct container of specimen (tube/slide, etc.)
+type SpecimenContainer struct {
+	common.BackboneElement
+
+	// Introduced substance to preserve, maintain or enhance the specimen
+	AdditiveCodeableConcept *common.CodeableConcept `json:"additiveCodeableConcept,omitempty"`
+
+	// Introduced substance to preserve, maintain or enhance the specimen
+	AdditiveReference *common.Reference `json:"additiveReference,omitempty"`
+
+	// The capacity (volume or other measure) the container may contain
+	Capacity *common.Quantity `json:"capacity,omitempty"`
+
+	// Textual description of the container
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Id for container
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// The quantity of specimen in the container; may be volume, dimensions, or other appropriate measurements
+	SpecimenQuantity *common.Quantity `json:"specimenQuantity,omitempty"`
+
+	// The type of container associated with the specimen (e.g. slide, aliquot, etc.)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
@@ -0,0 +1,136 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SpecimenDefinition represents a kind of specimen with associated set of requirements
+type SpecimenDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "SpecimenDefinition"
+
+	// The action to be performed for collecting the specimen
+	Collection []common.CodeableConcept `json:"collection,omitempty"`
+
+	// A business identifier associated with the kind of specimen
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// Preparation of the patient for specimen collection
+	PatientPreparation []common.CodeableConcept `json:"patientPreparation,omitempty"`
+
+	// Time aspect of specimen collection (duration or offset)
+	TimeAspect        *string         `json:"timeAspect,omitempty"`
+	TimeAspectElement *common.Element `json:"_timeAspect,omitempty"`
+
+	// The kind of material to be collected
+	TypeCollected *common.CodeableConcept `json:"typeCollected,omitempty"`
+
+	// Specimen conditioned in a container as expected by the testing laboratory
+	TypeTested []SpecimenDefinitionTypeTested `json:"typeTested,omitempty"`
+}
+
+// SpecimenDefinitionTypeTestedContainerAdditive represents substance introduced in the kind of container to preserve, maintain or enhance the specimen
+type SpecimenDefinitionTypeTestedContainerAdditive struct {
+	common.BackboneElement
+
+	// Substance introduced in the kind of container to preserve, maintain or enhance the specimen
+	AdditiveCodeableConcept *common.CodeableConcept `json:"additiveCodeableConcept,omitempty"`
+
+	// Substance introduced in the kind of container to preserve, maintain or enhance the specimen
+	AdditiveReference *common.Reference `json:"additiveReference,omitempty"`
+}
+
+// SpecimenDefinitionTypeTestedContainer represents the specimen's container
+type SpecimenDefinitionTypeTestedContainer struct {
+	common.BackboneElement
+
+	// Substance introduced in the kind of container to preserve, maintain or enhance the specimen
+	Additive []SpecimenDefinitionTypeTestedContainerAdditive `json:"additive,omitempty"`
+
+	// Color of container cap
+	Cap *common.CodeableConcept `json:"cap,omitempty"`
+
+	// The capacity (volume or other measure) of this kind of container
+	Capacity *common.Quantity `json:"capacity,omitempty"`
+
+	// The textual description of the kind of container
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The type of material of the container
+	Material *common.CodeableConcept `json:"material,omitempty"`
+
+	// The minimum volume to be conditioned in the container
+	MinimumVolumeQuantity *common.Quantity `json:"minimumVolumeQuantity,omitempty"`
+
+	// The minimum volume to be conditioned in the container
+	MinimumVolumeString        *string         `json:"minimumVolumeString,omitempty"`
+	MinimumVolumeStringElement *common.Element `json:"_minimumVolumeString,omitempty"`
+
+	// Special processing that should be applied to the container for this kind of specimen
+	Preparation        *string         `json:"preparation,omitempty"`
+	PreparationElement *common.Element `json:"_preparation,omitempty"`
+
+	// The type of container used to contain this kind of specimen
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SpecimenDefinitionTypeTestedHandling represents set of instructions for preservation/transport of the specimen at a defined temperature interval
+type SpecimenDefinitionTypeTestedHandling struct {
+	common.BackboneElement
+
+	// Additional textual instructions for the preservation or transport of the specimen
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
+
+	// The maximum time interval of preservation of the specimen with these conditions
+	MaxDuration *Duration `json:"maxDuration,omitempty"`
+
+	// It qualifies the interval of temperature, which characterizes an occurrence of handling
+	TemperatureQualifier *common.CodeableConcept `json:"temperatureQualifier,omitempty"`
+
+	// The temperature interval for this set of handling instructions
+	TemperatureRange *Range `json:"temperatureRange,omitempty"`
+}
+
+// SpecimenDefinitionTypeTested represents specimen conditioned in a container as expected by the testing laboratory
+type SpecimenDefinitionTypeTested struct {
+	common.BackboneElement
+
+	// The specimen's container
+	Container *SpecimenDefinitionTypeTestedContainer `json:"container,omitempty"`
+
+	// Set of instructions for preservation/transport of the specimen at a defined temperature interval
+	Handling []SpecimenDefinitionTypeTestedHandling `json:"handling,omitempty"`
+
+	// Primary of secondary specimen
+	IsDerived        *bool           `json:"isDerived,omitempty"`
+	IsDerivedElement *common.Element `json:"_isDerived,omitempty"`
+
+	// The preference for this type of conditioned specimen
+	Preference        SpecimenDefinitionTypeTestedPreference `json:"preference"`
+	PreferenceElement *common.Element                        `json:"_preference,omitempty"`
+
+	// Criterion for rejection of the specimen in its container by the laboratory
+	RejectionCriterion []common.CodeableConcept `json:"rejectionCriterion,omitempty"`
+
+	// Requirements for delivery and special handling of this kind of conditioned specimen
+	Requirement        *string         `json:"requirement,omitempty"`
+	RequirementElement *common.Element `json:"_requirement,omitempty"`
+
+	// The usual time that a specimen of this kind is retained after the ordered tests are completed
+	RetentionTime *Duration `json:"retentionTime,omitempty"`
+
+	// The kind of specimen conditioned for testing expected by lab
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SpecimenDefinitionTypeTestedPreference represents the preference of a specimen definition type tested
+type SpecimenDefinitionTypeTestedPreference string
+
+const (
+	SpecimenDefinitionTypeTestedPreferencePreferred SpecimenDefinitionTypeTestedPreference = "preferred"
+	SpecimenDefinitionTypeTestedPreferenceAlternate SpecimenDefinitionTypeTestedPreference = "alternate"
+)
@@ -0,0 +1,550 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// StructureMap represents a Map of relationships between 2 structures that can be used to transform data
+type StructureMap struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "StructureMap"
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A copyright statement relating to the structure map and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// Note that this is not the same as the resource last-modified-date
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// This description can be used to capture details such as why the structure map was built
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Allows filtering of structure maps that are appropriate for use versus not
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Organizes the mapping into manageable chunks for human review/ease of maintenance
+	Group []StructureMapGroup `json:"group"`
+
+	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Other maps used by this map (canonical URLs)
+	Import        []string          `json:"import,omitempty"`
+	ImportElement []*common.Element `json:"_import,omitempty"`
+
+	// It may be possible for the structure map to be used in jurisdictions other than those for which it was originally designed
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Usually an organization but may be an individual
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the structure map
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// Allows filtering of structure maps that are appropriate for use versus not
+	Status        StructureMapStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
+
+	// It is not necessary for a structure map to identify any dependent structures
+	Structure []StructureMapStructure `json:"structure,omitempty"`
+
+	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	// There may be different structure map instances that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// StructureMapStatus represents allows filtering of structure maps that are appropriate for use versus not
+type StructureMapStatus string
+
+const (
+	StructureMapStatusDraft   StructureMapStatus = "draft"
+	StructureMapStatusActive  StructureMapStatus = "active"
+	StructureMapStatusRetired StructureMapStatus = "retired"
+	StructureMapStatusUnknown StructureMapStatus = "unknown"
+)
+
+// StructureMapStructure represents it is not necessary for a structure map to identify any dependent structures
+type StructureMapStructure struct {
+	common.BackboneElement
+
+	// This is needed if both types have the same name (e.g. version conversion)
+	Alias        *string         `json:"alias,omitempty"`
+	AliasElement *common.Element `json:"_alias,omitempty"`
+
+	// Documentation that describes how the structure is used in the mapping
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// How the referenced structure is used in this mapping
+	Mode        StructureMapStructureMode `json:"mode"`
+	ModeElement *common.Element           `json:"_mode,omitempty"`
+
+	// The canonical reference to the structure
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+}
+
+// StructureMapStructureMode represents how the referenced structure is used in this mapping
+type StructureMapStructureMode string
+
+const (
+	StructureMapStructureModeSource   StructureMapStructureMode = "source"
+	StructureMapStructureModeQueried  StructureMapStructureMode = "queried"
+	StructureMapStructureModeTarget   StructureMapStructureMode = "target"
+	StructureMapStructureModeProduced StructureMapStructureMode = "produced"
+)
+
+// StructureMapGroupInput represents if no inputs are named, then the entry mappings are type based
+type StructureMapGroupInput struct {
+	common.BackboneElement
+
+	// Documentation for this instance of data
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Mode for this instance of data
+	Mode        StructureMapGroupInputMode `json:"mode"`
+	ModeElement *common.Element            `json:"_mode,omitempty"`
+
+	// Name for this instance of data
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Type for this instance of data
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// StructureMapGroupInputMode represents mode for this instance of data
+type StructureMapGroupInputMode string
+
+const (
+	StructureMapGroupInputModeSource StructureMapGroupInputMode = "source"
+	StructureMapGroupInputModeTarget StructureMapGroupInputMode = "target"
+)
+
+// StructureMapGroupRuleSource represents source inputs to the mapping
+type StructureMapGroupRuleSource struct {
+	common.BackboneElement
+
+	// FHIRPath expression - must be true or the mapping engine throws an error instead of completing
+	Check        *string         `json:"check,omitempty"`
+	CheckElement *common.Element `json:"_check,omitempty"`
+
+	// FHIRPath expression - must be true or the rule does not apply
+	Condition        *string         `json:"condition,omitempty"`
+	ConditionElement *common.Element `json:"_condition,omitempty"`
+
+	// Type or variable this rule applies to
+	Context        string          `json:"context"`
+	ContextElement *common.Element `json:"_context,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueBase64Binary        *string         `json:"defaultValueBase64Binary,omitempty"`
+	DefaultValueBase64BinaryElement *common.Element `json:"_defaultValueBase64Binary,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueBoolean        *bool           `json:"defaultValueBoolean,omitempty"`
+	DefaultValueBooleanElement *common.Element `json:"_defaultValueBoolean,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueCanonical        *string         `json:"defaultValueCanonical,omitempty"`
+	DefaultValueCanonicalElement *common.Element `json:"_defaultValueCanonical,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueCode        *string         `json:"defaultValueCode,omitempty"`
+	DefaultValueCodeElement *common.Element `json:"_defaultValueCode,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDate        *common.Date    `json:"defaultValueDate,omitempty"`
+	DefaultValueDateElement *common.Element `json:"_defaultValueDate,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDateTime        *common.DateTime `json:"defaultValueDateTime,omitempty"`
+	DefaultValueDateTimeElement *common.Element  `json:"_defaultValueDateTime,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDecimal        *common.Decimal `json:"defaultValueDecimal,omitempty"`
+	DefaultValueDecimalElement *common.Element `json:"_defaultValueDecimal,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueId        *string         `json:"defaultValueId,omitempty"`
+	DefaultValueIdElement *common.Element `json:"_defaultValueId,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueInstant        *common.Instant `json:"defaultValueInstant,omitempty"`
+	DefaultValueInstantElement *common.Element `json:"_defaultValueInstant,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueInteger        *int            `json:"defaultValueInteger,omitempty"`
+	DefaultValueIntegerElement *common.Element `json:"_defaultValueInteger,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueMarkdown        *string         `json:"defaultValueMarkdown,omitempty"`
+	DefaultValueMarkdownElement *common.Element `json:"_defaultValueMarkdown,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueOid        *string         `json:"defaultValueOid,omitempty"`
+	DefaultValueOidElement *common.Element `json:"_defaultValueOid,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValuePositiveInt        *int            `json:"defaultValuePositiveInt,omitempty"`
+	DefaultValuePositiveIntElement *common.Element `json:"_defaultValuePositiveInt,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueString        *string         `json:"defaultValueString,omitempty"`
+	DefaultValueStringElement *common.Element `json:"_defaultValueString,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueTime        *common.Time    `json:"defaultValueTime,omitempty"`
+	DefaultValueTimeElement *common.Element `json:"_defaultValueTime,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueUnsignedInt        *int            `json:"defaultValueUnsignedInt,omitempty"`
+	DefaultValueUnsignedIntElement *common.Element `json:"_defaultValueUnsignedInt,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueUri        *string         `json:"defaultValueUri,omitempty"`
+	DefaultValueUriElement *common.Element `json:"_defaultValueUri,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueUrl        *string         `json:"defaultValueUrl,omitempty"`
+	DefaultValueUrlElement *common.Element `json:"_defaultValueUrl,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueUuid        *string         `json:"defaultValueUuid,omitempty"`
+	DefaultValueUuidElement *common.Element `json:"_defaultValueUuid,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueAddress *Address `json:"defaultValueAddress,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueAge *Age `json:"defaultValueAge,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueAnnotation *Annotation `json:"defaultValueAnnotation,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueAttachment *Attachment `json:"defaultValueAttachment,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueCodeableConcept *common.CodeableConcept `json:"defaultValueCodeableConcept,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueCoding *common.Coding `json:"defaultValueCoding,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueContactPoint *ContactPoint `json:"defaultValueContactPoint,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueCount *Count `json:"defaultValueCount,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDistance *Distance `json:"defaultValueDistance,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDuration *Duration `json:"defaultValueDuration,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueHumanName *HumanName `json:"defaultValueHumanName,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueIdentifier *common.Identifier `json:"defaultValueIdentifier,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueMoney *common.Money `json:"defaultValueMoney,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValuePeriod *common.Period `json:"defaultValuePeriod,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueQuantity *common.Quantity `json:"defaultValueQuantity,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueRange *Range `json:"defaultValueRange,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueRatio *Ratio `json:"defaultValueRatio,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueReference *common.Reference `json:"defaultValueReference,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueSampledData *common.SampledData `json:"defaultValueSampledData,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueSignature *common.Signature `json:"defaultValueSignature,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueTiming *Timing `json:"defaultValueTiming,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueContactDetail *ContactDetail `json:"defaultValueContactDetail,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueContributor *Contributor `json:"defaultValueContributor,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDataRequirement *common.DataRequirement `json:"defaultValueDataRequirement,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueExpression *common.Expression `json:"defaultValueExpression,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueParameterDefinition *common.ParameterDefinition `json:"defaultValueParameterDefinition,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueRelatedArtifact *common.RelatedArtifact `json:"defaultValueRelatedArtifact,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueTriggerDefinition *common.TriggerDefinition `json:"defaultValueTriggerDefinition,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueUsageContext *common.UsageContext `json:"defaultValueUsageContext,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueDosage *Dosage `json:"defaultValueDosage,omitempty"`
+
+	// If there's a default value on an item that can repeat, it will only be used once
+	DefaultValueMeta *Meta `json:"defaultValueMeta,omitempty"`
+
+	// Specified type for the element
+	Element        *string         `json:"element,omitempty"`
+	ElementElement *common.Element `json:"_element,omitempty"`
+
+	// How to handle the list mode for this element
+	ListMode        *StructureMapGroupRuleSourceListMode `json:"listMode,omitempty"`
+	ListModeElement *common.Element                      `json:"_listMode,omitempty"`
+
+	// FHIRPath expression to specify the last element
+	LogMessage        *string         `json:"logMessage,omitempty"`
+	LogMessageElement *common.Element `json:"_logMessage,omitempty"`
+
+	// Specified maximum cardinality for the element - a number or a "*"
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
+
+	// Specified minimum cardinality for the element
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
+
+	// Specified type for the element
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+
+	// Named context for field, if a field is specified
+	Variable        *string         `json:"variable,omitempty"`
+	VariableElement *common.Element `json:"_variable,omitempty"`
+}
+
+// StructureMapGroupRuleSourceListMode represents how to handle the list mode for this element
+type StructureMapGroupRuleSourceListMode string
+
+const (
+	StructureMapGroupRuleSourceListModeFirst    StructureMapGroupRuleSourceListMode = "first"
+	StructureMapGroupRuleSourceListModeNotFirst StructureMapGroupRuleSourceListMode = "not_first"
+	StructureMapGroupRuleSourceListModeLast     StructureMapGroupRuleSourceListMode = "last"
+	StructureMapGroupRuleSourceListModeNotLast  StructureMapGroupRuleSourceListMode = "not_last"
+	StructureMapGroupRuleSourceListModeOnlyOne  StructureMapGroupRuleSourceListMode = "only_one"
+)
+
+// StructureMapGroupRuleTargetParameter represents parameters to the transform
+type StructureMapGroupRuleTargetParameter struct {
+	common.BackboneElement
+
+	// Parameter value - variable or literal
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
+
+	// Parameter value - variable or literal
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// Parameter value - variable or literal
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// Parameter value - variable or literal
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// Parameter value - variable or literal
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+}
+
+// StructureMapGroupRuleTarget represents content to create because of this mapping rule
+type StructureMapGroupRuleTarget struct {
+	common.BackboneElement
+
+	// Type or variable this rule applies to
+	Context        *string         `json:"context,omitempty"`
+	ContextElement *common.Element `json:"_context,omitempty"`
+
+	// How to interpret the context
+	ContextType        *StructureMapGroupRuleTargetContextType `json:"contextType,omitempty"`
+	ContextTypeElement *common.Element                         `json:"_contextType,omitempty"`
+
+	// Field to create in the context
+	Element        *string         `json:"element,omitempty"`
+	ElementElement *common.Element `json:"_element,omitempty"`
+
+	// If field is a list, how to manage the list
+	ListMode        []StructureMapGroupRuleTargetListMode `json:"listMode,omitempty"`
+	ListModeElement []*common.Element                     `json:"_listMode,omitempty"`
+
+	// Internal rule reference for shared list items
+	ListRuleId        *string         `json:"listRuleId,omitempty"`
+	ListRuleIdElement *common.Element `json:"_listRuleId,omitempty"`
+
+	// Parameters to the transform
+	Parameter []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty"`
+
+	// Parameters to the transform
+	Transform        *StructureMapGroupRuleTargetTransform `json:"transform,omitempty"`
+	TransformElement *common.Element                       `json:"_transform,omitempty"`
+
+	// Named context for field, if desired, and a field is specified
+	Variable        *string         `json:"variable,omitempty"`
+	VariableElement *common.Element `json:"_variable,omitempty"`
+}
+
+// StructureMapGroupRuleTargetContextType represents how to interpret the context
+type StructureMapGroupRuleTargetContextType string
+
+const (
+	StructureMapGroupRuleTargetContextTypeType     StructureMapGroupRuleTargetContextType = "type"
+	StructureMapGroupRuleTargetContextTypeVariable StructureMapGroupRuleTargetContextType = "variable"
+)
+
+// StructureMapGroupRuleTargetListMode represents if field is a list, how to manage the list
+type StructureMapGroupRuleTargetListMode string
+
+const (
+	StructureMapGroupRuleTargetListModeFirst   StructureMapGroupRuleTargetListMode = "first"
+	StructureMapGroupRuleTargetListModeShare   StructureMapGroupRuleTargetListMode = "share"
+	StructureMapGroupRuleTargetListModeLast    StructureMapGroupRuleTargetListMode = "last"
+	StructureMapGroupRuleTargetListModeCollate StructureMapGroupRuleTargetListMode = "collate"
+)
+
+// StructureMapGroupRuleTargetTransform represents parameters to the transform
+type StructureMapGroupRuleTargetTransform string
+
+const (
+	StructureMapGroupRuleTargetTransformCreate    StructureMapGroupRuleTargetTransform = "create"
+	StructureMapGroupRuleTargetTransformCopy      StructureMapGroupRuleTargetTransform = "copy"
+	StructureMapGroupRuleTargetTransformTruncate  StructureMapGroupRuleTargetTransform = "truncate"
+	StructureMapGroupRuleTargetTransformEscape    StructureMapGroupRuleTargetTransform = "escape"
+	StructureMapGroupRuleTargetTransformCast      StructureMapGroupRuleTargetTransform = "cast"
+	StructureMapGroupRuleTargetTransformAppend    StructureMapGroupRuleTargetTransform = "append"
+	StructureMapGroupRuleTargetTransformTranslate StructureMapGroupRuleTargetTransform = "translate"
+	StructureMapGroupRuleTargetTransformReference StructureMapGroupRuleTargetTransform = "reference"
+	StructureMapGroupRuleTargetTransformDateOp    StructureMapGroupRuleTargetTransform = "dateOp"
+	StructureMapGroupRuleTargetTransformUuid      StructureMapGroupRuleTargetTransform = "uuid"
+	StructureMapGroupRuleTargetTransformPointer   StructureMapGroupRuleTargetTransform = "pointer"
+	StructureMapGroupRuleTargetTransformEvaluate  StructureMapGroupRuleTargetTransform = "evaluate"
+	StructureMapGroupRuleTargetTransformCc        StructureMapGroupRuleTargetTransform = "cc"
+	StructureMapGroupRuleTargetTransformC         StructureMapGroupRuleTargetTransform = "c"
+	StructureMapGroupRuleTargetTransformQty       StructureMapGroupRuleTargetTransform = "qty"
+	StructureMapGroupRuleTargetTransformId        StructureMapGroupRuleTargetTransform = "id"
+	StructureMapGroupRuleTargetTransformCp        StructureMapGroupRuleTargetTransform = "cp"
+)
+
+// StructureMapGroupRuleDependent represents which other rules to apply in the context of this rule
+type StructureMapGroupRuleDependent struct {
+	common.BackboneElement
+
+	// Name of a rule or group to apply
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Variable to pass to the rule or group
+	Variable        []string          `json:"variable"`
+	VariableElement []*common.Element `json:"_variable,omitempty"`
+}
+
+// StructureMapGroupRule represents transform Rule from source to target
+type StructureMapGroupRule struct {
+	common.BackboneElement
+
+	// Which other rules to apply in the context of this rule
+	Dependent []StructureMapGroupRuleDependent `json:"dependent,omitempty"`
+
+	// Documentation for this instance of data
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Name of the rule for internal references
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Rules contained in this rule
+	Rule []StructureMapGroupRule `json:"rule,omitempty"`
+
+	// Source inputs to the mapping
+	Source []StructureMapGroupRuleSource `json:"source"`
+
+	// Content to create because of this mapping rule
+	Target []StructureMapGroupRuleTarget `json:"target,omitempty"`
+}
+
+// StructureMapGroup represents organizes the mapping into manageable chunks for human review/ease of maintenance
+type StructureMapGroup struct {
+	common.BackboneElement
+
+	// Additional supporting documentation that explains the purpose of the group and the types of mappings within it
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+
+	// Another group that this group adds rules to
+	Extends        *string         `json:"extends,omitempty"`
+	ExtendsElement *common.Element `json:"_extends,omitempty"`
+
+	// If no inputs are named, then the entry mappings are type based
+	Input []StructureMapGroupInput `json:"input"`
+
+	// A unique name for the group for the convenience of human readers
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Transform Rule from source to target
+	Rule []StructureMapGroupRule `json:"rule"`
+
+	// If this is the default rule set to apply for the source type or this combination of types
+	TypeMode        StructureMapGroupTypeMode `json:"typeMode"`
+	TypeModeElement *common.Element           `json:"_typeMode,omitempty"`
+}
+
+// StructureMapGroupTypeMode represents if this is the default rule set to apply for the source type or this combination of types
+type StructureMapGroupTypeMode string
+
+const (
+	StructureMapGroupTypeModeNone         StructureMapGroupTypeMode = "none"
+	StructureMapGroupTypeModeTypes        StructureMapGroupTypeMode = "types"
+	StructureMapGroupTypeModeTypeAndTypes StructureMapGroupTypeMode = "type-and-types"
+)
@@ -0,0 +1,81 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Subscription represents the subscription resource is used to define a push-based subscription from a server to another system
+type Subscription struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Subscription"
+
+	// Details where to send notifications when resources are received that meet the criteria
+	Channel SubscriptionChannel `json:"channel"`
+
+	// Contact details for a human to contact about the subscription
+	Contact []ContactPoint `json:"contact,omitempty"`
+
+	// The rules are search criteria (without the [base] part)
+	Criteria        string          `json:"criteria"`
+	CriteriaElement *common.Element `json:"_criteria,omitempty"`
+
+	// The server is permitted to deviate from this time but should observe it
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// A record of the last error that occurred when the server processed a notification
+	Error        *string         `json:"error,omitempty"`
+	ErrorElement *common.Element `json:"_error,omitempty"`
+
+	// A description of why this subscription is defined
+	Reason        string          `json:"reason"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
+
+	// A client can only submit subscription resources in the requested or off state
+	Status        SubscriptionStatusCode `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+}
+
+// SubscriptionStatusCode represents the status of a subscription
+type SubscriptionStatusCode string
+
+const (
+	SubscriptionStatusCodeRequested SubscriptionStatusCode = "requested"
+	SubscriptionStatusCodeActive    SubscriptionStatusCode = "active"
+	SubscriptionStatusCodeError     SubscriptionStatusCode = "error"
+	SubscriptionStatusCodeOff       SubscriptionStatusCode = "off"
+)
+
+// SubscriptionChannel represents details where to send notifications when resources are received that meet the criteria
+type SubscriptionChannel struct {
+	common.BackboneElement
+
+	// For rest-hook, and websocket, the end-point must be an http: or https: URL
+	Endpoint        *string         `json:"endpoint,omitempty"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// Exactly what these mean depend on the channel type
+	Header        []string          `json:"header,omitempty"`
+	HeaderElement []*common.Element `json:"_header,omitempty"`
+
+	// Sending the payload has obvious security implications
+	Payload        *string         `json:"payload,omitempty"`
+	PayloadElement *common.Element `json:"_payload,omitempty"`
+
+	// The type of channel to send notifications on
+	Type        SubscriptionChannelType `json:"type"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
+}
+
+// SubscriptionChannelType represents the type of channel to send notifications on
+type SubscriptionChannelType string
+
+const (
+	SubscriptionChannelTypeRestHook  SubscriptionChannelType = "rest-hook"
+	SubscriptionChannelTypeWebsocket SubscriptionChannelType = "websocket"
+	SubscriptionChannelTypeEmail     SubscriptionChannelType = "email"
+	SubscriptionChannelTypeSms       SubscriptionChannelType = "sms"
+	SubscriptionChannelTypeMessage   SubscriptionChannelType = "message"
+)
@@ -0,0 +1,328 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SubstanceDefinition represents the detailed description of a substance, typically at a level beyond what is used for prescribing
+type SubstanceDefinition struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "SubstanceDefinition"
+
+	// A high level categorization, e.g. polymer or nucleic acid, or food, chemical, biological
+	Classification []common.CodeableConcept `json:"classification,omitempty"`
+
+	// Codes associated with the substance
+	Code []SubstanceDefinitionCode `json:"code,omitempty"`
+
+	// Textual description of the substance
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// If the substance applies to human or veterinary use
+	Domain *common.CodeableConcept `json:"domain,omitempty"`
+
+	// The quality standard, established benchmark, to which substance complies (e.g. USP/NF, Ph
+	Grade []common.CodeableConcept `json:"grade,omitempty"`
+
+	// Identifier by which this substance is known
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Supporting literature
+	InformationSource []common.Reference `json:"informationSource,omitempty"`
+
+	// The entity that creates, makes, produces or fabricates the substance
+	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
+
+	// Moiety, for structural modifications
+	Moiety []SubstanceDefinitionMoiety `json:"moiety,omitempty"`
+
+	// The molecular weight or weight range (for proteins, polymers or nucleic acids)
+	MolecularWeight []SubstanceDefinitionMolecularWeight `json:"molecularWeight,omitempty"`
+
+	// Names applicable to this substance
+	Name []SubstanceDefinitionName `json:"name,omitempty"`
+
+	// Textual comment about the substance's catalogue or registry record
+	Note []Annotation `json:"note,omitempty"`
+
+	// General specifications for this substance
+	Property []SubstanceDefinitionProperty `json:"property,omitempty"`
+
+	// A link between this substance and another, with details of the relationship
+	Relationship []SubstanceDefinitionRelationship `json:"relationship,omitempty"`
+
+	// Material or taxonomic/anatomical source for the substance
+	SourceMaterial *SubstanceDefinitionSourceMaterial `json:"sourceMaterial,omitempty"`
+
+	// Status of substance within the catalogue e.g. active, retired
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// Structural information
+	Structure *SubstanceDefinitionStructure `json:"structure,omitempty"`
+
+	// An entity that is the source for the substance
+	Supplier []common.Reference `json:"supplier,omitempty"`
+
+	// A business level version identifier of the substance
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// SubstanceDefinitionMoiety represents moiety, for structural modifications
+type SubstanceDefinitionMoiety struct {
+	common.BackboneElement
+
+	// Quantitative value for this moiety
+	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
+
+	// Quantitative value for this moiety
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
+
+	// Identifier by which this moiety substance is known
+	Identifier *common.Identifier `json:"identifier,omitempty"`
+
+	// The measurement type of the quantitative value
+	MeasurementType *common.CodeableConcept `json:"measurementType,omitempty"`
+
+	// Molecular formula for this moiety of this substance, typically using the Hill system
+	MolecularFormula        *string         `json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *common.Element `json:"_molecularFormula,omitempty"`
+
+	// Textual name for this moiety substance
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Optical activity type
+	OpticalActivity *common.CodeableConcept `json:"opticalActivity,omitempty"`
+
+	// Role that the moiety is playing
+	Role *common.CodeableConcept `json:"role,omitempty"`
+
+	// Stereochemistry type
+	Stereochemistry *common.CodeableConcept `json:"stereochemistry,omitempty"`
+}
+
+// SubstanceDefinitionProperty represents general specifications for this substance
+type SubstanceDefinitionProperty struct {
+	common.BackboneElement
+
+	// A code expressing the type of property
+	Type common.CodeableConcept `json:"type"`
+
+	// A value for the property
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// A value for the property
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// A value for the property
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// A value for the property
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// A value for the property
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+}
+
+// SubstanceDefinitionMolecularWeight represents the average mass of a molecule of a compound
+type SubstanceDefinitionMolecularWeight struct {
+	common.BackboneElement
+
+	// Used to capture quantitative values for a variety of elements
+	Amount common.Quantity `json:"amount"`
+
+	// The method by which the molecular weight was determined
+	Method *common.CodeableConcept `json:"method,omitempty"`
+
+	// Type of molecular weight such as exact, average (also known as
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SubstanceDefinitionStructureRepresentation represents a depiction of the structure of the substance
+type SubstanceDefinitionStructureRepresentation struct {
+	common.BackboneElement
+
+	// An attached file with the structural representation or characterization e.g. a molecular structure graphic of the substance, a JCAMP or AnIML file
+	Document *common.Reference `json:"document,omitempty"`
+
+	// The format of the representation e.g. InChI, SMILES, MOLFILE, CDX, SDF, PDB, mmCIF
+	Format *common.CodeableConcept `json:"format,omitempty"`
+
+	// The structural representation or characterization as a text string in a standard format
+	Representation        *string         `json:"representation,omitempty"`
+	RepresentationElement *common.Element `json:"_representation,omitempty"`
+
+	// The kind of structural representation (e.g. full, partial)
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SubstanceDefinitionStructure represents structural information
+type SubstanceDefinitionStructure struct {
+	common.BackboneElement
+
+	// Molecular formula of this substance, typically using the Hill system
+	MolecularFormula        *string         `json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *common.Element `json:"_molecularFormula,omitempty"`
+
+	// Specified per moiety according to the Hill system, i.e. first C, then H, then alphabetical
+	MolecularFormulaByMoiety        *string         `json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoietyElement *common.Element `json:"_molecularFormulaByMoiety,omitempty"`
+
+	// The molecular weight or weight range (for proteins, polymers or nucleic acids)
+	MolecularWeight *SubstanceDefinitionMolecularWeight `json:"molecularWeight,omitempty"`
+
+	// Optical activity type
+	OpticalActivity *common.CodeableConcept `json:"opticalActivity,omitempty"`
+
+	// A depiction of the structure or characterization of the substance
+	Representation []SubstanceDefinitionStructureRepresentation `json:"representation,omitempty"`
+
+	// The source of information about the structure
+	SourceDocument []common.Reference `json:"sourceDocument,omitempty"`
+
+	// Stereochemistry type
+	Stereochemistry *common.CodeableConcept `json:"stereochemistry,omitempty"`
+
+	// The method used to elucidate the structure or characterization of the drug substance
+	Technique []common.CodeableConcept `json:"technique,omitempty"`
+}
+
+// SubstanceDefinitionCode represents codes associated with the substance
+type SubstanceDefinitionCode struct {
+	common.BackboneElement
+
+	// The specific code
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Any comment can be provided in this field, if necessary
+	Note []Annotation `json:"note,omitempty"`
+
+	// Supporting literature
+	Source []common.Reference `json:"source,omitempty"`
+
+	// Status of the code assignment, for example 'provisional', 'approved'
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// The date at which the code status was changed as part of the terminology maintenance
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
+}
+
+// SubstanceDefinitionNameOfficial represents details of the official nature of this name
+type SubstanceDefinitionNameOfficial struct {
+	common.BackboneElement
+
+	// Which authority uses this official name
+	Authority *common.CodeableConcept `json:"authority,omitempty"`
+
+	// Date of the official name change
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// The status of the official name, for example 'draft', 'active', 'retired'
+	Status *common.CodeableConcept `json:"status,omitempty"`
+}
+
+// SubstanceDefinitionName represents names applicable to this substance
+type SubstanceDefinitionName struct {
+	common.BackboneElement
+
+	// The use context of this name for example if there is a different name a drug active ingredient as opposed to a food colour additive
+	Domain []common.CodeableConcept `json:"domain,omitempty"`
+
+	// The jurisdiction where this name applies
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// Human language that the name is written in
+	Language []common.CodeableConcept `json:"language,omitempty"`
+
+	// The actual name
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Details of the official nature of this name
+	Official []SubstanceDefinitionNameOfficial `json:"official,omitempty"`
+
+	// If this is the preferred name for this substance
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
+
+	// Supporting literature
+	Source []common.Reference `json:"source,omitempty"`
+
+	// The status of the name, for example 'current', 'proposed'
+	Status *common.CodeableConcept `json:"status,omitempty"`
+
+	// A synonym of this particular name, by which the substance is also known
+	Synonym []SubstanceDefinitionName `json:"synonym,omitempty"`
+
+	// A translation for this name into another human language
+	Translation []SubstanceDefinitionName `json:"translation,omitempty"`
+
+	// Name type, for example 'systematic', 'scientific, 'brand'
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
+
+// SubstanceDefinitionRelationship represents a link between this substance and another
+type SubstanceDefinitionRelationship struct {
+	common.BackboneElement
+
+	// A numeric factor for the relationship
+	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
+
+	// A numeric factor for the relationship
+	AmountRatio *Ratio `json:"amountRatio,omitempty"`
+
+	// A numeric factor for the relationship
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
+
+	// An operator for the amount, for example "average", "approximately", "less than"
+	Comparator *common.CodeableConcept `json:"comparator,omitempty"`
+
+	// For example where an enzyme strongly bonds with a particular substance
+	IsDefining        *bool           `json:"isDefining,omitempty"`
+	IsDefiningElement *common.Element `json:"_isDefining,omitempty"`
+
+	// For use when the numeric has an uncertain range
+	RatioHighLimitAmount *Ratio `json:"ratioHighLimitAmount,omitempty"`
+
+	// Supporting literature
+	Source []common.Reference `json:"source,omitempty"`
+
+	// A pointer to another substance, as a resource or just a representational code
+	SubstanceDefinitionReference *common.Reference `json:"substanceDefinitionReference,omitempty"`
+
+	// A pointer to another substance, as a resource or just a representational code
+	SubstanceDefinitionCodeableConcept *common.CodeableConcept `json:"substanceDefinitionCodeableConcept,omitempty"`
+
+	// For example "salt to parent", "active moiety", "starting material", "polymorph", "impurity of"
+	Type common.CodeableConcept `json:"type"`
+}
+
+// SubstanceDefinitionSourceMaterial represents material or taxonomic/anatomical source
+type SubstanceDefinitionSourceMaterial struct {
+	common.BackboneElement
+
+	// The country or countries where the material is harvested
+	CountryOfOrigin []common.CodeableConcept `json:"countryOfOrigin,omitempty"`
+
+	// The genus of an organism, typically referring to the Latin epithet of the genus element of the plant/animal scientific name
+	Genus *common.CodeableConcept `json:"genus,omitempty"`
+
+	// An anatomical origin of the source material within an organism
+	Part *common.CodeableConcept `json:"part,omitempty"`
+
+	// The species of an organism, typically referring to the Latin epithet of the species of the plant/animal
+	Species *common.CodeableConcept `json:"species,omitempty"`
+
+	// A classification that provides the origin of the raw material
+	Type *common.CodeableConcept `json:"type,omitempty"`
+}
@@ -0,0 +1,115 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SupplyRequest represents a record of a request for a medication, substance or device used in the healthcare setting
+type SupplyRequest struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "SupplyRequest"
+
+	// When the request was made
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// Category of supply, e.g. central, non-stock, etc. This is used to support work flows associated with the supply process
+	Category *common.CodeableConcept `json:"category,omitempty"`
+
+	// Where the supply is expected to come from
+	DeliverFrom *common.Reference `json:"deliverFrom,omitempty"`
+
+	// Where the supply is destined to go
+	DeliverTo *common.Reference `json:"deliverTo,omitempty"`
+
+	// The identifier.type element is used to distinguish between the identifiers assigned by the requester/placer and the performer/filler
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Note that there's a difference between a prescription - an instruction to take a medication, along with a (sometimes) implicit supply, and an explicit request to supply, with no explicit instructions
+	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
+
+	// Note that there's a difference between a prescription - an instruction to take a medication, along with a (sometimes) implicit supply, and an explicit request to supply, with no explicit instructions
+	ItemReference *common.Reference `json:"itemReference,omitempty"`
+
+	// When the request should be fulfilled
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+
+	// When the request should be fulfilled
+	OccurrencePeriod *common.Period `json:"occurrencePeriod,omitempty"`
+
+	// When the request should be fulfilled
+	OccurrenceTiming *Timing `json:"occurrenceTiming,omitempty"`
+
+	// Specific parameters for the ordered item. For example, the size of the indicated item
+	Parameter []SupplyRequestParameter `json:"parameter,omitempty"`
+
+	// Indicates how quickly this SupplyRequest should be addressed with respect to other requests
+	Priority        *SupplyRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
+
+	// The amount that is being ordered of the indicated item
+	Quantity common.Quantity `json:"quantity"`
+
+	// The reason why the supply item was requested
+	ReasonCode []common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// The reason why the supply item was requested
+	ReasonReference []common.Reference `json:"reasonReference,omitempty"`
+
+	// The device, practitioner, etc. who initiated the request
+	Requester *common.Reference `json:"requester,omitempty"`
+
+	// Status of the supply request
+	Status        *SupplyRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
+
+	// Who is intended to fulfill the request
+	Supplier []common.Reference `json:"supplier,omitempty"`
+}
+
+// SupplyRequestPriority represents the priority of a supply request
+type SupplyRequestPriority string
+
+const (
+	SupplyRequestPriorityRoutine SupplyRequestPriority = "routine"
+	SupplyRequestPriorityUrgent  SupplyRequestPriority = "urgent"
+	SupplyRequestPriorityAsap    SupplyRequestPriority = "asap"
+	SupplyRequestPriorityStat    SupplyRequestPriority = "stat"
+)
+
+// SupplyRequestStatus represents the status of a supply request
+type SupplyRequestStatus string
+
+const (
+	SupplyRequestStatusDraft          SupplyRequestStatus = "draft"
+	SupplyRequestStatusActive         SupplyRequestStatus = "active"
+	SupplyRequestStatusSuspended      SupplyRequestStatus = "suspended"
+	SupplyRequestStatusCancelled      SupplyRequestStatus = "cancelled"
+	SupplyRequestStatusCompleted      SupplyRequestStatus = "completed"
+	SupplyRequestStatusEnteredInError SupplyRequestStatus = "entered-in-error"
+	SupplyRequestStatusUnknown        SupplyRequestStatus = "unknown"
+)
+
+// SupplyRequestParameter represents specific parameters for the ordered item. For example, the size of the indicated item
+type SupplyRequestParameter struct {
+	common.BackboneElement
+
+	// A code or string that identifies the device detail being asserted
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// Range means device should have a value that falls somewhere within the specified range
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// Range means device should have a value that falls somewhere within the specified range
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// Range means device should have a value that falls somewhere within the specified range
+	ValueRange *Range `json:"valueRange,omitempty"`
+
+	// Range means device should have a value that falls somewhere within the specified range
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+}
@@ -0,0 +1,526 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Task represents a task to be performed
+type Task struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Task"
+
+	// The date and time this task was created
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
+
+	// BasedOn refers to a higher-level authorization that triggered the creation of the task
+	BasedOn []common.Reference `json:"basedOn,omitempty"`
+
+	// Contains business-specific nuances of the business state
+	BusinessStatus *common.CodeableConcept `json:"businessStatus,omitempty"`
+
+	// The title (eg "My Tasks", "Outstanding Tasks for Patient X") should go into the code
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// A free-text description of what is to be performed
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The healthcare event (e.g. a patient and healthcare provider interaction) during which this task was created
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	// Identifies the time action was first taken against the task (start) and/or the time final action was taken against the task prior to marking it as completed (end)
+	ExecutionPeriod *common.Period `json:"executionPeriod,omitempty"`
+
+	// If multiple resources need to be manipulated, use sub-tasks
+	Focus *common.Reference `json:"focus,omitempty"`
+
+	// The entity who benefits from the performance of the service specified in the task (e.g., the patient)
+	For *common.Reference `json:"for,omitempty"`
+
+	// An identifier that links together multiple tasks and other requests that were created in the same context
+	GroupIdentifier *common.Identifier `json:"groupIdentifier,omitempty"`
+
+	// The business identifier for this task
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Additional information that may be needed in the execution of the task
+	Input []TaskInput `json:"input,omitempty"`
+
+	// The URL pointing to a *FHIR*-defined protocol, guideline, orderset or other definition that is adhered to in whole or in part by this Task
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
+
+	// The URL pointing to an *externally* maintained protocol, guideline, orderset or other definition that is adhered to in whole or in part by this Task
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
+
+	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations that may be relevant to the Task
+	Insurance []common.Reference `json:"insurance,omitempty"`
+
+	// This element is immutable. Proposed tasks, planned tasks, etc. must be distinct instances
+	Intent        TaskIntent      `json:"intent"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
+
+	// The date and time of last modification to this task
+	LastModified        *common.DateTime `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element  `json:"_lastModified,omitempty"`
+
+	// Principal physical location where the this task is performed
+	Location *common.Reference `json:"location,omitempty"`
+
+	// Free-text information captured about the task as it progresses
+	Note []Annotation `json:"note,omitempty"`
+
+	// Outputs produced by the Task
+	Output []TaskOutput `json:"output,omitempty"`
+
+	// Tasks may be created with an owner not yet identified
+	Owner *common.Reference `json:"owner,omitempty"`
+
+	// This should usually be 0..1
+	PartOf []common.Reference `json:"partOf,omitempty"`
+
+	// The kind of participant that should perform the task
+	PerformerType []common.CodeableConcept `json:"performerType,omitempty"`
+
+	// Indicates how quickly the Task should be addressed with respect to other requests
+	Priority        *TaskPriority   `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
+
+	// This should only be included if there is no focus or if it differs from the reason indicated on the focus
+	ReasonCode *common.CodeableConcept `json:"reasonCode,omitempty"`
+
+	// Tasks might be justified based on an Observation, a Condition, a past or planned procedure, etc
+	ReasonReference *common.Reference `json:"reasonReference,omitempty"`
+
+	// This element does not point to the Provenance associated with the *current* version of the resource
+	RelevantHistory []common.Reference `json:"relevantHistory,omitempty"`
+
+	// The creator of the task
+	Requester *common.Reference `json:"requester,omitempty"`
+
+	// If the Task.focus is a request resource and the task is seeking fulfillment, this element identifies any limitations on what parts of the referenced request should be actioned
+	Restriction *TaskRestriction `json:"restriction,omitempty"`
+
+	// The current status of the task
+	Status        TaskStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	// This applies to the current status. Look at the history of the task to see reasons for past statuses
+	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
+}
+
+// TaskIntent represents the intent of a task
+type TaskIntent string
+
+const (
+	TaskIntentUnknown       TaskIntent = "unknown"
+	TaskIntentProposal      TaskIntent = "proposal"
+	TaskIntentPlan          TaskIntent = "plan"
+	TaskIntentOrder         TaskIntent = "order"
+	TaskIntentOriginalOrder TaskIntent = "original-order"
+	TaskIntentReflexOrder   TaskIntent = "reflex-order"
+	TaskIntentFillerOrder   TaskIntent = "filler-order"
+	TaskIntentInstanceOrder TaskIntent = "instance-order"
+	TaskIntentOption        TaskIntent = "option"
+)
+
+// TaskPriority represents the priority of a task
+type TaskPriority string
+
+const (
+	TaskPriorityRoutine TaskPriority = "routine"
+	TaskPriorityUrgent  TaskPriority = "urgent"
+	TaskPriorityAsap    TaskPriority = "asap"
+	TaskPriorityStat    TaskPriority = "stat"
+)
+
+// TaskStatus represents the status of a task
+type TaskStatus string
+
+const (
+	TaskStatusDraft          TaskStatus = "draft"
+	TaskStatusRequested      TaskStatus = "requested"
+	TaskStatusReceived       TaskStatus = "received"
+	TaskStatusAccepted       TaskStatus = "accepted"
+	TaskStatusRejected       TaskStatus = "rejected"
+	TaskStatusReady          TaskStatus = "ready"
+	TaskStatusCancelled      TaskStatus = "cancelled"
+	TaskStatusInProgress     TaskStatus = "in-progress"
+	TaskStatusOnHold         TaskStatus = "on-hold"
+	TaskStatusFailed         TaskStatus = "failed"
+	TaskStatusCompleted      TaskStatus = "completed"
+	TaskStatusEnteredInError TaskStatus = "entered-in-error"
+)
+
+// TaskRestriction represents if the Task.focus is a request resource and the task is seeking fulfillment, this element identifies any limitations on what parts of the referenced request should be actioned
+type TaskRestriction struct {
+	common.BackboneElement
+
+	// Note that period.high is the due date representing the time by which the task should be completed
+	Period *common.Period `json:"period,omitempty"`
+
+	// For requests that are targeted to more than on potential recipient/target, for whom is fulfillment sought?
+	Recipient []common.Reference `json:"recipient,omitempty"`
+
+	// Indicates the number of times the requested action should occur
+	Repetitions        *int            `json:"repetitions,omitempty"`
+	RepetitionsElement *common.Element `json:"_repetitions,omitempty"`
+}
+
+// TaskInput represents additional information that may be needed in the execution of the task
+type TaskInput struct {
+	common.BackboneElement
+
+	// If referencing a BPMN workflow or Protocol, the "system" is the URL for the workflow definition and the code is the "name" of the required input
+	Type common.CodeableConcept `json:"type"`
+
+	// The value of the input parameter as a basic type
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueAddress *Address `json:"valueAddress,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueAge *Age `json:"valueAge,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueCount *Count `json:"valueCount,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDistance *Distance `json:"valueDistance,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDuration *Duration `json:"valueDuration,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueHumanName *HumanName `json:"valueHumanName,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueIdentifier *common.Identifier `json:"valueIdentifier,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueMoney *common.Money `json:"valueMoney,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValuePeriod *common.Period `json:"valuePeriod,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueRange *Range `json:"valueRange,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueRatio *Ratio `json:"valueRatio,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueSampledData *common.SampledData `json:"valueSampledData,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueSignature *common.Signature `json:"valueSignature,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueTiming *Timing `json:"valueTiming,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueContributor *Contributor `json:"valueContributor,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDataRequirement *common.DataRequirement `json:"valueDataRequirement,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueExpression *common.Expression `json:"valueExpression,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueParameterDefinition *common.ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueRelatedArtifact *common.RelatedArtifact `json:"valueRelatedArtifact,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueTriggerDefinition *common.TriggerDefinition `json:"valueTriggerDefinition,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueUsageContext *common.UsageContext `json:"valueUsageContext,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueDosage *Dosage `json:"valueDosage,omitempty"`
+
+	// The value of the input parameter as a basic type
+	ValueMeta *Meta `json:"valueMeta,omitempty"`
+}
+
+// TaskOutput represents outputs produced by the Task
+type TaskOutput struct {
+	common.BackboneElement
+
+	// The name of the Output parameter
+	Type common.CodeableConcept `json:"type"`
+
+	// The value of the Output parameter as a basic type
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueId        *string         `json:"valueId,omitempty"`
+	ValueIdElement *common.Element `json:"_valueId,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueAddress *Address `json:"valueAddress,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueAge *Age `json:"valueAge,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueAnnotation *Annotation `json:"valueAnnotation,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueContactPoint *ContactPoint `json:"valueContactPoint,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueCount *Count `json:"valueCount,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDistance *Distance `json:"valueDistance,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDuration *Duration `json:"valueDuration,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueHumanName *HumanName `json:"valueHumanName,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueIdentifier *common.Identifier `json:"valueIdentifier,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueMoney *common.Money `json:"valueMoney,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValuePeriod *common.Period `json:"valuePeriod,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueRange *Range `json:"valueRange,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueRatio *Ratio `json:"valueRatio,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueReference *common.Reference `json:"valueReference,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueSampledData *common.SampledData `json:"valueSampledData,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueSignature *common.Signature `json:"valueSignature,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueTiming *Timing `json:"valueTiming,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueContactDetail *ContactDetail `json:"valueContactDetail,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueContributor *Contributor `json:"valueContributor,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDataRequirement *common.DataRequirement `json:"valueDataRequirement,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueExpression *common.Expression `json:"valueExpression,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueParameterDefinition *common.ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueRelatedArtifact *common.RelatedArtifact `json:"valueRelatedArtifact,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueTriggerDefinition *common.TriggerDefinition `json:"valueTriggerDefinition,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueUsageContext *common.UsageContext `json:"valueUsageContext,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueDosage *Dosage `json:"valueDosage,omitempty"`
+
+	// The value of the Output parameter as a basic type
+	ValueMeta *Meta `json:"valueMeta,omitempty"`
+}
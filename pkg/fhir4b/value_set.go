@@ -0,0 +1,238 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// ValueSet represents a set of codes drawn from one or more code systems
+type ValueSet struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "ValueSet"
+
+	Compose *ValueSetCompose `json:"compose,omitempty"`
+
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	Expansion *ValueSetExpansion `json:"expansion,omitempty"`
+
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	Immutable        *bool           `json:"immutable,omitempty"`
+	ImmutableElement *common.Element `json:"_immutable,omitempty"`
+
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	Status        ValueSetStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
+
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
+
+	UseContext []common.UsageContext `json:"useContext,omitempty"`
+
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetStatus represents the status of a value set
+type ValueSetStatus string
+
+const (
+	ValueSetStatusDraft   ValueSetStatus = "draft"
+	ValueSetStatusActive  ValueSetStatus = "active"
+	ValueSetStatusRetired ValueSetStatus = "retired"
+	ValueSetStatusUnknown ValueSetStatus = "unknown"
+)
+
+// ValueSetComposeIncludeConceptDesignation represents additional representations for this concept
+type ValueSetComposeIncludeConceptDesignation struct {
+	common.BackboneElement
+
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
+
+	Use *common.Coding `json:"use,omitempty"`
+
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ValueSetComposeIncludeConcept represents a concept defined in the system
+type ValueSetComposeIncludeConcept struct {
+	common.BackboneElement
+
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+}
+
+// ValueSetComposeIncludeFilter represents select codes/concepts by their properties (including relationships)
+type ValueSetComposeIncludeFilter struct {
+	common.BackboneElement
+
+	Op        ValueSetComposeIncludeFilterOp `json:"op"`
+	OpElement *common.Element                `json:"_op,omitempty"`
+
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
+
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// ValueSetComposeIncludeFilterOp represents the op of a value set compose include filter
+type ValueSetComposeIncludeFilterOp string
+
+const (
+	ValueSetComposeIncludeFilterOpEquals       ValueSetComposeIncludeFilterOp = "="
+	ValueSetComposeIncludeFilterOpIsA          ValueSetComposeIncludeFilterOp = "is-a"
+	ValueSetComposeIncludeFilterOpDescendentOf ValueSetComposeIncludeFilterOp = "descendent-of"
+	ValueSetComposeIncludeFilterOpIsNotA       ValueSetComposeIncludeFilterOp = "is-not-a"
+	ValueSetComposeIncludeFilterOpRegex        ValueSetComposeIncludeFilterOp = "regex"
+	ValueSetComposeIncludeFilterOpIn           ValueSetComposeIncludeFilterOp = "in"
+	ValueSetComposeIncludeFilterOpNotIn        ValueSetComposeIncludeFilterOp = "not-in"
+	ValueSetComposeIncludeFilterOpGeneralizes  ValueSetComposeIncludeFilterOp = "generalizes"
+	ValueSetComposeIncludeFilterOpExists       ValueSetComposeIncludeFilterOp = "exists"
+)
+
+// ValueSetComposeInclude represents include one or more codes from a code system or other value set(s)
+type ValueSetComposeInclude struct {
+	common.BackboneElement
+
+	Concept []ValueSetComposeIncludeConcept `json:"concept,omitempty"`
+
+	Filter []ValueSetComposeIncludeFilter `json:"filter,omitempty"`
+
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	ValueSet        []string          `json:"valueSet,omitempty"`
+	ValueSetElement []*common.Element `json:"_valueSet,omitempty"`
+
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetCompose represents the Content Logical Definition (CLD) for a ValueSet
+type ValueSetCompose struct {
+	common.BackboneElement
+
+	Exclude []ValueSetComposeInclude `json:"exclude,omitempty"`
+
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
+
+	Include []ValueSetComposeInclude `json:"include"`
+
+	LockedDate        *common.Date    `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element `json:"_lockedDate,omitempty"`
+}
+
+// ValueSetExpansionParameter represents parameter that controlled the expansion process
+type ValueSetExpansionParameter struct {
+	common.BackboneElement
+
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
+
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
+
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
+
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
+
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
+
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
+
+	// The value of the parameter
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+}
+
+// ValueSetExpansionContains represents codes in the value set
+type ValueSetExpansionContains struct {
+	common.BackboneElement
+
+	Abstract        *bool           `json:"abstract,omitempty"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
+
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
+
+	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
+
+	// The designations provided must be based on the value set and code system definitions
+	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
+
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
+
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
+
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+}
+
+// ValueSetExpansion represents the expansion of a ValueSet
+type ValueSetExpansion struct {
+	common.BackboneElement
+
+	Contains []ValueSetExpansionContains `json:"contains,omitempty"`
+
+	Identifier        *string         `json:"identifier,omitempty"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
+
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
+
+	Parameter []ValueSetExpansionParameter `json:"parameter,omitempty"`
+
+	Timestamp        common.DateTime `json:"timestamp"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
+}
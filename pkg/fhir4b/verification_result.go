@@ -0,0 +1,114 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// VerificationResult represents validation requirements, source(s), status and dates for one or more elements
+type VerificationResult struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "VerificationResult"
+
+	Attestation *VerificationResultAttestation `json:"attestation,omitempty"`
+
+	FailureAction *common.CodeableConcept `json:"failureAction,omitempty"`
+
+	Frequency *Timing `json:"frequency,omitempty"`
+
+	LastPerformed        *common.DateTime `json:"lastPerformed,omitempty"`
+	LastPerformedElement *common.Element  `json:"_lastPerformed,omitempty"`
+
+	Need *common.CodeableConcept `json:"need,omitempty"`
+
+	NextScheduled        *common.Date    `json:"nextScheduled,omitempty"`
+	NextScheduledElement *common.Element `json:"_nextScheduled,omitempty"`
+
+	PrimarySource []VerificationResultPrimarySource `json:"primarySource,omitempty"`
+
+	Status        VerificationResultStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
+
+	Target []common.Reference `json:"target,omitempty"`
+
+	TargetLocation        []string          `json:"targetLocation,omitempty"`
+	TargetLocationElement []*common.Element `json:"_targetLocation,omitempty"`
+
+	ValidationProcess []common.CodeableConcept `json:"validationProcess,omitempty"`
+
+	ValidationType *common.CodeableConcept `json:"validationType,omitempty"`
+
+	Validator []VerificationResultValidator `json:"validator,omitempty"`
+}
+
+// VerificationResultStatus represents the status of a verification result
+type VerificationResultStatus string
+
+const (
+	VerificationResultStatusAttested   VerificationResultStatus = "attested"
+	VerificationResultStatusValidated  VerificationResultStatus = "validated"
+	VerificationResultStatusInProcess  VerificationResultStatus = "in-process"
+	VerificationResultStatusReqRevalid VerificationResultStatus = "req-revalid"
+	VerificationResultStatusValFail    VerificationResultStatus = "val-fail"
+	VerificationResultStatusRevalFail  VerificationResultStatus = "reval-fail"
+)
+
+// VerificationResultPrimarySource represents information about the primary source(s) involved in validation
+type VerificationResultPrimarySource struct {
+	common.BackboneElement
+
+	CanPushUpdates *common.CodeableConcept `json:"canPushUpdates,omitempty"`
+
+	CommunicationMethod []common.CodeableConcept `json:"communicationMethod,omitempty"`
+
+	PushTypeAvailable []common.CodeableConcept `json:"pushTypeAvailable,omitempty"`
+
+	Type []common.CodeableConcept `json:"type,omitempty"`
+
+	ValidationDate        *common.DateTime `json:"validationDate,omitempty"`
+	ValidationDateElement *common.Element  `json:"_validationDate,omitempty"`
+
+	ValidationStatus *common.CodeableConcept `json:"validationStatus,omitempty"`
+
+	Who *common.Reference `json:"who,omitempty"`
+}
+
+// VerificationResultAttestation represents information about the entity attesting to information
+type VerificationResultAttestation struct {
+	common.BackboneElement
+
+	CommunicationMethod *common.CodeableConcept `json:"communicationMethod,omitempty"`
+
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
+
+	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
+
+	ProxyIdentityCertificate        *string         `json:"proxyIdentityCertificate,omitempty"`
+	ProxyIdentityCertificateElement *common.Element `json:"_proxyIdentityCertificate,omitempty"`
+
+	ProxySignature *common.Signature `json:"proxySignature,omitempty"`
+
+	SourceIdentityCertificate        *string         `json:"sourceIdentityCertificate,omitempty"`
+	SourceIdentityCertificateElement *common.Element `json:"_sourceIdentityCertificate,omitempty"`
+
+	SourceSignature *common.Signature `json:"sourceSignature,omitempty"`
+
+	Who *common.Reference `json:"who,omitempty"`
+}
+
+// VerificationResultValidator represents information about the entity validating information
+type VerificationResultValidator struct {
+	common.BackboneElement
+
+	AttestationSignature *common.Signature `json:"attestationSignature,omitempty"`
+
+	IdentityCertificate        *string         `json:"identityCertificate,omitempty"`
+	IdentityCertificateElement *common.Element `json:"_identityCertificate,omitempty"`
+
+	Organization common.Reference `json:"organization"`
+}
@@ -0,0 +1,114 @@
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// VisionPrescription represents an authorization for the provision of glasses and/or contact lenses to a patient
+type VisionPrescription struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "VisionPrescription"
+
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
+
+	DateWritten        common.DateTime `json:"dateWritten"`
+	DateWrittenElement *common.Element `json:"_dateWritten,omitempty"`
+
+	Encounter *common.Reference `json:"encounter,omitempty"`
+
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	LensSpecification []VisionPrescriptionLensSpecification `json:"lensSpecification"`
+
+	Patient common.Reference `json:"patient"`
+
+	Prescriber common.Reference `json:"prescriber"`
+
+	Status        VisionPrescriptionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
+}
+
+// VisionPrescriptionStatus represents the status of a vision prescription
+type VisionPrescriptionStatus string
+
+const (
+	VisionPrescriptionStatusActive         VisionPrescriptionStatus = "active"
+	VisionPrescriptionStatusCancelled      VisionPrescriptionStatus = "cancelled"
+	VisionPrescriptionStatusDraft          VisionPrescriptionStatus = "draft"
+	VisionPrescriptionStatusEnteredInError VisionPrescriptionStatus = "entered-in-error"
+)
+
+// VisionPrescriptionLensSpecificationPrism represents eye alignment compensation
+type VisionPrescriptionLensSpecificationPrism struct {
+	common.BackboneElement
+
+	Amount        common.Decimal  `json:"amount"`
+	AmountElement *common.Element `json:"_amount,omitempty"`
+
+	Base        VisionPrescriptionLensSpecificationPrismBase `json:"base"`
+	BaseElement *common.Element                              `json:"_base,omitempty"`
+}
+
+// VisionPrescriptionLensSpecificationPrismBase represents the base of a vision prescription lens specification prism
+type VisionPrescriptionLensSpecificationPrismBase string
+
+const (
+	VisionPrescriptionLensSpecificationPrismBaseUp   VisionPrescriptionLensSpecificationPrismBase = "up"
+	VisionPrescriptionLensSpecificationPrismBaseDown VisionPrescriptionLensSpecificationPrismBase = "down"
+	VisionPrescriptionLensSpecificationPrismBaseIn   VisionPrescriptionLensSpecificationPrismBase = "in"
+	VisionPrescriptionLensSpecificationPrismBaseOut  VisionPrescriptionLensSpecificationPrismBase = "out"
+)
+
+// VisionPrescriptionLensSpecification represents vision lens authorization
+type VisionPrescriptionLensSpecification struct {
+	common.BackboneElement
+
+	Add        *common.Decimal `json:"add,omitempty"`
+	AddElement *common.Element `json:"_add,omitempty"`
+
+	Axis        *int            `json:"axis,omitempty"`
+	AxisElement *common.Element `json:"_axis,omitempty"`
+
+	BackCurve        *common.Decimal `json:"backCurve,omitempty"`
+	BackCurveElement *common.Element `json:"_backCurve,omitempty"`
+
+	Brand        *string         `json:"brand,omitempty"`
+	BrandElement *common.Element `json:"_brand,omitempty"`
+
+	Color        *string         `json:"color,omitempty"`
+	ColorElement *common.Element `json:"_color,omitempty"`
+
+	Cylinder        *common.Decimal `json:"cylinder,omitempty"`
+	CylinderElement *common.Element `json:"_cylinder,omitempty"`
+
+	Diameter        *common.Decimal `json:"diameter,omitempty"`
+	DiameterElement *common.Element `json:"_diameter,omitempty"`
+
+	Duration *common.Quantity `json:"duration,omitempty"`
+
+	Eye        VisionPrescriptionLensSpecificationEye `json:"eye"`
+	EyeElement *common.Element                        `json:"_eye,omitempty"`
+
+	Note []Annotation `json:"note,omitempty"`
+
+	Power        *common.Decimal `json:"power,omitempty"`
+	PowerElement *common.Element `json:"_power,omitempty"`
+
+	Prism []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty"`
+
+	Product common.CodeableConcept `json:"product"`
+
+	Sphere        *common.Decimal `json:"sphere,omitempty"`
+	SphereElement *common.Element `json:"_sphere,omitempty"`
+}
+
+// VisionPrescriptionLensSpecificationEye represents the eye of a vision prescription lens specification
+type VisionPrescriptionLensSpecificationEye string
+
+const (
+	VisionPrescriptionLensSpecificationEyeRight VisionPrescriptionLensSpecificationEye = "right"
+	VisionPrescriptionLensSpecificationEyeLeft  VisionPrescriptionLensSpecificationEye = "left"
+)
@@ -0,0 +1,31 @@
+// Package fhir4b contains FHIR R4B (version 4.3.0) resource definitions
+package fhir4b
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// xmlCodec reads and writes the FHIR XML representation of the resources of this package
+var xmlCodec = common.NewXMLCodec(registry, elementOrder, nil)
+
+// MarshalXML writes a resource as a FHIR XML document
+func MarshalXML(resource common.Resource) ([]byte, error) {
+	return xmlCodec.Marshal(resource)
+}
+
+// MarshalXMLIndent is like MarshalXML but indents the elements
+func MarshalXMLIndent(resource common.Resource, prefix, indent string) ([]byte, error) {
+	return xmlCodec.MarshalIndent(resource, prefix, indent)
+}
+
+// UnmarshalXML decodes a FHIR XML document into the given resource struct,
+// e.g. a Patient document into a *Patient
+func UnmarshalXML(data []byte, resource common.Resource) error {
+	return xmlCodec.UnmarshalInto(data, resource)
+}
+
+// UnmarshalResourceXML decodes a FHIR XML document into the matching struct of this package.
+// Unknown resource types are returned as *common.RawResource.
+func UnmarshalResourceXML(data []byte) (common.Resource, error) {
+	return xmlCodec.Unmarshal(data)
+}
@@ -0,0 +1,49 @@
+package fhir4b_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+// knownDifferences are the examples the R4B XML codec cannot represent yet
+var knownDifferences = map[string]string{
+	"organization-example.json": "the R4B XML codec does not know the R5 datatype valueAvailability",
+}
+
+func TestXML_RoundTrip(t *testing.T) {
+	definitions := test_utils.LoadElementDefinitions(t, "../fhir4/testdata/fhir4-definitions", "../fhir5/testdata/fhir5-json")
+	for _, ex := range loadExamples(t) {
+		if reason, ok := knownDifferences[ex.name]; ok {
+			t.Logf("skipping %s: %s", ex.name, reason)
+			continue
+		}
+		t.Run(ex.name, func(t *testing.T) {
+			resource, err := fhir4b.UnmarshalResource(ex.data)
+			if err != nil {
+				t.Fatalf("failed to unmarshal JSON: %v", err)
+			}
+			expected, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			xmlData, err := fhir4b.MarshalXML(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal XML: %v", err)
+			}
+			test_utils.CheckXMLElementOrder(t, xmlData, definitions)
+
+			decoded, err := fhir4b.UnmarshalResourceXML(xmlData)
+			if err != nil {
+				t.Fatalf("failed to unmarshal XML: %v\n%s", err, xmlData)
+			}
+			actual, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			test_utils.CompareXMLRoundTrip(t, expected, actual, xmlData)
+		})
+	}
+}
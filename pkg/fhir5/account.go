@@ -24,7 +24,8 @@ type AccountCoverage struct {
 	Coverage common.Reference `json:"coverage"`
 
 	// The priority of the coverage in the context of this account
-	Priority *int `json:"priority,omitempty"`
+	Priority        *int            `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
 }
 
 // AccountGuarantor represents parties responsible for balancing the account
@@ -32,7 +33,8 @@ type AccountGuarantor struct {
 	common.BackboneElement
 
 	// A guarantor may be placed on credit hold or otherwise have their role temporarily suspended
-	OnHold *bool `json:"onHold,omitempty"`
+	OnHold        *bool           `json:"onHold,omitempty"`
+	OnHoldElement *common.Element `json:"_onHold,omitempty"`
 
 	// The entity who is responsible
 	Party common.Reference `json:"party"`
@@ -49,16 +51,19 @@ type AccountDiagnosis struct {
 	Condition CodeableReference `json:"condition"`
 
 	// Date of the diagnosis
-	DateOfDiagnosis *string `json:"dateOfDiagnosis,omitempty"`
+	DateOfDiagnosis        *common.DateTime `json:"dateOfDiagnosis,omitempty"`
+	DateOfDiagnosisElement *common.Element  `json:"_dateOfDiagnosis,omitempty"`
 
 	// Was the diagnosis present on admission
-	OnAdmission *bool `json:"onAdmission,omitempty"`
+	OnAdmission        *bool           `json:"onAdmission,omitempty"`
+	OnAdmissionElement *common.Element `json:"_onAdmission,omitempty"`
 
 	// Package code
 	PackageCode []common.CodeableConcept `json:"packageCode,omitempty"`
 
 	// Ranking of the diagnosis
-	Sequence *int `json:"sequence,omitempty"`
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// Type that this diagnosis has relevant to the account
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -72,7 +77,8 @@ type AccountProcedure struct {
 	Code CodeableReference `json:"code"`
 
 	// Date of the procedure
-	DateOfService *string `json:"dateOfService,omitempty"`
+	DateOfService        *common.DateTime `json:"dateOfService,omitempty"`
+	DateOfServiceElement *common.Element  `json:"_dateOfService,omitempty"`
 
 	// Devices associated with the procedure
 	Device []common.Reference `json:"device,omitempty"`
@@ -81,7 +87,8 @@ type AccountProcedure struct {
 	PackageCode []common.CodeableConcept `json:"packageCode,omitempty"`
 
 	// Ranking of the procedure
-	Sequence *int `json:"sequence,omitempty"`
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// How this procedure value should be used in charging the account
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -103,10 +110,12 @@ type Money struct {
 	common.Element
 
 	// Numerical value (with implicit precision)
-	Value *float64 `json:"value,omitempty"`
+	Value        *common.Decimal `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 
 	// ISO 4217 Currency Code
-	Currency *string `json:"currency,omitempty"`
+	Currency        *string         `json:"currency,omitempty"`
+	CurrencyElement *common.Element `json:"_currency,omitempty"`
 }
 
 // AccountBalance represents balance information for an account
@@ -120,7 +129,8 @@ type AccountBalance struct {
 	Amount Money `json:"amount"`
 
 	// The amount is only an estimated value
-	Estimate *bool `json:"estimate,omitempty"`
+	Estimate        *bool           `json:"estimate,omitempty"`
+	EstimateElement *common.Element `json:"_estimate,omitempty"`
 
 	// The term of the account balances
 	Term *common.CodeableConcept `json:"term,omitempty"`
@@ -140,7 +150,8 @@ type Account struct {
 	BillingStatus *common.CodeableConcept `json:"billingStatus,omitempty"`
 
 	// Time the balances were calculated
-	CalculatedAt *string `json:"calculatedAt,omitempty"`
+	CalculatedAt        *common.Instant `json:"calculatedAt,omitempty"`
+	CalculatedAtElement *common.Element `json:"_calculatedAt,omitempty"`
 
 	// The coverage accounts to be used for billing
 	Coverage []AccountCoverage `json:"coverage,omitempty"`
@@ -149,7 +160,8 @@ type Account struct {
 	Currency *common.CodeableConcept `json:"currency,omitempty"`
 
 	// Explanation of purpose/use
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The set of diagnoses that are relevant for billing
 	Diagnosis []AccountDiagnosis `json:"diagnosis,omitempty"`
@@ -161,7 +173,8 @@ type Account struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Human-readable label
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Entity managing the Account
 	Owner *common.Reference `json:"owner,omitempty"`
@@ -176,7 +189,8 @@ type Account struct {
 	ServicePeriod *common.Period `json:"servicePeriod,omitempty"`
 
 	// active | inactive | entered-in-error | on-hold | unknown
-	Status AccountStatus `json:"status"`
+	Status        AccountStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The entity that caused the expenses
 	Subject []common.Reference `json:"subject,omitempty"`
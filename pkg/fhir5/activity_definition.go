@@ -16,10 +16,12 @@ type ActivityDefinitionParticipant struct {
 	Role *common.CodeableConcept `json:"role,omitempty"`
 
 	// The type of participant in the action
-	Type *ParticipantType `json:"type,omitempty"`
+	Type        *ParticipantType `json:"type,omitempty"`
+	TypeElement *common.Element  `json:"_type,omitempty"`
 
 	// The type of participant in the action (canonical)
-	TypeCanonical *string `json:"typeCanonical,omitempty"`
+	TypeCanonical        *string         `json:"typeCanonical,omitempty"`
+	TypeCanonicalElement *common.Element `json:"_typeCanonical,omitempty"`
 
 	// When this element is a reference, it SHOULD be a reference to a definitional resource
 	TypeReference *common.Reference `json:"typeReference,omitempty"`
@@ -33,7 +35,8 @@ type ActivityDefinitionDynamicValue struct {
 	Expression Expression `json:"expression"`
 
 	// The path attribute contains a Simple FHIRPath Subset that allows path traversal
-	Path string `json:"path"`
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
 }
 
 // ActivityDefinition represents a definition of an activity to be performed
@@ -44,10 +47,12 @@ type ActivityDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "ActivityDefinition"
 
 	// The date on which the resource content was approved by the publisher
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// If a CodeableConcept is present, it indicates the pre-condition for performing the service
 	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
 	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
@@ -63,19 +68,24 @@ type ActivityDefinition struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// The short copyright declaration
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date (and optionally time) when the activity definition was published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Natural language description of the activity definition
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This element is not intended to be used to communicate a decision support response to cancel an order in progress
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// If a dosage instruction is used, the definition should not specify timing or quantity
 	Dosage []Dosage `json:"dosage,omitempty"`
@@ -93,56 +103,68 @@ type ActivityDefinition struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Additional identifier for the activity definition
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Indicates the level of authority/intentionality associated with the activity
-	Intent *RequestIntent `json:"intent,omitempty"`
+	Intent        *RequestIntent  `json:"intent,omitempty"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
 
 	// Intended jurisdiction for activity definition (if applicable)
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The kind element may only specify Request resource types
-	Kind *string `json:"kind,omitempty"`
+	Kind        *string         `json:"kind,omitempty"`
+	KindElement *common.Element `json:"_kind,omitempty"`
 
 	// When the activity definition was last reviewed
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// A reference to a Library resource containing any formal logic used by the activity definition
-	Library []string `json:"library,omitempty"`
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
 
 	// May reference a specific clinical location or may just identify a type of location
 	Location *CodeableReference `json:"location,omitempty"`
 
 	// Name for this activity definition (computer friendly)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Defines observation requirements for the action to be performed
-	ObservationRequirement []string `json:"observationRequirement,omitempty"`
+	ObservationRequirement        []string          `json:"observationRequirement,omitempty"`
+	ObservationRequirementElement []*common.Element `json:"_observationRequirement,omitempty"`
 
 	// Defines the observations that are expected to be produced by the action
-	ObservationResultRequirement []string `json:"observationResultRequirement,omitempty"`
+	ObservationResultRequirement        []string          `json:"observationResultRequirement,omitempty"`
+	ObservationResultRequirementElement []*common.Element `json:"_observationResultRequirement,omitempty"`
 
 	// Indicates who should participate in performing the action described
 	Participant []ActivityDefinitionParticipant `json:"participant,omitempty"`
 
 	// Indicates how quickly the activity should be addressed with respect to other requests
-	Priority *RequestPriority `json:"priority,omitempty"`
+	Priority        *RequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element  `json:"_priority,omitempty"`
 
 	// Identifies the food, drug or other product being consumed or supplied in the activity
 	ProductReference       *common.Reference       `json:"productReference,omitempty"`
 	ProductCodeableConcept *common.CodeableConcept `json:"productCodeableConcept,omitempty"`
 
 	// A profile to which the target of the activity definition is expected to conform
-	Profile *string `json:"profile,omitempty"`
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// Name of the publisher (organization or individual)
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this activity definition is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Identifies the quantity expected to be consumed at once
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -154,18 +176,22 @@ type ActivityDefinition struct {
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// Defines specimen requirements for the action to be performed
-	SpecimenRequirement []string `json:"specimenRequirement,omitempty"`
+	SpecimenRequirement        []string          `json:"specimenRequirement,omitempty"`
+	SpecimenRequirementElement []*common.Element `json:"_specimenRequirement,omitempty"`
 
 	// draft | active | retired | unknown
-	Status RequestStatus `json:"status"`
+	Status        RequestStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Type of individual the activity definition is intended for
-	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
-	SubjectReference       *common.Reference       `json:"subjectReference,omitempty"`
-	SubjectCanonical       *string                 `json:"subjectCanonical,omitempty"`
+	SubjectCodeableConcept  *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
+	SubjectReference        *common.Reference       `json:"subjectReference,omitempty"`
+	SubjectCanonical        *string                 `json:"subjectCanonical,omitempty"`
+	SubjectCanonicalElement *common.Element         `json:"_subjectCanonical,omitempty"`
 
 	// An explanatory or alternate title for the activity definition
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// The intent of the timing element is to provide timing when the action should be performed
 	TimingTiming   *Timing     `json:"timingTiming,omitempty"`
@@ -174,27 +200,33 @@ type ActivityDefinition struct {
 	TimingDuration *Duration   `json:"timingDuration,omitempty"`
 
 	// Name for this activity definition (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// E.g. Education, Treatment, Assessment, etc.
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// Note that if both a transform and dynamic values are specified, the dynamic values will be applied to the result of the transform
-	Transform *string `json:"transform,omitempty"`
+	Transform        *string         `json:"transform,omitempty"`
+	TransformElement *common.Element `json:"_transform,omitempty"`
 
 	// Canonical identifier for this activity definition
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description of how the activity definition is used from a clinical perspective
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// The context that the content is intended to support
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// Business version of the activity definition
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// How to compare versions
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
@@ -21,31 +21,39 @@ type ActorDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "ActorDefinition"
 
 	// The capability statement for the actor (if the concept is applicable)
-	Capabilities *string `json:"capabilities,omitempty"`
+	Capabilities        *string         `json:"capabilities,omitempty"`
+	CapabilitiesElement *common.Element `json:"_capabilities,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc.
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Use and/or publishing restrictions
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Date this was last changed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// A url that identifies the definition of this actor in another IG
-	DerivedFrom []string `json:"derivedFrom,omitempty"`
+	DerivedFrom        []string          `json:"derivedFrom,omitempty"`
+	DerivedFromElement []*common.Element `json:"_derivedFrom,omitempty"`
 
 	// Natural language description of the actor
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// What the actor does (or is expected to do)
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Additional identifier for the actor definition
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -54,36 +62,46 @@ type ActorDefinition struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// Name for this actor definition (computer friendly)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Name of the publisher (organization or individual)
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this actor definition is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// A reference to additional documentation about the actor
-	Reference []string `json:"reference,omitempty"`
+	Reference        []string          `json:"reference,omitempty"`
+	ReferenceElement []*common.Element `json:"_reference,omitempty"`
 
 	// draft | active | retired | unknown
-	Status RequestStatus `json:"status"`
+	Status        RequestStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Name for this actor definition (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Whether the actor represents a human or an application
-	Type ActorType `json:"type"`
+	Type        ActorType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Canonical identifier for this actor definition
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// The context that the content is intended to support
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// Business version of the actor definition
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// How to compare versions
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
@@ -18,9 +18,12 @@ type AdministrableProductDefinitionProperty struct {
 	// A value for the characteristic
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueDate            *string                 `json:"valueDate,omitempty"`
+	ValueDate            *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element         `json:"_valueDate,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueMarkdown        *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element         `json:"_valueMarkdown,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
 }
@@ -30,7 +33,8 @@ type AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalP
 	common.BackboneElement
 
 	// Extra information about the withdrawal period
-	SupportingInformation *string `json:"supportingInformation,omitempty"`
+	SupportingInformation        *string         `json:"supportingInformation,omitempty"`
+	SupportingInformationElement *common.Element `json:"_supportingInformation,omitempty"`
 
 	// Coded expression for the type of tissue for which the withdrawal period applies
 	Tissue common.CodeableConcept `json:"tissue"`
@@ -87,7 +91,8 @@ type AdministrableProductDefinition struct {
 	AdministrableDoseForm *common.CodeableConcept `json:"administrableDoseForm,omitempty"`
 
 	// A general description of the product, when in its final form, suitable for administration
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A device that is integral to the medicinal product
 	Device *common.Reference `json:"device,omitempty"`
@@ -111,7 +116,8 @@ type AdministrableProductDefinition struct {
 	RouteOfAdministration []AdministrableProductDefinitionRouteOfAdministration `json:"routeOfAdministration"`
 
 	// draft | active | retired | unknown
-	Status RequestStatus `json:"status"`
+	Status        RequestStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The presentation type in which this item is given to a patient
 	UnitOfPresentation *common.CodeableConcept `json:"unitOfPresentation,omitempty"`
@@ -104,7 +104,8 @@ type AdverseEvent struct {
 	ResourceType string `json:"resourceType"` // Always "AdverseEvent"
 
 	// Whether the event actually happened or was potential
-	Actuality AdverseEventActuality `json:"actuality"`
+	Actuality        AdverseEventActuality `json:"actuality"`
+	ActualityElement *common.Element       `json:"_actuality,omitempty"`
 
 	// The overall type of event, intended for search and filtering purposes
 	Category []common.CodeableConcept `json:"category,omitempty"`
@@ -116,13 +117,15 @@ type AdverseEvent struct {
 	ContributingFactor []AdverseEventContributingFactor `json:"contributingFactor,omitempty"`
 
 	// Estimated or actual date the AdverseEvent began, in the opinion of the reporter
-	Detected *string `json:"detected,omitempty"`
+	Detected        *common.DateTime `json:"detected,omitempty"`
+	DetectedElement *common.Element  `json:"_detected,omitempty"`
 
 	// The encounter the event occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Considered likely or probable or anticipated in the research study
-	ExpectedInResearchStudy *bool `json:"expectedInResearchStudy,omitempty"`
+	ExpectedInResearchStudy        *bool           `json:"expectedInResearchStudy,omitempty"`
+	ExpectedInResearchStudyElement *common.Element `json:"_expectedInResearchStudy,omitempty"`
 
 	// Business identifier for the adverse event
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -137,9 +140,10 @@ type AdverseEvent struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// The date (and perhaps time) when the adverse event occurred
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// Describes the type of outcome from the adverse event
 	Outcome []common.CodeableConcept `json:"outcome,omitempty"`
@@ -151,7 +155,8 @@ type AdverseEvent struct {
 	PreventiveAction []AdverseEventPreventiveAction `json:"preventiveAction,omitempty"`
 
 	// The recordedDate represents the date when this particular AdverseEvent record was created in the system
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Information on who recorded the adverse event
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -163,7 +168,8 @@ type AdverseEvent struct {
 	Seriousness *common.CodeableConcept `json:"seriousness,omitempty"`
 
 	// in-progress | completed | entered-in-error | unknown
-	Status AdverseEventStatus `json:"status"`
+	Status        AdverseEventStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// The research study that the subject is enrolled in
 	Study []common.Reference `json:"study,omitempty"`
@@ -49,7 +49,8 @@ type AllergyIntoleranceReaction struct {
 	common.BackboneElement
 
 	// Use the description to provide any details of a particular event of the occurred reaction
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Coding of the route of exposure with a terminology should be used wherever possible
 	ExposureRoute *common.CodeableConcept `json:"exposureRoute,omitempty"`
@@ -61,10 +62,12 @@ type AllergyIntoleranceReaction struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Record of the date and/or time of the onset of the Reaction
-	Onset *string `json:"onset,omitempty"`
+	Onset        *common.DateTime `json:"onset,omitempty"`
+	OnsetElement *common.Element  `json:"_onset,omitempty"`
 
 	// It is acknowledged that this assessment is very subjective
-	Severity *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	Severity        *AllergyIntoleranceReactionSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element                     `json:"_severity,omitempty"`
 
 	// Identification of the specific substance considered to be responsible for the Adverse Reaction event
 	Substance *common.CodeableConcept `json:"substance,omitempty"`
@@ -78,7 +81,8 @@ type AllergyIntolerance struct {
 	ResourceType string `json:"resourceType"` // Always "AllergyIntolerance"
 
 	// This data element has been included because it is currently being captured in some clinical systems
-	Category []AllergyIntoleranceCategory `json:"category,omitempty"`
+	Category        []AllergyIntoleranceCategory `json:"category,omitempty"`
+	CategoryElement []*common.Element            `json:"_category,omitempty"`
 
 	// AllergyIntolerance.clinicalStatus should be present if verificationStatus is not entered-in-error
 	ClinicalStatus *common.CodeableConcept `json:"clinicalStatus,omitempty"`
@@ -87,7 +91,8 @@ type AllergyIntolerance struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The default criticality value for any propensity to an adverse reaction should be 'Low Risk'
-	Criticality *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	Criticality        *AllergyIntoleranceCriticality `json:"criticality,omitempty"`
+	CriticalityElement *common.Element                `json:"_criticality,omitempty"`
 
 	// The encounter when the allergy or intolerance was asserted
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -96,17 +101,20 @@ type AllergyIntolerance struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This date may be replicated by one of the Onset of Reaction dates
-	LastOccurrence *string `json:"lastOccurrence,omitempty"`
+	LastOccurrence        *common.DateTime `json:"lastOccurrence,omitempty"`
+	LastOccurrenceElement *common.Element  `json:"_lastOccurrence,omitempty"`
 
 	// For example: including reason for flagging a seriousness of 'High Risk'
 	Note []Annotation `json:"note,omitempty"`
 
 	// Age is generally used when the patient reports an age at which the AllergyIntolerance was noted
-	OnsetDateTime *string        `json:"onsetDateTime,omitempty"`
-	OnsetAge      *common.Age    `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *common.Age      `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// Indicates who or what participated in the activities related to the allergy or intolerance and how they were involved
 	Participant []AllergyIntoleranceParticipant `json:"participant,omitempty"`
@@ -118,7 +126,8 @@ type AllergyIntolerance struct {
 	Reaction []AllergyIntoleranceReaction `json:"reaction,omitempty"`
 
 	// When onset date is unknown, recordedDate can be used to establish if the allergy or intolerance was present
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// Individual who recorded the record and takes responsibility for its content
 	Recorder *common.Reference `json:"recorder,omitempty"`
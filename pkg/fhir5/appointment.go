@@ -36,16 +36,24 @@ type AppointmentRecurrenceTemplateWeeklyTemplate struct {
 	common.BackboneElement
 
 	// Days of the week when the appointment recurs
-	Monday    *bool `json:"monday,omitempty"`
-	Tuesday   *bool `json:"tuesday,omitempty"`
-	Wednesday *bool `json:"wednesday,omitempty"`
-	Thursday  *bool `json:"thursday,omitempty"`
-	Friday    *bool `json:"friday,omitempty"`
-	Saturday  *bool `json:"saturday,omitempty"`
-	Sunday    *bool `json:"sunday,omitempty"`
+	Monday           *bool           `json:"monday,omitempty"`
+	MondayElement    *common.Element `json:"_monday,omitempty"`
+	Tuesday          *bool           `json:"tuesday,omitempty"`
+	TuesdayElement   *common.Element `json:"_tuesday,omitempty"`
+	Wednesday        *bool           `json:"wednesday,omitempty"`
+	WednesdayElement *common.Element `json:"_wednesday,omitempty"`
+	Thursday         *bool           `json:"thursday,omitempty"`
+	ThursdayElement  *common.Element `json:"_thursday,omitempty"`
+	Friday           *bool           `json:"friday,omitempty"`
+	FridayElement    *common.Element `json:"_friday,omitempty"`
+	Saturday         *bool           `json:"saturday,omitempty"`
+	SaturdayElement  *common.Element `json:"_saturday,omitempty"`
+	Sunday           *bool           `json:"sunday,omitempty"`
+	SundayElement    *common.Element `json:"_sunday,omitempty"`
 
 	// The number of weeks between recurrences
-	WeekInterval *int `json:"weekInterval,omitempty"`
+	WeekInterval        *int            `json:"weekInterval,omitempty"`
+	WeekIntervalElement *common.Element `json:"_weekInterval,omitempty"`
 }
 
 // AppointmentRecurrenceTemplateMonthlyTemplate represents monthly recurrence template
@@ -53,16 +61,19 @@ type AppointmentRecurrenceTemplateMonthlyTemplate struct {
 	common.BackboneElement
 
 	// The day of the month when the appointment recurs
-	DayOfMonth *int `json:"dayOfMonth,omitempty"`
+	DayOfMonth        *int            `json:"dayOfMonth,omitempty"`
+	DayOfMonthElement *common.Element `json:"_dayOfMonth,omitempty"`
 
 	// Indicates which week within the month the appointment should recur
-	NthWeekOfMonth *int `json:"nthWeekOfMonth,omitempty"`
+	NthWeekOfMonth        *int            `json:"nthWeekOfMonth,omitempty"`
+	NthWeekOfMonthElement *common.Element `json:"_nthWeekOfMonth,omitempty"`
 
 	// Indicates which day of the week the appointment should recur
 	DayOfWeek *common.Coding `json:"dayOfWeek,omitempty"`
 
 	// The number of months between recurrences
-	MonthInterval *int `json:"monthInterval,omitempty"`
+	MonthInterval        *int            `json:"monthInterval,omitempty"`
+	MonthIntervalElement *common.Element `json:"_monthInterval,omitempty"`
 }
 
 // AppointmentRecurrenceTemplateYearlyTemplate represents yearly recurrence template
@@ -70,7 +81,8 @@ type AppointmentRecurrenceTemplateYearlyTemplate struct {
 	common.BackboneElement
 
 	// The number of years between recurrences
-	YearInterval *int `json:"yearInterval,omitempty"`
+	YearInterval        *int            `json:"yearInterval,omitempty"`
+	YearIntervalElement *common.Element `json:"_yearInterval,omitempty"`
 }
 
 // AppointmentRecurrenceTemplate represents recurrence template for appointments
@@ -84,16 +96,20 @@ type AppointmentRecurrenceTemplate struct {
 	RecurrenceType common.CodeableConcept `json:"recurrenceType"`
 
 	// The date when the recurrence should end
-	LastOccurrenceDate *string `json:"lastOccurrenceDate,omitempty"`
+	LastOccurrenceDate        *common.Date    `json:"lastOccurrenceDate,omitempty"`
+	LastOccurrenceDateElement *common.Element `json:"_lastOccurrenceDate,omitempty"`
 
 	// How many appointment occurrences are planned for the series
-	OccurrenceCount *int `json:"occurrenceCount,omitempty"`
+	OccurrenceCount        *int            `json:"occurrenceCount,omitempty"`
+	OccurrenceCountElement *common.Element `json:"_occurrenceCount,omitempty"`
 
 	// Recurring dates that should be excluded from the series
-	ExcludingDate []string `json:"excludingDate,omitempty"`
+	ExcludingDate        []common.Date     `json:"excludingDate,omitempty"`
+	ExcludingDateElement []*common.Element `json:"_excludingDate,omitempty"`
 
 	// Identifiers of occurrences that should be excluded from the series
-	ExcludingRecurrenceId []int `json:"excludingRecurrenceId,omitempty"`
+	ExcludingRecurrenceId        []int             `json:"excludingRecurrenceId,omitempty"`
+	ExcludingRecurrenceIdElement []*common.Element `json:"_excludingRecurrenceId,omitempty"`
 
 	// Template for weekly recurring appointments
 	WeeklyTemplate *AppointmentRecurrenceTemplateWeeklyTemplate `json:"weeklyTemplate,omitempty"`
@@ -113,10 +129,12 @@ type AppointmentParticipant struct {
 	Actor *common.Reference `json:"actor,omitempty"`
 
 	// Whether this participant is required to be present at the meeting
-	Required *bool `json:"required,omitempty"`
+	Required        *bool           `json:"required,omitempty"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
 
 	// Participation status of the participant
-	Status AppointmentParticipantStatus `json:"status"`
+	Status        AppointmentParticipantStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
 
 	// Role of participant in the appointment
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -145,19 +163,23 @@ type Appointment struct {
 	Class []common.CodeableConcept `json:"class,omitempty"`
 
 	// The date that this appointment was initially created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// Shown on a subject line in a meeting request, or appointment list
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// When appointment is to conclude
-	End *string `json:"end,omitempty"`
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// External identifier for the appointment
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Can be less than start/end
-	MinutesDuration *int `json:"minutesDuration,omitempty"`
+	MinutesDuration        *int            `json:"minutesDuration,omitempty"`
+	MinutesDurationElement *common.Element `json:"_minutesDuration,omitempty"`
 
 	// Additional information to support the appointment
 	Note []Annotation `json:"note,omitempty"`
@@ -199,10 +221,12 @@ type Appointment struct {
 	Specialty []common.CodeableConcept `json:"specialty,omitempty"`
 
 	// When appointment is to take place
-	Start *string `json:"start,omitempty"`
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// The overall status of the Appointment
-	Status AppointmentStatus `json:"status"`
+	Status        AppointmentStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// The patient or group associated with the appointment
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -19,32 +19,40 @@ type AppointmentResponse struct {
 	Appointment common.Reference `json:"appointment"`
 
 	// This comment is particularly important when the responder is declining, tentatively accepting or requesting another time
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// This may be either the same as the appointment request to confirm the details of the appointment, or alternately a new time to request a re-negotiation of the end time
-	End *string `json:"end,omitempty"`
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
 
 	// Business identifier for this appointment response
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The original date within a recurring request
-	OccurrenceDate *string `json:"occurrenceDate,omitempty"`
+	OccurrenceDate        *common.Date    `json:"occurrenceDate,omitempty"`
+	OccurrenceDateElement *common.Element `json:"_occurrenceDate,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the code entered-in-error
-	ParticipantStatus AppointmentParticipantStatus `json:"participantStatus"`
+	ParticipantStatus        AppointmentParticipantStatus `json:"participantStatus"`
+	ParticipantStatusElement *common.Element              `json:"_participantStatus,omitempty"`
 
 	// The role of the participant can be used to declare what the actor will be doing in the scope of the referenced appointment
 	ParticipantType []common.CodeableConcept `json:"participantType,omitempty"`
 
 	// Indicates that the response is proposing a different time that was initially requested
-	ProposedNewTime *bool `json:"proposedNewTime,omitempty"`
+	ProposedNewTime        *bool           `json:"proposedNewTime,omitempty"`
+	ProposedNewTimeElement *common.Element `json:"_proposedNewTime,omitempty"`
 
 	// The recurrence ID (sequence number) of the specific appointment when responding to a recurring request
-	RecurrenceId *int `json:"recurrenceId,omitempty"`
+	RecurrenceId        *int            `json:"recurrenceId,omitempty"`
+	RecurrenceIdElement *common.Element `json:"_recurrenceId,omitempty"`
 
 	// When a recurring appointment is requested, the participant may choose to respond to each individual occurrence
-	Recurring *bool `json:"recurring,omitempty"`
+	Recurring        *bool           `json:"recurring,omitempty"`
+	RecurringElement *common.Element `json:"_recurring,omitempty"`
 
 	// This may be either the same as the appointment request to confirm the details of the appointment, or alternately a new time to request a re-negotiation of the start time
-	Start *string `json:"start,omitempty"`
+	Start        *common.Instant `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 }
@@ -19,13 +19,16 @@ type ArtifactAssessmentContent struct {
 	Component []ArtifactAssessmentContent `json:"component,omitempty"`
 
 	// Acceptable to publicly share the comment, classifier or rating
-	FreeToShare *bool `json:"freeToShare,omitempty"`
+	FreeToShare        *bool           `json:"freeToShare,omitempty"`
+	FreeToShareElement *common.Element `json:"_freeToShare,omitempty"`
 
 	// The type of information this component of the content represents
-	InformationType *ArtifactAssessmentInformationType `json:"informationType,omitempty"`
+	InformationType        *ArtifactAssessmentInformationType `json:"informationType,omitempty"`
+	InformationTypeElement *common.Element                    `json:"_informationType,omitempty"`
 
 	// The target element is used to point the comment to aspect of the artifact
-	Path []string `json:"path,omitempty"`
+	Path        []string          `json:"path,omitempty"`
+	PathElement []*common.Element `json:"_path,omitempty"`
 
 	// A quantitative rating of the artifact
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -34,7 +37,8 @@ type ArtifactAssessmentContent struct {
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// A brief summary of the content of this component
-	Summary *string `json:"summary,omitempty"`
+	Summary        *string         `json:"summary,omitempty"`
+	SummaryElement *common.Element `json:"_summary,omitempty"`
 
 	// Indicates what type of content this component represents
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -60,46 +64,56 @@ type ArtifactAssessment struct {
 	ResourceType string `json:"resourceType"` // Always "ArtifactAssessment"
 
 	// The 'date' element may be more recent than the approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// A reference to a resource which the comment or assessment is about
 	ArtifactReference *common.Reference `json:"artifactReference,omitempty"`
 
 	// A reference to a canonical resource which the comment or assessment is about
-	ArtifactCanonical *string `json:"artifactCanonical,omitempty"`
+	ArtifactCanonical        *string         `json:"artifactCanonical,omitempty"`
+	ArtifactCanonicalElement *common.Element `json:"_artifactCanonical,omitempty"`
 
 	// A reference to a URI which the comment or assessment is about
-	ArtifactUri *string `json:"artifactUri,omitempty"`
+	ArtifactUri        *string         `json:"artifactUri,omitempty"`
+	ArtifactUriElement *common.Element `json:"_artifactUri,omitempty"`
 
 	// Display of or reference to the bibliographic citation
 	CiteAsReference *common.Reference `json:"citeAsReference,omitempty"`
 
 	// Display of or reference to the bibliographic citation
-	CiteAsMarkdown *string `json:"citeAsMarkdown,omitempty"`
+	CiteAsMarkdown        *string         `json:"citeAsMarkdown,omitempty"`
+	CiteAsMarkdownElement *common.Element `json:"_citeAsMarkdown,omitempty"`
 
 	// A component comment, classifier, or rating of the artifact
 	Content []ArtifactAssessmentContent `json:"content,omitempty"`
 
 	// A copyright statement relating to the artifact assessment
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The date is often not tracked until the resource is published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Indicates the disposition of the responsible party to the comment
-	Disposition *ArtifactAssessmentDisposition `json:"disposition,omitempty"`
+	Disposition        *ArtifactAssessmentDisposition `json:"disposition,omitempty"`
+	DispositionElement *common.Element                `json:"_disposition,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// A short title for the assessment for use in displaying and selecting
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Indicates the workflow status of the comment or change request
-	WorkflowStatus *ArtifactAssessmentWorkflowStatus `json:"workflowStatus,omitempty"`
+	WorkflowStatus        *ArtifactAssessmentWorkflowStatus `json:"workflowStatus,omitempty"`
+	WorkflowStatusElement *common.Element                   `json:"_workflowStatus,omitempty"`
 }
 
 // ArtifactAssessmentDisposition represents the disposition of an artifact assessment
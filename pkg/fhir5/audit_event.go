@@ -30,16 +30,20 @@ type AuditEventAgent struct {
 	NetworkReference *common.Reference `json:"networkReference,omitempty"`
 
 	// When remote network endpoint is known, another agent representing the remote agent
-	NetworkUri *string `json:"networkUri,omitempty"`
+	NetworkUri        *string         `json:"networkUri,omitempty"`
+	NetworkUriElement *common.Element `json:"_networkUri,omitempty"`
 
 	// When remote network endpoint is known, another agent representing the remote agent
-	NetworkString *string `json:"networkString,omitempty"`
+	NetworkString        *string         `json:"networkString,omitempty"`
+	NetworkStringElement *common.Element `json:"_networkString,omitempty"`
 
 	// For example: Where an OAuth token authorizes, the unique identifier from the OAuth token
-	Policy []string `json:"policy,omitempty"`
+	Policy        []string          `json:"policy,omitempty"`
+	PolicyElement []*common.Element `json:"_policy,omitempty"`
 
 	// There can only be one initiator. If the initiator is not clear, then do not choose any one agent as the initiator
-	Requestor *bool `json:"requestor,omitempty"`
+	Requestor        *bool           `json:"requestor,omitempty"`
+	RequestorElement *common.Element `json:"_requestor,omitempty"`
 
 	// For example: Chief-of-Radiology, Nurse, Physician, Medical-Student, etc.
 	Role []common.CodeableConcept `json:"role,omitempty"`
@@ -76,16 +80,19 @@ type AuditEventEntity struct {
 	Detail []AuditEventEntityDetail `json:"detail,omitempty"`
 
 	// The meaning and processing of the data element/entity
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The type of the object that was involved in the audit event
 	Lifecycle *common.Coding `json:"lifecycle,omitempty"`
 
 	// A name of the entity in the audit event
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Text that describes the entity in more detail
-	Query *string `json:"query,omitempty"`
+	Query        *string         `json:"query,omitempty"`
+	QueryElement *common.Element `json:"_query,omitempty"`
 
 	// The security labels on the entity
 	SecurityLabel []common.Coding `json:"securityLabel,omitempty"`
@@ -102,11 +109,14 @@ type AuditEventEntityDetail struct {
 	common.BackboneElement
 
 	// The type of extra detail provided in the value
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// The value of the extra detail
-	ValueString       *string `json:"valueString,omitempty"`
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueString              *string         `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element `json:"_valueString,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 }
 
 // AuditEvent represents an audit event
@@ -117,7 +127,8 @@ type AuditEvent struct {
 	ResourceType string `json:"resourceType"` // Always "AuditEvent"
 
 	// Indicator for type of action performed during the event that generated the audit
-	Action *string `json:"action,omitempty"`
+	Action        *string         `json:"action,omitempty"`
+	ActionElement *common.Element `json:"_action,omitempty"`
 
 	// Several agents may be associated with an event or activity
 	Agent []AuditEventAgent `json:"agent"`
@@ -144,7 +155,8 @@ type AuditEvent struct {
 	OccurredPeriod *common.Period `json:"occurredPeriod,omitempty"`
 
 	// The time or period can be a little arbitrary
-	OccurredDateTime *string `json:"occurredDateTime,omitempty"`
+	OccurredDateTime        *common.DateTime `json:"occurredDateTime,omitempty"`
+	OccurredDateTimeElement *common.Element  `json:"_occurredDateTime,omitempty"`
 
 	// In some cases a "success" may be partial
 	Outcome *AuditEventOutcome `json:"outcome,omitempty"`
@@ -153,10 +165,12 @@ type AuditEvent struct {
 	Patient *common.Reference `json:"patient,omitempty"`
 
 	// In a distributed system, some sort of common time base is a good implementation tactic
-	Recorded string `json:"recorded"`
+	Recorded        common.Instant  `json:"recorded"`
+	RecordedElement *common.Element `json:"_recorded,omitempty"`
 
 	// ATNA will map this to the SYSLOG PRI element
-	Severity *AuditEventSeverity `json:"severity,omitempty"`
+	Severity        *AuditEventSeverity `json:"severity,omitempty"`
+	SeverityElement *common.Element     `json:"_severity,omitempty"`
 
 	// Events are reported by the actor that detected them
 	Source AuditEventSource `json:"source"`
@@ -19,7 +19,8 @@ type Basic struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// Identifies when the resource was first created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// Identifier assigned to the resource for business purposes, outside the context of FHIR
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -13,10 +13,12 @@ type Binary struct {
 	ResourceType string `json:"resourceType"` // Always "Binary"
 
 	// MimeType of the binary content represented as a standard MimeType (BCP 13)
-	ContentType string `json:"contentType"`
+	ContentType        string          `json:"contentType"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// If the content type is itself base64 encoding, then this will be base64 encoded twice
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// Very often, a server will also know of a resource that references the binary
 	SecurityContext *common.Reference `json:"securityContext,omitempty"`
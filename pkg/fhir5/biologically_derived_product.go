@@ -10,7 +10,8 @@ type BiologicallyDerivedProductCollection struct {
 	common.BackboneElement
 
 	// Time of product collection
-	CollectedDateTime *string `json:"collectedDateTime,omitempty"`
+	CollectedDateTime        *common.DateTime `json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement *common.Element  `json:"_collectedDateTime,omitempty"`
 
 	// Time of product collection
 	CollectedPeriod *common.Period `json:"collectedPeriod,omitempty"`
@@ -30,10 +31,12 @@ type BiologicallyDerivedProductProperty struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The value should be provided as a boolean, integer, CodeableConcept, period, quantity, range, ratio, or attachment
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value should be provided as a boolean, integer, CodeableConcept, period, quantity, range, ratio, or attachment
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The value should be provided as a boolean, integer, CodeableConcept, period, quantity, range, ratio, or attachment
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
@@ -51,7 +54,8 @@ type BiologicallyDerivedProductProperty struct {
 	ValueRatio *Ratio `json:"valueRatio,omitempty"`
 
 	// The value should be provided as a boolean, integer, CodeableConcept, period, quantity, range, ratio, or attachment
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value should be provided as a boolean, integer, CodeableConcept, period, quantity, range, ratio, or attachment
 	ValueAttachment *Attachment `json:"valueAttachment,omitempty"`
@@ -71,10 +75,12 @@ type BiologicallyDerivedProduct struct {
 	Collection *BiologicallyDerivedProductCollection `json:"collection,omitempty"`
 
 	// A unique identifier for an aliquot of a product
-	Division *string `json:"division,omitempty"`
+	Division        *string         `json:"division,omitempty"`
+	DivisionElement *common.Element `json:"_division,omitempty"`
 
 	// Date, and where relevant time, of expiration
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// This identifier should uniquely identify the product instance in the business domain
 	Identifier []common.Identifier `json:"identifier,omitempty"`
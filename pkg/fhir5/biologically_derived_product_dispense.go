@@ -54,7 +54,8 @@ type BiologicallyDerivedProductDispense struct {
 	Performer []BiologicallyDerivedProductDispensePerformer `json:"performer,omitempty"`
 
 	// When the product was selected/ matched
-	PreparedDate *string `json:"preparedDate,omitempty"`
+	PreparedDate        *common.DateTime `json:"preparedDate,omitempty"`
+	PreparedDateElement *common.Element  `json:"_preparedDate,omitempty"`
 
 	// A link to a resource identifying the biologically derived product that is being dispensed
 	Product common.Reference `json:"product"`
@@ -63,13 +64,16 @@ type BiologicallyDerivedProductDispense struct {
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// A code specifying the state of the dispense event
-	Status BiologicallyDerivedProductDispenseStatus `json:"status"`
+	Status        BiologicallyDerivedProductDispenseStatus `json:"status"`
+	StatusElement *common.Element                          `json:"_status,omitempty"`
 
 	// Specific instructions for use
-	UsageInstruction *string `json:"usageInstruction,omitempty"`
+	UsageInstruction        *string         `json:"usageInstruction,omitempty"`
+	UsageInstructionElement *common.Element `json:"_usageInstruction,omitempty"`
 
 	// When the product was dispatched for clinical use
-	WhenHandedOver *string `json:"whenHandedOver,omitempty"`
+	WhenHandedOver        *common.DateTime `json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement *common.Element  `json:"_whenHandedOver,omitempty"`
 }
 
 // BiologicallyDerivedProductDispenseStatus represents the status of a biologically derived product dispense
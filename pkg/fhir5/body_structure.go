@@ -61,10 +61,12 @@ type BodyStructure struct {
 	ResourceType string `json:"resourceType"` // Always "BodyStructure"
 
 	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// This description could include any visual markings used to orientate the viewer
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The anatomical location(s) or region(s) not occupied or represented by the specimen, lesion, or body structure
 	ExcludedStructure []BodyStructureIncludedStructure `json:"excludedStructure,omitempty"`
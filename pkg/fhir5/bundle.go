@@ -2,6 +2,8 @@
 package fhir5
 
 import (
+	"encoding/json"
+
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
@@ -50,7 +52,8 @@ type Signature struct {
 	Type []common.Coding `json:"type"`
 
 	// When the signature was created
-	When *string `json:"when,omitempty"`
+	When        *common.Instant `json:"when,omitempty"`
+	WhenElement *common.Element `json:"_when,omitempty"`
 
 	// Who signed
 	Who common.Reference `json:"who"`
@@ -59,13 +62,16 @@ type Signature struct {
 	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
 
 	// The technical format of the signed resources
-	TargetFormat *string `json:"targetFormat,omitempty"`
+	TargetFormat        *string         `json:"targetFormat,omitempty"`
+	TargetFormatElement *common.Element `json:"_targetFormat,omitempty"`
 
 	// The technical format of the signature
-	SigFormat *string `json:"sigFormat,omitempty"`
+	SigFormat        *string         `json:"sigFormat,omitempty"`
+	SigFormatElement *common.Element `json:"_sigFormat,omitempty"`
 
 	// The actual signature content (XML DigSig, JWS, etc.)
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 }
 
 // BundleLink represents a link in a bundle
@@ -73,10 +79,12 @@ type BundleLink struct {
 	common.BackboneElement
 
 	// A name which details the functional use for this link
-	Relation string `json:"relation"`
+	Relation        string          `json:"relation"`
+	RelationElement *common.Element `json:"_relation,omitempty"`
 
 	// The reference details for the link
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntrySearch represents information about the search process that led to the creation of this entry
@@ -84,10 +92,12 @@ type BundleEntrySearch struct {
 	common.BackboneElement
 
 	// There is only one mode. In some corner cases, a resource may be included because it is both a match and an include
-	Mode *BundleEntrySearchMode `json:"mode,omitempty"`
+	Mode        *BundleEntrySearchMode `json:"mode,omitempty"`
+	ModeElement *common.Element        `json:"_mode,omitempty"`
 
 	// Servers are not required to return a ranking score. 1 is most relevant, and 0 is least relevant
-	Score *float64 `json:"score,omitempty"`
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
 }
 
 // BundleEntryRequest represents additional information about how this entry should be processed as part of a transaction or batch
@@ -95,22 +105,28 @@ type BundleEntryRequest struct {
 	common.BackboneElement
 
 	// Only perform the operation if the Etag value matches
-	IfMatch *string `json:"ifMatch,omitempty"`
+	IfMatch        *string         `json:"ifMatch,omitempty"`
+	IfMatchElement *common.Element `json:"_ifMatch,omitempty"`
 
 	// Only perform the operation if the last updated date matches
-	IfModifiedSince *string `json:"ifModifiedSince,omitempty"`
+	IfModifiedSince        *common.Instant `json:"ifModifiedSince,omitempty"`
+	IfModifiedSinceElement *common.Element `json:"_ifModifiedSince,omitempty"`
 
 	// Instruct the server not to perform the create if a specified resource already exists
-	IfNoneExist *string `json:"ifNoneExist,omitempty"`
+	IfNoneExist        *string         `json:"ifNoneExist,omitempty"`
+	IfNoneExistElement *common.Element `json:"_ifNoneExist,omitempty"`
 
 	// If the ETag values match, return a 304 Not Modified status
-	IfNoneMatch *string `json:"ifNoneMatch,omitempty"`
+	IfNoneMatch        *string         `json:"ifNoneMatch,omitempty"`
+	IfNoneMatchElement *common.Element `json:"_ifNoneMatch,omitempty"`
 
 	// In a transaction or batch, this is the HTTP action to be executed for this entry
-	Method BundleEntryRequestMethod `json:"method"`
+	Method        BundleEntryRequestMethod `json:"method"`
+	MethodElement *common.Element          `json:"_method,omitempty"`
 
 	// The URL for the entry
-	URL string `json:"url"`
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // BundleEntryResponse represents the results of processing the corresponding 'request' entry in the batch or transaction
@@ -118,19 +134,23 @@ type BundleEntryResponse struct {
 	common.BackboneElement
 
 	// Etags match the Resource.meta.versionId
-	ETag *string `json:"etag,omitempty"`
+	ETag        *string         `json:"etag,omitempty"`
+	ETagElement *common.Element `json:"_etag,omitempty"`
 
 	// This has to match the same time in the meta header (meta.lastUpdated) if a resource is included
-	LastModified *string `json:"lastModified,omitempty"`
+	LastModified        *common.Instant `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element `json:"_lastModified,omitempty"`
 
 	// The location header created by processing this operation
-	Location *string `json:"location,omitempty"`
+	Location        *string         `json:"location,omitempty"`
+	LocationElement *common.Element `json:"_location,omitempty"`
 
 	// For a POST/PUT operation, this is the equivalent outcome that would be returned for prefer = operationoutcome
-	Outcome interface{} `json:"outcome,omitempty"`
+	Outcome common.Resource `json:"outcome,omitempty"`
 
 	// The status code returned by processing this entry
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
 
 // BundleEntry represents an entry in a bundle resource
@@ -138,7 +158,8 @@ type BundleEntry struct {
 	common.BackboneElement
 
 	// The Absolute URL for the resource
-	FullURL *string `json:"fullUrl,omitempty"`
+	FullURL        *string         `json:"fullUrl,omitempty"`
+	FullURLElement *common.Element `json:"_fullUrl,omitempty"`
 
 	// A series of links that provide context to this entry
 	Link []BundleLink `json:"link,omitempty"`
@@ -147,7 +168,7 @@ type BundleEntry struct {
 	Request *BundleEntryRequest `json:"request,omitempty"`
 
 	// The Resource for the entry
-	Resource interface{} `json:"resource,omitempty"`
+	Resource common.Resource `json:"resource,omitempty"`
 
 	// Indicates the results of processing the corresponding 'request' entry in the batch or transaction
 	Response *BundleEntryResponse `json:"response,omitempty"`
@@ -170,7 +191,7 @@ type Bundle struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Issues must apply to the Bundle as a whole, not to individual entries
-	Issues interface{} `json:"issues,omitempty"`
+	Issues common.Resource `json:"issues,omitempty"`
 
 	// A series of links that provide context to this bundle
 	Link []BundleLink `json:"link,omitempty"`
@@ -179,11 +200,74 @@ type Bundle struct {
 	Signature *Signature `json:"signature,omitempty"`
 
 	// For many bundles, the timestamp is equal to .meta.lastUpdated
-	Timestamp *string `json:"timestamp,omitempty"`
+	Timestamp        *common.Instant `json:"timestamp,omitempty"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
 
 	// Only used if the bundle is a search result set
-	Total *int `json:"total,omitempty"`
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
 
 	// It's possible to use a bundle for other purposes (e.g. a document can be accepted as a transaction)
-	Type BundleType `json:"type"`
+	Type        BundleType      `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
+}
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (e *BundleEntry) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntry
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(e),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	e.Resource = resource
+	return nil
+}
+
+// UnmarshalJSON decodes the inline OperationOutcome into the struct matching its resourceType
+func (r *BundleEntryResponse) UnmarshalJSON(data []byte) error {
+	type Alias BundleEntryResponse
+	aux := &struct {
+		*Alias
+		Outcome json.RawMessage `json:"outcome,omitempty"`
+	}{
+		Alias: (*Alias)(r),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Outcome)
+	if err != nil {
+		return err
+	}
+	r.Outcome = resource
+	return nil
+}
+
+// UnmarshalJSON decodes the issues into the struct matching their resourceType
+func (b *Bundle) UnmarshalJSON(data []byte) error {
+	type Alias Bundle
+	aux := &struct {
+		*Alias
+		Issues json.RawMessage `json:"issues,omitempty"`
+	}{
+		Alias: (*Alias)(b),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Issues)
+	if err != nil {
+		return err
+	}
+	b.Issues = resource
+	return nil
 }
@@ -132,13 +132,16 @@ type CapabilityStatementSoftware struct {
 	common.BackboneElement
 
 	// Name the software is known by
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Date this version of the software was released
-	ReleaseDate *string `json:"releaseDate,omitempty"`
+	ReleaseDate        *common.DateTime `json:"releaseDate,omitempty"`
+	ReleaseDateElement *common.Element  `json:"_releaseDate,omitempty"`
 
 	// If possible, a version should be specified, as statements are likely to be different for different versions of software
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // CapabilityStatementImplementation represents a specific implementation instance
@@ -149,10 +152,12 @@ type CapabilityStatementImplementation struct {
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// Information about the specific installation that this capability statement relates to
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An absolute base URL for the implementation
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // CapabilityStatementRestSecurity represents security information
@@ -160,10 +165,12 @@ type CapabilityStatementRestSecurity struct {
 	common.BackboneElement
 
 	// The easiest CORS headers to add are Access-Control-Allow-Origin: * & Access-Control-Request-Method: GET, POST, PUT, DELETE
-	CORS *bool `json:"cors,omitempty"`
+	CORS        *bool           `json:"cors,omitempty"`
+	CORSElement *common.Element `json:"_cors,omitempty"`
 
 	// General description of how security works
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Types of security services that are supported/required by the system
 	Service []common.CodeableConcept `json:"service,omitempty"`
@@ -174,10 +181,12 @@ type CapabilityStatementRestResourceInteraction struct {
 	common.BackboneElement
 
 	// Coded identifier of the operation, supported by the system resource
-	Code CapabilityStatementRestResourceInteractionCode `json:"code"`
+	Code        CapabilityStatementRestResourceInteractionCode `json:"code"`
+	CodeElement *common.Element                                `json:"_code,omitempty"`
 
 	// Guidance specific to the implementation of this operation
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // CapabilityStatementRestResourceSearchParam represents a search parameter supported by the system
@@ -185,16 +194,20 @@ type CapabilityStatementRestResourceSearchParam struct {
 	common.BackboneElement
 
 	// This SHOULD be present, and matches refers to a SearchParameter by its canonical URL
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// This allows documentation of any distinct behaviors about how the search parameter is used
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// Parameter names cannot overlap with standard parameter names
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// While this can be looked up from the definition, it is included here as a convenience
-	Type CapabilityStatementRestResourceSearchParamType `json:"type"`
+	Type        CapabilityStatementRestResourceSearchParamType `json:"type"`
+	TypeElement *common.Element                                `json:"_type,omitempty"`
 }
 
 // CapabilityStatementRestResourceOperation represents an operation supported by the system
@@ -202,13 +215,16 @@ type CapabilityStatementRestResourceOperation struct {
 	common.BackboneElement
 
 	// This can be used to build an HTML form to invoke the operation, for instance
-	Definition string `json:"definition"`
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// Documentation that describes anything special about the operation behavior
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// The name here SHOULD be the same as the OperationDefinition.code in the referenced OperationDefinition
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 }
 
 // CapabilityStatementRestResource represents a resource type supported by the system
@@ -216,22 +232,28 @@ type CapabilityStatementRestResource struct {
 	common.BackboneElement
 
 	// Conditional Create is mainly appropriate for interface engine scripts converting from other formats
-	ConditionalCreate *bool `json:"conditionalCreate,omitempty"`
+	ConditionalCreate        *bool           `json:"conditionalCreate,omitempty"`
+	ConditionalCreateElement *common.Element `json:"_conditionalCreate,omitempty"`
 
 	// Conditional Delete is mainly appropriate for interface engine scripts converting from other formats
-	ConditionalDelete *CapabilityStatementRestResourceConditionalDelete `json:"conditionalDelete,omitempty"`
+	ConditionalDelete        *CapabilityStatementRestResourceConditionalDelete `json:"conditionalDelete,omitempty"`
+	ConditionalDeleteElement *common.Element                                   `json:"_conditionalDelete,omitempty"`
 
 	// Conditional Patch is mainly appropriate for interface engine scripts converting from other formats
-	ConditionalPatch *bool `json:"conditionalPatch,omitempty"`
+	ConditionalPatch        *bool           `json:"conditionalPatch,omitempty"`
+	ConditionalPatchElement *common.Element `json:"_conditionalPatch,omitempty"`
 
 	// Conditional Read is mainly appropriate for interface engine scripts converting from other formats
-	ConditionalRead *CapabilityStatementRestResourceConditionalRead `json:"conditionalRead,omitempty"`
+	ConditionalRead        *CapabilityStatementRestResourceConditionalRead `json:"conditionalRead,omitempty"`
+	ConditionalReadElement *common.Element                                 `json:"_conditionalRead,omitempty"`
 
 	// Conditional Update is mainly appropriate for interface engine scripts converting from other formats
-	ConditionalUpdate *bool `json:"conditionalUpdate,omitempty"`
+	ConditionalUpdate        *bool           `json:"conditionalUpdate,omitempty"`
+	ConditionalUpdateElement *common.Element `json:"_conditionalUpdate,omitempty"`
 
 	// Additional information about the resource type used by the system
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// In general, a Resource will only appear in a CapabilityStatement if the server actually has some capabilities
 	Interaction []CapabilityStatementRestResourceInteraction `json:"interaction,omitempty"`
@@ -240,34 +262,43 @@ type CapabilityStatementRestResource struct {
 	Operation []CapabilityStatementRestResourceOperation `json:"operation,omitempty"`
 
 	// All other profiles for this type that are listed in `.rest.resource.supportedProfile` must conform to this profile
-	Profile *string `json:"profile,omitempty"`
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// It is useful to support the vRead operation for current operations, even if past versions aren't available
-	ReadHistory *bool `json:"readHistory,omitempty"`
+	ReadHistory        *bool           `json:"readHistory,omitempty"`
+	ReadHistoryElement *common.Element `json:"_readHistory,omitempty"`
 
 	// A set of flags that defines how references are supported
-	ReferencePolicy []CapabilityStatementRestResourceReferencePolicy `json:"referencePolicy,omitempty"`
+	ReferencePolicy        []CapabilityStatementRestResourceReferencePolicy `json:"referencePolicy,omitempty"`
+	ReferencePolicyElement []*common.Element                                `json:"_referencePolicy,omitempty"`
 
 	// Documenting `_include` support helps set conformance expectations for the desired system
-	SearchInclude []string `json:"searchInclude,omitempty"`
+	SearchInclude        []string          `json:"searchInclude,omitempty"`
+	SearchIncludeElement []*common.Element `json:"_searchInclude,omitempty"`
 
 	// The search parameters should include the control search parameters such as _sort, _count, etc.
 	SearchParam []CapabilityStatementRestResourceSearchParam `json:"searchParam,omitempty"`
 
 	// See `CapabilityStatement.rest.resource.searchInclude` comments
-	SearchRevInclude []string `json:"searchRevInclude,omitempty"`
+	SearchRevInclude        []string          `json:"searchRevInclude,omitempty"`
+	SearchRevIncludeElement []*common.Element `json:"_searchRevInclude,omitempty"`
 
 	// Supported profiles must conform to the resource profile in the `.rest.resource.profile` element
-	SupportedProfile []string `json:"supportedProfile,omitempty"`
+	SupportedProfile        []string          `json:"supportedProfile,omitempty"`
+	SupportedProfileElement []*common.Element `json:"_supportedProfile,omitempty"`
 
 	// A type of resource exposed via the restful interface
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Allowing the clients to create new identities on the server
-	UpdateCreate *bool `json:"updateCreate,omitempty"`
+	UpdateCreate        *bool           `json:"updateCreate,omitempty"`
+	UpdateCreateElement *common.Element `json:"_updateCreate,omitempty"`
 
 	// If a server supports versionIds correctly, it SHOULD support vread too
-	Versioning *CapabilityStatementRestResourceVersioning `json:"versioning,omitempty"`
+	Versioning        *CapabilityStatementRestResourceVersioning `json:"versioning,omitempty"`
+	VersioningElement *common.Element                            `json:"_versioning,omitempty"`
 }
 
 // CapabilityStatementRestInteraction represents a system interaction
@@ -275,10 +306,12 @@ type CapabilityStatementRestInteraction struct {
 	common.BackboneElement
 
 	// A coded identifier of the operation, supported by the system
-	Code CapabilityStatementRestInteractionCode `json:"code"`
+	Code        CapabilityStatementRestInteractionCode `json:"code"`
+	CodeElement *common.Element                        `json:"_code,omitempty"`
 
 	// Guidance specific to the implementation of this operation
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // CapabilityStatementRest represents a REST interface
@@ -286,16 +319,19 @@ type CapabilityStatementRest struct {
 	common.BackboneElement
 
 	// At present, the only defined compartments are at CompartmentDefinition
-	Compartment []string `json:"compartment,omitempty"`
+	Compartment        []string          `json:"compartment,omitempty"`
+	CompartmentElement []*common.Element `json:"_compartment,omitempty"`
 
 	// Information about the system's restful capabilities that apply across all applications
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// A specification of restful operations supported by the system
 	Interaction []CapabilityStatementRestInteraction `json:"interaction,omitempty"`
 
 	// Identifies whether this portion of the statement is describing the ability to initiate or receive restful operations
-	Mode CapabilityStatementRestMode `json:"mode"`
+	Mode        CapabilityStatementRestMode `json:"mode"`
+	ModeElement *common.Element             `json:"_mode,omitempty"`
 
 	// CapabilityStatement.rest.operation is for operations invoked at the system level
 	Operation []CapabilityStatementRestResourceOperation `json:"operation,omitempty"`
@@ -307,18 +343,44 @@ type CapabilityStatementRest struct {
 	Security *CapabilityStatementRestSecurity `json:"security,omitempty"`
 }
 
+// CapabilityStatementMessagingEndpoint represents where messages should be sent
+type CapabilityStatementMessagingEndpoint struct {
+	common.BackboneElement
+
+	// http | ftp | mllp +
+	Protocol common.Coding `json:"protocol"`
+
+	// Network address or identifier of the end-point
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
+}
+
+// CapabilityStatementMessagingSupportedMessage represents messages supported by this system
+type CapabilityStatementMessagingSupportedMessage struct {
+	common.BackboneElement
+
+	// sender | receiver
+	Mode        string          `json:"mode"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
+
+	// Message supported by this system
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
+}
+
 // CapabilityStatementMessaging represents messaging capabilities
 type CapabilityStatementMessaging struct {
 	common.BackboneElement
 
 	// Documentation about the system's messaging capabilities for this endpoint not otherwise documented by the capability statement
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// An endpoint (network accessible address) to which messages and/or replies are to be sent
-	Endpoint []interface{} `json:"endpoint,omitempty"`
+	Endpoint []CapabilityStatementMessagingEndpoint `json:"endpoint,omitempty"`
 
 	// Identifies which messaging standard is supported
-	SupportedMessage []interface{} `json:"supportedMessage,omitempty"`
+	SupportedMessage []CapabilityStatementMessagingSupportedMessage `json:"supportedMessage,omitempty"`
 }
 
 // CapabilityStatementDocument represents document capabilities
@@ -326,10 +388,12 @@ type CapabilityStatementDocument struct {
 	common.BackboneElement
 
 	// Mode of this document declaration - whether an application is a producer or consumer
-	Mode CapabilityStatementDocumentMode `json:"mode"`
+	Mode        CapabilityStatementDocumentMode `json:"mode"`
+	ModeElement *common.Element                 `json:"_mode,omitempty"`
 
 	// A profile on the DocumentReference for documents that conform to this document definition
-	Profile string `json:"profile"`
+	Profile        string          `json:"profile"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 }
 
 // CapabilityStatement represents a capability statement documenting the capabilities of a FHIR Server or Client
@@ -340,34 +404,42 @@ type CapabilityStatement struct {
 	ResourceType string `json:"resourceType"` // Always "CapabilityStatement"
 
 	// In general, if a server gets a request with an Accept-Language that it doesn't support, it should still return the resource
-	AcceptLanguage []string `json:"acceptLanguage,omitempty"`
+	AcceptLanguage        []string          `json:"acceptLanguage,omitempty"`
+	AcceptLanguageElement []*common.Element `json:"_acceptLanguage,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc.
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Use and/or publishing restrictions
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published, but may be present on draft content
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse, instructions for clinical use and interpretation
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A document definition
 	Document []CapabilityStatementDocument `json:"document,omitempty"`
 
 	// Allows filtering of capability statements that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Servers may implement multiple versions
-	FhirVersion string `json:"fhirVersion"`
+	FhirVersion        string          `json:"fhirVersion"`
+	FhirVersionElement *common.Element `json:"_fhirVersion,omitempty"`
 
 	// "xml", "json" and "ttl" are allowed, which describe the simple encodings described in the specification
-	Format []string `json:"format"`
+	Format        []string          `json:"format"`
+	FormatElement []*common.Element `json:"_format,omitempty"`
 
 	// A formal identifier that is used to identify this CapabilityStatement when it is represented in other formats
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -376,34 +448,42 @@ type CapabilityStatement struct {
 	Implementation *CapabilityStatementImplementation `json:"implementation,omitempty"`
 
 	// Note: this is primarily only relevant in terms of ImplementationGuides that don't define specific CapabilityStatements
-	ImplementationGuide []string `json:"implementationGuide,omitempty"`
+	ImplementationGuide        []string          `json:"implementationGuide,omitempty"`
+	ImplementationGuideElement []*common.Element `json:"_implementationGuide,omitempty"`
 
 	// the contents of any directly or indirectly imported CapabilityStatements SHALL NOT overlap
-	Imports []string `json:"imports,omitempty"`
+	Imports        []string          `json:"imports,omitempty"`
+	ImportsElement []*common.Element `json:"_imports,omitempty"`
 
 	// HL7 defines the following Services: Terminology Service
-	Instantiates []string `json:"instantiates,omitempty"`
+	Instantiates        []string          `json:"instantiates,omitempty"`
+	InstantiatesElement []*common.Element `json:"_instantiates,omitempty"`
 
 	// It may be possible for the capability statement to be used in jurisdictions other than those for which it was originally designed
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The way that this statement is intended to be used, to describe an actual running instance of software
-	Kind CapabilityStatementKind `json:"kind"`
+	Kind        CapabilityStatementKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
 
 	// Multiple repetitions allow the documentation of multiple endpoints per solution
 	Messaging []CapabilityStatementMessaging `json:"messaging,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// At present, the patch mime types application/json-patch+json and application/xml-patch+xml are legal
-	PatchFormat []string `json:"patchFormat,omitempty"`
+	PatchFormat        []string          `json:"patchFormat,omitempty"`
+	PatchFormatElement []*common.Element `json:"_patchFormat,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the capability statement
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Multiple repetitions allow definition of both client and/or server behaviors
 	Rest []CapabilityStatementRest `json:"rest,omitempty"`
@@ -412,21 +492,26 @@ type CapabilityStatement struct {
 	Software *CapabilityStatementSoftware `json:"software,omitempty"`
 
 	// Allows filtering of capability statements that are appropriate for use versus not
-	Status CapabilityStatementStatus `json:"status"`
+	Status        CapabilityStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc.
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different capability statement instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
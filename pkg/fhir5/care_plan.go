@@ -69,13 +69,15 @@ type CarePlan struct {
 	Contributor []common.Reference `json:"contributor,omitempty"`
 
 	// Represents when this particular CarePlan record was created in the system
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// The custodian might or might not be a contributor
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// A description of the scope and nature of the plan
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This will typically be the encounter the event occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -87,13 +89,16 @@ type CarePlan struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, questionnaire or other definition that is adhered to in whole or in part
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// This element is labeled as a modifier because the intent alters when and how the resource is actually applicable
-	Intent CarePlanIntent `json:"intent"`
+	Intent        CarePlanIntent  `json:"intent"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
 
 	// General notes about the care plan not covered elsewhere
 	Note []Annotation `json:"note,omitempty"`
@@ -108,7 +113,8 @@ type CarePlan struct {
 	Replaces []common.Reference `json:"replaces,omitempty"`
 
 	// The unknown code is not to be used to convey other statuses
-	Status CarePlanStatus `json:"status"`
+	Status        CarePlanStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Identifies the patient or group whose intended care is described by the plan
 	Subject common.Reference `json:"subject"`
@@ -117,5 +123,6 @@ type CarePlan struct {
 	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
 
 	// Human-friendly name for the care plan
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
@@ -53,7 +53,8 @@ type CareTeam struct {
 	ManagingOrganization []common.Reference `json:"managingOrganization,omitempty"`
 
 	// The meaning/purpose of the team is conveyed in CareTeam.category
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Comments made about the CareTeam
 	Note []Annotation `json:"note,omitempty"`
@@ -68,7 +69,8 @@ type CareTeam struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the code entered-in-error
-	Status *CareTeamStatus `json:"status,omitempty"`
+	Status        *CareTeamStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Use Group for care provision to all members of the group (e.g. group therapy)
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -41,10 +41,12 @@ type MonetaryComponent struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Factor used for calculating this component
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// base | surcharge | deduction | discount | tax | informational
-	Type MonetaryComponentType `json:"type"`
+	Type        MonetaryComponentType `json:"type"`
+	TypeElement *common.Element       `json:"_type,omitempty"`
 }
 
 // ChargeItemPerformer represents who performed or participated in the charged service
@@ -78,16 +80,19 @@ type ChargeItem struct {
 	CostCenter *common.Reference `json:"costCenter,omitempty"`
 
 	// References the source of pricing information, rules of application for the code this ChargeItem uses
-	DefinitionCanonical []string `json:"definitionCanonical,omitempty"`
+	DefinitionCanonical        []string          `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement []*common.Element `json:"_definitionCanonical,omitempty"`
 
 	// References the (external) source of pricing information, rules of application for the code this ChargeItem uses
-	DefinitionUri []string `json:"definitionUri,omitempty"`
+	DefinitionUri        []string          `json:"definitionUri,omitempty"`
+	DefinitionUriElement []*common.Element `json:"_definitionUri,omitempty"`
 
 	// This ChargeItem may be recorded during planning, execution or after the actual encounter takes place
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// The actual date when the service associated with the charge has been rendered is captured in occurrence[x]
-	EnteredDate *string `json:"enteredDate,omitempty"`
+	EnteredDate        *common.DateTime `json:"enteredDate,omitempty"`
+	EnteredDateElement *common.Element  `json:"_enteredDate,omitempty"`
 
 	// The enterer is also the person considered responsible for factor/price overrides if applicable
 	Enterer *common.Reference `json:"enterer,omitempty"`
@@ -99,9 +104,10 @@ type ChargeItem struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// The list of types may be constrained as appropriate for the type of charge item
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// If the list price or the rule-based factor associated with the code is overridden
 	OverrideReason *common.CodeableConcept `json:"overrideReason,omitempty"`
@@ -131,7 +137,8 @@ type ChargeItem struct {
 	Service []CodeableReference `json:"service,omitempty"`
 
 	// Unknown does not represent "other" - one of the defined statuses must apply
-	Status ChargeItemStatus `json:"status"`
+	Status        ChargeItemStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 
 	// The individual or set of individuals the action is being or was performed on
 	Subject common.Reference `json:"subject"`
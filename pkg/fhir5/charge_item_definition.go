@@ -41,7 +41,8 @@ type ChargeItemDefinition struct {
 	Applicability []ChargeItemDefinitionApplicability `json:"applicability,omitempty"`
 
 	// The 'date' element may be more recent than the approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// The defined billing details in this resource pertain to the given billing code
 	Code *common.CodeableConcept `json:"code,omitempty"`
@@ -50,22 +51,28 @@ type ChargeItemDefinition struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the charge item definition
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The URL pointing to an externally-defined charge item definition
-	DerivedFromUri []string `json:"derivedFromUri,omitempty"`
+	DerivedFromUri        []string          `json:"derivedFromUri,omitempty"`
+	DerivedFromUriElement []*common.Element `json:"_derivedFromUri,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of charge item definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -77,43 +84,54 @@ type ChargeItemDefinition struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A larger definition of which this particular definition is a component or step
-	PartOf []string `json:"partOf,omitempty"`
+	PartOf        []string          `json:"partOf,omitempty"`
+	PartOfElement []*common.Element `json:"_partOf,omitempty"`
 
 	// Group of properties which are applicable under the same conditions
 	PropertyGroup []ChargeItemDefinitionPropertyGroup `json:"propertyGroup,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the charge item definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// As new versions of a protocol or guideline are defined, allows identification of what versions are replaced
-	Replaces []string `json:"replaces,omitempty"`
+	Replaces        []string          `json:"replaces,omitempty"`
+	ReplacesElement []*common.Element `json:"_replaces,omitempty"`
 
 	// Allows filtering of charge item definitions that are appropriate for use versus not
-	Status ChargeItemDefinitionStatus `json:"status"`
+	Status        ChargeItemDefinitionStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different charge item definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression
 	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
@@ -0,0 +1,110 @@
+package fhir5
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+func TestChoice_Accessors(t *testing.T) {
+	var observation Observation
+	if value, typeName := observation.Value(); value != nil || typeName != "" {
+		t.Fatalf("expected no value, got %v (%s)", value, typeName)
+	}
+
+	quantity := &common.Quantity{Unit: StringPtr("mmHg")}
+	if err := observation.SetValue(quantity); err != nil {
+		t.Fatalf("failed to set quantity: %v", err)
+	}
+	if value, typeName := observation.Value(); value != quantity || typeName != "Quantity" {
+		t.Errorf("expected quantity, got %v (%s)", value, typeName)
+	}
+
+	observation.ValueStringElement = &common.Element{ID: StringPtr("s")}
+	if err := observation.SetValue(StringPtr("high")); err != nil {
+		t.Fatalf("failed to set string: %v", err)
+	}
+	if observation.ValueQuantity != nil {
+		t.Error("expected quantity to be cleared")
+	}
+	if value, typeName := observation.Value(); *value.(*string) != "high" || typeName != "String" {
+		t.Errorf("expected string, got %v (%s)", value, typeName)
+	}
+
+	if err := observation.SetValue(42); err == nil {
+		t.Error("expected error for int value")
+	}
+	if err := observation.SetValueAs("Quantity", StringPtr("x")); err == nil {
+		t.Error("expected error for mismatching type name")
+	}
+	if err := observation.SetValue(nil); err != nil || observation.ValueString != nil || observation.ValueStringElement != nil {
+		t.Errorf("expected value to be cleared, got %v", err)
+	}
+
+	var extension common.Extension
+	if err := extension.SetValueAs("Code", StringPtr("final")); err != nil {
+		t.Fatalf("failed to set code: %v", err)
+	}
+	if _, typeName := extension.Value(); typeName != "Code" {
+		t.Errorf("expected code, got %s", typeName)
+	}
+}
+
+func TestChoice_Validate(t *testing.T) {
+	observation := &Observation{
+		EffectiveDateTime: common.DateTimePtr(common.MustParseDateTime("2024-01-01")),
+		EffectivePeriod:   &common.Period{},
+		Component: []ObservationComponent{
+			{ValueString: StringPtr("a")},
+			{ValueString: StringPtr("a"), ValueBoolean: BoolPtr(true)},
+		},
+	}
+	bundle := &Bundle{Type: BundleTypeCollection, Entry: []BundleEntry{{Resource: observation}}}
+
+	err := common.ValidateChoices(bundle)
+	if err == nil {
+		t.Fatal("expected choice errors")
+	}
+	var paths []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var choiceErr *common.ChoiceError
+		if !errors.As(e, &choiceErr) {
+			t.Fatalf("unexpected error %v", e)
+		}
+		paths = append(paths, choiceErr.Path)
+	}
+	want := []string{"Bundle.entry[0].resource.effective[x]", "Bundle.entry[0].resource.component[1].value[x]"}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("got paths %q, want %q", paths, want)
+	}
+}
+
+func TestChoice_Examples(t *testing.T) {
+	files, err := filepath.Glob("testdata/fhir5-json/*.json")
+	if err != nil {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		var probe struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if json.Unmarshal(data, &probe) != nil {
+			continue
+		}
+		resource, err := UnmarshalResource(data)
+		if err != nil {
+			continue
+		}
+		if err := common.ValidateChoices(resource); err != nil {
+			t.Errorf("%s: %v", filepath.Base(file), err)
+		}
+	}
+}
@@ -13,7 +13,8 @@ type CitationSummary struct {
 	Style *common.CodeableConcept `json:"style,omitempty"`
 
 	// The human-readable display of the citation summary
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // CitationClassification represents classification of the citation record
@@ -35,7 +36,8 @@ type CitationStatusDate struct {
 	Activity common.CodeableConcept `json:"activity"`
 
 	// Whether the status date is actual (has occurred) or expected (estimated or anticipated)
-	Actual *bool `json:"actual,omitempty"`
+	Actual        *bool           `json:"actual,omitempty"`
+	ActualElement *common.Element `json:"_actual,omitempty"`
 
 	// For an instance, place the same value in both start and end elements
 	Period common.Period `json:"period"`
@@ -49,7 +51,8 @@ type CitationCitedArtifactVersion struct {
 	BaseCitation *common.Reference `json:"baseCitation,omitempty"`
 
 	// The version number or other version identifier
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // CitationCitedArtifact represents the article or artifact being described
@@ -101,7 +104,8 @@ type CitationCitedArtifactTitle struct {
 	Language *common.CodeableConcept `json:"language,omitempty"`
 
 	// The title of the article or artifact
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // CitationCitedArtifactAbstract represents summary of the article or artifact
@@ -112,10 +116,12 @@ type CitationCitedArtifactAbstract struct {
 	Language *common.CodeableConcept `json:"language,omitempty"`
 
 	// Copyright holder for this abstract
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Abstract content
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Used for the display of the abstract
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -129,7 +135,8 @@ type CitationCitedArtifactPart struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// The specification of the component
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 
 	// The citation for the full article or artifact
 	BaseCitation *common.Reference `json:"baseCitation,omitempty"`
@@ -146,31 +153,39 @@ type CitationCitedArtifactPublicationForm struct {
 	PeriodicRelease *CitationCitedArtifactPublicationFormPeriodicRelease `json:"periodicRelease,omitempty"`
 
 	// The date the article was added to the database
-	ArticleDate *string `json:"articleDate,omitempty"`
+	ArticleDate        *common.DateTime `json:"articleDate,omitempty"`
+	ArticleDateElement *common.Element  `json:"_articleDate,omitempty"`
 
 	// The date the article was last revised or updated in the database
-	LastRevisionDate *string `json:"lastRevisionDate,omitempty"`
+	LastRevisionDate        *common.DateTime `json:"lastRevisionDate,omitempty"`
+	LastRevisionDateElement *common.Element  `json:"_lastRevisionDate,omitempty"`
 
 	// Language in which the form is written
 	Language []common.CodeableConcept `json:"language,omitempty"`
 
 	// The accession number for the publication
-	AccessionNumber *string `json:"accessionNumber,omitempty"`
+	AccessionNumber        *string         `json:"accessionNumber,omitempty"`
+	AccessionNumberElement *common.Element `json:"_accessionNumber,omitempty"`
 
 	// The page numbers of the publication
-	PageString *string `json:"pageString,omitempty"`
+	PageString        *string         `json:"pageString,omitempty"`
+	PageStringElement *common.Element `json:"_pageString,omitempty"`
 
 	// The page numbers of the publication
-	FirstPage *string `json:"firstPage,omitempty"`
+	FirstPage        *string         `json:"firstPage,omitempty"`
+	FirstPageElement *common.Element `json:"_firstPage,omitempty"`
 
 	// The page numbers of the publication
-	LastPage *string `json:"lastPage,omitempty"`
+	LastPage        *string         `json:"lastPage,omitempty"`
+	LastPageElement *common.Element `json:"_lastPage,omitempty"`
 
 	// The page numbers of the publication
-	PageCount *string `json:"pageCount,omitempty"`
+	PageCount        *string         `json:"pageCount,omitempty"`
+	PageCountElement *common.Element `json:"_pageCount,omitempty"`
 
 	// Copyright notice for the full article or artifact
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 }
 
 // CitationCitedArtifactPublicationFormPublishedIn represents the collection the cited article is published in
@@ -181,19 +196,23 @@ type CitationCitedArtifactPublicationFormPublishedIn struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// Name of the database or title of the book or journal
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Name of the publisher
 	Publisher *common.Reference `json:"publisher,omitempty"`
 
 	// Geographic location of the publisher
-	PublisherLocation *string `json:"publisherLocation,omitempty"`
+	PublisherLocation        *string         `json:"publisherLocation,omitempty"`
+	PublisherLocationElement *common.Element `json:"_publisherLocation,omitempty"`
 
 	// When the article was published
-	PublicationDate *string `json:"publicationDate,omitempty"`
+	PublicationDate        *common.Date    `json:"publicationDate,omitempty"`
+	PublicationDateElement *common.Element `json:"_publicationDate,omitempty"`
 
 	// When the article was published
-	PublicationDateString *string `json:"publicationDateString,omitempty"`
+	PublicationDateString        *string         `json:"publicationDateString,omitempty"`
+	PublicationDateStringElement *common.Element `json:"_publicationDateString,omitempty"`
 }
 
 // CitationCitedArtifactPublicationFormPeriodicRelease represents the specific issue in which the cited article resides
@@ -204,10 +223,12 @@ type CitationCitedArtifactPublicationFormPeriodicRelease struct {
 	CitedMedium *common.CodeableConcept `json:"citedMedium,omitempty"`
 
 	// Volume number of journal in which the article is published
-	Volume *string `json:"volume,omitempty"`
+	Volume        *string         `json:"volume,omitempty"`
+	VolumeElement *common.Element `json:"_volume,omitempty"`
 
 	// Issue, part or supplement of journal in which the article is published
-	Issue *string `json:"issue,omitempty"`
+	Issue        *string         `json:"issue,omitempty"`
+	IssueElement *common.Element `json:"_issue,omitempty"`
 
 	// Date of publication of the issue
 	DateOfPublication *CitationCitedArtifactPublicationFormPeriodicReleaseDateOfPublication `json:"dateOfPublication,omitempty"`
@@ -218,22 +239,28 @@ type CitationCitedArtifactPublicationFormPeriodicReleaseDateOfPublication struct
 	common.BackboneElement
 
 	// Date on which the issue of the journal was published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Year on which the issue of the journal was published
-	Year *string `json:"year,omitempty"`
+	Year        *string         `json:"year,omitempty"`
+	YearElement *common.Element `json:"_year,omitempty"`
 
 	// Month on which the issue of the journal was published
-	Month *string `json:"month,omitempty"`
+	Month        *string         `json:"month,omitempty"`
+	MonthElement *common.Element `json:"_month,omitempty"`
 
 	// Day on which the issue of the journal was published
-	Day *string `json:"day,omitempty"`
+	Day        *string         `json:"day,omitempty"`
+	DayElement *common.Element `json:"_day,omitempty"`
 
 	// Spring, Summer, Fall/Autumn, Winter
-	Season *string `json:"season,omitempty"`
+	Season        *string         `json:"season,omitempty"`
+	SeasonElement *common.Element `json:"_season,omitempty"`
 
 	// Text representation of the date of which the issue of the journal was published
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // CitationCitedArtifactWebLocation represents the web location of the cited artifact
@@ -244,7 +271,8 @@ type CitationCitedArtifactWebLocation struct {
 	Classifier []common.CodeableConcept `json:"classifier,omitempty"`
 
 	// The specific URL
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // CitationCitedArtifactClassification represents the classification of the cited artifact
@@ -263,7 +291,8 @@ type CitationCitedArtifactContributorship struct {
 	common.BackboneElement
 
 	// Indicates if the list includes all authors and/or contributors
-	Complete *bool `json:"complete,omitempty"`
+	Complete        *bool           `json:"complete,omitempty"`
+	CompleteElement *common.Element `json:"_complete,omitempty"`
 
 	// An individual entity named as a contributor
 	Entry []CitationCitedArtifactContributorshipEntry `json:"entry,omitempty"`
@@ -280,13 +309,16 @@ type CitationCitedArtifactContributorshipEntry struct {
 	Contributor *common.Reference `json:"contributor,omitempty"`
 
 	// For citation of contributorship list where the order is important
-	ForenameInitials *string `json:"forenameInitials,omitempty"`
+	ForenameInitials        *string         `json:"forenameInitials,omitempty"`
+	ForenameInitialsElement *common.Element `json:"_forenameInitials,omitempty"`
 
 	// For citation of contributorship list where the order is important
-	SurnameInitials *string `json:"surnameInitials,omitempty"`
+	SurnameInitials        *string         `json:"surnameInitials,omitempty"`
+	SurnameInitialsElement *common.Element `json:"_surnameInitials,omitempty"`
 
 	// The initials for forename if used to disambiguate the contributor
-	Initials *string `json:"initials,omitempty"`
+	Initials        *string         `json:"initials,omitempty"`
+	InitialsElement *common.Element `json:"_initials,omitempty"`
 
 	// Used to code contribution based on appropriate role taxonomy
 	ContributionType []common.CodeableConcept `json:"contributionType,omitempty"`
@@ -298,10 +330,12 @@ type CitationCitedArtifactContributorshipEntry struct {
 	ContributionInstance []CitationCitedArtifactContributorshipEntryContributionInstance `json:"contributionInstance,omitempty"`
 
 	// Indication of which contributor is the corresponding contributor for the role
-	CorrespondingContact *bool `json:"correspondingContact,omitempty"`
+	CorrespondingContact        *bool           `json:"correspondingContact,omitempty"`
+	CorrespondingContactElement *common.Element `json:"_correspondingContact,omitempty"`
 
 	// Ranked order of contribution e.g. 1, 2, 3, etc
-	ListOrder *int `json:"listOrder,omitempty"`
+	ListOrder        *int            `json:"listOrder,omitempty"`
+	ListOrderElement *common.Element `json:"_listOrder,omitempty"`
 }
 
 // CitationCitedArtifactContributorshipEntryContributionInstance represents contributions with accounting for time or number
@@ -309,13 +343,15 @@ type CitationCitedArtifactContributorshipEntryContributionInstance struct {
 	common.BackboneElement
 
 	// The specific time
-	Time *string `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 
 	// The amount of time
 	TimeRange *common.Period `json:"timeRange,omitempty"`
 
 	// The number of occurrences
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// The number of occurrences
 	CountRange *Range `json:"countRange,omitempty"`
@@ -338,7 +374,8 @@ type CitationCitedArtifactContributorshipSummary struct {
 	Source *common.CodeableConcept `json:"source,omitempty"`
 
 	// The display string for the author list, contributor list, or contributorship statement
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // CitationCitedArtifactRelatesTo represents the related artifacts
@@ -352,7 +389,8 @@ type CitationCitedArtifactRelatesTo struct {
 	TargetClassifier []common.CodeableConcept `json:"targetClassifier,omitempty"`
 
 	// The article or artifact that the cited artifact is related to
-	TargetUri *string `json:"targetUri,omitempty"`
+	TargetUri        *string         `json:"targetUri,omitempty"`
+	TargetUriElement *common.Element `json:"_targetUri,omitempty"`
 
 	// The article or artifact that the cited artifact is related to
 	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
@@ -372,7 +410,8 @@ type Citation struct {
 	ResourceType string `json:"resourceType"` // Always "Citation"
 
 	// The 'date' element may be more recent than the approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// The Citation.author element is structured to support a name and contact point information
 	Author []ContactDetail `json:"author,omitempty"`
@@ -387,19 +426,23 @@ type Citation struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Use and/or publishing restrictions for the citation record
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Use this if needed for reporting the state or status of the citation record
 	CurrentState []common.CodeableConcept `json:"currentState,omitempty"`
 
 	// The date is often not tracked until the resource is published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The Citation.editor element is structured to support a name and contact point information
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -411,7 +454,8 @@ type Citation struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of citation records that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Use this element if you need to identify the citation record independently
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -420,19 +464,23 @@ type Citation struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Used for general notes and annotations not coded elsewhere
 	Note []Annotation `json:"note,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the citation
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Use this if needed for reporting artifacts related to the citation record
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -441,7 +489,8 @@ type Citation struct {
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// Allows filtering of summaries that are appropriate for use versus not
-	Status CitationStatus `json:"status"`
+	Status        CitationStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Use this if needed for reporting the state or status of the citation record
 	StatusDate []CitationStatusDate `json:"statusDate,omitempty"`
@@ -450,19 +499,23 @@ type Citation struct {
 	Summary []CitationSummary `json:"summary,omitempty"`
 
 	// This name does not need to be machine-processing friendly
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// In some cases, the resource can no longer be found at the stated url
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different citation record instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression
 	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
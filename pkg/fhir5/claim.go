@@ -13,12 +13,15 @@ type Claim struct {
 	ResourceType         string                   `json:"resourceType"` // Always "Claim"
 	Identifier           []common.Identifier      `json:"identifier,omitempty"`
 	Status               ClaimStatus              `json:"status"`
+	StatusElement        *common.Element          `json:"_status,omitempty"`
 	Type                 common.CodeableConcept   `json:"type"`
 	SubType              []common.CodeableConcept `json:"subType,omitempty"`
 	Use                  ClaimUse                 `json:"use"`
+	UseElement           *common.Element          `json:"_use,omitempty"`
 	Patient              common.Reference         `json:"patient"`
 	BillablePeriod       *common.Period           `json:"billablePeriod,omitempty"`
-	Created              string                   `json:"created"`
+	Created              common.DateTime          `json:"created"`
+	CreatedElement       *common.Element          `json:"_created,omitempty"`
 	Enterer              *common.Reference        `json:"enterer,omitempty"`
 	Insurer              *common.Reference        `json:"insurer,omitempty"`
 	Provider             common.Reference         `json:"provider"`
@@ -72,31 +75,38 @@ type ClaimPayee struct {
 
 type ClaimCareTeam struct {
 	common.BackboneElement
-	Sequence      int                     `json:"sequence"`
-	Provider      common.Reference        `json:"provider"`
-	Responsible   *bool                   `json:"responsible,omitempty"`
-	Role          *common.CodeableConcept `json:"role,omitempty"`
-	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
+	Sequence           int                     `json:"sequence"`
+	SequenceElement    *common.Element         `json:"_sequence,omitempty"`
+	Provider           common.Reference        `json:"provider"`
+	Responsible        *bool                   `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element         `json:"_responsible,omitempty"`
+	Role               *common.CodeableConcept `json:"role,omitempty"`
+	Qualification      *common.CodeableConcept `json:"qualification,omitempty"`
 }
 
 type ClaimSupportingInfo struct {
 	common.BackboneElement
-	Sequence        int                     `json:"sequence"`
-	Category        common.CodeableConcept  `json:"category"`
-	Code            *common.CodeableConcept `json:"code,omitempty"`
-	TimingDate      *string                 `json:"timingDate,omitempty"`
-	TimingPeriod    *common.Period          `json:"timingPeriod,omitempty"`
-	ValueBoolean    *bool                   `json:"valueBoolean,omitempty"`
-	ValueString     *string                 `json:"valueString,omitempty"`
-	ValueQuantity   *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueAttachment *Attachment             `json:"valueAttachment,omitempty"`
-	ValueReference  *common.Reference       `json:"valueReference,omitempty"`
-	Reason          *common.CodeableConcept `json:"reason,omitempty"`
+	Sequence            int                     `json:"sequence"`
+	SequenceElement     *common.Element         `json:"_sequence,omitempty"`
+	Category            common.CodeableConcept  `json:"category"`
+	Code                *common.CodeableConcept `json:"code,omitempty"`
+	TimingDate          *common.Date            `json:"timingDate,omitempty"`
+	TimingDateElement   *common.Element         `json:"_timingDate,omitempty"`
+	TimingPeriod        *common.Period          `json:"timingPeriod,omitempty"`
+	ValueBoolean        *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element         `json:"_valueBoolean,omitempty"`
+	ValueString         *string                 `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element         `json:"_valueString,omitempty"`
+	ValueQuantity       *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueAttachment     *Attachment             `json:"valueAttachment,omitempty"`
+	ValueReference      *common.Reference       `json:"valueReference,omitempty"`
+	Reason              *common.CodeableConcept `json:"reason,omitempty"`
 }
 
 type ClaimDiagnosis struct {
 	common.BackboneElement
 	Sequence                 int                      `json:"sequence"`
+	SequenceElement          *common.Element          `json:"_sequence,omitempty"`
 	DiagnosisCodeableConcept *common.CodeableConcept  `json:"diagnosisCodeableConcept,omitempty"`
 	DiagnosisReference       *common.Reference        `json:"diagnosisReference,omitempty"`
 	Type                     []common.CodeableConcept `json:"type,omitempty"`
@@ -107,26 +117,33 @@ type ClaimDiagnosis struct {
 type ClaimProcedure struct {
 	common.BackboneElement
 	Sequence                 int                      `json:"sequence"`
+	SequenceElement          *common.Element          `json:"_sequence,omitempty"`
 	Type                     []common.CodeableConcept `json:"type,omitempty"`
-	Date                     *string                  `json:"date,omitempty"`
+	Date                     *common.DateTime         `json:"date,omitempty"`
+	DateElement              *common.Element          `json:"_date,omitempty"`
 	ProcedureCodeableConcept *common.CodeableConcept  `json:"procedureCodeableConcept,omitempty"`
 	ProcedureReference       *common.Reference        `json:"procedureReference,omitempty"`
 }
 
 type ClaimInsurance struct {
 	common.BackboneElement
-	Sequence            int                `json:"sequence"`
-	Focal               bool               `json:"focal"`
-	Identifier          *common.Identifier `json:"identifier,omitempty"`
-	Coverage            common.Reference   `json:"coverage"`
-	BusinessArrangement *string            `json:"businessArrangement,omitempty"`
-	PreAuthRef          []string           `json:"preAuthRef,omitempty"`
-	ClaimResponse       *common.Reference  `json:"claimResponse,omitempty"`
+	Sequence                   int                `json:"sequence"`
+	SequenceElement            *common.Element    `json:"_sequence,omitempty"`
+	Focal                      bool               `json:"focal"`
+	FocalElement               *common.Element    `json:"_focal,omitempty"`
+	Identifier                 *common.Identifier `json:"identifier,omitempty"`
+	Coverage                   common.Reference   `json:"coverage"`
+	BusinessArrangement        *string            `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element    `json:"_businessArrangement,omitempty"`
+	PreAuthRef                 []string           `json:"preAuthRef,omitempty"`
+	PreAuthRefElement          []*common.Element  `json:"_preAuthRef,omitempty"`
+	ClaimResponse              *common.Reference  `json:"claimResponse,omitempty"`
 }
 
 type ClaimAccident struct {
 	common.BackboneElement
-	Date              string                  `json:"date"`
+	Date              common.Date             `json:"date"`
+	DateElement       *common.Element         `json:"_date,omitempty"`
 	Type              *common.CodeableConcept `json:"type,omitempty"`
 	LocationAddress   *Address                `json:"locationAddress,omitempty"`
 	LocationReference *common.Reference       `json:"locationReference,omitempty"`
@@ -134,37 +151,45 @@ type ClaimAccident struct {
 
 type ClaimItem struct {
 	common.BackboneElement
-	Sequence                int                      `json:"sequence"`
-	CareTeamSequence        []int                    `json:"careTeamSequence,omitempty"`
-	DiagnosisSequence       []int                    `json:"diagnosisSequence,omitempty"`
-	ProcedureSequence       []int                    `json:"procedureSequence,omitempty"`
-	InformationSequence     []int                    `json:"informationSequence,omitempty"`
-	Revenue                 *common.CodeableConcept  `json:"revenue,omitempty"`
-	Category                *common.CodeableConcept  `json:"category,omitempty"`
-	ProductOrService        common.CodeableConcept   `json:"productOrService"`
-	ProductOrServiceEnd     *common.CodeableConcept  `json:"productOrServiceEnd,omitempty"`
-	Modifier                []common.CodeableConcept `json:"modifier,omitempty"`
-	ProgramCode             []common.CodeableConcept `json:"programCode,omitempty"`
-	ServicedDate            *string                  `json:"servicedDate,omitempty"`
-	ServicedPeriod          *common.Period           `json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *common.CodeableConcept  `json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *Address                 `json:"locationAddress,omitempty"`
-	LocationReference       *common.Reference        `json:"locationReference,omitempty"`
-	Quantity                *common.Quantity         `json:"quantity,omitempty"`
-	UnitPrice               *Money                   `json:"unitPrice,omitempty"`
-	Factor                  *float64                 `json:"factor,omitempty"`
-	Tax                     *Money                   `json:"tax,omitempty"`
-	Net                     *Money                   `json:"net,omitempty"`
-	Udi                     []common.Reference       `json:"udi,omitempty"`
-	BodySite                *common.CodeableConcept  `json:"bodySite,omitempty"`
-	SubSite                 []common.CodeableConcept `json:"subSite,omitempty"`
-	Encounter               []common.Reference       `json:"encounter,omitempty"`
-	Detail                  []ClaimItemDetail        `json:"detail,omitempty"`
+	Sequence                   int                      `json:"sequence"`
+	SequenceElement            *common.Element          `json:"_sequence,omitempty"`
+	CareTeamSequence           []int                    `json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement    []*common.Element        `json:"_careTeamSequence,omitempty"`
+	DiagnosisSequence          []int                    `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement   []*common.Element        `json:"_diagnosisSequence,omitempty"`
+	ProcedureSequence          []int                    `json:"procedureSequence,omitempty"`
+	ProcedureSequenceElement   []*common.Element        `json:"_procedureSequence,omitempty"`
+	InformationSequence        []int                    `json:"informationSequence,omitempty"`
+	InformationSequenceElement []*common.Element        `json:"_informationSequence,omitempty"`
+	Revenue                    *common.CodeableConcept  `json:"revenue,omitempty"`
+	Category                   *common.CodeableConcept  `json:"category,omitempty"`
+	ProductOrService           common.CodeableConcept   `json:"productOrService"`
+	ProductOrServiceEnd        *common.CodeableConcept  `json:"productOrServiceEnd,omitempty"`
+	Modifier                   []common.CodeableConcept `json:"modifier,omitempty"`
+	ProgramCode                []common.CodeableConcept `json:"programCode,omitempty"`
+	ServicedDate               *common.Date             `json:"servicedDate,omitempty"`
+	ServicedDateElement        *common.Element          `json:"_servicedDate,omitempty"`
+	ServicedPeriod             *common.Period           `json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept    *common.CodeableConcept  `json:"locationCodeableConcept,omitempty"`
+	LocationAddress            *Address                 `json:"locationAddress,omitempty"`
+	LocationReference          *common.Reference        `json:"locationReference,omitempty"`
+	Quantity                   *common.Quantity         `json:"quantity,omitempty"`
+	UnitPrice                  *Money                   `json:"unitPrice,omitempty"`
+	Factor                     *common.Decimal          `json:"factor,omitempty"`
+	FactorElement              *common.Element          `json:"_factor,omitempty"`
+	Tax                        *Money                   `json:"tax,omitempty"`
+	Net                        *Money                   `json:"net,omitempty"`
+	Udi                        []common.Reference       `json:"udi,omitempty"`
+	BodySite                   *common.CodeableConcept  `json:"bodySite,omitempty"`
+	SubSite                    []common.CodeableConcept `json:"subSite,omitempty"`
+	Encounter                  []common.Reference       `json:"encounter,omitempty"`
+	Detail                     []ClaimItemDetail        `json:"detail,omitempty"`
 }
 
 type ClaimItemDetail struct {
 	common.BackboneElement
 	Sequence            int                        `json:"sequence"`
+	SequenceElement     *common.Element            `json:"_sequence,omitempty"`
 	Revenue             *common.CodeableConcept    `json:"revenue,omitempty"`
 	Category            *common.CodeableConcept    `json:"category,omitempty"`
 	ProductOrService    common.CodeableConcept     `json:"productOrService"`
@@ -173,17 +198,20 @@ type ClaimItemDetail struct {
 	ProgramCode         []common.CodeableConcept   `json:"programCode,omitempty"`
 	Quantity            *common.Quantity           `json:"quantity,omitempty"`
 	UnitPrice           *Money                     `json:"unitPrice,omitempty"`
-	Factor              *float64                   `json:"factor,omitempty"`
+	Factor              *common.Decimal            `json:"factor,omitempty"`
+	FactorElement       *common.Element            `json:"_factor,omitempty"`
 	Tax                 *Money                     `json:"tax,omitempty"`
 	Net                 *Money                     `json:"net,omitempty"`
 	Udi                 []common.Reference         `json:"udi,omitempty"`
 	NoteNumber          []int                      `json:"noteNumber,omitempty"`
+	NoteNumberElement   []*common.Element          `json:"_noteNumber,omitempty"`
 	SubDetail           []ClaimItemDetailSubDetail `json:"subDetail,omitempty"`
 }
 
 type ClaimItemDetailSubDetail struct {
 	common.BackboneElement
 	Sequence            int                      `json:"sequence"`
+	SequenceElement     *common.Element          `json:"_sequence,omitempty"`
 	Revenue             *common.CodeableConcept  `json:"revenue,omitempty"`
 	Category            *common.CodeableConcept  `json:"category,omitempty"`
 	ProductOrService    common.CodeableConcept   `json:"productOrService"`
@@ -192,9 +220,11 @@ type ClaimItemDetailSubDetail struct {
 	ProgramCode         []common.CodeableConcept `json:"programCode,omitempty"`
 	Quantity            *common.Quantity         `json:"quantity,omitempty"`
 	UnitPrice           *Money                   `json:"unitPrice,omitempty"`
-	Factor              *float64                 `json:"factor,omitempty"`
+	Factor              *common.Decimal          `json:"factor,omitempty"`
+	FactorElement       *common.Element          `json:"_factor,omitempty"`
 	Tax                 *Money                   `json:"tax,omitempty"`
 	Net                 *Money                   `json:"net,omitempty"`
 	Udi                 []common.Reference       `json:"udi,omitempty"`
 	NoteNumber          []int                    `json:"noteNumber,omitempty"`
+	NoteNumberElement   []*common.Element        `json:"_noteNumber,omitempty"`
 }
@@ -12,17 +12,23 @@ type ClaimResponse struct {
 	ResourceType         string                      `json:"resourceType"` // Always "ClaimResponse"
 	Identifier           []common.Identifier         `json:"identifier,omitempty"`
 	Status               ClaimResponseStatus         `json:"status"`
+	StatusElement        *common.Element             `json:"_status,omitempty"`
 	Type                 common.CodeableConcept      `json:"type"`
 	SubType              *common.CodeableConcept     `json:"subType,omitempty"`
 	Use                  ClaimResponseUse            `json:"use"`
+	UseElement           *common.Element             `json:"_use,omitempty"`
 	Patient              common.Reference            `json:"patient"`
-	Created              string                      `json:"created"`
+	Created              common.DateTime             `json:"created"`
+	CreatedElement       *common.Element             `json:"_created,omitempty"`
 	Insurer              common.Reference            `json:"insurer"`
 	Requestor            *common.Reference           `json:"requestor,omitempty"`
 	Request              *common.Reference           `json:"request,omitempty"`
 	Outcome              ClaimResponseOutcome        `json:"outcome"`
+	OutcomeElement       *common.Element             `json:"_outcome,omitempty"`
 	Disposition          *string                     `json:"disposition,omitempty"`
+	DispositionElement   *common.Element             `json:"_disposition,omitempty"`
 	PreAuthRef           *string                     `json:"preAuthRef,omitempty"`
+	PreAuthRefElement    *common.Element             `json:"_preAuthRef,omitempty"`
 	PreAuthPeriod        *common.Period              `json:"preAuthPeriod,omitempty"`
 	PayeeType            *common.CodeableConcept     `json:"payeeType,omitempty"`
 	Item                 []ClaimResponseItem         `json:"item,omitempty"`
@@ -67,18 +73,22 @@ const (
 
 type ClaimResponseItem struct {
 	common.BackboneElement
-	ItemSequence int                         `json:"itemSequence"`
-	NoteNumber   []int                       `json:"noteNumber,omitempty"`
-	Adjudication []ClaimResponseAdjudication `json:"adjudication,omitempty"`
-	Detail       []ClaimResponseItemDetail   `json:"detail,omitempty"`
+	ItemSequence        int                         `json:"itemSequence"`
+	ItemSequenceElement *common.Element             `json:"_itemSequence,omitempty"`
+	NoteNumber          []int                       `json:"noteNumber,omitempty"`
+	NoteNumberElement   []*common.Element           `json:"_noteNumber,omitempty"`
+	Adjudication        []ClaimResponseAdjudication `json:"adjudication,omitempty"`
+	Detail              []ClaimResponseItemDetail   `json:"detail,omitempty"`
 }
 
 type ClaimResponseItemDetail struct {
 	common.BackboneElement
-	DetailSequence int                                `json:"detailSequence"`
-	NoteNumber     []int                              `json:"noteNumber,omitempty"`
-	Adjudication   []ClaimResponseAdjudication        `json:"adjudication,omitempty"`
-	SubDetail      []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty"`
+	DetailSequence        int                                `json:"detailSequence"`
+	DetailSequenceElement *common.Element                    `json:"_detailSequence,omitempty"`
+	NoteNumber            []int                              `json:"noteNumber,omitempty"`
+	NoteNumberElement     []*common.Element                  `json:"_noteNumber,omitempty"`
+	Adjudication          []ClaimResponseAdjudication        `json:"adjudication,omitempty"`
+	SubDetail             []ClaimResponseItemDetailSubDetail `json:"subDetail,omitempty"`
 }
 
 type ClaimResponseItemDetailSubDetail struct {
@@ -89,38 +99,46 @@ type ClaimResponseItemDetailSubDetail struct {
 	ProgramCode         []common.CodeableConcept    `json:"programCode,omitempty"`
 	Quantity            *common.Quantity            `json:"quantity,omitempty"`
 	UnitPrice           *Money                      `json:"unitPrice,omitempty"`
-	Factor              *float64                    `json:"factor,omitempty"`
+	Factor              *common.Decimal             `json:"factor,omitempty"`
+	FactorElement       *common.Element             `json:"_factor,omitempty"`
 	Tax                 *Money                      `json:"tax,omitempty"`
 	Net                 *Money                      `json:"net,omitempty"`
 	NoteNumber          []int                       `json:"noteNumber,omitempty"`
+	NoteNumberElement   []*common.Element           `json:"_noteNumber,omitempty"`
 	Adjudication        []ClaimResponseAdjudication `json:"adjudication,omitempty"`
 }
 
 type ClaimResponseAddItem struct {
 	common.BackboneElement
-	ItemSequence            []int                        `json:"itemSequence,omitempty"`
-	DetailSequence          []int                        `json:"detailSequence,omitempty"`
-	SubDetailSequence       []int                        `json:"subDetailSequence,omitempty"`
-	Provider                []common.Reference           `json:"provider,omitempty"`
-	ProductOrService        common.CodeableConcept       `json:"productOrService"`
-	ProductOrServiceEnd     *common.CodeableConcept      `json:"productOrServiceEnd,omitempty"`
-	Modifier                []common.CodeableConcept     `json:"modifier,omitempty"`
-	ProgramCode             []common.CodeableConcept     `json:"programCode,omitempty"`
-	ServicedDate            *string                      `json:"servicedDate,omitempty"`
-	ServicedPeriod          *common.Period               `json:"servicedPeriod,omitempty"`
-	LocationCodeableConcept *common.CodeableConcept      `json:"locationCodeableConcept,omitempty"`
-	LocationAddress         *Address                     `json:"locationAddress,omitempty"`
-	LocationReference       *common.Reference            `json:"locationReference,omitempty"`
-	Quantity                *common.Quantity             `json:"quantity,omitempty"`
-	UnitPrice               *Money                       `json:"unitPrice,omitempty"`
-	Factor                  *float64                     `json:"factor,omitempty"`
-	Tax                     *Money                       `json:"tax,omitempty"`
-	Net                     *Money                       `json:"net,omitempty"`
-	BodySite                *common.CodeableConcept      `json:"bodySite,omitempty"`
-	SubSite                 []common.CodeableConcept     `json:"subSite,omitempty"`
-	NoteNumber              []int                        `json:"noteNumber,omitempty"`
-	Adjudication            []ClaimResponseAdjudication  `json:"adjudication,omitempty"`
-	Detail                  []ClaimResponseAddItemDetail `json:"detail,omitempty"`
+	ItemSequence             []int                        `json:"itemSequence,omitempty"`
+	ItemSequenceElement      []*common.Element            `json:"_itemSequence,omitempty"`
+	DetailSequence           []int                        `json:"detailSequence,omitempty"`
+	DetailSequenceElement    []*common.Element            `json:"_detailSequence,omitempty"`
+	SubDetailSequence        []int                        `json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement []*common.Element            `json:"_subDetailSequence,omitempty"`
+	Provider                 []common.Reference           `json:"provider,omitempty"`
+	ProductOrService         common.CodeableConcept       `json:"productOrService"`
+	ProductOrServiceEnd      *common.CodeableConcept      `json:"productOrServiceEnd,omitempty"`
+	Modifier                 []common.CodeableConcept     `json:"modifier,omitempty"`
+	ProgramCode              []common.CodeableConcept     `json:"programCode,omitempty"`
+	ServicedDate             *common.Date                 `json:"servicedDate,omitempty"`
+	ServicedDateElement      *common.Element              `json:"_servicedDate,omitempty"`
+	ServicedPeriod           *common.Period               `json:"servicedPeriod,omitempty"`
+	LocationCodeableConcept  *common.CodeableConcept      `json:"locationCodeableConcept,omitempty"`
+	LocationAddress          *Address                     `json:"locationAddress,omitempty"`
+	LocationReference        *common.Reference            `json:"locationReference,omitempty"`
+	Quantity                 *common.Quantity             `json:"quantity,omitempty"`
+	UnitPrice                *Money                       `json:"unitPrice,omitempty"`
+	Factor                   *common.Decimal              `json:"factor,omitempty"`
+	FactorElement            *common.Element              `json:"_factor,omitempty"`
+	Tax                      *Money                       `json:"tax,omitempty"`
+	Net                      *Money                       `json:"net,omitempty"`
+	BodySite                 *common.CodeableConcept      `json:"bodySite,omitempty"`
+	SubSite                  []common.CodeableConcept     `json:"subSite,omitempty"`
+	NoteNumber               []int                        `json:"noteNumber,omitempty"`
+	NoteNumberElement        []*common.Element            `json:"_noteNumber,omitempty"`
+	Adjudication             []ClaimResponseAdjudication  `json:"adjudication,omitempty"`
+	Detail                   []ClaimResponseAddItemDetail `json:"detail,omitempty"`
 }
 
 type ClaimResponseAddItemDetail struct {
@@ -131,10 +149,12 @@ type ClaimResponseAddItemDetail struct {
 	ProgramCode         []common.CodeableConcept              `json:"programCode,omitempty"`
 	Quantity            *common.Quantity                      `json:"quantity,omitempty"`
 	UnitPrice           *Money                                `json:"unitPrice,omitempty"`
-	Factor              *float64                              `json:"factor,omitempty"`
+	Factor              *common.Decimal                       `json:"factor,omitempty"`
+	FactorElement       *common.Element                       `json:"_factor,omitempty"`
 	Tax                 *Money                                `json:"tax,omitempty"`
 	Net                 *Money                                `json:"net,omitempty"`
 	NoteNumber          []int                                 `json:"noteNumber,omitempty"`
+	NoteNumberElement   []*common.Element                     `json:"_noteNumber,omitempty"`
 	Adjudication        []ClaimResponseAdjudication           `json:"adjudication,omitempty"`
 	SubDetail           []ClaimResponseAddItemDetailSubDetail `json:"subDetail,omitempty"`
 }
@@ -147,19 +167,22 @@ type ClaimResponseAddItemDetailSubDetail struct {
 	ProgramCode         []common.CodeableConcept    `json:"programCode,omitempty"`
 	Quantity            *common.Quantity            `json:"quantity,omitempty"`
 	UnitPrice           *Money                      `json:"unitPrice,omitempty"`
-	Factor              *float64                    `json:"factor,omitempty"`
+	Factor              *common.Decimal             `json:"factor,omitempty"`
+	FactorElement       *common.Element             `json:"_factor,omitempty"`
 	Tax                 *Money                      `json:"tax,omitempty"`
 	Net                 *Money                      `json:"net,omitempty"`
 	NoteNumber          []int                       `json:"noteNumber,omitempty"`
+	NoteNumberElement   []*common.Element           `json:"_noteNumber,omitempty"`
 	Adjudication        []ClaimResponseAdjudication `json:"adjudication,omitempty"`
 }
 
 type ClaimResponseAdjudication struct {
 	common.BackboneElement
-	Category common.CodeableConcept  `json:"category"`
-	Reason   *common.CodeableConcept `json:"reason,omitempty"`
-	Amount   *Money                  `json:"amount,omitempty"`
-	Value    *float64                `json:"value,omitempty"`
+	Category     common.CodeableConcept  `json:"category"`
+	Reason       *common.CodeableConcept `json:"reason,omitempty"`
+	Amount       *Money                  `json:"amount,omitempty"`
+	Value        *common.Decimal         `json:"value,omitempty"`
+	ValueElement *common.Element         `json:"_value,omitempty"`
 }
 
 type ClaimResponseTotal struct {
@@ -173,17 +196,21 @@ type ClaimResponsePayment struct {
 	Type             common.CodeableConcept  `json:"type"`
 	Adjustment       *Money                  `json:"adjustment,omitempty"`
 	AdjustmentReason *common.CodeableConcept `json:"adjustmentReason,omitempty"`
-	Date             *string                 `json:"date,omitempty"`
+	Date             *common.Date            `json:"date,omitempty"`
+	DateElement      *common.Element         `json:"_date,omitempty"`
 	Amount           Money                   `json:"amount"`
 	Identifier       *common.Identifier      `json:"identifier,omitempty"`
 }
 
 type ClaimResponseProcessNote struct {
 	common.BackboneElement
-	Number   *int                          `json:"number,omitempty"`
-	Type     *ClaimResponseProcessNoteType `json:"type,omitempty"`
-	Text     string                        `json:"text"`
-	Language *common.CodeableConcept       `json:"language,omitempty"`
+	Number        *int                          `json:"number,omitempty"`
+	NumberElement *common.Element               `json:"_number,omitempty"`
+	Type          *ClaimResponseProcessNoteType `json:"type,omitempty"`
+	TypeElement   *common.Element               `json:"_type,omitempty"`
+	Text          string                        `json:"text"`
+	TextElement   *common.Element               `json:"_text,omitempty"`
+	Language      *common.CodeableConcept       `json:"language,omitempty"`
 }
 
 type ClaimResponseProcessNoteType string
@@ -196,19 +223,26 @@ const (
 
 type ClaimResponseInsurance struct {
 	common.BackboneElement
-	Sequence            int                `json:"sequence"`
-	Focal               bool               `json:"focal"`
-	Identifier          *common.Identifier `json:"identifier,omitempty"`
-	Coverage            common.Reference   `json:"coverage"`
-	BusinessArrangement *string            `json:"businessArrangement,omitempty"`
-	PreAuthRef          []string           `json:"preAuthRef,omitempty"`
-	ClaimResponse       *common.Reference  `json:"claimResponse,omitempty"`
+	Sequence                   int                `json:"sequence"`
+	SequenceElement            *common.Element    `json:"_sequence,omitempty"`
+	Focal                      bool               `json:"focal"`
+	FocalElement               *common.Element    `json:"_focal,omitempty"`
+	Identifier                 *common.Identifier `json:"identifier,omitempty"`
+	Coverage                   common.Reference   `json:"coverage"`
+	BusinessArrangement        *string            `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element    `json:"_businessArrangement,omitempty"`
+	PreAuthRef                 []string           `json:"preAuthRef,omitempty"`
+	PreAuthRefElement          []*common.Element  `json:"_preAuthRef,omitempty"`
+	ClaimResponse              *common.Reference  `json:"claimResponse,omitempty"`
 }
 
 type ClaimResponseError struct {
 	common.BackboneElement
-	ItemSequence      *int                   `json:"itemSequence,omitempty"`
-	DetailSequence    *int                   `json:"detailSequence,omitempty"`
-	SubDetailSequence *int                   `json:"subDetailSequence,omitempty"`
-	Code              common.CodeableConcept `json:"code"`
+	ItemSequence             *int                   `json:"itemSequence,omitempty"`
+	ItemSequenceElement      *common.Element        `json:"_itemSequence,omitempty"`
+	DetailSequence           *int                   `json:"detailSequence,omitempty"`
+	DetailSequenceElement    *common.Element        `json:"_detailSequence,omitempty"`
+	SubDetailSequence        *int                   `json:"subDetailSequence,omitempty"`
+	SubDetailSequenceElement *common.Element        `json:"_subDetailSequence,omitempty"`
+	Code                     common.CodeableConcept `json:"code"`
 }
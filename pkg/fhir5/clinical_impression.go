@@ -10,7 +10,8 @@ type ClinicalImpressionFinding struct {
 	common.BackboneElement
 
 	// Which investigations support finding or diagnosis
-	Basis *string `json:"basis,omitempty"`
+	Basis        *string         `json:"basis,omitempty"`
+	BasisElement *common.Element `json:"_basis,omitempty"`
 
 	// Specific text, code or reference for finding or diagnosis
 	Item *CodeableReference `json:"item,omitempty"`
@@ -27,13 +28,16 @@ type ClinicalImpression struct {
 	ChangePattern *common.CodeableConcept `json:"changePattern,omitempty"`
 
 	// Indicates when the documentation of the assessment was complete
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// A summary of the context and/or cause of the assessment
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This SHOULD be accurate to at least the minute
-	EffectiveDateTime *string `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
 
 	// This SHOULD be accurate to at least the minute
 	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
@@ -66,10 +70,12 @@ type ClinicalImpression struct {
 	PrognosisReference []common.Reference `json:"prognosisReference,omitempty"`
 
 	// Reference to a specific published clinical protocol that was followed during this assessment
-	Protocol []string `json:"protocol,omitempty"`
+	Protocol        []string          `json:"protocol,omitempty"`
+	ProtocolElement []*common.Element `json:"_protocol,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the code entered-in-error
-	Status ClinicalImpressionStatus `json:"status"`
+	Status        ClinicalImpressionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 
 	// This is generally only used for "exception" statuses such as "not-done", "on-hold" or "stopped"
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -78,7 +84,8 @@ type ClinicalImpression struct {
 	Subject common.Reference `json:"subject"`
 
 	// A text summary of the investigations and the diagnosis
-	Summary *string `json:"summary,omitempty"`
+	Summary        *string         `json:"summary,omitempty"`
+	SummaryElement *common.Element `json:"_summary,omitempty"`
 
 	// Information supporting the clinical impression, which can contain investigation results
 	SupportingInfo []common.Reference `json:"supportingInfo,omitempty"`
@@ -59,7 +59,8 @@ type ClinicalUseDefinitionIndication struct {
 	DurationRange *Range `json:"durationRange,omitempty"`
 
 	// Timing or duration information, that may be associated with use with the indicated condition
-	DurationString *string `json:"durationString,omitempty"`
+	DurationString        *string         `json:"durationString,omitempty"`
+	DurationStringElement *common.Element `json:"_durationString,omitempty"`
 
 	// The intended effect, aim or strategy to be achieved
 	IntendedEffect *CodeableReference `json:"intendedEffect,omitempty"`
@@ -124,7 +125,8 @@ type ClinicalUseDefinitionWarning struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// A textual definition of this warning, with formatting
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 }
 
 // ClinicalUseDefinition represents a single issue - either an indication, contraindication, interaction or an undesirable effect
@@ -150,7 +152,8 @@ type ClinicalUseDefinition struct {
 	Interaction *ClinicalUseDefinitionInteraction `json:"interaction,omitempty"`
 
 	// Logic used by the clinical use definition
-	Library []string `json:"library,omitempty"`
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
 
 	// The population group to which this applies
 	Population []common.Reference `json:"population,omitempty"`
@@ -162,7 +165,8 @@ type ClinicalUseDefinition struct {
 	Subject []common.Reference `json:"subject,omitempty"`
 
 	// indication | contraindication | interaction | undesirable-effect | warning
-	Type ClinicalUseDefinitionType `json:"type"`
+	Type        ClinicalUseDefinitionType `json:"type"`
+	TypeElement *common.Element           `json:"_type,omitempty"`
 
 	// Describe the possible undesirable effects (negative outcomes) from the use of the medicinal product as treatment
 	UndesirableEffect *ClinicalUseDefinitionUndesirableEffect `json:"undesirableEffect,omitempty"`
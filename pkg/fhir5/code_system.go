@@ -10,16 +10,20 @@ type CodeSystemFilter struct {
 	common.BackboneElement
 
 	// The code that identifies this filter when it is used as a filter in ValueSet
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// A description of how or why the filter is used
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A list of operators that can be used with the filter
-	Operator []string `json:"operator"`
+	Operator        []string          `json:"operator"`
+	OperatorElement []*common.Element `json:"_operator,omitempty"`
 
 	// A description of what the value for the filter should be
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // CodeSystemProperty represents a property that can be used to extend the code system
@@ -27,16 +31,20 @@ type CodeSystemProperty struct {
 	common.BackboneElement
 
 	// A code that is used to identify the property
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// A description of the property- why it is defined, and how its value might be used
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The type of the property value
-	Type CodeSystemPropertyType `json:"type"`
+	Type        CodeSystemPropertyType `json:"type"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
 
 	// Reference to the formal meaning of the property
-	URI *string `json:"uri,omitempty"`
+	URI        *string         `json:"uri,omitempty"`
+	URIElement *common.Element `json:"_uri,omitempty"`
 }
 
 // CodeSystemPropertyType represents the type of a code system property
@@ -60,13 +68,15 @@ type CodeSystemConceptDesignation struct {
 	AdditionalUse []common.Coding `json:"additionalUse,omitempty"`
 
 	// In the absence of a language, the resource language applies
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 
 	// If no use is provided, the designation can be assumed to be suitable for general display
 	Use *common.Coding `json:"use,omitempty"`
 
 	// The text value for this designation
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // CodeSystemConceptProperty represents a property value for a concept
@@ -74,28 +84,35 @@ type CodeSystemConceptProperty struct {
 	common.BackboneElement
 
 	// A code that is a reference to CodeSystem.property.code
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// The value of this property
-	ValueCode *string `json:"valueCode,omitempty"`
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
 
 	// The value of this property
 	ValueCoding *common.Coding `json:"valueCoding,omitempty"`
 
 	// The value of this property
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value of this property
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The value of this property
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value of this property
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// The value of this property
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 }
 
 // CodeSystemConcept represents a concept in a code system
@@ -103,19 +120,22 @@ type CodeSystemConcept struct {
 	common.BackboneElement
 
 	// A code - a text symbol - that uniquely identifies the concept within the code system
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Defines children of a concept to produce a hierarchy of concepts
 	Concept []CodeSystemConcept `json:"concept,omitempty"`
 
 	// The formal definition of the concept
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// Concepts have both a display and an array of designation
 	Designation []CodeSystemConceptDesignation `json:"designation,omitempty"`
 
 	// A human readable string that is the recommended default way to present this concept to a user
-	Display *string `json:"display,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
 
 	// A property value for this concept
 	Property []CodeSystemConceptProperty `json:"property,omitempty"`
@@ -129,16 +149,19 @@ type CodeSystem struct {
 	ResourceType string `json:"resourceType"` // Always "CodeSystem"
 
 	// The 'date' element may be more recent than the approval date because of minor changes
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the CodeSystem
 	Author []ContactDetail `json:"author,omitempty"`
 
 	// If this value is missing, then it is not specified whether a code system is case sensitive or not
-	CaseSensitive *bool `json:"caseSensitive,omitempty"`
+	CaseSensitive        *bool           `json:"caseSensitive,omitempty"`
+	CaseSensitiveElement *common.Element `json:"_caseSensitive,omitempty"`
 
 	// Note that the code system resource does not define what the compositional grammar is
-	Compositional *bool `json:"compositional,omitempty"`
+	Compositional        *bool           `json:"compositional,omitempty"`
+	CompositionalElement *common.Element `json:"_compositional,omitempty"`
 
 	// If this is empty, it means that the code system resource does not represent the content of the code system
 	Concept []CodeSystemConcept `json:"concept,omitempty"`
@@ -147,28 +170,35 @@ type CodeSystem struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// The extent of the content of the code system
-	Content CodeSystemContent `json:"content"`
+	Content        CodeSystemContent `json:"content"`
+	ContentElement *common.Element   `json:"_content,omitempty"`
 
 	// Sometimes, the copyright differs between the code system and the codes that are included
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The count of concepts defined in this resource cannot be more than this value
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// A free text natural language description of the code system from a consumer's perspective
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A Boolean value to indicate that this code system is authored for testing purposes
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// A filter that can be used in a value set compose statement when selecting concepts using a filter
 	Filter []CodeSystemFilter `json:"filter,omitempty"`
 
 	// The hierarchyMeaning element describes how the concepts in the code system are organized
-	HierarchyMeaning *CodeSystemHierarchyMeaning `json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaning        *CodeSystemHierarchyMeaning `json:"hierarchyMeaning,omitempty"`
+	HierarchyMeaningElement *common.Element             `json:"_hierarchyMeaning,omitempty"`
 
 	// A formal identifier that is used to identify this code system when it is represented in other formats
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -177,34 +207,42 @@ type CodeSystem struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A property defines an additional slot through which additional information can be provided
 	Property []CodeSystemProperty `json:"property,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the code system. Instead, it provides traceability
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The status of this code system
-	Status PublicationStatus `json:"status"`
+	Status        PublicationStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// A short, descriptive, user-friendly title for the code system
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// An absolute URI that is used to identify this code system when it is referenced in a specification
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// The content was developed with a focus and intent of supporting the contexts that are listed
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// The identifier that is used to identify this version of the code system when it is referenced
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// The version is used for this rather than the version of the code system
-	VersionNeeded *bool `json:"versionNeeded,omitempty"`
+	VersionNeeded        *bool           `json:"versionNeeded,omitempty"`
+	VersionNeededElement *common.Element `json:"_versionNeeded,omitempty"`
 }
 
 // CodeSystemContent represents the extent of the content of the code system
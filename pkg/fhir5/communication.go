@@ -45,10 +45,12 @@ type Communication struct {
 	InResponseTo []common.Reference `json:"inResponseTo,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, orderset or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// A channel that was used for this communication (e.g. email, fax)
 	Medium []common.CodeableConcept `json:"medium,omitempty"`
@@ -63,13 +65,15 @@ type Communication struct {
 	Payload []CommunicationPayload `json:"payload,omitempty"`
 
 	// Used to prioritize workflow when the communication is planned or in progress
-	Priority *CommunicationPriority `json:"priority,omitempty"`
+	Priority        *CommunicationPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
 
 	// Textual reasons can be captured using reason.concept.text
 	Reason []CodeableReference `json:"reason,omitempty"`
 
 	// The time when this communication arrived at the destination
-	Received *string `json:"received,omitempty"`
+	Received        *common.DateTime `json:"received,omitempty"`
+	ReceivedElement *common.Element  `json:"_received,omitempty"`
 
 	// If receipts need to be tracked by an individual, a separate resource instance will need to be created
 	Recipient []common.Reference `json:"recipient,omitempty"`
@@ -78,10 +82,12 @@ type Communication struct {
 	Sender *common.Reference `json:"sender,omitempty"`
 
 	// The time when this communication was sent
-	Sent *string `json:"sent,omitempty"`
+	Sent        *common.DateTime `json:"sent,omitempty"`
+	SentElement *common.Element  `json:"_sent,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the codes aborted and entered-in-error
-	Status CommunicationStatus `json:"status"`
+	Status        CommunicationStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// This is generally only used for "exception" statuses such as "not-done", "suspended" or "aborted"
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -20,31 +20,37 @@ type CommunicationRequest struct {
 
 	ResourceType string `json:"resourceType"` // Always "CommunicationRequest"
 
-	About               []common.Reference            `json:"about,omitempty"`
-	AuthoredOn          *string                       `json:"authoredOn,omitempty"`
-	BasedOn             []common.Reference            `json:"basedOn,omitempty"`
-	Category            []common.CodeableConcept      `json:"category,omitempty"`
-	DoNotPerform        *bool                         `json:"doNotPerform,omitempty"`
-	Encounter           *common.Reference             `json:"encounter,omitempty"`
-	GroupIdentifier     *common.Identifier            `json:"groupIdentifier,omitempty"`
-	Identifier          []common.Identifier           `json:"identifier,omitempty"`
-	InformationProvider []common.Reference            `json:"informationProvider,omitempty"`
-	Intent              CommunicationRequestIntent    `json:"intent"`
-	Medium              []common.CodeableConcept      `json:"medium,omitempty"`
-	Note                []Annotation                  `json:"note,omitempty"`
-	OccurrenceDateTime  *string                       `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod    *common.Period                `json:"occurrencePeriod,omitempty"`
-	Payload             []CommunicationRequestPayload `json:"payload,omitempty"`
-	Priority            *CommunicationRequestPriority `json:"priority,omitempty"`
-	Reason              []CodeableReference           `json:"reason,omitempty"`
-	Recipient           []common.Reference            `json:"recipient,omitempty"`
-	Replaces            []common.Reference            `json:"replaces,omitempty"`
-	Requester           *common.Reference             `json:"requester,omitempty"`
-	Sender              *common.Reference             `json:"sender,omitempty"`
-	Status              CommunicationRequestStatus    `json:"status"`
-	StatusReason        *common.CodeableConcept       `json:"statusReason,omitempty"`
-	Subject             *common.Reference             `json:"subject,omitempty"`
-	Topic               *common.CodeableConcept       `json:"topic,omitempty"`
+	About                     []common.Reference            `json:"about,omitempty"`
+	AuthoredOn                *common.DateTime              `json:"authoredOn,omitempty"`
+	AuthoredOnElement         *common.Element               `json:"_authoredOn,omitempty"`
+	BasedOn                   []common.Reference            `json:"basedOn,omitempty"`
+	Category                  []common.CodeableConcept      `json:"category,omitempty"`
+	DoNotPerform              *bool                         `json:"doNotPerform,omitempty"`
+	DoNotPerformElement       *common.Element               `json:"_doNotPerform,omitempty"`
+	Encounter                 *common.Reference             `json:"encounter,omitempty"`
+	GroupIdentifier           *common.Identifier            `json:"groupIdentifier,omitempty"`
+	Identifier                []common.Identifier           `json:"identifier,omitempty"`
+	InformationProvider       []common.Reference            `json:"informationProvider,omitempty"`
+	Intent                    CommunicationRequestIntent    `json:"intent"`
+	IntentElement             *common.Element               `json:"_intent,omitempty"`
+	Medium                    []common.CodeableConcept      `json:"medium,omitempty"`
+	Note                      []Annotation                  `json:"note,omitempty"`
+	OccurrenceDateTime        *common.DateTime              `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element               `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period                `json:"occurrencePeriod,omitempty"`
+	Payload                   []CommunicationRequestPayload `json:"payload,omitempty"`
+	Priority                  *CommunicationRequestPriority `json:"priority,omitempty"`
+	PriorityElement           *common.Element               `json:"_priority,omitempty"`
+	Reason                    []CodeableReference           `json:"reason,omitempty"`
+	Recipient                 []common.Reference            `json:"recipient,omitempty"`
+	Replaces                  []common.Reference            `json:"replaces,omitempty"`
+	Requester                 *common.Reference             `json:"requester,omitempty"`
+	Sender                    *common.Reference             `json:"sender,omitempty"`
+	Status                    CommunicationRequestStatus    `json:"status"`
+	StatusElement             *common.Element               `json:"_status,omitempty"`
+	StatusReason              *common.CodeableConcept       `json:"statusReason,omitempty"`
+	Subject                   *common.Reference             `json:"subject,omitempty"`
+	Topic                     *common.CodeableConcept       `json:"topic,omitempty"`
 }
 
 type CommunicationRequestIntent string
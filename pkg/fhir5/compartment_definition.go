@@ -9,9 +9,12 @@ import (
 type CompartmentDefinitionResource struct {
 	common.BackboneElement
 
-	Code          string   `json:"code"`
-	Param         []string `json:"param,omitempty"`
-	Documentation *string  `json:"documentation,omitempty"`
+	Code                 string            `json:"code"`
+	CodeElement          *common.Element   `json:"_code,omitempty"`
+	Param                []string          `json:"param,omitempty"`
+	ParamElement         []*common.Element `json:"_param,omitempty"`
+	Documentation        *string           `json:"documentation,omitempty"`
+	DocumentationElement *common.Element   `json:"_documentation,omitempty"`
 }
 
 // CompartmentDefinition represents a compartment definition
@@ -20,17 +23,28 @@ type CompartmentDefinition struct {
 
 	ResourceType string `json:"resourceType"` // Always "CompartmentDefinition"
 
-	Url          string                          `json:"url"`
-	Version      *string                         `json:"version,omitempty"`
-	Name         string                          `json:"name"`
-	Status       PublicationStatus               `json:"status"`
-	Experimental *bool                           `json:"experimental,omitempty"`
-	Date         string                          `json:"date"`
-	Publisher    *string                         `json:"publisher,omitempty"`
-	Contact      []ContactDetail                 `json:"contact,omitempty"`
-	Description  *string                         `json:"description,omitempty"`
-	Purpose      *string                         `json:"purpose,omitempty"`
-	Code         string                          `json:"code"`
-	Search       bool                            `json:"search"`
-	Resource     []CompartmentDefinitionResource `json:"resource,omitempty"`
+	Url                 string                          `json:"url"`
+	UrlElement          *common.Element                 `json:"_url,omitempty"`
+	Version             *string                         `json:"version,omitempty"`
+	VersionElement      *common.Element                 `json:"_version,omitempty"`
+	Name                string                          `json:"name"`
+	NameElement         *common.Element                 `json:"_name,omitempty"`
+	Status              PublicationStatus               `json:"status"`
+	StatusElement       *common.Element                 `json:"_status,omitempty"`
+	Experimental        *bool                           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element                 `json:"_experimental,omitempty"`
+	Date                common.DateTime                 `json:"date"`
+	DateElement         *common.Element                 `json:"_date,omitempty"`
+	Publisher           *string                         `json:"publisher,omitempty"`
+	PublisherElement    *common.Element                 `json:"_publisher,omitempty"`
+	Contact             []ContactDetail                 `json:"contact,omitempty"`
+	Description         *string                         `json:"description,omitempty"`
+	DescriptionElement  *common.Element                 `json:"_description,omitempty"`
+	Purpose             *string                         `json:"purpose,omitempty"`
+	PurposeElement      *common.Element                 `json:"_purpose,omitempty"`
+	Code                string                          `json:"code"`
+	CodeElement         *common.Element                 `json:"_code,omitempty"`
+	Search              bool                            `json:"search"`
+	SearchElement       *common.Element                 `json:"_search,omitempty"`
+	Resource            []CompartmentDefinitionResource `json:"resource,omitempty"`
 }
@@ -9,9 +9,11 @@ import (
 type CompositionAttester struct {
 	common.BackboneElement
 
-	Mode  []string          `json:"mode"`
-	Time  *string           `json:"time,omitempty"`
-	Party *common.Reference `json:"party,omitempty"`
+	Mode        []string          `json:"mode"`
+	ModeElement []*common.Element `json:"_mode,omitempty"`
+	Time        *common.DateTime  `json:"time,omitempty"`
+	TimeElement *common.Element   `json:"_time,omitempty"`
+	Party       *common.Reference `json:"party,omitempty"`
 }
 
 // CompositionEvent represents an event the composition documents
@@ -28,6 +30,7 @@ type CompositionRelatesTo struct {
 	common.BackboneElement
 
 	Code             string             `json:"code"`
+	CodeElement      *common.Element    `json:"_code,omitempty"`
 	TargetIdentifier *common.Identifier `json:"targetIdentifier,omitempty"`
 	TargetReference  *common.Reference  `json:"targetReference,omitempty"`
 }
@@ -36,16 +39,18 @@ type CompositionRelatesTo struct {
 type CompositionSection struct {
 	common.BackboneElement
 
-	Title       *string                 `json:"title,omitempty"`
-	Code        *common.CodeableConcept `json:"code,omitempty"`
-	Author      []common.Reference      `json:"author,omitempty"`
-	Focus       *common.Reference       `json:"focus,omitempty"`
-	Text        *Narrative              `json:"text,omitempty"`
-	Mode        *string                 `json:"mode,omitempty"`
-	OrderedBy   *common.CodeableConcept `json:"orderedBy,omitempty"`
-	Entry       []common.Reference      `json:"entry,omitempty"`
-	EmptyReason *common.CodeableConcept `json:"emptyReason,omitempty"`
-	Section     []CompositionSection    `json:"section,omitempty"`
+	Title        *string                 `json:"title,omitempty"`
+	TitleElement *common.Element         `json:"_title,omitempty"`
+	Code         *common.CodeableConcept `json:"code,omitempty"`
+	Author       []common.Reference      `json:"author,omitempty"`
+	Focus        *common.Reference       `json:"focus,omitempty"`
+	Text         *Narrative              `json:"text,omitempty"`
+	Mode         *string                 `json:"mode,omitempty"`
+	ModeElement  *common.Element         `json:"_mode,omitempty"`
+	OrderedBy    *common.CodeableConcept `json:"orderedBy,omitempty"`
+	Entry        []common.Reference      `json:"entry,omitempty"`
+	EmptyReason  *common.CodeableConcept `json:"emptyReason,omitempty"`
+	Section      []CompositionSection    `json:"section,omitempty"`
 }
 
 // Composition represents a set of healthcare-related information that is assembled together
@@ -54,21 +59,25 @@ type Composition struct {
 
 	ResourceType string `json:"resourceType"` // Always "Composition"
 
-	Identifier      *common.Identifier       `json:"identifier,omitempty"`
-	Status          CompositionStatus        `json:"status"`
-	Type            common.CodeableConcept   `json:"type"`
-	Category        []common.CodeableConcept `json:"category,omitempty"`
-	Subject         *common.Reference        `json:"subject,omitempty"`
-	Encounter       *common.Reference        `json:"encounter,omitempty"`
-	Date            string                   `json:"date"`
-	Author          []common.Reference       `json:"author"`
-	Title           string                   `json:"title"`
-	Confidentiality *string                  `json:"confidentiality,omitempty"`
-	Attester        []CompositionAttester    `json:"attester,omitempty"`
-	Custodian       *common.Reference        `json:"custodian,omitempty"`
-	RelatesTo       []CompositionRelatesTo   `json:"relatesTo,omitempty"`
-	Event           []CompositionEvent       `json:"event,omitempty"`
-	Section         []CompositionSection     `json:"section,omitempty"`
+	Identifier             *common.Identifier       `json:"identifier,omitempty"`
+	Status                 CompositionStatus        `json:"status"`
+	StatusElement          *common.Element          `json:"_status,omitempty"`
+	Type                   common.CodeableConcept   `json:"type"`
+	Category               []common.CodeableConcept `json:"category,omitempty"`
+	Subject                *common.Reference        `json:"subject,omitempty"`
+	Encounter              *common.Reference        `json:"encounter,omitempty"`
+	Date                   common.DateTime          `json:"date"`
+	DateElement            *common.Element          `json:"_date,omitempty"`
+	Author                 []common.Reference       `json:"author"`
+	Title                  string                   `json:"title"`
+	TitleElement           *common.Element          `json:"_title,omitempty"`
+	Confidentiality        *string                  `json:"confidentiality,omitempty"`
+	ConfidentialityElement *common.Element          `json:"_confidentiality,omitempty"`
+	Attester               []CompositionAttester    `json:"attester,omitempty"`
+	Custodian              *common.Reference        `json:"custodian,omitempty"`
+	RelatesTo              []CompositionRelatesTo   `json:"relatesTo,omitempty"`
+	Event                  []CompositionEvent       `json:"event,omitempty"`
+	Section                []CompositionSection     `json:"section,omitempty"`
 }
 
 type CompositionStatus string
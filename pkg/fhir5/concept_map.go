@@ -9,94 +9,131 @@ import (
 type ConceptMapAdditionalAttribute struct {
 	common.BackboneElement
 
-	Code        string  `json:"code"`
-	URI         *string `json:"uri,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Type        string  `json:"type"`
+	Code               string          `json:"code"`
+	CodeElement        *common.Element `json:"_code,omitempty"`
+	URI                *string         `json:"uri,omitempty"`
+	URIElement         *common.Element `json:"_uri,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+	Type               string          `json:"type"`
+	TypeElement        *common.Element `json:"_type,omitempty"`
 }
 
 // ConceptMapProperty represents properties for mapping
 type ConceptMapProperty struct {
 	common.BackboneElement
 
-	Code        string  `json:"code"`
-	URI         *string `json:"uri,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Type        string  `json:"type"`
+	Code               string          `json:"code"`
+	CodeElement        *common.Element `json:"_code,omitempty"`
+	URI                *string         `json:"uri,omitempty"`
+	URIElement         *common.Element `json:"_uri,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+	Type               string          `json:"type"`
+	TypeElement        *common.Element `json:"_type,omitempty"`
 }
 
 // ConceptMapGroupElementTargetProperty represents a property value for mapping
 type ConceptMapGroupElementTargetProperty struct {
 	common.BackboneElement
 
-	Code          string         `json:"code"`
-	ValueCoding   *common.Coding `json:"valueCoding,omitempty"`
-	ValueString   *string        `json:"valueString,omitempty"`
-	ValueInteger  *int           `json:"valueInteger,omitempty"`
-	ValueBoolean  *bool          `json:"valueBoolean,omitempty"`
-	ValueDateTime *string        `json:"valueDateTime,omitempty"`
-	ValueDecimal  *float64       `json:"valueDecimal,omitempty"`
-	ValueCode     *string        `json:"valueCode,omitempty"`
+	Code                 string           `json:"code"`
+	CodeElement          *common.Element  `json:"_code,omitempty"`
+	ValueCoding          *common.Coding   `json:"valueCoding,omitempty"`
+	ValueString          *string          `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element  `json:"_valueString,omitempty"`
+	ValueInteger         *int             `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element  `json:"_valueInteger,omitempty"`
+	ValueBoolean         *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+	ValueDecimal         *common.Decimal  `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element  `json:"_valueDecimal,omitempty"`
+	ValueCode            *string          `json:"valueCode,omitempty"`
+	ValueCodeElement     *common.Element  `json:"_valueCode,omitempty"`
 }
 
 // ConceptMapGroupElementTargetDependsOn represents dependencies for mapping
 type ConceptMapGroupElementTargetDependsOn struct {
 	common.BackboneElement
 
-	Attribute     string           `json:"attribute"`
-	ValueCode     *string          `json:"valueCode,omitempty"`
-	ValueCoding   *common.Coding   `json:"valueCoding,omitempty"`
-	ValueString   *string          `json:"valueString,omitempty"`
-	ValueBoolean  *bool            `json:"valueBoolean,omitempty"`
-	ValueQuantity *common.Quantity `json:"valueQuantity,omitempty"`
-	ValueSet      *string          `json:"valueSet,omitempty"`
+	Attribute           string           `json:"attribute"`
+	AttributeElement    *common.Element  `json:"_attribute,omitempty"`
+	ValueCode           *string          `json:"valueCode,omitempty"`
+	ValueCodeElement    *common.Element  `json:"_valueCode,omitempty"`
+	ValueCoding         *common.Coding   `json:"valueCoding,omitempty"`
+	ValueString         *string          `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element  `json:"_valueString,omitempty"`
+	ValueBoolean        *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueQuantity       *common.Quantity `json:"valueQuantity,omitempty"`
+	ValueSet            *string          `json:"valueSet,omitempty"`
+	ValueSetElement     *common.Element  `json:"_valueSet,omitempty"`
 }
 
 // ConceptMapGroupElementTarget represents a target element in mapping
 type ConceptMapGroupElementTarget struct {
 	common.BackboneElement
 
-	Code         *string                                 `json:"code,omitempty"`
-	Comment      *string                                 `json:"comment,omitempty"`
-	DependsOn    []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty"`
-	Display      *string                                 `json:"display,omitempty"`
-	Product      []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty"`
-	Property     []ConceptMapGroupElementTargetProperty  `json:"property,omitempty"`
-	Relationship ConceptMapRelationship                  `json:"relationship"`
-	ValueSet     *string                                 `json:"valueSet,omitempty"`
+	Code                *string                                 `json:"code,omitempty"`
+	CodeElement         *common.Element                         `json:"_code,omitempty"`
+	Comment             *string                                 `json:"comment,omitempty"`
+	CommentElement      *common.Element                         `json:"_comment,omitempty"`
+	DependsOn           []ConceptMapGroupElementTargetDependsOn `json:"dependsOn,omitempty"`
+	Display             *string                                 `json:"display,omitempty"`
+	DisplayElement      *common.Element                         `json:"_display,omitempty"`
+	Product             []ConceptMapGroupElementTargetDependsOn `json:"product,omitempty"`
+	Property            []ConceptMapGroupElementTargetProperty  `json:"property,omitempty"`
+	Relationship        ConceptMapRelationship                  `json:"relationship"`
+	RelationshipElement *common.Element                         `json:"_relationship,omitempty"`
+	ValueSet            *string                                 `json:"valueSet,omitempty"`
+	ValueSetElement     *common.Element                         `json:"_valueSet,omitempty"`
 }
 
 // ConceptMapGroupElement represents a source element in mapping
 type ConceptMapGroupElement struct {
 	common.BackboneElement
 
-	Code     *string                        `json:"code,omitempty"`
-	Display  *string                        `json:"display,omitempty"`
-	NoMap    *bool                          `json:"noMap,omitempty"`
-	Target   []ConceptMapGroupElementTarget `json:"target,omitempty"`
-	ValueSet *string                        `json:"valueSet,omitempty"`
+	Code            *string                        `json:"code,omitempty"`
+	CodeElement     *common.Element                `json:"_code,omitempty"`
+	Display         *string                        `json:"display,omitempty"`
+	DisplayElement  *common.Element                `json:"_display,omitempty"`
+	NoMap           *bool                          `json:"noMap,omitempty"`
+	NoMapElement    *common.Element                `json:"_noMap,omitempty"`
+	Target          []ConceptMapGroupElementTarget `json:"target,omitempty"`
+	ValueSet        *string                        `json:"valueSet,omitempty"`
+	ValueSetElement *common.Element                `json:"_valueSet,omitempty"`
 }
 
 // ConceptMapGroupUnmapped represents unmapped elements
 type ConceptMapGroupUnmapped struct {
 	common.BackboneElement
 
-	Code         *string                 `json:"code,omitempty"`
-	Display      *string                 `json:"display,omitempty"`
-	Mode         ConceptMapUnmappedMode  `json:"mode"`
-	OtherMap     *string                 `json:"otherMap,omitempty"`
-	Relationship *ConceptMapRelationship `json:"relationship,omitempty"`
-	ValueSet     *string                 `json:"valueSet,omitempty"`
+	Code                *string                 `json:"code,omitempty"`
+	CodeElement         *common.Element         `json:"_code,omitempty"`
+	Display             *string                 `json:"display,omitempty"`
+	DisplayElement      *common.Element         `json:"_display,omitempty"`
+	Mode                ConceptMapUnmappedMode  `json:"mode"`
+	ModeElement         *common.Element         `json:"_mode,omitempty"`
+	OtherMap            *string                 `json:"otherMap,omitempty"`
+	OtherMapElement     *common.Element         `json:"_otherMap,omitempty"`
+	Relationship        *ConceptMapRelationship `json:"relationship,omitempty"`
+	RelationshipElement *common.Element         `json:"_relationship,omitempty"`
+	ValueSet            *string                 `json:"valueSet,omitempty"`
+	ValueSetElement     *common.Element         `json:"_valueSet,omitempty"`
 }
 
 // ConceptMapGroup represents a group of mappings
 type ConceptMapGroup struct {
 	common.BackboneElement
 
-	Element  []ConceptMapGroupElement `json:"element"`
-	Source   *string                  `json:"source,omitempty"`
-	Target   *string                  `json:"target,omitempty"`
-	Unmapped *ConceptMapGroupUnmapped `json:"unmapped,omitempty"`
+	Element       []ConceptMapGroupElement `json:"element"`
+	Source        *string                  `json:"source,omitempty"`
+	SourceElement *common.Element          `json:"_source,omitempty"`
+	Target        *string                  `json:"target,omitempty"`
+	TargetElement *common.Element          `json:"_target,omitempty"`
+	Unmapped      *ConceptMapGroupUnmapped `json:"unmapped,omitempty"`
 }
 
 // ConceptMap represents a statement of relationships from one set of concepts to another
@@ -105,40 +142,59 @@ type ConceptMap struct {
 
 	ResourceType string `json:"resourceType"` // Always "ConceptMap"
 
-	AdditionalAttribute    []ConceptMapAdditionalAttribute `json:"additionalAttribute,omitempty"`
-	ApprovalDate           *string                         `json:"approvalDate,omitempty"`
-	Author                 []ContactDetail                 `json:"author,omitempty"`
-	Contact                []ContactDetail                 `json:"contact,omitempty"`
-	Copyright              *string                         `json:"copyright,omitempty"`
-	CopyrightLabel         *string                         `json:"copyrightLabel,omitempty"`
-	Date                   *string                         `json:"date,omitempty"`
-	Description            *string                         `json:"description,omitempty"`
-	Editor                 []ContactDetail                 `json:"editor,omitempty"`
-	EffectivePeriod        *common.Period                  `json:"effectivePeriod,omitempty"`
-	Endorser               []ContactDetail                 `json:"endorser,omitempty"`
-	Experimental           *bool                           `json:"experimental,omitempty"`
-	Group                  []ConceptMapGroup               `json:"group,omitempty"`
-	Identifier             []common.Identifier             `json:"identifier,omitempty"`
-	Jurisdiction           []common.CodeableConcept        `json:"jurisdiction,omitempty"`
-	LastReviewDate         *string                         `json:"lastReviewDate,omitempty"`
-	Name                   *string                         `json:"name,omitempty"`
-	Property               []ConceptMapProperty            `json:"property,omitempty"`
-	Publisher              *string                         `json:"publisher,omitempty"`
-	Purpose                *string                         `json:"purpose,omitempty"`
-	RelatedArtifact        []RelatedArtifact               `json:"relatedArtifact,omitempty"`
-	Reviewer               []ContactDetail                 `json:"reviewer,omitempty"`
-	SourceScopeUri         *string                         `json:"sourceScopeUri,omitempty"`
-	SourceScopeCanonical   *string                         `json:"sourceScopeCanonical,omitempty"`
-	Status                 PublicationStatus               `json:"status"`
-	TargetScopeUri         *string                         `json:"targetScopeUri,omitempty"`
-	TargetScopeCanonical   *string                         `json:"targetScopeCanonical,omitempty"`
-	Title                  *string                         `json:"title,omitempty"`
-	Topic                  []common.CodeableConcept        `json:"topic,omitempty"`
-	URL                    *string                         `json:"url,omitempty"`
-	UseContext             []UsageContext                  `json:"useContext,omitempty"`
-	Version                *string                         `json:"version,omitempty"`
-	VersionAlgorithmString *string                         `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding                  `json:"versionAlgorithmCoding,omitempty"`
+	AdditionalAttribute           []ConceptMapAdditionalAttribute `json:"additionalAttribute,omitempty"`
+	ApprovalDate                  *common.Date                    `json:"approvalDate,omitempty"`
+	ApprovalDateElement           *common.Element                 `json:"_approvalDate,omitempty"`
+	Author                        []ContactDetail                 `json:"author,omitempty"`
+	Contact                       []ContactDetail                 `json:"contact,omitempty"`
+	Copyright                     *string                         `json:"copyright,omitempty"`
+	CopyrightElement              *common.Element                 `json:"_copyright,omitempty"`
+	CopyrightLabel                *string                         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement         *common.Element                 `json:"_copyrightLabel,omitempty"`
+	Date                          *common.DateTime                `json:"date,omitempty"`
+	DateElement                   *common.Element                 `json:"_date,omitempty"`
+	Description                   *string                         `json:"description,omitempty"`
+	DescriptionElement            *common.Element                 `json:"_description,omitempty"`
+	Editor                        []ContactDetail                 `json:"editor,omitempty"`
+	EffectivePeriod               *common.Period                  `json:"effectivePeriod,omitempty"`
+	Endorser                      []ContactDetail                 `json:"endorser,omitempty"`
+	Experimental                  *bool                           `json:"experimental,omitempty"`
+	ExperimentalElement           *common.Element                 `json:"_experimental,omitempty"`
+	Group                         []ConceptMapGroup               `json:"group,omitempty"`
+	Identifier                    []common.Identifier             `json:"identifier,omitempty"`
+	Jurisdiction                  []common.CodeableConcept        `json:"jurisdiction,omitempty"`
+	LastReviewDate                *common.Date                    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement         *common.Element                 `json:"_lastReviewDate,omitempty"`
+	Name                          *string                         `json:"name,omitempty"`
+	NameElement                   *common.Element                 `json:"_name,omitempty"`
+	Property                      []ConceptMapProperty            `json:"property,omitempty"`
+	Publisher                     *string                         `json:"publisher,omitempty"`
+	PublisherElement              *common.Element                 `json:"_publisher,omitempty"`
+	Purpose                       *string                         `json:"purpose,omitempty"`
+	PurposeElement                *common.Element                 `json:"_purpose,omitempty"`
+	RelatedArtifact               []RelatedArtifact               `json:"relatedArtifact,omitempty"`
+	Reviewer                      []ContactDetail                 `json:"reviewer,omitempty"`
+	SourceScopeUri                *string                         `json:"sourceScopeUri,omitempty"`
+	SourceScopeUriElement         *common.Element                 `json:"_sourceScopeUri,omitempty"`
+	SourceScopeCanonical          *string                         `json:"sourceScopeCanonical,omitempty"`
+	SourceScopeCanonicalElement   *common.Element                 `json:"_sourceScopeCanonical,omitempty"`
+	Status                        PublicationStatus               `json:"status"`
+	StatusElement                 *common.Element                 `json:"_status,omitempty"`
+	TargetScopeUri                *string                         `json:"targetScopeUri,omitempty"`
+	TargetScopeUriElement         *common.Element                 `json:"_targetScopeUri,omitempty"`
+	TargetScopeCanonical          *string                         `json:"targetScopeCanonical,omitempty"`
+	TargetScopeCanonicalElement   *common.Element                 `json:"_targetScopeCanonical,omitempty"`
+	Title                         *string                         `json:"title,omitempty"`
+	TitleElement                  *common.Element                 `json:"_title,omitempty"`
+	Topic                         []common.CodeableConcept        `json:"topic,omitempty"`
+	URL                           *string                         `json:"url,omitempty"`
+	URLElement                    *common.Element                 `json:"_url,omitempty"`
+	UseContext                    []UsageContext                  `json:"useContext,omitempty"`
+	Version                       *string                         `json:"version,omitempty"`
+	VersionElement                *common.Element                 `json:"_version,omitempty"`
+	VersionAlgorithmString        *string                         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element                 `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding                  `json:"versionAlgorithmCoding,omitempty"`
 }
 
 // ConceptMapRelationship represents the relationship between concepts
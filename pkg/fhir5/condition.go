@@ -38,11 +38,13 @@ type Condition struct {
 	ResourceType string `json:"resourceType"` // Always "Condition"
 
 	// The date or estimated date that the condition resolved or went into remission
-	AbatementDateTime *string        `json:"abatementDateTime,omitempty"`
-	AbatementAge      *common.Age    `json:"abatementAge,omitempty"`
-	AbatementPeriod   *common.Period `json:"abatementPeriod,omitempty"`
-	AbatementRange    *Range         `json:"abatementRange,omitempty"`
-	AbatementString   *string        `json:"abatementString,omitempty"`
+	AbatementDateTime        *common.DateTime `json:"abatementDateTime,omitempty"`
+	AbatementDateTimeElement *common.Element  `json:"_abatementDateTime,omitempty"`
+	AbatementAge             *common.Age      `json:"abatementAge,omitempty"`
+	AbatementPeriod          *common.Period   `json:"abatementPeriod,omitempty"`
+	AbatementRange           *Range           `json:"abatementRange,omitempty"`
+	AbatementString          *string          `json:"abatementString,omitempty"`
+	AbatementStringElement   *common.Element  `json:"_abatementString,omitempty"`
 
 	// The anatomical location where this condition manifests itself
 	BodySite []common.CodeableConcept `json:"bodySite,omitempty"`
@@ -69,17 +71,20 @@ type Condition struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Estimated or actual date or date-time the condition began
-	OnsetDateTime *string        `json:"onsetDateTime,omitempty"`
-	OnsetAge      *common.Age    `json:"onsetAge,omitempty"`
-	OnsetPeriod   *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetRange    *Range         `json:"onsetRange,omitempty"`
-	OnsetString   *string        `json:"onsetString,omitempty"`
+	OnsetDateTime        *common.DateTime `json:"onsetDateTime,omitempty"`
+	OnsetDateTimeElement *common.Element  `json:"_onsetDateTime,omitempty"`
+	OnsetAge             *common.Age      `json:"onsetAge,omitempty"`
+	OnsetPeriod          *common.Period   `json:"onsetPeriod,omitempty"`
+	OnsetRange           *Range           `json:"onsetRange,omitempty"`
+	OnsetString          *string          `json:"onsetString,omitempty"`
+	OnsetStringElement   *common.Element  `json:"_onsetString,omitempty"`
 
 	// Indicates who or what participated in the activities related to the condition (R5 feature)
 	Participant []ConditionParticipant `json:"participant,omitempty"`
 
 	// The recordedDate represents when this particular Condition record was created in the system
-	RecordedDate *string `json:"recordedDate,omitempty"`
+	RecordedDate        *common.DateTime `json:"recordedDate,omitempty"`
+	RecordedDateElement *common.Element  `json:"_recordedDate,omitempty"`
 
 	// A subjective assessment of the severity of the condition
 	Severity *common.CodeableConcept `json:"severity,omitempty"`
@@ -27,6 +27,7 @@ type ConditionDefinitionPrecondition struct {
 
 	Code                 common.CodeableConcept              `json:"code"`
 	Type                 ConditionDefinitionPreconditionType `json:"type"`
+	TypeElement          *common.Element                     `json:"_type,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept             `json:"valueCodeableConcept,omitempty"`
 	ValueQuantity        *common.Quantity                    `json:"valueQuantity,omitempty"`
 }
@@ -35,8 +36,9 @@ type ConditionDefinitionPrecondition struct {
 type ConditionDefinitionQuestionnaire struct {
 	common.BackboneElement
 
-	Purpose   ConditionDefinitionQuestionnairePurpose `json:"purpose"`
-	Reference common.Reference                        `json:"reference"`
+	Purpose        ConditionDefinitionQuestionnairePurpose `json:"purpose"`
+	PurposeElement *common.Element                         `json:"_purpose,omitempty"`
+	Reference      common.Reference                        `json:"reference"`
 }
 
 // ConditionDefinitionPlan represents a plan for a condition
@@ -53,36 +55,51 @@ type ConditionDefinition struct {
 
 	ResourceType string `json:"resourceType"` // Always "ConditionDefinition"
 
-	BodySite               *common.CodeableConcept            `json:"bodySite,omitempty"`
-	Code                   common.CodeableConcept             `json:"code"`
-	Contact                []ContactDetail                    `json:"contact,omitempty"`
-	Date                   *string                            `json:"date,omitempty"`
-	Definition             []string                           `json:"definition,omitempty"`
-	Description            *string                            `json:"description,omitempty"`
-	Experimental           *bool                              `json:"experimental,omitempty"`
-	HasBodySite            *bool                              `json:"hasBodySite,omitempty"`
-	HasSeverity            *bool                              `json:"hasSeverity,omitempty"`
-	HasStage               *bool                              `json:"hasStage,omitempty"`
-	Identifier             []common.Identifier                `json:"identifier,omitempty"`
-	Jurisdiction           []common.CodeableConcept           `json:"jurisdiction,omitempty"`
-	Medication             []ConditionDefinitionMedication    `json:"medication,omitempty"`
-	Name                   *string                            `json:"name,omitempty"`
-	Observation            []ConditionDefinitionObservation   `json:"observation,omitempty"`
-	Plan                   []ConditionDefinitionPlan          `json:"plan,omitempty"`
-	Precondition           []ConditionDefinitionPrecondition  `json:"precondition,omitempty"`
-	Publisher              *string                            `json:"publisher,omitempty"`
-	Questionnaire          []ConditionDefinitionQuestionnaire `json:"questionnaire,omitempty"`
-	Severity               *common.CodeableConcept            `json:"severity,omitempty"`
-	Stage                  *common.CodeableConcept            `json:"stage,omitempty"`
-	Status                 PublicationStatus                  `json:"status"`
-	Subtitle               *string                            `json:"subtitle,omitempty"`
-	Team                   []common.Reference                 `json:"team,omitempty"`
-	Title                  *string                            `json:"title,omitempty"`
-	URL                    *string                            `json:"url,omitempty"`
-	UseContext             []UsageContext                     `json:"useContext,omitempty"`
-	Version                *string                            `json:"version,omitempty"`
-	VersionAlgorithmString *string                            `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding                     `json:"versionAlgorithmCoding,omitempty"`
+	BodySite                      *common.CodeableConcept            `json:"bodySite,omitempty"`
+	Code                          common.CodeableConcept             `json:"code"`
+	Contact                       []ContactDetail                    `json:"contact,omitempty"`
+	Date                          *common.DateTime                   `json:"date,omitempty"`
+	DateElement                   *common.Element                    `json:"_date,omitempty"`
+	Definition                    []string                           `json:"definition,omitempty"`
+	DefinitionElement             []*common.Element                  `json:"_definition,omitempty"`
+	Description                   *string                            `json:"description,omitempty"`
+	DescriptionElement            *common.Element                    `json:"_description,omitempty"`
+	Experimental                  *bool                              `json:"experimental,omitempty"`
+	ExperimentalElement           *common.Element                    `json:"_experimental,omitempty"`
+	HasBodySite                   *bool                              `json:"hasBodySite,omitempty"`
+	HasBodySiteElement            *common.Element                    `json:"_hasBodySite,omitempty"`
+	HasSeverity                   *bool                              `json:"hasSeverity,omitempty"`
+	HasSeverityElement            *common.Element                    `json:"_hasSeverity,omitempty"`
+	HasStage                      *bool                              `json:"hasStage,omitempty"`
+	HasStageElement               *common.Element                    `json:"_hasStage,omitempty"`
+	Identifier                    []common.Identifier                `json:"identifier,omitempty"`
+	Jurisdiction                  []common.CodeableConcept           `json:"jurisdiction,omitempty"`
+	Medication                    []ConditionDefinitionMedication    `json:"medication,omitempty"`
+	Name                          *string                            `json:"name,omitempty"`
+	NameElement                   *common.Element                    `json:"_name,omitempty"`
+	Observation                   []ConditionDefinitionObservation   `json:"observation,omitempty"`
+	Plan                          []ConditionDefinitionPlan          `json:"plan,omitempty"`
+	Precondition                  []ConditionDefinitionPrecondition  `json:"precondition,omitempty"`
+	Publisher                     *string                            `json:"publisher,omitempty"`
+	PublisherElement              *common.Element                    `json:"_publisher,omitempty"`
+	Questionnaire                 []ConditionDefinitionQuestionnaire `json:"questionnaire,omitempty"`
+	Severity                      *common.CodeableConcept            `json:"severity,omitempty"`
+	Stage                         *common.CodeableConcept            `json:"stage,omitempty"`
+	Status                        PublicationStatus                  `json:"status"`
+	StatusElement                 *common.Element                    `json:"_status,omitempty"`
+	Subtitle                      *string                            `json:"subtitle,omitempty"`
+	SubtitleElement               *common.Element                    `json:"_subtitle,omitempty"`
+	Team                          []common.Reference                 `json:"team,omitempty"`
+	Title                         *string                            `json:"title,omitempty"`
+	TitleElement                  *common.Element                    `json:"_title,omitempty"`
+	URL                           *string                            `json:"url,omitempty"`
+	URLElement                    *common.Element                    `json:"_url,omitempty"`
+	UseContext                    []UsageContext                     `json:"useContext,omitempty"`
+	Version                       *string                            `json:"version,omitempty"`
+	VersionElement                *common.Element                    `json:"_version,omitempty"`
+	VersionAlgorithmString        *string                            `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element                    `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding                     `json:"versionAlgorithmCoding,omitempty"`
 }
 
 // ConditionDefinitionPreconditionType represents the type of precondition
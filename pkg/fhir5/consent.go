@@ -9,19 +9,22 @@ import (
 type ConsentPolicyBasis struct {
 	common.BackboneElement
 
-	Reference *common.Reference `json:"reference,omitempty"`
-	URL       *string           `json:"url,omitempty"`
+	Reference  *common.Reference `json:"reference,omitempty"`
+	URL        *string           `json:"url,omitempty"`
+	URLElement *common.Element   `json:"_url,omitempty"`
 }
 
 // ConsentVerification represents verification of consent
 type ConsentVerification struct {
 	common.BackboneElement
 
-	VerificationDate []string                `json:"verificationDate,omitempty"`
-	VerificationType *common.CodeableConcept `json:"verificationType,omitempty"`
-	Verified         bool                    `json:"verified"`
-	VerifiedBy       *common.Reference       `json:"verifiedBy,omitempty"`
-	VerifiedWith     *common.Reference       `json:"verifiedWith,omitempty"`
+	VerificationDate        []common.DateTime       `json:"verificationDate,omitempty"`
+	VerificationDateElement []*common.Element       `json:"_verificationDate,omitempty"`
+	VerificationType        *common.CodeableConcept `json:"verificationType,omitempty"`
+	Verified                bool                    `json:"verified"`
+	VerifiedElement         *common.Element         `json:"_verified,omitempty"`
+	VerifiedBy              *common.Reference       `json:"verifiedBy,omitempty"`
+	VerifiedWith            *common.Reference       `json:"verifiedWith,omitempty"`
 }
 
 // ConsentProvisionActor represents an actor in a consent provision
@@ -36,8 +39,9 @@ type ConsentProvisionActor struct {
 type ConsentProvisionData struct {
 	common.BackboneElement
 
-	Meaning   ConsentProvisionDataMeaning `json:"meaning"`
-	Reference common.Reference            `json:"reference"`
+	Meaning        ConsentProvisionDataMeaning `json:"meaning"`
+	MeaningElement *common.Element             `json:"_meaning,omitempty"`
+	Reference      common.Reference            `json:"reference"`
 }
 
 // ConsentProvision represents a provision in consent
@@ -66,8 +70,10 @@ type Consent struct {
 
 	Category         []common.CodeableConcept `json:"category,omitempty"`
 	Controller       []common.Reference       `json:"controller,omitempty"`
-	Date             *string                  `json:"date,omitempty"`
+	Date             *common.Date             `json:"date,omitempty"`
+	DateElement      *common.Element          `json:"_date,omitempty"`
 	Decision         *ConsentDecision         `json:"decision,omitempty"`
+	DecisionElement  *common.Element          `json:"_decision,omitempty"`
 	Grantee          []common.Reference       `json:"grantee,omitempty"`
 	Grantor          []common.Reference       `json:"grantor,omitempty"`
 	Identifier       []common.Identifier      `json:"identifier,omitempty"`
@@ -80,6 +86,7 @@ type Consent struct {
 	SourceAttachment []Attachment             `json:"sourceAttachment,omitempty"`
 	SourceReference  []common.Reference       `json:"sourceReference,omitempty"`
 	Status           ConsentStatus            `json:"status"`
+	StatusElement    *common.Element          `json:"_status,omitempty"`
 	Subject          *common.Reference        `json:"subject,omitempty"`
 	Verification     []ConsentVerification    `json:"verification,omitempty"`
 }
@@ -9,12 +9,15 @@ import (
 type ContractContentDefinition struct {
 	common.BackboneElement
 
-	Copyright         *string                                    `json:"copyright,omitempty"`
-	PublicationDate   *string                                    `json:"publicationDate,omitempty"`
-	PublicationStatus ContractContentDefinitionPublicationStatus `json:"publicationStatus"`
-	Publisher         *common.Reference                          `json:"publisher,omitempty"`
-	SubType           *common.CodeableConcept                    `json:"subType,omitempty"`
-	Type              common.CodeableConcept                     `json:"type"`
+	Copyright                *string                                    `json:"copyright,omitempty"`
+	CopyrightElement         *common.Element                            `json:"_copyright,omitempty"`
+	PublicationDate          *common.Date                               `json:"publicationDate,omitempty"`
+	PublicationDateElement   *common.Element                            `json:"_publicationDate,omitempty"`
+	PublicationStatus        ContractContentDefinitionPublicationStatus `json:"publicationStatus"`
+	PublicationStatusElement *common.Element                            `json:"_publicationStatus,omitempty"`
+	Publisher                *common.Reference                          `json:"publisher,omitempty"`
+	SubType                  *common.CodeableConcept                    `json:"subType,omitempty"`
+	Type                     common.CodeableConcept                     `json:"type"`
 }
 
 // ContractSigner represents a signer of a contract
@@ -57,6 +60,7 @@ type Contract struct {
 	ResourceType string `json:"resourceType"` // Always "Contract"
 
 	Alias                    []string                   `json:"alias,omitempty"`
+	AliasElement             []*common.Element          `json:"_alias,omitempty"`
 	Applies                  *common.Period             `json:"applies,omitempty"`
 	Author                   *common.Reference          `json:"author,omitempty"`
 	Authority                []common.Reference         `json:"authority,omitempty"`
@@ -68,29 +72,37 @@ type Contract struct {
 	Identifier               []common.Identifier        `json:"identifier,omitempty"`
 	InstantiatesCanonical    *common.Reference          `json:"instantiatesCanonical,omitempty"`
 	InstantiatesUri          *string                    `json:"instantiatesUri,omitempty"`
-	Issued                   *string                    `json:"issued,omitempty"`
+	InstantiatesUriElement   *common.Element            `json:"_instantiatesUri,omitempty"`
+	Issued                   *common.DateTime           `json:"issued,omitempty"`
+	IssuedElement            *common.Element            `json:"_issued,omitempty"`
 	Legal                    []ContractLegal            `json:"legal,omitempty"`
 	LegallyBindingAttachment *Attachment                `json:"legallyBindingAttachment,omitempty"`
 	LegallyBindingReference  *common.Reference          `json:"legallyBindingReference,omitempty"`
 	LegalState               *common.CodeableConcept    `json:"legalState,omitempty"`
 	Name                     *string                    `json:"name,omitempty"`
+	NameElement              *common.Element            `json:"_name,omitempty"`
 	RelevantHistory          []common.Reference         `json:"relevantHistory,omitempty"`
 	Rule                     []ContractRule             `json:"rule,omitempty"`
 	Scope                    *common.CodeableConcept    `json:"scope,omitempty"`
 	Signer                   []ContractSigner           `json:"signer,omitempty"`
 	Site                     []common.Reference         `json:"site,omitempty"`
 	Status                   *ContractStatus            `json:"status,omitempty"`
+	StatusElement            *common.Element            `json:"_status,omitempty"`
 	Subject                  []common.Reference         `json:"subject,omitempty"`
 	Subtitle                 *string                    `json:"subtitle,omitempty"`
+	SubtitleElement          *common.Element            `json:"_subtitle,omitempty"`
 	SubType                  []common.CodeableConcept   `json:"subType,omitempty"`
 	SupportingInfo           []common.Reference         `json:"supportingInfo,omitempty"`
 	Term                     []ContractTerm             `json:"term,omitempty"`
 	Title                    *string                    `json:"title,omitempty"`
+	TitleElement             *common.Element            `json:"_title,omitempty"`
 	TopicCodeableConcept     *common.CodeableConcept    `json:"topicCodeableConcept,omitempty"`
 	TopicReference           *common.Reference          `json:"topicReference,omitempty"`
 	Type                     *common.CodeableConcept    `json:"type,omitempty"`
 	URL                      *string                    `json:"url,omitempty"`
+	URLElement               *common.Element            `json:"_url,omitempty"`
 	Version                  *string                    `json:"version,omitempty"`
+	VersionElement           *common.Element            `json:"_version,omitempty"`
 }
 
 // ContractContentDefinitionPublicationStatus represents the publication status
@@ -11,16 +11,19 @@ type Resource struct {
 	ResourceType string `json:"resourceType"`
 
 	// Logical id of this artifact
-	ID *string `json:"id,omitempty"`
+	ID        *string         `json:"id,omitempty"`
+	IDElement *common.Element `json:"_id,omitempty"`
 
 	// Metadata about the resource
 	Meta *Meta `json:"meta,omitempty"`
 
 	// A set of rules under which this content was created
-	ImplicitRules *string `json:"implicitRules,omitempty"`
+	ImplicitRules        *string         `json:"implicitRules,omitempty"`
+	ImplicitRulesElement *common.Element `json:"_implicitRules,omitempty"`
 
 	// Language of the resource content
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 }
 
 // DomainResource is a resource that includes narrative, extensions, and contained resources (R5 version)
@@ -28,7 +31,7 @@ type DomainResource struct {
 	Resource
 
 	// Contained, inline Resources
-	Contained []interface{} `json:"contained,omitempty"`
+	Contained ResourceList `json:"contained,omitempty"`
 
 	// Additional content defined by implementations
 	Extension []common.Extension `json:"extension,omitempty"`
@@ -45,16 +48,20 @@ type Meta struct {
 	DataType
 
 	// Version specific identifier
-	VersionID *string `json:"versionId,omitempty"`
+	VersionID        *string         `json:"versionId,omitempty"`
+	VersionIDElement *common.Element `json:"_versionId,omitempty"`
 
 	// When the resource version last changed
-	LastUpdated *string `json:"lastUpdated,omitempty"`
+	LastUpdated        *common.Instant `json:"lastUpdated,omitempty"`
+	LastUpdatedElement *common.Element `json:"_lastUpdated,omitempty"`
 
 	// Identifies where the resource comes from
-	Source *string `json:"source,omitempty"`
+	Source        *string         `json:"source,omitempty"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 
 	// Profiles this resource claims to conform to
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Security Labels applied to this resource
 	Security []common.Coding `json:"security,omitempty"`
@@ -68,7 +75,8 @@ type Narrative struct {
 	DataType
 
 	// generated | extensions | additional | empty
-	Status NarrativeStatus `json:"status"`
+	Status        NarrativeStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Limited xhtml content
 	Div string `json:"div"`
@@ -89,3 +97,9 @@ func StringPtr(s string) *string    { return &s }
 func BoolPtr(b bool) *bool          { return &b }
 func IntPtr(i int) *int             { return &i }
 func Float64Ptr(f float64) *float64 { return &f }
+
+// DecimalPtr parses s as a FHIR decimal and returns a pointer to it. It panics if s is not a valid decimal.
+func DecimalPtr(s string) *common.Decimal {
+	d := common.MustParseDecimal(s)
+	return &d
+}
@@ -9,17 +9,19 @@ import (
 type CoveragePaymentBy struct {
 	common.BackboneElement
 
-	Party          common.Reference `json:"party"`
-	Responsibility *string          `json:"responsibility,omitempty"`
+	Party                 common.Reference `json:"party"`
+	Responsibility        *string          `json:"responsibility,omitempty"`
+	ResponsibilityElement *common.Element  `json:"_responsibility,omitempty"`
 }
 
 // CoverageClass represents a class of coverage
 type CoverageClass struct {
 	common.BackboneElement
 
-	Name  *string                `json:"name,omitempty"`
-	Type  common.CodeableConcept `json:"type"`
-	Value common.Identifier      `json:"value"`
+	Name        *string                `json:"name,omitempty"`
+	NameElement *common.Element        `json:"_name,omitempty"`
+	Type        common.CodeableConcept `json:"type"`
+	Value       common.Identifier      `json:"value"`
 }
 
 // CoverageCostToBeneficiaryException represents exceptions to beneficiary costs
@@ -50,26 +52,32 @@ type Coverage struct {
 
 	ResourceType string `json:"resourceType"` // Always "Coverage"
 
-	Beneficiary       common.Reference            `json:"beneficiary"`
-	Class             []CoverageClass             `json:"class,omitempty"`
-	Contract          []common.Reference          `json:"contract,omitempty"`
-	CostToBeneficiary []CoverageCostToBeneficiary `json:"costToBeneficiary,omitempty"`
-	Dependent         *string                     `json:"dependent,omitempty"`
-	Identifier        []common.Identifier         `json:"identifier,omitempty"`
-	InsurancePlan     *common.Reference           `json:"insurancePlan,omitempty"`
-	Insurer           *common.Reference           `json:"insurer,omitempty"`
-	Kind              CoverageKind                `json:"kind"`
-	Network           *string                     `json:"network,omitempty"`
-	Order             *int                        `json:"order,omitempty"`
-	PaymentBy         []CoveragePaymentBy         `json:"paymentBy,omitempty"`
-	Period            *common.Period              `json:"period,omitempty"`
-	PolicyHolder      *common.Reference           `json:"policyHolder,omitempty"`
-	Relationship      *common.CodeableConcept     `json:"relationship,omitempty"`
-	Status            CoverageStatus              `json:"status"`
-	Subrogation       *bool                       `json:"subrogation,omitempty"`
-	Subscriber        *common.Reference           `json:"subscriber,omitempty"`
-	SubscriberId      []common.Identifier         `json:"subscriberId,omitempty"`
-	Type              *common.CodeableConcept     `json:"type,omitempty"`
+	Beneficiary        common.Reference            `json:"beneficiary"`
+	Class              []CoverageClass             `json:"class,omitempty"`
+	Contract           []common.Reference          `json:"contract,omitempty"`
+	CostToBeneficiary  []CoverageCostToBeneficiary `json:"costToBeneficiary,omitempty"`
+	Dependent          *string                     `json:"dependent,omitempty"`
+	DependentElement   *common.Element             `json:"_dependent,omitempty"`
+	Identifier         []common.Identifier         `json:"identifier,omitempty"`
+	InsurancePlan      *common.Reference           `json:"insurancePlan,omitempty"`
+	Insurer            *common.Reference           `json:"insurer,omitempty"`
+	Kind               CoverageKind                `json:"kind"`
+	KindElement        *common.Element             `json:"_kind,omitempty"`
+	Network            *string                     `json:"network,omitempty"`
+	NetworkElement     *common.Element             `json:"_network,omitempty"`
+	Order              *int                        `json:"order,omitempty"`
+	OrderElement       *common.Element             `json:"_order,omitempty"`
+	PaymentBy          []CoveragePaymentBy         `json:"paymentBy,omitempty"`
+	Period             *common.Period              `json:"period,omitempty"`
+	PolicyHolder       *common.Reference           `json:"policyHolder,omitempty"`
+	Relationship       *common.CodeableConcept     `json:"relationship,omitempty"`
+	Status             CoverageStatus              `json:"status"`
+	StatusElement      *common.Element             `json:"_status,omitempty"`
+	Subrogation        *bool                       `json:"subrogation,omitempty"`
+	SubrogationElement *common.Element             `json:"_subrogation,omitempty"`
+	Subscriber         *common.Reference           `json:"subscriber,omitempty"`
+	SubscriberId       []common.Identifier         `json:"subscriberId,omitempty"`
+	Type               *common.CodeableConcept     `json:"type,omitempty"`
 }
 
 // CoverageKind represents the kind of coverage
@@ -9,27 +9,32 @@ import (
 type CoverageEligibilityRequestEvent struct {
 	common.BackboneElement
 
-	Type         common.CodeableConcept `json:"type"`
-	WhenDateTime *string                `json:"whenDateTime,omitempty"`
-	WhenPeriod   *common.Period         `json:"whenPeriod,omitempty"`
+	Type                common.CodeableConcept `json:"type"`
+	WhenDateTime        *common.DateTime       `json:"whenDateTime,omitempty"`
+	WhenDateTimeElement *common.Element        `json:"_whenDateTime,omitempty"`
+	WhenPeriod          *common.Period         `json:"whenPeriod,omitempty"`
 }
 
 // CoverageEligibilityRequestSupportingInfo represents supporting information
 type CoverageEligibilityRequestSupportingInfo struct {
 	common.BackboneElement
 
-	AppliesToAll *bool            `json:"appliesToAll,omitempty"`
-	Information  common.Reference `json:"information"`
-	Sequence     int              `json:"sequence"`
+	AppliesToAll        *bool            `json:"appliesToAll,omitempty"`
+	AppliesToAllElement *common.Element  `json:"_appliesToAll,omitempty"`
+	Information         common.Reference `json:"information"`
+	Sequence            int              `json:"sequence"`
+	SequenceElement     *common.Element  `json:"_sequence,omitempty"`
 }
 
 // CoverageEligibilityRequestInsurance represents insurance information
 type CoverageEligibilityRequestInsurance struct {
 	common.BackboneElement
 
-	BusinessArrangement *string          `json:"businessArrangement,omitempty"`
-	Coverage            common.Reference `json:"coverage"`
-	Focal               *bool            `json:"focal,omitempty"`
+	BusinessArrangement        *string          `json:"businessArrangement,omitempty"`
+	BusinessArrangementElement *common.Element  `json:"_businessArrangement,omitempty"`
+	Coverage                   common.Reference `json:"coverage"`
+	Focal                      *bool            `json:"focal,omitempty"`
+	FocalElement               *common.Element  `json:"_focal,omitempty"`
 }
 
 // CoverageEligibilityRequestItemDiagnosis represents diagnosis for an item
@@ -44,16 +49,17 @@ type CoverageEligibilityRequestItemDiagnosis struct {
 type CoverageEligibilityRequestItem struct {
 	common.BackboneElement
 
-	Category               *common.CodeableConcept                   `json:"category,omitempty"`
-	Detail                 []common.Reference                        `json:"detail,omitempty"`
-	Diagnosis              []CoverageEligibilityRequestItemDiagnosis `json:"diagnosis,omitempty"`
-	Facility               *common.Reference                         `json:"facility,omitempty"`
-	Modifier               []common.CodeableConcept                  `json:"modifier,omitempty"`
-	ProductOrService       *common.CodeableConcept                   `json:"productOrService,omitempty"`
-	Provider               *common.Reference                         `json:"provider,omitempty"`
-	Quantity               *common.Quantity                          `json:"quantity,omitempty"`
-	SupportingInfoSequence []int                                     `json:"supportingInfoSequence,omitempty"`
-	UnitPrice              *Money                                    `json:"unitPrice,omitempty"`
+	Category                      *common.CodeableConcept                   `json:"category,omitempty"`
+	Detail                        []common.Reference                        `json:"detail,omitempty"`
+	Diagnosis                     []CoverageEligibilityRequestItemDiagnosis `json:"diagnosis,omitempty"`
+	Facility                      *common.Reference                         `json:"facility,omitempty"`
+	Modifier                      []common.CodeableConcept                  `json:"modifier,omitempty"`
+	ProductOrService              *common.CodeableConcept                   `json:"productOrService,omitempty"`
+	Provider                      *common.Reference                         `json:"provider,omitempty"`
+	Quantity                      *common.Quantity                          `json:"quantity,omitempty"`
+	SupportingInfoSequence        []int                                     `json:"supportingInfoSequence,omitempty"`
+	SupportingInfoSequenceElement []*common.Element                         `json:"_supportingInfoSequence,omitempty"`
+	UnitPrice                     *Money                                    `json:"unitPrice,omitempty"`
 }
 
 // CoverageEligibilityRequest represents a request for coverage eligibility
@@ -62,22 +68,26 @@ type CoverageEligibilityRequest struct {
 
 	ResourceType string `json:"resourceType"` // Always "CoverageEligibilityRequest"
 
-	Created        string                                     `json:"created"`
-	Enterer        *common.Reference                          `json:"enterer,omitempty"`
-	Event          []CoverageEligibilityRequestEvent          `json:"event,omitempty"`
-	Facility       *common.Reference                          `json:"facility,omitempty"`
-	Identifier     []common.Identifier                        `json:"identifier,omitempty"`
-	Insurance      []CoverageEligibilityRequestInsurance      `json:"insurance,omitempty"`
-	Insurer        common.Reference                           `json:"insurer"`
-	Item           []CoverageEligibilityRequestItem           `json:"item,omitempty"`
-	Patient        common.Reference                           `json:"patient"`
-	Priority       *common.CodeableConcept                    `json:"priority,omitempty"`
-	Provider       *common.Reference                          `json:"provider,omitempty"`
-	Purpose        []CoverageEligibilityRequestPurpose        `json:"purpose"`
-	ServicedDate   *string                                    `json:"servicedDate,omitempty"`
-	ServicedPeriod *common.Period                             `json:"servicedPeriod,omitempty"`
-	Status         CoverageEligibilityRequestStatus           `json:"status"`
-	SupportingInfo []CoverageEligibilityRequestSupportingInfo `json:"supportingInfo,omitempty"`
+	Created             common.DateTime                            `json:"created"`
+	CreatedElement      *common.Element                            `json:"_created,omitempty"`
+	Enterer             *common.Reference                          `json:"enterer,omitempty"`
+	Event               []CoverageEligibilityRequestEvent          `json:"event,omitempty"`
+	Facility            *common.Reference                          `json:"facility,omitempty"`
+	Identifier          []common.Identifier                        `json:"identifier,omitempty"`
+	Insurance           []CoverageEligibilityRequestInsurance      `json:"insurance,omitempty"`
+	Insurer             common.Reference                           `json:"insurer"`
+	Item                []CoverageEligibilityRequestItem           `json:"item,omitempty"`
+	Patient             common.Reference                           `json:"patient"`
+	Priority            *common.CodeableConcept                    `json:"priority,omitempty"`
+	Provider            *common.Reference                          `json:"provider,omitempty"`
+	Purpose             []CoverageEligibilityRequestPurpose        `json:"purpose"`
+	PurposeElement      []*common.Element                          `json:"_purpose,omitempty"`
+	ServicedDate        *common.Date                               `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element                            `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period                             `json:"servicedPeriod,omitempty"`
+	Status              CoverageEligibilityRequestStatus           `json:"status"`
+	StatusElement       *common.Element                            `json:"_status,omitempty"`
+	SupportingInfo      []CoverageEligibilityRequestSupportingInfo `json:"supportingInfo,omitempty"`
 }
 
 // CoverageEligibilityRequestPurpose represents the purpose of the request
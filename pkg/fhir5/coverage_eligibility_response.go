@@ -9,60 +9,72 @@ import (
 type CoverageEligibilityResponseEvent struct {
 	common.BackboneElement
 
-	Type         common.CodeableConcept `json:"type"`
-	WhenDateTime *string                `json:"whenDateTime,omitempty"`
-	WhenPeriod   *common.Period         `json:"whenPeriod,omitempty"`
+	Type                common.CodeableConcept `json:"type"`
+	WhenDateTime        *common.DateTime       `json:"whenDateTime,omitempty"`
+	WhenDateTimeElement *common.Element        `json:"_whenDateTime,omitempty"`
+	WhenPeriod          *common.Period         `json:"whenPeriod,omitempty"`
 }
 
 // CoverageEligibilityResponseError represents errors in the response
 type CoverageEligibilityResponseError struct {
 	common.BackboneElement
 
-	Code       common.CodeableConcept `json:"code"`
-	Expression []string               `json:"expression,omitempty"`
+	Code              common.CodeableConcept `json:"code"`
+	Expression        []string               `json:"expression,omitempty"`
+	ExpressionElement []*common.Element      `json:"_expression,omitempty"`
 }
 
 // CoverageEligibilityResponseInsuranceItemBenefit represents benefits for an item
 type CoverageEligibilityResponseInsuranceItemBenefit struct {
 	common.BackboneElement
 
-	AllowedUnsignedInt *int                   `json:"allowedUnsignedInt,omitempty"`
-	AllowedString      *string                `json:"allowedString,omitempty"`
-	AllowedMoney       *Money                 `json:"allowedMoney,omitempty"`
-	Type               common.CodeableConcept `json:"type"`
-	UsedUnsignedInt    *int                   `json:"usedUnsignedInt,omitempty"`
-	UsedString         *string                `json:"usedString,omitempty"`
-	UsedMoney          *Money                 `json:"usedMoney,omitempty"`
+	AllowedUnsignedInt        *int                   `json:"allowedUnsignedInt,omitempty"`
+	AllowedUnsignedIntElement *common.Element        `json:"_allowedUnsignedInt,omitempty"`
+	AllowedString             *string                `json:"allowedString,omitempty"`
+	AllowedStringElement      *common.Element        `json:"_allowedString,omitempty"`
+	AllowedMoney              *Money                 `json:"allowedMoney,omitempty"`
+	Type                      common.CodeableConcept `json:"type"`
+	UsedUnsignedInt           *int                   `json:"usedUnsignedInt,omitempty"`
+	UsedUnsignedIntElement    *common.Element        `json:"_usedUnsignedInt,omitempty"`
+	UsedString                *string                `json:"usedString,omitempty"`
+	UsedStringElement         *common.Element        `json:"_usedString,omitempty"`
+	UsedMoney                 *Money                 `json:"usedMoney,omitempty"`
 }
 
 // CoverageEligibilityResponseInsuranceItem represents an item in the response
 type CoverageEligibilityResponseInsuranceItem struct {
 	common.BackboneElement
 
-	AuthorizationRequired   *bool                                             `json:"authorizationRequired,omitempty"`
-	AuthorizationSupporting []common.CodeableConcept                          `json:"authorizationSupporting,omitempty"`
-	AuthorizationUrl        *string                                           `json:"authorizationUrl,omitempty"`
-	Benefit                 []CoverageEligibilityResponseInsuranceItemBenefit `json:"benefit,omitempty"`
-	Category                *common.CodeableConcept                           `json:"category,omitempty"`
-	Description             *string                                           `json:"description,omitempty"`
-	Excluded                *bool                                             `json:"excluded,omitempty"`
-	Modifier                []common.CodeableConcept                          `json:"modifier,omitempty"`
-	Name                    *string                                           `json:"name,omitempty"`
-	Network                 *common.CodeableConcept                           `json:"network,omitempty"`
-	ProductOrService        *common.CodeableConcept                           `json:"productOrService,omitempty"`
-	Provider                *common.Reference                                 `json:"provider,omitempty"`
-	Term                    *common.CodeableConcept                           `json:"term,omitempty"`
-	Unit                    *common.CodeableConcept                           `json:"unit,omitempty"`
+	AuthorizationRequired        *bool                                             `json:"authorizationRequired,omitempty"`
+	AuthorizationRequiredElement *common.Element                                   `json:"_authorizationRequired,omitempty"`
+	AuthorizationSupporting      []common.CodeableConcept                          `json:"authorizationSupporting,omitempty"`
+	AuthorizationUrl             *string                                           `json:"authorizationUrl,omitempty"`
+	AuthorizationUrlElement      *common.Element                                   `json:"_authorizationUrl,omitempty"`
+	Benefit                      []CoverageEligibilityResponseInsuranceItemBenefit `json:"benefit,omitempty"`
+	Category                     *common.CodeableConcept                           `json:"category,omitempty"`
+	Description                  *string                                           `json:"description,omitempty"`
+	DescriptionElement           *common.Element                                   `json:"_description,omitempty"`
+	Excluded                     *bool                                             `json:"excluded,omitempty"`
+	ExcludedElement              *common.Element                                   `json:"_excluded,omitempty"`
+	Modifier                     []common.CodeableConcept                          `json:"modifier,omitempty"`
+	Name                         *string                                           `json:"name,omitempty"`
+	NameElement                  *common.Element                                   `json:"_name,omitempty"`
+	Network                      *common.CodeableConcept                           `json:"network,omitempty"`
+	ProductOrService             *common.CodeableConcept                           `json:"productOrService,omitempty"`
+	Provider                     *common.Reference                                 `json:"provider,omitempty"`
+	Term                         *common.CodeableConcept                           `json:"term,omitempty"`
+	Unit                         *common.CodeableConcept                           `json:"unit,omitempty"`
 }
 
 // CoverageEligibilityResponseInsurance represents insurance information
 type CoverageEligibilityResponseInsurance struct {
 	common.BackboneElement
 
-	BenefitPeriod *common.Period                             `json:"benefitPeriod,omitempty"`
-	Coverage      common.Reference                           `json:"coverage"`
-	Inforce       *bool                                      `json:"inforce,omitempty"`
-	Item          []CoverageEligibilityResponseInsuranceItem `json:"item,omitempty"`
+	BenefitPeriod  *common.Period                             `json:"benefitPeriod,omitempty"`
+	Coverage       common.Reference                           `json:"coverage"`
+	Inforce        *bool                                      `json:"inforce,omitempty"`
+	InforceElement *common.Element                            `json:"_inforce,omitempty"`
+	Item           []CoverageEligibilityResponseInsuranceItem `json:"item,omitempty"`
 }
 
 // CoverageEligibilityResponse represents a response for coverage eligibility
@@ -71,23 +83,30 @@ type CoverageEligibilityResponse struct {
 
 	ResourceType string `json:"resourceType"` // Always "CoverageEligibilityResponse"
 
-	Created        string                                 `json:"created"`
-	Disposition    *string                                `json:"disposition,omitempty"`
-	Error          []CoverageEligibilityResponseError     `json:"error,omitempty"`
-	Event          []CoverageEligibilityResponseEvent     `json:"event,omitempty"`
-	Form           *common.CodeableConcept                `json:"form,omitempty"`
-	Identifier     []common.Identifier                    `json:"identifier,omitempty"`
-	Insurance      []CoverageEligibilityResponseInsurance `json:"insurance,omitempty"`
-	Insurer        common.Reference                       `json:"insurer"`
-	Outcome        CoverageEligibilityResponseOutcome     `json:"outcome"`
-	Patient        common.Reference                       `json:"patient"`
-	PreAuthRef     *string                                `json:"preAuthRef,omitempty"`
-	Purpose        []CoverageEligibilityResponsePurpose   `json:"purpose"`
-	Request        common.Reference                       `json:"request"`
-	Requestor      *common.Reference                      `json:"requestor,omitempty"`
-	ServicedDate   *string                                `json:"servicedDate,omitempty"`
-	ServicedPeriod *common.Period                         `json:"servicedPeriod,omitempty"`
-	Status         CoverageEligibilityResponseStatus      `json:"status"`
+	Created             common.DateTime                        `json:"created"`
+	CreatedElement      *common.Element                        `json:"_created,omitempty"`
+	Disposition         *string                                `json:"disposition,omitempty"`
+	DispositionElement  *common.Element                        `json:"_disposition,omitempty"`
+	Error               []CoverageEligibilityResponseError     `json:"error,omitempty"`
+	Event               []CoverageEligibilityResponseEvent     `json:"event,omitempty"`
+	Form                *common.CodeableConcept                `json:"form,omitempty"`
+	Identifier          []common.Identifier                    `json:"identifier,omitempty"`
+	Insurance           []CoverageEligibilityResponseInsurance `json:"insurance,omitempty"`
+	Insurer             common.Reference                       `json:"insurer"`
+	Outcome             CoverageEligibilityResponseOutcome     `json:"outcome"`
+	OutcomeElement      *common.Element                        `json:"_outcome,omitempty"`
+	Patient             common.Reference                       `json:"patient"`
+	PreAuthRef          *string                                `json:"preAuthRef,omitempty"`
+	PreAuthRefElement   *common.Element                        `json:"_preAuthRef,omitempty"`
+	Purpose             []CoverageEligibilityResponsePurpose   `json:"purpose"`
+	PurposeElement      []*common.Element                      `json:"_purpose,omitempty"`
+	Request             common.Reference                       `json:"request"`
+	Requestor           *common.Reference                      `json:"requestor,omitempty"`
+	ServicedDate        *common.Date                           `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element                        `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period                         `json:"servicedPeriod,omitempty"`
+	Status              CoverageEligibilityResponseStatus      `json:"status"`
+	StatusElement       *common.Element                        `json:"_status,omitempty"`
 }
 
 // CoverageEligibilityResponseOutcome represents the outcome of the response
@@ -2,8 +2,6 @@
 package fhir5
 
 import (
-	"time"
-
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
@@ -18,34 +16,43 @@ type Address struct {
 	DataType
 
 	// The name of the city, town, suburb, village or other community or delivery center
-	City *string `json:"city,omitempty"`
+	City        *string         `json:"city,omitempty"`
+	CityElement *common.Element `json:"_city,omitempty"`
 
 	// ISO 3166 2- or 3- letter codes MAY be used in place of a human readable country name
-	Country *string `json:"country,omitempty"`
+	Country        *string         `json:"country,omitempty"`
+	CountryElement *common.Element `json:"_country,omitempty"`
 
 	// District is sometimes known as county
-	District *string `json:"district,omitempty"`
+	District        *string         `json:"district,omitempty"`
+	DistrictElement *common.Element `json:"_district,omitempty"`
 
 	// House number, apartment number, street name, street direction, P.O. Box number, delivery hints
-	Line []string `json:"line,omitempty"`
+	Line        []string          `json:"line,omitempty"`
+	LineElement []*common.Element `json:"_line,omitempty"`
 
 	// Time period when address was/is in use
 	Period *common.Period `json:"period,omitempty"`
 
 	// A postal code designating a region defined by the postal service
-	PostalCode *string `json:"postalCode,omitempty"`
+	PostalCode        *string         `json:"postalCode,omitempty"`
+	PostalCodeElement *common.Element `json:"_postalCode,omitempty"`
 
 	// Sub-unit of a country with limited sovereignty in a federally organized country
-	State *string `json:"state,omitempty"`
+	State        *string         `json:"state,omitempty"`
+	StateElement *common.Element `json:"_state,omitempty"`
 
 	// Text representation of the address
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// postal | physical | both - The definition of Address
-	Type *AddressType `json:"type,omitempty"`
+	Type        *AddressType    `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// home | work | temp | old | billing - purpose of this address
-	Use *AddressUse `json:"use,omitempty"`
+	Use        *AddressUse     `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 }
 
 // AddressType represents the type of address
@@ -73,14 +80,17 @@ type Annotation struct {
 	DataType
 
 	// Organization is used when there's no need for specific attribution
-	AuthorReference *common.Reference `json:"authorReference,omitempty"`
-	AuthorString    *string           `json:"authorString,omitempty"`
+	AuthorReference     *common.Reference `json:"authorReference,omitempty"`
+	AuthorString        *string           `json:"authorString,omitempty"`
+	AuthorStringElement *common.Element   `json:"_authorString,omitempty"`
 
 	// The text of the annotation in markdown format
-	Text string `json:"text"`
+	Text        string          `json:"text"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Indicates when this particular annotation was made
-	Time *time.Time `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 }
 
 // Attachment represents data content defined in other formats
@@ -89,43 +99,56 @@ type Attachment struct {
 	DataType
 
 	// Identifies the type of the data in the attachment
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The date that the attachment was first created
-	Creation *time.Time `json:"creation,omitempty"`
+	Creation        *common.DateTime `json:"creation,omitempty"`
+	CreationElement *common.Element  `json:"_creation,omitempty"`
 
 	// The base64-encoded data
-	Data *string `json:"data,omitempty"`
+	Data        *string         `json:"data,omitempty"`
+	DataElement *common.Element `json:"_data,omitempty"`
 
 	// The duration might differ from occurrencePeriod if recording was paused
-	Duration *int `json:"duration,omitempty"`
+	Duration        *int            `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
 
 	// Number of frames if not supplied, value may be unknown
-	Frames *int `json:"frames,omitempty"`
+	Frames        *int            `json:"frames,omitempty"`
+	FramesElement *common.Element `json:"_frames,omitempty"`
 
 	// Hash of the data (sha-1, base64ed)
-	Hash *string `json:"hash,omitempty"`
+	Hash        *string         `json:"hash,omitempty"`
+	HashElement *common.Element `json:"_hash,omitempty"`
 
 	// Height of the image in pixels (photo/video)
-	Height *int `json:"height,omitempty"`
+	Height        *int            `json:"height,omitempty"`
+	HeightElement *common.Element `json:"_height,omitempty"`
 
 	// Human language of the content (BCP-47)
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 
 	// The number of pages when printed
-	Pages *int `json:"pages,omitempty"`
+	Pages        *int            `json:"pages,omitempty"`
+	PagesElement *common.Element `json:"_pages,omitempty"`
 
 	// Number of bytes of content (if url provided) - Note: string in R5, int in R4
-	Size *string `json:"size,omitempty"`
+	Size        *string         `json:"size,omitempty"`
+	SizeElement *common.Element `json:"_size,omitempty"`
 
 	// Label to display in place of the data
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Uri where the data can be found
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// Width of the image in pixels (photo/video)
-	Width *int `json:"width,omitempty"`
+	Width        *int            `json:"width,omitempty"`
+	WidthElement *common.Element `json:"_width,omitempty"`
 }
 
 // Availability represents availability data for an item (new in R5)
@@ -144,16 +167,20 @@ type AvailabilityAvailableTime struct {
 	common.Element
 
 	// Always available? i.e. 24 hour service
-	AllDay *bool `json:"allDay,omitempty"`
+	AllDay        *bool           `json:"allDay,omitempty"`
+	AllDayElement *common.Element `json:"_allDay,omitempty"`
 
 	// Closing time of day (ignored if allDay = true)
-	AvailableEndTime *string `json:"availableEndTime,omitempty"`
+	AvailableEndTime        *common.Time    `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement *common.Element `json:"_availableEndTime,omitempty"`
 
 	// Opening time of day (ignored if allDay = true)
-	AvailableStartTime *string `json:"availableStartTime,omitempty"`
+	AvailableStartTime        *common.Time    `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element `json:"_availableStartTime,omitempty"`
 
 	// mon | tue | wed | thu | fri | sat | sun
-	DaysOfWeek []DaysOfWeek `json:"daysOfWeek,omitempty"`
+	DaysOfWeek        []DaysOfWeek      `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement []*common.Element `json:"_daysOfWeek,omitempty"`
 }
 
 // AvailabilityNotAvailableTime represents not available during this time due to provided reason
@@ -161,7 +188,8 @@ type AvailabilityNotAvailableTime struct {
 	common.Element
 
 	// Reason presented to the user explaining why time not available
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Service not available during this period
 	During *common.Period `json:"during,omitempty"`
@@ -222,22 +250,28 @@ type HumanName struct {
 	DataType
 
 	// usual | official | temp | nickname | anonymous | old | maiden
-	Use *HumanNameUse `json:"use,omitempty"`
+	Use        *HumanNameUse   `json:"use,omitempty"`
+	UseElement *common.Element `json:"_use,omitempty"`
 
 	// Text representation of the full name
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Family name (often called 'Surname')
-	Family *string `json:"family,omitempty"`
+	Family        *string         `json:"family,omitempty"`
+	FamilyElement *common.Element `json:"_family,omitempty"`
 
 	// Given names (not always 'first'). Includes middle names
-	Given []string `json:"given,omitempty"`
+	Given        []string          `json:"given,omitempty"`
+	GivenElement []*common.Element `json:"_given,omitempty"`
 
 	// Parts that come before the name
-	Prefix []string `json:"prefix,omitempty"`
+	Prefix        []string          `json:"prefix,omitempty"`
+	PrefixElement []*common.Element `json:"_prefix,omitempty"`
 
 	// Parts that come after the name
-	Suffix []string `json:"suffix,omitempty"`
+	Suffix        []string          `json:"suffix,omitempty"`
+	SuffixElement []*common.Element `json:"_suffix,omitempty"`
 
 	// Time period when name was/is in use
 	Period *common.Period `json:"period,omitempty"`
@@ -262,12 +296,18 @@ type RelatedArtifact struct {
 	DataType
 
 	Type              string            `json:"type"`
+	TypeElement       *common.Element   `json:"_type,omitempty"`
 	Label             *string           `json:"label,omitempty"`
+	LabelElement      *common.Element   `json:"_label,omitempty"`
 	Display           *string           `json:"display,omitempty"`
+	DisplayElement    *common.Element   `json:"_display,omitempty"`
 	Citation          *string           `json:"citation,omitempty"`
+	CitationElement   *common.Element   `json:"_citation,omitempty"`
 	URL               *string           `json:"url,omitempty"`
+	URLElement        *common.Element   `json:"_url,omitempty"`
 	Document          *Attachment       `json:"document,omitempty"`
 	Resource          *string           `json:"resource,omitempty"`
+	ResourceElement   *common.Element   `json:"_resource,omitempty"`
 	ResourceReference *common.Reference `json:"resourceReference,omitempty"`
 }
 
@@ -276,14 +316,18 @@ type RelatedArtifact struct {
 type TriggerDefinition struct {
 	DataType
 
-	Type            string            `json:"type"`
-	Name            *string           `json:"name,omitempty"`
-	TimingTiming    *Timing           `json:"timingTiming,omitempty"`
-	TimingReference *common.Reference `json:"timingReference,omitempty"`
-	TimingDate      *string           `json:"timingDate,omitempty"`
-	TimingDateTime  *string           `json:"timingDateTime,omitempty"`
-	Data            []DataRequirement `json:"data,omitempty"`
-	Condition       *Expression       `json:"condition,omitempty"`
+	Type                  string            `json:"type"`
+	TypeElement           *common.Element   `json:"_type,omitempty"`
+	Name                  *string           `json:"name,omitempty"`
+	NameElement           *common.Element   `json:"_name,omitempty"`
+	TimingTiming          *Timing           `json:"timingTiming,omitempty"`
+	TimingReference       *common.Reference `json:"timingReference,omitempty"`
+	TimingDate            *common.Date      `json:"timingDate,omitempty"`
+	TimingDateElement     *common.Element   `json:"_timingDate,omitempty"`
+	TimingDateTime        *common.DateTime  `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element   `json:"_timingDateTime,omitempty"`
+	Data                  []DataRequirement `json:"data,omitempty"`
+	Condition             *Expression       `json:"condition,omitempty"`
 }
 
 // FHIR R5 Expression
@@ -291,11 +335,16 @@ type TriggerDefinition struct {
 type Expression struct {
 	DataType
 
-	Description *string `json:"description,omitempty"`
-	Name        *string `json:"name,omitempty"`
-	Language    string  `json:"language"`
-	Expression  *string `json:"expression,omitempty"`
-	Reference   *string `json:"reference,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+	Name               *string         `json:"name,omitempty"`
+	NameElement        *common.Element `json:"_name,omitempty"`
+	Language           string          `json:"language"`
+	LanguageElement    *common.Element `json:"_language,omitempty"`
+	Expression         *string         `json:"expression,omitempty"`
+	ExpressionElement  *common.Element `json:"_expression,omitempty"`
+	Reference          *string         `json:"reference,omitempty"`
+	ReferenceElement   *common.Element `json:"_reference,omitempty"`
 }
 
 // FHIR R5 DataRequirement
@@ -304,34 +353,46 @@ type DataRequirement struct {
 	DataType
 
 	Type                   string                      `json:"type"`
+	TypeElement            *common.Element             `json:"_type,omitempty"`
 	Profile                []string                    `json:"profile,omitempty"`
+	ProfileElement         []*common.Element           `json:"_profile,omitempty"`
 	SubjectCodeableConcept *common.CodeableConcept     `json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *common.Reference           `json:"subjectReference,omitempty"`
 	MustSupport            []string                    `json:"mustSupport,omitempty"`
+	MustSupportElement     []*common.Element           `json:"_mustSupport,omitempty"`
 	CodeFilter             []DataRequirementCodeFilter `json:"codeFilter,omitempty"`
 	DateFilter             []DataRequirementDateFilter `json:"dateFilter,omitempty"`
 	Limit                  *int                        `json:"limit,omitempty"`
+	LimitElement           *common.Element             `json:"_limit,omitempty"`
 	Sort                   []DataRequirementSort       `json:"sort,omitempty"`
 }
 
 type DataRequirementCodeFilter struct {
-	Path        string                   `json:"path"`
-	SearchParam *string                  `json:"searchParam,omitempty"`
-	ValueSet    *string                  `json:"valueSet,omitempty"`
-	Code        []common.CodeableConcept `json:"code,omitempty"`
+	Path               string                   `json:"path"`
+	PathElement        *common.Element          `json:"_path,omitempty"`
+	SearchParam        *string                  `json:"searchParam,omitempty"`
+	SearchParamElement *common.Element          `json:"_searchParam,omitempty"`
+	ValueSet           *string                  `json:"valueSet,omitempty"`
+	ValueSetElement    *common.Element          `json:"_valueSet,omitempty"`
+	Code               []common.CodeableConcept `json:"code,omitempty"`
 }
 
 type DataRequirementDateFilter struct {
-	Path          string         `json:"path"`
-	SearchParam   *string        `json:"searchParam,omitempty"`
-	ValueDateTime *string        `json:"valueDateTime,omitempty"`
-	ValuePeriod   *common.Period `json:"valuePeriod,omitempty"`
-	ValueDuration *Duration      `json:"valueDuration,omitempty"`
+	Path                 string           `json:"path"`
+	PathElement          *common.Element  `json:"_path,omitempty"`
+	SearchParam          *string          `json:"searchParam,omitempty"`
+	SearchParamElement   *common.Element  `json:"_searchParam,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+	ValuePeriod          *common.Period   `json:"valuePeriod,omitempty"`
+	ValueDuration        *Duration        `json:"valueDuration,omitempty"`
 }
 
 type DataRequirementSort struct {
-	Path      string `json:"path"`
-	Direction string `json:"direction"`
+	Path             string          `json:"path"`
+	PathElement      *common.Element `json:"_path,omitempty"`
+	Direction        string          `json:"direction"`
+	DirectionElement *common.Element `json:"_direction,omitempty"`
 }
 
 // ContactDetail represents contact information
@@ -339,7 +400,8 @@ type ContactDetail struct {
 	DataType
 
 	// Name of the contact
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Contact details
 	Telecom []ContactPoint `json:"telecom,omitempty"`
@@ -350,16 +412,20 @@ type ContactPoint struct {
 	DataType
 
 	// phone | fax | email | pager | url | sms | other
-	System *ContactPointSystem `json:"system,omitempty"`
+	System        *ContactPointSystem `json:"system,omitempty"`
+	SystemElement *common.Element     `json:"_system,omitempty"`
 
 	// The actual contact point details
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 
 	// home | work | temp | old | mobile - purpose of this contact point
-	Use *ContactPointUse `json:"use,omitempty"`
+	Use        *ContactPointUse `json:"use,omitempty"`
+	UseElement *common.Element  `json:"_use,omitempty"`
 
 	// Specify preferred order of use (1 = highest)
-	Rank *int `json:"rank,omitempty"`
+	Rank        *int            `json:"rank,omitempty"`
+	RankElement *common.Element `json:"_rank,omitempty"`
 
 	// Time period when the contact point was/is in use
 	Period *common.Period `json:"period,omitempty"`
@@ -414,6 +480,42 @@ type VirtualServiceDetail struct {
 // RelatedPersonCommunication represents a language communication preference for a related person
 type RelatedPersonCommunication struct {
 	common.BackboneElement
-	Language  common.CodeableConcept `json:"language"`
-	Preferred *bool                  `json:"preferred,omitempty"`
+	Language         common.CodeableConcept `json:"language"`
+	Preferred        *bool                  `json:"preferred,omitempty"`
+	PreferredElement *common.Element        `json:"_preferred,omitempty"`
+}
+
+// MarketingStatus represents the date range in which a medicinal product is marketed in a country or jurisdiction
+type MarketingStatus struct {
+	common.BackboneElement
+
+	// The country in which the marketing authorization has been granted
+	Country *common.CodeableConcept `json:"country,omitempty"`
+
+	// The jurisdiction in which specific provisions of the marketing authorization apply
+	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// The status of the marketing of the medicinal product
+	Status common.CodeableConcept `json:"status"`
+
+	// The period in which the product is placed on the market
+	DateRange *common.Period `json:"dateRange,omitempty"`
+
+	// The date when the product is restored to the market after a suspension
+	RestoreDate        *common.DateTime `json:"restoreDate,omitempty"`
+	RestoreDateElement *common.Element  `json:"_restoreDate,omitempty"`
+}
+
+// RatioRange represents a range of ratio values
+type RatioRange struct {
+	DataType
+
+	// Low numerator limit
+	LowNumerator *common.Quantity `json:"lowNumerator,omitempty"`
+
+	// High numerator limit
+	HighNumerator *common.Quantity `json:"highNumerator,omitempty"`
+
+	// Denominator value
+	Denominator *common.Quantity `json:"denominator,omitempty"`
 }
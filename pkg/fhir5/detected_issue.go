@@ -17,10 +17,11 @@ type DetectedIssueEvidence struct {
 type DetectedIssueMitigation struct {
 	common.BackboneElement
 
-	Action common.CodeableConcept `json:"action"`
-	Author *common.Reference      `json:"author,omitempty"`
-	Date   *string                `json:"date,omitempty"`
-	Note   []Annotation           `json:"note,omitempty"`
+	Action      common.CodeableConcept `json:"action"`
+	Author      *common.Reference      `json:"author,omitempty"`
+	Date        *common.DateTime       `json:"date,omitempty"`
+	DateElement *common.Element        `json:"_date,omitempty"`
+	Note        []Annotation           `json:"note,omitempty"`
 }
 
 // DetectedIssue represents a detected clinical issue
@@ -29,21 +30,26 @@ type DetectedIssue struct {
 
 	ResourceType string `json:"resourceType"` // Always "DetectedIssue"
 
-	Author             *common.Reference         `json:"author,omitempty"`
-	Category           []common.CodeableConcept  `json:"category,omitempty"`
-	Code               *common.CodeableConcept   `json:"code,omitempty"`
-	Detail             *string                   `json:"detail,omitempty"`
-	Encounter          *common.Reference         `json:"encounter,omitempty"`
-	Evidence           []DetectedIssueEvidence   `json:"evidence,omitempty"`
-	IdentifiedDateTime *string                   `json:"identifiedDateTime,omitempty"`
-	IdentifiedPeriod   *common.Period            `json:"identifiedPeriod,omitempty"`
-	Identifier         []common.Identifier       `json:"identifier,omitempty"`
-	Implicated         []common.Reference        `json:"implicated,omitempty"`
-	Mitigation         []DetectedIssueMitigation `json:"mitigation,omitempty"`
-	Reference          *string                   `json:"reference,omitempty"`
-	Severity           *DetectedIssueSeverity    `json:"severity,omitempty"`
-	Status             DetectedIssueStatus       `json:"status"`
-	Subject            *common.Reference         `json:"subject,omitempty"`
+	Author                    *common.Reference         `json:"author,omitempty"`
+	Category                  []common.CodeableConcept  `json:"category,omitempty"`
+	Code                      *common.CodeableConcept   `json:"code,omitempty"`
+	Detail                    *string                   `json:"detail,omitempty"`
+	DetailElement             *common.Element           `json:"_detail,omitempty"`
+	Encounter                 *common.Reference         `json:"encounter,omitempty"`
+	Evidence                  []DetectedIssueEvidence   `json:"evidence,omitempty"`
+	IdentifiedDateTime        *common.DateTime          `json:"identifiedDateTime,omitempty"`
+	IdentifiedDateTimeElement *common.Element           `json:"_identifiedDateTime,omitempty"`
+	IdentifiedPeriod          *common.Period            `json:"identifiedPeriod,omitempty"`
+	Identifier                []common.Identifier       `json:"identifier,omitempty"`
+	Implicated                []common.Reference        `json:"implicated,omitempty"`
+	Mitigation                []DetectedIssueMitigation `json:"mitigation,omitempty"`
+	Reference                 *string                   `json:"reference,omitempty"`
+	ReferenceElement          *common.Element           `json:"_reference,omitempty"`
+	Severity                  *DetectedIssueSeverity    `json:"severity,omitempty"`
+	SeverityElement           *common.Element           `json:"_severity,omitempty"`
+	Status                    DetectedIssueStatus       `json:"status"`
+	StatusElement             *common.Element           `json:"_status,omitempty"`
+	Subject                   *common.Reference         `json:"subject,omitempty"`
 }
 
 // DetectedIssueSeverity represents the severity of a detected issue
@@ -7,34 +7,46 @@ import (
 
 type DeviceConformsTo struct {
 	common.BackboneElement
-	Category      *common.CodeableConcept `json:"category,omitempty"`
-	Specification common.CodeableConcept  `json:"specification"`
-	Version       *string                 `json:"version,omitempty"`
+	Category       *common.CodeableConcept `json:"category,omitempty"`
+	Specification  common.CodeableConcept  `json:"specification"`
+	Version        *string                 `json:"version,omitempty"`
+	VersionElement *common.Element         `json:"_version,omitempty"`
 }
 
 type DeviceName struct {
 	common.BackboneElement
-	Display *bool          `json:"display,omitempty"`
-	Type    DeviceNameType `json:"type"`
-	Value   string         `json:"value"`
+	Display        *bool           `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
+	Type           DeviceNameType  `json:"type"`
+	TypeElement    *common.Element `json:"_type,omitempty"`
+	Value          string          `json:"value"`
+	ValueElement   *common.Element `json:"_value,omitempty"`
 }
 
 type DeviceVersion struct {
 	common.BackboneElement
-	Component   *common.Identifier      `json:"component,omitempty"`
-	InstallDate *string                 `json:"installDate,omitempty"`
-	Type        *common.CodeableConcept `json:"type,omitempty"`
-	Value       string                  `json:"value"`
+	Component          *common.Identifier      `json:"component,omitempty"`
+	InstallDate        *common.DateTime        `json:"installDate,omitempty"`
+	InstallDateElement *common.Element         `json:"_installDate,omitempty"`
+	Type               *common.CodeableConcept `json:"type,omitempty"`
+	Value              string                  `json:"value"`
+	ValueElement       *common.Element         `json:"_value,omitempty"`
 }
 
 type DeviceUdiCarrier struct {
 	common.BackboneElement
-	CarrierAIDC      *string                    `json:"carrierAIDC,omitempty"`
-	CarrierHRF       *string                    `json:"carrierHRF,omitempty"`
-	DeviceIdentifier string                     `json:"deviceIdentifier"`
-	EntryType        *DeviceUdiCarrierEntryType `json:"entryType,omitempty"`
-	Issuer           string                     `json:"issuer"`
-	Jurisdiction     *string                    `json:"jurisdiction,omitempty"`
+	CarrierAIDC             *string                    `json:"carrierAIDC,omitempty"`
+	CarrierAIDCElement      *common.Element            `json:"_carrierAIDC,omitempty"`
+	CarrierHRF              *string                    `json:"carrierHRF,omitempty"`
+	CarrierHRFElement       *common.Element            `json:"_carrierHRF,omitempty"`
+	DeviceIdentifier        string                     `json:"deviceIdentifier"`
+	DeviceIdentifierElement *common.Element            `json:"_deviceIdentifier,omitempty"`
+	EntryType               *DeviceUdiCarrierEntryType `json:"entryType,omitempty"`
+	EntryTypeElement        *common.Element            `json:"_entryType,omitempty"`
+	Issuer                  string                     `json:"issuer"`
+	IssuerElement           *common.Element            `json:"_issuer,omitempty"`
+	Jurisdiction            *string                    `json:"jurisdiction,omitempty"`
+	JurisdictionElement     *common.Element            `json:"_jurisdiction,omitempty"`
 }
 
 type DeviceProperty struct {
@@ -43,46 +55,59 @@ type DeviceProperty struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
 }
 
 type Device struct {
 	DomainResource
 
-	ResourceType          string                   `json:"resourceType"` // Always "Device"
-	AvailabilityStatus    *common.CodeableConcept  `json:"availabilityStatus,omitempty"`
-	BiologicalSourceEvent *common.Identifier       `json:"biologicalSourceEvent,omitempty"`
-	Category              []common.CodeableConcept `json:"category,omitempty"`
-	ConformsTo            []DeviceConformsTo       `json:"conformsTo,omitempty"`
-	Contact               []ContactPoint           `json:"contact,omitempty"`
-	Cycle                 *Count                   `json:"cycle,omitempty"`
-	Definition            *CodeableReference       `json:"definition,omitempty"`
-	DisplayName           *string                  `json:"displayName,omitempty"`
-	Duration              *Duration                `json:"duration,omitempty"`
-	Endpoint              []common.Reference       `json:"endpoint,omitempty"`
-	ExpirationDate        *string                  `json:"expirationDate,omitempty"`
-	Gateway               []CodeableReference      `json:"gateway,omitempty"`
-	Identifier            []common.Identifier      `json:"identifier,omitempty"`
-	Location              *common.Reference        `json:"location,omitempty"`
-	LotNumber             *string                  `json:"lotNumber,omitempty"`
-	ManufactureDate       *string                  `json:"manufactureDate,omitempty"`
-	Manufacturer          *string                  `json:"manufacturer,omitempty"`
-	Mode                  *common.CodeableConcept  `json:"mode,omitempty"`
-	ModelNumber           *string                  `json:"modelNumber,omitempty"`
-	Name                  []DeviceName             `json:"name,omitempty"`
-	Note                  []Annotation             `json:"note,omitempty"`
-	Owner                 *common.Reference        `json:"owner,omitempty"`
-	Parent                *common.Reference        `json:"parent,omitempty"`
-	PartNumber            *string                  `json:"partNumber,omitempty"`
-	Property              []DeviceProperty         `json:"property,omitempty"`
-	Safety                []common.CodeableConcept `json:"safety,omitempty"`
-	SerialNumber          *string                  `json:"serialNumber,omitempty"`
-	Status                *DeviceStatus            `json:"status,omitempty"`
-	Type                  []common.CodeableConcept `json:"type,omitempty"`
-	UdiCarrier            []DeviceUdiCarrier       `json:"udiCarrier,omitempty"`
-	Url                   *string                  `json:"url,omitempty"`
-	Version               []DeviceVersion          `json:"version,omitempty"`
+	ResourceType           string                   `json:"resourceType"` // Always "Device"
+	AvailabilityStatus     *common.CodeableConcept  `json:"availabilityStatus,omitempty"`
+	BiologicalSourceEvent  *common.Identifier       `json:"biologicalSourceEvent,omitempty"`
+	Category               []common.CodeableConcept `json:"category,omitempty"`
+	ConformsTo             []DeviceConformsTo       `json:"conformsTo,omitempty"`
+	Contact                []ContactPoint           `json:"contact,omitempty"`
+	Cycle                  *Count                   `json:"cycle,omitempty"`
+	Definition             *CodeableReference       `json:"definition,omitempty"`
+	DisplayName            *string                  `json:"displayName,omitempty"`
+	DisplayNameElement     *common.Element          `json:"_displayName,omitempty"`
+	Duration               *Duration                `json:"duration,omitempty"`
+	Endpoint               []common.Reference       `json:"endpoint,omitempty"`
+	ExpirationDate         *common.DateTime         `json:"expirationDate,omitempty"`
+	ExpirationDateElement  *common.Element          `json:"_expirationDate,omitempty"`
+	Gateway                []CodeableReference      `json:"gateway,omitempty"`
+	Identifier             []common.Identifier      `json:"identifier,omitempty"`
+	Location               *common.Reference        `json:"location,omitempty"`
+	LotNumber              *string                  `json:"lotNumber,omitempty"`
+	LotNumberElement       *common.Element          `json:"_lotNumber,omitempty"`
+	ManufactureDate        *common.DateTime         `json:"manufactureDate,omitempty"`
+	ManufactureDateElement *common.Element          `json:"_manufactureDate,omitempty"`
+	Manufacturer           *string                  `json:"manufacturer,omitempty"`
+	ManufacturerElement    *common.Element          `json:"_manufacturer,omitempty"`
+	Mode                   *common.CodeableConcept  `json:"mode,omitempty"`
+	ModelNumber            *string                  `json:"modelNumber,omitempty"`
+	ModelNumberElement     *common.Element          `json:"_modelNumber,omitempty"`
+	Name                   []DeviceName             `json:"name,omitempty"`
+	Note                   []Annotation             `json:"note,omitempty"`
+	Owner                  *common.Reference        `json:"owner,omitempty"`
+	Parent                 *common.Reference        `json:"parent,omitempty"`
+	PartNumber             *string                  `json:"partNumber,omitempty"`
+	PartNumberElement      *common.Element          `json:"_partNumber,omitempty"`
+	Property               []DeviceProperty         `json:"property,omitempty"`
+	Safety                 []common.CodeableConcept `json:"safety,omitempty"`
+	SerialNumber           *string                  `json:"serialNumber,omitempty"`
+	SerialNumberElement    *common.Element          `json:"_serialNumber,omitempty"`
+	Status                 *DeviceStatus            `json:"status,omitempty"`
+	StatusElement          *common.Element          `json:"_status,omitempty"`
+	Type                   []common.CodeableConcept `json:"type,omitempty"`
+	UdiCarrier             []DeviceUdiCarrier       `json:"udiCarrier,omitempty"`
+	Url                    *string                  `json:"url,omitempty"`
+	UrlElement             *common.Element          `json:"_url,omitempty"`
+	Version                []DeviceVersion          `json:"version,omitempty"`
 }
 
 type DeviceNameType string
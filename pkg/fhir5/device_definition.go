@@ -12,7 +12,8 @@ type DeviceDefinitionUdiDeviceIdentifierMarketDistribution struct {
 	MarketPeriod common.Period `json:"marketPeriod"`
 
 	// National state or territory to which the marketDistribution refers
-	SubJurisdiction string `json:"subJurisdiction"`
+	SubJurisdiction        string          `json:"subJurisdiction"`
+	SubJurisdictionElement *common.Element `json:"_subJurisdiction,omitempty"`
 }
 
 // DeviceDefinitionUdiDeviceIdentifier represents UDI device identifier information
@@ -20,13 +21,16 @@ type DeviceDefinitionUdiDeviceIdentifier struct {
 	common.BackboneElement
 
 	// The identifier that is to be associated with every Device
-	DeviceIdentifier string `json:"deviceIdentifier"`
+	DeviceIdentifier        string          `json:"deviceIdentifier"`
+	DeviceIdentifierElement *common.Element `json:"_deviceIdentifier,omitempty"`
 
 	// The organization that assigns the identifier algorithm
-	Issuer string `json:"issuer"`
+	Issuer        string          `json:"issuer"`
+	IssuerElement *common.Element `json:"_issuer,omitempty"`
 
 	// The jurisdiction to which the deviceIdentifier applies
-	Jurisdiction string `json:"jurisdiction"`
+	Jurisdiction        string          `json:"jurisdiction"`
+	JurisdictionElement *common.Element `json:"_jurisdiction,omitempty"`
 
 	// Indicates where and when the device is available on the market
 	MarketDistribution []DeviceDefinitionUdiDeviceIdentifierMarketDistribution `json:"marketDistribution,omitempty"`
@@ -37,16 +41,20 @@ type DeviceDefinitionRegulatoryIdentifier struct {
 	common.BackboneElement
 
 	// The identifier itself
-	DeviceIdentifier string `json:"deviceIdentifier"`
+	DeviceIdentifier        string          `json:"deviceIdentifier"`
+	DeviceIdentifierElement *common.Element `json:"_deviceIdentifier,omitempty"`
 
 	// The organization that issued this identifier
-	Issuer string `json:"issuer"`
+	Issuer        string          `json:"issuer"`
+	IssuerElement *common.Element `json:"_issuer,omitempty"`
 
 	// The jurisdiction to which the deviceIdentifier applies
-	Jurisdiction string `json:"jurisdiction"`
+	Jurisdiction        string          `json:"jurisdiction"`
+	JurisdictionElement *common.Element `json:"_jurisdiction,omitempty"`
 
 	// The type of identifier itself
-	Type DeviceDefinitionRegulatoryIdentifierType `json:"type"`
+	Type        DeviceDefinitionRegulatoryIdentifierType `json:"type"`
+	TypeElement *common.Element                          `json:"_type,omitempty"`
 }
 
 // DeviceDefinitionRegulatoryIdentifierType represents the type of regulatory identifier
@@ -63,10 +71,12 @@ type DeviceDefinitionDeviceName struct {
 	common.BackboneElement
 
 	// A human-friendly name that is used to refer to the device
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of deviceName
-	Type DeviceDefinitionDeviceNameType `json:"type"`
+	Type        DeviceDefinitionDeviceNameType `json:"type"`
+	TypeElement *common.Element                `json:"_type,omitempty"`
 }
 
 // DeviceDefinitionDeviceNameType represents the type of device name
@@ -103,7 +113,8 @@ type DeviceDefinitionConformsTo struct {
 	Specification common.CodeableConcept `json:"specification"`
 
 	// Identifies the specific form or variant of the standard, specification, or formal guidance
-	Version []string `json:"version,omitempty"`
+	Version        []string          `json:"version,omitempty"`
+	VersionElement []*common.Element `json:"_version,omitempty"`
 }
 
 // DeviceDefinitionHasPart represents device component information
@@ -111,7 +122,8 @@ type DeviceDefinitionHasPart struct {
 	common.BackboneElement
 
 	// Number of instances of the component device in the current device
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// Reference to the device that is part of the current device
 	Reference common.Reference `json:"reference"`
@@ -122,7 +134,8 @@ type DeviceDefinitionPackagingDistributor struct {
 	common.BackboneElement
 
 	// Distributor's human-readable name
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Distributor as an Organization resource
 	OrganizationReference []common.Reference `json:"organizationReference,omitempty"`
@@ -133,7 +146,8 @@ type DeviceDefinitionPackaging struct {
 	common.BackboneElement
 
 	// The number of items contained in the package (devices or sub-packages)
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// An organization that distributes the packaged device
 	Distributor []DeviceDefinitionPackagingDistributor `json:"distributor,omitempty"`
@@ -162,7 +176,8 @@ type DeviceDefinitionVersion struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// The version of the device or software
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // DeviceDefinitionProperty represents device property information
@@ -198,10 +213,12 @@ type DeviceDefinitionMaterial struct {
 	Substance *common.CodeableConcept `json:"substance,omitempty"`
 
 	// Indicates if the material is an alternative to the base material
-	Alternate *bool `json:"alternate,omitempty"`
+	Alternate        *bool           `json:"alternate,omitempty"`
+	AlternateElement *common.Element `json:"_alternate,omitempty"`
 
 	// Whether the substance is a known or suspected allergen
-	AllergenicIndicator *bool `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicator        *bool           `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *common.Element `json:"_allergenicIndicator,omitempty"`
 }
 
 // DeviceDefinitionGuideline represents device guideline information
@@ -221,7 +238,8 @@ type DeviceDefinitionGuideline struct {
 	Warning []common.CodeableConcept `json:"warning,omitempty"`
 
 	// A description of the general purpose or medical use of the device or function of the device
-	IntendedUse *string `json:"intendedUse,omitempty"`
+	IntendedUse        *string         `json:"intendedUse,omitempty"`
+	IntendedUseElement *common.Element `json:"_intendedUse,omitempty"`
 }
 
 // DeviceDefinitionCorrectiveAction represents corrective action information
@@ -238,7 +256,8 @@ type DeviceDefinitionCorrectiveAction struct {
 	Device common.Reference `json:"device"`
 
 	// The scope of the corrective action - whether the action targeted all instances of this device
-	Scope *DeviceDefinitionCorrectiveActionScope `json:"scope,omitempty"`
+	Scope        *DeviceDefinitionCorrectiveActionScope `json:"scope,omitempty"`
+	ScopeElement *common.Element                        `json:"_scope,omitempty"`
 }
 
 // DeviceDefinitionCorrectiveActionScope represents the scope of corrective action
@@ -285,7 +304,8 @@ type DeviceDefinition struct {
 	CorrectiveAction *DeviceDefinitionCorrectiveAction `json:"correctiveAction,omitempty"`
 
 	// Additional information to describe the device
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The name or names of the device as given by the manufacturer
 	DeviceName []DeviceDefinitionDeviceName `json:"deviceName,omitempty"`
@@ -312,7 +332,8 @@ type DeviceDefinition struct {
 	Material []DeviceDefinitionMaterial `json:"material,omitempty"`
 
 	// The model number for the device for example as defined by the manufacturer or labeler, or other agency
-	ModelNumber *string `json:"modelNumber,omitempty"`
+	ModelNumber        *string         `json:"modelNumber,omitempty"`
+	ModelNumberElement *common.Element `json:"_modelNumber,omitempty"`
 
 	// Descriptive information, usage information or implantation information that is not captured in an existing element
 	Note []Annotation `json:"note,omitempty"`
@@ -324,10 +345,12 @@ type DeviceDefinition struct {
 	Packaging []DeviceDefinitionPackaging `json:"packaging,omitempty"`
 
 	// Alphanumeric Maximum 20
-	PartNumber *string `json:"partNumber,omitempty"`
+	PartNumber        *string         `json:"partNumber,omitempty"`
+	PartNumberElement *common.Element `json:"_partNumber,omitempty"`
 
 	// Indicates the production identifier(s) that are expected to appear in the UDI carrier on the device label
-	ProductionIdentifierInUDI []DeviceDefinitionProductionIdentifierInUDI `json:"productionIdentifierInUDI,omitempty"`
+	ProductionIdentifierInUDI        []DeviceDefinitionProductionIdentifierInUDI `json:"productionIdentifierInUDI,omitempty"`
+	ProductionIdentifierInUDIElement []*common.Element                           `json:"_productionIdentifierInUDI,omitempty"`
 
 	// Dynamic or current properties, such as settings, of an individual device are described using a Device instance-specific DeviceMetric
 	Property []DeviceDefinitionProperty `json:"property,omitempty"`
@@ -56,7 +56,8 @@ type DeviceDispense struct {
 	Performer []DeviceDispensePerformer `json:"performer,omitempty"`
 
 	// The time when the dispensed product was packaged and reviewed
-	PreparedDate *string `json:"preparedDate,omitempty"`
+	PreparedDate        *common.DateTime `json:"preparedDate,omitempty"`
+	PreparedDateElement *common.Element  `json:"_preparedDate,omitempty"`
 
 	// The number of devices that have been dispensed
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -65,7 +66,8 @@ type DeviceDispense struct {
 	Receiver *common.Reference `json:"receiver,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status DeviceDispenseStatus `json:"status"`
+	Status        DeviceDispenseStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// The subject for whom the device was dispensed
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -74,10 +76,12 @@ type DeviceDispense struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// The time the dispense was signed
-	WhenHandedOver *string `json:"whenHandedOver,omitempty"`
+	WhenHandedOver        *common.DateTime `json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement *common.Element  `json:"_whenHandedOver,omitempty"`
 
 	// The time when dispensed product was packaged and reviewed
-	WhenPrepared *string `json:"whenPrepared,omitempty"`
+	WhenPrepared        *string         `json:"whenPrepared,omitempty"`
+	WhenPreparedElement *common.Element `json:"_whenPrepared,omitempty"`
 }
 
 // DeviceDispenseStatus represents the status of the device dispense
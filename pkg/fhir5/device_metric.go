@@ -9,13 +9,16 @@ type DeviceMetricCalibration struct {
 	common.BackboneElement
 
 	// Describes the state of the calibration
-	State *DeviceMetricCalibrationState `json:"state,omitempty"`
+	State        *DeviceMetricCalibrationState `json:"state,omitempty"`
+	StateElement *common.Element               `json:"_state,omitempty"`
 
 	// Describes the time last calibration has been performed
-	Time *string `json:"time,omitempty"`
+	Time        *common.Instant `json:"time,omitempty"`
+	TimeElement *common.Element `json:"_time,omitempty"`
 
 	// Describes the type of the calibration method
-	Type *DeviceMetricCalibrationType `json:"type,omitempty"`
+	Type        *DeviceMetricCalibrationType `json:"type,omitempty"`
+	TypeElement *common.Element              `json:"_type,omitempty"`
 }
 
 // DeviceMetricCalibrationState represents the state of the calibration
@@ -49,10 +52,12 @@ type DeviceMetric struct {
 	Calibration []DeviceMetricCalibration `json:"calibration,omitempty"`
 
 	// Indicates the category of the observation generation process
-	Category DeviceMetricCategory `json:"category"`
+	Category        DeviceMetricCategory `json:"category"`
+	CategoryElement *common.Element      `json:"_category,omitempty"`
 
 	// The preferred color associated with the metric (e.g., display color)
-	Color *string `json:"color,omitempty"`
+	Color        *string         `json:"color,omitempty"`
+	ColorElement *common.Element `json:"_color,omitempty"`
 
 	// Note if this is not the top level device - also known as the source device
 	Device common.Reference `json:"device"`
@@ -64,7 +69,8 @@ type DeviceMetric struct {
 	MeasurementFrequency *common.Quantity `json:"measurementFrequency,omitempty"`
 
 	// Indicates current operational state of the device
-	OperationalStatus *DeviceMetricOperationalStatus `json:"operationalStatus,omitempty"`
+	OperationalStatus        *DeviceMetricOperationalStatus `json:"operationalStatus,omitempty"`
+	OperationalStatusElement *common.Element                `json:"_operationalStatus,omitempty"`
 
 	// DeviceMetric.type can be referred to either IEEE 11073-10101 or LOINC
 	Type common.CodeableConcept `json:"type"`
@@ -16,6 +16,7 @@ type DeviceRequestParameter struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 }
 
 // DeviceRequest represents a request for a patient to employ a medical device
@@ -26,13 +27,15 @@ type DeviceRequest struct {
 	ResourceType string `json:"resourceType"` // Always "DeviceRequest"
 
 	// This status is to indicate whether the request is a PRN or may be given as needed
-	AsNeeded *bool `json:"asNeeded,omitempty"`
+	AsNeeded        *bool           `json:"asNeeded,omitempty"`
+	AsNeededElement *common.Element `json:"_asNeeded,omitempty"`
 
 	// The reason for using the device
 	AsNeededFor *common.CodeableConcept `json:"asNeededFor,omitempty"`
 
 	// When the request transitioned to being actionable
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Plan/proposal/order fulfilled by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -41,7 +44,8 @@ type DeviceRequest struct {
 	Code CodeableReference `json:"code"`
 
 	// If do not perform is not specified, the request is a positive request
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// An encounter that provides additional context in which this request is made
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -53,24 +57,28 @@ type DeviceRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Note: This is a business identifier, not a resource identifier
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// Whether the request is a proposal, plan, an original order or a reflex order
-	Intent DeviceRequestIntent `json:"intent"`
+	Intent        DeviceRequestIntent `json:"intent"`
+	IntentElement *common.Element     `json:"_intent,omitempty"`
 
 	// Details about this request that were not represented at all or sufficiently in one of the attributes
 	Note []Annotation `json:"note,omitempty"`
 
 	// The timing schedule for the use of the device
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// Specific parameters for the ordered item
 	Parameter []DeviceRequestParameter `json:"parameter,omitempty"`
@@ -79,10 +87,12 @@ type DeviceRequest struct {
 	Performer *CodeableReference `json:"performer,omitempty"`
 
 	// Indicates how quickly the request should be addressed with respect to other requests
-	Priority *DeviceRequestPriority `json:"priority,omitempty"`
+	Priority        *DeviceRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
 
 	// The number of devices to be provided
-	Quantity *int `json:"quantity,omitempty"`
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
 
 	// Reason or justification for the use of this device
 	Reason []CodeableReference `json:"reason,omitempty"`
@@ -97,7 +107,8 @@ type DeviceRequest struct {
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// This element is labeled as a modifier because the status contains the codes revoked and entered-in-error
-	Status *DeviceRequestStatus `json:"status,omitempty"`
+	Status        *DeviceRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// The patient who will use the device
 	Subject common.Reference `json:"subject"`
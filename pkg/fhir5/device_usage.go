@@ -35,7 +35,8 @@ type DeviceUsage struct {
 	Context *common.Reference `json:"context,omitempty"`
 
 	// The time at which the statement was recorded by informationSource
-	DateAsserted *string `json:"dateAsserted,omitempty"`
+	DateAsserted        *common.DateTime `json:"dateAsserted,omitempty"`
+	DateAssertedElement *common.Element  `json:"_dateAsserted,omitempty"`
 
 	// The most common use cases for deriving a DeviceUsage comes from creating it from a request or from an observation or a claim
 	DerivedFrom []common.Reference `json:"derivedFrom,omitempty"`
@@ -59,12 +60,14 @@ type DeviceUsage struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 
 	// DeviceUseStatement is a statement at a point in time
-	Status DeviceUsageStatus `json:"status"`
+	Status        DeviceUsageStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// How often the device was used
-	TimingTiming   *Timing        `json:"timingTiming,omitempty"`
-	TimingPeriod   *common.Period `json:"timingPeriod,omitempty"`
-	TimingDateTime *string        `json:"timingDateTime,omitempty"`
+	TimingTiming          *Timing          `json:"timingTiming,omitempty"`
+	TimingPeriod          *common.Period   `json:"timingPeriod,omitempty"`
+	TimingDateTime        *common.DateTime `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement *common.Element  `json:"_timingDateTime,omitempty"`
 
 	// The reason for asserting the usage status
 	UsageReason []common.CodeableConcept `json:"usageReason,omitempty"`
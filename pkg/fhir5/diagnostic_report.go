@@ -38,7 +38,8 @@ type DiagnosticReportMedia struct {
 	common.BackboneElement
 
 	// The comment should be displayed with the image or data
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Reference to the image or data source
 	Link common.Reference `json:"link"`
@@ -64,14 +65,16 @@ type DiagnosticReport struct {
 	Composition *common.Reference `json:"composition,omitempty"`
 
 	// Concise and clinically contextualized summary conclusion (interpretation/impression) of the diagnostic report
-	Conclusion *string `json:"conclusion,omitempty"`
+	Conclusion        *string         `json:"conclusion,omitempty"`
+	ConclusionElement *common.Element `json:"_conclusion,omitempty"`
 
 	// One or more codes that represent the summary conclusion (interpretation/impression) of the diagnostic report
 	ConclusionCode []common.CodeableConcept `json:"conclusionCode,omitempty"`
 
 	// If the diagnostic procedure was performed on the patient, this is the time it was performed
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
 
 	// This will typically be the encounter the event occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -80,7 +83,8 @@ type DiagnosticReport struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// May be different from the update time of the resource itself, because that is the status of the record
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// A list of key images or data associated with this report
 	Media []DiagnosticReportMedia `json:"media,omitempty"`
@@ -104,7 +108,8 @@ type DiagnosticReport struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// The status of the diagnostic report
-	Status DiagnosticReportStatus `json:"status"`
+	Status        DiagnosticReportStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// For laboratory-type studies like GenomeStudy, type resources will be used for tracking additional metadata
 	Study []common.Reference `json:"study,omitempty"`
@@ -25,7 +25,8 @@ type DocumentReferenceAttester struct {
 	Party *common.Reference `json:"party,omitempty"`
 
 	// When the document was attested by the party
-	Time *string `json:"time,omitempty"`
+	Time        *common.DateTime `json:"time,omitempty"`
+	TimeElement *common.Element  `json:"_time,omitempty"`
 }
 
 // DocumentReferenceRelatesTo represents relationships this document has with other documents
@@ -44,9 +45,11 @@ type DocumentReferenceContentProfile struct {
 	common.BackboneElement
 
 	// Profile information as a coding, URI, or canonical reference
-	ValueCoding    *common.Coding `json:"valueCoding,omitempty"`
-	ValueUri       *string        `json:"valueUri,omitempty"`
-	ValueCanonical *string        `json:"valueCanonical,omitempty"`
+	ValueCoding           *common.Coding  `json:"valueCoding,omitempty"`
+	ValueUri              *string         `json:"valueUri,omitempty"`
+	ValueUriElement       *common.Element `json:"_valueUri,omitempty"`
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
 }
 
 // DocumentReferenceContent represents the content of the document
@@ -92,10 +95,12 @@ type DocumentReference struct {
 	Custodian *common.Reference `json:"custodian,omitempty"`
 
 	// When this document reference was created
-	Date *string `json:"date,omitempty"`
+	Date        *common.Instant `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Human-readable description
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Document security-tags
 	Facility *common.CodeableConcept `json:"facility,omitempty"`
@@ -116,7 +121,8 @@ type DocumentReference struct {
 	SecurityLabel []common.CodeableConcept `json:"securityLabel,omitempty"`
 
 	// Current | superseded | entered-in-error
-	Status DocumentReferenceStatus `json:"status"`
+	Status        DocumentReferenceStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// Who/what is the subject of the document
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -125,5 +131,6 @@ type DocumentReference struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// Document version
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
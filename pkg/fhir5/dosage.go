@@ -13,7 +13,8 @@ type Dosage struct {
 	AdditionalInstruction []common.CodeableConcept `json:"additionalInstruction,omitempty"`
 
 	// Can express "as needed" without a reason by setting the Boolean = True
-	AsNeeded *bool `json:"asNeeded,omitempty"`
+	AsNeeded        *bool           `json:"asNeeded,omitempty"`
+	AsNeededElement *common.Element `json:"_asNeeded,omitempty"`
 
 	// Can express "as needed" with a reason by including the CodeableConcept
 	AsNeededFor []common.CodeableConcept `json:"asNeededFor,omitempty"`
@@ -34,19 +35,22 @@ type Dosage struct {
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// Patient or consumer oriented instructions
-	PatientInstruction *string `json:"patientInstruction,omitempty"`
+	PatientInstruction        *string         `json:"patientInstruction,omitempty"`
+	PatientInstructionElement *common.Element `json:"_patientInstruction,omitempty"`
 
 	// How drug should enter body
 	Route *common.CodeableConcept `json:"route,omitempty"`
 
 	// Indicates the order in which the dosage instructions should be applied or interpreted
-	Sequence *int `json:"sequence,omitempty"`
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// Body site to administer to
 	Site *common.CodeableConcept `json:"site,omitempty"`
 
 	// Free text dosage instructions
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// Timing for the dosage
 	Timing *Timing `json:"timing,omitempty"`
@@ -77,7 +81,8 @@ type Timing struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Identifies specific times when the event occurs
-	Event []*string `json:"event,omitempty"`
+	Event        []*common.DateTime `json:"event,omitempty"`
+	EventElement []*common.Element  `json:"_event,omitempty"`
 
 	// A set of rules that describe when the event is scheduled
 	Repeat *TimingRepeat `json:"repeat,omitempty"`
@@ -93,44 +98,58 @@ type TimingRepeat struct {
 	BoundsPeriod   *common.Period `json:"boundsPeriod,omitempty"`
 
 	// Number of times to repeat
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// Maximum number of times to repeat
-	CountMax *int `json:"countMax,omitempty"`
+	CountMax        *int            `json:"countMax,omitempty"`
+	CountMaxElement *common.Element `json:"_countMax,omitempty"`
 
 	// How long when it happens
-	Duration *float64 `json:"duration,omitempty"`
+	Duration        *common.Decimal `json:"duration,omitempty"`
+	DurationElement *common.Element `json:"_duration,omitempty"`
 
 	// How long when it happens (Max)
-	DurationMax *float64 `json:"durationMax,omitempty"`
+	DurationMax        *common.Decimal `json:"durationMax,omitempty"`
+	DurationMaxElement *common.Element `json:"_durationMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	DurationUnit *string `json:"durationUnit,omitempty"`
+	DurationUnit        *string         `json:"durationUnit,omitempty"`
+	DurationUnitElement *common.Element `json:"_durationUnit,omitempty"`
 
 	// Event occurs frequency times per period
-	Frequency *int `json:"frequency,omitempty"`
+	Frequency        *int            `json:"frequency,omitempty"`
+	FrequencyElement *common.Element `json:"_frequency,omitempty"`
 
 	// Event occurs up to frequencyMax times per period
-	FrequencyMax *int `json:"frequencyMax,omitempty"`
+	FrequencyMax        *int            `json:"frequencyMax,omitempty"`
+	FrequencyMaxElement *common.Element `json:"_frequencyMax,omitempty"`
 
 	// Event occurs frequency times per period
-	Period *float64 `json:"period,omitempty"`
+	Period        *common.Decimal `json:"period,omitempty"`
+	PeriodElement *common.Element `json:"_period,omitempty"`
 
 	// Upper limit of period (3-4 hours)
-	PeriodMax *float64 `json:"periodMax,omitempty"`
+	PeriodMax        *common.Decimal `json:"periodMax,omitempty"`
+	PeriodMaxElement *common.Element `json:"_periodMax,omitempty"`
 
 	// s | min | h | d | wk | mo | a - unit of time (UCUM)
-	PeriodUnit *string `json:"periodUnit,omitempty"`
+	PeriodUnit        *string         `json:"periodUnit,omitempty"`
+	PeriodUnitElement *common.Element `json:"_periodUnit,omitempty"`
 
 	// mon | tue | wed | thu | fri | sat | sun
-	DayOfWeek []string `json:"dayOfWeek,omitempty"`
+	DayOfWeek        []string          `json:"dayOfWeek,omitempty"`
+	DayOfWeekElement []*common.Element `json:"_dayOfWeek,omitempty"`
 
 	// Time of day for action
-	TimeOfDay []*string `json:"timeOfDay,omitempty"`
+	TimeOfDay        []*common.Time    `json:"timeOfDay,omitempty"`
+	TimeOfDayElement []*common.Element `json:"_timeOfDay,omitempty"`
 
 	// Code for time period of occurrence
-	When []string `json:"when,omitempty"`
+	When        []string          `json:"when,omitempty"`
+	WhenElement []*common.Element `json:"_when,omitempty"`
 
 	// Minutes from event (before or after)
-	Offset *int `json:"offset,omitempty"`
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
 }
@@ -0,0 +1,463 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package fhir5
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// elementOrder lists the properties of each struct in the element order of FHIR XML
+var elementOrder = common.ElementOrder{
+	reflect.TypeOf(Account{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "billingStatus", "type", "name", "subject", "servicePeriod", "coverage", "owner", "description", "guarantor", "diagnosis", "procedure", "relatedAccount", "currency", "balance", "calculatedAt"},
+	reflect.TypeOf(AccountBalance{}):                                      {"id", "extension", "modifierExtension", "aggregate", "term", "estimate", "amount"},
+	reflect.TypeOf(AccountDiagnosis{}):                                    {"id", "extension", "modifierExtension", "sequence", "condition", "dateOfDiagnosis", "type", "onAdmission", "packageCode"},
+	reflect.TypeOf(AccountGuarantor{}):                                    {"id", "extension", "modifierExtension", "party", "onHold", "period"},
+	reflect.TypeOf(AccountProcedure{}):                                    {"id", "extension", "modifierExtension", "sequence", "code", "dateOfService", "type", "packageCode", "device"},
+	reflect.TypeOf(AccountRelatedAccount{}):                               {"id", "extension", "modifierExtension", "relationship", "account"},
+	reflect.TypeOf(ActivityDefinition{}):                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "subjectCanonical", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "kind", "profile", "code", "intent", "priority", "doNotPerform", "timingTiming", "timingAge", "timingRange", "timingDuration", "asNeededBoolean", "asNeededCodeableConcept", "location", "participant", "productReference", "productCodeableConcept", "quantity", "dosage", "bodySite", "specimenRequirement", "observationRequirement", "observationResultRequirement", "transform", "dynamicValue"},
+	reflect.TypeOf(ActivityDefinitionDynamicValue{}):                      {"id", "extension", "modifierExtension", "path", "expression"},
+	reflect.TypeOf(ActivityDefinitionParticipant{}):                       {"id", "extension", "modifierExtension", "type", "typeCanonical", "typeReference", "role", "function"},
+	reflect.TypeOf(ActorDefinition{}):                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "type", "documentation", "reference", "capabilities", "derivedFrom"},
+	reflect.TypeOf(Address{}):                                             {"id", "extension", "use", "type", "text", "line", "city", "district", "state", "postalCode", "country", "period"},
+	reflect.TypeOf(AdministrableProductDefinition{}):                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "formOf", "administrableDoseForm", "unitOfPresentation", "producedFrom", "ingredient", "device", "description", "property", "routeOfAdministration"},
+	reflect.TypeOf(AdministrableProductDefinitionProperty{}):              {"id", "extension", "modifierExtension", "type", "valueCodeableConcept", "valueQuantity", "valueDate", "valueBoolean", "valueMarkdown", "valueAttachment", "valueReference", "status"},
+	reflect.TypeOf(AdministrableProductDefinitionRouteOfAdministration{}): {"id", "extension", "modifierExtension", "code", "firstDose", "maxSingleDose", "maxDosePerDay", "maxDosePerTreatmentPeriod", "maxTreatmentPeriod", "targetSpecies"},
+	reflect.TypeOf(AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}): {"id", "extension", "modifierExtension", "tissue", "value", "supportingInformation"},
+	reflect.TypeOf(AdverseEvent{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "actuality", "category", "code", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "detected", "recordedDate", "resultingEffect", "location", "seriousness", "outcome", "recorder", "participant", "study", "expectedInResearchStudy", "suspectEntity", "contributingFactor", "preventiveAction", "mitigatingAction", "supportingInfo", "note"},
+	reflect.TypeOf(AdverseEventParticipant{}):                                              {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(AdverseEventSuspectEntity{}):                                            {"id", "extension", "modifierExtension", "instanceCodeableConcept", "instanceReference", "causality"},
+	reflect.TypeOf(AdverseEventSuspectEntityCausality{}):                                   {"id", "extension", "modifierExtension", "assessmentMethod", "entityRelatedness", "author"},
+	reflect.TypeOf(AllergyIntolerance{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "clinicalStatus", "verificationStatus", "type", "category", "criticality", "code", "patient", "encounter", "onsetDateTime", "onsetAge", "onsetPeriod", "onsetRange", "onsetString", "recordedDate", "participant", "lastOccurrence", "note", "reaction", "recorder"},
+	reflect.TypeOf(AllergyIntoleranceParticipant{}):                                        {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(AllergyIntoleranceReaction{}):                                           {"id", "extension", "modifierExtension", "substance", "manifestation", "description", "onset", "severity", "exposureRoute", "note"},
+	reflect.TypeOf(Annotation{}):                                                           {"id", "extension", "authorReference", "authorString", "time", "text"},
+	reflect.TypeOf(Appointment{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "cancellationReason", "class", "serviceCategory", "serviceType", "specialty", "appointmentType", "reason", "priority", "description", "replaces", "virtualService", "supportingInformation", "originatingAppointment", "start", "end", "minutesDuration", "requestedPeriod", "slot", "created", "note", "patientInstruction", "basedOn", "subject", "participant", "recurrenceTemplate"},
+	reflect.TypeOf(AppointmentParticipant{}):                                               {"id", "extension", "modifierExtension", "type", "period", "actor", "required", "status"},
+	reflect.TypeOf(AppointmentRecurrenceTemplate{}):                                        {"id", "extension", "modifierExtension", "timezone", "recurrenceType", "lastOccurrenceDate", "occurrenceCount", "weeklyTemplate", "monthlyTemplate", "yearlyTemplate", "excludingDate", "excludingRecurrenceId"},
+	reflect.TypeOf(AppointmentResponse{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "appointment", "proposedNewTime", "start", "end", "participantType", "actor", "participantStatus", "comment", "recurring", "occurrenceDate", "recurrenceId"},
+	reflect.TypeOf(ArtifactAssessment{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "title", "citeAsReference", "citeAsMarkdown", "date", "copyright", "approvalDate", "lastReviewDate", "artifactReference", "artifactCanonical", "artifactUri", "content", "workflowStatus", "disposition"},
+	reflect.TypeOf(ArtifactAssessmentContent{}):                                            {"id", "extension", "modifierExtension", "informationType", "summary", "type", "classifier", "quantity", "author", "path", "relatedArtifact", "freeToShare", "component"},
+	reflect.TypeOf(Attachment{}):                                                           {"id", "extension", "contentType", "language", "data", "url", "size", "hash", "title", "creation", "height", "width", "frames", "duration", "pages"},
+	reflect.TypeOf(AuditEvent{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "category", "code", "action", "severity", "occurredPeriod", "occurredDateTime", "recorded", "outcome", "authorization", "basedOn", "patient", "encounter", "agent", "source", "entity"},
+	reflect.TypeOf(AuditEventAgent{}):                                                      {"id", "extension", "modifierExtension", "type", "role", "who", "requestor", "location", "policy", "networkReference", "networkUri", "networkString", "authorization"},
+	reflect.TypeOf(AuditEventEntity{}):                                                     {"id", "extension", "modifierExtension", "what", "securityLabel", "query", "detail", "agent", "description", "lifecycle", "name", "type"},
+	reflect.TypeOf(AuditEventSource{}):                                                     {"id", "extension", "modifierExtension", "site", "observer", "type"},
+	reflect.TypeOf(AvailabilityAvailableTime{}):                                            {"id", "extension", "daysOfWeek", "allDay", "availableStartTime", "availableEndTime"},
+	reflect.TypeOf(Basic{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "subject", "created", "author"},
+	reflect.TypeOf(Binary{}):                                                               {"id", "meta", "implicitRules", "language", "contentType", "securityContext", "data"},
+	reflect.TypeOf(BiologicallyDerivedProduct{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "productCategory", "productCode", "parent", "request", "identifier", "biologicalSourceEvent", "processingFacility", "division", "productStatus", "expirationDate", "collection", "storageTempRequirements", "property"},
+	reflect.TypeOf(BiologicallyDerivedProductCollection{}):                                 {"id", "extension", "modifierExtension", "collector", "source", "collectedDateTime", "collectedPeriod"},
+	reflect.TypeOf(BiologicallyDerivedProductDispense{}):                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "originRelationshipType", "product", "patient", "matchStatus", "performer", "location", "quantity", "preparedDate", "whenHandedOver", "destination", "note", "usageInstruction"},
+	reflect.TypeOf(BiologicallyDerivedProductDispensePerformer{}):                          {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(BodyStructure{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "morphology", "includedStructure", "excludedStructure", "description", "image", "patient"},
+	reflect.TypeOf(BodyStructureIncludedStructure{}):                                       {"id", "extension", "modifierExtension", "structure", "laterality", "bodyLandmarkOrientation", "spatialReference", "qualifier"},
+	reflect.TypeOf(BodyStructureIncludedStructureBodyLandmarkOrientation{}):                {"id", "extension", "modifierExtension", "landmarkDescription", "clockFacePosition", "distanceFromLandmark", "surfaceOrientation"},
+	reflect.TypeOf(Bundle{}):                                                               {"id", "meta", "implicitRules", "language", "identifier", "type", "timestamp", "total", "link", "entry", "signature", "issues"},
+	reflect.TypeOf(BundleEntry{}):                                                          {"id", "extension", "modifierExtension", "link", "fullUrl", "resource", "search", "request", "response"},
+	reflect.TypeOf(BundleEntryRequest{}):                                                   {"id", "extension", "modifierExtension", "method", "url", "ifNoneMatch", "ifModifiedSince", "ifMatch", "ifNoneExist"},
+	reflect.TypeOf(BundleEntryResponse{}):                                                  {"id", "extension", "modifierExtension", "status", "location", "etag", "lastModified", "outcome"},
+	reflect.TypeOf(CapabilityStatement{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "kind", "instantiates", "imports", "software", "implementation", "fhirVersion", "format", "patchFormat", "acceptLanguage", "implementationGuide", "rest", "messaging", "document"},
+	reflect.TypeOf(CapabilityStatementImplementation{}):                                    {"id", "extension", "modifierExtension", "description", "url", "custodian"},
+	reflect.TypeOf(CapabilityStatementMessaging{}):                                         {"id", "extension", "modifierExtension", "endpoint", "documentation", "supportedMessage"},
+	reflect.TypeOf(CapabilityStatementRest{}):                                              {"id", "extension", "modifierExtension", "mode", "documentation", "security", "resource", "interaction", "operation", "compartment"},
+	reflect.TypeOf(CapabilityStatementRestResource{}):                                      {"id", "extension", "modifierExtension", "type", "profile", "supportedProfile", "documentation", "interaction", "versioning", "readHistory", "updateCreate", "conditionalCreate", "conditionalRead", "conditionalUpdate", "conditionalPatch", "conditionalDelete", "referencePolicy", "searchInclude", "searchRevInclude", "searchParam", "operation"},
+	reflect.TypeOf(CapabilityStatementRestResourceOperation{}):                             {"id", "extension", "modifierExtension", "name", "definition", "documentation"},
+	reflect.TypeOf(CapabilityStatementRestResourceSearchParam{}):                           {"id", "extension", "modifierExtension", "name", "definition", "type", "documentation"},
+	reflect.TypeOf(CapabilityStatementRestSecurity{}):                                      {"id", "extension", "modifierExtension", "cors", "service", "description"},
+	reflect.TypeOf(CapabilityStatementSoftware{}):                                          {"id", "extension", "modifierExtension", "name", "version", "releaseDate"},
+	reflect.TypeOf(CarePlan{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "replaces", "partOf", "status", "intent", "category", "title", "description", "subject", "encounter", "period", "created", "custodian", "contributor", "careTeam", "addresses", "supportingInfo", "goal", "activity", "note"},
+	reflect.TypeOf(CarePlanActivity{}):                                                     {"id", "extension", "modifierExtension", "performedActivity", "progress", "plannedActivityReference"},
+	reflect.TypeOf(CareTeam{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "name", "subject", "period", "participant", "reason", "managingOrganization", "telecom", "note"},
+	reflect.TypeOf(CareTeamParticipant{}):                                                  {"id", "extension", "modifierExtension", "role", "member", "onBehalfOf", "coveragePeriod", "coverageTiming"},
+	reflect.TypeOf(ChargeItem{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "definitionUri", "definitionCanonical", "status", "partOf", "code", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "performer", "performingOrganization", "requestingOrganization", "costCenter", "quantity", "bodysite", "unitPriceComponent", "totalPriceComponent", "overrideReason", "enterer", "enteredDate", "reason", "service", "product", "account", "note", "supportingInformation"},
+	reflect.TypeOf(ChargeItemDefinition{}):                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "derivedFromUri", "partOf", "replaces", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "code", "instance", "applicability", "propertyGroup"},
+	reflect.TypeOf(ChargeItemPerformer{}):                                                  {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(Citation{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "author", "editor", "reviewer", "endorser", "summary", "classification", "note", "currentState", "statusDate", "relatedArtifact", "citedArtifact"},
+	reflect.TypeOf(CitationCitedArtifact{}):                                                {"id", "extension", "modifierExtension", "version", "currentState", "statusDate", "title", "abstract", "part", "relatesTo", "publicationForm", "webLocation", "classification", "contributorship"},
+	reflect.TypeOf(CitationCitedArtifactAbstract{}):                                        {"id", "extension", "modifierExtension", "type", "language", "text", "copyright"},
+	reflect.TypeOf(CitationCitedArtifactContributorshipEntryContributionInstance{}):        {"id", "extension", "modifierExtension", "type", "time", "timeRange", "count", "countRange"},
+	reflect.TypeOf(CitationCitedArtifactPublicationFormPeriodicReleaseDateOfPublication{}): {"id", "text", "extension", "modifierExtension", "date", "year", "month", "day", "season"},
+	reflect.TypeOf(CitationCitedArtifactVersion{}):                                         {"id", "extension", "modifierExtension", "value", "baseCitation"},
+	reflect.TypeOf(CitationClassification{}):                                               {"id", "extension", "modifierExtension", "type", "classifier"},
+	reflect.TypeOf(Claim{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subType", "use", "patient", "billablePeriod", "created", "enterer", "insurer", "provider", "priority", "fundsReserve", "related", "prescription", "originalPrescription", "payee", "referral", "facility", "careTeam", "supportingInfo", "diagnosis", "procedure", "insurance", "accident", "item", "total"},
+	reflect.TypeOf(ClaimResponse{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subType", "use", "patient", "created", "insurer", "requestor", "request", "outcome", "disposition", "preAuthRef", "preAuthPeriod", "payeeType", "item", "addItem", "adjudication", "total", "payment", "fundsReserve", "formCode", "form", "processNote", "communicationRequest", "insurance", "error"},
+	reflect.TypeOf(ClinicalImpression{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusReason", "description", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "date", "performer", "previous", "problem", "changePattern", "protocol", "summary", "finding", "prognosisCodeableConcept", "prognosisReference", "supportingInfo", "note"},
+	reflect.TypeOf(ClinicalImpressionFinding{}):                                            {"id", "extension", "modifierExtension", "item", "basis"},
+	reflect.TypeOf(ClinicalUseDefinition{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "category", "subject", "status", "contraindication", "indication", "interaction", "population", "library", "undesirableEffect", "warning"},
+	reflect.TypeOf(ClinicalUseDefinitionContraindication{}):                                {"id", "extension", "modifierExtension", "diseaseSymptomProcedure", "diseaseStatus", "comorbidity", "indication", "applicability", "otherTherapy"},
+	reflect.TypeOf(ClinicalUseDefinitionIndication{}):                                      {"id", "extension", "modifierExtension", "diseaseSymptomProcedure", "diseaseStatus", "comorbidity", "intendedEffect", "durationRange", "durationString", "undesirableEffect", "applicability", "otherTherapy"},
+	reflect.TypeOf(ClinicalUseDefinitionInteraction{}):                                     {"id", "extension", "modifierExtension", "interactant", "type", "effect", "incidence", "management"},
+	reflect.TypeOf(ClinicalUseDefinitionUndesirableEffect{}):                               {"id", "extension", "modifierExtension", "symptomConditionEffect", "classification", "frequencyOfOccurrence"},
+	reflect.TypeOf(ClinicalUseDefinitionWarning{}):                                         {"id", "extension", "modifierExtension", "description", "code"},
+	reflect.TypeOf(CodeSystem{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "author", "caseSensitive", "hierarchyMeaning", "compositional", "versionNeeded", "content", "count", "filter", "property", "concept"},
+	reflect.TypeOf(CodeSystemConcept{}):                                                    {"id", "extension", "modifierExtension", "code", "display", "definition", "designation", "property", "concept"},
+	reflect.TypeOf(CodeSystemConceptDesignation{}):                                         {"id", "extension", "modifierExtension", "language", "use", "additionalUse", "value"},
+	reflect.TypeOf(CodeSystemProperty{}):                                                   {"id", "extension", "modifierExtension", "code", "uri", "description", "type"},
+	reflect.TypeOf(Communication{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "partOf", "inResponseTo", "status", "statusReason", "category", "priority", "medium", "subject", "topic", "about", "encounter", "sent", "received", "recipient", "sender", "reason", "payload", "note"},
+	reflect.TypeOf(CommunicationRequest{}):                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "replaces", "groupIdentifier", "status", "statusReason", "intent", "category", "priority", "doNotPerform", "medium", "subject", "about", "encounter", "payload", "occurrenceDateTime", "occurrencePeriod", "authoredOn", "requester", "recipient", "informationProvider", "reason", "note", "sender", "topic"},
+	reflect.TypeOf(CompartmentDefinition{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "status", "experimental", "date", "publisher", "contact", "description", "purpose", "code", "search", "resource"},
+	reflect.TypeOf(Composition{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "category", "subject", "encounter", "date", "author", "title", "confidentiality", "attester", "custodian", "relatesTo", "event", "section"},
+	reflect.TypeOf(ConceptMap{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "property", "additionalAttribute", "sourceScopeUri", "sourceScopeCanonical", "targetScopeUri", "targetScopeCanonical", "group"},
+	reflect.TypeOf(ConceptMapGroup{}):                                                      {"id", "extension", "modifierExtension", "source", "target", "element", "unmapped"},
+	reflect.TypeOf(ConceptMapGroupElement{}):                                               {"id", "extension", "modifierExtension", "code", "display", "valueSet", "noMap", "target"},
+	reflect.TypeOf(ConceptMapGroupElementTarget{}):                                         {"id", "extension", "modifierExtension", "code", "display", "valueSet", "relationship", "comment", "property", "dependsOn", "product"},
+	reflect.TypeOf(ConceptMapGroupUnmapped{}):                                              {"id", "extension", "modifierExtension", "mode", "code", "display", "valueSet", "relationship", "otherMap"},
+	reflect.TypeOf(Condition{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "clinicalStatus", "verificationStatus", "category", "severity", "code", "bodySite", "subject", "encounter", "onsetDateTime", "onsetAge", "onsetPeriod", "onsetRange", "onsetString", "abatementDateTime", "abatementAge", "abatementPeriod", "abatementRange", "abatementString", "recordedDate", "participant", "stage", "evidence", "note"},
+	reflect.TypeOf(ConditionDefinition{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "subtitle", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "code", "severity", "bodySite", "stage", "hasSeverity", "hasBodySite", "hasStage", "definition", "observation", "medication", "precondition", "team", "questionnaire", "plan"},
+	reflect.TypeOf(ConditionDefinitionPlan{}):                                              {"id", "extension", "modifierExtension", "role", "reference"},
+	reflect.TypeOf(ConditionDefinitionPrecondition{}):                                      {"id", "extension", "modifierExtension", "type", "code", "valueCodeableConcept", "valueQuantity"},
+	reflect.TypeOf(ConditionParticipant{}):                                                 {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(ConditionStage{}):                                                       {"id", "extension", "modifierExtension", "summary", "assessment", "type"},
+	reflect.TypeOf(Consent{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "subject", "date", "period", "grantor", "grantee", "manager", "controller", "sourceAttachment", "sourceReference", "regulatoryBasis", "policyBasis", "policyText", "verification", "decision", "provision"},
+	reflect.TypeOf(ConsentProvision{}):                                                     {"id", "extension", "modifierExtension", "period", "actor", "action", "securityLabel", "purpose", "documentType", "resourceType", "code", "dataPeriod", "data", "expression", "provision"},
+	reflect.TypeOf(ConsentProvisionActor{}):                                                {"id", "extension", "modifierExtension", "role", "reference"},
+	reflect.TypeOf(ConsentVerification{}):                                                  {"id", "extension", "modifierExtension", "verified", "verificationType", "verifiedBy", "verifiedWith", "verificationDate"},
+	reflect.TypeOf(Contract{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "alias", "applies", "author", "authority", "contentDefinition", "contentDerivative", "domain", "expirationType", "friendly", "identifier", "instantiatesCanonical", "instantiatesUri", "issued", "legal", "legallyBindingAttachment", "legallyBindingReference", "legalState", "name", "relevantHistory", "rule", "scope", "signer", "site", "status", "subject", "subtitle", "subType", "supportingInfo", "term", "title", "topicCodeableConcept", "topicReference", "type", "url", "version"},
+	reflect.TypeOf(Coverage{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "kind", "paymentBy", "type", "policyHolder", "subscriber", "subscriberId", "beneficiary", "dependent", "relationship", "period", "insurer", "class", "order", "network", "costToBeneficiary", "subrogation", "contract", "insurancePlan"},
+	reflect.TypeOf(CoverageClass{}):                                                        {"id", "extension", "modifierExtension", "type", "value", "name"},
+	reflect.TypeOf(CoverageCostToBeneficiary{}):                                            {"id", "extension", "modifierExtension", "type", "category", "network", "unit", "term", "valueQuantity", "valueMoney", "exception"},
+	reflect.TypeOf(CoverageCostToBeneficiaryException{}):                                   {"id", "extension", "modifierExtension", "type", "period"},
+	reflect.TypeOf(CoverageEligibilityRequest{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "priority", "purpose", "patient", "event", "servicedDate", "servicedPeriod", "created", "enterer", "provider", "insurer", "facility", "supportingInfo", "insurance", "item"},
+	reflect.TypeOf(CoverageEligibilityRequestInsurance{}):                                  {"id", "extension", "modifierExtension", "focal", "coverage", "businessArrangement"},
+	reflect.TypeOf(CoverageEligibilityRequestItem{}):                                       {"id", "extension", "modifierExtension", "supportingInfoSequence", "category", "productOrService", "modifier", "provider", "quantity", "unitPrice", "facility", "diagnosis", "detail"},
+	reflect.TypeOf(CoverageEligibilityRequestSupportingInfo{}):                             {"id", "extension", "modifierExtension", "sequence", "information", "appliesToAll"},
+	reflect.TypeOf(CoverageEligibilityResponse{}):                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "purpose", "patient", "event", "servicedDate", "servicedPeriod", "created", "requestor", "request", "outcome", "disposition", "insurer", "insurance", "preAuthRef", "form", "error"},
+	reflect.TypeOf(CoverageEligibilityResponseInsurance{}):                                 {"id", "extension", "modifierExtension", "coverage", "inforce", "benefitPeriod", "item"},
+	reflect.TypeOf(CoverageEligibilityResponseInsuranceItem{}):                             {"id", "extension", "modifierExtension", "category", "productOrService", "modifier", "provider", "excluded", "name", "description", "network", "unit", "term", "benefit", "authorizationRequired", "authorizationSupporting", "authorizationUrl"},
+	reflect.TypeOf(CoverageEligibilityResponseInsuranceItemBenefit{}):                      {"id", "extension", "modifierExtension", "type", "allowedUnsignedInt", "allowedString", "allowedMoney", "usedUnsignedInt", "usedString", "usedMoney"},
+	reflect.TypeOf(DetectedIssue{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "severity", "subject", "encounter", "identifiedDateTime", "identifiedPeriod", "author", "implicated", "evidence", "detail", "reference", "mitigation"},
+	reflect.TypeOf(DetectedIssueMitigation{}):                                              {"id", "extension", "modifierExtension", "action", "date", "author", "note"},
+	reflect.TypeOf(Device{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "displayName", "definition", "udiCarrier", "status", "availabilityStatus", "biologicalSourceEvent", "manufacturer", "manufactureDate", "expirationDate", "lotNumber", "serialNumber", "name", "modelNumber", "partNumber", "category", "type", "version", "conformsTo", "property", "mode", "cycle", "duration", "owner", "contact", "location", "url", "endpoint", "gateway", "note", "safety", "parent"},
+	reflect.TypeOf(DeviceAssociation{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "device", "category", "status", "statusReason", "subject", "bodyStructure", "period", "operation"},
+	reflect.TypeOf(DeviceAssociationOperation{}):                                           {"id", "extension", "modifierExtension", "status", "operator", "period"},
+	reflect.TypeOf(DeviceDefinition{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "description", "identifier", "udiDeviceIdentifier", "regulatoryIdentifier", "partNumber", "manufacturer", "deviceName", "modelNumber", "classification", "conformsTo", "hasPart", "packaging", "version", "safety", "shelfLifeStorage", "languageCode", "property", "owner", "contact", "link", "note", "material", "productionIdentifierInUDI", "guideline", "correctiveAction", "chargeItem"},
+	reflect.TypeOf(DeviceDefinitionClassification{}):                                       {"id", "extension", "modifierExtension", "type", "justification"},
+	reflect.TypeOf(DeviceDefinitionConformsTo{}):                                           {"id", "extension", "modifierExtension", "category", "specification", "version", "source"},
+	reflect.TypeOf(DeviceDefinitionCorrectiveAction{}):                                     {"id", "extension", "modifierExtension", "type", "scope", "period", "device"},
+	reflect.TypeOf(DeviceDefinitionHasPart{}):                                              {"id", "extension", "modifierExtension", "reference", "count"},
+	reflect.TypeOf(DeviceDefinitionPackaging{}):                                            {"id", "extension", "modifierExtension", "identifier", "type", "count", "distributor", "udiDeviceIdentifier", "packaging"},
+	reflect.TypeOf(DeviceDefinitionRegulatoryIdentifier{}):                                 {"id", "extension", "modifierExtension", "type", "deviceIdentifier", "issuer", "jurisdiction"},
+	reflect.TypeOf(DeviceDefinitionVersion{}):                                              {"id", "extension", "modifierExtension", "type", "component", "value"},
+	reflect.TypeOf(DeviceDispense{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "category", "device", "subject", "receiver", "encounter", "performer", "location", "type", "quantity", "preparedDate", "whenHandedOver", "destination", "note", "eventHistory", "whenPrepared"},
+	reflect.TypeOf(DeviceDispensePerformer{}):                                              {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(DeviceMetric{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "unit", "device", "operationalStatus", "color", "category", "measurementFrequency", "calibration"},
+	reflect.TypeOf(DeviceMetricCalibration{}):                                              {"id", "extension", "modifierExtension", "type", "state", "time"},
+	reflect.TypeOf(DeviceName{}):                                                           {"id", "extension", "modifierExtension", "value", "type", "display"},
+	reflect.TypeOf(DeviceRequest{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "replaces", "groupIdentifier", "status", "intent", "priority", "doNotPerform", "code", "quantity", "parameter", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "authoredOn", "requester", "performer", "reason", "asNeeded", "asNeededFor", "insurance", "supportingInfo", "note", "relevantHistory"},
+	reflect.TypeOf(DeviceUdiCarrier{}):                                                     {"id", "extension", "modifierExtension", "deviceIdentifier", "issuer", "jurisdiction", "carrierAIDC", "carrierHRF", "entryType"},
+	reflect.TypeOf(DeviceUsage{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "category", "patient", "derivedFrom", "context", "timingTiming", "timingPeriod", "timingDateTime", "dateAsserted", "usageStatus", "usageReason", "adherence", "informationSource", "device", "reason", "bodySite", "note"},
+	reflect.TypeOf(DeviceVersion{}):                                                        {"id", "extension", "modifierExtension", "type", "component", "installDate", "value"},
+	reflect.TypeOf(DiagnosticReport{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "category", "code", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "issued", "performer", "resultsInterpreter", "specimen", "result", "note", "study", "supportingInfo", "media", "composition", "conclusion", "conclusionCode", "presentedForm"},
+	reflect.TypeOf(DiagnosticReportSupportingInfo{}):                                       {"id", "extension", "modifierExtension", "type", "reference"},
+	reflect.TypeOf(DocumentReference{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "version", "basedOn", "status", "type", "category", "subject", "context", "bodySite", "practiceSetting", "period", "date", "author", "attester", "custodian", "relatesTo", "description", "securityLabel", "content", "facility"},
+	reflect.TypeOf(DocumentReferenceAttester{}):                                            {"id", "extension", "modifierExtension", "mode", "time", "party"},
+	reflect.TypeOf(DomainResource{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension"},
+	reflect.TypeOf(Dosage{}):                                                               {"id", "extension", "sequence", "text", "additionalInstruction", "patientInstruction", "timing", "asNeeded", "asNeededFor", "site", "route", "method", "doseAndRate", "maxDosePerPeriod", "maxDosePerAdministration", "maxDosePerLifetime"},
+	reflect.TypeOf(ElementDefinition{}):                                                    {"id", "extension", "modifierExtension", "path", "representation", "sliceName", "sliceIsConstraining", "label", "code", "slicing", "short", "definition", "comment", "requirements", "alias", "min", "max", "base", "contentReference", "type", "defaultValueBase64Binary", "defaultValueBoolean", "defaultValueCanonical", "defaultValueCode", "defaultValueDate", "defaultValueDateTime", "defaultValueDecimal", "defaultValueId", "defaultValueInstant", "defaultValueInteger", "defaultValueInteger64", "defaultValueMarkdown", "defaultValueOid", "defaultValuePositiveInt", "defaultValueString", "defaultValueTime", "defaultValueUnsignedInt", "defaultValueUri", "defaultValueUrl", "defaultValueUuid", "defaultValueAddress", "defaultValueAge", "defaultValueAnnotation", "defaultValueAttachment", "defaultValueCodeableConcept", "defaultValueCodeableReference", "defaultValueCoding", "defaultValueContactPoint", "defaultValueCount", "defaultValueDistance", "defaultValueDuration", "defaultValueHumanName", "defaultValueIdentifier", "defaultValueMoney", "defaultValuePeriod", "defaultValueQuantity", "defaultValueRange", "defaultValueRatio", "defaultValueRatioRange", "defaultValueReference", "defaultValueSampledData", "defaultValueSignature", "defaultValueTiming", "defaultValueContactDetail", "defaultValueDataRequirement", "defaultValueExpression", "defaultValueParameterDefinition", "defaultValueRelatedArtifact", "defaultValueTriggerDefinition", "defaultValueUsageContext", "defaultValueAvailability", "defaultValueExtendedContactDetail", "defaultValueDosage", "defaultValueMeta", "meaningWhenMissing", "orderMeaning", "fixedBase64Binary", "fixedBoolean", "fixedCanonical", "fixedCode", "fixedDate", "fixedDateTime", "fixedDecimal", "fixedId", "fixedInstant", "fixedInteger", "fixedInteger64", "fixedMarkdown", "fixedOid", "fixedPositiveInt", "fixedString", "fixedTime", "fixedUnsignedInt", "fixedUri", "fixedUrl", "fixedUuid", "fixedAddress", "fixedAge", "fixedAnnotation", "fixedAttachment", "fixedCodeableConcept", "fixedCodeableReference", "fixedCoding", "fixedContactPoint", "fixedCount", "fixedDistance", "fixedDuration", "fixedHumanName", "fixedIdentifier", "fixedMoney", "fixedPeriod", "fixedQuantity", "fixedRange", "fixedRatio", "fixedRatioRange", "fixedReference", "fixedSampledData", "fixedSignature", "fixedTiming", "fixedContactDetail", "fixedDataRequirement", "fixedExpression", "fixedParameterDefinition", "fixedRelatedArtifact", "fixedTriggerDefinition", "fixedUsageContext", "fixedAvailability", "fixedExtendedContactDetail", "fixedDosage", "fixedMeta", "patternBase64Binary", "patternBoolean", "patternCanonical", "patternCode", "patternDate", "patternDateTime", "patternDecimal", "patternId", "patternInstant", "patternInteger", "patternInteger64", "patternMarkdown", "patternOid", "patternPositiveInt", "patternString", "patternTime", "patternUnsignedInt", "patternUri", "patternUrl", "patternUuid", "patternAddress", "patternAge", "patternAnnotation", "patternAttachment", "patternCodeableConcept", "patternCodeableReference", "patternCoding", "patternContactPoint", "patternCount", "patternDistance", "patternDuration", "patternHumanName", "patternIdentifier", "patternMoney", "patternPeriod", "patternQuantity", "patternRange", "patternRatio", "patternRatioRange", "patternReference", "patternSampledData", "patternSignature", "patternTiming", "patternContactDetail", "patternDataRequirement", "patternExpression", "patternParameterDefinition", "patternRelatedArtifact", "patternTriggerDefinition", "patternUsageContext", "patternAvailability", "patternExtendedContactDetail", "patternDosage", "patternMeta", "example", "minValueDate", "minValueDateTime", "minValueInstant", "minValueTime", "minValueDecimal", "minValueInteger", "minValueInteger64", "minValuePositiveInt", "minValueUnsignedInt", "minValueQuantity", "maxValueDate", "maxValueDateTime", "maxValueInstant", "maxValueTime", "maxValueDecimal", "maxValueInteger", "maxValueInteger64", "maxValuePositiveInt", "maxValueUnsignedInt", "maxValueQuantity", "maxLength", "condition", "constraint", "mustHaveValue", "valueAlternatives", "mustSupport", "isModifier", "isModifierReason", "isSummary", "binding", "mapping"},
+	reflect.TypeOf(ElementDefinitionBase{}):                                                {"id", "extension", "path", "min", "max"},
+	reflect.TypeOf(ElementDefinitionBinding{}):                                             {"id", "extension", "strength", "description", "valueSet", "additional"},
+	reflect.TypeOf(ElementDefinitionBindingAdditional{}):                                   {"id", "extension", "purpose", "valueSet", "documentation", "shortDoco", "usage", "any"},
+	reflect.TypeOf(ElementDefinitionConstraint{}):                                          {"id", "extension", "key", "requirements", "severity", "suppress", "human", "expression", "source"},
+	reflect.TypeOf(ElementDefinitionMapping{}):                                             {"id", "extension", "identity", "language", "map", "comment"},
+	reflect.TypeOf(ElementDefinitionSlicing{}):                                             {"id", "extension", "discriminator", "description", "ordered", "rules"},
+	reflect.TypeOf(ElementDefinitionSlicingDiscriminator{}):                                {"id", "extension", "type", "path"},
+	reflect.TypeOf(ElementDefinitionType{}):                                                {"id", "extension", "code", "profile", "targetProfile", "aggregation", "versioning"},
+	reflect.TypeOf(Encounter{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "class", "priority", "type", "serviceType", "subject", "subjectStatus", "episodeOfCare", "basedOn", "careTeam", "partOf", "serviceProvider", "participant", "appointment", "virtualService", "actualPeriod", "plannedStartDate", "plannedEndDate", "length", "reason", "diagnosis", "account", "dietPreference", "specialArrangement", "specialCourtesy", "admission", "location"},
+	reflect.TypeOf(EncounterAdmission{}):                                                   {"id", "extension", "modifierExtension", "preAdmissionIdentifier", "origin", "admitSource", "reAdmission", "destination", "dischargeDisposition"},
+	reflect.TypeOf(EncounterHistory{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "encounter", "status", "period", "location"},
+	reflect.TypeOf(EncounterHistoryLocation{}):                                             {"id", "extension", "modifierExtension", "location", "form"},
+	reflect.TypeOf(EncounterLocation{}):                                                    {"id", "extension", "modifierExtension", "location", "status", "form", "period"},
+	reflect.TypeOf(EncounterParticipant{}):                                                 {"id", "extension", "modifierExtension", "type", "period", "actor"},
+	reflect.TypeOf(Endpoint{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "connectionType", "name", "description", "environmentType", "managingOrganization", "contact", "period", "payload", "address", "header"},
+	reflect.TypeOf(EndpointPayload{}):                                                      {"id", "extension", "modifierExtension", "type", "mimeType"},
+	reflect.TypeOf(EnrollmentRequest{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "insurer", "provider", "candidate", "coverage"},
+	reflect.TypeOf(EnrollmentResponse{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "request", "outcome", "disposition", "created", "organization", "requestProvider"},
+	reflect.TypeOf(EpisodeOfCare{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "statusHistory", "type", "reason", "diagnosis", "patient", "managingOrganization", "period", "referralRequest", "careManager", "careTeam", "account"},
+	reflect.TypeOf(EpisodeOfCareStatusHistory{}):                                           {"id", "extension", "modifierExtension", "status", "period"},
+	reflect.TypeOf(EventDefinition{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "trigger"},
+	reflect.TypeOf(Evidence{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "citeAsReference", "citeAsMarkdown", "status", "experimental", "date", "approvalDate", "lastReviewDate", "publisher", "contact", "author", "editor", "reviewer", "endorser", "useContext", "purpose", "copyright", "copyrightLabel", "relatedArtifact", "description", "assertion", "note", "variableDefinition", "synthesisType", "studyDesign", "statistic", "certainty", "effectivePeriod"},
+	reflect.TypeOf(EvidenceCertainty{}):                                                    {"id", "extension", "modifierExtension", "description", "note", "type", "rating", "rater", "subcomponent"},
+	reflect.TypeOf(EvidenceReport{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "status", "useContext", "identifier", "relatedIdentifier", "citeAsReference", "citeAsMarkdown", "type", "note", "relatedArtifact", "subject", "publisher", "contact", "author", "editor", "reviewer", "endorser", "relatesTo", "section"},
+	reflect.TypeOf(EvidenceReportRelatesToTarget{}):                                        {"id", "extension", "modifierExtension", "url", "identifier", "display", "resource"},
+	reflect.TypeOf(EvidenceReportSection{}):                                                {"id", "extension", "modifierExtension", "title", "focus", "focusReference", "author", "text", "mode", "orderedBy", "entryClassifier", "entryReference", "entryQuantity", "emptyReason", "section"},
+	reflect.TypeOf(EvidenceReportSubjectCharacteristic{}):                                  {"id", "extension", "modifierExtension", "code", "valueReference", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "exclude", "period"},
+	reflect.TypeOf(EvidenceStatistic{}):                                                    {"id", "extension", "modifierExtension", "description", "note", "statisticType", "category", "quantity", "numberOfEvents", "numberAffected", "sampleSize", "attributeEstimate", "modelCharacteristic"},
+	reflect.TypeOf(EvidenceStatisticAttributeEstimate{}):                                   {"id", "extension", "modifierExtension", "description", "note", "type", "quantity", "level", "range", "attributeEstimate"},
+	reflect.TypeOf(EvidenceStatisticModelCharacteristic{}):                                 {"id", "extension", "modifierExtension", "code", "value", "variable", "attributeEstimate"},
+	reflect.TypeOf(EvidenceStatisticModelCharacteristicVariable{}):                         {"id", "extension", "modifierExtension", "variableDefinition", "handling", "valueCategory", "valueQuantity", "valueRange"},
+	reflect.TypeOf(EvidenceStatisticSampleSize{}):                                          {"id", "extension", "modifierExtension", "description", "note", "numberOfStudies", "numberOfParticipants", "knownDataCount"},
+	reflect.TypeOf(EvidenceVariable{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension"},
+	reflect.TypeOf(EvidenceVariableCharacteristic{}):                                       {"id", "extension", "modifierExtension", "description", "exclude", "definitionReference", "definitionCanonical", "definitionCodeableConcept", "definitionExpression", "definitionId", "definitionByTypeAndValue", "definitionByCombination", "device", "groupMeasure", "instancesQuantity", "instancesRange", "method", "durationQuantity", "durationRange", "timeFromEvent"},
+	reflect.TypeOf(EvidenceVariableDefinition{}):                                           {"id", "extension", "modifierExtension", "description", "note", "variableRole", "observed", "intended", "directnessMatch"},
+	reflect.TypeOf(ExampleScenario{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "actor", "instance", "process"},
+	reflect.TypeOf(ExampleScenarioActor{}):                                                 {"id", "extension", "modifierExtension", "actorId", "type", "description", "name"},
+	reflect.TypeOf(ExampleScenarioProcessStep{}):                                           {"id", "extension", "modifierExtension", "process", "operation", "alternative", "pause"},
+	reflect.TypeOf(ExplanationOfBenefit{}):                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "accident", "benefitBalance", "benefitPeriod", "billablePeriod", "careTeam", "claim", "claimResponse", "created", "decision", "diagnosis", "diagnosisRelatedGroup", "disposition", "encounter", "enterer", "event", "facility", "form", "formCode", "fundsReserve", "fundsReserveRequested", "identifier", "insurance", "insurer", "item", "originalPrescription", "outcome", "patient", "patientPaid", "payee", "payment", "preAuthRef", "preAuthRefPeriod", "precedence", "prescription", "priority", "procedure", "processNote", "provider", "referral", "related", "status", "subType", "supportingInfo", "total", "traceNumber", "type", "use"},
+	reflect.TypeOf(ExplanationOfBenefitProcessNote{}):                                      {"id", "language", "text", "extension", "modifierExtension", "number", "type"},
+	reflect.TypeOf(FamilyMemberHistory{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "status", "dataAbsentReason", "patient", "date", "participant", "name", "relationship", "sex", "bornPeriod", "bornDate", "bornString", "ageAge", "ageRange", "ageString", "estimatedAge", "deceasedBoolean", "deceasedAge", "deceasedRange", "deceasedDate", "deceasedString", "reason", "note", "condition", "procedure"},
+	reflect.TypeOf(FamilyMemberHistoryCondition{}):                                         {"id", "extension", "modifierExtension", "code", "outcome", "contributedToDeath", "onsetAge", "onsetRange", "onsetPeriod", "onsetString", "note"},
+	reflect.TypeOf(FamilyMemberHistoryParticipant{}):                                       {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(FamilyMemberHistoryProcedure{}):                                         {"id", "extension", "modifierExtension", "code", "outcome", "contributedToDeath", "performedAge", "performedRange", "performedPeriod", "performedString", "note"},
+	reflect.TypeOf(Flag{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "subject", "period", "encounter", "author"},
+	reflect.TypeOf(FormularyItem{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "status"},
+	reflect.TypeOf(GenomicStudy{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "subject", "encounter", "startDate", "basedOn", "referrer", "interpreter", "reason", "instantiatesCanonical", "instantiatesUri", "note", "description", "analysis"},
+	reflect.TypeOf(GenomicStudyAnalysis{}):                                                 {"id", "extension", "modifierExtension", "identifier", "methodType", "changeType", "genomeBuild", "instantiatesCanonical", "instantiatesUri", "title", "focus", "specimen", "date", "note", "protocolPerformed", "regionsStudied", "regionsCalled", "input", "output", "performer", "device"},
+	reflect.TypeOf(GenomicStudyAnalysisInput{}):                                            {"id", "extension", "modifierExtension", "file", "type", "generatedByIdentifier", "generatedByReference"},
+	reflect.TypeOf(Goal{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "lifecycleStatus", "achievementStatus", "category", "continuous", "priority", "description", "subject", "startDate", "startCodeableConcept", "target", "statusDate", "statusReason", "source", "addresses", "note", "outcome"},
+	reflect.TypeOf(GoalTarget{}):                                                           {"id", "extension", "modifierExtension", "measure", "detailQuantity", "detailRange", "detailCodeableConcept", "detailString", "detailBoolean", "detailInteger", "detailRatio", "dueDate", "dueDuration"},
+	reflect.TypeOf(GraphDefinition{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "start", "node", "link"},
+	reflect.TypeOf(GraphDefinitionLink{}):                                                  {"id", "extension", "modifierExtension", "description", "min", "max", "sourceId", "path", "sliceName", "targetId", "params", "compartment"},
+	reflect.TypeOf(GraphDefinitionLinkCompartment{}):                                       {"id", "extension", "modifierExtension", "use", "rule", "code", "expression", "description"},
+	reflect.TypeOf(GraphDefinitionNode{}):                                                  {"id", "extension", "modifierExtension", "nodeId", "description", "type", "profile"},
+	reflect.TypeOf(Group{}):                                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "membership", "code", "name", "description", "quantity", "managingEntity", "characteristic", "member"},
+	reflect.TypeOf(GroupCharacteristic{}):                                                  {"id", "extension", "modifierExtension", "code", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "valueReference", "exclude", "period"},
+	reflect.TypeOf(GroupMember{}):                                                          {"id", "extension", "modifierExtension", "entity", "period", "inactive"},
+	reflect.TypeOf(GuidanceResponse{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "requestIdentifier", "identifier", "moduleUri", "moduleCanonical", "moduleCodeableConcept", "status", "subject", "encounter", "occurrenceDateTime", "performer", "reason", "note", "evaluationMessage", "outputParameters", "result", "dataRequirement"},
+	reflect.TypeOf(HealthcareService{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "providedBy", "offeredIn", "category", "type", "specialty", "location", "name", "comment", "extraDetails", "photo", "contact", "coverageArea", "serviceProvisionCode", "eligibility", "program", "characteristic", "communication", "referralMethod", "appointmentRequired", "availability", "endpoint"},
+	reflect.TypeOf(ImagingSelection{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "subject", "issued", "performer", "basedOn", "category", "code", "studyUid", "derivedFrom", "endpoint", "seriesUid", "seriesNumber", "frameOfReferenceUid", "bodySite", "focus", "instance"},
+	reflect.TypeOf(ImagingSelectionInstance{}):                                             {"id", "extension", "modifierExtension", "uid", "number", "sopClass", "subset", "imageRegion2D", "imageRegion3D"},
+	reflect.TypeOf(ImagingSelectionInstanceImageRegion2D{}):                                {"id", "extension", "modifierExtension", "regionType", "coordinate"},
+	reflect.TypeOf(ImagingSelectionInstanceImageRegion3D{}):                                {"id", "extension", "modifierExtension", "regionType", "coordinate"},
+	reflect.TypeOf(ImagingSelectionPerformer{}):                                            {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(ImagingStudy{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "modality", "subject", "encounter", "started", "basedOn", "partOf", "referrer", "endpoint", "numberOfSeries", "numberOfInstances", "procedure", "location", "reason", "note", "description", "series"},
+	reflect.TypeOf(ImagingStudySeries{}):                                                   {"id", "extension", "modifierExtension", "uid", "number", "modality", "description", "numberOfInstances", "endpoint", "bodySite", "laterality", "specimen", "started", "performer", "instance"},
+	reflect.TypeOf(ImagingStudySeriesInstance{}):                                           {"id", "extension", "modifierExtension", "uid", "sopClass", "number", "title"},
+	reflect.TypeOf(ImagingStudySeriesPerformer{}):                                          {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(Immunization{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "status", "statusReason", "vaccineCode", "administeredProduct", "manufacturer", "lotNumber", "expirationDate", "patient", "encounter", "supportingInformation", "occurrenceDateTime", "occurrenceString", "primarySource", "informationSource", "location", "site", "route", "doseQuantity", "performer", "note", "reason", "isSubpotent", "subpotentReason", "programEligibility", "fundingSource", "reaction", "protocolApplied"},
+	reflect.TypeOf(ImmunizationEvaluation{}):                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "patient", "date", "authority", "targetDisease", "immunizationEvent", "doseStatus", "doseStatusReason", "description", "series", "doseNumber", "seriesDoses"},
+	reflect.TypeOf(ImmunizationPerformer{}):                                                {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(ImmunizationProtocolApplied{}):                                          {"id", "extension", "modifierExtension", "series", "authority", "targetDisease", "doseNumber", "seriesDoses"},
+	reflect.TypeOf(ImmunizationRecommendation{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "patient", "date", "authority", "recommendation"},
+	reflect.TypeOf(ImmunizationRecommendationRecommendation{}):                             {"id", "extension", "modifierExtension", "vaccineCode", "targetDisease", "contraindicatedVaccineCode", "forecastStatus", "forecastReason", "dateCriterion", "description", "series", "doseNumber", "seriesDoses", "supportingImmunization", "supportingPatientInformation"},
+	reflect.TypeOf(ImplementationGuide{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "packageId", "license", "fhirVersion", "dependsOn", "global", "definition", "manifest"},
+	reflect.TypeOf(ImplementationGuideDefinition{}):                                        {"id", "extension", "modifierExtension", "grouping", "resource", "page", "parameter", "template"},
+	reflect.TypeOf(ImplementationGuideDefinitionGrouping{}):                                {"id", "extension", "modifierExtension", "name", "description"},
+	reflect.TypeOf(ImplementationGuideDefinitionPage{}):                                    {"id", "extension", "modifierExtension", "sourceUrl", "sourceString", "sourceMarkdown", "name", "title", "generation", "page"},
+	reflect.TypeOf(ImplementationGuideDefinitionResource{}):                                {"id", "extension", "modifierExtension", "reference", "fhirVersion", "name", "description", "isExample", "profile", "groupingId"},
+	reflect.TypeOf(ImplementationGuideDefinitionTemplate{}):                                {"id", "extension", "modifierExtension", "code", "source", "scope"},
+	reflect.TypeOf(ImplementationGuideDependsOn{}):                                         {"id", "extension", "modifierExtension", "uri", "packageId", "version", "reason"},
+	reflect.TypeOf(ImplementationGuideGlobal{}):                                            {"id", "extension", "modifierExtension", "type", "profile"},
+	reflect.TypeOf(ImplementationGuideManifest{}):                                          {"id", "extension", "modifierExtension", "rendering", "resource", "page", "image", "other"},
+	reflect.TypeOf(ImplementationGuideManifestPage{}):                                      {"id", "extension", "modifierExtension", "name", "title", "anchor"},
+	reflect.TypeOf(ImplementationGuideManifestResource{}):                                  {"id", "extension", "modifierExtension", "reference", "isExample", "profile", "relativePath"},
+	reflect.TypeOf(Ingredient{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "for", "role", "function", "group", "allergenicIndicator", "comment", "manufacturer", "substance"},
+	reflect.TypeOf(IngredientManufacturer{}):                                               {"id", "extension", "modifierExtension", "role", "manufacturer"},
+	reflect.TypeOf(IngredientSubstanceStrength{}):                                          {"id", "extension", "modifierExtension", "presentationRatio", "presentationCodeableConcept", "presentationQuantity", "textPresentation", "concentrationRatio", "concentrationCodeableConcept", "concentrationQuantity", "textConcentration", "basis", "measurementPoint", "country", "referenceStrength"},
+	reflect.TypeOf(IngredientSubstanceStrengthReferenceStrength{}):                         {"id", "extension", "modifierExtension", "substance", "strengthRatio", "strengthQuantity", "measurementPoint", "country"},
+	reflect.TypeOf(InsurancePlanCoverage{}):                                                {"id", "extension", "modifierExtension", "type", "network", "benefit"},
+	reflect.TypeOf(InsurancePlanCoverageBenefit{}):                                         {"id", "extension", "modifierExtension", "type", "requirement", "limit"},
+	reflect.TypeOf(InsurancePlanCoverageBenefitLimit{}):                                    {"id", "extension", "modifierExtension", "value", "code"},
+	reflect.TypeOf(InsurancePlanPlan{}):                                                    {"id", "extension", "modifierExtension", "identifier", "type", "coverageArea", "network", "generalCost", "specificCost"},
+	reflect.TypeOf(InsurancePlanPlanGeneralCost{}):                                         {"id", "extension", "modifierExtension", "type", "groupSize", "cost", "comment"},
+	reflect.TypeOf(InsurancePlanPlanSpecificCost{}):                                        {"id", "extension", "modifierExtension", "category", "benefit"},
+	reflect.TypeOf(InsurancePlanPlanSpecificCostBenefit{}):                                 {"id", "extension", "modifierExtension", "type", "cost"},
+	reflect.TypeOf(InsurancePlanPlanSpecificCostBenefitCost{}):                             {"id", "extension", "modifierExtension", "type", "applicability", "qualifiers", "value"},
+	reflect.TypeOf(InventoryItem{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "category", "code", "name", "responsibleOrganization", "description", "inventoryStatus", "baseUnit", "netContent", "association", "characteristic", "instance", "productReference"},
+	reflect.TypeOf(InventoryItemAssociation{}):                                             {"id", "extension", "modifierExtension", "associationType", "relatedItem", "quantity"},
+	reflect.TypeOf(InventoryItemDescription{}):                                             {"id", "extension", "modifierExtension", "language", "description"},
+	reflect.TypeOf(InventoryItemInstance{}):                                                {"id", "extension", "modifierExtension", "identifier", "lotNumber", "expiry", "subject", "location"},
+	reflect.TypeOf(InventoryItemName{}):                                                    {"id", "extension", "modifierExtension", "nameType", "language", "name"},
+	reflect.TypeOf(InventoryItemResponsibleOrganization{}):                                 {"id", "extension", "modifierExtension", "role", "organization"},
+	reflect.TypeOf(InventoryReport{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "countType", "operationType", "operationTypeReason", "reportedDateTime", "reporter", "reportingPeriod", "inventoryListing", "note"},
+	reflect.TypeOf(InventoryReportInventoryListing{}):                                      {"id", "extension", "modifierExtension", "location", "itemStatus", "countingDateTime", "item"},
+	reflect.TypeOf(InventoryReportInventoryListingItem{}):                                  {"id", "extension", "modifierExtension", "category", "quantity", "item"},
+	reflect.TypeOf(Invoice{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "cancelledReason", "type", "subject", "recipient", "date", "creation", "periodDate", "periodPeriod", "participant", "issuer", "account", "lineItem", "totalPriceComponent", "totalNet", "totalGross", "paymentTerms", "note"},
+	reflect.TypeOf(InvoiceLineItem{}):                                                      {"id", "extension", "modifierExtension", "sequence", "servicedDate", "servicedPeriod", "chargeItemReference", "chargeItemCodeableConcept", "priceComponent"},
+	reflect.TypeOf(InvoiceParticipant{}):                                                   {"id", "extension", "modifierExtension", "role", "actor"},
+	reflect.TypeOf(Library{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "subtitle", "status", "experimental", "type", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "parameter", "dataRequirement", "content"},
+	reflect.TypeOf(Linkage{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "active", "author", "item"},
+	reflect.TypeOf(LinkageItem{}):                                                          {"id", "extension", "modifierExtension", "type", "resource"},
+	reflect.TypeOf(List{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "mode", "title", "code", "subject", "encounter", "date", "source", "orderedBy", "note", "entry", "emptyReason"},
+	reflect.TypeOf(ListEntry{}):                                                            {"id", "extension", "modifierExtension", "flag", "deleted", "date", "item"},
+	reflect.TypeOf(Location{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "operationalStatus", "name", "alias", "description", "mode", "type", "contact", "address", "form", "position", "managingOrganization", "partOf", "characteristic", "hoursOfOperation", "virtualService", "endpoint"},
+	reflect.TypeOf(LocationPosition{}):                                                     {"id", "extension", "modifierExtension", "longitude", "latitude", "altitude"},
+	reflect.TypeOf(ManufacturedItemDefinition{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "name", "manufacturedDoseForm", "unitOfPresentation", "manufacturer", "marketingStatus", "ingredient", "property", "component"},
+	reflect.TypeOf(ManufacturedItemDefinitionComponent{}):                                  {"id", "extension", "modifierExtension", "type", "function", "amount", "constituent", "property", "component"},
+	reflect.TypeOf(ManufacturedItemDefinitionComponentConstituent{}):                       {"id", "extension", "modifierExtension", "amount", "constituent", "component", "location", "function"},
+	reflect.TypeOf(Measure{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "subtitle", "status", "experimental", "subjectCodeableConcept", "subjectReference", "basis", "date", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "disclaimer", "compositeScoring", "type", "clinicalRecommendationStatement", "improvementNotation", "guidance", "group"},
+	reflect.TypeOf(MeasureGroup{}):                                                         {"id", "extension", "modifierExtension", "linkId", "code", "description", "population", "stratifier"},
+	reflect.TypeOf(MeasureGroupPopulation{}):                                               {"id", "extension", "modifierExtension", "linkId", "code", "description", "criteria"},
+	reflect.TypeOf(MeasureGroupStratifier{}):                                               {"id", "extension", "modifierExtension", "linkId", "code", "description", "criteria", "component"},
+	reflect.TypeOf(MeasureGroupStratifierComponent{}):                                      {"id", "extension", "modifierExtension", "linkId", "code", "description", "criteria"},
+	reflect.TypeOf(MeasureReport{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "type", "dataUpdateType", "measure", "subject", "date", "reporter", "reportingVendor", "location", "period", "inputParameters", "scoring", "improvementNotation", "group", "supplementalData", "evaluatedResource"},
+	reflect.TypeOf(MeasureReportGroup{}):                                                   {"id", "extension", "modifierExtension", "linkId", "code", "population", "measureScoreQuantity", "measureScoreDateTime", "measureScoreCodeableConcept", "measureScorePeriod", "measureScoreRange", "measureScoreDuration", "stratifier"},
+	reflect.TypeOf(MeasureReportGroupPopulation{}):                                         {"id", "extension", "modifierExtension", "linkId", "code", "count", "subjectReference", "subjectResults", "subjects"},
+	reflect.TypeOf(MeasureReportGroupStratifier{}):                                         {"id", "extension", "modifierExtension", "linkId", "code", "stratum"},
+	reflect.TypeOf(MeasureReportGroupStratifierStratum{}):                                  {"id", "extension", "modifierExtension", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "valueReference", "component", "population", "measureScoreQuantity", "measureScoreDateTime", "measureScoreCodeableConcept", "measureScorePeriod", "measureScoreRange", "measureScoreDuration"},
+	reflect.TypeOf(MeasureReportGroupStratifierStratumComponent{}):                         {"id", "extension", "modifierExtension", "linkId", "code", "valueCodeableConcept", "valueBoolean", "valueQuantity", "valueRange", "valueReference"},
+	reflect.TypeOf(MeasureSupplementalData{}):                                              {"id", "extension", "modifierExtension", "linkId", "code", "usage", "description", "criteria"},
+	reflect.TypeOf(Medication{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "status", "marketingAuthorizationHolder", "doseForm", "totalVolume", "ingredient", "batch", "definition"},
+	reflect.TypeOf(MedicationAdministration{}):                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "statusReason", "category", "medication", "subject", "encounter", "supportingInformation", "recorded", "isSubPotent", "subPotentReason", "performer", "reason", "request", "device", "note", "dosage", "eventHistory", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming"},
+	reflect.TypeOf(MedicationAdministrationDosage{}):                                       {"id", "extension", "modifierExtension", "text", "site", "route", "method", "dose", "rateRatio", "rateQuantity"},
+	reflect.TypeOf(MedicationAdministrationPerformer{}):                                    {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(MedicationBatch{}):                                                      {"id", "extension", "modifierExtension", "lotNumber", "expirationDate"},
+	reflect.TypeOf(MedicationDispense{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "category", "medication", "subject", "encounter", "supportingInformation", "performer", "location", "authorizingPrescription", "type", "quantity", "daysSupply", "recorded", "whenPrepared", "whenHandedOver", "destination", "detectedIssue", "receiver", "note", "dosageInstruction", "substitution", "eventHistory", "statusReason"},
+	reflect.TypeOf(MedicationDispensePerformer{}):                                          {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(MedicationDispenseSubstitution{}):                                       {"id", "extension", "modifierExtension", "wasSubstituted", "type", "reason", "responsibleParty"},
+	reflect.TypeOf(MedicationIngredient{}):                                                 {"id", "extension", "modifierExtension", "item", "isActive", "strengthRatio", "strengthCodeableConcept", "strengthQuantity"},
+	reflect.TypeOf(MedicationKnowledge{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "code", "status", "author", "relatedMedicationKnowledge", "associatedMedication", "monograph", "cost", "monitoringProgram", "indicationGuideline", "medicineClassification", "packaging", "clinicalUseIssue", "storageGuideline", "definitional", "maxDispenseQuantity", "pharmacokineticProperty", "regulatoryInformation"},
+	reflect.TypeOf(MedicationKnowledgeCost{}):                                              {"id", "extension", "modifierExtension", "effectiveDate", "type", "source", "costMoney", "costCodeableConcept"},
+	reflect.TypeOf(MedicationKnowledgeDefinitional{}):                                      {"id", "extension", "modifierExtension", "definition", "doseForm", "intendedRoute", "ingredient", "drugCharacteristic"},
+	reflect.TypeOf(MedicationRequest{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "priorPrescription", "groupIdentifier", "status", "statusReason", "statusChanged", "intent", "category", "priority", "doNotPerform", "medication", "subject", "informationSource", "encounter", "supportingInformation", "authoredOn", "requester", "reported", "performerType", "performer", "device", "recorder", "reason", "courseOfTherapyType", "insurance", "note", "renderedDosageInstruction", "effectiveDosePeriod", "dosageInstruction", "dispenseRequest", "substitution", "eventHistory"},
+	reflect.TypeOf(MedicationRequestDispenseRequest{}):                                     {"id", "extension", "modifierExtension", "initialFill", "dispenseInterval", "validityPeriod", "numberOfRepeatsAllowed", "quantity", "expectedSupplyDuration", "dispenser", "dispenserInstruction", "doseAdministrationAid"},
+	reflect.TypeOf(MedicationRequestDispenseRequestInitialFill{}):                          {"id", "extension", "modifierExtension", "quantity", "duration"},
+	reflect.TypeOf(MedicationStatement{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "partOf", "status", "category", "medication", "subject", "encounter", "effectiveDateTime", "effectivePeriod", "effectiveTiming", "dateAsserted", "informationSource", "derivedFrom", "reason", "note", "relatedClinicalInformation", "renderedDosageInstruction", "dosage", "adherence"},
+	reflect.TypeOf(MedicinalProductDefinition{}):                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "domain", "version", "status", "statusDate", "description", "combinedPharmaceuticalDoseForm", "route", "indication", "legalStatusOfSupply", "additionalMonitoringIndicator", "specialMeasures", "pediatricUseIndicator", "classification", "marketingStatus", "packagedMedicinalProduct", "comprisedOf", "ingredient", "impurity", "attachedDocument", "masterFile", "contact", "clinicalTrial", "code", "name", "crossReference", "operation", "characteristic"},
+	reflect.TypeOf(MedicinalProductDefinitionContact{}):                                    {"id", "extension", "modifierExtension", "type", "contact"},
+	reflect.TypeOf(MedicinalProductDefinitionName{}):                                       {"id", "extension", "modifierExtension", "productName", "type", "part", "usage"},
+	reflect.TypeOf(MedicinalProductDefinitionOperation{}):                                  {"id", "extension", "modifierExtension", "type", "effectiveDate", "organization", "confidentialityIndicator"},
+	reflect.TypeOf(MessageDefinition{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "replaces", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "base", "parent", "eventCoding", "eventUri", "category", "focus", "responseRequired", "allowedResponse", "graph"},
+	reflect.TypeOf(MessageDefinitionFocus{}):                                               {"id", "extension", "modifierExtension", "code", "profile", "min", "max"},
+	reflect.TypeOf(MessageHeader{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "eventCoding", "eventCanonical", "destination", "sender", "author", "source", "responsible", "reason", "response", "focus", "definition"},
+	reflect.TypeOf(MessageHeaderDestination{}):                                             {"id", "extension", "modifierExtension", "endpointUrl", "endpointReference", "name", "target", "receiver"},
+	reflect.TypeOf(MessageHeaderResponse{}):                                                {"id", "extension", "modifierExtension", "identifier", "code", "details"},
+	reflect.TypeOf(MessageHeaderSource{}):                                                  {"id", "extension", "modifierExtension", "endpointUrl", "endpointReference", "name", "software", "version", "contact"},
+	reflect.TypeOf(MolecularSequence{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "type", "subject", "focus", "specimen", "device", "performer", "literal", "formatted", "relative"},
+	reflect.TypeOf(MolecularSequenceRelative{}):                                            {"id", "extension", "modifierExtension", "coordinateSystem", "ordinalPosition", "sequenceRange", "startingSequence", "edit"},
+	reflect.TypeOf(MolecularSequenceRelativeEdit{}):                                        {"id", "extension", "modifierExtension", "start", "end", "replacementSequence", "replacedSequence"},
+	reflect.TypeOf(MolecularSequenceRelativeStartingSequence{}):                            {"id", "extension", "modifierExtension", "genomeAssembly", "chromosome", "sequenceCodeableConcept", "sequenceString", "sequenceReference", "windowStart", "windowEnd", "orientation", "strand"},
+	reflect.TypeOf(MonetaryComponent{}):                                                    {"id", "extension", "type", "code", "factor", "amount"},
+	reflect.TypeOf(NamingSystem{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "name", "title", "status", "kind", "experimental", "date", "publisher", "contact", "responsible", "type", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "usage", "uniqueId"},
+	reflect.TypeOf(NamingSystemUniqueId{}):                                                 {"id", "extension", "modifierExtension", "type", "value", "preferred", "comment", "period", "authoritative"},
+	reflect.TypeOf(NutritionIntake{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "partOf", "status", "statusReason", "code", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "recorded", "reportedBoolean", "reportedReference", "consumedItem", "ingredientLabel", "performer", "location", "derivedFrom", "reason", "note"},
+	reflect.TypeOf(NutritionIntakeConsumedItem{}):                                          {"id", "extension", "modifierExtension", "type", "nutritionProduct", "schedule", "amount", "rate", "notConsumed", "notConsumedReason"},
+	reflect.TypeOf(NutritionIntakeIngredientLabel{}):                                       {"id", "extension", "modifierExtension", "nutrient", "amount"},
+	reflect.TypeOf(NutritionIntakePerformer{}):                                             {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(NutritionOrder{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "instantiates", "basedOn", "groupIdentifier", "status", "intent", "priority", "subject", "encounter", "supportingInformation", "dateTime", "orderer", "performer", "allergyIntolerance", "foodPreferenceModifier", "excludeFoodModifier", "outsideFoodAllowed", "oralDiet", "supplement", "enteralFormula", "note"},
+	reflect.TypeOf(NutritionProduct{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "code", "status", "category", "manufacturer", "nutrient", "ingredient", "knownAllergen", "characteristic", "instance", "note"},
+	reflect.TypeOf(NutritionProductIngredient{}):                                           {"id", "extension", "modifierExtension", "item", "amount"},
+	reflect.TypeOf(NutritionProductInstance{}):                                             {"id", "extension", "modifierExtension", "quantity", "identifier", "name", "lotNumber", "expiry", "useBy", "biologicalSourceEvent"},
+	reflect.TypeOf(NutritionProductNutrient{}):                                             {"id", "extension", "modifierExtension", "item", "amount"},
+	reflect.TypeOf(Observation{}):                                                          {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesReference", "basedOn", "triggeredBy", "partOf", "status", "category", "code", "subject", "focus", "encounter", "effectiveDateTime", "effectivePeriod", "effectiveTiming", "effectiveInstant", "issued", "performer", "valueQuantity", "valueCodeableConcept", "valueString", "valueBoolean", "valueInteger", "valueRange", "valueRatio", "valueSampledData", "valueTime", "valueDateTime", "valuePeriod", "valueAttachment", "valueReference", "dataAbsentReason", "interpretation", "note", "bodySite", "bodyStructure", "method", "specimen", "device", "referenceRange", "hasMember", "derivedFrom", "component"},
+	reflect.TypeOf(ObservationComponent{}):                                                 {"id", "extension", "modifierExtension", "code", "valueQuantity", "valueCodeableConcept", "valueString", "valueBoolean", "valueInteger", "valueRange", "valueRatio", "valueSampledData", "valueTime", "valueDateTime", "valuePeriod", "valueAttachment", "valueReference", "dataAbsentReason", "interpretation", "referenceRange"},
+	reflect.TypeOf(ObservationDefinition{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "derivedFromCanonical", "derivedFromUri", "subject", "performerType", "category", "code", "permittedDataType", "multipleResultsAllowed", "bodySite", "method", "specimen", "device", "preferredReportName", "permittedUnit", "qualifiedValue", "hasMember", "component"},
+	reflect.TypeOf(ObservationReferenceRange{}):                                            {"id", "extension", "modifierExtension", "low", "high", "normalValue", "type", "appliesTo", "age", "text"},
+	reflect.TypeOf(ObservationTriggeredBy{}):                                               {"id", "extension", "modifierExtension", "observation", "type", "reason"},
+	reflect.TypeOf(OperationDefinition{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "title", "status", "kind", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "affectsState", "code", "comment", "base", "idempotent", "resource", "system", "type", "instance", "parameter", "overload"},
+	reflect.TypeOf(OperationDefinitionParameter{}):                                         {"id", "extension", "modifierExtension", "name", "use", "scope", "min", "max", "documentation", "type", "allowedType", "searchType", "binding", "referencedFrom", "part"},
+	reflect.TypeOf(OperationOutcome{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "issue"},
+	reflect.TypeOf(OperationOutcomeIssue{}):                                                {"id", "extension", "modifierExtension", "severity", "code", "details", "diagnostics", "location", "expression"},
+	reflect.TypeOf(Organization{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "type", "name", "alias", "description", "contact", "partOf", "endpoint", "qualification"},
+	reflect.TypeOf(OrganizationAffiliation{}):                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "period", "organization", "participatingOrganization", "network", "code", "specialty", "location", "healthcareService", "contact", "endpoint"},
+	reflect.TypeOf(OrganizationQualification{}):                                            {"id", "extension", "modifierExtension", "identifier", "code", "period", "issuer"},
+	reflect.TypeOf(PackagedProductDefinition{}):                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "type", "packageFor", "status", "statusDate", "containedItemQuantity", "description", "legalStatusOfSupply", "marketingStatus", "copackagedIndicator", "manufacturer", "attachedDocument", "packaging", "characteristic"},
+	reflect.TypeOf(PackagedProductDefinitionPackaging{}):                                   {"id", "extension", "modifierExtension", "identifier", "componentPart", "material", "alternateMaterial", "manufacturer", "property", "containedItem", "packaging"},
+	reflect.TypeOf(PackagedProductDefinitionPackagingContainedItem{}):                      {"id", "extension", "modifierExtension", "item", "amount"},
+	reflect.TypeOf(Patient{}):                                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "address", "maritalStatus", "multipleBirthBoolean", "multipleBirthInteger", "photo", "contact", "communication", "generalPractitioner", "managingOrganization", "link"},
+	reflect.TypeOf(PaymentNotice{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "status", "request", "response", "created", "paymentDate", "payee", "amount", "payer", "paymentStatus", "provider", "target"},
+	reflect.TypeOf(PaymentReconciliation{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "period", "created", "request", "requestor", "outcome", "disposition", "formCode", "issuer", "processNote", "totalAmount", "transactionDate"},
+	reflect.TypeOf(PaymentReconciliationProcessNote{}):                                     {"id", "extension", "modifierExtension", "type", "text"},
+	reflect.TypeOf(Permission{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "status", "asserter", "date", "justification", "managingEntity", "note", "subject", "validityPeriod"},
+	reflect.TypeOf(Person{}):                                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "gender", "birthDate", "address", "photo", "managingOrganization", "link"},
+	reflect.TypeOf(PersonLink{}):                                                           {"id", "extension", "modifierExtension", "target", "assurance"},
+	reflect.TypeOf(PlanDefinition{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "subtitle", "type", "status", "experimental", "subjectCodeableConcept", "subjectReference", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "usage", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "library", "goal", "action"},
+	reflect.TypeOf(Practitioner{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "telecom", "gender", "birthDate", "deceasedBoolean", "deceasedDateTime", "address", "photo", "qualification", "communication"},
+	reflect.TypeOf(PractitionerQualification{}):                                            {"id", "extension", "modifierExtension", "identifier", "code", "period", "issuer"},
+	reflect.TypeOf(PractitionerRole{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "period", "practitioner", "organization", "code", "specialty", "location", "healthcareService", "telecom", "availableTime", "notAvailable", "availabilityExceptions", "endpoint"},
+	reflect.TypeOf(Procedure{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "partOf", "status", "statusReason", "category", "code", "subject", "focus", "encounter", "occurrenceDateTime", "occurrenceAge", "occurrencePeriod", "occurrenceRange", "occurrenceString", "occurrenceTiming", "recorded", "recorder", "reportedBoolean", "reportedReference", "performer", "location", "reason", "bodySite", "outcome", "report", "complication", "followUp", "note", "focalDevice", "used", "supportingInfo"},
+	reflect.TypeOf(ProcedurePerformer{}):                                                   {"id", "extension", "modifierExtension", "function", "actor", "onBehalfOf", "period"},
+	reflect.TypeOf(ProductShelfLife{}):                                                     {"id", "extension", "modifierExtension", "period", "type", "specialPrecautionsForStorage"},
+	reflect.TypeOf(Provenance{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "target", "occurredPeriod", "occurredDateTime", "recorded", "policy", "location", "authorization", "activity", "basedOn", "patient", "encounter", "agent", "entity", "signature"},
+	reflect.TypeOf(Questionnaire{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "derivedFrom", "status", "experimental", "subjectType", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "approvalDate", "lastReviewDate", "effectivePeriod", "code", "item"},
+	reflect.TypeOf(QuestionnaireResponse{}):                                                {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "partOf", "questionnaire", "status", "subject", "encounter", "authored", "author", "source", "item"},
+	reflect.TypeOf(QuestionnaireResponseItem{}):                                            {"id", "extension", "modifierExtension", "linkId", "definition", "text", "answer", "item", "prefix", "question", "type"},
+	reflect.TypeOf(RegulatedAuthorization{}):                                               {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "subject", "type", "description", "region", "status", "statusDate", "validityPeriod", "indication", "intendedUse", "basis", "holder", "regulator", "case"},
+	reflect.TypeOf(RelatedPerson{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "patient", "relationship", "name", "telecom", "gender", "birthDate", "address", "photo", "period"},
+	reflect.TypeOf(RequestOrchestration{}):                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "replaces", "groupIdentifier", "status", "intent", "priority", "subject", "encounter", "authoredOn", "author", "reason", "note", "action"},
+	reflect.TypeOf(Requirements{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "approvalDate", "author", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "editor", "effectivePeriod", "endorser", "lastReviewDate", "relatedArtifact", "reviewer", "subtitle", "topic", "type", "usage"},
+	reflect.TypeOf(ResearchStudy{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "approvalDate", "category", "contact", "url", "identifier", "version", "title", "protocol", "relatedArtifact", "status", "primaryPurposeType", "phase", "studyDesign", "focus", "keyword", "description", "endDate", "facility", "location", "period", "site", "note", "reasonStopped", "sponsor", "startDate", "enrollment", "targetPopulation"},
+	reflect.TypeOf(ResearchSubject{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "progress", "period", "study", "subject", "assignedComparisonGroup", "actualComparisonGroup", "consent"},
+	reflect.TypeOf(ResearchSubjectProgress{}):                                              {"id", "extension", "modifierExtension", "type", "subjectState", "milestone", "reason", "startDate", "endDate"},
+	reflect.TypeOf(RiskAssessment{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "parent", "status", "method", "code", "subject", "encounter", "occurrenceDateTime", "occurrencePeriod", "condition", "performer", "reason", "basis", "prediction", "mitigation", "note"},
+	reflect.TypeOf(RiskAssessmentPrediction{}):                                             {"id", "extension", "modifierExtension", "outcome", "probabilityDecimal", "probabilityRange", "qualitativeRisk", "relativeRisk", "whenPeriod", "whenRange", "rationale"},
+	reflect.TypeOf(Schedule{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "active", "name", "actor", "planningHorizon", "comment"},
+	reflect.TypeOf(SearchParameter{}):                                                      {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "version", "name", "derivedFrom", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "purpose", "code", "base", "type", "expression", "target", "multipleOr", "multipleAnd", "comparator", "modifier", "chain", "component", "composite", "operator", "usage", "xpath", "xpathUsage"},
+	reflect.TypeOf(ServiceRequest{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "replaces", "status", "intent", "category", "priority", "doNotPerform", "code", "orderDetail", "subject", "encounter", "occurrenceDateTime", "occurrenceAge", "occurrencePeriod", "occurrenceRange", "occurrenceString", "occurrenceTiming", "asNeededBoolean", "asNeededCodeableConcept", "authoredOn", "requester", "performerType", "performer", "location", "reason", "insurance", "supportingInfo", "bodySite", "bodyStructure", "instructions", "note", "statusReason"},
+	reflect.TypeOf(Slot{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "serviceCategory", "serviceType", "specialty", "appointmentType", "schedule", "status", "start", "end", "overbooked", "comment"},
+	reflect.TypeOf(Specimen{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "accessionIdentifier", "status", "type", "subject", "receivedTime", "parent", "request", "combined", "feature", "collection", "processing", "container", "condition", "note"},
+	reflect.TypeOf(SpecimenCollection{}):                                                   {"id", "extension", "modifierExtension", "collector", "collectedDateTime", "collectedPeriod", "duration", "quantity", "method", "device", "procedure", "bodySite", "fastingStatusCodeableConcept", "fastingStatusDuration"},
+	reflect.TypeOf(SpecimenDefinition{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "approvalDate", "author", "editor", "lastReviewDate", "effectivePeriod", "endorser", "relatedArtifact", "reviewer", "subtitle", "topic", "type", "usage", "typeTested"},
+	reflect.TypeOf(SpecimenDefinitionTypeTested{}):                                         {"id", "extension", "modifierExtension", "isDerived", "type", "preference", "container", "diagnostic", "requirement", "retentionTime", "rejectionCriterion", "handling"},
+	reflect.TypeOf(SpecimenDefinitionTypeTestedContainer{}):                                {"id", "extension", "modifierExtension", "material", "type", "cap", "capacity", "minimumVolumeQuantity", "minimumVolumeString", "additive", "preparation"},
+	reflect.TypeOf(SpecimenDefinitionTypeTestedHandling{}):                                 {"id", "extension", "modifierExtension", "temperatureQualifier", "temperatureRange", "maxDuration", "instruction"},
+	reflect.TypeOf(SpecimenFeature{}):                                                      {"id", "extension", "modifierExtension", "type", "description"},
+	reflect.TypeOf(SpecimenProcessing{}):                                                   {"id", "extension", "modifierExtension", "description", "method", "additive", "timeDateTime", "timePeriod"},
+	reflect.TypeOf(StructureDefinition{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "keyword", "fhirVersion", "mapping", "kind", "abstract", "context", "contextInvariant", "type", "baseDefinition", "derivation", "snapshot", "differential"},
+	reflect.TypeOf(StructureDefinitionContext{}):                                           {"id", "extension", "modifierExtension", "type", "expression"},
+	reflect.TypeOf(StructureDefinitionMapping{}):                                           {"id", "extension", "modifierExtension", "identity", "uri", "name", "comment"},
+	reflect.TypeOf(StructureMap{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "structure", "import", "const", "group"},
+	reflect.TypeOf(StructureMapGroup{}):                                                    {"id", "extension", "modifierExtension", "name", "extends", "typeMode", "documentation", "input", "rule"},
+	reflect.TypeOf(StructureMapGroupInput{}):                                               {"id", "extension", "modifierExtension", "name", "type", "mode", "documentation"},
+	reflect.TypeOf(StructureMapGroupRule{}):                                                {"id", "extension", "modifierExtension", "name", "source", "target", "rule", "dependent", "documentation"},
+	reflect.TypeOf(StructureMapGroupRuleSource{}):                                          {"id", "extension", "modifierExtension", "context", "min", "max", "type", "defaultValue", "element", "listMode", "variable", "condition", "check", "logMessage"},
+	reflect.TypeOf(StructureMapGroupRuleTarget{}):                                          {"id", "extension", "modifierExtension", "context", "element", "variable", "listMode", "listRuleId", "transform", "parameter"},
+	reflect.TypeOf(StructureMapStructure{}):                                                {"id", "extension", "modifierExtension", "url", "mode", "alias", "documentation"},
+	reflect.TypeOf(Subscription{}):                                                         {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "status", "topic", "contact", "end", "managingEntity", "reason", "filterBy", "channelType", "endpoint", "parameter", "heartbeatPeriod", "timeout", "contentType", "content", "maxCount"},
+	reflect.TypeOf(SubscriptionFilterBy{}):                                                 {"id", "extension", "modifierExtension", "resourceType", "filterParameter", "comparator", "modifier", "value"},
+	reflect.TypeOf(SubscriptionStatus{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "status", "type", "eventsSinceSubscriptionStart", "notificationEvent", "subscription", "topic", "error"},
+	reflect.TypeOf(SubscriptionStatusNotificationEvent{}):                                  {"id", "extension", "modifierExtension", "eventNumber", "timestamp", "focus", "additionalContext"},
+	reflect.TypeOf(SubscriptionTopic{}):                                                    {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "derivedFrom", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "resourceTrigger", "eventTrigger", "canFilterBy", "notificationShape"},
+	reflect.TypeOf(SubscriptionTopicCanFilterBy{}):                                         {"id", "extension", "modifierExtension", "description", "resource", "filterParameter", "filterDefinition", "comparator", "modifier"},
+	reflect.TypeOf(SubscriptionTopicNotificationShape{}):                                   {"id", "extension", "modifierExtension", "resource", "include", "revInclude"},
+	reflect.TypeOf(SubscriptionTopicResourceTrigger{}):                                     {"id", "extension", "modifierExtension", "description", "resource", "supportedInteraction", "queryCriteria", "fhirPathCriteria"},
+	reflect.TypeOf(SubscriptionTopicResourceTriggerQueryCriteria{}):                        {"id", "extension", "modifierExtension", "previous", "resultForCreate", "current", "resultForDelete", "requireBoth"},
+	reflect.TypeOf(Substance{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instance", "status", "category", "code", "description", "expiry", "quantity", "ingredient"},
+	reflect.TypeOf(SubstanceDefinition{}):                                                  {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "version", "status", "classification", "domain", "grade", "description", "informationSource", "note", "manufacturer", "supplier", "moiety", "characterization", "property", "referenceInformation", "molecularWeight", "structure", "code", "name", "relationship", "nucleicAcid", "polymer", "protein", "sourceMaterial"},
+	reflect.TypeOf(SubstanceDefinitionCharacterization{}):                                  {"id", "extension", "modifierExtension", "technique", "form", "description", "file"},
+	reflect.TypeOf(SubstanceDefinitionMoiety{}):                                            {"id", "extension", "modifierExtension", "role", "identifier", "name", "stereochemistry", "opticalActivity", "molecularFormula", "amountQuantity", "amountString", "measurementType"},
+	reflect.TypeOf(SubstanceDefinitionMolecularWeight{}):                                   {"id", "extension", "modifierExtension", "method", "type", "amount"},
+	reflect.TypeOf(SubstanceDefinitionStructure{}):                                         {"id", "extension", "modifierExtension", "stereochemistry", "opticalActivity", "molecularFormula", "molecularFormulaByMoiety", "molecularWeight", "isotope", "representation"},
+	reflect.TypeOf(SubstanceDefinitionStructureRepresentation{}):                           {"id", "extension", "modifierExtension", "type", "representation", "format", "document"},
+	reflect.TypeOf(SubstanceNucleicAcid{}):                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "sequenceType", "numberOfSubunits", "areaOfHybridisation", "oligoNucleotideType", "subunit"},
+	reflect.TypeOf(SubstanceNucleicAcidSubunit{}):                                          {"id", "extension", "modifierExtension", "subunit", "sequence", "length", "sequenceAttachment", "fivePrime", "threePrime", "linkage", "sugar"},
+	reflect.TypeOf(SubstancePolymer{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "class", "geometry", "copolymerConnectivity", "modification", "monomerSet", "repeat"},
+	reflect.TypeOf(SubstancePolymerMonomerSetStartingMaterial{}):                           {"id", "extension", "modifierExtension", "code", "category", "isDefining", "amount"},
+	reflect.TypeOf(SubstancePolymerRepeat{}):                                               {"id", "extension", "modifierExtension", "averageMolecularFormula", "repeatUnitAmountType", "repeatUnit"},
+	reflect.TypeOf(SubstancePolymerRepeatRepeatUnit{}):                                     {"id", "extension", "modifierExtension", "unit", "orientation", "amount", "degreeOfPolymerisation", "structuralRepresentation"},
+	reflect.TypeOf(SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation{}):               {"id", "extension", "modifierExtension", "type", "average", "low", "high"},
+	reflect.TypeOf(SubstancePolymerRepeatRepeatUnitStructuralRepresentation{}):             {"id", "extension", "modifierExtension", "type", "representation", "format", "attachment"},
+	reflect.TypeOf(SubstanceProtein{}):                                                     {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "sequenceType", "numberOfSubunits", "disulfideLinkage", "subunit"},
+	reflect.TypeOf(SubstanceProteinSubunit{}):                                              {"id", "extension", "modifierExtension", "subunit", "sequence", "length", "sequenceAttachment", "nTerminalModificationId", "nTerminalModification", "cTerminalModificationId", "cTerminalModification"},
+	reflect.TypeOf(SubstanceReferenceInformation{}):                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "comment", "gene", "geneElement", "target"},
+	reflect.TypeOf(SubstanceReferenceInformationGene{}):                                    {"id", "extension", "modifierExtension", "geneSequenceOrigin", "gene", "source"},
+	reflect.TypeOf(SubstanceReferenceInformationGeneElement{}):                             {"id", "extension", "modifierExtension", "type", "element", "source"},
+	reflect.TypeOf(SubstanceReferenceInformationTarget{}):                                  {"id", "extension", "modifierExtension", "target", "type", "interaction", "organism", "organismType", "amountQuantity", "amountRange", "amountString", "amountType", "source"},
+	reflect.TypeOf(SubstanceSourceMaterial{}):                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "sourceMaterialClass", "sourceMaterialType", "sourceMaterialState", "organismId", "organismName", "parentSubstanceId", "parentSubstanceName", "countryOfOrigin", "geographicalLocation", "developmentStage", "fractionDescription", "organism", "partDescription"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganism{}):                                      {"id", "extension", "modifierExtension", "family", "genus", "species", "intraspecificType", "intraspecificDescription", "author", "hybrid", "organismGeneral"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganismAuthor{}):                                {"id", "extension", "modifierExtension", "authorType", "authorDescription"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganismHybrid{}):                                {"id", "extension", "modifierExtension", "maternalOrganismId", "maternalOrganismName", "paternalOrganismId", "paternalOrganismName", "hybridType"},
+	reflect.TypeOf(SubstanceSourceMaterialOrganismOrganismGeneral{}):                       {"id", "extension", "modifierExtension", "kingdom", "phylum", "class", "order"},
+	reflect.TypeOf(SupplyDelivery{}):                                                       {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "basedOn", "partOf", "status", "patient", "type", "suppliedItem", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "supplier", "destination", "receiver"},
+	reflect.TypeOf(SupplyDeliverySuppliedItem{}):                                           {"id", "extension", "modifierExtension", "quantity", "itemCodeableConcept", "itemReference"},
+	reflect.TypeOf(SupplyRequest{}):                                                        {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "basedOn", "category", "priority", "deliverFor", "item", "quantity", "parameter", "occurrenceDateTime", "occurrencePeriod", "occurrenceTiming", "authoredOn", "requester", "supplier", "reason", "deliverFrom", "deliverTo"},
+	reflect.TypeOf(Task{}):                                                                 {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "instantiatesCanonical", "instantiatesUri", "basedOn", "groupIdentifier", "status", "statusReason", "businessStatus", "priority", "code", "focus", "for", "encounter", "executionPeriod", "authoredOn", "lastModified", "requester", "performer", "location", "reason", "insurance", "note", "relevantHistory", "restriction", "input", "output", "performerType", "subject", "title", "url"},
+	reflect.TypeOf(TaskPerformer{}):                                                        {"id", "extension", "modifierExtension", "function", "actor"},
+	reflect.TypeOf(TaskRestriction{}):                                                      {"id", "extension", "modifierExtension", "repetitions", "period", "recipient"},
+	reflect.TypeOf(TerminologyCapabilities{}):                                              {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "kind", "software", "implementation", "lockedDate", "codeSystem", "expansion", "codeSearch", "validateCode", "translation", "closure"},
+	reflect.TypeOf(TestPlan{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "category", "scope", "testTools", "dependency", "exitCriteria", "testCase"},
+	reflect.TypeOf(TestReport{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "name", "status", "testScript", "result", "score", "tester", "issued", "participant", "setup", "test", "teardown"},
+	reflect.TypeOf(TestReportParticipant{}):                                                {"id", "extension", "modifierExtension", "type", "uri", "display"},
+	reflect.TypeOf(TestReportSetupActionAssert{}):                                          {"id", "extension", "modifierExtension", "result", "message", "detail", "requirement"},
+	reflect.TypeOf(TestReportSetupActionOperation{}):                                       {"id", "extension", "modifierExtension", "result", "message", "detail"},
+	reflect.TypeOf(TestScript{}):                                                           {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "purpose", "copyright", "copyrightLabel", "origin", "destination", "metadata", "scope", "fixture", "profile", "variable", "setup", "test", "teardown"},
+	reflect.TypeOf(TestScriptMetadata{}):                                                   {"id", "extension", "modifierExtension", "link", "capability"},
+	reflect.TypeOf(TestScriptMetadataCapability{}):                                         {"id", "extension", "modifierExtension", "required", "validated", "description", "origin", "destination", "link", "capabilities"},
+	reflect.TypeOf(TestScriptMetadataLink{}):                                               {"id", "extension", "modifierExtension", "url", "description"},
+	reflect.TypeOf(Timing{}):                                                               {"id", "extension", "modifierExtension", "event", "repeat", "code"},
+	reflect.TypeOf(Transport{}):                                                            {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "instantiatesCanonical", "instantiatesUri", "basedOn", "groupIdentifier", "partOf", "status", "statusReason", "intent", "priority", "code", "description", "focus", "for", "encounter", "completionTime", "authoredOn", "lastModified", "requester", "performerType", "owner", "location", "insurance", "note", "relevantHistory", "restriction", "input", "output", "requestedLocation", "currentLocation", "reason", "history"},
+	reflect.TypeOf(TransportRestriction{}):                                                 {"id", "extension", "modifierExtension", "repetitions", "period", "recipient"},
+	reflect.TypeOf(ValueSet{}):                                                             {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "url", "identifier", "version", "versionAlgorithmString", "versionAlgorithmCoding", "name", "title", "status", "experimental", "date", "publisher", "contact", "description", "useContext", "jurisdiction", "immutable", "purpose", "copyright", "copyrightLabel", "approvalDate", "lastReviewDate", "effectivePeriod", "topic", "author", "editor", "reviewer", "endorser", "relatedArtifact", "compose", "expansion", "scope"},
+	reflect.TypeOf(ValueSetComposeInclude{}):                                               {"id", "extension", "modifierExtension", "system", "version", "concept", "filter", "valueSet"},
+	reflect.TypeOf(ValueSetComposeIncludeConcept{}):                                        {"id", "extension", "modifierExtension", "code", "display", "designation"},
+	reflect.TypeOf(ValueSetComposeIncludeConceptDesignation{}):                             {"id", "extension", "modifierExtension", "language", "use", "additionalUse", "value"},
+	reflect.TypeOf(ValueSetComposeIncludeFilter{}):                                         {"id", "extension", "modifierExtension", "property", "op", "value"},
+	reflect.TypeOf(ValueSetExpansionContains{}):                                            {"id", "extension", "modifierExtension", "system", "abstract", "inactive", "version", "code", "display", "designation", "property", "contains"},
+	reflect.TypeOf(VerificationResult{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "target", "targetLocation", "need", "status", "statusDate", "validationType", "validationProcess", "frequency", "lastPerformed", "nextScheduled", "failureAction", "primarySource", "attestation", "validator"},
+	reflect.TypeOf(VerificationResultAttestation{}):                                        {"id", "extension", "modifierExtension", "who", "onBehalfOf", "communicationMethod", "date", "sourceIdentityCertificate", "proxyIdentityCertificate", "proxySignature", "sourceSignature"},
+	reflect.TypeOf(VerificationResultPrimarySource{}):                                      {"id", "extension", "modifierExtension", "who", "type", "communicationMethod", "validationStatus", "validationDate", "canPushUpdates", "pushTypeAvailable"},
+	reflect.TypeOf(VerificationResultValidator{}):                                          {"id", "extension", "modifierExtension", "organization", "attestationSignature"},
+	reflect.TypeOf(VisionPrescription{}):                                                   {"id", "meta", "implicitRules", "language", "text", "contained", "extension", "modifierExtension", "identifier", "status", "created", "patient", "encounter", "dateWritten", "prescriber", "lensSpecification"},
+	reflect.TypeOf(VisionPrescriptionLensSpecification{}):                                  {"id", "extension", "modifierExtension", "product", "eye", "sphere", "cylinder", "axis", "prism", "add", "power", "backCurve", "diameter", "duration", "color", "brand", "note"},
+}
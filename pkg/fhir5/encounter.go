@@ -103,7 +103,8 @@ type EncounterLocation struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// When the patient is no longer active at a location
-	Status *EncounterLocationStatus `json:"status,omitempty"`
+	Status        *EncounterLocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
 
 // Encounter represents an interaction between a patient and healthcare provider(s)
@@ -159,10 +160,12 @@ type Encounter struct {
 	PartOf *common.Reference `json:"partOf,omitempty"`
 
 	// The planned end date/time (or discharge date) of the encounter
-	PlannedEndDate *string `json:"plannedEndDate,omitempty"`
+	PlannedEndDate        *common.DateTime `json:"plannedEndDate,omitempty"`
+	PlannedEndDateElement *common.Element  `json:"_plannedEndDate,omitempty"`
 
 	// The planned start date/time (or admission date) of the encounter
-	PlannedStartDate *string `json:"plannedStartDate,omitempty"`
+	PlannedStartDate        *common.DateTime `json:"plannedStartDate,omitempty"`
+	PlannedStartDateElement *common.Element  `json:"_plannedStartDate,omitempty"`
 
 	// Indicates the urgency of the encounter
 	Priority *common.CodeableConcept `json:"priority,omitempty"`
@@ -183,7 +186,8 @@ type Encounter struct {
 	SpecialCourtesy []common.CodeableConcept `json:"specialCourtesy,omitempty"`
 
 	// Note that internal business rules will determine the appropriate transitions
-	Status EncounterStatus `json:"status"`
+	Status        EncounterStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// While the encounter is always about the patient, the patient might not actually be known
 	Subject *common.Reference `json:"subject,omitempty"`
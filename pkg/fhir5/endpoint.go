@@ -21,7 +21,8 @@ type EndpointPayload struct {
 	common.BackboneElement
 
 	// MIME types supported by the endpoint
-	MimeType []string `json:"mimeType,omitempty"`
+	MimeType        []string          `json:"mimeType,omitempty"`
+	MimeTypeElement []*common.Element `json:"_mimeType,omitempty"`
 
 	// The payload type
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -35,7 +36,8 @@ type Endpoint struct {
 	ResourceType string `json:"resourceType"` // Always "Endpoint"
 
 	// The technical base address for connecting to this endpoint
-	Address string `json:"address"`
+	Address        string          `json:"address"`
+	AddressElement *common.Element `json:"_address,omitempty"`
 
 	// Connection type details
 	ConnectionType []common.CodeableConcept `json:"connectionType"`
@@ -44,13 +46,15 @@ type Endpoint struct {
 	Contact []ContactPoint `json:"contact,omitempty"`
 
 	// Description of the endpoint
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Environment type
 	EnvironmentType []common.CodeableConcept `json:"environmentType,omitempty"`
 
 	// Usage depends on the channel type
-	Header []string `json:"header,omitempty"`
+	Header        []string          `json:"header,omitempty"`
+	HeaderElement []*common.Element `json:"_header,omitempty"`
 
 	// Identifier for the endpoint
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -59,7 +63,8 @@ type Endpoint struct {
 	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
 
 	// A name that this endpoint can be referred to with
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Set of payloads that are provided by this endpoint
 	Payload []EndpointPayload `json:"payload,omitempty"`
@@ -68,5 +73,6 @@ type Endpoint struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// Active | suspended | error | off | entered-in-error
-	Status EndpointStatus `json:"status"`
+	Status        EndpointStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 }
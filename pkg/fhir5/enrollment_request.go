@@ -29,7 +29,8 @@ type EnrollmentRequest struct {
 	Coverage *common.Reference `json:"coverage,omitempty"`
 
 	// The date when this resource was created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// The Response business identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -41,5 +42,6 @@ type EnrollmentRequest struct {
 	Provider *common.Reference `json:"provider,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the request as not currently valid
-	Status *EnrollmentRequestStatus `json:"status,omitempty"`
+	Status        *EnrollmentRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
@@ -33,10 +33,12 @@ type EnrollmentResponse struct {
 	ResourceType string `json:"resourceType"` // Always "EnrollmentResponse"
 
 	// The date when the enclosed suite of services were performed or completed
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// A description of the status of the adjudication
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// The Response business identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -45,7 +47,8 @@ type EnrollmentResponse struct {
 	Organization *common.Reference `json:"organization,omitempty"`
 
 	// Processing status: error, complete
-	Outcome *EnrollmentResponseOutcome `json:"outcome,omitempty"`
+	Outcome        *EnrollmentResponseOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element            `json:"_outcome,omitempty"`
 
 	// Original request resource reference
 	Request *common.Reference `json:"request,omitempty"`
@@ -54,5 +57,6 @@ type EnrollmentResponse struct {
 	RequestProvider *common.Reference `json:"requestProvider,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the response as not currently valid
-	Status *EnrollmentResponseStatus `json:"status,omitempty"`
+	Status        *EnrollmentResponseStatus `json:"status,omitempty"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 }
@@ -26,7 +26,8 @@ type EpisodeOfCareStatusHistory struct {
 	Period common.Period `json:"period"`
 
 	// planned | waitlist | active | onhold | finished | cancelled | entered-in-error
-	Status EpisodeOfCareStatus `json:"status"`
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 }
 
 // EpisodeOfCareReason represents the reason for the episode of care
@@ -89,7 +90,8 @@ type EpisodeOfCare struct {
 	ReferralRequest []common.Reference `json:"referralRequest,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the episode as not currently valid
-	Status EpisodeOfCareStatus `json:"status"`
+	Status        EpisodeOfCareStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// The history of statuses that the EpisodeOfCare has been through
 	StatusHistory []EpisodeOfCareStatusHistory `json:"statusHistory,omitempty"`
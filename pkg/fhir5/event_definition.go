@@ -23,7 +23,8 @@ type EventDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "EventDefinition"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []ContactDetail `json:"author,omitempty"`
@@ -32,16 +33,20 @@ type EventDefinition struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// The short copyright declaration
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -53,7 +58,8 @@ type EventDefinition struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of event definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -62,16 +68,20 @@ type EventDefinition struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The publisher (or steward) of the event definition
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the event definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Each related resource is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -80,17 +90,20 @@ type EventDefinition struct {
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// Allows filtering of event definitions that are appropriate for use versus not
-	Status EventDefinitionStatus `json:"status"`
+	Status        EventDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// A code or group definition that describes the intended subject of the event definition - choice type
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *common.Reference       `json:"subjectReference,omitempty"`
 
 	// An explanatory or alternate title for the event definition
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// This name does not need to be machine-processing friendly
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// DEPRECATION NOTE: For consistency, implementations are encouraged to migrate to using the new 'topic' code in the useContext element
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -99,18 +112,22 @@ type EventDefinition struct {
 	Trigger []TriggerDefinition `json:"trigger"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description of how the event definition is used from a clinical perspective
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different event definition instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
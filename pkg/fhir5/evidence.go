@@ -13,10 +13,12 @@ type Evidence struct {
 	ResourceType string `json:"resourceType"` // Always "Evidence"
 
 	// The 'date' element may be more recent than the approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// Declarative description of the Evidence
-	Assertion *string `json:"assertion,omitempty"`
+	Assertion        *string         `json:"assertion,omitempty"`
+	AssertionElement *common.Element `json:"_assertion,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []ContactDetail `json:"author,omitempty"`
@@ -25,23 +27,28 @@ type Evidence struct {
 	Certainty []EvidenceCertainty `json:"certainty,omitempty"`
 
 	// Citation Resource or display of suggested citation for this evidence
-	CiteAsReference *common.Reference `json:"citeAsReference,omitempty"`
-	CiteAsMarkdown  *string           `json:"citeAsMarkdown,omitempty"`
+	CiteAsReference       *common.Reference `json:"citeAsReference,omitempty"`
+	CiteAsMarkdown        *string           `json:"citeAsMarkdown,omitempty"`
+	CiteAsMarkdownElement *common.Element   `json:"_citeAsMarkdown,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Use and/or publishing restrictions
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Date last changed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Natural language description of the evidence
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -53,25 +60,30 @@ type Evidence struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Additional identifier for the evidence
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// Name for this evidence (computer friendly)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Used for footnotes or explanatory notes
 	Note []Annotation `json:"note,omitempty"`
 
 	// Name of the publisher (organization or individual)
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this evidence is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Link or citation to artifact associated with the summary
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -83,7 +95,8 @@ type Evidence struct {
 	Statistic []EvidenceStatistic `json:"statistic,omitempty"`
 
 	// The status of this evidence
-	Status EventDefinitionStatus `json:"status"`
+	Status        EventDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// The design of the study that produced this evidence
 	StudyDesign []common.CodeableConcept `json:"studyDesign,omitempty"`
@@ -92,10 +105,12 @@ type Evidence struct {
 	SynthesisType *common.CodeableConcept `json:"synthesisType,omitempty"`
 
 	// Name for this evidence (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Canonical identifier for this evidence
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// The context that the content is intended to support
 	UseContext []UsageContext `json:"useContext,omitempty"`
@@ -104,11 +119,13 @@ type Evidence struct {
 	VariableDefinition []EvidenceVariableDefinition `json:"variableDefinition"`
 
 	// Business version of the evidence
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
 
 // (Add related types: EvidenceCertainty, EvidenceStatistic, etc. as needed)
@@ -13,7 +13,8 @@ type EvidenceReportSubjectCharacteristic struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// Is used to express not the characteristic
-	Exclude *bool `json:"exclude,omitempty"`
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// Timeframe for the characteristic
 	Period *common.Period `json:"period,omitempty"`
@@ -22,6 +23,7 @@ type EvidenceReportSubjectCharacteristic struct {
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 }
@@ -42,7 +44,8 @@ type EvidenceReportRelatesToTarget struct {
 	common.BackboneElement
 
 	// Target of the relationship Display
-	Display *string `json:"display,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
 
 	// Target of the relationship Identifier
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -51,7 +54,8 @@ type EvidenceReportRelatesToTarget struct {
 	Resource *common.Reference `json:"resource,omitempty"`
 
 	// Target of the relationship URL
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 }
 
 // EvidenceReportRelatesTo represents relationships to other documents
@@ -59,7 +63,8 @@ type EvidenceReportRelatesTo struct {
 	common.BackboneElement
 
 	// replaces | amends | appends | transforms | replacedWith | amendedWith | appendedWith | transformedWith
-	Code EvidenceReportRelatesToCode `json:"code"`
+	Code        EvidenceReportRelatesToCode `json:"code"`
+	CodeElement *common.Element             `json:"_code,omitempty"`
 
 	// The target composition/document of this relationship
 	Target EvidenceReportRelatesToTarget `json:"target"`
@@ -105,7 +110,8 @@ type EvidenceReportSection struct {
 	FocusReference *common.Reference `json:"focusReference,omitempty"`
 
 	// working | snapshot | changes
-	Mode *EvidenceReportSectionMode `json:"mode,omitempty"`
+	Mode        *EvidenceReportSectionMode `json:"mode,omitempty"`
+	ModeElement *common.Element            `json:"_mode,omitempty"`
 
 	// Applications SHOULD render ordered lists in the order provided
 	OrderedBy *common.CodeableConcept `json:"orderedBy,omitempty"`
@@ -117,7 +123,8 @@ type EvidenceReportSection struct {
 	Text *Narrative `json:"text,omitempty"`
 
 	// The title identifies the section for a human reader
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // EvidenceReportSectionMode represents the mode of a section
@@ -140,8 +147,9 @@ type EvidenceReport struct {
 	Author []ContactDetail `json:"author,omitempty"`
 
 	// Used for reports for which external citation is expected
-	CiteAsReference *common.Reference `json:"citeAsReference,omitempty"`
-	CiteAsMarkdown  *string           `json:"citeAsMarkdown,omitempty"`
+	CiteAsReference       *common.Reference `json:"citeAsReference,omitempty"`
+	CiteAsMarkdown        *string           `json:"citeAsMarkdown,omitempty"`
+	CiteAsMarkdownElement *common.Element   `json:"_citeAsMarkdown,omitempty"`
 
 	// Extensions to ContactDetail include: contactReference, contactAddress, and contributionTime
 	Contact []ContactDetail `json:"contact,omitempty"`
@@ -159,7 +167,8 @@ type EvidenceReport struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Link, description or reference to artifact associated with the report
 	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -177,7 +186,8 @@ type EvidenceReport struct {
 	Section []EvidenceReportSection `json:"section,omitempty"`
 
 	// Allows filtering of summaries that are appropriate for use versus not
-	Status EventDefinitionStatus `json:"status"`
+	Status        EventDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// May be used as an expression for search queries and search results
 	Subject EvidenceReportSubject `json:"subject"`
@@ -186,7 +196,8 @@ type EvidenceReport struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
@@ -10,7 +10,8 @@ type EvidenceVariableDefinition struct {
 	common.BackboneElement
 
 	// A text description or summary of the variable
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Indication of quality of match between intended variable to actual variable
 	DirectnessMatch *common.CodeableConcept `json:"directnessMatch,omitempty"`
@@ -33,19 +34,23 @@ type EvidenceStatisticSampleSize struct {
 	common.BackboneElement
 
 	// Human-readable summary of population sample size
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Number of participants with known results for measured variables
-	KnownDataCount *int `json:"knownDataCount,omitempty"`
+	KnownDataCount        *int            `json:"knownDataCount,omitempty"`
+	KnownDataCountElement *common.Element `json:"_knownDataCount,omitempty"`
 
 	// Footnote or explanatory note about the sample size
 	Note []Annotation `json:"note,omitempty"`
 
 	// Number of participants in the population
-	NumberOfParticipants *int `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipants        *int            `json:"numberOfParticipants,omitempty"`
+	NumberOfParticipantsElement *common.Element `json:"_numberOfParticipants,omitempty"`
 
 	// Number of studies
-	NumberOfStudies *int `json:"numberOfStudies,omitempty"`
+	NumberOfStudies        *int            `json:"numberOfStudies,omitempty"`
+	NumberOfStudiesElement *common.Element `json:"_numberOfStudies,omitempty"`
 }
 
 // EvidenceStatisticAttributeEstimate represents attribute estimate information
@@ -56,10 +61,12 @@ type EvidenceStatisticAttributeEstimate struct {
 	AttributeEstimate []EvidenceStatisticAttributeEstimate `json:"attributeEstimate,omitempty"`
 
 	// Human-readable summary of the estimate
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Use 95 for a 95% confidence interval
-	Level *float64 `json:"level,omitempty"`
+	Level        *common.Decimal `json:"level,omitempty"`
+	LevelElement *common.Element `json:"_level,omitempty"`
 
 	// Footnote or explanatory note about the estimate
 	Note []Annotation `json:"note,omitempty"`
@@ -79,7 +86,8 @@ type EvidenceStatisticModelCharacteristicVariable struct {
 	common.BackboneElement
 
 	// How the variable is classified for use in adjusted analysis
-	Handling *EvidenceVariableHandling `json:"handling,omitempty"`
+	Handling        *EvidenceVariableHandling `json:"handling,omitempty"`
+	HandlingElement *common.Element           `json:"_handling,omitempty"`
 
 	// Description for grouping of ordinal or polychotomous variables
 	ValueCategory []common.CodeableConcept `json:"valueCategory,omitempty"`
@@ -132,7 +140,8 @@ type EvidenceStatistic struct {
 	Category *common.CodeableConcept `json:"category,omitempty"`
 
 	// A description of the content value of the statistic
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A component of the method to generate the statistic
 	ModelCharacteristic []EvidenceStatisticModelCharacteristic `json:"modelCharacteristic,omitempty"`
@@ -141,10 +150,12 @@ type EvidenceStatistic struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Number of participants with events
-	NumberAffected *int `json:"numberAffected,omitempty"`
+	NumberAffected        *int            `json:"numberAffected,omitempty"`
+	NumberAffectedElement *common.Element `json:"_numberAffected,omitempty"`
 
 	// Number of events
-	NumberOfEvents *int `json:"numberOfEvents,omitempty"`
+	NumberOfEvents        *int            `json:"numberOfEvents,omitempty"`
+	NumberOfEventsElement *common.Element `json:"_numberOfEvents,omitempty"`
 
 	// Statistic value
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -161,13 +172,15 @@ type EvidenceCertainty struct {
 	common.BackboneElement
 
 	// Textual description of certainty
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Footnotes and/or explanatory notes
 	Note []Annotation `json:"note,omitempty"`
 
 	// Individual or group who did the rating
-	Rater *string `json:"rater,omitempty"`
+	Rater        *string         `json:"rater,omitempty"`
+	RaterElement *common.Element `json:"_rater,omitempty"`
 
 	// Assessment or judgement of the aspect
 	Rating *common.CodeableConcept `json:"rating,omitempty"`
@@ -200,10 +213,12 @@ type EvidenceVariableCharacteristicDefinitionByTypeAndValue struct {
 	// Defines the characteristic when paired with characteristic.definitionByTypeAndValue.type
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
 	ValueId              *string                 `json:"valueId,omitempty"`
+	ValueIdElement       *common.Element         `json:"_valueId,omitempty"`
 }
 
 // EvidenceVariableCharacteristicTimeFromEvent represents time from event
@@ -211,13 +226,16 @@ type EvidenceVariableCharacteristicTimeFromEvent struct {
 	common.BackboneElement
 
 	// Human readable description
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The event used as a base point (reference point) in time
 	EventCodeableConcept *common.CodeableConcept `json:"eventCodeableConcept,omitempty"`
 	EventReference       *common.Reference       `json:"eventReference,omitempty"`
-	EventDateTime        *string                 `json:"eventDateTime,omitempty"`
+	EventDateTime        *common.DateTime        `json:"eventDateTime,omitempty"`
+	EventDateTimeElement *common.Element         `json:"_eventDateTime,omitempty"`
 	EventId              *string                 `json:"eventId,omitempty"`
+	EventIdElement       *common.Element         `json:"_eventId,omitempty"`
 
 	// Used to express the observation at a defined number of time units after the defined event
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -235,7 +253,8 @@ type EvidenceVariableCharacteristic struct {
 	DefinitionByTypeAndValue *EvidenceVariableCharacteristicDefinitionByTypeAndValue `json:"definitionByTypeAndValue,omitempty"`
 
 	// Defines the characteristic using Canonical
-	DefinitionCanonical *string `json:"definitionCanonical,omitempty"`
+	DefinitionCanonical        *string         `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element `json:"_definitionCanonical,omitempty"`
 
 	// Defines the characteristic using CodeableConcept
 	DefinitionCodeableConcept *common.CodeableConcept `json:"definitionCodeableConcept,omitempty"`
@@ -244,19 +263,22 @@ type EvidenceVariableCharacteristic struct {
 	DefinitionExpression *Expression `json:"definitionExpression,omitempty"`
 
 	// Defines the characteristic using id
-	DefinitionId *string `json:"definitionId,omitempty"`
+	DefinitionId        *string         `json:"definitionId,omitempty"`
+	DefinitionIdElement *common.Element `json:"_definitionId,omitempty"`
 
 	// Defines the characteristic using Reference
 	DefinitionReference *common.Reference `json:"definitionReference,omitempty"`
 
 	// A short, natural language description of the characteristic
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Device used for determining characteristic
 	Device *common.Reference `json:"device,omitempty"`
 
 	// When true, members with this characteristic are excluded from the element
-	Exclude *bool `json:"exclude,omitempty"`
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// The grouping characteristic
 	GroupMeasure *common.CodeableConcept `json:"groupMeasure,omitempty"`
@@ -281,7 +303,8 @@ type EvidenceVariableCategory struct {
 	common.BackboneElement
 
 	// Description of the grouping
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Definition of the grouping
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
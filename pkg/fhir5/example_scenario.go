@@ -10,16 +10,20 @@ type ExampleScenarioActor struct {
 	common.BackboneElement
 
 	// ID of the actor
-	ActorID string `json:"actorId"`
+	ActorID        string          `json:"actorId"`
+	ActorIDElement *common.Element `json:"_actorId,omitempty"`
 
 	// The description of the actor
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The name of the actor as shown in the page
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of actor - person or system
-	Type ExampleScenarioActorType `json:"type"`
+	Type        ExampleScenarioActorType `json:"type"`
+	TypeElement *common.Element          `json:"_type,omitempty"`
 }
 
 // ExampleScenarioActorType represents the type of actor
@@ -35,10 +39,12 @@ type ExampleScenarioInstanceContainedInstance struct {
 	common.BackboneElement
 
 	// Each resource contained in the instance
-	ResourceID string `json:"resourceId"`
+	ResourceID        string          `json:"resourceId"`
+	ResourceIDElement *common.Element `json:"_resourceId,omitempty"`
 
 	// A specific version of a resource contained in the instance
-	VersionID *string `json:"versionId,omitempty"`
+	VersionID        *string         `json:"versionId,omitempty"`
+	VersionIDElement *common.Element `json:"_versionId,omitempty"`
 }
 
 // ExampleScenarioInstanceVersion represents version information for an instance
@@ -46,10 +52,12 @@ type ExampleScenarioInstanceVersion struct {
 	common.BackboneElement
 
 	// The identifier of a specific version of a resource described in the scenario
-	VersionID string `json:"versionId"`
+	VersionID        string          `json:"versionId"`
+	VersionIDElement *common.Element `json:"_versionId,omitempty"`
 
 	// The description of the resource version
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 }
 
 // ExampleScenarioInstance represents a single data collection that is shared as part of the scenario
@@ -57,16 +65,19 @@ type ExampleScenarioInstance struct {
 	common.BackboneElement
 
 	// The id of the resource for referencing
-	ResourceID string `json:"resourceId"`
+	ResourceID        string          `json:"resourceId"`
+	ResourceIDElement *common.Element `json:"_resourceId,omitempty"`
 
 	// The type of the resource
 	ResourceType string `json:"resourceType"`
 
 	// A short name for the resource instance
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Human-friendly description of the resource instance
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A specific version of the resource
 	Version []ExampleScenarioInstanceVersion `json:"version,omitempty"`
@@ -80,28 +91,36 @@ type ExampleScenarioProcessStepOperation struct {
 	common.BackboneElement
 
 	// The sequential number of the interaction
-	Number string `json:"number"`
+	Number        string          `json:"number"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// The type of operation - CRUD
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// The human-friendly name of the interaction
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Who starts the transaction
-	Initiator *string `json:"initiator,omitempty"`
+	Initiator        *string         `json:"initiator,omitempty"`
+	InitiatorElement *common.Element `json:"_initiator,omitempty"`
 
 	// Who receives the transaction
-	Receiver *string `json:"receiver,omitempty"`
+	Receiver        *string         `json:"receiver,omitempty"`
+	ReceiverElement *common.Element `json:"_receiver,omitempty"`
 
 	// A comment to be inserted in the diagram
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Whether the initiator is deactivated right after the transaction
-	InitiatorActive *bool `json:"initiatorActive,omitempty"`
+	InitiatorActive        *bool           `json:"initiatorActive,omitempty"`
+	InitiatorActiveElement *common.Element `json:"_initiatorActive,omitempty"`
 
 	// Whether the receiver is deactivated right after the transaction
-	ReceiverActive *bool `json:"receiverActive,omitempty"`
+	ReceiverActive        *bool           `json:"receiverActive,omitempty"`
+	ReceiverActiveElement *common.Element `json:"_receiverActive,omitempty"`
 
 	// Each resource instance used by the initiator
 	Request *ExampleScenarioInstanceContainedInstance `json:"request,omitempty"`
@@ -115,10 +134,12 @@ type ExampleScenarioProcessStepAlternative struct {
 	common.BackboneElement
 
 	// The label to display for the alternative that gives a sense of the circumstance in which the alternative should be invoked
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// A human-readable description of each option
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// What happens in each alternative option
 	Step []ExampleScenarioProcessStep `json:"step,omitempty"`
@@ -132,7 +153,8 @@ type ExampleScenarioProcessStep struct {
 	Process []ExampleScenarioProcess `json:"process,omitempty"`
 
 	// If there is a pause in the flow
-	Pause *bool `json:"pause,omitempty"`
+	Pause        *bool           `json:"pause,omitempty"`
+	PauseElement *common.Element `json:"_pause,omitempty"`
 
 	// Each interaction or action
 	Operation *ExampleScenarioProcessStepOperation `json:"operation,omitempty"`
@@ -146,16 +168,20 @@ type ExampleScenarioProcess struct {
 	common.BackboneElement
 
 	// The diagram title of the group of operations
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// A longer description of the group of operations
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Description of final status after the process ends
-	PreConditions *string `json:"preConditions,omitempty"`
+	PreConditions        *string         `json:"preConditions,omitempty"`
+	PreConditionsElement *common.Element `json:"_preConditions,omitempty"`
 
 	// Description of initial status before the process starts
-	PostConditions *string `json:"postConditions,omitempty"`
+	PostConditions        *string         `json:"postConditions,omitempty"`
+	PostConditionsElement *common.Element `json:"_postConditions,omitempty"`
 
 	// Each step of the process
 	Step []ExampleScenarioProcessStep `json:"step,omitempty"`
@@ -175,19 +201,24 @@ type ExampleScenario struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Use and/or publishing restrictions
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Date last changed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Natural language description of the example scenario
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Additional identifier for the example scenario
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -199,33 +230,41 @@ type ExampleScenario struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// Name for this example scenario (computer friendly)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Some scenarios might describe only one process
 	Process []ExampleScenarioProcess `json:"process,omitempty"`
 
 	// Name of the publisher (organization or individual)
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this example scenario is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The status of this example scenario
-	Status EventDefinitionStatus `json:"status"`
+	Status        EventDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// Name for this example scenario (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Canonical identifier for this example scenario
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// The context that the content is intended to support
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// Business version of the example scenario
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// How to compare versions
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
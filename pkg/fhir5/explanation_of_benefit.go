@@ -42,8 +42,9 @@ type ExplanationOfBenefitEvent struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// A date or period in the past or future indicating when the event occurred
-	WhenDateTime *string        `json:"whenDateTime,omitempty"`
-	WhenPeriod   *common.Period `json:"whenPeriod,omitempty"`
+	WhenDateTime        *common.DateTime `json:"whenDateTime,omitempty"`
+	WhenDateTimeElement *common.Element  `json:"_whenDateTime,omitempty"`
+	WhenPeriod          *common.Period   `json:"whenPeriod,omitempty"`
 }
 
 // ExplanationOfBenefitSupportingInfo represents supporting information for the claim
@@ -60,19 +61,23 @@ type ExplanationOfBenefitSupportingInfo struct {
 	Reason *common.Coding `json:"reason,omitempty"`
 
 	// A number to uniquely identify supporting information entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// The date when or period to which this information refers
-	TimingDate   *string        `json:"timingDate,omitempty"`
-	TimingPeriod *common.Period `json:"timingPeriod,omitempty"`
+	TimingDate        *common.Date    `json:"timingDate,omitempty"`
+	TimingDateElement *common.Element `json:"_timingDate,omitempty"`
+	TimingPeriod      *common.Period  `json:"timingPeriod,omitempty"`
 
 	// Could be used to provide references to other resources, document
-	ValueBoolean    *bool              `json:"valueBoolean,omitempty"`
-	ValueString     *string            `json:"valueString,omitempty"`
-	ValueQuantity   *common.Quantity   `json:"valueQuantity,omitempty"`
-	ValueAttachment *Attachment        `json:"valueAttachment,omitempty"`
-	ValueReference  *common.Reference  `json:"valueReference,omitempty"`
-	ValueIdentifier *common.Identifier `json:"valueIdentifier,omitempty"`
+	ValueBoolean        *bool              `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element    `json:"_valueBoolean,omitempty"`
+	ValueString         *string            `json:"valueString,omitempty"`
+	ValueStringElement  *common.Element    `json:"_valueString,omitempty"`
+	ValueQuantity       *common.Quantity   `json:"valueQuantity,omitempty"`
+	ValueAttachment     *Attachment        `json:"valueAttachment,omitempty"`
+	ValueReference      *common.Reference  `json:"valueReference,omitempty"`
+	ValueIdentifier     *common.Identifier `json:"valueIdentifier,omitempty"`
 }
 
 // ExplanationOfBenefitDiagnosis represents diagnoses relevant to the claim
@@ -87,7 +92,8 @@ type ExplanationOfBenefitDiagnosis struct {
 	OnAdmission *common.CodeableConcept `json:"onAdmission,omitempty"`
 
 	// Diagnosis are presented in list order to their expected importance
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// For example: admitting, primary, secondary, discharge
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -98,14 +104,16 @@ type ExplanationOfBenefitProcedure struct {
 	common.BackboneElement
 
 	// Date and optionally time the procedure was performed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Specific clinical procedure
 	ProcedureCodeableConcept *common.CodeableConcept `json:"procedureCodeableConcept,omitempty"`
 	ProcedureReference       *common.Reference       `json:"procedureReference,omitempty"`
 
 	// A number to uniquely identify procedure entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// Category of Procedure
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -122,10 +130,12 @@ type ExplanationOfBenefitInsurance struct {
 	Coverage common.Reference `json:"coverage"`
 
 	// Coverage to be used for adjudication
-	Focal bool `json:"focal"`
+	Focal        bool            `json:"focal"`
+	FocalElement *common.Element `json:"_focal,omitempty"`
 
 	// Prior authorization reference number
-	PreAuthRef []string `json:"preAuthRef,omitempty"`
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
 }
 
 // ExplanationOfBenefitAccident represents details of an accident
@@ -133,7 +143,8 @@ type ExplanationOfBenefitAccident struct {
 	common.BackboneElement
 
 	// When the incident occurred
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Where the event occurred
 	LocationAddress   *Address          `json:"locationAddress,omitempty"`
@@ -154,13 +165,15 @@ type ExplanationOfBenefitCareTeam struct {
 	Qualification *common.CodeableConcept `json:"qualification,omitempty"`
 
 	// Indicator of the lead practitioner
-	Responsible *bool `json:"responsible,omitempty"`
+	Responsible        *bool           `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
 
 	// Function within the team
 	Role *common.CodeableConcept `json:"role,omitempty"`
 
 	// Order of care team
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 }
 
 // ExplanationOfBenefitPayee represents the recipient of the payment
@@ -188,7 +201,8 @@ type ExplanationOfBenefitPayment struct {
 	Amount *Money `json:"amount,omitempty"`
 
 	// Expected date of payment
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Business identifier for the payment
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -205,10 +219,12 @@ type ExplanationOfBenefitProcessNote struct {
 	Language *common.CodeableConcept `json:"language,omitempty"`
 
 	// A number to uniquely identify a note entry
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// Note explanatory text
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The business purpose of the note text
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -247,13 +263,16 @@ type ExplanationOfBenefitBenefitBalance struct {
 	Category common.CodeableConcept `json:"category"`
 
 	// For example, 'DENT2 covers 100% of basic, 50% of major but excludes Ortho, Implants and Cosmetic services'
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// True if the indicated class of service is excluded from the plan
-	Excluded *bool `json:"excluded,omitempty"`
+	Excluded        *bool           `json:"excluded,omitempty"`
+	ExcludedElement *common.Element `json:"_excluded,omitempty"`
 
 	// For example: MED01, or DENT2
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Is a flag to indicate whether the benefits refer to in-network providers
 	Network *common.CodeableConcept `json:"network,omitempty"`
@@ -270,22 +289,26 @@ type ExplanationOfBenefitItem struct {
 	common.BackboneElement
 
 	// Care team members related to this service or product
-	CareTeamSequence []int `json:"careTeamSequence,omitempty"`
+	CareTeamSequence        []int             `json:"careTeamSequence,omitempty"`
+	CareTeamSequenceElement []*common.Element `json:"_careTeamSequence,omitempty"`
 
 	// Examples include Medical Care, Periodontics, Renal Dialysis, Vision Coverage
 	Category *common.CodeableConcept `json:"category,omitempty"`
 
 	// Diagnoses applicable for this service or product
-	DiagnosisSequence []int `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequence        []int             `json:"diagnosisSequence,omitempty"`
+	DiagnosisSequenceElement []*common.Element `json:"_diagnosisSequence,omitempty"`
 
 	// Healthcare encounters related to this claim
 	Encounter []common.Reference `json:"encounter,omitempty"`
 
 	// To show a 10% senior's discount, the value entered is: 0.90 (1.00 - 0.10)
-	Factor *float64 `json:"factor,omitempty"`
+	Factor        *common.Decimal `json:"factor,omitempty"`
+	FactorElement *common.Element `json:"_factor,omitempty"`
 
 	// Exceptions, special conditions and supporting information
-	InformationSequence []int `json:"informationSequence,omitempty"`
+	InformationSequence        []int             `json:"informationSequence,omitempty"`
+	InformationSequenceElement []*common.Element `json:"_informationSequence,omitempty"`
 
 	// Where the product or service was provided
 	LocationCodeableConcept *common.CodeableConcept `json:"locationCodeableConcept,omitempty"`
@@ -308,11 +331,13 @@ type ExplanationOfBenefitItem struct {
 	Revenue *common.CodeableConcept `json:"revenue,omitempty"`
 
 	// A number to uniquely identify item entries
-	Sequence int `json:"sequence"`
+	Sequence        int             `json:"sequence"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// Date or dates of service or product delivery
-	ServicedDate   *string        `json:"servicedDate,omitempty"`
-	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period  `json:"servicedPeriod,omitempty"`
 
 	// Anatomical sub-location
 	SubSite []common.CodeableConcept `json:"subSite,omitempty"`
@@ -353,7 +378,8 @@ type ExplanationOfBenefit struct {
 	ClaimResponse *common.Reference `json:"claimResponse,omitempty"`
 
 	// The date this resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// The element is used to indicate the current state of the adjudication
 	Decision *common.CodeableConcept `json:"decision,omitempty"`
@@ -365,7 +391,8 @@ type ExplanationOfBenefit struct {
 	DiagnosisRelatedGroup *common.CodeableConcept `json:"diagnosisRelatedGroup,omitempty"`
 
 	// A human readable description of the status of the adjudication
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// Healthcare encounters related to this claim
 	Encounter []common.Reference `json:"encounter,omitempty"`
@@ -407,7 +434,8 @@ type ExplanationOfBenefit struct {
 	OriginalPrescription *common.Reference `json:"originalPrescription,omitempty"`
 
 	// The resource may be used to indicate that the Claim has been received
-	Outcome ExplanationOfBenefitOutcome `json:"outcome"`
+	Outcome        ExplanationOfBenefitOutcome `json:"outcome"`
+	OutcomeElement *common.Element             `json:"_outcome,omitempty"`
 
 	// The party to whom the professional services have been supplied
 	Patient common.Reference `json:"patient"`
@@ -422,13 +450,15 @@ type ExplanationOfBenefit struct {
 	Payment *ExplanationOfBenefitPayment `json:"payment,omitempty"`
 
 	// This value is only present on preauthorization adjudications
-	PreAuthRef []string `json:"preAuthRef,omitempty"`
+	PreAuthRef        []string          `json:"preAuthRef,omitempty"`
+	PreAuthRefElement []*common.Element `json:"_preAuthRef,omitempty"`
 
 	// This value is only present on preauthorization adjudications
 	PreAuthRefPeriod []common.Period `json:"preAuthRefPeriod,omitempty"`
 
 	// This indicates the relative order of a series of EOBs
-	Precedence *int `json:"precedence,omitempty"`
+	Precedence        *int            `json:"precedence,omitempty"`
+	PrecedenceElement *common.Element `json:"_precedence,omitempty"`
 
 	// Prescription is the document/authorization given to the claim author
 	Prescription *common.Reference `json:"prescription,omitempty"`
@@ -452,7 +482,8 @@ type ExplanationOfBenefit struct {
 	Related []ExplanationOfBenefitRelated `json:"related,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes
-	Status ExplanationOfBenefitStatus `json:"status"`
+	Status        ExplanationOfBenefitStatus `json:"status"`
+	StatusElement *common.Element            `json:"_status,omitempty"`
 
 	// This may contain the local bill type codes such as the US UB-04 bill type code
 	SubType *common.CodeableConcept `json:"subType,omitempty"`
@@ -470,5 +501,6 @@ type ExplanationOfBenefit struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// A code to indicate whether the nature of the request is
-	Use ExplanationOfBenefitUse `json:"use"`
+	Use        ExplanationOfBenefitUse `json:"use"`
+	UseElement *common.Element         `json:"_use,omitempty"`
 }
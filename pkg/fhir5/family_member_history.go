@@ -34,16 +34,18 @@ type FamilyMemberHistoryCondition struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// This condition contributed to the cause of death of the related person
-	ContributedToDeath *bool `json:"contributedToDeath,omitempty"`
+	ContributedToDeath        *bool           `json:"contributedToDeath,omitempty"`
+	ContributedToDeathElement *common.Element `json:"_contributedToDeath,omitempty"`
 
 	// An area where general notes can be placed about this specific condition
 	Note []Annotation `json:"note,omitempty"`
 
 	// Either the age of onset, range of approximate age or descriptive string can be recorded
-	OnsetAge    *common.Age    `json:"onsetAge,omitempty"`
-	OnsetRange  *Range         `json:"onsetRange,omitempty"`
-	OnsetPeriod *common.Period `json:"onsetPeriod,omitempty"`
-	OnsetString *string        `json:"onsetString,omitempty"`
+	OnsetAge           *common.Age     `json:"onsetAge,omitempty"`
+	OnsetRange         *Range          `json:"onsetRange,omitempty"`
+	OnsetPeriod        *common.Period  `json:"onsetPeriod,omitempty"`
+	OnsetString        *string         `json:"onsetString,omitempty"`
+	OnsetStringElement *common.Element `json:"_onsetString,omitempty"`
 
 	// Indicates what happened following the condition
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
@@ -57,7 +59,8 @@ type FamilyMemberHistoryProcedure struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// This procedure contributed to the cause of death of the related person
-	ContributedToDeath *bool `json:"contributedToDeath,omitempty"`
+	ContributedToDeath        *bool           `json:"contributedToDeath,omitempty"`
+	ContributedToDeathElement *common.Element `json:"_contributedToDeath,omitempty"`
 
 	// An area where general notes can be placed about this specific procedure
 	Note []Annotation `json:"note,omitempty"`
@@ -66,10 +69,11 @@ type FamilyMemberHistoryProcedure struct {
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
 
 	// Estimated or actual date, date-time, period, or age when the procedure was performed
-	PerformedAge    *common.Age    `json:"performedAge,omitempty"`
-	PerformedRange  *Range         `json:"performedRange,omitempty"`
-	PerformedPeriod *common.Period `json:"performedPeriod,omitempty"`
-	PerformedString *string        `json:"performedString,omitempty"`
+	PerformedAge           *common.Age     `json:"performedAge,omitempty"`
+	PerformedRange         *Range          `json:"performedRange,omitempty"`
+	PerformedPeriod        *common.Period  `json:"performedPeriod,omitempty"`
+	PerformedString        *string         `json:"performedString,omitempty"`
+	PerformedStringElement *common.Element `json:"_performedString,omitempty"`
 }
 
 // FamilyMemberHistory represents significant health conditions for a person related to the patient
@@ -80,14 +84,17 @@ type FamilyMemberHistory struct {
 	ResourceType string `json:"resourceType"` // Always "FamilyMemberHistory"
 
 	// Use estimatedAge to indicate whether the age is actual or not
-	AgeAge    *common.Age `json:"ageAge,omitempty"`
-	AgeRange  *Range      `json:"ageRange,omitempty"`
-	AgeString *string     `json:"ageString,omitempty"`
+	AgeAge           *common.Age     `json:"ageAge,omitempty"`
+	AgeRange         *Range          `json:"ageRange,omitempty"`
+	AgeString        *string         `json:"ageString,omitempty"`
+	AgeStringElement *common.Element `json:"_ageString,omitempty"`
 
 	// The actual or approximate date of birth of the relative
-	BornPeriod *common.Period `json:"bornPeriod,omitempty"`
-	BornDate   *string        `json:"bornDate,omitempty"`
-	BornString *string        `json:"bornString,omitempty"`
+	BornPeriod        *common.Period  `json:"bornPeriod,omitempty"`
+	BornDate          *common.Date    `json:"bornDate,omitempty"`
+	BornDateElement   *common.Element `json:"_bornDate,omitempty"`
+	BornString        *string         `json:"bornString,omitempty"`
+	BornStringElement *common.Element `json:"_bornString,omitempty"`
 
 	// The significant conditions that the family member had
 	Condition []FamilyMemberHistoryCondition `json:"condition,omitempty"`
@@ -96,29 +103,37 @@ type FamilyMemberHistory struct {
 	DataAbsentReason *common.CodeableConcept `json:"dataAbsentReason,omitempty"`
 
 	// This should be captured even if the same as the date on the List aggregating the full family history
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Deceased flag or the actual or approximate age of the relative at the time of death
-	DeceasedBoolean *bool       `json:"deceasedBoolean,omitempty"`
-	DeceasedAge     *common.Age `json:"deceasedAge,omitempty"`
-	DeceasedRange   *Range      `json:"deceasedRange,omitempty"`
-	DeceasedDate    *string     `json:"deceasedDate,omitempty"`
-	DeceasedString  *string     `json:"deceasedString,omitempty"`
+	DeceasedBoolean        *bool           `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement *common.Element `json:"_deceasedBoolean,omitempty"`
+	DeceasedAge            *common.Age     `json:"deceasedAge,omitempty"`
+	DeceasedRange          *Range          `json:"deceasedRange,omitempty"`
+	DeceasedDate           *common.Date    `json:"deceasedDate,omitempty"`
+	DeceasedDateElement    *common.Element `json:"_deceasedDate,omitempty"`
+	DeceasedString         *string         `json:"deceasedString,omitempty"`
+	DeceasedStringElement  *common.Element `json:"_deceasedString,omitempty"`
 
 	// This element is labeled as a modifier because the fact that age is estimated can/should change the results
-	EstimatedAge *bool `json:"estimatedAge,omitempty"`
+	EstimatedAge        *bool           `json:"estimatedAge,omitempty"`
+	EstimatedAgeElement *common.Element `json:"_estimatedAge,omitempty"`
 
 	// This is a business identifier, not a resource identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, orderset or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// This might be an HTML page, PDF, etc. or could just be a non-resolvable URI identifier
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// This will either be a name or a description; e.g. "Aunt Susan", "my cousin with the red hair"
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// This property allows a non condition-specific note to be made about the related person
 	Note []Annotation `json:"note,omitempty"`
@@ -142,5 +157,6 @@ type FamilyMemberHistory struct {
 	Sex *common.CodeableConcept `json:"sex,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status FamilyMemberHistoryStatus `json:"status"`
+	Status        FamilyMemberHistoryStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 }
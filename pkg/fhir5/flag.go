@@ -40,7 +40,8 @@ type Flag struct {
 	Period *common.Period `json:"period,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status FlagStatus `json:"status"`
+	Status        FlagStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The patient, related person, location, group, organization, or practitioner this flag is associated with
 	Subject common.Reference `json:"subject"`
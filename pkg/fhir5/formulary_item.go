@@ -28,5 +28,6 @@ type FormularyItem struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This status is intended to identify if the formulary item in a local system is in active use within the formulary
-	Status *FormularyItemStatus `json:"status,omitempty"`
+	Status        *FormularyItemStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 }
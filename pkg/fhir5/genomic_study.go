@@ -73,7 +73,8 @@ type GenomicStudyAnalysis struct {
 	ChangeType []common.CodeableConcept `json:"changeType,omitempty"`
 
 	// The date of the analysis event
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Devices used for the analysis
 	Device []GenomicStudyAnalysisDevice `json:"device,omitempty"`
@@ -91,10 +92,12 @@ type GenomicStudyAnalysis struct {
 	Input []GenomicStudyAnalysisInput `json:"input,omitempty"`
 
 	// The defined protocol that describes the analysis
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained protocol
-	InstantiatesUri *string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Type of the methods used in the analysis
 	MethodType []common.CodeableConcept `json:"methodType,omitempty"`
@@ -121,7 +124,8 @@ type GenomicStudyAnalysis struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// Name of the analysis event (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // GenomicStudy represents a set of analyses performed to analyze and generate genomic data
@@ -138,7 +142,8 @@ type GenomicStudy struct {
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
 
 	// Description of the genomic study
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The healthcare event with which this genomics study is associated
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -147,10 +152,12 @@ type GenomicStudy struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The defined protocol that describes the study
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained protocol
-	InstantiatesUri *string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Healthcare professionals who interpreted the genomic study
 	Interpreter []common.Reference `json:"interpreter,omitempty"`
@@ -165,10 +172,12 @@ type GenomicStudy struct {
 	Referrer *common.Reference `json:"referrer,omitempty"`
 
 	// When the genomic study was started
-	StartDate *string `json:"startDate,omitempty"`
+	StartDate        *common.DateTime `json:"startDate,omitempty"`
+	StartDateElement *common.Element  `json:"_startDate,omitempty"`
 
 	// The status of the genomic study
-	Status GenomicStudyStatus `json:"status"`
+	Status        GenomicStudyStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// The primary subject of the genomic study
 	Subject common.Reference `json:"subject"`
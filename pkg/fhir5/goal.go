@@ -29,13 +29,17 @@ type GoalTarget struct {
 	DetailRange           *Range                  `json:"detailRange,omitempty"`
 	DetailCodeableConcept *common.CodeableConcept `json:"detailCodeableConcept,omitempty"`
 	DetailString          *string                 `json:"detailString,omitempty"`
+	DetailStringElement   *common.Element         `json:"_detailString,omitempty"`
 	DetailBoolean         *bool                   `json:"detailBoolean,omitempty"`
+	DetailBooleanElement  *common.Element         `json:"_detailBoolean,omitempty"`
 	DetailInteger         *int                    `json:"detailInteger,omitempty"`
+	DetailIntegerElement  *common.Element         `json:"_detailInteger,omitempty"`
 	DetailRatio           *Ratio                  `json:"detailRatio,omitempty"`
 
 	// Indicates either the date or the duration after start by which the goal should be met
-	DueDate     *string   `json:"dueDate,omitempty"`
-	DueDuration *Duration `json:"dueDuration,omitempty"`
+	DueDate        *common.Date    `json:"dueDate,omitempty"`
+	DueDateElement *common.Element `json:"_dueDate,omitempty"`
+	DueDuration    *Duration       `json:"dueDuration,omitempty"`
 
 	// The parameter whose value is being tracked, e.g. body weight, blood pressure, or hemoglobin A1c level
 	Measure *common.CodeableConcept `json:"measure,omitempty"`
@@ -58,7 +62,8 @@ type Goal struct {
 	Category []common.CodeableConcept `json:"category,omitempty"`
 
 	// For example, getting a yellow fever vaccination for a planned trip is a goal that is designed to be completed
-	Continuous *bool `json:"continuous,omitempty"`
+	Continuous        *bool           `json:"continuous,omitempty"`
+	ContinuousElement *common.Element `json:"_continuous,omitempty"`
 
 	// If no code is available, use CodeableConcept.text
 	Description common.CodeableConcept `json:"description"`
@@ -67,7 +72,8 @@ type Goal struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This element is labeled as a modifier because the lifecycleStatus contains codes that mark the resource as not currently valid
-	LifecycleStatus GoalLifecycleStatus `json:"lifecycleStatus"`
+	LifecycleStatus        GoalLifecycleStatus `json:"lifecycleStatus"`
+	LifecycleStatusElement *common.Element     `json:"_lifecycleStatus,omitempty"`
 
 	// May be used for progress notes, concerns or other related information that doesn't actually describe the goal itself
 	Note []Annotation `json:"note,omitempty"`
@@ -82,14 +88,17 @@ type Goal struct {
 	Source *common.Reference `json:"source,omitempty"`
 
 	// The date or event after which the goal should begin being pursued
-	StartDate            *string                 `json:"startDate,omitempty"`
+	StartDate            *common.Date            `json:"startDate,omitempty"`
+	StartDateElement     *common.Element         `json:"_startDate,omitempty"`
 	StartCodeableConcept *common.CodeableConcept `json:"startCodeableConcept,omitempty"`
 
 	// To see the date for past statuses, query history
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.Date    `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element `json:"_statusDate,omitempty"`
 
 	// This will typically be captured for statuses such as rejected, on-hold or cancelled
-	StatusReason *string `json:"statusReason,omitempty"`
+	StatusReason        *string         `json:"statusReason,omitempty"`
+	StatusReasonElement *common.Element `json:"_statusReason,omitempty"`
 
 	// Identifies the patient, group or organization for whom the goal is being established
 	Subject common.Reference `json:"subject"`
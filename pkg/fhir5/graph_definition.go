@@ -20,16 +20,20 @@ type GraphDefinitionNode struct {
 	common.BackboneElement
 
 	// Information about why this node is of interest in this graph definition
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Internal ID of node - target for link references
-	NodeId string `json:"nodeId"`
+	NodeId        string          `json:"nodeId"`
+	NodeIdElement *common.Element `json:"_nodeId,omitempty"`
 
 	// Profile for the target resource
-	Profile *string `json:"profile,omitempty"`
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// Type of resource this link refers to
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // GraphDefinitionLinkCompartmentRule represents the compartment rule
@@ -50,13 +54,16 @@ type GraphDefinitionLinkCompartment struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// Describes how the compartment rule is used
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Identifies the compartment
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// identical | matching | different | no-rule
-	Rule GraphDefinitionLinkCompartmentRule `json:"rule"`
+	Rule        GraphDefinitionLinkCompartmentRule `json:"rule"`
+	RuleElement *common.Element                    `json:"_rule,omitempty"`
 
 	// Custom rule, as a FHIRPath expression
 	Use common.CodeableConcept `json:"use"`
@@ -70,28 +77,36 @@ type GraphDefinitionLink struct {
 	Compartment []GraphDefinitionLinkCompartment `json:"compartment,omitempty"`
 
 	// Information about why this link is of interest in this graph definition
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Maximum occurrences for this link
-	Max *string `json:"max,omitempty"`
+	Max        *string         `json:"max,omitempty"`
+	MaxElement *common.Element `json:"_max,omitempty"`
 
 	// Minimum occurrences for this link
-	Min *int `json:"min,omitempty"`
+	Min        *int            `json:"min,omitempty"`
+	MinElement *common.Element `json:"_min,omitempty"`
 
 	// At least one of the parameters must have the value {ref} which identifies the focus resource
-	Params *string `json:"params,omitempty"`
+	Params        *string         `json:"params,omitempty"`
+	ParamsElement *common.Element `json:"_params,omitempty"`
 
 	// The path expression cannot contain a resolve() function
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 
 	// Which slice (if profiled)
-	SliceName *string `json:"sliceName,omitempty"`
+	SliceName        *string         `json:"sliceName,omitempty"`
+	SliceNameElement *common.Element `json:"_sliceName,omitempty"`
 
 	// The source node for this link
-	SourceId string `json:"sourceId"`
+	SourceId        string          `json:"sourceId"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
 
 	// The target node for this link
-	TargetId string `json:"targetId"`
+	TargetId        string          `json:"targetId"`
+	TargetIdElement *common.Element `json:"_targetId,omitempty"`
 }
 
 // GraphDefinition represents a formal computable definition of a graph of resources
@@ -105,19 +120,24 @@ type GraphDefinition struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Copyright statement
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright label
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of graph definitions that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// A formal identifier that is used to identify this GraphDefinition
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -129,35 +149,44 @@ type GraphDefinition struct {
 	Link []GraphDefinitionLink `json:"link,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Potential target for the link
 	Node []GraphDefinitionNode `json:"node,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the graph definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The Node at which instances of this graph start
-	Start *string `json:"start,omitempty"`
+	Start        *string         `json:"start,omitempty"`
+	StartElement *common.Element `json:"_start,omitempty"`
 
 	// Allows filtering of graph definitions that are appropriate for use versus not
-	Status GraphDefinitionStatus `json:"status"`
+	Status        GraphDefinitionStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different graph definition instances that have the same identifier
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// Version algorithm
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 }
@@ -108,7 +108,8 @@ type GroupCharacteristic struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// This is labeled as "Is Modifier" because applications cannot wrongly include excluded members
-	Exclude bool `json:"exclude"`
+	Exclude        bool            `json:"exclude"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// The period over which the characteristic is tested
 	Period *common.Period `json:"period,omitempty"`
@@ -116,6 +117,7 @@ type GroupCharacteristic struct {
 	// For Range, it means members of the group have a value that falls somewhere within the specified range
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
@@ -129,7 +131,8 @@ type GroupMember struct {
 	Entity common.Reference `json:"entity"`
 
 	// A flag to indicate that the member is no longer in the group
-	Inactive *bool `json:"inactive,omitempty"`
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
 
 	// The period that the member was in the group, if known
 	Period *common.Period `json:"period,omitempty"`
@@ -143,7 +146,8 @@ type Group struct {
 	ResourceType string `json:"resourceType"` // Always "Group"
 
 	// Indicates whether the record for the group is available for use or is merely being retained for historical purposes
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// All the identified characteristics must be true for an entity to a member of the group
 	Characteristic []GroupCharacteristic `json:"characteristic,omitempty"`
@@ -152,7 +156,8 @@ type Group struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Explanation of what the group represents and how it is intended to be used
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This is a business identifier, not a resource identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -164,14 +169,18 @@ type Group struct {
 	Member []GroupMember `json:"member,omitempty"`
 
 	// Basis for membership in the Group
-	Membership GroupMembership `json:"membership"`
+	Membership        GroupMembership `json:"membership"`
+	MembershipElement *common.Element `json:"_membership,omitempty"`
 
 	// A label assigned to the group for human identification and communication
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Note that the quantity may be less than the number of members if some of the members are not active
-	Quantity *int `json:"quantity,omitempty"`
+	Quantity        *int            `json:"quantity,omitempty"`
+	QuantityElement *common.Element `json:"_quantity,omitempty"`
 
 	// Group members SHALL be of the appropriate resource type
-	Type GroupType `json:"type"`
+	Type        GroupType       `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
@@ -24,7 +24,7 @@ type GuidanceResponse struct {
 	ResourceType string `json:"resourceType"` // Always "GuidanceResponse"
 
 	// If the evaluation could not be completed due to lack of information
-	DataRequirement []interface{} `json:"dataRequirement,omitempty"`
+	DataRequirement []DataRequirement `json:"dataRequirement,omitempty"`
 
 	// This will typically be the encounter the event occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -36,15 +36,18 @@ type GuidanceResponse struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// An identifier, CodeableConcept or canonical reference to the guidance that was requested
-	ModuleUri             *string                 `json:"moduleUri,omitempty"`
-	ModuleCanonical       *string                 `json:"moduleCanonical,omitempty"`
-	ModuleCodeableConcept *common.CodeableConcept `json:"moduleCodeableConcept,omitempty"`
+	ModuleUri              *string                 `json:"moduleUri,omitempty"`
+	ModuleUriElement       *common.Element         `json:"_moduleUri,omitempty"`
+	ModuleCanonical        *string                 `json:"moduleCanonical,omitempty"`
+	ModuleCanonicalElement *common.Element         `json:"_moduleCanonical,omitempty"`
+	ModuleCodeableConcept  *common.CodeableConcept `json:"moduleCodeableConcept,omitempty"`
 
 	// Provides a mechanism to communicate additional information about the response
 	Note []Annotation `json:"note,omitempty"`
 
 	// Indicates when the guidance response was processed
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
 
 	// The output parameters of the evaluation, if any
 	OutputParameters *common.Reference `json:"outputParameters,omitempty"`
@@ -62,7 +65,8 @@ type GuidanceResponse struct {
 	Result []common.Reference `json:"result,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status GuidanceResponseStatus `json:"status"`
+	Status        GuidanceResponseStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// The patient for which the request was processed
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -12,7 +12,8 @@ type HealthcareServiceEligibility struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The description of service eligibility
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 }
 
 // HealthcareService represents the details of a healthcare service available at a location
@@ -23,10 +24,12 @@ type HealthcareService struct {
 	ResourceType string `json:"resourceType"` // Always "HealthcareService"
 
 	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Indicates whether or not a prospective consumer will require an appointment for a particular service
-	AppointmentRequired *bool `json:"appointmentRequired,omitempty"`
+	AppointmentRequired        *bool           `json:"appointmentRequired,omitempty"`
+	AppointmentRequiredElement *common.Element `json:"_appointmentRequired,omitempty"`
 
 	// More detailed availability information may be provided in associated Schedule/Slot resources
 	Availability []Availability `json:"availability,omitempty"`
@@ -38,7 +41,8 @@ type HealthcareService struct {
 	Characteristic []common.CodeableConcept `json:"characteristic,omitempty"`
 
 	// Would expect that a user would not see this information on a search results
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// When using this property it indicates that the service is available with this language
 	Communication []common.CodeableConcept `json:"communication,omitempty"`
@@ -56,7 +60,8 @@ type HealthcareService struct {
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
 
 	// Extra details about the service that can't be placed in the other fields
-	ExtraDetails *string `json:"extraDetails,omitempty"`
+	ExtraDetails        *string         `json:"extraDetails,omitempty"`
+	ExtraDetailsElement *common.Element `json:"_extraDetails,omitempty"`
 
 	// External identifiers for this item
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -65,7 +70,8 @@ type HealthcareService struct {
 	Location []common.Reference `json:"location,omitempty"`
 
 	// Further description of the service as it would be presented to a consumer while searching
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// For example, if there is a generic Radiology service that offers CT Scans, MRIs, etc
 	OfferedIn []common.Reference `json:"offeredIn,omitempty"`
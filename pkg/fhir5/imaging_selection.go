@@ -52,10 +52,12 @@ type ImagingSelectionInstanceImageRegion2D struct {
 	common.BackboneElement
 
 	// For a description of how 2D coordinates are encoded
-	Coordinate []float64 `json:"coordinate"`
+	Coordinate        []common.Decimal  `json:"coordinate"`
+	CoordinateElement []*common.Element `json:"_coordinate,omitempty"`
 
 	// See DICOM PS3.3 C.10.5.1.2
-	RegionType ImagingSelectionInstanceImageRegion2DType `json:"regionType"`
+	RegionType        ImagingSelectionInstanceImageRegion2DType `json:"regionType"`
+	RegionTypeElement *common.Element                           `json:"_regionType,omitempty"`
 }
 
 // ImagingSelectionInstanceImageRegion3D represents each imaging selection 3D image region
@@ -63,10 +65,12 @@ type ImagingSelectionInstanceImageRegion3D struct {
 	common.BackboneElement
 
 	// For a description of how 3D coordinates are encoded
-	Coordinate []float64 `json:"coordinate"`
+	Coordinate        []common.Decimal  `json:"coordinate"`
+	CoordinateElement []*common.Element `json:"_coordinate,omitempty"`
 
 	// See DICOM PS3.3 C.18.9.1.2
-	RegionType ImagingSelectionInstanceImageRegion3DType `json:"regionType"`
+	RegionType        ImagingSelectionInstanceImageRegion3DType `json:"regionType"`
+	RegionTypeElement *common.Element                           `json:"_regionType,omitempty"`
 }
 
 // ImagingSelectionInstance represents each imaging selection instance or frame list
@@ -80,16 +84,19 @@ type ImagingSelectionInstance struct {
 	ImageRegion3D []ImagingSelectionInstanceImageRegion3D `json:"imageRegion3D,omitempty"`
 
 	// Note: A multiframe instance has a single instance number
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// See DICOM PS3.3 C.12.1
 	SopClass *common.Coding `json:"sopClass,omitempty"`
 
 	// Selected subset of the SOP Instance
-	Subset []string `json:"subset,omitempty"`
+	Subset        []string          `json:"subset,omitempty"`
+	SubsetElement []*common.Element `json:"_subset,omitempty"`
 
 	// See DICOM PS3.3 C.12.1
-	Uid string `json:"uid"`
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
 }
 
 // ImagingSelection represents a selection of DICOM SOP instances and/or frames within a single Study and Series
@@ -121,7 +128,8 @@ type ImagingSelection struct {
 	Focus []common.Reference `json:"focus,omitempty"`
 
 	// See DICOM PS3.3 C.7.4.1
-	FrameOfReferenceUid *string `json:"frameOfReferenceUid,omitempty"`
+	FrameOfReferenceUid        *string         `json:"frameOfReferenceUid,omitempty"`
+	FrameOfReferenceUidElement *common.Element `json:"_frameOfReferenceUid,omitempty"`
 
 	// This is a business identifier, not a resource identifier
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -130,22 +138,27 @@ type ImagingSelection struct {
 	Instance []ImagingSelectionInstance `json:"instance,omitempty"`
 
 	// The date and time this imaging selection was created
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// Selector of the instances – human or machine
 	Performer []ImagingSelectionPerformer `json:"performer,omitempty"`
 
 	// See DICOM PS3.3 C.7.3
-	SeriesNumber *int `json:"seriesNumber,omitempty"`
+	SeriesNumber        *int            `json:"seriesNumber,omitempty"`
+	SeriesNumberElement *common.Element `json:"_seriesNumber,omitempty"`
 
 	// See DICOM PS3.3 C.7.3
-	SeriesUid *string `json:"seriesUid,omitempty"`
+	SeriesUid        *string         `json:"seriesUid,omitempty"`
+	SeriesUidElement *common.Element `json:"_seriesUid,omitempty"`
 
 	// Unknown does not represent "other" - one of the defined statuses must apply
-	Status ImagingSelectionStatus `json:"status"`
+	Status        ImagingSelectionStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 
 	// See DICOM PS3.3 C.7.2
-	StudyUid *string `json:"studyUid,omitempty"`
+	StudyUid        *string         `json:"studyUid,omitempty"`
+	StudyUidElement *common.Element `json:"_studyUid,omitempty"`
 
 	// The patient, or group of patients, location, device, organization, procedure or practitioner
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -31,16 +31,19 @@ type ImagingStudySeriesInstance struct {
 	common.BackboneElement
 
 	// The number of instance in the series
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// DICOM instance type
 	SopClass common.Coding `json:"sopClass"`
 
 	// Particularly for post-acquisition analytic objects
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// See DICOM PS3.3 C.12.1
-	Uid string `json:"uid"`
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
 }
 
 // ImagingStudySeries represents each study has one or more series of images or other content
@@ -51,7 +54,8 @@ type ImagingStudySeries struct {
 	BodySite *CodeableReference `json:"bodySite,omitempty"`
 
 	// A description of the series
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Typical endpoint types include DICOM WADO-RS
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
@@ -66,10 +70,12 @@ type ImagingStudySeries struct {
 	Modality common.CodeableConcept `json:"modality"`
 
 	// The numeric identifier of this series in the study
-	Number *int `json:"number,omitempty"`
+	Number        *int            `json:"number,omitempty"`
+	NumberElement *common.Element `json:"_number,omitempty"`
 
 	// Number of SOP Instances in the Study
-	NumberOfInstances *int `json:"numberOfInstances,omitempty"`
+	NumberOfInstances        *int            `json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
 
 	// If the person who performed the series is not known, their Organization may be recorded
 	Performer []ImagingStudySeriesPerformer `json:"performer,omitempty"`
@@ -78,10 +84,12 @@ type ImagingStudySeries struct {
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// The date and time the series was started
-	Started *string `json:"started,omitempty"`
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
 
 	// See DICOM PS3.3 C.7.3
-	Uid string `json:"uid"`
+	Uid        string          `json:"uid"`
+	UidElement *common.Element `json:"_uid,omitempty"`
 }
 
 // ImagingStudy represents the content produced in a DICOM imaging study
@@ -95,7 +103,8 @@ type ImagingStudy struct {
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
 
 	// The Imaging Manager description of the study
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// This will typically be the encounter the event occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -116,10 +125,12 @@ type ImagingStudy struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// Number of SOP Instances in Study
-	NumberOfInstances *int `json:"numberOfInstances,omitempty"`
+	NumberOfInstances        *int            `json:"numberOfInstances,omitempty"`
+	NumberOfInstancesElement *common.Element `json:"_numberOfInstances,omitempty"`
 
 	// Number of Series in the Study
-	NumberOfSeries *int `json:"numberOfSeries,omitempty"`
+	NumberOfSeries        *int            `json:"numberOfSeries,omitempty"`
+	NumberOfSeriesElement *common.Element `json:"_numberOfSeries,omitempty"`
 
 	// To link an ImagingStudy to an Encounter use encounter
 	PartOf []common.Reference `json:"partOf,omitempty"`
@@ -137,10 +148,12 @@ type ImagingStudy struct {
 	Series []ImagingStudySeries `json:"series,omitempty"`
 
 	// Date and time the study started
-	Started *string `json:"started,omitempty"`
+	Started        *common.DateTime `json:"started,omitempty"`
+	StartedElement *common.Element  `json:"_started,omitempty"`
 
 	// Unknown does not represent "other" - one of the defined statuses must apply
-	Status ImagingStudyStatus `json:"status"`
+	Status        ImagingStudyStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// QA phantoms can be recorded with a Device; multiple subjects can be recorded with a Group
 	Subject common.Reference `json:"subject"`
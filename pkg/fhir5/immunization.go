@@ -40,13 +40,15 @@ type ImmunizationReaction struct {
 	common.BackboneElement
 
 	// Date of reaction to the immunization
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Details of the reaction
 	Manifestation *CodeableReference `json:"manifestation,omitempty"`
 
 	// Self-reported indicator
-	Reported *bool `json:"reported,omitempty"`
+	Reported        *bool           `json:"reported,omitempty"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
 }
 
 // ImmunizationProtocolApplied represents the protocol being followed by the provider
@@ -57,13 +59,16 @@ type ImmunizationProtocolApplied struct {
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumber string `json:"doseNumber"`
+	DoseNumber        string          `json:"doseNumber"`
+	DoseNumberElement *common.Element `json:"_doseNumber,omitempty"`
 
 	// One possible path to achieve presumed immunity against a disease
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDoses *string `json:"seriesDoses,omitempty"`
+	SeriesDoses        *string         `json:"seriesDoses,omitempty"`
+	SeriesDosesElement *common.Element `json:"_seriesDoses,omitempty"`
 
 	// The vaccine preventable disease the dose is being administered against
 	TargetDisease []common.CodeableConcept `json:"targetDisease,omitempty"`
@@ -89,7 +94,8 @@ type Immunization struct {
 	Encounter *common.Reference `json:"encounter,omitempty"`
 
 	// Date vaccine batch expires
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.Date    `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element `json:"_expirationDate,omitempty"`
 
 	// Indicates the source of the vaccine actually administered
 	FundingSource *common.CodeableConcept `json:"fundingSource,omitempty"`
@@ -101,13 +107,15 @@ type Immunization struct {
 	InformationSource *CodeableReference `json:"informationSource,omitempty"`
 
 	// Typically, the recognition of the dose being sub-potent is retrospective
-	IsSubpotent *bool `json:"isSubpotent,omitempty"`
+	IsSubpotent        *bool           `json:"isSubpotent,omitempty"`
+	IsSubpotentElement *common.Element `json:"_isSubpotent,omitempty"`
 
 	// The service delivery location where the vaccine administration occurred
 	Location *common.Reference `json:"location,omitempty"`
 
 	// Lot number of the vaccine product
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// Name of vaccine manufacturer
 	Manufacturer *CodeableReference `json:"manufacturer,omitempty"`
@@ -116,8 +124,10 @@ type Immunization struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// When immunizations are given a specific date and time should always be known
-	OccurrenceDateTime *string `json:"occurrenceDateTime,omitempty"`
-	OccurrenceString   *string `json:"occurrenceString,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrenceString          *string          `json:"occurrenceString,omitempty"`
+	OccurrenceStringElement   *common.Element  `json:"_occurrenceString,omitempty"`
 
 	// The patient who either received or did not receive the immunization
 	Patient common.Reference `json:"patient"`
@@ -126,7 +136,8 @@ type Immunization struct {
 	Performer []ImmunizationPerformer `json:"performer,omitempty"`
 
 	// Reflects the "reliability" of the content
-	PrimarySource *bool `json:"primarySource,omitempty"`
+	PrimarySource        *bool           `json:"primarySource,omitempty"`
+	PrimarySourceElement *common.Element `json:"_primarySource,omitempty"`
 
 	// Indicates a patient's eligibility for a funding program
 	ProgramEligibility []ImmunizationProgramEligibility `json:"programEligibility,omitempty"`
@@ -147,7 +158,8 @@ type Immunization struct {
 	Site *common.CodeableConcept `json:"site,omitempty"`
 
 	// Will generally be set to show that the immunization has been completed or not done
-	Status ImmunizationStatus `json:"status"`
+	Status        ImmunizationStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// This is generally only used for the status of "not-done"
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
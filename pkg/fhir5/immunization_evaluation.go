@@ -24,13 +24,16 @@ type ImmunizationEvaluation struct {
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// The date the evaluation of the vaccine administration event was performed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Additional information about the evaluation
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumber *string `json:"doseNumber,omitempty"`
+	DoseNumber        *string         `json:"doseNumber,omitempty"`
+	DoseNumberElement *common.Element `json:"_doseNumber,omitempty"`
 
 	// Indicates if the dose is valid or not valid with respect to the published recommendations
 	DoseStatus common.CodeableConcept `json:"doseStatus"`
@@ -48,13 +51,16 @@ type ImmunizationEvaluation struct {
 	Patient common.Reference `json:"patient"`
 
 	// One possible path to achieve presumed immunity against a disease
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDoses *string `json:"seriesDoses,omitempty"`
+	SeriesDoses        *string         `json:"seriesDoses,omitempty"`
+	SeriesDosesElement *common.Element `json:"_seriesDoses,omitempty"`
 
 	// Indicates the current status of the evaluation
-	Status ImmunizationEvaluationStatus `json:"status"`
+	Status        ImmunizationEvaluationStatus `json:"status"`
+	StatusElement *common.Element              `json:"_status,omitempty"`
 
 	// The vaccine preventable disease the dose is being evaluated against
 	TargetDisease common.CodeableConcept `json:"targetDisease"`
@@ -12,7 +12,8 @@ type ImmunizationRecommendationRecommendationDateCriterion struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// The date whose meaning is specified by dateCriterion.code
-	Value string `json:"value"`
+	Value        common.DateTime `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ImmunizationRecommendationRecommendation represents a single recommended administration
@@ -26,10 +27,12 @@ type ImmunizationRecommendationRecommendation struct {
 	DateCriterion []ImmunizationRecommendationRecommendationDateCriterion `json:"dateCriterion,omitempty"`
 
 	// Contains the description about the protocol under which the vaccine was administered
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The use of an integer is preferred if known
-	DoseNumber *string `json:"doseNumber,omitempty"`
+	DoseNumber        *string         `json:"doseNumber,omitempty"`
+	DoseNumberElement *common.Element `json:"_doseNumber,omitempty"`
 
 	// The reason for the assigned forecast status
 	ForecastReason []common.CodeableConcept `json:"forecastReason,omitempty"`
@@ -38,10 +41,12 @@ type ImmunizationRecommendationRecommendation struct {
 	ForecastStatus common.CodeableConcept `json:"forecastStatus"`
 
 	// One possible path to achieve presumed immunity against a disease
-	Series *string `json:"series,omitempty"`
+	Series        *string         `json:"series,omitempty"`
+	SeriesElement *common.Element `json:"_series,omitempty"`
 
 	// The use of an integer is preferred if known
-	SeriesDoses *string `json:"seriesDoses,omitempty"`
+	SeriesDoses        *string         `json:"seriesDoses,omitempty"`
+	SeriesDosesElement *common.Element `json:"_seriesDoses,omitempty"`
 
 	// Immunization event history and/or evaluation that supports the status and recommendation
 	SupportingImmunization []common.Reference `json:"supportingImmunization,omitempty"`
@@ -67,7 +72,8 @@ type ImmunizationRecommendation struct {
 	Authority *common.Reference `json:"authority,omitempty"`
 
 	// The date the immunization recommendation(s) were created
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// A unique identifier assigned to this particular recommendation record
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -29,16 +29,20 @@ type ImplementationGuideDependsOn struct {
 	common.BackboneElement
 
 	// The NPM package name for the Implementation Guide that this IG depends on
-	PackageId *string `json:"packageId,omitempty"`
+	PackageId        *string         `json:"packageId,omitempty"`
+	PackageIdElement *common.Element `json:"_packageId,omitempty"`
 
 	// This doesn't need to enumerate every resource used, but should give some sense of why the dependency exists
-	Reason *string `json:"reason,omitempty"`
+	Reason        *string         `json:"reason,omitempty"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
 
 	// Usually, A canonical reference to the implementation guide is the same as the master location
-	Uri string `json:"uri"`
+	Uri        string          `json:"uri"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 
 	// This follows the syntax of the NPM packaging version field
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // ImplementationGuideGlobal represents global profiles for resources
@@ -46,10 +50,12 @@ type ImplementationGuideGlobal struct {
 	common.BackboneElement
 
 	// A reference to the profile that all instances must conform to
-	Profile string `json:"profile"`
+	Profile        string          `json:"profile"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 
 	// The type must match that of the profile that is referred to
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // ImplementationGuideDefinitionGrouping represents groupings of content
@@ -57,10 +63,12 @@ type ImplementationGuideDefinitionGrouping struct {
 	common.BackboneElement
 
 	// Human readable text describing the package
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The human-readable title to display for the package of resources
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 }
 
 // ImplementationGuideDefinitionResource represents a resource that is part of the implementation guide
@@ -68,22 +76,28 @@ type ImplementationGuideDefinitionResource struct {
 	common.BackboneElement
 
 	// This is mostly used with examples to explain why it is present
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The resource SHALL be valid against all the versions it is specified to apply to
-	FhirVersion []string `json:"fhirVersion,omitempty"`
+	FhirVersion        []string          `json:"fhirVersion,omitempty"`
+	FhirVersionElement []*common.Element `json:"_fhirVersion,omitempty"`
 
 	// This must correspond to a group.id element within this implementation guide
-	GroupingId *string `json:"groupingId,omitempty"`
+	GroupingId        *string         `json:"groupingId,omitempty"`
+	GroupingIdElement *common.Element `json:"_groupingId,omitempty"`
 
 	// If true, indicates the resource is an example instance
-	IsExample *bool `json:"isExample,omitempty"`
+	IsExample        *bool           `json:"isExample,omitempty"`
+	IsExampleElement *common.Element `json:"_isExample,omitempty"`
 
 	// A human assigned name for the resource
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Examples: StructureDefinition -> Any, ValueSet -> expansion, etc.
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Usually this is a relative URL that locates the resource within the implementation guide
 	Reference common.Reference `json:"reference"`
@@ -94,21 +108,27 @@ type ImplementationGuideDefinitionPage struct {
 	common.BackboneElement
 
 	// A code that indicates how the page is generated
-	Generation ImplementationGuideDefinitionPageGeneration `json:"generation"`
+	Generation        ImplementationGuideDefinitionPageGeneration `json:"generation"`
+	GenerationElement *common.Element                             `json:"_generation,omitempty"`
 
 	// This SHALL be a local reference, expressed with respect to the root of the IG output folder
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Sub-pages (recursive structure)
 	Page []ImplementationGuideDefinitionPage `json:"page,omitempty"`
 
 	// If absent and the page isn't a generated page, this may be inferred from the page name
-	SourceUrl      *string `json:"sourceUrl,omitempty"`
-	SourceString   *string `json:"sourceString,omitempty"`
-	SourceMarkdown *string `json:"sourceMarkdown,omitempty"`
+	SourceUrl             *string         `json:"sourceUrl,omitempty"`
+	SourceUrlElement      *common.Element `json:"_sourceUrl,omitempty"`
+	SourceString          *string         `json:"sourceString,omitempty"`
+	SourceStringElement   *common.Element `json:"_sourceString,omitempty"`
+	SourceMarkdown        *string         `json:"sourceMarkdown,omitempty"`
+	SourceMarkdownElement *common.Element `json:"_sourceMarkdown,omitempty"`
 
 	// A short title used to represent this page in navigational structures
-	Title string `json:"title"`
+	Title        string          `json:"title"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ImplementationGuideDefinitionParameter represents parameters for the implementation guide
@@ -119,7 +139,8 @@ type ImplementationGuideDefinitionParameter struct {
 	Code common.Coding `json:"code"`
 
 	// Value for named type
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ImplementationGuideDefinitionTemplate represents a template for building resources
@@ -127,13 +148,16 @@ type ImplementationGuideDefinitionTemplate struct {
 	common.BackboneElement
 
 	// Type of template specified
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// The scope in which the template applies
-	Scope *string `json:"scope,omitempty"`
+	Scope        *string         `json:"scope,omitempty"`
+	ScopeElement *common.Element `json:"_scope,omitempty"`
 
 	// The source location for the template
-	Source string `json:"source"`
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
 }
 
 // ImplementationGuideDefinition represents the definition of the implementation guide
@@ -161,16 +185,19 @@ type ImplementationGuideManifestResource struct {
 	common.BackboneElement
 
 	// If true, indicates the resource is an example instance
-	IsExample *bool `json:"isExample,omitempty"`
+	IsExample        *bool           `json:"isExample,omitempty"`
+	IsExampleElement *common.Element `json:"_isExample,omitempty"`
 
 	// Examples: StructureDefinition -> Any, ValueSet -> expansion, etc.
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Usually this is a relative URL that locates the resource within the implementation guide
 	Reference common.Reference `json:"reference"`
 
 	// Appending 'rendering' + "/" + this should resolve to the resource page
-	RelativePath *string `json:"relativePath,omitempty"`
+	RelativePath        *string         `json:"relativePath,omitempty"`
+	RelativePathElement *common.Element `json:"_relativePath,omitempty"`
 }
 
 // ImplementationGuideManifestPage represents information about a page within the IG
@@ -178,13 +205,16 @@ type ImplementationGuideManifestPage struct {
 	common.BackboneElement
 
 	// Appending 'rendering' + "/" + page.name + "#" + page.anchor should resolve to the anchor
-	Anchor []string `json:"anchor,omitempty"`
+	Anchor        []string          `json:"anchor,omitempty"`
+	AnchorElement []*common.Element `json:"_anchor,omitempty"`
 
 	// Appending 'rendering' + "/" + this should resolve to the page
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Label for the page intended for human display
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 }
 
 // ImplementationGuideManifest represents information about an assembled implementation guide
@@ -192,16 +222,19 @@ type ImplementationGuideManifest struct {
 	common.BackboneElement
 
 	// Indicates a relative path to an image that exists within the IG
-	Image []string `json:"image,omitempty"`
+	Image        []string          `json:"image,omitempty"`
+	ImageElement []*common.Element `json:"_image,omitempty"`
 
 	// Indicates the relative path of an additional non-page, non-image file that is part of the IG
-	Other []string `json:"other,omitempty"`
+	Other        []string          `json:"other,omitempty"`
+	OtherElement []*common.Element `json:"_other,omitempty"`
 
 	// Information about a page within the IG
 	Page []ImplementationGuideManifestPage `json:"page,omitempty"`
 
 	// A pointer to official web page, PDF or other rendering of the implementation guide
-	Rendering *string `json:"rendering,omitempty"`
+	Rendering        *string         `json:"rendering,omitempty"`
+	RenderingElement *common.Element `json:"_rendering,omitempty"`
 
 	// A resource that is part of the implementation guide
 	Resource []ImplementationGuideManifestResource `json:"resource"`
@@ -218,13 +251,16 @@ type ImplementationGuide struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Use and/or publishing restrictions
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Date last changed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Principally, this consists of information about source resource and file locations
 	Definition *ImplementationGuideDefinition `json:"definition,omitempty"`
@@ -233,13 +269,16 @@ type ImplementationGuide struct {
 	DependsOn []ImplementationGuideDependsOn `json:"dependsOn,omitempty"`
 
 	// Natural language description of the implementation guide
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// FHIR Version(s) this Implementation Guide targets
-	FhirVersion []string `json:"fhirVersion"`
+	FhirVersion        []string          `json:"fhirVersion"`
+	FhirVersionElement []*common.Element `json:"_fhirVersion,omitempty"`
 
 	// See Default Profiles for a discussion of which resources are 'covered' by an implementation guide
 	Global []ImplementationGuideGlobal `json:"global,omitempty"`
@@ -251,39 +290,49 @@ type ImplementationGuide struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The license that applies to this Implementation Guide, using an SPDX license code
-	License *string `json:"license,omitempty"`
+	License        *string         `json:"license,omitempty"`
+	LicenseElement *common.Element `json:"_license,omitempty"`
 
 	// Information about an assembled implementation guide, created by the publication tooling
 	Manifest *ImplementationGuideManifest `json:"manifest,omitempty"`
 
 	// Name for this implementation guide (computer friendly)
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Many (if not all) IG publishing tools will require that this element be present
-	PackageId string `json:"packageId"`
+	PackageId        string          `json:"packageId"`
+	PackageIdElement *common.Element `json:"_packageId,omitempty"`
 
 	// Name of the publisher (organization or individual)
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this implementation guide is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// draft | active | retired | unknown
-	Status ImplementationGuideStatus `json:"status"`
+	Status        ImplementationGuideStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// Name for this implementation guide (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Canonical identifier for this implementation guide, represented as a URI
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// The context that the content is intended to support
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// Business version of the implementation guide
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// How to compare versions
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
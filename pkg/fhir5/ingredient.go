@@ -19,7 +19,8 @@ type IngredientManufacturer struct {
 	common.BackboneElement
 
 	// The organization that manufactures this ingredient
-	Manufacturer string `json:"manufacturer"`
+	Manufacturer        string          `json:"manufacturer"`
+	ManufacturerElement *common.Element `json:"_manufacturer,omitempty"`
 
 	// The way in which this manufacturer is associated with the ingredient
 	Role *common.CodeableConcept `json:"role,omitempty"`
@@ -33,7 +34,8 @@ type IngredientSubstanceStrengthReferenceStrength struct {
 	Country []common.CodeableConcept `json:"country,omitempty"`
 
 	// For when strength is measured at a particular point or distance
-	MeasurementPoint *string `json:"measurementPoint,omitempty"`
+	MeasurementPoint        *string         `json:"measurementPoint,omitempty"`
+	MeasurementPointElement *common.Element `json:"_measurementPoint,omitempty"`
 
 	// Strength expressed in terms of a reference substance
 	StrengthRatio    *Ratio           `json:"strengthRatio,omitempty"`
@@ -59,7 +61,8 @@ type IngredientSubstanceStrength struct {
 	Country []common.CodeableConcept `json:"country,omitempty"`
 
 	// For when strength is measured at a particular point or distance
-	MeasurementPoint *string `json:"measurementPoint,omitempty"`
+	MeasurementPoint        *string         `json:"measurementPoint,omitempty"`
+	MeasurementPointElement *common.Element `json:"_measurementPoint,omitempty"`
 
 	// The quantity of substance in the unit of presentation
 	PresentationRatio           *Ratio                  `json:"presentationRatio,omitempty"`
@@ -70,10 +73,12 @@ type IngredientSubstanceStrength struct {
 	ReferenceStrength []IngredientSubstanceStrengthReferenceStrength `json:"referenceStrength,omitempty"`
 
 	// A textual represention of either the whole of the concentration strength or a part of it
-	TextConcentration *string `json:"textConcentration,omitempty"`
+	TextConcentration        *string         `json:"textConcentration,omitempty"`
+	TextConcentrationElement *common.Element `json:"_textConcentration,omitempty"`
 
 	// A textual represention of either the whole of the presentation strength or a part of it
-	TextPresentation *string `json:"textPresentation,omitempty"`
+	TextPresentation        *string         `json:"textPresentation,omitempty"`
+	TextPresentationElement *common.Element `json:"_textPresentation,omitempty"`
 }
 
 // IngredientSubstance represents the substance that comprises this ingredient
@@ -95,10 +100,12 @@ type Ingredient struct {
 	ResourceType string `json:"resourceType"` // Always "Ingredient"
 
 	// If the ingredient is a known or suspected allergen
-	AllergenicIndicator *bool `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicator        *bool           `json:"allergenicIndicator,omitempty"`
+	AllergenicIndicatorElement *common.Element `json:"_allergenicIndicator,omitempty"`
 
 	// A place for providing any notes that are relevant to the component
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// The product which this ingredient is a constituent part of
 	For []common.Reference `json:"for,omitempty"`
@@ -119,7 +126,8 @@ type Ingredient struct {
 	Role common.CodeableConcept `json:"role"`
 
 	// Allows filtering of ingredient that are appropriate for use versus not
-	Status IngredientStatus `json:"status"`
+	Status        IngredientStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 
 	// The substance that comprises this ingredient
 	Substance IngredientSubstance `json:"substance"`
@@ -1,10 +1,15 @@
 // Package fhir5 contains FHIR R5 (version 5.0.0) resource definitions
 package fhir5
 
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
 // Instruction represents instruction details for the service request
 type Instruction struct {
 	DataType
 
 	// Describes the instruction
-	InstructionText *string `json:"instructionText,omitempty"`
+	InstructionText        *string         `json:"instructionText,omitempty"`
+	InstructionTextElement *common.Element `json:"_instructionText,omitempty"`
 }
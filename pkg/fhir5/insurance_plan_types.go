@@ -34,7 +34,8 @@ type InsurancePlanCoverageBenefit struct {
 	Limit []InsurancePlanCoverageBenefitLimit `json:"limit,omitempty"`
 
 	// The referral requirements to have access/coverage for this benefit
-	Requirement *string `json:"requirement,omitempty"`
+	Requirement        *string         `json:"requirement,omitempty"`
+	RequirementElement *common.Element `json:"_requirement,omitempty"`
 
 	// Type of benefit (primary care; speciality care; inpatient; outpatient)
 	Type common.CodeableConcept `json:"type"`
@@ -59,13 +60,15 @@ type InsurancePlanPlanGeneralCost struct {
 	common.BackboneElement
 
 	// Additional information about the general costs
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Value of the cost
 	Cost *Money `json:"cost,omitempty"`
 
 	// Number of enrollees
-	GroupSize *int `json:"groupSize,omitempty"`
+	GroupSize        *int            `json:"groupSize,omitempty"`
+	GroupSizeElement *common.Element `json:"_groupSize,omitempty"`
 
 	// Type of cost (copay; individual-deductible; family-deductible; out-of-pocket-maximum)
 	Type common.CodeableConcept `json:"type"`
@@ -19,10 +19,12 @@ type InventoryItemName struct {
 	common.BackboneElement
 
 	// The language that the item name is expressed in
-	Language string `json:"language"`
+	Language        string          `json:"language"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 
 	// The name or designation that the item is given
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of name e.g. 'brand-name', 'functional-name', 'common-name'
 	NameType common.Coding `json:"nameType"`
@@ -44,10 +46,12 @@ type InventoryItemDescription struct {
 	common.BackboneElement
 
 	// Textual description of the item
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The language for the item description
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 }
 
 // InventoryItemAssociation represents association with other items or products
@@ -73,11 +77,17 @@ type InventoryItemCharacteristic struct {
 
 	// The string value is used for characteristics that are descriptive and not codeable information
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
-	ValueDecimal         *float64                `json:"valueDecimal,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
+	ValueDecimal         *common.Decimal         `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element         `json:"_valueDecimal,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueUrl             *string                 `json:"valueUrl,omitempty"`
-	ValueDateTime        *string                 `json:"valueDateTime,omitempty"`
+	ValueUrlElement      *common.Element         `json:"_valueUrl,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
@@ -92,7 +102,8 @@ type InventoryItemInstance struct {
 	common.BackboneElement
 
 	// The expiry date or date and time for the product
-	Expiry *string `json:"expiry,omitempty"`
+	Expiry        *common.DateTime `json:"expiry,omitempty"`
+	ExpiryElement *common.Element  `json:"_expiry,omitempty"`
 
 	// The identifier for the physical instance, typically a serial number
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -101,7 +112,8 @@ type InventoryItemInstance struct {
 	Location *common.Reference `json:"location,omitempty"`
 
 	// The lot or batch number of the item
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// The subject that the item is associated with
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -154,5 +166,6 @@ type InventoryItem struct {
 	ResponsibleOrganization []InventoryItemResponsibleOrganization `json:"responsibleOrganization,omitempty"`
 
 	// Status of the item entry
-	Status InventoryItemStatus `json:"status"`
+	Status        InventoryItemStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 }
@@ -41,7 +41,8 @@ type InventoryReportInventoryListing struct {
 	common.BackboneElement
 
 	// The date and time when the items were counted
-	CountingDateTime *string `json:"countingDateTime,omitempty"`
+	CountingDateTime        *common.DateTime `json:"countingDateTime,omitempty"`
+	CountingDateTimeElement *common.Element  `json:"_countingDateTime,omitempty"`
 
 	// The item or items in this listing
 	Item []InventoryReportInventoryListingItem `json:"item,omitempty"`
@@ -61,7 +62,8 @@ type InventoryReport struct {
 	ResourceType string `json:"resourceType"` // Always "InventoryReport"
 
 	// Whether the report is about the current inventory count (snapshot) or a differential change in inventory (change)
-	CountType InventoryReportCountType `json:"countType"`
+	CountType        InventoryReportCountType `json:"countType"`
+	CountTypeElement *common.Element          `json:"_countType,omitempty"`
 
 	// Business identifier for the InvoiceReport
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -79,7 +81,8 @@ type InventoryReport struct {
 	OperationTypeReason *common.CodeableConcept `json:"operationTypeReason,omitempty"`
 
 	// When the report has been submitted
-	ReportedDateTime string `json:"reportedDateTime"`
+	ReportedDateTime        common.DateTime `json:"reportedDateTime"`
+	ReportedDateTimeElement *common.Element `json:"_reportedDateTime,omitempty"`
 
 	// Who submits the report
 	Reporter *common.Reference `json:"reporter,omitempty"`
@@ -88,5 +91,6 @@ type InventoryReport struct {
 	ReportingPeriod *common.Period `json:"reportingPeriod,omitempty"`
 
 	// The status of the inventory check or notification
-	Status InventoryReportStatus `json:"status"`
+	Status        InventoryReportStatus `json:"status"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 }
@@ -38,11 +38,13 @@ type InvoiceLineItem struct {
 	PriceComponent []MonetaryComponent `json:"priceComponent,omitempty"`
 
 	// Sequence in which the items appear on the invoice
-	Sequence *int `json:"sequence,omitempty"`
+	Sequence        *int            `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// Date/time(s) range when this service was delivered or completed
-	ServicedDate   *string        `json:"servicedDate,omitempty"`
-	ServicedPeriod *common.Period `json:"servicedPeriod,omitempty"`
+	ServicedDate        *common.Date    `json:"servicedDate,omitempty"`
+	ServicedDateElement *common.Element `json:"_servicedDate,omitempty"`
+	ServicedPeriod      *common.Period  `json:"servicedPeriod,omitempty"`
 }
 
 // Invoice represents an invoice for goods and services
@@ -56,13 +58,16 @@ type Invoice struct {
 	Account *common.Reference `json:"account,omitempty"`
 
 	// Reason for cancellation of this Invoice
-	CancelledReason *string `json:"cancelledReason,omitempty"`
+	CancelledReason        *string         `json:"cancelledReason,omitempty"`
+	CancelledReasonElement *common.Element `json:"_cancelledReason,omitempty"`
 
 	// When this Invoice was posted
-	Creation *string `json:"creation,omitempty"`
+	Creation        *common.DateTime `json:"creation,omitempty"`
+	CreationElement *common.Element  `json:"_creation,omitempty"`
 
 	// Deprecated by the element below
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Identifier of this Invoice
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -80,17 +85,20 @@ type Invoice struct {
 	Participant []InvoiceParticipant `json:"participant,omitempty"`
 
 	// Payment details
-	PaymentTerms *string `json:"paymentTerms,omitempty"`
+	PaymentTerms        *string         `json:"paymentTerms,omitempty"`
+	PaymentTermsElement *common.Element `json:"_paymentTerms,omitempty"`
 
 	// Date/time(s) range of services included in this invoice
-	PeriodDate   *string        `json:"periodDate,omitempty"`
-	PeriodPeriod *common.Period `json:"periodPeriod,omitempty"`
+	PeriodDate        *common.Date    `json:"periodDate,omitempty"`
+	PeriodDateElement *common.Element `json:"_periodDate,omitempty"`
+	PeriodPeriod      *common.Period  `json:"periodPeriod,omitempty"`
 
 	// The individual or Organization responsible for balancing of this invoice
 	Recipient *common.Reference `json:"recipient,omitempty"`
 
 	// The current state of the Invoice
-	Status InvoiceStatus `json:"status"`
+	Status        InvoiceStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The individual or set of individuals receiving the goods and services billed in this invoice
 	Subject *common.Reference `json:"subject,omitempty"`
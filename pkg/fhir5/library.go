@@ -22,7 +22,8 @@ type Library struct {
 	ResourceType string `json:"resourceType"` // Always "Library"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []ContactDetail `json:"author,omitempty"`
@@ -34,19 +35,23 @@ type Library struct {
 	Content []Attachment `json:"content,omitempty"`
 
 	// Use and/or publishing restrictions
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Describes a set of data that must be provided in order to be able to successfully perform the computations
-	DataRequirement []interface{} `json:"dataRequirement,omitempty"`
+	DataRequirement []DataRequirement `json:"dataRequirement,omitempty"`
 
 	// Date last changed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Natural language description of the library
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -58,7 +63,8 @@ type Library struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Additional identifier for the library
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -67,38 +73,45 @@ type Library struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// When the library was last reviewed
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// Name for this library (computer friendly)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The parameter element defines parameters used by the library
-	Parameter []interface{} `json:"parameter,omitempty"`
+	Parameter []common.ParameterDefinition `json:"parameter,omitempty"`
 
 	// Name of the publisher (organization or individual)
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this library is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Additional documentation, citations, etc.
-	RelatedArtifact []interface{} `json:"relatedArtifact,omitempty"`
+	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// Who reviewed the content
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// draft | active | retired | unknown
-	Status LibraryStatus `json:"status"`
+	Status        LibraryStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Type of individual the library content is focused on
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *common.Reference       `json:"subjectReference,omitempty"`
 
 	// Subordinate title of the library
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// Name for this library (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The category of the library, such as education, treatment, assessment, etc.
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -107,18 +120,22 @@ type Library struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// Canonical identifier for this library, represented as a URI
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// Describes the clinical usage of the library
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// The context that the content is intended to support
-	UseContext []interface{} `json:"useContext,omitempty"`
+	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// Business version of the library
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// How to compare versions
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
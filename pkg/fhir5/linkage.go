@@ -21,7 +21,8 @@ type LinkageItem struct {
 	Resource common.Reference `json:"resource"`
 
 	// Distinguishes which item is "source of truth" (if any) and which items are no longer considered to be current representations
-	Type LinkageItemType `json:"type"`
+	Type        LinkageItemType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // Linkage represents a linkage between resources
@@ -32,7 +33,8 @@ type Linkage struct {
 	ResourceType string `json:"resourceType"` // Always "Linkage"
 
 	// If false, any asserted linkages should not be considered current/relevant/applicable
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Identifies the user or organization responsible for asserting the linkages
 	Author *common.Reference `json:"author,omitempty"`
@@ -27,13 +27,16 @@ type LocationPosition struct {
 	common.BackboneElement
 
 	// Altitude with the same coordinate system as KML
-	Altitude *float64 `json:"altitude,omitempty"`
+	Altitude        *common.Decimal `json:"altitude,omitempty"`
+	AltitudeElement *common.Element `json:"_altitude,omitempty"`
 
 	// Latitude with the same coordinate system as KML
-	Latitude float64 `json:"latitude"`
+	Latitude        common.Decimal  `json:"latitude"`
+	LatitudeElement *common.Element `json:"_latitude,omitempty"`
 
 	// Longitude with the same coordinate system as KML
-	Longitude float64 `json:"longitude"`
+	Longitude        common.Decimal  `json:"longitude"`
+	LongitudeElement *common.Element `json:"_longitude,omitempty"`
 }
 
 // Location represents a place where services are provided and resources may be stored
@@ -47,7 +50,8 @@ type Location struct {
 	Address *Address `json:"address,omitempty"`
 
 	// Alternate names that the location is known as
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Collection of characteristics that describe the location
 	Characteristic []common.CodeableConcept `json:"characteristic,omitempty"`
@@ -56,7 +60,8 @@ type Location struct {
 	Contact []ExtendedContactDetail `json:"contact,omitempty"`
 
 	// Additional details about the location
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Technical endpoints providing access to services operated for the location
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
@@ -74,10 +79,12 @@ type Location struct {
 	ManagingOrganization *common.Reference `json:"managingOrganization,omitempty"`
 
 	// Whether this location is a class of locations or a specific location
-	Mode *LocationMode `json:"mode,omitempty"`
+	Mode        *LocationMode   `json:"mode,omitempty"`
+	ModeElement *common.Element `json:"_mode,omitempty"`
 
 	// Name of the location as used by humans
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The operational status of the location
 	OperationalStatus *common.Coding `json:"operationalStatus,omitempty"`
@@ -89,7 +96,8 @@ type Location struct {
 	Position *LocationPosition `json:"position,omitempty"`
 
 	// Whether the location is still in active use
-	Status *LocationStatus `json:"status,omitempty"`
+	Status        *LocationStatus `json:"status,omitempty"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Type of function performed at the location
 	Type []common.CodeableConcept `json:"type,omitempty"`
@@ -24,9 +24,12 @@ type ManufacturedItemDefinitionProperty struct {
 	// A value for the characteristic
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueDate            *string                 `json:"valueDate,omitempty"`
+	ValueDate            *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element         `json:"_valueDate,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueMarkdown        *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element         `json:"_valueMarkdown,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
 }
@@ -97,16 +100,18 @@ type ManufacturedItemDefinition struct {
 	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
 
 	// Allows specifying that an item is on the market for sale
-	MarketingStatus []interface{} `json:"marketingStatus,omitempty"`
+	MarketingStatus []MarketingStatus `json:"marketingStatus,omitempty"`
 
 	// A descriptive name applied to this item
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// General characteristics of this item
 	Property []ManufacturedItemDefinitionProperty `json:"property,omitempty"`
 
 	// Allows filtering of manufactured items that are appropriate for use versus not
-	Status ManufacturedItemDefinitionStatus `json:"status"`
+	Status        ManufacturedItemDefinitionStatus `json:"status"`
+	StatusElement *common.Element                  `json:"_status,omitempty"`
 
 	// The "real-world" units in which the quantity of the manufactured item is described
 	UnitOfPresentation *common.CodeableConcept `json:"unitOfPresentation,omitempty"`
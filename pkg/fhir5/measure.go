@@ -22,7 +22,8 @@ type MeasureTerm struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Provides a definition for the term as used within the measure
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 }
 
 // MeasureGroupPopulation represents a population in a measure group
@@ -33,13 +34,15 @@ type MeasureGroupPopulation struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The human readable description of this population criteria
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An expression that specifies the criteria for this population
-	Criteria interface{} `json:"criteria"`
+	Criteria Expression `json:"criteria"`
 
 	// A unique identifier for the population criteria
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 }
 
 // MeasureGroupStratifierComponent represents a component of a stratifier
@@ -50,13 +53,15 @@ type MeasureGroupStratifierComponent struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The expression that is used to define the stratifier criteria
-	Criteria interface{} `json:"criteria"`
+	Criteria Expression `json:"criteria"`
 
 	// The human readable description of this stratifier component
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A unique identifier for the stratifier component
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 }
 
 // MeasureGroupStratifier represents a stratifier for a measure group
@@ -70,13 +75,15 @@ type MeasureGroupStratifier struct {
 	Component []MeasureGroupStratifierComponent `json:"component,omitempty"`
 
 	// The expression that is used to define the stratifier criteria
-	Criteria *interface{} `json:"criteria,omitempty"`
+	Criteria *Expression `json:"criteria,omitempty"`
 
 	// The human readable description of this stratifier
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A unique identifier for the stratifier
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 }
 
 // MeasureGroup represents a group of populations for a measure
@@ -87,10 +94,12 @@ type MeasureGroup struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The human readable description of this population group
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A unique identifier for the group
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// A population criteria for the measure
 	Population []MeasureGroupPopulation `json:"population,omitempty"`
@@ -107,13 +116,15 @@ type MeasureSupplementalData struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The expression that is used to define the supplemental data
-	Criteria interface{} `json:"criteria"`
+	Criteria Expression `json:"criteria"`
 
 	// The human readable description of this supplemental data
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A unique identifier for the supplemental data element
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// Indicates how the calculation is performed for the measure
 	Usage []common.CodeableConcept `json:"usage,omitempty"`
@@ -127,16 +138,19 @@ type Measure struct {
 	ResourceType string `json:"resourceType"` // Always "Measure"
 
 	// The 'date' element may be more recent than the approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance of the content
 	Author []ContactDetail `json:"author,omitempty"`
 
 	// For a subject-based measure, the population basis is simply boolean
-	Basis *string `json:"basis,omitempty"`
+	Basis        *string         `json:"basis,omitempty"`
+	BasisElement *common.Element `json:"_basis,omitempty"`
 
 	// Provides a summary of relevant clinical guidelines or other clinical recommendations
-	ClinicalRecommendationStatement *string `json:"clinicalRecommendationStatement,omitempty"`
+	ClinicalRecommendationStatement        *string         `json:"clinicalRecommendationStatement,omitempty"`
+	ClinicalRecommendationStatementElement *common.Element `json:"_clinicalRecommendationStatement,omitempty"`
 
 	// If this is a composite measure, the scoring method used to combine the component measures
 	CompositeScoring *common.CodeableConcept `json:"compositeScoring,omitempty"`
@@ -145,19 +159,24 @@ type Measure struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the measure and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Natural language description of the measure
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Provides a summary of key clinical recommendation
-	Disclaimer *string `json:"disclaimer,omitempty"`
+	Disclaimer        *string         `json:"disclaimer,omitempty"`
+	DisclaimerElement *common.Element `json:"_disclaimer,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -169,10 +188,12 @@ type Measure struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Additional guidance for the measure including how it can be used in a clinical context
-	Guidance *string `json:"guidance,omitempty"`
+	Guidance        *string         `json:"guidance,omitempty"`
+	GuidanceElement *common.Element `json:"_guidance,omitempty"`
 
 	// Population criteria group
 	Group []MeasureGroup `json:"group,omitempty"`
@@ -187,35 +208,42 @@ type Measure struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// When the measure was last reviewed
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// Logic used by the measure
-	Library []string `json:"library,omitempty"`
+	Library        []string          `json:"library,omitempty"`
+	LibraryElement []*common.Element `json:"_library,omitempty"`
 
 	// Name for this measure (computer friendly)
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Natural language description of the purpose of the measure
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Additional documentation, citations, etc.
-	RelatedArtifact []interface{} `json:"relatedArtifact,omitempty"`
+	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// Who reviewed the content
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// draft | active | retired | unknown
-	Status MeasureStatus `json:"status"`
+	Status        MeasureStatus   `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The subject for which the measure is intended
 	SubjectCodeableConcept *common.CodeableConcept `json:"subjectCodeableConcept,omitempty"`
 	SubjectReference       *common.Reference       `json:"subjectReference,omitempty"`
 
 	// Subordinate title of the measure
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// Name for this measure (human friendly)
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The category of the measure, such as process, outcome, structure, patient-reported outcome, etc.
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -224,18 +252,22 @@ type Measure struct {
 	Type []common.CodeableConcept `json:"type,omitempty"`
 
 	// Canonical identifier for this measure, represented as a URI
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// Describes the clinical usage of the measure
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// The context that the content is intended to support
-	UseContext []interface{} `json:"useContext,omitempty"`
+	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// Business version of the measure
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// How to compare versions
-	VersionAlgorithmString *string        `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding  `json:"versionAlgorithmCoding,omitempty"`
 }
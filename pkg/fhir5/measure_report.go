@@ -39,10 +39,12 @@ type MeasureReportGroupPopulation struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// The number of members of the population
-	Count *int `json:"count,omitempty"`
+	Count        *int            `json:"count,omitempty"`
+	CountElement *common.Element `json:"_count,omitempty"`
 
 	// A unique identifier for the population criteria
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// Optional subject identifying the individual or individuals the report is for
 	SubjectReference []common.Reference `json:"subjectReference,omitempty"`
@@ -63,7 +65,8 @@ type MeasureReportGroupStratifierStratum struct {
 
 	// The measure score for this stratum
 	MeasureScoreQuantity        *common.Quantity        `json:"measureScoreQuantity,omitempty"`
-	MeasureScoreDateTime        *string                 `json:"measureScoreDateTime,omitempty"`
+	MeasureScoreDateTime        *common.DateTime        `json:"measureScoreDateTime,omitempty"`
+	MeasureScoreDateTimeElement *common.Element         `json:"_measureScoreDateTime,omitempty"`
 	MeasureScoreCodeableConcept *common.CodeableConcept `json:"measureScoreCodeableConcept,omitempty"`
 	MeasureScorePeriod          *common.Period          `json:"measureScorePeriod,omitempty"`
 	MeasureScoreRange           *Range                  `json:"measureScoreRange,omitempty"`
@@ -75,6 +78,7 @@ type MeasureReportGroupStratifierStratum struct {
 	// The value for this stratum, expressed as a CodeableConcept
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
@@ -88,11 +92,13 @@ type MeasureReportGroupStratifierStratumComponent struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// A unique identifier for the stratifier component
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// The value for this component, expressed as a CodeableConcept
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
@@ -106,7 +112,8 @@ type MeasureReportGroupStratifier struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// A unique identifier for the stratifier
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// Stratifier Results
 	Stratum []MeasureReportGroupStratifierStratum `json:"stratum,omitempty"`
@@ -120,11 +127,13 @@ type MeasureReportGroup struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// A unique identifier for the group
-	LinkId *string `json:"linkId,omitempty"`
+	LinkId        *string         `json:"linkId,omitempty"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// The measure score for this population group
 	MeasureScoreQuantity        *common.Quantity        `json:"measureScoreQuantity,omitempty"`
-	MeasureScoreDateTime        *string                 `json:"measureScoreDateTime,omitempty"`
+	MeasureScoreDateTime        *common.DateTime        `json:"measureScoreDateTime,omitempty"`
+	MeasureScoreDateTimeElement *common.Element         `json:"_measureScoreDateTime,omitempty"`
 	MeasureScoreCodeableConcept *common.CodeableConcept `json:"measureScoreCodeableConcept,omitempty"`
 	MeasureScorePeriod          *common.Period          `json:"measureScorePeriod,omitempty"`
 	MeasureScoreRange           *Range                  `json:"measureScoreRange,omitempty"`
@@ -145,10 +154,12 @@ type MeasureReport struct {
 	ResourceType string `json:"resourceType"` // Always "MeasureReport"
 
 	// This element only applies to Data-collection reports
-	DataUpdateType *MeasureReportDataUpdateType `json:"dataUpdateType,omitempty"`
+	DataUpdateType        *MeasureReportDataUpdateType `json:"dataUpdateType,omitempty"`
+	DataUpdateTypeElement *common.Element              `json:"_dataUpdateType,omitempty"`
 
 	// The date this measure was calculated
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Evaluated resources are only reported for individual reports
 	EvaluatedResource []common.Reference `json:"evaluatedResource,omitempty"`
@@ -169,7 +180,8 @@ type MeasureReport struct {
 	Location *common.Reference `json:"location,omitempty"`
 
 	// A reference to the Measure that was calculated to produce this report
-	Measure *string `json:"measure,omitempty"`
+	Measure        *string         `json:"measure,omitempty"`
+	MeasureElement *common.Element `json:"_measure,omitempty"`
 
 	// The reporting period for which the report was calculated
 	Period common.Period `json:"period"`
@@ -184,7 +196,8 @@ type MeasureReport struct {
 	Scoring *common.CodeableConcept `json:"scoring,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status MeasureReportStatus `json:"status"`
+	Status        MeasureReportStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// Optional subject identifying the individual or individuals the report is for
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -193,5 +206,6 @@ type MeasureReport struct {
 	SupplementalData []common.Reference `json:"supplementalData,omitempty"`
 
 	// Data-exchange reports are used only to communicate data-of-interest for a measure
-	Type MeasureReportType `json:"type"`
+	Type        MeasureReportType `json:"type"`
+	TypeElement *common.Element   `json:"_type,omitempty"`
 }
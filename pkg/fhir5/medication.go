@@ -10,7 +10,8 @@ type MedicationIngredient struct {
 	common.BackboneElement
 
 	// Indication of whether this ingredient affects the therapeutic action of the drug
-	IsActive *bool `json:"isActive,omitempty"`
+	IsActive        *bool           `json:"isActive,omitempty"`
+	IsActiveElement *common.Element `json:"_isActive,omitempty"`
 
 	// The ingredient (substance or medication) that the ingredient.strength relates to
 	Item CodeableReference `json:"item"`
@@ -26,10 +27,12 @@ type MedicationBatch struct {
 	common.BackboneElement
 
 	// When this specific batch of product will expire
-	ExpirationDate *string `json:"expirationDate,omitempty"`
+	ExpirationDate        *common.DateTime `json:"expirationDate,omitempty"`
+	ExpirationDateElement *common.Element  `json:"_expirationDate,omitempty"`
 
 	// The assigned lot number of a batch of the specified product
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 }
 
 // Medication represents a medication definition (R5)
@@ -61,7 +64,8 @@ type Medication struct {
 	MarketingAuthorizationHolder *common.Reference `json:"marketingAuthorizationHolder,omitempty"`
 
 	// Active | inactive | entered-in-error
-	Status *MedicationStatus `json:"status,omitempty"`
+	Status        *MedicationStatus `json:"status,omitempty"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// When the specified product code does not infer a package size, this is the specific amount of drug in the product
 	TotalVolume *common.Quantity `json:"totalVolume,omitempty"`
@@ -49,7 +49,8 @@ type MedicationAdministrationDosage struct {
 	Site *common.CodeableConcept `json:"site,omitempty"`
 
 	// Free text dosage instructions
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // MedicationAdministration represents the event of a patient consuming or otherwise being administered a medication
@@ -81,7 +82,8 @@ type MedicationAdministration struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// An indication that the full dose was not administered
-	IsSubPotent *bool `json:"isSubPotent,omitempty"`
+	IsSubPotent        *bool           `json:"isSubPotent,omitempty"`
+	IsSubPotentElement *common.Element `json:"_isSubPotent,omitempty"`
 
 	// If only a code is specified, then it needs to be a code for a specific product
 	Medication CodeableReference `json:"medication"`
@@ -90,9 +92,10 @@ type MedicationAdministration struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// A specific date/time or interval of time during which the administration took place
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// A larger event of which this particular event is a component or step
 	PartOf []common.Reference `json:"partOf,omitempty"`
@@ -104,13 +107,15 @@ type MedicationAdministration struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 
 	// The date the occurrence of the MedicationAdministration was first captured in the record
-	Recorded *string `json:"recorded,omitempty"`
+	Recorded        *common.DateTime `json:"recorded,omitempty"`
+	RecordedElement *common.Element  `json:"_recorded,omitempty"`
 
 	// The original request that this medication administration is based on
 	Request *common.Reference `json:"request,omitempty"`
 
 	// Will generally be set to show that the administration has been completed
-	Status MedicationAdministrationStatus `json:"status"`
+	Status        MedicationAdministrationStatus `json:"status"`
+	StatusElement *common.Element                `json:"_status,omitempty"`
 
 	// A code indicating why the administration was not performed
 	StatusReason []common.CodeableConcept `json:"statusReason,omitempty"`
@@ -44,7 +44,8 @@ type MedicationDispenseSubstitution struct {
 	ResponsibleParty *common.Reference `json:"responsibleParty,omitempty"`
 
 	// True if the dispenser dispensed a different drug or product from what was prescribed
-	WasSubstituted *bool `json:"wasSubstituted,omitempty"`
+	WasSubstituted        *bool           `json:"wasSubstituted,omitempty"`
+	WasSubstitutedElement *common.Element `json:"_wasSubstituted,omitempty"`
 }
 
 // MedicationDispense represents the provision of a medication to a patient
@@ -106,10 +107,12 @@ type MedicationDispense struct {
 	Receiver []common.Reference `json:"receiver,omitempty"`
 
 	// The date the dispensed product was recorded
-	Recorded *string `json:"recorded,omitempty"`
+	Recorded        *common.DateTime `json:"recorded,omitempty"`
+	RecordedElement *common.Element  `json:"_recorded,omitempty"`
 
 	// The status of the dispense
-	Status MedicationDispenseStatus `json:"status"`
+	Status        MedicationDispenseStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 
 	// Indicates the reason why a dispense was not performed
 	StatusReason *CodeableReference `json:"statusReason,omitempty"`
@@ -127,8 +130,10 @@ type MedicationDispense struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// The time when the dispensed product was packaged and reviewed
-	WhenHandedOver *string `json:"whenHandedOver,omitempty"`
+	WhenHandedOver        *common.DateTime `json:"whenHandedOver,omitempty"`
+	WhenHandedOverElement *common.Element  `json:"_whenHandedOver,omitempty"`
 
 	// The time the dispensed product was prepared
-	WhenPrepared *string `json:"whenPrepared,omitempty"`
+	WhenPrepared        *common.DateTime `json:"whenPrepared,omitempty"`
+	WhenPreparedElement *common.Element  `json:"_whenPrepared,omitempty"`
 }
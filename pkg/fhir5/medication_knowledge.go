@@ -16,12 +16,14 @@ const (
 type MedicationKnowledgeDefinitionalDrugCharacteristic struct {
 	common.BackboneElement
 
-	Type                 *common.CodeableConcept `json:"type,omitempty"`
-	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
-	ValueString          *string                 `json:"valueString,omitempty"`
-	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueBase64Binary    *string                 `json:"valueBase64Binary,omitempty"`
-	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
+	Type                     *common.CodeableConcept `json:"type,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueString              *string                 `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element         `json:"_valueString,omitempty"`
+	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element         `json:"_valueBase64Binary,omitempty"`
+	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
 }
 
 // MedicationKnowledgeDefinitionalIngredient represents ingredients of the medication
@@ -30,6 +32,7 @@ type MedicationKnowledgeDefinitionalIngredient struct {
 
 	Item                    CodeableReference       `json:"item"`
 	IsActive                *bool                   `json:"isActive,omitempty"`
+	IsActiveElement         *common.Element         `json:"_isActive,omitempty"`
 	StrengthRatio           *Ratio                  `json:"strengthRatio,omitempty"`
 	StrengthCodeableConcept *common.CodeableConcept `json:"strengthCodeableConcept,omitempty"`
 	StrengthQuantity        *common.Quantity        `json:"strengthQuantity,omitempty"`
@@ -57,27 +60,173 @@ type MedicationKnowledgeCost struct {
 	Type                *common.CodeableConcept `json:"type,omitempty"`
 }
 
+// MedicationKnowledgeIndicationGuideline represents guidelines or protocols for administration of the medication for an indication
+type MedicationKnowledgeIndicationGuideline struct {
+	common.BackboneElement
+
+	// Indication for use that applies to the specific administration guideline
+	Indication []CodeableReference `json:"indication,omitempty"`
+
+	// Guidelines for dosage of the medication
+	DosingGuideline []MedicationKnowledgeIndicationGuidelineDosingGuideline `json:"dosingGuideline,omitempty"`
+}
+
+// MedicationKnowledgeIndicationGuidelineDosingGuideline represents guidelines for dosage of the medication
+type MedicationKnowledgeIndicationGuidelineDosingGuideline struct {
+	common.BackboneElement
+
+	// Intention of the treatment
+	TreatmentIntent *common.CodeableConcept `json:"treatmentIntent,omitempty"`
+
+	// Dosage for the medication for the specific guidelines
+	Dosage []MedicationKnowledgeIndicationGuidelineDosingGuidelineDosage `json:"dosage,omitempty"`
+
+	// Type of treatment the guideline applies to
+	AdministrationTreatment *common.CodeableConcept `json:"administrationTreatment,omitempty"`
+
+	// Characteristics of the patient that are relevant to the administration guidelines
+	PatientCharacteristic []MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic `json:"patientCharacteristic,omitempty"`
+}
+
+// MedicationKnowledgeIndicationGuidelineDosingGuidelineDosage represents dosage for the medication for the specific guidelines
+type MedicationKnowledgeIndicationGuidelineDosingGuidelineDosage struct {
+	common.BackboneElement
+
+	// Category of dosage for a medication
+	Type common.CodeableConcept `json:"type"`
+
+	// Dosage for the medication for the specific guidelines
+	Dosage []Dosage `json:"dosage,omitempty"`
+}
+
+// MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic represents characteristics of the patient that are relevant to the administration guidelines
+type MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic struct {
+	common.BackboneElement
+
+	// Categorization of specific characteristic that is relevant to the administration guideline
+	Type common.CodeableConcept `json:"type"`
+
+	// The specific characteristic
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange           *Range                  `json:"valueRange,omitempty"`
+}
+
+// MedicationKnowledgeMedicineClassification represents categorization of the medication within a formulary or classification system
+type MedicationKnowledgeMedicineClassification struct {
+	common.BackboneElement
+
+	// The type of category for the medication (for example, therapeutic classification, therapeutic sub-classification)
+	Type common.CodeableConcept `json:"type"`
+
+	// The source of the classification
+	SourceString        *string         `json:"sourceString,omitempty"`
+	SourceStringElement *common.Element `json:"_sourceString,omitempty"`
+	SourceUri           *string         `json:"sourceUri,omitempty"`
+	SourceUriElement    *common.Element `json:"_sourceUri,omitempty"`
+
+	// Specific category assigned to the medication
+	Classification []common.CodeableConcept `json:"classification,omitempty"`
+}
+
+// MedicationKnowledgeMonitoringProgram represents program under which a medication is reviewed
+type MedicationKnowledgeMonitoringProgram struct {
+	common.BackboneElement
+
+	// Type of program under which the medication is monitored
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Name of the reviewing program
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+}
+
+// MedicationKnowledgeMonograph represents associated documentation about the medication
+type MedicationKnowledgeMonograph struct {
+	common.BackboneElement
+
+	// The category of medication document
+	Type *common.CodeableConcept `json:"type,omitempty"`
+
+	// Associated documentation about the medication
+	Source *common.Reference `json:"source,omitempty"`
+}
+
+// MedicationKnowledgePackaging represents details about packaged medications
+type MedicationKnowledgePackaging struct {
+	common.BackboneElement
+
+	// Cost of the packaged medication
+	Cost []MedicationKnowledgeCost `json:"cost,omitempty"`
+
+	// The packaged medication that is being priced
+	PackagedProduct *common.Reference `json:"packagedProduct,omitempty"`
+}
+
+// MedicationKnowledgeRelatedMedicationKnowledge represents associated or related medication information
+type MedicationKnowledgeRelatedMedicationKnowledge struct {
+	common.BackboneElement
+
+	// Category of medicationKnowledge
+	Type common.CodeableConcept `json:"type"`
+
+	// Associated documentation about the associated medication knowledge
+	Reference []common.Reference `json:"reference,omitempty"`
+}
+
+// MedicationKnowledgeStorageGuideline represents how the medication should be stored
+type MedicationKnowledgeStorageGuideline struct {
+	common.BackboneElement
+
+	// Reference to additional information
+	Reference        *string         `json:"reference,omitempty"`
+	ReferenceElement *common.Element `json:"_reference,omitempty"`
+
+	// Additional storage notes
+	Note []Annotation `json:"note,omitempty"`
+
+	// Duration remains stable
+	StabilityDuration *Duration `json:"stabilityDuration,omitempty"`
+
+	// Setting or value of environment for adequate storage
+	EnvironmentalSetting []MedicationKnowledgeStorageGuidelineEnvironmentalSetting `json:"environmentalSetting,omitempty"`
+}
+
+// MedicationKnowledgeStorageGuidelineEnvironmentalSetting represents setting or value of environment for adequate storage
+type MedicationKnowledgeStorageGuidelineEnvironmentalSetting struct {
+	common.BackboneElement
+
+	// Categorization of the setting
+	Type common.CodeableConcept `json:"type"`
+
+	// Value of the setting
+	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueRange           *Range                  `json:"valueRange,omitempty"`
+	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+}
+
 // MedicationKnowledge represents information about a medication that is used to support knowledge
 type MedicationKnowledge struct {
 	DomainResource
 
-	ResourceType               string                           `json:"resourceType"` // Always "MedicationKnowledge"
-	AssociatedMedication       []common.Reference               `json:"associatedMedication,omitempty"`
-	Author                     *common.Reference                `json:"author,omitempty"`
-	ClinicalUseIssue           []common.Reference               `json:"clinicalUseIssue,omitempty"`
-	Code                       *common.CodeableConcept          `json:"code,omitempty"`
-	Cost                       []MedicationKnowledgeCost        `json:"cost,omitempty"`
-	Definitional               *MedicationKnowledgeDefinitional `json:"definitional,omitempty"`
-	Identifier                 []common.Identifier              `json:"identifier,omitempty"`
-	IndicationGuideline        []interface{}                    `json:"indicationGuideline,omitempty"`
-	MaxDispenseQuantity        *common.Quantity                 `json:"maxDispenseQuantity,omitempty"`
-	MedicineClassification     []interface{}                    `json:"medicineClassification,omitempty"`
-	MonitoringProgram          []interface{}                    `json:"monitoringProgram,omitempty"`
-	Monograph                  []interface{}                    `json:"monograph,omitempty"`
-	Packaging                  []interface{}                    `json:"packaging,omitempty"`
-	PharmacokineticProperty    []interface{}                    `json:"pharmacokineticProperty,omitempty"`
-	RegulatoryInformation      []interface{}                    `json:"regulatoryInformation,omitempty"`
-	RelatedMedicationKnowledge []interface{}                    `json:"relatedMedicationKnowledge,omitempty"`
-	Status                     *MedicationKnowledgeStatus       `json:"status,omitempty"`
-	StorageGuideline           []interface{}                    `json:"storageGuideline,omitempty"`
+	ResourceType               string                                          `json:"resourceType"` // Always "MedicationKnowledge"
+	AssociatedMedication       []common.Reference                              `json:"associatedMedication,omitempty"`
+	Author                     *common.Reference                               `json:"author,omitempty"`
+	ClinicalUseIssue           []common.Reference                              `json:"clinicalUseIssue,omitempty"`
+	Code                       *common.CodeableConcept                         `json:"code,omitempty"`
+	Cost                       []MedicationKnowledgeCost                       `json:"cost,omitempty"`
+	Definitional               *MedicationKnowledgeDefinitional                `json:"definitional,omitempty"`
+	Identifier                 []common.Identifier                             `json:"identifier,omitempty"`
+	IndicationGuideline        []MedicationKnowledgeIndicationGuideline        `json:"indicationGuideline,omitempty"`
+	MaxDispenseQuantity        *common.Quantity                                `json:"maxDispenseQuantity,omitempty"`
+	MedicineClassification     []MedicationKnowledgeMedicineClassification     `json:"medicineClassification,omitempty"`
+	MonitoringProgram          []MedicationKnowledgeMonitoringProgram          `json:"monitoringProgram,omitempty"`
+	Monograph                  []MedicationKnowledgeMonograph                  `json:"monograph,omitempty"`
+	Packaging                  []MedicationKnowledgePackaging                  `json:"packaging,omitempty"`
+	PharmacokineticProperty    []interface{}                                   `json:"pharmacokineticProperty,omitempty"`
+	RegulatoryInformation      []interface{}                                   `json:"regulatoryInformation,omitempty"`
+	RelatedMedicationKnowledge []MedicationKnowledgeRelatedMedicationKnowledge `json:"relatedMedicationKnowledge,omitempty"`
+	Status                     *MedicationKnowledgeStatus                      `json:"status,omitempty"`
+	StatusElement              *common.Element                                 `json:"_status,omitempty"`
+	StorageGuideline           []MedicationKnowledgeStorageGuideline           `json:"storageGuideline,omitempty"`
 }
@@ -39,7 +39,8 @@ type MedicationRequestDispenseRequest struct {
 	InitialFill *MedicationRequestDispenseRequestInitialFill `json:"initialFill,omitempty"`
 
 	// If displaying "number of authorized fills", add 1 to this number
-	NumberOfRepeatsAllowed *int `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowed        *int            `json:"numberOfRepeatsAllowed,omitempty"`
+	NumberOfRepeatsAllowedElement *common.Element `json:"_numberOfRepeatsAllowed,omitempty"`
 
 	// The amount that is to be dispensed for one fill
 	Quantity *common.Quantity `json:"quantity,omitempty"`
@@ -54,6 +55,7 @@ type MedicationRequestSubstitution struct {
 
 	// Whether substitution is allowed
 	AllowedBoolean         *bool                   `json:"allowedBoolean,omitempty"`
+	AllowedBooleanElement  *common.Element         `json:"_allowedBoolean,omitempty"`
 	AllowedCodeableConcept *common.CodeableConcept `json:"allowedCodeableConcept,omitempty"`
 
 	// Indicates the reason for the substitution
@@ -68,7 +70,8 @@ type MedicationRequest struct {
 	ResourceType string `json:"resourceType"` // Always "MedicationRequest"
 
 	// The date when the prescription was initially written or authored on
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// A plan or request that is fulfilled in whole or in part by this medication request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -86,7 +89,8 @@ type MedicationRequest struct {
 	DispenseRequest *MedicationRequestDispenseRequest `json:"dispenseRequest,omitempty"`
 
 	// If do not perform is not specified, the request is a positive request
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// How to take the medication
 	DosageInstruction []Dosage `json:"dosageInstruction,omitempty"`
@@ -113,7 +117,8 @@ type MedicationRequest struct {
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// proposal | plan | order | original-order | reflex-order | filler-order | instance-order | option
-	Intent MedicationRequestIntent `json:"intent"`
+	Intent        MedicationRequestIntent `json:"intent"`
+	IntentElement *common.Element         `json:"_intent,omitempty"`
 
 	// Medication to be taken (R5 uses CodeableReference)
 	Medication CodeableReference `json:"medication"`
@@ -128,7 +133,8 @@ type MedicationRequest struct {
 	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
 
 	// routine | urgent | asap | stat
-	Priority *MedicationRequestPriority `json:"priority,omitempty"`
+	Priority        *MedicationRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element            `json:"_priority,omitempty"`
 
 	// Reference to an order/prescription that is being replaced
 	PriorPrescription *common.Reference `json:"priorPrescription,omitempty"`
@@ -140,19 +146,23 @@ type MedicationRequest struct {
 	Recorder *common.Reference `json:"recorder,omitempty"`
 
 	// Full representation of the dose of the medication (R5 feature)
-	RenderedDosageInstruction *string `json:"renderedDosageInstruction,omitempty"`
+	RenderedDosageInstruction        *string         `json:"renderedDosageInstruction,omitempty"`
+	RenderedDosageInstructionElement *common.Element `json:"_renderedDosageInstruction,omitempty"`
 
 	// If not populated, then assume that this is the original record
-	Reported *bool `json:"reported,omitempty"`
+	Reported        *bool           `json:"reported,omitempty"`
+	ReportedElement *common.Element `json:"_reported,omitempty"`
 
 	// The individual, organization, or device that initiated the request
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// active | on-hold | ended | stopped | completed | cancelled | entered-in-error | draft | unknown
-	Status MedicationRequestStatus `json:"status"`
+	Status        MedicationRequestStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
 
 	// The date when the status was changed (R5 feature)
-	StatusChanged *string `json:"statusChanged,omitempty"`
+	StatusChanged        *common.DateTime `json:"statusChanged,omitempty"`
+	StatusChangedElement *common.Element  `json:"_statusChanged,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -38,7 +38,8 @@ type MedicationStatement struct {
 	Category []common.CodeableConcept `json:"category,omitempty"`
 
 	// The date when the Medication Statement was asserted by the information source
-	DateAsserted *string `json:"dateAsserted,omitempty"`
+	DateAsserted        *common.DateTime `json:"dateAsserted,omitempty"`
+	DateAssertedElement *common.Element  `json:"_dateAsserted,omitempty"`
 
 	// Likely references would be to MedicationRequest, MedicationDispense, Claim, Observation or QuestionnaireAnswers
 	DerivedFrom []common.Reference `json:"derivedFrom,omitempty"`
@@ -47,9 +48,10 @@ type MedicationStatement struct {
 	Dosage []Dosage `json:"dosage,omitempty"`
 
 	// This attribute reflects the period over which the patient consumed the medication
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
-	EffectiveTiming   *Timing        `json:"effectiveTiming,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+	EffectiveTiming          *Timing          `json:"effectiveTiming,omitempty"`
 
 	// The encounter that establishes the context for this MedicationStatement
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -76,10 +78,12 @@ type MedicationStatement struct {
 	RelatedClinicalInformation []common.Reference `json:"relatedClinicalInformation,omitempty"`
 
 	// The full representation of the dose of the medication included in all dosage instructions
-	RenderedDosageInstruction *string `json:"renderedDosageInstruction,omitempty"`
+	RenderedDosageInstruction        *string         `json:"renderedDosageInstruction,omitempty"`
+	RenderedDosageInstructionElement *common.Element `json:"_renderedDosageInstruction,omitempty"`
 
 	// This status concerns just the recording of the medication statement
-	Status MedicationStatementStatus `json:"status"`
+	Status        MedicationStatementStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// The person, animal or group who is/was taking the medication
 	Subject common.Reference `json:"subject"`
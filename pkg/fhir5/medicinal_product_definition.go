@@ -20,7 +20,8 @@ type MedicinalProductDefinitionNamePart struct {
 	common.BackboneElement
 
 	// A fragment of a product name
-	Part string `json:"part"`
+	Part        string          `json:"part"`
+	PartElement *common.Element `json:"_part,omitempty"`
 
 	// Identifying type for this part of the name
 	Type common.CodeableConcept `json:"type"`
@@ -48,7 +49,8 @@ type MedicinalProductDefinitionName struct {
 	Part []MedicinalProductDefinitionNamePart `json:"part,omitempty"`
 
 	// The full product name
-	ProductName string `json:"productName"`
+	ProductName        string          `json:"productName"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
 
 	// Type of product name, such as rINN, BAN, Proprietary, Non-Proprietary
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -95,10 +97,14 @@ type MedicinalProductDefinitionCharacteristic struct {
 	// Description of the characteristic
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueMarkdown        *string                 `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element         `json:"_valueMarkdown,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
-	ValueDate            *string                 `json:"valueDate,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
+	ValueDate            *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element         `json:"_valueDate,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 }
 
@@ -140,7 +146,8 @@ type MedicinalProductDefinition struct {
 	CrossReference []MedicinalProductDefinitionCrossReference `json:"crossReference,omitempty"`
 
 	// General description of this product
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// If this medicine applies to human or veterinary uses
 	Domain *common.CodeableConcept `json:"domain,omitempty"`
@@ -152,7 +159,8 @@ type MedicinalProductDefinition struct {
 	Impurity []CodeableReference `json:"impurity,omitempty"`
 
 	// Description of indication(s) for this product
-	Indication *string `json:"indication,omitempty"`
+	Indication        *string         `json:"indication,omitempty"`
+	IndicationElement *common.Element `json:"_indication,omitempty"`
 
 	// The ingredients of this medicinal product
 	Ingredient []common.CodeableConcept `json:"ingredient,omitempty"`
@@ -161,7 +169,7 @@ type MedicinalProductDefinition struct {
 	LegalStatusOfSupply *common.CodeableConcept `json:"legalStatusOfSupply,omitempty"`
 
 	// Marketing status of the medicinal product
-	MarketingStatus []interface{} `json:"marketingStatus,omitempty"`
+	MarketingStatus []MarketingStatus `json:"marketingStatus,omitempty"`
 
 	// A master file for the medicinal product
 	MasterFile []common.Reference `json:"masterFile,omitempty"`
@@ -188,11 +196,13 @@ type MedicinalProductDefinition struct {
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// The date at which the given status became applicable
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
 
 	// Regulatory type, e.g. Investigational or Authorized
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// A business identifier relating to a specific version of the product
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
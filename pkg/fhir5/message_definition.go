@@ -20,17 +20,23 @@ type MessageDefinitionResponseRequired string
 type MessageDefinitionFocus struct {
 	common.BackboneElement
 
-	Code    string  `json:"code"`
-	Max     *string `json:"max,omitempty"`
-	Min     int     `json:"min"`
-	Profile *string `json:"profile,omitempty"`
+	Code           string          `json:"code"`
+	CodeElement    *common.Element `json:"_code,omitempty"`
+	Max            *string         `json:"max,omitempty"`
+	MaxElement     *common.Element `json:"_max,omitempty"`
+	Min            int             `json:"min"`
+	MinElement     *common.Element `json:"_min,omitempty"`
+	Profile        *string         `json:"profile,omitempty"`
+	ProfileElement *common.Element `json:"_profile,omitempty"`
 }
 
 type MessageDefinitionAllowedResponse struct {
 	common.BackboneElement
 
-	Message   string  `json:"message"`
-	Situation *string `json:"situation,omitempty"`
+	Message          string          `json:"message"`
+	MessageElement   *common.Element `json:"_message,omitempty"`
+	Situation        *string         `json:"situation,omitempty"`
+	SituationElement *common.Element `json:"_situation,omitempty"`
 }
 
 type MessageDefinition struct {
@@ -38,30 +44,49 @@ type MessageDefinition struct {
 
 	ResourceType string `json:"resourceType"` // Always "MessageDefinition"
 
-	AllowedResponse  []MessageDefinitionAllowedResponse `json:"allowedResponse,omitempty"`
-	Base             *string                            `json:"base,omitempty"`
-	Category         *MessageDefinitionCategory         `json:"category,omitempty"`
-	Contact          []ContactDetail                    `json:"contact,omitempty"`
-	Copyright        *string                            `json:"copyright,omitempty"`
-	CopyrightLabel   *string                            `json:"copyrightLabel,omitempty"`
-	Date             string                             `json:"date"`
-	Description      *string                            `json:"description,omitempty"`
-	EventCoding      *common.Coding                     `json:"eventCoding,omitempty"`
-	EventUri         *string                            `json:"eventUri,omitempty"`
-	Experimental     *bool                              `json:"experimental,omitempty"`
-	Focus            []MessageDefinitionFocus           `json:"focus,omitempty"`
-	Graph            *string                            `json:"graph,omitempty"`
-	Identifier       []common.Identifier                `json:"identifier,omitempty"`
-	Jurisdiction     []common.CodeableConcept           `json:"jurisdiction,omitempty"`
-	Name             *string                            `json:"name,omitempty"`
-	Parent           []string                           `json:"parent,omitempty"`
-	Publisher        *string                            `json:"publisher,omitempty"`
-	Purpose          *string                            `json:"purpose,omitempty"`
-	Replaces         []string                           `json:"replaces,omitempty"`
-	ResponseRequired *MessageDefinitionResponseRequired `json:"responseRequired,omitempty"`
-	Status           MessageDefinitionStatus            `json:"status"`
-	Title            *string                            `json:"title,omitempty"`
-	Url              *string                            `json:"url,omitempty"`
-	UseContext       []UsageContext                     `json:"useContext,omitempty"`
-	Version          *string                            `json:"version,omitempty"`
+	AllowedResponse         []MessageDefinitionAllowedResponse `json:"allowedResponse,omitempty"`
+	Base                    *string                            `json:"base,omitempty"`
+	BaseElement             *common.Element                    `json:"_base,omitempty"`
+	Category                *MessageDefinitionCategory         `json:"category,omitempty"`
+	CategoryElement         *common.Element                    `json:"_category,omitempty"`
+	Contact                 []ContactDetail                    `json:"contact,omitempty"`
+	Copyright               *string                            `json:"copyright,omitempty"`
+	CopyrightElement        *common.Element                    `json:"_copyright,omitempty"`
+	CopyrightLabel          *string                            `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement   *common.Element                    `json:"_copyrightLabel,omitempty"`
+	Date                    common.DateTime                    `json:"date"`
+	DateElement             *common.Element                    `json:"_date,omitempty"`
+	Description             *string                            `json:"description,omitempty"`
+	DescriptionElement      *common.Element                    `json:"_description,omitempty"`
+	EventCoding             *common.Coding                     `json:"eventCoding,omitempty"`
+	EventUri                *string                            `json:"eventUri,omitempty"`
+	EventUriElement         *common.Element                    `json:"_eventUri,omitempty"`
+	Experimental            *bool                              `json:"experimental,omitempty"`
+	ExperimentalElement     *common.Element                    `json:"_experimental,omitempty"`
+	Focus                   []MessageDefinitionFocus           `json:"focus,omitempty"`
+	Graph                   *string                            `json:"graph,omitempty"`
+	GraphElement            *common.Element                    `json:"_graph,omitempty"`
+	Identifier              []common.Identifier                `json:"identifier,omitempty"`
+	Jurisdiction            []common.CodeableConcept           `json:"jurisdiction,omitempty"`
+	Name                    *string                            `json:"name,omitempty"`
+	NameElement             *common.Element                    `json:"_name,omitempty"`
+	Parent                  []string                           `json:"parent,omitempty"`
+	ParentElement           []*common.Element                  `json:"_parent,omitempty"`
+	Publisher               *string                            `json:"publisher,omitempty"`
+	PublisherElement        *common.Element                    `json:"_publisher,omitempty"`
+	Purpose                 *string                            `json:"purpose,omitempty"`
+	PurposeElement          *common.Element                    `json:"_purpose,omitempty"`
+	Replaces                []string                           `json:"replaces,omitempty"`
+	ReplacesElement         []*common.Element                  `json:"_replaces,omitempty"`
+	ResponseRequired        *MessageDefinitionResponseRequired `json:"responseRequired,omitempty"`
+	ResponseRequiredElement *common.Element                    `json:"_responseRequired,omitempty"`
+	Status                  MessageDefinitionStatus            `json:"status"`
+	StatusElement           *common.Element                    `json:"_status,omitempty"`
+	Title                   *string                            `json:"title,omitempty"`
+	TitleElement            *common.Element                    `json:"_title,omitempty"`
+	Url                     *string                            `json:"url,omitempty"`
+	UrlElement              *common.Element                    `json:"_url,omitempty"`
+	UseContext              []UsageContext                     `json:"useContext,omitempty"`
+	Version                 *string                            `json:"version,omitempty"`
+	VersionElement          *common.Element                    `json:"_version,omitempty"`
 }
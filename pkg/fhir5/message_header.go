@@ -9,13 +9,15 @@ type MessageHeaderDestination struct {
 	common.BackboneElement
 
 	// URL for the destination
-	EndpointUrl *string `json:"endpointUrl,omitempty"`
+	EndpointUrl        *string         `json:"endpointUrl,omitempty"`
+	EndpointUrlElement *common.Element `json:"_endpointUrl,omitempty"`
 
 	// Reference to the destination endpoint
 	EndpointReference *common.Reference `json:"endpointReference,omitempty"`
 
 	// Name of the destination
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Specific person or department to receive the message
 	Receiver *common.Reference `json:"receiver,omitempty"`
@@ -32,19 +34,23 @@ type MessageHeaderSource struct {
 	Contact *ContactPoint `json:"contact,omitempty"`
 
 	// URL for the source
-	EndpointUrl *string `json:"endpointUrl,omitempty"`
+	EndpointUrl        *string         `json:"endpointUrl,omitempty"`
+	EndpointUrlElement *common.Element `json:"_endpointUrl,omitempty"`
 
 	// Reference to the source endpoint
 	EndpointReference *common.Reference `json:"endpointReference,omitempty"`
 
 	// Name of the source
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Software running at the source
-	Software *string `json:"software,omitempty"`
+	Software        *string         `json:"software,omitempty"`
+	SoftwareElement *common.Element `json:"_software,omitempty"`
 
 	// Version of the software
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
 
 // MessageHeaderResponseCode represents response codes
@@ -61,7 +67,8 @@ type MessageHeaderResponse struct {
 	common.BackboneElement
 
 	// Response code
-	Code MessageHeaderResponseCode `json:"code"`
+	Code        MessageHeaderResponseCode `json:"code"`
+	CodeElement *common.Element           `json:"_code,omitempty"`
 
 	// Details about the response
 	Details *common.Reference `json:"details,omitempty"`
@@ -81,7 +88,8 @@ type MessageHeader struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// Link to the message definition
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// Message destinations
 	Destination []MessageHeaderDestination `json:"destination,omitempty"`
@@ -90,7 +98,8 @@ type MessageHeader struct {
 	EventCoding *common.Coding `json:"eventCoding,omitempty"`
 
 	// Event the message represents (canonical)
-	EventCanonical *string `json:"eventCanonical,omitempty"`
+	EventCanonical        *string         `json:"eventCanonical,omitempty"`
+	EventCanonicalElement *common.Element `json:"_eventCanonical,omitempty"`
 
 	// The actual data content
 	Focus []common.Reference `json:"focus,omitempty"`
@@ -16,45 +16,57 @@ type MolecularSequenceRelativeStartingSequence struct {
 	Chromosome              *common.CodeableConcept                               `json:"chromosome,omitempty"`
 	GenomeAssembly          *common.CodeableConcept                               `json:"genomeAssembly,omitempty"`
 	Orientation             *MolecularSequenceRelativeStartingSequenceOrientation `json:"orientation,omitempty"`
+	OrientationElement      *common.Element                                       `json:"_orientation,omitempty"`
 	SequenceCodeableConcept *common.CodeableConcept                               `json:"sequenceCodeableConcept,omitempty"`
 	SequenceString          *string                                               `json:"sequenceString,omitempty"`
+	SequenceStringElement   *common.Element                                       `json:"_sequenceString,omitempty"`
 	SequenceReference       *common.Reference                                     `json:"sequenceReference,omitempty"`
 	Strand                  *MolecularSequenceRelativeStartingSequenceStrand      `json:"strand,omitempty"`
+	StrandElement           *common.Element                                       `json:"_strand,omitempty"`
 	WindowEnd               *int                                                  `json:"windowEnd,omitempty"`
+	WindowEndElement        *common.Element                                       `json:"_windowEnd,omitempty"`
 	WindowStart             *int                                                  `json:"windowStart,omitempty"`
+	WindowStartElement      *common.Element                                       `json:"_windowStart,omitempty"`
 }
 
 type MolecularSequenceRelativeEdit struct {
 	common.BackboneElement
 
-	End                 *int    `json:"end,omitempty"`
-	ReplacedSequence    *string `json:"replacedSequence,omitempty"`
-	ReplacementSequence *string `json:"replacementSequence,omitempty"`
-	Start               *int    `json:"start,omitempty"`
+	End                        *int            `json:"end,omitempty"`
+	EndElement                 *common.Element `json:"_end,omitempty"`
+	ReplacedSequence           *string         `json:"replacedSequence,omitempty"`
+	ReplacedSequenceElement    *common.Element `json:"_replacedSequence,omitempty"`
+	ReplacementSequence        *string         `json:"replacementSequence,omitempty"`
+	ReplacementSequenceElement *common.Element `json:"_replacementSequence,omitempty"`
+	Start                      *int            `json:"start,omitempty"`
+	StartElement               *common.Element `json:"_start,omitempty"`
 }
 
 type MolecularSequenceRelative struct {
 	common.BackboneElement
 
-	CoordinateSystem common.CodeableConcept                     `json:"coordinateSystem"`
-	Edit             []MolecularSequenceRelativeEdit            `json:"edit,omitempty"`
-	OrdinalPosition  *int                                       `json:"ordinalPosition,omitempty"`
-	SequenceRange    *Range                                     `json:"sequenceRange,omitempty"`
-	StartingSequence *MolecularSequenceRelativeStartingSequence `json:"startingSequence,omitempty"`
+	CoordinateSystem       common.CodeableConcept                     `json:"coordinateSystem"`
+	Edit                   []MolecularSequenceRelativeEdit            `json:"edit,omitempty"`
+	OrdinalPosition        *int                                       `json:"ordinalPosition,omitempty"`
+	OrdinalPositionElement *common.Element                            `json:"_ordinalPosition,omitempty"`
+	SequenceRange          *Range                                     `json:"sequenceRange,omitempty"`
+	StartingSequence       *MolecularSequenceRelativeStartingSequence `json:"startingSequence,omitempty"`
 }
 
 type MolecularSequence struct {
 	DomainResource
 
-	ResourceType string                      `json:"resourceType"` // Always "MolecularSequence"
-	Device       *common.Reference           `json:"device,omitempty"`
-	Focus        []common.Reference          `json:"focus,omitempty"`
-	Formatted    []Attachment                `json:"formatted,omitempty"`
-	Identifier   []common.Identifier         `json:"identifier,omitempty"`
-	Literal      *string                     `json:"literal,omitempty"`
-	Performer    *common.Reference           `json:"performer,omitempty"`
-	Relative     []MolecularSequenceRelative `json:"relative,omitempty"`
-	Specimen     *common.Reference           `json:"specimen,omitempty"`
-	Subject      *common.Reference           `json:"subject,omitempty"`
-	Type         *MolecularSequenceType      `json:"type,omitempty"`
+	ResourceType   string                      `json:"resourceType"` // Always "MolecularSequence"
+	Device         *common.Reference           `json:"device,omitempty"`
+	Focus          []common.Reference          `json:"focus,omitempty"`
+	Formatted      []Attachment                `json:"formatted,omitempty"`
+	Identifier     []common.Identifier         `json:"identifier,omitempty"`
+	Literal        *string                     `json:"literal,omitempty"`
+	LiteralElement *common.Element             `json:"_literal,omitempty"`
+	Performer      *common.Reference           `json:"performer,omitempty"`
+	Relative       []MolecularSequenceRelative `json:"relative,omitempty"`
+	Specimen       *common.Reference           `json:"specimen,omitempty"`
+	Subject        *common.Reference           `json:"subject,omitempty"`
+	Type           *MolecularSequenceType      `json:"type,omitempty"`
+	TypeElement    *common.Element             `json:"_type,omitempty"`
 }
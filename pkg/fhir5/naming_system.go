@@ -40,22 +40,27 @@ type NamingSystemUniqueId struct {
 	common.BackboneElement
 
 	// Indicates whether this identifier is endorsed by the official owner
-	Authoritative *bool `json:"authoritative,omitempty"`
+	Authoritative        *bool           `json:"authoritative,omitempty"`
+	AuthoritativeElement *common.Element `json:"_authoritative,omitempty"`
 
 	// Comment about the identifier
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// The period of time this identifier is valid
 	Period *common.Period `json:"period,omitempty"`
 
 	// Indicates whether this identifier is the "preferred" identifier
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 
 	// The type of identifier
-	Type NamingSystemUniqueIdType `json:"type"`
+	Type        NamingSystemUniqueIdType `json:"type"`
+	TypeElement *common.Element          `json:"_type,omitempty"`
 
 	// The string that should be sent over the wire
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // NamingSystem represents a namespace that issues unique symbols
@@ -66,7 +71,8 @@ type NamingSystem struct {
 	ResourceType string `json:"resourceType"` // Always "NamingSystem"
 
 	// The approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individual or organization primarily involved in the creation and maintenance
 	Author []ContactDetail `json:"author,omitempty"`
@@ -75,16 +81,20 @@ type NamingSystem struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Copyright statement
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Copyright holder and year(s)
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date when the naming system was published
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// Natural language description of the naming system
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -96,7 +106,8 @@ type NamingSystem struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// For testing purposes, not real usage
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -105,34 +116,42 @@ type NamingSystem struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// Indicates the purpose for the naming system
-	Kind NamingSystemKind `json:"kind"`
+	Kind        NamingSystemKind `json:"kind"`
+	KindElement *common.Element  `json:"_kind,omitempty"`
 
 	// Last review date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// Name for this naming system
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Name of the publisher
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// Why this naming system is defined
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Additional documentation, citations, etc.
-	RelatedArtifact []interface{} `json:"relatedArtifact,omitempty"`
+	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// Organization that is responsible for issuing identifiers or codes
-	Responsible *string `json:"responsible,omitempty"`
+	Responsible        *string         `json:"responsible,omitempty"`
+	ResponsibleElement *common.Element `json:"_responsible,omitempty"`
 
 	// Who reviewed the naming system
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// draft | active | retired | unknown
-	Status NamingSystemStatus `json:"status"`
+	Status        NamingSystemStatus `json:"status"`
+	StatusElement *common.Element    `json:"_status,omitempty"`
 
 	// Human-readable title
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// E.g. Education, Treatment, Assessment, etc.
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -144,11 +163,13 @@ type NamingSystem struct {
 	UniqueId []NamingSystemUniqueId `json:"uniqueId"`
 
 	// Canonical identifier for this naming system
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// Provides guidance on the use of the namespace
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// The context that the content is intended to support
-	UseContext []interface{} `json:"useContext,omitempty"`
+	UseContext []UsageContext `json:"useContext,omitempty"`
 }
@@ -21,13 +21,14 @@ const (
 // NutritionIntakeConsumedItem represents an item that was consumed
 type NutritionIntakeConsumedItem struct {
 	common.BackboneElement
-	Amount            *common.Quantity        `json:"amount,omitempty"`
-	NotConsumed       *bool                   `json:"notConsumed,omitempty"`
-	NotConsumedReason *common.CodeableConcept `json:"notConsumedReason,omitempty"`
-	NutritionProduct  CodeableReference       `json:"nutritionProduct"`
-	Rate              *common.Quantity        `json:"rate,omitempty"`
-	Schedule          *Timing                 `json:"schedule,omitempty"`
-	Type              common.CodeableConcept  `json:"type"`
+	Amount             *common.Quantity        `json:"amount,omitempty"`
+	NotConsumed        *bool                   `json:"notConsumed,omitempty"`
+	NotConsumedElement *common.Element         `json:"_notConsumed,omitempty"`
+	NotConsumedReason  *common.CodeableConcept `json:"notConsumedReason,omitempty"`
+	NutritionProduct   CodeableReference       `json:"nutritionProduct"`
+	Rate               *common.Quantity        `json:"rate,omitempty"`
+	Schedule           *Timing                 `json:"schedule,omitempty"`
+	Type               common.CodeableConcept  `json:"type"`
 }
 
 // NutritionIntakeIngredientLabel represents ingredient label information
@@ -47,27 +48,33 @@ type NutritionIntakePerformer struct {
 // NutritionIntake represents a record of food or fluid being taken by a patient
 type NutritionIntake struct {
 	DomainResource
-	ResourceType          string                           `json:"resourceType"` // Always "NutritionIntake"
-	BasedOn               []common.Reference               `json:"basedOn,omitempty"`
-	Code                  *common.CodeableConcept          `json:"code,omitempty"`
-	ConsumedItem          []NutritionIntakeConsumedItem    `json:"consumedItem"`
-	DerivedFrom           []common.Reference               `json:"derivedFrom,omitempty"`
-	Encounter             *common.Reference                `json:"encounter,omitempty"`
-	Identifier            []common.Identifier              `json:"identifier,omitempty"`
-	IngredientLabel       []NutritionIntakeIngredientLabel `json:"ingredientLabel,omitempty"`
-	InstantiatesCanonical []string                         `json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                         `json:"instantiatesUri,omitempty"`
-	Location              *common.Reference                `json:"location,omitempty"`
-	Note                  []Annotation                     `json:"note,omitempty"`
-	OccurrenceDateTime    *string                          `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod      *common.Period                   `json:"occurrencePeriod,omitempty"`
-	PartOf                []common.Reference               `json:"partOf,omitempty"`
-	Performer             []NutritionIntakePerformer       `json:"performer,omitempty"`
-	Reason                []CodeableReference              `json:"reason,omitempty"`
-	Recorded              *string                          `json:"recorded,omitempty"`
-	ReportedBoolean       *bool                            `json:"reportedBoolean,omitempty"`
-	ReportedReference     *common.Reference                `json:"reportedReference,omitempty"`
-	Status                NutritionIntakeStatus            `json:"status"`
-	StatusReason          []common.CodeableConcept         `json:"statusReason,omitempty"`
-	Subject               common.Reference                 `json:"subject"`
+	ResourceType                 string                           `json:"resourceType"` // Always "NutritionIntake"
+	BasedOn                      []common.Reference               `json:"basedOn,omitempty"`
+	Code                         *common.CodeableConcept          `json:"code,omitempty"`
+	ConsumedItem                 []NutritionIntakeConsumedItem    `json:"consumedItem"`
+	DerivedFrom                  []common.Reference               `json:"derivedFrom,omitempty"`
+	Encounter                    *common.Reference                `json:"encounter,omitempty"`
+	Identifier                   []common.Identifier              `json:"identifier,omitempty"`
+	IngredientLabel              []NutritionIntakeIngredientLabel `json:"ingredientLabel,omitempty"`
+	InstantiatesCanonical        []string                         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element                `json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*common.Element                `json:"_instantiatesUri,omitempty"`
+	Location                     *common.Reference                `json:"location,omitempty"`
+	Note                         []Annotation                     `json:"note,omitempty"`
+	OccurrenceDateTime           *common.DateTime                 `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement    *common.Element                  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod             *common.Period                   `json:"occurrencePeriod,omitempty"`
+	PartOf                       []common.Reference               `json:"partOf,omitempty"`
+	Performer                    []NutritionIntakePerformer       `json:"performer,omitempty"`
+	Reason                       []CodeableReference              `json:"reason,omitempty"`
+	Recorded                     *common.DateTime                 `json:"recorded,omitempty"`
+	RecordedElement              *common.Element                  `json:"_recorded,omitempty"`
+	ReportedBoolean              *bool                            `json:"reportedBoolean,omitempty"`
+	ReportedBooleanElement       *common.Element                  `json:"_reportedBoolean,omitempty"`
+	ReportedReference            *common.Reference                `json:"reportedReference,omitempty"`
+	Status                       NutritionIntakeStatus            `json:"status"`
+	StatusElement                *common.Element                  `json:"_status,omitempty"`
+	StatusReason                 []common.CodeableConcept         `json:"statusReason,omitempty"`
+	Subject                      common.Reference                 `json:"subject"`
 }
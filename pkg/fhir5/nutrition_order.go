@@ -43,31 +43,216 @@ const (
 	NutritionOrderPriorityStat    NutritionOrderPriority = "stat"
 )
 
+// NutritionOrderOralDiet represents oral diet components
+type NutritionOrderOralDiet struct {
+	common.BackboneElement
+
+	// Type of oral diet or diet restrictions that describe what can be consumed orally
+	Type []common.CodeableConcept `json:"type,omitempty"`
+
+	// Scheduling information for oral diets
+	Schedule *NutritionOrderOralDietSchedule `json:"schedule,omitempty"`
+
+	// Required nutrient modifications
+	Nutrient []NutritionOrderOralDietNutrient `json:"nutrient,omitempty"`
+
+	// Required texture modifications
+	Texture []NutritionOrderOralDietTexture `json:"texture,omitempty"`
+
+	// The required consistency of fluids and liquids provided to the patient
+	FluidConsistencyType []common.CodeableConcept `json:"fluidConsistencyType,omitempty"`
+
+	// Instructions or additional information about the oral diet
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
+}
+
+// NutritionOrderOralDietSchedule represents scheduling information for oral diets
+type NutritionOrderOralDietSchedule struct {
+	common.BackboneElement
+
+	// Scheduled frequency of diet
+	Timing []Timing `json:"timing,omitempty"`
+
+	// Take 'as needed'
+	AsNeeded        *bool           `json:"asNeeded,omitempty"`
+	AsNeededElement *common.Element `json:"_asNeeded,omitempty"`
+
+	// Take 'as needed' for x
+	AsNeededFor *common.CodeableConcept `json:"asNeededFor,omitempty"`
+}
+
+// NutritionOrderOralDietNutrient represents required nutrient modifications
+type NutritionOrderOralDietNutrient struct {
+	common.BackboneElement
+
+	// Type of nutrient that is being modified
+	Modifier *common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Quantity of the specified nutrient
+	Amount *common.Quantity `json:"amount,omitempty"`
+}
+
+// NutritionOrderOralDietTexture represents required texture modifications
+type NutritionOrderOralDietTexture struct {
+	common.BackboneElement
+
+	// Code to indicate how to alter the texture of the foods, e.g. pureed
+	Modifier *common.CodeableConcept `json:"modifier,omitempty"`
+
+	// Concepts that are used to identify an entity that is ingested for nutritional purposes
+	FoodType *common.CodeableConcept `json:"foodType,omitempty"`
+}
+
+// NutritionOrderSupplement represents supplement components
+type NutritionOrderSupplement struct {
+	common.BackboneElement
+
+	// Type of supplement product requested
+	Type *CodeableReference `json:"type,omitempty"`
+
+	// Product or brand name of the nutritional supplement
+	ProductName        *string         `json:"productName,omitempty"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
+
+	// Scheduling information for supplements
+	Schedule *NutritionOrderSupplementSchedule `json:"schedule,omitempty"`
+
+	// Amount of the nutritional supplement
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Instructions or additional information about the oral supplement
+	Instruction        *string         `json:"instruction,omitempty"`
+	InstructionElement *common.Element `json:"_instruction,omitempty"`
+}
+
+// NutritionOrderSupplementSchedule represents scheduling information for supplements
+type NutritionOrderSupplementSchedule struct {
+	common.BackboneElement
+
+	// Scheduled frequency of diet
+	Timing []Timing `json:"timing,omitempty"`
+
+	// Take 'as needed'
+	AsNeeded        *bool           `json:"asNeeded,omitempty"`
+	AsNeededElement *common.Element `json:"_asNeeded,omitempty"`
+
+	// Take 'as needed' for x
+	AsNeededFor *common.CodeableConcept `json:"asNeededFor,omitempty"`
+}
+
+// NutritionOrderEnteralFormula represents enteral formula components
+type NutritionOrderEnteralFormula struct {
+	common.BackboneElement
+
+	// Type of enteral or infant formula
+	BaseFormulaType *CodeableReference `json:"baseFormulaType,omitempty"`
+
+	// Product or brand name of the enteral or infant formula
+	BaseFormulaProductName        *string         `json:"baseFormulaProductName,omitempty"`
+	BaseFormulaProductNameElement *common.Element `json:"_baseFormulaProductName,omitempty"`
+
+	// Intended type of device for the administration
+	DeliveryDevice []CodeableReference `json:"deliveryDevice,omitempty"`
+
+	// Components to add to the feeding
+	Additive []NutritionOrderEnteralFormulaAdditive `json:"additive,omitempty"`
+
+	// Amount of energy per specified volume that is required
+	CaloricDensity *common.Quantity `json:"caloricDensity,omitempty"`
+
+	// How the formula should enter the patient's gastrointestinal tract
+	RouteOfAdministration *common.CodeableConcept `json:"routeOfAdministration,omitempty"`
+
+	// Formula feeding instruction as structured data
+	Administration []NutritionOrderEnteralFormulaAdministration `json:"administration,omitempty"`
+
+	// Upper limit on formula volume per unit of time
+	MaxVolumeToDeliver *common.Quantity `json:"maxVolumeToDeliver,omitempty"`
+
+	// Formula feeding instructions expressed as text
+	AdministrationInstruction        *string         `json:"administrationInstruction,omitempty"`
+	AdministrationInstructionElement *common.Element `json:"_administrationInstruction,omitempty"`
+}
+
+// NutritionOrderEnteralFormulaAdditive represents components to add to the feeding
+type NutritionOrderEnteralFormulaAdditive struct {
+	common.BackboneElement
+
+	// Type of modular component to add to the feeding
+	Type *CodeableReference `json:"type,omitempty"`
+
+	// Product or brand name of the modular additive
+	ProductName        *string         `json:"productName,omitempty"`
+	ProductNameElement *common.Element `json:"_productName,omitempty"`
+
+	// Amount of additive to be given or mixed in
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+}
+
+// NutritionOrderEnteralFormulaAdministration represents formula feeding instruction as structured data
+type NutritionOrderEnteralFormulaAdministration struct {
+	common.BackboneElement
+
+	// Scheduling information for enteral formula products
+	Schedule *NutritionOrderEnteralFormulaAdministrationSchedule `json:"schedule,omitempty"`
+
+	// The volume of formula to provide
+	Quantity *common.Quantity `json:"quantity,omitempty"`
+
+	// Speed with which the formula is provided per period of time
+	RateQuantity *common.Quantity `json:"rateQuantity,omitempty"`
+	RateRatio    *Ratio           `json:"rateRatio,omitempty"`
+}
+
+// NutritionOrderEnteralFormulaAdministrationSchedule represents scheduling information for enteral formula products
+type NutritionOrderEnteralFormulaAdministrationSchedule struct {
+	common.BackboneElement
+
+	// Scheduled frequency of enteral formula
+	Timing []Timing `json:"timing,omitempty"`
+
+	// Take 'as needed'
+	AsNeeded        *bool           `json:"asNeeded,omitempty"`
+	AsNeededElement *common.Element `json:"_asNeeded,omitempty"`
+
+	// Take 'as needed' for x
+	AsNeededFor *common.CodeableConcept `json:"asNeededFor,omitempty"`
+}
+
 // NutritionOrder represents a request for a diet, oral nutritional supplement, or enteral or parenteral formula
 type NutritionOrder struct {
 	DomainResource
-	ResourceType           string                   `json:"resourceType"` // Always "NutritionOrder"
-	AllergyIntolerance     []common.Reference       `json:"allergyIntolerance,omitempty"`
-	BasedOn                []common.Reference       `json:"basedOn,omitempty"`
-	DateTime               string                   `json:"dateTime"`
-	Encounter              *common.Reference        `json:"encounter,omitempty"`
-	EnteralFormula         *interface{}             `json:"enteralFormula,omitempty"`
-	ExcludeFoodModifier    []common.CodeableConcept `json:"excludeFoodModifier,omitempty"`
-	FoodPreferenceModifier []common.CodeableConcept `json:"foodPreferenceModifier,omitempty"`
-	GroupIdentifier        *common.Identifier       `json:"groupIdentifier,omitempty"`
-	Identifier             []common.Identifier      `json:"identifier,omitempty"`
-	Instantiates           []string                 `json:"instantiates,omitempty"`
-	InstantiatesCanonical  []string                 `json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri        []string                 `json:"instantiatesUri,omitempty"`
-	Intent                 NutritionOrderIntent     `json:"intent"`
-	Note                   []Annotation             `json:"note,omitempty"`
-	OralDiet               *interface{}             `json:"oralDiet,omitempty"`
-	Orderer                *common.Reference        `json:"orderer,omitempty"`
-	OutsideFoodAllowed     *bool                    `json:"outsideFoodAllowed,omitempty"`
-	Performer              []CodeableReference      `json:"performer,omitempty"`
-	Priority               *NutritionOrderPriority  `json:"priority,omitempty"`
-	Status                 NutritionOrderStatus     `json:"status"`
-	Subject                common.Reference         `json:"subject"`
-	Supplement             []interface{}            `json:"supplement,omitempty"`
-	SupportingInformation  []common.Reference       `json:"supportingInformation,omitempty"`
+	ResourceType                 string                        `json:"resourceType"` // Always "NutritionOrder"
+	AllergyIntolerance           []common.Reference            `json:"allergyIntolerance,omitempty"`
+	BasedOn                      []common.Reference            `json:"basedOn,omitempty"`
+	DateTime                     common.DateTime               `json:"dateTime"`
+	DateTimeElement              *common.Element               `json:"_dateTime,omitempty"`
+	Encounter                    *common.Reference             `json:"encounter,omitempty"`
+	EnteralFormula               *NutritionOrderEnteralFormula `json:"enteralFormula,omitempty"`
+	ExcludeFoodModifier          []common.CodeableConcept      `json:"excludeFoodModifier,omitempty"`
+	FoodPreferenceModifier       []common.CodeableConcept      `json:"foodPreferenceModifier,omitempty"`
+	GroupIdentifier              *common.Identifier            `json:"groupIdentifier,omitempty"`
+	Identifier                   []common.Identifier           `json:"identifier,omitempty"`
+	Instantiates                 []string                      `json:"instantiates,omitempty"`
+	InstantiatesElement          []*common.Element             `json:"_instantiates,omitempty"`
+	InstantiatesCanonical        []string                      `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element             `json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                      `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*common.Element             `json:"_instantiatesUri,omitempty"`
+	Intent                       NutritionOrderIntent          `json:"intent"`
+	IntentElement                *common.Element               `json:"_intent,omitempty"`
+	Note                         []Annotation                  `json:"note,omitempty"`
+	OralDiet                     *NutritionOrderOralDiet       `json:"oralDiet,omitempty"`
+	Orderer                      *common.Reference             `json:"orderer,omitempty"`
+	OutsideFoodAllowed           *bool                         `json:"outsideFoodAllowed,omitempty"`
+	OutsideFoodAllowedElement    *common.Element               `json:"_outsideFoodAllowed,omitempty"`
+	Performer                    []CodeableReference           `json:"performer,omitempty"`
+	Priority                     *NutritionOrderPriority       `json:"priority,omitempty"`
+	PriorityElement              *common.Element               `json:"_priority,omitempty"`
+	Status                       NutritionOrderStatus          `json:"status"`
+	StatusElement                *common.Element               `json:"_status,omitempty"`
+	Subject                      common.Reference              `json:"subject"`
+	Supplement                   []NutritionOrderSupplement    `json:"supplement,omitempty"`
+	SupportingInformation        []common.Reference            `json:"supportingInformation,omitempty"`
 }
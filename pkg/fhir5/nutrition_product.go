@@ -43,12 +43,15 @@ type NutritionProductCharacteristic struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The description of the characteristic
-	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
-	ValueString          *string                 `json:"valueString,omitempty"`
-	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueBase64Binary    *string                 `json:"valueBase64Binary,omitempty"`
-	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
-	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
+	ValueString              *string                 `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element         `json:"_valueString,omitempty"`
+	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
+	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element         `json:"_valueBase64Binary,omitempty"`
+	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
+	ValueBoolean             *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element         `json:"_valueBoolean,omitempty"`
 }
 
 // NutritionProductInstance represents conveys instance-level information about this product item
@@ -59,22 +62,26 @@ type NutritionProductInstance struct {
 	BiologicalSourceEvent *common.Identifier `json:"biologicalSourceEvent,omitempty"`
 
 	// The time after which the product is no longer expected to be in proper condition
-	Expiry *string `json:"expiry,omitempty"`
+	Expiry        *common.DateTime `json:"expiry,omitempty"`
+	ExpiryElement *common.Element  `json:"_expiry,omitempty"`
 
 	// The identifier for the physical instance
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The identification of the batch or lot of the product
-	LotNumber *string `json:"lotNumber,omitempty"`
+	LotNumber        *string         `json:"lotNumber,omitempty"`
+	LotNumberElement *common.Element `json:"_lotNumber,omitempty"`
 
 	// The name for the specific product
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The amount of items or instances that the resource considers
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// The time after which the product is no longer expected to be in proper condition
-	UseBy *string `json:"useBy,omitempty"`
+	UseBy        *common.DateTime `json:"useBy,omitempty"`
+	UseByElement *common.Element  `json:"_useBy,omitempty"`
 }
 
 // NutritionProduct represents a product used for nutritional purposes
@@ -112,5 +119,6 @@ type NutritionProduct struct {
 	Nutrient []NutritionProductNutrient `json:"nutrient,omitempty"`
 
 	// active | inactive | entered-in-error
-	Status NutritionProductStatus `json:"status"`
+	Status        NutritionProductStatus `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
 }
@@ -36,10 +36,12 @@ type ObservationTriggeredBy struct {
 	Observation common.Reference `json:"observation"`
 
 	// Provides the reason why this observation was performed as a result of the observation(s) referenced
-	Reason *string `json:"reason,omitempty"`
+	Reason        *string         `json:"reason,omitempty"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
 
 	// The type of trigger
-	Type ObservationTriggeredByType `json:"type"`
+	Type        ObservationTriggeredByType `json:"type"`
+	TypeElement *common.Element            `json:"_type,omitempty"`
 }
 
 // ObservationReferenceRange represents reference range for observation values
@@ -62,7 +64,8 @@ type ObservationReferenceRange struct {
 	NormalValue *common.CodeableConcept `json:"normalValue,omitempty"`
 
 	// Text based reference range in an observation
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// This SHOULD be populated if there is more than one range
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -88,13 +91,18 @@ type ObservationComponent struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
 	ValueSampledData     *common.SampledData     `json:"valueSampledData,omitempty"`
-	ValueTime            *string                 `json:"valueTime,omitempty"`
-	ValueDateTime        *string                 `json:"valueDateTime,omitempty"`
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
@@ -135,10 +143,12 @@ type Observation struct {
 	Device *common.Reference `json:"device,omitempty"`
 
 	// At least a date should be present unless this observation is a historical report
-	EffectiveDateTime *string        `json:"effectiveDateTime,omitempty"`
-	EffectivePeriod   *common.Period `json:"effectivePeriod,omitempty"`
-	EffectiveTiming   *Timing        `json:"effectiveTiming,omitempty"`
-	EffectiveInstant  *string        `json:"effectiveInstant,omitempty"`
+	EffectiveDateTime        *common.DateTime `json:"effectiveDateTime,omitempty"`
+	EffectiveDateTimeElement *common.Element  `json:"_effectiveDateTime,omitempty"`
+	EffectivePeriod          *common.Period   `json:"effectivePeriod,omitempty"`
+	EffectiveTiming          *Timing          `json:"effectiveTiming,omitempty"`
+	EffectiveInstant         *common.Instant  `json:"effectiveInstant,omitempty"`
+	EffectiveInstantElement  *common.Element  `json:"_effectiveInstant,omitempty"`
 
 	// This will typically be the encounter the event occurred within
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -153,7 +163,8 @@ type Observation struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// ObservationDefinition can be referenced by its canonical url using instantiatesCanonical
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// ObservationDefinition can be referenced by its canonical url using instantiatesCanonical
 	InstantiatesReference *common.Reference `json:"instantiatesReference,omitempty"`
@@ -162,7 +173,8 @@ type Observation struct {
 	Interpretation []common.CodeableConcept `json:"interpretation,omitempty"`
 
 	// For Observations that don't require review and verification
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.Instant `json:"issued,omitempty"`
+	IssuedElement *common.Element `json:"_issued,omitempty"`
 
 	// Only used if not implicit in code for Observation.code
 	Method *common.CodeableConcept `json:"method,omitempty"`
@@ -183,7 +195,8 @@ type Observation struct {
 	Specimen *common.Reference `json:"specimen,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status ObservationStatus `json:"status"`
+	Status        ObservationStatus `json:"status"`
+	StatusElement *common.Element   `json:"_status,omitempty"`
 
 	// One would expect this element to be a cardinality of 1..1
 	Subject *common.Reference `json:"subject,omitempty"`
@@ -195,13 +208,18 @@ type Observation struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueString          *string                 `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element         `json:"_valueString,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueInteger         *int                    `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element         `json:"_valueInteger,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueRatio           *Ratio                  `json:"valueRatio,omitempty"`
 	ValueSampledData     *common.SampledData     `json:"valueSampledData,omitempty"`
-	ValueTime            *string                 `json:"valueTime,omitempty"`
-	ValueDateTime        *string                 `json:"valueDateTime,omitempty"`
+	ValueTime            *common.Time            `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element         `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime        `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element         `json:"_valueDateTime,omitempty"`
 	ValuePeriod          *common.Period          `json:"valuePeriod,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
@@ -14,6 +14,54 @@ const (
 	ObservationDefinitionStatusUnknown ObservationDefinitionStatus = "unknown"
 )
 
+// ObservationDefinitionQualifiedValue represents set of qualified values for observation results
+type ObservationDefinitionQualifiedValue struct {
+	common.BackboneElement
+
+	// Context qualifier for the set of qualified values
+	Context *common.CodeableConcept `json:"context,omitempty"`
+
+	// Targetted population for the set of qualified values
+	AppliesTo []common.CodeableConcept `json:"appliesTo,omitempty"`
+
+	// male | female | other | unknown
+	Gender        *string         `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
+
+	// Applicable age range for the set of qualified values
+	Age *Range `json:"age,omitempty"`
+
+	// Applicable gestational age range for the set of qualified values
+	GestationalAge *Range `json:"gestationalAge,omitempty"`
+
+	// Condition associated with the set of qualified values
+	Condition        *string         `json:"condition,omitempty"`
+	ConditionElement *common.Element `json:"_condition,omitempty"`
+
+	// reference | critical | absolute
+	RangeCategory        *string         `json:"rangeCategory,omitempty"`
+	RangeCategoryElement *common.Element `json:"_rangeCategory,omitempty"`
+
+	// The range for continuous or ordinal observations
+	Range *Range `json:"range,omitempty"`
+
+	// Value set of valid coded values as part of this set of qualified values
+	ValidCodedValueSet        *string         `json:"validCodedValueSet,omitempty"`
+	ValidCodedValueSetElement *common.Element `json:"_validCodedValueSet,omitempty"`
+
+	// Value set of normal coded values as part of this set of qualified values
+	NormalCodedValueSet        *string         `json:"normalCodedValueSet,omitempty"`
+	NormalCodedValueSetElement *common.Element `json:"_normalCodedValueSet,omitempty"`
+
+	// Value set of abnormal coded values as part of this set of qualified values
+	AbnormalCodedValueSet        *string         `json:"abnormalCodedValueSet,omitempty"`
+	AbnormalCodedValueSetElement *common.Element `json:"_abnormalCodedValueSet,omitempty"`
+
+	// Value set of critical coded values as part of this set of qualified values
+	CriticalCodedValueSet        *string         `json:"criticalCodedValueSet,omitempty"`
+	CriticalCodedValueSetElement *common.Element `json:"_criticalCodedValueSet,omitempty"`
+}
+
 // ObservationDefinitionComponent represents some observations have multiple component observations
 type ObservationDefinitionComponent struct {
 	common.BackboneElement
@@ -22,13 +70,14 @@ type ObservationDefinitionComponent struct {
 	Code common.CodeableConcept `json:"code"`
 
 	// The data types allowed for the value element
-	PermittedDataType []string `json:"permittedDataType,omitempty"`
+	PermittedDataType        []string          `json:"permittedDataType,omitempty"`
+	PermittedDataTypeElement []*common.Element `json:"_permittedDataType,omitempty"`
 
 	// Units allowed for the valueQuantity element
 	PermittedUnit []common.Coding `json:"permittedUnit,omitempty"`
 
 	// A set of qualified values associated with a context and conditions
-	QualifiedValue []interface{} `json:"qualifiedValue,omitempty"`
+	QualifiedValue []ObservationDefinitionQualifiedValue `json:"qualifiedValue,omitempty"`
 }
 
 // ObservationDefinition represents a definition of an observation
@@ -39,7 +88,8 @@ type ObservationDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "ObservationDefinition"
 
 	// The date may be more recent than the approval date
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// Only used if the defined observation is to be made directly on a body part
 	BodySite *common.CodeableConcept `json:"bodySite,omitempty"`
@@ -57,22 +107,28 @@ type ObservationDefinition struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Copyright statement relating to the ObservationDefinition
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The canonical URL pointing to another FHIR-defined ObservationDefinition
-	DerivedFromCanonical []string `json:"derivedFromCanonical,omitempty"`
+	DerivedFromCanonical        []string          `json:"derivedFromCanonical,omitempty"`
+	DerivedFromCanonicalElement []*common.Element `json:"_derivedFromCanonical,omitempty"`
 
 	// The URL pointing to an externally-defined observation definition
-	DerivedFromUri []string `json:"derivedFromUri,omitempty"`
+	DerivedFromUri        []string          `json:"derivedFromUri,omitempty"`
+	DerivedFromUriElement []*common.Element `json:"_derivedFromUri,omitempty"`
 
 	// This description can be used to capture details
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// When multiple occurrences of device are present
 	Device []common.Reference `json:"device,omitempty"`
@@ -81,7 +137,8 @@ type ObservationDefinition struct {
 	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
 
 	// Allows filtering of ObservationDefinition that are appropriate for use vs. not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// This ObservationDefinition defines a group observation
 	HasMember []common.Reference `json:"hasMember,omitempty"`
@@ -93,56 +150,67 @@ type ObservationDefinition struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this is usually after the approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// Only used if not implicit in observation code
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// An example of observation allowing multiple results
-	MultipleResultsAllowed *bool `json:"multipleResultsAllowed,omitempty"`
+	MultipleResultsAllowed        *bool           `json:"multipleResultsAllowed,omitempty"`
+	MultipleResultsAllowedElement *common.Element `json:"_multipleResultsAllowed,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of individual/organization/device that is expected to act
 	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
 
 	// The data types allowed for the value element
-	PermittedDataType []string `json:"permittedDataType,omitempty"`
+	PermittedDataType        []string          `json:"permittedDataType,omitempty"`
+	PermittedDataTypeElement []*common.Element `json:"_permittedDataType,omitempty"`
 
 	// Units allowed for the valueQuantity element
 	PermittedUnit []common.Coding `json:"permittedUnit,omitempty"`
 
 	// The preferred name to be used when reporting the results
-	PreferredReportName *string `json:"preferredReportName,omitempty"`
+	PreferredReportName        *string         `json:"preferredReportName,omitempty"`
+	PreferredReportNameElement *common.Element `json:"_preferredReportName,omitempty"`
 
 	// Helps establish the "authority/credibility" of the ObservationDefinition
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the ObservationDefinition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// A set of qualified values associated with a context and conditions
-	QualifiedValue []interface{} `json:"qualifiedValue,omitempty"`
+	QualifiedValue []ObservationDefinitionQualifiedValue `json:"qualifiedValue,omitempty"`
 
 	// Only used for in vitro observations
 	Specimen []common.Reference `json:"specimen,omitempty"`
 
 	// draft | active | retired | unknown
-	Status ObservationDefinitionStatus `json:"status"`
+	Status        ObservationDefinitionStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 
 	// Examples: person, animal, device, air, surface
 	Subject []common.CodeableConcept `json:"subject,omitempty"`
 
 	// A short, descriptive, user-friendly title
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid:, but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different observation definitions that have the same url
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
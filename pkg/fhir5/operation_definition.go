@@ -23,45 +23,93 @@ const (
 	OperationDefinitionStatusUnknown OperationDefinitionStatus = "unknown"
 )
 
+// OperationDefinitionParameterBinding represents valueSet details if this is coded
+type OperationDefinitionParameterBinding struct {
+	common.BackboneElement
+
+	// required | extensible | preferred | example
+	Strength        string          `json:"strength"`
+	StrengthElement *common.Element `json:"_strength,omitempty"`
+
+	// Source of value set
+	ValueSet        string          `json:"valueSet"`
+	ValueSetElement *common.Element `json:"_valueSet,omitempty"`
+}
+
+// OperationDefinitionParameterReferencedFrom represents references to this parameter
+type OperationDefinitionParameterReferencedFrom struct {
+	common.BackboneElement
+
+	// Referencing parameter
+	Source        string          `json:"source"`
+	SourceElement *common.Element `json:"_source,omitempty"`
+
+	// Element id of reference
+	SourceId        *string         `json:"sourceId,omitempty"`
+	SourceIdElement *common.Element `json:"_sourceId,omitempty"`
+}
+
 // OperationDefinitionParameter represents a parameter for an operation
 type OperationDefinitionParameter struct {
 	common.BackboneElement
 
 	// In previous versions of FHIR, there was an extension for this
-	AllowedType []string `json:"allowedType,omitempty"`
+	AllowedType        []string          `json:"allowedType,omitempty"`
+	AllowedTypeElement []*common.Element `json:"_allowedType,omitempty"`
 
 	// Binds to a value set if this parameter is coded
-	Binding *interface{} `json:"binding,omitempty"`
+	Binding *OperationDefinitionParameterBinding `json:"binding,omitempty"`
 
 	// Describes the meaning or use of this parameter
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 
 	// The maximum number of times this element is permitted to appear
-	Max string `json:"max"`
+	Max        string          `json:"max"`
+	MaxElement *common.Element `json:"_max,omitempty"`
 
 	// The minimum number of times this parameter SHALL appear
-	Min int `json:"min"`
+	Min        int             `json:"min"`
+	MinElement *common.Element `json:"_min,omitempty"`
 
 	// This name must be a token
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Query Definitions only have one output parameter, named "result"
 	Part []OperationDefinitionParameter `json:"part,omitempty"`
 
 	// Resolution applies if the referenced parameter exists
-	ReferencedFrom []interface{} `json:"referencedFrom,omitempty"`
+	ReferencedFrom []OperationDefinitionParameterReferencedFrom `json:"referencedFrom,omitempty"`
 
 	// If present, indicates that the parameter applies when the operation is being invoked
-	Scope []string `json:"scope,omitempty"`
+	Scope        []string          `json:"scope,omitempty"`
+	ScopeElement []*common.Element `json:"_scope,omitempty"`
 
 	// How the parameter is understood as a search parameter
-	SearchType *string `json:"searchType,omitempty"`
+	SearchType        *string         `json:"searchType,omitempty"`
+	SearchTypeElement *common.Element `json:"_searchType,omitempty"`
 
 	// The type for this parameter
-	Type *string `json:"type,omitempty"`
+	Type        *string         `json:"type,omitempty"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Whether this is an input or an output parameter
-	Use string `json:"use"`
+	Use        string          `json:"use"`
+	UseElement *common.Element `json:"_use,omitempty"`
+}
+
+// OperationDefinitionOverload represents define overloaded variants for when generating code
+type OperationDefinitionOverload struct {
+	common.BackboneElement
+
+	// Name of parameter to include in overload
+	ParameterName        []string          `json:"parameterName,omitempty"`
+	ParameterNameElement []*common.Element `json:"_parameterName,omitempty"`
+
+	// Comments to go on overload
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 }
 
 // OperationDefinition represents a formal computable definition of an operation
@@ -72,77 +120,97 @@ type OperationDefinition struct {
 	ResourceType string `json:"resourceType"` // Always "OperationDefinition"
 
 	// What http methods can be used for the operation
-	AffectsState *bool `json:"affectsState,omitempty"`
+	AffectsState        *bool           `json:"affectsState,omitempty"`
+	AffectsStateElement *common.Element `json:"_affectsState,omitempty"`
 
 	// A constrained profile can make optional parameters required or not used
-	Base *string `json:"base,omitempty"`
+	Base        *string         `json:"base,omitempty"`
+	BaseElement *common.Element `json:"_base,omitempty"`
 
 	// For maximum compatibility, use only lowercase ASCII characters
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Additional information about how to use this operation or named query
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// May be a web site, an email address, a telephone number, etc.
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of operation definition that are appropriate for use vs. not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Operations that are idempotent may be invoked by performing an HTTP GET
-	Idempotent *bool `json:"idempotent,omitempty"`
+	Idempotent        *bool           `json:"idempotent,omitempty"`
+	IdempotentElement *common.Element `json:"_idempotent,omitempty"`
 
 	// Indicates whether this operation can be invoked on a particular instance
-	Instance bool `json:"instance"`
+	Instance        bool            `json:"instance"`
+	InstanceElement *common.Element `json:"_instance,omitempty"`
 
 	// It may be possible for the operation definition to be used in jurisdictions
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// Named queries are invoked differently, and have different capabilities
-	Kind OperationDefinitionKind `json:"kind"`
+	Kind        OperationDefinitionKind `json:"kind"`
+	KindElement *common.Element         `json:"_kind,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The combinations are suggestions as to which sets of parameters to use together
-	Overload []interface{} `json:"overload,omitempty"`
+	Overload []OperationDefinitionOverload `json:"overload,omitempty"`
 
 	// Query Definitions only have one output parameter, named "result"
 	Parameter []OperationDefinitionParameter `json:"parameter,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the operation definition
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// If the type is an abstract resource then the operation can be invoked
-	Resource []string `json:"resource,omitempty"`
+	Resource        []string          `json:"resource,omitempty"`
+	ResourceElement []*common.Element `json:"_resource,omitempty"`
 
 	// draft | active | retired | unknown
-	Status OperationDefinitionStatus `json:"status"`
+	Status        OperationDefinitionStatus `json:"status"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
 
 	// Indicates whether this operation or named query can be invoked at the system level
-	System bool `json:"system"`
+	System        bool            `json:"system"`
+	SystemElement *common.Element `json:"_system,omitempty"`
 
 	// This name does not need to be machine-processing friendly
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Indicates whether this operation can be invoked at the resource type level
-	Type bool `json:"type"`
+	Type        bool            `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different operation definitions that have the same url
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
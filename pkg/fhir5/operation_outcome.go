@@ -21,22 +21,27 @@ type OperationOutcomeIssue struct {
 	common.BackboneElement
 
 	// Code values should align with the severity
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// A human readable description of the error issue
 	Details *common.CodeableConcept `json:"details,omitempty"`
 
 	// This may be a description of how a value is erroneous
-	Diagnostics *string `json:"diagnostics,omitempty"`
+	Diagnostics        *string         `json:"diagnostics,omitempty"`
+	DiagnosticsElement *common.Element `json:"_diagnostics,omitempty"`
 
 	// The root of the FHIRPath is the resource or bundle that generated OperationOutcome
-	Expression []string `json:"expression,omitempty"`
+	Expression        []string          `json:"expression,omitempty"`
+	ExpressionElement []*common.Element `json:"_expression,omitempty"`
 
 	// The root of the XPath is the resource or bundle that generated OperationOutcome
-	Location []string `json:"location,omitempty"`
+	Location        []string          `json:"location,omitempty"`
+	LocationElement []*common.Element `json:"_location,omitempty"`
 
 	// Indicates whether the issue indicates a variation from successful processing
-	Severity OperationOutcomeIssueSeverity `json:"severity"`
+	Severity        OperationOutcomeIssueSeverity `json:"severity"`
+	SeverityElement *common.Element               `json:"_severity,omitempty"`
 }
 
 // OperationOutcome represents a collection of error, warning, or information messages
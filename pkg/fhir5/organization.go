@@ -30,16 +30,19 @@ type Organization struct {
 	ResourceType string `json:"resourceType"` // Always "Organization"
 
 	// Whether the organization record is still in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// A list of alternate names that the organization is known as, or was known as in the past
-	Alias []string `json:"alias,omitempty"`
+	Alias        []string          `json:"alias,omitempty"`
+	AliasElement []*common.Element `json:"_alias,omitempty"`
 
 	// Official contact details for the organization
 	Contact []ExtendedContactDetail `json:"contact,omitempty"`
 
 	// Additional details about the organization that could be displayed as further information
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Technical endpoints providing access to services operated for the organization
 	Endpoint []common.Reference `json:"endpoint,omitempty"`
@@ -48,7 +51,8 @@ type Organization struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Name used for the organization
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The organization of which this organization forms a part
 	PartOf *common.Reference `json:"partOf,omitempty"`
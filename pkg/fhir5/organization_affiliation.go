@@ -13,7 +13,8 @@ type OrganizationAffiliation struct {
 	ResourceType string `json:"resourceType"` // Always "OrganizationAffiliation"
 
 	// If this value is false, you may refer to the period to see when the role was in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Definition of the role the participatingOrganization plays in the association
 	Code []common.CodeableConcept `json:"code,omitempty"`
@@ -14,8 +14,10 @@ type PackagedProductDefinitionPackagingProperty struct {
 	// A value for the characteristic
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueDate            *string                 `json:"valueDate,omitempty"`
+	ValueDate            *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element         `json:"_valueDate,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 }
 
@@ -38,7 +40,8 @@ type PackagedProductDefinitionPackaging struct {
 	AlternateMaterial []common.CodeableConcept `json:"alternateMaterial,omitempty"`
 
 	// Is this a part of the packaging rather than the packaging itself
-	ComponentPart *bool `json:"componentPart,omitempty"`
+	ComponentPart        *bool           `json:"componentPart,omitempty"`
+	ComponentPartElement *common.Element `json:"_componentPart,omitempty"`
 
 	// The item(s) within the packaging
 	ContainedItem []PackagedProductDefinitionPackagingContainedItem `json:"containedItem,omitempty"`
@@ -59,6 +62,17 @@ type PackagedProductDefinitionPackaging struct {
 	Property []PackagedProductDefinitionPackagingProperty `json:"property,omitempty"`
 }
 
+// PackagedProductDefinitionLegalStatusOfSupply represents the legal status of supply of the packaged item as classified by the regulator
+type PackagedProductDefinitionLegalStatusOfSupply struct {
+	common.BackboneElement
+
+	// The actual status of supply. In what situation this package type may be supplied for use
+	Code *common.CodeableConcept `json:"code,omitempty"`
+
+	// The place where the legal status of supply applies
+	Jurisdiction *common.CodeableConcept `json:"jurisdiction,omitempty"`
+}
+
 // PackagedProductDefinition represents a medically related item or items, in a container or package
 type PackagedProductDefinition struct {
 	DomainResource
@@ -76,25 +90,28 @@ type PackagedProductDefinition struct {
 	ContainedItemQuantity []common.Quantity `json:"containedItemQuantity,omitempty"`
 
 	// Identifies if the package contains different items
-	CopackagedIndicator *bool `json:"copackagedIndicator,omitempty"`
+	CopackagedIndicator        *bool           `json:"copackagedIndicator,omitempty"`
+	CopackagedIndicatorElement *common.Element `json:"_copackagedIndicator,omitempty"`
 
 	// Textual description
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// A unique identifier for this package as whole
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The legal status of supply of the packaged item
-	LegalStatusOfSupply []interface{} `json:"legalStatusOfSupply,omitempty"`
+	LegalStatusOfSupply []PackagedProductDefinitionLegalStatusOfSupply `json:"legalStatusOfSupply,omitempty"`
 
 	// Manufacturer of this package type
 	Manufacturer []common.Reference `json:"manufacturer,omitempty"`
 
 	// Allows specifying that an item is on the market for sale
-	MarketingStatus []interface{} `json:"marketingStatus,omitempty"`
+	MarketingStatus []MarketingStatus `json:"marketingStatus,omitempty"`
 
 	// A name for this package
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The product this package model relates to
 	PackageFor []common.Reference `json:"packageFor,omitempty"`
@@ -106,7 +123,8 @@ type PackagedProductDefinition struct {
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// The date at which the given status became applicable
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
 
 	// A high level category
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -2,66 +2,89 @@
 package fhir5
 
 import (
+	"encoding/json"
+
 	"github.com/d4l-data4life/go-fhir/pkg/common"
 )
 
 // ParametersParameter represents a parameter passed to or received from the operation
 type ParametersParameter struct {
 	common.Element
-	Name                     string                  `json:"name"`
-	ValueBase64Binary        *string                 `json:"valueBase64Binary,omitempty"`
-	ValueBoolean             *bool                   `json:"valueBoolean,omitempty"`
-	ValueCanonical           *string                 `json:"valueCanonical,omitempty"`
-	ValueCode                *string                 `json:"valueCode,omitempty"`
-	ValueDate                *string                 `json:"valueDate,omitempty"`
-	ValueDateTime            *string                 `json:"valueDateTime,omitempty"`
-	ValueDecimal             *float64                `json:"valueDecimal,omitempty"`
-	ValueId                  *string                 `json:"valueId,omitempty"`
-	ValueInstant             *string                 `json:"valueInstant,omitempty"`
-	ValueInteger             *int                    `json:"valueInteger,omitempty"`
-	ValueInteger64           *int64                  `json:"valueInteger64,omitempty"`
-	ValueMarkdown            *string                 `json:"valueMarkdown,omitempty"`
-	ValueOid                 *string                 `json:"valueOid,omitempty"`
-	ValuePositiveInt         *int                    `json:"valuePositiveInt,omitempty"`
-	ValueString              *string                 `json:"valueString,omitempty"`
-	ValueTime                *string                 `json:"valueTime,omitempty"`
-	ValueUnsignedInt         *int                    `json:"valueUnsignedInt,omitempty"`
-	ValueUri                 *string                 `json:"valueUri,omitempty"`
-	ValueUrl                 *string                 `json:"valueUrl,omitempty"`
-	ValueUuid                *string                 `json:"valueUuid,omitempty"`
-	ValueAddress             *Address                `json:"valueAddress,omitempty"`
-	ValueAge                 *common.Age             `json:"valueAge,omitempty"`
-	ValueAnnotation          *Annotation             `json:"valueAnnotation,omitempty"`
-	ValueAttachment          *Attachment             `json:"valueAttachment,omitempty"`
-	ValueCodeableConcept     *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
-	ValueCoding              *common.Coding          `json:"valueCoding,omitempty"`
-	ValueContactPoint        *ContactPoint           `json:"valueContactPoint,omitempty"`
-	ValueCount               *Count                  `json:"valueCount,omitempty"`
-	ValueDistance            *Distance               `json:"valueDistance,omitempty"`
-	ValueDuration            *Duration               `json:"valueDuration,omitempty"`
-	ValueHumanName           *HumanName              `json:"valueHumanName,omitempty"`
-	ValueIdentifier          *common.Identifier      `json:"valueIdentifier,omitempty"`
-	ValueMoney               *Money                  `json:"valueMoney,omitempty"`
-	ValuePeriod              *common.Period          `json:"valuePeriod,omitempty"`
-	ValueQuantity            *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueRange               *Range                  `json:"valueRange,omitempty"`
-	ValueRatio               *Ratio                  `json:"valueRatio,omitempty"`
-	ValueReference           *common.Reference       `json:"valueReference,omitempty"`
-	ValueSampledData         *interface{}            `json:"valueSampledData,omitempty"`
-	ValueSignature           *Signature              `json:"valueSignature,omitempty"`
-	ValueTiming              *Timing                 `json:"valueTiming,omitempty"`
-	ValueContactDetail       *ContactDetail          `json:"valueContactDetail,omitempty"`
-	ValueContributor         *interface{}            `json:"valueContributor,omitempty"`
-	ValueDataRequirement     *interface{}            `json:"valueDataRequirement,omitempty"`
-	ValueExpression          *Expression             `json:"valueExpression,omitempty"`
-	ValueParameterDefinition *interface{}            `json:"valueParameterDefinition,omitempty"`
-	ValueRelatedArtifact     *RelatedArtifact        `json:"valueRelatedArtifact,omitempty"`
-	ValueTriggerDefinition   *TriggerDefinition      `json:"valueTriggerDefinition,omitempty"`
-	ValueUsageContext        *UsageContext           `json:"valueUsageContext,omitempty"`
-	ValueDosage              *Dosage                 `json:"valueDosage,omitempty"`
-	ValueMeta                *Meta                   `json:"valueMeta,omitempty"`
-	Resource                 interface{}             `json:"resource,omitempty"`
-	Part                     []ParametersParameter   `json:"part,omitempty"`
+	Name                     string                      `json:"name"`
+	NameElement              *common.Element             `json:"_name,omitempty"`
+	ValueBase64Binary        *string                     `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element             `json:"_valueBase64Binary,omitempty"`
+	ValueBoolean             *bool                       `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element             `json:"_valueBoolean,omitempty"`
+	ValueCanonical           *string                     `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *common.Element             `json:"_valueCanonical,omitempty"`
+	ValueCode                *string                     `json:"valueCode,omitempty"`
+	ValueCodeElement         *common.Element             `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date                `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element             `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime            `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element             `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal             `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element             `json:"_valueDecimal,omitempty"`
+	ValueId                  *string                     `json:"valueId,omitempty"`
+	ValueIdElement           *common.Element             `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant             `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element             `json:"_valueInstant,omitempty"`
+	ValueInteger             *int                        `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element             `json:"_valueInteger,omitempty"`
+	ValueInteger64           *int64                      `json:"valueInteger64,omitempty"`
+	ValueInteger64Element    *common.Element             `json:"_valueInteger64,omitempty"`
+	ValueMarkdown            *string                     `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element             `json:"_valueMarkdown,omitempty"`
+	ValueOid                 *string                     `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element             `json:"_valueOid,omitempty"`
+	ValuePositiveInt         *int                        `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element             `json:"_valuePositiveInt,omitempty"`
+	ValueString              *string                     `json:"valueString,omitempty"`
+	ValueStringElement       *common.Element             `json:"_valueString,omitempty"`
+	ValueTime                *common.Time                `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element             `json:"_valueTime,omitempty"`
+	ValueUnsignedInt         *int                        `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element             `json:"_valueUnsignedInt,omitempty"`
+	ValueUri                 *string                     `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element             `json:"_valueUri,omitempty"`
+	ValueUrl                 *string                     `json:"valueUrl,omitempty"`
+	ValueUrlElement          *common.Element             `json:"_valueUrl,omitempty"`
+	ValueUuid                *string                     `json:"valueUuid,omitempty"`
+	ValueUuidElement         *common.Element             `json:"_valueUuid,omitempty"`
+	ValueAddress             *Address                    `json:"valueAddress,omitempty"`
+	ValueAge                 *common.Age                 `json:"valueAge,omitempty"`
+	ValueAnnotation          *Annotation                 `json:"valueAnnotation,omitempty"`
+	ValueAttachment          *Attachment                 `json:"valueAttachment,omitempty"`
+	ValueCodeableConcept     *common.CodeableConcept     `json:"valueCodeableConcept,omitempty"`
+	ValueCoding              *common.Coding              `json:"valueCoding,omitempty"`
+	ValueContactPoint        *ContactPoint               `json:"valueContactPoint,omitempty"`
+	ValueCount               *Count                      `json:"valueCount,omitempty"`
+	ValueDistance            *Distance                   `json:"valueDistance,omitempty"`
+	ValueDuration            *Duration                   `json:"valueDuration,omitempty"`
+	ValueHumanName           *HumanName                  `json:"valueHumanName,omitempty"`
+	ValueIdentifier          *common.Identifier          `json:"valueIdentifier,omitempty"`
+	ValueMoney               *Money                      `json:"valueMoney,omitempty"`
+	ValuePeriod              *common.Period              `json:"valuePeriod,omitempty"`
+	ValueQuantity            *common.Quantity            `json:"valueQuantity,omitempty"`
+	ValueRange               *Range                      `json:"valueRange,omitempty"`
+	ValueRatio               *Ratio                      `json:"valueRatio,omitempty"`
+	ValueReference           *common.Reference           `json:"valueReference,omitempty"`
+	ValueSampledData         *common.SampledData         `json:"valueSampledData,omitempty"`
+	ValueSignature           *Signature                  `json:"valueSignature,omitempty"`
+	ValueTiming              *Timing                     `json:"valueTiming,omitempty"`
+	ValueContactDetail       *ContactDetail              `json:"valueContactDetail,omitempty"`
+	ValueContributor         *common.Contributor         `json:"valueContributor,omitempty"`
+	ValueDataRequirement     *DataRequirement            `json:"valueDataRequirement,omitempty"`
+	ValueExpression          *Expression                 `json:"valueExpression,omitempty"`
+	ValueParameterDefinition *common.ParameterDefinition `json:"valueParameterDefinition,omitempty"`
+	ValueRelatedArtifact     *RelatedArtifact            `json:"valueRelatedArtifact,omitempty"`
+	ValueTriggerDefinition   *TriggerDefinition          `json:"valueTriggerDefinition,omitempty"`
+	ValueUsageContext        *UsageContext               `json:"valueUsageContext,omitempty"`
+	ValueDosage              *Dosage                     `json:"valueDosage,omitempty"`
+	ValueMeta                *Meta                       `json:"valueMeta,omitempty"`
+	Resource                 common.Resource             `json:"resource,omitempty"`
+	Part                     []ParametersParameter       `json:"part,omitempty"`
 }
 
 // Parameters represents a set of parameters
@@ -70,3 +93,23 @@ type Parameters struct {
 	ResourceType string                `json:"resourceType"` // Always "Parameters"
 	Parameter    []ParametersParameter `json:"parameter,omitempty"`
 }
+
+// UnmarshalJSON decodes the inline resource into the struct matching its resourceType
+func (p *ParametersParameter) UnmarshalJSON(data []byte) error {
+	type Alias ParametersParameter
+	aux := &struct {
+		*Alias
+		Resource json.RawMessage `json:"resource,omitempty"`
+	}{
+		Alias: (*Alias)(p),
+	}
+	if err := json.Unmarshal(data, aux); err != nil {
+		return err
+	}
+	resource, err := UnmarshalResource(aux.Resource)
+	if err != nil {
+		return err
+	}
+	p.Resource = resource
+	return nil
+}
@@ -42,7 +42,8 @@ type PatientContact struct {
 	Address *Address `json:"address,omitempty"`
 
 	// Administrative Gender
-	Gender *PatientGender `json:"gender,omitempty"`
+	Gender        *PatientGender  `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// Organization on behalf of which the contact is acting or for which the contact is working
 	Organization *common.Reference `json:"organization,omitempty"`
@@ -59,7 +60,8 @@ type PatientCommunication struct {
 	Language common.CodeableConcept `json:"language"`
 
 	// Indicates whether or not the patient prefers this language
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 }
 
 // PatientLink represents a link to another patient resource
@@ -70,7 +72,8 @@ type PatientLink struct {
 	Other common.Reference `json:"other"`
 
 	// The type of link between this patient resource and another patient resource
-	Type PatientLinkType `json:"type"`
+	Type        PatientLinkType `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 }
 
 // Patient represents a person receiving care or other health-related services
@@ -90,13 +93,22 @@ type Patient struct {
 	Telecom []ContactPoint `json:"telecom,omitempty"`
 
 	// The gender of the patient
-	Gender *PatientGender `json:"gender,omitempty"`
+	Gender        *PatientGender  `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// The date of birth for the patient
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
+
+	// Indicates if the individual is deceased or not
+	DeceasedBoolean         *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement  *common.Element  `json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime        *common.DateTime `json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement *common.Element  `json:"_deceasedDateTime,omitempty"`
 
 	// Indicates if the patient record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// A list of addresses for the patient
 	Address []Address `json:"address,omitempty"`
@@ -105,8 +117,10 @@ type Patient struct {
 	MaritalStatus *common.CodeableConcept `json:"maritalStatus,omitempty"`
 
 	// Multiple birth indicator and order
-	MultipleBirthBoolean *bool `json:"multipleBirthBoolean,omitempty"`
-	MultipleBirthInteger *int  `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthBoolean        *bool           `json:"multipleBirthBoolean,omitempty"`
+	MultipleBirthBooleanElement *common.Element `json:"_multipleBirthBoolean,omitempty"`
+	MultipleBirthInteger        *int            `json:"multipleBirthInteger,omitempty"`
+	MultipleBirthIntegerElement *common.Element `json:"_multipleBirthInteger,omitempty"`
 
 	// A list of photos of the patient
 	Photo []Attachment `json:"photo,omitempty"`
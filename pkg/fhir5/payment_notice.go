@@ -25,7 +25,8 @@ type PaymentNotice struct {
 	Amount Money `json:"amount"`
 
 	// The date when this resource was created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// The party who will receive or has received payment that is the subject of this notification
 	Payee *common.Reference `json:"payee,omitempty"`
@@ -34,7 +35,8 @@ type PaymentNotice struct {
 	Payer *common.Reference `json:"payer,omitempty"`
 
 	// A note that describes or explains the processing in a human readable form
-	PaymentDate *string `json:"paymentDate,omitempty"`
+	PaymentDate        *common.Date    `json:"paymentDate,omitempty"`
+	PaymentDateElement *common.Element `json:"_paymentDate,omitempty"`
 
 	// The date when the above payment action occurred
 	PaymentStatus *common.CodeableConcept `json:"paymentStatus,omitempty"`
@@ -49,7 +51,8 @@ type PaymentNotice struct {
 	Response *common.Reference `json:"response,omitempty"`
 
 	// The status of the resource instance
-	Status PaymentNoticeStatus `json:"status"`
+	Status        PaymentNoticeStatus `json:"status"`
+	StatusElement *common.Element     `json:"_status,omitempty"`
 
 	// The target of the payment notice
 	Target *common.Reference `json:"target,omitempty"`
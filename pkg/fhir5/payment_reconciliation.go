@@ -39,10 +39,12 @@ type PaymentReconciliationProcessNote struct {
 	common.BackboneElement
 
 	// The explanation or description associated with the processing
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 
 	// The business purpose of the note text
-	Type *PaymentReconciliationProcessNoteType `json:"type,omitempty"`
+	Type        *PaymentReconciliationProcessNoteType `json:"type,omitempty"`
+	TypeElement *common.Element                       `json:"_type,omitempty"`
 }
 
 // PaymentReconciliation represents a payment reconciliation
@@ -53,10 +55,12 @@ type PaymentReconciliation struct {
 	ResourceType string `json:"resourceType"` // Always "PaymentReconciliation"
 
 	// The date when the resource was created
-	Created *string `json:"created,omitempty"`
+	Created        *common.DateTime `json:"created,omitempty"`
+	CreatedElement *common.Element  `json:"_created,omitempty"`
 
 	// A human readable description of the status of the request for the reconciliation
-	Disposition *string `json:"disposition,omitempty"`
+	Disposition        *string         `json:"disposition,omitempty"`
+	DispositionElement *common.Element `json:"_disposition,omitempty"`
 
 	// The form code or additional code identifying the form, such as a document definition
 	FormCode *common.CodeableConcept `json:"formCode,omitempty"`
@@ -68,7 +72,8 @@ type PaymentReconciliation struct {
 	Issuer *common.Reference `json:"issuer,omitempty"`
 
 	// The outcome of a request for a reconciliation
-	Outcome *PaymentReconciliationOutcome `json:"outcome,omitempty"`
+	Outcome        *PaymentReconciliationOutcome `json:"outcome,omitempty"`
+	OutcomeElement *common.Element               `json:"_outcome,omitempty"`
 
 	// The period of time for which payments have been gathered into this bulk payment for settlement
 	Period *common.Period `json:"period,omitempty"`
@@ -83,11 +88,13 @@ type PaymentReconciliation struct {
 	Requestor *common.Reference `json:"requestor,omitempty"`
 
 	// The status of the resource instance
-	Status PaymentReconciliationStatus `json:"status"`
+	Status        PaymentReconciliationStatus `json:"status"`
+	StatusElement *common.Element             `json:"_status,omitempty"`
 
 	// Total payment amount as indicated on the financial instrument
 	TotalAmount Money `json:"totalAmount"`
 
 	// The date when the above payment action occurred
-	TransactionDate *string `json:"transactionDate,omitempty"`
+	TransactionDate        *common.DateTime `json:"transactionDate,omitempty"`
+	TransactionDateElement *common.Element  `json:"_transactionDate,omitempty"`
 }
@@ -29,7 +29,8 @@ type PermissionSubjectCharacteristic struct {
 	common.BackboneElement
 
 	// Whether the characteristic is excluded or included
-	Exclude *bool `json:"exclude,omitempty"`
+	Exclude        *bool           `json:"exclude,omitempty"`
+	ExcludeElement *common.Element `json:"_exclude,omitempty"`
 
 	// The period during which the characteristic is valid
 	Period *common.Period `json:"period,omitempty"`
@@ -41,6 +42,7 @@ type PermissionSubjectCharacteristic struct {
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueReference       *common.Reference       `json:"valueReference,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueExpression      *Expression             `json:"valueExpression,omitempty"`
 }
 
@@ -66,7 +68,8 @@ type Permission struct {
 	Asserter *common.Reference `json:"asserter,omitempty"`
 
 	// The date that permission was asserted
-	Date []*string `json:"date,omitempty"`
+	Date        []*common.DateTime `json:"date,omitempty"`
+	DateElement []*common.Element  `json:"_date,omitempty"`
 
 	// The regulatory grounds for the permission
 	Justification *PermissionJustification `json:"justification,omitempty"`
@@ -78,7 +81,8 @@ type Permission struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// The status of the permission
-	Status PermissionStatus `json:"status"`
+	Status        PermissionStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 
 	// The subject of the permission
 	Subject []PermissionSubject `json:"subject,omitempty"`
@@ -29,7 +29,8 @@ type PersonLink struct {
 	common.BackboneElement
 
 	// Level of assurance that this link is associated with the target resource
-	Assurance *PersonLinkAssurance `json:"assurance,omitempty"`
+	Assurance        *PersonLinkAssurance `json:"assurance,omitempty"`
+	AssuranceElement *common.Element      `json:"_assurance,omitempty"`
 
 	// The resource to which this actual person is associated
 	Target common.Reference `json:"target"`
@@ -43,16 +44,19 @@ type Person struct {
 	ResourceType string `json:"resourceType"` // Always "Person"
 
 	// Whether this person's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// One or more addresses for the person
 	Address []Address `json:"address,omitempty"`
 
 	// The date on which the person was born
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// Administrative Gender - the gender that the person is considered to have for administration and record keeping purposes
-	Gender *PersonGender `json:"gender,omitempty"`
+	Gender        *PersonGender   `json:"gender,omitempty"`
+	GenderElement *common.Element `json:"_gender,omitempty"`
 
 	// Identifier for a person within a particular scope
 	Identifier []common.Identifier `json:"identifier,omitempty"`
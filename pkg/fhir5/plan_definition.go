@@ -9,41 +9,58 @@ import (
 type PlanDefinition struct {
 	DomainResource
 
-	ResourceType           string                   `json:"resourceType"` // Always "PlanDefinition"
-	Url                    *string                  `json:"url,omitempty"`
-	Identifier             []common.Identifier      `json:"identifier,omitempty"`
-	Version                *string                  `json:"version,omitempty"`
-	VersionAlgorithmString *string                  `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding           `json:"versionAlgorithmCoding,omitempty"`
-	Name                   *string                  `json:"name,omitempty"`
-	Title                  *string                  `json:"title,omitempty"`
-	Subtitle               *string                  `json:"subtitle,omitempty"`
-	Type                   *common.CodeableConcept  `json:"type,omitempty"`
-	Status                 PublicationStatus        `json:"status"`
-	Experimental           *bool                    `json:"experimental,omitempty"`
-	SubjectCodeableConcept *common.CodeableConcept  `json:"subjectCodeableConcept,omitempty"`
-	SubjectReference       *common.Reference        `json:"subjectReference,omitempty"`
-	Date                   *string                  `json:"date,omitempty"`
-	Publisher              *string                  `json:"publisher,omitempty"`
-	Contact                []ContactDetail          `json:"contact,omitempty"`
-	Description            *string                  `json:"description,omitempty"`
-	UseContext             []UsageContext           `json:"useContext,omitempty"`
-	Jurisdiction           []common.CodeableConcept `json:"jurisdiction,omitempty"`
-	Purpose                *string                  `json:"purpose,omitempty"`
-	Usage                  *string                  `json:"usage,omitempty"`
-	Copyright              *string                  `json:"copyright,omitempty"`
-	ApprovalDate           *string                  `json:"approvalDate,omitempty"`
-	LastReviewDate         *string                  `json:"lastReviewDate,omitempty"`
-	EffectivePeriod        *common.Period           `json:"effectivePeriod,omitempty"`
-	Topic                  []common.CodeableConcept `json:"topic,omitempty"`
-	Author                 []ContactDetail          `json:"author,omitempty"`
-	Editor                 []ContactDetail          `json:"editor,omitempty"`
-	Reviewer               []ContactDetail          `json:"reviewer,omitempty"`
-	Endorser               []ContactDetail          `json:"endorser,omitempty"`
-	RelatedArtifact        []RelatedArtifact        `json:"relatedArtifact,omitempty"`
-	Library                []string                 `json:"library,omitempty"`
-	Goal                   []PlanDefinitionGoal     `json:"goal,omitempty"`
-	Action                 []PlanDefinitionAction   `json:"action,omitempty"`
+	ResourceType                  string                   `json:"resourceType"` // Always "PlanDefinition"
+	Url                           *string                  `json:"url,omitempty"`
+	UrlElement                    *common.Element          `json:"_url,omitempty"`
+	Identifier                    []common.Identifier      `json:"identifier,omitempty"`
+	Version                       *string                  `json:"version,omitempty"`
+	VersionElement                *common.Element          `json:"_version,omitempty"`
+	VersionAlgorithmString        *string                  `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element          `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding           `json:"versionAlgorithmCoding,omitempty"`
+	Name                          *string                  `json:"name,omitempty"`
+	NameElement                   *common.Element          `json:"_name,omitempty"`
+	Title                         *string                  `json:"title,omitempty"`
+	TitleElement                  *common.Element          `json:"_title,omitempty"`
+	Subtitle                      *string                  `json:"subtitle,omitempty"`
+	SubtitleElement               *common.Element          `json:"_subtitle,omitempty"`
+	Type                          *common.CodeableConcept  `json:"type,omitempty"`
+	Status                        PublicationStatus        `json:"status"`
+	StatusElement                 *common.Element          `json:"_status,omitempty"`
+	Experimental                  *bool                    `json:"experimental,omitempty"`
+	ExperimentalElement           *common.Element          `json:"_experimental,omitempty"`
+	SubjectCodeableConcept        *common.CodeableConcept  `json:"subjectCodeableConcept,omitempty"`
+	SubjectReference              *common.Reference        `json:"subjectReference,omitempty"`
+	Date                          *common.DateTime         `json:"date,omitempty"`
+	DateElement                   *common.Element          `json:"_date,omitempty"`
+	Publisher                     *string                  `json:"publisher,omitempty"`
+	PublisherElement              *common.Element          `json:"_publisher,omitempty"`
+	Contact                       []ContactDetail          `json:"contact,omitempty"`
+	Description                   *string                  `json:"description,omitempty"`
+	DescriptionElement            *common.Element          `json:"_description,omitempty"`
+	UseContext                    []UsageContext           `json:"useContext,omitempty"`
+	Jurisdiction                  []common.CodeableConcept `json:"jurisdiction,omitempty"`
+	Purpose                       *string                  `json:"purpose,omitempty"`
+	PurposeElement                *common.Element          `json:"_purpose,omitempty"`
+	Usage                         *string                  `json:"usage,omitempty"`
+	UsageElement                  *common.Element          `json:"_usage,omitempty"`
+	Copyright                     *string                  `json:"copyright,omitempty"`
+	CopyrightElement              *common.Element          `json:"_copyright,omitempty"`
+	ApprovalDate                  *common.Date             `json:"approvalDate,omitempty"`
+	ApprovalDateElement           *common.Element          `json:"_approvalDate,omitempty"`
+	LastReviewDate                *common.Date             `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement         *common.Element          `json:"_lastReviewDate,omitempty"`
+	EffectivePeriod               *common.Period           `json:"effectivePeriod,omitempty"`
+	Topic                         []common.CodeableConcept `json:"topic,omitempty"`
+	Author                        []ContactDetail          `json:"author,omitempty"`
+	Editor                        []ContactDetail          `json:"editor,omitempty"`
+	Reviewer                      []ContactDetail          `json:"reviewer,omitempty"`
+	Endorser                      []ContactDetail          `json:"endorser,omitempty"`
+	RelatedArtifact               []RelatedArtifact        `json:"relatedArtifact,omitempty"`
+	Library                       []string                 `json:"library,omitempty"`
+	LibraryElement                []*common.Element        `json:"_library,omitempty"`
+	Goal                          []PlanDefinitionGoal     `json:"goal,omitempty"`
+	Action                        []PlanDefinitionAction   `json:"action,omitempty"`
 }
 
 type PlanDefinitionGoal struct {
@@ -68,76 +85,97 @@ type PlanDefinitionGoalTarget struct {
 
 type PlanDefinitionAction struct {
 	common.BackboneElement
-	Prefix                 *string                             `json:"prefix,omitempty"`
-	Title                  *string                             `json:"title,omitempty"`
-	Description            *string                             `json:"description,omitempty"`
-	TextEquivalent         *string                             `json:"textEquivalent,omitempty"`
-	Priority               *common.CodeableConcept             `json:"priority,omitempty"`
-	Code                   []common.CodeableConcept            `json:"code,omitempty"`
-	Reason                 []common.CodeableConcept            `json:"reason,omitempty"`
-	Documentation          []RelatedArtifact                   `json:"documentation,omitempty"`
-	GoalId                 []string                            `json:"goalId,omitempty"`
-	SubjectCodeableConcept *common.CodeableConcept             `json:"subjectCodeableConcept,omitempty"`
-	SubjectReference       *common.Reference                   `json:"subjectReference,omitempty"`
-	Trigger                []TriggerDefinition                 `json:"trigger,omitempty"`
-	Condition              []PlanDefinitionActionCondition     `json:"condition,omitempty"`
-	Input                  []PlanDefinitionActionInput         `json:"input,omitempty"`
-	Output                 []PlanDefinitionActionOutput        `json:"output,omitempty"`
-	RelatedAction          []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty"`
-	TimingDateTime         *string                             `json:"timingDateTime,omitempty"`
-	TimingAge              *common.Age                         `json:"timingAge,omitempty"`
-	TimingPeriod           *common.Period                      `json:"timingPeriod,omitempty"`
-	TimingDuration         *Duration                           `json:"timingDuration,omitempty"`
-	TimingRange            *Range                              `json:"timingRange,omitempty"`
-	TimingTiming           *Timing                             `json:"timingTiming,omitempty"`
-	Participant            []PlanDefinitionActionParticipant   `json:"participant,omitempty"`
-	Type                   *common.CodeableConcept             `json:"type,omitempty"`
-	GroupingBehavior       *string                             `json:"groupingBehavior,omitempty"`
-	SelectionBehavior      *string                             `json:"selectionBehavior,omitempty"`
-	RequiredBehavior       *string                             `json:"requiredBehavior,omitempty"`
-	PrecheckBehavior       *string                             `json:"precheckBehavior,omitempty"`
-	CardinalityBehavior    *string                             `json:"cardinalityBehavior,omitempty"`
-	DefinitionCanonical    *string                             `json:"definitionCanonical,omitempty"`
-	DefinitionUri          *string                             `json:"definitionUri,omitempty"`
-	Transform              *string                             `json:"transform,omitempty"`
-	DynamicValue           []PlanDefinitionActionDynamicValue  `json:"dynamicValue,omitempty"`
-	Action                 []PlanDefinitionAction              `json:"action,omitempty"`
+	Prefix                     *string                             `json:"prefix,omitempty"`
+	PrefixElement              *common.Element                     `json:"_prefix,omitempty"`
+	Title                      *string                             `json:"title,omitempty"`
+	TitleElement               *common.Element                     `json:"_title,omitempty"`
+	Description                *string                             `json:"description,omitempty"`
+	DescriptionElement         *common.Element                     `json:"_description,omitempty"`
+	TextEquivalent             *string                             `json:"textEquivalent,omitempty"`
+	TextEquivalentElement      *common.Element                     `json:"_textEquivalent,omitempty"`
+	Priority                   *common.CodeableConcept             `json:"priority,omitempty"`
+	Code                       []common.CodeableConcept            `json:"code,omitempty"`
+	Reason                     []common.CodeableConcept            `json:"reason,omitempty"`
+	Documentation              []RelatedArtifact                   `json:"documentation,omitempty"`
+	GoalId                     []string                            `json:"goalId,omitempty"`
+	GoalIdElement              []*common.Element                   `json:"_goalId,omitempty"`
+	SubjectCodeableConcept     *common.CodeableConcept             `json:"subjectCodeableConcept,omitempty"`
+	SubjectReference           *common.Reference                   `json:"subjectReference,omitempty"`
+	Trigger                    []TriggerDefinition                 `json:"trigger,omitempty"`
+	Condition                  []PlanDefinitionActionCondition     `json:"condition,omitempty"`
+	Input                      []PlanDefinitionActionInput         `json:"input,omitempty"`
+	Output                     []PlanDefinitionActionOutput        `json:"output,omitempty"`
+	RelatedAction              []PlanDefinitionActionRelatedAction `json:"relatedAction,omitempty"`
+	TimingDateTime             *common.DateTime                    `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement      *common.Element                     `json:"_timingDateTime,omitempty"`
+	TimingAge                  *common.Age                         `json:"timingAge,omitempty"`
+	TimingPeriod               *common.Period                      `json:"timingPeriod,omitempty"`
+	TimingDuration             *Duration                           `json:"timingDuration,omitempty"`
+	TimingRange                *Range                              `json:"timingRange,omitempty"`
+	TimingTiming               *Timing                             `json:"timingTiming,omitempty"`
+	Participant                []PlanDefinitionActionParticipant   `json:"participant,omitempty"`
+	Type                       *common.CodeableConcept             `json:"type,omitempty"`
+	GroupingBehavior           *string                             `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement    *common.Element                     `json:"_groupingBehavior,omitempty"`
+	SelectionBehavior          *string                             `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement   *common.Element                     `json:"_selectionBehavior,omitempty"`
+	RequiredBehavior           *string                             `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement    *common.Element                     `json:"_requiredBehavior,omitempty"`
+	PrecheckBehavior           *string                             `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement    *common.Element                     `json:"_precheckBehavior,omitempty"`
+	CardinalityBehavior        *string                             `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                     `json:"_cardinalityBehavior,omitempty"`
+	DefinitionCanonical        *string                             `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element                     `json:"_definitionCanonical,omitempty"`
+	DefinitionUri              *string                             `json:"definitionUri,omitempty"`
+	DefinitionUriElement       *common.Element                     `json:"_definitionUri,omitempty"`
+	Transform                  *string                             `json:"transform,omitempty"`
+	TransformElement           *common.Element                     `json:"_transform,omitempty"`
+	DynamicValue               []PlanDefinitionActionDynamicValue  `json:"dynamicValue,omitempty"`
+	Action                     []PlanDefinitionAction              `json:"action,omitempty"`
 }
 
 type PlanDefinitionActionCondition struct {
 	common.BackboneElement
-	Kind       string      `json:"kind"`
-	Expression *Expression `json:"expression,omitempty"`
+	Kind        string          `json:"kind"`
+	KindElement *common.Element `json:"_kind,omitempty"`
+	Expression  *Expression     `json:"expression,omitempty"`
 }
 
 type PlanDefinitionActionInput struct {
 	common.BackboneElement
-	Title       *string          `json:"title,omitempty"`
-	Requirement *DataRequirement `json:"requirement,omitempty"`
+	Title        *string          `json:"title,omitempty"`
+	TitleElement *common.Element  `json:"_title,omitempty"`
+	Requirement  *DataRequirement `json:"requirement,omitempty"`
 }
 
 type PlanDefinitionActionOutput struct {
 	common.BackboneElement
-	Title       *string          `json:"title,omitempty"`
-	Requirement *DataRequirement `json:"requirement,omitempty"`
+	Title        *string          `json:"title,omitempty"`
+	TitleElement *common.Element  `json:"_title,omitempty"`
+	Requirement  *DataRequirement `json:"requirement,omitempty"`
 }
 
 type PlanDefinitionActionRelatedAction struct {
 	common.BackboneElement
-	TargetId       string    `json:"targetId"`
-	Relationship   string    `json:"relationship"`
-	OffsetDuration *Duration `json:"offsetDuration,omitempty"`
-	OffsetRange    *Range    `json:"offsetRange,omitempty"`
+	TargetId            string          `json:"targetId"`
+	TargetIdElement     *common.Element `json:"_targetId,omitempty"`
+	Relationship        string          `json:"relationship"`
+	RelationshipElement *common.Element `json:"_relationship,omitempty"`
+	OffsetDuration      *Duration       `json:"offsetDuration,omitempty"`
+	OffsetRange         *Range          `json:"offsetRange,omitempty"`
 }
 
 type PlanDefinitionActionParticipant struct {
 	common.BackboneElement
-	Type string                  `json:"type"`
-	Role *common.CodeableConcept `json:"role,omitempty"`
+	Type        string                  `json:"type"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
+	Role        *common.CodeableConcept `json:"role,omitempty"`
 }
 
 type PlanDefinitionActionDynamicValue struct {
 	common.BackboneElement
-	Path       string      `json:"path"`
-	Expression *Expression `json:"expression,omitempty"`
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+	Expression  *Expression     `json:"expression,omitempty"`
 }
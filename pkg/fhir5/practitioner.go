@@ -13,7 +13,8 @@ type PractitionerCommunication struct {
 	Language common.CodeableConcept `json:"language"`
 
 	// Language preference indicator
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 }
 
 // PractitionerQualification represents qualifications obtained by training and certification
@@ -41,23 +42,28 @@ type Practitioner struct {
 	ResourceType string `json:"resourceType"` // Always "Practitioner"
 
 	// Whether this practitioner's record is in active use
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// Addresses where the practitioner can be found or visited or to which mail can be delivered
 	Address []Address `json:"address,omitempty"`
 
 	// The date of birth for the practitioner
-	BirthDate *string `json:"birthDate,omitempty"`
+	BirthDate        *common.Date    `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element `json:"_birthDate,omitempty"`
 
 	// A language the practitioner can use in patient communication (R5 uses structured communication)
 	Communication []PractitionerCommunication `json:"communication,omitempty"`
 
 	// Indicates if the practitioner is deceased (R5 feature)
-	DeceasedBoolean  *bool   `json:"deceasedBoolean,omitempty"`
-	DeceasedDateTime *string `json:"deceasedDateTime,omitempty"`
+	DeceasedBoolean         *bool            `json:"deceasedBoolean,omitempty"`
+	DeceasedBooleanElement  *common.Element  `json:"_deceasedBoolean,omitempty"`
+	DeceasedDateTime        *common.DateTime `json:"deceasedDateTime,omitempty"`
+	DeceasedDateTimeElement *common.Element  `json:"_deceasedDateTime,omitempty"`
 
 	// Administrative Gender - the gender that the person is considered to have for administration and record keeping purposes
-	Gender *PractitionerGender `json:"gender,omitempty"`
+	Gender        *PractitionerGender `json:"gender,omitempty"`
+	GenderElement *common.Element     `json:"_gender,omitempty"`
 
 	// An identifier that applies to this person in this role
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -9,33 +9,40 @@ import (
 type PractitionerRole struct {
 	DomainResource
 
-	ResourceType           string                          `json:"resourceType"` // Always "PractitionerRole"
-	Identifier             []common.Identifier             `json:"identifier,omitempty"`
-	Active                 *bool                           `json:"active,omitempty"`
-	Period                 *common.Period                  `json:"period,omitempty"`
-	Practitioner           *common.Reference               `json:"practitioner,omitempty"`
-	Organization           *common.Reference               `json:"organization,omitempty"`
-	Code                   []common.CodeableConcept        `json:"code,omitempty"`
-	Specialty              []common.CodeableConcept        `json:"specialty,omitempty"`
-	Location               []common.Reference              `json:"location,omitempty"`
-	HealthcareService      []common.Reference              `json:"healthcareService,omitempty"`
-	Telecom                []ContactPoint                  `json:"telecom,omitempty"`
-	AvailableTime          []PractitionerRoleAvailableTime `json:"availableTime,omitempty"`
-	NotAvailable           []PractitionerRoleNotAvailable  `json:"notAvailable,omitempty"`
-	AvailabilityExceptions *string                         `json:"availabilityExceptions,omitempty"`
-	Endpoint               []common.Reference              `json:"endpoint,omitempty"`
+	ResourceType                  string                          `json:"resourceType"` // Always "PractitionerRole"
+	Identifier                    []common.Identifier             `json:"identifier,omitempty"`
+	Active                        *bool                           `json:"active,omitempty"`
+	ActiveElement                 *common.Element                 `json:"_active,omitempty"`
+	Period                        *common.Period                  `json:"period,omitempty"`
+	Practitioner                  *common.Reference               `json:"practitioner,omitempty"`
+	Organization                  *common.Reference               `json:"organization,omitempty"`
+	Code                          []common.CodeableConcept        `json:"code,omitempty"`
+	Specialty                     []common.CodeableConcept        `json:"specialty,omitempty"`
+	Location                      []common.Reference              `json:"location,omitempty"`
+	HealthcareService             []common.Reference              `json:"healthcareService,omitempty"`
+	Telecom                       []ContactPoint                  `json:"telecom,omitempty"`
+	AvailableTime                 []PractitionerRoleAvailableTime `json:"availableTime,omitempty"`
+	NotAvailable                  []PractitionerRoleNotAvailable  `json:"notAvailable,omitempty"`
+	AvailabilityExceptions        *string                         `json:"availabilityExceptions,omitempty"`
+	AvailabilityExceptionsElement *common.Element                 `json:"_availabilityExceptions,omitempty"`
+	Endpoint                      []common.Reference              `json:"endpoint,omitempty"`
 }
 
 type PractitionerRoleAvailableTime struct {
 	common.BackboneElement
-	DaysOfWeek         []string `json:"daysOfWeek,omitempty"`
-	AllDay             *bool    `json:"allDay,omitempty"`
-	AvailableStartTime *string  `json:"availableStartTime,omitempty"`
-	AvailableEndTime   *string  `json:"availableEndTime,omitempty"`
+	DaysOfWeek                []string          `json:"daysOfWeek,omitempty"`
+	DaysOfWeekElement         []*common.Element `json:"_daysOfWeek,omitempty"`
+	AllDay                    *bool             `json:"allDay,omitempty"`
+	AllDayElement             *common.Element   `json:"_allDay,omitempty"`
+	AvailableStartTime        *common.Time      `json:"availableStartTime,omitempty"`
+	AvailableStartTimeElement *common.Element   `json:"_availableStartTime,omitempty"`
+	AvailableEndTime          *common.Time      `json:"availableEndTime,omitempty"`
+	AvailableEndTimeElement   *common.Element   `json:"_availableEndTime,omitempty"`
 }
 
 type PractitionerRoleNotAvailable struct {
 	common.BackboneElement
-	Description string         `json:"description"`
-	During      *common.Period `json:"during,omitempty"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+	During             *common.Period  `json:"during,omitempty"`
 }
@@ -0,0 +1,146 @@
+package fhir5
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// collectPrimitiveElements returns every underscore-prefixed JSON property and
+// the value of its primitive keyed by their path within the document
+func collectPrimitiveElements(value interface{}, path string, found map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			childPath := path + "." + key
+			if name, ok := strings.CutPrefix(key, "_"); ok {
+				encoded, _ := json.Marshal(child)
+				found[childPath] = string(encoded)
+				encoded, _ = json.Marshal(v[name])
+				found[path+"."+name] = string(encoded)
+			}
+			collectPrimitiveElements(child, childPath, found)
+		}
+	case []interface{}:
+		for i, child := range v {
+			collectPrimitiveElements(child, fmt.Sprintf("%s[%d]", path, i), found)
+		}
+	}
+}
+
+func TestPrimitiveExtensions_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("testdata/fhir5-json/*.json")
+	if err != nil {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+
+	tested := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		if !strings.Contains(string(data), `"_`) {
+			continue
+		}
+
+		var original interface{}
+		if err := json.Unmarshal(data, &original); err != nil {
+			t.Fatalf("failed to parse example file %s: %v", file, err)
+		}
+		expected := map[string]string{}
+		collectPrimitiveElements(original, "", expected)
+		if len(expected) == 0 {
+			continue
+		}
+
+		resource, err := UnmarshalResource(data)
+		if err != nil {
+			t.Errorf("failed to unmarshal example file %s: %v", file, err)
+			continue
+		}
+		if _, raw := resource.(*common.RawResource); raw {
+			continue
+		}
+		tested++
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			serialized, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal: %v", err)
+			}
+			var roundTripped interface{}
+			if err := json.Unmarshal(serialized, &roundTripped); err != nil {
+				t.Fatalf("failed to unmarshal serialized JSON: %v", err)
+			}
+			actual := map[string]string{}
+			collectPrimitiveElements(roundTripped, "", actual)
+
+			for path, want := range expected {
+				if got, ok := actual[path]; !ok {
+					t.Errorf("%s: primitive element lost", path)
+				} else if got != want {
+					t.Errorf("%s: got %s, want %s", path, got, want)
+				}
+			}
+		})
+	}
+	if tested == 0 {
+		t.Fatal("no example files with primitive extensions were tested")
+	}
+}
+
+func TestPrimitiveExtensions_ParallelArray(t *testing.T) {
+	data := []byte(`{"resourceType":"Patient","name":[{"given":["Peter","James"],"_given":[null,{"extension":[{"url":"http://hl7.org/fhir/StructureDefinition/iso21090-EN-qualifier","valueCode":"CL"}]}]}],"birthDate":"1974-12-25","_birthDate":{"extension":[{"url":"http://hl7.org/fhir/StructureDefinition/patient-birthTime","valueDateTime":"1974-12-25T14:35:45-05:00"}]}}`)
+
+	var patient Patient
+	if err := json.Unmarshal(data, &patient); err != nil {
+		t.Fatalf("failed to unmarshal patient: %v", err)
+	}
+	if patient.BirthDateElement == nil || len(patient.BirthDateElement.Extension) != 1 {
+		t.Fatalf("expected birthDate extension, got %+v", patient.BirthDateElement)
+	}
+	given := patient.Name[0].GivenElement
+	if len(given) != 2 || given[0] != nil || given[1] == nil {
+		t.Fatalf("expected parallel given elements [nil, element], got %+v", given)
+	}
+	if code := given[1].Extension[0].ValueCode; code == nil || *code != "CL" {
+		t.Errorf("expected qualifier CL, got %v", code)
+	}
+
+	serialized, err := json.Marshal(&patient)
+	if err != nil {
+		t.Fatalf("failed to marshal patient: %v", err)
+	}
+	if !strings.Contains(string(serialized), `"_given":[null,{`) {
+		t.Errorf("expected parallel array with null placeholder, got %s", serialized)
+	}
+}
+
+func TestPrimitiveExtensions_NullValue(t *testing.T) {
+	data := []byte(`{"resourceType":"Patient","name":[{"given":[null,"James"],"_given":[{"id":"a1","extension":[{"url":"http://hl7.org/fhir/StructureDefinition/data-absent-reason","valueCode":"masked"}]},null]}]}`)
+
+	var patient Patient
+	if err := json.Unmarshal(data, &patient); err != nil {
+		t.Fatalf("failed to unmarshal patient: %v", err)
+	}
+	if given := patient.Name[0].Given; len(given) != 2 || given[0] != "" || given[1] != "James" {
+		t.Fatalf("expected given names [\"\", James], got %q", given)
+	}
+
+	serialized, err := json.Marshal(&patient)
+	if err != nil {
+		t.Fatalf("failed to marshal patient: %v", err)
+	}
+	if !strings.Contains(string(serialized), `"given":[null,"James"]`) {
+		t.Errorf("expected null entry in given, got %s", serialized)
+	}
+	if !strings.Contains(string(serialized), `"_given":[{"id":"a1",`) {
+		t.Errorf("expected element of the null entry, got %s", serialized)
+	}
+}
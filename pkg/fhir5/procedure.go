@@ -85,10 +85,12 @@ type Procedure struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, order set or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained protocol, guideline, order set
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Where the procedure happened
 	Location *common.Reference `json:"location,omitempty"`
@@ -97,12 +99,14 @@ type Procedure struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// When the procedure occurred
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrenceAge      *common.Age    `json:"occurrenceAge,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceRange    *Range         `json:"occurrenceRange,omitempty"`
-	OccurrenceString   *string        `json:"occurrenceString,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrenceAge             *common.Age      `json:"occurrenceAge,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceRange           *Range           `json:"occurrenceRange,omitempty"`
+	OccurrenceString          *string          `json:"occurrenceString,omitempty"`
+	OccurrenceStringElement   *common.Element  `json:"_occurrenceString,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// The outcome of the procedure
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
@@ -117,7 +121,8 @@ type Procedure struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 
 	// The date the occurrence of the procedure was first captured in the record
-	Recorded *string `json:"recorded,omitempty"`
+	Recorded        *common.DateTime `json:"recorded,omitempty"`
+	RecordedElement *common.Element  `json:"_recorded,omitempty"`
 
 	// Individual who recorded the record and takes responsibility for its content
 	Recorder *common.Reference `json:"recorder,omitempty"`
@@ -126,11 +131,13 @@ type Procedure struct {
 	Report []common.Reference `json:"report,omitempty"`
 
 	// Indicates if this record was captured as a secondary 'reported' record
-	ReportedBoolean   *bool             `json:"reportedBoolean,omitempty"`
-	ReportedReference *common.Reference `json:"reportedReference,omitempty"`
+	ReportedBoolean        *bool             `json:"reportedBoolean,omitempty"`
+	ReportedBooleanElement *common.Element   `json:"_reportedBoolean,omitempty"`
+	ReportedReference      *common.Reference `json:"reportedReference,omitempty"`
 
 	// The status of the procedure
-	Status ProcedureStatus `json:"status"`
+	Status        ProcedureStatus `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Captures the reason for the current state of the procedure
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -9,21 +9,24 @@ import (
 type Provenance struct {
 	DomainResource
 
-	ResourceType     string                  `json:"resourceType"` // Always "Provenance"
-	Target           []common.Reference      `json:"target"`
-	OccurredPeriod   *common.Period          `json:"occurredPeriod,omitempty"`
-	OccurredDateTime *string                 `json:"occurredDateTime,omitempty"`
-	Recorded         string                  `json:"recorded"`
-	Policy           []string                `json:"policy,omitempty"`
-	Location         *common.Reference       `json:"location,omitempty"`
-	Authorization    []CodeableReference     `json:"authorization,omitempty"`
-	Activity         *common.CodeableConcept `json:"activity,omitempty"`
-	BasedOn          []common.Reference      `json:"basedOn,omitempty"`
-	Patient          *common.Reference       `json:"patient,omitempty"`
-	Encounter        *common.Reference       `json:"encounter,omitempty"`
-	Agent            []ProvenanceAgent       `json:"agent"`
-	Entity           []ProvenanceEntity      `json:"entity,omitempty"`
-	Signature        []Signature             `json:"signature,omitempty"`
+	ResourceType            string                  `json:"resourceType"` // Always "Provenance"
+	Target                  []common.Reference      `json:"target"`
+	OccurredPeriod          *common.Period          `json:"occurredPeriod,omitempty"`
+	OccurredDateTime        *common.DateTime        `json:"occurredDateTime,omitempty"`
+	OccurredDateTimeElement *common.Element         `json:"_occurredDateTime,omitempty"`
+	Recorded                common.Instant          `json:"recorded"`
+	RecordedElement         *common.Element         `json:"_recorded,omitempty"`
+	Policy                  []string                `json:"policy,omitempty"`
+	PolicyElement           []*common.Element       `json:"_policy,omitempty"`
+	Location                *common.Reference       `json:"location,omitempty"`
+	Authorization           []CodeableReference     `json:"authorization,omitempty"`
+	Activity                *common.CodeableConcept `json:"activity,omitempty"`
+	BasedOn                 []common.Reference      `json:"basedOn,omitempty"`
+	Patient                 *common.Reference       `json:"patient,omitempty"`
+	Encounter               *common.Reference       `json:"encounter,omitempty"`
+	Agent                   []ProvenanceAgent       `json:"agent"`
+	Entity                  []ProvenanceEntity      `json:"entity,omitempty"`
+	Signature               []Signature             `json:"signature,omitempty"`
 }
 
 type ProvenanceAgent struct {
@@ -36,7 +39,8 @@ type ProvenanceAgent struct {
 
 type ProvenanceEntity struct {
 	common.BackboneElement
-	Role  string            `json:"role"`
-	What  common.Reference  `json:"what"`
-	Agent []ProvenanceAgent `json:"agent,omitempty"`
+	Role        string            `json:"role"`
+	RoleElement *common.Element   `json:"_role,omitempty"`
+	What        common.Reference  `json:"what"`
+	Agent       []ProvenanceAgent `json:"agent,omitempty"`
 }
@@ -9,93 +9,142 @@ import (
 type Questionnaire struct {
 	DomainResource
 
-	ResourceType    string                   `json:"resourceType"` // Always "Questionnaire"
-	Url             *string                  `json:"url,omitempty"`
-	Identifier      []common.Identifier      `json:"identifier,omitempty"`
-	Version         *string                  `json:"version,omitempty"`
-	Name            *string                  `json:"name,omitempty"`
-	Title           *string                  `json:"title,omitempty"`
-	DerivedFrom     []string                 `json:"derivedFrom,omitempty"`
-	Status          PublicationStatus        `json:"status"`
-	Experimental    *bool                    `json:"experimental,omitempty"`
-	SubjectType     []string                 `json:"subjectType,omitempty"`
-	Date            *string                  `json:"date,omitempty"`
-	Publisher       *string                  `json:"publisher,omitempty"`
-	Contact         []ContactDetail          `json:"contact,omitempty"`
-	Description     *string                  `json:"description,omitempty"`
-	UseContext      []UsageContext           `json:"useContext,omitempty"`
-	Jurisdiction    []common.CodeableConcept `json:"jurisdiction,omitempty"`
-	Purpose         *string                  `json:"purpose,omitempty"`
-	Copyright       *string                  `json:"copyright,omitempty"`
-	ApprovalDate    *string                  `json:"approvalDate,omitempty"`
-	LastReviewDate  *string                  `json:"lastReviewDate,omitempty"`
-	EffectivePeriod *common.Period           `json:"effectivePeriod,omitempty"`
-	Code            []common.Coding          `json:"code,omitempty"`
-	Item            []QuestionnaireItem      `json:"item,omitempty"`
+	ResourceType          string                   `json:"resourceType"` // Always "Questionnaire"
+	Url                   *string                  `json:"url,omitempty"`
+	UrlElement            *common.Element          `json:"_url,omitempty"`
+	Identifier            []common.Identifier      `json:"identifier,omitempty"`
+	Version               *string                  `json:"version,omitempty"`
+	VersionElement        *common.Element          `json:"_version,omitempty"`
+	Name                  *string                  `json:"name,omitempty"`
+	NameElement           *common.Element          `json:"_name,omitempty"`
+	Title                 *string                  `json:"title,omitempty"`
+	TitleElement          *common.Element          `json:"_title,omitempty"`
+	DerivedFrom           []string                 `json:"derivedFrom,omitempty"`
+	DerivedFromElement    []*common.Element        `json:"_derivedFrom,omitempty"`
+	Status                PublicationStatus        `json:"status"`
+	StatusElement         *common.Element          `json:"_status,omitempty"`
+	Experimental          *bool                    `json:"experimental,omitempty"`
+	ExperimentalElement   *common.Element          `json:"_experimental,omitempty"`
+	SubjectType           []string                 `json:"subjectType,omitempty"`
+	SubjectTypeElement    []*common.Element        `json:"_subjectType,omitempty"`
+	Date                  *common.DateTime         `json:"date,omitempty"`
+	DateElement           *common.Element          `json:"_date,omitempty"`
+	Publisher             *string                  `json:"publisher,omitempty"`
+	PublisherElement      *common.Element          `json:"_publisher,omitempty"`
+	Contact               []ContactDetail          `json:"contact,omitempty"`
+	Description           *string                  `json:"description,omitempty"`
+	DescriptionElement    *common.Element          `json:"_description,omitempty"`
+	UseContext            []UsageContext           `json:"useContext,omitempty"`
+	Jurisdiction          []common.CodeableConcept `json:"jurisdiction,omitempty"`
+	Purpose               *string                  `json:"purpose,omitempty"`
+	PurposeElement        *common.Element          `json:"_purpose,omitempty"`
+	Copyright             *string                  `json:"copyright,omitempty"`
+	CopyrightElement      *common.Element          `json:"_copyright,omitempty"`
+	ApprovalDate          *common.Date             `json:"approvalDate,omitempty"`
+	ApprovalDateElement   *common.Element          `json:"_approvalDate,omitempty"`
+	LastReviewDate        *common.Date             `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element          `json:"_lastReviewDate,omitempty"`
+	EffectivePeriod       *common.Period           `json:"effectivePeriod,omitempty"`
+	Code                  []common.Coding          `json:"code,omitempty"`
+	Item                  []QuestionnaireItem      `json:"item,omitempty"`
 }
 
 type QuestionnaireItem struct {
 	common.BackboneElement
-	LinkId          string                          `json:"linkId"`
-	Definition      *string                         `json:"definition,omitempty"`
-	Code            []common.Coding                 `json:"code,omitempty"`
-	Prefix          *string                         `json:"prefix,omitempty"`
-	Text            *string                         `json:"text,omitempty"`
-	Type            string                          `json:"type"`
-	EnableWhen      []QuestionnaireItemEnableWhen   `json:"enableWhen,omitempty"`
-	EnableBehavior  *string                         `json:"enableBehavior,omitempty"`
-	DisabledDisplay *string                         `json:"disabledDisplay,omitempty"`
-	Required        *bool                           `json:"required,omitempty"`
-	Repeats         *bool                           `json:"repeats,omitempty"`
-	ReadOnly        *bool                           `json:"readOnly,omitempty"`
-	MaxLength       *int                            `json:"maxLength,omitempty"`
-	AnswerValueSet  *string                         `json:"answerValueSet,omitempty"`
-	AnswerOption    []QuestionnaireItemAnswerOption `json:"answerOption,omitempty"`
-	Initial         []QuestionnaireItemInitial      `json:"initial,omitempty"`
-	Item            []QuestionnaireItem             `json:"item,omitempty"`
+	LinkId                 string                          `json:"linkId"`
+	LinkIdElement          *common.Element                 `json:"_linkId,omitempty"`
+	Definition             *string                         `json:"definition,omitempty"`
+	DefinitionElement      *common.Element                 `json:"_definition,omitempty"`
+	Code                   []common.Coding                 `json:"code,omitempty"`
+	Prefix                 *string                         `json:"prefix,omitempty"`
+	PrefixElement          *common.Element                 `json:"_prefix,omitempty"`
+	Text                   *string                         `json:"text,omitempty"`
+	TextElement            *common.Element                 `json:"_text,omitempty"`
+	Type                   string                          `json:"type"`
+	TypeElement            *common.Element                 `json:"_type,omitempty"`
+	EnableWhen             []QuestionnaireItemEnableWhen   `json:"enableWhen,omitempty"`
+	EnableBehavior         *string                         `json:"enableBehavior,omitempty"`
+	EnableBehaviorElement  *common.Element                 `json:"_enableBehavior,omitempty"`
+	DisabledDisplay        *string                         `json:"disabledDisplay,omitempty"`
+	DisabledDisplayElement *common.Element                 `json:"_disabledDisplay,omitempty"`
+	Required               *bool                           `json:"required,omitempty"`
+	RequiredElement        *common.Element                 `json:"_required,omitempty"`
+	Repeats                *bool                           `json:"repeats,omitempty"`
+	RepeatsElement         *common.Element                 `json:"_repeats,omitempty"`
+	ReadOnly               *bool                           `json:"readOnly,omitempty"`
+	ReadOnlyElement        *common.Element                 `json:"_readOnly,omitempty"`
+	MaxLength              *int                            `json:"maxLength,omitempty"`
+	MaxLengthElement       *common.Element                 `json:"_maxLength,omitempty"`
+	AnswerValueSet         *string                         `json:"answerValueSet,omitempty"`
+	AnswerValueSetElement  *common.Element                 `json:"_answerValueSet,omitempty"`
+	AnswerOption           []QuestionnaireItemAnswerOption `json:"answerOption,omitempty"`
+	Initial                []QuestionnaireItemInitial      `json:"initial,omitempty"`
+	Item                   []QuestionnaireItem             `json:"item,omitempty"`
 }
 
 type QuestionnaireItemEnableWhen struct {
 	common.BackboneElement
-	Question        string            `json:"question"`
-	Operator        string            `json:"operator"`
-	AnswerBoolean   *bool             `json:"answerBoolean,omitempty"`
-	AnswerDecimal   *float64          `json:"answerDecimal,omitempty"`
-	AnswerInteger   *int              `json:"answerInteger,omitempty"`
-	AnswerDate      *string           `json:"answerDate,omitempty"`
-	AnswerDateTime  *string           `json:"answerDateTime,omitempty"`
-	AnswerTime      *string           `json:"answerTime,omitempty"`
-	AnswerString    *string           `json:"answerString,omitempty"`
-	AnswerCoding    *common.Coding    `json:"answerCoding,omitempty"`
-	AnswerQuantity  *common.Quantity  `json:"answerQuantity,omitempty"`
-	AnswerReference *common.Reference `json:"answerReference,omitempty"`
+	Question              string            `json:"question"`
+	QuestionElement       *common.Element   `json:"_question,omitempty"`
+	Operator              string            `json:"operator"`
+	OperatorElement       *common.Element   `json:"_operator,omitempty"`
+	AnswerBoolean         *bool             `json:"answerBoolean,omitempty"`
+	AnswerBooleanElement  *common.Element   `json:"_answerBoolean,omitempty"`
+	AnswerDecimal         *common.Decimal   `json:"answerDecimal,omitempty"`
+	AnswerDecimalElement  *common.Element   `json:"_answerDecimal,omitempty"`
+	AnswerInteger         *int              `json:"answerInteger,omitempty"`
+	AnswerIntegerElement  *common.Element   `json:"_answerInteger,omitempty"`
+	AnswerDate            *common.Date      `json:"answerDate,omitempty"`
+	AnswerDateElement     *common.Element   `json:"_answerDate,omitempty"`
+	AnswerDateTime        *common.DateTime  `json:"answerDateTime,omitempty"`
+	AnswerDateTimeElement *common.Element   `json:"_answerDateTime,omitempty"`
+	AnswerTime            *common.Time      `json:"answerTime,omitempty"`
+	AnswerTimeElement     *common.Element   `json:"_answerTime,omitempty"`
+	AnswerString          *string           `json:"answerString,omitempty"`
+	AnswerStringElement   *common.Element   `json:"_answerString,omitempty"`
+	AnswerCoding          *common.Coding    `json:"answerCoding,omitempty"`
+	AnswerQuantity        *common.Quantity  `json:"answerQuantity,omitempty"`
+	AnswerReference       *common.Reference `json:"answerReference,omitempty"`
 }
 
 type QuestionnaireItemAnswerOption struct {
 	common.BackboneElement
-	ValueInteger    *int              `json:"valueInteger,omitempty"`
-	ValueDate       *string           `json:"valueDate,omitempty"`
-	ValueTime       *string           `json:"valueTime,omitempty"`
-	ValueString     *string           `json:"valueString,omitempty"`
-	ValueCoding     *common.Coding    `json:"valueCoding,omitempty"`
-	ValueReference  *common.Reference `json:"valueReference,omitempty"`
-	InitialSelected *bool             `json:"initialSelected,omitempty"`
+	ValueInteger           *int              `json:"valueInteger,omitempty"`
+	ValueIntegerElement    *common.Element   `json:"_valueInteger,omitempty"`
+	ValueDate              *common.Date      `json:"valueDate,omitempty"`
+	ValueDateElement       *common.Element   `json:"_valueDate,omitempty"`
+	ValueTime              *common.Time      `json:"valueTime,omitempty"`
+	ValueTimeElement       *common.Element   `json:"_valueTime,omitempty"`
+	ValueString            *string           `json:"valueString,omitempty"`
+	ValueStringElement     *common.Element   `json:"_valueString,omitempty"`
+	ValueCoding            *common.Coding    `json:"valueCoding,omitempty"`
+	ValueReference         *common.Reference `json:"valueReference,omitempty"`
+	InitialSelected        *bool             `json:"initialSelected,omitempty"`
+	InitialSelectedElement *common.Element   `json:"_initialSelected,omitempty"`
 }
 
 type QuestionnaireItemInitial struct {
 	common.BackboneElement
-	ValueBoolean    *bool             `json:"valueBoolean,omitempty"`
-	ValueDecimal    *float64          `json:"valueDecimal,omitempty"`
-	ValueInteger    *int              `json:"valueInteger,omitempty"`
-	ValueDate       *string           `json:"valueDate,omitempty"`
-	ValueDateTime   *string           `json:"valueDateTime,omitempty"`
-	ValueTime       *string           `json:"valueTime,omitempty"`
-	ValueString     *string           `json:"valueString,omitempty"`
-	ValueUri        *string           `json:"valueUri,omitempty"`
-	ValueAttachment *Attachment       `json:"valueAttachment,omitempty"`
-	ValueCoding     *common.Coding    `json:"valueCoding,omitempty"`
-	ValueQuantity   *common.Quantity  `json:"valueQuantity,omitempty"`
-	ValueReference  *common.Reference `json:"valueReference,omitempty"`
+	ValueBoolean         *bool             `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element   `json:"_valueBoolean,omitempty"`
+	ValueDecimal         *common.Decimal   `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element   `json:"_valueDecimal,omitempty"`
+	ValueInteger         *int              `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element   `json:"_valueInteger,omitempty"`
+	ValueDate            *common.Date      `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element   `json:"_valueDate,omitempty"`
+	ValueDateTime        *common.DateTime  `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element   `json:"_valueDateTime,omitempty"`
+	ValueTime            *common.Time      `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element   `json:"_valueTime,omitempty"`
+	ValueString          *string           `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element   `json:"_valueString,omitempty"`
+	ValueUri             *string           `json:"valueUri,omitempty"`
+	ValueUriElement      *common.Element   `json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment       `json:"valueAttachment,omitempty"`
+	ValueCoding          *common.Coding    `json:"valueCoding,omitempty"`
+	ValueQuantity        *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueReference       *common.Reference `json:"valueReference,omitempty"`
 }
 
 // QuestionnaireResponseItemAnswer represents an answer to a question
@@ -103,18 +152,26 @@ type QuestionnaireResponseItemAnswer struct {
 	common.BackboneElement
 
 	// The answer (or one of the answers) provided by the respondent to the question
-	ValueBoolean    *bool             `json:"valueBoolean,omitempty"`
-	ValueDecimal    *float64          `json:"valueDecimal,omitempty"`
-	ValueInteger    *int              `json:"valueInteger,omitempty"`
-	ValueDate       *string           `json:"valueDate,omitempty"`
-	ValueDateTime   *string           `json:"valueDateTime,omitempty"`
-	ValueTime       *string           `json:"valueTime,omitempty"`
-	ValueString     *string           `json:"valueString,omitempty"`
-	ValueUri        *string           `json:"valueUri,omitempty"`
-	ValueAttachment *Attachment       `json:"valueAttachment,omitempty"`
-	ValueCoding     *common.Coding    `json:"valueCoding,omitempty"`
-	ValueQuantity   *common.Quantity  `json:"valueQuantity,omitempty"`
-	ValueReference  *common.Reference `json:"valueReference,omitempty"`
+	ValueBoolean         *bool             `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element   `json:"_valueBoolean,omitempty"`
+	ValueDecimal         *common.Decimal   `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element   `json:"_valueDecimal,omitempty"`
+	ValueInteger         *int              `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element   `json:"_valueInteger,omitempty"`
+	ValueDate            *common.Date      `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element   `json:"_valueDate,omitempty"`
+	ValueDateTime        *common.DateTime  `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element   `json:"_valueDateTime,omitempty"`
+	ValueTime            *common.Time      `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element   `json:"_valueTime,omitempty"`
+	ValueString          *string           `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element   `json:"_valueString,omitempty"`
+	ValueUri             *string           `json:"valueUri,omitempty"`
+	ValueUriElement      *common.Element   `json:"_valueUri,omitempty"`
+	ValueAttachment      *Attachment       `json:"valueAttachment,omitempty"`
+	ValueCoding          *common.Coding    `json:"valueCoding,omitempty"`
+	ValueQuantity        *common.Quantity  `json:"valueQuantity,omitempty"`
+	ValueReference       *common.Reference `json:"valueReference,omitempty"`
 
 	// Nested groups and questions within this answer
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
@@ -128,25 +185,31 @@ type QuestionnaireResponseItem struct {
 	Answer []QuestionnaireResponseItemAnswer `json:"answer,omitempty"`
 
 	// The item from the Questionnaire that corresponds to this item in the QuestionnaireResponse resource
-	Definition *string `json:"definition,omitempty"`
+	Definition        *string         `json:"definition,omitempty"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// The identifier that is unique to the questionnaire allowing referencing to this item from other questionnaires
-	LinkId string `json:"linkId"`
+	LinkId        string          `json:"linkId"`
+	LinkIdElement *common.Element `json:"_linkId,omitempty"`
 
 	// Text, questions and other groups to be nested beneath a question or group
 	Item []QuestionnaireResponseItem `json:"item,omitempty"`
 
 	// The name of a section, the text of a question or text displayed for the item
-	Prefix *string `json:"prefix,omitempty"`
+	Prefix        *string         `json:"prefix,omitempty"`
+	PrefixElement *common.Element `json:"_prefix,omitempty"`
 
 	// A question or group displayed in the questionnaire response
-	Question *string `json:"question,omitempty"`
+	Question        *string         `json:"question,omitempty"`
+	QuestionElement *common.Element `json:"_question,omitempty"`
 
 	// The type of questionnaire item this is - whether text for display, a grouping of other items or a particular type of data to be captured
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// The text to be displayed in the questionnaire response when this item is displayed
-	Text *string `json:"text,omitempty"`
+	Text        *string         `json:"text,omitempty"`
+	TextElement *common.Element `json:"_text,omitempty"`
 }
 
 // QuestionnaireResponse represents a structured set of questions and their answers
@@ -160,7 +223,8 @@ type QuestionnaireResponse struct {
 	Author *common.Reference `json:"author,omitempty"`
 
 	// The date and/or time that this set of answers was last changed
-	Authored *string `json:"authored,omitempty"`
+	Authored        *common.DateTime `json:"authored,omitempty"`
+	AuthoredElement *common.Element  `json:"_authored,omitempty"`
 
 	// The encounter or episode of care with primary association to the questionnaire response
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -172,13 +236,15 @@ type QuestionnaireResponse struct {
 	PartOf []common.Reference `json:"partOf,omitempty"`
 
 	// The Questionnaire that defines and organizes this questionnaire response
-	Questionnaire *string `json:"questionnaire,omitempty"`
+	Questionnaire        *string         `json:"questionnaire,omitempty"`
+	QuestionnaireElement *common.Element `json:"_questionnaire,omitempty"`
 
 	// The individual or organization that received the responses to the questionnaire
 	Source *common.Reference `json:"source,omitempty"`
 
 	// The position of the questionnaire response within its overall lifecycle
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The subject of the questionnaire response
 	Subject *common.Reference `json:"subject,omitempty"`
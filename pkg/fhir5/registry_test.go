@@ -0,0 +1,105 @@
+package fhir5
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+func TestUnmarshalResource_Bundle(t *testing.T) {
+	data, err := os.ReadFile("testdata/fhir5-json/bundle-example.json")
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	resource, err := UnmarshalResource(data)
+	if err != nil {
+		t.Fatalf("failed to unmarshal bundle: %v", err)
+	}
+	bundle, ok := resource.(*Bundle)
+	if !ok {
+		t.Fatalf("expected *Bundle, got %T", resource)
+	}
+	if len(bundle.Entry) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(bundle.Entry))
+	}
+	if _, ok := bundle.Entry[0].Resource.(*MedicationRequest); !ok {
+		t.Errorf("expected entry 0 to be *MedicationRequest, got %T", bundle.Entry[0].Resource)
+	}
+	if _, ok := bundle.Entry[1].Resource.(*Medication); !ok {
+		t.Errorf("expected entry 1 to be *Medication, got %T", bundle.Entry[1].Resource)
+	}
+}
+
+func TestUnmarshalResource_BundleResponseOutcome(t *testing.T) {
+	data, err := os.ReadFile("testdata/fhir5-json/bundle-response.json")
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+
+	var bundle Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatalf("failed to unmarshal bundle: %v", err)
+	}
+	if _, ok := bundle.Entry[0].Response.Outcome.(*OperationOutcome); !ok {
+		t.Errorf("expected outcome to be *OperationOutcome, got %T", bundle.Entry[0].Response.Outcome)
+	}
+	if _, ok := bundle.Entry[8].Resource.(*Bundle); !ok {
+		t.Errorf("expected nested *Bundle, got %T", bundle.Entry[8].Resource)
+	}
+}
+
+func TestUnmarshalResource_ParametersAndContained(t *testing.T) {
+	data, err := os.ReadFile("testdata/fhir5-json/parameters-example.json")
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+	var parameters Parameters
+	if err := json.Unmarshal(data, &parameters); err != nil {
+		t.Fatalf("failed to unmarshal parameters: %v", err)
+	}
+	if _, ok := parameters.Parameter[2].Resource.(*Patient); !ok {
+		t.Errorf("expected parameter resource to be *Patient, got %T", parameters.Parameter[2].Resource)
+	}
+
+	data, err = os.ReadFile("testdata/fhir5-json/activitydefinition-medicationorder-example.json")
+	if err != nil {
+		t.Fatalf("failed to read example file: %v", err)
+	}
+	var activityDefinition ActivityDefinition
+	if err := json.Unmarshal(data, &activityDefinition); err != nil {
+		t.Fatalf("failed to unmarshal activity definition: %v", err)
+	}
+	if _, ok := activityDefinition.Contained[0].(*Medication); !ok {
+		t.Errorf("expected contained resource to be *Medication, got %T", activityDefinition.Contained[0])
+	}
+	if _, ok := activityDefinition.Contained[1].(*Substance); !ok {
+		t.Errorf("expected contained resource to be *Substance, got %T", activityDefinition.Contained[1])
+	}
+}
+
+func TestUnmarshalResource_UnknownType(t *testing.T) {
+	data := []byte(`{"resourceType":"Bundle","entry":[{"resource":{"resourceType":"CustomThing","id":"1","foo":[1,2]}}],"type":"collection"}`)
+
+	var bundle Bundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		t.Fatalf("failed to unmarshal bundle: %v", err)
+	}
+	raw, ok := bundle.Entry[0].Resource.(*common.RawResource)
+	if !ok {
+		t.Fatalf("expected *common.RawResource, got %T", bundle.Entry[0].Resource)
+	}
+	if raw.ResourceType != "CustomThing" {
+		t.Errorf("expected resourceType CustomThing, got %q", raw.ResourceType)
+	}
+
+	serialized, err := json.Marshal(&bundle)
+	if err != nil {
+		t.Fatalf("failed to marshal bundle: %v", err)
+	}
+	if string(serialized) != string(data) {
+		t.Errorf("round trip mismatch:\n got: %s\nwant: %s", serialized, data)
+	}
+}
@@ -9,16 +9,19 @@ import (
 type RelatedPerson struct {
 	DomainResource
 
-	ResourceType string                   `json:"resourceType"` // Always "RelatedPerson"
-	Identifier   []common.Identifier      `json:"identifier,omitempty"`
-	Active       *bool                    `json:"active,omitempty"`
-	Patient      common.Reference         `json:"patient"`
-	Relationship []common.CodeableConcept `json:"relationship,omitempty"`
-	Name         []HumanName              `json:"name,omitempty"`
-	Telecom      []ContactPoint           `json:"telecom,omitempty"`
-	Gender       *string                  `json:"gender,omitempty"`
-	BirthDate    *string                  `json:"birthDate,omitempty"`
-	Address      []Address                `json:"address,omitempty"`
-	Photo        []Attachment             `json:"photo,omitempty"`
-	Period       *common.Period           `json:"period,omitempty"`
+	ResourceType     string                   `json:"resourceType"` // Always "RelatedPerson"
+	Identifier       []common.Identifier      `json:"identifier,omitempty"`
+	Active           *bool                    `json:"active,omitempty"`
+	ActiveElement    *common.Element          `json:"_active,omitempty"`
+	Patient          common.Reference         `json:"patient"`
+	Relationship     []common.CodeableConcept `json:"relationship,omitempty"`
+	Name             []HumanName              `json:"name,omitempty"`
+	Telecom          []ContactPoint           `json:"telecom,omitempty"`
+	Gender           *string                  `json:"gender,omitempty"`
+	GenderElement    *common.Element          `json:"_gender,omitempty"`
+	BirthDate        *common.Date             `json:"birthDate,omitempty"`
+	BirthDateElement *common.Element          `json:"_birthDate,omitempty"`
+	Address          []Address                `json:"address,omitempty"`
+	Photo            []Attachment             `json:"photo,omitempty"`
+	Period           *common.Period           `json:"period,omitempty"`
 }
@@ -9,23 +9,29 @@ import (
 type RequestOrchestration struct {
 	DomainResource
 
-	ResourceType          string                        `json:"resourceType"` // Always "RequestOrchestration"
-	Identifier            []common.Identifier           `json:"identifier,omitempty"`
-	InstantiatesCanonical []string                      `json:"instantiatesCanonical,omitempty"`
-	InstantiatesUri       []string                      `json:"instantiatesUri,omitempty"`
-	BasedOn               []common.Reference            `json:"basedOn,omitempty"`
-	Replaces              []common.Reference            `json:"replaces,omitempty"`
-	GroupIdentifier       *common.Identifier            `json:"groupIdentifier,omitempty"`
-	Status                RequestOrchestrationStatus    `json:"status"`
-	Intent                RequestOrchestrationIntent    `json:"intent"`
-	Priority              *RequestOrchestrationPriority `json:"priority,omitempty"`
-	Subject               *common.Reference             `json:"subject,omitempty"`
-	Encounter             *common.Reference             `json:"encounter,omitempty"`
-	AuthoredOn            *string                       `json:"authoredOn,omitempty"`
-	Author                *common.Reference             `json:"author,omitempty"`
-	Reason                []CodeableReference           `json:"reason,omitempty"`
-	Note                  []Annotation                  `json:"note,omitempty"`
-	Action                []RequestOrchestrationAction  `json:"action,omitempty"`
+	ResourceType                 string                        `json:"resourceType"` // Always "RequestOrchestration"
+	Identifier                   []common.Identifier           `json:"identifier,omitempty"`
+	InstantiatesCanonical        []string                      `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element             `json:"_instantiatesCanonical,omitempty"`
+	InstantiatesUri              []string                      `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement       []*common.Element             `json:"_instantiatesUri,omitempty"`
+	BasedOn                      []common.Reference            `json:"basedOn,omitempty"`
+	Replaces                     []common.Reference            `json:"replaces,omitempty"`
+	GroupIdentifier              *common.Identifier            `json:"groupIdentifier,omitempty"`
+	Status                       RequestOrchestrationStatus    `json:"status"`
+	StatusElement                *common.Element               `json:"_status,omitempty"`
+	Intent                       RequestOrchestrationIntent    `json:"intent"`
+	IntentElement                *common.Element               `json:"_intent,omitempty"`
+	Priority                     *RequestOrchestrationPriority `json:"priority,omitempty"`
+	PriorityElement              *common.Element               `json:"_priority,omitempty"`
+	Subject                      *common.Reference             `json:"subject,omitempty"`
+	Encounter                    *common.Reference             `json:"encounter,omitempty"`
+	AuthoredOn                   *common.DateTime              `json:"authoredOn,omitempty"`
+	AuthoredOnElement            *common.Element               `json:"_authoredOn,omitempty"`
+	Author                       *common.Reference             `json:"author,omitempty"`
+	Reason                       []CodeableReference           `json:"reason,omitempty"`
+	Note                         []Annotation                  `json:"note,omitempty"`
+	Action                       []RequestOrchestrationAction  `json:"action,omitempty"`
 }
 
 type RequestOrchestrationStatus string
@@ -64,77 +70,98 @@ const (
 
 type RequestOrchestrationAction struct {
 	common.BackboneElement
-	Prefix                 *string                                   `json:"prefix,omitempty"`
-	Title                  *string                                   `json:"title,omitempty"`
-	Description            *string                                   `json:"description,omitempty"`
-	TextEquivalent         *string                                   `json:"textEquivalent,omitempty"`
-	Priority               *RequestOrchestrationPriority             `json:"priority,omitempty"`
-	Code                   []common.CodeableConcept                  `json:"code,omitempty"`
-	Reason                 []common.CodeableConcept                  `json:"reason,omitempty"`
-	Documentation          []RelatedArtifact                         `json:"documentation,omitempty"`
-	Goal                   []common.Reference                        `json:"goal,omitempty"`
-	SubjectCodeableConcept *common.CodeableConcept                   `json:"subjectCodeableConcept,omitempty"`
-	SubjectReference       *common.Reference                         `json:"subjectReference,omitempty"`
-	Trigger                []TriggerDefinition                       `json:"trigger,omitempty"`
-	Condition              []RequestOrchestrationActionCondition     `json:"condition,omitempty"`
-	Input                  []RequestOrchestrationActionInput         `json:"input,omitempty"`
-	Output                 []RequestOrchestrationActionOutput        `json:"output,omitempty"`
-	RelatedAction          []RequestOrchestrationActionRelatedAction `json:"relatedAction,omitempty"`
-	TimingDateTime         *string                                   `json:"timingDateTime,omitempty"`
-	TimingAge              *common.Age                               `json:"timingAge,omitempty"`
-	TimingPeriod           *common.Period                            `json:"timingPeriod,omitempty"`
-	TimingDuration         *Duration                                 `json:"timingDuration,omitempty"`
-	TimingRange            *Range                                    `json:"timingRange,omitempty"`
-	TimingTiming           *Timing                                   `json:"timingTiming,omitempty"`
-	Participant            []RequestOrchestrationActionParticipant   `json:"participant,omitempty"`
-	Type                   *common.CodeableConcept                   `json:"type,omitempty"`
-	GroupingBehavior       *string                                   `json:"groupingBehavior,omitempty"`
-	SelectionBehavior      *string                                   `json:"selectionBehavior,omitempty"`
-	RequiredBehavior       *string                                   `json:"requiredBehavior,omitempty"`
-	PrecheckBehavior       *string                                   `json:"precheckBehavior,omitempty"`
-	CardinalityBehavior    *string                                   `json:"cardinalityBehavior,omitempty"`
-	Resource               *common.Reference                         `json:"resource,omitempty"`
-	DefinitionCanonical    *string                                   `json:"definitionCanonical,omitempty"`
-	DefinitionUri          *string                                   `json:"definitionUri,omitempty"`
-	Transform              *string                                   `json:"transform,omitempty"`
-	DynamicValue           []RequestOrchestrationActionDynamicValue  `json:"dynamicValue,omitempty"`
-	Action                 []RequestOrchestrationAction              `json:"action,omitempty"`
+	Prefix                     *string                                   `json:"prefix,omitempty"`
+	PrefixElement              *common.Element                           `json:"_prefix,omitempty"`
+	Title                      *string                                   `json:"title,omitempty"`
+	TitleElement               *common.Element                           `json:"_title,omitempty"`
+	Description                *string                                   `json:"description,omitempty"`
+	DescriptionElement         *common.Element                           `json:"_description,omitempty"`
+	TextEquivalent             *string                                   `json:"textEquivalent,omitempty"`
+	TextEquivalentElement      *common.Element                           `json:"_textEquivalent,omitempty"`
+	Priority                   *RequestOrchestrationPriority             `json:"priority,omitempty"`
+	PriorityElement            *common.Element                           `json:"_priority,omitempty"`
+	Code                       []common.CodeableConcept                  `json:"code,omitempty"`
+	Reason                     []common.CodeableConcept                  `json:"reason,omitempty"`
+	Documentation              []RelatedArtifact                         `json:"documentation,omitempty"`
+	Goal                       []common.Reference                        `json:"goal,omitempty"`
+	SubjectCodeableConcept     *common.CodeableConcept                   `json:"subjectCodeableConcept,omitempty"`
+	SubjectReference           *common.Reference                         `json:"subjectReference,omitempty"`
+	Trigger                    []TriggerDefinition                       `json:"trigger,omitempty"`
+	Condition                  []RequestOrchestrationActionCondition     `json:"condition,omitempty"`
+	Input                      []RequestOrchestrationActionInput         `json:"input,omitempty"`
+	Output                     []RequestOrchestrationActionOutput        `json:"output,omitempty"`
+	RelatedAction              []RequestOrchestrationActionRelatedAction `json:"relatedAction,omitempty"`
+	TimingDateTime             *common.DateTime                          `json:"timingDateTime,omitempty"`
+	TimingDateTimeElement      *common.Element                           `json:"_timingDateTime,omitempty"`
+	TimingAge                  *common.Age                               `json:"timingAge,omitempty"`
+	TimingPeriod               *common.Period                            `json:"timingPeriod,omitempty"`
+	TimingDuration             *Duration                                 `json:"timingDuration,omitempty"`
+	TimingRange                *Range                                    `json:"timingRange,omitempty"`
+	TimingTiming               *Timing                                   `json:"timingTiming,omitempty"`
+	Participant                []RequestOrchestrationActionParticipant   `json:"participant,omitempty"`
+	Type                       *common.CodeableConcept                   `json:"type,omitempty"`
+	GroupingBehavior           *string                                   `json:"groupingBehavior,omitempty"`
+	GroupingBehaviorElement    *common.Element                           `json:"_groupingBehavior,omitempty"`
+	SelectionBehavior          *string                                   `json:"selectionBehavior,omitempty"`
+	SelectionBehaviorElement   *common.Element                           `json:"_selectionBehavior,omitempty"`
+	RequiredBehavior           *string                                   `json:"requiredBehavior,omitempty"`
+	RequiredBehaviorElement    *common.Element                           `json:"_requiredBehavior,omitempty"`
+	PrecheckBehavior           *string                                   `json:"precheckBehavior,omitempty"`
+	PrecheckBehaviorElement    *common.Element                           `json:"_precheckBehavior,omitempty"`
+	CardinalityBehavior        *string                                   `json:"cardinalityBehavior,omitempty"`
+	CardinalityBehaviorElement *common.Element                           `json:"_cardinalityBehavior,omitempty"`
+	Resource                   *common.Reference                         `json:"resource,omitempty"`
+	DefinitionCanonical        *string                                   `json:"definitionCanonical,omitempty"`
+	DefinitionCanonicalElement *common.Element                           `json:"_definitionCanonical,omitempty"`
+	DefinitionUri              *string                                   `json:"definitionUri,omitempty"`
+	DefinitionUriElement       *common.Element                           `json:"_definitionUri,omitempty"`
+	Transform                  *string                                   `json:"transform,omitempty"`
+	TransformElement           *common.Element                           `json:"_transform,omitempty"`
+	DynamicValue               []RequestOrchestrationActionDynamicValue  `json:"dynamicValue,omitempty"`
+	Action                     []RequestOrchestrationAction              `json:"action,omitempty"`
 }
 
 type RequestOrchestrationActionCondition struct {
 	common.BackboneElement
-	Kind       string      `json:"kind"`
-	Expression *Expression `json:"expression,omitempty"`
+	Kind        string          `json:"kind"`
+	KindElement *common.Element `json:"_kind,omitempty"`
+	Expression  *Expression     `json:"expression,omitempty"`
 }
 
 type RequestOrchestrationActionInput struct {
 	common.BackboneElement
-	Title       *string          `json:"title,omitempty"`
-	Requirement *DataRequirement `json:"requirement,omitempty"`
+	Title        *string          `json:"title,omitempty"`
+	TitleElement *common.Element  `json:"_title,omitempty"`
+	Requirement  *DataRequirement `json:"requirement,omitempty"`
 }
 
 type RequestOrchestrationActionOutput struct {
 	common.BackboneElement
-	Title       *string          `json:"title,omitempty"`
-	Requirement *DataRequirement `json:"requirement,omitempty"`
+	Title        *string          `json:"title,omitempty"`
+	TitleElement *common.Element  `json:"_title,omitempty"`
+	Requirement  *DataRequirement `json:"requirement,omitempty"`
 }
 
 type RequestOrchestrationActionRelatedAction struct {
 	common.BackboneElement
-	TargetId       string    `json:"targetId"`
-	Relationship   string    `json:"relationship"`
-	OffsetDuration *Duration `json:"offsetDuration,omitempty"`
-	OffsetRange    *Range    `json:"offsetRange,omitempty"`
+	TargetId            string          `json:"targetId"`
+	TargetIdElement     *common.Element `json:"_targetId,omitempty"`
+	Relationship        string          `json:"relationship"`
+	RelationshipElement *common.Element `json:"_relationship,omitempty"`
+	OffsetDuration      *Duration       `json:"offsetDuration,omitempty"`
+	OffsetRange         *Range          `json:"offsetRange,omitempty"`
 }
 
 type RequestOrchestrationActionParticipant struct {
 	common.BackboneElement
-	Type string                  `json:"type"`
-	Role *common.CodeableConcept `json:"role,omitempty"`
+	Type        string                  `json:"type"`
+	TypeElement *common.Element         `json:"_type,omitempty"`
+	Role        *common.CodeableConcept `json:"role,omitempty"`
 }
 
 type RequestOrchestrationActionDynamicValue struct {
 	common.BackboneElement
-	Path       string      `json:"path"`
-	Expression *Expression `json:"expression,omitempty"`
+	Path        string          `json:"path"`
+	PathElement *common.Element `json:"_path,omitempty"`
+	Expression  *Expression     `json:"expression,omitempty"`
 }
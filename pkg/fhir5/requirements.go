@@ -13,7 +13,8 @@ type Requirements struct {
 	ResourceType string `json:"resourceType"` // Always "Requirements"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.DateTime `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element  `json:"_approvalDate,omitempty"`
 
 	// An individiual or organization primarily involved in the creation and maintenance of the content
 	Author []ContactDetail `json:"author,omitempty"`
@@ -22,13 +23,16 @@ type Requirements struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the requirements and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// Note that this is not the same as the resource last-modified-date, since the resource may be a secondary representation of the requirements
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as why the requirements were built
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the content
 	Editor []ContactDetail `json:"editor,omitempty"`
@@ -40,7 +44,8 @@ type Requirements struct {
 	Endorser []ContactDetail `json:"endorser,omitempty"`
 
 	// Allows filtering of requirements that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -49,31 +54,38 @@ type Requirements struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.DateTime `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element  `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the requirements
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
-	RelatedArtifact []interface{} `json:"relatedArtifact,omitempty"`
+	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// An individual or organization primarily responsible for review of some aspect of the content
 	Reviewer []ContactDetail `json:"reviewer,omitempty"`
 
 	// Allows filtering of requirements that are appropriate for use versus not
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// An explanatory or alternate title for the requirements giving additional information about its content
-	Subtitle *string `json:"subtitle,omitempty"`
+	Subtitle        *string         `json:"subtitle,omitempty"`
+	SubtitleElement *common.Element `json:"_subtitle,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Descriptive topics related to the content of the requirements
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
@@ -82,14 +94,17 @@ type Requirements struct {
 	Type *common.CodeableConcept `json:"type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description of how the requirements is used from a clinical perspective
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different requirements instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
@@ -13,7 +13,8 @@ type ResearchStudy struct {
 	ResourceType string `json:"resourceType"` // Always "ResearchStudy"
 
 	// The date on which the research study was approved by the research ethics board
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.DateTime `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element  `json:"_approvalDate,omitempty"`
 
 	// Classification for the study
 	Category []common.CodeableConcept `json:"category,omitempty"`
@@ -22,10 +23,12 @@ type ResearchStudy struct {
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// A full description of how the study is being conducted
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The date on which the research study was terminated
-	EndDate *string `json:"endDate,omitempty"`
+	EndDate        *common.DateTime `json:"endDate,omitempty"`
+	EndDateElement *common.Element  `json:"_endDate,omitempty"`
 
 	// The facility where the study is being conducted
 	Facility []common.Reference `json:"facility,omitempty"`
@@ -52,25 +55,30 @@ type ResearchStudy struct {
 	ReasonStopped *common.CodeableConcept `json:"reasonStopped,omitempty"`
 
 	// Related artifacts such as publications, references, and other resources
-	RelatedArtifact []interface{} `json:"relatedArtifact,omitempty"`
+	RelatedArtifact []RelatedArtifact `json:"relatedArtifact,omitempty"`
 
 	// The party responsible for the execution of the study
 	Sponsor *common.Reference `json:"sponsor,omitempty"`
 
 	// The date on which the research study began
-	StartDate *string `json:"startDate,omitempty"`
+	StartDate        *common.DateTime `json:"startDate,omitempty"`
+	StartDateElement *common.Element  `json:"_startDate,omitempty"`
 
 	// The current state of the study
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The title of the study
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// The URL where the study can be found
-	URL *string `json:"url,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	URLElement *common.Element `json:"_url,omitempty"`
 
 	// The version of the study
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// The subjects that are part of the study
 	Enrollment []common.Reference `json:"enrollment,omitempty"`
@@ -88,7 +96,7 @@ type ResearchStudy struct {
 	Site []common.Reference `json:"site,omitempty"`
 
 	// The design of the study
-	StudyDesign []interface{} `json:"studyDesign,omitempty"`
+	StudyDesign []common.CodeableConcept `json:"studyDesign,omitempty"`
 
 	// The target population for the study
 	TargetPopulation []common.Reference `json:"targetPopulation,omitempty"`
@@ -11,7 +11,8 @@ type ResearchSubjectProgress struct {
 	common.BackboneElement
 
 	// The date when the state ended
-	EndDate *string `json:"endDate,omitempty"`
+	EndDate        *common.DateTime `json:"endDate,omitempty"`
+	EndDateElement *common.Element  `json:"_endDate,omitempty"`
 
 	// The milestone achieved
 	Milestone *common.CodeableConcept `json:"milestone,omitempty"`
@@ -20,7 +21,8 @@ type ResearchSubjectProgress struct {
 	Reason *common.CodeableConcept `json:"reason,omitempty"`
 
 	// The date when the state started
-	StartDate *string `json:"startDate,omitempty"`
+	StartDate        *common.DateTime `json:"startDate,omitempty"`
+	StartDateElement *common.Element  `json:"_startDate,omitempty"`
 
 	// The current state of the subject
 	SubjectState *common.CodeableConcept `json:"subjectState,omitempty"`
@@ -39,10 +41,12 @@ type ResearchSubject struct {
 	ResourceType string `json:"resourceType"` // Always "ResearchSubject"
 
 	// The name of the arm in the study the subject actually followed
-	ActualComparisonGroup *string `json:"actualComparisonGroup,omitempty"`
+	ActualComparisonGroup        *string         `json:"actualComparisonGroup,omitempty"`
+	ActualComparisonGroupElement *common.Element `json:"_actualComparisonGroup,omitempty"`
 
 	// The name of the arm assigned to the subject
-	AssignedComparisonGroup *string `json:"assignedComparisonGroup,omitempty"`
+	AssignedComparisonGroup        *string         `json:"assignedComparisonGroup,omitempty"`
+	AssignedComparisonGroupElement *common.Element `json:"_assignedComparisonGroup,omitempty"`
 
 	// A record of the patient's informed agreement to participate in the study
 	Consent []common.Reference `json:"consent,omitempty"`
@@ -57,7 +61,8 @@ type ResearchSubject struct {
 	Progress []ResearchSubjectProgress `json:"progress,omitempty"`
 
 	// The publication state of the resource (not of the subject)
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// Reference to the study the subject is participating in
 	Study common.Reference `json:"study"`
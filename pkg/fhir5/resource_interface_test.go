@@ -0,0 +1,33 @@
+package fhir5
+
+import (
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+func TestResourceInterface(t *testing.T) {
+	resources := []common.Resource{
+		&Patient{},
+		&Observation{},
+		&Bundle{},
+		&common.RawResource{ResourceType: "CustomThing", Data: []byte(`{"resourceType":"CustomThing"}`)},
+	}
+	for _, resource := range resources {
+		resource.SetID(StringPtr("example"))
+		if id := resource.GetID(); id == nil || *id != "example" {
+			t.Errorf("%s: expected id example, got %v", resource.GetResourceType(), id)
+		}
+	}
+
+	var resource AnyDomainResource = &Observation{}
+	resource.SetMeta(&Meta{VersionID: StringPtr("2")})
+	resource.SetExtension([]common.Extension{{URL: "http://example.org/ext"}})
+	resource.SetContained([]common.Resource{&Patient{}})
+	if resource.GetResourceType() != "Observation" {
+		t.Errorf("expected resource type Observation, got %s", resource.GetResourceType())
+	}
+	if *resource.GetMeta().VersionID != "2" || len(resource.GetExtension()) != 1 || len(resource.GetContained()) != 1 {
+		t.Errorf("setters were not applied: %+v", resource)
+	}
+}
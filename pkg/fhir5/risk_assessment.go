@@ -13,17 +13,20 @@ type RiskAssessmentPrediction struct {
 	Outcome *common.CodeableConcept `json:"outcome,omitempty"`
 
 	// If range is used, it represents the lower and upper bounds of certainty; e.g. 40-60%
-	ProbabilityDecimal *float64 `json:"probabilityDecimal,omitempty"`
-	ProbabilityRange   *Range   `json:"probabilityRange,omitempty"`
+	ProbabilityDecimal        *common.Decimal `json:"probabilityDecimal,omitempty"`
+	ProbabilityDecimalElement *common.Element `json:"_probabilityDecimal,omitempty"`
+	ProbabilityRange          *Range          `json:"probabilityRange,omitempty"`
 
 	// Indicates how likely the outcome is (in the specified timeframe), expressed as a qualitative value
 	QualitativeRisk *common.CodeableConcept `json:"qualitativeRisk,omitempty"`
 
 	// Additional information explaining the basis for the prediction
-	Rationale *string `json:"rationale,omitempty"`
+	Rationale        *string         `json:"rationale,omitempty"`
+	RationaleElement *common.Element `json:"_rationale,omitempty"`
 
 	// Indicates the risk for this particular subject divided by the risk of the population in general
-	RelativeRisk *float64 `json:"relativeRisk,omitempty"`
+	RelativeRisk        *common.Decimal `json:"relativeRisk,omitempty"`
+	RelativeRiskElement *common.Element `json:"_relativeRisk,omitempty"`
 
 	// If not specified, the risk applies "over the subject's lifespan"
 	WhenPeriod *common.Period `json:"whenPeriod,omitempty"`
@@ -59,14 +62,16 @@ type RiskAssessment struct {
 	Method *common.CodeableConcept `json:"method,omitempty"`
 
 	// A description of the steps that might be taken to reduce the identified risk(s)
-	Mitigation *string `json:"mitigation,omitempty"`
+	Mitigation        *string         `json:"mitigation,omitempty"`
+	MitigationElement *common.Element `json:"_mitigation,omitempty"`
 
 	// Additional comments about the risk assessment
 	Note []Annotation `json:"note,omitempty"`
 
 	// The date (and possibly time) the risk assessment was performed
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
 
 	// A reference to a resource that this risk assessment is part of, such as a Procedure
 	Parent *common.Reference `json:"parent,omitempty"`
@@ -81,7 +86,8 @@ type RiskAssessment struct {
 	Reason []CodeableReference `json:"reason,omitempty"`
 
 	// The status of the RiskAssessment, using the same statuses as an Observation
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The patient or group the risk assessment applies to
 	Subject common.Reference `json:"subject"`
@@ -13,19 +13,22 @@ type Schedule struct {
 	ResourceType string `json:"resourceType"` // Always "Schedule"
 
 	// This element is labeled as a modifier because it may be used to mark that the resource was created in error
-	Active *bool `json:"active,omitempty"`
+	Active        *bool           `json:"active,omitempty"`
+	ActiveElement *common.Element `json:"_active,omitempty"`
 
 	// The capacity to support multiple referenced resource types should be used in cases where the specific resources themselves cannot be scheduled without the other
 	Actor []common.Reference `json:"actor"`
 
 	// Comments on the availability to describe any extended information
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// External Ids for this item
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// This MAY be used to describe what the schedule is for where it is clearer than just the name of the single actor
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The period of time that the slots that reference this Schedule resource cover
 	PlanningHorizon *common.Period `json:"planningHorizon,omitempty"`
@@ -10,10 +10,12 @@ type SearchParameterComponent struct {
 	common.BackboneElement
 
 	// The definition of the search parameter that describes this part
-	Definition string `json:"definition"`
+	Definition        string          `json:"definition"`
+	DefinitionElement *common.Element `json:"_definition,omitempty"`
 
 	// This expression overrides the expression in the definition and extracts the index values from the outcome of the composite expression
-	Expression string `json:"expression"`
+	Expression        string          `json:"expression"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 }
 
 // SearchParameter represents a search parameter that defines a named search item that can be used to search/filter on a resource
@@ -24,86 +26,111 @@ type SearchParameter struct {
 	ResourceType string `json:"resourceType"` // Always "SearchParameter"
 
 	// A search parameter must always apply to at least one resource type
-	Base []string `json:"base"`
+	Base        []string          `json:"base"`
+	BaseElement []*common.Element `json:"_base,omitempty"`
 
 	// Notes about chaining support
-	Chain []string `json:"chain,omitempty"`
+	Chain        []string          `json:"chain,omitempty"`
+	ChainElement []*common.Element `json:"_chain,omitempty"`
 
 	// For maximum compatibility, use only lowercase ASCII characters
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Used to define the parts of a composite search parameter
 	Component []SearchParameterComponent `json:"component,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Comparator []string `json:"comparator,omitempty"`
+	Comparator        []string          `json:"comparator,omitempty"`
+	ComparatorElement []*common.Element `json:"_comparator,omitempty"`
 
 	// A compound search parameter that can be used to define a complex search parameter
-	Composite *string `json:"composite,omitempty"`
+	Composite        *string         `json:"composite,omitempty"`
+	CompositeElement *common.Element `json:"_composite,omitempty"`
 
 	// Contact details to assist a user in finding and communicating with the publisher
 	Contact []ContactDetail `json:"contact,omitempty"`
 
 	// The date when the search parameter was last reviewed
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// A FHIRPath expression that returns a set of elements for the search parameter
-	DerivedFrom *string `json:"derivedFrom,omitempty"`
+	DerivedFrom        *string         `json:"derivedFrom,omitempty"`
+	DerivedFromElement *common.Element `json:"_derivedFrom,omitempty"`
 
 	// This description can be used to capture details
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Allows filtering of search parameters that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// A FHIRPath expression that returns a set of elements for the search parameter
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Modifier []string `json:"modifier,omitempty"`
+	Modifier        []string          `json:"modifier,omitempty"`
+	ModifierElement []*common.Element `json:"_modifier,omitempty"`
 
 	// A natural language name identifying the search parameter
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	MultipleOr *bool `json:"multipleOr,omitempty"`
+	MultipleOr        *bool           `json:"multipleOr,omitempty"`
+	MultipleOrElement *common.Element `json:"_multipleOr,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	MultipleAnd *bool `json:"multipleAnd,omitempty"`
+	MultipleAnd        *bool           `json:"multipleAnd,omitempty"`
+	MultipleAndElement *common.Element `json:"_multipleAnd,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Operator []string `json:"operator,omitempty"`
+	Operator        []string          `json:"operator,omitempty"`
+	OperatorElement []*common.Element `json:"_operator,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the search parameter
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Status string `json:"status"`
+	Status        string          `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Target []string `json:"target,omitempty"`
+	Target        []string          `json:"target,omitempty"`
+	TargetElement []*common.Element `json:"_target,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Type string `json:"type"`
+	Type        string          `json:"type"`
+	TypeElement *common.Element `json:"_type,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// A detailed description of how the search parameter is used from a clinical perspective
-	Usage *string `json:"usage,omitempty"`
+	Usage        *string         `json:"usage,omitempty"`
+	UsageElement *common.Element `json:"_usage,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
-	UseContext []interface{} `json:"useContext,omitempty"`
+	UseContext []UsageContext `json:"useContext,omitempty"`
 
 	// There may be different search parameter instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	Xpath *string `json:"xpath,omitempty"`
+	Xpath        *string         `json:"xpath,omitempty"`
+	XpathElement *common.Element `json:"_xpath,omitempty"`
 
 	// The type of value a search parameter refers to, and how the content is interpreted
-	XpathUsage *string `json:"xpathUsage,omitempty"`
+	XpathUsage        *string         `json:"xpathUsage,omitempty"`
+	XpathUsageElement *common.Element `json:"_xpathUsage,omitempty"`
 }
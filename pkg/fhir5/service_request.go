@@ -52,10 +52,12 @@ type ServiceRequest struct {
 
 	// If a CodeableConcept is present, it indicates the pre-condition for performing the service
 	AsNeededBoolean         *bool                   `json:"asNeededBoolean,omitempty"`
+	AsNeededBooleanElement  *common.Element         `json:"_asNeededBoolean,omitempty"`
 	AsNeededCodeableConcept *common.CodeableConcept `json:"asNeededCodeableConcept,omitempty"`
 
 	// When the request transitioned to being actionable
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Plan/proposal/order fulfilled by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -73,7 +75,8 @@ type ServiceRequest struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// If do not perform is not specified, the request is a positive request
-	DoNotPerform *bool `json:"doNotPerform,omitempty"`
+	DoNotPerform        *bool           `json:"doNotPerform,omitempty"`
+	DoNotPerformElement *common.Element `json:"_doNotPerform,omitempty"`
 
 	// An encounter that provides additional context in which this request is made
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -82,10 +85,12 @@ type ServiceRequest struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, order set or other definition
-	InstantiatesCanonical []string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        []string          `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement []*common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained protocol, guideline, order set
-	InstantiatesUri []string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        []string          `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement []*common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Additional clinical information
 	Instructions []Instruction `json:"instructions,omitempty"`
@@ -94,7 +99,8 @@ type ServiceRequest struct {
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// proposal | plan | directive | order | original-order | reflex-order | filler-order | instance-order | option
-	Intent ServiceRequestIntent `json:"intent"`
+	Intent        ServiceRequestIntent `json:"intent"`
+	IntentElement *common.Element      `json:"_intent,omitempty"`
 
 	// Where procedure is going to be done
 	Location []common.Reference `json:"location,omitempty"`
@@ -106,12 +112,14 @@ type ServiceRequest struct {
 	Note []Annotation `json:"note,omitempty"`
 
 	// When the request should be fulfilled
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrenceAge      *common.Age    `json:"occurrenceAge,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceRange    *Range         `json:"occurrenceRange,omitempty"`
-	OccurrenceString   *string        `json:"occurrenceString,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrenceAge             *common.Age      `json:"occurrenceAge,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceRange           *Range           `json:"occurrenceRange,omitempty"`
+	OccurrenceString          *string          `json:"occurrenceString,omitempty"`
+	OccurrenceStringElement   *common.Element  `json:"_occurrenceString,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// The individual who initiated the request and has responsibility for its activation
 	OrderDetail []common.CodeableConcept `json:"orderDetail,omitempty"`
@@ -123,7 +131,8 @@ type ServiceRequest struct {
 	PerformerType *common.CodeableConcept `json:"performerType,omitempty"`
 
 	// routine | urgent | asap | stat
-	Priority *ServiceRequestPriority `json:"priority,omitempty"`
+	Priority        *ServiceRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element         `json:"_priority,omitempty"`
 
 	// An explanation or justification for why this service is being requested
 	Reason []CodeableReference `json:"reason,omitempty"`
@@ -135,7 +144,8 @@ type ServiceRequest struct {
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// draft | active | on-hold | revoked | completed | entered-in-error | unknown
-	Status ServiceRequestStatus `json:"status"`
+	Status        ServiceRequestStatus `json:"status"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// Reason for current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
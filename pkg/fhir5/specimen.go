@@ -8,15 +8,17 @@ import (
 // SpecimenFeature represents a physical feature or landmark on a specimen
 type SpecimenFeature struct {
 	common.BackboneElement
-	Description string                 `json:"description"`
-	Type        common.CodeableConcept `json:"type"`
+	Description        string                 `json:"description"`
+	DescriptionElement *common.Element        `json:"_description,omitempty"`
+	Type               common.CodeableConcept `json:"type"`
 }
 
 // SpecimenCollection represents details concerning the specimen collection
 type SpecimenCollection struct {
 	common.BackboneElement
 	BodySite                     *CodeableReference      `json:"bodySite,omitempty"`
-	CollectedDateTime            *string                 `json:"collectedDateTime,omitempty"`
+	CollectedDateTime            *common.DateTime        `json:"collectedDateTime,omitempty"`
+	CollectedDateTimeElement     *common.Element         `json:"_collectedDateTime,omitempty"`
 	CollectedPeriod              *common.Period          `json:"collectedPeriod,omitempty"`
 	Collector                    *common.Reference       `json:"collector,omitempty"`
 	Device                       *CodeableReference      `json:"device,omitempty"`
@@ -31,11 +33,13 @@ type SpecimenCollection struct {
 // SpecimenProcessing represents details concerning processing and processing steps for the specimen
 type SpecimenProcessing struct {
 	common.BackboneElement
-	Additive     []common.Reference      `json:"additive,omitempty"`
-	Description  *string                 `json:"description,omitempty"`
-	Method       *common.CodeableConcept `json:"method,omitempty"`
-	TimeDateTime *string                 `json:"timeDateTime,omitempty"`
-	TimePeriod   *common.Period          `json:"timePeriod,omitempty"`
+	Additive            []common.Reference      `json:"additive,omitempty"`
+	Description         *string                 `json:"description,omitempty"`
+	DescriptionElement  *common.Element         `json:"_description,omitempty"`
+	Method              *common.CodeableConcept `json:"method,omitempty"`
+	TimeDateTime        *common.DateTime        `json:"timeDateTime,omitempty"`
+	TimeDateTimeElement *common.Element         `json:"_timeDateTime,omitempty"`
+	TimePeriod          *common.Period          `json:"timePeriod,omitempty"`
 }
 
 // SpecimenContainer represents the container holding the specimen
@@ -53,6 +57,7 @@ type Specimen struct {
 	AccessionIdentifier *common.Identifier       `json:"accessionIdentifier,omitempty"`
 	Collection          *SpecimenCollection      `json:"collection,omitempty"`
 	Combined            *string                  `json:"combined,omitempty"`
+	CombinedElement     *common.Element          `json:"_combined,omitempty"`
 	Condition           []common.CodeableConcept `json:"condition,omitempty"`
 	Container           []SpecimenContainer      `json:"container,omitempty"`
 	Feature             []SpecimenFeature        `json:"feature,omitempty"`
@@ -60,9 +65,11 @@ type Specimen struct {
 	Note                []Annotation             `json:"note,omitempty"`
 	Processing          []SpecimenProcessing     `json:"processing,omitempty"`
 	Parent              []common.Reference       `json:"parent,omitempty"`
-	ReceivedTime        *string                  `json:"receivedTime,omitempty"`
+	ReceivedTime        *common.DateTime         `json:"receivedTime,omitempty"`
+	ReceivedTimeElement *common.Element          `json:"_receivedTime,omitempty"`
 	Request             []common.Reference       `json:"request,omitempty"`
 	Status              *string                  `json:"status,omitempty"`
+	StatusElement       *common.Element          `json:"_status,omitempty"`
 	Subject             *common.Reference        `json:"subject,omitempty"`
 	Type                *common.CodeableConcept  `json:"type,omitempty"`
 }
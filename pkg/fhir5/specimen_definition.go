@@ -15,20 +15,23 @@ type SpecimenDefinitionTypeTestedContainerAdditive struct {
 // SpecimenDefinitionTypeTestedContainer represents the container for a specimen
 type SpecimenDefinitionTypeTestedContainer struct {
 	common.BackboneElement
-	Additive              []SpecimenDefinitionTypeTestedContainerAdditive `json:"additive,omitempty"`
-	Cap                   *common.CodeableConcept                         `json:"cap,omitempty"`
-	Capacity              *common.Quantity                                `json:"capacity,omitempty"`
-	Material              *common.CodeableConcept                         `json:"material,omitempty"`
-	MinimumVolumeQuantity *common.Quantity                                `json:"minimumVolumeQuantity,omitempty"`
-	MinimumVolumeString   *string                                         `json:"minimumVolumeString,omitempty"`
-	Preparation           *string                                         `json:"preparation,omitempty"`
-	Type                  *common.CodeableConcept                         `json:"type,omitempty"`
+	Additive                   []SpecimenDefinitionTypeTestedContainerAdditive `json:"additive,omitempty"`
+	Cap                        *common.CodeableConcept                         `json:"cap,omitempty"`
+	Capacity                   *common.Quantity                                `json:"capacity,omitempty"`
+	Material                   *common.CodeableConcept                         `json:"material,omitempty"`
+	MinimumVolumeQuantity      *common.Quantity                                `json:"minimumVolumeQuantity,omitempty"`
+	MinimumVolumeString        *string                                         `json:"minimumVolumeString,omitempty"`
+	MinimumVolumeStringElement *common.Element                                 `json:"_minimumVolumeString,omitempty"`
+	Preparation                *string                                         `json:"preparation,omitempty"`
+	PreparationElement         *common.Element                                 `json:"_preparation,omitempty"`
+	Type                       *common.CodeableConcept                         `json:"type,omitempty"`
 }
 
 // SpecimenDefinitionTypeTestedHandling represents handling instructions for a specimen
 type SpecimenDefinitionTypeTestedHandling struct {
 	common.BackboneElement
 	Instruction          *string                 `json:"instruction,omitempty"`
+	InstructionElement   *common.Element         `json:"_instruction,omitempty"`
 	MaxDuration          *Duration               `json:"maxDuration,omitempty"`
 	TemperatureQualifier *common.CodeableConcept `json:"temperatureQualifier,omitempty"`
 	TemperatureRange     *Range                  `json:"temperatureRange,omitempty"`
@@ -41,43 +44,61 @@ type SpecimenDefinitionTypeTested struct {
 	Diagnostic         *common.Reference                      `json:"diagnostic,omitempty"`
 	Handling           []SpecimenDefinitionTypeTestedHandling `json:"handling,omitempty"`
 	IsDerived          *bool                                  `json:"isDerived,omitempty"`
+	IsDerivedElement   *common.Element                        `json:"_isDerived,omitempty"`
 	Type               *common.CodeableConcept                `json:"type,omitempty"`
 	Preference         string                                 `json:"preference"`
+	PreferenceElement  *common.Element                        `json:"_preference,omitempty"`
 	RejectionCriterion []common.CodeableConcept               `json:"rejectionCriterion,omitempty"`
 	Requirement        *string                                `json:"requirement,omitempty"`
+	RequirementElement *common.Element                        `json:"_requirement,omitempty"`
 	RetentionTime      *Duration                              `json:"retentionTime,omitempty"`
 }
 
 // SpecimenDefinition represents a kind of specimen with associated set of requirements
 type SpecimenDefinition struct {
 	DomainResource
-	ResourceType    string                         `json:"resourceType"` // Always "SpecimenDefinition"
-	ApprovalDate    *string                        `json:"approvalDate,omitempty"`
-	Author          []ContactDetail                `json:"author,omitempty"`
-	Contact         []ContactDetail                `json:"contact,omitempty"`
-	Copyright       *string                        `json:"copyright,omitempty"`
-	Date            *string                        `json:"date,omitempty"`
-	Description     *string                        `json:"description,omitempty"`
-	Editor          []ContactDetail                `json:"editor,omitempty"`
-	EffectivePeriod *common.Period                 `json:"effectivePeriod,omitempty"`
-	Endorser        []ContactDetail                `json:"endorser,omitempty"`
-	Experimental    *bool                          `json:"experimental,omitempty"`
-	Identifier      []common.Identifier            `json:"identifier,omitempty"`
-	Jurisdiction    []common.CodeableConcept       `json:"jurisdiction,omitempty"`
-	LastReviewDate  *string                        `json:"lastReviewDate,omitempty"`
-	Name            *string                        `json:"name,omitempty"`
-	Publisher       *string                        `json:"publisher,omitempty"`
-	Purpose         *string                        `json:"purpose,omitempty"`
-	RelatedArtifact []interface{}                  `json:"relatedArtifact,omitempty"`
-	Reviewer        []ContactDetail                `json:"reviewer,omitempty"`
-	Status          string                         `json:"status"`
-	Subtitle        *string                        `json:"subtitle,omitempty"`
-	Title           *string                        `json:"title,omitempty"`
-	Topic           []common.CodeableConcept       `json:"topic,omitempty"`
-	Type            *common.CodeableConcept        `json:"type,omitempty"`
-	URL             *string                        `json:"url,omitempty"`
-	Usage           *string                        `json:"usage,omitempty"`
-	UseContext      []interface{}                  `json:"useContext,omitempty"`
-	Version         *string                        `json:"version,omitempty"`
-	TypeTested      []SpecimenDefinitionTypeTested `json:"typeTested,omitempty"`
+	ResourceType          string                         `json:"resourceType"` // Always "SpecimenDefinition"
+	ApprovalDate          *common.Date                   `json:"approvalDate,omitempty"`
+	ApprovalDateElement   *common.Element                `json:"_approvalDate,omitempty"`
+	Author                []ContactDetail                `json:"author,omitempty"`
+	Contact               []ContactDetail                `json:"contact,omitempty"`
+	Copyright             *string                        `json:"copyright,omitempty"`
+	CopyrightElement      *common.Element                `json:"_copyright,omitempty"`
+	Date                  *common.DateTime               `json:"date,omitempty"`
+	DateElement           *common.Element                `json:"_date,omitempty"`
+	Description           *string                        `json:"description,omitempty"`
+	DescriptionElement    *common.Element                `json:"_description,omitempty"`
+	Editor                []ContactDetail                `json:"editor,omitempty"`
+	EffectivePeriod       *common.Period                 `json:"effectivePeriod,omitempty"`
+	Endorser              []ContactDetail                `json:"endorser,omitempty"`
+	Experimental          *bool                          `json:"experimental,omitempty"`
+	ExperimentalElement   *common.Element                `json:"_experimental,omitempty"`
+	Identifier            []common.Identifier            `json:"identifier,omitempty"`
+	Jurisdiction          []common.CodeableConcept       `json:"jurisdiction,omitempty"`
+	LastReviewDate        *common.Date                   `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element                `json:"_lastReviewDate,omitempty"`
+	Name                  *string                        `json:"name,omitempty"`
+	NameElement           *common.Element                `json:"_name,omitempty"`
+	Publisher             *string                        `json:"publisher,omitempty"`
+	PublisherElement      *common.Element                `json:"_publisher,omitempty"`
+	Purpose               *string                        `json:"purpose,omitempty"`
+	PurposeElement        *common.Element                `json:"_purpose,omitempty"`
+	RelatedArtifact       []RelatedArtifact              `json:"relatedArtifact,omitempty"`
+	Reviewer              []ContactDetail                `json:"reviewer,omitempty"`
+	Status                string                         `json:"status"`
+	StatusElement         *common.Element                `json:"_status,omitempty"`
+	Subtitle              *string                        `json:"subtitle,omitempty"`
+	SubtitleElement       *common.Element                `json:"_subtitle,omitempty"`
+	Title                 *string                        `json:"title,omitempty"`
+	TitleElement          *common.Element                `json:"_title,omitempty"`
+	Topic                 []common.CodeableConcept       `json:"topic,omitempty"`
+	Type                  *common.CodeableConcept        `json:"type,omitempty"`
+	URL                   *string                        `json:"url,omitempty"`
+	URLElement            *common.Element                `json:"_url,omitempty"`
+	Usage                 *string                        `json:"usage,omitempty"`
+	UsageElement          *common.Element                `json:"_usage,omitempty"`
+	UseContext            []UsageContext                 `json:"useContext,omitempty"`
+	Version               *string                        `json:"version,omitempty"`
+	VersionElement        *common.Element                `json:"_version,omitempty"`
+	TypeTested            []SpecimenDefinitionTypeTested `json:"typeTested,omitempty"`
 }
@@ -0,0 +1,111 @@
+package fhir5
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+func TestStructureDefinition_RoundTripSerialization(t *testing.T) {
+	files, err := filepath.Glob("testdata/fhir5-json/*.profile.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to list profiles: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read profile %s: %v", file, err)
+		}
+		var sd StructureDefinition
+		test_utils.CompareRoundTripSerialization(t, data, &sd, file)
+	}
+}
+
+func TestStructureDefinition_ElementDefinition(t *testing.T) {
+	data, err := os.ReadFile("testdata/fhir5-json/bodyweight.profile.json")
+	if err != nil {
+		t.Fatalf("failed to read profile: %v", err)
+	}
+	var sd StructureDefinition
+	if err := json.Unmarshal(data, &sd); err != nil {
+		t.Fatalf("failed to unmarshal profile: %v", err)
+	}
+	if sd.Derivation == nil || *sd.Derivation != StructureDefinitionDerivationConstraint {
+		t.Errorf("expected a constraint, got %v", sd.Derivation)
+	}
+
+	elements := map[string]ElementDefinition{}
+	for _, element := range sd.Differential.Element {
+		elements[*element.ID] = element
+	}
+	slicing := elements["Observation.code.coding"].Slicing
+	if slicing == nil || slicing.Rules != ElementDefinitionSlicingRulesOpen || len(slicing.Discriminator) != 2 ||
+		slicing.Discriminator[0].Type != ElementDefinitionSlicingDiscriminatorTypeValue || slicing.Discriminator[0].Path != "code" {
+		t.Errorf("unexpected slicing %+v", slicing)
+	}
+	if slice := elements["Observation.code.coding:BodyWeightCode"]; slice.SliceName == nil || *slice.SliceName != "BodyWeightCode" {
+		t.Errorf("expected the BodyWeightCode slice, got %+v", slice.SliceName)
+	}
+	system := elements["Observation.code.coding:BodyWeightCode.system"]
+	if value, typeName := system.Fixed(); typeName != "Uri" || *value.(*string) != "http://loinc.org" {
+		t.Errorf("expected fixedUri, got %v (%s)", value, typeName)
+	}
+	if value := elements["Observation.valueQuantity.value"]; value.MustSupport == nil || !*value.MustSupport || *value.Min != 1 {
+		t.Errorf("expected a required must support element, got %+v", value)
+	}
+
+	for _, element := range sd.Snapshot.Element {
+		if element.Base == nil {
+			t.Errorf("%s: expected base in the snapshot", element.Path)
+		}
+	}
+}
+
+func TestStructureDefinition_Authoring(t *testing.T) {
+	derivation := StructureDefinitionDerivationConstraint
+	sd := StructureDefinition{
+		ResourceType:   "StructureDefinition",
+		Url:            "http://example.org/fhir/StructureDefinition/active-patient",
+		Name:           "ActivePatient",
+		Status:         StructureDefinitionStatusDraft,
+		Kind:           StructureDefinitionKindResource,
+		Type:           "Patient",
+		BaseDefinition: StringPtr("http://hl7.org/fhir/StructureDefinition/Patient"),
+		Derivation:     &derivation,
+		Differential: &StructureDefinitionDifferential{
+			Element: []ElementDefinition{
+				{Path: "Patient.active", Min: IntPtr(1), MustSupport: BoolPtr(true), PatternBoolean: BoolPtr(true)},
+				{
+					Path:    "Patient.identifier",
+					Slicing: &ElementDefinitionSlicing{Rules: ElementDefinitionSlicingRulesOpen},
+				},
+				{
+					Path:      "Patient.identifier",
+					SliceName: StringPtr("mrn"),
+					PatternIdentifier: &common.Identifier{
+						System: StringPtr("http://example.org/mrn"),
+					},
+				},
+			},
+		},
+	}
+	if err := common.ValidateChoices(&sd); err != nil {
+		t.Errorf("unexpected choice errors: %v", err)
+	}
+	data, err := json.Marshal(sd)
+	if err != nil {
+		t.Fatalf("failed to marshal profile: %v", err)
+	}
+	for _, want := range []string{`"patternBoolean":true`, `"mustSupport":true`, `"slicing":{"rules":"open"}`, `"sliceName":"mrn"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s in %s", want, data)
+		}
+	}
+	var decoded StructureDefinition
+	test_utils.CompareRoundTripSerialization(t, data, &decoded, "authored profile")
+}
@@ -7,114 +7,170 @@ import "github.com/d4l-data4life/go-fhir/pkg/common"
 // See: https://hl7.org/fhir/structuremap.html
 type StructureMapStructure struct {
 	common.BackboneElement
-	Alias         *string `json:"alias,omitempty"`
-	Documentation *string `json:"documentation,omitempty"`
-	Mode          string  `json:"mode"`
-	URL           string  `json:"url"`
+	Alias                *string         `json:"alias,omitempty"`
+	AliasElement         *common.Element `json:"_alias,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+	Mode                 string          `json:"mode"`
+	ModeElement          *common.Element `json:"_mode,omitempty"`
+	URL                  string          `json:"url"`
+	URLElement           *common.Element `json:"_url,omitempty"`
 }
 
 type StructureMapConst struct {
 	common.BackboneElement
-	Name  *string `json:"name,omitempty"`
-	Value *string `json:"value,omitempty"`
+	Name         *string         `json:"name,omitempty"`
+	NameElement  *common.Element `json:"_name,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 type StructureMapGroupInput struct {
 	common.BackboneElement
-	Documentation *string `json:"documentation,omitempty"`
-	Mode          string  `json:"mode"`
-	Name          string  `json:"name"`
-	Type          *string `json:"type,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
+	Mode                 string          `json:"mode"`
+	ModeElement          *common.Element `json:"_mode,omitempty"`
+	Name                 string          `json:"name"`
+	NameElement          *common.Element `json:"_name,omitempty"`
+	Type                 *string         `json:"type,omitempty"`
+	TypeElement          *common.Element `json:"_type,omitempty"`
 }
 
 type StructureMapGroupRuleSource struct {
 	common.BackboneElement
-	Check        *string `json:"check,omitempty"`
-	Condition    *string `json:"condition,omitempty"`
-	Context      string  `json:"context"`
-	DefaultValue *string `json:"defaultValue,omitempty"`
-	Element      *string `json:"element,omitempty"`
-	ListMode     *string `json:"listMode,omitempty"`
-	LogMessage   *string `json:"logMessage,omitempty"`
-	Max          *string `json:"max,omitempty"`
-	Min          *int    `json:"min,omitempty"`
-	Type         *string `json:"type,omitempty"`
-	Variable     *string `json:"variable,omitempty"`
+	Check               *string         `json:"check,omitempty"`
+	CheckElement        *common.Element `json:"_check,omitempty"`
+	Condition           *string         `json:"condition,omitempty"`
+	ConditionElement    *common.Element `json:"_condition,omitempty"`
+	Context             string          `json:"context"`
+	ContextElement      *common.Element `json:"_context,omitempty"`
+	DefaultValue        *string         `json:"defaultValue,omitempty"`
+	DefaultValueElement *common.Element `json:"_defaultValue,omitempty"`
+	Element             *string         `json:"element,omitempty"`
+	ElementElement      *common.Element `json:"_element,omitempty"`
+	ListMode            *string         `json:"listMode,omitempty"`
+	ListModeElement     *common.Element `json:"_listMode,omitempty"`
+	LogMessage          *string         `json:"logMessage,omitempty"`
+	LogMessageElement   *common.Element `json:"_logMessage,omitempty"`
+	Max                 *string         `json:"max,omitempty"`
+	MaxElement          *common.Element `json:"_max,omitempty"`
+	Min                 *int            `json:"min,omitempty"`
+	MinElement          *common.Element `json:"_min,omitempty"`
+	Type                *string         `json:"type,omitempty"`
+	TypeElement         *common.Element `json:"_type,omitempty"`
+	Variable            *string         `json:"variable,omitempty"`
+	VariableElement     *common.Element `json:"_variable,omitempty"`
 }
 
 type StructureMapGroupRuleTargetParameter struct {
 	common.BackboneElement
-	ValueId       *string  `json:"valueId,omitempty"`
-	ValueString   *string  `json:"valueString,omitempty"`
-	ValueBoolean  *bool    `json:"valueBoolean,omitempty"`
-	ValueInteger  *int     `json:"valueInteger,omitempty"`
-	ValueDecimal  *float64 `json:"valueDecimal,omitempty"`
-	ValueDate     *string  `json:"valueDate,omitempty"`
-	ValueTime     *string  `json:"valueTime,omitempty"`
-	ValueDateTime *string  `json:"valueDateTime,omitempty"`
+	ValueId              *string          `json:"valueId,omitempty"`
+	ValueIdElement       *common.Element  `json:"_valueId,omitempty"`
+	ValueString          *string          `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element  `json:"_valueString,omitempty"`
+	ValueBoolean         *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueInteger         *int             `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element  `json:"_valueInteger,omitempty"`
+	ValueDecimal         *common.Decimal  `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element  `json:"_valueDecimal,omitempty"`
+	ValueDate            *common.Date     `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element  `json:"_valueDate,omitempty"`
+	ValueTime            *common.Time     `json:"valueTime,omitempty"`
+	ValueTimeElement     *common.Element  `json:"_valueTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 }
 
 type StructureMapGroupRuleTarget struct {
 	common.BackboneElement
-	Context    *string                                `json:"context,omitempty"`
-	Element    *string                                `json:"element,omitempty"`
-	ListMode   []string                               `json:"listMode,omitempty"`
-	ListRuleId *string                                `json:"listRuleId,omitempty"`
-	Parameter  []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty"`
-	Transform  *string                                `json:"transform,omitempty"`
-	Variable   *string                                `json:"variable,omitempty"`
+	Context           *string                                `json:"context,omitempty"`
+	ContextElement    *common.Element                        `json:"_context,omitempty"`
+	Element           *string                                `json:"element,omitempty"`
+	ElementElement    *common.Element                        `json:"_element,omitempty"`
+	ListMode          []string                               `json:"listMode,omitempty"`
+	ListModeElement   []*common.Element                      `json:"_listMode,omitempty"`
+	ListRuleId        *string                                `json:"listRuleId,omitempty"`
+	ListRuleIdElement *common.Element                        `json:"_listRuleId,omitempty"`
+	Parameter         []StructureMapGroupRuleTargetParameter `json:"parameter,omitempty"`
+	Transform         *string                                `json:"transform,omitempty"`
+	TransformElement  *common.Element                        `json:"_transform,omitempty"`
+	Variable          *string                                `json:"variable,omitempty"`
+	VariableElement   *common.Element                        `json:"_variable,omitempty"`
 }
 
 type StructureMapGroupRuleDependent struct {
 	common.BackboneElement
-	Name      string                                 `json:"name"`
-	Parameter []StructureMapGroupRuleTargetParameter `json:"parameter"`
+	Name        string                                 `json:"name"`
+	NameElement *common.Element                        `json:"_name,omitempty"`
+	Parameter   []StructureMapGroupRuleTargetParameter `json:"parameter"`
 }
 
 type StructureMapGroupRule struct {
 	common.BackboneElement
-	Dependent     []StructureMapGroupRuleDependent `json:"dependent,omitempty"`
-	Documentation *string                          `json:"documentation,omitempty"`
-	Name          *string                          `json:"name,omitempty"`
-	Rule          []StructureMapGroupRule          `json:"rule,omitempty"`
-	Source        []StructureMapGroupRuleSource    `json:"source"`
-	Target        []StructureMapGroupRuleTarget    `json:"target,omitempty"`
+	Dependent            []StructureMapGroupRuleDependent `json:"dependent,omitempty"`
+	Documentation        *string                          `json:"documentation,omitempty"`
+	DocumentationElement *common.Element                  `json:"_documentation,omitempty"`
+	Name                 *string                          `json:"name,omitempty"`
+	NameElement          *common.Element                  `json:"_name,omitempty"`
+	Rule                 []StructureMapGroupRule          `json:"rule,omitempty"`
+	Source               []StructureMapGroupRuleSource    `json:"source"`
+	Target               []StructureMapGroupRuleTarget    `json:"target,omitempty"`
 }
 
 type StructureMapGroup struct {
 	common.BackboneElement
-	Documentation *string                  `json:"documentation,omitempty"`
-	Extends       *string                  `json:"extends,omitempty"`
-	Input         []StructureMapGroupInput `json:"input"`
-	Name          string                   `json:"name"`
-	Rule          []StructureMapGroupRule  `json:"rule,omitempty"`
-	TypeMode      *string                  `json:"typeMode,omitempty"`
+	Documentation        *string                  `json:"documentation,omitempty"`
+	DocumentationElement *common.Element          `json:"_documentation,omitempty"`
+	Extends              *string                  `json:"extends,omitempty"`
+	ExtendsElement       *common.Element          `json:"_extends,omitempty"`
+	Input                []StructureMapGroupInput `json:"input"`
+	Name                 string                   `json:"name"`
+	NameElement          *common.Element          `json:"_name,omitempty"`
+	Rule                 []StructureMapGroupRule  `json:"rule,omitempty"`
+	TypeMode             *string                  `json:"typeMode,omitempty"`
+	TypeModeElement      *common.Element          `json:"_typeMode,omitempty"`
 }
 
 // StructureMap is a FHIR R5 resource for mapping between structures
 type StructureMap struct {
 	DomainResource
-	ResourceType           string                   `json:"resourceType"` // Always "StructureMap"
-	Const                  []StructureMapConst      `json:"const,omitempty"`
-	Contact                []ContactDetail          `json:"contact,omitempty"`
-	Copyright              *string                  `json:"copyright,omitempty"`
-	CopyrightLabel         *string                  `json:"copyrightLabel,omitempty"`
-	Date                   *string                  `json:"date,omitempty"`
-	Description            *string                  `json:"description,omitempty"`
-	Experimental           *bool                    `json:"experimental,omitempty"`
-	Group                  []StructureMapGroup      `json:"group"`
-	Identifier             []common.Identifier      `json:"identifier,omitempty"`
-	Import                 []string                 `json:"import,omitempty"`
-	Jurisdiction           []common.CodeableConcept `json:"jurisdiction,omitempty"`
-	Name                   string                   `json:"name"`
-	Publisher              *string                  `json:"publisher,omitempty"`
-	Purpose                *string                  `json:"purpose,omitempty"`
-	Status                 string                   `json:"status"`
-	Structure              []StructureMapStructure  `json:"structure,omitempty"`
-	Title                  *string                  `json:"title,omitempty"`
-	URL                    string                   `json:"url"`
-	UseContext             []UsageContext           `json:"useContext,omitempty"`
-	Version                *string                  `json:"version,omitempty"`
-	VersionAlgorithmString *string                  `json:"versionAlgorithmString,omitempty"`
-	VersionAlgorithmCoding *common.Coding           `json:"versionAlgorithmCoding,omitempty"`
+	ResourceType                  string                   `json:"resourceType"` // Always "StructureMap"
+	Const                         []StructureMapConst      `json:"const,omitempty"`
+	Contact                       []ContactDetail          `json:"contact,omitempty"`
+	Copyright                     *string                  `json:"copyright,omitempty"`
+	CopyrightElement              *common.Element          `json:"_copyright,omitempty"`
+	CopyrightLabel                *string                  `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement         *common.Element          `json:"_copyrightLabel,omitempty"`
+	Date                          *common.DateTime         `json:"date,omitempty"`
+	DateElement                   *common.Element          `json:"_date,omitempty"`
+	Description                   *string                  `json:"description,omitempty"`
+	DescriptionElement            *common.Element          `json:"_description,omitempty"`
+	Experimental                  *bool                    `json:"experimental,omitempty"`
+	ExperimentalElement           *common.Element          `json:"_experimental,omitempty"`
+	Group                         []StructureMapGroup      `json:"group"`
+	Identifier                    []common.Identifier      `json:"identifier,omitempty"`
+	Import                        []string                 `json:"import,omitempty"`
+	ImportElement                 []*common.Element        `json:"_import,omitempty"`
+	Jurisdiction                  []common.CodeableConcept `json:"jurisdiction,omitempty"`
+	Name                          string                   `json:"name"`
+	NameElement                   *common.Element          `json:"_name,omitempty"`
+	Publisher                     *string                  `json:"publisher,omitempty"`
+	PublisherElement              *common.Element          `json:"_publisher,omitempty"`
+	Purpose                       *string                  `json:"purpose,omitempty"`
+	PurposeElement                *common.Element          `json:"_purpose,omitempty"`
+	Status                        string                   `json:"status"`
+	StatusElement                 *common.Element          `json:"_status,omitempty"`
+	Structure                     []StructureMapStructure  `json:"structure,omitempty"`
+	Title                         *string                  `json:"title,omitempty"`
+	TitleElement                  *common.Element          `json:"_title,omitempty"`
+	URL                           string                   `json:"url"`
+	URLElement                    *common.Element          `json:"_url,omitempty"`
+	UseContext                    []UsageContext           `json:"useContext,omitempty"`
+	Version                       *string                  `json:"version,omitempty"`
+	VersionElement                *common.Element          `json:"_version,omitempty"`
+	VersionAlgorithmString        *string                  `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element          `json:"_versionAlgorithmString,omitempty"`
+	VersionAlgorithmCoding        *common.Coding           `json:"versionAlgorithmCoding,omitempty"`
 }
@@ -0,0 +1,169 @@
+package fhir5
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Subscription represents the subscription resource is used to define a push-based subscription from a server to another system
+type Subscription struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "Subscription"
+
+	// The type of channel to send notifications on
+	ChannelType common.Coding `json:"channelType"`
+
+	// Contact details for a human to contact about the subscription
+	Contact []ContactPoint `json:"contact,omitempty"`
+
+	// Sending the payload has obvious security implications
+	Content        *SubscriptionContent `json:"content,omitempty"`
+	ContentElement *common.Element      `json:"_content,omitempty"`
+
+	// The MIME type to send the payload in - e.g., `application/fhir+xml` or `application/fhir+json`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
+
+	// The server is permitted to deviate from this time but should observe it
+	End        *common.Instant `json:"end,omitempty"`
+	EndElement *common.Element `json:"_end,omitempty"`
+
+	// For rest-hook the end-point must be an `http:` or `https:` URL; for websockets, `ws:` or `wss:`; for email
+	Endpoint        *string         `json:"endpoint,omitempty"`
+	EndpointElement *common.Element `json:"_endpoint,omitempty"`
+
+	// The filter properties to be applied to narrow the subscription topic stream
+	FilterBy []SubscriptionFilterBy `json:"filterBy,omitempty"`
+
+	// If present, a 'heartbeat' notification
+	HeartbeatPeriod        *int            `json:"heartbeatPeriod,omitempty"`
+	HeartbeatPeriodElement *common.Element `json:"_heartbeatPeriod,omitempty"`
+
+	// A formal identifier that is used to identify this code system when it is represented in other formats
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// Entity with authorization to make subsequent revisions to the Subscription and also determines what data the subscription is authorized to disclose
+	ManagingEntity *common.Reference `json:"managingEntity,omitempty"`
+
+	// If present, the maximum number of events that will be included in a notification bundle
+	MaxCount        *int            `json:"maxCount,omitempty"`
+	MaxCountElement *common.Element `json:"_maxCount,omitempty"`
+
+	// A natural language name identifying the subscription
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Exactly what these mean depend on the channel type
+	Parameter []SubscriptionParameter `json:"parameter,omitempty"`
+
+	// A description of why this subscription is defined
+	Reason        *string         `json:"reason,omitempty"`
+	ReasonElement *common.Element `json:"_reason,omitempty"`
+
+	// A client can only submit subscription resources in the requested or off state
+	Status        SubscriptionStatusCode `json:"status"`
+	StatusElement *common.Element        `json:"_status,omitempty"`
+
+	// If present, the maximum amount of time a server will allow before failing a notification attempt
+	Timeout        *int            `json:"timeout,omitempty"`
+	TimeoutElement *common.Element `json:"_timeout,omitempty"`
+
+	// The reference to the subscription topic to be notified about
+	Topic        string          `json:"topic"`
+	TopicElement *common.Element `json:"_topic,omitempty"`
+}
+
+// SubscriptionContent represents the content of a subscription
+type SubscriptionContent string
+
+const (
+	SubscriptionContentEmpty        SubscriptionContent = "empty"
+	SubscriptionContentIdOnly       SubscriptionContent = "id-only"
+	SubscriptionContentFullResource SubscriptionContent = "full-resource"
+)
+
+// SubscriptionStatusCode represents the status of a subscription
+type SubscriptionStatusCode string
+
+const (
+	SubscriptionStatusCodeRequested      SubscriptionStatusCode = "requested"
+	SubscriptionStatusCodeActive         SubscriptionStatusCode = "active"
+	SubscriptionStatusCodeError          SubscriptionStatusCode = "error"
+	SubscriptionStatusCodeOff            SubscriptionStatusCode = "off"
+	SubscriptionStatusCodeEnteredInError SubscriptionStatusCode = "entered-in-error"
+)
+
+// SubscriptionFilterBy represents criteria for narrowing the subscription topic stream
+type SubscriptionFilterBy struct {
+	common.BackboneElement
+
+	// Must be a comparator allowed by the SubscriptionTopic relevant to this Subscription filter
+	Comparator        *SubscriptionFilterByComparator `json:"comparator,omitempty"`
+	ComparatorElement *common.Element                 `json:"_comparator,omitempty"`
+
+	// The filter as defined in the `SubscriptionTopic.canFilterBy.filterParameter` element
+	FilterParameter        string          `json:"filterParameter"`
+	FilterParameterElement *common.Element `json:"_filterParameter,omitempty"`
+
+	// Must be a modifier allowed by the SubscriptionTopic relevant to this Subscription filter
+	Modifier        *SubscriptionFilterByModifier `json:"modifier,omitempty"`
+	ModifierElement *common.Element               `json:"_modifier,omitempty"`
+
+	// A resource listed in the `SubscriptionTopic` this `Subscription` references (`SubscriptionTopic.canFilterBy.resource`)
+	ResourceType        *string         `json:"resourceType,omitempty"`
+	ResourceTypeElement *common.Element `json:"_resourceType,omitempty"`
+
+	// The literal value or resource path as is legal in search - for example, `Patient/123` or `le1950`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
+
+// SubscriptionFilterByComparator represents the comparator of a subscription filter by
+type SubscriptionFilterByComparator string
+
+const (
+	SubscriptionFilterByComparatorEq SubscriptionFilterByComparator = "eq"
+	SubscriptionFilterByComparatorNe SubscriptionFilterByComparator = "ne"
+	SubscriptionFilterByComparatorGt SubscriptionFilterByComparator = "gt"
+	SubscriptionFilterByComparatorLt SubscriptionFilterByComparator = "lt"
+	SubscriptionFilterByComparatorGe SubscriptionFilterByComparator = "ge"
+	SubscriptionFilterByComparatorLe SubscriptionFilterByComparator = "le"
+	SubscriptionFilterByComparatorSa SubscriptionFilterByComparator = "sa"
+	SubscriptionFilterByComparatorEb SubscriptionFilterByComparator = "eb"
+	SubscriptionFilterByComparatorAp SubscriptionFilterByComparator = "ap"
+)
+
+// SubscriptionFilterByModifier represents the modifier of a subscription filter by
+type SubscriptionFilterByModifier string
+
+const (
+	SubscriptionFilterByModifierMissing      SubscriptionFilterByModifier = "missing"
+	SubscriptionFilterByModifierExact        SubscriptionFilterByModifier = "exact"
+	SubscriptionFilterByModifierContains     SubscriptionFilterByModifier = "contains"
+	SubscriptionFilterByModifierNot          SubscriptionFilterByModifier = "not"
+	SubscriptionFilterByModifierText         SubscriptionFilterByModifier = "text"
+	SubscriptionFilterByModifierIn           SubscriptionFilterByModifier = "in"
+	SubscriptionFilterByModifierNotIn        SubscriptionFilterByModifier = "not-in"
+	SubscriptionFilterByModifierBelow        SubscriptionFilterByModifier = "below"
+	SubscriptionFilterByModifierAbove        SubscriptionFilterByModifier = "above"
+	SubscriptionFilterByModifierType         SubscriptionFilterByModifier = "type"
+	SubscriptionFilterByModifierIdentifier   SubscriptionFilterByModifier = "identifier"
+	SubscriptionFilterByModifierOfType       SubscriptionFilterByModifier = "of-type"
+	SubscriptionFilterByModifierCodeText     SubscriptionFilterByModifier = "code-text"
+	SubscriptionFilterByModifierTextAdvanced SubscriptionFilterByModifier = "text-advanced"
+	SubscriptionFilterByModifierIterate      SubscriptionFilterByModifier = "iterate"
+)
+
+// SubscriptionParameter represents channel type
+type SubscriptionParameter struct {
+	common.BackboneElement
+
+	// Parameter name for information passed to the channel for notifications
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// Parameter value for information passed to the channel for notifications
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
+}
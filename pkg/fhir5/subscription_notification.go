@@ -0,0 +1,216 @@
+package fhir5
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SubscriptionNotification is the content of a subscription-notification bundle:
+// the status of the subscription and the entries that follow it
+type SubscriptionNotification struct {
+	// Status of the subscription, always the first entry of the bundle
+	Status *SubscriptionStatus
+
+	// Entries following the status. Entries of id-only payloads carry no resource.
+	Entries []BundleEntry
+}
+
+// Type returns the kind of notification, e.g. handshake or event-notification
+func (n *SubscriptionNotification) Type() SubscriptionStatusType {
+	return n.Status.Type
+}
+
+// Focus returns the resources of the entries following the status
+func (n *SubscriptionNotification) Focus() []common.Resource {
+	var resources []common.Resource
+	for _, entry := range n.Entries {
+		if entry.Resource != nil {
+			resources = append(resources, entry.Resource)
+		}
+	}
+	return resources
+}
+
+// ParseSubscriptionNotification splits a subscription-notification bundle into
+// its status and the entries that follow it
+func ParseSubscriptionNotification(bundle *Bundle) (*SubscriptionNotification, error) {
+	if bundle.Type != BundleTypeSubscriptionNotification {
+		return nil, fmt.Errorf("subscription notification: bundle type is %q", bundle.Type)
+	}
+	if len(bundle.Entry) == 0 {
+		return nil, fmt.Errorf("subscription notification: bundle has no entries")
+	}
+	status, ok := bundle.Entry[0].Resource.(*SubscriptionStatus)
+	if !ok {
+		return nil, fmt.Errorf("subscription notification: first entry is %T, not a SubscriptionStatus", bundle.Entry[0].Resource)
+	}
+	return &SubscriptionNotification{Status: status, Entries: bundle.Entry[1:]}, nil
+}
+
+// UnmarshalSubscriptionNotification decodes a JSON subscription-notification bundle
+func UnmarshalSubscriptionNotification(data []byte) (*SubscriptionNotification, error) {
+	resource, err := UnmarshalResource(data)
+	if err != nil {
+		return nil, err
+	}
+	bundle, ok := resource.(*Bundle)
+	if !ok {
+		return nil, fmt.Errorf("subscription notification: resource is %T, not a Bundle", resource)
+	}
+	return ParseSubscriptionNotification(bundle)
+}
+
+// NewSubscriptionNotification builds a subscription-notification bundle with the
+// status as first entry followed by the given entries. The bundle and the status
+// get a fresh id unless the status already has one, and the bundle is stamped
+// with the current time.
+func NewSubscriptionNotification(status *SubscriptionStatus, entries ...BundleEntry) *Bundle {
+	status.ResourceType = "SubscriptionStatus"
+	if status.ID == nil {
+		status.ID = StringPtr(newUUID())
+	}
+	bundle := &Bundle{
+		ResourceType: "Bundle",
+		Type:         BundleTypeSubscriptionNotification,
+		Timestamp:    common.InstantPtr(common.InstantFromTime(time.Now())),
+		Entry: append([]BundleEntry{{
+			FullURL:  StringPtr("urn:uuid:" + *status.ID),
+			Resource: status,
+		}}, entries...),
+	}
+	bundle.ID = StringPtr(newUUID())
+	return bundle
+}
+
+// NewHandshakeNotification builds the notification that confirms a new
+// subscription to its endpoint before any events are sent
+func NewHandshakeNotification(subscription *Subscription) *Bundle {
+	status := newSubscriptionStatus(subscription, SubscriptionStatusTypeHandshake, 0)
+	status.Status = statusPtr(SubscriptionStatusStatusRequested)
+	return NewSubscriptionNotification(status)
+}
+
+// NewHeartbeatNotification builds the notification that tells the endpoint the
+// subscription is alive while there are no events
+func NewHeartbeatNotification(subscription *Subscription, eventsSinceSubscriptionStart int64) *Bundle {
+	return NewSubscriptionNotification(newSubscriptionStatus(subscription, SubscriptionStatusTypeHeartbeat, eventsSinceSubscriptionStart))
+}
+
+// NewQueryStatusNotification builds the answer to a $status query of the subscription
+func NewQueryStatusNotification(subscription *Subscription, eventsSinceSubscriptionStart int64) *Bundle {
+	return NewSubscriptionNotification(newSubscriptionStatus(subscription, SubscriptionStatusTypeQueryStatus, eventsSinceSubscriptionStart))
+}
+
+// NotificationEvent is an event reported by an event-notification or
+// query-event notification
+type NotificationEvent struct {
+	// Number is the sequential number of the event for its subscription
+	Number int64
+
+	// Timestamp is when the event occurred
+	Timestamp time.Time
+
+	// Focus is the resource that triggered the event, nil if unknown
+	Focus common.Resource
+}
+
+// NewEventNotification builds the notification of the events that triggered on
+// the focus resources. The events are numbered up to eventsSinceSubscriptionStart
+// in the order of the resources. The content of the subscription decides what
+// the bundle carries, see NewEventsNotification.
+func NewEventNotification(subscription *Subscription, base string, eventsSinceSubscriptionStart int64, focus ...common.Resource) *Bundle {
+	now := time.Now()
+	first := eventsSinceSubscriptionStart - int64(len(focus)) + 1
+	events := make([]NotificationEvent, len(focus))
+	for i, resource := range focus {
+		events[i] = NotificationEvent{Number: first + int64(i), Timestamp: now, Focus: resource}
+	}
+	content := SubscriptionContentIdOnly
+	if subscription.Content != nil {
+		content = *subscription.Content
+	}
+	return NewEventsNotification(subscription, SubscriptionStatusTypeEventNotification, base, eventsSinceSubscriptionStart, content, events...)
+}
+
+// NewEventsNotification builds a notification of the given type, e.g.
+// event-notification or query-event, reporting the events. base is the service
+// base URL of the server, e.g. http://example.org/FHIR/R5, the references to
+// the subscription and the focus resources are absolute URLs on it; without a
+// base they are relative. The content decides what the bundle carries: an
+// entry with the fullUrl and the resource of each focus for full-resource, an
+// entry with only the fullUrl for id-only and neither for empty.
+func NewEventsNotification(subscription *Subscription, typ SubscriptionStatusType, base string, eventsSinceSubscriptionStart int64, content SubscriptionContent, events ...NotificationEvent) *Bundle {
+	status := newSubscriptionStatus(subscription, typ, eventsSinceSubscriptionStart)
+	status.Subscription.Reference = StringPtr(absolute(base, *status.Subscription.Reference))
+
+	var entries []BundleEntry
+	for _, event := range events {
+		notificationEvent := SubscriptionStatusNotificationEvent{
+			EventNumber: strconv.FormatInt(event.Number, 10),
+			Timestamp:   common.InstantPtr(common.InstantFromTime(event.Timestamp)),
+		}
+		if content != SubscriptionContentEmpty && event.Focus != nil {
+			// a focus without id has no server identity to refer to
+			fullURL := "urn:uuid:" + newUUID()
+			if event.Focus.GetID() != nil {
+				fullURL = absolute(base, reference(event.Focus))
+			}
+			notificationEvent.Focus = &common.Reference{Reference: StringPtr(fullURL)}
+			entry := BundleEntry{FullURL: StringPtr(fullURL)}
+			if content == SubscriptionContentFullResource {
+				entry.Resource = event.Focus
+			}
+			entries = append(entries, entry)
+		}
+		status.NotificationEvent = append(status.NotificationEvent, notificationEvent)
+	}
+	return NewSubscriptionNotification(status, entries...)
+}
+
+// newSubscriptionStatus describes the subscription for a notification of the given type
+func newSubscriptionStatus(subscription *Subscription, typ SubscriptionStatusType, eventsSinceSubscriptionStart int64) *SubscriptionStatus {
+	status := &SubscriptionStatus{
+		Type:                         typ,
+		Status:                       statusPtr(SubscriptionStatusStatus(subscription.Status)),
+		EventsSinceSubscriptionStart: StringPtr(strconv.FormatInt(eventsSinceSubscriptionStart, 10)),
+		Subscription:                 common.Reference{Reference: StringPtr(reference(subscription))},
+	}
+	if subscription.Topic != "" {
+		status.Topic = StringPtr(subscription.Topic)
+	}
+	return status
+}
+
+func statusPtr(s SubscriptionStatusStatus) *SubscriptionStatusStatus {
+	return &s
+}
+
+// reference returns the relative reference of a resource, e.g. "Encounter/2"
+func reference(resource common.Resource) string {
+	if id := resource.GetID(); id != nil {
+		return resource.GetResourceType() + "/" + *id
+	}
+	return resource.GetResourceType()
+}
+
+// absolute resolves a relative reference against a service base URL
+func absolute(base, reference string) string {
+	if base == "" {
+		return reference
+	}
+	return strings.TrimSuffix(base, "/") + "/" + reference
+}
+
+// newUUID returns a random version 4 UUID
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
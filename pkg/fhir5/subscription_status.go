@@ -0,0 +1,79 @@
+package fhir5
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SubscriptionStatus represents the status information about a Subscription provided during event notification
+type SubscriptionStatus struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "SubscriptionStatus"
+
+	// Recommended practice: clear errors when status is updated
+	Error []common.CodeableConcept `json:"error,omitempty"`
+
+	// The total number of actual events which have been generated since the Subscription was created
+	EventsSinceSubscriptionStart        *string         `json:"eventsSinceSubscriptionStart,omitempty"`
+	EventsSinceSubscriptionStartElement *common.Element `json:"_eventsSinceSubscriptionStart,omitempty"`
+
+	// Detailed information about events relevant to this subscription notification
+	NotificationEvent []SubscriptionStatusNotificationEvent `json:"notificationEvent,omitempty"`
+
+	// The status of the subscription, which marks the server state for managing the subscription
+	Status        *SubscriptionStatusStatus `json:"status,omitempty"`
+	StatusElement *common.Element           `json:"_status,omitempty"`
+
+	// The reference to the Subscription which generated this notification
+	Subscription common.Reference `json:"subscription"`
+
+	// This value SHOULD NOT be present when using `empty` payloads, MAY be present when using `id-only` payloads
+	Topic        *string         `json:"topic,omitempty"`
+	TopicElement *common.Element `json:"_topic,omitempty"`
+
+	// The type of event being conveyed with this notificaiton
+	Type        SubscriptionStatusType `json:"type"`
+	TypeElement *common.Element        `json:"_type,omitempty"`
+}
+
+// SubscriptionStatusStatus represents the status of a subscription status
+type SubscriptionStatusStatus string
+
+const (
+	SubscriptionStatusStatusRequested      SubscriptionStatusStatus = "requested"
+	SubscriptionStatusStatusActive         SubscriptionStatusStatus = "active"
+	SubscriptionStatusStatusError          SubscriptionStatusStatus = "error"
+	SubscriptionStatusStatusOff            SubscriptionStatusStatus = "off"
+	SubscriptionStatusStatusEnteredInError SubscriptionStatusStatus = "entered-in-error"
+)
+
+// SubscriptionStatusType represents the type of a subscription status
+type SubscriptionStatusType string
+
+const (
+	SubscriptionStatusTypeHandshake         SubscriptionStatusType = "handshake"
+	SubscriptionStatusTypeHeartbeat         SubscriptionStatusType = "heartbeat"
+	SubscriptionStatusTypeEventNotification SubscriptionStatusType = "event-notification"
+	SubscriptionStatusTypeQueryStatus       SubscriptionStatusType = "query-status"
+	SubscriptionStatusTypeQueryEvent        SubscriptionStatusType = "query-event"
+)
+
+// SubscriptionStatusNotificationEvent represents detailed information about any events relevant to this notification
+type SubscriptionStatusNotificationEvent struct {
+	common.BackboneElement
+
+	// Additional context information for this event
+	AdditionalContext []common.Reference `json:"additionalContext,omitempty"`
+
+	// The sequential number of this event in this subscription context
+	EventNumber        string          `json:"eventNumber"`
+	EventNumberElement *common.Element `json:"_eventNumber,omitempty"`
+
+	// The focus of this event
+	Focus *common.Reference `json:"focus,omitempty"`
+
+	// The actual time this event occured on the server
+	Timestamp        *common.Instant `json:"timestamp,omitempty"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
+}
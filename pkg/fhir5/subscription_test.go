@@ -0,0 +1,222 @@
+package fhir5
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+func TestSubscription_RoundTripSerialization(t *testing.T) {
+	examples := map[string]func() interface{}{
+		"testdata/fhir5-json/subscription-example.json":                func() interface{} { return &Subscription{} },
+		"testdata/fhir5-json/subscription-admission.json":              func() interface{} { return &Subscription{} },
+		"testdata/fhir5-json/subscriptionstatus-example.json":          func() interface{} { return &SubscriptionStatus{} },
+		"testdata/fhir5-json/subscriptiontopic-example.json":           func() interface{} { return &SubscriptionTopic{} },
+		"testdata/fhir5-json/subscriptiontopic-example-admission.json": func() interface{} { return &SubscriptionTopic{} },
+	}
+	for file, newResource := range examples {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		test_utils.CompareRoundTripSerialization(t, data, newResource(), file)
+	}
+}
+
+func TestUnmarshalSubscriptionNotification(t *testing.T) {
+	tests := []struct {
+		file     string
+		typ      SubscriptionStatusType
+		entries  int
+		focus    int
+		topicSet bool
+	}{
+		{"notification-handshake.json", SubscriptionStatusTypeHandshake, 0, 0, true},
+		{"notification-heartbeat.json", SubscriptionStatusTypeHeartbeat, 0, 0, true},
+		{"notification-query-status.json", SubscriptionStatusTypeQueryStatus, 0, 0, true},
+		{"notification-empty.json", SubscriptionStatusTypeEventNotification, 0, 0, false},
+		{"notification-id-only.json", SubscriptionStatusTypeEventNotification, 1, 0, true},
+		{"notification-full-resource.json", SubscriptionStatusTypeEventNotification, 1, 1, true},
+	}
+	for _, tt := range tests {
+		data, err := os.ReadFile("testdata/fhir5-json/" + tt.file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", tt.file, err)
+		}
+		notification, err := UnmarshalSubscriptionNotification(data)
+		if err != nil {
+			t.Errorf("%s: %v", tt.file, err)
+			continue
+		}
+		if notification.Type() != tt.typ {
+			t.Errorf("%s: expected type %s, got %s", tt.file, tt.typ, notification.Type())
+		}
+		if len(notification.Entries) != tt.entries {
+			t.Errorf("%s: expected %d entries after the status, got %d", tt.file, tt.entries, len(notification.Entries))
+		}
+		if len(notification.Focus()) != tt.focus {
+			t.Errorf("%s: expected %d focus resources, got %d", tt.file, tt.focus, len(notification.Focus()))
+		}
+		if (notification.Status.Topic != nil) != tt.topicSet {
+			t.Errorf("%s: unexpected topic %v", tt.file, notification.Status.Topic)
+		}
+	}
+}
+
+func TestParseSubscriptionNotification_Invalid(t *testing.T) {
+	if _, err := ParseSubscriptionNotification(&Bundle{Type: BundleTypeCollection}); err == nil {
+		t.Error("expected an error for a collection bundle")
+	}
+	if _, err := ParseSubscriptionNotification(&Bundle{Type: BundleTypeSubscriptionNotification}); err == nil {
+		t.Error("expected an error for a bundle without entries")
+	}
+	bundle := &Bundle{
+		Type:  BundleTypeSubscriptionNotification,
+		Entry: []BundleEntry{{Resource: &Encounter{}}},
+	}
+	if _, err := ParseSubscriptionNotification(bundle); err == nil {
+		t.Error("expected an error for a bundle not starting with the status")
+	}
+}
+
+func TestNewSubscriptionNotifications(t *testing.T) {
+	content := SubscriptionContentFullResource
+	subscription := &Subscription{
+		Status:  SubscriptionStatusCodeActive,
+		Topic:   "http://example.org/FHIR/R5/SubscriptionTopic/admission",
+		Content: &content,
+	}
+	subscription.ID = StringPtr("123")
+	encounter := &Encounter{ResourceType: "Encounter", Status: EncounterStatusInProgress}
+	encounter.ID = StringPtr("2")
+
+	tests := []struct {
+		name   string
+		bundle *Bundle
+		typ    SubscriptionStatusType
+		status SubscriptionStatusStatus
+		events string
+		focus  int
+	}{
+		{"handshake", NewHandshakeNotification(subscription), SubscriptionStatusTypeHandshake, SubscriptionStatusStatusRequested, "0", 0},
+		{"heartbeat", NewHeartbeatNotification(subscription, 310), SubscriptionStatusTypeHeartbeat, SubscriptionStatusStatusActive, "310", 0},
+		{"query-status", NewQueryStatusNotification(subscription, 310), SubscriptionStatusTypeQueryStatus, SubscriptionStatusStatusActive, "310", 0},
+		{"event-notification", NewEventNotification(subscription, "", 2, encounter), SubscriptionStatusTypeEventNotification, SubscriptionStatusStatusActive, "2", 1},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.bundle)
+		if err != nil {
+			t.Fatalf("%s: failed to marshal: %v", tt.name, err)
+		}
+		notification, err := UnmarshalSubscriptionNotification(data)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		status := notification.Status
+		if status.Type != tt.typ || status.Status == nil || *status.Status != tt.status {
+			t.Errorf("%s: unexpected type %s and status %v", tt.name, status.Type, status.Status)
+		}
+		if status.EventsSinceSubscriptionStart == nil || *status.EventsSinceSubscriptionStart != tt.events {
+			t.Errorf("%s: expected %s events since start, got %v", tt.name, tt.events, status.EventsSinceSubscriptionStart)
+		}
+		if *status.Subscription.Reference != "Subscription/123" || *status.Topic != subscription.Topic {
+			t.Errorf("%s: unexpected subscription %s and topic %s", tt.name, *status.Subscription.Reference, *status.Topic)
+		}
+		if len(notification.Focus()) != tt.focus {
+			t.Errorf("%s: expected %d focus resources, got %d", tt.name, tt.focus, len(notification.Focus()))
+		}
+		if *tt.bundle.Entry[0].FullURL != "urn:uuid:"+*status.ID {
+			t.Errorf("%s: unexpected status entry fullUrl %s", tt.name, *tt.bundle.Entry[0].FullURL)
+		}
+	}
+
+	event := NewEventNotification(subscription, "", 2, encounter)
+	status := event.Entry[0].Resource.(*SubscriptionStatus)
+	if len(status.NotificationEvent) != 1 || status.NotificationEvent[0].EventNumber != "2" || *status.NotificationEvent[0].Focus.Reference != "Encounter/2" {
+		t.Errorf("unexpected notification events %+v", status.NotificationEvent)
+	}
+}
+
+func TestNewEventNotification_Content(t *testing.T) {
+	encounter := &Encounter{ResourceType: "Encounter", Status: EncounterStatusInProgress}
+	encounter.ID = StringPtr("2")
+	for content, want := range map[SubscriptionContent]struct {
+		entries  int
+		hasFocus bool
+	}{
+		SubscriptionContentEmpty:        {1, false},
+		SubscriptionContentIdOnly:       {2, true},
+		SubscriptionContentFullResource: {2, true},
+	} {
+		c := content
+		bundle := NewEventNotification(&Subscription{Status: SubscriptionStatusCodeActive, Content: &c}, "", 5, encounter)
+		if len(bundle.Entry) != want.entries {
+			t.Errorf("%s: expected %d entries, got %d", content, want.entries, len(bundle.Entry))
+		}
+		event := bundle.Entry[0].Resource.(*SubscriptionStatus).NotificationEvent[0]
+		if (event.Focus != nil) != want.hasFocus {
+			t.Errorf("%s: unexpected focus %v", content, event.Focus)
+		}
+	}
+}
+
+func TestNewEventNotification_Examples(t *testing.T) {
+	for _, tt := range []struct {
+		file    string
+		content SubscriptionContent
+	}{
+		{"notification-id-only.json", SubscriptionContentIdOnly},
+		{"notification-full-resource.json", SubscriptionContentFullResource},
+	} {
+		data, err := os.ReadFile("testdata/fhir5-json/" + tt.file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", tt.file, err)
+		}
+		example, err := UnmarshalSubscriptionNotification(data)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.file, err)
+		}
+		fullURL := *example.Entries[0].FullURL
+		base := strings.TrimSuffix(fullURL, "/Encounter/2")
+		focus := example.Entries[0].Resource
+		if focus == nil {
+			encounter := &Encounter{ResourceType: "Encounter"}
+			encounter.ID = StringPtr("2")
+			focus = encounter
+		}
+		content := tt.content
+		subscription := &Subscription{Status: SubscriptionStatusCodeActive, Topic: *example.Status.Topic, Content: &content}
+		subscription.ID = StringPtr("123")
+
+		notification, err := ParseSubscriptionNotification(NewEventNotification(subscription, base, 2, focus))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.file, err)
+		}
+		status := notification.Status
+		if status.Type != example.Status.Type || *status.Status != *example.Status.Status ||
+			*status.EventsSinceSubscriptionStart != *example.Status.EventsSinceSubscriptionStart {
+			t.Errorf("%s: got status %s %s %s", tt.file, status.Type, *status.Status, *status.EventsSinceSubscriptionStart)
+		}
+		if *status.Subscription.Reference != base+"/Subscription/123" {
+			t.Errorf("%s: unexpected subscription reference %s", tt.file, *status.Subscription.Reference)
+		}
+		if len(status.NotificationEvent) != 1 || status.NotificationEvent[0].EventNumber != example.Status.NotificationEvent[0].EventNumber ||
+			*status.NotificationEvent[0].Focus.Reference != fullURL {
+			t.Errorf("%s: unexpected notification events %+v", tt.file, status.NotificationEvent)
+		}
+		if len(notification.Entries) != len(example.Entries) {
+			t.Fatalf("%s: expected %d entries after the status, got %d", tt.file, len(example.Entries), len(notification.Entries))
+		}
+		entry := notification.Entries[0]
+		if entry.FullURL == nil || *entry.FullURL != fullURL {
+			t.Errorf("%s: expected fullUrl %s, got %v", tt.file, fullURL, entry.FullURL)
+		}
+		if entry.Resource != example.Entries[0].Resource {
+			t.Errorf("%s: expected the resource %v, got %v", tt.file, example.Entries[0].Resource, entry.Resource)
+		}
+	}
+}
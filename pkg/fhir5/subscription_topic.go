@@ -0,0 +1,289 @@
+package fhir5
+
+import (
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// SubscriptionTopic represents the definition of a specific topic for triggering events within the Subscriptions framework
+type SubscriptionTopic struct {
+	DomainResource
+
+	// Resource Type Name (for serialization)
+	ResourceType string `json:"resourceType"` // Always "SubscriptionTopic"
+
+	// The date may be more recent than the approval date because of minor changes / editorial corrections
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
+
+	// List of properties by which Subscriptions on the SubscriptionTopic can be filtered
+	CanFilterBy []SubscriptionTopicCanFilterBy `json:"canFilterBy,omitempty"`
+
+	// May be a web site, an email address, a telephone number, etc
+	Contact []ContactDetail `json:"contact,omitempty"`
+
+	// A copyright statement relating to the SubscriptionTopic and/or its contents
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
+
+	// The (c) symbol should NOT be included in this string
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
+
+	// For draft definitions, indicates the date of initial creation
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
+
+	// The canonical URL pointing to another FHIR-defined SubscriptionTopic that is adhered to in whole or in part by this SubscriptionTopic
+	DerivedFrom        []string          `json:"derivedFrom,omitempty"`
+	DerivedFromElement []*common.Element `json:"_derivedFrom,omitempty"`
+
+	// This description can be used to capture details such as why the Topic was built, comments about misuse
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// The effective period for a SubscriptionTopic determines when the content is applicable for usage and is independent of publication and review dates
+	EffectivePeriod *common.Period `json:"effectivePeriod,omitempty"`
+
+	// Event definition which can be used to trigger the SubscriptionTopic
+	EventTrigger []SubscriptionTopicEventTrigger `json:"eventTrigger,omitempty"`
+
+	// Allows filtering of SubscriptionTopic that are appropriate for use vs
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
+
+	// Note: This is a business identifier, not a resource identifier (see discussion)
+	Identifier []common.Identifier `json:"identifier,omitempty"`
+
+	// A jurisdiction in which the Topic is intended to be used
+	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
+
+	// If specified, this is usually after the approval date
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
+
+	// The name is not expected to be globally unique
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
+
+	// List of properties to describe the shape (e.g., resources) included in notifications from this Subscription Topic
+	NotificationShape []SubscriptionTopicNotificationShape `json:"notificationShape,omitempty"`
+
+	// Helps establish the "authority/credibility" of the SubscriptionTopic
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
+
+	// This element does not describe the usage of the Topic
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
+
+	// A definition of a resource-based event that triggers a notification based on the SubscriptionTopic
+	ResourceTrigger []SubscriptionTopicResourceTrigger `json:"resourceTrigger,omitempty"`
+
+	// A nominal state-transition diagram can be found in the [[definition.html#statemachine | Definition pattern]] documentation Unknown does not represent "other" - one of the defined statuses must apply
+	Status        SubscriptionTopicStatus `json:"status"`
+	StatusElement *common.Element         `json:"_status,omitempty"`
+
+	// A short, descriptive, user-friendly title for the SubscriptionTopic, for example, "admission"
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
+
+	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
+	URL        string          `json:"url"`
+	URLElement *common.Element `json:"_url,omitempty"`
+
+	// When multiple usageContexts are specified, there is no expectation for whether all or any of the contexts apply
+	UseContext []UsageContext `json:"useContext,omitempty"`
+
+	// There may be multiple different instances of a subscription topic that have the same identifier but different versions
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
+
+	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
+
+	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in
+	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
+}
+
+// SubscriptionTopicStatus represents the status of a subscription topic
+type SubscriptionTopicStatus string
+
+const (
+	SubscriptionTopicStatusDraft   SubscriptionTopicStatus = "draft"
+	SubscriptionTopicStatusActive  SubscriptionTopicStatus = "active"
+	SubscriptionTopicStatusRetired SubscriptionTopicStatus = "retired"
+	SubscriptionTopicStatusUnknown SubscriptionTopicStatus = "unknown"
+)
+
+// SubscriptionTopicResourceTriggerQueryCriteria represents query based trigger rule
+type SubscriptionTopicResourceTriggerQueryCriteria struct {
+	common.BackboneElement
+
+	// The rules are search criteria (without the [base] part)
+	Current        *string         `json:"current,omitempty"`
+	CurrentElement *common.Element `json:"_current,omitempty"`
+
+	// The rules are search criteria (without the [base] part)
+	Previous        *string         `json:"previous,omitempty"`
+	PreviousElement *common.Element `json:"_previous,omitempty"`
+
+	// If set to true, both current and previous criteria must evaluate true to trigger a notification for this topic
+	RequireBoth        *bool           `json:"requireBoth,omitempty"`
+	RequireBothElement *common.Element `json:"_requireBoth,omitempty"`
+
+	// For "create" interactions, should the "previous" criteria count as an automatic pass or an automatic fail
+	ResultForCreate        *SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate `json:"resultForCreate,omitempty"`
+	ResultForCreateElement *common.Element                                               `json:"_resultForCreate,omitempty"`
+
+	// For "delete" interactions, should the "current" criteria count as an automatic pass or an automatic fail
+	ResultForDelete        *SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete `json:"resultForDelete,omitempty"`
+	ResultForDeleteElement *common.Element                                               `json:"_resultForDelete,omitempty"`
+}
+
+// SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate represents the result for create of a subscription topic resource trigger query criteria
+type SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate string
+
+const (
+	SubscriptionTopicResourceTriggerQueryCriteriaResultForCreateTestPasses SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate = "test-passes"
+	SubscriptionTopicResourceTriggerQueryCriteriaResultForCreateTestFails  SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate = "test-fails"
+)
+
+// SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete represents the result for delete of a subscription topic resource trigger query criteria
+type SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete string
+
+const (
+	SubscriptionTopicResourceTriggerQueryCriteriaResultForDeleteTestPasses SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete = "test-passes"
+	SubscriptionTopicResourceTriggerQueryCriteriaResultForDeleteTestFails  SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete = "test-fails"
+)
+
+// SubscriptionTopicResourceTrigger represents definition of a resource-based trigger for the subscription topic
+type SubscriptionTopicResourceTrigger struct {
+	common.BackboneElement
+
+	// Implementation of particular subscription topics might not use a computable definition and instead base their design on the definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// FHIRPath expression with %previous and %current vars
+	FhirPathCriteria        *string         `json:"fhirPathCriteria,omitempty"`
+	FhirPathCriteriaElement *common.Element `json:"_fhirPathCriteria,omitempty"`
+
+	// The FHIR query based rules that the server should use to determine when to trigger a notification for this subscription topic
+	QueryCriteria *SubscriptionTopicResourceTriggerQueryCriteria `json:"queryCriteria,omitempty"`
+
+	// URL of the Resource that is the type used in this resource trigger
+	Resource        string          `json:"resource"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+
+	// The FHIR RESTful interaction which can be used to trigger a notification for the SubscriptionTopic
+	SupportedInteraction        []SubscriptionTopicResourceTriggerSupportedInteraction `json:"supportedInteraction,omitempty"`
+	SupportedInteractionElement []*common.Element                                      `json:"_supportedInteraction,omitempty"`
+}
+
+// SubscriptionTopicResourceTriggerSupportedInteraction represents the supported interaction of a subscription topic resource trigger
+type SubscriptionTopicResourceTriggerSupportedInteraction string
+
+const (
+	SubscriptionTopicResourceTriggerSupportedInteractionCreate SubscriptionTopicResourceTriggerSupportedInteraction = "create"
+	SubscriptionTopicResourceTriggerSupportedInteractionUpdate SubscriptionTopicResourceTriggerSupportedInteraction = "update"
+	SubscriptionTopicResourceTriggerSupportedInteractionDelete SubscriptionTopicResourceTriggerSupportedInteraction = "delete"
+)
+
+// SubscriptionTopicEventTrigger represents an event definition of the SubscriptionTopic
+type SubscriptionTopicEventTrigger struct {
+	common.BackboneElement
+
+	// Implementation of particular subscription topics might not use a computable definition and instead base their design on the definition
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// A well-defined event which can be used to trigger notifications from the SubscriptionTopic
+	Event common.CodeableConcept `json:"event"`
+
+	// URL of the Resource that is the focus type used in this event trigger
+	Resource        string          `json:"resource"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+}
+
+// SubscriptionTopicCanFilterBy represents properties by which a Subscription can filter notifications from the SubscriptionTopic
+type SubscriptionTopicCanFilterBy struct {
+	common.BackboneElement
+
+	// If no comparators are listed, clients should not expect servers to support any comparators
+	Comparator        []SubscriptionTopicCanFilterByComparator `json:"comparator,omitempty"`
+	ComparatorElement []*common.Element                        `json:"_comparator,omitempty"`
+
+	// Description of how this filtering parameter is intended to be used
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
+
+	// Chained parameters are allowed (like "patient.gender") - but can not use colons or modifiers
+	FilterDefinition        *string         `json:"filterDefinition,omitempty"`
+	FilterDefinitionElement *common.Element `json:"_filterDefinition,omitempty"`
+
+	// Chained parameters are allowed (like "patient.gender") - but can not use colons or modifiers
+	FilterParameter        string          `json:"filterParameter"`
+	FilterParameterElement *common.Element `json:"_filterParameter,omitempty"`
+
+	// Allowable operators to apply when determining matches (Search Modifiers)
+	Modifier        []SubscriptionTopicCanFilterByModifier `json:"modifier,omitempty"`
+	ModifierElement []*common.Element                      `json:"_modifier,omitempty"`
+
+	// URL of the Resource that is the type used in this filter
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+}
+
+// SubscriptionTopicCanFilterByComparator represents the comparator of a subscription topic can filter by
+type SubscriptionTopicCanFilterByComparator string
+
+const (
+	SubscriptionTopicCanFilterByComparatorEq SubscriptionTopicCanFilterByComparator = "eq"
+	SubscriptionTopicCanFilterByComparatorNe SubscriptionTopicCanFilterByComparator = "ne"
+	SubscriptionTopicCanFilterByComparatorGt SubscriptionTopicCanFilterByComparator = "gt"
+	SubscriptionTopicCanFilterByComparatorLt SubscriptionTopicCanFilterByComparator = "lt"
+	SubscriptionTopicCanFilterByComparatorGe SubscriptionTopicCanFilterByComparator = "ge"
+	SubscriptionTopicCanFilterByComparatorLe SubscriptionTopicCanFilterByComparator = "le"
+	SubscriptionTopicCanFilterByComparatorSa SubscriptionTopicCanFilterByComparator = "sa"
+	SubscriptionTopicCanFilterByComparatorEb SubscriptionTopicCanFilterByComparator = "eb"
+	SubscriptionTopicCanFilterByComparatorAp SubscriptionTopicCanFilterByComparator = "ap"
+)
+
+// SubscriptionTopicCanFilterByModifier represents the modifier of a subscription topic can filter by
+type SubscriptionTopicCanFilterByModifier string
+
+const (
+	SubscriptionTopicCanFilterByModifierMissing      SubscriptionTopicCanFilterByModifier = "missing"
+	SubscriptionTopicCanFilterByModifierExact        SubscriptionTopicCanFilterByModifier = "exact"
+	SubscriptionTopicCanFilterByModifierContains     SubscriptionTopicCanFilterByModifier = "contains"
+	SubscriptionTopicCanFilterByModifierNot          SubscriptionTopicCanFilterByModifier = "not"
+	SubscriptionTopicCanFilterByModifierText         SubscriptionTopicCanFilterByModifier = "text"
+	SubscriptionTopicCanFilterByModifierIn           SubscriptionTopicCanFilterByModifier = "in"
+	SubscriptionTopicCanFilterByModifierNotIn        SubscriptionTopicCanFilterByModifier = "not-in"
+	SubscriptionTopicCanFilterByModifierBelow        SubscriptionTopicCanFilterByModifier = "below"
+	SubscriptionTopicCanFilterByModifierAbove        SubscriptionTopicCanFilterByModifier = "above"
+	SubscriptionTopicCanFilterByModifierType         SubscriptionTopicCanFilterByModifier = "type"
+	SubscriptionTopicCanFilterByModifierIdentifier   SubscriptionTopicCanFilterByModifier = "identifier"
+	SubscriptionTopicCanFilterByModifierOfType       SubscriptionTopicCanFilterByModifier = "of-type"
+	SubscriptionTopicCanFilterByModifierCodeText     SubscriptionTopicCanFilterByModifier = "code-text"
+	SubscriptionTopicCanFilterByModifierTextAdvanced SubscriptionTopicCanFilterByModifier = "text-advanced"
+	SubscriptionTopicCanFilterByModifierIterate      SubscriptionTopicCanFilterByModifier = "iterate"
+)
+
+// SubscriptionTopicNotificationShape represents properties for describing the shape of notifications generated by this topic
+type SubscriptionTopicNotificationShape struct {
+	common.BackboneElement
+
+	// Search-style _include directives, rooted in the resource for this shape
+	Include        []string          `json:"include,omitempty"`
+	IncludeElement []*common.Element `json:"_include,omitempty"`
+
+	// URL of the Resource that is the type used in this shape
+	Resource        string          `json:"resource"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
+
+	// Search-style _revinclude directives, rooted in the resource for this shape
+	RevInclude        []string          `json:"revInclude,omitempty"`
+	RevIncludeElement []*common.Element `json:"_revInclude,omitempty"`
+}
@@ -10,7 +10,8 @@ type SubstanceIngredient struct {
 	common.BackboneElement
 
 	// Indication of whether this ingredient affects the therapeutic action of the drug
-	IsActive *bool `json:"isActive,omitempty"`
+	IsActive        *bool           `json:"isActive,omitempty"`
+	IsActiveElement *common.Element `json:"_isActive,omitempty"`
 
 	// The actual ingredient - either a substance (simple ingredient) or another medication
 	ItemCodeableConcept *common.CodeableConcept `json:"itemCodeableConcept,omitempty"`
@@ -34,10 +35,12 @@ type Substance struct {
 	Code CodeableReference `json:"code"`
 
 	// A description of the substance - its appearance, handling requirements, and other usage notes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// When the substance is no longer valid to use
-	Expiry *string `json:"expiry,omitempty"`
+	Expiry        *common.DateTime `json:"expiry,omitempty"`
+	ExpiryElement *common.Element  `json:"_expiry,omitempty"`
 
 	// Unique identifier for the substance
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -46,13 +49,15 @@ type Substance struct {
 	Ingredient []SubstanceIngredient `json:"ingredient,omitempty"`
 
 	// A boolean to indicate if this an instance of a substance or a kind of one (a definition)
-	Instance bool `json:"instance"`
+	Instance        bool            `json:"instance"`
+	InstanceElement *common.Element `json:"_instance,omitempty"`
 
 	// The amount of the substance
 	Quantity *common.Quantity `json:"quantity,omitempty"`
 
 	// A code to indicate if the substance is actively used
-	Status *SubstanceStatus `json:"status,omitempty"`
+	Status        *SubstanceStatus `json:"status,omitempty"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 }
 
 // SubstanceStatus represents the status of a substance
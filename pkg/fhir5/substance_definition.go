@@ -10,8 +10,9 @@ type SubstanceDefinitionMoiety struct {
 	common.BackboneElement
 
 	// Quantitative value for this moiety
-	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
-	AmountString   *string          `json:"amountString,omitempty"`
+	AmountQuantity      *common.Quantity `json:"amountQuantity,omitempty"`
+	AmountString        *string          `json:"amountString,omitempty"`
+	AmountStringElement *common.Element  `json:"_amountString,omitempty"`
 
 	// Identifier by which this moiety substance is known
 	Identifier *common.Identifier `json:"identifier,omitempty"`
@@ -20,10 +21,12 @@ type SubstanceDefinitionMoiety struct {
 	MeasurementType *common.CodeableConcept `json:"measurementType,omitempty"`
 
 	// Molecular formula for this moiety of this substance, typically using the Hill system
-	MolecularFormula *string `json:"molecularFormula,omitempty"`
+	MolecularFormula        *string         `json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *common.Element `json:"_molecularFormula,omitempty"`
 
 	// Textual name for this moiety substance
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Optical activity type
 	OpticalActivity *common.CodeableConcept `json:"opticalActivity,omitempty"`
@@ -40,7 +43,8 @@ type SubstanceDefinitionCharacterization struct {
 	common.BackboneElement
 
 	// The description or justification in support of the interpretation of the data file
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The data produced by the analytical instrument or a pictorial representation of that data
 	File []Attachment `json:"file,omitempty"`
@@ -62,8 +66,10 @@ type SubstanceDefinitionProperty struct {
 	// A value for the property
 	ValueCodeableConcept *common.CodeableConcept `json:"valueCodeableConcept,omitempty"`
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
-	ValueDate            *string                 `json:"valueDate,omitempty"`
+	ValueDate            *common.Date            `json:"valueDate,omitempty"`
+	ValueDateElement     *common.Element         `json:"_valueDate,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 	ValueAttachment      *Attachment             `json:"valueAttachment,omitempty"`
 }
 
@@ -92,7 +98,8 @@ type SubstanceDefinitionStructureRepresentation struct {
 	Format *common.CodeableConcept `json:"format,omitempty"`
 
 	// The structural representation as a text string in a standard format
-	Representation *string `json:"representation,omitempty"`
+	Representation        *string         `json:"representation,omitempty"`
+	RepresentationElement *common.Element `json:"_representation,omitempty"`
 
 	// The kind of structural representation (e.g. full, partial)
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -103,10 +110,12 @@ type SubstanceDefinitionStructure struct {
 	common.BackboneElement
 
 	// An expression which states the number and type of atoms present in a molecule of a substance
-	MolecularFormula *string `json:"molecularFormula,omitempty"`
+	MolecularFormula        *string         `json:"molecularFormula,omitempty"`
+	MolecularFormulaElement *common.Element `json:"_molecularFormula,omitempty"`
 
 	// Specified per moiety according to the Hill system, i.e. first C, then H, then alphabetical, each moiety separated by a dot
-	MolecularFormulaByMoiety *string `json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoiety        *string         `json:"molecularFormulaByMoiety,omitempty"`
+	MolecularFormulaByMoietyElement *common.Element `json:"_molecularFormulaByMoiety,omitempty"`
 
 	// The molecular weight or weight range (for proteins, polymers or nucleic acids)
 	MolecularWeight *SubstanceDefinitionMolecularWeight `json:"molecularWeight,omitempty"`
@@ -155,7 +164,8 @@ type SubstanceDefinitionCode struct {
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// The date at which the code status is changed as part of the terminology maintenance
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
 
 	// Any comment can be provided in this field, if necessary
 	Note []Annotation `json:"note,omitempty"`
@@ -169,7 +179,8 @@ type SubstanceDefinitionName struct {
 	common.BackboneElement
 
 	// The actual name
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Name type
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -178,7 +189,8 @@ type SubstanceDefinitionName struct {
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// If this is the preferred name for this substance
-	Preferred *bool `json:"preferred,omitempty"`
+	Preferred        *bool           `json:"preferred,omitempty"`
+	PreferredElement *common.Element `json:"_preferred,omitempty"`
 
 	// Human language that the name is written in
 	Language []common.CodeableConcept `json:"language,omitempty"`
@@ -213,7 +225,8 @@ type SubstanceDefinitionNameOfficial struct {
 	Status *common.CodeableConcept `json:"status,omitempty"`
 
 	// Date of official name change
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 }
 
 // SubstanceDefinitionRelationship represents a link between this substance and another
@@ -228,12 +241,14 @@ type SubstanceDefinitionRelationship struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// For example where an enzyme strongly bonds onto a particular substance, this is a defining relationship for that enzyme, out of several possible substance relationships
-	IsDefining *bool `json:"isDefining,omitempty"`
+	IsDefining        *bool           `json:"isDefining,omitempty"`
+	IsDefiningElement *common.Element `json:"_isDefining,omitempty"`
 
 	// A numeric factor for the relationship, for instance to express that the salt of a substance has some percentage of the active substance in relation to some other
-	AmountQuantity *common.Quantity `json:"amountQuantity,omitempty"`
-	AmountRatio    *Ratio           `json:"amountRatio,omitempty"`
-	AmountString   *string          `json:"amountString,omitempty"`
+	AmountQuantity      *common.Quantity `json:"amountQuantity,omitempty"`
+	AmountRatio         *Ratio           `json:"amountRatio,omitempty"`
+	AmountString        *string          `json:"amountString,omitempty"`
+	AmountStringElement *common.Element  `json:"_amountString,omitempty"`
 
 	// For use when the numeric
 	RatioHighLimitAmount *common.Quantity `json:"ratioHighLimitAmount,omitempty"`
@@ -283,7 +298,8 @@ type SubstanceDefinition struct {
 	Code []SubstanceDefinitionCode `json:"code,omitempty"`
 
 	// Textual description of the substance
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// If the substance applies to human or veterinary use
 	Domain *common.CodeableConcept `json:"domain,omitempty"`
@@ -343,5 +359,6 @@ type SubstanceDefinition struct {
 	Supplier []common.Reference `json:"supplier,omitempty"`
 
 	// A business level version identifier of the substance
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 }
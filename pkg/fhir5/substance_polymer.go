@@ -19,7 +19,8 @@ type SubstancePolymerMonomerSetStartingMaterial struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Used to specify whether the attribute described is a defining element for the unique identification of the polymer
-	IsDefining *bool `json:"isDefining,omitempty"`
+	IsDefining        *bool           `json:"isDefining,omitempty"`
+	IsDefiningElement *common.Element `json:"_isDefining,omitempty"`
 }
 
 // SubstancePolymerMonomerSet represents monomer set information
@@ -38,13 +39,16 @@ type SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation struct {
 	common.BackboneElement
 
 	// An average amount of polymerisation
-	Average *int `json:"average,omitempty"`
+	Average        *int            `json:"average,omitempty"`
+	AverageElement *common.Element `json:"_average,omitempty"`
 
 	// A high expected limit of the amount
-	High *int `json:"high,omitempty"`
+	High        *int            `json:"high,omitempty"`
+	HighElement *common.Element `json:"_high,omitempty"`
 
 	// A low expected limit of the amount
-	Low *int `json:"low,omitempty"`
+	Low        *int            `json:"low,omitempty"`
+	LowElement *common.Element `json:"_low,omitempty"`
 
 	// The type of the degree of polymerisation shall be described, e.g. SRU/Polymer Ratio
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -61,7 +65,8 @@ type SubstancePolymerRepeatRepeatUnitStructuralRepresentation struct {
 	Format *common.CodeableConcept `json:"format,omitempty"`
 
 	// The structural representation as text string in a standard format
-	Representation *string `json:"representation,omitempty"`
+	Representation        *string         `json:"representation,omitempty"`
+	RepresentationElement *common.Element `json:"_representation,omitempty"`
 
 	// The type of structure (e.g. Full, Partial, Representative)
 	Type *common.CodeableConcept `json:"type,omitempty"`
@@ -72,7 +77,8 @@ type SubstancePolymerRepeatRepeatUnit struct {
 	common.BackboneElement
 
 	// Number of repeats of this unit
-	Amount *int `json:"amount,omitempty"`
+	Amount        *int            `json:"amount,omitempty"`
+	AmountElement *common.Element `json:"_amount,omitempty"`
 
 	// Applies to homopolymer and block co-polymers where the degree of polymerisation within a block can be described
 	DegreeOfPolymerisation []SubstancePolymerRepeatRepeatUnitDegreeOfPolymerisation `json:"degreeOfPolymerisation,omitempty"`
@@ -84,7 +90,8 @@ type SubstancePolymerRepeatRepeatUnit struct {
 	StructuralRepresentation []SubstancePolymerRepeatRepeatUnitStructuralRepresentation `json:"structuralRepresentation,omitempty"`
 
 	// Structural repeat units are essential elements for defining polymers
-	Unit *string `json:"unit,omitempty"`
+	Unit        *string         `json:"unit,omitempty"`
+	UnitElement *common.Element `json:"_unit,omitempty"`
 }
 
 // SubstancePolymerRepeat represents specifications and quantifies the repeated units and their configuration
@@ -92,7 +99,8 @@ type SubstancePolymerRepeat struct {
 	common.BackboneElement
 
 	// A representation of an (average) molecular formula from a polymer
-	AverageMolecularFormula *string `json:"averageMolecularFormula,omitempty"`
+	AverageMolecularFormula        *string         `json:"averageMolecularFormula,omitempty"`
+	AverageMolecularFormulaElement *common.Element `json:"_averageMolecularFormula,omitempty"`
 
 	// An SRU - Structural Repeat Unit
 	RepeatUnit []SubstancePolymerRepeatRepeatUnit `json:"repeatUnit,omitempty"`
@@ -121,7 +129,8 @@ type SubstancePolymer struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Modification information
-	Modification *string `json:"modification,omitempty"`
+	Modification        *string         `json:"modification,omitempty"`
+	ModificationElement *common.Element `json:"_modification,omitempty"`
 
 	// Monomer set information
 	MonomerSet []SubstancePolymerMonomerSet `json:"monomerSet,omitempty"`
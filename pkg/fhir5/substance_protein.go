@@ -10,28 +10,33 @@ type SubstanceProteinSubunit struct {
 	common.BackboneElement
 
 	// The modification at the C-terminal shall be specified
-	CTerminalModification *string `json:"cTerminalModification,omitempty"`
+	CTerminalModification        *string         `json:"cTerminalModification,omitempty"`
+	CTerminalModificationElement *common.Element `json:"_cTerminalModification,omitempty"`
 
 	// Unique identifier for molecular fragment modification based on the ISO 11238 Substance ID
 	CTerminalModificationId *common.Identifier `json:"cTerminalModificationId,omitempty"`
 
 	// Length of linear sequences of amino acids contained in the subunit
-	Length *int `json:"length,omitempty"`
+	Length        *int            `json:"length,omitempty"`
+	LengthElement *common.Element `json:"_length,omitempty"`
 
 	// The name of the fragment modified at the N-terminal of the SubstanceProtein shall be specified
-	NTerminalModification *string `json:"nTerminalModification,omitempty"`
+	NTerminalModification        *string         `json:"nTerminalModification,omitempty"`
+	NTerminalModificationElement *common.Element `json:"_nTerminalModification,omitempty"`
 
 	// Unique identifier for molecular fragment modification based on the ISO 11238 Substance ID
 	NTerminalModificationId *common.Identifier `json:"nTerminalModificationId,omitempty"`
 
 	// The sequence information shall be provided enumerating the amino acids from N- to C-terminal end
-	Sequence *string `json:"sequence,omitempty"`
+	Sequence        *string         `json:"sequence,omitempty"`
+	SequenceElement *common.Element `json:"_sequence,omitempty"`
 
 	// The sequence information shall be provided enumerating the amino acids from N- to C-terminal end
 	SequenceAttachment *Attachment `json:"sequenceAttachment,omitempty"`
 
 	// Index of primary sequences of amino acids linked through peptide bonds in order of decreasing length
-	Subunit *int `json:"subunit,omitempty"`
+	Subunit        *int            `json:"subunit,omitempty"`
+	SubunitElement *common.Element `json:"_subunit,omitempty"`
 }
 
 // SubstanceProtein represents a single unit of a linear amino acid sequence
@@ -42,10 +47,12 @@ type SubstanceProtein struct {
 	ResourceType string `json:"resourceType"` // Always "SubstanceProtein"
 
 	// The disulphide bond between two cysteine residues either on the same subunit or on two different subunits
-	DisulfideLinkage []string `json:"disulfideLinkage,omitempty"`
+	DisulfideLinkage        []string          `json:"disulfideLinkage,omitempty"`
+	DisulfideLinkageElement []*common.Element `json:"_disulfideLinkage,omitempty"`
 
 	// Number of linear sequences of amino acids linked through peptide bonds
-	NumberOfSubunits *int `json:"numberOfSubunits,omitempty"`
+	NumberOfSubunits        *int            `json:"numberOfSubunits,omitempty"`
+	NumberOfSubunitsElement *common.Element `json:"_numberOfSubunits,omitempty"`
 
 	// The SubstanceProtein descriptive elements will only be used when a complete or partial amino acid sequence is available
 	SequenceType *common.CodeableConcept `json:"sequenceType,omitempty"`
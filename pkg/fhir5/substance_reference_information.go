@@ -44,7 +44,8 @@ type SubstanceReferenceInformationTarget struct {
 	AmountRange *Range `json:"amountRange,omitempty"`
 
 	// Amount string
-	AmountString *string `json:"amountString,omitempty"`
+	AmountString        *string         `json:"amountString,omitempty"`
+	AmountStringElement *common.Element `json:"_amountString,omitempty"`
 
 	// Amount type
 	AmountType *common.CodeableConcept `json:"amountType,omitempty"`
@@ -76,7 +77,8 @@ type SubstanceReferenceInformation struct {
 	ResourceType string `json:"resourceType"` // Always "SubstanceReferenceInformation"
 
 	// Comment information
-	Comment *string `json:"comment,omitempty"`
+	Comment        *string         `json:"comment,omitempty"`
+	CommentElement *common.Element `json:"_comment,omitempty"`
 
 	// Gene information
 	Gene []SubstanceReferenceInformationGene `json:"gene,omitempty"`
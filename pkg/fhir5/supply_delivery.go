@@ -34,9 +34,10 @@ type SupplyDelivery struct {
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// When the delivery occurred
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// Not to be used to link an event to an Encounter - use Event.context for that
 	PartOf []common.Reference `json:"partOf,omitempty"`
@@ -48,7 +49,8 @@ type SupplyDelivery struct {
 	Receiver []common.Reference `json:"receiver,omitempty"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status *SupplyDeliveryStatus `json:"status,omitempty"`
+	Status        *SupplyDeliveryStatus `json:"status,omitempty"`
+	StatusElement *common.Element       `json:"_status,omitempty"`
 
 	// The item that is being delivered or has been supplied
 	SuppliedItem []SupplyDeliverySuppliedItem `json:"suppliedItem,omitempty"`
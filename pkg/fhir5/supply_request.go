@@ -17,6 +17,7 @@ type SupplyRequestParameter struct {
 	ValueQuantity        *common.Quantity        `json:"valueQuantity,omitempty"`
 	ValueRange           *Range                  `json:"valueRange,omitempty"`
 	ValueBoolean         *bool                   `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element         `json:"_valueBoolean,omitempty"`
 }
 
 // SupplyRequest represents a record of a request to deliver a medication, substance or device
@@ -27,7 +28,8 @@ type SupplyRequest struct {
 	ResourceType string `json:"resourceType"` // Always "SupplyRequest"
 
 	// When the request was made
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// Plan/proposal/order fulfilled by this request
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -51,15 +53,17 @@ type SupplyRequest struct {
 	Item CodeableReference `json:"item"`
 
 	// When the request should be fulfilled
-	OccurrenceDateTime *string        `json:"occurrenceDateTime,omitempty"`
-	OccurrencePeriod   *common.Period `json:"occurrencePeriod,omitempty"`
-	OccurrenceTiming   *Timing        `json:"occurrenceTiming,omitempty"`
+	OccurrenceDateTime        *common.DateTime `json:"occurrenceDateTime,omitempty"`
+	OccurrenceDateTimeElement *common.Element  `json:"_occurrenceDateTime,omitempty"`
+	OccurrencePeriod          *common.Period   `json:"occurrencePeriod,omitempty"`
+	OccurrenceTiming          *Timing          `json:"occurrenceTiming,omitempty"`
 
 	// Specific parameters for the ordered item
 	Parameter []SupplyRequestParameter `json:"parameter,omitempty"`
 
 	// Indicates how quickly this SupplyRequest should be addressed with respect to other requests
-	Priority *SupplyRequestPriority `json:"priority,omitempty"`
+	Priority        *SupplyRequestPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element        `json:"_priority,omitempty"`
 
 	// The amount that is being ordered of the indicated item
 	Quantity common.Quantity `json:"quantity"`
@@ -71,7 +75,8 @@ type SupplyRequest struct {
 	Requester *common.Reference `json:"requester,omitempty"`
 
 	// Status of the supply request
-	Status *SupplyRequestStatus `json:"status,omitempty"`
+	Status        *SupplyRequestStatus `json:"status,omitempty"`
+	StatusElement *common.Element      `json:"_status,omitempty"`
 
 	// Who is intended to fulfill the request
 	Supplier []common.Reference `json:"supplier,omitempty"`
@@ -27,7 +27,8 @@ type TaskRestriction struct {
 	Recipient []common.Reference `json:"recipient,omitempty"`
 
 	// Indicates the number of times the requested action should occur
-	Repetitions *int `json:"repetitions,omitempty"`
+	Repetitions        *int            `json:"repetitions,omitempty"`
+	RepetitionsElement *common.Element `json:"_repetitions,omitempty"`
 }
 
 // TaskInput represents input information for a task
@@ -39,25 +40,45 @@ type TaskInput struct {
 
 	// The value of the input parameter as a basic type
 	ValueBase64Binary        *string                     `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element             `json:"_valueBase64Binary,omitempty"`
 	ValueBoolean             *bool                       `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element             `json:"_valueBoolean,omitempty"`
 	ValueCanonical           *string                     `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *common.Element             `json:"_valueCanonical,omitempty"`
 	ValueCode                *string                     `json:"valueCode,omitempty"`
-	ValueDate                *string                     `json:"valueDate,omitempty"`
-	ValueDateTime            *string                     `json:"valueDateTime,omitempty"`
-	ValueDecimal             *float64                    `json:"valueDecimal,omitempty"`
+	ValueCodeElement         *common.Element             `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date                `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element             `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime            `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element             `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal             `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element             `json:"_valueDecimal,omitempty"`
 	ValueId                  *string                     `json:"valueId,omitempty"`
-	ValueInstant             *string                     `json:"valueInstant,omitempty"`
+	ValueIdElement           *common.Element             `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant             `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element             `json:"_valueInstant,omitempty"`
 	ValueInteger             *int                        `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element             `json:"_valueInteger,omitempty"`
 	ValueInteger64           *int64                      `json:"valueInteger64,omitempty"`
+	ValueInteger64Element    *common.Element             `json:"_valueInteger64,omitempty"`
 	ValueMarkdown            *string                     `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element             `json:"_valueMarkdown,omitempty"`
 	ValueOid                 *string                     `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element             `json:"_valueOid,omitempty"`
 	ValuePositiveInt         *int                        `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element             `json:"_valuePositiveInt,omitempty"`
 	ValueString              *string                     `json:"valueString,omitempty"`
-	ValueTime                *string                     `json:"valueTime,omitempty"`
+	ValueStringElement       *common.Element             `json:"_valueString,omitempty"`
+	ValueTime                *common.Time                `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element             `json:"_valueTime,omitempty"`
 	ValueUnsignedInt         *int                        `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element             `json:"_valueUnsignedInt,omitempty"`
 	ValueUri                 *string                     `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element             `json:"_valueUri,omitempty"`
 	ValueUrl                 *string                     `json:"valueUrl,omitempty"`
+	ValueUrlElement          *common.Element             `json:"_valueUrl,omitempty"`
 	ValueUuid                *string                     `json:"valueUuid,omitempty"`
+	ValueUuidElement         *common.Element             `json:"_valueUuid,omitempty"`
 	ValueAddress             *Address                    `json:"valueAddress,omitempty"`
 	ValueAge                 *common.Age                 `json:"valueAge,omitempty"`
 	ValueAnnotation          *Annotation                 `json:"valueAnnotation,omitempty"`
@@ -96,29 +117,50 @@ type TaskOutput struct {
 	common.BackboneElement
 
 	// The name of the Output parameter
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The value of the Output parameter as a basic type
 	ValueBase64Binary        *string                     `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element             `json:"_valueBase64Binary,omitempty"`
 	ValueBoolean             *bool                       `json:"valueBoolean,omitempty"`
+	ValueBooleanElement      *common.Element             `json:"_valueBoolean,omitempty"`
 	ValueCanonical           *string                     `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement    *common.Element             `json:"_valueCanonical,omitempty"`
 	ValueCode                *string                     `json:"valueCode,omitempty"`
-	ValueDate                *string                     `json:"valueDate,omitempty"`
-	ValueDateTime            *string                     `json:"valueDateTime,omitempty"`
-	ValueDecimal             *float64                    `json:"valueDecimal,omitempty"`
+	ValueCodeElement         *common.Element             `json:"_valueCode,omitempty"`
+	ValueDate                *common.Date                `json:"valueDate,omitempty"`
+	ValueDateElement         *common.Element             `json:"_valueDate,omitempty"`
+	ValueDateTime            *common.DateTime            `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement     *common.Element             `json:"_valueDateTime,omitempty"`
+	ValueDecimal             *common.Decimal             `json:"valueDecimal,omitempty"`
+	ValueDecimalElement      *common.Element             `json:"_valueDecimal,omitempty"`
 	ValueId                  *string                     `json:"valueId,omitempty"`
-	ValueInstant             *string                     `json:"valueInstant,omitempty"`
+	ValueIdElement           *common.Element             `json:"_valueId,omitempty"`
+	ValueInstant             *common.Instant             `json:"valueInstant,omitempty"`
+	ValueInstantElement      *common.Element             `json:"_valueInstant,omitempty"`
 	ValueInteger             *int                        `json:"valueInteger,omitempty"`
+	ValueIntegerElement      *common.Element             `json:"_valueInteger,omitempty"`
 	ValueInteger64           *int64                      `json:"valueInteger64,omitempty"`
+	ValueInteger64Element    *common.Element             `json:"_valueInteger64,omitempty"`
 	ValueMarkdown            *string                     `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement     *common.Element             `json:"_valueMarkdown,omitempty"`
 	ValueOid                 *string                     `json:"valueOid,omitempty"`
+	ValueOidElement          *common.Element             `json:"_valueOid,omitempty"`
 	ValuePositiveInt         *int                        `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement  *common.Element             `json:"_valuePositiveInt,omitempty"`
 	ValueString              *string                     `json:"valueString,omitempty"`
-	ValueTime                *string                     `json:"valueTime,omitempty"`
+	ValueStringElement       *common.Element             `json:"_valueString,omitempty"`
+	ValueTime                *common.Time                `json:"valueTime,omitempty"`
+	ValueTimeElement         *common.Element             `json:"_valueTime,omitempty"`
 	ValueUnsignedInt         *int                        `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement  *common.Element             `json:"_valueUnsignedInt,omitempty"`
 	ValueUri                 *string                     `json:"valueUri,omitempty"`
+	ValueUriElement          *common.Element             `json:"_valueUri,omitempty"`
 	ValueUrl                 *string                     `json:"valueUrl,omitempty"`
+	ValueUrlElement          *common.Element             `json:"_valueUrl,omitempty"`
 	ValueUuid                *string                     `json:"valueUuid,omitempty"`
+	ValueUuidElement         *common.Element             `json:"_valueUuid,omitempty"`
 	ValueAddress             *Address                    `json:"valueAddress,omitempty"`
 	ValueAge                 *common.Age                 `json:"valueAge,omitempty"`
 	ValueAnnotation          *Annotation                 `json:"valueAnnotation,omitempty"`
@@ -160,7 +202,8 @@ type Task struct {
 	ResourceType string `json:"resourceType"` // Always "Task"
 
 	// The date and time this task was created
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// BasedOn refers to a higher-level authorization that triggered the creation of the task
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -190,16 +233,19 @@ type Task struct {
 	Input []TaskInput `json:"input,omitempty"`
 
 	// An identifier for this task
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// An identifier for this task
-	InstantiatesUri *string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations that may be relevant to the task
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// The date and time of when the task was last modified
-	LastModified *string `json:"lastModified,omitempty"`
+	LastModified        *common.DateTime `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element  `json:"_lastModified,omitempty"`
 
 	// Additional information about the task
 	Location *common.Reference `json:"location,omitempty"`
@@ -217,7 +263,8 @@ type Task struct {
 	PerformerType []common.CodeableConcept `json:"performerType,omitempty"`
 
 	// The priority of the task among other tasks of the same type
-	Priority *TaskPriority `json:"priority,omitempty"`
+	Priority        *TaskPriority   `json:"priority,omitempty"`
+	PriorityElement *common.Element `json:"_priority,omitempty"`
 
 	// A description or code indicating why this task needs to be performed
 	Reason *common.CodeableConcept `json:"reason,omitempty"`
@@ -232,7 +279,8 @@ type Task struct {
 	Restriction *TaskRestriction `json:"restriction,omitempty"`
 
 	// The current status of the task
-	Status TaskStatus `json:"status"`
+	Status        TaskStatus      `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// An explanation as to why this task is held, failed, was refused, etc
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
@@ -241,10 +289,12 @@ type Task struct {
 	Subject *common.Reference `json:"subject,omitempty"`
 
 	// A short description of the task used to provide a summary to the user
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// A URI that identifies the task
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TaskStatus represents the status of a task
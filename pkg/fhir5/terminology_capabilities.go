@@ -9,7 +9,8 @@ type TerminologyCapabilitiesClosure struct {
 	common.BackboneElement
 
 	// If cross-system closure is supported
-	Translation *bool `json:"translation,omitempty"`
+	Translation        *bool           `json:"translation,omitempty"`
+	TranslationElement *common.Element `json:"_translation,omitempty"`
 }
 
 // TerminologyCapabilitiesCodeSystem represents code system capabilities
@@ -17,13 +18,15 @@ type TerminologyCapabilitiesCodeSystem struct {
 	common.BackboneElement
 
 	// URI for the Code System
-	Uri *string `json:"uri,omitempty"`
+	Uri        *string         `json:"uri,omitempty"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 
 	// Version of Code System supported
 	Version []TerminologyCapabilitiesCodeSystemVersion `json:"version,omitempty"`
 
 	// True if subsumption is supported
-	Subsumption *bool `json:"subsumption,omitempty"`
+	Subsumption        *bool           `json:"subsumption,omitempty"`
+	SubsumptionElement *common.Element `json:"_subsumption,omitempty"`
 }
 
 // TerminologyCapabilitiesCodeSystemVersion represents version-specific capabilities
@@ -31,22 +34,27 @@ type TerminologyCapabilitiesCodeSystemVersion struct {
 	common.BackboneElement
 
 	// Version identifier for this version
-	Code *string `json:"code,omitempty"`
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// If this is the default version for this code system
-	IsDefault *bool `json:"isDefault,omitempty"`
+	IsDefault        *bool           `json:"isDefault,omitempty"`
+	IsDefaultElement *common.Element `json:"_isDefault,omitempty"`
 
 	// If compositional grammar is supported
-	Compositional *bool `json:"compositional,omitempty"`
+	Compositional        *bool           `json:"compositional,omitempty"`
+	CompositionalElement *common.Element `json:"_compositional,omitempty"`
 
 	// Language Displays supported
-	Language []string `json:"language,omitempty"`
+	Language        []string          `json:"language,omitempty"`
+	LanguageElement []*common.Element `json:"_language,omitempty"`
 
 	// Filter Properties supported
 	Filter []TerminologyCapabilitiesCodeSystemVersionFilter `json:"filter,omitempty"`
 
 	// Properties supported for $lookup
-	Property []string `json:"property,omitempty"`
+	Property        []string          `json:"property,omitempty"`
+	PropertyElement []*common.Element `json:"_property,omitempty"`
 }
 
 // TerminologyCapabilitiesCodeSystemVersionFilter represents filter capabilities
@@ -54,10 +62,12 @@ type TerminologyCapabilitiesCodeSystemVersionFilter struct {
 	common.BackboneElement
 
 	// Code of the property supported
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Operations supported for the property
-	Op []string `json:"op"`
+	Op        []string          `json:"op"`
+	OpElement []*common.Element `json:"_op,omitempty"`
 }
 
 // TerminologyCapabilitiesExpansion represents expansion operation capabilities
@@ -65,19 +75,23 @@ type TerminologyCapabilitiesExpansion struct {
 	common.BackboneElement
 
 	// Whether the server can return nested value sets
-	Hierarchical *bool `json:"hierarchical,omitempty"`
+	Hierarchical        *bool           `json:"hierarchical,omitempty"`
+	HierarchicalElement *common.Element `json:"_hierarchical,omitempty"`
 
 	// Whether the server supports paging on expansion
-	Paging *bool `json:"paging,omitempty"`
+	Paging        *bool           `json:"paging,omitempty"`
+	PagingElement *common.Element `json:"_paging,omitempty"`
 
 	// Allow request for incomplete expansions
-	Incomplete *bool `json:"incomplete,omitempty"`
+	Incomplete        *bool           `json:"incomplete,omitempty"`
+	IncompleteElement *common.Element `json:"_incomplete,omitempty"`
 
 	// Supported expansion parameter
 	Parameter []TerminologyCapabilitiesExpansionParameter `json:"parameter,omitempty"`
 
 	// Documentation about text searching
-	TextFilter *string `json:"textFilter,omitempty"`
+	TextFilter        *string         `json:"textFilter,omitempty"`
+	TextFilterElement *common.Element `json:"_textFilter,omitempty"`
 }
 
 // TerminologyCapabilitiesExpansionParameter represents expansion parameter capabilities
@@ -85,10 +99,12 @@ type TerminologyCapabilitiesExpansionParameter struct {
 	common.BackboneElement
 
 	// Expansion Parameter name
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Description of support for parameter
-	Documentation *string `json:"documentation,omitempty"`
+	Documentation        *string         `json:"documentation,omitempty"`
+	DocumentationElement *common.Element `json:"_documentation,omitempty"`
 }
 
 // TerminologyCapabilitiesImplementation represents implementation details
@@ -96,10 +112,12 @@ type TerminologyCapabilitiesImplementation struct {
 	common.BackboneElement
 
 	// Describes this specific instance
-	Description string `json:"description"`
+	Description        string          `json:"description"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Base URL for the implementation
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TerminologyCapabilitiesSoftware represents software details
@@ -107,13 +125,16 @@ type TerminologyCapabilitiesSoftware struct {
 	common.BackboneElement
 
 	// A name the software is known by
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Version covered by this statement
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// Date this version was released
-	ReleaseDate *string `json:"releaseDate,omitempty"`
+	ReleaseDate        *common.DateTime `json:"releaseDate,omitempty"`
+	ReleaseDateElement *common.Element  `json:"_releaseDate,omitempty"`
 }
 
 // TerminologyCapabilitiesTranslation represents translation operation capabilities
@@ -121,7 +142,8 @@ type TerminologyCapabilitiesTranslation struct {
 	common.BackboneElement
 
 	// Whether the client must identify the map
-	NeedsMap bool `json:"needsMap"`
+	NeedsMap        bool            `json:"needsMap"`
+	NeedsMapElement *common.Element `json:"_needsMap,omitempty"`
 }
 
 // TerminologyCapabilitiesValidateCode represents validate-code operation capabilities
@@ -129,7 +151,8 @@ type TerminologyCapabilitiesValidateCode struct {
 	common.BackboneElement
 
 	// Whether translations are validated
-	Translations bool `json:"translations"`
+	Translations        bool            `json:"translations"`
+	TranslationsElement *common.Element `json:"_translations,omitempty"`
 }
 
 // TerminologyCapabilities represents a set of capabilities of a FHIR Terminology Server
@@ -143,7 +166,8 @@ type TerminologyCapabilities struct {
 	Closure *TerminologyCapabilitiesClosure `json:"closure,omitempty"`
 
 	// See notes on the ValueSet resource
-	CodeSearch *TerminologyCapabilitiesCodeSearch `json:"codeSearch,omitempty"`
+	CodeSearch        *TerminologyCapabilitiesCodeSearch `json:"codeSearch,omitempty"`
+	CodeSearchElement *common.Element                    `json:"_codeSearch,omitempty"`
 
 	// The code system - identified by its system URL - may also be declared explicitly as a Code System Resource at /CodeSystem, but it might not be
 	CodeSystem []TerminologyCapabilitiesCodeSystem `json:"codeSystem,omitempty"`
@@ -152,22 +176,27 @@ type TerminologyCapabilities struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the terminology capabilities and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published, but may be present on draft content
-	Date string `json:"date"`
+	Date        common.DateTime `json:"date"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse, instructions for clinical use and interpretation, literature references, examples from the paper world, etc
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Information about the ValueSet/$expand operation
 	Expansion *TerminologyCapabilitiesExpansion `json:"expansion,omitempty"`
 
 	// Allows filtering of terminology capabilitiess that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type, and can then identify this terminology capabilities outside of FHIR, where it is not possible to use the logical URI
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -179,34 +208,42 @@ type TerminologyCapabilities struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The way that this statement is intended to be used, to describe an actual running instance of software, a particular product (kind, not instance of software) or a class of implementation (e.g. a desired purchase)
-	Kind TerminologyCapabilitiesKind `json:"kind"`
+	Kind        TerminologyCapabilitiesKind `json:"kind"`
+	KindElement *common.Element             `json:"_kind,omitempty"`
 
 	// Whether the server supports lockedDate
-	LockedDate *bool `json:"lockedDate,omitempty"`
+	LockedDate        *bool           `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element `json:"_lockedDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the terminology capabilities
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Software that is covered by this terminology capability statement
 	Software *TerminologyCapabilitiesSoftware `json:"software,omitempty"`
 
 	// Allows filtering of terminology capabilitiess that are appropriate for use versus not
-	Status TerminologyCapabilitiesStatus `json:"status"`
+	Status        TerminologyCapabilitiesStatus `json:"status"`
+	StatusElement *common.Element               `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Information about the ConceptMap/$translate operation
 	Translation *TerminologyCapabilitiesTranslation `json:"translation,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
@@ -215,10 +252,12 @@ type TerminologyCapabilities struct {
 	ValidateCode *TerminologyCapabilitiesValidateCode `json:"validateCode,omitempty"`
 
 	// There may be different terminology capabilities instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
 	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
@@ -9,7 +9,8 @@ type TestPlanDependency struct {
 	common.BackboneElement
 
 	// A textual description of the criterium - what is needed for the dependency to be considered met
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Predecessor test plans - those that are expected to be successfully performed as a dependency for the execution of this test plan
 	Predecessor *common.Reference `json:"predecessor,omitempty"`
@@ -20,7 +21,8 @@ type TestPlanTestCaseDependency struct {
 	common.BackboneElement
 
 	// Description of the criteria
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Link to predecessor test plans
 	Predecessor *common.Reference `json:"predecessor,omitempty"`
@@ -34,7 +36,8 @@ type TestPlanTestCaseTestRunScript struct {
 	Language *common.CodeableConcept `json:"language,omitempty"`
 
 	// The actual content of the cases - references to TestScripts or externally defined content
-	SourceString *string `json:"sourceString,omitempty"`
+	SourceString        *string         `json:"sourceString,omitempty"`
+	SourceStringElement *common.Element `json:"_sourceString,omitempty"`
 
 	// The actual content of the cases - references to TestScripts or externally defined content
 	SourceReference *common.Reference `json:"sourceReference,omitempty"`
@@ -45,7 +48,8 @@ type TestPlanTestCaseTestRun struct {
 	common.BackboneElement
 
 	// The narrative description of the tests
-	Narrative *string `json:"narrative,omitempty"`
+	Narrative        *string         `json:"narrative,omitempty"`
+	NarrativeElement *common.Element `json:"_narrative,omitempty"`
 
 	// The test cases in a structured language e.g. gherkin, Postman, or FHIR TestScript
 	Script *TestPlanTestCaseTestRunScript `json:"script,omitempty"`
@@ -76,25 +80,31 @@ type TestPlan struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the test plan and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published, but may be present on draft content
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// The required criteria to execute the test plan - e.g. preconditions, previous tests
 	Dependency []TestPlanDependency `json:"dependency,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse, instructions for clinical use and interpretation, literature references, examples from the paper world, etc
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The threshold or criteria for the test plan to be considered successfully executed - narrative
-	ExitCriteria *string `json:"exitCriteria,omitempty"`
+	ExitCriteria        *string         `json:"exitCriteria,omitempty"`
+	ExitCriteriaElement *common.Element `json:"_exitCriteria,omitempty"`
 
 	// Allows filtering of test plans that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type, and can then identify this test plan outside of FHIR, where it is not possible to use the logical URI
 	Identifier []common.Identifier `json:"identifier,omitempty"`
@@ -103,40 +113,49 @@ type TestPlan struct {
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the test plan
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// What is being tested with this Test Plan - a conformance resource, or narrative criteria, or an external reference
 	Scope []common.Reference `json:"scope,omitempty"`
 
 	// Allows filtering of test plans that are appropriate for use versus not
-	Status TestPlanStatus `json:"status"`
+	Status        TestPlanStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// The individual test cases that are part of this plan, when they they are made explicit
 	TestCase []TestPlanTestCase `json:"testCase,omitempty"`
 
 	// A description of test tools to be used in the test plan
-	TestTools *string `json:"testTools,omitempty"`
+	TestTools        *string         `json:"testTools,omitempty"`
+	TestToolsElement *common.Element `json:"_testTools,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different test plan instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
 	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
@@ -9,13 +9,16 @@ type TestReportParticipant struct {
 	common.BackboneElement
 
 	// The display name of the participant
-	Display *string `json:"display,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
 
 	// The type of participant
-	Type TestReportParticipantType `json:"type"`
+	Type        TestReportParticipantType `json:"type"`
+	TypeElement *common.Element           `json:"_type,omitempty"`
 
 	// The uri of the participant
-	Uri string `json:"uri"`
+	Uri        string          `json:"uri"`
+	UriElement *common.Element `json:"_uri,omitempty"`
 }
 
 // TestReportParticipantType represents the type of participant
@@ -32,13 +35,16 @@ type TestReportSetupActionOperation struct {
 	common.BackboneElement
 
 	// A link to further details on the result
-	Detail *string `json:"detail,omitempty"`
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
 
 	// An explanatory message associated with the result
-	Message *string `json:"message,omitempty"`
+	Message        *string         `json:"message,omitempty"`
+	MessageElement *common.Element `json:"_message,omitempty"`
 
 	// The result of this operation
-	Result TestReportActionResult `json:"result"`
+	Result        TestReportActionResult `json:"result"`
+	ResultElement *common.Element        `json:"_result,omitempty"`
 }
 
 // TestReportSetupActionAssertRequirement represents requirements for assertions
@@ -46,10 +52,12 @@ type TestReportSetupActionAssertRequirement struct {
 	common.BackboneElement
 
 	// Link or reference providing traceability to the testing requirement for this test
-	LinkUri *string `json:"linkUri,omitempty"`
+	LinkUri        *string         `json:"linkUri,omitempty"`
+	LinkUriElement *common.Element `json:"_linkUri,omitempty"`
 
 	// Link or reference providing traceability to the testing requirement for this test
-	LinkCanonical *string `json:"linkCanonical,omitempty"`
+	LinkCanonical        *string         `json:"linkCanonical,omitempty"`
+	LinkCanonicalElement *common.Element `json:"_linkCanonical,omitempty"`
 }
 
 // TestReportSetupActionAssert represents the results of the assertion performed
@@ -57,16 +65,19 @@ type TestReportSetupActionAssert struct {
 	common.BackboneElement
 
 	// A link to further details on the result
-	Detail *string `json:"detail,omitempty"`
+	Detail        *string         `json:"detail,omitempty"`
+	DetailElement *common.Element `json:"_detail,omitempty"`
 
 	// An explanatory message associated with the result
-	Message *string `json:"message,omitempty"`
+	Message        *string         `json:"message,omitempty"`
+	MessageElement *common.Element `json:"_message,omitempty"`
 
 	// TestScript and TestReport instances are typically based on known, defined test requirements and documentation
 	Requirement []TestReportSetupActionAssertRequirement `json:"requirement,omitempty"`
 
 	// The result of this assertion
-	Result TestReportActionResult `json:"result"`
+	Result        TestReportActionResult `json:"result"`
+	ResultElement *common.Element        `json:"_result,omitempty"`
 }
 
 // TestReportSetupAction represents a single action performed as part of the test setup
@@ -104,10 +115,12 @@ type TestReportTest struct {
 	common.BackboneElement
 
 	// The name of this test used for tracking/logging purposes by test engines
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A short description of the test used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Action would contain either an operation or an assertion
 	Action []TestReportTestAction `json:"action"`
@@ -171,25 +184,30 @@ type TestReport struct {
 	Identifier *common.Identifier `json:"identifier,omitempty"`
 
 	// Additional specific dates may be added as extensions
-	Issued *string `json:"issued,omitempty"`
+	Issued        *common.DateTime `json:"issued,omitempty"`
+	IssuedElement *common.Element  `json:"_issued,omitempty"`
 
 	// Not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A participant in the test execution, either the execution engine, a client, or a server
 	Participant []TestReportParticipant `json:"participant,omitempty"`
 
 	// The pass and fail result represents a completed test script execution
-	Result TestReportResult `json:"result"`
+	Result        TestReportResult `json:"result"`
+	ResultElement *common.Element  `json:"_result,omitempty"`
 
 	// The final score (percentage of tests passed) resulting from the execution of the TestScript
-	Score *float64 `json:"score,omitempty"`
+	Score        *common.Decimal `json:"score,omitempty"`
+	ScoreElement *common.Element `json:"_score,omitempty"`
 
 	// The results of the series of required setup operations before the tests were executed
 	Setup *TestReportSetup `json:"setup,omitempty"`
 
 	// The status represents where the execution is currently within the test script execution life cycle
-	Status TestReportStatus `json:"status"`
+	Status        TestReportStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 
 	// The results of the series of operations required to clean up after all the tests were executed
 	Teardown *TestReportTeardown `json:"teardown,omitempty"`
@@ -198,8 +216,10 @@ type TestReport struct {
 	Test []TestReportTest `json:"test,omitempty"`
 
 	// Usually an organization, but may be an individual
-	Tester *string `json:"tester,omitempty"`
+	Tester        *string         `json:"tester,omitempty"`
+	TesterElement *common.Element `json:"_tester,omitempty"`
 
 	// Ideally this is an absolute URL that is used to identify the version-specific TestScript that was executed
-	TestScript string `json:"testScript"`
+	TestScript        string          `json:"testScript"`
+	TestScriptElement *common.Element `json:"_testScript,omitempty"`
 }
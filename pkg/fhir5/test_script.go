@@ -9,13 +9,15 @@ type TestScriptOrigin struct {
 	common.BackboneElement
 
 	// A given origin index can appear only once in the list
-	Index int `json:"index"`
+	Index        int             `json:"index"`
+	IndexElement *common.Element `json:"_index,omitempty"`
 
 	// Must be a "sender"/"client" profile
 	Profile common.Coding `json:"profile"`
 
 	// If provided, the test engine is not expected to prompt for or accept external input of this value
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TestScriptDestination represents the purpose of this element is to define the profile of a destination element used elsewhere in the script
@@ -23,13 +25,15 @@ type TestScriptDestination struct {
 	common.BackboneElement
 
 	// A given destination index can appear only once in the list
-	Index int `json:"index"`
+	Index        int             `json:"index"`
+	IndexElement *common.Element `json:"_index,omitempty"`
 
 	// Must be a "receiver"/"server" profile
 	Profile common.Coding `json:"profile"`
 
 	// If provided, the test engine is not expected to prompt for or accept external input of this value
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TestScriptMetadataLink represents a link to the FHIR specification that this test is covering
@@ -37,10 +41,12 @@ type TestScriptMetadataLink struct {
 	common.BackboneElement
 
 	// Short description of the link
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// URL to a particular requirement or feature within the FHIR specification
-	Url string `json:"url"`
+	Url        string          `json:"url"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TestScriptMetadataCapability represents the required capability must exist and are assumed to function correctly on the FHIR server being tested
@@ -48,25 +54,32 @@ type TestScriptMetadataCapability struct {
 	common.BackboneElement
 
 	// The conformance statement of the server has to contain at a minimum the contents of the reference pointed to by this element
-	Capabilities string `json:"capabilities"`
+	Capabilities        string          `json:"capabilities"`
+	CapabilitiesElement *common.Element `json:"_capabilities,omitempty"`
 
 	// Description of the capabilities that this test script is requiring the server to support
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Which server these requirements apply to
-	Destination *int `json:"destination,omitempty"`
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
 
 	// Links to the FHIR specification that describes this interaction and the resources involved in more detail
-	Link []string `json:"link,omitempty"`
+	Link        []string          `json:"link,omitempty"`
+	LinkElement []*common.Element `json:"_link,omitempty"`
 
 	// Which origin server these requirements apply to
-	Origin []int `json:"origin,omitempty"`
+	Origin        []int             `json:"origin,omitempty"`
+	OriginElement []*common.Element `json:"_origin,omitempty"`
 
 	// Whether or not the test execution will require the given capabilities of the server in order for this test script to execute
-	Required bool `json:"required"`
+	Required        bool            `json:"required"`
+	RequiredElement *common.Element `json:"_required,omitempty"`
 
 	// Whether or not the test execution will validate the given capabilities of the server in order for this test script to execute
-	Validated bool `json:"validated"`
+	Validated        bool            `json:"validated"`
+	ValidatedElement *common.Element `json:"_validated,omitempty"`
 }
 
 // TestScriptMetadata represents the required capability must exist and are assumed to function correctly on the FHIR server being tested
@@ -85,7 +98,8 @@ type TestScriptScope struct {
 	common.BackboneElement
 
 	// The specific conformance artifact being tested
-	Artifact string `json:"artifact"`
+	Artifact        string          `json:"artifact"`
+	ArtifactElement *common.Element `json:"_artifact,omitempty"`
 
 	// The expectation of whether the test must pass for the system to be considered conformant with the artifact
 	Conformance *common.CodeableConcept `json:"conformance,omitempty"`
@@ -99,10 +113,12 @@ type TestScriptFixture struct {
 	common.BackboneElement
 
 	// Whether or not to implicitly create the fixture during setup
-	Autocreate bool `json:"autocreate"`
+	Autocreate        bool            `json:"autocreate"`
+	AutocreateElement *common.Element `json:"_autocreate,omitempty"`
 
 	// Whether or not to implicitly delete the fixture during teardown
-	Autodelete bool `json:"autodelete"`
+	Autodelete        bool            `json:"autodelete"`
+	AutodeleteElement *common.Element `json:"_autodelete,omitempty"`
 
 	// Reference to the resource (contained in the test script)
 	Resource *common.Reference `json:"resource,omitempty"`
@@ -113,28 +129,36 @@ type TestScriptVariable struct {
 	common.BackboneElement
 
 	// Descriptive name for this variable
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Default, hard-coded, or user-defined value for this variable
-	DefaultValue *string `json:"defaultValue,omitempty"`
+	DefaultValue        *string         `json:"defaultValue,omitempty"`
+	DefaultValueElement *common.Element `json:"_defaultValue,omitempty"`
 
 	// A free-text natural language description of the variable and its purpose
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The FHIRPath expression against the fixture body
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// HTTP header field name for source extraction
-	HeaderField *string `json:"headerField,omitempty"`
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
 
 	// Hint help text for default value to enter
-	Hint *string `json:"hint,omitempty"`
+	Hint        *string         `json:"hint,omitempty"`
+	HintElement *common.Element `json:"_hint,omitempty"`
 
 	// XPath or JSONPath against the fixture body
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 
 	// Source of the value for this variable
-	SourceID *string `json:"sourceId,omitempty"`
+	SourceID        *string         `json:"sourceId,omitempty"`
+	SourceIDElement *common.Element `json:"_sourceId,omitempty"`
 }
 
 // TestScriptSetupActionOperation represents the operation to perform
@@ -145,49 +169,63 @@ type TestScriptSetupActionOperation struct {
 	Type *common.Coding `json:"type,omitempty"`
 
 	// Resource type
-	Resource *string `json:"resource,omitempty"`
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
 
 	// Label would be used for tracking/logging purposes by test engines
-	Label *string `json:"label,omitempty"`
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
 
 	// The description would be used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The mime-type to use for RESTful operations in the 'Accept' header
-	Accept *string `json:"accept,omitempty"`
+	Accept        *string         `json:"accept,omitempty"`
+	AcceptElement *common.Element `json:"_accept,omitempty"`
 
 	// The mime-type to use for RESTful operations in the 'Content-Type' header
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The server where the request message originates from
-	Destination *int `json:"destination,omitempty"`
+	Destination        *int            `json:"destination,omitempty"`
+	DestinationElement *common.Element `json:"_destination,omitempty"`
 
 	// Whether or not to implicitly send the request url in encoded format
-	EncodeRequestUrl *bool `json:"encodeRequestUrl,omitempty"`
+	EncodeRequestUrl        *bool           `json:"encodeRequestUrl,omitempty"`
+	EncodeRequestUrlElement *common.Element `json:"_encodeRequestUrl,omitempty"`
 
 	// The server where the request message is destined for
-	Origin *int `json:"origin,omitempty"`
+	Origin        *int            `json:"origin,omitempty"`
+	OriginElement *common.Element `json:"_origin,omitempty"`
 
 	// Path plus parameters after [type]
-	Params *string `json:"params,omitempty"`
+	Params        *string         `json:"params,omitempty"`
+	ParamsElement *common.Element `json:"_params,omitempty"`
 
 	// Explicitly defined path parameters
 	RequestHeader []TestScriptSetupActionOperationRequestHeader `json:"requestHeader,omitempty"`
 
 	// The fixture id (maybe new) for the request body
-	RequestID *string `json:"requestId,omitempty"`
+	RequestID        *string         `json:"requestId,omitempty"`
+	RequestIDElement *common.Element `json:"_requestId,omitempty"`
 
 	// The type of the resource
-	ResponseID *string `json:"responseId,omitempty"`
+	ResponseID        *string         `json:"responseId,omitempty"`
+	ResponseIDElement *common.Element `json:"_responseId,omitempty"`
 
 	// The fixture id for the response body
-	SourceID *string `json:"sourceId,omitempty"`
+	SourceID        *string         `json:"sourceId,omitempty"`
+	SourceIDElement *common.Element `json:"_sourceId,omitempty"`
 
 	// Id of fixture used for extracting the [id],  [type], and [vid] for GET requests
-	TargetID *string `json:"targetId,omitempty"`
+	TargetID        *string         `json:"targetId,omitempty"`
+	TargetIDElement *common.Element `json:"_targetId,omitempty"`
 
 	// Complete request URL
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 }
 
 // TestScriptSetupActionOperationRequestHeader represents explicitly defined path parameters
@@ -195,10 +233,12 @@ type TestScriptSetupActionOperationRequestHeader struct {
 	common.BackboneElement
 
 	// HTTP header field name
-	Field string `json:"field"`
+	Field        string          `json:"field"`
+	FieldElement *common.Element `json:"_field,omitempty"`
 
 	// HTTP header field value
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // TestScriptSetupActionAssert represents the assertion to perform
@@ -206,58 +246,76 @@ type TestScriptSetupActionAssert struct {
 	common.BackboneElement
 
 	// The label would be used for tracking/logging purposes by test engines
-	Label *string `json:"label,omitempty"`
+	Label        *string         `json:"label,omitempty"`
+	LabelElement *common.Element `json:"_label,omitempty"`
 
 	// The description would be used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The direction to use for the assertion
-	Direction *TestScriptSetupActionAssertDirection `json:"direction,omitempty"`
+	Direction        *TestScriptSetupActionAssertDirection `json:"direction,omitempty"`
+	DirectionElement *common.Element                       `json:"_direction,omitempty"`
 
 	// Id of the source fixture used as the contents to be evaluated by either the "source/expression" or "sourceId/path" definition
-	CompareToSourceID *string `json:"compareToSourceId,omitempty"`
+	CompareToSourceID        *string         `json:"compareToSourceId,omitempty"`
+	CompareToSourceIDElement *common.Element `json:"_compareToSourceId,omitempty"`
 
 	// The fluentpath expression to evaluate against the source fixture
-	CompareToSourceExpression *string `json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpression        *string         `json:"compareToSourceExpression,omitempty"`
+	CompareToSourceExpressionElement *common.Element `json:"_compareToSourceExpression,omitempty"`
 
 	// XPath or JSONPath expression to evaluate against the source fixture
-	CompareToSourcePath *string `json:"compareToSourcePath,omitempty"`
+	CompareToSourcePath        *string         `json:"compareToSourcePath,omitempty"`
+	CompareToSourcePathElement *common.Element `json:"_compareToSourcePath,omitempty"`
 
 	// The content-type or mime-type to use for RESTful operations in the 'Content-Type' header
-	ContentType *string `json:"contentType,omitempty"`
+	ContentType        *string         `json:"contentType,omitempty"`
+	ContentTypeElement *common.Element `json:"_contentType,omitempty"`
 
 	// The fluentpath expression to be evaluated
-	Expression *string `json:"expression,omitempty"`
+	Expression        *string         `json:"expression,omitempty"`
+	ExpressionElement *common.Element `json:"_expression,omitempty"`
 
 	// HTTP header field name
-	HeaderField *string `json:"headerField,omitempty"`
+	HeaderField        *string         `json:"headerField,omitempty"`
+	HeaderFieldElement *common.Element `json:"_headerField,omitempty"`
 
 	// The ID of a fixture
-	MinimumID *string `json:"minimumId,omitempty"`
+	MinimumID        *string         `json:"minimumId,omitempty"`
+	MinimumIDElement *common.Element `json:"_minimumId,omitempty"`
 
 	// Whether or not the test execution performs validation on the specified navigation links
-	NavigationLinks *bool `json:"navigationLinks,omitempty"`
+	NavigationLinks        *bool           `json:"navigationLinks,omitempty"`
+	NavigationLinksElement *common.Element `json:"_navigationLinks,omitempty"`
 
 	// The operator type defines the conditional behavior of the assert
-	Operator *TestScriptSetupActionAssertOperator `json:"operator,omitempty"`
+	Operator        *TestScriptSetupActionAssertOperator `json:"operator,omitempty"`
+	OperatorElement *common.Element                      `json:"_operator,omitempty"`
 
 	// XPath or JSONPath expression
-	Path *string `json:"path,omitempty"`
+	Path        *string         `json:"path,omitempty"`
+	PathElement *common.Element `json:"_path,omitempty"`
 
 	// The request method or HTTP operation code to compare against that used by the client system under test
-	RequestMethod *string `json:"requestMethod,omitempty"`
+	RequestMethod        *string         `json:"requestMethod,omitempty"`
+	RequestMethodElement *common.Element `json:"_requestMethod,omitempty"`
 
 	// The value to compare to
-	RequestURL *string `json:"requestURL,omitempty"`
+	RequestURL        *string         `json:"requestURL,omitempty"`
+	RequestURLElement *common.Element `json:"_requestURL,omitempty"`
 
 	// The type of the resource
-	Resource *string `json:"resource,omitempty"`
+	Resource        *string         `json:"resource,omitempty"`
+	ResourceElement *common.Element `json:"_resource,omitempty"`
 
 	// okay | created | noContent | notModified | bad | forbidden | notFound | methodNotAllowed | conflict | gone | preconditionFailed | unprocessable
-	Response *TestScriptSetupActionAssertResponse `json:"response,omitempty"`
+	Response        *TestScriptSetupActionAssertResponse `json:"response,omitempty"`
+	ResponseElement *common.Element                      `json:"_response,omitempty"`
 
 	// The value of the HTTP response code to be tested
-	ResponseCode *string `json:"responseCode,omitempty"`
+	ResponseCode        *string         `json:"responseCode,omitempty"`
+	ResponseCodeElement *common.Element `json:"_responseCode,omitempty"`
 
 	// The TestScript.rule this assert will evaluate
 	Rule *TestScriptSetupActionAssertRule `json:"rule,omitempty"`
@@ -266,16 +324,20 @@ type TestScriptSetupActionAssert struct {
 	Ruleset *TestScriptSetupActionAssertRuleset `json:"ruleset,omitempty"`
 
 	// Fixture to evaluate the XPath/JSONPath expression or the headerField against
-	SourceID *string `json:"sourceId,omitempty"`
+	SourceID        *string         `json:"sourceId,omitempty"`
+	SourceIDElement *common.Element `json:"_sourceId,omitempty"`
 
 	// The ID of the Profile to validate against
-	ValidateProfileID *string `json:"validateProfileId,omitempty"`
+	ValidateProfileID        *string         `json:"validateProfileId,omitempty"`
+	ValidateProfileIDElement *common.Element `json:"_validateProfileId,omitempty"`
 
 	// The value to compare to
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 
 	// Will this assert produce a warning only on error
-	WarningOnly *bool `json:"warningOnly,omitempty"`
+	WarningOnly        *bool           `json:"warningOnly,omitempty"`
+	WarningOnlyElement *common.Element `json:"_warningOnly,omitempty"`
 }
 
 // TestScriptSetupActionAssertDirection represents the direction to use for the assertion
@@ -326,7 +388,8 @@ type TestScriptSetupActionAssertRule struct {
 	common.BackboneElement
 
 	// The TestScript.rule id value this assert will evaluate
-	RuleID string `json:"ruleId"`
+	RuleID        string          `json:"ruleId"`
+	RuleIDElement *common.Element `json:"_ruleId,omitempty"`
 
 	// The referenced rule within the external ruleset template
 	Param []TestScriptSetupActionAssertRuleParam `json:"param,omitempty"`
@@ -337,10 +400,12 @@ type TestScriptSetupActionAssertRuleParam struct {
 	common.BackboneElement
 
 	// Parameter name matching external assert rule parameter
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Parameter value defined either explicitly or dynamically
-	Value *string `json:"value,omitempty"`
+	Value        *string         `json:"value,omitempty"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // TestScriptSetupActionAssertRuleset represents the TestScript.ruleset this assert will evaluate
@@ -348,7 +413,8 @@ type TestScriptSetupActionAssertRuleset struct {
 	common.BackboneElement
 
 	// The TestScript.ruleset id value this assert will evaluate
-	RulesetID string `json:"rulesetId"`
+	RulesetID        string          `json:"rulesetId"`
+	RulesetIDElement *common.Element `json:"_rulesetId,omitempty"`
 
 	// The referenced rule within the external ruleset template
 	Rule []TestScriptSetupActionAssertRulesetRule `json:"rule,omitempty"`
@@ -359,7 +425,8 @@ type TestScriptSetupActionAssertRulesetRule struct {
 	common.BackboneElement
 
 	// Id of the referenced rule within the external ruleset template
-	RuleID string `json:"ruleId"`
+	RuleID        string          `json:"ruleId"`
+	RuleIDElement *common.Element `json:"_ruleId,omitempty"`
 
 	// The referenced rule within the external ruleset template
 	Param []TestScriptSetupActionAssertRuleParam `json:"param,omitempty"`
@@ -400,10 +467,12 @@ type TestScriptTest struct {
 	common.BackboneElement
 
 	// The name of this test used for tracking/logging purposes by test engines
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// A short description of the test used by test engines for tracking and reporting purposes
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// Action would contain either an operation or an assertion
 	Action []TestScriptTestAction `json:"action"`
@@ -446,22 +515,27 @@ type TestScript struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// A copyright statement relating to the test script and/or its contents
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// The date is often not tracked until the resource is published, but may be present on draft content
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// This description can be used to capture details such as comments about misuse, instructions for clinical use and interpretation, literature references, examples from the paper world, etc
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The purpose of this element is to define the profile of a destination element used elsewhere in the script
 	Destination []TestScriptDestination `json:"destination,omitempty"`
 
 	// Allows filtering of test scripts that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Fixture in the test script - by reference (uri)
 	Fixture []TestScriptFixture `json:"fixture,omitempty"`
@@ -476,19 +550,23 @@ type TestScript struct {
 	Metadata *TestScriptMetadata `json:"metadata,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The purpose of this element is to define the profile of an origin element used elsewhere in the script
 	Origin []TestScriptOrigin `json:"origin,omitempty"`
 
 	// See the Resource List for complete list of resource types
-	Profile []string `json:"profile,omitempty"`
+	Profile        []string          `json:"profile,omitempty"`
+	ProfileElement []*common.Element `json:"_profile,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the test script
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// The scope indicates a conformance artifact that is tested by the test(s) within this test case
 	Scope []TestScriptScope `json:"scope,omitempty"`
@@ -497,7 +575,8 @@ type TestScript struct {
 	Setup *TestScriptSetup `json:"setup,omitempty"`
 
 	// Allows filtering of test scripts that are appropriate for use versus not
-	Status TestScriptStatus `json:"status"`
+	Status        TestScriptStatus `json:"status"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 
 	// A series of operations required to clean up after all the tests are executed
 	Teardown *TestScriptTeardown `json:"teardown,omitempty"`
@@ -506,10 +585,12 @@ type TestScript struct {
 	Test []TestScriptTest `json:"test,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
@@ -518,10 +599,12 @@ type TestScript struct {
 	Variable []TestScriptVariable `json:"variable,omitempty"`
 
 	// There may be different test script instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
 	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
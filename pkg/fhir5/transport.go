@@ -15,7 +15,8 @@ type TransportRestriction struct {
 	Recipient []common.Reference `json:"recipient,omitempty"`
 
 	// Indicates the number of times the requested action should occur
-	Repetitions *int `json:"repetitions,omitempty"`
+	Repetitions        *int            `json:"repetitions,omitempty"`
+	RepetitionsElement *common.Element `json:"_repetitions,omitempty"`
 }
 
 // TransportInput represents additional information that may be needed in the execution of the transport
@@ -26,64 +27,84 @@ type TransportInput struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The value of the input parameter as a basic type
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueCanonical *string `json:"valueCanonical,omitempty"`
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueCode *string `json:"valueCode,omitempty"`
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueID *string `json:"valueId,omitempty"`
+	ValueID        *string         `json:"valueId,omitempty"`
+	ValueIDElement *common.Element `json:"_valueId,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueInstant *string `json:"valueInstant,omitempty"`
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueInteger64 *int64 `json:"valueInteger64,omitempty"`
+	ValueInteger64        *int64          `json:"valueInteger64,omitempty"`
+	ValueInteger64Element *common.Element `json:"_valueInteger64,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueMarkdown *string `json:"valueMarkdown,omitempty"`
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueOid *string `json:"valueOid,omitempty"`
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty"`
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUnsignedInt *int `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUrl *string `json:"valueUrl,omitempty"`
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
 
 	// The value of the input parameter as a basic type
-	ValueUuid *string `json:"valueUuid,omitempty"`
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
 
 	// The value of the input parameter as a basic type
 	ValueAddress *common.Address `json:"valueAddress,omitempty"`
@@ -190,64 +211,84 @@ type TransportOutput struct {
 	Type common.CodeableConcept `json:"type"`
 
 	// The value of the output parameter as a basic type
-	ValueBase64Binary *string `json:"valueBase64Binary,omitempty"`
+	ValueBase64Binary        *string         `json:"valueBase64Binary,omitempty"`
+	ValueBase64BinaryElement *common.Element `json:"_valueBase64Binary,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueBoolean *bool `json:"valueBoolean,omitempty"`
+	ValueBoolean        *bool           `json:"valueBoolean,omitempty"`
+	ValueBooleanElement *common.Element `json:"_valueBoolean,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueCanonical *string `json:"valueCanonical,omitempty"`
+	ValueCanonical        *string         `json:"valueCanonical,omitempty"`
+	ValueCanonicalElement *common.Element `json:"_valueCanonical,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueCode *string `json:"valueCode,omitempty"`
+	ValueCode        *string         `json:"valueCode,omitempty"`
+	ValueCodeElement *common.Element `json:"_valueCode,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueDate *string `json:"valueDate,omitempty"`
+	ValueDate        *common.Date    `json:"valueDate,omitempty"`
+	ValueDateElement *common.Element `json:"_valueDate,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueDateTime *string `json:"valueDateTime,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueDecimal *float64 `json:"valueDecimal,omitempty"`
+	ValueDecimal        *common.Decimal `json:"valueDecimal,omitempty"`
+	ValueDecimalElement *common.Element `json:"_valueDecimal,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueID *string `json:"valueId,omitempty"`
+	ValueID        *string         `json:"valueId,omitempty"`
+	ValueIDElement *common.Element `json:"_valueId,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueInstant *string `json:"valueInstant,omitempty"`
+	ValueInstant        *common.Instant `json:"valueInstant,omitempty"`
+	ValueInstantElement *common.Element `json:"_valueInstant,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueInteger *int `json:"valueInteger,omitempty"`
+	ValueInteger        *int            `json:"valueInteger,omitempty"`
+	ValueIntegerElement *common.Element `json:"_valueInteger,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueInteger64 *int64 `json:"valueInteger64,omitempty"`
+	ValueInteger64        *int64          `json:"valueInteger64,omitempty"`
+	ValueInteger64Element *common.Element `json:"_valueInteger64,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueMarkdown *string `json:"valueMarkdown,omitempty"`
+	ValueMarkdown        *string         `json:"valueMarkdown,omitempty"`
+	ValueMarkdownElement *common.Element `json:"_valueMarkdown,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueOid *string `json:"valueOid,omitempty"`
+	ValueOid        *string         `json:"valueOid,omitempty"`
+	ValueOidElement *common.Element `json:"_valueOid,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValuePositiveInt *int `json:"valuePositiveInt,omitempty"`
+	ValuePositiveInt        *int            `json:"valuePositiveInt,omitempty"`
+	ValuePositiveIntElement *common.Element `json:"_valuePositiveInt,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueString *string `json:"valueString,omitempty"`
+	ValueString        *string         `json:"valueString,omitempty"`
+	ValueStringElement *common.Element `json:"_valueString,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueTime *string `json:"valueTime,omitempty"`
+	ValueTime        *common.Time    `json:"valueTime,omitempty"`
+	ValueTimeElement *common.Element `json:"_valueTime,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueUnsignedInt *int `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedInt        *int            `json:"valueUnsignedInt,omitempty"`
+	ValueUnsignedIntElement *common.Element `json:"_valueUnsignedInt,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueUri *string `json:"valueUri,omitempty"`
+	ValueUri        *string         `json:"valueUri,omitempty"`
+	ValueUriElement *common.Element `json:"_valueUri,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueUrl *string `json:"valueUrl,omitempty"`
+	ValueUrl        *string         `json:"valueUrl,omitempty"`
+	ValueUrlElement *common.Element `json:"_valueUrl,omitempty"`
 
 	// The value of the output parameter as a basic type
-	ValueUuid *string `json:"valueUuid,omitempty"`
+	ValueUuid        *string         `json:"valueUuid,omitempty"`
+	ValueUuidElement *common.Element `json:"_valueUuid,omitempty"`
 
 	// The value of the output parameter as a basic type
 	ValueAddress *common.Address `json:"valueAddress,omitempty"`
@@ -391,7 +432,8 @@ type Transport struct {
 	ResourceType string `json:"resourceType"` // Always "Transport"
 
 	// The date and time this transport was created
-	AuthoredOn *string `json:"authoredOn,omitempty"`
+	AuthoredOn        *common.DateTime `json:"authoredOn,omitempty"`
+	AuthoredOnElement *common.Element  `json:"_authoredOn,omitempty"`
 
 	// BasedOn refers to a higher-level authorization that triggered the creation of the transport
 	BasedOn []common.Reference `json:"basedOn,omitempty"`
@@ -400,13 +442,15 @@ type Transport struct {
 	Code *common.CodeableConcept `json:"code,omitempty"`
 
 	// Identifies the completion time of the event
-	CompletionTime *string `json:"completionTime,omitempty"`
+	CompletionTime        *common.DateTime `json:"completionTime,omitempty"`
+	CompletionTimeElement *common.Element  `json:"_completionTime,omitempty"`
 
 	// The current location for the entity to be transported
 	CurrentLocation common.Reference `json:"currentLocation"`
 
 	// A free-text description of what is to be performed
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// The healthcare event during which this transport was created
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -430,19 +474,23 @@ type Transport struct {
 	Input []TransportInput `json:"input,omitempty"`
 
 	// The URL pointing to a FHIR-defined protocol, guideline, orderset or other definition
-	InstantiatesCanonical *string `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonical        *string         `json:"instantiatesCanonical,omitempty"`
+	InstantiatesCanonicalElement *common.Element `json:"_instantiatesCanonical,omitempty"`
 
 	// The URL pointing to an externally maintained protocol, guideline, orderset or other definition
-	InstantiatesUri *string `json:"instantiatesUri,omitempty"`
+	InstantiatesUri        *string         `json:"instantiatesUri,omitempty"`
+	InstantiatesUriElement *common.Element `json:"_instantiatesUri,omitempty"`
 
 	// Insurance plans, coverage extensions, pre-authorizations and/or pre-determinations that may be relevant to the Transport
 	Insurance []common.Reference `json:"insurance,omitempty"`
 
 	// This element is immutable
-	Intent TransportIntent `json:"intent"`
+	Intent        TransportIntent `json:"intent"`
+	IntentElement *common.Element `json:"_intent,omitempty"`
 
 	// The date and time of last modification to this transport
-	LastModified *string `json:"lastModified,omitempty"`
+	LastModified        *common.DateTime `json:"lastModified,omitempty"`
+	LastModifiedElement *common.Element  `json:"_lastModified,omitempty"`
 
 	// Principal physical location where this transport is performed
 	Location *common.Reference `json:"location,omitempty"`
@@ -463,7 +511,8 @@ type Transport struct {
 	PerformerType []common.CodeableConcept `json:"performerType,omitempty"`
 
 	// Indicates how quickly the Transport should be addressed with respect to other requests
-	Priority *TransportPriority `json:"priority,omitempty"`
+	Priority        *TransportPriority `json:"priority,omitempty"`
+	PriorityElement *common.Element    `json:"_priority,omitempty"`
 
 	// Transports might be justified based on an Observation, a Condition, a past or planned procedure, etc
 	Reason *common.CodeableReference `json:"reason,omitempty"`
@@ -481,7 +530,8 @@ type Transport struct {
 	Restriction *TransportRestriction `json:"restriction,omitempty"`
 
 	// A code specifying the state of the transport event
-	Status *TransportStatus `json:"status,omitempty"`
+	Status        *TransportStatus `json:"status,omitempty"`
+	StatusElement *common.Element  `json:"_status,omitempty"`
 
 	// This applies to the current status
 	StatusReason *common.CodeableConcept `json:"statusReason,omitempty"`
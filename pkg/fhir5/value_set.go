@@ -12,13 +12,15 @@ type ValueSetComposeIncludeConceptDesignation struct {
 	AdditionalUse []common.Coding `json:"additionalUse,omitempty"`
 
 	// In the absence of a language, the resource language applies
-	Language *string `json:"language,omitempty"`
+	Language        *string         `json:"language,omitempty"`
+	LanguageElement *common.Element `json:"_language,omitempty"`
 
 	// If no use is provided, the designation can be assumed to be suitable for general display to a human user
 	Use *common.Coding `json:"use,omitempty"`
 
 	// The text value for this designation
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ValueSetComposeIncludeConcept represents the list of concepts is considered ordered
@@ -26,13 +28,15 @@ type ValueSetComposeIncludeConcept struct {
 	common.BackboneElement
 
 	// Expressions are allowed if defined by the underlying code system
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// Concepts have both a display and an array of designation
 	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
 
 	// The value set resource allows for an alternative display to be specified for when this concept is used in this particular value set
-	Display *string `json:"display,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
 }
 
 // ValueSetComposeIncludeFilter represents selecting codes by specifying filters based on properties
@@ -40,13 +44,16 @@ type ValueSetComposeIncludeFilter struct {
 	common.BackboneElement
 
 	// In case filter.property represents a property of the system, the operation applies to the selected property
-	Op ValueSetComposeIncludeFilterOp `json:"op"`
+	Op        ValueSetComposeIncludeFilterOp `json:"op"`
+	OpElement *common.Element                `json:"_op,omitempty"`
 
 	// A code that identifies a property or a filter defined in the code system
-	Property string `json:"property"`
+	Property        string          `json:"property"`
+	PropertyElement *common.Element `json:"_property,omitempty"`
 
 	// The match value may be either a code defined by the system, or a string value, which is a regex match on the literal string of the property value
-	Value string `json:"value"`
+	Value        string          `json:"value"`
+	ValueElement *common.Element `json:"_value,omitempty"`
 }
 
 // ValueSetComposeIncludeFilterOp represents the operation to perform
@@ -77,13 +84,16 @@ type ValueSetComposeInclude struct {
 	Filter []ValueSetComposeIncludeFilter `json:"filter,omitempty"`
 
 	// The version of the code system that the codes are selected from
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// The system value is required if the concept is not defined by the value set
-	System *string `json:"system,omitempty"`
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
 
 	// The value set resource allows for an alternative display to be specified for when this concept is used in this particular value set
-	ValueSet []string `json:"valueSet,omitempty"`
+	ValueSet        []string          `json:"valueSet,omitempty"`
+	ValueSetElement []*common.Element `json:"_valueSet,omitempty"`
 }
 
 // ValueSetCompose represents a set of criteria that define the contents of the value set by including or excluding codes
@@ -91,10 +101,12 @@ type ValueSetCompose struct {
 	common.BackboneElement
 
 	// The Locked Date is the effective date that is used to determine the version of all referenced Code Systems and Value Set Definitions included in the compose that are not already tied to a specific version
-	LockedDate *string `json:"lockedDate,omitempty"`
+	LockedDate        *common.Date    `json:"lockedDate,omitempty"`
+	LockedDateElement *common.Element `json:"_lockedDate,omitempty"`
 
 	// Whether inactive codes - codes that are not approved for current use - are in the value set
-	Inactive *bool `json:"inactive,omitempty"`
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
 
 	// Include one or more codes from a code system or other value set(s)
 	Include []ValueSetComposeInclude `json:"include"`
@@ -103,7 +115,8 @@ type ValueSetCompose struct {
 	Exclude []ValueSetComposeInclude `json:"exclude,omitempty"`
 
 	// Property to filter on
-	Property []string `json:"property,omitempty"`
+	Property        []string          `json:"property,omitempty"`
+	PropertyElement []*common.Element `json:"_property,omitempty"`
 }
 
 // ValueSetExpansionParameter represents expansion parameter
@@ -111,16 +124,24 @@ type ValueSetExpansionParameter struct {
 	common.BackboneElement
 
 	// The name of the parameter
-	Name string `json:"name"`
+	Name        string          `json:"name"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// The value of the parameter
-	ValueString   *string  `json:"valueString,omitempty"`
-	ValueBoolean  *bool    `json:"valueBoolean,omitempty"`
-	ValueInteger  *int     `json:"valueInteger,omitempty"`
-	ValueDecimal  *float64 `json:"valueDecimal,omitempty"`
-	ValueUri      *string  `json:"valueUri,omitempty"`
-	ValueCode     *string  `json:"valueCode,omitempty"`
-	ValueDateTime *string  `json:"valueDateTime,omitempty"`
+	ValueString          *string          `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element  `json:"_valueString,omitempty"`
+	ValueBoolean         *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueInteger         *int             `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element  `json:"_valueInteger,omitempty"`
+	ValueDecimal         *common.Decimal  `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element  `json:"_valueDecimal,omitempty"`
+	ValueUri             *string          `json:"valueUri,omitempty"`
+	ValueUriElement      *common.Element  `json:"_valueUri,omitempty"`
+	ValueCode            *string          `json:"valueCode,omitempty"`
+	ValueCodeElement     *common.Element  `json:"_valueCode,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
 }
 
 // ValueSetExpansionContains represents codes in the value set
@@ -128,22 +149,28 @@ type ValueSetExpansionContains struct {
 	common.BackboneElement
 
 	// An absolute URI that is used to identify this code in this value set
-	System *string `json:"system,omitempty"`
+	System        *string         `json:"system,omitempty"`
+	SystemElement *common.Element `json:"_system,omitempty"`
 
 	// If true, this entry is included in the expansion for navigational purposes, and the user cannot select the code directly as a proper value
-	Abstract *bool `json:"abstract,omitempty"`
+	Abstract        *bool           `json:"abstract,omitempty"`
+	AbstractElement *common.Element `json:"_abstract,omitempty"`
 
 	// If the concept is inactive in the code system that defines it
-	Inactive *bool `json:"inactive,omitempty"`
+	Inactive        *bool           `json:"inactive,omitempty"`
+	InactiveElement *common.Element `json:"_inactive,omitempty"`
 
 	// The version of the code system from this code was taken
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// The code for this item in the expansion hierarchy
-	Code *string `json:"code,omitempty"`
+	Code        *string         `json:"code,omitempty"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// The recommended display for this item in the expansion
-	Display *string `json:"display,omitempty"`
+	Display        *string         `json:"display,omitempty"`
+	DisplayElement *common.Element `json:"_display,omitempty"`
 
 	// Additional representations for this item
 	Designation []ValueSetComposeIncludeConceptDesignation `json:"designation,omitempty"`
@@ -160,16 +187,23 @@ type ValueSetExpansionContainsProperty struct {
 	common.BackboneElement
 
 	// A code that is a reference to ValueSet.expansion.property.code
-	Code string `json:"code"`
+	Code        string          `json:"code"`
+	CodeElement *common.Element `json:"_code,omitempty"`
 
 	// The value of this property
-	ValueCode     *string        `json:"valueCode,omitempty"`
-	ValueCoding   *common.Coding `json:"valueCoding,omitempty"`
-	ValueString   *string        `json:"valueString,omitempty"`
-	ValueInteger  *int           `json:"valueInteger,omitempty"`
-	ValueBoolean  *bool          `json:"valueBoolean,omitempty"`
-	ValueDateTime *string        `json:"valueDateTime,omitempty"`
-	ValueDecimal  *float64       `json:"valueDecimal,omitempty"`
+	ValueCode            *string          `json:"valueCode,omitempty"`
+	ValueCodeElement     *common.Element  `json:"_valueCode,omitempty"`
+	ValueCoding          *common.Coding   `json:"valueCoding,omitempty"`
+	ValueString          *string          `json:"valueString,omitempty"`
+	ValueStringElement   *common.Element  `json:"_valueString,omitempty"`
+	ValueInteger         *int             `json:"valueInteger,omitempty"`
+	ValueIntegerElement  *common.Element  `json:"_valueInteger,omitempty"`
+	ValueBoolean         *bool            `json:"valueBoolean,omitempty"`
+	ValueBooleanElement  *common.Element  `json:"_valueBoolean,omitempty"`
+	ValueDateTime        *common.DateTime `json:"valueDateTime,omitempty"`
+	ValueDateTimeElement *common.Element  `json:"_valueDateTime,omitempty"`
+	ValueDecimal         *common.Decimal  `json:"valueDecimal,omitempty"`
+	ValueDecimalElement  *common.Element  `json:"_valueDecimal,omitempty"`
 }
 
 // ValueSetExpansion represents expansion is performed to produce a collection of codes
@@ -177,16 +211,20 @@ type ValueSetExpansion struct {
 	common.BackboneElement
 
 	// An identifier that uniquely identifies this expansion of the valueset
-	Identifier *string `json:"identifier,omitempty"`
+	Identifier        *string         `json:"identifier,omitempty"`
+	IdentifierElement *common.Element `json:"_identifier,omitempty"`
 
 	// The time at which the expansion was produced by the expanding system
-	Timestamp string `json:"timestamp"`
+	Timestamp        common.DateTime `json:"timestamp"`
+	TimestampElement *common.Element `json:"_timestamp,omitempty"`
 
 	// The total number of concepts in the expansion
-	Total *int `json:"total,omitempty"`
+	Total        *int            `json:"total,omitempty"`
+	TotalElement *common.Element `json:"_total,omitempty"`
 
 	// If paging is being used, the offset at which this resource starts
-	Offset *int `json:"offset,omitempty"`
+	Offset        *int            `json:"offset,omitempty"`
+	OffsetElement *common.Element `json:"_offset,omitempty"`
 
 	// A parameter that controlled the expansion process
 	Parameter []ValueSetExpansionParameter `json:"parameter,omitempty"`
@@ -200,10 +238,12 @@ type ValueSetScope struct {
 	common.BackboneElement
 
 	// Criteria for inclusion of concepts in the value set expansion
-	InclusionCriteria *string `json:"inclusionCriteria,omitempty"`
+	InclusionCriteria        *string         `json:"inclusionCriteria,omitempty"`
+	InclusionCriteriaElement *common.Element `json:"_inclusionCriteria,omitempty"`
 
 	// Criteria for exclusion of concepts from the value set expansion
-	ExclusionCriteria *string `json:"exclusionCriteria,omitempty"`
+	ExclusionCriteria        *string         `json:"exclusionCriteria,omitempty"`
+	ExclusionCriteriaElement *common.Element `json:"_exclusionCriteria,omitempty"`
 }
 
 // ValueSetStatus represents the status of a value set
@@ -224,7 +264,8 @@ type ValueSet struct {
 	ResourceType string `json:"resourceType"` // Always "ValueSet"
 
 	// The 'date' element may be more recent than the approval date because of minor changes or editorial corrections
-	ApprovalDate *string `json:"approvalDate,omitempty"`
+	ApprovalDate        *common.Date    `json:"approvalDate,omitempty"`
+	ApprovalDateElement *common.Element `json:"_approvalDate,omitempty"`
 
 	// An individiual or organization primarily involved in the creation and maintenance of the ValueSet
 	Author []common.ContactDetail `json:"author,omitempty"`
@@ -236,16 +277,20 @@ type ValueSet struct {
 	Contact []common.ContactDetail `json:"contact,omitempty"`
 
 	// Frequently, the copyright differs between the value set and the codes that are included
-	Copyright *string `json:"copyright,omitempty"`
+	Copyright        *string         `json:"copyright,omitempty"`
+	CopyrightElement *common.Element `json:"_copyright,omitempty"`
 
 	// The (c) symbol should NOT be included in this string
-	CopyrightLabel *string `json:"copyrightLabel,omitempty"`
+	CopyrightLabel        *string         `json:"copyrightLabel,omitempty"`
+	CopyrightLabelElement *common.Element `json:"_copyrightLabel,omitempty"`
 
 	// Note that this is not the same as the meta.lastUpdated which is specific to an instance of a value set resource on a server
-	Date *string `json:"date,omitempty"`
+	Date        *common.DateTime `json:"date,omitempty"`
+	DateElement *common.Element  `json:"_date,omitempty"`
 
 	// Description SHOULD contain instructions for clinical or administrative use and interpretation and information about misuse
-	Description *string `json:"description,omitempty"`
+	Description        *string         `json:"description,omitempty"`
+	DescriptionElement *common.Element `json:"_description,omitempty"`
 
 	// An individual or organization primarily responsible for internal coherence of the ValueSet
 	Editor []common.ContactDetail `json:"editor,omitempty"`
@@ -260,28 +305,34 @@ type ValueSet struct {
 	Expansion *ValueSetExpansion `json:"expansion,omitempty"`
 
 	// Allows filtering of value sets that are appropriate for use versus not
-	Experimental *bool `json:"experimental,omitempty"`
+	Experimental        *bool           `json:"experimental,omitempty"`
+	ExperimentalElement *common.Element `json:"_experimental,omitempty"`
 
 	// Typically, this is used for identifiers that can go in an HL7 V3 II (instance identifier) data type, and can then identify this value set outside of FHIR, where it is not possible to use the logical URI
 	Identifier []common.Identifier `json:"identifier,omitempty"`
 
 	// Normally immutability is set to 'false', which is the default assumption if it is not populated
-	Immutable *bool `json:"immutable,omitempty"`
+	Immutable        *bool           `json:"immutable,omitempty"`
+	ImmutableElement *common.Element `json:"_immutable,omitempty"`
 
 	// It may be possible for the value set to be used in jurisdictions other than those for which it was originally designed or intended
 	Jurisdiction []common.CodeableConcept `json:"jurisdiction,omitempty"`
 
 	// If specified, this date follows the original approval date
-	LastReviewDate *string `json:"lastReviewDate,omitempty"`
+	LastReviewDate        *common.Date    `json:"lastReviewDate,omitempty"`
+	LastReviewDateElement *common.Element `json:"_lastReviewDate,omitempty"`
 
 	// The name is not expected to be globally unique
-	Name *string `json:"name,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	NameElement *common.Element `json:"_name,omitempty"`
 
 	// Usually an organization but may be an individual
-	Publisher *string `json:"publisher,omitempty"`
+	Publisher        *string         `json:"publisher,omitempty"`
+	PublisherElement *common.Element `json:"_publisher,omitempty"`
 
 	// This element does not describe the usage of the value set
-	Purpose *string `json:"purpose,omitempty"`
+	Purpose        *string         `json:"purpose,omitempty"`
+	PurposeElement *common.Element `json:"_purpose,omitempty"`
 
 	// Each related artifact is either an attachment, or a reference to another resource, but not both
 	RelatedArtifact []common.RelatedArtifact `json:"relatedArtifact,omitempty"`
@@ -293,25 +344,30 @@ type ValueSet struct {
 	Scope *ValueSetScope `json:"scope,omitempty"`
 
 	// Allows filtering of value sets that are appropriate for use versus not
-	Status ValueSetStatus `json:"status"`
+	Status        ValueSetStatus  `json:"status"`
+	StatusElement *common.Element `json:"_status,omitempty"`
 
 	// This name does not need to be machine-processing friendly and may contain punctuation, white-space, etc
-	Title *string `json:"title,omitempty"`
+	Title        *string         `json:"title,omitempty"`
+	TitleElement *common.Element `json:"_title,omitempty"`
 
 	// DEPRECATION NOTE: For consistency, implementations are encouraged to migrate to using the new 'topic' code in the useContext element
 	Topic []common.CodeableConcept `json:"topic,omitempty"`
 
 	// Can be a urn:uuid: or a urn:oid: but real http: addresses are preferred
-	Url *string `json:"url,omitempty"`
+	Url        *string         `json:"url,omitempty"`
+	UrlElement *common.Element `json:"_url,omitempty"`
 
 	// When multiple useContexts are specified, there is no expectation that all or any of the contexts apply
 	UseContext []common.UsageContext `json:"useContext,omitempty"`
 
 	// There may be different value set instances that have the same identifier but different versions
-	Version *string `json:"version,omitempty"`
+	Version        *string         `json:"version,omitempty"`
+	VersionElement *common.Element `json:"_version,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
-	VersionAlgorithmString *string `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmString        *string         `json:"versionAlgorithmString,omitempty"`
+	VersionAlgorithmStringElement *common.Element `json:"_versionAlgorithmString,omitempty"`
 
 	// If set as a string, this is a FHIRPath expression that has two additional context variables passed in - %version1 and %version2 and will return a negative number if version1 is newer, a positive number if version2 and a 0 if the version ordering can't be successfully be determined
 	VersionAlgorithmCoding *common.Coding `json:"versionAlgorithmCoding,omitempty"`
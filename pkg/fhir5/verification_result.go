@@ -21,7 +21,8 @@ type VerificationResultPrimarySource struct {
 	Type []common.CodeableConcept `json:"type,omitempty"`
 
 	// When the target was validated against the primary source
-	ValidationDate *string `json:"validationDate,omitempty"`
+	ValidationDate        *common.DateTime `json:"validationDate,omitempty"`
+	ValidationDateElement *common.Element  `json:"_validationDate,omitempty"`
 
 	// Status of the validation of the target against the primary source (successful; failed; unknown)
 	ValidationStatus *common.CodeableConcept `json:"validationStatus,omitempty"`
@@ -38,19 +39,22 @@ type VerificationResultAttestation struct {
 	CommunicationMethod *common.CodeableConcept `json:"communicationMethod,omitempty"`
 
 	// The date the information was attested to
-	Date *string `json:"date,omitempty"`
+	Date        *common.Date    `json:"date,omitempty"`
+	DateElement *common.Element `json:"_date,omitempty"`
 
 	// A digital identity certificate associated with the attestation source
 	OnBehalfOf *common.Reference `json:"onBehalfOf,omitempty"`
 
 	// The procedure or code system that was used to perform the attestation
-	ProxyIdentityCertificate *string `json:"proxyIdentityCertificate,omitempty"`
+	ProxyIdentityCertificate        *string         `json:"proxyIdentityCertificate,omitempty"`
+	ProxyIdentityCertificateElement *common.Element `json:"_proxyIdentityCertificate,omitempty"`
 
 	// Signed assertion by the proxy entity indicating that they have the right to submit attested information on behalf of the attestation source
 	ProxySignature *common.Signature `json:"proxySignature,omitempty"`
 
 	// A digital identity certificate associated with the proxy entity submitting attested information on behalf of the attestation source
-	SourceIdentityCertificate *string `json:"sourceIdentityCertificate,omitempty"`
+	SourceIdentityCertificate        *string         `json:"sourceIdentityCertificate,omitempty"`
+	SourceIdentityCertificateElement *common.Element `json:"_sourceIdentityCertificate,omitempty"`
 
 	// Signed assertion by the attestation source that they have the right to submit attested information
 	SourceSignature *common.Signature `json:"sourceSignature,omitempty"`
@@ -100,28 +104,33 @@ type VerificationResult struct {
 	Frequency *common.Timing `json:"frequency,omitempty"`
 
 	// The date/time validation was last completed (including failed validations)
-	LastPerformed *string `json:"lastPerformed,omitempty"`
+	LastPerformed        *common.DateTime `json:"lastPerformed,omitempty"`
+	LastPerformedElement *common.Element  `json:"_lastPerformed,omitempty"`
 
 	// The frequency with which the target must be validated (none; initial; periodic)
 	Need *common.CodeableConcept `json:"need,omitempty"`
 
 	// The date when target is next validated, if appropriate
-	NextScheduled *string `json:"nextScheduled,omitempty"`
+	NextScheduled        *common.Date    `json:"nextScheduled,omitempty"`
+	NextScheduledElement *common.Element `json:"_nextScheduled,omitempty"`
 
 	// Information about the primary source(s) involved in validation
 	PrimarySource []VerificationResultPrimarySource `json:"primarySource,omitempty"`
 
 	// The validation status of the target (attested; validated; in process; requires revalidation; validation failed; revalidation failed)
-	Status VerificationResultStatus `json:"status"`
+	Status        VerificationResultStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 
 	// When the validation status was updated
-	StatusDate *string `json:"statusDate,omitempty"`
+	StatusDate        *common.DateTime `json:"statusDate,omitempty"`
+	StatusDateElement *common.Element  `json:"_statusDate,omitempty"`
 
 	// A resource that was validated
 	Target []common.Reference `json:"target,omitempty"`
 
 	// The fhirpath location(s) within the resource that was validated
-	TargetLocation []string `json:"targetLocation,omitempty"`
+	TargetLocation        []string          `json:"targetLocation,omitempty"`
+	TargetLocationElement []*common.Element `json:"_targetLocation,omitempty"`
 
 	// The primary process by which the target is validated (edit check; value set; primary source; multiple sources; standalone; in context)
 	ValidationProcess []common.CodeableConcept `json:"validationProcess,omitempty"`
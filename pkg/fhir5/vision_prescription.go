@@ -9,10 +9,12 @@ type VisionPrescriptionLensSpecificationPrism struct {
 	common.BackboneElement
 
 	// Amount of prism to compensate for eye alignment in fractional units
-	Amount float64 `json:"amount"`
+	Amount        common.Decimal  `json:"amount"`
+	AmountElement *common.Element `json:"_amount,omitempty"`
 
 	// The relative base, or reference lens edge, for the prism
-	Base VisionPrescriptionLensSpecificationPrismBase `json:"base"`
+	Base        VisionPrescriptionLensSpecificationPrismBase `json:"base"`
+	BaseElement *common.Element                              `json:"_base,omitempty"`
 }
 
 // VisionPrescriptionLensSpecificationPrismBase represents the relative base for the prism
@@ -30,37 +32,46 @@ type VisionPrescriptionLensSpecification struct {
 	common.BackboneElement
 
 	// Power adjustment for multifocal lenses measured in dioptres (0.25 units)
-	Add *float64 `json:"add,omitempty"`
+	Add        *common.Decimal `json:"add,omitempty"`
+	AddElement *common.Element `json:"_add,omitempty"`
 
 	// The limits are +180 and -180 degrees
-	Axis *int `json:"axis,omitempty"`
+	Axis        *int            `json:"axis,omitempty"`
+	AxisElement *common.Element `json:"_axis,omitempty"`
 
 	// Back curvature measured in millimetres
-	BackCurve *float64 `json:"backCurve,omitempty"`
+	BackCurve        *common.Decimal `json:"backCurve,omitempty"`
+	BackCurveElement *common.Element `json:"_backCurve,omitempty"`
 
 	// Brand recommendations or restrictions
-	Brand *string `json:"brand,omitempty"`
+	Brand        *string         `json:"brand,omitempty"`
+	BrandElement *common.Element `json:"_brand,omitempty"`
 
 	// Special color or pattern
-	Color *string `json:"color,omitempty"`
+	Color        *string         `json:"color,omitempty"`
+	ColorElement *common.Element `json:"_color,omitempty"`
 
 	// Power adjustment for astigmatism measured in dioptres (0.25 units)
-	Cylinder *float64 `json:"cylinder,omitempty"`
+	Cylinder        *common.Decimal `json:"cylinder,omitempty"`
+	CylinderElement *common.Element `json:"_cylinder,omitempty"`
 
 	// Contact lens diameter measured in millimetres
-	Diameter *float64 `json:"diameter,omitempty"`
+	Diameter        *common.Decimal `json:"diameter,omitempty"`
+	DiameterElement *common.Element `json:"_diameter,omitempty"`
 
 	// The recommended maximum wear period for the lens
 	Duration *common.Quantity `json:"duration,omitempty"`
 
 	// May also appear on the paper claim form or in the Medical Records as as OD (oculus dexter) for the right eye and OS (oculus sinister) for the left eye
-	Eye VisionPrescriptionLensSpecificationEye `json:"eye"`
+	Eye        VisionPrescriptionLensSpecificationEye `json:"eye"`
+	EyeElement *common.Element                        `json:"_eye,omitempty"`
 
 	// Notes for special requirements such as coatings and lens materials
 	Note []common.Annotation `json:"note,omitempty"`
 
 	// Contact lens power measured in dioptres (0.25 units)
-	Power *float64 `json:"power,omitempty"`
+	Power        *common.Decimal `json:"power,omitempty"`
+	PowerElement *common.Element `json:"_power,omitempty"`
 
 	// Allows for adjustment on two axis
 	Prism []VisionPrescriptionLensSpecificationPrism `json:"prism,omitempty"`
@@ -69,7 +80,8 @@ type VisionPrescriptionLensSpecification struct {
 	Product common.CodeableConcept `json:"product"`
 
 	// The value is negative for near-sighted and positive for far sighted
-	Sphere *float64 `json:"sphere,omitempty"`
+	Sphere        *common.Decimal `json:"sphere,omitempty"`
+	SphereElement *common.Element `json:"_sphere,omitempty"`
 }
 
 // VisionPrescriptionLensSpecificationEye represents the eye for the lens specification
@@ -98,10 +110,12 @@ type VisionPrescription struct {
 	ResourceType string `json:"resourceType"` // Always "VisionPrescription"
 
 	// The date this resource was created
-	Created string `json:"created"`
+	Created        common.DateTime `json:"created"`
+	CreatedElement *common.Element `json:"_created,omitempty"`
 
 	// Jurisdictions determine the valid lifetime of a prescription
-	DateWritten string `json:"dateWritten"`
+	DateWritten        common.DateTime `json:"dateWritten"`
+	DateWrittenElement *common.Element `json:"_dateWritten,omitempty"`
 
 	// A reference to a resource that identifies the particular occurrence of contact between patient and health care provider during which the prescription was issued
 	Encounter *common.Reference `json:"encounter,omitempty"`
@@ -119,5 +133,6 @@ type VisionPrescription struct {
 	Prescriber common.Reference `json:"prescriber"`
 
 	// This element is labeled as a modifier because the status contains codes that mark the resource as not currently valid
-	Status VisionPrescriptionStatus `json:"status"`
+	Status        VisionPrescriptionStatus `json:"status"`
+	StatusElement *common.Element          `json:"_status,omitempty"`
 }
@@ -0,0 +1,249 @@
+package fhir5
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+// resourceElements are the elements whose values are resources
+var resourceElements = map[string]bool{"contained": true, "resource": true, "outcome": true, "issues": true}
+
+// hasUntypedContent reports whether the JSON contains resources that are decoded
+// as *common.RawResource. Only the root and the values of resourceElements are
+// resources, other objects can have a resourceType element of their own, e.g.
+// Subscription.filterBy, and backbone elements share the names, e.g.
+// CapabilityStatement.rest.resource.
+func hasUntypedContent(value interface{}, isResource bool) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if resourceType, ok := v["resourceType"].(string); ok && isResource {
+			if _, known := NewResource(resourceType); !known {
+				return true
+			}
+		}
+		for key, child := range v {
+			if hasUntypedContent(child, resourceElements[key]) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if hasUntypedContent(child, isResource) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestXML_RoundTrip(t *testing.T) {
+	files, err := filepath.Glob("testdata/fhir5-json/*.json")
+	if err != nil {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+
+	definitions := test_utils.LoadElementDefinitions(t, "testdata/fhir5-json")
+	tested := 0
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		var content interface{}
+		if err := json.Unmarshal(data, &content); err != nil {
+			t.Fatalf("failed to parse example file %s: %v", file, err)
+		}
+		if hasUntypedContent(content, true) {
+			t.Logf("skipping %s: contains resources without a struct", file)
+			continue
+		}
+		resource, err := UnmarshalResource(data)
+		if err != nil {
+			// the structs do not model every element of the R5 examples yet
+			t.Logf("skipping %s: %v", file, err)
+			continue
+		}
+		tested++
+
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			expected, err := json.Marshal(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			xmlData, err := MarshalXML(resource)
+			if err != nil {
+				t.Fatalf("failed to marshal XML: %v", err)
+			}
+			decoded, err := UnmarshalResourceXML(xmlData)
+			if err != nil {
+				t.Fatalf("failed to unmarshal XML: %v\n%s", err, xmlData)
+			}
+			actual, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatalf("failed to marshal JSON: %v", err)
+			}
+			test_utils.CompareXMLRoundTrip(t, expected, actual, xmlData)
+			test_utils.CheckXMLElementOrder(t, xmlData, definitions)
+		})
+	}
+	if tested == 0 {
+		t.Fatal("no example files were tested")
+	}
+}
+
+func TestXML_Patient(t *testing.T) {
+	data := []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<Patient xmlns="http://hl7.org/fhir">
+  <id value="example"/>
+  <text>
+    <status value="generated"/>
+    <div xmlns="http://www.w3.org/1999/xhtml"><p>Peter <b>Chalmers</b></p></div>
+  </text>
+  <extension url="http://example.org/fhir/StructureDefinition/nickname">
+    <valueString value="Jim"/>
+  </extension>
+  <identifier>
+    <use value="usual"/>
+    <system value="urn:oid:1.2.36.146.595.217.0.1"/>
+    <value value="12345"/>
+  </identifier>
+  <active value="true"/>
+  <name id="n1">
+    <family value="Chalmers"/>
+    <given value="Peter"/>
+    <given>
+      <extension url="http://hl7.org/fhir/StructureDefinition/iso21090-EN-qualifier">
+        <valueCode value="CL"/>
+      </extension>
+    </given>
+    <given value="James"/>
+  </name>
+  <gender value="male"/>
+  <birthDate value="1974-12-25">
+    <extension url="http://hl7.org/fhir/StructureDefinition/patient-birthTime">
+      <valueDateTime value="1974-12-25T14:35:45-05:00"/>
+    </extension>
+  </birthDate>
+  <contained>
+    <Organization>
+      <id value="org"/>
+      <name value="Good Health Clinic"/>
+    </Organization>
+  </contained>
+</Patient>`)
+
+	var patient Patient
+	if err := UnmarshalXML(data, &patient); err != nil {
+		t.Fatalf("failed to unmarshal patient: %v", err)
+	}
+	if patient.ID == nil || *patient.ID != "example" {
+		t.Errorf("unexpected id %v", patient.ID)
+	}
+	if patient.Text == nil || !strings.HasPrefix(patient.Text.Div, `<div xmlns="http://www.w3.org/1999/xhtml"><p>Peter <b>Chalmers</b>`) {
+		t.Errorf("unexpected narrative %+v", patient.Text)
+	}
+	if len(patient.Extension) != 1 || patient.Extension[0].URL != "http://example.org/fhir/StructureDefinition/nickname" {
+		t.Errorf("unexpected extensions %+v", patient.Extension)
+	}
+	if patient.Active == nil || !*patient.Active {
+		t.Error("expected active patient")
+	}
+	if len(patient.Name) != 1 || patient.Name[0].ID == nil || *patient.Name[0].ID != "n1" {
+		t.Fatalf("unexpected name %+v", patient.Name)
+	}
+	given := patient.Name[0].Given
+	if len(given) != 3 || given[0] != "Peter" || given[1] != "" || given[2] != "James" {
+		t.Errorf("unexpected given names %q", given)
+	}
+	if elements := patient.Name[0].GivenElement; len(elements) != 3 || elements[0] != nil || elements[1] == nil || elements[2] != nil {
+		t.Errorf("expected parallel given elements [nil, element, nil], got %+v", elements)
+	}
+	if patient.BirthDate == nil || patient.BirthDate.String() != "1974-12-25" || patient.BirthDateElement == nil {
+		t.Errorf("unexpected birth date %v / %+v", patient.BirthDate, patient.BirthDateElement)
+	}
+	if len(patient.Contained) != 1 || patient.Contained[0].GetResourceType() != "Organization" {
+		t.Errorf("unexpected contained resources %+v", patient.Contained)
+	}
+
+	serialized, err := MarshalXMLIndent(&patient, "", "  ")
+	if err != nil {
+		t.Fatalf("failed to marshal patient: %v", err)
+	}
+	for _, fragment := range []string{
+		`<Patient xmlns="http://hl7.org/fhir">`,
+		`<div xmlns="http://www.w3.org/1999/xhtml"><p>Peter <b>Chalmers</b></p></div>`,
+		`<extension url="http://example.org/fhir/StructureDefinition/nickname">`,
+		`<name id="n1">`,
+		`<birthDate value="1974-12-25">`,
+	} {
+		if !strings.Contains(string(serialized), fragment) {
+			t.Errorf("expected %s in\n%s", fragment, serialized)
+		}
+	}
+
+	// elements must follow the order of the specification, not of the struct fields
+	order := []string{"<id ", "<text>", "<contained>", "<extension ", "<identifier>", "<active ", "<name id", "<gender ", "<birthDate "}
+	last := -1
+	for _, element := range order {
+		i := strings.Index(string(serialized), element)
+		if i < last {
+			t.Errorf("%s is out of order in\n%s", element, serialized)
+		}
+		last = i
+	}
+}
+
+func TestXML_Invalid(t *testing.T) {
+	for _, input := range []string{
+		`<Patient><id value="x"/></Patient>`,
+		`<Patient xmlns="http://hl7.org/fhir"><active value="yes"/></Patient>`,
+		`<Patient xmlns="http://hl7.org/fhir"><birthDate value="25.12.1974"/></Patient>`,
+		`<Patient xmlns="http://hl7.org/fhir">`,
+	} {
+		if _, err := UnmarshalResourceXML([]byte(input)); err == nil {
+			t.Errorf("expected error for %s", input)
+		}
+	}
+	if err := UnmarshalXML([]byte(`<Observation xmlns="http://hl7.org/fhir"/>`), &Patient{}); err == nil {
+		t.Error("expected error for mismatching resource type")
+	}
+}
+
+func TestXML_UnknownResource(t *testing.T) {
+	raw := &common.RawResource{
+		ResourceType: "CustomResource",
+		Data:         []byte(`{"resourceType":"CustomResource","id":"1","status":"active","extension":[{"url":"http://example.org/ext","valueBoolean":true}]}`),
+	}
+	bundle := &Bundle{Type: BundleTypeCollection, Entry: []BundleEntry{{Resource: raw}}}
+
+	serialized, err := MarshalXML(bundle)
+	if err != nil {
+		t.Fatalf("failed to marshal bundle: %v", err)
+	}
+	if !strings.Contains(string(serialized), `<resource><CustomResource><id value="1"/><extension url="http://example.org/ext"><valueBoolean value="true"/></extension><status value="active"/></CustomResource></resource>`) {
+		t.Errorf("unexpected XML %s", serialized)
+	}
+
+	decoded, err := UnmarshalResourceXML(serialized)
+	if err != nil {
+		t.Fatalf("failed to unmarshal bundle: %v", err)
+	}
+	entry := decoded.(*Bundle).Entry[0].Resource
+	if _, ok := entry.(*common.RawResource); !ok || entry.GetResourceType() != "CustomResource" {
+		t.Fatalf("expected raw CustomResource, got %T", entry)
+	}
+	var got, want interface{}
+	out, _ := json.Marshal(entry)
+	_ = json.Unmarshal(out, &got)
+	_ = json.Unmarshal(raw.Data, &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %s, want %s", out, raw.Data)
+	}
+}
@@ -0,0 +1,652 @@
+package fhirpath
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+func (n *literalNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	return n.items, nil
+}
+
+func (n *memberNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	var result []item
+	for _, it := range focus {
+		// an identifier starting a path may name the type of the context, e.g. Patient.name
+		if n.root && unicode.IsUpper(rune(n.name[0])) {
+			if it.is(typeSpecifier{name: n.name}) {
+				result = append(result, it)
+			}
+			continue
+		}
+		result = append(result, it.children(n.name)...)
+	}
+	return result, nil
+}
+
+func (n *invocationNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	target, err := n.target.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+	return n.member.eval(ctx, target)
+}
+
+func (n *indexerNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	target, err := n.target.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+	index, err := n.index.eval(ctx, ctx.this)
+	if err != nil {
+		return nil, err
+	}
+	i, ok, err := integerOf(index)
+	if err != nil || !ok {
+		return nil, err
+	}
+	if i < 0 || int(i) >= len(target) {
+		return nil, nil
+	}
+	return target[i : i+1], nil
+}
+
+func (n *variableNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	switch n.name {
+	case "$this":
+		return ctx.this, nil
+	case "$index":
+		return []item{{value: int64(ctx.index)}}, nil
+	case "$total":
+		return ctx.total, nil
+	}
+	value, ok := ctx.vars[n.name[1:]]
+	if !ok {
+		return nil, fmt.Errorf("fhirpath: undefined variable %s", n.name)
+	}
+	return value, nil
+}
+
+func (n *unaryNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	operand, err := n.operand.eval(ctx, focus)
+	if err != nil || n.op == "+" {
+		return operand, err
+	}
+	v, err := singleton(operand)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch v := v.(type) {
+	case int64:
+		return []item{{value: -v}}, nil
+	case common.Decimal:
+		return []item{{value: v.Neg()}}, nil
+	case Quantity:
+		return []item{{value: Quantity{Value: v.Value.Neg(), Unit: v.Unit}}}, nil
+	}
+	return nil, fmt.Errorf("fhirpath: cannot negate %s", systemType(v))
+}
+
+func (n *typeNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	operand, err := n.operand.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "is" {
+		return isType(operand, n.typ)
+	}
+	return asType(operand, n.typ)
+}
+
+func isType(items []item, t typeSpecifier) ([]item, error) {
+	switch len(items) {
+	case 0:
+		return nil, nil
+	case 1:
+		return boolean(items[0].is(t)), nil
+	}
+	return nil, fmt.Errorf("fhirpath: is applied to a collection of %d items", len(items))
+}
+
+func asType(items []item, t typeSpecifier) ([]item, error) {
+	var result []item
+	for _, it := range items {
+		if it.is(t) {
+			result = append(result, it)
+		}
+	}
+	return result, nil
+}
+
+func (n *binaryNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	left, err := n.left.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "and", "or", "xor", "implies":
+		return n.logic(ctx, focus, left)
+	}
+	right, err := n.right.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "|":
+		return union(left, right), nil
+	case "=", "!=":
+		if len(left) == 0 || len(right) == 0 {
+			return nil, nil
+		}
+		result, ok := equal(left, right)
+		if !ok {
+			return nil, nil
+		}
+		return boolean(result == (n.op == "=")), nil
+	case "~", "!~":
+		return boolean(equivalent(left, right) == (n.op == "~")), nil
+	case "in", "contains":
+		if n.op == "contains" {
+			left, right = right, left
+		}
+		switch len(left) {
+		case 0:
+			return nil, nil
+		case 1:
+			return boolean(contains(right, left[0])), nil
+		}
+		return nil, fmt.Errorf("fhirpath: %s applied to a collection of %d items", n.op, len(left))
+	case "&":
+		a, err := stringOf(left)
+		if err != nil {
+			return nil, err
+		}
+		b, err := stringOf(right)
+		if err != nil {
+			return nil, err
+		}
+		return []item{{value: a + b}}, nil
+	}
+
+	a, err := singleton(left)
+	if err != nil {
+		return nil, err
+	}
+	b, err := singleton(right)
+	if err != nil || a == nil || b == nil {
+		return nil, err
+	}
+	switch n.op {
+	case "<", "<=", ">", ">=":
+		c, ok, err := compare(a, b)
+		if err != nil || !ok {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return boolean(c < 0), nil
+		case "<=":
+			return boolean(c <= 0), nil
+		case ">":
+			return boolean(c > 0), nil
+		}
+		return boolean(c >= 0), nil
+	}
+	return arithmetic(n.op, a, b)
+}
+
+// logic evaluates the three-valued boolean operators, the right operand is
+// only evaluated when needed
+func (n *binaryNode) logic(ctx *evalContext, focus []item, left []item) ([]item, error) {
+	a, aok, err := booleanOf(left)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case n.op == "and" && aok && !a, n.op == "or" && aok && a:
+		return boolean(a), nil
+	case n.op == "implies" && aok && !a:
+		return boolean(true), nil
+	}
+	right, err := n.right.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+	b, bok, err := booleanOf(right)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "and":
+		switch {
+		case bok && !b:
+			return boolean(false), nil
+		case aok && bok:
+			return boolean(true), nil
+		}
+	case "or":
+		switch {
+		case bok && b:
+			return boolean(true), nil
+		case aok && bok:
+			return boolean(false), nil
+		}
+	case "xor":
+		if aok && bok {
+			return boolean(a != b), nil
+		}
+	case "implies":
+		switch {
+		case bok && b:
+			return boolean(true), nil
+		case aok && bok:
+			return boolean(false), nil
+		}
+	}
+	return nil, nil
+}
+
+func boolean(b bool) []item {
+	return []item{{value: b}}
+}
+
+// singleton returns the system value of a collection with at most one item,
+// FHIR Quantity elements are converted into System.Quantity
+func singleton(items []item) (interface{}, error) {
+	switch len(items) {
+	case 0:
+		return nil, nil
+	case 1:
+		if q, ok := items[0].quantity(); ok {
+			return q, nil
+		}
+		if items[0].value == nil {
+			return nil, nil
+		}
+		return items[0].value, nil
+	}
+	return nil, fmt.Errorf("fhirpath: expected a single item, found %d", len(items))
+}
+
+// booleanOf applies the singleton evaluation of collections, ok is false for an
+// empty collection
+func booleanOf(items []item) (value, ok bool, err error) {
+	switch len(items) {
+	case 0:
+		return false, false, nil
+	case 1:
+		if b, isBool := items[0].value.(bool); isBool {
+			return b, true, nil
+		}
+		return true, true, nil
+	}
+	return false, false, fmt.Errorf("fhirpath: expected a single boolean, found %d items", len(items))
+}
+
+// integerOf returns the single integer of a collection
+func integerOf(items []item) (int64, bool, error) {
+	v, err := singleton(items)
+	if err != nil || v == nil {
+		return 0, false, err
+	}
+	i, ok := v.(int64)
+	if !ok {
+		return 0, false, fmt.Errorf("fhirpath: expected an integer, found %s", systemType(v))
+	}
+	return i, true, nil
+}
+
+// stringOf returns the single string of a collection, the empty string for an
+// empty collection
+func stringOf(items []item) (string, error) {
+	v, err := singleton(items)
+	if err != nil || v == nil {
+		return "", err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("fhirpath: expected a string, found %s", systemType(v))
+	}
+	return s, nil
+}
+
+// decimalOf converts integers and decimals into a decimal
+func decimalOf(v interface{}) (common.Decimal, bool) {
+	switch v := v.(type) {
+	case int64:
+		return common.DecimalFromInt(v), true
+	case common.Decimal:
+		return v, true
+	}
+	return common.Decimal{}, false
+}
+
+// equal compares two collections item by item, ok is false if the result is unknown
+func equal(a, b []item) (result, ok bool) {
+	if len(a) != len(b) {
+		return false, true
+	}
+	for i := range a {
+		eq, known := itemEqual(a[i], b[i])
+		if !known {
+			return false, false
+		}
+		if !eq {
+			return false, true
+		}
+	}
+	return true, true
+}
+
+func itemEqual(a, b item) (result, ok bool) {
+	if qa, qb, isQuantity := quantityOperands(a, b); isQuantity {
+		return valueEqual(qa, qb)
+	}
+	if a.node.IsValid() || b.node.IsValid() {
+		if !a.node.IsValid() || !b.node.IsValid() {
+			return false, true
+		}
+		return reflect.DeepEqual(a.node.Interface(), b.node.Interface()), true
+	}
+	if a.value == nil || b.value == nil {
+		return false, false
+	}
+	return valueEqual(a.value, b.value)
+}
+
+func valueEqual(a, b interface{}) (result, ok bool) {
+	if qa, qb, isQuantity := quantityValues(a, b); isQuantity {
+		c, known := compareQuantities(qa, qb)
+		return c == 0, known
+	}
+	if da, ok := decimalOf(a); ok {
+		if db, ok := decimalOf(b); ok {
+			return da.Cmp(db) == 0, true
+		}
+		return false, true
+	}
+	switch a := a.(type) {
+	case temporal:
+		if b, isTemporal := b.(temporal); isTemporal {
+			c, known := a.compare(b)
+			return c == 0, known
+		}
+	case Quantity:
+		// b does not convert to a Quantity
+	default:
+		// values of different types are not equal, the specification has no
+		// implicit conversion between e.g. String and Integer
+		return a == b, true
+	}
+	return false, true
+}
+
+// implicitQuantity converts a value to a Quantity as the conversion table of the
+// specification allows: an Integer or Decimal becomes a Quantity of unit '1'
+func implicitQuantity(v interface{}) (Quantity, bool) {
+	if q, ok := v.(Quantity); ok {
+		return q, true
+	}
+	if d, ok := decimalOf(v); ok {
+		return Quantity{Value: d, Unit: "1"}, true
+	}
+	return Quantity{}, false
+}
+
+// quantityValues converts both operands to quantities if one of them is a
+// Quantity and the other converts implicitly, e.g. 1 '1' = 1
+func quantityValues(a, b interface{}) (Quantity, Quantity, bool) {
+	_, aq := a.(Quantity)
+	_, bq := b.(Quantity)
+	if !aq && !bq {
+		return Quantity{}, Quantity{}, false
+	}
+	qa, aok := implicitQuantity(a)
+	qb, bok := implicitQuantity(b)
+	return qa, qb, aok && bok
+}
+
+// quantityOperands is quantityValues for items, which may be Quantity elements
+func quantityOperands(a, b item) (Quantity, Quantity, bool) {
+	qa, aok := a.quantity()
+	qb, bok := b.quantity()
+	if !aok && !bok {
+		return Quantity{}, Quantity{}, false
+	}
+	if !aok {
+		qa, aok = implicitQuantity(a.value)
+	}
+	if !bok {
+		qb, bok = implicitQuantity(b.value)
+	}
+	return qa, qb, aok && bok
+}
+
+// equivalent implements ~, which is order independent and never empty
+func equivalent(a, b []item) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	matched := make([]bool, len(b))
+outer:
+	for _, x := range a {
+		for j, y := range b {
+			if !matched[j] && itemEquivalent(x, y) {
+				matched[j] = true
+				continue outer
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func itemEquivalent(a, b item) bool {
+	if qa, qb, isQuantity := quantityOperands(a, b); isQuantity {
+		c, known := compareQuantities(qa, qb)
+		return known && c == 0
+	}
+	if a.node.IsValid() || b.node.IsValid() {
+		if !a.node.IsValid() || !b.node.IsValid() {
+			return false
+		}
+		ca, cb := a.allChildren(), b.allChildren()
+		return equivalent(ca, cb)
+	}
+	if da, ok := decimalOf(a.value); ok {
+		db, ok := decimalOf(b.value)
+		if !ok {
+			return false
+		}
+		// compare at the precision of the least precise operand
+		scale := min(da.Scale(), db.Scale())
+		ra, _ := da.Div(common.DecimalFromInt(1), scale)
+		rb, _ := db.Div(common.DecimalFromInt(1), scale)
+		return ra.Cmp(rb) == 0
+	}
+	switch x := a.value.(type) {
+	case string:
+		y, ok := b.value.(string)
+		return ok && normalizeString(x) == normalizeString(y)
+	case temporal:
+		y, ok := b.value.(temporal)
+		if !ok || x.precision != y.precision {
+			return false
+		}
+		c, known := x.compare(y)
+		return known && c == 0
+	}
+	eq, ok := itemEqual(a, b)
+	return ok && eq
+}
+
+func normalizeString(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// contains reports whether the collection contains an item equal to it
+func contains(items []item, it item) bool {
+	for _, candidate := range items {
+		if eq, ok := itemEqual(candidate, it); ok && eq {
+			return true
+		}
+	}
+	return false
+}
+
+// union merges two collections and removes duplicates
+func union(a, b []item) []item {
+	var result []item
+	for _, it := range append(append([]item{}, a...), b...) {
+		if !contains(result, it) {
+			result = append(result, it)
+		}
+	}
+	return result
+}
+
+// compare orders two values of the same type, ok is false if the result is unknown
+func compare(a, b interface{}) (int, bool, error) {
+	if qa, qb, isQuantity := quantityValues(a, b); isQuantity {
+		c, known := compareQuantities(qa, qb)
+		return c, known, nil
+	}
+	if da, ok := decimalOf(a); ok {
+		if db, ok := decimalOf(b); ok {
+			return da.Cmp(db), true, nil
+		}
+	}
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true, nil
+		}
+	case temporal:
+		if y, ok := b.(temporal); ok {
+			c, known := x.compare(y)
+			return c, known, nil
+		}
+	}
+	return 0, false, fmt.Errorf("fhirpath: cannot compare %s with %s", systemType(a), systemType(b))
+}
+
+// divisionScale is the number of fraction digits of an inexact division
+const divisionScale = 8
+
+func arithmetic(op string, a, b interface{}) ([]item, error) {
+	if x, ok := a.(int64); ok {
+		if y, ok := b.(int64); ok {
+			switch op {
+			case "+":
+				return []item{{value: x + y}}, nil
+			case "-":
+				return []item{{value: x - y}}, nil
+			case "*":
+				return []item{{value: x * y}}, nil
+			case "div", "mod":
+				if y == 0 {
+					return nil, nil
+				}
+				if op == "div" {
+					return []item{{value: x / y}}, nil
+				}
+				return []item{{value: x % y}}, nil
+			}
+		}
+	}
+	if x, ok := decimalOf(a); ok {
+		if y, ok := decimalOf(b); ok {
+			return decimalArithmetic(op, x, y)
+		}
+		if q, ok := b.(Quantity); ok && op == "*" {
+			return []item{{value: Quantity{Value: x.Mul(q.Value), Unit: q.Unit}}}, nil
+		}
+	}
+	switch x := a.(type) {
+	case string:
+		if y, ok := b.(string); ok && op == "+" {
+			return []item{{value: x + y}}, nil
+		}
+	case temporal:
+		if q, ok := b.(Quantity); ok && (op == "+" || op == "-") {
+			sign := 1
+			if op == "-" {
+				sign = -1
+			}
+			t, err := x.add(q, sign)
+			if err != nil {
+				return nil, err
+			}
+			return []item{{value: t}}, nil
+		}
+	case Quantity:
+		return quantityArithmetic(op, x, b)
+	}
+	return nil, fmt.Errorf("fhirpath: cannot apply %s to %s and %s", op, systemType(a), systemType(b))
+}
+
+func decimalArithmetic(op string, x, y common.Decimal) ([]item, error) {
+	switch op {
+	case "+":
+		return []item{{value: x.Add(y)}}, nil
+	case "-":
+		return []item{{value: x.Sub(y)}}, nil
+	case "*":
+		return []item{{value: x.Mul(y)}}, nil
+	}
+	if y.IsZero() {
+		return nil, nil
+	}
+	quotient := new(big.Rat).Quo(x.Rat(), y.Rat())
+	switch op {
+	case "/":
+		d, err := common.ParseDecimal(trimDecimal(quotient.FloatString(divisionScale)))
+		return []item{{value: d}}, err
+	case "div":
+		return []item{{value: truncate(quotient)}}, nil
+	case "mod":
+		whole := new(big.Rat).SetInt(big.NewInt(truncate(quotient)))
+		remainder := new(big.Rat).Sub(x.Rat(), whole.Mul(whole, y.Rat()))
+		d, err := common.ParseDecimal(remainder.FloatString(max(x.Scale(), y.Scale())))
+		return []item{{value: d}}, err
+	}
+	return nil, fmt.Errorf("fhirpath: unknown operator %s", op)
+}
+
+// truncate returns the integer part of r
+func truncate(r *big.Rat) int64 {
+	return new(big.Int).Quo(r.Num(), r.Denom()).Int64()
+}
+
+func quantityArithmetic(op string, x Quantity, b interface{}) ([]item, error) {
+	if y, ok := b.(Quantity); ok && (op == "+" || op == "-") {
+		converted, ok := convertQuantity(y, x.Unit)
+		if !ok {
+			return nil, nil
+		}
+		if op == "+" {
+			return []item{{value: Quantity{Value: x.Value.Add(converted.Value), Unit: x.Unit}}}, nil
+		}
+		return []item{{value: Quantity{Value: x.Value.Sub(converted.Value), Unit: x.Unit}}}, nil
+	}
+	if y, ok := decimalOf(b); ok {
+		switch op {
+		case "*":
+			return []item{{value: Quantity{Value: x.Value.Mul(y), Unit: x.Unit}}}, nil
+		case "/":
+			if y.IsZero() {
+				return nil, nil
+			}
+			quotient := new(big.Rat).Quo(x.Value.Rat(), y.Rat())
+			d, err := common.ParseDecimal(trimDecimal(quotient.FloatString(divisionScale)))
+			return []item{{value: Quantity{Value: d, Unit: x.Unit}}}, err
+		}
+	}
+	return nil, fmt.Errorf("fhirpath: cannot apply %s to Quantity and %s", op, systemType(b))
+}
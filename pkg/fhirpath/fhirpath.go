@@ -0,0 +1,223 @@
+// Package fhirpath implements the FHIRPath expression language (normative
+// release N1) on the resource structs of the version packages.
+//
+// Expressions navigate the structs by their JSON names, so the same expression
+// works on fhir2, fhir3, fhir4, fhir4b and fhir5 resources. Choice elements are
+// addressed without their type suffix, e.g. Observation.value, and are typed by
+// the suffix, e.g. Observation.value.ofType(Quantity).
+//
+//	names, err := fhirpath.Evaluate(patient, "Patient.name.where(use = 'official').given")
+//
+// Results are returned as Go values: bool, int64, common.Decimal, string,
+// common.Date, common.DateTime, common.Time, Quantity, or a pointer to the
+// struct of a complex element or resource.
+package fhirpath
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Expression is a parsed FHIRPath expression that can be evaluated repeatedly
+type Expression struct {
+	src  string
+	root node
+}
+
+// Parse parses a FHIRPath expression
+func Parse(expr string) (*Expression, error) {
+	root, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return &Expression{src: expr, root: root}, nil
+}
+
+// MustParse parses a FHIRPath expression and panics if it is invalid
+func MustParse(expr string) *Expression {
+	e, err := Parse(expr)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.src
+}
+
+// Option configures the evaluation of an expression
+type Option func(*evalContext)
+
+// WithVariable defines the external constant %name. The value is converted like
+// the context of the evaluation; a slice defines a collection.
+func WithVariable(name string, value interface{}) Option {
+	return func(ctx *evalContext) {
+		ctx.vars[name] = toItems(value)
+	}
+}
+
+// WithResource sets %resource, the resource that contains the context. It
+// defaults to the context if that is a resource.
+func WithResource(resource common.Resource) Option {
+	return func(ctx *evalContext) {
+		ctx.vars["resource"] = toItems(resource)
+	}
+}
+
+// WithRootResource sets %rootResource, the container of a contained %resource.
+// It defaults to %resource.
+func WithRootResource(resource common.Resource) Option {
+	return func(ctx *evalContext) {
+		ctx.vars["rootResource"] = toItems(resource)
+	}
+}
+
+// WithResolver sets the function used by resolve() for references that are
+// neither contained nor part of the Bundle being evaluated
+func WithResolver(resolve func(reference string) common.Resource) Option {
+	return func(ctx *evalContext) {
+		ctx.resolver = resolve
+	}
+}
+
+// WithNow sets the time returned by now(), today() and timeOfDay()
+func WithNow(now time.Time) Option {
+	return func(ctx *evalContext) {
+		ctx.now = now
+	}
+}
+
+// evalContext holds the state of an evaluation
+type evalContext struct {
+	this     []item
+	index    int
+	total    []item
+	vars     map[string][]item
+	resolver func(reference string) common.Resource
+	now      time.Time
+}
+
+// Evaluate evaluates the expression against a resource or element, which is
+// also available as %context
+func (e *Expression) Evaluate(context interface{}, opts ...Option) ([]interface{}, error) {
+	focus := toItems(context)
+	ctx := &evalContext{
+		vars: map[string][]item{
+			"context": focus,
+			"ucum":    {{value: "http://unitsofmeasure.org"}},
+			"sct":     {{value: "http://snomed.info/sct"}},
+			"loinc":   {{value: "http://loinc.org"}},
+		},
+		now: time.Now(),
+	}
+	if len(focus) == 1 && focus[0].isResource() {
+		ctx.vars["resource"] = focus
+	}
+	for _, opt := range opts {
+		opt(ctx)
+	}
+	if _, ok := ctx.vars["rootResource"]; !ok {
+		ctx.vars["rootResource"] = ctx.vars["resource"]
+	}
+	ctx.this = focus
+
+	result, err := e.root.eval(ctx, focus)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, 0, len(result))
+	for _, it := range result {
+		values = append(values, it.export())
+	}
+	return values, nil
+}
+
+// EvaluateBool evaluates an expression that yields a single boolean, such as
+// an invariant. An empty result is reported as ok false.
+func (e *Expression) EvaluateBool(context interface{}, opts ...Option) (value, ok bool, err error) {
+	result, err := e.Evaluate(context, opts...)
+	if err != nil || len(result) == 0 {
+		return false, false, err
+	}
+	if len(result) > 1 {
+		return false, false, fmt.Errorf("fhirpath: %s yields %d values, expected a single boolean", e.src, len(result))
+	}
+	b, isBool := result[0].(bool)
+	if !isBool {
+		// a single non-boolean value counts as true
+		return true, true, nil
+	}
+	return b, true, nil
+}
+
+// Evaluate parses and evaluates an expression
+func Evaluate(context interface{}, expr string, opts ...Option) ([]interface{}, error) {
+	e, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	return e.Evaluate(context, opts...)
+}
+
+// toItems converts a Go value into a collection
+func toItems(v interface{}) []item {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case int:
+		return []item{{value: int64(v)}}
+	case int64, bool, string, common.Decimal, Quantity:
+		return []item{{value: v}}
+	case []interface{}:
+		var items []item
+		for _, e := range v {
+			items = append(items, toItems(e)...)
+		}
+		return items
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice {
+		var items []item
+		for i := 0; i < rv.Len(); i++ {
+			items = append(items, toItems(rv.Index(i).Interface())...)
+		}
+		return items
+	}
+	items := newItems(rv, reflect.Value{}, "")
+	for i := range items {
+		// values passed in are System values, unless they are complex
+		if !items[i].node.IsValid() {
+			items[i].fhirType = ""
+		}
+	}
+	return items
+}
+
+// export converts an item into the Go value returned to callers
+func (it item) export() interface{} {
+	switch v := it.value.(type) {
+	case nil:
+		if it.node.IsValid() {
+			if it.node.Kind() == reflect.Struct {
+				return addressable(it.node).Addr().Interface()
+			}
+			return it.node.Interface()
+		}
+		return it.element
+	case temporal:
+		if it.fhirType == "instant" {
+			if i, err := common.ParseInstant(v.String()); err == nil {
+				return i
+			}
+		}
+		return v.export()
+	case typeSpecifier:
+		return v.String()
+	}
+	return it.value
+}
@@ -0,0 +1,312 @@
+package fhirpath
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+const patientJSON = `{
+	"resourceType": "Patient",
+	"id": "example",
+	"contained": [{"resourceType": "Organization", "id": "org", "name": "Acme"}],
+	"active": true,
+	"name": [
+		{"use": "official", "family": "Chalmers", "given": ["Peter", "James"]},
+		{"use": "usual", "given": ["Jim"], "_given": [{"extension": [{"url": "http://example.org/nick", "valueBoolean": true}]}]}
+	],
+	"gender": "male",
+	"birthDate": "1974-12-25",
+	"multipleBirthInteger": 2,
+	"managingOrganization": {"reference": "#org"}
+}`
+
+const observationJSON = `{
+	"resourceType": "Observation",
+	"id": "bp",
+	"status": "final",
+	"code": {"coding": [{"system": "http://loinc.org", "code": "29463-7"}]},
+	"effectiveDateTime": "2024-03-01T10:30:00Z",
+	"valueQuantity": {"value": 185, "unit": "lbs", "system": "http://unitsofmeasure.org", "code": "[lb_av]"}
+}`
+
+func mustResource(t *testing.T, data string) common.Resource {
+	t.Helper()
+	resource, err := fhir5.UnmarshalResource([]byte(data))
+	if err != nil {
+		t.Fatalf("failed to unmarshal resource: %v", err)
+	}
+	return resource
+}
+
+func TestEvaluate_Navigation(t *testing.T) {
+	patient := mustResource(t, patientJSON)
+	observation := mustResource(t, observationJSON)
+
+	tests := []struct {
+		context interface{}
+		expr    string
+		want    []interface{}
+	}{
+		{patient, "Patient.name.given", []interface{}{"Peter", "James", "Jim"}},
+		{patient, "name.where(use = 'official').family", []interface{}{"Chalmers"}},
+		{patient, "Patient.name.given.first() + ' ' + name.family", []interface{}{"Peter Chalmers"}},
+		{patient, "Observation.status", []interface{}{}},
+		{patient, "name.given.count()", []interface{}{int64(3)}},
+		{patient, "name[1].given.extension('http://example.org/nick').value", []interface{}{true}},
+		{patient, "multipleBirth", []interface{}{int64(2)}},
+		{patient, "multipleBirth is integer", []interface{}{true}},
+		{patient, "gender = 'male' and active", []interface{}{true}},
+		{patient, "birthDate < @2000-01-01", []interface{}{true}},
+		{patient, "birthDate + 1 year", []interface{}{common.MustParseDate("1975-12-25")}},
+		{patient, "managingOrganization.resolve().name", []interface{}{"Acme"}},
+		{patient, "managingOrganization.resolve() is Organization", []interface{}{true}},
+		{patient, "contained.id", []interface{}{"org"}},
+		{patient, "Patient is DomainResource", []interface{}{true}},
+		{patient, "name.select(given.count()).sum", []interface{}{}},
+		{patient, "%resource.id = %context.id", []interface{}{true}},
+		{observation, "value.ofType(Quantity).unit", []interface{}{"lbs"}},
+		{observation, "value > 80 'kg'", []interface{}{true}},
+		{observation, "value.value.toString()", []interface{}{"185"}},
+		{observation, "effective.ofType(dateTime) > @2024-03-01T10:00:00Z", []interface{}{true}},
+		{observation, "code.coding.where(system = %loinc).code", []interface{}{"29463-7"}},
+	}
+	for _, test := range tests {
+		got, err := Evaluate(test.context, test.expr)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.expr, err)
+			continue
+		}
+		if len(got) == 0 && len(test.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: expected %v, got %v", test.expr, test.want, got)
+		}
+	}
+}
+
+func TestEvaluate_Literals(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"1 + 2 * 3", "7"},
+		{"7 div 2 + 7 mod 2", "4"},
+		{"1 / 3", "0.33333333"},
+		{"1.5 + 1", "2.5"},
+		{"1 / 0", ""},
+		{"-(5).abs()", "-5"},
+		{"2.power(10)", "1024"},
+		{"3.14159.round(2)", "3.14"},
+		{"'abc' + 'def'", "abcdef"},
+		{"'abc' & {}", "abc"},
+		{"'hello world'.substring(6)", "world"},
+		{"'hello'.indexOf('l')", "2"},
+		{"'a,b,c'.split(',').join('-')", "a-b-c"},
+		{"'abc123'.matches('^[a-z]+[0-9]+$')", "true"},
+		{"'abc'.replaceMatches('(b)', '[$1]')", "a[b]c"},
+		{"'Hello  World' ~ 'hello world'", "true"},
+		{"1.0 = 1", "true"},
+		{"1.2 ~ 1.23", "true"},
+		{"{} = 1", ""},
+		{"(1 | 2 | 2 | 3).count()", "3"},
+		{"(1 | 2).combine(2).count()", "3"},
+		{"2 in (1 | 2)", "true"},
+		{"(1 | 2 | 3).where($this > 1).select($this * 10)", "20,30"},
+		{"(1 | 2 | 3).aggregate($this + $total, 0)", "6"},
+		{"(1 | 2 | 3).all($this > 0)", "true"},
+		{"(1 | 2).subsetOf(1 | 2 | 3)", "true"},
+		{"(1 | 2 | 3).exclude(2)", "1,3"},
+		{"(1 | 2 | 3).skip(1).take(1)", "2"},
+		{"true and {}", ""},
+		{"false and {}", "false"},
+		{"true or {}", "true"},
+		{"{} implies true", "true"},
+		{"true xor false", "true"},
+		{"iif(1 > 2, 'yes', 'no')", "no"},
+		{"'1.5'.toDecimal() + '2'.toInteger()", "3.5"},
+		{"'yes'.toBoolean()", "true"},
+		{"'abc'.convertsToInteger()", "false"},
+		{"'5 mg'.toQuantity()", ""},
+		{"'5 \\'mg\\''.toQuantity() = 0.005 'g'", "true"},
+		{"1 'kg' = 1000 'g'", "true"},
+		{"1 week = 7 days", "true"},
+		{"1 year = 1 'a'", ""},
+		{"1 '1' = 1", "true"},
+		{"1.5 = 1.5 '1'", "true"},
+		{"2 '1' > 1", "true"},
+		{"1 '1' ~ 1.0", "true"},
+		{"1 'mg' = 1", ""},
+		{"'1' = 1", "false"},
+		{"1 = '1'", "false"},
+		{"(1 'kg').toQuantity('g')", "1000 'g'"},
+		{"@2014 + 24 months", "2016"},
+		{"@2019-01-31 + 1 month", "2019-02-28"},
+		{"@2012-04-15T15:00:00Z - 2 hours", "2012-04-15T13:00:00Z"},
+		{"@2012 < @2012-01", ""},
+		{"@2012-01-01 = @2012-01-01", "true"},
+		{"@2012-04-15T15:00:00+02:00 = @2012-04-15T13:00:00Z", "true"},
+		{"@T10:30 < @T11", "true"},
+		{"@2012-04-15T10:00:00Z.toDate()", "2012-04-15"},
+		{"now() > @2026-01-01", "true"},
+		{"today()", "2030-06-15"},
+		{"1 is Integer", "true"},
+		{"1 is System.Integer", "true"},
+		{"1.0 is Decimal", "true"},
+		{"'a' as String", "a"},
+		{"(1 | 2 | 3).ofType(Integer).last()", "3"},
+		{"true.not()", "false"},
+		{"'abc'.toChars().count()", "3"},
+		{"1.587.lowBoundary()", "1.5865"},
+		{"1.587.highBoundary()", "1.5875"},
+		{"@2014.lowBoundary()", "2014-01-01"},
+		{"@2014-02.highBoundary()", "2014-02-28"},
+		{"@2014-01-01T08.lowBoundary()", "2014-01-01T08:00:00.000+14:00"},
+		{"@2014-01-01T08:05Z.highBoundary()", "2014-01-01T08:05:59.999Z"},
+		{"@T10:30.highBoundary()", "10:30:59.999"},
+		{"1 'cm'.comparable(1 '[in_i]')", "true"},
+		{"1 'cm'.comparable(1 's')", "false"},
+	}
+	now := time.Date(2030, 6, 15, 12, 0, 0, 0, time.UTC)
+	for _, test := range tests {
+		got, err := Evaluate(nil, test.expr, WithNow(now))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.expr, err)
+			continue
+		}
+		values := make([]string, len(got))
+		for i, v := range got {
+			values[i] = toText(v)
+		}
+		if joined := strings.Join(values, ","); joined != test.want {
+			t.Errorf("%s: expected %q, got %q", test.expr, test.want, joined)
+		}
+	}
+}
+
+func toText(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case interface{ String() string }:
+		return v.String()
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	for _, expr := range []string{
+		"name.",
+		"name.where(",
+		"'unterminated",
+		"1 +",
+		"unknownFunction()",
+		"%undefined",
+		"(1 | 2).single()",
+		"'a' < 1",
+		"substring()",
+	} {
+		if _, err := Evaluate(nil, expr); err == nil {
+			t.Errorf("%s: expected an error", expr)
+		}
+	}
+}
+
+func TestEvaluate_Variables(t *testing.T) {
+	patient := mustResource(t, patientJSON)
+	got, err := Evaluate(patient, "name.given.where($this = %nick)", WithVariable("nick", "Jim"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "Jim" {
+		t.Errorf("expected [Jim], got %v", got)
+	}
+
+	resolver := func(reference string) common.Resource {
+		if reference == "Organization/1" {
+			return &fhir5.Organization{DomainResource: fhir5.DomainResource{Resource: fhir5.Resource{ID: fhir5.StringPtr("1")}}}
+		}
+		return nil
+	}
+	got, err = Evaluate(&common.Reference{Reference: fhir5.StringPtr("Organization/1")}, "resolve().id", WithResolver(resolver))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0] != "1" {
+		t.Errorf("expected [1], got %v", got)
+	}
+}
+
+func TestEvaluate_Results(t *testing.T) {
+	patient := mustResource(t, patientJSON)
+	got, err := Evaluate(patient, "name.first() | birthDate")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 results, got %v", got)
+	}
+	name, ok := got[0].(*fhir5.HumanName)
+	if !ok || *name.Family != "Chalmers" {
+		t.Errorf("expected the official name, got %#v", got[0])
+	}
+	if got[1] != common.MustParseDate("1974-12-25") {
+		t.Errorf("expected the birth date, got %#v", got[1])
+	}
+}
+
+// TestEvaluate_Invariants evaluates the invariants of the Patient and
+// Observation profiles against the examples, which all satisfy them
+func TestEvaluate_Invariants(t *testing.T) {
+	for _, resourceType := range []string{"Patient", "Observation"} {
+		data, err := os.ReadFile("../fhir5/testdata/fhir5-json/" + strings.ToLower(resourceType) + ".profile.json")
+		if err != nil {
+			t.Fatalf("failed to read profile: %v", err)
+		}
+		var profile fhir5.StructureDefinition
+		if err := json.Unmarshal(data, &profile); err != nil {
+			t.Fatalf("failed to parse profile: %v", err)
+		}
+		var invariants []fhir5.ElementDefinitionConstraint
+		for _, element := range profile.Snapshot.Element {
+			for _, constraint := range element.Constraint {
+				if constraint.Expression == nil {
+					continue
+				}
+				if _, err := Parse(*constraint.Expression); err != nil {
+					t.Errorf("%s: %v", constraint.Key, err)
+				}
+				if element.Path == resourceType && constraint.Severity == fhir5.ElementDefinitionConstraintSeverityError {
+					invariants = append(invariants, constraint)
+				}
+			}
+		}
+
+		files, err := filepath.Glob("../fhir5/testdata/fhir5-json/" + strings.ToLower(resourceType) + "-example*.json")
+		if err != nil {
+			t.Fatalf("failed to list example files: %v", err)
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("failed to read example file %s: %v", file, err)
+			}
+			resource := mustResource(t, string(data))
+			for _, invariant := range invariants {
+				ok, known, err := MustParse(*invariant.Expression).EvaluateBool(resource)
+				if err != nil || known && !ok {
+					t.Errorf("%s: %s failed: %v", filepath.Base(file), invariant.Key, err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,1359 @@
+package fhirpath
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// function implements a FHIRPath function. Arguments are passed unevaluated,
+// so that functions such as where() can evaluate them for every item.
+type function struct {
+	minArgs, maxArgs int
+	call             func(ctx *evalContext, focus []item, args []node) ([]item, error)
+}
+
+// functions is the function library, it is filled in init to break the
+// initialization cycle through functionNode.eval
+var functions map[string]function
+
+func init() {
+	functions = map[string]function{
+		// existence
+		"empty":      {0, 0, fnEmpty},
+		"exists":     {0, 1, fnExists},
+		"all":        {1, 1, fnAll},
+		"allTrue":    {0, 0, allBooleans(true, true)},
+		"anyTrue":    {0, 0, allBooleans(false, true)},
+		"allFalse":   {0, 0, allBooleans(true, false)},
+		"anyFalse":   {0, 0, allBooleans(false, false)},
+		"subsetOf":   {1, 1, fnSubsetOf},
+		"supersetOf": {1, 1, fnSupersetOf},
+		"count":      {0, 0, fnCount},
+		"distinct":   {0, 0, fnDistinct},
+		"isDistinct": {0, 0, fnIsDistinct},
+
+		// filtering and projection
+		"where":     {1, 1, fnWhere},
+		"select":    {1, 1, fnSelect},
+		"repeat":    {1, 1, fnRepeat},
+		"ofType":    {1, 1, fnOfType},
+		"aggregate": {1, 2, fnAggregate},
+
+		// subsetting
+		"single":    {0, 0, fnSingle},
+		"first":     {0, 0, fnFirst},
+		"last":      {0, 0, fnLast},
+		"tail":      {0, 0, fnTail},
+		"skip":      {1, 1, fnSkip},
+		"take":      {1, 1, fnTake},
+		"intersect": {1, 1, fnIntersect},
+		"exclude":   {1, 1, fnExclude},
+
+		// combining
+		"union":   {1, 1, fnUnion},
+		"combine": {1, 1, fnCombine},
+
+		// conversion
+		"iif":                {2, 3, fnIif},
+		"toBoolean":          {0, 0, conversion(toBoolean)},
+		"convertsToBoolean":  {0, 0, convertsTo(toBoolean)},
+		"toInteger":          {0, 0, conversion(toInteger)},
+		"convertsToInteger":  {0, 0, convertsTo(toInteger)},
+		"toDecimal":          {0, 0, conversion(toDecimal)},
+		"convertsToDecimal":  {0, 0, convertsTo(toDecimal)},
+		"toString":           {0, 0, conversion(toString)},
+		"convertsToString":   {0, 0, convertsTo(toString)},
+		"toDate":             {0, 0, conversion(toDate)},
+		"convertsToDate":     {0, 0, convertsTo(toDate)},
+		"toDateTime":         {0, 0, conversion(toDateTime)},
+		"convertsToDateTime": {0, 0, convertsTo(toDateTime)},
+		"toTime":             {0, 0, conversion(toTime)},
+		"convertsToTime":     {0, 0, convertsTo(toTime)},
+		"toQuantity":         {0, 1, fnToQuantity},
+		"convertsToQuantity": {0, 1, fnConvertsToQuantity},
+
+		// string manipulation
+		"indexOf":        {1, 1, fnIndexOf},
+		"substring":      {1, 2, fnSubstring},
+		"startsWith":     {1, 1, stringPredicate(strings.HasPrefix)},
+		"endsWith":       {1, 1, stringPredicate(strings.HasSuffix)},
+		"contains":       {1, 1, stringPredicate(strings.Contains)},
+		"upper":          {0, 0, stringFunction(strings.ToUpper)},
+		"lower":          {0, 0, stringFunction(strings.ToLower)},
+		"trim":           {0, 0, stringFunction(strings.TrimSpace)},
+		"replace":        {2, 2, fnReplace},
+		"matches":        {1, 1, fnMatches},
+		"replaceMatches": {2, 2, fnReplaceMatches},
+		"length":         {0, 0, fnLength},
+		"toChars":        {0, 0, fnToChars},
+		"split":          {1, 1, fnSplit},
+		"join":           {0, 1, fnJoin},
+
+		// math
+		"abs":      {0, 0, fnAbs},
+		"ceiling":  {0, 0, rounding(math.Ceil)},
+		"floor":    {0, 0, rounding(math.Floor)},
+		"truncate": {0, 0, rounding(math.Trunc)},
+		"exp":      {0, 0, floatFunction(math.Exp)},
+		"ln":       {0, 0, floatFunction(math.Log)},
+		"sqrt":     {0, 0, floatFunction(math.Sqrt)},
+		"log":      {1, 1, fnLog},
+		"power":    {1, 1, fnPower},
+		"round":    {0, 1, fnRound},
+
+		// boundaries, defined by FHIRPath 2.0 and used by R5 invariants
+		"lowBoundary":  {0, 1, boundary(false)},
+		"highBoundary": {0, 1, boundary(true)},
+		"comparable":   {1, 1, fnComparable},
+
+		// tree navigation
+		"children":    {0, 0, fnChildren},
+		"descendants": {0, 0, fnDescendants},
+
+		// utility
+		"trace":     {1, 2, fnTrace},
+		"now":       {0, 0, fnNow},
+		"today":     {0, 0, fnToday},
+		"timeOfDay": {0, 0, fnTimeOfDay},
+		"not":       {0, 0, fnNot},
+		"is":        {1, 1, fnIs},
+		"as":        {1, 1, fnAs},
+
+		// FHIR additions
+		"extension":  {1, 1, fnExtension},
+		"hasValue":   {0, 0, fnHasValue},
+		"getValue":   {0, 0, fnGetValue},
+		"resolve":    {0, 0, fnResolve},
+		"htmlChecks": {0, 0, fnHTMLChecks},
+		"htmlchecks": {0, 0, fnHTMLChecks}, // the spelling of the STU3 invariants
+		"memberOf":   {1, 1, unsupported("memberOf")},
+		"conformsTo": {1, 1, unsupported("conformsTo")},
+	}
+}
+
+func (n *functionNode) eval(ctx *evalContext, focus []item) ([]item, error) {
+	fn, ok := functions[n.name]
+	if !ok {
+		return nil, fmt.Errorf("fhirpath: unknown function %s", n.name)
+	}
+	if len(n.args) < fn.minArgs || len(n.args) > fn.maxArgs {
+		return nil, fmt.Errorf("fhirpath: wrong number of arguments for %s: %d", n.name, len(n.args))
+	}
+	return fn.call(ctx, focus, n.args)
+}
+
+// arg evaluates an argument against the context of the enclosing expression
+func (ctx *evalContext) arg(n node) ([]item, error) {
+	return n.eval(ctx, ctx.this)
+}
+
+// lambda evaluates an argument for every item of the focus with $this and $index set
+func (ctx *evalContext) lambda(focus []item, n node, each func(i int, result []item) (bool, error)) error {
+	this, index := ctx.this, ctx.index
+	defer func() { ctx.this, ctx.index = this, index }()
+	for i, it := range focus {
+		ctx.this, ctx.index = []item{it}, i
+		result, err := n.eval(ctx, ctx.this)
+		if err != nil {
+			return err
+		}
+		next, err := each(i, result)
+		if err != nil || !next {
+			return err
+		}
+	}
+	return nil
+}
+
+func fnEmpty(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return boolean(len(focus) == 0), nil
+}
+
+func fnExists(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(args) == 1 {
+		filtered, err := fnWhere(ctx, focus, args)
+		if err != nil {
+			return nil, err
+		}
+		focus = filtered
+	}
+	return boolean(len(focus) > 0), nil
+}
+
+func fnAll(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	all := true
+	err := ctx.lambda(focus, args[0], func(i int, result []item) (bool, error) {
+		b, ok, err := booleanOf(result)
+		if err != nil {
+			return false, err
+		}
+		all = ok && b
+		return all, nil
+	})
+	return boolean(all), err
+}
+
+// allBooleans implements allTrue, anyTrue, allFalse and anyFalse
+func allBooleans(all, want bool) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		for _, it := range focus {
+			b, ok := it.value.(bool)
+			if !ok {
+				return nil, fmt.Errorf("fhirpath: expected booleans, found %s", systemType(it.value))
+			}
+			if all && b != want {
+				return boolean(false), nil
+			}
+			if !all && b == want {
+				return boolean(true), nil
+			}
+		}
+		return boolean(all), nil
+	}
+}
+
+func fnSubsetOf(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return boolean(subsetOf(focus, other)), nil
+}
+
+func fnSupersetOf(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return boolean(subsetOf(other, focus)), nil
+}
+
+func subsetOf(a, b []item) bool {
+	for _, it := range a {
+		if !contains(b, it) {
+			return false
+		}
+	}
+	return true
+}
+
+func fnCount(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return []item{{value: int64(len(focus))}}, nil
+}
+
+func fnDistinct(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return union(focus, nil), nil
+}
+
+func fnIsDistinct(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return boolean(len(union(focus, nil)) == len(focus)), nil
+}
+
+func fnWhere(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var result []item
+	err := ctx.lambda(focus, args[0], func(i int, criteria []item) (bool, error) {
+		b, ok, err := booleanOf(criteria)
+		if ok && b {
+			result = append(result, focus[i])
+		}
+		return true, err
+	})
+	return result, err
+}
+
+func fnSelect(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var result []item
+	err := ctx.lambda(focus, args[0], func(i int, projection []item) (bool, error) {
+		result = append(result, projection...)
+		return true, nil
+	})
+	return result, err
+}
+
+func fnRepeat(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var result []item
+	for len(focus) > 0 {
+		var next []item
+		err := ctx.lambda(focus, args[0], func(i int, projection []item) (bool, error) {
+			for _, it := range projection {
+				if !contains(result, it) {
+					result = append(result, it)
+					next = append(next, it)
+				}
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		focus = next
+	}
+	return result, nil
+}
+
+func fnOfType(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	t, err := typeArg(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return asType(focus, t)
+}
+
+func fnAggregate(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var total []item
+	if len(args) == 2 {
+		init, err := ctx.arg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		total = init
+	}
+	saved := ctx.total
+	defer func() { ctx.total = saved }()
+	ctx.total = total
+	err := ctx.lambda(focus, args[0], func(i int, result []item) (bool, error) {
+		ctx.total = result
+		return true, nil
+	})
+	return ctx.total, err
+}
+
+func fnSingle(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(focus) > 1 {
+		return nil, fmt.Errorf("fhirpath: single() applied to a collection of %d items", len(focus))
+	}
+	return focus, nil
+}
+
+func fnFirst(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(focus) == 0 {
+		return nil, nil
+	}
+	return focus[:1], nil
+}
+
+func fnLast(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(focus) == 0 {
+		return nil, nil
+	}
+	return focus[len(focus)-1:], nil
+}
+
+func fnTail(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(focus) == 0 {
+		return nil, nil
+	}
+	return focus[1:], nil
+}
+
+func fnSkip(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	n, err := countArg(ctx, args[0])
+	if err != nil || n >= len(focus) {
+		return nil, err
+	}
+	return focus[n:], nil
+}
+
+func fnTake(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	n, err := countArg(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return focus[:min(n, len(focus))], nil
+}
+
+func fnIntersect(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	var result []item
+	for _, it := range focus {
+		if contains(other, it) && !contains(result, it) {
+			result = append(result, it)
+		}
+	}
+	return result, nil
+}
+
+func fnExclude(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	var result []item
+	for _, it := range focus {
+		if !contains(other, it) {
+			result = append(result, it)
+		}
+	}
+	return result, nil
+}
+
+func fnUnion(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return union(focus, other), nil
+}
+
+func fnCombine(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]item{}, focus...), other...), nil
+}
+
+func fnIif(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	criterion, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	b, ok, err := booleanOf(criterion)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case ok && b:
+		return ctx.arg(args[1])
+	case len(args) == 3:
+		return ctx.arg(args[2])
+	}
+	return nil, nil
+}
+
+// typeArg returns the type specifier passed to is, as and ofType
+func typeArg(ctx *evalContext, n node) (typeSpecifier, error) {
+	if literal, ok := n.(*literalNode); ok && len(literal.items) == 1 {
+		if t, ok := literal.items[0].value.(typeSpecifier); ok {
+			return t, nil
+		}
+	}
+	return typeSpecifier{}, fmt.Errorf("fhirpath: expected a type name")
+}
+
+// countArg evaluates a non-negative integer argument
+func countArg(ctx *evalContext, n node) (int, error) {
+	value, err := ctx.arg(n)
+	if err != nil {
+		return 0, err
+	}
+	i, ok, err := integerOf(value)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("fhirpath: expected an integer argument")
+	}
+	return max(int(i), 0), nil
+}
+
+// stringArg evaluates a string argument, ok is false if it is empty
+func stringArg(ctx *evalContext, n node) (string, bool, error) {
+	value, err := ctx.arg(n)
+	if err != nil || len(value) == 0 {
+		return "", false, err
+	}
+	s, err := stringOf(value)
+	return s, err == nil, err
+}
+
+// input returns the single system value of the focus, nil if it is empty
+func input(focus []item) (interface{}, error) {
+	v, err := singleton(focus)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// conversion implements the to* functions, a value that cannot be converted yields empty
+func conversion(convert func(interface{}) (interface{}, bool)) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		v, err := input(focus)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		if converted, ok := convert(v); ok {
+			return []item{{value: converted}}, nil
+		}
+		return nil, nil
+	}
+}
+
+// convertsTo implements the convertsTo* functions
+func convertsTo(convert func(interface{}) (interface{}, bool)) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		v, err := input(focus)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		_, ok := convert(v)
+		return boolean(ok), nil
+	}
+}
+
+var (
+	integerPattern  = regexp.MustCompile(`^[+-]?\d+$`)
+	decimalPattern  = regexp.MustCompile(`^[+-]?\d+(\.\d+)?$`)
+	quantityPattern = regexp.MustCompile(`^([+-]?\d+(?:\.\d+)?)\s*(?:'([^']+)'|([a-zA-Z]+))?$`)
+)
+
+func toBoolean(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case bool:
+		return v, true
+	case int64:
+		if v == 0 || v == 1 {
+			return v == 1, true
+		}
+	case common.Decimal:
+		switch {
+		case v.Cmp(common.DecimalFromInt(1)) == 0:
+			return true, true
+		case v.IsZero():
+			return false, true
+		}
+	case string:
+		switch strings.ToLower(v) {
+		case "true", "t", "yes", "y", "1", "1.0":
+			return true, true
+		case "false", "f", "no", "n", "0", "0.0":
+			return false, true
+		}
+	}
+	return nil, false
+}
+
+func toInteger(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case int64:
+		return v, true
+	case bool:
+		if v {
+			return int64(1), true
+		}
+		return int64(0), true
+	case string:
+		if integerPattern.MatchString(v) {
+			i, err := strconv.ParseInt(v, 10, 64)
+			return i, err == nil
+		}
+	}
+	return nil, false
+}
+
+func toDecimal(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case int64:
+		return common.DecimalFromInt(v), true
+	case common.Decimal:
+		return v, true
+	case bool:
+		if v {
+			return common.MustParseDecimal("1.0"), true
+		}
+		return common.MustParseDecimal("0.0"), true
+	case string:
+		if decimalPattern.MatchString(v) {
+			d, err := common.ParseDecimal(strings.TrimPrefix(v, "+"))
+			return d, err == nil
+		}
+	}
+	return nil, false
+}
+
+func toString(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case common.Decimal:
+		return v.String(), true
+	case Quantity:
+		return v.Value.String() + " '" + v.Unit + "'", true
+	case temporal:
+		return v.String(), true
+	}
+	return nil, false
+}
+
+func toDate(v interface{}) (interface{}, bool) {
+	var t temporal
+	switch v := v.(type) {
+	case string:
+		parsed, err := parseDateTime(v)
+		if err != nil {
+			return nil, false
+		}
+		t = parsed
+	case temporal:
+		if v.kind == kindTime {
+			return nil, false
+		}
+		t = v
+	default:
+		return nil, false
+	}
+	t.kind, t.zone = kindDate, nil
+	t.precision = min(t.precision, precisionDay)
+	t.hour, t.minute, t.second, t.nanos = 0, 0, 0, 0
+	return t, true
+}
+
+func toDateTime(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case string:
+		t, err := parseDateTime(v)
+		if err != nil {
+			return nil, false
+		}
+		t.kind = kindDateTime
+		return t, true
+	case temporal:
+		if v.kind == kindTime {
+			return nil, false
+		}
+		v.kind = kindDateTime
+		return v, true
+	}
+	return nil, false
+}
+
+func toTime(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case string:
+		t, err := parseTime(v)
+		return t, err == nil
+	case temporal:
+		return v, v.kind == kindTime
+	}
+	return nil, false
+}
+
+func toQuantity(v interface{}) (Quantity, bool) {
+	switch v := v.(type) {
+	case Quantity:
+		return v, true
+	case int64:
+		return Quantity{Value: common.DecimalFromInt(v), Unit: "1"}, true
+	case common.Decimal:
+		return Quantity{Value: v, Unit: "1"}, true
+	case bool:
+		d, _ := toDecimal(v)
+		return Quantity{Value: d.(common.Decimal), Unit: "1"}, true
+	case string:
+		m := quantityPattern.FindStringSubmatch(strings.TrimSpace(v))
+		if m == nil {
+			return Quantity{}, false
+		}
+		q := Quantity{Value: common.MustParseDecimal(strings.TrimPrefix(m[1], "+")), Unit: "1"}
+		switch {
+		case m[2] != "":
+			q.Unit = m[2]
+		case m[3] != "":
+			unit, ok := calendarUnits[m[3]]
+			if !ok {
+				return Quantity{}, false
+			}
+			q.Unit = unit
+		}
+		return q, true
+	}
+	return Quantity{}, false
+}
+
+// quantityConversion converts the focus into a quantity in the unit of the optional argument
+func quantityConversion(ctx *evalContext, focus []item, args []node) (Quantity, bool, error) {
+	v, err := input(focus)
+	if err != nil || v == nil {
+		return Quantity{}, false, err
+	}
+	q, ok := toQuantity(v)
+	if !ok || len(args) == 0 {
+		return q, ok, nil
+	}
+	unit, ok, err := stringArg(ctx, args[0])
+	if err != nil || !ok {
+		return Quantity{}, false, err
+	}
+	q, ok = convertQuantity(q, unit)
+	return q, ok, nil
+}
+
+func fnToQuantity(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	q, ok, err := quantityConversion(ctx, focus, args)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return []item{{value: q}}, nil
+}
+
+func fnConvertsToQuantity(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(focus) == 0 {
+		return nil, nil
+	}
+	_, ok, err := quantityConversion(ctx, focus, args)
+	if err != nil {
+		return nil, err
+	}
+	return boolean(ok), nil
+}
+
+// stringInput returns the single string of the focus, ok is false if the focus is empty
+func stringInput(focus []item) (string, bool, error) {
+	if len(focus) == 0 {
+		return "", false, nil
+	}
+	s, err := stringOf(focus)
+	if err != nil {
+		return "", false, err
+	}
+	return s, focus[0].value != nil, nil
+}
+
+func stringFunction(fn func(string) string) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		s, ok, err := stringInput(focus)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return []item{{value: fn(s)}}, nil
+	}
+}
+
+func stringPredicate(fn func(s, arg string) bool) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		s, ok, err := stringInput(focus)
+		if err != nil || !ok {
+			return nil, err
+		}
+		arg, ok, err := stringArg(ctx, args[0])
+		if err != nil || !ok {
+			return nil, err
+		}
+		return boolean(fn(s, arg)), nil
+	}
+}
+
+func fnIndexOf(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	sub, ok, err := stringArg(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	i := strings.Index(s, sub)
+	if i >= 0 {
+		i = utf8.RuneCountInString(s[:i])
+	}
+	return []item{{value: int64(i)}}, nil
+}
+
+func fnSubstring(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	startItems, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	start, ok, err := integerOf(startItems)
+	runes := []rune(s)
+	if err != nil || !ok || start < 0 || int(start) >= len(runes) {
+		return nil, err
+	}
+	end := len(runes)
+	if len(args) == 2 {
+		lengthItems, err := ctx.arg(args[1])
+		if err != nil {
+			return nil, err
+		}
+		if length, ok, err := integerOf(lengthItems); err != nil {
+			return nil, err
+		} else if ok {
+			end = min(int(start)+max(int(length), 0), len(runes))
+		}
+	}
+	return []item{{value: string(runes[start:end])}}, nil
+}
+
+func fnReplace(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	pattern, ok, err := stringArg(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	substitution, ok, err := stringArg(ctx, args[1])
+	if err != nil || !ok {
+		return nil, err
+	}
+	return []item{{value: strings.ReplaceAll(s, pattern, substitution)}}, nil
+}
+
+func regexpArg(ctx *evalContext, n node) (*regexp.Regexp, bool, error) {
+	pattern, ok, err := stringArg(ctx, n)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	re, err := regexp.Compile("(?s)" + pattern)
+	if err != nil {
+		return nil, false, fmt.Errorf("fhirpath: invalid regular expression %q: %v", pattern, err)
+	}
+	return re, true, nil
+}
+
+func fnMatches(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	re, ok, err := regexpArg(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	return boolean(re.MatchString(s)), nil
+}
+
+func fnReplaceMatches(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	re, ok, err := regexpArg(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	substitution, ok, err := stringArg(ctx, args[1])
+	if err != nil || !ok {
+		return nil, err
+	}
+	return []item{{value: re.ReplaceAllString(s, substitution)}}, nil
+}
+
+func fnLength(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return []item{{value: int64(utf8.RuneCountInString(s))}}, nil
+}
+
+func fnToChars(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	var result []item
+	for _, r := range s {
+		result = append(result, item{value: string(r)})
+	}
+	return result, nil
+}
+
+func fnSplit(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	s, ok, err := stringInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	separator, ok, err := stringArg(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	var result []item
+	for _, part := range strings.Split(s, separator) {
+		result = append(result, item{value: part})
+	}
+	return result, nil
+}
+
+func fnJoin(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	separator := ""
+	if len(args) == 1 {
+		s, _, err := stringArg(ctx, args[0])
+		if err != nil {
+			return nil, err
+		}
+		separator = s
+	}
+	parts := make([]string, 0, len(focus))
+	for _, it := range focus {
+		s, ok := it.value.(string)
+		if !ok {
+			return nil, fmt.Errorf("fhirpath: join() expects strings, found %s", systemType(it.value))
+		}
+		parts = append(parts, s)
+	}
+	return []item{{value: strings.Join(parts, separator)}}, nil
+}
+
+// numberInput returns the single integer, decimal or quantity of the focus
+func numberInput(focus []item) (interface{}, error) {
+	v, err := input(focus)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	switch v.(type) {
+	case int64, common.Decimal, Quantity:
+		return v, nil
+	}
+	return nil, fmt.Errorf("fhirpath: expected a number, found %s", systemType(v))
+}
+
+func fnAbs(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	v, err := numberInput(focus)
+	switch v := v.(type) {
+	case int64:
+		if v < 0 {
+			v = -v
+		}
+		return []item{{value: v}}, nil
+	case common.Decimal:
+		return []item{{value: v.Abs()}}, nil
+	case Quantity:
+		return []item{{value: Quantity{Value: v.Value.Abs(), Unit: v.Unit}}}, nil
+	}
+	return nil, err
+}
+
+// rounding implements ceiling, floor and truncate, which return integers
+func rounding(fn func(float64) float64) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		v, err := numberInput(focus)
+		switch v := v.(type) {
+		case int64:
+			return []item{{value: v}}, nil
+		case common.Decimal:
+			return []item{{value: int64(fn(v.Float64()))}}, nil
+		case Quantity:
+			return nil, fmt.Errorf("fhirpath: expected a number, found Quantity")
+		}
+		return nil, err
+	}
+}
+
+// floatInput returns the single number of the focus as a float
+func floatInput(focus []item) (float64, bool, error) {
+	v, err := numberInput(focus)
+	if err != nil || v == nil {
+		return 0, false, err
+	}
+	d, ok := decimalOf(v)
+	if !ok {
+		return 0, false, fmt.Errorf("fhirpath: expected a number, found Quantity")
+	}
+	return d.Float64(), true, nil
+}
+
+// floatResult returns a decimal, or empty for results that are not numbers
+func floatResult(f float64) []item {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil
+	}
+	return []item{{value: common.DecimalFromFloat64(f)}}
+}
+
+func floatFunction(fn func(float64) float64) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		f, ok, err := floatInput(focus)
+		if err != nil || !ok {
+			return nil, err
+		}
+		return floatResult(fn(f)), nil
+	}
+}
+
+func fnLog(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	f, ok, err := floatInput(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	base, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	b, ok, err := floatInput(base)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return floatResult(math.Log(f) / math.Log(b)), nil
+}
+
+func fnPower(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	v, err := numberInput(focus)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	exponentItems, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	exponent, err := numberInput(exponentItems)
+	if err != nil || exponent == nil {
+		return nil, err
+	}
+	if base, ok := v.(int64); ok {
+		if e, ok := exponent.(int64); ok && e >= 0 {
+			result := new(big.Int).Exp(big.NewInt(base), big.NewInt(e), nil)
+			if result.IsInt64() {
+				return []item{{value: result.Int64()}}, nil
+			}
+		}
+	}
+	b, _ := decimalOf(v)
+	e, ok := decimalOf(exponent)
+	if !ok {
+		return nil, fmt.Errorf("fhirpath: expected a number, found Quantity")
+	}
+	return floatResult(math.Pow(b.Float64(), e.Float64())), nil
+}
+
+func fnRound(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	v, err := numberInput(focus)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	d, ok := decimalOf(v)
+	if !ok {
+		return nil, fmt.Errorf("fhirpath: expected a number, found Quantity")
+	}
+	digits := 0
+	if len(args) == 1 {
+		if digits, err = countArg(ctx, args[0]); err != nil {
+			return nil, err
+		}
+	}
+	// round half away from zero
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil))
+	scaled := new(big.Rat).Mul(d.Rat(), scale)
+	half := big.NewRat(1, 2)
+	if scaled.Sign() < 0 {
+		half.Neg(half)
+	}
+	whole := new(big.Rat).SetInt64(truncate(scaled.Add(scaled, half)))
+	rounded, err := common.ParseDecimal(whole.Quo(whole, scale).FloatString(digits))
+	if err != nil {
+		return nil, err
+	}
+	return []item{{value: rounded}}, nil
+}
+
+// boundary implements lowBoundary and highBoundary, the least and greatest
+// values an imprecise decimal, quantity or date/time may stand for
+func boundary(high bool) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		v, err := input(focus)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		digits := -1
+		if len(args) == 1 {
+			if digits, err = countArg(ctx, args[0]); err != nil {
+				return nil, err
+			}
+		}
+		switch v := v.(type) {
+		case int64, common.Decimal:
+			d, _ := decimalOf(v)
+			return []item{{value: decimalBoundary(d, high, digits)}}, nil
+		case Quantity:
+			return []item{{value: Quantity{Value: decimalBoundary(v.Value, high, digits), Unit: v.Unit}}}, nil
+		case temporal:
+			return []item{{value: temporalBoundary(v, high, digits)}}, nil
+		}
+		return nil, nil
+	}
+}
+
+func fnComparable(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	v, err := input(focus)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	other, err := ctx.arg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	o, err := input(other)
+	if err != nil || o == nil {
+		return nil, err
+	}
+	a, ok := v.(Quantity)
+	b, ok2 := o.(Quantity)
+	if !ok || !ok2 {
+		return nil, fmt.Errorf("fhirpath: comparable expects quantities, found %s and %s", systemType(v), systemType(o))
+	}
+	_, ok = compareQuantities(a, b)
+	return boolean(ok), nil
+}
+
+// decimalBoundary returns the bound of the implicit range of d, with the given
+// number of fraction digits if digits is not negative
+func decimalBoundary(d common.Decimal, high bool, digits int) common.Decimal {
+	bound, upper := d.Bounds()
+	if high {
+		bound = upper
+	}
+	if digits < 0 {
+		return bound
+	}
+	result, err := common.ParseDecimal(bound.Rat().FloatString(digits))
+	if err != nil {
+		return bound
+	}
+	return result
+}
+
+// temporalDigits maps the precision argument of the boundary functions to the
+// precision of dates and times, which it counts in digits
+var temporalDigits = map[int]precision{
+	4: precisionYear, 6: precisionMonth, 8: precisionDay, 10: precisionHour, 12: precisionMinute, 14: precisionSecond, 17: precisionMillisecond,
+}
+
+var timeDigits = map[int]precision{2: precisionHour, 4: precisionMinute, 6: precisionSecond, 9: precisionMillisecond}
+
+// temporalBoundary fills the components finer than the precision of t with
+// their least or greatest values. A dateTime without timezone takes the
+// timezone that makes it earliest or latest.
+func temporalBoundary(t temporal, high bool, digits int) temporal {
+	target := precisionMillisecond
+	switch {
+	case t.kind == kindDate:
+		target = precisionDay
+		if p, ok := temporalDigits[digits]; ok && p <= precisionDay {
+			target = p
+		}
+	case t.kind == kindTime:
+		if p, ok := timeDigits[digits]; ok {
+			target = p
+		}
+	default:
+		if p, ok := temporalDigits[digits]; ok {
+			target = p
+		}
+	}
+	if target < t.precision {
+		t.precision = target
+		return t
+	}
+	if t.precision < precisionMonth {
+		t.month = map[bool]int{false: 1, true: 12}[high]
+	}
+	if t.precision < precisionDay {
+		t.day = 1
+		if high {
+			t.day = daysIn(t.year, t.month)
+		}
+	}
+	if high {
+		if t.precision < precisionHour {
+			t.hour = 23
+		}
+		if t.precision < precisionMinute {
+			t.minute = 59
+		}
+		if t.precision < precisionSecond {
+			t.second = 59
+		}
+		if t.precision < precisionMillisecond {
+			t.nanos = 999e6
+		}
+	}
+	if t.kind == kindDateTime && t.zone == nil && target >= precisionHour {
+		t.zone = time.FixedZone("+14:00", 14*3600)
+		if high {
+			t.zone = time.FixedZone("-12:00", -12*3600)
+		}
+	}
+	t.precision = target
+	return t
+}
+
+func fnChildren(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var result []item
+	for _, it := range focus {
+		result = append(result, it.allChildren()...)
+	}
+	return result, nil
+}
+
+func fnDescendants(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var result []item
+	for level := focus; len(level) > 0; {
+		var next []item
+		for _, it := range level {
+			next = append(next, it.allChildren()...)
+		}
+		result = append(result, next...)
+		level = next
+	}
+	return result, nil
+}
+
+func fnTrace(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return focus, nil
+}
+
+func fnNow(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return []item{{value: temporalFromTime(ctx.now, kindDateTime, precisionMillisecond)}}, nil
+}
+
+func fnToday(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	t := temporalFromTime(ctx.now, kindDate, precisionDay)
+	t.hour, t.minute, t.second, t.nanos, t.zone = 0, 0, 0, 0, nil
+	return []item{{value: t}}, nil
+}
+
+func fnTimeOfDay(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	t := temporalFromTime(ctx.now, kindTime, precisionMillisecond)
+	t.year, t.month, t.day, t.zone = 0, 0, 0, nil
+	return []item{{value: t}}, nil
+}
+
+func fnNot(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	b, ok, err := booleanOf(focus)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return boolean(!b), nil
+}
+
+func fnIs(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	t, err := typeArg(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return isType(focus, t)
+}
+
+func fnAs(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	t, err := typeArg(ctx, args[0])
+	if err != nil {
+		return nil, err
+	}
+	return asType(focus, t)
+}
+
+func fnExtension(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	url, ok, err := stringArg(ctx, args[0])
+	if err != nil || !ok {
+		return nil, err
+	}
+	var result []item
+	for _, it := range focus {
+		for _, extension := range it.children("extension") {
+			for _, u := range extension.children("url") {
+				if u.value == url {
+					result = append(result, extension)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func fnHasValue(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return boolean(len(focus) == 1 && focus[0].value != nil && focus[0].fhirType != ""), nil
+}
+
+func fnGetValue(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	if len(focus) != 1 || focus[0].value == nil || focus[0].fhirType == "" {
+		return nil, nil
+	}
+	return []item{{value: focus[0].value}}, nil
+}
+
+func fnHTMLChecks(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	return boolean(true), nil
+}
+
+func unsupported(name string) func(*evalContext, []item, []node) ([]item, error) {
+	return func(ctx *evalContext, focus []item, args []node) ([]item, error) {
+		return nil, fmt.Errorf("fhirpath: %s() is not supported", name)
+	}
+}
+
+// fnResolve resolves references against the contained resources, the entries
+// of the Bundle being evaluated and the resolver passed with WithResolver
+func fnResolve(ctx *evalContext, focus []item, args []node) ([]item, error) {
+	var result []item
+	for _, it := range focus {
+		reference, ok := it.value.(string)
+		if !ok {
+			for _, r := range it.children("reference") {
+				reference, ok = r.value.(string)
+			}
+		}
+		if !ok || reference == "" {
+			continue
+		}
+		result = append(result, ctx.resolve(reference)...)
+	}
+	return result, nil
+}
+
+func (ctx *evalContext) resolve(reference string) []item {
+	roots := append(append([]item{}, ctx.vars["resource"]...), ctx.vars["rootResource"]...)
+	if id, ok := strings.CutPrefix(reference, "#"); ok {
+		for _, root := range roots {
+			for _, contained := range root.children("contained") {
+				if idOf(contained) == id {
+					return []item{contained}
+				}
+			}
+		}
+		return nil
+	}
+	for _, root := range roots {
+		if !root.is(typeSpecifier{name: "Bundle"}) {
+			continue
+		}
+		for _, entry := range root.children("entry") {
+			resources := entry.children("resource")
+			for _, fullURL := range entry.children("fullUrl") {
+				if fullURL.value == reference && len(resources) > 0 {
+					return resources
+				}
+			}
+			for _, resource := range resources {
+				if resource.fhirType+"/"+idOf(resource) == reference || strings.HasSuffix(reference, "/"+resource.fhirType+"/"+idOf(resource)) {
+					return []item{resource}
+				}
+			}
+		}
+	}
+	if ctx.resolver != nil {
+		if resource := ctx.resolver(reference); resource != nil {
+			return toItems(resource)
+		}
+	}
+	return nil
+}
+
+func idOf(it item) string {
+	for _, id := range it.children("id") {
+		if s, ok := id.value.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
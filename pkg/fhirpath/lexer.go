@@ -0,0 +1,203 @@
+package fhirpath
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind classifies the tokens of a FHIRPath expression
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenDelimited // `identifier`
+	tokenString
+	tokenNumber
+	tokenDateTime // @2012-04-15T10:00:00Z, @2012, @T12:00
+	tokenConstant // %name, %`name`, %'name'
+	tokenSymbol
+)
+
+// token is a lexical unit of an expression
+type token struct {
+	kind  tokenKind
+	text  string
+	value string // unescaped content of strings, delimited identifiers and constants
+	pos   int
+}
+
+// symbols lists the operator and punctuation tokens, longest first
+var symbols = []string{"<=", ">=", "!=", "!~", "(", ")", "[", "]", "{", "}", ".", ",", "+", "-", "*", "/", "&", "|", "=", "~", "<", ">"}
+
+// lex splits an expression into tokens
+func lex(src string) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("fhirpath: unterminated comment at %d", i)
+			}
+			i += end + 4
+		case c == '\'' || c == '"' || c == '`':
+			// double quotes are not FHIRPath, but some core invariants use them for strings
+			value, n, err := lexQuoted(src[i:], c)
+			if err != nil {
+				return nil, fmt.Errorf("fhirpath: %v at %d", err, i)
+			}
+			kind := tokenString
+			if c == '`' {
+				kind = tokenDelimited
+			}
+			tokens = append(tokens, token{kind: kind, text: src[i : i+n], value: value, pos: i})
+			i += n
+		case c == '%':
+			start := i
+			i++
+			if i < len(src) && (src[i] == '\'' || src[i] == '`') {
+				value, n, err := lexQuoted(src[i:], src[i])
+				if err != nil {
+					return nil, fmt.Errorf("fhirpath: %v at %d", err, i)
+				}
+				i += n
+				tokens = append(tokens, token{kind: tokenConstant, text: src[start:i], value: value, pos: start})
+				continue
+			}
+			n := identifierLength(src[i:])
+			if n == 0 {
+				return nil, fmt.Errorf("fhirpath: invalid constant at %d", start)
+			}
+			i += n
+			tokens = append(tokens, token{kind: tokenConstant, text: src[start:i], value: src[start+1 : i], pos: start})
+		case c == '@':
+			n := dateTimeLength(src[i+1:])
+			if n == 0 {
+				return nil, fmt.Errorf("fhirpath: invalid date/time literal at %d", i)
+			}
+			tokens = append(tokens, token{kind: tokenDateTime, text: src[i : i+1+n], value: src[i+1 : i+1+n], pos: i})
+			i += 1 + n
+		case c >= '0' && c <= '9':
+			start := i
+			for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+				i++
+			}
+			if i+1 < len(src) && src[i] == '.' && src[i+1] >= '0' && src[i+1] <= '9' {
+				i++
+				for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+					i++
+				}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: src[start:i], value: src[start:i], pos: start})
+		case c == '$' || c == '_' || unicode.IsLetter(rune(c)):
+			start := i
+			if c == '$' {
+				i++
+			}
+			n := identifierLength(src[i:])
+			if n == 0 {
+				return nil, fmt.Errorf("fhirpath: invalid identifier at %d", start)
+			}
+			i += n
+			tokens = append(tokens, token{kind: tokenIdentifier, text: src[start:i], value: src[start:i], pos: start})
+		default:
+			matched := false
+			for _, symbol := range symbols {
+				if strings.HasPrefix(src[i:], symbol) {
+					tokens = append(tokens, token{kind: tokenSymbol, text: symbol, value: symbol, pos: i})
+					i += len(symbol)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("fhirpath: unexpected character %q at %d", c, i)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(src)}), nil
+}
+
+func identifierLength(s string) int {
+	n := 0
+	for n < len(s) {
+		c := s[n]
+		if c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || n > 0 && c >= '0' && c <= '9' {
+			n++
+			continue
+		}
+		break
+	}
+	return n
+}
+
+// dateTimeLength returns the length of the date/time literal at the start of s
+func dateTimeLength(s string) int {
+	n := 0
+	for n < len(s) {
+		c := s[n]
+		switch {
+		case c >= '0' && c <= '9', c == '-', c == ':', c == 'T', c == 'Z':
+			n++
+		case c == '.' && n+1 < len(s) && s[n+1] >= '0' && s[n+1] <= '9':
+			n++
+		case c == '+' && n > 0 && strings.Contains(s[:n], "T"):
+			n++
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// lexQuoted reads a string or delimited identifier and resolves its escapes
+func lexQuoted(s string, quote byte) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if c == quote {
+			return b.String(), i + 1, nil
+		}
+		if c != '\\' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i >= len(s) {
+			break
+		}
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 >= len(s) {
+				return "", 0, fmt.Errorf("invalid unicode escape")
+			}
+			var r rune
+			if _, err := fmt.Sscanf(s[i+1:i+5], "%04x", &r); err != nil {
+				return "", 0, fmt.Errorf("invalid unicode escape")
+			}
+			b.WriteRune(r)
+			i += 4
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated %c", quote)
+}
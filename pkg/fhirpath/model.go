@@ -0,0 +1,472 @@
+package fhirpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// The evaluator navigates the structs of the version packages by their JSON
+// names. A choice element such as Observation.value[x] is found by its name
+// without the type suffix, and the suffix determines the FHIR type of the value.
+// Content without struct, such as raw resources, is navigated as generic JSON.
+
+// item is a single value of a collection
+type item struct {
+	// value is a system value: bool, int64, common.Decimal, string, temporal,
+	// Quantity or typeSpecifier. It is nil for complex values and for primitives
+	// that only carry extensions.
+	value interface{}
+
+	// node is the struct of a complex value or resource, or the generic JSON object
+	node reflect.Value
+
+	// element carries the id and extensions of a primitive
+	element *common.Element
+
+	// fhirType is the FHIR type name, e.g. "HumanName", "Patient" or "dateTime",
+	// empty for system values
+	fhirType string
+
+	// inferred marks a string or integer whose FHIR type is only known from the
+	// Go type, it may be any of the types derived from it, e.g. uri or code
+	inferred bool
+}
+
+// structField is a JSON property of a struct
+type structField struct {
+	index   []int
+	element []int // index of the _name companion, nil if there is none
+}
+
+// structInfo lists the JSON properties of a struct type in declaration order
+type structInfo struct {
+	names  []string
+	fields map[string]structField
+}
+
+var structInfos sync.Map
+
+// fieldsOf returns the JSON properties of a struct type, including those of
+// embedded structs, where the shallowest declaration wins
+func fieldsOf(t reflect.Type) *structInfo {
+	if info, ok := structInfos.Load(t); ok {
+		return info.(*structInfo)
+	}
+	info := &structInfo{fields: map[string]structField{}}
+	companions := map[string][]int{}
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		var embedded []reflect.StructField
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldIndex := append(append([]int{}, index...), i)
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				field.Index = fieldIndex
+				embedded = append(embedded, field)
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "" || name == "-" || name == "resourceType" && field.Type.Kind() == reflect.String {
+				continue
+			}
+			if companion, ok := strings.CutPrefix(name, "_"); ok {
+				if _, exists := companions[companion]; !exists {
+					companions[companion] = fieldIndex
+				}
+				continue
+			}
+			if _, exists := info.fields[name]; !exists {
+				info.fields[name] = structField{index: fieldIndex}
+				info.names = append(info.names, name)
+			}
+		}
+		for _, field := range embedded {
+			walk(field.Type, field.Index)
+		}
+	}
+	walk(t, nil)
+	for name, index := range companions {
+		if field, ok := info.fields[name]; ok {
+			field.element = index
+			info.fields[name] = field
+		}
+	}
+	actual, _ := structInfos.LoadOrStore(t, info)
+	return actual.(*structInfo)
+}
+
+// newItems converts a Go value into items, fhirType is the FHIR type declared
+// by a choice suffix, or empty to derive it from the Go type
+func newItems(v reflect.Value, element reflect.Value, fhirType string) []item {
+	for v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.IsValid() && v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		var items []item
+		for i := 0; i < v.Len(); i++ {
+			var e reflect.Value
+			if element.IsValid() && element.Kind() == reflect.Slice && i < element.Len() {
+				e = element.Index(i)
+			}
+			items = append(items, newItems(v.Index(i), e, fhirType)...)
+		}
+		if element.IsValid() && element.Kind() == reflect.Slice {
+			for i := v.Len(); i < element.Len(); i++ {
+				items = append(items, newItems(reflect.Value{}, element.Index(i), fhirType)...)
+			}
+		}
+		return items
+	}
+
+	var el *common.Element
+	if element.IsValid() && element.Kind() == reflect.Pointer && !element.IsNil() {
+		el = element.Interface().(*common.Element)
+	}
+	if !v.IsValid() || (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface || v.Kind() == reflect.Map) && v.IsNil() {
+		if el != nil {
+			return []item{{element: el, fhirType: fhirType}}
+		}
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	it := item{element: el, fhirType: fhirType}
+	switch value := v.Interface().(type) {
+	case common.Decimal:
+		it.value = value
+		it.fhirType = orType(fhirType, "decimal")
+	case common.Date:
+		it.value, _ = temporalFromValue(value)
+		it.fhirType = orType(fhirType, "date")
+	case common.DateTime:
+		it.value, _ = temporalFromValue(value)
+		it.fhirType = orType(fhirType, "dateTime")
+	case common.Instant:
+		it.value, _ = temporalFromValue(value)
+		it.fhirType = orType(fhirType, "instant")
+	case common.Time:
+		it.value, _ = temporalFromValue(value)
+		it.fhirType = orType(fhirType, "time")
+	case common.RawResource:
+		var generic map[string]interface{}
+		if json.Unmarshal(value.Data, &generic) != nil {
+			return nil
+		}
+		it.node = reflect.ValueOf(generic)
+		it.fhirType = value.ResourceType
+	default:
+		switch v.Kind() {
+		case reflect.String:
+			it.value = v.String()
+			if v.Type().Name() != "string" {
+				it.fhirType = orType(fhirType, "code")
+			} else {
+				it.fhirType, it.inferred = orType(fhirType, "string"), fhirType == ""
+			}
+		case reflect.Bool:
+			it.value = v.Bool()
+			it.fhirType = orType(fhirType, "boolean")
+		case reflect.Int, reflect.Int32:
+			it.value = v.Int()
+			it.fhirType, it.inferred = orType(fhirType, "integer"), fhirType == ""
+		case reflect.Int64:
+			it.value = v.Int()
+			it.fhirType = orType(fhirType, "integer64")
+		case reflect.Float64, reflect.Float32:
+			it.value = common.DecimalFromFloat64(v.Float())
+			it.fhirType = orType(fhirType, "decimal")
+		case reflect.Map:
+			it.node = v
+		case reflect.Struct:
+			it.node = v
+			if r, ok := v.Addr().Interface().(common.Resource); ok {
+				it.fhirType = r.GetResourceType()
+			} else {
+				it.fhirType = orType(fhirType, v.Type().Name())
+			}
+		default:
+			return nil
+		}
+	}
+	return []item{it}
+}
+
+func orType(declared, derived string) string {
+	if declared != "" {
+		return declared
+	}
+	return derived
+}
+
+// addressable returns an addressable copy of a struct value if needed
+func addressable(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Struct && !v.CanAddr() {
+		copied := reflect.New(v.Type()).Elem()
+		copied.Set(v)
+		return copied
+	}
+	return v
+}
+
+// children returns the children named name of an item
+func (it item) children(name string) []item {
+	if !it.node.IsValid() {
+		if it.element == nil {
+			return nil
+		}
+		return item{node: reflect.ValueOf(it.element).Elem(), fhirType: "Element"}.children(name)
+	}
+	if it.node.Kind() == reflect.Map {
+		return genericChildren(it.node.Interface().(map[string]interface{}), name)
+	}
+
+	node := addressable(it.node)
+	info := fieldsOf(node.Type())
+	if field, ok := info.fields[name]; ok {
+		var element reflect.Value
+		if field.element != nil {
+			element = node.FieldByIndex(field.element)
+		}
+		return newItems(node.FieldByIndex(field.index), element, "")
+	}
+
+	// choice element, e.g. value for valueQuantity
+	for _, fieldName := range info.names {
+		typeName, ok := strings.CutPrefix(fieldName, name)
+		if !ok || typeName == "" || typeName[0] < 'A' || typeName[0] > 'Z' {
+			continue
+		}
+		field := info.fields[fieldName]
+		var element reflect.Value
+		if field.element != nil {
+			element = node.FieldByIndex(field.element)
+		}
+		if items := newItems(node.FieldByIndex(field.index), element, choiceTypeName(typeName)); len(items) > 0 {
+			return items
+		}
+	}
+	return nil
+}
+
+// allChildren returns all child items of an item, in declaration order
+func (it item) allChildren() []item {
+	if !it.node.IsValid() {
+		if it.element == nil {
+			return nil
+		}
+		return item{node: reflect.ValueOf(it.element).Elem(), fhirType: "Element"}.allChildren()
+	}
+	if it.node.Kind() == reflect.Map {
+		var result []item
+		generic := it.node.Interface().(map[string]interface{})
+		for _, key := range sortedKeys(generic) {
+			if key != "resourceType" && !strings.HasPrefix(key, "_") {
+				result = append(result, genericChildren(generic, key)...)
+			}
+		}
+		return result
+	}
+	node := addressable(it.node)
+	info := fieldsOf(node.Type())
+	var result []item
+	for _, name := range info.names {
+		field := info.fields[name]
+		var element reflect.Value
+		if field.element != nil {
+			element = node.FieldByIndex(field.element)
+		}
+		result = append(result, newItems(node.FieldByIndex(field.index), element, "")...)
+	}
+	return result
+}
+
+// choiceTypeName converts a choice suffix into a FHIR type name, primitive
+// types start with a lower case letter
+func choiceTypeName(suffix string) string {
+	switch suffix {
+	case "Base64Binary", "Boolean", "Canonical", "Code", "Date", "DateTime", "Decimal", "Id", "Instant", "Integer",
+		"Integer64", "Markdown", "Oid", "PositiveInt", "String", "Time", "UnsignedInt", "Uri", "Url", "Uuid":
+		return strings.ToLower(suffix[:1]) + suffix[1:]
+	}
+	return suffix
+}
+
+// genericChildren navigates generic JSON, where the types of values are unknown
+func genericChildren(object map[string]interface{}, name string) []item {
+	value, ok := object[name]
+	if !ok {
+		for _, key := range sortedKeys(object) {
+			if typeName, found := strings.CutPrefix(key, name); found && typeName != "" && typeName[0] >= 'A' && typeName[0] <= 'Z' {
+				return genericItems(object[key], choiceTypeName(typeName))
+			}
+		}
+		return nil
+	}
+	return genericItems(value, "")
+}
+
+func genericItems(value interface{}, fhirType string) []item {
+	switch v := value.(type) {
+	case []interface{}:
+		var items []item
+		for _, e := range v {
+			items = append(items, genericItems(e, fhirType)...)
+		}
+		return items
+	case map[string]interface{}:
+		if resourceType, ok := v["resourceType"].(string); ok {
+			fhirType = resourceType
+		}
+		return []item{{node: reflect.ValueOf(v), fhirType: fhirType}}
+	case string:
+		if t, err := parseDateTime(v); err == nil && (fhirType == "date" || fhirType == "dateTime" || fhirType == "instant") {
+			return []item{{value: t, fhirType: fhirType}}
+		}
+		return []item{{value: v, fhirType: orType(fhirType, "string"), inferred: fhirType == ""}}
+	case bool:
+		return []item{{value: v, fhirType: "boolean"}}
+	case float64:
+		if v == float64(int64(v)) && fhirType != "decimal" {
+			return []item{{value: int64(v), fhirType: orType(fhirType, "integer"), inferred: fhirType == ""}}
+		}
+		return []item{{value: common.DecimalFromFloat64(v), fhirType: "decimal"}}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// fhirTypeHierarchy lists the base types of FHIR types, resources derive from
+// DomainResource and Resource as determined by their struct
+var fhirTypeHierarchy = map[string]string{
+	"code": "string", "id": "string", "markdown": "string",
+	"url": "uri", "canonical": "uri", "oid": "uri", "uuid": "uri",
+	"positiveInt": "integer", "unsignedInt": "integer",
+	"Age": "Quantity", "Count": "Quantity", "Distance": "Quantity", "Duration": "Quantity",
+	"SimpleQuantity": "Quantity", "MoneyQuantity": "Quantity",
+}
+
+// systemTypes maps the FHIR primitive types to the System types
+var systemTypes = map[string]string{
+	"boolean": "Boolean", "string": "String", "uri": "String", "code": "String", "id": "String", "markdown": "String",
+	"url": "String", "canonical": "String", "oid": "String", "uuid": "String", "base64Binary": "String", "xhtml": "String",
+	"integer": "Integer", "positiveInt": "Integer", "unsignedInt": "Integer", "integer64": "Long",
+	"decimal": "Decimal", "date": "Date", "dateTime": "DateTime", "instant": "DateTime", "time": "Time",
+}
+
+// systemType returns the System type of a system value
+func systemType(v interface{}) string {
+	switch v := v.(type) {
+	case bool:
+		return "Boolean"
+	case int64:
+		return "Integer"
+	case common.Decimal:
+		return "Decimal"
+	case string:
+		return "String"
+	case Quantity:
+		return "Quantity"
+	case temporal:
+		switch v.kind {
+		case kindDate:
+			return "Date"
+		case kindTime:
+			return "Time"
+		}
+		return "DateTime"
+	}
+	return ""
+}
+
+// is reports whether the item is of the type or one of its subtypes
+func (it item) is(t typeSpecifier) bool {
+	if it.fhirType == "" || t.namespace == "System" {
+		if t.namespace == "FHIR" {
+			return false
+		}
+		return systemType(it.value) == t.name && it.fhirType == "" ||
+			t.namespace == "System" && it.fhirType != "" && systemTypes[it.fhirType] == t.name
+	}
+	for typ := it.fhirType; typ != ""; typ = fhirTypeHierarchy[typ] {
+		if typ == t.name {
+			return true
+		}
+	}
+	if it.inferred && systemTypes[t.name] != "" && systemTypes[t.name] == systemTypes[it.fhirType] {
+		return true
+	}
+	if it.node.IsValid() && it.node.Kind() == reflect.Struct {
+		node := addressable(it.node)
+		if _, ok := node.Addr().Interface().(common.DomainResource); ok && t.name == "DomainResource" {
+			return true
+		}
+		if _, ok := node.Addr().Interface().(common.Resource); ok && t.name == "Resource" {
+			return true
+		}
+	}
+	if t.name == "Resource" || t.name == "DomainResource" {
+		if it.node.IsValid() && it.node.Kind() == reflect.Map {
+			_, ok := it.node.Interface().(map[string]interface{})["resourceType"]
+			return ok
+		}
+	}
+	return false
+}
+
+// isResource reports whether the item is a resource
+func (it item) isResource() bool {
+	return it.is(typeSpecifier{name: "Resource"})
+}
+
+// quantity converts the item into a System.Quantity, FHIR Quantity structs included
+func (it item) quantity() (Quantity, bool) {
+	if q, ok := it.value.(Quantity); ok {
+		return q, true
+	}
+	if !it.node.IsValid() || it.node.Kind() != reflect.Struct {
+		return Quantity{}, false
+	}
+	node := addressable(it.node)
+	info := fieldsOf(node.Type())
+	get := func(name string) reflect.Value {
+		if field, ok := info.fields[name]; ok {
+			return node.FieldByIndex(field.index)
+		}
+		return reflect.Value{}
+	}
+	v := get("value")
+	if !v.IsValid() {
+		return Quantity{}, false
+	}
+	value, ok := v.Interface().(*common.Decimal)
+	if !ok {
+		return Quantity{}, false
+	}
+	str := func(name string) *string {
+		if v := get(name); v.IsValid() {
+			if s, ok := v.Interface().(*string); ok {
+				return s
+			}
+		}
+		return nil
+	}
+	return quantityFromCommon(value, str("unit"), str("system"), str("code"))
+}
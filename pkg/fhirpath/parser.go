@@ -0,0 +1,367 @@
+package fhirpath
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// node is an element of the syntax tree of an expression
+type node interface {
+	eval(ctx *evalContext, focus []item) ([]item, error)
+}
+
+// literalNode is a constant value, {} is an empty literal
+type literalNode struct {
+	items []item
+}
+
+// memberNode navigates to the children named name of the focus
+type memberNode struct {
+	name string
+
+	// root marks an identifier at the start of a path, which may name the type of the context
+	root bool
+}
+
+// functionNode calls a function on the focus
+type functionNode struct {
+	name string
+	args []node
+}
+
+// invocationNode evaluates member on the result of target
+type invocationNode struct {
+	target node
+	member node
+}
+
+// indexerNode selects the item at an index, e.g. name[0]
+type indexerNode struct {
+	target node
+	index  node
+}
+
+// unaryNode is a polarity operator
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+// binaryNode is an infix operator
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+// typeNode is the is or as operator
+type typeNode struct {
+	op      string
+	operand node
+	typ     typeSpecifier
+}
+
+// variableNode is $this, $index, $total or an external constant such as %resource
+type variableNode struct {
+	name string
+}
+
+// typeSpecifier is a type name, optionally qualified by FHIR or System
+type typeSpecifier struct {
+	namespace string
+	name      string
+}
+
+func (t typeSpecifier) String() string {
+	if t.namespace == "" {
+		return t.name
+	}
+	return t.namespace + "." + t.name
+}
+
+// parser is a precedence climbing parser for FHIRPath
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// operator precedence, from loosest to tightest binding
+var precedence = map[string]int{
+	"implies": 1,
+	"or":      2, "xor": 2,
+	"and": 3,
+	"in":  4, "contains": 4,
+	"=": 5, "~": 5, "!=": 5, "!~": 5,
+	"<=": 6, "<": 6, ">": 6, ">=": 6,
+	"|":  7,
+	"is": 8, "as": 8,
+	"+": 9, "-": 9, "&": 9,
+	"*": 10, "/": 10, "div": 10, "mod": 10,
+}
+
+// calendarUnits are the time-valued quantity units that can be written without quotes
+var calendarUnits = map[string]string{
+	"year": "year", "years": "year", "month": "month", "months": "month", "week": "week", "weeks": "week",
+	"day": "day", "days": "day", "hour": "hour", "hours": "hour", "minute": "minute", "minutes": "minute",
+	"second": "second", "seconds": "second", "millisecond": "millisecond", "milliseconds": "millisecond",
+}
+
+func parse(src string) (node, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	n, err := p.expression(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("fhirpath: unexpected %q at %d", t.text, t.pos)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(symbol string) error {
+	if t := p.next(); t.kind != tokenSymbol || t.text != symbol {
+		return fmt.Errorf("fhirpath: expected %q at %d, found %q", symbol, t.pos, t.text)
+	}
+	return nil
+}
+
+// operator returns the binary operator at the current position, if any
+func (p *parser) operator() (string, bool) {
+	t := p.peek()
+	switch t.kind {
+	case tokenSymbol:
+		_, ok := precedence[t.text]
+		return t.text, ok
+	case tokenIdentifier:
+		_, ok := precedence[t.text]
+		return t.text, ok
+	}
+	return "", false
+}
+
+func (p *parser) expression(minPrecedence int) (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.operator()
+		if !ok || precedence[op] <= minPrecedence {
+			return left, nil
+		}
+		p.next()
+		if op == "is" || op == "as" {
+			typ, err := p.typeSpecifier()
+			if err != nil {
+				return nil, err
+			}
+			left = &typeNode{op: op, operand: left, typ: typ}
+			continue
+		}
+		right, err := p.expression(precedence[op])
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	if t := p.peek(); t.kind == tokenSymbol && (t.text == "+" || t.text == "-") {
+		p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: t.text, operand: operand}, nil
+	}
+	return p.postfix()
+}
+
+func (p *parser) postfix() (node, error) {
+	n, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokenSymbol {
+			return n, nil
+		}
+		switch t.text {
+		case ".":
+			p.next()
+			member, err := p.invocation(false)
+			if err != nil {
+				return nil, err
+			}
+			n = &invocationNode{target: n, member: member}
+		case "[":
+			p.next()
+			index, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			n = &indexerNode{target: n, index: index}
+		default:
+			return n, nil
+		}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokenString:
+		p.next()
+		return &literalNode{items: []item{{value: t.value}}}, nil
+	case tokenNumber:
+		p.next()
+		return p.number(t)
+	case tokenDateTime:
+		p.next()
+		value, err := parseTemporalLiteral(t.value)
+		if err != nil {
+			return nil, fmt.Errorf("fhirpath: %v at %d", err, t.pos)
+		}
+		return &literalNode{items: []item{{value: value}}}, nil
+	case tokenConstant:
+		p.next()
+		return &variableNode{name: "%" + t.value}, nil
+	case tokenSymbol:
+		switch t.text {
+		case "(":
+			p.next()
+			n, err := p.expression(0)
+			if err != nil {
+				return nil, err
+			}
+			return n, p.expect(")")
+		case "{":
+			p.next()
+			return &literalNode{}, p.expect("}")
+		}
+	case tokenIdentifier:
+		switch t.text {
+		case "true", "false":
+			p.next()
+			return &literalNode{items: []item{{value: t.text == "true"}}}, nil
+		}
+	}
+	return p.invocation(true)
+}
+
+// number parses an integer, decimal or quantity literal
+func (p *parser) number(t token) (node, error) {
+	value := common.MustParseDecimal(t.value)
+	unit := ""
+	switch next := p.peek(); {
+	case next.kind == tokenString:
+		p.next()
+		unit = next.value
+	case next.kind == tokenIdentifier && calendarUnits[next.text] != "":
+		p.next()
+		unit = calendarUnits[next.text]
+	}
+	if unit != "" {
+		return &literalNode{items: []item{{value: Quantity{Value: value, Unit: unit}}}}, nil
+	}
+	if !strings.Contains(t.value, ".") {
+		var i int64
+		if _, err := fmt.Sscan(t.value, &i); err != nil {
+			return nil, fmt.Errorf("fhirpath: invalid integer %s at %d", t.value, t.pos)
+		}
+		return &literalNode{items: []item{{value: i}}}, nil
+	}
+	return &literalNode{items: []item{{value: value}}}, nil
+}
+
+// invocation parses an identifier, a function call or $this, $index and $total
+func (p *parser) invocation(root bool) (node, error) {
+	t := p.next()
+	var name string
+	switch t.kind {
+	case tokenIdentifier:
+		name = t.text
+	case tokenDelimited:
+		name = t.value
+	default:
+		return nil, fmt.Errorf("fhirpath: unexpected %q at %d", t.text, t.pos)
+	}
+	if strings.HasPrefix(name, "$") {
+		switch name {
+		case "$this", "$index", "$total":
+			return &variableNode{name: name}, nil
+		}
+		return nil, fmt.Errorf("fhirpath: unknown variable %s at %d", name, t.pos)
+	}
+	if next := p.peek(); t.kind == tokenIdentifier && next.kind == tokenSymbol && next.text == "(" {
+		p.next()
+		fn := &functionNode{name: name}
+		if next := p.peek(); next.kind == tokenSymbol && next.text == ")" {
+			p.next()
+			return fn, nil
+		}
+		for {
+			if fn.name == "is" || fn.name == "as" || fn.name == "ofType" {
+				typ, err := p.typeSpecifier()
+				if err != nil {
+					return nil, err
+				}
+				fn.args = append(fn.args, &literalNode{items: []item{{value: typ}}})
+			} else {
+				arg, err := p.expression(0)
+				if err != nil {
+					return nil, err
+				}
+				fn.args = append(fn.args, arg)
+			}
+			sep := p.next()
+			if sep.kind == tokenSymbol && sep.text == ")" {
+				return fn, nil
+			}
+			if sep.kind != tokenSymbol || sep.text != "," {
+				return nil, fmt.Errorf("fhirpath: expected \",\" or \")\" at %d", sep.pos)
+			}
+		}
+	}
+	return &memberNode{name: name, root: root}, nil
+}
+
+// typeSpecifier parses a possibly qualified type name
+func (p *parser) typeSpecifier() (typeSpecifier, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier && t.kind != tokenDelimited {
+		return typeSpecifier{}, fmt.Errorf("fhirpath: expected type name at %d", t.pos)
+	}
+	name := t.value
+	if next := p.peek(); next.kind == tokenSymbol && next.text == "." {
+		p.next()
+		qualified := p.next()
+		if qualified.kind != tokenIdentifier && qualified.kind != tokenDelimited {
+			return typeSpecifier{}, fmt.Errorf("fhirpath: expected type name at %d", qualified.pos)
+		}
+		return typeSpecifier{namespace: name, name: qualified.value}, nil
+	}
+	return typeSpecifier{name: name}, nil
+}
@@ -0,0 +1,281 @@
+package fhirpath
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// Quantity is a System.Quantity: a decimal value with a UCUM unit, e.g. "mg",
+// or a calendar duration keyword such as "year" or "day"
+type Quantity struct {
+	Value common.Decimal
+	Unit  string
+}
+
+// String returns the FHIRPath literal of the quantity
+func (q Quantity) String() string {
+	if _, ok := calendarUnits[q.Unit]; ok {
+		return q.Value.String() + " " + q.Unit
+	}
+	return q.Value.String() + " '" + q.Unit + "'"
+}
+
+// Units are compared and converted with a small subset of UCUM: the metric
+// prefixes, the base units of mass, length, time, amount of substance and
+// volume, a few derived and customary units, and products and quotients of
+// them with exponents, e.g. "mg/dL" or "km/h". Units outside the subset are
+// comparable only with themselves.
+
+// dimension maps a base unit to its exponent
+type dimension map[string]int
+
+// unitAtom is a unit symbol with its factor to the base units
+type unitAtom struct {
+	factor *big.Rat
+	dims   dimension
+	metric bool
+}
+
+func rat(s string) *big.Rat {
+	r, _ := new(big.Rat).SetString(s)
+	return r
+}
+
+var unitAtoms = map[string]unitAtom{
+	"1":       {rat("1"), dimension{}, false},
+	"%":       {rat("1/100"), dimension{}, false},
+	"g":       {rat("1"), dimension{"g": 1}, true},
+	"m":       {rat("1"), dimension{"m": 1}, true},
+	"s":       {rat("1"), dimension{"s": 1}, true},
+	"mol":     {rat("1"), dimension{"mol": 1}, true},
+	"K":       {rat("1"), dimension{"K": 1}, true},
+	"L":       {rat("1/1000"), dimension{"m": 3}, true},
+	"l":       {rat("1/1000"), dimension{"m": 3}, true},
+	"min":     {rat("60"), dimension{"s": 1}, false},
+	"h":       {rat("3600"), dimension{"s": 1}, false},
+	"d":       {rat("86400"), dimension{"s": 1}, false},
+	"wk":      {rat("604800"), dimension{"s": 1}, false},
+	"mo":      {rat("2629800"), dimension{"s": 1}, false},
+	"a":       {rat("31557600"), dimension{"s": 1}, false},
+	"Pa":      {rat("1000"), dimension{"g": 1, "m": -1, "s": -2}, true},
+	"N":       {rat("1000"), dimension{"g": 1, "m": 1, "s": -2}, true},
+	"J":       {rat("1000"), dimension{"g": 1, "m": 2, "s": -2}, true},
+	"W":       {rat("1000"), dimension{"g": 1, "m": 2, "s": -3}, true},
+	"Hz":      {rat("1"), dimension{"s": -1}, true},
+	"[lb_av]": {rat("45359237/100000"), dimension{"g": 1}, false},
+	"[oz_av]": {rat("45359237/1600000"), dimension{"g": 1}, false},
+	"[in_i]":  {rat("127/5000"), dimension{"m": 1}, false},
+	"[ft_i]":  {rat("381/1250"), dimension{"m": 1}, false},
+	"[mi_i]":  {rat("201168/125"), dimension{"m": 1}, false},
+	"mm[Hg]":  {rat("133322387415/1000000"), dimension{"g": 1, "m": -1, "s": -2}, false},
+}
+
+var unitPrefixes = map[string]string{
+	"Y": "1e24", "Z": "1e21", "E": "1e18", "P": "1e15", "T": "1e12", "G": "1e9", "M": "1e6", "k": "1e3", "h": "1e2",
+	"da": "1e1", "d": "1e-1", "c": "1e-2", "m": "1e-3", "u": "1e-6", "n": "1e-9", "p": "1e-12", "f": "1e-15",
+}
+
+// calendarEquivalents are the UCUM units equal to the calendar durations of fixed length
+var calendarEquivalents = map[string]string{
+	"week": "wk", "day": "d", "hour": "h", "minute": "min", "second": "s", "millisecond": "ms",
+}
+
+// parsedUnit is a unit reduced to a factor and the base units
+type parsedUnit struct {
+	factor *big.Rat
+	dims   dimension
+}
+
+// parseUnit reduces a UCUM unit expression, ok is false for unknown units
+func parseUnit(unit string) (parsedUnit, bool) {
+	if equivalent, ok := calendarEquivalents[unit]; ok {
+		unit = equivalent
+	}
+	result := parsedUnit{factor: big.NewRat(1, 1), dims: dimension{}}
+	if unit == "" {
+		return result, true
+	}
+	sign := 1
+	for len(unit) > 0 {
+		end := strings.IndexAny(unit, "./")
+		term := unit
+		if end >= 0 {
+			term = unit[:end]
+		}
+		factor, dims, ok := parseUnitTerm(term)
+		if !ok {
+			return parsedUnit{}, false
+		}
+		if sign < 0 {
+			factor.Inv(factor)
+		}
+		result.factor.Mul(result.factor, factor)
+		for d, e := range dims {
+			result.dims[d] += sign * e
+		}
+		if end < 0 {
+			break
+		}
+		if unit[end] == '/' {
+			sign = -1
+		} else {
+			sign = 1
+		}
+		unit = unit[end+1:]
+	}
+	for d, e := range result.dims {
+		if e == 0 {
+			delete(result.dims, d)
+		}
+	}
+	return result, true
+}
+
+// parseUnitTerm parses a prefixed unit atom with an optional exponent, e.g. "cm2"
+func parseUnitTerm(term string) (*big.Rat, dimension, bool) {
+	if strings.HasPrefix(term, "10*") || strings.HasPrefix(term, "10^") {
+		exponent, err := strconv.Atoi(term[3:])
+		if err != nil {
+			return nil, nil, false
+		}
+		return rat(fmt.Sprintf("1e%d", exponent)), dimension{}, true
+	}
+	body, exponent := term, 1
+	i := len(body)
+	for i > 0 && (body[i-1] >= '0' && body[i-1] <= '9' || body[i-1] == '-') {
+		i--
+	}
+	if i > 0 && i < len(body) {
+		if e, err := strconv.Atoi(body[i:]); err == nil {
+			body, exponent = body[:i], e
+		}
+	}
+
+	atom, ok := unitAtoms[body]
+	factor := new(big.Rat)
+	if ok {
+		factor.Set(atom.factor)
+	} else {
+		found := false
+		for prefix, value := range unitPrefixes {
+			atom, ok = unitAtoms[strings.TrimPrefix(body, prefix)]
+			if strings.HasPrefix(body, prefix) && ok && atom.metric {
+				factor.Mul(rat(value), atom.factor)
+				found = true
+				break
+			}
+		}
+		if !found {
+			if body == "" {
+				return nil, nil, false
+			}
+			// unknown units only match themselves
+			return big.NewRat(1, 1), dimension{body: exponent}, true
+		}
+	}
+
+	result := big.NewRat(1, 1)
+	for i := 0; i < abs(exponent); i++ {
+		result.Mul(result, factor)
+	}
+	if exponent < 0 {
+		result.Inv(result)
+	}
+	dims := dimension{}
+	for d, e := range atom.dims {
+		dims[d] = e * exponent
+	}
+	return result, dims, true
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+func (d dimension) key() string {
+	keys := make([]string, 0, len(d))
+	for k, e := range d {
+		keys = append(keys, fmt.Sprintf("%s^%d", k, e))
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ".")
+}
+
+// normalize converts the quantity to the base units, ok is false for calendar
+// years and months and for unknown units
+func (q Quantity) normalize() (*big.Rat, string, bool) {
+	if q.Unit == "year" || q.Unit == "month" {
+		return q.Value.Rat(), q.Unit, true
+	}
+	unit, ok := parseUnit(q.Unit)
+	if !ok {
+		return nil, "", false
+	}
+	return new(big.Rat).Mul(q.Value.Rat(), unit.factor), unit.dims.key(), true
+}
+
+// compareQuantities compares two quantities, ok is false if the units are not comparable
+func compareQuantities(a, b Quantity) (int, bool) {
+	if a.Unit == b.Unit {
+		return a.Value.Cmp(b.Value), true
+	}
+	av, ad, aok := a.normalize()
+	bv, bd, bok := b.normalize()
+	if !aok || !bok || ad != bd {
+		return 0, false
+	}
+	return av.Cmp(bv), true
+}
+
+// convertQuantity expresses q in unit, ok is false if the units are not comparable
+func convertQuantity(q Quantity, unit string) (Quantity, bool) {
+	if q.Unit == unit {
+		return q, true
+	}
+	value, dims, ok := q.normalize()
+	target, ok2 := parseUnit(unit)
+	if !ok || !ok2 || dims != target.dims.key() || unit == "year" || unit == "month" {
+		return Quantity{}, false
+	}
+	converted := new(big.Rat).Quo(value, target.factor)
+	scale := max(q.Value.Scale(), 8)
+	d, _ := common.ParseDecimal(trimDecimal(converted.FloatString(scale)))
+	return Quantity{Value: d, Unit: unit}, true
+}
+
+// trimDecimal removes trailing zeros after the decimal point
+func trimDecimal(s string) string {
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// quantityFromCommon converts a FHIR Quantity, preferring the UCUM code over the unit
+func quantityFromCommon(value *common.Decimal, unit, system, code *string) (Quantity, bool) {
+	if value == nil {
+		return Quantity{}, false
+	}
+	q := Quantity{Value: *value, Unit: "1"}
+	switch {
+	case code != nil && (system == nil || *system == "http://unitsofmeasure.org"):
+		q.Unit = *code
+	case unit != nil:
+		q.Unit = *unit
+	}
+	if q.Unit == "" {
+		q.Unit = "1"
+	}
+	return q, true
+}
@@ -0,0 +1,380 @@
+package fhirpath
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// FHIRPath dates and times may be partial down to the year or hour and may omit
+// the timezone. Comparison is defined on the components up to the precision of
+// both operands and is empty where one operand is more precise than the other.
+
+// precision is the finest component of a temporal value
+type precision int
+
+const (
+	precisionYear precision = iota
+	precisionMonth
+	precisionDay
+	precisionHour
+	precisionMinute
+	precisionSecond
+	precisionMillisecond
+)
+
+// temporalKind distinguishes System.Date, System.DateTime and System.Time
+type temporalKind int
+
+const (
+	kindDate temporalKind = iota
+	kindDateTime
+	kindTime
+)
+
+// temporal is a System.Date, System.DateTime or System.Time value
+type temporal struct {
+	kind                 temporalKind
+	year, month, day     int
+	hour, minute, second int
+	nanos                int
+	precision            precision
+
+	// zone is nil if the value has no timezone
+	zone *time.Location
+}
+
+var (
+	dateTimeLiteral = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?)?(T(?:(\d{2})(?::(\d{2})(?::(\d{2})(?:\.(\d+))?)?)?)?(Z|[+-]\d{2}:\d{2})?)?$`)
+	timeLiteral     = regexp.MustCompile(`^T?(\d{2})(?::(\d{2})(?::(\d{2})(?:\.(\d+))?)?)?$`)
+)
+
+// parseTemporalLiteral parses the text of a @ literal
+func parseTemporalLiteral(s string) (temporal, error) {
+	if strings.HasPrefix(s, "T") {
+		return parseTime(s)
+	}
+	return parseDateTime(s)
+}
+
+// parseDateTime parses a date or dateTime, a value with a T is a dateTime
+func parseDateTime(s string) (temporal, error) {
+	m := dateTimeLiteral.FindStringSubmatch(s)
+	if m == nil {
+		return temporal{}, fmt.Errorf("invalid date/time %q", s)
+	}
+	t := temporal{kind: kindDate, month: 1, day: 1, precision: precisionYear}
+	t.year, _ = strconv.Atoi(m[1])
+	if m[2] != "" {
+		t.month, _ = strconv.Atoi(m[2])
+		t.precision = precisionMonth
+	}
+	if m[3] != "" {
+		t.day, _ = strconv.Atoi(m[3])
+		t.precision = precisionDay
+	}
+	if m[4] != "" {
+		t.kind = kindDateTime
+	}
+	if m[5] != "" {
+		t.hour, _ = strconv.Atoi(m[5])
+		t.precision = precisionHour
+	}
+	if m[6] != "" {
+		t.minute, _ = strconv.Atoi(m[6])
+		t.precision = precisionMinute
+	}
+	if m[7] != "" {
+		t.second, _ = strconv.Atoi(m[7])
+		t.precision = precisionSecond
+	}
+	if m[8] != "" {
+		t.nanos, _ = strconv.Atoi((m[8] + "000000000")[:9])
+		t.precision = precisionMillisecond
+	}
+	switch zone := m[9]; {
+	case zone == "Z":
+		t.zone = time.UTC
+	case zone != "":
+		hours, _ := strconv.Atoi(zone[1:3])
+		minutes, _ := strconv.Atoi(zone[4:6])
+		offset := hours*3600 + minutes*60
+		if zone[0] == '-' {
+			offset = -offset
+		}
+		t.zone = time.FixedZone(zone, offset)
+	}
+	if t.month < 1 || t.month > 12 || t.day < 1 || t.day > daysIn(t.year, t.month) || t.hour > 23 || t.minute > 59 || t.second > 60 {
+		return temporal{}, fmt.Errorf("invalid date/time %q", s)
+	}
+	return t, nil
+}
+
+// parseTime parses a time with an optional leading T
+func parseTime(s string) (temporal, error) {
+	m := timeLiteral.FindStringSubmatch(s)
+	if m == nil {
+		return temporal{}, fmt.Errorf("invalid time %q", s)
+	}
+	t := temporal{kind: kindTime, precision: precisionHour}
+	t.hour, _ = strconv.Atoi(m[1])
+	if m[2] != "" {
+		t.minute, _ = strconv.Atoi(m[2])
+		t.precision = precisionMinute
+	}
+	if m[3] != "" {
+		t.second, _ = strconv.Atoi(m[3])
+		t.precision = precisionSecond
+	}
+	if m[4] != "" {
+		t.nanos, _ = strconv.Atoi((m[4] + "000000000")[:9])
+		t.precision = precisionMillisecond
+	}
+	if t.hour > 23 || t.minute > 59 || t.second > 60 {
+		return temporal{}, fmt.Errorf("invalid time %q", s)
+	}
+	return t, nil
+}
+
+func daysIn(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// temporalFromTime returns a dateTime with millisecond precision
+func temporalFromTime(t time.Time, kind temporalKind, p precision) temporal {
+	return temporal{
+		kind: kind, year: t.Year(), month: int(t.Month()), day: t.Day(),
+		hour: t.Hour(), minute: t.Minute(), second: t.Second(), nanos: t.Nanosecond() / 1e6 * 1e6,
+		precision: p, zone: t.Location(),
+	}
+}
+
+// time returns the start of the value, values without timezone are taken as UTC
+func (t temporal) time() time.Time {
+	loc := t.zone
+	if loc == nil {
+		loc = time.UTC
+	}
+	if t.kind == kindTime {
+		return time.Date(0, 1, 1, t.hour, t.minute, t.second, t.nanos, loc)
+	}
+	return time.Date(t.year, time.Month(t.month), t.day, t.hour, t.minute, t.second, t.nanos, loc)
+}
+
+// String returns the FHIRPath lexical form without the leading @
+func (t temporal) String() string {
+	var b strings.Builder
+	if t.kind != kindTime {
+		fmt.Fprintf(&b, "%04d", t.year)
+		if t.precision >= precisionMonth {
+			fmt.Fprintf(&b, "-%02d", t.month)
+		}
+		if t.precision >= precisionDay {
+			fmt.Fprintf(&b, "-%02d", t.day)
+		}
+		if t.kind == kindDate {
+			return b.String()
+		}
+		b.WriteByte('T')
+		if t.precision < precisionHour {
+			return b.String()
+		}
+	}
+	fmt.Fprintf(&b, "%02d", t.hour)
+	if t.precision >= precisionMinute {
+		fmt.Fprintf(&b, ":%02d", t.minute)
+	}
+	if t.precision >= precisionSecond {
+		fmt.Fprintf(&b, ":%02d", t.second)
+	}
+	if t.precision >= precisionMillisecond {
+		fmt.Fprintf(&b, ".%03d", t.nanos/1e6)
+	}
+	if t.kind == kindDateTime && t.zone != nil {
+		if t.zone == time.UTC {
+			b.WriteByte('Z')
+		} else {
+			_, offset := t.time().Zone()
+			sign := '+'
+			if offset < 0 {
+				sign, offset = '-', -offset
+			}
+			fmt.Fprintf(&b, "%c%02d:%02d", sign, offset/3600, offset%3600/60)
+		}
+	}
+	return b.String()
+}
+
+// components returns the components of the value up to its precision, seconds
+// and milliseconds form a single component
+func (t temporal) components() []int {
+	if t.kind != kindTime && t.zone != nil && t.precision >= precisionHour {
+		utc := t.time().UTC()
+		t.year, t.month, t.day = utc.Year(), int(utc.Month()), utc.Day()
+		t.hour, t.minute = utc.Hour(), utc.Minute()
+	}
+	all := []int{t.year, t.month, t.day, t.hour, t.minute, t.second*1e9 + t.nanos}
+	if t.kind == kindTime {
+		all = all[3:]
+	}
+	n := int(t.precision) + 1
+	if t.precision == precisionMillisecond {
+		n--
+	}
+	if t.kind == kindTime {
+		n -= 3
+	}
+	return all[:n]
+}
+
+// compare compares two temporal values, ok is false if the result is unknown
+// because the values have different precisions
+func (t temporal) compare(other temporal) (result int, ok bool) {
+	if (t.kind == kindTime) != (other.kind == kindTime) {
+		return 0, false
+	}
+	a, b := t.components(), other.components()
+	for i := 0; i < len(a) && i < len(b); i++ {
+		switch {
+		case a[i] < b[i]:
+			return -1, true
+		case a[i] > b[i]:
+			return 1, true
+		}
+	}
+	if len(a) != len(b) {
+		return 0, false
+	}
+	return 0, true
+}
+
+// add adds a time-valued quantity. Units finer than the precision of the value
+// are converted to the precision, e.g. @2014 + 24 months is @2016, and the
+// amount is truncated to whole units of the precision.
+func (t temporal) add(q Quantity, sign int) (temporal, error) {
+	unit, ok := durationUnits[q.Unit]
+	if !ok {
+		return temporal{}, fmt.Errorf("fhirpath: cannot add %s to a date/time", q)
+	}
+	if t.kind == kindTime && unit < precisionHour {
+		return temporal{}, fmt.Errorf("fhirpath: cannot add %s to a time", q)
+	}
+	value, _ := q.Value.Rat().Float64()
+	if sign < 0 {
+		value = -value
+	}
+	if q.Unit == "week" || q.Unit == "wk" {
+		value *= 7
+	}
+
+	// units per next coarser unit
+	factors := map[precision]float64{precisionMonth: 12, precisionHour: 24, precisionMinute: 60, precisionSecond: 60, precisionMillisecond: 1000}
+	for unit > t.precision {
+		switch {
+		case unit == precisionDay && t.precision == precisionMonth:
+			value /= 30
+		case unit == precisionDay:
+			value /= 365
+			unit = precisionMonth
+		default:
+			value /= factors[unit]
+		}
+		unit--
+	}
+	amount := int(value)
+
+	switch unit {
+	case precisionYear, precisionMonth:
+		if unit == precisionYear {
+			amount *= 12
+		}
+		months := t.year*12 + t.month - 1 + amount
+		r := t
+		r.year, r.month = floorDiv(months, 12), months-floorDiv(months, 12)*12+1
+		r.day = min(r.day, daysIn(r.year, r.month))
+		return r, nil
+	case precisionDay:
+		r := temporalFromTime(t.time().AddDate(0, 0, amount), t.kind, t.precision)
+		r.zone = t.zone
+		return r, nil
+	}
+	d := map[precision]time.Duration{precisionHour: time.Hour, precisionMinute: time.Minute, precisionSecond: time.Second, precisionMillisecond: time.Millisecond}[unit]
+	if unit >= precisionSecond {
+		amount = int(value * float64(d/time.Millisecond))
+		d = time.Millisecond
+	}
+	r := temporalFromTime(t.time().Add(time.Duration(amount)*d), t.kind, t.precision)
+	if t.kind == kindTime {
+		r.year, r.month, r.day = 0, 0, 0
+	}
+	r.zone = t.zone
+	return r, nil
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// durationUnits maps the calendar and UCUM time units to the component they change
+var durationUnits = map[string]precision{
+	"year": precisionYear, "month": precisionMonth, "week": precisionDay, "day": precisionDay,
+	"hour": precisionHour, "minute": precisionMinute, "second": precisionSecond, "millisecond": precisionMillisecond,
+	"a": precisionYear, "mo": precisionMonth, "wk": precisionDay, "d": precisionDay,
+	"h": precisionHour, "min": precisionMinute, "s": precisionSecond, "ms": precisionMillisecond,
+}
+
+// temporalFromValue converts the common date and time types
+func temporalFromValue(v interface{}) (temporal, bool) {
+	var t temporal
+	var err error
+	switch v := v.(type) {
+	case common.Date:
+		t, err = parseDateTime(v.String())
+	case common.DateTime:
+		t, err = parseDateTime(v.String())
+		t.kind = kindDateTime
+	case common.Instant:
+		t, err = parseDateTime(v.String())
+		t.kind = kindDateTime
+	case common.Time:
+		t, err = parseTime(v.String())
+	default:
+		return temporal{}, false
+	}
+	return t, err == nil
+}
+
+// export converts the value to the common type with the closest precision
+func (t temporal) export() interface{} {
+	switch t.kind {
+	case kindDate:
+		return common.MustParseDate(t.String())
+	case kindTime:
+		for t.precision < precisionSecond {
+			t.precision++
+		}
+		if d, err := common.ParseTime(t.String()); err == nil {
+			return d
+		}
+	default:
+		if t.precision > precisionDay && t.precision < precisionSecond {
+			t.precision = precisionSecond
+		}
+		if t.precision <= precisionDay {
+			t.kind = kindDate
+			return common.MustParseDateTime(t.String())
+		}
+		if d, err := common.ParseDateTime(t.String()); err == nil {
+			return d
+		}
+	}
+	return t.String()
+}
@@ -0,0 +1,240 @@
+// Package npm loads FHIR NPM packages, e.g. Implementation Guides, from .tgz
+// files on local disk and resolves their conformance resources by canonical
+// URL.
+//
+//	manager, err := npm.NewManager("packages")
+//	ig, err := manager.Load("hl7.fhir.uv.ips", "1.1.0")
+//	resource, err := manager.Resolve("http://hl7.org/fhir/uv/ips/StructureDefinition/Patient-uv-ips|1.1.0")
+//
+// The dependencies of a package are loaded before the package itself. The
+// StructureDefinitions, ValueSets, CodeSystems, SearchParameters and
+// OperationDefinitions of a package are decoded into the structs of the
+// version package matching its FHIR version, e.g. *fhir4.ValueSet for 4.0.1.
+//
+// A Manager resolves R5 StructureDefinitions for profile.Generator and
+// profile.Validator and adds R5 code systems and value sets to a
+// terminology.Service.
+package npm
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/terminology"
+)
+
+// archive is a package file on disk
+type archive struct {
+	file     string
+	manifest Manifest
+}
+
+// Manager loads packages and resolves their resources. It is safe for
+// concurrent use.
+type Manager struct {
+	mu sync.RWMutex
+
+	// archives are the available package files by package name
+	archives map[string][]archive
+
+	// packages are the loaded packages by name#version, loaded in load order
+	packages map[string]*Package
+	loaded   []*Package
+
+	// resources are the loaded resources by url and url|version
+	resources map[string]common.Resource
+}
+
+// NewManager returns a manager for the .tgz packages in the directories. No
+// package is loaded yet.
+func NewManager(dirs ...string) (*Manager, error) {
+	m := &Manager{
+		archives:  map[string][]archive{},
+		packages:  map[string]*Package{},
+		resources: map[string]common.Resource{},
+	}
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*.tgz"))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			manifest, err := readManifest(file)
+			if err != nil {
+				return nil, fmt.Errorf("npm: %s: %w", file, err)
+			}
+			m.add(archive{file: file, manifest: manifest})
+		}
+	}
+	return m, nil
+}
+
+// add makes a package file available unless a file of the same version
+// already is
+func (m *Manager) add(a archive) {
+	for _, other := range m.archives[a.manifest.Name] {
+		if other.manifest.Version == a.manifest.Version {
+			return
+		}
+	}
+	m.archives[a.manifest.Name] = append(m.archives[a.manifest.Name], a)
+}
+
+// Load loads a package and its dependencies. The version is exact, has x
+// wildcards like 4.0.x or is empty or latest for the newest version available.
+func (m *Manager) Load(name, version string) (*Package, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a, ok := m.find(name, version)
+	if !ok {
+		return nil, fmt.Errorf("npm: package %s#%s is not available", name, version)
+	}
+	return m.load(a, map[string]bool{})
+}
+
+// LoadFile loads the package of a .tgz file and its dependencies
+func (m *Manager) LoadFile(file string) (*Package, error) {
+	manifest, err := readManifest(file)
+	if err != nil {
+		return nil, fmt.Errorf("npm: %s: %w", file, err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	a := archive{file: file, manifest: manifest}
+	m.add(a)
+	return m.load(a, map[string]bool{})
+}
+
+// find returns the newest package file of a package matching the version
+func (m *Manager) find(name, version string) (archive, bool) {
+	var found archive
+	ok := false
+	for _, a := range m.archives[name] {
+		if !matchVersion(version, a.manifest.Version) {
+			continue
+		}
+		if !ok || compareVersions(a.manifest.Version, found.manifest.Version) > 0 {
+			found, ok = a, true
+		}
+	}
+	return found, ok
+}
+
+func (m *Manager) load(a archive, loading map[string]bool) (*Package, error) {
+	key := a.manifest.Name + "#" + a.manifest.Version
+	if p, ok := m.packages[key]; ok {
+		return p, nil
+	}
+	if loading[key] {
+		return nil, fmt.Errorf("npm: package %s depends on itself", key)
+	}
+	loading[key] = true
+	defer delete(loading, key)
+
+	p := &Package{Manifest: a.manifest}
+	names := make([]string, 0, len(a.manifest.Dependencies))
+	for name := range a.manifest.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		version := a.manifest.Dependencies[name]
+		dependency, ok := m.find(name, version)
+		if !ok {
+			return nil, fmt.Errorf("npm: dependency %s#%s of %s is not available", name, version, key)
+		}
+		loaded, err := m.load(dependency, loading)
+		if err != nil {
+			return nil, err
+		}
+		p.Dependencies = append(p.Dependencies, loaded)
+	}
+
+	version, err := p.fhirVersion()
+	if err != nil {
+		return nil, fmt.Errorf("npm: %s: %w", key, err)
+	}
+	p.FHIRVersion = version
+	files, err := readFiles(a.file)
+	if err != nil {
+		return nil, fmt.Errorf("npm: %s: %w", a.file, err)
+	}
+	if err := p.decode(files); err != nil {
+		return nil, fmt.Errorf("npm: %s: %w", key, err)
+	}
+
+	m.packages[key] = p
+	m.loaded = append(m.loaded, p)
+	for i, r := range p.Resources {
+		f := p.indexed[i]
+		if f.URL == "" {
+			continue
+		}
+		m.resources[f.URL] = r
+		if f.Version != "" {
+			m.resources[f.URL+"|"+f.Version] = r
+		}
+	}
+	return p, nil
+}
+
+// Packages returns the loaded packages, dependencies before the packages
+// depending on them
+func (m *Manager) Packages() []*Package {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]*Package(nil), m.loaded...)
+}
+
+// Resolve returns a resource of the loaded packages by its canonical, which
+// may include a version. Without a version the resource of the package loaded
+// last is returned.
+func (m *Manager) Resolve(canonical string) (common.Resource, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	r, ok := m.resources[canonical]
+	if !ok {
+		return nil, fmt.Errorf("npm: resource %s is not available", canonical)
+	}
+	return r, nil
+}
+
+// StructureDefinition returns an R5 StructureDefinition by its canonical, which
+// makes the manager a profile.Resolver
+func (m *Manager) StructureDefinition(canonical string) (*fhir5.StructureDefinition, error) {
+	r, err := m.Resolve(canonical)
+	if err != nil {
+		return nil, err
+	}
+	sd, ok := r.(*fhir5.StructureDefinition)
+	if !ok {
+		return nil, fmt.Errorf("npm: resource %s is not an R5 structure definition", canonical)
+	}
+	return sd, nil
+}
+
+// AddTerminology adds the R5 code systems and value sets of the loaded
+// packages to a terminology service
+func (m *Manager) AddTerminology(service *terminology.Service) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, p := range m.loaded {
+		for _, r := range p.Resources {
+			var err error
+			switch r := r.(type) {
+			case *fhir5.CodeSystem:
+				err = service.AddCodeSystem(r)
+			case *fhir5.ValueSet:
+				err = service.AddValueSet(r)
+			}
+			if err != nil {
+				return fmt.Errorf("npm: %s#%s: %w", p.Name, p.Version, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,309 @@
+package npm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/profile"
+	"github.com/d4l-data4life/go-fhir/pkg/terminology"
+	"github.com/d4l-data4life/go-fhir/pkg/validate"
+)
+
+const (
+	testdata   = "../fhir5/testdata/fhir5-json/"
+	mrnProfile = "http://example.org/fhir/StructureDefinition/mrn-patient"
+)
+
+// writePackage writes a .tgz package with the files in its package folder and
+// an example in a subfolder, which is not loaded
+func writePackage(t *testing.T, file string, manifest Manifest, files map[string][]byte, index bool) {
+	t.Helper()
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("failed to marshal package.json: %v", err)
+	}
+	entries := map[string][]byte{
+		"package/package.json":         data,
+		"package/example/Patient.json": []byte(`{"resourceType":"StructureDefinition","url":"http://example.org/example"}`),
+	}
+	idx := Index{IndexVersion: 2}
+	for name, data := range files {
+		entries["package/"+name] = data
+		f, err := buildIndex(map[string][]byte{name: data})
+		if err != nil {
+			t.Fatalf("failed to index %s: %v", name, err)
+		}
+		idx.Files = append(idx.Files, f.Files...)
+	}
+	if index {
+		data, err := json.Marshal(idx)
+		if err != nil {
+			t.Fatalf("failed to marshal .index.json: %v", err)
+		}
+		entries["package/.index.json"] = data
+	}
+
+	out, err := os.Create(file)
+	if err != nil {
+		t.Fatalf("failed to create %s: %v", file, err)
+	}
+	defer out.Close()
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	for name, data := range entries {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+		if _, err := tw.Write(data); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to close gzip: %v", err)
+	}
+}
+
+func readTestdata(t *testing.T, pattern string) map[string][]byte {
+	t.Helper()
+	files, err := filepath.Glob(testdata + pattern)
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no files match %s: %v", pattern, err)
+	}
+	contents := map[string][]byte{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		contents[filepath.Base(file)] = data
+	}
+	return contents
+}
+
+// newTestManager writes an R5 core package in two versions, an Implementation
+// Guide depending on it, an R4 package and a package with a missing dependency
+func newTestManager(t *testing.T) (*Manager, string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	core := readTestdata(t, "*.profile.json")
+	for name, data := range readTestdata(t, "*-administrative-gender.json") {
+		core[name] = data
+	}
+	writePackage(t, filepath.Join(dir, "core.tgz"), Manifest{
+		Name: "hl7.fhir.r5.core", Version: "5.0.0", FHIRVersions: []string{"5.0.0"},
+	}, core, false)
+	writePackage(t, filepath.Join(dir, "core-ballot.tgz"), Manifest{
+		Name: "hl7.fhir.r5.core", Version: "5.0.0-ballot", FHIRVersions: []string{"5.0.0-ballot"},
+	}, readTestdata(t, "patient.profile.json"), false)
+
+	constraint := fhir5.StructureDefinitionDerivationConstraint
+	mrn, err := json.Marshal(&fhir5.StructureDefinition{
+		ResourceType:   "StructureDefinition",
+		Url:            mrnProfile,
+		Version:        fhir5.StringPtr("1.0.0"),
+		Name:           "MRNPatient",
+		Status:         fhir5.StructureDefinitionStatusActive,
+		Kind:           fhir5.StructureDefinitionKindResource,
+		Abstract:       false,
+		Type:           "Patient",
+		BaseDefinition: fhir5.StringPtr("http://hl7.org/fhir/StructureDefinition/Patient"),
+		Derivation:     &constraint,
+		Differential: &fhir5.StructureDefinitionDifferential{Element: []fhir5.ElementDefinition{
+			{Path: "Patient.identifier", Min: fhir5.IntPtr(1)},
+			{Path: "Patient.identifier.system", FixedUri: fhir5.StringPtr("http://example.org/mrn")},
+			{Path: "Patient.gender", Binding: &fhir5.ElementDefinitionBinding{
+				Strength: fhir5.ElementDefinitionBindingStrengthRequired,
+				ValueSet: fhir5.StringPtr("http://hl7.org/fhir/ValueSet/administrative-gender"),
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal profile: %v", err)
+	}
+	ig := readTestdata(t, "searchparameter-example.json")
+	ig["StructureDefinition-mrn-patient.json"] = mrn
+	writePackage(t, filepath.Join(dir, "ig.tgz"), Manifest{
+		Name: "example.fhir.ig", Version: "1.0.0",
+		Dependencies: map[string]string{"hl7.fhir.r5.core": "5.0.x"},
+	}, ig, true)
+
+	writePackage(t, filepath.Join(dir, "r4.tgz"), Manifest{
+		Name: "example.fhir.r4", Version: "0.1.0", FHIRVersions: []string{"4.0.1"},
+	}, map[string][]byte{
+		"ValueSet-colors.json": []byte(`{"resourceType":"ValueSet","url":"http://example.org/fhir/ValueSet/colors","status":"active"}`),
+	}, true)
+	writePackage(t, filepath.Join(dir, "broken.tgz"), Manifest{
+		Name: "example.fhir.broken", Version: "0.1.0", FHIRVersions: []string{"4.0.1"},
+		Dependencies: map[string]string{"hl7.fhir.r4.core": "4.0.1"},
+	}, nil, true)
+
+	manager, err := NewManager(dir)
+	if err != nil {
+		t.Fatalf("NewManager: %v", err)
+	}
+	return manager, dir
+}
+
+func TestManager_Load(t *testing.T) {
+	manager, _ := newTestManager(t)
+	ig, err := manager.Load("example.fhir.ig", "")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if ig.FHIRVersion != "5.0.0" {
+		t.Errorf("expected the FHIR version of the core dependency, got %s", ig.FHIRVersion)
+	}
+	if len(ig.Resources) != 2 || len(ig.Index.Files) != 2 {
+		t.Errorf("expected 2 resources, got %d and %d index entries", len(ig.Resources), len(ig.Index.Files))
+	}
+	if len(ig.Dependencies) != 1 || ig.Dependencies[0].Version != "5.0.0" {
+		t.Fatalf("expected the release of the core package as dependency, got %+v", ig.Dependencies)
+	}
+	core := ig.Dependencies[0]
+	if len(core.Resources) != 295 {
+		t.Errorf("expected 295 core resources, got %d", len(core.Resources))
+	}
+	packages := manager.Packages()
+	if len(packages) != 2 || packages[0] != core || packages[1] != ig {
+		t.Errorf("expected the core package to be loaded before the guide")
+	}
+
+	again, err := manager.Load("example.fhir.ig", "1.0.0")
+	if err != nil || again != ig {
+		t.Errorf("expected the loaded package, got %v", err)
+	}
+
+	tests := []struct {
+		canonical    string
+		resourceType string
+		errMessage   string
+	}{
+		{canonical: mrnProfile, resourceType: "StructureDefinition"},
+		{canonical: mrnProfile + "|1.0.0", resourceType: "StructureDefinition"},
+		{canonical: "http://hl7.org/fhir/StructureDefinition/Patient|5.0.0", resourceType: "StructureDefinition"},
+		{canonical: "http://hl7.org/fhir/ValueSet/administrative-gender", resourceType: "ValueSet"},
+		{canonical: "http://hl7.org/fhir/administrative-gender", resourceType: "CodeSystem"},
+		{canonical: "http://hl7.org/fhir/SearchParameter/example", resourceType: "SearchParameter"},
+		{canonical: mrnProfile + "|2.0.0", errMessage: "is not available"},
+		{canonical: "http://example.org/example", errMessage: "is not available"},
+	}
+	for _, tt := range tests {
+		r, err := manager.Resolve(tt.canonical)
+		if tt.errMessage != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.errMessage) {
+				t.Errorf("%s: expected error %q, got %v", tt.canonical, tt.errMessage, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.canonical, err)
+			continue
+		}
+		if r.GetResourceType() != tt.resourceType {
+			t.Errorf("%s: expected a %s, got %s", tt.canonical, tt.resourceType, r.GetResourceType())
+		}
+	}
+}
+
+func TestManager_Versions(t *testing.T) {
+	manager, dir := newTestManager(t)
+
+	r4, err := manager.Load("example.fhir.r4", "0.1.x")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if _, ok := r4.Resources[0].(*fhir4.ValueSet); !ok {
+		t.Errorf("expected an R4 value set, got %T", r4.Resources[0])
+	}
+	if _, err := manager.StructureDefinition("http://example.org/fhir/ValueSet/colors"); err == nil {
+		t.Errorf("expected an error for a resource that is no R5 structure definition")
+	}
+
+	if _, err := manager.Load("example.fhir.broken", ""); err == nil || !strings.Contains(err.Error(), "dependency hl7.fhir.r4.core#4.0.1") {
+		t.Errorf("expected an error for the missing dependency, got %v", err)
+	}
+	if _, err := manager.Load("example.fhir.ig", "2.0.0"); err == nil {
+		t.Errorf("expected an error for an unavailable version")
+	}
+
+	ballot, err := manager.LoadFile(filepath.Join(dir, "core-ballot.tgz"))
+	if err != nil {
+		t.Fatalf("LoadFile: %v", err)
+	}
+	if ballot.Version != "5.0.0-ballot" || len(ballot.Resources) != 1 {
+		t.Errorf("expected the ballot package with one resource, got %s with %d", ballot.Version, len(ballot.Resources))
+	}
+	if _, err := manager.StructureDefinition("http://hl7.org/fhir/StructureDefinition/Patient|5.0.0"); err != nil {
+		t.Errorf("expected the Patient definition: %v", err)
+	}
+}
+
+func TestManager_Validation(t *testing.T) {
+	manager, _ := newTestManager(t)
+	if _, err := manager.Load("example.fhir.ig", "1.0.0"); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	service := terminology.NewService()
+	if err := manager.AddTerminology(service); err != nil {
+		t.Fatalf("AddTerminology: %v", err)
+	}
+	validator := profile.NewValidator(manager, service)
+
+	female := fhir5.PatientGenderFemale
+	patient := &fhir5.Patient{Gender: &female}
+	patient.Meta = &fhir5.Meta{Profile: []string{mrnProfile}}
+	if outcome := validator.Validate(patient); !validate.HasErrors(outcome) {
+		t.Errorf("expected an error for the missing identifier")
+	}
+
+	patient.Identifier = []common.Identifier{{System: fhir5.StringPtr("http://example.org/mrn"), Value: fhir5.StringPtr("12345")}}
+	if outcome := validator.Validate(patient); validate.HasErrors(outcome) {
+		t.Errorf("expected no errors, got %+v", outcome.Issue)
+	}
+
+	none := fhir5.PatientGender("none")
+	patient.Gender = &none
+	if outcome := validator.Validate(patient); !validate.HasErrors(outcome) {
+		t.Errorf("expected an error for the code outside the required binding")
+	}
+}
+
+func TestVersions(t *testing.T) {
+	tests := []struct {
+		pattern, version string
+		matches          bool
+	}{
+		{"4.0.1", "4.0.1", true},
+		{"4.0.1", "4.0.10", false},
+		{"4.0.x", "4.0.10", true},
+		{"4.x", "4.3.0", true},
+		{"4.0", "4.0.1", false},
+		{"", "1.0.0", true},
+		{"latest", "1.0.0", true},
+		{"current", "current", true},
+	}
+	for _, tt := range tests {
+		if matchVersion(tt.pattern, tt.version) != tt.matches {
+			t.Errorf("matchVersion(%q, %q) should be %v", tt.pattern, tt.version, tt.matches)
+		}
+	}
+
+	ordered := []string{"0.9.0", "1.0.0-ballot", "1.0.0", "1.0.1", "1.2.0", "1.10.0"}
+	for i := 1; i < len(ordered); i++ {
+		if compareVersions(ordered[i-1], ordered[i]) >= 0 || compareVersions(ordered[i], ordered[i-1]) <= 0 {
+			t.Errorf("expected %s before %s", ordered[i-1], ordered[i])
+		}
+	}
+}
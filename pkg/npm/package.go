@@ -0,0 +1,336 @@
+package npm
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Manifest is the package.json of a package
+type Manifest struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Canonical   string `json:"canonical,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// FHIRVersions are the FHIR versions the package is written for, e.g. 4.0.1
+	FHIRVersions []string `json:"fhirVersions,omitempty"`
+
+	// Dependencies are the versions of the packages the package depends on by
+	// their name, e.g. hl7.fhir.r4.core: 4.0.1
+	Dependencies map[string]string `json:"dependencies,omitempty"`
+}
+
+// Index is the .index.json of a package, which lists the resources in its
+// package folder
+type Index struct {
+	IndexVersion int         `json:"index-version"`
+	Files        []IndexFile `json:"files"`
+}
+
+// IndexFile describes a resource in the package folder of a package
+type IndexFile struct {
+	Filename     string `json:"filename"`
+	ResourceType string `json:"resourceType"`
+	ID           string `json:"id,omitempty"`
+	URL          string `json:"url,omitempty"`
+	Version      string `json:"version,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+	Type         string `json:"type,omitempty"`
+}
+
+// Package is a loaded package
+type Package struct {
+	Manifest
+
+	// Index lists the resources of the package, it is built from the
+	// resources if the package has no .index.json
+	Index Index
+
+	// FHIRVersion is the FHIR version the resources were decoded with
+	FHIRVersion string
+
+	// Resources are the conformance resources of the package, decoded into the
+	// structs of the version package matching FHIRVersion
+	Resources []common.Resource
+
+	// Dependencies are the loaded packages the package depends on
+	Dependencies []*Package
+
+	// indexed are the index entries of the resources
+	indexed []IndexFile
+}
+
+// conformanceTypes are the resource types decoded from a package
+var conformanceTypes = map[string]bool{
+	"StructureDefinition": true,
+	"ValueSet":            true,
+	"CodeSystem":          true,
+	"SearchParameter":     true,
+	"OperationDefinition": true,
+}
+
+// unmarshalers decode resources by the major and minor FHIR version
+var unmarshalers = map[string]func(data []byte) (common.Resource, error){
+	"1.0": fhir2.UnmarshalResource,
+	"3.0": fhir3.UnmarshalResource,
+	"4.0": fhir4.UnmarshalResource,
+	"4.3": fhir4b.UnmarshalResource,
+	"5.0": fhir5.UnmarshalResource,
+}
+
+// corePackages are the core packages of the FHIR versions, a package without
+// fhirVersions is written for the version of the core package it depends on
+var corePackages = []string{
+	"hl7.fhir.r2.core",
+	"hl7.fhir.r3.core",
+	"hl7.fhir.r4.core",
+	"hl7.fhir.r4b.core",
+	"hl7.fhir.r5.core",
+}
+
+// readManifest reads the package.json of a .tgz package
+func readManifest(file string) (Manifest, error) {
+	var manifest Manifest
+	found := false
+	err := walkArchive(file, func(name string, r io.Reader) (bool, error) {
+		if name != "package.json" {
+			return false, nil
+		}
+		found = true
+		return true, json.NewDecoder(r).Decode(&manifest)
+	})
+	if err != nil {
+		return Manifest{}, err
+	}
+	if !found {
+		return Manifest{}, errors.New("package.json is missing")
+	}
+	if manifest.Name == "" || manifest.Version == "" {
+		return Manifest{}, errors.New("package.json has no name or version")
+	}
+	return manifest, nil
+}
+
+// readFiles reads the JSON files of the package folder of a .tgz package
+func readFiles(file string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	err := walkArchive(file, func(name string, r io.Reader) (bool, error) {
+		if !strings.HasSuffix(name, ".json") {
+			return false, nil
+		}
+		data, err := io.ReadAll(r)
+		files[name] = data
+		return false, err
+	})
+	return files, err
+}
+
+// walkArchive calls fn for the files in the package folder of a .tgz package
+// until fn asks to stop. Files in subfolders, e.g. examples, are skipped.
+func walkArchive(file string, fn func(name string, r io.Reader) (stop bool, err error)) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		dir, name := path.Split(strings.TrimPrefix(path.Clean(header.Name), "./"))
+		if dir != "package/" {
+			continue
+		}
+		stop, err := fn(name, tr)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		if stop {
+			return nil
+		}
+	}
+}
+
+// decode fills the index and the resources of a package from its files
+func (p *Package) decode(files map[string][]byte) error {
+	if data, ok := files[".index.json"]; ok {
+		if err := json.Unmarshal(data, &p.Index); err != nil {
+			return fmt.Errorf(".index.json: %w", err)
+		}
+	} else {
+		index, err := buildIndex(files)
+		if err != nil {
+			return err
+		}
+		p.Index = index
+	}
+
+	unmarshal, ok := unmarshalers[majorMinor(p.FHIRVersion)]
+	if !ok {
+		return fmt.Errorf("FHIR version %s is not supported", p.FHIRVersion)
+	}
+	for _, f := range p.Index.Files {
+		if !conformanceTypes[f.ResourceType] {
+			continue
+		}
+		data, ok := files[f.Filename]
+		if !ok {
+			return fmt.Errorf("%s is listed in .index.json but missing", f.Filename)
+		}
+		r, err := unmarshal(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.Filename, err)
+		}
+		p.Resources = append(p.Resources, r)
+		p.indexed = append(p.indexed, f)
+	}
+	return nil
+}
+
+// buildIndex indexes the resources of a package without .index.json
+func buildIndex(files map[string][]byte) (Index, error) {
+	index := Index{IndexVersion: 2}
+	for name, data := range files {
+		if name == "package.json" || name == ".index.json" {
+			continue
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return Index{}, fmt.Errorf("%s: %w", name, err)
+		}
+		f := IndexFile{Filename: name}
+		for field, value := range map[string]*string{
+			"resourceType": &f.ResourceType,
+			"id":           &f.ID,
+			"url":          &f.URL,
+			"version":      &f.Version,
+			"kind":         &f.Kind,
+			"type":         &f.Type,
+		} {
+			// e.g. the type of a Composition is not a string
+			_ = json.Unmarshal(fields[field], value)
+		}
+		if f.ResourceType != "" {
+			index.Files = append(index.Files, f)
+		}
+	}
+	sort.Slice(index.Files, func(i, j int) bool { return index.Files[i].Filename < index.Files[j].Filename })
+	return index, nil
+}
+
+// fhirVersion returns the FHIR version of a package, the first of its
+// fhirVersions or the version of the core package it depends on
+func (p *Package) fhirVersion() (string, error) {
+	if len(p.FHIRVersions) > 0 {
+		return p.FHIRVersions[0], nil
+	}
+	for _, core := range corePackages {
+		for _, dependency := range p.Dependencies {
+			if dependency.Name == core {
+				return dependency.FHIRVersion, nil
+			}
+		}
+	}
+	return "", errors.New("the FHIR version is not declared")
+}
+
+// majorMinor returns the major and minor version of a version, e.g. 4.0 for 4.0.1
+func majorMinor(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return version
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// matchVersion reports whether a version satisfies the version of a
+// dependency, which is either exact, has x wildcards like 4.0.x or is latest
+func matchVersion(pattern, version string) bool {
+	if pattern == "" || pattern == "latest" {
+		return true
+	}
+	p := strings.Split(pattern, ".")
+	v := strings.Split(version, ".")
+	for i, part := range p {
+		if part == "x" || part == "*" {
+			return true
+		}
+		if i >= len(v) || part != v[i] {
+			return false
+		}
+	}
+	return len(p) == len(v)
+}
+
+// compareVersions orders versions by their numeric parts, a pre-release like
+// 5.0.0-ballot comes before its release
+func compareVersions(a, b string) int {
+	a, aPre, aHasPre := strings.Cut(a, "-")
+	b, bPre, bHasPre := strings.Cut(b, "-")
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var x, y string
+		if i < len(aParts) {
+			x = aParts[i]
+		}
+		if i < len(bParts) {
+			y = bParts[i]
+		}
+		if c := comparePart(x, y); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case aHasPre && !bHasPre:
+		return -1
+	case !aHasPre && bHasPre:
+		return 1
+	}
+	return strings.Compare(aPre, bPre)
+}
+
+func comparePart(x, y string) int {
+	m, errX := strconv.Atoi(x)
+	n, errY := strconv.Atoi(y)
+	if errX != nil || errY != nil {
+		return strings.Compare(x, y)
+	}
+	switch {
+	case m < n:
+		return -1
+	case m > n:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,56 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+)
+
+// The functions below back the wrappers cmd/profilegen generates for profiles
+// and extensions.
+
+// MustUnmarshal decodes JSON into v and panics if it cannot. Generated
+// constructors fill in the fixed values of a profile with it.
+func MustUnmarshal(data string, v interface{}) {
+	if err := json.Unmarshal([]byte(data), v); err != nil {
+		panic(fmt.Sprintf("profile: %v", err))
+	}
+}
+
+// Matches reports whether v has every property and array item of a JSON
+// pattern, e.g. whether an Observation component has the code of a slice
+func Matches(v interface{}, pattern string) bool {
+	var p interface{}
+	if err := json.Unmarshal([]byte(pattern), &p); err != nil {
+		return false
+	}
+	return matchesPattern(genericValue(v), p)
+}
+
+// FindExtensions returns the extensions with the url
+func FindExtensions(extensions []common.Extension, url string) []*common.Extension {
+	var found []*common.Extension
+	for i := range extensions {
+		if extensions[i].URL == url {
+			found = append(found, &extensions[i])
+		}
+	}
+	return found
+}
+
+// ReplaceExtensions returns the extensions without those with the url,
+// followed by the replacements with the url
+func ReplaceExtensions(extensions []common.Extension, url string, replacements ...common.Extension) []common.Extension {
+	var replaced []common.Extension
+	for _, e := range extensions {
+		if e.URL != url {
+			replaced = append(replaced, e)
+		}
+	}
+	for _, e := range replacements {
+		e.URL = url
+		replaced = append(replaced, e)
+	}
+	return replaced
+}
@@ -0,0 +1,57 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+func TestMatches(t *testing.T) {
+	var component fhir5.ObservationComponent
+	MustUnmarshal(`{"code":{"coding":[{"system":"http://loinc.org","code":"8480-6","display":"Systolic"}]}}`, &component)
+
+	tests := []struct {
+		pattern string
+		matches bool
+	}{
+		{pattern: `{"code":{"coding":[{"system":"http://loinc.org","code":"8480-6"}]}}`, matches: true},
+		{pattern: `{"code":{"coding":[{"system":"http://loinc.org","code":"8462-4"}]}}`, matches: false},
+		{pattern: `{"valueQuantity":{"code":"mm[Hg]"}}`, matches: false},
+		{pattern: `{}`, matches: true},
+		{pattern: `not json`, matches: false},
+	}
+	for _, tt := range tests {
+		if Matches(&component, tt.pattern) != tt.matches {
+			t.Errorf("Matches(%s) should be %v", tt.pattern, tt.matches)
+		}
+	}
+}
+
+func TestExtensions(t *testing.T) {
+	const birthPlace = "http://hl7.org/fhir/StructureDefinition/patient-birthPlace"
+	extensions := []common.Extension{
+		{URL: birthPlace, ValueString: fhir5.StringPtr("Berlin")},
+		{URL: "http://example.org/other", ValueBoolean: fhir5.BoolPtr(true)},
+	}
+
+	found := FindExtensions(extensions, birthPlace)
+	if len(found) != 1 || *found[0].ValueString != "Berlin" {
+		t.Fatalf("expected the birth place extension, got %+v", found)
+	}
+	found[0].ValueString = fhir5.StringPtr("Potsdam")
+	if *extensions[0].ValueString != "Potsdam" {
+		t.Errorf("expected the found extension to point into the slice")
+	}
+
+	replaced := ReplaceExtensions(extensions, birthPlace, common.Extension{ValueString: fhir5.StringPtr("Hamburg")})
+	if len(replaced) != 2 || replaced[1].URL != birthPlace || *replaced[1].ValueString != "Hamburg" {
+		t.Errorf("expected the replacement after the other extension, got %+v", replaced)
+	}
+	if removed := ReplaceExtensions(extensions, birthPlace); len(removed) != 1 || removed[0].URL == birthPlace {
+		t.Errorf("expected the birth place extension to be removed, got %+v", removed)
+	}
+	if *extensions[0].ValueString != "Potsdam" {
+		t.Errorf("expected the extensions to be unchanged")
+	}
+}
@@ -0,0 +1,99 @@
+// Package profile works with FHIR R5 profiles, StructureDefinitions that
+// constrain a resource or data type: it generates their snapshots and
+// validates resources against them.
+//
+//	definitions := profile.NewDefinitions()
+//	err := definitions.Load("packages/hl7.fhir.r5.core/package/StructureDefinition-*.json")
+//	generator := profile.NewGenerator(definitions)
+//	withSnapshot, err := generator.Snapshot(differentialOnly)
+//
+//	validator := profile.NewValidator(definitions, terminology.NewService())
+//	outcome := validator.Validate(observation, "http://hl7.org/fhir/StructureDefinition/bp")
+//
+// Base definitions and the definitions of data types are looked up through a
+// Resolver by their canonical URL, optionally followed by |version.
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Resolver looks up StructureDefinitions by their canonical URL
+type Resolver interface {
+	StructureDefinition(canonical string) (*fhir5.StructureDefinition, error)
+}
+
+// Definitions is a Resolver over StructureDefinitions held in memory. It is
+// safe for concurrent use.
+type Definitions struct {
+	mu          sync.RWMutex
+	definitions map[string]*fhir5.StructureDefinition
+}
+
+// NewDefinitions returns a resolver without StructureDefinitions
+func NewDefinitions() *Definitions {
+	return &Definitions{definitions: map[string]*fhir5.StructureDefinition{}}
+}
+
+// Add makes a StructureDefinition available by its URL
+func (d *Definitions) Add(sd *fhir5.StructureDefinition) error {
+	if sd.Url == "" {
+		return errors.New("profile: structure definition has no url")
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.definitions[sd.Url] = sd
+	if sd.Version != nil {
+		d.definitions[sd.Url+"|"+*sd.Version] = sd
+	}
+	return nil
+}
+
+// Load adds the StructureDefinitions of the JSON files matching the pattern,
+// e.g. the files of a package in the local package cache. Files holding other
+// resources are skipped.
+func (d *Definitions) Load(pattern string) error {
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var header struct {
+			ResourceType string `json:"resourceType"`
+		}
+		if err := json.Unmarshal(data, &header); err != nil || header.ResourceType != "StructureDefinition" {
+			continue
+		}
+		var sd fhir5.StructureDefinition
+		if err := json.Unmarshal(data, &sd); err != nil {
+			return fmt.Errorf("profile: %s: %w", file, err)
+		}
+		if err := d.Add(&sd); err != nil {
+			return fmt.Errorf("profile: %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// StructureDefinition returns a StructureDefinition by its canonical, which
+// may include a version
+func (d *Definitions) StructureDefinition(canonical string) (*fhir5.StructureDefinition, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	sd, ok := d.definitions[canonical]
+	if !ok {
+		return nil, fmt.Errorf("profile: structure definition %s is not available", canonical)
+	}
+	return sd, nil
+}
@@ -0,0 +1,46 @@
+package profile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+func TestDefinitions(t *testing.T) {
+	definitions := NewDefinitions()
+	// the directory holds examples of other resources too
+	if err := definitions.Load("../fhir5/testdata/fhir5-json/*.json"); err != nil {
+		t.Fatalf("failed to load profiles: %v", err)
+	}
+
+	tests := []struct {
+		canonical  string
+		errMessage string
+	}{
+		{canonical: "http://hl7.org/fhir/StructureDefinition/Patient"},
+		{canonical: "http://hl7.org/fhir/StructureDefinition/Patient|5.0.0"},
+		{canonical: "http://hl7.org/fhir/StructureDefinition/Patient|4.0.1", errMessage: "is not available"},
+		{canonical: "http://hl7.org/fhir/StructureDefinition/Unknown", errMessage: "is not available"},
+	}
+	for _, tt := range tests {
+		sd, err := definitions.StructureDefinition(tt.canonical)
+		if tt.errMessage != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.errMessage) {
+				t.Errorf("%s: expected error %q, got %v", tt.canonical, tt.errMessage, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.canonical, err)
+			continue
+		}
+		if sd.Type != "Patient" {
+			t.Errorf("%s: expected the Patient definition, got %s", tt.canonical, sd.Type)
+		}
+	}
+
+	if err := definitions.Add(&fhir5.StructureDefinition{Type: "Patient"}); err == nil {
+		t.Errorf("expected an error for a definition without url")
+	}
+}
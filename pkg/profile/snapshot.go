@@ -0,0 +1,581 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// coreURL is the canonical base of the definitions of the FHIR data types
+const coreURL = "http://hl7.org/fhir/StructureDefinition/"
+
+// Generator derives the snapshots of StructureDefinitions from their
+// differential and the snapshot of their base definition. It is safe for
+// concurrent use.
+type Generator struct {
+	resolver Resolver
+
+	mu sync.Mutex
+	// definitions with a snapshot by canonical, including generated ones
+	definitions map[string]*fhir5.StructureDefinition
+}
+
+// NewGenerator returns a generator that resolves base definitions and the
+// definitions of data types through resolver
+func NewGenerator(resolver Resolver) *Generator {
+	return &Generator{resolver: resolver, definitions: map[string]*fhir5.StructureDefinition{}}
+}
+
+// Snapshot returns a copy of sd with a snapshot generated from its
+// differential. Base definitions without a snapshot get one first.
+//
+// Differential elements are merged onto the elements of the base snapshot
+// they address by id, or by path and slice name where they have no id. Types
+// of choice elements, e.g. valueQuantity, become type slices of the choice
+// element, e.g. value[x]:valueQuantity. Children of complex data types and of
+// content references are added where the differential constrains them, and
+// slices and reslices (a/b) are added after the existing slices of the
+// sliced element.
+func (g *Generator) Snapshot(sd *fhir5.StructureDefinition) (*fhir5.StructureDefinition, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.snapshot(sd, map[string]bool{})
+}
+
+func (g *Generator) snapshot(sd *fhir5.StructureDefinition, visiting map[string]bool) (*fhir5.StructureDefinition, error) {
+	if sd.Differential == nil {
+		return nil, fmt.Errorf("profile: %s has no differential", sd.Url)
+	}
+	if visiting[sd.Url] {
+		return nil, fmt.Errorf("profile: %s is its own base", sd.Url)
+	}
+	visiting[sd.Url] = true
+	defer delete(visiting, sd.Url)
+
+	b := &builder{g: g, visiting: visiting}
+	if sd.BaseDefinition != nil {
+		base, err := g.definition(*sd.BaseDefinition, visiting)
+		if err != nil {
+			return nil, err
+		}
+		b.elements = cloneElements(base.Snapshot.Element)
+		if sd.Derivation != nil {
+			b.specialization = *sd.Derivation == fhir5.StructureDefinitionDerivationSpecialization
+		} else {
+			b.specialization = sd.Type != base.Type
+		}
+		if b.specialization {
+			b.rename(base.Type, sd.Type)
+		}
+		b.base = cloneElements(b.elements)
+	} else {
+		b.specialization = true
+	}
+
+	differential := cloneElements(sd.Differential.Element)
+	ids := differentialIDs(differential)
+	for i, element := range differential {
+		index, choice, err := b.resolve(ids[i])
+		if err != nil {
+			return nil, fmt.Errorf("profile: %s: %w", sd.Url, err)
+		}
+		merge(&b.elements[index], element)
+		if err := b.profile(index, element.Type); err != nil {
+			return nil, fmt.Errorf("profile: %s: %w", sd.Url, err)
+		}
+		if choice >= 0 {
+			b.require(choice, index)
+		}
+	}
+	for i := range b.elements {
+		setBase(&b.elements[i])
+	}
+
+	result := cloneDefinition(sd)
+	result.Snapshot = &fhir5.StructureDefinitionSnapshot{Element: b.elements}
+	return result, nil
+}
+
+// definition returns the StructureDefinition of a canonical with a snapshot
+func (g *Generator) definition(canonical string, visiting map[string]bool) (*fhir5.StructureDefinition, error) {
+	if sd, ok := g.definitions[canonical]; ok {
+		return sd, nil
+	}
+	sd, err := g.resolver.StructureDefinition(canonical)
+	if err != nil {
+		return nil, err
+	}
+	if sd.Snapshot == nil || len(sd.Snapshot.Element) == 0 {
+		if sd, err = g.snapshot(sd, visiting); err != nil {
+			return nil, err
+		}
+	}
+	g.definitions[canonical] = sd
+	return sd, nil
+}
+
+// builder holds the elements of a snapshot while the differential is merged
+type builder struct {
+	g        *Generator
+	visiting map[string]bool
+	elements []fhir5.ElementDefinition
+	// elements of the base snapshot, which new slices start from
+	base []fhir5.ElementDefinition
+
+	// a specialization may add elements its base does not have
+	specialization bool
+}
+
+// rename replaces the root of the ids and paths of the base elements, e.g.
+// DomainResource with Observation
+func (b *builder) rename(from, to string) {
+	for i := range b.elements {
+		e := &b.elements[i]
+		e.ID = fhir5.StringPtr(replaceRoot(elementID(e), from, to))
+		e.Path = replaceRoot(e.Path, from, to)
+	}
+	if len(b.elements) > 0 {
+		// the root element is based on the specialization itself
+		b.elements[0].Base = nil
+	}
+}
+
+// resolve returns the index of the element with the id, adding the elements
+// on its way that the snapshot does not list yet. If the last name of the id
+// is a type of a choice element, e.g. valueQuantity of value[x], the index of
+// the choice element is returned too, otherwise -1.
+func (b *builder) resolve(id string) (int, int, error) {
+	current, choice := -1, -1
+	for _, segment := range strings.Split(id, ".") {
+		name, slice, _ := strings.Cut(segment, ":")
+		childID := name
+		if current >= 0 {
+			childID = elementID(&b.elements[current]) + "." + name
+		}
+		index := b.find(childID)
+		choice = -1
+		if index < 0 && current >= 0 {
+			if err := b.expand(current); err != nil {
+				return -1, -1, err
+			}
+			if index = b.find(childID); index < 0 {
+				index = b.find(childID + "[x]")
+			}
+			if index < 0 {
+				if index = b.choice(current, name); index >= 0 && slice == "" {
+					choice, slice = index, name
+				}
+			}
+		}
+		if index < 0 {
+			if !b.specialization {
+				return -1, -1, fmt.Errorf("no element %s in the base definition", childID)
+			}
+			index = b.add(current, childID, name)
+		}
+		if slice != "" {
+			var err error
+			if index, err = b.slice(index, slice); err != nil {
+				return -1, -1, err
+			}
+		}
+		current = index
+	}
+	return current, choice, nil
+}
+
+// profile adds the constraints of the profile the differential gives the
+// element at index as its type, e.g. those of SimpleQuantity. Extension
+// definitions are not looked up, their root has the constraints of Extension.
+func (b *builder) profile(index int, types []fhir5.ElementDefinitionType) error {
+	if len(types) != 1 || len(types[0].Profile) != 1 || types[0].Code == "Extension" {
+		return nil
+	}
+	sd, err := b.g.definition(types[0].Profile[0], b.visiting)
+	if err != nil {
+		return fmt.Errorf("profile of %s: %w", elementID(&b.elements[index]), err)
+	}
+	e := &b.elements[index]
+	for _, c := range sd.Snapshot.Element[0].Constraint {
+		found := false
+		for _, existing := range e.Constraint {
+			found = found || existing.Key == c.Key
+		}
+		if !found {
+			e.Constraint = append(e.Constraint, c)
+		}
+	}
+	return nil
+}
+
+// require raises the minimum cardinality of a choice element to that of its
+// type slice. A required type slice of a choice element with at most one
+// value leaves only its type.
+func (b *builder) require(choice, slice int) {
+	c, e := &b.elements[choice], &b.elements[slice]
+	if e.Min == nil || *e.Min == 0 {
+		return
+	}
+	if c.Min == nil || *c.Min < *e.Min {
+		c.Min = fhir5.IntPtr(*e.Min)
+	}
+	if c.Max != nil && *c.Max == "1" {
+		c.Type = append([]fhir5.ElementDefinitionType(nil), e.Type...)
+	}
+}
+
+// find returns the index of the element with the id or -1
+func (b *builder) find(id string) int {
+	for i := range b.elements {
+		if elementID(&b.elements[i]) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// end returns the index after the element at index, its children, its
+// slices and their children
+func (b *builder) end(index int) int {
+	id := elementID(&b.elements[index])
+	i := index + 1
+	for ; i < len(b.elements); i++ {
+		rest, ok := strings.CutPrefix(elementID(&b.elements[i]), id)
+		if !ok || rest == "" || !strings.ContainsRune(".:/", rune(rest[0])) {
+			break
+		}
+	}
+	return i
+}
+
+// subtree returns the index after the element at index and its children
+func (b *builder) subtree(index int) int {
+	prefix := elementID(&b.elements[index]) + "."
+	i := index + 1
+	for i < len(b.elements) && strings.HasPrefix(elementID(&b.elements[i]), prefix) {
+		i++
+	}
+	return i
+}
+
+// insert adds elements before index
+func (b *builder) insert(index int, elements ...fhir5.ElementDefinition) {
+	b.elements = append(b.elements[:index], append(elements, b.elements[index:]...)...)
+}
+
+// add appends a new element of a specialization to the children of parent
+func (b *builder) add(parent int, id, name string) int {
+	element := fhir5.ElementDefinition{Path: name}
+	element.ID = fhir5.StringPtr(id)
+	index := len(b.elements)
+	if parent >= 0 {
+		element.Path = b.elements[parent].Path + "." + name
+		index = b.subtree(parent)
+	}
+	b.insert(index, element)
+	return index
+}
+
+// expand adds the children of an element without children in the snapshot,
+// taken from the element its contentReference points to or from the
+// definition of its type. Elements with several types are not expanded.
+func (b *builder) expand(parent int) error {
+	p := b.elements[parent]
+	id := elementID(&p)
+	if parent+1 < len(b.elements) && strings.HasPrefix(elementID(&b.elements[parent+1]), id+".") {
+		return nil
+	}
+
+	var children []fhir5.ElementDefinition
+	var rootID, rootPath string
+	switch {
+	case p.ContentReference != nil:
+		_, ref, _ := strings.Cut(*p.ContentReference, "#")
+		target := b.find(ref)
+		if target < 0 {
+			return fmt.Errorf("no element %s for the content reference of %s", ref, id)
+		}
+		rootID, rootPath = ref, b.elements[target].Path
+		children = b.elements[target+1 : b.subtree(target)]
+	case len(p.Type) == 1:
+		url, ok := typeURL(p.Type[0])
+		if !ok {
+			return nil
+		}
+		sd, err := b.g.definition(url, b.visiting)
+		if err != nil {
+			return fmt.Errorf("type of %s: %w", id, err)
+		}
+		root := sd.Snapshot.Element[0]
+		rootID, rootPath = elementID(&root), root.Path
+		children = sd.Snapshot.Element[1:]
+	}
+
+	children = cloneElements(children)
+	for i := range children {
+		c := &children[i]
+		setBase(c)
+		c.ID = fhir5.StringPtr(id + strings.TrimPrefix(elementID(c), rootID))
+		c.Path = p.Path + strings.TrimPrefix(c.Path, rootPath)
+	}
+	b.insert(parent+1, children...)
+	return nil
+}
+
+// choice returns the index of the choice element of parent that name is a
+// type of, e.g. value[x] for valueQuantity, or -1
+func (b *builder) choice(parent int, name string) int {
+	prefix := elementID(&b.elements[parent]) + "."
+	for i := parent + 1; i < b.end(parent); i++ {
+		e := &b.elements[i]
+		child, ok := strings.CutPrefix(elementID(e), prefix)
+		if !ok || strings.ContainsAny(child, ".:") {
+			continue
+		}
+		if choiceType(e, name) != nil {
+			return i
+		}
+	}
+	return -1
+}
+
+// choiceType returns the type of the choice element that name stands for,
+// e.g. Quantity of value[x] for valueQuantity
+func choiceType(e *fhir5.ElementDefinition, name string) *fhir5.ElementDefinitionType {
+	prefix, ok := strings.CutSuffix(e.Path, "[x]")
+	if !ok {
+		return nil
+	}
+	prefix = prefix[strings.LastIndex(prefix, ".")+1:]
+	suffix, ok := strings.CutPrefix(name, prefix)
+	if !ok {
+		return nil
+	}
+	for i, t := range e.Type {
+		if t.Code != "" && strings.ToUpper(t.Code[:1])+t.Code[1:] == suffix {
+			return &e.Type[i]
+		}
+	}
+	return nil
+}
+
+// slice returns the index of the slice of the sliced element at index. A new
+// slice starts as a copy of the sliced element, or of the slice a for a
+// reslice a/b, and follows the existing slices.
+func (b *builder) slice(index int, name string) (int, error) {
+	sliced := &b.elements[index]
+	id := elementID(sliced) + ":" + name
+	if i := b.find(id); i >= 0 {
+		return i, nil
+	}
+
+	source := index
+	if parent := strings.LastIndex(name, "/"); parent >= 0 {
+		if source = b.find(elementID(sliced) + ":" + name[:parent]); source < 0 {
+			return -1, fmt.Errorf("no slice %s to reslice", elementID(sliced)+":"+name[:parent])
+		}
+	}
+	sourceID := elementID(&b.elements[source])
+	group := cloneElements(b.elements[source:b.subtree(source)])
+	for i := range b.base {
+		// constraints of the differential on the sliced element and its
+		// children do not apply to its slices
+		if elementID(&b.base[i]) == sourceID {
+			end := i + 1
+			for end < len(b.base) && strings.HasPrefix(elementID(&b.base[end]), sourceID+".") {
+				end++
+			}
+			group = cloneElements(b.base[i:end])
+			break
+		}
+	}
+
+	slice := &group[0]
+	slice.SliceName = fhir5.StringPtr(name)
+	slice.Slicing = nil
+	slice.Min = fhir5.IntPtr(0)
+	if t := choiceType(sliced, name); t != nil {
+		slice.Type = []fhir5.ElementDefinitionType{*t}
+		if sliced.Slicing == nil {
+			sliced.Slicing = defaultSlicing(fhir5.ElementDefinitionSlicingDiscriminatorTypeType, "$this")
+		}
+	} else if sliced.Slicing == nil && len(sliced.Type) == 1 && sliced.Type[0].Code == "Extension" {
+		sliced.Slicing = defaultSlicing(fhir5.ElementDefinitionSlicingDiscriminatorTypeValue, "url")
+	}
+	for i := range group {
+		group[i].ID = fhir5.StringPtr(id + strings.TrimPrefix(elementID(&group[i]), sourceID))
+	}
+
+	end := b.end(source)
+	b.insert(end, group...)
+	return end, nil
+}
+
+// defaultSlicing returns the open, unordered slicing of choice elements by
+// type and of extensions by url
+func defaultSlicing(discriminator fhir5.ElementDefinitionSlicingDiscriminatorType, path string) *fhir5.ElementDefinitionSlicing {
+	return &fhir5.ElementDefinitionSlicing{
+		Discriminator: []fhir5.ElementDefinitionSlicingDiscriminator{{Type: discriminator, Path: path}},
+		Ordered:       fhir5.BoolPtr(false),
+		Rules:         fhir5.ElementDefinitionSlicingRulesOpen,
+	}
+}
+
+// typeURL returns the canonical of the definition of a type and whether the
+// type has one
+func typeURL(t fhir5.ElementDefinitionType) (string, bool) {
+	switch {
+	case len(t.Profile) == 1:
+		return t.Profile[0], true
+	case strings.Contains(t.Code, "/"):
+		// FHIRPath system types of primitive values
+		return "", false
+	}
+	return coreURL + t.Code, true
+}
+
+// merge applies the constraints of a differential element to a snapshot
+// element. Constraints, mappings, conditions, aliases and extensions add to
+// those of the snapshot element, other properties replace them.
+func merge(dst *fhir5.ElementDefinition, src fhir5.ElementDefinition) {
+	id, path, base := dst.ID, dst.Path, dst.Base
+	constraints := dst.Constraint
+	for _, c := range src.Constraint {
+		constraints = replaceConstraint(constraints, c)
+	}
+	mappings := append(dst.Mapping, src.Mapping...)
+	conditions := union(dst.Condition, src.Condition)
+	aliases := union(dst.Alias, src.Alias)
+	extensions := dst.Extension
+	for _, e := range src.Extension {
+		extensions = replaceExtension(extensions, e)
+	}
+
+	dv, sv := reflect.ValueOf(dst).Elem(), reflect.ValueOf(src)
+	for i := 0; i < sv.NumField(); i++ {
+		if sv.Type().Field(i).Anonymous || sv.Field(i).IsZero() {
+			continue
+		}
+		dv.Field(i).Set(sv.Field(i))
+	}
+
+	dst.ID, dst.Path, dst.Base = id, path, base
+	dst.Constraint, dst.Mapping, dst.Condition, dst.Alias, dst.Extension = constraints, mappings, conditions, aliases, extensions
+}
+
+func replaceConstraint(constraints []fhir5.ElementDefinitionConstraint, c fhir5.ElementDefinitionConstraint) []fhir5.ElementDefinitionConstraint {
+	for i := range constraints {
+		if constraints[i].Key == c.Key {
+			constraints[i] = c
+			return constraints
+		}
+	}
+	return append(constraints, c)
+}
+
+func replaceExtension(extensions []common.Extension, e common.Extension) []common.Extension {
+	for i := range extensions {
+		if extensions[i].URL == e.URL {
+			extensions[i] = e
+			return extensions
+		}
+	}
+	return append(extensions, e)
+}
+
+func union(a, b []string) []string {
+	for _, s := range b {
+		found := false
+		for _, existing := range a {
+			if existing == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, s)
+		}
+	}
+	return a
+}
+
+// setBase records an element as its own base if it has none
+func setBase(e *fhir5.ElementDefinition) {
+	if e.Base != nil {
+		return
+	}
+	base := &fhir5.ElementDefinitionBase{Path: e.Path, Max: "*"}
+	if e.Min != nil {
+		base.Min = *e.Min
+	}
+	if e.Max != nil {
+		base.Max = *e.Max
+	}
+	e.Base = base
+}
+
+// differentialIDs returns the ids of the differential elements. Elements
+// without an id get one from their path and slice name, within the slice
+// of the closest preceding element whose path contains theirs.
+func differentialIDs(elements []fhir5.ElementDefinition) []string {
+	ids := make([]string, len(elements))
+	for i := range elements {
+		e := &elements[i]
+		if e.ID != nil {
+			ids[i] = *e.ID
+			continue
+		}
+		id := e.Path
+		for j := i - 1; j >= 0; j-- {
+			if rest, ok := strings.CutPrefix(e.Path, elements[j].Path); ok && strings.HasPrefix(rest, ".") {
+				id = ids[j] + rest
+				break
+			}
+		}
+		if e.SliceName != nil {
+			id += ":" + *e.SliceName
+		}
+		ids[i] = id
+	}
+	return ids
+}
+
+// elementID returns the id of an element, which defaults to its path
+func elementID(e *fhir5.ElementDefinition) string {
+	if e.ID != nil {
+		return *e.ID
+	}
+	return e.Path
+}
+
+// replaceRoot replaces the first name of an id or path
+func replaceRoot(s, from, to string) string {
+	if s == from {
+		return to
+	}
+	if rest, ok := strings.CutPrefix(s, from); ok && strings.ContainsRune(".:", rune(rest[0])) {
+		return to + rest
+	}
+	return s
+}
+
+// cloneElements returns a deep copy of elements
+func cloneElements(elements []fhir5.ElementDefinition) []fhir5.ElementDefinition {
+	data, _ := json.Marshal(elements)
+	var copied []fhir5.ElementDefinition
+	_ = json.Unmarshal(data, &copied)
+	return copied
+}
+
+// cloneDefinition returns a deep copy of sd
+func cloneDefinition(sd *fhir5.StructureDefinition) *fhir5.StructureDefinition {
+	data, _ := json.Marshal(sd)
+	var copied fhir5.StructureDefinition
+	_ = json.Unmarshal(data, &copied)
+	return &copied
+}
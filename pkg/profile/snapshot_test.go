@@ -0,0 +1,287 @@
+package profile
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+const testdata = "../fhir5/testdata/fhir5-json/*.profile.json"
+
+func newTestGenerator(t *testing.T) (*Definitions, *Generator) {
+	definitions := NewDefinitions()
+	if err := definitions.Load(testdata); err != nil {
+		t.Fatalf("failed to load profiles: %v", err)
+	}
+	return definitions, NewGenerator(definitions)
+}
+
+func TestGenerator_Snapshot_PublishedProfiles(t *testing.T) {
+	skip := map[string]bool{
+		// the published snapshot replaces Composition.date with its slice
+		"http://hl7.org/fhir/StructureDefinition/catalog": true,
+		// the published snapshot slices citeAs[x] without a type slice
+		"http://hl7.org/fhir/StructureDefinition/ebmrecommendation": true,
+		// the extension definition is not part of the test data
+		"http://hl7.org/fhir/StructureDefinition/executablevalueset": true,
+	}
+	definitions, generator := newTestGenerator(t)
+
+	count := 0
+	for canonical, sd := range definitions.definitions {
+		if skip[canonical] || strings.Contains(canonical, "|") {
+			continue
+		}
+		if sd.Derivation == nil || *sd.Derivation != fhir5.StructureDefinitionDerivationConstraint {
+			continue
+		}
+		differentialOnly := *sd
+		differentialOnly.Snapshot = nil
+		generated, err := generator.Snapshot(&differentialOnly)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", canonical, err)
+			continue
+		}
+		if diff := compareElements(generated.Snapshot.Element, sd.Snapshot.Element); diff != "" {
+			t.Errorf("%s: %s", canonical, diff)
+		}
+		if differentialOnly.Snapshot != nil {
+			t.Errorf("%s: expected the input to be unchanged", canonical)
+		}
+		count++
+	}
+	if count < 50 {
+		t.Errorf("expected at least 50 constraint profiles, got %d", count)
+	}
+}
+
+// compareElements returns the first difference between generated and
+// published snapshot elements or an empty string
+func compareElements(got, want []fhir5.ElementDefinition) string {
+	for i := 0; i < len(got) && i < len(want); i++ {
+		g, w := &got[i], &want[i]
+		id := elementID(w)
+		_, gotFixed := g.Fixed()
+		_, wantFixed := w.Fixed()
+		_, gotPattern := g.Pattern()
+		_, wantPattern := w.Pattern()
+		switch {
+		case elementID(g) != id:
+			return fmt.Sprintf("element %d is %s, expected %s", i, elementID(g), id)
+		case g.Path != w.Path:
+			return fmt.Sprintf("%s: path %s, expected %s", id, g.Path, w.Path)
+		case fmt.Sprint(derefInt(g.Min), derefString(g.Max)) != fmt.Sprint(derefInt(w.Min), derefString(w.Max)):
+			return fmt.Sprintf("%s: cardinality %v..%s, expected %v..%s", id, derefInt(g.Min), derefString(g.Max), derefInt(w.Min), derefString(w.Max))
+		case derefString(g.SliceName) != derefString(w.SliceName):
+			return fmt.Sprintf("%s: slice name %s, expected %s", id, derefString(g.SliceName), derefString(w.SliceName))
+		case (g.Slicing == nil) != (w.Slicing == nil):
+			return fmt.Sprintf("%s: slicing %+v, expected %+v", id, g.Slicing, w.Slicing)
+		case typeSignature(g) != typeSignature(w):
+			return fmt.Sprintf("%s: types %s, expected %s", id, typeSignature(g), typeSignature(w))
+		case g.Base == nil || g.Base.Path != w.Base.Path || g.Base.Min != w.Base.Min || g.Base.Max != w.Base.Max:
+			return fmt.Sprintf("%s: base %+v, expected %+v", id, g.Base, w.Base)
+		case gotFixed != wantFixed || gotPattern != wantPattern:
+			return fmt.Sprintf("%s: fixed %s and pattern %s, expected %s and %s", id, gotFixed, gotPattern, wantFixed, wantPattern)
+		case len(g.Constraint) != len(w.Constraint):
+			return fmt.Sprintf("%s: %d constraints, expected %d", id, len(g.Constraint), len(w.Constraint))
+		case (g.Binding == nil) != (w.Binding == nil) || (g.Binding != nil && g.Binding.Strength != w.Binding.Strength):
+			return fmt.Sprintf("%s: binding %+v, expected %+v", id, g.Binding, w.Binding)
+		case (g.MustSupport == nil) != (w.MustSupport == nil):
+			return fmt.Sprintf("%s: must support %v, expected %v", id, g.MustSupport, w.MustSupport)
+		}
+	}
+	if len(got) != len(want) {
+		return fmt.Sprintf("%d elements, expected %d", len(got), len(want))
+	}
+	return ""
+}
+
+func typeSignature(e *fhir5.ElementDefinition) string {
+	var codes []string
+	for _, t := range e.Type {
+		codes = append(codes, t.Code+strings.Join(t.Profile, ","))
+	}
+	return strings.Join(codes, " ")
+}
+
+func derefInt(i *int) interface{} {
+	if i == nil {
+		return nil
+	}
+	return *i
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func TestGenerator_Snapshot(t *testing.T) {
+	constraint := fhir5.StructureDefinitionDerivationConstraint
+	specialization := fhir5.StructureDefinitionDerivationSpecialization
+
+	tests := []struct {
+		name       string
+		sd         fhir5.StructureDefinition
+		check      func(elements map[string]fhir5.ElementDefinition, ids []string) error
+		errMessage string
+	}{
+		{
+			name: "slices without ids",
+			sd: fhir5.StructureDefinition{
+				Url:            "http://example.org/fhir/StructureDefinition/mrn-patient",
+				Type:           "Patient",
+				BaseDefinition: fhir5.StringPtr(coreURL + "Patient"),
+				Derivation:     &constraint,
+				Differential: &fhir5.StructureDefinitionDifferential{Element: []fhir5.ElementDefinition{
+					{Path: "Patient.active", Min: fhir5.IntPtr(1), PatternBoolean: fhir5.BoolPtr(true)},
+					{Path: "Patient.identifier", Slicing: &fhir5.ElementDefinitionSlicing{Rules: fhir5.ElementDefinitionSlicingRulesOpen}},
+					{Path: "Patient.identifier", SliceName: fhir5.StringPtr("mrn"), Min: fhir5.IntPtr(1)},
+					{Path: "Patient.identifier.system", Min: fhir5.IntPtr(1), FixedUri: fhir5.StringPtr("http://example.org/mrn")},
+					{Path: "Patient.identifier", SliceName: fhir5.StringPtr("mrn/old"), Max: fhir5.StringPtr("1")},
+				}},
+			},
+			check: func(elements map[string]fhir5.ElementDefinition, ids []string) error {
+				if e := elements["Patient.active"]; *e.Min != 1 || e.PatternBoolean == nil || e.Base.Min != 0 {
+					return fmt.Errorf("unexpected Patient.active %+v", e)
+				}
+				if e := elements["Patient.identifier:mrn"]; *e.SliceName != "mrn" || *e.Min != 1 || e.Base.Path != "Patient.identifier" {
+					return fmt.Errorf("unexpected slice %+v", e)
+				}
+				if e := elements["Patient.identifier:mrn.system"]; *e.Min != 1 || e.FixedUri == nil {
+					return fmt.Errorf("unexpected slice system %+v", e)
+				}
+				if e, ok := elements["Patient.identifier.system"]; ok {
+					return fmt.Errorf("expected the sliced element to keep its type unexpanded, got %+v", e)
+				}
+				if e := elements["Patient.identifier:mrn/old"]; *e.SliceName != "mrn/old" || *e.Max != "1" {
+					return fmt.Errorf("unexpected reslice %+v", e)
+				}
+				if e := elements["Patient.identifier:mrn/old.system"]; e.FixedUri == nil {
+					return fmt.Errorf("expected the reslice to start from its slice, got %+v", e)
+				}
+				return inOrder(ids, "Patient.identifier", "Patient.identifier:mrn", "Patient.identifier:mrn.system",
+					"Patient.identifier:mrn.assigner", "Patient.identifier:mrn/old", "Patient.identifier:mrn/old.assigner", "Patient.active")
+			},
+		},
+		{
+			name: "choice types and data types",
+			sd: fhir5.StructureDefinition{
+				Url:            "http://example.org/fhir/StructureDefinition/note",
+				Type:           "Observation",
+				BaseDefinition: fhir5.StringPtr(coreURL + "Observation"),
+				Differential: &fhir5.StructureDefinitionDifferential{Element: []fhir5.ElementDefinition{
+					{Path: "Observation.valueString", Min: fhir5.IntPtr(1)},
+					{Path: "Observation.subject.display", Min: fhir5.IntPtr(1)},
+				}},
+			},
+			check: func(elements map[string]fhir5.ElementDefinition, ids []string) error {
+				choice := elements["Observation.value[x]"]
+				if choice.Slicing == nil || choice.Slicing.Discriminator[0].Path != "$this" || len(choice.Type) != 1 || *choice.Min != 1 {
+					return fmt.Errorf("unexpected choice element %+v", choice)
+				}
+				if e := elements["Observation.value[x]:valueString"]; len(e.Type) != 1 || e.Type[0].Code != "string" {
+					return fmt.Errorf("unexpected type slice %+v", e)
+				}
+				if e := elements["Observation.subject.display"]; *e.Min != 1 || e.Base.Path != "Reference.display" {
+					return fmt.Errorf("unexpected data type element %+v", e)
+				}
+				return inOrder(ids, "Observation.subject", "Observation.subject.reference", "Observation.subject.display", "Observation.focus")
+			},
+		},
+		{
+			name: "specialization",
+			sd: fhir5.StructureDefinition{
+				Url:            "http://example.org/fhir/StructureDefinition/Example",
+				Type:           "Example",
+				BaseDefinition: fhir5.StringPtr(coreURL + "DomainResource"),
+				Derivation:     &specialization,
+				Differential: &fhir5.StructureDefinitionDifferential{Element: []fhir5.ElementDefinition{
+					{Path: "Example"},
+					{Path: "Example.status", Min: fhir5.IntPtr(1), Max: fhir5.StringPtr("1"), Type: []fhir5.ElementDefinitionType{{Code: "code"}}},
+				}},
+			},
+			check: func(elements map[string]fhir5.ElementDefinition, ids []string) error {
+				if e := elements["Example"]; e.Base.Path != "Example" {
+					return fmt.Errorf("unexpected root %+v", e)
+				}
+				if e := elements["Example.text"]; e.Base.Path != "DomainResource.text" {
+					return fmt.Errorf("unexpected inherited element %+v", e)
+				}
+				if e := elements["Example.status"]; e.Base.Path != "Example.status" || e.Base.Max != "1" {
+					return fmt.Errorf("unexpected new element %+v", e)
+				}
+				return inOrder(ids, "Example", "Example.modifierExtension", "Example.status")
+			},
+		},
+		{
+			name: "unknown element",
+			sd: fhir5.StructureDefinition{
+				Url:            "http://example.org/fhir/StructureDefinition/unknown",
+				Type:           "Patient",
+				BaseDefinition: fhir5.StringPtr(coreURL + "Patient"),
+				Derivation:     &constraint,
+				Differential: &fhir5.StructureDefinitionDifferential{Element: []fhir5.ElementDefinition{
+					{Path: "Patient.nickname"},
+				}},
+			},
+			errMessage: "no element Patient.nickname in the base definition",
+		},
+		{
+			name: "unknown base",
+			sd: fhir5.StructureDefinition{
+				Url:            "http://example.org/fhir/StructureDefinition/unknown-base",
+				Type:           "Patient",
+				BaseDefinition: fhir5.StringPtr("http://example.org/fhir/StructureDefinition/missing"),
+				Derivation:     &constraint,
+				Differential:   &fhir5.StructureDefinitionDifferential{},
+			},
+			errMessage: "structure definition http://example.org/fhir/StructureDefinition/missing is not available",
+		},
+	}
+
+	_, generator := newTestGenerator(t)
+	for _, tt := range tests {
+		generated, err := generator.Snapshot(&tt.sd)
+		if tt.errMessage != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.errMessage) {
+				t.Errorf("%s: expected error %q, got %v", tt.name, tt.errMessage, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		elements := map[string]fhir5.ElementDefinition{}
+		var ids []string
+		for _, e := range generated.Snapshot.Element {
+			if e.Base == nil {
+				t.Errorf("%s: %s has no base", tt.name, *e.ID)
+			}
+			elements[*e.ID] = e
+			ids = append(ids, *e.ID)
+		}
+		if err := tt.check(elements, ids); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+	}
+}
+
+// inOrder returns an error unless the ids contain the expected ones in order
+func inOrder(ids []string, expected ...string) error {
+	i := 0
+	for _, id := range ids {
+		if i < len(expected) && id == expected[i] {
+			i++
+		}
+	}
+	if i < len(expected) {
+		return fmt.Errorf("expected %s after %v in %v", expected[i], expected[:i], ids)
+	}
+	return nil
+}
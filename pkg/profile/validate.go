@@ -0,0 +1,818 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Issue type codes used by the validator
+const (
+	IssueTypeRequired      = "required"
+	IssueTypeStructure     = "structure"
+	IssueTypeValue         = "value"
+	IssueTypeCodeInvalid   = "code-invalid"
+	IssueTypeNotFound      = "not-found"
+	IssueTypeInformational = "informational"
+)
+
+// Terminology checks codes against value sets for the bindings of a profile,
+// e.g. a *terminology.Service
+type Terminology interface {
+	// ValidateValueSetCode returns the output parameters of
+	// ValueSet/$validate-code, result and message, for codings of a value
+	ValidateValueSetCode(canonical string, codings ...common.Coding) (*fhir5.Parameters, error)
+}
+
+// Validator checks resources against profiles. It is safe for concurrent use.
+type Validator struct {
+	generator   *Generator
+	terminology Terminology
+}
+
+// NewValidator returns a validator that resolves profiles through resolver
+// and checks bindings with terminology. Without terminology bindings are not
+// checked.
+func NewValidator(resolver Resolver, terminology Terminology) *Validator {
+	return &Validator{generator: NewGenerator(resolver), terminology: terminology}
+}
+
+// Validate checks r against the profiles of its meta.profile and the given
+// profiles: the cardinality of their elements, fixed and pattern values, the
+// types of choice elements, the targets of references, slicing and the
+// bindings to value sets. Profiles without a snapshot get one first.
+//
+// Violations are errors, codes outside of extensible bindings warnings and
+// absent mustSupport elements informational issues. Each issue locates the
+// element with a FHIRPath expression, e.g. "Observation.component[0].code",
+// and names the element of the profile in its diagnostics. A resource without
+// issues yields a single informational issue.
+func (v *Validator) Validate(r common.Resource, profiles ...string) *fhir5.OperationOutcome {
+	c := &checker{v: v, indexes: map[*fhir5.StructureDefinition]*index{}}
+	resource := map[string]interface{}{}
+	if data, err := json.Marshal(r); err != nil {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityFatal, IssueTypeStructure, r.GetResourceType(), "%v", err)
+	} else if err := json.Unmarshal(data, &resource); err != nil {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityFatal, IssueTypeStructure, r.GetResourceType(), "%v", err)
+	}
+
+	root := node{value: resource, typeName: r.GetResourceType(), path: r.GetResourceType()}
+	for _, canonical := range union(metaProfiles(resource), profiles) {
+		sd, err := v.profile(canonical)
+		if err != nil {
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeNotFound, root.path, "%v", err)
+			continue
+		}
+		if sd.Type != root.typeName {
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, root.path, "profile %s constrains %s, not %s", canonical, sd.Type, root.typeName)
+			continue
+		}
+		c.conforms(sd, root)
+	}
+
+	if len(c.issues) == 0 {
+		c.issues = append(c.issues, fhir5.OperationOutcomeIssue{
+			Severity:    fhir5.OperationOutcomeIssueSeverityInformation,
+			Code:        IssueTypeInformational,
+			Diagnostics: fhir5.StringPtr("All OK"),
+		})
+	}
+	return &fhir5.OperationOutcome{ResourceType: "OperationOutcome", Issue: c.issues}
+}
+
+// profile returns the StructureDefinition of a canonical with a snapshot
+func (v *Validator) profile(canonical string) (*fhir5.StructureDefinition, error) {
+	v.generator.mu.Lock()
+	defer v.generator.mu.Unlock()
+	return v.generator.definition(canonical, map[string]bool{})
+}
+
+// metaProfiles returns the profiles a resource claims to conform to
+func metaProfiles(resource map[string]interface{}) []string {
+	meta, _ := resource["meta"].(map[string]interface{})
+	values, _ := meta["profile"].([]interface{})
+	var profiles []string
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			profiles = append(profiles, s)
+		}
+	}
+	return profiles
+}
+
+// index looks up the elements of a snapshot by their relations
+type index struct {
+	// children by the id of their parent, without slices
+	children map[string][]*fhir5.ElementDefinition
+	// slices by the id of the sliced element, or of the slice they reslice
+	slices map[string][]*fhir5.ElementDefinition
+}
+
+func newIndex(sd *fhir5.StructureDefinition) *index {
+	ix := &index{
+		children: map[string][]*fhir5.ElementDefinition{},
+		slices:   map[string][]*fhir5.ElementDefinition{},
+	}
+	for i := range sd.Snapshot.Element {
+		e := &sd.Snapshot.Element[i]
+		id := elementID(e)
+		dot := strings.LastIndex(id, ".")
+		if dot < 0 {
+			continue
+		}
+		parent, last := id[:dot], id[dot+1:]
+		name, slice, ok := strings.Cut(last, ":")
+		switch {
+		case !ok:
+			ix.children[parent] = append(ix.children[parent], e)
+		case strings.Contains(slice, "/"):
+			sliced := parent + "." + name + ":" + slice[:strings.LastIndex(slice, "/")]
+			ix.slices[sliced] = append(ix.slices[sliced], e)
+		default:
+			ix.slices[parent+"."+name] = append(ix.slices[parent+"."+name], e)
+		}
+	}
+	return ix
+}
+
+// childrenOf returns the children of an element, those of the element its
+// contentReference points to if it has none
+func (ix *index) childrenOf(e *fhir5.ElementDefinition) []*fhir5.ElementDefinition {
+	children := ix.children[elementID(e)]
+	if len(children) == 0 && e.ContentReference != nil {
+		_, ref, _ := strings.Cut(*e.ContentReference, "#")
+		children = ix.children[ref]
+	}
+	return children
+}
+
+// child returns the child of an element with a name, e.g. value for value[x]
+func (ix *index) child(e *fhir5.ElementDefinition, name string) *fhir5.ElementDefinition {
+	for _, child := range ix.childrenOf(e) {
+		childName := lastName(child.Path)
+		if childName == name || childName == name+"[x]" {
+			return child
+		}
+	}
+	return nil
+}
+
+// node is a value of a resource as generic JSON
+type node struct {
+	value interface{}
+	// typeName is the FHIR type of the value if it is known, from the type
+	// suffix of a choice element, its resourceType or the single type of its
+	// element
+	typeName string
+	// path is the FHIRPath expression of the value, e.g. Observation.code
+	path string
+}
+
+// children returns the values of the child elements with a name, values
+// of a choice element, e.g. value[x], by the names of their types
+func (n node) children(name string, e *fhir5.ElementDefinition) []node {
+	object, ok := n.value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var nodes []node
+	add := func(key, typeName string) {
+		path := n.path + "." + strings.TrimSuffix(name, "[x]")
+		value, ok := object[key]
+		if !ok {
+			// a primitive that only has an id or extensions
+			value, ok = object["_"+key]
+			if !ok {
+				return
+			}
+		}
+		if values, ok := value.([]interface{}); ok {
+			for i, value := range values {
+				nodes = append(nodes, newNode(value, typeName, path+"["+strconv.Itoa(i)+"]"))
+			}
+			return
+		}
+		nodes = append(nodes, newNode(value, typeName, path))
+	}
+
+	if base, ok := strings.CutSuffix(name, "[x]"); ok {
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if typeName, ok := strings.CutPrefix(key, base); ok && typeName != "" && typeName[0] >= 'A' && typeName[0] <= 'Z' {
+				add(key, typeName)
+			}
+		}
+		return nodes
+	}
+	typeName := ""
+	if e != nil && len(e.Type) == 1 {
+		typeName = e.Type[0].Code
+	}
+	add(name, typeName)
+	return nodes
+}
+
+func newNode(value interface{}, typeName, path string) node {
+	if object, ok := value.(map[string]interface{}); ok {
+		if resourceType, ok := object["resourceType"].(string); ok {
+			typeName = resourceType
+		}
+	}
+	return node{value: value, typeName: typeName, path: path}
+}
+
+// checker collects the issues found while checking a resource
+type checker struct {
+	v       *Validator
+	indexes map[*fhir5.StructureDefinition]*index
+	issues  []fhir5.OperationOutcomeIssue
+}
+
+// addIssue adds an issue unless the same issue was found before, e.g. by
+// another profile
+func (c *checker) addIssue(severity fhir5.OperationOutcomeIssueSeverity, code, path, format string, args ...interface{}) {
+	diagnostics := fmt.Sprintf(format, args...)
+	for _, issue := range c.issues {
+		if issue.Severity == severity && issue.Code == code && issue.Expression[0] == path && *issue.Diagnostics == diagnostics {
+			return
+		}
+	}
+	c.issues = append(c.issues, fhir5.OperationOutcomeIssue{
+		Severity:    severity,
+		Code:        code,
+		Diagnostics: fhir5.StringPtr(diagnostics),
+		Expression:  []string{path},
+	})
+}
+
+func (c *checker) index(sd *fhir5.StructureDefinition) *index {
+	ix, ok := c.indexes[sd]
+	if !ok {
+		ix = newIndex(sd)
+		c.indexes[sd] = ix
+	}
+	return ix
+}
+
+// conforms checks a value against the root element of a profile
+func (c *checker) conforms(sd *fhir5.StructureDefinition, n node) {
+	ix := c.index(sd)
+	c.value(ix, &sd.Snapshot.Element[0], n)
+}
+
+// errors returns whether checking found errors
+func (c *checker) errors() bool {
+	for _, issue := range c.issues {
+		if issue.Severity == fhir5.OperationOutcomeIssueSeverityError || issue.Severity == fhir5.OperationOutcomeIssueSeverityFatal {
+			return true
+		}
+	}
+	return false
+}
+
+// sub returns a checker for trying whether a value conforms
+func (c *checker) sub() *checker {
+	return &checker{v: c.v, indexes: c.indexes}
+}
+
+// element checks the values of an element, path is the expression of the
+// element where it has no values
+func (c *checker) element(ix *index, e *fhir5.ElementDefinition, nodes []node, path string) {
+	id := elementID(e)
+	if e.Min != nil && len(nodes) < *e.Min {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeRequired, path, "%s: minimum required = %d, but only found %d", id, *e.Min, len(nodes))
+	}
+	if e.Max != nil && *e.Max != "*" {
+		if maximum, err := strconv.Atoi(*e.Max); err == nil && len(nodes) > maximum {
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, path, "%s: maximum allowed = %d, but found %d", id, maximum, len(nodes))
+		}
+	}
+	if len(nodes) == 0 && e.MustSupport != nil && *e.MustSupport {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityInformation, IssueTypeInformational, path, "%s: the must support element is not present", id)
+	}
+	if e.Slicing != nil && len(ix.slices[id]) > 0 {
+		// without values the cardinality of the slices is still checked
+		c.slicing(ix, e, nodes, path)
+	}
+	for _, n := range nodes {
+		c.value(ix, e, n)
+	}
+}
+
+// value checks a single value of an element and its children
+func (c *checker) value(ix *index, e *fhir5.ElementDefinition, n node) {
+	id := elementID(e)
+	if strings.HasSuffix(e.Path, "[x]") && n.typeName != "" && typeOf(e, n.typeName) == nil {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, n.path, "%s: the type %s is not one of %s", id, n.typeName, typeCodes(e))
+	}
+	if fixed, typeName := e.Fixed(); typeName != "" && !reflect.DeepEqual(n.value, genericValue(fixed)) {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeValue, n.path, "%s: the value must be %s", id, jsonString(fixed))
+	}
+	if pattern, typeName := e.Pattern(); typeName != "" && !matchesPattern(n.value, genericValue(pattern)) {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeValue, n.path, "%s: the value must match %s", id, jsonString(pattern))
+	}
+	c.reference(ix, e, n)
+	c.binding(e, n)
+	c.typeProfiles(e, n)
+
+	for _, child := range ix.childrenOf(e) {
+		name := lastName(child.Path)
+		c.element(ix, child, n.children(name, child), n.path+"."+strings.TrimSuffix(name, "[x]"))
+	}
+}
+
+// slicing assigns the values of a sliced element to its slices and checks
+// them against the slices they belong to
+func (c *checker) slicing(ix *index, e *fhir5.ElementDefinition, nodes []node, path string) {
+	id := elementID(e)
+	slices := ix.slices[id]
+	matched := make([][]node, len(slices))
+	last, unmatched := 0, false
+	for _, n := range nodes {
+		slice := -1
+		for i, s := range slices {
+			if c.inSlice(ix, e, s, n) {
+				slice = i
+				break
+			}
+		}
+		switch {
+		case slice < 0 && e.Slicing.Rules == fhir5.ElementDefinitionSlicingRulesClosed:
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, n.path, "%s: the value does not match any slice and the slicing is closed", id)
+		case slice < 0:
+			unmatched = true
+		case unmatched && e.Slicing.Rules == fhir5.ElementDefinitionSlicingRulesOpenAtEnd:
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, n.path, "%s: values that match no slice must follow the slices", id)
+		case slice < last && e.Slicing.Ordered != nil && *e.Slicing.Ordered:
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, n.path, "%s: the value of slice %s is out of order", id, *slices[slice].SliceName)
+		}
+		if slice >= 0 {
+			matched[slice] = append(matched[slice], n)
+			last = max(last, slice)
+		}
+	}
+	for i, s := range slices {
+		c.element(ix, s, matched[i], path)
+	}
+}
+
+// inSlice reports whether a value belongs to a slice by the discriminators
+// of the slicing. A value belongs to the slices of slicing without
+// discriminators, with profile discriminators or discriminators that cannot
+// be evaluated if it conforms to them.
+func (c *checker) inSlice(ix *index, sliced, slice *fhir5.ElementDefinition, n node) bool {
+	if len(sliced.Slicing.Discriminator) == 0 {
+		return c.sliceConforms(ix, slice, n)
+	}
+	for _, d := range sliced.Slicing.Discriminator {
+		e, expected, values, ok := discriminate(ix, slice, d.Path, n)
+		if !ok || d.Type == fhir5.ElementDefinitionSlicingDiscriminatorTypeProfile || d.Type == fhir5.ElementDefinitionSlicingDiscriminatorTypePosition {
+			return c.sliceConforms(ix, slice, n)
+		}
+		switch d.Type {
+		case fhir5.ElementDefinitionSlicingDiscriminatorTypeValue, fhir5.ElementDefinitionSlicingDiscriminatorTypePattern:
+			if expected != nil && !matchesAny(values, expected) {
+				return false
+			}
+			if expected == nil && e != nil {
+				// the values are given by the children or slices of the
+				// element, e.g. by the fixed system and code of a coding
+				sub := c.sub()
+				sub.element(ix, e, values, n.path)
+				if sub.errors() {
+					return false
+				}
+			}
+		case fhir5.ElementDefinitionSlicingDiscriminatorTypeExists:
+			if e != nil && e.Min != nil && *e.Min > 0 && len(values) == 0 || e != nil && e.Max != nil && *e.Max == "0" && len(values) > 0 {
+				return false
+			}
+		case fhir5.ElementDefinitionSlicingDiscriminatorTypeType:
+			for _, value := range values {
+				if e != nil && value.typeName != "" && typeOf(e, value.typeName) == nil {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// sliceConforms reports whether a value conforms to a slice
+func (c *checker) sliceConforms(ix *index, slice *fhir5.ElementDefinition, n node) bool {
+	sub := c.sub()
+	sub.value(ix, slice, n)
+	return !sub.errors()
+}
+
+// discriminate follows the path of a discriminator from a slice and a value.
+// It returns the element at the path, the fixed or pattern value expected
+// there, which may come from an element on the path, and the values at the
+// path. ok is false for paths that cannot be followed, e.g. resolve().
+func discriminate(ix *index, slice *fhir5.ElementDefinition, path string, n node) (e *fhir5.ElementDefinition, expected interface{}, values []node, ok bool) {
+	e, values = slice, []node{n}
+	expected = expectedValue(e)
+	for _, segment := range splitPath(path) {
+		switch {
+		case segment == "$this":
+			continue
+		case strings.HasPrefix(segment, "ofType(") && strings.HasSuffix(segment, ")"):
+			typeName := segment[len("ofType(") : len(segment)-1]
+			var typed []node
+			for _, value := range values {
+				if value.typeName == "" || strings.EqualFold(value.typeName, typeName) {
+					typed = append(typed, value)
+				}
+			}
+			values = typed
+			continue
+		case strings.HasPrefix(segment, "extension(") && strings.HasSuffix(segment, ")"):
+			url := strings.Trim(segment[len("extension("):len(segment)-1], `'"`)
+			var extensions []node
+			for _, value := range values {
+				for _, extension := range value.children("extension", nil) {
+					if object, ok := extension.value.(map[string]interface{}); ok && object["url"] == url {
+						extensions = append(extensions, extension)
+					}
+				}
+			}
+			values = extensions
+			e = extensionSlice(ix, e, url)
+			expected = nil
+			if e != nil {
+				expected = expectedValue(e)
+			}
+			continue
+		case strings.Contains(segment, "("):
+			return nil, nil, nil, false
+		}
+
+		var next []node
+		var child *fhir5.ElementDefinition
+		var fromSlices []interface{}
+		if e != nil {
+			child = ix.child(e, segment)
+			// the values of a sliced element may be given by its slices,
+			// e.g. the code of a coding
+			for _, slice := range ix.slices[elementID(e)] {
+				if sliceChild := ix.child(slice, segment); sliceChild != nil && expectedValue(sliceChild) != nil {
+					fromSlices = append(fromSlices, expectedValue(sliceChild))
+				}
+			}
+		}
+		name := segment
+		if child != nil {
+			name = lastName(child.Path)
+		}
+		for _, value := range values {
+			next = append(next, value.children(name, child)...)
+		}
+		values = next
+		expected = childValues(expected, segment)
+		if segment == "url" && e != nil && expected == nil {
+			// an extension slice without children is identified by the
+			// url of its extension definition
+			if t := typeOf(e, "Extension"); t != nil && len(t.Profile) == 1 {
+				expected = t.Profile[0]
+			}
+		}
+		if expected == nil && len(fromSlices) > 0 {
+			expected = fromSlices
+		}
+		e = child
+		if e != nil {
+			if own := expectedValue(e); own != nil {
+				expected = own
+			}
+		}
+	}
+	return e, expected, values, true
+}
+
+// extensionSlice returns the slice of the extensions of an element for the
+// extension definition with the url
+func extensionSlice(ix *index, e *fhir5.ElementDefinition, url string) *fhir5.ElementDefinition {
+	if e == nil {
+		return nil
+	}
+	extensions := ix.child(e, "extension")
+	if extensions == nil {
+		return nil
+	}
+	for _, slice := range ix.slices[elementID(extensions)] {
+		for _, t := range slice.Type {
+			for _, profile := range t.Profile {
+				if profile == url {
+					return slice
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// expectedValue returns the fixed or pattern value of an element as generic JSON
+func expectedValue(e *fhir5.ElementDefinition) interface{} {
+	if fixed, typeName := e.Fixed(); typeName != "" {
+		return genericValue(fixed)
+	}
+	if pattern, typeName := e.Pattern(); typeName != "" {
+		return genericValue(pattern)
+	}
+	return nil
+}
+
+// childValues returns the values of a property of a generic JSON value, an
+// array of values for arrays
+func childValues(value interface{}, name string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return v[name]
+	case []interface{}:
+		var values []interface{}
+		for _, item := range v {
+			switch child := childValues(item, name).(type) {
+			case nil:
+			case []interface{}:
+				values = append(values, child...)
+			default:
+				values = append(values, child)
+			}
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		return values
+	}
+	return nil
+}
+
+// matchesAny reports whether one of the values matches the expected value,
+// or one of them if it is an array
+func matchesAny(values []node, expected interface{}) bool {
+	candidates, ok := expected.([]interface{})
+	if !ok {
+		candidates = []interface{}{expected}
+	}
+	for _, value := range values {
+		for _, candidate := range candidates {
+			if matchesPattern(value.value, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesPattern reports whether a value has every property and array item
+// of a pattern
+func matchesPattern(value, pattern interface{}) bool {
+	switch p := pattern.(type) {
+	case map[string]interface{}:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, property := range p {
+			if !matchesPattern(v[key], property) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		v, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, item := range p {
+			found := false
+			for _, candidate := range v {
+				if matchesPattern(candidate, item) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(value, pattern)
+}
+
+// reference checks the type of the resource a reference points to against
+// the target profiles of the element
+func (c *checker) reference(ix *index, e *fhir5.ElementDefinition, n node) {
+	t := typeOf(e, "Reference")
+	object, ok := n.value.(map[string]interface{})
+	if t == nil || !ok || len(t.TargetProfile) == 0 || n.typeName != "" && n.typeName != "Reference" {
+		return
+	}
+	target := referenceType(object)
+	if target == "" {
+		return
+	}
+	var allowed []string
+	for _, canonical := range t.TargetProfile {
+		sd, err := c.v.profile(canonical)
+		if err != nil {
+			// a target that cannot be resolved allows any type
+			return
+		}
+		if sd.Type == target || sd.Type == "Resource" || sd.Type == "DomainResource" {
+			return
+		}
+		allowed = append(allowed, sd.Type)
+	}
+	c.addIssue(fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, n.path, "%s: the reference to a %s is not to one of %s", elementID(e), target, strings.Join(allowed, ", "))
+}
+
+// referenceType returns the resource type of the target of a reference, if
+// it is known from its type or its literal reference
+func referenceType(reference map[string]interface{}) string {
+	if t, ok := reference["type"].(string); ok {
+		return t
+	}
+	literal, _ := reference["reference"].(string)
+	if literal == "" || strings.HasPrefix(literal, "#") {
+		return ""
+	}
+	parts := strings.Split(literal, "/")
+	if len(parts) >= 4 && parts[len(parts)-2] == "_history" {
+		parts = parts[:len(parts)-2]
+	}
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
+
+// binding checks a code, Coding or CodeableConcept against the value set of a
+// binding. Codes outside of required bindings are errors, outside of
+// extensible bindings warnings and outside of preferred bindings
+// informational issues.
+func (c *checker) binding(e *fhir5.ElementDefinition, n node) {
+	b := e.Binding
+	if c.v.terminology == nil || b == nil || b.ValueSet == nil || b.Strength == fhir5.ElementDefinitionBindingStrengthExample {
+		return
+	}
+	codings := codingsOf(n.value)
+	if len(codings) == 0 {
+		return
+	}
+	outcome, err := c.v.terminology.ValidateValueSetCode(*b.ValueSet, codings...)
+	if err != nil {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityWarning, IssueTypeNotFound, n.path, "%s: the binding cannot be checked: %v", elementID(e), err)
+		return
+	}
+	result, message := false, ""
+	for _, p := range outcome.Parameter {
+		switch {
+		case p.Name == "result" && p.ValueBoolean != nil:
+			result = *p.ValueBoolean
+		case p.Name == "message" && p.ValueString != nil:
+			message = *p.ValueString
+		}
+	}
+	if result {
+		return
+	}
+	severity := fhir5.OperationOutcomeIssueSeverityInformation
+	switch b.Strength {
+	case fhir5.ElementDefinitionBindingStrengthRequired:
+		severity = fhir5.OperationOutcomeIssueSeverityError
+	case fhir5.ElementDefinitionBindingStrengthExtensible:
+		severity = fhir5.OperationOutcomeIssueSeverityWarning
+	}
+	c.addIssue(severity, IssueTypeCodeInvalid, n.path, "%s: the code is not in the %s value set %s: %s", elementID(e), b.Strength, *b.ValueSet, message)
+}
+
+// codingsOf returns the codings of a code, Coding, CodeableConcept or Quantity
+func codingsOf(value interface{}) []common.Coding {
+	switch v := value.(type) {
+	case string:
+		return []common.Coding{{Code: fhir5.StringPtr(v)}}
+	case map[string]interface{}:
+		if codings, ok := v["coding"].([]interface{}); ok {
+			var result []common.Coding
+			for _, coding := range codings {
+				result = append(result, codingsOf(coding)...)
+			}
+			return result
+		}
+		code, ok := v["code"].(string)
+		if !ok {
+			return nil
+		}
+		coding := common.Coding{Code: fhir5.StringPtr(code)}
+		if system, ok := v["system"].(string); ok {
+			coding.System = fhir5.StringPtr(system)
+		}
+		if display, ok := v["display"].(string); ok {
+			coding.Display = fhir5.StringPtr(display)
+		}
+		return []common.Coding{coding}
+	}
+	return nil
+}
+
+// typeProfiles checks a value against the profiles of its type, e.g. an
+// extension against its definition. A value has to conform to one of them.
+func (c *checker) typeProfiles(e *fhir5.ElementDefinition, n node) {
+	var t *fhir5.ElementDefinitionType
+	if n.typeName != "" {
+		t = typeOf(e, n.typeName)
+	} else if len(e.Type) == 1 {
+		t = &e.Type[0]
+	}
+	if t == nil || len(t.Profile) == 0 {
+		return
+	}
+	var first *checker
+	for _, canonical := range t.Profile {
+		sd, err := c.v.profile(canonical)
+		if err != nil {
+			c.addIssue(fhir5.OperationOutcomeIssueSeverityWarning, IssueTypeNotFound, n.path, "%s: %v", elementID(e), err)
+			return
+		}
+		sub := c.sub()
+		sub.conforms(sd, n)
+		if !sub.errors() {
+			c.issues = append(c.issues, sub.issues...)
+			return
+		}
+		if first == nil {
+			first = sub
+		}
+	}
+	c.issues = append(c.issues, first.issues...)
+}
+
+// typeOf returns the type of an element with a name, e.g. Quantity or string
+// for the type suffixes Quantity and String of a choice element
+func typeOf(e *fhir5.ElementDefinition, typeName string) *fhir5.ElementDefinitionType {
+	for i, t := range e.Type {
+		if strings.EqualFold(t.Code, typeName) {
+			return &e.Type[i]
+		}
+	}
+	return nil
+}
+
+// typeCodes returns the codes of the types of an element
+func typeCodes(e *fhir5.ElementDefinition) string {
+	codes := make([]string, len(e.Type))
+	for i, t := range e.Type {
+		codes[i] = t.Code
+	}
+	return strings.Join(codes, ", ")
+}
+
+// splitPath splits a FHIRPath expression at the dots outside of parentheses
+func splitPath(path string) []string {
+	var segments []string
+	depth, start := 0, 0
+	for i, r := range path {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '.':
+			if depth == 0 {
+				segments = append(segments, path[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(segments, path[start:])
+}
+
+// lastName returns the last name of a path
+func lastName(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+// genericValue returns a value as generic JSON
+func genericValue(value interface{}) interface{} {
+	data, _ := json.Marshal(value)
+	var generic interface{}
+	_ = json.Unmarshal(data, &generic)
+	return generic
+}
+
+func jsonString(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
@@ -0,0 +1,288 @@
+package profile
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/terminology"
+)
+
+const (
+	bpProfile      = "http://hl7.org/fhir/StructureDefinition/bp"
+	mrnProfile     = "http://example.org/fhir/StructureDefinition/mrn-patient"
+	genderValueSet = "http://hl7.org/fhir/ValueSet/administrative-gender"
+)
+
+func newTestValidator(t *testing.T) *Validator {
+	t.Helper()
+	definitions, _ := newTestGenerator(t)
+	constraint := fhir5.StructureDefinitionDerivationConstraint
+	closed := &fhir5.ElementDefinitionSlicing{
+		Discriminator: []fhir5.ElementDefinitionSlicingDiscriminator{{Type: fhir5.ElementDefinitionSlicingDiscriminatorTypeValue, Path: "system"}},
+		Rules:         fhir5.ElementDefinitionSlicingRulesClosed,
+	}
+	mrn := &fhir5.StructureDefinition{
+		Url:            mrnProfile,
+		Type:           "Patient",
+		BaseDefinition: fhir5.StringPtr(coreURL + "Patient"),
+		Derivation:     &constraint,
+		Differential: &fhir5.StructureDefinitionDifferential{Element: []fhir5.ElementDefinition{
+			{Path: "Patient.identifier", Slicing: closed},
+			{Path: "Patient.identifier", SliceName: fhir5.StringPtr("mrn"), Min: fhir5.IntPtr(1)},
+			{Path: "Patient.identifier.system", FixedUri: fhir5.StringPtr("http://example.org/mrn")},
+			{Path: "Patient.maritalStatus", Binding: &fhir5.ElementDefinitionBinding{
+				Strength: fhir5.ElementDefinitionBindingStrengthExtensible,
+				ValueSet: fhir5.StringPtr(genderValueSet),
+			}},
+			{Path: "Patient.birthDate", MustSupport: fhir5.BoolPtr(true)},
+		}},
+	}
+	if err := definitions.Add(mrn); err != nil {
+		t.Fatalf("failed to add profile: %v", err)
+	}
+
+	service := terminology.NewService()
+	var cs fhir5.CodeSystem
+	var vs fhir5.ValueSet
+	for file, v := range map[string]interface{}{"codesystem-administrative-gender.json": &cs, "valueset-administrative-gender.json": &vs} {
+		data, err := os.ReadFile("../fhir5/testdata/fhir5-json/" + file)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", file, err)
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", file, err)
+		}
+	}
+	if err := service.AddCodeSystem(&cs); err != nil {
+		t.Fatalf("AddCodeSystem: %v", err)
+	}
+	if err := service.AddValueSet(&vs); err != nil {
+		t.Fatalf("AddValueSet: %v", err)
+	}
+	return NewValidator(definitions, service)
+}
+
+func loadBloodPressure(t *testing.T) *fhir5.Observation {
+	t.Helper()
+	data, err := os.ReadFile("../fhir5/testdata/fhir5-json/observation-example-bloodpressure.json")
+	if err != nil {
+		t.Fatalf("failed to read example: %v", err)
+	}
+	var observation fhir5.Observation
+	if err := json.Unmarshal(data, &observation); err != nil {
+		t.Fatalf("failed to unmarshal example: %v", err)
+	}
+	return &observation
+}
+
+// issue is an expected issue, diagnostics is a part of its diagnostics
+type issue struct {
+	severity    fhir5.OperationOutcomeIssueSeverity
+	code        string
+	expression  string
+	diagnostics string
+}
+
+func TestValidator_Validate(t *testing.T) {
+	validator := newTestValidator(t)
+	bp := func(change func(o *fhir5.Observation)) *fhir5.Observation {
+		observation := loadBloodPressure(t)
+		change(observation)
+		return observation
+	}
+	identifier := func(system string) common.Identifier {
+		return common.Identifier{System: fhir5.StringPtr(system), Value: fhir5.StringPtr("1")}
+	}
+	gender := func(g string) *fhir5.PatientGender {
+		value := fhir5.PatientGender(g)
+		return &value
+	}
+	patient := &fhir5.Patient{
+		ResourceType:  "Patient",
+		Identifier:    []common.Identifier{identifier("http://example.org/mrn"), identifier("http://example.org/other")},
+		Gender:        gender("robot"),
+		MaritalStatus: &common.CodeableConcept{Coding: []common.Coding{{System: fhir5.StringPtr("http://hl7.org/fhir/administrative-gender"), Code: fhir5.StringPtr("none")}}},
+	}
+	patient.Meta = &fhir5.Meta{Profile: []string{mrnProfile}}
+
+	tests := []struct {
+		name     string
+		resource common.Resource
+		profiles []string
+		want     []issue
+	}{
+		{
+			name:     "valid",
+			resource: bp(func(o *fhir5.Observation) {}),
+			profiles: []string{bpProfile},
+		},
+		{
+			name:     "missing slice",
+			resource: bp(func(o *fhir5.Observation) { o.Component = o.Component[:1] }),
+			profiles: []string{bpProfile},
+			want: []issue{
+				{fhir5.OperationOutcomeIssueSeverityError, IssueTypeRequired, "Observation.component", "Observation.component: minimum required = 2, but only found 1"},
+				{fhir5.OperationOutcomeIssueSeverityError, IssueTypeRequired, "Observation.component", "Observation.component:DiastolicBP: minimum required = 1, but only found 0"},
+			},
+		},
+		{
+			name: "fixed value",
+			resource: bp(func(o *fhir5.Observation) {
+				o.Component[0].ValueQuantity.System = fhir5.StringPtr("http://example.org/units")
+			}),
+			profiles: []string{bpProfile},
+			want: []issue{{fhir5.OperationOutcomeIssueSeverityError, IssueTypeValue, "Observation.component[0].value.system",
+				`the value must be "http://unitsofmeasure.org"`}},
+		},
+		{
+			name: "choice type",
+			resource: bp(func(o *fhir5.Observation) {
+				o.Component[0].ValueQuantity, o.Component[0].ValueString = nil, fhir5.StringPtr("120")
+			}),
+			profiles: []string{bpProfile},
+			want: []issue{{fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, "Observation.component[0].value",
+				"Observation.component:SystolicBP.value[x]: the value does not match any slice and the slicing is closed"}},
+		},
+		{
+			name:     "slice by the pattern of a discriminator",
+			resource: bp(func(o *fhir5.Observation) { o.Category = nil }),
+			profiles: []string{bpProfile},
+			want: []issue{
+				{fhir5.OperationOutcomeIssueSeverityError, IssueTypeRequired, "Observation.category", "Observation.category: minimum required = 1, but only found 0"},
+				{fhir5.OperationOutcomeIssueSeverityError, IssueTypeRequired, "Observation.category", "Observation.category:VSCat: minimum required = 1, but only found 0"},
+			},
+		},
+		{
+			name:     "reference target",
+			resource: bp(func(o *fhir5.Observation) { o.Subject = &common.Reference{Reference: fhir5.StringPtr("Group/1")} }),
+			profiles: []string{bpProfile},
+			want: []issue{{fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, "Observation.subject",
+				"the reference to a Group is not to one of Patient"}},
+		},
+		{
+			name:     "closed slicing and bindings",
+			resource: patient,
+			want: []issue{
+				{fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, "Patient.identifier[1]", "does not match any slice and the slicing is closed"},
+				{fhir5.OperationOutcomeIssueSeverityError, IssueTypeCodeInvalid, "Patient.gender", "the code is not in the required value set"},
+				{fhir5.OperationOutcomeIssueSeverityWarning, IssueTypeCodeInvalid, "Patient.maritalStatus", "the code is not in the extensible value set"},
+				{fhir5.OperationOutcomeIssueSeverityInformation, IssueTypeInformational, "Patient.birthDate", "the must support element is not present"},
+			},
+		},
+		{
+			name: "missing required slice",
+			resource: &fhir5.Patient{
+				ResourceType: "Patient",
+				Gender:       gender("female"),
+				BirthDate:    common.DatePtr(common.MustParseDate("1970-01-01")),
+			},
+			profiles: []string{mrnProfile},
+			want: []issue{{fhir5.OperationOutcomeIssueSeverityError, IssueTypeRequired, "Patient.identifier",
+				"Patient.identifier:mrn: minimum required = 1, but only found 0"}},
+		},
+		{
+			name:     "unknown profile",
+			resource: &fhir5.Patient{ResourceType: "Patient"},
+			profiles: []string{"http://example.org/fhir/StructureDefinition/unknown"},
+			want: []issue{{fhir5.OperationOutcomeIssueSeverityError, IssueTypeNotFound, "Patient",
+				"structure definition http://example.org/fhir/StructureDefinition/unknown is not available"}},
+		},
+		{
+			name:     "profile of another type",
+			resource: &fhir5.Patient{ResourceType: "Patient"},
+			profiles: []string{bpProfile},
+			want:     []issue{{fhir5.OperationOutcomeIssueSeverityError, IssueTypeStructure, "Patient", "constrains Observation, not Patient"}},
+		},
+	}
+
+	for _, tt := range tests {
+		outcome := validator.Validate(tt.resource, tt.profiles...)
+		var errors []string
+		for _, i := range outcome.Issue {
+			if i.Severity == fhir5.OperationOutcomeIssueSeverityError {
+				errors = append(errors, strings.Join(i.Expression, ",")+": "+*i.Diagnostics)
+			}
+		}
+		for _, want := range tt.want {
+			found := false
+			for _, i := range outcome.Issue {
+				if i.Severity == want.severity && i.Code == want.code && len(i.Expression) == 1 && i.Expression[0] == want.expression &&
+					strings.Contains(*i.Diagnostics, want.diagnostics) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s: expected %s issue %q at %s, got %+v", tt.name, want.severity, want.diagnostics, want.expression, outcome.Issue)
+			}
+		}
+		wantErrors := 0
+		for _, want := range tt.want {
+			if want.severity == fhir5.OperationOutcomeIssueSeverityError {
+				wantErrors++
+			}
+		}
+		if len(errors) != wantErrors {
+			t.Errorf("%s: expected %d errors, got %v", tt.name, wantErrors, errors)
+		}
+	}
+}
+
+func TestValidator_Examples(t *testing.T) {
+	skip := map[string]bool{
+		// the R5 model has no NamingSystem.version, which the profile requires
+		"namingsystem-example-metadata.json":   true,
+		"namingsystem-example-metadata-2.json": true,
+	}
+	definitions, _ := newTestGenerator(t)
+	validator := NewValidator(definitions, nil)
+
+	files, err := filepath.Glob("../fhir5/testdata/fhir5-json/*.json")
+	if err != nil {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+	count := 0
+	for _, file := range files {
+		if skip[filepath.Base(file)] {
+			continue
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		resource, err := fhir5.UnmarshalResource(data)
+		if err != nil {
+			continue
+		}
+		var meta struct {
+			Meta struct {
+				Profile []string `json:"profile"`
+			} `json:"meta"`
+		}
+		if json.Unmarshal(data, &meta) != nil || len(meta.Meta.Profile) == 0 {
+			continue
+		}
+		available := true
+		for _, canonical := range meta.Meta.Profile {
+			if _, err := definitions.StructureDefinition(canonical); err != nil {
+				available = false
+			}
+		}
+		if !available {
+			continue
+		}
+		count++
+		for _, i := range validator.Validate(resource).Issue {
+			if i.Severity == fhir5.OperationOutcomeIssueSeverityError {
+				t.Errorf("%s: %s %s", filepath.Base(file), i.Expression, *i.Diagnostics)
+			}
+		}
+	}
+	if count < 100 {
+		t.Errorf("expected at least 100 examples with profiles, got %d", count)
+	}
+}
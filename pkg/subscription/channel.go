@@ -0,0 +1,360 @@
+package subscription
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// RESTHook delivers notifications by POSTing them to the endpoint of the
+// subscription. The parameters of the subscription are sent as HTTP headers
+// and its timeout bounds each request.
+type RESTHook struct {
+	// Client sends the requests, http.DefaultClient if nil
+	Client *http.Client
+}
+
+// Deliver implements Channel
+func (h *RESTHook) Deliver(ctx context.Context, s *fhir5.Subscription, notification *fhir5.Bundle) error {
+	if s.Endpoint == nil || *s.Endpoint == "" {
+		return errors.New("subscription: rest-hook subscription has no endpoint")
+	}
+	contentType := "application/fhir+json"
+	if s.ContentType != nil && *s.ContentType != "" {
+		contentType = *s.ContentType
+	}
+	var (
+		body []byte
+		err  error
+	)
+	if strings.Contains(contentType, "xml") {
+		body, err = fhir5.MarshalXML(notification)
+	} else {
+		body, err = json.Marshal(notification)
+	}
+	if err != nil {
+		return err
+	}
+	if s.Timeout != nil && *s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(*s.Timeout)*time.Second)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *s.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	for _, p := range s.Parameter {
+		req.Header.Add(p.Name, p.Value)
+	}
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("subscription: endpoint %s answered %s", *s.Endpoint, resp.Status)
+	}
+	return nil
+}
+
+// Notification is a notification bundle delivered in-process
+type Notification struct {
+	Subscription *fhir5.Subscription
+	Bundle       *fhir5.Bundle
+}
+
+// Local delivers notifications to a Go channel, for subscribers within the
+// same process. Deliver blocks until the notification is received or the
+// context is done.
+type Local struct {
+	C chan Notification
+}
+
+// NewLocal returns a local channel buffering up to size notifications
+func NewLocal(size int) *Local {
+	return &Local{C: make(chan Notification, size)}
+}
+
+// Deliver implements Channel
+func (l *Local) Deliver(ctx context.Context, s *fhir5.Subscription, notification *fhir5.Bundle) error {
+	select {
+	case l.C <- Notification{Subscription: s, Bundle: notification}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// websocketGUID is appended to the key of a websocket handshake (RFC 6455)
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// tokenLifetime is how long a binding token can be used to bind a connection
+const tokenLifetime = 5 * time.Minute
+
+// WebSocket delivers notifications to websocket connections. Clients obtain a
+// token for a subscription with Token ($get-ws-binding-token), connect to the
+// handler and send "bind-with-token <token>"; the notifications of the
+// subscription are then sent to the connection as JSON text messages.
+type WebSocket struct {
+	mu     sync.Mutex
+	tokens map[string]binding
+	conns  map[string][]*wsConn
+}
+
+type binding struct {
+	subscription string
+	expires      time.Time
+}
+
+type wsConn struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// NewWebSocket returns a websocket channel without connections
+func NewWebSocket() *WebSocket {
+	return &WebSocket{tokens: map[string]binding{}, conns: map[string][]*wsConn{}}
+}
+
+// Token implements $get-ws-binding-token: it returns a token that binds a
+// connection to the subscription and the time it expires
+func (w *WebSocket) Token(subscriptionID string) (string, time.Time) {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	token := hex.EncodeToString(b[:])
+	now := time.Now()
+	expires := now.Add(tokenLifetime)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.purgeTokens(now)
+	w.tokens[token] = binding{subscription: subscriptionID, expires: expires}
+	return token, expires
+}
+
+// purgeTokens removes the tokens that expired unused. The caller must hold w.mu.
+func (w *WebSocket) purgeTokens(now time.Time) {
+	for token, b := range w.tokens {
+		if now.After(b.expires) {
+			delete(w.tokens, token)
+		}
+	}
+}
+
+// Deliver implements Channel. It fails if no connection is bound to the
+// subscription.
+func (w *WebSocket) Deliver(ctx context.Context, s *fhir5.Subscription, notification *fhir5.Bundle) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	conns := append([]*wsConn(nil), w.conns[deref(s.ID)]...)
+	w.mu.Unlock()
+	if len(conns) == 0 {
+		return fmt.Errorf("subscription: no websocket bound to %s", deref(s.ID))
+	}
+	var errs []error
+	for _, c := range conns {
+		if err := c.write(opText, data); err != nil {
+			w.unbind(c)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(conns) {
+		return errors.Join(errs...)
+	}
+	return nil
+}
+
+// ServeHTTP upgrades the request to a websocket and serves the connection
+// until the client closes it
+func (w *WebSocket) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket") || key == "" {
+		http.Error(rw, "websocket upgrade required", http.StatusBadRequest)
+		return
+	}
+	hijacker, ok := rw.(http.Hijacker)
+	if !ok {
+		http.Error(rw, "websocket not supported", http.StatusInternalServerError)
+		return
+	}
+	conn, buf, err := hijacker.Hijack()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	fmt.Fprintf(buf, "HTTP/1.1 101 Switching Protocols\r\nUpgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Accept: %s\r\n\r\n",
+		base64.StdEncoding.EncodeToString(sum[:]))
+	if err := buf.Flush(); err != nil {
+		return
+	}
+
+	c := &wsConn{conn: conn}
+	defer w.unbind(c)
+	for {
+		op, payload, err := readFrame(buf.Reader)
+		if err != nil {
+			return
+		}
+		switch op {
+		case opClose:
+			_ = c.write(opClose, nil)
+			return
+		case opPing:
+			_ = c.write(opPong, payload)
+		case opText:
+			if err := w.bind(c, string(payload)); err != nil {
+				_ = c.write(opText, []byte(err.Error()))
+			}
+		}
+	}
+}
+
+// bind handles a "bind-with-token <token>" message
+func (w *WebSocket) bind(c *wsConn, message string) error {
+	token, ok := strings.CutPrefix(strings.TrimSpace(message), "bind-with-token ")
+	if !ok {
+		return fmt.Errorf("unsupported message %q", message)
+	}
+	token = strings.TrimSpace(token)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.purgeTokens(time.Now())
+	b, ok := w.tokens[token]
+	if !ok {
+		return errors.New("invalid or expired token")
+	}
+	delete(w.tokens, token)
+	w.conns[b.subscription] = append(w.conns[b.subscription], c)
+	return nil
+}
+
+// unbind removes a connection from all subscriptions
+func (w *WebSocket) unbind(c *wsConn) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for id, conns := range w.conns {
+		for i, other := range conns {
+			if other == c {
+				w.conns[id] = append(conns[:i:i], conns[i+1:]...)
+				break
+			}
+		}
+		if len(w.conns[id]) == 0 {
+			delete(w.conns, id)
+		}
+	}
+}
+
+func headerContains(h http.Header, name, token string) bool {
+	for _, v := range h.Values(name) {
+		for _, part := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// websocket opcodes
+const (
+	opText  = 0x1
+	opClose = 0x8
+	opPing  = 0x9
+	opPong  = 0xA
+)
+
+// maxFrameSize bounds the client messages, which are only binding requests
+const maxFrameSize = 1 << 16
+
+// write sends an unmasked server frame
+func (c *wsConn) write(op byte, payload []byte) error {
+	header := []byte{0x80 | op}
+	switch n := len(payload); {
+	case n < 126:
+		header = append(header, byte(n))
+	case n <= 0xFFFF:
+		header = append(header, 126, byte(n>>8), byte(n))
+	default:
+		header = append(header, 127)
+		header = binary.BigEndian.AppendUint64(header, uint64(n))
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, err := c.conn.Write(append(header, payload...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readFrame reads a masked client frame. Fragmented messages are not
+// supported as binding requests fit in a single frame.
+func readFrame(r *bufio.Reader) (byte, []byte, error) {
+	var head [2]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		return 0, nil, err
+	}
+	op := head[0] & 0x0F
+	masked := head[1]&0x80 != 0
+	n := uint64(head[1] & 0x7F)
+	switch n {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		n = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(r, ext[:]); err != nil {
+			return 0, nil, err
+		}
+		n = binary.BigEndian.Uint64(ext[:])
+	}
+	if n > maxFrameSize {
+		return 0, nil, fmt.Errorf("subscription: websocket frame of %d bytes is too large", n)
+	}
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(r, mask[:]); err != nil {
+			return 0, nil, err
+		}
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return op, payload, nil
+}
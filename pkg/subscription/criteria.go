@@ -0,0 +1,335 @@
+package subscription
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/fhirpath"
+)
+
+// criterion is a single search parameter test, e.g. status:not=in-progress
+type criterion struct {
+	resourceType string
+	parameter    string
+	modifier     string
+	comparator   string
+	values       []string
+}
+
+// parseQuery parses the query criteria of a resource trigger, e.g.
+// "status:not=in-progress&class=IMP"
+func parseQuery(resourceType, query string) ([]criterion, error) {
+	var criteria []criterion
+	for _, part := range strings.Split(strings.TrimPrefix(query, "?"), "&") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("subscription: invalid query criteria %q", part)
+		}
+		name, modifier, _ := strings.Cut(name, ":")
+		value, err := url.QueryUnescape(value)
+		if err != nil {
+			return nil, fmt.Errorf("subscription: invalid query criteria %q: %w", part, err)
+		}
+		criteria = append(criteria, criterion{
+			resourceType: resourceType,
+			parameter:    name,
+			modifier:     modifier,
+			values:       strings.Split(value, ","),
+		})
+	}
+	return criteria, nil
+}
+
+// splitPrefix splits the comparator prefix off a date or number value, e.g.
+// "ge2020-01-01" into "ge" and "2020-01-01". The comparator of a filter takes
+// precedence over the prefix.
+func splitPrefix(comparator, value string) (string, string) {
+	if comparator != "" {
+		return comparator, value
+	}
+	if len(value) > 2 && value[2] >= '0' && value[2] <= '9' {
+		switch value[:2] {
+		case "eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap":
+			return value[:2], value[2:]
+		}
+	}
+	return "", value
+}
+
+// searchParameter is how a search parameter selects values of a resource
+type searchParameter struct {
+	typ        string
+	expression *fhirpath.Expression
+}
+
+// lookup returns the search parameter of a resource type, falling back to the
+// element of the same name, e.g. Encounter.status for status, and to the
+// subject or patient element for patient
+func (e *Engine) lookup(resourceType, name string) (searchParameter, error) {
+	if p, ok := e.parameters[resourceType+"."+name]; ok {
+		return p, nil
+	}
+	if p, ok := e.parameters["Resource."+name]; ok {
+		return p, nil
+	}
+	expr := resourceType + "." + name
+	switch name {
+	case "_id":
+		expr = resourceType + ".id"
+	case "patient":
+		expr = resourceType + ".subject.where(reference.startsWith('Patient/')) | " + resourceType + ".patient"
+	}
+	parsed, err := fhirpath.Parse(expr)
+	if err != nil {
+		return searchParameter{}, fmt.Errorf("subscription: no search parameter %s for %s", name, resourceType)
+	}
+	return searchParameter{expression: parsed}, nil
+}
+
+// matches reports whether the resource passes the criterion. A nil resource
+// never matches.
+func (e *Engine) matches(resource common.Resource, c criterion) (bool, error) {
+	if resource == nil {
+		return false, nil
+	}
+	param, err := e.lookup(c.resourceType, c.parameter)
+	if err != nil {
+		return false, err
+	}
+	values, err := param.expression.Evaluate(resource)
+	if err != nil {
+		return false, fmt.Errorf("subscription: evaluating %s: %w", c.parameter, err)
+	}
+
+	switch c.modifier {
+	case "missing":
+		return (len(values) == 0) == (c.values[0] == "true"), nil
+	case "not":
+		c.modifier = ""
+		found, err := matchAny(values, c, param.typ)
+		return !found, err
+	case "", "exact", "contains", "text":
+		return matchAny(values, c, param.typ)
+	}
+	return false, fmt.Errorf("subscription: modifier %s is not supported", c.modifier)
+}
+
+// matchAny reports whether one of the values matches one of the criterion values
+func matchAny(values []interface{}, c criterion, typ string) (bool, error) {
+	for _, want := range c.values {
+		for _, v := range values {
+			ok, err := matchValue(v, want, c, typ)
+			if ok || err != nil {
+				return ok, err
+			}
+		}
+	}
+	return false, nil
+}
+
+// matchValue compares a single value of the resource with a criterion value.
+// The kind of comparison follows the Go type of the value; strings use token
+// semantics unless the search parameter is declared as a string parameter.
+func matchValue(v interface{}, want string, c criterion, typ string) (bool, error) {
+	switch v := v.(type) {
+	case string:
+		if typ == "string" || c.modifier == "exact" || c.modifier == "contains" {
+			return matchString(v, want, c.modifier), nil
+		}
+		return matchToken("", v, want), nil
+	case bool:
+		return fmt.Sprint(v) == want, nil
+	case int64:
+		return compareNumber(common.DecimalFromInt(v), want, c.comparator)
+	case common.Decimal:
+		return compareNumber(v, want, c.comparator)
+	case fhirpath.Quantity:
+		return compareNumber(v.Value, want, c.comparator)
+	case *common.Quantity:
+		if v.Value == nil {
+			return false, nil
+		}
+		return compareNumber(*v.Value, want, c.comparator)
+	case common.Date:
+		low, high := v.Range(time.UTC)
+		return compareTime(low, high, want, c.comparator)
+	case common.DateTime:
+		low, high := v.Range(time.UTC)
+		return compareTime(low, high, want, c.comparator)
+	case common.Instant:
+		low, high := v.Range()
+		return compareTime(low, high, want, c.comparator)
+	case *common.Period:
+		low, high := time.Time{}, time.Unix(1<<62, 0)
+		if v.Start != nil {
+			low, _ = v.Start.Range(time.UTC)
+		}
+		if v.End != nil {
+			_, high = v.End.Range(time.UTC)
+		}
+		return compareTime(low, high, want, c.comparator)
+	case *common.Coding:
+		if c.modifier == "text" {
+			return containsFold(deref(v.Display), want), nil
+		}
+		return matchToken(deref(v.System), deref(v.Code), want), nil
+	case *common.CodeableConcept:
+		if c.modifier == "text" {
+			return containsFold(deref(v.Text), want), nil
+		}
+		for i := range v.Coding {
+			if matchToken(deref(v.Coding[i].System), deref(v.Coding[i].Code), want) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case *common.Identifier:
+		return matchToken(deref(v.System), deref(v.Value), want), nil
+	case *common.Reference:
+		return matchReference(deref(v.Reference), want), nil
+	case *fhir5.CodeableReference:
+		if v.Concept != nil {
+			if ok, err := matchValue(v.Concept, want, c, typ); ok || err != nil {
+				return ok, err
+			}
+		}
+		if v.Reference != nil {
+			return matchValue(v.Reference, want, c, typ)
+		}
+		return false, nil
+	}
+	// complex values such as names and addresses match on any of their strings
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false, nil
+	}
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return false, nil
+	}
+	return anyString(tree, func(s string) bool { return matchString(s, want, c.modifier) }), nil
+}
+
+// matchString applies string search semantics: a case-insensitive prefix match
+// by default, an exact match for :exact and a substring match for :contains
+func matchString(s, want, modifier string) bool {
+	switch modifier {
+	case "exact":
+		return s == want
+	case "contains":
+		return containsFold(s, want)
+	}
+	return len(s) >= len(want) && strings.EqualFold(s[:len(want)], want)
+}
+
+// matchToken compares a code with a token value of the forms code, system|code,
+// |code and system|
+func matchToken(system, code, want string) bool {
+	wantSystem, wantCode, hasSystem := strings.Cut(want, "|")
+	if !hasSystem {
+		return code == want
+	}
+	if wantCode == "" {
+		return system == wantSystem
+	}
+	return system == wantSystem && code == wantCode
+}
+
+// matchReference compares a reference with a value of the forms Type/id, id or
+// an absolute URL
+func matchReference(reference, want string) bool {
+	if reference == "" {
+		return false
+	}
+	return reference == want || strings.HasSuffix(reference, "/"+want) || strings.HasSuffix(want, "/"+reference)
+}
+
+func compareNumber(v common.Decimal, want, comparator string) (bool, error) {
+	comparator, want = splitPrefix(comparator, want)
+	want, _, _ = strings.Cut(want, "|")
+	w, err := common.ParseDecimal(want)
+	if err != nil {
+		return false, fmt.Errorf("subscription: invalid number %q", want)
+	}
+	switch comparator {
+	case "", "eq":
+		return w.ImpliedRangeContains(v), nil
+	case "ne":
+		return !w.ImpliedRangeContains(v), nil
+	case "gt", "sa":
+		return v.Cmp(w) > 0, nil
+	case "lt", "eb":
+		return v.Cmp(w) < 0, nil
+	case "ge":
+		return v.Cmp(w) >= 0, nil
+	case "le":
+		return v.Cmp(w) <= 0, nil
+	}
+	return false, fmt.Errorf("subscription: comparator %s is not supported", comparator)
+}
+
+// compareTime compares the range of a date value with the range of a search
+// date, e.g. eq holds if the value lies within the day 2020-01-01
+func compareTime(low, high time.Time, want, comparator string) (bool, error) {
+	comparator, want = splitPrefix(comparator, want)
+	w, err := common.ParseDateTime(want)
+	if err != nil {
+		return false, fmt.Errorf("subscription: invalid date %q", want)
+	}
+	wantLow, wantHigh := w.Range(time.UTC)
+	within := !low.Before(wantLow) && !high.After(wantHigh)
+	switch comparator {
+	case "", "eq":
+		return within, nil
+	case "ne":
+		return !within, nil
+	case "gt", "sa":
+		return high.After(wantHigh), nil
+	case "lt", "eb":
+		return low.Before(wantLow), nil
+	case "ge":
+		return within || high.After(wantHigh), nil
+	case "le":
+		return within || low.Before(wantLow), nil
+	}
+	return false, fmt.Errorf("subscription: comparator %s is not supported", comparator)
+}
+
+func anyString(tree interface{}, match func(string) bool) bool {
+	switch t := tree.(type) {
+	case string:
+		return match(t)
+	case []interface{}:
+		for _, v := range t {
+			if anyString(v, match) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		for _, v := range t {
+			if anyString(v, match) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
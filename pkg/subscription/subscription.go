@@ -0,0 +1,433 @@
+// Package subscription implements the topic-based subscriptions of FHIR R5 for
+// a local server. The engine keeps SubscriptionTopic and Subscription
+// resources, evaluates the resource triggers of the topics against the changes
+// reported to it and delivers the resulting subscription-notification bundles
+// through the channel registered for the channel type of each subscription.
+//
+//	engine := subscription.NewEngine()
+//	engine.AddTopic(admission)
+//	engine.Subscribe(ctx, subscriber)
+//	engine.Notify(ctx, subscription.Change{Interaction: subscription.Update, Previous: old, Current: encounter})
+//
+// Query criteria and filters are evaluated with the SearchParameters added to
+// the engine. Parameters without a definition select the element of the same
+// name, so status matches Encounter.status.
+package subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/fhirpath"
+)
+
+// subscriptionErrorSystem is the code system of the errors reported in SubscriptionStatus.error
+const subscriptionErrorSystem = "http://terminology.hl7.org/CodeSystem/subscription-error"
+
+// DefaultRetainedEvents is the number of events per subscription kept for $events
+const DefaultRetainedEvents = 100
+
+// Channel delivers notification bundles to the endpoint of a subscription
+type Channel interface {
+	Deliver(ctx context.Context, subscription *fhir5.Subscription, notification *fhir5.Bundle) error
+}
+
+// Engine evaluates topics and delivers notifications to subscribers. It is
+// safe for concurrent use.
+type Engine struct {
+	// Now returns the current time, time.Now by default
+	Now func() time.Time
+
+	// RetainedEvents is the number of events per subscription kept for $events
+	RetainedEvents int
+
+	// Base is the service base URL of the server, e.g. https://example.org/fhir.
+	// Notifications refer to the subscription and the focus resources with
+	// absolute URLs on it, relative references are used if it is empty.
+	Base string
+
+	mu            sync.Mutex
+	topics        map[string]*topic
+	subscriptions map[string]*state
+	channels      map[string]Channel
+	parameters    map[string]searchParameter
+}
+
+// state is a subscription and the events sent to it
+type state struct {
+	subscription *fhir5.Subscription
+	topic        *topic
+	filters      []criterion
+	events       int64
+	history      []fhir5.NotificationEvent
+	lastSent     time.Time
+	errors       []common.CodeableConcept
+}
+
+// NewEngine returns an engine that delivers rest-hook subscriptions with
+// http.DefaultClient
+func NewEngine() *Engine {
+	e := &Engine{
+		Now:            time.Now,
+		RetainedEvents: DefaultRetainedEvents,
+		topics:         map[string]*topic{},
+		subscriptions:  map[string]*state{},
+		channels:       map[string]Channel{},
+		parameters:     map[string]searchParameter{},
+	}
+	e.RegisterChannel("rest-hook", &RESTHook{})
+	return e
+}
+
+// RegisterChannel makes a channel available for subscriptions whose
+// channelType has the given code, e.g. rest-hook or websocket
+func (e *Engine) RegisterChannel(code string, channel Channel) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.channels[code] = channel
+}
+
+// AddSearchParameter defines a search parameter used by query criteria and
+// subscription filters
+func (e *Engine) AddSearchParameter(sp *fhir5.SearchParameter) error {
+	if sp.Expression == nil {
+		return fmt.Errorf("subscription: search parameter %s has no expression", sp.Code)
+	}
+	expr, err := fhirpath.Parse(*sp.Expression)
+	if err != nil {
+		return fmt.Errorf("subscription: search parameter %s: %w", sp.Code, err)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, base := range sp.Base {
+		e.parameters[base+"."+sp.Code] = searchParameter{typ: sp.Type, expression: expr}
+	}
+	return nil
+}
+
+// AddTopic makes a topic available to subscriptions by its canonical URL
+func (e *Engine) AddTopic(t *fhir5.SubscriptionTopic) error {
+	parsed, err := newTopic(t)
+	if err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.topics[t.URL] = parsed
+	if t.Version != nil {
+		e.topics[t.URL+"|"+*t.Version] = parsed
+	}
+	return nil
+}
+
+// Subscribe registers a subscription. Its topic must be known, its filters
+// allowed by the topic and its channel type registered. A requested
+// subscription is activated by a handshake; if the handshake cannot be
+// delivered the subscription is kept with status error and the delivery error
+// is returned. The engine works on a copy and leaves the given subscription
+// unchanged, Status reports its current status.
+func (e *Engine) Subscribe(ctx context.Context, subscription *fhir5.Subscription) error {
+	copied := *subscription
+	s := &copied
+	if s.ID == nil {
+		return errors.New("subscription: subscription has no id")
+	}
+	e.mu.Lock()
+	t, ok := e.topics[s.Topic]
+	if !ok {
+		e.mu.Unlock()
+		return fmt.Errorf("subscription: unknown topic %s", s.Topic)
+	}
+	if _, ok := e.channels[deref(s.ChannelType.Code)]; !ok {
+		e.mu.Unlock()
+		return fmt.Errorf("subscription: unsupported channel type %s", deref(s.ChannelType.Code))
+	}
+	filters, err := filters(t, s)
+	if err != nil {
+		e.mu.Unlock()
+		return err
+	}
+	st := &state{subscription: s, topic: t, filters: filters}
+	e.subscriptions[*s.ID] = st
+	requested := s.Status == fhir5.SubscriptionStatusCodeRequested
+	e.mu.Unlock()
+
+	if !requested {
+		return nil
+	}
+	if err := e.deliver(ctx, st, fhir5.NewHandshakeNotification(s)); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	s.Status = fhir5.SubscriptionStatusCodeActive
+	e.mu.Unlock()
+	return nil
+}
+
+// Unsubscribe removes a subscription
+func (e *Engine) Unsubscribe(id string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.subscriptions, id)
+}
+
+// filters converts the filters of a subscription into criteria, rejecting
+// filters the topic does not offer
+func filters(t *topic, s *fhir5.Subscription) ([]criterion, error) {
+	var criteria []criterion
+	for _, f := range s.FilterBy {
+		resourceType := ""
+		if f.ResourceType != nil {
+			resourceType = typeName(*f.ResourceType)
+		}
+		allowed := false
+		for _, can := range t.resource.CanFilterBy {
+			if can.FilterParameter != f.FilterParameter || (can.Resource != nil && resourceType != "" && typeName(*can.Resource) != resourceType) {
+				continue
+			}
+			if resourceType == "" && can.Resource != nil {
+				resourceType = typeName(*can.Resource)
+			}
+			allowed = (f.Modifier == nil || supportsModifier(can.Modifier, string(*f.Modifier))) &&
+				(f.Comparator == nil || supportsComparator(can.Comparator, string(*f.Comparator)))
+			break
+		}
+		if !allowed {
+			return nil, fmt.Errorf("subscription: topic %s cannot filter by %s", t.resource.URL, f.FilterParameter)
+		}
+		c := criterion{resourceType: resourceType, parameter: f.FilterParameter, values: strings.Split(f.Value, ",")}
+		if f.Modifier != nil {
+			c.modifier = string(*f.Modifier)
+		}
+		if f.Comparator != nil {
+			c.comparator = string(*f.Comparator)
+		}
+		criteria = append(criteria, c)
+	}
+	return criteria, nil
+}
+
+func supportsModifier(modifiers []fhir5.SubscriptionTopicCanFilterByModifier, modifier string) bool {
+	for _, m := range modifiers {
+		if string(m) == modifier {
+			return true
+		}
+	}
+	return false
+}
+
+func supportsComparator(comparators []fhir5.SubscriptionTopicCanFilterByComparator, comparator string) bool {
+	// eq is implied when a topic lists no comparators
+	if len(comparators) == 0 {
+		return comparator == "eq"
+	}
+	for _, c := range comparators {
+		if string(c) == comparator {
+			return true
+		}
+	}
+	return false
+}
+
+// Notify evaluates the topics against a change and notifies every active
+// subscription whose topic fires and whose filters match the changed
+// resource. Errors of single subscriptions, e.g. failed deliveries, are joined.
+func (e *Engine) Notify(ctx context.Context, change Change) error {
+	type delivery struct {
+		st     *state
+		bundle *fhir5.Bundle
+	}
+	var (
+		deliveries []delivery
+		errs       []error
+	)
+	now := e.Now()
+
+	e.mu.Lock()
+	fired := map[*topic]bool{}
+	for _, id := range e.subscriptionIDs() {
+		st := e.subscriptions[id]
+		if !e.active(st, now) {
+			continue
+		}
+		ok, seen := fired[st.topic]
+		if !seen {
+			var err error
+			if ok, err = e.fires(st.topic, change); err != nil {
+				errs = append(errs, err)
+			}
+			fired[st.topic] = ok
+		}
+		if !ok {
+			continue
+		}
+		if ok, err := e.filtersMatch(st, change); !ok || err != nil {
+			if err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		st.events++
+		ev := fhir5.NotificationEvent{Number: st.events, Timestamp: now, Focus: change.focus()}
+		st.history = append(st.history, ev)
+		if len(st.history) > e.RetainedEvents {
+			st.history = st.history[len(st.history)-e.RetainedEvents:]
+		}
+		bundle := fhir5.NewEventsNotification(st.subscription, fhir5.SubscriptionStatusTypeEventNotification, e.Base, st.events, contentOf(st.subscription), ev)
+		deliveries = append(deliveries, delivery{st, bundle})
+	}
+	e.mu.Unlock()
+
+	for _, d := range deliveries {
+		if err := e.deliver(ctx, d.st, d.bundle); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Heartbeat sends a heartbeat to every active subscription with a heartbeat
+// period that has not been notified within that period. It is meant to be
+// called regularly, e.g. every second.
+func (e *Engine) Heartbeat(ctx context.Context) error {
+	now := e.Now()
+	var due []*state
+	e.mu.Lock()
+	for _, id := range e.subscriptionIDs() {
+		st := e.subscriptions[id]
+		s := st.subscription
+		if !e.active(st, now) || s.HeartbeatPeriod == nil || *s.HeartbeatPeriod <= 0 {
+			continue
+		}
+		if now.Sub(st.lastSent) >= time.Duration(*s.HeartbeatPeriod)*time.Second {
+			due = append(due, st)
+		}
+	}
+	e.mu.Unlock()
+
+	var errs []error
+	for _, st := range due {
+		e.mu.Lock()
+		bundle := fhir5.NewHeartbeatNotification(st.subscription, st.events)
+		e.mu.Unlock()
+		if err := e.deliver(ctx, st, bundle); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Status implements $status: a query-status notification reporting the
+// status, event count and errors of the subscription
+func (e *Engine) Status(id string) (*fhir5.Bundle, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	st, ok := e.subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("subscription: unknown subscription %s", id)
+	}
+	bundle := fhir5.NewQueryStatusNotification(st.subscription, st.events)
+	bundle.Entry[0].Resource.(*fhir5.SubscriptionStatus).Error = st.errors
+	return bundle, nil
+}
+
+// Events implements $events: a query-event notification with the retained
+// events numbered from since to until, both inclusive. Zero bounds are open.
+// The content defaults to that of the subscription.
+func (e *Engine) Events(id string, since, until int64, content fhir5.SubscriptionContent) (*fhir5.Bundle, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	st, ok := e.subscriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("subscription: unknown subscription %s", id)
+	}
+	var events []fhir5.NotificationEvent
+	for _, ev := range st.history {
+		if (since == 0 || ev.Number >= since) && (until == 0 || ev.Number <= until) {
+			events = append(events, ev)
+		}
+	}
+	if content == "" {
+		content = contentOf(st.subscription)
+	}
+	return fhir5.NewEventsNotification(st.subscription, fhir5.SubscriptionStatusTypeQueryEvent, e.Base, st.events, content, events...), nil
+}
+
+// deliver sends a notification through the channel of the subscription. A
+// failed delivery puts the subscription into the error state.
+func (e *Engine) deliver(ctx context.Context, st *state, bundle *fhir5.Bundle) error {
+	e.mu.Lock()
+	channel := e.channels[deref(st.subscription.ChannelType.Code)]
+	e.mu.Unlock()
+
+	err := channel.Deliver(ctx, st.subscription, bundle)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		st.subscription.Status = fhir5.SubscriptionStatusCodeError
+		st.errors = append(st.errors, common.CodeableConcept{
+			Coding: []common.Coding{{System: fhir5.StringPtr(subscriptionErrorSystem), Code: fhir5.StringPtr("no-response")}},
+			Text:   fhir5.StringPtr(err.Error()),
+		})
+		return fmt.Errorf("subscription: delivering to %s: %w", *st.subscription.ID, err)
+	}
+	st.lastSent = e.Now()
+	return nil
+}
+
+// active reports whether a subscription receives events: it has to be active
+// and must not have ended
+func (e *Engine) active(st *state, now time.Time) bool {
+	s := st.subscription
+	if s.Status != fhir5.SubscriptionStatusCodeActive {
+		return false
+	}
+	if s.End != nil && now.After(s.End.Time()) {
+		s.Status = fhir5.SubscriptionStatusCodeOff
+		return false
+	}
+	return true
+}
+
+// filtersMatch applies the filters of a subscription that concern the type of
+// the changed resource
+func (e *Engine) filtersMatch(st *state, change Change) (bool, error) {
+	resourceType := change.resourceType()
+	for _, f := range st.filters {
+		if f.resourceType != "" && f.resourceType != resourceType {
+			continue
+		}
+		f.resourceType = resourceType
+		ok, err := e.matches(change.focus(), f)
+		if !ok || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// subscriptionIDs returns the ids of the subscriptions in a stable order
+func (e *Engine) subscriptionIDs() []string {
+	ids := make([]string, 0, len(e.subscriptions))
+	for id := range e.subscriptions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// contentOf returns the payload content of a subscription, id-only by default
+func contentOf(s *fhir5.Subscription) fhir5.SubscriptionContent {
+	if s.Content != nil {
+		return *s.Content
+	}
+	return fhir5.SubscriptionContentIdOnly
+}
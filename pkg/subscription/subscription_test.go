@@ -0,0 +1,438 @@
+package subscription
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+const admissionTopic = "http://example.org/FHIR/R5/SubscriptionTopic/admission"
+
+func loadTopic(t *testing.T) *fhir5.SubscriptionTopic {
+	t.Helper()
+	data, err := os.ReadFile("../fhir5/testdata/fhir5-json/subscriptiontopic-example-admission.json")
+	if err != nil {
+		t.Fatalf("failed to read topic: %v", err)
+	}
+	var topic fhir5.SubscriptionTopic
+	if err := json.Unmarshal(data, &topic); err != nil {
+		t.Fatalf("failed to unmarshal topic: %v", err)
+	}
+	return &topic
+}
+
+func newSubscription(id, channel string, status fhir5.SubscriptionStatusCode, filters ...fhir5.SubscriptionFilterBy) *fhir5.Subscription {
+	s := &fhir5.Subscription{
+		ResourceType: "Subscription",
+		Status:       status,
+		Topic:        admissionTopic,
+		ChannelType:  common.Coding{Code: fhir5.StringPtr(channel)},
+		FilterBy:     filters,
+	}
+	s.ID = fhir5.StringPtr(id)
+	return s
+}
+
+func newEncounter(id, patient string, status fhir5.EncounterStatus) *fhir5.Encounter {
+	e := &fhir5.Encounter{
+		ResourceType: "Encounter",
+		Status:       status,
+		Subject:      &common.Reference{Reference: fhir5.StringPtr(patient)},
+	}
+	e.ID = fhir5.StringPtr(id)
+	return e
+}
+
+func newTestEngine(t *testing.T) (*Engine, *Local) {
+	t.Helper()
+	engine := NewEngine()
+	local := NewLocal(10)
+	engine.RegisterChannel("local", local)
+	if err := engine.AddTopic(loadTopic(t)); err != nil {
+		t.Fatalf("AddTopic: %v", err)
+	}
+	return engine, local
+}
+
+func receive(t *testing.T, local *Local) *fhir5.SubscriptionNotification {
+	t.Helper()
+	select {
+	case n := <-local.C:
+		notification, err := fhir5.ParseSubscriptionNotification(n.Bundle)
+		if err != nil {
+			t.Fatalf("invalid notification: %v", err)
+		}
+		return notification
+	default:
+		t.Fatal("expected a notification")
+		return nil
+	}
+}
+
+// subscriptionStatus returns the status the engine reports for a subscription
+func subscriptionStatus(t *testing.T, engine *Engine, id string) fhir5.SubscriptionStatusStatus {
+	t.Helper()
+	bundle, err := engine.Status(id)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	return *bundle.Entry[0].Resource.(*fhir5.SubscriptionStatus).Status
+}
+
+func TestEngine_Notify(t *testing.T) {
+	ctx := context.Background()
+	engine, local := newTestEngine(t)
+	content := fhir5.SubscriptionContentFullResource
+	s := newSubscription("s1", "local", fhir5.SubscriptionStatusCodeRequested,
+		fhir5.SubscriptionFilterBy{FilterParameter: "patient", Value: "Patient/123"})
+	s.Content = &content
+	if err := engine.Subscribe(ctx, s); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if n := receive(t, local); n.Type() != fhir5.SubscriptionStatusTypeHandshake {
+		t.Errorf("expected a handshake, got %s", n.Type())
+	}
+	if status := subscriptionStatus(t, engine, "s1"); status != fhir5.SubscriptionStatusStatusActive {
+		t.Errorf("expected the subscription to be active, got %s", status)
+	}
+	if s.Status != fhir5.SubscriptionStatusCodeRequested {
+		t.Errorf("expected the given subscription to be unchanged, got %s", s.Status)
+	}
+
+	planned := newEncounter("1", "Patient/123", fhir5.EncounterStatusPlanned)
+	started := newEncounter("1", "Patient/123", fhir5.EncounterStatusInProgress)
+	other := newEncounter("2", "Patient/456", fhir5.EncounterStatusInProgress)
+
+	changes := []struct {
+		name   string
+		change Change
+		fires  bool
+	}{
+		{"planned encounter", Change{Interaction: Create, Current: planned}, false},
+		{"admission", Change{Interaction: Update, Previous: planned, Current: started}, true},
+		{"unchanged status", Change{Interaction: Update, Previous: started, Current: started}, false},
+		{"other patient", Change{Interaction: Update, Previous: planned, Current: other}, false},
+		{"delete", Change{Interaction: Delete, Previous: started}, false},
+	}
+	for _, tt := range changes {
+		if err := engine.Notify(ctx, tt.change); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+		}
+		if fired := len(local.C) > 0; fired != tt.fires {
+			t.Errorf("%s: expected fired %v, got %v", tt.name, tt.fires, fired)
+		}
+		if !tt.fires {
+			continue
+		}
+		n := receive(t, local)
+		if n.Type() != fhir5.SubscriptionStatusTypeEventNotification {
+			t.Errorf("%s: expected an event notification, got %s", tt.name, n.Type())
+		}
+		if *n.Status.EventsSinceSubscriptionStart != "1" || n.Status.NotificationEvent[0].EventNumber != "1" {
+			t.Errorf("%s: unexpected event numbering %+v", tt.name, n.Status)
+		}
+		if focus := n.Focus(); len(focus) != 1 || *focus[0].GetID() != "1" {
+			t.Errorf("%s: unexpected focus %v", tt.name, focus)
+		}
+	}
+}
+
+func TestEngine_StatusAndEvents(t *testing.T) {
+	ctx := context.Background()
+	engine, local := newTestEngine(t)
+	engine.RetainedEvents = 2
+	engine.Base = "https://example.org/fhir"
+	if err := engine.Subscribe(ctx, newSubscription("s1", "local", fhir5.SubscriptionStatusCodeActive)); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		change := Change{
+			Interaction: Update,
+			Previous:    newEncounter(id, "Patient/1", fhir5.EncounterStatusPlanned),
+			Current:     newEncounter(id, "Patient/1", fhir5.EncounterStatusInProgress),
+		}
+		if err := engine.Notify(ctx, change); err != nil {
+			t.Fatalf("Notify: %v", err)
+		}
+		<-local.C
+	}
+
+	bundle, err := engine.Status("s1")
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	status, err := fhir5.ParseSubscriptionNotification(bundle)
+	if err != nil {
+		t.Fatalf("invalid status: %v", err)
+	}
+	if status.Type() != fhir5.SubscriptionStatusTypeQueryStatus || *status.Status.EventsSinceSubscriptionStart != "3" {
+		t.Errorf("unexpected status %+v", status.Status)
+	}
+
+	tests := []struct {
+		since, until int64
+		numbers      []string
+	}{
+		{0, 0, []string{"2", "3"}},
+		{3, 0, []string{"3"}},
+		{0, 2, []string{"2"}},
+		{1, 1, nil},
+	}
+	for _, tt := range tests {
+		bundle, err := engine.Events("s1", tt.since, tt.until, "")
+		if err != nil {
+			t.Fatalf("Events: %v", err)
+		}
+		events, err := fhir5.ParseSubscriptionNotification(bundle)
+		if err != nil {
+			t.Fatalf("invalid events: %v", err)
+		}
+		if events.Type() != fhir5.SubscriptionStatusTypeQueryEvent {
+			t.Errorf("expected query-event, got %s", events.Type())
+		}
+		var numbers []string
+		for _, ev := range events.Status.NotificationEvent {
+			numbers = append(numbers, ev.EventNumber)
+		}
+		if strings.Join(numbers, ",") != strings.Join(tt.numbers, ",") {
+			t.Errorf("events %d..%d: expected %v, got %v", tt.since, tt.until, tt.numbers, numbers)
+		}
+	}
+
+	// the subscription is id-only, the entries refer to the focus by its fullUrl
+	bundle, _ = engine.Events("s1", 3, 3, "")
+	if entries := bundle.Entry[1:]; len(entries) != 1 || entries[0].Resource != nil ||
+		*entries[0].FullURL != "https://example.org/fhir/Encounter/3" {
+		t.Errorf("expected an id-only entry for Encounter/3, got %+v", entries)
+	}
+
+	if _, err := engine.Status("unknown"); err == nil {
+		t.Error("expected an error for an unknown subscription")
+	}
+}
+
+func TestEngine_Subscribe_Invalid(t *testing.T) {
+	ctx := context.Background()
+	engine, _ := newTestEngine(t)
+	tests := map[string]*fhir5.Subscription{
+		"no id":           {Topic: admissionTopic, ChannelType: common.Coding{Code: fhir5.StringPtr("local")}},
+		"unknown topic":   newSubscription("s1", "local", fhir5.SubscriptionStatusCodeActive),
+		"unknown channel": newSubscription("s1", "email", fhir5.SubscriptionStatusCodeActive),
+		"filter not offered": newSubscription("s1", "local", fhir5.SubscriptionStatusCodeActive,
+			fhir5.SubscriptionFilterBy{FilterParameter: "status", Value: "planned"}),
+	}
+	tests["unknown topic"].Topic = "http://example.org/unknown"
+	for name, s := range tests {
+		if err := engine.Subscribe(ctx, s); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestEngine_SearchParameters(t *testing.T) {
+	engine := NewEngine()
+	sp := &fhir5.SearchParameter{
+		ResourceType: "SearchParameter",
+		Code:         "class",
+		Base:         []string{"Encounter"},
+		Type:         "token",
+		Expression:   fhir5.StringPtr("Encounter.class"),
+	}
+	if err := engine.AddSearchParameter(sp); err != nil {
+		t.Fatalf("AddSearchParameter: %v", err)
+	}
+	encounter := newEncounter("1", "Patient/123", fhir5.EncounterStatusInProgress)
+	encounter.Class = []common.CodeableConcept{{Coding: []common.Coding{{
+		System: fhir5.StringPtr("http://terminology.hl7.org/CodeSystem/v3-ActCode"),
+		Code:   fhir5.StringPtr("IMP"),
+	}}}}
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"class=IMP", true},
+		{"class=http://terminology.hl7.org/CodeSystem/v3-ActCode|IMP", true},
+		{"class=AMB,IMP", true},
+		{"class:not=IMP", false},
+		{"status=in-progress&class=AMB", false},
+		{"patient=Patient/123", true},
+		{"patient=123", true},
+		{"_id=1", true},
+		{"status:missing=true", false},
+	}
+	for _, tt := range tests {
+		criteria, err := parseQuery("Encounter", tt.query)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		got, err := engine.test(criteria, encounter)
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.query, tt.want, got)
+		}
+	}
+}
+
+func TestRESTHook(t *testing.T) {
+	var received *fhir5.SubscriptionNotification
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		data, _ := io.ReadAll(r.Body)
+		received, _ = fhir5.UnmarshalSubscriptionNotification(data)
+	}))
+	defer server.Close()
+
+	engine := NewEngine()
+	if err := engine.AddTopic(loadTopic(t)); err != nil {
+		t.Fatalf("AddTopic: %v", err)
+	}
+	s := newSubscription("s1", "rest-hook", fhir5.SubscriptionStatusCodeRequested)
+	s.Endpoint = fhir5.StringPtr(server.URL)
+	s.Parameter = []fhir5.SubscriptionParameter{{Name: "Authorization", Value: "Bearer secret"}}
+	if err := engine.Subscribe(context.Background(), s); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if received == nil || received.Type() != fhir5.SubscriptionStatusTypeHandshake {
+		t.Fatalf("expected a handshake, got %+v", received)
+	}
+	if authorization != "Bearer secret" {
+		t.Errorf("expected the parameter as header, got %q", authorization)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	s2 := newSubscription("s2", "rest-hook", fhir5.SubscriptionStatusCodeRequested)
+	s2.Endpoint = fhir5.StringPtr(failing.URL)
+	if err := engine.Subscribe(context.Background(), s2); err == nil {
+		t.Fatal("expected the handshake to fail")
+	}
+	bundle, _ := engine.Status("s2")
+	status := bundle.Entry[0].Resource.(*fhir5.SubscriptionStatus)
+	if *status.Status != fhir5.SubscriptionStatusStatusError {
+		t.Errorf("expected status error, got %s", *status.Status)
+	}
+	if len(status.Error) != 1 {
+		t.Errorf("expected the delivery error in the status, got %v", status.Error)
+	}
+}
+
+func TestWebSocket(t *testing.T) {
+	ws := NewWebSocket()
+	server := httptest.NewServer(ws)
+	defer server.Close()
+
+	engine := NewEngine()
+	engine.RegisterChannel("websocket", ws)
+	if err := engine.AddTopic(loadTopic(t)); err != nil {
+		t.Fatalf("AddTopic: %v", err)
+	}
+	s := newSubscription("s1", "websocket", fhir5.SubscriptionStatusCodeActive)
+	if err := engine.Subscribe(context.Background(), s); err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	conn, err := net.Dial("tcp", strings.TrimPrefix(server.URL, "http://"))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+	r := bufio.NewReader(conn)
+	_, _ = io.WriteString(conn, "GET / HTTP/1.1\r\nHost: test\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n"+
+		"Sec-WebSocket-Version: 13\r\nSec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n\r\n")
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatalf("handshake: %v", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("unexpected handshake response %s %v", resp.Status, resp.Header)
+	}
+
+	token, _ := ws.Token("s1")
+	writeClientFrame(t, conn, opText, []byte("bind-with-token "+token))
+	// binding is asynchronous, wait until the server knows the connection
+	for i := 0; ; i++ {
+		ws.mu.Lock()
+		bound := len(ws.conns["s1"]) > 0
+		ws.mu.Unlock()
+		if bound {
+			break
+		}
+		if i == 100 {
+			t.Fatal("connection was not bound")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	change := Change{
+		Interaction: Update,
+		Previous:    newEncounter("1", "Patient/1", fhir5.EncounterStatusPlanned),
+		Current:     newEncounter("1", "Patient/1", fhir5.EncounterStatusInProgress),
+	}
+	if err := engine.Notify(context.Background(), change); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	op, payload, err := readFrame(r)
+	if err != nil || op != opText {
+		t.Fatalf("expected a text frame, got %d: %v", op, err)
+	}
+	notification, err := fhir5.UnmarshalSubscriptionNotification(payload)
+	if err != nil {
+		t.Fatalf("invalid notification: %v", err)
+	}
+	if notification.Type() != fhir5.SubscriptionStatusTypeEventNotification {
+		t.Errorf("expected an event notification, got %s", notification.Type())
+	}
+}
+
+func TestWebSocket_Bind(t *testing.T) {
+	ws := NewWebSocket()
+	token, _ := ws.Token("s1")
+	ws.tokens["expired"] = binding{subscription: "s2", expires: time.Now().Add(-time.Second)}
+
+	if err := ws.bind(&wsConn{}, "bind-with-token  "+token+" "); err != nil {
+		t.Fatalf("bind: %v", err)
+	}
+	if len(ws.conns["s1"]) != 1 {
+		t.Errorf("expected a connection bound to s1, got %v", ws.conns)
+	}
+	if err := ws.bind(&wsConn{}, "bind-with-token "+token); err == nil {
+		t.Error("expected a used token to be rejected")
+	}
+	if err := ws.bind(&wsConn{}, "bind-with-token expired"); err == nil {
+		t.Error("expected an expired token to be rejected")
+	}
+	if len(ws.tokens) != 0 {
+		t.Errorf("expected the used and expired tokens to be removed, got %v", ws.tokens)
+	}
+}
+
+// writeClientFrame writes a masked frame as a websocket client does
+func writeClientFrame(t *testing.T, w io.Writer, op byte, payload []byte) {
+	t.Helper()
+	mask := []byte{1, 2, 3, 4}
+	frame := []byte{0x80 | op, 0x80 | byte(len(payload))}
+	frame = append(frame, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	if _, err := w.Write(frame); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package subscription
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/fhirpath"
+)
+
+// Interaction is the kind of change made to a resource
+type Interaction = fhir5.SubscriptionTopicResourceTriggerSupportedInteraction
+
+// Interactions that trigger resource triggers
+const (
+	Create = fhir5.SubscriptionTopicResourceTriggerSupportedInteractionCreate
+	Update = fhir5.SubscriptionTopicResourceTriggerSupportedInteractionUpdate
+	Delete = fhir5.SubscriptionTopicResourceTriggerSupportedInteractionDelete
+)
+
+// Change is a change of a resource. Previous is nil for a create and Current
+// is nil for a delete.
+type Change struct {
+	Interaction Interaction
+	Previous    common.Resource
+	Current     common.Resource
+}
+
+// resourceType returns the type of the changed resource
+func (c Change) resourceType() string {
+	if c.Current != nil {
+		return c.Current.GetResourceType()
+	}
+	if c.Previous != nil {
+		return c.Previous.GetResourceType()
+	}
+	return ""
+}
+
+// focus returns the resource a notification is about: the current version or
+// the deleted one
+func (c Change) focus() common.Resource {
+	if c.Current != nil {
+		return c.Current
+	}
+	return c.Previous
+}
+
+// topic is a SubscriptionTopic with its parsed criteria
+type topic struct {
+	resource *fhir5.SubscriptionTopic
+	triggers []trigger
+}
+
+// trigger is a resource trigger of a topic
+type trigger struct {
+	resourceType string
+	interactions []Interaction
+	fhirPath     *fhirpath.Expression
+	previous     []criterion
+	current      []criterion
+	query        *fhir5.SubscriptionTopicResourceTriggerQueryCriteria
+}
+
+// newTopic parses the criteria of the resource triggers of a topic
+func newTopic(t *fhir5.SubscriptionTopic) (*topic, error) {
+	parsed := &topic{resource: t}
+	for i, rt := range t.ResourceTrigger {
+		tr := trigger{resourceType: typeName(rt.Resource), interactions: rt.SupportedInteraction}
+		if rt.FhirPathCriteria != nil {
+			expr, err := fhirpath.Parse(*rt.FhirPathCriteria)
+			if err != nil {
+				return nil, fmt.Errorf("subscription: resourceTrigger[%d].fhirPathCriteria of %s: %w", i, t.URL, err)
+			}
+			tr.fhirPath = expr
+		}
+		if q := rt.QueryCriteria; q != nil {
+			tr.query = q
+			var err error
+			if q.Previous != nil {
+				if tr.previous, err = parseQuery(tr.resourceType, *q.Previous); err != nil {
+					return nil, err
+				}
+			}
+			if q.Current != nil {
+				if tr.current, err = parseQuery(tr.resourceType, *q.Current); err != nil {
+					return nil, err
+				}
+			}
+		}
+		parsed.triggers = append(parsed.triggers, tr)
+	}
+	return parsed, nil
+}
+
+// fires reports whether one of the resource triggers of the topic fires for the change
+func (e *Engine) fires(t *topic, change Change) (bool, error) {
+	for _, tr := range t.triggers {
+		ok, err := e.triggerFires(tr, change)
+		if ok || err != nil {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
+// triggerFires applies the interactions, the query criteria and the FHIRPath
+// criteria of a trigger, all of which have to pass
+func (e *Engine) triggerFires(tr trigger, change Change) (bool, error) {
+	if tr.resourceType != change.resourceType() && tr.resourceType != "Resource" {
+		return false, nil
+	}
+	if len(tr.interactions) > 0 && !supports(tr.interactions, change.Interaction) {
+		return false, nil
+	}
+	if tr.query != nil {
+		ok, err := e.queryPasses(tr, change)
+		if !ok || err != nil {
+			return false, err
+		}
+	}
+	if tr.fhirPath != nil {
+		context := change.focus()
+		ok, _, err := tr.fhirPath.EvaluateBool(context,
+			fhirpath.WithVariable("previous", change.Previous),
+			fhirpath.WithVariable("current", change.Current))
+		if !ok || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// queryPasses applies the previous and current query criteria. A create has no
+// previous version and a delete no current one; the tests of the missing
+// version take the result given by resultForCreate and resultForDelete.
+func (e *Engine) queryPasses(tr trigger, change Change) (bool, error) {
+	q := tr.query
+	previous, err := e.test(tr.previous, change.Previous)
+	if err != nil {
+		return false, err
+	}
+	if change.Interaction == Create && len(tr.previous) > 0 {
+		previous = q.ResultForCreate != nil && *q.ResultForCreate == fhir5.SubscriptionTopicResourceTriggerQueryCriteriaResultForCreateTestPasses
+	}
+	current, err := e.test(tr.current, change.Current)
+	if err != nil {
+		return false, err
+	}
+	if change.Interaction == Delete && len(tr.current) > 0 {
+		current = q.ResultForDelete != nil && *q.ResultForDelete == fhir5.SubscriptionTopicResourceTriggerQueryCriteriaResultForDeleteTestPasses
+	}
+
+	requireBoth := q.RequireBoth != nil && *q.RequireBoth
+	switch {
+	case len(tr.previous) == 0:
+		return current, nil
+	case len(tr.current) == 0:
+		return previous, nil
+	case requireBoth:
+		return previous && current, nil
+	}
+	return previous || current, nil
+}
+
+// test reports whether the resource passes all criteria
+func (e *Engine) test(criteria []criterion, resource common.Resource) (bool, error) {
+	for _, c := range criteria {
+		ok, err := e.matches(resource, c)
+		if !ok || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// typeName returns the resource type of a canonical URL such as
+// http://hl7.org/fhir/StructureDefinition/Encounter
+func typeName(resource string) string {
+	return resource[strings.LastIndexByte(resource, '/')+1:]
+}
+
+func supports(interactions []Interaction, interaction Interaction) bool {
+	for _, i := range interactions {
+		if i == interaction {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,211 @@
+package terminology
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// codeSystem is a CodeSystem indexed by code with its concept hierarchy
+type codeSystem struct {
+	resource *fhir5.CodeSystem
+	url      string
+	version  string
+	concepts map[string]*concept
+	folded   map[string]*concept
+	order    []*concept
+}
+
+// concept is a concept of a code system with its parents and children. The
+// hierarchy combines nested concepts with the parent and child properties.
+type concept struct {
+	def      *fhir5.CodeSystemConcept
+	parents  []*concept
+	children []*concept
+}
+
+// newCodeSystem indexes the concepts of a code system
+func newCodeSystem(cs *fhir5.CodeSystem) *codeSystem {
+	indexed := &codeSystem{
+		resource: cs,
+		url:      deref(cs.URL),
+		version:  deref(cs.Version),
+		concepts: map[string]*concept{},
+		folded:   map[string]*concept{},
+	}
+	var walk func(concepts []fhir5.CodeSystemConcept, parent *concept)
+	walk = func(concepts []fhir5.CodeSystemConcept, parent *concept) {
+		for i := range concepts {
+			c := &concept{def: &concepts[i]}
+			indexed.concepts[c.def.Code] = c
+			indexed.folded[strings.ToLower(c.def.Code)] = c
+			indexed.order = append(indexed.order, c)
+			if parent != nil {
+				link(parent, c)
+			}
+			walk(c.def.Concept, c)
+		}
+	}
+	walk(cs.Concept, nil)
+
+	for _, c := range indexed.order {
+		for _, p := range c.def.Property {
+			if p.ValueCode == nil {
+				continue
+			}
+			other := indexed.concepts[*p.ValueCode]
+			if other == nil {
+				continue
+			}
+			switch p.Code {
+			case "parent":
+				link(other, c)
+			case "child":
+				link(c, other)
+			}
+		}
+	}
+	return indexed
+}
+
+// link makes child a child of parent unless it already is
+func link(parent, child *concept) {
+	for _, c := range parent.children {
+		if c == child {
+			return
+		}
+	}
+	parent.children = append(parent.children, child)
+	child.parents = append(child.parents, parent)
+}
+
+// find returns the concept of a code, ignoring case if the code system is not
+// case sensitive
+func (cs *codeSystem) find(code string) *concept {
+	if c, ok := cs.concepts[code]; ok {
+		return c
+	}
+	if cs.resource.CaseSensitive != nil && !*cs.resource.CaseSensitive {
+		return cs.folded[strings.ToLower(code)]
+	}
+	return nil
+}
+
+// descendants returns the concepts below c, each once
+func (c *concept) descendants() []*concept {
+	var result []*concept
+	seen := map[*concept]bool{c: true}
+	var walk func(*concept)
+	walk = func(c *concept) {
+		for _, child := range c.children {
+			if !seen[child] {
+				seen[child] = true
+				result = append(result, child)
+				walk(child)
+			}
+		}
+	}
+	walk(c)
+	return result
+}
+
+// ancestors returns the concepts above c, each once
+func (c *concept) ancestors() []*concept {
+	var result []*concept
+	seen := map[*concept]bool{c: true}
+	var walk func(*concept)
+	walk = func(c *concept) {
+		for _, parent := range c.parents {
+			if !seen[parent] {
+				seen[parent] = true
+				result = append(result, parent)
+				walk(parent)
+			}
+		}
+	}
+	walk(c)
+	return result
+}
+
+// values returns the values of a property of the concept as strings. The
+// pseudo properties concept, code and display select the code and the display,
+// parent and child follow the hierarchy.
+func (c *concept) values(property string) []string {
+	switch property {
+	case "concept", "code":
+		return []string{c.def.Code}
+	case "display":
+		if c.def.Display == nil {
+			return nil
+		}
+		return []string{*c.def.Display}
+	case "parent":
+		return codes(c.parents)
+	case "child":
+		return codes(c.children)
+	}
+	var values []string
+	for _, p := range c.def.Property {
+		if p.Code != property {
+			continue
+		}
+		switch {
+		case p.ValueCode != nil:
+			values = append(values, *p.ValueCode)
+		case p.ValueCoding != nil:
+			values = append(values, deref(p.ValueCoding.Code))
+		case p.ValueString != nil:
+			values = append(values, *p.ValueString)
+		case p.ValueInteger != nil:
+			values = append(values, strconv.Itoa(*p.ValueInteger))
+		case p.ValueBoolean != nil:
+			values = append(values, strconv.FormatBool(*p.ValueBoolean))
+		case p.ValueDateTime != nil:
+			values = append(values, p.ValueDateTime.String())
+		case p.ValueDecimal != nil:
+			values = append(values, p.ValueDecimal.String())
+		}
+	}
+	return values
+}
+
+// inactive reports whether the concept is retired or marked inactive
+func (c *concept) inactive() bool {
+	for _, v := range c.values("status") {
+		if v == "retired" || v == "inactive" {
+			return true
+		}
+	}
+	for _, v := range c.values("inactive") {
+		if v == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// abstract reports whether the concept is not selectable
+func (c *concept) abstract() bool {
+	for _, v := range c.values("notSelectable") {
+		if v == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func codes(concepts []*concept) []string {
+	result := make([]string, len(concepts))
+	for i, c := range concepts {
+		result[i] = c.def.Code
+	}
+	return result
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
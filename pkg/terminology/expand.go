@@ -0,0 +1,613 @@
+package terminology
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// ExpandParameters are the parameters of $expand
+type ExpandParameters struct {
+	// Filter restricts the expansion to codes whose code, display or
+	// designations contain every word of the text
+	Filter string
+
+	// Offset and Count page through the expansion. A nil Count returns all
+	// codes, a Count of 0 only the total. Negative values are rejected.
+	Offset int
+	Count  *int
+
+	// IncludeDesignations returns the designations of each code
+	IncludeDesignations bool
+
+	// Properties are the concept properties returned for each code, in
+	// addition to those listed in ValueSet.compose.property
+	Properties []string
+
+	// ActiveOnly drops inactive codes
+	ActiveOnly bool
+
+	// ExcludeNested returns a flat list instead of nesting codes below their
+	// parents in the code system hierarchy. Paged expansions are always flat.
+	ExcludeNested bool
+
+	// DisplayLanguage selects the designation used as display
+	DisplayLanguage string
+}
+
+// Expand implements $expand for a value set added to the service
+func (s *Service) Expand(canonical string, params ExpandParameters) (*fhir5.ValueSet, error) {
+	s.mu.RLock()
+	vs, err := s.valueSet(canonical)
+	s.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return s.ExpandValueSet(vs, params)
+}
+
+// ExpandValueSet implements $expand: it evaluates the compose of the value set
+// against the code systems of the service and returns a copy of the value set
+// with the expansion. A value set without compose is expanded to the codes of
+// its existing expansion.
+func (s *Service) ExpandValueSet(vs *fhir5.ValueSet, params ExpandParameters) (*fhir5.ValueSet, error) {
+	if params.Offset < 0 {
+		return nil, fmt.Errorf("terminology: offset %d is negative", params.Offset)
+	}
+	if params.Count != nil && *params.Count < 0 {
+		return nil, fmt.Errorf("terminology: count %d is negative", *params.Count)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	x := &expander{service: s, visiting: map[string]bool{}, used: map[string]bool{}}
+	set, err := x.valueSet(vs)
+	if err != nil {
+		return nil, err
+	}
+
+	words := strings.Fields(strings.ToLower(params.Filter))
+	var entries []*entry
+	for _, e := range set.entries {
+		if params.ActiveOnly && e.inactive {
+			continue
+		}
+		if len(words) > 0 && !e.matches(words) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	properties := params.Properties
+	if vs.Compose != nil {
+		properties = append(append([]string(nil), vs.Compose.Property...), properties...)
+	}
+	b := builder{params: params, properties: properties}
+
+	expansion := &fhir5.ValueSetExpansion{
+		Identifier: fhir5.StringPtr("urn:uuid:" + newUUID()),
+		Timestamp:  common.DateTimeFromTime(time.Now()),
+		Total:      fhir5.IntPtr(len(entries)),
+		Parameter:  x.parameters(params),
+	}
+	if params.Offset > 0 || params.Count != nil {
+		expansion.Offset = fhir5.IntPtr(params.Offset)
+		end := len(entries)
+		if params.Count != nil && params.Offset+*params.Count < end {
+			end = params.Offset + *params.Count
+		}
+		for i := params.Offset; i < end; i++ {
+			expansion.Contains = append(expansion.Contains, b.contains(entries[i], nil))
+		}
+	} else if params.ExcludeNested {
+		for _, e := range entries {
+			expansion.Contains = append(expansion.Contains, b.contains(e, nil))
+		}
+	} else {
+		expansion.Contains = b.nested(entries)
+	}
+
+	expanded := *vs
+	expanded.ResourceType = "ValueSet"
+	expanded.Expansion = expansion
+	return &expanded, nil
+}
+
+// entry is a code of an expansion
+type entry struct {
+	system       string
+	version      string
+	code         string
+	display      *string
+	concept      *concept
+	designations []fhir5.ValueSetComposeIncludeConceptDesignation
+	properties   []fhir5.ValueSetExpansionContainsProperty
+	abstract     bool
+	inactive     bool
+}
+
+func (e *entry) key() string {
+	return e.system + "|" + e.code
+}
+
+// matches reports whether every word occurs in the code, the display or a designation
+func (e *entry) matches(words []string) bool {
+	texts := []string{strings.ToLower(e.code), strings.ToLower(deref(e.display))}
+	for _, d := range e.designations {
+		texts = append(texts, strings.ToLower(d.Value))
+	}
+	for _, w := range words {
+		found := false
+		for _, t := range texts {
+			if strings.Contains(t, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// codeSet is an ordered set of codes
+type codeSet struct {
+	index   map[string]int
+	entries []*entry
+}
+
+func newCodeSet() *codeSet {
+	return &codeSet{index: map[string]int{}}
+}
+
+func (s *codeSet) add(e *entry) {
+	if _, ok := s.index[e.key()]; ok {
+		return
+	}
+	s.index[e.key()] = len(s.entries)
+	s.entries = append(s.entries, e)
+}
+
+func (s *codeSet) has(key string) bool {
+	_, ok := s.index[key]
+	return ok
+}
+
+// intersect keeps the codes that are also in other
+func (s *codeSet) intersect(other *codeSet) *codeSet {
+	result := newCodeSet()
+	for _, e := range s.entries {
+		if other.has(e.key()) {
+			result.add(e)
+		}
+	}
+	return result
+}
+
+// expander evaluates the compose of value sets
+type expander struct {
+	service  *Service
+	visiting map[string]bool
+	used     map[string]bool
+	usedList []string
+}
+
+// valueSet expands a value set, detecting import cycles
+func (x *expander) valueSet(vs *fhir5.ValueSet) (*codeSet, error) {
+	if url := deref(vs.Url); url != "" {
+		if x.visiting[url] {
+			return nil, fmt.Errorf("terminology: value set %s imports itself", url)
+		}
+		x.visiting[url] = true
+		defer delete(x.visiting, url)
+	}
+	if vs.Compose == nil {
+		if vs.Expansion == nil {
+			return nil, fmt.Errorf("terminology: value set %s has neither compose nor expansion", deref(vs.Url))
+		}
+		set := newCodeSet()
+		addExpansion(set, vs.Expansion.Contains)
+		return set, nil
+	}
+
+	set := newCodeSet()
+	for i := range vs.Compose.Include {
+		included, err := x.include(&vs.Compose.Include[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range included.entries {
+			set.add(e)
+		}
+	}
+	excluded := newCodeSet()
+	for i := range vs.Compose.Exclude {
+		codes, err := x.include(&vs.Compose.Exclude[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range codes.entries {
+			excluded.add(e)
+		}
+	}
+
+	result := newCodeSet()
+	for _, e := range set.entries {
+		if excluded.has(e.key()) {
+			continue
+		}
+		if vs.Compose.Inactive != nil && !*vs.Compose.Inactive && e.inactive {
+			continue
+		}
+		result.add(e)
+	}
+	return result, nil
+}
+
+// addExpansion adds the codes of an existing expansion, skipping entries that
+// only group codes
+func addExpansion(set *codeSet, contains []fhir5.ValueSetExpansionContains) {
+	for _, c := range contains {
+		if c.Code != nil {
+			set.add(&entry{
+				system:       deref(c.System),
+				version:      deref(c.Version),
+				code:         *c.Code,
+				display:      c.Display,
+				designations: c.Designation,
+				properties:   c.Property,
+				abstract:     c.Abstract != nil && *c.Abstract,
+				inactive:     c.Inactive != nil && *c.Inactive,
+			})
+		}
+		addExpansion(set, c.Contains)
+	}
+}
+
+// include evaluates an include or exclude: the codes of the system, restricted
+// by the listed concepts or the filters, intersected with the imported value sets
+func (x *expander) include(inc *fhir5.ValueSetComposeInclude) (*codeSet, error) {
+	var set *codeSet
+	if inc.System != nil {
+		var err error
+		if set, err = x.system(inc); err != nil {
+			return nil, err
+		}
+	}
+	for _, canonical := range inc.ValueSet {
+		vs, err := x.service.valueSet(canonical)
+		if err != nil {
+			return nil, err
+		}
+		imported, err := x.valueSet(vs)
+		if err != nil {
+			return nil, err
+		}
+		if set == nil {
+			set = imported
+		} else {
+			set = set.intersect(imported)
+		}
+	}
+	if set == nil {
+		return nil, fmt.Errorf("terminology: include has neither system nor valueSet")
+	}
+	return set, nil
+}
+
+// system evaluates the system part of an include
+func (x *expander) system(inc *fhir5.ValueSetComposeInclude) (*codeSet, error) {
+	cs, err := x.service.codeSystem(*inc.System, deref(inc.Version))
+	set := newCodeSet()
+	if len(inc.Concept) > 0 {
+		// enumerated codes of unknown systems are taken as they are
+		for _, ic := range inc.Concept {
+			if cs == nil {
+				set.add(&entry{system: *inc.System, version: deref(inc.Version), code: ic.Code, display: ic.Display, designations: ic.Designation})
+				continue
+			}
+			c := cs.find(ic.Code)
+			if c == nil {
+				return nil, fmt.Errorf("terminology: code %s is not in code system %s", ic.Code, cs.url)
+			}
+			e := newEntry(cs, c)
+			if ic.Display != nil {
+				e.display = ic.Display
+			}
+			e.designations = append(e.designations, ic.Designation...)
+			set.add(e)
+		}
+		if cs != nil {
+			x.use(cs)
+		}
+		return set, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	x.use(cs)
+
+	concepts := cs.order
+	for _, f := range inc.Filter {
+		if concepts, err = filter(cs, concepts, f); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range concepts {
+		set.add(newEntry(cs, c))
+	}
+	return set, nil
+}
+
+// use records a code system for the used-codesystem parameter
+func (x *expander) use(cs *codeSystem) {
+	key := cs.url
+	if cs.version != "" {
+		key += "|" + cs.version
+	}
+	if !x.used[key] {
+		x.used[key] = true
+		x.usedList = append(x.usedList, key)
+	}
+}
+
+// parameters returns the expansion parameters: the code systems used and the
+// parameters of the request
+func (x *expander) parameters(params ExpandParameters) []fhir5.ValueSetExpansionParameter {
+	var result []fhir5.ValueSetExpansionParameter
+	for _, used := range x.usedList {
+		result = append(result, fhir5.ValueSetExpansionParameter{Name: "used-codesystem", ValueUri: fhir5.StringPtr(used)})
+	}
+	if params.Filter != "" {
+		result = append(result, fhir5.ValueSetExpansionParameter{Name: "filter", ValueString: fhir5.StringPtr(params.Filter)})
+	}
+	if params.Offset > 0 {
+		result = append(result, fhir5.ValueSetExpansionParameter{Name: "offset", ValueInteger: fhir5.IntPtr(params.Offset)})
+	}
+	if params.Count != nil {
+		result = append(result, fhir5.ValueSetExpansionParameter{Name: "count", ValueInteger: fhir5.IntPtr(*params.Count)})
+	}
+	flags := []struct {
+		name string
+		set  bool
+	}{
+		{"includeDesignations", params.IncludeDesignations},
+		{"activeOnly", params.ActiveOnly},
+		{"excludeNested", params.ExcludeNested},
+	}
+	for _, f := range flags {
+		if f.set {
+			result = append(result, fhir5.ValueSetExpansionParameter{Name: f.name, ValueBoolean: fhir5.BoolPtr(true)})
+		}
+	}
+	if params.DisplayLanguage != "" {
+		result = append(result, fhir5.ValueSetExpansionParameter{Name: "displayLanguage", ValueCode: fhir5.StringPtr(params.DisplayLanguage)})
+	}
+	for _, p := range params.Properties {
+		result = append(result, fhir5.ValueSetExpansionParameter{Name: "property", ValueString: fhir5.StringPtr(p)})
+	}
+	return result
+}
+
+func newEntry(cs *codeSystem, c *concept) *entry {
+	e := &entry{
+		system:   cs.url,
+		version:  cs.version,
+		code:     c.def.Code,
+		display:  c.def.Display,
+		concept:  c,
+		abstract: c.abstract(),
+		inactive: c.inactive(),
+	}
+	for _, d := range c.def.Designation {
+		e.designations = append(e.designations, fhir5.ValueSetComposeIncludeConceptDesignation(d))
+	}
+	return e
+}
+
+// filter applies a compose filter to concepts of a code system
+func filter(cs *codeSystem, concepts []*concept, f fhir5.ValueSetComposeIncludeFilter) ([]*concept, error) {
+	var keep func(*concept) bool
+	switch f.Op {
+	case fhir5.ValueSetComposeIncludeFilterOpEquals:
+		keep = func(c *concept) bool { return contains(c.values(f.Property), f.Value) }
+	case fhir5.ValueSetComposeIncludeFilterOpIn, fhir5.ValueSetComposeIncludeFilterOpNotIn:
+		wanted := strings.Split(f.Value, ",")
+		in := f.Op == fhir5.ValueSetComposeIncludeFilterOpIn
+		keep = func(c *concept) bool {
+			for _, v := range c.values(f.Property) {
+				if contains(wanted, v) {
+					return in
+				}
+			}
+			return !in
+		}
+	case fhir5.ValueSetComposeIncludeFilterOpExists:
+		exists := f.Value == "true"
+		keep = func(c *concept) bool { return (len(c.values(f.Property)) > 0) == exists }
+	case fhir5.ValueSetComposeIncludeFilterOpRegex:
+		re, err := regexp.Compile("^(?:" + f.Value + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("terminology: invalid regex filter %q: %w", f.Value, err)
+		}
+		keep = func(c *concept) bool {
+			for _, v := range c.values(f.Property) {
+				if re.MatchString(v) {
+					return true
+				}
+			}
+			return false
+		}
+	case fhir5.ValueSetComposeIncludeFilterOpIsA, fhir5.ValueSetComposeIncludeFilterOpDescendentOf,
+		fhir5.ValueSetComposeIncludeFilterOpIsNotA, fhir5.ValueSetComposeIncludeFilterOpGeneralizes,
+		fhir5.ValueSetComposeIncludeFilterOpChildOf, fhir5.ValueSetComposeIncludeFilterOpDescendentLeaf:
+		selected := map[*concept]bool{}
+		if root := cs.find(f.Value); root != nil {
+			for _, c := range related(root, f.Op) {
+				selected[c] = true
+			}
+		}
+		negate := f.Op == fhir5.ValueSetComposeIncludeFilterOpIsNotA
+		keep = func(c *concept) bool { return selected[c] != negate }
+	default:
+		return nil, fmt.Errorf("terminology: filter operator %s is not supported", f.Op)
+	}
+
+	var result []*concept
+	for _, c := range concepts {
+		if keep(c) {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+// related returns the concepts a hierarchy filter selects relative to root
+func related(root *concept, op fhir5.ValueSetComposeIncludeFilterOp) []*concept {
+	switch op {
+	case fhir5.ValueSetComposeIncludeFilterOpIsA, fhir5.ValueSetComposeIncludeFilterOpIsNotA:
+		return append([]*concept{root}, root.descendants()...)
+	case fhir5.ValueSetComposeIncludeFilterOpDescendentOf:
+		return root.descendants()
+	case fhir5.ValueSetComposeIncludeFilterOpGeneralizes:
+		return append([]*concept{root}, root.ancestors()...)
+	case fhir5.ValueSetComposeIncludeFilterOpChildOf:
+		return root.children
+	}
+	var leaves []*concept
+	for _, c := range root.descendants() {
+		if len(c.children) == 0 {
+			leaves = append(leaves, c)
+		}
+	}
+	return leaves
+}
+
+// builder converts entries into expansion contains
+type builder struct {
+	params     ExpandParameters
+	properties []string
+}
+
+// nested places each code below its nearest ancestor in the expansion
+func (b builder) nested(entries []*entry) []fhir5.ValueSetExpansionContains {
+	present := map[*concept]*entry{}
+	for _, e := range entries {
+		if e.concept != nil {
+			present[e.concept] = e
+		}
+	}
+	children := map[*entry][]*entry{}
+	var roots []*entry
+	for _, e := range entries {
+		if parent := nearest(e, present); parent != nil {
+			children[parent] = append(children[parent], e)
+		} else {
+			roots = append(roots, e)
+		}
+	}
+	result := make([]fhir5.ValueSetExpansionContains, len(roots))
+	for i, e := range roots {
+		result[i] = b.contains(e, children)
+	}
+	return result
+}
+
+// nearest returns the closest ancestor of an entry that is part of the
+// expansion, searching breadth first
+func nearest(e *entry, present map[*concept]*entry) *entry {
+	if e.concept == nil {
+		return nil
+	}
+	seen := map[*concept]bool{e.concept: true}
+	level := e.concept.parents
+	for len(level) > 0 {
+		var next []*concept
+		for _, p := range level {
+			if seen[p] {
+				continue
+			}
+			seen[p] = true
+			if parent, ok := present[p]; ok && parent.system == e.system {
+				return parent
+			}
+			next = append(next, p.parents...)
+		}
+		level = next
+	}
+	return nil
+}
+
+// contains converts an entry and, for nested expansions, its children
+func (b builder) contains(e *entry, children map[*entry][]*entry) fhir5.ValueSetExpansionContains {
+	c := fhir5.ValueSetExpansionContains{
+		System:  fhir5.StringPtr(e.system),
+		Code:    fhir5.StringPtr(e.code),
+		Display: e.display,
+	}
+	if e.version != "" {
+		c.Version = fhir5.StringPtr(e.version)
+	}
+	if e.abstract {
+		c.Abstract = fhir5.BoolPtr(true)
+	}
+	if e.inactive {
+		c.Inactive = fhir5.BoolPtr(true)
+	}
+	if b.params.DisplayLanguage != "" {
+		for _, d := range e.designations {
+			if d.Language != nil && strings.EqualFold(*d.Language, b.params.DisplayLanguage) {
+				c.Display = fhir5.StringPtr(d.Value)
+				break
+			}
+		}
+	}
+	if b.params.IncludeDesignations {
+		c.Designation = e.designations
+	}
+	for _, p := range b.properties {
+		c.Property = append(c.Property, e.property(p)...)
+	}
+	for _, child := range children[e] {
+		c.Contains = append(c.Contains, b.contains(child, children))
+	}
+	return c
+}
+
+// property returns the values of a property of the entry. Parents and
+// children come from the hierarchy when the concept does not state them.
+func (e *entry) property(code string) []fhir5.ValueSetExpansionContainsProperty {
+	var result []fhir5.ValueSetExpansionContainsProperty
+	if e.concept == nil {
+		for _, p := range e.properties {
+			if p.Code == code {
+				result = append(result, p)
+			}
+		}
+		return result
+	}
+	for _, p := range e.concept.def.Property {
+		if p.Code == code {
+			result = append(result, fhir5.ValueSetExpansionContainsProperty(p))
+		}
+	}
+	if len(result) == 0 && (code == "parent" || code == "child") {
+		for _, v := range e.concept.values(code) {
+			result = append(result, fhir5.ValueSetExpansionContainsProperty{Code: code, ValueCode: fhir5.StringPtr(v)})
+		}
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
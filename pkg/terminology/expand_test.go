@@ -0,0 +1,315 @@
+package terminology
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+const (
+	issueTypes = "http://hl7.org/fhir/issue-type"
+	acme       = "http://hl7.org/fhir/CodeSystem/example"
+	statuses   = "http://example.org/fhir/CodeSystem/status"
+)
+
+func loadCodeSystem(t *testing.T, file string) *fhir5.CodeSystem {
+	t.Helper()
+	data, err := os.ReadFile("../fhir5/testdata/fhir5-json/" + file)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", file, err)
+	}
+	var cs fhir5.CodeSystem
+	if err := json.Unmarshal(data, &cs); err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", file, err)
+	}
+	return &cs
+}
+
+// statusCodeSystem is a small code system whose hierarchy is given by parent
+// properties, with an abstract and a retired concept
+func statusCodeSystem() *fhir5.CodeSystem {
+	property := func(code, value string) fhir5.CodeSystemConceptProperty {
+		return fhir5.CodeSystemConceptProperty{Code: code, ValueCode: fhir5.StringPtr(value)}
+	}
+	return &fhir5.CodeSystem{
+		ResourceType: "CodeSystem",
+		URL:          fhir5.StringPtr(statuses),
+		Version:      fhir5.StringPtr("1.0"),
+		Content:      fhir5.CodeSystemContentComplete,
+		Concept: []fhir5.CodeSystemConcept{
+			{Code: "any", Display: fhir5.StringPtr("Any status"), Property: []fhir5.CodeSystemConceptProperty{
+				{Code: "notSelectable", ValueBoolean: fhir5.BoolPtr(true)},
+			}},
+			{Code: "open", Display: fhir5.StringPtr("Open"), Property: []fhir5.CodeSystemConceptProperty{property("parent", "any")}},
+			{Code: "closed", Display: fhir5.StringPtr("Closed"), Property: []fhir5.CodeSystemConceptProperty{property("parent", "any")},
+				Designation: []fhir5.CodeSystemConceptDesignation{{Language: fhir5.StringPtr("de"), Value: "Geschlossen"}}},
+			{Code: "archived", Display: fhir5.StringPtr("Archived"), Property: []fhir5.CodeSystemConceptProperty{
+				property("parent", "closed"), property("status", "retired"),
+			}},
+		},
+	}
+}
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	service := NewService()
+	for _, cs := range []*fhir5.CodeSystem{
+		loadCodeSystem(t, "codesystem-issue-type.json"),
+		loadCodeSystem(t, "codesystem-example.json"),
+		statusCodeSystem(),
+	} {
+		if err := service.AddCodeSystem(cs); err != nil {
+			t.Fatalf("AddCodeSystem: %v", err)
+		}
+	}
+	return service
+}
+
+func valueSet(url string, include []fhir5.ValueSetComposeInclude, exclude ...fhir5.ValueSetComposeInclude) *fhir5.ValueSet {
+	return &fhir5.ValueSet{
+		ResourceType: "ValueSet",
+		Url:          fhir5.StringPtr(url),
+		Status:       fhir5.ValueSetStatusActive,
+		Compose:      &fhir5.ValueSetCompose{Include: include, Exclude: exclude},
+	}
+}
+
+func filtered(system string, filters ...fhir5.ValueSetComposeIncludeFilter) fhir5.ValueSetComposeInclude {
+	return fhir5.ValueSetComposeInclude{System: fhir5.StringPtr(system), Filter: filters}
+}
+
+// flatten returns the codes of an expansion in document order, indenting
+// nested codes with a dot per level
+func flatten(contains []fhir5.ValueSetExpansionContains, prefix string) []string {
+	var codes []string
+	for _, c := range contains {
+		codes = append(codes, prefix+*c.Code)
+		codes = append(codes, flatten(c.Contains, prefix+".")...)
+	}
+	return codes
+}
+
+func TestExpand_Filters(t *testing.T) {
+	service := newTestService(t)
+	tests := []struct {
+		name    string
+		include fhir5.ValueSetComposeInclude
+		want    string
+	}{
+		{"is-a", filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "is-a", Value: "invalid"}),
+			"invalid .structure .required .value .invariant"},
+		{"descendent-of", filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "descendent-of", Value: "not-found"}),
+			"deleted"},
+		{"child-of", filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "child-of", Value: "security"}),
+			"login unknown expired forbidden suppressed"},
+		{"generalizes", filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "generalizes", Value: "deleted"}),
+			"processing .not-found ..deleted"},
+		{"descendent-leaf", filtered(statuses, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "descendent-leaf", Value: "any"}),
+			"open archived"},
+		{"is-not-a", filtered(statuses, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "is-not-a", Value: "closed"}),
+			"any .open"},
+		{"regex", filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "code", Op: "regex", Value: "t.*-.*"}),
+			"too-long too-costly"},
+		{"in", filtered(acme, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "in", Value: "chol,chol-mass"}),
+			"chol-mass chol"},
+		{"not-in", filtered(acme, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "not-in", Value: "chol,chol-mass"}),
+			"chol-mmol"},
+		{"exists", filtered(statuses, fhir5.ValueSetComposeIncludeFilter{Property: "status", Op: "exists", Value: "true"}),
+			"archived"},
+		{"equals", filtered(statuses, fhir5.ValueSetComposeIncludeFilter{Property: "parent", Op: "=", Value: "any"}),
+			"open closed"},
+		{"combined", filtered(issueTypes,
+			fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "is-a", Value: "processing"},
+			fhir5.ValueSetComposeIncludeFilter{Property: "display", Op: "regex", Value: ".*Not.*"}),
+			"not-found"},
+	}
+	for _, tt := range tests {
+		vs := valueSet("http://example.org/fhir/ValueSet/"+tt.name, []fhir5.ValueSetComposeInclude{tt.include})
+		expanded, err := service.ExpandValueSet(vs, ExpandParameters{})
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := strings.Join(flatten(expanded.Expansion.Contains, ""), " "); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestExpand_ComposeAndImports(t *testing.T) {
+	service := newTestService(t)
+	security := valueSet("http://example.org/fhir/ValueSet/security", []fhir5.ValueSetComposeInclude{
+		filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "is-a", Value: "security"}),
+	})
+	if err := service.AddValueSet(security); err != nil {
+		t.Fatalf("AddValueSet: %v", err)
+	}
+
+	vs := valueSet("http://example.org/fhir/ValueSet/composed",
+		[]fhir5.ValueSetComposeInclude{
+			{ValueSet: []string{"http://example.org/fhir/ValueSet/security"}},
+			{System: fhir5.StringPtr(issueTypes), Concept: []fhir5.ValueSetComposeIncludeConcept{
+				{Code: "success", Display: fhir5.StringPtr("All good")},
+			}},
+			{System: fhir5.StringPtr("http://example.org/unloaded"), Concept: []fhir5.ValueSetComposeIncludeConcept{{Code: "x"}}},
+		},
+		fhir5.ValueSetComposeInclude{System: fhir5.StringPtr(issueTypes), Concept: []fhir5.ValueSetComposeIncludeConcept{{Code: "expired"}}},
+	)
+	expanded, err := service.ExpandValueSet(vs, ExpandParameters{ExcludeNested: true})
+	if err != nil {
+		t.Fatalf("ExpandValueSet: %v", err)
+	}
+	want := "security login unknown forbidden suppressed success x"
+	if got := strings.Join(flatten(expanded.Expansion.Contains, ""), " "); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+	if *expanded.Expansion.Total != 7 {
+		t.Errorf("expected a total of 7, got %d", *expanded.Expansion.Total)
+	}
+	if display := expanded.Expansion.Contains[5].Display; *display != "All good" {
+		t.Errorf("expected the display of the value set, got %s", *display)
+	}
+	if p := expanded.Expansion.Parameter; len(p) < 2 || p[0].Name != "used-codesystem" || *p[0].ValueUri != issueTypes+"|5.0.0" {
+		t.Errorf("unexpected parameters %+v", p)
+	}
+	if expanded.Compose == nil || vs.Expansion != nil {
+		t.Error("expected a copy of the value set with the expansion")
+	}
+
+	// importing the value set restricts the system to its codes
+	restricted := valueSet("http://example.org/fhir/ValueSet/restricted", []fhir5.ValueSetComposeInclude{{
+		System:   fhir5.StringPtr(issueTypes),
+		Filter:   []fhir5.ValueSetComposeIncludeFilter{{Property: "code", Op: "regex", Value: "[a-l].*"}},
+		ValueSet: []string{"http://example.org/fhir/ValueSet/security"},
+	}})
+	expanded, err = service.ExpandValueSet(restricted, ExpandParameters{})
+	if err != nil {
+		t.Fatalf("ExpandValueSet: %v", err)
+	}
+	if got := strings.Join(flatten(expanded.Expansion.Contains, ""), " "); got != "login expired forbidden" {
+		t.Errorf("unexpected intersection %q", got)
+	}
+}
+
+func TestExpand_Errors(t *testing.T) {
+	service := newTestService(t)
+	cyclic := valueSet("http://example.org/fhir/ValueSet/cyclic", []fhir5.ValueSetComposeInclude{
+		{ValueSet: []string{"http://example.org/fhir/ValueSet/cyclic"}},
+	})
+	if err := service.AddValueSet(cyclic); err != nil {
+		t.Fatalf("AddValueSet: %v", err)
+	}
+	tests := map[string]*fhir5.ValueSet{
+		"cycle":           cyclic,
+		"unknown system":  valueSet("http://example.org/a", []fhir5.ValueSetComposeInclude{filtered("http://example.org/unknown")}),
+		"unknown code":    valueSet("http://example.org/b", []fhir5.ValueSetComposeInclude{{System: fhir5.StringPtr(acme), Concept: []fhir5.ValueSetComposeIncludeConcept{{Code: "nope"}}}}),
+		"unknown import":  valueSet("http://example.org/c", []fhir5.ValueSetComposeInclude{{ValueSet: []string{"http://example.org/missing"}}}),
+		"invalid regex":   valueSet("http://example.org/d", []fhir5.ValueSetComposeInclude{filtered(acme, fhir5.ValueSetComposeIncludeFilter{Property: "code", Op: "regex", Value: "("})}),
+		"unsupported op":  valueSet("http://example.org/e", []fhir5.ValueSetComposeInclude{filtered(acme, fhir5.ValueSetComposeIncludeFilter{Property: "code", Op: "near", Value: "x"})}),
+		"nothing to load": {Url: fhir5.StringPtr("http://example.org/f")},
+	}
+	for name, vs := range tests {
+		if _, err := service.ExpandValueSet(vs, ExpandParameters{}); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := service.Expand("http://example.org/missing", ExpandParameters{}); err == nil {
+		t.Error("expected an error for an unknown value set")
+	}
+	one, minusOne := 1, -1
+	paging := map[string]ExpandParameters{
+		"negative offset": {Offset: -1, Count: &one},
+		"negative count":  {Count: &minusOne},
+	}
+	for name, params := range paging {
+		if _, err := service.ExpandValueSet(valueSet("http://example.org/g", []fhir5.ValueSetComposeInclude{filtered(acme)}), params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestExpand_Parameters(t *testing.T) {
+	service := newTestService(t)
+	all := valueSet("http://example.org/fhir/ValueSet/all", []fhir5.ValueSetComposeInclude{filtered(issueTypes)})
+	if err := service.AddValueSet(all); err != nil {
+		t.Fatalf("AddValueSet: %v", err)
+	}
+
+	expanded, err := service.Expand(*all.Url, ExpandParameters{})
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if *expanded.Expansion.Total != 33 || len(expanded.Expansion.Contains) != 6 {
+		t.Errorf("expected 33 codes below 6 roots, got %d and %d", *expanded.Expansion.Total, len(expanded.Expansion.Contains))
+	}
+
+	count := 3
+	page, err := service.Expand(*all.Url, ExpandParameters{Offset: 4, Count: &count})
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if got := strings.Join(flatten(page.Expansion.Contains, ""), " "); got != "invariant security login" || *page.Expansion.Offset != 4 || *page.Expansion.Total != 33 {
+		t.Errorf("unexpected page %q offset %d total %d", got, *page.Expansion.Offset, *page.Expansion.Total)
+	}
+
+	text, err := service.Expand(*all.Url, ExpandParameters{Filter: "invalid CODE"})
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	if got := strings.Join(flatten(text.Expansion.Contains, ""), " "); got != "code-invalid" {
+		t.Errorf("unexpected text filter result %q", got)
+	}
+
+	statusSet := valueSet("http://example.org/fhir/ValueSet/status", []fhir5.ValueSetComposeInclude{filtered(statuses)})
+	statusSet.Compose.Property = []string{"parent"}
+	expanded, err = service.ExpandValueSet(statusSet, ExpandParameters{IncludeDesignations: true, DisplayLanguage: "de", Properties: []string{"status"}})
+	if err != nil {
+		t.Fatalf("ExpandValueSet: %v", err)
+	}
+	root := expanded.Expansion.Contains[0]
+	if root.Abstract == nil || !*root.Abstract || len(root.Contains) != 2 {
+		t.Fatalf("expected an abstract root with two children, got %+v", root)
+	}
+	closed := root.Contains[1]
+	if *closed.Display != "Geschlossen" || len(closed.Designation) != 1 {
+		t.Errorf("expected the German display and designation, got %s %v", *closed.Display, closed.Designation)
+	}
+	archived := closed.Contains[0]
+	if archived.Inactive == nil || !*archived.Inactive || *archived.Version != "1.0" {
+		t.Errorf("expected an inactive archived code, got %+v", archived)
+	}
+	if len(archived.Property) != 2 || archived.Property[0].Code != "parent" || *archived.Property[1].ValueCode != "retired" {
+		t.Errorf("unexpected properties %+v", archived.Property)
+	}
+
+	active, err := service.ExpandValueSet(statusSet, ExpandParameters{ActiveOnly: true, ExcludeNested: true})
+	if err != nil {
+		t.Fatalf("ExpandValueSet: %v", err)
+	}
+	if got := strings.Join(flatten(active.Expansion.Contains, ""), " "); got != "any open closed" {
+		t.Errorf("unexpected active codes %q", got)
+	}
+}
+
+func TestExpand_ExistingExpansion(t *testing.T) {
+	data, err := os.ReadFile("../fhir5/testdata/fhir5-json/valueset-example-inactive.json")
+	if err != nil {
+		t.Fatalf("failed to read value set: %v", err)
+	}
+	var vs fhir5.ValueSet
+	if err := json.Unmarshal(data, &vs); err != nil {
+		t.Fatalf("failed to unmarshal value set: %v", err)
+	}
+	// the code system is not loaded, so the stored expansion is used
+	vs.Compose = nil
+	expanded, err := NewService().ExpandValueSet(&vs, ExpandParameters{ActiveOnly: true})
+	if err != nil {
+		t.Fatalf("ExpandValueSet: %v", err)
+	}
+	if got := strings.Join(flatten(expanded.Expansion.Contains, ""), " "); got != "EXPEC GOL RSK OPT" {
+		t.Errorf("unexpected codes %q", got)
+	}
+}
@@ -0,0 +1,152 @@
+package terminology
+
+import (
+	"fmt"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Lookup implements CodeSystem/$lookup: the name and version of the code
+// system and the display, designations and properties of the code. Without
+// properties all properties of the concept are returned, including its
+// definition, its parents and children and whether it is inactive.
+func (s *Service) Lookup(coding common.Coding, properties ...string) (*fhir5.Parameters, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cs, c, err := s.concept(coding)
+	if err != nil {
+		return nil, err
+	}
+
+	name := cs.url
+	if cs.resource.Name != nil {
+		name = *cs.resource.Name
+	} else if cs.resource.Title != nil {
+		name = *cs.resource.Title
+	}
+	result := &fhir5.Parameters{ResourceType: "Parameters"}
+	result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "name", ValueString: fhir5.StringPtr(name)})
+	if cs.version != "" {
+		result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "version", ValueString: fhir5.StringPtr(cs.version)})
+	}
+	result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "display", ValueString: fhir5.StringPtr(display(c))})
+	for _, d := range c.def.Designation {
+		result.Parameter = append(result.Parameter, designationParameter(d))
+	}
+
+	if len(properties) == 0 {
+		properties = defaultProperties(c)
+	}
+	for _, code := range properties {
+		result.Parameter = append(result.Parameter, propertyParameters(c, code)...)
+	}
+	return result, nil
+}
+
+// concept finds the concept of a coding in the code systems of the service
+func (s *Service) concept(coding common.Coding) (*codeSystem, *concept, error) {
+	if coding.System == nil || coding.Code == nil {
+		return nil, nil, fmt.Errorf("terminology: coding needs a system and a code")
+	}
+	cs, err := s.codeSystem(*coding.System, deref(coding.Version))
+	if err != nil {
+		return nil, nil, err
+	}
+	c := cs.find(*coding.Code)
+	if c == nil {
+		return nil, nil, fmt.Errorf("terminology: code %s is not in code system %s", *coding.Code, cs.url)
+	}
+	return cs, c, nil
+}
+
+// defaultProperties lists the properties of a concept in the order they are
+// stated, followed by the pseudo properties it has
+func defaultProperties(c *concept) []string {
+	var properties []string
+	seen := map[string]bool{}
+	add := func(code string) {
+		if !seen[code] {
+			seen[code] = true
+			properties = append(properties, code)
+		}
+	}
+	if c.def.Definition != nil {
+		add("definition")
+	}
+	for _, p := range c.def.Property {
+		add(p.Code)
+	}
+	if len(c.parents) > 0 {
+		add("parent")
+	}
+	if len(c.children) > 0 {
+		add("child")
+	}
+	add("inactive")
+	return properties
+}
+
+// propertyParameters returns a property parameter per value of a property
+func propertyParameters(c *concept, code string) []fhir5.ParametersParameter {
+	property := func(value fhir5.ParametersParameter) fhir5.ParametersParameter {
+		value.Name = "value"
+		return fhir5.ParametersParameter{Name: "property", Part: []fhir5.ParametersParameter{
+			{Name: "code", ValueCode: fhir5.StringPtr(code)},
+			value,
+		}}
+	}
+	var result []fhir5.ParametersParameter
+	switch code {
+	case "definition":
+		if c.def.Definition != nil {
+			result = append(result, property(fhir5.ParametersParameter{ValueString: c.def.Definition}))
+		}
+		return result
+	case "inactive":
+		return append(result, property(fhir5.ParametersParameter{ValueBoolean: fhir5.BoolPtr(c.inactive())}))
+	}
+	for _, p := range c.def.Property {
+		if p.Code == code {
+			result = append(result, property(fhir5.ParametersParameter{
+				ValueCode:     p.ValueCode,
+				ValueCoding:   p.ValueCoding,
+				ValueString:   p.ValueString,
+				ValueInteger:  p.ValueInteger,
+				ValueBoolean:  p.ValueBoolean,
+				ValueDateTime: p.ValueDateTime,
+				ValueDecimal:  p.ValueDecimal,
+			}))
+		}
+	}
+	if len(result) == 0 && (code == "parent" || code == "child") {
+		for _, v := range c.values(code) {
+			result = append(result, property(fhir5.ParametersParameter{ValueCode: fhir5.StringPtr(v)}))
+		}
+	}
+	return result
+}
+
+// designationParameter converts a designation into a designation parameter
+func designationParameter(d fhir5.CodeSystemConceptDesignation) fhir5.ParametersParameter {
+	p := fhir5.ParametersParameter{Name: "designation"}
+	if d.Language != nil {
+		p.Part = append(p.Part, fhir5.ParametersParameter{Name: "language", ValueCode: d.Language})
+	}
+	if d.Use != nil {
+		p.Part = append(p.Part, fhir5.ParametersParameter{Name: "use", ValueCoding: d.Use})
+	}
+	for i := range d.AdditionalUse {
+		p.Part = append(p.Part, fhir5.ParametersParameter{Name: "additionalUse", ValueCoding: &d.AdditionalUse[i]})
+	}
+	p.Part = append(p.Part, fhir5.ParametersParameter{Name: "value", ValueString: fhir5.StringPtr(d.Value)})
+	return p
+}
+
+// display returns the display of a concept, the code if it has none
+func display(c *concept) string {
+	if c.def.Display != nil {
+		return *c.def.Display
+	}
+	return c.def.Code
+}
@@ -0,0 +1,136 @@
+package terminology
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/test_utils"
+)
+
+func coding(system, code string) common.Coding {
+	return common.Coding{System: fhir5.StringPtr(system), Code: fhir5.StringPtr(code)}
+}
+
+// param returns the first parameter of the name
+func param(p *fhir5.Parameters, name string) *fhir5.ParametersParameter {
+	for i := range p.Parameter {
+		if p.Parameter[i].Name == name {
+			return &p.Parameter[i]
+		}
+	}
+	return nil
+}
+
+// propertyValues returns the properties of a $lookup result as code=value
+func propertyValues(p *fhir5.Parameters) []string {
+	var values []string
+	for _, prop := range p.Parameter {
+		if prop.Name != "property" {
+			continue
+		}
+		value := prop.Part[1]
+		var s string
+		switch {
+		case value.ValueCode != nil:
+			s = *value.ValueCode
+		case value.ValueString != nil:
+			s = *value.ValueString
+		case value.ValueBoolean != nil:
+			s = map[bool]string{true: "true", false: "false"}[*value.ValueBoolean]
+		}
+		values = append(values, *prop.Part[0].ValueCode+"="+s)
+	}
+	return values
+}
+
+func TestLookup(t *testing.T) {
+	service := newTestService(t)
+
+	result, err := service.Lookup(coding(acme, "chol-mmol"))
+	if err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+	if *param(result, "name").ValueString != "ACMECholCodesBlood" || *param(result, "version").ValueString != "5.0.0" {
+		t.Errorf("unexpected name and version in %+v", result.Parameter)
+	}
+	if *param(result, "display").ValueString != "SChol (mmol/L)" {
+		t.Errorf("unexpected display %s", *param(result, "display").ValueString)
+	}
+	designation := param(result, "designation")
+	if designation == nil || len(designation.Part) != 2 || *designation.Part[1].ValueString != "From ACME POC Testing" {
+		t.Errorf("unexpected designation %+v", designation)
+	}
+	if got := strings.Join(propertyValues(result), " "); got != "definition=Serum Cholesterol, in mmol/L inactive=false" {
+		t.Errorf("unexpected properties %q", got)
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	test_utils.CompareRoundTripSerialization(t, data, &fhir5.Parameters{}, "lookup")
+
+	result, err = service.Lookup(coding(statuses, "closed"))
+	if err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+	if got := strings.Join(propertyValues(result), " "); got != "parent=any child=archived inactive=false" {
+		t.Errorf("unexpected properties %q", got)
+	}
+	result, err = service.Lookup(coding(statuses, "archived"), "status", "child")
+	if err != nil {
+		t.Fatalf("Lookup: %v", err)
+	}
+	if got := strings.Join(propertyValues(result), " "); got != "status=retired" {
+		t.Errorf("unexpected requested properties %q", got)
+	}
+
+	for name, c := range map[string]common.Coding{
+		"unknown code":    coding(acme, "nope"),
+		"unknown system":  coding("http://example.org/unknown", "x"),
+		"unknown version": {System: fhir5.StringPtr(acme), Version: fhir5.StringPtr("1.0"), Code: fhir5.StringPtr("chol")},
+		"no code":         {System: fhir5.StringPtr(acme)},
+	} {
+		if _, err := service.Lookup(c); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestSubsumes(t *testing.T) {
+	service := newTestService(t)
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"processing", "deleted", Subsumes},
+		{"deleted", "processing", SubsumedBy},
+		{"timeout", "timeout", Equivalent},
+		{"security", "deleted", NotSubsumed},
+	}
+	for _, tt := range tests {
+		result, err := service.Subsumes(coding(issueTypes, tt.a), common.Coding{Code: fhir5.StringPtr(tt.b)})
+		if err != nil {
+			t.Errorf("%s %s: %v", tt.a, tt.b, err)
+			continue
+		}
+		if got := *param(result, "outcome").ValueCode; got != tt.want {
+			t.Errorf("%s %s: expected %s, got %s", tt.a, tt.b, tt.want, got)
+		}
+	}
+
+	// the hierarchy of the status code system comes from parent properties
+	result, err := service.Subsumes(coding(statuses, "any"), coding(statuses, "archived"))
+	if err != nil || *param(result, "outcome").ValueCode != Subsumes {
+		t.Errorf("expected any to subsume archived, got %v %v", result, err)
+	}
+
+	if _, err := service.Subsumes(coding(issueTypes, "timeout"), coding(acme, "chol")); err == nil {
+		t.Error("expected an error for codes of different systems")
+	}
+	if _, err := service.Subsumes(coding(issueTypes, "timeout"), coding(issueTypes, "nope")); err == nil {
+		t.Error("expected an error for an unknown code")
+	}
+}
@@ -0,0 +1,67 @@
+package terminology
+
+import (
+	"fmt"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Subsumption outcomes of $subsumes
+const (
+	Equivalent  = "equivalent"
+	Subsumes    = "subsumes"
+	SubsumedBy  = "subsumed-by"
+	NotSubsumed = "not-subsumed"
+)
+
+// Subsumes implements CodeSystem/$subsumes: whether codingA subsumes codingB,
+// is subsumed by it, is equivalent to it or neither. Both codings have to be
+// from the same code system; a missing system of codingB is taken from
+// codingA. Subsumption follows the hierarchy of the code system, which must
+// not declare a hierarchy meaning other than is-a.
+func (s *Service) Subsumes(codingA, codingB common.Coding) (*fhir5.Parameters, error) {
+	if codingB.System == nil {
+		codingB.System = codingA.System
+		codingB.Version = codingA.Version
+	}
+	if deref(codingA.System) != deref(codingB.System) {
+		return nil, fmt.Errorf("terminology: cannot test subsumption across code systems %s and %s", deref(codingA.System), deref(codingB.System))
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cs, a, err := s.concept(codingA)
+	if err != nil {
+		return nil, err
+	}
+	if meaning := cs.resource.HierarchyMeaning; meaning != nil && *meaning != fhir5.CodeSystemHierarchyMeaningIsA {
+		return nil, fmt.Errorf("terminology: the hierarchy of code system %s means %s, not is-a", cs.url, *meaning)
+	}
+	_, b, err := s.concept(codingB)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := NotSubsumed
+	switch {
+	case a == b:
+		outcome = Equivalent
+	case includes(a.descendants(), b):
+		outcome = Subsumes
+	case includes(b.descendants(), a):
+		outcome = SubsumedBy
+	}
+	return &fhir5.Parameters{
+		ResourceType: "Parameters",
+		Parameter:    []fhir5.ParametersParameter{{Name: "outcome", ValueCode: fhir5.StringPtr(outcome)}},
+	}, nil
+}
+
+func includes(concepts []*concept, c *concept) bool {
+	for _, other := range concepts {
+		if other == c {
+			return true
+		}
+	}
+	return false
+}
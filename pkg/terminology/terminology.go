@@ -0,0 +1,102 @@
+// Package terminology implements terminology operations of FHIR R5 against
+// CodeSystem and ValueSet resources held in memory.
+//
+//	service := terminology.NewService()
+//	service.AddCodeSystem(issueTypes)
+//	expanded, err := service.ExpandValueSet(valueSet, terminology.ExpandParameters{Filter: "struct"})
+//	lookup, err := service.Lookup(coding)
+//
+// $lookup, $validate-code and $subsumes return their results as Parameters
+// with the output parameters of the operations.
+//
+// Code systems and value sets are looked up by their canonical URL, optionally
+// followed by |version. Without a version the one added last is used.
+package terminology
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Service holds code systems, value sets and concept maps and runs
+// terminology operations on them. It is safe for concurrent use.
+type Service struct {
+	mu          sync.RWMutex
+	codeSystems map[string]*codeSystem
+	valueSets   map[string]*fhir5.ValueSet
+	conceptMaps map[string]*fhir5.ConceptMap
+}
+
+// NewService returns a service without code systems, value sets and concept maps
+func NewService() *Service {
+	return &Service{
+		codeSystems: map[string]*codeSystem{},
+		valueSets:   map[string]*fhir5.ValueSet{},
+		conceptMaps: map[string]*fhir5.ConceptMap{},
+	}
+}
+
+// AddCodeSystem makes a code system available by its URL
+func (s *Service) AddCodeSystem(cs *fhir5.CodeSystem) error {
+	if cs.URL == nil {
+		return errors.New("terminology: code system has no url")
+	}
+	indexed := newCodeSystem(cs)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codeSystems[indexed.url] = indexed
+	if indexed.version != "" {
+		s.codeSystems[indexed.url+"|"+indexed.version] = indexed
+	}
+	return nil
+}
+
+// AddValueSet makes a value set available by its URL, e.g. for imports by
+// other value sets
+func (s *Service) AddValueSet(vs *fhir5.ValueSet) error {
+	if vs.Url == nil {
+		return errors.New("terminology: value set has no url")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.valueSets[*vs.Url] = vs
+	if vs.Version != nil {
+		s.valueSets[*vs.Url+"|"+*vs.Version] = vs
+	}
+	return nil
+}
+
+// codeSystem returns a code system by URL and optional version
+func (s *Service) codeSystem(url, version string) (*codeSystem, error) {
+	key := url
+	if version != "" {
+		key += "|" + version
+	}
+	cs, ok := s.codeSystems[key]
+	if !ok {
+		return nil, fmt.Errorf("terminology: code system %s is not available", key)
+	}
+	return cs, nil
+}
+
+// valueSet returns a value set by its canonical, which may include a version
+func (s *Service) valueSet(canonical string) (*fhir5.ValueSet, error) {
+	vs, ok := s.valueSets[canonical]
+	if !ok {
+		return nil, fmt.Errorf("terminology: value set %s is not available", canonical)
+	}
+	return vs, nil
+}
+
+// newUUID returns a random version 4 UUID
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
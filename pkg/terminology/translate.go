@@ -0,0 +1,415 @@
+package terminology
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// TranslateParameters are the parameters of $translate. Source codings are
+// translated forward along the groups of the map, target codings in reverse
+// to the source codes that map to them.
+type TranslateParameters struct {
+	// Source are the codings to translate, e.g. the codings of a sourceCodeableConcept
+	Source []common.Coding
+
+	// Target are the codings to find the source codes for (reverse translation)
+	Target []common.Coding
+
+	// TargetSystem restricts the result to codes of this system
+	TargetSystem string
+
+	// Dependencies are the values of the attributes that dependsOn conditions
+	// refer to; in a reverse translation they are matched against product
+	Dependencies []fhir5.ConceptMapGroupElementTargetDependsOn
+}
+
+// AddConceptMap makes a concept map available by its URL
+func (s *Service) AddConceptMap(cm *fhir5.ConceptMap) error {
+	if cm.URL == nil {
+		return errors.New("terminology: concept map has no url")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.conceptMaps[*cm.URL] = cm
+	if cm.Version != nil {
+		s.conceptMaps[*cm.URL+"|"+*cm.Version] = cm
+	}
+	return nil
+}
+
+// Translate implements ConceptMap/$translate with a concept map added to the
+// service. The result is true if there is a match whose relationship is not
+// not-related-to. Source codes a group does not map are handled by its
+// unmapped mode: use-source-code, fixed or other-map.
+func (s *Service) Translate(canonical string, params TranslateParameters) (*fhir5.Parameters, error) {
+	if len(params.Source) == 0 && len(params.Target) == 0 {
+		return nil, errors.New("terminology: $translate needs a source or a target coding")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	t := &translator{service: s, params: params, visiting: map[string]bool{}}
+	var matches []match
+	for _, c := range params.Source {
+		found, err := t.forward(canonical, c)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, found...)
+	}
+	for _, c := range params.Target {
+		found, err := t.reverse(canonical, c)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, found...)
+	}
+
+	result := false
+	for _, m := range matches {
+		if m.relationship != fhir5.ConceptMapRelationshipNotRelatedTo {
+			result = true
+		}
+	}
+	out := &fhir5.Parameters{ResourceType: "Parameters"}
+	out.Parameter = append(out.Parameter, fhir5.ParametersParameter{Name: "result", ValueBoolean: fhir5.BoolPtr(result)})
+	if !result {
+		out.Parameter = append(out.Parameter, fhir5.ParametersParameter{Name: "message", ValueString: fhir5.StringPtr(fmt.Sprintf("No mappings found in %s", canonical))})
+	}
+	for _, m := range matches {
+		out.Parameter = append(out.Parameter, m.parameter())
+	}
+	return out, nil
+}
+
+// match is a single translation
+type match struct {
+	relationship fhir5.ConceptMapRelationship
+	concept      common.Coding
+	product      []fhir5.ConceptMapGroupElementTargetDependsOn
+	property     []fhir5.ConceptMapGroupElementTargetProperty
+	originMap    string
+}
+
+// translator translates codings, following other-map references
+type translator struct {
+	service  *Service
+	params   TranslateParameters
+	visiting map[string]bool
+}
+
+func (t *translator) conceptMap(canonical string) (*fhir5.ConceptMap, error) {
+	cm, ok := t.service.conceptMaps[canonical]
+	if !ok {
+		return nil, fmt.Errorf("terminology: concept map %s is not available", canonical)
+	}
+	return cm, nil
+}
+
+// forward translates a source coding with the groups of the map whose source
+// is the system of the coding
+func (t *translator) forward(canonical string, source common.Coding) ([]match, error) {
+	if t.visiting[canonical] {
+		return nil, fmt.Errorf("terminology: concept map %s refers to itself", canonical)
+	}
+	t.visiting[canonical] = true
+	defer delete(t.visiting, canonical)
+	cm, err := t.conceptMap(canonical)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []match
+	for i := range cm.Group {
+		g := &cm.Group[i]
+		if !sameSystem(g.Source, source.System) || !t.wanted(g.Target) {
+			continue
+		}
+		mapped := false
+		for j := range g.Element {
+			el := &g.Element[j]
+			in, err := t.elementCovers(el, source)
+			if err != nil {
+				return nil, err
+			}
+			if !in {
+				continue
+			}
+			mapped = true
+			if el.NoMap != nil && *el.NoMap {
+				continue
+			}
+			for k := range el.Target {
+				target := &el.Target[k]
+				if target.Code == nil {
+					continue
+				}
+				ok, err := t.satisfied(target.DependsOn)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+				matches = append(matches, match{
+					relationship: target.Relationship,
+					concept:      common.Coding{System: systemOf(g.Target), Code: target.Code, Display: target.Display},
+					product:      target.Product,
+					property:     target.Property,
+					originMap:    canonicalOf(cm),
+				})
+			}
+		}
+		if !mapped && g.Unmapped != nil {
+			found, err := t.unmapped(cm, g, source)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, found...)
+		}
+	}
+	return matches, nil
+}
+
+// elementCovers reports whether a source element applies to the coding, by its
+// code or by the value set of source codes it stands for
+func (t *translator) elementCovers(el *fhir5.ConceptMapGroupElement, source common.Coding) (bool, error) {
+	if el.Code != nil {
+		return *el.Code == deref(source.Code), nil
+	}
+	if el.ValueSet != nil {
+		return t.inValueSet(*el.ValueSet, source)
+	}
+	return false, nil
+}
+
+// unmapped applies the unmapped mode of a group to a source code the group
+// does not map
+func (t *translator) unmapped(cm *fhir5.ConceptMap, g *fhir5.ConceptMapGroup, source common.Coding) ([]match, error) {
+	u := g.Unmapped
+	if u.ValueSet != nil {
+		in, err := t.inValueSet(*u.ValueSet, source)
+		if !in || err != nil {
+			return nil, err
+		}
+	}
+	relationship := fhir5.ConceptMapRelationshipRelatedTo
+	if u.Relationship != nil {
+		relationship = *u.Relationship
+	}
+	switch u.Mode {
+	case fhir5.ConceptMapUnmappedModeUseSourceCode:
+		return []match{{
+			relationship: relationship,
+			concept:      common.Coding{System: source.System, Code: source.Code, Display: source.Display},
+			originMap:    canonicalOf(cm),
+		}}, nil
+	case fhir5.ConceptMapUnmappedModeFixed:
+		if u.Code == nil {
+			return nil, fmt.Errorf("terminology: fixed unmapped mode of %s has no code", canonicalOf(cm))
+		}
+		return []match{{
+			relationship: relationship,
+			concept:      common.Coding{System: systemOf(g.Target), Code: u.Code, Display: u.Display},
+			originMap:    canonicalOf(cm),
+		}}, nil
+	case fhir5.ConceptMapUnmappedModeOtherMap:
+		if u.OtherMap == nil {
+			return nil, fmt.Errorf("terminology: other-map unmapped mode of %s has no map", canonicalOf(cm))
+		}
+		return t.forward(*u.OtherMap, source)
+	}
+	return nil, fmt.Errorf("terminology: unmapped mode %s is not supported", u.Mode)
+}
+
+// reverse finds the source codes that map to a target coding. The
+// relationships are inverted and product takes the place of dependsOn.
+func (t *translator) reverse(canonical string, target common.Coding) ([]match, error) {
+	cm, err := t.conceptMap(canonical)
+	if err != nil {
+		return nil, err
+	}
+	var matches []match
+	for i := range cm.Group {
+		g := &cm.Group[i]
+		if !sameSystem(g.Target, target.System) || !t.wanted(g.Source) {
+			continue
+		}
+		for j := range g.Element {
+			el := &g.Element[j]
+			if el.Code == nil {
+				continue
+			}
+			for k := range el.Target {
+				tg := &el.Target[k]
+				if deref(tg.Code) != deref(target.Code) {
+					continue
+				}
+				ok, err := t.satisfied(tg.Product)
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					continue
+				}
+				matches = append(matches, match{
+					relationship: invert(tg.Relationship),
+					concept:      common.Coding{System: systemOf(g.Source), Code: el.Code, Display: el.Display},
+					product:      tg.DependsOn,
+					property:     tg.Property,
+					originMap:    canonicalOf(cm),
+				})
+			}
+		}
+	}
+	return matches, nil
+}
+
+// wanted reports whether codes of the system are requested
+func (t *translator) wanted(system *string) bool {
+	return t.params.TargetSystem == "" || sameSystem(system, &t.params.TargetSystem)
+}
+
+// satisfied reports whether the dependencies of the request meet every
+// condition. A condition whose attribute is not given is not met.
+func (t *translator) satisfied(conditions []fhir5.ConceptMapGroupElementTargetDependsOn) (bool, error) {
+	for _, cond := range conditions {
+		met := false
+		for _, dep := range t.params.Dependencies {
+			if dep.Attribute != cond.Attribute {
+				continue
+			}
+			ok, err := t.sameValue(cond, dep)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				met = true
+				break
+			}
+		}
+		if !met {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// sameValue compares the value of a condition with the value of a dependency
+func (t *translator) sameValue(cond, dep fhir5.ConceptMapGroupElementTargetDependsOn) (bool, error) {
+	depCode := dep.ValueCode
+	if dep.ValueCoding != nil {
+		depCode = dep.ValueCoding.Code
+	}
+	switch {
+	case cond.ValueSet != nil:
+		coding := common.Coding{Code: depCode}
+		if dep.ValueCoding != nil {
+			coding = *dep.ValueCoding
+		}
+		return t.inValueSet(*cond.ValueSet, coding)
+	case cond.ValueCoding != nil:
+		if dep.ValueCoding != nil && dep.ValueCoding.System != nil && cond.ValueCoding.System != nil &&
+			*dep.ValueCoding.System != *cond.ValueCoding.System {
+			return false, nil
+		}
+		return deref(depCode) == deref(cond.ValueCoding.Code), nil
+	case cond.ValueCode != nil:
+		return deref(depCode) == *cond.ValueCode, nil
+	case cond.ValueString != nil:
+		return dep.ValueString != nil && *dep.ValueString == *cond.ValueString, nil
+	case cond.ValueBoolean != nil:
+		return dep.ValueBoolean != nil && *dep.ValueBoolean == *cond.ValueBoolean, nil
+	case cond.ValueQuantity != nil:
+		q, other := cond.ValueQuantity, dep.ValueQuantity
+		if other == nil || q.Value == nil || other.Value == nil || q.Value.Cmp(*other.Value) != 0 {
+			return false, nil
+		}
+		return deref(q.Code) == deref(other.Code) && deref(q.System) == deref(other.System), nil
+	}
+	return false, nil
+}
+
+// inValueSet reports whether a coding is in the expansion of a value set
+func (t *translator) inValueSet(canonical string, coding common.Coding) (bool, error) {
+	vs, err := t.service.valueSet(canonical)
+	if err != nil {
+		return false, err
+	}
+	x := &expander{service: t.service, visiting: map[string]bool{}, used: map[string]bool{}}
+	set, err := x.valueSet(vs)
+	if err != nil {
+		return false, err
+	}
+	return set.find(coding) != nil, nil
+}
+
+// parameter converts a match into a match parameter
+func (m match) parameter() fhir5.ParametersParameter {
+	concept := m.concept
+	p := fhir5.ParametersParameter{Name: "match", Part: []fhir5.ParametersParameter{
+		{Name: "relationship", ValueCode: fhir5.StringPtr(string(m.relationship))},
+		{Name: "concept", ValueCoding: &concept},
+	}}
+	for _, prop := range m.property {
+		p.Part = append(p.Part, fhir5.ParametersParameter{Name: "property", Part: []fhir5.ParametersParameter{
+			{Name: "code", ValueCode: fhir5.StringPtr(prop.Code)},
+			{Name: "value", ValueCoding: prop.ValueCoding, ValueString: prop.ValueString, ValueInteger: prop.ValueInteger,
+				ValueBoolean: prop.ValueBoolean, ValueDateTime: prop.ValueDateTime, ValueDecimal: prop.ValueDecimal, ValueCode: prop.ValueCode},
+		}})
+	}
+	for _, prod := range m.product {
+		p.Part = append(p.Part, fhir5.ParametersParameter{Name: "product", Part: []fhir5.ParametersParameter{
+			{Name: "attribute", ValueUri: fhir5.StringPtr(prod.Attribute)},
+			{Name: "value", ValueCode: prod.ValueCode, ValueCoding: prod.ValueCoding, ValueString: prod.ValueString,
+				ValueBoolean: prod.ValueBoolean, ValueQuantity: prod.ValueQuantity},
+		}})
+	}
+	if m.originMap != "" {
+		p.Part = append(p.Part, fhir5.ParametersParameter{Name: "originMap", ValueCanonical: fhir5.StringPtr(m.originMap)})
+	}
+	return p
+}
+
+// invert returns the relationship of a mapping read from target to source
+func invert(r fhir5.ConceptMapRelationship) fhir5.ConceptMapRelationship {
+	switch r {
+	case fhir5.ConceptMapRelationshipSourceIsNarrowerThanTarget:
+		return fhir5.ConceptMapRelationshipSourceIsBroaderThanTarget
+	case fhir5.ConceptMapRelationshipSourceIsBroaderThanTarget:
+		return fhir5.ConceptMapRelationshipSourceIsNarrowerThanTarget
+	}
+	return r
+}
+
+// sameSystem compares the system of a group, which may carry a version, with
+// the system of a coding. A group without a system covers any system.
+func sameSystem(group, system *string) bool {
+	if group == nil {
+		return true
+	}
+	if system == nil {
+		return false
+	}
+	url, _, _ := strings.Cut(*group, "|")
+	return url == *system
+}
+
+// systemOf returns the system of a group without its version
+func systemOf(group *string) *string {
+	if group == nil {
+		return nil
+	}
+	url, _, _ := strings.Cut(*group, "|")
+	return fhir5.StringPtr(url)
+}
+
+func canonicalOf(cm *fhir5.ConceptMap) string {
+	if cm.Version != nil {
+		return deref(cm.URL) + "|" + *cm.Version
+	}
+	return deref(cm.URL)
+}
@@ -0,0 +1,237 @@
+package terminology
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+const (
+	labCodes = "http://example.org/fhir/CodeSystem/lab"
+	loinc    = "http://loinc.org"
+	labMap   = "http://example.org/fhir/ConceptMap/lab-to-loinc"
+)
+
+func loadConceptMap(t *testing.T, file string) *fhir5.ConceptMap {
+	t.Helper()
+	data, err := os.ReadFile("../fhir5/testdata/fhir5-json/" + file)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", file, err)
+	}
+	var cm fhir5.ConceptMap
+	if err := json.Unmarshal(data, &cm); err != nil {
+		t.Fatalf("failed to unmarshal %s: %v", file, err)
+	}
+	return &cm
+}
+
+// labConceptMap maps local lab codes to LOINC. Glucose depends on the
+// specimen, unmapped codes are kept as they are.
+func labConceptMap() *fhir5.ConceptMap {
+	specimen := func(code string) []fhir5.ConceptMapGroupElementTargetDependsOn {
+		return []fhir5.ConceptMapGroupElementTargetDependsOn{{Attribute: "specimen", ValueCode: fhir5.StringPtr(code)}}
+	}
+	return &fhir5.ConceptMap{
+		ResourceType: "ConceptMap",
+		URL:          fhir5.StringPtr(labMap),
+		Status:       fhir5.PublicationStatusActive,
+		Group: []fhir5.ConceptMapGroup{{
+			Source: fhir5.StringPtr(labCodes),
+			Target: fhir5.StringPtr(loinc + "|2.76"),
+			Element: []fhir5.ConceptMapGroupElement{
+				{Code: fhir5.StringPtr("CHOL"), Target: []fhir5.ConceptMapGroupElementTarget{
+					{Code: fhir5.StringPtr("2093-3"), Display: fhir5.StringPtr("Cholesterol [Mass/volume] in Serum or Plasma"),
+						Relationship: fhir5.ConceptMapRelationshipEquivalent},
+				}},
+				{Code: fhir5.StringPtr("GLU"), Target: []fhir5.ConceptMapGroupElementTarget{
+					{Code: fhir5.StringPtr("2345-7"), Relationship: fhir5.ConceptMapRelationshipEquivalent, DependsOn: specimen("serum")},
+					{Code: fhir5.StringPtr("2339-0"), Relationship: fhir5.ConceptMapRelationshipEquivalent, DependsOn: specimen("blood")},
+				}},
+				{Code: fhir5.StringPtr("LIPID"), Target: []fhir5.ConceptMapGroupElementTarget{
+					{Code: fhir5.StringPtr("2093-3"), Relationship: fhir5.ConceptMapRelationshipSourceIsBroaderThanTarget},
+					{Code: fhir5.StringPtr("2571-8"), Relationship: fhir5.ConceptMapRelationshipSourceIsBroaderThanTarget},
+				}},
+				{Code: fhir5.StringPtr("CALC"), NoMap: fhir5.BoolPtr(true)},
+			},
+			Unmapped: &fhir5.ConceptMapGroupUnmapped{Mode: fhir5.ConceptMapUnmappedModeUseSourceCode},
+		}},
+	}
+}
+
+// matches returns the matches of a $translate result as relationship:system|code
+func matches(p *fhir5.Parameters) []string {
+	var result []string
+	for _, m := range p.Parameter {
+		if m.Name != "match" {
+			continue
+		}
+		concept := m.Part[1].ValueCoding
+		result = append(result, *m.Part[0].ValueCode+":"+deref(concept.System)+"|"+*concept.Code)
+	}
+	return result
+}
+
+func newTranslateService(t *testing.T) *Service {
+	t.Helper()
+	service := NewService()
+	for _, cm := range []*fhir5.ConceptMap{
+		labConceptMap(),
+		loadConceptMap(t, "conceptmap-example.json"),
+		loadConceptMap(t, "conceptmap-example-2.json"),
+		{
+			URL: fhir5.StringPtr("http://example.org/fhir/ConceptMap/map2"),
+			Group: []fhir5.ConceptMapGroup{{
+				Source: fhir5.StringPtr("http://example.org/fhir/example1"),
+				Target: fhir5.StringPtr("http://example.org/fhir/example3"),
+				Element: []fhir5.ConceptMapGroupElement{{Code: fhir5.StringPtr("other"), Target: []fhir5.ConceptMapGroupElementTarget{
+					{Code: fhir5.StringPtr("other3"), Relationship: fhir5.ConceptMapRelationshipRelatedTo},
+				}}},
+			}},
+		},
+	} {
+		if err := service.AddConceptMap(cm); err != nil {
+			t.Fatalf("AddConceptMap: %v", err)
+		}
+	}
+	return service
+}
+
+func TestTranslate(t *testing.T) {
+	service := newTranslateService(t)
+	specimen := func(code string) []fhir5.ConceptMapGroupElementTargetDependsOn {
+		return []fhir5.ConceptMapGroupElementTargetDependsOn{{Attribute: "specimen", ValueCode: fhir5.StringPtr(code)}}
+	}
+	addressUse := "http://hl7.org/fhir/address-use"
+	v3 := "http://terminology.hl7.org/CodeSystem/v3-AddressUse"
+	tests := []struct {
+		name       string
+		conceptMap string
+		params     TranslateParameters
+		result     bool
+		want       string
+	}{
+		{"equivalent", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "CHOL")}},
+			true, "equivalent:http://loinc.org|2093-3"},
+		{"depends on serum", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "GLU")}, Dependencies: specimen("serum")},
+			true, "equivalent:http://loinc.org|2345-7"},
+		{"depends on blood", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "GLU")}, Dependencies: specimen("blood")},
+			true, "equivalent:http://loinc.org|2339-0"},
+		{"dependency missing", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "GLU")}},
+			false, ""},
+		{"broader", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "LIPID")}},
+			true, "source-is-broader-than-target:http://loinc.org|2093-3 source-is-broader-than-target:http://loinc.org|2571-8"},
+		{"no map", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "CALC")}},
+			false, ""},
+		{"use source code", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "NA")}},
+			true, "related-to:" + labCodes + "|NA"},
+		{"other system", labMap, TranslateParameters{Source: []common.Coding{coding("http://example.org/other", "CHOL")}},
+			false, ""},
+		{"target system", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "CHOL")}, TargetSystem: "http://snomed.info/sct"},
+			false, ""},
+		{"codeable concept", labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "CALC"), coding(labCodes, "CHOL")}},
+			true, "equivalent:http://loinc.org|2093-3"},
+		{"reverse", labMap, TranslateParameters{Target: []common.Coding{coding(loinc, "2093-3")}},
+			true, "equivalent:" + labCodes + "|CHOL source-is-narrower-than-target:" + labCodes + "|LIPID"},
+		{"reverse product", labMap, TranslateParameters{Target: []common.Coding{coding(loinc, "2345-7")}},
+			true, "equivalent:" + labCodes + "|GLU"},
+		{"not related", "http://hl7.org/fhir/ConceptMap/101", TranslateParameters{Source: []common.Coding{coding(addressUse, "old")}},
+			false, "not-related-to:" + v3 + "|BAD"},
+		{"fixed", "http://hl7.org/fhir/ConceptMap/101", TranslateParameters{Source: []common.Coding{coding(addressUse, "billing")}},
+			true, "related-to:" + v3 + "|temp"},
+		{"coded dependency", "http://hl7.org/fhir/ConceptMap/example2", TranslateParameters{
+			Source: []common.Coding{coding("http://example.org/fhir/example1", "code")},
+			Dependencies: []fhir5.ConceptMapGroupElementTargetDependsOn{{Attribute: "ex3", ValueCoding: &common.Coding{
+				System: fhir5.StringPtr("http://example.org/fhir/example3"), Code: fhir5.StringPtr("some-code"),
+			}}},
+		}, true, "equivalent:http://example.org/fhir/example2|code2"},
+		{"other map", "http://hl7.org/fhir/ConceptMap/example2", TranslateParameters{Source: []common.Coding{coding("http://example.org/fhir/example1", "other")}},
+			true, "related-to:http://example.org/fhir/example3|other3"},
+	}
+	for _, tt := range tests {
+		result, err := service.Translate(tt.conceptMap, tt.params)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := *param(result, "result").ValueBoolean; got != tt.result {
+			t.Errorf("%s: expected result %v, got %v", tt.name, tt.result, got)
+		}
+		if got := strings.Join(matches(result), " "); got != tt.want {
+			t.Errorf("%s: expected matches %q, got %q", tt.name, tt.want, got)
+		}
+	}
+
+	result, err := service.Translate(labMap, TranslateParameters{Source: []common.Coding{coding(labCodes, "CHOL")}})
+	if err != nil {
+		t.Fatalf("Translate: %v", err)
+	}
+	origin := param(result, "match").Part[2]
+	if origin.Name != "originMap" || *origin.ValueCanonical != labMap {
+		t.Errorf("expected the origin map, got %+v", origin)
+	}
+}
+
+func TestTranslate_Errors(t *testing.T) {
+	service := newTranslateService(t)
+	cyclic := &fhir5.ConceptMap{
+		URL: fhir5.StringPtr("http://example.org/fhir/ConceptMap/cyclic"),
+		Group: []fhir5.ConceptMapGroup{{
+			Source:   fhir5.StringPtr(labCodes),
+			Unmapped: &fhir5.ConceptMapGroupUnmapped{Mode: fhir5.ConceptMapUnmappedModeOtherMap, OtherMap: fhir5.StringPtr("http://example.org/fhir/ConceptMap/cyclic")},
+		}},
+	}
+	if err := service.AddConceptMap(cyclic); err != nil {
+		t.Fatalf("AddConceptMap: %v", err)
+	}
+	source := TranslateParameters{Source: []common.Coding{coding(labCodes, "X")}}
+	if _, err := service.Translate(*cyclic.URL, source); err == nil {
+		t.Error("expected an error for a map that refers to itself")
+	}
+	if _, err := service.Translate("http://example.org/missing", source); err == nil {
+		t.Error("expected an error for an unknown map")
+	}
+	if _, err := service.Translate(labMap, TranslateParameters{}); err == nil {
+		t.Error("expected an error without codings")
+	}
+}
+
+func TestTranslate_ValueSets(t *testing.T) {
+	service := newTestService(t)
+	security := valueSet("http://example.org/fhir/ValueSet/security", []fhir5.ValueSetComposeInclude{
+		filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "is-a", Value: "security"}),
+	})
+	if err := service.AddValueSet(security); err != nil {
+		t.Fatalf("AddValueSet: %v", err)
+	}
+	cm := &fhir5.ConceptMap{
+		URL: fhir5.StringPtr("http://example.org/fhir/ConceptMap/issues"),
+		Group: []fhir5.ConceptMapGroup{{
+			Source: fhir5.StringPtr(issueTypes),
+			Target: fhir5.StringPtr("http://example.org/fhir/CodeSystem/http-status"),
+			Element: []fhir5.ConceptMapGroupElement{{ValueSet: security.Url, Target: []fhir5.ConceptMapGroupElementTarget{
+				{Code: fhir5.StringPtr("403"), Relationship: fhir5.ConceptMapRelationshipSourceIsNarrowerThanTarget},
+			}}},
+			Unmapped: &fhir5.ConceptMapGroupUnmapped{Mode: fhir5.ConceptMapUnmappedModeFixed, Code: fhir5.StringPtr("500")},
+		}},
+	}
+	if err := service.AddConceptMap(cm); err != nil {
+		t.Fatalf("AddConceptMap: %v", err)
+	}
+	for code, want := range map[string]string{
+		"expired": "source-is-narrower-than-target:http://example.org/fhir/CodeSystem/http-status|403",
+		"timeout": "related-to:http://example.org/fhir/CodeSystem/http-status|500",
+	} {
+		result, err := service.Translate(*cm.URL, TranslateParameters{Source: []common.Coding{coding(issueTypes, code)}})
+		if err != nil {
+			t.Errorf("%s: %v", code, err)
+			continue
+		}
+		if got := strings.Join(matches(result), " "); got != want {
+			t.Errorf("%s: expected %q, got %q", code, want, got)
+		}
+	}
+}
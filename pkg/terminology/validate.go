@@ -0,0 +1,152 @@
+package terminology
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// ValidateCode implements CodeSystem/$validate-code: whether the code is in
+// its code system and, if the coding has a display, whether the display is
+// the display or a designation of the concept. An unknown code system is an
+// error; an unknown code or a wrong display is reported with result false.
+func (s *Service) ValidateCode(coding common.Coding) (*fhir5.Parameters, error) {
+	if coding.System == nil || coding.Code == nil {
+		return nil, fmt.Errorf("terminology: coding needs a system and a code")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	cs, err := s.codeSystem(*coding.System, deref(coding.Version))
+	if err != nil {
+		return nil, err
+	}
+	c := cs.find(*coding.Code)
+	if c == nil {
+		return validation{message: fmt.Sprintf("Unknown code '%s' in the CodeSystem '%s'", *coding.Code, cs.url)}.parameters(), nil
+	}
+	return validate(coding, newEntry(cs, c)).parameters(), nil
+}
+
+// ValidateValueSetCode implements ValueSet/$validate-code for a coding or the
+// codings of a CodeableConcept: whether one of the codings is in the
+// expansion of the value set and has a valid display. A coding without a
+// system matches a code that occurs only once in the value set.
+func (s *Service) ValidateValueSetCode(canonical string, codings ...common.Coding) (*fhir5.Parameters, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	vs, err := s.valueSet(canonical)
+	if err != nil {
+		return nil, err
+	}
+	x := &expander{service: s, visiting: map[string]bool{}, used: map[string]bool{}}
+	set, err := x.valueSet(vs)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		first    *validation
+		messages []string
+	)
+	for _, coding := range codings {
+		e := set.find(coding)
+		if e == nil {
+			messages = append(messages, fmt.Sprintf("The provided code '%s|%s' is not in the value set '%s'", deref(coding.System), deref(coding.Code), canonical))
+			continue
+		}
+		v := validate(coding, e)
+		if v.result {
+			return v.parameters(), nil
+		}
+		if first == nil {
+			first = &v
+		}
+		messages = append(messages, v.message)
+	}
+	if first == nil {
+		first = &validation{}
+	}
+	first.message = strings.Join(messages, "; ")
+	return first.parameters(), nil
+}
+
+// find returns the entry of a coding. Without a system the code has to be
+// unique in the set.
+func (s *codeSet) find(coding common.Coding) *entry {
+	code := deref(coding.Code)
+	if coding.System != nil {
+		if i, ok := s.index[*coding.System+"|"+code]; ok {
+			return s.entries[i]
+		}
+		return nil
+	}
+	var found *entry
+	for _, e := range s.entries {
+		if e.code == code {
+			if found != nil {
+				return nil
+			}
+			found = e
+		}
+	}
+	return found
+}
+
+// validation is the outcome of $validate-code
+type validation struct {
+	result   bool
+	message  string
+	entry    *entry
+	inactive bool
+}
+
+// validate checks the display of a coding against a code that was found
+func validate(coding common.Coding, e *entry) validation {
+	v := validation{result: true, entry: e, inactive: e.inactive}
+	if coding.Display != nil && !e.hasDisplay(*coding.Display) {
+		v.result = false
+		v.message = fmt.Sprintf("Wrong Display Name '%s' for %s#%s. Valid display is '%s'", *coding.Display, e.system, e.code, deref(e.display))
+	}
+	return v
+}
+
+// hasDisplay reports whether the display or a designation of the entry equals
+// text, ignoring case and repeated whitespace
+func (e *entry) hasDisplay(text string) bool {
+	text = strings.Join(strings.Fields(text), " ")
+	if e.display != nil && strings.EqualFold(strings.Join(strings.Fields(*e.display), " "), text) {
+		return true
+	}
+	for _, d := range e.designations {
+		if strings.EqualFold(strings.Join(strings.Fields(d.Value), " "), text) {
+			return true
+		}
+	}
+	return false
+}
+
+// parameters returns the output parameters of $validate-code
+func (v validation) parameters() *fhir5.Parameters {
+	result := &fhir5.Parameters{ResourceType: "Parameters"}
+	result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "result", ValueBoolean: fhir5.BoolPtr(v.result)})
+	if v.message != "" {
+		result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "message", ValueString: fhir5.StringPtr(v.message)})
+	}
+	if e := v.entry; e != nil {
+		if e.display != nil {
+			result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "display", ValueString: e.display})
+		}
+		result.Parameter = append(result.Parameter,
+			fhir5.ParametersParameter{Name: "code", ValueCode: fhir5.StringPtr(e.code)},
+			fhir5.ParametersParameter{Name: "system", ValueUri: fhir5.StringPtr(e.system)})
+		if e.version != "" {
+			result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "version", ValueString: fhir5.StringPtr(e.version)})
+		}
+	}
+	if v.inactive {
+		result.Parameter = append(result.Parameter, fhir5.ParametersParameter{Name: "inactive", ValueBoolean: fhir5.BoolPtr(true)})
+	}
+	return result
+}
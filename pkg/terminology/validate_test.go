@@ -0,0 +1,92 @@
+package terminology
+
+import (
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+func withDisplay(c common.Coding, display string) common.Coding {
+	c.Display = fhir5.StringPtr(display)
+	return c
+}
+
+func TestValidateCode(t *testing.T) {
+	service := newTestService(t)
+	tests := []struct {
+		name     string
+		coding   common.Coding
+		result   bool
+		message  bool
+		inactive bool
+	}{
+		{"valid", coding(issueTypes, "timeout"), true, false, false},
+		{"valid display", withDisplay(coding(issueTypes, "timeout"), "timeout"), true, false, false},
+		{"designation", withDisplay(coding(statuses, "closed"), "Geschlossen"), true, false, false},
+		{"wrong display", withDisplay(coding(issueTypes, "timeout"), "Too slow"), false, true, false},
+		{"unknown code", coding(issueTypes, "nope"), false, true, false},
+		{"inactive", coding(statuses, "archived"), true, false, true},
+	}
+	for _, tt := range tests {
+		result, err := service.ValidateCode(tt.coding)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := *param(result, "result").ValueBoolean; got != tt.result {
+			t.Errorf("%s: expected result %v, got %v", tt.name, tt.result, got)
+		}
+		if (param(result, "message") != nil) != tt.message {
+			t.Errorf("%s: unexpected message %+v", tt.name, param(result, "message"))
+		}
+		if (param(result, "inactive") != nil) != tt.inactive {
+			t.Errorf("%s: unexpected inactive %+v", tt.name, param(result, "inactive"))
+		}
+	}
+
+	result, _ := service.ValidateCode(withDisplay(coding(issueTypes, "timeout"), "Too slow"))
+	if *param(result, "display").ValueString != "Timeout" || *param(result, "system").ValueUri != issueTypes {
+		t.Errorf("expected the valid display and the system, got %+v", result.Parameter)
+	}
+	if _, err := service.ValidateCode(coding("http://example.org/unknown", "x")); err == nil {
+		t.Error("expected an error for an unknown code system")
+	}
+}
+
+func TestValidateValueSetCode(t *testing.T) {
+	service := newTestService(t)
+	security := valueSet("http://example.org/fhir/ValueSet/security", []fhir5.ValueSetComposeInclude{
+		filtered(issueTypes, fhir5.ValueSetComposeIncludeFilter{Property: "concept", Op: "is-a", Value: "security"}),
+	})
+	if err := service.AddValueSet(security); err != nil {
+		t.Fatalf("AddValueSet: %v", err)
+	}
+	tests := []struct {
+		name   string
+		codes  []common.Coding
+		result bool
+	}{
+		{"member", []common.Coding{coding(issueTypes, "login")}, true},
+		{"not a member", []common.Coding{coding(issueTypes, "timeout")}, false},
+		{"inferred system", []common.Coding{{Code: fhir5.StringPtr("expired")}}, true},
+		{"wrong display", []common.Coding{withDisplay(coding(issueTypes, "login"), "Logout")}, false},
+		{"codeable concept", []common.Coding{coding(issueTypes, "timeout"), coding(issueTypes, "forbidden")}, true},
+	}
+	for _, tt := range tests {
+		result, err := service.ValidateValueSetCode(*security.Url, tt.codes...)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := *param(result, "result").ValueBoolean; got != tt.result {
+			t.Errorf("%s: expected result %v, got %v", tt.name, tt.result, got)
+		}
+		if !tt.result && param(result, "message") == nil {
+			t.Errorf("%s: expected a message", tt.name)
+		}
+	}
+	if _, err := service.ValidateValueSetCode("http://example.org/missing", coding(issueTypes, "login")); err == nil {
+		t.Error("expected an error for an unknown value set")
+	}
+}
@@ -0,0 +1,349 @@
+package test_utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const xhtmlNamespace = "http://www.w3.org/1999/xhtml"
+
+// ElementDefinitions holds the element order and types of the base
+// StructureDefinitions of a FHIR version, one definition set per directory
+type ElementDefinitions struct {
+	sets []*definitionSet
+}
+
+// definitionSet holds the elements of the StructureDefinitions of one
+// directory, keyed by element path
+type definitionSet struct {
+	children map[string][]string
+	types    map[string][]string
+	refs     map[string]string
+}
+
+var definitionsCache sync.Map
+
+// LoadElementDefinitions reads the base StructureDefinitions in dirs, either
+// single *.profile.json files or profiles-*.json bundles. Every directory is a
+// definition set of its own, an element is accepted if one of the sets defining
+// its parent allows it, as the versions without definitions of their own, like
+// R4B, combine the definitions of other versions.
+func LoadElementDefinitions(t *testing.T, dirs ...string) *ElementDefinitions {
+	t.Helper()
+	key := strings.Join(dirs, ",")
+	if defs, ok := definitionsCache.Load(key); ok {
+		return defs.(*ElementDefinitions)
+	}
+
+	defs := &ElementDefinitions{}
+	for _, dir := range dirs {
+		files, _ := filepath.Glob(filepath.Join(dir, "*.profile.json"))
+		bundles, _ := filepath.Glob(filepath.Join(dir, "profiles-*.json"))
+		files = append(files, bundles...)
+		sort.Strings(files)
+
+		set := &definitionSet{children: map[string][]string{}, types: map[string][]string{}, refs: map[string]string{}}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("failed to read definitions %s: %v", file, err)
+			}
+			var resource struct {
+				ResourceType string `json:"resourceType"`
+				Entry        []struct {
+					Resource json.RawMessage `json:"resource"`
+				} `json:"entry"`
+			}
+			if err := json.Unmarshal(data, &resource); err != nil {
+				t.Fatalf("failed to parse definitions %s: %v", file, err)
+			}
+			entries := []json.RawMessage{data}
+			if resource.ResourceType == "Bundle" {
+				entries = entries[:0]
+				for _, entry := range resource.Entry {
+					entries = append(entries, entry.Resource)
+				}
+			}
+			for _, entry := range entries {
+				var sd struct {
+					ResourceType string `json:"resourceType"`
+					Derivation   string `json:"derivation"`
+					Snapshot     struct {
+						Element []struct {
+							Path             string `json:"path"`
+							ContentReference string `json:"contentReference"`
+							Type             []struct {
+								Code string `json:"code"`
+							} `json:"type"`
+						} `json:"element"`
+					} `json:"snapshot"`
+				}
+				if err := json.Unmarshal(entry, &sd); err != nil || sd.ResourceType != "StructureDefinition" ||
+					sd.Derivation == "constraint" {
+					continue
+				}
+				for _, element := range sd.Snapshot.Element {
+					if ref := element.ContentReference; ref != "" {
+						set.refs[element.Path] = ref[strings.IndexByte(ref, '#')+1:]
+					}
+					for _, typ := range element.Type {
+						set.types[element.Path] = append(set.types[element.Path], typ.Code)
+					}
+					if i := strings.LastIndexByte(element.Path, '.'); i >= 0 {
+						parent := element.Path[:i]
+						set.children[parent] = append(set.children[parent], element.Path[i+1:])
+					}
+				}
+			}
+		}
+		defs.sets = append(defs.sets, set)
+	}
+	definitionsCache.Store(key, defs)
+	return defs
+}
+
+// child returns the position of an XML element within the children of the
+// element at path and the path of its definition, or -1 if it is not defined
+func (s *definitionSet) child(path, name string) (int, string, string) {
+	children := s.children[path]
+	for i, child := range children {
+		if child == name {
+			return i, path + "." + child, ""
+		}
+	}
+	for i, child := range children {
+		if base, ok := strings.CutSuffix(child, "[x]"); ok && strings.HasPrefix(name, base) && len(name) > len(base) {
+			if typ := name[len(base):]; typ[0] >= 'A' && typ[0] <= 'Z' {
+				return i, path + "." + child, typ
+			}
+		}
+	}
+	return -1, "", ""
+}
+
+// context returns the path whose children define the content of an element,
+// or "" if the definitions do not describe it
+func (s *definitionSet) context(path, choiceType string) string {
+	if ref, ok := s.refs[path]; ok {
+		return ref
+	}
+	if choiceType != "" {
+		for _, typ := range s.types[path] {
+			if strings.EqualFold(typ, choiceType) {
+				return typ
+			}
+		}
+		return ""
+	}
+	types := s.types[path]
+	if len(types) != 1 {
+		return ""
+	}
+	switch types[0] {
+	case "BackboneElement", "Element":
+		return path
+	}
+	return types[0]
+}
+
+// scope is a definition set and the path whose children it checks
+type scope struct {
+	set  *definitionSet
+	path string
+	last int
+}
+
+// scopes returns the definition sets that define the children of path
+func (d *ElementDefinitions) scopes(path string) []*scope {
+	if path == "" {
+		return nil
+	}
+	var result []*scope
+	for _, set := range d.sets {
+		if _, ok := set.children[path]; ok {
+			result = append(result, &scope{set: set, path: path})
+		}
+	}
+	return result
+}
+
+// CheckXMLElementOrder reports the elements of a FHIR XML document that are not
+// defined by defs or that do not follow the element order of the definitions
+func CheckXMLElementOrder(t *testing.T, data []byte, defs *ElementDefinitions) {
+	t.Helper()
+	type frame struct {
+		path     string
+		scopes   []*scope
+		resource bool
+	}
+	var stack []*frame
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			t.Fatalf("failed to read XML: %v", err)
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			name := token.Name.Local
+			if len(stack) == 0 {
+				stack = append(stack, &frame{path: name, scopes: defs.scopes(name)})
+				continue
+			}
+			parent := stack[len(stack)-1]
+			current := &frame{path: parent.path + "." + name}
+			switch {
+			case len(parent.scopes) == 0 || token.Name.Space == xhtmlNamespace:
+			case parent.resource:
+				// the resource held by an element of type Resource
+				current.scopes = defs.scopes(name)
+			default:
+				var accepted []*scope
+				defined := false
+				for _, s := range parent.scopes {
+					index, path, choiceType := s.set.child(s.path, name)
+					if index < 0 {
+						continue
+					}
+					defined = true
+					if index < s.last {
+						continue
+					}
+					s.last = index
+					accepted = append(accepted, s)
+					if types := s.set.types[path]; len(types) == 1 && types[0] == "Resource" {
+						current.resource = true
+						current.scopes = append(current.scopes, &scope{set: s.set, path: path})
+					} else {
+						current.scopes = append(current.scopes, defs.scopes(s.set.context(path, choiceType))...)
+					}
+				}
+				switch {
+				case !defined:
+					t.Errorf("%s: element not defined by %s", current.path, parent.scopes[0].path)
+				case len(accepted) == 0:
+					t.Errorf("%s: element out of order", current.path)
+				default:
+					parent.scopes = accepted
+				}
+			}
+			stack = append(stack, current)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+// CompareXML compares two FHIR XML documents, ignoring indentation and the XML
+// declaration
+func CompareXML(t *testing.T, expected, actual []byte) {
+	t.Helper()
+	want, err := canonicalXML(expected)
+	if err != nil {
+		t.Fatalf("failed to read expected XML: %v", err)
+	}
+	got, err := canonicalXML(actual)
+	if err != nil {
+		t.Fatalf("failed to read actual XML: %v", err)
+	}
+	if want != got {
+		t.Errorf("XML documents differ\nexpected: %s\nactual:   %s", want, got)
+	}
+}
+
+// CompareXMLRoundTrip compares the JSON of a resource before and after a round
+// trip through XML. XML requires the XHTML namespace on narratives, so a div
+// that lacks it is expected to come back with the namespace declared.
+func CompareXMLRoundTrip(t *testing.T, expected, actual, xmlData []byte) {
+	t.Helper()
+	want, err := canonicalJSON(expected)
+	if err != nil {
+		t.Fatalf("failed to read expected JSON: %v", err)
+	}
+	got, err := canonicalJSON(actual)
+	if err != nil {
+		t.Fatalf("failed to read actual JSON: %v", err)
+	}
+	if want != got {
+		t.Errorf("XML round trip changed the resource\nexpected: %s\nactual:   %s\nXML: %s", want, got, xmlData)
+	}
+}
+
+// canonicalJSON writes a JSON document with sorted keys and the XHTML namespace
+// declared on every narrative div
+func canonicalJSON(data []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+	out, err := json.Marshal(declareXHTMLNamespace(value))
+	return string(out), err
+}
+
+func declareXHTMLNamespace(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if div, ok := child.(string); ok && key == "div" && strings.HasPrefix(div, "<div") {
+				if end := strings.IndexByte(div, '>'); end > 0 && !strings.Contains(div[:end], "xmlns=") {
+					child = `<div xmlns="` + xhtmlNamespace + `"` + div[len("<div"):]
+				}
+			}
+			v[key] = declareXHTMLNamespace(child)
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = declareXHTMLNamespace(child)
+		}
+	}
+	return value
+}
+
+// canonicalXML writes the elements, sorted attributes and non-blank text of a document
+func canonicalXML(data []byte) (string, error) {
+	var b strings.Builder
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return b.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			var attrs []string
+			for _, attr := range token.Attr {
+				if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+					// the namespace is part of the element name
+					continue
+				}
+				attrs = append(attrs, fmt.Sprintf(" %s:%s=%q", attr.Name.Space, attr.Name.Local, attr.Value))
+			}
+			sort.Strings(attrs)
+			fmt.Fprintf(&b, "<%s:%s%s>", token.Name.Space, token.Name.Local, strings.Join(attrs, ""))
+		case xml.EndElement:
+			fmt.Fprintf(&b, "</%s>", token.Name.Local)
+		case xml.CharData:
+			if text := strings.TrimSpace(string(token)); text != "" {
+				b.WriteString(text)
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package validate
+
+//go:generate go run ../../cmd/resourcegen -dir ../fhir3 -profiles ../fhir3/testdata/fhir3-definitions -invariants invariants_fhir3_gen.go
+//go:generate go run ../../cmd/resourcegen -dir ../fhir4 -profiles ../fhir4/testdata/fhir4-definitions -invariants invariants_fhir4_gen.go
+//go:generate go run ../../cmd/resourcegen -dir ../fhir5 -profiles ../fhir5/testdata/fhir5-json -invariants invariants_fhir5_gen.go
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+	"github.com/d4l-data4life/go-fhir/pkg/fhirpath"
+)
+
+// IssueTypeInvariant is the issue type code of a violated invariant
+const IssueTypeInvariant = "invariant"
+
+// invariant is a FHIRPath constraint of the base specification
+type invariant struct {
+	key        string
+	element    string
+	severity   string
+	human      string
+	expression string
+}
+
+// versionInvariants maps the version packages to their invariants. The DSTU2
+// definitions carry no FHIRPath and R4B has no definitions of its own yet, see
+// TODO.md
+var versionInvariants = map[string]map[reflect.Type][]invariant{
+	"fhir3": fhir3Invariants,
+	"fhir4": fhir4Invariants,
+	"fhir5": fhir5Invariants,
+}
+
+// label names the invariant in issues; the constraints of the definitions
+// converted from the FHIR protos may lack a key, they are named by their expression
+func (inv invariant) label() string {
+	if inv.key == "" {
+		return inv.expression
+	}
+	return inv.key
+}
+
+// description is the diagnostics of a violation of the invariant
+func (inv invariant) description() string {
+	if inv.key == "" || inv.human == "" {
+		return "constraint failed: " + inv.label()
+	}
+	return inv.key + ": " + inv.human
+}
+
+// compiled caches the parsed expressions of the invariants
+var compiled sync.Map
+
+// parsed returns the expression evaluated on the struct holding the invariant;
+// an invariant on a field must hold for every value of the field
+func (inv invariant) parsed() (*fhirpath.Expression, error) {
+	src := inv.expression
+	if inv.element != "" {
+		src = inv.element + ".all(" + inv.expression + ")"
+	}
+	if e, ok := compiled.Load(src); ok {
+		return e.(*fhirpath.Expression), nil
+	}
+	e, err := fhirpath.Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	compiled.Store(src, e)
+	return e, nil
+}
+
+// Invariants evaluates the FHIRPath invariants of the base specification of the
+// version of r, such as dom-3 or obs-6, on r and every resource it contains.
+// Each violation is reported with the severity of the invariant and its key and
+// description, e.g. "obs-6: dataAbsentReason SHALL only be present if
+// Observation.value[x] is not present". A resource without violations yields a
+// single informational issue. Resources of versions without invariants, such as
+// DSTU2 and R4B, return ErrUnsupportedVersion.
+func Invariants(r common.Resource) (*fhir5.OperationOutcome, error) {
+	version, err := resourceVersion(r)
+	if err != nil {
+		return nil, err
+	}
+	invariants, ok := versionInvariants[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedVersion, version)
+	}
+	c := &invariantChecker{invariants: invariants, resource: r, root: r}
+	c.value(reflect.ValueOf(r), r.GetResourceType())
+	if len(c.issues) == 0 {
+		c.issues = append(c.issues, fhir5.OperationOutcomeIssue{
+			Severity:    fhir5.OperationOutcomeIssueSeverityInformation,
+			Code:        "informational",
+			Diagnostics: fhir5.StringPtr("All OK"),
+		})
+	}
+	return &fhir5.OperationOutcome{ResourceType: "OperationOutcome", Issue: c.issues}, nil
+}
+
+// invariantChecker evaluates the invariants of the structs of a resource, resource
+// is the resource being walked and root the resource containing it
+type invariantChecker struct {
+	invariants map[reflect.Type][]invariant
+	resource   common.Resource
+	root       common.Resource
+	issues     []fhir5.OperationOutcomeIssue
+}
+
+func (c *invariantChecker) value(val reflect.Value, path string) {
+	switch val.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !val.IsNil() {
+			c.value(val.Elem(), path)
+		}
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			c.value(val.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+	case reflect.Struct:
+		if !val.CanAddr() {
+			addressable := reflect.New(val.Type()).Elem()
+			addressable.Set(val)
+			val = addressable
+		}
+		c.structure(val, path)
+	}
+}
+
+// structure evaluates the invariants of a struct and of the structs it embeds
+func (c *invariantChecker) structure(val reflect.Value, path string) {
+	for _, inv := range structInvariants(c.invariants, val.Type()) {
+		c.evaluate(inv, val.Addr().Interface(), path)
+	}
+
+	fields := map[string]reflect.Value{}
+	var names []string
+	collectFields(val, fields, &names)
+	for _, name := range names {
+		field := fields[name]
+		fieldPath := path + "." + name
+		if field.Type().Implements(resourceType) || field.Kind() == reflect.Slice && field.Type().Elem().Implements(resourceType) {
+			c.resources(field, fieldPath, name == "contained")
+			continue
+		}
+		if field.Kind() == reflect.Struct && field.IsZero() {
+			continue
+		}
+		c.value(field, fieldPath)
+	}
+}
+
+// resources walks fields holding resources, contained resources keep the root
+// resource, other resources such as bundle entries are their own root
+func (c *invariantChecker) resources(val reflect.Value, path string, contained bool) {
+	if val.Kind() == reflect.Slice {
+		for i := 0; i < val.Len(); i++ {
+			c.resources(val.Index(i), path+"["+strconv.Itoa(i)+"]", contained)
+		}
+		return
+	}
+	if val.IsNil() {
+		return
+	}
+	r, ok := val.Interface().(common.Resource)
+	if _, raw := r.(*common.RawResource); !ok || raw {
+		return
+	}
+	resource, root := c.resource, c.root
+	defer func() { c.resource, c.root = resource, root }()
+	c.resource = r
+	if !contained {
+		c.root = r
+	}
+	c.value(val, path)
+}
+
+func (c *invariantChecker) evaluate(inv invariant, context interface{}, path string) {
+	if inv.element != "" {
+		path += "." + inv.element
+	}
+	expr, err := inv.parsed()
+	if err != nil {
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityWarning, "processing", path, "%s: %v", inv.label(), err)
+		return
+	}
+	ok, known, err := expr.EvaluateBool(context, fhirpath.WithResource(c.resource), fhirpath.WithRootResource(c.root))
+	switch {
+	case err != nil:
+		c.addIssue(fhir5.OperationOutcomeIssueSeverityWarning, "processing", path, "%s: %v", inv.label(), err)
+	case known && !ok:
+		severity := fhir5.OperationOutcomeIssueSeverityError
+		if inv.severity == "warning" {
+			severity = fhir5.OperationOutcomeIssueSeverityWarning
+		}
+		c.addIssue(severity, IssueTypeInvariant, path, "%s", inv.description())
+	}
+}
+
+func (c *invariantChecker) addIssue(severity fhir5.OperationOutcomeIssueSeverity, code, path, format string, args ...interface{}) {
+	c.issues = append(c.issues, fhir5.OperationOutcomeIssue{
+		Severity:    severity,
+		Code:        code,
+		Diagnostics: fhir5.StringPtr(fmt.Sprintf(format, args...)),
+		Expression:  []string{path},
+	})
+}
+
+// structInvariants returns the invariants of a struct type and of the structs it
+// embeds, e.g. ele-1 of Element
+func structInvariants(invariants map[reflect.Type][]invariant, t reflect.Type) []invariant {
+	result := invariants[t]
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.Anonymous && field.Type.Kind() == reflect.Struct {
+			result = append(result[:len(result):len(result)], structInvariants(invariants, field.Type)...)
+		}
+	}
+	return result
+}
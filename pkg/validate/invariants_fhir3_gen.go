@@ -0,0 +1,225 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+)
+
+// fhir3Invariants lists the FHIRPath constraints of each struct of the
+// fhir3 package, element is the field a constraint applies to or empty for
+// the struct itself
+var fhir3Invariants = map[reflect.Type][]invariant{
+	reflect.TypeOf(common.TimingRepeat{}): {
+		{"", "", "error", "", "offset.empty() or (when.exists() and ((when in ('C' | 'CM' | 'CD' | 'CV')).not()))"},
+		{"tim-5", "", "error", "period SHALL be a non-negative value", "period.exists() implies period >= 0"},
+		{"tim-6", "", "error", "If there's a periodMax, there must be a period", "periodMax.empty() or period.exists()"},
+		{"tim-7", "", "error", "If there's a durationMax, there must be a duration", "durationMax.empty() or duration.exists()"},
+		{"tim-8", "", "error", "If there's a countMax, there must be a count", "countMax.empty() or count.exists()"},
+		{"tim-1", "", "error", "if there's a duration, there needs to be duration units", "duration.empty() or durationUnit.exists()"},
+		{"tim-10", "", "error", "If there's a timeOfDay, there cannot be a when, or vice versa", "timeOfDay.empty() or when.empty()"},
+		{"tim-2", "", "error", "if there's a period, there needs to be period units", "period.empty() or periodUnit.exists()"},
+		{"tim-4", "", "error", "duration SHALL be a non-negative value", "duration.exists() implies duration >= 0"},
+	},
+	reflect.TypeOf(fhir3.AppointmentParticipant{}): {
+		{"app-1", "", "error", "Either the type or actor on the participant SHALL be specified", "type.exists() or actor.exists()"},
+	},
+	reflect.TypeOf(fhir3.AuditEventEntity{}): {
+		{"", "", "error", "", "name.empty() or query.empty()"},
+	},
+	reflect.TypeOf(fhir3.BundleEntry{}): {
+		{"bdl-5", "", "error", "must be a resource unless there's a request or response", "resource.exists() or request.exists() or response.exists()"},
+	},
+	reflect.TypeOf(fhir3.CapabilityStatementMessaging{}): {
+		{"", "", "error", "", "supportedMessage.empty() != event.empty()"},
+	},
+	reflect.TypeOf(fhir3.CapabilityStatementRest{}): {
+		{"cpb-9", "", "error", "A given resource can only be described once per RESTful mode.", "resource.select(type).isDistinct()"},
+	},
+	reflect.TypeOf(fhir3.CapabilityStatementRestResource{}): {
+		{"cpb-12", "", "error", "Search parameter names must be unique in the context of a resource.", "searchParam.select(name).isDistinct()"},
+	},
+	reflect.TypeOf(fhir3.CarePlanActivity{}): {
+		{"", "", "error", "", "detail.empty() or reference.empty()"},
+	},
+	reflect.TypeOf(fhir3.CareTeamParticipant{}): {
+		{"ctm-1", "", "error", "CareTeam.participant.onBehalfOf can only be populated when CareTeam.participant.member is a Practitioner", "onBehalfOf.exists() implies (member.resolve() is Practitioner)"},
+	},
+	reflect.TypeOf(fhir3.CommunicationRequestRequester{}): {
+		{"", "", "error", "", "(agent.resolve() is Practitioner) or (agent.resolve() is Device) or onBehalfOf.exists().not()"},
+	},
+	reflect.TypeOf(fhir3.CompositionSection{}): {
+		{"cmp-1", "", "error", "A section must contain at least one of text, entries, or sub-sections", "text.exists() or entry.exists() or section.exists()"},
+		{"cmp-2", "", "error", "A section can only have an emptyReason if it is empty", "emptyReason.empty() or entry.empty()"},
+	},
+	reflect.TypeOf(fhir3.ConceptMapGroupElementTarget{}): {
+		{"", "", "error", "", "comment.exists() or equivalence.empty() or ((equivalence != 'narrower') and (equivalence != 'inexact'))"},
+	},
+	reflect.TypeOf(fhir3.ConceptMapGroupUnmapped{}): {
+		{"", "", "error", "", "(mode = 'other-map') implies url.exists()"},
+		{"", "", "error", "", "(mode = 'fixed') implies code.exists()"},
+	},
+	reflect.TypeOf(fhir3.ConditionEvidence{}): {
+		{"", "", "error", "", "code.exists() or detail.exists()"},
+	},
+	reflect.TypeOf(fhir3.ConditionStage{}): {
+		{"con-1", "", "error", "Stage SHALL have summary or assessment", "summary.exists() or assessment.exists()"},
+	},
+	reflect.TypeOf(fhir3.DataElement{}): {
+		{"", "element", "error", "", "base.empty()"},
+		{"", "element", "error", "", "slicing.empty()"},
+	},
+	reflect.TypeOf(fhir3.DataElementMapping{}): {
+		{"", "", "error", "", "uri.exists() or name.exists()"},
+	},
+	reflect.TypeOf(fhir3.ElementDefinition{}): {
+		{"eld-3", "max", "error", "Max SHALL be a number or \"*\"", "empty() or ($this = '*') or (toInteger() >= 0)"},
+	},
+	reflect.TypeOf(fhir3.ElementDefinitionBinding{}): {
+		{"", "", "error", "", "valueSet.exists() or description.exists()"},
+		{"", "", "error", "", "valueSet.is(uri).not() or valueSet.as(uri).startsWith('http:') or valueSet.as(uri).startsWith('https') or valueSet.as(uri).startsWith('urn:')"},
+	},
+	reflect.TypeOf(fhir3.ElementDefinitionSlicing{}): {
+		{"", "", "error", "", "discriminator.exists() or description.exists()"},
+	},
+	reflect.TypeOf(fhir3.ElementDefinitionType{}): {
+		{"", "", "error", "", "aggregation.empty() or (code = 'Reference')"},
+	},
+	reflect.TypeOf(fhir3.ExpansionProfileDesignationExcludeDesignation{}): {
+		{"", "", "error", "", "language.exists() or use.exists()"},
+	},
+	reflect.TypeOf(fhir3.ExpansionProfileDesignationIncludeDesignation{}): {
+		{"", "", "error", "", "language.exists() or use.exists()"},
+	},
+	reflect.TypeOf(fhir3.GoalTarget{}): {
+		{"gol-1", "", "error", "Goal.target.measure is required if Goal.target.detail is populated", "(detail.exists() and measure.exists()) or detail.exists().not()"},
+	},
+	reflect.TypeOf(fhir3.ImmunizationRecommendationRecommendation{}): {
+		{"imr-1", "", "error", "One of vaccineCode or targetDisease SHALL be present", "vaccineCode.exists() or targetDisease.exists()"},
+	},
+	reflect.TypeOf(fhir3.MedicationAdministrationDosage{}): {
+		{"", "", "error", "", "dose.exists() or rate.exists()"},
+	},
+	reflect.TypeOf(fhir3.MedicationRequestRequester{}): {
+		{"", "", "error", "", "(agent.resolve().empty()) or (agent.resolve() is Device) or (agent.resolve() is Practitioner) or onBehalfOf.exists().not()"},
+	},
+	reflect.TypeOf(fhir3.MessageDefinitionFocus{}): {
+		{"md-1", "", "error", "Max must be postive int or *", "max='*' or (max.toInteger() > 0)"},
+	},
+	reflect.TypeOf(fhir3.Narrative{}): {
+		{"", "div", "error", "", "htmlchecks()"},
+	},
+	reflect.TypeOf(fhir3.ObservationReferenceRange{}): {
+		{"obs-3", "", "error", "Must have at least a low or a high or text", "low.exists() or high.exists() or text.exists()"},
+	},
+	reflect.TypeOf(fhir3.OperationDefinitionParameter{}): {
+		{"opd-1", "", "error", "Either a type must be provided, or parts", "type.exists() or part.exists()"},
+		{"opd-2", "", "error", "A search type can only be specified for parameters of type string", "searchType.exists() implies type = 'string'"},
+	},
+	reflect.TypeOf(fhir3.Organization{}): {
+		{"", "telecom", "error", "", "where(use = 'home').empty()"},
+		{"", "address", "error", "", "where(use = 'home').empty()"},
+	},
+	reflect.TypeOf(fhir3.ParametersParameter{}): {
+		{"inv-1", "", "error", "A parameter must have one and only one of (value, resource, part)", "(part.exists() and value.empty() and resource.empty()) or (part.empty() and (value.exists() xor resource.exists()))"},
+	},
+	reflect.TypeOf(fhir3.PatientContact{}): {
+		{"pat-1", "", "error", "SHALL at least contain a contact's details or a reference to an organization", "name.exists() or telecom.exists() or address.exists() or organization.exists()"},
+	},
+	reflect.TypeOf(fhir3.QuestionnaireItem{}): {
+		{"que-9", "", "error", "Read-only can't be specified for \"display\" items", "type!='display' or readOnly.empty()"},
+		{"que-8", "", "error", "Initial values can't be specified for groups or display items", "(type!='group' and type!='display') or initial.empty()"},
+		{"que-6", "", "error", "Required and repeat aren't permitted for display items", "type!='display' or (required.empty() and repeats.empty())"},
+		{"", "", "error", "", "(type ='choice' or type = 'open-choice') or (options.empty() and option.empty())"},
+		{"", "", "error", "", "option.empty() or options.empty()"},
+		{"que-3", "", "error", "Display items cannot have a \"code\" asserted", "type!='display' or code.empty()"},
+		{"", "", "error", "", "(type in ('boolean' | 'decimal' | 'integer' | 'string' | 'text' | 'url')) or maxLength.empty()"},
+		{"", "", "error", "", "(type='group' implies item.empty().not()) and (type.trace('type')='display' implies item.trace('item').empty())"},
+	},
+	reflect.TypeOf(fhir3.QuestionnaireItemEnableWhen{}): {
+		{"", "", "error", "", "hasAnswer.exists() xor answer.exists()"},
+	},
+	reflect.TypeOf(fhir3.QuestionnaireResponseItem{}): {
+		{"qrs-1", "", "error", "Item cannot contain both item and answer", "(answer.exists() and item.exists()).not()"},
+	},
+	reflect.TypeOf(fhir3.ReferralRequestRequester{}): {
+		{"", "", "error", "", "(agent.resolve() is Device) or (agent.resolve() is Practitioner) or onBehalfOf.exists().not()"},
+	},
+	reflect.TypeOf(fhir3.RequestGroupAction{}): {
+		{"rqg-1", "", "error", "Must have resource or action but not both", "resource.exists() != action.exists()"},
+	},
+	reflect.TypeOf(fhir3.RiskAssessmentPrediction{}): {
+		{"ras-1", "probability", "error", "low and high must be percentages, if present", "(low.empty() or ((low.code = '%') and (low.system = %ucum))) and (high.empty() or ((high.code = '%') and (high.system = %ucum)))"},
+	},
+	reflect.TypeOf(fhir3.SequenceReferenceSeq{}): {
+		{"", "", "error", "", "strand.empty() or strand = 1 or strand = -1"},
+		{"", "", "error", "", "(chromosome.empty() and genomeBuild.empty()) or (chromosome.exists() and genomeBuild.exists())"},
+		{"", "", "error", "", "(genomeBuild.count()+referenceSeqId.count()+ referenceSeqPointer.count()+ referenceSeqString.count()) = 1"},
+	},
+	reflect.TypeOf(fhir3.StructureDefinitionDifferential{}): {
+		{"", "", "error", "", "element.first().path.contains('.').not() implies element.first().type.empty()"},
+		{"", "", "error", "", "element.first().slicing.empty()"},
+		{"", "", "error", "", "element.first().path.startsWith(%resource.type) and element.tail().all(path.startsWith(%resource.type&'.'))"},
+	},
+	reflect.TypeOf(fhir3.StructureDefinitionMapping{}): {
+		{"sdf-2", "", "error", "Must have at least a name or a uri (or both)", "name.exists() or uri.exists()"},
+	},
+	reflect.TypeOf(fhir3.StructureDefinitionSnapshot{}): {
+		{"", "", "error", "", "element.first().type.empty()"},
+		{"", "", "error", "", "element.first().path = %resource.type and element.tail().all(path.startsWith(%resource.type&'.'))"},
+	},
+	reflect.TypeOf(fhir3.StructureMapGroupRuleTarget{}): {
+		{"", "", "error", "", "context.exists() implies contextType.exists()"},
+		{"smp-1", "", "error", "Can only have an element if you have a context", "element.exists() implies context.exists()"},
+	},
+	reflect.TypeOf(fhir3.TestReportSetupAction{}): {
+		{"inv-1", "", "error", "Setup action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir3.TestReportTestAction{}): {
+		{"inv-2", "", "error", "Test action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir3.TestScriptMetadata{}): {
+		{"tst-4", "", "error", "TestScript metadata capability SHALL contain required or validated or both.", "capability.required.exists() or capability.validated.exists()"},
+	},
+	reflect.TypeOf(fhir3.TestScriptSetupAction{}): {
+		{"tst-1", "", "error", "Setup action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir3.TestScriptSetupActionAssert{}): {
+		{"", "", "error", "", "contentType.count() + expression.count() + headerField.count() + minimumId.count() + navigationLinks.count() + path.count() + requestMethod.count() + resource.count() + responseCode.count() + response.count() + rule.count() + ruleset.count() + validateProfileId.count() <=1"},
+		{"tst-10", "", "error", "Setup action assert SHALL contain either compareToSourceId and compareToSourceExpression, compareToSourceId and compareToSourcePath or neither.", "compareToSourceId.empty() xor (compareToSourceExpression.exists() or compareToSourcePath.exists())"},
+		{"tst-12", "", "error", "Setup action assert response and responseCode SHALL be empty when direction equals request", "(response.empty() and responseCode.empty() and direction = 'request') or direction.empty() or direction = 'response'"},
+	},
+	reflect.TypeOf(fhir3.TestScriptSetupActionOperation{}): {
+		{"tst-7", "", "error", "Setup operation SHALL contain either sourceId or targetId or params or url.", "sourceId.exists() or (targetId.count() + url.count() + params.count() = 1) or (type.code in ('capabilities' |'search' | 'transaction' | 'history'))"},
+	},
+	reflect.TypeOf(fhir3.TestScriptTestAction{}): {
+		{"tst-2", "", "error", "Test action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir3.TestScriptVariable{}): {
+		{"tst-3", "", "error", "Variable can only contain one of expression, headerField or path.", "expression.empty() or headerField.empty() or path.empty()"},
+	},
+	reflect.TypeOf(fhir3.TimingRepeat{}): {
+		{"", "", "error", "", "offset.empty() or (when.exists() and ((when in ('C' | 'CM' | 'CD' | 'CV')).not()))"},
+		{"tim-5", "", "error", "period SHALL be a non-negative value", "period.exists() implies period >= 0"},
+		{"tim-6", "", "error", "If there's a periodMax, there must be a period", "periodMax.empty() or period.exists()"},
+		{"tim-7", "", "error", "If there's a durationMax, there must be a duration", "durationMax.empty() or duration.exists()"},
+		{"tim-8", "", "error", "If there's a countMax, there must be a count", "countMax.empty() or count.exists()"},
+		{"tim-1", "", "error", "if there's a duration, there needs to be duration units", "duration.empty() or durationUnit.exists()"},
+		{"tim-10", "", "error", "If there's a timeOfDay, there cannot be a when, or vice versa", "timeOfDay.empty() or when.empty()"},
+		{"tim-2", "", "error", "if there's a period, there needs to be period units", "period.empty() or periodUnit.exists()"},
+		{"tim-4", "", "error", "duration SHALL be a non-negative value", "duration.exists() implies duration >= 0"},
+	},
+	reflect.TypeOf(fhir3.ValueSetComposeInclude{}): {
+		{"vsd-2", "", "error", "A value set with concepts or filters SHALL include a system", "(concept.exists() or filter.exists()) implies system.exists()"},
+		{"vsd-3", "", "error", "Cannot have both concept and filter", "concept.empty() or filter.empty()"},
+		{"vsd-1", "", "error", "A value set include/exclude SHALL have a value set or a system", "valueSet.exists() or system.exists()"},
+	},
+	reflect.TypeOf(fhir3.ValueSetExpansionContains{}): {
+		{"vsd-6", "", "error", "SHALL have a code or a display", "code.exists() or display.exists()"},
+		{"vsd-9", "", "error", "SHALL have a code if not abstract", "code.exists() or abstract = true"},
+		{"vsd-10", "", "error", "SHALL have a system if a code is present", "code.empty() or system.exists()"},
+	},
+}
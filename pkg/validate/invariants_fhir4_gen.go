@@ -0,0 +1,420 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+)
+
+// fhir4Invariants lists the FHIRPath constraints of each struct of the
+// fhir4 package, element is the field a constraint applies to or empty for
+// the struct itself
+var fhir4Invariants = map[reflect.Type][]invariant{
+	reflect.TypeOf(common.Attachment{}): {
+		{"att-1", "", "error", "If the Attachment has data, it SHALL have a contentType", "data.empty() or contentType.exists()"},
+	},
+	reflect.TypeOf(common.ContactPoint{}): {
+		{"cpt-2", "", "error", "A system is required if a value is provided.", "value.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.Count{}): {
+		{"cnt-3", "", "error", "There SHALL be a code with a value of \"1\" if there is a value. If system is present, it SHALL be UCUM.  If present, the value SHALL be a whole number.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum) and (code.empty() or code = '1') and (value.empty() or value.hasValue().not() or value.toString().contains('.').not())"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.DataRequirementCodeFilter{}): {
+		{"drq-1", "", "error", "Either a path or a searchParam must be provided, but not both", "path.exists() xor searchParam.exists()"},
+	},
+	reflect.TypeOf(common.DataRequirementDateFilter{}): {
+		{"drq-2", "", "error", "Either a path or a searchParam must be provided, but not both", "path.exists() xor searchParam.exists()"},
+	},
+	reflect.TypeOf(common.Distance{}): {
+		{"dis-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of length.  If system is present, it SHALL be UCUM.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum)"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.Duration{}): {
+		{"drt-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of time.  If system is present, it SHALL be UCUM.", "code.exists() implies ((system = %ucum) and value.exists())"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.Expression{}): {
+		{"exp-1", "", "error", "An expression or a reference must be provided", "expression.exists() or reference.exists()"},
+	},
+	reflect.TypeOf(common.Period{}): {
+		{"", "", "error", "", "start.hasValue().not() or end.hasValue().not() or (start <= end)"},
+	},
+	reflect.TypeOf(common.Quantity{}): {
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.Range{}): {
+		{"", "", "error", "", "low.empty() or high.empty() or (low <= high)"},
+	},
+	reflect.TypeOf(common.Ratio{}): {
+		{"", "", "error", "", "(numerator.empty() xor denominator.exists()) and (numerator.exists() or extension.exists())"},
+	},
+	reflect.TypeOf(common.TimingRepeat{}): {
+		{"tim-1", "", "error", "if there's a duration, there needs to be duration units", "duration.empty() or durationUnit.exists()"},
+		{"tim-2", "", "error", "if there's a period, there needs to be period units", "period.empty() or periodUnit.exists()"},
+		{"tim-4", "", "error", "duration SHALL be a non-negative value", "duration.exists() implies duration >= 0"},
+		{"tim-5", "", "error", "period SHALL be a non-negative value", "period.exists() implies period >= 0"},
+		{"tim-6", "", "error", "If there's a periodMax, there must be a period", "periodMax.empty() or period.exists()"},
+		{"tim-7", "", "error", "If there's a durationMax, there must be a duration", "durationMax.empty() or duration.exists()"},
+		{"tim-8", "", "error", "If there's a countMax, there must be a count", "countMax.empty() or count.exists()"},
+		{"", "", "error", "", "offset.empty() or (when.exists() and ((when in ('C' | 'CM' | 'CD' | 'CV')).not()))"},
+		{"tim-10", "", "error", "If there's a timeOfDay, there cannot be a when, or vice versa", "timeOfDay.empty() or when.empty()"},
+	},
+	reflect.TypeOf(common.TriggerDefinition{}): {
+		{"trd-1", "", "error", "Either timing, or a data requirement, but not both", "data.empty() or timing.empty()"},
+		{"trd-2", "", "error", "A condition only if there is a data requirement", "condition.exists() implies data.exists()"},
+		{"trd-3", "", "error", "A named event requires a name, a periodic event requires timing, and a data event requires data", "(type = 'named-event' implies name.exists()) and (type = 'periodic' implies timing.exists()) and (type.startsWith('data-') implies data.exists())"},
+	},
+	reflect.TypeOf(fhir4.Age{}): {
+		{"age-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of time.  If system is present, it SHALL be UCUM.  If value is present, it SHALL be positive.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum) and (value.empty() or value.hasValue().not() or value > 0)"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(fhir4.AllergyIntolerance{}): {
+		{"", "", "error", "", "verificationStatus.coding.where(system = 'http://terminology.hl7.org/CodeSystem/allergyintolerance-verification' and code = 'entered-in-error').exists() or clinicalStatus.exists()"},
+		{"", "", "error", "", "verificationStatus.coding.where(system = 'http://terminology.hl7.org/CodeSystem/allergyintolerance-verification' and code = 'entered-in-error').empty() or clinicalStatus.empty()"},
+	},
+	reflect.TypeOf(fhir4.Appointment{}): {
+		{"app-2", "", "error", "Either start and end are specified, or neither", "start.exists() = end.exists()"},
+		{"app-3", "", "error", "Only proposed or cancelled appointments can be missing start/end dates", "(start.exists() and end.exists()) or (status in ('proposed' | 'cancelled' | 'waitlist'))"},
+		{"", "", "error", "", "Appointment.cancelationReason.exists() implies (Appointment.status='no-show' or Appointment.status='cancelled')"},
+	},
+	reflect.TypeOf(fhir4.AppointmentParticipant{}): {
+		{"app-1", "", "error", "Either the type or actor on the participant SHALL be specified", "type.exists() or actor.exists()"},
+	},
+	reflect.TypeOf(fhir4.AppointmentResponse{}): {
+		{"apr-1", "", "error", "Either the participantType or actor must be specified", "participantType.exists() or actor.exists()"},
+	},
+	reflect.TypeOf(fhir4.Attachment{}): {
+		{"att-1", "", "error", "If the Attachment has data, it SHALL have a contentType", "data.empty() or contentType.exists()"},
+	},
+	reflect.TypeOf(fhir4.AuditEventEntity{}): {
+		{"", "", "error", "", "name.empty() or query.empty()"},
+	},
+	reflect.TypeOf(fhir4.Bundle{}): {
+		{"bdl-1", "", "error", "total only when a search or history", "total.empty() or (type = 'searchset') or (type = 'history')"},
+		{"", "", "error", "", "entry.search.empty() or (type = 'searchset')"},
+		{"", "", "error", "", "entry.all(request.exists() = (%resource.type = 'batch' or %resource.type = 'transaction' or %resource.type = 'history'))"},
+		{"", "", "error", "", "entry.all(response.exists() = (%resource.type = 'batch-response' or %resource.type = 'transaction-response' or %resource.type = 'history'))"},
+		{"", "", "error", "", "(type = 'history') or entry.where(fullUrl.exists()).select(fullUrl&resource.meta.versionId).isDistinct()"},
+		{"bdl-9", "", "error", "A document must have an identifier with a system and a value", "type = 'document' implies (identifier.system.exists() and identifier.value.exists())"},
+		{"bdl-10", "", "error", "A document must have a date", "type = 'document' implies (timestamp.hasValue())"},
+		{"bdl-11", "", "error", "A document must have a Composition as the first resource", "type = 'document' implies entry.first().resource.is(Composition)"},
+		{"bdl-12", "", "error", "A message must have a MessageHeader as the first resource", "type = 'message' implies entry.first().resource.is(MessageHeader)"},
+	},
+	reflect.TypeOf(fhir4.BundleEntry{}): {
+		{"bdl-5", "", "error", "must be a resource unless there's a request or response", "resource.exists() or request.exists() or response.exists()"},
+	},
+	reflect.TypeOf(fhir4.CapabilityStatement{}): {
+		{"cpb-1", "", "error", "A Capability Statement SHALL have at least one of REST, messaging or document element.", "rest.exists() or messaging.exists() or document.exists()"},
+		{"cpb-2", "", "error", "A Capability Statement SHALL have at least one of description, software, or implementation element.", "(description.count() + software.count() + implementation.count()) > 0"},
+		{"cpb-3", "", "error", "Messaging end-point is only permitted when a capability statement is for an implementation.", "messaging.endpoint.empty() or kind = 'instance'"},
+		{"cpb-7", "", "error", "The set of documents must be unique by the combination of profile and mode.", "document.select(profile&mode).isDistinct()"},
+		{"cpb-14", "", "error", "If kind = instance, implementation must be present and software may be present", "(kind != 'instance') or implementation.exists()"},
+		{"cpb-15", "", "error", "If kind = capability, implementation must be absent, software must be present", "(kind != 'capability') or (implementation.exists().not() and software.exists())"},
+		{"cpb-16", "", "error", "If kind = requirements, implementation and software must be absent", "(kind!='requirements') or (implementation.exists().not() and software.exists().not())"},
+	},
+	reflect.TypeOf(fhir4.CapabilityStatementRest{}): {
+		{"cpb-9", "", "error", "A given resource can only be described once per RESTful mode.", "resource.select(type).isDistinct()"},
+	},
+	reflect.TypeOf(fhir4.CapabilityStatementRestResource{}): {
+		{"cpb-12", "", "error", "Search parameter names must be unique in the context of a resource.", "searchParam.select(name).isDistinct()"},
+	},
+	reflect.TypeOf(fhir4.CareTeamParticipant{}): {
+		{"", "", "error", "", "onBehalfOf.exists() implies (member.resolve().iif(empty(), true, ofType(Practitioner).exists()))"},
+	},
+	reflect.TypeOf(fhir4.CodeSystem{}): {
+		{"", "", "error", "", "concept.code.combine($this.descendants().concept.code).isDistinct()"},
+	},
+	reflect.TypeOf(fhir4.CompositionSection{}): {
+		{"cmp-1", "", "error", "A section must contain at least one of text, entries, or sub-sections", "text.exists() or entry.exists() or section.exists()"},
+		{"cmp-2", "", "error", "A section can only have an emptyReason if it is empty", "emptyReason.empty() or entry.empty()"},
+	},
+	reflect.TypeOf(fhir4.ConceptMapGroupElementTarget{}): {
+		{"", "", "error", "", "comment.exists() or equivalence.empty() or ((equivalence != 'narrower') and (equivalence != 'inexact'))"},
+	},
+	reflect.TypeOf(fhir4.ConceptMapGroupUnmapped{}): {
+		{"", "", "error", "", "(mode = 'fixed') implies code.exists()"},
+		{"", "", "error", "", "(mode = 'other-map') implies url.exists()"},
+	},
+	reflect.TypeOf(fhir4.Condition{}): {
+		{"", "", "error", "", "abatement.empty() or clinicalStatus.coding.where(system='http://terminology.hl7.org/CodeSystem/condition-clinical' and (code='resolved' or code='remission' or code='inactive')).exists()"},
+		{"", "", "error", "", "verificationStatus.coding.where(system='http://terminology.hl7.org/CodeSystem/condition-ver-status' and code='entered-in-error').empty() or clinicalStatus.empty()"},
+	},
+	reflect.TypeOf(fhir4.ConditionEvidence{}): {
+		{"", "", "error", "", "code.exists() or detail.exists()"},
+	},
+	reflect.TypeOf(fhir4.ConditionStage{}): {
+		{"con-1", "", "error", "Stage SHALL have summary or assessment", "summary.exists() or assessment.exists()"},
+	},
+	reflect.TypeOf(fhir4.Consent{}): {
+		{"", "", "error", "", "policy.exists() or policyRule.exists()"},
+		{"", "", "error", "", "patient.exists() or scope.coding.where(system='something' and code='patient-privacy').exists().not()"},
+		{"", "", "error", "", "patient.exists() or scope.coding.where(system='something' and code='research').exists().not()"},
+		{"", "", "error", "", "patient.exists() or scope.coding.where(system='something' and code='adr').exists().not()"},
+		{"", "", "error", "", "patient.exists() or scope.coding.where(system='something' and code='treatment').exists().not()"},
+	},
+	reflect.TypeOf(fhir4.ContactPoint{}): {
+		{"cpt-2", "", "error", "A system is required if a value is provided.", "value.empty() or system.exists()"},
+	},
+	reflect.TypeOf(fhir4.Count{}): {
+		{"cnt-3", "", "error", "There SHALL be a code with a value of \"1\" if there is a value. If system is present, it SHALL be UCUM.  If present, the value SHALL be a whole number.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum) and (code.empty() or code = '1') and (value.empty() or value.hasValue().not() or value.toString().contains('.').not())"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(fhir4.CoverageEligibilityResponseInsuranceItem{}): {
+		{"ces-1", "", "error", "SHALL contain a category or a billcode but not both.", "category.exists() xor productOrService.exists()"},
+	},
+	reflect.TypeOf(fhir4.Distance{}): {
+		{"dis-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of length.  If system is present, it SHALL be UCUM.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum)"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(fhir4.Duration{}): {
+		{"drt-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of time.  If system is present, it SHALL be UCUM.", "code.exists() implies ((system = %ucum) and value.exists())"},
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(fhir4.ElementDefinition{}): {
+		{"eld-2", "", "error", "Min <= Max", "min.empty() or max.empty() or (max = '*') or iif(max != '*', min <= max.toInteger())"},
+		{"eld-5", "", "error", "if the element definition has a contentReference, it cannot have type, defaultValue, fixed, pattern, example, minValue, maxValue, maxLength, or binding", "contentReference.empty() or (type.empty() and defaultValue.empty() and fixed.empty() and pattern.empty() and example.empty() and minValue.empty() and maxValue.empty() and maxLength.empty() and binding.empty())"},
+		{"eld-6", "", "error", "Fixed value may only be specified if there is one type", "fixed.empty() or (type.count()  <= 1)"},
+		{"eld-7", "", "error", "Pattern may only be specified if there is one type", "pattern.empty() or (type.count() <= 1)"},
+		{"eld-8", "", "error", "Pattern and fixed are mutually exclusive", "pattern.empty() or fixed.empty()"},
+		{"", "", "error", "", "binding.empty() or type.code.empty() or type.select((code = 'code') or (code = 'Coding') or (code='CodeableConcept') or (code = 'Quantity') or (code = 'string') or (code = 'uri')).exists()"},
+		{"eld-13", "", "error", "Types must be unique by code", "type.select(code).isDistinct()"},
+		{"eld-14", "", "error", "Constraints must be unique by key", "constraint.select(key).isDistinct()"},
+		{"eld-15", "", "error", "default value and meaningWhenMissing are mutually exclusive", "defaultValue.empty() or meaningWhenMissing.empty()"},
+		{"eld-16", "", "error", "sliceName must be composed of proper tokens separated by \"/\"", "sliceName.empty() or sliceName.matches('^[a-zA-Z0-9\\\\/\\\\-_\\\\[\\\\]\\\\@]+$')"},
+		{"eld-18", "", "error", "Must have a modifier reason if isModifier = true", "(isModifier.exists() and isModifier) implies isModifierReason.exists()"},
+		{"eld-22", "", "error", "sliceIsConstraining can only appear if slicename is present", "sliceIsConstraining.exists() implies sliceName.exists()"},
+		{"eld-3", "max", "error", "Max SHALL be a number or \"*\"", "empty() or ($this = '*') or (toInteger() >= 0)"},
+	},
+	reflect.TypeOf(fhir4.ElementDefinitionBinding{}): {
+		{"", "", "error", "", "valueSet.exists() implies (valueSet.startsWith('http:') or valueSet.startsWith('https') or valueSet.startsWith('urn:'))"},
+	},
+	reflect.TypeOf(fhir4.ElementDefinitionSlicing{}): {
+		{"", "", "error", "", "discriminator.exists() or description.exists()"},
+	},
+	reflect.TypeOf(fhir4.ElementDefinitionType{}): {
+		{"", "", "error", "", "aggregation.empty() or (code = 'Reference') or (code = 'canonical')"},
+		{"", "", "error", "", "(code='Reference' or code = 'canonical') or targetProfile.empty()"},
+	},
+	reflect.TypeOf(fhir4.FamilyMemberHistory{}): {
+		{"fhs-1", "", "error", "Can have age[x] or born[x], but not both", "age.empty() or born.empty()"},
+		{"fhs-2", "", "error", "Can only have estimatedAge if age[x] is present", "age.exists() or estimatedAge.empty()"},
+	},
+	reflect.TypeOf(fhir4.GoalTarget{}): {
+		{"gol-1", "", "error", "Goal.target.measure is required if Goal.target.detail is populated", "(detail.exists() and measure.exists()) or detail.exists().not()"},
+	},
+	reflect.TypeOf(fhir4.Group{}): {
+		{"", "", "error", "", "member.empty() or (actual = true)"},
+	},
+	reflect.TypeOf(fhir4.ImmunizationEducation{}): {
+		{"", "", "error", "", "documentType.exists() or reference.exists()"},
+	},
+	reflect.TypeOf(fhir4.ImmunizationRecommendationRecommendation{}): {
+		{"imr-1", "", "error", "One of vaccineCode or targetDisease SHALL be present", "vaccineCode.exists() or targetDisease.exists()"},
+	},
+	reflect.TypeOf(fhir4.ImplementationGuide{}): {
+		{"ig-2", "", "error", "If a resource has a fhirVersion, it must be one of the versions defined for the Implementation Guide", "definition.resource.fhirVersion.all(%context.fhirVersion contains $this)"},
+	},
+	reflect.TypeOf(fhir4.ImplementationGuideDefinition{}): {
+		{"ig-1", "", "error", "If a resource has a groupingId, it must refer to a grouping defined in the Implementation Guide", "resource.groupingId.all(%context.grouping.id contains $this)"},
+	},
+	reflect.TypeOf(fhir4.InsurancePlan{}): {
+		{"ipn-1", "", "error", "The organization SHALL at least have a name or an identifier, and possibly more than one", "(identifier.count() + name.count()) > 0"},
+	},
+	reflect.TypeOf(fhir4.Linkage{}): {
+		{"lnk-1", "", "error", "Must have at least two items", "item.count()>1"},
+	},
+	reflect.TypeOf(fhir4.List{}): {
+		{"lst-1", "", "error", "A list can only have an emptyReason if it is empty", "emptyReason.empty() or entry.empty()"},
+		{"", "", "error", "", "mode = 'changes' or entry.deleted.empty()"},
+		{"", "", "error", "", "mode = 'working' or entry.date.empty()"},
+	},
+	reflect.TypeOf(fhir4.Measure{}): {
+		{"mea-1", "", "error", "Stratifier SHALL be either a single criteria or a set of criteria components", "group.stratifier.all((code | description | criteria).exists() xor component.exists())"},
+	},
+	reflect.TypeOf(fhir4.MeasureReport{}): {
+		{"", "", "error", "", "(type != 'data-collection') or group.exists().not()"},
+		{"mrp-2", "", "error", "Stratifiers SHALL be either a single criteria or a set of criteria components", "group.stratifier.stratum.all(value.exists() xor component.exists())"},
+	},
+	reflect.TypeOf(fhir4.MedicationAdministrationDosage{}): {
+		{"", "", "error", "", "dose.exists() or rate.exists()"},
+	},
+	reflect.TypeOf(fhir4.MedicationDispense{}): {
+		{"mdd-1", "", "error", "whenHandedOver cannot be before whenPrepared", "whenHandedOver.empty() or whenPrepared.empty() or whenHandedOver >= whenPrepared"},
+	},
+	reflect.TypeOf(fhir4.MessageDefinitionFocus{}): {
+		{"md-1", "", "error", "Max must be postive int or *", "max='*' or (max.toInteger() > 0)"},
+	},
+	reflect.TypeOf(fhir4.MolecularSequence{}): {
+		{"", "", "error", "", "coordinateSystem = 1 or coordinateSystem = 0"},
+	},
+	reflect.TypeOf(fhir4.MolecularSequenceReferenceSeq{}): {
+		{"", "", "error", "", "(chromosome.empty() and genomeBuild.empty()) or (chromosome.exists() and genomeBuild.exists())"},
+		{"", "", "error", "", "(genomeBuild.count()+referenceSeqId.count()+ referenceSeqPointer.count()+ referenceSeqString.count()) = 1"},
+	},
+	reflect.TypeOf(fhir4.NamingSystem{}): {
+		{"nsd-1", "", "error", "Root systems cannot have uuid identifiers", "kind != 'root' or uniqueId.all(type != 'uuid')"},
+		{"nsd-2", "", "error", "Can't have more than one preferred identifier for a type", "uniqueId.where(preferred = true).select(type).isDistinct()"},
+	},
+	reflect.TypeOf(fhir4.Narrative{}): {
+		{"txt-2", "div", "error", "The narrative SHALL have some non-whitespace content", "htmlChecks()"},
+	},
+	reflect.TypeOf(fhir4.Observation{}): {
+		{"obs-6", "", "error", "dataAbsentReason SHALL only be present if Observation.value[x] is not present", "dataAbsentReason.empty() or value.empty()"},
+		{"obs-7", "", "error", "If Observation.component.code is the same as Observation.code, then Observation.value SHALL NOT be present (the Observation.component.value[x] holds the value).", "value.empty() or component.code.where(coding.intersect(%resource.code.coding).exists()).empty()"},
+	},
+	reflect.TypeOf(fhir4.ObservationReferenceRange{}): {
+		{"obs-3", "", "error", "Must have at least a low or a high or text", "low.exists() or high.exists() or text.exists()"},
+	},
+	reflect.TypeOf(fhir4.OperationDefinitionParameter{}): {
+		{"opd-1", "", "error", "Either a type must be provided, or parts", "type.exists() or part.exists()"},
+		{"opd-2", "", "error", "A search type can only be specified for parameters of type string", "searchType.exists() implies type = 'string'"},
+		{"", "", "error", "", "targetProfile.exists() implies (type = 'Reference' or type = 'canonical')"},
+	},
+	reflect.TypeOf(fhir4.Organization{}): {
+		{"org-1", "", "error", "The organization SHALL at least have a name or an identifier, and possibly more than one", "(identifier.count() + name.count()) > 0"},
+		{"", "telecom", "error", "", "where(use = 'home').empty()"},
+		{"", "address", "error", "", "where(use = 'home').empty()"},
+	},
+	reflect.TypeOf(fhir4.ParametersParameter{}): {
+		{"inv-1", "", "error", "A parameter must have one and only one of (value, resource, part)", "(part.exists() and value.empty() and resource.empty()) or (part.empty() and (value.exists() xor resource.exists()))"},
+	},
+	reflect.TypeOf(fhir4.PatientContact{}): {
+		{"pat-1", "", "error", "SHALL at least contain a contact's details or a reference to an organization", "name.exists() or telecom.exists() or address.exists() or organization.exists()"},
+	},
+	reflect.TypeOf(fhir4.Questionnaire{}): {
+		{"que-2", "", "error", "The link ids for groups and questions must be unique within the questionnaire", "descendants().linkId.isDistinct()"},
+	},
+	reflect.TypeOf(fhir4.QuestionnaireItem{}): {
+		{"", "", "error", "", "(type='group' implies item.empty().not()) and (type.trace('type')='display' implies item.trace('item').empty())"},
+		{"que-3", "", "error", "Display items cannot have a \"code\" asserted", "type!='display' or code.empty()"},
+		{"que-4", "", "error", "A question cannot have both answerOption and answerValueSet", "answerOption.empty() or answerValueSet.empty()"},
+		{"", "", "error", "", "(type ='choice' or type = 'open-choice' or type = 'decimal' or type = 'integer' or type = 'date' or type = 'dateTime' or type = 'time' or type = 'string' or type = 'quantity') or (answerValueSet.empty() and answerOption.empty())"},
+		{"que-6", "", "error", "Required and repeat aren't permitted for display items", "type!='display' or (required.empty() and repeats.empty())"},
+		{"que-8", "", "error", "Initial values can't be specified for groups or display items", "(type!='group' and type!='display') or initial.empty()"},
+		{"que-9", "", "error", "Read-only can't be specified for \"display\" items", "type!='display' or readOnly.empty()"},
+		{"", "", "error", "", "(type in ('boolean' | 'decimal' | 'integer' | 'string' | 'text' | 'url' | 'open-choice')) or maxLength.empty()"},
+		{"que-11", "", "error", "If one or more answerOption is present, initial cannot be present.  Use answerOption.initialSelected instead", "answerOption.empty() or initial.empty()"},
+		{"", "", "error", "", "enableWhen.count() > 2 implies enableBehavior.exists()"},
+		{"que-13", "", "error", "Can only have multiple initial values for repeating items", "repeats=true or initial.count() <= 1"},
+	},
+	reflect.TypeOf(fhir4.QuestionnaireItemEnableWhen{}): {
+		{"", "", "error", "", "operator = 'exists' implies (answer is Boolean)"},
+	},
+	reflect.TypeOf(fhir4.QuestionnaireResponseItem{}): {
+		{"qrs-1", "", "error", "Item cannot contain both item and answer", "(answer.exists() and item.exists()).not()"},
+	},
+	reflect.TypeOf(fhir4.Range{}): {
+		{"", "", "error", "", "low.empty() or high.empty() or (low <= high)"},
+	},
+	reflect.TypeOf(fhir4.Ratio{}): {
+		{"", "", "error", "", "(numerator.empty() xor denominator.exists()) and (numerator.exists() or extension.exists())"},
+	},
+	reflect.TypeOf(fhir4.RequestGroupAction{}): {
+		{"rqg-1", "", "error", "Must have resource or action but not both", "resource.exists() != action.exists()"},
+	},
+	reflect.TypeOf(fhir4.SearchParameter{}): {
+		{"", "", "error", "", "xpath.empty() or xpathUsage.exists()"},
+		{"spd-2", "", "error", "Search parameters can only have chain names when the search parameter type is 'reference'", "chain.empty() or type = 'reference'"},
+	},
+	reflect.TypeOf(fhir4.ServiceRequest{}): {
+		{"prr-1", "", "error", "orderDetail SHALL only be present if code is present", "orderDetail.empty() or code.exists()"},
+	},
+	reflect.TypeOf(fhir4.StructureDefinition{}): {
+		{"sdf-1", "", "error", "Element paths must be unique unless the structure is a constraint", "derivation = 'constraint' or snapshot.element.select(path).isDistinct()"},
+		{"sdf-15a", "", "error", "If the first element in a differential has no \".\" in the path and it's not a logical model, it has no type", "(kind!='logical'  and differential.element.first().path.contains('.').not()) implies differential.element.first().type.empty()"},
+		{"sdf-4", "", "error", "If the structure is not abstract, then there SHALL be a baseDefinition", "abstract = true or baseDefinition.exists()"},
+		{"sdf-5", "", "error", "If the structure defines an extension then the structure must have context information", "type != 'Extension' or derivation = 'specialization' or (context.exists())"},
+		{"sdf-6", "", "error", "A structure must have either a differential, or a snapshot (or both)", "snapshot.exists() or differential.exists()"},
+		{"sdf-9", "", "error", "In any snapshot or differential, no label, code or requirements on an element without a \".\" in the path (e.g. the first element)", "children().element.where(path.contains('.').not()).label.empty() and children().element.where(path.contains('.').not()).code.empty() and children().element.where(path.contains('.').not()).requirements.empty()"},
+		{"sdf-11", "", "error", "If there's a type, its content must match the path name in the first element of a snapshot", "kind != 'logical' implies snapshot.empty() or snapshot.element.first().path = type"},
+		{"sdf-14", "", "error", "All element definitions must have an id", "snapshot.element.all(id.exists()) and differential.element.all(id.exists())"},
+		{"", "", "error", "", "kind!='logical' implies snapshot.element.first().type.empty()"},
+		{"sdf-16", "", "error", "All element definitions must have unique ids (snapshot)", "snapshot.element.all(id.exists()) and snapshot.element.id.trace('ids').isDistinct()"},
+		{"sdf-17", "", "error", "All element definitions must have unique ids (diff)", "differential.element.all(id.exists()) and differential.element.id.trace('ids').isDistinct()"},
+		{"sdf-18", "", "error", "Context Invariants can only be used for extensions", "contextInvariant.exists() implies type = 'Extension'"},
+		{"", "", "error", "", "url.startsWith('http://hl7.org/fhir/StructureDefinition') implies (differential.element.type.code.all(matches('^[a-zA-Z0-9]+$') or matches('^http:\\\\/\\\\/hl7\\\\.org\\\\/fhirpath\\\\/System\\\\.[A-Z][A-Za-z]+$')) and snapshot.element.type.code.all(matches('^[a-zA-Z0-9\\\\.]+$') or matches('^http:\\\\/\\\\/hl7\\\\.org\\\\/fhirpath\\\\/System\\\\.[A-Z][A-Za-z]+$')))"},
+		{"sdf-21", "", "error", "Default values can only be specified on specializations", "differential.element.defaultValue.exists() implies (derivation = 'specialization')"},
+		{"sdf-22", "", "error", "FHIR Specification models never have default values", "url.startsWith('http://hl7.org/fhir/StructureDefinition') implies (snapshot.element.defaultValue.empty() and differential.element.defaultValue.empty())"},
+		{"sdf-23", "", "error", "No slice name on root", "(snapshot | differential).element.all(path.contains('.').not() implies sliceName.empty())"},
+	},
+	reflect.TypeOf(fhir4.StructureDefinitionDifferential{}): {
+		{"sdf-20", "", "error", "No slicing on the root element", "element.where(path.contains('.').not()).slicing.empty()"},
+		{"", "", "error", "", "(%resource.kind = 'logical' or element.first().path.startsWith(%resource.type)) and (element.tail().empty() or element.tail().all(path.startsWith(%resource.differential.element.first().path.replaceMatches('\\\\..*','')&'.')))"},
+	},
+	reflect.TypeOf(fhir4.StructureDefinitionMapping{}): {
+		{"sdf-2", "", "error", "Must have at least a name or a uri (or both)", "name.exists() or uri.exists()"},
+	},
+	reflect.TypeOf(fhir4.StructureDefinitionSnapshot{}): {
+		{"", "", "error", "", "element.all(definition.exists() and min.exists() and max.exists())"},
+		{"sdf-8", "", "error", "All snapshot elements must start with the StructureDefinition's specified type for non-logical models, or with the same type name for logical models", "(%resource.kind = 'logical' or element.first().path = %resource.type) and element.tail().all(path.startsWith(%resource.snapshot.element.first().path&'.'))"},
+		{"sdf-8b", "", "error", "All snapshot elements must have a base definition", "element.all(base.exists())"},
+		{"sdf-10", "element", "error", "provide either a binding reference or a description (or both)", "binding.empty() or binding.valueSet.exists() or binding.description.exists()"},
+	},
+	reflect.TypeOf(fhir4.StructureMapGroupRuleTarget{}): {
+		{"smp-1", "", "error", "Can only have an element if you have a context", "element.exists() implies context.exists()"},
+		{"", "", "error", "", "context.exists() implies contextType.exists()"},
+	},
+	reflect.TypeOf(fhir4.Task{}): {
+		{"inv-1", "", "error", "Last modified date must be greater than or equal to authored-on date.", "lastModified.exists().not() or authoredOn.exists().not() or lastModified >= authoredOn"},
+	},
+	reflect.TypeOf(fhir4.TerminologyCapabilities{}): {
+		{"tcp-2", "", "error", "A Terminology Capability statement SHALL have at least one of description, software, or implementation element", "(description.count() + software.count() + implementation.count()) > 0"},
+		{"tcp-3", "", "error", "If kind = instance, implementation must be present and software may be present", "(kind != 'instance') or implementation.exists()"},
+		{"tcp-4", "", "error", "If kind = capability, implementation must be absent, software must be present", "(kind != 'capability') or (implementation.exists().not() and software.exists())"},
+		{"tcp-5", "", "error", "If kind = requirements, implementation and software must be absent", "(kind!='requirements') or (implementation.exists().not() and software.exists().not())"},
+	},
+	reflect.TypeOf(fhir4.TerminologyCapabilitiesCodeSystem{}): {
+		{"tcp-1", "", "error", "If there is more than one version, a version code must be defined", "version.count() > 1 implies version.all(code.exists())"},
+	},
+	reflect.TypeOf(fhir4.TestReportSetupAction{}): {
+		{"inv-1", "", "error", "Setup action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir4.TestReportTestAction{}): {
+		{"inv-2", "", "error", "Test action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir4.TestScriptMetadata{}): {
+		{"tst-4", "", "error", "TestScript metadata capability SHALL contain required or validated or both.", "capability.required.exists() or capability.validated.exists()"},
+	},
+	reflect.TypeOf(fhir4.TestScriptSetupAction{}): {
+		{"tst-1", "", "error", "Setup action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir4.TestScriptSetupActionAssert{}): {
+		{"", "", "error", "", "extension.exists() or (contentType.count() + expression.count() + headerField.count() + minimumId.count() + navigationLinks.count() + path.count() + requestMethod.count() + resource.count() + responseCode.count() + response.count()  + validateProfileId.count() <=1)"},
+		{"tst-10", "", "error", "Setup action assert SHALL contain either compareToSourceId and compareToSourceExpression, compareToSourceId and compareToSourcePath or neither.", "compareToSourceId.empty() xor (compareToSourceExpression.exists() or compareToSourcePath.exists())"},
+		{"tst-12", "", "error", "Setup action assert response and responseCode SHALL be empty when direction equals request", "(response.empty() and responseCode.empty() and direction = 'request') or direction.empty() or direction = 'response'"},
+	},
+	reflect.TypeOf(fhir4.TestScriptSetupActionOperation{}): {
+		{"tst-7", "", "error", "Setup operation SHALL contain either sourceId or targetId or params or url.", "sourceId.exists() or (targetId.count() + url.count() + params.count() = 1) or (type.code in ('capabilities' |'search' | 'transaction' | 'history'))"},
+	},
+	reflect.TypeOf(fhir4.TestScriptTestAction{}): {
+		{"tst-2", "", "error", "Test action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir4.TestScriptVariable{}): {
+		{"tst-3", "", "error", "Variable can only contain one of expression, headerField or path.", "expression.empty() or headerField.empty() or path.empty()"},
+	},
+	reflect.TypeOf(fhir4.TimingRepeat{}): {
+		{"tim-1", "", "error", "if there's a duration, there needs to be duration units", "duration.empty() or durationUnit.exists()"},
+		{"tim-2", "", "error", "if there's a period, there needs to be period units", "period.empty() or periodUnit.exists()"},
+		{"tim-4", "", "error", "duration SHALL be a non-negative value", "duration.exists() implies duration >= 0"},
+		{"tim-5", "", "error", "period SHALL be a non-negative value", "period.exists() implies period >= 0"},
+		{"tim-6", "", "error", "If there's a periodMax, there must be a period", "periodMax.empty() or period.exists()"},
+		{"tim-7", "", "error", "If there's a durationMax, there must be a duration", "durationMax.empty() or duration.exists()"},
+		{"tim-8", "", "error", "If there's a countMax, there must be a count", "countMax.empty() or count.exists()"},
+		{"", "", "error", "", "offset.empty() or (when.exists() and ((when in ('C' | 'CM' | 'CD' | 'CV')).not()))"},
+		{"tim-10", "", "error", "If there's a timeOfDay, there cannot be a when, or vice versa", "timeOfDay.empty() or when.empty()"},
+	},
+	reflect.TypeOf(fhir4.ValueSetComposeInclude{}): {
+		{"vsd-1", "", "error", "A value set include/exclude SHALL have a value set or a system", "valueSet.exists() or system.exists()"},
+		{"vsd-2", "", "error", "A value set with concepts or filters SHALL include a system", "(concept.exists() or filter.exists()) implies system.exists()"},
+		{"vsd-3", "", "error", "Cannot have both concept and filter", "concept.empty() or filter.empty()"},
+	},
+	reflect.TypeOf(fhir4.ValueSetExpansionContains{}): {
+		{"vsd-6", "", "error", "SHALL have a code or a display", "code.exists() or display.exists()"},
+		{"vsd-9", "", "error", "SHALL have a code if not abstract", "code.exists() or abstract = true"},
+		{"vsd-10", "", "error", "SHALL have a system if a code is present", "code.empty() or system.exists()"},
+	},
+}
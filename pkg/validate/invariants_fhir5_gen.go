@@ -0,0 +1,747 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// fhir5Invariants lists the FHIRPath constraints of each struct of the
+// fhir5 package, element is the field a constraint applies to or empty for
+// the struct itself
+var fhir5Invariants = map[reflect.Type][]invariant{
+	reflect.TypeOf(common.Attachment{}): {
+		{"att-1", "", "error", "If the Attachment has data, it SHALL have a contentType", "data.empty() or contentType.exists()"},
+	},
+	reflect.TypeOf(common.Coding{}): {
+		{"cod-1", "", "warning", "A Coding SHOULD NOT have a display unless a code is also present.  Computation on Coding.display alone is generally unsafe.  Consider using CodeableConcept.text", "code.exists().not() implies display.exists().not()"},
+	},
+	reflect.TypeOf(common.ContactPoint{}): {
+		{"cpt-2", "", "error", "A system is required if a value is provided.", "value.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.Count{}): {
+		{"cnt-3", "", "error", "There SHALL be a code with a value of \"1\" if there is a value. If system is present, it SHALL be UCUM.  If present, the value SHALL be a whole number.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum) and (code.empty() or code = '1') and (value.empty() or value.hasValue().not() or value.toString().contains('.').not())"},
+	},
+	reflect.TypeOf(common.DataRequirementCodeFilter{}): {
+		{"drq-1", "", "error", "Either a path or a searchParam must be provided, but not both", "path.exists() xor searchParam.exists()"},
+	},
+	reflect.TypeOf(common.DataRequirementDateFilter{}): {
+		{"drq-2", "", "error", "Either a path or a searchParam must be provided, but not both", "path.exists() xor searchParam.exists()"},
+	},
+	reflect.TypeOf(common.Distance{}): {
+		{"dis-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of length.  If system is present, it SHALL be UCUM.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum)"},
+	},
+	reflect.TypeOf(common.Dosage{}): {
+		{"dos-1", "", "error", "AsNeededFor can only be set if AsNeeded is empty or true", "asNeededFor.empty() or asNeeded.empty() or asNeeded"},
+	},
+	reflect.TypeOf(common.Duration{}): {
+		{"drt-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of time.  If system is present, it SHALL be UCUM.", "code.exists() implies ((system = %ucum) and value.exists())"},
+	},
+	reflect.TypeOf(common.Element{}): {
+		{"ele-1", "", "error", "All FHIR elements must have a @value or children", "hasValue() or (children().count() > id.count())"},
+	},
+	reflect.TypeOf(common.Expression{}): {
+		{"exp-1", "", "error", "An expression or a reference must be provided", "expression.exists() or reference.exists()"},
+		{"exp-2", "", "error", "The name must be a valid variable name in most computer languages", "name.hasValue() implies name.matches('[A-Za-z][A-Za-z0-9\\\\_]{0,63}')"},
+	},
+	reflect.TypeOf(common.Extension{}): {
+		{"ext-1", "", "error", "Must have either extensions or value[x], not both", "extension.exists() != value.exists()"},
+	},
+	reflect.TypeOf(common.Identifier{}): {
+		{"ident-1", "", "warning", "Identifier with no value has limited utility.  If communicating that an identifier value has been suppressed or missing, the value element SHOULD be present with an extension indicating the missing semantic - e.g. data-absent-reason", "value.exists()"},
+	},
+	reflect.TypeOf(common.Period{}): {
+		{"per-1", "", "error", "If present, start SHALL have a lower or equal value than end", "start.hasValue().not() or end.hasValue().not() or (start.lowBoundary() <= end.highBoundary())"},
+	},
+	reflect.TypeOf(common.Quantity{}): {
+		{"qty-3", "", "error", "If a code for the unit is present, the system SHALL also be present", "code.empty() or system.exists()"},
+	},
+	reflect.TypeOf(common.Range{}): {
+		{"rng-2", "", "error", "If present, low SHALL have a lower value than high", "low.value.empty() or high.value.empty() or low.lowBoundary().comparable(high.highBoundary()).not() or (low.lowBoundary() <= high.highBoundary())"},
+	},
+	reflect.TypeOf(common.Ratio{}): {
+		{"rat-1", "", "error", "Numerator and denominator SHALL both be present, or both are absent. If both are absent, there SHALL be some extension present", "(numerator.exists() and denominator.exists()) or (numerator.empty() and denominator.empty() and extension.exists())"},
+	},
+	reflect.TypeOf(common.Reference{}): {
+		{"ref-1", "", "error", "SHALL have a contained resource if a local reference is provided", "reference.exists()  implies (reference.startsWith('#').not() or (reference.substring(1).trace('url') in %rootResource.contained.id.trace('ids')) or (reference='#' and %rootResource!=%resource))"},
+		{"ref-2", "", "error", "At least one of reference, identifier and display SHALL be present (unless an extension is provided).", "reference.exists() or identifier.exists() or display.exists() or extension.exists()"},
+	},
+	reflect.TypeOf(common.SampledData{}): {
+		{"sdd-1", "", "error", "A SampledData SAHLL have either an interval and offsets but not both", "interval.exists().not() xor offsets.exists().not()"},
+	},
+	reflect.TypeOf(common.TimingRepeat{}): {
+		{"tim-1", "", "error", "if there's a duration, there needs to be duration units", "duration.empty() or durationUnit.exists()"},
+		{"tim-2", "", "error", "if there's a period, there needs to be period units", "period.empty() or periodUnit.exists()"},
+		{"tim-4", "", "error", "duration SHALL be a non-negative value", "duration.exists() implies duration >= 0"},
+		{"tim-5", "", "error", "period SHALL be a non-negative value", "period.exists() implies period >= 0"},
+		{"tim-6", "", "error", "If there's a periodMax, there must be a period", "periodMax.empty() or period.exists()"},
+		{"tim-7", "", "error", "If there's a durationMax, there must be a duration", "durationMax.empty() or duration.exists()"},
+		{"tim-8", "", "error", "If there's a countMax, there must be a count", "countMax.empty() or count.exists()"},
+		{"tim-9", "", "error", "If there's an offset, there must be a when (and not C, CM, CD, CV)", "offset.empty() or (when.exists() and when.select($this in ('C' | 'CM' | 'CD' | 'CV')).allFalse())"},
+		{"tim-10", "", "error", "If there's a timeOfDay, there cannot be a when, or vice versa", "timeOfDay.empty() or when.empty()"},
+	},
+	reflect.TypeOf(common.TriggerDefinition{}): {
+		{"trd-1", "", "error", "Either timing, or a data requirement, but not both", "data.empty() or timing.empty()"},
+		{"trd-2", "", "error", "A condition only if there is a data requirement", "condition.exists() implies data.exists()"},
+		{"trd-3", "", "error", "A named event requires a name, a periodic event requires timing, and a data event requires data", "(type = 'named-event' implies name.exists()) and (type = 'periodic' implies timing.exists()) and (type.startsWith('data-') implies data.exists())"},
+	},
+	reflect.TypeOf(fhir5.AccountDiagnosis{}): {
+		{"act-1", "", "error", "The dateOfDiagnosis is not valid when using a reference to a diagnosis", "condition.reference.empty().not() implies dateOfDiagnosis.empty()"},
+	},
+	reflect.TypeOf(fhir5.AccountProcedure{}): {
+		{"act-2", "", "error", "The dateOfService is not valid when using a reference to a procedure", "code.reference.empty().not() implies dateOfService.empty()"},
+	},
+	reflect.TypeOf(fhir5.ActivityDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ActorDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.AdministrableProductDefinition{}): {
+		{"apd-1", "", "error", "RouteOfAdministration cannot be used when the 'formOf' product already uses MedicinalProductDefinition.route (and vice versa)", "AdministrableProductDefinition.formOf.resolve().route.empty()"},
+	},
+	reflect.TypeOf(fhir5.Appointment{}): {
+		{"app-2", "", "error", "Either start and end are specified, or neither", "start.exists() = end.exists()"},
+		{"app-3", "", "error", "Only proposed or cancelled appointments can be missing start/end dates", "(start.exists() and end.exists()) or (status in ('proposed' | 'cancelled' | 'waitlist'))"},
+		{"app-4", "", "error", "Cancellation reason is only used for appointments that have been cancelled, or noshow", "cancellationReason.exists() implies (status='noshow' or status='cancelled')"},
+		{"app-5", "", "error", "The start must be less than or equal to the end", "start.exists() implies start <= end"},
+		{"app-6", "", "warning", "An appointment may have an originatingAppointment or recurrenceTemplate, but not both", "originatingAppointment.exists().not() or recurrenceTemplate.exists().not()"},
+		{"app-7", "", "error", "Cancellation date is only used for appointments that have been cancelled, or noshow", "cancellationDate.exists() implies (status='noshow' or status='cancelled')"},
+	},
+	reflect.TypeOf(fhir5.AppointmentParticipant{}): {
+		{"app-1", "", "error", "Either the type or actor on the participant SHALL be specified", "type.exists() or actor.exists()"},
+	},
+	reflect.TypeOf(fhir5.AppointmentResponse{}): {
+		{"apr-1", "", "error", "Either the participantType or actor must be specified", "participantType.exists() or actor.exists()"},
+	},
+	reflect.TypeOf(fhir5.Attachment{}): {
+		{"att-1", "", "error", "If the Attachment has data, it SHALL have a contentType", "data.empty() or contentType.exists()"},
+	},
+	reflect.TypeOf(fhir5.AvailabilityAvailableTime{}): {
+		{"av-1", "", "error", "Cannot include start/end times when selecting all day availability.", "allDay.exists().not() or (allDay implies availableStartTime.exists().not() and availableEndTime.exists().not())"},
+	},
+	reflect.TypeOf(fhir5.Bundle{}): {
+		{"bdl-1", "", "error", "total only when a search or history", "total.empty() or (type = 'searchset') or (type = 'history')"},
+		{"bdl-2", "", "error", "entry.search only when a search", "(type = 'searchset') or entry.search.empty()"},
+		{"bdl-7", "", "error", "FullUrl must be unique in a bundle, or else entries with the same fullUrl must have different meta.versionId (except in history bundles)", "(type = 'history') or entry.where(fullUrl.exists()).select(fullUrl&iif(resource.meta.versionId.exists(), resource.meta.versionId, '')).isDistinct()"},
+		{"bdl-9", "", "error", "A document must have an identifier with a system and a value", "type = 'document' implies (identifier.system.exists() and identifier.value.exists())"},
+		{"bdl-10", "", "error", "A document must have a date", "type = 'document' implies (timestamp.hasValue())"},
+		{"bdl-11", "", "error", "A document must have a Composition as the first resource", "type = 'document' implies entry.first().resource.is(Composition)"},
+		{"bdl-12", "", "error", "A message must have a MessageHeader as the first resource", "type = 'message' implies entry.first().resource.is(MessageHeader)"},
+		{"bdl-13", "", "error", "A subscription-notification must have a SubscriptionStatus as the first resource", "type = 'subscription-notification' implies entry.first().resource.is(SubscriptionStatus)"},
+		{"bdl-14", "", "error", "entry.request.method PATCH not allowed for history", "type = 'history' implies entry.request.method != 'PATCH'"},
+		{"bdl-15", "", "error", "Bundle resources where type is not transaction, transaction-response, batch, or batch-response or when the request is a POST SHALL have Bundle.entry.fullUrl populated", "type='transaction' or type='transaction-response' or type='batch' or type='batch-response' or entry.all(fullUrl.exists() or request.method='POST')"},
+		{"bdl-16", "", "error", "Issue.severity for all issues within the OperationOutcome must be either 'information' or 'warning'.", "issues.exists() implies (issues.issue.severity = 'information' or issues.issue.severity = 'warning')"},
+		{"bdl-17", "", "error", "Use and meaning of issues for documents has not been validated because the content will not be rendered in the document.", "type = 'document' implies issues.empty()"},
+		{"bdl-18", "", "error", "Self link is required for searchsets.", "type = 'searchset' implies link.where(relation = 'self' and url.exists()).exists()"},
+		{"bdl-3a", "", "error", "For collections of type document, message, searchset or collection, all entries must contain resources, and not have request or response elements", "type in ('document' | 'message' | 'searchset' | 'collection') implies entry.all(resource.exists() and request.empty() and response.empty())"},
+		{"bdl-3b", "", "error", "For collections of type history, all entries must contain request or response elements, and resources if the method is POST, PUT or PATCH", "type = 'history' implies entry.all(request.exists() and response.exists() and ((request.method in ('POST' | 'PATCH' | 'PUT')) = resource.exists()))"},
+		{"bdl-3c", "", "error", "For collections of type transaction or batch, all entries must contain request elements, and resources if the method is POST, PUT or PATCH", "type in ('transaction' | 'batch') implies entry.all(request.method.exists() and ((request.method in ('POST' | 'PATCH' | 'PUT')) = resource.exists()))"},
+		{"bdl-3d", "", "error", "For collections of type transaction-response or batch-response, all entries must contain response elements", "type in ('transaction-response' | 'batch-response') implies entry.all(response.exists())"},
+	},
+	reflect.TypeOf(fhir5.BundleEntry{}): {
+		{"bdl-5", "", "error", "must be a resource unless there's a request or response", "resource.exists() or request.exists() or response.exists()"},
+		{"bdl-8", "", "error", "fullUrl cannot be a version specific reference", "fullUrl.exists() implies fullUrl.contains('/_history/').not()"},
+	},
+	reflect.TypeOf(fhir5.CapabilityStatement{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cpb-1", "", "error", "A Capability Statement SHALL have at least one of REST, messaging or document element.", "rest.exists() or messaging.exists() or document.exists()"},
+		{"cpb-2", "", "error", "A Capability Statement SHALL have at least one of description, software, or implementation element.", "(description.count() + software.count() + implementation.count()) > 0"},
+		{"cpb-3", "", "error", "Messaging end-point is only permitted when a capability statement is for an implementation.", "messaging.endpoint.empty() or kind = 'instance'"},
+		{"cpb-4", "", "error", "There should only be one CapabilityStatement.rest per mode.", "rest.mode.isDistinct()"},
+		{"cpb-7", "", "error", "The set of documents must be unique by the combination of profile and mode.", "document.select(profile&mode).isDistinct()"},
+		{"cpb-14", "", "error", "If kind = instance, implementation must be present and software may be present", "(kind != 'instance') or implementation.exists()"},
+		{"cpb-15", "", "error", "If kind = capability, implementation must be absent, software must be present", "(kind != 'capability') or (implementation.exists().not() and software.exists())"},
+		{"cpb-16", "", "error", "If kind = requirements, implementation and software must be absent", "(kind!='requirements') or (implementation.exists().not() and software.exists().not())"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.CapabilityStatementRest{}): {
+		{"cpb-9", "", "error", "A given resource can only be described once per RESTful mode.", "resource.select(type).isDistinct()"},
+	},
+	reflect.TypeOf(fhir5.CapabilityStatementRestResource{}): {
+		{"cpb-12", "", "error", "Search parameter names must be unique in the context of a resource.", "searchParam.select(name).isDistinct()"},
+	},
+	reflect.TypeOf(fhir5.CareTeamParticipant{}): {
+		{"ctm-1", "", "error", "CareTeam.participant.onBehalfOf can only be populated when CareTeam.participant.member is a Practitioner", "onBehalfOf.exists() implies (member.resolve() is Practitioner)"},
+		{"ctm-2", "", "warning", "CareTeam.participant.role or CareTeam.participant.member exists", "role.exists() or member.exists()"},
+	},
+	reflect.TypeOf(fhir5.ChargeItemDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.Citation{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ClinicalUseDefinition{}): {
+		{"cud-1", "", "error", "Indication, Contraindication, Interaction, UndesirableEffect and Warning cannot be used in the same instance", "(ClinicalUseDefinition.indication.count() + ClinicalUseDefinition.contraindication.count() + ClinicalUseDefinition.interaction.count() + ClinicalUseDefinition.undesirableEffect.count() + ClinicalUseDefinition.warning.count())  < 2"},
+	},
+	reflect.TypeOf(fhir5.CodeSystem{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"csd-1", "", "error", "Within a code system definition, all the codes SHALL be unique", "concept.exists() implies concept.code.combine(%resource.concept.descendants().concept.code).isDistinct()"},
+		{"csd-2", "", "warning", "If there is an explicit hierarchy, a hierarchyMeaning should be provided", "concept.concept.exists() implies hierarchyMeaning.exists()"},
+		{"csd-3", "", "warning", "If there is an implicit hierarchy, a hierarchyMeaning should be provided", "concept.where(property.code = 'parent' or property.code = 'child').exists() implies hierarchyMeaning.exists()"},
+		{"csd-4", "", "error", "If the code system content = supplement, it must nominate what it's a supplement for", "CodeSystem.content = 'supplement' implies CodeSystem.supplements.exists()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.CodeSystemConceptDesignation{}): {
+		{"csd-5", "", "error", "Must have a value for concept.designation.use if concept.designation.additionalUse is present", "additionalUse.exists() implies use.exists()"},
+	},
+	reflect.TypeOf(fhir5.CompartmentDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.CompositionSection{}): {
+		{"cmp-1", "", "error", "A section must contain at least one of text, entries, or sub-sections", "text.exists() or entry.exists() or section.exists()"},
+		{"cmp-2", "", "error", "A section can only have an emptyReason if it is empty", "emptyReason.empty() or entry.empty()"},
+	},
+	reflect.TypeOf(fhir5.ConceptMap{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ConceptMapGroupElement{}): {
+		{"cmd-4", "", "error", "If noMap is present, target SHALL NOT be present", "(noMap.exists() and noMap=true) implies target.empty()"},
+		{"cmd-5", "", "error", "Either code or valueSet SHALL be present but not both.", "(code.exists() and valueSet.empty()) or (code.empty() and valueSet.exists())"},
+	},
+	reflect.TypeOf(fhir5.ConceptMapGroupElementTarget{}): {
+		{"cmd-1", "", "error", "If the map is source-is-broader-than-target or not-related-to, there SHALL be some comments, unless the status is 'draft'", "comment.exists() or (%resource.status = 'draft') or relationship.empty() or ((relationship != 'source-is-broader-than-target') and (relationship != 'not-related-to'))"},
+		{"cmd-7", "", "error", "Either code or valueSet SHALL be present but not both.", "(code.exists() and valueSet.empty()) or (code.empty() and valueSet.exists())"},
+	},
+	reflect.TypeOf(fhir5.ConceptMapGroupElementTargetDependsOn{}): {
+		{"cmd-6", "", "error", "One of value[x] or valueSet must exist, but not both.", "(value.exists() and valueSet.empty()) or (value.empty() and valueSet.exists())"},
+	},
+	reflect.TypeOf(fhir5.ConceptMapGroupUnmapped{}): {
+		{"cmd-2", "", "error", "If the mode is 'fixed', either a code or valueSet must be provided, but not both.", "(mode = 'fixed') implies ((code.exists() and valueSet.empty()) or (code.empty() and valueSet.exists()))"},
+		{"cmd-3", "", "error", "If the mode is 'other-map', a url for the other map must be provided", "(mode = 'other-map') implies otherMap.exists()"},
+		{"cmd-8", "", "error", "If the mode is not 'fixed', code, display and valueSet are not allowed", "(mode != 'fixed') implies (code.empty() and display.empty() and valueSet.empty())"},
+		{"cmd-9", "", "error", "If the mode is not 'other-map', relationship must be provided", "(mode != 'other-map') implies relationship.exists()"},
+		{"cmd-10", "", "error", "If the mode is not 'other-map', otherMap is not allowed", "(mode != 'other-map') implies otherMap.empty()"},
+	},
+	reflect.TypeOf(fhir5.ConceptMapProperty{}): {
+		{"cmd-11", "", "error", "If the property type is code, a system SHALL be specified", "type = 'code' implies system.exists()"},
+	},
+	reflect.TypeOf(fhir5.Condition{}): {
+		{"con-2", "", "warning", "If category is problems list item, the clinicalStatus should not be unknown", "category.coding.where(system='http://terminology.hl7.org/CodeSystem/condition-category' and code='problem-list-item').exists() implies clinicalStatus.coding.where(system='http://terminology.hl7.org/CodeSystem/condition-clinical' and code='unknown').exists().not()"},
+		{"con-3", "", "error", "If condition is abated, then clinicalStatus must be either inactive, resolved, or remission.", "abatement.exists() implies (clinicalStatus.coding.where(system='http://terminology.hl7.org/CodeSystem/condition-clinical' and (code='inactive' or code='resolved' or code='remission')).exists())"},
+	},
+	reflect.TypeOf(fhir5.ConditionDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ConditionStage{}): {
+		{"con-1", "", "error", "Stage SHALL have summary or assessment", "summary.exists() or assessment.exists()"},
+	},
+	reflect.TypeOf(fhir5.ContactPoint{}): {
+		{"cpt-2", "", "error", "A system is required if a value is provided.", "value.empty() or system.exists()"},
+	},
+	reflect.TypeOf(fhir5.Count{}): {
+		{"cnt-3", "", "error", "There SHALL be a code with a value of \"1\" if there is a value. If system is present, it SHALL be UCUM.  If present, the value SHALL be a whole number.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum) and (code.empty() or code = '1') and (value.empty() or value.hasValue().not() or value.toString().contains('.').not())"},
+	},
+	reflect.TypeOf(fhir5.CoverageEligibilityResponseInsuranceItem{}): {
+		{"ces-1", "", "error", "SHALL contain a category or a billcode but not both.", "category.exists() xor productOrService.exists()"},
+	},
+	reflect.TypeOf(fhir5.DataRequirementCodeFilter{}): {
+		{"drq-1", "", "error", "Either a path or a searchParam must be provided, but not both", "path.exists() xor searchParam.exists()"},
+	},
+	reflect.TypeOf(fhir5.DataRequirementDateFilter{}): {
+		{"drq-2", "", "error", "Either a path or a searchParam must be provided, but not both", "path.exists() xor searchParam.exists()"},
+	},
+	reflect.TypeOf(fhir5.Device{}): {
+		{"dev-1", "", "error", "only one Device.name.display SHALL be true when there is more than one Device.name", "name.where(display=true).count() <= 1"},
+	},
+	reflect.TypeOf(fhir5.DiagnosticReport{}): {
+		{"dgr-1", "", "error", "When a Composition is referenced in `Diagnostic.composition`, all Observation resources referenced in `Composition.entry` must also be referenced in `Diagnostic.entry` or in the references Observations in `Observation.hasMember`", "composition.exists() implies (composition.resolve().section.entry.reference.where(resolve() is Observation) in (result.reference|result.reference.resolve().hasMember.reference))"},
+	},
+	reflect.TypeOf(fhir5.Distance{}): {
+		{"dis-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of length.  If system is present, it SHALL be UCUM.", "(code.exists() or value.empty()) and (system.empty() or system = %ucum)"},
+	},
+	reflect.TypeOf(fhir5.DocumentReference{}): {
+		{"docRef-1", "", "warning", "facilityType SHALL only be present if context is not an encounter", "facilityType.empty() or context.where(resolve() is Encounter).empty()"},
+		{"docRef-2", "", "warning", "practiceSetting SHALL only be present if context is not present", "practiceSetting.empty() or context.where(resolve() is Encounter).empty()"},
+	},
+	reflect.TypeOf(fhir5.DomainResource{}): {
+		{"dom-2", "", "error", "If the resource is contained in another resource, it SHALL NOT contain nested Resources", "contained.contained.empty()"},
+		{"dom-3", "", "error", "If the resource is contained in another resource, it SHALL be referred to from elsewhere in the resource or SHALL refer to the containing resource", "contained.where((('#'+id in (%resource.descendants().reference | %resource.descendants().ofType(canonical) | %resource.descendants().ofType(uri) | %resource.descendants().ofType(url))) or descendants().where(reference = '#').exists() or descendants().where(ofType(canonical) = '#').exists() or descendants().where(ofType(canonical) = '#').exists()).not()).trace('unmatched', id).empty()"},
+		{"dom-4", "", "error", "If a resource is contained in another resource, it SHALL NOT have a meta.versionId or a meta.lastUpdated", "contained.meta.versionId.empty() and contained.meta.lastUpdated.empty()"},
+		{"dom-5", "", "error", "If a resource is contained in another resource, it SHALL NOT have a security label", "contained.meta.security.empty()"},
+		{"dom-6", "", "warning", "A resource should have narrative for robust management", "text.`div`.exists()"},
+	},
+	reflect.TypeOf(fhir5.Dosage{}): {
+		{"dos-1", "", "error", "AsNeededFor can only be set if AsNeeded is empty or true", "asNeededFor.empty() or asNeeded.empty() or asNeeded"},
+	},
+	reflect.TypeOf(fhir5.Duration{}): {
+		{"drt-1", "", "error", "There SHALL be a code if there is a value and it SHALL be an expression of time.  If system is present, it SHALL be UCUM.", "code.exists() implies ((system = %ucum) and value.exists())"},
+	},
+	reflect.TypeOf(fhir5.ElementDefinition{}): {
+		{"eld-2", "", "error", "Min <= Max", "min.empty() or max.empty() or (max = '*') or iif(max != '*', min <= max.toInteger())"},
+		{"eld-5", "", "error", "if the element definition has a contentReference, it cannot have type, defaultValue, fixed, pattern, example, minValue, maxValue, maxLength, or binding", "contentReference.empty() or (type.empty() and defaultValue.empty() and fixed.empty() and pattern.empty() and example.empty() and minValue.empty() and maxValue.empty() and maxLength.empty() and binding.empty())"},
+		{"eld-6", "", "error", "Fixed value may only be specified if there is one type", "fixed.empty() or (type.count()  <= 1)"},
+		{"eld-7", "", "error", "Pattern may only be specified if there is one type", "pattern.empty() or (type.count() <= 1)"},
+		{"eld-8", "", "error", "Pattern and fixed are mutually exclusive", "pattern.empty() or fixed.empty()"},
+		{"eld-11", "", "error", "Binding can only be present for coded elements, string, and uri if using FHIR-defined types", "binding.empty() or type.code.empty() or type.code.contains(\":\") or type.select((code = 'code') or (code = 'Coding') or (code='CodeableConcept') or (code = 'Quantity') or (code = 'string') or (code = 'uri') or (code = 'Duration')).exists()"},
+		{"eld-13", "", "error", "Types must be unique by code", "type.select(code).isDistinct()"},
+		{"eld-14", "", "error", "Constraints must be unique by key", "constraint.select(key).isDistinct()"},
+		{"eld-15", "", "error", "default value and meaningWhenMissing are mutually exclusive", "defaultValue.empty() or meaningWhenMissing.empty()"},
+		{"eld-16", "", "error", "sliceName must be composed of proper tokens separated by \"/\"", "sliceName.empty() or sliceName.matches('^[a-zA-Z0-9\\\\/\\\\-_\\\\[\\\\]\\\\@]+$')"},
+		{"eld-18", "", "error", "Must have a modifier reason if isModifier = true", "(isModifier.exists() and isModifier) implies isModifierReason.exists()"},
+		{"eld-19", "", "error", "Element path SHALL be expressed as a set of '.'-separated components with each component restricted to a maximum of 64 characters and with some limits on the allowed choice of characters", "path.matches('^[^\\\\s\\\\.,:;\\\\\\'\"\\\\/|?!@#$%&*()\\\\[\\\\]{}]{1,64}(\\\\.[^\\\\s\\\\.,:;\\\\\\'\"\\\\/|?!@#$%&*()\\\\[\\\\]{}]{1,64}(\\\\[x\\\\])?(\\\\:[^\\\\s\\\\.]+)?)*$')"},
+		{"eld-20", "", "warning", "The first component of the path should be UpperCamelCase.  Additional components (following a '.') should be lowerCamelCase.  If this syntax is not adhered to, code generation tools may be broken. Logical models may be less concerned about this implication.", "path.matches('^[A-Za-z][A-Za-z0-9]{0,63}(\\\\.[a-z][A-Za-z0-9]{0,63}(\\\\[x])?)*$')"},
+		{"eld-22", "", "error", "sliceIsConstraining can only appear if slicename is present", "sliceIsConstraining.exists() implies sliceName.exists()"},
+		{"eld-24", "", "warning", "pattern[x] should be used rather than fixed[x]", "fixed.exists().not()"},
+		{"eld-25", "", "warning", "Order has no meaning (and cannot be asserted to have meaning), so enforcing rules on order is improper", "orderMeaning.empty() implies slicing.where(rules='openAtEnd' or ordered).exists().not()"},
+		{"eld-27", "", "warning", "Mappings SHOULD be unique by key", "mapping.select(identity).isDistinct()"},
+		{"eld-28", "", "error", "Can't have valueAlternatives if mustHaveValue is true", "mustHaveValue.value implies valueAlternatives.empty()"},
+		{"eld-3", "max", "error", "Max SHALL be a number or \"*\"", "empty() or ($this = '*') or (toInteger() >= 0)"},
+	},
+	reflect.TypeOf(fhir5.ElementDefinitionBinding{}): {
+		{"eld-12", "", "error", "ValueSet SHALL start with http:// or https:// or urn: or #", "valueSet.exists() implies (valueSet.startsWith('http:') or valueSet.startsWith('https') or valueSet.startsWith('urn:') or valueSet.startsWith('#'))"},
+		{"eld-23", "", "error", "binding SHALL have either description or valueSet", "description.exists() or valueSet.exists()"},
+	},
+	reflect.TypeOf(fhir5.ElementDefinitionConstraint{}): {
+		{"eld-21", "", "warning", "Constraints should have an expression or else validators will not be able to enforce them", "expression.exists()"},
+		{"eld-26", "", "error", "Errors cannot be suppressed", "(severity = 'error') implies suppress.empty()"},
+	},
+	reflect.TypeOf(fhir5.ElementDefinitionType{}): {
+		{"eld-4", "", "error", "Aggregation may only be specified if one of the allowed types for the element is a reference", "aggregation.empty() or (code = 'Reference') or (code = 'canonical') or (code = 'CodeableReference')"},
+		{"eld-17", "", "error", "targetProfile is only allowed if the type is Reference or canonical", "(code='Reference' or code = 'canonical' or code = 'CodeableReference') or targetProfile.empty()"},
+	},
+	reflect.TypeOf(fhir5.EncounterParticipant{}): {
+		{"enc-1", "", "error", "A type must be provided when no explicit actor is specified", "actor.exists() or type.exists()"},
+		{"enc-2", "", "error", "A type cannot be provided for a patient or group participant", "actor.exists(resolve() is Patient or resolve() is Group) implies type.exists().not()"},
+	},
+	reflect.TypeOf(fhir5.EventDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.Evidence{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.EvidenceReport{}): {
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.EvidenceVariable{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+	},
+	reflect.TypeOf(fhir5.EvidenceVariableCharacteristic{}): {
+		{"evv-1", "", "error", "In a characteristic, at most one of these six elements shall be used: definitionReference or definitionCanonical or definitionCodeableConcept or definitionId or definitionByTypeAndValue or definitionByCombination", "(definitionReference.count() + definitionCanonical.count() + definitionCodeableConcept.count() + definitionId.count() + definitionByTypeAndValue.count() + definitionByCombination.count())  < 2"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenario{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"exs-3", "", "error", "Must have actors if status is active or required", "status='active' or status='retired' implies actor.exists()"},
+		{"exs-4", "", "error", "Must have processes if status is active or required", "status='active' or status='retired' implies process.exists()"},
+		{"exs-6", "", "error", "Actor keys must be unique", "actor.key.count() = actor.key.distinct().count()"},
+		{"exs-7", "", "error", "Actor titles must be unique", "actor.title.count() = actor.title.distinct().count()"},
+		{"exs-8", "", "error", "Instance keys must be unique", "instance.key.count() = instance.key.distinct().count()"},
+		{"exs-9", "", "error", "Instance titles must be unique", "instance.title.count() = instance.title.distinct().count()"},
+		{"exs-12", "", "error", "Process titles must be unique", "process.title.count() = process.title.distinct().count()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenarioActor{}): {
+		{"exs-19", "", "warning", "Actor should be referenced in at least one operation", "%resource.process.descendants().select(operation).where(initiator=%context.key or receiver=%context.key).exists()"},
+		{"exs-23", "", "error", "actor.key canot be 'OTHER'", "key != 'OTHER'"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenarioInstance{}): {
+		{"exs-1", "", "error", "StructureVersion is required if structureType is not FHIR (but may still be present even if FHIR)", "structureType.exists() and structureType.memberOf('http://hl7.org/fhir/ValueSet/resource-types').not() implies structureVersion.exists()"},
+		{"exs-2", "", "error", "instance.content is only allowed if there are no instance.versions", "content.exists() implies version.empty()"},
+		{"exs-10", "", "error", "Version keys must be unique within an instance", "version.key.count() = version.key.distinct().count()"},
+		{"exs-11", "", "error", "Version titles must be unique within an instance", "version.title.count() = version.title.distinct().count()"},
+		{"exs-20", "", "warning", "Instance should be referenced in at least one location", "%resource.process.descendants().select(instanceReference).where($this=%context.key).exists()"},
+		{"exs-21", "", "warning", "Instance version should be referenced in at least one operation", "version.exists() implies version.key.intersect(%resource.process.descendants().where(instanceReference = %context.key).versionReference).exists()"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenarioInstanceContainedInstance{}): {
+		{"exs-14", "", "error", "InstanceReference must be a key of an instance defined in the ExampleScenario", "%resource.instance.where(key=%context.instanceReference).exists()"},
+		{"exs-15", "", "error", "versionReference must be specified if the referenced instance defines versions", "versionReference.empty() implies %resource.instance.where(key=%context.instanceReference).version.empty()"},
+		{"exs-16", "", "error", "versionReference must be a key of a version within the instance pointed to by instanceReference", "versionReference.exists() implies %resource.instance.where(key=%context.instanceReference).version.where(key=%context.versionReference).exists()"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenarioProcess{}): {
+		{"exs-5", "", "error", "Processes must have steps if ExampleScenario status is active or required", "%resource.status='active' or %resource.status='retired' implies step.exists()"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenarioProcessStep{}): {
+		{"exs-13", "", "error", "Alternative titles must be unique within a step", "alternative.title.count() = alternative.title.distinct().count()"},
+		{"exs-22", "", "error", "Can have a process, a workflow, one or more operations or none of these, but cannot have a combination", "(process.exists() implies workflow.empty() and operation.empty()) and (workflow.exists() implies operation.empty())"},
+	},
+	reflect.TypeOf(fhir5.ExampleScenarioProcessStepOperation{}): {
+		{"exs-17", "", "error", "If specified, initiator must be a key of an actor within the ExampleScenario", "initiator.exists() implies initiator = 'OTHER' or %resource.actor.where(key=%context.initiator).exists()"},
+		{"exs-18", "", "error", "If specified, receiver must be a key of an actor within the ExampleScenario", "receiver.exists() implies receiver = 'OTHER' or %resource.actor.where(key=%context.receiver).exists()"},
+	},
+	reflect.TypeOf(fhir5.Expression{}): {
+		{"exp-1", "", "error", "An expression or a reference must be provided", "expression.exists() or reference.exists()"},
+		{"exp-2", "", "error", "The name must be a valid variable name in most computer languages", "name.hasValue() implies name.matches('[A-Za-z][A-Za-z0-9\\\\_]{0,63}')"},
+	},
+	reflect.TypeOf(fhir5.FamilyMemberHistory{}): {
+		{"fhs-1", "", "error", "Can have age[x] or born[x], but not both", "age.empty() or born.empty()"},
+		{"fhs-2", "", "error", "Can only have estimatedAge if age[x] is present", "age.exists() or estimatedAge.empty()"},
+		{"fhs-3", "", "error", "Can have age[x] or deceased[x], but not both", "age.empty() or deceased.empty()"},
+	},
+	reflect.TypeOf(fhir5.GoalTarget{}): {
+		{"gol-1", "", "error", "Goal.target.measure is required if Goal.target.detail is populated", "(detail.exists() and measure.exists()) or detail.exists().not()"},
+	},
+	reflect.TypeOf(fhir5.GraphDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ImmunizationRecommendationRecommendation{}): {
+		{"imr-1", "", "error", "One of vaccineCode or targetDisease SHALL be present", "vaccineCode.exists() or targetDisease.exists()"},
+	},
+	reflect.TypeOf(fhir5.ImplementationGuide{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"ig-2", "", "error", "If a resource has a fhirVersion, it must be one of the versions defined for the Implementation Guide", "definition.resource.fhirVersion.all(%context.fhirVersion contains $this)"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ImplementationGuideDefinition{}): {
+		{"ig-1", "", "error", "If a resource has a groupingId, it must refer to a grouping defined in the Implementation Guide", "resource.groupingId.all(%context.grouping.id contains $this)"},
+	},
+	reflect.TypeOf(fhir5.ImplementationGuideDefinitionPage{}): {
+		{"ig-3", "", "error", "Source must be absent if 'generated' is generated", "generation='generated' implies source.empty()"},
+	},
+	reflect.TypeOf(fhir5.Ingredient{}): {
+		{"ing-1", "", "error", "If an ingredient is noted as an allergen (allergenicIndicator) then its substance should be a code. If the substance is a SubstanceDefinition, then the allegen information should be documented in that resource", "Ingredient.where(allergenicIndicator=true).count() + Ingredient.substance.code.reference.count()  < 2"},
+	},
+	reflect.TypeOf(fhir5.Library{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.Linkage{}): {
+		{"lnk-1", "", "error", "Must have at least two items", "item.count()>1"},
+	},
+	reflect.TypeOf(fhir5.List{}): {
+		{"lst-1", "", "error", "A list can only have an emptyReason if it is empty", "emptyReason.empty() or entry.empty()"},
+	},
+	reflect.TypeOf(fhir5.Measure{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"mea-1", "", "error", "Stratifier SHALL be either a single criteria or a set of criteria components", "group.stratifier.all((code | description | criteria).exists() xor component.exists())"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.MeasureGroup{}): {
+		{"mea-2", "linkId", "warning", "Link ids should be 255 characters or less", "$this.length() <= 255"},
+	},
+	reflect.TypeOf(fhir5.MeasureGroupPopulation{}): {
+		{"mea-3", "linkId", "warning", "Link ids should be 255 characters or less", "$this.length() <= 255"},
+	},
+	reflect.TypeOf(fhir5.MeasureGroupStratifier{}): {
+		{"mea-4", "linkId", "warning", "Link ids should be 255 characters or less", "$this.length() <= 255"},
+	},
+	reflect.TypeOf(fhir5.MeasureGroupStratifierComponent{}): {
+		{"mea-5", "linkId", "warning", "Link ids should be 255 characters or less", "$this.length() <= 255"},
+	},
+	reflect.TypeOf(fhir5.MeasureReport{}): {
+		{"mrp-1", "", "error", "Measure Reports used for data collection SHALL NOT communicate group and score information", "(type != 'data-exchange') or group.exists().not()"},
+		{"mrp-2", "", "error", "Stratifiers SHALL be either a single criteria or a set of criteria components", "group.stratifier.stratum.all(value.exists() xor component.exists())"},
+	},
+	reflect.TypeOf(fhir5.MeasureSupplementalData{}): {
+		{"mea-6", "linkId", "warning", "Link ids should be 255 characters or less", "$this.length() <= 255"},
+	},
+	reflect.TypeOf(fhir5.MedicationAdministrationDosage{}): {
+		{"mad-1", "", "error", "If dosage attribute is present then SHALL have at least one of dosage.text or dosage.dose or dosage.rate[x]", "(dose.exists() or rate.exists() or text.exists())"},
+	},
+	reflect.TypeOf(fhir5.MedicationDispense{}): {
+		{"mdd-1", "", "error", "whenHandedOver cannot be before whenPrepared", "whenHandedOver.empty() or whenPrepared.empty() or whenHandedOver >= whenPrepared"},
+	},
+	reflect.TypeOf(fhir5.MessageDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.MessageDefinitionFocus{}): {
+		{"md-1", "", "error", "Max must be postive int or *", "max='*' or (max.toInteger() > 0)"},
+	},
+	reflect.TypeOf(fhir5.MolecularSequenceRelativeStartingSequence{}): {
+		{"msq-5", "", "error", "Both genomeAssembly and chromosome must be both contained if either one of them is contained", "chromosome.exists() = genomeAssembly.exists()"},
+		{"msq-6", "", "error", "Have and only have one of the following elements in startingSequence: 1. genomeAssembly; 2 sequence", "genomeAssembly.exists() xor sequence.exists()"},
+	},
+	reflect.TypeOf(fhir5.NamingSystem{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"nsd-1", "", "error", "Root systems cannot have uuid identifiers", "kind != 'root' or uniqueId.all(type != 'uuid')"},
+		{"nsd-2", "", "error", "Can't have more than one preferred identifier for a type", "uniqueId.where(preferred = true).select(type).isDistinct()"},
+		{"nsd-3", "", "error", "Can't have more than one authoritative identifier for a type/period combination (only one authoritative identifier allowed at any given point of time)", "uniqueId.where(authoritative = 'true').select(type.toString() & period.start.toString() & period.end.toString()).isDistinct()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.Narrative{}): {
+		{"txt-1", "div", "error", "The narrative SHALL contain only the basic html formatting elements and attributes described in chapters 7-11 (except section 4 of chapter 9) and 15 of the HTML 4.0 standard, <a> elements (either name or href), images and internally contained style attributes", "htmlChecks()"},
+		{"txt-2", "div", "error", "The narrative SHALL have some non-whitespace content", "htmlChecks()"},
+	},
+	reflect.TypeOf(fhir5.NutritionOrder{}): {
+		{"nor-1", "", "warning", "Nutrition Order SHALL contain either Oral Diet , Supplement, or Enteral Formula class", "oralDiet.exists() or supplement.exists() or enteralFormula.exists()"},
+	},
+	reflect.TypeOf(fhir5.Observation{}): {
+		{"obs-6", "", "error", "dataAbsentReason SHALL only be present if Observation.value[x] is not present", "dataAbsentReason.empty() or value.empty()"},
+		{"obs-7", "", "error", "If Observation.component.code is the same as Observation.code, then Observation.value SHALL NOT be present (the Observation.component.value[x] holds the value).", "value.empty() or component.code.where(coding.intersect(%resource.code.coding).exists()).empty()"},
+		{"obs-8", "", "error", "bodyStructure SHALL only be present if Observation.bodySite is not present", "bodySite.exists() implies bodyStructure.empty()"},
+		{"obs-9", "specimen", "error", "If Observation.specimen is a reference to Group, the group can only have specimens", "(reference.resolve().exists() and reference.resolve() is Group) implies reference.resolve().member.entity.resolve().all($this is Specimen)"},
+	},
+	reflect.TypeOf(fhir5.ObservationDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"obd-0", "", "error", "If permittedUnit exists, then permittedDataType=Quantity must exist.", "permittedUnit.exists() implies (permittedDataType = 'Quantity').exists()"},
+	},
+	reflect.TypeOf(fhir5.ObservationDefinitionComponent{}): {
+		{"obd-1", "", "error", "If permittedUnit exists, then permittedDataType=Quantity must exist.", "permittedUnit.exists() implies (permittedDataType = 'Quantity').exists()"},
+	},
+	reflect.TypeOf(fhir5.ObservationReferenceRange{}): {
+		{"obs-3", "", "error", "Must have at least a low or a high or text", "low.exists() or high.exists() or text.exists()"},
+	},
+	reflect.TypeOf(fhir5.OperationDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"opd-5", "", "error", "A query operation cannot be defined at the instance level", "(kind = 'query') implies (instance = false)"},
+		{"opd-6", "", "error", "A query operation requires input parameters to have a search type", "(kind = 'query') implies (parameter.all((use = 'in' and searchType.exists()) or (use != 'in')))"},
+		{"opd-7", "", "error", "Named queries always have a single output parameter named 'result' of type Bundle", "(kind = 'query') implies ((parameter.where(use = 'out').count() = 1) and (parameter.where(use = 'out').all(name = 'result' and type = 'Bundle')))"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.OperationDefinitionParameter{}): {
+		{"opd-1", "", "error", "Either a type must be provided, or parts", "type.exists() or part.exists()"},
+		{"opd-2", "", "error", "A search type can only be specified for parameters of type string", "searchType.exists() implies type = 'string'"},
+		{"opd-3", "", "error", "A targetProfile can only be specified for parameters of type Reference, Canonical, or a Resource", "targetProfile.exists() implies (type = 'Reference' or type = 'canonical' or type.memberOf('http://hl7.org/fhir/ValueSet/resource-types'))"},
+		{"opd-4", "", "error", "SearchParamType can only be specified on in parameters", "(use = 'out') implies searchType.empty()"},
+	},
+	reflect.TypeOf(fhir5.Organization{}): {
+		{"org-1", "", "error", "The organization SHALL at least have a name or an identifier, and possibly more than one", "(identifier.count() + name.count()) > 0"},
+		{"org-3", "contact", "error", "The telecom of an organization can never be of use 'home'", "telecom.where(use = 'home').empty()"},
+		{"org-4", "contact", "error", "The address of an organization can never be of use 'home'", "address.where(use = 'home').empty()"},
+	},
+	reflect.TypeOf(fhir5.OrganizationAffiliation{}): {
+		{"org-3", "contact", "error", "The telecom of an organization can never be of use 'home'", "telecom.where(use = 'home').empty()"},
+		{"org-4", "contact", "error", "The address of an organization can never be of use 'home'", "address.where(use = 'home').empty()"},
+	},
+	reflect.TypeOf(fhir5.ParametersParameter{}): {
+		{"inv-1", "", "error", "A parameter must have one and only one of (value, resource, part)", "(part.exists() and value.empty() and resource.empty()) or (part.empty() and (value.exists() xor resource.exists()))"},
+	},
+	reflect.TypeOf(fhir5.PatientContact{}): {
+		{"pat-1", "", "error", "SHALL at least contain a contact's details or a reference to an organization", "name.exists() or telecom.exists() or address.exists() or organization.exists()"},
+	},
+	reflect.TypeOf(fhir5.PlanDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"pld-3", "", "warning", "goalid should reference the id of a goal definition", "%context.repeat(action).where((goalId in %context.goal.id).not()).exists().not()"},
+		{"pld-4", "", "warning", "targetId should reference the id of an action", "%context.repeat(action).relatedAction.where((targetId in %context.repeat(action).id).not()).exists().not()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.PlanDefinitionActionInput{}): {
+		{"pld-0", "", "error", "Input data elements must have a requirement or a relatedData, but not both", "requirement.exists() xor relatedData.exists()"},
+	},
+	reflect.TypeOf(fhir5.PlanDefinitionActionOutput{}): {
+		{"pld-1", "", "error", "Output data element must have a requirement or a relatedData, but not both", "requirement.exists() xor relatedData.exists()"},
+	},
+	reflect.TypeOf(fhir5.ProcedurePerformer{}): {
+		{"prc-1", "", "error", "Procedure.performer.onBehalfOf can only be populated when performer.actor isn't Practitioner or PractitionerRole", "onBehalfOf.exists() and actor.resolve().exists() implies actor.resolve().where($this is Practitioner or $this is PractitionerRole).empty()"},
+	},
+	reflect.TypeOf(fhir5.ProvenanceAgent{}): {
+		{"prov-1", "", "error", "Who and onBehalfOf cannot be the same", "who.resolve().exists() and onBehalfOf.resolve().exists() implies who.resolve() != onBehalfOf.resolve()"},
+		{"prov-2", "", "error", "If who is a PractitionerRole, onBehalfOf can't reference the same Practitioner", "who.resolve().ofType(PractitionerRole).practitioner.resolve().exists() and onBehalfOf.resolve().ofType(Practitioner).exists() implies who.resolve().practitioner.resolve() != onBehalfOf.resolve()"},
+		{"prov-3", "", "error", "If who is an organization, onBehalfOf can't be a PractitionerRole within that organization", "who.resolve().ofType(Organization).exists() and onBehalfOf.resolve().ofType(PractitionerRole).organization.resolve().exists() implies who.resolve() != onBehalfOf.resolve().organization.resolve()"},
+	},
+	reflect.TypeOf(fhir5.Questionnaire{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"que-2", "", "error", "The link ids for groups and questions must be unique within the questionnaire", "descendants().linkId.isDistinct()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.QuestionnaireItem{}): {
+		{"que-1a", "", "error", "Group items must have nested items when Questionanire is complete", "(type='group' and %resource.status='complete') implies item.empty().not()"},
+		{"que-1b", "", "warning", "Groups should have items", "type='group' implies item.empty().not()"},
+		{"que-1c", "", "error", "Display items cannot have child items", "type='display' implies item.empty()"},
+		{"que-3", "", "error", "Display items cannot have a \"code\" asserted", "type!='display' or code.empty()"},
+		{"que-4", "", "error", "A question cannot have both answerOption and answerValueSet", "answerOption.empty() or answerValueSet.empty()"},
+		{"que-5", "", "error", "Only coding, decimal, integer, date, dateTime, time, string or quantity  items can have answerOption or answerValueSet", "(type='coding' or type = 'decimal' or type = 'integer' or type = 'date' or type = 'dateTime' or type = 'time' or type = 'string' or type = 'quantity') or (answerValueSet.empty() and answerOption.empty())"},
+		{"que-6", "", "error", "Required and repeat aren't permitted for display items", "type!='display' or (required.empty() and repeats.empty())"},
+		{"que-8", "", "error", "Initial values can't be specified for groups or display items", "(type!='group' and type!='display') or initial.empty()"},
+		{"que-9", "", "error", "Read-only can't be specified for \"display\" items", "type!='display' or readOnly.empty()"},
+		{"que-10", "", "error", "Maximum length can only be declared for simple question types", "(type in ('boolean' | 'decimal' | 'integer' | 'string' | 'text' | 'url')) or answerConstraint='optionOrString' or maxLength.empty()"},
+		{"que-11", "", "error", "If one or more answerOption is present, initial cannot be present.  Use answerOption.initialSelected instead", "answerOption.empty() or initial.empty()"},
+		{"que-12", "", "error", "If there are more than one enableWhen, enableBehavior must be specified", "enableWhen.count() > 1 implies enableBehavior.exists()"},
+		{"que-13", "", "error", "Can only have multiple initial values for repeating items", "repeats=true or initial.count() <= 1"},
+		{"que-14", "", "warning", "Can only have answerConstraint if answerOption or answerValueSet are present.  (This is a warning because extensions may serve the same purpose)", "answerConstraint.exists() implies answerOption.exists() or answerValueSet.exists()"},
+		{"que-15", "linkId", "warning", "Link ids should be 255 characters or less", "$this.length() <= 255"},
+	},
+	reflect.TypeOf(fhir5.QuestionnaireItemEnableWhen{}): {
+		{"que-7", "", "error", "If the operator is 'exists', the value must be a boolean", "operator = 'exists' implies (answer is boolean)"},
+	},
+	reflect.TypeOf(fhir5.QuestionnaireResponseItem{}): {
+		{"qrs-1", "", "error", "Item cannot contain both item and answer", "(answer.exists() and item.exists()).not()"},
+		{"qrs-2", "", "error", "Repeated answers are combined in the answers array of a single item", "repeat(answer|item).select(item.where(answer.value.exists()).linkId.isDistinct()).allTrue()"},
+	},
+	reflect.TypeOf(fhir5.Range{}): {
+		{"rng-2", "", "error", "If present, low SHALL have a lower value than high", "low.value.empty() or high.value.empty() or low.lowBoundary().comparable(high.highBoundary()).not() or (low.lowBoundary() <= high.highBoundary())"},
+	},
+	reflect.TypeOf(fhir5.Ratio{}): {
+		{"rat-1", "", "error", "Numerator and denominator SHALL both be present, or both are absent. If both are absent, there SHALL be some extension present", "(numerator.exists() and denominator.exists()) or (numerator.empty() and denominator.empty() and extension.exists())"},
+	},
+	reflect.TypeOf(fhir5.RatioRange{}): {
+		{"ratrng-1", "", "error", "One of lowNumerator or highNumerator and denominator SHALL be present, or all are absent. If all are absent, there SHALL be some extension present", "((lowNumerator.exists() or highNumerator.exists()) and denominator.exists()) or (lowNumerator.empty() and highNumerator.empty() and denominator.empty() and extension.exists())"},
+		{"ratrng-2", "", "error", "If present, lowNumerator SHALL have a lower value than highNumerator", "lowNumerator.hasValue().not() or highNumerator.hasValue().not()  or (lowNumerator.lowBoundary() <= highNumerator.highBoundary())"},
+	},
+	reflect.TypeOf(fhir5.RequestOrchestrationAction{}): {
+		{"rqg-1", "", "error", "Must have resource or action but not both", "resource.exists() != action.exists()"},
+	},
+	reflect.TypeOf(fhir5.RequestOrchestrationActionInput{}): {
+		{"pld-0", "", "error", "Input data elements must have a requirement or a relatedData, but not both", "requirement.exists() xor relatedData.exists()"},
+	},
+	reflect.TypeOf(fhir5.RequestOrchestrationActionOutput{}): {
+		{"pld-1", "", "error", "Output data element must have a requirement or a relatedData, but not both", "requirement.exists() xor relatedData.exists()"},
+	},
+	reflect.TypeOf(fhir5.Requirements{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.RiskAssessmentPrediction{}): {
+		{"ras-2", "", "error", "Probability as a deciml must be <= 100", "probability.empty() or ((probability is decimal) implies ((probability as decimal) <= 100))"},
+		{"ras-1", "probability", "error", "low and high must be percentages, if present", "(low.empty() or ((low.code = '%') and (low.system = %ucum))) and (high.empty() or ((high.code = '%') and (high.system = %ucum)))"},
+	},
+	reflect.TypeOf(fhir5.SearchParameter{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"spd-1", "", "error", "If an expression is present, there SHALL be a processingMode", "expression.empty() or processingMode.exists()"},
+		{"spd-2", "", "error", "Search parameters can only have chain names when the search parameter type is 'reference'", "chain.empty() or type = 'reference'"},
+		{"spd-3", "", "error", "Search parameters comparator can only be used on type 'number', 'date', 'quantity' or 'special'.", "comparator.empty() or (type in ('number' | 'date' | 'quantity' | 'special'))"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ServiceRequest{}): {
+		{"bdystr-1", "", "error", "bodyStructure SHALL only be present if bodySite is not present", "bodySite.exists() implies bodyStructure.empty()"},
+		{"prr-1", "", "error", "orderDetail SHALL only be present if code is present", "orderDetail.empty() or code.exists()"},
+	},
+	reflect.TypeOf(fhir5.StructureDefinition{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"sdf-1", "", "error", "Element paths must be unique unless the structure is a constraint", "derivation = 'constraint' or snapshot.element.select(path).isDistinct()"},
+		{"sdf-4", "", "error", "If the structure is not abstract, then there SHALL be a baseDefinition", "abstract = true or baseDefinition.exists()"},
+		{"sdf-5", "", "error", "If the structure defines an extension then the structure must have context information", "type != 'Extension' or derivation = 'specialization' or (context.exists())"},
+		{"sdf-6", "", "error", "A structure must have either a differential, or a snapshot (or both)", "snapshot.exists() or differential.exists()"},
+		{"sdf-11", "", "error", "If there's a type, its content must match the path name in the first element of a snapshot", "kind != 'logical' implies snapshot.empty() or snapshot.element.first().path = type"},
+		{"sdf-14", "", "error", "All element definitions must have an id", "snapshot.element.all(id.exists()) and differential.element.all(id.exists())"},
+		{"sdf-15", "", "error", "The first element in a snapshot has no type unless model is a logical model.", "kind!='logical'  implies snapshot.element.first().type.empty()"},
+		{"sdf-15a", "", "error", "If the first element in a differential has no \".\" in the path and it's not a logical model, it has no type", "(kind!='logical'  and differential.element.first().path.contains('.').not()) implies differential.element.first().type.empty()"},
+		{"sdf-9", "", "error", "In any snapshot or differential, no label, code or requirements on an element without a \".\" in the path (e.g. the first element)", "children().element.where(path.contains('.').not()).label.empty() and children().element.where(path.contains('.').not()).code.empty() and children().element.where(path.contains('.').not()).requirements.empty()"},
+		{"sdf-16", "", "error", "All element definitions must have unique ids (snapshot)", "snapshot.element.all(id.exists()) and snapshot.element.id.trace('ids').isDistinct()"},
+		{"sdf-17", "", "error", "All element definitions must have unique ids (diff)", "differential.element.all(id.exists()) and differential.element.id.trace('ids').isDistinct()"},
+		{"sdf-18", "", "error", "Context Invariants can only be used for extensions", "contextInvariant.exists() implies type = 'Extension'"},
+		{"sdf-19", "", "error", "FHIR Specification models only use FHIR defined types", "url.startsWith('http://hl7.org/fhir/StructureDefinition') implies (differential | snapshot).element.type.code.all(matches('^[a-zA-Z0-9]+$') or matches('^http:\\\\/\\\\/hl7\\\\.org\\\\/fhirpath\\\\/System\\\\.[A-Z][A-Za-z]+$'))"},
+		{"sdf-21", "", "error", "Default values can only be specified on specializations", "differential.element.defaultValue.exists() implies (derivation = 'specialization')"},
+		{"sdf-22", "", "error", "FHIR Specification models never have default values", "url.startsWith('http://hl7.org/fhir/StructureDefinition') implies (snapshot.element.defaultValue.empty() and differential.element.defaultValue.empty())"},
+		{"sdf-23", "", "error", "No slice name on root", "(snapshot | differential).element.all(path.contains('.').not() implies sliceName.empty())"},
+		{"sdf-27", "", "error", "If there's a base definition, there must be a derivation ", "baseDefinition.exists() implies derivation.exists()"},
+		{"sdf-29", "", "warning", "Elements in Resources must have a min cardinality or 0 or 1 and a max cardinality of 1 or *", "((kind in 'resource' | 'complex-type') and (derivation = 'specialization')) implies differential.element.where((min != 0 and min != 1) or (max != '1' and max != '*')).empty()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.StructureDefinitionDifferential{}): {
+		{"sdf-20", "", "error", "No slicing on the root element", "element.where(path.contains('.').not()).slicing.empty()"},
+		{"sdf-8a", "", "error", "In any differential, all the elements must start with the StructureDefinition's specified type for non-logical models, or with the same type name for logical models", "(%resource.kind = 'logical' or element.first().path.startsWith(%resource.type)) and (element.tail().empty() or  element.tail().all(path.startsWith(%resource.differential.element.first().path.replaceMatches('\\\\..*','')&'.')))"},
+	},
+	reflect.TypeOf(fhir5.StructureDefinitionMapping{}): {
+		{"sdf-2", "", "error", "Must have at least a name or a uri (or both)", "name.exists() or uri.exists()"},
+	},
+	reflect.TypeOf(fhir5.StructureDefinitionSnapshot{}): {
+		{"sdf-3", "", "error", "Each element definition in a snapshot must have a formal definition and cardinalities, unless model is a logical model", "%resource.kind = 'logical' or element.all(definition.exists() and min.exists() and max.exists())"},
+		{"sdf-8", "", "error", "All snapshot elements must start with the StructureDefinition's specified type for non-logical models, or with the same type name for logical models", "(%resource.kind = 'logical' or element.first().path = %resource.type) and element.tail().all(path.startsWith(%resource.snapshot.element.first().path&'.'))"},
+		{"sdf-24", "", "error", "For CodeableReference elements, target profiles must be listed on the CodeableReference, not the CodeableReference.reference", "element.where(type.where(code='Reference').exists() and path.endsWith('.reference') and type.targetProfile.exists() and (path.substring(0,$this.path.length()-10) in %context.element.where(type.where(code='CodeableReference').exists()).path)).exists().not()"},
+		{"sdf-25", "", "error", "For CodeableReference elements, bindings must be listed on the CodeableReference, not the CodeableReference.concept", "element.where(type.where(code='CodeableConcept').exists() and path.endsWith('.concept') and binding.exists() and (path.substring(0,$this.path.length()-8) in %context.element.where(type.where(code='CodeableReference').exists()).path)).exists().not()"},
+		{"sdf-26", "", "warning", "The root element of a profile should not have mustSupport = true", "$this.where(element[0].mustSupport='true').exists().not()"},
+		{"sdf-8b", "", "error", "All snapshot elements must have a base definition", "element.all(base.exists())"},
+		{"sdf-10", "element", "error", "provide either a binding reference or a description (or both)", "binding.empty() or binding.valueSet.exists() or binding.description.exists()"},
+		{"sdf-28", "element", "error", "If there are no discriminators, there must be a definition", "slicing.exists().not() or (slicing.discriminator.exists() or slicing.description.exists())"},
+	},
+	reflect.TypeOf(fhir5.StructureMap{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.StructureMapGroupRuleTarget{}): {
+		{"smp-1", "", "error", "Can only have an element if you have a context", "element.exists() implies context.exists()"},
+	},
+	reflect.TypeOf(fhir5.SubscriptionFilterBy{}): {
+		{"scr-1", "", "error", "Subscription filters may only contain a modifier or a comparator", "(comparator.exists() and modifier.exists()).not()"},
+	},
+	reflect.TypeOf(fhir5.SubscriptionStatus{}): {
+		{"sst-1", "", "error", "Event notifications must contain events", "(type = 'event-notification' or type = 'query-event') implies notificationEvent.exists()"},
+		{"sst-2", "", "error", "Status messages must contain status", "type = 'query-status' implies status.exists()"},
+	},
+	reflect.TypeOf(fhir5.Task{}): {
+		{"inv-1", "", "error", "Last modified date must be greater than or equal to authored-on date.", "lastModified.exists().not() or authoredOn.exists().not() or lastModified >= authoredOn"},
+		{"tsk-1", "", "error", "Task.restriction is only allowed if the Task is seeking fulfillment and a focus is specified.", "restriction.exists() implies code.coding.where(code='fulfill' and system='http://hl7.org/fhir/CodeSystem/task-code').exists() and focus.exists()"},
+	},
+	reflect.TypeOf(fhir5.TerminologyCapabilities{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"tcp-2", "", "error", "A Terminology Capability statement SHALL have at least one of description, software, or implementation element", "(description.count() + software.count() + implementation.count()) > 0"},
+		{"tcp-3", "", "error", "If kind = instance, implementation must be present and software may be present", "(kind != 'instance') or implementation.exists()"},
+		{"tcp-4", "", "error", "If kind = capability, implementation must be absent, software must be present", "(kind != 'capability') or (implementation.exists().not() and software.exists())"},
+		{"tcp-5", "", "error", "If kind = requirements, implementation and software must be absent", "(kind!='requirements') or (implementation.exists().not() and software.exists().not())"},
+		{"tcp-6", "", "error", "Each instance of the codeSystem element must represent a distinct code system.", "codeSystem.uri.isDistinct()"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.TerminologyCapabilitiesCodeSystem{}): {
+		{"tcp-1", "", "error", "If there is more than one version, a version code must be defined", "version.count() > 1 implies version.all(code.exists())"},
+		{"tcp-7", "", "error", "Each version.code element must be distinct for a particular code system.", "version.code.isDistinct()"},
+		{"tcp-8", "", "error", "A codeSystem element instance may have at most one version.isDefault element with a value of 'true'.", "version.where(isDefault = true).count() <= 1"},
+	},
+	reflect.TypeOf(fhir5.TestPlan{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.TestReportSetupAction{}): {
+		{"inv-1", "", "error", "Setup action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir5.TestReportTestAction{}): {
+		{"inv-2", "", "error", "Test action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir5.TestScript{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.TestScriptMetadata{}): {
+		{"tst-4", "", "error", "TestScript metadata capability SHALL contain required or validated or both.", "capability.required.exists() or capability.validated.exists()"},
+	},
+	reflect.TypeOf(fhir5.TestScriptSetupAction{}): {
+		{"tst-1", "", "error", "Setup action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+	},
+	reflect.TypeOf(fhir5.TestScriptSetupActionAssert{}): {
+		{"tst-5", "", "error", "Only a single assertion SHALL be present within setup action assert element.", "extension.exists() or (contentType.count() + expression.count() + headerField.count() + minimumId.count() + navigationLinks.count() + path.count() + requestMethod.count() + resource.count() + responseCode.count() + response.count() + validateProfileId.count() <=1) or (((expression.count() + minimumId.count() <=2) or (expression.count() + validateProfileId.count() <=2)) and (expression.count() + path.count() <=1) and (minimumId.count() + validateProfileId.count() <=1)) or (((path.count() + minimumId.count() <=2) or (path.count() + validateProfileId.count() <=2)) and (expression.count() + path.count() <=1) and (minimumId.count() + validateProfileId.count() <=1))"},
+		{"tst-10", "", "error", "Setup action assert SHALL contain either compareToSourceId and compareToSourceExpression, compareToSourceId and compareToSourcePath or neither.", "compareToSourceId.empty() xor (compareToSourceExpression.exists() or compareToSourcePath.exists())"},
+		{"tst-12", "", "error", "Setup action assert response and responseCode SHALL be empty when direction equals request", "(response.empty() and responseCode.empty() and direction = 'request') or direction.empty() or direction = 'response'"},
+	},
+	reflect.TypeOf(fhir5.TestScriptSetupActionOperation{}): {
+		{"tst-7", "", "error", "Setup operation SHALL contain either sourceId or targetId or params or url.", "sourceId.exists() or (targetId.count() + url.count() + params.count() = 1) or (type.code in ('capabilities' |'search' | 'transaction' | 'history'))"},
+	},
+	reflect.TypeOf(fhir5.TestScriptTeardownAction{}): {
+		{"tst-9", "operation", "error", "Teardown operation SHALL contain either sourceId or targetId or params or url.", "sourceId.exists() or (targetId.count() + url.count() + params.count() = 1) or (type.code in ('capabilities' | 'search' | 'transaction' | 'history'))"},
+	},
+	reflect.TypeOf(fhir5.TestScriptTestAction{}): {
+		{"tst-2", "", "error", "Test action SHALL contain either an operation or assert but not both.", "operation.exists() xor assert.exists()"},
+		{"tst-8", "operation", "error", "Test operation SHALL contain either sourceId or targetId or params or url.", "sourceId.exists() or (targetId.count() + url.count() + params.count() = 1) or (type.code in ('capabilities' | 'search' | 'transaction' | 'history'))"},
+		{"tst-6", "assert", "error", "Only a single assertion SHALL be present within test action assert element.", "extension.exists() or (contentType.count() + expression.count() + headerField.count() + minimumId.count() + navigationLinks.count() + path.count() + requestMethod.count() + resource.count() + responseCode.count() + response.count() + validateProfileId.count() <=1) or (((expression.count() + minimumId.count() <=2) or (expression.count() + validateProfileId.count() <=2)) and (expression.count() + path.count() <=1) and (minimumId.count() + validateProfileId.count() <=1)) or (((path.count() + minimumId.count() <=2) or (path.count() + validateProfileId.count() <=2)) and (expression.count() + path.count() <=1) and (minimumId.count() + validateProfileId.count() <=1))"},
+		{"tst-11", "assert", "error", "Test action assert SHALL contain either compareToSourceId and compareToSourceExpression, compareToSourceId and compareToSourcePath or neither.", "compareToSourceId.empty() xor (compareToSourceExpression.exists() or compareToSourcePath.exists())"},
+		{"tst-13", "assert", "error", "Test action assert response and response and responseCode SHALL be empty when direction equals request", "(response.empty() and responseCode.empty() and direction = 'request') or direction.empty() or direction = 'response'"},
+	},
+	reflect.TypeOf(fhir5.TestScriptVariable{}): {
+		{"tst-3", "", "error", "Variable can only contain one of expression, headerField or path.", "expression.empty() or headerField.empty() or path.empty()"},
+	},
+	reflect.TypeOf(fhir5.TimingRepeat{}): {
+		{"tim-1", "", "error", "if there's a duration, there needs to be duration units", "duration.empty() or durationUnit.exists()"},
+		{"tim-2", "", "error", "if there's a period, there needs to be period units", "period.empty() or periodUnit.exists()"},
+		{"tim-4", "", "error", "duration SHALL be a non-negative value", "duration.exists() implies duration >= 0"},
+		{"tim-5", "", "error", "period SHALL be a non-negative value", "period.exists() implies period >= 0"},
+		{"tim-6", "", "error", "If there's a periodMax, there must be a period", "periodMax.empty() or period.exists()"},
+		{"tim-7", "", "error", "If there's a durationMax, there must be a duration", "durationMax.empty() or duration.exists()"},
+		{"tim-8", "", "error", "If there's a countMax, there must be a count", "countMax.empty() or count.exists()"},
+		{"tim-9", "", "error", "If there's an offset, there must be a when (and not C, CM, CD, CV)", "offset.empty() or (when.exists() and when.select($this in ('C' | 'CM' | 'CD' | 'CV')).allFalse())"},
+		{"tim-10", "", "error", "If there's a timeOfDay, there cannot be a when, or vice versa", "timeOfDay.empty() or when.empty()"},
+	},
+	reflect.TypeOf(fhir5.TriggerDefinition{}): {
+		{"trd-1", "", "error", "Either timing, or a data requirement, but not both", "data.empty() or timing.empty()"},
+		{"trd-2", "", "error", "A condition only if there is a data requirement", "condition.exists() implies data.exists()"},
+		{"trd-3", "", "error", "A named event requires a name, a periodic event requires timing, and a data event requires data", "(type = 'named-event' implies name.exists()) and (type = 'periodic' implies timing.exists()) and (type.startsWith('data-') implies data.exists())"},
+	},
+	reflect.TypeOf(fhir5.ValueSet{}): {
+		{"cnl-0", "", "warning", "Name should be usable as an identifier for the module by machine processing applications such as code generation", "name.exists() implies name.matches('^[A-Z]([A-Za-z0-9_]){1,254}$')"},
+		{"cnl-1", "url", "warning", "URL should not contain | or # - these characters make processing canonical references problematic", "exists() implies matches('^[^|# ]+$')"},
+	},
+	reflect.TypeOf(fhir5.ValueSetComposeInclude{}): {
+		{"vsd-1", "", "error", "A value set include/exclude SHALL have a value set or a system", "valueSet.exists() or system.exists()"},
+		{"vsd-2", "", "error", "A value set with concepts or filters SHALL include a system", "(concept.exists() or filter.exists()) implies system.exists()"},
+		{"vsd-3", "", "error", "Cannot have both concept and filter", "concept.empty() or filter.empty()"},
+	},
+	reflect.TypeOf(fhir5.ValueSetComposeIncludeConceptDesignation{}): {
+		{"vsd-11", "", "error", "Must have a value for concept.designation.use if concept.designation.additionalUse is present", "additionalUse.exists() implies use.exists()"},
+	},
+	reflect.TypeOf(fhir5.ValueSetExpansionContains{}): {
+		{"vsd-6", "", "error", "SHALL have a code or a display", "code.exists() or display.exists()"},
+		{"vsd-9", "", "error", "SHALL have a code if not abstract", "code.exists() or abstract = true"},
+		{"vsd-10", "", "error", "SHALL have a system if a code is present", "code.empty() or system.exists()"},
+	},
+}
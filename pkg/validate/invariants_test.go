@@ -0,0 +1,154 @@
+package validate
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+func TestInvariants_Examples(t *testing.T) {
+	for _, name := range []string{"patient-example.json", "observation-example.json", "bundle-example.json"} {
+		data, err := os.ReadFile(filepath.Join("../fhir5/testdata/fhir5-json", name))
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", name, err)
+		}
+		resource, err := fhir5.UnmarshalResource(data)
+		if err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", name, err)
+		}
+		outcome, err := Invariants(resource)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		for _, issue := range outcome.Issue {
+			if issue.Severity == fhir5.OperationOutcomeIssueSeverityError || issue.Code == "processing" {
+				t.Errorf("%s: %s %s", name, issue.Expression, *issue.Diagnostics)
+			}
+		}
+	}
+}
+
+func TestInvariants_Violations(t *testing.T) {
+	observation := &fhir5.Observation{
+		DomainResource: fhir5.DomainResource{
+			Contained: fhir5.ResourceList{&fhir5.Patient{
+				DomainResource: fhir5.DomainResource{Resource: fhir5.Resource{ID: fhir5.StringPtr("p1")}},
+				ResourceType:   "Patient",
+			}},
+		},
+		ResourceType:     "Observation",
+		Status:           "final",
+		Code:             common.CodeableConcept{Text: fhir5.StringPtr("weight")},
+		ValueString:      fhir5.StringPtr("heavy"),
+		DataAbsentReason: &common.CodeableConcept{Text: fhir5.StringPtr("unknown")},
+	}
+	extension := common.Extension{URL: "http://example.org/ext", ValueString: fhir5.StringPtr("a")}
+	extension.Extension = []common.Extension{{URL: "nested", ValueString: fhir5.StringPtr("b")}}
+	observation.Extension = []common.Extension{extension}
+
+	outcome, err := Invariants(observation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, issue := range outcome.Issue {
+		if issue.Severity != fhir5.OperationOutcomeIssueSeverityError {
+			continue
+		}
+		if issue.Code != IssueTypeInvariant {
+			t.Errorf("expected code %s, got %s", IssueTypeInvariant, issue.Code)
+		}
+		got = append(got, issue.Expression[0]+" "+strings.SplitN(*issue.Diagnostics, ":", 2)[0])
+	}
+	sort.Strings(got)
+	want := []string{"Observation dom-3", "Observation obs-6", "Observation.extension[0] ext-1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got issues %v, want %v", got, want)
+	}
+}
+
+func TestInvariants_Valid(t *testing.T) {
+	patient := &fhir5.Patient{ResourceType: "Patient"}
+	patient.Text = &fhir5.Narrative{Status: "generated", Div: `<div xmlns="http://www.w3.org/1999/xhtml">John Doe</div>`}
+	outcome, err := Invariants(patient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outcome.Issue) != 1 || outcome.Issue[0].Code != "informational" {
+		t.Errorf("expected a single informational issue, got %+v", outcome.Issue)
+	}
+}
+
+func TestInvariants_Versions(t *testing.T) {
+	files, err := filepath.Glob("../fhir3/testdata/fhir3-json/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		resource, err := fhir3.UnmarshalResource(data)
+		if err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", file, err)
+		}
+		outcome, err := Invariants(resource)
+		if err != nil {
+			t.Fatalf("%s: %v", filepath.Base(file), err)
+		}
+		for _, issue := range outcome.Issue {
+			if issue.Severity == fhir5.OperationOutcomeIssueSeverityError || issue.Code == "processing" {
+				t.Errorf("%s: %s %s", filepath.Base(file), issue.Expression, *issue.Diagnostics)
+			}
+		}
+	}
+
+	// the R4 invariants come from the R4 definitions, e.g. obs-6 on value[x]
+	observation := &fhir4.Observation{
+		Status:           "final",
+		Code:             common.CodeableConcept{Text: fhir5.StringPtr("weight")},
+		ValueString:      fhir5.StringPtr("heavy"),
+		DataAbsentReason: &common.CodeableConcept{Text: fhir5.StringPtr("unknown")},
+	}
+	outcome, err := Invariants(observation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outcome.Issue) != 1 || !strings.HasPrefix(*outcome.Issue[0].Diagnostics, "obs-6: ") {
+		t.Errorf("expected obs-6 to be reported, got %+v", outcome.Issue)
+	}
+}
+
+func TestInvariants_Unsupported(t *testing.T) {
+	for _, r := range []common.Resource{&fhir2.Patient{}, &fhir4b.Patient{}} {
+		if _, err := Invariants(r); !errors.Is(err, ErrUnsupportedVersion) {
+			t.Errorf("expected ErrUnsupportedVersion for %T, got %v", r, err)
+		}
+	}
+	if _, err := Invariants(nil); err == nil {
+		t.Error("expected an error for a nil resource")
+	}
+}
+
+func TestInvariant_Description(t *testing.T) {
+	inv := invariant{severity: "error", expression: "status.exists()"}
+	if got := inv.description(); got != "constraint failed: status.exists()" {
+		t.Errorf("got %q for an invariant without a key", got)
+	}
+	inv.key, inv.human = "x-1", "SHALL have a status"
+	if got := inv.description(); got != "x-1: SHALL have a status" {
+		t.Errorf("got %q for an invariant with a key", got)
+	}
+}
@@ -0,0 +1,408 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+)
+
+// fhir2Rules are the validation rules of the fhir2 package
+var fhir2Rules = ruleSet{
+	// cardinalities lists the required and limited elements of each struct, a max of -1 is unbounded
+	cardinalities: map[reflect.Type][]cardinality{
+		reflect.TypeOf(common.Annotation{}):                                           {{"text", 1, 1}},
+		reflect.TypeOf(common.Extension{}):                                            {{"url", 1, 1}},
+		reflect.TypeOf(common.SampledData{}):                                          {{"origin", 1, 1}, {"dimensions", 1, 1}, {"data", 1, 1}},
+		reflect.TypeOf(common.Signature{}):                                            {{"type", 1, -1}, {"when", 1, 1}},
+		reflect.TypeOf(common.TimingRepeat{}):                                         {{"when", 0, 1}},
+		reflect.TypeOf(fhir2.AllergyIntolerance{}):                                    {{"patient", 1, 1}, {"substance", 1, 1}},
+		reflect.TypeOf(fhir2.AllergyIntoleranceReaction{}):                            {{"manifestation", 1, -1}},
+		reflect.TypeOf(fhir2.Annotation{}):                                            {{"text", 1, 1}},
+		reflect.TypeOf(fhir2.Appointment{}):                                           {{"status", 1, 1}, {"participant", 1, -1}},
+		reflect.TypeOf(fhir2.AppointmentParticipant{}):                                {{"status", 1, 1}},
+		reflect.TypeOf(fhir2.AppointmentResponse{}):                                   {{"appointment", 1, 1}, {"participantStatus", 1, 1}},
+		reflect.TypeOf(fhir2.AuditEvent{}):                                            {{"event", 1, 1}, {"participant", 1, -1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir2.AuditEventEvent{}):                                       {{"type", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir2.AuditEventObjectDetail{}):                                {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir2.AuditEventParticipant{}):                                 {{"requestor", 1, 1}},
+		reflect.TypeOf(fhir2.AuditEventSource{}):                                      {{"identifier", 1, 1}},
+		reflect.TypeOf(fhir2.Basic{}):                                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.Binary{}):                                                {{"contentType", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir2.BodySite{}):                                              {{"patient", 1, 1}},
+		reflect.TypeOf(fhir2.Bundle{}):                                                {{"type", 1, 1}},
+		reflect.TypeOf(fhir2.BundleEntryRequest{}):                                    {{"method", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir2.BundleEntryResponse{}):                                   {{"status", 1, 1}},
+		reflect.TypeOf(fhir2.BundleLink{}):                                            {{"relation", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir2.CarePlan{}):                                              {{"status", 1, 1}, {"note", 0, 1}},
+		reflect.TypeOf(fhir2.CarePlanActivityDetail{}):                                {{"prohibited", 1, 1}},
+		reflect.TypeOf(fhir2.Claim{}):                                                 {{"type", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimCoverage{}):                                         {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimDiagnosis{}):                                        {{"sequence", 1, 1}, {"diagnosis", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimItem{}):                                             {{"sequence", 1, 1}, {"type", 1, 1}, {"service", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimItemDetail{}):                                       {{"sequence", 1, 1}, {"type", 1, 1}, {"service", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimItemDetailSubDetail{}):                              {{"sequence", 1, 1}, {"type", 1, 1}, {"service", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimMissingTeeth{}):                                     {{"tooth", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseAddItem{}):                                  {{"service", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseAddItemAdjudication{}):                      {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseAddItemDetail{}):                            {{"service", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseAddItemDetailAdjudication{}):                {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseCoverage{}):                                 {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseError{}):                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseItem{}):                                     {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseItemAdjudication{}):                         {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseItemDetail{}):                               {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseItemDetailAdjudication{}):                   {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseItemDetailSubDetail{}):                      {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir2.ClaimResponseItemDetailSubDetailAdjudication{}):          {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClinicalImpression{}):                                    {{"patient", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir2.ClinicalImpressionFinding{}):                             {{"item", 1, 1}},
+		reflect.TypeOf(fhir2.ClinicalImpressionInvestigations{}):                      {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ClinicalImpressionRuledOut{}):                            {{"item", 1, 1}},
+		reflect.TypeOf(fhir2.CommunicationPayload{}):                                  {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir2.CommunicationRequestPayload{}):                           {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir2.Composition{}):                                           {{"date", 1, 1}, {"type", 1, 1}, {"title", 1, 1}, {"status", 1, 1}, {"subject", 1, 1}, {"author", 1, -1}},
+		reflect.TypeOf(fhir2.CompositionAttester{}):                                   {{"mode", 1, -1}},
+		reflect.TypeOf(fhir2.ConceptMap{}):                                            {{"status", 1, 1}, {"source[x]", 1, 1}, {"target[x]", 1, 1}},
+		reflect.TypeOf(fhir2.ConceptMapElementTarget{}):                               {{"equivalence", 1, 1}},
+		reflect.TypeOf(fhir2.ConceptMapElementTargetDependsOn{}):                      {{"element", 1, 1}, {"codeSystem", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.Condition{}):                                             {{"patient", 1, 1}, {"code", 1, 1}, {"verificationStatus", 1, 1}},
+		reflect.TypeOf(fhir2.ConditionEvidence{}):                                     {{"code", 0, 1}},
+		reflect.TypeOf(fhir2.Conformance{}):                                           {{"date", 1, 1}, {"kind", 1, 1}, {"fhirVersion", 1, 1}, {"acceptUnknown", 1, 1}, {"format", 1, -1}},
+		reflect.TypeOf(fhir2.ConformanceDocument{}):                                   {{"mode", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceImplementation{}):                             {{"description", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceMessaging{}):                                  {{"event", 1, -1}},
+		reflect.TypeOf(fhir2.ConformanceMessagingEndpoint{}):                          {{"protocol", 1, 1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceMessagingEvent{}):                             {{"code", 1, 1}, {"mode", 1, 1}, {"focus", 1, 1}, {"request", 1, 1}, {"response", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceRest{}):                                       {{"mode", 1, 1}, {"resource", 1, -1}},
+		reflect.TypeOf(fhir2.ConformanceRestInteraction{}):                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceRestOperation{}):                              {{"name", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceRestResource{}):                               {{"type", 1, 1}, {"interaction", 1, -1}},
+		reflect.TypeOf(fhir2.ConformanceRestResourceInteraction{}):                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceRestResourceSearchParam{}):                    {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir2.ConformanceSoftware{}):                                   {{"name", 1, 1}},
+		reflect.TypeOf(fhir2.ContractActor{}):                                         {{"entity", 1, 1}},
+		reflect.TypeOf(fhir2.ContractFriendly{}):                                      {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir2.ContractLegal{}):                                         {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir2.ContractRule{}):                                          {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir2.ContractSigner{}):                                        {{"type", 1, 1}, {"party", 1, 1}, {"signature", 1, 1}},
+		reflect.TypeOf(fhir2.ContractTermActor{}):                                     {{"entity", 1, 1}},
+		reflect.TypeOf(fhir2.DataElement{}):                                           {{"status", 1, 1}, {"element", 1, -1}},
+		reflect.TypeOf(fhir2.DataElementMapping{}):                                    {{"identity", 1, 1}},
+		reflect.TypeOf(fhir2.DetectedIssueMitigation{}):                               {{"action", 1, 1}},
+		reflect.TypeOf(fhir2.Device{}):                                                {{"type", 1, 1}},
+		reflect.TypeOf(fhir2.DeviceComponent{}):                                       {{"type", 1, 1}, {"identifier", 1, 1}, {"lastSystemChange", 1, 1}},
+		reflect.TypeOf(fhir2.DeviceMetric{}):                                          {{"type", 1, 1}, {"identifier", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir2.DeviceUseRequest{}):                                      {{"device", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir2.DeviceUseStatement{}):                                    {{"device", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir2.DiagnosticOrder{}):                                       {{"subject", 1, 1}},
+		reflect.TypeOf(fhir2.DiagnosticOrderEvent{}):                                  {{"status", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir2.DiagnosticOrderItem{}):                                   {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.DiagnosticReport{}):                                      {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}, {"effective[x]", 1, 1}, {"issued", 1, 1}, {"performer", 1, 1}},
+		reflect.TypeOf(fhir2.DiagnosticReportImage{}):                                 {{"link", 1, 1}},
+		reflect.TypeOf(fhir2.DocumentManifest{}):                                      {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir2.DocumentManifestContent{}):                               {{"p[x]", 1, 1}},
+		reflect.TypeOf(fhir2.DocumentReference{}):                                     {{"type", 1, 1}, {"indexed", 1, 1}, {"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir2.DocumentReferenceContent{}):                              {{"attachment", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinition{}):                                     {{"path", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinitionBase{}):                                 {{"path", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinitionBinding{}):                              {{"strength", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinitionConstraint{}):                           {{"key", 1, 1}, {"severity", 1, 1}, {"human", 1, 1}, {"xpath", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinitionMapping{}):                              {{"identity", 1, 1}, {"map", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinitionSlicing{}):                              {{"rules", 1, 1}},
+		reflect.TypeOf(fhir2.ElementDefinitionType{}):                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.Encounter{}):                                             {{"status", 1, 1}},
+		reflect.TypeOf(fhir2.EncounterLocation{}):                                     {{"location", 1, 1}},
+		reflect.TypeOf(fhir2.EncounterStatusHistory{}):                                {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir2.EnrollmentRequest{}):                                     {{"subject", 1, 1}, {"coverage", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir2.EpisodeOfCare{}):                                         {{"status", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir2.EpisodeOfCareStatusHistory{}):                            {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir2.FamilyMemberHistory{}):                                   {{"patient", 1, 1}, {"status", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir2.FamilyMemberHistoryCondition{}):                          {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.Flag{}):                                                  {{"status", 1, 1}, {"subject", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.Goal{}):                                                  {{"description", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir2.Group{}):                                                 {{"type", 1, 1}, {"actual", 1, 1}},
+		reflect.TypeOf(fhir2.GroupCharacteristic{}):                                   {{"code", 1, 1}, {"value[x]", 1, 1}, {"exclude", 1, 1}},
+		reflect.TypeOf(fhir2.GroupMember{}):                                           {{"entity", 1, 1}},
+		reflect.TypeOf(fhir2.HealthcareService{}):                                     {{"location", 1, 1}},
+		reflect.TypeOf(fhir2.HealthcareServiceNotAvailable{}):                         {{"description", 1, 1}},
+		reflect.TypeOf(fhir2.HealthcareServiceServiceType{}):                          {{"type", 1, 1}},
+		reflect.TypeOf(fhir2.ImagingObjectSelection{}):                                {{"uid", 1, 1}, {"patient", 1, 1}, {"title", 1, 1}, {"study", 1, -1}},
+		reflect.TypeOf(fhir2.ImagingObjectSelectionStudy{}):                           {{"uid", 1, 1}, {"series", 1, -1}},
+		reflect.TypeOf(fhir2.ImagingObjectSelectionStudySeries{}):                     {{"instance", 1, -1}},
+		reflect.TypeOf(fhir2.ImagingObjectSelectionStudySeriesInstance{}):             {{"sopClass", 1, 1}, {"uid", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir2.ImagingObjectSelectionStudySeriesInstanceFrames{}):       {{"frameNumbers", 1, -1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir2.ImagingStudy{}):                                          {{"patient", 1, 1}, {"uid", 1, 1}, {"numberOfSeries", 1, 1}, {"numberOfInstances", 1, 1}},
+		reflect.TypeOf(fhir2.ImagingStudySeries{}):                                    {{"modality", 1, 1}, {"uid", 1, 1}, {"numberOfInstances", 1, 1}},
+		reflect.TypeOf(fhir2.ImagingStudySeriesInstance{}):                            {{"uid", 1, 1}, {"sopClass", 1, 1}},
+		reflect.TypeOf(fhir2.Immunization{}):                                          {{"vaccineCode", 1, 1}, {"patient", 1, 1}, {"wasNotGiven", 1, 1}, {"reported", 1, 1}},
+		reflect.TypeOf(fhir2.ImmunizationRecommendation{}):                            {{"patient", 1, 1}, {"recommendation", 1, -1}},
+		reflect.TypeOf(fhir2.ImmunizationRecommendationRecommendation{}):              {{"date", 1, 1}, {"vaccineCode", 1, 1}, {"forecastStatus", 1, 1}},
+		reflect.TypeOf(fhir2.ImmunizationRecommendationRecommendationDateCriterion{}): {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir2.ImmunizationVaccinationProtocol{}):                       {{"doseSequence", 1, 1}, {"doseStatus", 1, 1}},
+		reflect.TypeOf(fhir2.ImplementationGuide{}):                                   {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"package", 1, -1}, {"page", 1, 1}},
+		reflect.TypeOf(fhir2.ImplementationGuideDependency{}):                         {{"type", 1, 1}, {"uri", 1, 1}},
+		reflect.TypeOf(fhir2.ImplementationGuideGlobal{}):                             {{"type", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir2.ImplementationGuidePackage{}):                            {{"name", 1, 1}, {"resource", 1, -1}},
+		reflect.TypeOf(fhir2.ImplementationGuidePackageResource{}):                    {{"purpose", 1, 1}, {"source[x]", 1, 1}},
+		reflect.TypeOf(fhir2.ImplementationGuidePage{}):                               {{"source", 1, 1}, {"name", 1, 1}, {"kind", 1, 1}},
+		reflect.TypeOf(fhir2.List{}):                                                  {{"status", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir2.ListEntry{}):                                             {{"item", 1, 1}},
+		reflect.TypeOf(fhir2.LocationPosition{}):                                      {{"longitude", 1, 1}, {"latitude", 1, 1}},
+		reflect.TypeOf(fhir2.Media{}):                                                 {{"type", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationAdministration{}):                              {{"status", 1, 1}, {"patient", 1, 1}, {"effectiveTime[x]", 1, 1}, {"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationDispense{}):                                    {{"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationDispenseSubstitution{}):                        {{"type", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationOrder{}):                                       {{"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationOrderSubstitution{}):                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationPackageContent{}):                              {{"item", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationProductIngredient{}):                           {{"item", 1, 1}},
+		reflect.TypeOf(fhir2.MedicationStatement{}):                                   {{"patient", 1, 1}, {"status", 1, 1}, {"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir2.MessageHeader{}):                                         {{"timestamp", 1, 1}, {"event", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir2.MessageHeaderDestination{}):                              {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir2.MessageHeaderResponse{}):                                 {{"identifier", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.MessageHeaderSource{}):                                   {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir2.NamingSystem{}):                                          {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"date", 1, 1}, {"uniqueId", 1, -1}},
+		reflect.TypeOf(fhir2.NamingSystemUniqueId{}):                                  {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir2.Narrative{}):                                             {{"status", 1, 1}, {"div", 1, 1}},
+		reflect.TypeOf(fhir2.NutritionOrder{}):                                        {{"patient", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir2.Observation{}):                                           {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.ObservationComponent{}):                                  {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ObservationReferenceRange{}):                             {{"meaning", 0, 1}},
+		reflect.TypeOf(fhir2.ObservationRelated{}):                                    {{"target", 1, 1}},
+		reflect.TypeOf(fhir2.OperationDefinition{}):                                   {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"code", 1, 1}, {"system", 1, 1}, {"instance", 1, 1}},
+		reflect.TypeOf(fhir2.OperationDefinitionParameter{}):                          {{"name", 1, 1}, {"use", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir2.OperationDefinitionParameterBinding{}):                   {{"strength", 1, 1}, {"valueSet[x]", 1, 1}},
+		reflect.TypeOf(fhir2.OperationOutcome{}):                                      {{"issue", 1, -1}},
+		reflect.TypeOf(fhir2.OperationOutcomeIssue{}):                                 {{"severity", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.Order{}):                                                 {{"detail", 1, -1}},
+		reflect.TypeOf(fhir2.OrderResponse{}):                                         {{"request", 1, 1}, {"orderStatus", 1, 1}},
+		reflect.TypeOf(fhir2.ParametersParameter{}):                                   {{"name", 1, 1}},
+		reflect.TypeOf(fhir2.PatientAnimal{}):                                         {{"species", 1, 1}},
+		reflect.TypeOf(fhir2.PatientCommunication{}):                                  {{"language", 1, 1}},
+		reflect.TypeOf(fhir2.PatientLink{}):                                           {{"other", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir2.PaymentNotice{}):                                         {{"paymentStatus", 1, 1}},
+		reflect.TypeOf(fhir2.PaymentReconciliation{}):                                 {{"total", 1, 1}},
+		reflect.TypeOf(fhir2.PaymentReconciliationDetail{}):                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir2.PersonLink{}):                                            {{"target", 1, 1}},
+		reflect.TypeOf(fhir2.PractitionerQualification{}):                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.Procedure{}):                                             {{"subject", 1, 1}, {"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.ProcedureRequest{}):                                      {{"subject", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir2.ProcessRequest{}):                                        {{"action", 1, 1}},
+		reflect.TypeOf(fhir2.ProcessRequestItem{}):                                    {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir2.Provenance{}):                                            {{"target", 1, -1}, {"recorded", 1, 1}},
+		reflect.TypeOf(fhir2.ProvenanceAgent{}):                                       {{"role", 1, 1}},
+		reflect.TypeOf(fhir2.ProvenanceAgentRelatedAgent{}):                           {{"type", 1, 1}, {"target", 1, 1}},
+		reflect.TypeOf(fhir2.ProvenanceEntity{}):                                      {{"role", 1, 1}, {"type", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir2.Questionnaire{}):                                         {{"status", 1, 1}, {"group", 1, 1}},
+		reflect.TypeOf(fhir2.QuestionnaireResponse{}):                                 {{"status", 1, 1}},
+		reflect.TypeOf(fhir2.ReferralRequest{}):                                       {{"status", 1, 1}},
+		reflect.TypeOf(fhir2.RelatedPerson{}):                                         {{"patient", 1, 1}},
+		reflect.TypeOf(fhir2.RiskAssessmentPrediction{}):                              {{"outcome", 1, 1}},
+		reflect.TypeOf(fhir2.SampledData{}):                                           {{"origin", 1, 1}, {"period", 1, 1}, {"dimensions", 1, 1}, {"data", 1, 1}},
+		reflect.TypeOf(fhir2.Schedule{}):                                              {{"actor", 1, 1}},
+		reflect.TypeOf(fhir2.SearchParameter{}):                                       {{"url", 1, 1}, {"name", 1, 1}, {"code", 1, 1}, {"base", 1, 1}, {"type", 1, 1}, {"description", 1, 1}},
+		reflect.TypeOf(fhir2.Signature{}):                                             {{"type", 1, -1}, {"when", 1, 1}, {"who[x]", 1, 1}, {"contentType", 1, 1}, {"blob", 1, 1}},
+		reflect.TypeOf(fhir2.Slot{}):                                                  {{"schedule", 1, 1}, {"freeBusyType", 1, 1}, {"start", 1, 1}, {"end", 1, 1}},
+		reflect.TypeOf(fhir2.Specimen{}):                                              {{"subject", 1, 1}, {"accessionIdentifier", 0, 1}},
+		reflect.TypeOf(fhir2.StructureDefinition{}):                                   {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"abstract", 1, 1}},
+		reflect.TypeOf(fhir2.StructureDefinitionDifferential{}):                       {{"element", 1, -1}},
+		reflect.TypeOf(fhir2.StructureDefinitionMapping{}):                            {{"identity", 1, 1}},
+		reflect.TypeOf(fhir2.StructureDefinitionSnapshot{}):                           {{"element", 1, -1}},
+		reflect.TypeOf(fhir2.Subscription{}):                                          {{"criteria", 1, 1}, {"reason", 1, 1}, {"status", 1, 1}, {"channel", 1, 1}},
+		reflect.TypeOf(fhir2.SubscriptionChannel{}):                                   {{"type", 1, 1}, {"payload", 1, 1}},
+		reflect.TypeOf(fhir2.Substance{}):                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.SubstanceIngredient{}):                                   {{"substance", 1, 1}},
+		reflect.TypeOf(fhir2.TestScript{}):                                            {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir2.TestScriptMetadata{}):                                    {{"capability", 1, -1}},
+		reflect.TypeOf(fhir2.TestScriptMetadataCapability{}):                          {{"conformance", 1, 1}},
+		reflect.TypeOf(fhir2.TestScriptMetadataLink{}):                                {{"url", 1, 1}},
+		reflect.TypeOf(fhir2.TestScriptSetup{}):                                       {{"action", 1, -1}},
+		reflect.TypeOf(fhir2.TestScriptSetupActionOperationRequestHeader{}):           {{"field", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir2.TestScriptTeardown{}):                                    {{"action", 1, -1}},
+		reflect.TypeOf(fhir2.TestScriptTest{}):                                        {{"action", 1, -1}},
+		reflect.TypeOf(fhir2.TestScriptVariable{}):                                    {{"name", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSet{}):                                              {{"status", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetCodeSystem{}):                                    {{"system", 1, 1}, {"concept", 1, -1}},
+		reflect.TypeOf(fhir2.ValueSetCodeSystemConcept{}):                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetCodeSystemConceptDesignation{}):                  {{"value", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetComposeInclude{}):                                {{"system", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetComposeIncludeConcept{}):                         {{"code", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetComposeIncludeFilter{}):                          {{"property", 1, 1}, {"op", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetExpansion{}):                                     {{"identifier", 1, 1}, {"timestamp", 1, 1}},
+		reflect.TypeOf(fhir2.ValueSetExpansionParameter{}):                            {{"name", 1, 1}},
+		reflect.TypeOf(fhir2.VisionPrescriptionDispense{}):                            {{"product", 1, 1}},
+	},
+
+	// enumValues lists the allowed values of the code enum types
+	enumValues: map[reflect.Type][]string{
+		reflect.TypeOf(common.AddressType("")):                                {"postal", "physical", "both"},
+		reflect.TypeOf(common.AddressUse("")):                                 {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(common.ContactPointSystem("")):                         {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(common.ContactPointUse("")):                            {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(common.ContributorType("")):                            {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(common.DataRequirementSortDirection("")):               {"ascending", "descending"},
+		reflect.TypeOf(common.HumanNameUse("")):                               {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(common.IdentifierUse("")):                              {"usual", "official", "temp", "secondary", "old"},
+		reflect.TypeOf(common.ParameterUse("")):                               {"in", "out"},
+		reflect.TypeOf(common.QuantityComparator("")):                         {"<", "<=", ">", ">="},
+		reflect.TypeOf(common.RelatedArtifactType("")):                        {"documentation", "justification", "citation", "predecessor", "successor", "derived-from", "depends-on", "composed-of"},
+		reflect.TypeOf(common.TriggerDefinitionType("")):                      {"named-event", "periodic", "data-changed", "data-added", "data-modified", "data-removed", "data-accessed", "data-access-ended"},
+		reflect.TypeOf(fhir2.AddressType("")):                                 {"postal", "physical", "both"},
+		reflect.TypeOf(fhir2.AddressUse("")):                                  {"home", "work", "temp", "old"},
+		reflect.TypeOf(fhir2.AdministrativeGender("")):                        {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir2.AllergyIntoleranceCategory("")):                  {"food", "medication", "environment", "other"},
+		reflect.TypeOf(fhir2.AllergyIntoleranceCriticality("")):               {"CRITL", "CRITH", "CRITU"},
+		reflect.TypeOf(fhir2.AllergyIntoleranceReactionSeverity("")):          {"mild", "moderate", "severe"},
+		reflect.TypeOf(fhir2.AllergyIntoleranceStatus("")):                    {"active", "unconfirmed", "confirmed", "inactive", "resolved", "refuted", "entered-in-error"},
+		reflect.TypeOf(fhir2.AllergyIntoleranceType("")):                      {"allergy", "intolerance", "adverse-reaction"},
+		reflect.TypeOf(fhir2.AppointmentParticipantRequired("")):              {"required", "optional", "information-only"},
+		reflect.TypeOf(fhir2.AppointmentParticipantStatus("")):                {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir2.AppointmentResponseParticipantStatus("")):        {"accepted", "declined", "tentative", "in-process", "completed", "needs-action"},
+		reflect.TypeOf(fhir2.AppointmentStatus("")):                           {"proposed", "pending", "booked", "arrived", "fulfilled", "cancelled", "noshow"},
+		reflect.TypeOf(fhir2.BundleEntryRequestMethod("")):                    {"GET", "POST", "PUT", "DELETE"},
+		reflect.TypeOf(fhir2.BundleEntrySearchMode("")):                       {"match", "include", "outcome"},
+		reflect.TypeOf(fhir2.BundleType("")):                                  {"document", "message", "transaction", "transaction-response", "batch", "batch-response", "history", "searchset", "collection"},
+		reflect.TypeOf(fhir2.CarePlanActivityCategory("")):                    {"diet", "drug", "encounter", "observation", "procedure", "supply", "other"},
+		reflect.TypeOf(fhir2.CarePlanActivityStatus("")):                      {"not-started", "scheduled", "in-progress", "on-hold", "completed", "cancelled"},
+		reflect.TypeOf(fhir2.CarePlanGoalStatus("")):                          {"in-progress", "achieved", "sustaining", "cancelled", "accepted", "rejected"},
+		reflect.TypeOf(fhir2.CarePlanStatus("")):                              {"planned", "active", "completed", "cancelled"},
+		reflect.TypeOf(fhir2.ClaimResponseOutcome("")):                        {"complete", "error"},
+		reflect.TypeOf(fhir2.ClaimType("")):                                   {"institutional", "oral", "pharmacy", "professional", "vision"},
+		reflect.TypeOf(fhir2.ClaimUse("")):                                    {"complete", "proposed", "exploratory", "other"},
+		reflect.TypeOf(fhir2.ClinicalImpressionStatus("")):                    {"in-progress", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir2.CommunicationRequestStatus("")):                  {"proposed", "planned", "requested", "received", "accepted", "in-progress", "completed", "suspended", "rejected", "failed"},
+		reflect.TypeOf(fhir2.CommunicationStatus("")):                         {"in-progress", "completed", "suspended", "rejected", "failed"},
+		reflect.TypeOf(fhir2.CompositionAttesterMode("")):                     {"personal", "professional", "legal", "official"},
+		reflect.TypeOf(fhir2.CompositionSectionMode("")):                      {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir2.CompositionStatus("")):                           {"preliminary", "final", "amended", "entered-in-error"},
+		reflect.TypeOf(fhir2.ConceptMapElementTargetEquivalence("")):          {"equivalent", "equal", "wider", "subsumes", "narrower", "specializes", "inexact", "unmatched", "disjoint"},
+		reflect.TypeOf(fhir2.ConceptMapStatus("")):                            {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.ConditionClinicalStatus("")):                     {"active", "relapse", "remission", "resolved"},
+		reflect.TypeOf(fhir2.ConditionVerificationStatus("")):                 {"provisional", "differential", "confirmed", "refuted", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir2.ConformanceAcceptUnknown("")):                    {"no", "extensions", "elements", "both"},
+		reflect.TypeOf(fhir2.ConformanceDocumentMode("")):                     {"producer", "consumer"},
+		reflect.TypeOf(fhir2.ConformanceKind("")):                             {"instance", "capability", "requirements"},
+		reflect.TypeOf(fhir2.ConformanceMessagingEventCategory("")):           {"Consequence", "Currency", "Notification"},
+		reflect.TypeOf(fhir2.ConformanceMessagingEventMode("")):               {"sender", "receiver"},
+		reflect.TypeOf(fhir2.ConformanceRestInteractionCode("")):              {"transaction", "search-system", "history-system"},
+		reflect.TypeOf(fhir2.ConformanceRestMode("")):                         {"client", "server"},
+		reflect.TypeOf(fhir2.ConformanceRestResourceConditionalDelete("")):    {"not-supported", "single", "multiple"},
+		reflect.TypeOf(fhir2.ConformanceRestResourceInteractionCode("")):      {"read", "vread", "update", "delete", "history-instance", "validate", "history-type", "create", "search-type"},
+		reflect.TypeOf(fhir2.ConformanceRestResourceSearchParamModifier("")):  {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type"},
+		reflect.TypeOf(fhir2.ConformanceRestResourceSearchParamType("")):      {"number", "date", "string", "token", "reference", "composite", "quantity", "uri"},
+		reflect.TypeOf(fhir2.ConformanceRestResourceVersioning("")):           {"no-version", "versioned", "versioned-update"},
+		reflect.TypeOf(fhir2.ConformanceRestTransactionMode("")):              {"not-supported", "batch", "transaction", "both"},
+		reflect.TypeOf(fhir2.ConformanceStatus("")):                           {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.ContactPointSystem("")):                          {"phone", "fax", "email", "pager", "other"},
+		reflect.TypeOf(fhir2.ContactPointUse("")):                             {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(fhir2.DataElementStatus("")):                           {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.DataElementStringency("")):                       {"comparable", "fully-specified", "equivalent", "convertable", "scaleable", "flexible"},
+		reflect.TypeOf(fhir2.DetectedIssueSeverity("")):                       {"high", "moderate", "low"},
+		reflect.TypeOf(fhir2.DeviceComponentMeasurementPrinciple("")):         {"other", "chemical", "electrical", "impedance", "nuclear", "optical", "thermal", "biological", "mechanical", "acoustical", "manual"},
+		reflect.TypeOf(fhir2.DeviceMetricCalibrationState("")):                {"not-calibrated", "calibration-required", "calibrated", "unspecified"},
+		reflect.TypeOf(fhir2.DeviceMetricCalibrationType("")):                 {"unspecified", "offset", "gain", "two-point"},
+		reflect.TypeOf(fhir2.DeviceMetricCategory("")):                        {"measurement", "setting", "calculation", "unspecified"},
+		reflect.TypeOf(fhir2.DeviceMetricColor("")):                           {"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"},
+		reflect.TypeOf(fhir2.DeviceMetricOperationalStatus("")):               {"on", "off", "standby"},
+		reflect.TypeOf(fhir2.DeviceStatus("")):                                {"available", "not-available", "entered-in-error"},
+		reflect.TypeOf(fhir2.DeviceUseRequestPriority("")):                    {"routine", "urgent", "stat", "asap"},
+		reflect.TypeOf(fhir2.DeviceUseRequestStatus("")):                      {"proposed", "planned", "requested", "received", "accepted", "in-progress", "completed", "suspended", "rejected", "aborted"},
+		reflect.TypeOf(fhir2.DiagnosticOrderEventStatus("")):                  {"proposed", "draft", "planned", "requested", "received", "accepted", "in-progress", "review", "completed", "cancelled", "suspended", "rejected", "failed"},
+		reflect.TypeOf(fhir2.DiagnosticOrderItemStatus("")):                   {"proposed", "draft", "planned", "requested", "received", "accepted", "in-progress", "review", "completed", "cancelled", "suspended", "rejected", "failed"},
+		reflect.TypeOf(fhir2.DiagnosticOrderPriority("")):                     {"routine", "urgent", "stat", "asap"},
+		reflect.TypeOf(fhir2.DiagnosticOrderStatus("")):                       {"proposed", "draft", "planned", "requested", "received", "accepted", "in-progress", "review", "completed", "cancelled", "suspended", "rejected", "failed"},
+		reflect.TypeOf(fhir2.DiagnosticReportStatus("")):                      {"registered", "partial", "preliminary", "final", "amended", "corrected", "appended", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir2.DocumentManifestStatus("")):                      {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir2.DocumentReferenceStatus("")):                     {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir2.ElementDefinitionBindingStrength("")):            {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir2.ElementDefinitionConstraintSeverity("")):         {"error", "warning"},
+		reflect.TypeOf(fhir2.ElementDefinitionSlicingRules("")):               {"closed", "open", "openAtEnd"},
+		reflect.TypeOf(fhir2.ElementDefinitionTypeAggregation("")):            {"contained", "referenced", "bundled"},
+		reflect.TypeOf(fhir2.EligibilityResponseOutcome("")):                  {"complete", "error"},
+		reflect.TypeOf(fhir2.EncounterClass("")):                              {"inpatient", "outpatient", "ambulatory", "emergency", "home", "field", "daytime", "virtual"},
+		reflect.TypeOf(fhir2.EncounterLocationStatus("")):                     {"planned", "active", "reserved", "completed"},
+		reflect.TypeOf(fhir2.EncounterStatus("")):                             {"planned", "arrived", "in-progress", "onleave", "finished", "cancelled"},
+		reflect.TypeOf(fhir2.EnrollmentResponseOutcome("")):                   {"complete", "error"},
+		reflect.TypeOf(fhir2.EpisodeOfCareStatus("")):                         {"planned", "waitlist", "active", "onhold", "finished", "cancelled"},
+		reflect.TypeOf(fhir2.ExplanationOfBenefitOutcome("")):                 {"complete", "error"},
+		reflect.TypeOf(fhir2.FamilyMemberHistoryGender("")):                   {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir2.FamilyMemberHistoryStatus("")):                   {"partial", "completed", "entered-in-error", "health-unknown"},
+		reflect.TypeOf(fhir2.FlagStatus("")):                                  {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir2.GoalStatus("")):                                  {"proposed", "planned", "accepted", "rejected", "in-progress", "achieved", "sustaining", "on-hold", "cancelled"},
+		reflect.TypeOf(fhir2.GroupType("")):                                   {"person", "animal", "practitioner", "device", "medication", "substance"},
+		reflect.TypeOf(fhir2.HealthcareServiceAvailableTimeDaysOfWeek("")):    {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir2.HumanNameUse("")):                                {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(fhir2.ImagingStudyAvailability("")):                    {"ONLINE", "OFFLINE", "NEARLINE", "UNAVAILABLE"},
+		reflect.TypeOf(fhir2.ImagingStudySeriesAvailability("")):              {"ONLINE", "OFFLINE", "NEARLINE", "UNAVAILABLE"},
+		reflect.TypeOf(fhir2.ImplementationGuideDependencyType("")):           {"reference", "inclusion"},
+		reflect.TypeOf(fhir2.ImplementationGuidePackageResourcePurpose("")):   {"example", "terminology", "profile", "extension", "dictionary", "logical"},
+		reflect.TypeOf(fhir2.ImplementationGuidePageKind("")):                 {"page", "example", "list", "include", "directory", "dictionary", "toc", "resource"},
+		reflect.TypeOf(fhir2.ImplementationGuideStatus("")):                   {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.ListMode("")):                                    {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir2.ListStatus("")):                                  {"current", "retired", "entered-in-error"},
+		reflect.TypeOf(fhir2.LocationMode("")):                                {"instance", "kind"},
+		reflect.TypeOf(fhir2.LocationStatus("")):                              {"active", "suspended", "inactive"},
+		reflect.TypeOf(fhir2.MediaType("")):                                   {"photo", "video", "audio"},
+		reflect.TypeOf(fhir2.MedicationAdministrationStatus("")):              {"in-progress", "on-hold", "completed", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir2.MedicationDispenseStatus("")):                    {"in-progress", "on-hold", "completed", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir2.MedicationOrderPriority("")):                     {"routine", "urgent", "stat", "asap"},
+		reflect.TypeOf(fhir2.MedicationOrderStatus("")):                       {"active", "on-hold", "completed", "entered-in-error", "stopped", "draft"},
+		reflect.TypeOf(fhir2.MedicationStatementStatus("")):                   {"active", "completed", "entered-in-error", "intended"},
+		reflect.TypeOf(fhir2.MessageHeaderResponseCode("")):                   {"ok", "transient-error", "fatal-error"},
+		reflect.TypeOf(fhir2.NamingSystemKind("")):                            {"codesystem", "identifier", "root"},
+		reflect.TypeOf(fhir2.NamingSystemStatus("")):                          {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.NamingSystemUniqueIdType("")):                    {"oid", "uuid", "uri", "other"},
+		reflect.TypeOf(fhir2.NarrativeStatus("")):                             {"generated", "extensions", "additional", "empty"},
+		reflect.TypeOf(fhir2.NutritionOrderStatus("")):                        {"proposed", "draft", "planned", "requested", "active", "on-hold", "completed", "cancelled"},
+		reflect.TypeOf(fhir2.ObservationRelatedType("")):                      {"has-member", "derived-from", "sequel-to", "replaces", "qualified-by", "interfered-by"},
+		reflect.TypeOf(fhir2.ObservationStatus("")):                           {"registered", "preliminary", "final", "amended", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir2.OperationDefinitionKind("")):                     {"operation", "query"},
+		reflect.TypeOf(fhir2.OperationDefinitionParameterBindingStrength("")): {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir2.OperationDefinitionParameterUse("")):             {"in", "out"},
+		reflect.TypeOf(fhir2.OperationDefinitionStatus("")):                   {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.OperationOutcomeIssueSeverity("")):               {"fatal", "error", "warning", "information"},
+		reflect.TypeOf(fhir2.OrderResponseOrderStatus("")):                    {"pending", "review", "rejected", "error", "accepted", "cancelled", "replaced", "aborted", "completed"},
+		reflect.TypeOf(fhir2.PatientGender("")):                               {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir2.PatientLinkType("")):                             {"replace", "replaces", "refer", "seealso"},
+		reflect.TypeOf(fhir2.PaymentReconciliationOutcome("")):                {"complete", "error"},
+		reflect.TypeOf(fhir2.PersonLinkAssurance("")):                         {"level1", "level2", "level3", "level4"},
+		reflect.TypeOf(fhir2.PractitionerGender("")):                          {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir2.ProcedureRequestPriority("")):                    {"routine", "urgent", "stat", "asap"},
+		reflect.TypeOf(fhir2.ProcedureRequestStatus("")):                      {"proposed", "draft", "requested", "received", "accepted", "in-progress", "completed", "suspended", "rejected", "aborted"},
+		reflect.TypeOf(fhir2.ProcedureStatus("")):                             {"in-progress", "aborted", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir2.ProcessRequestAction("")):                        {"cancel", "poll", "reprocess", "status"},
+		reflect.TypeOf(fhir2.ProvenanceEntityRole("")):                        {"derivation", "revision", "quotation", "source"},
+		reflect.TypeOf(fhir2.QuestionnaireGroupQuestionType("")):              {"boolean", "decimal", "integer", "date", "dateTime", "instant", "time", "string", "text", "url", "choice", "open-choice", "attachment", "reference", "quantity"},
+		reflect.TypeOf(fhir2.QuestionnaireResponseStatus("")):                 {"in-progress", "completed", "amended"},
+		reflect.TypeOf(fhir2.QuestionnaireStatus("")):                         {"draft", "published", "retired"},
+		reflect.TypeOf(fhir2.ReferralRequestStatus("")):                       {"draft", "requested", "active", "cancelled", "accepted", "rejected", "completed"},
+		reflect.TypeOf(fhir2.SearchParameterStatus("")):                       {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.SearchParameterType("")):                         {"number", "date", "string", "token", "reference", "composite", "quantity", "uri"},
+		reflect.TypeOf(fhir2.SearchParameterXpathUsage("")):                   {"normal", "phonetic", "nearby", "distance", "other"},
+		reflect.TypeOf(fhir2.SlotFreeBusyType("")):                            {"busy", "free", "busy-unavailable", "busy-tentative"},
+		reflect.TypeOf(fhir2.StructureDefinitionContextType("")):              {"resource", "datatype", "mapping", "extension"},
+		reflect.TypeOf(fhir2.StructureDefinitionKind("")):                     {"datatype", "resource", "logical"},
+		reflect.TypeOf(fhir2.StructureDefinitionStatus("")):                   {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.SubscriptionChannelType("")):                     {"rest-hook", "websocket", "email", "sms", "message"},
+		reflect.TypeOf(fhir2.SubscriptionStatus("")):                          {"requested", "active", "error", "off"},
+		reflect.TypeOf(fhir2.SupplyDeliveryStatus("")):                        {"in-progress", "completed", "abandoned"},
+		reflect.TypeOf(fhir2.SupplyRequestStatus("")):                         {"requested", "completed", "failed", "cancelled"},
+		reflect.TypeOf(fhir2.TestScriptSetupActionAssertContentType("")):      {"xml", "json"},
+		reflect.TypeOf(fhir2.TestScriptSetupActionAssertDirection("")):        {"response", "request"},
+		reflect.TypeOf(fhir2.TestScriptSetupActionAssertOperator("")):         {"equals", "notEquals", "in", "notIn", "greaterThan", "lessThan", "empty", "notEmpty", "contains", "notContains"},
+		reflect.TypeOf(fhir2.TestScriptSetupActionAssertResponse("")):         {"okay", "created", "noContent", "notModified", "bad", "forbidden", "notFound", "methodNotAllowed", "conflict", "gone", "preconditionFailed", "unprocessable"},
+		reflect.TypeOf(fhir2.TestScriptSetupActionOperationAccept("")):        {"xml", "json"},
+		reflect.TypeOf(fhir2.TestScriptSetupActionOperationContentType("")):   {"xml", "json"},
+		reflect.TypeOf(fhir2.TestScriptStatus("")):                            {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.TimingRepeatDurationUnits("")):                   {"s", "min", "h", "d", "wk", "mo", "a"},
+		reflect.TypeOf(fhir2.TimingRepeatPeriodUnits("")):                     {"s", "min", "h", "d", "wk", "mo", "a"},
+		reflect.TypeOf(fhir2.TimingRepeatWhen("")):                            {"HS", "WAKE", "AC", "ACD", "ACM", "ACV", "C", "CD", "CM", "CV", "PC", "PCD", "PCM", "PCV"},
+		reflect.TypeOf(fhir2.ValueSetComposeIncludeFilterOp("")):              {"=", "is-a", "is-not-a", "regex", "in", "not-in"},
+		reflect.TypeOf(fhir2.ValueSetStatus("")):                              {"draft", "active", "retired"},
+		reflect.TypeOf(fhir2.VisionPrescriptionDispenseBase("")):              {"up", "down", "in", "out"},
+		reflect.TypeOf(fhir2.VisionPrescriptionDispenseEye("")):               {"right", "left"},
+	},
+}
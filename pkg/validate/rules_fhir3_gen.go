@@ -0,0 +1,616 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+)
+
+// fhir3Rules are the validation rules of the fhir3 package
+var fhir3Rules = ruleSet{
+	// cardinalities lists the required and limited elements of each struct, a max of -1 is unbounded
+	cardinalities: map[reflect.Type][]cardinality{
+		reflect.TypeOf(common.Annotation{}):                                           {{"text", 1, 1}},
+		reflect.TypeOf(common.Contributor{}):                                          {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(common.DataRequirement{}):                                      {{"type", 1, 1}},
+		reflect.TypeOf(common.DataRequirementCodeFilter{}):                            {{"path", 1, 1}},
+		reflect.TypeOf(common.DataRequirementDateFilter{}):                            {{"path", 1, 1}},
+		reflect.TypeOf(common.Extension{}):                                            {{"url", 1, 1}},
+		reflect.TypeOf(common.ParameterDefinition{}):                                  {{"use", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(common.RelatedArtifact{}):                                      {{"type", 1, 1}},
+		reflect.TypeOf(common.SampledData{}):                                          {{"origin", 1, 1}, {"dimensions", 1, 1}, {"data", 1, 1}},
+		reflect.TypeOf(common.Signature{}):                                            {{"type", 1, -1}, {"when", 1, 1}},
+		reflect.TypeOf(common.TriggerDefinition{}):                                    {{"type", 1, 1}},
+		reflect.TypeOf(common.UsageContext{}):                                         {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.AccountCoverage{}):                                       {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir3.AccountGuarantor{}):                                      {{"party", 1, 1}},
+		reflect.TypeOf(fhir3.ActivityDefinition{}):                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.ActivityDefinitionParticipant{}):                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.AdverseEventSuspectEntity{}):                             {{"instance", 1, 1}},
+		reflect.TypeOf(fhir3.AllergyIntolerance{}):                                    {{"verificationStatus", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir3.AllergyIntoleranceReaction{}):                            {{"manifestation", 1, -1}},
+		reflect.TypeOf(fhir3.Annotation{}):                                            {{"text", 1, 1}},
+		reflect.TypeOf(fhir3.Appointment{}):                                           {{"status", 1, 1}, {"participant", 1, -1}},
+		reflect.TypeOf(fhir3.AppointmentParticipant{}):                                {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.AppointmentResponse{}):                                   {{"appointment", 1, 1}, {"participantStatus", 1, 1}},
+		reflect.TypeOf(fhir3.AuditEvent{}):                                            {{"type", 1, 1}, {"recorded", 1, 1}, {"agent", 1, -1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir3.AuditEventAgent{}):                                       {{"requestor", 1, 1}},
+		reflect.TypeOf(fhir3.AuditEventEntityDetail{}):                                {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.AuditEventSource{}):                                      {{"identifier", 1, 1}},
+		reflect.TypeOf(fhir3.Basic{}):                                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.Binary{}):                                                {{"contentType", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir3.BodySite{}):                                              {{"patient", 1, 1}},
+		reflect.TypeOf(fhir3.Bundle{}):                                                {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.BundleEntryRequest{}):                                    {{"method", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir3.BundleEntryResponse{}):                                   {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.BundleLink{}):                                            {{"relation", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatement{}):                                   {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}, {"fhirVersion", 1, 1}, {"acceptUnknown", 1, 1}, {"format", 1, -1}},
+		reflect.TypeOf(fhir3.CapabilityStatementDocument{}):                           {{"mode", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementImplementation{}):                     {{"description", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementMessagingEndpoint{}):                  {{"protocol", 1, 1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementMessagingEvent{}):                     {{"code", 1, 1}, {"mode", 1, 1}, {"focus", 1, 1}, {"request", 1, 1}, {"response", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementMessagingSupportedMessage{}):          {{"mode", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementRest{}):                               {{"mode", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementRestInteraction{}):                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementRestOperation{}):                      {{"name", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResource{}):                       {{"type", 1, 1}, {"interaction", 1, -1}},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceInteraction{}):            {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceSearchParam{}):            {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.CapabilityStatementSoftware{}):                           {{"name", 1, 1}},
+		reflect.TypeOf(fhir3.CarePlan{}):                                              {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.CarePlanActivityDetail{}):                                {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.ChargeItem{}):                                            {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.ChargeItemParticipant{}):                                 {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimAccident{}):                                         {{"date", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimCareTeam{}):                                         {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimDiagnosis{}):                                        {{"sequence", 1, 1}, {"diagnosis[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimInformation{}):                                      {{"sequence", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimInsurance{}):                                        {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimItem{}):                                             {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimItemDetail{}):                                       {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimItemDetailSubDetail{}):                              {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimPayee{}):                                            {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimProcedure{}):                                        {{"sequence", 1, 1}, {"procedure[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimResponseError{}):                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimResponseInsurance{}):                                {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimResponseItem{}):                                     {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimResponseItemAdjudication{}):                         {{"category", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimResponseItemDetail{}):                               {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir3.ClaimResponseItemDetailSubDetail{}):                      {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir3.ClinicalImpression{}):                                    {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.ClinicalImpressionFinding{}):                             {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ClinicalImpressionInvestigation{}):                       {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.CodeSystem{}):                                            {{"status", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir3.CodeSystemConcept{}):                                     {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.CodeSystemConceptDesignation{}):                          {{"value", 1, 1}},
+		reflect.TypeOf(fhir3.CodeSystemConceptProperty{}):                             {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.CodeSystemFilter{}):                                      {{"code", 1, 1}, {"operator", 1, -1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.CodeSystemProperty{}):                                    {{"code", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.Communication{}):                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.CommunicationPayload{}):                                  {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir3.CommunicationRequest{}):                                  {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.CommunicationRequestPayload{}):                           {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir3.CommunicationRequestRequester{}):                         {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.CompartmentDefinition{}):                                 {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"code", 1, 1}, {"search", 1, 1}},
+		reflect.TypeOf(fhir3.CompartmentDefinitionResource{}):                         {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.Composition{}):                                           {{"status", 1, 1}, {"type", 1, 1}, {"subject", 1, 1}, {"date", 1, 1}, {"author", 1, -1}, {"title", 1, 1}},
+		reflect.TypeOf(fhir3.CompositionAttester{}):                                   {{"mode", 1, -1}},
+		reflect.TypeOf(fhir3.ConceptMap{}):                                            {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.ConceptMapGroup{}):                                       {{"element", 1, -1}},
+		reflect.TypeOf(fhir3.ConceptMapGroupElementTargetDependsOn{}):                 {{"property", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir3.ConceptMapGroupUnmapped{}):                               {{"mode", 1, 1}},
+		reflect.TypeOf(fhir3.Condition{}):                                             {{"subject", 1, 1}},
+		reflect.TypeOf(fhir3.Consent{}):                                               {{"status", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir3.ConsentActor{}):                                          {{"role", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir3.ConsentData{}):                                           {{"meaning", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir3.ConsentExcept{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.ConsentExceptActor{}):                                    {{"role", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir3.ConsentExceptData{}):                                     {{"meaning", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir3.ContractAgent{}):                                         {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.ContractFriendly{}):                                      {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ContractLegal{}):                                         {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ContractRule{}):                                          {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ContractSigner{}):                                        {{"type", 1, 1}, {"party", 1, 1}, {"signature", 1, -1}},
+		reflect.TypeOf(fhir3.ContractTermAgent{}):                                     {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.Contributor{}):                                           {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(fhir3.DataElement{}):                                           {{"status", 1, 1}, {"element", 1, -1}},
+		reflect.TypeOf(fhir3.DataElementMapping{}):                                    {{"identity", 1, 1}},
+		reflect.TypeOf(fhir3.DetectedIssue{}):                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.DetectedIssueMitigation{}):                               {{"action", 1, 1}},
+		reflect.TypeOf(fhir3.DeviceComponent{}):                                       {{"identifier", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.DeviceMetric{}):                                          {{"identifier", 1, 1}, {"type", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir3.DeviceRequest{}):                                         {{"intent", 1, 1}, {"code[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.DeviceRequestRequester{}):                                {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.DeviceUseStatement{}):                                    {{"status", 1, 1}, {"subject", 1, 1}, {"device", 1, 1}},
+		reflect.TypeOf(fhir3.DiagnosticReport{}):                                      {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir3.DiagnosticReportImage{}):                                 {{"link", 1, 1}},
+		reflect.TypeOf(fhir3.DiagnosticReportPerformer{}):                             {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.DocumentManifest{}):                                      {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir3.DocumentManifestContent{}):                               {{"p[x]", 1, 1}},
+		reflect.TypeOf(fhir3.DocumentReference{}):                                     {{"status", 1, 1}, {"type", 1, 1}, {"indexed", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir3.DocumentReferenceContent{}):                              {{"attachment", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinition{}):                                     {{"path", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionBase{}):                                 {{"path", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionBinding{}):                              {{"strength", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionConstraint{}):                           {{"key", 1, 1}, {"severity", 1, 1}, {"human", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionExample{}):                              {{"label", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionMapping{}):                              {{"identity", 1, 1}, {"map", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionSlicing{}):                              {{"rules", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionSlicingDiscriminator{}):                 {{"type", 1, 1}, {"path", 1, 1}},
+		reflect.TypeOf(fhir3.ElementDefinitionType{}):                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.EligibilityResponseError{}):                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.EligibilityResponseInsuranceBenefitBalance{}):            {{"category", 1, 1}},
+		reflect.TypeOf(fhir3.EligibilityResponseInsuranceBenefitBalanceFinancial{}):   {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.Encounter{}):                                             {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.EncounterClassHistory{}):                                 {{"class", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir3.EncounterDiagnosis{}):                                    {{"condition", 1, 1}},
+		reflect.TypeOf(fhir3.EncounterLocation{}):                                     {{"location", 1, 1}},
+		reflect.TypeOf(fhir3.EncounterStatusHistory{}):                                {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir3.Endpoint{}):                                              {{"status", 1, 1}, {"connectionType", 1, 1}, {"payloadType", 1, -1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir3.EpisodeOfCare{}):                                         {{"status", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir3.EpisodeOfCareDiagnosis{}):                                {{"condition", 1, 1}},
+		reflect.TypeOf(fhir3.EpisodeOfCareStatusHistory{}):                            {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir3.ExpansionProfile{}):                                      {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.ExpansionProfileExcludedSystem{}):                        {{"system", 1, 1}},
+		reflect.TypeOf(fhir3.ExpansionProfileFixedVersion{}):                          {{"system", 1, 1}, {"version", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitBenefitBalance{}):                    {{"category", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitBenefitBalanceFinancial{}):           {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitCareTeam{}):                          {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitDiagnosis{}):                         {{"sequence", 1, 1}, {"diagnosis[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitInformation{}):                       {{"sequence", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitItem{}):                              {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitItemAdjudication{}):                  {{"category", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitItemDetail{}):                        {{"sequence", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitItemDetailSubDetail{}):               {{"sequence", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitProcedure{}):                         {{"sequence", 1, 1}, {"procedure[x]", 1, 1}},
+		reflect.TypeOf(fhir3.FamilyMemberHistory{}):                                   {{"status", 1, 1}, {"patient", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir3.FamilyMemberHistoryCondition{}):                          {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.Flag{}):                                                  {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.Goal{}):                                                  {{"status", 1, 1}, {"description", 1, 1}},
+		reflect.TypeOf(fhir3.GraphDefinition{}):                                       {{"name", 1, 1}, {"status", 1, 1}, {"start", 1, 1}},
+		reflect.TypeOf(fhir3.GraphDefinitionLink{}):                                   {{"path", 1, 1}, {"target", 1, -1}},
+		reflect.TypeOf(fhir3.GraphDefinitionLinkTarget{}):                             {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.GraphDefinitionLinkTargetCompartment{}):                  {{"code", 1, 1}, {"rule", 1, 1}},
+		reflect.TypeOf(fhir3.Group{}):                                                 {{"type", 1, 1}, {"actual", 1, 1}},
+		reflect.TypeOf(fhir3.GroupCharacteristic{}):                                   {{"code", 1, 1}, {"value[x]", 1, 1}, {"exclude", 1, 1}},
+		reflect.TypeOf(fhir3.GroupMember{}):                                           {{"entity", 1, 1}},
+		reflect.TypeOf(fhir3.GuidanceResponse{}):                                      {{"module", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir3.HealthcareServiceNotAvailable{}):                         {{"description", 1, 1}},
+		reflect.TypeOf(fhir3.ImagingManifest{}):                                       {{"patient", 1, 1}, {"study", 1, -1}},
+		reflect.TypeOf(fhir3.ImagingManifestStudy{}):                                  {{"uid", 1, 1}, {"series", 1, -1}},
+		reflect.TypeOf(fhir3.ImagingManifestStudySeries{}):                            {{"uid", 1, 1}, {"instance", 1, -1}},
+		reflect.TypeOf(fhir3.ImagingManifestStudySeriesInstance{}):                    {{"sopClass", 1, 1}, {"uid", 1, 1}},
+		reflect.TypeOf(fhir3.ImagingStudy{}):                                          {{"uid", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir3.ImagingStudySeries{}):                                    {{"uid", 1, 1}, {"modality", 1, 1}},
+		reflect.TypeOf(fhir3.ImagingStudySeriesInstance{}):                            {{"uid", 1, 1}, {"sopClass", 1, 1}},
+		reflect.TypeOf(fhir3.Immunization{}):                                          {{"status", 1, 1}, {"notGiven", 1, 1}, {"vaccineCode", 1, 1}, {"patient", 1, 1}, {"primarySource", 1, 1}},
+		reflect.TypeOf(fhir3.ImmunizationPractitioner{}):                              {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.ImmunizationRecommendation{}):                            {{"patient", 1, 1}, {"recommendation", 1, -1}},
+		reflect.TypeOf(fhir3.ImmunizationRecommendationRecommendation{}):              {{"date", 1, 1}, {"forecastStatus", 1, 1}},
+		reflect.TypeOf(fhir3.ImmunizationRecommendationRecommendationDateCriterion{}): {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.ImmunizationVaccinationProtocol{}):                       {{"targetDisease", 1, -1}, {"doseStatus", 1, 1}},
+		reflect.TypeOf(fhir3.ImplementationGuide{}):                                   {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir3.ImplementationGuideDependency{}):                         {{"type", 1, 1}, {"uri", 1, 1}},
+		reflect.TypeOf(fhir3.ImplementationGuideGlobal{}):                             {{"type", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir3.ImplementationGuidePackage{}):                            {{"name", 1, 1}, {"resource", 1, -1}},
+		reflect.TypeOf(fhir3.ImplementationGuidePackageResource{}):                    {{"example", 1, 1}, {"source[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ImplementationGuidePage{}):                               {{"source", 1, 1}, {"title", 1, 1}, {"kind", 1, 1}},
+		reflect.TypeOf(fhir3.Library{}):                                               {{"status", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.Linkage{}):                                               {{"item", 1, -1}},
+		reflect.TypeOf(fhir3.LinkageItem{}):                                           {{"type", 1, 1}, {"resource", 1, 1}},
+		reflect.TypeOf(fhir3.List{}):                                                  {{"status", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir3.ListEntry{}):                                             {{"item", 1, 1}},
+		reflect.TypeOf(fhir3.LocationPosition{}):                                      {{"longitude", 1, 1}, {"latitude", 1, 1}},
+		reflect.TypeOf(fhir3.Measure{}):                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.MeasureGroup{}):                                          {{"identifier", 1, 1}},
+		reflect.TypeOf(fhir3.MeasureGroupPopulation{}):                                {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir3.MeasureReport{}):                                         {{"status", 1, 1}, {"type", 1, 1}, {"measure", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir3.MeasureReportGroup{}):                                    {{"identifier", 1, 1}},
+		reflect.TypeOf(fhir3.MeasureReportGroupStratifierStratum{}):                   {{"value", 1, 1}},
+		reflect.TypeOf(fhir3.Media{}):                                                 {{"type", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationAdministration{}):                              {{"status", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}, {"effective[x]", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationAdministrationPerformer{}):                     {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationDispense{}):                                    {{"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationDispensePerformer{}):                           {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationDispenseSubstitution{}):                        {{"wasSubstituted", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationIngredient{}):                                  {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationPackageContent{}):                              {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationRequest{}):                                     {{"intent", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationRequestRequester{}):                            {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationRequestSubstitution{}):                         {{"allowed", 1, 1}},
+		reflect.TypeOf(fhir3.MedicationStatement{}):                                   {{"status", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}, {"taken", 1, 1}},
+		reflect.TypeOf(fhir3.MessageDefinition{}):                                     {{"status", 1, 1}, {"date", 1, 1}, {"event", 1, 1}},
+		reflect.TypeOf(fhir3.MessageDefinitionAllowedResponse{}):                      {{"message", 1, 1}},
+		reflect.TypeOf(fhir3.MessageDefinitionFocus{}):                                {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.MessageHeader{}):                                         {{"event", 1, 1}, {"timestamp", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir3.MessageHeaderDestination{}):                              {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir3.MessageHeaderResponse{}):                                 {{"identifier", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir3.MessageHeaderSource{}):                                   {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir3.NamingSystem{}):                                          {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"date", 1, 1}, {"uniqueId", 1, -1}},
+		reflect.TypeOf(fhir3.NamingSystemUniqueId{}):                                  {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.Narrative{}):                                             {{"status", 1, 1}, {"div", 1, 1}},
+		reflect.TypeOf(fhir3.NutritionOrder{}):                                        {{"patient", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir3.Observation{}):                                           {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir3.ObservationRelated{}):                                    {{"target", 1, 1}},
+		reflect.TypeOf(fhir3.OperationDefinition{}):                                   {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"code", 1, 1}, {"system", 1, 1}, {"type", 1, 1}, {"instance", 1, 1}},
+		reflect.TypeOf(fhir3.OperationDefinitionParameter{}):                          {{"name", 1, 1}, {"use", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir3.OperationDefinitionParameterBinding{}):                   {{"strength", 1, 1}, {"valueSet[x]", 1, 1}},
+		reflect.TypeOf(fhir3.OperationOutcome{}):                                      {{"issue", 1, -1}},
+		reflect.TypeOf(fhir3.OperationOutcomeIssue{}):                                 {{"severity", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir3.ParametersParameter{}):                                   {{"name", 1, 1}},
+		reflect.TypeOf(fhir3.PatientLink{}):                                           {{"other", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.PaymentReconciliationDetail{}):                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.PersonLink{}):                                            {{"target", 1, 1}},
+		reflect.TypeOf(fhir3.PlanDefinition{}):                                        {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.PlanDefinitionActionCondition{}):                         {{"kind", 1, 1}},
+		reflect.TypeOf(fhir3.PlanDefinitionActionParticipant{}):                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.PlanDefinitionActionRelatedAction{}):                     {{"actionId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir3.PlanDefinitionGoal{}):                                    {{"description", 1, 1}},
+		reflect.TypeOf(fhir3.PractitionerQualification{}):                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.PractitionerRoleNotAvailable{}):                          {{"description", 1, 1}},
+		reflect.TypeOf(fhir3.Procedure{}):                                             {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.ProcedureFocalDevice{}):                                  {{"manipulated", 1, 1}},
+		reflect.TypeOf(fhir3.ProcedurePerformer{}):                                    {{"actor", 1, 1}},
+		reflect.TypeOf(fhir3.ProcedureRequest{}):                                      {{"status", 1, 1}, {"intent", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.ProcedureRequestRequester{}):                             {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.ProcessRequestItem{}):                                    {{"sequenceLinkId", 1, 1}},
+		reflect.TypeOf(fhir3.Provenance{}):                                            {{"target", 1, -1}, {"recorded", 1, 1}, {"agent", 1, -1}},
+		reflect.TypeOf(fhir3.ProvenanceAgent{}):                                       {{"who[x]", 1, 1}},
+		reflect.TypeOf(fhir3.ProvenanceEntity{}):                                      {{"role", 1, 1}, {"what[x]", 1, 1}},
+		reflect.TypeOf(fhir3.Questionnaire{}):                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.QuestionnaireItem{}):                                     {{"linkId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.QuestionnaireItemEnableWhen{}):                           {{"question", 1, 1}},
+		reflect.TypeOf(fhir3.QuestionnaireItemOption{}):                               {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.QuestionnaireResponse{}):                                 {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.QuestionnaireResponseItem{}):                             {{"linkId", 1, 1}},
+		reflect.TypeOf(fhir3.ReferralRequest{}):                                       {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir3.ReferralRequestRequester{}):                              {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.RelatedPerson{}):                                         {{"patient", 1, 1}},
+		reflect.TypeOf(fhir3.RequestGroup{}):                                          {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir3.RequestGroupActionCondition{}):                           {{"kind", 1, 1}},
+		reflect.TypeOf(fhir3.RequestGroupActionRelatedAction{}):                       {{"actionId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir3.ResearchStudy{}):                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.ResearchStudyArm{}):                                      {{"name", 1, 1}},
+		reflect.TypeOf(fhir3.ResearchSubject{}):                                       {{"status", 1, 1}, {"study", 1, 1}, {"individual", 1, 1}},
+		reflect.TypeOf(fhir3.RiskAssessment{}):                                        {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.RiskAssessmentPrediction{}):                              {{"outcome", 1, 1}},
+		reflect.TypeOf(fhir3.SampledData{}):                                           {{"origin", 1, 1}, {"period", 1, 1}, {"dimensions", 1, 1}, {"data", 1, 1}},
+		reflect.TypeOf(fhir3.Schedule{}):                                              {{"actor", 1, -1}},
+		reflect.TypeOf(fhir3.SearchParameter{}):                                       {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"code", 1, 1}, {"base", 1, -1}, {"type", 1, 1}, {"description", 1, 1}},
+		reflect.TypeOf(fhir3.SearchParameterComponent{}):                              {{"definition", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir3.Sequence{}):                                              {{"coordinateSystem", 1, 1}},
+		reflect.TypeOf(fhir3.SequenceQuality{}):                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.SequenceReferenceSeq{}):                                  {{"windowStart", 1, 1}, {"windowEnd", 1, 1}},
+		reflect.TypeOf(fhir3.SequenceRepository{}):                                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.ServiceDefinition{}):                                     {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.Signature{}):                                             {{"type", 1, -1}, {"when", 1, 1}, {"who[x]", 1, 1}},
+		reflect.TypeOf(fhir3.Slot{}):                                                  {{"schedule", 1, 1}, {"status", 1, 1}, {"start", 1, 1}, {"end", 1, 1}},
+		reflect.TypeOf(fhir3.Specimen{}):                                              {{"subject", 1, 1}},
+		reflect.TypeOf(fhir3.StructureDefinition{}):                                   {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"abstract", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir3.StructureDefinitionDifferential{}):                       {{"element", 1, -1}},
+		reflect.TypeOf(fhir3.StructureDefinitionMapping{}):                            {{"identity", 1, 1}},
+		reflect.TypeOf(fhir3.StructureDefinitionSnapshot{}):                           {{"element", 1, -1}},
+		reflect.TypeOf(fhir3.StructureMap{}):                                          {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"group", 1, -1}},
+		reflect.TypeOf(fhir3.StructureMapGroup{}):                                     {{"name", 1, 1}, {"typeMode", 1, 1}, {"input", 1, -1}, {"rule", 1, -1}},
+		reflect.TypeOf(fhir3.StructureMapGroupInput{}):                                {{"name", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir3.StructureMapGroupRule{}):                                 {{"name", 1, 1}, {"source", 1, -1}},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleDependent{}):                        {{"name", 1, 1}, {"variable", 1, -1}},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleSource{}):                           {{"context", 1, 1}},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleTargetParameter{}):                  {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.StructureMapStructure{}):                                 {{"url", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir3.Subscription{}):                                          {{"status", 1, 1}, {"reason", 1, 1}, {"criteria", 1, 1}, {"channel", 1, 1}},
+		reflect.TypeOf(fhir3.SubscriptionChannel{}):                                   {{"type", 1, 1}},
+		reflect.TypeOf(fhir3.Substance{}):                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.SubstanceIngredient{}):                                   {{"substance[x]", 1, 1}},
+		reflect.TypeOf(fhir3.SupplyRequestOrderedItem{}):                              {{"quantity", 1, 1}},
+		reflect.TypeOf(fhir3.SupplyRequestRequester{}):                                {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.Task{}):                                                  {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir3.TaskInput{}):                                             {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.TaskOutput{}):                                            {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir3.TaskRequester{}):                                         {{"agent", 1, 1}},
+		reflect.TypeOf(fhir3.TestReport{}):                                            {{"status", 1, 1}, {"testScript", 1, 1}, {"result", 1, 1}},
+		reflect.TypeOf(fhir3.TestReportParticipant{}):                                 {{"type", 1, 1}, {"uri", 1, 1}},
+		reflect.TypeOf(fhir3.TestReportSetup{}):                                       {{"action", 1, -1}},
+		reflect.TypeOf(fhir3.TestReportSetupActionAssert{}):                           {{"result", 1, 1}},
+		reflect.TypeOf(fhir3.TestReportSetupActionOperation{}):                        {{"result", 1, 1}},
+		reflect.TypeOf(fhir3.TestReportTeardown{}):                                    {{"action", 1, -1}},
+		reflect.TypeOf(fhir3.TestReportTeardownAction{}):                              {{"operation", 1, 1}},
+		reflect.TypeOf(fhir3.TestReportTest{}):                                        {{"action", 1, -1}},
+		reflect.TypeOf(fhir3.TestScript{}):                                            {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptDestination{}):                                 {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptMetadata{}):                                    {{"capability", 1, -1}},
+		reflect.TypeOf(fhir3.TestScriptMetadataCapability{}):                          {{"capabilities", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptMetadataLink{}):                                {{"url", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptOrigin{}):                                      {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptRule{}):                                        {{"resource", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptRuleParam{}):                                   {{"name", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptRuleset{}):                                     {{"resource", 1, 1}, {"rule", 1, -1}},
+		reflect.TypeOf(fhir3.TestScriptRulesetRule{}):                                 {{"ruleId", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptRulesetRuleParam{}):                            {{"name", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptSetup{}):                                       {{"action", 1, -1}},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertRule{}):                       {{"ruleId", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertRuleParam{}):                  {{"name", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertRuleset{}):                    {{"rulesetId", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertRulesetRule{}):                {{"ruleId", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertRulesetRuleParam{}):           {{"name", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptSetupActionOperationRequestHeader{}):           {{"field", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptTeardown{}):                                    {{"action", 1, -1}},
+		reflect.TypeOf(fhir3.TestScriptTeardownAction{}):                              {{"operation", 1, 1}},
+		reflect.TypeOf(fhir3.TestScriptTest{}):                                        {{"action", 1, -1}},
+		reflect.TypeOf(fhir3.TestScriptVariable{}):                                    {{"name", 1, 1}},
+		reflect.TypeOf(fhir3.ValueSet{}):                                              {{"status", 1, 1}},
+		reflect.TypeOf(fhir3.ValueSetCompose{}):                                       {{"include", 1, -1}},
+		reflect.TypeOf(fhir3.ValueSetComposeIncludeConcept{}):                         {{"code", 1, 1}},
+		reflect.TypeOf(fhir3.ValueSetComposeIncludeConceptDesignation{}):              {{"value", 1, 1}},
+		reflect.TypeOf(fhir3.ValueSetComposeIncludeFilter{}):                          {{"property", 1, 1}, {"op", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir3.ValueSetExpansion{}):                                     {{"identifier", 1, 1}, {"timestamp", 1, 1}},
+		reflect.TypeOf(fhir3.ValueSetExpansionParameter{}):                            {{"name", 1, 1}},
+	},
+
+	// enumValues lists the allowed values of the code enum types
+	enumValues: map[reflect.Type][]string{
+		reflect.TypeOf(common.AddressType("")):                                     {"postal", "physical", "both"},
+		reflect.TypeOf(common.AddressUse("")):                                      {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(common.ContactPointSystem("")):                              {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(common.ContactPointUse("")):                                 {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(common.ContributorType("")):                                 {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(common.DataRequirementSortDirection("")):                    {"ascending", "descending"},
+		reflect.TypeOf(common.HumanNameUse("")):                                    {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(common.IdentifierUse("")):                                   {"usual", "official", "temp", "secondary", "old"},
+		reflect.TypeOf(common.ParameterUse("")):                                    {"in", "out"},
+		reflect.TypeOf(common.QuantityComparator("")):                              {"<", "<=", ">", ">="},
+		reflect.TypeOf(common.RelatedArtifactType("")):                             {"documentation", "justification", "citation", "predecessor", "successor", "derived-from", "depends-on", "composed-of"},
+		reflect.TypeOf(common.TriggerDefinitionType("")):                           {"named-event", "periodic", "data-changed", "data-added", "data-modified", "data-removed", "data-accessed", "data-access-ended"},
+		reflect.TypeOf(fhir3.AccountStatus("")):                                    {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir3.ActivityDefinitionParticipantType("")):                {"patient", "practitioner", "related-person"},
+		reflect.TypeOf(fhir3.ActivityDefinitionStatus("")):                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.AddressType("")):                                      {"postal", "physical", "both"},
+		reflect.TypeOf(fhir3.AddressUse("")):                                       {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(fhir3.AdministrativeGender("")):                             {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir3.AdverseEventCategory("")):                             {"AE", "PAE"},
+		reflect.TypeOf(fhir3.AdverseEventSuspectEntityCausality("")):               {"causality1", "causality2"},
+		reflect.TypeOf(fhir3.AllergyIntoleranceCategory("")):                       {"food", "medication", "environment", "biologic"},
+		reflect.TypeOf(fhir3.AllergyIntoleranceClinicalStatus("")):                 {"active", "inactive", "resolved"},
+		reflect.TypeOf(fhir3.AllergyIntoleranceCriticality("")):                    {"low", "high", "unable-to-assess"},
+		reflect.TypeOf(fhir3.AllergyIntoleranceReactionSeverity("")):               {"mild", "moderate", "severe"},
+		reflect.TypeOf(fhir3.AllergyIntoleranceType("")):                           {"allergy", "intolerance"},
+		reflect.TypeOf(fhir3.AllergyIntoleranceVerificationStatus("")):             {"unconfirmed", "confirmed", "refuted", "entered-in-error"},
+		reflect.TypeOf(fhir3.AppointmentParticipantRequired("")):                   {"required", "optional", "information-only"},
+		reflect.TypeOf(fhir3.AppointmentParticipantStatus("")):                     {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir3.AppointmentResponseParticipantStatus("")):             {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir3.AppointmentStatus("")):                                {"proposed", "pending", "booked", "arrived", "fulfilled", "cancelled", "noshow", "entered-in-error"},
+		reflect.TypeOf(fhir3.BundleEntryRequestMethod("")):                         {"GET", "POST", "PUT", "DELETE"},
+		reflect.TypeOf(fhir3.BundleEntrySearchMode("")):                            {"match", "include", "outcome"},
+		reflect.TypeOf(fhir3.BundleType("")):                                       {"document", "message", "transaction", "transaction-response", "batch", "batch-response", "history", "searchset", "collection"},
+		reflect.TypeOf(fhir3.CapabilityStatementAcceptUnknown("")):                 {"no", "extensions", "elements", "both"},
+		reflect.TypeOf(fhir3.CapabilityStatementDocumentMode("")):                  {"producer", "consumer"},
+		reflect.TypeOf(fhir3.CapabilityStatementKind("")):                          {"instance", "capability", "requirements"},
+		reflect.TypeOf(fhir3.CapabilityStatementMessagingEventCategory("")):        {"Consequence", "Currency", "Notification"},
+		reflect.TypeOf(fhir3.CapabilityStatementMessagingEventMode("")):            {"sender", "receiver"},
+		reflect.TypeOf(fhir3.CapabilityStatementMessagingSupportedMessageMode("")): {"sender", "receiver"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestInteractionCode("")):           {"transaction", "batch", "search-system", "history-system"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestMode("")):                      {"client", "server"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceConditionalDelete("")): {"not-supported", "single", "multiple"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceConditionalRead("")):   {"not-supported", "modified-since", "not-match", "full-support"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceInteractionCode("")):   {"read", "vread", "update", "patch", "delete", "history-instance", "history-type", "create", "search-type"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceReferencePolicy("")):   {"literal", "logical", "resolves", "enforced", "local"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceSearchParamType("")):   {"number", "date", "string", "token", "reference", "composite", "quantity", "uri"},
+		reflect.TypeOf(fhir3.CapabilityStatementRestResourceVersioning("")):        {"no-version", "versioned", "versioned-update"},
+		reflect.TypeOf(fhir3.CapabilityStatementStatus("")):                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.CarePlanActivityStatus("")):                           {"not-started", "scheduled", "in-progress", "on-hold", "completed", "cancelled", "stopped", "unknown", "entered-in-error"},
+		reflect.TypeOf(fhir3.CarePlanIntent("")):                                   {"proposal", "plan", "order", "option"},
+		reflect.TypeOf(fhir3.CarePlanStatus("")):                                   {"draft", "active", "suspended", "completed", "entered-in-error", "cancelled", "unknown"},
+		reflect.TypeOf(fhir3.CareTeamStatus("")):                                   {"proposed", "active", "suspended", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir3.ChargeItemStatus("")):                                 {"planned", "billable", "not-billable", "aborted", "billed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.ClaimResponseStatus("")):                              {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.ClaimStatus("")):                                      {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.ClaimUse("")):                                         {"complete", "proposed", "exploratory", "other"},
+		reflect.TypeOf(fhir3.ClinicalImpressionStatus("")):                         {"draft", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir3.CodeSystemContent("")):                                {"not-present", "example", "fragment", "complete"},
+		reflect.TypeOf(fhir3.CodeSystemHierarchyMeaning("")):                       {"grouped-by", "is-a", "part-of", "classified-with"},
+		reflect.TypeOf(fhir3.CodeSystemPropertyType("")):                           {"code", "Coding", "string", "integer", "boolean", "dateTime"},
+		reflect.TypeOf(fhir3.CodeSystemStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.CommunicationRequestStatus("")):                       {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.CommunicationStatus("")):                              {"preparation", "in-progress", "suspended", "aborted", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir3.CompartmentDefinitionCode("")):                        {"Patient", "Encounter", "RelatedPerson", "Practitioner", "Device"},
+		reflect.TypeOf(fhir3.CompartmentDefinitionStatus("")):                      {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.CompositionAttesterMode("")):                          {"personal", "professional", "legal", "official"},
+		reflect.TypeOf(fhir3.CompositionSectionMode("")):                           {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir3.CompositionStatus("")):                                {"preliminary", "final", "amended", "entered-in-error"},
+		reflect.TypeOf(fhir3.ConceptMapGroupElementTargetEquivalence("")):          {"relatedto", "equivalent", "equal", "wider", "subsumes", "narrower", "specializes", "inexact", "unmatched", "disjoint"},
+		reflect.TypeOf(fhir3.ConceptMapGroupUnmappedMode("")):                      {"provided", "fixed", "other-map"},
+		reflect.TypeOf(fhir3.ConceptMapStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.ConditionClinicalStatus("")):                          {"active", "recurrence", "relapse", "inactive", "remission", "resolved"},
+		reflect.TypeOf(fhir3.ConditionVerificationStatus("")):                      {"provisional", "differential", "confirmed", "refuted", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.ConsentDataMeaning("")):                               {"instance", "related", "dependents", "authoredby"},
+		reflect.TypeOf(fhir3.ConsentExceptType("")):                                {"deny", "permit"},
+		reflect.TypeOf(fhir3.ConsentStatus("")):                                    {"draft", "proposed", "active", "rejected", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir3.ContactPointSystem("")):                               {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(fhir3.ContactPointUse("")):                                  {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(fhir3.ContractStatus("")):                                   {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir3.ContributorType("")):                                  {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(fhir3.CoverageStatus("")):                                   {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.DataElementStatus("")):                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.DataElementStringency("")):                            {"comparable", "fully-specified", "equivalent", "convertable", "scaleable", "flexible"},
+		reflect.TypeOf(fhir3.DetectedIssueSeverity("")):                            {"high", "moderate", "low"},
+		reflect.TypeOf(fhir3.DetectedIssueStatus("")):                              {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.DeviceComponentMeasurementPrinciple("")):              {"other", "chemical", "electrical", "impedance", "nuclear", "optical", "thermal", "biological", "mechanical", "acoustical", "manual"},
+		reflect.TypeOf(fhir3.DeviceMetricCalibrationState("")):                     {"not-calibrated", "calibration-required", "calibrated", "unspecified"},
+		reflect.TypeOf(fhir3.DeviceMetricCalibrationType("")):                      {"unspecified", "offset", "gain", "two-point"},
+		reflect.TypeOf(fhir3.DeviceMetricCategory("")):                             {"measurement", "setting", "calculation", "unspecified"},
+		reflect.TypeOf(fhir3.DeviceMetricColor("")):                                {"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"},
+		reflect.TypeOf(fhir3.DeviceMetricOperationalStatus("")):                    {"on", "off", "standby", "entered-in-error"},
+		reflect.TypeOf(fhir3.DeviceRequestStatus("")):                              {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.DeviceStatus("")):                                     {"active", "inactive", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.DeviceUseStatementStatus("")):                         {"active", "completed", "entered-in-error", "intended", "stopped", "on-hold"},
+		reflect.TypeOf(fhir3.DiagnosticReportStatus("")):                           {"registered", "partial", "preliminary", "final", "amended", "corrected", "appended", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.DocumentManifestStatus("")):                           {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir3.DocumentReferenceStatus("")):                          {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir3.ElementDefinitionBindingStrength("")):                 {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir3.ElementDefinitionConstraintSeverity("")):              {"error", "warning"},
+		reflect.TypeOf(fhir3.ElementDefinitionRepresentation("")):                  {"xmlAttr", "xmlText", "typeAttr", "cdaText", "xhtml"},
+		reflect.TypeOf(fhir3.ElementDefinitionSlicingDiscriminatorType("")):        {"value", "exists", "pattern", "type", "profile"},
+		reflect.TypeOf(fhir3.ElementDefinitionSlicingRules("")):                    {"closed", "open", "openAtEnd"},
+		reflect.TypeOf(fhir3.ElementDefinitionTypeAggregation("")):                 {"contained", "referenced", "bundled"},
+		reflect.TypeOf(fhir3.ElementDefinitionTypeVersioning("")):                  {"either", "independent", "specific"},
+		reflect.TypeOf(fhir3.EligibilityRequestStatus("")):                         {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.EligibilityResponseStatus("")):                        {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.EncounterLocationStatus("")):                          {"planned", "active", "reserved", "completed"},
+		reflect.TypeOf(fhir3.EncounterStatus("")):                                  {"planned", "arrived", "triaged", "in-progress", "onleave", "finished", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.EndpointStatus("")):                                   {"active", "suspended", "error", "off", "entered-in-error", "test"},
+		reflect.TypeOf(fhir3.EnrollmentRequestStatus("")):                          {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.EnrollmentResponseStatus("")):                         {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.EpisodeOfCareStatus("")):                              {"planned", "waitlist", "active", "onhold", "finished", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir3.ExpansionProfileFixedVersionMode("")):                 {"default", "check", "override"},
+		reflect.TypeOf(fhir3.ExpansionProfileStatus("")):                           {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.ExplanationOfBenefitStatus("")):                       {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.FamilyMemberHistoryGender("")):                        {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir3.FamilyMemberHistoryStatus("")):                        {"partial", "completed", "entered-in-error", "health-unknown"},
+		reflect.TypeOf(fhir3.FlagStatus("")):                                       {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir3.GoalStatus("")):                                       {"proposed", "accepted", "planned", "in-progress", "on-target", "ahead-of-target", "behind-target", "sustaining", "achieved", "on-hold", "cancelled", "entered-in-error", "rejected"},
+		reflect.TypeOf(fhir3.GraphDefinitionLinkTargetCompartmentRule("")):         {"identical", "matching", "different", "custom"},
+		reflect.TypeOf(fhir3.GraphDefinitionStatus("")):                            {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.GroupType("")):                                        {"person", "animal", "practitioner", "device", "medication", "substance"},
+		reflect.TypeOf(fhir3.GuidanceResponseStatus("")):                           {"success", "data-requested", "data-required", "in-progress", "failure", "entered-in-error"},
+		reflect.TypeOf(fhir3.HealthcareServiceAvailableTimeDaysOfWeek("")):         {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir3.HumanNameUse("")):                                     {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(fhir3.ImagingStudyAvailability("")):                         {"ONLINE", "OFFLINE", "NEARLINE", "UNAVAILABLE"},
+		reflect.TypeOf(fhir3.ImagingStudySeriesAvailability("")):                   {"ONLINE", "OFFLINE", "NEARLINE", "UNAVAILABLE"},
+		reflect.TypeOf(fhir3.ImmunizationStatus("")):                               {"completed", "entered-in-error"},
+		reflect.TypeOf(fhir3.ImplementationGuideDependencyType("")):                {"reference", "inclusion"},
+		reflect.TypeOf(fhir3.ImplementationGuidePageKind("")):                      {"page", "example", "list", "include", "directory", "dictionary", "toc", "resource"},
+		reflect.TypeOf(fhir3.ImplementationGuideStatus("")):                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.LibraryStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.LinkageItemType("")):                                  {"source", "alternate", "historical"},
+		reflect.TypeOf(fhir3.ListMode("")):                                         {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir3.ListStatus("")):                                       {"current", "retired", "entered-in-error"},
+		reflect.TypeOf(fhir3.LocationMode("")):                                     {"instance", "kind"},
+		reflect.TypeOf(fhir3.LocationStatus("")):                                   {"active", "suspended", "inactive"},
+		reflect.TypeOf(fhir3.MeasureReportStatus("")):                              {"complete", "pending", "error"},
+		reflect.TypeOf(fhir3.MeasureReportType("")):                                {"individual", "patient-list", "summary"},
+		reflect.TypeOf(fhir3.MeasureStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.MediaType("")):                                        {"photo", "video", "audio"},
+		reflect.TypeOf(fhir3.MedicationAdministrationStatus("")):                   {"in-progress", "on-hold", "completed", "entered-in-error", "stopped", "unknown"},
+		reflect.TypeOf(fhir3.MedicationDispenseStatus("")):                         {"preparation", "in-progress", "on-hold", "completed", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir3.MedicationRequestIntent("")):                          {"proposal", "plan", "order", "instance-order"},
+		reflect.TypeOf(fhir3.MedicationRequestPriority("")):                        {"routine", "urgent", "stat", "asap"},
+		reflect.TypeOf(fhir3.MedicationRequestStatus("")):                          {"active", "on-hold", "cancelled", "completed", "entered-in-error", "stopped", "draft", "unknown"},
+		reflect.TypeOf(fhir3.MedicationStatementStatus("")):                        {"active", "completed", "entered-in-error", "intended", "stopped", "on-hold"},
+		reflect.TypeOf(fhir3.MedicationStatementTaken("")):                         {"y", "n", "unk", "na"},
+		reflect.TypeOf(fhir3.MedicationStatus("")):                                 {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir3.MessageDefinitionCategory("")):                        {"Consequence", "Currency", "Notification"},
+		reflect.TypeOf(fhir3.MessageDefinitionStatus("")):                          {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.MessageHeaderResponseCode("")):                        {"ok", "transient-error", "fatal-error"},
+		reflect.TypeOf(fhir3.NamingSystemKind("")):                                 {"codesystem", "identifier", "root"},
+		reflect.TypeOf(fhir3.NamingSystemStatus("")):                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.NamingSystemUniqueIdType("")):                         {"oid", "uuid", "uri", "other"},
+		reflect.TypeOf(fhir3.NarrativeStatus("")):                                  {"generated", "extensions", "additional", "empty"},
+		reflect.TypeOf(fhir3.NutritionOrderStatus("")):                             {"proposed", "draft", "planned", "requested", "active", "on-hold", "completed", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir3.ObservationRelatedType("")):                           {"has-member", "derived-from", "sequel-to", "replaces", "qualified-by", "interfered-by"},
+		reflect.TypeOf(fhir3.ObservationStatus("")):                                {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.OperationDefinitionKind("")):                          {"operation", "query"},
+		reflect.TypeOf(fhir3.OperationDefinitionParameterBindingStrength("")):      {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir3.OperationDefinitionParameterSearchType("")):           {"number", "date", "string", "token", "reference", "composite", "quantity", "uri"},
+		reflect.TypeOf(fhir3.OperationDefinitionParameterUse("")):                  {"in", "out"},
+		reflect.TypeOf(fhir3.OperationDefinitionStatus("")):                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.OperationOutcomeIssueSeverity("")):                    {"fatal", "error", "warning", "information"},
+		reflect.TypeOf(fhir3.PatientGender("")):                                    {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir3.PatientLinkType("")):                                  {"replaced-by", "replaces", "refer", "seealso"},
+		reflect.TypeOf(fhir3.PaymentNoticeStatus("")):                              {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.PaymentReconciliationStatus("")):                      {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.PersonLinkAssurance("")):                              {"level1", "level2", "level3", "level4"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionCardinalityBehavior("")):          {"single", "multiple"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionConditionKind("")):                {"applicability", "start", "stop"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionGroupingBehavior("")):             {"visual-group", "logical-group", "sentence-group"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionParticipantType("")):              {"patient", "practitioner", "related-person"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionPrecheckBehavior("")):             {"yes", "no"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionRelatedActionRelationship("")):    {"before-start", "before", "before-end", "concurrent-with-start", "concurrent", "concurrent-with-end", "after-start", "after", "after-end"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionRequiredBehavior("")):             {"must", "could", "must-unless-documented"},
+		reflect.TypeOf(fhir3.PlanDefinitionActionSelectionBehavior("")):            {"any", "all", "all-or-none", "exactly-one", "at-most-one", "one-or-more"},
+		reflect.TypeOf(fhir3.PlanDefinitionStatus("")):                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.PractitionerGender("")):                               {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir3.PractitionerRoleAvailableTimeDaysOfWeek("")):          {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir3.ProcedureRequestIntent("")):                           {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir3.ProcedureRequestPriority("")):                         {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir3.ProcedureRequestStatus("")):                           {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.ProcedureStatus("")):                                  {"preparation", "in-progress", "suspended", "aborted", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.ProcessRequestAction("")):                             {"cancel", "poll", "reprocess", "status"},
+		reflect.TypeOf(fhir3.ProcessRequestStatus("")):                             {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.ProcessResponseStatus("")):                            {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir3.ProvenanceEntityRole("")):                             {"derivation", "revision", "quotation", "source", "removal"},
+		reflect.TypeOf(fhir3.QuestionnaireItemType("")):                            {"group", "display", "question", "boolean", "decimal", "integer", "date", "dateTime", "time", "string", "text", "url", "choice", "open-choice", "attachment", "reference", "quantity"},
+		reflect.TypeOf(fhir3.QuestionnaireResponseStatus("")):                      {"in-progress", "completed", "amended", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir3.QuestionnaireStatus("")):                              {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.ReferralRequestIntent("")):                            {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir3.ReferralRequestStatus("")):                            {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.RequestGroupActionCardinalityBehavior("")):            {"single", "multiple"},
+		reflect.TypeOf(fhir3.RequestGroupActionConditionKind("")):                  {"applicability", "start", "stop"},
+		reflect.TypeOf(fhir3.RequestGroupActionGroupingBehavior("")):               {"visual-group", "logical-group", "sentence-group"},
+		reflect.TypeOf(fhir3.RequestGroupActionPrecheckBehavior("")):               {"yes", "no"},
+		reflect.TypeOf(fhir3.RequestGroupActionRelatedActionRelationship("")):      {"before-start", "before", "before-end", "concurrent-with-start", "concurrent", "concurrent-with-end", "after-start", "after", "after-end"},
+		reflect.TypeOf(fhir3.RequestGroupActionRequiredBehavior("")):               {"must", "could", "must-unless-documented"},
+		reflect.TypeOf(fhir3.RequestGroupActionSelectionBehavior("")):              {"any", "all", "all-or-none", "exactly-one", "at-most-one", "one-or-more"},
+		reflect.TypeOf(fhir3.RequestGroupIntent("")):                               {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir3.RequestGroupPriority("")):                             {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir3.RequestGroupStatus("")):                               {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.ResearchStudyStatus("")):                              {"draft", "in-progress", "suspended", "stopped", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir3.ResearchSubjectStatus("")):                            {"candidate", "eligible", "follow-up", "ineligible", "not-registered", "off-study", "on-study", "on-study-intervention", "on-study-observation", "pending-on-study", "potential-candidate", "screening", "withdrawn"},
+		reflect.TypeOf(fhir3.RiskAssessmentStatus("")):                             {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.SearchParameterComparator("")):                        {"eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap"},
+		reflect.TypeOf(fhir3.SearchParameterModifier("")):                          {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type"},
+		reflect.TypeOf(fhir3.SearchParameterStatus("")):                            {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.SearchParameterType("")):                              {"number", "date", "string", "token", "reference", "composite", "quantity", "uri"},
+		reflect.TypeOf(fhir3.SearchParameterXpathUsage("")):                        {"normal", "phonetic", "nearby", "distance", "other"},
+		reflect.TypeOf(fhir3.SequenceQualityType("")):                              {"indel", "snp", "unknown"},
+		reflect.TypeOf(fhir3.SequenceRepositoryType("")):                           {"directlink", "openapi", "login", "oauth", "other"},
+		reflect.TypeOf(fhir3.ServiceDefinitionStatus("")):                          {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.SlotStatus("")):                                       {"busy", "free", "busy-unavailable", "busy-tentative", "entered-in-error"},
+		reflect.TypeOf(fhir3.SpecimenStatus("")):                                   {"available", "unavailable", "unsatisfactory", "entered-in-error"},
+		reflect.TypeOf(fhir3.StructureDefinitionContextType("")):                   {"resource", "datatype", "extension"},
+		reflect.TypeOf(fhir3.StructureDefinitionDerivation("")):                    {"specialization", "constraint"},
+		reflect.TypeOf(fhir3.StructureDefinitionKind("")):                          {"primitive-type", "complex-type", "resource", "logical"},
+		reflect.TypeOf(fhir3.StructureDefinitionStatus("")):                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.StructureMapGroupInputMode("")):                       {"source", "target"},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleSourceListMode("")):              {"first", "not_first", "last", "not_last", "only_one"},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleTargetContextType("")):           {"type", "variable"},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleTargetListMode("")):              {"first", "share", "last", "collate"},
+		reflect.TypeOf(fhir3.StructureMapGroupRuleTargetTransform("")):             {"create", "copy", "truncate", "escape", "cast", "append", "translate", "reference", "dateOp", "uuid", "pointer", "evaluate", "cc", "c", "qty", "id", "cp"},
+		reflect.TypeOf(fhir3.StructureMapGroupTypeMode("")):                        {"none", "types", "type-and-types"},
+		reflect.TypeOf(fhir3.StructureMapStatus("")):                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.StructureMapStructureMode("")):                        {"source", "queried", "target", "produced"},
+		reflect.TypeOf(fhir3.SubscriptionChannelType("")):                          {"rest-hook", "websocket", "email", "sms", "message"},
+		reflect.TypeOf(fhir3.SubscriptionStatus("")):                               {"requested", "active", "error", "off"},
+		reflect.TypeOf(fhir3.SubstanceStatus("")):                                  {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir3.SupplyDeliveryStatus("")):                             {"in-progress", "completed", "abandoned", "entered-in-error"},
+		reflect.TypeOf(fhir3.SupplyRequestPriority("")):                            {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir3.SupplyRequestStatus("")):                              {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir3.TaskIntent("")):                                       {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir3.TaskPriority("")):                                     {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir3.TaskStatus("")):                                       {"draft", "requested", "received", "accepted", "rejected", "ready", "cancelled", "in-progress", "on-hold", "failed", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir3.TestReportParticipantType("")):                        {"test-engine", "client", "server"},
+		reflect.TypeOf(fhir3.TestReportResult("")):                                 {"pass", "fail", "pending"},
+		reflect.TypeOf(fhir3.TestReportSetupActionAssertResult("")):                {"pass", "skip", "fail", "warning", "error"},
+		reflect.TypeOf(fhir3.TestReportSetupActionOperationResult("")):             {"pass", "skip", "fail", "warning", "error"},
+		reflect.TypeOf(fhir3.TestReportStatus("")):                                 {"completed", "in-progress", "waiting", "stopped", "entered-in-error"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertContentType("")):           {"xml", "json", "ttl", "none"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertDirection("")):             {"response", "request"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertOperator("")):              {"equals", "notEquals", "in", "notIn", "greaterThan", "lessThan", "empty", "notEmpty", "contains", "notContains", "eval"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertRequestMethod("")):         {"delete", "get", "options", "patch", "post", "put"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionAssertResponse("")):              {"okay", "created", "noContent", "notModified", "bad", "forbidden", "notFound", "methodNotAllowed", "conflict", "gone", "preconditionFailed", "unprocessable"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionOperationAccept("")):             {"xml", "json", "ttl", "none"},
+		reflect.TypeOf(fhir3.TestScriptSetupActionOperationContentType("")):        {"xml", "json", "ttl", "none"},
+		reflect.TypeOf(fhir3.TestScriptStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.TimingRepeatDayOfWeek("")):                            {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir3.TimingRepeatDurationUnit("")):                         {"s", "min", "h", "d", "wk", "mo", "a"},
+		reflect.TypeOf(fhir3.TimingRepeatPeriodUnit("")):                           {"s", "min", "h", "d", "wk", "mo", "a"},
+		reflect.TypeOf(fhir3.TimingRepeatWhen("")):                                 {"HS", "WAKE", "C", "CM", "CD", "CV", "AC", "ACM", "ACD", "ACV", "PC", "PCM", "PCD", "PCV"},
+		reflect.TypeOf(fhir3.ValueSetComposeIncludeFilterOp("")):                   {"=", "is-a", "descendent-of", "is-not-a", "regex", "in", "not-in", "generalizes", "exists"},
+		reflect.TypeOf(fhir3.ValueSetStatus("")):                                   {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir3.VisionPrescriptionDispenseBase("")):                   {"up", "down", "in", "out"},
+		reflect.TypeOf(fhir3.VisionPrescriptionDispenseEye("")):                    {"right", "left"},
+		reflect.TypeOf(fhir3.VisionPrescriptionStatus("")):                         {"active", "cancelled", "draft", "entered-in-error"},
+	},
+}
@@ -0,0 +1,686 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+)
+
+// fhir4Rules are the validation rules of the fhir4 package
+var fhir4Rules = ruleSet{
+	// cardinalities lists the required and limited elements of each struct, a max of -1 is unbounded
+	cardinalities: map[reflect.Type][]cardinality{
+		reflect.TypeOf(common.Annotation{}):                                                                      {{"text", 1, 1}},
+		reflect.TypeOf(common.Contributor{}):                                                                     {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(common.DataRequirement{}):                                                                 {{"type", 1, 1}},
+		reflect.TypeOf(common.DataRequirementSort{}):                                                             {{"path", 1, 1}, {"direction", 1, 1}},
+		reflect.TypeOf(common.Expression{}):                                                                      {{"language", 1, 1}},
+		reflect.TypeOf(common.Extension{}):                                                                       {{"url", 1, 1}},
+		reflect.TypeOf(common.ParameterDefinition{}):                                                             {{"use", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(common.RelatedArtifact{}):                                                                 {{"type", 1, 1}},
+		reflect.TypeOf(common.SampledData{}):                                                                     {{"origin", 1, 1}, {"dimensions", 1, 1}},
+		reflect.TypeOf(common.Signature{}):                                                                       {{"type", 1, -1}, {"when", 1, 1}, {"who", 1, 1}},
+		reflect.TypeOf(common.TriggerDefinition{}):                                                               {{"type", 1, 1}},
+		reflect.TypeOf(common.UsageContext{}):                                                                    {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.Account{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.AccountCoverage{}):                                                                  {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir4.AccountGuarantor{}):                                                                 {{"party", 1, 1}},
+		reflect.TypeOf(fhir4.ActivityDefinition{}):                                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.ActivityDefinitionDynamicValue{}):                                                   {{"path", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir4.ActivityDefinitionParticipant{}):                                                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.AdverseEvent{}):                                                                     {{"actuality", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.AdverseEventSuspectEntity{}):                                                        {{"instance", 1, 1}},
+		reflect.TypeOf(fhir4.AllergyIntolerance{}):                                                               {{"patient", 1, 1}},
+		reflect.TypeOf(fhir4.AllergyIntoleranceReaction{}):                                                       {{"manifestation", 1, -1}},
+		reflect.TypeOf(fhir4.Annotation{}):                                                                       {{"text", 1, 1}},
+		reflect.TypeOf(fhir4.Appointment{}):                                                                      {{"status", 1, 1}, {"participant", 1, -1}},
+		reflect.TypeOf(fhir4.AppointmentParticipant{}):                                                           {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.AppointmentResponse{}):                                                              {{"appointment", 1, 1}, {"participantStatus", 1, 1}},
+		reflect.TypeOf(fhir4.AuditEvent{}):                                                                       {{"type", 1, 1}, {"recorded", 1, 1}, {"agent", 1, -1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir4.AuditEventAgent{}):                                                                  {{"requestor", 1, 1}},
+		reflect.TypeOf(fhir4.AuditEventEntityDetail{}):                                                           {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.AuditEventSource{}):                                                                 {{"observer", 1, 1}},
+		reflect.TypeOf(fhir4.Basic{}):                                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.Binary{}):                                                                           {{"contentType", 1, 1}},
+		reflect.TypeOf(fhir4.BodyStructure{}):                                                                    {{"patient", 1, 1}},
+		reflect.TypeOf(fhir4.Bundle{}):                                                                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.BundleEntryRequest{}):                                                               {{"method", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir4.BundleEntryResponse{}):                                                              {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.BundleLink{}):                                                                       {{"relation", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatement{}):                                                              {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}, {"fhirVersion", 1, 1}, {"format", 1, -1}},
+		reflect.TypeOf(fhir4.CapabilityStatementDocument{}):                                                      {{"mode", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementImplementation{}):                                                {{"description", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementMessagingEndpoint{}):                                             {{"protocol", 1, 1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementMessagingSupportedMessage{}):                                     {{"mode", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementRest{}):                                                          {{"mode", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementRestInteraction{}):                                               {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementRestResource{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementRestResourceInteraction{}):                                       {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementRestResourceOperation{}):                                         {{"name", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementRestResourceSearchParam{}):                                       {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.CapabilityStatementSoftware{}):                                                      {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.CatalogEntry{}):                                                                     {{"orderable", 1, 1}, {"referencedItem", 1, 1}},
+		reflect.TypeOf(fhir4.CatalogEntryRelatedEntry{}):                                                         {{"relationtype", 1, 1}, {"item", 1, 1}},
+		reflect.TypeOf(fhir4.ChargeItem{}):                                                                       {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.ChargeItemDefinition{}):                                                             {{"url", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4.ChargeItemDefinitionPropertyGroupPriceComponent{}):                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.ChargeItemPerformer{}):                                                              {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.Claim{}):                                                                            {{"status", 1, 1}, {"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"provider", 1, 1}, {"priority", 1, 1}, {"insurance", 1, -1}},
+		reflect.TypeOf(fhir4.ClaimAccident{}):                                                                    {{"date", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimCareTeam{}):                                                                    {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimInsurance{}):                                                                   {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimItem{}):                                                                        {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimItemDetail{}):                                                                  {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimItemDetailSubDetail{}):                                                         {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimPayee{}):                                                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponse{}):                                                                    {{"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}, {"outcome", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponseAddItem{}):                                                             {{"productOrService", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4.ClaimResponseAddItemDetail{}):                                                       {{"productOrService", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4.ClaimResponseAddItemDetailSubDetail{}):                                              {{"productOrService", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4.ClaimResponseError{}):                                                               {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponseInsurance{}):                                                           {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponseItem{}):                                                                {{"itemSequence", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4.ClaimResponseItemAdjudication{}):                                                    {{"category", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponseItemDetail{}):                                                          {{"detailSequence", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4.ClaimResponseItemDetailSubDetail{}):                                                 {{"subDetailSequence", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponsePayment{}):                                                             {{"type", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponseProcessNote{}):                                                         {{"text", 1, 1}},
+		reflect.TypeOf(fhir4.ClaimResponseTotal{}):                                                               {{"category", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4.ClinicalImpression{}):                                                               {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.ClinicalImpressionInvestigation{}):                                                  {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.CodeSystem{}):                                                                       {{"status", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir4.CodeSystemConcept{}):                                                                {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.CodeSystemConceptDesignation{}):                                                     {{"value", 1, 1}},
+		reflect.TypeOf(fhir4.CodeSystemConceptProperty{}):                                                        {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.CodeSystemFilter{}):                                                                 {{"code", 1, 1}, {"operator", 1, -1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.CodeSystemProperty{}):                                                               {{"code", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.Communication{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.CommunicationPayload{}):                                                             {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4.CommunicationRequest{}):                                                             {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.CommunicationRequestPayload{}):                                                      {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4.CompartmentDefinition{}):                                                            {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"code", 1, 1}, {"search", 1, 1}},
+		reflect.TypeOf(fhir4.CompartmentDefinitionResource{}):                                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.Composition{}):                                                                      {{"status", 1, 1}, {"type", 1, 1}, {"date", 1, 1}, {"author", 1, -1}, {"title", 1, 1}},
+		reflect.TypeOf(fhir4.CompositionAttester{}):                                                              {{"mode", 1, 1}},
+		reflect.TypeOf(fhir4.CompositionRelatesTo{}):                                                             {{"code", 1, 1}, {"target[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ConceptMap{}):                                                                       {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.ConceptMapGroup{}):                                                                  {{"element", 1, -1}},
+		reflect.TypeOf(fhir4.ConceptMapGroupElementTarget{}):                                                     {{"equivalence", 1, 1}},
+		reflect.TypeOf(fhir4.ConceptMapGroupElementTargetDependsOn{}):                                            {{"property", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.ConceptMapGroupUnmapped{}):                                                          {{"mode", 1, 1}},
+		reflect.TypeOf(fhir4.Condition{}):                                                                        {{"subject", 1, 1}},
+		reflect.TypeOf(fhir4.Consent{}):                                                                          {{"status", 1, 1}, {"scope", 1, 1}, {"category", 1, -1}},
+		reflect.TypeOf(fhir4.ConsentProvisionActor{}):                                                            {{"role", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir4.ConsentProvisionData{}):                                                             {{"meaning", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir4.ConsentVerification{}):                                                              {{"verified", 1, 1}},
+		reflect.TypeOf(fhir4.ContractContentDefinition{}):                                                        {{"type", 1, 1}, {"publicationStatus", 1, 1}},
+		reflect.TypeOf(fhir4.ContractFriendly{}):                                                                 {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ContractLegal{}):                                                                    {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ContractRule{}):                                                                     {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ContractSigner{}):                                                                   {{"type", 1, 1}, {"party", 1, 1}, {"signature", 1, -1}},
+		reflect.TypeOf(fhir4.ContractTerm{}):                                                                     {{"offer", 1, 1}},
+		reflect.TypeOf(fhir4.ContractTermAction{}):                                                               {{"type", 1, 1}, {"intent", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4.ContractTermActionSubject{}):                                                        {{"reference", 1, -1}},
+		reflect.TypeOf(fhir4.ContractTermOfferAnswer{}):                                                          {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ContractTermOfferParty{}):                                                           {{"reference", 1, -1}, {"role", 1, 1}},
+		reflect.TypeOf(fhir4.ContractTermSecurityLabel{}):                                                        {{"classification", 1, 1}},
+		reflect.TypeOf(fhir4.Contributor{}):                                                                      {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(fhir4.Coverage{}):                                                                         {{"status", 1, 1}, {"beneficiary", 1, 1}, {"payor", 1, -1}},
+		reflect.TypeOf(fhir4.CoverageClass{}):                                                                    {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageCostToBeneficiary{}):                                                        {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageCostToBeneficiaryException{}):                                               {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityRequest{}):                                                       {{"status", 1, 1}, {"purpose", 1, -1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityRequestInsurance{}):                                              {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityRequestSupportingInfo{}):                                         {{"sequence", 1, 1}, {"information", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponse{}):                                                      {{"status", 1, 1}, {"purpose", 1, -1}, {"patient", 1, 1}, {"created", 1, 1}, {"request", 1, 1}, {"outcome", 1, 1}, {"insurer", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponseError{}):                                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponseInsurance{}):                                             {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponseInsuranceItemBenefit{}):                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.DetectedIssue{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.DetectedIssueMitigation{}):                                                          {{"action", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDefinitionCapability{}):                                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDefinitionDeviceName{}):                                                       {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDefinitionMaterial{}):                                                         {{"substance", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDefinitionProperty{}):                                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDefinitionSpecialization{}):                                                   {{"systemType", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDefinitionUdiDeviceIdentifier{}):                                              {{"deviceIdentifier", 1, 1}, {"issuer", 1, 1}, {"jurisdiction", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceDeviceName{}):                                                                 {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceMetric{}):                                                                     {{"type", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceProperty{}):                                                                   {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceRequest{}):                                                                    {{"intent", 1, 1}, {"code[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceSpecialization{}):                                                             {{"systemType", 1, 1}},
+		reflect.TypeOf(fhir4.DeviceUseStatement{}):                                                               {{"status", 1, 1}, {"subject", 1, 1}, {"device", 1, 1}},
+		reflect.TypeOf(fhir4.DiagnosticReport{}):                                                                 {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4.DiagnosticReportMedia{}):                                                            {{"link", 1, 1}},
+		reflect.TypeOf(fhir4.DocumentManifest{}):                                                                 {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir4.DocumentReference{}):                                                                {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir4.DocumentReferenceContent{}):                                                         {{"attachment", 1, 1}},
+		reflect.TypeOf(fhir4.DocumentReferenceRelatesTo{}):                                                       {{"code", 1, 1}, {"target", 1, 1}},
+		reflect.TypeOf(fhir4.EffectEvidenceSynthesis{}):                                                          {{"status", 1, 1}, {"population", 1, 1}, {"exposure", 1, 1}, {"exposureAlternative", 1, 1}, {"outcome", 1, 1}},
+		reflect.TypeOf(fhir4.EffectEvidenceSynthesisResultsByExposure{}):                                         {{"riskEvidenceSynthesis", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinition{}):                                                                {{"path", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionBase{}):                                                            {{"path", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionBinding{}):                                                         {{"strength", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionConstraint{}):                                                      {{"key", 1, 1}, {"severity", 1, 1}, {"human", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionExample{}):                                                         {{"label", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionMapping{}):                                                         {{"identity", 1, 1}, {"map", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionSlicing{}):                                                         {{"rules", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionSlicingDiscriminator{}):                                            {{"type", 1, 1}, {"path", 1, 1}},
+		reflect.TypeOf(fhir4.ElementDefinitionType{}):                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.Encounter{}):                                                                        {{"status", 1, 1}, {"class", 1, 1}},
+		reflect.TypeOf(fhir4.EncounterClassHistory{}):                                                            {{"class", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4.EncounterDiagnosis{}):                                                               {{"condition", 1, 1}},
+		reflect.TypeOf(fhir4.EncounterLocation{}):                                                                {{"location", 1, 1}},
+		reflect.TypeOf(fhir4.EncounterStatusHistory{}):                                                           {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4.Endpoint{}):                                                                         {{"status", 1, 1}, {"connectionType", 1, 1}, {"payloadType", 1, -1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir4.EpisodeOfCare{}):                                                                    {{"status", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir4.EpisodeOfCareDiagnosis{}):                                                           {{"condition", 1, 1}},
+		reflect.TypeOf(fhir4.EpisodeOfCareStatusHistory{}):                                                       {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4.EventDefinition{}):                                                                  {{"status", 1, 1}, {"trigger", 1, -1}},
+		reflect.TypeOf(fhir4.Evidence{}):                                                                         {{"status", 1, 1}, {"exposureBackground", 1, 1}},
+		reflect.TypeOf(fhir4.EvidenceVariable{}):                                                                 {{"status", 1, 1}, {"characteristic", 1, -1}},
+		reflect.TypeOf(fhir4.EvidenceVariableCharacteristic{}):                                                   {{"definition[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenario{}):                                                                  {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioActor{}):                                                             {{"actorId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioInstance{}):                                                          {{"resourceId", 1, 1}, {"resourceType", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioInstanceContainedInstance{}):                                         {{"resourceId", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioInstanceVersion{}):                                                   {{"versionId", 1, 1}, {"description", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioProcess{}):                                                           {{"title", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioProcessStepAlternative{}):                                            {{"title", 1, 1}},
+		reflect.TypeOf(fhir4.ExampleScenarioProcessStepOperation{}):                                              {{"number", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefit{}):                                                             {{"status", 1, 1}, {"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}, {"provider", 1, 1}, {"outcome", 1, 1}, {"insurance", 1, -1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitAddItem{}):                                                      {{"bodySite", 0, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitBenefitBalance{}):                                               {{"category", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitBenefitBalanceFinancial{}):                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitCareTeam{}):                                                     {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitDiagnosis{}):                                                    {{"sequence", 1, 1}, {"diagnosis[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitInsurance{}):                                                    {{"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitItem{}):                                                         {{"sequence", 1, 1}, {"bodySite", 0, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitItemAdjudication{}):                                             {{"category", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitItemDetail{}):                                                   {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitItemDetailSubDetail{}):                                          {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitProcedure{}):                                                    {{"sequence", 1, 1}, {"procedure[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitSupportingInfo{}):                                               {{"sequence", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitTotal{}):                                                        {{"category", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4.FamilyMemberHistory{}):                                                              {{"status", 1, 1}, {"patient", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir4.FamilyMemberHistoryCondition{}):                                                     {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.Flag{}):                                                                             {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.Goal{}):                                                                             {{"lifecycleStatus", 1, 1}, {"description", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.GraphDefinition{}):                                                                  {{"name", 1, 1}, {"status", 1, 1}, {"start", 1, 1}},
+		reflect.TypeOf(fhir4.GraphDefinitionLinkTarget{}):                                                        {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.GraphDefinitionLinkTargetCompartment{}):                                             {{"use", 1, 1}, {"code", 1, 1}, {"rule", 1, 1}},
+		reflect.TypeOf(fhir4.Group{}):                                                                            {{"type", 1, 1}, {"actual", 1, 1}},
+		reflect.TypeOf(fhir4.GroupCharacteristic{}):                                                              {{"code", 1, 1}, {"value[x]", 1, 1}, {"exclude", 1, 1}},
+		reflect.TypeOf(fhir4.GroupMember{}):                                                                      {{"entity", 1, 1}},
+		reflect.TypeOf(fhir4.GuidanceResponse{}):                                                                 {{"module[x]", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4.HealthcareServiceNotAvailable{}):                                                    {{"description", 1, 1}},
+		reflect.TypeOf(fhir4.ImagingStudy{}):                                                                     {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.ImagingStudySeries{}):                                                               {{"uid", 1, 1}, {"modality", 1, 1}},
+		reflect.TypeOf(fhir4.ImagingStudySeriesInstance{}):                                                       {{"uid", 1, 1}, {"sopClass", 1, 1}},
+		reflect.TypeOf(fhir4.ImagingStudySeriesPerformer{}):                                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.Immunization{}):                                                                     {{"status", 1, 1}, {"vaccineCode", 1, 1}, {"patient", 1, 1}, {"occurrence[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ImmunizationEvaluation{}):                                                           {{"status", 1, 1}, {"patient", 1, 1}, {"targetDisease", 1, 1}, {"immunizationEvent", 1, 1}, {"doseStatus", 1, 1}},
+		reflect.TypeOf(fhir4.ImmunizationPerformer{}):                                                            {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.ImmunizationProtocolApplied{}):                                                      {{"doseNumber[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ImmunizationRecommendation{}):                                                       {{"patient", 1, 1}, {"date", 1, 1}, {"recommendation", 1, -1}},
+		reflect.TypeOf(fhir4.ImmunizationRecommendationRecommendation{}):                                         {{"forecastStatus", 1, 1}},
+		reflect.TypeOf(fhir4.ImmunizationRecommendationRecommendationDateCriterion{}):                            {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuide{}):                                                              {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"packageId", 1, 1}, {"fhirVersion", 1, -1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinition{}):                                                    {{"resource", 1, -1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinitionGrouping{}):                                            {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinitionPage{}):                                                {{"title", 1, 1}, {"generation", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinitionParameter{}):                                           {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinitionResource{}):                                            {{"reference", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinitionTemplate{}):                                            {{"code", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideDependsOn{}):                                                     {{"uri", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideGlobal{}):                                                        {{"type", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideManifest{}):                                                      {{"resource", 1, -1}},
+		reflect.TypeOf(fhir4.ImplementationGuideManifestPage{}):                                                  {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.ImplementationGuideManifestResource{}):                                              {{"reference", 1, 1}},
+		reflect.TypeOf(fhir4.InsurancePlanCoverage{}):                                                            {{"type", 1, 1}, {"benefit", 1, -1}},
+		reflect.TypeOf(fhir4.InsurancePlanCoverageBenefit{}):                                                     {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.InsurancePlanPlanSpecificCost{}):                                                    {{"category", 1, 1}},
+		reflect.TypeOf(fhir4.InsurancePlanPlanSpecificCostBenefit{}):                                             {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.InsurancePlanPlanSpecificCostBenefitCost{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.Invoice{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.InvoiceLineItem{}):                                                                  {{"chargeItem[x]", 1, 1}},
+		reflect.TypeOf(fhir4.InvoiceLineItemPriceComponent{}):                                                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.InvoiceParticipant{}):                                                               {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.Library{}):                                                                          {{"status", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.Linkage{}):                                                                          {{"item", 1, -1}},
+		reflect.TypeOf(fhir4.LinkageItem{}):                                                                      {{"type", 1, 1}, {"resource", 1, 1}},
+		reflect.TypeOf(fhir4.List{}):                                                                             {{"status", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir4.ListEntry{}):                                                                        {{"item", 1, 1}},
+		reflect.TypeOf(fhir4.LocationPosition{}):                                                                 {{"longitude", 1, 1}, {"latitude", 1, 1}},
+		reflect.TypeOf(fhir4.Measure{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.MeasureGroupPopulation{}):                                                           {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir4.MeasureReport{}):                                                                    {{"status", 1, 1}, {"type", 1, 1}, {"measure", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4.MeasureReportGroupStratifierStratumComponent{}):                                     {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.MeasureSupplementalData{}):                                                          {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir4.Media{}):                                                                            {{"status", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationAdministration{}):                                                         {{"status", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}, {"effective[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationAdministrationPerformer{}):                                                {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationDispense{}):                                                               {{"status", 1, 1}, {"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationDispensePerformer{}):                                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationDispenseSubstitution{}):                                                   {{"wasSubstituted", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationIngredient{}):                                                             {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeAdministrationGuidelinesDosage{}):                                {{"type", 1, 1}, {"dosage", 1, -1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics{}):                {{"characteristic[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeCost{}):                                                          {{"type", 1, 1}, {"cost", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeIngredient{}):                                                    {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeMedicineClassification{}):                                        {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeRegulatory{}):                                                    {{"regulatoryAuthority", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeRegulatoryMaxDispense{}):                                         {{"quantity", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeRegulatorySchedule{}):                                            {{"schedule", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeRegulatorySubstitution{}):                                        {{"type", 1, 1}, {"allowed", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationKnowledgeRelatedMedicationKnowledge{}):                                    {{"type", 1, 1}, {"reference", 1, -1}},
+		reflect.TypeOf(fhir4.MedicationRequest{}):                                                                {{"status", 1, 1}, {"intent", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationRequestSubstitution{}):                                                    {{"allowed[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicationStatement{}):                                                              {{"status", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProduct{}):                                                                 {{"name", 1, -1}},
+		reflect.TypeOf(fhir4.MedicinalProductAuthorizationProcedure{}):                                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductContraindicationOtherTherapy{}):                                     {{"therapyRelationshipType", 1, 1}, {"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductIndicationOtherTherapy{}):                                           {{"therapyRelationshipType", 1, 1}, {"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductIngredient{}):                                                       {{"role", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductIngredientSpecifiedSubstance{}):                                     {{"code", 1, 1}, {"group", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductIngredientSpecifiedSubstanceStrength{}):                             {{"presentation", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductIngredientSpecifiedSubstanceStrengthReferenceStrength{}):            {{"strength", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductIngredientSubstance{}):                                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductInteractionInteractant{}):                                           {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductManufactured{}):                                                     {{"manufacturedDoseForm", 1, 1}, {"quantity", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductName{}):                                                             {{"productName", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductNameCountryLanguage{}):                                              {{"country", 1, 1}, {"language", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductNameNamePart{}):                                                     {{"part", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductPackaged{}):                                                         {{"packageItem", 1, -1}},
+		reflect.TypeOf(fhir4.MedicinalProductPackagedBatchIdentifier{}):                                          {{"outerPackaging", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductPackagedPackageItem{}):                                              {{"type", 1, 1}, {"quantity", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductPharmaceutical{}):                                                   {{"administrableDoseForm", 1, 1}, {"routeOfAdministration", 1, -1}},
+		reflect.TypeOf(fhir4.MedicinalProductPharmaceuticalCharacteristics{}):                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductPharmaceuticalRouteOfAdministration{}):                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpecies{}):                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.MedicinalProductPharmaceuticalRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}): {{"tissue", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.MessageDefinition{}):                                                                {{"status", 1, 1}, {"date", 1, 1}, {"event[x]", 1, 1}},
+		reflect.TypeOf(fhir4.MessageDefinitionAllowedResponse{}):                                                 {{"message", 1, 1}},
+		reflect.TypeOf(fhir4.MessageDefinitionFocus{}):                                                           {{"code", 1, 1}, {"min", 1, 1}},
+		reflect.TypeOf(fhir4.MessageHeader{}):                                                                    {{"event[x]", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir4.MessageHeaderDestination{}):                                                         {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir4.MessageHeaderResponse{}):                                                            {{"identifier", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4.MessageHeaderSource{}):                                                              {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir4.MolecularSequence{}):                                                                {{"coordinateSystem", 1, 1}},
+		reflect.TypeOf(fhir4.MolecularSequenceQuality{}):                                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.MolecularSequenceRepository{}):                                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.NamingSystem{}):                                                                     {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"date", 1, 1}, {"uniqueId", 1, -1}},
+		reflect.TypeOf(fhir4.NamingSystemUniqueId{}):                                                             {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.Narrative{}):                                                                        {{"status", 1, 1}, {"div", 1, 1}},
+		reflect.TypeOf(fhir4.NutritionOrder{}):                                                                   {{"status", 1, 1}, {"intent", 1, 1}, {"patient", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir4.Observation{}):                                                                      {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4.ObservationComponent{}):                                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.ObservationDefinition{}):                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.OperationDefinition{}):                                                              {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"code", 1, 1}, {"system", 1, 1}, {"type", 1, 1}, {"instance", 1, 1}},
+		reflect.TypeOf(fhir4.OperationDefinitionParameter{}):                                                     {{"name", 1, 1}, {"use", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir4.OperationDefinitionParameterBinding{}):                                              {{"strength", 1, 1}, {"valueSet", 1, 1}},
+		reflect.TypeOf(fhir4.OperationDefinitionParameterReferencedFrom{}):                                       {{"source", 1, 1}},
+		reflect.TypeOf(fhir4.OperationOutcome{}):                                                                 {{"issue", 1, -1}},
+		reflect.TypeOf(fhir4.OperationOutcomeIssue{}):                                                            {{"severity", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4.ParametersParameter{}):                                                              {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.PatientCommunication{}):                                                             {{"language", 1, 1}},
+		reflect.TypeOf(fhir4.PatientLink{}):                                                                      {{"other", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.PaymentNotice{}):                                                                    {{"status", 1, 1}, {"created", 1, 1}, {"payment", 1, 1}, {"recipient", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4.PaymentReconciliation{}):                                                            {{"status", 1, 1}, {"created", 1, 1}, {"paymentDate", 1, 1}, {"paymentAmount", 1, 1}},
+		reflect.TypeOf(fhir4.PaymentReconciliationDetail{}):                                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.PersonLink{}):                                                                       {{"target", 1, 1}},
+		reflect.TypeOf(fhir4.PlanDefinition{}):                                                                   {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.PlanDefinitionActionCondition{}):                                                    {{"kind", 1, 1}},
+		reflect.TypeOf(fhir4.PlanDefinitionActionParticipant{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.PlanDefinitionActionRelatedAction{}):                                                {{"actionId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir4.PlanDefinitionGoal{}):                                                               {{"description", 1, 1}},
+		reflect.TypeOf(fhir4.PractitionerQualification{}):                                                        {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.PractitionerRoleNotAvailable{}):                                                     {{"description", 1, 1}},
+		reflect.TypeOf(fhir4.Procedure{}):                                                                        {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.ProcedureFocalDevice{}):                                                             {{"manipulated", 1, 1}},
+		reflect.TypeOf(fhir4.ProcedurePerformer{}):                                                               {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4.ProductShelfLife{}):                                                                 {{"type", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4.Provenance{}):                                                                       {{"target", 1, -1}, {"recorded", 1, 1}, {"agent", 1, -1}},
+		reflect.TypeOf(fhir4.ProvenanceAgent{}):                                                                  {{"who", 1, 1}},
+		reflect.TypeOf(fhir4.ProvenanceEntity{}):                                                                 {{"role", 1, 1}, {"what", 1, 1}},
+		reflect.TypeOf(fhir4.Questionnaire{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.QuestionnaireItem{}):                                                                {{"linkId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.QuestionnaireItemAnswerOption{}):                                                    {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.QuestionnaireItemEnableWhen{}):                                                      {{"question", 1, 1}, {"operator", 1, 1}, {"answer[x]", 1, 1}},
+		reflect.TypeOf(fhir4.QuestionnaireItemInitial{}):                                                         {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.QuestionnaireResponse{}):                                                            {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.QuestionnaireResponseItem{}):                                                        {{"linkId", 1, 1}},
+		reflect.TypeOf(fhir4.RelatedPerson{}):                                                                    {{"patient", 1, 1}},
+		reflect.TypeOf(fhir4.RelatedPersonCommunication{}):                                                       {{"language", 1, 1}},
+		reflect.TypeOf(fhir4.RequestGroup{}):                                                                     {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir4.RequestGroupActionCondition{}):                                                      {{"kind", 1, 1}},
+		reflect.TypeOf(fhir4.RequestGroupActionRelatedAction{}):                                                  {{"actionId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir4.ResearchDefinition{}):                                                               {{"status", 1, 1}, {"population", 1, 1}},
+		reflect.TypeOf(fhir4.ResearchElementDefinition{}):                                                        {{"status", 1, 1}, {"type", 1, 1}, {"characteristic", 1, -1}},
+		reflect.TypeOf(fhir4.ResearchElementDefinitionCharacteristic{}):                                          {{"definition[x]", 1, 1}},
+		reflect.TypeOf(fhir4.ResearchStudy{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.ResearchStudyArm{}):                                                                 {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.ResearchSubject{}):                                                                  {{"status", 1, 1}, {"study", 1, 1}, {"individual", 1, 1}},
+		reflect.TypeOf(fhir4.RiskAssessment{}):                                                                   {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.RiskEvidenceSynthesis{}):                                                            {{"status", 1, 1}, {"population", 1, 1}, {"outcome", 1, 1}},
+		reflect.TypeOf(fhir4.SampledData{}):                                                                      {{"origin", 1, 1}, {"period", 1, 1}, {"dimensions", 1, 1}},
+		reflect.TypeOf(fhir4.Schedule{}):                                                                         {{"actor", 1, -1}},
+		reflect.TypeOf(fhir4.SearchParameter{}):                                                                  {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"description", 1, 1}, {"code", 1, 1}, {"base", 1, -1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.SearchParameterComponent{}):                                                         {{"definition", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir4.ServiceRequest{}):                                                                   {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4.Signature{}):                                                                        {{"type", 1, -1}, {"when", 1, 1}, {"who", 1, 1}},
+		reflect.TypeOf(fhir4.Slot{}):                                                                             {{"schedule", 1, 1}, {"status", 1, 1}, {"start", 1, 1}, {"end", 1, 1}},
+		reflect.TypeOf(fhir4.SpecimenDefinitionTypeTestedContainerAdditive{}):                                    {{"additive[x]", 1, 1}},
+		reflect.TypeOf(fhir4.StructureDefinition{}):                                                              {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"abstract", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4.StructureDefinitionContext{}):                                                       {{"type", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir4.StructureDefinitionDifferential{}):                                                  {{"element", 1, -1}},
+		reflect.TypeOf(fhir4.StructureDefinitionMapping{}):                                                       {{"identity", 1, 1}},
+		reflect.TypeOf(fhir4.StructureDefinitionSnapshot{}):                                                      {{"element", 1, -1}},
+		reflect.TypeOf(fhir4.StructureMap{}):                                                                     {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"group", 1, -1}},
+		reflect.TypeOf(fhir4.StructureMapGroup{}):                                                                {{"name", 1, 1}, {"typeMode", 1, 1}, {"input", 1, -1}, {"rule", 1, -1}},
+		reflect.TypeOf(fhir4.StructureMapGroupInput{}):                                                           {{"name", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir4.StructureMapGroupRule{}):                                                            {{"name", 1, 1}, {"source", 1, -1}},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleDependent{}):                                                   {{"name", 1, 1}, {"variable", 1, -1}},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleSource{}):                                                      {{"context", 1, 1}},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleTargetParameter{}):                                             {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.StructureMapStructure{}):                                                            {{"url", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir4.Subscription{}):                                                                     {{"status", 1, 1}, {"reason", 1, 1}, {"criteria", 1, 1}, {"channel", 1, 1}},
+		reflect.TypeOf(fhir4.SubscriptionChannel{}):                                                              {{"type", 1, 1}},
+		reflect.TypeOf(fhir4.Substance{}):                                                                        {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.SubstanceIngredient{}):                                                              {{"substance[x]", 1, 1}},
+		reflect.TypeOf(fhir4.SubstanceSpecificationName{}):                                                       {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.SupplyRequest{}):                                                                    {{"item[x]", 1, 1}, {"quantity", 1, 1}},
+		reflect.TypeOf(fhir4.Task{}):                                                                             {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir4.TaskInput{}):                                                                        {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.TaskOutput{}):                                                                       {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilities{}):                                                          {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilitiesCodeSystemVersionFilter{}):                                   {{"code", 1, 1}, {"op", 1, -1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilitiesExpansionParameter{}):                                        {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilitiesImplementation{}):                                            {{"description", 1, 1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilitiesSoftware{}):                                                  {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilitiesTranslation{}):                                               {{"needsMap", 1, 1}},
+		reflect.TypeOf(fhir4.TerminologyCapabilitiesValidateCode{}):                                              {{"translations", 1, 1}},
+		reflect.TypeOf(fhir4.TestReport{}):                                                                       {{"status", 1, 1}, {"testScript", 1, 1}, {"result", 1, 1}},
+		reflect.TypeOf(fhir4.TestReportParticipant{}):                                                            {{"type", 1, 1}, {"uri", 1, 1}},
+		reflect.TypeOf(fhir4.TestReportSetup{}):                                                                  {{"action", 1, -1}},
+		reflect.TypeOf(fhir4.TestReportSetupActionAssert{}):                                                      {{"result", 1, 1}},
+		reflect.TypeOf(fhir4.TestReportSetupActionOperation{}):                                                   {{"result", 1, 1}},
+		reflect.TypeOf(fhir4.TestReportTeardown{}):                                                               {{"action", 1, -1}},
+		reflect.TypeOf(fhir4.TestReportTeardownAction{}):                                                         {{"operation", 1, 1}},
+		reflect.TypeOf(fhir4.TestReportTest{}):                                                                   {{"action", 1, -1}},
+		reflect.TypeOf(fhir4.TestScript{}):                                                                       {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptDestination{}):                                                            {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptFixture{}):                                                                {{"autocreate", 1, 1}, {"autodelete", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptMetadata{}):                                                               {{"capability", 1, -1}},
+		reflect.TypeOf(fhir4.TestScriptMetadataCapability{}):                                                     {{"required", 1, 1}, {"validated", 1, 1}, {"capabilities", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptMetadataLink{}):                                                           {{"url", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptOrigin{}):                                                                 {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptSetup{}):                                                                  {{"action", 1, -1}},
+		reflect.TypeOf(fhir4.TestScriptSetupActionAssert{}):                                                      {{"warningOnly", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptSetupActionOperation{}):                                                   {{"encodeRequestUrl", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptSetupActionOperationRequestHeader{}):                                      {{"field", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptTeardown{}):                                                               {{"action", 1, -1}},
+		reflect.TypeOf(fhir4.TestScriptTeardownAction{}):                                                         {{"operation", 1, 1}},
+		reflect.TypeOf(fhir4.TestScriptTest{}):                                                                   {{"action", 1, -1}},
+		reflect.TypeOf(fhir4.ValueSet{}):                                                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.ValueSetCompose{}):                                                                  {{"include", 1, -1}},
+		reflect.TypeOf(fhir4.ValueSetComposeIncludeConcept{}):                                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir4.ValueSetComposeIncludeConceptDesignation{}):                                         {{"value", 1, 1}},
+		reflect.TypeOf(fhir4.ValueSetComposeIncludeFilter{}):                                                     {{"property", 1, 1}, {"op", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4.ValueSetExpansion{}):                                                                {{"timestamp", 1, 1}},
+		reflect.TypeOf(fhir4.ValueSetExpansionParameter{}):                                                       {{"name", 1, 1}},
+		reflect.TypeOf(fhir4.VerificationResult{}):                                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir4.VerificationResultValidator{}):                                                      {{"organization", 1, 1}},
+		reflect.TypeOf(fhir4.VisionPrescription{}):                                                               {{"status", 1, 1}, {"created", 1, 1}, {"patient", 1, 1}, {"dateWritten", 1, 1}, {"prescriber", 1, 1}, {"lensSpecification", 1, -1}},
+		reflect.TypeOf(fhir4.VisionPrescriptionLensSpecification{}):                                              {{"product", 1, 1}, {"eye", 1, 1}},
+		reflect.TypeOf(fhir4.VisionPrescriptionLensSpecificationPrism{}):                                         {{"amount", 1, 1}, {"base", 1, 1}},
+	},
+
+	// enumValues lists the allowed values of the code enum types
+	enumValues: map[reflect.Type][]string{
+		reflect.TypeOf(common.AddressType("")):                                          {"postal", "physical", "both"},
+		reflect.TypeOf(common.AddressUse("")):                                           {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(common.ContactPointSystem("")):                                   {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(common.ContactPointUse("")):                                      {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(common.ContributorType("")):                                      {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(common.DataRequirementSortDirection("")):                         {"ascending", "descending"},
+		reflect.TypeOf(common.HumanNameUse("")):                                         {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(common.IdentifierUse("")):                                        {"usual", "official", "temp", "secondary", "old"},
+		reflect.TypeOf(common.ParameterUse("")):                                         {"in", "out"},
+		reflect.TypeOf(common.QuantityComparator("")):                                   {"<", "<=", ">", ">="},
+		reflect.TypeOf(common.RelatedArtifactType("")):                                  {"documentation", "justification", "citation", "predecessor", "successor", "derived-from", "depends-on", "composed-of"},
+		reflect.TypeOf(common.TriggerDefinitionType("")):                                {"named-event", "periodic", "data-changed", "data-added", "data-modified", "data-removed", "data-accessed", "data-access-ended"},
+		reflect.TypeOf(fhir4.AccountStatus("")):                                         {"active", "inactive", "entered-in-error", "on-hold", "unknown"},
+		reflect.TypeOf(fhir4.ActivityDefinitionIntent("")):                              {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4.ActivityDefinitionParticipantType("")):                     {"patient", "practitioner", "related-person", "device"},
+		reflect.TypeOf(fhir4.ActivityDefinitionPriority("")):                            {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.ActivityDefinitionStatus("")):                              {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.AddressType("")):                                           {"postal", "physical", "both"},
+		reflect.TypeOf(fhir4.AddressUse("")):                                            {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(fhir4.AdministrativeGender("")):                                  {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir4.AdverseEventActuality("")):                                 {"actual", "potential"},
+		reflect.TypeOf(fhir4.AllergyIntoleranceCategory("")):                            {"food", "medication", "environment", "biologic"},
+		reflect.TypeOf(fhir4.AllergyIntoleranceCriticality("")):                         {"low", "high", "unable-to-assess"},
+		reflect.TypeOf(fhir4.AllergyIntoleranceReactionSeverity("")):                    {"mild", "moderate", "severe"},
+		reflect.TypeOf(fhir4.AllergyIntoleranceType("")):                                {"allergy", "intolerance"},
+		reflect.TypeOf(fhir4.AppointmentParticipantStatus("")):                          {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir4.AppointmentResponseParticipantStatus("")):                  {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir4.AppointmentStatus("")):                                     {"proposed", "pending", "booked", "arrived", "fulfilled", "cancelled", "no-show", "entered-in-error", "checked-in", "waitlist"},
+		reflect.TypeOf(fhir4.AuditEventAction("")):                                      {"C", "R", "U", "D", "E"},
+		reflect.TypeOf(fhir4.AuditEventAgentNetworkType("")):                            {"1", "2", "3", "4", "5"},
+		reflect.TypeOf(fhir4.AuditEventOutcome("")):                                     {"0", "4", "8", "12"},
+		reflect.TypeOf(fhir4.BiologicallyDerivedProductCategory("")):                    {"organ", "tissue", "fluid", "cells", "biologicalAgent"},
+		reflect.TypeOf(fhir4.BiologicallyDerivedProductStatus("")):                      {"available", "unavailable"},
+		reflect.TypeOf(fhir4.BundleEntryRequestMethod("")):                              {"GET", "POST", "PUT", "DELETE", "PATCH"},
+		reflect.TypeOf(fhir4.BundleEntrySearchMode("")):                                 {"search", "match", "include", "outcome"},
+		reflect.TypeOf(fhir4.BundleType("")):                                            {"document", "message", "transaction", "transaction-response", "batch", "batch-response", "history", "searchset", "collection"},
+		reflect.TypeOf(fhir4.ChargeItemDefinitionPropertyGroupPriceComponentType("")):   {"base", "surcharge", "deduction", "discount", "tax", "informational"},
+		reflect.TypeOf(fhir4.ChargeItemDefinitionStatus("")):                            {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.ClaimResponseOutcome("")):                                  {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4.ClaimResponseProcessNoteType("")):                          {"display", "print", "printoper"},
+		reflect.TypeOf(fhir4.ClaimUse("")):                                              {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir4.CommunicationPriority("")):                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.CommunicationStatus("")):                                   {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.CompositionAttesterMode("")):                               {"personal", "professional", "legal", "official"},
+		reflect.TypeOf(fhir4.CompositionRelatesToCode("")):                              {"replaces", "transforms", "signs", "appends"},
+		reflect.TypeOf(fhir4.CompositionStatus("")):                                     {"preliminary", "final", "amended", "entered-in-error"},
+		reflect.TypeOf(fhir4.ConsentProvisionDataMeaning("")):                           {"instance", "related", "dependents", "authoredby"},
+		reflect.TypeOf(fhir4.ConsentProvisionType("")):                                  {"deny", "permit"},
+		reflect.TypeOf(fhir4.ConsentStatus("")):                                         {"draft", "proposed", "active", "rejected", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4.ContactPointSystem("")):                                    {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(fhir4.ContactPointUse("")):                                       {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(fhir4.ContractContentDefinitionPublicationStatus("")):            {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir4.ContractStatus("")):                                        {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir4.ContributorType("")):                                       {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(fhir4.CoverageEligibilityRequestPurpose("")):                     {"auth-requirements", "benefits", "discovery", "validation"},
+		reflect.TypeOf(fhir4.CoverageEligibilityRequestStatus("")):                      {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponseOutcome("")):                    {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponsePurpose("")):                    {"auth-requirements", "benefits", "discovery", "validation"},
+		reflect.TypeOf(fhir4.CoverageEligibilityResponseStatus("")):                     {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.CoverageStatus("")):                                        {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.DaysOfWeek("")):                                            {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir4.DetectedIssueSeverity("")):                                 {"high", "moderate", "low"},
+		reflect.TypeOf(fhir4.DetectedIssueStatus("")):                                   {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.DeviceDefinitionDeviceNameType("")):                        {"udi-label-name", "user-friendly-name", "patient-reported-name", "manufacturer-name", "model-name", "other"},
+		reflect.TypeOf(fhir4.DeviceDeviceNameType("")):                                  {"udi-label-name", "user-friendly-name", "patient-reported-name", "manufacturer-name", "model-name", "other"},
+		reflect.TypeOf(fhir4.DeviceMetricCalibrationState("")):                          {"not-calibrated", "calibration-required", "calibrated", "unspecified"},
+		reflect.TypeOf(fhir4.DeviceMetricCalibrationType("")):                           {"unspecified", "offset", "gain", "two-point"},
+		reflect.TypeOf(fhir4.DeviceMetricCategory("")):                                  {"measurement", "setting", "calculation", "unspecified"},
+		reflect.TypeOf(fhir4.DeviceMetricColor("")):                                     {"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"},
+		reflect.TypeOf(fhir4.DeviceMetricOperationalStatus("")):                         {"on", "off", "standby", "entered-in-error"},
+		reflect.TypeOf(fhir4.DeviceRequestIntent("")):                                   {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4.DeviceRequestPriority("")):                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.DeviceRequestStatus("")):                                   {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.DeviceStatus("")):                                          {"active", "inactive", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.DeviceUdiCarrierEntryType("")):                             {"barcode", "rfid", "manual", "card", "self-reported", "unknown"},
+		reflect.TypeOf(fhir4.DeviceUseStatementStatus("")):                              {"active", "completed", "entered-in-error", "intended", "stopped", "on-hold"},
+		reflect.TypeOf(fhir4.DiagnosticReportStatus("")):                                {"registered", "partial", "preliminary", "final", "amended", "corrected", "appended", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.DocumentManifestStatus("")):                                {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir4.DocumentReferenceDocStatus("")):                            {"preliminary", "final", "amended", "entered-in-error"},
+		reflect.TypeOf(fhir4.DocumentReferenceRelatesToCode("")):                        {"replaces", "transforms", "signs", "appends"},
+		reflect.TypeOf(fhir4.DocumentReferenceStatus("")):                               {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir4.EffectEvidenceSynthesisResultsByExposureExposureState("")): {"exposure", "exposure-alternative"},
+		reflect.TypeOf(fhir4.EffectEvidenceSynthesisStatus("")):                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.ElementDefinitionBindingStrength("")):                      {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir4.ElementDefinitionConstraintSeverity("")):                   {"error", "warning"},
+		reflect.TypeOf(fhir4.ElementDefinitionRepresentation("")):                       {"xmlAttr", "xmlText", "typeAttr", "cdaText", "xhtml"},
+		reflect.TypeOf(fhir4.ElementDefinitionSlicingDiscriminatorType("")):             {"value", "exists", "pattern", "type", "profile"},
+		reflect.TypeOf(fhir4.ElementDefinitionSlicingRules("")):                         {"closed", "open", "openAtEnd"},
+		reflect.TypeOf(fhir4.ElementDefinitionTypeAggregation("")):                      {"contained", "referenced", "bundled"},
+		reflect.TypeOf(fhir4.ElementDefinitionTypeVersioning("")):                       {"either", "independent", "specific"},
+		reflect.TypeOf(fhir4.EncounterLocationStatus("")):                               {"planned", "active", "reserved", "completed"},
+		reflect.TypeOf(fhir4.EncounterStatus("")):                                       {"planned", "arrived", "triaged", "in-progress", "onleave", "finished", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.EndpointStatus("")):                                        {"active", "suspended", "error", "off", "entered-in-error", "test"},
+		reflect.TypeOf(fhir4.EnrollmentRequestStatus("")):                               {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.EnrollmentResponseOutcome("")):                             {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4.EnrollmentResponseStatus("")):                              {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.EpisodeOfCareStatus("")):                                   {"planned", "waitlist", "active", "onhold", "finished", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir4.EventDefinitionStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.EventTiming("")):                                           {"MORN", "AFT", "EVE", "NIGHT", "PHS"},
+		reflect.TypeOf(fhir4.EvidenceStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.EvidenceVariableCharacteristicGroupMeasure("")):            {"mean", "median", "mean-of-mean", "mean-of-median", "median-of-mean", "median-of-median"},
+		reflect.TypeOf(fhir4.EvidenceVariableStatus("")):                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.EvidenceVariableType("")):                                  {"dichotomous", "continuous", "descriptive"},
+		reflect.TypeOf(fhir4.ExampleScenarioActorType("")):                              {"person", "entity"},
+		reflect.TypeOf(fhir4.ExampleScenarioStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitOutcome("")):                           {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitProcessNoteType("")):                   {"display", "print", "printoper"},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitStatus("")):                            {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.ExplanationOfBenefitUse("")):                               {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir4.FamilyMemberHistoryStatus("")):                             {"partial", "completed", "entered-in-error", "health-unknown"},
+		reflect.TypeOf(fhir4.FlagStatus("")):                                            {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4.GoalLifecycleStatus("")):                                   {"proposed", "planned", "accepted", "active", "on-hold", "completed", "cancelled", "entered-in-error", "rejected"},
+		reflect.TypeOf(fhir4.GraphDefinitionLinkTargetCompartmentCode("")):              {"Patient", "Encounter", "RelatedPerson", "Practitioner", "Device"},
+		reflect.TypeOf(fhir4.GraphDefinitionLinkTargetCompartmentRule("")):              {"identical", "matching", "different", "custom"},
+		reflect.TypeOf(fhir4.GraphDefinitionLinkTargetCompartmentUse("")):               {"condition", "requirement"},
+		reflect.TypeOf(fhir4.GraphDefinitionStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.GroupType("")):                                             {"person", "animal", "practitioner", "device", "medication", "substance"},
+		reflect.TypeOf(fhir4.GuidanceResponseStatus("")):                                {"success", "data-requested", "data-required", "in-progress", "failure", "entered-in-error"},
+		reflect.TypeOf(fhir4.HealthcareServiceAvailableTimeDaysOfWeek("")):              {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir4.ImagingStudyStatus("")):                                    {"registered", "available", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.ImmunizationEvaluationStatus("")):                          {"completed", "entered-in-error"},
+		reflect.TypeOf(fhir4.ImmunizationStatus("")):                                    {"completed", "entered-in-error", "not-done"},
+		reflect.TypeOf(fhir4.ImplementationGuideDefinitionPageGeneration("")):           {"html", "markdown", "xml", "generated"},
+		reflect.TypeOf(fhir4.ImplementationGuideStatus("")):                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.InsurancePlanStatus("")):                                   {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.InvoiceLineItemPriceComponentType("")):                     {"base", "surcharge", "deduction", "discount", "tax", "informational"},
+		reflect.TypeOf(fhir4.InvoiceStatus("")):                                         {"draft", "issued", "balanced", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir4.LibraryStatus("")):                                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.LinkageItemType("")):                                       {"source", "alternate", "historical"},
+		reflect.TypeOf(fhir4.ListMode("")):                                              {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir4.ListStatus("")):                                            {"current", "retired", "entered-in-error"},
+		reflect.TypeOf(fhir4.LocationMode("")):                                          {"instance", "kind"},
+		reflect.TypeOf(fhir4.LocationStatus("")):                                        {"active", "suspended", "inactive"},
+		reflect.TypeOf(fhir4.MeasureReportStatus("")):                                   {"complete", "pending", "error"},
+		reflect.TypeOf(fhir4.MeasureReportType("")):                                     {"individual", "subject-list", "summary", "data-collection"},
+		reflect.TypeOf(fhir4.MeasureStatus("")):                                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.MediaStatus("")):                                           {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.MedicationAdministrationStatus("")):                        {"in-progress", "not-done", "on-hold", "completed", "entered-in-error", "stopped", "unknown"},
+		reflect.TypeOf(fhir4.MedicationDispenseStatus("")):                              {"preparation", "in-progress", "cancelled", "on-hold", "completed", "entered-in-error", "stopped", "declined", "unknown"},
+		reflect.TypeOf(fhir4.MedicationKnowledgeStatus("")):                             {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4.MedicationRequestIntent("")):                               {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4.MedicationRequestPriority("")):                             {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.MedicationRequestStatus("")):                               {"active", "on-hold", "cancelled", "completed", "entered-in-error", "stopped", "draft", "unknown"},
+		reflect.TypeOf(fhir4.MedicationStatementStatus("")):                             {"active", "completed", "entered-in-error", "intended", "stopped", "on-hold", "unknown", "not-taken"},
+		reflect.TypeOf(fhir4.MedicationStatus("")):                                      {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4.MessageDefinitionCategory("")):                             {"consequence", "currency", "notification"},
+		reflect.TypeOf(fhir4.MessageDefinitionResponseRequired("")):                     {"always", "on-error", "never", "on-success"},
+		reflect.TypeOf(fhir4.MessageDefinitionStatus("")):                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.MessageHeaderResponseCode("")):                             {"ok", "transient-error", "fatal-error"},
+		reflect.TypeOf(fhir4.MolecularSequenceQualityType("")):                          {"indel", "snp", "unknown"},
+		reflect.TypeOf(fhir4.MolecularSequenceReferenceSeqOrientation("")):              {"sense", "antisense"},
+		reflect.TypeOf(fhir4.MolecularSequenceReferenceSeqStrand("")):                   {"watson", "crick"},
+		reflect.TypeOf(fhir4.MolecularSequenceRepositoryType("")):                       {"directlink", "openapi", "login", "oauth", "other"},
+		reflect.TypeOf(fhir4.MolecularSequenceType("")):                                 {"aa", "dna", "rna"},
+		reflect.TypeOf(fhir4.NameUse("")):                                               {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(fhir4.NamingSystemKind("")):                                      {"codesystem", "identifier", "root"},
+		reflect.TypeOf(fhir4.NamingSystemStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.NamingSystemUniqueIdType("")):                              {"oid", "uuid", "uri", "other"},
+		reflect.TypeOf(fhir4.NarrativeStatus("")):                                       {"generated", "extensions", "additional", "empty"},
+		reflect.TypeOf(fhir4.NutritionOrderIntent("")):                                  {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4.NutritionOrderStatus("")):                                  {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.ObservationDefinitionPermittedDataType("")):                {"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Ratio", "SampledData", "time", "dateTime", "Period"},
+		reflect.TypeOf(fhir4.ObservationDefinitionQualifiedIntervalCategory("")):        {"reference", "critical", "absolute"},
+		reflect.TypeOf(fhir4.ObservationDefinitionQualifiedIntervalType("")):            {"value", "ratio"},
+		reflect.TypeOf(fhir4.ObservationStatus("")):                                     {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.OperationDefinitionKind("")):                               {"operation", "query"},
+		reflect.TypeOf(fhir4.OperationDefinitionParameterBindingStrength("")):           {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir4.OperationDefinitionParameterSearchType("")):                {"number", "date", "string", "token", "reference", "composite", "quantity", "uri", "special"},
+		reflect.TypeOf(fhir4.OperationDefinitionParameterUse("")):                       {"in", "out"},
+		reflect.TypeOf(fhir4.OperationDefinitionStatus("")):                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.OperationOutcomeIssueSeverity("")):                         {"fatal", "error", "warning", "information"},
+		reflect.TypeOf(fhir4.PatientLinkType("")):                                       {"replaced-by", "replaces", "refer", "seealso"},
+		reflect.TypeOf(fhir4.PaymentNoticeStatus("")):                                   {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.PaymentReconciliationOutcome("")):                          {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4.PaymentReconciliationProcessNoteType("")):                  {"display", "print", "printoper"},
+		reflect.TypeOf(fhir4.PaymentReconciliationStatus("")):                           {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4.PersonLinkAssurance("")):                                   {"level1", "level2", "level3", "level4"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionCardinalityBehavior("")):               {"single", "multiple"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionConditionKind("")):                     {"applicability", "start", "stop"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionGroupingBehavior("")):                  {"visual-group", "logical-group", "sentence-group"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionParticipantType("")):                   {"patient", "practitioner", "related-person", "device"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionPrecheckBehavior("")):                  {"yes", "no"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionPriority("")):                          {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionRelatedActionRelationship("")):         {"before-start", "before", "before-end", "concurrent-with-start", "concurrent", "concurrent-with-end", "after-start", "after", "after-end"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionRequiredBehavior("")):                  {"must", "could", "must-unless-documented"},
+		reflect.TypeOf(fhir4.PlanDefinitionActionSelectionBehavior("")):                 {"any", "all", "all-or-none", "exactly-one", "at-most-one", "one-or-more"},
+		reflect.TypeOf(fhir4.PlanDefinitionStatus("")):                                  {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.PractitionerRoleAvailableTimeDaysOfWeek("")):               {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir4.ProcedureStatus("")):                                       {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.ProvenanceEntityRole("")):                                  {"derivation", "revision", "quotation", "source", "removal"},
+		reflect.TypeOf(fhir4.QuestionnaireItemEnableBehavior("")):                       {"all", "any"},
+		reflect.TypeOf(fhir4.QuestionnaireItemEnableWhenOperator("")):                   {"exists", "=", "!=", ">", "<", ">=", "<="},
+		reflect.TypeOf(fhir4.QuestionnaireItemType("")):                                 {"group", "display", "question", "boolean", "decimal", "integer", "date", "dateTime", "time", "string", "text", "url", "choice", "open-choice", "attachment", "reference", "quantity"},
+		reflect.TypeOf(fhir4.QuestionnaireResponseStatus("")):                           {"in-progress", "completed", "amended", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir4.QuestionnaireStatus("")):                                   {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.RequestGroupActionCardinalityBehavior("")):                 {"single", "multiple"},
+		reflect.TypeOf(fhir4.RequestGroupActionConditionKind("")):                       {"applicability", "start", "stop"},
+		reflect.TypeOf(fhir4.RequestGroupActionGroupingBehavior("")):                    {"visual-group", "logical-group", "sentence-group"},
+		reflect.TypeOf(fhir4.RequestGroupActionPrecheckBehavior("")):                    {"yes", "no"},
+		reflect.TypeOf(fhir4.RequestGroupActionPriority("")):                            {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.RequestGroupActionRelatedActionRelationship("")):           {"before-start", "before", "before-end", "concurrent-with-start", "concurrent", "concurrent-with-end", "after-start", "after", "after-end"},
+		reflect.TypeOf(fhir4.RequestGroupActionRequiredBehavior("")):                    {"must", "could", "must-unless-documented"},
+		reflect.TypeOf(fhir4.RequestGroupActionSelectionBehavior("")):                   {"any", "all", "all-or-none", "exactly-one", "at-most-one", "one-or-more"},
+		reflect.TypeOf(fhir4.RequestGroupIntent("")):                                    {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4.RequestGroupPriority("")):                                  {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.RequestGroupStatus("")):                                    {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.ResearchDefinitionStatus("")):                              {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.ResearchElementDefinitionCharacteristicGroupMeasure("")):   {"mean", "median", "mean-of-mean", "mean-of-median", "median-of-mean", "median-of-median"},
+		reflect.TypeOf(fhir4.ResearchElementDefinitionStatus("")):                       {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.ResearchElementDefinitionType("")):                         {"population", "exposure", "outcome"},
+		reflect.TypeOf(fhir4.ResearchElementDefinitionVariableType("")):                 {"dichotomous", "continuous", "descriptive"},
+		reflect.TypeOf(fhir4.ResearchStudyStatus("")):                                   {"active", "administratively-completed", "approved", "closed-to-accrual", "closed-to-accrual-and-intervention", "completed", "disapproved", "in-review", "temporarily-closed-to-accrual", "temporarily-closed-to-accrual-and-intervention", "withdrawn"},
+		reflect.TypeOf(fhir4.ResearchSubjectStatus("")):                                 {"candidate", "eligible", "follow-up", "ineligible", "not-registered", "off-study", "on-study", "on-study-intervention", "on-study-observation", "pending-on-study", "potential-candidate", "screening", "withdrawn"},
+		reflect.TypeOf(fhir4.RiskAssessmentStatus("")):                                  {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.RiskEvidenceSynthesisStatus("")):                           {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.SearchParameterComparator("")):                             {"eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap"},
+		reflect.TypeOf(fhir4.SearchParameterModifier("")):                               {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type", "identifier", "ofType"},
+		reflect.TypeOf(fhir4.SearchParameterStatus("")):                                 {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.SearchParameterType("")):                                   {"number", "date", "string", "token", "reference", "composite", "quantity", "uri", "special"},
+		reflect.TypeOf(fhir4.SearchParameterXpathUsage("")):                             {"normal", "phonetic", "nearby", "distance", "other"},
+		reflect.TypeOf(fhir4.SectionMode("")):                                           {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir4.ServiceRequestIntent("")):                                  {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4.ServiceRequestPriority("")):                                {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4.ServiceRequestStatus("")):                                  {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4.SlotStatus("")):                                            {"busy", "free", "busy-unavailable", "busy-tentative", "entered-in-error"},
+		reflect.TypeOf(fhir4.StructureDefinitionContextType("")):                        {"fhirpath", "element", "extension"},
+		reflect.TypeOf(fhir4.StructureDefinitionDerivation("")):                         {"specialization", "constraint"},
+		reflect.TypeOf(fhir4.StructureDefinitionKind("")):                               {"primitive-type", "complex-type", "resource", "logical"},
+		reflect.TypeOf(fhir4.StructureDefinitionStatus("")):                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.StructureMapGroupInputMode("")):                            {"source", "target"},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleSourceListMode("")):                   {"first", "not_first", "last", "not_last", "only_one"},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleTargetContextType("")):                {"variable", "type"},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleTargetListMode("")):                   {"collate", "share"},
+		reflect.TypeOf(fhir4.StructureMapGroupRuleTargetTransform("")):                  {"create", "copy", "truncate", "escape", "cast", "append", "translate", "reference", "dateOp", "uuid", "pointer", "evaluate", "cc", "c", "qty", "id", "cp"},
+		reflect.TypeOf(fhir4.StructureMapGroupTypeMode("")):                             {"none", "types", "first-type"},
+		reflect.TypeOf(fhir4.StructureMapStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4.StructureMapStructureMode("")):                             {"source", "queried", "target", "produced"},
+		reflect.TypeOf(fhir4.SubscriptionChannelType("")):                               {"rest-hook", "websocket", "email", "sms", "message"},
+		reflect.TypeOf(fhir4.SubscriptionStatus("")):                                    {"requested", "active", "error", "off"},
+		reflect.TypeOf(fhir4.SubstanceStatus("")):                                       {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4.UnitsOfTime("")):                                           {"s", "min", "h", "d", "wk", "mo", "a"},
+	},
+}
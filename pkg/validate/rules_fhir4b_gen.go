@@ -0,0 +1,802 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+)
+
+// fhir4bRules are the validation rules of the fhir4b package
+var fhir4bRules = ruleSet{
+	// cardinalities lists the required and limited elements of each struct, a max of -1 is unbounded
+	cardinalities: map[reflect.Type][]cardinality{
+		reflect.TypeOf(common.Annotation{}):                                                                       {{"text", 1, 1}},
+		reflect.TypeOf(common.Contributor{}):                                                                      {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(common.DataRequirement{}):                                                                  {{"type", 1, 1}},
+		reflect.TypeOf(common.DataRequirementSort{}):                                                              {{"path", 1, 1}, {"direction", 1, 1}},
+		reflect.TypeOf(common.Expression{}):                                                                       {{"language", 1, 1}},
+		reflect.TypeOf(common.Extension{}):                                                                        {{"url", 1, 1}},
+		reflect.TypeOf(common.ParameterDefinition{}):                                                              {{"use", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(common.RelatedArtifact{}):                                                                  {{"type", 1, 1}},
+		reflect.TypeOf(common.SampledData{}):                                                                      {{"origin", 1, 1}, {"dimensions", 1, 1}},
+		reflect.TypeOf(common.Signature{}):                                                                        {{"type", 1, -1}, {"when", 1, 1}, {"who", 1, 1}},
+		reflect.TypeOf(common.TriggerDefinition{}):                                                                {{"type", 1, 1}},
+		reflect.TypeOf(common.UsageContext{}):                                                                     {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.Account{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.AccountCoverage{}):                                                                  {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir4b.AccountGuarantor{}):                                                                 {{"party", 1, 1}},
+		reflect.TypeOf(fhir4b.ActivityDefinition{}):                                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ActivityDefinitionDynamicValue{}):                                                   {{"path", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir4b.ActivityDefinitionParticipant{}):                                                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.AdministrableProductDefinition{}):                                                   {{"status", 1, 1}, {"routeOfAdministration", 1, -1}},
+		reflect.TypeOf(fhir4b.AdministrableProductDefinitionProperty{}):                                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.AdministrableProductDefinitionRouteOfAdministration{}):                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.AdministrableProductDefinitionRouteOfAdministrationTargetSpecies{}):                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}): {{"tissue", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.AdverseEvent{}):                                                                     {{"actuality", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.AdverseEventSuspectEntity{}):                                                        {{"instance", 1, 1}},
+		reflect.TypeOf(fhir4b.AllergyIntolerance{}):                                                               {{"patient", 1, 1}},
+		reflect.TypeOf(fhir4b.AllergyIntoleranceReaction{}):                                                       {{"manifestation", 1, -1}},
+		reflect.TypeOf(fhir4b.Annotation{}):                                                                       {{"text", 1, 1}},
+		reflect.TypeOf(fhir4b.Appointment{}):                                                                      {{"status", 1, 1}, {"participant", 1, -1}},
+		reflect.TypeOf(fhir4b.AppointmentParticipant{}):                                                           {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.AppointmentResponse{}):                                                              {{"appointment", 1, 1}, {"participantStatus", 1, 1}},
+		reflect.TypeOf(fhir4b.AuditEvent{}):                                                                       {{"type", 1, 1}, {"recorded", 1, 1}, {"agent", 1, -1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir4b.AuditEventAgent{}):                                                                  {{"requestor", 1, 1}},
+		reflect.TypeOf(fhir4b.AuditEventEntityDetail{}):                                                           {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.AuditEventSource{}):                                                                 {{"observer", 1, 1}},
+		reflect.TypeOf(fhir4b.Basic{}):                                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.Binary{}):                                                                           {{"contentType", 1, 1}},
+		reflect.TypeOf(fhir4b.BodyStructure{}):                                                                    {{"patient", 1, 1}},
+		reflect.TypeOf(fhir4b.Bundle{}):                                                                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.BundleEntryRequest{}):                                                               {{"method", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir4b.BundleEntryResponse{}):                                                              {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.BundleLink{}):                                                                       {{"relation", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatement{}):                                                              {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}, {"fhirVersion", 1, 1}, {"format", 1, -1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementDocument{}):                                                      {{"mode", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementImplementation{}):                                                {{"description", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementMessagingEndpoint{}):                                             {{"protocol", 1, 1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementMessagingSupportedMessage{}):                                     {{"mode", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementRest{}):                                                          {{"mode", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestInteraction{}):                                               {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResource{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceInteraction{}):                                       {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceOperation{}):                                         {{"name", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceSearchParam{}):                                       {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.CapabilityStatementSoftware{}):                                                      {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.CarePlan{}):                                                                         {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.CarePlanActivityDetail{}):                                                           {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.CatalogEntry{}):                                                                     {{"orderable", 1, 1}, {"referencedItem", 1, 1}},
+		reflect.TypeOf(fhir4b.CatalogEntryRelatedEntry{}):                                                         {{"relationtype", 1, 1}, {"item", 1, 1}},
+		reflect.TypeOf(fhir4b.ChargeItem{}):                                                                       {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.ChargeItemDefinition{}):                                                             {{"url", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ChargeItemDefinitionPropertyGroupPriceComponent{}):                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.ChargeItemPerformer{}):                                                              {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.Citation{}):                                                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactAbstract{}):                                                    {{"text", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactContributorshipEntryContributionInstance{}):                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactContributorshipSummary{}):                                      {{"value", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactRelatesTo{}):                                                   {{"relationshipType", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactStatusDate{}):                                                  {{"activity", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactTitle{}):                                                       {{"text", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationCitedArtifactVersion{}):                                                     {{"value", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationRelatesTo{}):                                                                {{"relationshipType", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationStatusDate{}):                                                               {{"activity", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.CitationSummary{}):                                                                  {{"text", 1, 1}},
+		reflect.TypeOf(fhir4b.Claim{}):                                                                            {{"status", 1, 1}, {"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"provider", 1, 1}, {"priority", 1, 1}, {"insurance", 1, -1}},
+		reflect.TypeOf(fhir4b.ClaimAccident{}):                                                                    {{"date", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimCareTeam{}):                                                                    {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimDiagnosis{}):                                                                   {{"sequence", 1, 1}, {"diagnosis[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimInsurance{}):                                                                   {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimItem{}):                                                                        {{"sequence", 1, 1}, {"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimItemDetail{}):                                                                  {{"sequence", 1, 1}, {"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimItemDetailSubDetail{}):                                                         {{"sequence", 1, 1}, {"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimPayee{}):                                                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimProcedure{}):                                                                   {{"sequence", 1, 1}, {"procedure[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponse{}):                                                                    {{"status", 1, 1}, {"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}, {"outcome", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponseAddItem{}):                                                             {{"productOrService", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4b.ClaimResponseAddItemDetail{}):                                                       {{"productOrService", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4b.ClaimResponseAddItemDetailSubDetail{}):                                              {{"productOrService", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4b.ClaimResponseError{}):                                                               {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponseInsurance{}):                                                           {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponseItem{}):                                                                {{"itemSequence", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4b.ClaimResponseItemAdjudication{}):                                                    {{"category", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponseItemDetail{}):                                                          {{"detailSequence", 1, 1}, {"adjudication", 1, -1}},
+		reflect.TypeOf(fhir4b.ClaimResponseItemDetailSubDetail{}):                                                 {{"subDetailSequence", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponsePayment{}):                                                             {{"type", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponseProcessNote{}):                                                         {{"text", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimResponseTotal{}):                                                               {{"category", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4b.ClaimSupportingInfo{}):                                                              {{"sequence", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir4b.ClinicalImpression{}):                                                               {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.ClinicalImpressionInvestigation{}):                                                  {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.ClinicalUseDefinition{}):                                                            {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.ClinicalUseDefinitionContraindicationOtherTherapy{}):                                {{"relationshipType", 1, 1}, {"therapy", 1, 1}},
+		reflect.TypeOf(fhir4b.ClinicalUseDefinitionInteractionInteractant{}):                                      {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.CodeSystem{}):                                                                       {{"status", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir4b.CodeSystemConcept{}):                                                                {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.CodeSystemConceptDesignation{}):                                                     {{"value", 1, 1}},
+		reflect.TypeOf(fhir4b.CodeSystemConceptProperty{}):                                                        {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.CodeSystemFilter{}):                                                                 {{"code", 1, 1}, {"operator", 1, -1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.CodeSystemProperty{}):                                                               {{"code", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.Communication{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.CommunicationPayload{}):                                                             {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.CommunicationRequest{}):                                                             {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.CommunicationRequestPayload{}):                                                      {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.CompartmentDefinition{}):                                                            {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"code", 1, 1}, {"search", 1, 1}},
+		reflect.TypeOf(fhir4b.CompartmentDefinitionResource{}):                                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.Composition{}):                                                                      {{"status", 1, 1}, {"type", 1, 1}, {"date", 1, 1}, {"author", 1, -1}, {"title", 1, 1}},
+		reflect.TypeOf(fhir4b.CompositionAttester{}):                                                              {{"mode", 1, 1}},
+		reflect.TypeOf(fhir4b.CompositionRelatesTo{}):                                                             {{"code", 1, 1}, {"target[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ConceptMap{}):                                                                       {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ConceptMapGroup{}):                                                                  {{"element", 1, -1}},
+		reflect.TypeOf(fhir4b.ConceptMapGroupElementTarget{}):                                                     {{"equivalence", 1, 1}},
+		reflect.TypeOf(fhir4b.ConceptMapGroupElementTargetDependsOn{}):                                            {{"property", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.ConceptMapGroupUnmapped{}):                                                          {{"mode", 1, 1}},
+		reflect.TypeOf(fhir4b.Condition{}):                                                                        {{"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.Consent{}):                                                                          {{"status", 1, 1}, {"scope", 1, 1}, {"category", 1, -1}},
+		reflect.TypeOf(fhir4b.ConsentProvisionActor{}):                                                            {{"role", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir4b.ConsentProvisionData{}):                                                             {{"meaning", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir4b.ConsentVerification{}):                                                              {{"verified", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractContentDefinition{}):                                                        {{"type", 1, 1}, {"publicationStatus", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractFriendly{}):                                                                 {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractLegal{}):                                                                    {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractRule{}):                                                                     {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractSigner{}):                                                                   {{"type", 1, 1}, {"party", 1, 1}, {"signature", 1, -1}},
+		reflect.TypeOf(fhir4b.ContractTerm{}):                                                                     {{"offer", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractTermAction{}):                                                               {{"type", 1, 1}, {"intent", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractTermActionSubject{}):                                                        {{"reference", 1, -1}},
+		reflect.TypeOf(fhir4b.ContractTermOfferAnswer{}):                                                          {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractTermOfferParty{}):                                                           {{"reference", 1, -1}, {"role", 1, 1}},
+		reflect.TypeOf(fhir4b.ContractTermSecurityLabel{}):                                                        {{"classification", 1, 1}},
+		reflect.TypeOf(fhir4b.Contributor{}):                                                                      {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(fhir4b.Coverage{}):                                                                         {{"status", 1, 1}, {"beneficiary", 1, 1}, {"payor", 1, -1}},
+		reflect.TypeOf(fhir4b.CoverageClass{}):                                                                    {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageCostToBeneficiary{}):                                                        {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageCostToBeneficiaryException{}):                                               {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityRequest{}):                                                       {{"status", 1, 1}, {"purpose", 1, -1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityRequestInsurance{}):                                              {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityRequestSupportingInfo{}):                                         {{"sequence", 1, 1}, {"information", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponse{}):                                                      {{"status", 1, 1}, {"purpose", 1, -1}, {"patient", 1, 1}, {"created", 1, 1}, {"request", 1, 1}, {"outcome", 1, 1}, {"insurer", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponseError{}):                                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponseInsurance{}):                                             {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponseInsuranceItemBenefit{}):                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.DetectedIssue{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.DetectedIssueMitigation{}):                                                          {{"action", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDefinitionCapability{}):                                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDefinitionDeviceName{}):                                                       {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDefinitionMaterial{}):                                                         {{"substance", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDefinitionProperty{}):                                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDefinitionSpecialization{}):                                                   {{"systemType", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDefinitionUdiDeviceIdentifier{}):                                              {{"deviceIdentifier", 1, 1}, {"issuer", 1, 1}, {"jurisdiction", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceDeviceName{}):                                                                 {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceMetric{}):                                                                     {{"type", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceProperty{}):                                                                   {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceRequest{}):                                                                    {{"intent", 1, 1}, {"code[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceSpecialization{}):                                                             {{"systemType", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceUseStatement{}):                                                               {{"status", 1, 1}, {"subject", 1, 1}, {"device", 1, 1}},
+		reflect.TypeOf(fhir4b.DeviceVersion{}):                                                                    {{"value", 1, 1}},
+		reflect.TypeOf(fhir4b.DiagnosticReport{}):                                                                 {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4b.DiagnosticReportMedia{}):                                                            {{"link", 1, 1}},
+		reflect.TypeOf(fhir4b.DocumentManifest{}):                                                                 {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir4b.DocumentReference{}):                                                                {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir4b.DocumentReferenceContent{}):                                                         {{"attachment", 1, 1}},
+		reflect.TypeOf(fhir4b.DocumentReferenceRelatesTo{}):                                                       {{"code", 1, 1}, {"target", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinition{}):                                                                {{"path", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionBase{}):                                                            {{"path", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionBinding{}):                                                         {{"strength", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionConstraint{}):                                                      {{"key", 1, 1}, {"severity", 1, 1}, {"human", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionExample{}):                                                         {{"label", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionMapping{}):                                                         {{"identity", 1, 1}, {"map", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionSlicing{}):                                                         {{"rules", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionSlicingDiscriminator{}):                                            {{"type", 1, 1}, {"path", 1, 1}},
+		reflect.TypeOf(fhir4b.ElementDefinitionType{}):                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.Encounter{}):                                                                        {{"status", 1, 1}, {"class", 1, 1}},
+		reflect.TypeOf(fhir4b.EncounterClassHistory{}):                                                            {{"class", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.EncounterDiagnosis{}):                                                               {{"condition", 1, 1}},
+		reflect.TypeOf(fhir4b.EncounterLocation{}):                                                                {{"location", 1, 1}},
+		reflect.TypeOf(fhir4b.EncounterStatusHistory{}):                                                           {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.Endpoint{}):                                                                         {{"status", 1, 1}, {"connectionType", 1, 1}, {"payloadType", 1, -1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir4b.EpisodeOfCare{}):                                                                    {{"status", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir4b.EpisodeOfCareDiagnosis{}):                                                           {{"condition", 1, 1}},
+		reflect.TypeOf(fhir4b.EpisodeOfCareStatusHistory{}):                                                       {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.EventDefinition{}):                                                                  {{"status", 1, 1}, {"trigger", 1, -1}},
+		reflect.TypeOf(fhir4b.Evidence{}):                                                                         {{"status", 1, 1}, {"variableDefinition", 1, -1}},
+		reflect.TypeOf(fhir4b.EvidenceReport{}):                                                                   {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceReportRelatesTo{}):                                                          {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceReportSubjectCharacteristic{}):                                              {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceStatisticModelCharacteristic{}):                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceStatisticModelCharacteristicVariable{}):                                     {{"variableDefinition", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceVariable{}):                                                                 {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceVariableCharacteristic{}):                                                   {{"definition[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.EvidenceVariableDefinition{}):                                                       {{"variableRole", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenario{}):                                                                  {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioActor{}):                                                             {{"actorId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioInstance{}):                                                          {{"resourceId", 1, 1}, {"resourceType", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioInstanceContainedInstance{}):                                         {{"resourceId", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioInstanceVersion{}):                                                   {{"versionId", 1, 1}, {"description", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioProcess{}):                                                           {{"title", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioProcessStepAlternative{}):                                            {{"title", 1, 1}},
+		reflect.TypeOf(fhir4b.ExampleScenarioProcessStepOperation{}):                                              {{"number", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefit{}):                                                             {{"status", 1, 1}, {"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}, {"provider", 1, 1}, {"outcome", 1, 1}, {"insurance", 1, -1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitAddItem{}):                                                      {{"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitAddItemDetail{}):                                                {{"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitAddItemDetailSubDetail{}):                                       {{"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitBenefitBalance{}):                                               {{"category", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitBenefitBalanceFinancial{}):                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitCareTeam{}):                                                     {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitDiagnosis{}):                                                    {{"sequence", 1, 1}, {"diagnosis[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitInsurance{}):                                                    {{"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitItem{}):                                                         {{"sequence", 1, 1}, {"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitItemAdjudication{}):                                             {{"category", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitItemDetail{}):                                                   {{"sequence", 1, 1}, {"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitItemDetailSubDetail{}):                                          {{"sequence", 1, 1}, {"productOrService", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitProcedure{}):                                                    {{"sequence", 1, 1}, {"procedure[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitSupportingInfo{}):                                               {{"sequence", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitTotal{}):                                                        {{"category", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4b.FamilyMemberHistory{}):                                                              {{"status", 1, 1}, {"patient", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir4b.FamilyMemberHistoryCondition{}):                                                     {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.Flag{}):                                                                             {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.Goal{}):                                                                             {{"lifecycleStatus", 1, 1}, {"description", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.GraphDefinition{}):                                                                  {{"name", 1, 1}, {"status", 1, 1}, {"start", 1, 1}},
+		reflect.TypeOf(fhir4b.GraphDefinitionLinkTarget{}):                                                        {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.GraphDefinitionLinkTargetCompartment{}):                                             {{"use", 1, 1}, {"code", 1, 1}, {"rule", 1, 1}},
+		reflect.TypeOf(fhir4b.Group{}):                                                                            {{"type", 1, 1}, {"actual", 1, 1}},
+		reflect.TypeOf(fhir4b.GroupCharacteristic{}):                                                              {{"code", 1, 1}, {"value[x]", 1, 1}, {"exclude", 1, 1}},
+		reflect.TypeOf(fhir4b.GroupMember{}):                                                                      {{"entity", 1, 1}},
+		reflect.TypeOf(fhir4b.GuidanceResponse{}):                                                                 {{"module[x]", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4b.HealthcareServiceNotAvailable{}):                                                    {{"description", 1, 1}},
+		reflect.TypeOf(fhir4b.ImagingStudy{}):                                                                     {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.ImagingStudySeries{}):                                                               {{"uid", 1, 1}, {"modality", 1, 1}},
+		reflect.TypeOf(fhir4b.ImagingStudySeriesInstance{}):                                                       {{"uid", 1, 1}, {"sopClass", 1, 1}},
+		reflect.TypeOf(fhir4b.ImagingStudySeriesPerformer{}):                                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.Immunization{}):                                                                     {{"status", 1, 1}, {"vaccineCode", 1, 1}, {"patient", 1, 1}, {"occurrence[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ImmunizationEvaluation{}):                                                           {{"status", 1, 1}, {"patient", 1, 1}, {"targetDisease", 1, 1}, {"immunizationEvent", 1, 1}, {"doseStatus", 1, 1}},
+		reflect.TypeOf(fhir4b.ImmunizationPerformer{}):                                                            {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.ImmunizationProtocolApplied{}):                                                      {{"doseNumber[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ImmunizationRecommendation{}):                                                       {{"patient", 1, 1}, {"date", 1, 1}, {"recommendation", 1, -1}},
+		reflect.TypeOf(fhir4b.ImmunizationRecommendationRecommendation{}):                                         {{"forecastStatus", 1, 1}},
+		reflect.TypeOf(fhir4b.ImmunizationRecommendationRecommendationDateCriterion{}):                            {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuide{}):                                                              {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"packageId", 1, 1}, {"fhirVersion", 1, -1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinition{}):                                                    {{"resource", 1, -1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionGrouping{}):                                            {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionPage{}):                                                {{"name[x]", 1, 1}, {"title", 1, 1}, {"generation", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionParameter{}):                                           {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionResource{}):                                            {{"reference", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionTemplate{}):                                            {{"code", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideDependsOn{}):                                                     {{"uri", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideGlobal{}):                                                        {{"type", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideManifest{}):                                                      {{"resource", 1, -1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideManifestPage{}):                                                  {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.ImplementationGuideManifestResource{}):                                              {{"reference", 1, 1}},
+		reflect.TypeOf(fhir4b.Ingredient{}):                                                                       {{"status", 1, 1}, {"role", 1, 1}, {"substance", 1, 1}},
+		reflect.TypeOf(fhir4b.IngredientManufacturer{}):                                                           {{"manufacturer", 1, 1}},
+		reflect.TypeOf(fhir4b.IngredientSubstance{}):                                                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.IngredientSubstanceStrengthReferenceStrength{}):                                     {{"strength[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.InsurancePlanCoverage{}):                                                            {{"type", 1, 1}, {"benefit", 1, -1}},
+		reflect.TypeOf(fhir4b.InsurancePlanCoverageBenefit{}):                                                     {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.InsurancePlanPlanSpecificCost{}):                                                    {{"category", 1, 1}},
+		reflect.TypeOf(fhir4b.InsurancePlanPlanSpecificCostBenefit{}):                                             {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.InsurancePlanPlanSpecificCostBenefitCost{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.Invoice{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.InvoiceLineItem{}):                                                                  {{"chargeItem[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.InvoiceLineItemPriceComponent{}):                                                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.InvoiceParticipant{}):                                                               {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.Library{}):                                                                          {{"status", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.Linkage{}):                                                                          {{"item", 1, -1}},
+		reflect.TypeOf(fhir4b.LinkageItem{}):                                                                      {{"type", 1, 1}, {"resource", 1, 1}},
+		reflect.TypeOf(fhir4b.List{}):                                                                             {{"status", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir4b.ListEntry{}):                                                                        {{"item", 1, 1}},
+		reflect.TypeOf(fhir4b.LocationPosition{}):                                                                 {{"longitude", 1, 1}, {"latitude", 1, 1}},
+		reflect.TypeOf(fhir4b.ManufacturedItemDefinition{}):                                                       {{"status", 1, 1}, {"manufacturedDoseForm", 1, 1}},
+		reflect.TypeOf(fhir4b.ManufacturedItemDefinitionProperty{}):                                               {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.MarketingStatus{}):                                                                  {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.Measure{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.MeasureGroupPopulation{}):                                                           {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir4b.MeasureGroupStratifierComponent{}):                                                  {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir4b.MeasureReport{}):                                                                    {{"status", 1, 1}, {"type", 1, 1}, {"measure", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.MeasureReportGroupStratifierStratumComponent{}):                                     {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.MeasureSupplementalData{}):                                                          {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir4b.Media{}):                                                                            {{"status", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationAdministration{}):                                                         {{"status", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}, {"effective[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationAdministrationPerformer{}):                                                {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationDispense{}):                                                               {{"status", 1, 1}, {"medication[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationDispensePerformer{}):                                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationDispenseSubstitution{}):                                                   {{"wasSubstituted", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationIngredient{}):                                                             {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeAdministrationGuidelinesDosage{}):                                {{"type", 1, 1}, {"dosage", 1, -1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeAdministrationGuidelinesPatientCharacteristics{}):                {{"characteristic[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeCost{}):                                                          {{"type", 1, 1}, {"cost", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeIngredient{}):                                                    {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeMedicineClassification{}):                                        {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeRegulatory{}):                                                    {{"regulatoryAuthority", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeRegulatoryMaxDispense{}):                                         {{"quantity", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeRegulatorySchedule{}):                                            {{"schedule", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeRegulatorySubstitution{}):                                        {{"type", 1, 1}, {"allowed", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeRelatedMedicationKnowledge{}):                                    {{"type", 1, 1}, {"reference", 1, -1}},
+		reflect.TypeOf(fhir4b.MedicationRequest{}):                                                                {{"status", 1, 1}, {"intent", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationRequestSubstitution{}):                                                    {{"allowed[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicationStatement{}):                                                              {{"status", 1, 1}, {"medication[x]", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinition{}):                                                       {{"name", 1, -1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinitionCharacteristic{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinitionContact{}):                                                {{"contact", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinitionCrossReference{}):                                         {{"product", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinitionName{}):                                                   {{"productName", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinitionNameCountryLanguage{}):                                    {{"country", 1, 1}, {"language", 1, 1}},
+		reflect.TypeOf(fhir4b.MedicinalProductDefinitionNameNamePart{}):                                           {{"part", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageDefinition{}):                                                                {{"status", 1, 1}, {"date", 1, 1}, {"event[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageDefinitionAllowedResponse{}):                                                 {{"message", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageDefinitionFocus{}):                                                           {{"code", 1, 1}, {"min", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageHeader{}):                                                                    {{"event[x]", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageHeaderDestination{}):                                                         {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageHeaderResponse{}):                                                            {{"identifier", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4b.MessageHeaderSource{}):                                                              {{"endpoint", 1, 1}},
+		reflect.TypeOf(fhir4b.MolecularSequence{}):                                                                {{"coordinateSystem", 1, 1}},
+		reflect.TypeOf(fhir4b.MolecularSequenceQuality{}):                                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.MolecularSequenceRepository{}):                                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.NamingSystem{}):                                                                     {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"date", 1, 1}, {"uniqueId", 1, -1}},
+		reflect.TypeOf(fhir4b.NamingSystemUniqueId{}):                                                             {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.Narrative{}):                                                                        {{"status", 1, 1}, {"div", 1, 1}},
+		reflect.TypeOf(fhir4b.NutritionOrder{}):                                                                   {{"status", 1, 1}, {"intent", 1, 1}, {"patient", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir4b.NutritionProduct{}):                                                                 {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.NutritionProductIngredient{}):                                                       {{"item", 1, 1}},
+		reflect.TypeOf(fhir4b.NutritionProductProductCharacteristic{}):                                            {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.Observation{}):                                                                      {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4b.ObservationComponent{}):                                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.ObservationDefinition{}):                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.OperationDefinition{}):                                                              {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"code", 1, 1}, {"system", 1, 1}, {"type", 1, 1}, {"instance", 1, 1}},
+		reflect.TypeOf(fhir4b.OperationDefinitionParameter{}):                                                     {{"name", 1, 1}, {"use", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir4b.OperationDefinitionParameterBinding{}):                                              {{"strength", 1, 1}, {"valueSet", 1, 1}},
+		reflect.TypeOf(fhir4b.OperationDefinitionParameterReferencedFrom{}):                                       {{"source", 1, 1}},
+		reflect.TypeOf(fhir4b.OperationOutcome{}):                                                                 {{"issue", 1, -1}},
+		reflect.TypeOf(fhir4b.OperationOutcomeIssue{}):                                                            {{"severity", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir4b.PackagedProductDefinitionPackageContainedItem{}):                                    {{"item", 1, 1}},
+		reflect.TypeOf(fhir4b.PackagedProductDefinitionPackageProperty{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.ParametersParameter{}):                                                              {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.PatientCommunication{}):                                                             {{"language", 1, 1}},
+		reflect.TypeOf(fhir4b.PatientLink{}):                                                                      {{"other", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.PaymentNotice{}):                                                                    {{"status", 1, 1}, {"created", 1, 1}, {"payment", 1, 1}, {"recipient", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir4b.PaymentReconciliation{}):                                                            {{"status", 1, 1}, {"created", 1, 1}, {"paymentDate", 1, 1}, {"paymentAmount", 1, 1}},
+		reflect.TypeOf(fhir4b.PaymentReconciliationDetail{}):                                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.PersonLink{}):                                                                       {{"target", 1, 1}},
+		reflect.TypeOf(fhir4b.PlanDefinition{}):                                                                   {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionCondition{}):                                                    {{"kind", 1, 1}},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionParticipant{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionRelatedAction{}):                                                {{"actionId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir4b.PlanDefinitionGoal{}):                                                               {{"description", 1, 1}},
+		reflect.TypeOf(fhir4b.PractitionerQualification{}):                                                        {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.PractitionerRoleNotAvailable{}):                                                     {{"description", 1, 1}},
+		reflect.TypeOf(fhir4b.Procedure{}):                                                                        {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.ProcedureFocalDevice{}):                                                             {{"manipulated", 1, 1}},
+		reflect.TypeOf(fhir4b.ProcedurePerformer{}):                                                               {{"actor", 1, 1}},
+		reflect.TypeOf(fhir4b.ProductShelfLife{}):                                                                 {{"type", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir4b.Provenance{}):                                                                       {{"target", 1, -1}, {"recorded", 1, 1}, {"agent", 1, -1}},
+		reflect.TypeOf(fhir4b.ProvenanceAgent{}):                                                                  {{"who", 1, 1}},
+		reflect.TypeOf(fhir4b.ProvenanceEntity{}):                                                                 {{"role", 1, 1}, {"what", 1, 1}},
+		reflect.TypeOf(fhir4b.Questionnaire{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.QuestionnaireItem{}):                                                                {{"linkId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.QuestionnaireItemAnswerOption{}):                                                    {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.QuestionnaireItemEnableWhen{}):                                                      {{"question", 1, 1}, {"operator", 1, 1}, {"answer[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.QuestionnaireItemInitial{}):                                                         {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.QuestionnaireResponse{}):                                                            {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.QuestionnaireResponseItem{}):                                                        {{"linkId", 1, 1}},
+		reflect.TypeOf(fhir4b.RelatedPerson{}):                                                                    {{"patient", 1, 1}},
+		reflect.TypeOf(fhir4b.RelatedPersonCommunication{}):                                                       {{"language", 1, 1}},
+		reflect.TypeOf(fhir4b.RequestGroup{}):                                                                     {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir4b.RequestGroupActionCondition{}):                                                      {{"kind", 1, 1}},
+		reflect.TypeOf(fhir4b.RequestGroupActionRelatedAction{}):                                                  {{"actionId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir4b.ResearchDefinition{}):                                                               {{"status", 1, 1}, {"population", 1, 1}},
+		reflect.TypeOf(fhir4b.ResearchElementDefinition{}):                                                        {{"status", 1, 1}, {"type", 1, 1}, {"characteristic", 1, -1}},
+		reflect.TypeOf(fhir4b.ResearchElementDefinitionCharacteristic{}):                                          {{"definition[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.ResearchStudy{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ResearchStudyArm{}):                                                                 {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.ResearchSubject{}):                                                                  {{"status", 1, 1}, {"study", 1, 1}, {"individual", 1, 1}},
+		reflect.TypeOf(fhir4b.RiskAssessment{}):                                                                   {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.Schedule{}):                                                                         {{"actor", 1, -1}},
+		reflect.TypeOf(fhir4b.SearchParameter{}):                                                                  {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"description", 1, 1}, {"code", 1, 1}, {"base", 1, -1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.SearchParameterComponent{}):                                                         {{"definition", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir4b.ServiceRequest{}):                                                                   {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir4b.Slot{}):                                                                             {{"schedule", 1, 1}, {"status", 1, 1}, {"start", 1, 1}, {"end", 1, 1}},
+		reflect.TypeOf(fhir4b.SpecimenDefinitionTypeTested{}):                                                     {{"preference", 1, 1}},
+		reflect.TypeOf(fhir4b.SpecimenDefinitionTypeTestedContainerAdditive{}):                                    {{"additive[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureDefinition{}):                                                              {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"abstract", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureDefinitionContext{}):                                                       {{"type", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureDefinitionDifferential{}):                                                  {{"element", 1, -1}},
+		reflect.TypeOf(fhir4b.StructureDefinitionMapping{}):                                                       {{"identity", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureDefinitionSnapshot{}):                                                      {{"element", 1, -1}},
+		reflect.TypeOf(fhir4b.StructureMap{}):                                                                     {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"group", 1, -1}},
+		reflect.TypeOf(fhir4b.StructureMapGroup{}):                                                                {{"name", 1, 1}, {"typeMode", 1, 1}, {"input", 1, -1}, {"rule", 1, -1}},
+		reflect.TypeOf(fhir4b.StructureMapGroupInput{}):                                                           {{"name", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureMapGroupRule{}):                                                            {{"name", 1, 1}, {"source", 1, -1}},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleDependent{}):                                                   {{"name", 1, 1}, {"variable", 1, -1}},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleSource{}):                                                      {{"context", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleTargetParameter{}):                                             {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.StructureMapStructure{}):                                                            {{"url", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir4b.Subscription{}):                                                                     {{"status", 1, 1}, {"reason", 1, 1}, {"criteria", 1, 1}, {"channel", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionChannel{}):                                                              {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionStatus{}):                                                               {{"type", 1, 1}, {"subscription", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionStatusNotificationEvent{}):                                              {{"eventNumber", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionTopic{}):                                                                {{"url", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionTopicCanFilterBy{}):                                                     {{"filterParameter", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionTopicEventTrigger{}):                                                    {{"event", 1, 1}, {"resource", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionTopicNotificationShape{}):                                               {{"resource", 1, 1}},
+		reflect.TypeOf(fhir4b.SubscriptionTopicResourceTrigger{}):                                                 {{"resource", 1, 1}},
+		reflect.TypeOf(fhir4b.Substance{}):                                                                        {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.SubstanceDefinitionMolecularWeight{}):                                               {{"amount", 1, 1}},
+		reflect.TypeOf(fhir4b.SubstanceDefinitionName{}):                                                          {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.SubstanceDefinitionProperty{}):                                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.SubstanceDefinitionRelationship{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir4b.SubstanceIngredient{}):                                                              {{"substance[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.SupplyRequest{}):                                                                    {{"item[x]", 1, 1}, {"quantity", 1, 1}},
+		reflect.TypeOf(fhir4b.Task{}):                                                                             {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir4b.TaskInput{}):                                                                        {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.TaskOutput{}):                                                                       {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilities{}):                                                          {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesCodeSystemVersionFilter{}):                                   {{"code", 1, 1}, {"op", 1, -1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesExpansionParameter{}):                                        {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesImplementation{}):                                            {{"description", 1, 1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesSoftware{}):                                                  {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesTranslation{}):                                               {{"needsMap", 1, 1}},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesValidateCode{}):                                              {{"translations", 1, 1}},
+		reflect.TypeOf(fhir4b.TestReport{}):                                                                       {{"status", 1, 1}, {"testScript", 1, 1}, {"result", 1, 1}},
+		reflect.TypeOf(fhir4b.TestReportParticipant{}):                                                            {{"type", 1, 1}, {"uri", 1, 1}},
+		reflect.TypeOf(fhir4b.TestReportSetup{}):                                                                  {{"action", 1, -1}},
+		reflect.TypeOf(fhir4b.TestReportSetupActionAssert{}):                                                      {{"result", 1, 1}},
+		reflect.TypeOf(fhir4b.TestReportSetupActionOperation{}):                                                   {{"result", 1, 1}},
+		reflect.TypeOf(fhir4b.TestReportTeardown{}):                                                               {{"action", 1, -1}},
+		reflect.TypeOf(fhir4b.TestReportTeardownAction{}):                                                         {{"operation", 1, 1}},
+		reflect.TypeOf(fhir4b.TestReportTest{}):                                                                   {{"action", 1, -1}},
+		reflect.TypeOf(fhir4b.TestScript{}):                                                                       {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptDestination{}):                                                            {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptFixture{}):                                                                {{"autocreate", 1, 1}, {"autodelete", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptMetadata{}):                                                               {{"capability", 1, -1}},
+		reflect.TypeOf(fhir4b.TestScriptMetadataCapability{}):                                                     {{"required", 1, 1}, {"validated", 1, 1}, {"capabilities", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptMetadataLink{}):                                                           {{"url", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptOrigin{}):                                                                 {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptSetup{}):                                                                  {{"action", 1, -1}},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionAssert{}):                                                      {{"warningOnly", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionOperation{}):                                                   {{"encodeRequestUrl", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionOperationRequestHeader{}):                                      {{"field", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptTeardown{}):                                                               {{"action", 1, -1}},
+		reflect.TypeOf(fhir4b.TestScriptTeardownAction{}):                                                         {{"operation", 1, 1}},
+		reflect.TypeOf(fhir4b.TestScriptTest{}):                                                                   {{"action", 1, -1}},
+		reflect.TypeOf(fhir4b.TestScriptVariable{}):                                                               {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.ValueSet{}):                                                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.ValueSetCompose{}):                                                                  {{"include", 1, -1}},
+		reflect.TypeOf(fhir4b.ValueSetComposeIncludeConcept{}):                                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir4b.ValueSetComposeIncludeConceptDesignation{}):                                         {{"value", 1, 1}},
+		reflect.TypeOf(fhir4b.ValueSetComposeIncludeFilter{}):                                                     {{"property", 1, 1}, {"op", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir4b.ValueSetExpansion{}):                                                                {{"timestamp", 1, 1}},
+		reflect.TypeOf(fhir4b.ValueSetExpansionParameter{}):                                                       {{"name", 1, 1}},
+		reflect.TypeOf(fhir4b.VerificationResult{}):                                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir4b.VerificationResultValidator{}):                                                      {{"organization", 1, 1}},
+		reflect.TypeOf(fhir4b.VisionPrescription{}):                                                               {{"status", 1, 1}, {"created", 1, 1}, {"patient", 1, 1}, {"dateWritten", 1, 1}, {"prescriber", 1, 1}, {"lensSpecification", 1, -1}},
+		reflect.TypeOf(fhir4b.VisionPrescriptionLensSpecification{}):                                              {{"product", 1, 1}, {"eye", 1, 1}},
+		reflect.TypeOf(fhir4b.VisionPrescriptionLensSpecificationPrism{}):                                         {{"amount", 1, 1}, {"base", 1, 1}},
+	},
+
+	// enumValues lists the allowed values of the code enum types
+	enumValues: map[reflect.Type][]string{
+		reflect.TypeOf(common.AddressType("")):                                                  {"postal", "physical", "both"},
+		reflect.TypeOf(common.AddressUse("")):                                                   {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(common.ContactPointSystem("")):                                           {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(common.ContactPointUse("")):                                              {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(common.ContributorType("")):                                              {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(common.DataRequirementSortDirection("")):                                 {"ascending", "descending"},
+		reflect.TypeOf(common.HumanNameUse("")):                                                 {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(common.IdentifierUse("")):                                                {"usual", "official", "temp", "secondary", "old"},
+		reflect.TypeOf(common.ParameterUse("")):                                                 {"in", "out"},
+		reflect.TypeOf(common.QuantityComparator("")):                                           {"<", "<=", ">", ">="},
+		reflect.TypeOf(common.RelatedArtifactType("")):                                          {"documentation", "justification", "citation", "predecessor", "successor", "derived-from", "depends-on", "composed-of"},
+		reflect.TypeOf(common.TriggerDefinitionType("")):                                        {"named-event", "periodic", "data-changed", "data-added", "data-modified", "data-removed", "data-accessed", "data-access-ended"},
+		reflect.TypeOf(fhir4b.AccountStatus("")):                                                {"active", "inactive", "entered-in-error", "on-hold", "unknown"},
+		reflect.TypeOf(fhir4b.ActivityDefinitionIntent("")):                                     {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.ActivityDefinitionParticipantType("")):                            {"patient", "practitioner", "related-person", "device"},
+		reflect.TypeOf(fhir4b.ActivityDefinitionPriority("")):                                   {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.ActivityDefinitionStatus("")):                                     {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.AddressType("")):                                                  {"postal", "physical", "both"},
+		reflect.TypeOf(fhir4b.AddressUse("")):                                                   {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(fhir4b.AdministrableProductDefinitionStatus("")):                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.AdministrativeGender("")):                                         {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir4b.AdverseEventActuality("")):                                        {"actual", "potential"},
+		reflect.TypeOf(fhir4b.AllergyIntoleranceCategory("")):                                   {"food", "medication", "environment", "biologic"},
+		reflect.TypeOf(fhir4b.AllergyIntoleranceCriticality("")):                                {"low", "high", "unable-to-assess"},
+		reflect.TypeOf(fhir4b.AllergyIntoleranceReactionSeverity("")):                           {"mild", "moderate", "severe"},
+		reflect.TypeOf(fhir4b.AllergyIntoleranceType("")):                                       {"allergy", "intolerance"},
+		reflect.TypeOf(fhir4b.AppointmentParticipantRequired("")):                               {"required", "optional", "information-only"},
+		reflect.TypeOf(fhir4b.AppointmentParticipantStatus("")):                                 {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir4b.AppointmentResponseParticipantStatus("")):                         {"accepted", "declined", "tentative", "needs-action"},
+		reflect.TypeOf(fhir4b.AppointmentStatus("")):                                            {"proposed", "pending", "booked", "arrived", "fulfilled", "cancelled", "noshow", "entered-in-error", "checked-in", "waitlist"},
+		reflect.TypeOf(fhir4b.BiologicallyDerivedProductCategory("")):                           {"organ", "tissue", "fluid", "cells", "biologicalAgent"},
+		reflect.TypeOf(fhir4b.BiologicallyDerivedProductStatus("")):                             {"available", "unavailable"},
+		reflect.TypeOf(fhir4b.BiologicallyDerivedProductStorageScale("")):                       {"farenheit", "celsius", "kelvin"},
+		reflect.TypeOf(fhir4b.BundleEntryRequestMethod("")):                                     {"GET", "HEAD", "POST", "PUT", "DELETE", "PATCH"},
+		reflect.TypeOf(fhir4b.BundleEntrySearchMode("")):                                        {"match", "include", "outcome"},
+		reflect.TypeOf(fhir4b.BundleType("")):                                                   {"document", "message", "transaction", "transaction-response", "batch", "batch-response", "history", "searchset", "collection"},
+		reflect.TypeOf(fhir4b.CapabilityStatementDocumentMode("")):                              {"producer", "consumer"},
+		reflect.TypeOf(fhir4b.CapabilityStatementKind("")):                                      {"instance", "capability", "requirements"},
+		reflect.TypeOf(fhir4b.CapabilityStatementMessagingSupportedMessageMode("")):             {"sender", "receiver"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestInteractionCode("")):                       {"transaction", "batch", "search-system", "history-system"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestMode("")):                                  {"client", "server"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceConditionalDelete("")):             {"not-supported", "single", "multiple"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceConditionalRead("")):               {"not-supported", "modified-since", "not-match", "full-support"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceInteractionCode("")):               {"read", "vread", "update", "patch", "delete", "history-instance", "history-type", "create", "search-type"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceReferencePolicy("")):               {"literal", "logical", "resolves", "enforced", "local"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceSearchParamType("")):               {"number", "date", "string", "token", "reference", "composite", "quantity", "uri", "special"},
+		reflect.TypeOf(fhir4b.CapabilityStatementRestResourceVersioning("")):                    {"no-version", "versioned", "versioned-update"},
+		reflect.TypeOf(fhir4b.CapabilityStatementStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.CarePlanActivityDetailKind("")):                                   {"Appointment", "CommunicationRequest", "DeviceRequest", "MedicationRequest", "NutritionOrder", "Task", "ServiceRequest", "VisionPrescription"},
+		reflect.TypeOf(fhir4b.CarePlanActivityDetailStatus("")):                                 {"not-started", "scheduled", "in-progress", "on-hold", "completed", "cancelled", "stopped", "unknown", "entered-in-error"},
+		reflect.TypeOf(fhir4b.CarePlanIntent("")):                                               {"proposal", "plan", "order", "option"},
+		reflect.TypeOf(fhir4b.CarePlanStatus("")):                                               {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.CareTeamStatus("")):                                               {"proposed", "active", "suspended", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.CatalogEntryRelatedEntryRelationtype("")):                         {"triggers", "is-replaced-by"},
+		reflect.TypeOf(fhir4b.CatalogEntryStatus("")):                                           {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ChargeItemDefinitionPropertyGroupPriceComponentType("")):          {"base", "surcharge", "deduction", "discount", "tax", "informational"},
+		reflect.TypeOf(fhir4b.ChargeItemDefinitionStatus("")):                                   {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ChargeItemStatus("")):                                             {"planned", "billable", "not-billable", "aborted", "billed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.CitationStatus("")):                                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ClaimResponseOutcome("")):                                         {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4b.ClaimResponseProcessNoteType("")):                                 {"display", "print", "printoper"},
+		reflect.TypeOf(fhir4b.ClaimResponseStatus("")):                                          {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ClaimStatus("")):                                                  {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ClaimUse("")):                                                     {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir4b.ClinicalImpressionStatus("")):                                     {"in-progress", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ClinicalUseDefinitionType("")):                                    {"indication", "contraindication", "interaction", "undesirable-effect", "warning"},
+		reflect.TypeOf(fhir4b.CodeSystemContent("")):                                            {"not-present", "example", "fragment", "complete", "supplement"},
+		reflect.TypeOf(fhir4b.CodeSystemFilterOperator("")):                                     {"=", "is-a", "descendent-of", "is-not-a", "regex", "in", "not-in", "generalizes", "exists"},
+		reflect.TypeOf(fhir4b.CodeSystemHierarchyMeaning("")):                                   {"grouped-by", "is-a", "part-of", "classified-with"},
+		reflect.TypeOf(fhir4b.CodeSystemPropertyType("")):                                       {"code", "Coding", "string", "integer", "boolean", "dateTime", "decimal"},
+		reflect.TypeOf(fhir4b.CodeSystemStatus("")):                                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.CommunicationPriority("")):                                        {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.CommunicationRequestPriority("")):                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.CommunicationRequestStatus("")):                                   {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.CommunicationStatus("")):                                          {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.CompartmentDefinitionCode("")):                                    {"Patient", "Encounter", "RelatedPerson", "Practitioner", "Device"},
+		reflect.TypeOf(fhir4b.CompartmentDefinitionStatus("")):                                  {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.CompositionAttesterMode("")):                                      {"personal", "professional", "legal", "official"},
+		reflect.TypeOf(fhir4b.CompositionRelatesToCode("")):                                     {"replaces", "transforms", "signs", "appends"},
+		reflect.TypeOf(fhir4b.CompositionStatus("")):                                            {"preliminary", "final", "amended", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ConceptMapGroupElementTargetEquivalence("")):                      {"relatedto", "equivalent", "equal", "wider", "subsumes", "narrower", "specializes", "inexact", "unmatched", "disjoint"},
+		reflect.TypeOf(fhir4b.ConceptMapGroupUnmappedMode("")):                                  {"provided", "fixed", "other-map"},
+		reflect.TypeOf(fhir4b.ConceptMapStatus("")):                                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ConsentProvisionDataMeaning("")):                                  {"instance", "related", "dependents", "authoredby"},
+		reflect.TypeOf(fhir4b.ConsentProvisionType("")):                                         {"deny", "permit"},
+		reflect.TypeOf(fhir4b.ConsentStatus("")):                                                {"draft", "proposed", "active", "rejected", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ContactPointSystem("")):                                           {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(fhir4b.ContactPointUse("")):                                              {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(fhir4b.ContractContentDefinitionPublicationStatus("")):                   {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir4b.ContractStatus("")):                                               {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir4b.ContributorType("")):                                              {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(fhir4b.CoverageEligibilityRequestPurpose("")):                            {"auth-requirements", "benefits", "discovery", "validation"},
+		reflect.TypeOf(fhir4b.CoverageEligibilityRequestStatus("")):                             {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponseOutcome("")):                           {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponsePurpose("")):                           {"auth-requirements", "benefits", "discovery", "validation"},
+		reflect.TypeOf(fhir4b.CoverageEligibilityResponseStatus("")):                            {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.CoverageStatus("")):                                               {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.DaysOfWeek("")):                                                   {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir4b.DetectedIssueSeverity("")):                                        {"high", "moderate", "low"},
+		reflect.TypeOf(fhir4b.DetectedIssueStatus("")):                                          {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.DeviceDefinitionDeviceNameType("")):                               {"udi-label-name", "user-friendly-name", "patient-reported-name", "manufacturer-name", "model-name", "other"},
+		reflect.TypeOf(fhir4b.DeviceDeviceNameType("")):                                         {"udi-label-name", "user-friendly-name", "patient-reported-name", "manufacturer-name", "model-name", "other"},
+		reflect.TypeOf(fhir4b.DeviceMetricCalibrationState("")):                                 {"not-calibrated", "calibration-required", "calibrated", "unspecified"},
+		reflect.TypeOf(fhir4b.DeviceMetricCalibrationType("")):                                  {"unspecified", "offset", "gain", "two-point"},
+		reflect.TypeOf(fhir4b.DeviceMetricCategory("")):                                         {"measurement", "setting", "calculation", "unspecified"},
+		reflect.TypeOf(fhir4b.DeviceMetricColor("")):                                            {"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"},
+		reflect.TypeOf(fhir4b.DeviceMetricOperationalStatus("")):                                {"on", "off", "standby", "entered-in-error"},
+		reflect.TypeOf(fhir4b.DeviceRequestIntent("")):                                          {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.DeviceRequestPriority("")):                                        {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.DeviceRequestStatus("")):                                          {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.DeviceStatus("")):                                                 {"active", "inactive", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.DeviceUdiCarrierEntryType("")):                                    {"barcode", "rfid", "manual", "card", "self-reported", "unknown"},
+		reflect.TypeOf(fhir4b.DeviceUseStatementStatus("")):                                     {"active", "completed", "entered-in-error", "intended", "stopped", "on-hold"},
+		reflect.TypeOf(fhir4b.DiagnosticReportStatus("")):                                       {"registered", "partial", "preliminary", "final", "amended", "corrected", "appended", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.DocumentManifestStatus("")):                                       {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir4b.DocumentReferenceDocStatus("")):                                   {"preliminary", "final", "amended", "entered-in-error"},
+		reflect.TypeOf(fhir4b.DocumentReferenceRelatesToCode("")):                               {"replaces", "transforms", "signs", "appends"},
+		reflect.TypeOf(fhir4b.DocumentReferenceStatus("")):                                      {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ElementDefinitionBindingStrength("")):                             {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir4b.ElementDefinitionConstraintSeverity("")):                          {"error", "warning"},
+		reflect.TypeOf(fhir4b.ElementDefinitionRepresentation("")):                              {"xmlAttr", "xmlText", "typeAttr", "cdaText", "xhtml"},
+		reflect.TypeOf(fhir4b.ElementDefinitionSlicingDiscriminatorType("")):                    {"value", "exists", "pattern", "type", "profile"},
+		reflect.TypeOf(fhir4b.ElementDefinitionSlicingRules("")):                                {"closed", "open", "openAtEnd"},
+		reflect.TypeOf(fhir4b.ElementDefinitionTypeAggregation("")):                             {"contained", "referenced", "bundled"},
+		reflect.TypeOf(fhir4b.ElementDefinitionTypeVersioning("")):                              {"either", "independent", "specific"},
+		reflect.TypeOf(fhir4b.EncounterLocationStatus("")):                                      {"planned", "active", "reserved", "completed"},
+		reflect.TypeOf(fhir4b.EncounterStatus("")):                                              {"planned", "arrived", "triaged", "in-progress", "onleave", "finished", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.EndpointStatus("")):                                               {"active", "suspended", "error", "off", "entered-in-error", "test"},
+		reflect.TypeOf(fhir4b.EnrollmentRequestStatus("")):                                      {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.EnrollmentResponseOutcome("")):                                    {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4b.EnrollmentResponseStatus("")):                                     {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.EpisodeOfCareStatus("")):                                          {"planned", "waitlist", "active", "onhold", "finished", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir4b.EventDefinitionStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.EvidenceReportRelatesToCode("")):                                  {"replaces", "amends", "appends", "transforms", "replacedWith", "amendedWith", "appendedWith", "transformedWith"},
+		reflect.TypeOf(fhir4b.EvidenceReportSectionMode("")):                                    {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir4b.EvidenceReportStatus("")):                                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.EvidenceStatisticModelCharacteristicVariableHandling("")):         {"continuous", "dichotomous", "ordinal", "polychotomous"},
+		reflect.TypeOf(fhir4b.EvidenceStatus("")):                                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.EvidenceVariableCharacteristicCombination("")):                    {"intersection", "union"},
+		reflect.TypeOf(fhir4b.EvidenceVariableCharacteristicGroupMeasure("")):                   {"mean", "median", "mean-of-mean", "mean-of-median", "median-of-mean", "median-of-median"},
+		reflect.TypeOf(fhir4b.EvidenceVariableHandling("")):                                     {"continuous", "dichotomous", "ordinal", "polychotomous"},
+		reflect.TypeOf(fhir4b.EvidenceVariableStatus("")):                                       {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ExampleScenarioActorType("")):                                     {"person", "entity"},
+		reflect.TypeOf(fhir4b.ExampleScenarioStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitOutcome("")):                                  {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitProcessNoteType("")):                          {"display", "print", "printoper"},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitStatus("")):                                   {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ExplanationOfBenefitUse("")):                                      {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir4b.FamilyMemberHistoryStatus("")):                                    {"partial", "completed", "entered-in-error", "health-unknown"},
+		reflect.TypeOf(fhir4b.FlagStatus("")):                                                   {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.GoalLifecycleStatus("")):                                          {"proposed", "planned", "accepted", "active", "on-hold", "completed", "cancelled", "entered-in-error", "rejected"},
+		reflect.TypeOf(fhir4b.GraphDefinitionLinkTargetCompartmentCode("")):                     {"Patient", "Encounter", "RelatedPerson", "Practitioner", "Device"},
+		reflect.TypeOf(fhir4b.GraphDefinitionLinkTargetCompartmentRule("")):                     {"identical", "matching", "different", "custom"},
+		reflect.TypeOf(fhir4b.GraphDefinitionLinkTargetCompartmentUse("")):                      {"condition", "requirement"},
+		reflect.TypeOf(fhir4b.GraphDefinitionStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.GroupType("")):                                                    {"person", "animal", "practitioner", "device", "medication", "substance"},
+		reflect.TypeOf(fhir4b.GuidanceResponseStatus("")):                                       {"success", "data-requested", "data-required", "in-progress", "failure", "entered-in-error"},
+		reflect.TypeOf(fhir4b.HealthcareServiceAvailableTimeDaysOfWeek("")):                     {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir4b.HumanNameUse("")):                                                 {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(fhir4b.ImagingStudyStatus("")):                                           {"registered", "available", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.ImmunizationEvaluationStatus("")):                                 {"completed", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ImmunizationStatus("")):                                           {"completed", "entered-in-error", "not-done"},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionPageGeneration("")):                  {"html", "markdown", "xml", "generated"},
+		reflect.TypeOf(fhir4b.ImplementationGuideDefinitionParameterCode("")):                   {"apply", "path-resource", "path-pages", "path-tx-cache", "expansion-parameter", "rule-broken-links", "generate-xml", "generate-json", "generate-turtle", "html-template"},
+		reflect.TypeOf(fhir4b.ImplementationGuideStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.IngredientManufacturerRole("")):                                   {"allowed", "possible", "actual"},
+		reflect.TypeOf(fhir4b.IngredientStatus("")):                                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.InsurancePlanStatus("")):                                          {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.InvoiceLineItemPriceComponentType("")):                            {"base", "surcharge", "deduction", "discount", "tax", "informational"},
+		reflect.TypeOf(fhir4b.InvoiceStatus("")):                                                {"draft", "issued", "balanced", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir4b.LibraryStatus("")):                                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.LinkageItemType("")):                                              {"source", "alternate", "historical"},
+		reflect.TypeOf(fhir4b.ListMode("")):                                                     {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir4b.ListStatus("")):                                                   {"current", "retired", "entered-in-error"},
+		reflect.TypeOf(fhir4b.LocationMode("")):                                                 {"instance", "kind"},
+		reflect.TypeOf(fhir4b.LocationStatus("")):                                               {"active", "suspended", "inactive"},
+		reflect.TypeOf(fhir4b.ManufacturedItemDefinitionStatus("")):                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.MeasureReportStatus("")):                                          {"complete", "pending", "error"},
+		reflect.TypeOf(fhir4b.MeasureReportType("")):                                            {"individual", "subject-list", "summary", "data-collection"},
+		reflect.TypeOf(fhir4b.MeasureStatus("")):                                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.MediaStatus("")):                                                  {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.MedicationAdministrationStatus("")):                               {"in-progress", "not-done", "on-hold", "completed", "entered-in-error", "stopped", "unknown"},
+		reflect.TypeOf(fhir4b.MedicationDispenseStatus("")):                                     {"preparation", "in-progress", "cancelled", "on-hold", "completed", "entered-in-error", "stopped", "declined", "unknown"},
+		reflect.TypeOf(fhir4b.MedicationKnowledgeStatus("")):                                    {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.MedicationRequestIntent("")):                                      {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.MedicationRequestPriority("")):                                    {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.MedicationRequestStatus("")):                                      {"active", "on-hold", "cancelled", "completed", "entered-in-error", "stopped", "draft", "unknown"},
+		reflect.TypeOf(fhir4b.MedicationStatementStatus("")):                                    {"active", "completed", "entered-in-error", "intended", "stopped", "on-hold", "unknown", "not-taken"},
+		reflect.TypeOf(fhir4b.MedicationStatus("")):                                             {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.MessageDefinitionCategory("")):                                    {"consequence", "currency", "notification"},
+		reflect.TypeOf(fhir4b.MessageDefinitionResponseRequired("")):                            {"always", "on-error", "never", "on-success"},
+		reflect.TypeOf(fhir4b.MessageDefinitionStatus("")):                                      {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.MessageHeaderResponseCode("")):                                    {"ok", "transient-error", "fatal-error"},
+		reflect.TypeOf(fhir4b.MolecularSequenceQualityType("")):                                 {"indel", "snp", "unknown"},
+		reflect.TypeOf(fhir4b.MolecularSequenceReferenceSeqOrientation("")):                     {"sense", "antisense"},
+		reflect.TypeOf(fhir4b.MolecularSequenceReferenceSeqStrand("")):                          {"watson", "crick"},
+		reflect.TypeOf(fhir4b.MolecularSequenceRepositoryType("")):                              {"directlink", "openapi", "login", "oauth", "other"},
+		reflect.TypeOf(fhir4b.MolecularSequenceType("")):                                        {"aa", "dna", "rna"},
+		reflect.TypeOf(fhir4b.NamingSystemKind("")):                                             {"codesystem", "identifier", "root"},
+		reflect.TypeOf(fhir4b.NamingSystemStatus("")):                                           {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.NamingSystemUniqueIdType("")):                                     {"oid", "uuid", "uri", "other"},
+		reflect.TypeOf(fhir4b.NarrativeStatus("")):                                              {"generated", "extensions", "additional", "empty"},
+		reflect.TypeOf(fhir4b.NutritionOrderIntent("")):                                         {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.NutritionOrderStatus("")):                                         {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.NutritionProductStatus("")):                                       {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.ObservationDefinitionPermittedDataType("")):                       {"Quantity", "CodeableConcept", "string", "boolean", "integer", "Range", "Ratio", "SampledData", "time", "dateTime", "Period"},
+		reflect.TypeOf(fhir4b.ObservationDefinitionQualifiedIntervalCategory("")):               {"reference", "critical", "absolute"},
+		reflect.TypeOf(fhir4b.ObservationDefinitionQualifiedIntervalGender("")):                 {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir4b.ObservationStatus("")):                                            {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.OperationDefinitionKind("")):                                      {"operation", "query"},
+		reflect.TypeOf(fhir4b.OperationDefinitionParameterBindingStrength("")):                  {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir4b.OperationDefinitionParameterSearchType("")):                       {"number", "date", "string", "token", "reference", "composite", "quantity", "uri", "special"},
+		reflect.TypeOf(fhir4b.OperationDefinitionParameterUse("")):                              {"in", "out"},
+		reflect.TypeOf(fhir4b.OperationDefinitionStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.OperationOutcomeIssueSeverity("")):                                {"fatal", "error", "warning", "information"},
+		reflect.TypeOf(fhir4b.PatientLinkType("")):                                              {"replaced-by", "replaces", "refer", "seealso"},
+		reflect.TypeOf(fhir4b.PaymentNoticeStatus("")):                                          {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.PaymentReconciliationOutcome("")):                                 {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir4b.PaymentReconciliationProcessNoteType("")):                         {"display", "print", "printoper"},
+		reflect.TypeOf(fhir4b.PaymentReconciliationStatus("")):                                  {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir4b.PersonLinkAssurance("")):                                          {"level1", "level2", "level3", "level4"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionCardinalityBehavior("")):                      {"single", "multiple"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionConditionKind("")):                            {"applicability", "start", "stop"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionGroupingBehavior("")):                         {"visual-group", "logical-group", "sentence-group"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionParticipantType("")):                          {"patient", "practitioner", "related-person", "device"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionPrecheckBehavior("")):                         {"yes", "no"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionPriority("")):                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionRelatedActionRelationship("")):                {"before-start", "before", "before-end", "concurrent-with-start", "concurrent", "concurrent-with-end", "after-start", "after", "after-end"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionRequiredBehavior("")):                         {"must", "could", "must-unless-documented"},
+		reflect.TypeOf(fhir4b.PlanDefinitionActionSelectionBehavior("")):                        {"any", "all", "all-or-none", "exactly-one", "at-most-one", "one-or-more"},
+		reflect.TypeOf(fhir4b.PlanDefinitionStatus("")):                                         {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.PractitionerGender("")):                                           {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir4b.PractitionerRoleAvailableTimeDaysOfWeek("")):                      {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir4b.ProcedureStatus("")):                                              {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.ProvenanceEntityRole("")):                                         {"derivation", "revision", "quotation", "source", "removal"},
+		reflect.TypeOf(fhir4b.QuestionnaireItemEnableBehavior("")):                              {"all", "any"},
+		reflect.TypeOf(fhir4b.QuestionnaireItemEnableWhenOperator("")):                          {"exists", "=", "!=", ">", "<", ">=", "<="},
+		reflect.TypeOf(fhir4b.QuestionnaireItemType("")):                                        {"group", "display", "question", "boolean", "decimal", "integer", "date", "dateTime", "time", "string", "text", "url", "choice", "open-choice", "attachment", "reference", "quantity"},
+		reflect.TypeOf(fhir4b.QuestionnaireResponseStatus("")):                                  {"in-progress", "completed", "amended", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir4b.QuestionnaireStatus("")):                                          {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.RequestGroupActionCardinalityBehavior("")):                        {"single", "multiple"},
+		reflect.TypeOf(fhir4b.RequestGroupActionConditionKind("")):                              {"applicability", "start", "stop"},
+		reflect.TypeOf(fhir4b.RequestGroupActionGroupingBehavior("")):                           {"visual-group", "logical-group", "sentence-group"},
+		reflect.TypeOf(fhir4b.RequestGroupActionPrecheckBehavior("")):                           {"yes", "no"},
+		reflect.TypeOf(fhir4b.RequestGroupActionPriority("")):                                   {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.RequestGroupActionRelatedActionRelationship("")):                  {"before-start", "before", "before-end", "concurrent-with-start", "concurrent", "concurrent-with-end", "after-start", "after", "after-end"},
+		reflect.TypeOf(fhir4b.RequestGroupActionRequiredBehavior("")):                           {"must", "could", "must-unless-documented"},
+		reflect.TypeOf(fhir4b.RequestGroupActionSelectionBehavior("")):                          {"any", "all", "all-or-none", "exactly-one", "at-most-one", "one-or-more"},
+		reflect.TypeOf(fhir4b.RequestGroupIntent("")):                                           {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.RequestGroupPriority("")):                                         {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.RequestGroupStatus("")):                                           {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.ResearchDefinitionStatus("")):                                     {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ResearchElementDefinitionCharacteristicGroupMeasure("")):          {"mean", "median", "mean-of-mean", "mean-of-median", "median-of-mean", "median-of-median"},
+		reflect.TypeOf(fhir4b.ResearchElementDefinitionStatus("")):                              {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ResearchElementDefinitionType("")):                                {"population", "exposure", "outcome"},
+		reflect.TypeOf(fhir4b.ResearchElementDefinitionVariableType("")):                        {"dichotomous", "continuous", "descriptive"},
+		reflect.TypeOf(fhir4b.ResearchStudyStatus("")):                                          {"active", "administratively-completed", "approved", "closed-to-accrual", "closed-to-accrual-and-intervention", "completed", "disapproved", "in-review", "temporarily-closed-to-accrual", "temporarily-closed-to-accrual-and-intervention", "withdrawn"},
+		reflect.TypeOf(fhir4b.ResearchSubjectStatus("")):                                        {"candidate", "eligible", "follow-up", "ineligible", "not-registered", "off-study", "on-study", "on-study-intervention", "on-study-observation", "pending-on-study", "potential-candidate", "screening", "withdrawn"},
+		reflect.TypeOf(fhir4b.RiskAssessmentStatus("")):                                         {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.SearchParameterComparator("")):                                    {"eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap"},
+		reflect.TypeOf(fhir4b.SearchParameterModifier("")):                                      {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type", "identifier", "ofType"},
+		reflect.TypeOf(fhir4b.SearchParameterStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.SearchParameterType("")):                                          {"number", "date", "string", "token", "reference", "composite", "quantity", "uri", "special"},
+		reflect.TypeOf(fhir4b.SearchParameterXpathUsage("")):                                    {"normal", "phonetic", "nearby", "distance", "other"},
+		reflect.TypeOf(fhir4b.SectionMode("")):                                                  {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir4b.ServiceRequestIntent("")):                                         {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.ServiceRequestPriority("")):                                       {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.ServiceRequestStatus("")):                                         {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.SlotStatus("")):                                                   {"busy", "free", "busy-unavailable", "busy-tentative", "entered-in-error"},
+		reflect.TypeOf(fhir4b.SpecimenDefinitionTypeTestedPreference("")):                       {"preferred", "alternate"},
+		reflect.TypeOf(fhir4b.SpecimenStatus("")):                                               {"available", "unavailable", "unsatisfactory", "entered-in-error"},
+		reflect.TypeOf(fhir4b.StructureDefinitionContextType("")):                               {"fhirpath", "element", "extension"},
+		reflect.TypeOf(fhir4b.StructureDefinitionDerivation("")):                                {"specialization", "constraint"},
+		reflect.TypeOf(fhir4b.StructureDefinitionKind("")):                                      {"primitive-type", "complex-type", "resource", "logical"},
+		reflect.TypeOf(fhir4b.StructureDefinitionStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.StructureMapGroupInputMode("")):                                   {"source", "target"},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleSourceListMode("")):                          {"first", "not_first", "last", "not_last", "only_one"},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleTargetContextType("")):                       {"type", "variable"},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleTargetListMode("")):                          {"first", "share", "last", "collate"},
+		reflect.TypeOf(fhir4b.StructureMapGroupRuleTargetTransform("")):                         {"create", "copy", "truncate", "escape", "cast", "append", "translate", "reference", "dateOp", "uuid", "pointer", "evaluate", "cc", "c", "qty", "id", "cp"},
+		reflect.TypeOf(fhir4b.StructureMapGroupTypeMode("")):                                    {"none", "types", "type-and-types"},
+		reflect.TypeOf(fhir4b.StructureMapStatus("")):                                           {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.StructureMapStructureMode("")):                                    {"source", "queried", "target", "produced"},
+		reflect.TypeOf(fhir4b.SubscriptionChannelType("")):                                      {"rest-hook", "websocket", "email", "sms", "message"},
+		reflect.TypeOf(fhir4b.SubscriptionStatusCode("")):                                       {"requested", "active", "error", "off"},
+		reflect.TypeOf(fhir4b.SubscriptionStatusStatus("")):                                     {"requested", "active", "error", "off", "entered-in-error"},
+		reflect.TypeOf(fhir4b.SubscriptionStatusType("")):                                       {"handshake", "heartbeat", "event-notification", "query-status", "query-event"},
+		reflect.TypeOf(fhir4b.SubscriptionTopicCanFilterByModifier("")):                         {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type", "identifier", "of-type", "code-text", "text-advanced", "iterate"},
+		reflect.TypeOf(fhir4b.SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate("")): {"test-passes", "test-fails"},
+		reflect.TypeOf(fhir4b.SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete("")): {"test-passes", "test-fails"},
+		reflect.TypeOf(fhir4b.SubscriptionTopicResourceTriggerSupportedInteraction("")):         {"create", "update", "delete"},
+		reflect.TypeOf(fhir4b.SubscriptionTopicStatus("")):                                      {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.SubstanceStatus("")):                                              {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir4b.SupplyDeliveryStatus("")):                                         {"in-progress", "completed", "abandoned", "entered-in-error"},
+		reflect.TypeOf(fhir4b.SupplyRequestPriority("")):                                        {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.SupplyRequestStatus("")):                                          {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir4b.TaskIntent("")):                                                   {"unknown", "proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir4b.TaskPriority("")):                                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir4b.TaskStatus("")):                                                   {"draft", "requested", "received", "accepted", "rejected", "ready", "cancelled", "in-progress", "on-hold", "failed", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesCodeSearch("")):                            {"explicit", "all"},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesKind("")):                                  {"instance", "capability", "requirements"},
+		reflect.TypeOf(fhir4b.TerminologyCapabilitiesStatus("")):                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.TestReportParticipantType("")):                                    {"test-engine", "client", "server"},
+		reflect.TypeOf(fhir4b.TestReportResult("")):                                             {"pass", "fail", "pending"},
+		reflect.TypeOf(fhir4b.TestReportSetupActionAssertResult("")):                            {"pass", "skip", "fail", "warning", "error"},
+		reflect.TypeOf(fhir4b.TestReportSetupActionOperationResult("")):                         {"pass", "skip", "fail", "warning", "error"},
+		reflect.TypeOf(fhir4b.TestReportStatus("")):                                             {"completed", "in-progress", "waiting", "stopped", "entered-in-error"},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionAssertDirection("")):                         {"response", "request"},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionAssertOperator("")):                          {"equals", "notEquals", "in", "notIn", "greaterThan", "lessThan", "empty", "notEmpty", "contains", "notContains", "eval"},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionAssertRequestMethod("")):                     {"delete", "get", "options", "patch", "post", "put", "head"},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionAssertResponse("")):                          {"okay", "created", "noContent", "notModified", "bad", "forbidden", "notFound", "methodNotAllowed", "conflict", "gone", "preconditionFailed", "unprocessable"},
+		reflect.TypeOf(fhir4b.TestScriptSetupActionOperationMethod("")):                         {"delete", "get", "options", "patch", "post", "put", "head"},
+		reflect.TypeOf(fhir4b.TestScriptStatus("")):                                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.ValueSetComposeIncludeFilterOp("")):                               {"=", "is-a", "descendent-of", "is-not-a", "regex", "in", "not-in", "generalizes", "exists"},
+		reflect.TypeOf(fhir4b.ValueSetStatus("")):                                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir4b.VerificationResultStatus("")):                                     {"attested", "validated", "in-process", "req-revalid", "val-fail", "reval-fail"},
+		reflect.TypeOf(fhir4b.VisionPrescriptionLensSpecificationEye("")):                       {"right", "left"},
+		reflect.TypeOf(fhir4b.VisionPrescriptionLensSpecificationPrismBase("")):                 {"up", "down", "in", "out"},
+		reflect.TypeOf(fhir4b.VisionPrescriptionStatus("")):                                     {"active", "cancelled", "draft", "entered-in-error"},
+	},
+}
@@ -0,0 +1,763 @@
+// Code generated by resourcegen; DO NOT EDIT.
+
+package validate
+
+import (
+	"reflect"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// fhir5Rules are the validation rules of the fhir5 package
+var fhir5Rules = ruleSet{
+	// cardinalities lists the required and limited elements of each struct, a max of -1 is unbounded
+	cardinalities: map[reflect.Type][]cardinality{
+		reflect.TypeOf(common.Annotation{}):                                                                      {{"text", 1, 1}},
+		reflect.TypeOf(common.Contributor{}):                                                                     {{"type", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(common.DataRequirement{}):                                                                 {{"type", 1, 1}},
+		reflect.TypeOf(common.DataRequirementSort{}):                                                             {{"path", 1, 1}, {"direction", 1, 1}},
+		reflect.TypeOf(common.Extension{}):                                                                       {{"url", 1, 1}},
+		reflect.TypeOf(common.ParameterDefinition{}):                                                             {{"use", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(common.RelatedArtifact{}):                                                                 {{"type", 1, 1}},
+		reflect.TypeOf(common.SampledData{}):                                                                     {{"origin", 1, 1}, {"intervalUnit", 1, 1}, {"dimensions", 1, 1}},
+		reflect.TypeOf(common.TriggerDefinition{}):                                                               {{"type", 1, 1}},
+		reflect.TypeOf(common.UsageContext{}):                                                                    {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.Account{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.AccountBalance{}):                                                                   {{"amount", 1, 1}},
+		reflect.TypeOf(fhir5.AccountCoverage{}):                                                                  {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir5.AccountDiagnosis{}):                                                                 {{"condition", 1, 1}},
+		reflect.TypeOf(fhir5.AccountGuarantor{}):                                                                 {{"party", 1, 1}},
+		reflect.TypeOf(fhir5.AccountProcedure{}):                                                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.AccountRelatedAccount{}):                                                            {{"account", 1, 1}},
+		reflect.TypeOf(fhir5.ActivityDefinition{}):                                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ActivityDefinitionDynamicValue{}):                                                   {{"path", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir5.ActorDefinition{}):                                                                  {{"status", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.AdministrableProductDefinition{}):                                                   {{"status", 1, 1}, {"routeOfAdministration", 1, -1}},
+		reflect.TypeOf(fhir5.AdministrableProductDefinitionProperty{}):                                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.AdministrableProductDefinitionRouteOfAdministration{}):                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.AdministrableProductDefinitionRouteOfAdministrationTargetSpecies{}):                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.AdministrableProductDefinitionRouteOfAdministrationTargetSpeciesWithdrawalPeriod{}): {{"tissue", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEvent{}):                                                                     {{"status", 1, 1}, {"actuality", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEventContributingFactor{}):                                                   {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEventMitigatingAction{}):                                                     {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEventParticipant{}):                                                          {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEventPreventiveAction{}):                                                     {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEventSupportingInfo{}):                                                       {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AdverseEventSuspectEntity{}):                                                        {{"instance[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AllergyIntolerance{}):                                                               {{"patient", 1, 1}},
+		reflect.TypeOf(fhir5.AllergyIntoleranceParticipant{}):                                                    {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.AllergyIntoleranceReaction{}):                                                       {{"manifestation", 1, -1}},
+		reflect.TypeOf(fhir5.Annotation{}):                                                                       {{"text", 1, 1}},
+		reflect.TypeOf(fhir5.Appointment{}):                                                                      {{"status", 1, 1}, {"participant", 1, -1}},
+		reflect.TypeOf(fhir5.AppointmentParticipant{}):                                                           {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.AppointmentRecurrenceTemplate{}):                                                    {{"recurrenceType", 1, 1}},
+		reflect.TypeOf(fhir5.AppointmentRecurrenceTemplateMonthlyTemplate{}):                                     {{"monthInterval", 1, 1}},
+		reflect.TypeOf(fhir5.AppointmentRecurrenceTemplateYearlyTemplate{}):                                      {{"yearInterval", 1, 1}},
+		reflect.TypeOf(fhir5.AppointmentResponse{}):                                                              {{"appointment", 1, 1}, {"participantStatus", 1, 1}},
+		reflect.TypeOf(fhir5.ArtifactAssessment{}):                                                               {{"artifact[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AuditEvent{}):                                                                       {{"code", 1, 1}, {"recorded", 1, 1}, {"agent", 1, -1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir5.AuditEventAgent{}):                                                                  {{"who", 1, 1}},
+		reflect.TypeOf(fhir5.AuditEventEntityDetail{}):                                                           {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.AuditEventOutcome{}):                                                                {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.AuditEventSource{}):                                                                 {{"observer", 1, 1}},
+		reflect.TypeOf(fhir5.Basic{}):                                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.Binary{}):                                                                           {{"contentType", 1, 1}},
+		reflect.TypeOf(fhir5.BiologicallyDerivedProductDispense{}):                                               {{"status", 1, 1}, {"product", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir5.BiologicallyDerivedProductDispensePerformer{}):                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.BiologicallyDerivedProductProperty{}):                                               {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.BodyStructure{}):                                                                    {{"includedStructure", 1, -1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir5.BodyStructureIncludedStructure{}):                                                   {{"structure", 1, 1}},
+		reflect.TypeOf(fhir5.Bundle{}):                                                                           {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.BundleEntryRequest{}):                                                               {{"method", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir5.BundleEntryResponse{}):                                                              {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.BundleLink{}):                                                                       {{"relation", 1, 1}, {"url", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatement{}):                                                              {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}, {"fhirVersion", 1, 1}, {"format", 1, -1}},
+		reflect.TypeOf(fhir5.CapabilityStatementDocument{}):                                                      {{"mode", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementImplementation{}):                                                {{"description", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementMessagingEndpoint{}):                                             {{"protocol", 1, 1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementMessagingSupportedMessage{}):                                     {{"mode", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementRest{}):                                                          {{"mode", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementRestInteraction{}):                                               {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResource{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceInteraction{}):                                       {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceOperation{}):                                         {{"name", 1, 1}, {"definition", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceSearchParam{}):                                       {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.CapabilityStatementSoftware{}):                                                      {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.CarePlan{}):                                                                         {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.ChargeItem{}):                                                                       {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.ChargeItemDefinition{}):                                                             {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ChargeItemPerformer{}):                                                              {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.Citation{}):                                                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifact{}):                                                            {{"part", 0, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactAbstract{}):                                                    {{"text", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactContributorshipEntry{}):                                        {{"contributor", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactContributorshipEntryContributionInstance{}):                    {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactContributorshipSummary{}):                                      {{"value", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactRelatesTo{}):                                                   {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactTitle{}):                                                       {{"text", 1, 1}},
+		reflect.TypeOf(fhir5.CitationCitedArtifactVersion{}):                                                     {{"value", 1, 1}},
+		reflect.TypeOf(fhir5.CitationStatusDate{}):                                                               {{"activity", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir5.CitationSummary{}):                                                                  {{"text", 1, 1}},
+		reflect.TypeOf(fhir5.Claim{}):                                                                            {{"status", 1, 1}, {"type", 1, 1}, {"subType", 0, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimAccident{}):                                                                    {{"date", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimCareTeam{}):                                                                    {{"sequence", 1, 1}, {"provider", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimDiagnosis{}):                                                                   {{"sequence", 1, 1}, {"diagnosis[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimInsurance{}):                                                                   {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimItem{}):                                                                        {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimItemDetail{}):                                                                  {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimItemDetailSubDetail{}):                                                         {{"sequence", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimPayee{}):                                                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimProcedure{}):                                                                   {{"sequence", 1, 1}, {"procedure[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponse{}):                                                                    {{"status", 1, 1}, {"type", 1, 1}, {"use", 1, 1}, {"patient", 1, 1}, {"created", 1, 1}, {"outcome", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponseError{}):                                                               {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponseInsurance{}):                                                           {{"sequence", 1, 1}, {"focal", 1, 1}, {"coverage", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponseItem{}):                                                                {{"itemSequence", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponseItemDetail{}):                                                          {{"detailSequence", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponsePayment{}):                                                             {{"type", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponseProcessNote{}):                                                         {{"text", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimResponseTotal{}):                                                               {{"category", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir5.ClaimSupportingInfo{}):                                                              {{"sequence", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir5.ClinicalImpression{}):                                                               {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.ClinicalUseDefinition{}):                                                            {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.ClinicalUseDefinitionContraindicationOtherTherapy{}):                                {{"relationshipType", 1, 1}, {"treatment", 1, 1}},
+		reflect.TypeOf(fhir5.ClinicalUseDefinitionInteractionInteractant{}):                                      {{"item[x]", 1, 1}},
+		reflect.TypeOf(fhir5.CodeSystem{}):                                                                       {{"status", 1, 1}, {"content", 1, 1}},
+		reflect.TypeOf(fhir5.CodeSystemConcept{}):                                                                {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.CodeSystemConceptDesignation{}):                                                     {{"value", 1, 1}},
+		reflect.TypeOf(fhir5.CodeSystemConceptProperty{}):                                                        {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.CodeSystemFilter{}):                                                                 {{"code", 1, 1}, {"operator", 1, -1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.CodeSystemProperty{}):                                                               {{"code", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.Communication{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.CommunicationPayload{}):                                                             {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir5.CommunicationRequest{}):                                                             {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir5.CommunicationRequestPayload{}):                                                      {{"content[x]", 1, 1}},
+		reflect.TypeOf(fhir5.CompartmentDefinition{}):                                                            {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"code", 1, 1}, {"search", 1, 1}},
+		reflect.TypeOf(fhir5.CompartmentDefinitionResource{}):                                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.Composition{}):                                                                      {{"status", 1, 1}, {"type", 1, 1}, {"date", 1, 1}, {"author", 1, -1}, {"title", 1, 1}},
+		reflect.TypeOf(fhir5.CompositionAttester{}):                                                              {{"mode", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMap{}):                                                                       {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMapAdditionalAttribute{}):                                                    {{"code", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMapGroup{}):                                                                  {{"element", 1, -1}},
+		reflect.TypeOf(fhir5.ConceptMapGroupElementTarget{}):                                                     {{"relationship", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMapGroupElementTargetDependsOn{}):                                            {{"attribute", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMapGroupElementTargetProperty{}):                                             {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMapGroupUnmapped{}):                                                          {{"mode", 1, 1}},
+		reflect.TypeOf(fhir5.ConceptMapProperty{}):                                                               {{"code", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.Condition{}):                                                                        {{"clinicalStatus", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.ConditionDefinition{}):                                                              {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.ConditionDefinitionPlan{}):                                                          {{"reference", 1, 1}},
+		reflect.TypeOf(fhir5.ConditionDefinitionPrecondition{}):                                                  {{"type", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.ConditionDefinitionQuestionnaire{}):                                                 {{"purpose", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir5.ConditionParticipant{}):                                                             {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.Consent{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ConsentProvisionData{}):                                                             {{"meaning", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir5.ConsentVerification{}):                                                              {{"verified", 1, 1}},
+		reflect.TypeOf(fhir5.Coverage{}):                                                                         {{"status", 1, 1}, {"kind", 1, 1}, {"beneficiary", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageClass{}):                                                                    {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageCostToBeneficiaryException{}):                                               {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityRequest{}):                                                       {{"status", 1, 1}, {"purpose", 1, -1}, {"patient", 1, 1}, {"created", 1, 1}, {"insurer", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityRequestEvent{}):                                                  {{"type", 1, 1}, {"when[x]", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityRequestInsurance{}):                                              {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityRequestSupportingInfo{}):                                         {{"sequence", 1, 1}, {"information", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponse{}):                                                      {{"status", 1, 1}, {"purpose", 1, -1}, {"patient", 1, 1}, {"created", 1, 1}, {"request", 1, 1}, {"outcome", 1, 1}, {"insurer", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponseError{}):                                                 {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponseEvent{}):                                                 {{"type", 1, 1}, {"when[x]", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponseInsurance{}):                                             {{"coverage", 1, 1}},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponseInsuranceItemBenefit{}):                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.CoveragePaymentBy{}):                                                                {{"party", 1, 1}},
+		reflect.TypeOf(fhir5.DataRequirement{}):                                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.DataRequirementSort{}):                                                              {{"path", 1, 1}, {"direction", 1, 1}},
+		reflect.TypeOf(fhir5.DetectedIssue{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.DetectedIssueMitigation{}):                                                          {{"action", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceAssociation{}):                                                                {{"device", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceAssociationOperation{}):                                                       {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceConformsTo{}):                                                                 {{"specification", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionChargeItem{}):                                                       {{"chargeItemCode", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionClassification{}):                                                   {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionConformsTo{}):                                                       {{"specification", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionCorrectiveAction{}):                                                 {{"period", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionDeviceName{}):                                                       {{"name", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionHasPart{}):                                                          {{"reference", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionLink{}):                                                             {{"relation", 1, 1}, {"relatedDevice", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionMaterial{}):                                                         {{"substance", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionProperty{}):                                                         {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionRegulatoryIdentifier{}):                                             {{"type", 1, 1}, {"deviceIdentifier", 1, 1}, {"issuer", 1, 1}, {"jurisdiction", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionUdiDeviceIdentifier{}):                                              {{"deviceIdentifier", 1, 1}, {"issuer", 1, 1}, {"jurisdiction", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionUdiDeviceIdentifierMarketDistribution{}):                            {{"marketPeriod", 1, 1}, {"subJurisdiction", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDefinitionVersion{}):                                                          {{"value", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDispense{}):                                                                   {{"status", 1, 1}, {"device", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceDispensePerformer{}):                                                          {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceMetric{}):                                                                     {{"type", 1, 1}, {"device", 1, 1}, {"category", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceName{}):                                                                       {{"value", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceProperty{}):                                                                   {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceRequest{}):                                                                    {{"intent", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceUdiCarrier{}):                                                                 {{"deviceIdentifier", 1, 1}, {"issuer", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceUsage{}):                                                                      {{"status", 1, 1}, {"patient", 1, 1}, {"device", 1, 1}},
+		reflect.TypeOf(fhir5.DeviceUsageAdherence{}):                                                             {{"reason", 1, -1}},
+		reflect.TypeOf(fhir5.DeviceVersion{}):                                                                    {{"value", 1, 1}},
+		reflect.TypeOf(fhir5.DiagnosticReport{}):                                                                 {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.DiagnosticReportMedia{}):                                                            {{"link", 1, 1}},
+		reflect.TypeOf(fhir5.DiagnosticReportSupportingInfo{}):                                                   {{"type", 1, 1}, {"reference", 1, 1}},
+		reflect.TypeOf(fhir5.DocumentReference{}):                                                                {{"status", 1, 1}, {"content", 1, -1}},
+		reflect.TypeOf(fhir5.DocumentReferenceAttester{}):                                                        {{"mode", 1, 1}},
+		reflect.TypeOf(fhir5.DocumentReferenceContent{}):                                                         {{"attachment", 1, 1}},
+		reflect.TypeOf(fhir5.DocumentReferenceContentProfile{}):                                                  {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.DocumentReferenceRelatesTo{}):                                                       {{"code", 1, 1}, {"target", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinition{}):                                                                {{"path", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionBase{}):                                                            {{"path", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionBinding{}):                                                         {{"strength", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionBindingAdditional{}):                                               {{"purpose", 1, 1}, {"valueSet", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionConstraint{}):                                                      {{"key", 1, 1}, {"severity", 1, 1}, {"human", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionExample{}):                                                         {{"label", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionMapping{}):                                                         {{"identity", 1, 1}, {"map", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionSlicing{}):                                                         {{"rules", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionSlicingDiscriminator{}):                                            {{"type", 1, 1}, {"path", 1, 1}},
+		reflect.TypeOf(fhir5.ElementDefinitionType{}):                                                            {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.Encounter{}):                                                                        {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.EncounterHistory{}):                                                                 {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.EncounterHistoryLocation{}):                                                         {{"location", 1, 1}},
+		reflect.TypeOf(fhir5.EncounterLocation{}):                                                                {{"location", 1, 1}},
+		reflect.TypeOf(fhir5.Endpoint{}):                                                                         {{"status", 1, 1}, {"connectionType", 1, -1}, {"address", 1, 1}},
+		reflect.TypeOf(fhir5.EpisodeOfCare{}):                                                                    {{"status", 1, 1}, {"patient", 1, 1}},
+		reflect.TypeOf(fhir5.EpisodeOfCareStatusHistory{}):                                                       {{"status", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir5.EventDefinition{}):                                                                  {{"status", 1, 1}, {"trigger", 1, -1}},
+		reflect.TypeOf(fhir5.Evidence{}):                                                                         {{"status", 1, 1}, {"variableDefinition", 1, -1}},
+		reflect.TypeOf(fhir5.EvidenceReport{}):                                                                   {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.EvidenceReportRelatesTo{}):                                                          {{"code", 1, 1}, {"target", 1, 1}},
+		reflect.TypeOf(fhir5.EvidenceReportSubjectCharacteristic{}):                                              {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.EvidenceStatisticModelCharacteristic{}):                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.EvidenceStatisticModelCharacteristicVariable{}):                                     {{"variableDefinition", 1, 1}},
+		reflect.TypeOf(fhir5.EvidenceVariableCharacteristicDefinitionByCombination{}):                            {{"code", 1, 1}, {"characteristic", 1, -1}},
+		reflect.TypeOf(fhir5.EvidenceVariableCharacteristicDefinitionByTypeAndValue{}):                           {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.EvidenceVariableDefinition{}):                                                       {{"variableRole", 1, 1}},
+		reflect.TypeOf(fhir5.ExampleScenario{}):                                                                  {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ExampleScenarioActor{}):                                                             {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.ExampleScenarioProcess{}):                                                           {{"title", 1, 1}},
+		reflect.TypeOf(fhir5.ExampleScenarioProcessStep{}):                                                       {{"process", 0, 1}},
+		reflect.TypeOf(fhir5.ExampleScenarioProcessStepAlternative{}):                                            {{"title", 1, 1}},
+		reflect.TypeOf(fhir5.FamilyMemberHistory{}):                                                              {{"status", 1, 1}, {"patient", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir5.FamilyMemberHistoryCondition{}):                                                     {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.FamilyMemberHistoryParticipant{}):                                                   {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.FamilyMemberHistoryProcedure{}):                                                     {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.Flag{}):                                                                             {{"status", 1, 1}, {"code", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.GenomicStudy{}):                                                                     {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.Goal{}):                                                                             {{"lifecycleStatus", 1, 1}, {"description", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.GraphDefinition{}):                                                                  {{"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.GraphDefinitionLink{}):                                                              {{"sourceId", 1, 1}, {"targetId", 1, 1}},
+		reflect.TypeOf(fhir5.GraphDefinitionLinkCompartment{}):                                                   {{"use", 1, 1}, {"rule", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.GraphDefinitionNode{}):                                                              {{"nodeId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.Group{}):                                                                            {{"type", 1, 1}, {"membership", 1, 1}},
+		reflect.TypeOf(fhir5.GroupCharacteristic{}):                                                              {{"code", 1, 1}, {"value[x]", 1, 1}, {"exclude", 1, 1}},
+		reflect.TypeOf(fhir5.GroupMember{}):                                                                      {{"entity", 1, 1}},
+		reflect.TypeOf(fhir5.GuidanceResponse{}):                                                                 {{"module[x]", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.ImagingSelection{}):                                                                 {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.ImagingSelectionInstance{}):                                                         {{"uid", 1, 1}},
+		reflect.TypeOf(fhir5.ImagingSelectionInstanceImageRegion2D{}):                                            {{"regionType", 1, 1}, {"coordinate", 1, -1}},
+		reflect.TypeOf(fhir5.ImagingSelectionInstanceImageRegion3D{}):                                            {{"regionType", 1, 1}, {"coordinate", 1, -1}},
+		reflect.TypeOf(fhir5.ImagingStudy{}):                                                                     {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.ImagingStudySeries{}):                                                               {{"uid", 1, 1}, {"modality", 1, 1}},
+		reflect.TypeOf(fhir5.ImagingStudySeriesInstance{}):                                                       {{"uid", 1, 1}, {"sopClass", 1, 1}},
+		reflect.TypeOf(fhir5.ImagingStudySeriesPerformer{}):                                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.Immunization{}):                                                                     {{"status", 1, 1}, {"vaccineCode", 1, 1}, {"patient", 1, 1}, {"occurrence[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ImmunizationEvaluation{}):                                                           {{"status", 1, 1}, {"patient", 1, 1}, {"targetDisease", 1, 1}, {"immunizationEvent", 1, 1}, {"doseStatus", 1, 1}},
+		reflect.TypeOf(fhir5.ImmunizationPerformer{}):                                                            {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.ImmunizationProgramEligibility{}):                                                   {{"program", 1, 1}, {"programStatus", 1, 1}},
+		reflect.TypeOf(fhir5.ImmunizationProtocolApplied{}):                                                      {{"doseNumber", 1, 1}},
+		reflect.TypeOf(fhir5.ImmunizationRecommendation{}):                                                       {{"patient", 1, 1}, {"date", 1, 1}, {"recommendation", 1, -1}},
+		reflect.TypeOf(fhir5.ImmunizationRecommendationRecommendation{}):                                         {{"forecastStatus", 1, 1}},
+		reflect.TypeOf(fhir5.ImmunizationRecommendationRecommendationDateCriterion{}):                            {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuide{}):                                                              {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"packageId", 1, 1}, {"fhirVersion", 1, -1}},
+		reflect.TypeOf(fhir5.ImplementationGuideDefinitionGrouping{}):                                            {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideDefinitionPage{}):                                                {{"name", 1, 1}, {"title", 1, 1}, {"generation", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideDefinitionParameter{}):                                           {{"code", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideDefinitionResource{}):                                            {{"reference", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideDefinitionTemplate{}):                                            {{"code", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideDependsOn{}):                                                     {{"uri", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideGlobal{}):                                                        {{"type", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideManifest{}):                                                      {{"resource", 1, -1}},
+		reflect.TypeOf(fhir5.ImplementationGuideManifestPage{}):                                                  {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.ImplementationGuideManifestResource{}):                                              {{"reference", 1, 1}},
+		reflect.TypeOf(fhir5.Ingredient{}):                                                                       {{"status", 1, 1}, {"role", 1, 1}, {"substance", 1, 1}},
+		reflect.TypeOf(fhir5.IngredientManufacturer{}):                                                           {{"manufacturer", 1, 1}},
+		reflect.TypeOf(fhir5.IngredientSubstance{}):                                                              {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.IngredientSubstanceStrengthReferenceStrength{}):                                     {{"substance", 1, 1}, {"strength[x]", 1, 1}},
+		reflect.TypeOf(fhir5.InsurancePlanCoverage{}):                                                            {{"type", 1, 1}, {"benefit", 1, -1}},
+		reflect.TypeOf(fhir5.InsurancePlanCoverageBenefit{}):                                                     {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.InsurancePlanPlanSpecificCost{}):                                                    {{"category", 1, 1}},
+		reflect.TypeOf(fhir5.InsurancePlanPlanSpecificCostBenefit{}):                                             {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.InsurancePlanPlanSpecificCostBenefitCost{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryItem{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryItemAssociation{}):                                                         {{"associationType", 1, 1}, {"relatedItem", 1, 1}, {"quantity", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryItemCharacteristic{}):                                                      {{"characteristicType", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryItemName{}):                                                                {{"nameType", 1, 1}, {"language", 1, 1}, {"name", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryItemResponsibleOrganization{}):                                             {{"role", 1, 1}, {"organization", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryReport{}):                                                                  {{"status", 1, 1}, {"countType", 1, 1}, {"reportedDateTime", 1, 1}},
+		reflect.TypeOf(fhir5.InventoryReportInventoryListingItem{}):                                              {{"quantity", 1, 1}, {"item", 1, 1}},
+		reflect.TypeOf(fhir5.Invoice{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.InvoiceLineItem{}):                                                                  {{"chargeItem[x]", 1, 1}},
+		reflect.TypeOf(fhir5.InvoiceParticipant{}):                                                               {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.Library{}):                                                                          {{"status", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.Linkage{}):                                                                          {{"item", 1, -1}},
+		reflect.TypeOf(fhir5.LinkageItem{}):                                                                      {{"type", 1, 1}, {"resource", 1, 1}},
+		reflect.TypeOf(fhir5.List{}):                                                                             {{"status", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir5.ListEntry{}):                                                                        {{"item", 1, 1}},
+		reflect.TypeOf(fhir5.LocationPosition{}):                                                                 {{"longitude", 1, 1}, {"latitude", 1, 1}},
+		reflect.TypeOf(fhir5.ManufacturedItemDefinition{}):                                                       {{"status", 1, 1}, {"manufacturedDoseForm", 1, 1}},
+		reflect.TypeOf(fhir5.ManufacturedItemDefinitionComponent{}):                                              {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.ManufacturedItemDefinitionProperty{}):                                               {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.MarketingStatus{}):                                                                  {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.Measure{}):                                                                          {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.MeasureReport{}):                                                                    {{"status", 1, 1}, {"type", 1, 1}, {"period", 1, 1}},
+		reflect.TypeOf(fhir5.MeasureReportGroupPopulation{}):                                                     {{"subjectResults", 0, 1}},
+		reflect.TypeOf(fhir5.MeasureReportGroupStratifierStratumComponent{}):                                     {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.MeasureSupplementalData{}):                                                          {{"criteria", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationAdministration{}):                                                         {{"status", 1, 1}, {"medication", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationAdministrationPerformer{}):                                                {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationDispense{}):                                                               {{"status", 1, 1}, {"medication", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationDispensePerformer{}):                                                      {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationDispenseSubstitution{}):                                                   {{"wasSubstituted", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationIngredient{}):                                                             {{"item", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeCost{}):                                                          {{"type", 1, 1}, {"cost[x]", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeDefinitionalIngredient{}):                                        {{"item", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeIndicationGuidelineDosingGuidelineDosage{}):                      {{"type", 1, 1}, {"dosage", 1, -1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeIndicationGuidelineDosingGuidelinePatientCharacteristic{}):       {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeMedicineClassification{}):                                        {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeRelatedMedicationKnowledge{}):                                    {{"type", 1, 1}, {"reference", 1, -1}},
+		reflect.TypeOf(fhir5.MedicationKnowledgeStorageGuidelineEnvironmentalSetting{}):                          {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationRequest{}):                                                                {{"status", 1, 1}, {"intent", 1, 1}, {"medication", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationRequestSubstitution{}):                                                    {{"allowed[x]", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationStatement{}):                                                              {{"status", 1, 1}, {"medication", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.MedicationStatementAdherence{}):                                                     {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinition{}):                                                       {{"name", 1, -1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinitionCharacteristic{}):                                         {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinitionContact{}):                                                {{"contact", 1, 1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinitionCrossReference{}):                                         {{"product", 1, 1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinitionName{}):                                                   {{"productName", 1, 1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinitionNamePart{}):                                               {{"part", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.MedicinalProductDefinitionNameUsage{}):                                              {{"country", 1, 1}, {"language", 1, 1}},
+		reflect.TypeOf(fhir5.MessageDefinition{}):                                                                {{"status", 1, 1}, {"date", 1, 1}, {"event[x]", 1, 1}},
+		reflect.TypeOf(fhir5.MessageDefinitionAllowedResponse{}):                                                 {{"message", 1, 1}},
+		reflect.TypeOf(fhir5.MessageDefinitionFocus{}):                                                           {{"code", 1, 1}, {"min", 1, 1}},
+		reflect.TypeOf(fhir5.MessageHeader{}):                                                                    {{"event[x]", 1, 1}, {"source", 1, 1}},
+		reflect.TypeOf(fhir5.MessageHeaderResponse{}):                                                            {{"identifier", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.MolecularSequenceRelative{}):                                                        {{"coordinateSystem", 1, 1}},
+		reflect.TypeOf(fhir5.MonetaryComponent{}):                                                                {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.NamingSystem{}):                                                                     {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"date", 1, 1}, {"uniqueId", 1, -1}},
+		reflect.TypeOf(fhir5.NamingSystemUniqueId{}):                                                             {{"type", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.Narrative{}):                                                                        {{"status", 1, 1}, {"div", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionIntake{}):                                                                  {{"status", 1, 1}, {"subject", 1, 1}, {"consumedItem", 1, -1}},
+		reflect.TypeOf(fhir5.NutritionIntakeConsumedItem{}):                                                      {{"type", 1, 1}, {"nutritionProduct", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionIntakeIngredientLabel{}):                                                   {{"nutrient", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionIntakePerformer{}):                                                         {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionOrder{}):                                                                   {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}, {"dateTime", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionProduct{}):                                                                 {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionProductCharacteristic{}):                                                   {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.NutritionProductIngredient{}):                                                       {{"item", 1, 1}},
+		reflect.TypeOf(fhir5.Observation{}):                                                                      {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.ObservationComponent{}):                                                             {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.ObservationDefinition{}):                                                            {{"status", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.ObservationDefinitionComponent{}):                                                   {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.ObservationTriggeredBy{}):                                                           {{"observation", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.OperationDefinition{}):                                                              {{"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"code", 1, 1}, {"system", 1, 1}, {"type", 1, 1}, {"instance", 1, 1}},
+		reflect.TypeOf(fhir5.OperationDefinitionParameter{}):                                                     {{"name", 1, 1}, {"use", 1, 1}, {"min", 1, 1}, {"max", 1, 1}},
+		reflect.TypeOf(fhir5.OperationDefinitionParameterBinding{}):                                              {{"strength", 1, 1}, {"valueSet", 1, 1}},
+		reflect.TypeOf(fhir5.OperationDefinitionParameterReferencedFrom{}):                                       {{"source", 1, 1}},
+		reflect.TypeOf(fhir5.OperationOutcome{}):                                                                 {{"issue", 1, -1}},
+		reflect.TypeOf(fhir5.OperationOutcomeIssue{}):                                                            {{"severity", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.OrganizationQualification{}):                                                        {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.PackagedProductDefinitionPackagingContainedItem{}):                                  {{"item", 1, 1}},
+		reflect.TypeOf(fhir5.PackagedProductDefinitionPackagingProperty{}):                                       {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.ParametersParameter{}):                                                              {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.PatientCommunication{}):                                                             {{"language", 1, 1}},
+		reflect.TypeOf(fhir5.PatientLink{}):                                                                      {{"other", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.PaymentNotice{}):                                                                    {{"status", 1, 1}, {"created", 1, 1}, {"amount", 1, 1}},
+		reflect.TypeOf(fhir5.PaymentReconciliation{}):                                                            {{"status", 1, 1}, {"created", 1, 1}},
+		reflect.TypeOf(fhir5.Permission{}):                                                                       {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.PersonLink{}):                                                                       {{"target", 1, 1}},
+		reflect.TypeOf(fhir5.PlanDefinition{}):                                                                   {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.PlanDefinitionAction{}):                                                             {{"code", 0, 1}},
+		reflect.TypeOf(fhir5.PlanDefinitionActionCondition{}):                                                    {{"kind", 1, 1}},
+		reflect.TypeOf(fhir5.PlanDefinitionActionRelatedAction{}):                                                {{"targetId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir5.PlanDefinitionGoal{}):                                                               {{"description", 1, 1}},
+		reflect.TypeOf(fhir5.PractitionerCommunication{}):                                                        {{"language", 1, 1}},
+		reflect.TypeOf(fhir5.PractitionerQualification{}):                                                        {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.Procedure{}):                                                                        {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.ProcedureFocalDevice{}):                                                             {{"manipulated", 1, 1}},
+		reflect.TypeOf(fhir5.ProcedurePerformer{}):                                                               {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.Provenance{}):                                                                       {{"target", 1, -1}, {"agent", 1, -1}},
+		reflect.TypeOf(fhir5.ProvenanceAgent{}):                                                                  {{"who", 1, 1}},
+		reflect.TypeOf(fhir5.ProvenanceEntity{}):                                                                 {{"role", 1, 1}, {"what", 1, 1}},
+		reflect.TypeOf(fhir5.Questionnaire{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireItem{}):                                                                {{"linkId", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireItemAnswerOption{}):                                                    {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireItemEnableWhen{}):                                                      {{"question", 1, 1}, {"operator", 1, 1}, {"answer[x]", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireItemInitial{}):                                                         {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireResponse{}):                                                            {{"questionnaire", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireResponseItem{}):                                                        {{"linkId", 1, 1}},
+		reflect.TypeOf(fhir5.QuestionnaireResponseItemAnswer{}):                                                  {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.RelatedArtifact{}):                                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.RelatedPerson{}):                                                                    {{"patient", 1, 1}},
+		reflect.TypeOf(fhir5.RelatedPersonCommunication{}):                                                       {{"language", 1, 1}},
+		reflect.TypeOf(fhir5.RequestOrchestration{}):                                                             {{"status", 1, 1}, {"intent", 1, 1}},
+		reflect.TypeOf(fhir5.RequestOrchestrationActionCondition{}):                                              {{"kind", 1, 1}},
+		reflect.TypeOf(fhir5.RequestOrchestrationActionRelatedAction{}):                                          {{"targetId", 1, 1}, {"relationship", 1, 1}},
+		reflect.TypeOf(fhir5.Requirements{}):                                                                     {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ResearchStudy{}):                                                                    {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ResearchSubject{}):                                                                  {{"status", 1, 1}, {"study", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.RiskAssessment{}):                                                                   {{"status", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.Schedule{}):                                                                         {{"actor", 1, -1}},
+		reflect.TypeOf(fhir5.SearchParameter{}):                                                                  {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"description", 1, 1}, {"code", 1, 1}, {"base", 1, -1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.SearchParameterComponent{}):                                                         {{"definition", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir5.ServiceRequest{}):                                                                   {{"status", 1, 1}, {"intent", 1, 1}, {"subject", 1, 1}},
+		reflect.TypeOf(fhir5.Slot{}):                                                                             {{"schedule", 1, 1}, {"status", 1, 1}, {"start", 1, 1}, {"end", 1, 1}},
+		reflect.TypeOf(fhir5.SpecimenContainer{}):                                                                {{"device", 1, 1}},
+		reflect.TypeOf(fhir5.SpecimenDefinition{}):                                                               {{"identifier", 0, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.SpecimenDefinitionTypeTested{}):                                                     {{"preference", 1, 1}},
+		reflect.TypeOf(fhir5.SpecimenDefinitionTypeTestedContainerAdditive{}):                                    {{"additive[x]", 1, 1}},
+		reflect.TypeOf(fhir5.SpecimenFeature{}):                                                                  {{"type", 1, 1}, {"description", 1, 1}},
+		reflect.TypeOf(fhir5.StructureDefinition{}):                                                              {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"kind", 1, 1}, {"abstract", 1, 1}, {"type", 1, 1}},
+		reflect.TypeOf(fhir5.StructureDefinitionContext{}):                                                       {{"type", 1, 1}, {"expression", 1, 1}},
+		reflect.TypeOf(fhir5.StructureDefinitionDifferential{}):                                                  {{"element", 1, -1}},
+		reflect.TypeOf(fhir5.StructureDefinitionMapping{}):                                                       {{"identity", 1, 1}},
+		reflect.TypeOf(fhir5.StructureDefinitionSnapshot{}):                                                      {{"element", 1, -1}},
+		reflect.TypeOf(fhir5.StructureMap{}):                                                                     {{"url", 1, 1}, {"name", 1, 1}, {"status", 1, 1}, {"group", 1, -1}},
+		reflect.TypeOf(fhir5.StructureMapGroup{}):                                                                {{"name", 1, 1}, {"input", 1, -1}},
+		reflect.TypeOf(fhir5.StructureMapGroupInput{}):                                                           {{"name", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir5.StructureMapGroupRule{}):                                                            {{"source", 1, -1}},
+		reflect.TypeOf(fhir5.StructureMapGroupRuleDependent{}):                                                   {{"name", 1, 1}, {"parameter", 1, -1}},
+		reflect.TypeOf(fhir5.StructureMapGroupRuleSource{}):                                                      {{"context", 1, 1}},
+		reflect.TypeOf(fhir5.StructureMapGroupRuleTargetParameter{}):                                             {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.StructureMapStructure{}):                                                            {{"url", 1, 1}, {"mode", 1, 1}},
+		reflect.TypeOf(fhir5.Subscription{}):                                                                     {{"status", 1, 1}, {"topic", 1, 1}, {"channelType", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionFilterBy{}):                                                             {{"filterParameter", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionParameter{}):                                                            {{"name", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionStatus{}):                                                               {{"type", 1, 1}, {"subscription", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionStatusNotificationEvent{}):                                              {{"eventNumber", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionTopic{}):                                                                {{"url", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionTopicCanFilterBy{}):                                                     {{"filterParameter", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionTopicEventTrigger{}):                                                    {{"event", 1, 1}, {"resource", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionTopicNotificationShape{}):                                               {{"resource", 1, 1}},
+		reflect.TypeOf(fhir5.SubscriptionTopicResourceTrigger{}):                                                 {{"resource", 1, 1}},
+		reflect.TypeOf(fhir5.Substance{}):                                                                        {{"instance", 1, 1}, {"code", 1, 1}},
+		reflect.TypeOf(fhir5.SubstanceDefinitionMolecularWeight{}):                                               {{"amount", 1, 1}},
+		reflect.TypeOf(fhir5.SubstanceDefinitionName{}):                                                          {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.SubstanceDefinitionProperty{}):                                                      {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.SubstanceDefinitionRelationship{}):                                                  {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.SupplyRequest{}):                                                                    {{"item", 1, 1}, {"quantity", 1, 1}},
+		reflect.TypeOf(fhir5.Task{}):                                                                             {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.TaskInput{}):                                                                        {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.TaskOutput{}):                                                                       {{"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.TaskPerformer{}):                                                                    {{"actor", 1, 1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilities{}):                                                          {{"status", 1, 1}, {"date", 1, 1}, {"kind", 1, 1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesCodeSystemVersionFilter{}):                                   {{"code", 1, 1}, {"op", 1, -1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesExpansionParameter{}):                                        {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesImplementation{}):                                            {{"description", 1, 1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesSoftware{}):                                                  {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesTranslation{}):                                               {{"needsMap", 1, 1}},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesValidateCode{}):                                              {{"translations", 1, 1}},
+		reflect.TypeOf(fhir5.TestPlan{}):                                                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.TestReport{}):                                                                       {{"status", 1, 1}, {"testScript", 1, 1}, {"result", 1, 1}},
+		reflect.TypeOf(fhir5.TestReportParticipant{}):                                                            {{"type", 1, 1}, {"uri", 1, 1}},
+		reflect.TypeOf(fhir5.TestReportSetup{}):                                                                  {{"action", 1, -1}},
+		reflect.TypeOf(fhir5.TestReportSetupActionAssert{}):                                                      {{"result", 1, 1}},
+		reflect.TypeOf(fhir5.TestReportSetupActionOperation{}):                                                   {{"result", 1, 1}},
+		reflect.TypeOf(fhir5.TestReportTeardown{}):                                                               {{"action", 1, -1}},
+		reflect.TypeOf(fhir5.TestReportTeardownAction{}):                                                         {{"operation", 1, 1}},
+		reflect.TypeOf(fhir5.TestReportTest{}):                                                                   {{"action", 1, -1}},
+		reflect.TypeOf(fhir5.TestScript{}):                                                                       {{"name", 1, 1}, {"status", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptDestination{}):                                                            {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptFixture{}):                                                                {{"autocreate", 1, 1}, {"autodelete", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptMetadata{}):                                                               {{"capability", 1, -1}},
+		reflect.TypeOf(fhir5.TestScriptMetadataCapability{}):                                                     {{"required", 1, 1}, {"validated", 1, 1}, {"capabilities", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptMetadataLink{}):                                                           {{"url", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptOrigin{}):                                                                 {{"index", 1, 1}, {"profile", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptScope{}):                                                                  {{"artifact", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptSetup{}):                                                                  {{"action", 1, -1}},
+		reflect.TypeOf(fhir5.TestScriptSetupActionAssert{}):                                                      {{"warningOnly", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptSetupActionOperation{}):                                                   {{"encodeRequestUrl", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptSetupActionOperationRequestHeader{}):                                      {{"field", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptTeardown{}):                                                               {{"action", 1, -1}},
+		reflect.TypeOf(fhir5.TestScriptTeardownAction{}):                                                         {{"operation", 1, 1}},
+		reflect.TypeOf(fhir5.TestScriptTest{}):                                                                   {{"action", 1, -1}},
+		reflect.TypeOf(fhir5.TestScriptVariable{}):                                                               {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.Transport{}):                                                                        {{"intent", 1, 1}, {"requestedLocation", 1, 1}, {"currentLocation", 1, 1}},
+		reflect.TypeOf(fhir5.TransportInput{}):                                                                   {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.TransportOutput{}):                                                                  {{"type", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.TriggerDefinition{}):                                                                {{"type", 1, 1}},
+		reflect.TypeOf(fhir5.UsageContext{}):                                                                     {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSet{}):                                                                         {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSetCompose{}):                                                                  {{"include", 1, -1}},
+		reflect.TypeOf(fhir5.ValueSetComposeIncludeConcept{}):                                                    {{"code", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSetComposeIncludeConceptDesignation{}):                                         {{"value", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSetComposeIncludeFilter{}):                                                     {{"property", 1, 1}, {"op", 1, 1}, {"value", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSetExpansion{}):                                                                {{"timestamp", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSetExpansionContainsProperty{}):                                                {{"code", 1, 1}, {"value[x]", 1, 1}},
+		reflect.TypeOf(fhir5.ValueSetExpansionParameter{}):                                                       {{"name", 1, 1}},
+		reflect.TypeOf(fhir5.VerificationResult{}):                                                               {{"status", 1, 1}},
+		reflect.TypeOf(fhir5.VerificationResultValidator{}):                                                      {{"organization", 1, 1}},
+		reflect.TypeOf(fhir5.VisionPrescription{}):                                                               {{"status", 1, 1}, {"created", 1, 1}, {"patient", 1, 1}, {"dateWritten", 1, 1}, {"prescriber", 1, 1}, {"lensSpecification", 1, -1}},
+		reflect.TypeOf(fhir5.VisionPrescriptionLensSpecification{}):                                              {{"product", 1, 1}, {"eye", 1, 1}},
+		reflect.TypeOf(fhir5.VisionPrescriptionLensSpecificationPrism{}):                                         {{"amount", 1, 1}, {"base", 1, 1}},
+	},
+
+	// enumValues lists the allowed values of the code enum types
+	enumValues: map[reflect.Type][]string{
+		reflect.TypeOf(common.AddressType("")):                                                 {"postal", "physical", "both"},
+		reflect.TypeOf(common.AddressUse("")):                                                  {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(common.ContactPointSystem("")):                                          {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(common.ContactPointUse("")):                                             {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(common.ContributorType("")):                                             {"author", "editor", "reviewer", "endorser"},
+		reflect.TypeOf(common.DataRequirementSortDirection("")):                                {"ascending", "descending"},
+		reflect.TypeOf(common.HumanNameUse("")):                                                {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(common.IdentifierUse("")):                                               {"usual", "official", "temp", "secondary", "old"},
+		reflect.TypeOf(common.ParameterUse("")):                                                {"in", "out"},
+		reflect.TypeOf(common.QuantityComparator("")):                                          {"<", "<=", ">=", ">", "ad"},
+		reflect.TypeOf(common.RelatedArtifactType("")):                                         {"documentation", "justification", "citation", "predecessor", "successor", "derived-from", "depends-on", "composed-of", "part-of", "amends", "amended-with", "appends", "appended-with", "cites", "cited-by", "comments-on", "comment-in", "contains", "contained-in", "corrects", "correction-in", "replaces", "replaced-with", "retracts", "retracted-by", "signs", "similar-to", "supports", "supported-with", "transforms", "transformed-into", "transformed-with", "documents", "specification-of", "created-with", "cite-as"},
+		reflect.TypeOf(common.TriggerDefinitionType("")):                                       {"named-event", "periodic", "data-changed", "data-added", "data-modified", "data-removed", "data-accessed", "data-access-ended"},
+		reflect.TypeOf(fhir5.AccountStatus("")):                                                {"active", "inactive", "entered-in-error", "on-hold", "unknown"},
+		reflect.TypeOf(fhir5.ActorType("")):                                                    {"person", "system"},
+		reflect.TypeOf(fhir5.AddressType("")):                                                  {"postal", "physical", "both"},
+		reflect.TypeOf(fhir5.AddressUse("")):                                                   {"home", "work", "temp", "old", "billing"},
+		reflect.TypeOf(fhir5.AdverseEventActuality("")):                                        {"actual", "potential"},
+		reflect.TypeOf(fhir5.AdverseEventStatus("")):                                           {"in-progress", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.AllergyIntoleranceCategory("")):                                   {"food", "medication", "environment", "biologic"},
+		reflect.TypeOf(fhir5.AllergyIntoleranceCriticality("")):                                {"low", "high", "unable-to-assess"},
+		reflect.TypeOf(fhir5.AllergyIntoleranceReactionSeverity("")):                           {"mild", "moderate", "severe"},
+		reflect.TypeOf(fhir5.AppointmentParticipantStatus("")):                                 {"accepted", "declined", "tentative", "needs-action", "entered-in-error"},
+		reflect.TypeOf(fhir5.AppointmentStatus("")):                                            {"proposed", "pending", "booked", "arrived", "fulfilled", "cancelled", "noshow", "entered-in-error", "checked-in", "waitlist"},
+		reflect.TypeOf(fhir5.ArtifactAssessmentDisposition("")):                                {"unresolved", "not-persuasive", "persuasive", "persuasive-with-modification", "not-persuasive-with-modification"},
+		reflect.TypeOf(fhir5.ArtifactAssessmentInformationType("")):                            {"comment", "classifier", "rating", "container", "response", "change-request"},
+		reflect.TypeOf(fhir5.ArtifactAssessmentWorkflowStatus("")):                             {"submitted", "triaged", "waiting-for-input", "resolved-no-change", "resolved-change-required", "deferred", "duplicate", "applied", "published", "entered-in-error"},
+		reflect.TypeOf(fhir5.AuditEventSeverity("")):                                           {"emergency", "alert", "critical", "error", "warning", "notice", "informational", "debug"},
+		reflect.TypeOf(fhir5.BiologicallyDerivedProductDispenseStatus("")):                     {"preparation", "in-progress", "allocated", "issued", "unfulfilled", "returned", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.BundleEntryRequestMethod("")):                                     {"GET", "HEAD", "POST", "PUT", "DELETE", "PATCH"},
+		reflect.TypeOf(fhir5.BundleEntrySearchMode("")):                                        {"match", "include", "outcome"},
+		reflect.TypeOf(fhir5.BundleType("")):                                                   {"document", "message", "transaction", "transaction-response", "batch", "batch-response", "history", "searchset", "collection", "subscription-notification"},
+		reflect.TypeOf(fhir5.CapabilityStatementDocumentMode("")):                              {"producer", "consumer"},
+		reflect.TypeOf(fhir5.CapabilityStatementKind("")):                                      {"instance", "capability", "requirements"},
+		reflect.TypeOf(fhir5.CapabilityStatementMessagingMode("")):                             {"sender", "receiver"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestInteractionCode("")):                       {"transaction", "batch", "search-system", "history-system"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestMode("")):                                  {"client", "server"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceConditionalDelete("")):             {"not-supported", "single", "multiple"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceConditionalRead("")):               {"not-supported", "modified-since", "not-match", "full-support"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceInteractionCode("")):               {"read", "vread", "update", "patch", "delete", "history-instance", "history-type", "create", "search-type"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceReferencePolicy("")):               {"literal", "logical", "resolves", "enforced", "local"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceSearchParamType("")):               {"number", "date", "string", "token", "reference", "composite", "quantity", "uri", "special"},
+		reflect.TypeOf(fhir5.CapabilityStatementRestResourceVersioning("")):                    {"no-version", "versioned", "versioned-update"},
+		reflect.TypeOf(fhir5.CapabilityStatementStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.CarePlanIntent("")):                                               {"proposal", "plan", "order", "option", "directive"},
+		reflect.TypeOf(fhir5.CarePlanStatus("")):                                               {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.CareTeamStatus("")):                                               {"proposed", "active", "suspended", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir5.ChargeItemDefinitionStatus("")):                                   {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.ChargeItemStatus("")):                                             {"planned", "billable", "not-billable", "aborted", "billed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.CitationStatus("")):                                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.ClaimResponseOutcome("")):                                         {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir5.ClaimResponseProcessNoteType("")):                                 {"display", "print", "printoper"},
+		reflect.TypeOf(fhir5.ClaimResponseStatus("")):                                          {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.ClaimResponseUse("")):                                             {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir5.ClaimStatus("")):                                                  {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.ClaimUse("")):                                                     {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir5.ClinicalImpressionStatus("")):                                     {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.ClinicalUseDefinitionType("")):                                    {"indication", "contraindication", "interaction", "undesirable-effect", "warning"},
+		reflect.TypeOf(fhir5.CodeSystemContent("")):                                            {"not-present", "example", "fragment", "complete", "supplement"},
+		reflect.TypeOf(fhir5.CodeSystemHierarchyMeaning("")):                                   {"grouped-by", "is-a", "part-of", "classified-with"},
+		reflect.TypeOf(fhir5.CodeSystemPropertyType("")):                                       {"code", "Coding", "string", "integer", "boolean", "dateTime", "decimal"},
+		reflect.TypeOf(fhir5.CommunicationPriority("")):                                        {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.CommunicationRequestIntent("")):                                   {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.CommunicationRequestPriority("")):                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.CommunicationRequestStatus("")):                                   {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.CommunicationStatus("")):                                          {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.CompositionStatus("")):                                            {"registered", "partial", "preliminary", "final", "amended", "corrected", "appended", "cancelled", "entered-in-error", "deprecated", "unknown"},
+		reflect.TypeOf(fhir5.ConceptMapRelationship("")):                                       {"related-to", "equivalent", "source-is-narrower-than-target", "source-is-broader-than-target", "not-related-to"},
+		reflect.TypeOf(fhir5.ConceptMapUnmappedMode("")):                                       {"use-source-code", "fixed", "other-map"},
+		reflect.TypeOf(fhir5.ConditionDefinitionPreconditionType("")):                          {"sensitive", "specific"},
+		reflect.TypeOf(fhir5.ConditionDefinitionQuestionnairePurpose("")):                      {"preadmit", "diff-diagnosis", "outcome"},
+		reflect.TypeOf(fhir5.ConsentDecision("")):                                              {"deny", "permit"},
+		reflect.TypeOf(fhir5.ConsentProvisionDataMeaning("")):                                  {"instance", "related", "dependents", "authoredby"},
+		reflect.TypeOf(fhir5.ConsentStatus("")):                                                {"draft", "active", "inactive", "not-done", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.ContactPointSystem("")):                                           {"phone", "fax", "email", "pager", "url", "sms", "other"},
+		reflect.TypeOf(fhir5.ContactPointUse("")):                                              {"home", "work", "temp", "old", "mobile"},
+		reflect.TypeOf(fhir5.ContractContentDefinitionPublicationStatus("")):                   {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir5.ContractStatus("")):                                               {"amended", "appended", "cancelled", "disputed", "entered-in-error", "executable", "executed", "negotiable", "offered", "policy", "rejected", "renewed", "revoked", "resolved", "terminated"},
+		reflect.TypeOf(fhir5.CoverageEligibilityRequestPurpose("")):                            {"auth-requirements", "benefits", "discovery", "validation"},
+		reflect.TypeOf(fhir5.CoverageEligibilityRequestStatus("")):                             {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponseOutcome("")):                           {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponsePurpose("")):                           {"auth-requirements", "benefits", "discovery", "validation"},
+		reflect.TypeOf(fhir5.CoverageEligibilityResponseStatus("")):                            {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.CoverageKind("")):                                                 {"insurance", "self-pay", "other"},
+		reflect.TypeOf(fhir5.CoverageStatus("")):                                               {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.DaysOfWeek("")):                                                   {"mon", "tue", "wed", "thu", "fri", "sat", "sun"},
+		reflect.TypeOf(fhir5.DetectedIssueSeverity("")):                                        {"high", "moderate", "low"},
+		reflect.TypeOf(fhir5.DetectedIssueStatus("")):                                          {"preliminary", "final", "entered-in-error", "mitigated"},
+		reflect.TypeOf(fhir5.DeviceDefinitionCorrectiveActionScope("")):                        {"model", "lot-numbers", "serial-numbers"},
+		reflect.TypeOf(fhir5.DeviceDefinitionDeviceNameType("")):                               {"registered-name", "user-friendly-name", "patient-reported-name"},
+		reflect.TypeOf(fhir5.DeviceDefinitionProductionIdentifierInUDI("")):                    {"lot-number", "manufactured-date", "serial-number", "expiration-date", "biological-source", "software-version"},
+		reflect.TypeOf(fhir5.DeviceDefinitionRegulatoryIdentifierType("")):                     {"basic", "master", "license"},
+		reflect.TypeOf(fhir5.DeviceDispenseStatus("")):                                         {"preparation", "in-progress", "cancelled", "on-hold", "completed", "entered-in-error", "stopped", "declined", "unknown"},
+		reflect.TypeOf(fhir5.DeviceMetricCalibrationState("")):                                 {"not-calibrated", "calibration-required", "calibrated", "unspecified"},
+		reflect.TypeOf(fhir5.DeviceMetricCalibrationType("")):                                  {"unspecified", "offset", "gain", "two-point"},
+		reflect.TypeOf(fhir5.DeviceMetricCategory("")):                                         {"measurement", "setting", "calculation", "unspecified"},
+		reflect.TypeOf(fhir5.DeviceMetricOperationalStatus("")):                                {"on", "off", "standby", "entered-in-error"},
+		reflect.TypeOf(fhir5.DeviceNameType("")):                                               {"registered-name", "user-friendly-name", "patient-reported-name"},
+		reflect.TypeOf(fhir5.DeviceRequestIntent("")):                                          {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.DeviceRequestPriority("")):                                        {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.DeviceRequestStatus("")):                                          {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.DeviceStatus("")):                                                 {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir5.DeviceUdiCarrierEntryType("")):                                    {"barcode", "rfid", "manual", "card", "self-reported", "electronic-transmission", "unknown"},
+		reflect.TypeOf(fhir5.DeviceUsageStatus("")):                                            {"active", "completed", "not-done", "entered-in-error", "intended", "stopped", "on-hold"},
+		reflect.TypeOf(fhir5.DiagnosticReportStatus("")):                                       {"registered", "partial", "preliminary", "modified", "final", "amended", "corrected", "appended", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.DocumentReferenceStatus("")):                                      {"current", "superseded", "entered-in-error"},
+		reflect.TypeOf(fhir5.ElementDefinitionBindingAdditionalPurpose("")):                    {"maximum", "minimum", "required", "extensible", "candidate", "current", "preferred", "ui", "starter", "component"},
+		reflect.TypeOf(fhir5.ElementDefinitionBindingStrength("")):                             {"required", "extensible", "preferred", "example"},
+		reflect.TypeOf(fhir5.ElementDefinitionConstraintSeverity("")):                          {"error", "warning"},
+		reflect.TypeOf(fhir5.ElementDefinitionRepresentation("")):                              {"xmlAttr", "xmlText", "typeAttr", "cdaText", "xhtml"},
+		reflect.TypeOf(fhir5.ElementDefinitionSlicingDiscriminatorType("")):                    {"value", "exists", "pattern", "type", "profile", "position"},
+		reflect.TypeOf(fhir5.ElementDefinitionSlicingRules("")):                                {"closed", "open", "openAtEnd"},
+		reflect.TypeOf(fhir5.ElementDefinitionTypeAggregation("")):                             {"contained", "referenced", "bundled"},
+		reflect.TypeOf(fhir5.ElementDefinitionTypeVersioning("")):                              {"either", "independent", "specific"},
+		reflect.TypeOf(fhir5.EncounterLocationStatus("")):                                      {"planned", "active", "reserved", "completed"},
+		reflect.TypeOf(fhir5.EncounterStatus("")):                                              {"planned", "in-progress", "on-hold", "discharged", "completed", "cancelled", "discontinued", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.EndpointStatus("")):                                               {"active", "suspended", "error", "off", "entered-in-error"},
+		reflect.TypeOf(fhir5.EnrollmentRequestStatus("")):                                      {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.EnrollmentResponseOutcome("")):                                    {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir5.EnrollmentResponseStatus("")):                                     {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.EpisodeOfCareStatus("")):                                          {"planned", "waitlist", "active", "onhold", "finished", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir5.EventDefinitionStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.EvidenceReportRelatesToCode("")):                                  {"replaces", "amends", "appends", "transforms", "replacedWith", "amendedWith", "appendedWith", "transformedWith"},
+		reflect.TypeOf(fhir5.EvidenceReportSectionMode("")):                                    {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir5.EvidenceVariableHandling("")):                                     {"continuous", "dichotomous", "ordinal", "polychotomous"},
+		reflect.TypeOf(fhir5.ExampleScenarioActorType("")):                                     {"person", "system"},
+		reflect.TypeOf(fhir5.ExplanationOfBenefitOutcome("")):                                  {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir5.ExplanationOfBenefitStatus("")):                                   {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.ExplanationOfBenefitUse("")):                                      {"claim", "preauthorization", "predetermination"},
+		reflect.TypeOf(fhir5.FamilyMemberHistoryStatus("")):                                    {"partial", "completed", "entered-in-error", "health-unknown"},
+		reflect.TypeOf(fhir5.FlagStatus("")):                                                   {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir5.FormularyItemStatus("")):                                          {"active", "entered-in-error", "inactive"},
+		reflect.TypeOf(fhir5.GenomicStudyStatus("")):                                           {"registered", "available", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.GoalLifecycleStatus("")):                                          {"proposed", "planned", "accepted", "active", "on-hold", "completed", "cancelled", "entered-in-error", "rejected"},
+		reflect.TypeOf(fhir5.GraphDefinitionLinkCompartmentRule("")):                           {"identical", "matching", "different", "custom"},
+		reflect.TypeOf(fhir5.GraphDefinitionStatus("")):                                        {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.GroupMembership("")):                                              {"definitional", "enumerated"},
+		reflect.TypeOf(fhir5.GroupType("")):                                                    {"person", "animal", "practitioner", "device", "careteam", "healthcareservice", "location", "organization", "relatedperson", "specimen"},
+		reflect.TypeOf(fhir5.GuidanceResponseStatus("")):                                       {"success", "data-requested", "data-required", "in-progress", "failure", "entered-in-error"},
+		reflect.TypeOf(fhir5.HumanNameUse("")):                                                 {"usual", "official", "temp", "nickname", "anonymous", "old", "maiden"},
+		reflect.TypeOf(fhir5.ImagingSelectionInstanceImageRegion2DType("")):                    {"point", "polyline", "interpolated", "circle", "ellipse"},
+		reflect.TypeOf(fhir5.ImagingSelectionInstanceImageRegion3DType("")):                    {"point", "multipoint", "polyline", "polygon", "ellipse", "ellipsoid"},
+		reflect.TypeOf(fhir5.ImagingSelectionStatus("")):                                       {"available", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.ImagingStudyStatus("")):                                           {"registered", "available", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.ImmunizationEvaluationStatus("")):                                 {"completed", "entered-in-error"},
+		reflect.TypeOf(fhir5.ImmunizationStatus("")):                                           {"completed", "entered-in-error", "not-done"},
+		reflect.TypeOf(fhir5.ImplementationGuideDefinitionPageGeneration("")):                  {"html", "markdown", "xml", "generated"},
+		reflect.TypeOf(fhir5.ImplementationGuideStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.IngredientStatus("")):                                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.InsurancePlanStatus("")):                                          {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.InventoryItemStatus("")):                                          {"active", "inactive", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.InventoryReportCountType("")):                                     {"snapshot", "difference"},
+		reflect.TypeOf(fhir5.InventoryReportStatus("")):                                        {"draft", "requested", "active", "entered-in-error"},
+		reflect.TypeOf(fhir5.InvoiceStatus("")):                                                {"draft", "issued", "balanced", "cancelled", "entered-in-error"},
+		reflect.TypeOf(fhir5.LibraryStatus("")):                                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.LinkageItemType("")):                                              {"source", "alternate", "historical"},
+		reflect.TypeOf(fhir5.ListMode("")):                                                     {"working", "snapshot", "changes"},
+		reflect.TypeOf(fhir5.ListStatus("")):                                                   {"current", "retired", "entered-in-error"},
+		reflect.TypeOf(fhir5.LocationMode("")):                                                 {"instance", "kind"},
+		reflect.TypeOf(fhir5.LocationStatus("")):                                               {"active", "suspended", "inactive"},
+		reflect.TypeOf(fhir5.ManufacturedItemDefinitionStatus("")):                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.MeasureReportDataUpdateType("")):                                  {"incremental", "snapshot"},
+		reflect.TypeOf(fhir5.MeasureReportStatus("")):                                          {"complete", "pending", "error"},
+		reflect.TypeOf(fhir5.MeasureReportType("")):                                            {"individual", "subject-list", "summary", "data-exchange"},
+		reflect.TypeOf(fhir5.MeasureStatus("")):                                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.MedicationAdministrationStatus("")):                               {"in-progress", "not-done", "on-hold", "completed", "entered-in-error", "stopped", "unknown"},
+		reflect.TypeOf(fhir5.MedicationDispenseStatus("")):                                     {"preparation", "in-progress", "cancelled", "on-hold", "completed", "entered-in-error", "stopped", "declined", "unknown"},
+		reflect.TypeOf(fhir5.MedicationKnowledgeStatus("")):                                    {"active", "entered-in-error", "inactive"},
+		reflect.TypeOf(fhir5.MedicationRequestIntent("")):                                      {"proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.MedicationRequestPriority("")):                                    {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.MedicationRequestStatus("")):                                      {"active", "on-hold", "ended", "stopped", "completed", "cancelled", "entered-in-error", "draft", "unknown"},
+		reflect.TypeOf(fhir5.MedicationStatementStatus("")):                                    {"recorded", "entered-in-error", "draft"},
+		reflect.TypeOf(fhir5.MedicationStatus("")):                                             {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir5.MessageDefinitionStatus("")):                                      {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.MessageHeaderResponseCode("")):                                    {"ok", "transient-error", "fatal-error"},
+		reflect.TypeOf(fhir5.MonetaryComponentType("")):                                        {"base", "surcharge", "deduction", "discount", "tax", "informational"},
+		reflect.TypeOf(fhir5.NamingSystemKind("")):                                             {"codesystem", "identifier", "root"},
+		reflect.TypeOf(fhir5.NamingSystemStatus("")):                                           {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.NamingSystemUniqueIdType("")):                                     {"oid", "uuid", "uri", "iri-stem", "v2csmnemonic", "other"},
+		reflect.TypeOf(fhir5.NarrativeStatus("")):                                              {"generated", "extensions", "additional", "empty"},
+		reflect.TypeOf(fhir5.NutritionIntakeStatus("")):                                        {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.NutritionOrderIntent("")):                                         {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.NutritionOrderPriority("")):                                       {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.NutritionOrderStatus("")):                                         {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.NutritionProductStatus("")):                                       {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir5.ObservationDefinitionStatus("")):                                  {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.ObservationStatus("")):                                            {"registered", "preliminary", "final", "amended", "corrected", "cancelled", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.ObservationTriggeredByType("")):                                   {"reflex", "repeat", "re-run"},
+		reflect.TypeOf(fhir5.OperationDefinitionKind("")):                                      {"operation", "query"},
+		reflect.TypeOf(fhir5.OperationDefinitionStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.OperationOutcomeIssueSeverity("")):                                {"fatal", "error", "warning", "information", "success"},
+		reflect.TypeOf(fhir5.ParticipantType("")):                                              {"careteam", "device", "group", "healthcareservice", "location", "organization", "patient", "practitioner", "practitionerrole", "relatedperson"},
+		reflect.TypeOf(fhir5.PatientGender("")):                                                {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir5.PatientLinkType("")):                                              {"replaced-by", "replaces", "refer", "seealso"},
+		reflect.TypeOf(fhir5.PaymentNoticeStatus("")):                                          {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.PaymentReconciliationOutcome("")):                                 {"queued", "complete", "error", "partial"},
+		reflect.TypeOf(fhir5.PaymentReconciliationProcessNoteType("")):                         {"display", "print", "printoper"},
+		reflect.TypeOf(fhir5.PaymentReconciliationStatus("")):                                  {"active", "cancelled", "draft", "entered-in-error"},
+		reflect.TypeOf(fhir5.PermissionStatus("")):                                             {"active", "entered-in-error", "draft", "rejected"},
+		reflect.TypeOf(fhir5.PersonGender("")):                                                 {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir5.PersonLinkAssurance("")):                                          {"level1", "level2", "level3", "level4"},
+		reflect.TypeOf(fhir5.PractitionerGender("")):                                           {"male", "female", "other", "unknown"},
+		reflect.TypeOf(fhir5.ProcedureStatus("")):                                              {"preparation", "in-progress", "not-done", "on-hold", "stopped", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.PublicationStatus("")):                                            {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.QuestionnaireResponseStatus("")):                                  {"in-progress", "completed", "amended", "entered-in-error", "stopped"},
+		reflect.TypeOf(fhir5.RequestIntent("")):                                                {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.RequestOrchestrationIntent("")):                                   {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.RequestOrchestrationPriority("")):                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.RequestOrchestrationStatus("")):                                   {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.RequestPriority("")):                                              {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.RequestStatus("")):                                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.ServiceRequestIntent("")):                                         {"proposal", "plan", "directive", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.ServiceRequestPriority("")):                                       {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.ServiceRequestStatus("")):                                         {"draft", "active", "on-hold", "revoked", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.StructureDefinitionContextType("")):                               {"fhirpath", "element", "extension"},
+		reflect.TypeOf(fhir5.StructureDefinitionDerivation("")):                                {"specialization", "constraint"},
+		reflect.TypeOf(fhir5.StructureDefinitionKind("")):                                      {"primitive-type", "complex-type", "resource", "logical"},
+		reflect.TypeOf(fhir5.StructureDefinitionStatus("")):                                    {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.SubscriptionContent("")):                                          {"empty", "id-only", "full-resource"},
+		reflect.TypeOf(fhir5.SubscriptionFilterByComparator("")):                               {"eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap"},
+		reflect.TypeOf(fhir5.SubscriptionFilterByModifier("")):                                 {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type", "identifier", "of-type", "code-text", "text-advanced", "iterate"},
+		reflect.TypeOf(fhir5.SubscriptionStatusCode("")):                                       {"requested", "active", "error", "off", "entered-in-error"},
+		reflect.TypeOf(fhir5.SubscriptionStatusStatus("")):                                     {"requested", "active", "error", "off", "entered-in-error"},
+		reflect.TypeOf(fhir5.SubscriptionStatusType("")):                                       {"handshake", "heartbeat", "event-notification", "query-status", "query-event"},
+		reflect.TypeOf(fhir5.SubscriptionTopicCanFilterByComparator("")):                       {"eq", "ne", "gt", "lt", "ge", "le", "sa", "eb", "ap"},
+		reflect.TypeOf(fhir5.SubscriptionTopicCanFilterByModifier("")):                         {"missing", "exact", "contains", "not", "text", "in", "not-in", "below", "above", "type", "identifier", "of-type", "code-text", "text-advanced", "iterate"},
+		reflect.TypeOf(fhir5.SubscriptionTopicResourceTriggerQueryCriteriaResultForCreate("")): {"test-passes", "test-fails"},
+		reflect.TypeOf(fhir5.SubscriptionTopicResourceTriggerQueryCriteriaResultForDelete("")): {"test-passes", "test-fails"},
+		reflect.TypeOf(fhir5.SubscriptionTopicResourceTriggerSupportedInteraction("")):         {"create", "update", "delete"},
+		reflect.TypeOf(fhir5.SubscriptionTopicStatus("")):                                      {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.SubstanceStatus("")):                                              {"active", "inactive", "entered-in-error"},
+		reflect.TypeOf(fhir5.SupplyDeliveryStatus("")):                                         {"in-progress", "completed", "abandoned", "entered-in-error"},
+		reflect.TypeOf(fhir5.SupplyRequestPriority("")):                                        {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.SupplyRequestStatus("")):                                          {"draft", "active", "suspended", "cancelled", "completed", "entered-in-error", "unknown"},
+		reflect.TypeOf(fhir5.TaskPriority("")):                                                 {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.TaskStatus("")):                                                   {"draft", "requested", "received", "accepted", "rejected", "ready", "cancelled", "in-progress", "on-hold", "failed", "completed", "entered-in-error"},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesCodeSearch("")):                            {"in-compose", "in-expansion", "in-compose-or-expansion"},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesKind("")):                                  {"instance", "capability", "requirements"},
+		reflect.TypeOf(fhir5.TerminologyCapabilitiesStatus("")):                                {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.TestPlanStatus("")):                                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.TestReportActionResult("")):                                       {"pass", "skip", "fail", "warning", "error"},
+		reflect.TypeOf(fhir5.TestReportParticipantType("")):                                    {"test-engine", "client", "server"},
+		reflect.TypeOf(fhir5.TestReportResult("")):                                             {"pass", "fail", "pending"},
+		reflect.TypeOf(fhir5.TestReportStatus("")):                                             {"completed", "in-progress", "waiting", "stopped", "entered-in-error"},
+		reflect.TypeOf(fhir5.TestScriptSetupActionAssertDirection("")):                         {"response", "request"},
+		reflect.TypeOf(fhir5.TestScriptSetupActionAssertOperator("")):                          {"equals", "notEquals", "in", "notIn", "greaterThan", "lessThan", "empty", "notEmpty", "contains", "notContains", "eval", "manualEval"},
+		reflect.TypeOf(fhir5.TestScriptSetupActionAssertResponse("")):                          {"continue", "switchingProtocols", "okay", "created", "accepted", "nonAuthoritativeInformation", "noContent", "resetContent", "partialContent", "multipleChoices", "movedPermanently", "found", "seeOther", "notModified", "useProxy", "temporaryRedirect", "permanentRedirect", "badRequest", "unauthorized", "paymentRequired", "forbidden", "notFound", "methodNotAllowed", "notAcceptable", "proxyAuthenticationRequired", "requestTimeout", "conflict", "gone", "lengthRequired", "preconditionFailed", "contentTooLarge", "uriTooLong", "unsupportedMediaType", "rangeNotSatisfiable", "expectationFailed", "misdirectedRequest", "unprocessableContent", "upgradeRequired", "internalServerError", "notImplemented", "badGateway", "serviceUnavailable", "gatewayTimeout", "httpVersionNotSupported"},
+		reflect.TypeOf(fhir5.TestScriptStatus("")):                                             {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.TransportIntent("")):                                              {"unknown", "proposal", "plan", "order", "original-order", "reflex-order", "filler-order", "instance-order", "option"},
+		reflect.TypeOf(fhir5.TransportPriority("")):                                            {"routine", "urgent", "asap", "stat"},
+		reflect.TypeOf(fhir5.TransportStatus("")):                                              {"in-progress", "completed", "abandoned", "cancelled", "planned", "entered-in-error"},
+		reflect.TypeOf(fhir5.ValueSetComposeIncludeFilterOp("")):                               {"=", "is-a", "descendent-of", "is-not-a", "regex", "in", "not-in", "generalizes", "child-of", "descendent-leaf", "exists"},
+		reflect.TypeOf(fhir5.ValueSetStatus("")):                                               {"draft", "active", "retired", "unknown"},
+		reflect.TypeOf(fhir5.VerificationResultStatus("")):                                     {"attested", "validated", "in-process", "req-revalid", "val-fail", "reval-fail", "entered-in-error"},
+		reflect.TypeOf(fhir5.VisionPrescriptionLensSpecificationEye("")):                       {"right", "left"},
+		reflect.TypeOf(fhir5.VisionPrescriptionLensSpecificationPrismBase("")):                 {"up", "down", "in", "out"},
+		reflect.TypeOf(fhir5.VisionPrescriptionStatus("")):                                     {"active", "cancelled", "draft", "entered-in-error"},
+	},
+}
@@ -0,0 +1,292 @@
+// Package validate checks FHIR resources against the structure of the base
+// specification of their version: the cardinality of elements, required
+// elements, the values of code enums and the exclusivity of choice types.
+package validate
+
+//go:generate go run ../../cmd/resourcegen -dir ../fhir2 -profiles ../fhir2/testdata/fhir2-definitions -rules rules_fhir2_gen.go
+//go:generate go run ../../cmd/resourcegen -dir ../fhir3 -profiles ../fhir3/testdata/fhir3-definitions -rules rules_fhir3_gen.go
+//go:generate go run ../../cmd/resourcegen -dir ../fhir4 -profiles ../fhir4/testdata/fhir4-definitions -rules rules_fhir4_gen.go
+//go:generate go run ../../cmd/resourcegen -dir ../fhir4b -profiles ../fhir4/testdata/fhir4-definitions,../fhir5/testdata/fhir5-json -dts ../../js/r4b.d.ts -rules rules_fhir4b_gen.go
+//go:generate go run ../../cmd/resourcegen -dir ../fhir5 -profiles ../fhir5/testdata/fhir5-json -rules rules_fhir5_gen.go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+// Issue type codes used by the validator
+const (
+	IssueTypeRequired    = "required"
+	IssueTypeStructure   = "structure"
+	IssueTypeCodeInvalid = "code-invalid"
+)
+
+// ErrUnsupportedVersion is returned for resources of a FHIR version the
+// validator has no rules for
+var ErrUnsupportedVersion = errors.New("validate: unsupported FHIR version")
+
+// cardinality is the allowed number of values of an element
+type cardinality struct {
+	name     string
+	min, max int
+}
+
+// ruleSet holds the validation rules of one version package
+type ruleSet struct {
+	cardinalities map[reflect.Type][]cardinality
+	enumValues    map[reflect.Type][]string
+}
+
+// versionRules maps the version packages to their rules. R4B has no definitions
+// of its own yet, its rules come from those of R4 and R5 and take the required
+// elements from js/r4b.d.ts, see TODO.md
+var versionRules = map[string]*ruleSet{
+	"fhir2":  &fhir2Rules,
+	"fhir3":  &fhir3Rules,
+	"fhir4":  &fhir4Rules,
+	"fhir4b": &fhir4bRules,
+	"fhir5":  &fhir5Rules,
+}
+
+var resourceType = reflect.TypeOf((*common.Resource)(nil)).Elem()
+
+// resourceVersion returns the name of the version package declaring the type of r
+func resourceVersion(r common.Resource) (string, error) {
+	val := reflect.ValueOf(r)
+	if r == nil || val.Kind() == reflect.Pointer && val.IsNil() {
+		return "", errors.New("validate: resource is nil")
+	}
+	pkg := reflect.Indirect(val).Type().PkgPath()
+	return pkg[strings.LastIndexByte(pkg, '/')+1:], nil
+}
+
+// Resource validates r and every resource it contains, e.g. contained
+// resources or bundle entries, against the rules of the version of r. Each
+// violation is reported as an issue whose expression locates the element, e.g.
+// "Observation.component[0].code". A resource without violations yields a
+// single informational issue. Resources of versions without rules, such as
+// a *common.RawResource, return ErrUnsupportedVersion.
+func Resource(r common.Resource) (*fhir5.OperationOutcome, error) {
+	version, err := resourceVersion(r)
+	if err != nil {
+		return nil, err
+	}
+	rules, ok := versionRules[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedVersion, version)
+	}
+	v := &validator{rules: rules}
+	v.value(reflect.ValueOf(r), r.GetResourceType())
+	if len(v.issues) == 0 {
+		v.issues = append(v.issues, fhir5.OperationOutcomeIssue{
+			Severity:    fhir5.OperationOutcomeIssueSeverityInformation,
+			Code:        "informational",
+			Diagnostics: fhir5.StringPtr("All OK"),
+		})
+	}
+	return &fhir5.OperationOutcome{ResourceType: "OperationOutcome", Issue: v.issues}, nil
+}
+
+// HasErrors reports whether the outcome contains issues of severity error or fatal
+func HasErrors(outcome *fhir5.OperationOutcome) bool {
+	for _, issue := range outcome.Issue {
+		if issue.Severity == fhir5.OperationOutcomeIssueSeverityError || issue.Severity == fhir5.OperationOutcomeIssueSeverityFatal {
+			return true
+		}
+	}
+	return false
+}
+
+// validator collects the issues found while walking a resource
+type validator struct {
+	rules  *ruleSet
+	issues []fhir5.OperationOutcomeIssue
+}
+
+func (v *validator) addIssue(code, path, format string, args ...interface{}) {
+	v.issues = append(v.issues, fhir5.OperationOutcomeIssue{
+		Severity:    fhir5.OperationOutcomeIssueSeverityError,
+		Code:        code,
+		Diagnostics: fhir5.StringPtr(fmt.Sprintf(format, args...)),
+		Expression:  []string{path},
+	})
+}
+
+// value validates a field value, path is its FHIRPath expression
+func (v *validator) value(val reflect.Value, path string) {
+	switch val.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !val.IsNil() {
+			v.value(val.Elem(), path)
+		}
+	case reflect.Slice:
+		for i := 0; i < val.Len(); i++ {
+			v.value(val.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+	case reflect.String:
+		v.enum(val, path)
+	case reflect.Struct:
+		if !val.CanAddr() {
+			addressable := reflect.New(val.Type()).Elem()
+			addressable.Set(val)
+			val = addressable
+		}
+		v.structure(val, path)
+	}
+}
+
+// enum checks that a code value is one of the values of its enum type
+func (v *validator) enum(val reflect.Value, path string) {
+	values, ok := v.rules.enumValues[val.Type()]
+	if !ok || val.String() == "" {
+		return
+	}
+	for _, allowed := range values {
+		if val.String() == allowed {
+			return
+		}
+	}
+	v.addIssue(IssueTypeCodeInvalid, path, "the value %q is not one of %s", val.String(), strings.Join(values, ", "))
+}
+
+// structure checks the cardinality and choice types of a struct and validates its fields
+func (v *validator) structure(val reflect.Value, path string) {
+	fields := map[string]reflect.Value{}
+	var names []string
+	collectFields(val, fields, &names)
+
+	for _, c := range v.rules.cardinalities[val.Type()] {
+		count := 0
+		if base, ok := strings.CutSuffix(c.name, "[x]"); ok {
+			for _, name := range names {
+				if typeName, ok := strings.CutPrefix(name, base); ok && isChoiceType(typeName) && (present(fields[name]) > 0 || present(fields["_"+name]) > 0) {
+					count = 1
+				}
+			}
+		} else {
+			count = max(present(fields[c.name]), present(fields["_"+c.name]))
+		}
+		if count < c.min {
+			v.addIssue(IssueTypeRequired, path+"."+strings.TrimSuffix(c.name, "[x]"), "minimum required = %d, but only found %d", c.min, count)
+		}
+		if c.max >= 0 && count > c.max {
+			v.addIssue(IssueTypeStructure, path+"."+strings.TrimSuffix(c.name, "[x]"), "maximum allowed = %d, but found %d", c.max, count)
+		}
+	}
+
+	if validator, ok := val.Addr().Interface().(common.ChoiceValidator); ok {
+		v.choices(validator.ValidateChoices(), path)
+	}
+
+	for _, name := range names {
+		field := fields[name]
+		fieldPath := path + "." + name
+		if field.Type().Implements(resourceType) || field.Kind() == reflect.Slice && field.Type().Elem().Implements(resourceType) {
+			v.resources(field, fieldPath)
+			continue
+		}
+		if field.Kind() == reflect.Struct && field.IsZero() {
+			// a missing required struct is reported above, not its children
+			continue
+		}
+		v.value(field, fieldPath)
+	}
+}
+
+// resources validates fields holding resources, raw resources of unknown types are skipped
+func (v *validator) resources(val reflect.Value, path string) {
+	if val.Kind() == reflect.Slice {
+		for i := 0; i < val.Len(); i++ {
+			v.resources(val.Index(i), path+"["+strconv.Itoa(i)+"]")
+		}
+		return
+	}
+	if val.IsNil() {
+		return
+	}
+	if _, raw := val.Interface().(*common.RawResource); raw {
+		return
+	}
+	v.value(val, path)
+}
+
+// choices reports the choice errors of a struct
+func (v *validator) choices(err error, path string) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			v.choices(e, path)
+		}
+		return
+	}
+	var choiceErr *common.ChoiceError
+	if errors.As(err, &choiceErr) {
+		v.addIssue(IssueTypeStructure, path+"."+strings.TrimSuffix(choiceErr.Path, "[x]"), "only one of the types %s may be set", strings.Join(choiceErr.Types, ", "))
+	}
+}
+
+// collectFields maps the JSON names of a struct to its fields, including those
+// of embedded structs; fields declared by the outer struct win
+func collectFields(val reflect.Value, fields map[string]reflect.Value, names *[]string) {
+	t := val.Type()
+	var embedded []int
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, i)
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" || name == "-" || name == "resourceType" && field.Type.Kind() == reflect.String {
+			continue
+		}
+		if _, ok := fields[name]; ok {
+			continue
+		}
+		fields[name] = val.Field(i)
+		if !strings.HasPrefix(name, "_") {
+			*names = append(*names, name)
+		}
+	}
+	for _, i := range embedded {
+		collectFields(val.Field(i), fields, names)
+	}
+}
+
+// present returns the number of values of a field
+func present(val reflect.Value) int {
+	if !val.IsValid() {
+		return 0
+	}
+	switch val.Kind() {
+	case reflect.Slice:
+		return val.Len()
+	case reflect.Pointer, reflect.Interface, reflect.Map:
+		if val.IsNil() {
+			return 0
+		}
+		return 1
+	case reflect.Bool, reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Float64:
+		// false and 0 are values, absence cannot be told apart
+		return 1
+	}
+	if val.IsZero() {
+		return 0
+	}
+	return 1
+}
+
+func isChoiceType(typeName string) bool {
+	return typeName != "" && typeName[0] >= 'A' && typeName[0] <= 'Z'
+}
@@ -0,0 +1,181 @@
+package validate
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/d4l-data4life/go-fhir/pkg/common"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir2"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir3"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir4b"
+	"github.com/d4l-data4life/go-fhir/pkg/fhir5"
+)
+
+func TestResource_Examples(t *testing.T) {
+	files, err := filepath.Glob("../fhir5/testdata/fhir5-json/*.json")
+	if err != nil {
+		t.Fatalf("failed to list example files: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read example file %s: %v", file, err)
+		}
+		resource, err := fhir5.UnmarshalResource(data)
+		if _, raw := resource.(*common.RawResource); err != nil || raw {
+			continue
+		}
+		outcome, err := Resource(resource)
+		if err != nil {
+			t.Fatalf("%s: %v", filepath.Base(file), err)
+		}
+		if HasErrors(outcome) {
+			for _, issue := range outcome.Issue {
+				t.Errorf("%s: %s %s", filepath.Base(file), issue.Expression, *issue.Diagnostics)
+			}
+		}
+	}
+}
+
+func TestResource_Observation(t *testing.T) {
+	observation := &fhir5.Observation{
+		Status:            "done",
+		EffectiveDateTime: common.DateTimePtr(common.MustParseDateTime("2024-01-01")),
+		EffectivePeriod:   &common.Period{},
+		Component: []fhir5.ObservationComponent{
+			{ValueString: fhir5.StringPtr("a")},
+		},
+	}
+	bundle := &fhir5.Bundle{Type: fhir5.BundleTypeCollection, Entry: []fhir5.BundleEntry{{Resource: observation}}}
+
+	outcome, err := Resource(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !HasErrors(outcome) {
+		t.Fatal("expected errors")
+	}
+	got := map[string]string{}
+	for _, issue := range outcome.Issue {
+		if len(issue.Expression) != 1 {
+			t.Fatalf("expected one expression, got %v", issue.Expression)
+		}
+		got[issue.Expression[0]] = issue.Code
+	}
+	want := map[string]string{
+		"Bundle.entry[0].resource.status":            IssueTypeCodeInvalid,
+		"Bundle.entry[0].resource.code":              IssueTypeRequired,
+		"Bundle.entry[0].resource.effective":         IssueTypeStructure,
+		"Bundle.entry[0].resource.component[0].code": IssueTypeRequired,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got issues %v, want %v", got, want)
+	}
+}
+
+func TestResource_Valid(t *testing.T) {
+	outcome, err := Resource(&fhir5.OperationOutcome{Issue: []fhir5.OperationOutcomeIssue{{
+		Severity: fhir5.OperationOutcomeIssueSeverityWarning,
+		Code:     "informational",
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HasErrors(outcome) || len(outcome.Issue) != 1 || outcome.Issue[0].Code != "informational" {
+		t.Errorf("expected a single informational issue, got %+v", outcome.Issue)
+	}
+
+	outcome, err = Resource(&fhir5.OperationOutcome{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !HasErrors(outcome) || outcome.Issue[0].Expression[0] != "OperationOutcome.issue" {
+		t.Errorf("expected missing issue to be reported, got %+v", outcome.Issue)
+	}
+}
+
+func TestResource_Versions(t *testing.T) {
+	for _, dir := range []string{"../fhir2/testdata/fhir2-json", "../fhir3/testdata/fhir3-json"} {
+		files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil || len(files) == 0 {
+			t.Fatalf("failed to list example files: %v", err)
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("failed to read example file %s: %v", file, err)
+			}
+			var resource common.Resource
+			if strings.Contains(dir, "fhir2") {
+				resource, err = fhir2.UnmarshalResource(data)
+			} else {
+				resource, err = fhir3.UnmarshalResource(data)
+			}
+			if err != nil {
+				t.Fatalf("failed to unmarshal %s: %v", file, err)
+			}
+			outcome, err := Resource(resource)
+			if err != nil {
+				t.Fatalf("%s: %v", filepath.Base(file), err)
+			}
+			for _, issue := range outcome.Issue {
+				if issue.Severity == fhir5.OperationOutcomeIssueSeverityError {
+					t.Errorf("%s: %s %s", filepath.Base(file), issue.Expression, *issue.Diagnostics)
+				}
+			}
+		}
+	}
+
+	// R4 requires the medication and subject of a MedicationRequest
+	outcome, err := Resource(&fhir4.MedicationRequest{Status: "active", Intent: "order"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, issue := range outcome.Issue {
+		got = append(got, issue.Expression[0])
+	}
+	want := []string{"MedicationRequest.medication", "MedicationRequest.subject"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got issues %v, want %v", got, want)
+	}
+
+	// R4B no longer requires the characteristic R4 requires, and requires the
+	// relationship type of the Citation elements R4 does not define
+	outcome, err = Resource(&fhir4b.EvidenceVariable{Status: fhir4b.EvidenceVariableStatusActive})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if HasErrors(outcome) {
+		t.Errorf("expected a valid R4B EvidenceVariable, got %+v", outcome.Issue)
+	}
+	outcome, err = Resource(&fhir4b.Citation{Status: fhir4b.CitationStatusActive, RelatesTo: []fhir4b.CitationRelatesTo{{}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = nil
+	for _, issue := range outcome.Issue {
+		got = append(got, issue.Expression[0])
+	}
+	want = []string{"Citation.relatesTo[0].relationshipType"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got issues %v, want %v", got, want)
+	}
+}
+
+func TestResource_Unsupported(t *testing.T) {
+	if _, err := Resource(&common.RawResource{ResourceType: "Patient"}); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("expected ErrUnsupportedVersion for a raw resource, got %v", err)
+	}
+	if _, err := Resource(nil); err == nil {
+		t.Error("expected an error for a nil resource")
+	}
+	if _, err := Resource((*fhir5.Patient)(nil)); err == nil {
+		t.Error("expected an error for a nil pointer")
+	}
+}